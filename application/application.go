@@ -2,32 +2,47 @@ package application
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/apirpc"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/mtls"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/rpc"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
 	"github.com/cloudreve/Cloudreve/v4/pkg/email"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/onedrive"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/tracing"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/cloudreve/Cloudreve/v4/routers"
+	"github.com/cloudreve/Cloudreve/v4/service/grpcapi"
+	"github.com/cloudreve/Cloudreve/v4/service/node"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 type Server interface {
 	// Start starts the Cloudreve server.
 	Start() error
 	PrintBanner()
+	// Reload re-reads the config file and applies hot reloadable changes.
+	Reload()
 	Close()
 }
 
@@ -48,6 +63,16 @@ type server struct {
 	server    *http.Server
 	kv        cache.Driver
 	mailQueue email.Driver
+	// shutdownTracing flushes pending spans on close.
+	shutdownTracing func(context.Context) error
+	// stopHeartbeat stops pushing heartbeats to master in slave mode.
+	stopHeartbeat context.CancelFunc
+	// grpcServer serves master calls in slave mode, or the gRPC API in master mode, nil if disabled.
+	grpcServer *grpc.Server
+	// slaveTLS is the TLS config of listeners in slave mode, nil if SSL is not enabled.
+	slaveTLS *tls.Config
+	// stopCron stops cron jobs and resigns the cron leadership.
+	stopCron context.CancelFunc
 }
 
 func (s *server) PrintBanner() {
@@ -70,6 +95,25 @@ func (s *server) Start() error {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
+	shutdownTracing, err := tracing.Init(context.Background(), s.config.Tracing(), s.config.System().Mode)
+	if err != nil {
+		return fmt.Errorf("failed to initialize tracing: %w", err)
+	}
+	s.shutdownTracing = shutdownTracing
+
+	if reporting := s.config.ErrorReporting(); reporting.DSN != "" {
+		reporter, err := logging.NewSentryReporter(&logging.SentryOptions{
+			DSN:         reporting.DSN,
+			Environment: reporting.Environment,
+			Release:     fmt.Sprintf("cloudreve@%s+%s", constants.BackendVersion, constants.LastCommit),
+			SampleRate:  reporting.SampleRate,
+		}, s.logger)
+		if err != nil {
+			return fmt.Errorf("failed to initialize error reporter: %w", err)
+		}
+		logging.SetErrorReporter(reporter)
+	}
+
 	s.kv = s.dep.KV()
 	// delete all cached settings
 	_ = s.kv.Delete(setting.KvSettingPrefix)
@@ -94,35 +138,89 @@ func (s *server) Start() error {
 			cred := dep.CredManager()
 			cred.RefreshAll(ctx)
 		})
+		crontab.Register(setting.CronTypeAuditLogPrune, func(ctx context.Context) {
+			dep := dependency.FromContext(ctx)
+			retention := dep.SettingProvider().AuditLogRetention(ctx)
+			if retention <= 0 {
+				return
+			}
+
+			deleted, err := dep.AuditRecorder().Prune(ctx, time.Now().Add(-retention))
+			if err != nil {
+				dep.Logger().Warning("Failed to prune audit logs: %s", err)
+				return
+			}
+
+			if deleted > 0 {
+				dep.Logger().Info("Pruned %d expired audit log(s).", deleted)
+			}
+		})
 
 		// Initialize email queue before user traffic starts.
 		_ = s.dep.EmailClient(context.Background())
+		// Initialize usage statistics recorder before user traffic starts.
+		_ = s.dep.StatsRecorder()
 
 		// Start all queues
 		s.dep.MediaMetaQueue(context.Background()).Start()
 		s.dep.EntityRecycleQueue(context.Background()).Start()
 		s.dep.IoIntenseQueue(context.Background()).Start()
 		s.dep.RemoteDownloadQueue(context.Background()).Start()
+		s.dep.ThumbPregenQueue(context.Background()).Start()
 
 		// Start cron jobs
-		c, err := crontab.NewCron(context.Background(), s.dep)
+		cronCtx, stopCron := context.WithCancel(context.Background())
+		c, err := crontab.NewCron(cronCtx, s.dep)
 		if err != nil {
+			stopCron()
 			return err
 		}
 		c.Start()
+		s.stopCron = func() {
+			c.Stop()
+			stopCron()
+		}
+
+		// Folders created before their sizes are cached are reconciled once in background.
+		go manager.ReconcileFolderSizesOnce(s.dep.ForkWithLogger(cronCtx, s.logger))
 
 		// Start node pool
 		if _, err := s.dep.NodePool(context.Background()); err != nil {
 			return err
 		}
+
+		if err := s.startAPIGRPC(); err != nil {
+			return err
+		}
 	} else {
+		if err := s.initSlaveTLS(); err != nil {
+			return err
+		}
+
 		s.dep.SlaveQueue(context.Background()).Start()
+
+		// Push heartbeats to master
+		var heartbeatCtx context.Context
+		heartbeatCtx, s.stopHeartbeat = context.WithCancel(context.Background())
+		go cluster.RunHeartbeat(heartbeatCtx, s.logger, s.config, s.kv)
+
+		if err := s.startGRPC(); err != nil {
+			return err
+		}
 	}
 	s.dep.ThumbQueue(context.Background()).Start()
 
 	api := routers.InitRouter(s.dep)
 	api.TrustedPlatform = s.config.System().ProxyHeader
 	s.server = &http.Server{Handler: api}
+	if s.slaveTLS != nil {
+		// Client facing routes are served by the same listener, certificate of master is
+		// enforced per route by middleware.
+		s.server.TLSConfig = s.slaveTLS.Clone()
+		if s.server.TLSConfig.ClientAuth == tls.RequireAndVerifyClientCert {
+			s.server.TLSConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+	}
 
 	// 如果启用了SSL
 	if s.config.SSL().CertPath != "" {
@@ -160,27 +258,176 @@ func (s *server) Start() error {
 	return nil
 }
 
-func (s *server) Close() {
-	if s.dbClient != nil {
-		s.logger.Info("Shutting down database connection...")
-		if err := s.dbClient.Close(); err != nil {
-			s.logger.Error("Failed to close database connection: %s", err)
+// initSlaveTLS loads TLS config of slave listeners. In secure mode, plaintext listeners and master
+// URL are rejected.
+func (s *server) initSlaveTLS() error {
+	slaveConf := s.config.Slave()
+	certPath := s.config.SSL().CertPath
+	if certPath == "" && slaveConf.ClientCAPath != "" {
+		return errors.New("SSL must be enabled to verify client certificates of master")
+	}
+
+	if slaveConf.SecureMode {
+		if certPath == "" || slaveConf.ClientCAPath == "" {
+			return errors.New("secure mode requires SSL certificate and client CA of master to be configured")
+		}
+
+		if slaveConf.MasterURL != "" {
+			if u, err := url.Parse(slaveConf.MasterURL); err != nil || u.Scheme != "https" {
+				return errors.New("secure mode requires master URL to use https")
+			}
+		}
+	}
+
+	if certPath == "" {
+		return nil
+	}
+
+	config, err := mtls.ServerConfig(certPath, s.config.SSL().KeyPath, slaveConf.ClientCAPath)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS config: %w", err)
+	}
+
+	s.slaveTLS = config
+	return nil
+}
+
+// startGRPC starts the gRPC service for master calls if configured.
+func (s *server) startGRPC() error {
+	addr := s.config.Slave().GRPCListen
+	if addr == "" {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen to %q for gRPC: %w", addr, err)
+	}
+
+	var opts []grpc.ServerOption
+	if s.slaveTLS != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.slaveTLS)))
+	}
+
+	impl := node.NewGRPCServer(s.dep)
+	s.grpcServer = rpc.NewServer(s.dep.GeneralAuth(), impl.Context, opts...)
+	rpc.RegisterSlaveServer(s.grpcServer, impl)
+
+	s.logger.Info("gRPC service listening to %q", addr)
+	go func() {
+		if err := s.grpcServer.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			s.logger.Error("gRPC server stopped: %s", err)
+		}
+	}()
+
+	return nil
+}
+
+// startAPIGRPC starts the gRPC API for programmatic access if configured.
+func (s *server) startAPIGRPC() error {
+	addr := s.config.System().GRPCListen
+	if addr == "" {
+		return nil
+	}
+
+	var opts []grpc.ServerOption
+	if s.config.SSL().CertPath != "" {
+		creds, err := credentials.NewServerTLSFromFile(s.config.SSL().CertPath, s.config.SSL().KeyPath)
+		if err != nil {
+			return fmt.Errorf("failed to load SSL certificate for gRPC: %w", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen to %q for gRPC: %w", addr, err)
+	}
+
+	impl := grpcapi.NewServer(s.dep)
+	opts = append(opts, grpc.ChainUnaryInterceptor(impl.UnaryInterceptor()), grpc.ChainStreamInterceptor(impl.StreamInterceptor()))
+	s.grpcServer = apirpc.NewServer(impl.VerifyToken, impl.Context, opts...)
+	apirpc.RegisterAPIServer(s.grpcServer, impl)
+
+	s.logger.Info("gRPC API listening to %q", addr)
+	go func() {
+		if err := s.grpcServer.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			s.logger.Error("gRPC server stopped: %s", err)
 		}
+	}()
+
+	return nil
+}
+
+func (s *server) Reload() {
+	if _, err := s.dep.ReloadConfig(context.Background()); err != nil {
+		s.logger.Error("Failed to reload config: %s", err)
 	}
+}
 
-	ctx := context.Background()
-	if conf.SystemConfig.GracePeriod != 0 {
+// closeTimeout limits the time to shut down dependencies after the drain window.
+const closeTimeout = 30 * time.Second
+
+// Close stops accepting new requests and tasks, waits for in-flight requests (e.g. chunk uploads)
+// and running tasks within the grace period, then shuts down dependencies and closes DB/KV.
+func (s *server) Close() {
+	if s.stopHeartbeat != nil {
+		s.stopHeartbeat()
+	}
+
+	if s.stopCron != nil {
+		s.stopCron()
+	}
+
+	drainCtx := context.Background()
+	if grace := s.config.System().GracePeriod; grace != 0 {
 		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(ctx, time.Duration(s.config.System().GracePeriod)*time.Second)
+		drainCtx, cancel = context.WithTimeout(drainCtx, time.Duration(grace)*time.Second)
 		defer cancel()
 	}
 
-	// Shutdown http server
+	// Shutdown http server and drain task queues at the same time
+	wg := sync.WaitGroup{}
 	if s.server != nil {
-		err := s.server.Shutdown(ctx)
-		if err != nil {
-			s.logger.Error("Failed to shutdown server: %s", err)
-		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.logger.Info("Waiting for in-flight requests to finish...")
+			if err := s.server.Shutdown(drainCtx); err != nil {
+				s.logger.Warning("Failed to shutdown server gracefully, remaining connections are closed: %s", err)
+				_ = s.server.Close()
+			}
+		}()
+	}
+
+	if s.grpcServer != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stopped := make(chan struct{})
+			go func() {
+				s.grpcServer.GracefulStop()
+				close(stopped)
+			}()
+
+			select {
+			case <-stopped:
+			case <-drainCtx.Done():
+				s.logger.Warning("Failed to stop gRPC server gracefully, remaining calls are canceled.")
+				s.grpcServer.Stop()
+			}
+		}()
+	}
+
+	s.dep.Drain(drainCtx)
+	wg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+
+	// Tasks still running are interrupted and persisted to be resumed on next start.
+	if err := s.dep.Shutdown(ctx); err != nil {
+		s.logger.Warning("Failed to shutdown dependency manager: %s", err)
 	}
 
 	if s.kv != nil {
@@ -189,8 +436,21 @@ func (s *server) Close() {
 		}
 	}
 
-	if err := s.dep.Shutdown(ctx); err != nil {
-		s.logger.Warning("Failed to shutdown dependency manager: %s", err)
+	if s.shutdownTracing != nil {
+		if err := s.shutdownTracing(ctx); err != nil {
+			s.logger.Warning("Failed to flush traces: %s", err)
+		}
+	}
+
+	if err := logging.Reporter().Flush(ctx); err != nil {
+		s.logger.Warning("Failed to flush error reports: %s", err)
+	}
+
+	if s.dbClient != nil {
+		s.logger.Info("Shutting down database connection...")
+		if err := s.dbClient.Close(); err != nil {
+			s.logger.Error("Failed to close database connection: %s", err)
+		}
 	}
 }
 
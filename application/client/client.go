@@ -0,0 +1,444 @@
+// Package client implements a command line client of Cloudreve talking to the REST API of a
+// remote server with a personal access token. Request and response types are shared with the server.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/service/explorer"
+	"github.com/cloudreve/Cloudreve/v4/service/share"
+	"github.com/cloudreve/Cloudreve/v4/service/user"
+)
+
+const (
+	configDirName  = "cloudreve"
+	configFileName = "client.json"
+	listPageSize   = 100
+	requestTimeout = 60 * time.Second
+	// RemotePrefix marks an argument as a remote path, e.g. "cr:/docs/a.txt".
+	RemotePrefix = "cr:"
+)
+
+var (
+	ErrNotLoggedIn       = errors.New("not logged in, run `cloudreve client login` first")
+	ErrUnsupportedUpload = errors.New("uploading to this storage policy is not supported by the client, " +
+		"ask the administrator to enable relay upload for it")
+)
+
+type (
+	// Config is persisted in user's config dir after login.
+	Config struct {
+		Server string `json:"server"`
+		Token  string `json:"token"`
+	}
+
+	Client struct {
+		server *url.URL
+		http   request.Client
+	}
+)
+
+// ConfigPath returns the path of client config file.
+func ConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, configDirName, configFileName), nil
+}
+
+// LoadConfig reads saved client config.
+func LoadConfig() (*Config, error) {
+	p, err := ConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(p)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotLoggedIn
+		}
+		return nil, err
+	}
+
+	config := &Config{}
+	if err := json.Unmarshal(content, config); err != nil {
+		return nil, fmt.Errorf("invalid client config %q: %w", p, err)
+	}
+
+	if config.Server == "" || config.Token == "" {
+		return nil, ErrNotLoggedIn
+	}
+
+	return config, nil
+}
+
+// Save persists the config, only readable by current user as it contains the token.
+func (c *Config) Save() error {
+	p, err := ConfigPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(p, content, 0600)
+}
+
+// New creates a client with given config.
+func New(config *Config) (*Client, error) {
+	server, err := url.Parse(strings.TrimSuffix(config.Server, "/"))
+	if err != nil || (server.Scheme != "http" && server.Scheme != "https") || server.Host == "" {
+		return nil, fmt.Errorf("invalid server URL %q", config.Server)
+	}
+
+	if !strings.HasPrefix(config.Token, auth.AccessTokenPrefix) {
+		return nil, fmt.Errorf("token must be a personal access token starting with %q", auth.AccessTokenPrefix)
+	}
+
+	return &Client{
+		server: server,
+		http: request.NewClientDeprecated(
+			request.WithEndpoint(server.JoinPath(constants.APIPrefix).String()+"/"),
+			request.WithHeader(http.Header{"Authorization": {auth.TokenHeaderPrefix + config.Token}}),
+		),
+	}, nil
+}
+
+// ResolveUri converts a remote path into a Cloudreve URI, plain paths are resolved under "My files".
+func ResolveUri(p string) (*fs.URI, error) {
+	p = strings.TrimPrefix(p, RemotePrefix)
+	if strings.HasPrefix(p, constants.CloudreveScheme+"://") {
+		return fs.NewUriFromString(p)
+	}
+
+	root, err := fs.NewUriFromString(fs.NewMyUri(""))
+	if err != nil {
+		return nil, err
+	}
+
+	p = path.Clean("/" + p)
+	if p == "/" {
+		return root, nil
+	}
+
+	return root.JoinRaw(p), nil
+}
+
+// IsRemote returns true if given argument refers to a remote path.
+func IsRemote(p string) bool {
+	return strings.HasPrefix(p, RemotePrefix) || strings.HasPrefix(p, constants.CloudreveScheme+"://")
+}
+
+// call sends a request to the API and decodes data of the response into res if not nil.
+func (c *Client) call(ctx context.Context, method, target string, body any, res any, opts ...request.Option) error {
+	var reader io.Reader
+	switch b := body.(type) {
+	case nil:
+	case io.Reader:
+		reader = b
+	default:
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		reader = bytes.NewReader(encoded)
+		opts = append(opts,
+			request.WithHeader(http.Header{"Content-Type": {"application/json"}}),
+			request.WithTimeout(requestTimeout),
+		)
+	}
+
+	opts = append(opts, request.WithContext(ctx))
+	resp, err := c.http.Request(method, target, reader, opts...).CheckHTTPResponse(http.StatusOK).DecodeResponse()
+	if err != nil {
+		return err
+	}
+
+	if resp.Code != 0 {
+		return serializer.NewErrorFromResponse(resp)
+	}
+
+	if res == nil || resp.Data == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, res)
+}
+
+// Me returns the user that owns the token.
+func (c *Client) Me(ctx context.Context) (*user.User, error) {
+	res := &user.User{}
+	return res, c.call(ctx, http.MethodGet, "user/me", nil, res)
+}
+
+// Get returns info of given file.
+func (c *Client) Get(ctx context.Context, uri *fs.URI) (*explorer.FileResponse, error) {
+	res := &explorer.FileResponse{}
+	return res, c.call(ctx, http.MethodGet, "file/info?"+url.Values{"uri": {uri.String()}}.Encode(), nil, res)
+}
+
+// List returns all children of given folder.
+func (c *Client) List(ctx context.Context, uri *fs.URI) ([]explorer.FileResponse, error) {
+	var (
+		files []explorer.FileResponse
+		page  = 0
+		token = ""
+	)
+	for {
+		query := url.Values{
+			"uri":       {uri.String()},
+			"page_size": {strconv.Itoa(listPageSize)},
+			"page":      {strconv.Itoa(page)},
+		}
+		if token != "" {
+			query.Set("next_page_token", token)
+		}
+
+		res := &explorer.ListResponse{}
+		if err := c.call(ctx, http.MethodGet, "file?"+query.Encode(), nil, res); err != nil {
+			return nil, err
+		}
+
+		files = append(files, res.Files...)
+		if res.Pagination == nil {
+			return files, nil
+		}
+
+		switch {
+		case res.Pagination.NextPageToken != "":
+			token = res.Pagination.NextPageToken
+		case !res.Pagination.IsCursor && (page+1)*res.Pagination.PageSize < res.Pagination.TotalItems:
+			page++
+		default:
+			return files, nil
+		}
+	}
+}
+
+// Walk lists given folder recursively, f is called with the path of each file relative to the folder.
+func (c *Client) Walk(ctx context.Context, uri *fs.URI, f func(rel string, file *explorer.FileResponse) error) error {
+	return c.walk(ctx, uri, "", f)
+}
+
+func (c *Client) walk(ctx context.Context, uri *fs.URI, rel string, f func(rel string, file *explorer.FileResponse) error) error {
+	files, err := c.List(ctx, uri)
+	if err != nil {
+		return err
+	}
+
+	for i := range files {
+		childRel := path.Join(rel, files[i].Name)
+		if err := f(childRel, &files[i]); err != nil {
+			return err
+		}
+
+		if files[i].Type == int(types.FileTypeFolder) {
+			if err := c.walk(ctx, uri.Join(files[i].Name), childRel, f); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateFolder creates a folder and its missing parents, existing folder is not an error.
+func (c *Client) CreateFolder(ctx context.Context, uri *fs.URI) error {
+	return c.call(ctx, http.MethodPost, "file/create", &explorer.CreateFileService{
+		Uri:  uri.String(),
+		Type: "folder",
+	}, nil)
+}
+
+// Move moves or copies files into given folder.
+func (c *Client) Move(ctx context.Context, dst *fs.URI, copy bool, src ...*fs.URI) error {
+	return c.call(ctx, http.MethodPost, "file/move", &explorer.MoveFileService{
+		Uris: uriStrings(src),
+		Dst:  dst.String(),
+		Copy: copy,
+	}, nil)
+}
+
+// Rename renames a file.
+func (c *Client) Rename(ctx context.Context, uri *fs.URI, newName string) error {
+	return c.call(ctx, http.MethodPost, "file/rename", &explorer.RenameFileService{
+		Uri:     uri.String(),
+		NewName: newName,
+	}, nil)
+}
+
+// Delete deletes files, they are moved to trash bin unless skipSoftDelete is set.
+func (c *Client) Delete(ctx context.Context, skipSoftDelete bool, uris ...*fs.URI) error {
+	return c.call(ctx, http.MethodDelete, "file", &explorer.DeleteFileService{
+		Uris:           uriStrings(uris),
+		SkipSoftDelete: skipSoftDelete,
+	}, nil)
+}
+
+// CreateShare creates a share link of given file and returns the link.
+func (c *Client) CreateShare(ctx context.Context, uri *fs.URI, args *share.ShareCreateService) (string, error) {
+	args.Uri = uri.String()
+	var link string
+	return link, c.call(ctx, http.MethodPut, "share", args, &link)
+}
+
+// Upload uploads the content of a local file to given URI, the existing file is overwritten.
+func (c *Client) Upload(ctx context.Context, uri *fs.URI, src *os.File) error {
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+
+	session := &explorer.UploadSessionResponse{}
+	if err := c.call(ctx, http.MethodPut, "file/upload", &explorer.CreateUploadSessionService{
+		Uri:          uri.String(),
+		Size:         info.Size(),
+		LastModified: info.ModTime().UnixMilli(),
+	}, session); err != nil {
+		return err
+	}
+
+	if err := c.uploadChunks(ctx, session, src, info.Size()); err != nil {
+		_ = c.call(context.Background(), http.MethodDelete, "file/upload", &explorer.DeleteUploadSessionService{
+			ID:  session.SessionID,
+			Uri: session.Uri,
+		}, nil)
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) uploadChunks(ctx context.Context, session *explorer.UploadSessionResponse, src io.ReaderAt, size int64) error {
+	if session.StoragePolicy == nil {
+		return ErrUnsupportedUpload
+	}
+
+	chunkSize := session.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = max(size, 1)
+	}
+
+	for index, offset := 0, int64(0); offset < size || index == 0; index, offset = index+1, offset+chunkSize {
+		length := min(chunkSize, size-offset)
+		chunk := io.NewSectionReader(src, offset, length)
+
+		var err error
+		switch {
+		case session.StoragePolicy.Type == types.PolicyTypeLocal || session.StoragePolicy.Relay:
+			err = c.call(ctx, http.MethodPost, fmt.Sprintf("file/upload/%s/%d", session.SessionID, index),
+				chunk, nil, request.WithContentLength(length))
+		case session.StoragePolicy.Type == types.PolicyTypeRemote && len(session.UploadURLs) > 0:
+			err = c.uploadRemoteChunk(ctx, session, index, chunk, length)
+		default:
+			return ErrUnsupportedUpload
+		}
+		if err != nil {
+			return fmt.Errorf("failed to upload chunk #%d: %w", index, err)
+		}
+	}
+
+	return nil
+}
+
+// uploadRemoteChunk uploads a chunk to the slave node directly with the credential in session.
+func (c *Client) uploadRemoteChunk(ctx context.Context, session *explorer.UploadSessionResponse, index int, chunk io.Reader, length int64) error {
+	target, err := url.Parse(session.UploadURLs[0])
+	if err != nil {
+		return err
+	}
+
+	query := target.Query()
+	query.Set("chunk", strconv.Itoa(index))
+	target.RawQuery = query.Encode()
+
+	resp, err := request.NewClientDeprecated().Request(http.MethodPost, target.String(), chunk,
+		request.WithContext(ctx),
+		request.WithContentLength(length),
+		request.WithHeader(http.Header{"Authorization": {session.Credential}}),
+	).CheckHTTPResponse(http.StatusOK).DecodeResponse()
+	if err != nil {
+		return err
+	}
+
+	if resp.Code != 0 {
+		return serializer.NewErrorFromResponse(resp)
+	}
+
+	return nil
+}
+
+// Download writes the content of given file into dst.
+func (c *Client) Download(ctx context.Context, uri *fs.URI, dst io.Writer) error {
+	res := &explorer.FileURLResponse{}
+	if err := c.call(ctx, http.MethodPost, "file/url", &explorer.FileURLService{
+		Uris:     []string{uri.String()},
+		Download: true,
+	}, res); err != nil {
+		return err
+	}
+
+	if len(res.Urls) == 0 {
+		return fmt.Errorf("no download URL returned for %q", uri.String())
+	}
+
+	target, err := url.Parse(res.Urls[0].Url)
+	if err != nil {
+		return err
+	}
+
+	// Download URLs might point to other hosts, the token must not be sent along.
+	resp := request.NewClientDeprecated().Request(http.MethodGet, c.server.ResolveReference(target).String(), nil,
+		request.WithContext(ctx)).
+		CheckHTTPResponse(http.StatusOK)
+	if resp.Err != nil {
+		return resp.Err
+	}
+	defer resp.Response.Body.Close()
+
+	_, err = io.Copy(dst, resp.Response.Body)
+	return err
+}
+
+func uriStrings(uris []*fs.URI) []string {
+	res := make([]string, len(uris))
+	for i, u := range uris {
+		res[i] = u.String()
+	}
+	return res
+}
@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	_ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/routers"
+	"github.com/cloudreve/Cloudreve/v4/service/explorer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+const testToken = "cr_pat_test"
+
+func newTestServer(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := New(&Config{Server: server.URL, Token: testToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func writeData(w http.ResponseWriter, data any) {
+	_ = json.NewEncoder(w).Encode(serializer.Response{Data: data})
+}
+
+func TestClient_List(t *testing.T) {
+	a := assert.New(t)
+	c := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		a.Equal("/api/v4/file", r.URL.Path)
+		a.Equal("cloudreve://my/docs", r.URL.Query().Get("uri"))
+		if r.URL.Query().Get("next_page_token") == "" {
+			writeData(w, explorer.ListResponse{
+				Files:      []explorer.FileResponse{{Name: "a"}},
+				Pagination: &inventory.PaginationResults{NextPageToken: "next", IsCursor: true},
+			})
+			return
+		}
+
+		writeData(w, explorer.ListResponse{
+			Files:      []explorer.FileResponse{{Name: "b"}},
+			Pagination: &inventory.PaginationResults{IsCursor: true},
+		})
+	})
+
+	uri, _ := ResolveUri("/docs")
+	files, err := c.List(context.Background(), uri)
+	a.NoError(err)
+	a.Len(files, 2)
+	a.Equal("b", files[1].Name)
+}
+
+func TestClient_Upload(t *testing.T) {
+	a := assert.New(t)
+	var chunks []string
+	c := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v4/file/upload":
+			writeData(w, explorer.UploadSessionResponse{
+				SessionID:     "session",
+				ChunkSize:     4,
+				StoragePolicy: &explorer.StoragePolicy{Type: types.PolicyTypeLocal},
+			})
+		case r.Method == http.MethodPost:
+			content, _ := io.ReadAll(r.Body)
+			chunks = append(chunks, r.URL.Path+":"+string(content))
+			writeData(w, nil)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	src := filepath.Join(t.TempDir(), "a.txt")
+	a.NoError(os.WriteFile(src, []byte("hello world"), 0644))
+	uri, _ := ResolveUri("/a.txt")
+	a.NoError(c.CopyUp(context.Background(), src, uri))
+	a.Equal([]string{
+		"/api/v4/file/upload/session/0:hell",
+		"/api/v4/file/upload/session/1:o wo",
+		"/api/v4/file/upload/session/2:rld",
+	}, chunks)
+}
+
+func TestClient_Error(t *testing.T) {
+	a := assert.New(t)
+	c := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(serializer.Response{Code: serializer.CodeNotFound, Msg: "not found"})
+	})
+
+	uri, _ := ResolveUri("/missing")
+	files, err := c.walkRemote(context.Background(), uri)
+	a.NoError(err)
+	a.Nil(files)
+
+	_, err = c.Get(context.Background(), uri)
+	a.Error(err)
+}
+
+// TestClient_ManageFiles runs the client against the real router, personal access tokens must be
+// accepted by routes used by `cloudreve client mv/rename/rm` and sync.
+func TestClient_ManageFiles(t *testing.T) {
+	a := assert.New(t)
+	gin.SetMode(gin.TestMode)
+	drv, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l := logging.NewConsoleLogger(logging.LevelError)
+	dep := dependency.NewDependency(
+		dependency.WithLogger(l),
+		dependency.WithConfigPath(filepath.Join(t.TempDir(), "conf.ini")),
+		dependency.WithKV(cache.NewMemoStore("", l)),
+		dependency.WithRawEntClient(ent.NewClient(ent.Driver(drv))),
+	)
+	t.Cleanup(func() { dep.DBClient().Close() })
+
+	ctx := context.Background()
+	u, err := dep.UserClient().Create(ctx, &inventory.NewUserArgs{Email: "client@cloudreve.org", Status: user.StatusActive, GroupID: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(routers.InitRouter(dep))
+	t.Cleanup(server.Close)
+	newClient := func(scopes ...auth.Scope) *Client {
+		plain, token, err := auth.NewAccessToken(dep.HashIDEncoder(), u.ID, "client", lo.Map(scopes, func(s auth.Scope, _ int) string { return string(s) }), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := dep.UserClient().AddAccessToken(ctx, token); err != nil {
+			t.Fatal(err)
+		}
+
+		c, err := New(&Config{Server: server.URL, Token: plain})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	c := newClient(auth.ScopeFileRead, auth.ScopeFileUpload, auth.ScopeFileManage)
+	uri := func(p string) *fs.URI {
+		res, _ := ResolveUri(p)
+		return res
+	}
+	a.NoError(c.CreateFolder(ctx, uri("/a")))
+	a.NoError(c.CreateFolder(ctx, uri("/dst")))
+
+	// Token without files.manage is denied.
+	a.Error(newClient(auth.ScopeFileRead, auth.ScopeFileWrite).Rename(ctx, uri("/a"), "b"))
+
+	a.NoError(c.Rename(ctx, uri("/a"), "b"))
+	a.NoError(c.Move(ctx, uri("/dst"), false, uri("/b")))
+	files, err := c.List(ctx, uri("/dst"))
+	a.NoError(err)
+	if a.Len(files, 1) {
+		a.Equal("b", files[0].Name)
+	}
+
+	a.NoError(c.Delete(ctx, true, uri("/dst/b")))
+	files, err = c.List(ctx, uri("/dst"))
+	a.NoError(err)
+	a.Empty(files)
+}
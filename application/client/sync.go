@@ -0,0 +1,346 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	cfs "github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/service/explorer"
+)
+
+// mtimeTolerance absorbs precision loss of modified time stored on server side.
+const mtimeTolerance = time.Second
+
+type (
+	SyncOp string
+
+	// SyncAction is a change applied, or to be applied in dry run, by a sync.
+	SyncAction struct {
+		Op   SyncOp
+		Path string
+	}
+
+	SyncOptions struct {
+		// Delete removes files on the destination side that do not exist on the source side.
+		Delete bool
+		// DryRun only reports actions without changing anything.
+		DryRun bool
+		// OnAction is called before each action.
+		OnAction func(action SyncAction)
+	}
+
+	localEntry struct {
+		isDir   bool
+		size    int64
+		modTime time.Time
+	}
+)
+
+const (
+	SyncOpUpload   = SyncOp("upload")
+	SyncOpDownload = SyncOp("download")
+	SyncOpMkdir    = SyncOp("mkdir")
+	SyncOpDelete   = SyncOp("delete")
+)
+
+func (o *SyncOptions) report(op SyncOp, p string) {
+	if o.OnAction != nil {
+		o.OnAction(SyncAction{Op: op, Path: p})
+	}
+}
+
+// changed returns true if the file needs to be transferred, files are compared by size and modified time.
+func changed(size int64, modTime time.Time, remote *explorer.FileResponse) bool {
+	if remote.Size != size {
+		return true
+	}
+
+	diff := modTime.Sub(remote.UpdatedAt)
+	return diff >= mtimeTolerance || diff <= -mtimeTolerance
+}
+
+// SyncUp makes the remote folder a mirror of the local folder.
+func (c *Client) SyncUp(ctx context.Context, localDir string, remote *cfs.URI, opts *SyncOptions) error {
+	local, err := walkLocal(localDir)
+	if err != nil {
+		return err
+	}
+
+	remoteFiles, err := c.walkRemote(ctx, remote)
+	if err != nil {
+		return err
+	}
+
+	if remoteFiles == nil && !opts.DryRun {
+		if err := c.CreateFolder(ctx, remote); err != nil {
+			return fmt.Errorf("failed to create remote folder: %w", err)
+		}
+	}
+
+	for _, rel := range sortedKeys(local) {
+		entry := local[rel]
+		existing, ok := remoteFiles[rel]
+		if ok && (existing.Type == int(types.FileTypeFolder)) != entry.isDir {
+			return fmt.Errorf("%q is a file on one side and a folder on the other", rel)
+		}
+
+		if entry.isDir {
+			if ok {
+				continue
+			}
+
+			opts.report(SyncOpMkdir, rel)
+			if !opts.DryRun {
+				if err := c.CreateFolder(ctx, remote.JoinRaw(rel)); err != nil {
+					return fmt.Errorf("failed to create folder %q: %w", rel, err)
+				}
+			}
+			continue
+		}
+
+		if ok && !changed(entry.size, entry.modTime, existing) {
+			continue
+		}
+
+		opts.report(SyncOpUpload, rel)
+		if !opts.DryRun {
+			if err := c.uploadFile(ctx, filepath.Join(localDir, filepath.FromSlash(rel)), remote.JoinRaw(rel)); err != nil {
+				return fmt.Errorf("failed to upload %q: %w", rel, err)
+			}
+		}
+	}
+
+	if !opts.Delete {
+		return nil
+	}
+
+	var toDelete []*cfs.URI
+	for _, rel := range extraneous(sortedKeys(remoteFiles), local) {
+		opts.report(SyncOpDelete, rel)
+		toDelete = append(toDelete, remote.JoinRaw(rel))
+	}
+
+	if len(toDelete) == 0 || opts.DryRun {
+		return nil
+	}
+
+	return c.Delete(ctx, false, toDelete...)
+}
+
+// SyncDown makes the local folder a mirror of the remote folder.
+func (c *Client) SyncDown(ctx context.Context, remote *cfs.URI, localDir string, opts *SyncOptions) error {
+	remoteFiles, err := c.walkRemote(ctx, remote)
+	if err != nil {
+		return err
+	}
+
+	if remoteFiles == nil {
+		return fmt.Errorf("remote folder %q does not exist", remote.String())
+	}
+
+	if !opts.DryRun {
+		if err := os.MkdirAll(localDir, 0755); err != nil {
+			return err
+		}
+	}
+
+	local, err := walkLocal(localDir)
+	if err != nil && !(opts.DryRun && errors.Is(err, os.ErrNotExist)) {
+		return err
+	}
+
+	for _, rel := range sortedKeys(remoteFiles) {
+		file := remoteFiles[rel]
+		isDir := file.Type == int(types.FileTypeFolder)
+		existing, ok := local[rel]
+		if ok && existing.isDir != isDir {
+			return fmt.Errorf("%q is a file on one side and a folder on the other", rel)
+		}
+
+		dst := filepath.Join(localDir, filepath.FromSlash(rel))
+		if isDir {
+			if ok {
+				continue
+			}
+
+			opts.report(SyncOpMkdir, rel)
+			if !opts.DryRun {
+				if err := os.MkdirAll(dst, 0755); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if ok && !changed(existing.size, existing.modTime, file) {
+			continue
+		}
+
+		opts.report(SyncOpDownload, rel)
+		if !opts.DryRun {
+			if err := c.downloadFile(ctx, remote.JoinRaw(rel), dst, file.UpdatedAt); err != nil {
+				return fmt.Errorf("failed to download %q: %w", rel, err)
+			}
+		}
+	}
+
+	if !opts.Delete {
+		return nil
+	}
+
+	for _, rel := range extraneous(sortedKeys(local), remoteFiles) {
+		opts.report(SyncOpDelete, rel)
+		if !opts.DryRun {
+			if err := os.RemoveAll(filepath.Join(localDir, filepath.FromSlash(rel))); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// CopyUp uploads a local file to given URI.
+func (c *Client) CopyUp(ctx context.Context, src string, dst *cfs.URI) error {
+	return c.uploadFile(ctx, src, dst)
+}
+
+// CopyDown downloads given file to a local path, keeping its modified time.
+func (c *Client) CopyDown(ctx context.Context, src *cfs.URI, dst string) error {
+	file, err := c.Get(ctx, src)
+	if err != nil {
+		return err
+	}
+
+	if file.Type == int(types.FileTypeFolder) {
+		return fmt.Errorf("%q is a folder, use `sync down` instead", src.String())
+	}
+
+	return c.downloadFile(ctx, src, dst, file.UpdatedAt)
+}
+
+func (c *Client) uploadFile(ctx context.Context, src string, dst *cfs.URI) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return c.Upload(ctx, dst, f)
+}
+
+// downloadFile downloads into a temp file next to dst first, so that an interrupted download
+// never leaves a partial file that looks up to date.
+func (c *Client) downloadFile(ctx context.Context, src *cfs.URI, dst string, modTime time.Time) error {
+	tmp, err := os.CreateTemp(filepath.Dir(dst), "."+filepath.Base(dst)+".*.part")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	err = c.Download(ctx, src, tmp)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := os.Chtimes(tmp.Name(), modTime, modTime); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), dst)
+}
+
+// walkRemote lists the remote folder recursively, nil is returned if the folder does not exist.
+func (c *Client) walkRemote(ctx context.Context, root *cfs.URI) (map[string]*explorer.FileResponse, error) {
+	if _, err := c.Get(ctx, root); err != nil {
+		var appErr serializer.AppError
+		if errors.As(err, &appErr) && (appErr.Code == serializer.CodeNotFound || appErr.Code == serializer.CodeParentNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	res := make(map[string]*explorer.FileResponse)
+	return res, c.Walk(ctx, root, func(rel string, file *explorer.FileResponse) error {
+		res[rel] = file
+		return nil
+	})
+}
+
+// walkLocal lists the local folder recursively with slash separated relative paths as keys.
+func walkLocal(root string) (map[string]localEntry, error) {
+	res := make(map[string]localEntry)
+	return res, filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if p == root || (!d.IsDir() && !d.Type().IsRegular()) {
+			return nil
+		}
+
+		// Skip partial files of interrupted downloads.
+		if !d.IsDir() && strings.HasPrefix(d.Name(), ".") && strings.HasSuffix(d.Name(), ".part") {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+
+		res[filepath.ToSlash(rel)] = localEntry{isDir: d.IsDir(), size: info.Size(), modTime: info.ModTime()}
+		return nil
+	})
+}
+
+// extraneous returns paths not existing in source, children of an extraneous folder are omitted.
+// paths must be sorted.
+func extraneous[T any](paths []string, source map[string]T) []string {
+	var res []string
+	for _, p := range paths {
+		if _, ok := source[p]; ok {
+			continue
+		}
+
+		if len(res) > 0 && strings.HasPrefix(p, res[len(res)-1]+"/") {
+			continue
+		}
+
+		res = append(res, p)
+	}
+
+	return res
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	// Compare by path segments so that a folder is always followed by its children.
+	sort.Slice(keys, func(i, j int) bool {
+		return slices.Compare(strings.Split(keys[i], "/"), strings.Split(keys[j], "/")) < 0
+	})
+	return keys
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/service/explorer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChanged(t *testing.T) {
+	a := assert.New(t)
+	mod := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	remote := &explorer.FileResponse{Size: 10, UpdatedAt: mod}
+
+	a.False(changed(10, mod, remote))
+	a.False(changed(10, mod.Add(500*time.Millisecond), remote), "sub-second difference is ignored")
+	a.True(changed(11, mod, remote))
+	a.True(changed(10, mod.Add(2*time.Second), remote))
+	a.True(changed(10, mod.Add(-2*time.Second), remote))
+}
+
+func TestExtraneous(t *testing.T) {
+	a := assert.New(t)
+	paths := sortedKeys(map[string]int{
+		"a": 0, "a/b": 0, "a/b/c": 0, "a b": 0, "d": 0, "d/e": 0, "f": 0,
+	})
+	a.Equal([]string{"a", "a/b", "a/b/c", "a b", "d", "d/e", "f"}, paths)
+	a.Equal([]string{"a", "d/e"}, extraneous(paths, map[string]int{"a b": 0, "d": 0, "f": 0}))
+	a.Empty(extraneous(paths, map[string]int{"a": 0, "a/b": 0, "a/b/c": 0, "a b": 0, "d": 0, "d/e": 0, "f": 0}))
+}
+
+func TestResolveUri(t *testing.T) {
+	a := assert.New(t)
+	for input, expected := range map[string]string{
+		"":                              "cloudreve://my",
+		"/":                             "cloudreve://my",
+		"cr:/docs/a b.txt":              "cloudreve://my/docs/a%20b.txt",
+		"docs/../x":                     "cloudreve://my/x",
+		"cloudreve://my/docs":           "cloudreve://my/docs",
+		"cr:cloudreve://shared_with_me": "cloudreve://shared_with_me",
+	} {
+		uri, err := ResolveUri(input)
+		a.NoError(err, input)
+		a.Equal(expected, uri.String(), input)
+	}
+
+	a.True(IsRemote("cr:/a"))
+	a.True(IsRemote("cloudreve://my/a"))
+	a.False(IsRemote("./a"))
+}
+
+func TestWalkLocal(t *testing.T) {
+	a := assert.New(t)
+	root := t.TempDir()
+	a.NoError(os.MkdirAll(filepath.Join(root, "sub"), 0755))
+	a.NoError(os.WriteFile(filepath.Join(root, "sub", "a.txt"), []byte("hello"), 0644))
+	a.NoError(os.WriteFile(filepath.Join(root, ".a.txt.123.part"), []byte("partial"), 0644))
+
+	entries, err := walkLocal(root)
+	a.NoError(err)
+	a.Len(entries, 2)
+	a.True(entries["sub"].isDir)
+	a.EqualValues(5, entries["sub/a.txt"].size)
+}
@@ -0,0 +1,381 @@
+// Package dbtransfer copies all data of Cloudreve from one database to another, e.g. from SQLite to
+// Postgres or MySQL.
+package dbtransfer
+
+import (
+	"context"
+	rawsql "database/sql"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/migrate"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/samber/lo"
+)
+
+const DefaultBatchSize = 1000
+
+type (
+	Transfer struct {
+		l         logging.Logger
+		src       *sql.Driver
+		dst       *sql.Driver
+		batchSize int
+		deferred  []deferredUpdate
+	}
+
+	// TableCount is the number of rows of a table in source and target database.
+	TableCount struct {
+		Table  string
+		Source int64
+		Target int64
+	}
+
+	tablePlan struct {
+		table *schema.Table
+		// deferred are nullable foreign key columns referencing rows not inserted yet, they are inserted as
+		// NULL and updated after all tables are copied.
+		deferred map[string]bool
+		// selfRef are deferred columns referencing the table itself, only forward references are deferred.
+		selfRef map[string]bool
+	}
+
+	deferredUpdate struct {
+		table  string
+		column string
+		id     int64
+		value  int64
+	}
+)
+
+// New creates a transfer from src database to dst database. Target database must be empty.
+func New(l logging.Logger, src, dst *sql.Driver, batchSize int) *Transfer {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	return &Transfer{l: l, src: src, dst: dst, batchSize: batchSize}
+}
+
+// Run creates schema in target database, copies all tables and verifies row counts.
+func (t *Transfer) Run(ctx context.Context) ([]TableCount, error) {
+	t.l.Info("Creating schema in target database...")
+	if err := ent.NewClient(ent.Driver(t.dst)).Schema.Create(ctx); err != nil {
+		return nil, fmt.Errorf("failed to create schema in target database: %w", err)
+	}
+
+	for _, table := range migrate.Tables {
+		count, err := countRows(ctx, t.dst, table.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if count > 0 {
+			return nil, fmt.Errorf("table %q in target database is not empty", table.Name)
+		}
+	}
+
+	plans, err := plan(migrate.Tables)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range plans {
+		t.l.Info("Copying table %q...", p.table.Name)
+		copied, err := t.copyTable(ctx, p)
+		if err != nil {
+			return nil, fmt.Errorf("failed to copy table %q: %w", p.table.Name, err)
+		}
+
+		t.l.Info("Copied %d rows of table %q.", copied, p.table.Name)
+	}
+
+	t.l.Info("Restoring %d deferred references...", len(t.deferred))
+	d := t.dst.Dialect()
+	for _, u := range t.deferred {
+		query, args := sql.Dialect(d).Update(u.table).Set(u.column, u.value).Where(sql.EQ("id", u.id)).Query()
+		if _, err := t.dst.DB().ExecContext(ctx, query, args...); err != nil {
+			return nil, fmt.Errorf("failed to restore %q of row %d in table %q: %w", u.column, u.id, u.table, err)
+		}
+	}
+
+	if d == dialect.Postgres {
+		t.l.Info("Resetting sequences...")
+		if err := t.resetSequences(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	t.l.Info("Verifying row counts...")
+	return t.verify(ctx)
+}
+
+// plan orders tables so that referenced rows are inserted first. Cycles are broken at nullable foreign
+// keys, which are deferred.
+func plan(tables []*schema.Table) ([]*tablePlan, error) {
+	placed := make(map[string]int, len(tables))
+	plans := make([]*tablePlan, 0, len(tables))
+	place := func(table *schema.Table) {
+		placed[table.Name] = len(plans)
+		plans = append(plans, &tablePlan{table: table, deferred: make(map[string]bool), selfRef: make(map[string]bool)})
+	}
+
+	// unplacedRefs returns foreign keys of table referencing other tables not placed yet.
+	unplacedRefs := func(table *schema.Table) []*schema.ForeignKey {
+		return lo.Filter(table.ForeignKeys, func(fk *schema.ForeignKey, index int) bool {
+			_, ok := placed[fk.RefTable.Name]
+			return fk.RefTable != table && !ok
+		})
+	}
+
+	for len(plans) < len(tables) {
+		progress := false
+		for _, table := range tables {
+			if _, ok := placed[table.Name]; !ok && len(unplacedRefs(table)) == 0 {
+				place(table)
+				progress = true
+			}
+		}
+
+		if progress {
+			continue
+		}
+
+		cycle, found := lo.Find(tables, func(table *schema.Table) bool {
+			_, ok := placed[table.Name]
+			return !ok && lo.EveryBy(unplacedRefs(table), func(fk *schema.ForeignKey) bool {
+				return fk.Columns[0].Nullable
+			})
+		})
+		if !found {
+			return nil, fmt.Errorf("cannot resolve foreign key cycle among tables")
+		}
+		place(cycle)
+	}
+
+	for i, p := range plans {
+		for _, fk := range p.table.ForeignKeys {
+			if fk.RefTable != p.table && placed[fk.RefTable.Name] < i {
+				continue
+			}
+
+			column := fk.Columns[0]
+			if !column.Nullable || len(p.table.PrimaryKey) != 1 {
+				return nil, fmt.Errorf("cannot defer foreign key %q of table %q", fk.Symbol, p.table.Name)
+			}
+
+			p.deferred[column.Name] = true
+			p.selfRef[column.Name] = fk.RefTable == p.table
+		}
+	}
+
+	return plans, nil
+}
+
+func (t *Transfer) copyTable(ctx context.Context, p *tablePlan) (int, error) {
+	table := p.table
+	columns := lo.Map(table.Columns, func(c *schema.Column, index int) string {
+		return c.Name
+	})
+	pks := lo.Map(table.PrimaryKey, func(c *schema.Column, index int) string {
+		return c.Name
+	})
+	// Tables with a single integer primary key are paged by it, join tables are paged by offset.
+	keyset := len(table.PrimaryKey) == 1 && table.PrimaryKey[0].Type == field.TypeInt
+	pkIndex := lo.IndexOf(columns, pks[0])
+	batchSize := min(t.batchSize, maxBatchParams(t.dst.Dialect())/len(columns))
+
+	var (
+		copied int
+		lastID int64
+	)
+	for {
+		selector := sql.Dialect(t.src.Dialect()).Select(columns...).From(sql.Table(table.Name)).Limit(batchSize)
+		if keyset {
+			selector.Where(sql.GT(pks[0], lastID)).OrderBy(pks[0])
+		} else {
+			selector.OrderBy(pks...).Offset(copied)
+		}
+
+		rows, err := t.readRows(ctx, selector, table.Columns)
+		if err != nil {
+			return copied, err
+		}
+
+		if len(rows) == 0 {
+			return copied, nil
+		}
+
+		insert := sql.Dialect(t.dst.Dialect()).Insert(table.Name).Columns(columns...)
+		for _, row := range rows {
+			id, _ := row[pkIndex].(int64)
+			for i, column := range columns {
+				value, ok := row[i].(int64)
+				if !p.deferred[column] || !ok || (p.selfRef[column] && value < id) {
+					continue
+				}
+
+				t.deferred = append(t.deferred, deferredUpdate{table: table.Name, column: column, id: id, value: value})
+				row[i] = nil
+			}
+			insert.Values(row...)
+		}
+
+		query, args := insert.Query()
+		if _, err := t.dst.DB().ExecContext(ctx, query, args...); err != nil {
+			return copied, fmt.Errorf("failed to insert rows: %w", err)
+		}
+
+		copied += len(rows)
+		if keyset {
+			lastID, _ = rows[len(rows)-1][pkIndex].(int64)
+		}
+
+		if len(rows) < batchSize {
+			return copied, nil
+		}
+	}
+}
+
+// maxBatchParams returns the max number of parameters in one insert statement of given dialect.
+func maxBatchParams(d string) int {
+	if d == dialect.SQLite {
+		// SQLite builds before 3.32 only allow 999 parameters.
+		return 999
+	}
+
+	// Limit of Postgres, MySQL allows the same number of placeholders.
+	return 65535
+}
+
+// readRows reads rows with values converted by column types, so that they can be written into
+// databases of another dialect.
+func (t *Transfer) readRows(ctx context.Context, selector *sql.Selector, columns []*schema.Column) ([][]any, error) {
+	query, args := selector.Query()
+	rows, err := t.src.DB().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rows: %w", err)
+	}
+	defer rows.Close()
+
+	var res [][]any
+	for rows.Next() {
+		holders := lo.Map(columns, func(c *schema.Column, index int) any {
+			return newHolder(c)
+		})
+		if err := rows.Scan(holders...); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		res = append(res, lo.Map(holders, func(h any, index int) any {
+			return holderValue(h)
+		}))
+	}
+
+	return res, rows.Err()
+}
+
+func newHolder(c *schema.Column) any {
+	switch c.Type {
+	case field.TypeBool:
+		return &rawsql.NullBool{}
+	case field.TypeTime:
+		return &rawsql.NullTime{}
+	case field.TypeInt, field.TypeInt8, field.TypeInt16, field.TypeInt32, field.TypeInt64,
+		field.TypeUint, field.TypeUint8, field.TypeUint16, field.TypeUint32, field.TypeUint64:
+		return &rawsql.NullInt64{}
+	case field.TypeFloat32, field.TypeFloat64:
+		return &rawsql.NullFloat64{}
+	case field.TypeBytes:
+		var raw any
+		return &raw
+	default:
+		// Strings, enums, UUIDs and JSON are transferred as text.
+		return &rawsql.NullString{}
+	}
+}
+
+func holderValue(h any) any {
+	switch v := h.(type) {
+	case *rawsql.NullBool:
+		return lo.Ternary[any](v.Valid, v.Bool, nil)
+	case *rawsql.NullTime:
+		return lo.Ternary[any](v.Valid, v.Time, nil)
+	case *rawsql.NullInt64:
+		return lo.Ternary[any](v.Valid, v.Int64, nil)
+	case *rawsql.NullFloat64:
+		return lo.Ternary[any](v.Valid, v.Float64, nil)
+	case *rawsql.NullString:
+		return lo.Ternary[any](v.Valid, v.String, nil)
+	case *any:
+		switch raw := (*v).(type) {
+		case string:
+			return []byte(raw)
+		case []byte:
+			return append([]byte{}, raw...)
+		default:
+			return raw
+		}
+	}
+
+	return nil
+}
+
+// resetSequences moves sequences of Postgres identity columns past copied IDs.
+func (t *Transfer) resetSequences(ctx context.Context) error {
+	for _, table := range migrate.Tables {
+		if len(table.PrimaryKey) != 1 || !table.PrimaryKey[0].Increment {
+			continue
+		}
+
+		pk := table.PrimaryKey[0].Name
+		query := fmt.Sprintf(`SELECT setval(pg_get_serial_sequence('"%s"', '%s'), MAX("%s")) FROM "%s" HAVING MAX("%s") IS NOT NULL`,
+			table.Name, pk, pk, table.Name, pk)
+		if _, err := t.dst.DB().ExecContext(ctx, query); err != nil {
+			return fmt.Errorf("failed to reset sequence of table %q: %w", table.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (t *Transfer) verify(ctx context.Context) ([]TableCount, error) {
+	counts := make([]TableCount, 0, len(migrate.Tables))
+	mismatched := 0
+	for _, table := range migrate.Tables {
+		src, err := countRows(ctx, t.src, table.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		dst, err := countRows(ctx, t.dst, table.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if src != dst {
+			mismatched++
+		}
+		counts = append(counts, TableCount{Table: table.Name, Source: src, Target: dst})
+	}
+
+	if mismatched > 0 {
+		return counts, fmt.Errorf("row counts of %d tables do not match", mismatched)
+	}
+
+	return counts, nil
+}
+
+func countRows(ctx context.Context, drv *sql.Driver, table string) (int64, error) {
+	query, args := sql.Dialect(drv.Dialect()).Select(sql.Count("*")).From(sql.Table(table)).Query()
+	var count int64
+	if err := drv.DB().QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count rows of table %q: %w", table, err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,125 @@
+package dbtransfer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/migrate"
+	_ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+	_ "github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func openTestDB(t *testing.T, name string) *sql.Driver {
+	drv, err := sql.Open("sqlite3", "file:"+t.Name()+name+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { drv.Close() })
+	return drv
+}
+
+func TestPlan(t *testing.T) {
+	a := assert.New(t)
+	plans, err := plan(migrate.Tables)
+	a.NoError(err)
+	a.Len(plans, len(migrate.Tables))
+
+	placed := make(map[string]int, len(plans))
+	for i, p := range plans {
+		placed[p.table.Name] = i
+	}
+
+	// Foreign keys either reference tables placed before, or are deferred
+	for i, p := range plans {
+		for _, fk := range p.table.ForeignKeys {
+			column := fk.Columns[0].Name
+			if fk.RefTable == p.table {
+				a.True(p.deferred[column] && p.selfRef[column], "%s.%s", p.table.Name, column)
+			} else if placed[fk.RefTable.Name] > i {
+				a.True(p.deferred[column] && !p.selfRef[column], "%s.%s", p.table.Name, column)
+			} else {
+				a.False(p.deferred[column], "%s.%s", p.table.Name, column)
+			}
+		}
+	}
+
+	a.Less(placed["groups"], placed["users"])
+	a.Less(placed["users"], placed["files"])
+}
+
+func TestMaxBatchParams(t *testing.T) {
+	a := assert.New(t)
+	a.Equal(999, maxBatchParams(dialect.SQLite))
+	a.Equal(65535, maxBatchParams(dialect.Postgres))
+
+	// Widest table still fits in one SQLite statement
+	for _, table := range migrate.Tables {
+		a.Positive(maxBatchParams(dialect.SQLite)/len(table.Columns), table.Name)
+	}
+}
+
+func TestTransfer_Run(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+	src, dst := openTestDB(t, "src"), openTestDB(t, "dst")
+	client := ent.NewClient(ent.Driver(src))
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	policy := client.StoragePolicy.Create().SetName("local").SetType(types.PolicyTypeLocal).SaveX(ctx)
+	group := client.Group.Create().SetName("users").SetPermissions(&boolset.BooleanSet{}).SetStoragePoliciesID(policy.ID).SaveX(ctx)
+	u := client.User.Create().SetEmail("a@cloudreve.org").SetNick("a").SetGroupUsers(group.ID).SaveX(ctx)
+	root := client.File.Create().SetType(int(types.FileTypeFolder)).SetName("").SetOwnerID(u.ID).SaveX(ctx)
+
+	// Entity references user placed after it, files reference folders created after them
+	entity := client.Entity.Create().SetType(int(types.EntityTypeVersion)).SetSource("a").SetSize(1).
+		SetStoragePolicyEntities(policy.ID).SetUser(u).SaveX(ctx)
+	var files []*ent.File
+	for i := 0; i < 5; i++ {
+		files = append(files, client.File.Create().SetType(int(types.FileTypeFile)).SetName(fmt.Sprintf("%d.txt", i)).
+			SetOwnerID(u.ID).SetParent(root).AddEntities(entity).SaveX(ctx))
+	}
+	folder := client.File.Create().SetType(int(types.FileTypeFolder)).SetName("folder").SetOwnerID(u.ID).SetParent(root).SaveX(ctx)
+	client.File.Update().Where(file.IDIn(files[0].ID, files[3].ID)).SetParent(folder).ExecX(ctx)
+
+	counts, err := New(logging.NewConsoleLogger(logging.LevelError), src, dst, 2).Run(ctx)
+	a.NoError(err)
+	a.Len(counts, len(migrate.Tables))
+	for _, c := range counts {
+		a.Equal(c.Source, c.Target, c.Table)
+	}
+
+	copied := ent.NewClient(ent.Driver(dst))
+	a.Equal(7, copied.File.Query().CountX(ctx))
+	a.Equal(folder.ID, copied.File.Query().Where(file.ID(files[0].ID)).QueryParent().OnlyIDX(ctx))
+	a.Equal(folder.ID, copied.File.Query().Where(file.ID(files[3].ID)).QueryParent().OnlyIDX(ctx))
+	a.Equal(root.ID, copied.File.Query().Where(file.ID(files[1].ID)).QueryParent().OnlyIDX(ctx))
+	a.Equal(u.ID, copied.Entity.GetX(ctx, entity.ID).CreatedBy)
+	a.Equal(5, copied.Entity.GetX(ctx, entity.ID).QueryFile().CountX(ctx))
+	a.Equal("a@cloudreve.org", copied.User.GetX(ctx, u.ID).Email)
+
+	// Target database that is not empty is rejected
+	_, err = New(logging.NewConsoleLogger(logging.LevelError), src, dst, 2).Run(ctx)
+	a.Error(err)
+
+	// Row count mismatch is reported
+	copied.Setting.Create().SetName("extra").SetValue("1").ExecX(ctx)
+	counts, err = (&Transfer{src: src, dst: dst}).verify(ctx)
+	a.Error(err)
+	for _, c := range counts {
+		if c.Table == "settings" {
+			a.Equal(c.Source+1, c.Target)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package dependency
 import (
 	"context"
 	"errors"
+	"fmt"
 	iofs "io/fs"
 	"net/url"
 	"sync"
@@ -10,23 +11,32 @@ import (
 
 	"github.com/cloudreve/Cloudreve/v4/application/statics"
 	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
 	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/credmanager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/edgecache"
 	"github.com/cloudreve/Cloudreve/v4/pkg/email"
+	"github.com/cloudreve/Cloudreve/v4/pkg/eventbus"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/mime"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/lock"
+	"github.com/cloudreve/Cloudreve/v4/pkg/grouppolicy"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/mediameta"
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/search"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/stats"
 	"github.com/cloudreve/Cloudreve/v4/pkg/thumb"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webhook"
 	"github.com/gin-contrib/static"
 	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/robfig/cron/v3"
@@ -77,14 +87,46 @@ type Dep interface {
 	GeneralAuth() auth.Auth
 	// Shutdown the dependencies gracefully.
 	Shutdown(ctx context.Context) error
+	// ReloadConfig re-reads the config file and applies hot reloadable changes, cached settings
+	// are also cleared so that they are read from DB again.
+	ReloadConfig(ctx context.Context) (*conf.ReloadResult, error)
+	// Drain stops task queues from picking up new tasks and waits for running tasks to finish until
+	// ctx is done.
+	Drain(ctx context.Context)
 	// FileClient Creates a new inventory.FileClient instance for access DB file store.
 	FileClient() inventory.FileClient
 	// NodeClient Creates a new inventory.NodeClient instance for access DB node store.
 	NodeClient() inventory.NodeClient
 	// DavAccountClient Creates a new inventory.DavAccountClient instance for access DB dav account store.
 	DavAccountClient() inventory.DavAccountClient
+	// AuditLogClient Creates a new inventory.AuditLogClient instance for access DB audit log store.
+	AuditLogClient() inventory.AuditLogClient
 	// DirectLinkClient Creates a new inventory.DirectLinkClient instance for access DB direct link store.
 	DirectLinkClient() inventory.DirectLinkClient
+	// CheckoutClient Creates a new inventory.CheckoutClient instance for access DB check-out store.
+	CheckoutClient() inventory.CheckoutClient
+	// NotificationClient Creates a new inventory.NotificationClient instance for access DB notification store.
+	NotificationClient() inventory.NotificationClient
+	// SavedSearchClient Creates a new inventory.SavedSearchClient instance for access DB saved search store.
+	SavedSearchClient() inventory.SavedSearchClient
+	// RssSubscriptionClient Creates a new inventory.RssSubscriptionClient instance for access DB RSS subscription store.
+	RssSubscriptionClient() inventory.RssSubscriptionClient
+	// AutomationRuleClient Creates a new inventory.AutomationRuleClient instance for access DB automation rule store.
+	AutomationRuleClient() inventory.AutomationRuleClient
+	// SyncJobClient Creates a new inventory.SyncJobClient instance for access DB folder sync job store.
+	SyncJobClient() inventory.SyncJobClient
+	// RetentionRuleClient Creates a new inventory.RetentionRuleClient instance for access DB folder retention rule store.
+	RetentionRuleClient() inventory.RetentionRuleClient
+	// ModerationCaseClient Creates a new inventory.ModerationCaseClient instance for access DB content moderation case store.
+	ModerationCaseClient() inventory.ModerationCaseClient
+	// AnnouncementClient Creates a new inventory.AnnouncementClient instance for access DB announcement store.
+	AnnouncementClient() inventory.AnnouncementClient
+	// WebhookClient Creates a new inventory.WebhookClient instance for access DB webhook store.
+	WebhookClient() inventory.WebhookClient
+	// OrganizationClient Creates a new inventory.OrganizationClient instance for access DB organization store.
+	OrganizationClient() inventory.OrganizationClient
+	// S3AccessKeyClient Creates a new inventory.S3AccessKeyClient instance for access DB S3 gateway access key store.
+	S3AccessKeyClient() inventory.S3AccessKeyClient
 	// HashIDEncoder Get a singleton hashid.Encoder instance for encoding/decoding hashids.
 	HashIDEncoder() hashid.Encoder
 	// TokenAuth Get a singleton auth.TokenAuth instance for token authentication.
@@ -111,6 +153,11 @@ type Dep interface {
 	ThumbPipeline() thumb.Generator
 	// ThumbQueue Get a singleton queue.Queue instance for thumbnail generation.
 	ThumbQueue(ctx context.Context) queue.Queue
+	// ThumbPregenQueue Get a singleton queue.Queue instance for eager thumbnail generation, scheduled by priority.
+	ThumbPregenQueue(ctx context.Context) queue.Queue
+	// EdgeCache Get a singleton edgecache.Cache instance for caching hot entities on slave node. Returns nil
+	// if edge caching is disabled.
+	EdgeCache() edgecache.Cache
 	// EntityRecycleQueue Get a singleton queue.Queue instance for entity recycle.
 	EntityRecycleQueue(ctx context.Context) queue.Queue
 	// MimeDetector Get a singleton fs.MimeDetector instance for MIME type detection.
@@ -129,50 +176,90 @@ type Dep interface {
 	WebAuthn(ctx context.Context) (*webauthn.WebAuthn, error)
 	// UAParser Get a singleton uaparser.Parser instance for user agent parsing.
 	UAParser() *uaparser.Parser
+	// AuditRecorder Get a singleton audit.Recorder instance for recording audit events.
+	AuditRecorder() audit.Recorder
+	// StatsRecorder Get a singleton stats.Recorder instance for collecting usage statistics.
+	StatsRecorder() stats.Recorder
+	// WebhookDispatcher Get a singleton webhook.Dispatcher instance for sending events to webhooks.
+	WebhookDispatcher() webhook.Dispatcher
+	// EventBus Get a singleton eventbus.Bus instance for publishing events to external message brokers. A no-op
+	// bus is returned if event bus is disabled.
+	EventBus() eventbus.Bus
+	// EventHub Get a singleton eventbus.Hub instance for delivering events of users to real-time subscribers.
+	EventHub() eventbus.Hub
+	// GroupPolicyChecker Get a singleton grouppolicy.Checker instance for evaluating group policies.
+	GroupPolicyChecker() grouppolicy.Checker
+	// SearchIndexer Get a singleton search.Indexer instance for full-text search. A no-op indexer is returned
+	// if full-text search is disabled.
+	SearchIndexer(ctx context.Context) search.Indexer
 }
 
 type dependency struct {
-	configProvider      conf.ConfigProvider
-	logger              logging.Logger
-	statics             iofs.FS
-	serverStaticFS      static.ServeFileSystem
-	dbClient            *ent.Client
-	rawEntClient        *ent.Client
-	kv                  cache.Driver
-	navigatorStateKv    cache.Driver
-	settingClient       inventory.SettingClient
-	fileClient          inventory.FileClient
-	shareClient         inventory.ShareClient
-	settingProvider     setting.Provider
-	userClient          inventory.UserClient
-	groupClient         inventory.GroupClient
-	storagePolicyClient inventory.StoragePolicyClient
-	taskClient          inventory.TaskClient
-	nodeClient          inventory.NodeClient
-	davAccountClient    inventory.DavAccountClient
-	directLinkClient    inventory.DirectLinkClient
-	emailClient         email.Driver
-	generalAuth         auth.Auth
-	hashidEncoder       hashid.Encoder
-	tokenAuth           auth.TokenAuth
-	lockSystem          lock.LockSystem
-	requestClient       request.Client
-	ioIntenseQueue      queue.Queue
-	thumbQueue          queue.Queue
-	mediaMetaQueue      queue.Queue
-	entityRecycleQueue  queue.Queue
-	slaveQueue          queue.Queue
-	remoteDownloadQueue queue.Queue
-	ioIntenseQueueTask  queue.Task
-	mediaMeta           mediameta.Extractor
-	thumbPipeline       thumb.Generator
-	mimeDetector        mime.MimeDetector
-	credManager         credmanager.CredManager
-	nodePool            cluster.NodePool
-	taskRegistry        queue.TaskRegistry
-	webauthn            *webauthn.WebAuthn
-	parser              *uaparser.Parser
-	cron                *cron.Cron
+	configProvider        conf.ConfigProvider
+	logger                logging.Logger
+	statics               iofs.FS
+	serverStaticFS        static.ServeFileSystem
+	dbClient              *ent.Client
+	rawEntClient          *ent.Client
+	kv                    cache.Driver
+	navigatorStateKv      cache.Driver
+	settingClient         inventory.SettingClient
+	fileClient            inventory.FileClient
+	shareClient           inventory.ShareClient
+	settingProvider       setting.Provider
+	userClient            inventory.UserClient
+	groupClient           inventory.GroupClient
+	storagePolicyClient   inventory.StoragePolicyClient
+	taskClient            inventory.TaskClient
+	nodeClient            inventory.NodeClient
+	davAccountClient      inventory.DavAccountClient
+	auditLogClient        inventory.AuditLogClient
+	directLinkClient      inventory.DirectLinkClient
+	checkoutClient        inventory.CheckoutClient
+	notificationClient    inventory.NotificationClient
+	savedSearchClient     inventory.SavedSearchClient
+	rssSubscriptionClient inventory.RssSubscriptionClient
+	automationRuleClient  inventory.AutomationRuleClient
+	syncJobClient         inventory.SyncJobClient
+	retentionRuleClient   inventory.RetentionRuleClient
+	moderationCaseClient  inventory.ModerationCaseClient
+	announcementClient    inventory.AnnouncementClient
+	webhookClient         inventory.WebhookClient
+	organizationClient    inventory.OrganizationClient
+	s3AccessKeyClient     inventory.S3AccessKeyClient
+	emailClient           email.Driver
+	generalAuth           *auth.SwappableAuth
+	hashidEncoder         hashid.Encoder
+	tokenAuth             auth.TokenAuth
+	lockSystem            lock.LockSystem
+	requestClient         request.Client
+	ioIntenseQueue        queue.Queue
+	thumbQueue            queue.Queue
+	thumbPregenQueue      queue.Queue
+	mediaMetaQueue        queue.Queue
+	entityRecycleQueue    queue.Queue
+	slaveQueue            queue.Queue
+	remoteDownloadQueue   queue.Queue
+	ioIntenseQueueTask    queue.Task
+	mediaMeta             mediameta.Extractor
+	thumbPipeline         thumb.Generator
+	edgeCache             edgecache.Cache
+	edgeCacheLoaded       bool
+	mimeDetector          mime.MimeDetector
+	credManager           credmanager.CredManager
+	nodePool              cluster.NodePool
+	taskRegistry          queue.TaskRegistry
+	webauthn              *webauthn.WebAuthn
+	parser                *uaparser.Parser
+	auditRecorder         audit.Recorder
+	statsRecorder         stats.Recorder
+	webhookDispatcher     webhook.Dispatcher
+	eventBus              eventbus.Bus
+	eventHub              eventbus.Hub
+	groupPolicyChecker    grouppolicy.Checker
+	searchIndexer         search.Indexer
+	searchIndexerKey      string
+	cron                  *cron.Cron
 
 	configPath        string
 	isPro             bool
@@ -263,8 +350,36 @@ func (d *dependency) Logger() logging.Logger {
 		logLevel = logging.LevelDebug
 	}
 
-	d.logger = logging.NewConsoleLogger(logLevel)
-	d.logger.Info("Logger initialized with LogLevel=%q.", logLevel)
+	logConf := config.Log()
+	opts := &logging.Options{
+		Level:  logLevel,
+		Format: logging.Format(logConf.Format),
+	}
+
+	var initErrs []error
+	if logConf.Levels != "" {
+		levels, err := logging.ParseComponentLevels(logConf.Levels)
+		if err != nil {
+			initErrs = append(initErrs, fmt.Errorf("failed to parse component log levels: %w", err))
+		}
+		opts.ComponentLevels = levels
+	}
+
+	if logConf.File != "" {
+		f, err := logging.NewRotatingFile(util.RelativePath(logConf.File), logConf.MaxSize, logConf.Daily,
+			logConf.MaxBackups, logConf.MaxAge)
+		if err != nil {
+			initErrs = append(initErrs, fmt.Errorf("failed to open log file, falling back to stdout: %w", err))
+		} else {
+			opts.Output = f
+		}
+	}
+
+	d.logger = logging.NewLogger(opts)
+	for _, err := range initErrs {
+		d.logger.Warning("%s", err)
+	}
+	d.logger.Info("Logger initialized with LogLevel=%q, Format=%q.", logLevel, opts.Format)
 	return d.logger
 }
 
@@ -422,7 +537,7 @@ func (d *dependency) NodePool(ctx context.Context) (cluster.NodePool, error) {
 	}
 
 	if d.ConfigProvider().System().Mode == conf.MasterMode {
-		np, err := cluster.NewNodePool(ctx, d.Logger(), d.ConfigProvider(), d.SettingProvider(), d.NodeClient())
+		np, err := cluster.NewNodePool(ctx, d.Logger(), d.ConfigProvider(), d.SettingProvider(), d.NodeClient(), d.TaskClient())
 		if err != nil {
 			return nil, err
 		}
@@ -509,6 +624,33 @@ func (d *dependency) ThumbQueue(ctx context.Context) queue.Queue {
 	return d.thumbQueue
 }
 
+func (d *dependency) ThumbPregenQueue(ctx context.Context) queue.Queue {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	_, reload := ctx.Value(ReloadCtx{}).(bool)
+	if d.thumbPregenQueue != nil && !reload {
+		return d.thumbPregenQueue
+	}
+
+	if d.thumbPregenQueue != nil {
+		d.thumbPregenQueue.Shutdown()
+	}
+
+	queueSetting := d.SettingProvider().Queue(context.Background(), setting.QueueTypeThumbPregen)
+	d.thumbPregenQueue = queue.New(d.Logger(), nil, nil, d,
+		queue.WithBackoffFactor(queueSetting.BackoffFactor),
+		queue.WithMaxRetry(queueSetting.MaxRetry),
+		queue.WithBackoffMaxDuration(queueSetting.BackoffMaxDuration),
+		queue.WithRetryDelay(queueSetting.RetryDelay),
+		queue.WithWorkerCount(queueSetting.WorkerNum),
+		queue.WithName("ThumbPregenQueue"),
+		queue.WithMaxTaskExecution(queueSetting.MaxExecution),
+		queue.WithPriorityLanes(queue.ThumbPregenPriorityLanes),
+	)
+	return d.thumbPregenQueue
+}
+
 func (d *dependency) MediaMetaQueue(ctx context.Context) queue.Queue {
 	d.mu.Lock()
 	defer d.mu.Unlock()
@@ -532,8 +674,11 @@ func (d *dependency) MediaMetaQueue(ctx context.Context) queue.Queue {
 		queue.WithRetryDelay(queueSetting.RetryDelay),
 		queue.WithWorkerCount(queueSetting.WorkerNum),
 		queue.WithName("MediaMetadataQueue"),
+		queue.WithCancelSignal(d.KV()),
+		queue.WithTaskLease(d.KV()),
+		queue.WithVisibilityTimeout(queueSetting.VisibilityTimeout),
 		queue.WithMaxTaskExecution(queueSetting.MaxExecution),
-		queue.WithResumeTaskType(queue.MediaMetaTaskType),
+		queue.WithResumeTaskType(queue.MediaMetaTaskType, queue.OcrTaskType, queue.ShareModerationTaskType),
 	)
 	return d.mediaMetaQueue
 }
@@ -561,8 +706,12 @@ func (d *dependency) IoIntenseQueue(ctx context.Context) queue.Queue {
 		queue.WithRetryDelay(queueSetting.RetryDelay),
 		queue.WithWorkerCount(queueSetting.WorkerNum),
 		queue.WithName("IoIntenseQueue"),
+		queue.WithCancelSignal(d.KV()),
+		queue.WithTaskLease(d.KV()),
+		queue.WithVisibilityTimeout(queueSetting.VisibilityTimeout),
+		queue.WithOnTaskFinished(d.notifyTaskFinished),
 		queue.WithMaxTaskExecution(queueSetting.MaxExecution),
-		queue.WithResumeTaskType(queue.CreateArchiveTaskType, queue.ExtractArchiveTaskType, queue.RelocateTaskType, queue.ImportTaskType),
+		queue.WithResumeTaskType(queue.CreateArchiveTaskType, queue.ExtractArchiveTaskType, queue.RelocateTaskType, queue.ImportTaskType, queue.OffboardExportTaskType, queue.FolderSyncTaskType, queue.UrlImportTaskType, queue.TakeoutTaskType),
 		queue.WithTaskPullInterval(10*time.Second),
 	)
 	return d.ioIntenseQueue
@@ -591,6 +740,10 @@ func (d *dependency) RemoteDownloadQueue(ctx context.Context) queue.Queue {
 		queue.WithRetryDelay(queueSetting.RetryDelay),
 		queue.WithWorkerCount(queueSetting.WorkerNum),
 		queue.WithName("RemoteDownloadQueue"),
+		queue.WithCancelSignal(d.KV()),
+		queue.WithTaskLease(d.KV()),
+		queue.WithVisibilityTimeout(queueSetting.VisibilityTimeout),
+		queue.WithOnTaskFinished(d.notifyTaskFinished),
 		queue.WithMaxTaskExecution(queueSetting.MaxExecution),
 		queue.WithResumeTaskType(queue.RemoteDownloadTaskType),
 		queue.WithTaskPullInterval(10*time.Second),
@@ -621,6 +774,9 @@ func (d *dependency) EntityRecycleQueue(ctx context.Context) queue.Queue {
 		queue.WithRetryDelay(queueSetting.RetryDelay),
 		queue.WithWorkerCount(queueSetting.WorkerNum),
 		queue.WithName("EntityRecycleQueue"),
+		queue.WithCancelSignal(d.KV()),
+		queue.WithTaskLease(d.KV()),
+		queue.WithVisibilityTimeout(queueSetting.VisibilityTimeout),
 		queue.WithMaxTaskExecution(queueSetting.MaxExecution),
 		queue.WithResumeTaskType(queue.EntityRecycleRoutineTaskType, queue.ExplicitEntityRecycleTaskType, queue.UploadSentinelCheckTaskType),
 		queue.WithTaskPullInterval(10*time.Second),
@@ -661,6 +817,11 @@ func (d *dependency) GeneralAuth() auth.Auth {
 		return d.generalAuth
 	}
 
+	d.generalAuth = auth.NewSwappableAuth(d.newGeneralAuth())
+	return d.generalAuth
+}
+
+func (d *dependency) newGeneralAuth() auth.Auth {
 	var secretKey string
 	if d.ConfigProvider().System().Mode == conf.MasterMode {
 		secretKey = d.SettingProvider().SecretKey(context.Background())
@@ -671,11 +832,47 @@ func (d *dependency) GeneralAuth() auth.Auth {
 		}
 	}
 
-	d.generalAuth = auth.HMACAuth{
+	return auth.HMACAuth{
 		SecretKey: []byte(secretKey),
 	}
+}
 
-	return d.generalAuth
+func (d *dependency) ReloadConfig(ctx context.Context) (*conf.ReloadResult, error) {
+	config := d.ConfigProvider()
+	res, err := config.Reload()
+	if err != nil {
+		return nil, err
+	}
+
+	l := d.Logger()
+	if setter, ok := l.(logging.LevelSetter); ok {
+		logLevel := logging.LogLevel(config.System().LogLevel)
+		if config.System().Debug {
+			logLevel = logging.LevelDebug
+		}
+
+		levels, err := logging.ParseComponentLevels(config.Log().Levels)
+		if err != nil {
+			l.Warning("Failed to parse component log levels: %s", err)
+		}
+		setter.SetLevels(logLevel, levels)
+	}
+
+	// Settings like rate limits and SMTP are read from DB again.
+	if err := d.KV().Delete(setting.KvSettingPrefix); err != nil {
+		l.Warning("Failed to clear cached settings: %s", err)
+	}
+
+	if config.System().Mode == conf.MasterMode {
+		d.EmailClient(context.WithValue(ctx, ReloadCtx{}, true))
+	}
+
+	if d.generalAuth != nil {
+		d.generalAuth.Swap(d.newGeneralAuth())
+	}
+
+	l.Info("Config reloaded, applied changes: %v, changes requiring restart: %v.", res.Applied, res.RestartRequired)
+	return res, nil
 }
 
 func (d *dependency) FileClient() inventory.FileClient {
@@ -710,6 +907,14 @@ func (d *dependency) DavAccountClient() inventory.DavAccountClient {
 	return inventory.NewDavAccountClient(d.DBClient(), d.ConfigProvider().Database().Type, d.HashIDEncoder())
 }
 
+func (d *dependency) AuditLogClient() inventory.AuditLogClient {
+	if d.auditLogClient != nil {
+		return d.auditLogClient
+	}
+
+	return inventory.NewAuditLogClient(d.DBClient(), d.ConfigProvider().Database().Type)
+}
+
 func (d *dependency) DirectLinkClient() inventory.DirectLinkClient {
 	if d.directLinkClient != nil {
 		return d.directLinkClient
@@ -718,6 +923,105 @@ func (d *dependency) DirectLinkClient() inventory.DirectLinkClient {
 	return inventory.NewDirectLinkClient(d.DBClient(), d.ConfigProvider().Database().Type, d.HashIDEncoder())
 }
 
+func (d *dependency) CheckoutClient() inventory.CheckoutClient {
+	if d.checkoutClient != nil {
+		return d.checkoutClient
+	}
+
+	return inventory.NewCheckoutClient(d.DBClient())
+}
+
+func (d *dependency) NotificationClient() inventory.NotificationClient {
+	if d.notificationClient != nil {
+		return d.notificationClient
+	}
+
+	return &realtimeNotificationClient{
+		NotificationClient: inventory.NewNotificationClient(d.DBClient(), d.ConfigProvider().Database().Type),
+		hub:                d.EventHub(),
+	}
+}
+
+func (d *dependency) SavedSearchClient() inventory.SavedSearchClient {
+	if d.savedSearchClient != nil {
+		return d.savedSearchClient
+	}
+
+	return inventory.NewSavedSearchClient(d.DBClient(), d.ConfigProvider().Database().Type)
+}
+
+func (d *dependency) RssSubscriptionClient() inventory.RssSubscriptionClient {
+	if d.rssSubscriptionClient != nil {
+		return d.rssSubscriptionClient
+	}
+
+	return inventory.NewRssSubscriptionClient(d.DBClient(), d.ConfigProvider().Database().Type)
+}
+
+func (d *dependency) AutomationRuleClient() inventory.AutomationRuleClient {
+	if d.automationRuleClient != nil {
+		return d.automationRuleClient
+	}
+
+	return inventory.NewAutomationRuleClient(d.DBClient())
+}
+
+func (d *dependency) SyncJobClient() inventory.SyncJobClient {
+	if d.syncJobClient != nil {
+		return d.syncJobClient
+	}
+
+	return inventory.NewSyncJobClient(d.DBClient(), d.ConfigProvider().Database().Type)
+}
+
+func (d *dependency) RetentionRuleClient() inventory.RetentionRuleClient {
+	if d.retentionRuleClient != nil {
+		return d.retentionRuleClient
+	}
+
+	return inventory.NewRetentionRuleClient(d.DBClient(), d.ConfigProvider().Database().Type)
+}
+
+func (d *dependency) ModerationCaseClient() inventory.ModerationCaseClient {
+	if d.moderationCaseClient != nil {
+		return d.moderationCaseClient
+	}
+
+	return inventory.NewModerationCaseClient(d.DBClient(), d.ConfigProvider().Database().Type)
+}
+
+func (d *dependency) AnnouncementClient() inventory.AnnouncementClient {
+	if d.announcementClient != nil {
+		return d.announcementClient
+	}
+
+	return inventory.NewAnnouncementClient(d.DBClient())
+}
+
+func (d *dependency) WebhookClient() inventory.WebhookClient {
+	if d.webhookClient != nil {
+		return d.webhookClient
+	}
+
+	return inventory.NewWebhookClient(d.DBClient())
+}
+
+func (d *dependency) OrganizationClient() inventory.OrganizationClient {
+	if d.organizationClient != nil {
+		return d.organizationClient
+	}
+
+	return inventory.NewOrganizationClient(d.DBClient())
+}
+
+func (d *dependency) S3AccessKeyClient() inventory.S3AccessKeyClient {
+	if d.s3AccessKeyClient != nil {
+		return d.s3AccessKeyClient
+	}
+
+	return inventory.NewS3AccessKeyClient(d.DBClient())
+}
+
 func (d *dependency) HashIDEncoder() hashid.Encoder {
 	if d.hashidEncoder != nil {
 		return d.hashidEncoder
@@ -760,7 +1064,7 @@ func (d *dependency) LockSystem() lock.LockSystem {
 		return d.lockSystem
 	}
 
-	d.lockSystem = lock.NewMemLS(d.HashIDEncoder(), d.Logger())
+	d.lockSystem = lock.NewKVLS(d.KV(), d.HashIDEncoder(), d.Logger())
 	return d.lockSystem
 }
 
@@ -781,6 +1085,78 @@ func (d *dependency) ThumbPipeline() thumb.Generator {
 	return d.thumbPipeline
 }
 
+func (d *dependency) EdgeCache() edgecache.Cache {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.edgeCacheLoaded {
+		return d.edgeCache
+	}
+
+	d.edgeCacheLoaded = true
+	slaveConfig := d.ConfigProvider().Slave()
+	if d.ConfigProvider().System().Mode != conf.SlaveMode || slaveConfig.EdgeCacheSize <= 0 {
+		return nil
+	}
+
+	c, err := edgecache.New(util.DataPath(slaveConfig.EdgeCachePath), slaveConfig.EdgeCacheSize*1024*1024, d.Logger())
+	if err != nil {
+		d.Logger().Warning("Failed to initialize edge cache, edge caching is disabled: %s", err)
+		return nil
+	}
+
+	d.edgeCache = c
+	return d.edgeCache
+}
+
+func (d *dependency) SearchIndexer(ctx context.Context) search.Indexer {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	settings := d.SettingProvider().FullTextSearch(ctx)
+	if !settings.Enabled {
+		return search.NewNoopIndexer()
+	}
+
+	// Indexer is reopened once the provider or its location is changed.
+	key := fmt.Sprintf("%s|%s|%v", settings.Provider, settings.IndexPath, settings.Elasticsearch)
+	if d.searchIndexer != nil && d.searchIndexerKey == key {
+		return d.searchIndexer
+	}
+
+	var (
+		indexer search.Indexer
+		err     error
+	)
+	switch settings.Provider {
+	case setting.SearchProviderElasticsearch:
+		es := settings.Elasticsearch
+		indexer, err = search.NewElasticsearchIndexer(ctx, d.RequestClient(request.WithLogger(d.Logger())),
+			search.ElasticsearchConfig{
+				Endpoint: es.Endpoint,
+				Username: es.Username,
+				Password: es.Password,
+				Index:    es.Index,
+			}, d.Logger())
+	default:
+		indexer, err = search.NewBleveIndexer(util.DataPath(settings.IndexPath), d.Logger())
+	}
+
+	if err != nil {
+		d.Logger().Warning("Failed to open full text search index: %s", err)
+		return search.NewNoopIndexer()
+	}
+
+	if d.searchIndexer != nil {
+		if err := d.searchIndexer.Close(); err != nil {
+			d.Logger().Warning("Failed to close previous full text search index: %s", err)
+		}
+	}
+
+	d.searchIndexer, d.searchIndexerKey = indexer, key
+	return d.searchIndexer
+}
+
 func (d *dependency) TaskRegistry() queue.TaskRegistry {
 	if d.taskRegistry != nil {
 		return d.taskRegistry
@@ -790,6 +1166,95 @@ func (d *dependency) TaskRegistry() queue.TaskRegistry {
 	return d.taskRegistry
 }
 
+func (d *dependency) AuditRecorder() audit.Recorder {
+	if d.auditRecorder != nil {
+		return d.auditRecorder
+	}
+
+	d.auditRecorder = audit.NewDBRecorder(d.AuditLogClient())
+	return d.auditRecorder
+}
+
+func (d *dependency) StatsRecorder() stats.Recorder {
+	if d.statsRecorder != nil {
+		return d.statsRecorder
+	}
+
+	d.statsRecorder = stats.NewDBRecorder(inventory.NewDailyStatClient(d.DBClient()))
+	return d.statsRecorder
+}
+
+func (d *dependency) WebhookDispatcher() webhook.Dispatcher {
+	if d.webhookDispatcher != nil {
+		return d.webhookDispatcher
+	}
+
+	d.webhookDispatcher = webhook.NewDispatcher(d.WebhookClient(), d.RequestClient(), d.Logger())
+	return d.webhookDispatcher
+}
+
+func (d *dependency) EventBus() eventbus.Bus {
+	if d.eventBus != nil {
+		return d.eventBus
+	}
+
+	bus, err := eventbus.NewBus(d.ConfigProvider().EventBus(), d.Logger())
+	if err != nil {
+		d.Logger().Warning("Failed to initialize event bus, events will not be published: %s", err)
+		bus = eventbus.NewNoopBus()
+	}
+
+	d.eventBus = bus
+	return d.eventBus
+}
+
+func (d *dependency) EventHub() eventbus.Hub {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.eventHub == nil {
+		d.eventHub = eventbus.NewHub()
+	}
+
+	return d.eventHub
+}
+
+func (d *dependency) GroupPolicyChecker() grouppolicy.Checker {
+	if d.groupPolicyChecker != nil {
+		return d.groupPolicyChecker
+	}
+
+	d.groupPolicyChecker = grouppolicy.NewChecker(d.KV())
+	return d.groupPolicyChecker
+}
+
+func (d *dependency) Drain(ctx context.Context) {
+	d.mu.Lock()
+	queues := lo.Filter([]queue.Queue{
+		d.ioIntenseQueue,
+		d.thumbQueue,
+		d.thumbPregenQueue,
+		d.mediaMetaQueue,
+		d.entityRecycleQueue,
+		d.slaveQueue,
+		d.remoteDownloadQueue,
+	}, func(q queue.Queue, _ int) bool {
+		return q != nil
+	})
+	d.mu.Unlock()
+
+	wg := sync.WaitGroup{}
+	for _, q := range queues {
+		wg.Add(1)
+		go func(q queue.Queue) {
+			defer wg.Done()
+			q.Drain(ctx)
+		}(q)
+	}
+
+	wg.Wait()
+}
+
 func (d *dependency) Shutdown(ctx context.Context) error {
 	d.mu.Lock()
 
@@ -797,6 +1262,8 @@ func (d *dependency) Shutdown(ctx context.Context) error {
 		d.emailClient.Close()
 	}
 
+	// Recorders are closed after queues, so that events of interrupted tasks are still flushed.
+	auditRecorder, statsRecorder, searchIndexer, eventBus := d.auditRecorder, d.statsRecorder, d.searchIndexer, d.eventBus
 	wg := sync.WaitGroup{}
 
 	if d.mediaMetaQueue != nil {
@@ -815,6 +1282,14 @@ func (d *dependency) Shutdown(ctx context.Context) error {
 		}()
 	}
 
+	if d.thumbPregenQueue != nil {
+		wg.Add(1)
+		go func() {
+			d.thumbPregenQueue.Shutdown()
+			defer wg.Done()
+		}()
+	}
+
 	if d.ioIntenseQueue != nil {
 		wg.Add(1)
 		go func() {
@@ -850,6 +1325,28 @@ func (d *dependency) Shutdown(ctx context.Context) error {
 	d.mu.Unlock()
 	wg.Wait()
 
+	if auditRecorder != nil {
+		auditRecorder.Close()
+	}
+
+	if statsRecorder != nil {
+		if err := statsRecorder.Close(); err != nil {
+			d.Logger().Warning("Failed to flush usage statistics: %s", err)
+		}
+	}
+
+	if searchIndexer != nil {
+		if err := searchIndexer.Close(); err != nil {
+			d.Logger().Warning("Failed to close full text search index: %s", err)
+		}
+	}
+
+	if eventBus != nil {
+		if err := eventBus.Close(); err != nil {
+			d.Logger().Warning("Failed to close event bus: %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -877,3 +1374,52 @@ type dependencyCorrelated struct {
 func (d *dependencyCorrelated) Logger() logging.Logger {
 	return d.l
 }
+
+// notifyTaskFinished sends a notification to the owner of a completed or failed task.
+func (d *dependency) notifyTaskFinished(ctx context.Context, t queue.Task) {
+	owner := t.Owner()
+	if owner == nil || inventory.IsAnonymousUser(owner) {
+		return
+	}
+
+	n := &inventory.NotificationArgs{
+		Type: types.NotificationTypeTaskCompleted,
+		Props: map[string]string{
+			"task_id":   hashid.EncodeTaskID(d.HashIDEncoder(), t.ID()),
+			"task_type": t.Type(),
+		},
+	}
+	if t.Status() == task.StatusError {
+		n.Type = types.NotificationTypeTaskFailed
+	}
+
+	d.EventHub().Publish(ctx, owner.ID, eventbus.EventTaskFinished, &eventbus.TaskData{
+		ID:     n.Props["task_id"],
+		Type:   t.Type(),
+		Status: string(t.Status()),
+	})
+	if _, err := d.NotificationClient().Create(ctx, owner.ID, n); err != nil {
+		d.Logger().Warning("Failed to notify user %d of task %d: %s", owner.ID, t.ID(), err)
+	}
+}
+
+// realtimeNotificationClient delivers created notifications to real-time subscribers of the recipient.
+type realtimeNotificationClient struct {
+	inventory.NotificationClient
+	hub eventbus.Hub
+}
+
+func (c *realtimeNotificationClient) Create(ctx context.Context, uid int, n *inventory.NotificationArgs) (*ent.Notification, error) {
+	created, err := c.NotificationClient.Create(ctx, uid, n)
+	if err == nil {
+		c.hub.Publish(ctx, uid, eventbus.EventNotificationCreated, &eventbus.NotificationData{
+			ID:        created.ID,
+			Type:      created.Type,
+			Link:      created.Link,
+			Props:     created.Props,
+			CreatedAt: created.CreatedAt,
+		})
+	}
+
+	return created, err
+}
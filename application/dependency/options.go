@@ -132,7 +132,7 @@ func WithEmailClient(s email.Driver) Option {
 // WithGeneralAuth Set the default general auth
 func WithGeneralAuth(s auth.Auth) Option {
 	return optionFunc(func(o *dependency) {
-		o.generalAuth = s
+		o.generalAuth = auth.NewSwappableAuth(s)
 	})
 }
 
@@ -0,0 +1,292 @@
+// Package importer imports users, files and shares from other self-hosted storage services.
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const (
+	FailureUser  = "user"
+	FailureFile  = "file"
+	FailureShare = "share"
+
+	// batchSize is the number of source rows listed in one query.
+	batchSize = 1000
+)
+
+type (
+	Importer struct {
+		dep    dependency.Dep
+		l      logging.Logger
+		policy *ent.StoragePolicy
+		report *Report
+	}
+
+	// Report maps imported source objects to Cloudreve ones.
+	Report struct {
+		Source      string         `json:"source"`
+		GeneratedAt time.Time      `json:"generated_at"`
+		Users       []UserMapping  `json:"users"`
+		Files       []FileMapping  `json:"files"`
+		Shares      []ShareMapping `json:"shares"`
+		Failures    []Failure      `json:"failures"`
+	}
+
+	UserMapping struct {
+		Source string `json:"source"`
+		ID     string `json:"id"`
+		Email  string `json:"email"`
+		// Existed indicates that the source user is mapped to an existing user with the same email.
+		Existed bool `json:"existed,omitempty"`
+	}
+
+	FileMapping struct {
+		Source string `json:"source"`
+		Uri    string `json:"uri"`
+	}
+
+	ShareMapping struct {
+		Source   string `json:"source"`
+		Url      string `json:"url"`
+		Password string `json:"password,omitempty"`
+	}
+
+	Failure struct {
+		Type   string `json:"type"`
+		Source string `json:"source"`
+		Reason string `json:"reason"`
+	}
+
+	// userSession is the context to import files of one user.
+	userSession struct {
+		ctx  context.Context
+		user *ent.User
+		fm   manager.FileManager
+		root *fs.URI
+	}
+)
+
+// New creates an importer storing imported files with given storage policy.
+func New(ctx context.Context, dep dependency.Dep, source string, policyID int) (*Importer, error) {
+	policy, err := dep.StoragePolicyClient().GetPolicyByID(ctx, policyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage policy %d: %w", policyID, err)
+	}
+
+	return &Importer{
+		dep:    dep,
+		l:      dep.Logger(),
+		policy: policy,
+		report: &Report{
+			Source:      source,
+			GeneratedAt: time.Now(),
+			Users:       []UserMapping{},
+			Files:       []FileMapping{},
+			Shares:      []ShareMapping{},
+			Failures:    []Failure{},
+		},
+	}, nil
+}
+
+// Report returns the mapping report of imported objects.
+func (i *Importer) Report() *Report {
+	return i.report
+}
+
+// Save writes report as JSON into given path.
+func (r *Report) Save(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal report: %w", err)
+	}
+
+	return os.WriteFile(path, data, 0600)
+}
+
+func (i *Importer) fail(typ, source string, err error) {
+	i.l.Warning("Failed to import %s %q: %s", typ, source, err)
+	i.report.Failures = append(i.report.Failures, Failure{Type: typ, Source: source, Reason: err.Error()})
+}
+
+// referenceInPlace checks if blobs can be referenced in place by storage policy of importer.
+func (i *Importer) referenceInPlace() error {
+	if i.policy.Type != types.PolicyTypeLocal {
+		return fmt.Errorf("storage policy %q is not a local policy, blobs can only be referenced in place by local policy", i.policy.Name)
+	}
+
+	return nil
+}
+
+// importUser creates a user, or reuses the existing one with the same email. Imported users have no
+// password, they sign in by resetting password. If storeInPolicy is set, new users are bound to storage
+// policy of importer, so that uploaded content is stored in it.
+func (i *Importer) importUser(ctx context.Context, source, email, nick string, storeInPolicy bool) (*userSession, error) {
+	userClient := i.dep.UserClient()
+	u, err := userClient.Create(ctx, &inventory.NewUserArgs{
+		Email:   email,
+		Nick:    nick,
+		Status:  user.StatusActive,
+		GroupID: i.dep.SettingProvider().DefaultGroup(ctx),
+	})
+	existed := errors.Is(err, inventory.ErrUserEmailExisted)
+	if err != nil && !existed {
+		return nil, err
+	}
+
+	if storeInPolicy {
+		if existed {
+			i.l.Warning("User %q already exists, its files are stored in its own storage policy.", email)
+		} else if err := userClient.SetOverrides(ctx, u.ID, i.policy.ID, nil); err != nil {
+			return nil, fmt.Errorf("failed to set storage policy: %w", err)
+		}
+	}
+
+	// Reload user with group for file manager.
+	u, err = userClient.GetLoginUserByID(ctx, u.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load user: %w", err)
+	}
+
+	root, err := fs.NewUriFromString(fs.NewMyUri(""))
+	if err != nil {
+		return nil, err
+	}
+
+	i.report.Users = append(i.report.Users, UserMapping{
+		Source:  source,
+		ID:      hashid.EncodeUserID(i.dep.HashIDEncoder(), u.ID),
+		Email:   u.Email,
+		Existed: existed,
+	})
+	return &userSession{
+		ctx:  context.WithValue(ctx, inventory.UserCtx{}, u),
+		user: u,
+		fm:   manager.NewFileManager(i.dep, u),
+		root: root,
+	}, nil
+}
+
+func (s *userSession) recycle() {
+	s.fm.Recycle()
+}
+
+// importFolder creates folder of given relative path, existing folder is reused.
+func (i *Importer) importFolder(s *userSession, source, relativePath string) {
+	uri := s.root.JoinRaw(relativePath)
+	if _, err := s.fm.Create(s.ctx, uri, types.FileTypeFolder); err != nil && !isObjectExist(err) {
+		i.fail(FailureFile, source, err)
+		return
+	}
+
+	i.report.Files = append(i.report.Files, FileMapping{Source: source, Uri: uri.String()})
+}
+
+// importFile creates file of given relative path referencing blob in place, existing file is skipped.
+func (i *Importer) importFile(s *userSession, source, relativePath, blob string, size int64, modified time.Time) {
+	dir, name := path.Split(relativePath)
+	parent := s.root.JoinRaw(dir)
+	err := s.fm.ImportPhysical(s.ctx, parent, i.policy.ID, fs.PhysicalObject{
+		Name:         name,
+		Source:       blob,
+		RelativePath: name,
+		Size:         size,
+		LastModify:   modified,
+	}, false)
+	if err != nil && !isObjectExist(err) {
+		i.fail(FailureFile, source, err)
+		return
+	}
+
+	i.report.Files = append(i.report.Files, FileMapping{Source: source, Uri: parent.Join(name).String()})
+}
+
+// importContent creates file of given relative path with content read from r, existing file is skipped.
+func (i *Importer) importContent(s *userSession, source, relativePath string, size int64, modified time.Time, r io.ReadCloser) {
+	defer r.Close()
+	uri := s.root.JoinRaw(relativePath)
+	_, err := s.fm.Update(s.ctx, &fs.UploadRequest{
+		Props: &fs.UploadProps{
+			Uri:          uri,
+			Size:         size,
+			LastModified: &modified,
+		},
+		File: r,
+	}, fs.WithNoEntityType())
+	if err != nil && !isObjectExist(err) {
+		i.fail(FailureFile, source, err)
+		return
+	}
+
+	i.report.Files = append(i.report.Files, FileMapping{Source: source, Uri: uri.String()})
+}
+
+// importShare creates a share link of given relative path. Passwords of source shares are hashed, so
+// protected shares get a new random password.
+func (i *Importer) importShare(s *userSession, source, relativePath string, protected bool, expire *time.Time) {
+	share, err := s.fm.CreateOrUpdateShare(s.ctx, s.root.JoinRaw(relativePath), &manager.CreateShareArgs{
+		IsPrivate: protected,
+		Expire:    expire,
+	})
+	if err != nil {
+		i.fail(FailureShare, source, err)
+		return
+	}
+
+	shareID := hashid.EncodeShareID(i.dep.HashIDEncoder(), share.ID)
+	i.report.Shares = append(i.report.Shares, ShareMapping{
+		Source:   source,
+		Url:      routes.MasterShareUrl(i.dep.SettingProvider().SiteURL(s.ctx), shareID, share.Password).String(),
+		Password: share.Password,
+	})
+}
+
+func isObjectExist(err error) bool {
+	var appErr serializer.AppError
+	return errors.As(err, &appErr) && appErr.Code == serializer.CodeObjectExist
+}
+
+// openSourceDB connects to database of the source service.
+func openSourceDB(dbType, dsn string) (*gorm.DB, error) {
+	var dialector gorm.Dialector
+	switch dbType {
+	case "mysql":
+		dialector = mysql.Open(dsn)
+	case "postgres":
+		dialector = postgres.Open(dsn)
+	case "sqlite":
+		dialector = sqlite.Open(dsn)
+	default:
+		return nil, fmt.Errorf("unsupported database type %q", dbType)
+	}
+
+	db, err := gorm.Open(dialector, &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to source database: %w", err)
+	}
+
+	return db, nil
+}
@@ -0,0 +1,207 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+const (
+	// ncFilesPrefix is the path prefix of user files in Nextcloud file cache, versions and trash bin are
+	// stored under other prefixes and not imported.
+	ncFilesPrefix   = "files/"
+	ncHomePrefix    = "home::"
+	ncFolderMime    = "httpd/unix-directory"
+	ncShareTypeLink = 3
+)
+
+type (
+	// NextcloudOptions configures the Nextcloud instance to import from.
+	NextcloudOptions struct {
+		// DBType is one of mysql, postgres and sqlite.
+		DBType      string
+		DSN         string
+		TablePrefix string
+		// DataDir is the data directory of Nextcloud, user files are stored in <DataDir>/<uid>/files.
+		DataDir string
+		// EmailDomain is used to build email of users without one as <uid>@<EmailDomain>. Users without
+		// email are skipped if empty.
+		EmailDomain string
+	}
+
+	ncUser struct {
+		UID         string `gorm:"column:uid"`
+		DisplayName string `gorm:"column:displayname"`
+	}
+
+	ncPreference struct {
+		UserID      string `gorm:"column:userid"`
+		ConfigValue string `gorm:"column:configvalue"`
+	}
+
+	ncStorage struct {
+		NumericID int    `gorm:"column:numeric_id"`
+		ID        string `gorm:"column:id"`
+	}
+
+	ncFile struct {
+		FileID   int64  `gorm:"column:fileid"`
+		Path     string `gorm:"column:path"`
+		MimeType int    `gorm:"column:mimetype"`
+		Size     int64  `gorm:"column:size"`
+		MTime    int64  `gorm:"column:mtime"`
+	}
+
+	ncShare struct {
+		ID         int64      `gorm:"column:id"`
+		UIDOwner   string     `gorm:"column:uid_owner"`
+		FileSource int64      `gorm:"column:file_source"`
+		Token      string     `gorm:"column:token"`
+		Password   *string    `gorm:"column:password"`
+		Expiration *time.Time `gorm:"column:expiration"`
+	}
+)
+
+// ImportNextcloud imports users, files in their home storage and public link shares from Nextcloud.
+// Only files stored in local data directory can be imported, they are referenced in place by the local
+// storage policy of importer.
+func (i *Importer) ImportNextcloud(ctx context.Context, o *NextcloudOptions) error {
+	if err := i.referenceInPlace(); err != nil {
+		return err
+	}
+
+	db, err := openSourceDB(o.DBType, o.DSN)
+	if err != nil {
+		return err
+	}
+
+	table := func(name string) *gorm.DB {
+		return db.Table(o.TablePrefix + name)
+	}
+
+	var folderMimes []int
+	if err := table("mimetypes").Where("mimetype = ?", ncFolderMime).Pluck("id", &folderMimes).Error; err != nil {
+		return fmt.Errorf("failed to list Nextcloud mime types: %w", err)
+	}
+
+	var users []ncUser
+	if err := table("users").Find(&users).Error; err != nil {
+		return fmt.Errorf("failed to list Nextcloud users: %w", err)
+	}
+
+	var emailPrefs []ncPreference
+	if err := table("preferences").Where("appid = ? AND configkey = ?", "settings", "email").Find(&emailPrefs).Error; err != nil {
+		return fmt.Errorf("failed to list Nextcloud user emails: %w", err)
+	}
+	emails := make(map[string]string, len(emailPrefs))
+	for _, p := range emailPrefs {
+		emails[p.UserID] = p.ConfigValue
+	}
+
+	var storages []ncStorage
+	if err := table("storages").Where("id LIKE ?", ncHomePrefix+"%").Find(&storages).Error; err != nil {
+		return fmt.Errorf("failed to list Nextcloud storages: %w", err)
+	}
+	homeStorages := make(map[string]int, len(storages))
+	for _, s := range storages {
+		homeStorages[strings.TrimPrefix(s.ID, ncHomePrefix)] = s.NumericID
+	}
+
+	i.l.Info("Found %d Nextcloud users to be imported.", len(users))
+	sessions := make(map[string]*userSession)
+	// Relative path of imported files by Nextcloud file ID, used to import shares.
+	paths := make(map[int64]string)
+	defer func() {
+		for _, s := range sessions {
+			s.recycle()
+		}
+	}()
+
+	for _, u := range users {
+		email := emails[u.UID]
+		if email == "" && o.EmailDomain != "" {
+			email = u.UID + "@" + o.EmailDomain
+		}
+
+		if email == "" {
+			i.fail(FailureUser, u.UID, fmt.Errorf("user has no email"))
+			continue
+		}
+
+		s, err := i.importUser(ctx, u.UID, strings.ToLower(email), u.DisplayName, false)
+		if err != nil {
+			i.fail(FailureUser, u.UID, err)
+			continue
+		}
+		sessions[u.UID] = s
+
+		storage, ok := homeStorages[u.UID]
+		if !ok {
+			i.l.Info("Nextcloud user %q has no home storage, skipping files.", u.UID)
+			continue
+		}
+
+		i.l.Info("Importing files of Nextcloud user %q...", u.UID)
+		for offset := 0; ; offset += batchSize {
+			var files []ncFile
+			// Ordered by path so that parent folders are created first.
+			if err := table("filecache").
+				Where("storage = ? AND path LIKE ?", storage, ncFilesPrefix+"%").
+				Order("path").Limit(batchSize).Offset(offset).
+				Find(&files).Error; err != nil {
+				return fmt.Errorf("failed to list Nextcloud files of user %q: %w", u.UID, err)
+			}
+
+			if len(files) == 0 {
+				break
+			}
+
+			for _, f := range files {
+				source := strconv.FormatInt(f.FileID, 10)
+				relativePath := strings.TrimPrefix(f.Path, ncFilesPrefix)
+				paths[f.FileID] = relativePath
+				if lo.Contains(folderMimes, f.MimeType) {
+					i.importFolder(s, source, relativePath)
+					continue
+				}
+
+				blob := filepath.Join(o.DataDir, u.UID, filepath.FromSlash(f.Path))
+				i.importFile(s, source, relativePath, blob, f.Size, time.Unix(f.MTime, 0))
+			}
+		}
+	}
+
+	i.l.Info("Importing Nextcloud public link shares...")
+	for offset := 0; ; offset += batchSize {
+		var shares []ncShare
+		if err := table("share").Where("share_type = ?", ncShareTypeLink).
+			Order("id").Limit(batchSize).Offset(offset).
+			Find(&shares).Error; err != nil {
+			return fmt.Errorf("failed to list Nextcloud shares: %w", err)
+		}
+
+		if len(shares) == 0 {
+			break
+		}
+
+		for _, share := range shares {
+			relativePath, ok := paths[share.FileSource]
+			s, owned := sessions[share.UIDOwner]
+			if !ok || !owned {
+				i.fail(FailureShare, share.Token, fmt.Errorf("shared file %d is not imported", share.FileSource))
+				continue
+			}
+
+			protected := share.Password != nil && *share.Password != ""
+			i.importShare(s, share.Token, relativePath, protected, share.Expiration)
+		}
+	}
+
+	return nil
+}
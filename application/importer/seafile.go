@@ -0,0 +1,327 @@
+package importer
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+)
+
+const (
+	// seafEmptyID is the object ID of empty files and folders, which have no object stored.
+	seafEmptyID = "0000000000000000000000000000000000000000"
+	// seafModeDir is the file type bits of folders in mode of dirents.
+	seafModeDir      = 0040000
+	seafModeTypeMask = 0170000
+)
+
+type (
+	// SeafileOptions configures the Seafile instance to import from.
+	SeafileOptions struct {
+		// DBType is one of mysql, postgres and sqlite.
+		DBType     string
+		CcnetDSN   string
+		SeafileDSN string
+		SeahubDSN  string
+		// DataDir is the seafile-data directory, objects are stored in <DataDir>/storage.
+		DataDir string
+	}
+
+	seafUser struct {
+		Email string `gorm:"column:email"`
+	}
+
+	seafProfile struct {
+		User     string `gorm:"column:user"`
+		Nickname string `gorm:"column:nickname"`
+	}
+
+	seafRepo struct {
+		RepoID   string `gorm:"column:repo_id"`
+		OwnerID  string `gorm:"column:owner_id"`
+		CommitID string `gorm:"column:commit_id"`
+	}
+
+	seafFileShare struct {
+		Username   string     `gorm:"column:username"`
+		RepoID     string     `gorm:"column:repo_id"`
+		Path       string     `gorm:"column:path"`
+		Token      string     `gorm:"column:token"`
+		Password   *string    `gorm:"column:password"`
+		ExpireDate *time.Time `gorm:"column:expire_date"`
+	}
+
+	seafCommit struct {
+		RootID    string `json:"root_id"`
+		RepoName  string `json:"repo_name"`
+		Encrypted string `json:"encrypted"`
+		Version   int    `json:"version"`
+	}
+
+	seafDirent struct {
+		ID    string `json:"id"`
+		Mode  uint32 `json:"mode"`
+		MTime int64  `json:"mtime"`
+		Name  string `json:"name"`
+		Size  int64  `json:"size"`
+	}
+
+	seafDir struct {
+		Dirents []seafDirent `json:"dirents"`
+	}
+
+	seafFile struct {
+		BlockIDs []string `json:"block_ids"`
+		Size     int64    `json:"size"`
+	}
+
+	// seafStore reads objects of one library from Seafile storage.
+	seafStore struct {
+		root   string
+		repoID string
+	}
+
+	// seafBlockReader reads content of a file by concatenating its blocks.
+	seafBlockReader struct {
+		store  *seafStore
+		blocks []string
+		cur    *os.File
+	}
+)
+
+// ImportSeafile imports users, libraries and share links from Seafile. Directory trees of libraries are
+// reconstructed from FS objects, and file content is assembled from blocks into storage policy of importer.
+// Each library is imported as a folder in root of its owner. Encrypted libraries cannot be imported.
+func (i *Importer) ImportSeafile(ctx context.Context, o *SeafileOptions) error {
+	ccnet, err := openSourceDB(o.DBType, o.CcnetDSN)
+	if err != nil {
+		return err
+	}
+
+	seafile, err := openSourceDB(o.DBType, o.SeafileDSN)
+	if err != nil {
+		return err
+	}
+
+	seahub, err := openSourceDB(o.DBType, o.SeahubDSN)
+	if err != nil {
+		return err
+	}
+
+	var users []seafUser
+	if err := ccnet.Table("EmailUser").Find(&users).Error; err != nil {
+		return fmt.Errorf("failed to list Seafile users: %w", err)
+	}
+
+	var profiles []seafProfile
+	if err := seahub.Table("profile_profile").Find(&profiles).Error; err != nil {
+		i.l.Warning("Failed to list Seafile user profiles, nicknames are not imported: %s", err)
+	}
+	nicks := lo.SliceToMap(profiles, func(p seafProfile) (string, string) { return p.User, p.Nickname })
+
+	i.l.Info("Found %d Seafile users to be imported.", len(users))
+	sessions := make(map[string]*userSession)
+	defer func() {
+		for _, s := range sessions {
+			s.recycle()
+		}
+	}()
+
+	for _, u := range users {
+		s, err := i.importUser(ctx, u.Email, strings.ToLower(u.Email), nicks[u.Email], true)
+		if err != nil {
+			i.fail(FailureUser, u.Email, err)
+			continue
+		}
+		sessions[u.Email] = s
+	}
+
+	// Sub-folders shared as virtual libraries are imported with their origin library.
+	var repos []seafRepo
+	if err := seafile.Table("RepoOwner").
+		Select("RepoOwner.repo_id, RepoOwner.owner_id, Branch.commit_id").
+		Joins("JOIN Branch ON Branch.repo_id = RepoOwner.repo_id AND Branch.name = ?", "master").
+		Where("RepoOwner.repo_id NOT IN (?)", seafile.Table("VirtualRepo").Select("repo_id")).
+		Find(&repos).Error; err != nil {
+		return fmt.Errorf("failed to list Seafile libraries: %w", err)
+	}
+
+	i.l.Info("Found %d Seafile libraries to be imported.", len(repos))
+	// Folder of imported libraries by repo ID, used to import shares.
+	libraries := make(map[string]string)
+	// Folder names taken by libraries of each owner.
+	taken := make(map[string]bool)
+	for _, repo := range repos {
+		s, ok := sessions[repo.OwnerID]
+		if !ok {
+			i.fail(FailureFile, repo.RepoID, fmt.Errorf("owner %q of library is not imported", repo.OwnerID))
+			continue
+		}
+
+		store := &seafStore{root: filepath.Join(o.DataDir, "storage"), repoID: repo.RepoID}
+		commit := &seafCommit{}
+		if err := store.readObject("commits", repo.CommitID, false, commit); err != nil {
+			i.fail(FailureFile, repo.RepoID, fmt.Errorf("failed to read head commit: %w", err))
+			continue
+		}
+
+		if commit.Encrypted == "true" {
+			i.fail(FailureFile, repo.RepoID, fmt.Errorf("library %q is encrypted", commit.RepoName))
+			continue
+		}
+
+		if commit.Version < 1 {
+			i.fail(FailureFile, repo.RepoID, fmt.Errorf("library %q uses unsupported object format version %d", commit.RepoName, commit.Version))
+			continue
+		}
+
+		// Libraries of one owner may have the same name.
+		folder := commit.RepoName
+		if taken[repo.OwnerID+"/"+folder] {
+			folder = fmt.Sprintf("%s (%s)", commit.RepoName, repo.RepoID[:8])
+		}
+		taken[repo.OwnerID+"/"+folder] = true
+		libraries[repo.RepoID] = folder
+
+		i.l.Info("Importing Seafile library %q of user %q...", commit.RepoName, repo.OwnerID)
+		i.importFolder(s, repo.RepoID, folder)
+		i.importSeafileDir(s, store, commit.RootID, folder)
+	}
+
+	i.l.Info("Importing Seafile share links...")
+	var shares []seafFileShare
+	if err := seahub.Table("share_fileshare").Find(&shares).Error; err != nil {
+		return fmt.Errorf("failed to list Seafile share links: %w", err)
+	}
+
+	for _, share := range shares {
+		folder, ok := libraries[share.RepoID]
+		s, owned := sessions[share.Username]
+		if !ok || !owned {
+			i.fail(FailureShare, share.Token, fmt.Errorf("shared library %q is not imported", share.RepoID))
+			continue
+		}
+
+		protected := share.Password != nil && *share.Password != ""
+		i.importShare(s, share.Token, path.Join(folder, share.Path), protected, share.ExpireDate)
+	}
+
+	var sharedRepos int64
+	if err := seafile.Table("SharedRepo").Count(&sharedRepos).Error; err == nil && sharedRepos > 0 {
+		i.l.Warning("%d libraries shared to users or groups are not imported, share them with links instead.", sharedRepos)
+	}
+
+	return nil
+}
+
+// importSeafileDir imports content of dir object recursively into given relative path.
+func (i *Importer) importSeafileDir(s *userSession, store *seafStore, dirID, relativePath string) {
+	if dirID == seafEmptyID {
+		return
+	}
+
+	dir := &seafDir{}
+	if err := store.readObject("fs", dirID, true, dir); err != nil {
+		i.fail(FailureFile, store.repoID+":"+relativePath, fmt.Errorf("failed to read dir object: %w", err))
+		return
+	}
+
+	for _, dirent := range dir.Dirents {
+		childPath := path.Join(relativePath, dirent.Name)
+		source := store.repoID + ":" + dirent.ID
+		if dirent.Mode&seafModeTypeMask == seafModeDir {
+			i.importFolder(s, source, childPath)
+			i.importSeafileDir(s, store, dirent.ID, childPath)
+			continue
+		}
+
+		file := &seafFile{}
+		if dirent.ID != seafEmptyID {
+			if err := store.readObject("fs", dirent.ID, true, file); err != nil {
+				i.fail(FailureFile, source, fmt.Errorf("failed to read file object: %w", err))
+				continue
+			}
+		}
+
+		i.importContent(s, source, childPath, file.Size, time.Unix(dirent.MTime, 0), &seafBlockReader{
+			store:  store,
+			blocks: file.BlockIDs,
+		})
+	}
+}
+
+func (s *seafStore) objectPath(kind, id string) string {
+	return filepath.Join(s.root, kind, s.repoID, id[:2], id[2:])
+}
+
+// readObject reads and decodes a JSON object, FS objects are compressed by zlib.
+func (s *seafStore) readObject(kind, id string, compressed bool, v any) error {
+	if len(id) < 3 {
+		return fmt.Errorf("invalid object ID %q", id)
+	}
+
+	content, err := os.ReadFile(s.objectPath(kind, id))
+	if err != nil {
+		return err
+	}
+
+	if compressed {
+		r, err := zlib.NewReader(bytes.NewReader(content))
+		if err != nil {
+			return fmt.Errorf("failed to decompress object: %w", err)
+		}
+		defer r.Close()
+
+		if content, err = io.ReadAll(r); err != nil {
+			return fmt.Errorf("failed to decompress object: %w", err)
+		}
+	}
+
+	return json.Unmarshal(content, v)
+}
+
+func (r *seafBlockReader) Read(p []byte) (int, error) {
+	for {
+		if r.cur == nil {
+			if len(r.blocks) == 0 {
+				return 0, io.EOF
+			}
+
+			f, err := os.Open(r.store.objectPath("blocks", r.blocks[0]))
+			if err != nil {
+				return 0, fmt.Errorf("failed to open block: %w", err)
+			}
+			r.cur = f
+			r.blocks = r.blocks[1:]
+		}
+
+		n, err := r.cur.Read(p)
+		if err == io.EOF {
+			r.cur.Close()
+			r.cur = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+
+		return n, err
+	}
+}
+
+func (r *seafBlockReader) Close() error {
+	if r.cur != nil {
+		return r.cur.Close()
+	}
+
+	return nil
+}
@@ -12,36 +12,44 @@ import (
 func migrateAvatars(m *Migrator) error {
 	m.l.Info("Migrating avatars files...")
 	avatarRoot := util.RelativePath(m.state.V3AvatarPath)
+	migrated := 0
 
-	for uid, _ := range m.state.UserIDs {
+	for uid := range m.state.UserIDs {
+		// Largest size of v3 avatar is used.
 		avatarPath := filepath.Join(avatarRoot, fmt.Sprintf("avatar_%d_2.png", uid))
 
 		// check if file exists
-		if util.Exists(avatarPath) {
-			m.l.Info("Migrating avatar for user %d", uid)
-			// Copy to v4 avatar path
-			v4Path := filepath.Join(util.DataPath("avatar"), fmt.Sprintf("avatar_%d.png", uid))
-
-			// copy
-			origin, err := os.Open(avatarPath)
-			if err != nil {
-				return fmt.Errorf("failed to open avatar file: %w", err)
-			}
-			defer origin.Close()
-
-			dest, err := util.CreatNestedFile(v4Path)
-			if err != nil {
-				return fmt.Errorf("failed to create avatar file: %w", err)
-			}
-			defer dest.Close()
-
-			_, err = io.Copy(dest, origin)
-
-			if err != nil {
-				m.l.Warning("Failed to copy avatar file: %s, skipping...", err)
-			}
+		if !util.Exists(avatarPath) {
+			continue
 		}
+
+		m.l.Info("Migrating avatar for user %d", uid)
+		v4Path := filepath.Join(util.DataPath("avatar"), fmt.Sprintf("avatar_%d.png", uid))
+		if err := copyAvatar(avatarPath, v4Path); err != nil {
+			m.l.Warning("Failed to copy avatar file for user %d: %s, skipping...", uid, err)
+			continue
+		}
+
+		migrated++
 	}
 
+	m.l.Info("Migrated %d avatar files.", migrated)
 	return nil
 }
+
+func copyAvatar(src, dst string) error {
+	origin, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open avatar file: %w", err)
+	}
+	defer origin.Close()
+
+	dest, err := util.CreatNestedFile(dst)
+	if err != nil {
+		return fmt.Errorf("failed to create avatar file: %w", err)
+	}
+	defer dest.Close()
+
+	_, err = io.Copy(dest, origin)
+	return err
+}
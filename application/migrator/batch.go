@@ -0,0 +1,100 @@
+package migrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/cloudreve/Cloudreve/v4/application/migrator/model"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/samber/lo"
+)
+
+// batchSize is the number of v3 rows migrated in one v4 transaction.
+const batchSize = 1000
+
+// SetWorkers sets the number of steps and batches migrated concurrently. SQLite does not support
+// concurrent writes, so only one worker is used for it.
+func (m *Migrator) SetWorkers(workers int) {
+	dbType := m.dep.ConfigProvider().Database().Type
+	if workers > 1 && (dbType == conf.SQLiteDB || dbType == conf.SQLite3DB || dbType == "") {
+		m.l.Warning("Concurrent migration is not supported for SQLite, fallback to 1 worker.")
+		workers = 1
+	}
+
+	m.workers = max(workers, 1)
+}
+
+// migrateBatches lists v3 rows of T in rounds of one batch per worker. Batches in a round are migrated
+// concurrently, by default split in ID order, and state is saved after each batch is committed. Offset
+// is saved only after the whole round is committed, so migrate must skip rows already committed when an
+// interrupted round is resumed.
+func migrateBatches[T any](m *Migrator, name string, offset *int, split func([]T) [][]T, migrate func(ctx context.Context, rows []T) error) error {
+	ctx := context.Background()
+	m.mu.Lock()
+	current := *offset
+	m.mu.Unlock()
+
+	if current > 0 {
+		m.l.Info("Resuming %s migration from offset %d", name, current)
+	}
+
+	progress := m.progress.Load()
+	progress.startTable(name, current)
+	defer progress.finishTable(name)
+
+	for {
+		m.l.Info("Migrating %s with offset %d", name, current)
+		var rows []T
+		if err := model.DB.Order("id").Limit(batchSize * m.workers).Offset(current).Find(&rows).Error; err != nil {
+			return fmt.Errorf("failed to list v3 %s: %w", name, err)
+		}
+
+		if len(rows) == 0 {
+			return nil
+		}
+
+		var batches [][]T
+		if split != nil {
+			batches = split(rows)
+		} else {
+			batches = lo.Chunk(rows, batchSize)
+		}
+
+		var (
+			wg   sync.WaitGroup
+			errs = make([]error, len(batches))
+		)
+		for i, batch := range batches {
+			wg.Add(1)
+			go func(i int, batch []T) {
+				defer wg.Done()
+				if errs[i] = migrate(ctx, batch); errs[i] != nil {
+					return
+				}
+				progress.advanceTable(name, len(batch))
+
+				// Save IDs and entities of committed batch for resuming.
+				if err := m.saveState(); err != nil {
+					m.l.Warning("Failed to save state after %s batch: %s", name, err)
+				}
+			}(i, batch)
+		}
+
+		wg.Wait()
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+
+		current += len(rows)
+		m.mu.Lock()
+		*offset = current
+		m.mu.Unlock()
+		if err := m.saveState(); err != nil {
+			m.l.Warning("Failed to save state after %s batch: %s", name, err)
+		} else {
+			m.l.Info("Saved migration state after processing %d %s", current, name)
+		}
+	}
+}
@@ -0,0 +1,334 @@
+package migrator
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/migrator/model"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+const (
+	// ReportFileName is the default file name of dry-run report, placed next to v3 config file.
+	ReportFileName = "migration_report.json"
+
+	// maxIssuesPerType limits the issues listed in report for each type, all of them are still counted.
+	maxIssuesPerType = 100
+	// maxNameLength is the size of name column in v4 database on MySQL.
+	maxNameLength = 255
+	// maxPathLength is the longest full path that can be safely handled by local storage and WebDAV clients.
+	maxPathLength = 4096
+	// Rough estimations used to predict migration time and v4 database size.
+	estimatedRowsPerSecond = 1000
+	estimatedBytesPerRow   = 512
+)
+
+type IssueType string
+
+const (
+	IssueOrphanedFolder    = IssueType("orphaned_folder")
+	IssueOrphanedFile      = IssueType("orphaned_file")
+	IssueDuplicateName     = IssueType("duplicate_name")
+	IssueUnsupportedPolicy = IssueType("unsupported_policy")
+	IssueNameTooLong       = IssueType("name_too_long")
+	IssuePathTooLong       = IssueType("path_too_long")
+)
+
+const (
+	// SeverityError issues cause data to be skipped or broken after migration.
+	SeverityError = "error"
+	// SeverityWarning issues are resolved automatically but change the data.
+	SeverityWarning = "warning"
+)
+
+type (
+	// Report is the result of a dry-run migration.
+	Report struct {
+		GeneratedAt time.Time           `json:"generated_at"`
+		Counts      map[string]int64    `json:"counts"`
+		Issues      []Issue             `json:"issues"`
+		IssueCounts map[IssueType]int64 `json:"issue_counts"`
+		Estimation  Estimation          `json:"estimation"`
+	}
+
+	Issue struct {
+		Type     IssueType `json:"type"`
+		Severity string    `json:"severity"`
+		ID       uint      `json:"id"`
+		Message  string    `json:"message"`
+	}
+
+	Estimation struct {
+		// Rows is the estimated number of rows inserted into v4 database.
+		Rows int64 `json:"rows"`
+		// DatabaseSize is the estimated size of v4 database in bytes.
+		DatabaseSize int64 `json:"database_size"`
+		// StorageSize is the total size of migrated files. Blobs are referenced in place, not copied.
+		StorageSize uint64 `json:"storage_size"`
+		// Duration is the estimated migration time in seconds.
+		Duration int64 `json:"duration"`
+	}
+
+	dryRunFolder struct {
+		parentID *uint
+		name     string
+		ownerID  uint
+		path     string
+	}
+)
+
+// Save writes report as JSON into given path.
+func (r *Report) Save(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal report: %w", err)
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+// HasErrors returns whether any issue would lose data during migration.
+func (r *Report) HasErrors() bool {
+	for _, issue := range r.Issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Report) add(t IssueType, severity string, id uint, format string, args ...any) {
+	r.IssueCounts[t]++
+	if r.IssueCounts[t] <= maxIssuesPerType {
+		r.Issues = append(r.Issues, Issue{Type: t, Severity: severity, ID: id, Message: fmt.Sprintf(format, args...)})
+	}
+}
+
+// DryRun validates the v3 database and estimates the migration cost without writing anything.
+func (m *Migrator) DryRun() (*Report, error) {
+	r := &Report{
+		GeneratedAt: time.Now(),
+		Counts:      make(map[string]int64),
+		Issues:      []Issue{},
+		IssueCounts: make(map[IssueType]int64),
+	}
+
+	counted := map[string]any{
+		"settings":     &model.Setting{},
+		"nodes":        &model.Node{},
+		"groups":       &model.Group{},
+		"users":        &model.User{},
+		"shares":       &model.Share{},
+		"direct_links": &model.SourceLink{},
+		"webdavs":      &model.Webdav{},
+	}
+	for name, table := range counted {
+		var count int64
+		if err := model.DB.Model(table).Count(&count).Error; err != nil {
+			return nil, fmt.Errorf("failed to count v3 %s: %w", name, err)
+		}
+		r.Counts[name] = count
+	}
+
+	m.l.Info("Checking storage policies...")
+	policyIDs, err := m.dryRunPolicies(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var userIDs []uint
+	if err := model.DB.Model(&model.User{}).Pluck("id", &userIDs).Error; err != nil {
+		return nil, fmt.Errorf("failed to list v3 users: %w", err)
+	}
+	users := make(map[uint]bool, len(userIDs))
+	for _, id := range userIDs {
+		users[id] = true
+	}
+
+	m.l.Info("Checking folders...")
+	folders, err := m.dryRunFolders(r, users)
+	if err != nil {
+		return nil, err
+	}
+
+	m.l.Info("Checking files...")
+	if err := m.dryRunFiles(r, users, policyIDs, folders); err != nil {
+		return nil, err
+	}
+
+	var rows int64
+	for name, count := range r.Counts {
+		rows += count
+		if name == "files" {
+			// Each file comes with at least one entity.
+			rows += count
+		}
+	}
+	r.Estimation.Rows = rows
+	r.Estimation.DatabaseSize = rows * estimatedBytesPerRow
+	r.Estimation.Duration = rows/estimatedRowsPerSecond + 1
+
+	return r, nil
+}
+
+func (m *Migrator) dryRunPolicies(r *Report) (map[uint]bool, error) {
+	var policies []model.Policy
+	if err := model.DB.Find(&policies).Error; err != nil {
+		return nil, fmt.Errorf("failed to list v3 storage policies: %w", err)
+	}
+
+	supported := map[string]bool{
+		types.PolicyTypeLocal:  true,
+		types.PolicyTypeQiniu:  true,
+		types.PolicyTypeUpyun:  true,
+		types.PolicyTypeOss:    true,
+		types.PolicyTypeCos:    true,
+		types.PolicyTypeS3:     true,
+		types.PolicyTypeOd:     true,
+		types.PolicyTypeRemote: true,
+		types.PolicyTypeObs:    true,
+	}
+
+	policyIDs := make(map[uint]bool, len(policies))
+	for _, p := range policies {
+		policyIDs[p.ID] = true
+		if !supported[p.Type] {
+			r.add(IssueUnsupportedPolicy, SeverityError, p.ID, "Storage policy %q has unsupported type %q, files stored in it cannot be accessed after migration", p.Name, p.Type)
+		}
+	}
+
+	r.Counts["policies"] = int64(len(policies))
+	return policyIDs, nil
+}
+
+func (m *Migrator) dryRunFolders(r *Report, users map[uint]bool) (map[uint]*dryRunFolder, error) {
+	folders := make(map[uint]*dryRunFolder)
+	batchSize := 1000
+	offset := 0
+	for {
+		var batch []model.Folder
+		if err := model.DB.Select("id", "name", "parent_id", "owner_id").Limit(batchSize).Offset(offset).Find(&batch).Error; err != nil {
+			return nil, fmt.Errorf("failed to list v3 folders: %w", err)
+		}
+
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, f := range batch {
+			folders[f.ID] = &dryRunFolder{parentID: f.ParentID, name: f.Name, ownerID: f.OwnerID}
+		}
+		offset += batchSize
+	}
+
+	r.Counts["folders"] = int64(len(folders))
+	for id, f := range folders {
+		if !users[f.ownerID] {
+			r.add(IssueOrphanedFolder, SeverityError, id, "Owner %d of folder %q not found, folder will be skipped", f.ownerID, f.name)
+			continue
+		}
+
+		if f.parentID == nil {
+			continue
+		}
+
+		if parent, ok := folders[*f.parentID]; !ok || *f.parentID == 0 {
+			r.add(IssueOrphanedFolder, SeverityError, id, "Parent %d of folder %q not found, folder will be skipped", *f.parentID, f.name)
+			continue
+		} else if parent.ownerID != f.ownerID {
+			r.add(IssueOrphanedFolder, SeverityError, id, "Folder %q is owned by user %d, but its parent is owned by user %d", f.name, f.ownerID, parent.ownerID)
+		}
+
+		if len(f.name) > maxNameLength {
+			r.add(IssueNameTooLong, SeverityError, id, "Name of folder %q exceeds %d bytes", f.name, maxNameLength)
+		}
+
+		if path := folderPath(folders, id); len(path) > maxPathLength {
+			r.add(IssuePathTooLong, SeverityWarning, id, "Full path of folder %q exceeds %d bytes", path, maxPathLength)
+		}
+	}
+
+	return folders, nil
+}
+
+func (m *Migrator) dryRunFiles(r *Report, users, policies map[uint]bool, folders map[uint]*dryRunFolder) error {
+	// Files and folders share the same name space in v4.
+	folderNames := make(map[uint]map[string]bool)
+	for _, f := range folders {
+		if f.parentID == nil {
+			continue
+		}
+		if folderNames[*f.parentID] == nil {
+			folderNames[*f.parentID] = make(map[string]bool)
+		}
+		folderNames[*f.parentID][f.name] = true
+	}
+
+	batchSize := 1000
+	offset := 0
+	for {
+		var files []model.File
+		if err := model.DB.Select("id", "name", "user_id", "size", "folder_id", "policy_id").Limit(batchSize).Offset(offset).Find(&files).Error; err != nil {
+			return fmt.Errorf("failed to list v3 files: %w", err)
+		}
+
+		if len(files) == 0 {
+			break
+		}
+
+		for _, f := range files {
+			r.Counts["files"]++
+			if _, ok := folders[f.FolderID]; !ok {
+				r.add(IssueOrphanedFile, SeverityError, f.ID, "Folder %d of file %q not found, file will be skipped", f.FolderID, f.Name)
+				continue
+			}
+
+			if !users[f.UserID] {
+				r.add(IssueOrphanedFile, SeverityError, f.ID, "Owner %d of file %q not found, file will be skipped", f.UserID, f.Name)
+				continue
+			}
+
+			if !policies[f.PolicyID] {
+				r.add(IssueOrphanedFile, SeverityError, f.ID, "Storage policy %d of file %q not found, file will be skipped", f.PolicyID, f.Name)
+				continue
+			}
+
+			r.Estimation.StorageSize += f.Size
+			if folderNames[f.FolderID][f.Name] {
+				r.add(IssueDuplicateName, SeverityWarning, f.ID, "File %q conflicts with a folder of the same name, it will be renamed to %q", f.Name, fmt.Sprintf("%d_%s", f.ID, f.Name))
+			}
+
+			if len(f.Name) > maxNameLength {
+				r.add(IssueNameTooLong, SeverityError, f.ID, "Name of file %q exceeds %d bytes", f.Name, maxNameLength)
+			}
+
+			if path := folderPath(folders, f.FolderID) + "/" + f.Name; len(path) > maxPathLength {
+				r.add(IssuePathTooLong, SeverityWarning, f.ID, "Full path of file %q exceeds %d bytes", path, maxPathLength)
+			}
+		}
+
+		offset += batchSize
+	}
+
+	return nil
+}
+
+// folderPath returns full path of given folder, results are cached in folders.
+func folderPath(folders map[uint]*dryRunFolder, id uint) string {
+	f, ok := folders[id]
+	if !ok {
+		return ""
+	}
+
+	if f.path != "" || f.parentID == nil {
+		return f.path
+	}
+
+	// Mark as visited to stop on cyclic parents.
+	f.path = "/" + f.name
+	f.path = folderPath(folders, *f.parentID) + "/" + f.name
+	return f.path
+}
@@ -4,169 +4,223 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"os"
 	"strconv"
 
 	"github.com/cloudreve/Cloudreve/v4/application/migrator/model"
 	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/samber/lo"
 )
 
 func (m *Migrator) migrateFile() error {
 	m.l.Info("Migrating files...")
-	batchSize := 1000
-	offset := m.state.FileOffset
 	ctx := context.Background()
 
-	if m.state.FileConflictRename == nil {
-		m.state.FileConflictRename = make(map[uint]string)
+	err := migrateBatches(m, "files", &m.state.FileOffset, m.splitFiles, func(ctx context.Context, files []model.File) error {
+		for {
+			conflict, err := m.migrateFileBatch(ctx, files)
+			if err != nil {
+				return err
+			}
+
+			if conflict == nil {
+				return nil
+			}
+
+			m.mu.Lock()
+			_, renamed := m.state.FileConflictRename[conflict.ID]
+			if !renamed {
+				m.state.FileConflictRename[conflict.ID] = fmt.Sprintf("%d_%s", conflict.ID, conflict.Name)
+			}
+			m.mu.Unlock()
+
+			if renamed {
+				return fmt.Errorf("file %d already exists, but new name is already in conflict rename map, please resolve this manually", conflict.ID)
+			}
+
+			m.l.Warning("File %d already exists, retry batch with new name", conflict.ID)
+		}
+	})
+	if err != nil {
+		return err
 	}
 
-	if m.state.EntitySources == nil {
-		m.state.EntitySources = make(map[string]int)
+	if m.dep.ConfigProvider().Database().Type == conf.PostgresDB {
+		m.l.Info("Resetting file ID sequence for postgres...")
+		m.v4client.File.ExecContext(ctx, "SELECT SETVAL('files_id_seq',  (SELECT MAX(id) FROM files))")
 	}
 
-	if offset > 0 {
-		m.l.Info("Resuming file migration from offset %d", offset)
+	return nil
+}
+
+// splitFiles splits files into one batch per worker. Files of the same blob are always put into the same
+// batch, so that their entity is created only once.
+func (m *Migrator) splitFiles(files []model.File) [][]model.File {
+	batches := make([][]model.File, m.workers)
+	for _, f := range files {
+		h := fnv.New32a()
+		h.Write([]byte(entityKey(int(f.PolicyID), f.SourceName)))
+		i := h.Sum32() % uint32(m.workers)
+		batches[i] = append(batches[i], f)
 	}
 
-out:
-	for {
-		m.l.Info("Migrating files with offset %d", offset)
-		var files []model.File
-		if err := model.DB.Limit(batchSize).Offset(offset).Find(&files).Error; err != nil {
-			return fmt.Errorf("failed to list v3 files: %w", err)
-		}
+	return lo.Filter(batches, func(batch []model.File, _ int) bool {
+		return len(batch) > 0
+	})
+}
 
-		if len(files) == 0 {
-			if m.dep.ConfigProvider().Database().Type == conf.PostgresDB {
-				m.l.Info("Resetting file ID sequence for postgres...")
-				m.v4client.File.ExecContext(ctx, "SELECT SETVAL('files_id_seq',  (SELECT MAX(id) FROM files))")
-			}
-			break
+// migrateFileBatch migrates files in one transaction. If a file conflicts with existing one with the same
+// name, the transaction is rolled back and the conflicting file is returned.
+func (m *Migrator) migrateFileBatch(ctx context.Context, files []model.File) (*model.File, error) {
+	// Files committed by an interrupted round already exist.
+	existing, err := m.v4client.File.Query().
+		Where(file.IDIn(lo.Map(files, func(f model.File, _ int) int { return int(f.ID) + m.state.LastFolderID })...)).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query existing files: %w", err)
+	}
+	existingIDs := lo.SliceToMap(existing, func(id int) (int, bool) { return id, true })
+
+	tx, err := m.v4client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Entities created in this transaction are forgotten on rollback.
+	var created []string
+	rollback := func() {
+		_ = tx.Rollback()
+		m.mu.Lock()
+		for _, key := range created {
+			delete(m.state.EntitySources, key)
 		}
+		m.mu.Unlock()
+	}
 
-		tx, err := m.v4client.Tx(ctx)
-		if err != nil {
-			_ = tx.Rollback()
-			return fmt.Errorf("failed to start transaction: %w", err)
+	for _, f := range files {
+		if existingIDs[int(f.ID)+m.state.LastFolderID] {
+			continue
 		}
 
-		for _, f := range files {
-			if _, ok := m.state.FolderIDs[int(f.FolderID)]; !ok {
-				m.l.Warning("Folder ID %d for file %d not found, skipping", f.FolderID, f.ID)
-				continue
-			}
+		if _, ok := m.state.FolderIDs[int(f.FolderID)]; !ok {
+			m.l.Warning("Folder ID %d for file %d not found, skipping", f.FolderID, f.ID)
+			continue
+		}
 
-			if _, ok := m.state.UserIDs[int(f.UserID)]; !ok {
-				m.l.Warning("User ID %d for file %d not found, skipping", f.UserID, f.ID)
-				continue
-			}
+		if _, ok := m.state.UserIDs[int(f.UserID)]; !ok {
+			m.l.Warning("User ID %d for file %d not found, skipping", f.UserID, f.ID)
+			continue
+		}
 
-			if _, ok := m.state.PolicyIDs[int(f.PolicyID)]; !ok {
-				m.l.Warning("Policy ID %d for file %d not found, skipping", f.PolicyID, f.ID)
-				continue
-			}
+		if _, ok := m.state.PolicyIDs[int(f.PolicyID)]; !ok {
+			m.l.Warning("Policy ID %d for file %d not found, skipping", f.PolicyID, f.ID)
+			continue
+		}
 
-			metadata := make(map[string]string)
-			if f.Metadata != "" {
-				json.Unmarshal([]byte(f.Metadata), &metadata)
-			}
+		metadata := make(map[string]string)
+		if f.Metadata != "" {
+			json.Unmarshal([]byte(f.Metadata), &metadata)
+		}
 
-			var (
-				thumbnail *ent.Entity
-				entity    *ent.Entity
-				err       error
-			)
-
-			if metadata[model.ThumbStatusMetadataKey] == model.ThumbStatusExist {
-				size := int64(0)
-				if m.state.LocalPolicyIDs[int(f.PolicyID)] {
-					thumbFile, err := os.Stat(f.SourceName + m.state.ThumbSuffix)
-					if err == nil {
-						size = thumbFile.Size()
-					}
+		var (
+			thumbnail *ent.Entity
+			entity    *ent.Entity
+			isNew     bool
+		)
+
+		if metadata[model.ThumbStatusMetadataKey] == model.ThumbStatusExist {
+			size := int64(0)
+			if m.state.LocalPolicyIDs[int(f.PolicyID)] {
+				thumbFile, err := os.Stat(f.SourceName + m.state.ThumbSuffix)
+				if err == nil {
+					size = thumbFile.Size()
+				} else {
 					m.l.Warning("Thumbnail file %s for file %d not found, use 0 size", f.SourceName+m.state.ThumbSuffix, f.ID)
 				}
-				// Insert thumbnail entity
-				thumbnail, err = m.insertEntity(tx, f.SourceName+m.state.ThumbSuffix, int(types.EntityTypeThumbnail), int(f.PolicyID), int(f.UserID), size)
-				if err != nil {
-					_ = tx.Rollback()
-					return fmt.Errorf("failed to insert thumbnail entity: %w", err)
-				}
 			}
-
-			// Insert file version entity
-			entity, err = m.insertEntity(tx, f.SourceName, int(types.EntityTypeVersion), int(f.PolicyID), int(f.UserID), int64(f.Size))
+			// Insert thumbnail entity
+			thumbnail, isNew, err = m.insertEntity(tx, f.SourceName+m.state.ThumbSuffix, int(types.EntityTypeThumbnail), int(f.PolicyID), int(f.UserID), size)
 			if err != nil {
-				_ = tx.Rollback()
-				return fmt.Errorf("failed to insert file version entity: %w", err)
+				rollback()
+				return nil, fmt.Errorf("failed to insert thumbnail entity: %w", err)
 			}
-
-			fname := f.Name
-			if _, ok := m.state.FileConflictRename[f.ID]; ok {
-				fname = m.state.FileConflictRename[f.ID]
-			}
-
-			stm := tx.File.Create().
-				SetCreatedAt(formatTime(f.CreatedAt)).
-				SetUpdatedAt(formatTime(f.UpdatedAt)).
-				SetName(fname).
-				SetRawID(int(f.ID) + m.state.LastFolderID).
-				SetOwnerID(int(f.UserID)).
-				SetSize(int64(f.Size)).
-				SetPrimaryEntity(entity.ID).
-				SetFileChildren(int(f.FolderID)).
-				SetType(int(types.FileTypeFile)).
-				SetStoragePoliciesID(int(f.PolicyID)).
-				AddEntities(entity)
-
-			if thumbnail != nil {
-				stm.AddEntities(thumbnail)
+			if isNew {
+				created = append(created, entityKey(int(f.PolicyID), f.SourceName+m.state.ThumbSuffix))
 			}
+		}
 
-			if _, err := stm.Save(ctx); err != nil {
-				_ = tx.Rollback()
-				if ent.IsConstraintError(err) {
-					if _, ok := m.state.FileConflictRename[f.ID]; ok {
-						return fmt.Errorf("file %d already exists, but new name is already in conflict rename map, please resolve this manually", f.ID)
-					}
-
-					m.l.Warning("File %d already exists, will retry with new name in next batch", f.ID)
-					m.state.FileConflictRename[f.ID] = fmt.Sprintf("%d_%s", f.ID, f.Name)
-					continue out
-				}
-				return fmt.Errorf("failed to create file %d: %w", f.ID, err)
-			}
+		// Insert file version entity
+		entity, isNew, err = m.insertEntity(tx, f.SourceName, int(types.EntityTypeVersion), int(f.PolicyID), int(f.UserID), int64(f.Size))
+		if err != nil {
+			rollback()
+			return nil, fmt.Errorf("failed to insert file version entity: %w", err)
+		}
+		if isNew {
+			created = append(created, entityKey(int(f.PolicyID), f.SourceName))
 		}
 
-		if err := tx.Commit(); err != nil {
-			return fmt.Errorf("failed to commit transaction: %w", err)
+		fname := f.Name
+		m.mu.Lock()
+		if renamed, ok := m.state.FileConflictRename[f.ID]; ok {
+			fname = renamed
+		}
+		m.mu.Unlock()
+
+		stm := tx.File.Create().
+			SetCreatedAt(formatTime(f.CreatedAt)).
+			SetUpdatedAt(formatTime(f.UpdatedAt)).
+			SetName(fname).
+			SetRawID(int(f.ID) + m.state.LastFolderID).
+			SetOwnerID(int(f.UserID)).
+			SetSize(int64(f.Size)).
+			SetPrimaryEntity(entity.ID).
+			SetFileChildren(int(f.FolderID)).
+			SetType(int(types.FileTypeFile)).
+			SetStoragePoliciesID(int(f.PolicyID)).
+			AddEntities(entity)
+
+		if thumbnail != nil {
+			stm.AddEntities(thumbnail)
 		}
 
-		offset += batchSize
-		m.state.FileOffset = offset
-		if err := m.saveState(); err != nil {
-			m.l.Warning("Failed to save state after file batch: %s", err)
-		} else {
-			m.l.Info("Saved migration state after processing this batch")
+		if _, err := stm.Save(ctx); err != nil {
+			rollback()
+			if ent.IsConstraintError(err) {
+				return &f, nil
+			}
+			return nil, fmt.Errorf("failed to create file %d: %w", f.ID, err)
 		}
 	}
 
-	return nil
+	if err := tx.Commit(); err != nil {
+		rollback()
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil, nil
 }
 
-func (m *Migrator) insertEntity(tx *ent.Tx, source string, entityType, policyID, createdBy int, size int64) (*ent.Entity, error) {
+func entityKey(policyID int, source string) string {
+	return strconv.Itoa(policyID) + "+" + source
+}
 
+// insertEntity creates an entity for given blob, or references the existing one created before.
+func (m *Migrator) insertEntity(tx *ent.Tx, source string, entityType, policyID, createdBy int, size int64) (*ent.Entity, bool, error) {
 	// find existing one
-	entityKey := strconv.Itoa(policyID) + "+" + source
-	if existingId, ok := m.state.EntitySources[entityKey]; ok {
+	key := entityKey(policyID, source)
+	m.mu.Lock()
+	existingId, ok := m.state.EntitySources[key]
+	m.mu.Unlock()
+	if ok {
 		existing, err := tx.Entity.UpdateOneID(existingId).
 			AddReferenceCount(1).
 			Save(context.Background())
 		if err == nil {
-			return existing, nil
+			return existing, false, nil
 		}
 		m.l.Warning("Failed to update existing entity %d: %s, fallback to create new one.", existingId, err)
 	}
@@ -181,9 +235,11 @@ func (m *Migrator) insertEntity(tx *ent.Tx, source string, entityType, policyID,
 		SetReferenceCount(1).
 		Save(context.Background())
 	if err != nil {
-		return nil, fmt.Errorf("failed to create new entity: %w", err)
+		return nil, false, fmt.Errorf("failed to create new entity: %w", err)
 	}
 
-	m.state.EntitySources[entityKey] = e.ID
-	return e, nil
+	m.mu.Lock()
+	m.state.EntitySources[key] = e.ID
+	m.mu.Unlock()
+	return e, true, nil
 }
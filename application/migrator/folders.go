@@ -5,43 +5,18 @@ import (
 	"fmt"
 
 	"github.com/cloudreve/Cloudreve/v4/application/migrator/model"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 )
 
 func (m *Migrator) migrateFolders() error {
 	m.l.Info("Migrating folders...")
-	batchSize := 1000
-	// Start from the saved offset if available
-	offset := m.state.FolderOffset
-	ctx := context.Background()
 	foldersCount := 0
 
-	if m.state.FolderIDs == nil {
-		m.state.FolderIDs = make(map[int]bool)
-	}
-
-	if offset > 0 {
-		m.l.Info("Resuming folder migration from offset %d", offset)
-	}
-
-	for {
-		m.l.Info("Migrating folders with offset %d", offset)
-		var folders []model.Folder
-		if err := model.DB.Limit(batchSize).Offset(offset).Find(&folders).Error; err != nil {
-			return fmt.Errorf("failed to list v3 folders: %w", err)
-		}
-
-		if len(folders) == 0 {
-			break
-		}
-
-		tx, err := m.v4client.Tx(ctx)
-		if err != nil {
-			_ = tx.Rollback()
-			return fmt.Errorf("failed to start transaction: %w", err)
-		}
-
-		batchFoldersCount := 0
+	err := migrateBatches(m, "folders", &m.state.FolderOffset, nil, func(ctx context.Context, folders []model.Folder) error {
+		builders := make([]*ent.FileCreate, 0, len(folders))
+		ids := make([]int, 0, len(folders))
 		for _, f := range folders {
 			if _, ok := m.state.UserIDs[int(f.OwnerID)]; !ok {
 				m.l.Warning("Owner ID %d not found, skipping folder %d", f.OwnerID, f.ID)
@@ -56,38 +31,37 @@ func (m *Migrator) migrateFolders() error {
 				continue
 			}
 
-			stm := tx.File.Create().
+			builders = append(builders, m.v4client.File.Create().
 				SetRawID(int(f.ID)).
 				SetType(int(types.FileTypeFolder)).
 				SetCreatedAt(formatTime(f.CreatedAt)).
 				SetUpdatedAt(formatTime(f.UpdatedAt)).
 				SetName(f.Name).
-				SetOwnerID(int(f.OwnerID))
+				SetOwnerID(int(f.OwnerID)))
+			ids = append(ids, int(f.ID))
+		}
 
-			if _, err := stm.Save(ctx); err != nil {
-				_ = tx.Rollback()
-				return fmt.Errorf("failed to create folder %d: %w", f.ID, err)
+		if len(builders) > 0 {
+			// Folders are created without parent, so only folders committed by an interrupted round conflict.
+			if err := m.v4client.File.CreateBulk(builders...).OnConflictColumns(file.FieldID).DoNothing().Exec(ctx); err != nil {
+				return fmt.Errorf("failed to create folders from %d: %w", folders[0].ID, err)
 			}
-
-			m.state.FolderIDs[int(f.ID)] = true
-			m.state.LastFolderID = int(f.ID)
-
-			foldersCount++
-			batchFoldersCount++
 		}
 
-		if err := tx.Commit(); err != nil {
-			return fmt.Errorf("failed to commit transaction: %w", err)
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		for _, id := range ids {
+			m.state.FolderIDs[id] = true
+			// File IDs are offset by the largest folder ID.
+			m.state.LastFolderID = max(m.state.LastFolderID, id)
 		}
 
-		// Update the offset in state and save after each batch
-		offset += batchSize
-		m.state.FolderOffset = offset
-		if err := m.saveState(); err != nil {
-			m.l.Warning("Failed to save state after folder batch: %s", err)
-		} else {
-			m.l.Info("Saved migration state after processing %d folders in this batch", batchFoldersCount)
-		}
+		foldersCount += len(ids)
+		m.l.Info("Migrated %d folders in this batch", len(ids))
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	m.l.Info("Successfully migrated %d folders", foldersCount)
@@ -96,24 +70,10 @@ func (m *Migrator) migrateFolders() error {
 
 func (m *Migrator) migrateFolderParent() error {
 	m.l.Info("Migrating folder parent...")
-	batchSize := 1000
-	offset := m.state.FolderParentOffset
-	ctx := context.Background()
-
-	for {
-		m.l.Info("Migrating folder parent with offset %d", offset)
-		var folderParents []model.Folder
-		if err := model.DB.Limit(batchSize).Offset(offset).Find(&folderParents).Error; err != nil {
-			return fmt.Errorf("failed to list v3 folder parents: %w", err)
-		}
-
-		if len(folderParents) == 0 {
-			break
-		}
 
+	return migrateBatches(m, "folder parents", &m.state.FolderParentOffset, nil, func(ctx context.Context, folderParents []model.Folder) error {
 		tx, err := m.v4client.Tx(ctx)
 		if err != nil {
-			_ = tx.Rollback()
 			return fmt.Errorf("failed to start transaction: %w", err)
 		}
 
@@ -124,6 +84,10 @@ func (m *Migrator) migrateFolderParent() error {
 					continue
 				}
 
+				if _, ok := m.state.FolderIDs[int(f.ID)]; !ok {
+					continue
+				}
+
 				if _, err := tx.File.UpdateOneID(int(f.ID)).SetParentID(int(*f.ParentID)).Save(ctx); err != nil {
 					_ = tx.Rollback()
 					return fmt.Errorf("failed to update folder parent %d: %w", f.ID, err)
@@ -135,13 +99,6 @@ func (m *Migrator) migrateFolderParent() error {
 			return fmt.Errorf("failed to commit transaction: %w", err)
 		}
 
-		// Update the offset in state and save after each batch
-		offset += batchSize
-		m.state.FolderParentOffset = offset
-		if err := m.saveState(); err != nil {
-			m.l.Warning("Failed to save state after folder parent batch: %s", err)
-		}
-	}
-
-	return nil
+		return nil
+	})
 }
@@ -38,6 +38,10 @@ func (m *Migrator) migrateGroup() error {
 			_, exist := m.state.PolicyIDs[id]
 			return exist
 		})
+		if len(policies) > 1 {
+			// v4 groups are bound to only one storage policy.
+			m.l.Warning("Group %q has multiple storage policies %v, only the first one %d is kept.", group.Name, policies, policies[0])
+		}
 
 		newOpts := &types.GroupSetting{
 			CompressSize:          int64(opts.CompressSize),
@@ -3,9 +3,12 @@ package migrator
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
@@ -26,6 +29,7 @@ type State struct {
 	EntitySources      map[string]int  `json:"entity_sources,omitempty"`
 	LastFolderID       int             `json:"last_folder_id,omitempty"`
 	Step               int             `json:"step,omitempty"`
+	CompletedSteps     map[int]bool    `json:"completed_steps,omitempty"`
 	UserOffset         int             `json:"user_offset,omitempty"`
 	FolderOffset       int             `json:"folder_offset,omitempty"`
 	FileOffset         int             `json:"file_offset,omitempty"`
@@ -68,15 +72,19 @@ type Migrator struct {
 	v4client  *ent.Client
 	state     *State
 	statePath string
+	dryRun    bool
+	workers   int
+	// mu guards state, which is shared by concurrent steps and batches.
+	mu       sync.Mutex
+	progress atomic.Pointer[progressTracker]
 }
 
 func NewMigrator(dep dependency.Dep, v3ConfPath string) (*Migrator, error) {
 	m := &Migrator{
-		dep: dep,
-		l:   dep.Logger(),
+		dep:     dep,
+		l:       dep.Logger(),
+		workers: 1,
 		state: &State{
-			PolicyIDs:    make(map[int]bool),
-			UserIDs:      make(map[int]bool),
 			Step:         StepInitial,
 			UserOffset:   0,
 			FolderOffset: 0,
@@ -112,6 +120,14 @@ func NewMigrator(dep dependency.Dep, v3ConfPath string) (*Migrator, error) {
 			stepName = "user migration"
 		case StepFolders:
 			stepName = "folders migration"
+		case StepFolderParent:
+			stepName = "folder parent migration"
+		case StepFile:
+			stepName = "file migration"
+		case StepShare:
+			stepName = "share migration"
+		case StepDirectLink:
+			stepName = "direct link migration"
 		case StepCompleted:
 			stepName = "completed"
 		case StepWebdav:
@@ -132,6 +148,7 @@ func NewMigrator(dep dependency.Dep, v3ConfPath string) (*Migrator, error) {
 		}
 	}
 
+	m.initState()
 	err := conf.Init(m.dep.Logger(), v3ConfPath)
 	if err != nil {
 		return nil, err
@@ -151,9 +168,56 @@ func NewMigrator(dep dependency.Dep, v3ConfPath string) (*Migrator, error) {
 	return m, nil
 }
 
+// NewDryRunMigrator creates a migrator that only reads the v3 database. Neither the v4 database
+// nor the migration state file is touched.
+func NewDryRunMigrator(dep dependency.Dep, v3ConfPath string) (*Migrator, error) {
+	m := &Migrator{
+		dep:    dep,
+		l:      dep.Logger(),
+		state:  &State{},
+		dryRun: true,
+	}
+
+	if err := conf.Init(m.dep.Logger(), v3ConfPath); err != nil {
+		return nil, err
+	}
+
+	if err := model.Init(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// initState creates missing maps of state, and converts state of sequential migration into completed steps.
+func (m *Migrator) initState() {
+	if m.state.CompletedSteps == nil {
+		m.state.CompletedSteps = make(map[int]bool)
+		for step := StepSchema; step < m.state.Step; step++ {
+			m.state.CompletedSteps[step] = true
+		}
+	}
+
+	for _, ids := range []*map[int]bool{&m.state.PolicyIDs, &m.state.LocalPolicyIDs, &m.state.UserIDs, &m.state.FolderIDs} {
+		if *ids == nil {
+			*ids = make(map[int]bool)
+		}
+	}
+
+	if m.state.EntitySources == nil {
+		m.state.EntitySources = make(map[string]int)
+	}
+
+	if m.state.FileConflictRename == nil {
+		m.state.FileConflictRename = make(map[uint]string)
+	}
+}
+
 // saveState persists migration state to file
 func (m *Migrator) saveState() error {
+	m.mu.Lock()
 	data, err := json.Marshal(m.state)
+	m.mu.Unlock()
 	if err != nil {
 		return fmt.Errorf("failed to marshal state: %w", err)
 	}
@@ -171,140 +235,131 @@ func (m *Migrator) loadState() error {
 	return json.Unmarshal(data, m.state)
 }
 
-// updateStep updates current step and persists state
-func (m *Migrator) updateStep(step int) error {
-	m.state.Step = step
-	return m.saveState()
-}
-
-func (m *Migrator) Migrate() error {
-	// Continue from the current step
-	if m.state.Step <= StepSchema {
-		m.l.Info("Creating basic v4 table schema...")
-		if err := m.v4client.Schema.Create(context.Background()); err != nil {
-			return fmt.Errorf("failed creating schema resources: %w", err)
-		}
-		if err := m.updateStep(StepSettings); err != nil {
-			return fmt.Errorf("failed to update step: %w", err)
-		}
-	}
-
-	if m.state.Step <= StepSettings {
-		if err := m.migrateSettings(); err != nil {
-			return err
-		}
-		if err := m.updateStep(StepNode); err != nil {
-			return fmt.Errorf("failed to update step: %w", err)
-		}
-	}
-
-	if m.state.Step <= StepNode {
-		if err := m.migrateNode(); err != nil {
-			return err
-		}
-		if err := m.updateStep(StepPolicy); err != nil {
-			return fmt.Errorf("failed to update step: %w", err)
+// completeStep marks given step as completed and persists state
+func (m *Migrator) completeStep(step int) error {
+	m.mu.Lock()
+	m.state.CompletedSteps[step] = true
+	m.state.Step = StepCompleted
+	for _, s := range m.steps() {
+		if !m.state.CompletedSteps[s.step] {
+			m.state.Step = s.step
+			break
 		}
 	}
+	m.mu.Unlock()
+	return m.saveState()
+}
 
-	if m.state.Step <= StepPolicy {
-		allPolicyIDs, err := m.migratePolicy()
-		if err != nil {
-			return err
-		}
-		m.state.PolicyIDs = allPolicyIDs
-		if err := m.updateStep(StepGroup); err != nil {
-			return fmt.Errorf("failed to update step: %w", err)
-		}
-	}
+// migrationStep is a migration phase that starts once all its dependencies are completed.
+type migrationStep struct {
+	step int
+	name string
+	deps []int
+	run  func() error
+}
 
-	if m.state.Step <= StepGroup {
-		if err := m.migrateGroup(); err != nil {
-			return err
-		}
-		if err := m.updateStep(StepUser); err != nil {
-			return fmt.Errorf("failed to update step: %w", err)
-		}
+func (m *Migrator) steps() []migrationStep {
+	return []migrationStep{
+		{StepSchema, "schema creation", nil, m.createSchema},
+		{StepSettings, "settings migration", []int{StepSchema}, m.migrateSettings},
+		{StepNode, "node migration", []int{StepSchema}, m.migrateNode},
+		// Slave nodes created for remote policies must not take IDs of migrated nodes.
+		{StepPolicy, "policy migration", []int{StepNode}, m.migratePolicy},
+		{StepGroup, "group migration", []int{StepPolicy}, m.migrateGroup},
+		{StepUser, "user migration", []int{StepGroup}, m.migrateUser},
+		{StepFolders, "folders migration", []int{StepUser}, m.migrateFolders},
+		{StepFolderParent, "folder parent migration", []int{StepFolders}, m.migrateFolderParent},
+		// Thumbnail suffix is read from settings.
+		{StepFile, "file migration", []int{StepFolderParent, StepSettings}, m.migrateFile},
+		{StepShare, "share migration", []int{StepFile}, m.migrateShare},
+		{StepDirectLink, "direct link migration", []int{StepFile}, m.migrateDirectLink},
+		// Avatar path is read from settings.
+		{StepAvatar, "avatar migration", []int{StepUser, StepSettings}, func() error { return migrateAvatars(m) }},
+		{StepWebdav, "webdav migration", []int{StepUser}, m.migrateWebdav},
 	}
+}
 
-	if m.state.Step <= StepUser {
-		if err := m.migrateUser(); err != nil {
-			m.saveState()
-			return err
-		}
-		// Reset user offset after completion
-		m.state.UserOffset = 0
-		if err := m.updateStep(StepFolders); err != nil {
-			return fmt.Errorf("failed to update step: %w", err)
-		}
+// Migrate runs all migration steps. Independent steps run concurrently, at most one step per worker.
+func (m *Migrator) Migrate() error {
+	if m.dryRun {
+		return fmt.Errorf("migrator is created in dry-run mode")
 	}
 
-	if m.state.Step <= StepFolders {
-		if err := m.migrateFolders(); err != nil {
-			m.saveState()
-			return err
-		}
-		// Reset folder offset after completion
-		m.state.FolderOffset = 0
-		if err := m.updateStep(StepFolderParent); err != nil {
-			return fmt.Errorf("failed to update step: %w", err)
-		}
+	if err := m.initProgress(); err != nil {
+		return err
 	}
+	progress := m.progress.Load()
 
-	if m.state.Step <= StepFolderParent {
-		if err := m.migrateFolderParent(); err != nil {
-			return err
-		}
-		if err := m.updateStep(StepFile); err != nil {
-			return fmt.Errorf("failed to update step: %w", err)
-		}
+	steps := m.steps()
+	done := make(map[int]chan struct{}, len(steps))
+	for _, s := range steps {
+		done[s.step] = make(chan struct{})
 	}
 
-	if m.state.Step <= StepFile {
-		if err := m.migrateFile(); err != nil {
-			return err
-		}
-		if err := m.updateStep(StepShare); err != nil {
-			return fmt.Errorf("failed to update step: %w", err)
-		}
+	var (
+		wg       sync.WaitGroup
+		failOnce sync.Once
+		errs     = make([]error, len(steps))
+		failed   = make(chan struct{})
+		sem      = make(chan struct{}, m.workers)
+	)
+	for i, s := range steps {
+		wg.Add(1)
+		go func(i int, s migrationStep) {
+			defer wg.Done()
+			for _, dep := range s.deps {
+				select {
+				case <-done[dep]:
+				case <-failed:
+					return
+				}
+			}
+
+			m.mu.Lock()
+			completed := m.state.CompletedSteps[s.step]
+			m.mu.Unlock()
+			if !completed {
+				sem <- struct{}{}
+				progress.setStep(s.name, ProgressStatusRunning)
+				err := s.run()
+				if err == nil {
+					err = m.completeStep(s.step)
+				}
+				<-sem
+
+				if err != nil {
+					progress.setStep(s.name, ProgressStatusFailed)
+					errs[i] = fmt.Errorf("%s failed: %w", s.name, err)
+					failOnce.Do(func() { close(failed) })
+					return
+				}
+				progress.setStep(s.name, ProgressStatusCompleted)
+			}
+
+			close(done[s.step])
+		}(i, s)
 	}
 
-	if m.state.Step <= StepShare {
-		if err := m.migrateShare(); err != nil {
-			return err
-		}
-		if err := m.updateStep(StepDirectLink); err != nil {
-			return fmt.Errorf("failed to update step: %w", err)
+	wg.Wait()
+	err := errors.Join(errs...)
+	progress.finish(err)
+	if err != nil {
+		if saveErr := m.saveState(); saveErr != nil {
+			m.l.Warning("Failed to save state: %s", saveErr)
 		}
+		return err
 	}
 
-	if m.state.Step <= StepDirectLink {
-		if err := m.migrateDirectLink(); err != nil {
-			return err
-		}
-		if err := m.updateStep(StepAvatar); err != nil {
-			return fmt.Errorf("failed to update step: %w", err)
-		}
-	}
+	m.l.Info("Migration completed successfully")
+	return nil
+}
 
-	if m.state.Step <= StepAvatar {
-		if err := migrateAvatars(m); err != nil {
-			return err
-		}
-		if err := m.updateStep(StepWebdav); err != nil {
-			return fmt.Errorf("failed to update step: %w", err)
-		}
+func (m *Migrator) createSchema() error {
+	m.l.Info("Creating basic v4 table schema...")
+	if err := m.v4client.Schema.Create(context.Background()); err != nil {
+		return fmt.Errorf("failed creating schema resources: %w", err)
 	}
 
-	if m.state.Step <= StepWebdav {
-		if err := m.migrateWebdav(); err != nil {
-			return err
-		}
-		if err := m.updateStep(StepCompleted); err != nil {
-			return fmt.Errorf("failed to update step: %w", err)
-		}
-	}
-	m.l.Info("Migration completed successfully")
 	return nil
 }
 
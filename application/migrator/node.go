@@ -51,6 +51,7 @@ func (m *Migrator) migrateNode() error {
 				}
 			}
 
+			settings.Interval = aria2Options.Interval
 			settings.Aria2Setting = &types.Aria2Setting{
 				Server:   aria2Options.Server,
 				Token:    aria2Options.Token,
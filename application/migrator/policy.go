@@ -16,19 +16,11 @@ import (
 	"github.com/samber/lo"
 )
 
-func (m *Migrator) migratePolicy() (map[int]bool, error) {
+func (m *Migrator) migratePolicy() error {
 	m.l.Info("Migrating storage policies...")
 	var policies []model.Policy
 	if err := model.DB.Find(&policies).Error; err != nil {
-		return nil, fmt.Errorf("failed to list v3 storage policies: %w", err)
-	}
-
-	if m.state.LocalPolicyIDs == nil {
-		m.state.LocalPolicyIDs = make(map[int]bool)
-	}
-
-	if m.state.PolicyIDs == nil {
-		m.state.PolicyIDs = make(map[int]bool)
+		return fmt.Errorf("failed to list v3 storage policies: %w", err)
 	}
 
 	m.l.Info("Found %d v3 storage policies to be migrated.", len(policies))
@@ -45,7 +37,7 @@ func (m *Migrator) migratePolicy() (map[int]bool, error) {
 
 	tx, err := m.v4client.Tx(context.Background())
 	if err != nil {
-		return nil, fmt.Errorf("failed to start transaction: %w", err)
+		return fmt.Errorf("failed to start transaction: %w", err)
 	}
 
 	for _, s := range thumbProxySettings {
@@ -61,7 +53,7 @@ func (m *Migrator) migratePolicy() (map[int]bool, error) {
 	for _, policy := range policies {
 		m.l.Info("Migrating storage policy %q...", policy.Name)
 		if err := json.Unmarshal([]byte(policy.Options), &policy.OptionsSerialized); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal options for policy %q: %w", policy.Name, err)
+			return fmt.Errorf("failed to unmarshal options for policy %q: %w", policy.Name, err)
 		}
 
 		settings := &types.PolicySetting{
@@ -159,23 +151,25 @@ func (m *Migrator) migratePolicy() (map[int]bool, error) {
 				}).
 				Save(context.Background())
 			if err != nil {
-				return nil, fmt.Errorf("failed to create node for storage policy %q: %w", policy.Name, err)
+				return fmt.Errorf("failed to create node for storage policy %q: %w", policy.Name, err)
 			}
 
 			stm.SetNodeID(n.ID)
 		}
 
 		if _, err := stm.Save(context.Background()); err != nil {
-			return nil, fmt.Errorf("failed to create storage policy %q: %w", policy.Name, err)
+			return fmt.Errorf("failed to create storage policy %q: %w", policy.Name, err)
 		}
 
+		m.mu.Lock()
 		m.state.PolicyIDs[int(policy.ID)] = true
 		if policy.Type == types.PolicyTypeLocal {
 			m.state.LocalPolicyIDs[int(policy.ID)] = true
 		}
+		m.mu.Unlock()
 	}
 	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
 	if m.dep.ConfigProvider().Database().Type == conf.PostgresDB {
@@ -188,5 +182,5 @@ func (m *Migrator) migratePolicy() (map[int]bool, error) {
 		m.v4client.Node.ExecContext(context.Background(), "SELECT SETVAL('nodes_id_seq',  (SELECT MAX(id) FROM nodes))")
 	}
 
-	return m.state.PolicyIDs, nil
+	return nil
 }
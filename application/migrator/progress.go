@@ -0,0 +1,271 @@
+package migrator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/migrator/model"
+)
+
+const (
+	// SummaryFileName is the default file name of migration summary, placed next to v3 config file.
+	SummaryFileName = "migration_summary.json"
+
+	ProgressStatusPending   = "pending"
+	ProgressStatusRunning   = "running"
+	ProgressStatusCompleted = "completed"
+	ProgressStatusSkipped   = "skipped"
+	ProgressStatusFailed    = "failed"
+)
+
+type (
+	// Progress is a snapshot of migration progress.
+	Progress struct {
+		Status     string          `json:"status"`
+		Error      string          `json:"error,omitempty"`
+		StartedAt  time.Time       `json:"started_at"`
+		FinishedAt *time.Time      `json:"finished_at,omitempty"`
+		Steps      []StepProgress  `json:"steps"`
+		Tables     []TableProgress `json:"tables"`
+		// Done and Total are the number of v3 rows migrated and to be migrated by batched steps.
+		Done  int64 `json:"done"`
+		Total int64 `json:"total"`
+		// Rate is the number of rows migrated per second in this run.
+		Rate float64 `json:"rate"`
+		// ETA is the estimated remaining time in seconds, -1 if unknown.
+		ETA int64 `json:"eta"`
+	}
+
+	StepProgress struct {
+		Name   string `json:"name"`
+		Status string `json:"status"`
+	}
+
+	TableProgress struct {
+		Name    string  `json:"name"`
+		Running bool    `json:"running"`
+		Done    int64   `json:"done"`
+		Total   int64   `json:"total"`
+		Rate    float64 `json:"rate"`
+		ETA     int64   `json:"eta"`
+	}
+
+	// progressTracker collects progress of concurrent steps and batches.
+	progressTracker struct {
+		mu         sync.Mutex
+		startedAt  time.Time
+		finishedAt *time.Time
+		err        error
+		steps      []StepProgress
+		tables     []*tableTracker
+	}
+
+	tableTracker struct {
+		name      string
+		running   bool
+		done      int64
+		total     int64
+		resumed   int64
+		startedAt time.Time
+	}
+)
+
+// progressTables are v3 tables migrated in batches, with the step migrating them.
+var progressTables = []struct {
+	step  int
+	name  string
+	model any
+}{
+	{StepUser, "users", &model.User{}},
+	{StepFolders, "folders", &model.Folder{}},
+	{StepFolderParent, "folder parents", &model.Folder{}},
+	{StepFile, "files", &model.File{}},
+	{StepShare, "shares", &model.Share{}},
+	{StepDirectLink, "direct links", &model.SourceLink{}},
+	{StepWebdav, "webdav accounts", &model.Webdav{}},
+}
+
+// initProgress counts v3 rows to be migrated, tables of completed steps are counted as done.
+func (m *Migrator) initProgress() error {
+	p := &progressTracker{startedAt: time.Now()}
+	for _, s := range m.steps() {
+		status := ProgressStatusPending
+		if m.state.CompletedSteps[s.step] {
+			status = ProgressStatusSkipped
+		}
+		p.steps = append(p.steps, StepProgress{Name: s.name, Status: status})
+	}
+
+	for _, t := range progressTables {
+		var total int64
+		if err := model.DB.Model(t.model).Count(&total).Error; err != nil {
+			return fmt.Errorf("failed to count v3 %s: %w", t.name, err)
+		}
+
+		table := &tableTracker{name: t.name, total: total}
+		if m.state.CompletedSteps[t.step] {
+			table.done = total
+			table.resumed = total
+		}
+		p.tables = append(p.tables, table)
+	}
+
+	m.progress.Store(p)
+	return nil
+}
+
+// Progress returns a snapshot of migration progress, nil if migration is not started.
+func (m *Migrator) Progress() *Progress {
+	p := m.progress.Load()
+	if p == nil {
+		return nil
+	}
+
+	return p.snapshot()
+}
+
+// StatusHandler serves migration progress as JSON.
+func (m *Migrator) StatusHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		progress := m.Progress()
+		if progress == nil {
+			http.Error(w, "migration is not started", http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(progress)
+	})
+}
+
+// Save writes progress as JSON into given path.
+func (p *Progress) Save(path string) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal summary: %w", err)
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+func (p *progressTracker) setStep(name, status string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i := range p.steps {
+		if p.steps[i].Name == name {
+			p.steps[i].Status = status
+		}
+	}
+}
+
+// startTable marks table as running, rows before offset are migrated in previous runs.
+func (p *progressTracker) startTable(name string, offset int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if t := p.table(name); t != nil {
+		t.running = true
+		t.done = int64(offset)
+		t.resumed = int64(offset)
+		t.startedAt = time.Now()
+	}
+}
+
+func (p *progressTracker) advanceTable(name string, rows int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if t := p.table(name); t != nil {
+		t.done += int64(rows)
+	}
+}
+
+func (p *progressTracker) finishTable(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if t := p.table(name); t != nil {
+		t.running = false
+		// Rows inserted during migration are migrated as well.
+		t.total = max(t.total, t.done)
+	}
+}
+
+func (p *progressTracker) finish(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := time.Now()
+	p.finishedAt = &now
+	p.err = err
+}
+
+func (p *progressTracker) table(name string) *tableTracker {
+	for _, t := range p.tables {
+		if t.name == name {
+			return t
+		}
+	}
+
+	return nil
+}
+
+func (p *progressTracker) snapshot() *Progress {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	res := &Progress{
+		Status:     ProgressStatusRunning,
+		StartedAt:  p.startedAt,
+		FinishedAt: p.finishedAt,
+		Steps:      append([]StepProgress{}, p.steps...),
+		Tables:     make([]TableProgress, 0, len(p.tables)),
+		ETA:        -1,
+	}
+
+	if p.finishedAt != nil {
+		res.Status = ProgressStatusCompleted
+		if p.err != nil {
+			res.Status = ProgressStatusFailed
+			res.Error = p.err.Error()
+		}
+	}
+
+	end := time.Now()
+	if p.finishedAt != nil {
+		end = *p.finishedAt
+	}
+
+	var migrated int64
+	for _, t := range p.tables {
+		table := TableProgress{Name: t.name, Running: t.running, Done: t.done, Total: max(t.total, t.done), ETA: -1}
+		if !t.startedAt.IsZero() {
+			table.Rate = rate(t.done-t.resumed, end.Sub(t.startedAt))
+		}
+		if table.Rate > 0 {
+			table.ETA = int64(float64(table.Total-table.Done) / table.Rate)
+		}
+
+		res.Tables = append(res.Tables, table)
+		res.Done += table.Done
+		res.Total += table.Total
+		migrated += t.done - t.resumed
+	}
+
+	res.Rate = rate(migrated, end.Sub(p.startedAt))
+	if res.Done == res.Total {
+		res.ETA = 0
+	} else if res.Rate > 0 {
+		res.ETA = int64(float64(res.Total-res.Done) / res.Rate)
+	}
+
+	return res
+}
+
+func rate(rows int64, elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return 0
+	}
+
+	return float64(rows) / elapsed.Seconds()
+}
@@ -158,12 +158,14 @@ func (m *Migrator) migrateSettings() error {
 
 	migratedSettings := make([]settingMigrated, 0)
 	for _, s := range settings {
+		m.mu.Lock()
 		if s.Name == "thumb_file_suffix" {
 			m.state.ThumbSuffix = s.Value
 		}
 		if s.Name == "avatar_path" {
 			m.state.V3AvatarPath = s.Value
 		}
+		m.mu.Unlock()
 		migrator, ok := migrators[s.Name]
 		if ok {
 			newSettings, err := migrator(allSettings, s.Name, s.Value)
@@ -5,50 +5,36 @@ import (
 	"fmt"
 
 	"github.com/cloudreve/Cloudreve/v4/application/migrator/model"
+	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/share"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/samber/lo"
 )
 
 func (m *Migrator) migrateShare() error {
 	m.l.Info("Migrating shares...")
-	batchSize := 1000
-	offset := m.state.ShareOffset
 	ctx := context.Background()
 
-	if offset > 0 {
-		m.l.Info("Resuming share migration from offset %d", offset)
-	}
-
-	for {
-		m.l.Info("Migrating shares with offset %d", offset)
-		var shares []model.Share
-		if err := model.DB.Limit(batchSize).Offset(offset).Find(&shares).Error; err != nil {
-			return fmt.Errorf("failed to list v3 shares: %w", err)
-		}
-
-		if len(shares) == 0 {
-			if m.dep.ConfigProvider().Database().Type == conf.PostgresDB {
-				m.l.Info("Resetting share ID sequence for postgres...")
-				m.v4client.Share.ExecContext(ctx, "SELECT SETVAL('shares_id_seq',  (SELECT MAX(id) FROM shares))")
+	err := migrateBatches(m, "shares", &m.state.ShareOffset, nil, func(ctx context.Context, shares []model.Share) error {
+		sourceIds := lo.Map(shares, func(s model.Share, _ int) int {
+			if s.IsDir {
+				return int(s.SourceID)
 			}
-			break
-		}
+			return int(s.SourceID) + m.state.LastFolderID
+		})
 
-		tx, err := m.v4client.Tx(ctx)
+		// check if file exists
+		existing, err := m.v4client.File.Query().Where(file.IDIn(sourceIds...)).IDs(ctx)
 		if err != nil {
-			_ = tx.Rollback()
-			return fmt.Errorf("failed to start transaction: %w", err)
+			return fmt.Errorf("failed to query share files: %w", err)
 		}
+		existingIDs := lo.SliceToMap(existing, func(id int) (int, bool) { return id, true })
 
-		for _, s := range shares {
-			sourceId := int(s.SourceID)
-			if !s.IsDir {
-				sourceId += m.state.LastFolderID
-			}
-
-			// check if file exists
-			_, err = tx.File.Query().Where(file.ID(sourceId)).First(ctx)
-			if err != nil {
+		builders := make([]*ent.ShareCreate, 0, len(shares))
+		for i, s := range shares {
+			sourceId := sourceIds[i]
+			if !existingIDs[sourceId] {
 				m.l.Warning("File %d not found, skipping share %d", sourceId, s.ID)
 				continue
 			}
@@ -59,7 +45,8 @@ func (m *Migrator) migrateShare() error {
 				continue
 			}
 
-			stm := tx.Share.Create().
+			// Share keys are hash IDs of raw ID with the migrated hash_id_salt, so existing links keep working.
+			stm := m.v4client.Share.Create().
 				SetCreatedAt(formatTime(s.CreatedAt)).
 				SetUpdatedAt(formatTime(s.UpdatedAt)).
 				SetViews(s.Views).
@@ -80,23 +67,28 @@ func (m *Migrator) migrateShare() error {
 				stm.SetRemainDownloads(s.RemainDownloads)
 			}
 
-			if _, err := stm.Save(ctx); err != nil {
-				_ = tx.Rollback()
-				return fmt.Errorf("failed to create share %d: %w", s.ID, err)
-			}
+			builders = append(builders, stm)
 		}
 
-		if err := tx.Commit(); err != nil {
-			return fmt.Errorf("failed to commit transaction: %w", err)
+		if len(builders) == 0 {
+			return nil
 		}
 
-		offset += batchSize
-		m.state.ShareOffset = offset
-		if err := m.saveState(); err != nil {
-			m.l.Warning("Failed to save state after share batch: %s", err)
-		} else {
-			m.l.Info("Saved migration state after processing this batch")
+		// Shares committed by an interrupted round already exist.
+		if err := m.v4client.Share.CreateBulk(builders...).OnConflictColumns(share.FieldID).DoNothing().Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create shares from %d: %w", shares[0].ID, err)
 		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
+
+	if m.dep.ConfigProvider().Database().Type == conf.PostgresDB {
+		m.l.Info("Resetting share ID sequence for postgres...")
+		m.v4client.Share.ExecContext(ctx, "SELECT SETVAL('shares_id_seq',  (SELECT MAX(id) FROM shares))")
+	}
+
 	return nil
 }
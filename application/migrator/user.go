@@ -3,7 +3,9 @@ package migrator
 import (
 	"context"
 	"fmt"
+
 	"github.com/cloudreve/Cloudreve/v4/application/migrator/model"
+	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
@@ -11,40 +13,15 @@ import (
 
 func (m *Migrator) migrateUser() error {
 	m.l.Info("Migrating users...")
-	batchSize := 1000
-	// Start from the saved offset if available
-	offset := m.state.UserOffset
 	ctx := context.Background()
-	if m.state.UserIDs == nil {
-		m.state.UserIDs = make(map[int]bool)
-	}
 
 	// If we're resuming, load existing user IDs
 	if len(m.state.UserIDs) > 0 {
-		m.l.Info("Resuming user migration from offset %d, %d users already migrated", offset, len(m.state.UserIDs))
+		m.l.Info("Resuming user migration from offset %d, %d users already migrated", m.state.UserOffset, len(m.state.UserIDs))
 	}
 
-	for {
-		m.l.Info("Migrating users with offset %d", offset)
-		var users []model.User
-		if err := model.DB.Limit(batchSize).Offset(offset).Find(&users).Error; err != nil {
-			return fmt.Errorf("failed to list v3 users: %w", err)
-		}
-
-		if len(users) == 0 {
-			if m.dep.ConfigProvider().Database().Type == conf.PostgresDB {
-				m.l.Info("Resetting user ID sequence for postgres...")
-				m.v4client.User.ExecContext(ctx, "SELECT SETVAL('users_id_seq',  (SELECT MAX(id) FROM users))")
-			}
-			break
-		}
-
-		tx, err := m.v4client.Tx(context.Background())
-		if err != nil {
-			_ = tx.Rollback()
-			return fmt.Errorf("failed to start transaction: %w", err)
-		}
-
+	err := migrateBatches(m, "users", &m.state.UserOffset, nil, func(ctx context.Context, users []model.User) error {
+		builders := make([]*ent.UserCreate, 0, len(users))
 		for _, u := range users {
 			userStatus := user.StatusActive
 			switch u.Status {
@@ -63,7 +40,7 @@ func (m *Migrator) migrateUser() error {
 				VersionRetentionMax: 10,
 			}
 
-			stm := tx.User.Create().
+			stm := m.v4client.User.Create().
 				SetRawID(int(u.ID)).
 				SetCreatedAt(formatTime(u.CreatedAt)).
 				SetUpdatedAt(formatTime(u.UpdatedAt)).
@@ -83,26 +60,28 @@ func (m *Migrator) migrateUser() error {
 				stm.SetAvatar(u.Avatar)
 			}
 
-			if _, err := stm.Save(ctx); err != nil {
-				_ = tx.Rollback()
-				return fmt.Errorf("failed to create user %d: %w", u.ID, err)
-			}
-
-			m.state.UserIDs[int(u.ID)] = true
+			builders = append(builders, stm)
 		}
 
-		if err := tx.Commit(); err != nil {
-			return fmt.Errorf("failed to commit transaction: %w", err)
+		// Users committed by an interrupted round already exist.
+		if err := m.v4client.User.CreateBulk(builders...).OnConflictColumns(user.FieldID).DoNothing().Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create users from %d: %w", users[0].ID, err)
 		}
 
-		// Update the offset in state and save after each batch
-		offset += batchSize
-		m.state.UserOffset = offset
-		if err := m.saveState(); err != nil {
-			m.l.Warning("Failed to save state after user batch: %s", err)
-		} else {
-			m.l.Info("Saved migration state after processing %d users", offset)
+		m.mu.Lock()
+		for _, u := range users {
+			m.state.UserIDs[int(u.ID)] = true
 		}
+		m.mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if m.dep.ConfigProvider().Database().Type == conf.PostgresDB {
+		m.l.Info("Resetting user ID sequence for postgres...")
+		m.v4client.User.ExecContext(ctx, "SELECT SETVAL('users_id_seq',  (SELECT MAX(id) FROM users))")
 	}
 
 	return nil
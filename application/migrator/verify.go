@@ -0,0 +1,350 @@
+package migrator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/migrator/conf"
+	"github.com/cloudreve/Cloudreve/v4/application/migrator/model"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+)
+
+const (
+	// VerificationFileName is the default file name of verification report, placed next to v3 config file.
+	VerificationFileName = "migration_verification.json"
+	// DefaultVerifySamples is the default number of files whose blobs are read and hashed.
+	DefaultVerifySamples = 100
+
+	CheckUserCounts    = "user_counts"
+	CheckUserSizes     = "user_sizes"
+	CheckBlobChecksums = "blob_checksums"
+	CheckShareLinks    = "share_links"
+)
+
+type (
+	// VerificationReport is the result of comparing migrated v4 data with v3 database.
+	VerificationReport struct {
+		GeneratedAt time.Time            `json:"generated_at"`
+		Passed      bool                 `json:"passed"`
+		Checks      []*VerificationCheck `json:"checks"`
+	}
+
+	VerificationCheck struct {
+		Name    string `json:"name"`
+		Passed  bool   `json:"passed"`
+		Checked int64  `json:"checked"`
+		Failed  int64  `json:"failed"`
+		// Failures lists at most maxIssuesPerType failures, all of them are counted in Failed.
+		Failures []VerificationFailure `json:"failures"`
+	}
+
+	VerificationFailure struct {
+		// ID is the v3 ID of user, file or share failed the check.
+		ID      uint   `json:"id"`
+		Message string `json:"message"`
+	}
+
+	// userStat is the number of files and folders and total file size of one user.
+	userStat struct {
+		Files   int64
+		Folders int64
+		Size    int64
+	}
+)
+
+// Verify compares v3 database with migrated v4 data: file and folder counts and total sizes of each user,
+// checksums of blobs of randomly sampled files, and resolvability of share links. Migration must be
+// completed before verification.
+func (m *Migrator) Verify(samples int) (*VerificationReport, error) {
+	if m.state.Step != StepCompleted {
+		return nil, fmt.Errorf("migration is not completed yet")
+	}
+
+	ctx := context.Background()
+	counts := &VerificationCheck{Name: CheckUserCounts}
+	sizes := &VerificationCheck{Name: CheckUserSizes}
+	m.l.Info("Verifying file counts and sizes of users...")
+	if err := m.verifyUserStats(ctx, counts, sizes); err != nil {
+		return nil, err
+	}
+
+	blobs := &VerificationCheck{Name: CheckBlobChecksums}
+	m.l.Info("Verifying blobs of %d sampled files...", samples)
+	if err := m.verifyBlobs(ctx, samples, blobs); err != nil {
+		return nil, err
+	}
+
+	shares := &VerificationCheck{Name: CheckShareLinks}
+	m.l.Info("Verifying share links...")
+	if err := m.verifyShares(ctx, shares); err != nil {
+		return nil, err
+	}
+
+	report := &VerificationReport{
+		GeneratedAt: time.Now(),
+		Passed:      true,
+		Checks:      []*VerificationCheck{counts, sizes, blobs, shares},
+	}
+	for _, c := range report.Checks {
+		c.Passed = c.Failed == 0
+		report.Passed = report.Passed && c.Passed
+		if c.Failures == nil {
+			c.Failures = []VerificationFailure{}
+		}
+	}
+
+	return report, nil
+}
+
+// Save writes report as JSON into given path.
+func (r *VerificationReport) Save(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal report: %w", err)
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+func (c *VerificationCheck) fail(id uint, format string, args ...any) {
+	c.Failed++
+	if len(c.Failures) < maxIssuesPerType {
+		c.Failures = append(c.Failures, VerificationFailure{ID: id, Message: fmt.Sprintf(format, args...)})
+	}
+}
+
+func (m *Migrator) verifyUserStats(ctx context.Context, counts, sizes *VerificationCheck) error {
+	var v3Files []struct {
+		UserID uint
+		Count  int64
+		Size   int64
+	}
+	if err := model.DB.Model(&model.File{}).
+		Select("user_id, count(*) as count, sum(size) as size").
+		Group("user_id").
+		Scan(&v3Files).Error; err != nil {
+		return fmt.Errorf("failed to count v3 files: %w", err)
+	}
+
+	var v3Folders []struct {
+		OwnerID uint
+		Count   int64
+	}
+	if err := model.DB.Model(&model.Folder{}).
+		Select("owner_id, count(*) as count").
+		Group("owner_id").
+		Scan(&v3Folders).Error; err != nil {
+		return fmt.Errorf("failed to count v3 folders: %w", err)
+	}
+
+	var v4Files []struct {
+		OwnerID int   `json:"owner_id"`
+		Type    int   `json:"type"`
+		Count   int64 `json:"count"`
+		Sum     int64 `json:"sum"`
+	}
+	if err := m.v4client.File.Query().
+		GroupBy(file.FieldOwnerID, file.FieldType).
+		Aggregate(ent.Count(), ent.Sum(file.FieldSize)).
+		Scan(ctx, &v4Files); err != nil {
+		return fmt.Errorf("failed to count v4 files: %w", err)
+	}
+
+	v3Stats := make(map[uint]*userStat)
+	v4Stats := make(map[uint]*userStat)
+	stat := func(stats map[uint]*userStat, id uint) *userStat {
+		if _, ok := stats[id]; !ok {
+			stats[id] = &userStat{}
+		}
+		return stats[id]
+	}
+
+	for _, f := range v3Files {
+		s := stat(v3Stats, f.UserID)
+		s.Files, s.Size = f.Count, f.Size
+	}
+	for _, f := range v3Folders {
+		stat(v3Stats, f.OwnerID).Folders = f.Count
+	}
+	for _, f := range v4Files {
+		s := stat(v4Stats, uint(f.OwnerID))
+		if f.Type == int(types.FileTypeFolder) {
+			s.Folders = f.Count
+		} else {
+			s.Files, s.Size = f.Count, f.Sum
+		}
+	}
+
+	for id, v3 := range v3Stats {
+		v4 := stat(v4Stats, id)
+		counts.Checked++
+		if v3.Files != v4.Files || v3.Folders != v4.Folders {
+			counts.fail(id, "v3 has %d files and %d folders, but v4 has %d files and %d folders", v3.Files, v3.Folders, v4.Files, v4.Folders)
+		}
+
+		sizes.Checked++
+		if v3.Size != v4.Size {
+			sizes.fail(id, "v3 files total %d bytes, but v4 files total %d bytes", v3.Size, v4.Size)
+		}
+	}
+
+	return nil
+}
+
+// verifyBlobs reads primary entities of sampled files through v4 storage drivers, and compares their sizes
+// with v3 ones. Blobs of v3 local policies are also hashed and compared by SHA-256.
+func (m *Migrator) verifyBlobs(ctx context.Context, samples int, check *VerificationCheck) error {
+	var total int64
+	if err := model.DB.Model(&model.File{}).Count(&total).Error; err != nil {
+		return fmt.Errorf("failed to count v3 files: %w", err)
+	}
+
+	offsets := make(map[int64]bool)
+	for int64(len(offsets)) < min(int64(samples), total) {
+		offsets[rand.Int63n(total)] = true
+	}
+
+	for offset := range offsets {
+		var f model.File
+		if err := model.DB.Order("id").Offset(int(offset)).Limit(1).Find(&f).Error; err != nil {
+			return fmt.Errorf("failed to sample v3 file: %w", err)
+		}
+
+		if f.ID == 0 {
+			continue
+		}
+
+		check.Checked++
+		if err := m.verifyBlob(ctx, &f); err != nil {
+			check.fail(f.ID, "%s", err)
+		}
+	}
+
+	return nil
+}
+
+func (m *Migrator) verifyBlob(ctx context.Context, f *model.File) error {
+	v4File, err := m.v4client.File.Get(ctx, int(f.ID)+m.state.LastFolderID)
+	if err != nil {
+		return fmt.Errorf("file is not migrated: %w", err)
+	}
+
+	if v4File.Size != int64(f.Size) {
+		return fmt.Errorf("v3 file size is %d, but v4 file size is %d", f.Size, v4File.Size)
+	}
+
+	v4Hash, v4Size, err := m.hashV4Entity(ctx, v4File)
+	if err != nil {
+		return fmt.Errorf("failed to read v4 blob: %w", err)
+	}
+
+	if v4Size != int64(f.Size) {
+		return fmt.Errorf("v3 file size is %d, but %d bytes are read from v4 blob", f.Size, v4Size)
+	}
+
+	if !m.state.LocalPolicyIDs[int(f.PolicyID)] {
+		return nil
+	}
+
+	blob, err := os.Open(f.SourceName)
+	if err != nil {
+		return fmt.Errorf("failed to open v3 blob: %w", err)
+	}
+	defer blob.Close()
+
+	v3Hash, _, err := hashReader(blob)
+	if err != nil {
+		return fmt.Errorf("failed to read v3 blob: %w", err)
+	}
+
+	if v3Hash != v4Hash {
+		return fmt.Errorf("v3 blob SHA-256 is %s, but v4 blob SHA-256 is %s", v3Hash, v4Hash)
+	}
+
+	return nil
+}
+
+// hashV4Entity reads primary entity of file as its owner.
+func (m *Migrator) hashV4Entity(ctx context.Context, f *ent.File) (string, int64, error) {
+	u, err := m.dep.UserClient().GetLoginUserByID(ctx, f.OwnerID)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get owner: %w", err)
+	}
+
+	fm := manager.NewFileManager(m.dep, u)
+	defer fm.Recycle()
+
+	es, err := fm.GetEntitySource(context.WithValue(ctx, inventory.UserCtx{}, u), f.PrimaryEntity)
+	if err != nil {
+		return "", 0, err
+	}
+	defer es.Close()
+
+	return hashReader(es)
+}
+
+func hashReader(r io.Reader) (string, int64, error) {
+	h := sha256.New()
+	n, err := io.Copy(h, r)
+	if err != nil {
+		return "", n, err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), n, nil
+}
+
+// verifyShares resolves v3 share links with v4 hash ID encoder, and checks that they point to migrated files.
+func (m *Migrator) verifyShares(ctx context.Context, check *VerificationCheck) error {
+	v3Hasher, err := hashid.New(conf.SystemConfig.HashIDSalt)
+	if err != nil {
+		return fmt.Errorf("failed to create v3 hash ID encoder: %w", err)
+	}
+
+	for offset := 0; ; offset += batchSize {
+		var shares []model.Share
+		if err := model.DB.Order("id").Offset(offset).Limit(batchSize).Find(&shares).Error; err != nil {
+			return fmt.Errorf("failed to list v3 shares: %w", err)
+		}
+
+		for _, s := range shares {
+			check.Checked++
+			key := hashid.EncodeShareID(v3Hasher, int(s.ID))
+			share, err := m.dep.ShareClient().GetByHashID(ctx, key)
+			if err != nil {
+				check.fail(s.ID, "share link %q does not resolve: %s", key, err)
+				continue
+			}
+
+			expected := int(s.SourceID)
+			if !s.IsDir {
+				expected += m.state.LastFolderID
+			}
+
+			fileID, err := share.QueryFile().OnlyID(ctx)
+			if err != nil {
+				check.fail(s.ID, "shared file of link %q is not found: %s", key, err)
+				continue
+			}
+
+			if fileID != expected {
+				check.fail(s.ID, "share link %q points to file %d instead of %d", key, fileID, expected)
+			}
+		}
+
+		if len(shares) < batchSize {
+			return nil
+		}
+	}
+}
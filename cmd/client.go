@@ -0,0 +1,338 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/client"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/service/explorer"
+	"github.com/cloudreve/Cloudreve/v4/service/share"
+	"github.com/spf13/cobra"
+)
+
+var (
+	clientConfig      client.Config
+	clientRecursive   bool
+	clientPermanent   bool
+	clientSyncOptions client.SyncOptions
+	clientShare       share.ShareCreateService
+)
+
+func init() {
+	rootCmd.AddCommand(clientCmd)
+	clientCmd.AddCommand(clientLoginCmd, clientLsCmd, clientCpCmd, clientMvCmd, clientRmCmd, clientSyncCmd, clientShareCmd)
+	clientSyncCmd.AddCommand(clientSyncUpCmd, clientSyncDownCmd)
+	clientShareCmd.AddCommand(clientShareCreateCmd)
+
+	clientLoginCmd.Flags().StringVar(&clientConfig.Server, "server", "", "Base URL of the Cloudreve server, e.g. https://cloud.example.com")
+	clientLoginCmd.Flags().StringVar(&clientConfig.Token, "token", "", "Personal access token created in user settings")
+	_ = clientLoginCmd.MarkFlagRequired("server")
+	_ = clientLoginCmd.MarkFlagRequired("token")
+
+	clientLsCmd.Flags().BoolVarP(&clientRecursive, "recursive", "r", false, "List sub folders recursively")
+	clientRmCmd.Flags().BoolVar(&clientPermanent, "permanent", false, "Delete permanently instead of moving to trash bin")
+
+	clientSyncCmd.PersistentFlags().BoolVar(&clientSyncOptions.Delete, "delete", false, "Delete files in destination that do not exist in source")
+	clientSyncCmd.PersistentFlags().BoolVar(&clientSyncOptions.DryRun, "dry-run", false, "Print changes without applying them")
+
+	clientShareCreateCmd.Flags().BoolVar(&clientShare.IsPrivate, "private", false, "Protect the share link with a password")
+	clientShareCreateCmd.Flags().IntVar(&clientShare.RemainDownloads, "downloads", 0, "Expire the link after given number of downloads, 0 for unlimited")
+	clientShareCreateCmd.Flags().IntVar(&clientShare.Expire, "expire", 0, "Expire the link after given seconds, 0 for never")
+}
+
+var clientCmd = &cobra.Command{
+	Use:   "client",
+	Short: "Manage files on a remote Cloudreve server with a personal access token",
+	Long: `Manage files on a remote Cloudreve server with a personal access token.
+
+Remote paths are either Cloudreve URIs like "cloudreve://my/docs", or paths under "My files"
+like "/docs". In "cp", prefix remote paths with "cr:" to tell them apart from local ones.
+
+The token needs files.read and files.upload scopes, "mv", "rename", "rm" and "sync --delete"
+also need files.manage.`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		// Arguments are valid at this point, errors from now on are not usage errors,
+		// and they are printed by Execute.
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+	},
+}
+
+var clientLoginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Save server address and personal access token",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := client.New(&clientConfig)
+		if err != nil {
+			return err
+		}
+
+		me, err := c.Me(cmd.Context())
+		if err != nil {
+			return fmt.Errorf("failed to verify token: %w", err)
+		}
+
+		if err := clientConfig.Save(); err != nil {
+			return fmt.Errorf("failed to save client config: %w", err)
+		}
+
+		fmt.Printf("Logged in as %s (%s).\n", me.Nickname, me.Email)
+		return nil
+	},
+}
+
+var clientLsCmd = &cobra.Command{
+	Use:   "ls [path]",
+	Short: "List files in a remote folder",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		uri, err := client.ResolveUri(strings.Join(args, ""))
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		defer w.Flush()
+
+		print := func(name string, file *explorer.FileResponse) {
+			size := strconv.FormatInt(file.Size, 10)
+			if file.Type == int(types.FileTypeFolder) {
+				size, name = "-", name+"/"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\n", size, file.UpdatedAt.Local().Format(time.DateTime), name)
+		}
+
+		if clientRecursive {
+			return c.Walk(cmd.Context(), uri, func(rel string, file *explorer.FileResponse) error {
+				print(rel, file)
+				return nil
+			})
+		}
+
+		files, err := c.List(cmd.Context(), uri)
+		if err != nil {
+			return err
+		}
+
+		for i := range files {
+			print(files[i].Name, &files[i])
+		}
+		return nil
+	},
+}
+
+var clientCpCmd = &cobra.Command{
+	Use:   "cp <src> <dst>",
+	Short: "Upload a local file, or download a remote file",
+	Example: `  cloudreve client cp ./report.pdf cr:/docs/
+  cloudreve client cp cr:/docs/report.pdf ./`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		src, dst := args[0], args[1]
+		switch {
+		case !client.IsRemote(src) && client.IsRemote(dst):
+			dstUri, err := client.ResolveUri(dst)
+			if err != nil {
+				return err
+			}
+
+			if isRemoteFolder(cmd.Context(), c, dstUri) || strings.HasSuffix(dst, "/") {
+				dstUri = dstUri.Join(filepath.Base(src))
+			}
+
+			return c.CopyUp(cmd.Context(), src, dstUri)
+		case client.IsRemote(src) && !client.IsRemote(dst):
+			srcUri, err := client.ResolveUri(src)
+			if err != nil {
+				return err
+			}
+
+			if info, err := os.Stat(dst); err == nil && info.IsDir() {
+				dst = filepath.Join(dst, srcUri.Name())
+			}
+
+			return c.CopyDown(cmd.Context(), srcUri, dst)
+		default:
+			return fmt.Errorf("exactly one of src and dst must be a remote path prefixed with %q", client.RemotePrefix)
+		}
+	},
+}
+
+var clientMvCmd = &cobra.Command{
+	Use:   "mv <src> <dst>",
+	Short: "Move or rename a remote file",
+	Long:  "Move or rename a remote file, it is moved into dst if dst is an existing folder.",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		src, err := client.ResolveUri(args[0])
+		if err != nil {
+			return err
+		}
+
+		dst, err := client.ResolveUri(args[1])
+		if err != nil {
+			return err
+		}
+
+		if isRemoteFolder(cmd.Context(), c, dst) {
+			return c.Move(cmd.Context(), dst, false, src)
+		}
+
+		parent := dst.DirUri()
+		if parent.String() != src.DirUri().String() {
+			if err := c.Move(cmd.Context(), parent, false, src); err != nil {
+				return err
+			}
+		}
+
+		if dst.Name() == src.Name() {
+			return nil
+		}
+
+		return c.Rename(cmd.Context(), parent.Join(src.Name()), dst.Name())
+	},
+}
+
+var clientRmCmd = &cobra.Command{
+	Use:   "rm <path>...",
+	Short: "Delete remote files",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		uris := make([]*fs.URI, len(args))
+		for i, arg := range args {
+			if uris[i], err = client.ResolveUri(arg); err != nil {
+				return err
+			}
+		}
+
+		return c.Delete(cmd.Context(), clientPermanent, uris...)
+	},
+}
+
+var clientSyncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Mirror a folder between local and remote, only changed files are transferred",
+	Long: `Mirror a folder between local and remote. Files are considered changed if size or
+modified time differs, only changed files are transferred.`,
+}
+
+var clientSyncUpCmd = &cobra.Command{
+	Use:   "up <local> <remote>",
+	Short: "Mirror a local folder to remote",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		remote, err := client.ResolveUri(args[1])
+		if err != nil {
+			return err
+		}
+
+		clientSyncOptions.OnAction = printSyncAction
+		return c.SyncUp(cmd.Context(), args[0], remote, &clientSyncOptions)
+	},
+}
+
+var clientSyncDownCmd = &cobra.Command{
+	Use:   "down <remote> <local>",
+	Short: "Mirror a remote folder to local",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		remote, err := client.ResolveUri(args[0])
+		if err != nil {
+			return err
+		}
+
+		clientSyncOptions.OnAction = printSyncAction
+		return c.SyncDown(cmd.Context(), remote, args[1], &clientSyncOptions)
+	},
+}
+
+var clientShareCmd = &cobra.Command{
+	Use:   "share",
+	Short: "Manage share links",
+}
+
+var clientShareCreateCmd = &cobra.Command{
+	Use:   "create <path>",
+	Short: "Create a share link of a remote file or folder",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newClient()
+		if err != nil {
+			return err
+		}
+
+		uri, err := client.ResolveUri(args[0])
+		if err != nil {
+			return err
+		}
+
+		link, err := c.CreateShare(cmd.Context(), uri, &clientShare)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(link)
+		return nil
+	},
+}
+
+func newClient() (*client.Client, error) {
+	config, err := client.LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.New(config)
+}
+
+func isRemoteFolder(ctx context.Context, c *client.Client, uri *fs.URI) bool {
+	file, err := c.Get(ctx, uri)
+	return err == nil && file.Type == int(types.FileTypeFolder)
+}
+
+func printSyncAction(action client.SyncAction) {
+	prefix := ""
+	if clientSyncOptions.DryRun {
+		prefix = "(dry run) "
+	}
+	fmt.Printf("%s%s\t%s\n", prefix, action.Op, action.Path)
+}
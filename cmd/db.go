@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dbtransfer"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+var (
+	transferTargetConf string
+	transferBatchSize  int
+)
+
+func init() {
+	rootCmd.AddCommand(dbCmd)
+	dbCmd.AddCommand(dbTransferCmd)
+
+	dbTransferCmd.Flags().StringVar(&transferTargetConf, "target-conf", "", "Path to a config file whose [Database] section describes the target database")
+	dbTransferCmd.Flags().IntVar(&transferBatchSize, "batch-size", dbtransfer.DefaultBatchSize, "Number of rows inserted in one statement")
+	_ = dbTransferCmd.MarkFlagRequired("target-conf")
+}
+
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Database maintenance tools",
+}
+
+var dbTransferCmd = &cobra.Command{
+	Use:   "transfer",
+	Short: "Copy all data from the configured database into another empty database",
+	Run: func(cmd *cobra.Command, args []string) {
+		dep := dependency.NewDependency(
+			dependency.WithConfigPath(confPath),
+			dependency.WithProFlag(constants.IsPro == "true"),
+		)
+		logger := dep.Logger()
+		logger.Warning("Make sure Cloudreve is stopped, changes made during transfer are not copied.")
+
+		if !util.Exists(transferTargetConf) {
+			logger.Error("Target config file %q not found.", transferTargetConf)
+			os.Exit(1)
+		}
+
+		targetConf, err := conf.NewIniConfigProvider(transferTargetConf, logger)
+		if err != nil {
+			logger.Error("Failed to load target config: %s", err)
+			os.Exit(1)
+		}
+
+		src, err := inventory.NewSQLDriver(logger, dep.ConfigProvider())
+		if err != nil {
+			logger.Error("Failed to connect to source database: %s", err)
+			os.Exit(1)
+		}
+		defer src.Close()
+
+		dst, err := inventory.NewSQLDriver(logger, targetConf)
+		if err != nil {
+			logger.Error("Failed to connect to target database: %s", err)
+			os.Exit(1)
+		}
+		defer dst.Close()
+
+		counts, err := dbtransfer.New(logger, src, dst, transferBatchSize).Run(context.Background())
+		for _, c := range counts {
+			if c.Source != c.Target {
+				logger.Error("Table %q has %d rows in source database, but %d rows in target database.", c.Table, c.Source, c.Target)
+			}
+		}
+
+		if err != nil {
+			logger.Error("Failed to transfer database: %s", err)
+			os.Exit(1)
+		}
+
+		logger.Info("Database transferred, %d tables verified. Replace [Database] section of %q with the target one to use it.", len(counts), confPath)
+	},
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/application/importer"
+	"github.com/spf13/cobra"
+)
+
+var (
+	importPolicyID   int
+	importReportPath string
+	nextcloudOptions importer.NextcloudOptions
+	seafileOptions   importer.SeafileOptions
+)
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+	importCmd.AddCommand(importNextcloudCmd)
+	importCmd.AddCommand(importSeafileCmd)
+
+	importCmd.PersistentFlags().IntVar(&importPolicyID, "policy", 0, "ID of the storage policy storing imported files, Nextcloud import requires a local policy")
+	importCmd.PersistentFlags().StringVar(&importReportPath, "report", "import_report.json", "Path to write the mapping report")
+	_ = importCmd.MarkPersistentFlagRequired("policy")
+
+	importNextcloudCmd.Flags().StringVar(&nextcloudOptions.DBType, "db-type", "mysql", "Database type of Nextcloud, one of mysql, postgres and sqlite")
+	importNextcloudCmd.Flags().StringVar(&nextcloudOptions.DSN, "dsn", "", "Data source name of Nextcloud database, or file path for sqlite")
+	importNextcloudCmd.Flags().StringVar(&nextcloudOptions.TablePrefix, "table-prefix", "oc_", "Table prefix of Nextcloud database")
+	importNextcloudCmd.Flags().StringVar(&nextcloudOptions.DataDir, "data-dir", "", "Data directory of Nextcloud")
+	importNextcloudCmd.Flags().StringVar(&nextcloudOptions.EmailDomain, "email-domain", "", "Domain used to build email of users without one")
+	_ = importNextcloudCmd.MarkFlagRequired("dsn")
+	_ = importNextcloudCmd.MarkFlagRequired("data-dir")
+
+	importSeafileCmd.Flags().StringVar(&seafileOptions.DBType, "db-type", "mysql", "Database type of Seafile, one of mysql, postgres and sqlite")
+	importSeafileCmd.Flags().StringVar(&seafileOptions.CcnetDSN, "ccnet-dsn", "", "Data source name of ccnet database")
+	importSeafileCmd.Flags().StringVar(&seafileOptions.SeafileDSN, "seafile-dsn", "", "Data source name of seafile database")
+	importSeafileCmd.Flags().StringVar(&seafileOptions.SeahubDSN, "seahub-dsn", "", "Data source name of seahub database")
+	importSeafileCmd.Flags().StringVar(&seafileOptions.DataDir, "data-dir", "", "Path to seafile-data directory")
+	_ = importSeafileCmd.MarkFlagRequired("ccnet-dsn")
+	_ = importSeafileCmd.MarkFlagRequired("seafile-dsn")
+	_ = importSeafileCmd.MarkFlagRequired("seahub-dsn")
+	_ = importSeafileCmd.MarkFlagRequired("data-dir")
+}
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import users, files and shares from other storage services",
+}
+
+var importNextcloudCmd = &cobra.Command{
+	Use:   "nextcloud",
+	Short: "Import from a Nextcloud instance",
+	Run: func(cmd *cobra.Command, args []string) {
+		runImport("nextcloud", func(ctx context.Context, i *importer.Importer) error {
+			return i.ImportNextcloud(ctx, &nextcloudOptions)
+		})
+	},
+}
+
+var importSeafileCmd = &cobra.Command{
+	Use:   "seafile",
+	Short: "Import from a Seafile instance",
+	Run: func(cmd *cobra.Command, args []string) {
+		runImport("seafile", func(ctx context.Context, i *importer.Importer) error {
+			return i.ImportSeafile(ctx, &seafileOptions)
+		})
+	},
+}
+
+func runImport(source string, fn func(ctx context.Context, i *importer.Importer) error) {
+	dep := dependency.NewDependency(
+		dependency.WithConfigPath(confPath),
+		dependency.WithRequiredDbVersion(constants.BackendVersion),
+		dependency.WithProFlag(constants.IsPro == "true"),
+	)
+	logger := dep.Logger()
+	ctx := context.Background()
+
+	i, err := importer.New(ctx, dep, source, importPolicyID)
+	if err != nil {
+		logger.Error("Failed to create importer: %s", err)
+		os.Exit(1)
+	}
+
+	importErr := fn(ctx, i)
+	report := i.Report()
+	if err := report.Save(importReportPath); err != nil {
+		logger.Error("Failed to save import report: %s", err)
+	} else {
+		logger.Info("Import report saved to %s.", importReportPath)
+	}
+
+	if importErr != nil {
+		logger.Error("Failed to import from %s: %s", source, importErr)
+		os.Exit(1)
+	}
+
+	logger.Info("Imported %d users, %d files and %d shares from %s, %d failed.",
+		len(report.Users), len(report.Files), len(report.Shares), source, len(report.Failures))
+}
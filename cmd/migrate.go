@@ -1,25 +1,49 @@
 package cmd
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
 	"os"
 	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/application/migrator"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
 )
 
 var (
-	v3ConfPath string
-	forceReset bool
+	v3ConfPath  string
+	forceReset  bool
+	dryRun      bool
+	reportPath  string
+	workers     int
+	statusAddr  string
+	summaryPath string
+	verify      bool
+	samples     int
 )
 
 func init() {
 	rootCmd.AddCommand(migrateCmd)
 	migrateCmd.PersistentFlags().StringVar(&v3ConfPath, "v3-conf", "", "Path to the v3 config file")
 	migrateCmd.PersistentFlags().BoolVar(&forceReset, "force-reset", false, "Force reset migration state and start from beginning")
+	migrateCmd.PersistentFlags().IntVar(&workers, "workers", runtime.NumCPU(), "Number of migration steps and batches processed concurrently")
+	migrateCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Validate v3 database and estimate migration cost without writing anything")
+	migrateCmd.PersistentFlags().BoolVar(&verify, "verify", false, "Verify migrated data against v3 database after migration is completed")
+	migrateCmd.PersistentFlags().IntVar(&samples, "verify-samples", migrator.DefaultVerifySamples, "Number of randomly sampled files whose blobs are read and hashed during verification")
+	migrateCmd.PersistentFlags().StringVar(&reportPath, "report", "", "Path to write the dry-run or verification report, defaults to migration_report.json or migration_verification.json next to the v3 config file")
+	migrateCmd.PersistentFlags().StringVar(&statusAddr, "status-addr", "", "Address to serve migration progress as JSON on, e.g. 127.0.0.1:5213, disabled if empty")
+	migrateCmd.PersistentFlags().StringVar(&summaryPath, "summary", "", "Path to write the migration summary, defaults to migration_summary.json next to the v3 config file")
 }
 
 var migrateCmd = &cobra.Command{
@@ -39,6 +63,16 @@ var migrateCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
+		if dryRun {
+			runMigrateDryRun(dep)
+			return
+		}
+
+		if verify {
+			runMigrateVerify(dep)
+			return
+		}
+
 		// Check if state file exists and warn about resuming
 		stateFilePath := filepath.Join(filepath.Dir(v3ConfPath), "migration_state.json")
 		if util.Exists(stateFilePath) && !forceReset {
@@ -52,14 +86,46 @@ var migrateCmd = &cobra.Command{
 			}
 		}
 
-		migrator, err := migrator.NewMigrator(dep, v3ConfPath)
+		m, err := migrator.NewMigrator(dep, v3ConfPath)
 		if err != nil {
 			logger.Error("Failed to create migrator: %s", err)
 			os.Exit(1)
 		}
 
-		if err := migrator.Migrate(); err != nil {
-			logger.Error("Failed to migrate: %s", err)
+		m.SetWorkers(workers)
+		if statusAddr != "" {
+			server, err := serveMigrateStatus(logger, m)
+			if err != nil {
+				logger.Error("Failed to serve migration status: %s", err)
+				os.Exit(1)
+			}
+			defer server.Close()
+		}
+
+		ctx, stopDisplay := context.WithCancel(context.Background())
+		displayDone := make(chan struct{})
+		go func() {
+			displayMigrateProgress(ctx, logger, m)
+			close(displayDone)
+		}()
+
+		migrateErr := m.Migrate()
+		stopDisplay()
+		<-displayDone
+
+		if summaryPath == "" {
+			summaryPath = filepath.Join(filepath.Dir(v3ConfPath), migrator.SummaryFileName)
+		}
+		if progress := m.Progress(); progress != nil {
+			if err := progress.Save(summaryPath); err != nil {
+				logger.Warning("Failed to save migration summary: %s", err)
+			} else {
+				logger.Info("Migration summary saved to %s.", summaryPath)
+			}
+		}
+
+		if migrateErr != nil {
+			logger.Error("Failed to migrate: %s", migrateErr)
 			logger.Info("Migration failed but state has been saved. You can retry with the same command to resume from the last successful step.")
 			os.Exit(1)
 		}
@@ -67,3 +133,162 @@ var migrateCmd = &cobra.Command{
 		logger.Info("Migration from v3 to v4 completed successfully.")
 	},
 }
+
+func runMigrateDryRun(dep dependency.Dep) {
+	logger := dep.Logger()
+	m, err := migrator.NewDryRunMigrator(dep, v3ConfPath)
+	if err != nil {
+		logger.Error("Failed to create migrator: %s", err)
+		os.Exit(1)
+	}
+
+	report, err := m.DryRun()
+	if err != nil {
+		logger.Error("Failed to run migration dry-run: %s", err)
+		os.Exit(1)
+	}
+
+	if reportPath == "" {
+		reportPath = filepath.Join(filepath.Dir(v3ConfPath), migrator.ReportFileName)
+	}
+	if err := report.Save(reportPath); err != nil {
+		logger.Error("Failed to save dry-run report: %s", err)
+		os.Exit(1)
+	}
+
+	for t, count := range report.IssueCounts {
+		logger.Warning("Found %d issue(s) of type %q.", count, t)
+	}
+	logger.Info("Estimated %d rows (%s) to be written to v4 database in about %s, referencing %s of stored files.",
+		report.Estimation.Rows,
+		humanize.IBytes(uint64(report.Estimation.DatabaseSize)),
+		time.Duration(report.Estimation.Duration)*time.Second,
+		humanize.IBytes(report.Estimation.StorageSize),
+	)
+	logger.Info("Dry-run report saved to %s.", reportPath)
+
+	if report.HasErrors() {
+		logger.Warning("Some data cannot be migrated, please check the report before migrating.")
+		os.Exit(1)
+	}
+}
+
+func runMigrateVerify(dep dependency.Dep) {
+	logger := dep.Logger()
+	m, err := migrator.NewMigrator(dep, v3ConfPath)
+	if err != nil {
+		logger.Error("Failed to create migrator: %s", err)
+		os.Exit(1)
+	}
+
+	report, err := m.Verify(samples)
+	if err != nil {
+		logger.Error("Failed to verify migration: %s", err)
+		os.Exit(1)
+	}
+
+	if reportPath == "" {
+		reportPath = filepath.Join(filepath.Dir(v3ConfPath), migrator.VerificationFileName)
+	}
+	if err := report.Save(reportPath); err != nil {
+		logger.Error("Failed to save verification report: %s", err)
+		os.Exit(1)
+	}
+
+	for _, c := range report.Checks {
+		if c.Passed {
+			logger.Info("Check %q passed, %d item(s) checked.", c.Name, c.Checked)
+		} else {
+			logger.Warning("Check %q failed, %d of %d item(s) mismatched.", c.Name, c.Failed, c.Checked)
+		}
+	}
+	logger.Info("Verification report saved to %s.", reportPath)
+
+	if !report.Passed {
+		logger.Warning("Migrated data does not match v3 database, please check the report before decommissioning v3 instance.")
+		os.Exit(1)
+	}
+
+	logger.Info("Verification passed, v3 instance can be decommissioned.")
+}
+
+func serveMigrateStatus(l logging.Logger, m *migrator.Migrator) (*http.Server, error) {
+	listener, err := net.Listen("tcp", statusAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/status", m.StatusHandler())
+	server := &http.Server{Handler: mux}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			l.Warning("Migration status server stopped: %s", err)
+		}
+	}()
+
+	l.Info("Serving migration progress on http://%s/status.", listener.Addr())
+	return server, nil
+}
+
+// displayMigrateProgress redraws a progress line on terminal every second, or logs progress every
+// 30 seconds if output is redirected.
+func displayMigrateProgress(ctx context.Context, l logging.Logger, m *migrator.Migrator) {
+	interval := 30 * time.Second
+	stat, err := os.Stdout.Stat()
+	isTerminal := err == nil && stat.Mode()&os.ModeCharDevice != 0
+	if isTerminal {
+		interval = time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if isTerminal {
+				fmt.Print("\r\033[K")
+			}
+			return
+		case <-ticker.C:
+		}
+
+		progress := m.Progress()
+		if progress == nil {
+			continue
+		}
+
+		line := formatMigrateProgress(progress)
+		if isTerminal {
+			fmt.Print("\r\033[K" + line + "\r")
+		} else {
+			l.Info("Progress: %s", line)
+		}
+	}
+}
+
+func formatMigrateProgress(p *migrator.Progress) string {
+	var running []string
+	for _, t := range p.Tables {
+		if t.Running {
+			running = append(running, fmt.Sprintf("%s %d/%d", t.Name, t.Done, t.Total))
+		}
+	}
+
+	current := "-"
+	if len(running) > 0 {
+		current = strings.Join(running, ", ")
+	}
+
+	eta := "unknown"
+	if p.ETA >= 0 {
+		eta = (time.Duration(p.ETA) * time.Second).String()
+	}
+
+	percent := 100.0
+	if p.Total > 0 {
+		percent = float64(p.Done) * 100 / float64(p.Total)
+	}
+
+	return fmt.Sprintf("[%5.1f%%] %d/%d rows, %.0f rows/s, ETA %s | %s", percent, p.Done, p.Total, p.Rate, eta, current)
+}
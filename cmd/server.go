@@ -38,9 +38,14 @@ var serverCmd = &cobra.Command{
 
 		// Graceful shutdown after received signal.
 		sigChan := make(chan os.Signal, 1)
-		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGQUIT)
+		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM, syscall.SIGQUIT)
 		go shutdown(sigChan, logger, server)
 
+		// Reload config after received SIGHUP.
+		reloadChan := make(chan os.Signal, 1)
+		signal.Notify(reloadChan, syscall.SIGHUP)
+		go reload(reloadChan, logger, server)
+
 		if err := server.Start(); err != nil {
 			logger.Error("Failed to start server: %s", err)
 			os.Exit(1)
@@ -58,3 +63,10 @@ func shutdown(sigChan chan os.Signal, logger logging.Logger, server application.
 	server.Close()
 	close(sigChan)
 }
+
+func reload(sigChan chan os.Signal, logger logging.Logger, server application.Server) {
+	for sig := range sigChan {
+		logger.Info("Signal %s received, reloading config...", sig)
+		server.Reload()
+	}
+}
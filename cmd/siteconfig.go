@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/pkg/siteconfig"
+	"github.com/spf13/cobra"
+)
+
+var (
+	siteConfigFile string
+	exportOptions  siteconfig.ExportOptions
+	applyDryRun    bool
+)
+
+func init() {
+	rootCmd.AddCommand(siteConfigCmd)
+	siteConfigCmd.AddCommand(siteConfigExportCmd, siteConfigApplyCmd)
+
+	siteConfigExportCmd.Flags().StringVarP(&siteConfigFile, "output", "o", "", "Path to write the manifest to")
+	siteConfigExportCmd.Flags().BoolVar(&exportOptions.Nodes, "nodes", false, "Include nodes")
+	siteConfigExportCmd.Flags().BoolVar(&exportOptions.Policies, "policies", false, "Include storage policies")
+	siteConfigExportCmd.Flags().BoolVar(&exportOptions.Groups, "groups", false, "Include groups")
+	siteConfigExportCmd.Flags().BoolVar(&exportOptions.Secrets, "secrets", false, "Include storage policy credentials and node keys")
+	_ = siteConfigExportCmd.MarkFlagRequired("output")
+
+	siteConfigApplyCmd.Flags().StringVarP(&siteConfigFile, "file", "f", "", "Path to the manifest to apply")
+	siteConfigApplyCmd.Flags().BoolVar(&applyDryRun, "dry-run", false, "Print changes without saving them")
+	_ = siteConfigApplyCmd.MarkFlagRequired("file")
+}
+
+var siteConfigCmd = &cobra.Command{
+	Use:   "siteconfig",
+	Short: "Export or apply site configuration as YAML manifest",
+}
+
+var siteConfigExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export site settings, and optionally nodes, storage policies and groups",
+	Run: func(cmd *cobra.Command, args []string) {
+		dep := newSiteConfigDependency()
+		logger := dep.Logger()
+
+		m, err := siteconfig.Export(context.Background(), dep, &exportOptions)
+		if err != nil {
+			logger.Error("Failed to export site config: %s", err)
+			os.Exit(1)
+		}
+
+		content, err := siteconfig.Marshal(m)
+		if err != nil {
+			logger.Error("Failed to encode site config: %s", err)
+			os.Exit(1)
+		}
+
+		if err := os.WriteFile(siteConfigFile, content, 0600); err != nil {
+			logger.Error("Failed to write manifest: %s", err)
+			os.Exit(1)
+		}
+
+		logger.Info("Site config exported to %q.", siteConfigFile)
+	},
+}
+
+var siteConfigApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Apply a site config manifest",
+	Run: func(cmd *cobra.Command, args []string) {
+		dep := newSiteConfigDependency()
+		logger := dep.Logger()
+
+		content, err := os.ReadFile(siteConfigFile)
+		if err != nil {
+			logger.Error("Failed to read manifest: %s", err)
+			os.Exit(1)
+		}
+
+		m, err := siteconfig.Unmarshal(content)
+		if err != nil {
+			logger.Error("%s", err)
+			os.Exit(1)
+		}
+
+		res, err := siteconfig.Apply(context.Background(), dep, m, &siteconfig.ApplyOptions{DryRun: applyDryRun})
+		if err != nil {
+			logger.Error("Failed to apply site config: %s", err)
+			os.Exit(1)
+		}
+
+		logger.Info("Changed settings: %s", strings.Join(res.Settings, ", "))
+		logger.Info("Created: %s", strings.Join(res.Created, ", "))
+		logger.Info("Updated: %s", strings.Join(res.Updated, ", "))
+		if len(res.Unknown) > 0 {
+			logger.Warning("Unknown settings are ignored: %s", strings.Join(res.Unknown, ", "))
+		}
+
+		if applyDryRun {
+			logger.Info("Dry run, no changes are saved.")
+			return
+		}
+
+		logger.Info("Site config applied, restart running Cloudreve instances for all changes to take effect.")
+	},
+}
+
+func newSiteConfigDependency() dependency.Dep {
+	return dependency.NewDependency(
+		dependency.WithConfigPath(confPath),
+		dependency.WithRequiredDbVersion(constants.BackendVersion),
+		dependency.WithProFlag(constants.IsPro == "true"),
+	)
+}
@@ -0,0 +1,1052 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/accesstoken"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// AccessTokenCreate is the builder for creating a AccessToken entity.
+type AccessTokenCreate struct {
+	config
+	mutation *AccessTokenMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (atc *AccessTokenCreate) SetCreatedAt(t time.Time) *AccessTokenCreate {
+	atc.mutation.SetCreatedAt(t)
+	return atc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (atc *AccessTokenCreate) SetNillableCreatedAt(t *time.Time) *AccessTokenCreate {
+	if t != nil {
+		atc.SetCreatedAt(*t)
+	}
+	return atc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (atc *AccessTokenCreate) SetUpdatedAt(t time.Time) *AccessTokenCreate {
+	atc.mutation.SetUpdatedAt(t)
+	return atc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (atc *AccessTokenCreate) SetNillableUpdatedAt(t *time.Time) *AccessTokenCreate {
+	if t != nil {
+		atc.SetUpdatedAt(*t)
+	}
+	return atc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (atc *AccessTokenCreate) SetDeletedAt(t time.Time) *AccessTokenCreate {
+	atc.mutation.SetDeletedAt(t)
+	return atc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (atc *AccessTokenCreate) SetNillableDeletedAt(t *time.Time) *AccessTokenCreate {
+	if t != nil {
+		atc.SetDeletedAt(*t)
+	}
+	return atc
+}
+
+// SetUserID sets the "user_id" field.
+func (atc *AccessTokenCreate) SetUserID(i int) *AccessTokenCreate {
+	atc.mutation.SetUserID(i)
+	return atc
+}
+
+// SetTokenID sets the "token_id" field.
+func (atc *AccessTokenCreate) SetTokenID(s string) *AccessTokenCreate {
+	atc.mutation.SetTokenID(s)
+	return atc
+}
+
+// SetName sets the "name" field.
+func (atc *AccessTokenCreate) SetName(s string) *AccessTokenCreate {
+	atc.mutation.SetName(s)
+	return atc
+}
+
+// SetDigest sets the "digest" field.
+func (atc *AccessTokenCreate) SetDigest(s string) *AccessTokenCreate {
+	atc.mutation.SetDigest(s)
+	return atc
+}
+
+// SetScopes sets the "scopes" field.
+func (atc *AccessTokenCreate) SetScopes(s []string) *AccessTokenCreate {
+	atc.mutation.SetScopes(s)
+	return atc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (atc *AccessTokenCreate) SetExpiresAt(t time.Time) *AccessTokenCreate {
+	atc.mutation.SetExpiresAt(t)
+	return atc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (atc *AccessTokenCreate) SetNillableExpiresAt(t *time.Time) *AccessTokenCreate {
+	if t != nil {
+		atc.SetExpiresAt(*t)
+	}
+	return atc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (atc *AccessTokenCreate) SetLastUsedAt(t time.Time) *AccessTokenCreate {
+	atc.mutation.SetLastUsedAt(t)
+	return atc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (atc *AccessTokenCreate) SetNillableLastUsedAt(t *time.Time) *AccessTokenCreate {
+	if t != nil {
+		atc.SetLastUsedAt(*t)
+	}
+	return atc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (atc *AccessTokenCreate) SetUser(u *User) *AccessTokenCreate {
+	return atc.SetUserID(u.ID)
+}
+
+// Mutation returns the AccessTokenMutation object of the builder.
+func (atc *AccessTokenCreate) Mutation() *AccessTokenMutation {
+	return atc.mutation
+}
+
+// Save creates the AccessToken in the database.
+func (atc *AccessTokenCreate) Save(ctx context.Context) (*AccessToken, error) {
+	if err := atc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, atc.sqlSave, atc.mutation, atc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (atc *AccessTokenCreate) SaveX(ctx context.Context) *AccessToken {
+	v, err := atc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atc *AccessTokenCreate) Exec(ctx context.Context) error {
+	_, err := atc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atc *AccessTokenCreate) ExecX(ctx context.Context) {
+	if err := atc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atc *AccessTokenCreate) defaults() error {
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		if accesstoken.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized accesstoken.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := accesstoken.DefaultCreatedAt()
+		atc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := atc.mutation.UpdatedAt(); !ok {
+		if accesstoken.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized accesstoken.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := accesstoken.DefaultUpdatedAt()
+		atc.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atc *AccessTokenCreate) check() error {
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccessToken.created_at"`)}
+	}
+	if _, ok := atc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AccessToken.updated_at"`)}
+	}
+	if _, ok := atc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AccessToken.user_id"`)}
+	}
+	if _, ok := atc.mutation.TokenID(); !ok {
+		return &ValidationError{Name: "token_id", err: errors.New(`ent: missing required field "AccessToken.token_id"`)}
+	}
+	if _, ok := atc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AccessToken.name"`)}
+	}
+	if _, ok := atc.mutation.Digest(); !ok {
+		return &ValidationError{Name: "digest", err: errors.New(`ent: missing required field "AccessToken.digest"`)}
+	}
+	if _, ok := atc.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "AccessToken.scopes"`)}
+	}
+	if _, ok := atc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "AccessToken.user"`)}
+	}
+	return nil
+}
+
+func (atc *AccessTokenCreate) sqlSave(ctx context.Context) (*AccessToken, error) {
+	if err := atc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := atc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, atc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	atc.mutation.id = &_node.ID
+	atc.mutation.done = true
+	return _node, nil
+}
+
+func (atc *AccessTokenCreate) createSpec() (*AccessToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccessToken{config: atc.config}
+		_spec = sqlgraph.NewCreateSpec(accesstoken.Table, sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt))
+	)
+
+	if id, ok := atc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = atc.conflict
+	if value, ok := atc.mutation.CreatedAt(); ok {
+		_spec.SetField(accesstoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := atc.mutation.UpdatedAt(); ok {
+		_spec.SetField(accesstoken.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := atc.mutation.DeletedAt(); ok {
+		_spec.SetField(accesstoken.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := atc.mutation.TokenID(); ok {
+		_spec.SetField(accesstoken.FieldTokenID, field.TypeString, value)
+		_node.TokenID = value
+	}
+	if value, ok := atc.mutation.Name(); ok {
+		_spec.SetField(accesstoken.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := atc.mutation.Digest(); ok {
+		_spec.SetField(accesstoken.FieldDigest, field.TypeString, value)
+		_node.Digest = value
+	}
+	if value, ok := atc.mutation.Scopes(); ok {
+		_spec.SetField(accesstoken.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := atc.mutation.ExpiresAt(); ok {
+		_spec.SetField(accesstoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := atc.mutation.LastUsedAt(); ok {
+		_spec.SetField(accesstoken.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if nodes := atc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accesstoken.UserTable,
+			Columns: []string{accesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccessToken.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccessTokenUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (atc *AccessTokenCreate) OnConflict(opts ...sql.ConflictOption) *AccessTokenUpsertOne {
+	atc.conflict = opts
+	return &AccessTokenUpsertOne{
+		create: atc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccessToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (atc *AccessTokenCreate) OnConflictColumns(columns ...string) *AccessTokenUpsertOne {
+	atc.conflict = append(atc.conflict, sql.ConflictColumns(columns...))
+	return &AccessTokenUpsertOne{
+		create: atc,
+	}
+}
+
+type (
+	// AccessTokenUpsertOne is the builder for "upsert"-ing
+	//  one AccessToken node.
+	AccessTokenUpsertOne struct {
+		create *AccessTokenCreate
+	}
+
+	// AccessTokenUpsert is the "OnConflict" setter.
+	AccessTokenUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AccessTokenUpsert) SetUpdatedAt(v time.Time) *AccessTokenUpsert {
+	u.Set(accesstoken.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AccessTokenUpsert) UpdateUpdatedAt() *AccessTokenUpsert {
+	u.SetExcluded(accesstoken.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AccessTokenUpsert) SetDeletedAt(v time.Time) *AccessTokenUpsert {
+	u.Set(accesstoken.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AccessTokenUpsert) UpdateDeletedAt() *AccessTokenUpsert {
+	u.SetExcluded(accesstoken.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AccessTokenUpsert) ClearDeletedAt() *AccessTokenUpsert {
+	u.SetNull(accesstoken.FieldDeletedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AccessTokenUpsert) SetUserID(v int) *AccessTokenUpsert {
+	u.Set(accesstoken.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AccessTokenUpsert) UpdateUserID() *AccessTokenUpsert {
+	u.SetExcluded(accesstoken.FieldUserID)
+	return u
+}
+
+// SetTokenID sets the "token_id" field.
+func (u *AccessTokenUpsert) SetTokenID(v string) *AccessTokenUpsert {
+	u.Set(accesstoken.FieldTokenID, v)
+	return u
+}
+
+// UpdateTokenID sets the "token_id" field to the value that was provided on create.
+func (u *AccessTokenUpsert) UpdateTokenID() *AccessTokenUpsert {
+	u.SetExcluded(accesstoken.FieldTokenID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *AccessTokenUpsert) SetName(v string) *AccessTokenUpsert {
+	u.Set(accesstoken.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AccessTokenUpsert) UpdateName() *AccessTokenUpsert {
+	u.SetExcluded(accesstoken.FieldName)
+	return u
+}
+
+// SetDigest sets the "digest" field.
+func (u *AccessTokenUpsert) SetDigest(v string) *AccessTokenUpsert {
+	u.Set(accesstoken.FieldDigest, v)
+	return u
+}
+
+// UpdateDigest sets the "digest" field to the value that was provided on create.
+func (u *AccessTokenUpsert) UpdateDigest() *AccessTokenUpsert {
+	u.SetExcluded(accesstoken.FieldDigest)
+	return u
+}
+
+// SetScopes sets the "scopes" field.
+func (u *AccessTokenUpsert) SetScopes(v []string) *AccessTokenUpsert {
+	u.Set(accesstoken.FieldScopes, v)
+	return u
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *AccessTokenUpsert) UpdateScopes() *AccessTokenUpsert {
+	u.SetExcluded(accesstoken.FieldScopes)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *AccessTokenUpsert) SetExpiresAt(v time.Time) *AccessTokenUpsert {
+	u.Set(accesstoken.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *AccessTokenUpsert) UpdateExpiresAt() *AccessTokenUpsert {
+	u.SetExcluded(accesstoken.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *AccessTokenUpsert) ClearExpiresAt() *AccessTokenUpsert {
+	u.SetNull(accesstoken.FieldExpiresAt)
+	return u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *AccessTokenUpsert) SetLastUsedAt(v time.Time) *AccessTokenUpsert {
+	u.Set(accesstoken.FieldLastUsedAt, v)
+	return u
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *AccessTokenUpsert) UpdateLastUsedAt() *AccessTokenUpsert {
+	u.SetExcluded(accesstoken.FieldLastUsedAt)
+	return u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *AccessTokenUpsert) ClearLastUsedAt() *AccessTokenUpsert {
+	u.SetNull(accesstoken.FieldLastUsedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.AccessToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AccessTokenUpsertOne) UpdateNewValues() *AccessTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(accesstoken.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccessToken.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AccessTokenUpsertOne) Ignore() *AccessTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccessTokenUpsertOne) DoNothing() *AccessTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccessTokenCreate.OnConflict
+// documentation for more info.
+func (u *AccessTokenUpsertOne) Update(set func(*AccessTokenUpsert)) *AccessTokenUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccessTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AccessTokenUpsertOne) SetUpdatedAt(v time.Time) *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AccessTokenUpsertOne) UpdateUpdatedAt() *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AccessTokenUpsertOne) SetDeletedAt(v time.Time) *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AccessTokenUpsertOne) UpdateDeletedAt() *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AccessTokenUpsertOne) ClearDeletedAt() *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AccessTokenUpsertOne) SetUserID(v int) *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AccessTokenUpsertOne) UpdateUserID() *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetTokenID sets the "token_id" field.
+func (u *AccessTokenUpsertOne) SetTokenID(v string) *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetTokenID(v)
+	})
+}
+
+// UpdateTokenID sets the "token_id" field to the value that was provided on create.
+func (u *AccessTokenUpsertOne) UpdateTokenID() *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateTokenID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AccessTokenUpsertOne) SetName(v string) *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AccessTokenUpsertOne) UpdateName() *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDigest sets the "digest" field.
+func (u *AccessTokenUpsertOne) SetDigest(v string) *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetDigest(v)
+	})
+}
+
+// UpdateDigest sets the "digest" field to the value that was provided on create.
+func (u *AccessTokenUpsertOne) UpdateDigest() *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateDigest()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *AccessTokenUpsertOne) SetScopes(v []string) *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *AccessTokenUpsertOne) UpdateScopes() *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *AccessTokenUpsertOne) SetExpiresAt(v time.Time) *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *AccessTokenUpsertOne) UpdateExpiresAt() *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *AccessTokenUpsertOne) ClearExpiresAt() *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *AccessTokenUpsertOne) SetLastUsedAt(v time.Time) *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *AccessTokenUpsertOne) UpdateLastUsedAt() *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *AccessTokenUpsertOne) ClearLastUsedAt() *AccessTokenUpsertOne {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AccessTokenUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccessTokenCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccessTokenUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AccessTokenUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AccessTokenUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *AccessTokenCreate) SetRawID(t int) *AccessTokenCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// AccessTokenCreateBulk is the builder for creating many AccessToken entities in bulk.
+type AccessTokenCreateBulk struct {
+	config
+	err      error
+	builders []*AccessTokenCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AccessToken entities in the database.
+func (atcb *AccessTokenCreateBulk) Save(ctx context.Context) ([]*AccessToken, error) {
+	if atcb.err != nil {
+		return nil, atcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(atcb.builders))
+	nodes := make([]*AccessToken, len(atcb.builders))
+	mutators := make([]Mutator, len(atcb.builders))
+	for i := range atcb.builders {
+		func(i int, root context.Context) {
+			builder := atcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccessTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, atcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = atcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, atcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, atcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atcb *AccessTokenCreateBulk) SaveX(ctx context.Context) []*AccessToken {
+	v, err := atcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atcb *AccessTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := atcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atcb *AccessTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := atcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AccessToken.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AccessTokenUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (atcb *AccessTokenCreateBulk) OnConflict(opts ...sql.ConflictOption) *AccessTokenUpsertBulk {
+	atcb.conflict = opts
+	return &AccessTokenUpsertBulk{
+		create: atcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AccessToken.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (atcb *AccessTokenCreateBulk) OnConflictColumns(columns ...string) *AccessTokenUpsertBulk {
+	atcb.conflict = append(atcb.conflict, sql.ConflictColumns(columns...))
+	return &AccessTokenUpsertBulk{
+		create: atcb,
+	}
+}
+
+// AccessTokenUpsertBulk is the builder for "upsert"-ing
+// a bulk of AccessToken nodes.
+type AccessTokenUpsertBulk struct {
+	create *AccessTokenCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AccessToken.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AccessTokenUpsertBulk) UpdateNewValues() *AccessTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(accesstoken.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AccessToken.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AccessTokenUpsertBulk) Ignore() *AccessTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AccessTokenUpsertBulk) DoNothing() *AccessTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AccessTokenCreateBulk.OnConflict
+// documentation for more info.
+func (u *AccessTokenUpsertBulk) Update(set func(*AccessTokenUpsert)) *AccessTokenUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AccessTokenUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AccessTokenUpsertBulk) SetUpdatedAt(v time.Time) *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AccessTokenUpsertBulk) UpdateUpdatedAt() *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AccessTokenUpsertBulk) SetDeletedAt(v time.Time) *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AccessTokenUpsertBulk) UpdateDeletedAt() *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AccessTokenUpsertBulk) ClearDeletedAt() *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AccessTokenUpsertBulk) SetUserID(v int) *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AccessTokenUpsertBulk) UpdateUserID() *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetTokenID sets the "token_id" field.
+func (u *AccessTokenUpsertBulk) SetTokenID(v string) *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetTokenID(v)
+	})
+}
+
+// UpdateTokenID sets the "token_id" field to the value that was provided on create.
+func (u *AccessTokenUpsertBulk) UpdateTokenID() *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateTokenID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AccessTokenUpsertBulk) SetName(v string) *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AccessTokenUpsertBulk) UpdateName() *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDigest sets the "digest" field.
+func (u *AccessTokenUpsertBulk) SetDigest(v string) *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetDigest(v)
+	})
+}
+
+// UpdateDigest sets the "digest" field to the value that was provided on create.
+func (u *AccessTokenUpsertBulk) UpdateDigest() *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateDigest()
+	})
+}
+
+// SetScopes sets the "scopes" field.
+func (u *AccessTokenUpsertBulk) SetScopes(v []string) *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetScopes(v)
+	})
+}
+
+// UpdateScopes sets the "scopes" field to the value that was provided on create.
+func (u *AccessTokenUpsertBulk) UpdateScopes() *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateScopes()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *AccessTokenUpsertBulk) SetExpiresAt(v time.Time) *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *AccessTokenUpsertBulk) UpdateExpiresAt() *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *AccessTokenUpsertBulk) ClearExpiresAt() *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *AccessTokenUpsertBulk) SetLastUsedAt(v time.Time) *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *AccessTokenUpsertBulk) UpdateLastUsedAt() *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *AccessTokenUpsertBulk) ClearLastUsedAt() *AccessTokenUpsertBulk {
+	return u.Update(func(s *AccessTokenUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AccessTokenUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AccessTokenCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AccessTokenCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AccessTokenUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
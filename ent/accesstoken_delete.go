@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/accesstoken"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// AccessTokenDelete is the builder for deleting a AccessToken entity.
+type AccessTokenDelete struct {
+	config
+	hooks    []Hook
+	mutation *AccessTokenMutation
+}
+
+// Where appends a list predicates to the AccessTokenDelete builder.
+func (atd *AccessTokenDelete) Where(ps ...predicate.AccessToken) *AccessTokenDelete {
+	atd.mutation.Where(ps...)
+	return atd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (atd *AccessTokenDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, atd.sqlExec, atd.mutation, atd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atd *AccessTokenDelete) ExecX(ctx context.Context) int {
+	n, err := atd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (atd *AccessTokenDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(accesstoken.Table, sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt))
+	if ps := atd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, atd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	atd.mutation.done = true
+	return affected, err
+}
+
+// AccessTokenDeleteOne is the builder for deleting a single AccessToken entity.
+type AccessTokenDeleteOne struct {
+	atd *AccessTokenDelete
+}
+
+// Where appends a list predicates to the AccessTokenDelete builder.
+func (atdo *AccessTokenDeleteOne) Where(ps ...predicate.AccessToken) *AccessTokenDeleteOne {
+	atdo.atd.mutation.Where(ps...)
+	return atdo
+}
+
+// Exec executes the deletion query.
+func (atdo *AccessTokenDeleteOne) Exec(ctx context.Context) error {
+	n, err := atdo.atd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{accesstoken.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atdo *AccessTokenDeleteOne) ExecX(ctx context.Context) {
+	if err := atdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
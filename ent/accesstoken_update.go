@@ -0,0 +1,654 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/accesstoken"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// AccessTokenUpdate is the builder for updating AccessToken entities.
+type AccessTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccessTokenMutation
+}
+
+// Where appends a list predicates to the AccessTokenUpdate builder.
+func (atu *AccessTokenUpdate) Where(ps ...predicate.AccessToken) *AccessTokenUpdate {
+	atu.mutation.Where(ps...)
+	return atu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (atu *AccessTokenUpdate) SetUpdatedAt(t time.Time) *AccessTokenUpdate {
+	atu.mutation.SetUpdatedAt(t)
+	return atu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (atu *AccessTokenUpdate) SetDeletedAt(t time.Time) *AccessTokenUpdate {
+	atu.mutation.SetDeletedAt(t)
+	return atu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (atu *AccessTokenUpdate) SetNillableDeletedAt(t *time.Time) *AccessTokenUpdate {
+	if t != nil {
+		atu.SetDeletedAt(*t)
+	}
+	return atu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (atu *AccessTokenUpdate) ClearDeletedAt() *AccessTokenUpdate {
+	atu.mutation.ClearDeletedAt()
+	return atu
+}
+
+// SetUserID sets the "user_id" field.
+func (atu *AccessTokenUpdate) SetUserID(i int) *AccessTokenUpdate {
+	atu.mutation.SetUserID(i)
+	return atu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (atu *AccessTokenUpdate) SetNillableUserID(i *int) *AccessTokenUpdate {
+	if i != nil {
+		atu.SetUserID(*i)
+	}
+	return atu
+}
+
+// SetTokenID sets the "token_id" field.
+func (atu *AccessTokenUpdate) SetTokenID(s string) *AccessTokenUpdate {
+	atu.mutation.SetTokenID(s)
+	return atu
+}
+
+// SetNillableTokenID sets the "token_id" field if the given value is not nil.
+func (atu *AccessTokenUpdate) SetNillableTokenID(s *string) *AccessTokenUpdate {
+	if s != nil {
+		atu.SetTokenID(*s)
+	}
+	return atu
+}
+
+// SetName sets the "name" field.
+func (atu *AccessTokenUpdate) SetName(s string) *AccessTokenUpdate {
+	atu.mutation.SetName(s)
+	return atu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (atu *AccessTokenUpdate) SetNillableName(s *string) *AccessTokenUpdate {
+	if s != nil {
+		atu.SetName(*s)
+	}
+	return atu
+}
+
+// SetDigest sets the "digest" field.
+func (atu *AccessTokenUpdate) SetDigest(s string) *AccessTokenUpdate {
+	atu.mutation.SetDigest(s)
+	return atu
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (atu *AccessTokenUpdate) SetNillableDigest(s *string) *AccessTokenUpdate {
+	if s != nil {
+		atu.SetDigest(*s)
+	}
+	return atu
+}
+
+// SetScopes sets the "scopes" field.
+func (atu *AccessTokenUpdate) SetScopes(s []string) *AccessTokenUpdate {
+	atu.mutation.SetScopes(s)
+	return atu
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (atu *AccessTokenUpdate) AppendScopes(s []string) *AccessTokenUpdate {
+	atu.mutation.AppendScopes(s)
+	return atu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (atu *AccessTokenUpdate) SetExpiresAt(t time.Time) *AccessTokenUpdate {
+	atu.mutation.SetExpiresAt(t)
+	return atu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (atu *AccessTokenUpdate) SetNillableExpiresAt(t *time.Time) *AccessTokenUpdate {
+	if t != nil {
+		atu.SetExpiresAt(*t)
+	}
+	return atu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (atu *AccessTokenUpdate) ClearExpiresAt() *AccessTokenUpdate {
+	atu.mutation.ClearExpiresAt()
+	return atu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (atu *AccessTokenUpdate) SetLastUsedAt(t time.Time) *AccessTokenUpdate {
+	atu.mutation.SetLastUsedAt(t)
+	return atu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (atu *AccessTokenUpdate) SetNillableLastUsedAt(t *time.Time) *AccessTokenUpdate {
+	if t != nil {
+		atu.SetLastUsedAt(*t)
+	}
+	return atu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (atu *AccessTokenUpdate) ClearLastUsedAt() *AccessTokenUpdate {
+	atu.mutation.ClearLastUsedAt()
+	return atu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (atu *AccessTokenUpdate) SetUser(u *User) *AccessTokenUpdate {
+	return atu.SetUserID(u.ID)
+}
+
+// Mutation returns the AccessTokenMutation object of the builder.
+func (atu *AccessTokenUpdate) Mutation() *AccessTokenMutation {
+	return atu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (atu *AccessTokenUpdate) ClearUser() *AccessTokenUpdate {
+	atu.mutation.ClearUser()
+	return atu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (atu *AccessTokenUpdate) Save(ctx context.Context) (int, error) {
+	if err := atu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, atu.sqlSave, atu.mutation, atu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atu *AccessTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := atu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (atu *AccessTokenUpdate) Exec(ctx context.Context) error {
+	_, err := atu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atu *AccessTokenUpdate) ExecX(ctx context.Context) {
+	if err := atu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atu *AccessTokenUpdate) defaults() error {
+	if _, ok := atu.mutation.UpdatedAt(); !ok {
+		if accesstoken.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized accesstoken.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := accesstoken.UpdateDefaultUpdatedAt()
+		atu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atu *AccessTokenUpdate) check() error {
+	if _, ok := atu.mutation.UserID(); atu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AccessToken.user"`)
+	}
+	return nil
+}
+
+func (atu *AccessTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := atu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accesstoken.Table, accesstoken.Columns, sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt))
+	if ps := atu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atu.mutation.UpdatedAt(); ok {
+		_spec.SetField(accesstoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := atu.mutation.DeletedAt(); ok {
+		_spec.SetField(accesstoken.FieldDeletedAt, field.TypeTime, value)
+	}
+	if atu.mutation.DeletedAtCleared() {
+		_spec.ClearField(accesstoken.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := atu.mutation.TokenID(); ok {
+		_spec.SetField(accesstoken.FieldTokenID, field.TypeString, value)
+	}
+	if value, ok := atu.mutation.Name(); ok {
+		_spec.SetField(accesstoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := atu.mutation.Digest(); ok {
+		_spec.SetField(accesstoken.FieldDigest, field.TypeString, value)
+	}
+	if value, ok := atu.mutation.Scopes(); ok {
+		_spec.SetField(accesstoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := atu.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesstoken.FieldScopes, value)
+		})
+	}
+	if value, ok := atu.mutation.ExpiresAt(); ok {
+		_spec.SetField(accesstoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if atu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(accesstoken.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := atu.mutation.LastUsedAt(); ok {
+		_spec.SetField(accesstoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if atu.mutation.LastUsedAtCleared() {
+		_spec.ClearField(accesstoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if atu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accesstoken.UserTable,
+			Columns: []string{accesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accesstoken.UserTable,
+			Columns: []string{accesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, atu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	atu.mutation.done = true
+	return n, nil
+}
+
+// AccessTokenUpdateOne is the builder for updating a single AccessToken entity.
+type AccessTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccessTokenMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (atuo *AccessTokenUpdateOne) SetUpdatedAt(t time.Time) *AccessTokenUpdateOne {
+	atuo.mutation.SetUpdatedAt(t)
+	return atuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (atuo *AccessTokenUpdateOne) SetDeletedAt(t time.Time) *AccessTokenUpdateOne {
+	atuo.mutation.SetDeletedAt(t)
+	return atuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (atuo *AccessTokenUpdateOne) SetNillableDeletedAt(t *time.Time) *AccessTokenUpdateOne {
+	if t != nil {
+		atuo.SetDeletedAt(*t)
+	}
+	return atuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (atuo *AccessTokenUpdateOne) ClearDeletedAt() *AccessTokenUpdateOne {
+	atuo.mutation.ClearDeletedAt()
+	return atuo
+}
+
+// SetUserID sets the "user_id" field.
+func (atuo *AccessTokenUpdateOne) SetUserID(i int) *AccessTokenUpdateOne {
+	atuo.mutation.SetUserID(i)
+	return atuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (atuo *AccessTokenUpdateOne) SetNillableUserID(i *int) *AccessTokenUpdateOne {
+	if i != nil {
+		atuo.SetUserID(*i)
+	}
+	return atuo
+}
+
+// SetTokenID sets the "token_id" field.
+func (atuo *AccessTokenUpdateOne) SetTokenID(s string) *AccessTokenUpdateOne {
+	atuo.mutation.SetTokenID(s)
+	return atuo
+}
+
+// SetNillableTokenID sets the "token_id" field if the given value is not nil.
+func (atuo *AccessTokenUpdateOne) SetNillableTokenID(s *string) *AccessTokenUpdateOne {
+	if s != nil {
+		atuo.SetTokenID(*s)
+	}
+	return atuo
+}
+
+// SetName sets the "name" field.
+func (atuo *AccessTokenUpdateOne) SetName(s string) *AccessTokenUpdateOne {
+	atuo.mutation.SetName(s)
+	return atuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (atuo *AccessTokenUpdateOne) SetNillableName(s *string) *AccessTokenUpdateOne {
+	if s != nil {
+		atuo.SetName(*s)
+	}
+	return atuo
+}
+
+// SetDigest sets the "digest" field.
+func (atuo *AccessTokenUpdateOne) SetDigest(s string) *AccessTokenUpdateOne {
+	atuo.mutation.SetDigest(s)
+	return atuo
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (atuo *AccessTokenUpdateOne) SetNillableDigest(s *string) *AccessTokenUpdateOne {
+	if s != nil {
+		atuo.SetDigest(*s)
+	}
+	return atuo
+}
+
+// SetScopes sets the "scopes" field.
+func (atuo *AccessTokenUpdateOne) SetScopes(s []string) *AccessTokenUpdateOne {
+	atuo.mutation.SetScopes(s)
+	return atuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (atuo *AccessTokenUpdateOne) AppendScopes(s []string) *AccessTokenUpdateOne {
+	atuo.mutation.AppendScopes(s)
+	return atuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (atuo *AccessTokenUpdateOne) SetExpiresAt(t time.Time) *AccessTokenUpdateOne {
+	atuo.mutation.SetExpiresAt(t)
+	return atuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (atuo *AccessTokenUpdateOne) SetNillableExpiresAt(t *time.Time) *AccessTokenUpdateOne {
+	if t != nil {
+		atuo.SetExpiresAt(*t)
+	}
+	return atuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (atuo *AccessTokenUpdateOne) ClearExpiresAt() *AccessTokenUpdateOne {
+	atuo.mutation.ClearExpiresAt()
+	return atuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (atuo *AccessTokenUpdateOne) SetLastUsedAt(t time.Time) *AccessTokenUpdateOne {
+	atuo.mutation.SetLastUsedAt(t)
+	return atuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (atuo *AccessTokenUpdateOne) SetNillableLastUsedAt(t *time.Time) *AccessTokenUpdateOne {
+	if t != nil {
+		atuo.SetLastUsedAt(*t)
+	}
+	return atuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (atuo *AccessTokenUpdateOne) ClearLastUsedAt() *AccessTokenUpdateOne {
+	atuo.mutation.ClearLastUsedAt()
+	return atuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (atuo *AccessTokenUpdateOne) SetUser(u *User) *AccessTokenUpdateOne {
+	return atuo.SetUserID(u.ID)
+}
+
+// Mutation returns the AccessTokenMutation object of the builder.
+func (atuo *AccessTokenUpdateOne) Mutation() *AccessTokenMutation {
+	return atuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (atuo *AccessTokenUpdateOne) ClearUser() *AccessTokenUpdateOne {
+	atuo.mutation.ClearUser()
+	return atuo
+}
+
+// Where appends a list predicates to the AccessTokenUpdate builder.
+func (atuo *AccessTokenUpdateOne) Where(ps ...predicate.AccessToken) *AccessTokenUpdateOne {
+	atuo.mutation.Where(ps...)
+	return atuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (atuo *AccessTokenUpdateOne) Select(field string, fields ...string) *AccessTokenUpdateOne {
+	atuo.fields = append([]string{field}, fields...)
+	return atuo
+}
+
+// Save executes the query and returns the updated AccessToken entity.
+func (atuo *AccessTokenUpdateOne) Save(ctx context.Context) (*AccessToken, error) {
+	if err := atuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, atuo.sqlSave, atuo.mutation, atuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atuo *AccessTokenUpdateOne) SaveX(ctx context.Context) *AccessToken {
+	node, err := atuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (atuo *AccessTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := atuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atuo *AccessTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := atuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atuo *AccessTokenUpdateOne) defaults() error {
+	if _, ok := atuo.mutation.UpdatedAt(); !ok {
+		if accesstoken.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized accesstoken.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := accesstoken.UpdateDefaultUpdatedAt()
+		atuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atuo *AccessTokenUpdateOne) check() error {
+	if _, ok := atuo.mutation.UserID(); atuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AccessToken.user"`)
+	}
+	return nil
+}
+
+func (atuo *AccessTokenUpdateOne) sqlSave(ctx context.Context) (_node *AccessToken, err error) {
+	if err := atuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accesstoken.Table, accesstoken.Columns, sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt))
+	id, ok := atuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccessToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := atuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accesstoken.FieldID)
+		for _, f := range fields {
+			if !accesstoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accesstoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := atuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(accesstoken.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := atuo.mutation.DeletedAt(); ok {
+		_spec.SetField(accesstoken.FieldDeletedAt, field.TypeTime, value)
+	}
+	if atuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(accesstoken.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := atuo.mutation.TokenID(); ok {
+		_spec.SetField(accesstoken.FieldTokenID, field.TypeString, value)
+	}
+	if value, ok := atuo.mutation.Name(); ok {
+		_spec.SetField(accesstoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := atuo.mutation.Digest(); ok {
+		_spec.SetField(accesstoken.FieldDigest, field.TypeString, value)
+	}
+	if value, ok := atuo.mutation.Scopes(); ok {
+		_spec.SetField(accesstoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := atuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesstoken.FieldScopes, value)
+		})
+	}
+	if value, ok := atuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(accesstoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if atuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(accesstoken.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := atuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(accesstoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if atuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(accesstoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if atuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accesstoken.UserTable,
+			Columns: []string{accesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accesstoken.UserTable,
+			Columns: []string{accesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AccessToken{config: atuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, atuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	atuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/announcement"
+)
+
+// Announcement is the model entity for the Announcement schema.
+type Announcement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// Groups holds the value of the "groups" field.
+	Groups []int `json:"groups,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	EndsAt *time.Time `json:"ends_at,omitempty"`
+	// Dismissible holds the value of the "dismissible" field.
+	Dismissible bool `json:"dismissible,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AnnouncementQuery when eager-loading is set.
+	Edges        AnnouncementEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AnnouncementEdges holds the relations/edges for other nodes in the graph.
+type AnnouncementEdges struct {
+	// DismissedBy holds the value of the dismissed_by edge.
+	DismissedBy []*User `json:"dismissed_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedDismissedBy map[string][]*User
+}
+
+// DismissedByOrErr returns the DismissedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e AnnouncementEdges) DismissedByOrErr() ([]*User, error) {
+	if e.loadedTypes[0] {
+		return e.DismissedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "dismissed_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Announcement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case announcement.FieldGroups:
+			values[i] = new([]byte)
+		case announcement.FieldDismissible:
+			values[i] = new(sql.NullBool)
+		case announcement.FieldID:
+			values[i] = new(sql.NullInt64)
+		case announcement.FieldTitle, announcement.FieldBody:
+			values[i] = new(sql.NullString)
+		case announcement.FieldCreatedAt, announcement.FieldUpdatedAt, announcement.FieldDeletedAt, announcement.FieldStartsAt, announcement.FieldEndsAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Announcement fields.
+func (a *Announcement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case announcement.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			a.ID = int(value.Int64)
+		case announcement.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				a.CreatedAt = value.Time
+			}
+		case announcement.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				a.UpdatedAt = value.Time
+			}
+		case announcement.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				a.DeletedAt = new(time.Time)
+				*a.DeletedAt = value.Time
+			}
+		case announcement.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				a.Title = value.String
+			}
+		case announcement.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				a.Body = value.String
+			}
+		case announcement.FieldGroups:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field groups", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.Groups); err != nil {
+					return fmt.Errorf("unmarshal field groups: %w", err)
+				}
+			}
+		case announcement.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				a.StartsAt = new(time.Time)
+				*a.StartsAt = value.Time
+			}
+		case announcement.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				a.EndsAt = new(time.Time)
+				*a.EndsAt = value.Time
+			}
+		case announcement.FieldDismissible:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field dismissible", values[i])
+			} else if value.Valid {
+				a.Dismissible = value.Bool
+			}
+		default:
+			a.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Announcement.
+// This includes values selected through modifiers, order, etc.
+func (a *Announcement) Value(name string) (ent.Value, error) {
+	return a.selectValues.Get(name)
+}
+
+// QueryDismissedBy queries the "dismissed_by" edge of the Announcement entity.
+func (a *Announcement) QueryDismissedBy() *UserQuery {
+	return NewAnnouncementClient(a.config).QueryDismissedBy(a)
+}
+
+// Update returns a builder for updating this Announcement.
+// Note that you need to call Announcement.Unwrap() before calling this method if this Announcement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Announcement) Update() *AnnouncementUpdateOne {
+	return NewAnnouncementClient(a.config).UpdateOne(a)
+}
+
+// Unwrap unwraps the Announcement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Announcement) Unwrap() *Announcement {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Announcement is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Announcement) String() string {
+	var builder strings.Builder
+	builder.WriteString("Announcement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(a.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := a.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(a.Title)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(a.Body)
+	builder.WriteString(", ")
+	builder.WriteString("groups=")
+	builder.WriteString(fmt.Sprintf("%v", a.Groups))
+	builder.WriteString(", ")
+	if v := a.StartsAt; v != nil {
+		builder.WriteString("starts_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := a.EndsAt; v != nil {
+		builder.WriteString("ends_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("dismissible=")
+	builder.WriteString(fmt.Sprintf("%v", a.Dismissible))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetDismissedBy manually set the edge as loaded state.
+func (e *Announcement) SetDismissedBy(v []*User) {
+	e.Edges.DismissedBy = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// NamedDismissedBy returns the DismissedBy named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (a *Announcement) NamedDismissedBy(name string) ([]*User, error) {
+	if a.Edges.namedDismissedBy == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := a.Edges.namedDismissedBy[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (a *Announcement) appendNamedDismissedBy(name string, edges ...*User) {
+	if a.Edges.namedDismissedBy == nil {
+		a.Edges.namedDismissedBy = make(map[string][]*User)
+	}
+	if len(edges) == 0 {
+		a.Edges.namedDismissedBy[name] = []*User{}
+	} else {
+		a.Edges.namedDismissedBy[name] = append(a.Edges.namedDismissedBy[name], edges...)
+	}
+}
+
+// Announcements is a parsable slice of Announcement.
+type Announcements []*Announcement
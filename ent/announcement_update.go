@@ -0,0 +1,722 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/announcement"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// AnnouncementUpdate is the builder for updating Announcement entities.
+type AnnouncementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AnnouncementMutation
+}
+
+// Where appends a list predicates to the AnnouncementUpdate builder.
+func (au *AnnouncementUpdate) Where(ps ...predicate.Announcement) *AnnouncementUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (au *AnnouncementUpdate) SetUpdatedAt(t time.Time) *AnnouncementUpdate {
+	au.mutation.SetUpdatedAt(t)
+	return au
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (au *AnnouncementUpdate) SetDeletedAt(t time.Time) *AnnouncementUpdate {
+	au.mutation.SetDeletedAt(t)
+	return au
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (au *AnnouncementUpdate) SetNillableDeletedAt(t *time.Time) *AnnouncementUpdate {
+	if t != nil {
+		au.SetDeletedAt(*t)
+	}
+	return au
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (au *AnnouncementUpdate) ClearDeletedAt() *AnnouncementUpdate {
+	au.mutation.ClearDeletedAt()
+	return au
+}
+
+// SetTitle sets the "title" field.
+func (au *AnnouncementUpdate) SetTitle(s string) *AnnouncementUpdate {
+	au.mutation.SetTitle(s)
+	return au
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (au *AnnouncementUpdate) SetNillableTitle(s *string) *AnnouncementUpdate {
+	if s != nil {
+		au.SetTitle(*s)
+	}
+	return au
+}
+
+// SetBody sets the "body" field.
+func (au *AnnouncementUpdate) SetBody(s string) *AnnouncementUpdate {
+	au.mutation.SetBody(s)
+	return au
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (au *AnnouncementUpdate) SetNillableBody(s *string) *AnnouncementUpdate {
+	if s != nil {
+		au.SetBody(*s)
+	}
+	return au
+}
+
+// ClearBody clears the value of the "body" field.
+func (au *AnnouncementUpdate) ClearBody() *AnnouncementUpdate {
+	au.mutation.ClearBody()
+	return au
+}
+
+// SetGroups sets the "groups" field.
+func (au *AnnouncementUpdate) SetGroups(i []int) *AnnouncementUpdate {
+	au.mutation.SetGroups(i)
+	return au
+}
+
+// AppendGroups appends i to the "groups" field.
+func (au *AnnouncementUpdate) AppendGroups(i []int) *AnnouncementUpdate {
+	au.mutation.AppendGroups(i)
+	return au
+}
+
+// ClearGroups clears the value of the "groups" field.
+func (au *AnnouncementUpdate) ClearGroups() *AnnouncementUpdate {
+	au.mutation.ClearGroups()
+	return au
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (au *AnnouncementUpdate) SetStartsAt(t time.Time) *AnnouncementUpdate {
+	au.mutation.SetStartsAt(t)
+	return au
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (au *AnnouncementUpdate) SetNillableStartsAt(t *time.Time) *AnnouncementUpdate {
+	if t != nil {
+		au.SetStartsAt(*t)
+	}
+	return au
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (au *AnnouncementUpdate) ClearStartsAt() *AnnouncementUpdate {
+	au.mutation.ClearStartsAt()
+	return au
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (au *AnnouncementUpdate) SetEndsAt(t time.Time) *AnnouncementUpdate {
+	au.mutation.SetEndsAt(t)
+	return au
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (au *AnnouncementUpdate) SetNillableEndsAt(t *time.Time) *AnnouncementUpdate {
+	if t != nil {
+		au.SetEndsAt(*t)
+	}
+	return au
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (au *AnnouncementUpdate) ClearEndsAt() *AnnouncementUpdate {
+	au.mutation.ClearEndsAt()
+	return au
+}
+
+// SetDismissible sets the "dismissible" field.
+func (au *AnnouncementUpdate) SetDismissible(b bool) *AnnouncementUpdate {
+	au.mutation.SetDismissible(b)
+	return au
+}
+
+// SetNillableDismissible sets the "dismissible" field if the given value is not nil.
+func (au *AnnouncementUpdate) SetNillableDismissible(b *bool) *AnnouncementUpdate {
+	if b != nil {
+		au.SetDismissible(*b)
+	}
+	return au
+}
+
+// AddDismissedByIDs adds the "dismissed_by" edge to the User entity by IDs.
+func (au *AnnouncementUpdate) AddDismissedByIDs(ids ...int) *AnnouncementUpdate {
+	au.mutation.AddDismissedByIDs(ids...)
+	return au
+}
+
+// AddDismissedBy adds the "dismissed_by" edges to the User entity.
+func (au *AnnouncementUpdate) AddDismissedBy(u ...*User) *AnnouncementUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return au.AddDismissedByIDs(ids...)
+}
+
+// Mutation returns the AnnouncementMutation object of the builder.
+func (au *AnnouncementUpdate) Mutation() *AnnouncementMutation {
+	return au.mutation
+}
+
+// ClearDismissedBy clears all "dismissed_by" edges to the User entity.
+func (au *AnnouncementUpdate) ClearDismissedBy() *AnnouncementUpdate {
+	au.mutation.ClearDismissedBy()
+	return au
+}
+
+// RemoveDismissedByIDs removes the "dismissed_by" edge to User entities by IDs.
+func (au *AnnouncementUpdate) RemoveDismissedByIDs(ids ...int) *AnnouncementUpdate {
+	au.mutation.RemoveDismissedByIDs(ids...)
+	return au
+}
+
+// RemoveDismissedBy removes "dismissed_by" edges to User entities.
+func (au *AnnouncementUpdate) RemoveDismissedBy(u ...*User) *AnnouncementUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return au.RemoveDismissedByIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AnnouncementUpdate) Save(ctx context.Context) (int, error) {
+	if err := au.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AnnouncementUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AnnouncementUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AnnouncementUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (au *AnnouncementUpdate) defaults() error {
+	if _, ok := au.mutation.UpdatedAt(); !ok {
+		if announcement.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized announcement.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := announcement.UpdateDefaultUpdatedAt()
+		au.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (au *AnnouncementUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(announcement.Table, announcement.Columns, sqlgraph.NewFieldSpec(announcement.FieldID, field.TypeInt))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.UpdatedAt(); ok {
+		_spec.SetField(announcement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := au.mutation.DeletedAt(); ok {
+		_spec.SetField(announcement.FieldDeletedAt, field.TypeTime, value)
+	}
+	if au.mutation.DeletedAtCleared() {
+		_spec.ClearField(announcement.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.Title(); ok {
+		_spec.SetField(announcement.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := au.mutation.Body(); ok {
+		_spec.SetField(announcement.FieldBody, field.TypeString, value)
+	}
+	if au.mutation.BodyCleared() {
+		_spec.ClearField(announcement.FieldBody, field.TypeString)
+	}
+	if value, ok := au.mutation.Groups(); ok {
+		_spec.SetField(announcement.FieldGroups, field.TypeJSON, value)
+	}
+	if value, ok := au.mutation.AppendedGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, announcement.FieldGroups, value)
+		})
+	}
+	if au.mutation.GroupsCleared() {
+		_spec.ClearField(announcement.FieldGroups, field.TypeJSON)
+	}
+	if value, ok := au.mutation.StartsAt(); ok {
+		_spec.SetField(announcement.FieldStartsAt, field.TypeTime, value)
+	}
+	if au.mutation.StartsAtCleared() {
+		_spec.ClearField(announcement.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.EndsAt(); ok {
+		_spec.SetField(announcement.FieldEndsAt, field.TypeTime, value)
+	}
+	if au.mutation.EndsAtCleared() {
+		_spec.ClearField(announcement.FieldEndsAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.Dismissible(); ok {
+		_spec.SetField(announcement.FieldDismissible, field.TypeBool, value)
+	}
+	if au.mutation.DismissedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   announcement.DismissedByTable,
+			Columns: announcement.DismissedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedDismissedByIDs(); len(nodes) > 0 && !au.mutation.DismissedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   announcement.DismissedByTable,
+			Columns: announcement.DismissedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.DismissedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   announcement.DismissedByTable,
+			Columns: announcement.DismissedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{announcement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AnnouncementUpdateOne is the builder for updating a single Announcement entity.
+type AnnouncementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AnnouncementMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (auo *AnnouncementUpdateOne) SetUpdatedAt(t time.Time) *AnnouncementUpdateOne {
+	auo.mutation.SetUpdatedAt(t)
+	return auo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (auo *AnnouncementUpdateOne) SetDeletedAt(t time.Time) *AnnouncementUpdateOne {
+	auo.mutation.SetDeletedAt(t)
+	return auo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (auo *AnnouncementUpdateOne) SetNillableDeletedAt(t *time.Time) *AnnouncementUpdateOne {
+	if t != nil {
+		auo.SetDeletedAt(*t)
+	}
+	return auo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (auo *AnnouncementUpdateOne) ClearDeletedAt() *AnnouncementUpdateOne {
+	auo.mutation.ClearDeletedAt()
+	return auo
+}
+
+// SetTitle sets the "title" field.
+func (auo *AnnouncementUpdateOne) SetTitle(s string) *AnnouncementUpdateOne {
+	auo.mutation.SetTitle(s)
+	return auo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (auo *AnnouncementUpdateOne) SetNillableTitle(s *string) *AnnouncementUpdateOne {
+	if s != nil {
+		auo.SetTitle(*s)
+	}
+	return auo
+}
+
+// SetBody sets the "body" field.
+func (auo *AnnouncementUpdateOne) SetBody(s string) *AnnouncementUpdateOne {
+	auo.mutation.SetBody(s)
+	return auo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (auo *AnnouncementUpdateOne) SetNillableBody(s *string) *AnnouncementUpdateOne {
+	if s != nil {
+		auo.SetBody(*s)
+	}
+	return auo
+}
+
+// ClearBody clears the value of the "body" field.
+func (auo *AnnouncementUpdateOne) ClearBody() *AnnouncementUpdateOne {
+	auo.mutation.ClearBody()
+	return auo
+}
+
+// SetGroups sets the "groups" field.
+func (auo *AnnouncementUpdateOne) SetGroups(i []int) *AnnouncementUpdateOne {
+	auo.mutation.SetGroups(i)
+	return auo
+}
+
+// AppendGroups appends i to the "groups" field.
+func (auo *AnnouncementUpdateOne) AppendGroups(i []int) *AnnouncementUpdateOne {
+	auo.mutation.AppendGroups(i)
+	return auo
+}
+
+// ClearGroups clears the value of the "groups" field.
+func (auo *AnnouncementUpdateOne) ClearGroups() *AnnouncementUpdateOne {
+	auo.mutation.ClearGroups()
+	return auo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (auo *AnnouncementUpdateOne) SetStartsAt(t time.Time) *AnnouncementUpdateOne {
+	auo.mutation.SetStartsAt(t)
+	return auo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (auo *AnnouncementUpdateOne) SetNillableStartsAt(t *time.Time) *AnnouncementUpdateOne {
+	if t != nil {
+		auo.SetStartsAt(*t)
+	}
+	return auo
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (auo *AnnouncementUpdateOne) ClearStartsAt() *AnnouncementUpdateOne {
+	auo.mutation.ClearStartsAt()
+	return auo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (auo *AnnouncementUpdateOne) SetEndsAt(t time.Time) *AnnouncementUpdateOne {
+	auo.mutation.SetEndsAt(t)
+	return auo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (auo *AnnouncementUpdateOne) SetNillableEndsAt(t *time.Time) *AnnouncementUpdateOne {
+	if t != nil {
+		auo.SetEndsAt(*t)
+	}
+	return auo
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (auo *AnnouncementUpdateOne) ClearEndsAt() *AnnouncementUpdateOne {
+	auo.mutation.ClearEndsAt()
+	return auo
+}
+
+// SetDismissible sets the "dismissible" field.
+func (auo *AnnouncementUpdateOne) SetDismissible(b bool) *AnnouncementUpdateOne {
+	auo.mutation.SetDismissible(b)
+	return auo
+}
+
+// SetNillableDismissible sets the "dismissible" field if the given value is not nil.
+func (auo *AnnouncementUpdateOne) SetNillableDismissible(b *bool) *AnnouncementUpdateOne {
+	if b != nil {
+		auo.SetDismissible(*b)
+	}
+	return auo
+}
+
+// AddDismissedByIDs adds the "dismissed_by" edge to the User entity by IDs.
+func (auo *AnnouncementUpdateOne) AddDismissedByIDs(ids ...int) *AnnouncementUpdateOne {
+	auo.mutation.AddDismissedByIDs(ids...)
+	return auo
+}
+
+// AddDismissedBy adds the "dismissed_by" edges to the User entity.
+func (auo *AnnouncementUpdateOne) AddDismissedBy(u ...*User) *AnnouncementUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return auo.AddDismissedByIDs(ids...)
+}
+
+// Mutation returns the AnnouncementMutation object of the builder.
+func (auo *AnnouncementUpdateOne) Mutation() *AnnouncementMutation {
+	return auo.mutation
+}
+
+// ClearDismissedBy clears all "dismissed_by" edges to the User entity.
+func (auo *AnnouncementUpdateOne) ClearDismissedBy() *AnnouncementUpdateOne {
+	auo.mutation.ClearDismissedBy()
+	return auo
+}
+
+// RemoveDismissedByIDs removes the "dismissed_by" edge to User entities by IDs.
+func (auo *AnnouncementUpdateOne) RemoveDismissedByIDs(ids ...int) *AnnouncementUpdateOne {
+	auo.mutation.RemoveDismissedByIDs(ids...)
+	return auo
+}
+
+// RemoveDismissedBy removes "dismissed_by" edges to User entities.
+func (auo *AnnouncementUpdateOne) RemoveDismissedBy(u ...*User) *AnnouncementUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return auo.RemoveDismissedByIDs(ids...)
+}
+
+// Where appends a list predicates to the AnnouncementUpdate builder.
+func (auo *AnnouncementUpdateOne) Where(ps ...predicate.Announcement) *AnnouncementUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AnnouncementUpdateOne) Select(field string, fields ...string) *AnnouncementUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Announcement entity.
+func (auo *AnnouncementUpdateOne) Save(ctx context.Context) (*Announcement, error) {
+	if err := auo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AnnouncementUpdateOne) SaveX(ctx context.Context) *Announcement {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AnnouncementUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AnnouncementUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (auo *AnnouncementUpdateOne) defaults() error {
+	if _, ok := auo.mutation.UpdatedAt(); !ok {
+		if announcement.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized announcement.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := announcement.UpdateDefaultUpdatedAt()
+		auo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (auo *AnnouncementUpdateOne) sqlSave(ctx context.Context) (_node *Announcement, err error) {
+	_spec := sqlgraph.NewUpdateSpec(announcement.Table, announcement.Columns, sqlgraph.NewFieldSpec(announcement.FieldID, field.TypeInt))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Announcement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, announcement.FieldID)
+		for _, f := range fields {
+			if !announcement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != announcement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.UpdatedAt(); ok {
+		_spec.SetField(announcement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := auo.mutation.DeletedAt(); ok {
+		_spec.SetField(announcement.FieldDeletedAt, field.TypeTime, value)
+	}
+	if auo.mutation.DeletedAtCleared() {
+		_spec.ClearField(announcement.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.Title(); ok {
+		_spec.SetField(announcement.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.Body(); ok {
+		_spec.SetField(announcement.FieldBody, field.TypeString, value)
+	}
+	if auo.mutation.BodyCleared() {
+		_spec.ClearField(announcement.FieldBody, field.TypeString)
+	}
+	if value, ok := auo.mutation.Groups(); ok {
+		_spec.SetField(announcement.FieldGroups, field.TypeJSON, value)
+	}
+	if value, ok := auo.mutation.AppendedGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, announcement.FieldGroups, value)
+		})
+	}
+	if auo.mutation.GroupsCleared() {
+		_spec.ClearField(announcement.FieldGroups, field.TypeJSON)
+	}
+	if value, ok := auo.mutation.StartsAt(); ok {
+		_spec.SetField(announcement.FieldStartsAt, field.TypeTime, value)
+	}
+	if auo.mutation.StartsAtCleared() {
+		_spec.ClearField(announcement.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.EndsAt(); ok {
+		_spec.SetField(announcement.FieldEndsAt, field.TypeTime, value)
+	}
+	if auo.mutation.EndsAtCleared() {
+		_spec.ClearField(announcement.FieldEndsAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.Dismissible(); ok {
+		_spec.SetField(announcement.FieldDismissible, field.TypeBool, value)
+	}
+	if auo.mutation.DismissedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   announcement.DismissedByTable,
+			Columns: announcement.DismissedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedDismissedByIDs(); len(nodes) > 0 && !auo.mutation.DismissedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   announcement.DismissedByTable,
+			Columns: announcement.DismissedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.DismissedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   announcement.DismissedByTable,
+			Columns: announcement.DismissedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Announcement{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{announcement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/auditlog"
+)
+
+// AuditLog is the model entity for the AuditLog schema.
+type AuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// ActorID holds the value of the "actor_id" field.
+	ActorID int `json:"actor_id,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// CorrelationID holds the value of the "correlation_id" field.
+	CorrelationID string `json:"correlation_id,omitempty"`
+	// EntityType holds the value of the "entity_type" field.
+	EntityType string `json:"entity_type,omitempty"`
+	// EntityID holds the value of the "entity_id" field.
+	EntityID int `json:"entity_id,omitempty"`
+	// Content holds the value of the "content" field.
+	Content      map[string]string `json:"content,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldContent:
+			values[i] = new([]byte)
+		case auditlog.FieldID, auditlog.FieldUserID, auditlog.FieldActorID, auditlog.FieldEntityID:
+			values[i] = new(sql.NullInt64)
+		case auditlog.FieldType, auditlog.FieldIP, auditlog.FieldUserAgent, auditlog.FieldCorrelationID, auditlog.FieldEntityType:
+			values[i] = new(sql.NullString)
+		case auditlog.FieldCreatedAt, auditlog.FieldUpdatedAt, auditlog.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditLog fields.
+func (al *AuditLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			al.ID = int(value.Int64)
+		case auditlog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				al.CreatedAt = value.Time
+			}
+		case auditlog.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				al.UpdatedAt = value.Time
+			}
+		case auditlog.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				al.DeletedAt = new(time.Time)
+				*al.DeletedAt = value.Time
+			}
+		case auditlog.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				al.Type = value.String
+			}
+		case auditlog.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				al.UserID = int(value.Int64)
+			}
+		case auditlog.FieldActorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				al.ActorID = int(value.Int64)
+			}
+		case auditlog.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				al.IP = value.String
+			}
+		case auditlog.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				al.UserAgent = value.String
+			}
+		case auditlog.FieldCorrelationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field correlation_id", values[i])
+			} else if value.Valid {
+				al.CorrelationID = value.String
+			}
+		case auditlog.FieldEntityType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_type", values[i])
+			} else if value.Valid {
+				al.EntityType = value.String
+			}
+		case auditlog.FieldEntityID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				al.EntityID = int(value.Int64)
+			}
+		case auditlog.FieldContent:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &al.Content); err != nil {
+					return fmt.Errorf("unmarshal field content: %w", err)
+				}
+			}
+		default:
+			al.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditLog.
+// This includes values selected through modifiers, order, etc.
+func (al *AuditLog) Value(name string) (ent.Value, error) {
+	return al.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditLog.
+// Note that you need to call AuditLog.Unwrap() before calling this method if this AuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (al *AuditLog) Update() *AuditLogUpdateOne {
+	return NewAuditLogClient(al.config).UpdateOne(al)
+}
+
+// Unwrap unwraps the AuditLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (al *AuditLog) Unwrap() *AuditLog {
+	_tx, ok := al.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditLog is not a transactional entity")
+	}
+	al.config.driver = _tx.drv
+	return al
+}
+
+// String implements the fmt.Stringer.
+func (al *AuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", al.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(al.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(al.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := al.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(al.Type)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("actor_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.ActorID))
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(al.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(al.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("correlation_id=")
+	builder.WriteString(al.CorrelationID)
+	builder.WriteString(", ")
+	builder.WriteString("entity_type=")
+	builder.WriteString(al.EntityType)
+	builder.WriteString(", ")
+	builder.WriteString("entity_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.EntityID))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(fmt.Sprintf("%v", al.Content))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditLogs is a parsable slice of AuditLog.
+type AuditLogs []*AuditLog
@@ -0,0 +1,770 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/auditlog"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (alu *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	alu.mutation.Where(ps...)
+	return alu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (alu *AuditLogUpdate) SetUpdatedAt(t time.Time) *AuditLogUpdate {
+	alu.mutation.SetUpdatedAt(t)
+	return alu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (alu *AuditLogUpdate) SetDeletedAt(t time.Time) *AuditLogUpdate {
+	alu.mutation.SetDeletedAt(t)
+	return alu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableDeletedAt(t *time.Time) *AuditLogUpdate {
+	if t != nil {
+		alu.SetDeletedAt(*t)
+	}
+	return alu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (alu *AuditLogUpdate) ClearDeletedAt() *AuditLogUpdate {
+	alu.mutation.ClearDeletedAt()
+	return alu
+}
+
+// SetType sets the "type" field.
+func (alu *AuditLogUpdate) SetType(s string) *AuditLogUpdate {
+	alu.mutation.SetType(s)
+	return alu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableType(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetType(*s)
+	}
+	return alu
+}
+
+// SetUserID sets the "user_id" field.
+func (alu *AuditLogUpdate) SetUserID(i int) *AuditLogUpdate {
+	alu.mutation.ResetUserID()
+	alu.mutation.SetUserID(i)
+	return alu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableUserID(i *int) *AuditLogUpdate {
+	if i != nil {
+		alu.SetUserID(*i)
+	}
+	return alu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (alu *AuditLogUpdate) AddUserID(i int) *AuditLogUpdate {
+	alu.mutation.AddUserID(i)
+	return alu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (alu *AuditLogUpdate) ClearUserID() *AuditLogUpdate {
+	alu.mutation.ClearUserID()
+	return alu
+}
+
+// SetActorID sets the "actor_id" field.
+func (alu *AuditLogUpdate) SetActorID(i int) *AuditLogUpdate {
+	alu.mutation.ResetActorID()
+	alu.mutation.SetActorID(i)
+	return alu
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableActorID(i *int) *AuditLogUpdate {
+	if i != nil {
+		alu.SetActorID(*i)
+	}
+	return alu
+}
+
+// AddActorID adds i to the "actor_id" field.
+func (alu *AuditLogUpdate) AddActorID(i int) *AuditLogUpdate {
+	alu.mutation.AddActorID(i)
+	return alu
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (alu *AuditLogUpdate) ClearActorID() *AuditLogUpdate {
+	alu.mutation.ClearActorID()
+	return alu
+}
+
+// SetIP sets the "ip" field.
+func (alu *AuditLogUpdate) SetIP(s string) *AuditLogUpdate {
+	alu.mutation.SetIP(s)
+	return alu
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableIP(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetIP(*s)
+	}
+	return alu
+}
+
+// ClearIP clears the value of the "ip" field.
+func (alu *AuditLogUpdate) ClearIP() *AuditLogUpdate {
+	alu.mutation.ClearIP()
+	return alu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (alu *AuditLogUpdate) SetUserAgent(s string) *AuditLogUpdate {
+	alu.mutation.SetUserAgent(s)
+	return alu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableUserAgent(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetUserAgent(*s)
+	}
+	return alu
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (alu *AuditLogUpdate) ClearUserAgent() *AuditLogUpdate {
+	alu.mutation.ClearUserAgent()
+	return alu
+}
+
+// SetCorrelationID sets the "correlation_id" field.
+func (alu *AuditLogUpdate) SetCorrelationID(s string) *AuditLogUpdate {
+	alu.mutation.SetCorrelationID(s)
+	return alu
+}
+
+// SetNillableCorrelationID sets the "correlation_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableCorrelationID(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetCorrelationID(*s)
+	}
+	return alu
+}
+
+// ClearCorrelationID clears the value of the "correlation_id" field.
+func (alu *AuditLogUpdate) ClearCorrelationID() *AuditLogUpdate {
+	alu.mutation.ClearCorrelationID()
+	return alu
+}
+
+// SetEntityType sets the "entity_type" field.
+func (alu *AuditLogUpdate) SetEntityType(s string) *AuditLogUpdate {
+	alu.mutation.SetEntityType(s)
+	return alu
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableEntityType(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetEntityType(*s)
+	}
+	return alu
+}
+
+// ClearEntityType clears the value of the "entity_type" field.
+func (alu *AuditLogUpdate) ClearEntityType() *AuditLogUpdate {
+	alu.mutation.ClearEntityType()
+	return alu
+}
+
+// SetEntityID sets the "entity_id" field.
+func (alu *AuditLogUpdate) SetEntityID(i int) *AuditLogUpdate {
+	alu.mutation.ResetEntityID()
+	alu.mutation.SetEntityID(i)
+	return alu
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableEntityID(i *int) *AuditLogUpdate {
+	if i != nil {
+		alu.SetEntityID(*i)
+	}
+	return alu
+}
+
+// AddEntityID adds i to the "entity_id" field.
+func (alu *AuditLogUpdate) AddEntityID(i int) *AuditLogUpdate {
+	alu.mutation.AddEntityID(i)
+	return alu
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (alu *AuditLogUpdate) ClearEntityID() *AuditLogUpdate {
+	alu.mutation.ClearEntityID()
+	return alu
+}
+
+// SetContent sets the "content" field.
+func (alu *AuditLogUpdate) SetContent(m map[string]string) *AuditLogUpdate {
+	alu.mutation.SetContent(m)
+	return alu
+}
+
+// ClearContent clears the value of the "content" field.
+func (alu *AuditLogUpdate) ClearContent() *AuditLogUpdate {
+	alu.mutation.ClearContent()
+	return alu
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alu *AuditLogUpdate) Mutation() *AuditLogMutation {
+	return alu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (alu *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+	if err := alu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, alu.sqlSave, alu.mutation, alu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alu *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := alu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (alu *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := alu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alu *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := alu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alu *AuditLogUpdate) defaults() error {
+	if _, ok := alu.mutation.UpdatedAt(); !ok {
+		if auditlog.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized auditlog.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := auditlog.UpdateDefaultUpdatedAt()
+		alu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (alu *AuditLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt))
+	if ps := alu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := alu.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := alu.mutation.DeletedAt(); ok {
+		_spec.SetField(auditlog.FieldDeletedAt, field.TypeTime, value)
+	}
+	if alu.mutation.DeletedAtCleared() {
+		_spec.ClearField(auditlog.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := alu.mutation.GetType(); ok {
+		_spec.SetField(auditlog.FieldType, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.UserID(); ok {
+		_spec.SetField(auditlog.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := alu.mutation.AddedUserID(); ok {
+		_spec.AddField(auditlog.FieldUserID, field.TypeInt, value)
+	}
+	if alu.mutation.UserIDCleared() {
+		_spec.ClearField(auditlog.FieldUserID, field.TypeInt)
+	}
+	if value, ok := alu.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeInt, value)
+	}
+	if value, ok := alu.mutation.AddedActorID(); ok {
+		_spec.AddField(auditlog.FieldActorID, field.TypeInt, value)
+	}
+	if alu.mutation.ActorIDCleared() {
+		_spec.ClearField(auditlog.FieldActorID, field.TypeInt)
+	}
+	if value, ok := alu.mutation.IP(); ok {
+		_spec.SetField(auditlog.FieldIP, field.TypeString, value)
+	}
+	if alu.mutation.IPCleared() {
+		_spec.ClearField(auditlog.FieldIP, field.TypeString)
+	}
+	if value, ok := alu.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+	}
+	if alu.mutation.UserAgentCleared() {
+		_spec.ClearField(auditlog.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := alu.mutation.CorrelationID(); ok {
+		_spec.SetField(auditlog.FieldCorrelationID, field.TypeString, value)
+	}
+	if alu.mutation.CorrelationIDCleared() {
+		_spec.ClearField(auditlog.FieldCorrelationID, field.TypeString)
+	}
+	if value, ok := alu.mutation.EntityType(); ok {
+		_spec.SetField(auditlog.FieldEntityType, field.TypeString, value)
+	}
+	if alu.mutation.EntityTypeCleared() {
+		_spec.ClearField(auditlog.FieldEntityType, field.TypeString)
+	}
+	if value, ok := alu.mutation.EntityID(); ok {
+		_spec.SetField(auditlog.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := alu.mutation.AddedEntityID(); ok {
+		_spec.AddField(auditlog.FieldEntityID, field.TypeInt, value)
+	}
+	if alu.mutation.EntityIDCleared() {
+		_spec.ClearField(auditlog.FieldEntityID, field.TypeInt)
+	}
+	if value, ok := alu.mutation.Content(); ok {
+		_spec.SetField(auditlog.FieldContent, field.TypeJSON, value)
+	}
+	if alu.mutation.ContentCleared() {
+		_spec.ClearField(auditlog.FieldContent, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, alu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	alu.mutation.done = true
+	return n, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aluo *AuditLogUpdateOne) SetUpdatedAt(t time.Time) *AuditLogUpdateOne {
+	aluo.mutation.SetUpdatedAt(t)
+	return aluo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (aluo *AuditLogUpdateOne) SetDeletedAt(t time.Time) *AuditLogUpdateOne {
+	aluo.mutation.SetDeletedAt(t)
+	return aluo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableDeletedAt(t *time.Time) *AuditLogUpdateOne {
+	if t != nil {
+		aluo.SetDeletedAt(*t)
+	}
+	return aluo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (aluo *AuditLogUpdateOne) ClearDeletedAt() *AuditLogUpdateOne {
+	aluo.mutation.ClearDeletedAt()
+	return aluo
+}
+
+// SetType sets the "type" field.
+func (aluo *AuditLogUpdateOne) SetType(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetType(s)
+	return aluo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableType(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetType(*s)
+	}
+	return aluo
+}
+
+// SetUserID sets the "user_id" field.
+func (aluo *AuditLogUpdateOne) SetUserID(i int) *AuditLogUpdateOne {
+	aluo.mutation.ResetUserID()
+	aluo.mutation.SetUserID(i)
+	return aluo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableUserID(i *int) *AuditLogUpdateOne {
+	if i != nil {
+		aluo.SetUserID(*i)
+	}
+	return aluo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (aluo *AuditLogUpdateOne) AddUserID(i int) *AuditLogUpdateOne {
+	aluo.mutation.AddUserID(i)
+	return aluo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (aluo *AuditLogUpdateOne) ClearUserID() *AuditLogUpdateOne {
+	aluo.mutation.ClearUserID()
+	return aluo
+}
+
+// SetActorID sets the "actor_id" field.
+func (aluo *AuditLogUpdateOne) SetActorID(i int) *AuditLogUpdateOne {
+	aluo.mutation.ResetActorID()
+	aluo.mutation.SetActorID(i)
+	return aluo
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableActorID(i *int) *AuditLogUpdateOne {
+	if i != nil {
+		aluo.SetActorID(*i)
+	}
+	return aluo
+}
+
+// AddActorID adds i to the "actor_id" field.
+func (aluo *AuditLogUpdateOne) AddActorID(i int) *AuditLogUpdateOne {
+	aluo.mutation.AddActorID(i)
+	return aluo
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (aluo *AuditLogUpdateOne) ClearActorID() *AuditLogUpdateOne {
+	aluo.mutation.ClearActorID()
+	return aluo
+}
+
+// SetIP sets the "ip" field.
+func (aluo *AuditLogUpdateOne) SetIP(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetIP(s)
+	return aluo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableIP(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetIP(*s)
+	}
+	return aluo
+}
+
+// ClearIP clears the value of the "ip" field.
+func (aluo *AuditLogUpdateOne) ClearIP() *AuditLogUpdateOne {
+	aluo.mutation.ClearIP()
+	return aluo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (aluo *AuditLogUpdateOne) SetUserAgent(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetUserAgent(s)
+	return aluo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableUserAgent(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetUserAgent(*s)
+	}
+	return aluo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (aluo *AuditLogUpdateOne) ClearUserAgent() *AuditLogUpdateOne {
+	aluo.mutation.ClearUserAgent()
+	return aluo
+}
+
+// SetCorrelationID sets the "correlation_id" field.
+func (aluo *AuditLogUpdateOne) SetCorrelationID(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetCorrelationID(s)
+	return aluo
+}
+
+// SetNillableCorrelationID sets the "correlation_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableCorrelationID(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetCorrelationID(*s)
+	}
+	return aluo
+}
+
+// ClearCorrelationID clears the value of the "correlation_id" field.
+func (aluo *AuditLogUpdateOne) ClearCorrelationID() *AuditLogUpdateOne {
+	aluo.mutation.ClearCorrelationID()
+	return aluo
+}
+
+// SetEntityType sets the "entity_type" field.
+func (aluo *AuditLogUpdateOne) SetEntityType(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetEntityType(s)
+	return aluo
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableEntityType(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetEntityType(*s)
+	}
+	return aluo
+}
+
+// ClearEntityType clears the value of the "entity_type" field.
+func (aluo *AuditLogUpdateOne) ClearEntityType() *AuditLogUpdateOne {
+	aluo.mutation.ClearEntityType()
+	return aluo
+}
+
+// SetEntityID sets the "entity_id" field.
+func (aluo *AuditLogUpdateOne) SetEntityID(i int) *AuditLogUpdateOne {
+	aluo.mutation.ResetEntityID()
+	aluo.mutation.SetEntityID(i)
+	return aluo
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableEntityID(i *int) *AuditLogUpdateOne {
+	if i != nil {
+		aluo.SetEntityID(*i)
+	}
+	return aluo
+}
+
+// AddEntityID adds i to the "entity_id" field.
+func (aluo *AuditLogUpdateOne) AddEntityID(i int) *AuditLogUpdateOne {
+	aluo.mutation.AddEntityID(i)
+	return aluo
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (aluo *AuditLogUpdateOne) ClearEntityID() *AuditLogUpdateOne {
+	aluo.mutation.ClearEntityID()
+	return aluo
+}
+
+// SetContent sets the "content" field.
+func (aluo *AuditLogUpdateOne) SetContent(m map[string]string) *AuditLogUpdateOne {
+	aluo.mutation.SetContent(m)
+	return aluo
+}
+
+// ClearContent clears the value of the "content" field.
+func (aluo *AuditLogUpdateOne) ClearContent() *AuditLogUpdateOne {
+	aluo.mutation.ClearContent()
+	return aluo
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (aluo *AuditLogUpdateOne) Mutation() *AuditLogMutation {
+	return aluo.mutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (aluo *AuditLogUpdateOne) Where(ps ...predicate.AuditLog) *AuditLogUpdateOne {
+	aluo.mutation.Where(ps...)
+	return aluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aluo *AuditLogUpdateOne) Select(field string, fields ...string) *AuditLogUpdateOne {
+	aluo.fields = append([]string{field}, fields...)
+	return aluo
+}
+
+// Save executes the query and returns the updated AuditLog entity.
+func (aluo *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+	if err := aluo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, aluo.sqlSave, aluo.mutation, aluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	node, err := aluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aluo *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := aluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := aluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aluo *AuditLogUpdateOne) defaults() error {
+	if _, ok := aluo.mutation.UpdatedAt(); !ok {
+		if auditlog.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized auditlog.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := auditlog.UpdateDefaultUpdatedAt()
+		aluo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (aluo *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt))
+	id, ok := aluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlog.FieldID)
+		for _, f := range fields {
+			if !auditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aluo.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := aluo.mutation.DeletedAt(); ok {
+		_spec.SetField(auditlog.FieldDeletedAt, field.TypeTime, value)
+	}
+	if aluo.mutation.DeletedAtCleared() {
+		_spec.ClearField(auditlog.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := aluo.mutation.GetType(); ok {
+		_spec.SetField(auditlog.FieldType, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.UserID(); ok {
+		_spec.SetField(auditlog.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := aluo.mutation.AddedUserID(); ok {
+		_spec.AddField(auditlog.FieldUserID, field.TypeInt, value)
+	}
+	if aluo.mutation.UserIDCleared() {
+		_spec.ClearField(auditlog.FieldUserID, field.TypeInt)
+	}
+	if value, ok := aluo.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeInt, value)
+	}
+	if value, ok := aluo.mutation.AddedActorID(); ok {
+		_spec.AddField(auditlog.FieldActorID, field.TypeInt, value)
+	}
+	if aluo.mutation.ActorIDCleared() {
+		_spec.ClearField(auditlog.FieldActorID, field.TypeInt)
+	}
+	if value, ok := aluo.mutation.IP(); ok {
+		_spec.SetField(auditlog.FieldIP, field.TypeString, value)
+	}
+	if aluo.mutation.IPCleared() {
+		_spec.ClearField(auditlog.FieldIP, field.TypeString)
+	}
+	if value, ok := aluo.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+	}
+	if aluo.mutation.UserAgentCleared() {
+		_spec.ClearField(auditlog.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := aluo.mutation.CorrelationID(); ok {
+		_spec.SetField(auditlog.FieldCorrelationID, field.TypeString, value)
+	}
+	if aluo.mutation.CorrelationIDCleared() {
+		_spec.ClearField(auditlog.FieldCorrelationID, field.TypeString)
+	}
+	if value, ok := aluo.mutation.EntityType(); ok {
+		_spec.SetField(auditlog.FieldEntityType, field.TypeString, value)
+	}
+	if aluo.mutation.EntityTypeCleared() {
+		_spec.ClearField(auditlog.FieldEntityType, field.TypeString)
+	}
+	if value, ok := aluo.mutation.EntityID(); ok {
+		_spec.SetField(auditlog.FieldEntityID, field.TypeInt, value)
+	}
+	if value, ok := aluo.mutation.AddedEntityID(); ok {
+		_spec.AddField(auditlog.FieldEntityID, field.TypeInt, value)
+	}
+	if aluo.mutation.EntityIDCleared() {
+		_spec.ClearField(auditlog.FieldEntityID, field.TypeInt)
+	}
+	if value, ok := aluo.mutation.Content(); ok {
+		_spec.SetField(auditlog.FieldContent, field.TypeJSON, value)
+	}
+	if aluo.mutation.ContentCleared() {
+		_spec.ClearField(auditlog.FieldContent, field.TypeJSON)
+	}
+	_node = &AuditLog{config: aluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aluo.mutation.done = true
+	return _node, nil
+}
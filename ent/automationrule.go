@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/automationrule"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// AutomationRule is the model entity for the AutomationRule schema.
+type AutomationRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Trigger holds the value of the "trigger" field.
+	Trigger string `json:"trigger,omitempty"`
+	// Conditions holds the value of the "conditions" field.
+	Conditions *types.AutomationConditions `json:"conditions,omitempty"`
+	// Actions holds the value of the "actions" field.
+	Actions []types.AutomationAction `json:"actions,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// LastTriggeredAt holds the value of the "last_triggered_at" field.
+	LastTriggeredAt *time.Time `json:"last_triggered_at,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AutomationRuleQuery when eager-loading is set.
+	Edges        AutomationRuleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AutomationRuleEdges holds the relations/edges for other nodes in the graph.
+type AutomationRuleEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AutomationRuleEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AutomationRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case automationrule.FieldConditions, automationrule.FieldActions:
+			values[i] = new([]byte)
+		case automationrule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case automationrule.FieldID, automationrule.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case automationrule.FieldName, automationrule.FieldTrigger, automationrule.FieldLastError:
+			values[i] = new(sql.NullString)
+		case automationrule.FieldCreatedAt, automationrule.FieldUpdatedAt, automationrule.FieldDeletedAt, automationrule.FieldLastTriggeredAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AutomationRule fields.
+func (ar *AutomationRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case automationrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ar.ID = int(value.Int64)
+		case automationrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ar.CreatedAt = value.Time
+			}
+		case automationrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ar.UpdatedAt = value.Time
+			}
+		case automationrule.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ar.DeletedAt = new(time.Time)
+				*ar.DeletedAt = value.Time
+			}
+		case automationrule.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ar.UserID = int(value.Int64)
+			}
+		case automationrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ar.Name = value.String
+			}
+		case automationrule.FieldTrigger:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger", values[i])
+			} else if value.Valid {
+				ar.Trigger = value.String
+			}
+		case automationrule.FieldConditions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field conditions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ar.Conditions); err != nil {
+					return fmt.Errorf("unmarshal field conditions: %w", err)
+				}
+			}
+		case automationrule.FieldActions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field actions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ar.Actions); err != nil {
+					return fmt.Errorf("unmarshal field actions: %w", err)
+				}
+			}
+		case automationrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				ar.Enabled = value.Bool
+			}
+		case automationrule.FieldLastTriggeredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_triggered_at", values[i])
+			} else if value.Valid {
+				ar.LastTriggeredAt = new(time.Time)
+				*ar.LastTriggeredAt = value.Time
+			}
+		case automationrule.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				ar.LastError = value.String
+			}
+		default:
+			ar.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AutomationRule.
+// This includes values selected through modifiers, order, etc.
+func (ar *AutomationRule) Value(name string) (ent.Value, error) {
+	return ar.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the AutomationRule entity.
+func (ar *AutomationRule) QueryUser() *UserQuery {
+	return NewAutomationRuleClient(ar.config).QueryUser(ar)
+}
+
+// Update returns a builder for updating this AutomationRule.
+// Note that you need to call AutomationRule.Unwrap() before calling this method if this AutomationRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ar *AutomationRule) Update() *AutomationRuleUpdateOne {
+	return NewAutomationRuleClient(ar.config).UpdateOne(ar)
+}
+
+// Unwrap unwraps the AutomationRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ar *AutomationRule) Unwrap() *AutomationRule {
+	_tx, ok := ar.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AutomationRule is not a transactional entity")
+	}
+	ar.config.driver = _tx.drv
+	return ar
+}
+
+// String implements the fmt.Stringer.
+func (ar *AutomationRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("AutomationRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ar.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ar.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ar.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ar.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ar.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ar.Name)
+	builder.WriteString(", ")
+	builder.WriteString("trigger=")
+	builder.WriteString(ar.Trigger)
+	builder.WriteString(", ")
+	builder.WriteString("conditions=")
+	builder.WriteString(fmt.Sprintf("%v", ar.Conditions))
+	builder.WriteString(", ")
+	builder.WriteString("actions=")
+	builder.WriteString(fmt.Sprintf("%v", ar.Actions))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", ar.Enabled))
+	builder.WriteString(", ")
+	if v := ar.LastTriggeredAt; v != nil {
+		builder.WriteString("last_triggered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(ar.LastError)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetUser manually set the edge as loaded state.
+func (e *AutomationRule) SetUser(v *User) {
+	e.Edges.User = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// AutomationRules is a parsable slice of AutomationRule.
+type AutomationRules []*AutomationRule
@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package automationrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldName, v))
+}
+
+// Trigger applies equality check predicate on the "trigger" field. It's identical to TriggerEQ.
+func Trigger(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldTrigger, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// LastTriggeredAt applies equality check predicate on the "last_triggered_at" field. It's identical to LastTriggeredAtEQ.
+func LastTriggeredAt(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldLastTriggeredAt, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldLastError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// TriggerGT applies the GT predicate on the "trigger" field.
+func TriggerGT(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGT(FieldTrigger, v))
+}
+
+// TriggerGTE applies the GTE predicate on the "trigger" field.
+func TriggerGTE(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGTE(FieldTrigger, v))
+}
+
+// TriggerLT applies the LT predicate on the "trigger" field.
+func TriggerLT(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLT(FieldTrigger, v))
+}
+
+// TriggerLTE applies the LTE predicate on the "trigger" field.
+func TriggerLTE(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLTE(FieldTrigger, v))
+}
+
+// TriggerContains applies the Contains predicate on the "trigger" field.
+func TriggerContains(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldContains(FieldTrigger, v))
+}
+
+// TriggerHasPrefix applies the HasPrefix predicate on the "trigger" field.
+func TriggerHasPrefix(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldHasPrefix(FieldTrigger, v))
+}
+
+// TriggerHasSuffix applies the HasSuffix predicate on the "trigger" field.
+func TriggerHasSuffix(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldHasSuffix(FieldTrigger, v))
+}
+
+// TriggerEqualFold applies the EqualFold predicate on the "trigger" field.
+func TriggerEqualFold(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEqualFold(FieldTrigger, v))
+}
+
+// TriggerContainsFold applies the ContainsFold predicate on the "trigger" field.
+func TriggerContainsFold(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldContainsFold(FieldTrigger, v))
+}
+
+// ConditionsIsNil applies the IsNil predicate on the "conditions" field.
+func ConditionsIsNil() predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIsNull(FieldConditions))
+}
+
+// ConditionsNotNil applies the NotNil predicate on the "conditions" field.
+func ConditionsNotNil() predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotNull(FieldConditions))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// LastTriggeredAtEQ applies the EQ predicate on the "last_triggered_at" field.
+func LastTriggeredAtEQ(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtNEQ applies the NEQ predicate on the "last_triggered_at" field.
+func LastTriggeredAtNEQ(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNEQ(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtIn applies the In predicate on the "last_triggered_at" field.
+func LastTriggeredAtIn(vs ...time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIn(FieldLastTriggeredAt, vs...))
+}
+
+// LastTriggeredAtNotIn applies the NotIn predicate on the "last_triggered_at" field.
+func LastTriggeredAtNotIn(vs ...time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotIn(FieldLastTriggeredAt, vs...))
+}
+
+// LastTriggeredAtGT applies the GT predicate on the "last_triggered_at" field.
+func LastTriggeredAtGT(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGT(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtGTE applies the GTE predicate on the "last_triggered_at" field.
+func LastTriggeredAtGTE(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGTE(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtLT applies the LT predicate on the "last_triggered_at" field.
+func LastTriggeredAtLT(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLT(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtLTE applies the LTE predicate on the "last_triggered_at" field.
+func LastTriggeredAtLTE(v time.Time) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLTE(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtIsNil applies the IsNil predicate on the "last_triggered_at" field.
+func LastTriggeredAtIsNil() predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIsNull(FieldLastTriggeredAt))
+}
+
+// LastTriggeredAtNotNil applies the NotNil predicate on the "last_triggered_at" field.
+func LastTriggeredAtNotNil() predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotNull(FieldLastTriggeredAt))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.AutomationRule {
+	return predicate.AutomationRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.AutomationRule {
+	return predicate.AutomationRule(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AutomationRule) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AutomationRule) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AutomationRule) predicate.AutomationRule {
+	return predicate.AutomationRule(sql.NotPredicates(p))
+}
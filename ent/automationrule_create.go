@@ -0,0 +1,1135 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/automationrule"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// AutomationRuleCreate is the builder for creating a AutomationRule entity.
+type AutomationRuleCreate struct {
+	config
+	mutation *AutomationRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (arc *AutomationRuleCreate) SetCreatedAt(t time.Time) *AutomationRuleCreate {
+	arc.mutation.SetCreatedAt(t)
+	return arc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (arc *AutomationRuleCreate) SetNillableCreatedAt(t *time.Time) *AutomationRuleCreate {
+	if t != nil {
+		arc.SetCreatedAt(*t)
+	}
+	return arc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (arc *AutomationRuleCreate) SetUpdatedAt(t time.Time) *AutomationRuleCreate {
+	arc.mutation.SetUpdatedAt(t)
+	return arc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (arc *AutomationRuleCreate) SetNillableUpdatedAt(t *time.Time) *AutomationRuleCreate {
+	if t != nil {
+		arc.SetUpdatedAt(*t)
+	}
+	return arc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (arc *AutomationRuleCreate) SetDeletedAt(t time.Time) *AutomationRuleCreate {
+	arc.mutation.SetDeletedAt(t)
+	return arc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (arc *AutomationRuleCreate) SetNillableDeletedAt(t *time.Time) *AutomationRuleCreate {
+	if t != nil {
+		arc.SetDeletedAt(*t)
+	}
+	return arc
+}
+
+// SetUserID sets the "user_id" field.
+func (arc *AutomationRuleCreate) SetUserID(i int) *AutomationRuleCreate {
+	arc.mutation.SetUserID(i)
+	return arc
+}
+
+// SetName sets the "name" field.
+func (arc *AutomationRuleCreate) SetName(s string) *AutomationRuleCreate {
+	arc.mutation.SetName(s)
+	return arc
+}
+
+// SetTrigger sets the "trigger" field.
+func (arc *AutomationRuleCreate) SetTrigger(s string) *AutomationRuleCreate {
+	arc.mutation.SetTrigger(s)
+	return arc
+}
+
+// SetConditions sets the "conditions" field.
+func (arc *AutomationRuleCreate) SetConditions(tc *types.AutomationConditions) *AutomationRuleCreate {
+	arc.mutation.SetConditions(tc)
+	return arc
+}
+
+// SetActions sets the "actions" field.
+func (arc *AutomationRuleCreate) SetActions(ta []types.AutomationAction) *AutomationRuleCreate {
+	arc.mutation.SetActions(ta)
+	return arc
+}
+
+// SetEnabled sets the "enabled" field.
+func (arc *AutomationRuleCreate) SetEnabled(b bool) *AutomationRuleCreate {
+	arc.mutation.SetEnabled(b)
+	return arc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (arc *AutomationRuleCreate) SetNillableEnabled(b *bool) *AutomationRuleCreate {
+	if b != nil {
+		arc.SetEnabled(*b)
+	}
+	return arc
+}
+
+// SetLastTriggeredAt sets the "last_triggered_at" field.
+func (arc *AutomationRuleCreate) SetLastTriggeredAt(t time.Time) *AutomationRuleCreate {
+	arc.mutation.SetLastTriggeredAt(t)
+	return arc
+}
+
+// SetNillableLastTriggeredAt sets the "last_triggered_at" field if the given value is not nil.
+func (arc *AutomationRuleCreate) SetNillableLastTriggeredAt(t *time.Time) *AutomationRuleCreate {
+	if t != nil {
+		arc.SetLastTriggeredAt(*t)
+	}
+	return arc
+}
+
+// SetLastError sets the "last_error" field.
+func (arc *AutomationRuleCreate) SetLastError(s string) *AutomationRuleCreate {
+	arc.mutation.SetLastError(s)
+	return arc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (arc *AutomationRuleCreate) SetNillableLastError(s *string) *AutomationRuleCreate {
+	if s != nil {
+		arc.SetLastError(*s)
+	}
+	return arc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (arc *AutomationRuleCreate) SetUser(u *User) *AutomationRuleCreate {
+	return arc.SetUserID(u.ID)
+}
+
+// Mutation returns the AutomationRuleMutation object of the builder.
+func (arc *AutomationRuleCreate) Mutation() *AutomationRuleMutation {
+	return arc.mutation
+}
+
+// Save creates the AutomationRule in the database.
+func (arc *AutomationRuleCreate) Save(ctx context.Context) (*AutomationRule, error) {
+	if err := arc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, arc.sqlSave, arc.mutation, arc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (arc *AutomationRuleCreate) SaveX(ctx context.Context) *AutomationRule {
+	v, err := arc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arc *AutomationRuleCreate) Exec(ctx context.Context) error {
+	_, err := arc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arc *AutomationRuleCreate) ExecX(ctx context.Context) {
+	if err := arc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (arc *AutomationRuleCreate) defaults() error {
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		if automationrule.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized automationrule.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := automationrule.DefaultCreatedAt()
+		arc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := arc.mutation.UpdatedAt(); !ok {
+		if automationrule.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized automationrule.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := automationrule.DefaultUpdatedAt()
+		arc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := arc.mutation.Enabled(); !ok {
+		v := automationrule.DefaultEnabled
+		arc.mutation.SetEnabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (arc *AutomationRuleCreate) check() error {
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AutomationRule.created_at"`)}
+	}
+	if _, ok := arc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AutomationRule.updated_at"`)}
+	}
+	if _, ok := arc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AutomationRule.user_id"`)}
+	}
+	if _, ok := arc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AutomationRule.name"`)}
+	}
+	if _, ok := arc.mutation.Trigger(); !ok {
+		return &ValidationError{Name: "trigger", err: errors.New(`ent: missing required field "AutomationRule.trigger"`)}
+	}
+	if _, ok := arc.mutation.Actions(); !ok {
+		return &ValidationError{Name: "actions", err: errors.New(`ent: missing required field "AutomationRule.actions"`)}
+	}
+	if _, ok := arc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "AutomationRule.enabled"`)}
+	}
+	if _, ok := arc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "AutomationRule.user"`)}
+	}
+	return nil
+}
+
+func (arc *AutomationRuleCreate) sqlSave(ctx context.Context) (*AutomationRule, error) {
+	if err := arc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := arc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, arc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	arc.mutation.id = &_node.ID
+	arc.mutation.done = true
+	return _node, nil
+}
+
+func (arc *AutomationRuleCreate) createSpec() (*AutomationRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AutomationRule{config: arc.config}
+		_spec = sqlgraph.NewCreateSpec(automationrule.Table, sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt))
+	)
+
+	if id, ok := arc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = arc.conflict
+	if value, ok := arc.mutation.CreatedAt(); ok {
+		_spec.SetField(automationrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := arc.mutation.UpdatedAt(); ok {
+		_spec.SetField(automationrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := arc.mutation.DeletedAt(); ok {
+		_spec.SetField(automationrule.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := arc.mutation.Name(); ok {
+		_spec.SetField(automationrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := arc.mutation.Trigger(); ok {
+		_spec.SetField(automationrule.FieldTrigger, field.TypeString, value)
+		_node.Trigger = value
+	}
+	if value, ok := arc.mutation.Conditions(); ok {
+		_spec.SetField(automationrule.FieldConditions, field.TypeJSON, value)
+		_node.Conditions = value
+	}
+	if value, ok := arc.mutation.Actions(); ok {
+		_spec.SetField(automationrule.FieldActions, field.TypeJSON, value)
+		_node.Actions = value
+	}
+	if value, ok := arc.mutation.Enabled(); ok {
+		_spec.SetField(automationrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := arc.mutation.LastTriggeredAt(); ok {
+		_spec.SetField(automationrule.FieldLastTriggeredAt, field.TypeTime, value)
+		_node.LastTriggeredAt = &value
+	}
+	if value, ok := arc.mutation.LastError(); ok {
+		_spec.SetField(automationrule.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if nodes := arc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   automationrule.UserTable,
+			Columns: []string{automationrule.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AutomationRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AutomationRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (arc *AutomationRuleCreate) OnConflict(opts ...sql.ConflictOption) *AutomationRuleUpsertOne {
+	arc.conflict = opts
+	return &AutomationRuleUpsertOne{
+		create: arc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AutomationRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (arc *AutomationRuleCreate) OnConflictColumns(columns ...string) *AutomationRuleUpsertOne {
+	arc.conflict = append(arc.conflict, sql.ConflictColumns(columns...))
+	return &AutomationRuleUpsertOne{
+		create: arc,
+	}
+}
+
+type (
+	// AutomationRuleUpsertOne is the builder for "upsert"-ing
+	//  one AutomationRule node.
+	AutomationRuleUpsertOne struct {
+		create *AutomationRuleCreate
+	}
+
+	// AutomationRuleUpsert is the "OnConflict" setter.
+	AutomationRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AutomationRuleUpsert) SetUpdatedAt(v time.Time) *AutomationRuleUpsert {
+	u.Set(automationrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AutomationRuleUpsert) UpdateUpdatedAt() *AutomationRuleUpsert {
+	u.SetExcluded(automationrule.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AutomationRuleUpsert) SetDeletedAt(v time.Time) *AutomationRuleUpsert {
+	u.Set(automationrule.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AutomationRuleUpsert) UpdateDeletedAt() *AutomationRuleUpsert {
+	u.SetExcluded(automationrule.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AutomationRuleUpsert) ClearDeletedAt() *AutomationRuleUpsert {
+	u.SetNull(automationrule.FieldDeletedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AutomationRuleUpsert) SetUserID(v int) *AutomationRuleUpsert {
+	u.Set(automationrule.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AutomationRuleUpsert) UpdateUserID() *AutomationRuleUpsert {
+	u.SetExcluded(automationrule.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *AutomationRuleUpsert) SetName(v string) *AutomationRuleUpsert {
+	u.Set(automationrule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AutomationRuleUpsert) UpdateName() *AutomationRuleUpsert {
+	u.SetExcluded(automationrule.FieldName)
+	return u
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *AutomationRuleUpsert) SetTrigger(v string) *AutomationRuleUpsert {
+	u.Set(automationrule.FieldTrigger, v)
+	return u
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *AutomationRuleUpsert) UpdateTrigger() *AutomationRuleUpsert {
+	u.SetExcluded(automationrule.FieldTrigger)
+	return u
+}
+
+// SetConditions sets the "conditions" field.
+func (u *AutomationRuleUpsert) SetConditions(v *types.AutomationConditions) *AutomationRuleUpsert {
+	u.Set(automationrule.FieldConditions, v)
+	return u
+}
+
+// UpdateConditions sets the "conditions" field to the value that was provided on create.
+func (u *AutomationRuleUpsert) UpdateConditions() *AutomationRuleUpsert {
+	u.SetExcluded(automationrule.FieldConditions)
+	return u
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (u *AutomationRuleUpsert) ClearConditions() *AutomationRuleUpsert {
+	u.SetNull(automationrule.FieldConditions)
+	return u
+}
+
+// SetActions sets the "actions" field.
+func (u *AutomationRuleUpsert) SetActions(v []types.AutomationAction) *AutomationRuleUpsert {
+	u.Set(automationrule.FieldActions, v)
+	return u
+}
+
+// UpdateActions sets the "actions" field to the value that was provided on create.
+func (u *AutomationRuleUpsert) UpdateActions() *AutomationRuleUpsert {
+	u.SetExcluded(automationrule.FieldActions)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *AutomationRuleUpsert) SetEnabled(v bool) *AutomationRuleUpsert {
+	u.Set(automationrule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *AutomationRuleUpsert) UpdateEnabled() *AutomationRuleUpsert {
+	u.SetExcluded(automationrule.FieldEnabled)
+	return u
+}
+
+// SetLastTriggeredAt sets the "last_triggered_at" field.
+func (u *AutomationRuleUpsert) SetLastTriggeredAt(v time.Time) *AutomationRuleUpsert {
+	u.Set(automationrule.FieldLastTriggeredAt, v)
+	return u
+}
+
+// UpdateLastTriggeredAt sets the "last_triggered_at" field to the value that was provided on create.
+func (u *AutomationRuleUpsert) UpdateLastTriggeredAt() *AutomationRuleUpsert {
+	u.SetExcluded(automationrule.FieldLastTriggeredAt)
+	return u
+}
+
+// ClearLastTriggeredAt clears the value of the "last_triggered_at" field.
+func (u *AutomationRuleUpsert) ClearLastTriggeredAt() *AutomationRuleUpsert {
+	u.SetNull(automationrule.FieldLastTriggeredAt)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *AutomationRuleUpsert) SetLastError(v string) *AutomationRuleUpsert {
+	u.Set(automationrule.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *AutomationRuleUpsert) UpdateLastError() *AutomationRuleUpsert {
+	u.SetExcluded(automationrule.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *AutomationRuleUpsert) ClearLastError() *AutomationRuleUpsert {
+	u.SetNull(automationrule.FieldLastError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.AutomationRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AutomationRuleUpsertOne) UpdateNewValues() *AutomationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(automationrule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AutomationRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AutomationRuleUpsertOne) Ignore() *AutomationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AutomationRuleUpsertOne) DoNothing() *AutomationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AutomationRuleCreate.OnConflict
+// documentation for more info.
+func (u *AutomationRuleUpsertOne) Update(set func(*AutomationRuleUpsert)) *AutomationRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AutomationRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AutomationRuleUpsertOne) SetUpdatedAt(v time.Time) *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AutomationRuleUpsertOne) UpdateUpdatedAt() *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AutomationRuleUpsertOne) SetDeletedAt(v time.Time) *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AutomationRuleUpsertOne) UpdateDeletedAt() *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AutomationRuleUpsertOne) ClearDeletedAt() *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AutomationRuleUpsertOne) SetUserID(v int) *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AutomationRuleUpsertOne) UpdateUserID() *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AutomationRuleUpsertOne) SetName(v string) *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AutomationRuleUpsertOne) UpdateName() *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *AutomationRuleUpsertOne) SetTrigger(v string) *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *AutomationRuleUpsertOne) UpdateTrigger() *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// SetConditions sets the "conditions" field.
+func (u *AutomationRuleUpsertOne) SetConditions(v *types.AutomationConditions) *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetConditions(v)
+	})
+}
+
+// UpdateConditions sets the "conditions" field to the value that was provided on create.
+func (u *AutomationRuleUpsertOne) UpdateConditions() *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateConditions()
+	})
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (u *AutomationRuleUpsertOne) ClearConditions() *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.ClearConditions()
+	})
+}
+
+// SetActions sets the "actions" field.
+func (u *AutomationRuleUpsertOne) SetActions(v []types.AutomationAction) *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetActions(v)
+	})
+}
+
+// UpdateActions sets the "actions" field to the value that was provided on create.
+func (u *AutomationRuleUpsertOne) UpdateActions() *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateActions()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *AutomationRuleUpsertOne) SetEnabled(v bool) *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *AutomationRuleUpsertOne) UpdateEnabled() *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetLastTriggeredAt sets the "last_triggered_at" field.
+func (u *AutomationRuleUpsertOne) SetLastTriggeredAt(v time.Time) *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetLastTriggeredAt(v)
+	})
+}
+
+// UpdateLastTriggeredAt sets the "last_triggered_at" field to the value that was provided on create.
+func (u *AutomationRuleUpsertOne) UpdateLastTriggeredAt() *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateLastTriggeredAt()
+	})
+}
+
+// ClearLastTriggeredAt clears the value of the "last_triggered_at" field.
+func (u *AutomationRuleUpsertOne) ClearLastTriggeredAt() *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.ClearLastTriggeredAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *AutomationRuleUpsertOne) SetLastError(v string) *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *AutomationRuleUpsertOne) UpdateLastError() *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *AutomationRuleUpsertOne) ClearLastError() *AutomationRuleUpsertOne {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *AutomationRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AutomationRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AutomationRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AutomationRuleUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AutomationRuleUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *AutomationRuleCreate) SetRawID(t int) *AutomationRuleCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// AutomationRuleCreateBulk is the builder for creating many AutomationRule entities in bulk.
+type AutomationRuleCreateBulk struct {
+	config
+	err      error
+	builders []*AutomationRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AutomationRule entities in the database.
+func (arcb *AutomationRuleCreateBulk) Save(ctx context.Context) ([]*AutomationRule, error) {
+	if arcb.err != nil {
+		return nil, arcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(arcb.builders))
+	nodes := make([]*AutomationRule, len(arcb.builders))
+	mutators := make([]Mutator, len(arcb.builders))
+	for i := range arcb.builders {
+		func(i int, root context.Context) {
+			builder := arcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AutomationRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, arcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = arcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, arcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, arcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (arcb *AutomationRuleCreateBulk) SaveX(ctx context.Context) []*AutomationRule {
+	v, err := arcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arcb *AutomationRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := arcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arcb *AutomationRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := arcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AutomationRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AutomationRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (arcb *AutomationRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *AutomationRuleUpsertBulk {
+	arcb.conflict = opts
+	return &AutomationRuleUpsertBulk{
+		create: arcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AutomationRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (arcb *AutomationRuleCreateBulk) OnConflictColumns(columns ...string) *AutomationRuleUpsertBulk {
+	arcb.conflict = append(arcb.conflict, sql.ConflictColumns(columns...))
+	return &AutomationRuleUpsertBulk{
+		create: arcb,
+	}
+}
+
+// AutomationRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of AutomationRule nodes.
+type AutomationRuleUpsertBulk struct {
+	create *AutomationRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AutomationRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *AutomationRuleUpsertBulk) UpdateNewValues() *AutomationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(automationrule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AutomationRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AutomationRuleUpsertBulk) Ignore() *AutomationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AutomationRuleUpsertBulk) DoNothing() *AutomationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AutomationRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *AutomationRuleUpsertBulk) Update(set func(*AutomationRuleUpsert)) *AutomationRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AutomationRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AutomationRuleUpsertBulk) SetUpdatedAt(v time.Time) *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AutomationRuleUpsertBulk) UpdateUpdatedAt() *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *AutomationRuleUpsertBulk) SetDeletedAt(v time.Time) *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *AutomationRuleUpsertBulk) UpdateDeletedAt() *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *AutomationRuleUpsertBulk) ClearDeletedAt() *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AutomationRuleUpsertBulk) SetUserID(v int) *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AutomationRuleUpsertBulk) UpdateUserID() *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *AutomationRuleUpsertBulk) SetName(v string) *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *AutomationRuleUpsertBulk) UpdateName() *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *AutomationRuleUpsertBulk) SetTrigger(v string) *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *AutomationRuleUpsertBulk) UpdateTrigger() *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// SetConditions sets the "conditions" field.
+func (u *AutomationRuleUpsertBulk) SetConditions(v *types.AutomationConditions) *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetConditions(v)
+	})
+}
+
+// UpdateConditions sets the "conditions" field to the value that was provided on create.
+func (u *AutomationRuleUpsertBulk) UpdateConditions() *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateConditions()
+	})
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (u *AutomationRuleUpsertBulk) ClearConditions() *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.ClearConditions()
+	})
+}
+
+// SetActions sets the "actions" field.
+func (u *AutomationRuleUpsertBulk) SetActions(v []types.AutomationAction) *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetActions(v)
+	})
+}
+
+// UpdateActions sets the "actions" field to the value that was provided on create.
+func (u *AutomationRuleUpsertBulk) UpdateActions() *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateActions()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *AutomationRuleUpsertBulk) SetEnabled(v bool) *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *AutomationRuleUpsertBulk) UpdateEnabled() *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetLastTriggeredAt sets the "last_triggered_at" field.
+func (u *AutomationRuleUpsertBulk) SetLastTriggeredAt(v time.Time) *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetLastTriggeredAt(v)
+	})
+}
+
+// UpdateLastTriggeredAt sets the "last_triggered_at" field to the value that was provided on create.
+func (u *AutomationRuleUpsertBulk) UpdateLastTriggeredAt() *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateLastTriggeredAt()
+	})
+}
+
+// ClearLastTriggeredAt clears the value of the "last_triggered_at" field.
+func (u *AutomationRuleUpsertBulk) ClearLastTriggeredAt() *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.ClearLastTriggeredAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *AutomationRuleUpsertBulk) SetLastError(v string) *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *AutomationRuleUpsertBulk) UpdateLastError() *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *AutomationRuleUpsertBulk) ClearLastError() *AutomationRuleUpsertBulk {
+	return u.Update(func(s *AutomationRuleUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *AutomationRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AutomationRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AutomationRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AutomationRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
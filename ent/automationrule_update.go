@@ -0,0 +1,691 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/automationrule"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// AutomationRuleUpdate is the builder for updating AutomationRule entities.
+type AutomationRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AutomationRuleMutation
+}
+
+// Where appends a list predicates to the AutomationRuleUpdate builder.
+func (aru *AutomationRuleUpdate) Where(ps ...predicate.AutomationRule) *AutomationRuleUpdate {
+	aru.mutation.Where(ps...)
+	return aru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aru *AutomationRuleUpdate) SetUpdatedAt(t time.Time) *AutomationRuleUpdate {
+	aru.mutation.SetUpdatedAt(t)
+	return aru
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (aru *AutomationRuleUpdate) SetDeletedAt(t time.Time) *AutomationRuleUpdate {
+	aru.mutation.SetDeletedAt(t)
+	return aru
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (aru *AutomationRuleUpdate) SetNillableDeletedAt(t *time.Time) *AutomationRuleUpdate {
+	if t != nil {
+		aru.SetDeletedAt(*t)
+	}
+	return aru
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (aru *AutomationRuleUpdate) ClearDeletedAt() *AutomationRuleUpdate {
+	aru.mutation.ClearDeletedAt()
+	return aru
+}
+
+// SetUserID sets the "user_id" field.
+func (aru *AutomationRuleUpdate) SetUserID(i int) *AutomationRuleUpdate {
+	aru.mutation.SetUserID(i)
+	return aru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aru *AutomationRuleUpdate) SetNillableUserID(i *int) *AutomationRuleUpdate {
+	if i != nil {
+		aru.SetUserID(*i)
+	}
+	return aru
+}
+
+// SetName sets the "name" field.
+func (aru *AutomationRuleUpdate) SetName(s string) *AutomationRuleUpdate {
+	aru.mutation.SetName(s)
+	return aru
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (aru *AutomationRuleUpdate) SetNillableName(s *string) *AutomationRuleUpdate {
+	if s != nil {
+		aru.SetName(*s)
+	}
+	return aru
+}
+
+// SetTrigger sets the "trigger" field.
+func (aru *AutomationRuleUpdate) SetTrigger(s string) *AutomationRuleUpdate {
+	aru.mutation.SetTrigger(s)
+	return aru
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (aru *AutomationRuleUpdate) SetNillableTrigger(s *string) *AutomationRuleUpdate {
+	if s != nil {
+		aru.SetTrigger(*s)
+	}
+	return aru
+}
+
+// SetConditions sets the "conditions" field.
+func (aru *AutomationRuleUpdate) SetConditions(tc *types.AutomationConditions) *AutomationRuleUpdate {
+	aru.mutation.SetConditions(tc)
+	return aru
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (aru *AutomationRuleUpdate) ClearConditions() *AutomationRuleUpdate {
+	aru.mutation.ClearConditions()
+	return aru
+}
+
+// SetActions sets the "actions" field.
+func (aru *AutomationRuleUpdate) SetActions(ta []types.AutomationAction) *AutomationRuleUpdate {
+	aru.mutation.SetActions(ta)
+	return aru
+}
+
+// AppendActions appends ta to the "actions" field.
+func (aru *AutomationRuleUpdate) AppendActions(ta []types.AutomationAction) *AutomationRuleUpdate {
+	aru.mutation.AppendActions(ta)
+	return aru
+}
+
+// SetEnabled sets the "enabled" field.
+func (aru *AutomationRuleUpdate) SetEnabled(b bool) *AutomationRuleUpdate {
+	aru.mutation.SetEnabled(b)
+	return aru
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (aru *AutomationRuleUpdate) SetNillableEnabled(b *bool) *AutomationRuleUpdate {
+	if b != nil {
+		aru.SetEnabled(*b)
+	}
+	return aru
+}
+
+// SetLastTriggeredAt sets the "last_triggered_at" field.
+func (aru *AutomationRuleUpdate) SetLastTriggeredAt(t time.Time) *AutomationRuleUpdate {
+	aru.mutation.SetLastTriggeredAt(t)
+	return aru
+}
+
+// SetNillableLastTriggeredAt sets the "last_triggered_at" field if the given value is not nil.
+func (aru *AutomationRuleUpdate) SetNillableLastTriggeredAt(t *time.Time) *AutomationRuleUpdate {
+	if t != nil {
+		aru.SetLastTriggeredAt(*t)
+	}
+	return aru
+}
+
+// ClearLastTriggeredAt clears the value of the "last_triggered_at" field.
+func (aru *AutomationRuleUpdate) ClearLastTriggeredAt() *AutomationRuleUpdate {
+	aru.mutation.ClearLastTriggeredAt()
+	return aru
+}
+
+// SetLastError sets the "last_error" field.
+func (aru *AutomationRuleUpdate) SetLastError(s string) *AutomationRuleUpdate {
+	aru.mutation.SetLastError(s)
+	return aru
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (aru *AutomationRuleUpdate) SetNillableLastError(s *string) *AutomationRuleUpdate {
+	if s != nil {
+		aru.SetLastError(*s)
+	}
+	return aru
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (aru *AutomationRuleUpdate) ClearLastError() *AutomationRuleUpdate {
+	aru.mutation.ClearLastError()
+	return aru
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (aru *AutomationRuleUpdate) SetUser(u *User) *AutomationRuleUpdate {
+	return aru.SetUserID(u.ID)
+}
+
+// Mutation returns the AutomationRuleMutation object of the builder.
+func (aru *AutomationRuleUpdate) Mutation() *AutomationRuleMutation {
+	return aru.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (aru *AutomationRuleUpdate) ClearUser() *AutomationRuleUpdate {
+	aru.mutation.ClearUser()
+	return aru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aru *AutomationRuleUpdate) Save(ctx context.Context) (int, error) {
+	if err := aru.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, aru.sqlSave, aru.mutation, aru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aru *AutomationRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := aru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aru *AutomationRuleUpdate) Exec(ctx context.Context) error {
+	_, err := aru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aru *AutomationRuleUpdate) ExecX(ctx context.Context) {
+	if err := aru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aru *AutomationRuleUpdate) defaults() error {
+	if _, ok := aru.mutation.UpdatedAt(); !ok {
+		if automationrule.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized automationrule.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := automationrule.UpdateDefaultUpdatedAt()
+		aru.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aru *AutomationRuleUpdate) check() error {
+	if _, ok := aru.mutation.UserID(); aru.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AutomationRule.user"`)
+	}
+	return nil
+}
+
+func (aru *AutomationRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(automationrule.Table, automationrule.Columns, sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt))
+	if ps := aru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aru.mutation.UpdatedAt(); ok {
+		_spec.SetField(automationrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := aru.mutation.DeletedAt(); ok {
+		_spec.SetField(automationrule.FieldDeletedAt, field.TypeTime, value)
+	}
+	if aru.mutation.DeletedAtCleared() {
+		_spec.ClearField(automationrule.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := aru.mutation.Name(); ok {
+		_spec.SetField(automationrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := aru.mutation.Trigger(); ok {
+		_spec.SetField(automationrule.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := aru.mutation.Conditions(); ok {
+		_spec.SetField(automationrule.FieldConditions, field.TypeJSON, value)
+	}
+	if aru.mutation.ConditionsCleared() {
+		_spec.ClearField(automationrule.FieldConditions, field.TypeJSON)
+	}
+	if value, ok := aru.mutation.Actions(); ok {
+		_spec.SetField(automationrule.FieldActions, field.TypeJSON, value)
+	}
+	if value, ok := aru.mutation.AppendedActions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, automationrule.FieldActions, value)
+		})
+	}
+	if value, ok := aru.mutation.Enabled(); ok {
+		_spec.SetField(automationrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := aru.mutation.LastTriggeredAt(); ok {
+		_spec.SetField(automationrule.FieldLastTriggeredAt, field.TypeTime, value)
+	}
+	if aru.mutation.LastTriggeredAtCleared() {
+		_spec.ClearField(automationrule.FieldLastTriggeredAt, field.TypeTime)
+	}
+	if value, ok := aru.mutation.LastError(); ok {
+		_spec.SetField(automationrule.FieldLastError, field.TypeString, value)
+	}
+	if aru.mutation.LastErrorCleared() {
+		_spec.ClearField(automationrule.FieldLastError, field.TypeString)
+	}
+	if aru.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   automationrule.UserTable,
+			Columns: []string{automationrule.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aru.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   automationrule.UserTable,
+			Columns: []string{automationrule.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{automationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aru.mutation.done = true
+	return n, nil
+}
+
+// AutomationRuleUpdateOne is the builder for updating a single AutomationRule entity.
+type AutomationRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AutomationRuleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aruo *AutomationRuleUpdateOne) SetUpdatedAt(t time.Time) *AutomationRuleUpdateOne {
+	aruo.mutation.SetUpdatedAt(t)
+	return aruo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (aruo *AutomationRuleUpdateOne) SetDeletedAt(t time.Time) *AutomationRuleUpdateOne {
+	aruo.mutation.SetDeletedAt(t)
+	return aruo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (aruo *AutomationRuleUpdateOne) SetNillableDeletedAt(t *time.Time) *AutomationRuleUpdateOne {
+	if t != nil {
+		aruo.SetDeletedAt(*t)
+	}
+	return aruo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (aruo *AutomationRuleUpdateOne) ClearDeletedAt() *AutomationRuleUpdateOne {
+	aruo.mutation.ClearDeletedAt()
+	return aruo
+}
+
+// SetUserID sets the "user_id" field.
+func (aruo *AutomationRuleUpdateOne) SetUserID(i int) *AutomationRuleUpdateOne {
+	aruo.mutation.SetUserID(i)
+	return aruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aruo *AutomationRuleUpdateOne) SetNillableUserID(i *int) *AutomationRuleUpdateOne {
+	if i != nil {
+		aruo.SetUserID(*i)
+	}
+	return aruo
+}
+
+// SetName sets the "name" field.
+func (aruo *AutomationRuleUpdateOne) SetName(s string) *AutomationRuleUpdateOne {
+	aruo.mutation.SetName(s)
+	return aruo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (aruo *AutomationRuleUpdateOne) SetNillableName(s *string) *AutomationRuleUpdateOne {
+	if s != nil {
+		aruo.SetName(*s)
+	}
+	return aruo
+}
+
+// SetTrigger sets the "trigger" field.
+func (aruo *AutomationRuleUpdateOne) SetTrigger(s string) *AutomationRuleUpdateOne {
+	aruo.mutation.SetTrigger(s)
+	return aruo
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (aruo *AutomationRuleUpdateOne) SetNillableTrigger(s *string) *AutomationRuleUpdateOne {
+	if s != nil {
+		aruo.SetTrigger(*s)
+	}
+	return aruo
+}
+
+// SetConditions sets the "conditions" field.
+func (aruo *AutomationRuleUpdateOne) SetConditions(tc *types.AutomationConditions) *AutomationRuleUpdateOne {
+	aruo.mutation.SetConditions(tc)
+	return aruo
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (aruo *AutomationRuleUpdateOne) ClearConditions() *AutomationRuleUpdateOne {
+	aruo.mutation.ClearConditions()
+	return aruo
+}
+
+// SetActions sets the "actions" field.
+func (aruo *AutomationRuleUpdateOne) SetActions(ta []types.AutomationAction) *AutomationRuleUpdateOne {
+	aruo.mutation.SetActions(ta)
+	return aruo
+}
+
+// AppendActions appends ta to the "actions" field.
+func (aruo *AutomationRuleUpdateOne) AppendActions(ta []types.AutomationAction) *AutomationRuleUpdateOne {
+	aruo.mutation.AppendActions(ta)
+	return aruo
+}
+
+// SetEnabled sets the "enabled" field.
+func (aruo *AutomationRuleUpdateOne) SetEnabled(b bool) *AutomationRuleUpdateOne {
+	aruo.mutation.SetEnabled(b)
+	return aruo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (aruo *AutomationRuleUpdateOne) SetNillableEnabled(b *bool) *AutomationRuleUpdateOne {
+	if b != nil {
+		aruo.SetEnabled(*b)
+	}
+	return aruo
+}
+
+// SetLastTriggeredAt sets the "last_triggered_at" field.
+func (aruo *AutomationRuleUpdateOne) SetLastTriggeredAt(t time.Time) *AutomationRuleUpdateOne {
+	aruo.mutation.SetLastTriggeredAt(t)
+	return aruo
+}
+
+// SetNillableLastTriggeredAt sets the "last_triggered_at" field if the given value is not nil.
+func (aruo *AutomationRuleUpdateOne) SetNillableLastTriggeredAt(t *time.Time) *AutomationRuleUpdateOne {
+	if t != nil {
+		aruo.SetLastTriggeredAt(*t)
+	}
+	return aruo
+}
+
+// ClearLastTriggeredAt clears the value of the "last_triggered_at" field.
+func (aruo *AutomationRuleUpdateOne) ClearLastTriggeredAt() *AutomationRuleUpdateOne {
+	aruo.mutation.ClearLastTriggeredAt()
+	return aruo
+}
+
+// SetLastError sets the "last_error" field.
+func (aruo *AutomationRuleUpdateOne) SetLastError(s string) *AutomationRuleUpdateOne {
+	aruo.mutation.SetLastError(s)
+	return aruo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (aruo *AutomationRuleUpdateOne) SetNillableLastError(s *string) *AutomationRuleUpdateOne {
+	if s != nil {
+		aruo.SetLastError(*s)
+	}
+	return aruo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (aruo *AutomationRuleUpdateOne) ClearLastError() *AutomationRuleUpdateOne {
+	aruo.mutation.ClearLastError()
+	return aruo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (aruo *AutomationRuleUpdateOne) SetUser(u *User) *AutomationRuleUpdateOne {
+	return aruo.SetUserID(u.ID)
+}
+
+// Mutation returns the AutomationRuleMutation object of the builder.
+func (aruo *AutomationRuleUpdateOne) Mutation() *AutomationRuleMutation {
+	return aruo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (aruo *AutomationRuleUpdateOne) ClearUser() *AutomationRuleUpdateOne {
+	aruo.mutation.ClearUser()
+	return aruo
+}
+
+// Where appends a list predicates to the AutomationRuleUpdate builder.
+func (aruo *AutomationRuleUpdateOne) Where(ps ...predicate.AutomationRule) *AutomationRuleUpdateOne {
+	aruo.mutation.Where(ps...)
+	return aruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aruo *AutomationRuleUpdateOne) Select(field string, fields ...string) *AutomationRuleUpdateOne {
+	aruo.fields = append([]string{field}, fields...)
+	return aruo
+}
+
+// Save executes the query and returns the updated AutomationRule entity.
+func (aruo *AutomationRuleUpdateOne) Save(ctx context.Context) (*AutomationRule, error) {
+	if err := aruo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, aruo.sqlSave, aruo.mutation, aruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aruo *AutomationRuleUpdateOne) SaveX(ctx context.Context) *AutomationRule {
+	node, err := aruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aruo *AutomationRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := aruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aruo *AutomationRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := aruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aruo *AutomationRuleUpdateOne) defaults() error {
+	if _, ok := aruo.mutation.UpdatedAt(); !ok {
+		if automationrule.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized automationrule.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := automationrule.UpdateDefaultUpdatedAt()
+		aruo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aruo *AutomationRuleUpdateOne) check() error {
+	if _, ok := aruo.mutation.UserID(); aruo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AutomationRule.user"`)
+	}
+	return nil
+}
+
+func (aruo *AutomationRuleUpdateOne) sqlSave(ctx context.Context) (_node *AutomationRule, err error) {
+	if err := aruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(automationrule.Table, automationrule.Columns, sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt))
+	id, ok := aruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AutomationRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, automationrule.FieldID)
+		for _, f := range fields {
+			if !automationrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != automationrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(automationrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := aruo.mutation.DeletedAt(); ok {
+		_spec.SetField(automationrule.FieldDeletedAt, field.TypeTime, value)
+	}
+	if aruo.mutation.DeletedAtCleared() {
+		_spec.ClearField(automationrule.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := aruo.mutation.Name(); ok {
+		_spec.SetField(automationrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := aruo.mutation.Trigger(); ok {
+		_spec.SetField(automationrule.FieldTrigger, field.TypeString, value)
+	}
+	if value, ok := aruo.mutation.Conditions(); ok {
+		_spec.SetField(automationrule.FieldConditions, field.TypeJSON, value)
+	}
+	if aruo.mutation.ConditionsCleared() {
+		_spec.ClearField(automationrule.FieldConditions, field.TypeJSON)
+	}
+	if value, ok := aruo.mutation.Actions(); ok {
+		_spec.SetField(automationrule.FieldActions, field.TypeJSON, value)
+	}
+	if value, ok := aruo.mutation.AppendedActions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, automationrule.FieldActions, value)
+		})
+	}
+	if value, ok := aruo.mutation.Enabled(); ok {
+		_spec.SetField(automationrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := aruo.mutation.LastTriggeredAt(); ok {
+		_spec.SetField(automationrule.FieldLastTriggeredAt, field.TypeTime, value)
+	}
+	if aruo.mutation.LastTriggeredAtCleared() {
+		_spec.ClearField(automationrule.FieldLastTriggeredAt, field.TypeTime)
+	}
+	if value, ok := aruo.mutation.LastError(); ok {
+		_spec.SetField(automationrule.FieldLastError, field.TypeString, value)
+	}
+	if aruo.mutation.LastErrorCleared() {
+		_spec.ClearField(automationrule.FieldLastError, field.TypeString)
+	}
+	if aruo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   automationrule.UserTable,
+			Columns: []string{automationrule.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aruo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   automationrule.UserTable,
+			Columns: []string{automationrule.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AutomationRule{config: aruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{automationrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/checkout"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// Checkout is the model entity for the Checkout schema.
+type Checkout struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"token,omitempty"`
+	// Ns holds the value of the "ns" field.
+	Ns string `json:"ns,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// FileType holds the value of the "file_type" field.
+	FileType int `json:"file_type,omitempty"`
+	// ZeroDepth holds the value of the "zero_depth" field.
+	ZeroDepth bool `json:"zero_depth,omitempty"`
+	// ExpireAt holds the value of the "expire_at" field.
+	ExpireAt time.Time `json:"expire_at,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID int `json:"owner_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CheckoutQuery when eager-loading is set.
+	Edges        CheckoutEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CheckoutEdges holds the relations/edges for other nodes in the graph.
+type CheckoutEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CheckoutEdges) OwnerOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Checkout) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case checkout.FieldZeroDepth:
+			values[i] = new(sql.NullBool)
+		case checkout.FieldID, checkout.FieldFileType, checkout.FieldOwnerID:
+			values[i] = new(sql.NullInt64)
+		case checkout.FieldToken, checkout.FieldNs, checkout.FieldPath:
+			values[i] = new(sql.NullString)
+		case checkout.FieldCreatedAt, checkout.FieldUpdatedAt, checkout.FieldDeletedAt, checkout.FieldExpireAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Checkout fields.
+func (c *Checkout) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case checkout.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case checkout.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case checkout.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		case checkout.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				c.DeletedAt = new(time.Time)
+				*c.DeletedAt = value.Time
+			}
+		case checkout.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				c.Token = value.String
+			}
+		case checkout.FieldNs:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ns", values[i])
+			} else if value.Valid {
+				c.Ns = value.String
+			}
+		case checkout.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				c.Path = value.String
+			}
+		case checkout.FieldFileType:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field file_type", values[i])
+			} else if value.Valid {
+				c.FileType = int(value.Int64)
+			}
+		case checkout.FieldZeroDepth:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field zero_depth", values[i])
+			} else if value.Valid {
+				c.ZeroDepth = value.Bool
+			}
+		case checkout.FieldExpireAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expire_at", values[i])
+			} else if value.Valid {
+				c.ExpireAt = value.Time
+			}
+		case checkout.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				c.OwnerID = int(value.Int64)
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Checkout.
+// This includes values selected through modifiers, order, etc.
+func (c *Checkout) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the Checkout entity.
+func (c *Checkout) QueryOwner() *UserQuery {
+	return NewCheckoutClient(c.config).QueryOwner(c)
+}
+
+// Update returns a builder for updating this Checkout.
+// Note that you need to call Checkout.Unwrap() before calling this method if this Checkout
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Checkout) Update() *CheckoutUpdateOne {
+	return NewCheckoutClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Checkout entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Checkout) Unwrap() *Checkout {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Checkout is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Checkout) String() string {
+	var builder strings.Builder
+	builder.WriteString("Checkout(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := c.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("token=")
+	builder.WriteString(c.Token)
+	builder.WriteString(", ")
+	builder.WriteString("ns=")
+	builder.WriteString(c.Ns)
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(c.Path)
+	builder.WriteString(", ")
+	builder.WriteString("file_type=")
+	builder.WriteString(fmt.Sprintf("%v", c.FileType))
+	builder.WriteString(", ")
+	builder.WriteString("zero_depth=")
+	builder.WriteString(fmt.Sprintf("%v", c.ZeroDepth))
+	builder.WriteString(", ")
+	builder.WriteString("expire_at=")
+	builder.WriteString(c.ExpireAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.OwnerID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetOwner manually set the edge as loaded state.
+func (e *Checkout) SetOwner(v *User) {
+	e.Edges.Owner = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// Checkouts is a parsable slice of Checkout.
+type Checkouts []*Checkout
@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package checkout
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldToken, v))
+}
+
+// Ns applies equality check predicate on the "ns" field. It's identical to NsEQ.
+func Ns(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldNs, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldPath, v))
+}
+
+// FileType applies equality check predicate on the "file_type" field. It's identical to FileTypeEQ.
+func FileType(v int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldFileType, v))
+}
+
+// ZeroDepth applies equality check predicate on the "zero_depth" field. It's identical to ZeroDepthEQ.
+func ZeroDepth(v bool) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldZeroDepth, v))
+}
+
+// ExpireAt applies equality check predicate on the "expire_at" field. It's identical to ExpireAtEQ.
+func ExpireAt(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldExpireAt, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Checkout {
+	return predicate.Checkout(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Checkout {
+	return predicate.Checkout(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldContainsFold(FieldToken, v))
+}
+
+// NsEQ applies the EQ predicate on the "ns" field.
+func NsEQ(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldNs, v))
+}
+
+// NsNEQ applies the NEQ predicate on the "ns" field.
+func NsNEQ(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNEQ(FieldNs, v))
+}
+
+// NsIn applies the In predicate on the "ns" field.
+func NsIn(vs ...string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldIn(FieldNs, vs...))
+}
+
+// NsNotIn applies the NotIn predicate on the "ns" field.
+func NsNotIn(vs ...string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNotIn(FieldNs, vs...))
+}
+
+// NsGT applies the GT predicate on the "ns" field.
+func NsGT(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGT(FieldNs, v))
+}
+
+// NsGTE applies the GTE predicate on the "ns" field.
+func NsGTE(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGTE(FieldNs, v))
+}
+
+// NsLT applies the LT predicate on the "ns" field.
+func NsLT(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLT(FieldNs, v))
+}
+
+// NsLTE applies the LTE predicate on the "ns" field.
+func NsLTE(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLTE(FieldNs, v))
+}
+
+// NsContains applies the Contains predicate on the "ns" field.
+func NsContains(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldContains(FieldNs, v))
+}
+
+// NsHasPrefix applies the HasPrefix predicate on the "ns" field.
+func NsHasPrefix(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldHasPrefix(FieldNs, v))
+}
+
+// NsHasSuffix applies the HasSuffix predicate on the "ns" field.
+func NsHasSuffix(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldHasSuffix(FieldNs, v))
+}
+
+// NsEqualFold applies the EqualFold predicate on the "ns" field.
+func NsEqualFold(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEqualFold(FieldNs, v))
+}
+
+// NsContainsFold applies the ContainsFold predicate on the "ns" field.
+func NsContainsFold(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldContainsFold(FieldNs, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.Checkout {
+	return predicate.Checkout(sql.FieldContainsFold(FieldPath, v))
+}
+
+// FileTypeEQ applies the EQ predicate on the "file_type" field.
+func FileTypeEQ(v int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldFileType, v))
+}
+
+// FileTypeNEQ applies the NEQ predicate on the "file_type" field.
+func FileTypeNEQ(v int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNEQ(FieldFileType, v))
+}
+
+// FileTypeIn applies the In predicate on the "file_type" field.
+func FileTypeIn(vs ...int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldIn(FieldFileType, vs...))
+}
+
+// FileTypeNotIn applies the NotIn predicate on the "file_type" field.
+func FileTypeNotIn(vs ...int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNotIn(FieldFileType, vs...))
+}
+
+// FileTypeGT applies the GT predicate on the "file_type" field.
+func FileTypeGT(v int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGT(FieldFileType, v))
+}
+
+// FileTypeGTE applies the GTE predicate on the "file_type" field.
+func FileTypeGTE(v int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGTE(FieldFileType, v))
+}
+
+// FileTypeLT applies the LT predicate on the "file_type" field.
+func FileTypeLT(v int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLT(FieldFileType, v))
+}
+
+// FileTypeLTE applies the LTE predicate on the "file_type" field.
+func FileTypeLTE(v int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLTE(FieldFileType, v))
+}
+
+// ZeroDepthEQ applies the EQ predicate on the "zero_depth" field.
+func ZeroDepthEQ(v bool) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldZeroDepth, v))
+}
+
+// ZeroDepthNEQ applies the NEQ predicate on the "zero_depth" field.
+func ZeroDepthNEQ(v bool) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNEQ(FieldZeroDepth, v))
+}
+
+// ExpireAtEQ applies the EQ predicate on the "expire_at" field.
+func ExpireAtEQ(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldExpireAt, v))
+}
+
+// ExpireAtNEQ applies the NEQ predicate on the "expire_at" field.
+func ExpireAtNEQ(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNEQ(FieldExpireAt, v))
+}
+
+// ExpireAtIn applies the In predicate on the "expire_at" field.
+func ExpireAtIn(vs ...time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldIn(FieldExpireAt, vs...))
+}
+
+// ExpireAtNotIn applies the NotIn predicate on the "expire_at" field.
+func ExpireAtNotIn(vs ...time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNotIn(FieldExpireAt, vs...))
+}
+
+// ExpireAtGT applies the GT predicate on the "expire_at" field.
+func ExpireAtGT(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGT(FieldExpireAt, v))
+}
+
+// ExpireAtGTE applies the GTE predicate on the "expire_at" field.
+func ExpireAtGTE(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldGTE(FieldExpireAt, v))
+}
+
+// ExpireAtLT applies the LT predicate on the "expire_at" field.
+func ExpireAtLT(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLT(FieldExpireAt, v))
+}
+
+// ExpireAtLTE applies the LTE predicate on the "expire_at" field.
+func ExpireAtLTE(v time.Time) predicate.Checkout {
+	return predicate.Checkout(sql.FieldLTE(FieldExpireAt, v))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...int) predicate.Checkout {
+	return predicate.Checkout(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.Checkout {
+	return predicate.Checkout(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.Checkout {
+	return predicate.Checkout(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Checkout) predicate.Checkout {
+	return predicate.Checkout(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Checkout) predicate.Checkout {
+	return predicate.Checkout(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Checkout) predicate.Checkout {
+	return predicate.Checkout(sql.NotPredicates(p))
+}
@@ -0,0 +1,1034 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/checkout"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// CheckoutCreate is the builder for creating a Checkout entity.
+type CheckoutCreate struct {
+	config
+	mutation *CheckoutMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CheckoutCreate) SetCreatedAt(t time.Time) *CheckoutCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CheckoutCreate) SetNillableCreatedAt(t *time.Time) *CheckoutCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cc *CheckoutCreate) SetUpdatedAt(t time.Time) *CheckoutCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cc *CheckoutCreate) SetNillableUpdatedAt(t *time.Time) *CheckoutCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cc *CheckoutCreate) SetDeletedAt(t time.Time) *CheckoutCreate {
+	cc.mutation.SetDeletedAt(t)
+	return cc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cc *CheckoutCreate) SetNillableDeletedAt(t *time.Time) *CheckoutCreate {
+	if t != nil {
+		cc.SetDeletedAt(*t)
+	}
+	return cc
+}
+
+// SetToken sets the "token" field.
+func (cc *CheckoutCreate) SetToken(s string) *CheckoutCreate {
+	cc.mutation.SetToken(s)
+	return cc
+}
+
+// SetNs sets the "ns" field.
+func (cc *CheckoutCreate) SetNs(s string) *CheckoutCreate {
+	cc.mutation.SetNs(s)
+	return cc
+}
+
+// SetPath sets the "path" field.
+func (cc *CheckoutCreate) SetPath(s string) *CheckoutCreate {
+	cc.mutation.SetPath(s)
+	return cc
+}
+
+// SetFileType sets the "file_type" field.
+func (cc *CheckoutCreate) SetFileType(i int) *CheckoutCreate {
+	cc.mutation.SetFileType(i)
+	return cc
+}
+
+// SetZeroDepth sets the "zero_depth" field.
+func (cc *CheckoutCreate) SetZeroDepth(b bool) *CheckoutCreate {
+	cc.mutation.SetZeroDepth(b)
+	return cc
+}
+
+// SetNillableZeroDepth sets the "zero_depth" field if the given value is not nil.
+func (cc *CheckoutCreate) SetNillableZeroDepth(b *bool) *CheckoutCreate {
+	if b != nil {
+		cc.SetZeroDepth(*b)
+	}
+	return cc
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (cc *CheckoutCreate) SetExpireAt(t time.Time) *CheckoutCreate {
+	cc.mutation.SetExpireAt(t)
+	return cc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (cc *CheckoutCreate) SetOwnerID(i int) *CheckoutCreate {
+	cc.mutation.SetOwnerID(i)
+	return cc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (cc *CheckoutCreate) SetOwner(u *User) *CheckoutCreate {
+	return cc.SetOwnerID(u.ID)
+}
+
+// Mutation returns the CheckoutMutation object of the builder.
+func (cc *CheckoutCreate) Mutation() *CheckoutMutation {
+	return cc.mutation
+}
+
+// Save creates the Checkout in the database.
+func (cc *CheckoutCreate) Save(ctx context.Context) (*Checkout, error) {
+	if err := cc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CheckoutCreate) SaveX(ctx context.Context) *Checkout {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CheckoutCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CheckoutCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CheckoutCreate) defaults() error {
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		if checkout.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized checkout.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := checkout.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		if checkout.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized checkout.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := checkout.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cc.mutation.ZeroDepth(); !ok {
+		v := checkout.DefaultZeroDepth
+		cc.mutation.SetZeroDepth(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CheckoutCreate) check() error {
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Checkout.created_at"`)}
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Checkout.updated_at"`)}
+	}
+	if _, ok := cc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "Checkout.token"`)}
+	}
+	if _, ok := cc.mutation.Ns(); !ok {
+		return &ValidationError{Name: "ns", err: errors.New(`ent: missing required field "Checkout.ns"`)}
+	}
+	if _, ok := cc.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "Checkout.path"`)}
+	}
+	if _, ok := cc.mutation.FileType(); !ok {
+		return &ValidationError{Name: "file_type", err: errors.New(`ent: missing required field "Checkout.file_type"`)}
+	}
+	if _, ok := cc.mutation.ZeroDepth(); !ok {
+		return &ValidationError{Name: "zero_depth", err: errors.New(`ent: missing required field "Checkout.zero_depth"`)}
+	}
+	if _, ok := cc.mutation.ExpireAt(); !ok {
+		return &ValidationError{Name: "expire_at", err: errors.New(`ent: missing required field "Checkout.expire_at"`)}
+	}
+	if _, ok := cc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "Checkout.owner_id"`)}
+	}
+	if _, ok := cc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Checkout.owner"`)}
+	}
+	return nil
+}
+
+func (cc *CheckoutCreate) sqlSave(ctx context.Context) (*Checkout, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CheckoutCreate) createSpec() (*Checkout, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Checkout{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(checkout.Table, sqlgraph.NewFieldSpec(checkout.FieldID, field.TypeInt))
+	)
+
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = cc.conflict
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(checkout.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.SetField(checkout.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := cc.mutation.DeletedAt(); ok {
+		_spec.SetField(checkout.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := cc.mutation.Token(); ok {
+		_spec.SetField(checkout.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := cc.mutation.Ns(); ok {
+		_spec.SetField(checkout.FieldNs, field.TypeString, value)
+		_node.Ns = value
+	}
+	if value, ok := cc.mutation.Path(); ok {
+		_spec.SetField(checkout.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := cc.mutation.FileType(); ok {
+		_spec.SetField(checkout.FieldFileType, field.TypeInt, value)
+		_node.FileType = value
+	}
+	if value, ok := cc.mutation.ZeroDepth(); ok {
+		_spec.SetField(checkout.FieldZeroDepth, field.TypeBool, value)
+		_node.ZeroDepth = value
+	}
+	if value, ok := cc.mutation.ExpireAt(); ok {
+		_spec.SetField(checkout.FieldExpireAt, field.TypeTime, value)
+		_node.ExpireAt = value
+	}
+	if nodes := cc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkout.OwnerTable,
+			Columns: []string{checkout.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Checkout.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CheckoutUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (cc *CheckoutCreate) OnConflict(opts ...sql.ConflictOption) *CheckoutUpsertOne {
+	cc.conflict = opts
+	return &CheckoutUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Checkout.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cc *CheckoutCreate) OnConflictColumns(columns ...string) *CheckoutUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &CheckoutUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// CheckoutUpsertOne is the builder for "upsert"-ing
+	//  one Checkout node.
+	CheckoutUpsertOne struct {
+		create *CheckoutCreate
+	}
+
+	// CheckoutUpsert is the "OnConflict" setter.
+	CheckoutUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CheckoutUpsert) SetUpdatedAt(v time.Time) *CheckoutUpsert {
+	u.Set(checkout.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CheckoutUpsert) UpdateUpdatedAt() *CheckoutUpsert {
+	u.SetExcluded(checkout.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CheckoutUpsert) SetDeletedAt(v time.Time) *CheckoutUpsert {
+	u.Set(checkout.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CheckoutUpsert) UpdateDeletedAt() *CheckoutUpsert {
+	u.SetExcluded(checkout.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CheckoutUpsert) ClearDeletedAt() *CheckoutUpsert {
+	u.SetNull(checkout.FieldDeletedAt)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *CheckoutUpsert) SetToken(v string) *CheckoutUpsert {
+	u.Set(checkout.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *CheckoutUpsert) UpdateToken() *CheckoutUpsert {
+	u.SetExcluded(checkout.FieldToken)
+	return u
+}
+
+// SetNs sets the "ns" field.
+func (u *CheckoutUpsert) SetNs(v string) *CheckoutUpsert {
+	u.Set(checkout.FieldNs, v)
+	return u
+}
+
+// UpdateNs sets the "ns" field to the value that was provided on create.
+func (u *CheckoutUpsert) UpdateNs() *CheckoutUpsert {
+	u.SetExcluded(checkout.FieldNs)
+	return u
+}
+
+// SetPath sets the "path" field.
+func (u *CheckoutUpsert) SetPath(v string) *CheckoutUpsert {
+	u.Set(checkout.FieldPath, v)
+	return u
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *CheckoutUpsert) UpdatePath() *CheckoutUpsert {
+	u.SetExcluded(checkout.FieldPath)
+	return u
+}
+
+// SetFileType sets the "file_type" field.
+func (u *CheckoutUpsert) SetFileType(v int) *CheckoutUpsert {
+	u.Set(checkout.FieldFileType, v)
+	return u
+}
+
+// UpdateFileType sets the "file_type" field to the value that was provided on create.
+func (u *CheckoutUpsert) UpdateFileType() *CheckoutUpsert {
+	u.SetExcluded(checkout.FieldFileType)
+	return u
+}
+
+// AddFileType adds v to the "file_type" field.
+func (u *CheckoutUpsert) AddFileType(v int) *CheckoutUpsert {
+	u.Add(checkout.FieldFileType, v)
+	return u
+}
+
+// SetZeroDepth sets the "zero_depth" field.
+func (u *CheckoutUpsert) SetZeroDepth(v bool) *CheckoutUpsert {
+	u.Set(checkout.FieldZeroDepth, v)
+	return u
+}
+
+// UpdateZeroDepth sets the "zero_depth" field to the value that was provided on create.
+func (u *CheckoutUpsert) UpdateZeroDepth() *CheckoutUpsert {
+	u.SetExcluded(checkout.FieldZeroDepth)
+	return u
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (u *CheckoutUpsert) SetExpireAt(v time.Time) *CheckoutUpsert {
+	u.Set(checkout.FieldExpireAt, v)
+	return u
+}
+
+// UpdateExpireAt sets the "expire_at" field to the value that was provided on create.
+func (u *CheckoutUpsert) UpdateExpireAt() *CheckoutUpsert {
+	u.SetExcluded(checkout.FieldExpireAt)
+	return u
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *CheckoutUpsert) SetOwnerID(v int) *CheckoutUpsert {
+	u.Set(checkout.FieldOwnerID, v)
+	return u
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *CheckoutUpsert) UpdateOwnerID() *CheckoutUpsert {
+	u.SetExcluded(checkout.FieldOwnerID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Checkout.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CheckoutUpsertOne) UpdateNewValues() *CheckoutUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(checkout.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Checkout.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CheckoutUpsertOne) Ignore() *CheckoutUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CheckoutUpsertOne) DoNothing() *CheckoutUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CheckoutCreate.OnConflict
+// documentation for more info.
+func (u *CheckoutUpsertOne) Update(set func(*CheckoutUpsert)) *CheckoutUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CheckoutUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CheckoutUpsertOne) SetUpdatedAt(v time.Time) *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CheckoutUpsertOne) UpdateUpdatedAt() *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CheckoutUpsertOne) SetDeletedAt(v time.Time) *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CheckoutUpsertOne) UpdateDeletedAt() *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CheckoutUpsertOne) ClearDeletedAt() *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *CheckoutUpsertOne) SetToken(v string) *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *CheckoutUpsertOne) UpdateToken() *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetNs sets the "ns" field.
+func (u *CheckoutUpsertOne) SetNs(v string) *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetNs(v)
+	})
+}
+
+// UpdateNs sets the "ns" field to the value that was provided on create.
+func (u *CheckoutUpsertOne) UpdateNs() *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdateNs()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *CheckoutUpsertOne) SetPath(v string) *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *CheckoutUpsertOne) UpdatePath() *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetFileType sets the "file_type" field.
+func (u *CheckoutUpsertOne) SetFileType(v int) *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetFileType(v)
+	})
+}
+
+// AddFileType adds v to the "file_type" field.
+func (u *CheckoutUpsertOne) AddFileType(v int) *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.AddFileType(v)
+	})
+}
+
+// UpdateFileType sets the "file_type" field to the value that was provided on create.
+func (u *CheckoutUpsertOne) UpdateFileType() *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdateFileType()
+	})
+}
+
+// SetZeroDepth sets the "zero_depth" field.
+func (u *CheckoutUpsertOne) SetZeroDepth(v bool) *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetZeroDepth(v)
+	})
+}
+
+// UpdateZeroDepth sets the "zero_depth" field to the value that was provided on create.
+func (u *CheckoutUpsertOne) UpdateZeroDepth() *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdateZeroDepth()
+	})
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (u *CheckoutUpsertOne) SetExpireAt(v time.Time) *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetExpireAt(v)
+	})
+}
+
+// UpdateExpireAt sets the "expire_at" field to the value that was provided on create.
+func (u *CheckoutUpsertOne) UpdateExpireAt() *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdateExpireAt()
+	})
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *CheckoutUpsertOne) SetOwnerID(v int) *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *CheckoutUpsertOne) UpdateOwnerID() *CheckoutUpsertOne {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// Exec executes the query.
+func (u *CheckoutUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CheckoutCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CheckoutUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CheckoutUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CheckoutUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *CheckoutCreate) SetRawID(t int) *CheckoutCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// CheckoutCreateBulk is the builder for creating many Checkout entities in bulk.
+type CheckoutCreateBulk struct {
+	config
+	err      error
+	builders []*CheckoutCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Checkout entities in the database.
+func (ccb *CheckoutCreateBulk) Save(ctx context.Context) ([]*Checkout, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Checkout, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CheckoutMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CheckoutCreateBulk) SaveX(ctx context.Context) []*Checkout {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CheckoutCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CheckoutCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Checkout.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CheckoutUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ccb *CheckoutCreateBulk) OnConflict(opts ...sql.ConflictOption) *CheckoutUpsertBulk {
+	ccb.conflict = opts
+	return &CheckoutUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Checkout.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ccb *CheckoutCreateBulk) OnConflictColumns(columns ...string) *CheckoutUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &CheckoutUpsertBulk{
+		create: ccb,
+	}
+}
+
+// CheckoutUpsertBulk is the builder for "upsert"-ing
+// a bulk of Checkout nodes.
+type CheckoutUpsertBulk struct {
+	create *CheckoutCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Checkout.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CheckoutUpsertBulk) UpdateNewValues() *CheckoutUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(checkout.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Checkout.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CheckoutUpsertBulk) Ignore() *CheckoutUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CheckoutUpsertBulk) DoNothing() *CheckoutUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CheckoutCreateBulk.OnConflict
+// documentation for more info.
+func (u *CheckoutUpsertBulk) Update(set func(*CheckoutUpsert)) *CheckoutUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CheckoutUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CheckoutUpsertBulk) SetUpdatedAt(v time.Time) *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CheckoutUpsertBulk) UpdateUpdatedAt() *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *CheckoutUpsertBulk) SetDeletedAt(v time.Time) *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *CheckoutUpsertBulk) UpdateDeletedAt() *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *CheckoutUpsertBulk) ClearDeletedAt() *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *CheckoutUpsertBulk) SetToken(v string) *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *CheckoutUpsertBulk) UpdateToken() *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// SetNs sets the "ns" field.
+func (u *CheckoutUpsertBulk) SetNs(v string) *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetNs(v)
+	})
+}
+
+// UpdateNs sets the "ns" field to the value that was provided on create.
+func (u *CheckoutUpsertBulk) UpdateNs() *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdateNs()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *CheckoutUpsertBulk) SetPath(v string) *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *CheckoutUpsertBulk) UpdatePath() *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// SetFileType sets the "file_type" field.
+func (u *CheckoutUpsertBulk) SetFileType(v int) *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetFileType(v)
+	})
+}
+
+// AddFileType adds v to the "file_type" field.
+func (u *CheckoutUpsertBulk) AddFileType(v int) *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.AddFileType(v)
+	})
+}
+
+// UpdateFileType sets the "file_type" field to the value that was provided on create.
+func (u *CheckoutUpsertBulk) UpdateFileType() *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdateFileType()
+	})
+}
+
+// SetZeroDepth sets the "zero_depth" field.
+func (u *CheckoutUpsertBulk) SetZeroDepth(v bool) *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetZeroDepth(v)
+	})
+}
+
+// UpdateZeroDepth sets the "zero_depth" field to the value that was provided on create.
+func (u *CheckoutUpsertBulk) UpdateZeroDepth() *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdateZeroDepth()
+	})
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (u *CheckoutUpsertBulk) SetExpireAt(v time.Time) *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetExpireAt(v)
+	})
+}
+
+// UpdateExpireAt sets the "expire_at" field to the value that was provided on create.
+func (u *CheckoutUpsertBulk) UpdateExpireAt() *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdateExpireAt()
+	})
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (u *CheckoutUpsertBulk) SetOwnerID(v int) *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.SetOwnerID(v)
+	})
+}
+
+// UpdateOwnerID sets the "owner_id" field to the value that was provided on create.
+func (u *CheckoutUpsertBulk) UpdateOwnerID() *CheckoutUpsertBulk {
+	return u.Update(func(s *CheckoutUpsert) {
+		s.UpdateOwnerID()
+	})
+}
+
+// Exec executes the query.
+func (u *CheckoutUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CheckoutCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CheckoutCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CheckoutUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
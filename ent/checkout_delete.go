@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/checkout"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// CheckoutDelete is the builder for deleting a Checkout entity.
+type CheckoutDelete struct {
+	config
+	hooks    []Hook
+	mutation *CheckoutMutation
+}
+
+// Where appends a list predicates to the CheckoutDelete builder.
+func (cd *CheckoutDelete) Where(ps ...predicate.Checkout) *CheckoutDelete {
+	cd.mutation.Where(ps...)
+	return cd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cd *CheckoutDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cd.sqlExec, cd.mutation, cd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cd *CheckoutDelete) ExecX(ctx context.Context) int {
+	n, err := cd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cd *CheckoutDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(checkout.Table, sqlgraph.NewFieldSpec(checkout.FieldID, field.TypeInt))
+	if ps := cd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cd.mutation.done = true
+	return affected, err
+}
+
+// CheckoutDeleteOne is the builder for deleting a single Checkout entity.
+type CheckoutDeleteOne struct {
+	cd *CheckoutDelete
+}
+
+// Where appends a list predicates to the CheckoutDelete builder.
+func (cdo *CheckoutDeleteOne) Where(ps ...predicate.Checkout) *CheckoutDeleteOne {
+	cdo.cd.mutation.Where(ps...)
+	return cdo
+}
+
+// Exec executes the deletion query.
+func (cdo *CheckoutDeleteOne) Exec(ctx context.Context) error {
+	n, err := cdo.cd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{checkout.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdo *CheckoutDeleteOne) ExecX(ctx context.Context) {
+	if err := cdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,631 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/checkout"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// CheckoutUpdate is the builder for updating Checkout entities.
+type CheckoutUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CheckoutMutation
+}
+
+// Where appends a list predicates to the CheckoutUpdate builder.
+func (cu *CheckoutUpdate) Where(ps ...predicate.Checkout) *CheckoutUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CheckoutUpdate) SetUpdatedAt(t time.Time) *CheckoutUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cu *CheckoutUpdate) SetDeletedAt(t time.Time) *CheckoutUpdate {
+	cu.mutation.SetDeletedAt(t)
+	return cu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cu *CheckoutUpdate) SetNillableDeletedAt(t *time.Time) *CheckoutUpdate {
+	if t != nil {
+		cu.SetDeletedAt(*t)
+	}
+	return cu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cu *CheckoutUpdate) ClearDeletedAt() *CheckoutUpdate {
+	cu.mutation.ClearDeletedAt()
+	return cu
+}
+
+// SetToken sets the "token" field.
+func (cu *CheckoutUpdate) SetToken(s string) *CheckoutUpdate {
+	cu.mutation.SetToken(s)
+	return cu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (cu *CheckoutUpdate) SetNillableToken(s *string) *CheckoutUpdate {
+	if s != nil {
+		cu.SetToken(*s)
+	}
+	return cu
+}
+
+// SetNs sets the "ns" field.
+func (cu *CheckoutUpdate) SetNs(s string) *CheckoutUpdate {
+	cu.mutation.SetNs(s)
+	return cu
+}
+
+// SetNillableNs sets the "ns" field if the given value is not nil.
+func (cu *CheckoutUpdate) SetNillableNs(s *string) *CheckoutUpdate {
+	if s != nil {
+		cu.SetNs(*s)
+	}
+	return cu
+}
+
+// SetPath sets the "path" field.
+func (cu *CheckoutUpdate) SetPath(s string) *CheckoutUpdate {
+	cu.mutation.SetPath(s)
+	return cu
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (cu *CheckoutUpdate) SetNillablePath(s *string) *CheckoutUpdate {
+	if s != nil {
+		cu.SetPath(*s)
+	}
+	return cu
+}
+
+// SetFileType sets the "file_type" field.
+func (cu *CheckoutUpdate) SetFileType(i int) *CheckoutUpdate {
+	cu.mutation.ResetFileType()
+	cu.mutation.SetFileType(i)
+	return cu
+}
+
+// SetNillableFileType sets the "file_type" field if the given value is not nil.
+func (cu *CheckoutUpdate) SetNillableFileType(i *int) *CheckoutUpdate {
+	if i != nil {
+		cu.SetFileType(*i)
+	}
+	return cu
+}
+
+// AddFileType adds i to the "file_type" field.
+func (cu *CheckoutUpdate) AddFileType(i int) *CheckoutUpdate {
+	cu.mutation.AddFileType(i)
+	return cu
+}
+
+// SetZeroDepth sets the "zero_depth" field.
+func (cu *CheckoutUpdate) SetZeroDepth(b bool) *CheckoutUpdate {
+	cu.mutation.SetZeroDepth(b)
+	return cu
+}
+
+// SetNillableZeroDepth sets the "zero_depth" field if the given value is not nil.
+func (cu *CheckoutUpdate) SetNillableZeroDepth(b *bool) *CheckoutUpdate {
+	if b != nil {
+		cu.SetZeroDepth(*b)
+	}
+	return cu
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (cu *CheckoutUpdate) SetExpireAt(t time.Time) *CheckoutUpdate {
+	cu.mutation.SetExpireAt(t)
+	return cu
+}
+
+// SetNillableExpireAt sets the "expire_at" field if the given value is not nil.
+func (cu *CheckoutUpdate) SetNillableExpireAt(t *time.Time) *CheckoutUpdate {
+	if t != nil {
+		cu.SetExpireAt(*t)
+	}
+	return cu
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (cu *CheckoutUpdate) SetOwnerID(i int) *CheckoutUpdate {
+	cu.mutation.SetOwnerID(i)
+	return cu
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (cu *CheckoutUpdate) SetNillableOwnerID(i *int) *CheckoutUpdate {
+	if i != nil {
+		cu.SetOwnerID(*i)
+	}
+	return cu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (cu *CheckoutUpdate) SetOwner(u *User) *CheckoutUpdate {
+	return cu.SetOwnerID(u.ID)
+}
+
+// Mutation returns the CheckoutMutation object of the builder.
+func (cu *CheckoutUpdate) Mutation() *CheckoutMutation {
+	return cu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (cu *CheckoutUpdate) ClearOwner() *CheckoutUpdate {
+	cu.mutation.ClearOwner()
+	return cu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CheckoutUpdate) Save(ctx context.Context) (int, error) {
+	if err := cu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CheckoutUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CheckoutUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CheckoutUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CheckoutUpdate) defaults() error {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		if checkout.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized checkout.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := checkout.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CheckoutUpdate) check() error {
+	if _, ok := cu.mutation.OwnerID(); cu.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Checkout.owner"`)
+	}
+	return nil
+}
+
+func (cu *CheckoutUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(checkout.Table, checkout.Columns, sqlgraph.NewFieldSpec(checkout.FieldID, field.TypeInt))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(checkout.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.DeletedAt(); ok {
+		_spec.SetField(checkout.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cu.mutation.DeletedAtCleared() {
+		_spec.ClearField(checkout.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.Token(); ok {
+		_spec.SetField(checkout.FieldToken, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Ns(); ok {
+		_spec.SetField(checkout.FieldNs, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Path(); ok {
+		_spec.SetField(checkout.FieldPath, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.FileType(); ok {
+		_spec.SetField(checkout.FieldFileType, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedFileType(); ok {
+		_spec.AddField(checkout.FieldFileType, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.ZeroDepth(); ok {
+		_spec.SetField(checkout.FieldZeroDepth, field.TypeBool, value)
+	}
+	if value, ok := cu.mutation.ExpireAt(); ok {
+		_spec.SetField(checkout.FieldExpireAt, field.TypeTime, value)
+	}
+	if cu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkout.OwnerTable,
+			Columns: []string{checkout.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkout.OwnerTable,
+			Columns: []string{checkout.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checkout.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CheckoutUpdateOne is the builder for updating a single Checkout entity.
+type CheckoutUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CheckoutMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CheckoutUpdateOne) SetUpdatedAt(t time.Time) *CheckoutUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cuo *CheckoutUpdateOne) SetDeletedAt(t time.Time) *CheckoutUpdateOne {
+	cuo.mutation.SetDeletedAt(t)
+	return cuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cuo *CheckoutUpdateOne) SetNillableDeletedAt(t *time.Time) *CheckoutUpdateOne {
+	if t != nil {
+		cuo.SetDeletedAt(*t)
+	}
+	return cuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cuo *CheckoutUpdateOne) ClearDeletedAt() *CheckoutUpdateOne {
+	cuo.mutation.ClearDeletedAt()
+	return cuo
+}
+
+// SetToken sets the "token" field.
+func (cuo *CheckoutUpdateOne) SetToken(s string) *CheckoutUpdateOne {
+	cuo.mutation.SetToken(s)
+	return cuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (cuo *CheckoutUpdateOne) SetNillableToken(s *string) *CheckoutUpdateOne {
+	if s != nil {
+		cuo.SetToken(*s)
+	}
+	return cuo
+}
+
+// SetNs sets the "ns" field.
+func (cuo *CheckoutUpdateOne) SetNs(s string) *CheckoutUpdateOne {
+	cuo.mutation.SetNs(s)
+	return cuo
+}
+
+// SetNillableNs sets the "ns" field if the given value is not nil.
+func (cuo *CheckoutUpdateOne) SetNillableNs(s *string) *CheckoutUpdateOne {
+	if s != nil {
+		cuo.SetNs(*s)
+	}
+	return cuo
+}
+
+// SetPath sets the "path" field.
+func (cuo *CheckoutUpdateOne) SetPath(s string) *CheckoutUpdateOne {
+	cuo.mutation.SetPath(s)
+	return cuo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (cuo *CheckoutUpdateOne) SetNillablePath(s *string) *CheckoutUpdateOne {
+	if s != nil {
+		cuo.SetPath(*s)
+	}
+	return cuo
+}
+
+// SetFileType sets the "file_type" field.
+func (cuo *CheckoutUpdateOne) SetFileType(i int) *CheckoutUpdateOne {
+	cuo.mutation.ResetFileType()
+	cuo.mutation.SetFileType(i)
+	return cuo
+}
+
+// SetNillableFileType sets the "file_type" field if the given value is not nil.
+func (cuo *CheckoutUpdateOne) SetNillableFileType(i *int) *CheckoutUpdateOne {
+	if i != nil {
+		cuo.SetFileType(*i)
+	}
+	return cuo
+}
+
+// AddFileType adds i to the "file_type" field.
+func (cuo *CheckoutUpdateOne) AddFileType(i int) *CheckoutUpdateOne {
+	cuo.mutation.AddFileType(i)
+	return cuo
+}
+
+// SetZeroDepth sets the "zero_depth" field.
+func (cuo *CheckoutUpdateOne) SetZeroDepth(b bool) *CheckoutUpdateOne {
+	cuo.mutation.SetZeroDepth(b)
+	return cuo
+}
+
+// SetNillableZeroDepth sets the "zero_depth" field if the given value is not nil.
+func (cuo *CheckoutUpdateOne) SetNillableZeroDepth(b *bool) *CheckoutUpdateOne {
+	if b != nil {
+		cuo.SetZeroDepth(*b)
+	}
+	return cuo
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (cuo *CheckoutUpdateOne) SetExpireAt(t time.Time) *CheckoutUpdateOne {
+	cuo.mutation.SetExpireAt(t)
+	return cuo
+}
+
+// SetNillableExpireAt sets the "expire_at" field if the given value is not nil.
+func (cuo *CheckoutUpdateOne) SetNillableExpireAt(t *time.Time) *CheckoutUpdateOne {
+	if t != nil {
+		cuo.SetExpireAt(*t)
+	}
+	return cuo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (cuo *CheckoutUpdateOne) SetOwnerID(i int) *CheckoutUpdateOne {
+	cuo.mutation.SetOwnerID(i)
+	return cuo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (cuo *CheckoutUpdateOne) SetNillableOwnerID(i *int) *CheckoutUpdateOne {
+	if i != nil {
+		cuo.SetOwnerID(*i)
+	}
+	return cuo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (cuo *CheckoutUpdateOne) SetOwner(u *User) *CheckoutUpdateOne {
+	return cuo.SetOwnerID(u.ID)
+}
+
+// Mutation returns the CheckoutMutation object of the builder.
+func (cuo *CheckoutUpdateOne) Mutation() *CheckoutMutation {
+	return cuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (cuo *CheckoutUpdateOne) ClearOwner() *CheckoutUpdateOne {
+	cuo.mutation.ClearOwner()
+	return cuo
+}
+
+// Where appends a list predicates to the CheckoutUpdate builder.
+func (cuo *CheckoutUpdateOne) Where(ps ...predicate.Checkout) *CheckoutUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CheckoutUpdateOne) Select(field string, fields ...string) *CheckoutUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Checkout entity.
+func (cuo *CheckoutUpdateOne) Save(ctx context.Context) (*Checkout, error) {
+	if err := cuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CheckoutUpdateOne) SaveX(ctx context.Context) *Checkout {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CheckoutUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CheckoutUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CheckoutUpdateOne) defaults() error {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		if checkout.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized checkout.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := checkout.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CheckoutUpdateOne) check() error {
+	if _, ok := cuo.mutation.OwnerID(); cuo.mutation.OwnerCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Checkout.owner"`)
+	}
+	return nil
+}
+
+func (cuo *CheckoutUpdateOne) sqlSave(ctx context.Context) (_node *Checkout, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(checkout.Table, checkout.Columns, sqlgraph.NewFieldSpec(checkout.FieldID, field.TypeInt))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Checkout.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, checkout.FieldID)
+		for _, f := range fields {
+			if !checkout.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != checkout.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(checkout.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.DeletedAt(); ok {
+		_spec.SetField(checkout.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(checkout.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.Token(); ok {
+		_spec.SetField(checkout.FieldToken, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Ns(); ok {
+		_spec.SetField(checkout.FieldNs, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Path(); ok {
+		_spec.SetField(checkout.FieldPath, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.FileType(); ok {
+		_spec.SetField(checkout.FieldFileType, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedFileType(); ok {
+		_spec.AddField(checkout.FieldFileType, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.ZeroDepth(); ok {
+		_spec.SetField(checkout.FieldZeroDepth, field.TypeBool, value)
+	}
+	if value, ok := cuo.mutation.ExpireAt(); ok {
+		_spec.SetField(checkout.FieldExpireAt, field.TypeTime, value)
+	}
+	if cuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkout.OwnerTable,
+			Columns: []string{checkout.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   checkout.OwnerTable,
+			Columns: []string{checkout.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Checkout{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{checkout.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/dailyactiveuser"
+)
+
+// DailyActiveUser is the model entity for the DailyActiveUser schema.
+type DailyActiveUser struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Date holds the value of the "date" field.
+	Date string `json:"date,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID       int `json:"user_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DailyActiveUser) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dailyactiveuser.FieldID, dailyactiveuser.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case dailyactiveuser.FieldDate:
+			values[i] = new(sql.NullString)
+		case dailyactiveuser.FieldCreatedAt, dailyactiveuser.FieldUpdatedAt, dailyactiveuser.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DailyActiveUser fields.
+func (dau *DailyActiveUser) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dailyactiveuser.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			dau.ID = int(value.Int64)
+		case dailyactiveuser.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				dau.CreatedAt = value.Time
+			}
+		case dailyactiveuser.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				dau.UpdatedAt = value.Time
+			}
+		case dailyactiveuser.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				dau.DeletedAt = new(time.Time)
+				*dau.DeletedAt = value.Time
+			}
+		case dailyactiveuser.FieldDate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				dau.Date = value.String
+			}
+		case dailyactiveuser.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				dau.UserID = int(value.Int64)
+			}
+		default:
+			dau.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DailyActiveUser.
+// This includes values selected through modifiers, order, etc.
+func (dau *DailyActiveUser) Value(name string) (ent.Value, error) {
+	return dau.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DailyActiveUser.
+// Note that you need to call DailyActiveUser.Unwrap() before calling this method if this DailyActiveUser
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dau *DailyActiveUser) Update() *DailyActiveUserUpdateOne {
+	return NewDailyActiveUserClient(dau.config).UpdateOne(dau)
+}
+
+// Unwrap unwraps the DailyActiveUser entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dau *DailyActiveUser) Unwrap() *DailyActiveUser {
+	_tx, ok := dau.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DailyActiveUser is not a transactional entity")
+	}
+	dau.config.driver = _tx.drv
+	return dau
+}
+
+// String implements the fmt.Stringer.
+func (dau *DailyActiveUser) String() string {
+	var builder strings.Builder
+	builder.WriteString("DailyActiveUser(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dau.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(dau.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(dau.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := dau.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(dau.Date)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", dau.UserID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DailyActiveUsers is a parsable slice of DailyActiveUser.
+type DailyActiveUsers []*DailyActiveUser
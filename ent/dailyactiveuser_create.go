@@ -0,0 +1,735 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/dailyactiveuser"
+)
+
+// DailyActiveUserCreate is the builder for creating a DailyActiveUser entity.
+type DailyActiveUserCreate struct {
+	config
+	mutation *DailyActiveUserMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dauc *DailyActiveUserCreate) SetCreatedAt(t time.Time) *DailyActiveUserCreate {
+	dauc.mutation.SetCreatedAt(t)
+	return dauc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dauc *DailyActiveUserCreate) SetNillableCreatedAt(t *time.Time) *DailyActiveUserCreate {
+	if t != nil {
+		dauc.SetCreatedAt(*t)
+	}
+	return dauc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dauc *DailyActiveUserCreate) SetUpdatedAt(t time.Time) *DailyActiveUserCreate {
+	dauc.mutation.SetUpdatedAt(t)
+	return dauc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dauc *DailyActiveUserCreate) SetNillableUpdatedAt(t *time.Time) *DailyActiveUserCreate {
+	if t != nil {
+		dauc.SetUpdatedAt(*t)
+	}
+	return dauc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dauc *DailyActiveUserCreate) SetDeletedAt(t time.Time) *DailyActiveUserCreate {
+	dauc.mutation.SetDeletedAt(t)
+	return dauc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dauc *DailyActiveUserCreate) SetNillableDeletedAt(t *time.Time) *DailyActiveUserCreate {
+	if t != nil {
+		dauc.SetDeletedAt(*t)
+	}
+	return dauc
+}
+
+// SetDate sets the "date" field.
+func (dauc *DailyActiveUserCreate) SetDate(s string) *DailyActiveUserCreate {
+	dauc.mutation.SetDate(s)
+	return dauc
+}
+
+// SetUserID sets the "user_id" field.
+func (dauc *DailyActiveUserCreate) SetUserID(i int) *DailyActiveUserCreate {
+	dauc.mutation.SetUserID(i)
+	return dauc
+}
+
+// Mutation returns the DailyActiveUserMutation object of the builder.
+func (dauc *DailyActiveUserCreate) Mutation() *DailyActiveUserMutation {
+	return dauc.mutation
+}
+
+// Save creates the DailyActiveUser in the database.
+func (dauc *DailyActiveUserCreate) Save(ctx context.Context) (*DailyActiveUser, error) {
+	if err := dauc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, dauc.sqlSave, dauc.mutation, dauc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dauc *DailyActiveUserCreate) SaveX(ctx context.Context) *DailyActiveUser {
+	v, err := dauc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dauc *DailyActiveUserCreate) Exec(ctx context.Context) error {
+	_, err := dauc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dauc *DailyActiveUserCreate) ExecX(ctx context.Context) {
+	if err := dauc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dauc *DailyActiveUserCreate) defaults() error {
+	if _, ok := dauc.mutation.CreatedAt(); !ok {
+		if dailyactiveuser.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dailyactiveuser.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := dailyactiveuser.DefaultCreatedAt()
+		dauc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dauc.mutation.UpdatedAt(); !ok {
+		if dailyactiveuser.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dailyactiveuser.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := dailyactiveuser.DefaultUpdatedAt()
+		dauc.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dauc *DailyActiveUserCreate) check() error {
+	if _, ok := dauc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DailyActiveUser.created_at"`)}
+	}
+	if _, ok := dauc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DailyActiveUser.updated_at"`)}
+	}
+	if _, ok := dauc.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "DailyActiveUser.date"`)}
+	}
+	if _, ok := dauc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "DailyActiveUser.user_id"`)}
+	}
+	return nil
+}
+
+func (dauc *DailyActiveUserCreate) sqlSave(ctx context.Context) (*DailyActiveUser, error) {
+	if err := dauc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dauc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dauc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dauc.mutation.id = &_node.ID
+	dauc.mutation.done = true
+	return _node, nil
+}
+
+func (dauc *DailyActiveUserCreate) createSpec() (*DailyActiveUser, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DailyActiveUser{config: dauc.config}
+		_spec = sqlgraph.NewCreateSpec(dailyactiveuser.Table, sqlgraph.NewFieldSpec(dailyactiveuser.FieldID, field.TypeInt))
+	)
+
+	if id, ok := dauc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = dauc.conflict
+	if value, ok := dauc.mutation.CreatedAt(); ok {
+		_spec.SetField(dailyactiveuser.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dauc.mutation.UpdatedAt(); ok {
+		_spec.SetField(dailyactiveuser.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := dauc.mutation.DeletedAt(); ok {
+		_spec.SetField(dailyactiveuser.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := dauc.mutation.Date(); ok {
+		_spec.SetField(dailyactiveuser.FieldDate, field.TypeString, value)
+		_node.Date = value
+	}
+	if value, ok := dauc.mutation.UserID(); ok {
+		_spec.SetField(dailyactiveuser.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DailyActiveUser.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DailyActiveUserUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dauc *DailyActiveUserCreate) OnConflict(opts ...sql.ConflictOption) *DailyActiveUserUpsertOne {
+	dauc.conflict = opts
+	return &DailyActiveUserUpsertOne{
+		create: dauc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DailyActiveUser.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dauc *DailyActiveUserCreate) OnConflictColumns(columns ...string) *DailyActiveUserUpsertOne {
+	dauc.conflict = append(dauc.conflict, sql.ConflictColumns(columns...))
+	return &DailyActiveUserUpsertOne{
+		create: dauc,
+	}
+}
+
+type (
+	// DailyActiveUserUpsertOne is the builder for "upsert"-ing
+	//  one DailyActiveUser node.
+	DailyActiveUserUpsertOne struct {
+		create *DailyActiveUserCreate
+	}
+
+	// DailyActiveUserUpsert is the "OnConflict" setter.
+	DailyActiveUserUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DailyActiveUserUpsert) SetUpdatedAt(v time.Time) *DailyActiveUserUpsert {
+	u.Set(dailyactiveuser.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DailyActiveUserUpsert) UpdateUpdatedAt() *DailyActiveUserUpsert {
+	u.SetExcluded(dailyactiveuser.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *DailyActiveUserUpsert) SetDeletedAt(v time.Time) *DailyActiveUserUpsert {
+	u.Set(dailyactiveuser.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *DailyActiveUserUpsert) UpdateDeletedAt() *DailyActiveUserUpsert {
+	u.SetExcluded(dailyactiveuser.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *DailyActiveUserUpsert) ClearDeletedAt() *DailyActiveUserUpsert {
+	u.SetNull(dailyactiveuser.FieldDeletedAt)
+	return u
+}
+
+// SetDate sets the "date" field.
+func (u *DailyActiveUserUpsert) SetDate(v string) *DailyActiveUserUpsert {
+	u.Set(dailyactiveuser.FieldDate, v)
+	return u
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *DailyActiveUserUpsert) UpdateDate() *DailyActiveUserUpsert {
+	u.SetExcluded(dailyactiveuser.FieldDate)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DailyActiveUserUpsert) SetUserID(v int) *DailyActiveUserUpsert {
+	u.Set(dailyactiveuser.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DailyActiveUserUpsert) UpdateUserID() *DailyActiveUserUpsert {
+	u.SetExcluded(dailyactiveuser.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *DailyActiveUserUpsert) AddUserID(v int) *DailyActiveUserUpsert {
+	u.Add(dailyactiveuser.FieldUserID, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.DailyActiveUser.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DailyActiveUserUpsertOne) UpdateNewValues() *DailyActiveUserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(dailyactiveuser.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DailyActiveUser.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DailyActiveUserUpsertOne) Ignore() *DailyActiveUserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DailyActiveUserUpsertOne) DoNothing() *DailyActiveUserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DailyActiveUserCreate.OnConflict
+// documentation for more info.
+func (u *DailyActiveUserUpsertOne) Update(set func(*DailyActiveUserUpsert)) *DailyActiveUserUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DailyActiveUserUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DailyActiveUserUpsertOne) SetUpdatedAt(v time.Time) *DailyActiveUserUpsertOne {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DailyActiveUserUpsertOne) UpdateUpdatedAt() *DailyActiveUserUpsertOne {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *DailyActiveUserUpsertOne) SetDeletedAt(v time.Time) *DailyActiveUserUpsertOne {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *DailyActiveUserUpsertOne) UpdateDeletedAt() *DailyActiveUserUpsertOne {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *DailyActiveUserUpsertOne) ClearDeletedAt() *DailyActiveUserUpsertOne {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *DailyActiveUserUpsertOne) SetDate(v string) *DailyActiveUserUpsertOne {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *DailyActiveUserUpsertOne) UpdateDate() *DailyActiveUserUpsertOne {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DailyActiveUserUpsertOne) SetUserID(v int) *DailyActiveUserUpsertOne {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *DailyActiveUserUpsertOne) AddUserID(v int) *DailyActiveUserUpsertOne {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DailyActiveUserUpsertOne) UpdateUserID() *DailyActiveUserUpsertOne {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// Exec executes the query.
+func (u *DailyActiveUserUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DailyActiveUserCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DailyActiveUserUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DailyActiveUserUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DailyActiveUserUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *DailyActiveUserCreate) SetRawID(t int) *DailyActiveUserCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// DailyActiveUserCreateBulk is the builder for creating many DailyActiveUser entities in bulk.
+type DailyActiveUserCreateBulk struct {
+	config
+	err      error
+	builders []*DailyActiveUserCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DailyActiveUser entities in the database.
+func (daucb *DailyActiveUserCreateBulk) Save(ctx context.Context) ([]*DailyActiveUser, error) {
+	if daucb.err != nil {
+		return nil, daucb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(daucb.builders))
+	nodes := make([]*DailyActiveUser, len(daucb.builders))
+	mutators := make([]Mutator, len(daucb.builders))
+	for i := range daucb.builders {
+		func(i int, root context.Context) {
+			builder := daucb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DailyActiveUserMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, daucb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = daucb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, daucb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, daucb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (daucb *DailyActiveUserCreateBulk) SaveX(ctx context.Context) []*DailyActiveUser {
+	v, err := daucb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (daucb *DailyActiveUserCreateBulk) Exec(ctx context.Context) error {
+	_, err := daucb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (daucb *DailyActiveUserCreateBulk) ExecX(ctx context.Context) {
+	if err := daucb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DailyActiveUser.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DailyActiveUserUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (daucb *DailyActiveUserCreateBulk) OnConflict(opts ...sql.ConflictOption) *DailyActiveUserUpsertBulk {
+	daucb.conflict = opts
+	return &DailyActiveUserUpsertBulk{
+		create: daucb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DailyActiveUser.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (daucb *DailyActiveUserCreateBulk) OnConflictColumns(columns ...string) *DailyActiveUserUpsertBulk {
+	daucb.conflict = append(daucb.conflict, sql.ConflictColumns(columns...))
+	return &DailyActiveUserUpsertBulk{
+		create: daucb,
+	}
+}
+
+// DailyActiveUserUpsertBulk is the builder for "upsert"-ing
+// a bulk of DailyActiveUser nodes.
+type DailyActiveUserUpsertBulk struct {
+	create *DailyActiveUserCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DailyActiveUser.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DailyActiveUserUpsertBulk) UpdateNewValues() *DailyActiveUserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(dailyactiveuser.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DailyActiveUser.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DailyActiveUserUpsertBulk) Ignore() *DailyActiveUserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DailyActiveUserUpsertBulk) DoNothing() *DailyActiveUserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DailyActiveUserCreateBulk.OnConflict
+// documentation for more info.
+func (u *DailyActiveUserUpsertBulk) Update(set func(*DailyActiveUserUpsert)) *DailyActiveUserUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DailyActiveUserUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DailyActiveUserUpsertBulk) SetUpdatedAt(v time.Time) *DailyActiveUserUpsertBulk {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DailyActiveUserUpsertBulk) UpdateUpdatedAt() *DailyActiveUserUpsertBulk {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *DailyActiveUserUpsertBulk) SetDeletedAt(v time.Time) *DailyActiveUserUpsertBulk {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *DailyActiveUserUpsertBulk) UpdateDeletedAt() *DailyActiveUserUpsertBulk {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *DailyActiveUserUpsertBulk) ClearDeletedAt() *DailyActiveUserUpsertBulk {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *DailyActiveUserUpsertBulk) SetDate(v string) *DailyActiveUserUpsertBulk {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *DailyActiveUserUpsertBulk) UpdateDate() *DailyActiveUserUpsertBulk {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DailyActiveUserUpsertBulk) SetUserID(v int) *DailyActiveUserUpsertBulk {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *DailyActiveUserUpsertBulk) AddUserID(v int) *DailyActiveUserUpsertBulk {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DailyActiveUserUpsertBulk) UpdateUserID() *DailyActiveUserUpsertBulk {
+	return u.Update(func(s *DailyActiveUserUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// Exec executes the query.
+func (u *DailyActiveUserUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DailyActiveUserCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DailyActiveUserCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DailyActiveUserUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
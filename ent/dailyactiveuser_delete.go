@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/dailyactiveuser"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// DailyActiveUserDelete is the builder for deleting a DailyActiveUser entity.
+type DailyActiveUserDelete struct {
+	config
+	hooks    []Hook
+	mutation *DailyActiveUserMutation
+}
+
+// Where appends a list predicates to the DailyActiveUserDelete builder.
+func (daud *DailyActiveUserDelete) Where(ps ...predicate.DailyActiveUser) *DailyActiveUserDelete {
+	daud.mutation.Where(ps...)
+	return daud
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (daud *DailyActiveUserDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, daud.sqlExec, daud.mutation, daud.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (daud *DailyActiveUserDelete) ExecX(ctx context.Context) int {
+	n, err := daud.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (daud *DailyActiveUserDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(dailyactiveuser.Table, sqlgraph.NewFieldSpec(dailyactiveuser.FieldID, field.TypeInt))
+	if ps := daud.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, daud.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	daud.mutation.done = true
+	return affected, err
+}
+
+// DailyActiveUserDeleteOne is the builder for deleting a single DailyActiveUser entity.
+type DailyActiveUserDeleteOne struct {
+	daud *DailyActiveUserDelete
+}
+
+// Where appends a list predicates to the DailyActiveUserDelete builder.
+func (daudo *DailyActiveUserDeleteOne) Where(ps ...predicate.DailyActiveUser) *DailyActiveUserDeleteOne {
+	daudo.daud.mutation.Where(ps...)
+	return daudo
+}
+
+// Exec executes the deletion query.
+func (daudo *DailyActiveUserDeleteOne) Exec(ctx context.Context) error {
+	n, err := daudo.daud.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{dailyactiveuser.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (daudo *DailyActiveUserDeleteOne) ExecX(ctx context.Context) {
+	if err := daudo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,539 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/dailyactiveuser"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// DailyActiveUserQuery is the builder for querying DailyActiveUser entities.
+type DailyActiveUserQuery struct {
+	config
+	ctx        *QueryContext
+	order      []dailyactiveuser.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DailyActiveUser
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*DailyActiveUser) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DailyActiveUserQuery builder.
+func (dauq *DailyActiveUserQuery) Where(ps ...predicate.DailyActiveUser) *DailyActiveUserQuery {
+	dauq.predicates = append(dauq.predicates, ps...)
+	return dauq
+}
+
+// Limit the number of records to be returned by this query.
+func (dauq *DailyActiveUserQuery) Limit(limit int) *DailyActiveUserQuery {
+	dauq.ctx.Limit = &limit
+	return dauq
+}
+
+// Offset to start from.
+func (dauq *DailyActiveUserQuery) Offset(offset int) *DailyActiveUserQuery {
+	dauq.ctx.Offset = &offset
+	return dauq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dauq *DailyActiveUserQuery) Unique(unique bool) *DailyActiveUserQuery {
+	dauq.ctx.Unique = &unique
+	return dauq
+}
+
+// Order specifies how the records should be ordered.
+func (dauq *DailyActiveUserQuery) Order(o ...dailyactiveuser.OrderOption) *DailyActiveUserQuery {
+	dauq.order = append(dauq.order, o...)
+	return dauq
+}
+
+// First returns the first DailyActiveUser entity from the query.
+// Returns a *NotFoundError when no DailyActiveUser was found.
+func (dauq *DailyActiveUserQuery) First(ctx context.Context) (*DailyActiveUser, error) {
+	nodes, err := dauq.Limit(1).All(setContextOp(ctx, dauq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{dailyactiveuser.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dauq *DailyActiveUserQuery) FirstX(ctx context.Context) *DailyActiveUser {
+	node, err := dauq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DailyActiveUser ID from the query.
+// Returns a *NotFoundError when no DailyActiveUser ID was found.
+func (dauq *DailyActiveUserQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dauq.Limit(1).IDs(setContextOp(ctx, dauq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{dailyactiveuser.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dauq *DailyActiveUserQuery) FirstIDX(ctx context.Context) int {
+	id, err := dauq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DailyActiveUser entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DailyActiveUser entity is found.
+// Returns a *NotFoundError when no DailyActiveUser entities are found.
+func (dauq *DailyActiveUserQuery) Only(ctx context.Context) (*DailyActiveUser, error) {
+	nodes, err := dauq.Limit(2).All(setContextOp(ctx, dauq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{dailyactiveuser.Label}
+	default:
+		return nil, &NotSingularError{dailyactiveuser.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dauq *DailyActiveUserQuery) OnlyX(ctx context.Context) *DailyActiveUser {
+	node, err := dauq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DailyActiveUser ID in the query.
+// Returns a *NotSingularError when more than one DailyActiveUser ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dauq *DailyActiveUserQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dauq.Limit(2).IDs(setContextOp(ctx, dauq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{dailyactiveuser.Label}
+	default:
+		err = &NotSingularError{dailyactiveuser.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dauq *DailyActiveUserQuery) OnlyIDX(ctx context.Context) int {
+	id, err := dauq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DailyActiveUsers.
+func (dauq *DailyActiveUserQuery) All(ctx context.Context) ([]*DailyActiveUser, error) {
+	ctx = setContextOp(ctx, dauq.ctx, "All")
+	if err := dauq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DailyActiveUser, *DailyActiveUserQuery]()
+	return withInterceptors[[]*DailyActiveUser](ctx, dauq, qr, dauq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dauq *DailyActiveUserQuery) AllX(ctx context.Context) []*DailyActiveUser {
+	nodes, err := dauq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DailyActiveUser IDs.
+func (dauq *DailyActiveUserQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if dauq.ctx.Unique == nil && dauq.path != nil {
+		dauq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dauq.ctx, "IDs")
+	if err = dauq.Select(dailyactiveuser.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dauq *DailyActiveUserQuery) IDsX(ctx context.Context) []int {
+	ids, err := dauq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dauq *DailyActiveUserQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dauq.ctx, "Count")
+	if err := dauq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dauq, querierCount[*DailyActiveUserQuery](), dauq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dauq *DailyActiveUserQuery) CountX(ctx context.Context) int {
+	count, err := dauq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dauq *DailyActiveUserQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dauq.ctx, "Exist")
+	switch _, err := dauq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dauq *DailyActiveUserQuery) ExistX(ctx context.Context) bool {
+	exist, err := dauq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DailyActiveUserQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dauq *DailyActiveUserQuery) Clone() *DailyActiveUserQuery {
+	if dauq == nil {
+		return nil
+	}
+	return &DailyActiveUserQuery{
+		config:     dauq.config,
+		ctx:        dauq.ctx.Clone(),
+		order:      append([]dailyactiveuser.OrderOption{}, dauq.order...),
+		inters:     append([]Interceptor{}, dauq.inters...),
+		predicates: append([]predicate.DailyActiveUser{}, dauq.predicates...),
+		// clone intermediate query.
+		sql:  dauq.sql.Clone(),
+		path: dauq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DailyActiveUser.Query().
+//		GroupBy(dailyactiveuser.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dauq *DailyActiveUserQuery) GroupBy(field string, fields ...string) *DailyActiveUserGroupBy {
+	dauq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DailyActiveUserGroupBy{build: dauq}
+	grbuild.flds = &dauq.ctx.Fields
+	grbuild.label = dailyactiveuser.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.DailyActiveUser.Query().
+//		Select(dailyactiveuser.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (dauq *DailyActiveUserQuery) Select(fields ...string) *DailyActiveUserSelect {
+	dauq.ctx.Fields = append(dauq.ctx.Fields, fields...)
+	sbuild := &DailyActiveUserSelect{DailyActiveUserQuery: dauq}
+	sbuild.label = dailyactiveuser.Label
+	sbuild.flds, sbuild.scan = &dauq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DailyActiveUserSelect configured with the given aggregations.
+func (dauq *DailyActiveUserQuery) Aggregate(fns ...AggregateFunc) *DailyActiveUserSelect {
+	return dauq.Select().Aggregate(fns...)
+}
+
+func (dauq *DailyActiveUserQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dauq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dauq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dauq.ctx.Fields {
+		if !dailyactiveuser.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dauq.path != nil {
+		prev, err := dauq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dauq.sql = prev
+	}
+	return nil
+}
+
+func (dauq *DailyActiveUserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DailyActiveUser, error) {
+	var (
+		nodes = []*DailyActiveUser{}
+		_spec = dauq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DailyActiveUser).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DailyActiveUser{config: dauq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(dauq.modifiers) > 0 {
+		_spec.Modifiers = dauq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dauq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range dauq.loadTotal {
+		if err := dauq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (dauq *DailyActiveUserQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dauq.querySpec()
+	if len(dauq.modifiers) > 0 {
+		_spec.Modifiers = dauq.modifiers
+	}
+	_spec.Node.Columns = dauq.ctx.Fields
+	if len(dauq.ctx.Fields) > 0 {
+		_spec.Unique = dauq.ctx.Unique != nil && *dauq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dauq.driver, _spec)
+}
+
+func (dauq *DailyActiveUserQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(dailyactiveuser.Table, dailyactiveuser.Columns, sqlgraph.NewFieldSpec(dailyactiveuser.FieldID, field.TypeInt))
+	_spec.From = dauq.sql
+	if unique := dauq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dauq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dauq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dailyactiveuser.FieldID)
+		for i := range fields {
+			if fields[i] != dailyactiveuser.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dauq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dauq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dauq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dauq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dauq *DailyActiveUserQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dauq.driver.Dialect())
+	t1 := builder.Table(dailyactiveuser.Table)
+	columns := dauq.ctx.Fields
+	if len(columns) == 0 {
+		columns = dailyactiveuser.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dauq.sql != nil {
+		selector = dauq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dauq.ctx.Unique != nil && *dauq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range dauq.predicates {
+		p(selector)
+	}
+	for _, p := range dauq.order {
+		p(selector)
+	}
+	if offset := dauq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dauq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DailyActiveUserGroupBy is the group-by builder for DailyActiveUser entities.
+type DailyActiveUserGroupBy struct {
+	selector
+	build *DailyActiveUserQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (daugb *DailyActiveUserGroupBy) Aggregate(fns ...AggregateFunc) *DailyActiveUserGroupBy {
+	daugb.fns = append(daugb.fns, fns...)
+	return daugb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (daugb *DailyActiveUserGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, daugb.build.ctx, "GroupBy")
+	if err := daugb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DailyActiveUserQuery, *DailyActiveUserGroupBy](ctx, daugb.build, daugb, daugb.build.inters, v)
+}
+
+func (daugb *DailyActiveUserGroupBy) sqlScan(ctx context.Context, root *DailyActiveUserQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(daugb.fns))
+	for _, fn := range daugb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*daugb.flds)+len(daugb.fns))
+		for _, f := range *daugb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*daugb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := daugb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DailyActiveUserSelect is the builder for selecting fields of DailyActiveUser entities.
+type DailyActiveUserSelect struct {
+	*DailyActiveUserQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (daus *DailyActiveUserSelect) Aggregate(fns ...AggregateFunc) *DailyActiveUserSelect {
+	daus.fns = append(daus.fns, fns...)
+	return daus
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (daus *DailyActiveUserSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, daus.ctx, "Select")
+	if err := daus.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DailyActiveUserQuery, *DailyActiveUserSelect](ctx, daus.DailyActiveUserQuery, daus, daus.inters, v)
+}
+
+func (daus *DailyActiveUserSelect) sqlScan(ctx context.Context, root *DailyActiveUserQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(daus.fns))
+	for _, fn := range daus.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*daus.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := daus.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
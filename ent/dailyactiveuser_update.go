@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/dailyactiveuser"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// DailyActiveUserUpdate is the builder for updating DailyActiveUser entities.
+type DailyActiveUserUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DailyActiveUserMutation
+}
+
+// Where appends a list predicates to the DailyActiveUserUpdate builder.
+func (dauu *DailyActiveUserUpdate) Where(ps ...predicate.DailyActiveUser) *DailyActiveUserUpdate {
+	dauu.mutation.Where(ps...)
+	return dauu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dauu *DailyActiveUserUpdate) SetUpdatedAt(t time.Time) *DailyActiveUserUpdate {
+	dauu.mutation.SetUpdatedAt(t)
+	return dauu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dauu *DailyActiveUserUpdate) SetDeletedAt(t time.Time) *DailyActiveUserUpdate {
+	dauu.mutation.SetDeletedAt(t)
+	return dauu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dauu *DailyActiveUserUpdate) SetNillableDeletedAt(t *time.Time) *DailyActiveUserUpdate {
+	if t != nil {
+		dauu.SetDeletedAt(*t)
+	}
+	return dauu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (dauu *DailyActiveUserUpdate) ClearDeletedAt() *DailyActiveUserUpdate {
+	dauu.mutation.ClearDeletedAt()
+	return dauu
+}
+
+// SetDate sets the "date" field.
+func (dauu *DailyActiveUserUpdate) SetDate(s string) *DailyActiveUserUpdate {
+	dauu.mutation.SetDate(s)
+	return dauu
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (dauu *DailyActiveUserUpdate) SetNillableDate(s *string) *DailyActiveUserUpdate {
+	if s != nil {
+		dauu.SetDate(*s)
+	}
+	return dauu
+}
+
+// SetUserID sets the "user_id" field.
+func (dauu *DailyActiveUserUpdate) SetUserID(i int) *DailyActiveUserUpdate {
+	dauu.mutation.ResetUserID()
+	dauu.mutation.SetUserID(i)
+	return dauu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (dauu *DailyActiveUserUpdate) SetNillableUserID(i *int) *DailyActiveUserUpdate {
+	if i != nil {
+		dauu.SetUserID(*i)
+	}
+	return dauu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (dauu *DailyActiveUserUpdate) AddUserID(i int) *DailyActiveUserUpdate {
+	dauu.mutation.AddUserID(i)
+	return dauu
+}
+
+// Mutation returns the DailyActiveUserMutation object of the builder.
+func (dauu *DailyActiveUserUpdate) Mutation() *DailyActiveUserMutation {
+	return dauu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dauu *DailyActiveUserUpdate) Save(ctx context.Context) (int, error) {
+	if err := dauu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, dauu.sqlSave, dauu.mutation, dauu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dauu *DailyActiveUserUpdate) SaveX(ctx context.Context) int {
+	affected, err := dauu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dauu *DailyActiveUserUpdate) Exec(ctx context.Context) error {
+	_, err := dauu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dauu *DailyActiveUserUpdate) ExecX(ctx context.Context) {
+	if err := dauu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dauu *DailyActiveUserUpdate) defaults() error {
+	if _, ok := dauu.mutation.UpdatedAt(); !ok {
+		if dailyactiveuser.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dailyactiveuser.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := dailyactiveuser.UpdateDefaultUpdatedAt()
+		dauu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (dauu *DailyActiveUserUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dailyactiveuser.Table, dailyactiveuser.Columns, sqlgraph.NewFieldSpec(dailyactiveuser.FieldID, field.TypeInt))
+	if ps := dauu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dauu.mutation.UpdatedAt(); ok {
+		_spec.SetField(dailyactiveuser.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dauu.mutation.DeletedAt(); ok {
+		_spec.SetField(dailyactiveuser.FieldDeletedAt, field.TypeTime, value)
+	}
+	if dauu.mutation.DeletedAtCleared() {
+		_spec.ClearField(dailyactiveuser.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := dauu.mutation.Date(); ok {
+		_spec.SetField(dailyactiveuser.FieldDate, field.TypeString, value)
+	}
+	if value, ok := dauu.mutation.UserID(); ok {
+		_spec.SetField(dailyactiveuser.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := dauu.mutation.AddedUserID(); ok {
+		_spec.AddField(dailyactiveuser.FieldUserID, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dauu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dailyactiveuser.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dauu.mutation.done = true
+	return n, nil
+}
+
+// DailyActiveUserUpdateOne is the builder for updating a single DailyActiveUser entity.
+type DailyActiveUserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DailyActiveUserMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dauuo *DailyActiveUserUpdateOne) SetUpdatedAt(t time.Time) *DailyActiveUserUpdateOne {
+	dauuo.mutation.SetUpdatedAt(t)
+	return dauuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dauuo *DailyActiveUserUpdateOne) SetDeletedAt(t time.Time) *DailyActiveUserUpdateOne {
+	dauuo.mutation.SetDeletedAt(t)
+	return dauuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dauuo *DailyActiveUserUpdateOne) SetNillableDeletedAt(t *time.Time) *DailyActiveUserUpdateOne {
+	if t != nil {
+		dauuo.SetDeletedAt(*t)
+	}
+	return dauuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (dauuo *DailyActiveUserUpdateOne) ClearDeletedAt() *DailyActiveUserUpdateOne {
+	dauuo.mutation.ClearDeletedAt()
+	return dauuo
+}
+
+// SetDate sets the "date" field.
+func (dauuo *DailyActiveUserUpdateOne) SetDate(s string) *DailyActiveUserUpdateOne {
+	dauuo.mutation.SetDate(s)
+	return dauuo
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (dauuo *DailyActiveUserUpdateOne) SetNillableDate(s *string) *DailyActiveUserUpdateOne {
+	if s != nil {
+		dauuo.SetDate(*s)
+	}
+	return dauuo
+}
+
+// SetUserID sets the "user_id" field.
+func (dauuo *DailyActiveUserUpdateOne) SetUserID(i int) *DailyActiveUserUpdateOne {
+	dauuo.mutation.ResetUserID()
+	dauuo.mutation.SetUserID(i)
+	return dauuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (dauuo *DailyActiveUserUpdateOne) SetNillableUserID(i *int) *DailyActiveUserUpdateOne {
+	if i != nil {
+		dauuo.SetUserID(*i)
+	}
+	return dauuo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (dauuo *DailyActiveUserUpdateOne) AddUserID(i int) *DailyActiveUserUpdateOne {
+	dauuo.mutation.AddUserID(i)
+	return dauuo
+}
+
+// Mutation returns the DailyActiveUserMutation object of the builder.
+func (dauuo *DailyActiveUserUpdateOne) Mutation() *DailyActiveUserMutation {
+	return dauuo.mutation
+}
+
+// Where appends a list predicates to the DailyActiveUserUpdate builder.
+func (dauuo *DailyActiveUserUpdateOne) Where(ps ...predicate.DailyActiveUser) *DailyActiveUserUpdateOne {
+	dauuo.mutation.Where(ps...)
+	return dauuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dauuo *DailyActiveUserUpdateOne) Select(field string, fields ...string) *DailyActiveUserUpdateOne {
+	dauuo.fields = append([]string{field}, fields...)
+	return dauuo
+}
+
+// Save executes the query and returns the updated DailyActiveUser entity.
+func (dauuo *DailyActiveUserUpdateOne) Save(ctx context.Context) (*DailyActiveUser, error) {
+	if err := dauuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, dauuo.sqlSave, dauuo.mutation, dauuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dauuo *DailyActiveUserUpdateOne) SaveX(ctx context.Context) *DailyActiveUser {
+	node, err := dauuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dauuo *DailyActiveUserUpdateOne) Exec(ctx context.Context) error {
+	_, err := dauuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dauuo *DailyActiveUserUpdateOne) ExecX(ctx context.Context) {
+	if err := dauuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dauuo *DailyActiveUserUpdateOne) defaults() error {
+	if _, ok := dauuo.mutation.UpdatedAt(); !ok {
+		if dailyactiveuser.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dailyactiveuser.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := dailyactiveuser.UpdateDefaultUpdatedAt()
+		dauuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (dauuo *DailyActiveUserUpdateOne) sqlSave(ctx context.Context) (_node *DailyActiveUser, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dailyactiveuser.Table, dailyactiveuser.Columns, sqlgraph.NewFieldSpec(dailyactiveuser.FieldID, field.TypeInt))
+	id, ok := dauuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DailyActiveUser.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dauuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dailyactiveuser.FieldID)
+		for _, f := range fields {
+			if !dailyactiveuser.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dailyactiveuser.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dauuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dauuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(dailyactiveuser.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dauuo.mutation.DeletedAt(); ok {
+		_spec.SetField(dailyactiveuser.FieldDeletedAt, field.TypeTime, value)
+	}
+	if dauuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(dailyactiveuser.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := dauuo.mutation.Date(); ok {
+		_spec.SetField(dailyactiveuser.FieldDate, field.TypeString, value)
+	}
+	if value, ok := dauuo.mutation.UserID(); ok {
+		_spec.SetField(dailyactiveuser.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := dauuo.mutation.AddedUserID(); ok {
+		_spec.AddField(dailyactiveuser.FieldUserID, field.TypeInt, value)
+	}
+	_node = &DailyActiveUser{config: dauuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dauuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dailyactiveuser.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dauuo.mutation.done = true
+	return _node, nil
+}
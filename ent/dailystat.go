@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/dailystat"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// DailyStat is the model entity for the DailyStat schema.
+type DailyStat struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Date holds the value of the "date" field.
+	Date string `json:"date,omitempty"`
+	// ActiveUserCount holds the value of the "active_user_count" field.
+	ActiveUserCount int `json:"active_user_count,omitempty"`
+	// Uploads holds the value of the "uploads" field.
+	Uploads int `json:"uploads,omitempty"`
+	// UploadSize holds the value of the "upload_size" field.
+	UploadSize int64 `json:"upload_size,omitempty"`
+	// Downloads holds the value of the "downloads" field.
+	Downloads int `json:"downloads,omitempty"`
+	// DownloadSize holds the value of the "download_size" field.
+	DownloadSize int64 `json:"download_size,omitempty"`
+	// FileTypes holds the value of the "file_types" field.
+	FileTypes map[string]*types.StatsFileType `json:"file_types,omitempty"`
+	// PolicyStorage holds the value of the "policy_storage" field.
+	PolicyStorage map[int]int64 `json:"policy_storage,omitempty"`
+	// GroupStorage holds the value of the "group_storage" field.
+	GroupStorage map[int]int64 `json:"group_storage,omitempty"`
+	// TopUsers holds the value of the "top_users" field.
+	TopUsers     []types.StatsUserStorage `json:"top_users,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DailyStat) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dailystat.FieldFileTypes, dailystat.FieldPolicyStorage, dailystat.FieldGroupStorage, dailystat.FieldTopUsers:
+			values[i] = new([]byte)
+		case dailystat.FieldID, dailystat.FieldActiveUserCount, dailystat.FieldUploads, dailystat.FieldUploadSize, dailystat.FieldDownloads, dailystat.FieldDownloadSize:
+			values[i] = new(sql.NullInt64)
+		case dailystat.FieldDate:
+			values[i] = new(sql.NullString)
+		case dailystat.FieldCreatedAt, dailystat.FieldUpdatedAt, dailystat.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DailyStat fields.
+func (ds *DailyStat) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dailystat.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ds.ID = int(value.Int64)
+		case dailystat.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ds.CreatedAt = value.Time
+			}
+		case dailystat.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ds.UpdatedAt = value.Time
+			}
+		case dailystat.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ds.DeletedAt = new(time.Time)
+				*ds.DeletedAt = value.Time
+			}
+		case dailystat.FieldDate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				ds.Date = value.String
+			}
+		case dailystat.FieldActiveUserCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field active_user_count", values[i])
+			} else if value.Valid {
+				ds.ActiveUserCount = int(value.Int64)
+			}
+		case dailystat.FieldUploads:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field uploads", values[i])
+			} else if value.Valid {
+				ds.Uploads = int(value.Int64)
+			}
+		case dailystat.FieldUploadSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_size", values[i])
+			} else if value.Valid {
+				ds.UploadSize = value.Int64
+			}
+		case dailystat.FieldDownloads:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field downloads", values[i])
+			} else if value.Valid {
+				ds.Downloads = int(value.Int64)
+			}
+		case dailystat.FieldDownloadSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field download_size", values[i])
+			} else if value.Valid {
+				ds.DownloadSize = value.Int64
+			}
+		case dailystat.FieldFileTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field file_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ds.FileTypes); err != nil {
+					return fmt.Errorf("unmarshal field file_types: %w", err)
+				}
+			}
+		case dailystat.FieldPolicyStorage:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_storage", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ds.PolicyStorage); err != nil {
+					return fmt.Errorf("unmarshal field policy_storage: %w", err)
+				}
+			}
+		case dailystat.FieldGroupStorage:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field group_storage", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ds.GroupStorage); err != nil {
+					return fmt.Errorf("unmarshal field group_storage: %w", err)
+				}
+			}
+		case dailystat.FieldTopUsers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field top_users", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ds.TopUsers); err != nil {
+					return fmt.Errorf("unmarshal field top_users: %w", err)
+				}
+			}
+		default:
+			ds.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DailyStat.
+// This includes values selected through modifiers, order, etc.
+func (ds *DailyStat) Value(name string) (ent.Value, error) {
+	return ds.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DailyStat.
+// Note that you need to call DailyStat.Unwrap() before calling this method if this DailyStat
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ds *DailyStat) Update() *DailyStatUpdateOne {
+	return NewDailyStatClient(ds.config).UpdateOne(ds)
+}
+
+// Unwrap unwraps the DailyStat entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ds *DailyStat) Unwrap() *DailyStat {
+	_tx, ok := ds.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DailyStat is not a transactional entity")
+	}
+	ds.config.driver = _tx.drv
+	return ds
+}
+
+// String implements the fmt.Stringer.
+func (ds *DailyStat) String() string {
+	var builder strings.Builder
+	builder.WriteString("DailyStat(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ds.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ds.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ds.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ds.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(ds.Date)
+	builder.WriteString(", ")
+	builder.WriteString("active_user_count=")
+	builder.WriteString(fmt.Sprintf("%v", ds.ActiveUserCount))
+	builder.WriteString(", ")
+	builder.WriteString("uploads=")
+	builder.WriteString(fmt.Sprintf("%v", ds.Uploads))
+	builder.WriteString(", ")
+	builder.WriteString("upload_size=")
+	builder.WriteString(fmt.Sprintf("%v", ds.UploadSize))
+	builder.WriteString(", ")
+	builder.WriteString("downloads=")
+	builder.WriteString(fmt.Sprintf("%v", ds.Downloads))
+	builder.WriteString(", ")
+	builder.WriteString("download_size=")
+	builder.WriteString(fmt.Sprintf("%v", ds.DownloadSize))
+	builder.WriteString(", ")
+	builder.WriteString("file_types=")
+	builder.WriteString(fmt.Sprintf("%v", ds.FileTypes))
+	builder.WriteString(", ")
+	builder.WriteString("policy_storage=")
+	builder.WriteString(fmt.Sprintf("%v", ds.PolicyStorage))
+	builder.WriteString(", ")
+	builder.WriteString("group_storage=")
+	builder.WriteString(fmt.Sprintf("%v", ds.GroupStorage))
+	builder.WriteString(", ")
+	builder.WriteString("top_users=")
+	builder.WriteString(fmt.Sprintf("%v", ds.TopUsers))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DailyStats is a parsable slice of DailyStat.
+type DailyStats []*DailyStat
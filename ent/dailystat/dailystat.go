@@ -0,0 +1,152 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dailystat
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the dailystat type in the database.
+	Label = "daily_stat"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldDate holds the string denoting the date field in the database.
+	FieldDate = "date"
+	// FieldActiveUserCount holds the string denoting the active_user_count field in the database.
+	FieldActiveUserCount = "active_user_count"
+	// FieldUploads holds the string denoting the uploads field in the database.
+	FieldUploads = "uploads"
+	// FieldUploadSize holds the string denoting the upload_size field in the database.
+	FieldUploadSize = "upload_size"
+	// FieldDownloads holds the string denoting the downloads field in the database.
+	FieldDownloads = "downloads"
+	// FieldDownloadSize holds the string denoting the download_size field in the database.
+	FieldDownloadSize = "download_size"
+	// FieldFileTypes holds the string denoting the file_types field in the database.
+	FieldFileTypes = "file_types"
+	// FieldPolicyStorage holds the string denoting the policy_storage field in the database.
+	FieldPolicyStorage = "policy_storage"
+	// FieldGroupStorage holds the string denoting the group_storage field in the database.
+	FieldGroupStorage = "group_storage"
+	// FieldTopUsers holds the string denoting the top_users field in the database.
+	FieldTopUsers = "top_users"
+	// Table holds the table name of the dailystat in the database.
+	Table = "daily_stats"
+)
+
+// Columns holds all SQL columns for dailystat fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldDate,
+	FieldActiveUserCount,
+	FieldUploads,
+	FieldUploadSize,
+	FieldDownloads,
+	FieldDownloadSize,
+	FieldFileTypes,
+	FieldPolicyStorage,
+	FieldGroupStorage,
+	FieldTopUsers,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultActiveUserCount holds the default value on creation for the "active_user_count" field.
+	DefaultActiveUserCount int
+	// DefaultUploads holds the default value on creation for the "uploads" field.
+	DefaultUploads int
+	// DefaultUploadSize holds the default value on creation for the "upload_size" field.
+	DefaultUploadSize int64
+	// DefaultDownloads holds the default value on creation for the "downloads" field.
+	DefaultDownloads int
+	// DefaultDownloadSize holds the default value on creation for the "download_size" field.
+	DefaultDownloadSize int64
+)
+
+// OrderOption defines the ordering options for the DailyStat queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByDate orders the results by the date field.
+func ByDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDate, opts...).ToFunc()
+}
+
+// ByActiveUserCount orders the results by the active_user_count field.
+func ByActiveUserCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActiveUserCount, opts...).ToFunc()
+}
+
+// ByUploads orders the results by the uploads field.
+func ByUploads(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploads, opts...).ToFunc()
+}
+
+// ByUploadSize orders the results by the upload_size field.
+func ByUploadSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadSize, opts...).ToFunc()
+}
+
+// ByDownloads orders the results by the downloads field.
+func ByDownloads(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDownloads, opts...).ToFunc()
+}
+
+// ByDownloadSize orders the results by the download_size field.
+func ByDownloadSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDownloadSize, opts...).ToFunc()
+}
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dailystat
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v string) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldDate, v))
+}
+
+// ActiveUserCount applies equality check predicate on the "active_user_count" field. It's identical to ActiveUserCountEQ.
+func ActiveUserCount(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldActiveUserCount, v))
+}
+
+// Uploads applies equality check predicate on the "uploads" field. It's identical to UploadsEQ.
+func Uploads(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldUploads, v))
+}
+
+// UploadSize applies equality check predicate on the "upload_size" field. It's identical to UploadSizeEQ.
+func UploadSize(v int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldUploadSize, v))
+}
+
+// Downloads applies equality check predicate on the "downloads" field. It's identical to DownloadsEQ.
+func Downloads(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldDownloads, v))
+}
+
+// DownloadSize applies equality check predicate on the "download_size" field. It's identical to DownloadSizeEQ.
+func DownloadSize(v int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldDownloadSize, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v string) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v string) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...string) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...string) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v string) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v string) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v string) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v string) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLTE(FieldDate, v))
+}
+
+// DateContains applies the Contains predicate on the "date" field.
+func DateContains(v string) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldContains(FieldDate, v))
+}
+
+// DateHasPrefix applies the HasPrefix predicate on the "date" field.
+func DateHasPrefix(v string) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldHasPrefix(FieldDate, v))
+}
+
+// DateHasSuffix applies the HasSuffix predicate on the "date" field.
+func DateHasSuffix(v string) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldHasSuffix(FieldDate, v))
+}
+
+// DateEqualFold applies the EqualFold predicate on the "date" field.
+func DateEqualFold(v string) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEqualFold(FieldDate, v))
+}
+
+// DateContainsFold applies the ContainsFold predicate on the "date" field.
+func DateContainsFold(v string) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldContainsFold(FieldDate, v))
+}
+
+// ActiveUserCountEQ applies the EQ predicate on the "active_user_count" field.
+func ActiveUserCountEQ(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldActiveUserCount, v))
+}
+
+// ActiveUserCountNEQ applies the NEQ predicate on the "active_user_count" field.
+func ActiveUserCountNEQ(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNEQ(FieldActiveUserCount, v))
+}
+
+// ActiveUserCountIn applies the In predicate on the "active_user_count" field.
+func ActiveUserCountIn(vs ...int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldIn(FieldActiveUserCount, vs...))
+}
+
+// ActiveUserCountNotIn applies the NotIn predicate on the "active_user_count" field.
+func ActiveUserCountNotIn(vs ...int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNotIn(FieldActiveUserCount, vs...))
+}
+
+// ActiveUserCountGT applies the GT predicate on the "active_user_count" field.
+func ActiveUserCountGT(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGT(FieldActiveUserCount, v))
+}
+
+// ActiveUserCountGTE applies the GTE predicate on the "active_user_count" field.
+func ActiveUserCountGTE(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGTE(FieldActiveUserCount, v))
+}
+
+// ActiveUserCountLT applies the LT predicate on the "active_user_count" field.
+func ActiveUserCountLT(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLT(FieldActiveUserCount, v))
+}
+
+// ActiveUserCountLTE applies the LTE predicate on the "active_user_count" field.
+func ActiveUserCountLTE(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLTE(FieldActiveUserCount, v))
+}
+
+// UploadsEQ applies the EQ predicate on the "uploads" field.
+func UploadsEQ(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldUploads, v))
+}
+
+// UploadsNEQ applies the NEQ predicate on the "uploads" field.
+func UploadsNEQ(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNEQ(FieldUploads, v))
+}
+
+// UploadsIn applies the In predicate on the "uploads" field.
+func UploadsIn(vs ...int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldIn(FieldUploads, vs...))
+}
+
+// UploadsNotIn applies the NotIn predicate on the "uploads" field.
+func UploadsNotIn(vs ...int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNotIn(FieldUploads, vs...))
+}
+
+// UploadsGT applies the GT predicate on the "uploads" field.
+func UploadsGT(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGT(FieldUploads, v))
+}
+
+// UploadsGTE applies the GTE predicate on the "uploads" field.
+func UploadsGTE(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGTE(FieldUploads, v))
+}
+
+// UploadsLT applies the LT predicate on the "uploads" field.
+func UploadsLT(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLT(FieldUploads, v))
+}
+
+// UploadsLTE applies the LTE predicate on the "uploads" field.
+func UploadsLTE(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLTE(FieldUploads, v))
+}
+
+// UploadSizeEQ applies the EQ predicate on the "upload_size" field.
+func UploadSizeEQ(v int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldUploadSize, v))
+}
+
+// UploadSizeNEQ applies the NEQ predicate on the "upload_size" field.
+func UploadSizeNEQ(v int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNEQ(FieldUploadSize, v))
+}
+
+// UploadSizeIn applies the In predicate on the "upload_size" field.
+func UploadSizeIn(vs ...int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldIn(FieldUploadSize, vs...))
+}
+
+// UploadSizeNotIn applies the NotIn predicate on the "upload_size" field.
+func UploadSizeNotIn(vs ...int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNotIn(FieldUploadSize, vs...))
+}
+
+// UploadSizeGT applies the GT predicate on the "upload_size" field.
+func UploadSizeGT(v int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGT(FieldUploadSize, v))
+}
+
+// UploadSizeGTE applies the GTE predicate on the "upload_size" field.
+func UploadSizeGTE(v int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGTE(FieldUploadSize, v))
+}
+
+// UploadSizeLT applies the LT predicate on the "upload_size" field.
+func UploadSizeLT(v int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLT(FieldUploadSize, v))
+}
+
+// UploadSizeLTE applies the LTE predicate on the "upload_size" field.
+func UploadSizeLTE(v int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLTE(FieldUploadSize, v))
+}
+
+// DownloadsEQ applies the EQ predicate on the "downloads" field.
+func DownloadsEQ(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldDownloads, v))
+}
+
+// DownloadsNEQ applies the NEQ predicate on the "downloads" field.
+func DownloadsNEQ(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNEQ(FieldDownloads, v))
+}
+
+// DownloadsIn applies the In predicate on the "downloads" field.
+func DownloadsIn(vs ...int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldIn(FieldDownloads, vs...))
+}
+
+// DownloadsNotIn applies the NotIn predicate on the "downloads" field.
+func DownloadsNotIn(vs ...int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNotIn(FieldDownloads, vs...))
+}
+
+// DownloadsGT applies the GT predicate on the "downloads" field.
+func DownloadsGT(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGT(FieldDownloads, v))
+}
+
+// DownloadsGTE applies the GTE predicate on the "downloads" field.
+func DownloadsGTE(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGTE(FieldDownloads, v))
+}
+
+// DownloadsLT applies the LT predicate on the "downloads" field.
+func DownloadsLT(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLT(FieldDownloads, v))
+}
+
+// DownloadsLTE applies the LTE predicate on the "downloads" field.
+func DownloadsLTE(v int) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLTE(FieldDownloads, v))
+}
+
+// DownloadSizeEQ applies the EQ predicate on the "download_size" field.
+func DownloadSizeEQ(v int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldEQ(FieldDownloadSize, v))
+}
+
+// DownloadSizeNEQ applies the NEQ predicate on the "download_size" field.
+func DownloadSizeNEQ(v int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNEQ(FieldDownloadSize, v))
+}
+
+// DownloadSizeIn applies the In predicate on the "download_size" field.
+func DownloadSizeIn(vs ...int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldIn(FieldDownloadSize, vs...))
+}
+
+// DownloadSizeNotIn applies the NotIn predicate on the "download_size" field.
+func DownloadSizeNotIn(vs ...int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNotIn(FieldDownloadSize, vs...))
+}
+
+// DownloadSizeGT applies the GT predicate on the "download_size" field.
+func DownloadSizeGT(v int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGT(FieldDownloadSize, v))
+}
+
+// DownloadSizeGTE applies the GTE predicate on the "download_size" field.
+func DownloadSizeGTE(v int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldGTE(FieldDownloadSize, v))
+}
+
+// DownloadSizeLT applies the LT predicate on the "download_size" field.
+func DownloadSizeLT(v int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLT(FieldDownloadSize, v))
+}
+
+// DownloadSizeLTE applies the LTE predicate on the "download_size" field.
+func DownloadSizeLTE(v int64) predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldLTE(FieldDownloadSize, v))
+}
+
+// FileTypesIsNil applies the IsNil predicate on the "file_types" field.
+func FileTypesIsNil() predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldIsNull(FieldFileTypes))
+}
+
+// FileTypesNotNil applies the NotNil predicate on the "file_types" field.
+func FileTypesNotNil() predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNotNull(FieldFileTypes))
+}
+
+// PolicyStorageIsNil applies the IsNil predicate on the "policy_storage" field.
+func PolicyStorageIsNil() predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldIsNull(FieldPolicyStorage))
+}
+
+// PolicyStorageNotNil applies the NotNil predicate on the "policy_storage" field.
+func PolicyStorageNotNil() predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNotNull(FieldPolicyStorage))
+}
+
+// GroupStorageIsNil applies the IsNil predicate on the "group_storage" field.
+func GroupStorageIsNil() predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldIsNull(FieldGroupStorage))
+}
+
+// GroupStorageNotNil applies the NotNil predicate on the "group_storage" field.
+func GroupStorageNotNil() predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNotNull(FieldGroupStorage))
+}
+
+// TopUsersIsNil applies the IsNil predicate on the "top_users" field.
+func TopUsersIsNil() predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldIsNull(FieldTopUsers))
+}
+
+// TopUsersNotNil applies the NotNil predicate on the "top_users" field.
+func TopUsersNotNil() predicate.DailyStat {
+	return predicate.DailyStat(sql.FieldNotNull(FieldTopUsers))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DailyStat) predicate.DailyStat {
+	return predicate.DailyStat(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DailyStat) predicate.DailyStat {
+	return predicate.DailyStat(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DailyStat) predicate.DailyStat {
+	return predicate.DailyStat(sql.NotPredicates(p))
+}
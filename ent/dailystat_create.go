@@ -0,0 +1,1368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/dailystat"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// DailyStatCreate is the builder for creating a DailyStat entity.
+type DailyStatCreate struct {
+	config
+	mutation *DailyStatMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dsc *DailyStatCreate) SetCreatedAt(t time.Time) *DailyStatCreate {
+	dsc.mutation.SetCreatedAt(t)
+	return dsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dsc *DailyStatCreate) SetNillableCreatedAt(t *time.Time) *DailyStatCreate {
+	if t != nil {
+		dsc.SetCreatedAt(*t)
+	}
+	return dsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dsc *DailyStatCreate) SetUpdatedAt(t time.Time) *DailyStatCreate {
+	dsc.mutation.SetUpdatedAt(t)
+	return dsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dsc *DailyStatCreate) SetNillableUpdatedAt(t *time.Time) *DailyStatCreate {
+	if t != nil {
+		dsc.SetUpdatedAt(*t)
+	}
+	return dsc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dsc *DailyStatCreate) SetDeletedAt(t time.Time) *DailyStatCreate {
+	dsc.mutation.SetDeletedAt(t)
+	return dsc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dsc *DailyStatCreate) SetNillableDeletedAt(t *time.Time) *DailyStatCreate {
+	if t != nil {
+		dsc.SetDeletedAt(*t)
+	}
+	return dsc
+}
+
+// SetDate sets the "date" field.
+func (dsc *DailyStatCreate) SetDate(s string) *DailyStatCreate {
+	dsc.mutation.SetDate(s)
+	return dsc
+}
+
+// SetActiveUserCount sets the "active_user_count" field.
+func (dsc *DailyStatCreate) SetActiveUserCount(i int) *DailyStatCreate {
+	dsc.mutation.SetActiveUserCount(i)
+	return dsc
+}
+
+// SetNillableActiveUserCount sets the "active_user_count" field if the given value is not nil.
+func (dsc *DailyStatCreate) SetNillableActiveUserCount(i *int) *DailyStatCreate {
+	if i != nil {
+		dsc.SetActiveUserCount(*i)
+	}
+	return dsc
+}
+
+// SetUploads sets the "uploads" field.
+func (dsc *DailyStatCreate) SetUploads(i int) *DailyStatCreate {
+	dsc.mutation.SetUploads(i)
+	return dsc
+}
+
+// SetNillableUploads sets the "uploads" field if the given value is not nil.
+func (dsc *DailyStatCreate) SetNillableUploads(i *int) *DailyStatCreate {
+	if i != nil {
+		dsc.SetUploads(*i)
+	}
+	return dsc
+}
+
+// SetUploadSize sets the "upload_size" field.
+func (dsc *DailyStatCreate) SetUploadSize(i int64) *DailyStatCreate {
+	dsc.mutation.SetUploadSize(i)
+	return dsc
+}
+
+// SetNillableUploadSize sets the "upload_size" field if the given value is not nil.
+func (dsc *DailyStatCreate) SetNillableUploadSize(i *int64) *DailyStatCreate {
+	if i != nil {
+		dsc.SetUploadSize(*i)
+	}
+	return dsc
+}
+
+// SetDownloads sets the "downloads" field.
+func (dsc *DailyStatCreate) SetDownloads(i int) *DailyStatCreate {
+	dsc.mutation.SetDownloads(i)
+	return dsc
+}
+
+// SetNillableDownloads sets the "downloads" field if the given value is not nil.
+func (dsc *DailyStatCreate) SetNillableDownloads(i *int) *DailyStatCreate {
+	if i != nil {
+		dsc.SetDownloads(*i)
+	}
+	return dsc
+}
+
+// SetDownloadSize sets the "download_size" field.
+func (dsc *DailyStatCreate) SetDownloadSize(i int64) *DailyStatCreate {
+	dsc.mutation.SetDownloadSize(i)
+	return dsc
+}
+
+// SetNillableDownloadSize sets the "download_size" field if the given value is not nil.
+func (dsc *DailyStatCreate) SetNillableDownloadSize(i *int64) *DailyStatCreate {
+	if i != nil {
+		dsc.SetDownloadSize(*i)
+	}
+	return dsc
+}
+
+// SetFileTypes sets the "file_types" field.
+func (dsc *DailyStatCreate) SetFileTypes(mft map[string]*types.StatsFileType) *DailyStatCreate {
+	dsc.mutation.SetFileTypes(mft)
+	return dsc
+}
+
+// SetPolicyStorage sets the "policy_storage" field.
+func (dsc *DailyStatCreate) SetPolicyStorage(m map[int]int64) *DailyStatCreate {
+	dsc.mutation.SetPolicyStorage(m)
+	return dsc
+}
+
+// SetGroupStorage sets the "group_storage" field.
+func (dsc *DailyStatCreate) SetGroupStorage(m map[int]int64) *DailyStatCreate {
+	dsc.mutation.SetGroupStorage(m)
+	return dsc
+}
+
+// SetTopUsers sets the "top_users" field.
+func (dsc *DailyStatCreate) SetTopUsers(tus []types.StatsUserStorage) *DailyStatCreate {
+	dsc.mutation.SetTopUsers(tus)
+	return dsc
+}
+
+// Mutation returns the DailyStatMutation object of the builder.
+func (dsc *DailyStatCreate) Mutation() *DailyStatMutation {
+	return dsc.mutation
+}
+
+// Save creates the DailyStat in the database.
+func (dsc *DailyStatCreate) Save(ctx context.Context) (*DailyStat, error) {
+	if err := dsc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, dsc.sqlSave, dsc.mutation, dsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dsc *DailyStatCreate) SaveX(ctx context.Context) *DailyStat {
+	v, err := dsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dsc *DailyStatCreate) Exec(ctx context.Context) error {
+	_, err := dsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsc *DailyStatCreate) ExecX(ctx context.Context) {
+	if err := dsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dsc *DailyStatCreate) defaults() error {
+	if _, ok := dsc.mutation.CreatedAt(); !ok {
+		if dailystat.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dailystat.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := dailystat.DefaultCreatedAt()
+		dsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dsc.mutation.UpdatedAt(); !ok {
+		if dailystat.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dailystat.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := dailystat.DefaultUpdatedAt()
+		dsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dsc.mutation.ActiveUserCount(); !ok {
+		v := dailystat.DefaultActiveUserCount
+		dsc.mutation.SetActiveUserCount(v)
+	}
+	if _, ok := dsc.mutation.Uploads(); !ok {
+		v := dailystat.DefaultUploads
+		dsc.mutation.SetUploads(v)
+	}
+	if _, ok := dsc.mutation.UploadSize(); !ok {
+		v := dailystat.DefaultUploadSize
+		dsc.mutation.SetUploadSize(v)
+	}
+	if _, ok := dsc.mutation.Downloads(); !ok {
+		v := dailystat.DefaultDownloads
+		dsc.mutation.SetDownloads(v)
+	}
+	if _, ok := dsc.mutation.DownloadSize(); !ok {
+		v := dailystat.DefaultDownloadSize
+		dsc.mutation.SetDownloadSize(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dsc *DailyStatCreate) check() error {
+	if _, ok := dsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DailyStat.created_at"`)}
+	}
+	if _, ok := dsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DailyStat.updated_at"`)}
+	}
+	if _, ok := dsc.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "DailyStat.date"`)}
+	}
+	if _, ok := dsc.mutation.ActiveUserCount(); !ok {
+		return &ValidationError{Name: "active_user_count", err: errors.New(`ent: missing required field "DailyStat.active_user_count"`)}
+	}
+	if _, ok := dsc.mutation.Uploads(); !ok {
+		return &ValidationError{Name: "uploads", err: errors.New(`ent: missing required field "DailyStat.uploads"`)}
+	}
+	if _, ok := dsc.mutation.UploadSize(); !ok {
+		return &ValidationError{Name: "upload_size", err: errors.New(`ent: missing required field "DailyStat.upload_size"`)}
+	}
+	if _, ok := dsc.mutation.Downloads(); !ok {
+		return &ValidationError{Name: "downloads", err: errors.New(`ent: missing required field "DailyStat.downloads"`)}
+	}
+	if _, ok := dsc.mutation.DownloadSize(); !ok {
+		return &ValidationError{Name: "download_size", err: errors.New(`ent: missing required field "DailyStat.download_size"`)}
+	}
+	return nil
+}
+
+func (dsc *DailyStatCreate) sqlSave(ctx context.Context) (*DailyStat, error) {
+	if err := dsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dsc.mutation.id = &_node.ID
+	dsc.mutation.done = true
+	return _node, nil
+}
+
+func (dsc *DailyStatCreate) createSpec() (*DailyStat, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DailyStat{config: dsc.config}
+		_spec = sqlgraph.NewCreateSpec(dailystat.Table, sqlgraph.NewFieldSpec(dailystat.FieldID, field.TypeInt))
+	)
+
+	if id, ok := dsc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = dsc.conflict
+	if value, ok := dsc.mutation.CreatedAt(); ok {
+		_spec.SetField(dailystat.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(dailystat.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := dsc.mutation.DeletedAt(); ok {
+		_spec.SetField(dailystat.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := dsc.mutation.Date(); ok {
+		_spec.SetField(dailystat.FieldDate, field.TypeString, value)
+		_node.Date = value
+	}
+	if value, ok := dsc.mutation.ActiveUserCount(); ok {
+		_spec.SetField(dailystat.FieldActiveUserCount, field.TypeInt, value)
+		_node.ActiveUserCount = value
+	}
+	if value, ok := dsc.mutation.Uploads(); ok {
+		_spec.SetField(dailystat.FieldUploads, field.TypeInt, value)
+		_node.Uploads = value
+	}
+	if value, ok := dsc.mutation.UploadSize(); ok {
+		_spec.SetField(dailystat.FieldUploadSize, field.TypeInt64, value)
+		_node.UploadSize = value
+	}
+	if value, ok := dsc.mutation.Downloads(); ok {
+		_spec.SetField(dailystat.FieldDownloads, field.TypeInt, value)
+		_node.Downloads = value
+	}
+	if value, ok := dsc.mutation.DownloadSize(); ok {
+		_spec.SetField(dailystat.FieldDownloadSize, field.TypeInt64, value)
+		_node.DownloadSize = value
+	}
+	if value, ok := dsc.mutation.FileTypes(); ok {
+		_spec.SetField(dailystat.FieldFileTypes, field.TypeJSON, value)
+		_node.FileTypes = value
+	}
+	if value, ok := dsc.mutation.PolicyStorage(); ok {
+		_spec.SetField(dailystat.FieldPolicyStorage, field.TypeJSON, value)
+		_node.PolicyStorage = value
+	}
+	if value, ok := dsc.mutation.GroupStorage(); ok {
+		_spec.SetField(dailystat.FieldGroupStorage, field.TypeJSON, value)
+		_node.GroupStorage = value
+	}
+	if value, ok := dsc.mutation.TopUsers(); ok {
+		_spec.SetField(dailystat.FieldTopUsers, field.TypeJSON, value)
+		_node.TopUsers = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DailyStat.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DailyStatUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dsc *DailyStatCreate) OnConflict(opts ...sql.ConflictOption) *DailyStatUpsertOne {
+	dsc.conflict = opts
+	return &DailyStatUpsertOne{
+		create: dsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DailyStat.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dsc *DailyStatCreate) OnConflictColumns(columns ...string) *DailyStatUpsertOne {
+	dsc.conflict = append(dsc.conflict, sql.ConflictColumns(columns...))
+	return &DailyStatUpsertOne{
+		create: dsc,
+	}
+}
+
+type (
+	// DailyStatUpsertOne is the builder for "upsert"-ing
+	//  one DailyStat node.
+	DailyStatUpsertOne struct {
+		create *DailyStatCreate
+	}
+
+	// DailyStatUpsert is the "OnConflict" setter.
+	DailyStatUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DailyStatUpsert) SetUpdatedAt(v time.Time) *DailyStatUpsert {
+	u.Set(dailystat.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DailyStatUpsert) UpdateUpdatedAt() *DailyStatUpsert {
+	u.SetExcluded(dailystat.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *DailyStatUpsert) SetDeletedAt(v time.Time) *DailyStatUpsert {
+	u.Set(dailystat.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *DailyStatUpsert) UpdateDeletedAt() *DailyStatUpsert {
+	u.SetExcluded(dailystat.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *DailyStatUpsert) ClearDeletedAt() *DailyStatUpsert {
+	u.SetNull(dailystat.FieldDeletedAt)
+	return u
+}
+
+// SetDate sets the "date" field.
+func (u *DailyStatUpsert) SetDate(v string) *DailyStatUpsert {
+	u.Set(dailystat.FieldDate, v)
+	return u
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *DailyStatUpsert) UpdateDate() *DailyStatUpsert {
+	u.SetExcluded(dailystat.FieldDate)
+	return u
+}
+
+// SetActiveUserCount sets the "active_user_count" field.
+func (u *DailyStatUpsert) SetActiveUserCount(v int) *DailyStatUpsert {
+	u.Set(dailystat.FieldActiveUserCount, v)
+	return u
+}
+
+// UpdateActiveUserCount sets the "active_user_count" field to the value that was provided on create.
+func (u *DailyStatUpsert) UpdateActiveUserCount() *DailyStatUpsert {
+	u.SetExcluded(dailystat.FieldActiveUserCount)
+	return u
+}
+
+// AddActiveUserCount adds v to the "active_user_count" field.
+func (u *DailyStatUpsert) AddActiveUserCount(v int) *DailyStatUpsert {
+	u.Add(dailystat.FieldActiveUserCount, v)
+	return u
+}
+
+// SetUploads sets the "uploads" field.
+func (u *DailyStatUpsert) SetUploads(v int) *DailyStatUpsert {
+	u.Set(dailystat.FieldUploads, v)
+	return u
+}
+
+// UpdateUploads sets the "uploads" field to the value that was provided on create.
+func (u *DailyStatUpsert) UpdateUploads() *DailyStatUpsert {
+	u.SetExcluded(dailystat.FieldUploads)
+	return u
+}
+
+// AddUploads adds v to the "uploads" field.
+func (u *DailyStatUpsert) AddUploads(v int) *DailyStatUpsert {
+	u.Add(dailystat.FieldUploads, v)
+	return u
+}
+
+// SetUploadSize sets the "upload_size" field.
+func (u *DailyStatUpsert) SetUploadSize(v int64) *DailyStatUpsert {
+	u.Set(dailystat.FieldUploadSize, v)
+	return u
+}
+
+// UpdateUploadSize sets the "upload_size" field to the value that was provided on create.
+func (u *DailyStatUpsert) UpdateUploadSize() *DailyStatUpsert {
+	u.SetExcluded(dailystat.FieldUploadSize)
+	return u
+}
+
+// AddUploadSize adds v to the "upload_size" field.
+func (u *DailyStatUpsert) AddUploadSize(v int64) *DailyStatUpsert {
+	u.Add(dailystat.FieldUploadSize, v)
+	return u
+}
+
+// SetDownloads sets the "downloads" field.
+func (u *DailyStatUpsert) SetDownloads(v int) *DailyStatUpsert {
+	u.Set(dailystat.FieldDownloads, v)
+	return u
+}
+
+// UpdateDownloads sets the "downloads" field to the value that was provided on create.
+func (u *DailyStatUpsert) UpdateDownloads() *DailyStatUpsert {
+	u.SetExcluded(dailystat.FieldDownloads)
+	return u
+}
+
+// AddDownloads adds v to the "downloads" field.
+func (u *DailyStatUpsert) AddDownloads(v int) *DailyStatUpsert {
+	u.Add(dailystat.FieldDownloads, v)
+	return u
+}
+
+// SetDownloadSize sets the "download_size" field.
+func (u *DailyStatUpsert) SetDownloadSize(v int64) *DailyStatUpsert {
+	u.Set(dailystat.FieldDownloadSize, v)
+	return u
+}
+
+// UpdateDownloadSize sets the "download_size" field to the value that was provided on create.
+func (u *DailyStatUpsert) UpdateDownloadSize() *DailyStatUpsert {
+	u.SetExcluded(dailystat.FieldDownloadSize)
+	return u
+}
+
+// AddDownloadSize adds v to the "download_size" field.
+func (u *DailyStatUpsert) AddDownloadSize(v int64) *DailyStatUpsert {
+	u.Add(dailystat.FieldDownloadSize, v)
+	return u
+}
+
+// SetFileTypes sets the "file_types" field.
+func (u *DailyStatUpsert) SetFileTypes(v map[string]*types.StatsFileType) *DailyStatUpsert {
+	u.Set(dailystat.FieldFileTypes, v)
+	return u
+}
+
+// UpdateFileTypes sets the "file_types" field to the value that was provided on create.
+func (u *DailyStatUpsert) UpdateFileTypes() *DailyStatUpsert {
+	u.SetExcluded(dailystat.FieldFileTypes)
+	return u
+}
+
+// ClearFileTypes clears the value of the "file_types" field.
+func (u *DailyStatUpsert) ClearFileTypes() *DailyStatUpsert {
+	u.SetNull(dailystat.FieldFileTypes)
+	return u
+}
+
+// SetPolicyStorage sets the "policy_storage" field.
+func (u *DailyStatUpsert) SetPolicyStorage(v map[int]int64) *DailyStatUpsert {
+	u.Set(dailystat.FieldPolicyStorage, v)
+	return u
+}
+
+// UpdatePolicyStorage sets the "policy_storage" field to the value that was provided on create.
+func (u *DailyStatUpsert) UpdatePolicyStorage() *DailyStatUpsert {
+	u.SetExcluded(dailystat.FieldPolicyStorage)
+	return u
+}
+
+// ClearPolicyStorage clears the value of the "policy_storage" field.
+func (u *DailyStatUpsert) ClearPolicyStorage() *DailyStatUpsert {
+	u.SetNull(dailystat.FieldPolicyStorage)
+	return u
+}
+
+// SetGroupStorage sets the "group_storage" field.
+func (u *DailyStatUpsert) SetGroupStorage(v map[int]int64) *DailyStatUpsert {
+	u.Set(dailystat.FieldGroupStorage, v)
+	return u
+}
+
+// UpdateGroupStorage sets the "group_storage" field to the value that was provided on create.
+func (u *DailyStatUpsert) UpdateGroupStorage() *DailyStatUpsert {
+	u.SetExcluded(dailystat.FieldGroupStorage)
+	return u
+}
+
+// ClearGroupStorage clears the value of the "group_storage" field.
+func (u *DailyStatUpsert) ClearGroupStorage() *DailyStatUpsert {
+	u.SetNull(dailystat.FieldGroupStorage)
+	return u
+}
+
+// SetTopUsers sets the "top_users" field.
+func (u *DailyStatUpsert) SetTopUsers(v []types.StatsUserStorage) *DailyStatUpsert {
+	u.Set(dailystat.FieldTopUsers, v)
+	return u
+}
+
+// UpdateTopUsers sets the "top_users" field to the value that was provided on create.
+func (u *DailyStatUpsert) UpdateTopUsers() *DailyStatUpsert {
+	u.SetExcluded(dailystat.FieldTopUsers)
+	return u
+}
+
+// ClearTopUsers clears the value of the "top_users" field.
+func (u *DailyStatUpsert) ClearTopUsers() *DailyStatUpsert {
+	u.SetNull(dailystat.FieldTopUsers)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.DailyStat.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DailyStatUpsertOne) UpdateNewValues() *DailyStatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(dailystat.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DailyStat.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DailyStatUpsertOne) Ignore() *DailyStatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DailyStatUpsertOne) DoNothing() *DailyStatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DailyStatCreate.OnConflict
+// documentation for more info.
+func (u *DailyStatUpsertOne) Update(set func(*DailyStatUpsert)) *DailyStatUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DailyStatUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DailyStatUpsertOne) SetUpdatedAt(v time.Time) *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DailyStatUpsertOne) UpdateUpdatedAt() *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *DailyStatUpsertOne) SetDeletedAt(v time.Time) *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *DailyStatUpsertOne) UpdateDeletedAt() *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *DailyStatUpsertOne) ClearDeletedAt() *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *DailyStatUpsertOne) SetDate(v string) *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *DailyStatUpsertOne) UpdateDate() *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetActiveUserCount sets the "active_user_count" field.
+func (u *DailyStatUpsertOne) SetActiveUserCount(v int) *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetActiveUserCount(v)
+	})
+}
+
+// AddActiveUserCount adds v to the "active_user_count" field.
+func (u *DailyStatUpsertOne) AddActiveUserCount(v int) *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.AddActiveUserCount(v)
+	})
+}
+
+// UpdateActiveUserCount sets the "active_user_count" field to the value that was provided on create.
+func (u *DailyStatUpsertOne) UpdateActiveUserCount() *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateActiveUserCount()
+	})
+}
+
+// SetUploads sets the "uploads" field.
+func (u *DailyStatUpsertOne) SetUploads(v int) *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetUploads(v)
+	})
+}
+
+// AddUploads adds v to the "uploads" field.
+func (u *DailyStatUpsertOne) AddUploads(v int) *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.AddUploads(v)
+	})
+}
+
+// UpdateUploads sets the "uploads" field to the value that was provided on create.
+func (u *DailyStatUpsertOne) UpdateUploads() *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateUploads()
+	})
+}
+
+// SetUploadSize sets the "upload_size" field.
+func (u *DailyStatUpsertOne) SetUploadSize(v int64) *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetUploadSize(v)
+	})
+}
+
+// AddUploadSize adds v to the "upload_size" field.
+func (u *DailyStatUpsertOne) AddUploadSize(v int64) *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.AddUploadSize(v)
+	})
+}
+
+// UpdateUploadSize sets the "upload_size" field to the value that was provided on create.
+func (u *DailyStatUpsertOne) UpdateUploadSize() *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateUploadSize()
+	})
+}
+
+// SetDownloads sets the "downloads" field.
+func (u *DailyStatUpsertOne) SetDownloads(v int) *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetDownloads(v)
+	})
+}
+
+// AddDownloads adds v to the "downloads" field.
+func (u *DailyStatUpsertOne) AddDownloads(v int) *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.AddDownloads(v)
+	})
+}
+
+// UpdateDownloads sets the "downloads" field to the value that was provided on create.
+func (u *DailyStatUpsertOne) UpdateDownloads() *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateDownloads()
+	})
+}
+
+// SetDownloadSize sets the "download_size" field.
+func (u *DailyStatUpsertOne) SetDownloadSize(v int64) *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetDownloadSize(v)
+	})
+}
+
+// AddDownloadSize adds v to the "download_size" field.
+func (u *DailyStatUpsertOne) AddDownloadSize(v int64) *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.AddDownloadSize(v)
+	})
+}
+
+// UpdateDownloadSize sets the "download_size" field to the value that was provided on create.
+func (u *DailyStatUpsertOne) UpdateDownloadSize() *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateDownloadSize()
+	})
+}
+
+// SetFileTypes sets the "file_types" field.
+func (u *DailyStatUpsertOne) SetFileTypes(v map[string]*types.StatsFileType) *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetFileTypes(v)
+	})
+}
+
+// UpdateFileTypes sets the "file_types" field to the value that was provided on create.
+func (u *DailyStatUpsertOne) UpdateFileTypes() *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateFileTypes()
+	})
+}
+
+// ClearFileTypes clears the value of the "file_types" field.
+func (u *DailyStatUpsertOne) ClearFileTypes() *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.ClearFileTypes()
+	})
+}
+
+// SetPolicyStorage sets the "policy_storage" field.
+func (u *DailyStatUpsertOne) SetPolicyStorage(v map[int]int64) *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetPolicyStorage(v)
+	})
+}
+
+// UpdatePolicyStorage sets the "policy_storage" field to the value that was provided on create.
+func (u *DailyStatUpsertOne) UpdatePolicyStorage() *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdatePolicyStorage()
+	})
+}
+
+// ClearPolicyStorage clears the value of the "policy_storage" field.
+func (u *DailyStatUpsertOne) ClearPolicyStorage() *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.ClearPolicyStorage()
+	})
+}
+
+// SetGroupStorage sets the "group_storage" field.
+func (u *DailyStatUpsertOne) SetGroupStorage(v map[int]int64) *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetGroupStorage(v)
+	})
+}
+
+// UpdateGroupStorage sets the "group_storage" field to the value that was provided on create.
+func (u *DailyStatUpsertOne) UpdateGroupStorage() *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateGroupStorage()
+	})
+}
+
+// ClearGroupStorage clears the value of the "group_storage" field.
+func (u *DailyStatUpsertOne) ClearGroupStorage() *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.ClearGroupStorage()
+	})
+}
+
+// SetTopUsers sets the "top_users" field.
+func (u *DailyStatUpsertOne) SetTopUsers(v []types.StatsUserStorage) *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetTopUsers(v)
+	})
+}
+
+// UpdateTopUsers sets the "top_users" field to the value that was provided on create.
+func (u *DailyStatUpsertOne) UpdateTopUsers() *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateTopUsers()
+	})
+}
+
+// ClearTopUsers clears the value of the "top_users" field.
+func (u *DailyStatUpsertOne) ClearTopUsers() *DailyStatUpsertOne {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.ClearTopUsers()
+	})
+}
+
+// Exec executes the query.
+func (u *DailyStatUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DailyStatCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DailyStatUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DailyStatUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DailyStatUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *DailyStatCreate) SetRawID(t int) *DailyStatCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// DailyStatCreateBulk is the builder for creating many DailyStat entities in bulk.
+type DailyStatCreateBulk struct {
+	config
+	err      error
+	builders []*DailyStatCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DailyStat entities in the database.
+func (dscb *DailyStatCreateBulk) Save(ctx context.Context) ([]*DailyStat, error) {
+	if dscb.err != nil {
+		return nil, dscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dscb.builders))
+	nodes := make([]*DailyStat, len(dscb.builders))
+	mutators := make([]Mutator, len(dscb.builders))
+	for i := range dscb.builders {
+		func(i int, root context.Context) {
+			builder := dscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DailyStatMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dscb *DailyStatCreateBulk) SaveX(ctx context.Context) []*DailyStat {
+	v, err := dscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dscb *DailyStatCreateBulk) Exec(ctx context.Context) error {
+	_, err := dscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dscb *DailyStatCreateBulk) ExecX(ctx context.Context) {
+	if err := dscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DailyStat.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DailyStatUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (dscb *DailyStatCreateBulk) OnConflict(opts ...sql.ConflictOption) *DailyStatUpsertBulk {
+	dscb.conflict = opts
+	return &DailyStatUpsertBulk{
+		create: dscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DailyStat.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dscb *DailyStatCreateBulk) OnConflictColumns(columns ...string) *DailyStatUpsertBulk {
+	dscb.conflict = append(dscb.conflict, sql.ConflictColumns(columns...))
+	return &DailyStatUpsertBulk{
+		create: dscb,
+	}
+}
+
+// DailyStatUpsertBulk is the builder for "upsert"-ing
+// a bulk of DailyStat nodes.
+type DailyStatUpsertBulk struct {
+	create *DailyStatCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DailyStat.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DailyStatUpsertBulk) UpdateNewValues() *DailyStatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(dailystat.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DailyStat.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DailyStatUpsertBulk) Ignore() *DailyStatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DailyStatUpsertBulk) DoNothing() *DailyStatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DailyStatCreateBulk.OnConflict
+// documentation for more info.
+func (u *DailyStatUpsertBulk) Update(set func(*DailyStatUpsert)) *DailyStatUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DailyStatUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DailyStatUpsertBulk) SetUpdatedAt(v time.Time) *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DailyStatUpsertBulk) UpdateUpdatedAt() *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *DailyStatUpsertBulk) SetDeletedAt(v time.Time) *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *DailyStatUpsertBulk) UpdateDeletedAt() *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *DailyStatUpsertBulk) ClearDeletedAt() *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetDate sets the "date" field.
+func (u *DailyStatUpsertBulk) SetDate(v string) *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetDate(v)
+	})
+}
+
+// UpdateDate sets the "date" field to the value that was provided on create.
+func (u *DailyStatUpsertBulk) UpdateDate() *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateDate()
+	})
+}
+
+// SetActiveUserCount sets the "active_user_count" field.
+func (u *DailyStatUpsertBulk) SetActiveUserCount(v int) *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetActiveUserCount(v)
+	})
+}
+
+// AddActiveUserCount adds v to the "active_user_count" field.
+func (u *DailyStatUpsertBulk) AddActiveUserCount(v int) *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.AddActiveUserCount(v)
+	})
+}
+
+// UpdateActiveUserCount sets the "active_user_count" field to the value that was provided on create.
+func (u *DailyStatUpsertBulk) UpdateActiveUserCount() *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateActiveUserCount()
+	})
+}
+
+// SetUploads sets the "uploads" field.
+func (u *DailyStatUpsertBulk) SetUploads(v int) *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetUploads(v)
+	})
+}
+
+// AddUploads adds v to the "uploads" field.
+func (u *DailyStatUpsertBulk) AddUploads(v int) *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.AddUploads(v)
+	})
+}
+
+// UpdateUploads sets the "uploads" field to the value that was provided on create.
+func (u *DailyStatUpsertBulk) UpdateUploads() *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateUploads()
+	})
+}
+
+// SetUploadSize sets the "upload_size" field.
+func (u *DailyStatUpsertBulk) SetUploadSize(v int64) *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetUploadSize(v)
+	})
+}
+
+// AddUploadSize adds v to the "upload_size" field.
+func (u *DailyStatUpsertBulk) AddUploadSize(v int64) *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.AddUploadSize(v)
+	})
+}
+
+// UpdateUploadSize sets the "upload_size" field to the value that was provided on create.
+func (u *DailyStatUpsertBulk) UpdateUploadSize() *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateUploadSize()
+	})
+}
+
+// SetDownloads sets the "downloads" field.
+func (u *DailyStatUpsertBulk) SetDownloads(v int) *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetDownloads(v)
+	})
+}
+
+// AddDownloads adds v to the "downloads" field.
+func (u *DailyStatUpsertBulk) AddDownloads(v int) *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.AddDownloads(v)
+	})
+}
+
+// UpdateDownloads sets the "downloads" field to the value that was provided on create.
+func (u *DailyStatUpsertBulk) UpdateDownloads() *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateDownloads()
+	})
+}
+
+// SetDownloadSize sets the "download_size" field.
+func (u *DailyStatUpsertBulk) SetDownloadSize(v int64) *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetDownloadSize(v)
+	})
+}
+
+// AddDownloadSize adds v to the "download_size" field.
+func (u *DailyStatUpsertBulk) AddDownloadSize(v int64) *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.AddDownloadSize(v)
+	})
+}
+
+// UpdateDownloadSize sets the "download_size" field to the value that was provided on create.
+func (u *DailyStatUpsertBulk) UpdateDownloadSize() *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateDownloadSize()
+	})
+}
+
+// SetFileTypes sets the "file_types" field.
+func (u *DailyStatUpsertBulk) SetFileTypes(v map[string]*types.StatsFileType) *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetFileTypes(v)
+	})
+}
+
+// UpdateFileTypes sets the "file_types" field to the value that was provided on create.
+func (u *DailyStatUpsertBulk) UpdateFileTypes() *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateFileTypes()
+	})
+}
+
+// ClearFileTypes clears the value of the "file_types" field.
+func (u *DailyStatUpsertBulk) ClearFileTypes() *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.ClearFileTypes()
+	})
+}
+
+// SetPolicyStorage sets the "policy_storage" field.
+func (u *DailyStatUpsertBulk) SetPolicyStorage(v map[int]int64) *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetPolicyStorage(v)
+	})
+}
+
+// UpdatePolicyStorage sets the "policy_storage" field to the value that was provided on create.
+func (u *DailyStatUpsertBulk) UpdatePolicyStorage() *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdatePolicyStorage()
+	})
+}
+
+// ClearPolicyStorage clears the value of the "policy_storage" field.
+func (u *DailyStatUpsertBulk) ClearPolicyStorage() *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.ClearPolicyStorage()
+	})
+}
+
+// SetGroupStorage sets the "group_storage" field.
+func (u *DailyStatUpsertBulk) SetGroupStorage(v map[int]int64) *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetGroupStorage(v)
+	})
+}
+
+// UpdateGroupStorage sets the "group_storage" field to the value that was provided on create.
+func (u *DailyStatUpsertBulk) UpdateGroupStorage() *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateGroupStorage()
+	})
+}
+
+// ClearGroupStorage clears the value of the "group_storage" field.
+func (u *DailyStatUpsertBulk) ClearGroupStorage() *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.ClearGroupStorage()
+	})
+}
+
+// SetTopUsers sets the "top_users" field.
+func (u *DailyStatUpsertBulk) SetTopUsers(v []types.StatsUserStorage) *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.SetTopUsers(v)
+	})
+}
+
+// UpdateTopUsers sets the "top_users" field to the value that was provided on create.
+func (u *DailyStatUpsertBulk) UpdateTopUsers() *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.UpdateTopUsers()
+	})
+}
+
+// ClearTopUsers clears the value of the "top_users" field.
+func (u *DailyStatUpsertBulk) ClearTopUsers() *DailyStatUpsertBulk {
+	return u.Update(func(s *DailyStatUpsert) {
+		s.ClearTopUsers()
+	})
+}
+
+// Exec executes the query.
+func (u *DailyStatUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DailyStatCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DailyStatCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DailyStatUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
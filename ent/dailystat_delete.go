@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/dailystat"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// DailyStatDelete is the builder for deleting a DailyStat entity.
+type DailyStatDelete struct {
+	config
+	hooks    []Hook
+	mutation *DailyStatMutation
+}
+
+// Where appends a list predicates to the DailyStatDelete builder.
+func (dsd *DailyStatDelete) Where(ps ...predicate.DailyStat) *DailyStatDelete {
+	dsd.mutation.Where(ps...)
+	return dsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dsd *DailyStatDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dsd.sqlExec, dsd.mutation, dsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsd *DailyStatDelete) ExecX(ctx context.Context) int {
+	n, err := dsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dsd *DailyStatDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(dailystat.Table, sqlgraph.NewFieldSpec(dailystat.FieldID, field.TypeInt))
+	if ps := dsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dsd.mutation.done = true
+	return affected, err
+}
+
+// DailyStatDeleteOne is the builder for deleting a single DailyStat entity.
+type DailyStatDeleteOne struct {
+	dsd *DailyStatDelete
+}
+
+// Where appends a list predicates to the DailyStatDelete builder.
+func (dsdo *DailyStatDeleteOne) Where(ps ...predicate.DailyStat) *DailyStatDeleteOne {
+	dsdo.dsd.mutation.Where(ps...)
+	return dsdo
+}
+
+// Exec executes the deletion query.
+func (dsdo *DailyStatDeleteOne) Exec(ctx context.Context) error {
+	n, err := dsdo.dsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{dailystat.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsdo *DailyStatDeleteOne) ExecX(ctx context.Context) {
+	if err := dsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
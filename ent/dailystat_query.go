@@ -0,0 +1,539 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/dailystat"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// DailyStatQuery is the builder for querying DailyStat entities.
+type DailyStatQuery struct {
+	config
+	ctx        *QueryContext
+	order      []dailystat.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DailyStat
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*DailyStat) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DailyStatQuery builder.
+func (dsq *DailyStatQuery) Where(ps ...predicate.DailyStat) *DailyStatQuery {
+	dsq.predicates = append(dsq.predicates, ps...)
+	return dsq
+}
+
+// Limit the number of records to be returned by this query.
+func (dsq *DailyStatQuery) Limit(limit int) *DailyStatQuery {
+	dsq.ctx.Limit = &limit
+	return dsq
+}
+
+// Offset to start from.
+func (dsq *DailyStatQuery) Offset(offset int) *DailyStatQuery {
+	dsq.ctx.Offset = &offset
+	return dsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dsq *DailyStatQuery) Unique(unique bool) *DailyStatQuery {
+	dsq.ctx.Unique = &unique
+	return dsq
+}
+
+// Order specifies how the records should be ordered.
+func (dsq *DailyStatQuery) Order(o ...dailystat.OrderOption) *DailyStatQuery {
+	dsq.order = append(dsq.order, o...)
+	return dsq
+}
+
+// First returns the first DailyStat entity from the query.
+// Returns a *NotFoundError when no DailyStat was found.
+func (dsq *DailyStatQuery) First(ctx context.Context) (*DailyStat, error) {
+	nodes, err := dsq.Limit(1).All(setContextOp(ctx, dsq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{dailystat.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dsq *DailyStatQuery) FirstX(ctx context.Context) *DailyStat {
+	node, err := dsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DailyStat ID from the query.
+// Returns a *NotFoundError when no DailyStat ID was found.
+func (dsq *DailyStatQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dsq.Limit(1).IDs(setContextOp(ctx, dsq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{dailystat.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dsq *DailyStatQuery) FirstIDX(ctx context.Context) int {
+	id, err := dsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DailyStat entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DailyStat entity is found.
+// Returns a *NotFoundError when no DailyStat entities are found.
+func (dsq *DailyStatQuery) Only(ctx context.Context) (*DailyStat, error) {
+	nodes, err := dsq.Limit(2).All(setContextOp(ctx, dsq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{dailystat.Label}
+	default:
+		return nil, &NotSingularError{dailystat.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dsq *DailyStatQuery) OnlyX(ctx context.Context) *DailyStat {
+	node, err := dsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DailyStat ID in the query.
+// Returns a *NotSingularError when more than one DailyStat ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dsq *DailyStatQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dsq.Limit(2).IDs(setContextOp(ctx, dsq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{dailystat.Label}
+	default:
+		err = &NotSingularError{dailystat.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dsq *DailyStatQuery) OnlyIDX(ctx context.Context) int {
+	id, err := dsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DailyStats.
+func (dsq *DailyStatQuery) All(ctx context.Context) ([]*DailyStat, error) {
+	ctx = setContextOp(ctx, dsq.ctx, "All")
+	if err := dsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DailyStat, *DailyStatQuery]()
+	return withInterceptors[[]*DailyStat](ctx, dsq, qr, dsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dsq *DailyStatQuery) AllX(ctx context.Context) []*DailyStat {
+	nodes, err := dsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DailyStat IDs.
+func (dsq *DailyStatQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if dsq.ctx.Unique == nil && dsq.path != nil {
+		dsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dsq.ctx, "IDs")
+	if err = dsq.Select(dailystat.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dsq *DailyStatQuery) IDsX(ctx context.Context) []int {
+	ids, err := dsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dsq *DailyStatQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dsq.ctx, "Count")
+	if err := dsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dsq, querierCount[*DailyStatQuery](), dsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dsq *DailyStatQuery) CountX(ctx context.Context) int {
+	count, err := dsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dsq *DailyStatQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dsq.ctx, "Exist")
+	switch _, err := dsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dsq *DailyStatQuery) ExistX(ctx context.Context) bool {
+	exist, err := dsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DailyStatQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dsq *DailyStatQuery) Clone() *DailyStatQuery {
+	if dsq == nil {
+		return nil
+	}
+	return &DailyStatQuery{
+		config:     dsq.config,
+		ctx:        dsq.ctx.Clone(),
+		order:      append([]dailystat.OrderOption{}, dsq.order...),
+		inters:     append([]Interceptor{}, dsq.inters...),
+		predicates: append([]predicate.DailyStat{}, dsq.predicates...),
+		// clone intermediate query.
+		sql:  dsq.sql.Clone(),
+		path: dsq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DailyStat.Query().
+//		GroupBy(dailystat.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dsq *DailyStatQuery) GroupBy(field string, fields ...string) *DailyStatGroupBy {
+	dsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DailyStatGroupBy{build: dsq}
+	grbuild.flds = &dsq.ctx.Fields
+	grbuild.label = dailystat.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.DailyStat.Query().
+//		Select(dailystat.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (dsq *DailyStatQuery) Select(fields ...string) *DailyStatSelect {
+	dsq.ctx.Fields = append(dsq.ctx.Fields, fields...)
+	sbuild := &DailyStatSelect{DailyStatQuery: dsq}
+	sbuild.label = dailystat.Label
+	sbuild.flds, sbuild.scan = &dsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DailyStatSelect configured with the given aggregations.
+func (dsq *DailyStatQuery) Aggregate(fns ...AggregateFunc) *DailyStatSelect {
+	return dsq.Select().Aggregate(fns...)
+}
+
+func (dsq *DailyStatQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dsq.ctx.Fields {
+		if !dailystat.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dsq.path != nil {
+		prev, err := dsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dsq.sql = prev
+	}
+	return nil
+}
+
+func (dsq *DailyStatQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DailyStat, error) {
+	var (
+		nodes = []*DailyStat{}
+		_spec = dsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DailyStat).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DailyStat{config: dsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(dsq.modifiers) > 0 {
+		_spec.Modifiers = dsq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range dsq.loadTotal {
+		if err := dsq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (dsq *DailyStatQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dsq.querySpec()
+	if len(dsq.modifiers) > 0 {
+		_spec.Modifiers = dsq.modifiers
+	}
+	_spec.Node.Columns = dsq.ctx.Fields
+	if len(dsq.ctx.Fields) > 0 {
+		_spec.Unique = dsq.ctx.Unique != nil && *dsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dsq.driver, _spec)
+}
+
+func (dsq *DailyStatQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(dailystat.Table, dailystat.Columns, sqlgraph.NewFieldSpec(dailystat.FieldID, field.TypeInt))
+	_spec.From = dsq.sql
+	if unique := dsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dailystat.FieldID)
+		for i := range fields {
+			if fields[i] != dailystat.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dsq *DailyStatQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dsq.driver.Dialect())
+	t1 := builder.Table(dailystat.Table)
+	columns := dsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = dailystat.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dsq.sql != nil {
+		selector = dsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dsq.ctx.Unique != nil && *dsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range dsq.predicates {
+		p(selector)
+	}
+	for _, p := range dsq.order {
+		p(selector)
+	}
+	if offset := dsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DailyStatGroupBy is the group-by builder for DailyStat entities.
+type DailyStatGroupBy struct {
+	selector
+	build *DailyStatQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dsgb *DailyStatGroupBy) Aggregate(fns ...AggregateFunc) *DailyStatGroupBy {
+	dsgb.fns = append(dsgb.fns, fns...)
+	return dsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dsgb *DailyStatGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dsgb.build.ctx, "GroupBy")
+	if err := dsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DailyStatQuery, *DailyStatGroupBy](ctx, dsgb.build, dsgb, dsgb.build.inters, v)
+}
+
+func (dsgb *DailyStatGroupBy) sqlScan(ctx context.Context, root *DailyStatQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dsgb.fns))
+	for _, fn := range dsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dsgb.flds)+len(dsgb.fns))
+		for _, f := range *dsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DailyStatSelect is the builder for selecting fields of DailyStat entities.
+type DailyStatSelect struct {
+	*DailyStatQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dss *DailyStatSelect) Aggregate(fns ...AggregateFunc) *DailyStatSelect {
+	dss.fns = append(dss.fns, fns...)
+	return dss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dss *DailyStatSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dss.ctx, "Select")
+	if err := dss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DailyStatQuery, *DailyStatSelect](ctx, dss.DailyStatQuery, dss, dss.inters, v)
+}
+
+func (dss *DailyStatSelect) sqlScan(ctx context.Context, root *DailyStatQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dss.fns))
+	for _, fn := range dss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
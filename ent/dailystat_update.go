@@ -0,0 +1,748 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/dailystat"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// DailyStatUpdate is the builder for updating DailyStat entities.
+type DailyStatUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DailyStatMutation
+}
+
+// Where appends a list predicates to the DailyStatUpdate builder.
+func (dsu *DailyStatUpdate) Where(ps ...predicate.DailyStat) *DailyStatUpdate {
+	dsu.mutation.Where(ps...)
+	return dsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dsu *DailyStatUpdate) SetUpdatedAt(t time.Time) *DailyStatUpdate {
+	dsu.mutation.SetUpdatedAt(t)
+	return dsu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dsu *DailyStatUpdate) SetDeletedAt(t time.Time) *DailyStatUpdate {
+	dsu.mutation.SetDeletedAt(t)
+	return dsu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dsu *DailyStatUpdate) SetNillableDeletedAt(t *time.Time) *DailyStatUpdate {
+	if t != nil {
+		dsu.SetDeletedAt(*t)
+	}
+	return dsu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (dsu *DailyStatUpdate) ClearDeletedAt() *DailyStatUpdate {
+	dsu.mutation.ClearDeletedAt()
+	return dsu
+}
+
+// SetDate sets the "date" field.
+func (dsu *DailyStatUpdate) SetDate(s string) *DailyStatUpdate {
+	dsu.mutation.SetDate(s)
+	return dsu
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (dsu *DailyStatUpdate) SetNillableDate(s *string) *DailyStatUpdate {
+	if s != nil {
+		dsu.SetDate(*s)
+	}
+	return dsu
+}
+
+// SetActiveUserCount sets the "active_user_count" field.
+func (dsu *DailyStatUpdate) SetActiveUserCount(i int) *DailyStatUpdate {
+	dsu.mutation.ResetActiveUserCount()
+	dsu.mutation.SetActiveUserCount(i)
+	return dsu
+}
+
+// SetNillableActiveUserCount sets the "active_user_count" field if the given value is not nil.
+func (dsu *DailyStatUpdate) SetNillableActiveUserCount(i *int) *DailyStatUpdate {
+	if i != nil {
+		dsu.SetActiveUserCount(*i)
+	}
+	return dsu
+}
+
+// AddActiveUserCount adds i to the "active_user_count" field.
+func (dsu *DailyStatUpdate) AddActiveUserCount(i int) *DailyStatUpdate {
+	dsu.mutation.AddActiveUserCount(i)
+	return dsu
+}
+
+// SetUploads sets the "uploads" field.
+func (dsu *DailyStatUpdate) SetUploads(i int) *DailyStatUpdate {
+	dsu.mutation.ResetUploads()
+	dsu.mutation.SetUploads(i)
+	return dsu
+}
+
+// SetNillableUploads sets the "uploads" field if the given value is not nil.
+func (dsu *DailyStatUpdate) SetNillableUploads(i *int) *DailyStatUpdate {
+	if i != nil {
+		dsu.SetUploads(*i)
+	}
+	return dsu
+}
+
+// AddUploads adds i to the "uploads" field.
+func (dsu *DailyStatUpdate) AddUploads(i int) *DailyStatUpdate {
+	dsu.mutation.AddUploads(i)
+	return dsu
+}
+
+// SetUploadSize sets the "upload_size" field.
+func (dsu *DailyStatUpdate) SetUploadSize(i int64) *DailyStatUpdate {
+	dsu.mutation.ResetUploadSize()
+	dsu.mutation.SetUploadSize(i)
+	return dsu
+}
+
+// SetNillableUploadSize sets the "upload_size" field if the given value is not nil.
+func (dsu *DailyStatUpdate) SetNillableUploadSize(i *int64) *DailyStatUpdate {
+	if i != nil {
+		dsu.SetUploadSize(*i)
+	}
+	return dsu
+}
+
+// AddUploadSize adds i to the "upload_size" field.
+func (dsu *DailyStatUpdate) AddUploadSize(i int64) *DailyStatUpdate {
+	dsu.mutation.AddUploadSize(i)
+	return dsu
+}
+
+// SetDownloads sets the "downloads" field.
+func (dsu *DailyStatUpdate) SetDownloads(i int) *DailyStatUpdate {
+	dsu.mutation.ResetDownloads()
+	dsu.mutation.SetDownloads(i)
+	return dsu
+}
+
+// SetNillableDownloads sets the "downloads" field if the given value is not nil.
+func (dsu *DailyStatUpdate) SetNillableDownloads(i *int) *DailyStatUpdate {
+	if i != nil {
+		dsu.SetDownloads(*i)
+	}
+	return dsu
+}
+
+// AddDownloads adds i to the "downloads" field.
+func (dsu *DailyStatUpdate) AddDownloads(i int) *DailyStatUpdate {
+	dsu.mutation.AddDownloads(i)
+	return dsu
+}
+
+// SetDownloadSize sets the "download_size" field.
+func (dsu *DailyStatUpdate) SetDownloadSize(i int64) *DailyStatUpdate {
+	dsu.mutation.ResetDownloadSize()
+	dsu.mutation.SetDownloadSize(i)
+	return dsu
+}
+
+// SetNillableDownloadSize sets the "download_size" field if the given value is not nil.
+func (dsu *DailyStatUpdate) SetNillableDownloadSize(i *int64) *DailyStatUpdate {
+	if i != nil {
+		dsu.SetDownloadSize(*i)
+	}
+	return dsu
+}
+
+// AddDownloadSize adds i to the "download_size" field.
+func (dsu *DailyStatUpdate) AddDownloadSize(i int64) *DailyStatUpdate {
+	dsu.mutation.AddDownloadSize(i)
+	return dsu
+}
+
+// SetFileTypes sets the "file_types" field.
+func (dsu *DailyStatUpdate) SetFileTypes(mft map[string]*types.StatsFileType) *DailyStatUpdate {
+	dsu.mutation.SetFileTypes(mft)
+	return dsu
+}
+
+// ClearFileTypes clears the value of the "file_types" field.
+func (dsu *DailyStatUpdate) ClearFileTypes() *DailyStatUpdate {
+	dsu.mutation.ClearFileTypes()
+	return dsu
+}
+
+// SetPolicyStorage sets the "policy_storage" field.
+func (dsu *DailyStatUpdate) SetPolicyStorage(m map[int]int64) *DailyStatUpdate {
+	dsu.mutation.SetPolicyStorage(m)
+	return dsu
+}
+
+// ClearPolicyStorage clears the value of the "policy_storage" field.
+func (dsu *DailyStatUpdate) ClearPolicyStorage() *DailyStatUpdate {
+	dsu.mutation.ClearPolicyStorage()
+	return dsu
+}
+
+// SetGroupStorage sets the "group_storage" field.
+func (dsu *DailyStatUpdate) SetGroupStorage(m map[int]int64) *DailyStatUpdate {
+	dsu.mutation.SetGroupStorage(m)
+	return dsu
+}
+
+// ClearGroupStorage clears the value of the "group_storage" field.
+func (dsu *DailyStatUpdate) ClearGroupStorage() *DailyStatUpdate {
+	dsu.mutation.ClearGroupStorage()
+	return dsu
+}
+
+// SetTopUsers sets the "top_users" field.
+func (dsu *DailyStatUpdate) SetTopUsers(tus []types.StatsUserStorage) *DailyStatUpdate {
+	dsu.mutation.SetTopUsers(tus)
+	return dsu
+}
+
+// AppendTopUsers appends tus to the "top_users" field.
+func (dsu *DailyStatUpdate) AppendTopUsers(tus []types.StatsUserStorage) *DailyStatUpdate {
+	dsu.mutation.AppendTopUsers(tus)
+	return dsu
+}
+
+// ClearTopUsers clears the value of the "top_users" field.
+func (dsu *DailyStatUpdate) ClearTopUsers() *DailyStatUpdate {
+	dsu.mutation.ClearTopUsers()
+	return dsu
+}
+
+// Mutation returns the DailyStatMutation object of the builder.
+func (dsu *DailyStatUpdate) Mutation() *DailyStatMutation {
+	return dsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dsu *DailyStatUpdate) Save(ctx context.Context) (int, error) {
+	if err := dsu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, dsu.sqlSave, dsu.mutation, dsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dsu *DailyStatUpdate) SaveX(ctx context.Context) int {
+	affected, err := dsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dsu *DailyStatUpdate) Exec(ctx context.Context) error {
+	_, err := dsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsu *DailyStatUpdate) ExecX(ctx context.Context) {
+	if err := dsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dsu *DailyStatUpdate) defaults() error {
+	if _, ok := dsu.mutation.UpdatedAt(); !ok {
+		if dailystat.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dailystat.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := dailystat.UpdateDefaultUpdatedAt()
+		dsu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (dsu *DailyStatUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dailystat.Table, dailystat.Columns, sqlgraph.NewFieldSpec(dailystat.FieldID, field.TypeInt))
+	if ps := dsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(dailystat.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dsu.mutation.DeletedAt(); ok {
+		_spec.SetField(dailystat.FieldDeletedAt, field.TypeTime, value)
+	}
+	if dsu.mutation.DeletedAtCleared() {
+		_spec.ClearField(dailystat.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := dsu.mutation.Date(); ok {
+		_spec.SetField(dailystat.FieldDate, field.TypeString, value)
+	}
+	if value, ok := dsu.mutation.ActiveUserCount(); ok {
+		_spec.SetField(dailystat.FieldActiveUserCount, field.TypeInt, value)
+	}
+	if value, ok := dsu.mutation.AddedActiveUserCount(); ok {
+		_spec.AddField(dailystat.FieldActiveUserCount, field.TypeInt, value)
+	}
+	if value, ok := dsu.mutation.Uploads(); ok {
+		_spec.SetField(dailystat.FieldUploads, field.TypeInt, value)
+	}
+	if value, ok := dsu.mutation.AddedUploads(); ok {
+		_spec.AddField(dailystat.FieldUploads, field.TypeInt, value)
+	}
+	if value, ok := dsu.mutation.UploadSize(); ok {
+		_spec.SetField(dailystat.FieldUploadSize, field.TypeInt64, value)
+	}
+	if value, ok := dsu.mutation.AddedUploadSize(); ok {
+		_spec.AddField(dailystat.FieldUploadSize, field.TypeInt64, value)
+	}
+	if value, ok := dsu.mutation.Downloads(); ok {
+		_spec.SetField(dailystat.FieldDownloads, field.TypeInt, value)
+	}
+	if value, ok := dsu.mutation.AddedDownloads(); ok {
+		_spec.AddField(dailystat.FieldDownloads, field.TypeInt, value)
+	}
+	if value, ok := dsu.mutation.DownloadSize(); ok {
+		_spec.SetField(dailystat.FieldDownloadSize, field.TypeInt64, value)
+	}
+	if value, ok := dsu.mutation.AddedDownloadSize(); ok {
+		_spec.AddField(dailystat.FieldDownloadSize, field.TypeInt64, value)
+	}
+	if value, ok := dsu.mutation.FileTypes(); ok {
+		_spec.SetField(dailystat.FieldFileTypes, field.TypeJSON, value)
+	}
+	if dsu.mutation.FileTypesCleared() {
+		_spec.ClearField(dailystat.FieldFileTypes, field.TypeJSON)
+	}
+	if value, ok := dsu.mutation.PolicyStorage(); ok {
+		_spec.SetField(dailystat.FieldPolicyStorage, field.TypeJSON, value)
+	}
+	if dsu.mutation.PolicyStorageCleared() {
+		_spec.ClearField(dailystat.FieldPolicyStorage, field.TypeJSON)
+	}
+	if value, ok := dsu.mutation.GroupStorage(); ok {
+		_spec.SetField(dailystat.FieldGroupStorage, field.TypeJSON, value)
+	}
+	if dsu.mutation.GroupStorageCleared() {
+		_spec.ClearField(dailystat.FieldGroupStorage, field.TypeJSON)
+	}
+	if value, ok := dsu.mutation.TopUsers(); ok {
+		_spec.SetField(dailystat.FieldTopUsers, field.TypeJSON, value)
+	}
+	if value, ok := dsu.mutation.AppendedTopUsers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, dailystat.FieldTopUsers, value)
+		})
+	}
+	if dsu.mutation.TopUsersCleared() {
+		_spec.ClearField(dailystat.FieldTopUsers, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dailystat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dsu.mutation.done = true
+	return n, nil
+}
+
+// DailyStatUpdateOne is the builder for updating a single DailyStat entity.
+type DailyStatUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DailyStatMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dsuo *DailyStatUpdateOne) SetUpdatedAt(t time.Time) *DailyStatUpdateOne {
+	dsuo.mutation.SetUpdatedAt(t)
+	return dsuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dsuo *DailyStatUpdateOne) SetDeletedAt(t time.Time) *DailyStatUpdateOne {
+	dsuo.mutation.SetDeletedAt(t)
+	return dsuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dsuo *DailyStatUpdateOne) SetNillableDeletedAt(t *time.Time) *DailyStatUpdateOne {
+	if t != nil {
+		dsuo.SetDeletedAt(*t)
+	}
+	return dsuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (dsuo *DailyStatUpdateOne) ClearDeletedAt() *DailyStatUpdateOne {
+	dsuo.mutation.ClearDeletedAt()
+	return dsuo
+}
+
+// SetDate sets the "date" field.
+func (dsuo *DailyStatUpdateOne) SetDate(s string) *DailyStatUpdateOne {
+	dsuo.mutation.SetDate(s)
+	return dsuo
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (dsuo *DailyStatUpdateOne) SetNillableDate(s *string) *DailyStatUpdateOne {
+	if s != nil {
+		dsuo.SetDate(*s)
+	}
+	return dsuo
+}
+
+// SetActiveUserCount sets the "active_user_count" field.
+func (dsuo *DailyStatUpdateOne) SetActiveUserCount(i int) *DailyStatUpdateOne {
+	dsuo.mutation.ResetActiveUserCount()
+	dsuo.mutation.SetActiveUserCount(i)
+	return dsuo
+}
+
+// SetNillableActiveUserCount sets the "active_user_count" field if the given value is not nil.
+func (dsuo *DailyStatUpdateOne) SetNillableActiveUserCount(i *int) *DailyStatUpdateOne {
+	if i != nil {
+		dsuo.SetActiveUserCount(*i)
+	}
+	return dsuo
+}
+
+// AddActiveUserCount adds i to the "active_user_count" field.
+func (dsuo *DailyStatUpdateOne) AddActiveUserCount(i int) *DailyStatUpdateOne {
+	dsuo.mutation.AddActiveUserCount(i)
+	return dsuo
+}
+
+// SetUploads sets the "uploads" field.
+func (dsuo *DailyStatUpdateOne) SetUploads(i int) *DailyStatUpdateOne {
+	dsuo.mutation.ResetUploads()
+	dsuo.mutation.SetUploads(i)
+	return dsuo
+}
+
+// SetNillableUploads sets the "uploads" field if the given value is not nil.
+func (dsuo *DailyStatUpdateOne) SetNillableUploads(i *int) *DailyStatUpdateOne {
+	if i != nil {
+		dsuo.SetUploads(*i)
+	}
+	return dsuo
+}
+
+// AddUploads adds i to the "uploads" field.
+func (dsuo *DailyStatUpdateOne) AddUploads(i int) *DailyStatUpdateOne {
+	dsuo.mutation.AddUploads(i)
+	return dsuo
+}
+
+// SetUploadSize sets the "upload_size" field.
+func (dsuo *DailyStatUpdateOne) SetUploadSize(i int64) *DailyStatUpdateOne {
+	dsuo.mutation.ResetUploadSize()
+	dsuo.mutation.SetUploadSize(i)
+	return dsuo
+}
+
+// SetNillableUploadSize sets the "upload_size" field if the given value is not nil.
+func (dsuo *DailyStatUpdateOne) SetNillableUploadSize(i *int64) *DailyStatUpdateOne {
+	if i != nil {
+		dsuo.SetUploadSize(*i)
+	}
+	return dsuo
+}
+
+// AddUploadSize adds i to the "upload_size" field.
+func (dsuo *DailyStatUpdateOne) AddUploadSize(i int64) *DailyStatUpdateOne {
+	dsuo.mutation.AddUploadSize(i)
+	return dsuo
+}
+
+// SetDownloads sets the "downloads" field.
+func (dsuo *DailyStatUpdateOne) SetDownloads(i int) *DailyStatUpdateOne {
+	dsuo.mutation.ResetDownloads()
+	dsuo.mutation.SetDownloads(i)
+	return dsuo
+}
+
+// SetNillableDownloads sets the "downloads" field if the given value is not nil.
+func (dsuo *DailyStatUpdateOne) SetNillableDownloads(i *int) *DailyStatUpdateOne {
+	if i != nil {
+		dsuo.SetDownloads(*i)
+	}
+	return dsuo
+}
+
+// AddDownloads adds i to the "downloads" field.
+func (dsuo *DailyStatUpdateOne) AddDownloads(i int) *DailyStatUpdateOne {
+	dsuo.mutation.AddDownloads(i)
+	return dsuo
+}
+
+// SetDownloadSize sets the "download_size" field.
+func (dsuo *DailyStatUpdateOne) SetDownloadSize(i int64) *DailyStatUpdateOne {
+	dsuo.mutation.ResetDownloadSize()
+	dsuo.mutation.SetDownloadSize(i)
+	return dsuo
+}
+
+// SetNillableDownloadSize sets the "download_size" field if the given value is not nil.
+func (dsuo *DailyStatUpdateOne) SetNillableDownloadSize(i *int64) *DailyStatUpdateOne {
+	if i != nil {
+		dsuo.SetDownloadSize(*i)
+	}
+	return dsuo
+}
+
+// AddDownloadSize adds i to the "download_size" field.
+func (dsuo *DailyStatUpdateOne) AddDownloadSize(i int64) *DailyStatUpdateOne {
+	dsuo.mutation.AddDownloadSize(i)
+	return dsuo
+}
+
+// SetFileTypes sets the "file_types" field.
+func (dsuo *DailyStatUpdateOne) SetFileTypes(mft map[string]*types.StatsFileType) *DailyStatUpdateOne {
+	dsuo.mutation.SetFileTypes(mft)
+	return dsuo
+}
+
+// ClearFileTypes clears the value of the "file_types" field.
+func (dsuo *DailyStatUpdateOne) ClearFileTypes() *DailyStatUpdateOne {
+	dsuo.mutation.ClearFileTypes()
+	return dsuo
+}
+
+// SetPolicyStorage sets the "policy_storage" field.
+func (dsuo *DailyStatUpdateOne) SetPolicyStorage(m map[int]int64) *DailyStatUpdateOne {
+	dsuo.mutation.SetPolicyStorage(m)
+	return dsuo
+}
+
+// ClearPolicyStorage clears the value of the "policy_storage" field.
+func (dsuo *DailyStatUpdateOne) ClearPolicyStorage() *DailyStatUpdateOne {
+	dsuo.mutation.ClearPolicyStorage()
+	return dsuo
+}
+
+// SetGroupStorage sets the "group_storage" field.
+func (dsuo *DailyStatUpdateOne) SetGroupStorage(m map[int]int64) *DailyStatUpdateOne {
+	dsuo.mutation.SetGroupStorage(m)
+	return dsuo
+}
+
+// ClearGroupStorage clears the value of the "group_storage" field.
+func (dsuo *DailyStatUpdateOne) ClearGroupStorage() *DailyStatUpdateOne {
+	dsuo.mutation.ClearGroupStorage()
+	return dsuo
+}
+
+// SetTopUsers sets the "top_users" field.
+func (dsuo *DailyStatUpdateOne) SetTopUsers(tus []types.StatsUserStorage) *DailyStatUpdateOne {
+	dsuo.mutation.SetTopUsers(tus)
+	return dsuo
+}
+
+// AppendTopUsers appends tus to the "top_users" field.
+func (dsuo *DailyStatUpdateOne) AppendTopUsers(tus []types.StatsUserStorage) *DailyStatUpdateOne {
+	dsuo.mutation.AppendTopUsers(tus)
+	return dsuo
+}
+
+// ClearTopUsers clears the value of the "top_users" field.
+func (dsuo *DailyStatUpdateOne) ClearTopUsers() *DailyStatUpdateOne {
+	dsuo.mutation.ClearTopUsers()
+	return dsuo
+}
+
+// Mutation returns the DailyStatMutation object of the builder.
+func (dsuo *DailyStatUpdateOne) Mutation() *DailyStatMutation {
+	return dsuo.mutation
+}
+
+// Where appends a list predicates to the DailyStatUpdate builder.
+func (dsuo *DailyStatUpdateOne) Where(ps ...predicate.DailyStat) *DailyStatUpdateOne {
+	dsuo.mutation.Where(ps...)
+	return dsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dsuo *DailyStatUpdateOne) Select(field string, fields ...string) *DailyStatUpdateOne {
+	dsuo.fields = append([]string{field}, fields...)
+	return dsuo
+}
+
+// Save executes the query and returns the updated DailyStat entity.
+func (dsuo *DailyStatUpdateOne) Save(ctx context.Context) (*DailyStat, error) {
+	if err := dsuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, dsuo.sqlSave, dsuo.mutation, dsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dsuo *DailyStatUpdateOne) SaveX(ctx context.Context) *DailyStat {
+	node, err := dsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dsuo *DailyStatUpdateOne) Exec(ctx context.Context) error {
+	_, err := dsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsuo *DailyStatUpdateOne) ExecX(ctx context.Context) {
+	if err := dsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dsuo *DailyStatUpdateOne) defaults() error {
+	if _, ok := dsuo.mutation.UpdatedAt(); !ok {
+		if dailystat.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized dailystat.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := dailystat.UpdateDefaultUpdatedAt()
+		dsuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (dsuo *DailyStatUpdateOne) sqlSave(ctx context.Context) (_node *DailyStat, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dailystat.Table, dailystat.Columns, sqlgraph.NewFieldSpec(dailystat.FieldID, field.TypeInt))
+	id, ok := dsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DailyStat.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dailystat.FieldID)
+		for _, f := range fields {
+			if !dailystat.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dailystat.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(dailystat.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dsuo.mutation.DeletedAt(); ok {
+		_spec.SetField(dailystat.FieldDeletedAt, field.TypeTime, value)
+	}
+	if dsuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(dailystat.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := dsuo.mutation.Date(); ok {
+		_spec.SetField(dailystat.FieldDate, field.TypeString, value)
+	}
+	if value, ok := dsuo.mutation.ActiveUserCount(); ok {
+		_spec.SetField(dailystat.FieldActiveUserCount, field.TypeInt, value)
+	}
+	if value, ok := dsuo.mutation.AddedActiveUserCount(); ok {
+		_spec.AddField(dailystat.FieldActiveUserCount, field.TypeInt, value)
+	}
+	if value, ok := dsuo.mutation.Uploads(); ok {
+		_spec.SetField(dailystat.FieldUploads, field.TypeInt, value)
+	}
+	if value, ok := dsuo.mutation.AddedUploads(); ok {
+		_spec.AddField(dailystat.FieldUploads, field.TypeInt, value)
+	}
+	if value, ok := dsuo.mutation.UploadSize(); ok {
+		_spec.SetField(dailystat.FieldUploadSize, field.TypeInt64, value)
+	}
+	if value, ok := dsuo.mutation.AddedUploadSize(); ok {
+		_spec.AddField(dailystat.FieldUploadSize, field.TypeInt64, value)
+	}
+	if value, ok := dsuo.mutation.Downloads(); ok {
+		_spec.SetField(dailystat.FieldDownloads, field.TypeInt, value)
+	}
+	if value, ok := dsuo.mutation.AddedDownloads(); ok {
+		_spec.AddField(dailystat.FieldDownloads, field.TypeInt, value)
+	}
+	if value, ok := dsuo.mutation.DownloadSize(); ok {
+		_spec.SetField(dailystat.FieldDownloadSize, field.TypeInt64, value)
+	}
+	if value, ok := dsuo.mutation.AddedDownloadSize(); ok {
+		_spec.AddField(dailystat.FieldDownloadSize, field.TypeInt64, value)
+	}
+	if value, ok := dsuo.mutation.FileTypes(); ok {
+		_spec.SetField(dailystat.FieldFileTypes, field.TypeJSON, value)
+	}
+	if dsuo.mutation.FileTypesCleared() {
+		_spec.ClearField(dailystat.FieldFileTypes, field.TypeJSON)
+	}
+	if value, ok := dsuo.mutation.PolicyStorage(); ok {
+		_spec.SetField(dailystat.FieldPolicyStorage, field.TypeJSON, value)
+	}
+	if dsuo.mutation.PolicyStorageCleared() {
+		_spec.ClearField(dailystat.FieldPolicyStorage, field.TypeJSON)
+	}
+	if value, ok := dsuo.mutation.GroupStorage(); ok {
+		_spec.SetField(dailystat.FieldGroupStorage, field.TypeJSON, value)
+	}
+	if dsuo.mutation.GroupStorageCleared() {
+		_spec.ClearField(dailystat.FieldGroupStorage, field.TypeJSON)
+	}
+	if value, ok := dsuo.mutation.TopUsers(); ok {
+		_spec.SetField(dailystat.FieldTopUsers, field.TypeJSON, value)
+	}
+	if value, ok := dsuo.mutation.AppendedTopUsers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, dailystat.FieldTopUsers, value)
+		})
+	}
+	if dsuo.mutation.TopUsersCleared() {
+		_spec.ClearField(dailystat.FieldTopUsers, field.TypeJSON)
+	}
+	_node = &DailyStat{config: dsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dailystat.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dsuo.mutation.done = true
+	return _node, nil
+}
@@ -39,6 +39,12 @@ type DavAccount struct {
 	Props *types.DavAccountProps `json:"props,omitempty"`
 	// OwnerID holds the value of the "owner_id" field.
 	OwnerID int `json:"owner_id,omitempty"`
+	// ExpireAt holds the value of the "expire_at" field.
+	ExpireAt *time.Time `json:"expire_at,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// DisabledAt holds the value of the "disabled_at" field.
+	DisabledAt *time.Time `json:"disabled_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DavAccountQuery when eager-loading is set.
 	Edges        DavAccountEdges `json:"edges"`
@@ -52,6 +58,8 @@ type DavAccountEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -80,7 +88,7 @@ func (*DavAccount) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case davaccount.FieldName, davaccount.FieldURI, davaccount.FieldPassword:
 			values[i] = new(sql.NullString)
-		case davaccount.FieldCreatedAt, davaccount.FieldUpdatedAt, davaccount.FieldDeletedAt:
+		case davaccount.FieldCreatedAt, davaccount.FieldUpdatedAt, davaccount.FieldDeletedAt, davaccount.FieldExpireAt, davaccount.FieldLastUsedAt, davaccount.FieldDisabledAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -160,6 +168,27 @@ func (da *DavAccount) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				da.OwnerID = int(value.Int64)
 			}
+		case davaccount.FieldExpireAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expire_at", values[i])
+			} else if value.Valid {
+				da.ExpireAt = new(time.Time)
+				*da.ExpireAt = value.Time
+			}
+		case davaccount.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				da.LastUsedAt = new(time.Time)
+				*da.LastUsedAt = value.Time
+			}
+		case davaccount.FieldDisabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled_at", values[i])
+			} else if value.Valid {
+				da.DisabledAt = new(time.Time)
+				*da.DisabledAt = value.Time
+			}
 		default:
 			da.selectValues.Set(columns[i], values[i])
 		}
@@ -228,6 +257,21 @@ func (da *DavAccount) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("owner_id=")
 	builder.WriteString(fmt.Sprintf("%v", da.OwnerID))
+	builder.WriteString(", ")
+	if v := da.ExpireAt; v != nil {
+		builder.WriteString("expire_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := da.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := da.DisabledAt; v != nil {
+		builder.WriteString("disabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -103,6 +103,48 @@ func (dac *DavAccountCreate) SetOwnerID(i int) *DavAccountCreate {
 	return dac
 }
 
+// SetExpireAt sets the "expire_at" field.
+func (dac *DavAccountCreate) SetExpireAt(t time.Time) *DavAccountCreate {
+	dac.mutation.SetExpireAt(t)
+	return dac
+}
+
+// SetNillableExpireAt sets the "expire_at" field if the given value is not nil.
+func (dac *DavAccountCreate) SetNillableExpireAt(t *time.Time) *DavAccountCreate {
+	if t != nil {
+		dac.SetExpireAt(*t)
+	}
+	return dac
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (dac *DavAccountCreate) SetLastUsedAt(t time.Time) *DavAccountCreate {
+	dac.mutation.SetLastUsedAt(t)
+	return dac
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (dac *DavAccountCreate) SetNillableLastUsedAt(t *time.Time) *DavAccountCreate {
+	if t != nil {
+		dac.SetLastUsedAt(*t)
+	}
+	return dac
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (dac *DavAccountCreate) SetDisabledAt(t time.Time) *DavAccountCreate {
+	dac.mutation.SetDisabledAt(t)
+	return dac
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (dac *DavAccountCreate) SetNillableDisabledAt(t *time.Time) *DavAccountCreate {
+	if t != nil {
+		dac.SetDisabledAt(*t)
+	}
+	return dac
+}
+
 // SetOwner sets the "owner" edge to the User entity.
 func (dac *DavAccountCreate) SetOwner(u *User) *DavAccountCreate {
 	return dac.SetOwnerID(u.ID)
@@ -254,6 +296,18 @@ func (dac *DavAccountCreate) createSpec() (*DavAccount, *sqlgraph.CreateSpec) {
 		_spec.SetField(davaccount.FieldProps, field.TypeJSON, value)
 		_node.Props = value
 	}
+	if value, ok := dac.mutation.ExpireAt(); ok {
+		_spec.SetField(davaccount.FieldExpireAt, field.TypeTime, value)
+		_node.ExpireAt = &value
+	}
+	if value, ok := dac.mutation.LastUsedAt(); ok {
+		_spec.SetField(davaccount.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := dac.mutation.DisabledAt(); ok {
+		_spec.SetField(davaccount.FieldDisabledAt, field.TypeTime, value)
+		_node.DisabledAt = &value
+	}
 	if nodes := dac.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -431,6 +485,60 @@ func (u *DavAccountUpsert) UpdateOwnerID() *DavAccountUpsert {
 	return u
 }
 
+// SetExpireAt sets the "expire_at" field.
+func (u *DavAccountUpsert) SetExpireAt(v time.Time) *DavAccountUpsert {
+	u.Set(davaccount.FieldExpireAt, v)
+	return u
+}
+
+// UpdateExpireAt sets the "expire_at" field to the value that was provided on create.
+func (u *DavAccountUpsert) UpdateExpireAt() *DavAccountUpsert {
+	u.SetExcluded(davaccount.FieldExpireAt)
+	return u
+}
+
+// ClearExpireAt clears the value of the "expire_at" field.
+func (u *DavAccountUpsert) ClearExpireAt() *DavAccountUpsert {
+	u.SetNull(davaccount.FieldExpireAt)
+	return u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *DavAccountUpsert) SetLastUsedAt(v time.Time) *DavAccountUpsert {
+	u.Set(davaccount.FieldLastUsedAt, v)
+	return u
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *DavAccountUpsert) UpdateLastUsedAt() *DavAccountUpsert {
+	u.SetExcluded(davaccount.FieldLastUsedAt)
+	return u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *DavAccountUpsert) ClearLastUsedAt() *DavAccountUpsert {
+	u.SetNull(davaccount.FieldLastUsedAt)
+	return u
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (u *DavAccountUpsert) SetDisabledAt(v time.Time) *DavAccountUpsert {
+	u.Set(davaccount.FieldDisabledAt, v)
+	return u
+}
+
+// UpdateDisabledAt sets the "disabled_at" field to the value that was provided on create.
+func (u *DavAccountUpsert) UpdateDisabledAt() *DavAccountUpsert {
+	u.SetExcluded(davaccount.FieldDisabledAt)
+	return u
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (u *DavAccountUpsert) ClearDisabledAt() *DavAccountUpsert {
+	u.SetNull(davaccount.FieldDisabledAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -602,6 +710,69 @@ func (u *DavAccountUpsertOne) UpdateOwnerID() *DavAccountUpsertOne {
 	})
 }
 
+// SetExpireAt sets the "expire_at" field.
+func (u *DavAccountUpsertOne) SetExpireAt(v time.Time) *DavAccountUpsertOne {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.SetExpireAt(v)
+	})
+}
+
+// UpdateExpireAt sets the "expire_at" field to the value that was provided on create.
+func (u *DavAccountUpsertOne) UpdateExpireAt() *DavAccountUpsertOne {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.UpdateExpireAt()
+	})
+}
+
+// ClearExpireAt clears the value of the "expire_at" field.
+func (u *DavAccountUpsertOne) ClearExpireAt() *DavAccountUpsertOne {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.ClearExpireAt()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *DavAccountUpsertOne) SetLastUsedAt(v time.Time) *DavAccountUpsertOne {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *DavAccountUpsertOne) UpdateLastUsedAt() *DavAccountUpsertOne {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *DavAccountUpsertOne) ClearLastUsedAt() *DavAccountUpsertOne {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (u *DavAccountUpsertOne) SetDisabledAt(v time.Time) *DavAccountUpsertOne {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.SetDisabledAt(v)
+	})
+}
+
+// UpdateDisabledAt sets the "disabled_at" field to the value that was provided on create.
+func (u *DavAccountUpsertOne) UpdateDisabledAt() *DavAccountUpsertOne {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.UpdateDisabledAt()
+	})
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (u *DavAccountUpsertOne) ClearDisabledAt() *DavAccountUpsertOne {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.ClearDisabledAt()
+	})
+}
+
 // Exec executes the query.
 func (u *DavAccountUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -944,6 +1115,69 @@ func (u *DavAccountUpsertBulk) UpdateOwnerID() *DavAccountUpsertBulk {
 	})
 }
 
+// SetExpireAt sets the "expire_at" field.
+func (u *DavAccountUpsertBulk) SetExpireAt(v time.Time) *DavAccountUpsertBulk {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.SetExpireAt(v)
+	})
+}
+
+// UpdateExpireAt sets the "expire_at" field to the value that was provided on create.
+func (u *DavAccountUpsertBulk) UpdateExpireAt() *DavAccountUpsertBulk {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.UpdateExpireAt()
+	})
+}
+
+// ClearExpireAt clears the value of the "expire_at" field.
+func (u *DavAccountUpsertBulk) ClearExpireAt() *DavAccountUpsertBulk {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.ClearExpireAt()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *DavAccountUpsertBulk) SetLastUsedAt(v time.Time) *DavAccountUpsertBulk {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *DavAccountUpsertBulk) UpdateLastUsedAt() *DavAccountUpsertBulk {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *DavAccountUpsertBulk) ClearLastUsedAt() *DavAccountUpsertBulk {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (u *DavAccountUpsertBulk) SetDisabledAt(v time.Time) *DavAccountUpsertBulk {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.SetDisabledAt(v)
+	})
+}
+
+// UpdateDisabledAt sets the "disabled_at" field to the value that was provided on create.
+func (u *DavAccountUpsertBulk) UpdateDisabledAt() *DavAccountUpsertBulk {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.UpdateDisabledAt()
+	})
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (u *DavAccountUpsertBulk) ClearDisabledAt() *DavAccountUpsertBulk {
+	return u.Update(func(s *DavAccountUpsert) {
+		s.ClearDisabledAt()
+	})
+}
+
 // Exec executes the query.
 func (u *DavAccountUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -23,6 +23,8 @@ type DavAccountQuery struct {
 	inters     []Interceptor
 	predicates []predicate.DavAccount
 	withOwner  *UserQuery
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*DavAccount) error
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -382,6 +384,9 @@ func (daq *DavAccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(daq.modifiers) > 0 {
+		_spec.Modifiers = daq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -397,6 +402,11 @@ func (daq *DavAccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	for i := range daq.loadTotal {
+		if err := daq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -432,6 +442,9 @@ func (daq *DavAccountQuery) loadOwner(ctx context.Context, query *UserQuery, nod
 
 func (daq *DavAccountQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := daq.querySpec()
+	if len(daq.modifiers) > 0 {
+		_spec.Modifiers = daq.modifiers
+	}
 	_spec.Node.Columns = daq.ctx.Fields
 	if len(daq.ctx.Fields) > 0 {
 		_spec.Unique = daq.ctx.Unique != nil && *daq.ctx.Unique
@@ -131,6 +131,66 @@ func (dau *DavAccountUpdate) SetNillableOwnerID(i *int) *DavAccountUpdate {
 	return dau
 }
 
+// SetExpireAt sets the "expire_at" field.
+func (dau *DavAccountUpdate) SetExpireAt(t time.Time) *DavAccountUpdate {
+	dau.mutation.SetExpireAt(t)
+	return dau
+}
+
+// SetNillableExpireAt sets the "expire_at" field if the given value is not nil.
+func (dau *DavAccountUpdate) SetNillableExpireAt(t *time.Time) *DavAccountUpdate {
+	if t != nil {
+		dau.SetExpireAt(*t)
+	}
+	return dau
+}
+
+// ClearExpireAt clears the value of the "expire_at" field.
+func (dau *DavAccountUpdate) ClearExpireAt() *DavAccountUpdate {
+	dau.mutation.ClearExpireAt()
+	return dau
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (dau *DavAccountUpdate) SetLastUsedAt(t time.Time) *DavAccountUpdate {
+	dau.mutation.SetLastUsedAt(t)
+	return dau
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (dau *DavAccountUpdate) SetNillableLastUsedAt(t *time.Time) *DavAccountUpdate {
+	if t != nil {
+		dau.SetLastUsedAt(*t)
+	}
+	return dau
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (dau *DavAccountUpdate) ClearLastUsedAt() *DavAccountUpdate {
+	dau.mutation.ClearLastUsedAt()
+	return dau
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (dau *DavAccountUpdate) SetDisabledAt(t time.Time) *DavAccountUpdate {
+	dau.mutation.SetDisabledAt(t)
+	return dau
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (dau *DavAccountUpdate) SetNillableDisabledAt(t *time.Time) *DavAccountUpdate {
+	if t != nil {
+		dau.SetDisabledAt(*t)
+	}
+	return dau
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (dau *DavAccountUpdate) ClearDisabledAt() *DavAccountUpdate {
+	dau.mutation.ClearDisabledAt()
+	return dau
+}
+
 // SetOwner sets the "owner" edge to the User entity.
 func (dau *DavAccountUpdate) SetOwner(u *User) *DavAccountUpdate {
 	return dau.SetOwnerID(u.ID)
@@ -236,6 +296,24 @@ func (dau *DavAccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if dau.mutation.PropsCleared() {
 		_spec.ClearField(davaccount.FieldProps, field.TypeJSON)
 	}
+	if value, ok := dau.mutation.ExpireAt(); ok {
+		_spec.SetField(davaccount.FieldExpireAt, field.TypeTime, value)
+	}
+	if dau.mutation.ExpireAtCleared() {
+		_spec.ClearField(davaccount.FieldExpireAt, field.TypeTime)
+	}
+	if value, ok := dau.mutation.LastUsedAt(); ok {
+		_spec.SetField(davaccount.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if dau.mutation.LastUsedAtCleared() {
+		_spec.ClearField(davaccount.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := dau.mutation.DisabledAt(); ok {
+		_spec.SetField(davaccount.FieldDisabledAt, field.TypeTime, value)
+	}
+	if dau.mutation.DisabledAtCleared() {
+		_spec.ClearField(davaccount.FieldDisabledAt, field.TypeTime)
+	}
 	if dau.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -385,6 +463,66 @@ func (dauo *DavAccountUpdateOne) SetNillableOwnerID(i *int) *DavAccountUpdateOne
 	return dauo
 }
 
+// SetExpireAt sets the "expire_at" field.
+func (dauo *DavAccountUpdateOne) SetExpireAt(t time.Time) *DavAccountUpdateOne {
+	dauo.mutation.SetExpireAt(t)
+	return dauo
+}
+
+// SetNillableExpireAt sets the "expire_at" field if the given value is not nil.
+func (dauo *DavAccountUpdateOne) SetNillableExpireAt(t *time.Time) *DavAccountUpdateOne {
+	if t != nil {
+		dauo.SetExpireAt(*t)
+	}
+	return dauo
+}
+
+// ClearExpireAt clears the value of the "expire_at" field.
+func (dauo *DavAccountUpdateOne) ClearExpireAt() *DavAccountUpdateOne {
+	dauo.mutation.ClearExpireAt()
+	return dauo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (dauo *DavAccountUpdateOne) SetLastUsedAt(t time.Time) *DavAccountUpdateOne {
+	dauo.mutation.SetLastUsedAt(t)
+	return dauo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (dauo *DavAccountUpdateOne) SetNillableLastUsedAt(t *time.Time) *DavAccountUpdateOne {
+	if t != nil {
+		dauo.SetLastUsedAt(*t)
+	}
+	return dauo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (dauo *DavAccountUpdateOne) ClearLastUsedAt() *DavAccountUpdateOne {
+	dauo.mutation.ClearLastUsedAt()
+	return dauo
+}
+
+// SetDisabledAt sets the "disabled_at" field.
+func (dauo *DavAccountUpdateOne) SetDisabledAt(t time.Time) *DavAccountUpdateOne {
+	dauo.mutation.SetDisabledAt(t)
+	return dauo
+}
+
+// SetNillableDisabledAt sets the "disabled_at" field if the given value is not nil.
+func (dauo *DavAccountUpdateOne) SetNillableDisabledAt(t *time.Time) *DavAccountUpdateOne {
+	if t != nil {
+		dauo.SetDisabledAt(*t)
+	}
+	return dauo
+}
+
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (dauo *DavAccountUpdateOne) ClearDisabledAt() *DavAccountUpdateOne {
+	dauo.mutation.ClearDisabledAt()
+	return dauo
+}
+
 // SetOwner sets the "owner" edge to the User entity.
 func (dauo *DavAccountUpdateOne) SetOwner(u *User) *DavAccountUpdateOne {
 	return dauo.SetOwnerID(u.ID)
@@ -520,6 +658,24 @@ func (dauo *DavAccountUpdateOne) sqlSave(ctx context.Context) (_node *DavAccount
 	if dauo.mutation.PropsCleared() {
 		_spec.ClearField(davaccount.FieldProps, field.TypeJSON)
 	}
+	if value, ok := dauo.mutation.ExpireAt(); ok {
+		_spec.SetField(davaccount.FieldExpireAt, field.TypeTime, value)
+	}
+	if dauo.mutation.ExpireAtCleared() {
+		_spec.ClearField(davaccount.FieldExpireAt, field.TypeTime)
+	}
+	if value, ok := dauo.mutation.LastUsedAt(); ok {
+		_spec.SetField(davaccount.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if dauo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(davaccount.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := dauo.mutation.DisabledAt(); ok {
+		_spec.SetField(davaccount.FieldDisabledAt, field.TypeTime, value)
+	}
+	if dauo.mutation.DisabledAtCleared() {
+		_spec.ClearField(davaccount.FieldDisabledAt, field.TypeTime)
+	}
 	if dauo.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -23,6 +23,8 @@ type DirectLinkQuery struct {
 	inters     []Interceptor
 	predicates []predicate.DirectLink
 	withFile   *FileQuery
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*DirectLink) error
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -382,6 +384,9 @@ func (dlq *DirectLinkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(dlq.modifiers) > 0 {
+		_spec.Modifiers = dlq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -397,6 +402,11 @@ func (dlq *DirectLinkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	for i := range dlq.loadTotal {
+		if err := dlq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -432,6 +442,9 @@ func (dlq *DirectLinkQuery) loadFile(ctx context.Context, query *FileQuery, node
 
 func (dlq *DirectLinkQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := dlq.querySpec()
+	if len(dlq.modifiers) > 0 {
+		_spec.Modifiers = dlq.modifiers
+	}
 	_spec.Node.Columns = dlq.ctx.Fields
 	if len(dlq.ctx.Fields) > 0 {
 		_spec.Unique = dlq.ctx.Unique != nil && *dlq.ctx.Unique
@@ -12,19 +12,39 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/cloudreve/Cloudreve/v4/ent/accesstoken"
+	"github.com/cloudreve/Cloudreve/v4/ent/announcement"
+	"github.com/cloudreve/Cloudreve/v4/ent/auditlog"
+	"github.com/cloudreve/Cloudreve/v4/ent/automationrule"
+	"github.com/cloudreve/Cloudreve/v4/ent/checkout"
+	"github.com/cloudreve/Cloudreve/v4/ent/dailyactiveuser"
+	"github.com/cloudreve/Cloudreve/v4/ent/dailystat"
 	"github.com/cloudreve/Cloudreve/v4/ent/davaccount"
 	"github.com/cloudreve/Cloudreve/v4/ent/directlink"
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
+	"github.com/cloudreve/Cloudreve/v4/ent/invitation"
 	"github.com/cloudreve/Cloudreve/v4/ent/metadata"
+	"github.com/cloudreve/Cloudreve/v4/ent/moderationcase"
 	"github.com/cloudreve/Cloudreve/v4/ent/node"
+	"github.com/cloudreve/Cloudreve/v4/ent/notification"
+	"github.com/cloudreve/Cloudreve/v4/ent/organization"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
+	"github.com/cloudreve/Cloudreve/v4/ent/retentionrule"
+	"github.com/cloudreve/Cloudreve/v4/ent/rsssubscription"
+	"github.com/cloudreve/Cloudreve/v4/ent/s3accesskey"
+	"github.com/cloudreve/Cloudreve/v4/ent/savedsearch"
 	"github.com/cloudreve/Cloudreve/v4/ent/setting"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
 	"github.com/cloudreve/Cloudreve/v4/ent/storagepolicy"
+	"github.com/cloudreve/Cloudreve/v4/ent/syncjob"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/useremail"
+	"github.com/cloudreve/Cloudreve/v4/ent/viewpreference"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhook"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -85,19 +105,39 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			davaccount.Table:    davaccount.ValidColumn,
-			directlink.Table:    directlink.ValidColumn,
-			entity.Table:        entity.ValidColumn,
-			file.Table:          file.ValidColumn,
-			group.Table:         group.ValidColumn,
-			metadata.Table:      metadata.ValidColumn,
-			node.Table:          node.ValidColumn,
-			passkey.Table:       passkey.ValidColumn,
-			setting.Table:       setting.ValidColumn,
-			share.Table:         share.ValidColumn,
-			storagepolicy.Table: storagepolicy.ValidColumn,
-			task.Table:          task.ValidColumn,
-			user.Table:          user.ValidColumn,
+			accesstoken.Table:     accesstoken.ValidColumn,
+			announcement.Table:    announcement.ValidColumn,
+			auditlog.Table:        auditlog.ValidColumn,
+			automationrule.Table:  automationrule.ValidColumn,
+			checkout.Table:        checkout.ValidColumn,
+			dailyactiveuser.Table: dailyactiveuser.ValidColumn,
+			dailystat.Table:       dailystat.ValidColumn,
+			davaccount.Table:      davaccount.ValidColumn,
+			directlink.Table:      directlink.ValidColumn,
+			entity.Table:          entity.ValidColumn,
+			file.Table:            file.ValidColumn,
+			group.Table:           group.ValidColumn,
+			invitation.Table:      invitation.ValidColumn,
+			metadata.Table:        metadata.ValidColumn,
+			moderationcase.Table:  moderationcase.ValidColumn,
+			node.Table:            node.ValidColumn,
+			notification.Table:    notification.ValidColumn,
+			organization.Table:    organization.ValidColumn,
+			passkey.Table:         passkey.ValidColumn,
+			retentionrule.Table:   retentionrule.ValidColumn,
+			rsssubscription.Table: rsssubscription.ValidColumn,
+			s3accesskey.Table:     s3accesskey.ValidColumn,
+			savedsearch.Table:     savedsearch.ValidColumn,
+			setting.Table:         setting.ValidColumn,
+			share.Table:           share.ValidColumn,
+			storagepolicy.Table:   storagepolicy.ValidColumn,
+			syncjob.Table:         syncjob.ValidColumn,
+			task.Table:            task.ValidColumn,
+			user.Table:            user.ValidColumn,
+			useremail.Table:       useremail.ValidColumn,
+			viewpreference.Table:  viewpreference.ValidColumn,
+			webhook.Table:         webhook.ValidColumn,
+			webhookdelivery.Table: webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
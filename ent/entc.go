@@ -4,12 +4,25 @@ package main
 
 import (
 	"log"
+	"slices"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
 func main() {
+	ex, err := entgql.NewExtension(
+		entgql.WithSchemaGenerator(),
+		entgql.WithSchemaPath("../service/graph/ent.graphql"),
+		entgql.WithConfigPath("../service/graph/gqlgen.yml"),
+		entgql.WithSchemaHook(removeRelayNode, optionalPrivateFields),
+	)
+	if err != nil {
+		log.Fatal("creating entgql extension:", err)
+	}
+
 	if err := entc.Generate("./schema", &gen.Config{
 		Features: []gen.Feature{
 			gen.FeatureIntercept,
@@ -23,7 +36,33 @@ func main() {
 			gen.MustParse(gen.NewTemplate("mutation_helper").ParseFiles("templates/mutationhelper.tmpl")),
 			gen.MustParse(gen.NewTemplate("create_helper").ParseFiles("templates/createhelper.tmpl")),
 		},
-	}); err != nil {
+	}, entc.Extensions(ex)); err != nil {
 		log.Fatal("running ent codegen:", err)
 	}
 }
+
+// removeRelayNode removes the Relay Node interface and its query fields from the GraphQL schema. IDs in
+// GraphQL API are hash IDs of their own types, objects cannot be fetched by ID across types. Query
+// fields are defined in service/graph/schema.graphql.
+func removeRelayNode(_ *gen.Graph, s *ast.Schema) error {
+	delete(s.Types, entgql.RelayNode)
+	delete(s.Types, "Query")
+	for _, def := range s.Types {
+		def.Interfaces = slices.DeleteFunc(def.Interfaces, func(name string) bool { return name == entgql.RelayNode })
+	}
+	delete(s.PossibleTypes, entgql.RelayNode)
+	return nil
+}
+
+// optionalPrivateFields makes fields with @private directive nullable, they are null for users other than
+// the owner and admins.
+func optionalPrivateFields(_ *gen.Graph, s *ast.Schema) error {
+	for _, def := range s.Types {
+		for _, f := range def.Fields {
+			if f.Directives.ForName("private") != nil {
+				f.Type.NonNull = false
+			}
+		}
+	}
+	return nil
+}
@@ -61,6 +61,10 @@ type EntityEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [3]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedFile map[string][]*File
 }
 
 // FileOrErr returns the File value or an error if the edge
@@ -313,5 +317,29 @@ func (e *Entity) SetStoragePolicy(v *StoragePolicy) {
 	e.Edges.loadedTypes[2] = true
 }
 
+// NamedFile returns the File named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (e *Entity) NamedFile(name string) ([]*File, error) {
+	if e.Edges.namedFile == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := e.Edges.namedFile[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (e *Entity) appendNamedFile(name string, edges ...*File) {
+	if e.Edges.namedFile == nil {
+		e.Edges.namedFile = make(map[string][]*File)
+	}
+	if len(edges) == 0 {
+		e.Edges.namedFile[name] = []*File{}
+	} else {
+		e.Edges.namedFile[name] = append(e.Edges.namedFile[name], edges...)
+	}
+}
+
 // Entities is a parsable slice of Entity.
 type Entities []*Entity
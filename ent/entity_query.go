@@ -28,6 +28,9 @@ type EntityQuery struct {
 	withFile          *FileQuery
 	withUser          *UserQuery
 	withStoragePolicy *StoragePolicyQuery
+	modifiers         []func(*sql.Selector)
+	loadTotal         []func(context.Context, []*Entity) error
+	withNamedFile     map[string]*FileQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -457,6 +460,9 @@ func (eq *EntityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Entit
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(eq.modifiers) > 0 {
+		_spec.Modifiers = eq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -485,6 +491,18 @@ func (eq *EntityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Entit
 			return nil, err
 		}
 	}
+	for name, query := range eq.withNamedFile {
+		if err := eq.loadFile(ctx, query, nodes,
+			func(n *Entity) { n.appendNamedFile(name) },
+			func(n *Entity, e *File) { n.appendNamedFile(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range eq.loadTotal {
+		if err := eq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -610,6 +628,9 @@ func (eq *EntityQuery) loadStoragePolicy(ctx context.Context, query *StoragePoli
 
 func (eq *EntityQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := eq.querySpec()
+	if len(eq.modifiers) > 0 {
+		_spec.Modifiers = eq.modifiers
+	}
 	_spec.Node.Columns = eq.ctx.Fields
 	if len(eq.ctx.Fields) > 0 {
 		_spec.Unique = eq.ctx.Unique != nil && *eq.ctx.Unique
@@ -695,6 +716,20 @@ func (eq *EntityQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedFile tells the query-builder to eager-load the nodes that are connected to the "file"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (eq *EntityQuery) WithNamedFile(name string, opts ...func(*FileQuery)) *EntityQuery {
+	query := (&FileClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if eq.withNamedFile == nil {
+		eq.withNamedFile = make(map[string]*FileQuery)
+	}
+	eq.withNamedFile[name] = query
+	return eq
+}
+
 // EntityGroupBy is the group-by builder for Entity entities.
 type EntityGroupBy struct {
 	selector
@@ -72,6 +72,12 @@ type FileEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [8]bool
+
+	namedChildren    map[string][]*File
+	namedMetadata    map[string][]*Metadata
+	namedEntities    map[string][]*Entity
+	namedShares      map[string][]*Share
+	namedDirectLinks map[string][]*DirectLink
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -434,5 +440,125 @@ func (e *File) SetDirectLinks(v []*DirectLink) {
 	e.Edges.loadedTypes[7] = true
 }
 
+// NamedChildren returns the Children named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (f *File) NamedChildren(name string) ([]*File, error) {
+	if f.Edges.namedChildren == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := f.Edges.namedChildren[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (f *File) appendNamedChildren(name string, edges ...*File) {
+	if f.Edges.namedChildren == nil {
+		f.Edges.namedChildren = make(map[string][]*File)
+	}
+	if len(edges) == 0 {
+		f.Edges.namedChildren[name] = []*File{}
+	} else {
+		f.Edges.namedChildren[name] = append(f.Edges.namedChildren[name], edges...)
+	}
+}
+
+// NamedMetadata returns the Metadata named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (f *File) NamedMetadata(name string) ([]*Metadata, error) {
+	if f.Edges.namedMetadata == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := f.Edges.namedMetadata[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (f *File) appendNamedMetadata(name string, edges ...*Metadata) {
+	if f.Edges.namedMetadata == nil {
+		f.Edges.namedMetadata = make(map[string][]*Metadata)
+	}
+	if len(edges) == 0 {
+		f.Edges.namedMetadata[name] = []*Metadata{}
+	} else {
+		f.Edges.namedMetadata[name] = append(f.Edges.namedMetadata[name], edges...)
+	}
+}
+
+// NamedEntities returns the Entities named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (f *File) NamedEntities(name string) ([]*Entity, error) {
+	if f.Edges.namedEntities == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := f.Edges.namedEntities[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (f *File) appendNamedEntities(name string, edges ...*Entity) {
+	if f.Edges.namedEntities == nil {
+		f.Edges.namedEntities = make(map[string][]*Entity)
+	}
+	if len(edges) == 0 {
+		f.Edges.namedEntities[name] = []*Entity{}
+	} else {
+		f.Edges.namedEntities[name] = append(f.Edges.namedEntities[name], edges...)
+	}
+}
+
+// NamedShares returns the Shares named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (f *File) NamedShares(name string) ([]*Share, error) {
+	if f.Edges.namedShares == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := f.Edges.namedShares[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (f *File) appendNamedShares(name string, edges ...*Share) {
+	if f.Edges.namedShares == nil {
+		f.Edges.namedShares = make(map[string][]*Share)
+	}
+	if len(edges) == 0 {
+		f.Edges.namedShares[name] = []*Share{}
+	} else {
+		f.Edges.namedShares[name] = append(f.Edges.namedShares[name], edges...)
+	}
+}
+
+// NamedDirectLinks returns the DirectLinks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (f *File) NamedDirectLinks(name string) ([]*DirectLink, error) {
+	if f.Edges.namedDirectLinks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := f.Edges.namedDirectLinks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (f *File) appendNamedDirectLinks(name string, edges ...*DirectLink) {
+	if f.Edges.namedDirectLinks == nil {
+		f.Edges.namedDirectLinks = make(map[string][]*DirectLink)
+	}
+	if len(edges) == 0 {
+		f.Edges.namedDirectLinks[name] = []*DirectLink{}
+	} else {
+		f.Edges.namedDirectLinks[name] = append(f.Edges.namedDirectLinks[name], edges...)
+	}
+}
+
 // Files is a parsable slice of File.
 type Files []*File
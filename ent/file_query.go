@@ -24,18 +24,25 @@ import (
 // FileQuery is the builder for querying File entities.
 type FileQuery struct {
 	config
-	ctx                 *QueryContext
-	order               []file.OrderOption
-	inters              []Interceptor
-	predicates          []predicate.File
-	withOwner           *UserQuery
-	withStoragePolicies *StoragePolicyQuery
-	withParent          *FileQuery
-	withChildren        *FileQuery
-	withMetadata        *MetadataQuery
-	withEntities        *EntityQuery
-	withShares          *ShareQuery
-	withDirectLinks     *DirectLinkQuery
+	ctx                  *QueryContext
+	order                []file.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.File
+	withOwner            *UserQuery
+	withStoragePolicies  *StoragePolicyQuery
+	withParent           *FileQuery
+	withChildren         *FileQuery
+	withMetadata         *MetadataQuery
+	withEntities         *EntityQuery
+	withShares           *ShareQuery
+	withDirectLinks      *DirectLinkQuery
+	modifiers            []func(*sql.Selector)
+	loadTotal            []func(context.Context, []*File) error
+	withNamedChildren    map[string]*FileQuery
+	withNamedMetadata    map[string]*MetadataQuery
+	withNamedEntities    map[string]*EntityQuery
+	withNamedShares      map[string]*ShareQuery
+	withNamedDirectLinks map[string]*DirectLinkQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -640,6 +647,9 @@ func (fq *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(fq.modifiers) > 0 {
+		_spec.Modifiers = fq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -702,6 +712,46 @@ func (fq *FileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*File, e
 			return nil, err
 		}
 	}
+	for name, query := range fq.withNamedChildren {
+		if err := fq.loadChildren(ctx, query, nodes,
+			func(n *File) { n.appendNamedChildren(name) },
+			func(n *File, e *File) { n.appendNamedChildren(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range fq.withNamedMetadata {
+		if err := fq.loadMetadata(ctx, query, nodes,
+			func(n *File) { n.appendNamedMetadata(name) },
+			func(n *File, e *Metadata) { n.appendNamedMetadata(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range fq.withNamedEntities {
+		if err := fq.loadEntities(ctx, query, nodes,
+			func(n *File) { n.appendNamedEntities(name) },
+			func(n *File, e *Entity) { n.appendNamedEntities(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range fq.withNamedShares {
+		if err := fq.loadShares(ctx, query, nodes,
+			func(n *File) { n.appendNamedShares(name) },
+			func(n *File, e *Share) { n.appendNamedShares(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range fq.withNamedDirectLinks {
+		if err := fq.loadDirectLinks(ctx, query, nodes,
+			func(n *File) { n.appendNamedDirectLinks(name) },
+			func(n *File, e *DirectLink) { n.appendNamedDirectLinks(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range fq.loadTotal {
+		if err := fq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -977,6 +1027,9 @@ func (fq *FileQuery) loadDirectLinks(ctx context.Context, query *DirectLinkQuery
 
 func (fq *FileQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := fq.querySpec()
+	if len(fq.modifiers) > 0 {
+		_spec.Modifiers = fq.modifiers
+	}
 	_spec.Node.Columns = fq.ctx.Fields
 	if len(fq.ctx.Fields) > 0 {
 		_spec.Unique = fq.ctx.Unique != nil && *fq.ctx.Unique
@@ -1065,6 +1118,76 @@ func (fq *FileQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedChildren tells the query-builder to eager-load the nodes that are connected to the "children"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (fq *FileQuery) WithNamedChildren(name string, opts ...func(*FileQuery)) *FileQuery {
+	query := (&FileClient{config: fq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if fq.withNamedChildren == nil {
+		fq.withNamedChildren = make(map[string]*FileQuery)
+	}
+	fq.withNamedChildren[name] = query
+	return fq
+}
+
+// WithNamedMetadata tells the query-builder to eager-load the nodes that are connected to the "metadata"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (fq *FileQuery) WithNamedMetadata(name string, opts ...func(*MetadataQuery)) *FileQuery {
+	query := (&MetadataClient{config: fq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if fq.withNamedMetadata == nil {
+		fq.withNamedMetadata = make(map[string]*MetadataQuery)
+	}
+	fq.withNamedMetadata[name] = query
+	return fq
+}
+
+// WithNamedEntities tells the query-builder to eager-load the nodes that are connected to the "entities"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (fq *FileQuery) WithNamedEntities(name string, opts ...func(*EntityQuery)) *FileQuery {
+	query := (&EntityClient{config: fq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if fq.withNamedEntities == nil {
+		fq.withNamedEntities = make(map[string]*EntityQuery)
+	}
+	fq.withNamedEntities[name] = query
+	return fq
+}
+
+// WithNamedShares tells the query-builder to eager-load the nodes that are connected to the "shares"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (fq *FileQuery) WithNamedShares(name string, opts ...func(*ShareQuery)) *FileQuery {
+	query := (&ShareClient{config: fq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if fq.withNamedShares == nil {
+		fq.withNamedShares = make(map[string]*ShareQuery)
+	}
+	fq.withNamedShares[name] = query
+	return fq
+}
+
+// WithNamedDirectLinks tells the query-builder to eager-load the nodes that are connected to the "direct_links"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (fq *FileQuery) WithNamedDirectLinks(name string, opts ...func(*DirectLinkQuery)) *FileQuery {
+	query := (&DirectLinkClient{config: fq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if fq.withNamedDirectLinks == nil {
+		fq.withNamedDirectLinks = make(map[string]*DirectLinkQuery)
+	}
+	fq.withNamedDirectLinks[name] = query
+	return fq
+}
+
 // FileGroupBy is the group-by builder for File entities.
 type FileGroupBy struct {
 	selector
@@ -0,0 +1,31 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+)
+
+func (s *Share) User(ctx context.Context) (*User, error) {
+	result, err := s.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = s.QueryUser().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (s *Share) File(ctx context.Context) (*File, error) {
+	result, err := s.Edges.FileOrErr()
+	if IsNotLoaded(err) {
+		result, err = s.QueryFile().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (u *User) Group(ctx context.Context) (*Group, error) {
+	result, err := u.Edges.GroupOrErr()
+	if IsNotLoaded(err) {
+		result, err = u.QueryGroup().Only(ctx)
+	}
+	return result, err
+}
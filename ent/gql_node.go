@@ -0,0 +1,367 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/group"
+	"github.com/cloudreve/Cloudreve/v4/ent/share"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/hashicorp/go-multierror"
+	"golang.org/x/sync/semaphore"
+)
+
+// Noder wraps the basic Node method.
+type Noder interface {
+	IsNode()
+}
+
+var fileImplementors = []string{"File", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*File) IsNode() {}
+
+var groupImplementors = []string{"Group", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Group) IsNode() {}
+
+var shareImplementors = []string{"Share", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Share) IsNode() {}
+
+var taskImplementors = []string{"Task", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*Task) IsNode() {}
+
+var userImplementors = []string{"User", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*User) IsNode() {}
+
+var errNodeInvalidID = &NotFoundError{"node"}
+
+// NodeOption allows configuring the Noder execution using functional options.
+type NodeOption func(*nodeOptions)
+
+// WithNodeType sets the node Type resolver function (i.e. the table to query).
+// If was not provided, the table will be derived from the universal-id
+// configuration as described in: https://entgo.io/docs/migrate/#universal-ids.
+func WithNodeType(f func(context.Context, int) (string, error)) NodeOption {
+	return func(o *nodeOptions) {
+		o.nodeType = f
+	}
+}
+
+// WithFixedNodeType sets the Type of the node to a fixed value.
+func WithFixedNodeType(t string) NodeOption {
+	return WithNodeType(func(context.Context, int) (string, error) {
+		return t, nil
+	})
+}
+
+type nodeOptions struct {
+	nodeType func(context.Context, int) (string, error)
+}
+
+func (c *Client) newNodeOpts(opts []NodeOption) *nodeOptions {
+	nopts := &nodeOptions{}
+	for _, opt := range opts {
+		opt(nopts)
+	}
+	if nopts.nodeType == nil {
+		nopts.nodeType = func(ctx context.Context, id int) (string, error) {
+			return c.tables.nodeType(ctx, c.driver, id)
+		}
+	}
+	return nopts
+}
+
+// Noder returns a Node by its id. If the NodeType was not provided, it will
+// be derived from the id value according to the universal-id configuration.
+//
+//	c.Noder(ctx, id)
+//	c.Noder(ctx, id, ent.WithNodeType(typeResolver))
+func (c *Client) Noder(ctx context.Context, id int, opts ...NodeOption) (_ Noder, err error) {
+	defer func() {
+		if IsNotFound(err) {
+			err = multierror.Append(err, entgql.ErrNodeNotFound(id))
+		}
+	}()
+	table, err := c.newNodeOpts(opts).nodeType(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return c.noder(ctx, table, id)
+}
+
+func (c *Client) noder(ctx context.Context, table string, id int) (Noder, error) {
+	switch table {
+	case file.Table:
+		query := c.File.Query().
+			Where(file.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, fileImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case group.Table:
+		query := c.Group.Query().
+			Where(group.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, groupImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case share.Table:
+		query := c.Share.Query().
+			Where(share.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, shareImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case task.Table:
+		query := c.Task.Query().
+			Where(task.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, taskImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	case user.Table:
+		query := c.User.Query().
+			Where(user.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, userImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
+	default:
+		return nil, fmt.Errorf("cannot resolve noder from table %q: %w", table, errNodeInvalidID)
+	}
+}
+
+func (c *Client) Noders(ctx context.Context, ids []int, opts ...NodeOption) ([]Noder, error) {
+	switch len(ids) {
+	case 1:
+		noder, err := c.Noder(ctx, ids[0], opts...)
+		if err != nil {
+			return nil, err
+		}
+		return []Noder{noder}, nil
+	case 0:
+		return []Noder{}, nil
+	}
+
+	noders := make([]Noder, len(ids))
+	errors := make([]error, len(ids))
+	tables := make(map[string][]int)
+	id2idx := make(map[int][]int, len(ids))
+	nopts := c.newNodeOpts(opts)
+	for i, id := range ids {
+		table, err := nopts.nodeType(ctx, id)
+		if err != nil {
+			errors[i] = err
+			continue
+		}
+		tables[table] = append(tables[table], id)
+		id2idx[id] = append(id2idx[id], i)
+	}
+
+	for table, ids := range tables {
+		nodes, err := c.noders(ctx, table, ids)
+		if err != nil {
+			for _, id := range ids {
+				for _, idx := range id2idx[id] {
+					errors[idx] = err
+				}
+			}
+		} else {
+			for i, id := range ids {
+				for _, idx := range id2idx[id] {
+					noders[idx] = nodes[i]
+				}
+			}
+		}
+	}
+
+	for i, id := range ids {
+		if errors[i] == nil {
+			if noders[i] != nil {
+				continue
+			}
+			errors[i] = entgql.ErrNodeNotFound(id)
+		} else if IsNotFound(errors[i]) {
+			errors[i] = multierror.Append(errors[i], entgql.ErrNodeNotFound(id))
+		}
+		ctx := graphql.WithPathContext(ctx,
+			graphql.NewPathWithIndex(i),
+		)
+		graphql.AddError(ctx, errors[i])
+	}
+	return noders, nil
+}
+
+func (c *Client) noders(ctx context.Context, table string, ids []int) ([]Noder, error) {
+	noders := make([]Noder, len(ids))
+	idmap := make(map[int][]*Noder, len(ids))
+	for i, id := range ids {
+		idmap[id] = append(idmap[id], &noders[i])
+	}
+	switch table {
+	case file.Table:
+		query := c.File.Query().
+			Where(file.IDIn(ids...))
+		query, err := query.CollectFields(ctx, fileImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case group.Table:
+		query := c.Group.Query().
+			Where(group.IDIn(ids...))
+		query, err := query.CollectFields(ctx, groupImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case share.Table:
+		query := c.Share.Query().
+			Where(share.IDIn(ids...))
+		query, err := query.CollectFields(ctx, shareImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case task.Table:
+		query := c.Task.Query().
+			Where(task.IDIn(ids...))
+		query, err := query.CollectFields(ctx, taskImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	case user.Table:
+		query := c.User.Query().
+			Where(user.IDIn(ids...))
+		query, err := query.CollectFields(ctx, userImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
+	default:
+		return nil, fmt.Errorf("cannot resolve noders from table %q: %w", table, errNodeInvalidID)
+	}
+	return noders, nil
+}
+
+type tables struct {
+	once  sync.Once
+	sem   *semaphore.Weighted
+	value atomic.Value
+}
+
+func (t *tables) nodeType(ctx context.Context, drv dialect.Driver, id int) (string, error) {
+	tables, err := t.Load(ctx, drv)
+	if err != nil {
+		return "", err
+	}
+	idx := int(id / (1<<32 - 1))
+	if idx < 0 || idx >= len(tables) {
+		return "", fmt.Errorf("cannot resolve table from id %v: %w", id, errNodeInvalidID)
+	}
+	return tables[idx], nil
+}
+
+func (t *tables) Load(ctx context.Context, drv dialect.Driver) ([]string, error) {
+	if tables := t.value.Load(); tables != nil {
+		return tables.([]string), nil
+	}
+	t.once.Do(func() { t.sem = semaphore.NewWeighted(1) })
+	if err := t.sem.Acquire(ctx, 1); err != nil {
+		return nil, err
+	}
+	defer t.sem.Release(1)
+	if tables := t.value.Load(); tables != nil {
+		return tables.([]string), nil
+	}
+	tables, err := t.load(ctx, drv)
+	if err == nil {
+		t.value.Store(tables)
+	}
+	return tables, err
+}
+
+func (*tables) load(ctx context.Context, drv dialect.Driver) ([]string, error) {
+	rows := &sql.Rows{}
+	query, args := sql.Dialect(drv.Dialect()).
+		Select("type").
+		From(sql.Table(schema.TypeTable)).
+		OrderBy(sql.Asc("id")).
+		Query()
+	if err := drv.Query(ctx, query, args, rows); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var tables []string
+	return tables, sql.ScanSlice(rows, &tables)
+}
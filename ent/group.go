@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
+	"github.com/cloudreve/Cloudreve/v4/ent/organization"
 	"github.com/cloudreve/Cloudreve/v4/ent/storagepolicy"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
@@ -39,6 +40,8 @@ type Group struct {
 	Settings *types.GroupSetting `json:"settings,omitempty"`
 	// StoragePolicyID holds the value of the "storage_policy_id" field.
 	StoragePolicyID int `json:"storage_policy_id,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID int `json:"organization_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GroupQuery when eager-loading is set.
 	Edges        GroupEdges `json:"edges"`
@@ -51,9 +54,13 @@ type GroupEdges struct {
 	Users []*User `json:"users,omitempty"`
 	// StoragePolicies holds the value of the storage_policies edge.
 	StoragePolicies *StoragePolicy `json:"storage_policies,omitempty"`
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
+
+	namedUsers map[string][]*User
 }
 
 // UsersOrErr returns the Users value or an error if the edge
@@ -78,6 +85,19 @@ func (e GroupEdges) StoragePoliciesOrErr() (*StoragePolicy, error) {
 	return nil, &NotLoadedError{edge: "storage_policies"}
 }
 
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GroupEdges) OrganizationOrErr() (*Organization, error) {
+	if e.loadedTypes[2] {
+		if e.Organization == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: organization.Label}
+		}
+		return e.Organization, nil
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Group) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -87,7 +107,7 @@ func (*Group) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case group.FieldPermissions:
 			values[i] = new(boolset.BooleanSet)
-		case group.FieldID, group.FieldMaxStorage, group.FieldSpeedLimit, group.FieldStoragePolicyID:
+		case group.FieldID, group.FieldMaxStorage, group.FieldSpeedLimit, group.FieldStoragePolicyID, group.FieldOrganizationID:
 			values[i] = new(sql.NullInt64)
 		case group.FieldName:
 			values[i] = new(sql.NullString)
@@ -171,6 +191,12 @@ func (gr *Group) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				gr.StoragePolicyID = int(value.Int64)
 			}
+		case group.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				gr.OrganizationID = int(value.Int64)
+			}
 		default:
 			gr.selectValues.Set(columns[i], values[i])
 		}
@@ -194,6 +220,11 @@ func (gr *Group) QueryStoragePolicies() *StoragePolicyQuery {
 	return NewGroupClient(gr.config).QueryStoragePolicies(gr)
 }
 
+// QueryOrganization queries the "organization" edge of the Group entity.
+func (gr *Group) QueryOrganization() *OrganizationQuery {
+	return NewGroupClient(gr.config).QueryOrganization(gr)
+}
+
 // Update returns a builder for updating this Group.
 // Note that you need to call Group.Unwrap() before calling this method if this Group
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -245,6 +276,9 @@ func (gr *Group) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("storage_policy_id=")
 	builder.WriteString(fmt.Sprintf("%v", gr.StoragePolicyID))
+	builder.WriteString(", ")
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", gr.OrganizationID))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -261,5 +295,35 @@ func (e *Group) SetStoragePolicies(v *StoragePolicy) {
 	e.Edges.loadedTypes[1] = true
 }
 
+// SetOrganization manually set the edge as loaded state.
+func (e *Group) SetOrganization(v *Organization) {
+	e.Edges.Organization = v
+	e.Edges.loadedTypes[2] = true
+}
+
+// NamedUsers returns the Users named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (gr *Group) NamedUsers(name string) ([]*User, error) {
+	if gr.Edges.namedUsers == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := gr.Edges.namedUsers[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (gr *Group) appendNamedUsers(name string, edges ...*User) {
+	if gr.Edges.namedUsers == nil {
+		gr.Edges.namedUsers = make(map[string][]*User)
+	}
+	if len(edges) == 0 {
+		gr.Edges.namedUsers[name] = []*User{}
+	} else {
+		gr.Edges.namedUsers[name] = append(gr.Edges.namedUsers[name], edges...)
+	}
+}
+
 // Groups is a parsable slice of Group.
 type Groups []*Group
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
+	"github.com/cloudreve/Cloudreve/v4/ent/organization"
 	"github.com/cloudreve/Cloudreve/v4/ent/storagepolicy"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
@@ -128,6 +129,20 @@ func (gc *GroupCreate) SetNillableStoragePolicyID(i *int) *GroupCreate {
 	return gc
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (gc *GroupCreate) SetOrganizationID(i int) *GroupCreate {
+	gc.mutation.SetOrganizationID(i)
+	return gc
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (gc *GroupCreate) SetNillableOrganizationID(i *int) *GroupCreate {
+	if i != nil {
+		gc.SetOrganizationID(*i)
+	}
+	return gc
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (gc *GroupCreate) AddUserIDs(ids ...int) *GroupCreate {
 	gc.mutation.AddUserIDs(ids...)
@@ -162,6 +177,11 @@ func (gc *GroupCreate) SetStoragePolicies(s *StoragePolicy) *GroupCreate {
 	return gc.SetStoragePoliciesID(s.ID)
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (gc *GroupCreate) SetOrganization(o *Organization) *GroupCreate {
+	return gc.SetOrganizationID(o.ID)
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (gc *GroupCreate) Mutation() *GroupMutation {
 	return gc.mutation
@@ -333,6 +353,23 @@ func (gc *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		_node.StoragePolicyID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := gc.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   group.OrganizationTable,
+			Columns: []string{group.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -523,6 +560,24 @@ func (u *GroupUpsert) ClearStoragePolicyID() *GroupUpsert {
 	return u
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (u *GroupUpsert) SetOrganizationID(v int) *GroupUpsert {
+	u.Set(group.FieldOrganizationID, v)
+	return u
+}
+
+// UpdateOrganizationID sets the "organization_id" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateOrganizationID() *GroupUpsert {
+	u.SetExcluded(group.FieldOrganizationID)
+	return u
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (u *GroupUpsert) ClearOrganizationID() *GroupUpsert {
+	u.SetNull(group.FieldOrganizationID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -729,6 +784,27 @@ func (u *GroupUpsertOne) ClearStoragePolicyID() *GroupUpsertOne {
 	})
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (u *GroupUpsertOne) SetOrganizationID(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetOrganizationID(v)
+	})
+}
+
+// UpdateOrganizationID sets the "organization_id" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateOrganizationID() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateOrganizationID()
+	})
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (u *GroupUpsertOne) ClearOrganizationID() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearOrganizationID()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1106,6 +1182,27 @@ func (u *GroupUpsertBulk) ClearStoragePolicyID() *GroupUpsertBulk {
 	})
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (u *GroupUpsertBulk) SetOrganizationID(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetOrganizationID(v)
+	})
+}
+
+// UpdateOrganizationID sets the "organization_id" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateOrganizationID() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateOrganizationID()
+	})
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (u *GroupUpsertBulk) ClearOrganizationID() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearOrganizationID()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
+	"github.com/cloudreve/Cloudreve/v4/ent/organization"
 	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
 	"github.com/cloudreve/Cloudreve/v4/ent/storagepolicy"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
@@ -164,6 +165,26 @@ func (gu *GroupUpdate) ClearStoragePolicyID() *GroupUpdate {
 	return gu
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (gu *GroupUpdate) SetOrganizationID(i int) *GroupUpdate {
+	gu.mutation.SetOrganizationID(i)
+	return gu
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (gu *GroupUpdate) SetNillableOrganizationID(i *int) *GroupUpdate {
+	if i != nil {
+		gu.SetOrganizationID(*i)
+	}
+	return gu
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (gu *GroupUpdate) ClearOrganizationID() *GroupUpdate {
+	gu.mutation.ClearOrganizationID()
+	return gu
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (gu *GroupUpdate) AddUserIDs(ids ...int) *GroupUpdate {
 	gu.mutation.AddUserIDs(ids...)
@@ -198,6 +219,11 @@ func (gu *GroupUpdate) SetStoragePolicies(s *StoragePolicy) *GroupUpdate {
 	return gu.SetStoragePoliciesID(s.ID)
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (gu *GroupUpdate) SetOrganization(o *Organization) *GroupUpdate {
+	return gu.SetOrganizationID(o.ID)
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (gu *GroupUpdate) Mutation() *GroupMutation {
 	return gu.mutation
@@ -230,6 +256,12 @@ func (gu *GroupUpdate) ClearStoragePolicies() *GroupUpdate {
 	return gu
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (gu *GroupUpdate) ClearOrganization() *GroupUpdate {
+	gu.mutation.ClearOrganization()
+	return gu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (gu *GroupUpdate) Save(ctx context.Context) (int, error) {
 	if err := gu.defaults(); err != nil {
@@ -394,6 +426,35 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if gu.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   group.OrganizationTable,
+			Columns: []string{group.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   group.OrganizationTable,
+			Columns: []string{group.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{group.Label}
@@ -546,6 +607,26 @@ func (guo *GroupUpdateOne) ClearStoragePolicyID() *GroupUpdateOne {
 	return guo
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (guo *GroupUpdateOne) SetOrganizationID(i int) *GroupUpdateOne {
+	guo.mutation.SetOrganizationID(i)
+	return guo
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (guo *GroupUpdateOne) SetNillableOrganizationID(i *int) *GroupUpdateOne {
+	if i != nil {
+		guo.SetOrganizationID(*i)
+	}
+	return guo
+}
+
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (guo *GroupUpdateOne) ClearOrganizationID() *GroupUpdateOne {
+	guo.mutation.ClearOrganizationID()
+	return guo
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (guo *GroupUpdateOne) AddUserIDs(ids ...int) *GroupUpdateOne {
 	guo.mutation.AddUserIDs(ids...)
@@ -580,6 +661,11 @@ func (guo *GroupUpdateOne) SetStoragePolicies(s *StoragePolicy) *GroupUpdateOne
 	return guo.SetStoragePoliciesID(s.ID)
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (guo *GroupUpdateOne) SetOrganization(o *Organization) *GroupUpdateOne {
+	return guo.SetOrganizationID(o.ID)
+}
+
 // Mutation returns the GroupMutation object of the builder.
 func (guo *GroupUpdateOne) Mutation() *GroupMutation {
 	return guo.mutation
@@ -612,6 +698,12 @@ func (guo *GroupUpdateOne) ClearStoragePolicies() *GroupUpdateOne {
 	return guo
 }
 
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (guo *GroupUpdateOne) ClearOrganization() *GroupUpdateOne {
+	guo.mutation.ClearOrganization()
+	return guo
+}
+
 // Where appends a list predicates to the GroupUpdate builder.
 func (guo *GroupUpdateOne) Where(ps ...predicate.Group) *GroupUpdateOne {
 	guo.mutation.Where(ps...)
@@ -806,6 +898,35 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if guo.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   group.OrganizationTable,
+			Columns: []string{group.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   group.OrganizationTable,
+			Columns: []string{group.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Group{config: guo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
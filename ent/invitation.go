@@ -0,0 +1,312 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/invitation"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// Invitation is the model entity for the Invitation schema.
+type Invitation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// Group of users registered with this code, 0 means default group
+	GroupID int `json:"group_id,omitempty"`
+	// Max storage override of users registered with this code
+	MaxStorage *int64 `json:"max_storage,omitempty"`
+	// Maximum number of registrations, 0 means unlimited
+	MaxUses int `json:"max_uses,omitempty"`
+	// Uses holds the value of the "uses" field.
+	Uses int `json:"uses,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvitationQuery when eager-loading is set.
+	Edges        InvitationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// InvitationEdges holds the relations/edges for other nodes in the graph.
+type InvitationEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Invitees holds the value of the invitees edge.
+	Invitees []*User `json:"invitees,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedInvitees map[string][]*User
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e InvitationEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// InviteesOrErr returns the Invitees value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvitationEdges) InviteesOrErr() ([]*User, error) {
+	if e.loadedTypes[1] {
+		return e.Invitees, nil
+	}
+	return nil, &NotLoadedError{edge: "invitees"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Invitation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invitation.FieldID, invitation.FieldUserID, invitation.FieldGroupID, invitation.FieldMaxStorage, invitation.FieldMaxUses, invitation.FieldUses:
+			values[i] = new(sql.NullInt64)
+		case invitation.FieldCode, invitation.FieldNote:
+			values[i] = new(sql.NullString)
+		case invitation.FieldCreatedAt, invitation.FieldUpdatedAt, invitation.FieldDeletedAt, invitation.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Invitation fields.
+func (i *Invitation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for j := range columns {
+		switch columns[j] {
+		case invitation.FieldID:
+			value, ok := values[j].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			i.ID = int(value.Int64)
+		case invitation.FieldCreatedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[j])
+			} else if value.Valid {
+				i.CreatedAt = value.Time
+			}
+		case invitation.FieldUpdatedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[j])
+			} else if value.Valid {
+				i.UpdatedAt = value.Time
+			}
+		case invitation.FieldDeletedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[j])
+			} else if value.Valid {
+				i.DeletedAt = new(time.Time)
+				*i.DeletedAt = value.Time
+			}
+		case invitation.FieldUserID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[j])
+			} else if value.Valid {
+				i.UserID = int(value.Int64)
+			}
+		case invitation.FieldCode:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[j])
+			} else if value.Valid {
+				i.Code = value.String
+			}
+		case invitation.FieldNote:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[j])
+			} else if value.Valid {
+				i.Note = value.String
+			}
+		case invitation.FieldGroupID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[j])
+			} else if value.Valid {
+				i.GroupID = int(value.Int64)
+			}
+		case invitation.FieldMaxStorage:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_storage", values[j])
+			} else if value.Valid {
+				i.MaxStorage = new(int64)
+				*i.MaxStorage = value.Int64
+			}
+		case invitation.FieldMaxUses:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_uses", values[j])
+			} else if value.Valid {
+				i.MaxUses = int(value.Int64)
+			}
+		case invitation.FieldUses:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field uses", values[j])
+			} else if value.Valid {
+				i.Uses = int(value.Int64)
+			}
+		case invitation.FieldExpiresAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[j])
+			} else if value.Valid {
+				i.ExpiresAt = new(time.Time)
+				*i.ExpiresAt = value.Time
+			}
+		default:
+			i.selectValues.Set(columns[j], values[j])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Invitation.
+// This includes values selected through modifiers, order, etc.
+func (i *Invitation) Value(name string) (ent.Value, error) {
+	return i.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Invitation entity.
+func (i *Invitation) QueryUser() *UserQuery {
+	return NewInvitationClient(i.config).QueryUser(i)
+}
+
+// QueryInvitees queries the "invitees" edge of the Invitation entity.
+func (i *Invitation) QueryInvitees() *UserQuery {
+	return NewInvitationClient(i.config).QueryInvitees(i)
+}
+
+// Update returns a builder for updating this Invitation.
+// Note that you need to call Invitation.Unwrap() before calling this method if this Invitation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Invitation) Update() *InvitationUpdateOne {
+	return NewInvitationClient(i.config).UpdateOne(i)
+}
+
+// Unwrap unwraps the Invitation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (i *Invitation) Unwrap() *Invitation {
+	_tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Invitation is not a transactional entity")
+	}
+	i.config.driver = _tx.drv
+	return i
+}
+
+// String implements the fmt.Stringer.
+func (i *Invitation) String() string {
+	var builder strings.Builder
+	builder.WriteString("Invitation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", i.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(i.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(i.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := i.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(i.Code)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(i.Note)
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.GroupID))
+	builder.WriteString(", ")
+	if v := i.MaxStorage; v != nil {
+		builder.WriteString("max_storage=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("max_uses=")
+	builder.WriteString(fmt.Sprintf("%v", i.MaxUses))
+	builder.WriteString(", ")
+	builder.WriteString("uses=")
+	builder.WriteString(fmt.Sprintf("%v", i.Uses))
+	builder.WriteString(", ")
+	if v := i.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetUser manually set the edge as loaded state.
+func (e *Invitation) SetUser(v *User) {
+	e.Edges.User = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// SetInvitees manually set the edge as loaded state.
+func (e *Invitation) SetInvitees(v []*User) {
+	e.Edges.Invitees = v
+	e.Edges.loadedTypes[1] = true
+}
+
+// NamedInvitees returns the Invitees named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (i *Invitation) NamedInvitees(name string) ([]*User, error) {
+	if i.Edges.namedInvitees == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := i.Edges.namedInvitees[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (i *Invitation) appendNamedInvitees(name string, edges ...*User) {
+	if i.Edges.namedInvitees == nil {
+		i.Edges.namedInvitees = make(map[string][]*User)
+	}
+	if len(edges) == 0 {
+		i.Edges.namedInvitees[name] = []*User{}
+	} else {
+		i.Edges.namedInvitees[name] = append(i.Edges.namedInvitees[name], edges...)
+	}
+}
+
+// Invitations is a parsable slice of Invitation.
+type Invitations []*Invitation
@@ -0,0 +1,1290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/invitation"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// InvitationCreate is the builder for creating a Invitation entity.
+type InvitationCreate struct {
+	config
+	mutation *InvitationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ic *InvitationCreate) SetCreatedAt(t time.Time) *InvitationCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableCreatedAt(t *time.Time) *InvitationCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ic *InvitationCreate) SetUpdatedAt(t time.Time) *InvitationCreate {
+	ic.mutation.SetUpdatedAt(t)
+	return ic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableUpdatedAt(t *time.Time) *InvitationCreate {
+	if t != nil {
+		ic.SetUpdatedAt(*t)
+	}
+	return ic
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ic *InvitationCreate) SetDeletedAt(t time.Time) *InvitationCreate {
+	ic.mutation.SetDeletedAt(t)
+	return ic
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableDeletedAt(t *time.Time) *InvitationCreate {
+	if t != nil {
+		ic.SetDeletedAt(*t)
+	}
+	return ic
+}
+
+// SetUserID sets the "user_id" field.
+func (ic *InvitationCreate) SetUserID(i int) *InvitationCreate {
+	ic.mutation.SetUserID(i)
+	return ic
+}
+
+// SetCode sets the "code" field.
+func (ic *InvitationCreate) SetCode(s string) *InvitationCreate {
+	ic.mutation.SetCode(s)
+	return ic
+}
+
+// SetNote sets the "note" field.
+func (ic *InvitationCreate) SetNote(s string) *InvitationCreate {
+	ic.mutation.SetNote(s)
+	return ic
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableNote(s *string) *InvitationCreate {
+	if s != nil {
+		ic.SetNote(*s)
+	}
+	return ic
+}
+
+// SetGroupID sets the "group_id" field.
+func (ic *InvitationCreate) SetGroupID(i int) *InvitationCreate {
+	ic.mutation.SetGroupID(i)
+	return ic
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableGroupID(i *int) *InvitationCreate {
+	if i != nil {
+		ic.SetGroupID(*i)
+	}
+	return ic
+}
+
+// SetMaxStorage sets the "max_storage" field.
+func (ic *InvitationCreate) SetMaxStorage(i int64) *InvitationCreate {
+	ic.mutation.SetMaxStorage(i)
+	return ic
+}
+
+// SetNillableMaxStorage sets the "max_storage" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableMaxStorage(i *int64) *InvitationCreate {
+	if i != nil {
+		ic.SetMaxStorage(*i)
+	}
+	return ic
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (ic *InvitationCreate) SetMaxUses(i int) *InvitationCreate {
+	ic.mutation.SetMaxUses(i)
+	return ic
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableMaxUses(i *int) *InvitationCreate {
+	if i != nil {
+		ic.SetMaxUses(*i)
+	}
+	return ic
+}
+
+// SetUses sets the "uses" field.
+func (ic *InvitationCreate) SetUses(i int) *InvitationCreate {
+	ic.mutation.SetUses(i)
+	return ic
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableUses(i *int) *InvitationCreate {
+	if i != nil {
+		ic.SetUses(*i)
+	}
+	return ic
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ic *InvitationCreate) SetExpiresAt(t time.Time) *InvitationCreate {
+	ic.mutation.SetExpiresAt(t)
+	return ic
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ic *InvitationCreate) SetNillableExpiresAt(t *time.Time) *InvitationCreate {
+	if t != nil {
+		ic.SetExpiresAt(*t)
+	}
+	return ic
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ic *InvitationCreate) SetUser(u *User) *InvitationCreate {
+	return ic.SetUserID(u.ID)
+}
+
+// AddInviteeIDs adds the "invitees" edge to the User entity by IDs.
+func (ic *InvitationCreate) AddInviteeIDs(ids ...int) *InvitationCreate {
+	ic.mutation.AddInviteeIDs(ids...)
+	return ic
+}
+
+// AddInvitees adds the "invitees" edges to the User entity.
+func (ic *InvitationCreate) AddInvitees(u ...*User) *InvitationCreate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ic.AddInviteeIDs(ids...)
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (ic *InvitationCreate) Mutation() *InvitationMutation {
+	return ic.mutation
+}
+
+// Save creates the Invitation in the database.
+func (ic *InvitationCreate) Save(ctx context.Context) (*Invitation, error) {
+	if err := ic.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InvitationCreate) SaveX(ctx context.Context) *Invitation {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *InvitationCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *InvitationCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InvitationCreate) defaults() error {
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		if invitation.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized invitation.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := invitation.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		if invitation.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized invitation.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := invitation.DefaultUpdatedAt()
+		ic.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ic.mutation.MaxUses(); !ok {
+		v := invitation.DefaultMaxUses
+		ic.mutation.SetMaxUses(v)
+	}
+	if _, ok := ic.mutation.Uses(); !ok {
+		v := invitation.DefaultUses
+		ic.mutation.SetUses(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InvitationCreate) check() error {
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Invitation.created_at"`)}
+	}
+	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Invitation.updated_at"`)}
+	}
+	if _, ok := ic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Invitation.user_id"`)}
+	}
+	if _, ok := ic.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "Invitation.code"`)}
+	}
+	if _, ok := ic.mutation.MaxUses(); !ok {
+		return &ValidationError{Name: "max_uses", err: errors.New(`ent: missing required field "Invitation.max_uses"`)}
+	}
+	if _, ok := ic.mutation.Uses(); !ok {
+		return &ValidationError{Name: "uses", err: errors.New(`ent: missing required field "Invitation.uses"`)}
+	}
+	if _, ok := ic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Invitation.user"`)}
+	}
+	return nil
+}
+
+func (ic *InvitationCreate) sqlSave(ctx context.Context) (*Invitation, error) {
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ic.mutation.id = &_node.ID
+	ic.mutation.done = true
+	return _node, nil
+}
+
+func (ic *InvitationCreate) createSpec() (*Invitation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invitation{config: ic.config}
+		_spec = sqlgraph.NewCreateSpec(invitation.Table, sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt))
+	)
+
+	if id, ok := ic.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = ic.conflict
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.SetField(invitation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ic.mutation.UpdatedAt(); ok {
+		_spec.SetField(invitation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ic.mutation.DeletedAt(); ok {
+		_spec.SetField(invitation.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := ic.mutation.Code(); ok {
+		_spec.SetField(invitation.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := ic.mutation.Note(); ok {
+		_spec.SetField(invitation.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := ic.mutation.GroupID(); ok {
+		_spec.SetField(invitation.FieldGroupID, field.TypeInt, value)
+		_node.GroupID = value
+	}
+	if value, ok := ic.mutation.MaxStorage(); ok {
+		_spec.SetField(invitation.FieldMaxStorage, field.TypeInt64, value)
+		_node.MaxStorage = &value
+	}
+	if value, ok := ic.mutation.MaxUses(); ok {
+		_spec.SetField(invitation.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = value
+	}
+	if value, ok := ic.mutation.Uses(); ok {
+		_spec.SetField(invitation.FieldUses, field.TypeInt, value)
+		_node.Uses = value
+	}
+	if value, ok := ic.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if nodes := ic.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.UserTable,
+			Columns: []string{invitation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.InviteesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invitation.InviteesTable,
+			Columns: []string{invitation.InviteesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invitation.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvitationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ic *InvitationCreate) OnConflict(opts ...sql.ConflictOption) *InvitationUpsertOne {
+	ic.conflict = opts
+	return &InvitationUpsertOne{
+		create: ic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invitation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ic *InvitationCreate) OnConflictColumns(columns ...string) *InvitationUpsertOne {
+	ic.conflict = append(ic.conflict, sql.ConflictColumns(columns...))
+	return &InvitationUpsertOne{
+		create: ic,
+	}
+}
+
+type (
+	// InvitationUpsertOne is the builder for "upsert"-ing
+	//  one Invitation node.
+	InvitationUpsertOne struct {
+		create *InvitationCreate
+	}
+
+	// InvitationUpsert is the "OnConflict" setter.
+	InvitationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvitationUpsert) SetUpdatedAt(v time.Time) *InvitationUpsert {
+	u.Set(invitation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvitationUpsert) UpdateUpdatedAt() *InvitationUpsert {
+	u.SetExcluded(invitation.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InvitationUpsert) SetDeletedAt(v time.Time) *InvitationUpsert {
+	u.Set(invitation.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InvitationUpsert) UpdateDeletedAt() *InvitationUpsert {
+	u.SetExcluded(invitation.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InvitationUpsert) ClearDeletedAt() *InvitationUpsert {
+	u.SetNull(invitation.FieldDeletedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *InvitationUpsert) SetUserID(v int) *InvitationUpsert {
+	u.Set(invitation.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *InvitationUpsert) UpdateUserID() *InvitationUpsert {
+	u.SetExcluded(invitation.FieldUserID)
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *InvitationUpsert) SetCode(v string) *InvitationUpsert {
+	u.Set(invitation.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *InvitationUpsert) UpdateCode() *InvitationUpsert {
+	u.SetExcluded(invitation.FieldCode)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *InvitationUpsert) SetNote(v string) *InvitationUpsert {
+	u.Set(invitation.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *InvitationUpsert) UpdateNote() *InvitationUpsert {
+	u.SetExcluded(invitation.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *InvitationUpsert) ClearNote() *InvitationUpsert {
+	u.SetNull(invitation.FieldNote)
+	return u
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *InvitationUpsert) SetGroupID(v int) *InvitationUpsert {
+	u.Set(invitation.FieldGroupID, v)
+	return u
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *InvitationUpsert) UpdateGroupID() *InvitationUpsert {
+	u.SetExcluded(invitation.FieldGroupID)
+	return u
+}
+
+// AddGroupID adds v to the "group_id" field.
+func (u *InvitationUpsert) AddGroupID(v int) *InvitationUpsert {
+	u.Add(invitation.FieldGroupID, v)
+	return u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (u *InvitationUpsert) ClearGroupID() *InvitationUpsert {
+	u.SetNull(invitation.FieldGroupID)
+	return u
+}
+
+// SetMaxStorage sets the "max_storage" field.
+func (u *InvitationUpsert) SetMaxStorage(v int64) *InvitationUpsert {
+	u.Set(invitation.FieldMaxStorage, v)
+	return u
+}
+
+// UpdateMaxStorage sets the "max_storage" field to the value that was provided on create.
+func (u *InvitationUpsert) UpdateMaxStorage() *InvitationUpsert {
+	u.SetExcluded(invitation.FieldMaxStorage)
+	return u
+}
+
+// AddMaxStorage adds v to the "max_storage" field.
+func (u *InvitationUpsert) AddMaxStorage(v int64) *InvitationUpsert {
+	u.Add(invitation.FieldMaxStorage, v)
+	return u
+}
+
+// ClearMaxStorage clears the value of the "max_storage" field.
+func (u *InvitationUpsert) ClearMaxStorage() *InvitationUpsert {
+	u.SetNull(invitation.FieldMaxStorage)
+	return u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *InvitationUpsert) SetMaxUses(v int) *InvitationUpsert {
+	u.Set(invitation.FieldMaxUses, v)
+	return u
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *InvitationUpsert) UpdateMaxUses() *InvitationUpsert {
+	u.SetExcluded(invitation.FieldMaxUses)
+	return u
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *InvitationUpsert) AddMaxUses(v int) *InvitationUpsert {
+	u.Add(invitation.FieldMaxUses, v)
+	return u
+}
+
+// SetUses sets the "uses" field.
+func (u *InvitationUpsert) SetUses(v int) *InvitationUpsert {
+	u.Set(invitation.FieldUses, v)
+	return u
+}
+
+// UpdateUses sets the "uses" field to the value that was provided on create.
+func (u *InvitationUpsert) UpdateUses() *InvitationUpsert {
+	u.SetExcluded(invitation.FieldUses)
+	return u
+}
+
+// AddUses adds v to the "uses" field.
+func (u *InvitationUpsert) AddUses(v int) *InvitationUpsert {
+	u.Add(invitation.FieldUses, v)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *InvitationUpsert) SetExpiresAt(v time.Time) *InvitationUpsert {
+	u.Set(invitation.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InvitationUpsert) UpdateExpiresAt() *InvitationUpsert {
+	u.SetExcluded(invitation.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *InvitationUpsert) ClearExpiresAt() *InvitationUpsert {
+	u.SetNull(invitation.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Invitation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InvitationUpsertOne) UpdateNewValues() *InvitationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(invitation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invitation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvitationUpsertOne) Ignore() *InvitationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvitationUpsertOne) DoNothing() *InvitationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvitationCreate.OnConflict
+// documentation for more info.
+func (u *InvitationUpsertOne) Update(set func(*InvitationUpsert)) *InvitationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvitationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvitationUpsertOne) SetUpdatedAt(v time.Time) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvitationUpsertOne) UpdateUpdatedAt() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InvitationUpsertOne) SetDeletedAt(v time.Time) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InvitationUpsertOne) UpdateDeletedAt() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InvitationUpsertOne) ClearDeletedAt() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *InvitationUpsertOne) SetUserID(v int) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *InvitationUpsertOne) UpdateUserID() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *InvitationUpsertOne) SetCode(v string) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *InvitationUpsertOne) UpdateCode() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *InvitationUpsertOne) SetNote(v string) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *InvitationUpsertOne) UpdateNote() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *InvitationUpsertOne) ClearNote() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *InvitationUpsertOne) SetGroupID(v int) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// AddGroupID adds v to the "group_id" field.
+func (u *InvitationUpsertOne) AddGroupID(v int) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.AddGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *InvitationUpsertOne) UpdateGroupID() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (u *InvitationUpsertOne) ClearGroupID() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearGroupID()
+	})
+}
+
+// SetMaxStorage sets the "max_storage" field.
+func (u *InvitationUpsertOne) SetMaxStorage(v int64) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetMaxStorage(v)
+	})
+}
+
+// AddMaxStorage adds v to the "max_storage" field.
+func (u *InvitationUpsertOne) AddMaxStorage(v int64) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.AddMaxStorage(v)
+	})
+}
+
+// UpdateMaxStorage sets the "max_storage" field to the value that was provided on create.
+func (u *InvitationUpsertOne) UpdateMaxStorage() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateMaxStorage()
+	})
+}
+
+// ClearMaxStorage clears the value of the "max_storage" field.
+func (u *InvitationUpsertOne) ClearMaxStorage() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearMaxStorage()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *InvitationUpsertOne) SetMaxUses(v int) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *InvitationUpsertOne) AddMaxUses(v int) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *InvitationUpsertOne) UpdateMaxUses() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// SetUses sets the "uses" field.
+func (u *InvitationUpsertOne) SetUses(v int) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetUses(v)
+	})
+}
+
+// AddUses adds v to the "uses" field.
+func (u *InvitationUpsertOne) AddUses(v int) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.AddUses(v)
+	})
+}
+
+// UpdateUses sets the "uses" field to the value that was provided on create.
+func (u *InvitationUpsertOne) UpdateUses() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateUses()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *InvitationUpsertOne) SetExpiresAt(v time.Time) *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InvitationUpsertOne) UpdateExpiresAt() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *InvitationUpsertOne) ClearExpiresAt() *InvitationUpsertOne {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InvitationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvitationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvitationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvitationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvitationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *InvitationCreate) SetRawID(t int) *InvitationCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// InvitationCreateBulk is the builder for creating many Invitation entities in bulk.
+type InvitationCreateBulk struct {
+	config
+	err      error
+	builders []*InvitationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Invitation entities in the database.
+func (icb *InvitationCreateBulk) Save(ctx context.Context) ([]*Invitation, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Invitation, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvitationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = icb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InvitationCreateBulk) SaveX(ctx context.Context) []*Invitation {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *InvitationCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *InvitationCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invitation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvitationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (icb *InvitationCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvitationUpsertBulk {
+	icb.conflict = opts
+	return &InvitationUpsertBulk{
+		create: icb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invitation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (icb *InvitationCreateBulk) OnConflictColumns(columns ...string) *InvitationUpsertBulk {
+	icb.conflict = append(icb.conflict, sql.ConflictColumns(columns...))
+	return &InvitationUpsertBulk{
+		create: icb,
+	}
+}
+
+// InvitationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Invitation nodes.
+type InvitationUpsertBulk struct {
+	create *InvitationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Invitation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InvitationUpsertBulk) UpdateNewValues() *InvitationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(invitation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invitation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvitationUpsertBulk) Ignore() *InvitationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvitationUpsertBulk) DoNothing() *InvitationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvitationCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvitationUpsertBulk) Update(set func(*InvitationUpsert)) *InvitationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvitationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InvitationUpsertBulk) SetUpdatedAt(v time.Time) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InvitationUpsertBulk) UpdateUpdatedAt() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InvitationUpsertBulk) SetDeletedAt(v time.Time) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InvitationUpsertBulk) UpdateDeletedAt() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InvitationUpsertBulk) ClearDeletedAt() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *InvitationUpsertBulk) SetUserID(v int) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *InvitationUpsertBulk) UpdateUserID() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *InvitationUpsertBulk) SetCode(v string) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *InvitationUpsertBulk) UpdateCode() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *InvitationUpsertBulk) SetNote(v string) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *InvitationUpsertBulk) UpdateNote() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *InvitationUpsertBulk) ClearNote() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *InvitationUpsertBulk) SetGroupID(v int) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// AddGroupID adds v to the "group_id" field.
+func (u *InvitationUpsertBulk) AddGroupID(v int) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.AddGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *InvitationUpsertBulk) UpdateGroupID() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (u *InvitationUpsertBulk) ClearGroupID() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearGroupID()
+	})
+}
+
+// SetMaxStorage sets the "max_storage" field.
+func (u *InvitationUpsertBulk) SetMaxStorage(v int64) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetMaxStorage(v)
+	})
+}
+
+// AddMaxStorage adds v to the "max_storage" field.
+func (u *InvitationUpsertBulk) AddMaxStorage(v int64) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.AddMaxStorage(v)
+	})
+}
+
+// UpdateMaxStorage sets the "max_storage" field to the value that was provided on create.
+func (u *InvitationUpsertBulk) UpdateMaxStorage() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateMaxStorage()
+	})
+}
+
+// ClearMaxStorage clears the value of the "max_storage" field.
+func (u *InvitationUpsertBulk) ClearMaxStorage() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearMaxStorage()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *InvitationUpsertBulk) SetMaxUses(v int) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *InvitationUpsertBulk) AddMaxUses(v int) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *InvitationUpsertBulk) UpdateMaxUses() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// SetUses sets the "uses" field.
+func (u *InvitationUpsertBulk) SetUses(v int) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetUses(v)
+	})
+}
+
+// AddUses adds v to the "uses" field.
+func (u *InvitationUpsertBulk) AddUses(v int) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.AddUses(v)
+	})
+}
+
+// UpdateUses sets the "uses" field to the value that was provided on create.
+func (u *InvitationUpsertBulk) UpdateUses() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateUses()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *InvitationUpsertBulk) SetExpiresAt(v time.Time) *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *InvitationUpsertBulk) UpdateExpiresAt() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *InvitationUpsertBulk) ClearExpiresAt() *InvitationUpsertBulk {
+	return u.Update(func(s *InvitationUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InvitationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InvitationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvitationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvitationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
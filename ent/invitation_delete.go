@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/invitation"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// InvitationDelete is the builder for deleting a Invitation entity.
+type InvitationDelete struct {
+	config
+	hooks    []Hook
+	mutation *InvitationMutation
+}
+
+// Where appends a list predicates to the InvitationDelete builder.
+func (id *InvitationDelete) Where(ps ...predicate.Invitation) *InvitationDelete {
+	id.mutation.Where(ps...)
+	return id
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (id *InvitationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, id.sqlExec, id.mutation, id.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (id *InvitationDelete) ExecX(ctx context.Context) int {
+	n, err := id.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (id *InvitationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(invitation.Table, sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt))
+	if ps := id.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, id.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	id.mutation.done = true
+	return affected, err
+}
+
+// InvitationDeleteOne is the builder for deleting a single Invitation entity.
+type InvitationDeleteOne struct {
+	id *InvitationDelete
+}
+
+// Where appends a list predicates to the InvitationDelete builder.
+func (ido *InvitationDeleteOne) Where(ps ...predicate.Invitation) *InvitationDeleteOne {
+	ido.id.mutation.Where(ps...)
+	return ido
+}
+
+// Exec executes the deletion query.
+func (ido *InvitationDeleteOne) Exec(ctx context.Context) error {
+	n, err := ido.id.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{invitation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ido *InvitationDeleteOne) ExecX(ctx context.Context) {
+	if err := ido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,959 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/invitation"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// InvitationUpdate is the builder for updating Invitation entities.
+type InvitationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvitationMutation
+}
+
+// Where appends a list predicates to the InvitationUpdate builder.
+func (iu *InvitationUpdate) Where(ps ...predicate.Invitation) *InvitationUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iu *InvitationUpdate) SetUpdatedAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetUpdatedAt(t)
+	return iu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (iu *InvitationUpdate) SetDeletedAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetDeletedAt(t)
+	return iu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableDeletedAt(t *time.Time) *InvitationUpdate {
+	if t != nil {
+		iu.SetDeletedAt(*t)
+	}
+	return iu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iu *InvitationUpdate) ClearDeletedAt() *InvitationUpdate {
+	iu.mutation.ClearDeletedAt()
+	return iu
+}
+
+// SetUserID sets the "user_id" field.
+func (iu *InvitationUpdate) SetUserID(i int) *InvitationUpdate {
+	iu.mutation.SetUserID(i)
+	return iu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableUserID(i *int) *InvitationUpdate {
+	if i != nil {
+		iu.SetUserID(*i)
+	}
+	return iu
+}
+
+// SetCode sets the "code" field.
+func (iu *InvitationUpdate) SetCode(s string) *InvitationUpdate {
+	iu.mutation.SetCode(s)
+	return iu
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableCode(s *string) *InvitationUpdate {
+	if s != nil {
+		iu.SetCode(*s)
+	}
+	return iu
+}
+
+// SetNote sets the "note" field.
+func (iu *InvitationUpdate) SetNote(s string) *InvitationUpdate {
+	iu.mutation.SetNote(s)
+	return iu
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableNote(s *string) *InvitationUpdate {
+	if s != nil {
+		iu.SetNote(*s)
+	}
+	return iu
+}
+
+// ClearNote clears the value of the "note" field.
+func (iu *InvitationUpdate) ClearNote() *InvitationUpdate {
+	iu.mutation.ClearNote()
+	return iu
+}
+
+// SetGroupID sets the "group_id" field.
+func (iu *InvitationUpdate) SetGroupID(i int) *InvitationUpdate {
+	iu.mutation.ResetGroupID()
+	iu.mutation.SetGroupID(i)
+	return iu
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableGroupID(i *int) *InvitationUpdate {
+	if i != nil {
+		iu.SetGroupID(*i)
+	}
+	return iu
+}
+
+// AddGroupID adds i to the "group_id" field.
+func (iu *InvitationUpdate) AddGroupID(i int) *InvitationUpdate {
+	iu.mutation.AddGroupID(i)
+	return iu
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (iu *InvitationUpdate) ClearGroupID() *InvitationUpdate {
+	iu.mutation.ClearGroupID()
+	return iu
+}
+
+// SetMaxStorage sets the "max_storage" field.
+func (iu *InvitationUpdate) SetMaxStorage(i int64) *InvitationUpdate {
+	iu.mutation.ResetMaxStorage()
+	iu.mutation.SetMaxStorage(i)
+	return iu
+}
+
+// SetNillableMaxStorage sets the "max_storage" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableMaxStorage(i *int64) *InvitationUpdate {
+	if i != nil {
+		iu.SetMaxStorage(*i)
+	}
+	return iu
+}
+
+// AddMaxStorage adds i to the "max_storage" field.
+func (iu *InvitationUpdate) AddMaxStorage(i int64) *InvitationUpdate {
+	iu.mutation.AddMaxStorage(i)
+	return iu
+}
+
+// ClearMaxStorage clears the value of the "max_storage" field.
+func (iu *InvitationUpdate) ClearMaxStorage() *InvitationUpdate {
+	iu.mutation.ClearMaxStorage()
+	return iu
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (iu *InvitationUpdate) SetMaxUses(i int) *InvitationUpdate {
+	iu.mutation.ResetMaxUses()
+	iu.mutation.SetMaxUses(i)
+	return iu
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableMaxUses(i *int) *InvitationUpdate {
+	if i != nil {
+		iu.SetMaxUses(*i)
+	}
+	return iu
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (iu *InvitationUpdate) AddMaxUses(i int) *InvitationUpdate {
+	iu.mutation.AddMaxUses(i)
+	return iu
+}
+
+// SetUses sets the "uses" field.
+func (iu *InvitationUpdate) SetUses(i int) *InvitationUpdate {
+	iu.mutation.ResetUses()
+	iu.mutation.SetUses(i)
+	return iu
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableUses(i *int) *InvitationUpdate {
+	if i != nil {
+		iu.SetUses(*i)
+	}
+	return iu
+}
+
+// AddUses adds i to the "uses" field.
+func (iu *InvitationUpdate) AddUses(i int) *InvitationUpdate {
+	iu.mutation.AddUses(i)
+	return iu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iu *InvitationUpdate) SetExpiresAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetExpiresAt(t)
+	return iu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableExpiresAt(t *time.Time) *InvitationUpdate {
+	if t != nil {
+		iu.SetExpiresAt(*t)
+	}
+	return iu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (iu *InvitationUpdate) ClearExpiresAt() *InvitationUpdate {
+	iu.mutation.ClearExpiresAt()
+	return iu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (iu *InvitationUpdate) SetUser(u *User) *InvitationUpdate {
+	return iu.SetUserID(u.ID)
+}
+
+// AddInviteeIDs adds the "invitees" edge to the User entity by IDs.
+func (iu *InvitationUpdate) AddInviteeIDs(ids ...int) *InvitationUpdate {
+	iu.mutation.AddInviteeIDs(ids...)
+	return iu
+}
+
+// AddInvitees adds the "invitees" edges to the User entity.
+func (iu *InvitationUpdate) AddInvitees(u ...*User) *InvitationUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return iu.AddInviteeIDs(ids...)
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (iu *InvitationUpdate) Mutation() *InvitationMutation {
+	return iu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (iu *InvitationUpdate) ClearUser() *InvitationUpdate {
+	iu.mutation.ClearUser()
+	return iu
+}
+
+// ClearInvitees clears all "invitees" edges to the User entity.
+func (iu *InvitationUpdate) ClearInvitees() *InvitationUpdate {
+	iu.mutation.ClearInvitees()
+	return iu
+}
+
+// RemoveInviteeIDs removes the "invitees" edge to User entities by IDs.
+func (iu *InvitationUpdate) RemoveInviteeIDs(ids ...int) *InvitationUpdate {
+	iu.mutation.RemoveInviteeIDs(ids...)
+	return iu
+}
+
+// RemoveInvitees removes "invitees" edges to User entities.
+func (iu *InvitationUpdate) RemoveInvitees(u ...*User) *InvitationUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return iu.RemoveInviteeIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InvitationUpdate) Save(ctx context.Context) (int, error) {
+	if err := iu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InvitationUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InvitationUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iu *InvitationUpdate) defaults() error {
+	if _, ok := iu.mutation.UpdatedAt(); !ok {
+		if invitation.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized invitation.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := invitation.UpdateDefaultUpdatedAt()
+		iu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InvitationUpdate) check() error {
+	if _, ok := iu.mutation.UserID(); iu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Invitation.user"`)
+	}
+	return nil
+}
+
+func (iu *InvitationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invitation.Table, invitation.Columns, sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt))
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.UpdatedAt(); ok {
+		_spec.SetField(invitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := iu.mutation.DeletedAt(); ok {
+		_spec.SetField(invitation.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iu.mutation.DeletedAtCleared() {
+		_spec.ClearField(invitation.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.Code(); ok {
+		_spec.SetField(invitation.FieldCode, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.Note(); ok {
+		_spec.SetField(invitation.FieldNote, field.TypeString, value)
+	}
+	if iu.mutation.NoteCleared() {
+		_spec.ClearField(invitation.FieldNote, field.TypeString)
+	}
+	if value, ok := iu.mutation.GroupID(); ok {
+		_spec.SetField(invitation.FieldGroupID, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedGroupID(); ok {
+		_spec.AddField(invitation.FieldGroupID, field.TypeInt, value)
+	}
+	if iu.mutation.GroupIDCleared() {
+		_spec.ClearField(invitation.FieldGroupID, field.TypeInt)
+	}
+	if value, ok := iu.mutation.MaxStorage(); ok {
+		_spec.SetField(invitation.FieldMaxStorage, field.TypeInt64, value)
+	}
+	if value, ok := iu.mutation.AddedMaxStorage(); ok {
+		_spec.AddField(invitation.FieldMaxStorage, field.TypeInt64, value)
+	}
+	if iu.mutation.MaxStorageCleared() {
+		_spec.ClearField(invitation.FieldMaxStorage, field.TypeInt64)
+	}
+	if value, ok := iu.mutation.MaxUses(); ok {
+		_spec.SetField(invitation.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invitation.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.Uses(); ok {
+		_spec.SetField(invitation.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedUses(); ok {
+		_spec.AddField(invitation.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if iu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(invitation.FieldExpiresAt, field.TypeTime)
+	}
+	if iu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.UserTable,
+			Columns: []string{invitation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.UserTable,
+			Columns: []string{invitation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.InviteesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invitation.InviteesTable,
+			Columns: []string{invitation.InviteesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedInviteesIDs(); len(nodes) > 0 && !iu.mutation.InviteesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invitation.InviteesTable,
+			Columns: []string{invitation.InviteesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.InviteesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invitation.InviteesTable,
+			Columns: []string{invitation.InviteesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// InvitationUpdateOne is the builder for updating a single Invitation entity.
+type InvitationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvitationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iuo *InvitationUpdateOne) SetUpdatedAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetUpdatedAt(t)
+	return iuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (iuo *InvitationUpdateOne) SetDeletedAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetDeletedAt(t)
+	return iuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableDeletedAt(t *time.Time) *InvitationUpdateOne {
+	if t != nil {
+		iuo.SetDeletedAt(*t)
+	}
+	return iuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iuo *InvitationUpdateOne) ClearDeletedAt() *InvitationUpdateOne {
+	iuo.mutation.ClearDeletedAt()
+	return iuo
+}
+
+// SetUserID sets the "user_id" field.
+func (iuo *InvitationUpdateOne) SetUserID(i int) *InvitationUpdateOne {
+	iuo.mutation.SetUserID(i)
+	return iuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableUserID(i *int) *InvitationUpdateOne {
+	if i != nil {
+		iuo.SetUserID(*i)
+	}
+	return iuo
+}
+
+// SetCode sets the "code" field.
+func (iuo *InvitationUpdateOne) SetCode(s string) *InvitationUpdateOne {
+	iuo.mutation.SetCode(s)
+	return iuo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableCode(s *string) *InvitationUpdateOne {
+	if s != nil {
+		iuo.SetCode(*s)
+	}
+	return iuo
+}
+
+// SetNote sets the "note" field.
+func (iuo *InvitationUpdateOne) SetNote(s string) *InvitationUpdateOne {
+	iuo.mutation.SetNote(s)
+	return iuo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableNote(s *string) *InvitationUpdateOne {
+	if s != nil {
+		iuo.SetNote(*s)
+	}
+	return iuo
+}
+
+// ClearNote clears the value of the "note" field.
+func (iuo *InvitationUpdateOne) ClearNote() *InvitationUpdateOne {
+	iuo.mutation.ClearNote()
+	return iuo
+}
+
+// SetGroupID sets the "group_id" field.
+func (iuo *InvitationUpdateOne) SetGroupID(i int) *InvitationUpdateOne {
+	iuo.mutation.ResetGroupID()
+	iuo.mutation.SetGroupID(i)
+	return iuo
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableGroupID(i *int) *InvitationUpdateOne {
+	if i != nil {
+		iuo.SetGroupID(*i)
+	}
+	return iuo
+}
+
+// AddGroupID adds i to the "group_id" field.
+func (iuo *InvitationUpdateOne) AddGroupID(i int) *InvitationUpdateOne {
+	iuo.mutation.AddGroupID(i)
+	return iuo
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (iuo *InvitationUpdateOne) ClearGroupID() *InvitationUpdateOne {
+	iuo.mutation.ClearGroupID()
+	return iuo
+}
+
+// SetMaxStorage sets the "max_storage" field.
+func (iuo *InvitationUpdateOne) SetMaxStorage(i int64) *InvitationUpdateOne {
+	iuo.mutation.ResetMaxStorage()
+	iuo.mutation.SetMaxStorage(i)
+	return iuo
+}
+
+// SetNillableMaxStorage sets the "max_storage" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableMaxStorage(i *int64) *InvitationUpdateOne {
+	if i != nil {
+		iuo.SetMaxStorage(*i)
+	}
+	return iuo
+}
+
+// AddMaxStorage adds i to the "max_storage" field.
+func (iuo *InvitationUpdateOne) AddMaxStorage(i int64) *InvitationUpdateOne {
+	iuo.mutation.AddMaxStorage(i)
+	return iuo
+}
+
+// ClearMaxStorage clears the value of the "max_storage" field.
+func (iuo *InvitationUpdateOne) ClearMaxStorage() *InvitationUpdateOne {
+	iuo.mutation.ClearMaxStorage()
+	return iuo
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (iuo *InvitationUpdateOne) SetMaxUses(i int) *InvitationUpdateOne {
+	iuo.mutation.ResetMaxUses()
+	iuo.mutation.SetMaxUses(i)
+	return iuo
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableMaxUses(i *int) *InvitationUpdateOne {
+	if i != nil {
+		iuo.SetMaxUses(*i)
+	}
+	return iuo
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (iuo *InvitationUpdateOne) AddMaxUses(i int) *InvitationUpdateOne {
+	iuo.mutation.AddMaxUses(i)
+	return iuo
+}
+
+// SetUses sets the "uses" field.
+func (iuo *InvitationUpdateOne) SetUses(i int) *InvitationUpdateOne {
+	iuo.mutation.ResetUses()
+	iuo.mutation.SetUses(i)
+	return iuo
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableUses(i *int) *InvitationUpdateOne {
+	if i != nil {
+		iuo.SetUses(*i)
+	}
+	return iuo
+}
+
+// AddUses adds i to the "uses" field.
+func (iuo *InvitationUpdateOne) AddUses(i int) *InvitationUpdateOne {
+	iuo.mutation.AddUses(i)
+	return iuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iuo *InvitationUpdateOne) SetExpiresAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetExpiresAt(t)
+	return iuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableExpiresAt(t *time.Time) *InvitationUpdateOne {
+	if t != nil {
+		iuo.SetExpiresAt(*t)
+	}
+	return iuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (iuo *InvitationUpdateOne) ClearExpiresAt() *InvitationUpdateOne {
+	iuo.mutation.ClearExpiresAt()
+	return iuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (iuo *InvitationUpdateOne) SetUser(u *User) *InvitationUpdateOne {
+	return iuo.SetUserID(u.ID)
+}
+
+// AddInviteeIDs adds the "invitees" edge to the User entity by IDs.
+func (iuo *InvitationUpdateOne) AddInviteeIDs(ids ...int) *InvitationUpdateOne {
+	iuo.mutation.AddInviteeIDs(ids...)
+	return iuo
+}
+
+// AddInvitees adds the "invitees" edges to the User entity.
+func (iuo *InvitationUpdateOne) AddInvitees(u ...*User) *InvitationUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return iuo.AddInviteeIDs(ids...)
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (iuo *InvitationUpdateOne) Mutation() *InvitationMutation {
+	return iuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (iuo *InvitationUpdateOne) ClearUser() *InvitationUpdateOne {
+	iuo.mutation.ClearUser()
+	return iuo
+}
+
+// ClearInvitees clears all "invitees" edges to the User entity.
+func (iuo *InvitationUpdateOne) ClearInvitees() *InvitationUpdateOne {
+	iuo.mutation.ClearInvitees()
+	return iuo
+}
+
+// RemoveInviteeIDs removes the "invitees" edge to User entities by IDs.
+func (iuo *InvitationUpdateOne) RemoveInviteeIDs(ids ...int) *InvitationUpdateOne {
+	iuo.mutation.RemoveInviteeIDs(ids...)
+	return iuo
+}
+
+// RemoveInvitees removes "invitees" edges to User entities.
+func (iuo *InvitationUpdateOne) RemoveInvitees(u ...*User) *InvitationUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return iuo.RemoveInviteeIDs(ids...)
+}
+
+// Where appends a list predicates to the InvitationUpdate builder.
+func (iuo *InvitationUpdateOne) Where(ps ...predicate.Invitation) *InvitationUpdateOne {
+	iuo.mutation.Where(ps...)
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InvitationUpdateOne) Select(field string, fields ...string) *InvitationUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Invitation entity.
+func (iuo *InvitationUpdateOne) Save(ctx context.Context) (*Invitation, error) {
+	if err := iuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InvitationUpdateOne) SaveX(ctx context.Context) *Invitation {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iuo *InvitationUpdateOne) defaults() error {
+	if _, ok := iuo.mutation.UpdatedAt(); !ok {
+		if invitation.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized invitation.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := invitation.UpdateDefaultUpdatedAt()
+		iuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InvitationUpdateOne) check() error {
+	if _, ok := iuo.mutation.UserID(); iuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Invitation.user"`)
+	}
+	return nil
+}
+
+func (iuo *InvitationUpdateOne) sqlSave(ctx context.Context) (_node *Invitation, err error) {
+	if err := iuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invitation.Table, invitation.Columns, sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt))
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Invitation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invitation.FieldID)
+		for _, f := range fields {
+			if !invitation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(invitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := iuo.mutation.DeletedAt(); ok {
+		_spec.SetField(invitation.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(invitation.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.Code(); ok {
+		_spec.SetField(invitation.FieldCode, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.Note(); ok {
+		_spec.SetField(invitation.FieldNote, field.TypeString, value)
+	}
+	if iuo.mutation.NoteCleared() {
+		_spec.ClearField(invitation.FieldNote, field.TypeString)
+	}
+	if value, ok := iuo.mutation.GroupID(); ok {
+		_spec.SetField(invitation.FieldGroupID, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedGroupID(); ok {
+		_spec.AddField(invitation.FieldGroupID, field.TypeInt, value)
+	}
+	if iuo.mutation.GroupIDCleared() {
+		_spec.ClearField(invitation.FieldGroupID, field.TypeInt)
+	}
+	if value, ok := iuo.mutation.MaxStorage(); ok {
+		_spec.SetField(invitation.FieldMaxStorage, field.TypeInt64, value)
+	}
+	if value, ok := iuo.mutation.AddedMaxStorage(); ok {
+		_spec.AddField(invitation.FieldMaxStorage, field.TypeInt64, value)
+	}
+	if iuo.mutation.MaxStorageCleared() {
+		_spec.ClearField(invitation.FieldMaxStorage, field.TypeInt64)
+	}
+	if value, ok := iuo.mutation.MaxUses(); ok {
+		_spec.SetField(invitation.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedMaxUses(); ok {
+		_spec.AddField(invitation.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.Uses(); ok {
+		_spec.SetField(invitation.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedUses(); ok {
+		_spec.AddField(invitation.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if iuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(invitation.FieldExpiresAt, field.TypeTime)
+	}
+	if iuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.UserTable,
+			Columns: []string{invitation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.UserTable,
+			Columns: []string{invitation.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.InviteesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invitation.InviteesTable,
+			Columns: []string{invitation.InviteesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedInviteesIDs(); len(nodes) > 0 && !iuo.mutation.InviteesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invitation.InviteesTable,
+			Columns: []string{invitation.InviteesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.InviteesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   invitation.InviteesTable,
+			Columns: []string{invitation.InviteesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Invitation{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuo.mutation.done = true
+	return _node, nil
+}
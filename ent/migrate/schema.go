@@ -8,6 +8,220 @@ import (
 )
 
 var (
+	// AccessTokensColumns holds the columns for the "access_tokens" table.
+	AccessTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "token_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "digest", Type: field.TypeString},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// AccessTokensTable holds the schema information for the "access_tokens" table.
+	AccessTokensTable = &schema.Table{
+		Name:       "access_tokens",
+		Columns:    AccessTokensColumns,
+		PrimaryKey: []*schema.Column{AccessTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "access_tokens_users_access_tokens",
+				Columns:    []*schema.Column{AccessTokensColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accesstoken_user_id_token_id",
+				Unique:  true,
+				Columns: []*schema.Column{AccessTokensColumns[10], AccessTokensColumns[4]},
+			},
+		},
+	}
+	// AnnouncementsColumns holds the columns for the "announcements" table.
+	AnnouncementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "title", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "groups", Type: field.TypeJSON, Nullable: true},
+		{Name: "starts_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "ends_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "dismissible", Type: field.TypeBool, Default: false},
+	}
+	// AnnouncementsTable holds the schema information for the "announcements" table.
+	AnnouncementsTable = &schema.Table{
+		Name:       "announcements",
+		Columns:    AnnouncementsColumns,
+		PrimaryKey: []*schema.Column{AnnouncementsColumns[0]},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "type", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+		{Name: "actor_id", Type: field.TypeInt, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "correlation_id", Type: field.TypeString, Nullable: true},
+		{Name: "entity_type", Type: field.TypeString, Nullable: true},
+		{Name: "entity_id", Type: field.TypeInt, Nullable: true},
+		{Name: "content", Type: field.TypeJSON, Nullable: true},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_actor_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[6]},
+			},
+			{
+				Name:    "auditlog_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[5]},
+			},
+		},
+	}
+	// AutomationRulesColumns holds the columns for the "automation_rules" table.
+	AutomationRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "trigger", Type: field.TypeString},
+		{Name: "conditions", Type: field.TypeJSON, Nullable: true},
+		{Name: "actions", Type: field.TypeJSON},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "last_triggered_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// AutomationRulesTable holds the schema information for the "automation_rules" table.
+	AutomationRulesTable = &schema.Table{
+		Name:       "automation_rules",
+		Columns:    AutomationRulesColumns,
+		PrimaryKey: []*schema.Column{AutomationRulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "automation_rules_users_automation_rules",
+				Columns:    []*schema.Column{AutomationRulesColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "automationrule_user_id_trigger",
+				Unique:  false,
+				Columns: []*schema.Column{AutomationRulesColumns[11], AutomationRulesColumns[5]},
+			},
+		},
+	}
+	// CheckoutsColumns holds the columns for the "checkouts" table.
+	CheckoutsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "ns", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString, Size: 2147483647},
+		{Name: "file_type", Type: field.TypeInt},
+		{Name: "zero_depth", Type: field.TypeBool, Default: true},
+		{Name: "expire_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "owner_id", Type: field.TypeInt},
+	}
+	// CheckoutsTable holds the schema information for the "checkouts" table.
+	CheckoutsTable = &schema.Table{
+		Name:       "checkouts",
+		Columns:    CheckoutsColumns,
+		PrimaryKey: []*schema.Column{CheckoutsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "checkouts_users_checkouts",
+				Columns:    []*schema.Column{CheckoutsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "checkout_ns",
+				Unique:  false,
+				Columns: []*schema.Column{CheckoutsColumns[5]},
+			},
+			{
+				Name:    "checkout_owner_id",
+				Unique:  false,
+				Columns: []*schema.Column{CheckoutsColumns[10]},
+			},
+		},
+	}
+	// DailyActiveUsersColumns holds the columns for the "daily_active_users" table.
+	DailyActiveUsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "date", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// DailyActiveUsersTable holds the schema information for the "daily_active_users" table.
+	DailyActiveUsersTable = &schema.Table{
+		Name:       "daily_active_users",
+		Columns:    DailyActiveUsersColumns,
+		PrimaryKey: []*schema.Column{DailyActiveUsersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dailyactiveuser_date_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{DailyActiveUsersColumns[4], DailyActiveUsersColumns[5]},
+			},
+		},
+	}
+	// DailyStatsColumns holds the columns for the "daily_stats" table.
+	DailyStatsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "date", Type: field.TypeString, Unique: true},
+		{Name: "active_user_count", Type: field.TypeInt, Default: 0},
+		{Name: "uploads", Type: field.TypeInt, Default: 0},
+		{Name: "upload_size", Type: field.TypeInt64, Default: 0},
+		{Name: "downloads", Type: field.TypeInt, Default: 0},
+		{Name: "download_size", Type: field.TypeInt64, Default: 0},
+		{Name: "file_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "policy_storage", Type: field.TypeJSON, Nullable: true},
+		{Name: "group_storage", Type: field.TypeJSON, Nullable: true},
+		{Name: "top_users", Type: field.TypeJSON, Nullable: true},
+	}
+	// DailyStatsTable holds the schema information for the "daily_stats" table.
+	DailyStatsTable = &schema.Table{
+		Name:       "daily_stats",
+		Columns:    DailyStatsColumns,
+		PrimaryKey: []*schema.Column{DailyStatsColumns[0]},
+	}
 	// DavAccountsColumns holds the columns for the "dav_accounts" table.
 	DavAccountsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -19,6 +233,9 @@ var (
 		{Name: "password", Type: field.TypeString},
 		{Name: "options", Type: field.TypeBytes},
 		{Name: "props", Type: field.TypeJSON, Nullable: true},
+		{Name: "expire_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "disabled_at", Type: field.TypeTime, Nullable: true},
 		{Name: "owner_id", Type: field.TypeInt},
 	}
 	// DavAccountsTable holds the schema information for the "dav_accounts" table.
@@ -29,7 +246,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "dav_accounts_users_dav_accounts",
-				Columns:    []*schema.Column{DavAccountsColumns[9]},
+				Columns:    []*schema.Column{DavAccountsColumns[12]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -38,7 +255,7 @@ var (
 			{
 				Name:    "davaccount_owner_id_password",
 				Unique:  true,
-				Columns: []*schema.Column{DavAccountsColumns[9], DavAccountsColumns[6]},
+				Columns: []*schema.Column{DavAccountsColumns[12], DavAccountsColumns[6]},
 			},
 		},
 	}
@@ -159,6 +376,16 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{FilesColumns[10], FilesColumns[4], FilesColumns[6]},
 			},
+			{
+				Name:    "file_file_children_type_name",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[10], FilesColumns[4], FilesColumns[5]},
+			},
+			{
+				Name:    "file_owner_id_is_symbolic",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[12], FilesColumns[8]},
+			},
 		},
 	}
 	// GroupsColumns holds the columns for the "groups" table.
@@ -172,6 +399,7 @@ var (
 		{Name: "speed_limit", Type: field.TypeInt, Nullable: true},
 		{Name: "permissions", Type: field.TypeBytes},
 		{Name: "settings", Type: field.TypeJSON, Nullable: true},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
 		{Name: "storage_policy_id", Type: field.TypeInt, Nullable: true},
 	}
 	// GroupsTable holds the schema information for the "groups" table.
@@ -181,13 +409,48 @@ var (
 		PrimaryKey: []*schema.Column{GroupsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "groups_storage_policies_groups",
+				Symbol:     "groups_organizations_groups",
 				Columns:    []*schema.Column{GroupsColumns[9]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "groups_storage_policies_groups",
+				Columns:    []*schema.Column{GroupsColumns[10]},
 				RefColumns: []*schema.Column{StoragePoliciesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// InvitationsColumns holds the columns for the "invitations" table.
+	InvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "group_id", Type: field.TypeInt, Nullable: true},
+		{Name: "max_storage", Type: field.TypeInt64, Nullable: true},
+		{Name: "max_uses", Type: field.TypeInt, Default: 0},
+		{Name: "uses", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// InvitationsTable holds the schema information for the "invitations" table.
+	InvitationsTable = &schema.Table{
+		Name:       "invitations",
+		Columns:    InvitationsColumns,
+		PrimaryKey: []*schema.Column{InvitationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invitations_users_invitations",
+				Columns:    []*schema.Column{InvitationsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// MetadataColumns holds the columns for the "metadata" table.
 	MetadataColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -220,6 +483,46 @@ var (
 			},
 		},
 	}
+	// ModerationCasesColumns holds the columns for the "moderation_cases" table.
+	ModerationCasesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "share_id", Type: field.TypeInt, Nullable: true},
+		{Name: "file_id", Type: field.TypeInt},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"quarantined", "approved", "rejected"}, Default: "quarantined"},
+		{Name: "matches", Type: field.TypeJSON, Nullable: true},
+		{Name: "reviewed_by", Type: field.TypeInt, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ModerationCasesTable holds the schema information for the "moderation_cases" table.
+	ModerationCasesTable = &schema.Table{
+		Name:       "moderation_cases",
+		Columns:    ModerationCasesColumns,
+		PrimaryKey: []*schema.Column{ModerationCasesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "moderation_cases_users_moderation_cases",
+				Columns:    []*schema.Column{ModerationCasesColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "moderationcase_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ModerationCasesColumns[10]},
+			},
+			{
+				Name:    "moderationcase_status",
+				Unique:  false,
+				Columns: []*schema.Column{ModerationCasesColumns[6]},
+			},
+		},
+	}
 	// NodesColumns holds the columns for the "nodes" table.
 	NodesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -241,6 +544,54 @@ var (
 		Columns:    NodesColumns,
 		PrimaryKey: []*schema.Column{NodesColumns[0]},
 	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "type", Type: field.TypeString},
+		{Name: "props", Type: field.TypeJSON, Nullable: true},
+		{Name: "link", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notifications_users_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_user_id_read_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[8], NotificationsColumns[7]},
+			},
+		},
+	}
+	// OrganizationsColumns holds the columns for the "organizations" table.
+	OrganizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "branding", Type: field.TypeJSON, Nullable: true},
+	}
+	// OrganizationsTable holds the schema information for the "organizations" table.
+	OrganizationsTable = &schema.Table{
+		Name:       "organizations",
+		Columns:    OrganizationsColumns,
+		PrimaryKey: []*schema.Column{OrganizationsColumns[0]},
+	}
 	// PasskeysColumns holds the columns for the "passkeys" table.
 	PasskeysColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -274,6 +625,163 @@ var (
 			},
 		},
 	}
+	// RetentionRulesColumns holds the columns for the "retention_rules" table.
+	RetentionRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "folder", Type: field.TypeString, Size: 2147483647},
+		{Name: "recursive", Type: field.TypeBool, Default: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"trash", "delete"}, Default: "trash"},
+		{Name: "max_age_days", Type: field.TypeInt, Default: 0},
+		{Name: "keep_versions", Type: field.TypeInt, Default: 0},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "last_result", Type: field.TypeJSON, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// RetentionRulesTable holds the schema information for the "retention_rules" table.
+	RetentionRulesTable = &schema.Table{
+		Name:       "retention_rules",
+		Columns:    RetentionRulesColumns,
+		PrimaryKey: []*schema.Column{RetentionRulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "retention_rules_users_retention_rules",
+				Columns:    []*schema.Column{RetentionRulesColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "retentionrule_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{RetentionRulesColumns[12]},
+			},
+		},
+	}
+	// RssSubscriptionsColumns holds the columns for the "rss_subscriptions" table.
+	RssSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString, Size: 2147483647},
+		{Name: "include", Type: field.TypeString, Nullable: true},
+		{Name: "exclude", Type: field.TypeString, Nullable: true},
+		{Name: "dst", Type: field.TypeString, Size: 2147483647},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "seen", Type: field.TypeJSON, Nullable: true},
+		{Name: "last_checked_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// RssSubscriptionsTable holds the schema information for the "rss_subscriptions" table.
+	RssSubscriptionsTable = &schema.Table{
+		Name:       "rss_subscriptions",
+		Columns:    RssSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{RssSubscriptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "rss_subscriptions_users_rss_subscriptions",
+				Columns:    []*schema.Column{RssSubscriptionsColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rsssubscription_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{RssSubscriptionsColumns[13]},
+			},
+			{
+				Name:    "rsssubscription_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{RssSubscriptionsColumns[9]},
+			},
+		},
+	}
+	// S3accessKeysColumns holds the columns for the "s3access_keys" table.
+	S3accessKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "access_key_id", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "read_only", Type: field.TypeBool, Default: false},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// S3accessKeysTable holds the schema information for the "s3access_keys" table.
+	S3accessKeysTable = &schema.Table{
+		Name:       "s3access_keys",
+		Columns:    S3accessKeysColumns,
+		PrimaryKey: []*schema.Column{S3accessKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "s3access_keys_users_s3_access_keys",
+				Columns:    []*schema.Column{S3accessKeysColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "s3accesskey_access_key_id",
+				Unique:  true,
+				Columns: []*schema.Column{S3accessKeysColumns[5]},
+			},
+			{
+				Name:    "s3accesskey_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{S3accessKeysColumns[9]},
+			},
+		},
+	}
+	// SavedSearchesColumns holds the columns for the "saved_searches" table.
+	SavedSearchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "uri", Type: field.TypeString, Size: 2147483647},
+		{Name: "notify", Type: field.TypeBool, Default: false},
+		{Name: "last_checked_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// SavedSearchesTable holds the schema information for the "saved_searches" table.
+	SavedSearchesTable = &schema.Table{
+		Name:       "saved_searches",
+		Columns:    SavedSearchesColumns,
+		PrimaryKey: []*schema.Column{SavedSearchesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "saved_searches_users_saved_searches",
+				Columns:    []*schema.Column{SavedSearchesColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "savedsearch_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SavedSearchesColumns[8]},
+			},
+			{
+				Name:    "savedsearch_notify",
+				Unique:  false,
+				Columns: []*schema.Column{SavedSearchesColumns[6]},
+			},
+		},
+	}
 	// SettingsColumns holds the columns for the "settings" table.
 	SettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -300,6 +808,8 @@ var (
 		{Name: "downloads", Type: field.TypeInt, Default: 0},
 		{Name: "expires", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
 		{Name: "remain_downloads", Type: field.TypeInt, Nullable: true},
+		{Name: "quarantined", Type: field.TypeBool, Default: false},
+		{Name: "recipients", Type: field.TypeJSON, Nullable: true},
 		{Name: "file_shares", Type: field.TypeInt, Nullable: true},
 		{Name: "user_shares", Type: field.TypeInt, Nullable: true},
 	}
@@ -311,17 +821,24 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "shares_files_shares",
-				Columns:    []*schema.Column{SharesColumns[9]},
+				Columns:    []*schema.Column{SharesColumns[11]},
 				RefColumns: []*schema.Column{FilesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "shares_users_shares",
-				Columns:    []*schema.Column{SharesColumns[10]},
+				Columns:    []*schema.Column{SharesColumns[12]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "share_file_shares",
+				Unique:  false,
+				Columns: []*schema.Column{SharesColumns[11]},
+			},
+		},
 	}
 	// StoragePoliciesColumns holds the columns for the "storage_policies" table.
 	StoragePoliciesColumns = []*schema.Column{
@@ -356,6 +873,51 @@ var (
 			},
 		},
 	}
+	// SyncJobsColumns holds the columns for the "sync_jobs" table.
+	SyncJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "src", Type: field.TypeString, Size: 2147483647},
+		{Name: "dst", Type: field.TypeString, Size: 2147483647},
+		{Name: "interval", Type: field.TypeInt},
+		{Name: "compare", Type: field.TypeEnum, Enums: []string{"mtime", "hash"}, Default: "mtime"},
+		{Name: "propagate_delete", Type: field.TypeBool, Default: false},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "next_run_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "task_id", Type: field.TypeInt, Nullable: true},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "last_report", Type: field.TypeJSON, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// SyncJobsTable holds the schema information for the "sync_jobs" table.
+	SyncJobsTable = &schema.Table{
+		Name:       "sync_jobs",
+		Columns:    SyncJobsColumns,
+		PrimaryKey: []*schema.Column{SyncJobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sync_jobs_users_sync_jobs",
+				Columns:    []*schema.Column{SyncJobsColumns[15]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "syncjob_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SyncJobsColumns[15]},
+			},
+			{
+				Name:    "syncjob_enabled_next_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{SyncJobsColumns[10], SyncJobsColumns[11]},
+			},
+		},
+	}
 	// TasksColumns holds the columns for the "tasks" table.
 	TasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -367,6 +929,8 @@ var (
 		{Name: "public_state", Type: field.TypeJSON},
 		{Name: "private_state", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "correlation_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "idempotency_key", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "dead_lettered_at", Type: field.TypeTime, Nullable: true},
 		{Name: "user_tasks", Type: field.TypeInt, Nullable: true},
 	}
 	// TasksTable holds the schema information for the "tasks" table.
@@ -377,7 +941,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tasks_users_tasks",
-				Columns:    []*schema.Column{TasksColumns[9]},
+				Columns:    []*schema.Column{TasksColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -392,12 +956,17 @@ var (
 		{Name: "email", Type: field.TypeString, Unique: true, Size: 100},
 		{Name: "nick", Type: field.TypeString, Size: 100},
 		{Name: "password", Type: field.TypeString, Nullable: true},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "inactive", "manual_banned", "sys_banned"}, Default: "active"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "inactive", "manual_banned", "sys_banned", "archived"}, Default: "active"},
 		{Name: "storage", Type: field.TypeInt64, Default: 0},
 		{Name: "two_factor_secret", Type: field.TypeString, Nullable: true},
 		{Name: "avatar", Type: field.TypeString, Nullable: true},
 		{Name: "settings", Type: field.TypeJSON, Nullable: true},
+		{Name: "storage_policy_override", Type: field.TypeInt, Nullable: true},
+		{Name: "max_storage_override", Type: field.TypeInt64, Nullable: true},
+		{Name: "group_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "email_change_at", Type: field.TypeTime, Nullable: true},
 		{Name: "group_users", Type: field.TypeInt},
+		{Name: "invitation_invitees", Type: field.TypeInt, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -407,10 +976,187 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_groups_users",
-				Columns:    []*schema.Column{UsersColumns[12]},
+				Columns:    []*schema.Column{UsersColumns[16]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "users_invitations_invitees",
+				Columns:    []*schema.Column{UsersColumns[17]},
+				RefColumns: []*schema.Column{InvitationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_group_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[14]},
+			},
+			{
+				Name:    "user_email_change_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[15]},
+			},
+		},
+	}
+	// UserEmailsColumns holds the columns for the "user_emails" table.
+	UserEmailsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "email", Type: field.TypeString},
+		{Name: "verified_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "confirm_digest", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// UserEmailsTable holds the schema information for the "user_emails" table.
+	UserEmailsTable = &schema.Table{
+		Name:       "user_emails",
+		Columns:    UserEmailsColumns,
+		PrimaryKey: []*schema.Column{UserEmailsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_emails_users_emails",
+				Columns:    []*schema.Column{UserEmailsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "useremail_email",
+				Unique:  true,
+				Columns: []*schema.Column{UserEmailsColumns[4]},
+			},
+			{
+				Name:    "useremail_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserEmailsColumns[8]},
+			},
+		},
+	}
+	// ViewPreferencesColumns holds the columns for the "view_preferences" table.
+	ViewPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "folder_path", Type: field.TypeString},
+		{Name: "layout", Type: field.TypeString, Default: "grid"},
+		{Name: "show_thumb", Type: field.TypeBool, Default: true},
+		{Name: "sort_by", Type: field.TypeString, Default: "created_at"},
+		{Name: "sort_direction", Type: field.TypeString, Default: "asc"},
+		{Name: "page_size", Type: field.TypeInt, Default: 100},
+		{Name: "gallery_width", Type: field.TypeInt, Default: 220},
+		{Name: "list_columns", Type: field.TypeString, Default: ""},
+		{Name: "user_view_preferences", Type: field.TypeInt},
+	}
+	// ViewPreferencesTable holds the schema information for the "view_preferences" table.
+	ViewPreferencesTable = &schema.Table{
+		Name:       "view_preferences",
+		Columns:    ViewPreferencesColumns,
+		PrimaryKey: []*schema.Column{ViewPreferencesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "view_preferences_users_view_preferences",
+				Columns:    []*schema.Column{ViewPreferencesColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "viewpreference_folder_path_user_view_preferences",
+				Unique:  true,
+				Columns: []*schema.Column{ViewPreferencesColumns[4], ViewPreferencesColumns[12]},
+			},
+		},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString, Size: 2147483647},
+		{Name: "secret", Type: field.TypeString, Nullable: true},
+		{Name: "events", Type: field.TypeJSON, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "event", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "response_code", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"mysql": "datetime"}},
+		{Name: "webhook_id", Type: field.TypeInt},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[11]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[6], WebhookDeliveriesColumns[10]},
+			},
+			{
+				Name:    "webhookdelivery_webhook_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[11]},
+			},
+		},
+	}
+	// AnnouncementDismissedByColumns holds the columns for the "announcement_dismissed_by" table.
+	AnnouncementDismissedByColumns = []*schema.Column{
+		{Name: "announcement_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// AnnouncementDismissedByTable holds the schema information for the "announcement_dismissed_by" table.
+	AnnouncementDismissedByTable = &schema.Table{
+		Name:       "announcement_dismissed_by",
+		Columns:    AnnouncementDismissedByColumns,
+		PrimaryKey: []*schema.Column{AnnouncementDismissedByColumns[0], AnnouncementDismissedByColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "announcement_dismissed_by_announcement_id",
+				Columns:    []*schema.Column{AnnouncementDismissedByColumns[0]},
+				RefColumns: []*schema.Column{AnnouncementsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "announcement_dismissed_by_user_id",
+				Columns:    []*schema.Column{AnnouncementDismissedByColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 		},
 	}
 	// FileEntitiesColumns holds the columns for the "file_entities" table.
@@ -438,26 +1184,102 @@ var (
 			},
 		},
 	}
+	// OrganizationAdminsColumns holds the columns for the "organization_admins" table.
+	OrganizationAdminsColumns = []*schema.Column{
+		{Name: "organization_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// OrganizationAdminsTable holds the schema information for the "organization_admins" table.
+	OrganizationAdminsTable = &schema.Table{
+		Name:       "organization_admins",
+		Columns:    OrganizationAdminsColumns,
+		PrimaryKey: []*schema.Column{OrganizationAdminsColumns[0], OrganizationAdminsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "organization_admins_organization_id",
+				Columns:    []*schema.Column{OrganizationAdminsColumns[0]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "organization_admins_user_id",
+				Columns:    []*schema.Column{OrganizationAdminsColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// OrganizationStoragePoliciesColumns holds the columns for the "organization_storage_policies" table.
+	OrganizationStoragePoliciesColumns = []*schema.Column{
+		{Name: "organization_id", Type: field.TypeInt},
+		{Name: "storage_policy_id", Type: field.TypeInt},
+	}
+	// OrganizationStoragePoliciesTable holds the schema information for the "organization_storage_policies" table.
+	OrganizationStoragePoliciesTable = &schema.Table{
+		Name:       "organization_storage_policies",
+		Columns:    OrganizationStoragePoliciesColumns,
+		PrimaryKey: []*schema.Column{OrganizationStoragePoliciesColumns[0], OrganizationStoragePoliciesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "organization_storage_policies_organization_id",
+				Columns:    []*schema.Column{OrganizationStoragePoliciesColumns[0]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "organization_storage_policies_storage_policy_id",
+				Columns:    []*schema.Column{OrganizationStoragePoliciesColumns[1]},
+				RefColumns: []*schema.Column{StoragePoliciesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccessTokensTable,
+		AnnouncementsTable,
+		AuditLogsTable,
+		AutomationRulesTable,
+		CheckoutsTable,
+		DailyActiveUsersTable,
+		DailyStatsTable,
 		DavAccountsTable,
 		DirectLinksTable,
 		EntitiesTable,
 		FilesTable,
 		GroupsTable,
+		InvitationsTable,
 		MetadataTable,
+		ModerationCasesTable,
 		NodesTable,
+		NotificationsTable,
+		OrganizationsTable,
 		PasskeysTable,
+		RetentionRulesTable,
+		RssSubscriptionsTable,
+		S3accessKeysTable,
+		SavedSearchesTable,
 		SettingsTable,
 		SharesTable,
 		StoragePoliciesTable,
+		SyncJobsTable,
 		TasksTable,
 		UsersTable,
+		UserEmailsTable,
+		ViewPreferencesTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
+		AnnouncementDismissedByTable,
 		FileEntitiesTable,
+		OrganizationAdminsTable,
+		OrganizationStoragePoliciesTable,
 	}
 )
 
 func init() {
+	AccessTokensTable.ForeignKeys[0].RefTable = UsersTable
+	AutomationRulesTable.ForeignKeys[0].RefTable = UsersTable
+	CheckoutsTable.ForeignKeys[0].RefTable = UsersTable
 	DavAccountsTable.ForeignKeys[0].RefTable = UsersTable
 	DirectLinksTable.ForeignKeys[0].RefTable = FilesTable
 	EntitiesTable.ForeignKeys[0].RefTable = StoragePoliciesTable
@@ -465,14 +1287,33 @@ func init() {
 	FilesTable.ForeignKeys[0].RefTable = FilesTable
 	FilesTable.ForeignKeys[1].RefTable = StoragePoliciesTable
 	FilesTable.ForeignKeys[2].RefTable = UsersTable
-	GroupsTable.ForeignKeys[0].RefTable = StoragePoliciesTable
+	GroupsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	GroupsTable.ForeignKeys[1].RefTable = StoragePoliciesTable
+	InvitationsTable.ForeignKeys[0].RefTable = UsersTable
 	MetadataTable.ForeignKeys[0].RefTable = FilesTable
+	ModerationCasesTable.ForeignKeys[0].RefTable = UsersTable
+	NotificationsTable.ForeignKeys[0].RefTable = UsersTable
 	PasskeysTable.ForeignKeys[0].RefTable = UsersTable
+	RetentionRulesTable.ForeignKeys[0].RefTable = UsersTable
+	RssSubscriptionsTable.ForeignKeys[0].RefTable = UsersTable
+	S3accessKeysTable.ForeignKeys[0].RefTable = UsersTable
+	SavedSearchesTable.ForeignKeys[0].RefTable = UsersTable
 	SharesTable.ForeignKeys[0].RefTable = FilesTable
 	SharesTable.ForeignKeys[1].RefTable = UsersTable
 	StoragePoliciesTable.ForeignKeys[0].RefTable = NodesTable
+	SyncJobsTable.ForeignKeys[0].RefTable = UsersTable
 	TasksTable.ForeignKeys[0].RefTable = UsersTable
 	UsersTable.ForeignKeys[0].RefTable = GroupsTable
+	UsersTable.ForeignKeys[1].RefTable = InvitationsTable
+	UserEmailsTable.ForeignKeys[0].RefTable = UsersTable
+	ViewPreferencesTable.ForeignKeys[0].RefTable = UsersTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
+	AnnouncementDismissedByTable.ForeignKeys[0].RefTable = AnnouncementsTable
+	AnnouncementDismissedByTable.ForeignKeys[1].RefTable = UsersTable
 	FileEntitiesTable.ForeignKeys[0].RefTable = FilesTable
 	FileEntitiesTable.ForeignKeys[1].RefTable = EntitiesTable
+	OrganizationAdminsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	OrganizationAdminsTable.ForeignKeys[1].RefTable = UsersTable
+	OrganizationStoragePoliciesTable.ForeignKeys[0].RefTable = OrganizationsTable
+	OrganizationStoragePoliciesTable.ForeignKeys[1].RefTable = StoragePoliciesTable
 }
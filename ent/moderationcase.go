@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/moderationcase"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// ModerationCase is the model entity for the ModerationCase schema.
+type ModerationCase struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// ShareID holds the value of the "share_id" field.
+	ShareID *int `json:"share_id,omitempty"`
+	// FileID holds the value of the "file_id" field.
+	FileID int `json:"file_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status moderationcase.Status `json:"status,omitempty"`
+	// Matches holds the value of the "matches" field.
+	Matches []types.ModerationMatch `json:"matches,omitempty"`
+	// ReviewedBy holds the value of the "reviewed_by" field.
+	ReviewedBy *int `json:"reviewed_by,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ModerationCaseQuery when eager-loading is set.
+	Edges        ModerationCaseEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ModerationCaseEdges holds the relations/edges for other nodes in the graph.
+type ModerationCaseEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ModerationCaseEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ModerationCase) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case moderationcase.FieldMatches:
+			values[i] = new([]byte)
+		case moderationcase.FieldID, moderationcase.FieldUserID, moderationcase.FieldShareID, moderationcase.FieldFileID, moderationcase.FieldReviewedBy:
+			values[i] = new(sql.NullInt64)
+		case moderationcase.FieldStatus:
+			values[i] = new(sql.NullString)
+		case moderationcase.FieldCreatedAt, moderationcase.FieldUpdatedAt, moderationcase.FieldDeletedAt, moderationcase.FieldReviewedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ModerationCase fields.
+func (mc *ModerationCase) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case moderationcase.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mc.ID = int(value.Int64)
+		case moderationcase.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mc.CreatedAt = value.Time
+			}
+		case moderationcase.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				mc.UpdatedAt = value.Time
+			}
+		case moderationcase.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				mc.DeletedAt = new(time.Time)
+				*mc.DeletedAt = value.Time
+			}
+		case moderationcase.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				mc.UserID = int(value.Int64)
+			}
+		case moderationcase.FieldShareID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field share_id", values[i])
+			} else if value.Valid {
+				mc.ShareID = new(int)
+				*mc.ShareID = int(value.Int64)
+			}
+		case moderationcase.FieldFileID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field file_id", values[i])
+			} else if value.Valid {
+				mc.FileID = int(value.Int64)
+			}
+		case moderationcase.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				mc.Status = moderationcase.Status(value.String)
+			}
+		case moderationcase.FieldMatches:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field matches", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mc.Matches); err != nil {
+					return fmt.Errorf("unmarshal field matches: %w", err)
+				}
+			}
+		case moderationcase.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				mc.ReviewedBy = new(int)
+				*mc.ReviewedBy = int(value.Int64)
+			}
+		case moderationcase.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				mc.ReviewedAt = new(time.Time)
+				*mc.ReviewedAt = value.Time
+			}
+		default:
+			mc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ModerationCase.
+// This includes values selected through modifiers, order, etc.
+func (mc *ModerationCase) Value(name string) (ent.Value, error) {
+	return mc.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the ModerationCase entity.
+func (mc *ModerationCase) QueryUser() *UserQuery {
+	return NewModerationCaseClient(mc.config).QueryUser(mc)
+}
+
+// Update returns a builder for updating this ModerationCase.
+// Note that you need to call ModerationCase.Unwrap() before calling this method if this ModerationCase
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mc *ModerationCase) Update() *ModerationCaseUpdateOne {
+	return NewModerationCaseClient(mc.config).UpdateOne(mc)
+}
+
+// Unwrap unwraps the ModerationCase entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mc *ModerationCase) Unwrap() *ModerationCase {
+	_tx, ok := mc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ModerationCase is not a transactional entity")
+	}
+	mc.config.driver = _tx.drv
+	return mc
+}
+
+// String implements the fmt.Stringer.
+func (mc *ModerationCase) String() string {
+	var builder strings.Builder
+	builder.WriteString("ModerationCase(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mc.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(mc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(mc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := mc.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", mc.UserID))
+	builder.WriteString(", ")
+	if v := mc.ShareID; v != nil {
+		builder.WriteString("share_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("file_id=")
+	builder.WriteString(fmt.Sprintf("%v", mc.FileID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", mc.Status))
+	builder.WriteString(", ")
+	builder.WriteString("matches=")
+	builder.WriteString(fmt.Sprintf("%v", mc.Matches))
+	builder.WriteString(", ")
+	if v := mc.ReviewedBy; v != nil {
+		builder.WriteString("reviewed_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := mc.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetUser manually set the edge as loaded state.
+func (e *ModerationCase) SetUser(v *User) {
+	e.Edges.User = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// ModerationCases is a parsable slice of ModerationCase.
+type ModerationCases []*ModerationCase
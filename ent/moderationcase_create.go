@@ -0,0 +1,1172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/moderationcase"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// ModerationCaseCreate is the builder for creating a ModerationCase entity.
+type ModerationCaseCreate struct {
+	config
+	mutation *ModerationCaseMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mcc *ModerationCaseCreate) SetCreatedAt(t time.Time) *ModerationCaseCreate {
+	mcc.mutation.SetCreatedAt(t)
+	return mcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mcc *ModerationCaseCreate) SetNillableCreatedAt(t *time.Time) *ModerationCaseCreate {
+	if t != nil {
+		mcc.SetCreatedAt(*t)
+	}
+	return mcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mcc *ModerationCaseCreate) SetUpdatedAt(t time.Time) *ModerationCaseCreate {
+	mcc.mutation.SetUpdatedAt(t)
+	return mcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mcc *ModerationCaseCreate) SetNillableUpdatedAt(t *time.Time) *ModerationCaseCreate {
+	if t != nil {
+		mcc.SetUpdatedAt(*t)
+	}
+	return mcc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mcc *ModerationCaseCreate) SetDeletedAt(t time.Time) *ModerationCaseCreate {
+	mcc.mutation.SetDeletedAt(t)
+	return mcc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mcc *ModerationCaseCreate) SetNillableDeletedAt(t *time.Time) *ModerationCaseCreate {
+	if t != nil {
+		mcc.SetDeletedAt(*t)
+	}
+	return mcc
+}
+
+// SetUserID sets the "user_id" field.
+func (mcc *ModerationCaseCreate) SetUserID(i int) *ModerationCaseCreate {
+	mcc.mutation.SetUserID(i)
+	return mcc
+}
+
+// SetShareID sets the "share_id" field.
+func (mcc *ModerationCaseCreate) SetShareID(i int) *ModerationCaseCreate {
+	mcc.mutation.SetShareID(i)
+	return mcc
+}
+
+// SetNillableShareID sets the "share_id" field if the given value is not nil.
+func (mcc *ModerationCaseCreate) SetNillableShareID(i *int) *ModerationCaseCreate {
+	if i != nil {
+		mcc.SetShareID(*i)
+	}
+	return mcc
+}
+
+// SetFileID sets the "file_id" field.
+func (mcc *ModerationCaseCreate) SetFileID(i int) *ModerationCaseCreate {
+	mcc.mutation.SetFileID(i)
+	return mcc
+}
+
+// SetStatus sets the "status" field.
+func (mcc *ModerationCaseCreate) SetStatus(m moderationcase.Status) *ModerationCaseCreate {
+	mcc.mutation.SetStatus(m)
+	return mcc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mcc *ModerationCaseCreate) SetNillableStatus(m *moderationcase.Status) *ModerationCaseCreate {
+	if m != nil {
+		mcc.SetStatus(*m)
+	}
+	return mcc
+}
+
+// SetMatches sets the "matches" field.
+func (mcc *ModerationCaseCreate) SetMatches(tm []types.ModerationMatch) *ModerationCaseCreate {
+	mcc.mutation.SetMatches(tm)
+	return mcc
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (mcc *ModerationCaseCreate) SetReviewedBy(i int) *ModerationCaseCreate {
+	mcc.mutation.SetReviewedBy(i)
+	return mcc
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (mcc *ModerationCaseCreate) SetNillableReviewedBy(i *int) *ModerationCaseCreate {
+	if i != nil {
+		mcc.SetReviewedBy(*i)
+	}
+	return mcc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (mcc *ModerationCaseCreate) SetReviewedAt(t time.Time) *ModerationCaseCreate {
+	mcc.mutation.SetReviewedAt(t)
+	return mcc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (mcc *ModerationCaseCreate) SetNillableReviewedAt(t *time.Time) *ModerationCaseCreate {
+	if t != nil {
+		mcc.SetReviewedAt(*t)
+	}
+	return mcc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (mcc *ModerationCaseCreate) SetUser(u *User) *ModerationCaseCreate {
+	return mcc.SetUserID(u.ID)
+}
+
+// Mutation returns the ModerationCaseMutation object of the builder.
+func (mcc *ModerationCaseCreate) Mutation() *ModerationCaseMutation {
+	return mcc.mutation
+}
+
+// Save creates the ModerationCase in the database.
+func (mcc *ModerationCaseCreate) Save(ctx context.Context) (*ModerationCase, error) {
+	if err := mcc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, mcc.sqlSave, mcc.mutation, mcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mcc *ModerationCaseCreate) SaveX(ctx context.Context) *ModerationCase {
+	v, err := mcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcc *ModerationCaseCreate) Exec(ctx context.Context) error {
+	_, err := mcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcc *ModerationCaseCreate) ExecX(ctx context.Context) {
+	if err := mcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mcc *ModerationCaseCreate) defaults() error {
+	if _, ok := mcc.mutation.CreatedAt(); !ok {
+		if moderationcase.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized moderationcase.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := moderationcase.DefaultCreatedAt()
+		mcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mcc.mutation.UpdatedAt(); !ok {
+		if moderationcase.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized moderationcase.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := moderationcase.DefaultUpdatedAt()
+		mcc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mcc.mutation.Status(); !ok {
+		v := moderationcase.DefaultStatus
+		mcc.mutation.SetStatus(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mcc *ModerationCaseCreate) check() error {
+	if _, ok := mcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ModerationCase.created_at"`)}
+	}
+	if _, ok := mcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ModerationCase.updated_at"`)}
+	}
+	if _, ok := mcc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ModerationCase.user_id"`)}
+	}
+	if _, ok := mcc.mutation.FileID(); !ok {
+		return &ValidationError{Name: "file_id", err: errors.New(`ent: missing required field "ModerationCase.file_id"`)}
+	}
+	if _, ok := mcc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ModerationCase.status"`)}
+	}
+	if v, ok := mcc.mutation.Status(); ok {
+		if err := moderationcase.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ModerationCase.status": %w`, err)}
+		}
+	}
+	if _, ok := mcc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ModerationCase.user"`)}
+	}
+	return nil
+}
+
+func (mcc *ModerationCaseCreate) sqlSave(ctx context.Context) (*ModerationCase, error) {
+	if err := mcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mcc.mutation.id = &_node.ID
+	mcc.mutation.done = true
+	return _node, nil
+}
+
+func (mcc *ModerationCaseCreate) createSpec() (*ModerationCase, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModerationCase{config: mcc.config}
+		_spec = sqlgraph.NewCreateSpec(moderationcase.Table, sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeInt))
+	)
+
+	if id, ok := mcc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = mcc.conflict
+	if value, ok := mcc.mutation.CreatedAt(); ok {
+		_spec.SetField(moderationcase.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationcase.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := mcc.mutation.DeletedAt(); ok {
+		_spec.SetField(moderationcase.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := mcc.mutation.ShareID(); ok {
+		_spec.SetField(moderationcase.FieldShareID, field.TypeInt, value)
+		_node.ShareID = &value
+	}
+	if value, ok := mcc.mutation.FileID(); ok {
+		_spec.SetField(moderationcase.FieldFileID, field.TypeInt, value)
+		_node.FileID = value
+	}
+	if value, ok := mcc.mutation.Status(); ok {
+		_spec.SetField(moderationcase.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := mcc.mutation.Matches(); ok {
+		_spec.SetField(moderationcase.FieldMatches, field.TypeJSON, value)
+		_node.Matches = value
+	}
+	if value, ok := mcc.mutation.ReviewedBy(); ok {
+		_spec.SetField(moderationcase.FieldReviewedBy, field.TypeInt, value)
+		_node.ReviewedBy = &value
+	}
+	if value, ok := mcc.mutation.ReviewedAt(); ok {
+		_spec.SetField(moderationcase.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if nodes := mcc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationcase.UserTable,
+			Columns: []string{moderationcase.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationCase.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationCaseUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mcc *ModerationCaseCreate) OnConflict(opts ...sql.ConflictOption) *ModerationCaseUpsertOne {
+	mcc.conflict = opts
+	return &ModerationCaseUpsertOne{
+		create: mcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationCase.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mcc *ModerationCaseCreate) OnConflictColumns(columns ...string) *ModerationCaseUpsertOne {
+	mcc.conflict = append(mcc.conflict, sql.ConflictColumns(columns...))
+	return &ModerationCaseUpsertOne{
+		create: mcc,
+	}
+}
+
+type (
+	// ModerationCaseUpsertOne is the builder for "upsert"-ing
+	//  one ModerationCase node.
+	ModerationCaseUpsertOne struct {
+		create *ModerationCaseCreate
+	}
+
+	// ModerationCaseUpsert is the "OnConflict" setter.
+	ModerationCaseUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ModerationCaseUpsert) SetUpdatedAt(v time.Time) *ModerationCaseUpsert {
+	u.Set(moderationcase.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ModerationCaseUpsert) UpdateUpdatedAt() *ModerationCaseUpsert {
+	u.SetExcluded(moderationcase.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ModerationCaseUpsert) SetDeletedAt(v time.Time) *ModerationCaseUpsert {
+	u.Set(moderationcase.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ModerationCaseUpsert) UpdateDeletedAt() *ModerationCaseUpsert {
+	u.SetExcluded(moderationcase.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ModerationCaseUpsert) ClearDeletedAt() *ModerationCaseUpsert {
+	u.SetNull(moderationcase.FieldDeletedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ModerationCaseUpsert) SetUserID(v int) *ModerationCaseUpsert {
+	u.Set(moderationcase.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ModerationCaseUpsert) UpdateUserID() *ModerationCaseUpsert {
+	u.SetExcluded(moderationcase.FieldUserID)
+	return u
+}
+
+// SetShareID sets the "share_id" field.
+func (u *ModerationCaseUpsert) SetShareID(v int) *ModerationCaseUpsert {
+	u.Set(moderationcase.FieldShareID, v)
+	return u
+}
+
+// UpdateShareID sets the "share_id" field to the value that was provided on create.
+func (u *ModerationCaseUpsert) UpdateShareID() *ModerationCaseUpsert {
+	u.SetExcluded(moderationcase.FieldShareID)
+	return u
+}
+
+// AddShareID adds v to the "share_id" field.
+func (u *ModerationCaseUpsert) AddShareID(v int) *ModerationCaseUpsert {
+	u.Add(moderationcase.FieldShareID, v)
+	return u
+}
+
+// ClearShareID clears the value of the "share_id" field.
+func (u *ModerationCaseUpsert) ClearShareID() *ModerationCaseUpsert {
+	u.SetNull(moderationcase.FieldShareID)
+	return u
+}
+
+// SetFileID sets the "file_id" field.
+func (u *ModerationCaseUpsert) SetFileID(v int) *ModerationCaseUpsert {
+	u.Set(moderationcase.FieldFileID, v)
+	return u
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *ModerationCaseUpsert) UpdateFileID() *ModerationCaseUpsert {
+	u.SetExcluded(moderationcase.FieldFileID)
+	return u
+}
+
+// AddFileID adds v to the "file_id" field.
+func (u *ModerationCaseUpsert) AddFileID(v int) *ModerationCaseUpsert {
+	u.Add(moderationcase.FieldFileID, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ModerationCaseUpsert) SetStatus(v moderationcase.Status) *ModerationCaseUpsert {
+	u.Set(moderationcase.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ModerationCaseUpsert) UpdateStatus() *ModerationCaseUpsert {
+	u.SetExcluded(moderationcase.FieldStatus)
+	return u
+}
+
+// SetMatches sets the "matches" field.
+func (u *ModerationCaseUpsert) SetMatches(v []types.ModerationMatch) *ModerationCaseUpsert {
+	u.Set(moderationcase.FieldMatches, v)
+	return u
+}
+
+// UpdateMatches sets the "matches" field to the value that was provided on create.
+func (u *ModerationCaseUpsert) UpdateMatches() *ModerationCaseUpsert {
+	u.SetExcluded(moderationcase.FieldMatches)
+	return u
+}
+
+// ClearMatches clears the value of the "matches" field.
+func (u *ModerationCaseUpsert) ClearMatches() *ModerationCaseUpsert {
+	u.SetNull(moderationcase.FieldMatches)
+	return u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *ModerationCaseUpsert) SetReviewedBy(v int) *ModerationCaseUpsert {
+	u.Set(moderationcase.FieldReviewedBy, v)
+	return u
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *ModerationCaseUpsert) UpdateReviewedBy() *ModerationCaseUpsert {
+	u.SetExcluded(moderationcase.FieldReviewedBy)
+	return u
+}
+
+// AddReviewedBy adds v to the "reviewed_by" field.
+func (u *ModerationCaseUpsert) AddReviewedBy(v int) *ModerationCaseUpsert {
+	u.Add(moderationcase.FieldReviewedBy, v)
+	return u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *ModerationCaseUpsert) ClearReviewedBy() *ModerationCaseUpsert {
+	u.SetNull(moderationcase.FieldReviewedBy)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ModerationCaseUpsert) SetReviewedAt(v time.Time) *ModerationCaseUpsert {
+	u.Set(moderationcase.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ModerationCaseUpsert) UpdateReviewedAt() *ModerationCaseUpsert {
+	u.SetExcluded(moderationcase.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ModerationCaseUpsert) ClearReviewedAt() *ModerationCaseUpsert {
+	u.SetNull(moderationcase.FieldReviewedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ModerationCase.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ModerationCaseUpsertOne) UpdateNewValues() *ModerationCaseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(moderationcase.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationCase.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ModerationCaseUpsertOne) Ignore() *ModerationCaseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationCaseUpsertOne) DoNothing() *ModerationCaseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationCaseCreate.OnConflict
+// documentation for more info.
+func (u *ModerationCaseUpsertOne) Update(set func(*ModerationCaseUpsert)) *ModerationCaseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationCaseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ModerationCaseUpsertOne) SetUpdatedAt(v time.Time) *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ModerationCaseUpsertOne) UpdateUpdatedAt() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ModerationCaseUpsertOne) SetDeletedAt(v time.Time) *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ModerationCaseUpsertOne) UpdateDeletedAt() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ModerationCaseUpsertOne) ClearDeletedAt() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ModerationCaseUpsertOne) SetUserID(v int) *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ModerationCaseUpsertOne) UpdateUserID() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetShareID sets the "share_id" field.
+func (u *ModerationCaseUpsertOne) SetShareID(v int) *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetShareID(v)
+	})
+}
+
+// AddShareID adds v to the "share_id" field.
+func (u *ModerationCaseUpsertOne) AddShareID(v int) *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.AddShareID(v)
+	})
+}
+
+// UpdateShareID sets the "share_id" field to the value that was provided on create.
+func (u *ModerationCaseUpsertOne) UpdateShareID() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateShareID()
+	})
+}
+
+// ClearShareID clears the value of the "share_id" field.
+func (u *ModerationCaseUpsertOne) ClearShareID() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.ClearShareID()
+	})
+}
+
+// SetFileID sets the "file_id" field.
+func (u *ModerationCaseUpsertOne) SetFileID(v int) *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// AddFileID adds v to the "file_id" field.
+func (u *ModerationCaseUpsertOne) AddFileID(v int) *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.AddFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *ModerationCaseUpsertOne) UpdateFileID() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ModerationCaseUpsertOne) SetStatus(v moderationcase.Status) *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ModerationCaseUpsertOne) UpdateStatus() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMatches sets the "matches" field.
+func (u *ModerationCaseUpsertOne) SetMatches(v []types.ModerationMatch) *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetMatches(v)
+	})
+}
+
+// UpdateMatches sets the "matches" field to the value that was provided on create.
+func (u *ModerationCaseUpsertOne) UpdateMatches() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateMatches()
+	})
+}
+
+// ClearMatches clears the value of the "matches" field.
+func (u *ModerationCaseUpsertOne) ClearMatches() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.ClearMatches()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *ModerationCaseUpsertOne) SetReviewedBy(v int) *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// AddReviewedBy adds v to the "reviewed_by" field.
+func (u *ModerationCaseUpsertOne) AddReviewedBy(v int) *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.AddReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *ModerationCaseUpsertOne) UpdateReviewedBy() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *ModerationCaseUpsertOne) ClearReviewedBy() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ModerationCaseUpsertOne) SetReviewedAt(v time.Time) *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ModerationCaseUpsertOne) UpdateReviewedAt() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ModerationCaseUpsertOne) ClearReviewedAt() *ModerationCaseUpsertOne {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ModerationCaseUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModerationCaseCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationCaseUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ModerationCaseUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ModerationCaseUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *ModerationCaseCreate) SetRawID(t int) *ModerationCaseCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// ModerationCaseCreateBulk is the builder for creating many ModerationCase entities in bulk.
+type ModerationCaseCreateBulk struct {
+	config
+	err      error
+	builders []*ModerationCaseCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ModerationCase entities in the database.
+func (mccb *ModerationCaseCreateBulk) Save(ctx context.Context) ([]*ModerationCase, error) {
+	if mccb.err != nil {
+		return nil, mccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mccb.builders))
+	nodes := make([]*ModerationCase, len(mccb.builders))
+	mutators := make([]Mutator, len(mccb.builders))
+	for i := range mccb.builders {
+		func(i int, root context.Context) {
+			builder := mccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModerationCaseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mccb *ModerationCaseCreateBulk) SaveX(ctx context.Context) []*ModerationCase {
+	v, err := mccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mccb *ModerationCaseCreateBulk) Exec(ctx context.Context) error {
+	_, err := mccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mccb *ModerationCaseCreateBulk) ExecX(ctx context.Context) {
+	if err := mccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationCase.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationCaseUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (mccb *ModerationCaseCreateBulk) OnConflict(opts ...sql.ConflictOption) *ModerationCaseUpsertBulk {
+	mccb.conflict = opts
+	return &ModerationCaseUpsertBulk{
+		create: mccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationCase.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mccb *ModerationCaseCreateBulk) OnConflictColumns(columns ...string) *ModerationCaseUpsertBulk {
+	mccb.conflict = append(mccb.conflict, sql.ConflictColumns(columns...))
+	return &ModerationCaseUpsertBulk{
+		create: mccb,
+	}
+}
+
+// ModerationCaseUpsertBulk is the builder for "upsert"-ing
+// a bulk of ModerationCase nodes.
+type ModerationCaseUpsertBulk struct {
+	create *ModerationCaseCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ModerationCase.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ModerationCaseUpsertBulk) UpdateNewValues() *ModerationCaseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(moderationcase.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationCase.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ModerationCaseUpsertBulk) Ignore() *ModerationCaseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationCaseUpsertBulk) DoNothing() *ModerationCaseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationCaseCreateBulk.OnConflict
+// documentation for more info.
+func (u *ModerationCaseUpsertBulk) Update(set func(*ModerationCaseUpsert)) *ModerationCaseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationCaseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ModerationCaseUpsertBulk) SetUpdatedAt(v time.Time) *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ModerationCaseUpsertBulk) UpdateUpdatedAt() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ModerationCaseUpsertBulk) SetDeletedAt(v time.Time) *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ModerationCaseUpsertBulk) UpdateDeletedAt() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ModerationCaseUpsertBulk) ClearDeletedAt() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ModerationCaseUpsertBulk) SetUserID(v int) *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ModerationCaseUpsertBulk) UpdateUserID() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetShareID sets the "share_id" field.
+func (u *ModerationCaseUpsertBulk) SetShareID(v int) *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetShareID(v)
+	})
+}
+
+// AddShareID adds v to the "share_id" field.
+func (u *ModerationCaseUpsertBulk) AddShareID(v int) *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.AddShareID(v)
+	})
+}
+
+// UpdateShareID sets the "share_id" field to the value that was provided on create.
+func (u *ModerationCaseUpsertBulk) UpdateShareID() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateShareID()
+	})
+}
+
+// ClearShareID clears the value of the "share_id" field.
+func (u *ModerationCaseUpsertBulk) ClearShareID() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.ClearShareID()
+	})
+}
+
+// SetFileID sets the "file_id" field.
+func (u *ModerationCaseUpsertBulk) SetFileID(v int) *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetFileID(v)
+	})
+}
+
+// AddFileID adds v to the "file_id" field.
+func (u *ModerationCaseUpsertBulk) AddFileID(v int) *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.AddFileID(v)
+	})
+}
+
+// UpdateFileID sets the "file_id" field to the value that was provided on create.
+func (u *ModerationCaseUpsertBulk) UpdateFileID() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateFileID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ModerationCaseUpsertBulk) SetStatus(v moderationcase.Status) *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ModerationCaseUpsertBulk) UpdateStatus() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMatches sets the "matches" field.
+func (u *ModerationCaseUpsertBulk) SetMatches(v []types.ModerationMatch) *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetMatches(v)
+	})
+}
+
+// UpdateMatches sets the "matches" field to the value that was provided on create.
+func (u *ModerationCaseUpsertBulk) UpdateMatches() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateMatches()
+	})
+}
+
+// ClearMatches clears the value of the "matches" field.
+func (u *ModerationCaseUpsertBulk) ClearMatches() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.ClearMatches()
+	})
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (u *ModerationCaseUpsertBulk) SetReviewedBy(v int) *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetReviewedBy(v)
+	})
+}
+
+// AddReviewedBy adds v to the "reviewed_by" field.
+func (u *ModerationCaseUpsertBulk) AddReviewedBy(v int) *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.AddReviewedBy(v)
+	})
+}
+
+// UpdateReviewedBy sets the "reviewed_by" field to the value that was provided on create.
+func (u *ModerationCaseUpsertBulk) UpdateReviewedBy() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateReviewedBy()
+	})
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (u *ModerationCaseUpsertBulk) ClearReviewedBy() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.ClearReviewedBy()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *ModerationCaseUpsertBulk) SetReviewedAt(v time.Time) *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *ModerationCaseUpsertBulk) UpdateReviewedAt() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *ModerationCaseUpsertBulk) ClearReviewedAt() *ModerationCaseUpsertBulk {
+	return u.Update(func(s *ModerationCaseUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ModerationCaseUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ModerationCaseCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModerationCaseCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationCaseUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/moderationcase"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// ModerationCaseDelete is the builder for deleting a ModerationCase entity.
+type ModerationCaseDelete struct {
+	config
+	hooks    []Hook
+	mutation *ModerationCaseMutation
+}
+
+// Where appends a list predicates to the ModerationCaseDelete builder.
+func (mcd *ModerationCaseDelete) Where(ps ...predicate.ModerationCase) *ModerationCaseDelete {
+	mcd.mutation.Where(ps...)
+	return mcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mcd *ModerationCaseDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mcd.sqlExec, mcd.mutation, mcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcd *ModerationCaseDelete) ExecX(ctx context.Context) int {
+	n, err := mcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mcd *ModerationCaseDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(moderationcase.Table, sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeInt))
+	if ps := mcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mcd.mutation.done = true
+	return affected, err
+}
+
+// ModerationCaseDeleteOne is the builder for deleting a single ModerationCase entity.
+type ModerationCaseDeleteOne struct {
+	mcd *ModerationCaseDelete
+}
+
+// Where appends a list predicates to the ModerationCaseDelete builder.
+func (mcdo *ModerationCaseDeleteOne) Where(ps ...predicate.ModerationCase) *ModerationCaseDeleteOne {
+	mcdo.mcd.mutation.Where(ps...)
+	return mcdo
+}
+
+// Exec executes the deletion query.
+func (mcdo *ModerationCaseDeleteOne) Exec(ctx context.Context) error {
+	n, err := mcdo.mcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{moderationcase.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcdo *ModerationCaseDeleteOne) ExecX(ctx context.Context) {
+	if err := mcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
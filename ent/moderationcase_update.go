@@ -0,0 +1,761 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/moderationcase"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// ModerationCaseUpdate is the builder for updating ModerationCase entities.
+type ModerationCaseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ModerationCaseMutation
+}
+
+// Where appends a list predicates to the ModerationCaseUpdate builder.
+func (mcu *ModerationCaseUpdate) Where(ps ...predicate.ModerationCase) *ModerationCaseUpdate {
+	mcu.mutation.Where(ps...)
+	return mcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mcu *ModerationCaseUpdate) SetUpdatedAt(t time.Time) *ModerationCaseUpdate {
+	mcu.mutation.SetUpdatedAt(t)
+	return mcu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mcu *ModerationCaseUpdate) SetDeletedAt(t time.Time) *ModerationCaseUpdate {
+	mcu.mutation.SetDeletedAt(t)
+	return mcu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mcu *ModerationCaseUpdate) SetNillableDeletedAt(t *time.Time) *ModerationCaseUpdate {
+	if t != nil {
+		mcu.SetDeletedAt(*t)
+	}
+	return mcu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (mcu *ModerationCaseUpdate) ClearDeletedAt() *ModerationCaseUpdate {
+	mcu.mutation.ClearDeletedAt()
+	return mcu
+}
+
+// SetUserID sets the "user_id" field.
+func (mcu *ModerationCaseUpdate) SetUserID(i int) *ModerationCaseUpdate {
+	mcu.mutation.SetUserID(i)
+	return mcu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mcu *ModerationCaseUpdate) SetNillableUserID(i *int) *ModerationCaseUpdate {
+	if i != nil {
+		mcu.SetUserID(*i)
+	}
+	return mcu
+}
+
+// SetShareID sets the "share_id" field.
+func (mcu *ModerationCaseUpdate) SetShareID(i int) *ModerationCaseUpdate {
+	mcu.mutation.ResetShareID()
+	mcu.mutation.SetShareID(i)
+	return mcu
+}
+
+// SetNillableShareID sets the "share_id" field if the given value is not nil.
+func (mcu *ModerationCaseUpdate) SetNillableShareID(i *int) *ModerationCaseUpdate {
+	if i != nil {
+		mcu.SetShareID(*i)
+	}
+	return mcu
+}
+
+// AddShareID adds i to the "share_id" field.
+func (mcu *ModerationCaseUpdate) AddShareID(i int) *ModerationCaseUpdate {
+	mcu.mutation.AddShareID(i)
+	return mcu
+}
+
+// ClearShareID clears the value of the "share_id" field.
+func (mcu *ModerationCaseUpdate) ClearShareID() *ModerationCaseUpdate {
+	mcu.mutation.ClearShareID()
+	return mcu
+}
+
+// SetFileID sets the "file_id" field.
+func (mcu *ModerationCaseUpdate) SetFileID(i int) *ModerationCaseUpdate {
+	mcu.mutation.ResetFileID()
+	mcu.mutation.SetFileID(i)
+	return mcu
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (mcu *ModerationCaseUpdate) SetNillableFileID(i *int) *ModerationCaseUpdate {
+	if i != nil {
+		mcu.SetFileID(*i)
+	}
+	return mcu
+}
+
+// AddFileID adds i to the "file_id" field.
+func (mcu *ModerationCaseUpdate) AddFileID(i int) *ModerationCaseUpdate {
+	mcu.mutation.AddFileID(i)
+	return mcu
+}
+
+// SetStatus sets the "status" field.
+func (mcu *ModerationCaseUpdate) SetStatus(m moderationcase.Status) *ModerationCaseUpdate {
+	mcu.mutation.SetStatus(m)
+	return mcu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mcu *ModerationCaseUpdate) SetNillableStatus(m *moderationcase.Status) *ModerationCaseUpdate {
+	if m != nil {
+		mcu.SetStatus(*m)
+	}
+	return mcu
+}
+
+// SetMatches sets the "matches" field.
+func (mcu *ModerationCaseUpdate) SetMatches(tm []types.ModerationMatch) *ModerationCaseUpdate {
+	mcu.mutation.SetMatches(tm)
+	return mcu
+}
+
+// AppendMatches appends tm to the "matches" field.
+func (mcu *ModerationCaseUpdate) AppendMatches(tm []types.ModerationMatch) *ModerationCaseUpdate {
+	mcu.mutation.AppendMatches(tm)
+	return mcu
+}
+
+// ClearMatches clears the value of the "matches" field.
+func (mcu *ModerationCaseUpdate) ClearMatches() *ModerationCaseUpdate {
+	mcu.mutation.ClearMatches()
+	return mcu
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (mcu *ModerationCaseUpdate) SetReviewedBy(i int) *ModerationCaseUpdate {
+	mcu.mutation.ResetReviewedBy()
+	mcu.mutation.SetReviewedBy(i)
+	return mcu
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (mcu *ModerationCaseUpdate) SetNillableReviewedBy(i *int) *ModerationCaseUpdate {
+	if i != nil {
+		mcu.SetReviewedBy(*i)
+	}
+	return mcu
+}
+
+// AddReviewedBy adds i to the "reviewed_by" field.
+func (mcu *ModerationCaseUpdate) AddReviewedBy(i int) *ModerationCaseUpdate {
+	mcu.mutation.AddReviewedBy(i)
+	return mcu
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (mcu *ModerationCaseUpdate) ClearReviewedBy() *ModerationCaseUpdate {
+	mcu.mutation.ClearReviewedBy()
+	return mcu
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (mcu *ModerationCaseUpdate) SetReviewedAt(t time.Time) *ModerationCaseUpdate {
+	mcu.mutation.SetReviewedAt(t)
+	return mcu
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (mcu *ModerationCaseUpdate) SetNillableReviewedAt(t *time.Time) *ModerationCaseUpdate {
+	if t != nil {
+		mcu.SetReviewedAt(*t)
+	}
+	return mcu
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (mcu *ModerationCaseUpdate) ClearReviewedAt() *ModerationCaseUpdate {
+	mcu.mutation.ClearReviewedAt()
+	return mcu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (mcu *ModerationCaseUpdate) SetUser(u *User) *ModerationCaseUpdate {
+	return mcu.SetUserID(u.ID)
+}
+
+// Mutation returns the ModerationCaseMutation object of the builder.
+func (mcu *ModerationCaseUpdate) Mutation() *ModerationCaseMutation {
+	return mcu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (mcu *ModerationCaseUpdate) ClearUser() *ModerationCaseUpdate {
+	mcu.mutation.ClearUser()
+	return mcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mcu *ModerationCaseUpdate) Save(ctx context.Context) (int, error) {
+	if err := mcu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, mcu.sqlSave, mcu.mutation, mcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcu *ModerationCaseUpdate) SaveX(ctx context.Context) int {
+	affected, err := mcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mcu *ModerationCaseUpdate) Exec(ctx context.Context) error {
+	_, err := mcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcu *ModerationCaseUpdate) ExecX(ctx context.Context) {
+	if err := mcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mcu *ModerationCaseUpdate) defaults() error {
+	if _, ok := mcu.mutation.UpdatedAt(); !ok {
+		if moderationcase.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized moderationcase.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := moderationcase.UpdateDefaultUpdatedAt()
+		mcu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mcu *ModerationCaseUpdate) check() error {
+	if v, ok := mcu.mutation.Status(); ok {
+		if err := moderationcase.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ModerationCase.status": %w`, err)}
+		}
+	}
+	if _, ok := mcu.mutation.UserID(); mcu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ModerationCase.user"`)
+	}
+	return nil
+}
+
+func (mcu *ModerationCaseUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(moderationcase.Table, moderationcase.Columns, sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeInt))
+	if ps := mcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationcase.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mcu.mutation.DeletedAt(); ok {
+		_spec.SetField(moderationcase.FieldDeletedAt, field.TypeTime, value)
+	}
+	if mcu.mutation.DeletedAtCleared() {
+		_spec.ClearField(moderationcase.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := mcu.mutation.ShareID(); ok {
+		_spec.SetField(moderationcase.FieldShareID, field.TypeInt, value)
+	}
+	if value, ok := mcu.mutation.AddedShareID(); ok {
+		_spec.AddField(moderationcase.FieldShareID, field.TypeInt, value)
+	}
+	if mcu.mutation.ShareIDCleared() {
+		_spec.ClearField(moderationcase.FieldShareID, field.TypeInt)
+	}
+	if value, ok := mcu.mutation.FileID(); ok {
+		_spec.SetField(moderationcase.FieldFileID, field.TypeInt, value)
+	}
+	if value, ok := mcu.mutation.AddedFileID(); ok {
+		_spec.AddField(moderationcase.FieldFileID, field.TypeInt, value)
+	}
+	if value, ok := mcu.mutation.Status(); ok {
+		_spec.SetField(moderationcase.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := mcu.mutation.Matches(); ok {
+		_spec.SetField(moderationcase.FieldMatches, field.TypeJSON, value)
+	}
+	if value, ok := mcu.mutation.AppendedMatches(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, moderationcase.FieldMatches, value)
+		})
+	}
+	if mcu.mutation.MatchesCleared() {
+		_spec.ClearField(moderationcase.FieldMatches, field.TypeJSON)
+	}
+	if value, ok := mcu.mutation.ReviewedBy(); ok {
+		_spec.SetField(moderationcase.FieldReviewedBy, field.TypeInt, value)
+	}
+	if value, ok := mcu.mutation.AddedReviewedBy(); ok {
+		_spec.AddField(moderationcase.FieldReviewedBy, field.TypeInt, value)
+	}
+	if mcu.mutation.ReviewedByCleared() {
+		_spec.ClearField(moderationcase.FieldReviewedBy, field.TypeInt)
+	}
+	if value, ok := mcu.mutation.ReviewedAt(); ok {
+		_spec.SetField(moderationcase.FieldReviewedAt, field.TypeTime, value)
+	}
+	if mcu.mutation.ReviewedAtCleared() {
+		_spec.ClearField(moderationcase.FieldReviewedAt, field.TypeTime)
+	}
+	if mcu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationcase.UserTable,
+			Columns: []string{moderationcase.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mcu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationcase.UserTable,
+			Columns: []string{moderationcase.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationcase.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mcu.mutation.done = true
+	return n, nil
+}
+
+// ModerationCaseUpdateOne is the builder for updating a single ModerationCase entity.
+type ModerationCaseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ModerationCaseMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mcuo *ModerationCaseUpdateOne) SetUpdatedAt(t time.Time) *ModerationCaseUpdateOne {
+	mcuo.mutation.SetUpdatedAt(t)
+	return mcuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (mcuo *ModerationCaseUpdateOne) SetDeletedAt(t time.Time) *ModerationCaseUpdateOne {
+	mcuo.mutation.SetDeletedAt(t)
+	return mcuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (mcuo *ModerationCaseUpdateOne) SetNillableDeletedAt(t *time.Time) *ModerationCaseUpdateOne {
+	if t != nil {
+		mcuo.SetDeletedAt(*t)
+	}
+	return mcuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (mcuo *ModerationCaseUpdateOne) ClearDeletedAt() *ModerationCaseUpdateOne {
+	mcuo.mutation.ClearDeletedAt()
+	return mcuo
+}
+
+// SetUserID sets the "user_id" field.
+func (mcuo *ModerationCaseUpdateOne) SetUserID(i int) *ModerationCaseUpdateOne {
+	mcuo.mutation.SetUserID(i)
+	return mcuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (mcuo *ModerationCaseUpdateOne) SetNillableUserID(i *int) *ModerationCaseUpdateOne {
+	if i != nil {
+		mcuo.SetUserID(*i)
+	}
+	return mcuo
+}
+
+// SetShareID sets the "share_id" field.
+func (mcuo *ModerationCaseUpdateOne) SetShareID(i int) *ModerationCaseUpdateOne {
+	mcuo.mutation.ResetShareID()
+	mcuo.mutation.SetShareID(i)
+	return mcuo
+}
+
+// SetNillableShareID sets the "share_id" field if the given value is not nil.
+func (mcuo *ModerationCaseUpdateOne) SetNillableShareID(i *int) *ModerationCaseUpdateOne {
+	if i != nil {
+		mcuo.SetShareID(*i)
+	}
+	return mcuo
+}
+
+// AddShareID adds i to the "share_id" field.
+func (mcuo *ModerationCaseUpdateOne) AddShareID(i int) *ModerationCaseUpdateOne {
+	mcuo.mutation.AddShareID(i)
+	return mcuo
+}
+
+// ClearShareID clears the value of the "share_id" field.
+func (mcuo *ModerationCaseUpdateOne) ClearShareID() *ModerationCaseUpdateOne {
+	mcuo.mutation.ClearShareID()
+	return mcuo
+}
+
+// SetFileID sets the "file_id" field.
+func (mcuo *ModerationCaseUpdateOne) SetFileID(i int) *ModerationCaseUpdateOne {
+	mcuo.mutation.ResetFileID()
+	mcuo.mutation.SetFileID(i)
+	return mcuo
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (mcuo *ModerationCaseUpdateOne) SetNillableFileID(i *int) *ModerationCaseUpdateOne {
+	if i != nil {
+		mcuo.SetFileID(*i)
+	}
+	return mcuo
+}
+
+// AddFileID adds i to the "file_id" field.
+func (mcuo *ModerationCaseUpdateOne) AddFileID(i int) *ModerationCaseUpdateOne {
+	mcuo.mutation.AddFileID(i)
+	return mcuo
+}
+
+// SetStatus sets the "status" field.
+func (mcuo *ModerationCaseUpdateOne) SetStatus(m moderationcase.Status) *ModerationCaseUpdateOne {
+	mcuo.mutation.SetStatus(m)
+	return mcuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mcuo *ModerationCaseUpdateOne) SetNillableStatus(m *moderationcase.Status) *ModerationCaseUpdateOne {
+	if m != nil {
+		mcuo.SetStatus(*m)
+	}
+	return mcuo
+}
+
+// SetMatches sets the "matches" field.
+func (mcuo *ModerationCaseUpdateOne) SetMatches(tm []types.ModerationMatch) *ModerationCaseUpdateOne {
+	mcuo.mutation.SetMatches(tm)
+	return mcuo
+}
+
+// AppendMatches appends tm to the "matches" field.
+func (mcuo *ModerationCaseUpdateOne) AppendMatches(tm []types.ModerationMatch) *ModerationCaseUpdateOne {
+	mcuo.mutation.AppendMatches(tm)
+	return mcuo
+}
+
+// ClearMatches clears the value of the "matches" field.
+func (mcuo *ModerationCaseUpdateOne) ClearMatches() *ModerationCaseUpdateOne {
+	mcuo.mutation.ClearMatches()
+	return mcuo
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (mcuo *ModerationCaseUpdateOne) SetReviewedBy(i int) *ModerationCaseUpdateOne {
+	mcuo.mutation.ResetReviewedBy()
+	mcuo.mutation.SetReviewedBy(i)
+	return mcuo
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (mcuo *ModerationCaseUpdateOne) SetNillableReviewedBy(i *int) *ModerationCaseUpdateOne {
+	if i != nil {
+		mcuo.SetReviewedBy(*i)
+	}
+	return mcuo
+}
+
+// AddReviewedBy adds i to the "reviewed_by" field.
+func (mcuo *ModerationCaseUpdateOne) AddReviewedBy(i int) *ModerationCaseUpdateOne {
+	mcuo.mutation.AddReviewedBy(i)
+	return mcuo
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (mcuo *ModerationCaseUpdateOne) ClearReviewedBy() *ModerationCaseUpdateOne {
+	mcuo.mutation.ClearReviewedBy()
+	return mcuo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (mcuo *ModerationCaseUpdateOne) SetReviewedAt(t time.Time) *ModerationCaseUpdateOne {
+	mcuo.mutation.SetReviewedAt(t)
+	return mcuo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (mcuo *ModerationCaseUpdateOne) SetNillableReviewedAt(t *time.Time) *ModerationCaseUpdateOne {
+	if t != nil {
+		mcuo.SetReviewedAt(*t)
+	}
+	return mcuo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (mcuo *ModerationCaseUpdateOne) ClearReviewedAt() *ModerationCaseUpdateOne {
+	mcuo.mutation.ClearReviewedAt()
+	return mcuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (mcuo *ModerationCaseUpdateOne) SetUser(u *User) *ModerationCaseUpdateOne {
+	return mcuo.SetUserID(u.ID)
+}
+
+// Mutation returns the ModerationCaseMutation object of the builder.
+func (mcuo *ModerationCaseUpdateOne) Mutation() *ModerationCaseMutation {
+	return mcuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (mcuo *ModerationCaseUpdateOne) ClearUser() *ModerationCaseUpdateOne {
+	mcuo.mutation.ClearUser()
+	return mcuo
+}
+
+// Where appends a list predicates to the ModerationCaseUpdate builder.
+func (mcuo *ModerationCaseUpdateOne) Where(ps ...predicate.ModerationCase) *ModerationCaseUpdateOne {
+	mcuo.mutation.Where(ps...)
+	return mcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mcuo *ModerationCaseUpdateOne) Select(field string, fields ...string) *ModerationCaseUpdateOne {
+	mcuo.fields = append([]string{field}, fields...)
+	return mcuo
+}
+
+// Save executes the query and returns the updated ModerationCase entity.
+func (mcuo *ModerationCaseUpdateOne) Save(ctx context.Context) (*ModerationCase, error) {
+	if err := mcuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, mcuo.sqlSave, mcuo.mutation, mcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcuo *ModerationCaseUpdateOne) SaveX(ctx context.Context) *ModerationCase {
+	node, err := mcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mcuo *ModerationCaseUpdateOne) Exec(ctx context.Context) error {
+	_, err := mcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcuo *ModerationCaseUpdateOne) ExecX(ctx context.Context) {
+	if err := mcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mcuo *ModerationCaseUpdateOne) defaults() error {
+	if _, ok := mcuo.mutation.UpdatedAt(); !ok {
+		if moderationcase.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized moderationcase.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := moderationcase.UpdateDefaultUpdatedAt()
+		mcuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mcuo *ModerationCaseUpdateOne) check() error {
+	if v, ok := mcuo.mutation.Status(); ok {
+		if err := moderationcase.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ModerationCase.status": %w`, err)}
+		}
+	}
+	if _, ok := mcuo.mutation.UserID(); mcuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ModerationCase.user"`)
+	}
+	return nil
+}
+
+func (mcuo *ModerationCaseUpdateOne) sqlSave(ctx context.Context) (_node *ModerationCase, err error) {
+	if err := mcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(moderationcase.Table, moderationcase.Columns, sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeInt))
+	id, ok := mcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ModerationCase.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, moderationcase.FieldID)
+		for _, f := range fields {
+			if !moderationcase.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != moderationcase.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(moderationcase.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mcuo.mutation.DeletedAt(); ok {
+		_spec.SetField(moderationcase.FieldDeletedAt, field.TypeTime, value)
+	}
+	if mcuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(moderationcase.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := mcuo.mutation.ShareID(); ok {
+		_spec.SetField(moderationcase.FieldShareID, field.TypeInt, value)
+	}
+	if value, ok := mcuo.mutation.AddedShareID(); ok {
+		_spec.AddField(moderationcase.FieldShareID, field.TypeInt, value)
+	}
+	if mcuo.mutation.ShareIDCleared() {
+		_spec.ClearField(moderationcase.FieldShareID, field.TypeInt)
+	}
+	if value, ok := mcuo.mutation.FileID(); ok {
+		_spec.SetField(moderationcase.FieldFileID, field.TypeInt, value)
+	}
+	if value, ok := mcuo.mutation.AddedFileID(); ok {
+		_spec.AddField(moderationcase.FieldFileID, field.TypeInt, value)
+	}
+	if value, ok := mcuo.mutation.Status(); ok {
+		_spec.SetField(moderationcase.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := mcuo.mutation.Matches(); ok {
+		_spec.SetField(moderationcase.FieldMatches, field.TypeJSON, value)
+	}
+	if value, ok := mcuo.mutation.AppendedMatches(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, moderationcase.FieldMatches, value)
+		})
+	}
+	if mcuo.mutation.MatchesCleared() {
+		_spec.ClearField(moderationcase.FieldMatches, field.TypeJSON)
+	}
+	if value, ok := mcuo.mutation.ReviewedBy(); ok {
+		_spec.SetField(moderationcase.FieldReviewedBy, field.TypeInt, value)
+	}
+	if value, ok := mcuo.mutation.AddedReviewedBy(); ok {
+		_spec.AddField(moderationcase.FieldReviewedBy, field.TypeInt, value)
+	}
+	if mcuo.mutation.ReviewedByCleared() {
+		_spec.ClearField(moderationcase.FieldReviewedBy, field.TypeInt)
+	}
+	if value, ok := mcuo.mutation.ReviewedAt(); ok {
+		_spec.SetField(moderationcase.FieldReviewedAt, field.TypeTime, value)
+	}
+	if mcuo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(moderationcase.FieldReviewedAt, field.TypeTime)
+	}
+	if mcuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationcase.UserTable,
+			Columns: []string{moderationcase.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mcuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   moderationcase.UserTable,
+			Columns: []string{moderationcase.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ModerationCase{config: mcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationcase.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mcuo.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,48 @@ package ent
 
 // SetUpdatedAt sets the "updated_at" field.
 
+func (m *AccessTokenMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
+func (m *AnnouncementMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
+func (m *AuditLogMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
+func (m *AutomationRuleMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
+func (m *CheckoutMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
+func (m *DailyActiveUserMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
+func (m *DailyStatMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
 func (m *DavAccountMutation) SetRawID(t int) {
 	m.id = &t
 }
@@ -34,24 +76,72 @@ func (m *GroupMutation) SetRawID(t int) {
 
 // SetUpdatedAt sets the "updated_at" field.
 
+func (m *InvitationMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
 func (m *MetadataMutation) SetRawID(t int) {
 	m.id = &t
 }
 
 // SetUpdatedAt sets the "updated_at" field.
 
+func (m *ModerationCaseMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
 func (m *NodeMutation) SetRawID(t int) {
 	m.id = &t
 }
 
 // SetUpdatedAt sets the "updated_at" field.
 
+func (m *NotificationMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
+func (m *OrganizationMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
 func (m *PasskeyMutation) SetRawID(t int) {
 	m.id = &t
 }
 
 // SetUpdatedAt sets the "updated_at" field.
 
+func (m *RetentionRuleMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
+func (m *RssSubscriptionMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
+func (m *S3AccessKeyMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
+func (m *SavedSearchMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
 func (m *SettingMutation) SetRawID(t int) {
 	m.id = &t
 }
@@ -70,6 +160,12 @@ func (m *StoragePolicyMutation) SetRawID(t int) {
 
 // SetUpdatedAt sets the "updated_at" field.
 
+func (m *SyncJobMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
 func (m *TaskMutation) SetRawID(t int) {
 	m.id = &t
 }
@@ -79,3 +175,27 @@ func (m *TaskMutation) SetRawID(t int) {
 func (m *UserMutation) SetRawID(t int) {
 	m.id = &t
 }
+
+// SetUpdatedAt sets the "updated_at" field.
+
+func (m *UserEmailMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
+func (m *ViewPreferenceMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
+func (m *WebhookMutation) SetRawID(t int) {
+	m.id = &t
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+
+func (m *WebhookDeliveryMutation) SetRawID(t int) {
+	m.id = &t
+}
@@ -55,6 +55,8 @@ type NodeEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [1]bool
+
+	namedStoragePolicy map[string][]*StoragePolicy
 }
 
 // StoragePolicyOrErr returns the StoragePolicy value or an error if the edge
@@ -256,5 +258,29 @@ func (e *Node) SetStoragePolicy(v []*StoragePolicy) {
 	e.Edges.loadedTypes[0] = true
 }
 
+// NamedStoragePolicy returns the StoragePolicy named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (n *Node) NamedStoragePolicy(name string) ([]*StoragePolicy, error) {
+	if n.Edges.namedStoragePolicy == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := n.Edges.namedStoragePolicy[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (n *Node) appendNamedStoragePolicy(name string, edges ...*StoragePolicy) {
+	if n.Edges.namedStoragePolicy == nil {
+		n.Edges.namedStoragePolicy = make(map[string][]*StoragePolicy)
+	}
+	if len(edges) == 0 {
+		n.Edges.namedStoragePolicy[name] = []*StoragePolicy{}
+	} else {
+		n.Edges.namedStoragePolicy[name] = append(n.Edges.namedStoragePolicy[name], edges...)
+	}
+}
+
 // Nodes is a parsable slice of Node.
 type Nodes []*Node
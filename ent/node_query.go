@@ -19,11 +19,14 @@ import (
 // NodeQuery is the builder for querying Node entities.
 type NodeQuery struct {
 	config
-	ctx               *QueryContext
-	order             []node.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.Node
-	withStoragePolicy *StoragePolicyQuery
+	ctx                    *QueryContext
+	order                  []node.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.Node
+	withStoragePolicy      *StoragePolicyQuery
+	modifiers              []func(*sql.Selector)
+	loadTotal              []func(context.Context, []*Node) error
+	withNamedStoragePolicy map[string]*StoragePolicyQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -383,6 +386,9 @@ func (nq *NodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Node, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(nq.modifiers) > 0 {
+		_spec.Modifiers = nq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -399,6 +405,18 @@ func (nq *NodeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Node, e
 			return nil, err
 		}
 	}
+	for name, query := range nq.withNamedStoragePolicy {
+		if err := nq.loadStoragePolicy(ctx, query, nodes,
+			func(n *Node) { n.appendNamedStoragePolicy(name) },
+			func(n *Node, e *StoragePolicy) { n.appendNamedStoragePolicy(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range nq.loadTotal {
+		if err := nq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -435,6 +453,9 @@ func (nq *NodeQuery) loadStoragePolicy(ctx context.Context, query *StoragePolicy
 
 func (nq *NodeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := nq.querySpec()
+	if len(nq.modifiers) > 0 {
+		_spec.Modifiers = nq.modifiers
+	}
 	_spec.Node.Columns = nq.ctx.Fields
 	if len(nq.ctx.Fields) > 0 {
 		_spec.Unique = nq.ctx.Unique != nil && *nq.ctx.Unique
@@ -514,6 +535,20 @@ func (nq *NodeQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedStoragePolicy tells the query-builder to eager-load the nodes that are connected to the "storage_policy"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (nq *NodeQuery) WithNamedStoragePolicy(name string, opts ...func(*StoragePolicyQuery)) *NodeQuery {
+	query := (&StoragePolicyClient{config: nq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if nq.withNamedStoragePolicy == nil {
+		nq.withNamedStoragePolicy = make(map[string]*StoragePolicyQuery)
+	}
+	nq.withNamedStoragePolicy[name] = query
+	return nq
+}
+
 // NodeGroupBy is the group-by builder for Node entities.
 type NodeGroupBy struct {
 	selector
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/notification"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// Notification is the model entity for the Notification schema.
+type Notification struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// Props holds the value of the "props" field.
+	Props map[string]string `json:"props,omitempty"`
+	// Link holds the value of the "link" field.
+	Link string `json:"link,omitempty"`
+	// ReadAt holds the value of the "read_at" field.
+	ReadAt *time.Time `json:"read_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NotificationQuery when eager-loading is set.
+	Edges        NotificationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// NotificationEdges holds the relations/edges for other nodes in the graph.
+type NotificationEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Notification) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notification.FieldProps:
+			values[i] = new([]byte)
+		case notification.FieldID, notification.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case notification.FieldType, notification.FieldLink:
+			values[i] = new(sql.NullString)
+		case notification.FieldCreatedAt, notification.FieldUpdatedAt, notification.FieldDeletedAt, notification.FieldReadAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Notification fields.
+func (n *Notification) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notification.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			n.ID = int(value.Int64)
+		case notification.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				n.CreatedAt = value.Time
+			}
+		case notification.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				n.UpdatedAt = value.Time
+			}
+		case notification.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				n.DeletedAt = new(time.Time)
+				*n.DeletedAt = value.Time
+			}
+		case notification.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				n.UserID = int(value.Int64)
+			}
+		case notification.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				n.Type = value.String
+			}
+		case notification.FieldProps:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field props", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &n.Props); err != nil {
+					return fmt.Errorf("unmarshal field props: %w", err)
+				}
+			}
+		case notification.FieldLink:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field link", values[i])
+			} else if value.Valid {
+				n.Link = value.String
+			}
+		case notification.FieldReadAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field read_at", values[i])
+			} else if value.Valid {
+				n.ReadAt = new(time.Time)
+				*n.ReadAt = value.Time
+			}
+		default:
+			n.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Notification.
+// This includes values selected through modifiers, order, etc.
+func (n *Notification) Value(name string) (ent.Value, error) {
+	return n.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Notification entity.
+func (n *Notification) QueryUser() *UserQuery {
+	return NewNotificationClient(n.config).QueryUser(n)
+}
+
+// Update returns a builder for updating this Notification.
+// Note that you need to call Notification.Unwrap() before calling this method if this Notification
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (n *Notification) Update() *NotificationUpdateOne {
+	return NewNotificationClient(n.config).UpdateOne(n)
+}
+
+// Unwrap unwraps the Notification entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (n *Notification) Unwrap() *Notification {
+	_tx, ok := n.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Notification is not a transactional entity")
+	}
+	n.config.driver = _tx.drv
+	return n
+}
+
+// String implements the fmt.Stringer.
+func (n *Notification) String() string {
+	var builder strings.Builder
+	builder.WriteString("Notification(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", n.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(n.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(n.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := n.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", n.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(n.Type)
+	builder.WriteString(", ")
+	builder.WriteString("props=")
+	builder.WriteString(fmt.Sprintf("%v", n.Props))
+	builder.WriteString(", ")
+	builder.WriteString("link=")
+	builder.WriteString(n.Link)
+	builder.WriteString(", ")
+	if v := n.ReadAt; v != nil {
+		builder.WriteString("read_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetUser manually set the edge as loaded state.
+func (e *Notification) SetUser(v *User) {
+	e.Edges.User = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// Notifications is a parsable slice of Notification.
+type Notifications []*Notification
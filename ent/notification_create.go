@@ -0,0 +1,963 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/notification"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// NotificationCreate is the builder for creating a Notification entity.
+type NotificationCreate struct {
+	config
+	mutation *NotificationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nc *NotificationCreate) SetCreatedAt(t time.Time) *NotificationCreate {
+	nc.mutation.SetCreatedAt(t)
+	return nc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableCreatedAt(t *time.Time) *NotificationCreate {
+	if t != nil {
+		nc.SetCreatedAt(*t)
+	}
+	return nc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nc *NotificationCreate) SetUpdatedAt(t time.Time) *NotificationCreate {
+	nc.mutation.SetUpdatedAt(t)
+	return nc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableUpdatedAt(t *time.Time) *NotificationCreate {
+	if t != nil {
+		nc.SetUpdatedAt(*t)
+	}
+	return nc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (nc *NotificationCreate) SetDeletedAt(t time.Time) *NotificationCreate {
+	nc.mutation.SetDeletedAt(t)
+	return nc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableDeletedAt(t *time.Time) *NotificationCreate {
+	if t != nil {
+		nc.SetDeletedAt(*t)
+	}
+	return nc
+}
+
+// SetUserID sets the "user_id" field.
+func (nc *NotificationCreate) SetUserID(i int) *NotificationCreate {
+	nc.mutation.SetUserID(i)
+	return nc
+}
+
+// SetType sets the "type" field.
+func (nc *NotificationCreate) SetType(s string) *NotificationCreate {
+	nc.mutation.SetType(s)
+	return nc
+}
+
+// SetProps sets the "props" field.
+func (nc *NotificationCreate) SetProps(m map[string]string) *NotificationCreate {
+	nc.mutation.SetProps(m)
+	return nc
+}
+
+// SetLink sets the "link" field.
+func (nc *NotificationCreate) SetLink(s string) *NotificationCreate {
+	nc.mutation.SetLink(s)
+	return nc
+}
+
+// SetNillableLink sets the "link" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableLink(s *string) *NotificationCreate {
+	if s != nil {
+		nc.SetLink(*s)
+	}
+	return nc
+}
+
+// SetReadAt sets the "read_at" field.
+func (nc *NotificationCreate) SetReadAt(t time.Time) *NotificationCreate {
+	nc.mutation.SetReadAt(t)
+	return nc
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableReadAt(t *time.Time) *NotificationCreate {
+	if t != nil {
+		nc.SetReadAt(*t)
+	}
+	return nc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (nc *NotificationCreate) SetUser(u *User) *NotificationCreate {
+	return nc.SetUserID(u.ID)
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nc *NotificationCreate) Mutation() *NotificationMutation {
+	return nc.mutation
+}
+
+// Save creates the Notification in the database.
+func (nc *NotificationCreate) Save(ctx context.Context) (*Notification, error) {
+	if err := nc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, nc.sqlSave, nc.mutation, nc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nc *NotificationCreate) SaveX(ctx context.Context) *Notification {
+	v, err := nc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nc *NotificationCreate) Exec(ctx context.Context) error {
+	_, err := nc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nc *NotificationCreate) ExecX(ctx context.Context) {
+	if err := nc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nc *NotificationCreate) defaults() error {
+	if _, ok := nc.mutation.CreatedAt(); !ok {
+		if notification.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized notification.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := notification.DefaultCreatedAt()
+		nc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := nc.mutation.UpdatedAt(); !ok {
+		if notification.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized notification.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := notification.DefaultUpdatedAt()
+		nc.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nc *NotificationCreate) check() error {
+	if _, ok := nc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Notification.created_at"`)}
+	}
+	if _, ok := nc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Notification.updated_at"`)}
+	}
+	if _, ok := nc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Notification.user_id"`)}
+	}
+	if _, ok := nc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Notification.type"`)}
+	}
+	if _, ok := nc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Notification.user"`)}
+	}
+	return nil
+}
+
+func (nc *NotificationCreate) sqlSave(ctx context.Context) (*Notification, error) {
+	if err := nc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := nc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	nc.mutation.id = &_node.ID
+	nc.mutation.done = true
+	return _node, nil
+}
+
+func (nc *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Notification{config: nc.config}
+		_spec = sqlgraph.NewCreateSpec(notification.Table, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt))
+	)
+
+	if id, ok := nc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = nc.conflict
+	if value, ok := nc.mutation.CreatedAt(); ok {
+		_spec.SetField(notification.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := nc.mutation.UpdatedAt(); ok {
+		_spec.SetField(notification.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := nc.mutation.DeletedAt(); ok {
+		_spec.SetField(notification.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := nc.mutation.GetType(); ok {
+		_spec.SetField(notification.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := nc.mutation.Props(); ok {
+		_spec.SetField(notification.FieldProps, field.TypeJSON, value)
+		_node.Props = value
+	}
+	if value, ok := nc.mutation.Link(); ok {
+		_spec.SetField(notification.FieldLink, field.TypeString, value)
+		_node.Link = value
+	}
+	if value, ok := nc.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+		_node.ReadAt = &value
+	}
+	if nodes := nc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.UserTable,
+			Columns: []string{notification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Notification.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (nc *NotificationCreate) OnConflict(opts ...sql.ConflictOption) *NotificationUpsertOne {
+	nc.conflict = opts
+	return &NotificationUpsertOne{
+		create: nc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (nc *NotificationCreate) OnConflictColumns(columns ...string) *NotificationUpsertOne {
+	nc.conflict = append(nc.conflict, sql.ConflictColumns(columns...))
+	return &NotificationUpsertOne{
+		create: nc,
+	}
+}
+
+type (
+	// NotificationUpsertOne is the builder for "upsert"-ing
+	//  one Notification node.
+	NotificationUpsertOne struct {
+		create *NotificationCreate
+	}
+
+	// NotificationUpsert is the "OnConflict" setter.
+	NotificationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationUpsert) SetUpdatedAt(v time.Time) *NotificationUpsert {
+	u.Set(notification.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateUpdatedAt() *NotificationUpsert {
+	u.SetExcluded(notification.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *NotificationUpsert) SetDeletedAt(v time.Time) *NotificationUpsert {
+	u.Set(notification.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateDeletedAt() *NotificationUpsert {
+	u.SetExcluded(notification.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *NotificationUpsert) ClearDeletedAt() *NotificationUpsert {
+	u.SetNull(notification.FieldDeletedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *NotificationUpsert) SetUserID(v int) *NotificationUpsert {
+	u.Set(notification.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateUserID() *NotificationUpsert {
+	u.SetExcluded(notification.FieldUserID)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *NotificationUpsert) SetType(v string) *NotificationUpsert {
+	u.Set(notification.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateType() *NotificationUpsert {
+	u.SetExcluded(notification.FieldType)
+	return u
+}
+
+// SetProps sets the "props" field.
+func (u *NotificationUpsert) SetProps(v map[string]string) *NotificationUpsert {
+	u.Set(notification.FieldProps, v)
+	return u
+}
+
+// UpdateProps sets the "props" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateProps() *NotificationUpsert {
+	u.SetExcluded(notification.FieldProps)
+	return u
+}
+
+// ClearProps clears the value of the "props" field.
+func (u *NotificationUpsert) ClearProps() *NotificationUpsert {
+	u.SetNull(notification.FieldProps)
+	return u
+}
+
+// SetLink sets the "link" field.
+func (u *NotificationUpsert) SetLink(v string) *NotificationUpsert {
+	u.Set(notification.FieldLink, v)
+	return u
+}
+
+// UpdateLink sets the "link" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateLink() *NotificationUpsert {
+	u.SetExcluded(notification.FieldLink)
+	return u
+}
+
+// ClearLink clears the value of the "link" field.
+func (u *NotificationUpsert) ClearLink() *NotificationUpsert {
+	u.SetNull(notification.FieldLink)
+	return u
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *NotificationUpsert) SetReadAt(v time.Time) *NotificationUpsert {
+	u.Set(notification.FieldReadAt, v)
+	return u
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *NotificationUpsert) UpdateReadAt() *NotificationUpsert {
+	u.SetExcluded(notification.FieldReadAt)
+	return u
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *NotificationUpsert) ClearReadAt() *NotificationUpsert {
+	u.SetNull(notification.FieldReadAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NotificationUpsertOne) UpdateNewValues() *NotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(notification.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NotificationUpsertOne) Ignore() *NotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationUpsertOne) DoNothing() *NotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationCreate.OnConflict
+// documentation for more info.
+func (u *NotificationUpsertOne) Update(set func(*NotificationUpsert)) *NotificationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationUpsertOne) SetUpdatedAt(v time.Time) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateUpdatedAt() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *NotificationUpsertOne) SetDeletedAt(v time.Time) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateDeletedAt() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *NotificationUpsertOne) ClearDeletedAt() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *NotificationUpsertOne) SetUserID(v int) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateUserID() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *NotificationUpsertOne) SetType(v string) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateType() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetProps sets the "props" field.
+func (u *NotificationUpsertOne) SetProps(v map[string]string) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetProps(v)
+	})
+}
+
+// UpdateProps sets the "props" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateProps() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateProps()
+	})
+}
+
+// ClearProps clears the value of the "props" field.
+func (u *NotificationUpsertOne) ClearProps() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearProps()
+	})
+}
+
+// SetLink sets the "link" field.
+func (u *NotificationUpsertOne) SetLink(v string) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetLink(v)
+	})
+}
+
+// UpdateLink sets the "link" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateLink() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateLink()
+	})
+}
+
+// ClearLink clears the value of the "link" field.
+func (u *NotificationUpsertOne) ClearLink() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearLink()
+	})
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *NotificationUpsertOne) SetReadAt(v time.Time) *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetReadAt(v)
+	})
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *NotificationUpsertOne) UpdateReadAt() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateReadAt()
+	})
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *NotificationUpsertOne) ClearReadAt() *NotificationUpsertOne {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearReadAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NotificationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NotificationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *NotificationCreate) SetRawID(t int) *NotificationCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// NotificationCreateBulk is the builder for creating many Notification entities in bulk.
+type NotificationCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Notification entities in the database.
+func (ncb *NotificationCreateBulk) Save(ctx context.Context) ([]*Notification, error) {
+	if ncb.err != nil {
+		return nil, ncb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ncb.builders))
+	nodes := make([]*Notification, len(ncb.builders))
+	mutators := make([]Mutator, len(ncb.builders))
+	for i := range ncb.builders {
+		func(i int, root context.Context) {
+			builder := ncb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ncb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ncb *NotificationCreateBulk) SaveX(ctx context.Context) []*Notification {
+	v, err := ncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ncb *NotificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := ncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ncb *NotificationCreateBulk) ExecX(ctx context.Context) {
+	if err := ncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Notification.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ncb *NotificationCreateBulk) OnConflict(opts ...sql.ConflictOption) *NotificationUpsertBulk {
+	ncb.conflict = opts
+	return &NotificationUpsertBulk{
+		create: ncb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ncb *NotificationCreateBulk) OnConflictColumns(columns ...string) *NotificationUpsertBulk {
+	ncb.conflict = append(ncb.conflict, sql.ConflictColumns(columns...))
+	return &NotificationUpsertBulk{
+		create: ncb,
+	}
+}
+
+// NotificationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Notification nodes.
+type NotificationUpsertBulk struct {
+	create *NotificationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NotificationUpsertBulk) UpdateNewValues() *NotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(notification.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Notification.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NotificationUpsertBulk) Ignore() *NotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationUpsertBulk) DoNothing() *NotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationCreateBulk.OnConflict
+// documentation for more info.
+func (u *NotificationUpsertBulk) Update(set func(*NotificationUpsert)) *NotificationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationUpsertBulk) SetUpdatedAt(v time.Time) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateUpdatedAt() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *NotificationUpsertBulk) SetDeletedAt(v time.Time) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateDeletedAt() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *NotificationUpsertBulk) ClearDeletedAt() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *NotificationUpsertBulk) SetUserID(v int) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateUserID() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *NotificationUpsertBulk) SetType(v string) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateType() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetProps sets the "props" field.
+func (u *NotificationUpsertBulk) SetProps(v map[string]string) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetProps(v)
+	})
+}
+
+// UpdateProps sets the "props" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateProps() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateProps()
+	})
+}
+
+// ClearProps clears the value of the "props" field.
+func (u *NotificationUpsertBulk) ClearProps() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearProps()
+	})
+}
+
+// SetLink sets the "link" field.
+func (u *NotificationUpsertBulk) SetLink(v string) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetLink(v)
+	})
+}
+
+// UpdateLink sets the "link" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateLink() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateLink()
+	})
+}
+
+// ClearLink clears the value of the "link" field.
+func (u *NotificationUpsertBulk) ClearLink() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearLink()
+	})
+}
+
+// SetReadAt sets the "read_at" field.
+func (u *NotificationUpsertBulk) SetReadAt(v time.Time) *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.SetReadAt(v)
+	})
+}
+
+// UpdateReadAt sets the "read_at" field to the value that was provided on create.
+func (u *NotificationUpsertBulk) UpdateReadAt() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.UpdateReadAt()
+	})
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (u *NotificationUpsertBulk) ClearReadAt() *NotificationUpsertBulk {
+	return u.Update(func(s *NotificationUpsert) {
+		s.ClearReadAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NotificationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/notification"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// NotificationDelete is the builder for deleting a Notification entity.
+type NotificationDelete struct {
+	config
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// Where appends a list predicates to the NotificationDelete builder.
+func (nd *NotificationDelete) Where(ps ...predicate.Notification) *NotificationDelete {
+	nd.mutation.Where(ps...)
+	return nd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (nd *NotificationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, nd.sqlExec, nd.mutation, nd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nd *NotificationDelete) ExecX(ctx context.Context) int {
+	n, err := nd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (nd *NotificationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(notification.Table, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt))
+	if ps := nd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, nd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	nd.mutation.done = true
+	return affected, err
+}
+
+// NotificationDeleteOne is the builder for deleting a single Notification entity.
+type NotificationDeleteOne struct {
+	nd *NotificationDelete
+}
+
+// Where appends a list predicates to the NotificationDelete builder.
+func (ndo *NotificationDeleteOne) Where(ps ...predicate.Notification) *NotificationDeleteOne {
+	ndo.nd.mutation.Where(ps...)
+	return ndo
+}
+
+// Exec executes the deletion query.
+func (ndo *NotificationDeleteOne) Exec(ctx context.Context) error {
+	n, err := ndo.nd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{notification.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndo *NotificationDeleteOne) ExecX(ctx context.Context) {
+	if err := ndo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
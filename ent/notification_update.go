@@ -0,0 +1,581 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/notification"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// NotificationUpdate is the builder for updating Notification entities.
+type NotificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (nu *NotificationUpdate) Where(ps ...predicate.Notification) *NotificationUpdate {
+	nu.mutation.Where(ps...)
+	return nu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nu *NotificationUpdate) SetUpdatedAt(t time.Time) *NotificationUpdate {
+	nu.mutation.SetUpdatedAt(t)
+	return nu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (nu *NotificationUpdate) SetDeletedAt(t time.Time) *NotificationUpdate {
+	nu.mutation.SetDeletedAt(t)
+	return nu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableDeletedAt(t *time.Time) *NotificationUpdate {
+	if t != nil {
+		nu.SetDeletedAt(*t)
+	}
+	return nu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (nu *NotificationUpdate) ClearDeletedAt() *NotificationUpdate {
+	nu.mutation.ClearDeletedAt()
+	return nu
+}
+
+// SetUserID sets the "user_id" field.
+func (nu *NotificationUpdate) SetUserID(i int) *NotificationUpdate {
+	nu.mutation.SetUserID(i)
+	return nu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableUserID(i *int) *NotificationUpdate {
+	if i != nil {
+		nu.SetUserID(*i)
+	}
+	return nu
+}
+
+// SetType sets the "type" field.
+func (nu *NotificationUpdate) SetType(s string) *NotificationUpdate {
+	nu.mutation.SetType(s)
+	return nu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableType(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetType(*s)
+	}
+	return nu
+}
+
+// SetProps sets the "props" field.
+func (nu *NotificationUpdate) SetProps(m map[string]string) *NotificationUpdate {
+	nu.mutation.SetProps(m)
+	return nu
+}
+
+// ClearProps clears the value of the "props" field.
+func (nu *NotificationUpdate) ClearProps() *NotificationUpdate {
+	nu.mutation.ClearProps()
+	return nu
+}
+
+// SetLink sets the "link" field.
+func (nu *NotificationUpdate) SetLink(s string) *NotificationUpdate {
+	nu.mutation.SetLink(s)
+	return nu
+}
+
+// SetNillableLink sets the "link" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableLink(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetLink(*s)
+	}
+	return nu
+}
+
+// ClearLink clears the value of the "link" field.
+func (nu *NotificationUpdate) ClearLink() *NotificationUpdate {
+	nu.mutation.ClearLink()
+	return nu
+}
+
+// SetReadAt sets the "read_at" field.
+func (nu *NotificationUpdate) SetReadAt(t time.Time) *NotificationUpdate {
+	nu.mutation.SetReadAt(t)
+	return nu
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableReadAt(t *time.Time) *NotificationUpdate {
+	if t != nil {
+		nu.SetReadAt(*t)
+	}
+	return nu
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nu *NotificationUpdate) ClearReadAt() *NotificationUpdate {
+	nu.mutation.ClearReadAt()
+	return nu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (nu *NotificationUpdate) SetUser(u *User) *NotificationUpdate {
+	return nu.SetUserID(u.ID)
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nu *NotificationUpdate) Mutation() *NotificationMutation {
+	return nu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (nu *NotificationUpdate) ClearUser() *NotificationUpdate {
+	nu.mutation.ClearUser()
+	return nu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nu *NotificationUpdate) Save(ctx context.Context) (int, error) {
+	if err := nu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, nu.sqlSave, nu.mutation, nu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nu *NotificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := nu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nu *NotificationUpdate) Exec(ctx context.Context) error {
+	_, err := nu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nu *NotificationUpdate) ExecX(ctx context.Context) {
+	if err := nu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nu *NotificationUpdate) defaults() error {
+	if _, ok := nu.mutation.UpdatedAt(); !ok {
+		if notification.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized notification.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := notification.UpdateDefaultUpdatedAt()
+		nu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nu *NotificationUpdate) check() error {
+	if _, ok := nu.mutation.UserID(); nu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Notification.user"`)
+	}
+	return nil
+}
+
+func (nu *NotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := nu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt))
+	if ps := nu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nu.mutation.UpdatedAt(); ok {
+		_spec.SetField(notification.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := nu.mutation.DeletedAt(); ok {
+		_spec.SetField(notification.FieldDeletedAt, field.TypeTime, value)
+	}
+	if nu.mutation.DeletedAtCleared() {
+		_spec.ClearField(notification.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := nu.mutation.GetType(); ok {
+		_spec.SetField(notification.FieldType, field.TypeString, value)
+	}
+	if value, ok := nu.mutation.Props(); ok {
+		_spec.SetField(notification.FieldProps, field.TypeJSON, value)
+	}
+	if nu.mutation.PropsCleared() {
+		_spec.ClearField(notification.FieldProps, field.TypeJSON)
+	}
+	if value, ok := nu.mutation.Link(); ok {
+		_spec.SetField(notification.FieldLink, field.TypeString, value)
+	}
+	if nu.mutation.LinkCleared() {
+		_spec.ClearField(notification.FieldLink, field.TypeString)
+	}
+	if value, ok := nu.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if nu.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
+	if nu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.UserTable,
+			Columns: []string{notification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.UserTable,
+			Columns: []string{notification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nu.mutation.done = true
+	return n, nil
+}
+
+// NotificationUpdateOne is the builder for updating a single Notification entity.
+type NotificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nuo *NotificationUpdateOne) SetUpdatedAt(t time.Time) *NotificationUpdateOne {
+	nuo.mutation.SetUpdatedAt(t)
+	return nuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (nuo *NotificationUpdateOne) SetDeletedAt(t time.Time) *NotificationUpdateOne {
+	nuo.mutation.SetDeletedAt(t)
+	return nuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableDeletedAt(t *time.Time) *NotificationUpdateOne {
+	if t != nil {
+		nuo.SetDeletedAt(*t)
+	}
+	return nuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (nuo *NotificationUpdateOne) ClearDeletedAt() *NotificationUpdateOne {
+	nuo.mutation.ClearDeletedAt()
+	return nuo
+}
+
+// SetUserID sets the "user_id" field.
+func (nuo *NotificationUpdateOne) SetUserID(i int) *NotificationUpdateOne {
+	nuo.mutation.SetUserID(i)
+	return nuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableUserID(i *int) *NotificationUpdateOne {
+	if i != nil {
+		nuo.SetUserID(*i)
+	}
+	return nuo
+}
+
+// SetType sets the "type" field.
+func (nuo *NotificationUpdateOne) SetType(s string) *NotificationUpdateOne {
+	nuo.mutation.SetType(s)
+	return nuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableType(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetType(*s)
+	}
+	return nuo
+}
+
+// SetProps sets the "props" field.
+func (nuo *NotificationUpdateOne) SetProps(m map[string]string) *NotificationUpdateOne {
+	nuo.mutation.SetProps(m)
+	return nuo
+}
+
+// ClearProps clears the value of the "props" field.
+func (nuo *NotificationUpdateOne) ClearProps() *NotificationUpdateOne {
+	nuo.mutation.ClearProps()
+	return nuo
+}
+
+// SetLink sets the "link" field.
+func (nuo *NotificationUpdateOne) SetLink(s string) *NotificationUpdateOne {
+	nuo.mutation.SetLink(s)
+	return nuo
+}
+
+// SetNillableLink sets the "link" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableLink(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetLink(*s)
+	}
+	return nuo
+}
+
+// ClearLink clears the value of the "link" field.
+func (nuo *NotificationUpdateOne) ClearLink() *NotificationUpdateOne {
+	nuo.mutation.ClearLink()
+	return nuo
+}
+
+// SetReadAt sets the "read_at" field.
+func (nuo *NotificationUpdateOne) SetReadAt(t time.Time) *NotificationUpdateOne {
+	nuo.mutation.SetReadAt(t)
+	return nuo
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableReadAt(t *time.Time) *NotificationUpdateOne {
+	if t != nil {
+		nuo.SetReadAt(*t)
+	}
+	return nuo
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nuo *NotificationUpdateOne) ClearReadAt() *NotificationUpdateOne {
+	nuo.mutation.ClearReadAt()
+	return nuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (nuo *NotificationUpdateOne) SetUser(u *User) *NotificationUpdateOne {
+	return nuo.SetUserID(u.ID)
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nuo *NotificationUpdateOne) Mutation() *NotificationMutation {
+	return nuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (nuo *NotificationUpdateOne) ClearUser() *NotificationUpdateOne {
+	nuo.mutation.ClearUser()
+	return nuo
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (nuo *NotificationUpdateOne) Where(ps ...predicate.Notification) *NotificationUpdateOne {
+	nuo.mutation.Where(ps...)
+	return nuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nuo *NotificationUpdateOne) Select(field string, fields ...string) *NotificationUpdateOne {
+	nuo.fields = append([]string{field}, fields...)
+	return nuo
+}
+
+// Save executes the query and returns the updated Notification entity.
+func (nuo *NotificationUpdateOne) Save(ctx context.Context) (*Notification, error) {
+	if err := nuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, nuo.sqlSave, nuo.mutation, nuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) SaveX(ctx context.Context) *Notification {
+	node, err := nuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nuo *NotificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := nuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) ExecX(ctx context.Context) {
+	if err := nuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nuo *NotificationUpdateOne) defaults() error {
+	if _, ok := nuo.mutation.UpdatedAt(); !ok {
+		if notification.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized notification.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := notification.UpdateDefaultUpdatedAt()
+		nuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nuo *NotificationUpdateOne) check() error {
+	if _, ok := nuo.mutation.UserID(); nuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Notification.user"`)
+	}
+	return nil
+}
+
+func (nuo *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notification, err error) {
+	if err := nuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt))
+	id, ok := nuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Notification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notification.FieldID)
+		for _, f := range fields {
+			if !notification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(notification.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := nuo.mutation.DeletedAt(); ok {
+		_spec.SetField(notification.FieldDeletedAt, field.TypeTime, value)
+	}
+	if nuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(notification.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := nuo.mutation.GetType(); ok {
+		_spec.SetField(notification.FieldType, field.TypeString, value)
+	}
+	if value, ok := nuo.mutation.Props(); ok {
+		_spec.SetField(notification.FieldProps, field.TypeJSON, value)
+	}
+	if nuo.mutation.PropsCleared() {
+		_spec.ClearField(notification.FieldProps, field.TypeJSON)
+	}
+	if value, ok := nuo.mutation.Link(); ok {
+		_spec.SetField(notification.FieldLink, field.TypeString, value)
+	}
+	if nuo.mutation.LinkCleared() {
+		_spec.ClearField(notification.FieldLink, field.TypeString)
+	}
+	if value, ok := nuo.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if nuo.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
+	if nuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.UserTable,
+			Columns: []string{notification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.UserTable,
+			Columns: []string{notification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Notification{config: nuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nuo.mutation.done = true
+	return _node, nil
+}
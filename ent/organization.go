@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/organization"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// Organization is the model entity for the Organization schema.
+type Organization struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Branding holds the value of the "branding" field.
+	Branding *types.OrganizationBranding `json:"branding,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrganizationQuery when eager-loading is set.
+	Edges        OrganizationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OrganizationEdges holds the relations/edges for other nodes in the graph.
+type OrganizationEdges struct {
+	// Groups holds the value of the groups edge.
+	Groups []*Group `json:"groups,omitempty"`
+	// Admins holds the value of the admins edge.
+	Admins []*User `json:"admins,omitempty"`
+	// StoragePolicies holds the value of the storage_policies edge.
+	StoragePolicies []*StoragePolicy `json:"storage_policies,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedGroups          map[string][]*Group
+	namedAdmins          map[string][]*User
+	namedStoragePolicies map[string][]*StoragePolicy
+}
+
+// GroupsOrErr returns the Groups value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) GroupsOrErr() ([]*Group, error) {
+	if e.loadedTypes[0] {
+		return e.Groups, nil
+	}
+	return nil, &NotLoadedError{edge: "groups"}
+}
+
+// AdminsOrErr returns the Admins value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) AdminsOrErr() ([]*User, error) {
+	if e.loadedTypes[1] {
+		return e.Admins, nil
+	}
+	return nil, &NotLoadedError{edge: "admins"}
+}
+
+// StoragePoliciesOrErr returns the StoragePolicies value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) StoragePoliciesOrErr() ([]*StoragePolicy, error) {
+	if e.loadedTypes[2] {
+		return e.StoragePolicies, nil
+	}
+	return nil, &NotLoadedError{edge: "storage_policies"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Organization) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case organization.FieldBranding:
+			values[i] = new([]byte)
+		case organization.FieldID:
+			values[i] = new(sql.NullInt64)
+		case organization.FieldName:
+			values[i] = new(sql.NullString)
+		case organization.FieldCreatedAt, organization.FieldUpdatedAt, organization.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Organization fields.
+func (o *Organization) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case organization.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			o.ID = int(value.Int64)
+		case organization.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				o.CreatedAt = value.Time
+			}
+		case organization.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				o.UpdatedAt = value.Time
+			}
+		case organization.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				o.DeletedAt = new(time.Time)
+				*o.DeletedAt = value.Time
+			}
+		case organization.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				o.Name = value.String
+			}
+		case organization.FieldBranding:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field branding", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.Branding); err != nil {
+					return fmt.Errorf("unmarshal field branding: %w", err)
+				}
+			}
+		default:
+			o.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Organization.
+// This includes values selected through modifiers, order, etc.
+func (o *Organization) Value(name string) (ent.Value, error) {
+	return o.selectValues.Get(name)
+}
+
+// QueryGroups queries the "groups" edge of the Organization entity.
+func (o *Organization) QueryGroups() *GroupQuery {
+	return NewOrganizationClient(o.config).QueryGroups(o)
+}
+
+// QueryAdmins queries the "admins" edge of the Organization entity.
+func (o *Organization) QueryAdmins() *UserQuery {
+	return NewOrganizationClient(o.config).QueryAdmins(o)
+}
+
+// QueryStoragePolicies queries the "storage_policies" edge of the Organization entity.
+func (o *Organization) QueryStoragePolicies() *StoragePolicyQuery {
+	return NewOrganizationClient(o.config).QueryStoragePolicies(o)
+}
+
+// Update returns a builder for updating this Organization.
+// Note that you need to call Organization.Unwrap() before calling this method if this Organization
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (o *Organization) Update() *OrganizationUpdateOne {
+	return NewOrganizationClient(o.config).UpdateOne(o)
+}
+
+// Unwrap unwraps the Organization entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (o *Organization) Unwrap() *Organization {
+	_tx, ok := o.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Organization is not a transactional entity")
+	}
+	o.config.driver = _tx.drv
+	return o
+}
+
+// String implements the fmt.Stringer.
+func (o *Organization) String() string {
+	var builder strings.Builder
+	builder.WriteString("Organization(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", o.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(o.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(o.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := o.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(o.Name)
+	builder.WriteString(", ")
+	builder.WriteString("branding=")
+	builder.WriteString(fmt.Sprintf("%v", o.Branding))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetGroups manually set the edge as loaded state.
+func (e *Organization) SetGroups(v []*Group) {
+	e.Edges.Groups = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// SetAdmins manually set the edge as loaded state.
+func (e *Organization) SetAdmins(v []*User) {
+	e.Edges.Admins = v
+	e.Edges.loadedTypes[1] = true
+}
+
+// SetStoragePolicies manually set the edge as loaded state.
+func (e *Organization) SetStoragePolicies(v []*StoragePolicy) {
+	e.Edges.StoragePolicies = v
+	e.Edges.loadedTypes[2] = true
+}
+
+// NamedGroups returns the Groups named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (o *Organization) NamedGroups(name string) ([]*Group, error) {
+	if o.Edges.namedGroups == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := o.Edges.namedGroups[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (o *Organization) appendNamedGroups(name string, edges ...*Group) {
+	if o.Edges.namedGroups == nil {
+		o.Edges.namedGroups = make(map[string][]*Group)
+	}
+	if len(edges) == 0 {
+		o.Edges.namedGroups[name] = []*Group{}
+	} else {
+		o.Edges.namedGroups[name] = append(o.Edges.namedGroups[name], edges...)
+	}
+}
+
+// NamedAdmins returns the Admins named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (o *Organization) NamedAdmins(name string) ([]*User, error) {
+	if o.Edges.namedAdmins == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := o.Edges.namedAdmins[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (o *Organization) appendNamedAdmins(name string, edges ...*User) {
+	if o.Edges.namedAdmins == nil {
+		o.Edges.namedAdmins = make(map[string][]*User)
+	}
+	if len(edges) == 0 {
+		o.Edges.namedAdmins[name] = []*User{}
+	} else {
+		o.Edges.namedAdmins[name] = append(o.Edges.namedAdmins[name], edges...)
+	}
+}
+
+// NamedStoragePolicies returns the StoragePolicies named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (o *Organization) NamedStoragePolicies(name string) ([]*StoragePolicy, error) {
+	if o.Edges.namedStoragePolicies == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := o.Edges.namedStoragePolicies[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (o *Organization) appendNamedStoragePolicies(name string, edges ...*StoragePolicy) {
+	if o.Edges.namedStoragePolicies == nil {
+		o.Edges.namedStoragePolicies = make(map[string][]*StoragePolicy)
+	}
+	if len(edges) == 0 {
+		o.Edges.namedStoragePolicies[name] = []*StoragePolicy{}
+	} else {
+		o.Edges.namedStoragePolicies[name] = append(o.Edges.namedStoragePolicies[name], edges...)
+	}
+}
+
+// Organizations is a parsable slice of Organization.
+type Organizations []*Organization
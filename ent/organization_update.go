@@ -0,0 +1,836 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/group"
+	"github.com/cloudreve/Cloudreve/v4/ent/organization"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/storagepolicy"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// OrganizationUpdate is the builder for updating Organization entities.
+type OrganizationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrganizationMutation
+}
+
+// Where appends a list predicates to the OrganizationUpdate builder.
+func (ou *OrganizationUpdate) Where(ps ...predicate.Organization) *OrganizationUpdate {
+	ou.mutation.Where(ps...)
+	return ou
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ou *OrganizationUpdate) SetUpdatedAt(t time.Time) *OrganizationUpdate {
+	ou.mutation.SetUpdatedAt(t)
+	return ou
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ou *OrganizationUpdate) SetDeletedAt(t time.Time) *OrganizationUpdate {
+	ou.mutation.SetDeletedAt(t)
+	return ou
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ou *OrganizationUpdate) SetNillableDeletedAt(t *time.Time) *OrganizationUpdate {
+	if t != nil {
+		ou.SetDeletedAt(*t)
+	}
+	return ou
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ou *OrganizationUpdate) ClearDeletedAt() *OrganizationUpdate {
+	ou.mutation.ClearDeletedAt()
+	return ou
+}
+
+// SetName sets the "name" field.
+func (ou *OrganizationUpdate) SetName(s string) *OrganizationUpdate {
+	ou.mutation.SetName(s)
+	return ou
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ou *OrganizationUpdate) SetNillableName(s *string) *OrganizationUpdate {
+	if s != nil {
+		ou.SetName(*s)
+	}
+	return ou
+}
+
+// SetBranding sets the "branding" field.
+func (ou *OrganizationUpdate) SetBranding(tb *types.OrganizationBranding) *OrganizationUpdate {
+	ou.mutation.SetBranding(tb)
+	return ou
+}
+
+// ClearBranding clears the value of the "branding" field.
+func (ou *OrganizationUpdate) ClearBranding() *OrganizationUpdate {
+	ou.mutation.ClearBranding()
+	return ou
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (ou *OrganizationUpdate) AddGroupIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.AddGroupIDs(ids...)
+	return ou
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (ou *OrganizationUpdate) AddGroups(g ...*Group) *OrganizationUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return ou.AddGroupIDs(ids...)
+}
+
+// AddAdminIDs adds the "admins" edge to the User entity by IDs.
+func (ou *OrganizationUpdate) AddAdminIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.AddAdminIDs(ids...)
+	return ou
+}
+
+// AddAdmins adds the "admins" edges to the User entity.
+func (ou *OrganizationUpdate) AddAdmins(u ...*User) *OrganizationUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ou.AddAdminIDs(ids...)
+}
+
+// AddStoragePolicyIDs adds the "storage_policies" edge to the StoragePolicy entity by IDs.
+func (ou *OrganizationUpdate) AddStoragePolicyIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.AddStoragePolicyIDs(ids...)
+	return ou
+}
+
+// AddStoragePolicies adds the "storage_policies" edges to the StoragePolicy entity.
+func (ou *OrganizationUpdate) AddStoragePolicies(s ...*StoragePolicy) *OrganizationUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ou.AddStoragePolicyIDs(ids...)
+}
+
+// Mutation returns the OrganizationMutation object of the builder.
+func (ou *OrganizationUpdate) Mutation() *OrganizationMutation {
+	return ou.mutation
+}
+
+// ClearGroups clears all "groups" edges to the Group entity.
+func (ou *OrganizationUpdate) ClearGroups() *OrganizationUpdate {
+	ou.mutation.ClearGroups()
+	return ou
+}
+
+// RemoveGroupIDs removes the "groups" edge to Group entities by IDs.
+func (ou *OrganizationUpdate) RemoveGroupIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.RemoveGroupIDs(ids...)
+	return ou
+}
+
+// RemoveGroups removes "groups" edges to Group entities.
+func (ou *OrganizationUpdate) RemoveGroups(g ...*Group) *OrganizationUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return ou.RemoveGroupIDs(ids...)
+}
+
+// ClearAdmins clears all "admins" edges to the User entity.
+func (ou *OrganizationUpdate) ClearAdmins() *OrganizationUpdate {
+	ou.mutation.ClearAdmins()
+	return ou
+}
+
+// RemoveAdminIDs removes the "admins" edge to User entities by IDs.
+func (ou *OrganizationUpdate) RemoveAdminIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.RemoveAdminIDs(ids...)
+	return ou
+}
+
+// RemoveAdmins removes "admins" edges to User entities.
+func (ou *OrganizationUpdate) RemoveAdmins(u ...*User) *OrganizationUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ou.RemoveAdminIDs(ids...)
+}
+
+// ClearStoragePolicies clears all "storage_policies" edges to the StoragePolicy entity.
+func (ou *OrganizationUpdate) ClearStoragePolicies() *OrganizationUpdate {
+	ou.mutation.ClearStoragePolicies()
+	return ou
+}
+
+// RemoveStoragePolicyIDs removes the "storage_policies" edge to StoragePolicy entities by IDs.
+func (ou *OrganizationUpdate) RemoveStoragePolicyIDs(ids ...int) *OrganizationUpdate {
+	ou.mutation.RemoveStoragePolicyIDs(ids...)
+	return ou
+}
+
+// RemoveStoragePolicies removes "storage_policies" edges to StoragePolicy entities.
+func (ou *OrganizationUpdate) RemoveStoragePolicies(s ...*StoragePolicy) *OrganizationUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ou.RemoveStoragePolicyIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ou *OrganizationUpdate) Save(ctx context.Context) (int, error) {
+	if err := ou.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, ou.sqlSave, ou.mutation, ou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ou *OrganizationUpdate) SaveX(ctx context.Context) int {
+	affected, err := ou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ou *OrganizationUpdate) Exec(ctx context.Context) error {
+	_, err := ou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ou *OrganizationUpdate) ExecX(ctx context.Context) {
+	if err := ou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ou *OrganizationUpdate) defaults() error {
+	if _, ok := ou.mutation.UpdatedAt(); !ok {
+		if organization.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized organization.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := organization.UpdateDefaultUpdatedAt()
+		ou.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(organization.Table, organization.Columns, sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt))
+	if ps := ou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ou.mutation.UpdatedAt(); ok {
+		_spec.SetField(organization.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ou.mutation.DeletedAt(); ok {
+		_spec.SetField(organization.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ou.mutation.DeletedAtCleared() {
+		_spec.ClearField(organization.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ou.mutation.Name(); ok {
+		_spec.SetField(organization.FieldName, field.TypeString, value)
+	}
+	if value, ok := ou.mutation.Branding(); ok {
+		_spec.SetField(organization.FieldBranding, field.TypeJSON, value)
+	}
+	if ou.mutation.BrandingCleared() {
+		_spec.ClearField(organization.FieldBranding, field.TypeJSON)
+	}
+	if ou.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.GroupsTable,
+			Columns: []string{organization.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !ou.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.GroupsTable,
+			Columns: []string{organization.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.GroupsTable,
+			Columns: []string{organization.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.AdminsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   organization.AdminsTable,
+			Columns: organization.AdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedAdminsIDs(); len(nodes) > 0 && !ou.mutation.AdminsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   organization.AdminsTable,
+			Columns: organization.AdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.AdminsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   organization.AdminsTable,
+			Columns: organization.AdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.StoragePoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   organization.StoragePoliciesTable,
+			Columns: organization.StoragePoliciesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storagepolicy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedStoragePoliciesIDs(); len(nodes) > 0 && !ou.mutation.StoragePoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   organization.StoragePoliciesTable,
+			Columns: organization.StoragePoliciesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storagepolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.StoragePoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   organization.StoragePoliciesTable,
+			Columns: organization.StoragePoliciesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storagepolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organization.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ou.mutation.done = true
+	return n, nil
+}
+
+// OrganizationUpdateOne is the builder for updating a single Organization entity.
+type OrganizationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrganizationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ouo *OrganizationUpdateOne) SetUpdatedAt(t time.Time) *OrganizationUpdateOne {
+	ouo.mutation.SetUpdatedAt(t)
+	return ouo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ouo *OrganizationUpdateOne) SetDeletedAt(t time.Time) *OrganizationUpdateOne {
+	ouo.mutation.SetDeletedAt(t)
+	return ouo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ouo *OrganizationUpdateOne) SetNillableDeletedAt(t *time.Time) *OrganizationUpdateOne {
+	if t != nil {
+		ouo.SetDeletedAt(*t)
+	}
+	return ouo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ouo *OrganizationUpdateOne) ClearDeletedAt() *OrganizationUpdateOne {
+	ouo.mutation.ClearDeletedAt()
+	return ouo
+}
+
+// SetName sets the "name" field.
+func (ouo *OrganizationUpdateOne) SetName(s string) *OrganizationUpdateOne {
+	ouo.mutation.SetName(s)
+	return ouo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ouo *OrganizationUpdateOne) SetNillableName(s *string) *OrganizationUpdateOne {
+	if s != nil {
+		ouo.SetName(*s)
+	}
+	return ouo
+}
+
+// SetBranding sets the "branding" field.
+func (ouo *OrganizationUpdateOne) SetBranding(tb *types.OrganizationBranding) *OrganizationUpdateOne {
+	ouo.mutation.SetBranding(tb)
+	return ouo
+}
+
+// ClearBranding clears the value of the "branding" field.
+func (ouo *OrganizationUpdateOne) ClearBranding() *OrganizationUpdateOne {
+	ouo.mutation.ClearBranding()
+	return ouo
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (ouo *OrganizationUpdateOne) AddGroupIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.AddGroupIDs(ids...)
+	return ouo
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (ouo *OrganizationUpdateOne) AddGroups(g ...*Group) *OrganizationUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return ouo.AddGroupIDs(ids...)
+}
+
+// AddAdminIDs adds the "admins" edge to the User entity by IDs.
+func (ouo *OrganizationUpdateOne) AddAdminIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.AddAdminIDs(ids...)
+	return ouo
+}
+
+// AddAdmins adds the "admins" edges to the User entity.
+func (ouo *OrganizationUpdateOne) AddAdmins(u ...*User) *OrganizationUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ouo.AddAdminIDs(ids...)
+}
+
+// AddStoragePolicyIDs adds the "storage_policies" edge to the StoragePolicy entity by IDs.
+func (ouo *OrganizationUpdateOne) AddStoragePolicyIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.AddStoragePolicyIDs(ids...)
+	return ouo
+}
+
+// AddStoragePolicies adds the "storage_policies" edges to the StoragePolicy entity.
+func (ouo *OrganizationUpdateOne) AddStoragePolicies(s ...*StoragePolicy) *OrganizationUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ouo.AddStoragePolicyIDs(ids...)
+}
+
+// Mutation returns the OrganizationMutation object of the builder.
+func (ouo *OrganizationUpdateOne) Mutation() *OrganizationMutation {
+	return ouo.mutation
+}
+
+// ClearGroups clears all "groups" edges to the Group entity.
+func (ouo *OrganizationUpdateOne) ClearGroups() *OrganizationUpdateOne {
+	ouo.mutation.ClearGroups()
+	return ouo
+}
+
+// RemoveGroupIDs removes the "groups" edge to Group entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveGroupIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.RemoveGroupIDs(ids...)
+	return ouo
+}
+
+// RemoveGroups removes "groups" edges to Group entities.
+func (ouo *OrganizationUpdateOne) RemoveGroups(g ...*Group) *OrganizationUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return ouo.RemoveGroupIDs(ids...)
+}
+
+// ClearAdmins clears all "admins" edges to the User entity.
+func (ouo *OrganizationUpdateOne) ClearAdmins() *OrganizationUpdateOne {
+	ouo.mutation.ClearAdmins()
+	return ouo
+}
+
+// RemoveAdminIDs removes the "admins" edge to User entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveAdminIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.RemoveAdminIDs(ids...)
+	return ouo
+}
+
+// RemoveAdmins removes "admins" edges to User entities.
+func (ouo *OrganizationUpdateOne) RemoveAdmins(u ...*User) *OrganizationUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return ouo.RemoveAdminIDs(ids...)
+}
+
+// ClearStoragePolicies clears all "storage_policies" edges to the StoragePolicy entity.
+func (ouo *OrganizationUpdateOne) ClearStoragePolicies() *OrganizationUpdateOne {
+	ouo.mutation.ClearStoragePolicies()
+	return ouo
+}
+
+// RemoveStoragePolicyIDs removes the "storage_policies" edge to StoragePolicy entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveStoragePolicyIDs(ids ...int) *OrganizationUpdateOne {
+	ouo.mutation.RemoveStoragePolicyIDs(ids...)
+	return ouo
+}
+
+// RemoveStoragePolicies removes "storage_policies" edges to StoragePolicy entities.
+func (ouo *OrganizationUpdateOne) RemoveStoragePolicies(s ...*StoragePolicy) *OrganizationUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ouo.RemoveStoragePolicyIDs(ids...)
+}
+
+// Where appends a list predicates to the OrganizationUpdate builder.
+func (ouo *OrganizationUpdateOne) Where(ps ...predicate.Organization) *OrganizationUpdateOne {
+	ouo.mutation.Where(ps...)
+	return ouo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ouo *OrganizationUpdateOne) Select(field string, fields ...string) *OrganizationUpdateOne {
+	ouo.fields = append([]string{field}, fields...)
+	return ouo
+}
+
+// Save executes the query and returns the updated Organization entity.
+func (ouo *OrganizationUpdateOne) Save(ctx context.Context) (*Organization, error) {
+	if err := ouo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ouo.sqlSave, ouo.mutation, ouo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ouo *OrganizationUpdateOne) SaveX(ctx context.Context) *Organization {
+	node, err := ouo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ouo *OrganizationUpdateOne) Exec(ctx context.Context) error {
+	_, err := ouo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ouo *OrganizationUpdateOne) ExecX(ctx context.Context) {
+	if err := ouo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ouo *OrganizationUpdateOne) defaults() error {
+	if _, ok := ouo.mutation.UpdatedAt(); !ok {
+		if organization.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized organization.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := organization.UpdateDefaultUpdatedAt()
+		ouo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organization, err error) {
+	_spec := sqlgraph.NewUpdateSpec(organization.Table, organization.Columns, sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt))
+	id, ok := ouo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Organization.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ouo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, organization.FieldID)
+		for _, f := range fields {
+			if !organization.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != organization.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ouo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ouo.mutation.UpdatedAt(); ok {
+		_spec.SetField(organization.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ouo.mutation.DeletedAt(); ok {
+		_spec.SetField(organization.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ouo.mutation.DeletedAtCleared() {
+		_spec.ClearField(organization.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ouo.mutation.Name(); ok {
+		_spec.SetField(organization.FieldName, field.TypeString, value)
+	}
+	if value, ok := ouo.mutation.Branding(); ok {
+		_spec.SetField(organization.FieldBranding, field.TypeJSON, value)
+	}
+	if ouo.mutation.BrandingCleared() {
+		_spec.ClearField(organization.FieldBranding, field.TypeJSON)
+	}
+	if ouo.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.GroupsTable,
+			Columns: []string{organization.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !ouo.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.GroupsTable,
+			Columns: []string{organization.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.GroupsTable,
+			Columns: []string{organization.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.AdminsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   organization.AdminsTable,
+			Columns: organization.AdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedAdminsIDs(); len(nodes) > 0 && !ouo.mutation.AdminsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   organization.AdminsTable,
+			Columns: organization.AdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.AdminsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   organization.AdminsTable,
+			Columns: organization.AdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.StoragePoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   organization.StoragePoliciesTable,
+			Columns: organization.StoragePoliciesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storagepolicy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedStoragePoliciesIDs(); len(nodes) > 0 && !ouo.mutation.StoragePoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   organization.StoragePoliciesTable,
+			Columns: organization.StoragePoliciesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storagepolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.StoragePoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   organization.StoragePoliciesTable,
+			Columns: organization.StoragePoliciesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(storagepolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Organization{config: ouo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ouo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organization.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ouo.mutation.done = true
+	return _node, nil
+}
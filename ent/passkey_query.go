@@ -23,6 +23,8 @@ type PasskeyQuery struct {
 	inters     []Interceptor
 	predicates []predicate.Passkey
 	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*Passkey) error
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -382,6 +384,9 @@ func (pq *PasskeyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pass
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -397,6 +402,11 @@ func (pq *PasskeyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pass
 			return nil, err
 		}
 	}
+	for i := range pq.loadTotal {
+		if err := pq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -432,6 +442,9 @@ func (pq *PasskeyQuery) loadUser(ctx context.Context, query *UserQuery, nodes []
 
 func (pq *PasskeyQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
 	_spec.Node.Columns = pq.ctx.Fields
 	if len(pq.ctx.Fields) > 0 {
 		_spec.Unique = pq.ctx.Unique != nil && *pq.ctx.Unique
@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/retentionrule"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// RetentionRule is the model entity for the RetentionRule schema.
+type RetentionRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Folder holds the value of the "folder" field.
+	Folder string `json:"folder,omitempty"`
+	// Recursive holds the value of the "recursive" field.
+	Recursive bool `json:"recursive,omitempty"`
+	// Action holds the value of the "action" field.
+	Action retentionrule.Action `json:"action,omitempty"`
+	// MaxAgeDays holds the value of the "max_age_days" field.
+	MaxAgeDays int `json:"max_age_days,omitempty"`
+	// KeepVersions holds the value of the "keep_versions" field.
+	KeepVersions int `json:"keep_versions,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// LastRunAt holds the value of the "last_run_at" field.
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	// LastResult holds the value of the "last_result" field.
+	LastResult *types.RetentionResult `json:"last_result,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RetentionRuleQuery when eager-loading is set.
+	Edges        RetentionRuleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RetentionRuleEdges holds the relations/edges for other nodes in the graph.
+type RetentionRuleEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RetentionRuleEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RetentionRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case retentionrule.FieldLastResult:
+			values[i] = new([]byte)
+		case retentionrule.FieldRecursive, retentionrule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case retentionrule.FieldID, retentionrule.FieldUserID, retentionrule.FieldMaxAgeDays, retentionrule.FieldKeepVersions:
+			values[i] = new(sql.NullInt64)
+		case retentionrule.FieldFolder, retentionrule.FieldAction:
+			values[i] = new(sql.NullString)
+		case retentionrule.FieldCreatedAt, retentionrule.FieldUpdatedAt, retentionrule.FieldDeletedAt, retentionrule.FieldLastRunAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RetentionRule fields.
+func (rr *RetentionRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case retentionrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rr.ID = int(value.Int64)
+		case retentionrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rr.CreatedAt = value.Time
+			}
+		case retentionrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rr.UpdatedAt = value.Time
+			}
+		case retentionrule.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				rr.DeletedAt = new(time.Time)
+				*rr.DeletedAt = value.Time
+			}
+		case retentionrule.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				rr.UserID = int(value.Int64)
+			}
+		case retentionrule.FieldFolder:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field folder", values[i])
+			} else if value.Valid {
+				rr.Folder = value.String
+			}
+		case retentionrule.FieldRecursive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field recursive", values[i])
+			} else if value.Valid {
+				rr.Recursive = value.Bool
+			}
+		case retentionrule.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				rr.Action = retentionrule.Action(value.String)
+			}
+		case retentionrule.FieldMaxAgeDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_age_days", values[i])
+			} else if value.Valid {
+				rr.MaxAgeDays = int(value.Int64)
+			}
+		case retentionrule.FieldKeepVersions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field keep_versions", values[i])
+			} else if value.Valid {
+				rr.KeepVersions = int(value.Int64)
+			}
+		case retentionrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				rr.Enabled = value.Bool
+			}
+		case retentionrule.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				rr.LastRunAt = new(time.Time)
+				*rr.LastRunAt = value.Time
+			}
+		case retentionrule.FieldLastResult:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field last_result", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rr.LastResult); err != nil {
+					return fmt.Errorf("unmarshal field last_result: %w", err)
+				}
+			}
+		default:
+			rr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RetentionRule.
+// This includes values selected through modifiers, order, etc.
+func (rr *RetentionRule) Value(name string) (ent.Value, error) {
+	return rr.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the RetentionRule entity.
+func (rr *RetentionRule) QueryUser() *UserQuery {
+	return NewRetentionRuleClient(rr.config).QueryUser(rr)
+}
+
+// Update returns a builder for updating this RetentionRule.
+// Note that you need to call RetentionRule.Unwrap() before calling this method if this RetentionRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rr *RetentionRule) Update() *RetentionRuleUpdateOne {
+	return NewRetentionRuleClient(rr.config).UpdateOne(rr)
+}
+
+// Unwrap unwraps the RetentionRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rr *RetentionRule) Unwrap() *RetentionRule {
+	_tx, ok := rr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RetentionRule is not a transactional entity")
+	}
+	rr.config.driver = _tx.drv
+	return rr
+}
+
+// String implements the fmt.Stringer.
+func (rr *RetentionRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("RetentionRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(rr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := rr.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", rr.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("folder=")
+	builder.WriteString(rr.Folder)
+	builder.WriteString(", ")
+	builder.WriteString("recursive=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Recursive))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Action))
+	builder.WriteString(", ")
+	builder.WriteString("max_age_days=")
+	builder.WriteString(fmt.Sprintf("%v", rr.MaxAgeDays))
+	builder.WriteString(", ")
+	builder.WriteString("keep_versions=")
+	builder.WriteString(fmt.Sprintf("%v", rr.KeepVersions))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Enabled))
+	builder.WriteString(", ")
+	if v := rr.LastRunAt; v != nil {
+		builder.WriteString("last_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_result=")
+	builder.WriteString(fmt.Sprintf("%v", rr.LastResult))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetUser manually set the edge as loaded state.
+func (e *RetentionRule) SetUser(v *User) {
+	e.Edges.User = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// RetentionRules is a parsable slice of RetentionRule.
+type RetentionRules []*RetentionRule
@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package retentionrule
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the retentionrule type in the database.
+	Label = "retention_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldFolder holds the string denoting the folder field in the database.
+	FieldFolder = "folder"
+	// FieldRecursive holds the string denoting the recursive field in the database.
+	FieldRecursive = "recursive"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldMaxAgeDays holds the string denoting the max_age_days field in the database.
+	FieldMaxAgeDays = "max_age_days"
+	// FieldKeepVersions holds the string denoting the keep_versions field in the database.
+	FieldKeepVersions = "keep_versions"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldLastRunAt holds the string denoting the last_run_at field in the database.
+	FieldLastRunAt = "last_run_at"
+	// FieldLastResult holds the string denoting the last_result field in the database.
+	FieldLastResult = "last_result"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the retentionrule in the database.
+	Table = "retention_rules"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "retention_rules"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+)
+
+// Columns holds all SQL columns for retentionrule fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldUserID,
+	FieldFolder,
+	FieldRecursive,
+	FieldAction,
+	FieldMaxAgeDays,
+	FieldKeepVersions,
+	FieldEnabled,
+	FieldLastRunAt,
+	FieldLastResult,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultRecursive holds the default value on creation for the "recursive" field.
+	DefaultRecursive bool
+	// DefaultMaxAgeDays holds the default value on creation for the "max_age_days" field.
+	DefaultMaxAgeDays int
+	// DefaultKeepVersions holds the default value on creation for the "keep_versions" field.
+	DefaultKeepVersions int
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// ActionTrash is the default value of the Action enum.
+const DefaultAction = ActionTrash
+
+// Action values.
+const (
+	ActionTrash  Action = "trash"
+	ActionDelete Action = "delete"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionTrash, ActionDelete:
+		return nil
+	default:
+		return fmt.Errorf("retentionrule: invalid enum value for action field: %q", a)
+	}
+}
+
+// OrderOption defines the ordering options for the RetentionRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByFolder orders the results by the folder field.
+func ByFolder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFolder, opts...).ToFunc()
+}
+
+// ByRecursive orders the results by the recursive field.
+func ByRecursive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecursive, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByMaxAgeDays orders the results by the max_age_days field.
+func ByMaxAgeDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAgeDays, opts...).ToFunc()
+}
+
+// ByKeepVersions orders the results by the keep_versions field.
+func ByKeepVersions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeepVersions, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByLastRunAt orders the results by the last_run_at field.
+func ByLastRunAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastRunAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Action) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Action) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Action(str)
+	if err := ActionValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Action", str)
+	}
+	return nil
+}
@@ -0,0 +1,539 @@
+// Code generated by ent, DO NOT EDIT.
+
+package retentionrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldUserID, v))
+}
+
+// Folder applies equality check predicate on the "folder" field. It's identical to FolderEQ.
+func Folder(v string) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldFolder, v))
+}
+
+// Recursive applies equality check predicate on the "recursive" field. It's identical to RecursiveEQ.
+func Recursive(v bool) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldRecursive, v))
+}
+
+// MaxAgeDays applies equality check predicate on the "max_age_days" field. It's identical to MaxAgeDaysEQ.
+func MaxAgeDays(v int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldMaxAgeDays, v))
+}
+
+// KeepVersions applies equality check predicate on the "keep_versions" field. It's identical to KeepVersionsEQ.
+func KeepVersions(v int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldKeepVersions, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// LastRunAt applies equality check predicate on the "last_run_at" field. It's identical to LastRunAtEQ.
+func LastRunAt(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// FolderEQ applies the EQ predicate on the "folder" field.
+func FolderEQ(v string) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldFolder, v))
+}
+
+// FolderNEQ applies the NEQ predicate on the "folder" field.
+func FolderNEQ(v string) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNEQ(FieldFolder, v))
+}
+
+// FolderIn applies the In predicate on the "folder" field.
+func FolderIn(vs ...string) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldIn(FieldFolder, vs...))
+}
+
+// FolderNotIn applies the NotIn predicate on the "folder" field.
+func FolderNotIn(vs ...string) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNotIn(FieldFolder, vs...))
+}
+
+// FolderGT applies the GT predicate on the "folder" field.
+func FolderGT(v string) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldGT(FieldFolder, v))
+}
+
+// FolderGTE applies the GTE predicate on the "folder" field.
+func FolderGTE(v string) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldGTE(FieldFolder, v))
+}
+
+// FolderLT applies the LT predicate on the "folder" field.
+func FolderLT(v string) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldLT(FieldFolder, v))
+}
+
+// FolderLTE applies the LTE predicate on the "folder" field.
+func FolderLTE(v string) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldLTE(FieldFolder, v))
+}
+
+// FolderContains applies the Contains predicate on the "folder" field.
+func FolderContains(v string) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldContains(FieldFolder, v))
+}
+
+// FolderHasPrefix applies the HasPrefix predicate on the "folder" field.
+func FolderHasPrefix(v string) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldHasPrefix(FieldFolder, v))
+}
+
+// FolderHasSuffix applies the HasSuffix predicate on the "folder" field.
+func FolderHasSuffix(v string) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldHasSuffix(FieldFolder, v))
+}
+
+// FolderEqualFold applies the EqualFold predicate on the "folder" field.
+func FolderEqualFold(v string) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEqualFold(FieldFolder, v))
+}
+
+// FolderContainsFold applies the ContainsFold predicate on the "folder" field.
+func FolderContainsFold(v string) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldContainsFold(FieldFolder, v))
+}
+
+// RecursiveEQ applies the EQ predicate on the "recursive" field.
+func RecursiveEQ(v bool) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldRecursive, v))
+}
+
+// RecursiveNEQ applies the NEQ predicate on the "recursive" field.
+func RecursiveNEQ(v bool) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNEQ(FieldRecursive, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// MaxAgeDaysEQ applies the EQ predicate on the "max_age_days" field.
+func MaxAgeDaysEQ(v int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldMaxAgeDays, v))
+}
+
+// MaxAgeDaysNEQ applies the NEQ predicate on the "max_age_days" field.
+func MaxAgeDaysNEQ(v int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNEQ(FieldMaxAgeDays, v))
+}
+
+// MaxAgeDaysIn applies the In predicate on the "max_age_days" field.
+func MaxAgeDaysIn(vs ...int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldIn(FieldMaxAgeDays, vs...))
+}
+
+// MaxAgeDaysNotIn applies the NotIn predicate on the "max_age_days" field.
+func MaxAgeDaysNotIn(vs ...int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNotIn(FieldMaxAgeDays, vs...))
+}
+
+// MaxAgeDaysGT applies the GT predicate on the "max_age_days" field.
+func MaxAgeDaysGT(v int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldGT(FieldMaxAgeDays, v))
+}
+
+// MaxAgeDaysGTE applies the GTE predicate on the "max_age_days" field.
+func MaxAgeDaysGTE(v int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldGTE(FieldMaxAgeDays, v))
+}
+
+// MaxAgeDaysLT applies the LT predicate on the "max_age_days" field.
+func MaxAgeDaysLT(v int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldLT(FieldMaxAgeDays, v))
+}
+
+// MaxAgeDaysLTE applies the LTE predicate on the "max_age_days" field.
+func MaxAgeDaysLTE(v int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldLTE(FieldMaxAgeDays, v))
+}
+
+// KeepVersionsEQ applies the EQ predicate on the "keep_versions" field.
+func KeepVersionsEQ(v int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldKeepVersions, v))
+}
+
+// KeepVersionsNEQ applies the NEQ predicate on the "keep_versions" field.
+func KeepVersionsNEQ(v int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNEQ(FieldKeepVersions, v))
+}
+
+// KeepVersionsIn applies the In predicate on the "keep_versions" field.
+func KeepVersionsIn(vs ...int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldIn(FieldKeepVersions, vs...))
+}
+
+// KeepVersionsNotIn applies the NotIn predicate on the "keep_versions" field.
+func KeepVersionsNotIn(vs ...int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNotIn(FieldKeepVersions, vs...))
+}
+
+// KeepVersionsGT applies the GT predicate on the "keep_versions" field.
+func KeepVersionsGT(v int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldGT(FieldKeepVersions, v))
+}
+
+// KeepVersionsGTE applies the GTE predicate on the "keep_versions" field.
+func KeepVersionsGTE(v int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldGTE(FieldKeepVersions, v))
+}
+
+// KeepVersionsLT applies the LT predicate on the "keep_versions" field.
+func KeepVersionsLT(v int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldLT(FieldKeepVersions, v))
+}
+
+// KeepVersionsLTE applies the LTE predicate on the "keep_versions" field.
+func KeepVersionsLTE(v int) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldLTE(FieldKeepVersions, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// LastRunAtEQ applies the EQ predicate on the "last_run_at" field.
+func LastRunAtEQ(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtNEQ applies the NEQ predicate on the "last_run_at" field.
+func LastRunAtNEQ(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtIn applies the In predicate on the "last_run_at" field.
+func LastRunAtIn(vs ...time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtNotIn applies the NotIn predicate on the "last_run_at" field.
+func LastRunAtNotIn(vs ...time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNotIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtGT applies the GT predicate on the "last_run_at" field.
+func LastRunAtGT(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldGT(FieldLastRunAt, v))
+}
+
+// LastRunAtGTE applies the GTE predicate on the "last_run_at" field.
+func LastRunAtGTE(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldGTE(FieldLastRunAt, v))
+}
+
+// LastRunAtLT applies the LT predicate on the "last_run_at" field.
+func LastRunAtLT(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldLT(FieldLastRunAt, v))
+}
+
+// LastRunAtLTE applies the LTE predicate on the "last_run_at" field.
+func LastRunAtLTE(v time.Time) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldLTE(FieldLastRunAt, v))
+}
+
+// LastRunAtIsNil applies the IsNil predicate on the "last_run_at" field.
+func LastRunAtIsNil() predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldIsNull(FieldLastRunAt))
+}
+
+// LastRunAtNotNil applies the NotNil predicate on the "last_run_at" field.
+func LastRunAtNotNil() predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNotNull(FieldLastRunAt))
+}
+
+// LastResultIsNil applies the IsNil predicate on the "last_result" field.
+func LastResultIsNil() predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldIsNull(FieldLastResult))
+}
+
+// LastResultNotNil applies the NotNil predicate on the "last_result" field.
+func LastResultNotNil() predicate.RetentionRule {
+	return predicate.RetentionRule(sql.FieldNotNull(FieldLastResult))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.RetentionRule {
+	return predicate.RetentionRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.RetentionRule {
+	return predicate.RetentionRule(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RetentionRule) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RetentionRule) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RetentionRule) predicate.RetentionRule {
+	return predicate.RetentionRule(sql.NotPredicates(p))
+}
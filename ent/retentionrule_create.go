@@ -0,0 +1,1256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/retentionrule"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// RetentionRuleCreate is the builder for creating a RetentionRule entity.
+type RetentionRuleCreate struct {
+	config
+	mutation *RetentionRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rrc *RetentionRuleCreate) SetCreatedAt(t time.Time) *RetentionRuleCreate {
+	rrc.mutation.SetCreatedAt(t)
+	return rrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rrc *RetentionRuleCreate) SetNillableCreatedAt(t *time.Time) *RetentionRuleCreate {
+	if t != nil {
+		rrc.SetCreatedAt(*t)
+	}
+	return rrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rrc *RetentionRuleCreate) SetUpdatedAt(t time.Time) *RetentionRuleCreate {
+	rrc.mutation.SetUpdatedAt(t)
+	return rrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rrc *RetentionRuleCreate) SetNillableUpdatedAt(t *time.Time) *RetentionRuleCreate {
+	if t != nil {
+		rrc.SetUpdatedAt(*t)
+	}
+	return rrc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rrc *RetentionRuleCreate) SetDeletedAt(t time.Time) *RetentionRuleCreate {
+	rrc.mutation.SetDeletedAt(t)
+	return rrc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rrc *RetentionRuleCreate) SetNillableDeletedAt(t *time.Time) *RetentionRuleCreate {
+	if t != nil {
+		rrc.SetDeletedAt(*t)
+	}
+	return rrc
+}
+
+// SetUserID sets the "user_id" field.
+func (rrc *RetentionRuleCreate) SetUserID(i int) *RetentionRuleCreate {
+	rrc.mutation.SetUserID(i)
+	return rrc
+}
+
+// SetFolder sets the "folder" field.
+func (rrc *RetentionRuleCreate) SetFolder(s string) *RetentionRuleCreate {
+	rrc.mutation.SetFolder(s)
+	return rrc
+}
+
+// SetRecursive sets the "recursive" field.
+func (rrc *RetentionRuleCreate) SetRecursive(b bool) *RetentionRuleCreate {
+	rrc.mutation.SetRecursive(b)
+	return rrc
+}
+
+// SetNillableRecursive sets the "recursive" field if the given value is not nil.
+func (rrc *RetentionRuleCreate) SetNillableRecursive(b *bool) *RetentionRuleCreate {
+	if b != nil {
+		rrc.SetRecursive(*b)
+	}
+	return rrc
+}
+
+// SetAction sets the "action" field.
+func (rrc *RetentionRuleCreate) SetAction(r retentionrule.Action) *RetentionRuleCreate {
+	rrc.mutation.SetAction(r)
+	return rrc
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (rrc *RetentionRuleCreate) SetNillableAction(r *retentionrule.Action) *RetentionRuleCreate {
+	if r != nil {
+		rrc.SetAction(*r)
+	}
+	return rrc
+}
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (rrc *RetentionRuleCreate) SetMaxAgeDays(i int) *RetentionRuleCreate {
+	rrc.mutation.SetMaxAgeDays(i)
+	return rrc
+}
+
+// SetNillableMaxAgeDays sets the "max_age_days" field if the given value is not nil.
+func (rrc *RetentionRuleCreate) SetNillableMaxAgeDays(i *int) *RetentionRuleCreate {
+	if i != nil {
+		rrc.SetMaxAgeDays(*i)
+	}
+	return rrc
+}
+
+// SetKeepVersions sets the "keep_versions" field.
+func (rrc *RetentionRuleCreate) SetKeepVersions(i int) *RetentionRuleCreate {
+	rrc.mutation.SetKeepVersions(i)
+	return rrc
+}
+
+// SetNillableKeepVersions sets the "keep_versions" field if the given value is not nil.
+func (rrc *RetentionRuleCreate) SetNillableKeepVersions(i *int) *RetentionRuleCreate {
+	if i != nil {
+		rrc.SetKeepVersions(*i)
+	}
+	return rrc
+}
+
+// SetEnabled sets the "enabled" field.
+func (rrc *RetentionRuleCreate) SetEnabled(b bool) *RetentionRuleCreate {
+	rrc.mutation.SetEnabled(b)
+	return rrc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (rrc *RetentionRuleCreate) SetNillableEnabled(b *bool) *RetentionRuleCreate {
+	if b != nil {
+		rrc.SetEnabled(*b)
+	}
+	return rrc
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (rrc *RetentionRuleCreate) SetLastRunAt(t time.Time) *RetentionRuleCreate {
+	rrc.mutation.SetLastRunAt(t)
+	return rrc
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (rrc *RetentionRuleCreate) SetNillableLastRunAt(t *time.Time) *RetentionRuleCreate {
+	if t != nil {
+		rrc.SetLastRunAt(*t)
+	}
+	return rrc
+}
+
+// SetLastResult sets the "last_result" field.
+func (rrc *RetentionRuleCreate) SetLastResult(tr *types.RetentionResult) *RetentionRuleCreate {
+	rrc.mutation.SetLastResult(tr)
+	return rrc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rrc *RetentionRuleCreate) SetUser(u *User) *RetentionRuleCreate {
+	return rrc.SetUserID(u.ID)
+}
+
+// Mutation returns the RetentionRuleMutation object of the builder.
+func (rrc *RetentionRuleCreate) Mutation() *RetentionRuleMutation {
+	return rrc.mutation
+}
+
+// Save creates the RetentionRule in the database.
+func (rrc *RetentionRuleCreate) Save(ctx context.Context) (*RetentionRule, error) {
+	if err := rrc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rrc.sqlSave, rrc.mutation, rrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rrc *RetentionRuleCreate) SaveX(ctx context.Context) *RetentionRule {
+	v, err := rrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrc *RetentionRuleCreate) Exec(ctx context.Context) error {
+	_, err := rrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrc *RetentionRuleCreate) ExecX(ctx context.Context) {
+	if err := rrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrc *RetentionRuleCreate) defaults() error {
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		if retentionrule.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized retentionrule.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := retentionrule.DefaultCreatedAt()
+		rrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		if retentionrule.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized retentionrule.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := retentionrule.DefaultUpdatedAt()
+		rrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rrc.mutation.Recursive(); !ok {
+		v := retentionrule.DefaultRecursive
+		rrc.mutation.SetRecursive(v)
+	}
+	if _, ok := rrc.mutation.Action(); !ok {
+		v := retentionrule.DefaultAction
+		rrc.mutation.SetAction(v)
+	}
+	if _, ok := rrc.mutation.MaxAgeDays(); !ok {
+		v := retentionrule.DefaultMaxAgeDays
+		rrc.mutation.SetMaxAgeDays(v)
+	}
+	if _, ok := rrc.mutation.KeepVersions(); !ok {
+		v := retentionrule.DefaultKeepVersions
+		rrc.mutation.SetKeepVersions(v)
+	}
+	if _, ok := rrc.mutation.Enabled(); !ok {
+		v := retentionrule.DefaultEnabled
+		rrc.mutation.SetEnabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrc *RetentionRuleCreate) check() error {
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RetentionRule.created_at"`)}
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RetentionRule.updated_at"`)}
+	}
+	if _, ok := rrc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RetentionRule.user_id"`)}
+	}
+	if _, ok := rrc.mutation.Folder(); !ok {
+		return &ValidationError{Name: "folder", err: errors.New(`ent: missing required field "RetentionRule.folder"`)}
+	}
+	if _, ok := rrc.mutation.Recursive(); !ok {
+		return &ValidationError{Name: "recursive", err: errors.New(`ent: missing required field "RetentionRule.recursive"`)}
+	}
+	if _, ok := rrc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "RetentionRule.action"`)}
+	}
+	if v, ok := rrc.mutation.Action(); ok {
+		if err := retentionrule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "RetentionRule.action": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.MaxAgeDays(); !ok {
+		return &ValidationError{Name: "max_age_days", err: errors.New(`ent: missing required field "RetentionRule.max_age_days"`)}
+	}
+	if _, ok := rrc.mutation.KeepVersions(); !ok {
+		return &ValidationError{Name: "keep_versions", err: errors.New(`ent: missing required field "RetentionRule.keep_versions"`)}
+	}
+	if _, ok := rrc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "RetentionRule.enabled"`)}
+	}
+	if _, ok := rrc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "RetentionRule.user"`)}
+	}
+	return nil
+}
+
+func (rrc *RetentionRuleCreate) sqlSave(ctx context.Context) (*RetentionRule, error) {
+	if err := rrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rrc.mutation.id = &_node.ID
+	rrc.mutation.done = true
+	return _node, nil
+}
+
+func (rrc *RetentionRuleCreate) createSpec() (*RetentionRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RetentionRule{config: rrc.config}
+		_spec = sqlgraph.NewCreateSpec(retentionrule.Table, sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeInt))
+	)
+
+	if id, ok := rrc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = rrc.conflict
+	if value, ok := rrc.mutation.CreatedAt(); ok {
+		_spec.SetField(retentionrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(retentionrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rrc.mutation.DeletedAt(); ok {
+		_spec.SetField(retentionrule.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := rrc.mutation.Folder(); ok {
+		_spec.SetField(retentionrule.FieldFolder, field.TypeString, value)
+		_node.Folder = value
+	}
+	if value, ok := rrc.mutation.Recursive(); ok {
+		_spec.SetField(retentionrule.FieldRecursive, field.TypeBool, value)
+		_node.Recursive = value
+	}
+	if value, ok := rrc.mutation.Action(); ok {
+		_spec.SetField(retentionrule.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := rrc.mutation.MaxAgeDays(); ok {
+		_spec.SetField(retentionrule.FieldMaxAgeDays, field.TypeInt, value)
+		_node.MaxAgeDays = value
+	}
+	if value, ok := rrc.mutation.KeepVersions(); ok {
+		_spec.SetField(retentionrule.FieldKeepVersions, field.TypeInt, value)
+		_node.KeepVersions = value
+	}
+	if value, ok := rrc.mutation.Enabled(); ok {
+		_spec.SetField(retentionrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := rrc.mutation.LastRunAt(); ok {
+		_spec.SetField(retentionrule.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := rrc.mutation.LastResult(); ok {
+		_spec.SetField(retentionrule.FieldLastResult, field.TypeJSON, value)
+		_node.LastResult = value
+	}
+	if nodes := rrc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   retentionrule.UserTable,
+			Columns: []string{retentionrule.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RetentionRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RetentionRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rrc *RetentionRuleCreate) OnConflict(opts ...sql.ConflictOption) *RetentionRuleUpsertOne {
+	rrc.conflict = opts
+	return &RetentionRuleUpsertOne{
+		create: rrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RetentionRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rrc *RetentionRuleCreate) OnConflictColumns(columns ...string) *RetentionRuleUpsertOne {
+	rrc.conflict = append(rrc.conflict, sql.ConflictColumns(columns...))
+	return &RetentionRuleUpsertOne{
+		create: rrc,
+	}
+}
+
+type (
+	// RetentionRuleUpsertOne is the builder for "upsert"-ing
+	//  one RetentionRule node.
+	RetentionRuleUpsertOne struct {
+		create *RetentionRuleCreate
+	}
+
+	// RetentionRuleUpsert is the "OnConflict" setter.
+	RetentionRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RetentionRuleUpsert) SetUpdatedAt(v time.Time) *RetentionRuleUpsert {
+	u.Set(retentionrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RetentionRuleUpsert) UpdateUpdatedAt() *RetentionRuleUpsert {
+	u.SetExcluded(retentionrule.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *RetentionRuleUpsert) SetDeletedAt(v time.Time) *RetentionRuleUpsert {
+	u.Set(retentionrule.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *RetentionRuleUpsert) UpdateDeletedAt() *RetentionRuleUpsert {
+	u.SetExcluded(retentionrule.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *RetentionRuleUpsert) ClearDeletedAt() *RetentionRuleUpsert {
+	u.SetNull(retentionrule.FieldDeletedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *RetentionRuleUpsert) SetUserID(v int) *RetentionRuleUpsert {
+	u.Set(retentionrule.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *RetentionRuleUpsert) UpdateUserID() *RetentionRuleUpsert {
+	u.SetExcluded(retentionrule.FieldUserID)
+	return u
+}
+
+// SetFolder sets the "folder" field.
+func (u *RetentionRuleUpsert) SetFolder(v string) *RetentionRuleUpsert {
+	u.Set(retentionrule.FieldFolder, v)
+	return u
+}
+
+// UpdateFolder sets the "folder" field to the value that was provided on create.
+func (u *RetentionRuleUpsert) UpdateFolder() *RetentionRuleUpsert {
+	u.SetExcluded(retentionrule.FieldFolder)
+	return u
+}
+
+// SetRecursive sets the "recursive" field.
+func (u *RetentionRuleUpsert) SetRecursive(v bool) *RetentionRuleUpsert {
+	u.Set(retentionrule.FieldRecursive, v)
+	return u
+}
+
+// UpdateRecursive sets the "recursive" field to the value that was provided on create.
+func (u *RetentionRuleUpsert) UpdateRecursive() *RetentionRuleUpsert {
+	u.SetExcluded(retentionrule.FieldRecursive)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *RetentionRuleUpsert) SetAction(v retentionrule.Action) *RetentionRuleUpsert {
+	u.Set(retentionrule.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *RetentionRuleUpsert) UpdateAction() *RetentionRuleUpsert {
+	u.SetExcluded(retentionrule.FieldAction)
+	return u
+}
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (u *RetentionRuleUpsert) SetMaxAgeDays(v int) *RetentionRuleUpsert {
+	u.Set(retentionrule.FieldMaxAgeDays, v)
+	return u
+}
+
+// UpdateMaxAgeDays sets the "max_age_days" field to the value that was provided on create.
+func (u *RetentionRuleUpsert) UpdateMaxAgeDays() *RetentionRuleUpsert {
+	u.SetExcluded(retentionrule.FieldMaxAgeDays)
+	return u
+}
+
+// AddMaxAgeDays adds v to the "max_age_days" field.
+func (u *RetentionRuleUpsert) AddMaxAgeDays(v int) *RetentionRuleUpsert {
+	u.Add(retentionrule.FieldMaxAgeDays, v)
+	return u
+}
+
+// SetKeepVersions sets the "keep_versions" field.
+func (u *RetentionRuleUpsert) SetKeepVersions(v int) *RetentionRuleUpsert {
+	u.Set(retentionrule.FieldKeepVersions, v)
+	return u
+}
+
+// UpdateKeepVersions sets the "keep_versions" field to the value that was provided on create.
+func (u *RetentionRuleUpsert) UpdateKeepVersions() *RetentionRuleUpsert {
+	u.SetExcluded(retentionrule.FieldKeepVersions)
+	return u
+}
+
+// AddKeepVersions adds v to the "keep_versions" field.
+func (u *RetentionRuleUpsert) AddKeepVersions(v int) *RetentionRuleUpsert {
+	u.Add(retentionrule.FieldKeepVersions, v)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RetentionRuleUpsert) SetEnabled(v bool) *RetentionRuleUpsert {
+	u.Set(retentionrule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RetentionRuleUpsert) UpdateEnabled() *RetentionRuleUpsert {
+	u.SetExcluded(retentionrule.FieldEnabled)
+	return u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *RetentionRuleUpsert) SetLastRunAt(v time.Time) *RetentionRuleUpsert {
+	u.Set(retentionrule.FieldLastRunAt, v)
+	return u
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *RetentionRuleUpsert) UpdateLastRunAt() *RetentionRuleUpsert {
+	u.SetExcluded(retentionrule.FieldLastRunAt)
+	return u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *RetentionRuleUpsert) ClearLastRunAt() *RetentionRuleUpsert {
+	u.SetNull(retentionrule.FieldLastRunAt)
+	return u
+}
+
+// SetLastResult sets the "last_result" field.
+func (u *RetentionRuleUpsert) SetLastResult(v *types.RetentionResult) *RetentionRuleUpsert {
+	u.Set(retentionrule.FieldLastResult, v)
+	return u
+}
+
+// UpdateLastResult sets the "last_result" field to the value that was provided on create.
+func (u *RetentionRuleUpsert) UpdateLastResult() *RetentionRuleUpsert {
+	u.SetExcluded(retentionrule.FieldLastResult)
+	return u
+}
+
+// ClearLastResult clears the value of the "last_result" field.
+func (u *RetentionRuleUpsert) ClearLastResult() *RetentionRuleUpsert {
+	u.SetNull(retentionrule.FieldLastResult)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.RetentionRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RetentionRuleUpsertOne) UpdateNewValues() *RetentionRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(retentionrule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RetentionRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RetentionRuleUpsertOne) Ignore() *RetentionRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RetentionRuleUpsertOne) DoNothing() *RetentionRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RetentionRuleCreate.OnConflict
+// documentation for more info.
+func (u *RetentionRuleUpsertOne) Update(set func(*RetentionRuleUpsert)) *RetentionRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RetentionRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RetentionRuleUpsertOne) SetUpdatedAt(v time.Time) *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RetentionRuleUpsertOne) UpdateUpdatedAt() *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *RetentionRuleUpsertOne) SetDeletedAt(v time.Time) *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *RetentionRuleUpsertOne) UpdateDeletedAt() *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *RetentionRuleUpsertOne) ClearDeletedAt() *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *RetentionRuleUpsertOne) SetUserID(v int) *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *RetentionRuleUpsertOne) UpdateUserID() *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetFolder sets the "folder" field.
+func (u *RetentionRuleUpsertOne) SetFolder(v string) *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetFolder(v)
+	})
+}
+
+// UpdateFolder sets the "folder" field to the value that was provided on create.
+func (u *RetentionRuleUpsertOne) UpdateFolder() *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateFolder()
+	})
+}
+
+// SetRecursive sets the "recursive" field.
+func (u *RetentionRuleUpsertOne) SetRecursive(v bool) *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetRecursive(v)
+	})
+}
+
+// UpdateRecursive sets the "recursive" field to the value that was provided on create.
+func (u *RetentionRuleUpsertOne) UpdateRecursive() *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateRecursive()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *RetentionRuleUpsertOne) SetAction(v retentionrule.Action) *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *RetentionRuleUpsertOne) UpdateAction() *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (u *RetentionRuleUpsertOne) SetMaxAgeDays(v int) *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetMaxAgeDays(v)
+	})
+}
+
+// AddMaxAgeDays adds v to the "max_age_days" field.
+func (u *RetentionRuleUpsertOne) AddMaxAgeDays(v int) *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.AddMaxAgeDays(v)
+	})
+}
+
+// UpdateMaxAgeDays sets the "max_age_days" field to the value that was provided on create.
+func (u *RetentionRuleUpsertOne) UpdateMaxAgeDays() *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateMaxAgeDays()
+	})
+}
+
+// SetKeepVersions sets the "keep_versions" field.
+func (u *RetentionRuleUpsertOne) SetKeepVersions(v int) *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetKeepVersions(v)
+	})
+}
+
+// AddKeepVersions adds v to the "keep_versions" field.
+func (u *RetentionRuleUpsertOne) AddKeepVersions(v int) *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.AddKeepVersions(v)
+	})
+}
+
+// UpdateKeepVersions sets the "keep_versions" field to the value that was provided on create.
+func (u *RetentionRuleUpsertOne) UpdateKeepVersions() *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateKeepVersions()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RetentionRuleUpsertOne) SetEnabled(v bool) *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RetentionRuleUpsertOne) UpdateEnabled() *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *RetentionRuleUpsertOne) SetLastRunAt(v time.Time) *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *RetentionRuleUpsertOne) UpdateLastRunAt() *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *RetentionRuleUpsertOne) ClearLastRunAt() *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetLastResult sets the "last_result" field.
+func (u *RetentionRuleUpsertOne) SetLastResult(v *types.RetentionResult) *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetLastResult(v)
+	})
+}
+
+// UpdateLastResult sets the "last_result" field to the value that was provided on create.
+func (u *RetentionRuleUpsertOne) UpdateLastResult() *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateLastResult()
+	})
+}
+
+// ClearLastResult clears the value of the "last_result" field.
+func (u *RetentionRuleUpsertOne) ClearLastResult() *RetentionRuleUpsertOne {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.ClearLastResult()
+	})
+}
+
+// Exec executes the query.
+func (u *RetentionRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RetentionRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RetentionRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RetentionRuleUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RetentionRuleUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *RetentionRuleCreate) SetRawID(t int) *RetentionRuleCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// RetentionRuleCreateBulk is the builder for creating many RetentionRule entities in bulk.
+type RetentionRuleCreateBulk struct {
+	config
+	err      error
+	builders []*RetentionRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RetentionRule entities in the database.
+func (rrcb *RetentionRuleCreateBulk) Save(ctx context.Context) ([]*RetentionRule, error) {
+	if rrcb.err != nil {
+		return nil, rrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rrcb.builders))
+	nodes := make([]*RetentionRule, len(rrcb.builders))
+	mutators := make([]Mutator, len(rrcb.builders))
+	for i := range rrcb.builders {
+		func(i int, root context.Context) {
+			builder := rrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RetentionRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrcb *RetentionRuleCreateBulk) SaveX(ctx context.Context) []*RetentionRule {
+	v, err := rrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrcb *RetentionRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := rrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrcb *RetentionRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := rrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RetentionRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RetentionRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rrcb *RetentionRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *RetentionRuleUpsertBulk {
+	rrcb.conflict = opts
+	return &RetentionRuleUpsertBulk{
+		create: rrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RetentionRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rrcb *RetentionRuleCreateBulk) OnConflictColumns(columns ...string) *RetentionRuleUpsertBulk {
+	rrcb.conflict = append(rrcb.conflict, sql.ConflictColumns(columns...))
+	return &RetentionRuleUpsertBulk{
+		create: rrcb,
+	}
+}
+
+// RetentionRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of RetentionRule nodes.
+type RetentionRuleUpsertBulk struct {
+	create *RetentionRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RetentionRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RetentionRuleUpsertBulk) UpdateNewValues() *RetentionRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(retentionrule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RetentionRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RetentionRuleUpsertBulk) Ignore() *RetentionRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RetentionRuleUpsertBulk) DoNothing() *RetentionRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RetentionRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *RetentionRuleUpsertBulk) Update(set func(*RetentionRuleUpsert)) *RetentionRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RetentionRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RetentionRuleUpsertBulk) SetUpdatedAt(v time.Time) *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RetentionRuleUpsertBulk) UpdateUpdatedAt() *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *RetentionRuleUpsertBulk) SetDeletedAt(v time.Time) *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *RetentionRuleUpsertBulk) UpdateDeletedAt() *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *RetentionRuleUpsertBulk) ClearDeletedAt() *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *RetentionRuleUpsertBulk) SetUserID(v int) *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *RetentionRuleUpsertBulk) UpdateUserID() *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetFolder sets the "folder" field.
+func (u *RetentionRuleUpsertBulk) SetFolder(v string) *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetFolder(v)
+	})
+}
+
+// UpdateFolder sets the "folder" field to the value that was provided on create.
+func (u *RetentionRuleUpsertBulk) UpdateFolder() *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateFolder()
+	})
+}
+
+// SetRecursive sets the "recursive" field.
+func (u *RetentionRuleUpsertBulk) SetRecursive(v bool) *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetRecursive(v)
+	})
+}
+
+// UpdateRecursive sets the "recursive" field to the value that was provided on create.
+func (u *RetentionRuleUpsertBulk) UpdateRecursive() *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateRecursive()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *RetentionRuleUpsertBulk) SetAction(v retentionrule.Action) *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *RetentionRuleUpsertBulk) UpdateAction() *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (u *RetentionRuleUpsertBulk) SetMaxAgeDays(v int) *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetMaxAgeDays(v)
+	})
+}
+
+// AddMaxAgeDays adds v to the "max_age_days" field.
+func (u *RetentionRuleUpsertBulk) AddMaxAgeDays(v int) *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.AddMaxAgeDays(v)
+	})
+}
+
+// UpdateMaxAgeDays sets the "max_age_days" field to the value that was provided on create.
+func (u *RetentionRuleUpsertBulk) UpdateMaxAgeDays() *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateMaxAgeDays()
+	})
+}
+
+// SetKeepVersions sets the "keep_versions" field.
+func (u *RetentionRuleUpsertBulk) SetKeepVersions(v int) *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetKeepVersions(v)
+	})
+}
+
+// AddKeepVersions adds v to the "keep_versions" field.
+func (u *RetentionRuleUpsertBulk) AddKeepVersions(v int) *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.AddKeepVersions(v)
+	})
+}
+
+// UpdateKeepVersions sets the "keep_versions" field to the value that was provided on create.
+func (u *RetentionRuleUpsertBulk) UpdateKeepVersions() *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateKeepVersions()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RetentionRuleUpsertBulk) SetEnabled(v bool) *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RetentionRuleUpsertBulk) UpdateEnabled() *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *RetentionRuleUpsertBulk) SetLastRunAt(v time.Time) *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *RetentionRuleUpsertBulk) UpdateLastRunAt() *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *RetentionRuleUpsertBulk) ClearLastRunAt() *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetLastResult sets the "last_result" field.
+func (u *RetentionRuleUpsertBulk) SetLastResult(v *types.RetentionResult) *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.SetLastResult(v)
+	})
+}
+
+// UpdateLastResult sets the "last_result" field to the value that was provided on create.
+func (u *RetentionRuleUpsertBulk) UpdateLastResult() *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.UpdateLastResult()
+	})
+}
+
+// ClearLastResult clears the value of the "last_result" field.
+func (u *RetentionRuleUpsertBulk) ClearLastResult() *RetentionRuleUpsertBulk {
+	return u.Update(func(s *RetentionRuleUpsert) {
+		s.ClearLastResult()
+	})
+}
+
+// Exec executes the query.
+func (u *RetentionRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RetentionRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RetentionRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RetentionRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
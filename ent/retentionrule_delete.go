@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/retentionrule"
+)
+
+// RetentionRuleDelete is the builder for deleting a RetentionRule entity.
+type RetentionRuleDelete struct {
+	config
+	hooks    []Hook
+	mutation *RetentionRuleMutation
+}
+
+// Where appends a list predicates to the RetentionRuleDelete builder.
+func (rrd *RetentionRuleDelete) Where(ps ...predicate.RetentionRule) *RetentionRuleDelete {
+	rrd.mutation.Where(ps...)
+	return rrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rrd *RetentionRuleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rrd.sqlExec, rrd.mutation, rrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrd *RetentionRuleDelete) ExecX(ctx context.Context) int {
+	n, err := rrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rrd *RetentionRuleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(retentionrule.Table, sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeInt))
+	if ps := rrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rrd.mutation.done = true
+	return affected, err
+}
+
+// RetentionRuleDeleteOne is the builder for deleting a single RetentionRule entity.
+type RetentionRuleDeleteOne struct {
+	rrd *RetentionRuleDelete
+}
+
+// Where appends a list predicates to the RetentionRuleDelete builder.
+func (rrdo *RetentionRuleDeleteOne) Where(ps ...predicate.RetentionRule) *RetentionRuleDeleteOne {
+	rrdo.rrd.mutation.Where(ps...)
+	return rrdo
+}
+
+// Exec executes the deletion query.
+func (rrdo *RetentionRuleDeleteOne) Exec(ctx context.Context) error {
+	n, err := rrdo.rrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{retentionrule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrdo *RetentionRuleDeleteOne) ExecX(ctx context.Context) {
+	if err := rrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,618 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/retentionrule"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// RetentionRuleQuery is the builder for querying RetentionRule entities.
+type RetentionRuleQuery struct {
+	config
+	ctx        *QueryContext
+	order      []retentionrule.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RetentionRule
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*RetentionRule) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RetentionRuleQuery builder.
+func (rrq *RetentionRuleQuery) Where(ps ...predicate.RetentionRule) *RetentionRuleQuery {
+	rrq.predicates = append(rrq.predicates, ps...)
+	return rrq
+}
+
+// Limit the number of records to be returned by this query.
+func (rrq *RetentionRuleQuery) Limit(limit int) *RetentionRuleQuery {
+	rrq.ctx.Limit = &limit
+	return rrq
+}
+
+// Offset to start from.
+func (rrq *RetentionRuleQuery) Offset(offset int) *RetentionRuleQuery {
+	rrq.ctx.Offset = &offset
+	return rrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rrq *RetentionRuleQuery) Unique(unique bool) *RetentionRuleQuery {
+	rrq.ctx.Unique = &unique
+	return rrq
+}
+
+// Order specifies how the records should be ordered.
+func (rrq *RetentionRuleQuery) Order(o ...retentionrule.OrderOption) *RetentionRuleQuery {
+	rrq.order = append(rrq.order, o...)
+	return rrq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (rrq *RetentionRuleQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: rrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(retentionrule.Table, retentionrule.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, retentionrule.UserTable, retentionrule.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RetentionRule entity from the query.
+// Returns a *NotFoundError when no RetentionRule was found.
+func (rrq *RetentionRuleQuery) First(ctx context.Context) (*RetentionRule, error) {
+	nodes, err := rrq.Limit(1).All(setContextOp(ctx, rrq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{retentionrule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rrq *RetentionRuleQuery) FirstX(ctx context.Context) *RetentionRule {
+	node, err := rrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RetentionRule ID from the query.
+// Returns a *NotFoundError when no RetentionRule ID was found.
+func (rrq *RetentionRuleQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rrq.Limit(1).IDs(setContextOp(ctx, rrq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{retentionrule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rrq *RetentionRuleQuery) FirstIDX(ctx context.Context) int {
+	id, err := rrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RetentionRule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RetentionRule entity is found.
+// Returns a *NotFoundError when no RetentionRule entities are found.
+func (rrq *RetentionRuleQuery) Only(ctx context.Context) (*RetentionRule, error) {
+	nodes, err := rrq.Limit(2).All(setContextOp(ctx, rrq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{retentionrule.Label}
+	default:
+		return nil, &NotSingularError{retentionrule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rrq *RetentionRuleQuery) OnlyX(ctx context.Context) *RetentionRule {
+	node, err := rrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RetentionRule ID in the query.
+// Returns a *NotSingularError when more than one RetentionRule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rrq *RetentionRuleQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rrq.Limit(2).IDs(setContextOp(ctx, rrq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{retentionrule.Label}
+	default:
+		err = &NotSingularError{retentionrule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rrq *RetentionRuleQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RetentionRules.
+func (rrq *RetentionRuleQuery) All(ctx context.Context) ([]*RetentionRule, error) {
+	ctx = setContextOp(ctx, rrq.ctx, "All")
+	if err := rrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RetentionRule, *RetentionRuleQuery]()
+	return withInterceptors[[]*RetentionRule](ctx, rrq, qr, rrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rrq *RetentionRuleQuery) AllX(ctx context.Context) []*RetentionRule {
+	nodes, err := rrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RetentionRule IDs.
+func (rrq *RetentionRuleQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rrq.ctx.Unique == nil && rrq.path != nil {
+		rrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rrq.ctx, "IDs")
+	if err = rrq.Select(retentionrule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rrq *RetentionRuleQuery) IDsX(ctx context.Context) []int {
+	ids, err := rrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rrq *RetentionRuleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rrq.ctx, "Count")
+	if err := rrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rrq, querierCount[*RetentionRuleQuery](), rrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rrq *RetentionRuleQuery) CountX(ctx context.Context) int {
+	count, err := rrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rrq *RetentionRuleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rrq.ctx, "Exist")
+	switch _, err := rrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rrq *RetentionRuleQuery) ExistX(ctx context.Context) bool {
+	exist, err := rrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RetentionRuleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rrq *RetentionRuleQuery) Clone() *RetentionRuleQuery {
+	if rrq == nil {
+		return nil
+	}
+	return &RetentionRuleQuery{
+		config:     rrq.config,
+		ctx:        rrq.ctx.Clone(),
+		order:      append([]retentionrule.OrderOption{}, rrq.order...),
+		inters:     append([]Interceptor{}, rrq.inters...),
+		predicates: append([]predicate.RetentionRule{}, rrq.predicates...),
+		withUser:   rrq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  rrq.sql.Clone(),
+		path: rrq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (rrq *RetentionRuleQuery) WithUser(opts ...func(*UserQuery)) *RetentionRuleQuery {
+	query := (&UserClient{config: rrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rrq.withUser = query
+	return rrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RetentionRule.Query().
+//		GroupBy(retentionrule.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rrq *RetentionRuleQuery) GroupBy(field string, fields ...string) *RetentionRuleGroupBy {
+	rrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RetentionRuleGroupBy{build: rrq}
+	grbuild.flds = &rrq.ctx.Fields
+	grbuild.label = retentionrule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.RetentionRule.Query().
+//		Select(retentionrule.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (rrq *RetentionRuleQuery) Select(fields ...string) *RetentionRuleSelect {
+	rrq.ctx.Fields = append(rrq.ctx.Fields, fields...)
+	sbuild := &RetentionRuleSelect{RetentionRuleQuery: rrq}
+	sbuild.label = retentionrule.Label
+	sbuild.flds, sbuild.scan = &rrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RetentionRuleSelect configured with the given aggregations.
+func (rrq *RetentionRuleQuery) Aggregate(fns ...AggregateFunc) *RetentionRuleSelect {
+	return rrq.Select().Aggregate(fns...)
+}
+
+func (rrq *RetentionRuleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rrq.ctx.Fields {
+		if !retentionrule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rrq.path != nil {
+		prev, err := rrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rrq.sql = prev
+	}
+	return nil
+}
+
+func (rrq *RetentionRuleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RetentionRule, error) {
+	var (
+		nodes       = []*RetentionRule{}
+		_spec       = rrq.querySpec()
+		loadedTypes = [1]bool{
+			rrq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RetentionRule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RetentionRule{config: rrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(rrq.modifiers) > 0 {
+		_spec.Modifiers = rrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rrq.withUser; query != nil {
+		if err := rrq.loadUser(ctx, query, nodes, nil,
+			func(n *RetentionRule, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range rrq.loadTotal {
+		if err := rrq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rrq *RetentionRuleQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*RetentionRule, init func(*RetentionRule), assign func(*RetentionRule, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RetentionRule)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rrq *RetentionRuleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rrq.querySpec()
+	if len(rrq.modifiers) > 0 {
+		_spec.Modifiers = rrq.modifiers
+	}
+	_spec.Node.Columns = rrq.ctx.Fields
+	if len(rrq.ctx.Fields) > 0 {
+		_spec.Unique = rrq.ctx.Unique != nil && *rrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rrq.driver, _spec)
+}
+
+func (rrq *RetentionRuleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(retentionrule.Table, retentionrule.Columns, sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeInt))
+	_spec.From = rrq.sql
+	if unique := rrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, retentionrule.FieldID)
+		for i := range fields {
+			if fields[i] != retentionrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if rrq.withUser != nil {
+			_spec.Node.AddColumnOnce(retentionrule.FieldUserID)
+		}
+	}
+	if ps := rrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rrq *RetentionRuleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rrq.driver.Dialect())
+	t1 := builder.Table(retentionrule.Table)
+	columns := rrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = retentionrule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rrq.sql != nil {
+		selector = rrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rrq.ctx.Unique != nil && *rrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rrq.predicates {
+		p(selector)
+	}
+	for _, p := range rrq.order {
+		p(selector)
+	}
+	if offset := rrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RetentionRuleGroupBy is the group-by builder for RetentionRule entities.
+type RetentionRuleGroupBy struct {
+	selector
+	build *RetentionRuleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rrgb *RetentionRuleGroupBy) Aggregate(fns ...AggregateFunc) *RetentionRuleGroupBy {
+	rrgb.fns = append(rrgb.fns, fns...)
+	return rrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrgb *RetentionRuleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrgb.build.ctx, "GroupBy")
+	if err := rrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RetentionRuleQuery, *RetentionRuleGroupBy](ctx, rrgb.build, rrgb, rrgb.build.inters, v)
+}
+
+func (rrgb *RetentionRuleGroupBy) sqlScan(ctx context.Context, root *RetentionRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rrgb.fns))
+	for _, fn := range rrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rrgb.flds)+len(rrgb.fns))
+		for _, f := range *rrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RetentionRuleSelect is the builder for selecting fields of RetentionRule entities.
+type RetentionRuleSelect struct {
+	*RetentionRuleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rrs *RetentionRuleSelect) Aggregate(fns ...AggregateFunc) *RetentionRuleSelect {
+	rrs.fns = append(rrs.fns, fns...)
+	return rrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrs *RetentionRuleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrs.ctx, "Select")
+	if err := rrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RetentionRuleQuery, *RetentionRuleSelect](ctx, rrs.RetentionRuleQuery, rrs, rrs.inters, v)
+}
+
+func (rrs *RetentionRuleSelect) sqlScan(ctx context.Context, root *RetentionRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rrs.fns))
+	for _, fn := range rrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,750 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/retentionrule"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// RetentionRuleUpdate is the builder for updating RetentionRule entities.
+type RetentionRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RetentionRuleMutation
+}
+
+// Where appends a list predicates to the RetentionRuleUpdate builder.
+func (rru *RetentionRuleUpdate) Where(ps ...predicate.RetentionRule) *RetentionRuleUpdate {
+	rru.mutation.Where(ps...)
+	return rru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rru *RetentionRuleUpdate) SetUpdatedAt(t time.Time) *RetentionRuleUpdate {
+	rru.mutation.SetUpdatedAt(t)
+	return rru
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rru *RetentionRuleUpdate) SetDeletedAt(t time.Time) *RetentionRuleUpdate {
+	rru.mutation.SetDeletedAt(t)
+	return rru
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rru *RetentionRuleUpdate) SetNillableDeletedAt(t *time.Time) *RetentionRuleUpdate {
+	if t != nil {
+		rru.SetDeletedAt(*t)
+	}
+	return rru
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (rru *RetentionRuleUpdate) ClearDeletedAt() *RetentionRuleUpdate {
+	rru.mutation.ClearDeletedAt()
+	return rru
+}
+
+// SetUserID sets the "user_id" field.
+func (rru *RetentionRuleUpdate) SetUserID(i int) *RetentionRuleUpdate {
+	rru.mutation.SetUserID(i)
+	return rru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rru *RetentionRuleUpdate) SetNillableUserID(i *int) *RetentionRuleUpdate {
+	if i != nil {
+		rru.SetUserID(*i)
+	}
+	return rru
+}
+
+// SetFolder sets the "folder" field.
+func (rru *RetentionRuleUpdate) SetFolder(s string) *RetentionRuleUpdate {
+	rru.mutation.SetFolder(s)
+	return rru
+}
+
+// SetNillableFolder sets the "folder" field if the given value is not nil.
+func (rru *RetentionRuleUpdate) SetNillableFolder(s *string) *RetentionRuleUpdate {
+	if s != nil {
+		rru.SetFolder(*s)
+	}
+	return rru
+}
+
+// SetRecursive sets the "recursive" field.
+func (rru *RetentionRuleUpdate) SetRecursive(b bool) *RetentionRuleUpdate {
+	rru.mutation.SetRecursive(b)
+	return rru
+}
+
+// SetNillableRecursive sets the "recursive" field if the given value is not nil.
+func (rru *RetentionRuleUpdate) SetNillableRecursive(b *bool) *RetentionRuleUpdate {
+	if b != nil {
+		rru.SetRecursive(*b)
+	}
+	return rru
+}
+
+// SetAction sets the "action" field.
+func (rru *RetentionRuleUpdate) SetAction(r retentionrule.Action) *RetentionRuleUpdate {
+	rru.mutation.SetAction(r)
+	return rru
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (rru *RetentionRuleUpdate) SetNillableAction(r *retentionrule.Action) *RetentionRuleUpdate {
+	if r != nil {
+		rru.SetAction(*r)
+	}
+	return rru
+}
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (rru *RetentionRuleUpdate) SetMaxAgeDays(i int) *RetentionRuleUpdate {
+	rru.mutation.ResetMaxAgeDays()
+	rru.mutation.SetMaxAgeDays(i)
+	return rru
+}
+
+// SetNillableMaxAgeDays sets the "max_age_days" field if the given value is not nil.
+func (rru *RetentionRuleUpdate) SetNillableMaxAgeDays(i *int) *RetentionRuleUpdate {
+	if i != nil {
+		rru.SetMaxAgeDays(*i)
+	}
+	return rru
+}
+
+// AddMaxAgeDays adds i to the "max_age_days" field.
+func (rru *RetentionRuleUpdate) AddMaxAgeDays(i int) *RetentionRuleUpdate {
+	rru.mutation.AddMaxAgeDays(i)
+	return rru
+}
+
+// SetKeepVersions sets the "keep_versions" field.
+func (rru *RetentionRuleUpdate) SetKeepVersions(i int) *RetentionRuleUpdate {
+	rru.mutation.ResetKeepVersions()
+	rru.mutation.SetKeepVersions(i)
+	return rru
+}
+
+// SetNillableKeepVersions sets the "keep_versions" field if the given value is not nil.
+func (rru *RetentionRuleUpdate) SetNillableKeepVersions(i *int) *RetentionRuleUpdate {
+	if i != nil {
+		rru.SetKeepVersions(*i)
+	}
+	return rru
+}
+
+// AddKeepVersions adds i to the "keep_versions" field.
+func (rru *RetentionRuleUpdate) AddKeepVersions(i int) *RetentionRuleUpdate {
+	rru.mutation.AddKeepVersions(i)
+	return rru
+}
+
+// SetEnabled sets the "enabled" field.
+func (rru *RetentionRuleUpdate) SetEnabled(b bool) *RetentionRuleUpdate {
+	rru.mutation.SetEnabled(b)
+	return rru
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (rru *RetentionRuleUpdate) SetNillableEnabled(b *bool) *RetentionRuleUpdate {
+	if b != nil {
+		rru.SetEnabled(*b)
+	}
+	return rru
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (rru *RetentionRuleUpdate) SetLastRunAt(t time.Time) *RetentionRuleUpdate {
+	rru.mutation.SetLastRunAt(t)
+	return rru
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (rru *RetentionRuleUpdate) SetNillableLastRunAt(t *time.Time) *RetentionRuleUpdate {
+	if t != nil {
+		rru.SetLastRunAt(*t)
+	}
+	return rru
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (rru *RetentionRuleUpdate) ClearLastRunAt() *RetentionRuleUpdate {
+	rru.mutation.ClearLastRunAt()
+	return rru
+}
+
+// SetLastResult sets the "last_result" field.
+func (rru *RetentionRuleUpdate) SetLastResult(tr *types.RetentionResult) *RetentionRuleUpdate {
+	rru.mutation.SetLastResult(tr)
+	return rru
+}
+
+// ClearLastResult clears the value of the "last_result" field.
+func (rru *RetentionRuleUpdate) ClearLastResult() *RetentionRuleUpdate {
+	rru.mutation.ClearLastResult()
+	return rru
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rru *RetentionRuleUpdate) SetUser(u *User) *RetentionRuleUpdate {
+	return rru.SetUserID(u.ID)
+}
+
+// Mutation returns the RetentionRuleMutation object of the builder.
+func (rru *RetentionRuleUpdate) Mutation() *RetentionRuleMutation {
+	return rru.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (rru *RetentionRuleUpdate) ClearUser() *RetentionRuleUpdate {
+	rru.mutation.ClearUser()
+	return rru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rru *RetentionRuleUpdate) Save(ctx context.Context) (int, error) {
+	if err := rru.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, rru.sqlSave, rru.mutation, rru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rru *RetentionRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := rru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rru *RetentionRuleUpdate) Exec(ctx context.Context) error {
+	_, err := rru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rru *RetentionRuleUpdate) ExecX(ctx context.Context) {
+	if err := rru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rru *RetentionRuleUpdate) defaults() error {
+	if _, ok := rru.mutation.UpdatedAt(); !ok {
+		if retentionrule.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized retentionrule.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := retentionrule.UpdateDefaultUpdatedAt()
+		rru.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rru *RetentionRuleUpdate) check() error {
+	if v, ok := rru.mutation.Action(); ok {
+		if err := retentionrule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "RetentionRule.action": %w`, err)}
+		}
+	}
+	if _, ok := rru.mutation.UserID(); rru.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "RetentionRule.user"`)
+	}
+	return nil
+}
+
+func (rru *RetentionRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(retentionrule.Table, retentionrule.Columns, sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeInt))
+	if ps := rru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rru.mutation.UpdatedAt(); ok {
+		_spec.SetField(retentionrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rru.mutation.DeletedAt(); ok {
+		_spec.SetField(retentionrule.FieldDeletedAt, field.TypeTime, value)
+	}
+	if rru.mutation.DeletedAtCleared() {
+		_spec.ClearField(retentionrule.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := rru.mutation.Folder(); ok {
+		_spec.SetField(retentionrule.FieldFolder, field.TypeString, value)
+	}
+	if value, ok := rru.mutation.Recursive(); ok {
+		_spec.SetField(retentionrule.FieldRecursive, field.TypeBool, value)
+	}
+	if value, ok := rru.mutation.Action(); ok {
+		_spec.SetField(retentionrule.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := rru.mutation.MaxAgeDays(); ok {
+		_spec.SetField(retentionrule.FieldMaxAgeDays, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.AddedMaxAgeDays(); ok {
+		_spec.AddField(retentionrule.FieldMaxAgeDays, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.KeepVersions(); ok {
+		_spec.SetField(retentionrule.FieldKeepVersions, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.AddedKeepVersions(); ok {
+		_spec.AddField(retentionrule.FieldKeepVersions, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.Enabled(); ok {
+		_spec.SetField(retentionrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := rru.mutation.LastRunAt(); ok {
+		_spec.SetField(retentionrule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if rru.mutation.LastRunAtCleared() {
+		_spec.ClearField(retentionrule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := rru.mutation.LastResult(); ok {
+		_spec.SetField(retentionrule.FieldLastResult, field.TypeJSON, value)
+	}
+	if rru.mutation.LastResultCleared() {
+		_spec.ClearField(retentionrule.FieldLastResult, field.TypeJSON)
+	}
+	if rru.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   retentionrule.UserTable,
+			Columns: []string{retentionrule.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rru.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   retentionrule.UserTable,
+			Columns: []string{retentionrule.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{retentionrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rru.mutation.done = true
+	return n, nil
+}
+
+// RetentionRuleUpdateOne is the builder for updating a single RetentionRule entity.
+type RetentionRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RetentionRuleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rruo *RetentionRuleUpdateOne) SetUpdatedAt(t time.Time) *RetentionRuleUpdateOne {
+	rruo.mutation.SetUpdatedAt(t)
+	return rruo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rruo *RetentionRuleUpdateOne) SetDeletedAt(t time.Time) *RetentionRuleUpdateOne {
+	rruo.mutation.SetDeletedAt(t)
+	return rruo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rruo *RetentionRuleUpdateOne) SetNillableDeletedAt(t *time.Time) *RetentionRuleUpdateOne {
+	if t != nil {
+		rruo.SetDeletedAt(*t)
+	}
+	return rruo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (rruo *RetentionRuleUpdateOne) ClearDeletedAt() *RetentionRuleUpdateOne {
+	rruo.mutation.ClearDeletedAt()
+	return rruo
+}
+
+// SetUserID sets the "user_id" field.
+func (rruo *RetentionRuleUpdateOne) SetUserID(i int) *RetentionRuleUpdateOne {
+	rruo.mutation.SetUserID(i)
+	return rruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rruo *RetentionRuleUpdateOne) SetNillableUserID(i *int) *RetentionRuleUpdateOne {
+	if i != nil {
+		rruo.SetUserID(*i)
+	}
+	return rruo
+}
+
+// SetFolder sets the "folder" field.
+func (rruo *RetentionRuleUpdateOne) SetFolder(s string) *RetentionRuleUpdateOne {
+	rruo.mutation.SetFolder(s)
+	return rruo
+}
+
+// SetNillableFolder sets the "folder" field if the given value is not nil.
+func (rruo *RetentionRuleUpdateOne) SetNillableFolder(s *string) *RetentionRuleUpdateOne {
+	if s != nil {
+		rruo.SetFolder(*s)
+	}
+	return rruo
+}
+
+// SetRecursive sets the "recursive" field.
+func (rruo *RetentionRuleUpdateOne) SetRecursive(b bool) *RetentionRuleUpdateOne {
+	rruo.mutation.SetRecursive(b)
+	return rruo
+}
+
+// SetNillableRecursive sets the "recursive" field if the given value is not nil.
+func (rruo *RetentionRuleUpdateOne) SetNillableRecursive(b *bool) *RetentionRuleUpdateOne {
+	if b != nil {
+		rruo.SetRecursive(*b)
+	}
+	return rruo
+}
+
+// SetAction sets the "action" field.
+func (rruo *RetentionRuleUpdateOne) SetAction(r retentionrule.Action) *RetentionRuleUpdateOne {
+	rruo.mutation.SetAction(r)
+	return rruo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (rruo *RetentionRuleUpdateOne) SetNillableAction(r *retentionrule.Action) *RetentionRuleUpdateOne {
+	if r != nil {
+		rruo.SetAction(*r)
+	}
+	return rruo
+}
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (rruo *RetentionRuleUpdateOne) SetMaxAgeDays(i int) *RetentionRuleUpdateOne {
+	rruo.mutation.ResetMaxAgeDays()
+	rruo.mutation.SetMaxAgeDays(i)
+	return rruo
+}
+
+// SetNillableMaxAgeDays sets the "max_age_days" field if the given value is not nil.
+func (rruo *RetentionRuleUpdateOne) SetNillableMaxAgeDays(i *int) *RetentionRuleUpdateOne {
+	if i != nil {
+		rruo.SetMaxAgeDays(*i)
+	}
+	return rruo
+}
+
+// AddMaxAgeDays adds i to the "max_age_days" field.
+func (rruo *RetentionRuleUpdateOne) AddMaxAgeDays(i int) *RetentionRuleUpdateOne {
+	rruo.mutation.AddMaxAgeDays(i)
+	return rruo
+}
+
+// SetKeepVersions sets the "keep_versions" field.
+func (rruo *RetentionRuleUpdateOne) SetKeepVersions(i int) *RetentionRuleUpdateOne {
+	rruo.mutation.ResetKeepVersions()
+	rruo.mutation.SetKeepVersions(i)
+	return rruo
+}
+
+// SetNillableKeepVersions sets the "keep_versions" field if the given value is not nil.
+func (rruo *RetentionRuleUpdateOne) SetNillableKeepVersions(i *int) *RetentionRuleUpdateOne {
+	if i != nil {
+		rruo.SetKeepVersions(*i)
+	}
+	return rruo
+}
+
+// AddKeepVersions adds i to the "keep_versions" field.
+func (rruo *RetentionRuleUpdateOne) AddKeepVersions(i int) *RetentionRuleUpdateOne {
+	rruo.mutation.AddKeepVersions(i)
+	return rruo
+}
+
+// SetEnabled sets the "enabled" field.
+func (rruo *RetentionRuleUpdateOne) SetEnabled(b bool) *RetentionRuleUpdateOne {
+	rruo.mutation.SetEnabled(b)
+	return rruo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (rruo *RetentionRuleUpdateOne) SetNillableEnabled(b *bool) *RetentionRuleUpdateOne {
+	if b != nil {
+		rruo.SetEnabled(*b)
+	}
+	return rruo
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (rruo *RetentionRuleUpdateOne) SetLastRunAt(t time.Time) *RetentionRuleUpdateOne {
+	rruo.mutation.SetLastRunAt(t)
+	return rruo
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (rruo *RetentionRuleUpdateOne) SetNillableLastRunAt(t *time.Time) *RetentionRuleUpdateOne {
+	if t != nil {
+		rruo.SetLastRunAt(*t)
+	}
+	return rruo
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (rruo *RetentionRuleUpdateOne) ClearLastRunAt() *RetentionRuleUpdateOne {
+	rruo.mutation.ClearLastRunAt()
+	return rruo
+}
+
+// SetLastResult sets the "last_result" field.
+func (rruo *RetentionRuleUpdateOne) SetLastResult(tr *types.RetentionResult) *RetentionRuleUpdateOne {
+	rruo.mutation.SetLastResult(tr)
+	return rruo
+}
+
+// ClearLastResult clears the value of the "last_result" field.
+func (rruo *RetentionRuleUpdateOne) ClearLastResult() *RetentionRuleUpdateOne {
+	rruo.mutation.ClearLastResult()
+	return rruo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rruo *RetentionRuleUpdateOne) SetUser(u *User) *RetentionRuleUpdateOne {
+	return rruo.SetUserID(u.ID)
+}
+
+// Mutation returns the RetentionRuleMutation object of the builder.
+func (rruo *RetentionRuleUpdateOne) Mutation() *RetentionRuleMutation {
+	return rruo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (rruo *RetentionRuleUpdateOne) ClearUser() *RetentionRuleUpdateOne {
+	rruo.mutation.ClearUser()
+	return rruo
+}
+
+// Where appends a list predicates to the RetentionRuleUpdate builder.
+func (rruo *RetentionRuleUpdateOne) Where(ps ...predicate.RetentionRule) *RetentionRuleUpdateOne {
+	rruo.mutation.Where(ps...)
+	return rruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rruo *RetentionRuleUpdateOne) Select(field string, fields ...string) *RetentionRuleUpdateOne {
+	rruo.fields = append([]string{field}, fields...)
+	return rruo
+}
+
+// Save executes the query and returns the updated RetentionRule entity.
+func (rruo *RetentionRuleUpdateOne) Save(ctx context.Context) (*RetentionRule, error) {
+	if err := rruo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rruo.sqlSave, rruo.mutation, rruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rruo *RetentionRuleUpdateOne) SaveX(ctx context.Context) *RetentionRule {
+	node, err := rruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rruo *RetentionRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := rruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rruo *RetentionRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := rruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rruo *RetentionRuleUpdateOne) defaults() error {
+	if _, ok := rruo.mutation.UpdatedAt(); !ok {
+		if retentionrule.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized retentionrule.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := retentionrule.UpdateDefaultUpdatedAt()
+		rruo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rruo *RetentionRuleUpdateOne) check() error {
+	if v, ok := rruo.mutation.Action(); ok {
+		if err := retentionrule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "RetentionRule.action": %w`, err)}
+		}
+	}
+	if _, ok := rruo.mutation.UserID(); rruo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "RetentionRule.user"`)
+	}
+	return nil
+}
+
+func (rruo *RetentionRuleUpdateOne) sqlSave(ctx context.Context) (_node *RetentionRule, err error) {
+	if err := rruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(retentionrule.Table, retentionrule.Columns, sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeInt))
+	id, ok := rruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RetentionRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, retentionrule.FieldID)
+		for _, f := range fields {
+			if !retentionrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != retentionrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(retentionrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rruo.mutation.DeletedAt(); ok {
+		_spec.SetField(retentionrule.FieldDeletedAt, field.TypeTime, value)
+	}
+	if rruo.mutation.DeletedAtCleared() {
+		_spec.ClearField(retentionrule.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := rruo.mutation.Folder(); ok {
+		_spec.SetField(retentionrule.FieldFolder, field.TypeString, value)
+	}
+	if value, ok := rruo.mutation.Recursive(); ok {
+		_spec.SetField(retentionrule.FieldRecursive, field.TypeBool, value)
+	}
+	if value, ok := rruo.mutation.Action(); ok {
+		_spec.SetField(retentionrule.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := rruo.mutation.MaxAgeDays(); ok {
+		_spec.SetField(retentionrule.FieldMaxAgeDays, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.AddedMaxAgeDays(); ok {
+		_spec.AddField(retentionrule.FieldMaxAgeDays, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.KeepVersions(); ok {
+		_spec.SetField(retentionrule.FieldKeepVersions, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.AddedKeepVersions(); ok {
+		_spec.AddField(retentionrule.FieldKeepVersions, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.Enabled(); ok {
+		_spec.SetField(retentionrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := rruo.mutation.LastRunAt(); ok {
+		_spec.SetField(retentionrule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if rruo.mutation.LastRunAtCleared() {
+		_spec.ClearField(retentionrule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := rruo.mutation.LastResult(); ok {
+		_spec.SetField(retentionrule.FieldLastResult, field.TypeJSON, value)
+	}
+	if rruo.mutation.LastResultCleared() {
+		_spec.ClearField(retentionrule.FieldLastResult, field.TypeJSON)
+	}
+	if rruo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   retentionrule.UserTable,
+			Columns: []string{retentionrule.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rruo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   retentionrule.UserTable,
+			Columns: []string{retentionrule.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RetentionRule{config: rruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{retentionrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rruo.mutation.done = true
+	return _node, nil
+}
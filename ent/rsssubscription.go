@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/rsssubscription"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// RssSubscription is the model entity for the RssSubscription schema.
+type RssSubscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Include holds the value of the "include" field.
+	Include string `json:"include,omitempty"`
+	// Exclude holds the value of the "exclude" field.
+	Exclude string `json:"exclude,omitempty"`
+	// Dst holds the value of the "dst" field.
+	Dst string `json:"dst,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Seen holds the value of the "seen" field.
+	Seen []string `json:"seen,omitempty"`
+	// LastCheckedAt holds the value of the "last_checked_at" field.
+	LastCheckedAt *time.Time `json:"last_checked_at,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RssSubscriptionQuery when eager-loading is set.
+	Edges        RssSubscriptionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RssSubscriptionEdges holds the relations/edges for other nodes in the graph.
+type RssSubscriptionEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RssSubscriptionEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RssSubscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rsssubscription.FieldSeen:
+			values[i] = new([]byte)
+		case rsssubscription.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case rsssubscription.FieldID, rsssubscription.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case rsssubscription.FieldName, rsssubscription.FieldURL, rsssubscription.FieldInclude, rsssubscription.FieldExclude, rsssubscription.FieldDst, rsssubscription.FieldLastError:
+			values[i] = new(sql.NullString)
+		case rsssubscription.FieldCreatedAt, rsssubscription.FieldUpdatedAt, rsssubscription.FieldDeletedAt, rsssubscription.FieldLastCheckedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RssSubscription fields.
+func (rs *RssSubscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rsssubscription.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rs.ID = int(value.Int64)
+		case rsssubscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rs.CreatedAt = value.Time
+			}
+		case rsssubscription.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rs.UpdatedAt = value.Time
+			}
+		case rsssubscription.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				rs.DeletedAt = new(time.Time)
+				*rs.DeletedAt = value.Time
+			}
+		case rsssubscription.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				rs.UserID = int(value.Int64)
+			}
+		case rsssubscription.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				rs.Name = value.String
+			}
+		case rsssubscription.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				rs.URL = value.String
+			}
+		case rsssubscription.FieldInclude:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field include", values[i])
+			} else if value.Valid {
+				rs.Include = value.String
+			}
+		case rsssubscription.FieldExclude:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exclude", values[i])
+			} else if value.Valid {
+				rs.Exclude = value.String
+			}
+		case rsssubscription.FieldDst:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dst", values[i])
+			} else if value.Valid {
+				rs.Dst = value.String
+			}
+		case rsssubscription.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				rs.Enabled = value.Bool
+			}
+		case rsssubscription.FieldSeen:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field seen", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rs.Seen); err != nil {
+					return fmt.Errorf("unmarshal field seen: %w", err)
+				}
+			}
+		case rsssubscription.FieldLastCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_checked_at", values[i])
+			} else if value.Valid {
+				rs.LastCheckedAt = new(time.Time)
+				*rs.LastCheckedAt = value.Time
+			}
+		case rsssubscription.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				rs.LastError = value.String
+			}
+		default:
+			rs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RssSubscription.
+// This includes values selected through modifiers, order, etc.
+func (rs *RssSubscription) Value(name string) (ent.Value, error) {
+	return rs.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the RssSubscription entity.
+func (rs *RssSubscription) QueryUser() *UserQuery {
+	return NewRssSubscriptionClient(rs.config).QueryUser(rs)
+}
+
+// Update returns a builder for updating this RssSubscription.
+// Note that you need to call RssSubscription.Unwrap() before calling this method if this RssSubscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rs *RssSubscription) Update() *RssSubscriptionUpdateOne {
+	return NewRssSubscriptionClient(rs.config).UpdateOne(rs)
+}
+
+// Unwrap unwraps the RssSubscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rs *RssSubscription) Unwrap() *RssSubscription {
+	_tx, ok := rs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RssSubscription is not a transactional entity")
+	}
+	rs.config.driver = _tx.drv
+	return rs
+}
+
+// String implements the fmt.Stringer.
+func (rs *RssSubscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("RssSubscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rs.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(rs.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rs.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := rs.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", rs.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(rs.Name)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(rs.URL)
+	builder.WriteString(", ")
+	builder.WriteString("include=")
+	builder.WriteString(rs.Include)
+	builder.WriteString(", ")
+	builder.WriteString("exclude=")
+	builder.WriteString(rs.Exclude)
+	builder.WriteString(", ")
+	builder.WriteString("dst=")
+	builder.WriteString(rs.Dst)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", rs.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("seen=")
+	builder.WriteString(fmt.Sprintf("%v", rs.Seen))
+	builder.WriteString(", ")
+	if v := rs.LastCheckedAt; v != nil {
+		builder.WriteString("last_checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(rs.LastError)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetUser manually set the edge as loaded state.
+func (e *RssSubscription) SetUser(v *User) {
+	e.Edges.User = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// RssSubscriptions is a parsable slice of RssSubscription.
+type RssSubscriptions []*RssSubscription
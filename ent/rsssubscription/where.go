@@ -0,0 +1,794 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rsssubscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldName, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldURL, v))
+}
+
+// Include applies equality check predicate on the "include" field. It's identical to IncludeEQ.
+func Include(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldInclude, v))
+}
+
+// Exclude applies equality check predicate on the "exclude" field. It's identical to ExcludeEQ.
+func Exclude(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldExclude, v))
+}
+
+// Dst applies equality check predicate on the "dst" field. It's identical to DstEQ.
+func Dst(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldDst, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldEnabled, v))
+}
+
+// LastCheckedAt applies equality check predicate on the "last_checked_at" field. It's identical to LastCheckedAtEQ.
+func LastCheckedAt(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldLastCheckedAt, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldLastError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldContainsFold(FieldName, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldContainsFold(FieldURL, v))
+}
+
+// IncludeEQ applies the EQ predicate on the "include" field.
+func IncludeEQ(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldInclude, v))
+}
+
+// IncludeNEQ applies the NEQ predicate on the "include" field.
+func IncludeNEQ(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNEQ(FieldInclude, v))
+}
+
+// IncludeIn applies the In predicate on the "include" field.
+func IncludeIn(vs ...string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIn(FieldInclude, vs...))
+}
+
+// IncludeNotIn applies the NotIn predicate on the "include" field.
+func IncludeNotIn(vs ...string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotIn(FieldInclude, vs...))
+}
+
+// IncludeGT applies the GT predicate on the "include" field.
+func IncludeGT(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGT(FieldInclude, v))
+}
+
+// IncludeGTE applies the GTE predicate on the "include" field.
+func IncludeGTE(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGTE(FieldInclude, v))
+}
+
+// IncludeLT applies the LT predicate on the "include" field.
+func IncludeLT(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLT(FieldInclude, v))
+}
+
+// IncludeLTE applies the LTE predicate on the "include" field.
+func IncludeLTE(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLTE(FieldInclude, v))
+}
+
+// IncludeContains applies the Contains predicate on the "include" field.
+func IncludeContains(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldContains(FieldInclude, v))
+}
+
+// IncludeHasPrefix applies the HasPrefix predicate on the "include" field.
+func IncludeHasPrefix(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldHasPrefix(FieldInclude, v))
+}
+
+// IncludeHasSuffix applies the HasSuffix predicate on the "include" field.
+func IncludeHasSuffix(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldHasSuffix(FieldInclude, v))
+}
+
+// IncludeIsNil applies the IsNil predicate on the "include" field.
+func IncludeIsNil() predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIsNull(FieldInclude))
+}
+
+// IncludeNotNil applies the NotNil predicate on the "include" field.
+func IncludeNotNil() predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotNull(FieldInclude))
+}
+
+// IncludeEqualFold applies the EqualFold predicate on the "include" field.
+func IncludeEqualFold(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEqualFold(FieldInclude, v))
+}
+
+// IncludeContainsFold applies the ContainsFold predicate on the "include" field.
+func IncludeContainsFold(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldContainsFold(FieldInclude, v))
+}
+
+// ExcludeEQ applies the EQ predicate on the "exclude" field.
+func ExcludeEQ(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldExclude, v))
+}
+
+// ExcludeNEQ applies the NEQ predicate on the "exclude" field.
+func ExcludeNEQ(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNEQ(FieldExclude, v))
+}
+
+// ExcludeIn applies the In predicate on the "exclude" field.
+func ExcludeIn(vs ...string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIn(FieldExclude, vs...))
+}
+
+// ExcludeNotIn applies the NotIn predicate on the "exclude" field.
+func ExcludeNotIn(vs ...string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotIn(FieldExclude, vs...))
+}
+
+// ExcludeGT applies the GT predicate on the "exclude" field.
+func ExcludeGT(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGT(FieldExclude, v))
+}
+
+// ExcludeGTE applies the GTE predicate on the "exclude" field.
+func ExcludeGTE(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGTE(FieldExclude, v))
+}
+
+// ExcludeLT applies the LT predicate on the "exclude" field.
+func ExcludeLT(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLT(FieldExclude, v))
+}
+
+// ExcludeLTE applies the LTE predicate on the "exclude" field.
+func ExcludeLTE(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLTE(FieldExclude, v))
+}
+
+// ExcludeContains applies the Contains predicate on the "exclude" field.
+func ExcludeContains(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldContains(FieldExclude, v))
+}
+
+// ExcludeHasPrefix applies the HasPrefix predicate on the "exclude" field.
+func ExcludeHasPrefix(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldHasPrefix(FieldExclude, v))
+}
+
+// ExcludeHasSuffix applies the HasSuffix predicate on the "exclude" field.
+func ExcludeHasSuffix(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldHasSuffix(FieldExclude, v))
+}
+
+// ExcludeIsNil applies the IsNil predicate on the "exclude" field.
+func ExcludeIsNil() predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIsNull(FieldExclude))
+}
+
+// ExcludeNotNil applies the NotNil predicate on the "exclude" field.
+func ExcludeNotNil() predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotNull(FieldExclude))
+}
+
+// ExcludeEqualFold applies the EqualFold predicate on the "exclude" field.
+func ExcludeEqualFold(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEqualFold(FieldExclude, v))
+}
+
+// ExcludeContainsFold applies the ContainsFold predicate on the "exclude" field.
+func ExcludeContainsFold(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldContainsFold(FieldExclude, v))
+}
+
+// DstEQ applies the EQ predicate on the "dst" field.
+func DstEQ(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldDst, v))
+}
+
+// DstNEQ applies the NEQ predicate on the "dst" field.
+func DstNEQ(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNEQ(FieldDst, v))
+}
+
+// DstIn applies the In predicate on the "dst" field.
+func DstIn(vs ...string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIn(FieldDst, vs...))
+}
+
+// DstNotIn applies the NotIn predicate on the "dst" field.
+func DstNotIn(vs ...string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotIn(FieldDst, vs...))
+}
+
+// DstGT applies the GT predicate on the "dst" field.
+func DstGT(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGT(FieldDst, v))
+}
+
+// DstGTE applies the GTE predicate on the "dst" field.
+func DstGTE(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGTE(FieldDst, v))
+}
+
+// DstLT applies the LT predicate on the "dst" field.
+func DstLT(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLT(FieldDst, v))
+}
+
+// DstLTE applies the LTE predicate on the "dst" field.
+func DstLTE(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLTE(FieldDst, v))
+}
+
+// DstContains applies the Contains predicate on the "dst" field.
+func DstContains(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldContains(FieldDst, v))
+}
+
+// DstHasPrefix applies the HasPrefix predicate on the "dst" field.
+func DstHasPrefix(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldHasPrefix(FieldDst, v))
+}
+
+// DstHasSuffix applies the HasSuffix predicate on the "dst" field.
+func DstHasSuffix(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldHasSuffix(FieldDst, v))
+}
+
+// DstEqualFold applies the EqualFold predicate on the "dst" field.
+func DstEqualFold(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEqualFold(FieldDst, v))
+}
+
+// DstContainsFold applies the ContainsFold predicate on the "dst" field.
+func DstContainsFold(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldContainsFold(FieldDst, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// SeenIsNil applies the IsNil predicate on the "seen" field.
+func SeenIsNil() predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIsNull(FieldSeen))
+}
+
+// SeenNotNil applies the NotNil predicate on the "seen" field.
+func SeenNotNil() predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotNull(FieldSeen))
+}
+
+// LastCheckedAtEQ applies the EQ predicate on the "last_checked_at" field.
+func LastCheckedAtEQ(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtNEQ applies the NEQ predicate on the "last_checked_at" field.
+func LastCheckedAtNEQ(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNEQ(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtIn applies the In predicate on the "last_checked_at" field.
+func LastCheckedAtIn(vs ...time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIn(FieldLastCheckedAt, vs...))
+}
+
+// LastCheckedAtNotIn applies the NotIn predicate on the "last_checked_at" field.
+func LastCheckedAtNotIn(vs ...time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotIn(FieldLastCheckedAt, vs...))
+}
+
+// LastCheckedAtGT applies the GT predicate on the "last_checked_at" field.
+func LastCheckedAtGT(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGT(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtGTE applies the GTE predicate on the "last_checked_at" field.
+func LastCheckedAtGTE(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGTE(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtLT applies the LT predicate on the "last_checked_at" field.
+func LastCheckedAtLT(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLT(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtLTE applies the LTE predicate on the "last_checked_at" field.
+func LastCheckedAtLTE(v time.Time) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLTE(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtIsNil applies the IsNil predicate on the "last_checked_at" field.
+func LastCheckedAtIsNil() predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIsNull(FieldLastCheckedAt))
+}
+
+// LastCheckedAtNotNil applies the NotNil predicate on the "last_checked_at" field.
+func LastCheckedAtNotNil() predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotNull(FieldLastCheckedAt))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.RssSubscription {
+	return predicate.RssSubscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.RssSubscription {
+	return predicate.RssSubscription(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RssSubscription) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RssSubscription) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RssSubscription) predicate.RssSubscription {
+	return predicate.RssSubscription(sql.NotPredicates(p))
+}
@@ -0,0 +1,1290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/rsssubscription"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// RssSubscriptionCreate is the builder for creating a RssSubscription entity.
+type RssSubscriptionCreate struct {
+	config
+	mutation *RssSubscriptionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rsc *RssSubscriptionCreate) SetCreatedAt(t time.Time) *RssSubscriptionCreate {
+	rsc.mutation.SetCreatedAt(t)
+	return rsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rsc *RssSubscriptionCreate) SetNillableCreatedAt(t *time.Time) *RssSubscriptionCreate {
+	if t != nil {
+		rsc.SetCreatedAt(*t)
+	}
+	return rsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rsc *RssSubscriptionCreate) SetUpdatedAt(t time.Time) *RssSubscriptionCreate {
+	rsc.mutation.SetUpdatedAt(t)
+	return rsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rsc *RssSubscriptionCreate) SetNillableUpdatedAt(t *time.Time) *RssSubscriptionCreate {
+	if t != nil {
+		rsc.SetUpdatedAt(*t)
+	}
+	return rsc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rsc *RssSubscriptionCreate) SetDeletedAt(t time.Time) *RssSubscriptionCreate {
+	rsc.mutation.SetDeletedAt(t)
+	return rsc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rsc *RssSubscriptionCreate) SetNillableDeletedAt(t *time.Time) *RssSubscriptionCreate {
+	if t != nil {
+		rsc.SetDeletedAt(*t)
+	}
+	return rsc
+}
+
+// SetUserID sets the "user_id" field.
+func (rsc *RssSubscriptionCreate) SetUserID(i int) *RssSubscriptionCreate {
+	rsc.mutation.SetUserID(i)
+	return rsc
+}
+
+// SetName sets the "name" field.
+func (rsc *RssSubscriptionCreate) SetName(s string) *RssSubscriptionCreate {
+	rsc.mutation.SetName(s)
+	return rsc
+}
+
+// SetURL sets the "url" field.
+func (rsc *RssSubscriptionCreate) SetURL(s string) *RssSubscriptionCreate {
+	rsc.mutation.SetURL(s)
+	return rsc
+}
+
+// SetInclude sets the "include" field.
+func (rsc *RssSubscriptionCreate) SetInclude(s string) *RssSubscriptionCreate {
+	rsc.mutation.SetInclude(s)
+	return rsc
+}
+
+// SetNillableInclude sets the "include" field if the given value is not nil.
+func (rsc *RssSubscriptionCreate) SetNillableInclude(s *string) *RssSubscriptionCreate {
+	if s != nil {
+		rsc.SetInclude(*s)
+	}
+	return rsc
+}
+
+// SetExclude sets the "exclude" field.
+func (rsc *RssSubscriptionCreate) SetExclude(s string) *RssSubscriptionCreate {
+	rsc.mutation.SetExclude(s)
+	return rsc
+}
+
+// SetNillableExclude sets the "exclude" field if the given value is not nil.
+func (rsc *RssSubscriptionCreate) SetNillableExclude(s *string) *RssSubscriptionCreate {
+	if s != nil {
+		rsc.SetExclude(*s)
+	}
+	return rsc
+}
+
+// SetDst sets the "dst" field.
+func (rsc *RssSubscriptionCreate) SetDst(s string) *RssSubscriptionCreate {
+	rsc.mutation.SetDst(s)
+	return rsc
+}
+
+// SetEnabled sets the "enabled" field.
+func (rsc *RssSubscriptionCreate) SetEnabled(b bool) *RssSubscriptionCreate {
+	rsc.mutation.SetEnabled(b)
+	return rsc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (rsc *RssSubscriptionCreate) SetNillableEnabled(b *bool) *RssSubscriptionCreate {
+	if b != nil {
+		rsc.SetEnabled(*b)
+	}
+	return rsc
+}
+
+// SetSeen sets the "seen" field.
+func (rsc *RssSubscriptionCreate) SetSeen(s []string) *RssSubscriptionCreate {
+	rsc.mutation.SetSeen(s)
+	return rsc
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (rsc *RssSubscriptionCreate) SetLastCheckedAt(t time.Time) *RssSubscriptionCreate {
+	rsc.mutation.SetLastCheckedAt(t)
+	return rsc
+}
+
+// SetNillableLastCheckedAt sets the "last_checked_at" field if the given value is not nil.
+func (rsc *RssSubscriptionCreate) SetNillableLastCheckedAt(t *time.Time) *RssSubscriptionCreate {
+	if t != nil {
+		rsc.SetLastCheckedAt(*t)
+	}
+	return rsc
+}
+
+// SetLastError sets the "last_error" field.
+func (rsc *RssSubscriptionCreate) SetLastError(s string) *RssSubscriptionCreate {
+	rsc.mutation.SetLastError(s)
+	return rsc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (rsc *RssSubscriptionCreate) SetNillableLastError(s *string) *RssSubscriptionCreate {
+	if s != nil {
+		rsc.SetLastError(*s)
+	}
+	return rsc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rsc *RssSubscriptionCreate) SetUser(u *User) *RssSubscriptionCreate {
+	return rsc.SetUserID(u.ID)
+}
+
+// Mutation returns the RssSubscriptionMutation object of the builder.
+func (rsc *RssSubscriptionCreate) Mutation() *RssSubscriptionMutation {
+	return rsc.mutation
+}
+
+// Save creates the RssSubscription in the database.
+func (rsc *RssSubscriptionCreate) Save(ctx context.Context) (*RssSubscription, error) {
+	if err := rsc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rsc.sqlSave, rsc.mutation, rsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rsc *RssSubscriptionCreate) SaveX(ctx context.Context) *RssSubscription {
+	v, err := rsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rsc *RssSubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := rsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsc *RssSubscriptionCreate) ExecX(ctx context.Context) {
+	if err := rsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rsc *RssSubscriptionCreate) defaults() error {
+	if _, ok := rsc.mutation.CreatedAt(); !ok {
+		if rsssubscription.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized rsssubscription.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := rsssubscription.DefaultCreatedAt()
+		rsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rsc.mutation.UpdatedAt(); !ok {
+		if rsssubscription.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized rsssubscription.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := rsssubscription.DefaultUpdatedAt()
+		rsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rsc.mutation.Enabled(); !ok {
+		v := rsssubscription.DefaultEnabled
+		rsc.mutation.SetEnabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsc *RssSubscriptionCreate) check() error {
+	if _, ok := rsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RssSubscription.created_at"`)}
+	}
+	if _, ok := rsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RssSubscription.updated_at"`)}
+	}
+	if _, ok := rsc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RssSubscription.user_id"`)}
+	}
+	if _, ok := rsc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "RssSubscription.name"`)}
+	}
+	if _, ok := rsc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "RssSubscription.url"`)}
+	}
+	if _, ok := rsc.mutation.Dst(); !ok {
+		return &ValidationError{Name: "dst", err: errors.New(`ent: missing required field "RssSubscription.dst"`)}
+	}
+	if _, ok := rsc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "RssSubscription.enabled"`)}
+	}
+	if _, ok := rsc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "RssSubscription.user"`)}
+	}
+	return nil
+}
+
+func (rsc *RssSubscriptionCreate) sqlSave(ctx context.Context) (*RssSubscription, error) {
+	if err := rsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rsc.mutation.id = &_node.ID
+	rsc.mutation.done = true
+	return _node, nil
+}
+
+func (rsc *RssSubscriptionCreate) createSpec() (*RssSubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RssSubscription{config: rsc.config}
+		_spec = sqlgraph.NewCreateSpec(rsssubscription.Table, sqlgraph.NewFieldSpec(rsssubscription.FieldID, field.TypeInt))
+	)
+
+	if id, ok := rsc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = rsc.conflict
+	if value, ok := rsc.mutation.CreatedAt(); ok {
+		_spec.SetField(rsssubscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(rsssubscription.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rsc.mutation.DeletedAt(); ok {
+		_spec.SetField(rsssubscription.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := rsc.mutation.Name(); ok {
+		_spec.SetField(rsssubscription.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := rsc.mutation.URL(); ok {
+		_spec.SetField(rsssubscription.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := rsc.mutation.Include(); ok {
+		_spec.SetField(rsssubscription.FieldInclude, field.TypeString, value)
+		_node.Include = value
+	}
+	if value, ok := rsc.mutation.Exclude(); ok {
+		_spec.SetField(rsssubscription.FieldExclude, field.TypeString, value)
+		_node.Exclude = value
+	}
+	if value, ok := rsc.mutation.Dst(); ok {
+		_spec.SetField(rsssubscription.FieldDst, field.TypeString, value)
+		_node.Dst = value
+	}
+	if value, ok := rsc.mutation.Enabled(); ok {
+		_spec.SetField(rsssubscription.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := rsc.mutation.Seen(); ok {
+		_spec.SetField(rsssubscription.FieldSeen, field.TypeJSON, value)
+		_node.Seen = value
+	}
+	if value, ok := rsc.mutation.LastCheckedAt(); ok {
+		_spec.SetField(rsssubscription.FieldLastCheckedAt, field.TypeTime, value)
+		_node.LastCheckedAt = &value
+	}
+	if value, ok := rsc.mutation.LastError(); ok {
+		_spec.SetField(rsssubscription.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if nodes := rsc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rsssubscription.UserTable,
+			Columns: []string{rsssubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RssSubscription.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RssSubscriptionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rsc *RssSubscriptionCreate) OnConflict(opts ...sql.ConflictOption) *RssSubscriptionUpsertOne {
+	rsc.conflict = opts
+	return &RssSubscriptionUpsertOne{
+		create: rsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RssSubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rsc *RssSubscriptionCreate) OnConflictColumns(columns ...string) *RssSubscriptionUpsertOne {
+	rsc.conflict = append(rsc.conflict, sql.ConflictColumns(columns...))
+	return &RssSubscriptionUpsertOne{
+		create: rsc,
+	}
+}
+
+type (
+	// RssSubscriptionUpsertOne is the builder for "upsert"-ing
+	//  one RssSubscription node.
+	RssSubscriptionUpsertOne struct {
+		create *RssSubscriptionCreate
+	}
+
+	// RssSubscriptionUpsert is the "OnConflict" setter.
+	RssSubscriptionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RssSubscriptionUpsert) SetUpdatedAt(v time.Time) *RssSubscriptionUpsert {
+	u.Set(rsssubscription.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RssSubscriptionUpsert) UpdateUpdatedAt() *RssSubscriptionUpsert {
+	u.SetExcluded(rsssubscription.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *RssSubscriptionUpsert) SetDeletedAt(v time.Time) *RssSubscriptionUpsert {
+	u.Set(rsssubscription.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *RssSubscriptionUpsert) UpdateDeletedAt() *RssSubscriptionUpsert {
+	u.SetExcluded(rsssubscription.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *RssSubscriptionUpsert) ClearDeletedAt() *RssSubscriptionUpsert {
+	u.SetNull(rsssubscription.FieldDeletedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *RssSubscriptionUpsert) SetUserID(v int) *RssSubscriptionUpsert {
+	u.Set(rsssubscription.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *RssSubscriptionUpsert) UpdateUserID() *RssSubscriptionUpsert {
+	u.SetExcluded(rsssubscription.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *RssSubscriptionUpsert) SetName(v string) *RssSubscriptionUpsert {
+	u.Set(rsssubscription.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RssSubscriptionUpsert) UpdateName() *RssSubscriptionUpsert {
+	u.SetExcluded(rsssubscription.FieldName)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *RssSubscriptionUpsert) SetURL(v string) *RssSubscriptionUpsert {
+	u.Set(rsssubscription.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *RssSubscriptionUpsert) UpdateURL() *RssSubscriptionUpsert {
+	u.SetExcluded(rsssubscription.FieldURL)
+	return u
+}
+
+// SetInclude sets the "include" field.
+func (u *RssSubscriptionUpsert) SetInclude(v string) *RssSubscriptionUpsert {
+	u.Set(rsssubscription.FieldInclude, v)
+	return u
+}
+
+// UpdateInclude sets the "include" field to the value that was provided on create.
+func (u *RssSubscriptionUpsert) UpdateInclude() *RssSubscriptionUpsert {
+	u.SetExcluded(rsssubscription.FieldInclude)
+	return u
+}
+
+// ClearInclude clears the value of the "include" field.
+func (u *RssSubscriptionUpsert) ClearInclude() *RssSubscriptionUpsert {
+	u.SetNull(rsssubscription.FieldInclude)
+	return u
+}
+
+// SetExclude sets the "exclude" field.
+func (u *RssSubscriptionUpsert) SetExclude(v string) *RssSubscriptionUpsert {
+	u.Set(rsssubscription.FieldExclude, v)
+	return u
+}
+
+// UpdateExclude sets the "exclude" field to the value that was provided on create.
+func (u *RssSubscriptionUpsert) UpdateExclude() *RssSubscriptionUpsert {
+	u.SetExcluded(rsssubscription.FieldExclude)
+	return u
+}
+
+// ClearExclude clears the value of the "exclude" field.
+func (u *RssSubscriptionUpsert) ClearExclude() *RssSubscriptionUpsert {
+	u.SetNull(rsssubscription.FieldExclude)
+	return u
+}
+
+// SetDst sets the "dst" field.
+func (u *RssSubscriptionUpsert) SetDst(v string) *RssSubscriptionUpsert {
+	u.Set(rsssubscription.FieldDst, v)
+	return u
+}
+
+// UpdateDst sets the "dst" field to the value that was provided on create.
+func (u *RssSubscriptionUpsert) UpdateDst() *RssSubscriptionUpsert {
+	u.SetExcluded(rsssubscription.FieldDst)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RssSubscriptionUpsert) SetEnabled(v bool) *RssSubscriptionUpsert {
+	u.Set(rsssubscription.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RssSubscriptionUpsert) UpdateEnabled() *RssSubscriptionUpsert {
+	u.SetExcluded(rsssubscription.FieldEnabled)
+	return u
+}
+
+// SetSeen sets the "seen" field.
+func (u *RssSubscriptionUpsert) SetSeen(v []string) *RssSubscriptionUpsert {
+	u.Set(rsssubscription.FieldSeen, v)
+	return u
+}
+
+// UpdateSeen sets the "seen" field to the value that was provided on create.
+func (u *RssSubscriptionUpsert) UpdateSeen() *RssSubscriptionUpsert {
+	u.SetExcluded(rsssubscription.FieldSeen)
+	return u
+}
+
+// ClearSeen clears the value of the "seen" field.
+func (u *RssSubscriptionUpsert) ClearSeen() *RssSubscriptionUpsert {
+	u.SetNull(rsssubscription.FieldSeen)
+	return u
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (u *RssSubscriptionUpsert) SetLastCheckedAt(v time.Time) *RssSubscriptionUpsert {
+	u.Set(rsssubscription.FieldLastCheckedAt, v)
+	return u
+}
+
+// UpdateLastCheckedAt sets the "last_checked_at" field to the value that was provided on create.
+func (u *RssSubscriptionUpsert) UpdateLastCheckedAt() *RssSubscriptionUpsert {
+	u.SetExcluded(rsssubscription.FieldLastCheckedAt)
+	return u
+}
+
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (u *RssSubscriptionUpsert) ClearLastCheckedAt() *RssSubscriptionUpsert {
+	u.SetNull(rsssubscription.FieldLastCheckedAt)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *RssSubscriptionUpsert) SetLastError(v string) *RssSubscriptionUpsert {
+	u.Set(rsssubscription.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *RssSubscriptionUpsert) UpdateLastError() *RssSubscriptionUpsert {
+	u.SetExcluded(rsssubscription.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *RssSubscriptionUpsert) ClearLastError() *RssSubscriptionUpsert {
+	u.SetNull(rsssubscription.FieldLastError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.RssSubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RssSubscriptionUpsertOne) UpdateNewValues() *RssSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(rsssubscription.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RssSubscription.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RssSubscriptionUpsertOne) Ignore() *RssSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RssSubscriptionUpsertOne) DoNothing() *RssSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RssSubscriptionCreate.OnConflict
+// documentation for more info.
+func (u *RssSubscriptionUpsertOne) Update(set func(*RssSubscriptionUpsert)) *RssSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RssSubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RssSubscriptionUpsertOne) SetUpdatedAt(v time.Time) *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertOne) UpdateUpdatedAt() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *RssSubscriptionUpsertOne) SetDeletedAt(v time.Time) *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertOne) UpdateDeletedAt() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *RssSubscriptionUpsertOne) ClearDeletedAt() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *RssSubscriptionUpsertOne) SetUserID(v int) *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertOne) UpdateUserID() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RssSubscriptionUpsertOne) SetName(v string) *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertOne) UpdateName() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *RssSubscriptionUpsertOne) SetURL(v string) *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertOne) UpdateURL() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetInclude sets the "include" field.
+func (u *RssSubscriptionUpsertOne) SetInclude(v string) *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetInclude(v)
+	})
+}
+
+// UpdateInclude sets the "include" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertOne) UpdateInclude() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateInclude()
+	})
+}
+
+// ClearInclude clears the value of the "include" field.
+func (u *RssSubscriptionUpsertOne) ClearInclude() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.ClearInclude()
+	})
+}
+
+// SetExclude sets the "exclude" field.
+func (u *RssSubscriptionUpsertOne) SetExclude(v string) *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetExclude(v)
+	})
+}
+
+// UpdateExclude sets the "exclude" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertOne) UpdateExclude() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateExclude()
+	})
+}
+
+// ClearExclude clears the value of the "exclude" field.
+func (u *RssSubscriptionUpsertOne) ClearExclude() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.ClearExclude()
+	})
+}
+
+// SetDst sets the "dst" field.
+func (u *RssSubscriptionUpsertOne) SetDst(v string) *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetDst(v)
+	})
+}
+
+// UpdateDst sets the "dst" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertOne) UpdateDst() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateDst()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RssSubscriptionUpsertOne) SetEnabled(v bool) *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertOne) UpdateEnabled() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetSeen sets the "seen" field.
+func (u *RssSubscriptionUpsertOne) SetSeen(v []string) *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetSeen(v)
+	})
+}
+
+// UpdateSeen sets the "seen" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertOne) UpdateSeen() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateSeen()
+	})
+}
+
+// ClearSeen clears the value of the "seen" field.
+func (u *RssSubscriptionUpsertOne) ClearSeen() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.ClearSeen()
+	})
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (u *RssSubscriptionUpsertOne) SetLastCheckedAt(v time.Time) *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetLastCheckedAt(v)
+	})
+}
+
+// UpdateLastCheckedAt sets the "last_checked_at" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertOne) UpdateLastCheckedAt() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateLastCheckedAt()
+	})
+}
+
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (u *RssSubscriptionUpsertOne) ClearLastCheckedAt() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.ClearLastCheckedAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *RssSubscriptionUpsertOne) SetLastError(v string) *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertOne) UpdateLastError() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *RssSubscriptionUpsertOne) ClearLastError() *RssSubscriptionUpsertOne {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *RssSubscriptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RssSubscriptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RssSubscriptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RssSubscriptionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RssSubscriptionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *RssSubscriptionCreate) SetRawID(t int) *RssSubscriptionCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// RssSubscriptionCreateBulk is the builder for creating many RssSubscription entities in bulk.
+type RssSubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*RssSubscriptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RssSubscription entities in the database.
+func (rscb *RssSubscriptionCreateBulk) Save(ctx context.Context) ([]*RssSubscription, error) {
+	if rscb.err != nil {
+		return nil, rscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rscb.builders))
+	nodes := make([]*RssSubscription, len(rscb.builders))
+	mutators := make([]Mutator, len(rscb.builders))
+	for i := range rscb.builders {
+		func(i int, root context.Context) {
+			builder := rscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RssSubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rscb *RssSubscriptionCreateBulk) SaveX(ctx context.Context) []*RssSubscription {
+	v, err := rscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rscb *RssSubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := rscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rscb *RssSubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := rscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RssSubscription.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RssSubscriptionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (rscb *RssSubscriptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *RssSubscriptionUpsertBulk {
+	rscb.conflict = opts
+	return &RssSubscriptionUpsertBulk{
+		create: rscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RssSubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rscb *RssSubscriptionCreateBulk) OnConflictColumns(columns ...string) *RssSubscriptionUpsertBulk {
+	rscb.conflict = append(rscb.conflict, sql.ConflictColumns(columns...))
+	return &RssSubscriptionUpsertBulk{
+		create: rscb,
+	}
+}
+
+// RssSubscriptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of RssSubscription nodes.
+type RssSubscriptionUpsertBulk struct {
+	create *RssSubscriptionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RssSubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RssSubscriptionUpsertBulk) UpdateNewValues() *RssSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(rsssubscription.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RssSubscription.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RssSubscriptionUpsertBulk) Ignore() *RssSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RssSubscriptionUpsertBulk) DoNothing() *RssSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RssSubscriptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *RssSubscriptionUpsertBulk) Update(set func(*RssSubscriptionUpsert)) *RssSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RssSubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RssSubscriptionUpsertBulk) SetUpdatedAt(v time.Time) *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertBulk) UpdateUpdatedAt() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *RssSubscriptionUpsertBulk) SetDeletedAt(v time.Time) *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertBulk) UpdateDeletedAt() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *RssSubscriptionUpsertBulk) ClearDeletedAt() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *RssSubscriptionUpsertBulk) SetUserID(v int) *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertBulk) UpdateUserID() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RssSubscriptionUpsertBulk) SetName(v string) *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertBulk) UpdateName() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *RssSubscriptionUpsertBulk) SetURL(v string) *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertBulk) UpdateURL() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetInclude sets the "include" field.
+func (u *RssSubscriptionUpsertBulk) SetInclude(v string) *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetInclude(v)
+	})
+}
+
+// UpdateInclude sets the "include" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertBulk) UpdateInclude() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateInclude()
+	})
+}
+
+// ClearInclude clears the value of the "include" field.
+func (u *RssSubscriptionUpsertBulk) ClearInclude() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.ClearInclude()
+	})
+}
+
+// SetExclude sets the "exclude" field.
+func (u *RssSubscriptionUpsertBulk) SetExclude(v string) *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetExclude(v)
+	})
+}
+
+// UpdateExclude sets the "exclude" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertBulk) UpdateExclude() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateExclude()
+	})
+}
+
+// ClearExclude clears the value of the "exclude" field.
+func (u *RssSubscriptionUpsertBulk) ClearExclude() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.ClearExclude()
+	})
+}
+
+// SetDst sets the "dst" field.
+func (u *RssSubscriptionUpsertBulk) SetDst(v string) *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetDst(v)
+	})
+}
+
+// UpdateDst sets the "dst" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertBulk) UpdateDst() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateDst()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RssSubscriptionUpsertBulk) SetEnabled(v bool) *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertBulk) UpdateEnabled() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetSeen sets the "seen" field.
+func (u *RssSubscriptionUpsertBulk) SetSeen(v []string) *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetSeen(v)
+	})
+}
+
+// UpdateSeen sets the "seen" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertBulk) UpdateSeen() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateSeen()
+	})
+}
+
+// ClearSeen clears the value of the "seen" field.
+func (u *RssSubscriptionUpsertBulk) ClearSeen() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.ClearSeen()
+	})
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (u *RssSubscriptionUpsertBulk) SetLastCheckedAt(v time.Time) *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetLastCheckedAt(v)
+	})
+}
+
+// UpdateLastCheckedAt sets the "last_checked_at" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertBulk) UpdateLastCheckedAt() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateLastCheckedAt()
+	})
+}
+
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (u *RssSubscriptionUpsertBulk) ClearLastCheckedAt() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.ClearLastCheckedAt()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *RssSubscriptionUpsertBulk) SetLastError(v string) *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *RssSubscriptionUpsertBulk) UpdateLastError() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *RssSubscriptionUpsertBulk) ClearLastError() *RssSubscriptionUpsertBulk {
+	return u.Update(func(s *RssSubscriptionUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// Exec executes the query.
+func (u *RssSubscriptionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RssSubscriptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RssSubscriptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RssSubscriptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/rsssubscription"
+)
+
+// RssSubscriptionDelete is the builder for deleting a RssSubscription entity.
+type RssSubscriptionDelete struct {
+	config
+	hooks    []Hook
+	mutation *RssSubscriptionMutation
+}
+
+// Where appends a list predicates to the RssSubscriptionDelete builder.
+func (rsd *RssSubscriptionDelete) Where(ps ...predicate.RssSubscription) *RssSubscriptionDelete {
+	rsd.mutation.Where(ps...)
+	return rsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rsd *RssSubscriptionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rsd.sqlExec, rsd.mutation, rsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsd *RssSubscriptionDelete) ExecX(ctx context.Context) int {
+	n, err := rsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rsd *RssSubscriptionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(rsssubscription.Table, sqlgraph.NewFieldSpec(rsssubscription.FieldID, field.TypeInt))
+	if ps := rsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rsd.mutation.done = true
+	return affected, err
+}
+
+// RssSubscriptionDeleteOne is the builder for deleting a single RssSubscription entity.
+type RssSubscriptionDeleteOne struct {
+	rsd *RssSubscriptionDelete
+}
+
+// Where appends a list predicates to the RssSubscriptionDelete builder.
+func (rsdo *RssSubscriptionDeleteOne) Where(ps ...predicate.RssSubscription) *RssSubscriptionDeleteOne {
+	rsdo.rsd.mutation.Where(ps...)
+	return rsdo
+}
+
+// Exec executes the deletion query.
+func (rsdo *RssSubscriptionDeleteOne) Exec(ctx context.Context) error {
+	n, err := rsdo.rsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{rsssubscription.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsdo *RssSubscriptionDeleteOne) ExecX(ctx context.Context) {
+	if err := rsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,810 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/rsssubscription"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// RssSubscriptionUpdate is the builder for updating RssSubscription entities.
+type RssSubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RssSubscriptionMutation
+}
+
+// Where appends a list predicates to the RssSubscriptionUpdate builder.
+func (rsu *RssSubscriptionUpdate) Where(ps ...predicate.RssSubscription) *RssSubscriptionUpdate {
+	rsu.mutation.Where(ps...)
+	return rsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rsu *RssSubscriptionUpdate) SetUpdatedAt(t time.Time) *RssSubscriptionUpdate {
+	rsu.mutation.SetUpdatedAt(t)
+	return rsu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rsu *RssSubscriptionUpdate) SetDeletedAt(t time.Time) *RssSubscriptionUpdate {
+	rsu.mutation.SetDeletedAt(t)
+	return rsu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rsu *RssSubscriptionUpdate) SetNillableDeletedAt(t *time.Time) *RssSubscriptionUpdate {
+	if t != nil {
+		rsu.SetDeletedAt(*t)
+	}
+	return rsu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (rsu *RssSubscriptionUpdate) ClearDeletedAt() *RssSubscriptionUpdate {
+	rsu.mutation.ClearDeletedAt()
+	return rsu
+}
+
+// SetUserID sets the "user_id" field.
+func (rsu *RssSubscriptionUpdate) SetUserID(i int) *RssSubscriptionUpdate {
+	rsu.mutation.SetUserID(i)
+	return rsu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rsu *RssSubscriptionUpdate) SetNillableUserID(i *int) *RssSubscriptionUpdate {
+	if i != nil {
+		rsu.SetUserID(*i)
+	}
+	return rsu
+}
+
+// SetName sets the "name" field.
+func (rsu *RssSubscriptionUpdate) SetName(s string) *RssSubscriptionUpdate {
+	rsu.mutation.SetName(s)
+	return rsu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (rsu *RssSubscriptionUpdate) SetNillableName(s *string) *RssSubscriptionUpdate {
+	if s != nil {
+		rsu.SetName(*s)
+	}
+	return rsu
+}
+
+// SetURL sets the "url" field.
+func (rsu *RssSubscriptionUpdate) SetURL(s string) *RssSubscriptionUpdate {
+	rsu.mutation.SetURL(s)
+	return rsu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (rsu *RssSubscriptionUpdate) SetNillableURL(s *string) *RssSubscriptionUpdate {
+	if s != nil {
+		rsu.SetURL(*s)
+	}
+	return rsu
+}
+
+// SetInclude sets the "include" field.
+func (rsu *RssSubscriptionUpdate) SetInclude(s string) *RssSubscriptionUpdate {
+	rsu.mutation.SetInclude(s)
+	return rsu
+}
+
+// SetNillableInclude sets the "include" field if the given value is not nil.
+func (rsu *RssSubscriptionUpdate) SetNillableInclude(s *string) *RssSubscriptionUpdate {
+	if s != nil {
+		rsu.SetInclude(*s)
+	}
+	return rsu
+}
+
+// ClearInclude clears the value of the "include" field.
+func (rsu *RssSubscriptionUpdate) ClearInclude() *RssSubscriptionUpdate {
+	rsu.mutation.ClearInclude()
+	return rsu
+}
+
+// SetExclude sets the "exclude" field.
+func (rsu *RssSubscriptionUpdate) SetExclude(s string) *RssSubscriptionUpdate {
+	rsu.mutation.SetExclude(s)
+	return rsu
+}
+
+// SetNillableExclude sets the "exclude" field if the given value is not nil.
+func (rsu *RssSubscriptionUpdate) SetNillableExclude(s *string) *RssSubscriptionUpdate {
+	if s != nil {
+		rsu.SetExclude(*s)
+	}
+	return rsu
+}
+
+// ClearExclude clears the value of the "exclude" field.
+func (rsu *RssSubscriptionUpdate) ClearExclude() *RssSubscriptionUpdate {
+	rsu.mutation.ClearExclude()
+	return rsu
+}
+
+// SetDst sets the "dst" field.
+func (rsu *RssSubscriptionUpdate) SetDst(s string) *RssSubscriptionUpdate {
+	rsu.mutation.SetDst(s)
+	return rsu
+}
+
+// SetNillableDst sets the "dst" field if the given value is not nil.
+func (rsu *RssSubscriptionUpdate) SetNillableDst(s *string) *RssSubscriptionUpdate {
+	if s != nil {
+		rsu.SetDst(*s)
+	}
+	return rsu
+}
+
+// SetEnabled sets the "enabled" field.
+func (rsu *RssSubscriptionUpdate) SetEnabled(b bool) *RssSubscriptionUpdate {
+	rsu.mutation.SetEnabled(b)
+	return rsu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (rsu *RssSubscriptionUpdate) SetNillableEnabled(b *bool) *RssSubscriptionUpdate {
+	if b != nil {
+		rsu.SetEnabled(*b)
+	}
+	return rsu
+}
+
+// SetSeen sets the "seen" field.
+func (rsu *RssSubscriptionUpdate) SetSeen(s []string) *RssSubscriptionUpdate {
+	rsu.mutation.SetSeen(s)
+	return rsu
+}
+
+// AppendSeen appends s to the "seen" field.
+func (rsu *RssSubscriptionUpdate) AppendSeen(s []string) *RssSubscriptionUpdate {
+	rsu.mutation.AppendSeen(s)
+	return rsu
+}
+
+// ClearSeen clears the value of the "seen" field.
+func (rsu *RssSubscriptionUpdate) ClearSeen() *RssSubscriptionUpdate {
+	rsu.mutation.ClearSeen()
+	return rsu
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (rsu *RssSubscriptionUpdate) SetLastCheckedAt(t time.Time) *RssSubscriptionUpdate {
+	rsu.mutation.SetLastCheckedAt(t)
+	return rsu
+}
+
+// SetNillableLastCheckedAt sets the "last_checked_at" field if the given value is not nil.
+func (rsu *RssSubscriptionUpdate) SetNillableLastCheckedAt(t *time.Time) *RssSubscriptionUpdate {
+	if t != nil {
+		rsu.SetLastCheckedAt(*t)
+	}
+	return rsu
+}
+
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (rsu *RssSubscriptionUpdate) ClearLastCheckedAt() *RssSubscriptionUpdate {
+	rsu.mutation.ClearLastCheckedAt()
+	return rsu
+}
+
+// SetLastError sets the "last_error" field.
+func (rsu *RssSubscriptionUpdate) SetLastError(s string) *RssSubscriptionUpdate {
+	rsu.mutation.SetLastError(s)
+	return rsu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (rsu *RssSubscriptionUpdate) SetNillableLastError(s *string) *RssSubscriptionUpdate {
+	if s != nil {
+		rsu.SetLastError(*s)
+	}
+	return rsu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (rsu *RssSubscriptionUpdate) ClearLastError() *RssSubscriptionUpdate {
+	rsu.mutation.ClearLastError()
+	return rsu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rsu *RssSubscriptionUpdate) SetUser(u *User) *RssSubscriptionUpdate {
+	return rsu.SetUserID(u.ID)
+}
+
+// Mutation returns the RssSubscriptionMutation object of the builder.
+func (rsu *RssSubscriptionUpdate) Mutation() *RssSubscriptionMutation {
+	return rsu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (rsu *RssSubscriptionUpdate) ClearUser() *RssSubscriptionUpdate {
+	rsu.mutation.ClearUser()
+	return rsu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rsu *RssSubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	if err := rsu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, rsu.sqlSave, rsu.mutation, rsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsu *RssSubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := rsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rsu *RssSubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := rsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsu *RssSubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := rsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rsu *RssSubscriptionUpdate) defaults() error {
+	if _, ok := rsu.mutation.UpdatedAt(); !ok {
+		if rsssubscription.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized rsssubscription.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := rsssubscription.UpdateDefaultUpdatedAt()
+		rsu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsu *RssSubscriptionUpdate) check() error {
+	if _, ok := rsu.mutation.UserID(); rsu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "RssSubscription.user"`)
+	}
+	return nil
+}
+
+func (rsu *RssSubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rsssubscription.Table, rsssubscription.Columns, sqlgraph.NewFieldSpec(rsssubscription.FieldID, field.TypeInt))
+	if ps := rsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(rsssubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rsu.mutation.DeletedAt(); ok {
+		_spec.SetField(rsssubscription.FieldDeletedAt, field.TypeTime, value)
+	}
+	if rsu.mutation.DeletedAtCleared() {
+		_spec.ClearField(rsssubscription.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := rsu.mutation.Name(); ok {
+		_spec.SetField(rsssubscription.FieldName, field.TypeString, value)
+	}
+	if value, ok := rsu.mutation.URL(); ok {
+		_spec.SetField(rsssubscription.FieldURL, field.TypeString, value)
+	}
+	if value, ok := rsu.mutation.Include(); ok {
+		_spec.SetField(rsssubscription.FieldInclude, field.TypeString, value)
+	}
+	if rsu.mutation.IncludeCleared() {
+		_spec.ClearField(rsssubscription.FieldInclude, field.TypeString)
+	}
+	if value, ok := rsu.mutation.Exclude(); ok {
+		_spec.SetField(rsssubscription.FieldExclude, field.TypeString, value)
+	}
+	if rsu.mutation.ExcludeCleared() {
+		_spec.ClearField(rsssubscription.FieldExclude, field.TypeString)
+	}
+	if value, ok := rsu.mutation.Dst(); ok {
+		_spec.SetField(rsssubscription.FieldDst, field.TypeString, value)
+	}
+	if value, ok := rsu.mutation.Enabled(); ok {
+		_spec.SetField(rsssubscription.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := rsu.mutation.Seen(); ok {
+		_spec.SetField(rsssubscription.FieldSeen, field.TypeJSON, value)
+	}
+	if value, ok := rsu.mutation.AppendedSeen(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, rsssubscription.FieldSeen, value)
+		})
+	}
+	if rsu.mutation.SeenCleared() {
+		_spec.ClearField(rsssubscription.FieldSeen, field.TypeJSON)
+	}
+	if value, ok := rsu.mutation.LastCheckedAt(); ok {
+		_spec.SetField(rsssubscription.FieldLastCheckedAt, field.TypeTime, value)
+	}
+	if rsu.mutation.LastCheckedAtCleared() {
+		_spec.ClearField(rsssubscription.FieldLastCheckedAt, field.TypeTime)
+	}
+	if value, ok := rsu.mutation.LastError(); ok {
+		_spec.SetField(rsssubscription.FieldLastError, field.TypeString, value)
+	}
+	if rsu.mutation.LastErrorCleared() {
+		_spec.ClearField(rsssubscription.FieldLastError, field.TypeString)
+	}
+	if rsu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rsssubscription.UserTable,
+			Columns: []string{rsssubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rsssubscription.UserTable,
+			Columns: []string{rsssubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rsssubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rsu.mutation.done = true
+	return n, nil
+}
+
+// RssSubscriptionUpdateOne is the builder for updating a single RssSubscription entity.
+type RssSubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RssSubscriptionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rsuo *RssSubscriptionUpdateOne) SetUpdatedAt(t time.Time) *RssSubscriptionUpdateOne {
+	rsuo.mutation.SetUpdatedAt(t)
+	return rsuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rsuo *RssSubscriptionUpdateOne) SetDeletedAt(t time.Time) *RssSubscriptionUpdateOne {
+	rsuo.mutation.SetDeletedAt(t)
+	return rsuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rsuo *RssSubscriptionUpdateOne) SetNillableDeletedAt(t *time.Time) *RssSubscriptionUpdateOne {
+	if t != nil {
+		rsuo.SetDeletedAt(*t)
+	}
+	return rsuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (rsuo *RssSubscriptionUpdateOne) ClearDeletedAt() *RssSubscriptionUpdateOne {
+	rsuo.mutation.ClearDeletedAt()
+	return rsuo
+}
+
+// SetUserID sets the "user_id" field.
+func (rsuo *RssSubscriptionUpdateOne) SetUserID(i int) *RssSubscriptionUpdateOne {
+	rsuo.mutation.SetUserID(i)
+	return rsuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rsuo *RssSubscriptionUpdateOne) SetNillableUserID(i *int) *RssSubscriptionUpdateOne {
+	if i != nil {
+		rsuo.SetUserID(*i)
+	}
+	return rsuo
+}
+
+// SetName sets the "name" field.
+func (rsuo *RssSubscriptionUpdateOne) SetName(s string) *RssSubscriptionUpdateOne {
+	rsuo.mutation.SetName(s)
+	return rsuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (rsuo *RssSubscriptionUpdateOne) SetNillableName(s *string) *RssSubscriptionUpdateOne {
+	if s != nil {
+		rsuo.SetName(*s)
+	}
+	return rsuo
+}
+
+// SetURL sets the "url" field.
+func (rsuo *RssSubscriptionUpdateOne) SetURL(s string) *RssSubscriptionUpdateOne {
+	rsuo.mutation.SetURL(s)
+	return rsuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (rsuo *RssSubscriptionUpdateOne) SetNillableURL(s *string) *RssSubscriptionUpdateOne {
+	if s != nil {
+		rsuo.SetURL(*s)
+	}
+	return rsuo
+}
+
+// SetInclude sets the "include" field.
+func (rsuo *RssSubscriptionUpdateOne) SetInclude(s string) *RssSubscriptionUpdateOne {
+	rsuo.mutation.SetInclude(s)
+	return rsuo
+}
+
+// SetNillableInclude sets the "include" field if the given value is not nil.
+func (rsuo *RssSubscriptionUpdateOne) SetNillableInclude(s *string) *RssSubscriptionUpdateOne {
+	if s != nil {
+		rsuo.SetInclude(*s)
+	}
+	return rsuo
+}
+
+// ClearInclude clears the value of the "include" field.
+func (rsuo *RssSubscriptionUpdateOne) ClearInclude() *RssSubscriptionUpdateOne {
+	rsuo.mutation.ClearInclude()
+	return rsuo
+}
+
+// SetExclude sets the "exclude" field.
+func (rsuo *RssSubscriptionUpdateOne) SetExclude(s string) *RssSubscriptionUpdateOne {
+	rsuo.mutation.SetExclude(s)
+	return rsuo
+}
+
+// SetNillableExclude sets the "exclude" field if the given value is not nil.
+func (rsuo *RssSubscriptionUpdateOne) SetNillableExclude(s *string) *RssSubscriptionUpdateOne {
+	if s != nil {
+		rsuo.SetExclude(*s)
+	}
+	return rsuo
+}
+
+// ClearExclude clears the value of the "exclude" field.
+func (rsuo *RssSubscriptionUpdateOne) ClearExclude() *RssSubscriptionUpdateOne {
+	rsuo.mutation.ClearExclude()
+	return rsuo
+}
+
+// SetDst sets the "dst" field.
+func (rsuo *RssSubscriptionUpdateOne) SetDst(s string) *RssSubscriptionUpdateOne {
+	rsuo.mutation.SetDst(s)
+	return rsuo
+}
+
+// SetNillableDst sets the "dst" field if the given value is not nil.
+func (rsuo *RssSubscriptionUpdateOne) SetNillableDst(s *string) *RssSubscriptionUpdateOne {
+	if s != nil {
+		rsuo.SetDst(*s)
+	}
+	return rsuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (rsuo *RssSubscriptionUpdateOne) SetEnabled(b bool) *RssSubscriptionUpdateOne {
+	rsuo.mutation.SetEnabled(b)
+	return rsuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (rsuo *RssSubscriptionUpdateOne) SetNillableEnabled(b *bool) *RssSubscriptionUpdateOne {
+	if b != nil {
+		rsuo.SetEnabled(*b)
+	}
+	return rsuo
+}
+
+// SetSeen sets the "seen" field.
+func (rsuo *RssSubscriptionUpdateOne) SetSeen(s []string) *RssSubscriptionUpdateOne {
+	rsuo.mutation.SetSeen(s)
+	return rsuo
+}
+
+// AppendSeen appends s to the "seen" field.
+func (rsuo *RssSubscriptionUpdateOne) AppendSeen(s []string) *RssSubscriptionUpdateOne {
+	rsuo.mutation.AppendSeen(s)
+	return rsuo
+}
+
+// ClearSeen clears the value of the "seen" field.
+func (rsuo *RssSubscriptionUpdateOne) ClearSeen() *RssSubscriptionUpdateOne {
+	rsuo.mutation.ClearSeen()
+	return rsuo
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (rsuo *RssSubscriptionUpdateOne) SetLastCheckedAt(t time.Time) *RssSubscriptionUpdateOne {
+	rsuo.mutation.SetLastCheckedAt(t)
+	return rsuo
+}
+
+// SetNillableLastCheckedAt sets the "last_checked_at" field if the given value is not nil.
+func (rsuo *RssSubscriptionUpdateOne) SetNillableLastCheckedAt(t *time.Time) *RssSubscriptionUpdateOne {
+	if t != nil {
+		rsuo.SetLastCheckedAt(*t)
+	}
+	return rsuo
+}
+
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (rsuo *RssSubscriptionUpdateOne) ClearLastCheckedAt() *RssSubscriptionUpdateOne {
+	rsuo.mutation.ClearLastCheckedAt()
+	return rsuo
+}
+
+// SetLastError sets the "last_error" field.
+func (rsuo *RssSubscriptionUpdateOne) SetLastError(s string) *RssSubscriptionUpdateOne {
+	rsuo.mutation.SetLastError(s)
+	return rsuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (rsuo *RssSubscriptionUpdateOne) SetNillableLastError(s *string) *RssSubscriptionUpdateOne {
+	if s != nil {
+		rsuo.SetLastError(*s)
+	}
+	return rsuo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (rsuo *RssSubscriptionUpdateOne) ClearLastError() *RssSubscriptionUpdateOne {
+	rsuo.mutation.ClearLastError()
+	return rsuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (rsuo *RssSubscriptionUpdateOne) SetUser(u *User) *RssSubscriptionUpdateOne {
+	return rsuo.SetUserID(u.ID)
+}
+
+// Mutation returns the RssSubscriptionMutation object of the builder.
+func (rsuo *RssSubscriptionUpdateOne) Mutation() *RssSubscriptionMutation {
+	return rsuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (rsuo *RssSubscriptionUpdateOne) ClearUser() *RssSubscriptionUpdateOne {
+	rsuo.mutation.ClearUser()
+	return rsuo
+}
+
+// Where appends a list predicates to the RssSubscriptionUpdate builder.
+func (rsuo *RssSubscriptionUpdateOne) Where(ps ...predicate.RssSubscription) *RssSubscriptionUpdateOne {
+	rsuo.mutation.Where(ps...)
+	return rsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rsuo *RssSubscriptionUpdateOne) Select(field string, fields ...string) *RssSubscriptionUpdateOne {
+	rsuo.fields = append([]string{field}, fields...)
+	return rsuo
+}
+
+// Save executes the query and returns the updated RssSubscription entity.
+func (rsuo *RssSubscriptionUpdateOne) Save(ctx context.Context) (*RssSubscription, error) {
+	if err := rsuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rsuo.sqlSave, rsuo.mutation, rsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsuo *RssSubscriptionUpdateOne) SaveX(ctx context.Context) *RssSubscription {
+	node, err := rsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rsuo *RssSubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := rsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsuo *RssSubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := rsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rsuo *RssSubscriptionUpdateOne) defaults() error {
+	if _, ok := rsuo.mutation.UpdatedAt(); !ok {
+		if rsssubscription.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized rsssubscription.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := rsssubscription.UpdateDefaultUpdatedAt()
+		rsuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsuo *RssSubscriptionUpdateOne) check() error {
+	if _, ok := rsuo.mutation.UserID(); rsuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "RssSubscription.user"`)
+	}
+	return nil
+}
+
+func (rsuo *RssSubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *RssSubscription, err error) {
+	if err := rsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rsssubscription.Table, rsssubscription.Columns, sqlgraph.NewFieldSpec(rsssubscription.FieldID, field.TypeInt))
+	id, ok := rsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RssSubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rsssubscription.FieldID)
+		for _, f := range fields {
+			if !rsssubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rsssubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(rsssubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rsuo.mutation.DeletedAt(); ok {
+		_spec.SetField(rsssubscription.FieldDeletedAt, field.TypeTime, value)
+	}
+	if rsuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(rsssubscription.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := rsuo.mutation.Name(); ok {
+		_spec.SetField(rsssubscription.FieldName, field.TypeString, value)
+	}
+	if value, ok := rsuo.mutation.URL(); ok {
+		_spec.SetField(rsssubscription.FieldURL, field.TypeString, value)
+	}
+	if value, ok := rsuo.mutation.Include(); ok {
+		_spec.SetField(rsssubscription.FieldInclude, field.TypeString, value)
+	}
+	if rsuo.mutation.IncludeCleared() {
+		_spec.ClearField(rsssubscription.FieldInclude, field.TypeString)
+	}
+	if value, ok := rsuo.mutation.Exclude(); ok {
+		_spec.SetField(rsssubscription.FieldExclude, field.TypeString, value)
+	}
+	if rsuo.mutation.ExcludeCleared() {
+		_spec.ClearField(rsssubscription.FieldExclude, field.TypeString)
+	}
+	if value, ok := rsuo.mutation.Dst(); ok {
+		_spec.SetField(rsssubscription.FieldDst, field.TypeString, value)
+	}
+	if value, ok := rsuo.mutation.Enabled(); ok {
+		_spec.SetField(rsssubscription.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := rsuo.mutation.Seen(); ok {
+		_spec.SetField(rsssubscription.FieldSeen, field.TypeJSON, value)
+	}
+	if value, ok := rsuo.mutation.AppendedSeen(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, rsssubscription.FieldSeen, value)
+		})
+	}
+	if rsuo.mutation.SeenCleared() {
+		_spec.ClearField(rsssubscription.FieldSeen, field.TypeJSON)
+	}
+	if value, ok := rsuo.mutation.LastCheckedAt(); ok {
+		_spec.SetField(rsssubscription.FieldLastCheckedAt, field.TypeTime, value)
+	}
+	if rsuo.mutation.LastCheckedAtCleared() {
+		_spec.ClearField(rsssubscription.FieldLastCheckedAt, field.TypeTime)
+	}
+	if value, ok := rsuo.mutation.LastError(); ok {
+		_spec.SetField(rsssubscription.FieldLastError, field.TypeString, value)
+	}
+	if rsuo.mutation.LastErrorCleared() {
+		_spec.ClearField(rsssubscription.FieldLastError, field.TypeString)
+	}
+	if rsuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rsssubscription.UserTable,
+			Columns: []string{rsssubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rsuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rsssubscription.UserTable,
+			Columns: []string{rsssubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RssSubscription{config: rsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rsssubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rsuo.mutation.done = true
+	return _node, nil
+}
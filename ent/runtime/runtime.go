@@ -5,20 +5,40 @@ package runtime
 import (
 	"time"
 
+	"github.com/cloudreve/Cloudreve/v4/ent/accesstoken"
+	"github.com/cloudreve/Cloudreve/v4/ent/announcement"
+	"github.com/cloudreve/Cloudreve/v4/ent/auditlog"
+	"github.com/cloudreve/Cloudreve/v4/ent/automationrule"
+	"github.com/cloudreve/Cloudreve/v4/ent/checkout"
+	"github.com/cloudreve/Cloudreve/v4/ent/dailyactiveuser"
+	"github.com/cloudreve/Cloudreve/v4/ent/dailystat"
 	"github.com/cloudreve/Cloudreve/v4/ent/davaccount"
 	"github.com/cloudreve/Cloudreve/v4/ent/directlink"
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
+	"github.com/cloudreve/Cloudreve/v4/ent/invitation"
 	"github.com/cloudreve/Cloudreve/v4/ent/metadata"
+	"github.com/cloudreve/Cloudreve/v4/ent/moderationcase"
 	"github.com/cloudreve/Cloudreve/v4/ent/node"
+	"github.com/cloudreve/Cloudreve/v4/ent/notification"
+	"github.com/cloudreve/Cloudreve/v4/ent/organization"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
+	"github.com/cloudreve/Cloudreve/v4/ent/retentionrule"
+	"github.com/cloudreve/Cloudreve/v4/ent/rsssubscription"
+	"github.com/cloudreve/Cloudreve/v4/ent/s3accesskey"
+	"github.com/cloudreve/Cloudreve/v4/ent/savedsearch"
 	"github.com/cloudreve/Cloudreve/v4/ent/schema"
 	"github.com/cloudreve/Cloudreve/v4/ent/setting"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
 	"github.com/cloudreve/Cloudreve/v4/ent/storagepolicy"
+	"github.com/cloudreve/Cloudreve/v4/ent/syncjob"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/useremail"
+	"github.com/cloudreve/Cloudreve/v4/ent/viewpreference"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhook"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhookdelivery"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 )
 
@@ -26,6 +46,171 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accesstokenMixin := schema.AccessToken{}.Mixin()
+	accesstokenMixinHooks0 := accesstokenMixin[0].Hooks()
+	accesstoken.Hooks[0] = accesstokenMixinHooks0[0]
+	accesstokenMixinInters0 := accesstokenMixin[0].Interceptors()
+	accesstoken.Interceptors[0] = accesstokenMixinInters0[0]
+	accesstokenMixinFields0 := accesstokenMixin[0].Fields()
+	_ = accesstokenMixinFields0
+	accesstokenFields := schema.AccessToken{}.Fields()
+	_ = accesstokenFields
+	// accesstokenDescCreatedAt is the schema descriptor for created_at field.
+	accesstokenDescCreatedAt := accesstokenMixinFields0[0].Descriptor()
+	// accesstoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accesstoken.DefaultCreatedAt = accesstokenDescCreatedAt.Default.(func() time.Time)
+	// accesstokenDescUpdatedAt is the schema descriptor for updated_at field.
+	accesstokenDescUpdatedAt := accesstokenMixinFields0[1].Descriptor()
+	// accesstoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	accesstoken.DefaultUpdatedAt = accesstokenDescUpdatedAt.Default.(func() time.Time)
+	// accesstoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	accesstoken.UpdateDefaultUpdatedAt = accesstokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	announcementMixin := schema.Announcement{}.Mixin()
+	announcementMixinHooks0 := announcementMixin[0].Hooks()
+	announcement.Hooks[0] = announcementMixinHooks0[0]
+	announcementMixinInters0 := announcementMixin[0].Interceptors()
+	announcement.Interceptors[0] = announcementMixinInters0[0]
+	announcementMixinFields0 := announcementMixin[0].Fields()
+	_ = announcementMixinFields0
+	announcementFields := schema.Announcement{}.Fields()
+	_ = announcementFields
+	// announcementDescCreatedAt is the schema descriptor for created_at field.
+	announcementDescCreatedAt := announcementMixinFields0[0].Descriptor()
+	// announcement.DefaultCreatedAt holds the default value on creation for the created_at field.
+	announcement.DefaultCreatedAt = announcementDescCreatedAt.Default.(func() time.Time)
+	// announcementDescUpdatedAt is the schema descriptor for updated_at field.
+	announcementDescUpdatedAt := announcementMixinFields0[1].Descriptor()
+	// announcement.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	announcement.DefaultUpdatedAt = announcementDescUpdatedAt.Default.(func() time.Time)
+	// announcement.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	announcement.UpdateDefaultUpdatedAt = announcementDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// announcementDescDismissible is the schema descriptor for dismissible field.
+	announcementDescDismissible := announcementFields[5].Descriptor()
+	// announcement.DefaultDismissible holds the default value on creation for the dismissible field.
+	announcement.DefaultDismissible = announcementDescDismissible.Default.(bool)
+	auditlogMixin := schema.AuditLog{}.Mixin()
+	auditlogMixinHooks0 := auditlogMixin[0].Hooks()
+	auditlog.Hooks[0] = auditlogMixinHooks0[0]
+	auditlogMixinInters0 := auditlogMixin[0].Interceptors()
+	auditlog.Interceptors[0] = auditlogMixinInters0[0]
+	auditlogMixinFields0 := auditlogMixin[0].Fields()
+	_ = auditlogMixinFields0
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogMixinFields0[0].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	// auditlogDescUpdatedAt is the schema descriptor for updated_at field.
+	auditlogDescUpdatedAt := auditlogMixinFields0[1].Descriptor()
+	// auditlog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	auditlog.DefaultUpdatedAt = auditlogDescUpdatedAt.Default.(func() time.Time)
+	// auditlog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	auditlog.UpdateDefaultUpdatedAt = auditlogDescUpdatedAt.UpdateDefault.(func() time.Time)
+	automationruleMixin := schema.AutomationRule{}.Mixin()
+	automationruleMixinHooks0 := automationruleMixin[0].Hooks()
+	automationrule.Hooks[0] = automationruleMixinHooks0[0]
+	automationruleMixinInters0 := automationruleMixin[0].Interceptors()
+	automationrule.Interceptors[0] = automationruleMixinInters0[0]
+	automationruleMixinFields0 := automationruleMixin[0].Fields()
+	_ = automationruleMixinFields0
+	automationruleFields := schema.AutomationRule{}.Fields()
+	_ = automationruleFields
+	// automationruleDescCreatedAt is the schema descriptor for created_at field.
+	automationruleDescCreatedAt := automationruleMixinFields0[0].Descriptor()
+	// automationrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	automationrule.DefaultCreatedAt = automationruleDescCreatedAt.Default.(func() time.Time)
+	// automationruleDescUpdatedAt is the schema descriptor for updated_at field.
+	automationruleDescUpdatedAt := automationruleMixinFields0[1].Descriptor()
+	// automationrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	automationrule.DefaultUpdatedAt = automationruleDescUpdatedAt.Default.(func() time.Time)
+	// automationrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	automationrule.UpdateDefaultUpdatedAt = automationruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// automationruleDescEnabled is the schema descriptor for enabled field.
+	automationruleDescEnabled := automationruleFields[5].Descriptor()
+	// automationrule.DefaultEnabled holds the default value on creation for the enabled field.
+	automationrule.DefaultEnabled = automationruleDescEnabled.Default.(bool)
+	checkoutMixin := schema.Checkout{}.Mixin()
+	checkoutMixinHooks0 := checkoutMixin[0].Hooks()
+	checkout.Hooks[0] = checkoutMixinHooks0[0]
+	checkoutMixinInters0 := checkoutMixin[0].Interceptors()
+	checkout.Interceptors[0] = checkoutMixinInters0[0]
+	checkoutMixinFields0 := checkoutMixin[0].Fields()
+	_ = checkoutMixinFields0
+	checkoutFields := schema.Checkout{}.Fields()
+	_ = checkoutFields
+	// checkoutDescCreatedAt is the schema descriptor for created_at field.
+	checkoutDescCreatedAt := checkoutMixinFields0[0].Descriptor()
+	// checkout.DefaultCreatedAt holds the default value on creation for the created_at field.
+	checkout.DefaultCreatedAt = checkoutDescCreatedAt.Default.(func() time.Time)
+	// checkoutDescUpdatedAt is the schema descriptor for updated_at field.
+	checkoutDescUpdatedAt := checkoutMixinFields0[1].Descriptor()
+	// checkout.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	checkout.DefaultUpdatedAt = checkoutDescUpdatedAt.Default.(func() time.Time)
+	// checkout.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	checkout.UpdateDefaultUpdatedAt = checkoutDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// checkoutDescZeroDepth is the schema descriptor for zero_depth field.
+	checkoutDescZeroDepth := checkoutFields[4].Descriptor()
+	// checkout.DefaultZeroDepth holds the default value on creation for the zero_depth field.
+	checkout.DefaultZeroDepth = checkoutDescZeroDepth.Default.(bool)
+	dailyactiveuserMixin := schema.DailyActiveUser{}.Mixin()
+	dailyactiveuserMixinHooks0 := dailyactiveuserMixin[0].Hooks()
+	dailyactiveuser.Hooks[0] = dailyactiveuserMixinHooks0[0]
+	dailyactiveuserMixinInters0 := dailyactiveuserMixin[0].Interceptors()
+	dailyactiveuser.Interceptors[0] = dailyactiveuserMixinInters0[0]
+	dailyactiveuserMixinFields0 := dailyactiveuserMixin[0].Fields()
+	_ = dailyactiveuserMixinFields0
+	dailyactiveuserFields := schema.DailyActiveUser{}.Fields()
+	_ = dailyactiveuserFields
+	// dailyactiveuserDescCreatedAt is the schema descriptor for created_at field.
+	dailyactiveuserDescCreatedAt := dailyactiveuserMixinFields0[0].Descriptor()
+	// dailyactiveuser.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dailyactiveuser.DefaultCreatedAt = dailyactiveuserDescCreatedAt.Default.(func() time.Time)
+	// dailyactiveuserDescUpdatedAt is the schema descriptor for updated_at field.
+	dailyactiveuserDescUpdatedAt := dailyactiveuserMixinFields0[1].Descriptor()
+	// dailyactiveuser.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dailyactiveuser.DefaultUpdatedAt = dailyactiveuserDescUpdatedAt.Default.(func() time.Time)
+	// dailyactiveuser.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dailyactiveuser.UpdateDefaultUpdatedAt = dailyactiveuserDescUpdatedAt.UpdateDefault.(func() time.Time)
+	dailystatMixin := schema.DailyStat{}.Mixin()
+	dailystatMixinHooks0 := dailystatMixin[0].Hooks()
+	dailystat.Hooks[0] = dailystatMixinHooks0[0]
+	dailystatMixinInters0 := dailystatMixin[0].Interceptors()
+	dailystat.Interceptors[0] = dailystatMixinInters0[0]
+	dailystatMixinFields0 := dailystatMixin[0].Fields()
+	_ = dailystatMixinFields0
+	dailystatFields := schema.DailyStat{}.Fields()
+	_ = dailystatFields
+	// dailystatDescCreatedAt is the schema descriptor for created_at field.
+	dailystatDescCreatedAt := dailystatMixinFields0[0].Descriptor()
+	// dailystat.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dailystat.DefaultCreatedAt = dailystatDescCreatedAt.Default.(func() time.Time)
+	// dailystatDescUpdatedAt is the schema descriptor for updated_at field.
+	dailystatDescUpdatedAt := dailystatMixinFields0[1].Descriptor()
+	// dailystat.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dailystat.DefaultUpdatedAt = dailystatDescUpdatedAt.Default.(func() time.Time)
+	// dailystat.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dailystat.UpdateDefaultUpdatedAt = dailystatDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// dailystatDescActiveUserCount is the schema descriptor for active_user_count field.
+	dailystatDescActiveUserCount := dailystatFields[1].Descriptor()
+	// dailystat.DefaultActiveUserCount holds the default value on creation for the active_user_count field.
+	dailystat.DefaultActiveUserCount = dailystatDescActiveUserCount.Default.(int)
+	// dailystatDescUploads is the schema descriptor for uploads field.
+	dailystatDescUploads := dailystatFields[2].Descriptor()
+	// dailystat.DefaultUploads holds the default value on creation for the uploads field.
+	dailystat.DefaultUploads = dailystatDescUploads.Default.(int)
+	// dailystatDescUploadSize is the schema descriptor for upload_size field.
+	dailystatDescUploadSize := dailystatFields[3].Descriptor()
+	// dailystat.DefaultUploadSize holds the default value on creation for the upload_size field.
+	dailystat.DefaultUploadSize = dailystatDescUploadSize.Default.(int64)
+	// dailystatDescDownloads is the schema descriptor for downloads field.
+	dailystatDescDownloads := dailystatFields[4].Descriptor()
+	// dailystat.DefaultDownloads holds the default value on creation for the downloads field.
+	dailystat.DefaultDownloads = dailystatDescDownloads.Default.(int)
+	// dailystatDescDownloadSize is the schema descriptor for download_size field.
+	dailystatDescDownloadSize := dailystatFields[5].Descriptor()
+	// dailystat.DefaultDownloadSize holds the default value on creation for the download_size field.
+	dailystat.DefaultDownloadSize = dailystatDescDownloadSize.Default.(int64)
 	davaccountMixin := schema.DavAccount{}.Mixin()
 	davaccountMixinHooks0 := davaccountMixin[0].Hooks()
 	davaccount.Hooks[0] = davaccountMixinHooks0[0]
@@ -137,6 +322,33 @@ func init() {
 	groupDescSettings := groupFields[4].Descriptor()
 	// group.DefaultSettings holds the default value on creation for the settings field.
 	group.DefaultSettings = groupDescSettings.Default.(*types.GroupSetting)
+	invitationMixin := schema.Invitation{}.Mixin()
+	invitationMixinHooks0 := invitationMixin[0].Hooks()
+	invitation.Hooks[0] = invitationMixinHooks0[0]
+	invitationMixinInters0 := invitationMixin[0].Interceptors()
+	invitation.Interceptors[0] = invitationMixinInters0[0]
+	invitationMixinFields0 := invitationMixin[0].Fields()
+	_ = invitationMixinFields0
+	invitationFields := schema.Invitation{}.Fields()
+	_ = invitationFields
+	// invitationDescCreatedAt is the schema descriptor for created_at field.
+	invitationDescCreatedAt := invitationMixinFields0[0].Descriptor()
+	// invitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invitation.DefaultCreatedAt = invitationDescCreatedAt.Default.(func() time.Time)
+	// invitationDescUpdatedAt is the schema descriptor for updated_at field.
+	invitationDescUpdatedAt := invitationMixinFields0[1].Descriptor()
+	// invitation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	invitation.DefaultUpdatedAt = invitationDescUpdatedAt.Default.(func() time.Time)
+	// invitation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	invitation.UpdateDefaultUpdatedAt = invitationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// invitationDescMaxUses is the schema descriptor for max_uses field.
+	invitationDescMaxUses := invitationFields[5].Descriptor()
+	// invitation.DefaultMaxUses holds the default value on creation for the max_uses field.
+	invitation.DefaultMaxUses = invitationDescMaxUses.Default.(int)
+	// invitationDescUses is the schema descriptor for uses field.
+	invitationDescUses := invitationFields[6].Descriptor()
+	// invitation.DefaultUses holds the default value on creation for the uses field.
+	invitation.DefaultUses = invitationDescUses.Default.(int)
 	metadataMixin := schema.Metadata{}.Mixin()
 	metadataMixinHooks0 := metadataMixin[0].Hooks()
 	metadata.Hooks[0] = metadataMixinHooks0[0]
@@ -160,6 +372,25 @@ func init() {
 	metadataDescIsPublic := metadataFields[3].Descriptor()
 	// metadata.DefaultIsPublic holds the default value on creation for the is_public field.
 	metadata.DefaultIsPublic = metadataDescIsPublic.Default.(bool)
+	moderationcaseMixin := schema.ModerationCase{}.Mixin()
+	moderationcaseMixinHooks0 := moderationcaseMixin[0].Hooks()
+	moderationcase.Hooks[0] = moderationcaseMixinHooks0[0]
+	moderationcaseMixinInters0 := moderationcaseMixin[0].Interceptors()
+	moderationcase.Interceptors[0] = moderationcaseMixinInters0[0]
+	moderationcaseMixinFields0 := moderationcaseMixin[0].Fields()
+	_ = moderationcaseMixinFields0
+	moderationcaseFields := schema.ModerationCase{}.Fields()
+	_ = moderationcaseFields
+	// moderationcaseDescCreatedAt is the schema descriptor for created_at field.
+	moderationcaseDescCreatedAt := moderationcaseMixinFields0[0].Descriptor()
+	// moderationcase.DefaultCreatedAt holds the default value on creation for the created_at field.
+	moderationcase.DefaultCreatedAt = moderationcaseDescCreatedAt.Default.(func() time.Time)
+	// moderationcaseDescUpdatedAt is the schema descriptor for updated_at field.
+	moderationcaseDescUpdatedAt := moderationcaseMixinFields0[1].Descriptor()
+	// moderationcase.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	moderationcase.DefaultUpdatedAt = moderationcaseDescUpdatedAt.Default.(func() time.Time)
+	// moderationcase.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	moderationcase.UpdateDefaultUpdatedAt = moderationcaseDescUpdatedAt.UpdateDefault.(func() time.Time)
 	nodeMixin := schema.Node{}.Mixin()
 	nodeMixinHooks0 := nodeMixin[0].Hooks()
 	node.Hooks[0] = nodeMixinHooks0[0]
@@ -187,6 +418,44 @@ func init() {
 	nodeDescWeight := nodeFields[7].Descriptor()
 	// node.DefaultWeight holds the default value on creation for the weight field.
 	node.DefaultWeight = nodeDescWeight.Default.(int)
+	notificationMixin := schema.Notification{}.Mixin()
+	notificationMixinHooks0 := notificationMixin[0].Hooks()
+	notification.Hooks[0] = notificationMixinHooks0[0]
+	notificationMixinInters0 := notificationMixin[0].Interceptors()
+	notification.Interceptors[0] = notificationMixinInters0[0]
+	notificationMixinFields0 := notificationMixin[0].Fields()
+	_ = notificationMixinFields0
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationMixinFields0[0].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	// notificationDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationDescUpdatedAt := notificationMixinFields0[1].Descriptor()
+	// notification.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notification.DefaultUpdatedAt = notificationDescUpdatedAt.Default.(func() time.Time)
+	// notification.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notification.UpdateDefaultUpdatedAt = notificationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	organizationMixin := schema.Organization{}.Mixin()
+	organizationMixinHooks0 := organizationMixin[0].Hooks()
+	organization.Hooks[0] = organizationMixinHooks0[0]
+	organizationMixinInters0 := organizationMixin[0].Interceptors()
+	organization.Interceptors[0] = organizationMixinInters0[0]
+	organizationMixinFields0 := organizationMixin[0].Fields()
+	_ = organizationMixinFields0
+	organizationFields := schema.Organization{}.Fields()
+	_ = organizationFields
+	// organizationDescCreatedAt is the schema descriptor for created_at field.
+	organizationDescCreatedAt := organizationMixinFields0[0].Descriptor()
+	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
+	// organizationDescUpdatedAt is the schema descriptor for updated_at field.
+	organizationDescUpdatedAt := organizationMixinFields0[1].Descriptor()
+	// organization.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	organization.DefaultUpdatedAt = organizationDescUpdatedAt.Default.(func() time.Time)
+	// organization.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	organization.UpdateDefaultUpdatedAt = organizationDescUpdatedAt.UpdateDefault.(func() time.Time)
 	passkeyMixin := schema.Passkey{}.Mixin()
 	passkeyMixinHooks0 := passkeyMixin[0].Hooks()
 	passkey.Hooks[0] = passkeyMixinHooks0[0]
@@ -206,6 +475,110 @@ func init() {
 	passkey.DefaultUpdatedAt = passkeyDescUpdatedAt.Default.(func() time.Time)
 	// passkey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	passkey.UpdateDefaultUpdatedAt = passkeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	retentionruleMixin := schema.RetentionRule{}.Mixin()
+	retentionruleMixinHooks0 := retentionruleMixin[0].Hooks()
+	retentionrule.Hooks[0] = retentionruleMixinHooks0[0]
+	retentionruleMixinInters0 := retentionruleMixin[0].Interceptors()
+	retentionrule.Interceptors[0] = retentionruleMixinInters0[0]
+	retentionruleMixinFields0 := retentionruleMixin[0].Fields()
+	_ = retentionruleMixinFields0
+	retentionruleFields := schema.RetentionRule{}.Fields()
+	_ = retentionruleFields
+	// retentionruleDescCreatedAt is the schema descriptor for created_at field.
+	retentionruleDescCreatedAt := retentionruleMixinFields0[0].Descriptor()
+	// retentionrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	retentionrule.DefaultCreatedAt = retentionruleDescCreatedAt.Default.(func() time.Time)
+	// retentionruleDescUpdatedAt is the schema descriptor for updated_at field.
+	retentionruleDescUpdatedAt := retentionruleMixinFields0[1].Descriptor()
+	// retentionrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	retentionrule.DefaultUpdatedAt = retentionruleDescUpdatedAt.Default.(func() time.Time)
+	// retentionrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	retentionrule.UpdateDefaultUpdatedAt = retentionruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// retentionruleDescRecursive is the schema descriptor for recursive field.
+	retentionruleDescRecursive := retentionruleFields[2].Descriptor()
+	// retentionrule.DefaultRecursive holds the default value on creation for the recursive field.
+	retentionrule.DefaultRecursive = retentionruleDescRecursive.Default.(bool)
+	// retentionruleDescMaxAgeDays is the schema descriptor for max_age_days field.
+	retentionruleDescMaxAgeDays := retentionruleFields[4].Descriptor()
+	// retentionrule.DefaultMaxAgeDays holds the default value on creation for the max_age_days field.
+	retentionrule.DefaultMaxAgeDays = retentionruleDescMaxAgeDays.Default.(int)
+	// retentionruleDescKeepVersions is the schema descriptor for keep_versions field.
+	retentionruleDescKeepVersions := retentionruleFields[5].Descriptor()
+	// retentionrule.DefaultKeepVersions holds the default value on creation for the keep_versions field.
+	retentionrule.DefaultKeepVersions = retentionruleDescKeepVersions.Default.(int)
+	// retentionruleDescEnabled is the schema descriptor for enabled field.
+	retentionruleDescEnabled := retentionruleFields[6].Descriptor()
+	// retentionrule.DefaultEnabled holds the default value on creation for the enabled field.
+	retentionrule.DefaultEnabled = retentionruleDescEnabled.Default.(bool)
+	rsssubscriptionMixin := schema.RssSubscription{}.Mixin()
+	rsssubscriptionMixinHooks0 := rsssubscriptionMixin[0].Hooks()
+	rsssubscription.Hooks[0] = rsssubscriptionMixinHooks0[0]
+	rsssubscriptionMixinInters0 := rsssubscriptionMixin[0].Interceptors()
+	rsssubscription.Interceptors[0] = rsssubscriptionMixinInters0[0]
+	rsssubscriptionMixinFields0 := rsssubscriptionMixin[0].Fields()
+	_ = rsssubscriptionMixinFields0
+	rsssubscriptionFields := schema.RssSubscription{}.Fields()
+	_ = rsssubscriptionFields
+	// rsssubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	rsssubscriptionDescCreatedAt := rsssubscriptionMixinFields0[0].Descriptor()
+	// rsssubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rsssubscription.DefaultCreatedAt = rsssubscriptionDescCreatedAt.Default.(func() time.Time)
+	// rsssubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	rsssubscriptionDescUpdatedAt := rsssubscriptionMixinFields0[1].Descriptor()
+	// rsssubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rsssubscription.DefaultUpdatedAt = rsssubscriptionDescUpdatedAt.Default.(func() time.Time)
+	// rsssubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rsssubscription.UpdateDefaultUpdatedAt = rsssubscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// rsssubscriptionDescEnabled is the schema descriptor for enabled field.
+	rsssubscriptionDescEnabled := rsssubscriptionFields[6].Descriptor()
+	// rsssubscription.DefaultEnabled holds the default value on creation for the enabled field.
+	rsssubscription.DefaultEnabled = rsssubscriptionDescEnabled.Default.(bool)
+	s3accesskeyMixin := schema.S3AccessKey{}.Mixin()
+	s3accesskeyMixinHooks0 := s3accesskeyMixin[0].Hooks()
+	s3accesskey.Hooks[0] = s3accesskeyMixinHooks0[0]
+	s3accesskeyMixinInters0 := s3accesskeyMixin[0].Interceptors()
+	s3accesskey.Interceptors[0] = s3accesskeyMixinInters0[0]
+	s3accesskeyMixinFields0 := s3accesskeyMixin[0].Fields()
+	_ = s3accesskeyMixinFields0
+	s3accesskeyFields := schema.S3AccessKey{}.Fields()
+	_ = s3accesskeyFields
+	// s3accesskeyDescCreatedAt is the schema descriptor for created_at field.
+	s3accesskeyDescCreatedAt := s3accesskeyMixinFields0[0].Descriptor()
+	// s3accesskey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	s3accesskey.DefaultCreatedAt = s3accesskeyDescCreatedAt.Default.(func() time.Time)
+	// s3accesskeyDescUpdatedAt is the schema descriptor for updated_at field.
+	s3accesskeyDescUpdatedAt := s3accesskeyMixinFields0[1].Descriptor()
+	// s3accesskey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	s3accesskey.DefaultUpdatedAt = s3accesskeyDescUpdatedAt.Default.(func() time.Time)
+	// s3accesskey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	s3accesskey.UpdateDefaultUpdatedAt = s3accesskeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// s3accesskeyDescReadOnly is the schema descriptor for read_only field.
+	s3accesskeyDescReadOnly := s3accesskeyFields[4].Descriptor()
+	// s3accesskey.DefaultReadOnly holds the default value on creation for the read_only field.
+	s3accesskey.DefaultReadOnly = s3accesskeyDescReadOnly.Default.(bool)
+	savedsearchMixin := schema.SavedSearch{}.Mixin()
+	savedsearchMixinHooks0 := savedsearchMixin[0].Hooks()
+	savedsearch.Hooks[0] = savedsearchMixinHooks0[0]
+	savedsearchMixinInters0 := savedsearchMixin[0].Interceptors()
+	savedsearch.Interceptors[0] = savedsearchMixinInters0[0]
+	savedsearchMixinFields0 := savedsearchMixin[0].Fields()
+	_ = savedsearchMixinFields0
+	savedsearchFields := schema.SavedSearch{}.Fields()
+	_ = savedsearchFields
+	// savedsearchDescCreatedAt is the schema descriptor for created_at field.
+	savedsearchDescCreatedAt := savedsearchMixinFields0[0].Descriptor()
+	// savedsearch.DefaultCreatedAt holds the default value on creation for the created_at field.
+	savedsearch.DefaultCreatedAt = savedsearchDescCreatedAt.Default.(func() time.Time)
+	// savedsearchDescUpdatedAt is the schema descriptor for updated_at field.
+	savedsearchDescUpdatedAt := savedsearchMixinFields0[1].Descriptor()
+	// savedsearch.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	savedsearch.DefaultUpdatedAt = savedsearchDescUpdatedAt.Default.(func() time.Time)
+	// savedsearch.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	savedsearch.UpdateDefaultUpdatedAt = savedsearchDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// savedsearchDescNotify is the schema descriptor for notify field.
+	savedsearchDescNotify := savedsearchFields[3].Descriptor()
+	// savedsearch.DefaultNotify holds the default value on creation for the notify field.
+	savedsearch.DefaultNotify = savedsearchDescNotify.Default.(bool)
 	settingMixin := schema.Setting{}.Mixin()
 	settingMixinHooks0 := settingMixin[0].Hooks()
 	setting.Hooks[0] = settingMixinHooks0[0]
@@ -252,6 +625,10 @@ func init() {
 	shareDescDownloads := shareFields[2].Descriptor()
 	// share.DefaultDownloads holds the default value on creation for the downloads field.
 	share.DefaultDownloads = shareDescDownloads.Default.(int)
+	// shareDescQuarantined is the schema descriptor for quarantined field.
+	shareDescQuarantined := shareFields[5].Descriptor()
+	// share.DefaultQuarantined holds the default value on creation for the quarantined field.
+	share.DefaultQuarantined = shareDescQuarantined.Default.(bool)
 	storagepolicyMixin := schema.StoragePolicy{}.Mixin()
 	storagepolicyMixinHooks0 := storagepolicyMixin[0].Hooks()
 	storagepolicy.Hooks[0] = storagepolicyMixinHooks0[0]
@@ -275,6 +652,33 @@ func init() {
 	storagepolicyDescSettings := storagepolicyFields[10].Descriptor()
 	// storagepolicy.DefaultSettings holds the default value on creation for the settings field.
 	storagepolicy.DefaultSettings = storagepolicyDescSettings.Default.(*types.PolicySetting)
+	syncjobMixin := schema.SyncJob{}.Mixin()
+	syncjobMixinHooks0 := syncjobMixin[0].Hooks()
+	syncjob.Hooks[0] = syncjobMixinHooks0[0]
+	syncjobMixinInters0 := syncjobMixin[0].Interceptors()
+	syncjob.Interceptors[0] = syncjobMixinInters0[0]
+	syncjobMixinFields0 := syncjobMixin[0].Fields()
+	_ = syncjobMixinFields0
+	syncjobFields := schema.SyncJob{}.Fields()
+	_ = syncjobFields
+	// syncjobDescCreatedAt is the schema descriptor for created_at field.
+	syncjobDescCreatedAt := syncjobMixinFields0[0].Descriptor()
+	// syncjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	syncjob.DefaultCreatedAt = syncjobDescCreatedAt.Default.(func() time.Time)
+	// syncjobDescUpdatedAt is the schema descriptor for updated_at field.
+	syncjobDescUpdatedAt := syncjobMixinFields0[1].Descriptor()
+	// syncjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	syncjob.DefaultUpdatedAt = syncjobDescUpdatedAt.Default.(func() time.Time)
+	// syncjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	syncjob.UpdateDefaultUpdatedAt = syncjobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// syncjobDescPropagateDelete is the schema descriptor for propagate_delete field.
+	syncjobDescPropagateDelete := syncjobFields[6].Descriptor()
+	// syncjob.DefaultPropagateDelete holds the default value on creation for the propagate_delete field.
+	syncjob.DefaultPropagateDelete = syncjobDescPropagateDelete.Default.(bool)
+	// syncjobDescEnabled is the schema descriptor for enabled field.
+	syncjobDescEnabled := syncjobFields[7].Descriptor()
+	// syncjob.DefaultEnabled holds the default value on creation for the enabled field.
+	syncjob.DefaultEnabled = syncjobDescEnabled.Default.(bool)
 	taskMixin := schema.Task{}.Mixin()
 	taskMixinHooks0 := taskMixin[0].Hooks()
 	task.Hooks[0] = taskMixinHooks0[0]
@@ -329,6 +733,122 @@ func init() {
 	userDescSettings := userFields[7].Descriptor()
 	// user.DefaultSettings holds the default value on creation for the settings field.
 	user.DefaultSettings = userDescSettings.Default.(*types.UserSetting)
+	useremailMixin := schema.UserEmail{}.Mixin()
+	useremailMixinHooks0 := useremailMixin[0].Hooks()
+	useremail.Hooks[0] = useremailMixinHooks0[0]
+	useremailMixinInters0 := useremailMixin[0].Interceptors()
+	useremail.Interceptors[0] = useremailMixinInters0[0]
+	useremailMixinFields0 := useremailMixin[0].Fields()
+	_ = useremailMixinFields0
+	useremailFields := schema.UserEmail{}.Fields()
+	_ = useremailFields
+	// useremailDescCreatedAt is the schema descriptor for created_at field.
+	useremailDescCreatedAt := useremailMixinFields0[0].Descriptor()
+	// useremail.DefaultCreatedAt holds the default value on creation for the created_at field.
+	useremail.DefaultCreatedAt = useremailDescCreatedAt.Default.(func() time.Time)
+	// useremailDescUpdatedAt is the schema descriptor for updated_at field.
+	useremailDescUpdatedAt := useremailMixinFields0[1].Descriptor()
+	// useremail.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	useremail.DefaultUpdatedAt = useremailDescUpdatedAt.Default.(func() time.Time)
+	// useremail.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	useremail.UpdateDefaultUpdatedAt = useremailDescUpdatedAt.UpdateDefault.(func() time.Time)
+	viewpreferenceMixin := schema.ViewPreference{}.Mixin()
+	viewpreferenceMixinHooks0 := viewpreferenceMixin[0].Hooks()
+	viewpreference.Hooks[0] = viewpreferenceMixinHooks0[0]
+	viewpreferenceMixinInters0 := viewpreferenceMixin[0].Interceptors()
+	viewpreference.Interceptors[0] = viewpreferenceMixinInters0[0]
+	viewpreferenceMixinFields0 := viewpreferenceMixin[0].Fields()
+	_ = viewpreferenceMixinFields0
+	viewpreferenceFields := schema.ViewPreference{}.Fields()
+	_ = viewpreferenceFields
+	// viewpreferenceDescCreatedAt is the schema descriptor for created_at field.
+	viewpreferenceDescCreatedAt := viewpreferenceMixinFields0[0].Descriptor()
+	// viewpreference.DefaultCreatedAt holds the default value on creation for the created_at field.
+	viewpreference.DefaultCreatedAt = viewpreferenceDescCreatedAt.Default.(func() time.Time)
+	// viewpreferenceDescUpdatedAt is the schema descriptor for updated_at field.
+	viewpreferenceDescUpdatedAt := viewpreferenceMixinFields0[1].Descriptor()
+	// viewpreference.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	viewpreference.DefaultUpdatedAt = viewpreferenceDescUpdatedAt.Default.(func() time.Time)
+	// viewpreference.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	viewpreference.UpdateDefaultUpdatedAt = viewpreferenceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// viewpreferenceDescFolderPath is the schema descriptor for folder_path field.
+	viewpreferenceDescFolderPath := viewpreferenceFields[0].Descriptor()
+	// viewpreference.FolderPathValidator is a validator for the "folder_path" field. It is called by the builders before save.
+	viewpreference.FolderPathValidator = viewpreferenceDescFolderPath.Validators[0].(func(string) error)
+	// viewpreferenceDescLayout is the schema descriptor for layout field.
+	viewpreferenceDescLayout := viewpreferenceFields[1].Descriptor()
+	// viewpreference.DefaultLayout holds the default value on creation for the layout field.
+	viewpreference.DefaultLayout = viewpreferenceDescLayout.Default.(string)
+	// viewpreferenceDescShowThumb is the schema descriptor for show_thumb field.
+	viewpreferenceDescShowThumb := viewpreferenceFields[2].Descriptor()
+	// viewpreference.DefaultShowThumb holds the default value on creation for the show_thumb field.
+	viewpreference.DefaultShowThumb = viewpreferenceDescShowThumb.Default.(bool)
+	// viewpreferenceDescSortBy is the schema descriptor for sort_by field.
+	viewpreferenceDescSortBy := viewpreferenceFields[3].Descriptor()
+	// viewpreference.DefaultSortBy holds the default value on creation for the sort_by field.
+	viewpreference.DefaultSortBy = viewpreferenceDescSortBy.Default.(string)
+	// viewpreferenceDescSortDirection is the schema descriptor for sort_direction field.
+	viewpreferenceDescSortDirection := viewpreferenceFields[4].Descriptor()
+	// viewpreference.DefaultSortDirection holds the default value on creation for the sort_direction field.
+	viewpreference.DefaultSortDirection = viewpreferenceDescSortDirection.Default.(string)
+	// viewpreferenceDescPageSize is the schema descriptor for page_size field.
+	viewpreferenceDescPageSize := viewpreferenceFields[5].Descriptor()
+	// viewpreference.DefaultPageSize holds the default value on creation for the page_size field.
+	viewpreference.DefaultPageSize = viewpreferenceDescPageSize.Default.(int)
+	// viewpreferenceDescGalleryWidth is the schema descriptor for gallery_width field.
+	viewpreferenceDescGalleryWidth := viewpreferenceFields[6].Descriptor()
+	// viewpreference.DefaultGalleryWidth holds the default value on creation for the gallery_width field.
+	viewpreference.DefaultGalleryWidth = viewpreferenceDescGalleryWidth.Default.(int)
+	// viewpreferenceDescListColumns is the schema descriptor for list_columns field.
+	viewpreferenceDescListColumns := viewpreferenceFields[7].Descriptor()
+	// viewpreference.DefaultListColumns holds the default value on creation for the list_columns field.
+	viewpreference.DefaultListColumns = viewpreferenceDescListColumns.Default.(string)
+	webhookMixin := schema.Webhook{}.Mixin()
+	webhookMixinHooks0 := webhookMixin[0].Hooks()
+	webhook.Hooks[0] = webhookMixinHooks0[0]
+	webhookMixinInters0 := webhookMixin[0].Interceptors()
+	webhook.Interceptors[0] = webhookMixinInters0[0]
+	webhookMixinFields0 := webhookMixin[0].Fields()
+	_ = webhookMixinFields0
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookMixinFields0[0].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookMixinFields0[1].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookDescEnabled is the schema descriptor for enabled field.
+	webhookDescEnabled := webhookFields[4].Descriptor()
+	// webhook.DefaultEnabled holds the default value on creation for the enabled field.
+	webhook.DefaultEnabled = webhookDescEnabled.Default.(bool)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinHooks0 := webhookdeliveryMixin[0].Hooks()
+	webhookdelivery.Hooks[0] = webhookdeliveryMixinHooks0[0]
+	webhookdeliveryMixinInters0 := webhookdeliveryMixin[0].Interceptors()
+	webhookdelivery.Interceptors[0] = webhookdeliveryMixinInters0[0]
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryMixinFields0[1].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
 }
 
 const (
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/s3accesskey"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// S3AccessKey is the model entity for the S3AccessKey schema.
+type S3AccessKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Public part of the key used for lookup
+	AccessKeyID string `json:"access_key_id,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
+	// ReadOnly holds the value of the "read_only" field.
+	ReadOnly bool `json:"read_only,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the S3AccessKeyQuery when eager-loading is set.
+	Edges        S3AccessKeyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// S3AccessKeyEdges holds the relations/edges for other nodes in the graph.
+type S3AccessKeyEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e S3AccessKeyEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*S3AccessKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case s3accesskey.FieldReadOnly:
+			values[i] = new(sql.NullBool)
+		case s3accesskey.FieldID, s3accesskey.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case s3accesskey.FieldName, s3accesskey.FieldAccessKeyID, s3accesskey.FieldSecret:
+			values[i] = new(sql.NullString)
+		case s3accesskey.FieldCreatedAt, s3accesskey.FieldUpdatedAt, s3accesskey.FieldDeletedAt, s3accesskey.FieldLastUsedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the S3AccessKey fields.
+func (sk *S3AccessKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case s3accesskey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sk.ID = int(value.Int64)
+		case s3accesskey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sk.CreatedAt = value.Time
+			}
+		case s3accesskey.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sk.UpdatedAt = value.Time
+			}
+		case s3accesskey.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				sk.DeletedAt = new(time.Time)
+				*sk.DeletedAt = value.Time
+			}
+		case s3accesskey.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				sk.UserID = int(value.Int64)
+			}
+		case s3accesskey.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sk.Name = value.String
+			}
+		case s3accesskey.FieldAccessKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_id", values[i])
+			} else if value.Valid {
+				sk.AccessKeyID = value.String
+			}
+		case s3accesskey.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				sk.Secret = value.String
+			}
+		case s3accesskey.FieldReadOnly:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field read_only", values[i])
+			} else if value.Valid {
+				sk.ReadOnly = value.Bool
+			}
+		case s3accesskey.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				sk.LastUsedAt = new(time.Time)
+				*sk.LastUsedAt = value.Time
+			}
+		default:
+			sk.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the S3AccessKey.
+// This includes values selected through modifiers, order, etc.
+func (sk *S3AccessKey) Value(name string) (ent.Value, error) {
+	return sk.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the S3AccessKey entity.
+func (sk *S3AccessKey) QueryUser() *UserQuery {
+	return NewS3AccessKeyClient(sk.config).QueryUser(sk)
+}
+
+// Update returns a builder for updating this S3AccessKey.
+// Note that you need to call S3AccessKey.Unwrap() before calling this method if this S3AccessKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sk *S3AccessKey) Update() *S3AccessKeyUpdateOne {
+	return NewS3AccessKeyClient(sk.config).UpdateOne(sk)
+}
+
+// Unwrap unwraps the S3AccessKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sk *S3AccessKey) Unwrap() *S3AccessKey {
+	_tx, ok := sk.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: S3AccessKey is not a transactional entity")
+	}
+	sk.config.driver = _tx.drv
+	return sk
+}
+
+// String implements the fmt.Stringer.
+func (sk *S3AccessKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("S3AccessKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sk.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sk.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sk.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := sk.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", sk.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(sk.Name)
+	builder.WriteString(", ")
+	builder.WriteString("access_key_id=")
+	builder.WriteString(sk.AccessKeyID)
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("read_only=")
+	builder.WriteString(fmt.Sprintf("%v", sk.ReadOnly))
+	builder.WriteString(", ")
+	if v := sk.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetUser manually set the edge as loaded state.
+func (e *S3AccessKey) SetUser(v *User) {
+	e.Edges.User = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// S3AccessKeys is a parsable slice of S3AccessKey.
+type S3AccessKeys []*S3AccessKey
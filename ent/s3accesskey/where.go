@@ -0,0 +1,544 @@
+// Code generated by ent, DO NOT EDIT.
+
+package s3accesskey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldName, v))
+}
+
+// AccessKeyID applies equality check predicate on the "access_key_id" field. It's identical to AccessKeyIDEQ.
+func AccessKeyID(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldAccessKeyID, v))
+}
+
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldSecret, v))
+}
+
+// ReadOnly applies equality check predicate on the "read_only" field. It's identical to ReadOnlyEQ.
+func ReadOnly(v bool) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldReadOnly, v))
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldContainsFold(FieldName, v))
+}
+
+// AccessKeyIDEQ applies the EQ predicate on the "access_key_id" field.
+func AccessKeyIDEQ(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDNEQ applies the NEQ predicate on the "access_key_id" field.
+func AccessKeyIDNEQ(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNEQ(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDIn applies the In predicate on the "access_key_id" field.
+func AccessKeyIDIn(vs ...string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldIn(FieldAccessKeyID, vs...))
+}
+
+// AccessKeyIDNotIn applies the NotIn predicate on the "access_key_id" field.
+func AccessKeyIDNotIn(vs ...string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNotIn(FieldAccessKeyID, vs...))
+}
+
+// AccessKeyIDGT applies the GT predicate on the "access_key_id" field.
+func AccessKeyIDGT(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldGT(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDGTE applies the GTE predicate on the "access_key_id" field.
+func AccessKeyIDGTE(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldGTE(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDLT applies the LT predicate on the "access_key_id" field.
+func AccessKeyIDLT(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldLT(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDLTE applies the LTE predicate on the "access_key_id" field.
+func AccessKeyIDLTE(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldLTE(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDContains applies the Contains predicate on the "access_key_id" field.
+func AccessKeyIDContains(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldContains(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDHasPrefix applies the HasPrefix predicate on the "access_key_id" field.
+func AccessKeyIDHasPrefix(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldHasPrefix(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDHasSuffix applies the HasSuffix predicate on the "access_key_id" field.
+func AccessKeyIDHasSuffix(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldHasSuffix(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDEqualFold applies the EqualFold predicate on the "access_key_id" field.
+func AccessKeyIDEqualFold(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEqualFold(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDContainsFold applies the ContainsFold predicate on the "access_key_id" field.
+func AccessKeyIDContainsFold(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldContainsFold(FieldAccessKeyID, v))
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNEQ(FieldSecret, v))
+}
+
+// SecretIn applies the In predicate on the "secret" field.
+func SecretIn(vs ...string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldIn(FieldSecret, vs...))
+}
+
+// SecretNotIn applies the NotIn predicate on the "secret" field.
+func SecretNotIn(vs ...string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNotIn(FieldSecret, vs...))
+}
+
+// SecretGT applies the GT predicate on the "secret" field.
+func SecretGT(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldGT(FieldSecret, v))
+}
+
+// SecretGTE applies the GTE predicate on the "secret" field.
+func SecretGTE(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldGTE(FieldSecret, v))
+}
+
+// SecretLT applies the LT predicate on the "secret" field.
+func SecretLT(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldLT(FieldSecret, v))
+}
+
+// SecretLTE applies the LTE predicate on the "secret" field.
+func SecretLTE(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldLTE(FieldSecret, v))
+}
+
+// SecretContains applies the Contains predicate on the "secret" field.
+func SecretContains(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldContains(FieldSecret, v))
+}
+
+// SecretHasPrefix applies the HasPrefix predicate on the "secret" field.
+func SecretHasPrefix(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldHasPrefix(FieldSecret, v))
+}
+
+// SecretHasSuffix applies the HasSuffix predicate on the "secret" field.
+func SecretHasSuffix(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldHasSuffix(FieldSecret, v))
+}
+
+// SecretEqualFold applies the EqualFold predicate on the "secret" field.
+func SecretEqualFold(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEqualFold(FieldSecret, v))
+}
+
+// SecretContainsFold applies the ContainsFold predicate on the "secret" field.
+func SecretContainsFold(v string) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldContainsFold(FieldSecret, v))
+}
+
+// ReadOnlyEQ applies the EQ predicate on the "read_only" field.
+func ReadOnlyEQ(v bool) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldReadOnly, v))
+}
+
+// ReadOnlyNEQ applies the NEQ predicate on the "read_only" field.
+func ReadOnlyNEQ(v bool) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNEQ(FieldReadOnly, v))
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNotIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldGT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldGTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldLT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldLTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "last_used_at" field.
+func LastUsedAtIsNil() predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldIsNull(FieldLastUsedAt))
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "last_used_at" field.
+func LastUsedAtNotNil() predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.FieldNotNull(FieldLastUsedAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.S3AccessKey {
+	return predicate.S3AccessKey(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.S3AccessKey {
+	return predicate.S3AccessKey(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.S3AccessKey) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.S3AccessKey) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.S3AccessKey) predicate.S3AccessKey {
+	return predicate.S3AccessKey(sql.NotPredicates(p))
+}
@@ -0,0 +1,986 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/s3accesskey"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// S3AccessKeyCreate is the builder for creating a S3AccessKey entity.
+type S3AccessKeyCreate struct {
+	config
+	mutation *S3AccessKeyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (skc *S3AccessKeyCreate) SetCreatedAt(t time.Time) *S3AccessKeyCreate {
+	skc.mutation.SetCreatedAt(t)
+	return skc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (skc *S3AccessKeyCreate) SetNillableCreatedAt(t *time.Time) *S3AccessKeyCreate {
+	if t != nil {
+		skc.SetCreatedAt(*t)
+	}
+	return skc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (skc *S3AccessKeyCreate) SetUpdatedAt(t time.Time) *S3AccessKeyCreate {
+	skc.mutation.SetUpdatedAt(t)
+	return skc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (skc *S3AccessKeyCreate) SetNillableUpdatedAt(t *time.Time) *S3AccessKeyCreate {
+	if t != nil {
+		skc.SetUpdatedAt(*t)
+	}
+	return skc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (skc *S3AccessKeyCreate) SetDeletedAt(t time.Time) *S3AccessKeyCreate {
+	skc.mutation.SetDeletedAt(t)
+	return skc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (skc *S3AccessKeyCreate) SetNillableDeletedAt(t *time.Time) *S3AccessKeyCreate {
+	if t != nil {
+		skc.SetDeletedAt(*t)
+	}
+	return skc
+}
+
+// SetUserID sets the "user_id" field.
+func (skc *S3AccessKeyCreate) SetUserID(i int) *S3AccessKeyCreate {
+	skc.mutation.SetUserID(i)
+	return skc
+}
+
+// SetName sets the "name" field.
+func (skc *S3AccessKeyCreate) SetName(s string) *S3AccessKeyCreate {
+	skc.mutation.SetName(s)
+	return skc
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (skc *S3AccessKeyCreate) SetAccessKeyID(s string) *S3AccessKeyCreate {
+	skc.mutation.SetAccessKeyID(s)
+	return skc
+}
+
+// SetSecret sets the "secret" field.
+func (skc *S3AccessKeyCreate) SetSecret(s string) *S3AccessKeyCreate {
+	skc.mutation.SetSecret(s)
+	return skc
+}
+
+// SetReadOnly sets the "read_only" field.
+func (skc *S3AccessKeyCreate) SetReadOnly(b bool) *S3AccessKeyCreate {
+	skc.mutation.SetReadOnly(b)
+	return skc
+}
+
+// SetNillableReadOnly sets the "read_only" field if the given value is not nil.
+func (skc *S3AccessKeyCreate) SetNillableReadOnly(b *bool) *S3AccessKeyCreate {
+	if b != nil {
+		skc.SetReadOnly(*b)
+	}
+	return skc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (skc *S3AccessKeyCreate) SetLastUsedAt(t time.Time) *S3AccessKeyCreate {
+	skc.mutation.SetLastUsedAt(t)
+	return skc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (skc *S3AccessKeyCreate) SetNillableLastUsedAt(t *time.Time) *S3AccessKeyCreate {
+	if t != nil {
+		skc.SetLastUsedAt(*t)
+	}
+	return skc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (skc *S3AccessKeyCreate) SetUser(u *User) *S3AccessKeyCreate {
+	return skc.SetUserID(u.ID)
+}
+
+// Mutation returns the S3AccessKeyMutation object of the builder.
+func (skc *S3AccessKeyCreate) Mutation() *S3AccessKeyMutation {
+	return skc.mutation
+}
+
+// Save creates the S3AccessKey in the database.
+func (skc *S3AccessKeyCreate) Save(ctx context.Context) (*S3AccessKey, error) {
+	if err := skc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, skc.sqlSave, skc.mutation, skc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (skc *S3AccessKeyCreate) SaveX(ctx context.Context) *S3AccessKey {
+	v, err := skc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skc *S3AccessKeyCreate) Exec(ctx context.Context) error {
+	_, err := skc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skc *S3AccessKeyCreate) ExecX(ctx context.Context) {
+	if err := skc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (skc *S3AccessKeyCreate) defaults() error {
+	if _, ok := skc.mutation.CreatedAt(); !ok {
+		if s3accesskey.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized s3accesskey.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := s3accesskey.DefaultCreatedAt()
+		skc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := skc.mutation.UpdatedAt(); !ok {
+		if s3accesskey.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized s3accesskey.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := s3accesskey.DefaultUpdatedAt()
+		skc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := skc.mutation.ReadOnly(); !ok {
+		v := s3accesskey.DefaultReadOnly
+		skc.mutation.SetReadOnly(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skc *S3AccessKeyCreate) check() error {
+	if _, ok := skc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "S3AccessKey.created_at"`)}
+	}
+	if _, ok := skc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "S3AccessKey.updated_at"`)}
+	}
+	if _, ok := skc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "S3AccessKey.user_id"`)}
+	}
+	if _, ok := skc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "S3AccessKey.name"`)}
+	}
+	if _, ok := skc.mutation.AccessKeyID(); !ok {
+		return &ValidationError{Name: "access_key_id", err: errors.New(`ent: missing required field "S3AccessKey.access_key_id"`)}
+	}
+	if _, ok := skc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "S3AccessKey.secret"`)}
+	}
+	if _, ok := skc.mutation.ReadOnly(); !ok {
+		return &ValidationError{Name: "read_only", err: errors.New(`ent: missing required field "S3AccessKey.read_only"`)}
+	}
+	if _, ok := skc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "S3AccessKey.user"`)}
+	}
+	return nil
+}
+
+func (skc *S3AccessKeyCreate) sqlSave(ctx context.Context) (*S3AccessKey, error) {
+	if err := skc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := skc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, skc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	skc.mutation.id = &_node.ID
+	skc.mutation.done = true
+	return _node, nil
+}
+
+func (skc *S3AccessKeyCreate) createSpec() (*S3AccessKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &S3AccessKey{config: skc.config}
+		_spec = sqlgraph.NewCreateSpec(s3accesskey.Table, sqlgraph.NewFieldSpec(s3accesskey.FieldID, field.TypeInt))
+	)
+
+	if id, ok := skc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = skc.conflict
+	if value, ok := skc.mutation.CreatedAt(); ok {
+		_spec.SetField(s3accesskey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := skc.mutation.UpdatedAt(); ok {
+		_spec.SetField(s3accesskey.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := skc.mutation.DeletedAt(); ok {
+		_spec.SetField(s3accesskey.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := skc.mutation.Name(); ok {
+		_spec.SetField(s3accesskey.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := skc.mutation.AccessKeyID(); ok {
+		_spec.SetField(s3accesskey.FieldAccessKeyID, field.TypeString, value)
+		_node.AccessKeyID = value
+	}
+	if value, ok := skc.mutation.Secret(); ok {
+		_spec.SetField(s3accesskey.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := skc.mutation.ReadOnly(); ok {
+		_spec.SetField(s3accesskey.FieldReadOnly, field.TypeBool, value)
+		_node.ReadOnly = value
+	}
+	if value, ok := skc.mutation.LastUsedAt(); ok {
+		_spec.SetField(s3accesskey.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if nodes := skc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   s3accesskey.UserTable,
+			Columns: []string{s3accesskey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.S3AccessKey.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.S3AccessKeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (skc *S3AccessKeyCreate) OnConflict(opts ...sql.ConflictOption) *S3AccessKeyUpsertOne {
+	skc.conflict = opts
+	return &S3AccessKeyUpsertOne{
+		create: skc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.S3AccessKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (skc *S3AccessKeyCreate) OnConflictColumns(columns ...string) *S3AccessKeyUpsertOne {
+	skc.conflict = append(skc.conflict, sql.ConflictColumns(columns...))
+	return &S3AccessKeyUpsertOne{
+		create: skc,
+	}
+}
+
+type (
+	// S3AccessKeyUpsertOne is the builder for "upsert"-ing
+	//  one S3AccessKey node.
+	S3AccessKeyUpsertOne struct {
+		create *S3AccessKeyCreate
+	}
+
+	// S3AccessKeyUpsert is the "OnConflict" setter.
+	S3AccessKeyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *S3AccessKeyUpsert) SetUpdatedAt(v time.Time) *S3AccessKeyUpsert {
+	u.Set(s3accesskey.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *S3AccessKeyUpsert) UpdateUpdatedAt() *S3AccessKeyUpsert {
+	u.SetExcluded(s3accesskey.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *S3AccessKeyUpsert) SetDeletedAt(v time.Time) *S3AccessKeyUpsert {
+	u.Set(s3accesskey.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *S3AccessKeyUpsert) UpdateDeletedAt() *S3AccessKeyUpsert {
+	u.SetExcluded(s3accesskey.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *S3AccessKeyUpsert) ClearDeletedAt() *S3AccessKeyUpsert {
+	u.SetNull(s3accesskey.FieldDeletedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *S3AccessKeyUpsert) SetUserID(v int) *S3AccessKeyUpsert {
+	u.Set(s3accesskey.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *S3AccessKeyUpsert) UpdateUserID() *S3AccessKeyUpsert {
+	u.SetExcluded(s3accesskey.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *S3AccessKeyUpsert) SetName(v string) *S3AccessKeyUpsert {
+	u.Set(s3accesskey.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *S3AccessKeyUpsert) UpdateName() *S3AccessKeyUpsert {
+	u.SetExcluded(s3accesskey.FieldName)
+	return u
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (u *S3AccessKeyUpsert) SetAccessKeyID(v string) *S3AccessKeyUpsert {
+	u.Set(s3accesskey.FieldAccessKeyID, v)
+	return u
+}
+
+// UpdateAccessKeyID sets the "access_key_id" field to the value that was provided on create.
+func (u *S3AccessKeyUpsert) UpdateAccessKeyID() *S3AccessKeyUpsert {
+	u.SetExcluded(s3accesskey.FieldAccessKeyID)
+	return u
+}
+
+// SetSecret sets the "secret" field.
+func (u *S3AccessKeyUpsert) SetSecret(v string) *S3AccessKeyUpsert {
+	u.Set(s3accesskey.FieldSecret, v)
+	return u
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *S3AccessKeyUpsert) UpdateSecret() *S3AccessKeyUpsert {
+	u.SetExcluded(s3accesskey.FieldSecret)
+	return u
+}
+
+// SetReadOnly sets the "read_only" field.
+func (u *S3AccessKeyUpsert) SetReadOnly(v bool) *S3AccessKeyUpsert {
+	u.Set(s3accesskey.FieldReadOnly, v)
+	return u
+}
+
+// UpdateReadOnly sets the "read_only" field to the value that was provided on create.
+func (u *S3AccessKeyUpsert) UpdateReadOnly() *S3AccessKeyUpsert {
+	u.SetExcluded(s3accesskey.FieldReadOnly)
+	return u
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *S3AccessKeyUpsert) SetLastUsedAt(v time.Time) *S3AccessKeyUpsert {
+	u.Set(s3accesskey.FieldLastUsedAt, v)
+	return u
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *S3AccessKeyUpsert) UpdateLastUsedAt() *S3AccessKeyUpsert {
+	u.SetExcluded(s3accesskey.FieldLastUsedAt)
+	return u
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *S3AccessKeyUpsert) ClearLastUsedAt() *S3AccessKeyUpsert {
+	u.SetNull(s3accesskey.FieldLastUsedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.S3AccessKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *S3AccessKeyUpsertOne) UpdateNewValues() *S3AccessKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(s3accesskey.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.S3AccessKey.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *S3AccessKeyUpsertOne) Ignore() *S3AccessKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *S3AccessKeyUpsertOne) DoNothing() *S3AccessKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the S3AccessKeyCreate.OnConflict
+// documentation for more info.
+func (u *S3AccessKeyUpsertOne) Update(set func(*S3AccessKeyUpsert)) *S3AccessKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&S3AccessKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *S3AccessKeyUpsertOne) SetUpdatedAt(v time.Time) *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *S3AccessKeyUpsertOne) UpdateUpdatedAt() *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *S3AccessKeyUpsertOne) SetDeletedAt(v time.Time) *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *S3AccessKeyUpsertOne) UpdateDeletedAt() *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *S3AccessKeyUpsertOne) ClearDeletedAt() *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *S3AccessKeyUpsertOne) SetUserID(v int) *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *S3AccessKeyUpsertOne) UpdateUserID() *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *S3AccessKeyUpsertOne) SetName(v string) *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *S3AccessKeyUpsertOne) UpdateName() *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (u *S3AccessKeyUpsertOne) SetAccessKeyID(v string) *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.SetAccessKeyID(v)
+	})
+}
+
+// UpdateAccessKeyID sets the "access_key_id" field to the value that was provided on create.
+func (u *S3AccessKeyUpsertOne) UpdateAccessKeyID() *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.UpdateAccessKeyID()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *S3AccessKeyUpsertOne) SetSecret(v string) *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *S3AccessKeyUpsertOne) UpdateSecret() *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetReadOnly sets the "read_only" field.
+func (u *S3AccessKeyUpsertOne) SetReadOnly(v bool) *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.SetReadOnly(v)
+	})
+}
+
+// UpdateReadOnly sets the "read_only" field to the value that was provided on create.
+func (u *S3AccessKeyUpsertOne) UpdateReadOnly() *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.UpdateReadOnly()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *S3AccessKeyUpsertOne) SetLastUsedAt(v time.Time) *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *S3AccessKeyUpsertOne) UpdateLastUsedAt() *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *S3AccessKeyUpsertOne) ClearLastUsedAt() *S3AccessKeyUpsertOne {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *S3AccessKeyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for S3AccessKeyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *S3AccessKeyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *S3AccessKeyUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *S3AccessKeyUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *S3AccessKeyCreate) SetRawID(t int) *S3AccessKeyCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// S3AccessKeyCreateBulk is the builder for creating many S3AccessKey entities in bulk.
+type S3AccessKeyCreateBulk struct {
+	config
+	err      error
+	builders []*S3AccessKeyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the S3AccessKey entities in the database.
+func (skcb *S3AccessKeyCreateBulk) Save(ctx context.Context) ([]*S3AccessKey, error) {
+	if skcb.err != nil {
+		return nil, skcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(skcb.builders))
+	nodes := make([]*S3AccessKey, len(skcb.builders))
+	mutators := make([]Mutator, len(skcb.builders))
+	for i := range skcb.builders {
+		func(i int, root context.Context) {
+			builder := skcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*S3AccessKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, skcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = skcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, skcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, skcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skcb *S3AccessKeyCreateBulk) SaveX(ctx context.Context) []*S3AccessKey {
+	v, err := skcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skcb *S3AccessKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := skcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skcb *S3AccessKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := skcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.S3AccessKey.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.S3AccessKeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (skcb *S3AccessKeyCreateBulk) OnConflict(opts ...sql.ConflictOption) *S3AccessKeyUpsertBulk {
+	skcb.conflict = opts
+	return &S3AccessKeyUpsertBulk{
+		create: skcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.S3AccessKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (skcb *S3AccessKeyCreateBulk) OnConflictColumns(columns ...string) *S3AccessKeyUpsertBulk {
+	skcb.conflict = append(skcb.conflict, sql.ConflictColumns(columns...))
+	return &S3AccessKeyUpsertBulk{
+		create: skcb,
+	}
+}
+
+// S3AccessKeyUpsertBulk is the builder for "upsert"-ing
+// a bulk of S3AccessKey nodes.
+type S3AccessKeyUpsertBulk struct {
+	create *S3AccessKeyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.S3AccessKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *S3AccessKeyUpsertBulk) UpdateNewValues() *S3AccessKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(s3accesskey.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.S3AccessKey.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *S3AccessKeyUpsertBulk) Ignore() *S3AccessKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *S3AccessKeyUpsertBulk) DoNothing() *S3AccessKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the S3AccessKeyCreateBulk.OnConflict
+// documentation for more info.
+func (u *S3AccessKeyUpsertBulk) Update(set func(*S3AccessKeyUpsert)) *S3AccessKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&S3AccessKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *S3AccessKeyUpsertBulk) SetUpdatedAt(v time.Time) *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *S3AccessKeyUpsertBulk) UpdateUpdatedAt() *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *S3AccessKeyUpsertBulk) SetDeletedAt(v time.Time) *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *S3AccessKeyUpsertBulk) UpdateDeletedAt() *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *S3AccessKeyUpsertBulk) ClearDeletedAt() *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *S3AccessKeyUpsertBulk) SetUserID(v int) *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *S3AccessKeyUpsertBulk) UpdateUserID() *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *S3AccessKeyUpsertBulk) SetName(v string) *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *S3AccessKeyUpsertBulk) UpdateName() *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (u *S3AccessKeyUpsertBulk) SetAccessKeyID(v string) *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.SetAccessKeyID(v)
+	})
+}
+
+// UpdateAccessKeyID sets the "access_key_id" field to the value that was provided on create.
+func (u *S3AccessKeyUpsertBulk) UpdateAccessKeyID() *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.UpdateAccessKeyID()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *S3AccessKeyUpsertBulk) SetSecret(v string) *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *S3AccessKeyUpsertBulk) UpdateSecret() *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetReadOnly sets the "read_only" field.
+func (u *S3AccessKeyUpsertBulk) SetReadOnly(v bool) *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.SetReadOnly(v)
+	})
+}
+
+// UpdateReadOnly sets the "read_only" field to the value that was provided on create.
+func (u *S3AccessKeyUpsertBulk) UpdateReadOnly() *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.UpdateReadOnly()
+	})
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (u *S3AccessKeyUpsertBulk) SetLastUsedAt(v time.Time) *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.SetLastUsedAt(v)
+	})
+}
+
+// UpdateLastUsedAt sets the "last_used_at" field to the value that was provided on create.
+func (u *S3AccessKeyUpsertBulk) UpdateLastUsedAt() *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.UpdateLastUsedAt()
+	})
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (u *S3AccessKeyUpsertBulk) ClearLastUsedAt() *S3AccessKeyUpsertBulk {
+	return u.Update(func(s *S3AccessKeyUpsert) {
+		s.ClearLastUsedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *S3AccessKeyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the S3AccessKeyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for S3AccessKeyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *S3AccessKeyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/s3accesskey"
+)
+
+// S3AccessKeyDelete is the builder for deleting a S3AccessKey entity.
+type S3AccessKeyDelete struct {
+	config
+	hooks    []Hook
+	mutation *S3AccessKeyMutation
+}
+
+// Where appends a list predicates to the S3AccessKeyDelete builder.
+func (skd *S3AccessKeyDelete) Where(ps ...predicate.S3AccessKey) *S3AccessKeyDelete {
+	skd.mutation.Where(ps...)
+	return skd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (skd *S3AccessKeyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, skd.sqlExec, skd.mutation, skd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skd *S3AccessKeyDelete) ExecX(ctx context.Context) int {
+	n, err := skd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (skd *S3AccessKeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(s3accesskey.Table, sqlgraph.NewFieldSpec(s3accesskey.FieldID, field.TypeInt))
+	if ps := skd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, skd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	skd.mutation.done = true
+	return affected, err
+}
+
+// S3AccessKeyDeleteOne is the builder for deleting a single S3AccessKey entity.
+type S3AccessKeyDeleteOne struct {
+	skd *S3AccessKeyDelete
+}
+
+// Where appends a list predicates to the S3AccessKeyDelete builder.
+func (skdo *S3AccessKeyDeleteOne) Where(ps ...predicate.S3AccessKey) *S3AccessKeyDeleteOne {
+	skdo.skd.mutation.Where(ps...)
+	return skdo
+}
+
+// Exec executes the deletion query.
+func (skdo *S3AccessKeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := skdo.skd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{s3accesskey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skdo *S3AccessKeyDeleteOne) ExecX(ctx context.Context) {
+	if err := skdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
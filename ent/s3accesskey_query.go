@@ -0,0 +1,618 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/s3accesskey"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// S3AccessKeyQuery is the builder for querying S3AccessKey entities.
+type S3AccessKeyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []s3accesskey.OrderOption
+	inters     []Interceptor
+	predicates []predicate.S3AccessKey
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*S3AccessKey) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the S3AccessKeyQuery builder.
+func (skq *S3AccessKeyQuery) Where(ps ...predicate.S3AccessKey) *S3AccessKeyQuery {
+	skq.predicates = append(skq.predicates, ps...)
+	return skq
+}
+
+// Limit the number of records to be returned by this query.
+func (skq *S3AccessKeyQuery) Limit(limit int) *S3AccessKeyQuery {
+	skq.ctx.Limit = &limit
+	return skq
+}
+
+// Offset to start from.
+func (skq *S3AccessKeyQuery) Offset(offset int) *S3AccessKeyQuery {
+	skq.ctx.Offset = &offset
+	return skq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (skq *S3AccessKeyQuery) Unique(unique bool) *S3AccessKeyQuery {
+	skq.ctx.Unique = &unique
+	return skq
+}
+
+// Order specifies how the records should be ordered.
+func (skq *S3AccessKeyQuery) Order(o ...s3accesskey.OrderOption) *S3AccessKeyQuery {
+	skq.order = append(skq.order, o...)
+	return skq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (skq *S3AccessKeyQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: skq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := skq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := skq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(s3accesskey.Table, s3accesskey.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, s3accesskey.UserTable, s3accesskey.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(skq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first S3AccessKey entity from the query.
+// Returns a *NotFoundError when no S3AccessKey was found.
+func (skq *S3AccessKeyQuery) First(ctx context.Context) (*S3AccessKey, error) {
+	nodes, err := skq.Limit(1).All(setContextOp(ctx, skq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{s3accesskey.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (skq *S3AccessKeyQuery) FirstX(ctx context.Context) *S3AccessKey {
+	node, err := skq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first S3AccessKey ID from the query.
+// Returns a *NotFoundError when no S3AccessKey ID was found.
+func (skq *S3AccessKeyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = skq.Limit(1).IDs(setContextOp(ctx, skq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{s3accesskey.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (skq *S3AccessKeyQuery) FirstIDX(ctx context.Context) int {
+	id, err := skq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single S3AccessKey entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one S3AccessKey entity is found.
+// Returns a *NotFoundError when no S3AccessKey entities are found.
+func (skq *S3AccessKeyQuery) Only(ctx context.Context) (*S3AccessKey, error) {
+	nodes, err := skq.Limit(2).All(setContextOp(ctx, skq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{s3accesskey.Label}
+	default:
+		return nil, &NotSingularError{s3accesskey.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (skq *S3AccessKeyQuery) OnlyX(ctx context.Context) *S3AccessKey {
+	node, err := skq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only S3AccessKey ID in the query.
+// Returns a *NotSingularError when more than one S3AccessKey ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (skq *S3AccessKeyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = skq.Limit(2).IDs(setContextOp(ctx, skq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{s3accesskey.Label}
+	default:
+		err = &NotSingularError{s3accesskey.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (skq *S3AccessKeyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := skq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of S3AccessKeys.
+func (skq *S3AccessKeyQuery) All(ctx context.Context) ([]*S3AccessKey, error) {
+	ctx = setContextOp(ctx, skq.ctx, "All")
+	if err := skq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*S3AccessKey, *S3AccessKeyQuery]()
+	return withInterceptors[[]*S3AccessKey](ctx, skq, qr, skq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (skq *S3AccessKeyQuery) AllX(ctx context.Context) []*S3AccessKey {
+	nodes, err := skq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of S3AccessKey IDs.
+func (skq *S3AccessKeyQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if skq.ctx.Unique == nil && skq.path != nil {
+		skq.Unique(true)
+	}
+	ctx = setContextOp(ctx, skq.ctx, "IDs")
+	if err = skq.Select(s3accesskey.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (skq *S3AccessKeyQuery) IDsX(ctx context.Context) []int {
+	ids, err := skq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (skq *S3AccessKeyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, skq.ctx, "Count")
+	if err := skq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, skq, querierCount[*S3AccessKeyQuery](), skq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (skq *S3AccessKeyQuery) CountX(ctx context.Context) int {
+	count, err := skq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (skq *S3AccessKeyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, skq.ctx, "Exist")
+	switch _, err := skq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (skq *S3AccessKeyQuery) ExistX(ctx context.Context) bool {
+	exist, err := skq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the S3AccessKeyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (skq *S3AccessKeyQuery) Clone() *S3AccessKeyQuery {
+	if skq == nil {
+		return nil
+	}
+	return &S3AccessKeyQuery{
+		config:     skq.config,
+		ctx:        skq.ctx.Clone(),
+		order:      append([]s3accesskey.OrderOption{}, skq.order...),
+		inters:     append([]Interceptor{}, skq.inters...),
+		predicates: append([]predicate.S3AccessKey{}, skq.predicates...),
+		withUser:   skq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  skq.sql.Clone(),
+		path: skq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (skq *S3AccessKeyQuery) WithUser(opts ...func(*UserQuery)) *S3AccessKeyQuery {
+	query := (&UserClient{config: skq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	skq.withUser = query
+	return skq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.S3AccessKey.Query().
+//		GroupBy(s3accesskey.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (skq *S3AccessKeyQuery) GroupBy(field string, fields ...string) *S3AccessKeyGroupBy {
+	skq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &S3AccessKeyGroupBy{build: skq}
+	grbuild.flds = &skq.ctx.Fields
+	grbuild.label = s3accesskey.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.S3AccessKey.Query().
+//		Select(s3accesskey.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (skq *S3AccessKeyQuery) Select(fields ...string) *S3AccessKeySelect {
+	skq.ctx.Fields = append(skq.ctx.Fields, fields...)
+	sbuild := &S3AccessKeySelect{S3AccessKeyQuery: skq}
+	sbuild.label = s3accesskey.Label
+	sbuild.flds, sbuild.scan = &skq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a S3AccessKeySelect configured with the given aggregations.
+func (skq *S3AccessKeyQuery) Aggregate(fns ...AggregateFunc) *S3AccessKeySelect {
+	return skq.Select().Aggregate(fns...)
+}
+
+func (skq *S3AccessKeyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range skq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, skq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range skq.ctx.Fields {
+		if !s3accesskey.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if skq.path != nil {
+		prev, err := skq.path(ctx)
+		if err != nil {
+			return err
+		}
+		skq.sql = prev
+	}
+	return nil
+}
+
+func (skq *S3AccessKeyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*S3AccessKey, error) {
+	var (
+		nodes       = []*S3AccessKey{}
+		_spec       = skq.querySpec()
+		loadedTypes = [1]bool{
+			skq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*S3AccessKey).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &S3AccessKey{config: skq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(skq.modifiers) > 0 {
+		_spec.Modifiers = skq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, skq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := skq.withUser; query != nil {
+		if err := skq.loadUser(ctx, query, nodes, nil,
+			func(n *S3AccessKey, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range skq.loadTotal {
+		if err := skq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (skq *S3AccessKeyQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*S3AccessKey, init func(*S3AccessKey), assign func(*S3AccessKey, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*S3AccessKey)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (skq *S3AccessKeyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := skq.querySpec()
+	if len(skq.modifiers) > 0 {
+		_spec.Modifiers = skq.modifiers
+	}
+	_spec.Node.Columns = skq.ctx.Fields
+	if len(skq.ctx.Fields) > 0 {
+		_spec.Unique = skq.ctx.Unique != nil && *skq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, skq.driver, _spec)
+}
+
+func (skq *S3AccessKeyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(s3accesskey.Table, s3accesskey.Columns, sqlgraph.NewFieldSpec(s3accesskey.FieldID, field.TypeInt))
+	_spec.From = skq.sql
+	if unique := skq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if skq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := skq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, s3accesskey.FieldID)
+		for i := range fields {
+			if fields[i] != s3accesskey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if skq.withUser != nil {
+			_spec.Node.AddColumnOnce(s3accesskey.FieldUserID)
+		}
+	}
+	if ps := skq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := skq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := skq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := skq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (skq *S3AccessKeyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(skq.driver.Dialect())
+	t1 := builder.Table(s3accesskey.Table)
+	columns := skq.ctx.Fields
+	if len(columns) == 0 {
+		columns = s3accesskey.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if skq.sql != nil {
+		selector = skq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if skq.ctx.Unique != nil && *skq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range skq.predicates {
+		p(selector)
+	}
+	for _, p := range skq.order {
+		p(selector)
+	}
+	if offset := skq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := skq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// S3AccessKeyGroupBy is the group-by builder for S3AccessKey entities.
+type S3AccessKeyGroupBy struct {
+	selector
+	build *S3AccessKeyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (skgb *S3AccessKeyGroupBy) Aggregate(fns ...AggregateFunc) *S3AccessKeyGroupBy {
+	skgb.fns = append(skgb.fns, fns...)
+	return skgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (skgb *S3AccessKeyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, skgb.build.ctx, "GroupBy")
+	if err := skgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*S3AccessKeyQuery, *S3AccessKeyGroupBy](ctx, skgb.build, skgb, skgb.build.inters, v)
+}
+
+func (skgb *S3AccessKeyGroupBy) sqlScan(ctx context.Context, root *S3AccessKeyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(skgb.fns))
+	for _, fn := range skgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*skgb.flds)+len(skgb.fns))
+		for _, f := range *skgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*skgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := skgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// S3AccessKeySelect is the builder for selecting fields of S3AccessKey entities.
+type S3AccessKeySelect struct {
+	*S3AccessKeyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sks *S3AccessKeySelect) Aggregate(fns ...AggregateFunc) *S3AccessKeySelect {
+	sks.fns = append(sks.fns, fns...)
+	return sks
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sks *S3AccessKeySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sks.ctx, "Select")
+	if err := sks.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*S3AccessKeyQuery, *S3AccessKeySelect](ctx, sks.S3AccessKeyQuery, sks, sks.inters, v)
+}
+
+func (sks *S3AccessKeySelect) sqlScan(ctx context.Context, root *S3AccessKeyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sks.fns))
+	for _, fn := range sks.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sks.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sks.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
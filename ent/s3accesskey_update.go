@@ -0,0 +1,595 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/s3accesskey"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// S3AccessKeyUpdate is the builder for updating S3AccessKey entities.
+type S3AccessKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *S3AccessKeyMutation
+}
+
+// Where appends a list predicates to the S3AccessKeyUpdate builder.
+func (sku *S3AccessKeyUpdate) Where(ps ...predicate.S3AccessKey) *S3AccessKeyUpdate {
+	sku.mutation.Where(ps...)
+	return sku
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sku *S3AccessKeyUpdate) SetUpdatedAt(t time.Time) *S3AccessKeyUpdate {
+	sku.mutation.SetUpdatedAt(t)
+	return sku
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sku *S3AccessKeyUpdate) SetDeletedAt(t time.Time) *S3AccessKeyUpdate {
+	sku.mutation.SetDeletedAt(t)
+	return sku
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sku *S3AccessKeyUpdate) SetNillableDeletedAt(t *time.Time) *S3AccessKeyUpdate {
+	if t != nil {
+		sku.SetDeletedAt(*t)
+	}
+	return sku
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (sku *S3AccessKeyUpdate) ClearDeletedAt() *S3AccessKeyUpdate {
+	sku.mutation.ClearDeletedAt()
+	return sku
+}
+
+// SetUserID sets the "user_id" field.
+func (sku *S3AccessKeyUpdate) SetUserID(i int) *S3AccessKeyUpdate {
+	sku.mutation.SetUserID(i)
+	return sku
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (sku *S3AccessKeyUpdate) SetNillableUserID(i *int) *S3AccessKeyUpdate {
+	if i != nil {
+		sku.SetUserID(*i)
+	}
+	return sku
+}
+
+// SetName sets the "name" field.
+func (sku *S3AccessKeyUpdate) SetName(s string) *S3AccessKeyUpdate {
+	sku.mutation.SetName(s)
+	return sku
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sku *S3AccessKeyUpdate) SetNillableName(s *string) *S3AccessKeyUpdate {
+	if s != nil {
+		sku.SetName(*s)
+	}
+	return sku
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (sku *S3AccessKeyUpdate) SetAccessKeyID(s string) *S3AccessKeyUpdate {
+	sku.mutation.SetAccessKeyID(s)
+	return sku
+}
+
+// SetNillableAccessKeyID sets the "access_key_id" field if the given value is not nil.
+func (sku *S3AccessKeyUpdate) SetNillableAccessKeyID(s *string) *S3AccessKeyUpdate {
+	if s != nil {
+		sku.SetAccessKeyID(*s)
+	}
+	return sku
+}
+
+// SetSecret sets the "secret" field.
+func (sku *S3AccessKeyUpdate) SetSecret(s string) *S3AccessKeyUpdate {
+	sku.mutation.SetSecret(s)
+	return sku
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (sku *S3AccessKeyUpdate) SetNillableSecret(s *string) *S3AccessKeyUpdate {
+	if s != nil {
+		sku.SetSecret(*s)
+	}
+	return sku
+}
+
+// SetReadOnly sets the "read_only" field.
+func (sku *S3AccessKeyUpdate) SetReadOnly(b bool) *S3AccessKeyUpdate {
+	sku.mutation.SetReadOnly(b)
+	return sku
+}
+
+// SetNillableReadOnly sets the "read_only" field if the given value is not nil.
+func (sku *S3AccessKeyUpdate) SetNillableReadOnly(b *bool) *S3AccessKeyUpdate {
+	if b != nil {
+		sku.SetReadOnly(*b)
+	}
+	return sku
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (sku *S3AccessKeyUpdate) SetLastUsedAt(t time.Time) *S3AccessKeyUpdate {
+	sku.mutation.SetLastUsedAt(t)
+	return sku
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (sku *S3AccessKeyUpdate) SetNillableLastUsedAt(t *time.Time) *S3AccessKeyUpdate {
+	if t != nil {
+		sku.SetLastUsedAt(*t)
+	}
+	return sku
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (sku *S3AccessKeyUpdate) ClearLastUsedAt() *S3AccessKeyUpdate {
+	sku.mutation.ClearLastUsedAt()
+	return sku
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sku *S3AccessKeyUpdate) SetUser(u *User) *S3AccessKeyUpdate {
+	return sku.SetUserID(u.ID)
+}
+
+// Mutation returns the S3AccessKeyMutation object of the builder.
+func (sku *S3AccessKeyUpdate) Mutation() *S3AccessKeyMutation {
+	return sku.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (sku *S3AccessKeyUpdate) ClearUser() *S3AccessKeyUpdate {
+	sku.mutation.ClearUser()
+	return sku
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sku *S3AccessKeyUpdate) Save(ctx context.Context) (int, error) {
+	if err := sku.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, sku.sqlSave, sku.mutation, sku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sku *S3AccessKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := sku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sku *S3AccessKeyUpdate) Exec(ctx context.Context) error {
+	_, err := sku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sku *S3AccessKeyUpdate) ExecX(ctx context.Context) {
+	if err := sku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sku *S3AccessKeyUpdate) defaults() error {
+	if _, ok := sku.mutation.UpdatedAt(); !ok {
+		if s3accesskey.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized s3accesskey.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := s3accesskey.UpdateDefaultUpdatedAt()
+		sku.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sku *S3AccessKeyUpdate) check() error {
+	if _, ok := sku.mutation.UserID(); sku.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "S3AccessKey.user"`)
+	}
+	return nil
+}
+
+func (sku *S3AccessKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(s3accesskey.Table, s3accesskey.Columns, sqlgraph.NewFieldSpec(s3accesskey.FieldID, field.TypeInt))
+	if ps := sku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sku.mutation.UpdatedAt(); ok {
+		_spec.SetField(s3accesskey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := sku.mutation.DeletedAt(); ok {
+		_spec.SetField(s3accesskey.FieldDeletedAt, field.TypeTime, value)
+	}
+	if sku.mutation.DeletedAtCleared() {
+		_spec.ClearField(s3accesskey.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := sku.mutation.Name(); ok {
+		_spec.SetField(s3accesskey.FieldName, field.TypeString, value)
+	}
+	if value, ok := sku.mutation.AccessKeyID(); ok {
+		_spec.SetField(s3accesskey.FieldAccessKeyID, field.TypeString, value)
+	}
+	if value, ok := sku.mutation.Secret(); ok {
+		_spec.SetField(s3accesskey.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := sku.mutation.ReadOnly(); ok {
+		_spec.SetField(s3accesskey.FieldReadOnly, field.TypeBool, value)
+	}
+	if value, ok := sku.mutation.LastUsedAt(); ok {
+		_spec.SetField(s3accesskey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if sku.mutation.LastUsedAtCleared() {
+		_spec.ClearField(s3accesskey.FieldLastUsedAt, field.TypeTime)
+	}
+	if sku.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   s3accesskey.UserTable,
+			Columns: []string{s3accesskey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sku.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   s3accesskey.UserTable,
+			Columns: []string{s3accesskey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{s3accesskey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sku.mutation.done = true
+	return n, nil
+}
+
+// S3AccessKeyUpdateOne is the builder for updating a single S3AccessKey entity.
+type S3AccessKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *S3AccessKeyMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (skuo *S3AccessKeyUpdateOne) SetUpdatedAt(t time.Time) *S3AccessKeyUpdateOne {
+	skuo.mutation.SetUpdatedAt(t)
+	return skuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (skuo *S3AccessKeyUpdateOne) SetDeletedAt(t time.Time) *S3AccessKeyUpdateOne {
+	skuo.mutation.SetDeletedAt(t)
+	return skuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (skuo *S3AccessKeyUpdateOne) SetNillableDeletedAt(t *time.Time) *S3AccessKeyUpdateOne {
+	if t != nil {
+		skuo.SetDeletedAt(*t)
+	}
+	return skuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (skuo *S3AccessKeyUpdateOne) ClearDeletedAt() *S3AccessKeyUpdateOne {
+	skuo.mutation.ClearDeletedAt()
+	return skuo
+}
+
+// SetUserID sets the "user_id" field.
+func (skuo *S3AccessKeyUpdateOne) SetUserID(i int) *S3AccessKeyUpdateOne {
+	skuo.mutation.SetUserID(i)
+	return skuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (skuo *S3AccessKeyUpdateOne) SetNillableUserID(i *int) *S3AccessKeyUpdateOne {
+	if i != nil {
+		skuo.SetUserID(*i)
+	}
+	return skuo
+}
+
+// SetName sets the "name" field.
+func (skuo *S3AccessKeyUpdateOne) SetName(s string) *S3AccessKeyUpdateOne {
+	skuo.mutation.SetName(s)
+	return skuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (skuo *S3AccessKeyUpdateOne) SetNillableName(s *string) *S3AccessKeyUpdateOne {
+	if s != nil {
+		skuo.SetName(*s)
+	}
+	return skuo
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (skuo *S3AccessKeyUpdateOne) SetAccessKeyID(s string) *S3AccessKeyUpdateOne {
+	skuo.mutation.SetAccessKeyID(s)
+	return skuo
+}
+
+// SetNillableAccessKeyID sets the "access_key_id" field if the given value is not nil.
+func (skuo *S3AccessKeyUpdateOne) SetNillableAccessKeyID(s *string) *S3AccessKeyUpdateOne {
+	if s != nil {
+		skuo.SetAccessKeyID(*s)
+	}
+	return skuo
+}
+
+// SetSecret sets the "secret" field.
+func (skuo *S3AccessKeyUpdateOne) SetSecret(s string) *S3AccessKeyUpdateOne {
+	skuo.mutation.SetSecret(s)
+	return skuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (skuo *S3AccessKeyUpdateOne) SetNillableSecret(s *string) *S3AccessKeyUpdateOne {
+	if s != nil {
+		skuo.SetSecret(*s)
+	}
+	return skuo
+}
+
+// SetReadOnly sets the "read_only" field.
+func (skuo *S3AccessKeyUpdateOne) SetReadOnly(b bool) *S3AccessKeyUpdateOne {
+	skuo.mutation.SetReadOnly(b)
+	return skuo
+}
+
+// SetNillableReadOnly sets the "read_only" field if the given value is not nil.
+func (skuo *S3AccessKeyUpdateOne) SetNillableReadOnly(b *bool) *S3AccessKeyUpdateOne {
+	if b != nil {
+		skuo.SetReadOnly(*b)
+	}
+	return skuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (skuo *S3AccessKeyUpdateOne) SetLastUsedAt(t time.Time) *S3AccessKeyUpdateOne {
+	skuo.mutation.SetLastUsedAt(t)
+	return skuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (skuo *S3AccessKeyUpdateOne) SetNillableLastUsedAt(t *time.Time) *S3AccessKeyUpdateOne {
+	if t != nil {
+		skuo.SetLastUsedAt(*t)
+	}
+	return skuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (skuo *S3AccessKeyUpdateOne) ClearLastUsedAt() *S3AccessKeyUpdateOne {
+	skuo.mutation.ClearLastUsedAt()
+	return skuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (skuo *S3AccessKeyUpdateOne) SetUser(u *User) *S3AccessKeyUpdateOne {
+	return skuo.SetUserID(u.ID)
+}
+
+// Mutation returns the S3AccessKeyMutation object of the builder.
+func (skuo *S3AccessKeyUpdateOne) Mutation() *S3AccessKeyMutation {
+	return skuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (skuo *S3AccessKeyUpdateOne) ClearUser() *S3AccessKeyUpdateOne {
+	skuo.mutation.ClearUser()
+	return skuo
+}
+
+// Where appends a list predicates to the S3AccessKeyUpdate builder.
+func (skuo *S3AccessKeyUpdateOne) Where(ps ...predicate.S3AccessKey) *S3AccessKeyUpdateOne {
+	skuo.mutation.Where(ps...)
+	return skuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (skuo *S3AccessKeyUpdateOne) Select(field string, fields ...string) *S3AccessKeyUpdateOne {
+	skuo.fields = append([]string{field}, fields...)
+	return skuo
+}
+
+// Save executes the query and returns the updated S3AccessKey entity.
+func (skuo *S3AccessKeyUpdateOne) Save(ctx context.Context) (*S3AccessKey, error) {
+	if err := skuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, skuo.sqlSave, skuo.mutation, skuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skuo *S3AccessKeyUpdateOne) SaveX(ctx context.Context) *S3AccessKey {
+	node, err := skuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (skuo *S3AccessKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := skuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skuo *S3AccessKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := skuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (skuo *S3AccessKeyUpdateOne) defaults() error {
+	if _, ok := skuo.mutation.UpdatedAt(); !ok {
+		if s3accesskey.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized s3accesskey.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := s3accesskey.UpdateDefaultUpdatedAt()
+		skuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skuo *S3AccessKeyUpdateOne) check() error {
+	if _, ok := skuo.mutation.UserID(); skuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "S3AccessKey.user"`)
+	}
+	return nil
+}
+
+func (skuo *S3AccessKeyUpdateOne) sqlSave(ctx context.Context) (_node *S3AccessKey, err error) {
+	if err := skuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(s3accesskey.Table, s3accesskey.Columns, sqlgraph.NewFieldSpec(s3accesskey.FieldID, field.TypeInt))
+	id, ok := skuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "S3AccessKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := skuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, s3accesskey.FieldID)
+		for _, f := range fields {
+			if !s3accesskey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != s3accesskey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := skuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := skuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(s3accesskey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := skuo.mutation.DeletedAt(); ok {
+		_spec.SetField(s3accesskey.FieldDeletedAt, field.TypeTime, value)
+	}
+	if skuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(s3accesskey.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := skuo.mutation.Name(); ok {
+		_spec.SetField(s3accesskey.FieldName, field.TypeString, value)
+	}
+	if value, ok := skuo.mutation.AccessKeyID(); ok {
+		_spec.SetField(s3accesskey.FieldAccessKeyID, field.TypeString, value)
+	}
+	if value, ok := skuo.mutation.Secret(); ok {
+		_spec.SetField(s3accesskey.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := skuo.mutation.ReadOnly(); ok {
+		_spec.SetField(s3accesskey.FieldReadOnly, field.TypeBool, value)
+	}
+	if value, ok := skuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(s3accesskey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if skuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(s3accesskey.FieldLastUsedAt, field.TypeTime)
+	}
+	if skuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   s3accesskey.UserTable,
+			Columns: []string{s3accesskey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := skuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   s3accesskey.UserTable,
+			Columns: []string{s3accesskey.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &S3AccessKey{config: skuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, skuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{s3accesskey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	skuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/savedsearch"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// SavedSearch is the model entity for the SavedSearch schema.
+type SavedSearch struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// URI holds the value of the "uri" field.
+	URI string `json:"uri,omitempty"`
+	// Notify holds the value of the "notify" field.
+	Notify bool `json:"notify,omitempty"`
+	// LastCheckedAt holds the value of the "last_checked_at" field.
+	LastCheckedAt *time.Time `json:"last_checked_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SavedSearchQuery when eager-loading is set.
+	Edges        SavedSearchEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SavedSearchEdges holds the relations/edges for other nodes in the graph.
+type SavedSearchEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SavedSearchEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SavedSearch) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case savedsearch.FieldNotify:
+			values[i] = new(sql.NullBool)
+		case savedsearch.FieldID, savedsearch.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case savedsearch.FieldName, savedsearch.FieldURI:
+			values[i] = new(sql.NullString)
+		case savedsearch.FieldCreatedAt, savedsearch.FieldUpdatedAt, savedsearch.FieldDeletedAt, savedsearch.FieldLastCheckedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SavedSearch fields.
+func (ss *SavedSearch) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case savedsearch.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ss.ID = int(value.Int64)
+		case savedsearch.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ss.CreatedAt = value.Time
+			}
+		case savedsearch.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ss.UpdatedAt = value.Time
+			}
+		case savedsearch.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ss.DeletedAt = new(time.Time)
+				*ss.DeletedAt = value.Time
+			}
+		case savedsearch.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ss.UserID = int(value.Int64)
+			}
+		case savedsearch.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ss.Name = value.String
+			}
+		case savedsearch.FieldURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uri", values[i])
+			} else if value.Valid {
+				ss.URI = value.String
+			}
+		case savedsearch.FieldNotify:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field notify", values[i])
+			} else if value.Valid {
+				ss.Notify = value.Bool
+			}
+		case savedsearch.FieldLastCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_checked_at", values[i])
+			} else if value.Valid {
+				ss.LastCheckedAt = new(time.Time)
+				*ss.LastCheckedAt = value.Time
+			}
+		default:
+			ss.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SavedSearch.
+// This includes values selected through modifiers, order, etc.
+func (ss *SavedSearch) Value(name string) (ent.Value, error) {
+	return ss.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the SavedSearch entity.
+func (ss *SavedSearch) QueryUser() *UserQuery {
+	return NewSavedSearchClient(ss.config).QueryUser(ss)
+}
+
+// Update returns a builder for updating this SavedSearch.
+// Note that you need to call SavedSearch.Unwrap() before calling this method if this SavedSearch
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ss *SavedSearch) Update() *SavedSearchUpdateOne {
+	return NewSavedSearchClient(ss.config).UpdateOne(ss)
+}
+
+// Unwrap unwraps the SavedSearch entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ss *SavedSearch) Unwrap() *SavedSearch {
+	_tx, ok := ss.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SavedSearch is not a transactional entity")
+	}
+	ss.config.driver = _tx.drv
+	return ss
+}
+
+// String implements the fmt.Stringer.
+func (ss *SavedSearch) String() string {
+	var builder strings.Builder
+	builder.WriteString("SavedSearch(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ss.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ss.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ss.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ss.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ss.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ss.Name)
+	builder.WriteString(", ")
+	builder.WriteString("uri=")
+	builder.WriteString(ss.URI)
+	builder.WriteString(", ")
+	builder.WriteString("notify=")
+	builder.WriteString(fmt.Sprintf("%v", ss.Notify))
+	builder.WriteString(", ")
+	if v := ss.LastCheckedAt; v != nil {
+		builder.WriteString("last_checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetUser manually set the edge as loaded state.
+func (e *SavedSearch) SetUser(v *User) {
+	e.Edges.User = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// SavedSearches is a parsable slice of SavedSearch.
+type SavedSearches []*SavedSearch
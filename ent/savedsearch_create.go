@@ -0,0 +1,933 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/savedsearch"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// SavedSearchCreate is the builder for creating a SavedSearch entity.
+type SavedSearchCreate struct {
+	config
+	mutation *SavedSearchMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ssc *SavedSearchCreate) SetCreatedAt(t time.Time) *SavedSearchCreate {
+	ssc.mutation.SetCreatedAt(t)
+	return ssc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ssc *SavedSearchCreate) SetNillableCreatedAt(t *time.Time) *SavedSearchCreate {
+	if t != nil {
+		ssc.SetCreatedAt(*t)
+	}
+	return ssc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ssc *SavedSearchCreate) SetUpdatedAt(t time.Time) *SavedSearchCreate {
+	ssc.mutation.SetUpdatedAt(t)
+	return ssc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ssc *SavedSearchCreate) SetNillableUpdatedAt(t *time.Time) *SavedSearchCreate {
+	if t != nil {
+		ssc.SetUpdatedAt(*t)
+	}
+	return ssc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ssc *SavedSearchCreate) SetDeletedAt(t time.Time) *SavedSearchCreate {
+	ssc.mutation.SetDeletedAt(t)
+	return ssc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ssc *SavedSearchCreate) SetNillableDeletedAt(t *time.Time) *SavedSearchCreate {
+	if t != nil {
+		ssc.SetDeletedAt(*t)
+	}
+	return ssc
+}
+
+// SetUserID sets the "user_id" field.
+func (ssc *SavedSearchCreate) SetUserID(i int) *SavedSearchCreate {
+	ssc.mutation.SetUserID(i)
+	return ssc
+}
+
+// SetName sets the "name" field.
+func (ssc *SavedSearchCreate) SetName(s string) *SavedSearchCreate {
+	ssc.mutation.SetName(s)
+	return ssc
+}
+
+// SetURI sets the "uri" field.
+func (ssc *SavedSearchCreate) SetURI(s string) *SavedSearchCreate {
+	ssc.mutation.SetURI(s)
+	return ssc
+}
+
+// SetNotify sets the "notify" field.
+func (ssc *SavedSearchCreate) SetNotify(b bool) *SavedSearchCreate {
+	ssc.mutation.SetNotify(b)
+	return ssc
+}
+
+// SetNillableNotify sets the "notify" field if the given value is not nil.
+func (ssc *SavedSearchCreate) SetNillableNotify(b *bool) *SavedSearchCreate {
+	if b != nil {
+		ssc.SetNotify(*b)
+	}
+	return ssc
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (ssc *SavedSearchCreate) SetLastCheckedAt(t time.Time) *SavedSearchCreate {
+	ssc.mutation.SetLastCheckedAt(t)
+	return ssc
+}
+
+// SetNillableLastCheckedAt sets the "last_checked_at" field if the given value is not nil.
+func (ssc *SavedSearchCreate) SetNillableLastCheckedAt(t *time.Time) *SavedSearchCreate {
+	if t != nil {
+		ssc.SetLastCheckedAt(*t)
+	}
+	return ssc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ssc *SavedSearchCreate) SetUser(u *User) *SavedSearchCreate {
+	return ssc.SetUserID(u.ID)
+}
+
+// Mutation returns the SavedSearchMutation object of the builder.
+func (ssc *SavedSearchCreate) Mutation() *SavedSearchMutation {
+	return ssc.mutation
+}
+
+// Save creates the SavedSearch in the database.
+func (ssc *SavedSearchCreate) Save(ctx context.Context) (*SavedSearch, error) {
+	if err := ssc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ssc.sqlSave, ssc.mutation, ssc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ssc *SavedSearchCreate) SaveX(ctx context.Context) *SavedSearch {
+	v, err := ssc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ssc *SavedSearchCreate) Exec(ctx context.Context) error {
+	_, err := ssc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssc *SavedSearchCreate) ExecX(ctx context.Context) {
+	if err := ssc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssc *SavedSearchCreate) defaults() error {
+	if _, ok := ssc.mutation.CreatedAt(); !ok {
+		if savedsearch.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized savedsearch.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := savedsearch.DefaultCreatedAt()
+		ssc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ssc.mutation.UpdatedAt(); !ok {
+		if savedsearch.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized savedsearch.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := savedsearch.DefaultUpdatedAt()
+		ssc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ssc.mutation.Notify(); !ok {
+		v := savedsearch.DefaultNotify
+		ssc.mutation.SetNotify(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssc *SavedSearchCreate) check() error {
+	if _, ok := ssc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SavedSearch.created_at"`)}
+	}
+	if _, ok := ssc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SavedSearch.updated_at"`)}
+	}
+	if _, ok := ssc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SavedSearch.user_id"`)}
+	}
+	if _, ok := ssc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SavedSearch.name"`)}
+	}
+	if _, ok := ssc.mutation.URI(); !ok {
+		return &ValidationError{Name: "uri", err: errors.New(`ent: missing required field "SavedSearch.uri"`)}
+	}
+	if _, ok := ssc.mutation.Notify(); !ok {
+		return &ValidationError{Name: "notify", err: errors.New(`ent: missing required field "SavedSearch.notify"`)}
+	}
+	if _, ok := ssc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "SavedSearch.user"`)}
+	}
+	return nil
+}
+
+func (ssc *SavedSearchCreate) sqlSave(ctx context.Context) (*SavedSearch, error) {
+	if err := ssc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ssc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ssc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ssc.mutation.id = &_node.ID
+	ssc.mutation.done = true
+	return _node, nil
+}
+
+func (ssc *SavedSearchCreate) createSpec() (*SavedSearch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SavedSearch{config: ssc.config}
+		_spec = sqlgraph.NewCreateSpec(savedsearch.Table, sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt))
+	)
+
+	if id, ok := ssc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = ssc.conflict
+	if value, ok := ssc.mutation.CreatedAt(); ok {
+		_spec.SetField(savedsearch.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ssc.mutation.UpdatedAt(); ok {
+		_spec.SetField(savedsearch.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ssc.mutation.DeletedAt(); ok {
+		_spec.SetField(savedsearch.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := ssc.mutation.Name(); ok {
+		_spec.SetField(savedsearch.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ssc.mutation.URI(); ok {
+		_spec.SetField(savedsearch.FieldURI, field.TypeString, value)
+		_node.URI = value
+	}
+	if value, ok := ssc.mutation.Notify(); ok {
+		_spec.SetField(savedsearch.FieldNotify, field.TypeBool, value)
+		_node.Notify = value
+	}
+	if value, ok := ssc.mutation.LastCheckedAt(); ok {
+		_spec.SetField(savedsearch.FieldLastCheckedAt, field.TypeTime, value)
+		_node.LastCheckedAt = &value
+	}
+	if nodes := ssc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.UserTable,
+			Columns: []string{savedsearch.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SavedSearch.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SavedSearchUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (ssc *SavedSearchCreate) OnConflict(opts ...sql.ConflictOption) *SavedSearchUpsertOne {
+	ssc.conflict = opts
+	return &SavedSearchUpsertOne{
+		create: ssc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SavedSearch.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ssc *SavedSearchCreate) OnConflictColumns(columns ...string) *SavedSearchUpsertOne {
+	ssc.conflict = append(ssc.conflict, sql.ConflictColumns(columns...))
+	return &SavedSearchUpsertOne{
+		create: ssc,
+	}
+}
+
+type (
+	// SavedSearchUpsertOne is the builder for "upsert"-ing
+	//  one SavedSearch node.
+	SavedSearchUpsertOne struct {
+		create *SavedSearchCreate
+	}
+
+	// SavedSearchUpsert is the "OnConflict" setter.
+	SavedSearchUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SavedSearchUpsert) SetUpdatedAt(v time.Time) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateUpdatedAt() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *SavedSearchUpsert) SetDeletedAt(v time.Time) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateDeletedAt() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *SavedSearchUpsert) ClearDeletedAt() *SavedSearchUpsert {
+	u.SetNull(savedsearch.FieldDeletedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *SavedSearchUpsert) SetUserID(v int) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateUserID() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SavedSearchUpsert) SetName(v string) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateName() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldName)
+	return u
+}
+
+// SetURI sets the "uri" field.
+func (u *SavedSearchUpsert) SetURI(v string) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldURI, v)
+	return u
+}
+
+// UpdateURI sets the "uri" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateURI() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldURI)
+	return u
+}
+
+// SetNotify sets the "notify" field.
+func (u *SavedSearchUpsert) SetNotify(v bool) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldNotify, v)
+	return u
+}
+
+// UpdateNotify sets the "notify" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateNotify() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldNotify)
+	return u
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (u *SavedSearchUpsert) SetLastCheckedAt(v time.Time) *SavedSearchUpsert {
+	u.Set(savedsearch.FieldLastCheckedAt, v)
+	return u
+}
+
+// UpdateLastCheckedAt sets the "last_checked_at" field to the value that was provided on create.
+func (u *SavedSearchUpsert) UpdateLastCheckedAt() *SavedSearchUpsert {
+	u.SetExcluded(savedsearch.FieldLastCheckedAt)
+	return u
+}
+
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (u *SavedSearchUpsert) ClearLastCheckedAt() *SavedSearchUpsert {
+	u.SetNull(savedsearch.FieldLastCheckedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SavedSearch.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SavedSearchUpsertOne) UpdateNewValues() *SavedSearchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(savedsearch.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SavedSearch.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SavedSearchUpsertOne) Ignore() *SavedSearchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SavedSearchUpsertOne) DoNothing() *SavedSearchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SavedSearchCreate.OnConflict
+// documentation for more info.
+func (u *SavedSearchUpsertOne) Update(set func(*SavedSearchUpsert)) *SavedSearchUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SavedSearchUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SavedSearchUpsertOne) SetUpdatedAt(v time.Time) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateUpdatedAt() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *SavedSearchUpsertOne) SetDeletedAt(v time.Time) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateDeletedAt() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *SavedSearchUpsertOne) ClearDeletedAt() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *SavedSearchUpsertOne) SetUserID(v int) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateUserID() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SavedSearchUpsertOne) SetName(v string) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateName() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetURI sets the "uri" field.
+func (u *SavedSearchUpsertOne) SetURI(v string) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetURI(v)
+	})
+}
+
+// UpdateURI sets the "uri" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateURI() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateURI()
+	})
+}
+
+// SetNotify sets the "notify" field.
+func (u *SavedSearchUpsertOne) SetNotify(v bool) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetNotify(v)
+	})
+}
+
+// UpdateNotify sets the "notify" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateNotify() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateNotify()
+	})
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (u *SavedSearchUpsertOne) SetLastCheckedAt(v time.Time) *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetLastCheckedAt(v)
+	})
+}
+
+// UpdateLastCheckedAt sets the "last_checked_at" field to the value that was provided on create.
+func (u *SavedSearchUpsertOne) UpdateLastCheckedAt() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateLastCheckedAt()
+	})
+}
+
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (u *SavedSearchUpsertOne) ClearLastCheckedAt() *SavedSearchUpsertOne {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.ClearLastCheckedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SavedSearchUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SavedSearchCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SavedSearchUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SavedSearchUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SavedSearchUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *SavedSearchCreate) SetRawID(t int) *SavedSearchCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// SavedSearchCreateBulk is the builder for creating many SavedSearch entities in bulk.
+type SavedSearchCreateBulk struct {
+	config
+	err      error
+	builders []*SavedSearchCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SavedSearch entities in the database.
+func (sscb *SavedSearchCreateBulk) Save(ctx context.Context) ([]*SavedSearch, error) {
+	if sscb.err != nil {
+		return nil, sscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sscb.builders))
+	nodes := make([]*SavedSearch, len(sscb.builders))
+	mutators := make([]Mutator, len(sscb.builders))
+	for i := range sscb.builders {
+		func(i int, root context.Context) {
+			builder := sscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SavedSearchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sscb *SavedSearchCreateBulk) SaveX(ctx context.Context) []*SavedSearch {
+	v, err := sscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sscb *SavedSearchCreateBulk) Exec(ctx context.Context) error {
+	_, err := sscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscb *SavedSearchCreateBulk) ExecX(ctx context.Context) {
+	if err := sscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SavedSearch.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SavedSearchUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sscb *SavedSearchCreateBulk) OnConflict(opts ...sql.ConflictOption) *SavedSearchUpsertBulk {
+	sscb.conflict = opts
+	return &SavedSearchUpsertBulk{
+		create: sscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SavedSearch.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sscb *SavedSearchCreateBulk) OnConflictColumns(columns ...string) *SavedSearchUpsertBulk {
+	sscb.conflict = append(sscb.conflict, sql.ConflictColumns(columns...))
+	return &SavedSearchUpsertBulk{
+		create: sscb,
+	}
+}
+
+// SavedSearchUpsertBulk is the builder for "upsert"-ing
+// a bulk of SavedSearch nodes.
+type SavedSearchUpsertBulk struct {
+	create *SavedSearchCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SavedSearch.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SavedSearchUpsertBulk) UpdateNewValues() *SavedSearchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(savedsearch.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SavedSearch.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SavedSearchUpsertBulk) Ignore() *SavedSearchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SavedSearchUpsertBulk) DoNothing() *SavedSearchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SavedSearchCreateBulk.OnConflict
+// documentation for more info.
+func (u *SavedSearchUpsertBulk) Update(set func(*SavedSearchUpsert)) *SavedSearchUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SavedSearchUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SavedSearchUpsertBulk) SetUpdatedAt(v time.Time) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateUpdatedAt() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *SavedSearchUpsertBulk) SetDeletedAt(v time.Time) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateDeletedAt() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *SavedSearchUpsertBulk) ClearDeletedAt() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *SavedSearchUpsertBulk) SetUserID(v int) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateUserID() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SavedSearchUpsertBulk) SetName(v string) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateName() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetURI sets the "uri" field.
+func (u *SavedSearchUpsertBulk) SetURI(v string) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetURI(v)
+	})
+}
+
+// UpdateURI sets the "uri" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateURI() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateURI()
+	})
+}
+
+// SetNotify sets the "notify" field.
+func (u *SavedSearchUpsertBulk) SetNotify(v bool) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetNotify(v)
+	})
+}
+
+// UpdateNotify sets the "notify" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateNotify() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateNotify()
+	})
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (u *SavedSearchUpsertBulk) SetLastCheckedAt(v time.Time) *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.SetLastCheckedAt(v)
+	})
+}
+
+// UpdateLastCheckedAt sets the "last_checked_at" field to the value that was provided on create.
+func (u *SavedSearchUpsertBulk) UpdateLastCheckedAt() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.UpdateLastCheckedAt()
+	})
+}
+
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (u *SavedSearchUpsertBulk) ClearLastCheckedAt() *SavedSearchUpsertBulk {
+	return u.Update(func(s *SavedSearchUpsert) {
+		s.ClearLastCheckedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SavedSearchUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SavedSearchCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SavedSearchCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SavedSearchUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/savedsearch"
+)
+
+// SavedSearchDelete is the builder for deleting a SavedSearch entity.
+type SavedSearchDelete struct {
+	config
+	hooks    []Hook
+	mutation *SavedSearchMutation
+}
+
+// Where appends a list predicates to the SavedSearchDelete builder.
+func (ssd *SavedSearchDelete) Where(ps ...predicate.SavedSearch) *SavedSearchDelete {
+	ssd.mutation.Where(ps...)
+	return ssd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ssd *SavedSearchDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ssd.sqlExec, ssd.mutation, ssd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssd *SavedSearchDelete) ExecX(ctx context.Context) int {
+	n, err := ssd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ssd *SavedSearchDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(savedsearch.Table, sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt))
+	if ps := ssd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ssd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ssd.mutation.done = true
+	return affected, err
+}
+
+// SavedSearchDeleteOne is the builder for deleting a single SavedSearch entity.
+type SavedSearchDeleteOne struct {
+	ssd *SavedSearchDelete
+}
+
+// Where appends a list predicates to the SavedSearchDelete builder.
+func (ssdo *SavedSearchDeleteOne) Where(ps ...predicate.SavedSearch) *SavedSearchDeleteOne {
+	ssdo.ssd.mutation.Where(ps...)
+	return ssdo
+}
+
+// Exec executes the deletion query.
+func (ssdo *SavedSearchDeleteOne) Exec(ctx context.Context) error {
+	n, err := ssdo.ssd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{savedsearch.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssdo *SavedSearchDeleteOne) ExecX(ctx context.Context) {
+	if err := ssdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
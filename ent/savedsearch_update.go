@@ -0,0 +1,561 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/savedsearch"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+)
+
+// SavedSearchUpdate is the builder for updating SavedSearch entities.
+type SavedSearchUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SavedSearchMutation
+}
+
+// Where appends a list predicates to the SavedSearchUpdate builder.
+func (ssu *SavedSearchUpdate) Where(ps ...predicate.SavedSearch) *SavedSearchUpdate {
+	ssu.mutation.Where(ps...)
+	return ssu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ssu *SavedSearchUpdate) SetUpdatedAt(t time.Time) *SavedSearchUpdate {
+	ssu.mutation.SetUpdatedAt(t)
+	return ssu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ssu *SavedSearchUpdate) SetDeletedAt(t time.Time) *SavedSearchUpdate {
+	ssu.mutation.SetDeletedAt(t)
+	return ssu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ssu *SavedSearchUpdate) SetNillableDeletedAt(t *time.Time) *SavedSearchUpdate {
+	if t != nil {
+		ssu.SetDeletedAt(*t)
+	}
+	return ssu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ssu *SavedSearchUpdate) ClearDeletedAt() *SavedSearchUpdate {
+	ssu.mutation.ClearDeletedAt()
+	return ssu
+}
+
+// SetUserID sets the "user_id" field.
+func (ssu *SavedSearchUpdate) SetUserID(i int) *SavedSearchUpdate {
+	ssu.mutation.SetUserID(i)
+	return ssu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ssu *SavedSearchUpdate) SetNillableUserID(i *int) *SavedSearchUpdate {
+	if i != nil {
+		ssu.SetUserID(*i)
+	}
+	return ssu
+}
+
+// SetName sets the "name" field.
+func (ssu *SavedSearchUpdate) SetName(s string) *SavedSearchUpdate {
+	ssu.mutation.SetName(s)
+	return ssu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ssu *SavedSearchUpdate) SetNillableName(s *string) *SavedSearchUpdate {
+	if s != nil {
+		ssu.SetName(*s)
+	}
+	return ssu
+}
+
+// SetURI sets the "uri" field.
+func (ssu *SavedSearchUpdate) SetURI(s string) *SavedSearchUpdate {
+	ssu.mutation.SetURI(s)
+	return ssu
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (ssu *SavedSearchUpdate) SetNillableURI(s *string) *SavedSearchUpdate {
+	if s != nil {
+		ssu.SetURI(*s)
+	}
+	return ssu
+}
+
+// SetNotify sets the "notify" field.
+func (ssu *SavedSearchUpdate) SetNotify(b bool) *SavedSearchUpdate {
+	ssu.mutation.SetNotify(b)
+	return ssu
+}
+
+// SetNillableNotify sets the "notify" field if the given value is not nil.
+func (ssu *SavedSearchUpdate) SetNillableNotify(b *bool) *SavedSearchUpdate {
+	if b != nil {
+		ssu.SetNotify(*b)
+	}
+	return ssu
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (ssu *SavedSearchUpdate) SetLastCheckedAt(t time.Time) *SavedSearchUpdate {
+	ssu.mutation.SetLastCheckedAt(t)
+	return ssu
+}
+
+// SetNillableLastCheckedAt sets the "last_checked_at" field if the given value is not nil.
+func (ssu *SavedSearchUpdate) SetNillableLastCheckedAt(t *time.Time) *SavedSearchUpdate {
+	if t != nil {
+		ssu.SetLastCheckedAt(*t)
+	}
+	return ssu
+}
+
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (ssu *SavedSearchUpdate) ClearLastCheckedAt() *SavedSearchUpdate {
+	ssu.mutation.ClearLastCheckedAt()
+	return ssu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ssu *SavedSearchUpdate) SetUser(u *User) *SavedSearchUpdate {
+	return ssu.SetUserID(u.ID)
+}
+
+// Mutation returns the SavedSearchMutation object of the builder.
+func (ssu *SavedSearchUpdate) Mutation() *SavedSearchMutation {
+	return ssu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ssu *SavedSearchUpdate) ClearUser() *SavedSearchUpdate {
+	ssu.mutation.ClearUser()
+	return ssu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ssu *SavedSearchUpdate) Save(ctx context.Context) (int, error) {
+	if err := ssu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, ssu.sqlSave, ssu.mutation, ssu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssu *SavedSearchUpdate) SaveX(ctx context.Context) int {
+	affected, err := ssu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ssu *SavedSearchUpdate) Exec(ctx context.Context) error {
+	_, err := ssu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssu *SavedSearchUpdate) ExecX(ctx context.Context) {
+	if err := ssu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssu *SavedSearchUpdate) defaults() error {
+	if _, ok := ssu.mutation.UpdatedAt(); !ok {
+		if savedsearch.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized savedsearch.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := savedsearch.UpdateDefaultUpdatedAt()
+		ssu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssu *SavedSearchUpdate) check() error {
+	if _, ok := ssu.mutation.UserID(); ssu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SavedSearch.user"`)
+	}
+	return nil
+}
+
+func (ssu *SavedSearchUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ssu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedsearch.Table, savedsearch.Columns, sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt))
+	if ps := ssu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssu.mutation.UpdatedAt(); ok {
+		_spec.SetField(savedsearch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ssu.mutation.DeletedAt(); ok {
+		_spec.SetField(savedsearch.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ssu.mutation.DeletedAtCleared() {
+		_spec.ClearField(savedsearch.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ssu.mutation.Name(); ok {
+		_spec.SetField(savedsearch.FieldName, field.TypeString, value)
+	}
+	if value, ok := ssu.mutation.URI(); ok {
+		_spec.SetField(savedsearch.FieldURI, field.TypeString, value)
+	}
+	if value, ok := ssu.mutation.Notify(); ok {
+		_spec.SetField(savedsearch.FieldNotify, field.TypeBool, value)
+	}
+	if value, ok := ssu.mutation.LastCheckedAt(); ok {
+		_spec.SetField(savedsearch.FieldLastCheckedAt, field.TypeTime, value)
+	}
+	if ssu.mutation.LastCheckedAtCleared() {
+		_spec.ClearField(savedsearch.FieldLastCheckedAt, field.TypeTime)
+	}
+	if ssu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.UserTable,
+			Columns: []string{savedsearch.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.UserTable,
+			Columns: []string{savedsearch.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ssu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedsearch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ssu.mutation.done = true
+	return n, nil
+}
+
+// SavedSearchUpdateOne is the builder for updating a single SavedSearch entity.
+type SavedSearchUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SavedSearchMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ssuo *SavedSearchUpdateOne) SetUpdatedAt(t time.Time) *SavedSearchUpdateOne {
+	ssuo.mutation.SetUpdatedAt(t)
+	return ssuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ssuo *SavedSearchUpdateOne) SetDeletedAt(t time.Time) *SavedSearchUpdateOne {
+	ssuo.mutation.SetDeletedAt(t)
+	return ssuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ssuo *SavedSearchUpdateOne) SetNillableDeletedAt(t *time.Time) *SavedSearchUpdateOne {
+	if t != nil {
+		ssuo.SetDeletedAt(*t)
+	}
+	return ssuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ssuo *SavedSearchUpdateOne) ClearDeletedAt() *SavedSearchUpdateOne {
+	ssuo.mutation.ClearDeletedAt()
+	return ssuo
+}
+
+// SetUserID sets the "user_id" field.
+func (ssuo *SavedSearchUpdateOne) SetUserID(i int) *SavedSearchUpdateOne {
+	ssuo.mutation.SetUserID(i)
+	return ssuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ssuo *SavedSearchUpdateOne) SetNillableUserID(i *int) *SavedSearchUpdateOne {
+	if i != nil {
+		ssuo.SetUserID(*i)
+	}
+	return ssuo
+}
+
+// SetName sets the "name" field.
+func (ssuo *SavedSearchUpdateOne) SetName(s string) *SavedSearchUpdateOne {
+	ssuo.mutation.SetName(s)
+	return ssuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ssuo *SavedSearchUpdateOne) SetNillableName(s *string) *SavedSearchUpdateOne {
+	if s != nil {
+		ssuo.SetName(*s)
+	}
+	return ssuo
+}
+
+// SetURI sets the "uri" field.
+func (ssuo *SavedSearchUpdateOne) SetURI(s string) *SavedSearchUpdateOne {
+	ssuo.mutation.SetURI(s)
+	return ssuo
+}
+
+// SetNillableURI sets the "uri" field if the given value is not nil.
+func (ssuo *SavedSearchUpdateOne) SetNillableURI(s *string) *SavedSearchUpdateOne {
+	if s != nil {
+		ssuo.SetURI(*s)
+	}
+	return ssuo
+}
+
+// SetNotify sets the "notify" field.
+func (ssuo *SavedSearchUpdateOne) SetNotify(b bool) *SavedSearchUpdateOne {
+	ssuo.mutation.SetNotify(b)
+	return ssuo
+}
+
+// SetNillableNotify sets the "notify" field if the given value is not nil.
+func (ssuo *SavedSearchUpdateOne) SetNillableNotify(b *bool) *SavedSearchUpdateOne {
+	if b != nil {
+		ssuo.SetNotify(*b)
+	}
+	return ssuo
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (ssuo *SavedSearchUpdateOne) SetLastCheckedAt(t time.Time) *SavedSearchUpdateOne {
+	ssuo.mutation.SetLastCheckedAt(t)
+	return ssuo
+}
+
+// SetNillableLastCheckedAt sets the "last_checked_at" field if the given value is not nil.
+func (ssuo *SavedSearchUpdateOne) SetNillableLastCheckedAt(t *time.Time) *SavedSearchUpdateOne {
+	if t != nil {
+		ssuo.SetLastCheckedAt(*t)
+	}
+	return ssuo
+}
+
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (ssuo *SavedSearchUpdateOne) ClearLastCheckedAt() *SavedSearchUpdateOne {
+	ssuo.mutation.ClearLastCheckedAt()
+	return ssuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ssuo *SavedSearchUpdateOne) SetUser(u *User) *SavedSearchUpdateOne {
+	return ssuo.SetUserID(u.ID)
+}
+
+// Mutation returns the SavedSearchMutation object of the builder.
+func (ssuo *SavedSearchUpdateOne) Mutation() *SavedSearchMutation {
+	return ssuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ssuo *SavedSearchUpdateOne) ClearUser() *SavedSearchUpdateOne {
+	ssuo.mutation.ClearUser()
+	return ssuo
+}
+
+// Where appends a list predicates to the SavedSearchUpdate builder.
+func (ssuo *SavedSearchUpdateOne) Where(ps ...predicate.SavedSearch) *SavedSearchUpdateOne {
+	ssuo.mutation.Where(ps...)
+	return ssuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ssuo *SavedSearchUpdateOne) Select(field string, fields ...string) *SavedSearchUpdateOne {
+	ssuo.fields = append([]string{field}, fields...)
+	return ssuo
+}
+
+// Save executes the query and returns the updated SavedSearch entity.
+func (ssuo *SavedSearchUpdateOne) Save(ctx context.Context) (*SavedSearch, error) {
+	if err := ssuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ssuo.sqlSave, ssuo.mutation, ssuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssuo *SavedSearchUpdateOne) SaveX(ctx context.Context) *SavedSearch {
+	node, err := ssuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ssuo *SavedSearchUpdateOne) Exec(ctx context.Context) error {
+	_, err := ssuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssuo *SavedSearchUpdateOne) ExecX(ctx context.Context) {
+	if err := ssuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssuo *SavedSearchUpdateOne) defaults() error {
+	if _, ok := ssuo.mutation.UpdatedAt(); !ok {
+		if savedsearch.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized savedsearch.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := savedsearch.UpdateDefaultUpdatedAt()
+		ssuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssuo *SavedSearchUpdateOne) check() error {
+	if _, ok := ssuo.mutation.UserID(); ssuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SavedSearch.user"`)
+	}
+	return nil
+}
+
+func (ssuo *SavedSearchUpdateOne) sqlSave(ctx context.Context) (_node *SavedSearch, err error) {
+	if err := ssuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedsearch.Table, savedsearch.Columns, sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt))
+	id, ok := ssuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SavedSearch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ssuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedsearch.FieldID)
+		for _, f := range fields {
+			if !savedsearch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != savedsearch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ssuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(savedsearch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ssuo.mutation.DeletedAt(); ok {
+		_spec.SetField(savedsearch.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ssuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(savedsearch.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ssuo.mutation.Name(); ok {
+		_spec.SetField(savedsearch.FieldName, field.TypeString, value)
+	}
+	if value, ok := ssuo.mutation.URI(); ok {
+		_spec.SetField(savedsearch.FieldURI, field.TypeString, value)
+	}
+	if value, ok := ssuo.mutation.Notify(); ok {
+		_spec.SetField(savedsearch.FieldNotify, field.TypeBool, value)
+	}
+	if value, ok := ssuo.mutation.LastCheckedAt(); ok {
+		_spec.SetField(savedsearch.FieldLastCheckedAt, field.TypeTime, value)
+	}
+	if ssuo.mutation.LastCheckedAtCleared() {
+		_spec.ClearField(savedsearch.FieldLastCheckedAt, field.TypeTime)
+	}
+	if ssuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.UserTable,
+			Columns: []string{savedsearch.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.UserTable,
+			Columns: []string{savedsearch.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SavedSearch{config: ssuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ssuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedsearch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ssuo.mutation.done = true
+	return _node, nil
+}
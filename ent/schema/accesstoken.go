@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AccessToken holds the schema definition for the AccessToken entity.
+type AccessToken struct {
+	ent.Schema
+}
+
+// Fields of the AccessToken.
+func (AccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("token_id").
+			Comment("Public part of the token used for lookup"),
+		field.String("name"),
+		field.String("digest").
+			Sensitive().
+			Comment("SHA-256 digest of the token secret"),
+		field.Strings("scopes"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.Time("last_used_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+	}
+}
+
+// Edges of the AccessToken.
+func (AccessToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Field("user_id").
+			Ref("access_tokens").
+			Unique().
+			Required(),
+	}
+}
+
+func (AccessToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (AccessToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "token_id").Unique(),
+	}
+}
+
+func (AccessToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
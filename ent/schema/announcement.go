@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Announcement holds the schema definition for the Announcement entity, site-wide announcements.
+type Announcement struct {
+	ent.Schema
+}
+
+// Fields of the Announcement.
+func (Announcement) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("title"),
+		// Body in markdown.
+		field.Text("body").
+			Optional(),
+		// Audience group IDs, empty means all users including anonymous.
+		field.JSON("groups", []int{}).
+			Optional(),
+		field.Time("starts_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.Time("ends_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.Bool("dismissible").
+			Default(false),
+	}
+}
+
+// Edges of the Announcement.
+func (Announcement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("dismissed_by", User.Type),
+	}
+}
+
+func (Announcement) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (Announcement) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog. Users are referenced by ID only, so that audit logs outlive deleted users.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("type"),
+		field.Int("user_id").
+			Optional(),
+		field.Int("actor_id").
+			Optional(),
+		field.String("ip").
+			Optional(),
+		field.Text("user_agent").
+			Optional(),
+		field.String("correlation_id").
+			Optional(),
+		field.String("entity_type").
+			Optional(),
+		field.Int("entity_id").
+			Optional(),
+		field.JSON("content", map[string]string{}).
+			Optional(),
+	}
+}
+
+// Edges of the AuditLog.
+func (AuditLog) Edges() []ent.Edge {
+	return nil
+}
+
+func (AuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("actor_id"),
+		index.Fields("user_id"),
+	}
+}
+
+func (AuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
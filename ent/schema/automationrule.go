@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// AutomationRule holds the schema definition for the AutomationRule entity, user defined actions applied
+// to files matching given conditions once a file event is triggered.
+type AutomationRule struct {
+	ent.Schema
+}
+
+// Fields of the AutomationRule. Trigger is the file event evaluating the rule.
+func (AutomationRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("name"),
+		field.String("trigger"),
+		field.JSON("conditions", &types.AutomationConditions{}).
+			Optional(),
+		field.JSON("actions", []types.AutomationAction{}),
+		field.Bool("enabled").
+			Default(true),
+		field.Time("last_triggered_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.Text("last_error").
+			Optional(),
+	}
+}
+
+// Edges of the AutomationRule.
+func (AutomationRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Field("user_id").
+			Ref("automation_rules").
+			Unique().
+			Required(),
+	}
+}
+
+func (AutomationRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (AutomationRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "trigger"),
+	}
+}
+
+func (AutomationRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Checkout holds the schema definition for the Checkout entity, files checked out by users for exclusive editing.
+type Checkout struct {
+	ent.Schema
+}
+
+// Fields of the Checkout. Ns and path locate the checked out file in the same way as locks, see lock.LockDetails.
+func (Checkout) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token").
+			Unique(),
+		field.String("ns"),
+		field.Text("path"),
+		field.Int("file_type"),
+		field.Bool("zero_depth").
+			Default(true),
+		field.Time("expire_at").
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.Int("owner_id"),
+	}
+}
+
+// Edges of the Checkout.
+func (Checkout) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Field("owner_id").
+			Ref("checkouts").
+			Unique().
+			Required(),
+	}
+}
+
+func (Checkout) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (Checkout) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ns"),
+		index.Fields("owner_id"),
+	}
+}
+
+func (Checkout) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
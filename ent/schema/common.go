@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -94,7 +95,8 @@ func commonFields() []ent.Field {
 			Nillable().
 			SchemaType(map[string]string{
 				dialect.MySQL: "datetime",
-			}),
+			}).
+			Annotations(entgql.Skip()),
 	}
 }
 
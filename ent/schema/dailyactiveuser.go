@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DailyActiveUser holds the schema definition for users active in a day, used to count distinct
+// active users of daily stats across flushes and nodes.
+type DailyActiveUser struct {
+	ent.Schema
+}
+
+// Fields of the DailyActiveUser.
+func (DailyActiveUser) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("date"),
+		field.Int("user_id"),
+	}
+}
+
+// Edges of the DailyActiveUser.
+func (DailyActiveUser) Edges() []ent.Edge {
+	return nil
+}
+
+func (DailyActiveUser) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (DailyActiveUser) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("date", "user_id").
+			Unique(),
+	}
+}
+
+func (DailyActiveUser) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
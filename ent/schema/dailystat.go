@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// DailyStat holds the schema definition for pre-aggregated usage statistics of a single day.
+type DailyStat struct {
+	ent.Schema
+}
+
+// Fields of the DailyStat.
+func (DailyStat) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("date").
+			Unique(),
+		// Distinct users recorded in DailyActiveUser of the day.
+		field.Int("active_user_count").
+			Default(0),
+		field.Int("uploads").
+			Default(0),
+		field.Int64("upload_size").
+			Default(0),
+		field.Int("downloads").
+			Default(0),
+		field.Int64("download_size").
+			Default(0),
+		field.JSON("file_types", map[string]*types.StatsFileType{}).
+			Optional(),
+		field.JSON("policy_storage", map[int]int64{}).
+			Optional(),
+		field.JSON("group_storage", map[int]int64{}).
+			Optional(),
+		field.JSON("top_users", []types.StatsUserStorage{}).
+			Optional(),
+	}
+}
+
+// Edges of the DailyStat.
+func (DailyStat) Edges() []ent.Edge {
+	return nil
+}
+
+func (DailyStat) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (DailyStat) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -1,7 +1,9 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -25,6 +27,17 @@ func (DavAccount) Fields() []ent.Field {
 		field.JSON("props", &types.DavAccountProps{}).
 			Optional(),
 		field.Int("owner_id"),
+		// ExpireAt is when the password expires, nil means never.
+		field.Time("expire_at").
+			Optional().
+			Nillable(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		// DisabledAt is set when the account is disabled for being unused, until its password is rotated.
+		field.Time("disabled_at").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -52,3 +65,9 @@ func (DavAccount) Mixin() []ent.Mixin {
 		CommonMixin{},
 	}
 }
+
+func (DavAccount) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
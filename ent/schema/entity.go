@@ -1,7 +1,9 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
@@ -52,3 +54,9 @@ func (Entity) Mixin() []ent.Mixin {
 		CommonMixin{},
 	}
 }
+
+func (Entity) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
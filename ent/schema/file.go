@@ -1,7 +1,9 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -16,42 +18,54 @@ type File struct {
 // Fields of the File.
 func (File) Fields() []ent.Field {
 	return []ent.Field{
-		field.Int("type"),
+		field.Int("type").
+			Annotations(entgql.Skip()),
 		field.String("name"),
-		field.Int("owner_id"),
+		field.Int("owner_id").
+			Annotations(entgql.Skip()),
 		field.Int64("size").
 			Default(0),
 		field.Int("primary_entity").
-			Optional(),
+			Optional().
+			Annotations(entgql.Skip()),
 		field.Int("file_children").
-			Optional(),
+			Optional().
+			Annotations(entgql.Skip()),
 		field.Bool("is_symbolic").
-			Default(false),
-		field.JSON("props", &types.FileProps{}).Optional(),
+			Default(false).
+			Annotations(entgql.Skip()),
+		field.JSON("props", &types.FileProps{}).Optional().
+			Annotations(entgql.Skip()),
 		field.Int("storage_policy_files").
-			Optional(),
+			Optional().
+			Annotations(entgql.Skip()),
 	}
 }
 
 // Edges of the File.
 func (File) Edges() []ent.Edge {
 	return []ent.Edge{
+		// Owners are batch loaded by GraphQL API.
 		edge.From("owner", User.Type).
 			Ref("files").
 			Field("owner_id").
 			Unique().
-			Required(),
+			Required().
+			Annotations(entgql.Skip()),
 		edge.From("storage_policies", StoragePolicy.Type).
 			Ref("files").
 			Field("storage_policy_files").
 			Unique(),
 		edge.To("children", File.Type).
+			Annotations(entgql.Skip()).
 			From("parent").
 			Field("file_children").
-			Unique(),
+			Unique().
+			Annotations(entgql.Skip()),
 		edge.To("metadata", Metadata.Type),
 		edge.To("entities", Entity.Type),
-		edge.To("shares", Share.Type),
+		edge.To("shares", Share.Type).
+			Annotations(entgql.Skip()),
 		edge.To("direct_links", DirectLink.Type),
 	}
 }
@@ -63,6 +77,16 @@ func (File) Indexes() []ent.Index {
 			Unique(),
 		index.Fields("file_children", "type", "updated_at"),
 		index.Fields("file_children", "type", "size"),
+		// Listings query folders and files separately ordered by name, the unique index above
+		// would scan all children of the folder.
+		index.Fields("file_children", "type", "name"),
+		index.Fields("owner_id", "is_symbolic"),
+	}
+}
+
+func (File) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
 	}
 }
 
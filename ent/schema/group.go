@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -19,12 +20,18 @@ func (Group) Fields() []ent.Field {
 		field.Int64("max_storage").
 			Optional(),
 		field.Int("speed_limit").
-			Optional(),
-		field.Bytes("permissions").GoType(&boolset.BooleanSet{}),
+			Optional().
+			Annotations(entgql.Skip()),
+		field.Bytes("permissions").GoType(&boolset.BooleanSet{}).
+			Annotations(entgql.Skip()),
 		field.JSON("settings", &types.GroupSetting{}).
 			Default(&types.GroupSetting{}).
-			Optional(),
-		field.Int("storage_policy_id").Optional(),
+			Optional().
+			Annotations(entgql.Skip()),
+		field.Int("storage_policy_id").Optional().
+			Annotations(entgql.Skip()),
+		field.Int("organization_id").Optional().
+			Annotations(entgql.Skip()),
 	}
 }
 
@@ -36,10 +43,15 @@ func (Group) Mixin() []ent.Mixin {
 
 func (Group) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("users", User.Type),
+		edge.To("users", User.Type).
+			Annotations(entgql.Skip()),
 		edge.From("storage_policies", StoragePolicy.Type).
 			Ref("groups").
 			Field("storage_policy_id").
 			Unique(),
+		edge.From("organization", Organization.Type).
+			Ref("groups").
+			Field("organization_id").
+			Unique(),
 	}
 }
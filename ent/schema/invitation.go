@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Invitation holds the schema definition for the Invitation entity.
+type Invitation struct {
+	ent.Schema
+}
+
+// Fields of the Invitation.
+func (Invitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("code").
+			Unique(),
+		field.String("note").
+			Optional(),
+		field.Int("group_id").
+			Optional().
+			Comment("Group of users registered with this code, 0 means default group"),
+		field.Int64("max_storage").
+			Optional().
+			Nillable().
+			Comment("Max storage override of users registered with this code"),
+		field.Int("max_uses").
+			Default(0).
+			Comment("Maximum number of registrations, 0 means unlimited"),
+		field.Int("uses").
+			Default(0),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+	}
+}
+
+// Edges of the Invitation.
+func (Invitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Field("user_id").
+			Ref("invitations").
+			Unique().
+			Required(),
+		edge.To("invitees", User.Type),
+	}
+}
+
+func (Invitation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (Invitation) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// ModerationCase holds the schema definition for the ModerationCase entity, a public share quarantined
+// by content moderation waiting for admin review.
+type ModerationCase struct {
+	ent.Schema
+}
+
+// Fields of the ModerationCase. ShareID is cleared once the share is deleted, FileID is the shared file
+// or folder.
+func (ModerationCase) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.Int("share_id").
+			Optional().
+			Nillable(),
+		field.Int("file_id"),
+		field.Enum("status").
+			Values("quarantined", "approved", "rejected").
+			Default("quarantined"),
+		field.JSON("matches", []types.ModerationMatch{}).
+			Optional(),
+		field.Int("reviewed_by").
+			Optional().
+			Nillable(),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+	}
+}
+
+// Edges of the ModerationCase.
+func (ModerationCase) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Field("user_id").
+			Ref("moderation_cases").
+			Unique().
+			Required(),
+	}
+}
+
+func (ModerationCase) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (ModerationCase) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("status"),
+	}
+}
+
+func (ModerationCase) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -1,7 +1,9 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
@@ -44,3 +46,9 @@ func (Node) Mixin() []ent.Mixin {
 		CommonMixin{},
 	}
 }
+
+func (Node) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
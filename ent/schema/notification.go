@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Notification holds the schema definition for the Notification entity, in-app notifications of users.
+type Notification struct {
+	ent.Schema
+}
+
+// Fields of the Notification. Clients render type as a translation key with props as parameters.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("type"),
+		field.JSON("props", map[string]string{}).
+			Optional(),
+		field.Text("link").
+			Optional(),
+		field.Time("read_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+	}
+}
+
+// Edges of the Notification.
+func (Notification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Field("user_id").
+			Ref("notifications").
+			Unique().
+			Required(),
+	}
+}
+
+func (Notification) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "read_at"),
+	}
+}
+
+func (Notification) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
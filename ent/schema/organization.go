@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// Organization holds the schema definition for the Organization entity, a workspace above user
+// groups. Users are members of the organization their group belongs to.
+type Organization struct {
+	ent.Schema
+}
+
+// Fields of the Organization.
+func (Organization) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.JSON("branding", &types.OrganizationBranding{}).
+			Optional(),
+	}
+}
+
+// Edges of the Organization.
+func (Organization) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("groups", Group.Type),
+		// Users delegated to manage members of the organization.
+		edge.To("admins", User.Type),
+		// Storage pool of the organization.
+		edge.To("storage_policies", StoragePolicy.Type),
+	}
+}
+
+func (Organization) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (Organization) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -1,7 +1,9 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
@@ -50,9 +52,17 @@ func (StoragePolicy) Edges() []ent.Edge {
 		edge.To("groups", Group.Type),
 		edge.To("files", File.Type),
 		edge.To("entities", Entity.Type),
+		edge.From("organizations", Organization.Type).
+			Ref("storage_policies"),
 		edge.From("node", Node.Type).
 			Ref("storage_policy").
 			Field("node_id").
 			Unique(),
 	}
 }
+
+func (StoragePolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// RetentionRule holds the schema definition for the RetentionRule entity, cleanup rules attached to a
+// folder executed by the scheduler.
+type RetentionRule struct {
+	ent.Schema
+}
+
+// Fields of the RetentionRule. Folder is the URI of the folder the rule is attached to. Files not
+// modified in MaxAgeDays days are moved to trash or deleted according to Action, versions other than
+// the latest KeepVersions ones are deleted. Zero disables the corresponding cleanup.
+func (RetentionRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.Text("folder"),
+		field.Bool("recursive").
+			Default(true),
+		field.Enum("action").
+			Values("trash", "delete").
+			Default("trash"),
+		field.Int("max_age_days").
+			Default(0),
+		field.Int("keep_versions").
+			Default(0),
+		field.Bool("enabled").
+			Default(true),
+		field.Time("last_run_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.JSON("last_result", &types.RetentionResult{}).
+			Optional(),
+	}
+}
+
+// Edges of the RetentionRule.
+func (RetentionRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Field("user_id").
+			Ref("retention_rules").
+			Unique().
+			Required(),
+	}
+}
+
+func (RetentionRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (RetentionRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+func (RetentionRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
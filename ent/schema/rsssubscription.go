@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RssSubscription holds the schema definition for the RssSubscription entity, feeds whose items are
+// downloaded automatically by remote download tasks.
+type RssSubscription struct {
+	ent.Schema
+}
+
+// Fields of the RssSubscription. Dst is the URI of folder to save downloaded files, seen holds IDs of
+// feed items already processed.
+func (RssSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("name"),
+		field.Text("url"),
+		field.String("include").
+			Optional(),
+		field.String("exclude").
+			Optional(),
+		field.Text("dst"),
+		field.Bool("enabled").
+			Default(true),
+		field.JSON("seen", []string{}).
+			Optional(),
+		field.Time("last_checked_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.Text("last_error").
+			Optional(),
+	}
+}
+
+// Edges of the RssSubscription.
+func (RssSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Field("user_id").
+			Ref("rss_subscriptions").
+			Unique().
+			Required(),
+	}
+}
+
+func (RssSubscription) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (RssSubscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("enabled"),
+	}
+}
+
+func (RssSubscription) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// S3AccessKey holds the schema definition for the S3AccessKey entity, credentials of the S3 gateway.
+type S3AccessKey struct {
+	ent.Schema
+}
+
+// Fields of the S3AccessKey.
+func (S3AccessKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("name"),
+		field.String("access_key_id").
+			Comment("Public part of the key used for lookup"),
+		// SigV4 requires the plain secret to verify signatures.
+		field.String("secret").
+			Sensitive(),
+		field.Bool("read_only").
+			Default(false),
+		field.Time("last_used_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+	}
+}
+
+// Edges of the S3AccessKey.
+func (S3AccessKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Field("user_id").
+			Ref("s3_access_keys").
+			Unique().
+			Required(),
+	}
+}
+
+func (S3AccessKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (S3AccessKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("access_key_id").
+			Unique(),
+		index.Fields("user_id"),
+	}
+}
+
+func (S3AccessKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SavedSearch holds the schema definition for the SavedSearch entity, search queries saved by users.
+type SavedSearch struct {
+	ent.Schema
+}
+
+// Fields of the SavedSearch. Uri is a file URI with search parameters in its query.
+func (SavedSearch) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("name"),
+		field.Text("uri"),
+		field.Bool("notify").
+			Default(false),
+		field.Time("last_checked_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+	}
+}
+
+// Edges of the SavedSearch.
+func (SavedSearch) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Field("user_id").
+			Ref("saved_searches").
+			Unique().
+			Required(),
+	}
+}
+
+func (SavedSearch) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (SavedSearch) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("notify"),
+	}
+}
+
+func (SavedSearch) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -1,10 +1,13 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Share holds the schema definition for the Share entity.
@@ -16,7 +19,8 @@ type Share struct {
 func (Share) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("password").
-			Optional(),
+			Optional().
+			Annotations(entgql.Skip()),
 		field.Int("views").
 			Default(0),
 		field.Int("downloads").
@@ -30,6 +34,14 @@ func (Share) Fields() []ent.Field {
 		field.Int("remain_downloads").
 			Nillable().
 			Optional(),
+		// Quarantined shares are disabled by content moderation until reviewed by admin.
+		field.Bool("quarantined").
+			Default(false),
+		// Recipients are lower case emails of users allowed to access the share, matched against their
+		// primary and verified secondary emails. Shares without recipients are open to anyone with the link.
+		field.Strings("recipients").
+			Optional().
+			Annotations(entgql.Skip()),
 	}
 }
 
@@ -37,12 +49,26 @@ func (Share) Fields() []ent.Field {
 func (Share) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("user", User.Type).
-			Ref("shares").Unique(),
+			Ref("shares").Unique().
+			Annotations(entgql.MapsTo("owner")),
 		edge.From("file", File.Type).
 			Ref("shares").Unique(),
 	}
 }
 
+// Indexes of the Share.
+func (Share) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("file"),
+	}
+}
+
+func (Share) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+	}
+}
+
 func (Share) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		CommonMixin{},
@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// SyncJob holds the schema definition for the SyncJob entity, folders mirrored one-way to another folder
+// on a schedule.
+type SyncJob struct {
+	ent.Schema
+}
+
+// Fields of the SyncJob. Src and Dst are URIs of the source and destination folder, Interval is the
+// number of minutes between runs. TaskID is the ID of the task of latest run.
+func (SyncJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("name"),
+		field.Text("src"),
+		field.Text("dst"),
+		field.Int("interval"),
+		field.Enum("compare").
+			Values("mtime", "hash").
+			Default("mtime"),
+		field.Bool("propagate_delete").
+			Default(false),
+		field.Bool("enabled").
+			Default(true),
+		field.Time("next_run_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.Int("task_id").
+			Optional().
+			Nillable(),
+		field.Time("last_run_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.JSON("last_report", &types.SyncReport{}).
+			Optional(),
+	}
+}
+
+// Edges of the SyncJob.
+func (SyncJob) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Field("user_id").
+			Ref("sync_jobs").
+			Unique().
+			Required(),
+	}
+}
+
+func (SyncJob) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (SyncJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("enabled", "next_run_at"),
+	}
+}
+
+func (SyncJob) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -1,7 +1,9 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
@@ -19,23 +21,48 @@ func (Task) Fields() []ent.Field {
 		field.String("type"),
 		field.Enum("status").
 			Values("queued", "processing", "suspending", "error", "canceled", "completed").
-			Default("queued"),
-		field.JSON("public_state", &types.TaskPublicState{}),
-		field.Text("private_state").Optional(),
+			Default("queued").
+			Annotations(entgql.Type("TaskStatus")),
+		field.JSON("public_state", &types.TaskPublicState{}).
+			Annotations(entgql.Skip()),
+		field.Text("private_state").Optional().
+			Annotations(entgql.Skip()),
 		field.UUID("correlation_id", uuid.Must(uuid.NewV4())).
 			Optional().
-			Immutable(),
-		field.Int("user_tasks").Optional(),
+			Immutable().
+			Annotations(entgql.Skip()),
+		field.Int("user_tasks").Optional().
+			Annotations(entgql.Skip()),
+		// IdempotencyKey deduplicates submissions of the same Task, unset for most tasks.
+		field.String("idempotency_key").
+			Optional().
+			Nillable().
+			Unique().
+			Annotations(entgql.Skip()),
+		// DeadLetteredAt is set when the Task failed after exhausting all retries, kept for inspection
+		// and replay until purged.
+		field.Time("dead_lettered_at").
+			Optional().
+			Nillable().
+			Annotations(entgql.Skip()),
 	}
 }
 
 // Edges of the Task.
 func (Task) Edges() []ent.Edge {
 	return []ent.Edge{
+		// Owners are batch loaded by GraphQL API.
 		edge.From("user", User.Type).
 			Ref("tasks").
 			Field("user_tasks").
-			Unique(),
+			Unique().
+			Annotations(entgql.Skip()),
+	}
+}
+
+func (Task) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
 	}
 }
 
@@ -1,12 +1,18 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 )
 
+// privateField annotates GraphQL fields of User only visible to the user and admins.
+var privateField = entgql.Directives(entgql.NewDirective("private"))
+
 // User holds the schema definition for the User entity.
 type User struct {
 	ent.Schema
@@ -16,26 +22,51 @@ func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("email").
 			MaxLen(100).
-			Unique(),
+			Unique().
+			Annotations(privateField),
 		field.String("nick").
 			MaxLen(100),
 		field.String("password").
 			Optional().
 			Sensitive(),
 		field.Enum("status").
-			Values("active", "inactive", "manual_banned", "sys_banned").
-			Default("active"),
+			Values("active", "inactive", "manual_banned", "sys_banned", "archived").
+			Default("active").
+			Annotations(privateField, entgql.Type("UserStatus")),
 		field.Int64("storage").
-			Default(0),
+			Default(0).
+			Annotations(privateField),
 		field.String("two_factor_secret").
 			Sensitive().
 			Optional(),
 		field.String("avatar").
-			Optional(),
+			Optional().
+			Annotations(entgql.Skip()),
 		field.JSON("settings", &types.UserSetting{}).
 			Default(&types.UserSetting{}).
-			Optional(),
-		field.Int("group_users"),
+			Optional().
+			Annotations(entgql.Skip()),
+		field.Int("group_users").
+			Annotations(entgql.Skip()),
+		field.Int("storage_policy_override").
+			Optional().
+			Comment("Overrides the storage policy of user's group if not 0").
+			Annotations(entgql.Skip()),
+		field.Int64("max_storage_override").
+			Optional().
+			Nillable().
+			Comment("Overrides the max storage of user's group if not null").
+			Annotations(entgql.Skip()),
+		field.Time("group_expires_at").
+			Optional().
+			Nillable().
+			Comment("Copy of settings.group_expiration.expires_at, indexed for expiration lookup").
+			Annotations(entgql.Skip()),
+		field.Time("email_change_at").
+			Optional().
+			Nillable().
+			Comment("Copy of settings.pending_email.effective_at, indexed for lookup of due email changes").
+			Annotations(entgql.Skip()),
 	}
 }
 
@@ -45,14 +76,38 @@ func (User) Edges() []ent.Edge {
 			Ref("users").
 			Field("group_users").
 			Unique().
-			Required(),
-		edge.To("files", File.Type),
+			Required().
+			Annotations(privateField),
+		// Files, shares and tasks are paginated by top level queries of GraphQL API.
+		edge.To("files", File.Type).
+			Annotations(entgql.Skip()),
 		edge.To("dav_accounts", DavAccount.Type),
-		edge.To("shares", Share.Type),
+		edge.To("shares", Share.Type).
+			Annotations(entgql.Skip()),
 		edge.To("passkey", Passkey.Type),
-		edge.To("tasks", Task.Type),
+		edge.To("tasks", Task.Type).
+			Annotations(entgql.Skip()),
 		edge.To("entities", Entity.Type),
 		edge.To("view_preferences", ViewPreference.Type),
+		edge.To("access_tokens", AccessToken.Type),
+		edge.To("invitations", Invitation.Type),
+		edge.To("emails", UserEmail.Type),
+		edge.To("notifications", Notification.Type),
+		edge.To("saved_searches", SavedSearch.Type),
+		edge.To("rss_subscriptions", RssSubscription.Type),
+		edge.To("automation_rules", AutomationRule.Type),
+		edge.To("sync_jobs", SyncJob.Type),
+		edge.To("retention_rules", RetentionRule.Type),
+		edge.To("moderation_cases", ModerationCase.Type),
+		edge.To("s3_access_keys", S3AccessKey.Type),
+		edge.To("checkouts", Checkout.Type),
+		edge.From("dismissed_announcements", Announcement.Type).
+			Ref("dismissed_by"),
+		edge.From("admin_organizations", Organization.Type).
+			Ref("admins"),
+		edge.From("invitation", Invitation.Type).
+			Ref("invitees").
+			Unique(),
 	}
 }
 
@@ -61,3 +116,16 @@ func (User) Mixin() []ent.Mixin {
 		CommonMixin{},
 	}
 }
+
+func (User) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+	}
+}
+
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_expires_at"),
+		index.Fields("email_change_at"),
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserEmail holds the schema definition for the UserEmail entity, secondary email addresses of users.
+// Verified ones resolve to their owner in UserClient.GetByEmail, the same as the primary address.
+type UserEmail struct {
+	ent.Schema
+}
+
+// Fields of the UserEmail. Email is stored in lower case.
+func (UserEmail) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("email"),
+		field.Time("verified_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+		field.String("confirm_digest").
+			Optional().
+			Sensitive(),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+	}
+}
+
+// Edges of the UserEmail.
+func (UserEmail) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Field("user_id").
+			Ref("emails").
+			Unique().
+			Required(),
+	}
+}
+
+func (UserEmail) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (UserEmail) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email").Unique(),
+		index.Fields("user_id"),
+	}
+}
+
+func (UserEmail) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
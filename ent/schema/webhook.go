@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Webhook holds the schema definition for the Webhook entity, endpoints receiving site events.
+type Webhook struct {
+	ent.Schema
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Text("url"),
+		// Secret used to sign payloads, empty to send unsigned payloads.
+		field.String("secret").
+			Optional().
+			Sensitive(),
+		// Subscribed event types, empty means all events.
+		field.JSON("events", []string{}).
+			Optional(),
+		field.Bool("enabled").
+			Default(true),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
+
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (Webhook) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity, an event sent or to be
+// sent to a webhook.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("webhook_id"),
+		field.String("event"),
+		field.Text("payload"),
+		field.Enum("status").
+			Values("pending", "succeeded", "failed").
+			Default("pending"),
+		field.Int("attempts").
+			Default(0),
+		// HTTP status code of the last attempt, 0 if no response is received.
+		field.Int("response_code").
+			Optional(),
+		field.Text("error").
+			Optional(),
+		// Time of next retry for pending deliveries.
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.MySQL: "datetime",
+			}),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Field("webhook_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		CommonMixin{},
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("webhook_id"),
+	}
+}
+
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -35,6 +36,10 @@ type Share struct {
 	Expires *time.Time `json:"expires,omitempty"`
 	// RemainDownloads holds the value of the "remain_downloads" field.
 	RemainDownloads *int `json:"remain_downloads,omitempty"`
+	// Quarantined holds the value of the "quarantined" field.
+	Quarantined bool `json:"quarantined,omitempty"`
+	// Recipients holds the value of the "recipients" field.
+	Recipients []string `json:"recipients,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ShareQuery when eager-loading is set.
 	Edges        ShareEdges `json:"edges"`
@@ -52,6 +57,8 @@ type ShareEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -85,6 +92,10 @@ func (*Share) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case share.FieldRecipients:
+			values[i] = new([]byte)
+		case share.FieldQuarantined:
+			values[i] = new(sql.NullBool)
 		case share.FieldID, share.FieldViews, share.FieldDownloads, share.FieldRemainDownloads:
 			values[i] = new(sql.NullInt64)
 		case share.FieldPassword:
@@ -167,6 +178,20 @@ func (s *Share) assignValues(columns []string, values []any) error {
 				s.RemainDownloads = new(int)
 				*s.RemainDownloads = int(value.Int64)
 			}
+		case share.FieldQuarantined:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field quarantined", values[i])
+			} else if value.Valid {
+				s.Quarantined = value.Bool
+			}
+		case share.FieldRecipients:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field recipients", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Recipients); err != nil {
+					return fmt.Errorf("unmarshal field recipients: %w", err)
+				}
+			}
 		case share.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field file_shares", value)
@@ -256,6 +281,12 @@ func (s *Share) String() string {
 		builder.WriteString("remain_downloads=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("quarantined=")
+	builder.WriteString(fmt.Sprintf("%v", s.Quarantined))
+	builder.WriteString(", ")
+	builder.WriteString("recipients=")
+	builder.WriteString(fmt.Sprintf("%v", s.Recipients))
 	builder.WriteByte(')')
 	return builder.String()
 }
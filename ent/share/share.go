@@ -31,6 +31,10 @@ const (
 	FieldExpires = "expires"
 	// FieldRemainDownloads holds the string denoting the remain_downloads field in the database.
 	FieldRemainDownloads = "remain_downloads"
+	// FieldQuarantined holds the string denoting the quarantined field in the database.
+	FieldQuarantined = "quarantined"
+	// FieldRecipients holds the string denoting the recipients field in the database.
+	FieldRecipients = "recipients"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeFile holds the string denoting the file edge name in mutations.
@@ -64,6 +68,8 @@ var Columns = []string{
 	FieldDownloads,
 	FieldExpires,
 	FieldRemainDownloads,
+	FieldQuarantined,
+	FieldRecipients,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "shares"
@@ -106,6 +112,8 @@ var (
 	DefaultViews int
 	// DefaultDownloads holds the default value on creation for the "downloads" field.
 	DefaultDownloads int
+	// DefaultQuarantined holds the default value on creation for the "quarantined" field.
+	DefaultQuarantined bool
 )
 
 // OrderOption defines the ordering options for the Share queries.
@@ -156,6 +164,11 @@ func ByRemainDownloads(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRemainDownloads, opts...).ToFunc()
 }
 
+// ByQuarantined orders the results by the quarantined field.
+func ByQuarantined(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuarantined, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -95,6 +95,11 @@ func RemainDownloads(v int) predicate.Share {
 	return predicate.Share(sql.FieldEQ(FieldRemainDownloads, v))
 }
 
+// Quarantined applies equality check predicate on the "quarantined" field. It's identical to QuarantinedEQ.
+func Quarantined(v bool) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldQuarantined, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Share {
 	return predicate.Share(sql.FieldEQ(FieldCreatedAt, v))
@@ -480,6 +485,26 @@ func RemainDownloadsNotNil() predicate.Share {
 	return predicate.Share(sql.FieldNotNull(FieldRemainDownloads))
 }
 
+// QuarantinedEQ applies the EQ predicate on the "quarantined" field.
+func QuarantinedEQ(v bool) predicate.Share {
+	return predicate.Share(sql.FieldEQ(FieldQuarantined, v))
+}
+
+// QuarantinedNEQ applies the NEQ predicate on the "quarantined" field.
+func QuarantinedNEQ(v bool) predicate.Share {
+	return predicate.Share(sql.FieldNEQ(FieldQuarantined, v))
+}
+
+// RecipientsIsNil applies the IsNil predicate on the "recipients" field.
+func RecipientsIsNil() predicate.Share {
+	return predicate.Share(sql.FieldIsNull(FieldRecipients))
+}
+
+// RecipientsNotNil applies the NotNil predicate on the "recipients" field.
+func RecipientsNotNil() predicate.Share {
+	return predicate.Share(sql.FieldNotNull(FieldRecipients))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Share {
 	return predicate.Share(func(s *sql.Selector) {
@@ -136,6 +136,26 @@ func (sc *ShareCreate) SetNillableRemainDownloads(i *int) *ShareCreate {
 	return sc
 }
 
+// SetQuarantined sets the "quarantined" field.
+func (sc *ShareCreate) SetQuarantined(b bool) *ShareCreate {
+	sc.mutation.SetQuarantined(b)
+	return sc
+}
+
+// SetNillableQuarantined sets the "quarantined" field if the given value is not nil.
+func (sc *ShareCreate) SetNillableQuarantined(b *bool) *ShareCreate {
+	if b != nil {
+		sc.SetQuarantined(*b)
+	}
+	return sc
+}
+
+// SetRecipients sets the "recipients" field.
+func (sc *ShareCreate) SetRecipients(s []string) *ShareCreate {
+	sc.mutation.SetRecipients(s)
+	return sc
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (sc *ShareCreate) SetUserID(id int) *ShareCreate {
 	sc.mutation.SetUserID(id)
@@ -233,6 +253,10 @@ func (sc *ShareCreate) defaults() error {
 		v := share.DefaultDownloads
 		sc.mutation.SetDownloads(v)
 	}
+	if _, ok := sc.mutation.Quarantined(); !ok {
+		v := share.DefaultQuarantined
+		sc.mutation.SetQuarantined(v)
+	}
 	return nil
 }
 
@@ -250,6 +274,9 @@ func (sc *ShareCreate) check() error {
 	if _, ok := sc.mutation.Downloads(); !ok {
 		return &ValidationError{Name: "downloads", err: errors.New(`ent: missing required field "Share.downloads"`)}
 	}
+	if _, ok := sc.mutation.Quarantined(); !ok {
+		return &ValidationError{Name: "quarantined", err: errors.New(`ent: missing required field "Share.quarantined"`)}
+	}
 	return nil
 }
 
@@ -316,6 +343,14 @@ func (sc *ShareCreate) createSpec() (*Share, *sqlgraph.CreateSpec) {
 		_spec.SetField(share.FieldRemainDownloads, field.TypeInt, value)
 		_node.RemainDownloads = &value
 	}
+	if value, ok := sc.mutation.Quarantined(); ok {
+		_spec.SetField(share.FieldQuarantined, field.TypeBool, value)
+		_node.Quarantined = value
+	}
+	if value, ok := sc.mutation.Recipients(); ok {
+		_spec.SetField(share.FieldRecipients, field.TypeJSON, value)
+		_node.Recipients = value
+	}
 	if nodes := sc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -528,6 +563,36 @@ func (u *ShareUpsert) ClearRemainDownloads() *ShareUpsert {
 	return u
 }
 
+// SetQuarantined sets the "quarantined" field.
+func (u *ShareUpsert) SetQuarantined(v bool) *ShareUpsert {
+	u.Set(share.FieldQuarantined, v)
+	return u
+}
+
+// UpdateQuarantined sets the "quarantined" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateQuarantined() *ShareUpsert {
+	u.SetExcluded(share.FieldQuarantined)
+	return u
+}
+
+// SetRecipients sets the "recipients" field.
+func (u *ShareUpsert) SetRecipients(v []string) *ShareUpsert {
+	u.Set(share.FieldRecipients, v)
+	return u
+}
+
+// UpdateRecipients sets the "recipients" field to the value that was provided on create.
+func (u *ShareUpsert) UpdateRecipients() *ShareUpsert {
+	u.SetExcluded(share.FieldRecipients)
+	return u
+}
+
+// ClearRecipients clears the value of the "recipients" field.
+func (u *ShareUpsert) ClearRecipients() *ShareUpsert {
+	u.SetNull(share.FieldRecipients)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -720,6 +785,41 @@ func (u *ShareUpsertOne) ClearRemainDownloads() *ShareUpsertOne {
 	})
 }
 
+// SetQuarantined sets the "quarantined" field.
+func (u *ShareUpsertOne) SetQuarantined(v bool) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetQuarantined(v)
+	})
+}
+
+// UpdateQuarantined sets the "quarantined" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateQuarantined() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateQuarantined()
+	})
+}
+
+// SetRecipients sets the "recipients" field.
+func (u *ShareUpsertOne) SetRecipients(v []string) *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetRecipients(v)
+	})
+}
+
+// UpdateRecipients sets the "recipients" field to the value that was provided on create.
+func (u *ShareUpsertOne) UpdateRecipients() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateRecipients()
+	})
+}
+
+// ClearRecipients clears the value of the "recipients" field.
+func (u *ShareUpsertOne) ClearRecipients() *ShareUpsertOne {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearRecipients()
+	})
+}
+
 // Exec executes the query.
 func (u *ShareUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1083,6 +1183,41 @@ func (u *ShareUpsertBulk) ClearRemainDownloads() *ShareUpsertBulk {
 	})
 }
 
+// SetQuarantined sets the "quarantined" field.
+func (u *ShareUpsertBulk) SetQuarantined(v bool) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetQuarantined(v)
+	})
+}
+
+// UpdateQuarantined sets the "quarantined" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateQuarantined() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateQuarantined()
+	})
+}
+
+// SetRecipients sets the "recipients" field.
+func (u *ShareUpsertBulk) SetRecipients(v []string) *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.SetRecipients(v)
+	})
+}
+
+// UpdateRecipients sets the "recipients" field to the value that was provided on create.
+func (u *ShareUpsertBulk) UpdateRecipients() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.UpdateRecipients()
+	})
+}
+
+// ClearRecipients clears the value of the "recipients" field.
+func (u *ShareUpsertBulk) ClearRecipients() *ShareUpsertBulk {
+	return u.Update(func(s *ShareUpsert) {
+		s.ClearRecipients()
+	})
+}
+
 // Exec executes the query.
 func (u *ShareUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
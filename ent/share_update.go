@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
@@ -165,6 +166,38 @@ func (su *ShareUpdate) ClearRemainDownloads() *ShareUpdate {
 	return su
 }
 
+// SetQuarantined sets the "quarantined" field.
+func (su *ShareUpdate) SetQuarantined(b bool) *ShareUpdate {
+	su.mutation.SetQuarantined(b)
+	return su
+}
+
+// SetNillableQuarantined sets the "quarantined" field if the given value is not nil.
+func (su *ShareUpdate) SetNillableQuarantined(b *bool) *ShareUpdate {
+	if b != nil {
+		su.SetQuarantined(*b)
+	}
+	return su
+}
+
+// SetRecipients sets the "recipients" field.
+func (su *ShareUpdate) SetRecipients(s []string) *ShareUpdate {
+	su.mutation.SetRecipients(s)
+	return su
+}
+
+// AppendRecipients appends s to the "recipients" field.
+func (su *ShareUpdate) AppendRecipients(s []string) *ShareUpdate {
+	su.mutation.AppendRecipients(s)
+	return su
+}
+
+// ClearRecipients clears the value of the "recipients" field.
+func (su *ShareUpdate) ClearRecipients() *ShareUpdate {
+	su.mutation.ClearRecipients()
+	return su
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (su *ShareUpdate) SetUserID(id int) *ShareUpdate {
 	su.mutation.SetUserID(id)
@@ -313,6 +346,20 @@ func (su *ShareUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.RemainDownloadsCleared() {
 		_spec.ClearField(share.FieldRemainDownloads, field.TypeInt)
 	}
+	if value, ok := su.mutation.Quarantined(); ok {
+		_spec.SetField(share.FieldQuarantined, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.Recipients(); ok {
+		_spec.SetField(share.FieldRecipients, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedRecipients(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, share.FieldRecipients, value)
+		})
+	}
+	if su.mutation.RecipientsCleared() {
+		_spec.ClearField(share.FieldRecipients, field.TypeJSON)
+	}
 	if su.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -526,6 +573,38 @@ func (suo *ShareUpdateOne) ClearRemainDownloads() *ShareUpdateOne {
 	return suo
 }
 
+// SetQuarantined sets the "quarantined" field.
+func (suo *ShareUpdateOne) SetQuarantined(b bool) *ShareUpdateOne {
+	suo.mutation.SetQuarantined(b)
+	return suo
+}
+
+// SetNillableQuarantined sets the "quarantined" field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableQuarantined(b *bool) *ShareUpdateOne {
+	if b != nil {
+		suo.SetQuarantined(*b)
+	}
+	return suo
+}
+
+// SetRecipients sets the "recipients" field.
+func (suo *ShareUpdateOne) SetRecipients(s []string) *ShareUpdateOne {
+	suo.mutation.SetRecipients(s)
+	return suo
+}
+
+// AppendRecipients appends s to the "recipients" field.
+func (suo *ShareUpdateOne) AppendRecipients(s []string) *ShareUpdateOne {
+	suo.mutation.AppendRecipients(s)
+	return suo
+}
+
+// ClearRecipients clears the value of the "recipients" field.
+func (suo *ShareUpdateOne) ClearRecipients() *ShareUpdateOne {
+	suo.mutation.ClearRecipients()
+	return suo
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (suo *ShareUpdateOne) SetUserID(id int) *ShareUpdateOne {
 	suo.mutation.SetUserID(id)
@@ -704,6 +783,20 @@ func (suo *ShareUpdateOne) sqlSave(ctx context.Context) (_node *Share, err error
 	if suo.mutation.RemainDownloadsCleared() {
 		_spec.ClearField(share.FieldRemainDownloads, field.TypeInt)
 	}
+	if value, ok := suo.mutation.Quarantined(); ok {
+		_spec.SetField(share.FieldQuarantined, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.Recipients(); ok {
+		_spec.SetField(share.FieldRecipients, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedRecipients(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, share.FieldRecipients, value)
+		})
+	}
+	if suo.mutation.RecipientsCleared() {
+		_spec.ClearField(share.FieldRecipients, field.TypeJSON)
+	}
 	if suo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
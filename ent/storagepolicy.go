@@ -64,11 +64,20 @@ type StoragePolicyEdges struct {
 	Files []*File `json:"files,omitempty"`
 	// Entities holds the value of the entities edge.
 	Entities []*Entity `json:"entities,omitempty"`
+	// Organizations holds the value of the organizations edge.
+	Organizations []*Organization `json:"organizations,omitempty"`
 	// Node holds the value of the node edge.
 	Node *Node `json:"node,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedGroups        map[string][]*Group
+	namedFiles         map[string][]*File
+	namedEntities      map[string][]*Entity
+	namedOrganizations map[string][]*Organization
 }
 
 // GroupsOrErr returns the Groups value or an error if the edge
@@ -98,10 +107,19 @@ func (e StoragePolicyEdges) EntitiesOrErr() ([]*Entity, error) {
 	return nil, &NotLoadedError{edge: "entities"}
 }
 
+// OrganizationsOrErr returns the Organizations value or an error if the edge
+// was not loaded in eager-loading.
+func (e StoragePolicyEdges) OrganizationsOrErr() ([]*Organization, error) {
+	if e.loadedTypes[3] {
+		return e.Organizations, nil
+	}
+	return nil, &NotLoadedError{edge: "organizations"}
+}
+
 // NodeOrErr returns the Node value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e StoragePolicyEdges) NodeOrErr() (*Node, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		if e.Node == nil {
 			// Edge was loaded but was not found.
 			return nil, &NotFoundError{label: node.Label}
@@ -268,6 +286,11 @@ func (sp *StoragePolicy) QueryEntities() *EntityQuery {
 	return NewStoragePolicyClient(sp.config).QueryEntities(sp)
 }
 
+// QueryOrganizations queries the "organizations" edge of the StoragePolicy entity.
+func (sp *StoragePolicy) QueryOrganizations() *OrganizationQuery {
+	return NewStoragePolicyClient(sp.config).QueryOrganizations(sp)
+}
+
 // QueryNode queries the "node" edge of the StoragePolicy entity.
 func (sp *StoragePolicy) QueryNode() *NodeQuery {
 	return NewStoragePolicyClient(sp.config).QueryNode(sp)
@@ -364,10 +387,112 @@ func (e *StoragePolicy) SetEntities(v []*Entity) {
 	e.Edges.loadedTypes[2] = true
 }
 
+// SetOrganizations manually set the edge as loaded state.
+func (e *StoragePolicy) SetOrganizations(v []*Organization) {
+	e.Edges.Organizations = v
+	e.Edges.loadedTypes[3] = true
+}
+
 // SetNode manually set the edge as loaded state.
 func (e *StoragePolicy) SetNode(v *Node) {
 	e.Edges.Node = v
-	e.Edges.loadedTypes[3] = true
+	e.Edges.loadedTypes[4] = true
+}
+
+// NamedGroups returns the Groups named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (sp *StoragePolicy) NamedGroups(name string) ([]*Group, error) {
+	if sp.Edges.namedGroups == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := sp.Edges.namedGroups[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (sp *StoragePolicy) appendNamedGroups(name string, edges ...*Group) {
+	if sp.Edges.namedGroups == nil {
+		sp.Edges.namedGroups = make(map[string][]*Group)
+	}
+	if len(edges) == 0 {
+		sp.Edges.namedGroups[name] = []*Group{}
+	} else {
+		sp.Edges.namedGroups[name] = append(sp.Edges.namedGroups[name], edges...)
+	}
+}
+
+// NamedFiles returns the Files named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (sp *StoragePolicy) NamedFiles(name string) ([]*File, error) {
+	if sp.Edges.namedFiles == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := sp.Edges.namedFiles[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (sp *StoragePolicy) appendNamedFiles(name string, edges ...*File) {
+	if sp.Edges.namedFiles == nil {
+		sp.Edges.namedFiles = make(map[string][]*File)
+	}
+	if len(edges) == 0 {
+		sp.Edges.namedFiles[name] = []*File{}
+	} else {
+		sp.Edges.namedFiles[name] = append(sp.Edges.namedFiles[name], edges...)
+	}
+}
+
+// NamedEntities returns the Entities named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (sp *StoragePolicy) NamedEntities(name string) ([]*Entity, error) {
+	if sp.Edges.namedEntities == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := sp.Edges.namedEntities[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (sp *StoragePolicy) appendNamedEntities(name string, edges ...*Entity) {
+	if sp.Edges.namedEntities == nil {
+		sp.Edges.namedEntities = make(map[string][]*Entity)
+	}
+	if len(edges) == 0 {
+		sp.Edges.namedEntities[name] = []*Entity{}
+	} else {
+		sp.Edges.namedEntities[name] = append(sp.Edges.namedEntities[name], edges...)
+	}
+}
+
+// NamedOrganizations returns the Organizations named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (sp *StoragePolicy) NamedOrganizations(name string) ([]*Organization, error) {
+	if sp.Edges.namedOrganizations == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := sp.Edges.namedOrganizations[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (sp *StoragePolicy) appendNamedOrganizations(name string, edges ...*Organization) {
+	if sp.Edges.namedOrganizations == nil {
+		sp.Edges.namedOrganizations = make(map[string][]*Organization)
+	}
+	if len(edges) == 0 {
+		sp.Edges.namedOrganizations[name] = []*Organization{}
+	} else {
+		sp.Edges.namedOrganizations[name] = append(sp.Edges.namedOrganizations[name], edges...)
+	}
 }
 
 // StoragePolicies is a parsable slice of StoragePolicy.
@@ -15,6 +15,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
 	"github.com/cloudreve/Cloudreve/v4/ent/node"
+	"github.com/cloudreve/Cloudreve/v4/ent/organization"
 	"github.com/cloudreve/Cloudreve/v4/ent/storagepolicy"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 )
@@ -258,6 +259,21 @@ func (spc *StoragePolicyCreate) AddEntities(e ...*Entity) *StoragePolicyCreate {
 	return spc.AddEntityIDs(ids...)
 }
 
+// AddOrganizationIDs adds the "organizations" edge to the Organization entity by IDs.
+func (spc *StoragePolicyCreate) AddOrganizationIDs(ids ...int) *StoragePolicyCreate {
+	spc.mutation.AddOrganizationIDs(ids...)
+	return spc
+}
+
+// AddOrganizations adds the "organizations" edges to the Organization entity.
+func (spc *StoragePolicyCreate) AddOrganizations(o ...*Organization) *StoragePolicyCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return spc.AddOrganizationIDs(ids...)
+}
+
 // SetNode sets the "node" edge to the Node entity.
 func (spc *StoragePolicyCreate) SetNode(n *Node) *StoragePolicyCreate {
 	return spc.SetNodeID(n.ID)
@@ -473,6 +489,22 @@ func (spc *StoragePolicyCreate) createSpec() (*StoragePolicy, *sqlgraph.CreateSp
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := spc.mutation.OrganizationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   storagepolicy.OrganizationsTable,
+			Columns: storagepolicy.OrganizationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := spc.mutation.NodeIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -15,6 +15,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
 	"github.com/cloudreve/Cloudreve/v4/ent/node"
+	"github.com/cloudreve/Cloudreve/v4/ent/organization"
 	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
 	"github.com/cloudreve/Cloudreve/v4/ent/storagepolicy"
 )
@@ -22,14 +23,21 @@ import (
 // StoragePolicyQuery is the builder for querying StoragePolicy entities.
 type StoragePolicyQuery struct {
 	config
-	ctx          *QueryContext
-	order        []storagepolicy.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.StoragePolicy
-	withGroups   *GroupQuery
-	withFiles    *FileQuery
-	withEntities *EntityQuery
-	withNode     *NodeQuery
+	ctx                    *QueryContext
+	order                  []storagepolicy.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.StoragePolicy
+	withGroups             *GroupQuery
+	withFiles              *FileQuery
+	withEntities           *EntityQuery
+	withOrganizations      *OrganizationQuery
+	withNode               *NodeQuery
+	modifiers              []func(*sql.Selector)
+	loadTotal              []func(context.Context, []*StoragePolicy) error
+	withNamedGroups        map[string]*GroupQuery
+	withNamedFiles         map[string]*FileQuery
+	withNamedEntities      map[string]*EntityQuery
+	withNamedOrganizations map[string]*OrganizationQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -132,6 +140,28 @@ func (spq *StoragePolicyQuery) QueryEntities() *EntityQuery {
 	return query
 }
 
+// QueryOrganizations chains the current query on the "organizations" edge.
+func (spq *StoragePolicyQuery) QueryOrganizations() *OrganizationQuery {
+	query := (&OrganizationClient{config: spq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := spq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := spq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(storagepolicy.Table, storagepolicy.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, storagepolicy.OrganizationsTable, storagepolicy.OrganizationsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(spq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryNode chains the current query on the "node" edge.
 func (spq *StoragePolicyQuery) QueryNode() *NodeQuery {
 	query := (&NodeClient{config: spq.config}).Query()
@@ -341,15 +371,16 @@ func (spq *StoragePolicyQuery) Clone() *StoragePolicyQuery {
 		return nil
 	}
 	return &StoragePolicyQuery{
-		config:       spq.config,
-		ctx:          spq.ctx.Clone(),
-		order:        append([]storagepolicy.OrderOption{}, spq.order...),
-		inters:       append([]Interceptor{}, spq.inters...),
-		predicates:   append([]predicate.StoragePolicy{}, spq.predicates...),
-		withGroups:   spq.withGroups.Clone(),
-		withFiles:    spq.withFiles.Clone(),
-		withEntities: spq.withEntities.Clone(),
-		withNode:     spq.withNode.Clone(),
+		config:            spq.config,
+		ctx:               spq.ctx.Clone(),
+		order:             append([]storagepolicy.OrderOption{}, spq.order...),
+		inters:            append([]Interceptor{}, spq.inters...),
+		predicates:        append([]predicate.StoragePolicy{}, spq.predicates...),
+		withGroups:        spq.withGroups.Clone(),
+		withFiles:         spq.withFiles.Clone(),
+		withEntities:      spq.withEntities.Clone(),
+		withOrganizations: spq.withOrganizations.Clone(),
+		withNode:          spq.withNode.Clone(),
 		// clone intermediate query.
 		sql:  spq.sql.Clone(),
 		path: spq.path,
@@ -389,6 +420,17 @@ func (spq *StoragePolicyQuery) WithEntities(opts ...func(*EntityQuery)) *Storage
 	return spq
 }
 
+// WithOrganizations tells the query-builder to eager-load the nodes that are connected to
+// the "organizations" edge. The optional arguments are used to configure the query builder of the edge.
+func (spq *StoragePolicyQuery) WithOrganizations(opts ...func(*OrganizationQuery)) *StoragePolicyQuery {
+	query := (&OrganizationClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	spq.withOrganizations = query
+	return spq
+}
+
 // WithNode tells the query-builder to eager-load the nodes that are connected to
 // the "node" edge. The optional arguments are used to configure the query builder of the edge.
 func (spq *StoragePolicyQuery) WithNode(opts ...func(*NodeQuery)) *StoragePolicyQuery {
@@ -478,10 +520,11 @@ func (spq *StoragePolicyQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 	var (
 		nodes       = []*StoragePolicy{}
 		_spec       = spq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [5]bool{
 			spq.withGroups != nil,
 			spq.withFiles != nil,
 			spq.withEntities != nil,
+			spq.withOrganizations != nil,
 			spq.withNode != nil,
 		}
 	)
@@ -494,6 +537,9 @@ func (spq *StoragePolicyQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(spq.modifiers) > 0 {
+		_spec.Modifiers = spq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -524,12 +570,52 @@ func (spq *StoragePolicyQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := spq.withOrganizations; query != nil {
+		if err := spq.loadOrganizations(ctx, query, nodes,
+			func(n *StoragePolicy) { n.Edges.Organizations = []*Organization{} },
+			func(n *StoragePolicy, e *Organization) { n.Edges.Organizations = append(n.Edges.Organizations, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := spq.withNode; query != nil {
 		if err := spq.loadNode(ctx, query, nodes, nil,
 			func(n *StoragePolicy, e *Node) { n.Edges.Node = e }); err != nil {
 			return nil, err
 		}
 	}
+	for name, query := range spq.withNamedGroups {
+		if err := spq.loadGroups(ctx, query, nodes,
+			func(n *StoragePolicy) { n.appendNamedGroups(name) },
+			func(n *StoragePolicy, e *Group) { n.appendNamedGroups(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range spq.withNamedFiles {
+		if err := spq.loadFiles(ctx, query, nodes,
+			func(n *StoragePolicy) { n.appendNamedFiles(name) },
+			func(n *StoragePolicy, e *File) { n.appendNamedFiles(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range spq.withNamedEntities {
+		if err := spq.loadEntities(ctx, query, nodes,
+			func(n *StoragePolicy) { n.appendNamedEntities(name) },
+			func(n *StoragePolicy, e *Entity) { n.appendNamedEntities(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range spq.withNamedOrganizations {
+		if err := spq.loadOrganizations(ctx, query, nodes,
+			func(n *StoragePolicy) { n.appendNamedOrganizations(name) },
+			func(n *StoragePolicy, e *Organization) { n.appendNamedOrganizations(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range spq.loadTotal {
+		if err := spq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -623,6 +709,67 @@ func (spq *StoragePolicyQuery) loadEntities(ctx context.Context, query *EntityQu
 	}
 	return nil
 }
+func (spq *StoragePolicyQuery) loadOrganizations(ctx context.Context, query *OrganizationQuery, nodes []*StoragePolicy, init func(*StoragePolicy), assign func(*StoragePolicy, *Organization)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*StoragePolicy)
+	nids := make(map[int]map[*StoragePolicy]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(storagepolicy.OrganizationsTable)
+		s.Join(joinT).On(s.C(organization.FieldID), joinT.C(storagepolicy.OrganizationsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(storagepolicy.OrganizationsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(storagepolicy.OrganizationsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*StoragePolicy]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Organization](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "organizations" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 func (spq *StoragePolicyQuery) loadNode(ctx context.Context, query *NodeQuery, nodes []*StoragePolicy, init func(*StoragePolicy), assign func(*StoragePolicy, *Node)) error {
 	ids := make([]int, 0, len(nodes))
 	nodeids := make(map[int][]*StoragePolicy)
@@ -655,6 +802,9 @@ func (spq *StoragePolicyQuery) loadNode(ctx context.Context, query *NodeQuery, n
 
 func (spq *StoragePolicyQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := spq.querySpec()
+	if len(spq.modifiers) > 0 {
+		_spec.Modifiers = spq.modifiers
+	}
 	_spec.Node.Columns = spq.ctx.Fields
 	if len(spq.ctx.Fields) > 0 {
 		_spec.Unique = spq.ctx.Unique != nil && *spq.ctx.Unique
@@ -737,6 +887,62 @@ func (spq *StoragePolicyQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedGroups tells the query-builder to eager-load the nodes that are connected to the "groups"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (spq *StoragePolicyQuery) WithNamedGroups(name string, opts ...func(*GroupQuery)) *StoragePolicyQuery {
+	query := (&GroupClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if spq.withNamedGroups == nil {
+		spq.withNamedGroups = make(map[string]*GroupQuery)
+	}
+	spq.withNamedGroups[name] = query
+	return spq
+}
+
+// WithNamedFiles tells the query-builder to eager-load the nodes that are connected to the "files"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (spq *StoragePolicyQuery) WithNamedFiles(name string, opts ...func(*FileQuery)) *StoragePolicyQuery {
+	query := (&FileClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if spq.withNamedFiles == nil {
+		spq.withNamedFiles = make(map[string]*FileQuery)
+	}
+	spq.withNamedFiles[name] = query
+	return spq
+}
+
+// WithNamedEntities tells the query-builder to eager-load the nodes that are connected to the "entities"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (spq *StoragePolicyQuery) WithNamedEntities(name string, opts ...func(*EntityQuery)) *StoragePolicyQuery {
+	query := (&EntityClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if spq.withNamedEntities == nil {
+		spq.withNamedEntities = make(map[string]*EntityQuery)
+	}
+	spq.withNamedEntities[name] = query
+	return spq
+}
+
+// WithNamedOrganizations tells the query-builder to eager-load the nodes that are connected to the "organizations"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (spq *StoragePolicyQuery) WithNamedOrganizations(name string, opts ...func(*OrganizationQuery)) *StoragePolicyQuery {
+	query := (&OrganizationClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if spq.withNamedOrganizations == nil {
+		spq.withNamedOrganizations = make(map[string]*OrganizationQuery)
+	}
+	spq.withNamedOrganizations[name] = query
+	return spq
+}
+
 // StoragePolicyGroupBy is the group-by builder for StoragePolicy entities.
 type StoragePolicyGroupBy struct {
 	selector
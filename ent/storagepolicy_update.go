@@ -15,6 +15,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
 	"github.com/cloudreve/Cloudreve/v4/ent/node"
+	"github.com/cloudreve/Cloudreve/v4/ent/organization"
 	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
 	"github.com/cloudreve/Cloudreve/v4/ent/storagepolicy"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
@@ -331,6 +332,21 @@ func (spu *StoragePolicyUpdate) AddEntities(e ...*Entity) *StoragePolicyUpdate {
 	return spu.AddEntityIDs(ids...)
 }
 
+// AddOrganizationIDs adds the "organizations" edge to the Organization entity by IDs.
+func (spu *StoragePolicyUpdate) AddOrganizationIDs(ids ...int) *StoragePolicyUpdate {
+	spu.mutation.AddOrganizationIDs(ids...)
+	return spu
+}
+
+// AddOrganizations adds the "organizations" edges to the Organization entity.
+func (spu *StoragePolicyUpdate) AddOrganizations(o ...*Organization) *StoragePolicyUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return spu.AddOrganizationIDs(ids...)
+}
+
 // SetNode sets the "node" edge to the Node entity.
 func (spu *StoragePolicyUpdate) SetNode(n *Node) *StoragePolicyUpdate {
 	return spu.SetNodeID(n.ID)
@@ -404,6 +420,27 @@ func (spu *StoragePolicyUpdate) RemoveEntities(e ...*Entity) *StoragePolicyUpdat
 	return spu.RemoveEntityIDs(ids...)
 }
 
+// ClearOrganizations clears all "organizations" edges to the Organization entity.
+func (spu *StoragePolicyUpdate) ClearOrganizations() *StoragePolicyUpdate {
+	spu.mutation.ClearOrganizations()
+	return spu
+}
+
+// RemoveOrganizationIDs removes the "organizations" edge to Organization entities by IDs.
+func (spu *StoragePolicyUpdate) RemoveOrganizationIDs(ids ...int) *StoragePolicyUpdate {
+	spu.mutation.RemoveOrganizationIDs(ids...)
+	return spu
+}
+
+// RemoveOrganizations removes "organizations" edges to Organization entities.
+func (spu *StoragePolicyUpdate) RemoveOrganizations(o ...*Organization) *StoragePolicyUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return spu.RemoveOrganizationIDs(ids...)
+}
+
 // ClearNode clears the "node" edge to the Node entity.
 func (spu *StoragePolicyUpdate) ClearNode() *StoragePolicyUpdate {
 	spu.mutation.ClearNode()
@@ -668,6 +705,51 @@ func (spu *StoragePolicyUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if spu.mutation.OrganizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   storagepolicy.OrganizationsTable,
+			Columns: storagepolicy.OrganizationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.RemovedOrganizationsIDs(); len(nodes) > 0 && !spu.mutation.OrganizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   storagepolicy.OrganizationsTable,
+			Columns: storagepolicy.OrganizationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.OrganizationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   storagepolicy.OrganizationsTable,
+			Columns: storagepolicy.OrganizationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if spu.mutation.NodeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1015,6 +1097,21 @@ func (spuo *StoragePolicyUpdateOne) AddEntities(e ...*Entity) *StoragePolicyUpda
 	return spuo.AddEntityIDs(ids...)
 }
 
+// AddOrganizationIDs adds the "organizations" edge to the Organization entity by IDs.
+func (spuo *StoragePolicyUpdateOne) AddOrganizationIDs(ids ...int) *StoragePolicyUpdateOne {
+	spuo.mutation.AddOrganizationIDs(ids...)
+	return spuo
+}
+
+// AddOrganizations adds the "organizations" edges to the Organization entity.
+func (spuo *StoragePolicyUpdateOne) AddOrganizations(o ...*Organization) *StoragePolicyUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return spuo.AddOrganizationIDs(ids...)
+}
+
 // SetNode sets the "node" edge to the Node entity.
 func (spuo *StoragePolicyUpdateOne) SetNode(n *Node) *StoragePolicyUpdateOne {
 	return spuo.SetNodeID(n.ID)
@@ -1088,6 +1185,27 @@ func (spuo *StoragePolicyUpdateOne) RemoveEntities(e ...*Entity) *StoragePolicyU
 	return spuo.RemoveEntityIDs(ids...)
 }
 
+// ClearOrganizations clears all "organizations" edges to the Organization entity.
+func (spuo *StoragePolicyUpdateOne) ClearOrganizations() *StoragePolicyUpdateOne {
+	spuo.mutation.ClearOrganizations()
+	return spuo
+}
+
+// RemoveOrganizationIDs removes the "organizations" edge to Organization entities by IDs.
+func (spuo *StoragePolicyUpdateOne) RemoveOrganizationIDs(ids ...int) *StoragePolicyUpdateOne {
+	spuo.mutation.RemoveOrganizationIDs(ids...)
+	return spuo
+}
+
+// RemoveOrganizations removes "organizations" edges to Organization entities.
+func (spuo *StoragePolicyUpdateOne) RemoveOrganizations(o ...*Organization) *StoragePolicyUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return spuo.RemoveOrganizationIDs(ids...)
+}
+
 // ClearNode clears the "node" edge to the Node entity.
 func (spuo *StoragePolicyUpdateOne) ClearNode() *StoragePolicyUpdateOne {
 	spuo.mutation.ClearNode()
@@ -1382,6 +1500,51 @@ func (spuo *StoragePolicyUpdateOne) sqlSave(ctx context.Context) (_node *Storage
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if spuo.mutation.OrganizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   storagepolicy.OrganizationsTable,
+			Columns: storagepolicy.OrganizationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.RemovedOrganizationsIDs(); len(nodes) > 0 && !spuo.mutation.OrganizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   storagepolicy.OrganizationsTable,
+			Columns: storagepolicy.OrganizationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.OrganizationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   storagepolicy.OrganizationsTable,
+			Columns: storagepolicy.OrganizationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if spuo.mutation.NodeCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,319 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/syncjob"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// SyncJob is the model entity for the SyncJob schema.
+type SyncJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Src holds the value of the "src" field.
+	Src string `json:"src,omitempty"`
+	// Dst holds the value of the "dst" field.
+	Dst string `json:"dst,omitempty"`
+	// Interval holds the value of the "interval" field.
+	Interval int `json:"interval,omitempty"`
+	// Compare holds the value of the "compare" field.
+	Compare syncjob.Compare `json:"compare,omitempty"`
+	// PropagateDelete holds the value of the "propagate_delete" field.
+	PropagateDelete bool `json:"propagate_delete,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// NextRunAt holds the value of the "next_run_at" field.
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID *int `json:"task_id,omitempty"`
+	// LastRunAt holds the value of the "last_run_at" field.
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	// LastReport holds the value of the "last_report" field.
+	LastReport *types.SyncReport `json:"last_report,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SyncJobQuery when eager-loading is set.
+	Edges        SyncJobEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SyncJobEdges holds the relations/edges for other nodes in the graph.
+type SyncJobEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SyncJobEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SyncJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case syncjob.FieldLastReport:
+			values[i] = new([]byte)
+		case syncjob.FieldPropagateDelete, syncjob.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case syncjob.FieldID, syncjob.FieldUserID, syncjob.FieldInterval, syncjob.FieldTaskID:
+			values[i] = new(sql.NullInt64)
+		case syncjob.FieldName, syncjob.FieldSrc, syncjob.FieldDst, syncjob.FieldCompare:
+			values[i] = new(sql.NullString)
+		case syncjob.FieldCreatedAt, syncjob.FieldUpdatedAt, syncjob.FieldDeletedAt, syncjob.FieldNextRunAt, syncjob.FieldLastRunAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SyncJob fields.
+func (sj *SyncJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case syncjob.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sj.ID = int(value.Int64)
+		case syncjob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sj.CreatedAt = value.Time
+			}
+		case syncjob.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sj.UpdatedAt = value.Time
+			}
+		case syncjob.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				sj.DeletedAt = new(time.Time)
+				*sj.DeletedAt = value.Time
+			}
+		case syncjob.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				sj.UserID = int(value.Int64)
+			}
+		case syncjob.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sj.Name = value.String
+			}
+		case syncjob.FieldSrc:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field src", values[i])
+			} else if value.Valid {
+				sj.Src = value.String
+			}
+		case syncjob.FieldDst:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field dst", values[i])
+			} else if value.Valid {
+				sj.Dst = value.String
+			}
+		case syncjob.FieldInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field interval", values[i])
+			} else if value.Valid {
+				sj.Interval = int(value.Int64)
+			}
+		case syncjob.FieldCompare:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field compare", values[i])
+			} else if value.Valid {
+				sj.Compare = syncjob.Compare(value.String)
+			}
+		case syncjob.FieldPropagateDelete:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field propagate_delete", values[i])
+			} else if value.Valid {
+				sj.PropagateDelete = value.Bool
+			}
+		case syncjob.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				sj.Enabled = value.Bool
+			}
+		case syncjob.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				sj.NextRunAt = new(time.Time)
+				*sj.NextRunAt = value.Time
+			}
+		case syncjob.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				sj.TaskID = new(int)
+				*sj.TaskID = int(value.Int64)
+			}
+		case syncjob.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				sj.LastRunAt = new(time.Time)
+				*sj.LastRunAt = value.Time
+			}
+		case syncjob.FieldLastReport:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field last_report", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sj.LastReport); err != nil {
+					return fmt.Errorf("unmarshal field last_report: %w", err)
+				}
+			}
+		default:
+			sj.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SyncJob.
+// This includes values selected through modifiers, order, etc.
+func (sj *SyncJob) Value(name string) (ent.Value, error) {
+	return sj.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the SyncJob entity.
+func (sj *SyncJob) QueryUser() *UserQuery {
+	return NewSyncJobClient(sj.config).QueryUser(sj)
+}
+
+// Update returns a builder for updating this SyncJob.
+// Note that you need to call SyncJob.Unwrap() before calling this method if this SyncJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sj *SyncJob) Update() *SyncJobUpdateOne {
+	return NewSyncJobClient(sj.config).UpdateOne(sj)
+}
+
+// Unwrap unwraps the SyncJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sj *SyncJob) Unwrap() *SyncJob {
+	_tx, ok := sj.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SyncJob is not a transactional entity")
+	}
+	sj.config.driver = _tx.drv
+	return sj
+}
+
+// String implements the fmt.Stringer.
+func (sj *SyncJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("SyncJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sj.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sj.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sj.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := sj.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", sj.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(sj.Name)
+	builder.WriteString(", ")
+	builder.WriteString("src=")
+	builder.WriteString(sj.Src)
+	builder.WriteString(", ")
+	builder.WriteString("dst=")
+	builder.WriteString(sj.Dst)
+	builder.WriteString(", ")
+	builder.WriteString("interval=")
+	builder.WriteString(fmt.Sprintf("%v", sj.Interval))
+	builder.WriteString(", ")
+	builder.WriteString("compare=")
+	builder.WriteString(fmt.Sprintf("%v", sj.Compare))
+	builder.WriteString(", ")
+	builder.WriteString("propagate_delete=")
+	builder.WriteString(fmt.Sprintf("%v", sj.PropagateDelete))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", sj.Enabled))
+	builder.WriteString(", ")
+	if v := sj.NextRunAt; v != nil {
+		builder.WriteString("next_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := sj.TaskID; v != nil {
+		builder.WriteString("task_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := sj.LastRunAt; v != nil {
+		builder.WriteString("last_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_report=")
+	builder.WriteString(fmt.Sprintf("%v", sj.LastReport))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetUser manually set the edge as loaded state.
+func (e *SyncJob) SetUser(v *User) {
+	e.Edges.User = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// SyncJobs is a parsable slice of SyncJob.
+type SyncJobs []*SyncJob
@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package syncjob
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the syncjob type in the database.
+	Label = "sync_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSrc holds the string denoting the src field in the database.
+	FieldSrc = "src"
+	// FieldDst holds the string denoting the dst field in the database.
+	FieldDst = "dst"
+	// FieldInterval holds the string denoting the interval field in the database.
+	FieldInterval = "interval"
+	// FieldCompare holds the string denoting the compare field in the database.
+	FieldCompare = "compare"
+	// FieldPropagateDelete holds the string denoting the propagate_delete field in the database.
+	FieldPropagateDelete = "propagate_delete"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldNextRunAt holds the string denoting the next_run_at field in the database.
+	FieldNextRunAt = "next_run_at"
+	// FieldTaskID holds the string denoting the task_id field in the database.
+	FieldTaskID = "task_id"
+	// FieldLastRunAt holds the string denoting the last_run_at field in the database.
+	FieldLastRunAt = "last_run_at"
+	// FieldLastReport holds the string denoting the last_report field in the database.
+	FieldLastReport = "last_report"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the syncjob in the database.
+	Table = "sync_jobs"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "sync_jobs"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+)
+
+// Columns holds all SQL columns for syncjob fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldUserID,
+	FieldName,
+	FieldSrc,
+	FieldDst,
+	FieldInterval,
+	FieldCompare,
+	FieldPropagateDelete,
+	FieldEnabled,
+	FieldNextRunAt,
+	FieldTaskID,
+	FieldLastRunAt,
+	FieldLastReport,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultPropagateDelete holds the default value on creation for the "propagate_delete" field.
+	DefaultPropagateDelete bool
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+)
+
+// Compare defines the type for the "compare" enum field.
+type Compare string
+
+// CompareMtime is the default value of the Compare enum.
+const DefaultCompare = CompareMtime
+
+// Compare values.
+const (
+	CompareMtime Compare = "mtime"
+	CompareHash  Compare = "hash"
+)
+
+func (c Compare) String() string {
+	return string(c)
+}
+
+// CompareValidator is a validator for the "compare" field enum values. It is called by the builders before save.
+func CompareValidator(c Compare) error {
+	switch c {
+	case CompareMtime, CompareHash:
+		return nil
+	default:
+		return fmt.Errorf("syncjob: invalid enum value for compare field: %q", c)
+	}
+}
+
+// OrderOption defines the ordering options for the SyncJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// BySrc orders the results by the src field.
+func BySrc(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSrc, opts...).ToFunc()
+}
+
+// ByDst orders the results by the dst field.
+func ByDst(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDst, opts...).ToFunc()
+}
+
+// ByInterval orders the results by the interval field.
+func ByInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInterval, opts...).ToFunc()
+}
+
+// ByCompare orders the results by the compare field.
+func ByCompare(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompare, opts...).ToFunc()
+}
+
+// ByPropagateDelete orders the results by the propagate_delete field.
+func ByPropagateDelete(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPropagateDelete, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByNextRunAt orders the results by the next_run_at field.
+func ByNextRunAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextRunAt, opts...).ToFunc()
+}
+
+// ByTaskID orders the results by the task_id field.
+func ByTaskID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaskID, opts...).ToFunc()
+}
+
+// ByLastRunAt orders the results by the last_run_at field.
+func ByLastRunAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastRunAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Compare) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Compare) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Compare(str)
+	if err := CompareValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Compare", str)
+	}
+	return nil
+}
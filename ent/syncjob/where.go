@@ -0,0 +1,744 @@
+// Code generated by ent, DO NOT EDIT.
+
+package syncjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldName, v))
+}
+
+// Src applies equality check predicate on the "src" field. It's identical to SrcEQ.
+func Src(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldSrc, v))
+}
+
+// Dst applies equality check predicate on the "dst" field. It's identical to DstEQ.
+func Dst(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldDst, v))
+}
+
+// Interval applies equality check predicate on the "interval" field. It's identical to IntervalEQ.
+func Interval(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldInterval, v))
+}
+
+// PropagateDelete applies equality check predicate on the "propagate_delete" field. It's identical to PropagateDeleteEQ.
+func PropagateDelete(v bool) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldPropagateDelete, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldEnabled, v))
+}
+
+// NextRunAt applies equality check predicate on the "next_run_at" field. It's identical to NextRunAtEQ.
+func NextRunAt(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldTaskID, v))
+}
+
+// LastRunAt applies equality check predicate on the "last_run_at" field. It's identical to LastRunAtEQ.
+func LastRunAt(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContainsFold(FieldName, v))
+}
+
+// SrcEQ applies the EQ predicate on the "src" field.
+func SrcEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldSrc, v))
+}
+
+// SrcNEQ applies the NEQ predicate on the "src" field.
+func SrcNEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldSrc, v))
+}
+
+// SrcIn applies the In predicate on the "src" field.
+func SrcIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldSrc, vs...))
+}
+
+// SrcNotIn applies the NotIn predicate on the "src" field.
+func SrcNotIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldSrc, vs...))
+}
+
+// SrcGT applies the GT predicate on the "src" field.
+func SrcGT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldSrc, v))
+}
+
+// SrcGTE applies the GTE predicate on the "src" field.
+func SrcGTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldSrc, v))
+}
+
+// SrcLT applies the LT predicate on the "src" field.
+func SrcLT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldSrc, v))
+}
+
+// SrcLTE applies the LTE predicate on the "src" field.
+func SrcLTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldSrc, v))
+}
+
+// SrcContains applies the Contains predicate on the "src" field.
+func SrcContains(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContains(FieldSrc, v))
+}
+
+// SrcHasPrefix applies the HasPrefix predicate on the "src" field.
+func SrcHasPrefix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasPrefix(FieldSrc, v))
+}
+
+// SrcHasSuffix applies the HasSuffix predicate on the "src" field.
+func SrcHasSuffix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasSuffix(FieldSrc, v))
+}
+
+// SrcEqualFold applies the EqualFold predicate on the "src" field.
+func SrcEqualFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEqualFold(FieldSrc, v))
+}
+
+// SrcContainsFold applies the ContainsFold predicate on the "src" field.
+func SrcContainsFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContainsFold(FieldSrc, v))
+}
+
+// DstEQ applies the EQ predicate on the "dst" field.
+func DstEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldDst, v))
+}
+
+// DstNEQ applies the NEQ predicate on the "dst" field.
+func DstNEQ(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldDst, v))
+}
+
+// DstIn applies the In predicate on the "dst" field.
+func DstIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldDst, vs...))
+}
+
+// DstNotIn applies the NotIn predicate on the "dst" field.
+func DstNotIn(vs ...string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldDst, vs...))
+}
+
+// DstGT applies the GT predicate on the "dst" field.
+func DstGT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldDst, v))
+}
+
+// DstGTE applies the GTE predicate on the "dst" field.
+func DstGTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldDst, v))
+}
+
+// DstLT applies the LT predicate on the "dst" field.
+func DstLT(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldDst, v))
+}
+
+// DstLTE applies the LTE predicate on the "dst" field.
+func DstLTE(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldDst, v))
+}
+
+// DstContains applies the Contains predicate on the "dst" field.
+func DstContains(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContains(FieldDst, v))
+}
+
+// DstHasPrefix applies the HasPrefix predicate on the "dst" field.
+func DstHasPrefix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasPrefix(FieldDst, v))
+}
+
+// DstHasSuffix applies the HasSuffix predicate on the "dst" field.
+func DstHasSuffix(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldHasSuffix(FieldDst, v))
+}
+
+// DstEqualFold applies the EqualFold predicate on the "dst" field.
+func DstEqualFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEqualFold(FieldDst, v))
+}
+
+// DstContainsFold applies the ContainsFold predicate on the "dst" field.
+func DstContainsFold(v string) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldContainsFold(FieldDst, v))
+}
+
+// IntervalEQ applies the EQ predicate on the "interval" field.
+func IntervalEQ(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldInterval, v))
+}
+
+// IntervalNEQ applies the NEQ predicate on the "interval" field.
+func IntervalNEQ(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldInterval, v))
+}
+
+// IntervalIn applies the In predicate on the "interval" field.
+func IntervalIn(vs ...int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldInterval, vs...))
+}
+
+// IntervalNotIn applies the NotIn predicate on the "interval" field.
+func IntervalNotIn(vs ...int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldInterval, vs...))
+}
+
+// IntervalGT applies the GT predicate on the "interval" field.
+func IntervalGT(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldInterval, v))
+}
+
+// IntervalGTE applies the GTE predicate on the "interval" field.
+func IntervalGTE(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldInterval, v))
+}
+
+// IntervalLT applies the LT predicate on the "interval" field.
+func IntervalLT(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldInterval, v))
+}
+
+// IntervalLTE applies the LTE predicate on the "interval" field.
+func IntervalLTE(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldInterval, v))
+}
+
+// CompareEQ applies the EQ predicate on the "compare" field.
+func CompareEQ(v Compare) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldCompare, v))
+}
+
+// CompareNEQ applies the NEQ predicate on the "compare" field.
+func CompareNEQ(v Compare) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldCompare, v))
+}
+
+// CompareIn applies the In predicate on the "compare" field.
+func CompareIn(vs ...Compare) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldCompare, vs...))
+}
+
+// CompareNotIn applies the NotIn predicate on the "compare" field.
+func CompareNotIn(vs ...Compare) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldCompare, vs...))
+}
+
+// PropagateDeleteEQ applies the EQ predicate on the "propagate_delete" field.
+func PropagateDeleteEQ(v bool) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldPropagateDelete, v))
+}
+
+// PropagateDeleteNEQ applies the NEQ predicate on the "propagate_delete" field.
+func PropagateDeleteNEQ(v bool) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldPropagateDelete, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// NextRunAtEQ applies the EQ predicate on the "next_run_at" field.
+func NextRunAtEQ(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtNEQ applies the NEQ predicate on the "next_run_at" field.
+func NextRunAtNEQ(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtIn applies the In predicate on the "next_run_at" field.
+func NextRunAtIn(vs ...time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtNotIn applies the NotIn predicate on the "next_run_at" field.
+func NextRunAtNotIn(vs ...time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtGT applies the GT predicate on the "next_run_at" field.
+func NextRunAtGT(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldNextRunAt, v))
+}
+
+// NextRunAtGTE applies the GTE predicate on the "next_run_at" field.
+func NextRunAtGTE(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldNextRunAt, v))
+}
+
+// NextRunAtLT applies the LT predicate on the "next_run_at" field.
+func NextRunAtLT(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldNextRunAt, v))
+}
+
+// NextRunAtLTE applies the LTE predicate on the "next_run_at" field.
+func NextRunAtLTE(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldNextRunAt, v))
+}
+
+// NextRunAtIsNil applies the IsNil predicate on the "next_run_at" field.
+func NextRunAtIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldNextRunAt))
+}
+
+// NextRunAtNotNil applies the NotNil predicate on the "next_run_at" field.
+func NextRunAtNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldNextRunAt))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v int) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldTaskID, v))
+}
+
+// TaskIDIsNil applies the IsNil predicate on the "task_id" field.
+func TaskIDIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldTaskID))
+}
+
+// TaskIDNotNil applies the NotNil predicate on the "task_id" field.
+func TaskIDNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldTaskID))
+}
+
+// LastRunAtEQ applies the EQ predicate on the "last_run_at" field.
+func LastRunAtEQ(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtNEQ applies the NEQ predicate on the "last_run_at" field.
+func LastRunAtNEQ(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtIn applies the In predicate on the "last_run_at" field.
+func LastRunAtIn(vs ...time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtNotIn applies the NotIn predicate on the "last_run_at" field.
+func LastRunAtNotIn(vs ...time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtGT applies the GT predicate on the "last_run_at" field.
+func LastRunAtGT(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGT(FieldLastRunAt, v))
+}
+
+// LastRunAtGTE applies the GTE predicate on the "last_run_at" field.
+func LastRunAtGTE(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldGTE(FieldLastRunAt, v))
+}
+
+// LastRunAtLT applies the LT predicate on the "last_run_at" field.
+func LastRunAtLT(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLT(FieldLastRunAt, v))
+}
+
+// LastRunAtLTE applies the LTE predicate on the "last_run_at" field.
+func LastRunAtLTE(v time.Time) predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldLTE(FieldLastRunAt, v))
+}
+
+// LastRunAtIsNil applies the IsNil predicate on the "last_run_at" field.
+func LastRunAtIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldLastRunAt))
+}
+
+// LastRunAtNotNil applies the NotNil predicate on the "last_run_at" field.
+func LastRunAtNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldLastRunAt))
+}
+
+// LastReportIsNil applies the IsNil predicate on the "last_report" field.
+func LastReportIsNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldIsNull(FieldLastReport))
+}
+
+// LastReportNotNil applies the NotNil predicate on the "last_report" field.
+func LastReportNotNil() predicate.SyncJob {
+	return predicate.SyncJob(sql.FieldNotNull(FieldLastReport))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.SyncJob {
+	return predicate.SyncJob(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.SyncJob {
+	return predicate.SyncJob(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SyncJob) predicate.SyncJob {
+	return predicate.SyncJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SyncJob) predicate.SyncJob {
+	return predicate.SyncJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SyncJob) predicate.SyncJob {
+	return predicate.SyncJob(sql.NotPredicates(p))
+}
@@ -0,0 +1,1441 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/syncjob"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// SyncJobCreate is the builder for creating a SyncJob entity.
+type SyncJobCreate struct {
+	config
+	mutation *SyncJobMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sjc *SyncJobCreate) SetCreatedAt(t time.Time) *SyncJobCreate {
+	sjc.mutation.SetCreatedAt(t)
+	return sjc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableCreatedAt(t *time.Time) *SyncJobCreate {
+	if t != nil {
+		sjc.SetCreatedAt(*t)
+	}
+	return sjc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sjc *SyncJobCreate) SetUpdatedAt(t time.Time) *SyncJobCreate {
+	sjc.mutation.SetUpdatedAt(t)
+	return sjc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableUpdatedAt(t *time.Time) *SyncJobCreate {
+	if t != nil {
+		sjc.SetUpdatedAt(*t)
+	}
+	return sjc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sjc *SyncJobCreate) SetDeletedAt(t time.Time) *SyncJobCreate {
+	sjc.mutation.SetDeletedAt(t)
+	return sjc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableDeletedAt(t *time.Time) *SyncJobCreate {
+	if t != nil {
+		sjc.SetDeletedAt(*t)
+	}
+	return sjc
+}
+
+// SetUserID sets the "user_id" field.
+func (sjc *SyncJobCreate) SetUserID(i int) *SyncJobCreate {
+	sjc.mutation.SetUserID(i)
+	return sjc
+}
+
+// SetName sets the "name" field.
+func (sjc *SyncJobCreate) SetName(s string) *SyncJobCreate {
+	sjc.mutation.SetName(s)
+	return sjc
+}
+
+// SetSrc sets the "src" field.
+func (sjc *SyncJobCreate) SetSrc(s string) *SyncJobCreate {
+	sjc.mutation.SetSrc(s)
+	return sjc
+}
+
+// SetDst sets the "dst" field.
+func (sjc *SyncJobCreate) SetDst(s string) *SyncJobCreate {
+	sjc.mutation.SetDst(s)
+	return sjc
+}
+
+// SetInterval sets the "interval" field.
+func (sjc *SyncJobCreate) SetInterval(i int) *SyncJobCreate {
+	sjc.mutation.SetInterval(i)
+	return sjc
+}
+
+// SetCompare sets the "compare" field.
+func (sjc *SyncJobCreate) SetCompare(s syncjob.Compare) *SyncJobCreate {
+	sjc.mutation.SetCompare(s)
+	return sjc
+}
+
+// SetNillableCompare sets the "compare" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableCompare(s *syncjob.Compare) *SyncJobCreate {
+	if s != nil {
+		sjc.SetCompare(*s)
+	}
+	return sjc
+}
+
+// SetPropagateDelete sets the "propagate_delete" field.
+func (sjc *SyncJobCreate) SetPropagateDelete(b bool) *SyncJobCreate {
+	sjc.mutation.SetPropagateDelete(b)
+	return sjc
+}
+
+// SetNillablePropagateDelete sets the "propagate_delete" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillablePropagateDelete(b *bool) *SyncJobCreate {
+	if b != nil {
+		sjc.SetPropagateDelete(*b)
+	}
+	return sjc
+}
+
+// SetEnabled sets the "enabled" field.
+func (sjc *SyncJobCreate) SetEnabled(b bool) *SyncJobCreate {
+	sjc.mutation.SetEnabled(b)
+	return sjc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableEnabled(b *bool) *SyncJobCreate {
+	if b != nil {
+		sjc.SetEnabled(*b)
+	}
+	return sjc
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (sjc *SyncJobCreate) SetNextRunAt(t time.Time) *SyncJobCreate {
+	sjc.mutation.SetNextRunAt(t)
+	return sjc
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableNextRunAt(t *time.Time) *SyncJobCreate {
+	if t != nil {
+		sjc.SetNextRunAt(*t)
+	}
+	return sjc
+}
+
+// SetTaskID sets the "task_id" field.
+func (sjc *SyncJobCreate) SetTaskID(i int) *SyncJobCreate {
+	sjc.mutation.SetTaskID(i)
+	return sjc
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableTaskID(i *int) *SyncJobCreate {
+	if i != nil {
+		sjc.SetTaskID(*i)
+	}
+	return sjc
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (sjc *SyncJobCreate) SetLastRunAt(t time.Time) *SyncJobCreate {
+	sjc.mutation.SetLastRunAt(t)
+	return sjc
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (sjc *SyncJobCreate) SetNillableLastRunAt(t *time.Time) *SyncJobCreate {
+	if t != nil {
+		sjc.SetLastRunAt(*t)
+	}
+	return sjc
+}
+
+// SetLastReport sets the "last_report" field.
+func (sjc *SyncJobCreate) SetLastReport(tr *types.SyncReport) *SyncJobCreate {
+	sjc.mutation.SetLastReport(tr)
+	return sjc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sjc *SyncJobCreate) SetUser(u *User) *SyncJobCreate {
+	return sjc.SetUserID(u.ID)
+}
+
+// Mutation returns the SyncJobMutation object of the builder.
+func (sjc *SyncJobCreate) Mutation() *SyncJobMutation {
+	return sjc.mutation
+}
+
+// Save creates the SyncJob in the database.
+func (sjc *SyncJobCreate) Save(ctx context.Context) (*SyncJob, error) {
+	if err := sjc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, sjc.sqlSave, sjc.mutation, sjc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sjc *SyncJobCreate) SaveX(ctx context.Context) *SyncJob {
+	v, err := sjc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sjc *SyncJobCreate) Exec(ctx context.Context) error {
+	_, err := sjc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sjc *SyncJobCreate) ExecX(ctx context.Context) {
+	if err := sjc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sjc *SyncJobCreate) defaults() error {
+	if _, ok := sjc.mutation.CreatedAt(); !ok {
+		if syncjob.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized syncjob.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := syncjob.DefaultCreatedAt()
+		sjc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sjc.mutation.UpdatedAt(); !ok {
+		if syncjob.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized syncjob.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := syncjob.DefaultUpdatedAt()
+		sjc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sjc.mutation.Compare(); !ok {
+		v := syncjob.DefaultCompare
+		sjc.mutation.SetCompare(v)
+	}
+	if _, ok := sjc.mutation.PropagateDelete(); !ok {
+		v := syncjob.DefaultPropagateDelete
+		sjc.mutation.SetPropagateDelete(v)
+	}
+	if _, ok := sjc.mutation.Enabled(); !ok {
+		v := syncjob.DefaultEnabled
+		sjc.mutation.SetEnabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sjc *SyncJobCreate) check() error {
+	if _, ok := sjc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SyncJob.created_at"`)}
+	}
+	if _, ok := sjc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SyncJob.updated_at"`)}
+	}
+	if _, ok := sjc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SyncJob.user_id"`)}
+	}
+	if _, ok := sjc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SyncJob.name"`)}
+	}
+	if _, ok := sjc.mutation.Src(); !ok {
+		return &ValidationError{Name: "src", err: errors.New(`ent: missing required field "SyncJob.src"`)}
+	}
+	if _, ok := sjc.mutation.Dst(); !ok {
+		return &ValidationError{Name: "dst", err: errors.New(`ent: missing required field "SyncJob.dst"`)}
+	}
+	if _, ok := sjc.mutation.Interval(); !ok {
+		return &ValidationError{Name: "interval", err: errors.New(`ent: missing required field "SyncJob.interval"`)}
+	}
+	if _, ok := sjc.mutation.Compare(); !ok {
+		return &ValidationError{Name: "compare", err: errors.New(`ent: missing required field "SyncJob.compare"`)}
+	}
+	if v, ok := sjc.mutation.Compare(); ok {
+		if err := syncjob.CompareValidator(v); err != nil {
+			return &ValidationError{Name: "compare", err: fmt.Errorf(`ent: validator failed for field "SyncJob.compare": %w`, err)}
+		}
+	}
+	if _, ok := sjc.mutation.PropagateDelete(); !ok {
+		return &ValidationError{Name: "propagate_delete", err: errors.New(`ent: missing required field "SyncJob.propagate_delete"`)}
+	}
+	if _, ok := sjc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "SyncJob.enabled"`)}
+	}
+	if _, ok := sjc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "SyncJob.user"`)}
+	}
+	return nil
+}
+
+func (sjc *SyncJobCreate) sqlSave(ctx context.Context) (*SyncJob, error) {
+	if err := sjc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sjc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sjc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sjc.mutation.id = &_node.ID
+	sjc.mutation.done = true
+	return _node, nil
+}
+
+func (sjc *SyncJobCreate) createSpec() (*SyncJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SyncJob{config: sjc.config}
+		_spec = sqlgraph.NewCreateSpec(syncjob.Table, sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt))
+	)
+
+	if id, ok := sjc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = sjc.conflict
+	if value, ok := sjc.mutation.CreatedAt(); ok {
+		_spec.SetField(syncjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sjc.mutation.UpdatedAt(); ok {
+		_spec.SetField(syncjob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sjc.mutation.DeletedAt(); ok {
+		_spec.SetField(syncjob.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := sjc.mutation.Name(); ok {
+		_spec.SetField(syncjob.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sjc.mutation.Src(); ok {
+		_spec.SetField(syncjob.FieldSrc, field.TypeString, value)
+		_node.Src = value
+	}
+	if value, ok := sjc.mutation.Dst(); ok {
+		_spec.SetField(syncjob.FieldDst, field.TypeString, value)
+		_node.Dst = value
+	}
+	if value, ok := sjc.mutation.Interval(); ok {
+		_spec.SetField(syncjob.FieldInterval, field.TypeInt, value)
+		_node.Interval = value
+	}
+	if value, ok := sjc.mutation.Compare(); ok {
+		_spec.SetField(syncjob.FieldCompare, field.TypeEnum, value)
+		_node.Compare = value
+	}
+	if value, ok := sjc.mutation.PropagateDelete(); ok {
+		_spec.SetField(syncjob.FieldPropagateDelete, field.TypeBool, value)
+		_node.PropagateDelete = value
+	}
+	if value, ok := sjc.mutation.Enabled(); ok {
+		_spec.SetField(syncjob.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := sjc.mutation.NextRunAt(); ok {
+		_spec.SetField(syncjob.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = &value
+	}
+	if value, ok := sjc.mutation.TaskID(); ok {
+		_spec.SetField(syncjob.FieldTaskID, field.TypeInt, value)
+		_node.TaskID = &value
+	}
+	if value, ok := sjc.mutation.LastRunAt(); ok {
+		_spec.SetField(syncjob.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := sjc.mutation.LastReport(); ok {
+		_spec.SetField(syncjob.FieldLastReport, field.TypeJSON, value)
+		_node.LastReport = value
+	}
+	if nodes := sjc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   syncjob.UserTable,
+			Columns: []string{syncjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SyncJob.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SyncJobUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sjc *SyncJobCreate) OnConflict(opts ...sql.ConflictOption) *SyncJobUpsertOne {
+	sjc.conflict = opts
+	return &SyncJobUpsertOne{
+		create: sjc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SyncJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sjc *SyncJobCreate) OnConflictColumns(columns ...string) *SyncJobUpsertOne {
+	sjc.conflict = append(sjc.conflict, sql.ConflictColumns(columns...))
+	return &SyncJobUpsertOne{
+		create: sjc,
+	}
+}
+
+type (
+	// SyncJobUpsertOne is the builder for "upsert"-ing
+	//  one SyncJob node.
+	SyncJobUpsertOne struct {
+		create *SyncJobCreate
+	}
+
+	// SyncJobUpsert is the "OnConflict" setter.
+	SyncJobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SyncJobUpsert) SetUpdatedAt(v time.Time) *SyncJobUpsert {
+	u.Set(syncjob.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SyncJobUpsert) UpdateUpdatedAt() *SyncJobUpsert {
+	u.SetExcluded(syncjob.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *SyncJobUpsert) SetDeletedAt(v time.Time) *SyncJobUpsert {
+	u.Set(syncjob.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *SyncJobUpsert) UpdateDeletedAt() *SyncJobUpsert {
+	u.SetExcluded(syncjob.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *SyncJobUpsert) ClearDeletedAt() *SyncJobUpsert {
+	u.SetNull(syncjob.FieldDeletedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *SyncJobUpsert) SetUserID(v int) *SyncJobUpsert {
+	u.Set(syncjob.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *SyncJobUpsert) UpdateUserID() *SyncJobUpsert {
+	u.SetExcluded(syncjob.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SyncJobUpsert) SetName(v string) *SyncJobUpsert {
+	u.Set(syncjob.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SyncJobUpsert) UpdateName() *SyncJobUpsert {
+	u.SetExcluded(syncjob.FieldName)
+	return u
+}
+
+// SetSrc sets the "src" field.
+func (u *SyncJobUpsert) SetSrc(v string) *SyncJobUpsert {
+	u.Set(syncjob.FieldSrc, v)
+	return u
+}
+
+// UpdateSrc sets the "src" field to the value that was provided on create.
+func (u *SyncJobUpsert) UpdateSrc() *SyncJobUpsert {
+	u.SetExcluded(syncjob.FieldSrc)
+	return u
+}
+
+// SetDst sets the "dst" field.
+func (u *SyncJobUpsert) SetDst(v string) *SyncJobUpsert {
+	u.Set(syncjob.FieldDst, v)
+	return u
+}
+
+// UpdateDst sets the "dst" field to the value that was provided on create.
+func (u *SyncJobUpsert) UpdateDst() *SyncJobUpsert {
+	u.SetExcluded(syncjob.FieldDst)
+	return u
+}
+
+// SetInterval sets the "interval" field.
+func (u *SyncJobUpsert) SetInterval(v int) *SyncJobUpsert {
+	u.Set(syncjob.FieldInterval, v)
+	return u
+}
+
+// UpdateInterval sets the "interval" field to the value that was provided on create.
+func (u *SyncJobUpsert) UpdateInterval() *SyncJobUpsert {
+	u.SetExcluded(syncjob.FieldInterval)
+	return u
+}
+
+// AddInterval adds v to the "interval" field.
+func (u *SyncJobUpsert) AddInterval(v int) *SyncJobUpsert {
+	u.Add(syncjob.FieldInterval, v)
+	return u
+}
+
+// SetCompare sets the "compare" field.
+func (u *SyncJobUpsert) SetCompare(v syncjob.Compare) *SyncJobUpsert {
+	u.Set(syncjob.FieldCompare, v)
+	return u
+}
+
+// UpdateCompare sets the "compare" field to the value that was provided on create.
+func (u *SyncJobUpsert) UpdateCompare() *SyncJobUpsert {
+	u.SetExcluded(syncjob.FieldCompare)
+	return u
+}
+
+// SetPropagateDelete sets the "propagate_delete" field.
+func (u *SyncJobUpsert) SetPropagateDelete(v bool) *SyncJobUpsert {
+	u.Set(syncjob.FieldPropagateDelete, v)
+	return u
+}
+
+// UpdatePropagateDelete sets the "propagate_delete" field to the value that was provided on create.
+func (u *SyncJobUpsert) UpdatePropagateDelete() *SyncJobUpsert {
+	u.SetExcluded(syncjob.FieldPropagateDelete)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *SyncJobUpsert) SetEnabled(v bool) *SyncJobUpsert {
+	u.Set(syncjob.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *SyncJobUpsert) UpdateEnabled() *SyncJobUpsert {
+	u.SetExcluded(syncjob.FieldEnabled)
+	return u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *SyncJobUpsert) SetNextRunAt(v time.Time) *SyncJobUpsert {
+	u.Set(syncjob.FieldNextRunAt, v)
+	return u
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *SyncJobUpsert) UpdateNextRunAt() *SyncJobUpsert {
+	u.SetExcluded(syncjob.FieldNextRunAt)
+	return u
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *SyncJobUpsert) ClearNextRunAt() *SyncJobUpsert {
+	u.SetNull(syncjob.FieldNextRunAt)
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *SyncJobUpsert) SetTaskID(v int) *SyncJobUpsert {
+	u.Set(syncjob.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *SyncJobUpsert) UpdateTaskID() *SyncJobUpsert {
+	u.SetExcluded(syncjob.FieldTaskID)
+	return u
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *SyncJobUpsert) AddTaskID(v int) *SyncJobUpsert {
+	u.Add(syncjob.FieldTaskID, v)
+	return u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *SyncJobUpsert) ClearTaskID() *SyncJobUpsert {
+	u.SetNull(syncjob.FieldTaskID)
+	return u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *SyncJobUpsert) SetLastRunAt(v time.Time) *SyncJobUpsert {
+	u.Set(syncjob.FieldLastRunAt, v)
+	return u
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *SyncJobUpsert) UpdateLastRunAt() *SyncJobUpsert {
+	u.SetExcluded(syncjob.FieldLastRunAt)
+	return u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *SyncJobUpsert) ClearLastRunAt() *SyncJobUpsert {
+	u.SetNull(syncjob.FieldLastRunAt)
+	return u
+}
+
+// SetLastReport sets the "last_report" field.
+func (u *SyncJobUpsert) SetLastReport(v *types.SyncReport) *SyncJobUpsert {
+	u.Set(syncjob.FieldLastReport, v)
+	return u
+}
+
+// UpdateLastReport sets the "last_report" field to the value that was provided on create.
+func (u *SyncJobUpsert) UpdateLastReport() *SyncJobUpsert {
+	u.SetExcluded(syncjob.FieldLastReport)
+	return u
+}
+
+// ClearLastReport clears the value of the "last_report" field.
+func (u *SyncJobUpsert) ClearLastReport() *SyncJobUpsert {
+	u.SetNull(syncjob.FieldLastReport)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SyncJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SyncJobUpsertOne) UpdateNewValues() *SyncJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(syncjob.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SyncJob.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SyncJobUpsertOne) Ignore() *SyncJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SyncJobUpsertOne) DoNothing() *SyncJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SyncJobCreate.OnConflict
+// documentation for more info.
+func (u *SyncJobUpsertOne) Update(set func(*SyncJobUpsert)) *SyncJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SyncJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SyncJobUpsertOne) SetUpdatedAt(v time.Time) *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SyncJobUpsertOne) UpdateUpdatedAt() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *SyncJobUpsertOne) SetDeletedAt(v time.Time) *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *SyncJobUpsertOne) UpdateDeletedAt() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *SyncJobUpsertOne) ClearDeletedAt() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *SyncJobUpsertOne) SetUserID(v int) *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *SyncJobUpsertOne) UpdateUserID() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SyncJobUpsertOne) SetName(v string) *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SyncJobUpsertOne) UpdateName() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetSrc sets the "src" field.
+func (u *SyncJobUpsertOne) SetSrc(v string) *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetSrc(v)
+	})
+}
+
+// UpdateSrc sets the "src" field to the value that was provided on create.
+func (u *SyncJobUpsertOne) UpdateSrc() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateSrc()
+	})
+}
+
+// SetDst sets the "dst" field.
+func (u *SyncJobUpsertOne) SetDst(v string) *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetDst(v)
+	})
+}
+
+// UpdateDst sets the "dst" field to the value that was provided on create.
+func (u *SyncJobUpsertOne) UpdateDst() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateDst()
+	})
+}
+
+// SetInterval sets the "interval" field.
+func (u *SyncJobUpsertOne) SetInterval(v int) *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetInterval(v)
+	})
+}
+
+// AddInterval adds v to the "interval" field.
+func (u *SyncJobUpsertOne) AddInterval(v int) *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.AddInterval(v)
+	})
+}
+
+// UpdateInterval sets the "interval" field to the value that was provided on create.
+func (u *SyncJobUpsertOne) UpdateInterval() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateInterval()
+	})
+}
+
+// SetCompare sets the "compare" field.
+func (u *SyncJobUpsertOne) SetCompare(v syncjob.Compare) *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetCompare(v)
+	})
+}
+
+// UpdateCompare sets the "compare" field to the value that was provided on create.
+func (u *SyncJobUpsertOne) UpdateCompare() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateCompare()
+	})
+}
+
+// SetPropagateDelete sets the "propagate_delete" field.
+func (u *SyncJobUpsertOne) SetPropagateDelete(v bool) *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetPropagateDelete(v)
+	})
+}
+
+// UpdatePropagateDelete sets the "propagate_delete" field to the value that was provided on create.
+func (u *SyncJobUpsertOne) UpdatePropagateDelete() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdatePropagateDelete()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *SyncJobUpsertOne) SetEnabled(v bool) *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *SyncJobUpsertOne) UpdateEnabled() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *SyncJobUpsertOne) SetNextRunAt(v time.Time) *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *SyncJobUpsertOne) UpdateNextRunAt() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *SyncJobUpsertOne) ClearNextRunAt() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *SyncJobUpsertOne) SetTaskID(v int) *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *SyncJobUpsertOne) AddTaskID(v int) *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *SyncJobUpsertOne) UpdateTaskID() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *SyncJobUpsertOne) ClearTaskID() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *SyncJobUpsertOne) SetLastRunAt(v time.Time) *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *SyncJobUpsertOne) UpdateLastRunAt() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *SyncJobUpsertOne) ClearLastRunAt() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetLastReport sets the "last_report" field.
+func (u *SyncJobUpsertOne) SetLastReport(v *types.SyncReport) *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetLastReport(v)
+	})
+}
+
+// UpdateLastReport sets the "last_report" field to the value that was provided on create.
+func (u *SyncJobUpsertOne) UpdateLastReport() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateLastReport()
+	})
+}
+
+// ClearLastReport clears the value of the "last_report" field.
+func (u *SyncJobUpsertOne) ClearLastReport() *SyncJobUpsertOne {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.ClearLastReport()
+	})
+}
+
+// Exec executes the query.
+func (u *SyncJobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SyncJobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SyncJobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SyncJobUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SyncJobUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *SyncJobCreate) SetRawID(t int) *SyncJobCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// SyncJobCreateBulk is the builder for creating many SyncJob entities in bulk.
+type SyncJobCreateBulk struct {
+	config
+	err      error
+	builders []*SyncJobCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SyncJob entities in the database.
+func (sjcb *SyncJobCreateBulk) Save(ctx context.Context) ([]*SyncJob, error) {
+	if sjcb.err != nil {
+		return nil, sjcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sjcb.builders))
+	nodes := make([]*SyncJob, len(sjcb.builders))
+	mutators := make([]Mutator, len(sjcb.builders))
+	for i := range sjcb.builders {
+		func(i int, root context.Context) {
+			builder := sjcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SyncJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sjcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sjcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sjcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sjcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sjcb *SyncJobCreateBulk) SaveX(ctx context.Context) []*SyncJob {
+	v, err := sjcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sjcb *SyncJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := sjcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sjcb *SyncJobCreateBulk) ExecX(ctx context.Context) {
+	if err := sjcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SyncJob.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SyncJobUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (sjcb *SyncJobCreateBulk) OnConflict(opts ...sql.ConflictOption) *SyncJobUpsertBulk {
+	sjcb.conflict = opts
+	return &SyncJobUpsertBulk{
+		create: sjcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SyncJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sjcb *SyncJobCreateBulk) OnConflictColumns(columns ...string) *SyncJobUpsertBulk {
+	sjcb.conflict = append(sjcb.conflict, sql.ConflictColumns(columns...))
+	return &SyncJobUpsertBulk{
+		create: sjcb,
+	}
+}
+
+// SyncJobUpsertBulk is the builder for "upsert"-ing
+// a bulk of SyncJob nodes.
+type SyncJobUpsertBulk struct {
+	create *SyncJobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SyncJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SyncJobUpsertBulk) UpdateNewValues() *SyncJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(syncjob.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SyncJob.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SyncJobUpsertBulk) Ignore() *SyncJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SyncJobUpsertBulk) DoNothing() *SyncJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SyncJobCreateBulk.OnConflict
+// documentation for more info.
+func (u *SyncJobUpsertBulk) Update(set func(*SyncJobUpsert)) *SyncJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SyncJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SyncJobUpsertBulk) SetUpdatedAt(v time.Time) *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SyncJobUpsertBulk) UpdateUpdatedAt() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *SyncJobUpsertBulk) SetDeletedAt(v time.Time) *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *SyncJobUpsertBulk) UpdateDeletedAt() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *SyncJobUpsertBulk) ClearDeletedAt() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *SyncJobUpsertBulk) SetUserID(v int) *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *SyncJobUpsertBulk) UpdateUserID() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *SyncJobUpsertBulk) SetName(v string) *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SyncJobUpsertBulk) UpdateName() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetSrc sets the "src" field.
+func (u *SyncJobUpsertBulk) SetSrc(v string) *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetSrc(v)
+	})
+}
+
+// UpdateSrc sets the "src" field to the value that was provided on create.
+func (u *SyncJobUpsertBulk) UpdateSrc() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateSrc()
+	})
+}
+
+// SetDst sets the "dst" field.
+func (u *SyncJobUpsertBulk) SetDst(v string) *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetDst(v)
+	})
+}
+
+// UpdateDst sets the "dst" field to the value that was provided on create.
+func (u *SyncJobUpsertBulk) UpdateDst() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateDst()
+	})
+}
+
+// SetInterval sets the "interval" field.
+func (u *SyncJobUpsertBulk) SetInterval(v int) *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetInterval(v)
+	})
+}
+
+// AddInterval adds v to the "interval" field.
+func (u *SyncJobUpsertBulk) AddInterval(v int) *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.AddInterval(v)
+	})
+}
+
+// UpdateInterval sets the "interval" field to the value that was provided on create.
+func (u *SyncJobUpsertBulk) UpdateInterval() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateInterval()
+	})
+}
+
+// SetCompare sets the "compare" field.
+func (u *SyncJobUpsertBulk) SetCompare(v syncjob.Compare) *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetCompare(v)
+	})
+}
+
+// UpdateCompare sets the "compare" field to the value that was provided on create.
+func (u *SyncJobUpsertBulk) UpdateCompare() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateCompare()
+	})
+}
+
+// SetPropagateDelete sets the "propagate_delete" field.
+func (u *SyncJobUpsertBulk) SetPropagateDelete(v bool) *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetPropagateDelete(v)
+	})
+}
+
+// UpdatePropagateDelete sets the "propagate_delete" field to the value that was provided on create.
+func (u *SyncJobUpsertBulk) UpdatePropagateDelete() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdatePropagateDelete()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *SyncJobUpsertBulk) SetEnabled(v bool) *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *SyncJobUpsertBulk) UpdateEnabled() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *SyncJobUpsertBulk) SetNextRunAt(v time.Time) *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *SyncJobUpsertBulk) UpdateNextRunAt() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *SyncJobUpsertBulk) ClearNextRunAt() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *SyncJobUpsertBulk) SetTaskID(v int) *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *SyncJobUpsertBulk) AddTaskID(v int) *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *SyncJobUpsertBulk) UpdateTaskID() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *SyncJobUpsertBulk) ClearTaskID() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (u *SyncJobUpsertBulk) SetLastRunAt(v time.Time) *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetLastRunAt(v)
+	})
+}
+
+// UpdateLastRunAt sets the "last_run_at" field to the value that was provided on create.
+func (u *SyncJobUpsertBulk) UpdateLastRunAt() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateLastRunAt()
+	})
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (u *SyncJobUpsertBulk) ClearLastRunAt() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.ClearLastRunAt()
+	})
+}
+
+// SetLastReport sets the "last_report" field.
+func (u *SyncJobUpsertBulk) SetLastReport(v *types.SyncReport) *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.SetLastReport(v)
+	})
+}
+
+// UpdateLastReport sets the "last_report" field to the value that was provided on create.
+func (u *SyncJobUpsertBulk) UpdateLastReport() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.UpdateLastReport()
+	})
+}
+
+// ClearLastReport clears the value of the "last_report" field.
+func (u *SyncJobUpsertBulk) ClearLastReport() *SyncJobUpsertBulk {
+	return u.Update(func(s *SyncJobUpsert) {
+		s.ClearLastReport()
+	})
+}
+
+// Exec executes the query.
+func (u *SyncJobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SyncJobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SyncJobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SyncJobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
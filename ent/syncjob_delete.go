@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/syncjob"
+)
+
+// SyncJobDelete is the builder for deleting a SyncJob entity.
+type SyncJobDelete struct {
+	config
+	hooks    []Hook
+	mutation *SyncJobMutation
+}
+
+// Where appends a list predicates to the SyncJobDelete builder.
+func (sjd *SyncJobDelete) Where(ps ...predicate.SyncJob) *SyncJobDelete {
+	sjd.mutation.Where(ps...)
+	return sjd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sjd *SyncJobDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sjd.sqlExec, sjd.mutation, sjd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sjd *SyncJobDelete) ExecX(ctx context.Context) int {
+	n, err := sjd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sjd *SyncJobDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(syncjob.Table, sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt))
+	if ps := sjd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sjd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sjd.mutation.done = true
+	return affected, err
+}
+
+// SyncJobDeleteOne is the builder for deleting a single SyncJob entity.
+type SyncJobDeleteOne struct {
+	sjd *SyncJobDelete
+}
+
+// Where appends a list predicates to the SyncJobDelete builder.
+func (sjdo *SyncJobDeleteOne) Where(ps ...predicate.SyncJob) *SyncJobDeleteOne {
+	sjdo.sjd.mutation.Where(ps...)
+	return sjdo
+}
+
+// Exec executes the deletion query.
+func (sjdo *SyncJobDeleteOne) Exec(ctx context.Context) error {
+	n, err := sjdo.sjd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{syncjob.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sjdo *SyncJobDeleteOne) ExecX(ctx context.Context) {
+	if err := sjdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,888 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/syncjob"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// SyncJobUpdate is the builder for updating SyncJob entities.
+type SyncJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SyncJobMutation
+}
+
+// Where appends a list predicates to the SyncJobUpdate builder.
+func (sju *SyncJobUpdate) Where(ps ...predicate.SyncJob) *SyncJobUpdate {
+	sju.mutation.Where(ps...)
+	return sju
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sju *SyncJobUpdate) SetUpdatedAt(t time.Time) *SyncJobUpdate {
+	sju.mutation.SetUpdatedAt(t)
+	return sju
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sju *SyncJobUpdate) SetDeletedAt(t time.Time) *SyncJobUpdate {
+	sju.mutation.SetDeletedAt(t)
+	return sju
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableDeletedAt(t *time.Time) *SyncJobUpdate {
+	if t != nil {
+		sju.SetDeletedAt(*t)
+	}
+	return sju
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (sju *SyncJobUpdate) ClearDeletedAt() *SyncJobUpdate {
+	sju.mutation.ClearDeletedAt()
+	return sju
+}
+
+// SetUserID sets the "user_id" field.
+func (sju *SyncJobUpdate) SetUserID(i int) *SyncJobUpdate {
+	sju.mutation.SetUserID(i)
+	return sju
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableUserID(i *int) *SyncJobUpdate {
+	if i != nil {
+		sju.SetUserID(*i)
+	}
+	return sju
+}
+
+// SetName sets the "name" field.
+func (sju *SyncJobUpdate) SetName(s string) *SyncJobUpdate {
+	sju.mutation.SetName(s)
+	return sju
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableName(s *string) *SyncJobUpdate {
+	if s != nil {
+		sju.SetName(*s)
+	}
+	return sju
+}
+
+// SetSrc sets the "src" field.
+func (sju *SyncJobUpdate) SetSrc(s string) *SyncJobUpdate {
+	sju.mutation.SetSrc(s)
+	return sju
+}
+
+// SetNillableSrc sets the "src" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableSrc(s *string) *SyncJobUpdate {
+	if s != nil {
+		sju.SetSrc(*s)
+	}
+	return sju
+}
+
+// SetDst sets the "dst" field.
+func (sju *SyncJobUpdate) SetDst(s string) *SyncJobUpdate {
+	sju.mutation.SetDst(s)
+	return sju
+}
+
+// SetNillableDst sets the "dst" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableDst(s *string) *SyncJobUpdate {
+	if s != nil {
+		sju.SetDst(*s)
+	}
+	return sju
+}
+
+// SetInterval sets the "interval" field.
+func (sju *SyncJobUpdate) SetInterval(i int) *SyncJobUpdate {
+	sju.mutation.ResetInterval()
+	sju.mutation.SetInterval(i)
+	return sju
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableInterval(i *int) *SyncJobUpdate {
+	if i != nil {
+		sju.SetInterval(*i)
+	}
+	return sju
+}
+
+// AddInterval adds i to the "interval" field.
+func (sju *SyncJobUpdate) AddInterval(i int) *SyncJobUpdate {
+	sju.mutation.AddInterval(i)
+	return sju
+}
+
+// SetCompare sets the "compare" field.
+func (sju *SyncJobUpdate) SetCompare(s syncjob.Compare) *SyncJobUpdate {
+	sju.mutation.SetCompare(s)
+	return sju
+}
+
+// SetNillableCompare sets the "compare" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableCompare(s *syncjob.Compare) *SyncJobUpdate {
+	if s != nil {
+		sju.SetCompare(*s)
+	}
+	return sju
+}
+
+// SetPropagateDelete sets the "propagate_delete" field.
+func (sju *SyncJobUpdate) SetPropagateDelete(b bool) *SyncJobUpdate {
+	sju.mutation.SetPropagateDelete(b)
+	return sju
+}
+
+// SetNillablePropagateDelete sets the "propagate_delete" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillablePropagateDelete(b *bool) *SyncJobUpdate {
+	if b != nil {
+		sju.SetPropagateDelete(*b)
+	}
+	return sju
+}
+
+// SetEnabled sets the "enabled" field.
+func (sju *SyncJobUpdate) SetEnabled(b bool) *SyncJobUpdate {
+	sju.mutation.SetEnabled(b)
+	return sju
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableEnabled(b *bool) *SyncJobUpdate {
+	if b != nil {
+		sju.SetEnabled(*b)
+	}
+	return sju
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (sju *SyncJobUpdate) SetNextRunAt(t time.Time) *SyncJobUpdate {
+	sju.mutation.SetNextRunAt(t)
+	return sju
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableNextRunAt(t *time.Time) *SyncJobUpdate {
+	if t != nil {
+		sju.SetNextRunAt(*t)
+	}
+	return sju
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (sju *SyncJobUpdate) ClearNextRunAt() *SyncJobUpdate {
+	sju.mutation.ClearNextRunAt()
+	return sju
+}
+
+// SetTaskID sets the "task_id" field.
+func (sju *SyncJobUpdate) SetTaskID(i int) *SyncJobUpdate {
+	sju.mutation.ResetTaskID()
+	sju.mutation.SetTaskID(i)
+	return sju
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableTaskID(i *int) *SyncJobUpdate {
+	if i != nil {
+		sju.SetTaskID(*i)
+	}
+	return sju
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (sju *SyncJobUpdate) AddTaskID(i int) *SyncJobUpdate {
+	sju.mutation.AddTaskID(i)
+	return sju
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (sju *SyncJobUpdate) ClearTaskID() *SyncJobUpdate {
+	sju.mutation.ClearTaskID()
+	return sju
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (sju *SyncJobUpdate) SetLastRunAt(t time.Time) *SyncJobUpdate {
+	sju.mutation.SetLastRunAt(t)
+	return sju
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (sju *SyncJobUpdate) SetNillableLastRunAt(t *time.Time) *SyncJobUpdate {
+	if t != nil {
+		sju.SetLastRunAt(*t)
+	}
+	return sju
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (sju *SyncJobUpdate) ClearLastRunAt() *SyncJobUpdate {
+	sju.mutation.ClearLastRunAt()
+	return sju
+}
+
+// SetLastReport sets the "last_report" field.
+func (sju *SyncJobUpdate) SetLastReport(tr *types.SyncReport) *SyncJobUpdate {
+	sju.mutation.SetLastReport(tr)
+	return sju
+}
+
+// ClearLastReport clears the value of the "last_report" field.
+func (sju *SyncJobUpdate) ClearLastReport() *SyncJobUpdate {
+	sju.mutation.ClearLastReport()
+	return sju
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sju *SyncJobUpdate) SetUser(u *User) *SyncJobUpdate {
+	return sju.SetUserID(u.ID)
+}
+
+// Mutation returns the SyncJobMutation object of the builder.
+func (sju *SyncJobUpdate) Mutation() *SyncJobMutation {
+	return sju.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (sju *SyncJobUpdate) ClearUser() *SyncJobUpdate {
+	sju.mutation.ClearUser()
+	return sju
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sju *SyncJobUpdate) Save(ctx context.Context) (int, error) {
+	if err := sju.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, sju.sqlSave, sju.mutation, sju.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sju *SyncJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := sju.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sju *SyncJobUpdate) Exec(ctx context.Context) error {
+	_, err := sju.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sju *SyncJobUpdate) ExecX(ctx context.Context) {
+	if err := sju.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sju *SyncJobUpdate) defaults() error {
+	if _, ok := sju.mutation.UpdatedAt(); !ok {
+		if syncjob.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized syncjob.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := syncjob.UpdateDefaultUpdatedAt()
+		sju.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sju *SyncJobUpdate) check() error {
+	if v, ok := sju.mutation.Compare(); ok {
+		if err := syncjob.CompareValidator(v); err != nil {
+			return &ValidationError{Name: "compare", err: fmt.Errorf(`ent: validator failed for field "SyncJob.compare": %w`, err)}
+		}
+	}
+	if _, ok := sju.mutation.UserID(); sju.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SyncJob.user"`)
+	}
+	return nil
+}
+
+func (sju *SyncJobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sju.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(syncjob.Table, syncjob.Columns, sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt))
+	if ps := sju.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sju.mutation.UpdatedAt(); ok {
+		_spec.SetField(syncjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := sju.mutation.DeletedAt(); ok {
+		_spec.SetField(syncjob.FieldDeletedAt, field.TypeTime, value)
+	}
+	if sju.mutation.DeletedAtCleared() {
+		_spec.ClearField(syncjob.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := sju.mutation.Name(); ok {
+		_spec.SetField(syncjob.FieldName, field.TypeString, value)
+	}
+	if value, ok := sju.mutation.Src(); ok {
+		_spec.SetField(syncjob.FieldSrc, field.TypeString, value)
+	}
+	if value, ok := sju.mutation.Dst(); ok {
+		_spec.SetField(syncjob.FieldDst, field.TypeString, value)
+	}
+	if value, ok := sju.mutation.Interval(); ok {
+		_spec.SetField(syncjob.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := sju.mutation.AddedInterval(); ok {
+		_spec.AddField(syncjob.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := sju.mutation.Compare(); ok {
+		_spec.SetField(syncjob.FieldCompare, field.TypeEnum, value)
+	}
+	if value, ok := sju.mutation.PropagateDelete(); ok {
+		_spec.SetField(syncjob.FieldPropagateDelete, field.TypeBool, value)
+	}
+	if value, ok := sju.mutation.Enabled(); ok {
+		_spec.SetField(syncjob.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := sju.mutation.NextRunAt(); ok {
+		_spec.SetField(syncjob.FieldNextRunAt, field.TypeTime, value)
+	}
+	if sju.mutation.NextRunAtCleared() {
+		_spec.ClearField(syncjob.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := sju.mutation.TaskID(); ok {
+		_spec.SetField(syncjob.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := sju.mutation.AddedTaskID(); ok {
+		_spec.AddField(syncjob.FieldTaskID, field.TypeInt, value)
+	}
+	if sju.mutation.TaskIDCleared() {
+		_spec.ClearField(syncjob.FieldTaskID, field.TypeInt)
+	}
+	if value, ok := sju.mutation.LastRunAt(); ok {
+		_spec.SetField(syncjob.FieldLastRunAt, field.TypeTime, value)
+	}
+	if sju.mutation.LastRunAtCleared() {
+		_spec.ClearField(syncjob.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := sju.mutation.LastReport(); ok {
+		_spec.SetField(syncjob.FieldLastReport, field.TypeJSON, value)
+	}
+	if sju.mutation.LastReportCleared() {
+		_spec.ClearField(syncjob.FieldLastReport, field.TypeJSON)
+	}
+	if sju.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   syncjob.UserTable,
+			Columns: []string{syncjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sju.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   syncjob.UserTable,
+			Columns: []string{syncjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sju.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{syncjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sju.mutation.done = true
+	return n, nil
+}
+
+// SyncJobUpdateOne is the builder for updating a single SyncJob entity.
+type SyncJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SyncJobMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sjuo *SyncJobUpdateOne) SetUpdatedAt(t time.Time) *SyncJobUpdateOne {
+	sjuo.mutation.SetUpdatedAt(t)
+	return sjuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sjuo *SyncJobUpdateOne) SetDeletedAt(t time.Time) *SyncJobUpdateOne {
+	sjuo.mutation.SetDeletedAt(t)
+	return sjuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableDeletedAt(t *time.Time) *SyncJobUpdateOne {
+	if t != nil {
+		sjuo.SetDeletedAt(*t)
+	}
+	return sjuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (sjuo *SyncJobUpdateOne) ClearDeletedAt() *SyncJobUpdateOne {
+	sjuo.mutation.ClearDeletedAt()
+	return sjuo
+}
+
+// SetUserID sets the "user_id" field.
+func (sjuo *SyncJobUpdateOne) SetUserID(i int) *SyncJobUpdateOne {
+	sjuo.mutation.SetUserID(i)
+	return sjuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableUserID(i *int) *SyncJobUpdateOne {
+	if i != nil {
+		sjuo.SetUserID(*i)
+	}
+	return sjuo
+}
+
+// SetName sets the "name" field.
+func (sjuo *SyncJobUpdateOne) SetName(s string) *SyncJobUpdateOne {
+	sjuo.mutation.SetName(s)
+	return sjuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableName(s *string) *SyncJobUpdateOne {
+	if s != nil {
+		sjuo.SetName(*s)
+	}
+	return sjuo
+}
+
+// SetSrc sets the "src" field.
+func (sjuo *SyncJobUpdateOne) SetSrc(s string) *SyncJobUpdateOne {
+	sjuo.mutation.SetSrc(s)
+	return sjuo
+}
+
+// SetNillableSrc sets the "src" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableSrc(s *string) *SyncJobUpdateOne {
+	if s != nil {
+		sjuo.SetSrc(*s)
+	}
+	return sjuo
+}
+
+// SetDst sets the "dst" field.
+func (sjuo *SyncJobUpdateOne) SetDst(s string) *SyncJobUpdateOne {
+	sjuo.mutation.SetDst(s)
+	return sjuo
+}
+
+// SetNillableDst sets the "dst" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableDst(s *string) *SyncJobUpdateOne {
+	if s != nil {
+		sjuo.SetDst(*s)
+	}
+	return sjuo
+}
+
+// SetInterval sets the "interval" field.
+func (sjuo *SyncJobUpdateOne) SetInterval(i int) *SyncJobUpdateOne {
+	sjuo.mutation.ResetInterval()
+	sjuo.mutation.SetInterval(i)
+	return sjuo
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableInterval(i *int) *SyncJobUpdateOne {
+	if i != nil {
+		sjuo.SetInterval(*i)
+	}
+	return sjuo
+}
+
+// AddInterval adds i to the "interval" field.
+func (sjuo *SyncJobUpdateOne) AddInterval(i int) *SyncJobUpdateOne {
+	sjuo.mutation.AddInterval(i)
+	return sjuo
+}
+
+// SetCompare sets the "compare" field.
+func (sjuo *SyncJobUpdateOne) SetCompare(s syncjob.Compare) *SyncJobUpdateOne {
+	sjuo.mutation.SetCompare(s)
+	return sjuo
+}
+
+// SetNillableCompare sets the "compare" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableCompare(s *syncjob.Compare) *SyncJobUpdateOne {
+	if s != nil {
+		sjuo.SetCompare(*s)
+	}
+	return sjuo
+}
+
+// SetPropagateDelete sets the "propagate_delete" field.
+func (sjuo *SyncJobUpdateOne) SetPropagateDelete(b bool) *SyncJobUpdateOne {
+	sjuo.mutation.SetPropagateDelete(b)
+	return sjuo
+}
+
+// SetNillablePropagateDelete sets the "propagate_delete" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillablePropagateDelete(b *bool) *SyncJobUpdateOne {
+	if b != nil {
+		sjuo.SetPropagateDelete(*b)
+	}
+	return sjuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (sjuo *SyncJobUpdateOne) SetEnabled(b bool) *SyncJobUpdateOne {
+	sjuo.mutation.SetEnabled(b)
+	return sjuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableEnabled(b *bool) *SyncJobUpdateOne {
+	if b != nil {
+		sjuo.SetEnabled(*b)
+	}
+	return sjuo
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (sjuo *SyncJobUpdateOne) SetNextRunAt(t time.Time) *SyncJobUpdateOne {
+	sjuo.mutation.SetNextRunAt(t)
+	return sjuo
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableNextRunAt(t *time.Time) *SyncJobUpdateOne {
+	if t != nil {
+		sjuo.SetNextRunAt(*t)
+	}
+	return sjuo
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (sjuo *SyncJobUpdateOne) ClearNextRunAt() *SyncJobUpdateOne {
+	sjuo.mutation.ClearNextRunAt()
+	return sjuo
+}
+
+// SetTaskID sets the "task_id" field.
+func (sjuo *SyncJobUpdateOne) SetTaskID(i int) *SyncJobUpdateOne {
+	sjuo.mutation.ResetTaskID()
+	sjuo.mutation.SetTaskID(i)
+	return sjuo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableTaskID(i *int) *SyncJobUpdateOne {
+	if i != nil {
+		sjuo.SetTaskID(*i)
+	}
+	return sjuo
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (sjuo *SyncJobUpdateOne) AddTaskID(i int) *SyncJobUpdateOne {
+	sjuo.mutation.AddTaskID(i)
+	return sjuo
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (sjuo *SyncJobUpdateOne) ClearTaskID() *SyncJobUpdateOne {
+	sjuo.mutation.ClearTaskID()
+	return sjuo
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (sjuo *SyncJobUpdateOne) SetLastRunAt(t time.Time) *SyncJobUpdateOne {
+	sjuo.mutation.SetLastRunAt(t)
+	return sjuo
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (sjuo *SyncJobUpdateOne) SetNillableLastRunAt(t *time.Time) *SyncJobUpdateOne {
+	if t != nil {
+		sjuo.SetLastRunAt(*t)
+	}
+	return sjuo
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (sjuo *SyncJobUpdateOne) ClearLastRunAt() *SyncJobUpdateOne {
+	sjuo.mutation.ClearLastRunAt()
+	return sjuo
+}
+
+// SetLastReport sets the "last_report" field.
+func (sjuo *SyncJobUpdateOne) SetLastReport(tr *types.SyncReport) *SyncJobUpdateOne {
+	sjuo.mutation.SetLastReport(tr)
+	return sjuo
+}
+
+// ClearLastReport clears the value of the "last_report" field.
+func (sjuo *SyncJobUpdateOne) ClearLastReport() *SyncJobUpdateOne {
+	sjuo.mutation.ClearLastReport()
+	return sjuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (sjuo *SyncJobUpdateOne) SetUser(u *User) *SyncJobUpdateOne {
+	return sjuo.SetUserID(u.ID)
+}
+
+// Mutation returns the SyncJobMutation object of the builder.
+func (sjuo *SyncJobUpdateOne) Mutation() *SyncJobMutation {
+	return sjuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (sjuo *SyncJobUpdateOne) ClearUser() *SyncJobUpdateOne {
+	sjuo.mutation.ClearUser()
+	return sjuo
+}
+
+// Where appends a list predicates to the SyncJobUpdate builder.
+func (sjuo *SyncJobUpdateOne) Where(ps ...predicate.SyncJob) *SyncJobUpdateOne {
+	sjuo.mutation.Where(ps...)
+	return sjuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sjuo *SyncJobUpdateOne) Select(field string, fields ...string) *SyncJobUpdateOne {
+	sjuo.fields = append([]string{field}, fields...)
+	return sjuo
+}
+
+// Save executes the query and returns the updated SyncJob entity.
+func (sjuo *SyncJobUpdateOne) Save(ctx context.Context) (*SyncJob, error) {
+	if err := sjuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, sjuo.sqlSave, sjuo.mutation, sjuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sjuo *SyncJobUpdateOne) SaveX(ctx context.Context) *SyncJob {
+	node, err := sjuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sjuo *SyncJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := sjuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sjuo *SyncJobUpdateOne) ExecX(ctx context.Context) {
+	if err := sjuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sjuo *SyncJobUpdateOne) defaults() error {
+	if _, ok := sjuo.mutation.UpdatedAt(); !ok {
+		if syncjob.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized syncjob.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := syncjob.UpdateDefaultUpdatedAt()
+		sjuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sjuo *SyncJobUpdateOne) check() error {
+	if v, ok := sjuo.mutation.Compare(); ok {
+		if err := syncjob.CompareValidator(v); err != nil {
+			return &ValidationError{Name: "compare", err: fmt.Errorf(`ent: validator failed for field "SyncJob.compare": %w`, err)}
+		}
+	}
+	if _, ok := sjuo.mutation.UserID(); sjuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SyncJob.user"`)
+	}
+	return nil
+}
+
+func (sjuo *SyncJobUpdateOne) sqlSave(ctx context.Context) (_node *SyncJob, err error) {
+	if err := sjuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(syncjob.Table, syncjob.Columns, sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt))
+	id, ok := sjuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SyncJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sjuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, syncjob.FieldID)
+		for _, f := range fields {
+			if !syncjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != syncjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sjuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sjuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(syncjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := sjuo.mutation.DeletedAt(); ok {
+		_spec.SetField(syncjob.FieldDeletedAt, field.TypeTime, value)
+	}
+	if sjuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(syncjob.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := sjuo.mutation.Name(); ok {
+		_spec.SetField(syncjob.FieldName, field.TypeString, value)
+	}
+	if value, ok := sjuo.mutation.Src(); ok {
+		_spec.SetField(syncjob.FieldSrc, field.TypeString, value)
+	}
+	if value, ok := sjuo.mutation.Dst(); ok {
+		_spec.SetField(syncjob.FieldDst, field.TypeString, value)
+	}
+	if value, ok := sjuo.mutation.Interval(); ok {
+		_spec.SetField(syncjob.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := sjuo.mutation.AddedInterval(); ok {
+		_spec.AddField(syncjob.FieldInterval, field.TypeInt, value)
+	}
+	if value, ok := sjuo.mutation.Compare(); ok {
+		_spec.SetField(syncjob.FieldCompare, field.TypeEnum, value)
+	}
+	if value, ok := sjuo.mutation.PropagateDelete(); ok {
+		_spec.SetField(syncjob.FieldPropagateDelete, field.TypeBool, value)
+	}
+	if value, ok := sjuo.mutation.Enabled(); ok {
+		_spec.SetField(syncjob.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := sjuo.mutation.NextRunAt(); ok {
+		_spec.SetField(syncjob.FieldNextRunAt, field.TypeTime, value)
+	}
+	if sjuo.mutation.NextRunAtCleared() {
+		_spec.ClearField(syncjob.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := sjuo.mutation.TaskID(); ok {
+		_spec.SetField(syncjob.FieldTaskID, field.TypeInt, value)
+	}
+	if value, ok := sjuo.mutation.AddedTaskID(); ok {
+		_spec.AddField(syncjob.FieldTaskID, field.TypeInt, value)
+	}
+	if sjuo.mutation.TaskIDCleared() {
+		_spec.ClearField(syncjob.FieldTaskID, field.TypeInt)
+	}
+	if value, ok := sjuo.mutation.LastRunAt(); ok {
+		_spec.SetField(syncjob.FieldLastRunAt, field.TypeTime, value)
+	}
+	if sjuo.mutation.LastRunAtCleared() {
+		_spec.ClearField(syncjob.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := sjuo.mutation.LastReport(); ok {
+		_spec.SetField(syncjob.FieldLastReport, field.TypeJSON, value)
+	}
+	if sjuo.mutation.LastReportCleared() {
+		_spec.ClearField(syncjob.FieldLastReport, field.TypeJSON)
+	}
+	if sjuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   syncjob.UserTable,
+			Columns: []string{syncjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sjuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   syncjob.UserTable,
+			Columns: []string{syncjob.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SyncJob{config: sjuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sjuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{syncjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sjuo.mutation.done = true
+	return _node, nil
+}
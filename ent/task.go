@@ -39,6 +39,10 @@ type Task struct {
 	CorrelationID uuid.UUID `json:"correlation_id,omitempty"`
 	// UserTasks holds the value of the "user_tasks" field.
 	UserTasks int `json:"user_tasks,omitempty"`
+	// IdempotencyKey holds the value of the "idempotency_key" field.
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
+	// DeadLetteredAt holds the value of the "dead_lettered_at" field.
+	DeadLetteredAt *time.Time `json:"dead_lettered_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TaskQuery when eager-loading is set.
 	Edges        TaskEdges `json:"edges"`
@@ -76,9 +80,9 @@ func (*Task) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case task.FieldID, task.FieldUserTasks:
 			values[i] = new(sql.NullInt64)
-		case task.FieldType, task.FieldStatus, task.FieldPrivateState:
+		case task.FieldType, task.FieldStatus, task.FieldPrivateState, task.FieldIdempotencyKey:
 			values[i] = new(sql.NullString)
-		case task.FieldCreatedAt, task.FieldUpdatedAt, task.FieldDeletedAt:
+		case task.FieldCreatedAt, task.FieldUpdatedAt, task.FieldDeletedAt, task.FieldDeadLetteredAt:
 			values[i] = new(sql.NullTime)
 		case task.FieldCorrelationID:
 			values[i] = new(uuid.UUID)
@@ -160,6 +164,20 @@ func (t *Task) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.UserTasks = int(value.Int64)
 			}
+		case task.FieldIdempotencyKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idempotency_key", values[i])
+			} else if value.Valid {
+				t.IdempotencyKey = new(string)
+				*t.IdempotencyKey = value.String
+			}
+		case task.FieldDeadLetteredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field dead_lettered_at", values[i])
+			} else if value.Valid {
+				t.DeadLetteredAt = new(time.Time)
+				*t.DeadLetteredAt = value.Time
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -229,6 +247,16 @@ func (t *Task) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("user_tasks=")
 	builder.WriteString(fmt.Sprintf("%v", t.UserTasks))
+	builder.WriteString(", ")
+	if v := t.IdempotencyKey; v != nil {
+		builder.WriteString("idempotency_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := t.DeadLetteredAt; v != nil {
+		builder.WriteString("dead_lettered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -4,6 +4,8 @@ package task
 
 import (
 	"fmt"
+	"io"
+	"strconv"
 	"time"
 
 	"entgo.io/ent"
@@ -34,6 +36,10 @@ const (
 	FieldCorrelationID = "correlation_id"
 	// FieldUserTasks holds the string denoting the user_tasks field in the database.
 	FieldUserTasks = "user_tasks"
+	// FieldIdempotencyKey holds the string denoting the idempotency_key field in the database.
+	FieldIdempotencyKey = "idempotency_key"
+	// FieldDeadLetteredAt holds the string denoting the dead_lettered_at field in the database.
+	FieldDeadLetteredAt = "dead_lettered_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// Table holds the table name of the task in the database.
@@ -59,6 +65,8 @@ var Columns = []string{
 	FieldPrivateState,
 	FieldCorrelationID,
 	FieldUserTasks,
+	FieldIdempotencyKey,
+	FieldDeadLetteredAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -165,6 +173,16 @@ func ByUserTasks(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserTasks, opts...).ToFunc()
 }
 
+// ByIdempotencyKey orders the results by the idempotency_key field.
+func ByIdempotencyKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdempotencyKey, opts...).ToFunc()
+}
+
+// ByDeadLetteredAt orders the results by the dead_lettered_at field.
+func ByDeadLetteredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeadLetteredAt, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -178,3 +196,21 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Status) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Status) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Status(str)
+	if err := StatusValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Status", str)
+	}
+	return nil
+}
@@ -91,6 +91,16 @@ func UserTasks(v int) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldUserTasks, v))
 }
 
+// IdempotencyKey applies equality check predicate on the "idempotency_key" field. It's identical to IdempotencyKeyEQ.
+func IdempotencyKey(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// DeadLetteredAt applies equality check predicate on the "dead_lettered_at" field. It's identical to DeadLetteredAtEQ.
+func DeadLetteredAt(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldDeadLetteredAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldCreatedAt, v))
@@ -461,6 +471,131 @@ func UserTasksNotNil() predicate.Task {
 	return predicate.Task(sql.FieldNotNull(FieldUserTasks))
 }
 
+// IdempotencyKeyEQ applies the EQ predicate on the "idempotency_key" field.
+func IdempotencyKeyEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyNEQ applies the NEQ predicate on the "idempotency_key" field.
+func IdempotencyKeyNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIn applies the In predicate on the "idempotency_key" field.
+func IdempotencyKeyIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyNotIn applies the NotIn predicate on the "idempotency_key" field.
+func IdempotencyKeyNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyGT applies the GT predicate on the "idempotency_key" field.
+func IdempotencyKeyGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyGTE applies the GTE predicate on the "idempotency_key" field.
+func IdempotencyKeyGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLT applies the LT predicate on the "idempotency_key" field.
+func IdempotencyKeyLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLTE applies the LTE predicate on the "idempotency_key" field.
+func IdempotencyKeyLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContains applies the Contains predicate on the "idempotency_key" field.
+func IdempotencyKeyContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasPrefix applies the HasPrefix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasSuffix applies the HasSuffix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIsNil applies the IsNil predicate on the "idempotency_key" field.
+func IdempotencyKeyIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldIdempotencyKey))
+}
+
+// IdempotencyKeyNotNil applies the NotNil predicate on the "idempotency_key" field.
+func IdempotencyKeyNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldIdempotencyKey))
+}
+
+// IdempotencyKeyEqualFold applies the EqualFold predicate on the "idempotency_key" field.
+func IdempotencyKeyEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContainsFold applies the ContainsFold predicate on the "idempotency_key" field.
+func IdempotencyKeyContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldIdempotencyKey, v))
+}
+
+// DeadLetteredAtEQ applies the EQ predicate on the "dead_lettered_at" field.
+func DeadLetteredAtEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldDeadLetteredAt, v))
+}
+
+// DeadLetteredAtNEQ applies the NEQ predicate on the "dead_lettered_at" field.
+func DeadLetteredAtNEQ(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldDeadLetteredAt, v))
+}
+
+// DeadLetteredAtIn applies the In predicate on the "dead_lettered_at" field.
+func DeadLetteredAtIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldDeadLetteredAt, vs...))
+}
+
+// DeadLetteredAtNotIn applies the NotIn predicate on the "dead_lettered_at" field.
+func DeadLetteredAtNotIn(vs ...time.Time) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldDeadLetteredAt, vs...))
+}
+
+// DeadLetteredAtGT applies the GT predicate on the "dead_lettered_at" field.
+func DeadLetteredAtGT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldDeadLetteredAt, v))
+}
+
+// DeadLetteredAtGTE applies the GTE predicate on the "dead_lettered_at" field.
+func DeadLetteredAtGTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldDeadLetteredAt, v))
+}
+
+// DeadLetteredAtLT applies the LT predicate on the "dead_lettered_at" field.
+func DeadLetteredAtLT(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldDeadLetteredAt, v))
+}
+
+// DeadLetteredAtLTE applies the LTE predicate on the "dead_lettered_at" field.
+func DeadLetteredAtLTE(v time.Time) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldDeadLetteredAt, v))
+}
+
+// DeadLetteredAtIsNil applies the IsNil predicate on the "dead_lettered_at" field.
+func DeadLetteredAtIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldDeadLetteredAt))
+}
+
+// DeadLetteredAtNotNil applies the NotNil predicate on the "dead_lettered_at" field.
+func DeadLetteredAtNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldDeadLetteredAt))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Task {
 	return predicate.Task(func(s *sql.Selector) {
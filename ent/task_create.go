@@ -135,6 +135,34 @@ func (tc *TaskCreate) SetNillableUserTasks(i *int) *TaskCreate {
 	return tc
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (tc *TaskCreate) SetIdempotencyKey(s string) *TaskCreate {
+	tc.mutation.SetIdempotencyKey(s)
+	return tc
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableIdempotencyKey(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetIdempotencyKey(*s)
+	}
+	return tc
+}
+
+// SetDeadLetteredAt sets the "dead_lettered_at" field.
+func (tc *TaskCreate) SetDeadLetteredAt(t time.Time) *TaskCreate {
+	tc.mutation.SetDeadLetteredAt(t)
+	return tc
+}
+
+// SetNillableDeadLetteredAt sets the "dead_lettered_at" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableDeadLetteredAt(t *time.Time) *TaskCreate {
+	if t != nil {
+		tc.SetDeadLetteredAt(*t)
+	}
+	return tc
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (tc *TaskCreate) SetUserID(id int) *TaskCreate {
 	tc.mutation.SetUserID(id)
@@ -300,6 +328,14 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldCorrelationID, field.TypeUUID, value)
 		_node.CorrelationID = value
 	}
+	if value, ok := tc.mutation.IdempotencyKey(); ok {
+		_spec.SetField(task.FieldIdempotencyKey, field.TypeString, value)
+		_node.IdempotencyKey = &value
+	}
+	if value, ok := tc.mutation.DeadLetteredAt(); ok {
+		_spec.SetField(task.FieldDeadLetteredAt, field.TypeTime, value)
+		_node.DeadLetteredAt = &value
+	}
 	if nodes := tc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -471,6 +507,42 @@ func (u *TaskUpsert) ClearUserTasks() *TaskUpsert {
 	return u
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (u *TaskUpsert) SetIdempotencyKey(v string) *TaskUpsert {
+	u.Set(task.FieldIdempotencyKey, v)
+	return u
+}
+
+// UpdateIdempotencyKey sets the "idempotency_key" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateIdempotencyKey() *TaskUpsert {
+	u.SetExcluded(task.FieldIdempotencyKey)
+	return u
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (u *TaskUpsert) ClearIdempotencyKey() *TaskUpsert {
+	u.SetNull(task.FieldIdempotencyKey)
+	return u
+}
+
+// SetDeadLetteredAt sets the "dead_lettered_at" field.
+func (u *TaskUpsert) SetDeadLetteredAt(v time.Time) *TaskUpsert {
+	u.Set(task.FieldDeadLetteredAt, v)
+	return u
+}
+
+// UpdateDeadLetteredAt sets the "dead_lettered_at" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateDeadLetteredAt() *TaskUpsert {
+	u.SetExcluded(task.FieldDeadLetteredAt)
+	return u
+}
+
+// ClearDeadLetteredAt clears the value of the "dead_lettered_at" field.
+func (u *TaskUpsert) ClearDeadLetteredAt() *TaskUpsert {
+	u.SetNull(task.FieldDeadLetteredAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -638,6 +710,48 @@ func (u *TaskUpsertOne) ClearUserTasks() *TaskUpsertOne {
 	})
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (u *TaskUpsertOne) SetIdempotencyKey(v string) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetIdempotencyKey(v)
+	})
+}
+
+// UpdateIdempotencyKey sets the "idempotency_key" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateIdempotencyKey() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateIdempotencyKey()
+	})
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (u *TaskUpsertOne) ClearIdempotencyKey() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearIdempotencyKey()
+	})
+}
+
+// SetDeadLetteredAt sets the "dead_lettered_at" field.
+func (u *TaskUpsertOne) SetDeadLetteredAt(v time.Time) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetDeadLetteredAt(v)
+	})
+}
+
+// UpdateDeadLetteredAt sets the "dead_lettered_at" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateDeadLetteredAt() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateDeadLetteredAt()
+	})
+}
+
+// ClearDeadLetteredAt clears the value of the "dead_lettered_at" field.
+func (u *TaskUpsertOne) ClearDeadLetteredAt() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearDeadLetteredAt()
+	})
+}
+
 // Exec executes the query.
 func (u *TaskUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -976,6 +1090,48 @@ func (u *TaskUpsertBulk) ClearUserTasks() *TaskUpsertBulk {
 	})
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (u *TaskUpsertBulk) SetIdempotencyKey(v string) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetIdempotencyKey(v)
+	})
+}
+
+// UpdateIdempotencyKey sets the "idempotency_key" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateIdempotencyKey() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateIdempotencyKey()
+	})
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (u *TaskUpsertBulk) ClearIdempotencyKey() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearIdempotencyKey()
+	})
+}
+
+// SetDeadLetteredAt sets the "dead_lettered_at" field.
+func (u *TaskUpsertBulk) SetDeadLetteredAt(v time.Time) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetDeadLetteredAt(v)
+	})
+}
+
+// UpdateDeadLetteredAt sets the "dead_lettered_at" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateDeadLetteredAt() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateDeadLetteredAt()
+	})
+}
+
+// ClearDeadLetteredAt clears the value of the "dead_lettered_at" field.
+func (u *TaskUpsertBulk) ClearDeadLetteredAt() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearDeadLetteredAt()
+	})
+}
+
 // Exec executes the query.
 func (u *TaskUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -130,6 +130,46 @@ func (tu *TaskUpdate) ClearUserTasks() *TaskUpdate {
 	return tu
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (tu *TaskUpdate) SetIdempotencyKey(s string) *TaskUpdate {
+	tu.mutation.SetIdempotencyKey(s)
+	return tu
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableIdempotencyKey(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetIdempotencyKey(*s)
+	}
+	return tu
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (tu *TaskUpdate) ClearIdempotencyKey() *TaskUpdate {
+	tu.mutation.ClearIdempotencyKey()
+	return tu
+}
+
+// SetDeadLetteredAt sets the "dead_lettered_at" field.
+func (tu *TaskUpdate) SetDeadLetteredAt(t time.Time) *TaskUpdate {
+	tu.mutation.SetDeadLetteredAt(t)
+	return tu
+}
+
+// SetNillableDeadLetteredAt sets the "dead_lettered_at" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableDeadLetteredAt(t *time.Time) *TaskUpdate {
+	if t != nil {
+		tu.SetDeadLetteredAt(*t)
+	}
+	return tu
+}
+
+// ClearDeadLetteredAt clears the value of the "dead_lettered_at" field.
+func (tu *TaskUpdate) ClearDeadLetteredAt() *TaskUpdate {
+	tu.mutation.ClearDeadLetteredAt()
+	return tu
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (tu *TaskUpdate) SetUserID(id int) *TaskUpdate {
 	tu.mutation.SetUserID(id)
@@ -251,6 +291,18 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.CorrelationIDCleared() {
 		_spec.ClearField(task.FieldCorrelationID, field.TypeUUID)
 	}
+	if value, ok := tu.mutation.IdempotencyKey(); ok {
+		_spec.SetField(task.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if tu.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(task.FieldIdempotencyKey, field.TypeString)
+	}
+	if value, ok := tu.mutation.DeadLetteredAt(); ok {
+		_spec.SetField(task.FieldDeadLetteredAt, field.TypeTime, value)
+	}
+	if tu.mutation.DeadLetteredAtCleared() {
+		_spec.ClearField(task.FieldDeadLetteredAt, field.TypeTime)
+	}
 	if tu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -400,6 +452,46 @@ func (tuo *TaskUpdateOne) ClearUserTasks() *TaskUpdateOne {
 	return tuo
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (tuo *TaskUpdateOne) SetIdempotencyKey(s string) *TaskUpdateOne {
+	tuo.mutation.SetIdempotencyKey(s)
+	return tuo
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableIdempotencyKey(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetIdempotencyKey(*s)
+	}
+	return tuo
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (tuo *TaskUpdateOne) ClearIdempotencyKey() *TaskUpdateOne {
+	tuo.mutation.ClearIdempotencyKey()
+	return tuo
+}
+
+// SetDeadLetteredAt sets the "dead_lettered_at" field.
+func (tuo *TaskUpdateOne) SetDeadLetteredAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetDeadLetteredAt(t)
+	return tuo
+}
+
+// SetNillableDeadLetteredAt sets the "dead_lettered_at" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableDeadLetteredAt(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetDeadLetteredAt(*t)
+	}
+	return tuo
+}
+
+// ClearDeadLetteredAt clears the value of the "dead_lettered_at" field.
+func (tuo *TaskUpdateOne) ClearDeadLetteredAt() *TaskUpdateOne {
+	tuo.mutation.ClearDeadLetteredAt()
+	return tuo
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (tuo *TaskUpdateOne) SetUserID(id int) *TaskUpdateOne {
 	tuo.mutation.SetUserID(id)
@@ -551,6 +643,18 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 	if tuo.mutation.CorrelationIDCleared() {
 		_spec.ClearField(task.FieldCorrelationID, field.TypeUUID)
 	}
+	if value, ok := tuo.mutation.IdempotencyKey(); ok {
+		_spec.SetField(task.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if tuo.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(task.FieldIdempotencyKey, field.TypeString)
+	}
+	if value, ok := tuo.mutation.DeadLetteredAt(); ok {
+		_spec.SetField(task.FieldDeadLetteredAt, field.TypeTime, value)
+	}
+	if tuo.mutation.DeadLetteredAtCleared() {
+		_spec.ClearField(task.FieldDeadLetteredAt, field.TypeTime)
+	}
 	if tuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -14,6 +14,20 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccessToken is the client for interacting with the AccessToken builders.
+	AccessToken *AccessTokenClient
+	// Announcement is the client for interacting with the Announcement builders.
+	Announcement *AnnouncementClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// AutomationRule is the client for interacting with the AutomationRule builders.
+	AutomationRule *AutomationRuleClient
+	// Checkout is the client for interacting with the Checkout builders.
+	Checkout *CheckoutClient
+	// DailyActiveUser is the client for interacting with the DailyActiveUser builders.
+	DailyActiveUser *DailyActiveUserClient
+	// DailyStat is the client for interacting with the DailyStat builders.
+	DailyStat *DailyStatClient
 	// DavAccount is the client for interacting with the DavAccount builders.
 	DavAccount *DavAccountClient
 	// DirectLink is the client for interacting with the DirectLink builders.
@@ -24,22 +38,48 @@ type Tx struct {
 	File *FileClient
 	// Group is the client for interacting with the Group builders.
 	Group *GroupClient
+	// Invitation is the client for interacting with the Invitation builders.
+	Invitation *InvitationClient
 	// Metadata is the client for interacting with the Metadata builders.
 	Metadata *MetadataClient
+	// ModerationCase is the client for interacting with the ModerationCase builders.
+	ModerationCase *ModerationCaseClient
 	// Node is the client for interacting with the Node builders.
 	Node *NodeClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// Organization is the client for interacting with the Organization builders.
+	Organization *OrganizationClient
 	// Passkey is the client for interacting with the Passkey builders.
 	Passkey *PasskeyClient
+	// RetentionRule is the client for interacting with the RetentionRule builders.
+	RetentionRule *RetentionRuleClient
+	// RssSubscription is the client for interacting with the RssSubscription builders.
+	RssSubscription *RssSubscriptionClient
+	// S3AccessKey is the client for interacting with the S3AccessKey builders.
+	S3AccessKey *S3AccessKeyClient
+	// SavedSearch is the client for interacting with the SavedSearch builders.
+	SavedSearch *SavedSearchClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
 	// Share is the client for interacting with the Share builders.
 	Share *ShareClient
 	// StoragePolicy is the client for interacting with the StoragePolicy builders.
 	StoragePolicy *StoragePolicyClient
+	// SyncJob is the client for interacting with the SyncJob builders.
+	SyncJob *SyncJobClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserEmail is the client for interacting with the UserEmail builders.
+	UserEmail *UserEmailClient
+	// ViewPreference is the client for interacting with the ViewPreference builders.
+	ViewPreference *ViewPreferenceClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -171,19 +211,39 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccessToken = NewAccessTokenClient(tx.config)
+	tx.Announcement = NewAnnouncementClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.AutomationRule = NewAutomationRuleClient(tx.config)
+	tx.Checkout = NewCheckoutClient(tx.config)
+	tx.DailyActiveUser = NewDailyActiveUserClient(tx.config)
+	tx.DailyStat = NewDailyStatClient(tx.config)
 	tx.DavAccount = NewDavAccountClient(tx.config)
 	tx.DirectLink = NewDirectLinkClient(tx.config)
 	tx.Entity = NewEntityClient(tx.config)
 	tx.File = NewFileClient(tx.config)
 	tx.Group = NewGroupClient(tx.config)
+	tx.Invitation = NewInvitationClient(tx.config)
 	tx.Metadata = NewMetadataClient(tx.config)
+	tx.ModerationCase = NewModerationCaseClient(tx.config)
 	tx.Node = NewNodeClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.Organization = NewOrganizationClient(tx.config)
 	tx.Passkey = NewPasskeyClient(tx.config)
+	tx.RetentionRule = NewRetentionRuleClient(tx.config)
+	tx.RssSubscription = NewRssSubscriptionClient(tx.config)
+	tx.S3AccessKey = NewS3AccessKeyClient(tx.config)
+	tx.SavedSearch = NewSavedSearchClient(tx.config)
 	tx.Setting = NewSettingClient(tx.config)
 	tx.Share = NewShareClient(tx.config)
 	tx.StoragePolicy = NewStoragePolicyClient(tx.config)
+	tx.SyncJob = NewSyncJobClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserEmail = NewUserEmailClient(tx.config)
+	tx.ViewPreference = NewViewPreferenceClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -193,7 +253,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: DavAccount.QueryXXX(), the query will be executed
+// applies a query, for example: AccessToken.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
+	"github.com/cloudreve/Cloudreve/v4/ent/invitation"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 )
@@ -44,10 +45,19 @@ type User struct {
 	Settings *types.UserSetting `json:"settings,omitempty"`
 	// GroupUsers holds the value of the "group_users" field.
 	GroupUsers int `json:"group_users,omitempty"`
+	// Overrides the storage policy of user's group if not 0
+	StoragePolicyOverride int `json:"storage_policy_override,omitempty"`
+	// Overrides the max storage of user's group if not null
+	MaxStorageOverride *int64 `json:"max_storage_override,omitempty"`
+	// Copy of settings.group_expiration.expires_at, indexed for expiration lookup
+	GroupExpiresAt *time.Time `json:"group_expires_at,omitempty"`
+	// Copy of settings.pending_email.effective_at, indexed for lookup of due email changes
+	EmailChangeAt *time.Time `json:"email_change_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
-	Edges        UserEdges `json:"edges"`
-	selectValues sql.SelectValues
+	Edges               UserEdges `json:"edges"`
+	invitation_invitees *int
+	selectValues        sql.SelectValues
 }
 
 // UserEdges holds the relations/edges for other nodes in the graph.
@@ -66,9 +76,65 @@ type UserEdges struct {
 	Tasks []*Task `json:"tasks,omitempty"`
 	// Entities holds the value of the entities edge.
 	Entities []*Entity `json:"entities,omitempty"`
+	// ViewPreferences holds the value of the view_preferences edge.
+	ViewPreferences []*ViewPreference `json:"view_preferences,omitempty"`
+	// AccessTokens holds the value of the access_tokens edge.
+	AccessTokens []*AccessToken `json:"access_tokens,omitempty"`
+	// Invitations holds the value of the invitations edge.
+	Invitations []*Invitation `json:"invitations,omitempty"`
+	// Emails holds the value of the emails edge.
+	Emails []*UserEmail `json:"emails,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
+	// SavedSearches holds the value of the saved_searches edge.
+	SavedSearches []*SavedSearch `json:"saved_searches,omitempty"`
+	// RssSubscriptions holds the value of the rss_subscriptions edge.
+	RssSubscriptions []*RssSubscription `json:"rss_subscriptions,omitempty"`
+	// AutomationRules holds the value of the automation_rules edge.
+	AutomationRules []*AutomationRule `json:"automation_rules,omitempty"`
+	// SyncJobs holds the value of the sync_jobs edge.
+	SyncJobs []*SyncJob `json:"sync_jobs,omitempty"`
+	// RetentionRules holds the value of the retention_rules edge.
+	RetentionRules []*RetentionRule `json:"retention_rules,omitempty"`
+	// ModerationCases holds the value of the moderation_cases edge.
+	ModerationCases []*ModerationCase `json:"moderation_cases,omitempty"`
+	// S3AccessKeys holds the value of the s3_access_keys edge.
+	S3AccessKeys []*S3AccessKey `json:"s3_access_keys,omitempty"`
+	// Checkouts holds the value of the checkouts edge.
+	Checkouts []*Checkout `json:"checkouts,omitempty"`
+	// DismissedAnnouncements holds the value of the dismissed_announcements edge.
+	DismissedAnnouncements []*Announcement `json:"dismissed_announcements,omitempty"`
+	// AdminOrganizations holds the value of the admin_organizations edge.
+	AdminOrganizations []*Organization `json:"admin_organizations,omitempty"`
+	// Invitation holds the value of the invitation edge.
+	Invitation *Invitation `json:"invitation,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [23]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedFiles                  map[string][]*File
+	namedDavAccounts            map[string][]*DavAccount
+	namedShares                 map[string][]*Share
+	namedPasskey                map[string][]*Passkey
+	namedTasks                  map[string][]*Task
+	namedEntities               map[string][]*Entity
+	namedViewPreferences        map[string][]*ViewPreference
+	namedAccessTokens           map[string][]*AccessToken
+	namedInvitations            map[string][]*Invitation
+	namedEmails                 map[string][]*UserEmail
+	namedNotifications          map[string][]*Notification
+	namedSavedSearches          map[string][]*SavedSearch
+	namedRssSubscriptions       map[string][]*RssSubscription
+	namedAutomationRules        map[string][]*AutomationRule
+	namedSyncJobs               map[string][]*SyncJob
+	namedRetentionRules         map[string][]*RetentionRule
+	namedModerationCases        map[string][]*ModerationCase
+	namedS3AccessKeys           map[string][]*S3AccessKey
+	namedCheckouts              map[string][]*Checkout
+	namedDismissedAnnouncements map[string][]*Announcement
+	namedAdminOrganizations     map[string][]*Organization
 }
 
 // GroupOrErr returns the Group value or an error if the edge
@@ -138,6 +204,154 @@ func (e UserEdges) EntitiesOrErr() ([]*Entity, error) {
 	return nil, &NotLoadedError{edge: "entities"}
 }
 
+// ViewPreferencesOrErr returns the ViewPreferences value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ViewPreferencesOrErr() ([]*ViewPreference, error) {
+	if e.loadedTypes[7] {
+		return e.ViewPreferences, nil
+	}
+	return nil, &NotLoadedError{edge: "view_preferences"}
+}
+
+// AccessTokensOrErr returns the AccessTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AccessTokensOrErr() ([]*AccessToken, error) {
+	if e.loadedTypes[8] {
+		return e.AccessTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "access_tokens"}
+}
+
+// InvitationsOrErr returns the Invitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) InvitationsOrErr() ([]*Invitation, error) {
+	if e.loadedTypes[9] {
+		return e.Invitations, nil
+	}
+	return nil, &NotLoadedError{edge: "invitations"}
+}
+
+// EmailsOrErr returns the Emails value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) EmailsOrErr() ([]*UserEmail, error) {
+	if e.loadedTypes[10] {
+		return e.Emails, nil
+	}
+	return nil, &NotLoadedError{edge: "emails"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[11] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
+// SavedSearchesOrErr returns the SavedSearches value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SavedSearchesOrErr() ([]*SavedSearch, error) {
+	if e.loadedTypes[12] {
+		return e.SavedSearches, nil
+	}
+	return nil, &NotLoadedError{edge: "saved_searches"}
+}
+
+// RssSubscriptionsOrErr returns the RssSubscriptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RssSubscriptionsOrErr() ([]*RssSubscription, error) {
+	if e.loadedTypes[13] {
+		return e.RssSubscriptions, nil
+	}
+	return nil, &NotLoadedError{edge: "rss_subscriptions"}
+}
+
+// AutomationRulesOrErr returns the AutomationRules value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AutomationRulesOrErr() ([]*AutomationRule, error) {
+	if e.loadedTypes[14] {
+		return e.AutomationRules, nil
+	}
+	return nil, &NotLoadedError{edge: "automation_rules"}
+}
+
+// SyncJobsOrErr returns the SyncJobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SyncJobsOrErr() ([]*SyncJob, error) {
+	if e.loadedTypes[15] {
+		return e.SyncJobs, nil
+	}
+	return nil, &NotLoadedError{edge: "sync_jobs"}
+}
+
+// RetentionRulesOrErr returns the RetentionRules value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RetentionRulesOrErr() ([]*RetentionRule, error) {
+	if e.loadedTypes[16] {
+		return e.RetentionRules, nil
+	}
+	return nil, &NotLoadedError{edge: "retention_rules"}
+}
+
+// ModerationCasesOrErr returns the ModerationCases value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ModerationCasesOrErr() ([]*ModerationCase, error) {
+	if e.loadedTypes[17] {
+		return e.ModerationCases, nil
+	}
+	return nil, &NotLoadedError{edge: "moderation_cases"}
+}
+
+// S3AccessKeysOrErr returns the S3AccessKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) S3AccessKeysOrErr() ([]*S3AccessKey, error) {
+	if e.loadedTypes[18] {
+		return e.S3AccessKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "s3_access_keys"}
+}
+
+// CheckoutsOrErr returns the Checkouts value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CheckoutsOrErr() ([]*Checkout, error) {
+	if e.loadedTypes[19] {
+		return e.Checkouts, nil
+	}
+	return nil, &NotLoadedError{edge: "checkouts"}
+}
+
+// DismissedAnnouncementsOrErr returns the DismissedAnnouncements value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) DismissedAnnouncementsOrErr() ([]*Announcement, error) {
+	if e.loadedTypes[20] {
+		return e.DismissedAnnouncements, nil
+	}
+	return nil, &NotLoadedError{edge: "dismissed_announcements"}
+}
+
+// AdminOrganizationsOrErr returns the AdminOrganizations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AdminOrganizationsOrErr() ([]*Organization, error) {
+	if e.loadedTypes[21] {
+		return e.AdminOrganizations, nil
+	}
+	return nil, &NotLoadedError{edge: "admin_organizations"}
+}
+
+// InvitationOrErr returns the Invitation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) InvitationOrErr() (*Invitation, error) {
+	if e.loadedTypes[22] {
+		if e.Invitation == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: invitation.Label}
+		}
+		return e.Invitation, nil
+	}
+	return nil, &NotLoadedError{edge: "invitation"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -145,12 +359,14 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldSettings:
 			values[i] = new([]byte)
-		case user.FieldID, user.FieldStorage, user.FieldGroupUsers:
+		case user.FieldID, user.FieldStorage, user.FieldGroupUsers, user.FieldStoragePolicyOverride, user.FieldMaxStorageOverride:
 			values[i] = new(sql.NullInt64)
 		case user.FieldEmail, user.FieldNick, user.FieldPassword, user.FieldStatus, user.FieldTwoFactorSecret, user.FieldAvatar:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldDeletedAt:
+		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldDeletedAt, user.FieldGroupExpiresAt, user.FieldEmailChangeAt:
 			values[i] = new(sql.NullTime)
+		case user.ForeignKeys[0]: // invitation_invitees
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -247,6 +463,40 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.GroupUsers = int(value.Int64)
 			}
+		case user.FieldStoragePolicyOverride:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_policy_override", values[i])
+			} else if value.Valid {
+				u.StoragePolicyOverride = int(value.Int64)
+			}
+		case user.FieldMaxStorageOverride:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_storage_override", values[i])
+			} else if value.Valid {
+				u.MaxStorageOverride = new(int64)
+				*u.MaxStorageOverride = value.Int64
+			}
+		case user.FieldGroupExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field group_expires_at", values[i])
+			} else if value.Valid {
+				u.GroupExpiresAt = new(time.Time)
+				*u.GroupExpiresAt = value.Time
+			}
+		case user.FieldEmailChangeAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field email_change_at", values[i])
+			} else if value.Valid {
+				u.EmailChangeAt = new(time.Time)
+				*u.EmailChangeAt = value.Time
+			}
+		case user.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field invitation_invitees", value)
+			} else if value.Valid {
+				u.invitation_invitees = new(int)
+				*u.invitation_invitees = int(value.Int64)
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -295,6 +545,86 @@ func (u *User) QueryEntities() *EntityQuery {
 	return NewUserClient(u.config).QueryEntities(u)
 }
 
+// QueryViewPreferences queries the "view_preferences" edge of the User entity.
+func (u *User) QueryViewPreferences() *ViewPreferenceQuery {
+	return NewUserClient(u.config).QueryViewPreferences(u)
+}
+
+// QueryAccessTokens queries the "access_tokens" edge of the User entity.
+func (u *User) QueryAccessTokens() *AccessTokenQuery {
+	return NewUserClient(u.config).QueryAccessTokens(u)
+}
+
+// QueryInvitations queries the "invitations" edge of the User entity.
+func (u *User) QueryInvitations() *InvitationQuery {
+	return NewUserClient(u.config).QueryInvitations(u)
+}
+
+// QueryEmails queries the "emails" edge of the User entity.
+func (u *User) QueryEmails() *UserEmailQuery {
+	return NewUserClient(u.config).QueryEmails(u)
+}
+
+// QueryNotifications queries the "notifications" edge of the User entity.
+func (u *User) QueryNotifications() *NotificationQuery {
+	return NewUserClient(u.config).QueryNotifications(u)
+}
+
+// QuerySavedSearches queries the "saved_searches" edge of the User entity.
+func (u *User) QuerySavedSearches() *SavedSearchQuery {
+	return NewUserClient(u.config).QuerySavedSearches(u)
+}
+
+// QueryRssSubscriptions queries the "rss_subscriptions" edge of the User entity.
+func (u *User) QueryRssSubscriptions() *RssSubscriptionQuery {
+	return NewUserClient(u.config).QueryRssSubscriptions(u)
+}
+
+// QueryAutomationRules queries the "automation_rules" edge of the User entity.
+func (u *User) QueryAutomationRules() *AutomationRuleQuery {
+	return NewUserClient(u.config).QueryAutomationRules(u)
+}
+
+// QuerySyncJobs queries the "sync_jobs" edge of the User entity.
+func (u *User) QuerySyncJobs() *SyncJobQuery {
+	return NewUserClient(u.config).QuerySyncJobs(u)
+}
+
+// QueryRetentionRules queries the "retention_rules" edge of the User entity.
+func (u *User) QueryRetentionRules() *RetentionRuleQuery {
+	return NewUserClient(u.config).QueryRetentionRules(u)
+}
+
+// QueryModerationCases queries the "moderation_cases" edge of the User entity.
+func (u *User) QueryModerationCases() *ModerationCaseQuery {
+	return NewUserClient(u.config).QueryModerationCases(u)
+}
+
+// QueryS3AccessKeys queries the "s3_access_keys" edge of the User entity.
+func (u *User) QueryS3AccessKeys() *S3AccessKeyQuery {
+	return NewUserClient(u.config).QueryS3AccessKeys(u)
+}
+
+// QueryCheckouts queries the "checkouts" edge of the User entity.
+func (u *User) QueryCheckouts() *CheckoutQuery {
+	return NewUserClient(u.config).QueryCheckouts(u)
+}
+
+// QueryDismissedAnnouncements queries the "dismissed_announcements" edge of the User entity.
+func (u *User) QueryDismissedAnnouncements() *AnnouncementQuery {
+	return NewUserClient(u.config).QueryDismissedAnnouncements(u)
+}
+
+// QueryAdminOrganizations queries the "admin_organizations" edge of the User entity.
+func (u *User) QueryAdminOrganizations() *OrganizationQuery {
+	return NewUserClient(u.config).QueryAdminOrganizations(u)
+}
+
+// QueryInvitation queries the "invitation" edge of the User entity.
+func (u *User) QueryInvitation() *InvitationQuery {
+	return NewUserClient(u.config).QueryInvitation(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -353,6 +683,24 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("group_users=")
 	builder.WriteString(fmt.Sprintf("%v", u.GroupUsers))
+	builder.WriteString(", ")
+	builder.WriteString("storage_policy_override=")
+	builder.WriteString(fmt.Sprintf("%v", u.StoragePolicyOverride))
+	builder.WriteString(", ")
+	if v := u.MaxStorageOverride; v != nil {
+		builder.WriteString("max_storage_override=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := u.GroupExpiresAt; v != nil {
+		builder.WriteString("group_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.EmailChangeAt; v != nil {
+		builder.WriteString("email_change_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -399,5 +747,605 @@ func (e *User) SetEntities(v []*Entity) {
 	e.Edges.loadedTypes[6] = true
 }
 
+// SetViewPreferences manually set the edge as loaded state.
+func (e *User) SetViewPreferences(v []*ViewPreference) {
+	e.Edges.ViewPreferences = v
+	e.Edges.loadedTypes[7] = true
+}
+
+// SetAccessTokens manually set the edge as loaded state.
+func (e *User) SetAccessTokens(v []*AccessToken) {
+	e.Edges.AccessTokens = v
+	e.Edges.loadedTypes[8] = true
+}
+
+// SetInvitations manually set the edge as loaded state.
+func (e *User) SetInvitations(v []*Invitation) {
+	e.Edges.Invitations = v
+	e.Edges.loadedTypes[9] = true
+}
+
+// SetEmails manually set the edge as loaded state.
+func (e *User) SetEmails(v []*UserEmail) {
+	e.Edges.Emails = v
+	e.Edges.loadedTypes[10] = true
+}
+
+// SetNotifications manually set the edge as loaded state.
+func (e *User) SetNotifications(v []*Notification) {
+	e.Edges.Notifications = v
+	e.Edges.loadedTypes[11] = true
+}
+
+// SetSavedSearches manually set the edge as loaded state.
+func (e *User) SetSavedSearches(v []*SavedSearch) {
+	e.Edges.SavedSearches = v
+	e.Edges.loadedTypes[12] = true
+}
+
+// SetRssSubscriptions manually set the edge as loaded state.
+func (e *User) SetRssSubscriptions(v []*RssSubscription) {
+	e.Edges.RssSubscriptions = v
+	e.Edges.loadedTypes[13] = true
+}
+
+// SetAutomationRules manually set the edge as loaded state.
+func (e *User) SetAutomationRules(v []*AutomationRule) {
+	e.Edges.AutomationRules = v
+	e.Edges.loadedTypes[14] = true
+}
+
+// SetSyncJobs manually set the edge as loaded state.
+func (e *User) SetSyncJobs(v []*SyncJob) {
+	e.Edges.SyncJobs = v
+	e.Edges.loadedTypes[15] = true
+}
+
+// SetRetentionRules manually set the edge as loaded state.
+func (e *User) SetRetentionRules(v []*RetentionRule) {
+	e.Edges.RetentionRules = v
+	e.Edges.loadedTypes[16] = true
+}
+
+// SetModerationCases manually set the edge as loaded state.
+func (e *User) SetModerationCases(v []*ModerationCase) {
+	e.Edges.ModerationCases = v
+	e.Edges.loadedTypes[17] = true
+}
+
+// SetS3AccessKeys manually set the edge as loaded state.
+func (e *User) SetS3AccessKeys(v []*S3AccessKey) {
+	e.Edges.S3AccessKeys = v
+	e.Edges.loadedTypes[18] = true
+}
+
+// SetCheckouts manually set the edge as loaded state.
+func (e *User) SetCheckouts(v []*Checkout) {
+	e.Edges.Checkouts = v
+	e.Edges.loadedTypes[19] = true
+}
+
+// SetDismissedAnnouncements manually set the edge as loaded state.
+func (e *User) SetDismissedAnnouncements(v []*Announcement) {
+	e.Edges.DismissedAnnouncements = v
+	e.Edges.loadedTypes[20] = true
+}
+
+// SetAdminOrganizations manually set the edge as loaded state.
+func (e *User) SetAdminOrganizations(v []*Organization) {
+	e.Edges.AdminOrganizations = v
+	e.Edges.loadedTypes[21] = true
+}
+
+// SetInvitation manually set the edge as loaded state.
+func (e *User) SetInvitation(v *Invitation) {
+	e.Edges.Invitation = v
+	e.Edges.loadedTypes[22] = true
+}
+
+// NamedFiles returns the Files named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedFiles(name string) ([]*File, error) {
+	if u.Edges.namedFiles == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedFiles[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedFiles(name string, edges ...*File) {
+	if u.Edges.namedFiles == nil {
+		u.Edges.namedFiles = make(map[string][]*File)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedFiles[name] = []*File{}
+	} else {
+		u.Edges.namedFiles[name] = append(u.Edges.namedFiles[name], edges...)
+	}
+}
+
+// NamedDavAccounts returns the DavAccounts named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedDavAccounts(name string) ([]*DavAccount, error) {
+	if u.Edges.namedDavAccounts == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedDavAccounts[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedDavAccounts(name string, edges ...*DavAccount) {
+	if u.Edges.namedDavAccounts == nil {
+		u.Edges.namedDavAccounts = make(map[string][]*DavAccount)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedDavAccounts[name] = []*DavAccount{}
+	} else {
+		u.Edges.namedDavAccounts[name] = append(u.Edges.namedDavAccounts[name], edges...)
+	}
+}
+
+// NamedShares returns the Shares named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedShares(name string) ([]*Share, error) {
+	if u.Edges.namedShares == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedShares[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedShares(name string, edges ...*Share) {
+	if u.Edges.namedShares == nil {
+		u.Edges.namedShares = make(map[string][]*Share)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedShares[name] = []*Share{}
+	} else {
+		u.Edges.namedShares[name] = append(u.Edges.namedShares[name], edges...)
+	}
+}
+
+// NamedPasskey returns the Passkey named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedPasskey(name string) ([]*Passkey, error) {
+	if u.Edges.namedPasskey == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedPasskey[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedPasskey(name string, edges ...*Passkey) {
+	if u.Edges.namedPasskey == nil {
+		u.Edges.namedPasskey = make(map[string][]*Passkey)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedPasskey[name] = []*Passkey{}
+	} else {
+		u.Edges.namedPasskey[name] = append(u.Edges.namedPasskey[name], edges...)
+	}
+}
+
+// NamedTasks returns the Tasks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedTasks(name string) ([]*Task, error) {
+	if u.Edges.namedTasks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedTasks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedTasks(name string, edges ...*Task) {
+	if u.Edges.namedTasks == nil {
+		u.Edges.namedTasks = make(map[string][]*Task)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedTasks[name] = []*Task{}
+	} else {
+		u.Edges.namedTasks[name] = append(u.Edges.namedTasks[name], edges...)
+	}
+}
+
+// NamedEntities returns the Entities named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedEntities(name string) ([]*Entity, error) {
+	if u.Edges.namedEntities == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedEntities[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedEntities(name string, edges ...*Entity) {
+	if u.Edges.namedEntities == nil {
+		u.Edges.namedEntities = make(map[string][]*Entity)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedEntities[name] = []*Entity{}
+	} else {
+		u.Edges.namedEntities[name] = append(u.Edges.namedEntities[name], edges...)
+	}
+}
+
+// NamedViewPreferences returns the ViewPreferences named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedViewPreferences(name string) ([]*ViewPreference, error) {
+	if u.Edges.namedViewPreferences == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedViewPreferences[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedViewPreferences(name string, edges ...*ViewPreference) {
+	if u.Edges.namedViewPreferences == nil {
+		u.Edges.namedViewPreferences = make(map[string][]*ViewPreference)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedViewPreferences[name] = []*ViewPreference{}
+	} else {
+		u.Edges.namedViewPreferences[name] = append(u.Edges.namedViewPreferences[name], edges...)
+	}
+}
+
+// NamedAccessTokens returns the AccessTokens named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedAccessTokens(name string) ([]*AccessToken, error) {
+	if u.Edges.namedAccessTokens == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedAccessTokens[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedAccessTokens(name string, edges ...*AccessToken) {
+	if u.Edges.namedAccessTokens == nil {
+		u.Edges.namedAccessTokens = make(map[string][]*AccessToken)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedAccessTokens[name] = []*AccessToken{}
+	} else {
+		u.Edges.namedAccessTokens[name] = append(u.Edges.namedAccessTokens[name], edges...)
+	}
+}
+
+// NamedInvitations returns the Invitations named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedInvitations(name string) ([]*Invitation, error) {
+	if u.Edges.namedInvitations == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedInvitations[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedInvitations(name string, edges ...*Invitation) {
+	if u.Edges.namedInvitations == nil {
+		u.Edges.namedInvitations = make(map[string][]*Invitation)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedInvitations[name] = []*Invitation{}
+	} else {
+		u.Edges.namedInvitations[name] = append(u.Edges.namedInvitations[name], edges...)
+	}
+}
+
+// NamedEmails returns the Emails named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedEmails(name string) ([]*UserEmail, error) {
+	if u.Edges.namedEmails == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedEmails[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedEmails(name string, edges ...*UserEmail) {
+	if u.Edges.namedEmails == nil {
+		u.Edges.namedEmails = make(map[string][]*UserEmail)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedEmails[name] = []*UserEmail{}
+	} else {
+		u.Edges.namedEmails[name] = append(u.Edges.namedEmails[name], edges...)
+	}
+}
+
+// NamedNotifications returns the Notifications named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedNotifications(name string) ([]*Notification, error) {
+	if u.Edges.namedNotifications == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedNotifications[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedNotifications(name string, edges ...*Notification) {
+	if u.Edges.namedNotifications == nil {
+		u.Edges.namedNotifications = make(map[string][]*Notification)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedNotifications[name] = []*Notification{}
+	} else {
+		u.Edges.namedNotifications[name] = append(u.Edges.namedNotifications[name], edges...)
+	}
+}
+
+// NamedSavedSearches returns the SavedSearches named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedSavedSearches(name string) ([]*SavedSearch, error) {
+	if u.Edges.namedSavedSearches == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedSavedSearches[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedSavedSearches(name string, edges ...*SavedSearch) {
+	if u.Edges.namedSavedSearches == nil {
+		u.Edges.namedSavedSearches = make(map[string][]*SavedSearch)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedSavedSearches[name] = []*SavedSearch{}
+	} else {
+		u.Edges.namedSavedSearches[name] = append(u.Edges.namedSavedSearches[name], edges...)
+	}
+}
+
+// NamedRssSubscriptions returns the RssSubscriptions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedRssSubscriptions(name string) ([]*RssSubscription, error) {
+	if u.Edges.namedRssSubscriptions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedRssSubscriptions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedRssSubscriptions(name string, edges ...*RssSubscription) {
+	if u.Edges.namedRssSubscriptions == nil {
+		u.Edges.namedRssSubscriptions = make(map[string][]*RssSubscription)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedRssSubscriptions[name] = []*RssSubscription{}
+	} else {
+		u.Edges.namedRssSubscriptions[name] = append(u.Edges.namedRssSubscriptions[name], edges...)
+	}
+}
+
+// NamedAutomationRules returns the AutomationRules named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedAutomationRules(name string) ([]*AutomationRule, error) {
+	if u.Edges.namedAutomationRules == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedAutomationRules[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedAutomationRules(name string, edges ...*AutomationRule) {
+	if u.Edges.namedAutomationRules == nil {
+		u.Edges.namedAutomationRules = make(map[string][]*AutomationRule)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedAutomationRules[name] = []*AutomationRule{}
+	} else {
+		u.Edges.namedAutomationRules[name] = append(u.Edges.namedAutomationRules[name], edges...)
+	}
+}
+
+// NamedSyncJobs returns the SyncJobs named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedSyncJobs(name string) ([]*SyncJob, error) {
+	if u.Edges.namedSyncJobs == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedSyncJobs[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedSyncJobs(name string, edges ...*SyncJob) {
+	if u.Edges.namedSyncJobs == nil {
+		u.Edges.namedSyncJobs = make(map[string][]*SyncJob)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedSyncJobs[name] = []*SyncJob{}
+	} else {
+		u.Edges.namedSyncJobs[name] = append(u.Edges.namedSyncJobs[name], edges...)
+	}
+}
+
+// NamedRetentionRules returns the RetentionRules named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedRetentionRules(name string) ([]*RetentionRule, error) {
+	if u.Edges.namedRetentionRules == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedRetentionRules[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedRetentionRules(name string, edges ...*RetentionRule) {
+	if u.Edges.namedRetentionRules == nil {
+		u.Edges.namedRetentionRules = make(map[string][]*RetentionRule)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedRetentionRules[name] = []*RetentionRule{}
+	} else {
+		u.Edges.namedRetentionRules[name] = append(u.Edges.namedRetentionRules[name], edges...)
+	}
+}
+
+// NamedModerationCases returns the ModerationCases named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedModerationCases(name string) ([]*ModerationCase, error) {
+	if u.Edges.namedModerationCases == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedModerationCases[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedModerationCases(name string, edges ...*ModerationCase) {
+	if u.Edges.namedModerationCases == nil {
+		u.Edges.namedModerationCases = make(map[string][]*ModerationCase)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedModerationCases[name] = []*ModerationCase{}
+	} else {
+		u.Edges.namedModerationCases[name] = append(u.Edges.namedModerationCases[name], edges...)
+	}
+}
+
+// NamedS3AccessKeys returns the S3AccessKeys named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedS3AccessKeys(name string) ([]*S3AccessKey, error) {
+	if u.Edges.namedS3AccessKeys == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedS3AccessKeys[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedS3AccessKeys(name string, edges ...*S3AccessKey) {
+	if u.Edges.namedS3AccessKeys == nil {
+		u.Edges.namedS3AccessKeys = make(map[string][]*S3AccessKey)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedS3AccessKeys[name] = []*S3AccessKey{}
+	} else {
+		u.Edges.namedS3AccessKeys[name] = append(u.Edges.namedS3AccessKeys[name], edges...)
+	}
+}
+
+// NamedCheckouts returns the Checkouts named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedCheckouts(name string) ([]*Checkout, error) {
+	if u.Edges.namedCheckouts == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedCheckouts[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedCheckouts(name string, edges ...*Checkout) {
+	if u.Edges.namedCheckouts == nil {
+		u.Edges.namedCheckouts = make(map[string][]*Checkout)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedCheckouts[name] = []*Checkout{}
+	} else {
+		u.Edges.namedCheckouts[name] = append(u.Edges.namedCheckouts[name], edges...)
+	}
+}
+
+// NamedDismissedAnnouncements returns the DismissedAnnouncements named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedDismissedAnnouncements(name string) ([]*Announcement, error) {
+	if u.Edges.namedDismissedAnnouncements == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedDismissedAnnouncements[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedDismissedAnnouncements(name string, edges ...*Announcement) {
+	if u.Edges.namedDismissedAnnouncements == nil {
+		u.Edges.namedDismissedAnnouncements = make(map[string][]*Announcement)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedDismissedAnnouncements[name] = []*Announcement{}
+	} else {
+		u.Edges.namedDismissedAnnouncements[name] = append(u.Edges.namedDismissedAnnouncements[name], edges...)
+	}
+}
+
+// NamedAdminOrganizations returns the AdminOrganizations named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (u *User) NamedAdminOrganizations(name string) ([]*Organization, error) {
+	if u.Edges.namedAdminOrganizations == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := u.Edges.namedAdminOrganizations[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (u *User) appendNamedAdminOrganizations(name string, edges ...*Organization) {
+	if u.Edges.namedAdminOrganizations == nil {
+		u.Edges.namedAdminOrganizations = make(map[string][]*Organization)
+	}
+	if len(edges) == 0 {
+		u.Edges.namedAdminOrganizations[name] = []*Organization{}
+	} else {
+		u.Edges.namedAdminOrganizations[name] = append(u.Edges.namedAdminOrganizations[name], edges...)
+	}
+}
+
 // Users is a parsable slice of User.
 type Users []*User
@@ -4,6 +4,8 @@ package user
 
 import (
 	"fmt"
+	"io"
+	"strconv"
 	"time"
 
 	"entgo.io/ent"
@@ -41,6 +43,14 @@ const (
 	FieldSettings = "settings"
 	// FieldGroupUsers holds the string denoting the group_users field in the database.
 	FieldGroupUsers = "group_users"
+	// FieldStoragePolicyOverride holds the string denoting the storage_policy_override field in the database.
+	FieldStoragePolicyOverride = "storage_policy_override"
+	// FieldMaxStorageOverride holds the string denoting the max_storage_override field in the database.
+	FieldMaxStorageOverride = "max_storage_override"
+	// FieldGroupExpiresAt holds the string denoting the group_expires_at field in the database.
+	FieldGroupExpiresAt = "group_expires_at"
+	// FieldEmailChangeAt holds the string denoting the email_change_at field in the database.
+	FieldEmailChangeAt = "email_change_at"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
 	EdgeGroup = "group"
 	// EdgeFiles holds the string denoting the files edge name in mutations.
@@ -55,6 +65,38 @@ const (
 	EdgeTasks = "tasks"
 	// EdgeEntities holds the string denoting the entities edge name in mutations.
 	EdgeEntities = "entities"
+	// EdgeViewPreferences holds the string denoting the view_preferences edge name in mutations.
+	EdgeViewPreferences = "view_preferences"
+	// EdgeAccessTokens holds the string denoting the access_tokens edge name in mutations.
+	EdgeAccessTokens = "access_tokens"
+	// EdgeInvitations holds the string denoting the invitations edge name in mutations.
+	EdgeInvitations = "invitations"
+	// EdgeEmails holds the string denoting the emails edge name in mutations.
+	EdgeEmails = "emails"
+	// EdgeNotifications holds the string denoting the notifications edge name in mutations.
+	EdgeNotifications = "notifications"
+	// EdgeSavedSearches holds the string denoting the saved_searches edge name in mutations.
+	EdgeSavedSearches = "saved_searches"
+	// EdgeRssSubscriptions holds the string denoting the rss_subscriptions edge name in mutations.
+	EdgeRssSubscriptions = "rss_subscriptions"
+	// EdgeAutomationRules holds the string denoting the automation_rules edge name in mutations.
+	EdgeAutomationRules = "automation_rules"
+	// EdgeSyncJobs holds the string denoting the sync_jobs edge name in mutations.
+	EdgeSyncJobs = "sync_jobs"
+	// EdgeRetentionRules holds the string denoting the retention_rules edge name in mutations.
+	EdgeRetentionRules = "retention_rules"
+	// EdgeModerationCases holds the string denoting the moderation_cases edge name in mutations.
+	EdgeModerationCases = "moderation_cases"
+	// EdgeS3AccessKeys holds the string denoting the s3_access_keys edge name in mutations.
+	EdgeS3AccessKeys = "s3_access_keys"
+	// EdgeCheckouts holds the string denoting the checkouts edge name in mutations.
+	EdgeCheckouts = "checkouts"
+	// EdgeDismissedAnnouncements holds the string denoting the dismissed_announcements edge name in mutations.
+	EdgeDismissedAnnouncements = "dismissed_announcements"
+	// EdgeAdminOrganizations holds the string denoting the admin_organizations edge name in mutations.
+	EdgeAdminOrganizations = "admin_organizations"
+	// EdgeInvitation holds the string denoting the invitation edge name in mutations.
+	EdgeInvitation = "invitation"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// GroupTable is the table that holds the group relation/edge.
@@ -106,6 +148,114 @@ const (
 	EntitiesInverseTable = "entities"
 	// EntitiesColumn is the table column denoting the entities relation/edge.
 	EntitiesColumn = "created_by"
+	// ViewPreferencesTable is the table that holds the view_preferences relation/edge.
+	ViewPreferencesTable = "view_preferences"
+	// ViewPreferencesInverseTable is the table name for the ViewPreference entity.
+	// It exists in this package in order to avoid circular dependency with the "viewpreference" package.
+	ViewPreferencesInverseTable = "view_preferences"
+	// ViewPreferencesColumn is the table column denoting the view_preferences relation/edge.
+	ViewPreferencesColumn = "user_view_preferences"
+	// AccessTokensTable is the table that holds the access_tokens relation/edge.
+	AccessTokensTable = "access_tokens"
+	// AccessTokensInverseTable is the table name for the AccessToken entity.
+	// It exists in this package in order to avoid circular dependency with the "accesstoken" package.
+	AccessTokensInverseTable = "access_tokens"
+	// AccessTokensColumn is the table column denoting the access_tokens relation/edge.
+	AccessTokensColumn = "user_id"
+	// InvitationsTable is the table that holds the invitations relation/edge.
+	InvitationsTable = "invitations"
+	// InvitationsInverseTable is the table name for the Invitation entity.
+	// It exists in this package in order to avoid circular dependency with the "invitation" package.
+	InvitationsInverseTable = "invitations"
+	// InvitationsColumn is the table column denoting the invitations relation/edge.
+	InvitationsColumn = "user_id"
+	// EmailsTable is the table that holds the emails relation/edge.
+	EmailsTable = "user_emails"
+	// EmailsInverseTable is the table name for the UserEmail entity.
+	// It exists in this package in order to avoid circular dependency with the "useremail" package.
+	EmailsInverseTable = "user_emails"
+	// EmailsColumn is the table column denoting the emails relation/edge.
+	EmailsColumn = "user_id"
+	// NotificationsTable is the table that holds the notifications relation/edge.
+	NotificationsTable = "notifications"
+	// NotificationsInverseTable is the table name for the Notification entity.
+	// It exists in this package in order to avoid circular dependency with the "notification" package.
+	NotificationsInverseTable = "notifications"
+	// NotificationsColumn is the table column denoting the notifications relation/edge.
+	NotificationsColumn = "user_id"
+	// SavedSearchesTable is the table that holds the saved_searches relation/edge.
+	SavedSearchesTable = "saved_searches"
+	// SavedSearchesInverseTable is the table name for the SavedSearch entity.
+	// It exists in this package in order to avoid circular dependency with the "savedsearch" package.
+	SavedSearchesInverseTable = "saved_searches"
+	// SavedSearchesColumn is the table column denoting the saved_searches relation/edge.
+	SavedSearchesColumn = "user_id"
+	// RssSubscriptionsTable is the table that holds the rss_subscriptions relation/edge.
+	RssSubscriptionsTable = "rss_subscriptions"
+	// RssSubscriptionsInverseTable is the table name for the RssSubscription entity.
+	// It exists in this package in order to avoid circular dependency with the "rsssubscription" package.
+	RssSubscriptionsInverseTable = "rss_subscriptions"
+	// RssSubscriptionsColumn is the table column denoting the rss_subscriptions relation/edge.
+	RssSubscriptionsColumn = "user_id"
+	// AutomationRulesTable is the table that holds the automation_rules relation/edge.
+	AutomationRulesTable = "automation_rules"
+	// AutomationRulesInverseTable is the table name for the AutomationRule entity.
+	// It exists in this package in order to avoid circular dependency with the "automationrule" package.
+	AutomationRulesInverseTable = "automation_rules"
+	// AutomationRulesColumn is the table column denoting the automation_rules relation/edge.
+	AutomationRulesColumn = "user_id"
+	// SyncJobsTable is the table that holds the sync_jobs relation/edge.
+	SyncJobsTable = "sync_jobs"
+	// SyncJobsInverseTable is the table name for the SyncJob entity.
+	// It exists in this package in order to avoid circular dependency with the "syncjob" package.
+	SyncJobsInverseTable = "sync_jobs"
+	// SyncJobsColumn is the table column denoting the sync_jobs relation/edge.
+	SyncJobsColumn = "user_id"
+	// RetentionRulesTable is the table that holds the retention_rules relation/edge.
+	RetentionRulesTable = "retention_rules"
+	// RetentionRulesInverseTable is the table name for the RetentionRule entity.
+	// It exists in this package in order to avoid circular dependency with the "retentionrule" package.
+	RetentionRulesInverseTable = "retention_rules"
+	// RetentionRulesColumn is the table column denoting the retention_rules relation/edge.
+	RetentionRulesColumn = "user_id"
+	// ModerationCasesTable is the table that holds the moderation_cases relation/edge.
+	ModerationCasesTable = "moderation_cases"
+	// ModerationCasesInverseTable is the table name for the ModerationCase entity.
+	// It exists in this package in order to avoid circular dependency with the "moderationcase" package.
+	ModerationCasesInverseTable = "moderation_cases"
+	// ModerationCasesColumn is the table column denoting the moderation_cases relation/edge.
+	ModerationCasesColumn = "user_id"
+	// S3AccessKeysTable is the table that holds the s3_access_keys relation/edge.
+	S3AccessKeysTable = "s3access_keys"
+	// S3AccessKeysInverseTable is the table name for the S3AccessKey entity.
+	// It exists in this package in order to avoid circular dependency with the "s3accesskey" package.
+	S3AccessKeysInverseTable = "s3access_keys"
+	// S3AccessKeysColumn is the table column denoting the s3_access_keys relation/edge.
+	S3AccessKeysColumn = "user_id"
+	// CheckoutsTable is the table that holds the checkouts relation/edge.
+	CheckoutsTable = "checkouts"
+	// CheckoutsInverseTable is the table name for the Checkout entity.
+	// It exists in this package in order to avoid circular dependency with the "checkout" package.
+	CheckoutsInverseTable = "checkouts"
+	// CheckoutsColumn is the table column denoting the checkouts relation/edge.
+	CheckoutsColumn = "owner_id"
+	// DismissedAnnouncementsTable is the table that holds the dismissed_announcements relation/edge. The primary key declared below.
+	DismissedAnnouncementsTable = "announcement_dismissed_by"
+	// DismissedAnnouncementsInverseTable is the table name for the Announcement entity.
+	// It exists in this package in order to avoid circular dependency with the "announcement" package.
+	DismissedAnnouncementsInverseTable = "announcements"
+	// AdminOrganizationsTable is the table that holds the admin_organizations relation/edge. The primary key declared below.
+	AdminOrganizationsTable = "organization_admins"
+	// AdminOrganizationsInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	AdminOrganizationsInverseTable = "organizations"
+	// InvitationTable is the table that holds the invitation relation/edge.
+	InvitationTable = "users"
+	// InvitationInverseTable is the table name for the Invitation entity.
+	// It exists in this package in order to avoid circular dependency with the "invitation" package.
+	InvitationInverseTable = "invitations"
+	// InvitationColumn is the table column denoting the invitation relation/edge.
+	InvitationColumn = "invitation_invitees"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -123,8 +273,27 @@ var Columns = []string{
 	FieldAvatar,
 	FieldSettings,
 	FieldGroupUsers,
+	FieldStoragePolicyOverride,
+	FieldMaxStorageOverride,
+	FieldGroupExpiresAt,
+	FieldEmailChangeAt,
 }
 
+// ForeignKeys holds the SQL foreign-keys that are owned by the "users"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"invitation_invitees",
+}
+
+var (
+	// DismissedAnnouncementsPrimaryKey and DismissedAnnouncementsColumn2 are the table columns denoting the
+	// primary key for the dismissed_announcements relation (M2M).
+	DismissedAnnouncementsPrimaryKey = []string{"announcement_id", "user_id"}
+	// AdminOrganizationsPrimaryKey and AdminOrganizationsColumn2 are the table columns denoting the
+	// primary key for the admin_organizations relation (M2M).
+	AdminOrganizationsPrimaryKey = []string{"organization_id", "user_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -132,6 +301,11 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
 	return false
 }
 
@@ -171,6 +345,7 @@ const (
 	StatusInactive     Status = "inactive"
 	StatusManualBanned Status = "manual_banned"
 	StatusSysBanned    Status = "sys_banned"
+	StatusArchived     Status = "archived"
 )
 
 func (s Status) String() string {
@@ -180,7 +355,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusActive, StatusInactive, StatusManualBanned, StatusSysBanned:
+	case StatusActive, StatusInactive, StatusManualBanned, StatusSysBanned, StatusArchived:
 		return nil
 	default:
 		return fmt.Errorf("user: invalid enum value for status field: %q", s)
@@ -250,6 +425,26 @@ func ByGroupUsers(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGroupUsers, opts...).ToFunc()
 }
 
+// ByStoragePolicyOverride orders the results by the storage_policy_override field.
+func ByStoragePolicyOverride(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoragePolicyOverride, opts...).ToFunc()
+}
+
+// ByMaxStorageOverride orders the results by the max_storage_override field.
+func ByMaxStorageOverride(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxStorageOverride, opts...).ToFunc()
+}
+
+// ByGroupExpiresAt orders the results by the group_expires_at field.
+func ByGroupExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupExpiresAt, opts...).ToFunc()
+}
+
+// ByEmailChangeAt orders the results by the email_change_at field.
+func ByEmailChangeAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailChangeAt, opts...).ToFunc()
+}
+
 // ByGroupField orders the results by group field.
 func ByGroupField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -340,6 +535,223 @@ func ByEntities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newEntitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByViewPreferencesCount orders the results by view_preferences count.
+func ByViewPreferencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newViewPreferencesStep(), opts...)
+	}
+}
+
+// ByViewPreferences orders the results by view_preferences terms.
+func ByViewPreferences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newViewPreferencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAccessTokensCount orders the results by access_tokens count.
+func ByAccessTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAccessTokensStep(), opts...)
+	}
+}
+
+// ByAccessTokens orders the results by access_tokens terms.
+func ByAccessTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccessTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInvitationsCount orders the results by invitations count.
+func ByInvitationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInvitationsStep(), opts...)
+	}
+}
+
+// ByInvitations orders the results by invitations terms.
+func ByInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvitationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEmailsCount orders the results by emails count.
+func ByEmailsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEmailsStep(), opts...)
+	}
+}
+
+// ByEmails orders the results by emails terms.
+func ByEmails(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEmailsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationsCount orders the results by notifications count.
+func ByNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationsStep(), opts...)
+	}
+}
+
+// ByNotifications orders the results by notifications terms.
+func ByNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavedSearchesCount orders the results by saved_searches count.
+func BySavedSearchesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavedSearchesStep(), opts...)
+	}
+}
+
+// BySavedSearches orders the results by saved_searches terms.
+func BySavedSearches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedSearchesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRssSubscriptionsCount orders the results by rss_subscriptions count.
+func ByRssSubscriptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRssSubscriptionsStep(), opts...)
+	}
+}
+
+// ByRssSubscriptions orders the results by rss_subscriptions terms.
+func ByRssSubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRssSubscriptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAutomationRulesCount orders the results by automation_rules count.
+func ByAutomationRulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAutomationRulesStep(), opts...)
+	}
+}
+
+// ByAutomationRules orders the results by automation_rules terms.
+func ByAutomationRules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAutomationRulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySyncJobsCount orders the results by sync_jobs count.
+func BySyncJobsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSyncJobsStep(), opts...)
+	}
+}
+
+// BySyncJobs orders the results by sync_jobs terms.
+func BySyncJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSyncJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRetentionRulesCount orders the results by retention_rules count.
+func ByRetentionRulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRetentionRulesStep(), opts...)
+	}
+}
+
+// ByRetentionRules orders the results by retention_rules terms.
+func ByRetentionRules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRetentionRulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByModerationCasesCount orders the results by moderation_cases count.
+func ByModerationCasesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newModerationCasesStep(), opts...)
+	}
+}
+
+// ByModerationCases orders the results by moderation_cases terms.
+func ByModerationCases(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newModerationCasesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByS3AccessKeysCount orders the results by s3_access_keys count.
+func ByS3AccessKeysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newS3AccessKeysStep(), opts...)
+	}
+}
+
+// ByS3AccessKeys orders the results by s3_access_keys terms.
+func ByS3AccessKeys(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newS3AccessKeysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCheckoutsCount orders the results by checkouts count.
+func ByCheckoutsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCheckoutsStep(), opts...)
+	}
+}
+
+// ByCheckouts orders the results by checkouts terms.
+func ByCheckouts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCheckoutsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDismissedAnnouncementsCount orders the results by dismissed_announcements count.
+func ByDismissedAnnouncementsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDismissedAnnouncementsStep(), opts...)
+	}
+}
+
+// ByDismissedAnnouncements orders the results by dismissed_announcements terms.
+func ByDismissedAnnouncements(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDismissedAnnouncementsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAdminOrganizationsCount orders the results by admin_organizations count.
+func ByAdminOrganizationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAdminOrganizationsStep(), opts...)
+	}
+}
+
+// ByAdminOrganizations orders the results by admin_organizations terms.
+func ByAdminOrganizations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAdminOrganizationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInvitationField orders the results by invitation field.
+func ByInvitationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInvitationStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newGroupStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -389,3 +801,133 @@ func newEntitiesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, EntitiesTable, EntitiesColumn),
 	)
 }
+func newViewPreferencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ViewPreferencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ViewPreferencesTable, ViewPreferencesColumn),
+	)
+}
+func newAccessTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccessTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AccessTokensTable, AccessTokensColumn),
+	)
+}
+func newInvitationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvitationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InvitationsTable, InvitationsColumn),
+	)
+}
+func newEmailsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EmailsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EmailsTable, EmailsColumn),
+	)
+}
+func newNotificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+	)
+}
+func newSavedSearchesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedSearchesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SavedSearchesTable, SavedSearchesColumn),
+	)
+}
+func newRssSubscriptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RssSubscriptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RssSubscriptionsTable, RssSubscriptionsColumn),
+	)
+}
+func newAutomationRulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AutomationRulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AutomationRulesTable, AutomationRulesColumn),
+	)
+}
+func newSyncJobsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SyncJobsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SyncJobsTable, SyncJobsColumn),
+	)
+}
+func newRetentionRulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RetentionRulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RetentionRulesTable, RetentionRulesColumn),
+	)
+}
+func newModerationCasesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ModerationCasesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ModerationCasesTable, ModerationCasesColumn),
+	)
+}
+func newS3AccessKeysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(S3AccessKeysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, S3AccessKeysTable, S3AccessKeysColumn),
+	)
+}
+func newCheckoutsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CheckoutsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CheckoutsTable, CheckoutsColumn),
+	)
+}
+func newDismissedAnnouncementsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DismissedAnnouncementsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, DismissedAnnouncementsTable, DismissedAnnouncementsPrimaryKey...),
+	)
+}
+func newAdminOrganizationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AdminOrganizationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, AdminOrganizationsTable, AdminOrganizationsPrimaryKey...),
+	)
+}
+func newInvitationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InvitationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, InvitationTable, InvitationColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Status) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Status) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Status(str)
+	if err := StatusValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Status", str)
+	}
+	return nil
+}
@@ -105,6 +105,26 @@ func GroupUsers(v int) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldGroupUsers, v))
 }
 
+// StoragePolicyOverride applies equality check predicate on the "storage_policy_override" field. It's identical to StoragePolicyOverrideEQ.
+func StoragePolicyOverride(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStoragePolicyOverride, v))
+}
+
+// MaxStorageOverride applies equality check predicate on the "max_storage_override" field. It's identical to MaxStorageOverrideEQ.
+func MaxStorageOverride(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMaxStorageOverride, v))
+}
+
+// GroupExpiresAt applies equality check predicate on the "group_expires_at" field. It's identical to GroupExpiresAtEQ.
+func GroupExpiresAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldGroupExpiresAt, v))
+}
+
+// EmailChangeAt applies equality check predicate on the "email_change_at" field. It's identical to EmailChangeAtEQ.
+func EmailChangeAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailChangeAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -680,6 +700,206 @@ func GroupUsersNotIn(vs ...int) predicate.User {
 	return predicate.User(sql.FieldNotIn(FieldGroupUsers, vs...))
 }
 
+// StoragePolicyOverrideEQ applies the EQ predicate on the "storage_policy_override" field.
+func StoragePolicyOverrideEQ(v int) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStoragePolicyOverride, v))
+}
+
+// StoragePolicyOverrideNEQ applies the NEQ predicate on the "storage_policy_override" field.
+func StoragePolicyOverrideNEQ(v int) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldStoragePolicyOverride, v))
+}
+
+// StoragePolicyOverrideIn applies the In predicate on the "storage_policy_override" field.
+func StoragePolicyOverrideIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldIn(FieldStoragePolicyOverride, vs...))
+}
+
+// StoragePolicyOverrideNotIn applies the NotIn predicate on the "storage_policy_override" field.
+func StoragePolicyOverrideNotIn(vs ...int) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldStoragePolicyOverride, vs...))
+}
+
+// StoragePolicyOverrideGT applies the GT predicate on the "storage_policy_override" field.
+func StoragePolicyOverrideGT(v int) predicate.User {
+	return predicate.User(sql.FieldGT(FieldStoragePolicyOverride, v))
+}
+
+// StoragePolicyOverrideGTE applies the GTE predicate on the "storage_policy_override" field.
+func StoragePolicyOverrideGTE(v int) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldStoragePolicyOverride, v))
+}
+
+// StoragePolicyOverrideLT applies the LT predicate on the "storage_policy_override" field.
+func StoragePolicyOverrideLT(v int) predicate.User {
+	return predicate.User(sql.FieldLT(FieldStoragePolicyOverride, v))
+}
+
+// StoragePolicyOverrideLTE applies the LTE predicate on the "storage_policy_override" field.
+func StoragePolicyOverrideLTE(v int) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldStoragePolicyOverride, v))
+}
+
+// StoragePolicyOverrideIsNil applies the IsNil predicate on the "storage_policy_override" field.
+func StoragePolicyOverrideIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldStoragePolicyOverride))
+}
+
+// StoragePolicyOverrideNotNil applies the NotNil predicate on the "storage_policy_override" field.
+func StoragePolicyOverrideNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldStoragePolicyOverride))
+}
+
+// MaxStorageOverrideEQ applies the EQ predicate on the "max_storage_override" field.
+func MaxStorageOverrideEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMaxStorageOverride, v))
+}
+
+// MaxStorageOverrideNEQ applies the NEQ predicate on the "max_storage_override" field.
+func MaxStorageOverrideNEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMaxStorageOverride, v))
+}
+
+// MaxStorageOverrideIn applies the In predicate on the "max_storage_override" field.
+func MaxStorageOverrideIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldIn(FieldMaxStorageOverride, vs...))
+}
+
+// MaxStorageOverrideNotIn applies the NotIn predicate on the "max_storage_override" field.
+func MaxStorageOverrideNotIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldMaxStorageOverride, vs...))
+}
+
+// MaxStorageOverrideGT applies the GT predicate on the "max_storage_override" field.
+func MaxStorageOverrideGT(v int64) predicate.User {
+	return predicate.User(sql.FieldGT(FieldMaxStorageOverride, v))
+}
+
+// MaxStorageOverrideGTE applies the GTE predicate on the "max_storage_override" field.
+func MaxStorageOverrideGTE(v int64) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldMaxStorageOverride, v))
+}
+
+// MaxStorageOverrideLT applies the LT predicate on the "max_storage_override" field.
+func MaxStorageOverrideLT(v int64) predicate.User {
+	return predicate.User(sql.FieldLT(FieldMaxStorageOverride, v))
+}
+
+// MaxStorageOverrideLTE applies the LTE predicate on the "max_storage_override" field.
+func MaxStorageOverrideLTE(v int64) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldMaxStorageOverride, v))
+}
+
+// MaxStorageOverrideIsNil applies the IsNil predicate on the "max_storage_override" field.
+func MaxStorageOverrideIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldMaxStorageOverride))
+}
+
+// MaxStorageOverrideNotNil applies the NotNil predicate on the "max_storage_override" field.
+func MaxStorageOverrideNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldMaxStorageOverride))
+}
+
+// GroupExpiresAtEQ applies the EQ predicate on the "group_expires_at" field.
+func GroupExpiresAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldGroupExpiresAt, v))
+}
+
+// GroupExpiresAtNEQ applies the NEQ predicate on the "group_expires_at" field.
+func GroupExpiresAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldGroupExpiresAt, v))
+}
+
+// GroupExpiresAtIn applies the In predicate on the "group_expires_at" field.
+func GroupExpiresAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldGroupExpiresAt, vs...))
+}
+
+// GroupExpiresAtNotIn applies the NotIn predicate on the "group_expires_at" field.
+func GroupExpiresAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldGroupExpiresAt, vs...))
+}
+
+// GroupExpiresAtGT applies the GT predicate on the "group_expires_at" field.
+func GroupExpiresAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldGroupExpiresAt, v))
+}
+
+// GroupExpiresAtGTE applies the GTE predicate on the "group_expires_at" field.
+func GroupExpiresAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldGroupExpiresAt, v))
+}
+
+// GroupExpiresAtLT applies the LT predicate on the "group_expires_at" field.
+func GroupExpiresAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldGroupExpiresAt, v))
+}
+
+// GroupExpiresAtLTE applies the LTE predicate on the "group_expires_at" field.
+func GroupExpiresAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldGroupExpiresAt, v))
+}
+
+// GroupExpiresAtIsNil applies the IsNil predicate on the "group_expires_at" field.
+func GroupExpiresAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldGroupExpiresAt))
+}
+
+// GroupExpiresAtNotNil applies the NotNil predicate on the "group_expires_at" field.
+func GroupExpiresAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldGroupExpiresAt))
+}
+
+// EmailChangeAtEQ applies the EQ predicate on the "email_change_at" field.
+func EmailChangeAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailChangeAt, v))
+}
+
+// EmailChangeAtNEQ applies the NEQ predicate on the "email_change_at" field.
+func EmailChangeAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldEmailChangeAt, v))
+}
+
+// EmailChangeAtIn applies the In predicate on the "email_change_at" field.
+func EmailChangeAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldEmailChangeAt, vs...))
+}
+
+// EmailChangeAtNotIn applies the NotIn predicate on the "email_change_at" field.
+func EmailChangeAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldEmailChangeAt, vs...))
+}
+
+// EmailChangeAtGT applies the GT predicate on the "email_change_at" field.
+func EmailChangeAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldEmailChangeAt, v))
+}
+
+// EmailChangeAtGTE applies the GTE predicate on the "email_change_at" field.
+func EmailChangeAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldEmailChangeAt, v))
+}
+
+// EmailChangeAtLT applies the LT predicate on the "email_change_at" field.
+func EmailChangeAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldEmailChangeAt, v))
+}
+
+// EmailChangeAtLTE applies the LTE predicate on the "email_change_at" field.
+func EmailChangeAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldEmailChangeAt, v))
+}
+
+// EmailChangeAtIsNil applies the IsNil predicate on the "email_change_at" field.
+func EmailChangeAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldEmailChangeAt))
+}
+
+// EmailChangeAtNotNil applies the NotNil predicate on the "email_change_at" field.
+func EmailChangeAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldEmailChangeAt))
+}
+
 // HasGroup applies the HasEdge predicate on the "group" edge.
 func HasGroup() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -841,6 +1061,374 @@ func HasEntitiesWith(preds ...predicate.Entity) predicate.User {
 	})
 }
 
+// HasViewPreferences applies the HasEdge predicate on the "view_preferences" edge.
+func HasViewPreferences() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ViewPreferencesTable, ViewPreferencesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasViewPreferencesWith applies the HasEdge predicate on the "view_preferences" edge with a given conditions (other predicates).
+func HasViewPreferencesWith(preds ...predicate.ViewPreference) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newViewPreferencesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccessTokens applies the HasEdge predicate on the "access_tokens" edge.
+func HasAccessTokens() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AccessTokensTable, AccessTokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccessTokensWith applies the HasEdge predicate on the "access_tokens" edge with a given conditions (other predicates).
+func HasAccessTokensWith(preds ...predicate.AccessToken) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAccessTokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvitations applies the HasEdge predicate on the "invitations" edge.
+func HasInvitations() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InvitationsTable, InvitationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvitationsWith applies the HasEdge predicate on the "invitations" edge with a given conditions (other predicates).
+func HasInvitationsWith(preds ...predicate.Invitation) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newInvitationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEmails applies the HasEdge predicate on the "emails" edge.
+func HasEmails() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EmailsTable, EmailsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEmailsWith applies the HasEdge predicate on the "emails" edge with a given conditions (other predicates).
+func HasEmailsWith(preds ...predicate.UserEmail) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newEmailsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotifications applies the HasEdge predicate on the "notifications" edge.
+func HasNotifications() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationsWith applies the HasEdge predicate on the "notifications" edge with a given conditions (other predicates).
+func HasNotificationsWith(preds ...predicate.Notification) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newNotificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSavedSearches applies the HasEdge predicate on the "saved_searches" edge.
+func HasSavedSearches() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SavedSearchesTable, SavedSearchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavedSearchesWith applies the HasEdge predicate on the "saved_searches" edge with a given conditions (other predicates).
+func HasSavedSearchesWith(preds ...predicate.SavedSearch) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSavedSearchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRssSubscriptions applies the HasEdge predicate on the "rss_subscriptions" edge.
+func HasRssSubscriptions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RssSubscriptionsTable, RssSubscriptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRssSubscriptionsWith applies the HasEdge predicate on the "rss_subscriptions" edge with a given conditions (other predicates).
+func HasRssSubscriptionsWith(preds ...predicate.RssSubscription) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRssSubscriptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAutomationRules applies the HasEdge predicate on the "automation_rules" edge.
+func HasAutomationRules() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AutomationRulesTable, AutomationRulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAutomationRulesWith applies the HasEdge predicate on the "automation_rules" edge with a given conditions (other predicates).
+func HasAutomationRulesWith(preds ...predicate.AutomationRule) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAutomationRulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSyncJobs applies the HasEdge predicate on the "sync_jobs" edge.
+func HasSyncJobs() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SyncJobsTable, SyncJobsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSyncJobsWith applies the HasEdge predicate on the "sync_jobs" edge with a given conditions (other predicates).
+func HasSyncJobsWith(preds ...predicate.SyncJob) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSyncJobsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRetentionRules applies the HasEdge predicate on the "retention_rules" edge.
+func HasRetentionRules() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RetentionRulesTable, RetentionRulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRetentionRulesWith applies the HasEdge predicate on the "retention_rules" edge with a given conditions (other predicates).
+func HasRetentionRulesWith(preds ...predicate.RetentionRule) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRetentionRulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasModerationCases applies the HasEdge predicate on the "moderation_cases" edge.
+func HasModerationCases() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ModerationCasesTable, ModerationCasesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasModerationCasesWith applies the HasEdge predicate on the "moderation_cases" edge with a given conditions (other predicates).
+func HasModerationCasesWith(preds ...predicate.ModerationCase) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newModerationCasesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasS3AccessKeys applies the HasEdge predicate on the "s3_access_keys" edge.
+func HasS3AccessKeys() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, S3AccessKeysTable, S3AccessKeysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasS3AccessKeysWith applies the HasEdge predicate on the "s3_access_keys" edge with a given conditions (other predicates).
+func HasS3AccessKeysWith(preds ...predicate.S3AccessKey) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newS3AccessKeysStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCheckouts applies the HasEdge predicate on the "checkouts" edge.
+func HasCheckouts() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CheckoutsTable, CheckoutsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCheckoutsWith applies the HasEdge predicate on the "checkouts" edge with a given conditions (other predicates).
+func HasCheckoutsWith(preds ...predicate.Checkout) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newCheckoutsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDismissedAnnouncements applies the HasEdge predicate on the "dismissed_announcements" edge.
+func HasDismissedAnnouncements() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, DismissedAnnouncementsTable, DismissedAnnouncementsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDismissedAnnouncementsWith applies the HasEdge predicate on the "dismissed_announcements" edge with a given conditions (other predicates).
+func HasDismissedAnnouncementsWith(preds ...predicate.Announcement) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newDismissedAnnouncementsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAdminOrganizations applies the HasEdge predicate on the "admin_organizations" edge.
+func HasAdminOrganizations() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, AdminOrganizationsTable, AdminOrganizationsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAdminOrganizationsWith applies the HasEdge predicate on the "admin_organizations" edge with a given conditions (other predicates).
+func HasAdminOrganizationsWith(preds ...predicate.Organization) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAdminOrganizationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvitation applies the HasEdge predicate on the "invitation" edge.
+func HasInvitation() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, InvitationTable, InvitationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvitationWith applies the HasEdge predicate on the "invitation" edge with a given conditions (other predicates).
+func HasInvitationWith(preds ...predicate.Invitation) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newInvitationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -11,14 +11,29 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/accesstoken"
+	"github.com/cloudreve/Cloudreve/v4/ent/announcement"
+	"github.com/cloudreve/Cloudreve/v4/ent/automationrule"
+	"github.com/cloudreve/Cloudreve/v4/ent/checkout"
 	"github.com/cloudreve/Cloudreve/v4/ent/davaccount"
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
+	"github.com/cloudreve/Cloudreve/v4/ent/invitation"
+	"github.com/cloudreve/Cloudreve/v4/ent/moderationcase"
+	"github.com/cloudreve/Cloudreve/v4/ent/notification"
+	"github.com/cloudreve/Cloudreve/v4/ent/organization"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
+	"github.com/cloudreve/Cloudreve/v4/ent/retentionrule"
+	"github.com/cloudreve/Cloudreve/v4/ent/rsssubscription"
+	"github.com/cloudreve/Cloudreve/v4/ent/s3accesskey"
+	"github.com/cloudreve/Cloudreve/v4/ent/savedsearch"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
+	"github.com/cloudreve/Cloudreve/v4/ent/syncjob"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/useremail"
+	"github.com/cloudreve/Cloudreve/v4/ent/viewpreference"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 )
 
@@ -166,6 +181,62 @@ func (uc *UserCreate) SetGroupUsers(i int) *UserCreate {
 	return uc
 }
 
+// SetStoragePolicyOverride sets the "storage_policy_override" field.
+func (uc *UserCreate) SetStoragePolicyOverride(i int) *UserCreate {
+	uc.mutation.SetStoragePolicyOverride(i)
+	return uc
+}
+
+// SetNillableStoragePolicyOverride sets the "storage_policy_override" field if the given value is not nil.
+func (uc *UserCreate) SetNillableStoragePolicyOverride(i *int) *UserCreate {
+	if i != nil {
+		uc.SetStoragePolicyOverride(*i)
+	}
+	return uc
+}
+
+// SetMaxStorageOverride sets the "max_storage_override" field.
+func (uc *UserCreate) SetMaxStorageOverride(i int64) *UserCreate {
+	uc.mutation.SetMaxStorageOverride(i)
+	return uc
+}
+
+// SetNillableMaxStorageOverride sets the "max_storage_override" field if the given value is not nil.
+func (uc *UserCreate) SetNillableMaxStorageOverride(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetMaxStorageOverride(*i)
+	}
+	return uc
+}
+
+// SetGroupExpiresAt sets the "group_expires_at" field.
+func (uc *UserCreate) SetGroupExpiresAt(t time.Time) *UserCreate {
+	uc.mutation.SetGroupExpiresAt(t)
+	return uc
+}
+
+// SetNillableGroupExpiresAt sets the "group_expires_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableGroupExpiresAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetGroupExpiresAt(*t)
+	}
+	return uc
+}
+
+// SetEmailChangeAt sets the "email_change_at" field.
+func (uc *UserCreate) SetEmailChangeAt(t time.Time) *UserCreate {
+	uc.mutation.SetEmailChangeAt(t)
+	return uc
+}
+
+// SetNillableEmailChangeAt sets the "email_change_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableEmailChangeAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetEmailChangeAt(*t)
+	}
+	return uc
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (uc *UserCreate) SetGroupID(id int) *UserCreate {
 	uc.mutation.SetGroupID(id)
@@ -267,6 +338,250 @@ func (uc *UserCreate) AddEntities(e ...*Entity) *UserCreate {
 	return uc.AddEntityIDs(ids...)
 }
 
+// AddViewPreferenceIDs adds the "view_preferences" edge to the ViewPreference entity by IDs.
+func (uc *UserCreate) AddViewPreferenceIDs(ids ...int) *UserCreate {
+	uc.mutation.AddViewPreferenceIDs(ids...)
+	return uc
+}
+
+// AddViewPreferences adds the "view_preferences" edges to the ViewPreference entity.
+func (uc *UserCreate) AddViewPreferences(v ...*ViewPreference) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return uc.AddViewPreferenceIDs(ids...)
+}
+
+// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by IDs.
+func (uc *UserCreate) AddAccessTokenIDs(ids ...int) *UserCreate {
+	uc.mutation.AddAccessTokenIDs(ids...)
+	return uc
+}
+
+// AddAccessTokens adds the "access_tokens" edges to the AccessToken entity.
+func (uc *UserCreate) AddAccessTokens(a ...*AccessToken) *UserCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAccessTokenIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (uc *UserCreate) AddInvitationIDs(ids ...int) *UserCreate {
+	uc.mutation.AddInvitationIDs(ids...)
+	return uc
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (uc *UserCreate) AddInvitations(i ...*Invitation) *UserCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddInvitationIDs(ids...)
+}
+
+// AddEmailIDs adds the "emails" edge to the UserEmail entity by IDs.
+func (uc *UserCreate) AddEmailIDs(ids ...int) *UserCreate {
+	uc.mutation.AddEmailIDs(ids...)
+	return uc
+}
+
+// AddEmails adds the "emails" edges to the UserEmail entity.
+func (uc *UserCreate) AddEmails(u ...*UserEmail) *UserCreate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uc.AddEmailIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (uc *UserCreate) AddNotificationIDs(ids ...int) *UserCreate {
+	uc.mutation.AddNotificationIDs(ids...)
+	return uc
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (uc *UserCreate) AddNotifications(n ...*Notification) *UserCreate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uc.AddNotificationIDs(ids...)
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (uc *UserCreate) AddSavedSearchIDs(ids ...int) *UserCreate {
+	uc.mutation.AddSavedSearchIDs(ids...)
+	return uc
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (uc *UserCreate) AddSavedSearches(s ...*SavedSearch) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSavedSearchIDs(ids...)
+}
+
+// AddRssSubscriptionIDs adds the "rss_subscriptions" edge to the RssSubscription entity by IDs.
+func (uc *UserCreate) AddRssSubscriptionIDs(ids ...int) *UserCreate {
+	uc.mutation.AddRssSubscriptionIDs(ids...)
+	return uc
+}
+
+// AddRssSubscriptions adds the "rss_subscriptions" edges to the RssSubscription entity.
+func (uc *UserCreate) AddRssSubscriptions(r ...*RssSubscription) *UserCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRssSubscriptionIDs(ids...)
+}
+
+// AddAutomationRuleIDs adds the "automation_rules" edge to the AutomationRule entity by IDs.
+func (uc *UserCreate) AddAutomationRuleIDs(ids ...int) *UserCreate {
+	uc.mutation.AddAutomationRuleIDs(ids...)
+	return uc
+}
+
+// AddAutomationRules adds the "automation_rules" edges to the AutomationRule entity.
+func (uc *UserCreate) AddAutomationRules(a ...*AutomationRule) *UserCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAutomationRuleIDs(ids...)
+}
+
+// AddSyncJobIDs adds the "sync_jobs" edge to the SyncJob entity by IDs.
+func (uc *UserCreate) AddSyncJobIDs(ids ...int) *UserCreate {
+	uc.mutation.AddSyncJobIDs(ids...)
+	return uc
+}
+
+// AddSyncJobs adds the "sync_jobs" edges to the SyncJob entity.
+func (uc *UserCreate) AddSyncJobs(s ...*SyncJob) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSyncJobIDs(ids...)
+}
+
+// AddRetentionRuleIDs adds the "retention_rules" edge to the RetentionRule entity by IDs.
+func (uc *UserCreate) AddRetentionRuleIDs(ids ...int) *UserCreate {
+	uc.mutation.AddRetentionRuleIDs(ids...)
+	return uc
+}
+
+// AddRetentionRules adds the "retention_rules" edges to the RetentionRule entity.
+func (uc *UserCreate) AddRetentionRules(r ...*RetentionRule) *UserCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRetentionRuleIDs(ids...)
+}
+
+// AddModerationCaseIDs adds the "moderation_cases" edge to the ModerationCase entity by IDs.
+func (uc *UserCreate) AddModerationCaseIDs(ids ...int) *UserCreate {
+	uc.mutation.AddModerationCaseIDs(ids...)
+	return uc
+}
+
+// AddModerationCases adds the "moderation_cases" edges to the ModerationCase entity.
+func (uc *UserCreate) AddModerationCases(m ...*ModerationCase) *UserCreate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uc.AddModerationCaseIDs(ids...)
+}
+
+// AddS3AccessKeyIDs adds the "s3_access_keys" edge to the S3AccessKey entity by IDs.
+func (uc *UserCreate) AddS3AccessKeyIDs(ids ...int) *UserCreate {
+	uc.mutation.AddS3AccessKeyIDs(ids...)
+	return uc
+}
+
+// AddS3AccessKeys adds the "s3_access_keys" edges to the S3AccessKey entity.
+func (uc *UserCreate) AddS3AccessKeys(s ...*S3AccessKey) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddS3AccessKeyIDs(ids...)
+}
+
+// AddCheckoutIDs adds the "checkouts" edge to the Checkout entity by IDs.
+func (uc *UserCreate) AddCheckoutIDs(ids ...int) *UserCreate {
+	uc.mutation.AddCheckoutIDs(ids...)
+	return uc
+}
+
+// AddCheckouts adds the "checkouts" edges to the Checkout entity.
+func (uc *UserCreate) AddCheckouts(c ...*Checkout) *UserCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddCheckoutIDs(ids...)
+}
+
+// AddDismissedAnnouncementIDs adds the "dismissed_announcements" edge to the Announcement entity by IDs.
+func (uc *UserCreate) AddDismissedAnnouncementIDs(ids ...int) *UserCreate {
+	uc.mutation.AddDismissedAnnouncementIDs(ids...)
+	return uc
+}
+
+// AddDismissedAnnouncements adds the "dismissed_announcements" edges to the Announcement entity.
+func (uc *UserCreate) AddDismissedAnnouncements(a ...*Announcement) *UserCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddDismissedAnnouncementIDs(ids...)
+}
+
+// AddAdminOrganizationIDs adds the "admin_organizations" edge to the Organization entity by IDs.
+func (uc *UserCreate) AddAdminOrganizationIDs(ids ...int) *UserCreate {
+	uc.mutation.AddAdminOrganizationIDs(ids...)
+	return uc
+}
+
+// AddAdminOrganizations adds the "admin_organizations" edges to the Organization entity.
+func (uc *UserCreate) AddAdminOrganizations(o ...*Organization) *UserCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uc.AddAdminOrganizationIDs(ids...)
+}
+
+// SetInvitationID sets the "invitation" edge to the Invitation entity by ID.
+func (uc *UserCreate) SetInvitationID(id int) *UserCreate {
+	uc.mutation.SetInvitationID(id)
+	return uc
+}
+
+// SetNillableInvitationID sets the "invitation" edge to the Invitation entity by ID if the given value is not nil.
+func (uc *UserCreate) SetNillableInvitationID(id *int) *UserCreate {
+	if id != nil {
+		uc = uc.SetInvitationID(*id)
+	}
+	return uc
+}
+
+// SetInvitation sets the "invitation" edge to the Invitation entity.
+func (uc *UserCreate) SetInvitation(i *Invitation) *UserCreate {
+	return uc.SetInvitationID(i.ID)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -452,6 +767,22 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldSettings, field.TypeJSON, value)
 		_node.Settings = value
 	}
+	if value, ok := uc.mutation.StoragePolicyOverride(); ok {
+		_spec.SetField(user.FieldStoragePolicyOverride, field.TypeInt, value)
+		_node.StoragePolicyOverride = value
+	}
+	if value, ok := uc.mutation.MaxStorageOverride(); ok {
+		_spec.SetField(user.FieldMaxStorageOverride, field.TypeInt64, value)
+		_node.MaxStorageOverride = &value
+	}
+	if value, ok := uc.mutation.GroupExpiresAt(); ok {
+		_spec.SetField(user.FieldGroupExpiresAt, field.TypeTime, value)
+		_node.GroupExpiresAt = &value
+	}
+	if value, ok := uc.mutation.EmailChangeAt(); ok {
+		_spec.SetField(user.FieldEmailChangeAt, field.TypeTime, value)
+		_node.EmailChangeAt = &value
+	}
 	if nodes := uc.mutation.GroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -565,6 +896,263 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.ViewPreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ViewPreferencesTable,
+			Columns: []string{user.ViewPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewpreference.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccessTokensTable,
+			Columns: []string{user.AccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.EmailsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailsTable,
+			Columns: []string{user.EmailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useremail.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.RssSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RssSubscriptionsTable,
+			Columns: []string{user.RssSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsssubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AutomationRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AutomationRulesTable,
+			Columns: []string{user.AutomationRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SyncJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SyncJobsTable,
+			Columns: []string{user.SyncJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.RetentionRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RetentionRulesTable,
+			Columns: []string{user.RetentionRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ModerationCasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ModerationCasesTable,
+			Columns: []string{user.ModerationCasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.S3AccessKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.S3AccessKeysTable,
+			Columns: []string{user.S3AccessKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(s3accesskey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.CheckoutsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CheckoutsTable,
+			Columns: []string{user.CheckoutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkout.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.DismissedAnnouncementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.DismissedAnnouncementsTable,
+			Columns: user.DismissedAnnouncementsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AdminOrganizationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AdminOrganizationsTable,
+			Columns: user.AdminOrganizationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.InvitationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.InvitationTable,
+			Columns: []string{user.InvitationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.invitation_invitees = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -785,6 +1373,90 @@ func (u *UserUpsert) UpdateGroupUsers() *UserUpsert {
 	return u
 }
 
+// SetStoragePolicyOverride sets the "storage_policy_override" field.
+func (u *UserUpsert) SetStoragePolicyOverride(v int) *UserUpsert {
+	u.Set(user.FieldStoragePolicyOverride, v)
+	return u
+}
+
+// UpdateStoragePolicyOverride sets the "storage_policy_override" field to the value that was provided on create.
+func (u *UserUpsert) UpdateStoragePolicyOverride() *UserUpsert {
+	u.SetExcluded(user.FieldStoragePolicyOverride)
+	return u
+}
+
+// AddStoragePolicyOverride adds v to the "storage_policy_override" field.
+func (u *UserUpsert) AddStoragePolicyOverride(v int) *UserUpsert {
+	u.Add(user.FieldStoragePolicyOverride, v)
+	return u
+}
+
+// ClearStoragePolicyOverride clears the value of the "storage_policy_override" field.
+func (u *UserUpsert) ClearStoragePolicyOverride() *UserUpsert {
+	u.SetNull(user.FieldStoragePolicyOverride)
+	return u
+}
+
+// SetMaxStorageOverride sets the "max_storage_override" field.
+func (u *UserUpsert) SetMaxStorageOverride(v int64) *UserUpsert {
+	u.Set(user.FieldMaxStorageOverride, v)
+	return u
+}
+
+// UpdateMaxStorageOverride sets the "max_storage_override" field to the value that was provided on create.
+func (u *UserUpsert) UpdateMaxStorageOverride() *UserUpsert {
+	u.SetExcluded(user.FieldMaxStorageOverride)
+	return u
+}
+
+// AddMaxStorageOverride adds v to the "max_storage_override" field.
+func (u *UserUpsert) AddMaxStorageOverride(v int64) *UserUpsert {
+	u.Add(user.FieldMaxStorageOverride, v)
+	return u
+}
+
+// ClearMaxStorageOverride clears the value of the "max_storage_override" field.
+func (u *UserUpsert) ClearMaxStorageOverride() *UserUpsert {
+	u.SetNull(user.FieldMaxStorageOverride)
+	return u
+}
+
+// SetGroupExpiresAt sets the "group_expires_at" field.
+func (u *UserUpsert) SetGroupExpiresAt(v time.Time) *UserUpsert {
+	u.Set(user.FieldGroupExpiresAt, v)
+	return u
+}
+
+// UpdateGroupExpiresAt sets the "group_expires_at" field to the value that was provided on create.
+func (u *UserUpsert) UpdateGroupExpiresAt() *UserUpsert {
+	u.SetExcluded(user.FieldGroupExpiresAt)
+	return u
+}
+
+// ClearGroupExpiresAt clears the value of the "group_expires_at" field.
+func (u *UserUpsert) ClearGroupExpiresAt() *UserUpsert {
+	u.SetNull(user.FieldGroupExpiresAt)
+	return u
+}
+
+// SetEmailChangeAt sets the "email_change_at" field.
+func (u *UserUpsert) SetEmailChangeAt(v time.Time) *UserUpsert {
+	u.Set(user.FieldEmailChangeAt, v)
+	return u
+}
+
+// UpdateEmailChangeAt sets the "email_change_at" field to the value that was provided on create.
+func (u *UserUpsert) UpdateEmailChangeAt() *UserUpsert {
+	u.SetExcluded(user.FieldEmailChangeAt)
+	return u
+}
+
+// ClearEmailChangeAt clears the value of the "email_change_at" field.
+func (u *UserUpsert) ClearEmailChangeAt() *UserUpsert {
+	u.SetNull(user.FieldEmailChangeAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -1026,6 +1698,104 @@ func (u *UserUpsertOne) UpdateGroupUsers() *UserUpsertOne {
 	})
 }
 
+// SetStoragePolicyOverride sets the "storage_policy_override" field.
+func (u *UserUpsertOne) SetStoragePolicyOverride(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetStoragePolicyOverride(v)
+	})
+}
+
+// AddStoragePolicyOverride adds v to the "storage_policy_override" field.
+func (u *UserUpsertOne) AddStoragePolicyOverride(v int) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddStoragePolicyOverride(v)
+	})
+}
+
+// UpdateStoragePolicyOverride sets the "storage_policy_override" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateStoragePolicyOverride() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateStoragePolicyOverride()
+	})
+}
+
+// ClearStoragePolicyOverride clears the value of the "storage_policy_override" field.
+func (u *UserUpsertOne) ClearStoragePolicyOverride() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearStoragePolicyOverride()
+	})
+}
+
+// SetMaxStorageOverride sets the "max_storage_override" field.
+func (u *UserUpsertOne) SetMaxStorageOverride(v int64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMaxStorageOverride(v)
+	})
+}
+
+// AddMaxStorageOverride adds v to the "max_storage_override" field.
+func (u *UserUpsertOne) AddMaxStorageOverride(v int64) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.AddMaxStorageOverride(v)
+	})
+}
+
+// UpdateMaxStorageOverride sets the "max_storage_override" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateMaxStorageOverride() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMaxStorageOverride()
+	})
+}
+
+// ClearMaxStorageOverride clears the value of the "max_storage_override" field.
+func (u *UserUpsertOne) ClearMaxStorageOverride() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearMaxStorageOverride()
+	})
+}
+
+// SetGroupExpiresAt sets the "group_expires_at" field.
+func (u *UserUpsertOne) SetGroupExpiresAt(v time.Time) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetGroupExpiresAt(v)
+	})
+}
+
+// UpdateGroupExpiresAt sets the "group_expires_at" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateGroupExpiresAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateGroupExpiresAt()
+	})
+}
+
+// ClearGroupExpiresAt clears the value of the "group_expires_at" field.
+func (u *UserUpsertOne) ClearGroupExpiresAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearGroupExpiresAt()
+	})
+}
+
+// SetEmailChangeAt sets the "email_change_at" field.
+func (u *UserUpsertOne) SetEmailChangeAt(v time.Time) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetEmailChangeAt(v)
+	})
+}
+
+// UpdateEmailChangeAt sets the "email_change_at" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateEmailChangeAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateEmailChangeAt()
+	})
+}
+
+// ClearEmailChangeAt clears the value of the "email_change_at" field.
+func (u *UserUpsertOne) ClearEmailChangeAt() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearEmailChangeAt()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1438,6 +2208,104 @@ func (u *UserUpsertBulk) UpdateGroupUsers() *UserUpsertBulk {
 	})
 }
 
+// SetStoragePolicyOverride sets the "storage_policy_override" field.
+func (u *UserUpsertBulk) SetStoragePolicyOverride(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetStoragePolicyOverride(v)
+	})
+}
+
+// AddStoragePolicyOverride adds v to the "storage_policy_override" field.
+func (u *UserUpsertBulk) AddStoragePolicyOverride(v int) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddStoragePolicyOverride(v)
+	})
+}
+
+// UpdateStoragePolicyOverride sets the "storage_policy_override" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateStoragePolicyOverride() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateStoragePolicyOverride()
+	})
+}
+
+// ClearStoragePolicyOverride clears the value of the "storage_policy_override" field.
+func (u *UserUpsertBulk) ClearStoragePolicyOverride() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearStoragePolicyOverride()
+	})
+}
+
+// SetMaxStorageOverride sets the "max_storage_override" field.
+func (u *UserUpsertBulk) SetMaxStorageOverride(v int64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetMaxStorageOverride(v)
+	})
+}
+
+// AddMaxStorageOverride adds v to the "max_storage_override" field.
+func (u *UserUpsertBulk) AddMaxStorageOverride(v int64) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.AddMaxStorageOverride(v)
+	})
+}
+
+// UpdateMaxStorageOverride sets the "max_storage_override" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateMaxStorageOverride() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateMaxStorageOverride()
+	})
+}
+
+// ClearMaxStorageOverride clears the value of the "max_storage_override" field.
+func (u *UserUpsertBulk) ClearMaxStorageOverride() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearMaxStorageOverride()
+	})
+}
+
+// SetGroupExpiresAt sets the "group_expires_at" field.
+func (u *UserUpsertBulk) SetGroupExpiresAt(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetGroupExpiresAt(v)
+	})
+}
+
+// UpdateGroupExpiresAt sets the "group_expires_at" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateGroupExpiresAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateGroupExpiresAt()
+	})
+}
+
+// ClearGroupExpiresAt clears the value of the "group_expires_at" field.
+func (u *UserUpsertBulk) ClearGroupExpiresAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearGroupExpiresAt()
+	})
+}
+
+// SetEmailChangeAt sets the "email_change_at" field.
+func (u *UserUpsertBulk) SetEmailChangeAt(v time.Time) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetEmailChangeAt(v)
+	})
+}
+
+// UpdateEmailChangeAt sets the "email_change_at" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateEmailChangeAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateEmailChangeAt()
+	})
+}
+
+// ClearEmailChangeAt clears the value of the "email_change_at" field.
+func (u *UserUpsertBulk) ClearEmailChangeAt() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.ClearEmailChangeAt()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
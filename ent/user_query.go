@@ -11,31 +11,86 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/accesstoken"
+	"github.com/cloudreve/Cloudreve/v4/ent/announcement"
+	"github.com/cloudreve/Cloudreve/v4/ent/automationrule"
+	"github.com/cloudreve/Cloudreve/v4/ent/checkout"
 	"github.com/cloudreve/Cloudreve/v4/ent/davaccount"
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
+	"github.com/cloudreve/Cloudreve/v4/ent/invitation"
+	"github.com/cloudreve/Cloudreve/v4/ent/moderationcase"
+	"github.com/cloudreve/Cloudreve/v4/ent/notification"
+	"github.com/cloudreve/Cloudreve/v4/ent/organization"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
 	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/retentionrule"
+	"github.com/cloudreve/Cloudreve/v4/ent/rsssubscription"
+	"github.com/cloudreve/Cloudreve/v4/ent/s3accesskey"
+	"github.com/cloudreve/Cloudreve/v4/ent/savedsearch"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
+	"github.com/cloudreve/Cloudreve/v4/ent/syncjob"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/useremail"
+	"github.com/cloudreve/Cloudreve/v4/ent/viewpreference"
 )
 
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx             *QueryContext
-	order           []user.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.User
-	withGroup       *GroupQuery
-	withFiles       *FileQuery
-	withDavAccounts *DavAccountQuery
-	withShares      *ShareQuery
-	withPasskey     *PasskeyQuery
-	withTasks       *TaskQuery
-	withEntities    *EntityQuery
+	ctx                             *QueryContext
+	order                           []user.OrderOption
+	inters                          []Interceptor
+	predicates                      []predicate.User
+	withGroup                       *GroupQuery
+	withFiles                       *FileQuery
+	withDavAccounts                 *DavAccountQuery
+	withShares                      *ShareQuery
+	withPasskey                     *PasskeyQuery
+	withTasks                       *TaskQuery
+	withEntities                    *EntityQuery
+	withViewPreferences             *ViewPreferenceQuery
+	withAccessTokens                *AccessTokenQuery
+	withInvitations                 *InvitationQuery
+	withEmails                      *UserEmailQuery
+	withNotifications               *NotificationQuery
+	withSavedSearches               *SavedSearchQuery
+	withRssSubscriptions            *RssSubscriptionQuery
+	withAutomationRules             *AutomationRuleQuery
+	withSyncJobs                    *SyncJobQuery
+	withRetentionRules              *RetentionRuleQuery
+	withModerationCases             *ModerationCaseQuery
+	withS3AccessKeys                *S3AccessKeyQuery
+	withCheckouts                   *CheckoutQuery
+	withDismissedAnnouncements      *AnnouncementQuery
+	withAdminOrganizations          *OrganizationQuery
+	withInvitation                  *InvitationQuery
+	withFKs                         bool
+	modifiers                       []func(*sql.Selector)
+	loadTotal                       []func(context.Context, []*User) error
+	withNamedFiles                  map[string]*FileQuery
+	withNamedDavAccounts            map[string]*DavAccountQuery
+	withNamedShares                 map[string]*ShareQuery
+	withNamedPasskey                map[string]*PasskeyQuery
+	withNamedTasks                  map[string]*TaskQuery
+	withNamedEntities               map[string]*EntityQuery
+	withNamedViewPreferences        map[string]*ViewPreferenceQuery
+	withNamedAccessTokens           map[string]*AccessTokenQuery
+	withNamedInvitations            map[string]*InvitationQuery
+	withNamedEmails                 map[string]*UserEmailQuery
+	withNamedNotifications          map[string]*NotificationQuery
+	withNamedSavedSearches          map[string]*SavedSearchQuery
+	withNamedRssSubscriptions       map[string]*RssSubscriptionQuery
+	withNamedAutomationRules        map[string]*AutomationRuleQuery
+	withNamedSyncJobs               map[string]*SyncJobQuery
+	withNamedRetentionRules         map[string]*RetentionRuleQuery
+	withNamedModerationCases        map[string]*ModerationCaseQuery
+	withNamedS3AccessKeys           map[string]*S3AccessKeyQuery
+	withNamedCheckouts              map[string]*CheckoutQuery
+	withNamedDismissedAnnouncements map[string]*AnnouncementQuery
+	withNamedAdminOrganizations     map[string]*OrganizationQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -226,141 +281,493 @@ func (uq *UserQuery) QueryEntities() *EntityQuery {
 	return query
 }
 
-// First returns the first User entity from the query.
-// Returns a *NotFoundError when no User was found.
-func (uq *UserQuery) First(ctx context.Context) (*User, error) {
-	nodes, err := uq.Limit(1).All(setContextOp(ctx, uq.ctx, "First"))
-	if err != nil {
-		return nil, err
-	}
-	if len(nodes) == 0 {
-		return nil, &NotFoundError{user.Label}
+// QueryViewPreferences chains the current query on the "view_preferences" edge.
+func (uq *UserQuery) QueryViewPreferences() *ViewPreferenceQuery {
+	query := (&ViewPreferenceClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(viewpreference.Table, viewpreference.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ViewPreferencesTable, user.ViewPreferencesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return nodes[0], nil
+	return query
 }
 
-// FirstX is like First, but panics if an error occurs.
-func (uq *UserQuery) FirstX(ctx context.Context) *User {
-	node, err := uq.First(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
+// QueryAccessTokens chains the current query on the "access_tokens" edge.
+func (uq *UserQuery) QueryAccessTokens() *AccessTokenQuery {
+	query := (&AccessTokenClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(accesstoken.Table, accesstoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AccessTokensTable, user.AccessTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return node
+	return query
 }
 
-// FirstID returns the first User ID from the query.
-// Returns a *NotFoundError when no User ID was found.
-func (uq *UserQuery) FirstID(ctx context.Context) (id int, err error) {
-	var ids []int
-	if ids, err = uq.Limit(1).IDs(setContextOp(ctx, uq.ctx, "FirstID")); err != nil {
-		return
-	}
-	if len(ids) == 0 {
-		err = &NotFoundError{user.Label}
-		return
+// QueryInvitations chains the current query on the "invitations" edge.
+func (uq *UserQuery) QueryInvitations() *InvitationQuery {
+	query := (&InvitationClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(invitation.Table, invitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.InvitationsTable, user.InvitationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return ids[0], nil
+	return query
 }
 
-// FirstIDX is like FirstID, but panics if an error occurs.
-func (uq *UserQuery) FirstIDX(ctx context.Context) int {
-	id, err := uq.FirstID(ctx)
-	if err != nil && !IsNotFound(err) {
-		panic(err)
+// QueryEmails chains the current query on the "emails" edge.
+func (uq *UserQuery) QueryEmails() *UserEmailQuery {
+	query := (&UserEmailClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(useremail.Table, useremail.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.EmailsTable, user.EmailsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return id
+	return query
 }
 
-// Only returns a single User entity found by the query, ensuring it only returns one.
-// Returns a *NotSingularError when more than one User entity is found.
-// Returns a *NotFoundError when no User entities are found.
-func (uq *UserQuery) Only(ctx context.Context) (*User, error) {
-	nodes, err := uq.Limit(2).All(setContextOp(ctx, uq.ctx, "Only"))
-	if err != nil {
-		return nil, err
-	}
-	switch len(nodes) {
-	case 1:
-		return nodes[0], nil
-	case 0:
-		return nil, &NotFoundError{user.Label}
-	default:
-		return nil, &NotSingularError{user.Label}
+// QueryNotifications chains the current query on the "notifications" edge.
+func (uq *UserQuery) QueryNotifications() *NotificationQuery {
+	query := (&NotificationClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationsTable, user.NotificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
+	return query
 }
 
-// OnlyX is like Only, but panics if an error occurs.
-func (uq *UserQuery) OnlyX(ctx context.Context) *User {
-	node, err := uq.Only(ctx)
-	if err != nil {
-		panic(err)
+// QuerySavedSearches chains the current query on the "saved_searches" edge.
+func (uq *UserQuery) QuerySavedSearches() *SavedSearchQuery {
+	query := (&SavedSearchClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(savedsearch.Table, savedsearch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SavedSearchesTable, user.SavedSearchesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return node
+	return query
 }
 
-// OnlyID is like Only, but returns the only User ID in the query.
-// Returns a *NotSingularError when more than one User ID is found.
-// Returns a *NotFoundError when no entities are found.
-func (uq *UserQuery) OnlyID(ctx context.Context) (id int, err error) {
-	var ids []int
-	if ids, err = uq.Limit(2).IDs(setContextOp(ctx, uq.ctx, "OnlyID")); err != nil {
-		return
-	}
-	switch len(ids) {
-	case 1:
-		id = ids[0]
-	case 0:
-		err = &NotFoundError{user.Label}
-	default:
-		err = &NotSingularError{user.Label}
+// QueryRssSubscriptions chains the current query on the "rss_subscriptions" edge.
+func (uq *UserQuery) QueryRssSubscriptions() *RssSubscriptionQuery {
+	query := (&RssSubscriptionClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(rsssubscription.Table, rsssubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RssSubscriptionsTable, user.RssSubscriptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return
+	return query
 }
 
-// OnlyIDX is like OnlyID, but panics if an error occurs.
-func (uq *UserQuery) OnlyIDX(ctx context.Context) int {
-	id, err := uq.OnlyID(ctx)
-	if err != nil {
-		panic(err)
+// QueryAutomationRules chains the current query on the "automation_rules" edge.
+func (uq *UserQuery) QueryAutomationRules() *AutomationRuleQuery {
+	query := (&AutomationRuleClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(automationrule.Table, automationrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AutomationRulesTable, user.AutomationRulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return id
+	return query
 }
 
-// All executes the query and returns a list of Users.
-func (uq *UserQuery) All(ctx context.Context) ([]*User, error) {
-	ctx = setContextOp(ctx, uq.ctx, "All")
-	if err := uq.prepareQuery(ctx); err != nil {
-		return nil, err
+// QuerySyncJobs chains the current query on the "sync_jobs" edge.
+func (uq *UserQuery) QuerySyncJobs() *SyncJobQuery {
+	query := (&SyncJobClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(syncjob.Table, syncjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SyncJobsTable, user.SyncJobsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	qr := querierAll[[]*User, *UserQuery]()
-	return withInterceptors[[]*User](ctx, uq, qr, uq.inters)
+	return query
 }
 
-// AllX is like All, but panics if an error occurs.
-func (uq *UserQuery) AllX(ctx context.Context) []*User {
-	nodes, err := uq.All(ctx)
-	if err != nil {
-		panic(err)
+// QueryRetentionRules chains the current query on the "retention_rules" edge.
+func (uq *UserQuery) QueryRetentionRules() *RetentionRuleQuery {
+	query := (&RetentionRuleClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(retentionrule.Table, retentionrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RetentionRulesTable, user.RetentionRulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return nodes
+	return query
 }
 
-// IDs executes the query and returns a list of User IDs.
-func (uq *UserQuery) IDs(ctx context.Context) (ids []int, err error) {
-	if uq.ctx.Unique == nil && uq.path != nil {
-		uq.Unique(true)
-	}
-	ctx = setContextOp(ctx, uq.ctx, "IDs")
-	if err = uq.Select(user.FieldID).Scan(ctx, &ids); err != nil {
-		return nil, err
+// QueryModerationCases chains the current query on the "moderation_cases" edge.
+func (uq *UserQuery) QueryModerationCases() *ModerationCaseQuery {
+	query := (&ModerationCaseClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(moderationcase.Table, moderationcase.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ModerationCasesTable, user.ModerationCasesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
 	}
-	return ids, nil
+	return query
 }
 
-// IDsX is like IDs, but panics if an error occurs.
-func (uq *UserQuery) IDsX(ctx context.Context) []int {
-	ids, err := uq.IDs(ctx)
-	if err != nil {
+// QueryS3AccessKeys chains the current query on the "s3_access_keys" edge.
+func (uq *UserQuery) QueryS3AccessKeys() *S3AccessKeyQuery {
+	query := (&S3AccessKeyClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(s3accesskey.Table, s3accesskey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.S3AccessKeysTable, user.S3AccessKeysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCheckouts chains the current query on the "checkouts" edge.
+func (uq *UserQuery) QueryCheckouts() *CheckoutQuery {
+	query := (&CheckoutClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(checkout.Table, checkout.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CheckoutsTable, user.CheckoutsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDismissedAnnouncements chains the current query on the "dismissed_announcements" edge.
+func (uq *UserQuery) QueryDismissedAnnouncements() *AnnouncementQuery {
+	query := (&AnnouncementClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(announcement.Table, announcement.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.DismissedAnnouncementsTable, user.DismissedAnnouncementsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAdminOrganizations chains the current query on the "admin_organizations" edge.
+func (uq *UserQuery) QueryAdminOrganizations() *OrganizationQuery {
+	query := (&OrganizationClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.AdminOrganizationsTable, user.AdminOrganizationsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvitation chains the current query on the "invitation" edge.
+func (uq *UserQuery) QueryInvitation() *InvitationQuery {
+	query := (&InvitationClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(invitation.Table, invitation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, user.InvitationTable, user.InvitationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first User entity from the query.
+// Returns a *NotFoundError when no User was found.
+func (uq *UserQuery) First(ctx context.Context) (*User, error) {
+	nodes, err := uq.Limit(1).All(setContextOp(ctx, uq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{user.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (uq *UserQuery) FirstX(ctx context.Context) *User {
+	node, err := uq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first User ID from the query.
+// Returns a *NotFoundError when no User ID was found.
+func (uq *UserQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = uq.Limit(1).IDs(setContextOp(ctx, uq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{user.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (uq *UserQuery) FirstIDX(ctx context.Context) int {
+	id, err := uq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single User entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one User entity is found.
+// Returns a *NotFoundError when no User entities are found.
+func (uq *UserQuery) Only(ctx context.Context) (*User, error) {
+	nodes, err := uq.Limit(2).All(setContextOp(ctx, uq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{user.Label}
+	default:
+		return nil, &NotSingularError{user.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (uq *UserQuery) OnlyX(ctx context.Context) *User {
+	node, err := uq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only User ID in the query.
+// Returns a *NotSingularError when more than one User ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (uq *UserQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = uq.Limit(2).IDs(setContextOp(ctx, uq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{user.Label}
+	default:
+		err = &NotSingularError{user.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (uq *UserQuery) OnlyIDX(ctx context.Context) int {
+	id, err := uq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Users.
+func (uq *UserQuery) All(ctx context.Context) ([]*User, error) {
+	ctx = setContextOp(ctx, uq.ctx, "All")
+	if err := uq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*User, *UserQuery]()
+	return withInterceptors[[]*User](ctx, uq, qr, uq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (uq *UserQuery) AllX(ctx context.Context) []*User {
+	nodes, err := uq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of User IDs.
+func (uq *UserQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if uq.ctx.Unique == nil && uq.path != nil {
+		uq.Unique(true)
+	}
+	ctx = setContextOp(ctx, uq.ctx, "IDs")
+	if err = uq.Select(user.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (uq *UserQuery) IDsX(ctx context.Context) []int {
+	ids, err := uq.IDs(ctx)
+	if err != nil {
 		panic(err)
 	}
 	return ids
@@ -413,18 +820,34 @@ func (uq *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:          uq.config,
-		ctx:             uq.ctx.Clone(),
-		order:           append([]user.OrderOption{}, uq.order...),
-		inters:          append([]Interceptor{}, uq.inters...),
-		predicates:      append([]predicate.User{}, uq.predicates...),
-		withGroup:       uq.withGroup.Clone(),
-		withFiles:       uq.withFiles.Clone(),
-		withDavAccounts: uq.withDavAccounts.Clone(),
-		withShares:      uq.withShares.Clone(),
-		withPasskey:     uq.withPasskey.Clone(),
-		withTasks:       uq.withTasks.Clone(),
-		withEntities:    uq.withEntities.Clone(),
+		config:                     uq.config,
+		ctx:                        uq.ctx.Clone(),
+		order:                      append([]user.OrderOption{}, uq.order...),
+		inters:                     append([]Interceptor{}, uq.inters...),
+		predicates:                 append([]predicate.User{}, uq.predicates...),
+		withGroup:                  uq.withGroup.Clone(),
+		withFiles:                  uq.withFiles.Clone(),
+		withDavAccounts:            uq.withDavAccounts.Clone(),
+		withShares:                 uq.withShares.Clone(),
+		withPasskey:                uq.withPasskey.Clone(),
+		withTasks:                  uq.withTasks.Clone(),
+		withEntities:               uq.withEntities.Clone(),
+		withViewPreferences:        uq.withViewPreferences.Clone(),
+		withAccessTokens:           uq.withAccessTokens.Clone(),
+		withInvitations:            uq.withInvitations.Clone(),
+		withEmails:                 uq.withEmails.Clone(),
+		withNotifications:          uq.withNotifications.Clone(),
+		withSavedSearches:          uq.withSavedSearches.Clone(),
+		withRssSubscriptions:       uq.withRssSubscriptions.Clone(),
+		withAutomationRules:        uq.withAutomationRules.Clone(),
+		withSyncJobs:               uq.withSyncJobs.Clone(),
+		withRetentionRules:         uq.withRetentionRules.Clone(),
+		withModerationCases:        uq.withModerationCases.Clone(),
+		withS3AccessKeys:           uq.withS3AccessKeys.Clone(),
+		withCheckouts:              uq.withCheckouts.Clone(),
+		withDismissedAnnouncements: uq.withDismissedAnnouncements.Clone(),
+		withAdminOrganizations:     uq.withAdminOrganizations.Clone(),
+		withInvitation:             uq.withInvitation.Clone(),
 		// clone intermediate query.
 		sql:  uq.sql.Clone(),
 		path: uq.path,
@@ -508,40 +931,216 @@ func (uq *UserQuery) WithEntities(opts ...func(*EntityQuery)) *UserQuery {
 	return uq
 }
 
-// GroupBy is used to group vertices by one or more fields/columns.
-// It is often used with aggregate functions, like: count, max, mean, min, sum.
-//
-// Example:
-//
-//	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
-//		Count int `json:"count,omitempty"`
-//	}
-//
-//	client.User.Query().
-//		GroupBy(user.FieldCreatedAt).
-//		Aggregate(ent.Count()).
-//		Scan(ctx, &v)
-func (uq *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
-	uq.ctx.Fields = append([]string{field}, fields...)
-	grbuild := &UserGroupBy{build: uq}
-	grbuild.flds = &uq.ctx.Fields
-	grbuild.label = user.Label
-	grbuild.scan = grbuild.Scan
-	return grbuild
+// WithViewPreferences tells the query-builder to eager-load the nodes that are connected to
+// the "view_preferences" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithViewPreferences(opts ...func(*ViewPreferenceQuery)) *UserQuery {
+	query := (&ViewPreferenceClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withViewPreferences = query
+	return uq
 }
 
-// Select allows the selection one or more fields/columns for the given query,
-// instead of selecting all fields in the entity.
-//
-// Example:
-//
-//	var v []struct {
-//		CreatedAt time.Time `json:"created_at,omitempty"`
-//	}
-//
-//	client.User.Query().
-//		Select(user.FieldCreatedAt).
+// WithAccessTokens tells the query-builder to eager-load the nodes that are connected to
+// the "access_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithAccessTokens(opts ...func(*AccessTokenQuery)) *UserQuery {
+	query := (&AccessTokenClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAccessTokens = query
+	return uq
+}
+
+// WithInvitations tells the query-builder to eager-load the nodes that are connected to
+// the "invitations" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithInvitations(opts ...func(*InvitationQuery)) *UserQuery {
+	query := (&InvitationClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withInvitations = query
+	return uq
+}
+
+// WithEmails tells the query-builder to eager-load the nodes that are connected to
+// the "emails" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithEmails(opts ...func(*UserEmailQuery)) *UserQuery {
+	query := (&UserEmailClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withEmails = query
+	return uq
+}
+
+// WithNotifications tells the query-builder to eager-load the nodes that are connected to
+// the "notifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNotifications(opts ...func(*NotificationQuery)) *UserQuery {
+	query := (&NotificationClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withNotifications = query
+	return uq
+}
+
+// WithSavedSearches tells the query-builder to eager-load the nodes that are connected to
+// the "saved_searches" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithSavedSearches(opts ...func(*SavedSearchQuery)) *UserQuery {
+	query := (&SavedSearchClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withSavedSearches = query
+	return uq
+}
+
+// WithRssSubscriptions tells the query-builder to eager-load the nodes that are connected to
+// the "rss_subscriptions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithRssSubscriptions(opts ...func(*RssSubscriptionQuery)) *UserQuery {
+	query := (&RssSubscriptionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withRssSubscriptions = query
+	return uq
+}
+
+// WithAutomationRules tells the query-builder to eager-load the nodes that are connected to
+// the "automation_rules" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithAutomationRules(opts ...func(*AutomationRuleQuery)) *UserQuery {
+	query := (&AutomationRuleClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAutomationRules = query
+	return uq
+}
+
+// WithSyncJobs tells the query-builder to eager-load the nodes that are connected to
+// the "sync_jobs" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithSyncJobs(opts ...func(*SyncJobQuery)) *UserQuery {
+	query := (&SyncJobClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withSyncJobs = query
+	return uq
+}
+
+// WithRetentionRules tells the query-builder to eager-load the nodes that are connected to
+// the "retention_rules" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithRetentionRules(opts ...func(*RetentionRuleQuery)) *UserQuery {
+	query := (&RetentionRuleClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withRetentionRules = query
+	return uq
+}
+
+// WithModerationCases tells the query-builder to eager-load the nodes that are connected to
+// the "moderation_cases" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithModerationCases(opts ...func(*ModerationCaseQuery)) *UserQuery {
+	query := (&ModerationCaseClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withModerationCases = query
+	return uq
+}
+
+// WithS3AccessKeys tells the query-builder to eager-load the nodes that are connected to
+// the "s3_access_keys" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithS3AccessKeys(opts ...func(*S3AccessKeyQuery)) *UserQuery {
+	query := (&S3AccessKeyClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withS3AccessKeys = query
+	return uq
+}
+
+// WithCheckouts tells the query-builder to eager-load the nodes that are connected to
+// the "checkouts" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithCheckouts(opts ...func(*CheckoutQuery)) *UserQuery {
+	query := (&CheckoutClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withCheckouts = query
+	return uq
+}
+
+// WithDismissedAnnouncements tells the query-builder to eager-load the nodes that are connected to
+// the "dismissed_announcements" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithDismissedAnnouncements(opts ...func(*AnnouncementQuery)) *UserQuery {
+	query := (&AnnouncementClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withDismissedAnnouncements = query
+	return uq
+}
+
+// WithAdminOrganizations tells the query-builder to eager-load the nodes that are connected to
+// the "admin_organizations" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithAdminOrganizations(opts ...func(*OrganizationQuery)) *UserQuery {
+	query := (&OrganizationClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAdminOrganizations = query
+	return uq
+}
+
+// WithInvitation tells the query-builder to eager-load the nodes that are connected to
+// the "invitation" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithInvitation(opts ...func(*InvitationQuery)) *UserQuery {
+	query := (&InvitationClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withInvitation = query
+	return uq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.User.Query().
+//		GroupBy(user.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (uq *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
+	uq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserGroupBy{build: uq}
+	grbuild.flds = &uq.ctx.Fields
+	grbuild.label = user.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.User.Query().
+//		Select(user.FieldCreatedAt).
 //		Scan(ctx, &v)
 func (uq *UserQuery) Select(fields ...string) *UserSelect {
 	uq.ctx.Fields = append(uq.ctx.Fields, fields...)
@@ -561,140 +1160,853 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 		if inter == nil {
 			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
 		}
-		if trv, ok := inter.(Traverser); ok {
-			if err := trv.Traverse(ctx, uq); err != nil {
-				return err
-			}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, uq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range uq.ctx.Fields {
+		if !user.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if uq.path != nil {
+		prev, err := uq.path(ctx)
+		if err != nil {
+			return err
+		}
+		uq.sql = prev
+	}
+	return nil
+}
+
+func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, error) {
+	var (
+		nodes       = []*User{}
+		withFKs     = uq.withFKs
+		_spec       = uq.querySpec()
+		loadedTypes = [23]bool{
+			uq.withGroup != nil,
+			uq.withFiles != nil,
+			uq.withDavAccounts != nil,
+			uq.withShares != nil,
+			uq.withPasskey != nil,
+			uq.withTasks != nil,
+			uq.withEntities != nil,
+			uq.withViewPreferences != nil,
+			uq.withAccessTokens != nil,
+			uq.withInvitations != nil,
+			uq.withEmails != nil,
+			uq.withNotifications != nil,
+			uq.withSavedSearches != nil,
+			uq.withRssSubscriptions != nil,
+			uq.withAutomationRules != nil,
+			uq.withSyncJobs != nil,
+			uq.withRetentionRules != nil,
+			uq.withModerationCases != nil,
+			uq.withS3AccessKeys != nil,
+			uq.withCheckouts != nil,
+			uq.withDismissedAnnouncements != nil,
+			uq.withAdminOrganizations != nil,
+			uq.withInvitation != nil,
+		}
+	)
+	if uq.withInvitation != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, user.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*User).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &User{config: uq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, uq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := uq.withGroup; query != nil {
+		if err := uq.loadGroup(ctx, query, nodes, nil,
+			func(n *User, e *Group) { n.Edges.Group = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withFiles; query != nil {
+		if err := uq.loadFiles(ctx, query, nodes,
+			func(n *User) { n.Edges.Files = []*File{} },
+			func(n *User, e *File) { n.Edges.Files = append(n.Edges.Files, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withDavAccounts; query != nil {
+		if err := uq.loadDavAccounts(ctx, query, nodes,
+			func(n *User) { n.Edges.DavAccounts = []*DavAccount{} },
+			func(n *User, e *DavAccount) { n.Edges.DavAccounts = append(n.Edges.DavAccounts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withShares; query != nil {
+		if err := uq.loadShares(ctx, query, nodes,
+			func(n *User) { n.Edges.Shares = []*Share{} },
+			func(n *User, e *Share) { n.Edges.Shares = append(n.Edges.Shares, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withPasskey; query != nil {
+		if err := uq.loadPasskey(ctx, query, nodes,
+			func(n *User) { n.Edges.Passkey = []*Passkey{} },
+			func(n *User, e *Passkey) { n.Edges.Passkey = append(n.Edges.Passkey, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withTasks; query != nil {
+		if err := uq.loadTasks(ctx, query, nodes,
+			func(n *User) { n.Edges.Tasks = []*Task{} },
+			func(n *User, e *Task) { n.Edges.Tasks = append(n.Edges.Tasks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withEntities; query != nil {
+		if err := uq.loadEntities(ctx, query, nodes,
+			func(n *User) { n.Edges.Entities = []*Entity{} },
+			func(n *User, e *Entity) { n.Edges.Entities = append(n.Edges.Entities, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withViewPreferences; query != nil {
+		if err := uq.loadViewPreferences(ctx, query, nodes,
+			func(n *User) { n.Edges.ViewPreferences = []*ViewPreference{} },
+			func(n *User, e *ViewPreference) { n.Edges.ViewPreferences = append(n.Edges.ViewPreferences, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withAccessTokens; query != nil {
+		if err := uq.loadAccessTokens(ctx, query, nodes,
+			func(n *User) { n.Edges.AccessTokens = []*AccessToken{} },
+			func(n *User, e *AccessToken) { n.Edges.AccessTokens = append(n.Edges.AccessTokens, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withInvitations; query != nil {
+		if err := uq.loadInvitations(ctx, query, nodes,
+			func(n *User) { n.Edges.Invitations = []*Invitation{} },
+			func(n *User, e *Invitation) { n.Edges.Invitations = append(n.Edges.Invitations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withEmails; query != nil {
+		if err := uq.loadEmails(ctx, query, nodes,
+			func(n *User) { n.Edges.Emails = []*UserEmail{} },
+			func(n *User, e *UserEmail) { n.Edges.Emails = append(n.Edges.Emails, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withNotifications; query != nil {
+		if err := uq.loadNotifications(ctx, query, nodes,
+			func(n *User) { n.Edges.Notifications = []*Notification{} },
+			func(n *User, e *Notification) { n.Edges.Notifications = append(n.Edges.Notifications, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withSavedSearches; query != nil {
+		if err := uq.loadSavedSearches(ctx, query, nodes,
+			func(n *User) { n.Edges.SavedSearches = []*SavedSearch{} },
+			func(n *User, e *SavedSearch) { n.Edges.SavedSearches = append(n.Edges.SavedSearches, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withRssSubscriptions; query != nil {
+		if err := uq.loadRssSubscriptions(ctx, query, nodes,
+			func(n *User) { n.Edges.RssSubscriptions = []*RssSubscription{} },
+			func(n *User, e *RssSubscription) { n.Edges.RssSubscriptions = append(n.Edges.RssSubscriptions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withAutomationRules; query != nil {
+		if err := uq.loadAutomationRules(ctx, query, nodes,
+			func(n *User) { n.Edges.AutomationRules = []*AutomationRule{} },
+			func(n *User, e *AutomationRule) { n.Edges.AutomationRules = append(n.Edges.AutomationRules, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withSyncJobs; query != nil {
+		if err := uq.loadSyncJobs(ctx, query, nodes,
+			func(n *User) { n.Edges.SyncJobs = []*SyncJob{} },
+			func(n *User, e *SyncJob) { n.Edges.SyncJobs = append(n.Edges.SyncJobs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withRetentionRules; query != nil {
+		if err := uq.loadRetentionRules(ctx, query, nodes,
+			func(n *User) { n.Edges.RetentionRules = []*RetentionRule{} },
+			func(n *User, e *RetentionRule) { n.Edges.RetentionRules = append(n.Edges.RetentionRules, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withModerationCases; query != nil {
+		if err := uq.loadModerationCases(ctx, query, nodes,
+			func(n *User) { n.Edges.ModerationCases = []*ModerationCase{} },
+			func(n *User, e *ModerationCase) { n.Edges.ModerationCases = append(n.Edges.ModerationCases, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withS3AccessKeys; query != nil {
+		if err := uq.loadS3AccessKeys(ctx, query, nodes,
+			func(n *User) { n.Edges.S3AccessKeys = []*S3AccessKey{} },
+			func(n *User, e *S3AccessKey) { n.Edges.S3AccessKeys = append(n.Edges.S3AccessKeys, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withCheckouts; query != nil {
+		if err := uq.loadCheckouts(ctx, query, nodes,
+			func(n *User) { n.Edges.Checkouts = []*Checkout{} },
+			func(n *User, e *Checkout) { n.Edges.Checkouts = append(n.Edges.Checkouts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withDismissedAnnouncements; query != nil {
+		if err := uq.loadDismissedAnnouncements(ctx, query, nodes,
+			func(n *User) { n.Edges.DismissedAnnouncements = []*Announcement{} },
+			func(n *User, e *Announcement) {
+				n.Edges.DismissedAnnouncements = append(n.Edges.DismissedAnnouncements, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withAdminOrganizations; query != nil {
+		if err := uq.loadAdminOrganizations(ctx, query, nodes,
+			func(n *User) { n.Edges.AdminOrganizations = []*Organization{} },
+			func(n *User, e *Organization) { n.Edges.AdminOrganizations = append(n.Edges.AdminOrganizations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withInvitation; query != nil {
+		if err := uq.loadInvitation(ctx, query, nodes, nil,
+			func(n *User, e *Invitation) { n.Edges.Invitation = e }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedFiles {
+		if err := uq.loadFiles(ctx, query, nodes,
+			func(n *User) { n.appendNamedFiles(name) },
+			func(n *User, e *File) { n.appendNamedFiles(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedDavAccounts {
+		if err := uq.loadDavAccounts(ctx, query, nodes,
+			func(n *User) { n.appendNamedDavAccounts(name) },
+			func(n *User, e *DavAccount) { n.appendNamedDavAccounts(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedShares {
+		if err := uq.loadShares(ctx, query, nodes,
+			func(n *User) { n.appendNamedShares(name) },
+			func(n *User, e *Share) { n.appendNamedShares(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedPasskey {
+		if err := uq.loadPasskey(ctx, query, nodes,
+			func(n *User) { n.appendNamedPasskey(name) },
+			func(n *User, e *Passkey) { n.appendNamedPasskey(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedTasks {
+		if err := uq.loadTasks(ctx, query, nodes,
+			func(n *User) { n.appendNamedTasks(name) },
+			func(n *User, e *Task) { n.appendNamedTasks(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedEntities {
+		if err := uq.loadEntities(ctx, query, nodes,
+			func(n *User) { n.appendNamedEntities(name) },
+			func(n *User, e *Entity) { n.appendNamedEntities(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedViewPreferences {
+		if err := uq.loadViewPreferences(ctx, query, nodes,
+			func(n *User) { n.appendNamedViewPreferences(name) },
+			func(n *User, e *ViewPreference) { n.appendNamedViewPreferences(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedAccessTokens {
+		if err := uq.loadAccessTokens(ctx, query, nodes,
+			func(n *User) { n.appendNamedAccessTokens(name) },
+			func(n *User, e *AccessToken) { n.appendNamedAccessTokens(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedInvitations {
+		if err := uq.loadInvitations(ctx, query, nodes,
+			func(n *User) { n.appendNamedInvitations(name) },
+			func(n *User, e *Invitation) { n.appendNamedInvitations(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedEmails {
+		if err := uq.loadEmails(ctx, query, nodes,
+			func(n *User) { n.appendNamedEmails(name) },
+			func(n *User, e *UserEmail) { n.appendNamedEmails(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedNotifications {
+		if err := uq.loadNotifications(ctx, query, nodes,
+			func(n *User) { n.appendNamedNotifications(name) },
+			func(n *User, e *Notification) { n.appendNamedNotifications(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedSavedSearches {
+		if err := uq.loadSavedSearches(ctx, query, nodes,
+			func(n *User) { n.appendNamedSavedSearches(name) },
+			func(n *User, e *SavedSearch) { n.appendNamedSavedSearches(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedRssSubscriptions {
+		if err := uq.loadRssSubscriptions(ctx, query, nodes,
+			func(n *User) { n.appendNamedRssSubscriptions(name) },
+			func(n *User, e *RssSubscription) { n.appendNamedRssSubscriptions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedAutomationRules {
+		if err := uq.loadAutomationRules(ctx, query, nodes,
+			func(n *User) { n.appendNamedAutomationRules(name) },
+			func(n *User, e *AutomationRule) { n.appendNamedAutomationRules(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedSyncJobs {
+		if err := uq.loadSyncJobs(ctx, query, nodes,
+			func(n *User) { n.appendNamedSyncJobs(name) },
+			func(n *User, e *SyncJob) { n.appendNamedSyncJobs(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedRetentionRules {
+		if err := uq.loadRetentionRules(ctx, query, nodes,
+			func(n *User) { n.appendNamedRetentionRules(name) },
+			func(n *User, e *RetentionRule) { n.appendNamedRetentionRules(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedModerationCases {
+		if err := uq.loadModerationCases(ctx, query, nodes,
+			func(n *User) { n.appendNamedModerationCases(name) },
+			func(n *User, e *ModerationCase) { n.appendNamedModerationCases(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedS3AccessKeys {
+		if err := uq.loadS3AccessKeys(ctx, query, nodes,
+			func(n *User) { n.appendNamedS3AccessKeys(name) },
+			func(n *User, e *S3AccessKey) { n.appendNamedS3AccessKeys(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedCheckouts {
+		if err := uq.loadCheckouts(ctx, query, nodes,
+			func(n *User) { n.appendNamedCheckouts(name) },
+			func(n *User, e *Checkout) { n.appendNamedCheckouts(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedDismissedAnnouncements {
+		if err := uq.loadDismissedAnnouncements(ctx, query, nodes,
+			func(n *User) { n.appendNamedDismissedAnnouncements(name) },
+			func(n *User, e *Announcement) { n.appendNamedDismissedAnnouncements(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range uq.withNamedAdminOrganizations {
+		if err := uq.loadAdminOrganizations(ctx, query, nodes,
+			func(n *User) { n.appendNamedAdminOrganizations(name) },
+			func(n *User, e *Organization) { n.appendNamedAdminOrganizations(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range uq.loadTotal {
+		if err := uq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (uq *UserQuery) loadGroup(ctx context.Context, query *GroupQuery, nodes []*User, init func(*User), assign func(*User, *Group)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*User)
+	for i := range nodes {
+		fk := nodes[i].GroupUsers
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(group.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "group_users" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (uq *UserQuery) loadFiles(ctx context.Context, query *FileQuery, nodes []*User, init func(*User), assign func(*User, *File)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(file.FieldOwnerID)
+	}
+	query.Where(predicate.File(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.FilesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OwnerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadDavAccounts(ctx context.Context, query *DavAccountQuery, nodes []*User, init func(*User), assign func(*User, *DavAccount)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(davaccount.FieldOwnerID)
+	}
+	query.Where(predicate.DavAccount(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.DavAccountsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OwnerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadShares(ctx context.Context, query *ShareQuery, nodes []*User, init func(*User), assign func(*User, *Share)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Share(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SharesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_shares
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_shares" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_shares" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadPasskey(ctx context.Context, query *PasskeyQuery, nodes []*User, init func(*User), assign func(*User, *Passkey)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(passkey.FieldUserID)
+	}
+	query.Where(predicate.Passkey(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.PasskeyColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadTasks(ctx context.Context, query *TaskQuery, nodes []*User, init func(*User), assign func(*User, *Task)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(task.FieldUserTasks)
+	}
+	query.Where(predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.TasksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserTasks
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_tasks" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadEntities(ctx context.Context, query *EntityQuery, nodes []*User, init func(*User), assign func(*User, *Entity)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(entity.FieldCreatedBy)
+	}
+	query.Where(predicate.Entity(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.EntitiesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CreatedBy
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "created_by" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadViewPreferences(ctx context.Context, query *ViewPreferenceQuery, nodes []*User, init func(*User), assign func(*User, *ViewPreference)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ViewPreference(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ViewPreferencesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_view_preferences
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_view_preferences" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_view_preferences" returned %v for node %v`, *fk, n.ID)
 		}
+		assign(node, n)
 	}
-	for _, f := range uq.ctx.Fields {
-		if !user.ValidColumn(f) {
-			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+	return nil
+}
+func (uq *UserQuery) loadAccessTokens(ctx context.Context, query *AccessTokenQuery, nodes []*User, init func(*User), assign func(*User, *AccessToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	if uq.path != nil {
-		prev, err := uq.path(ctx)
-		if err != nil {
-			return err
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(accesstoken.FieldUserID)
+	}
+	query.Where(predicate.AccessToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.AccessTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
-		uq.sql = prev
+		assign(node, n)
 	}
 	return nil
 }
-
-func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, error) {
-	var (
-		nodes       = []*User{}
-		_spec       = uq.querySpec()
-		loadedTypes = [7]bool{
-			uq.withGroup != nil,
-			uq.withFiles != nil,
-			uq.withDavAccounts != nil,
-			uq.withShares != nil,
-			uq.withPasskey != nil,
-			uq.withTasks != nil,
-			uq.withEntities != nil,
+func (uq *UserQuery) loadInvitations(ctx context.Context, query *InvitationQuery, nodes []*User, init func(*User), assign func(*User, *Invitation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
-	)
-	_spec.ScanValues = func(columns []string) ([]any, error) {
-		return (*User).scanValues(nil, columns)
 	}
-	_spec.Assign = func(columns []string, values []any) error {
-		node := &User{config: uq.config}
-		nodes = append(nodes, node)
-		node.Edges.loadedTypes = loadedTypes
-		return node.assignValues(columns, values)
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(invitation.FieldUserID)
 	}
-	for i := range hooks {
-		hooks[i](ctx, _spec)
+	query.Where(predicate.Invitation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.InvitationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
 	}
-	if err := sqlgraph.QueryNodes(ctx, uq.driver, _spec); err != nil {
-		return nil, err
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
 	}
-	if len(nodes) == 0 {
-		return nodes, nil
+	return nil
+}
+func (uq *UserQuery) loadEmails(ctx context.Context, query *UserEmailQuery, nodes []*User, init func(*User), assign func(*User, *UserEmail)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
 	}
-	if query := uq.withGroup; query != nil {
-		if err := uq.loadGroup(ctx, query, nodes, nil,
-			func(n *User, e *Group) { n.Edges.Group = e }); err != nil {
-			return nil, err
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(useremail.FieldUserID)
+	}
+	query.Where(predicate.UserEmail(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.EmailsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	if query := uq.withFiles; query != nil {
-		if err := uq.loadFiles(ctx, query, nodes,
-			func(n *User) { n.Edges.Files = []*File{} },
-			func(n *User, e *File) { n.Edges.Files = append(n.Edges.Files, e) }); err != nil {
-			return nil, err
+	return nil
+}
+func (uq *UserQuery) loadNotifications(ctx context.Context, query *NotificationQuery, nodes []*User, init func(*User), assign func(*User, *Notification)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	if query := uq.withDavAccounts; query != nil {
-		if err := uq.loadDavAccounts(ctx, query, nodes,
-			func(n *User) { n.Edges.DavAccounts = []*DavAccount{} },
-			func(n *User, e *DavAccount) { n.Edges.DavAccounts = append(n.Edges.DavAccounts, e) }); err != nil {
-			return nil, err
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notification.FieldUserID)
+	}
+	query.Where(predicate.Notification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.NotificationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	if query := uq.withShares; query != nil {
-		if err := uq.loadShares(ctx, query, nodes,
-			func(n *User) { n.Edges.Shares = []*Share{} },
-			func(n *User, e *Share) { n.Edges.Shares = append(n.Edges.Shares, e) }); err != nil {
-			return nil, err
+	return nil
+}
+func (uq *UserQuery) loadSavedSearches(ctx context.Context, query *SavedSearchQuery, nodes []*User, init func(*User), assign func(*User, *SavedSearch)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	if query := uq.withPasskey; query != nil {
-		if err := uq.loadPasskey(ctx, query, nodes,
-			func(n *User) { n.Edges.Passkey = []*Passkey{} },
-			func(n *User, e *Passkey) { n.Edges.Passkey = append(n.Edges.Passkey, e) }); err != nil {
-			return nil, err
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(savedsearch.FieldUserID)
+	}
+	query.Where(predicate.SavedSearch(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SavedSearchesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	if query := uq.withTasks; query != nil {
-		if err := uq.loadTasks(ctx, query, nodes,
-			func(n *User) { n.Edges.Tasks = []*Task{} },
-			func(n *User, e *Task) { n.Edges.Tasks = append(n.Edges.Tasks, e) }); err != nil {
-			return nil, err
+	return nil
+}
+func (uq *UserQuery) loadRssSubscriptions(ctx context.Context, query *RssSubscriptionQuery, nodes []*User, init func(*User), assign func(*User, *RssSubscription)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
 	}
-	if query := uq.withEntities; query != nil {
-		if err := uq.loadEntities(ctx, query, nodes,
-			func(n *User) { n.Edges.Entities = []*Entity{} },
-			func(n *User, e *Entity) { n.Edges.Entities = append(n.Edges.Entities, e) }); err != nil {
-			return nil, err
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(rsssubscription.FieldUserID)
+	}
+	query.Where(predicate.RssSubscription(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.RssSubscriptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
-	return nodes, nil
+	return nil
 }
-
-func (uq *UserQuery) loadGroup(ctx context.Context, query *GroupQuery, nodes []*User, init func(*User), assign func(*User, *Group)) error {
-	ids := make([]int, 0, len(nodes))
-	nodeids := make(map[int][]*User)
+func (uq *UserQuery) loadAutomationRules(ctx context.Context, query *AutomationRuleQuery, nodes []*User, init func(*User), assign func(*User, *AutomationRule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
 	for i := range nodes {
-		fk := nodes[i].GroupUsers
-		if _, ok := nodeids[fk]; !ok {
-			ids = append(ids, fk)
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
 		}
-		nodeids[fk] = append(nodeids[fk], nodes[i])
 	}
-	if len(ids) == 0 {
-		return nil
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(automationrule.FieldUserID)
 	}
-	query.Where(group.IDIn(ids...))
+	query.Where(predicate.AutomationRule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.AutomationRulesColumn), fks...))
+	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		nodes, ok := nodeids[n.ID]
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "group_users" returned %v`, n.ID)
-		}
-		for i := range nodes {
-			assign(nodes[i], n)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadFiles(ctx context.Context, query *FileQuery, nodes []*User, init func(*User), assign func(*User, *File)) error {
+func (uq *UserQuery) loadSyncJobs(ctx context.Context, query *SyncJobQuery, nodes []*User, init func(*User), assign func(*User, *SyncJob)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -705,26 +2017,26 @@ func (uq *UserQuery) loadFiles(ctx context.Context, query *FileQuery, nodes []*U
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(file.FieldOwnerID)
-	}
-	query.Where(predicate.File(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.FilesColumn), fks...))
+		query.ctx.AppendFieldOnce(syncjob.FieldUserID)
+	}
+	query.Where(predicate.SyncJob(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SyncJobsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.OwnerID
+		fk := n.UserID
 		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadDavAccounts(ctx context.Context, query *DavAccountQuery, nodes []*User, init func(*User), assign func(*User, *DavAccount)) error {
+func (uq *UserQuery) loadRetentionRules(ctx context.Context, query *RetentionRuleQuery, nodes []*User, init func(*User), assign func(*User, *RetentionRule)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -735,26 +2047,26 @@ func (uq *UserQuery) loadDavAccounts(ctx context.Context, query *DavAccountQuery
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(davaccount.FieldOwnerID)
+		query.ctx.AppendFieldOnce(retentionrule.FieldUserID)
 	}
-	query.Where(predicate.DavAccount(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.DavAccountsColumn), fks...))
+	query.Where(predicate.RetentionRule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.RetentionRulesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.OwnerID
+		fk := n.UserID
 		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadShares(ctx context.Context, query *ShareQuery, nodes []*User, init func(*User), assign func(*User, *Share)) error {
+func (uq *UserQuery) loadModerationCases(ctx context.Context, query *ModerationCaseQuery, nodes []*User, init func(*User), assign func(*User, *ModerationCase)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -764,28 +2076,27 @@ func (uq *UserQuery) loadShares(ctx context.Context, query *ShareQuery, nodes []
 			init(nodes[i])
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.Share(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.SharesColumn), fks...))
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(moderationcase.FieldUserID)
+	}
+	query.Where(predicate.ModerationCase(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ModerationCasesColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_shares
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_shares" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		fk := n.UserID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_shares" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadPasskey(ctx context.Context, query *PasskeyQuery, nodes []*User, init func(*User), assign func(*User, *Passkey)) error {
+func (uq *UserQuery) loadS3AccessKeys(ctx context.Context, query *S3AccessKeyQuery, nodes []*User, init func(*User), assign func(*User, *S3AccessKey)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -796,10 +2107,10 @@ func (uq *UserQuery) loadPasskey(ctx context.Context, query *PasskeyQuery, nodes
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(passkey.FieldUserID)
+		query.ctx.AppendFieldOnce(s3accesskey.FieldUserID)
 	}
-	query.Where(predicate.Passkey(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.PasskeyColumn), fks...))
+	query.Where(predicate.S3AccessKey(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.S3AccessKeysColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
@@ -815,7 +2126,7 @@ func (uq *UserQuery) loadPasskey(ctx context.Context, query *PasskeyQuery, nodes
 	}
 	return nil
 }
-func (uq *UserQuery) loadTasks(ctx context.Context, query *TaskQuery, nodes []*User, init func(*User), assign func(*User, *Task)) error {
+func (uq *UserQuery) loadCheckouts(ctx context.Context, query *CheckoutQuery, nodes []*User, init func(*User), assign func(*User, *Checkout)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*User)
 	for i := range nodes {
@@ -826,58 +2137,185 @@ func (uq *UserQuery) loadTasks(ctx context.Context, query *TaskQuery, nodes []*U
 		}
 	}
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(task.FieldUserTasks)
+		query.ctx.AppendFieldOnce(checkout.FieldOwnerID)
 	}
-	query.Where(predicate.Task(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.TasksColumn), fks...))
+	query.Where(predicate.Checkout(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.CheckoutsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.UserTasks
+		fk := n.OwnerID
 		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_tasks" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (uq *UserQuery) loadEntities(ctx context.Context, query *EntityQuery, nodes []*User, init func(*User), assign func(*User, *Entity)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[int]*User)
-	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
+func (uq *UserQuery) loadDismissedAnnouncements(ctx context.Context, query *AnnouncementQuery, nodes []*User, init func(*User), assign func(*User, *Announcement)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User)
+	nids := make(map[int]map[*User]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
 		if init != nil {
-			init(nodes[i])
+			init(node)
 		}
 	}
-	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(entity.FieldCreatedBy)
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(user.DismissedAnnouncementsTable)
+		s.Join(joinT).On(s.C(announcement.FieldID), joinT.C(user.DismissedAnnouncementsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(user.DismissedAnnouncementsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(user.DismissedAnnouncementsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
 	}
-	query.Where(predicate.Entity(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(user.EntitiesColumn), fks...))
-	}))
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*User]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Announcement](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "dismissed_announcements" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (uq *UserQuery) loadAdminOrganizations(ctx context.Context, query *OrganizationQuery, nodes []*User, init func(*User), assign func(*User, *Organization)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*User)
+	nids := make(map[int]map[*User]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(user.AdminOrganizationsTable)
+		s.Join(joinT).On(s.C(organization.FieldID), joinT.C(user.AdminOrganizationsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(user.AdminOrganizationsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(user.AdminOrganizationsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*User]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Organization](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "admin_organizations" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (uq *UserQuery) loadInvitation(ctx context.Context, query *InvitationQuery, nodes []*User, init func(*User), assign func(*User, *Invitation)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*User)
+	for i := range nodes {
+		if nodes[i].invitation_invitees == nil {
+			continue
+		}
+		fk := *nodes[i].invitation_invitees
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(invitation.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.CreatedBy
-		node, ok := nodeids[fk]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "created_by" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "invitation_invitees" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
 	_spec.Node.Columns = uq.ctx.Fields
 	if len(uq.ctx.Fields) > 0 {
 		_spec.Unique = uq.ctx.Unique != nil && *uq.ctx.Unique
@@ -960,6 +2398,300 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedFiles tells the query-builder to eager-load the nodes that are connected to the "files"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedFiles(name string, opts ...func(*FileQuery)) *UserQuery {
+	query := (&FileClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedFiles == nil {
+		uq.withNamedFiles = make(map[string]*FileQuery)
+	}
+	uq.withNamedFiles[name] = query
+	return uq
+}
+
+// WithNamedDavAccounts tells the query-builder to eager-load the nodes that are connected to the "dav_accounts"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedDavAccounts(name string, opts ...func(*DavAccountQuery)) *UserQuery {
+	query := (&DavAccountClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedDavAccounts == nil {
+		uq.withNamedDavAccounts = make(map[string]*DavAccountQuery)
+	}
+	uq.withNamedDavAccounts[name] = query
+	return uq
+}
+
+// WithNamedShares tells the query-builder to eager-load the nodes that are connected to the "shares"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedShares(name string, opts ...func(*ShareQuery)) *UserQuery {
+	query := (&ShareClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedShares == nil {
+		uq.withNamedShares = make(map[string]*ShareQuery)
+	}
+	uq.withNamedShares[name] = query
+	return uq
+}
+
+// WithNamedPasskey tells the query-builder to eager-load the nodes that are connected to the "passkey"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedPasskey(name string, opts ...func(*PasskeyQuery)) *UserQuery {
+	query := (&PasskeyClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedPasskey == nil {
+		uq.withNamedPasskey = make(map[string]*PasskeyQuery)
+	}
+	uq.withNamedPasskey[name] = query
+	return uq
+}
+
+// WithNamedTasks tells the query-builder to eager-load the nodes that are connected to the "tasks"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedTasks(name string, opts ...func(*TaskQuery)) *UserQuery {
+	query := (&TaskClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedTasks == nil {
+		uq.withNamedTasks = make(map[string]*TaskQuery)
+	}
+	uq.withNamedTasks[name] = query
+	return uq
+}
+
+// WithNamedEntities tells the query-builder to eager-load the nodes that are connected to the "entities"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedEntities(name string, opts ...func(*EntityQuery)) *UserQuery {
+	query := (&EntityClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedEntities == nil {
+		uq.withNamedEntities = make(map[string]*EntityQuery)
+	}
+	uq.withNamedEntities[name] = query
+	return uq
+}
+
+// WithNamedViewPreferences tells the query-builder to eager-load the nodes that are connected to the "view_preferences"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedViewPreferences(name string, opts ...func(*ViewPreferenceQuery)) *UserQuery {
+	query := (&ViewPreferenceClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedViewPreferences == nil {
+		uq.withNamedViewPreferences = make(map[string]*ViewPreferenceQuery)
+	}
+	uq.withNamedViewPreferences[name] = query
+	return uq
+}
+
+// WithNamedAccessTokens tells the query-builder to eager-load the nodes that are connected to the "access_tokens"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedAccessTokens(name string, opts ...func(*AccessTokenQuery)) *UserQuery {
+	query := (&AccessTokenClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedAccessTokens == nil {
+		uq.withNamedAccessTokens = make(map[string]*AccessTokenQuery)
+	}
+	uq.withNamedAccessTokens[name] = query
+	return uq
+}
+
+// WithNamedInvitations tells the query-builder to eager-load the nodes that are connected to the "invitations"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedInvitations(name string, opts ...func(*InvitationQuery)) *UserQuery {
+	query := (&InvitationClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedInvitations == nil {
+		uq.withNamedInvitations = make(map[string]*InvitationQuery)
+	}
+	uq.withNamedInvitations[name] = query
+	return uq
+}
+
+// WithNamedEmails tells the query-builder to eager-load the nodes that are connected to the "emails"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedEmails(name string, opts ...func(*UserEmailQuery)) *UserQuery {
+	query := (&UserEmailClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedEmails == nil {
+		uq.withNamedEmails = make(map[string]*UserEmailQuery)
+	}
+	uq.withNamedEmails[name] = query
+	return uq
+}
+
+// WithNamedNotifications tells the query-builder to eager-load the nodes that are connected to the "notifications"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedNotifications(name string, opts ...func(*NotificationQuery)) *UserQuery {
+	query := (&NotificationClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedNotifications == nil {
+		uq.withNamedNotifications = make(map[string]*NotificationQuery)
+	}
+	uq.withNamedNotifications[name] = query
+	return uq
+}
+
+// WithNamedSavedSearches tells the query-builder to eager-load the nodes that are connected to the "saved_searches"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedSavedSearches(name string, opts ...func(*SavedSearchQuery)) *UserQuery {
+	query := (&SavedSearchClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedSavedSearches == nil {
+		uq.withNamedSavedSearches = make(map[string]*SavedSearchQuery)
+	}
+	uq.withNamedSavedSearches[name] = query
+	return uq
+}
+
+// WithNamedRssSubscriptions tells the query-builder to eager-load the nodes that are connected to the "rss_subscriptions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedRssSubscriptions(name string, opts ...func(*RssSubscriptionQuery)) *UserQuery {
+	query := (&RssSubscriptionClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedRssSubscriptions == nil {
+		uq.withNamedRssSubscriptions = make(map[string]*RssSubscriptionQuery)
+	}
+	uq.withNamedRssSubscriptions[name] = query
+	return uq
+}
+
+// WithNamedAutomationRules tells the query-builder to eager-load the nodes that are connected to the "automation_rules"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedAutomationRules(name string, opts ...func(*AutomationRuleQuery)) *UserQuery {
+	query := (&AutomationRuleClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedAutomationRules == nil {
+		uq.withNamedAutomationRules = make(map[string]*AutomationRuleQuery)
+	}
+	uq.withNamedAutomationRules[name] = query
+	return uq
+}
+
+// WithNamedSyncJobs tells the query-builder to eager-load the nodes that are connected to the "sync_jobs"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedSyncJobs(name string, opts ...func(*SyncJobQuery)) *UserQuery {
+	query := (&SyncJobClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedSyncJobs == nil {
+		uq.withNamedSyncJobs = make(map[string]*SyncJobQuery)
+	}
+	uq.withNamedSyncJobs[name] = query
+	return uq
+}
+
+// WithNamedRetentionRules tells the query-builder to eager-load the nodes that are connected to the "retention_rules"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedRetentionRules(name string, opts ...func(*RetentionRuleQuery)) *UserQuery {
+	query := (&RetentionRuleClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedRetentionRules == nil {
+		uq.withNamedRetentionRules = make(map[string]*RetentionRuleQuery)
+	}
+	uq.withNamedRetentionRules[name] = query
+	return uq
+}
+
+// WithNamedModerationCases tells the query-builder to eager-load the nodes that are connected to the "moderation_cases"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedModerationCases(name string, opts ...func(*ModerationCaseQuery)) *UserQuery {
+	query := (&ModerationCaseClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedModerationCases == nil {
+		uq.withNamedModerationCases = make(map[string]*ModerationCaseQuery)
+	}
+	uq.withNamedModerationCases[name] = query
+	return uq
+}
+
+// WithNamedS3AccessKeys tells the query-builder to eager-load the nodes that are connected to the "s3_access_keys"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedS3AccessKeys(name string, opts ...func(*S3AccessKeyQuery)) *UserQuery {
+	query := (&S3AccessKeyClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedS3AccessKeys == nil {
+		uq.withNamedS3AccessKeys = make(map[string]*S3AccessKeyQuery)
+	}
+	uq.withNamedS3AccessKeys[name] = query
+	return uq
+}
+
+// WithNamedCheckouts tells the query-builder to eager-load the nodes that are connected to the "checkouts"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedCheckouts(name string, opts ...func(*CheckoutQuery)) *UserQuery {
+	query := (&CheckoutClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedCheckouts == nil {
+		uq.withNamedCheckouts = make(map[string]*CheckoutQuery)
+	}
+	uq.withNamedCheckouts[name] = query
+	return uq
+}
+
+// WithNamedDismissedAnnouncements tells the query-builder to eager-load the nodes that are connected to the "dismissed_announcements"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedDismissedAnnouncements(name string, opts ...func(*AnnouncementQuery)) *UserQuery {
+	query := (&AnnouncementClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedDismissedAnnouncements == nil {
+		uq.withNamedDismissedAnnouncements = make(map[string]*AnnouncementQuery)
+	}
+	uq.withNamedDismissedAnnouncements[name] = query
+	return uq
+}
+
+// WithNamedAdminOrganizations tells the query-builder to eager-load the nodes that are connected to the "admin_organizations"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNamedAdminOrganizations(name string, opts ...func(*OrganizationQuery)) *UserQuery {
+	query := (&OrganizationClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if uq.withNamedAdminOrganizations == nil {
+		uq.withNamedAdminOrganizations = make(map[string]*OrganizationQuery)
+	}
+	uq.withNamedAdminOrganizations[name] = query
+	return uq
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
@@ -11,15 +11,30 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/accesstoken"
+	"github.com/cloudreve/Cloudreve/v4/ent/announcement"
+	"github.com/cloudreve/Cloudreve/v4/ent/automationrule"
+	"github.com/cloudreve/Cloudreve/v4/ent/checkout"
 	"github.com/cloudreve/Cloudreve/v4/ent/davaccount"
 	"github.com/cloudreve/Cloudreve/v4/ent/entity"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/group"
+	"github.com/cloudreve/Cloudreve/v4/ent/invitation"
+	"github.com/cloudreve/Cloudreve/v4/ent/moderationcase"
+	"github.com/cloudreve/Cloudreve/v4/ent/notification"
+	"github.com/cloudreve/Cloudreve/v4/ent/organization"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
 	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/retentionrule"
+	"github.com/cloudreve/Cloudreve/v4/ent/rsssubscription"
+	"github.com/cloudreve/Cloudreve/v4/ent/s3accesskey"
+	"github.com/cloudreve/Cloudreve/v4/ent/savedsearch"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
+	"github.com/cloudreve/Cloudreve/v4/ent/syncjob"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/useremail"
+	"github.com/cloudreve/Cloudreve/v4/ent/viewpreference"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 )
 
@@ -211,6 +226,100 @@ func (uu *UserUpdate) SetNillableGroupUsers(i *int) *UserUpdate {
 	return uu
 }
 
+// SetStoragePolicyOverride sets the "storage_policy_override" field.
+func (uu *UserUpdate) SetStoragePolicyOverride(i int) *UserUpdate {
+	uu.mutation.ResetStoragePolicyOverride()
+	uu.mutation.SetStoragePolicyOverride(i)
+	return uu
+}
+
+// SetNillableStoragePolicyOverride sets the "storage_policy_override" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableStoragePolicyOverride(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetStoragePolicyOverride(*i)
+	}
+	return uu
+}
+
+// AddStoragePolicyOverride adds i to the "storage_policy_override" field.
+func (uu *UserUpdate) AddStoragePolicyOverride(i int) *UserUpdate {
+	uu.mutation.AddStoragePolicyOverride(i)
+	return uu
+}
+
+// ClearStoragePolicyOverride clears the value of the "storage_policy_override" field.
+func (uu *UserUpdate) ClearStoragePolicyOverride() *UserUpdate {
+	uu.mutation.ClearStoragePolicyOverride()
+	return uu
+}
+
+// SetMaxStorageOverride sets the "max_storage_override" field.
+func (uu *UserUpdate) SetMaxStorageOverride(i int64) *UserUpdate {
+	uu.mutation.ResetMaxStorageOverride()
+	uu.mutation.SetMaxStorageOverride(i)
+	return uu
+}
+
+// SetNillableMaxStorageOverride sets the "max_storage_override" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableMaxStorageOverride(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetMaxStorageOverride(*i)
+	}
+	return uu
+}
+
+// AddMaxStorageOverride adds i to the "max_storage_override" field.
+func (uu *UserUpdate) AddMaxStorageOverride(i int64) *UserUpdate {
+	uu.mutation.AddMaxStorageOverride(i)
+	return uu
+}
+
+// ClearMaxStorageOverride clears the value of the "max_storage_override" field.
+func (uu *UserUpdate) ClearMaxStorageOverride() *UserUpdate {
+	uu.mutation.ClearMaxStorageOverride()
+	return uu
+}
+
+// SetGroupExpiresAt sets the "group_expires_at" field.
+func (uu *UserUpdate) SetGroupExpiresAt(t time.Time) *UserUpdate {
+	uu.mutation.SetGroupExpiresAt(t)
+	return uu
+}
+
+// SetNillableGroupExpiresAt sets the "group_expires_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableGroupExpiresAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetGroupExpiresAt(*t)
+	}
+	return uu
+}
+
+// ClearGroupExpiresAt clears the value of the "group_expires_at" field.
+func (uu *UserUpdate) ClearGroupExpiresAt() *UserUpdate {
+	uu.mutation.ClearGroupExpiresAt()
+	return uu
+}
+
+// SetEmailChangeAt sets the "email_change_at" field.
+func (uu *UserUpdate) SetEmailChangeAt(t time.Time) *UserUpdate {
+	uu.mutation.SetEmailChangeAt(t)
+	return uu
+}
+
+// SetNillableEmailChangeAt sets the "email_change_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableEmailChangeAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetEmailChangeAt(*t)
+	}
+	return uu
+}
+
+// ClearEmailChangeAt clears the value of the "email_change_at" field.
+func (uu *UserUpdate) ClearEmailChangeAt() *UserUpdate {
+	uu.mutation.ClearEmailChangeAt()
+	return uu
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (uu *UserUpdate) SetGroupID(id int) *UserUpdate {
 	uu.mutation.SetGroupID(id)
@@ -312,6 +421,250 @@ func (uu *UserUpdate) AddEntities(e ...*Entity) *UserUpdate {
 	return uu.AddEntityIDs(ids...)
 }
 
+// AddViewPreferenceIDs adds the "view_preferences" edge to the ViewPreference entity by IDs.
+func (uu *UserUpdate) AddViewPreferenceIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddViewPreferenceIDs(ids...)
+	return uu
+}
+
+// AddViewPreferences adds the "view_preferences" edges to the ViewPreference entity.
+func (uu *UserUpdate) AddViewPreferences(v ...*ViewPreference) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return uu.AddViewPreferenceIDs(ids...)
+}
+
+// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by IDs.
+func (uu *UserUpdate) AddAccessTokenIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddAccessTokenIDs(ids...)
+	return uu
+}
+
+// AddAccessTokens adds the "access_tokens" edges to the AccessToken entity.
+func (uu *UserUpdate) AddAccessTokens(a ...*AccessToken) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddAccessTokenIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (uu *UserUpdate) AddInvitationIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddInvitationIDs(ids...)
+	return uu
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (uu *UserUpdate) AddInvitations(i ...*Invitation) *UserUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uu.AddInvitationIDs(ids...)
+}
+
+// AddEmailIDs adds the "emails" edge to the UserEmail entity by IDs.
+func (uu *UserUpdate) AddEmailIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddEmailIDs(ids...)
+	return uu
+}
+
+// AddEmails adds the "emails" edges to the UserEmail entity.
+func (uu *UserUpdate) AddEmails(u ...*UserEmail) *UserUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uu.AddEmailIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (uu *UserUpdate) AddNotificationIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddNotificationIDs(ids...)
+	return uu
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (uu *UserUpdate) AddNotifications(n ...*Notification) *UserUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uu.AddNotificationIDs(ids...)
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (uu *UserUpdate) AddSavedSearchIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddSavedSearchIDs(ids...)
+	return uu
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (uu *UserUpdate) AddSavedSearches(s ...*SavedSearch) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddSavedSearchIDs(ids...)
+}
+
+// AddRssSubscriptionIDs adds the "rss_subscriptions" edge to the RssSubscription entity by IDs.
+func (uu *UserUpdate) AddRssSubscriptionIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddRssSubscriptionIDs(ids...)
+	return uu
+}
+
+// AddRssSubscriptions adds the "rss_subscriptions" edges to the RssSubscription entity.
+func (uu *UserUpdate) AddRssSubscriptions(r ...*RssSubscription) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddRssSubscriptionIDs(ids...)
+}
+
+// AddAutomationRuleIDs adds the "automation_rules" edge to the AutomationRule entity by IDs.
+func (uu *UserUpdate) AddAutomationRuleIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddAutomationRuleIDs(ids...)
+	return uu
+}
+
+// AddAutomationRules adds the "automation_rules" edges to the AutomationRule entity.
+func (uu *UserUpdate) AddAutomationRules(a ...*AutomationRule) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddAutomationRuleIDs(ids...)
+}
+
+// AddSyncJobIDs adds the "sync_jobs" edge to the SyncJob entity by IDs.
+func (uu *UserUpdate) AddSyncJobIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddSyncJobIDs(ids...)
+	return uu
+}
+
+// AddSyncJobs adds the "sync_jobs" edges to the SyncJob entity.
+func (uu *UserUpdate) AddSyncJobs(s ...*SyncJob) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddSyncJobIDs(ids...)
+}
+
+// AddRetentionRuleIDs adds the "retention_rules" edge to the RetentionRule entity by IDs.
+func (uu *UserUpdate) AddRetentionRuleIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddRetentionRuleIDs(ids...)
+	return uu
+}
+
+// AddRetentionRules adds the "retention_rules" edges to the RetentionRule entity.
+func (uu *UserUpdate) AddRetentionRules(r ...*RetentionRule) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.AddRetentionRuleIDs(ids...)
+}
+
+// AddModerationCaseIDs adds the "moderation_cases" edge to the ModerationCase entity by IDs.
+func (uu *UserUpdate) AddModerationCaseIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddModerationCaseIDs(ids...)
+	return uu
+}
+
+// AddModerationCases adds the "moderation_cases" edges to the ModerationCase entity.
+func (uu *UserUpdate) AddModerationCases(m ...*ModerationCase) *UserUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.AddModerationCaseIDs(ids...)
+}
+
+// AddS3AccessKeyIDs adds the "s3_access_keys" edge to the S3AccessKey entity by IDs.
+func (uu *UserUpdate) AddS3AccessKeyIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddS3AccessKeyIDs(ids...)
+	return uu
+}
+
+// AddS3AccessKeys adds the "s3_access_keys" edges to the S3AccessKey entity.
+func (uu *UserUpdate) AddS3AccessKeys(s ...*S3AccessKey) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddS3AccessKeyIDs(ids...)
+}
+
+// AddCheckoutIDs adds the "checkouts" edge to the Checkout entity by IDs.
+func (uu *UserUpdate) AddCheckoutIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddCheckoutIDs(ids...)
+	return uu
+}
+
+// AddCheckouts adds the "checkouts" edges to the Checkout entity.
+func (uu *UserUpdate) AddCheckouts(c ...*Checkout) *UserUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.AddCheckoutIDs(ids...)
+}
+
+// AddDismissedAnnouncementIDs adds the "dismissed_announcements" edge to the Announcement entity by IDs.
+func (uu *UserUpdate) AddDismissedAnnouncementIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddDismissedAnnouncementIDs(ids...)
+	return uu
+}
+
+// AddDismissedAnnouncements adds the "dismissed_announcements" edges to the Announcement entity.
+func (uu *UserUpdate) AddDismissedAnnouncements(a ...*Announcement) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddDismissedAnnouncementIDs(ids...)
+}
+
+// AddAdminOrganizationIDs adds the "admin_organizations" edge to the Organization entity by IDs.
+func (uu *UserUpdate) AddAdminOrganizationIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddAdminOrganizationIDs(ids...)
+	return uu
+}
+
+// AddAdminOrganizations adds the "admin_organizations" edges to the Organization entity.
+func (uu *UserUpdate) AddAdminOrganizations(o ...*Organization) *UserUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uu.AddAdminOrganizationIDs(ids...)
+}
+
+// SetInvitationID sets the "invitation" edge to the Invitation entity by ID.
+func (uu *UserUpdate) SetInvitationID(id int) *UserUpdate {
+	uu.mutation.SetInvitationID(id)
+	return uu
+}
+
+// SetNillableInvitationID sets the "invitation" edge to the Invitation entity by ID if the given value is not nil.
+func (uu *UserUpdate) SetNillableInvitationID(id *int) *UserUpdate {
+	if id != nil {
+		uu = uu.SetInvitationID(*id)
+	}
+	return uu
+}
+
+// SetInvitation sets the "invitation" edge to the Invitation entity.
+func (uu *UserUpdate) SetInvitation(i *Invitation) *UserUpdate {
+	return uu.SetInvitationID(i.ID)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -449,78 +802,399 @@ func (uu *UserUpdate) RemoveEntities(e ...*Entity) *UserUpdate {
 	return uu.RemoveEntityIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
-	if err := uu.defaults(); err != nil {
-		return 0, err
-	}
-	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
+// ClearViewPreferences clears all "view_preferences" edges to the ViewPreference entity.
+func (uu *UserUpdate) ClearViewPreferences() *UserUpdate {
+	uu.mutation.ClearViewPreferences()
+	return uu
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (uu *UserUpdate) SaveX(ctx context.Context) int {
-	affected, err := uu.Save(ctx)
-	if err != nil {
-		panic(err)
+// RemoveViewPreferenceIDs removes the "view_preferences" edge to ViewPreference entities by IDs.
+func (uu *UserUpdate) RemoveViewPreferenceIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveViewPreferenceIDs(ids...)
+	return uu
+}
+
+// RemoveViewPreferences removes "view_preferences" edges to ViewPreference entities.
+func (uu *UserUpdate) RemoveViewPreferences(v ...*ViewPreference) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return affected
+	return uu.RemoveViewPreferenceIDs(ids...)
 }
 
-// Exec executes the query.
-func (uu *UserUpdate) Exec(ctx context.Context) error {
-	_, err := uu.Save(ctx)
-	return err
+// ClearAccessTokens clears all "access_tokens" edges to the AccessToken entity.
+func (uu *UserUpdate) ClearAccessTokens() *UserUpdate {
+	uu.mutation.ClearAccessTokens()
+	return uu
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (uu *UserUpdate) ExecX(ctx context.Context) {
-	if err := uu.Exec(ctx); err != nil {
-		panic(err)
-	}
+// RemoveAccessTokenIDs removes the "access_tokens" edge to AccessToken entities by IDs.
+func (uu *UserUpdate) RemoveAccessTokenIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveAccessTokenIDs(ids...)
+	return uu
 }
 
-// defaults sets the default values of the builder before save.
-func (uu *UserUpdate) defaults() error {
-	if _, ok := uu.mutation.UpdatedAt(); !ok {
-		if user.UpdateDefaultUpdatedAt == nil {
-			return fmt.Errorf("ent: uninitialized user.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
-		}
-		v := user.UpdateDefaultUpdatedAt()
-		uu.mutation.SetUpdatedAt(v)
+// RemoveAccessTokens removes "access_tokens" edges to AccessToken entities.
+func (uu *UserUpdate) RemoveAccessTokens(a ...*AccessToken) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return nil
+	return uu.RemoveAccessTokenIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (uu *UserUpdate) check() error {
-	if v, ok := uu.mutation.Email(); ok {
-		if err := user.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
-		}
+// ClearInvitations clears all "invitations" edges to the Invitation entity.
+func (uu *UserUpdate) ClearInvitations() *UserUpdate {
+	uu.mutation.ClearInvitations()
+	return uu
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
+func (uu *UserUpdate) RemoveInvitationIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveInvitationIDs(ids...)
+	return uu
+}
+
+// RemoveInvitations removes "invitations" edges to Invitation entities.
+func (uu *UserUpdate) RemoveInvitations(i ...*Invitation) *UserUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
 	}
-	if v, ok := uu.mutation.Nick(); ok {
-		if err := user.NickValidator(v); err != nil {
-			return &ValidationError{Name: "nick", err: fmt.Errorf(`ent: validator failed for field "User.nick": %w`, err)}
-		}
+	return uu.RemoveInvitationIDs(ids...)
+}
+
+// ClearEmails clears all "emails" edges to the UserEmail entity.
+func (uu *UserUpdate) ClearEmails() *UserUpdate {
+	uu.mutation.ClearEmails()
+	return uu
+}
+
+// RemoveEmailIDs removes the "emails" edge to UserEmail entities by IDs.
+func (uu *UserUpdate) RemoveEmailIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveEmailIDs(ids...)
+	return uu
+}
+
+// RemoveEmails removes "emails" edges to UserEmail entities.
+func (uu *UserUpdate) RemoveEmails(u ...*UserEmail) *UserUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
 	}
-	if v, ok := uu.mutation.Status(); ok {
-		if err := user.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "User.status": %w`, err)}
-		}
+	return uu.RemoveEmailIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (uu *UserUpdate) ClearNotifications() *UserUpdate {
+	uu.mutation.ClearNotifications()
+	return uu
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (uu *UserUpdate) RemoveNotificationIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveNotificationIDs(ids...)
+	return uu
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (uu *UserUpdate) RemoveNotifications(n ...*Notification) *UserUpdate {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
 	}
-	if _, ok := uu.mutation.GroupID(); uu.mutation.GroupCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "User.group"`)
+	return uu.RemoveNotificationIDs(ids...)
+}
+
+// ClearSavedSearches clears all "saved_searches" edges to the SavedSearch entity.
+func (uu *UserUpdate) ClearSavedSearches() *UserUpdate {
+	uu.mutation.ClearSavedSearches()
+	return uu
+}
+
+// RemoveSavedSearchIDs removes the "saved_searches" edge to SavedSearch entities by IDs.
+func (uu *UserUpdate) RemoveSavedSearchIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveSavedSearchIDs(ids...)
+	return uu
+}
+
+// RemoveSavedSearches removes "saved_searches" edges to SavedSearch entities.
+func (uu *UserUpdate) RemoveSavedSearches(s ...*SavedSearch) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
-	return nil
+	return uu.RemoveSavedSearchIDs(ids...)
 }
 
-func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := uu.check(); err != nil {
-		return n, err
+// ClearRssSubscriptions clears all "rss_subscriptions" edges to the RssSubscription entity.
+func (uu *UserUpdate) ClearRssSubscriptions() *UserUpdate {
+	uu.mutation.ClearRssSubscriptions()
+	return uu
+}
+
+// RemoveRssSubscriptionIDs removes the "rss_subscriptions" edge to RssSubscription entities by IDs.
+func (uu *UserUpdate) RemoveRssSubscriptionIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveRssSubscriptionIDs(ids...)
+	return uu
+}
+
+// RemoveRssSubscriptions removes "rss_subscriptions" edges to RssSubscription entities.
+func (uu *UserUpdate) RemoveRssSubscriptions(r ...*RssSubscription) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
 	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
-	if ps := uu.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
+	return uu.RemoveRssSubscriptionIDs(ids...)
+}
+
+// ClearAutomationRules clears all "automation_rules" edges to the AutomationRule entity.
+func (uu *UserUpdate) ClearAutomationRules() *UserUpdate {
+	uu.mutation.ClearAutomationRules()
+	return uu
+}
+
+// RemoveAutomationRuleIDs removes the "automation_rules" edge to AutomationRule entities by IDs.
+func (uu *UserUpdate) RemoveAutomationRuleIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveAutomationRuleIDs(ids...)
+	return uu
+}
+
+// RemoveAutomationRules removes "automation_rules" edges to AutomationRule entities.
+func (uu *UserUpdate) RemoveAutomationRules(a ...*AutomationRule) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.RemoveAutomationRuleIDs(ids...)
+}
+
+// ClearSyncJobs clears all "sync_jobs" edges to the SyncJob entity.
+func (uu *UserUpdate) ClearSyncJobs() *UserUpdate {
+	uu.mutation.ClearSyncJobs()
+	return uu
+}
+
+// RemoveSyncJobIDs removes the "sync_jobs" edge to SyncJob entities by IDs.
+func (uu *UserUpdate) RemoveSyncJobIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveSyncJobIDs(ids...)
+	return uu
+}
+
+// RemoveSyncJobs removes "sync_jobs" edges to SyncJob entities.
+func (uu *UserUpdate) RemoveSyncJobs(s ...*SyncJob) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveSyncJobIDs(ids...)
+}
+
+// ClearRetentionRules clears all "retention_rules" edges to the RetentionRule entity.
+func (uu *UserUpdate) ClearRetentionRules() *UserUpdate {
+	uu.mutation.ClearRetentionRules()
+	return uu
+}
+
+// RemoveRetentionRuleIDs removes the "retention_rules" edge to RetentionRule entities by IDs.
+func (uu *UserUpdate) RemoveRetentionRuleIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveRetentionRuleIDs(ids...)
+	return uu
+}
+
+// RemoveRetentionRules removes "retention_rules" edges to RetentionRule entities.
+func (uu *UserUpdate) RemoveRetentionRules(r ...*RetentionRule) *UserUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uu.RemoveRetentionRuleIDs(ids...)
+}
+
+// ClearModerationCases clears all "moderation_cases" edges to the ModerationCase entity.
+func (uu *UserUpdate) ClearModerationCases() *UserUpdate {
+	uu.mutation.ClearModerationCases()
+	return uu
+}
+
+// RemoveModerationCaseIDs removes the "moderation_cases" edge to ModerationCase entities by IDs.
+func (uu *UserUpdate) RemoveModerationCaseIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveModerationCaseIDs(ids...)
+	return uu
+}
+
+// RemoveModerationCases removes "moderation_cases" edges to ModerationCase entities.
+func (uu *UserUpdate) RemoveModerationCases(m ...*ModerationCase) *UserUpdate {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uu.RemoveModerationCaseIDs(ids...)
+}
+
+// ClearS3AccessKeys clears all "s3_access_keys" edges to the S3AccessKey entity.
+func (uu *UserUpdate) ClearS3AccessKeys() *UserUpdate {
+	uu.mutation.ClearS3AccessKeys()
+	return uu
+}
+
+// RemoveS3AccessKeyIDs removes the "s3_access_keys" edge to S3AccessKey entities by IDs.
+func (uu *UserUpdate) RemoveS3AccessKeyIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveS3AccessKeyIDs(ids...)
+	return uu
+}
+
+// RemoveS3AccessKeys removes "s3_access_keys" edges to S3AccessKey entities.
+func (uu *UserUpdate) RemoveS3AccessKeys(s ...*S3AccessKey) *UserUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveS3AccessKeyIDs(ids...)
+}
+
+// ClearCheckouts clears all "checkouts" edges to the Checkout entity.
+func (uu *UserUpdate) ClearCheckouts() *UserUpdate {
+	uu.mutation.ClearCheckouts()
+	return uu
+}
+
+// RemoveCheckoutIDs removes the "checkouts" edge to Checkout entities by IDs.
+func (uu *UserUpdate) RemoveCheckoutIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveCheckoutIDs(ids...)
+	return uu
+}
+
+// RemoveCheckouts removes "checkouts" edges to Checkout entities.
+func (uu *UserUpdate) RemoveCheckouts(c ...*Checkout) *UserUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uu.RemoveCheckoutIDs(ids...)
+}
+
+// ClearDismissedAnnouncements clears all "dismissed_announcements" edges to the Announcement entity.
+func (uu *UserUpdate) ClearDismissedAnnouncements() *UserUpdate {
+	uu.mutation.ClearDismissedAnnouncements()
+	return uu
+}
+
+// RemoveDismissedAnnouncementIDs removes the "dismissed_announcements" edge to Announcement entities by IDs.
+func (uu *UserUpdate) RemoveDismissedAnnouncementIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveDismissedAnnouncementIDs(ids...)
+	return uu
+}
+
+// RemoveDismissedAnnouncements removes "dismissed_announcements" edges to Announcement entities.
+func (uu *UserUpdate) RemoveDismissedAnnouncements(a ...*Announcement) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.RemoveDismissedAnnouncementIDs(ids...)
+}
+
+// ClearAdminOrganizations clears all "admin_organizations" edges to the Organization entity.
+func (uu *UserUpdate) ClearAdminOrganizations() *UserUpdate {
+	uu.mutation.ClearAdminOrganizations()
+	return uu
+}
+
+// RemoveAdminOrganizationIDs removes the "admin_organizations" edge to Organization entities by IDs.
+func (uu *UserUpdate) RemoveAdminOrganizationIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveAdminOrganizationIDs(ids...)
+	return uu
+}
+
+// RemoveAdminOrganizations removes "admin_organizations" edges to Organization entities.
+func (uu *UserUpdate) RemoveAdminOrganizations(o ...*Organization) *UserUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uu.RemoveAdminOrganizationIDs(ids...)
+}
+
+// ClearInvitation clears the "invitation" edge to the Invitation entity.
+func (uu *UserUpdate) ClearInvitation() *UserUpdate {
+	uu.mutation.ClearInvitation()
+	return uu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+	if err := uu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uu *UserUpdate) SaveX(ctx context.Context) int {
+	affected, err := uu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uu *UserUpdate) Exec(ctx context.Context) error {
+	_, err := uu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uu *UserUpdate) ExecX(ctx context.Context) {
+	if err := uu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uu *UserUpdate) defaults() error {
+	if _, ok := uu.mutation.UpdatedAt(); !ok {
+		if user.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized user.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := user.UpdateDefaultUpdatedAt()
+		uu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uu *UserUpdate) check() error {
+	if v, ok := uu.mutation.Email(); ok {
+		if err := user.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.Nick(); ok {
+		if err := user.NickValidator(v); err != nil {
+			return &ValidationError{Name: "nick", err: fmt.Errorf(`ent: validator failed for field "User.nick": %w`, err)}
+		}
+	}
+	if v, ok := uu.mutation.Status(); ok {
+		if err := user.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "User.status": %w`, err)}
+		}
+	}
+	if _, ok := uu.mutation.GroupID(); uu.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "User.group"`)
+	}
+	return nil
+}
+
+func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
+	if ps := uu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
@@ -574,6 +1248,36 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.SettingsCleared() {
 		_spec.ClearField(user.FieldSettings, field.TypeJSON)
 	}
+	if value, ok := uu.mutation.StoragePolicyOverride(); ok {
+		_spec.SetField(user.FieldStoragePolicyOverride, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedStoragePolicyOverride(); ok {
+		_spec.AddField(user.FieldStoragePolicyOverride, field.TypeInt, value)
+	}
+	if uu.mutation.StoragePolicyOverrideCleared() {
+		_spec.ClearField(user.FieldStoragePolicyOverride, field.TypeInt)
+	}
+	if value, ok := uu.mutation.MaxStorageOverride(); ok {
+		_spec.SetField(user.FieldMaxStorageOverride, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedMaxStorageOverride(); ok {
+		_spec.AddField(user.FieldMaxStorageOverride, field.TypeInt64, value)
+	}
+	if uu.mutation.MaxStorageOverrideCleared() {
+		_spec.ClearField(user.FieldMaxStorageOverride, field.TypeInt64)
+	}
+	if value, ok := uu.mutation.GroupExpiresAt(); ok {
+		_spec.SetField(user.FieldGroupExpiresAt, field.TypeTime, value)
+	}
+	if uu.mutation.GroupExpiresAtCleared() {
+		_spec.ClearField(user.FieldGroupExpiresAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.EmailChangeAt(); ok {
+		_spec.SetField(user.FieldEmailChangeAt, field.TypeTime, value)
+	}
+	if uu.mutation.EmailChangeAtCleared() {
+		_spec.ClearField(user.FieldEmailChangeAt, field.TypeTime)
+	}
 	if uu.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -873,616 +1577,2594 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if uu.mutation.ViewPreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ViewPreferencesTable,
+			Columns: []string{user.ViewPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewpreference.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	uu.mutation.done = true
-	return n, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetUpdatedAt(t)
-	return uuo
-}
-
-// SetDeletedAt sets the "deleted_at" field.
-func (uuo *UserUpdateOne) SetDeletedAt(t time.Time) *UserUpdateOne {
-	uuo.mutation.SetDeletedAt(t)
-	return uuo
-}
-
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableDeletedAt(t *time.Time) *UserUpdateOne {
-	if t != nil {
-		uuo.SetDeletedAt(*t)
+	if nodes := uu.mutation.RemovedViewPreferencesIDs(); len(nodes) > 0 && !uu.mutation.ViewPreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ViewPreferencesTable,
+			Columns: []string{user.ViewPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewpreference.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (uuo *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
-	uuo.mutation.ClearDeletedAt()
-	return uuo
-}
-
-// SetEmail sets the "email" field.
-func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
-	uuo.mutation.SetEmail(s)
-	return uuo
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetEmail(*s)
+	if nodes := uu.mutation.ViewPreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ViewPreferencesTable,
+			Columns: []string{user.ViewPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewpreference.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// SetNick sets the "nick" field.
-func (uuo *UserUpdateOne) SetNick(s string) *UserUpdateOne {
-	uuo.mutation.SetNick(s)
-	return uuo
-}
-
-// SetNillableNick sets the "nick" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableNick(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetNick(*s)
+	if uu.mutation.AccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccessTokensTable,
+			Columns: []string{user.AccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetPassword sets the "password" field.
-func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
-	uuo.mutation.SetPassword(s)
-	return uuo
-}
-
-// SetNillablePassword sets the "password" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillablePassword(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetPassword(*s)
+	if nodes := uu.mutation.RemovedAccessTokensIDs(); len(nodes) > 0 && !uu.mutation.AccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccessTokensTable,
+			Columns: []string{user.AccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// ClearPassword clears the value of the "password" field.
-func (uuo *UserUpdateOne) ClearPassword() *UserUpdateOne {
-	uuo.mutation.ClearPassword()
-	return uuo
-}
-
-// SetStatus sets the "status" field.
-func (uuo *UserUpdateOne) SetStatus(u user.Status) *UserUpdateOne {
-	uuo.mutation.SetStatus(u)
-	return uuo
-}
-
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableStatus(u *user.Status) *UserUpdateOne {
-	if u != nil {
-		uuo.SetStatus(*u)
+	if nodes := uu.mutation.AccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccessTokensTable,
+			Columns: []string{user.AccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// SetStorage sets the "storage" field.
-func (uuo *UserUpdateOne) SetStorage(i int64) *UserUpdateOne {
-	uuo.mutation.ResetStorage()
-	uuo.mutation.SetStorage(i)
-	return uuo
-}
-
-// SetNillableStorage sets the "storage" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableStorage(i *int64) *UserUpdateOne {
-	if i != nil {
-		uuo.SetStorage(*i)
+	if uu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// AddStorage adds i to the "storage" field.
-func (uuo *UserUpdateOne) AddStorage(i int64) *UserUpdateOne {
-	uuo.mutation.AddStorage(i)
-	return uuo
-}
-
-// SetTwoFactorSecret sets the "two_factor_secret" field.
-func (uuo *UserUpdateOne) SetTwoFactorSecret(s string) *UserUpdateOne {
-	uuo.mutation.SetTwoFactorSecret(s)
-	return uuo
-}
-
-// SetNillableTwoFactorSecret sets the "two_factor_secret" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableTwoFactorSecret(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetTwoFactorSecret(*s)
+	if nodes := uu.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !uu.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// ClearTwoFactorSecret clears the value of the "two_factor_secret" field.
-func (uuo *UserUpdateOne) ClearTwoFactorSecret() *UserUpdateOne {
-	uuo.mutation.ClearTwoFactorSecret()
-	return uuo
-}
-
-// SetAvatar sets the "avatar" field.
-func (uuo *UserUpdateOne) SetAvatar(s string) *UserUpdateOne {
-	uuo.mutation.SetAvatar(s)
-	return uuo
-}
-
-// SetNillableAvatar sets the "avatar" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableAvatar(s *string) *UserUpdateOne {
-	if s != nil {
-		uuo.SetAvatar(*s)
+	if nodes := uu.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo
-}
-
-// ClearAvatar clears the value of the "avatar" field.
-func (uuo *UserUpdateOne) ClearAvatar() *UserUpdateOne {
-	uuo.mutation.ClearAvatar()
-	return uuo
-}
-
-// SetSettings sets the "settings" field.
-func (uuo *UserUpdateOne) SetSettings(ts *types.UserSetting) *UserUpdateOne {
-	uuo.mutation.SetSettings(ts)
-	return uuo
-}
-
-// ClearSettings clears the value of the "settings" field.
-func (uuo *UserUpdateOne) ClearSettings() *UserUpdateOne {
-	uuo.mutation.ClearSettings()
-	return uuo
-}
-
-// SetGroupUsers sets the "group_users" field.
-func (uuo *UserUpdateOne) SetGroupUsers(i int) *UserUpdateOne {
-	uuo.mutation.SetGroupUsers(i)
-	return uuo
-}
-
-// SetNillableGroupUsers sets the "group_users" field if the given value is not nil.
-func (uuo *UserUpdateOne) SetNillableGroupUsers(i *int) *UserUpdateOne {
-	if i != nil {
-		uuo.SetGroupUsers(*i)
+	if uu.mutation.EmailsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailsTable,
+			Columns: []string{user.EmailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useremail.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo
-}
-
-// SetGroupID sets the "group" edge to the Group entity by ID.
-func (uuo *UserUpdateOne) SetGroupID(id int) *UserUpdateOne {
-	uuo.mutation.SetGroupID(id)
-	return uuo
-}
-
-// SetGroup sets the "group" edge to the Group entity.
-func (uuo *UserUpdateOne) SetGroup(g *Group) *UserUpdateOne {
-	return uuo.SetGroupID(g.ID)
-}
-
-// AddFileIDs adds the "files" edge to the File entity by IDs.
-func (uuo *UserUpdateOne) AddFileIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddFileIDs(ids...)
-	return uuo
-}
-
-// AddFiles adds the "files" edges to the File entity.
-func (uuo *UserUpdateOne) AddFiles(f ...*File) *UserUpdateOne {
-	ids := make([]int, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
+	if nodes := uu.mutation.RemovedEmailsIDs(); len(nodes) > 0 && !uu.mutation.EmailsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailsTable,
+			Columns: []string{user.EmailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useremail.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddFileIDs(ids...)
-}
-
-// AddDavAccountIDs adds the "dav_accounts" edge to the DavAccount entity by IDs.
-func (uuo *UserUpdateOne) AddDavAccountIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddDavAccountIDs(ids...)
-	return uuo
-}
-
-// AddDavAccounts adds the "dav_accounts" edges to the DavAccount entity.
-func (uuo *UserUpdateOne) AddDavAccounts(d ...*DavAccount) *UserUpdateOne {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+	if nodes := uu.mutation.EmailsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailsTable,
+			Columns: []string{user.EmailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useremail.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.AddDavAccountIDs(ids...)
-}
-
-// AddShareIDs adds the "shares" edge to the Share entity by IDs.
-func (uuo *UserUpdateOne) AddShareIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddShareIDs(ids...)
-	return uuo
-}
-
-// AddShares adds the "shares" edges to the Share entity.
-func (uuo *UserUpdateOne) AddShares(s ...*Share) *UserUpdateOne {
-	ids := make([]int, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+	if uu.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddShareIDs(ids...)
-}
-
-// AddPasskeyIDs adds the "passkey" edge to the Passkey entity by IDs.
-func (uuo *UserUpdateOne) AddPasskeyIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddPasskeyIDs(ids...)
-	return uuo
-}
-
-// AddPasskey adds the "passkey" edges to the Passkey entity.
-func (uuo *UserUpdateOne) AddPasskey(p ...*Passkey) *UserUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+	if nodes := uu.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !uu.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddPasskeyIDs(ids...)
-}
-
-// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
-func (uuo *UserUpdateOne) AddTaskIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddTaskIDs(ids...)
-	return uuo
-}
-
-// AddTasks adds the "tasks" edges to the Task entity.
-func (uuo *UserUpdateOne) AddTasks(t ...*Task) *UserUpdateOne {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
+	if nodes := uu.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.AddTaskIDs(ids...)
-}
-
-// AddEntityIDs adds the "entities" edge to the Entity entity by IDs.
-func (uuo *UserUpdateOne) AddEntityIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.AddEntityIDs(ids...)
-	return uuo
-}
-
-// AddEntities adds the "entities" edges to the Entity entity.
-func (uuo *UserUpdateOne) AddEntities(e ...*Entity) *UserUpdateOne {
-	ids := make([]int, len(e))
-	for i := range e {
-		ids[i] = e[i].ID
+	if uu.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.AddEntityIDs(ids...)
-}
-
-// Mutation returns the UserMutation object of the builder.
-func (uuo *UserUpdateOne) Mutation() *UserMutation {
-	return uuo.mutation
-}
-
-// ClearGroup clears the "group" edge to the Group entity.
-func (uuo *UserUpdateOne) ClearGroup() *UserUpdateOne {
-	uuo.mutation.ClearGroup()
-	return uuo
-}
-
-// ClearFiles clears all "files" edges to the File entity.
-func (uuo *UserUpdateOne) ClearFiles() *UserUpdateOne {
-	uuo.mutation.ClearFiles()
-	return uuo
-}
-
-// RemoveFileIDs removes the "files" edge to File entities by IDs.
-func (uuo *UserUpdateOne) RemoveFileIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveFileIDs(ids...)
-	return uuo
-}
-
-// RemoveFiles removes "files" edges to File entities.
-func (uuo *UserUpdateOne) RemoveFiles(f ...*File) *UserUpdateOne {
-	ids := make([]int, len(f))
-	for i := range f {
-		ids[i] = f[i].ID
+	if nodes := uu.mutation.RemovedSavedSearchesIDs(); len(nodes) > 0 && !uu.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveFileIDs(ids...)
-}
-
-// ClearDavAccounts clears all "dav_accounts" edges to the DavAccount entity.
-func (uuo *UserUpdateOne) ClearDavAccounts() *UserUpdateOne {
-	uuo.mutation.ClearDavAccounts()
-	return uuo
-}
-
-// RemoveDavAccountIDs removes the "dav_accounts" edge to DavAccount entities by IDs.
-func (uuo *UserUpdateOne) RemoveDavAccountIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveDavAccountIDs(ids...)
-	return uuo
-}
-
-// RemoveDavAccounts removes "dav_accounts" edges to DavAccount entities.
-func (uuo *UserUpdateOne) RemoveDavAccounts(d ...*DavAccount) *UserUpdateOne {
-	ids := make([]int, len(d))
-	for i := range d {
-		ids[i] = d[i].ID
+	if nodes := uu.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemoveDavAccountIDs(ids...)
-}
-
-// ClearShares clears all "shares" edges to the Share entity.
-func (uuo *UserUpdateOne) ClearShares() *UserUpdateOne {
-	uuo.mutation.ClearShares()
-	return uuo
-}
-
-// RemoveShareIDs removes the "shares" edge to Share entities by IDs.
-func (uuo *UserUpdateOne) RemoveShareIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveShareIDs(ids...)
-	return uuo
-}
-
-// RemoveShares removes "shares" edges to Share entities.
-func (uuo *UserUpdateOne) RemoveShares(s ...*Share) *UserUpdateOne {
-	ids := make([]int, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+	if uu.mutation.RssSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RssSubscriptionsTable,
+			Columns: []string{user.RssSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsssubscription.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemoveShareIDs(ids...)
-}
-
-// ClearPasskey clears all "passkey" edges to the Passkey entity.
-func (uuo *UserUpdateOne) ClearPasskey() *UserUpdateOne {
-	uuo.mutation.ClearPasskey()
-	return uuo
-}
-
-// RemovePasskeyIDs removes the "passkey" edge to Passkey entities by IDs.
-func (uuo *UserUpdateOne) RemovePasskeyIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemovePasskeyIDs(ids...)
-	return uuo
-}
-
-// RemovePasskey removes "passkey" edges to Passkey entities.
-func (uuo *UserUpdateOne) RemovePasskey(p ...*Passkey) *UserUpdateOne {
-	ids := make([]int, len(p))
-	for i := range p {
-		ids[i] = p[i].ID
+	if nodes := uu.mutation.RemovedRssSubscriptionsIDs(); len(nodes) > 0 && !uu.mutation.RssSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RssSubscriptionsTable,
+			Columns: []string{user.RssSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsssubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return uuo.RemovePasskeyIDs(ids...)
-}
-
-// ClearTasks clears all "tasks" edges to the Task entity.
-func (uuo *UserUpdateOne) ClearTasks() *UserUpdateOne {
-	uuo.mutation.ClearTasks()
-	return uuo
-}
-
-// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
-func (uuo *UserUpdateOne) RemoveTaskIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveTaskIDs(ids...)
-	return uuo
-}
-
-// RemoveTasks removes "tasks" edges to Task entities.
-func (uuo *UserUpdateOne) RemoveTasks(t ...*Task) *UserUpdateOne {
-	ids := make([]int, len(t))
-	for i := range t {
-		ids[i] = t[i].ID
+	if nodes := uu.mutation.RssSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RssSubscriptionsTable,
+			Columns: []string{user.RssSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsssubscription.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return uuo.RemoveTaskIDs(ids...)
-}
-
-// ClearEntities clears all "entities" edges to the Entity entity.
-func (uuo *UserUpdateOne) ClearEntities() *UserUpdateOne {
-	uuo.mutation.ClearEntities()
-	return uuo
+	if uu.mutation.AutomationRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AutomationRulesTable,
+			Columns: []string{user.AutomationRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedAutomationRulesIDs(); len(nodes) > 0 && !uu.mutation.AutomationRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AutomationRulesTable,
+			Columns: []string{user.AutomationRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.AutomationRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AutomationRulesTable,
+			Columns: []string{user.AutomationRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.SyncJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SyncJobsTable,
+			Columns: []string{user.SyncJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedSyncJobsIDs(); len(nodes) > 0 && !uu.mutation.SyncJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SyncJobsTable,
+			Columns: []string{user.SyncJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SyncJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SyncJobsTable,
+			Columns: []string{user.SyncJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.RetentionRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RetentionRulesTable,
+			Columns: []string{user.RetentionRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedRetentionRulesIDs(); len(nodes) > 0 && !uu.mutation.RetentionRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RetentionRulesTable,
+			Columns: []string{user.RetentionRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RetentionRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RetentionRulesTable,
+			Columns: []string{user.RetentionRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.ModerationCasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ModerationCasesTable,
+			Columns: []string{user.ModerationCasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedModerationCasesIDs(); len(nodes) > 0 && !uu.mutation.ModerationCasesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ModerationCasesTable,
+			Columns: []string{user.ModerationCasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.ModerationCasesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ModerationCasesTable,
+			Columns: []string{user.ModerationCasesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.S3AccessKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.S3AccessKeysTable,
+			Columns: []string{user.S3AccessKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(s3accesskey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedS3AccessKeysIDs(); len(nodes) > 0 && !uu.mutation.S3AccessKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.S3AccessKeysTable,
+			Columns: []string{user.S3AccessKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(s3accesskey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.S3AccessKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.S3AccessKeysTable,
+			Columns: []string{user.S3AccessKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(s3accesskey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.CheckoutsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CheckoutsTable,
+			Columns: []string{user.CheckoutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkout.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedCheckoutsIDs(); len(nodes) > 0 && !uu.mutation.CheckoutsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CheckoutsTable,
+			Columns: []string{user.CheckoutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkout.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.CheckoutsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CheckoutsTable,
+			Columns: []string{user.CheckoutsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(checkout.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.DismissedAnnouncementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.DismissedAnnouncementsTable,
+			Columns: user.DismissedAnnouncementsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedDismissedAnnouncementsIDs(); len(nodes) > 0 && !uu.mutation.DismissedAnnouncementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.DismissedAnnouncementsTable,
+			Columns: user.DismissedAnnouncementsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.DismissedAnnouncementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.DismissedAnnouncementsTable,
+			Columns: user.DismissedAnnouncementsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.AdminOrganizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AdminOrganizationsTable,
+			Columns: user.AdminOrganizationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedAdminOrganizationsIDs(); len(nodes) > 0 && !uu.mutation.AdminOrganizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AdminOrganizationsTable,
+			Columns: user.AdminOrganizationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.AdminOrganizationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AdminOrganizationsTable,
+			Columns: user.AdminOrganizationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uu.mutation.InvitationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.InvitationTable,
+			Columns: []string{user.InvitationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.InvitationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.InvitationTable,
+			Columns: []string{user.InvitationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uuo *UserUpdateOne) SetUpdatedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetUpdatedAt(t)
+	return uuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uuo *UserUpdateOne) SetDeletedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetDeletedAt(t)
+	return uuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDeletedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetDeletedAt(*t)
+	}
+	return uuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (uuo *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
+	uuo.mutation.ClearDeletedAt()
+	return uuo
+}
+
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
+	return uuo
+}
+
+// SetNick sets the "nick" field.
+func (uuo *UserUpdateOne) SetNick(s string) *UserUpdateOne {
+	uuo.mutation.SetNick(s)
+	return uuo
+}
+
+// SetNillableNick sets the "nick" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableNick(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetNick(*s)
+	}
+	return uuo
+}
+
+// SetPassword sets the "password" field.
+func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
+	uuo.mutation.SetPassword(s)
+	return uuo
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePassword(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPassword(*s)
+	}
+	return uuo
+}
+
+// ClearPassword clears the value of the "password" field.
+func (uuo *UserUpdateOne) ClearPassword() *UserUpdateOne {
+	uuo.mutation.ClearPassword()
+	return uuo
+}
+
+// SetStatus sets the "status" field.
+func (uuo *UserUpdateOne) SetStatus(u user.Status) *UserUpdateOne {
+	uuo.mutation.SetStatus(u)
+	return uuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableStatus(u *user.Status) *UserUpdateOne {
+	if u != nil {
+		uuo.SetStatus(*u)
+	}
+	return uuo
+}
+
+// SetStorage sets the "storage" field.
+func (uuo *UserUpdateOne) SetStorage(i int64) *UserUpdateOne {
+	uuo.mutation.ResetStorage()
+	uuo.mutation.SetStorage(i)
+	return uuo
+}
+
+// SetNillableStorage sets the "storage" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableStorage(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetStorage(*i)
+	}
+	return uuo
+}
+
+// AddStorage adds i to the "storage" field.
+func (uuo *UserUpdateOne) AddStorage(i int64) *UserUpdateOne {
+	uuo.mutation.AddStorage(i)
+	return uuo
+}
+
+// SetTwoFactorSecret sets the "two_factor_secret" field.
+func (uuo *UserUpdateOne) SetTwoFactorSecret(s string) *UserUpdateOne {
+	uuo.mutation.SetTwoFactorSecret(s)
+	return uuo
+}
+
+// SetNillableTwoFactorSecret sets the "two_factor_secret" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTwoFactorSecret(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetTwoFactorSecret(*s)
+	}
+	return uuo
+}
+
+// ClearTwoFactorSecret clears the value of the "two_factor_secret" field.
+func (uuo *UserUpdateOne) ClearTwoFactorSecret() *UserUpdateOne {
+	uuo.mutation.ClearTwoFactorSecret()
+	return uuo
+}
+
+// SetAvatar sets the "avatar" field.
+func (uuo *UserUpdateOne) SetAvatar(s string) *UserUpdateOne {
+	uuo.mutation.SetAvatar(s)
+	return uuo
+}
+
+// SetNillableAvatar sets the "avatar" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAvatar(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetAvatar(*s)
+	}
+	return uuo
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (uuo *UserUpdateOne) ClearAvatar() *UserUpdateOne {
+	uuo.mutation.ClearAvatar()
+	return uuo
+}
+
+// SetSettings sets the "settings" field.
+func (uuo *UserUpdateOne) SetSettings(ts *types.UserSetting) *UserUpdateOne {
+	uuo.mutation.SetSettings(ts)
+	return uuo
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (uuo *UserUpdateOne) ClearSettings() *UserUpdateOne {
+	uuo.mutation.ClearSettings()
+	return uuo
+}
+
+// SetGroupUsers sets the "group_users" field.
+func (uuo *UserUpdateOne) SetGroupUsers(i int) *UserUpdateOne {
+	uuo.mutation.SetGroupUsers(i)
+	return uuo
+}
+
+// SetNillableGroupUsers sets the "group_users" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableGroupUsers(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetGroupUsers(*i)
+	}
+	return uuo
+}
+
+// SetStoragePolicyOverride sets the "storage_policy_override" field.
+func (uuo *UserUpdateOne) SetStoragePolicyOverride(i int) *UserUpdateOne {
+	uuo.mutation.ResetStoragePolicyOverride()
+	uuo.mutation.SetStoragePolicyOverride(i)
+	return uuo
+}
+
+// SetNillableStoragePolicyOverride sets the "storage_policy_override" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableStoragePolicyOverride(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetStoragePolicyOverride(*i)
+	}
+	return uuo
+}
+
+// AddStoragePolicyOverride adds i to the "storage_policy_override" field.
+func (uuo *UserUpdateOne) AddStoragePolicyOverride(i int) *UserUpdateOne {
+	uuo.mutation.AddStoragePolicyOverride(i)
+	return uuo
+}
+
+// ClearStoragePolicyOverride clears the value of the "storage_policy_override" field.
+func (uuo *UserUpdateOne) ClearStoragePolicyOverride() *UserUpdateOne {
+	uuo.mutation.ClearStoragePolicyOverride()
+	return uuo
+}
+
+// SetMaxStorageOverride sets the "max_storage_override" field.
+func (uuo *UserUpdateOne) SetMaxStorageOverride(i int64) *UserUpdateOne {
+	uuo.mutation.ResetMaxStorageOverride()
+	uuo.mutation.SetMaxStorageOverride(i)
+	return uuo
+}
+
+// SetNillableMaxStorageOverride sets the "max_storage_override" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableMaxStorageOverride(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetMaxStorageOverride(*i)
+	}
+	return uuo
+}
+
+// AddMaxStorageOverride adds i to the "max_storage_override" field.
+func (uuo *UserUpdateOne) AddMaxStorageOverride(i int64) *UserUpdateOne {
+	uuo.mutation.AddMaxStorageOverride(i)
+	return uuo
+}
+
+// ClearMaxStorageOverride clears the value of the "max_storage_override" field.
+func (uuo *UserUpdateOne) ClearMaxStorageOverride() *UserUpdateOne {
+	uuo.mutation.ClearMaxStorageOverride()
+	return uuo
+}
+
+// SetGroupExpiresAt sets the "group_expires_at" field.
+func (uuo *UserUpdateOne) SetGroupExpiresAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetGroupExpiresAt(t)
+	return uuo
+}
+
+// SetNillableGroupExpiresAt sets the "group_expires_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableGroupExpiresAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetGroupExpiresAt(*t)
+	}
+	return uuo
+}
+
+// ClearGroupExpiresAt clears the value of the "group_expires_at" field.
+func (uuo *UserUpdateOne) ClearGroupExpiresAt() *UserUpdateOne {
+	uuo.mutation.ClearGroupExpiresAt()
+	return uuo
+}
+
+// SetEmailChangeAt sets the "email_change_at" field.
+func (uuo *UserUpdateOne) SetEmailChangeAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetEmailChangeAt(t)
+	return uuo
+}
+
+// SetNillableEmailChangeAt sets the "email_change_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmailChangeAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetEmailChangeAt(*t)
+	}
+	return uuo
+}
+
+// ClearEmailChangeAt clears the value of the "email_change_at" field.
+func (uuo *UserUpdateOne) ClearEmailChangeAt() *UserUpdateOne {
+	uuo.mutation.ClearEmailChangeAt()
+	return uuo
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (uuo *UserUpdateOne) SetGroupID(id int) *UserUpdateOne {
+	uuo.mutation.SetGroupID(id)
+	return uuo
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (uuo *UserUpdateOne) SetGroup(g *Group) *UserUpdateOne {
+	return uuo.SetGroupID(g.ID)
+}
+
+// AddFileIDs adds the "files" edge to the File entity by IDs.
+func (uuo *UserUpdateOne) AddFileIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddFileIDs(ids...)
+	return uuo
+}
+
+// AddFiles adds the "files" edges to the File entity.
+func (uuo *UserUpdateOne) AddFiles(f ...*File) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.AddFileIDs(ids...)
+}
+
+// AddDavAccountIDs adds the "dav_accounts" edge to the DavAccount entity by IDs.
+func (uuo *UserUpdateOne) AddDavAccountIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddDavAccountIDs(ids...)
+	return uuo
+}
+
+// AddDavAccounts adds the "dav_accounts" edges to the DavAccount entity.
+func (uuo *UserUpdateOne) AddDavAccounts(d ...*DavAccount) *UserUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.AddDavAccountIDs(ids...)
+}
+
+// AddShareIDs adds the "shares" edge to the Share entity by IDs.
+func (uuo *UserUpdateOne) AddShareIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddShareIDs(ids...)
+	return uuo
+}
+
+// AddShares adds the "shares" edges to the Share entity.
+func (uuo *UserUpdateOne) AddShares(s ...*Share) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddShareIDs(ids...)
+}
+
+// AddPasskeyIDs adds the "passkey" edge to the Passkey entity by IDs.
+func (uuo *UserUpdateOne) AddPasskeyIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddPasskeyIDs(ids...)
+	return uuo
+}
+
+// AddPasskey adds the "passkey" edges to the Passkey entity.
+func (uuo *UserUpdateOne) AddPasskey(p ...*Passkey) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddPasskeyIDs(ids...)
+}
+
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (uuo *UserUpdateOne) AddTaskIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddTaskIDs(ids...)
+	return uuo
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (uuo *UserUpdateOne) AddTasks(t ...*Task) *UserUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.AddTaskIDs(ids...)
+}
+
+// AddEntityIDs adds the "entities" edge to the Entity entity by IDs.
+func (uuo *UserUpdateOne) AddEntityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddEntityIDs(ids...)
+	return uuo
+}
+
+// AddEntities adds the "entities" edges to the Entity entity.
+func (uuo *UserUpdateOne) AddEntities(e ...*Entity) *UserUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uuo.AddEntityIDs(ids...)
+}
+
+// AddViewPreferenceIDs adds the "view_preferences" edge to the ViewPreference entity by IDs.
+func (uuo *UserUpdateOne) AddViewPreferenceIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddViewPreferenceIDs(ids...)
+	return uuo
+}
+
+// AddViewPreferences adds the "view_preferences" edges to the ViewPreference entity.
+func (uuo *UserUpdateOne) AddViewPreferences(v ...*ViewPreference) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return uuo.AddViewPreferenceIDs(ids...)
+}
+
+// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by IDs.
+func (uuo *UserUpdateOne) AddAccessTokenIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddAccessTokenIDs(ids...)
+	return uuo
+}
+
+// AddAccessTokens adds the "access_tokens" edges to the AccessToken entity.
+func (uuo *UserUpdateOne) AddAccessTokens(a ...*AccessToken) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddAccessTokenIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (uuo *UserUpdateOne) AddInvitationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddInvitationIDs(ids...)
+	return uuo
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (uuo *UserUpdateOne) AddInvitations(i ...*Invitation) *UserUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.AddInvitationIDs(ids...)
+}
+
+// AddEmailIDs adds the "emails" edge to the UserEmail entity by IDs.
+func (uuo *UserUpdateOne) AddEmailIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddEmailIDs(ids...)
+	return uuo
+}
+
+// AddEmails adds the "emails" edges to the UserEmail entity.
+func (uuo *UserUpdateOne) AddEmails(u ...*UserEmail) *UserUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.AddEmailIDs(ids...)
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
+func (uuo *UserUpdateOne) AddNotificationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddNotificationIDs(ids...)
+	return uuo
+}
+
+// AddNotifications adds the "notifications" edges to the Notification entity.
+func (uuo *UserUpdateOne) AddNotifications(n ...*Notification) *UserUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.AddNotificationIDs(ids...)
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (uuo *UserUpdateOne) AddSavedSearchIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddSavedSearchIDs(ids...)
+	return uuo
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (uuo *UserUpdateOne) AddSavedSearches(s ...*SavedSearch) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSavedSearchIDs(ids...)
+}
+
+// AddRssSubscriptionIDs adds the "rss_subscriptions" edge to the RssSubscription entity by IDs.
+func (uuo *UserUpdateOne) AddRssSubscriptionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddRssSubscriptionIDs(ids...)
+	return uuo
+}
+
+// AddRssSubscriptions adds the "rss_subscriptions" edges to the RssSubscription entity.
+func (uuo *UserUpdateOne) AddRssSubscriptions(r ...*RssSubscription) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddRssSubscriptionIDs(ids...)
+}
+
+// AddAutomationRuleIDs adds the "automation_rules" edge to the AutomationRule entity by IDs.
+func (uuo *UserUpdateOne) AddAutomationRuleIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddAutomationRuleIDs(ids...)
+	return uuo
+}
+
+// AddAutomationRules adds the "automation_rules" edges to the AutomationRule entity.
+func (uuo *UserUpdateOne) AddAutomationRules(a ...*AutomationRule) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddAutomationRuleIDs(ids...)
+}
+
+// AddSyncJobIDs adds the "sync_jobs" edge to the SyncJob entity by IDs.
+func (uuo *UserUpdateOne) AddSyncJobIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddSyncJobIDs(ids...)
+	return uuo
+}
+
+// AddSyncJobs adds the "sync_jobs" edges to the SyncJob entity.
+func (uuo *UserUpdateOne) AddSyncJobs(s ...*SyncJob) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddSyncJobIDs(ids...)
+}
+
+// AddRetentionRuleIDs adds the "retention_rules" edge to the RetentionRule entity by IDs.
+func (uuo *UserUpdateOne) AddRetentionRuleIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddRetentionRuleIDs(ids...)
+	return uuo
+}
+
+// AddRetentionRules adds the "retention_rules" edges to the RetentionRule entity.
+func (uuo *UserUpdateOne) AddRetentionRules(r ...*RetentionRule) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.AddRetentionRuleIDs(ids...)
+}
+
+// AddModerationCaseIDs adds the "moderation_cases" edge to the ModerationCase entity by IDs.
+func (uuo *UserUpdateOne) AddModerationCaseIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddModerationCaseIDs(ids...)
+	return uuo
+}
+
+// AddModerationCases adds the "moderation_cases" edges to the ModerationCase entity.
+func (uuo *UserUpdateOne) AddModerationCases(m ...*ModerationCase) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.AddModerationCaseIDs(ids...)
+}
+
+// AddS3AccessKeyIDs adds the "s3_access_keys" edge to the S3AccessKey entity by IDs.
+func (uuo *UserUpdateOne) AddS3AccessKeyIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddS3AccessKeyIDs(ids...)
+	return uuo
+}
+
+// AddS3AccessKeys adds the "s3_access_keys" edges to the S3AccessKey entity.
+func (uuo *UserUpdateOne) AddS3AccessKeys(s ...*S3AccessKey) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddS3AccessKeyIDs(ids...)
+}
+
+// AddCheckoutIDs adds the "checkouts" edge to the Checkout entity by IDs.
+func (uuo *UserUpdateOne) AddCheckoutIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddCheckoutIDs(ids...)
+	return uuo
+}
+
+// AddCheckouts adds the "checkouts" edges to the Checkout entity.
+func (uuo *UserUpdateOne) AddCheckouts(c ...*Checkout) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.AddCheckoutIDs(ids...)
+}
+
+// AddDismissedAnnouncementIDs adds the "dismissed_announcements" edge to the Announcement entity by IDs.
+func (uuo *UserUpdateOne) AddDismissedAnnouncementIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddDismissedAnnouncementIDs(ids...)
+	return uuo
+}
+
+// AddDismissedAnnouncements adds the "dismissed_announcements" edges to the Announcement entity.
+func (uuo *UserUpdateOne) AddDismissedAnnouncements(a ...*Announcement) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddDismissedAnnouncementIDs(ids...)
+}
+
+// AddAdminOrganizationIDs adds the "admin_organizations" edge to the Organization entity by IDs.
+func (uuo *UserUpdateOne) AddAdminOrganizationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddAdminOrganizationIDs(ids...)
+	return uuo
+}
+
+// AddAdminOrganizations adds the "admin_organizations" edges to the Organization entity.
+func (uuo *UserUpdateOne) AddAdminOrganizations(o ...*Organization) *UserUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uuo.AddAdminOrganizationIDs(ids...)
+}
+
+// SetInvitationID sets the "invitation" edge to the Invitation entity by ID.
+func (uuo *UserUpdateOne) SetInvitationID(id int) *UserUpdateOne {
+	uuo.mutation.SetInvitationID(id)
+	return uuo
+}
+
+// SetNillableInvitationID sets the "invitation" edge to the Invitation entity by ID if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableInvitationID(id *int) *UserUpdateOne {
+	if id != nil {
+		uuo = uuo.SetInvitationID(*id)
+	}
+	return uuo
+}
+
+// SetInvitation sets the "invitation" edge to the Invitation entity.
+func (uuo *UserUpdateOne) SetInvitation(i *Invitation) *UserUpdateOne {
+	return uuo.SetInvitationID(i.ID)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return uuo.mutation
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (uuo *UserUpdateOne) ClearGroup() *UserUpdateOne {
+	uuo.mutation.ClearGroup()
+	return uuo
+}
+
+// ClearFiles clears all "files" edges to the File entity.
+func (uuo *UserUpdateOne) ClearFiles() *UserUpdateOne {
+	uuo.mutation.ClearFiles()
+	return uuo
+}
+
+// RemoveFileIDs removes the "files" edge to File entities by IDs.
+func (uuo *UserUpdateOne) RemoveFileIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveFileIDs(ids...)
+	return uuo
+}
+
+// RemoveFiles removes "files" edges to File entities.
+func (uuo *UserUpdateOne) RemoveFiles(f ...*File) *UserUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveFileIDs(ids...)
+}
+
+// ClearDavAccounts clears all "dav_accounts" edges to the DavAccount entity.
+func (uuo *UserUpdateOne) ClearDavAccounts() *UserUpdateOne {
+	uuo.mutation.ClearDavAccounts()
+	return uuo
+}
+
+// RemoveDavAccountIDs removes the "dav_accounts" edge to DavAccount entities by IDs.
+func (uuo *UserUpdateOne) RemoveDavAccountIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveDavAccountIDs(ids...)
+	return uuo
+}
+
+// RemoveDavAccounts removes "dav_accounts" edges to DavAccount entities.
+func (uuo *UserUpdateOne) RemoveDavAccounts(d ...*DavAccount) *UserUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return uuo.RemoveDavAccountIDs(ids...)
+}
+
+// ClearShares clears all "shares" edges to the Share entity.
+func (uuo *UserUpdateOne) ClearShares() *UserUpdateOne {
+	uuo.mutation.ClearShares()
+	return uuo
+}
+
+// RemoveShareIDs removes the "shares" edge to Share entities by IDs.
+func (uuo *UserUpdateOne) RemoveShareIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveShareIDs(ids...)
+	return uuo
+}
+
+// RemoveShares removes "shares" edges to Share entities.
+func (uuo *UserUpdateOne) RemoveShares(s ...*Share) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveShareIDs(ids...)
+}
+
+// ClearPasskey clears all "passkey" edges to the Passkey entity.
+func (uuo *UserUpdateOne) ClearPasskey() *UserUpdateOne {
+	uuo.mutation.ClearPasskey()
+	return uuo
+}
+
+// RemovePasskeyIDs removes the "passkey" edge to Passkey entities by IDs.
+func (uuo *UserUpdateOne) RemovePasskeyIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemovePasskeyIDs(ids...)
+	return uuo
+}
+
+// RemovePasskey removes "passkey" edges to Passkey entities.
+func (uuo *UserUpdateOne) RemovePasskey(p ...*Passkey) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemovePasskeyIDs(ids...)
+}
+
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (uuo *UserUpdateOne) ClearTasks() *UserUpdateOne {
+	uuo.mutation.ClearTasks()
+	return uuo
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (uuo *UserUpdateOne) RemoveTaskIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveTaskIDs(ids...)
+	return uuo
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (uuo *UserUpdateOne) RemoveTasks(t ...*Task) *UserUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return uuo.RemoveTaskIDs(ids...)
+}
+
+// ClearEntities clears all "entities" edges to the Entity entity.
+func (uuo *UserUpdateOne) ClearEntities() *UserUpdateOne {
+	uuo.mutation.ClearEntities()
+	return uuo
+}
+
+// RemoveEntityIDs removes the "entities" edge to Entity entities by IDs.
+func (uuo *UserUpdateOne) RemoveEntityIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveEntityIDs(ids...)
+	return uuo
+}
+
+// RemoveEntities removes "entities" edges to Entity entities.
+func (uuo *UserUpdateOne) RemoveEntities(e ...*Entity) *UserUpdateOne {
+	ids := make([]int, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return uuo.RemoveEntityIDs(ids...)
+}
+
+// ClearViewPreferences clears all "view_preferences" edges to the ViewPreference entity.
+func (uuo *UserUpdateOne) ClearViewPreferences() *UserUpdateOne {
+	uuo.mutation.ClearViewPreferences()
+	return uuo
+}
+
+// RemoveViewPreferenceIDs removes the "view_preferences" edge to ViewPreference entities by IDs.
+func (uuo *UserUpdateOne) RemoveViewPreferenceIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveViewPreferenceIDs(ids...)
+	return uuo
+}
+
+// RemoveViewPreferences removes "view_preferences" edges to ViewPreference entities.
+func (uuo *UserUpdateOne) RemoveViewPreferences(v ...*ViewPreference) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return uuo.RemoveViewPreferenceIDs(ids...)
+}
+
+// ClearAccessTokens clears all "access_tokens" edges to the AccessToken entity.
+func (uuo *UserUpdateOne) ClearAccessTokens() *UserUpdateOne {
+	uuo.mutation.ClearAccessTokens()
+	return uuo
+}
+
+// RemoveAccessTokenIDs removes the "access_tokens" edge to AccessToken entities by IDs.
+func (uuo *UserUpdateOne) RemoveAccessTokenIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveAccessTokenIDs(ids...)
+	return uuo
+}
+
+// RemoveAccessTokens removes "access_tokens" edges to AccessToken entities.
+func (uuo *UserUpdateOne) RemoveAccessTokens(a ...*AccessToken) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveAccessTokenIDs(ids...)
+}
+
+// ClearInvitations clears all "invitations" edges to the Invitation entity.
+func (uuo *UserUpdateOne) ClearInvitations() *UserUpdateOne {
+	uuo.mutation.ClearInvitations()
+	return uuo
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to Invitation entities by IDs.
+func (uuo *UserUpdateOne) RemoveInvitationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveInvitationIDs(ids...)
+	return uuo
+}
+
+// RemoveInvitations removes "invitations" edges to Invitation entities.
+func (uuo *UserUpdateOne) RemoveInvitations(i ...*Invitation) *UserUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uuo.RemoveInvitationIDs(ids...)
+}
+
+// ClearEmails clears all "emails" edges to the UserEmail entity.
+func (uuo *UserUpdateOne) ClearEmails() *UserUpdateOne {
+	uuo.mutation.ClearEmails()
+	return uuo
+}
+
+// RemoveEmailIDs removes the "emails" edge to UserEmail entities by IDs.
+func (uuo *UserUpdateOne) RemoveEmailIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveEmailIDs(ids...)
+	return uuo
+}
+
+// RemoveEmails removes "emails" edges to UserEmail entities.
+func (uuo *UserUpdateOne) RemoveEmails(u ...*UserEmail) *UserUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uuo.RemoveEmailIDs(ids...)
+}
+
+// ClearNotifications clears all "notifications" edges to the Notification entity.
+func (uuo *UserUpdateOne) ClearNotifications() *UserUpdateOne {
+	uuo.mutation.ClearNotifications()
+	return uuo
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to Notification entities by IDs.
+func (uuo *UserUpdateOne) RemoveNotificationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveNotificationIDs(ids...)
+	return uuo
+}
+
+// RemoveNotifications removes "notifications" edges to Notification entities.
+func (uuo *UserUpdateOne) RemoveNotifications(n ...*Notification) *UserUpdateOne {
+	ids := make([]int, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return uuo.RemoveNotificationIDs(ids...)
+}
+
+// ClearSavedSearches clears all "saved_searches" edges to the SavedSearch entity.
+func (uuo *UserUpdateOne) ClearSavedSearches() *UserUpdateOne {
+	uuo.mutation.ClearSavedSearches()
+	return uuo
+}
+
+// RemoveSavedSearchIDs removes the "saved_searches" edge to SavedSearch entities by IDs.
+func (uuo *UserUpdateOne) RemoveSavedSearchIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveSavedSearchIDs(ids...)
+	return uuo
+}
+
+// RemoveSavedSearches removes "saved_searches" edges to SavedSearch entities.
+func (uuo *UserUpdateOne) RemoveSavedSearches(s ...*SavedSearch) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveSavedSearchIDs(ids...)
+}
+
+// ClearRssSubscriptions clears all "rss_subscriptions" edges to the RssSubscription entity.
+func (uuo *UserUpdateOne) ClearRssSubscriptions() *UserUpdateOne {
+	uuo.mutation.ClearRssSubscriptions()
+	return uuo
 }
 
-// RemoveEntityIDs removes the "entities" edge to Entity entities by IDs.
-func (uuo *UserUpdateOne) RemoveEntityIDs(ids ...int) *UserUpdateOne {
-	uuo.mutation.RemoveEntityIDs(ids...)
+// RemoveRssSubscriptionIDs removes the "rss_subscriptions" edge to RssSubscription entities by IDs.
+func (uuo *UserUpdateOne) RemoveRssSubscriptionIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveRssSubscriptionIDs(ids...)
 	return uuo
 }
 
-// RemoveEntities removes "entities" edges to Entity entities.
-func (uuo *UserUpdateOne) RemoveEntities(e ...*Entity) *UserUpdateOne {
-	ids := make([]int, len(e))
-	for i := range e {
-		ids[i] = e[i].ID
+// RemoveRssSubscriptions removes "rss_subscriptions" edges to RssSubscription entities.
+func (uuo *UserUpdateOne) RemoveRssSubscriptions(r ...*RssSubscription) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveRssSubscriptionIDs(ids...)
+}
+
+// ClearAutomationRules clears all "automation_rules" edges to the AutomationRule entity.
+func (uuo *UserUpdateOne) ClearAutomationRules() *UserUpdateOne {
+	uuo.mutation.ClearAutomationRules()
+	return uuo
+}
+
+// RemoveAutomationRuleIDs removes the "automation_rules" edge to AutomationRule entities by IDs.
+func (uuo *UserUpdateOne) RemoveAutomationRuleIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveAutomationRuleIDs(ids...)
+	return uuo
+}
+
+// RemoveAutomationRules removes "automation_rules" edges to AutomationRule entities.
+func (uuo *UserUpdateOne) RemoveAutomationRules(a ...*AutomationRule) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveAutomationRuleIDs(ids...)
+}
+
+// ClearSyncJobs clears all "sync_jobs" edges to the SyncJob entity.
+func (uuo *UserUpdateOne) ClearSyncJobs() *UserUpdateOne {
+	uuo.mutation.ClearSyncJobs()
+	return uuo
+}
+
+// RemoveSyncJobIDs removes the "sync_jobs" edge to SyncJob entities by IDs.
+func (uuo *UserUpdateOne) RemoveSyncJobIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveSyncJobIDs(ids...)
+	return uuo
+}
+
+// RemoveSyncJobs removes "sync_jobs" edges to SyncJob entities.
+func (uuo *UserUpdateOne) RemoveSyncJobs(s ...*SyncJob) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveSyncJobIDs(ids...)
+}
+
+// ClearRetentionRules clears all "retention_rules" edges to the RetentionRule entity.
+func (uuo *UserUpdateOne) ClearRetentionRules() *UserUpdateOne {
+	uuo.mutation.ClearRetentionRules()
+	return uuo
+}
+
+// RemoveRetentionRuleIDs removes the "retention_rules" edge to RetentionRule entities by IDs.
+func (uuo *UserUpdateOne) RemoveRetentionRuleIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveRetentionRuleIDs(ids...)
+	return uuo
+}
+
+// RemoveRetentionRules removes "retention_rules" edges to RetentionRule entities.
+func (uuo *UserUpdateOne) RemoveRetentionRules(r ...*RetentionRule) *UserUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uuo.RemoveRetentionRuleIDs(ids...)
+}
+
+// ClearModerationCases clears all "moderation_cases" edges to the ModerationCase entity.
+func (uuo *UserUpdateOne) ClearModerationCases() *UserUpdateOne {
+	uuo.mutation.ClearModerationCases()
+	return uuo
+}
+
+// RemoveModerationCaseIDs removes the "moderation_cases" edge to ModerationCase entities by IDs.
+func (uuo *UserUpdateOne) RemoveModerationCaseIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveModerationCaseIDs(ids...)
+	return uuo
+}
+
+// RemoveModerationCases removes "moderation_cases" edges to ModerationCase entities.
+func (uuo *UserUpdateOne) RemoveModerationCases(m ...*ModerationCase) *UserUpdateOne {
+	ids := make([]int, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return uuo.RemoveModerationCaseIDs(ids...)
+}
+
+// ClearS3AccessKeys clears all "s3_access_keys" edges to the S3AccessKey entity.
+func (uuo *UserUpdateOne) ClearS3AccessKeys() *UserUpdateOne {
+	uuo.mutation.ClearS3AccessKeys()
+	return uuo
+}
+
+// RemoveS3AccessKeyIDs removes the "s3_access_keys" edge to S3AccessKey entities by IDs.
+func (uuo *UserUpdateOne) RemoveS3AccessKeyIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveS3AccessKeyIDs(ids...)
+	return uuo
+}
+
+// RemoveS3AccessKeys removes "s3_access_keys" edges to S3AccessKey entities.
+func (uuo *UserUpdateOne) RemoveS3AccessKeys(s ...*S3AccessKey) *UserUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveS3AccessKeyIDs(ids...)
+}
+
+// ClearCheckouts clears all "checkouts" edges to the Checkout entity.
+func (uuo *UserUpdateOne) ClearCheckouts() *UserUpdateOne {
+	uuo.mutation.ClearCheckouts()
+	return uuo
+}
+
+// RemoveCheckoutIDs removes the "checkouts" edge to Checkout entities by IDs.
+func (uuo *UserUpdateOne) RemoveCheckoutIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveCheckoutIDs(ids...)
+	return uuo
+}
+
+// RemoveCheckouts removes "checkouts" edges to Checkout entities.
+func (uuo *UserUpdateOne) RemoveCheckouts(c ...*Checkout) *UserUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uuo.RemoveCheckoutIDs(ids...)
+}
+
+// ClearDismissedAnnouncements clears all "dismissed_announcements" edges to the Announcement entity.
+func (uuo *UserUpdateOne) ClearDismissedAnnouncements() *UserUpdateOne {
+	uuo.mutation.ClearDismissedAnnouncements()
+	return uuo
+}
+
+// RemoveDismissedAnnouncementIDs removes the "dismissed_announcements" edge to Announcement entities by IDs.
+func (uuo *UserUpdateOne) RemoveDismissedAnnouncementIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveDismissedAnnouncementIDs(ids...)
+	return uuo
+}
+
+// RemoveDismissedAnnouncements removes "dismissed_announcements" edges to Announcement entities.
+func (uuo *UserUpdateOne) RemoveDismissedAnnouncements(a ...*Announcement) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveDismissedAnnouncementIDs(ids...)
+}
+
+// ClearAdminOrganizations clears all "admin_organizations" edges to the Organization entity.
+func (uuo *UserUpdateOne) ClearAdminOrganizations() *UserUpdateOne {
+	uuo.mutation.ClearAdminOrganizations()
+	return uuo
+}
+
+// RemoveAdminOrganizationIDs removes the "admin_organizations" edge to Organization entities by IDs.
+func (uuo *UserUpdateOne) RemoveAdminOrganizationIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveAdminOrganizationIDs(ids...)
+	return uuo
+}
+
+// RemoveAdminOrganizations removes "admin_organizations" edges to Organization entities.
+func (uuo *UserUpdateOne) RemoveAdminOrganizations(o ...*Organization) *UserUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return uuo.RemoveAdminOrganizationIDs(ids...)
+}
+
+// ClearInvitation clears the "invitation" edge to the Invitation entity.
+func (uuo *UserUpdateOne) ClearInvitation() *UserUpdateOne {
+	uuo.mutation.ClearInvitation()
+	return uuo
+}
+
+// Where appends a list predicates to the UserUpdate builder.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.mutation.Where(ps...)
+	return uuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
+	uuo.fields = append([]string{field}, fields...)
+	return uuo
+}
+
+// Save executes the query and returns the updated User entity.
+func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	if err := uuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
+	node, err := uuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
+	_, err := uuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
+	if err := uuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uuo *UserUpdateOne) defaults() error {
+	if _, ok := uuo.mutation.UpdatedAt(); !ok {
+		if user.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized user.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := user.UpdateDefaultUpdatedAt()
+		uuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if v, ok := uuo.mutation.Email(); ok {
+		if err := user.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Nick(); ok {
+		if err := user.NickValidator(v); err != nil {
+			return &ValidationError{Name: "nick", err: fmt.Errorf(`ent: validator failed for field "User.nick": %w`, err)}
+		}
+	}
+	if v, ok := uuo.mutation.Status(); ok {
+		if err := user.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "User.status": %w`, err)}
+		}
+	}
+	if _, ok := uuo.mutation.GroupID(); uuo.mutation.GroupCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "User.group"`)
+	}
+	return nil
+}
+
+func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := uuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
+	id, ok := uuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for _, f := range fields {
+			if !user.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uuo.mutation.DeletedAt(); ok {
+		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Nick(); ok {
+		_spec.SetField(user.FieldNick, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Password(); ok {
+		_spec.SetField(user.FieldPassword, field.TypeString, value)
+	}
+	if uuo.mutation.PasswordCleared() {
+		_spec.ClearField(user.FieldPassword, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Status(); ok {
+		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := uuo.mutation.Storage(); ok {
+		_spec.SetField(user.FieldStorage, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedStorage(); ok {
+		_spec.AddField(user.FieldStorage, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.TwoFactorSecret(); ok {
+		_spec.SetField(user.FieldTwoFactorSecret, field.TypeString, value)
+	}
+	if uuo.mutation.TwoFactorSecretCleared() {
+		_spec.ClearField(user.FieldTwoFactorSecret, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Avatar(); ok {
+		_spec.SetField(user.FieldAvatar, field.TypeString, value)
+	}
+	if uuo.mutation.AvatarCleared() {
+		_spec.ClearField(user.FieldAvatar, field.TypeString)
+	}
+	if value, ok := uuo.mutation.Settings(); ok {
+		_spec.SetField(user.FieldSettings, field.TypeJSON, value)
+	}
+	if uuo.mutation.SettingsCleared() {
+		_spec.ClearField(user.FieldSettings, field.TypeJSON)
+	}
+	if value, ok := uuo.mutation.StoragePolicyOverride(); ok {
+		_spec.SetField(user.FieldStoragePolicyOverride, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedStoragePolicyOverride(); ok {
+		_spec.AddField(user.FieldStoragePolicyOverride, field.TypeInt, value)
+	}
+	if uuo.mutation.StoragePolicyOverrideCleared() {
+		_spec.ClearField(user.FieldStoragePolicyOverride, field.TypeInt)
+	}
+	if value, ok := uuo.mutation.MaxStorageOverride(); ok {
+		_spec.SetField(user.FieldMaxStorageOverride, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedMaxStorageOverride(); ok {
+		_spec.AddField(user.FieldMaxStorageOverride, field.TypeInt64, value)
+	}
+	if uuo.mutation.MaxStorageOverrideCleared() {
+		_spec.ClearField(user.FieldMaxStorageOverride, field.TypeInt64)
+	}
+	if value, ok := uuo.mutation.GroupExpiresAt(); ok {
+		_spec.SetField(user.FieldGroupExpiresAt, field.TypeTime, value)
+	}
+	if uuo.mutation.GroupExpiresAtCleared() {
+		_spec.ClearField(user.FieldGroupExpiresAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.EmailChangeAt(); ok {
+		_spec.SetField(user.FieldEmailChangeAt, field.TypeTime, value)
+	}
+	if uuo.mutation.EmailChangeAtCleared() {
+		_spec.ClearField(user.FieldEmailChangeAt, field.TypeTime)
 	}
-	return uuo.RemoveEntityIDs(ids...)
-}
-
-// Where appends a list predicates to the UserUpdate builder.
-func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
-	uuo.mutation.Where(ps...)
-	return uuo
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
-	uuo.fields = append([]string{field}, fields...)
-	return uuo
-}
-
-// Save executes the query and returns the updated User entity.
-func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	if err := uuo.defaults(); err != nil {
-		return nil, err
+	if uuo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.GroupTable,
+			Columns: []string{user.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
-	node, err := uuo.Save(ctx)
-	if err != nil {
-		panic(err)
+	if nodes := uuo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.GroupTable,
+			Columns: []string{user.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := uuo.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := uuo.Exec(ctx); err != nil {
-		panic(err)
+	if uuo.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FilesTable,
+			Columns: []string{user.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// defaults sets the default values of the builder before save.
-func (uuo *UserUpdateOne) defaults() error {
-	if _, ok := uuo.mutation.UpdatedAt(); !ok {
-		if user.UpdateDefaultUpdatedAt == nil {
-			return fmt.Errorf("ent: uninitialized user.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+	if nodes := uuo.mutation.RemovedFilesIDs(); len(nodes) > 0 && !uuo.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FilesTable,
+			Columns: []string{user.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FilesTable,
+			Columns: []string{user.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.DavAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DavAccountsTable,
+			Columns: []string{user.DavAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(davaccount.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedDavAccountsIDs(); len(nodes) > 0 && !uuo.mutation.DavAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DavAccountsTable,
+			Columns: []string{user.DavAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(davaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.DavAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DavAccountsTable,
+			Columns: []string{user.DavAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(davaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SharesTable,
+			Columns: []string{user.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedSharesIDs(); len(nodes) > 0 && !uuo.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SharesTable,
+			Columns: []string{user.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SharesTable,
+			Columns: []string{user.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.PasskeyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasskeyTable,
+			Columns: []string{user.PasskeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passkey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedPasskeyIDs(); len(nodes) > 0 && !uuo.mutation.PasskeyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasskeyTable,
+			Columns: []string{user.PasskeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.PasskeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasskeyTable,
+			Columns: []string{user.PasskeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TasksTable,
+			Columns: []string{user.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
 		}
-		v := user.UpdateDefaultUpdatedAt()
-		uuo.mutation.SetUpdatedAt(v)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return nil
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (uuo *UserUpdateOne) check() error {
-	if v, ok := uuo.mutation.Email(); ok {
-		if err := user.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+	if nodes := uuo.mutation.RemovedTasksIDs(); len(nodes) > 0 && !uuo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TasksTable,
+			Columns: []string{user.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := uuo.mutation.Nick(); ok {
-		if err := user.NickValidator(v); err != nil {
-			return &ValidationError{Name: "nick", err: fmt.Errorf(`ent: validator failed for field "User.nick": %w`, err)}
+	if nodes := uuo.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TasksTable,
+			Columns: []string{user.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := uuo.mutation.Status(); ok {
-		if err := user.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "User.status": %w`, err)}
+	if uuo.mutation.EntitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EntitiesTable,
+			Columns: []string{user.EntitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entity.FieldID, field.TypeInt),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _, ok := uuo.mutation.GroupID(); uuo.mutation.GroupCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "User.group"`)
+	if nodes := uuo.mutation.RemovedEntitiesIDs(); len(nodes) > 0 && !uuo.mutation.EntitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EntitiesTable,
+			Columns: []string{user.EntitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return nil
-}
-
-func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
-	if err := uuo.check(); err != nil {
-		return _node, err
+	if nodes := uuo.mutation.EntitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EntitiesTable,
+			Columns: []string{user.EntitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
-	id, ok := uuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	if uuo.mutation.ViewPreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ViewPreferencesTable,
+			Columns: []string{user.ViewPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewpreference.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := uuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
-		for _, f := range fields {
-			if !user.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != user.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if nodes := uuo.mutation.RemovedViewPreferencesIDs(); len(nodes) > 0 && !uuo.mutation.ViewPreferencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ViewPreferencesTable,
+			Columns: []string{user.ViewPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewpreference.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := uuo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := uuo.mutation.ViewPreferencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ViewPreferencesTable,
+			Columns: []string{user.ViewPreferencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(viewpreference.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.UpdatedAt(); ok {
-		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	if uuo.mutation.AccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccessTokensTable,
+			Columns: []string{user.AccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.DeletedAt(); ok {
-		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
+	if nodes := uuo.mutation.RemovedAccessTokensIDs(); len(nodes) > 0 && !uuo.mutation.AccessTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccessTokensTable,
+			Columns: []string{user.AccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.DeletedAtCleared() {
-		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
+	if nodes := uuo.mutation.AccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccessTokensTable,
+			Columns: []string{user.AccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.Email(); ok {
-		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	if uuo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Nick(); ok {
-		_spec.SetField(user.FieldNick, field.TypeString, value)
+	if nodes := uuo.mutation.RemovedInvitationsIDs(); len(nodes) > 0 && !uuo.mutation.InvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Password(); ok {
-		_spec.SetField(user.FieldPassword, field.TypeString, value)
+	if nodes := uuo.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.PasswordCleared() {
-		_spec.ClearField(user.FieldPassword, field.TypeString)
+	if uuo.mutation.EmailsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailsTable,
+			Columns: []string{user.EmailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useremail.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Status(); ok {
-		_spec.SetField(user.FieldStatus, field.TypeEnum, value)
+	if nodes := uuo.mutation.RemovedEmailsIDs(); len(nodes) > 0 && !uuo.mutation.EmailsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailsTable,
+			Columns: []string{user.EmailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useremail.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Storage(); ok {
-		_spec.SetField(user.FieldStorage, field.TypeInt64, value)
+	if nodes := uuo.mutation.EmailsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.EmailsTable,
+			Columns: []string{user.EmailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useremail.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.AddedStorage(); ok {
-		_spec.AddField(user.FieldStorage, field.TypeInt64, value)
+	if uuo.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.TwoFactorSecret(); ok {
-		_spec.SetField(user.FieldTwoFactorSecret, field.TypeString, value)
+	if nodes := uuo.mutation.RemovedNotificationsIDs(); len(nodes) > 0 && !uuo.mutation.NotificationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.TwoFactorSecretCleared() {
-		_spec.ClearField(user.FieldTwoFactorSecret, field.TypeString)
+	if nodes := uuo.mutation.NotificationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.NotificationsTable,
+			Columns: []string{user.NotificationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := uuo.mutation.Avatar(); ok {
-		_spec.SetField(user.FieldAvatar, field.TypeString, value)
+	if uuo.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.AvatarCleared() {
-		_spec.ClearField(user.FieldAvatar, field.TypeString)
+	if nodes := uuo.mutation.RemovedSavedSearchesIDs(); len(nodes) > 0 && !uuo.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := uuo.mutation.Settings(); ok {
-		_spec.SetField(user.FieldSettings, field.TypeJSON, value)
+	if nodes := uuo.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SavedSearchesTable,
+			Columns: []string{user.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.SettingsCleared() {
-		_spec.ClearField(user.FieldSettings, field.TypeJSON)
+	if uuo.mutation.RssSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RssSubscriptionsTable,
+			Columns: []string{user.RssSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rsssubscription.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if uuo.mutation.GroupCleared() {
+	if nodes := uuo.mutation.RemovedRssSubscriptionsIDs(); len(nodes) > 0 && !uuo.mutation.RssSubscriptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   user.GroupTable,
-			Columns: []string{user.GroupColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RssSubscriptionsTable,
+			Columns: []string{user.RssSubscriptionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(rsssubscription.FieldID, field.TypeInt),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.GroupIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.RssSubscriptionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   user.GroupTable,
-			Columns: []string{user.GroupColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RssSubscriptionsTable,
+			Columns: []string{user.RssSubscriptionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(rsssubscription.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1490,28 +4172,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.FilesCleared() {
+	if uuo.mutation.AutomationRulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.FilesTable,
-			Columns: []string{user.FilesColumn},
+			Table:   user.AutomationRulesTable,
+			Columns: []string{user.AutomationRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedFilesIDs(); len(nodes) > 0 && !uuo.mutation.FilesCleared() {
+	if nodes := uuo.mutation.RemovedAutomationRulesIDs(); len(nodes) > 0 && !uuo.mutation.AutomationRulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.FilesTable,
-			Columns: []string{user.FilesColumn},
+			Table:   user.AutomationRulesTable,
+			Columns: []string{user.AutomationRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1519,15 +4201,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.FilesIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.AutomationRulesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.FilesTable,
-			Columns: []string{user.FilesColumn},
+			Table:   user.AutomationRulesTable,
+			Columns: []string{user.AutomationRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(automationrule.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1535,28 +4217,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.DavAccountsCleared() {
+	if uuo.mutation.SyncJobsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DavAccountsTable,
-			Columns: []string{user.DavAccountsColumn},
+			Table:   user.SyncJobsTable,
+			Columns: []string{user.SyncJobsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(davaccount.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedDavAccountsIDs(); len(nodes) > 0 && !uuo.mutation.DavAccountsCleared() {
+	if nodes := uuo.mutation.RemovedSyncJobsIDs(); len(nodes) > 0 && !uuo.mutation.SyncJobsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DavAccountsTable,
-			Columns: []string{user.DavAccountsColumn},
+			Table:   user.SyncJobsTable,
+			Columns: []string{user.SyncJobsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(davaccount.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1564,15 +4246,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.DavAccountsIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.SyncJobsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.DavAccountsTable,
-			Columns: []string{user.DavAccountsColumn},
+			Table:   user.SyncJobsTable,
+			Columns: []string{user.SyncJobsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(davaccount.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(syncjob.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1580,28 +4262,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.SharesCleared() {
+	if uuo.mutation.RetentionRulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SharesTable,
-			Columns: []string{user.SharesColumn},
+			Table:   user.RetentionRulesTable,
+			Columns: []string{user.RetentionRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedSharesIDs(); len(nodes) > 0 && !uuo.mutation.SharesCleared() {
+	if nodes := uuo.mutation.RemovedRetentionRulesIDs(); len(nodes) > 0 && !uuo.mutation.RetentionRulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SharesTable,
-			Columns: []string{user.SharesColumn},
+			Table:   user.RetentionRulesTable,
+			Columns: []string{user.RetentionRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1609,15 +4291,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.SharesIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.RetentionRulesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.SharesTable,
-			Columns: []string{user.SharesColumn},
+			Table:   user.RetentionRulesTable,
+			Columns: []string{user.RetentionRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(share.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1625,28 +4307,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.PasskeyCleared() {
+	if uuo.mutation.ModerationCasesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PasskeyTable,
-			Columns: []string{user.PasskeyColumn},
+			Table:   user.ModerationCasesTable,
+			Columns: []string{user.ModerationCasesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(passkey.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedPasskeyIDs(); len(nodes) > 0 && !uuo.mutation.PasskeyCleared() {
+	if nodes := uuo.mutation.RemovedModerationCasesIDs(); len(nodes) > 0 && !uuo.mutation.ModerationCasesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PasskeyTable,
-			Columns: []string{user.PasskeyColumn},
+			Table:   user.ModerationCasesTable,
+			Columns: []string{user.ModerationCasesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(passkey.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1654,15 +4336,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.PasskeyIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.ModerationCasesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.PasskeyTable,
-			Columns: []string{user.PasskeyColumn},
+			Table:   user.ModerationCasesTable,
+			Columns: []string{user.ModerationCasesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(passkey.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(moderationcase.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1670,28 +4352,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.TasksCleared() {
+	if uuo.mutation.S3AccessKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TasksTable,
-			Columns: []string{user.TasksColumn},
+			Table:   user.S3AccessKeysTable,
+			Columns: []string{user.S3AccessKeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(s3accesskey.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedTasksIDs(); len(nodes) > 0 && !uuo.mutation.TasksCleared() {
+	if nodes := uuo.mutation.RemovedS3AccessKeysIDs(); len(nodes) > 0 && !uuo.mutation.S3AccessKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TasksTable,
-			Columns: []string{user.TasksColumn},
+			Table:   user.S3AccessKeysTable,
+			Columns: []string{user.S3AccessKeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(s3accesskey.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1699,15 +4381,15 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.TasksIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.S3AccessKeysIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.TasksTable,
-			Columns: []string{user.TasksColumn},
+			Table:   user.S3AccessKeysTable,
+			Columns: []string{user.S3AccessKeysColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(s3accesskey.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1715,28 +4397,28 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if uuo.mutation.EntitiesCleared() {
+	if uuo.mutation.CheckoutsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.EntitiesTable,
-			Columns: []string{user.EntitiesColumn},
+			Table:   user.CheckoutsTable,
+			Columns: []string{user.CheckoutsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(entity.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(checkout.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.RemovedEntitiesIDs(); len(nodes) > 0 && !uuo.mutation.EntitiesCleared() {
+	if nodes := uuo.mutation.RemovedCheckoutsIDs(); len(nodes) > 0 && !uuo.mutation.CheckoutsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.EntitiesTable,
-			Columns: []string{user.EntitiesColumn},
+			Table:   user.CheckoutsTable,
+			Columns: []string{user.CheckoutsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(entity.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(checkout.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -1744,15 +4426,134 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := uuo.mutation.EntitiesIDs(); len(nodes) > 0 {
+	if nodes := uuo.mutation.CheckoutsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.EntitiesTable,
-			Columns: []string{user.EntitiesColumn},
+			Table:   user.CheckoutsTable,
+			Columns: []string{user.CheckoutsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(entity.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(checkout.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.DismissedAnnouncementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.DismissedAnnouncementsTable,
+			Columns: user.DismissedAnnouncementsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcement.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedDismissedAnnouncementsIDs(); len(nodes) > 0 && !uuo.mutation.DismissedAnnouncementsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.DismissedAnnouncementsTable,
+			Columns: user.DismissedAnnouncementsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.DismissedAnnouncementsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.DismissedAnnouncementsTable,
+			Columns: user.DismissedAnnouncementsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(announcement.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.AdminOrganizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AdminOrganizationsTable,
+			Columns: user.AdminOrganizationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedAdminOrganizationsIDs(); len(nodes) > 0 && !uuo.mutation.AdminOrganizationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AdminOrganizationsTable,
+			Columns: user.AdminOrganizationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.AdminOrganizationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.AdminOrganizationsTable,
+			Columns: user.AdminOrganizationsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uuo.mutation.InvitationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.InvitationTable,
+			Columns: []string{user.InvitationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.InvitationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.InvitationTable,
+			Columns: []string{user.InvitationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/useremail"
+)
+
+// UserEmail is the model entity for the UserEmail schema.
+type UserEmail struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// VerifiedAt holds the value of the "verified_at" field.
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	// ConfirmDigest holds the value of the "confirm_digest" field.
+	ConfirmDigest string `json:"-"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserEmailQuery when eager-loading is set.
+	Edges        UserEmailEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// UserEmailEdges holds the relations/edges for other nodes in the graph.
+type UserEmailEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEmailEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserEmail) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case useremail.FieldID, useremail.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case useremail.FieldEmail, useremail.FieldConfirmDigest:
+			values[i] = new(sql.NullString)
+		case useremail.FieldCreatedAt, useremail.FieldUpdatedAt, useremail.FieldDeletedAt, useremail.FieldVerifiedAt, useremail.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserEmail fields.
+func (ue *UserEmail) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case useremail.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ue.ID = int(value.Int64)
+		case useremail.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ue.CreatedAt = value.Time
+			}
+		case useremail.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ue.UpdatedAt = value.Time
+			}
+		case useremail.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ue.DeletedAt = new(time.Time)
+				*ue.DeletedAt = value.Time
+			}
+		case useremail.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ue.UserID = int(value.Int64)
+			}
+		case useremail.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				ue.Email = value.String
+			}
+		case useremail.FieldVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_at", values[i])
+			} else if value.Valid {
+				ue.VerifiedAt = new(time.Time)
+				*ue.VerifiedAt = value.Time
+			}
+		case useremail.FieldConfirmDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field confirm_digest", values[i])
+			} else if value.Valid {
+				ue.ConfirmDigest = value.String
+			}
+		case useremail.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ue.ExpiresAt = new(time.Time)
+				*ue.ExpiresAt = value.Time
+			}
+		default:
+			ue.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserEmail.
+// This includes values selected through modifiers, order, etc.
+func (ue *UserEmail) Value(name string) (ent.Value, error) {
+	return ue.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the UserEmail entity.
+func (ue *UserEmail) QueryUser() *UserQuery {
+	return NewUserEmailClient(ue.config).QueryUser(ue)
+}
+
+// Update returns a builder for updating this UserEmail.
+// Note that you need to call UserEmail.Unwrap() before calling this method if this UserEmail
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ue *UserEmail) Update() *UserEmailUpdateOne {
+	return NewUserEmailClient(ue.config).UpdateOne(ue)
+}
+
+// Unwrap unwraps the UserEmail entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ue *UserEmail) Unwrap() *UserEmail {
+	_tx, ok := ue.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserEmail is not a transactional entity")
+	}
+	ue.config.driver = _tx.drv
+	return ue
+}
+
+// String implements the fmt.Stringer.
+func (ue *UserEmail) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserEmail(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ue.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ue.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ue.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ue.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ue.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(ue.Email)
+	builder.WriteString(", ")
+	if v := ue.VerifiedAt; v != nil {
+		builder.WriteString("verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("confirm_digest=<sensitive>")
+	builder.WriteString(", ")
+	if v := ue.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetUser manually set the edge as loaded state.
+func (e *UserEmail) SetUser(v *User) {
+	e.Edges.User = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// UserEmails is a parsable slice of UserEmail.
+type UserEmails []*UserEmail
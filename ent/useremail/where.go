@@ -0,0 +1,524 @@
+// Code generated by ent, DO NOT EDIT.
+
+package useremail
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldUserID, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldEmail, v))
+}
+
+// VerifiedAt applies equality check predicate on the "verified_at" field. It's identical to VerifiedAtEQ.
+func VerifiedAt(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// ConfirmDigest applies equality check predicate on the "confirm_digest" field. It's identical to ConfirmDigestEQ.
+func ConfirmDigest(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldConfirmDigest, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// VerifiedAtEQ applies the EQ predicate on the "verified_at" field.
+func VerifiedAtEQ(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtNEQ applies the NEQ predicate on the "verified_at" field.
+func VerifiedAtNEQ(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIn applies the In predicate on the "verified_at" field.
+func VerifiedAtIn(vs ...time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtNotIn applies the NotIn predicate on the "verified_at" field.
+func VerifiedAtNotIn(vs ...time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNotIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtGT applies the GT predicate on the "verified_at" field.
+func VerifiedAtGT(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldGT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtGTE applies the GTE predicate on the "verified_at" field.
+func VerifiedAtGTE(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldGTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLT applies the LT predicate on the "verified_at" field.
+func VerifiedAtLT(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldLT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLTE applies the LTE predicate on the "verified_at" field.
+func VerifiedAtLTE(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldLTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIsNil applies the IsNil predicate on the "verified_at" field.
+func VerifiedAtIsNil() predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldIsNull(FieldVerifiedAt))
+}
+
+// VerifiedAtNotNil applies the NotNil predicate on the "verified_at" field.
+func VerifiedAtNotNil() predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNotNull(FieldVerifiedAt))
+}
+
+// ConfirmDigestEQ applies the EQ predicate on the "confirm_digest" field.
+func ConfirmDigestEQ(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldConfirmDigest, v))
+}
+
+// ConfirmDigestNEQ applies the NEQ predicate on the "confirm_digest" field.
+func ConfirmDigestNEQ(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNEQ(FieldConfirmDigest, v))
+}
+
+// ConfirmDigestIn applies the In predicate on the "confirm_digest" field.
+func ConfirmDigestIn(vs ...string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldIn(FieldConfirmDigest, vs...))
+}
+
+// ConfirmDigestNotIn applies the NotIn predicate on the "confirm_digest" field.
+func ConfirmDigestNotIn(vs ...string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNotIn(FieldConfirmDigest, vs...))
+}
+
+// ConfirmDigestGT applies the GT predicate on the "confirm_digest" field.
+func ConfirmDigestGT(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldGT(FieldConfirmDigest, v))
+}
+
+// ConfirmDigestGTE applies the GTE predicate on the "confirm_digest" field.
+func ConfirmDigestGTE(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldGTE(FieldConfirmDigest, v))
+}
+
+// ConfirmDigestLT applies the LT predicate on the "confirm_digest" field.
+func ConfirmDigestLT(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldLT(FieldConfirmDigest, v))
+}
+
+// ConfirmDigestLTE applies the LTE predicate on the "confirm_digest" field.
+func ConfirmDigestLTE(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldLTE(FieldConfirmDigest, v))
+}
+
+// ConfirmDigestContains applies the Contains predicate on the "confirm_digest" field.
+func ConfirmDigestContains(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldContains(FieldConfirmDigest, v))
+}
+
+// ConfirmDigestHasPrefix applies the HasPrefix predicate on the "confirm_digest" field.
+func ConfirmDigestHasPrefix(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldHasPrefix(FieldConfirmDigest, v))
+}
+
+// ConfirmDigestHasSuffix applies the HasSuffix predicate on the "confirm_digest" field.
+func ConfirmDigestHasSuffix(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldHasSuffix(FieldConfirmDigest, v))
+}
+
+// ConfirmDigestIsNil applies the IsNil predicate on the "confirm_digest" field.
+func ConfirmDigestIsNil() predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldIsNull(FieldConfirmDigest))
+}
+
+// ConfirmDigestNotNil applies the NotNil predicate on the "confirm_digest" field.
+func ConfirmDigestNotNil() predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNotNull(FieldConfirmDigest))
+}
+
+// ConfirmDigestEqualFold applies the EqualFold predicate on the "confirm_digest" field.
+func ConfirmDigestEqualFold(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEqualFold(FieldConfirmDigest, v))
+}
+
+// ConfirmDigestContainsFold applies the ContainsFold predicate on the "confirm_digest" field.
+func ConfirmDigestContainsFold(v string) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldContainsFold(FieldConfirmDigest, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.UserEmail {
+	return predicate.UserEmail(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.UserEmail {
+	return predicate.UserEmail(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.UserEmail {
+	return predicate.UserEmail(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserEmail) predicate.UserEmail {
+	return predicate.UserEmail(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserEmail) predicate.UserEmail {
+	return predicate.UserEmail(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserEmail) predicate.UserEmail {
+	return predicate.UserEmail(sql.NotPredicates(p))
+}
@@ -0,0 +1,971 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/useremail"
+)
+
+// UserEmailCreate is the builder for creating a UserEmail entity.
+type UserEmailCreate struct {
+	config
+	mutation *UserEmailMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uec *UserEmailCreate) SetCreatedAt(t time.Time) *UserEmailCreate {
+	uec.mutation.SetCreatedAt(t)
+	return uec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uec *UserEmailCreate) SetNillableCreatedAt(t *time.Time) *UserEmailCreate {
+	if t != nil {
+		uec.SetCreatedAt(*t)
+	}
+	return uec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uec *UserEmailCreate) SetUpdatedAt(t time.Time) *UserEmailCreate {
+	uec.mutation.SetUpdatedAt(t)
+	return uec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (uec *UserEmailCreate) SetNillableUpdatedAt(t *time.Time) *UserEmailCreate {
+	if t != nil {
+		uec.SetUpdatedAt(*t)
+	}
+	return uec
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (uec *UserEmailCreate) SetDeletedAt(t time.Time) *UserEmailCreate {
+	uec.mutation.SetDeletedAt(t)
+	return uec
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (uec *UserEmailCreate) SetNillableDeletedAt(t *time.Time) *UserEmailCreate {
+	if t != nil {
+		uec.SetDeletedAt(*t)
+	}
+	return uec
+}
+
+// SetUserID sets the "user_id" field.
+func (uec *UserEmailCreate) SetUserID(i int) *UserEmailCreate {
+	uec.mutation.SetUserID(i)
+	return uec
+}
+
+// SetEmail sets the "email" field.
+func (uec *UserEmailCreate) SetEmail(s string) *UserEmailCreate {
+	uec.mutation.SetEmail(s)
+	return uec
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (uec *UserEmailCreate) SetVerifiedAt(t time.Time) *UserEmailCreate {
+	uec.mutation.SetVerifiedAt(t)
+	return uec
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (uec *UserEmailCreate) SetNillableVerifiedAt(t *time.Time) *UserEmailCreate {
+	if t != nil {
+		uec.SetVerifiedAt(*t)
+	}
+	return uec
+}
+
+// SetConfirmDigest sets the "confirm_digest" field.
+func (uec *UserEmailCreate) SetConfirmDigest(s string) *UserEmailCreate {
+	uec.mutation.SetConfirmDigest(s)
+	return uec
+}
+
+// SetNillableConfirmDigest sets the "confirm_digest" field if the given value is not nil.
+func (uec *UserEmailCreate) SetNillableConfirmDigest(s *string) *UserEmailCreate {
+	if s != nil {
+		uec.SetConfirmDigest(*s)
+	}
+	return uec
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (uec *UserEmailCreate) SetExpiresAt(t time.Time) *UserEmailCreate {
+	uec.mutation.SetExpiresAt(t)
+	return uec
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (uec *UserEmailCreate) SetNillableExpiresAt(t *time.Time) *UserEmailCreate {
+	if t != nil {
+		uec.SetExpiresAt(*t)
+	}
+	return uec
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (uec *UserEmailCreate) SetUser(u *User) *UserEmailCreate {
+	return uec.SetUserID(u.ID)
+}
+
+// Mutation returns the UserEmailMutation object of the builder.
+func (uec *UserEmailCreate) Mutation() *UserEmailMutation {
+	return uec.mutation
+}
+
+// Save creates the UserEmail in the database.
+func (uec *UserEmailCreate) Save(ctx context.Context) (*UserEmail, error) {
+	if err := uec.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, uec.sqlSave, uec.mutation, uec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uec *UserEmailCreate) SaveX(ctx context.Context) *UserEmail {
+	v, err := uec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uec *UserEmailCreate) Exec(ctx context.Context) error {
+	_, err := uec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uec *UserEmailCreate) ExecX(ctx context.Context) {
+	if err := uec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uec *UserEmailCreate) defaults() error {
+	if _, ok := uec.mutation.CreatedAt(); !ok {
+		if useremail.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized useremail.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := useremail.DefaultCreatedAt()
+		uec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := uec.mutation.UpdatedAt(); !ok {
+		if useremail.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized useremail.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := useremail.DefaultUpdatedAt()
+		uec.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uec *UserEmailCreate) check() error {
+	if _, ok := uec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserEmail.created_at"`)}
+	}
+	if _, ok := uec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserEmail.updated_at"`)}
+	}
+	if _, ok := uec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserEmail.user_id"`)}
+	}
+	if _, ok := uec.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "UserEmail.email"`)}
+	}
+	if _, ok := uec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserEmail.user"`)}
+	}
+	return nil
+}
+
+func (uec *UserEmailCreate) sqlSave(ctx context.Context) (*UserEmail, error) {
+	if err := uec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := uec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	uec.mutation.id = &_node.ID
+	uec.mutation.done = true
+	return _node, nil
+}
+
+func (uec *UserEmailCreate) createSpec() (*UserEmail, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserEmail{config: uec.config}
+		_spec = sqlgraph.NewCreateSpec(useremail.Table, sqlgraph.NewFieldSpec(useremail.FieldID, field.TypeInt))
+	)
+
+	if id, ok := uec.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = uec.conflict
+	if value, ok := uec.mutation.CreatedAt(); ok {
+		_spec.SetField(useremail.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := uec.mutation.UpdatedAt(); ok {
+		_spec.SetField(useremail.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := uec.mutation.DeletedAt(); ok {
+		_spec.SetField(useremail.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := uec.mutation.Email(); ok {
+		_spec.SetField(useremail.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := uec.mutation.VerifiedAt(); ok {
+		_spec.SetField(useremail.FieldVerifiedAt, field.TypeTime, value)
+		_node.VerifiedAt = &value
+	}
+	if value, ok := uec.mutation.ConfirmDigest(); ok {
+		_spec.SetField(useremail.FieldConfirmDigest, field.TypeString, value)
+		_node.ConfirmDigest = value
+	}
+	if value, ok := uec.mutation.ExpiresAt(); ok {
+		_spec.SetField(useremail.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if nodes := uec.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   useremail.UserTable,
+			Columns: []string{useremail.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserEmail.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserEmailUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (uec *UserEmailCreate) OnConflict(opts ...sql.ConflictOption) *UserEmailUpsertOne {
+	uec.conflict = opts
+	return &UserEmailUpsertOne{
+		create: uec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserEmail.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uec *UserEmailCreate) OnConflictColumns(columns ...string) *UserEmailUpsertOne {
+	uec.conflict = append(uec.conflict, sql.ConflictColumns(columns...))
+	return &UserEmailUpsertOne{
+		create: uec,
+	}
+}
+
+type (
+	// UserEmailUpsertOne is the builder for "upsert"-ing
+	//  one UserEmail node.
+	UserEmailUpsertOne struct {
+		create *UserEmailCreate
+	}
+
+	// UserEmailUpsert is the "OnConflict" setter.
+	UserEmailUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserEmailUpsert) SetUpdatedAt(v time.Time) *UserEmailUpsert {
+	u.Set(useremail.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserEmailUpsert) UpdateUpdatedAt() *UserEmailUpsert {
+	u.SetExcluded(useremail.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UserEmailUpsert) SetDeletedAt(v time.Time) *UserEmailUpsert {
+	u.Set(useremail.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UserEmailUpsert) UpdateDeletedAt() *UserEmailUpsert {
+	u.SetExcluded(useremail.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UserEmailUpsert) ClearDeletedAt() *UserEmailUpsert {
+	u.SetNull(useremail.FieldDeletedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserEmailUpsert) SetUserID(v int) *UserEmailUpsert {
+	u.Set(useremail.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserEmailUpsert) UpdateUserID() *UserEmailUpsert {
+	u.SetExcluded(useremail.FieldUserID)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *UserEmailUpsert) SetEmail(v string) *UserEmailUpsert {
+	u.Set(useremail.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *UserEmailUpsert) UpdateEmail() *UserEmailUpsert {
+	u.SetExcluded(useremail.FieldEmail)
+	return u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *UserEmailUpsert) SetVerifiedAt(v time.Time) *UserEmailUpsert {
+	u.Set(useremail.FieldVerifiedAt, v)
+	return u
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *UserEmailUpsert) UpdateVerifiedAt() *UserEmailUpsert {
+	u.SetExcluded(useremail.FieldVerifiedAt)
+	return u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *UserEmailUpsert) ClearVerifiedAt() *UserEmailUpsert {
+	u.SetNull(useremail.FieldVerifiedAt)
+	return u
+}
+
+// SetConfirmDigest sets the "confirm_digest" field.
+func (u *UserEmailUpsert) SetConfirmDigest(v string) *UserEmailUpsert {
+	u.Set(useremail.FieldConfirmDigest, v)
+	return u
+}
+
+// UpdateConfirmDigest sets the "confirm_digest" field to the value that was provided on create.
+func (u *UserEmailUpsert) UpdateConfirmDigest() *UserEmailUpsert {
+	u.SetExcluded(useremail.FieldConfirmDigest)
+	return u
+}
+
+// ClearConfirmDigest clears the value of the "confirm_digest" field.
+func (u *UserEmailUpsert) ClearConfirmDigest() *UserEmailUpsert {
+	u.SetNull(useremail.FieldConfirmDigest)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *UserEmailUpsert) SetExpiresAt(v time.Time) *UserEmailUpsert {
+	u.Set(useremail.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *UserEmailUpsert) UpdateExpiresAt() *UserEmailUpsert {
+	u.SetExcluded(useremail.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *UserEmailUpsert) ClearExpiresAt() *UserEmailUpsert {
+	u.SetNull(useremail.FieldExpiresAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.UserEmail.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserEmailUpsertOne) UpdateNewValues() *UserEmailUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(useremail.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserEmail.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserEmailUpsertOne) Ignore() *UserEmailUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserEmailUpsertOne) DoNothing() *UserEmailUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserEmailCreate.OnConflict
+// documentation for more info.
+func (u *UserEmailUpsertOne) Update(set func(*UserEmailUpsert)) *UserEmailUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserEmailUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserEmailUpsertOne) SetUpdatedAt(v time.Time) *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserEmailUpsertOne) UpdateUpdatedAt() *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UserEmailUpsertOne) SetDeletedAt(v time.Time) *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UserEmailUpsertOne) UpdateDeletedAt() *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UserEmailUpsertOne) ClearDeletedAt() *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserEmailUpsertOne) SetUserID(v int) *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserEmailUpsertOne) UpdateUserID() *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *UserEmailUpsertOne) SetEmail(v string) *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *UserEmailUpsertOne) UpdateEmail() *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *UserEmailUpsertOne) SetVerifiedAt(v time.Time) *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *UserEmailUpsertOne) UpdateVerifiedAt() *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *UserEmailUpsertOne) ClearVerifiedAt() *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.ClearVerifiedAt()
+	})
+}
+
+// SetConfirmDigest sets the "confirm_digest" field.
+func (u *UserEmailUpsertOne) SetConfirmDigest(v string) *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.SetConfirmDigest(v)
+	})
+}
+
+// UpdateConfirmDigest sets the "confirm_digest" field to the value that was provided on create.
+func (u *UserEmailUpsertOne) UpdateConfirmDigest() *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.UpdateConfirmDigest()
+	})
+}
+
+// ClearConfirmDigest clears the value of the "confirm_digest" field.
+func (u *UserEmailUpsertOne) ClearConfirmDigest() *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.ClearConfirmDigest()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *UserEmailUpsertOne) SetExpiresAt(v time.Time) *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *UserEmailUpsertOne) UpdateExpiresAt() *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *UserEmailUpsertOne) ClearExpiresAt() *UserEmailUpsertOne {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserEmailUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserEmailCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserEmailUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserEmailUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserEmailUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *UserEmailCreate) SetRawID(t int) *UserEmailCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// UserEmailCreateBulk is the builder for creating many UserEmail entities in bulk.
+type UserEmailCreateBulk struct {
+	config
+	err      error
+	builders []*UserEmailCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UserEmail entities in the database.
+func (uecb *UserEmailCreateBulk) Save(ctx context.Context) ([]*UserEmail, error) {
+	if uecb.err != nil {
+		return nil, uecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uecb.builders))
+	nodes := make([]*UserEmail, len(uecb.builders))
+	mutators := make([]Mutator, len(uecb.builders))
+	for i := range uecb.builders {
+		func(i int, root context.Context) {
+			builder := uecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserEmailMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = uecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uecb *UserEmailCreateBulk) SaveX(ctx context.Context) []*UserEmail {
+	v, err := uecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uecb *UserEmailCreateBulk) Exec(ctx context.Context) error {
+	_, err := uecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uecb *UserEmailCreateBulk) ExecX(ctx context.Context) {
+	if err := uecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserEmail.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserEmailUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (uecb *UserEmailCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserEmailUpsertBulk {
+	uecb.conflict = opts
+	return &UserEmailUpsertBulk{
+		create: uecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserEmail.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uecb *UserEmailCreateBulk) OnConflictColumns(columns ...string) *UserEmailUpsertBulk {
+	uecb.conflict = append(uecb.conflict, sql.ConflictColumns(columns...))
+	return &UserEmailUpsertBulk{
+		create: uecb,
+	}
+}
+
+// UserEmailUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserEmail nodes.
+type UserEmailUpsertBulk struct {
+	create *UserEmailCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserEmail.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *UserEmailUpsertBulk) UpdateNewValues() *UserEmailUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(useremail.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserEmail.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserEmailUpsertBulk) Ignore() *UserEmailUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserEmailUpsertBulk) DoNothing() *UserEmailUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserEmailCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserEmailUpsertBulk) Update(set func(*UserEmailUpsert)) *UserEmailUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserEmailUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserEmailUpsertBulk) SetUpdatedAt(v time.Time) *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserEmailUpsertBulk) UpdateUpdatedAt() *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UserEmailUpsertBulk) SetDeletedAt(v time.Time) *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UserEmailUpsertBulk) UpdateDeletedAt() *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UserEmailUpsertBulk) ClearDeletedAt() *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserEmailUpsertBulk) SetUserID(v int) *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserEmailUpsertBulk) UpdateUserID() *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *UserEmailUpsertBulk) SetEmail(v string) *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *UserEmailUpsertBulk) UpdateEmail() *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *UserEmailUpsertBulk) SetVerifiedAt(v time.Time) *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *UserEmailUpsertBulk) UpdateVerifiedAt() *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *UserEmailUpsertBulk) ClearVerifiedAt() *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.ClearVerifiedAt()
+	})
+}
+
+// SetConfirmDigest sets the "confirm_digest" field.
+func (u *UserEmailUpsertBulk) SetConfirmDigest(v string) *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.SetConfirmDigest(v)
+	})
+}
+
+// UpdateConfirmDigest sets the "confirm_digest" field to the value that was provided on create.
+func (u *UserEmailUpsertBulk) UpdateConfirmDigest() *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.UpdateConfirmDigest()
+	})
+}
+
+// ClearConfirmDigest clears the value of the "confirm_digest" field.
+func (u *UserEmailUpsertBulk) ClearConfirmDigest() *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.ClearConfirmDigest()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *UserEmailUpsertBulk) SetExpiresAt(v time.Time) *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *UserEmailUpsertBulk) UpdateExpiresAt() *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *UserEmailUpsertBulk) ClearExpiresAt() *UserEmailUpsertBulk {
+	return u.Update(func(s *UserEmailUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserEmailUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserEmailCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserEmailCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserEmailUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
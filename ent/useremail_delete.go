@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/useremail"
+)
+
+// UserEmailDelete is the builder for deleting a UserEmail entity.
+type UserEmailDelete struct {
+	config
+	hooks    []Hook
+	mutation *UserEmailMutation
+}
+
+// Where appends a list predicates to the UserEmailDelete builder.
+func (ued *UserEmailDelete) Where(ps ...predicate.UserEmail) *UserEmailDelete {
+	ued.mutation.Where(ps...)
+	return ued
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ued *UserEmailDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ued.sqlExec, ued.mutation, ued.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ued *UserEmailDelete) ExecX(ctx context.Context) int {
+	n, err := ued.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ued *UserEmailDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(useremail.Table, sqlgraph.NewFieldSpec(useremail.FieldID, field.TypeInt))
+	if ps := ued.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ued.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ued.mutation.done = true
+	return affected, err
+}
+
+// UserEmailDeleteOne is the builder for deleting a single UserEmail entity.
+type UserEmailDeleteOne struct {
+	ued *UserEmailDelete
+}
+
+// Where appends a list predicates to the UserEmailDelete builder.
+func (uedo *UserEmailDeleteOne) Where(ps ...predicate.UserEmail) *UserEmailDeleteOne {
+	uedo.ued.mutation.Where(ps...)
+	return uedo
+}
+
+// Exec executes the deletion query.
+func (uedo *UserEmailDeleteOne) Exec(ctx context.Context) error {
+	n, err := uedo.ued.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{useremail.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uedo *UserEmailDeleteOne) ExecX(ctx context.Context) {
+	if err := uedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,618 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/useremail"
+)
+
+// UserEmailQuery is the builder for querying UserEmail entities.
+type UserEmailQuery struct {
+	config
+	ctx        *QueryContext
+	order      []useremail.OrderOption
+	inters     []Interceptor
+	predicates []predicate.UserEmail
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*UserEmail) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UserEmailQuery builder.
+func (ueq *UserEmailQuery) Where(ps ...predicate.UserEmail) *UserEmailQuery {
+	ueq.predicates = append(ueq.predicates, ps...)
+	return ueq
+}
+
+// Limit the number of records to be returned by this query.
+func (ueq *UserEmailQuery) Limit(limit int) *UserEmailQuery {
+	ueq.ctx.Limit = &limit
+	return ueq
+}
+
+// Offset to start from.
+func (ueq *UserEmailQuery) Offset(offset int) *UserEmailQuery {
+	ueq.ctx.Offset = &offset
+	return ueq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ueq *UserEmailQuery) Unique(unique bool) *UserEmailQuery {
+	ueq.ctx.Unique = &unique
+	return ueq
+}
+
+// Order specifies how the records should be ordered.
+func (ueq *UserEmailQuery) Order(o ...useremail.OrderOption) *UserEmailQuery {
+	ueq.order = append(ueq.order, o...)
+	return ueq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (ueq *UserEmailQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: ueq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ueq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ueq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(useremail.Table, useremail.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, useremail.UserTable, useremail.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ueq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first UserEmail entity from the query.
+// Returns a *NotFoundError when no UserEmail was found.
+func (ueq *UserEmailQuery) First(ctx context.Context) (*UserEmail, error) {
+	nodes, err := ueq.Limit(1).All(setContextOp(ctx, ueq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{useremail.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ueq *UserEmailQuery) FirstX(ctx context.Context) *UserEmail {
+	node, err := ueq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UserEmail ID from the query.
+// Returns a *NotFoundError when no UserEmail ID was found.
+func (ueq *UserEmailQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ueq.Limit(1).IDs(setContextOp(ctx, ueq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{useremail.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ueq *UserEmailQuery) FirstIDX(ctx context.Context) int {
+	id, err := ueq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UserEmail entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UserEmail entity is found.
+// Returns a *NotFoundError when no UserEmail entities are found.
+func (ueq *UserEmailQuery) Only(ctx context.Context) (*UserEmail, error) {
+	nodes, err := ueq.Limit(2).All(setContextOp(ctx, ueq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{useremail.Label}
+	default:
+		return nil, &NotSingularError{useremail.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ueq *UserEmailQuery) OnlyX(ctx context.Context) *UserEmail {
+	node, err := ueq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UserEmail ID in the query.
+// Returns a *NotSingularError when more than one UserEmail ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ueq *UserEmailQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ueq.Limit(2).IDs(setContextOp(ctx, ueq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{useremail.Label}
+	default:
+		err = &NotSingularError{useremail.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ueq *UserEmailQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ueq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UserEmails.
+func (ueq *UserEmailQuery) All(ctx context.Context) ([]*UserEmail, error) {
+	ctx = setContextOp(ctx, ueq.ctx, "All")
+	if err := ueq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UserEmail, *UserEmailQuery]()
+	return withInterceptors[[]*UserEmail](ctx, ueq, qr, ueq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ueq *UserEmailQuery) AllX(ctx context.Context) []*UserEmail {
+	nodes, err := ueq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UserEmail IDs.
+func (ueq *UserEmailQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ueq.ctx.Unique == nil && ueq.path != nil {
+		ueq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ueq.ctx, "IDs")
+	if err = ueq.Select(useremail.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ueq *UserEmailQuery) IDsX(ctx context.Context) []int {
+	ids, err := ueq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ueq *UserEmailQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ueq.ctx, "Count")
+	if err := ueq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ueq, querierCount[*UserEmailQuery](), ueq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ueq *UserEmailQuery) CountX(ctx context.Context) int {
+	count, err := ueq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ueq *UserEmailQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ueq.ctx, "Exist")
+	switch _, err := ueq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ueq *UserEmailQuery) ExistX(ctx context.Context) bool {
+	exist, err := ueq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UserEmailQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ueq *UserEmailQuery) Clone() *UserEmailQuery {
+	if ueq == nil {
+		return nil
+	}
+	return &UserEmailQuery{
+		config:     ueq.config,
+		ctx:        ueq.ctx.Clone(),
+		order:      append([]useremail.OrderOption{}, ueq.order...),
+		inters:     append([]Interceptor{}, ueq.inters...),
+		predicates: append([]predicate.UserEmail{}, ueq.predicates...),
+		withUser:   ueq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  ueq.sql.Clone(),
+		path: ueq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (ueq *UserEmailQuery) WithUser(opts ...func(*UserQuery)) *UserEmailQuery {
+	query := (&UserClient{config: ueq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ueq.withUser = query
+	return ueq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UserEmail.Query().
+//		GroupBy(useremail.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ueq *UserEmailQuery) GroupBy(field string, fields ...string) *UserEmailGroupBy {
+	ueq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserEmailGroupBy{build: ueq}
+	grbuild.flds = &ueq.ctx.Fields
+	grbuild.label = useremail.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.UserEmail.Query().
+//		Select(useremail.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ueq *UserEmailQuery) Select(fields ...string) *UserEmailSelect {
+	ueq.ctx.Fields = append(ueq.ctx.Fields, fields...)
+	sbuild := &UserEmailSelect{UserEmailQuery: ueq}
+	sbuild.label = useremail.Label
+	sbuild.flds, sbuild.scan = &ueq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UserEmailSelect configured with the given aggregations.
+func (ueq *UserEmailQuery) Aggregate(fns ...AggregateFunc) *UserEmailSelect {
+	return ueq.Select().Aggregate(fns...)
+}
+
+func (ueq *UserEmailQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ueq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ueq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ueq.ctx.Fields {
+		if !useremail.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ueq.path != nil {
+		prev, err := ueq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ueq.sql = prev
+	}
+	return nil
+}
+
+func (ueq *UserEmailQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserEmail, error) {
+	var (
+		nodes       = []*UserEmail{}
+		_spec       = ueq.querySpec()
+		loadedTypes = [1]bool{
+			ueq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UserEmail).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UserEmail{config: ueq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ueq.modifiers) > 0 {
+		_spec.Modifiers = ueq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ueq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ueq.withUser; query != nil {
+		if err := ueq.loadUser(ctx, query, nodes, nil,
+			func(n *UserEmail, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range ueq.loadTotal {
+		if err := ueq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ueq *UserEmailQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*UserEmail, init func(*UserEmail), assign func(*UserEmail, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*UserEmail)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ueq *UserEmailQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ueq.querySpec()
+	if len(ueq.modifiers) > 0 {
+		_spec.Modifiers = ueq.modifiers
+	}
+	_spec.Node.Columns = ueq.ctx.Fields
+	if len(ueq.ctx.Fields) > 0 {
+		_spec.Unique = ueq.ctx.Unique != nil && *ueq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ueq.driver, _spec)
+}
+
+func (ueq *UserEmailQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(useremail.Table, useremail.Columns, sqlgraph.NewFieldSpec(useremail.FieldID, field.TypeInt))
+	_spec.From = ueq.sql
+	if unique := ueq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ueq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ueq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, useremail.FieldID)
+		for i := range fields {
+			if fields[i] != useremail.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ueq.withUser != nil {
+			_spec.Node.AddColumnOnce(useremail.FieldUserID)
+		}
+	}
+	if ps := ueq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ueq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ueq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ueq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ueq *UserEmailQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ueq.driver.Dialect())
+	t1 := builder.Table(useremail.Table)
+	columns := ueq.ctx.Fields
+	if len(columns) == 0 {
+		columns = useremail.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ueq.sql != nil {
+		selector = ueq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ueq.ctx.Unique != nil && *ueq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ueq.predicates {
+		p(selector)
+	}
+	for _, p := range ueq.order {
+		p(selector)
+	}
+	if offset := ueq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ueq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// UserEmailGroupBy is the group-by builder for UserEmail entities.
+type UserEmailGroupBy struct {
+	selector
+	build *UserEmailQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (uegb *UserEmailGroupBy) Aggregate(fns ...AggregateFunc) *UserEmailGroupBy {
+	uegb.fns = append(uegb.fns, fns...)
+	return uegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uegb *UserEmailGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, uegb.build.ctx, "GroupBy")
+	if err := uegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserEmailQuery, *UserEmailGroupBy](ctx, uegb.build, uegb, uegb.build.inters, v)
+}
+
+func (uegb *UserEmailGroupBy) sqlScan(ctx context.Context, root *UserEmailQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(uegb.fns))
+	for _, fn := range uegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*uegb.flds)+len(uegb.fns))
+		for _, f := range *uegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*uegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UserEmailSelect is the builder for selecting fields of UserEmail entities.
+type UserEmailSelect struct {
+	*UserEmailQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ues *UserEmailSelect) Aggregate(fns ...AggregateFunc) *UserEmailSelect {
+	ues.fns = append(ues.fns, fns...)
+	return ues
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ues *UserEmailSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ues.ctx, "Select")
+	if err := ues.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserEmailQuery, *UserEmailSelect](ctx, ues.UserEmailQuery, ues, ues.inters, v)
+}
+
+func (ues *UserEmailSelect) sqlScan(ctx context.Context, root *UserEmailQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ues.fns))
+	for _, fn := range ues.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ues.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ues.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
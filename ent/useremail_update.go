@@ -0,0 +1,597 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/useremail"
+)
+
+// UserEmailUpdate is the builder for updating UserEmail entities.
+type UserEmailUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserEmailMutation
+}
+
+// Where appends a list predicates to the UserEmailUpdate builder.
+func (ueu *UserEmailUpdate) Where(ps ...predicate.UserEmail) *UserEmailUpdate {
+	ueu.mutation.Where(ps...)
+	return ueu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ueu *UserEmailUpdate) SetUpdatedAt(t time.Time) *UserEmailUpdate {
+	ueu.mutation.SetUpdatedAt(t)
+	return ueu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ueu *UserEmailUpdate) SetDeletedAt(t time.Time) *UserEmailUpdate {
+	ueu.mutation.SetDeletedAt(t)
+	return ueu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ueu *UserEmailUpdate) SetNillableDeletedAt(t *time.Time) *UserEmailUpdate {
+	if t != nil {
+		ueu.SetDeletedAt(*t)
+	}
+	return ueu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ueu *UserEmailUpdate) ClearDeletedAt() *UserEmailUpdate {
+	ueu.mutation.ClearDeletedAt()
+	return ueu
+}
+
+// SetUserID sets the "user_id" field.
+func (ueu *UserEmailUpdate) SetUserID(i int) *UserEmailUpdate {
+	ueu.mutation.SetUserID(i)
+	return ueu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ueu *UserEmailUpdate) SetNillableUserID(i *int) *UserEmailUpdate {
+	if i != nil {
+		ueu.SetUserID(*i)
+	}
+	return ueu
+}
+
+// SetEmail sets the "email" field.
+func (ueu *UserEmailUpdate) SetEmail(s string) *UserEmailUpdate {
+	ueu.mutation.SetEmail(s)
+	return ueu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (ueu *UserEmailUpdate) SetNillableEmail(s *string) *UserEmailUpdate {
+	if s != nil {
+		ueu.SetEmail(*s)
+	}
+	return ueu
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (ueu *UserEmailUpdate) SetVerifiedAt(t time.Time) *UserEmailUpdate {
+	ueu.mutation.SetVerifiedAt(t)
+	return ueu
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (ueu *UserEmailUpdate) SetNillableVerifiedAt(t *time.Time) *UserEmailUpdate {
+	if t != nil {
+		ueu.SetVerifiedAt(*t)
+	}
+	return ueu
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (ueu *UserEmailUpdate) ClearVerifiedAt() *UserEmailUpdate {
+	ueu.mutation.ClearVerifiedAt()
+	return ueu
+}
+
+// SetConfirmDigest sets the "confirm_digest" field.
+func (ueu *UserEmailUpdate) SetConfirmDigest(s string) *UserEmailUpdate {
+	ueu.mutation.SetConfirmDigest(s)
+	return ueu
+}
+
+// SetNillableConfirmDigest sets the "confirm_digest" field if the given value is not nil.
+func (ueu *UserEmailUpdate) SetNillableConfirmDigest(s *string) *UserEmailUpdate {
+	if s != nil {
+		ueu.SetConfirmDigest(*s)
+	}
+	return ueu
+}
+
+// ClearConfirmDigest clears the value of the "confirm_digest" field.
+func (ueu *UserEmailUpdate) ClearConfirmDigest() *UserEmailUpdate {
+	ueu.mutation.ClearConfirmDigest()
+	return ueu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ueu *UserEmailUpdate) SetExpiresAt(t time.Time) *UserEmailUpdate {
+	ueu.mutation.SetExpiresAt(t)
+	return ueu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ueu *UserEmailUpdate) SetNillableExpiresAt(t *time.Time) *UserEmailUpdate {
+	if t != nil {
+		ueu.SetExpiresAt(*t)
+	}
+	return ueu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (ueu *UserEmailUpdate) ClearExpiresAt() *UserEmailUpdate {
+	ueu.mutation.ClearExpiresAt()
+	return ueu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ueu *UserEmailUpdate) SetUser(u *User) *UserEmailUpdate {
+	return ueu.SetUserID(u.ID)
+}
+
+// Mutation returns the UserEmailMutation object of the builder.
+func (ueu *UserEmailUpdate) Mutation() *UserEmailMutation {
+	return ueu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ueu *UserEmailUpdate) ClearUser() *UserEmailUpdate {
+	ueu.mutation.ClearUser()
+	return ueu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ueu *UserEmailUpdate) Save(ctx context.Context) (int, error) {
+	if err := ueu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, ueu.sqlSave, ueu.mutation, ueu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ueu *UserEmailUpdate) SaveX(ctx context.Context) int {
+	affected, err := ueu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ueu *UserEmailUpdate) Exec(ctx context.Context) error {
+	_, err := ueu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ueu *UserEmailUpdate) ExecX(ctx context.Context) {
+	if err := ueu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ueu *UserEmailUpdate) defaults() error {
+	if _, ok := ueu.mutation.UpdatedAt(); !ok {
+		if useremail.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized useremail.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := useremail.UpdateDefaultUpdatedAt()
+		ueu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ueu *UserEmailUpdate) check() error {
+	if _, ok := ueu.mutation.UserID(); ueu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserEmail.user"`)
+	}
+	return nil
+}
+
+func (ueu *UserEmailUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ueu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(useremail.Table, useremail.Columns, sqlgraph.NewFieldSpec(useremail.FieldID, field.TypeInt))
+	if ps := ueu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ueu.mutation.UpdatedAt(); ok {
+		_spec.SetField(useremail.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ueu.mutation.DeletedAt(); ok {
+		_spec.SetField(useremail.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ueu.mutation.DeletedAtCleared() {
+		_spec.ClearField(useremail.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ueu.mutation.Email(); ok {
+		_spec.SetField(useremail.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := ueu.mutation.VerifiedAt(); ok {
+		_spec.SetField(useremail.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if ueu.mutation.VerifiedAtCleared() {
+		_spec.ClearField(useremail.FieldVerifiedAt, field.TypeTime)
+	}
+	if value, ok := ueu.mutation.ConfirmDigest(); ok {
+		_spec.SetField(useremail.FieldConfirmDigest, field.TypeString, value)
+	}
+	if ueu.mutation.ConfirmDigestCleared() {
+		_spec.ClearField(useremail.FieldConfirmDigest, field.TypeString)
+	}
+	if value, ok := ueu.mutation.ExpiresAt(); ok {
+		_spec.SetField(useremail.FieldExpiresAt, field.TypeTime, value)
+	}
+	if ueu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(useremail.FieldExpiresAt, field.TypeTime)
+	}
+	if ueu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   useremail.UserTable,
+			Columns: []string{useremail.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ueu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   useremail.UserTable,
+			Columns: []string{useremail.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ueu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{useremail.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ueu.mutation.done = true
+	return n, nil
+}
+
+// UserEmailUpdateOne is the builder for updating a single UserEmail entity.
+type UserEmailUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserEmailMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ueuo *UserEmailUpdateOne) SetUpdatedAt(t time.Time) *UserEmailUpdateOne {
+	ueuo.mutation.SetUpdatedAt(t)
+	return ueuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ueuo *UserEmailUpdateOne) SetDeletedAt(t time.Time) *UserEmailUpdateOne {
+	ueuo.mutation.SetDeletedAt(t)
+	return ueuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ueuo *UserEmailUpdateOne) SetNillableDeletedAt(t *time.Time) *UserEmailUpdateOne {
+	if t != nil {
+		ueuo.SetDeletedAt(*t)
+	}
+	return ueuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ueuo *UserEmailUpdateOne) ClearDeletedAt() *UserEmailUpdateOne {
+	ueuo.mutation.ClearDeletedAt()
+	return ueuo
+}
+
+// SetUserID sets the "user_id" field.
+func (ueuo *UserEmailUpdateOne) SetUserID(i int) *UserEmailUpdateOne {
+	ueuo.mutation.SetUserID(i)
+	return ueuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (ueuo *UserEmailUpdateOne) SetNillableUserID(i *int) *UserEmailUpdateOne {
+	if i != nil {
+		ueuo.SetUserID(*i)
+	}
+	return ueuo
+}
+
+// SetEmail sets the "email" field.
+func (ueuo *UserEmailUpdateOne) SetEmail(s string) *UserEmailUpdateOne {
+	ueuo.mutation.SetEmail(s)
+	return ueuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (ueuo *UserEmailUpdateOne) SetNillableEmail(s *string) *UserEmailUpdateOne {
+	if s != nil {
+		ueuo.SetEmail(*s)
+	}
+	return ueuo
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (ueuo *UserEmailUpdateOne) SetVerifiedAt(t time.Time) *UserEmailUpdateOne {
+	ueuo.mutation.SetVerifiedAt(t)
+	return ueuo
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (ueuo *UserEmailUpdateOne) SetNillableVerifiedAt(t *time.Time) *UserEmailUpdateOne {
+	if t != nil {
+		ueuo.SetVerifiedAt(*t)
+	}
+	return ueuo
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (ueuo *UserEmailUpdateOne) ClearVerifiedAt() *UserEmailUpdateOne {
+	ueuo.mutation.ClearVerifiedAt()
+	return ueuo
+}
+
+// SetConfirmDigest sets the "confirm_digest" field.
+func (ueuo *UserEmailUpdateOne) SetConfirmDigest(s string) *UserEmailUpdateOne {
+	ueuo.mutation.SetConfirmDigest(s)
+	return ueuo
+}
+
+// SetNillableConfirmDigest sets the "confirm_digest" field if the given value is not nil.
+func (ueuo *UserEmailUpdateOne) SetNillableConfirmDigest(s *string) *UserEmailUpdateOne {
+	if s != nil {
+		ueuo.SetConfirmDigest(*s)
+	}
+	return ueuo
+}
+
+// ClearConfirmDigest clears the value of the "confirm_digest" field.
+func (ueuo *UserEmailUpdateOne) ClearConfirmDigest() *UserEmailUpdateOne {
+	ueuo.mutation.ClearConfirmDigest()
+	return ueuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (ueuo *UserEmailUpdateOne) SetExpiresAt(t time.Time) *UserEmailUpdateOne {
+	ueuo.mutation.SetExpiresAt(t)
+	return ueuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (ueuo *UserEmailUpdateOne) SetNillableExpiresAt(t *time.Time) *UserEmailUpdateOne {
+	if t != nil {
+		ueuo.SetExpiresAt(*t)
+	}
+	return ueuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (ueuo *UserEmailUpdateOne) ClearExpiresAt() *UserEmailUpdateOne {
+	ueuo.mutation.ClearExpiresAt()
+	return ueuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ueuo *UserEmailUpdateOne) SetUser(u *User) *UserEmailUpdateOne {
+	return ueuo.SetUserID(u.ID)
+}
+
+// Mutation returns the UserEmailMutation object of the builder.
+func (ueuo *UserEmailUpdateOne) Mutation() *UserEmailMutation {
+	return ueuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ueuo *UserEmailUpdateOne) ClearUser() *UserEmailUpdateOne {
+	ueuo.mutation.ClearUser()
+	return ueuo
+}
+
+// Where appends a list predicates to the UserEmailUpdate builder.
+func (ueuo *UserEmailUpdateOne) Where(ps ...predicate.UserEmail) *UserEmailUpdateOne {
+	ueuo.mutation.Where(ps...)
+	return ueuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ueuo *UserEmailUpdateOne) Select(field string, fields ...string) *UserEmailUpdateOne {
+	ueuo.fields = append([]string{field}, fields...)
+	return ueuo
+}
+
+// Save executes the query and returns the updated UserEmail entity.
+func (ueuo *UserEmailUpdateOne) Save(ctx context.Context) (*UserEmail, error) {
+	if err := ueuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, ueuo.sqlSave, ueuo.mutation, ueuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ueuo *UserEmailUpdateOne) SaveX(ctx context.Context) *UserEmail {
+	node, err := ueuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ueuo *UserEmailUpdateOne) Exec(ctx context.Context) error {
+	_, err := ueuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ueuo *UserEmailUpdateOne) ExecX(ctx context.Context) {
+	if err := ueuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ueuo *UserEmailUpdateOne) defaults() error {
+	if _, ok := ueuo.mutation.UpdatedAt(); !ok {
+		if useremail.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized useremail.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := useremail.UpdateDefaultUpdatedAt()
+		ueuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ueuo *UserEmailUpdateOne) check() error {
+	if _, ok := ueuo.mutation.UserID(); ueuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "UserEmail.user"`)
+	}
+	return nil
+}
+
+func (ueuo *UserEmailUpdateOne) sqlSave(ctx context.Context) (_node *UserEmail, err error) {
+	if err := ueuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(useremail.Table, useremail.Columns, sqlgraph.NewFieldSpec(useremail.FieldID, field.TypeInt))
+	id, ok := ueuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserEmail.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ueuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, useremail.FieldID)
+		for _, f := range fields {
+			if !useremail.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != useremail.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ueuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ueuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(useremail.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ueuo.mutation.DeletedAt(); ok {
+		_spec.SetField(useremail.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ueuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(useremail.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := ueuo.mutation.Email(); ok {
+		_spec.SetField(useremail.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := ueuo.mutation.VerifiedAt(); ok {
+		_spec.SetField(useremail.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if ueuo.mutation.VerifiedAtCleared() {
+		_spec.ClearField(useremail.FieldVerifiedAt, field.TypeTime)
+	}
+	if value, ok := ueuo.mutation.ConfirmDigest(); ok {
+		_spec.SetField(useremail.FieldConfirmDigest, field.TypeString, value)
+	}
+	if ueuo.mutation.ConfirmDigestCleared() {
+		_spec.ClearField(useremail.FieldConfirmDigest, field.TypeString)
+	}
+	if value, ok := ueuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(useremail.FieldExpiresAt, field.TypeTime, value)
+	}
+	if ueuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(useremail.FieldExpiresAt, field.TypeTime)
+	}
+	if ueuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   useremail.UserTable,
+			Columns: []string{useremail.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ueuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   useremail.UserTable,
+			Columns: []string{useremail.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &UserEmail{config: ueuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ueuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{useremail.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ueuo.mutation.done = true
+	return _node, nil
+}
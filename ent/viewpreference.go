@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/viewpreference"
+)
+
+// ViewPreference is the model entity for the ViewPreference schema.
+type ViewPreference struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// The folder path this preference applies to
+	FolderPath string `json:"folder_path,omitempty"`
+	// View layout (grid/list/gallery)
+	Layout string `json:"layout,omitempty"`
+	// Show thumbnails in grid view
+	ShowThumb bool `json:"show_thumb,omitempty"`
+	// Sort field
+	SortBy string `json:"sort_by,omitempty"`
+	// Sort direction (asc/desc)
+	SortDirection string `json:"sort_direction,omitempty"`
+	// Pagination size
+	PageSize int `json:"page_size,omitempty"`
+	// Gallery view image width
+	GalleryWidth int `json:"gallery_width,omitempty"`
+	// List view column settings as JSON string
+	ListColumns string `json:"list_columns,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ViewPreferenceQuery when eager-loading is set.
+	Edges                 ViewPreferenceEdges `json:"edges"`
+	user_view_preferences *int
+	selectValues          sql.SelectValues
+}
+
+// ViewPreferenceEdges holds the relations/edges for other nodes in the graph.
+type ViewPreferenceEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ViewPreferenceEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ViewPreference) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case viewpreference.FieldShowThumb:
+			values[i] = new(sql.NullBool)
+		case viewpreference.FieldID, viewpreference.FieldPageSize, viewpreference.FieldGalleryWidth:
+			values[i] = new(sql.NullInt64)
+		case viewpreference.FieldFolderPath, viewpreference.FieldLayout, viewpreference.FieldSortBy, viewpreference.FieldSortDirection, viewpreference.FieldListColumns:
+			values[i] = new(sql.NullString)
+		case viewpreference.FieldCreatedAt, viewpreference.FieldUpdatedAt, viewpreference.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case viewpreference.ForeignKeys[0]: // user_view_preferences
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ViewPreference fields.
+func (vp *ViewPreference) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case viewpreference.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			vp.ID = int(value.Int64)
+		case viewpreference.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				vp.CreatedAt = value.Time
+			}
+		case viewpreference.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				vp.UpdatedAt = value.Time
+			}
+		case viewpreference.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				vp.DeletedAt = new(time.Time)
+				*vp.DeletedAt = value.Time
+			}
+		case viewpreference.FieldFolderPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field folder_path", values[i])
+			} else if value.Valid {
+				vp.FolderPath = value.String
+			}
+		case viewpreference.FieldLayout:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field layout", values[i])
+			} else if value.Valid {
+				vp.Layout = value.String
+			}
+		case viewpreference.FieldShowThumb:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field show_thumb", values[i])
+			} else if value.Valid {
+				vp.ShowThumb = value.Bool
+			}
+		case viewpreference.FieldSortBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_by", values[i])
+			} else if value.Valid {
+				vp.SortBy = value.String
+			}
+		case viewpreference.FieldSortDirection:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_direction", values[i])
+			} else if value.Valid {
+				vp.SortDirection = value.String
+			}
+		case viewpreference.FieldPageSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field page_size", values[i])
+			} else if value.Valid {
+				vp.PageSize = int(value.Int64)
+			}
+		case viewpreference.FieldGalleryWidth:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gallery_width", values[i])
+			} else if value.Valid {
+				vp.GalleryWidth = int(value.Int64)
+			}
+		case viewpreference.FieldListColumns:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field list_columns", values[i])
+			} else if value.Valid {
+				vp.ListColumns = value.String
+			}
+		case viewpreference.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_view_preferences", value)
+			} else if value.Valid {
+				vp.user_view_preferences = new(int)
+				*vp.user_view_preferences = int(value.Int64)
+			}
+		default:
+			vp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ViewPreference.
+// This includes values selected through modifiers, order, etc.
+func (vp *ViewPreference) Value(name string) (ent.Value, error) {
+	return vp.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the ViewPreference entity.
+func (vp *ViewPreference) QueryUser() *UserQuery {
+	return NewViewPreferenceClient(vp.config).QueryUser(vp)
+}
+
+// Update returns a builder for updating this ViewPreference.
+// Note that you need to call ViewPreference.Unwrap() before calling this method if this ViewPreference
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (vp *ViewPreference) Update() *ViewPreferenceUpdateOne {
+	return NewViewPreferenceClient(vp.config).UpdateOne(vp)
+}
+
+// Unwrap unwraps the ViewPreference entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (vp *ViewPreference) Unwrap() *ViewPreference {
+	_tx, ok := vp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ViewPreference is not a transactional entity")
+	}
+	vp.config.driver = _tx.drv
+	return vp
+}
+
+// String implements the fmt.Stringer.
+func (vp *ViewPreference) String() string {
+	var builder strings.Builder
+	builder.WriteString("ViewPreference(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", vp.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(vp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(vp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := vp.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("folder_path=")
+	builder.WriteString(vp.FolderPath)
+	builder.WriteString(", ")
+	builder.WriteString("layout=")
+	builder.WriteString(vp.Layout)
+	builder.WriteString(", ")
+	builder.WriteString("show_thumb=")
+	builder.WriteString(fmt.Sprintf("%v", vp.ShowThumb))
+	builder.WriteString(", ")
+	builder.WriteString("sort_by=")
+	builder.WriteString(vp.SortBy)
+	builder.WriteString(", ")
+	builder.WriteString("sort_direction=")
+	builder.WriteString(vp.SortDirection)
+	builder.WriteString(", ")
+	builder.WriteString("page_size=")
+	builder.WriteString(fmt.Sprintf("%v", vp.PageSize))
+	builder.WriteString(", ")
+	builder.WriteString("gallery_width=")
+	builder.WriteString(fmt.Sprintf("%v", vp.GalleryWidth))
+	builder.WriteString(", ")
+	builder.WriteString("list_columns=")
+	builder.WriteString(vp.ListColumns)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetUser manually set the edge as loaded state.
+func (e *ViewPreference) SetUser(v *User) {
+	e.Edges.User = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// ViewPreferences is a parsable slice of ViewPreference.
+type ViewPreferences []*ViewPreference
@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package viewpreference
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the viewpreference type in the database.
+	Label = "view_preference"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldFolderPath holds the string denoting the folder_path field in the database.
+	FieldFolderPath = "folder_path"
+	// FieldLayout holds the string denoting the layout field in the database.
+	FieldLayout = "layout"
+	// FieldShowThumb holds the string denoting the show_thumb field in the database.
+	FieldShowThumb = "show_thumb"
+	// FieldSortBy holds the string denoting the sort_by field in the database.
+	FieldSortBy = "sort_by"
+	// FieldSortDirection holds the string denoting the sort_direction field in the database.
+	FieldSortDirection = "sort_direction"
+	// FieldPageSize holds the string denoting the page_size field in the database.
+	FieldPageSize = "page_size"
+	// FieldGalleryWidth holds the string denoting the gallery_width field in the database.
+	FieldGalleryWidth = "gallery_width"
+	// FieldListColumns holds the string denoting the list_columns field in the database.
+	FieldListColumns = "list_columns"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the viewpreference in the database.
+	Table = "view_preferences"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "view_preferences"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_view_preferences"
+)
+
+// Columns holds all SQL columns for viewpreference fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldFolderPath,
+	FieldLayout,
+	FieldShowThumb,
+	FieldSortBy,
+	FieldSortDirection,
+	FieldPageSize,
+	FieldGalleryWidth,
+	FieldListColumns,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "view_preferences"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_view_preferences",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// FolderPathValidator is a validator for the "folder_path" field. It is called by the builders before save.
+	FolderPathValidator func(string) error
+	// DefaultLayout holds the default value on creation for the "layout" field.
+	DefaultLayout string
+	// DefaultShowThumb holds the default value on creation for the "show_thumb" field.
+	DefaultShowThumb bool
+	// DefaultSortBy holds the default value on creation for the "sort_by" field.
+	DefaultSortBy string
+	// DefaultSortDirection holds the default value on creation for the "sort_direction" field.
+	DefaultSortDirection string
+	// DefaultPageSize holds the default value on creation for the "page_size" field.
+	DefaultPageSize int
+	// DefaultGalleryWidth holds the default value on creation for the "gallery_width" field.
+	DefaultGalleryWidth int
+	// DefaultListColumns holds the default value on creation for the "list_columns" field.
+	DefaultListColumns string
+)
+
+// OrderOption defines the ordering options for the ViewPreference queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByFolderPath orders the results by the folder_path field.
+func ByFolderPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFolderPath, opts...).ToFunc()
+}
+
+// ByLayout orders the results by the layout field.
+func ByLayout(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLayout, opts...).ToFunc()
+}
+
+// ByShowThumb orders the results by the show_thumb field.
+func ByShowThumb(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShowThumb, opts...).ToFunc()
+}
+
+// BySortBy orders the results by the sort_by field.
+func BySortBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortBy, opts...).ToFunc()
+}
+
+// BySortDirection orders the results by the sort_direction field.
+func BySortDirection(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortDirection, opts...).ToFunc()
+}
+
+// ByPageSize orders the results by the page_size field.
+func ByPageSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPageSize, opts...).ToFunc()
+}
+
+// ByGalleryWidth orders the results by the gallery_width field.
+func ByGalleryWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGalleryWidth, opts...).ToFunc()
+}
+
+// ByListColumns orders the results by the list_columns field.
+func ByListColumns(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldListColumns, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
@@ -0,0 +1,694 @@
+// Code generated by ent, DO NOT EDIT.
+
+package viewpreference
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// FolderPath applies equality check predicate on the "folder_path" field. It's identical to FolderPathEQ.
+func FolderPath(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldFolderPath, v))
+}
+
+// Layout applies equality check predicate on the "layout" field. It's identical to LayoutEQ.
+func Layout(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldLayout, v))
+}
+
+// ShowThumb applies equality check predicate on the "show_thumb" field. It's identical to ShowThumbEQ.
+func ShowThumb(v bool) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldShowThumb, v))
+}
+
+// SortBy applies equality check predicate on the "sort_by" field. It's identical to SortByEQ.
+func SortBy(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldSortBy, v))
+}
+
+// SortDirection applies equality check predicate on the "sort_direction" field. It's identical to SortDirectionEQ.
+func SortDirection(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldSortDirection, v))
+}
+
+// PageSize applies equality check predicate on the "page_size" field. It's identical to PageSizeEQ.
+func PageSize(v int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldPageSize, v))
+}
+
+// GalleryWidth applies equality check predicate on the "gallery_width" field. It's identical to GalleryWidthEQ.
+func GalleryWidth(v int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldGalleryWidth, v))
+}
+
+// ListColumns applies equality check predicate on the "list_columns" field. It's identical to ListColumnsEQ.
+func ListColumns(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldListColumns, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// FolderPathEQ applies the EQ predicate on the "folder_path" field.
+func FolderPathEQ(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldFolderPath, v))
+}
+
+// FolderPathNEQ applies the NEQ predicate on the "folder_path" field.
+func FolderPathNEQ(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNEQ(FieldFolderPath, v))
+}
+
+// FolderPathIn applies the In predicate on the "folder_path" field.
+func FolderPathIn(vs ...string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldIn(FieldFolderPath, vs...))
+}
+
+// FolderPathNotIn applies the NotIn predicate on the "folder_path" field.
+func FolderPathNotIn(vs ...string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNotIn(FieldFolderPath, vs...))
+}
+
+// FolderPathGT applies the GT predicate on the "folder_path" field.
+func FolderPathGT(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGT(FieldFolderPath, v))
+}
+
+// FolderPathGTE applies the GTE predicate on the "folder_path" field.
+func FolderPathGTE(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGTE(FieldFolderPath, v))
+}
+
+// FolderPathLT applies the LT predicate on the "folder_path" field.
+func FolderPathLT(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLT(FieldFolderPath, v))
+}
+
+// FolderPathLTE applies the LTE predicate on the "folder_path" field.
+func FolderPathLTE(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLTE(FieldFolderPath, v))
+}
+
+// FolderPathContains applies the Contains predicate on the "folder_path" field.
+func FolderPathContains(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldContains(FieldFolderPath, v))
+}
+
+// FolderPathHasPrefix applies the HasPrefix predicate on the "folder_path" field.
+func FolderPathHasPrefix(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldHasPrefix(FieldFolderPath, v))
+}
+
+// FolderPathHasSuffix applies the HasSuffix predicate on the "folder_path" field.
+func FolderPathHasSuffix(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldHasSuffix(FieldFolderPath, v))
+}
+
+// FolderPathEqualFold applies the EqualFold predicate on the "folder_path" field.
+func FolderPathEqualFold(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEqualFold(FieldFolderPath, v))
+}
+
+// FolderPathContainsFold applies the ContainsFold predicate on the "folder_path" field.
+func FolderPathContainsFold(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldContainsFold(FieldFolderPath, v))
+}
+
+// LayoutEQ applies the EQ predicate on the "layout" field.
+func LayoutEQ(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldLayout, v))
+}
+
+// LayoutNEQ applies the NEQ predicate on the "layout" field.
+func LayoutNEQ(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNEQ(FieldLayout, v))
+}
+
+// LayoutIn applies the In predicate on the "layout" field.
+func LayoutIn(vs ...string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldIn(FieldLayout, vs...))
+}
+
+// LayoutNotIn applies the NotIn predicate on the "layout" field.
+func LayoutNotIn(vs ...string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNotIn(FieldLayout, vs...))
+}
+
+// LayoutGT applies the GT predicate on the "layout" field.
+func LayoutGT(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGT(FieldLayout, v))
+}
+
+// LayoutGTE applies the GTE predicate on the "layout" field.
+func LayoutGTE(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGTE(FieldLayout, v))
+}
+
+// LayoutLT applies the LT predicate on the "layout" field.
+func LayoutLT(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLT(FieldLayout, v))
+}
+
+// LayoutLTE applies the LTE predicate on the "layout" field.
+func LayoutLTE(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLTE(FieldLayout, v))
+}
+
+// LayoutContains applies the Contains predicate on the "layout" field.
+func LayoutContains(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldContains(FieldLayout, v))
+}
+
+// LayoutHasPrefix applies the HasPrefix predicate on the "layout" field.
+func LayoutHasPrefix(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldHasPrefix(FieldLayout, v))
+}
+
+// LayoutHasSuffix applies the HasSuffix predicate on the "layout" field.
+func LayoutHasSuffix(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldHasSuffix(FieldLayout, v))
+}
+
+// LayoutEqualFold applies the EqualFold predicate on the "layout" field.
+func LayoutEqualFold(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEqualFold(FieldLayout, v))
+}
+
+// LayoutContainsFold applies the ContainsFold predicate on the "layout" field.
+func LayoutContainsFold(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldContainsFold(FieldLayout, v))
+}
+
+// ShowThumbEQ applies the EQ predicate on the "show_thumb" field.
+func ShowThumbEQ(v bool) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldShowThumb, v))
+}
+
+// ShowThumbNEQ applies the NEQ predicate on the "show_thumb" field.
+func ShowThumbNEQ(v bool) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNEQ(FieldShowThumb, v))
+}
+
+// SortByEQ applies the EQ predicate on the "sort_by" field.
+func SortByEQ(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldSortBy, v))
+}
+
+// SortByNEQ applies the NEQ predicate on the "sort_by" field.
+func SortByNEQ(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNEQ(FieldSortBy, v))
+}
+
+// SortByIn applies the In predicate on the "sort_by" field.
+func SortByIn(vs ...string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldIn(FieldSortBy, vs...))
+}
+
+// SortByNotIn applies the NotIn predicate on the "sort_by" field.
+func SortByNotIn(vs ...string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNotIn(FieldSortBy, vs...))
+}
+
+// SortByGT applies the GT predicate on the "sort_by" field.
+func SortByGT(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGT(FieldSortBy, v))
+}
+
+// SortByGTE applies the GTE predicate on the "sort_by" field.
+func SortByGTE(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGTE(FieldSortBy, v))
+}
+
+// SortByLT applies the LT predicate on the "sort_by" field.
+func SortByLT(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLT(FieldSortBy, v))
+}
+
+// SortByLTE applies the LTE predicate on the "sort_by" field.
+func SortByLTE(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLTE(FieldSortBy, v))
+}
+
+// SortByContains applies the Contains predicate on the "sort_by" field.
+func SortByContains(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldContains(FieldSortBy, v))
+}
+
+// SortByHasPrefix applies the HasPrefix predicate on the "sort_by" field.
+func SortByHasPrefix(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldHasPrefix(FieldSortBy, v))
+}
+
+// SortByHasSuffix applies the HasSuffix predicate on the "sort_by" field.
+func SortByHasSuffix(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldHasSuffix(FieldSortBy, v))
+}
+
+// SortByEqualFold applies the EqualFold predicate on the "sort_by" field.
+func SortByEqualFold(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEqualFold(FieldSortBy, v))
+}
+
+// SortByContainsFold applies the ContainsFold predicate on the "sort_by" field.
+func SortByContainsFold(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldContainsFold(FieldSortBy, v))
+}
+
+// SortDirectionEQ applies the EQ predicate on the "sort_direction" field.
+func SortDirectionEQ(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldSortDirection, v))
+}
+
+// SortDirectionNEQ applies the NEQ predicate on the "sort_direction" field.
+func SortDirectionNEQ(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNEQ(FieldSortDirection, v))
+}
+
+// SortDirectionIn applies the In predicate on the "sort_direction" field.
+func SortDirectionIn(vs ...string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldIn(FieldSortDirection, vs...))
+}
+
+// SortDirectionNotIn applies the NotIn predicate on the "sort_direction" field.
+func SortDirectionNotIn(vs ...string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNotIn(FieldSortDirection, vs...))
+}
+
+// SortDirectionGT applies the GT predicate on the "sort_direction" field.
+func SortDirectionGT(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGT(FieldSortDirection, v))
+}
+
+// SortDirectionGTE applies the GTE predicate on the "sort_direction" field.
+func SortDirectionGTE(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGTE(FieldSortDirection, v))
+}
+
+// SortDirectionLT applies the LT predicate on the "sort_direction" field.
+func SortDirectionLT(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLT(FieldSortDirection, v))
+}
+
+// SortDirectionLTE applies the LTE predicate on the "sort_direction" field.
+func SortDirectionLTE(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLTE(FieldSortDirection, v))
+}
+
+// SortDirectionContains applies the Contains predicate on the "sort_direction" field.
+func SortDirectionContains(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldContains(FieldSortDirection, v))
+}
+
+// SortDirectionHasPrefix applies the HasPrefix predicate on the "sort_direction" field.
+func SortDirectionHasPrefix(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldHasPrefix(FieldSortDirection, v))
+}
+
+// SortDirectionHasSuffix applies the HasSuffix predicate on the "sort_direction" field.
+func SortDirectionHasSuffix(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldHasSuffix(FieldSortDirection, v))
+}
+
+// SortDirectionEqualFold applies the EqualFold predicate on the "sort_direction" field.
+func SortDirectionEqualFold(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEqualFold(FieldSortDirection, v))
+}
+
+// SortDirectionContainsFold applies the ContainsFold predicate on the "sort_direction" field.
+func SortDirectionContainsFold(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldContainsFold(FieldSortDirection, v))
+}
+
+// PageSizeEQ applies the EQ predicate on the "page_size" field.
+func PageSizeEQ(v int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldPageSize, v))
+}
+
+// PageSizeNEQ applies the NEQ predicate on the "page_size" field.
+func PageSizeNEQ(v int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNEQ(FieldPageSize, v))
+}
+
+// PageSizeIn applies the In predicate on the "page_size" field.
+func PageSizeIn(vs ...int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldIn(FieldPageSize, vs...))
+}
+
+// PageSizeNotIn applies the NotIn predicate on the "page_size" field.
+func PageSizeNotIn(vs ...int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNotIn(FieldPageSize, vs...))
+}
+
+// PageSizeGT applies the GT predicate on the "page_size" field.
+func PageSizeGT(v int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGT(FieldPageSize, v))
+}
+
+// PageSizeGTE applies the GTE predicate on the "page_size" field.
+func PageSizeGTE(v int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGTE(FieldPageSize, v))
+}
+
+// PageSizeLT applies the LT predicate on the "page_size" field.
+func PageSizeLT(v int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLT(FieldPageSize, v))
+}
+
+// PageSizeLTE applies the LTE predicate on the "page_size" field.
+func PageSizeLTE(v int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLTE(FieldPageSize, v))
+}
+
+// GalleryWidthEQ applies the EQ predicate on the "gallery_width" field.
+func GalleryWidthEQ(v int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldGalleryWidth, v))
+}
+
+// GalleryWidthNEQ applies the NEQ predicate on the "gallery_width" field.
+func GalleryWidthNEQ(v int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNEQ(FieldGalleryWidth, v))
+}
+
+// GalleryWidthIn applies the In predicate on the "gallery_width" field.
+func GalleryWidthIn(vs ...int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldIn(FieldGalleryWidth, vs...))
+}
+
+// GalleryWidthNotIn applies the NotIn predicate on the "gallery_width" field.
+func GalleryWidthNotIn(vs ...int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNotIn(FieldGalleryWidth, vs...))
+}
+
+// GalleryWidthGT applies the GT predicate on the "gallery_width" field.
+func GalleryWidthGT(v int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGT(FieldGalleryWidth, v))
+}
+
+// GalleryWidthGTE applies the GTE predicate on the "gallery_width" field.
+func GalleryWidthGTE(v int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGTE(FieldGalleryWidth, v))
+}
+
+// GalleryWidthLT applies the LT predicate on the "gallery_width" field.
+func GalleryWidthLT(v int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLT(FieldGalleryWidth, v))
+}
+
+// GalleryWidthLTE applies the LTE predicate on the "gallery_width" field.
+func GalleryWidthLTE(v int) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLTE(FieldGalleryWidth, v))
+}
+
+// ListColumnsEQ applies the EQ predicate on the "list_columns" field.
+func ListColumnsEQ(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEQ(FieldListColumns, v))
+}
+
+// ListColumnsNEQ applies the NEQ predicate on the "list_columns" field.
+func ListColumnsNEQ(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNEQ(FieldListColumns, v))
+}
+
+// ListColumnsIn applies the In predicate on the "list_columns" field.
+func ListColumnsIn(vs ...string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldIn(FieldListColumns, vs...))
+}
+
+// ListColumnsNotIn applies the NotIn predicate on the "list_columns" field.
+func ListColumnsNotIn(vs ...string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldNotIn(FieldListColumns, vs...))
+}
+
+// ListColumnsGT applies the GT predicate on the "list_columns" field.
+func ListColumnsGT(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGT(FieldListColumns, v))
+}
+
+// ListColumnsGTE applies the GTE predicate on the "list_columns" field.
+func ListColumnsGTE(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldGTE(FieldListColumns, v))
+}
+
+// ListColumnsLT applies the LT predicate on the "list_columns" field.
+func ListColumnsLT(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLT(FieldListColumns, v))
+}
+
+// ListColumnsLTE applies the LTE predicate on the "list_columns" field.
+func ListColumnsLTE(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldLTE(FieldListColumns, v))
+}
+
+// ListColumnsContains applies the Contains predicate on the "list_columns" field.
+func ListColumnsContains(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldContains(FieldListColumns, v))
+}
+
+// ListColumnsHasPrefix applies the HasPrefix predicate on the "list_columns" field.
+func ListColumnsHasPrefix(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldHasPrefix(FieldListColumns, v))
+}
+
+// ListColumnsHasSuffix applies the HasSuffix predicate on the "list_columns" field.
+func ListColumnsHasSuffix(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldHasSuffix(FieldListColumns, v))
+}
+
+// ListColumnsEqualFold applies the EqualFold predicate on the "list_columns" field.
+func ListColumnsEqualFold(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldEqualFold(FieldListColumns, v))
+}
+
+// ListColumnsContainsFold applies the ContainsFold predicate on the "list_columns" field.
+func ListColumnsContainsFold(v string) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.FieldContainsFold(FieldListColumns, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ViewPreference {
+	return predicate.ViewPreference(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ViewPreference {
+	return predicate.ViewPreference(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ViewPreference) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ViewPreference) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ViewPreference) predicate.ViewPreference {
+	return predicate.ViewPreference(sql.NotPredicates(p))
+}
@@ -0,0 +1,1194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/viewpreference"
+)
+
+// ViewPreferenceCreate is the builder for creating a ViewPreference entity.
+type ViewPreferenceCreate struct {
+	config
+	mutation *ViewPreferenceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (vpc *ViewPreferenceCreate) SetCreatedAt(t time.Time) *ViewPreferenceCreate {
+	vpc.mutation.SetCreatedAt(t)
+	return vpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (vpc *ViewPreferenceCreate) SetNillableCreatedAt(t *time.Time) *ViewPreferenceCreate {
+	if t != nil {
+		vpc.SetCreatedAt(*t)
+	}
+	return vpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (vpc *ViewPreferenceCreate) SetUpdatedAt(t time.Time) *ViewPreferenceCreate {
+	vpc.mutation.SetUpdatedAt(t)
+	return vpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (vpc *ViewPreferenceCreate) SetNillableUpdatedAt(t *time.Time) *ViewPreferenceCreate {
+	if t != nil {
+		vpc.SetUpdatedAt(*t)
+	}
+	return vpc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (vpc *ViewPreferenceCreate) SetDeletedAt(t time.Time) *ViewPreferenceCreate {
+	vpc.mutation.SetDeletedAt(t)
+	return vpc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (vpc *ViewPreferenceCreate) SetNillableDeletedAt(t *time.Time) *ViewPreferenceCreate {
+	if t != nil {
+		vpc.SetDeletedAt(*t)
+	}
+	return vpc
+}
+
+// SetFolderPath sets the "folder_path" field.
+func (vpc *ViewPreferenceCreate) SetFolderPath(s string) *ViewPreferenceCreate {
+	vpc.mutation.SetFolderPath(s)
+	return vpc
+}
+
+// SetLayout sets the "layout" field.
+func (vpc *ViewPreferenceCreate) SetLayout(s string) *ViewPreferenceCreate {
+	vpc.mutation.SetLayout(s)
+	return vpc
+}
+
+// SetNillableLayout sets the "layout" field if the given value is not nil.
+func (vpc *ViewPreferenceCreate) SetNillableLayout(s *string) *ViewPreferenceCreate {
+	if s != nil {
+		vpc.SetLayout(*s)
+	}
+	return vpc
+}
+
+// SetShowThumb sets the "show_thumb" field.
+func (vpc *ViewPreferenceCreate) SetShowThumb(b bool) *ViewPreferenceCreate {
+	vpc.mutation.SetShowThumb(b)
+	return vpc
+}
+
+// SetNillableShowThumb sets the "show_thumb" field if the given value is not nil.
+func (vpc *ViewPreferenceCreate) SetNillableShowThumb(b *bool) *ViewPreferenceCreate {
+	if b != nil {
+		vpc.SetShowThumb(*b)
+	}
+	return vpc
+}
+
+// SetSortBy sets the "sort_by" field.
+func (vpc *ViewPreferenceCreate) SetSortBy(s string) *ViewPreferenceCreate {
+	vpc.mutation.SetSortBy(s)
+	return vpc
+}
+
+// SetNillableSortBy sets the "sort_by" field if the given value is not nil.
+func (vpc *ViewPreferenceCreate) SetNillableSortBy(s *string) *ViewPreferenceCreate {
+	if s != nil {
+		vpc.SetSortBy(*s)
+	}
+	return vpc
+}
+
+// SetSortDirection sets the "sort_direction" field.
+func (vpc *ViewPreferenceCreate) SetSortDirection(s string) *ViewPreferenceCreate {
+	vpc.mutation.SetSortDirection(s)
+	return vpc
+}
+
+// SetNillableSortDirection sets the "sort_direction" field if the given value is not nil.
+func (vpc *ViewPreferenceCreate) SetNillableSortDirection(s *string) *ViewPreferenceCreate {
+	if s != nil {
+		vpc.SetSortDirection(*s)
+	}
+	return vpc
+}
+
+// SetPageSize sets the "page_size" field.
+func (vpc *ViewPreferenceCreate) SetPageSize(i int) *ViewPreferenceCreate {
+	vpc.mutation.SetPageSize(i)
+	return vpc
+}
+
+// SetNillablePageSize sets the "page_size" field if the given value is not nil.
+func (vpc *ViewPreferenceCreate) SetNillablePageSize(i *int) *ViewPreferenceCreate {
+	if i != nil {
+		vpc.SetPageSize(*i)
+	}
+	return vpc
+}
+
+// SetGalleryWidth sets the "gallery_width" field.
+func (vpc *ViewPreferenceCreate) SetGalleryWidth(i int) *ViewPreferenceCreate {
+	vpc.mutation.SetGalleryWidth(i)
+	return vpc
+}
+
+// SetNillableGalleryWidth sets the "gallery_width" field if the given value is not nil.
+func (vpc *ViewPreferenceCreate) SetNillableGalleryWidth(i *int) *ViewPreferenceCreate {
+	if i != nil {
+		vpc.SetGalleryWidth(*i)
+	}
+	return vpc
+}
+
+// SetListColumns sets the "list_columns" field.
+func (vpc *ViewPreferenceCreate) SetListColumns(s string) *ViewPreferenceCreate {
+	vpc.mutation.SetListColumns(s)
+	return vpc
+}
+
+// SetNillableListColumns sets the "list_columns" field if the given value is not nil.
+func (vpc *ViewPreferenceCreate) SetNillableListColumns(s *string) *ViewPreferenceCreate {
+	if s != nil {
+		vpc.SetListColumns(*s)
+	}
+	return vpc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (vpc *ViewPreferenceCreate) SetUserID(id int) *ViewPreferenceCreate {
+	vpc.mutation.SetUserID(id)
+	return vpc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (vpc *ViewPreferenceCreate) SetUser(u *User) *ViewPreferenceCreate {
+	return vpc.SetUserID(u.ID)
+}
+
+// Mutation returns the ViewPreferenceMutation object of the builder.
+func (vpc *ViewPreferenceCreate) Mutation() *ViewPreferenceMutation {
+	return vpc.mutation
+}
+
+// Save creates the ViewPreference in the database.
+func (vpc *ViewPreferenceCreate) Save(ctx context.Context) (*ViewPreference, error) {
+	if err := vpc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, vpc.sqlSave, vpc.mutation, vpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vpc *ViewPreferenceCreate) SaveX(ctx context.Context) *ViewPreference {
+	v, err := vpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vpc *ViewPreferenceCreate) Exec(ctx context.Context) error {
+	_, err := vpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vpc *ViewPreferenceCreate) ExecX(ctx context.Context) {
+	if err := vpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vpc *ViewPreferenceCreate) defaults() error {
+	if _, ok := vpc.mutation.CreatedAt(); !ok {
+		if viewpreference.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized viewpreference.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := viewpreference.DefaultCreatedAt()
+		vpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := vpc.mutation.UpdatedAt(); !ok {
+		if viewpreference.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized viewpreference.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := viewpreference.DefaultUpdatedAt()
+		vpc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := vpc.mutation.Layout(); !ok {
+		v := viewpreference.DefaultLayout
+		vpc.mutation.SetLayout(v)
+	}
+	if _, ok := vpc.mutation.ShowThumb(); !ok {
+		v := viewpreference.DefaultShowThumb
+		vpc.mutation.SetShowThumb(v)
+	}
+	if _, ok := vpc.mutation.SortBy(); !ok {
+		v := viewpreference.DefaultSortBy
+		vpc.mutation.SetSortBy(v)
+	}
+	if _, ok := vpc.mutation.SortDirection(); !ok {
+		v := viewpreference.DefaultSortDirection
+		vpc.mutation.SetSortDirection(v)
+	}
+	if _, ok := vpc.mutation.PageSize(); !ok {
+		v := viewpreference.DefaultPageSize
+		vpc.mutation.SetPageSize(v)
+	}
+	if _, ok := vpc.mutation.GalleryWidth(); !ok {
+		v := viewpreference.DefaultGalleryWidth
+		vpc.mutation.SetGalleryWidth(v)
+	}
+	if _, ok := vpc.mutation.ListColumns(); !ok {
+		v := viewpreference.DefaultListColumns
+		vpc.mutation.SetListColumns(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vpc *ViewPreferenceCreate) check() error {
+	if _, ok := vpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ViewPreference.created_at"`)}
+	}
+	if _, ok := vpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ViewPreference.updated_at"`)}
+	}
+	if _, ok := vpc.mutation.FolderPath(); !ok {
+		return &ValidationError{Name: "folder_path", err: errors.New(`ent: missing required field "ViewPreference.folder_path"`)}
+	}
+	if v, ok := vpc.mutation.FolderPath(); ok {
+		if err := viewpreference.FolderPathValidator(v); err != nil {
+			return &ValidationError{Name: "folder_path", err: fmt.Errorf(`ent: validator failed for field "ViewPreference.folder_path": %w`, err)}
+		}
+	}
+	if _, ok := vpc.mutation.Layout(); !ok {
+		return &ValidationError{Name: "layout", err: errors.New(`ent: missing required field "ViewPreference.layout"`)}
+	}
+	if _, ok := vpc.mutation.ShowThumb(); !ok {
+		return &ValidationError{Name: "show_thumb", err: errors.New(`ent: missing required field "ViewPreference.show_thumb"`)}
+	}
+	if _, ok := vpc.mutation.SortBy(); !ok {
+		return &ValidationError{Name: "sort_by", err: errors.New(`ent: missing required field "ViewPreference.sort_by"`)}
+	}
+	if _, ok := vpc.mutation.SortDirection(); !ok {
+		return &ValidationError{Name: "sort_direction", err: errors.New(`ent: missing required field "ViewPreference.sort_direction"`)}
+	}
+	if _, ok := vpc.mutation.PageSize(); !ok {
+		return &ValidationError{Name: "page_size", err: errors.New(`ent: missing required field "ViewPreference.page_size"`)}
+	}
+	if _, ok := vpc.mutation.GalleryWidth(); !ok {
+		return &ValidationError{Name: "gallery_width", err: errors.New(`ent: missing required field "ViewPreference.gallery_width"`)}
+	}
+	if _, ok := vpc.mutation.ListColumns(); !ok {
+		return &ValidationError{Name: "list_columns", err: errors.New(`ent: missing required field "ViewPreference.list_columns"`)}
+	}
+	if _, ok := vpc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ViewPreference.user"`)}
+	}
+	return nil
+}
+
+func (vpc *ViewPreferenceCreate) sqlSave(ctx context.Context) (*ViewPreference, error) {
+	if err := vpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	vpc.mutation.id = &_node.ID
+	vpc.mutation.done = true
+	return _node, nil
+}
+
+func (vpc *ViewPreferenceCreate) createSpec() (*ViewPreference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ViewPreference{config: vpc.config}
+		_spec = sqlgraph.NewCreateSpec(viewpreference.Table, sqlgraph.NewFieldSpec(viewpreference.FieldID, field.TypeInt))
+	)
+
+	if id, ok := vpc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = vpc.conflict
+	if value, ok := vpc.mutation.CreatedAt(); ok {
+		_spec.SetField(viewpreference.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := vpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(viewpreference.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := vpc.mutation.DeletedAt(); ok {
+		_spec.SetField(viewpreference.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := vpc.mutation.FolderPath(); ok {
+		_spec.SetField(viewpreference.FieldFolderPath, field.TypeString, value)
+		_node.FolderPath = value
+	}
+	if value, ok := vpc.mutation.Layout(); ok {
+		_spec.SetField(viewpreference.FieldLayout, field.TypeString, value)
+		_node.Layout = value
+	}
+	if value, ok := vpc.mutation.ShowThumb(); ok {
+		_spec.SetField(viewpreference.FieldShowThumb, field.TypeBool, value)
+		_node.ShowThumb = value
+	}
+	if value, ok := vpc.mutation.SortBy(); ok {
+		_spec.SetField(viewpreference.FieldSortBy, field.TypeString, value)
+		_node.SortBy = value
+	}
+	if value, ok := vpc.mutation.SortDirection(); ok {
+		_spec.SetField(viewpreference.FieldSortDirection, field.TypeString, value)
+		_node.SortDirection = value
+	}
+	if value, ok := vpc.mutation.PageSize(); ok {
+		_spec.SetField(viewpreference.FieldPageSize, field.TypeInt, value)
+		_node.PageSize = value
+	}
+	if value, ok := vpc.mutation.GalleryWidth(); ok {
+		_spec.SetField(viewpreference.FieldGalleryWidth, field.TypeInt, value)
+		_node.GalleryWidth = value
+	}
+	if value, ok := vpc.mutation.ListColumns(); ok {
+		_spec.SetField(viewpreference.FieldListColumns, field.TypeString, value)
+		_node.ListColumns = value
+	}
+	if nodes := vpc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   viewpreference.UserTable,
+			Columns: []string{viewpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_view_preferences = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ViewPreference.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ViewPreferenceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (vpc *ViewPreferenceCreate) OnConflict(opts ...sql.ConflictOption) *ViewPreferenceUpsertOne {
+	vpc.conflict = opts
+	return &ViewPreferenceUpsertOne{
+		create: vpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ViewPreference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vpc *ViewPreferenceCreate) OnConflictColumns(columns ...string) *ViewPreferenceUpsertOne {
+	vpc.conflict = append(vpc.conflict, sql.ConflictColumns(columns...))
+	return &ViewPreferenceUpsertOne{
+		create: vpc,
+	}
+}
+
+type (
+	// ViewPreferenceUpsertOne is the builder for "upsert"-ing
+	//  one ViewPreference node.
+	ViewPreferenceUpsertOne struct {
+		create *ViewPreferenceCreate
+	}
+
+	// ViewPreferenceUpsert is the "OnConflict" setter.
+	ViewPreferenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ViewPreferenceUpsert) SetUpdatedAt(v time.Time) *ViewPreferenceUpsert {
+	u.Set(viewpreference.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ViewPreferenceUpsert) UpdateUpdatedAt() *ViewPreferenceUpsert {
+	u.SetExcluded(viewpreference.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ViewPreferenceUpsert) SetDeletedAt(v time.Time) *ViewPreferenceUpsert {
+	u.Set(viewpreference.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ViewPreferenceUpsert) UpdateDeletedAt() *ViewPreferenceUpsert {
+	u.SetExcluded(viewpreference.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ViewPreferenceUpsert) ClearDeletedAt() *ViewPreferenceUpsert {
+	u.SetNull(viewpreference.FieldDeletedAt)
+	return u
+}
+
+// SetFolderPath sets the "folder_path" field.
+func (u *ViewPreferenceUpsert) SetFolderPath(v string) *ViewPreferenceUpsert {
+	u.Set(viewpreference.FieldFolderPath, v)
+	return u
+}
+
+// UpdateFolderPath sets the "folder_path" field to the value that was provided on create.
+func (u *ViewPreferenceUpsert) UpdateFolderPath() *ViewPreferenceUpsert {
+	u.SetExcluded(viewpreference.FieldFolderPath)
+	return u
+}
+
+// SetLayout sets the "layout" field.
+func (u *ViewPreferenceUpsert) SetLayout(v string) *ViewPreferenceUpsert {
+	u.Set(viewpreference.FieldLayout, v)
+	return u
+}
+
+// UpdateLayout sets the "layout" field to the value that was provided on create.
+func (u *ViewPreferenceUpsert) UpdateLayout() *ViewPreferenceUpsert {
+	u.SetExcluded(viewpreference.FieldLayout)
+	return u
+}
+
+// SetShowThumb sets the "show_thumb" field.
+func (u *ViewPreferenceUpsert) SetShowThumb(v bool) *ViewPreferenceUpsert {
+	u.Set(viewpreference.FieldShowThumb, v)
+	return u
+}
+
+// UpdateShowThumb sets the "show_thumb" field to the value that was provided on create.
+func (u *ViewPreferenceUpsert) UpdateShowThumb() *ViewPreferenceUpsert {
+	u.SetExcluded(viewpreference.FieldShowThumb)
+	return u
+}
+
+// SetSortBy sets the "sort_by" field.
+func (u *ViewPreferenceUpsert) SetSortBy(v string) *ViewPreferenceUpsert {
+	u.Set(viewpreference.FieldSortBy, v)
+	return u
+}
+
+// UpdateSortBy sets the "sort_by" field to the value that was provided on create.
+func (u *ViewPreferenceUpsert) UpdateSortBy() *ViewPreferenceUpsert {
+	u.SetExcluded(viewpreference.FieldSortBy)
+	return u
+}
+
+// SetSortDirection sets the "sort_direction" field.
+func (u *ViewPreferenceUpsert) SetSortDirection(v string) *ViewPreferenceUpsert {
+	u.Set(viewpreference.FieldSortDirection, v)
+	return u
+}
+
+// UpdateSortDirection sets the "sort_direction" field to the value that was provided on create.
+func (u *ViewPreferenceUpsert) UpdateSortDirection() *ViewPreferenceUpsert {
+	u.SetExcluded(viewpreference.FieldSortDirection)
+	return u
+}
+
+// SetPageSize sets the "page_size" field.
+func (u *ViewPreferenceUpsert) SetPageSize(v int) *ViewPreferenceUpsert {
+	u.Set(viewpreference.FieldPageSize, v)
+	return u
+}
+
+// UpdatePageSize sets the "page_size" field to the value that was provided on create.
+func (u *ViewPreferenceUpsert) UpdatePageSize() *ViewPreferenceUpsert {
+	u.SetExcluded(viewpreference.FieldPageSize)
+	return u
+}
+
+// AddPageSize adds v to the "page_size" field.
+func (u *ViewPreferenceUpsert) AddPageSize(v int) *ViewPreferenceUpsert {
+	u.Add(viewpreference.FieldPageSize, v)
+	return u
+}
+
+// SetGalleryWidth sets the "gallery_width" field.
+func (u *ViewPreferenceUpsert) SetGalleryWidth(v int) *ViewPreferenceUpsert {
+	u.Set(viewpreference.FieldGalleryWidth, v)
+	return u
+}
+
+// UpdateGalleryWidth sets the "gallery_width" field to the value that was provided on create.
+func (u *ViewPreferenceUpsert) UpdateGalleryWidth() *ViewPreferenceUpsert {
+	u.SetExcluded(viewpreference.FieldGalleryWidth)
+	return u
+}
+
+// AddGalleryWidth adds v to the "gallery_width" field.
+func (u *ViewPreferenceUpsert) AddGalleryWidth(v int) *ViewPreferenceUpsert {
+	u.Add(viewpreference.FieldGalleryWidth, v)
+	return u
+}
+
+// SetListColumns sets the "list_columns" field.
+func (u *ViewPreferenceUpsert) SetListColumns(v string) *ViewPreferenceUpsert {
+	u.Set(viewpreference.FieldListColumns, v)
+	return u
+}
+
+// UpdateListColumns sets the "list_columns" field to the value that was provided on create.
+func (u *ViewPreferenceUpsert) UpdateListColumns() *ViewPreferenceUpsert {
+	u.SetExcluded(viewpreference.FieldListColumns)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ViewPreference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ViewPreferenceUpsertOne) UpdateNewValues() *ViewPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(viewpreference.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ViewPreference.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ViewPreferenceUpsertOne) Ignore() *ViewPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ViewPreferenceUpsertOne) DoNothing() *ViewPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ViewPreferenceCreate.OnConflict
+// documentation for more info.
+func (u *ViewPreferenceUpsertOne) Update(set func(*ViewPreferenceUpsert)) *ViewPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ViewPreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ViewPreferenceUpsertOne) SetUpdatedAt(v time.Time) *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertOne) UpdateUpdatedAt() *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ViewPreferenceUpsertOne) SetDeletedAt(v time.Time) *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertOne) UpdateDeletedAt() *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ViewPreferenceUpsertOne) ClearDeletedAt() *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetFolderPath sets the "folder_path" field.
+func (u *ViewPreferenceUpsertOne) SetFolderPath(v string) *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetFolderPath(v)
+	})
+}
+
+// UpdateFolderPath sets the "folder_path" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertOne) UpdateFolderPath() *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateFolderPath()
+	})
+}
+
+// SetLayout sets the "layout" field.
+func (u *ViewPreferenceUpsertOne) SetLayout(v string) *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetLayout(v)
+	})
+}
+
+// UpdateLayout sets the "layout" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertOne) UpdateLayout() *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateLayout()
+	})
+}
+
+// SetShowThumb sets the "show_thumb" field.
+func (u *ViewPreferenceUpsertOne) SetShowThumb(v bool) *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetShowThumb(v)
+	})
+}
+
+// UpdateShowThumb sets the "show_thumb" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertOne) UpdateShowThumb() *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateShowThumb()
+	})
+}
+
+// SetSortBy sets the "sort_by" field.
+func (u *ViewPreferenceUpsertOne) SetSortBy(v string) *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetSortBy(v)
+	})
+}
+
+// UpdateSortBy sets the "sort_by" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertOne) UpdateSortBy() *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateSortBy()
+	})
+}
+
+// SetSortDirection sets the "sort_direction" field.
+func (u *ViewPreferenceUpsertOne) SetSortDirection(v string) *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetSortDirection(v)
+	})
+}
+
+// UpdateSortDirection sets the "sort_direction" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertOne) UpdateSortDirection() *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateSortDirection()
+	})
+}
+
+// SetPageSize sets the "page_size" field.
+func (u *ViewPreferenceUpsertOne) SetPageSize(v int) *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetPageSize(v)
+	})
+}
+
+// AddPageSize adds v to the "page_size" field.
+func (u *ViewPreferenceUpsertOne) AddPageSize(v int) *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.AddPageSize(v)
+	})
+}
+
+// UpdatePageSize sets the "page_size" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertOne) UpdatePageSize() *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdatePageSize()
+	})
+}
+
+// SetGalleryWidth sets the "gallery_width" field.
+func (u *ViewPreferenceUpsertOne) SetGalleryWidth(v int) *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetGalleryWidth(v)
+	})
+}
+
+// AddGalleryWidth adds v to the "gallery_width" field.
+func (u *ViewPreferenceUpsertOne) AddGalleryWidth(v int) *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.AddGalleryWidth(v)
+	})
+}
+
+// UpdateGalleryWidth sets the "gallery_width" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertOne) UpdateGalleryWidth() *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateGalleryWidth()
+	})
+}
+
+// SetListColumns sets the "list_columns" field.
+func (u *ViewPreferenceUpsertOne) SetListColumns(v string) *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetListColumns(v)
+	})
+}
+
+// UpdateListColumns sets the "list_columns" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertOne) UpdateListColumns() *ViewPreferenceUpsertOne {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateListColumns()
+	})
+}
+
+// Exec executes the query.
+func (u *ViewPreferenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ViewPreferenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ViewPreferenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ViewPreferenceUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ViewPreferenceUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *ViewPreferenceCreate) SetRawID(t int) *ViewPreferenceCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// ViewPreferenceCreateBulk is the builder for creating many ViewPreference entities in bulk.
+type ViewPreferenceCreateBulk struct {
+	config
+	err      error
+	builders []*ViewPreferenceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ViewPreference entities in the database.
+func (vpcb *ViewPreferenceCreateBulk) Save(ctx context.Context) ([]*ViewPreference, error) {
+	if vpcb.err != nil {
+		return nil, vpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(vpcb.builders))
+	nodes := make([]*ViewPreference, len(vpcb.builders))
+	mutators := make([]Mutator, len(vpcb.builders))
+	for i := range vpcb.builders {
+		func(i int, root context.Context) {
+			builder := vpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ViewPreferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = vpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vpcb *ViewPreferenceCreateBulk) SaveX(ctx context.Context) []*ViewPreference {
+	v, err := vpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vpcb *ViewPreferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := vpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vpcb *ViewPreferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := vpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ViewPreference.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ViewPreferenceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (vpcb *ViewPreferenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *ViewPreferenceUpsertBulk {
+	vpcb.conflict = opts
+	return &ViewPreferenceUpsertBulk{
+		create: vpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ViewPreference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (vpcb *ViewPreferenceCreateBulk) OnConflictColumns(columns ...string) *ViewPreferenceUpsertBulk {
+	vpcb.conflict = append(vpcb.conflict, sql.ConflictColumns(columns...))
+	return &ViewPreferenceUpsertBulk{
+		create: vpcb,
+	}
+}
+
+// ViewPreferenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of ViewPreference nodes.
+type ViewPreferenceUpsertBulk struct {
+	create *ViewPreferenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ViewPreference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ViewPreferenceUpsertBulk) UpdateNewValues() *ViewPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(viewpreference.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ViewPreference.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ViewPreferenceUpsertBulk) Ignore() *ViewPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ViewPreferenceUpsertBulk) DoNothing() *ViewPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ViewPreferenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *ViewPreferenceUpsertBulk) Update(set func(*ViewPreferenceUpsert)) *ViewPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ViewPreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ViewPreferenceUpsertBulk) SetUpdatedAt(v time.Time) *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertBulk) UpdateUpdatedAt() *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ViewPreferenceUpsertBulk) SetDeletedAt(v time.Time) *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertBulk) UpdateDeletedAt() *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ViewPreferenceUpsertBulk) ClearDeletedAt() *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetFolderPath sets the "folder_path" field.
+func (u *ViewPreferenceUpsertBulk) SetFolderPath(v string) *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetFolderPath(v)
+	})
+}
+
+// UpdateFolderPath sets the "folder_path" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertBulk) UpdateFolderPath() *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateFolderPath()
+	})
+}
+
+// SetLayout sets the "layout" field.
+func (u *ViewPreferenceUpsertBulk) SetLayout(v string) *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetLayout(v)
+	})
+}
+
+// UpdateLayout sets the "layout" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertBulk) UpdateLayout() *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateLayout()
+	})
+}
+
+// SetShowThumb sets the "show_thumb" field.
+func (u *ViewPreferenceUpsertBulk) SetShowThumb(v bool) *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetShowThumb(v)
+	})
+}
+
+// UpdateShowThumb sets the "show_thumb" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertBulk) UpdateShowThumb() *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateShowThumb()
+	})
+}
+
+// SetSortBy sets the "sort_by" field.
+func (u *ViewPreferenceUpsertBulk) SetSortBy(v string) *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetSortBy(v)
+	})
+}
+
+// UpdateSortBy sets the "sort_by" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertBulk) UpdateSortBy() *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateSortBy()
+	})
+}
+
+// SetSortDirection sets the "sort_direction" field.
+func (u *ViewPreferenceUpsertBulk) SetSortDirection(v string) *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetSortDirection(v)
+	})
+}
+
+// UpdateSortDirection sets the "sort_direction" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertBulk) UpdateSortDirection() *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateSortDirection()
+	})
+}
+
+// SetPageSize sets the "page_size" field.
+func (u *ViewPreferenceUpsertBulk) SetPageSize(v int) *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetPageSize(v)
+	})
+}
+
+// AddPageSize adds v to the "page_size" field.
+func (u *ViewPreferenceUpsertBulk) AddPageSize(v int) *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.AddPageSize(v)
+	})
+}
+
+// UpdatePageSize sets the "page_size" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertBulk) UpdatePageSize() *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdatePageSize()
+	})
+}
+
+// SetGalleryWidth sets the "gallery_width" field.
+func (u *ViewPreferenceUpsertBulk) SetGalleryWidth(v int) *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetGalleryWidth(v)
+	})
+}
+
+// AddGalleryWidth adds v to the "gallery_width" field.
+func (u *ViewPreferenceUpsertBulk) AddGalleryWidth(v int) *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.AddGalleryWidth(v)
+	})
+}
+
+// UpdateGalleryWidth sets the "gallery_width" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertBulk) UpdateGalleryWidth() *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateGalleryWidth()
+	})
+}
+
+// SetListColumns sets the "list_columns" field.
+func (u *ViewPreferenceUpsertBulk) SetListColumns(v string) *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.SetListColumns(v)
+	})
+}
+
+// UpdateListColumns sets the "list_columns" field to the value that was provided on create.
+func (u *ViewPreferenceUpsertBulk) UpdateListColumns() *ViewPreferenceUpsertBulk {
+	return u.Update(func(s *ViewPreferenceUpsert) {
+		s.UpdateListColumns()
+	})
+}
+
+// Exec executes the query.
+func (u *ViewPreferenceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ViewPreferenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ViewPreferenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ViewPreferenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/viewpreference"
+)
+
+// ViewPreferenceDelete is the builder for deleting a ViewPreference entity.
+type ViewPreferenceDelete struct {
+	config
+	hooks    []Hook
+	mutation *ViewPreferenceMutation
+}
+
+// Where appends a list predicates to the ViewPreferenceDelete builder.
+func (vpd *ViewPreferenceDelete) Where(ps ...predicate.ViewPreference) *ViewPreferenceDelete {
+	vpd.mutation.Where(ps...)
+	return vpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (vpd *ViewPreferenceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, vpd.sqlExec, vpd.mutation, vpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vpd *ViewPreferenceDelete) ExecX(ctx context.Context) int {
+	n, err := vpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (vpd *ViewPreferenceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(viewpreference.Table, sqlgraph.NewFieldSpec(viewpreference.FieldID, field.TypeInt))
+	if ps := vpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, vpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	vpd.mutation.done = true
+	return affected, err
+}
+
+// ViewPreferenceDeleteOne is the builder for deleting a single ViewPreference entity.
+type ViewPreferenceDeleteOne struct {
+	vpd *ViewPreferenceDelete
+}
+
+// Where appends a list predicates to the ViewPreferenceDelete builder.
+func (vpdo *ViewPreferenceDeleteOne) Where(ps ...predicate.ViewPreference) *ViewPreferenceDeleteOne {
+	vpdo.vpd.mutation.Where(ps...)
+	return vpdo
+}
+
+// Exec executes the deletion query.
+func (vpdo *ViewPreferenceDeleteOne) Exec(ctx context.Context) error {
+	n, err := vpdo.vpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{viewpreference.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vpdo *ViewPreferenceDeleteOne) ExecX(ctx context.Context) {
+	if err := vpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,626 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/viewpreference"
+)
+
+// ViewPreferenceQuery is the builder for querying ViewPreference entities.
+type ViewPreferenceQuery struct {
+	config
+	ctx        *QueryContext
+	order      []viewpreference.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ViewPreference
+	withUser   *UserQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*ViewPreference) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ViewPreferenceQuery builder.
+func (vpq *ViewPreferenceQuery) Where(ps ...predicate.ViewPreference) *ViewPreferenceQuery {
+	vpq.predicates = append(vpq.predicates, ps...)
+	return vpq
+}
+
+// Limit the number of records to be returned by this query.
+func (vpq *ViewPreferenceQuery) Limit(limit int) *ViewPreferenceQuery {
+	vpq.ctx.Limit = &limit
+	return vpq
+}
+
+// Offset to start from.
+func (vpq *ViewPreferenceQuery) Offset(offset int) *ViewPreferenceQuery {
+	vpq.ctx.Offset = &offset
+	return vpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (vpq *ViewPreferenceQuery) Unique(unique bool) *ViewPreferenceQuery {
+	vpq.ctx.Unique = &unique
+	return vpq
+}
+
+// Order specifies how the records should be ordered.
+func (vpq *ViewPreferenceQuery) Order(o ...viewpreference.OrderOption) *ViewPreferenceQuery {
+	vpq.order = append(vpq.order, o...)
+	return vpq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (vpq *ViewPreferenceQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: vpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := vpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := vpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(viewpreference.Table, viewpreference.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, viewpreference.UserTable, viewpreference.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(vpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ViewPreference entity from the query.
+// Returns a *NotFoundError when no ViewPreference was found.
+func (vpq *ViewPreferenceQuery) First(ctx context.Context) (*ViewPreference, error) {
+	nodes, err := vpq.Limit(1).All(setContextOp(ctx, vpq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{viewpreference.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (vpq *ViewPreferenceQuery) FirstX(ctx context.Context) *ViewPreference {
+	node, err := vpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ViewPreference ID from the query.
+// Returns a *NotFoundError when no ViewPreference ID was found.
+func (vpq *ViewPreferenceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = vpq.Limit(1).IDs(setContextOp(ctx, vpq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{viewpreference.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (vpq *ViewPreferenceQuery) FirstIDX(ctx context.Context) int {
+	id, err := vpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ViewPreference entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ViewPreference entity is found.
+// Returns a *NotFoundError when no ViewPreference entities are found.
+func (vpq *ViewPreferenceQuery) Only(ctx context.Context) (*ViewPreference, error) {
+	nodes, err := vpq.Limit(2).All(setContextOp(ctx, vpq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{viewpreference.Label}
+	default:
+		return nil, &NotSingularError{viewpreference.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (vpq *ViewPreferenceQuery) OnlyX(ctx context.Context) *ViewPreference {
+	node, err := vpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ViewPreference ID in the query.
+// Returns a *NotSingularError when more than one ViewPreference ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (vpq *ViewPreferenceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = vpq.Limit(2).IDs(setContextOp(ctx, vpq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{viewpreference.Label}
+	default:
+		err = &NotSingularError{viewpreference.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (vpq *ViewPreferenceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := vpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ViewPreferences.
+func (vpq *ViewPreferenceQuery) All(ctx context.Context) ([]*ViewPreference, error) {
+	ctx = setContextOp(ctx, vpq.ctx, "All")
+	if err := vpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ViewPreference, *ViewPreferenceQuery]()
+	return withInterceptors[[]*ViewPreference](ctx, vpq, qr, vpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (vpq *ViewPreferenceQuery) AllX(ctx context.Context) []*ViewPreference {
+	nodes, err := vpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ViewPreference IDs.
+func (vpq *ViewPreferenceQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if vpq.ctx.Unique == nil && vpq.path != nil {
+		vpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, vpq.ctx, "IDs")
+	if err = vpq.Select(viewpreference.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (vpq *ViewPreferenceQuery) IDsX(ctx context.Context) []int {
+	ids, err := vpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (vpq *ViewPreferenceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, vpq.ctx, "Count")
+	if err := vpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, vpq, querierCount[*ViewPreferenceQuery](), vpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (vpq *ViewPreferenceQuery) CountX(ctx context.Context) int {
+	count, err := vpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (vpq *ViewPreferenceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, vpq.ctx, "Exist")
+	switch _, err := vpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (vpq *ViewPreferenceQuery) ExistX(ctx context.Context) bool {
+	exist, err := vpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ViewPreferenceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (vpq *ViewPreferenceQuery) Clone() *ViewPreferenceQuery {
+	if vpq == nil {
+		return nil
+	}
+	return &ViewPreferenceQuery{
+		config:     vpq.config,
+		ctx:        vpq.ctx.Clone(),
+		order:      append([]viewpreference.OrderOption{}, vpq.order...),
+		inters:     append([]Interceptor{}, vpq.inters...),
+		predicates: append([]predicate.ViewPreference{}, vpq.predicates...),
+		withUser:   vpq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  vpq.sql.Clone(),
+		path: vpq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (vpq *ViewPreferenceQuery) WithUser(opts ...func(*UserQuery)) *ViewPreferenceQuery {
+	query := (&UserClient{config: vpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	vpq.withUser = query
+	return vpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ViewPreference.Query().
+//		GroupBy(viewpreference.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (vpq *ViewPreferenceQuery) GroupBy(field string, fields ...string) *ViewPreferenceGroupBy {
+	vpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ViewPreferenceGroupBy{build: vpq}
+	grbuild.flds = &vpq.ctx.Fields
+	grbuild.label = viewpreference.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ViewPreference.Query().
+//		Select(viewpreference.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (vpq *ViewPreferenceQuery) Select(fields ...string) *ViewPreferenceSelect {
+	vpq.ctx.Fields = append(vpq.ctx.Fields, fields...)
+	sbuild := &ViewPreferenceSelect{ViewPreferenceQuery: vpq}
+	sbuild.label = viewpreference.Label
+	sbuild.flds, sbuild.scan = &vpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ViewPreferenceSelect configured with the given aggregations.
+func (vpq *ViewPreferenceQuery) Aggregate(fns ...AggregateFunc) *ViewPreferenceSelect {
+	return vpq.Select().Aggregate(fns...)
+}
+
+func (vpq *ViewPreferenceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range vpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, vpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range vpq.ctx.Fields {
+		if !viewpreference.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if vpq.path != nil {
+		prev, err := vpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		vpq.sql = prev
+	}
+	return nil
+}
+
+func (vpq *ViewPreferenceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ViewPreference, error) {
+	var (
+		nodes       = []*ViewPreference{}
+		withFKs     = vpq.withFKs
+		_spec       = vpq.querySpec()
+		loadedTypes = [1]bool{
+			vpq.withUser != nil,
+		}
+	)
+	if vpq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, viewpreference.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ViewPreference).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ViewPreference{config: vpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(vpq.modifiers) > 0 {
+		_spec.Modifiers = vpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, vpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := vpq.withUser; query != nil {
+		if err := vpq.loadUser(ctx, query, nodes, nil,
+			func(n *ViewPreference, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range vpq.loadTotal {
+		if err := vpq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (vpq *ViewPreferenceQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*ViewPreference, init func(*ViewPreference), assign func(*ViewPreference, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ViewPreference)
+	for i := range nodes {
+		if nodes[i].user_view_preferences == nil {
+			continue
+		}
+		fk := *nodes[i].user_view_preferences
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_view_preferences" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (vpq *ViewPreferenceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := vpq.querySpec()
+	if len(vpq.modifiers) > 0 {
+		_spec.Modifiers = vpq.modifiers
+	}
+	_spec.Node.Columns = vpq.ctx.Fields
+	if len(vpq.ctx.Fields) > 0 {
+		_spec.Unique = vpq.ctx.Unique != nil && *vpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, vpq.driver, _spec)
+}
+
+func (vpq *ViewPreferenceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(viewpreference.Table, viewpreference.Columns, sqlgraph.NewFieldSpec(viewpreference.FieldID, field.TypeInt))
+	_spec.From = vpq.sql
+	if unique := vpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if vpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := vpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, viewpreference.FieldID)
+		for i := range fields {
+			if fields[i] != viewpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := vpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := vpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := vpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := vpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (vpq *ViewPreferenceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(vpq.driver.Dialect())
+	t1 := builder.Table(viewpreference.Table)
+	columns := vpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = viewpreference.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if vpq.sql != nil {
+		selector = vpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if vpq.ctx.Unique != nil && *vpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range vpq.predicates {
+		p(selector)
+	}
+	for _, p := range vpq.order {
+		p(selector)
+	}
+	if offset := vpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := vpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ViewPreferenceGroupBy is the group-by builder for ViewPreference entities.
+type ViewPreferenceGroupBy struct {
+	selector
+	build *ViewPreferenceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (vpgb *ViewPreferenceGroupBy) Aggregate(fns ...AggregateFunc) *ViewPreferenceGroupBy {
+	vpgb.fns = append(vpgb.fns, fns...)
+	return vpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vpgb *ViewPreferenceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vpgb.build.ctx, "GroupBy")
+	if err := vpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ViewPreferenceQuery, *ViewPreferenceGroupBy](ctx, vpgb.build, vpgb, vpgb.build.inters, v)
+}
+
+func (vpgb *ViewPreferenceGroupBy) sqlScan(ctx context.Context, root *ViewPreferenceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(vpgb.fns))
+	for _, fn := range vpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*vpgb.flds)+len(vpgb.fns))
+		for _, f := range *vpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*vpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ViewPreferenceSelect is the builder for selecting fields of ViewPreference entities.
+type ViewPreferenceSelect struct {
+	*ViewPreferenceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (vps *ViewPreferenceSelect) Aggregate(fns ...AggregateFunc) *ViewPreferenceSelect {
+	vps.fns = append(vps.fns, fns...)
+	return vps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vps *ViewPreferenceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vps.ctx, "Select")
+	if err := vps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ViewPreferenceQuery, *ViewPreferenceSelect](ctx, vps.ViewPreferenceQuery, vps, vps.inters, v)
+}
+
+func (vps *ViewPreferenceSelect) sqlScan(ctx context.Context, root *ViewPreferenceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(vps.fns))
+	for _, fn := range vps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*vps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
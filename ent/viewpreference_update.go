@@ -0,0 +1,713 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/viewpreference"
+)
+
+// ViewPreferenceUpdate is the builder for updating ViewPreference entities.
+type ViewPreferenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ViewPreferenceMutation
+}
+
+// Where appends a list predicates to the ViewPreferenceUpdate builder.
+func (vpu *ViewPreferenceUpdate) Where(ps ...predicate.ViewPreference) *ViewPreferenceUpdate {
+	vpu.mutation.Where(ps...)
+	return vpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (vpu *ViewPreferenceUpdate) SetUpdatedAt(t time.Time) *ViewPreferenceUpdate {
+	vpu.mutation.SetUpdatedAt(t)
+	return vpu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (vpu *ViewPreferenceUpdate) SetDeletedAt(t time.Time) *ViewPreferenceUpdate {
+	vpu.mutation.SetDeletedAt(t)
+	return vpu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (vpu *ViewPreferenceUpdate) SetNillableDeletedAt(t *time.Time) *ViewPreferenceUpdate {
+	if t != nil {
+		vpu.SetDeletedAt(*t)
+	}
+	return vpu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (vpu *ViewPreferenceUpdate) ClearDeletedAt() *ViewPreferenceUpdate {
+	vpu.mutation.ClearDeletedAt()
+	return vpu
+}
+
+// SetFolderPath sets the "folder_path" field.
+func (vpu *ViewPreferenceUpdate) SetFolderPath(s string) *ViewPreferenceUpdate {
+	vpu.mutation.SetFolderPath(s)
+	return vpu
+}
+
+// SetNillableFolderPath sets the "folder_path" field if the given value is not nil.
+func (vpu *ViewPreferenceUpdate) SetNillableFolderPath(s *string) *ViewPreferenceUpdate {
+	if s != nil {
+		vpu.SetFolderPath(*s)
+	}
+	return vpu
+}
+
+// SetLayout sets the "layout" field.
+func (vpu *ViewPreferenceUpdate) SetLayout(s string) *ViewPreferenceUpdate {
+	vpu.mutation.SetLayout(s)
+	return vpu
+}
+
+// SetNillableLayout sets the "layout" field if the given value is not nil.
+func (vpu *ViewPreferenceUpdate) SetNillableLayout(s *string) *ViewPreferenceUpdate {
+	if s != nil {
+		vpu.SetLayout(*s)
+	}
+	return vpu
+}
+
+// SetShowThumb sets the "show_thumb" field.
+func (vpu *ViewPreferenceUpdate) SetShowThumb(b bool) *ViewPreferenceUpdate {
+	vpu.mutation.SetShowThumb(b)
+	return vpu
+}
+
+// SetNillableShowThumb sets the "show_thumb" field if the given value is not nil.
+func (vpu *ViewPreferenceUpdate) SetNillableShowThumb(b *bool) *ViewPreferenceUpdate {
+	if b != nil {
+		vpu.SetShowThumb(*b)
+	}
+	return vpu
+}
+
+// SetSortBy sets the "sort_by" field.
+func (vpu *ViewPreferenceUpdate) SetSortBy(s string) *ViewPreferenceUpdate {
+	vpu.mutation.SetSortBy(s)
+	return vpu
+}
+
+// SetNillableSortBy sets the "sort_by" field if the given value is not nil.
+func (vpu *ViewPreferenceUpdate) SetNillableSortBy(s *string) *ViewPreferenceUpdate {
+	if s != nil {
+		vpu.SetSortBy(*s)
+	}
+	return vpu
+}
+
+// SetSortDirection sets the "sort_direction" field.
+func (vpu *ViewPreferenceUpdate) SetSortDirection(s string) *ViewPreferenceUpdate {
+	vpu.mutation.SetSortDirection(s)
+	return vpu
+}
+
+// SetNillableSortDirection sets the "sort_direction" field if the given value is not nil.
+func (vpu *ViewPreferenceUpdate) SetNillableSortDirection(s *string) *ViewPreferenceUpdate {
+	if s != nil {
+		vpu.SetSortDirection(*s)
+	}
+	return vpu
+}
+
+// SetPageSize sets the "page_size" field.
+func (vpu *ViewPreferenceUpdate) SetPageSize(i int) *ViewPreferenceUpdate {
+	vpu.mutation.ResetPageSize()
+	vpu.mutation.SetPageSize(i)
+	return vpu
+}
+
+// SetNillablePageSize sets the "page_size" field if the given value is not nil.
+func (vpu *ViewPreferenceUpdate) SetNillablePageSize(i *int) *ViewPreferenceUpdate {
+	if i != nil {
+		vpu.SetPageSize(*i)
+	}
+	return vpu
+}
+
+// AddPageSize adds i to the "page_size" field.
+func (vpu *ViewPreferenceUpdate) AddPageSize(i int) *ViewPreferenceUpdate {
+	vpu.mutation.AddPageSize(i)
+	return vpu
+}
+
+// SetGalleryWidth sets the "gallery_width" field.
+func (vpu *ViewPreferenceUpdate) SetGalleryWidth(i int) *ViewPreferenceUpdate {
+	vpu.mutation.ResetGalleryWidth()
+	vpu.mutation.SetGalleryWidth(i)
+	return vpu
+}
+
+// SetNillableGalleryWidth sets the "gallery_width" field if the given value is not nil.
+func (vpu *ViewPreferenceUpdate) SetNillableGalleryWidth(i *int) *ViewPreferenceUpdate {
+	if i != nil {
+		vpu.SetGalleryWidth(*i)
+	}
+	return vpu
+}
+
+// AddGalleryWidth adds i to the "gallery_width" field.
+func (vpu *ViewPreferenceUpdate) AddGalleryWidth(i int) *ViewPreferenceUpdate {
+	vpu.mutation.AddGalleryWidth(i)
+	return vpu
+}
+
+// SetListColumns sets the "list_columns" field.
+func (vpu *ViewPreferenceUpdate) SetListColumns(s string) *ViewPreferenceUpdate {
+	vpu.mutation.SetListColumns(s)
+	return vpu
+}
+
+// SetNillableListColumns sets the "list_columns" field if the given value is not nil.
+func (vpu *ViewPreferenceUpdate) SetNillableListColumns(s *string) *ViewPreferenceUpdate {
+	if s != nil {
+		vpu.SetListColumns(*s)
+	}
+	return vpu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (vpu *ViewPreferenceUpdate) SetUserID(id int) *ViewPreferenceUpdate {
+	vpu.mutation.SetUserID(id)
+	return vpu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (vpu *ViewPreferenceUpdate) SetUser(u *User) *ViewPreferenceUpdate {
+	return vpu.SetUserID(u.ID)
+}
+
+// Mutation returns the ViewPreferenceMutation object of the builder.
+func (vpu *ViewPreferenceUpdate) Mutation() *ViewPreferenceMutation {
+	return vpu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (vpu *ViewPreferenceUpdate) ClearUser() *ViewPreferenceUpdate {
+	vpu.mutation.ClearUser()
+	return vpu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vpu *ViewPreferenceUpdate) Save(ctx context.Context) (int, error) {
+	if err := vpu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, vpu.sqlSave, vpu.mutation, vpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vpu *ViewPreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := vpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vpu *ViewPreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := vpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vpu *ViewPreferenceUpdate) ExecX(ctx context.Context) {
+	if err := vpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vpu *ViewPreferenceUpdate) defaults() error {
+	if _, ok := vpu.mutation.UpdatedAt(); !ok {
+		if viewpreference.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized viewpreference.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := viewpreference.UpdateDefaultUpdatedAt()
+		vpu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vpu *ViewPreferenceUpdate) check() error {
+	if v, ok := vpu.mutation.FolderPath(); ok {
+		if err := viewpreference.FolderPathValidator(v); err != nil {
+			return &ValidationError{Name: "folder_path", err: fmt.Errorf(`ent: validator failed for field "ViewPreference.folder_path": %w`, err)}
+		}
+	}
+	if _, ok := vpu.mutation.UserID(); vpu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ViewPreference.user"`)
+	}
+	return nil
+}
+
+func (vpu *ViewPreferenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := vpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(viewpreference.Table, viewpreference.Columns, sqlgraph.NewFieldSpec(viewpreference.FieldID, field.TypeInt))
+	if ps := vpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(viewpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := vpu.mutation.DeletedAt(); ok {
+		_spec.SetField(viewpreference.FieldDeletedAt, field.TypeTime, value)
+	}
+	if vpu.mutation.DeletedAtCleared() {
+		_spec.ClearField(viewpreference.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := vpu.mutation.FolderPath(); ok {
+		_spec.SetField(viewpreference.FieldFolderPath, field.TypeString, value)
+	}
+	if value, ok := vpu.mutation.Layout(); ok {
+		_spec.SetField(viewpreference.FieldLayout, field.TypeString, value)
+	}
+	if value, ok := vpu.mutation.ShowThumb(); ok {
+		_spec.SetField(viewpreference.FieldShowThumb, field.TypeBool, value)
+	}
+	if value, ok := vpu.mutation.SortBy(); ok {
+		_spec.SetField(viewpreference.FieldSortBy, field.TypeString, value)
+	}
+	if value, ok := vpu.mutation.SortDirection(); ok {
+		_spec.SetField(viewpreference.FieldSortDirection, field.TypeString, value)
+	}
+	if value, ok := vpu.mutation.PageSize(); ok {
+		_spec.SetField(viewpreference.FieldPageSize, field.TypeInt, value)
+	}
+	if value, ok := vpu.mutation.AddedPageSize(); ok {
+		_spec.AddField(viewpreference.FieldPageSize, field.TypeInt, value)
+	}
+	if value, ok := vpu.mutation.GalleryWidth(); ok {
+		_spec.SetField(viewpreference.FieldGalleryWidth, field.TypeInt, value)
+	}
+	if value, ok := vpu.mutation.AddedGalleryWidth(); ok {
+		_spec.AddField(viewpreference.FieldGalleryWidth, field.TypeInt, value)
+	}
+	if value, ok := vpu.mutation.ListColumns(); ok {
+		_spec.SetField(viewpreference.FieldListColumns, field.TypeString, value)
+	}
+	if vpu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   viewpreference.UserTable,
+			Columns: []string{viewpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vpu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   viewpreference.UserTable,
+			Columns: []string{viewpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, vpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{viewpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vpu.mutation.done = true
+	return n, nil
+}
+
+// ViewPreferenceUpdateOne is the builder for updating a single ViewPreference entity.
+type ViewPreferenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ViewPreferenceMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (vpuo *ViewPreferenceUpdateOne) SetUpdatedAt(t time.Time) *ViewPreferenceUpdateOne {
+	vpuo.mutation.SetUpdatedAt(t)
+	return vpuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (vpuo *ViewPreferenceUpdateOne) SetDeletedAt(t time.Time) *ViewPreferenceUpdateOne {
+	vpuo.mutation.SetDeletedAt(t)
+	return vpuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (vpuo *ViewPreferenceUpdateOne) SetNillableDeletedAt(t *time.Time) *ViewPreferenceUpdateOne {
+	if t != nil {
+		vpuo.SetDeletedAt(*t)
+	}
+	return vpuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (vpuo *ViewPreferenceUpdateOne) ClearDeletedAt() *ViewPreferenceUpdateOne {
+	vpuo.mutation.ClearDeletedAt()
+	return vpuo
+}
+
+// SetFolderPath sets the "folder_path" field.
+func (vpuo *ViewPreferenceUpdateOne) SetFolderPath(s string) *ViewPreferenceUpdateOne {
+	vpuo.mutation.SetFolderPath(s)
+	return vpuo
+}
+
+// SetNillableFolderPath sets the "folder_path" field if the given value is not nil.
+func (vpuo *ViewPreferenceUpdateOne) SetNillableFolderPath(s *string) *ViewPreferenceUpdateOne {
+	if s != nil {
+		vpuo.SetFolderPath(*s)
+	}
+	return vpuo
+}
+
+// SetLayout sets the "layout" field.
+func (vpuo *ViewPreferenceUpdateOne) SetLayout(s string) *ViewPreferenceUpdateOne {
+	vpuo.mutation.SetLayout(s)
+	return vpuo
+}
+
+// SetNillableLayout sets the "layout" field if the given value is not nil.
+func (vpuo *ViewPreferenceUpdateOne) SetNillableLayout(s *string) *ViewPreferenceUpdateOne {
+	if s != nil {
+		vpuo.SetLayout(*s)
+	}
+	return vpuo
+}
+
+// SetShowThumb sets the "show_thumb" field.
+func (vpuo *ViewPreferenceUpdateOne) SetShowThumb(b bool) *ViewPreferenceUpdateOne {
+	vpuo.mutation.SetShowThumb(b)
+	return vpuo
+}
+
+// SetNillableShowThumb sets the "show_thumb" field if the given value is not nil.
+func (vpuo *ViewPreferenceUpdateOne) SetNillableShowThumb(b *bool) *ViewPreferenceUpdateOne {
+	if b != nil {
+		vpuo.SetShowThumb(*b)
+	}
+	return vpuo
+}
+
+// SetSortBy sets the "sort_by" field.
+func (vpuo *ViewPreferenceUpdateOne) SetSortBy(s string) *ViewPreferenceUpdateOne {
+	vpuo.mutation.SetSortBy(s)
+	return vpuo
+}
+
+// SetNillableSortBy sets the "sort_by" field if the given value is not nil.
+func (vpuo *ViewPreferenceUpdateOne) SetNillableSortBy(s *string) *ViewPreferenceUpdateOne {
+	if s != nil {
+		vpuo.SetSortBy(*s)
+	}
+	return vpuo
+}
+
+// SetSortDirection sets the "sort_direction" field.
+func (vpuo *ViewPreferenceUpdateOne) SetSortDirection(s string) *ViewPreferenceUpdateOne {
+	vpuo.mutation.SetSortDirection(s)
+	return vpuo
+}
+
+// SetNillableSortDirection sets the "sort_direction" field if the given value is not nil.
+func (vpuo *ViewPreferenceUpdateOne) SetNillableSortDirection(s *string) *ViewPreferenceUpdateOne {
+	if s != nil {
+		vpuo.SetSortDirection(*s)
+	}
+	return vpuo
+}
+
+// SetPageSize sets the "page_size" field.
+func (vpuo *ViewPreferenceUpdateOne) SetPageSize(i int) *ViewPreferenceUpdateOne {
+	vpuo.mutation.ResetPageSize()
+	vpuo.mutation.SetPageSize(i)
+	return vpuo
+}
+
+// SetNillablePageSize sets the "page_size" field if the given value is not nil.
+func (vpuo *ViewPreferenceUpdateOne) SetNillablePageSize(i *int) *ViewPreferenceUpdateOne {
+	if i != nil {
+		vpuo.SetPageSize(*i)
+	}
+	return vpuo
+}
+
+// AddPageSize adds i to the "page_size" field.
+func (vpuo *ViewPreferenceUpdateOne) AddPageSize(i int) *ViewPreferenceUpdateOne {
+	vpuo.mutation.AddPageSize(i)
+	return vpuo
+}
+
+// SetGalleryWidth sets the "gallery_width" field.
+func (vpuo *ViewPreferenceUpdateOne) SetGalleryWidth(i int) *ViewPreferenceUpdateOne {
+	vpuo.mutation.ResetGalleryWidth()
+	vpuo.mutation.SetGalleryWidth(i)
+	return vpuo
+}
+
+// SetNillableGalleryWidth sets the "gallery_width" field if the given value is not nil.
+func (vpuo *ViewPreferenceUpdateOne) SetNillableGalleryWidth(i *int) *ViewPreferenceUpdateOne {
+	if i != nil {
+		vpuo.SetGalleryWidth(*i)
+	}
+	return vpuo
+}
+
+// AddGalleryWidth adds i to the "gallery_width" field.
+func (vpuo *ViewPreferenceUpdateOne) AddGalleryWidth(i int) *ViewPreferenceUpdateOne {
+	vpuo.mutation.AddGalleryWidth(i)
+	return vpuo
+}
+
+// SetListColumns sets the "list_columns" field.
+func (vpuo *ViewPreferenceUpdateOne) SetListColumns(s string) *ViewPreferenceUpdateOne {
+	vpuo.mutation.SetListColumns(s)
+	return vpuo
+}
+
+// SetNillableListColumns sets the "list_columns" field if the given value is not nil.
+func (vpuo *ViewPreferenceUpdateOne) SetNillableListColumns(s *string) *ViewPreferenceUpdateOne {
+	if s != nil {
+		vpuo.SetListColumns(*s)
+	}
+	return vpuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (vpuo *ViewPreferenceUpdateOne) SetUserID(id int) *ViewPreferenceUpdateOne {
+	vpuo.mutation.SetUserID(id)
+	return vpuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (vpuo *ViewPreferenceUpdateOne) SetUser(u *User) *ViewPreferenceUpdateOne {
+	return vpuo.SetUserID(u.ID)
+}
+
+// Mutation returns the ViewPreferenceMutation object of the builder.
+func (vpuo *ViewPreferenceUpdateOne) Mutation() *ViewPreferenceMutation {
+	return vpuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (vpuo *ViewPreferenceUpdateOne) ClearUser() *ViewPreferenceUpdateOne {
+	vpuo.mutation.ClearUser()
+	return vpuo
+}
+
+// Where appends a list predicates to the ViewPreferenceUpdate builder.
+func (vpuo *ViewPreferenceUpdateOne) Where(ps ...predicate.ViewPreference) *ViewPreferenceUpdateOne {
+	vpuo.mutation.Where(ps...)
+	return vpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vpuo *ViewPreferenceUpdateOne) Select(field string, fields ...string) *ViewPreferenceUpdateOne {
+	vpuo.fields = append([]string{field}, fields...)
+	return vpuo
+}
+
+// Save executes the query and returns the updated ViewPreference entity.
+func (vpuo *ViewPreferenceUpdateOne) Save(ctx context.Context) (*ViewPreference, error) {
+	if err := vpuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, vpuo.sqlSave, vpuo.mutation, vpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vpuo *ViewPreferenceUpdateOne) SaveX(ctx context.Context) *ViewPreference {
+	node, err := vpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vpuo *ViewPreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := vpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vpuo *ViewPreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := vpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vpuo *ViewPreferenceUpdateOne) defaults() error {
+	if _, ok := vpuo.mutation.UpdatedAt(); !ok {
+		if viewpreference.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized viewpreference.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := viewpreference.UpdateDefaultUpdatedAt()
+		vpuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vpuo *ViewPreferenceUpdateOne) check() error {
+	if v, ok := vpuo.mutation.FolderPath(); ok {
+		if err := viewpreference.FolderPathValidator(v); err != nil {
+			return &ValidationError{Name: "folder_path", err: fmt.Errorf(`ent: validator failed for field "ViewPreference.folder_path": %w`, err)}
+		}
+	}
+	if _, ok := vpuo.mutation.UserID(); vpuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ViewPreference.user"`)
+	}
+	return nil
+}
+
+func (vpuo *ViewPreferenceUpdateOne) sqlSave(ctx context.Context) (_node *ViewPreference, err error) {
+	if err := vpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(viewpreference.Table, viewpreference.Columns, sqlgraph.NewFieldSpec(viewpreference.FieldID, field.TypeInt))
+	id, ok := vpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ViewPreference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, viewpreference.FieldID)
+		for _, f := range fields {
+			if !viewpreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != viewpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(viewpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := vpuo.mutation.DeletedAt(); ok {
+		_spec.SetField(viewpreference.FieldDeletedAt, field.TypeTime, value)
+	}
+	if vpuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(viewpreference.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := vpuo.mutation.FolderPath(); ok {
+		_spec.SetField(viewpreference.FieldFolderPath, field.TypeString, value)
+	}
+	if value, ok := vpuo.mutation.Layout(); ok {
+		_spec.SetField(viewpreference.FieldLayout, field.TypeString, value)
+	}
+	if value, ok := vpuo.mutation.ShowThumb(); ok {
+		_spec.SetField(viewpreference.FieldShowThumb, field.TypeBool, value)
+	}
+	if value, ok := vpuo.mutation.SortBy(); ok {
+		_spec.SetField(viewpreference.FieldSortBy, field.TypeString, value)
+	}
+	if value, ok := vpuo.mutation.SortDirection(); ok {
+		_spec.SetField(viewpreference.FieldSortDirection, field.TypeString, value)
+	}
+	if value, ok := vpuo.mutation.PageSize(); ok {
+		_spec.SetField(viewpreference.FieldPageSize, field.TypeInt, value)
+	}
+	if value, ok := vpuo.mutation.AddedPageSize(); ok {
+		_spec.AddField(viewpreference.FieldPageSize, field.TypeInt, value)
+	}
+	if value, ok := vpuo.mutation.GalleryWidth(); ok {
+		_spec.SetField(viewpreference.FieldGalleryWidth, field.TypeInt, value)
+	}
+	if value, ok := vpuo.mutation.AddedGalleryWidth(); ok {
+		_spec.AddField(viewpreference.FieldGalleryWidth, field.TypeInt, value)
+	}
+	if value, ok := vpuo.mutation.ListColumns(); ok {
+		_spec.SetField(viewpreference.FieldListColumns, field.TypeString, value)
+	}
+	if vpuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   viewpreference.UserTable,
+			Columns: []string{viewpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vpuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   viewpreference.UserTable,
+			Columns: []string{viewpreference.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ViewPreference{config: vpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{viewpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	vpuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhook"
+)
+
+// Webhook is the model entity for the Webhook schema.
+type Webhook struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
+	// Events holds the value of the "events" field.
+	Events []string `json:"events,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebhookQuery when eager-loading is set.
+	Edges        WebhookEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WebhookEdges holds the relations/edges for other nodes in the graph.
+type WebhookEdges struct {
+	// Deliveries holds the value of the deliveries edge.
+	Deliveries []*WebhookDelivery `json:"deliveries,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+
+	namedDeliveries map[string][]*WebhookDelivery
+}
+
+// DeliveriesOrErr returns the Deliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e WebhookEdges) DeliveriesOrErr() ([]*WebhookDelivery, error) {
+	if e.loadedTypes[0] {
+		return e.Deliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "deliveries"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Webhook) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhook.FieldEvents:
+			values[i] = new([]byte)
+		case webhook.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case webhook.FieldID:
+			values[i] = new(sql.NullInt64)
+		case webhook.FieldName, webhook.FieldURL, webhook.FieldSecret:
+			values[i] = new(sql.NullString)
+		case webhook.FieldCreatedAt, webhook.FieldUpdatedAt, webhook.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Webhook fields.
+func (w *Webhook) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhook.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			w.ID = int(value.Int64)
+		case webhook.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				w.CreatedAt = value.Time
+			}
+		case webhook.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				w.UpdatedAt = value.Time
+			}
+		case webhook.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				w.DeletedAt = new(time.Time)
+				*w.DeletedAt = value.Time
+			}
+		case webhook.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				w.Name = value.String
+			}
+		case webhook.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				w.URL = value.String
+			}
+		case webhook.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				w.Secret = value.String
+			}
+		case webhook.FieldEvents:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field events", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &w.Events); err != nil {
+					return fmt.Errorf("unmarshal field events: %w", err)
+				}
+			}
+		case webhook.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				w.Enabled = value.Bool
+			}
+		default:
+			w.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Webhook.
+// This includes values selected through modifiers, order, etc.
+func (w *Webhook) Value(name string) (ent.Value, error) {
+	return w.selectValues.Get(name)
+}
+
+// QueryDeliveries queries the "deliveries" edge of the Webhook entity.
+func (w *Webhook) QueryDeliveries() *WebhookDeliveryQuery {
+	return NewWebhookClient(w.config).QueryDeliveries(w)
+}
+
+// Update returns a builder for updating this Webhook.
+// Note that you need to call Webhook.Unwrap() before calling this method if this Webhook
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (w *Webhook) Update() *WebhookUpdateOne {
+	return NewWebhookClient(w.config).UpdateOne(w)
+}
+
+// Unwrap unwraps the Webhook entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (w *Webhook) Unwrap() *Webhook {
+	_tx, ok := w.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Webhook is not a transactional entity")
+	}
+	w.config.driver = _tx.drv
+	return w
+}
+
+// String implements the fmt.Stringer.
+func (w *Webhook) String() string {
+	var builder strings.Builder
+	builder.WriteString("Webhook(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", w.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(w.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(w.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := w.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(w.Name)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(w.URL)
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("events=")
+	builder.WriteString(fmt.Sprintf("%v", w.Events))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", w.Enabled))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetDeliveries manually set the edge as loaded state.
+func (e *Webhook) SetDeliveries(v []*WebhookDelivery) {
+	e.Edges.Deliveries = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// NamedDeliveries returns the Deliveries named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (w *Webhook) NamedDeliveries(name string) ([]*WebhookDelivery, error) {
+	if w.Edges.namedDeliveries == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := w.Edges.namedDeliveries[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (w *Webhook) appendNamedDeliveries(name string, edges ...*WebhookDelivery) {
+	if w.Edges.namedDeliveries == nil {
+		w.Edges.namedDeliveries = make(map[string][]*WebhookDelivery)
+	}
+	if len(edges) == 0 {
+		w.Edges.namedDeliveries[name] = []*WebhookDelivery{}
+	} else {
+		w.Edges.namedDeliveries[name] = append(w.Edges.namedDeliveries[name], edges...)
+	}
+}
+
+// Webhooks is a parsable slice of Webhook.
+type Webhooks []*Webhook
@@ -0,0 +1,960 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhook"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhookdelivery"
+)
+
+// WebhookCreate is the builder for creating a Webhook entity.
+type WebhookCreate struct {
+	config
+	mutation *WebhookMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wc *WebhookCreate) SetCreatedAt(t time.Time) *WebhookCreate {
+	wc.mutation.SetCreatedAt(t)
+	return wc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableCreatedAt(t *time.Time) *WebhookCreate {
+	if t != nil {
+		wc.SetCreatedAt(*t)
+	}
+	return wc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wc *WebhookCreate) SetUpdatedAt(t time.Time) *WebhookCreate {
+	wc.mutation.SetUpdatedAt(t)
+	return wc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableUpdatedAt(t *time.Time) *WebhookCreate {
+	if t != nil {
+		wc.SetUpdatedAt(*t)
+	}
+	return wc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (wc *WebhookCreate) SetDeletedAt(t time.Time) *WebhookCreate {
+	wc.mutation.SetDeletedAt(t)
+	return wc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableDeletedAt(t *time.Time) *WebhookCreate {
+	if t != nil {
+		wc.SetDeletedAt(*t)
+	}
+	return wc
+}
+
+// SetName sets the "name" field.
+func (wc *WebhookCreate) SetName(s string) *WebhookCreate {
+	wc.mutation.SetName(s)
+	return wc
+}
+
+// SetURL sets the "url" field.
+func (wc *WebhookCreate) SetURL(s string) *WebhookCreate {
+	wc.mutation.SetURL(s)
+	return wc
+}
+
+// SetSecret sets the "secret" field.
+func (wc *WebhookCreate) SetSecret(s string) *WebhookCreate {
+	wc.mutation.SetSecret(s)
+	return wc
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableSecret(s *string) *WebhookCreate {
+	if s != nil {
+		wc.SetSecret(*s)
+	}
+	return wc
+}
+
+// SetEvents sets the "events" field.
+func (wc *WebhookCreate) SetEvents(s []string) *WebhookCreate {
+	wc.mutation.SetEvents(s)
+	return wc
+}
+
+// SetEnabled sets the "enabled" field.
+func (wc *WebhookCreate) SetEnabled(b bool) *WebhookCreate {
+	wc.mutation.SetEnabled(b)
+	return wc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableEnabled(b *bool) *WebhookCreate {
+	if b != nil {
+		wc.SetEnabled(*b)
+	}
+	return wc
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wc *WebhookCreate) AddDeliveryIDs(ids ...int) *WebhookCreate {
+	wc.mutation.AddDeliveryIDs(ids...)
+	return wc
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wc *WebhookCreate) AddDeliveries(w ...*WebhookDelivery) *WebhookCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wc.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wc *WebhookCreate) Mutation() *WebhookMutation {
+	return wc.mutation
+}
+
+// Save creates the Webhook in the database.
+func (wc *WebhookCreate) Save(ctx context.Context) (*Webhook, error) {
+	if err := wc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, wc.sqlSave, wc.mutation, wc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wc *WebhookCreate) SaveX(ctx context.Context) *Webhook {
+	v, err := wc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wc *WebhookCreate) Exec(ctx context.Context) error {
+	_, err := wc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wc *WebhookCreate) ExecX(ctx context.Context) {
+	if err := wc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wc *WebhookCreate) defaults() error {
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		if webhook.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized webhook.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := webhook.DefaultCreatedAt()
+		wc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wc.mutation.UpdatedAt(); !ok {
+		if webhook.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized webhook.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := webhook.DefaultUpdatedAt()
+		wc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wc.mutation.Enabled(); !ok {
+		v := webhook.DefaultEnabled
+		wc.mutation.SetEnabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wc *WebhookCreate) check() error {
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Webhook.created_at"`)}
+	}
+	if _, ok := wc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Webhook.updated_at"`)}
+	}
+	if _, ok := wc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Webhook.name"`)}
+	}
+	if _, ok := wc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "Webhook.url"`)}
+	}
+	if _, ok := wc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Webhook.enabled"`)}
+	}
+	return nil
+}
+
+func (wc *WebhookCreate) sqlSave(ctx context.Context) (*Webhook, error) {
+	if err := wc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wc.mutation.id = &_node.ID
+	wc.mutation.done = true
+	return _node, nil
+}
+
+func (wc *WebhookCreate) createSpec() (*Webhook, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Webhook{config: wc.config}
+		_spec = sqlgraph.NewCreateSpec(webhook.Table, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt))
+	)
+
+	if id, ok := wc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = wc.conflict
+	if value, ok := wc.mutation.CreatedAt(); ok {
+		_spec.SetField(webhook.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wc.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhook.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := wc.mutation.DeletedAt(); ok {
+		_spec.SetField(webhook.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := wc.mutation.Name(); ok {
+		_spec.SetField(webhook.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := wc.mutation.URL(); ok {
+		_spec.SetField(webhook.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := wc.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := wc.mutation.Events(); ok {
+		_spec.SetField(webhook.FieldEvents, field.TypeJSON, value)
+		_node.Events = value
+	}
+	if value, ok := wc.mutation.Enabled(); ok {
+		_spec.SetField(webhook.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if nodes := wc.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Webhook.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (wc *WebhookCreate) OnConflict(opts ...sql.ConflictOption) *WebhookUpsertOne {
+	wc.conflict = opts
+	return &WebhookUpsertOne{
+		create: wc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Webhook.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wc *WebhookCreate) OnConflictColumns(columns ...string) *WebhookUpsertOne {
+	wc.conflict = append(wc.conflict, sql.ConflictColumns(columns...))
+	return &WebhookUpsertOne{
+		create: wc,
+	}
+}
+
+type (
+	// WebhookUpsertOne is the builder for "upsert"-ing
+	//  one Webhook node.
+	WebhookUpsertOne struct {
+		create *WebhookCreate
+	}
+
+	// WebhookUpsert is the "OnConflict" setter.
+	WebhookUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookUpsert) SetUpdatedAt(v time.Time) *WebhookUpsert {
+	u.Set(webhook.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookUpsert) UpdateUpdatedAt() *WebhookUpsert {
+	u.SetExcluded(webhook.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *WebhookUpsert) SetDeletedAt(v time.Time) *WebhookUpsert {
+	u.Set(webhook.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *WebhookUpsert) UpdateDeletedAt() *WebhookUpsert {
+	u.SetExcluded(webhook.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *WebhookUpsert) ClearDeletedAt() *WebhookUpsert {
+	u.SetNull(webhook.FieldDeletedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *WebhookUpsert) SetName(v string) *WebhookUpsert {
+	u.Set(webhook.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WebhookUpsert) UpdateName() *WebhookUpsert {
+	u.SetExcluded(webhook.FieldName)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *WebhookUpsert) SetURL(v string) *WebhookUpsert {
+	u.Set(webhook.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WebhookUpsert) UpdateURL() *WebhookUpsert {
+	u.SetExcluded(webhook.FieldURL)
+	return u
+}
+
+// SetSecret sets the "secret" field.
+func (u *WebhookUpsert) SetSecret(v string) *WebhookUpsert {
+	u.Set(webhook.FieldSecret, v)
+	return u
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WebhookUpsert) UpdateSecret() *WebhookUpsert {
+	u.SetExcluded(webhook.FieldSecret)
+	return u
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (u *WebhookUpsert) ClearSecret() *WebhookUpsert {
+	u.SetNull(webhook.FieldSecret)
+	return u
+}
+
+// SetEvents sets the "events" field.
+func (u *WebhookUpsert) SetEvents(v []string) *WebhookUpsert {
+	u.Set(webhook.FieldEvents, v)
+	return u
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *WebhookUpsert) UpdateEvents() *WebhookUpsert {
+	u.SetExcluded(webhook.FieldEvents)
+	return u
+}
+
+// ClearEvents clears the value of the "events" field.
+func (u *WebhookUpsert) ClearEvents() *WebhookUpsert {
+	u.SetNull(webhook.FieldEvents)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *WebhookUpsert) SetEnabled(v bool) *WebhookUpsert {
+	u.Set(webhook.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *WebhookUpsert) UpdateEnabled() *WebhookUpsert {
+	u.SetExcluded(webhook.FieldEnabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Webhook.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WebhookUpsertOne) UpdateNewValues() *WebhookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(webhook.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Webhook.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WebhookUpsertOne) Ignore() *WebhookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookUpsertOne) DoNothing() *WebhookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookCreate.OnConflict
+// documentation for more info.
+func (u *WebhookUpsertOne) Update(set func(*WebhookUpsert)) *WebhookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookUpsertOne) SetUpdatedAt(v time.Time) *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookUpsertOne) UpdateUpdatedAt() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *WebhookUpsertOne) SetDeletedAt(v time.Time) *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *WebhookUpsertOne) UpdateDeletedAt() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *WebhookUpsertOne) ClearDeletedAt() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *WebhookUpsertOne) SetName(v string) *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WebhookUpsertOne) UpdateName() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *WebhookUpsertOne) SetURL(v string) *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WebhookUpsertOne) UpdateURL() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *WebhookUpsertOne) SetSecret(v string) *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WebhookUpsertOne) UpdateSecret() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (u *WebhookUpsertOne) ClearSecret() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.ClearSecret()
+	})
+}
+
+// SetEvents sets the "events" field.
+func (u *WebhookUpsertOne) SetEvents(v []string) *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetEvents(v)
+	})
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *WebhookUpsertOne) UpdateEvents() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateEvents()
+	})
+}
+
+// ClearEvents clears the value of the "events" field.
+func (u *WebhookUpsertOne) ClearEvents() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.ClearEvents()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *WebhookUpsertOne) SetEnabled(v bool) *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *WebhookUpsertOne) UpdateEnabled() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WebhookCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WebhookUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WebhookUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *WebhookCreate) SetRawID(t int) *WebhookCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// WebhookCreateBulk is the builder for creating many Webhook entities in bulk.
+type WebhookCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Webhook entities in the database.
+func (wcb *WebhookCreateBulk) Save(ctx context.Context) ([]*Webhook, error) {
+	if wcb.err != nil {
+		return nil, wcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wcb.builders))
+	nodes := make([]*Webhook, len(wcb.builders))
+	mutators := make([]Mutator, len(wcb.builders))
+	for i := range wcb.builders {
+		func(i int, root context.Context) {
+			builder := wcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcb *WebhookCreateBulk) SaveX(ctx context.Context) []*Webhook {
+	v, err := wcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wcb *WebhookCreateBulk) Exec(ctx context.Context) error {
+	_, err := wcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcb *WebhookCreateBulk) ExecX(ctx context.Context) {
+	if err := wcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Webhook.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (wcb *WebhookCreateBulk) OnConflict(opts ...sql.ConflictOption) *WebhookUpsertBulk {
+	wcb.conflict = opts
+	return &WebhookUpsertBulk{
+		create: wcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Webhook.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wcb *WebhookCreateBulk) OnConflictColumns(columns ...string) *WebhookUpsertBulk {
+	wcb.conflict = append(wcb.conflict, sql.ConflictColumns(columns...))
+	return &WebhookUpsertBulk{
+		create: wcb,
+	}
+}
+
+// WebhookUpsertBulk is the builder for "upsert"-ing
+// a bulk of Webhook nodes.
+type WebhookUpsertBulk struct {
+	create *WebhookCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Webhook.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WebhookUpsertBulk) UpdateNewValues() *WebhookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(webhook.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Webhook.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WebhookUpsertBulk) Ignore() *WebhookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookUpsertBulk) DoNothing() *WebhookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookCreateBulk.OnConflict
+// documentation for more info.
+func (u *WebhookUpsertBulk) Update(set func(*WebhookUpsert)) *WebhookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookUpsertBulk) SetUpdatedAt(v time.Time) *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookUpsertBulk) UpdateUpdatedAt() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *WebhookUpsertBulk) SetDeletedAt(v time.Time) *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *WebhookUpsertBulk) UpdateDeletedAt() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *WebhookUpsertBulk) ClearDeletedAt() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *WebhookUpsertBulk) SetName(v string) *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *WebhookUpsertBulk) UpdateName() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *WebhookUpsertBulk) SetURL(v string) *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WebhookUpsertBulk) UpdateURL() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *WebhookUpsertBulk) SetSecret(v string) *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WebhookUpsertBulk) UpdateSecret() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (u *WebhookUpsertBulk) ClearSecret() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.ClearSecret()
+	})
+}
+
+// SetEvents sets the "events" field.
+func (u *WebhookUpsertBulk) SetEvents(v []string) *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetEvents(v)
+	})
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *WebhookUpsertBulk) UpdateEvents() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateEvents()
+	})
+}
+
+// ClearEvents clears the value of the "events" field.
+func (u *WebhookUpsertBulk) ClearEvents() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.ClearEvents()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *WebhookUpsertBulk) SetEnabled(v bool) *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *WebhookUpsertBulk) UpdateEnabled() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WebhookCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WebhookCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
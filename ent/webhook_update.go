@@ -0,0 +1,652 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhook"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhookdelivery"
+)
+
+// WebhookUpdate is the builder for updating Webhook entities.
+type WebhookUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookMutation
+}
+
+// Where appends a list predicates to the WebhookUpdate builder.
+func (wu *WebhookUpdate) Where(ps ...predicate.Webhook) *WebhookUpdate {
+	wu.mutation.Where(ps...)
+	return wu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wu *WebhookUpdate) SetUpdatedAt(t time.Time) *WebhookUpdate {
+	wu.mutation.SetUpdatedAt(t)
+	return wu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (wu *WebhookUpdate) SetDeletedAt(t time.Time) *WebhookUpdate {
+	wu.mutation.SetDeletedAt(t)
+	return wu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableDeletedAt(t *time.Time) *WebhookUpdate {
+	if t != nil {
+		wu.SetDeletedAt(*t)
+	}
+	return wu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (wu *WebhookUpdate) ClearDeletedAt() *WebhookUpdate {
+	wu.mutation.ClearDeletedAt()
+	return wu
+}
+
+// SetName sets the "name" field.
+func (wu *WebhookUpdate) SetName(s string) *WebhookUpdate {
+	wu.mutation.SetName(s)
+	return wu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableName(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetName(*s)
+	}
+	return wu
+}
+
+// SetURL sets the "url" field.
+func (wu *WebhookUpdate) SetURL(s string) *WebhookUpdate {
+	wu.mutation.SetURL(s)
+	return wu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableURL(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetURL(*s)
+	}
+	return wu
+}
+
+// SetSecret sets the "secret" field.
+func (wu *WebhookUpdate) SetSecret(s string) *WebhookUpdate {
+	wu.mutation.SetSecret(s)
+	return wu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableSecret(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetSecret(*s)
+	}
+	return wu
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (wu *WebhookUpdate) ClearSecret() *WebhookUpdate {
+	wu.mutation.ClearSecret()
+	return wu
+}
+
+// SetEvents sets the "events" field.
+func (wu *WebhookUpdate) SetEvents(s []string) *WebhookUpdate {
+	wu.mutation.SetEvents(s)
+	return wu
+}
+
+// AppendEvents appends s to the "events" field.
+func (wu *WebhookUpdate) AppendEvents(s []string) *WebhookUpdate {
+	wu.mutation.AppendEvents(s)
+	return wu
+}
+
+// ClearEvents clears the value of the "events" field.
+func (wu *WebhookUpdate) ClearEvents() *WebhookUpdate {
+	wu.mutation.ClearEvents()
+	return wu
+}
+
+// SetEnabled sets the "enabled" field.
+func (wu *WebhookUpdate) SetEnabled(b bool) *WebhookUpdate {
+	wu.mutation.SetEnabled(b)
+	return wu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableEnabled(b *bool) *WebhookUpdate {
+	if b != nil {
+		wu.SetEnabled(*b)
+	}
+	return wu
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wu *WebhookUpdate) AddDeliveryIDs(ids ...int) *WebhookUpdate {
+	wu.mutation.AddDeliveryIDs(ids...)
+	return wu
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wu *WebhookUpdate) AddDeliveries(w ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wu *WebhookUpdate) Mutation() *WebhookMutation {
+	return wu.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (wu *WebhookUpdate) ClearDeliveries() *WebhookUpdate {
+	wu.mutation.ClearDeliveries()
+	return wu
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (wu *WebhookUpdate) RemoveDeliveryIDs(ids ...int) *WebhookUpdate {
+	wu.mutation.RemoveDeliveryIDs(ids...)
+	return wu
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (wu *WebhookUpdate) RemoveDeliveries(w ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.RemoveDeliveryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wu *WebhookUpdate) Save(ctx context.Context) (int, error) {
+	if err := wu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, wu.sqlSave, wu.mutation, wu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wu *WebhookUpdate) SaveX(ctx context.Context) int {
+	affected, err := wu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wu *WebhookUpdate) Exec(ctx context.Context) error {
+	_, err := wu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wu *WebhookUpdate) ExecX(ctx context.Context) {
+	if err := wu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wu *WebhookUpdate) defaults() error {
+	if _, ok := wu.mutation.UpdatedAt(); !ok {
+		if webhook.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized webhook.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := webhook.UpdateDefaultUpdatedAt()
+		wu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (wu *WebhookUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhook.Table, webhook.Columns, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt))
+	if ps := wu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhook.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.DeletedAt(); ok {
+		_spec.SetField(webhook.FieldDeletedAt, field.TypeTime, value)
+	}
+	if wu.mutation.DeletedAtCleared() {
+		_spec.ClearField(webhook.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := wu.mutation.Name(); ok {
+		_spec.SetField(webhook.FieldName, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.URL(); ok {
+		_spec.SetField(webhook.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeString, value)
+	}
+	if wu.mutation.SecretCleared() {
+		_spec.ClearField(webhook.FieldSecret, field.TypeString)
+	}
+	if value, ok := wu.mutation.Events(); ok {
+		_spec.SetField(webhook.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := wu.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldEvents, value)
+		})
+	}
+	if wu.mutation.EventsCleared() {
+		_spec.ClearField(webhook.FieldEvents, field.TypeJSON)
+	}
+	if value, ok := wu.mutation.Enabled(); ok {
+		_spec.SetField(webhook.FieldEnabled, field.TypeBool, value)
+	}
+	if wu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wu.mutation.done = true
+	return n, nil
+}
+
+// WebhookUpdateOne is the builder for updating a single Webhook entity.
+type WebhookUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wuo *WebhookUpdateOne) SetUpdatedAt(t time.Time) *WebhookUpdateOne {
+	wuo.mutation.SetUpdatedAt(t)
+	return wuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (wuo *WebhookUpdateOne) SetDeletedAt(t time.Time) *WebhookUpdateOne {
+	wuo.mutation.SetDeletedAt(t)
+	return wuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableDeletedAt(t *time.Time) *WebhookUpdateOne {
+	if t != nil {
+		wuo.SetDeletedAt(*t)
+	}
+	return wuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (wuo *WebhookUpdateOne) ClearDeletedAt() *WebhookUpdateOne {
+	wuo.mutation.ClearDeletedAt()
+	return wuo
+}
+
+// SetName sets the "name" field.
+func (wuo *WebhookUpdateOne) SetName(s string) *WebhookUpdateOne {
+	wuo.mutation.SetName(s)
+	return wuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableName(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetName(*s)
+	}
+	return wuo
+}
+
+// SetURL sets the "url" field.
+func (wuo *WebhookUpdateOne) SetURL(s string) *WebhookUpdateOne {
+	wuo.mutation.SetURL(s)
+	return wuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableURL(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetURL(*s)
+	}
+	return wuo
+}
+
+// SetSecret sets the "secret" field.
+func (wuo *WebhookUpdateOne) SetSecret(s string) *WebhookUpdateOne {
+	wuo.mutation.SetSecret(s)
+	return wuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableSecret(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetSecret(*s)
+	}
+	return wuo
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (wuo *WebhookUpdateOne) ClearSecret() *WebhookUpdateOne {
+	wuo.mutation.ClearSecret()
+	return wuo
+}
+
+// SetEvents sets the "events" field.
+func (wuo *WebhookUpdateOne) SetEvents(s []string) *WebhookUpdateOne {
+	wuo.mutation.SetEvents(s)
+	return wuo
+}
+
+// AppendEvents appends s to the "events" field.
+func (wuo *WebhookUpdateOne) AppendEvents(s []string) *WebhookUpdateOne {
+	wuo.mutation.AppendEvents(s)
+	return wuo
+}
+
+// ClearEvents clears the value of the "events" field.
+func (wuo *WebhookUpdateOne) ClearEvents() *WebhookUpdateOne {
+	wuo.mutation.ClearEvents()
+	return wuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (wuo *WebhookUpdateOne) SetEnabled(b bool) *WebhookUpdateOne {
+	wuo.mutation.SetEnabled(b)
+	return wuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableEnabled(b *bool) *WebhookUpdateOne {
+	if b != nil {
+		wuo.SetEnabled(*b)
+	}
+	return wuo
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wuo *WebhookUpdateOne) AddDeliveryIDs(ids ...int) *WebhookUpdateOne {
+	wuo.mutation.AddDeliveryIDs(ids...)
+	return wuo
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wuo *WebhookUpdateOne) AddDeliveries(w ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wuo *WebhookUpdateOne) Mutation() *WebhookMutation {
+	return wuo.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (wuo *WebhookUpdateOne) ClearDeliveries() *WebhookUpdateOne {
+	wuo.mutation.ClearDeliveries()
+	return wuo
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (wuo *WebhookUpdateOne) RemoveDeliveryIDs(ids ...int) *WebhookUpdateOne {
+	wuo.mutation.RemoveDeliveryIDs(ids...)
+	return wuo
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (wuo *WebhookUpdateOne) RemoveDeliveries(w ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.RemoveDeliveryIDs(ids...)
+}
+
+// Where appends a list predicates to the WebhookUpdate builder.
+func (wuo *WebhookUpdateOne) Where(ps ...predicate.Webhook) *WebhookUpdateOne {
+	wuo.mutation.Where(ps...)
+	return wuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wuo *WebhookUpdateOne) Select(field string, fields ...string) *WebhookUpdateOne {
+	wuo.fields = append([]string{field}, fields...)
+	return wuo
+}
+
+// Save executes the query and returns the updated Webhook entity.
+func (wuo *WebhookUpdateOne) Save(ctx context.Context) (*Webhook, error) {
+	if err := wuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, wuo.sqlSave, wuo.mutation, wuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) SaveX(ctx context.Context) *Webhook {
+	node, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuo *WebhookUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wuo *WebhookUpdateOne) defaults() error {
+	if _, ok := wuo.mutation.UpdatedAt(); !ok {
+		if webhook.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized webhook.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := webhook.UpdateDefaultUpdatedAt()
+		wuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (wuo *WebhookUpdateOne) sqlSave(ctx context.Context) (_node *Webhook, err error) {
+	_spec := sqlgraph.NewUpdateSpec(webhook.Table, webhook.Columns, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt))
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Webhook.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhook.FieldID)
+		for _, f := range fields {
+			if !webhook.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhook.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.DeletedAt(); ok {
+		_spec.SetField(webhook.FieldDeletedAt, field.TypeTime, value)
+	}
+	if wuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(webhook.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := wuo.mutation.Name(); ok {
+		_spec.SetField(webhook.FieldName, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.URL(); ok {
+		_spec.SetField(webhook.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeString, value)
+	}
+	if wuo.mutation.SecretCleared() {
+		_spec.ClearField(webhook.FieldSecret, field.TypeString)
+	}
+	if value, ok := wuo.mutation.Events(); ok {
+		_spec.SetField(webhook.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := wuo.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldEvents, value)
+		})
+	}
+	if wuo.mutation.EventsCleared() {
+		_spec.ClearField(webhook.FieldEvents, field.TypeJSON)
+	}
+	if value, ok := wuo.mutation.Enabled(); ok {
+		_spec.SetField(webhook.FieldEnabled, field.TypeBool, value)
+	}
+	if wuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Webhook{config: wuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,259 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhook"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhookdelivery"
+)
+
+// WebhookDelivery is the model entity for the WebhookDelivery schema.
+type WebhookDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// WebhookID holds the value of the "webhook_id" field.
+	WebhookID int `json:"webhook_id,omitempty"`
+	// Event holds the value of the "event" field.
+	Event string `json:"event,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload string `json:"payload,omitempty"`
+	// Status holds the value of the "status" field.
+	Status webhookdelivery.Status `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// ResponseCode holds the value of the "response_code" field.
+	ResponseCode int `json:"response_code,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebhookDeliveryQuery when eager-loading is set.
+	Edges        WebhookDeliveryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WebhookDeliveryEdges holds the relations/edges for other nodes in the graph.
+type WebhookDeliveryEdges struct {
+	// Webhook holds the value of the webhook edge.
+	Webhook *Webhook `json:"webhook,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// WebhookOrErr returns the Webhook value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebhookDeliveryEdges) WebhookOrErr() (*Webhook, error) {
+	if e.loadedTypes[0] {
+		if e.Webhook == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: webhook.Label}
+		}
+		return e.Webhook, nil
+	}
+	return nil, &NotLoadedError{edge: "webhook"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookdelivery.FieldID, webhookdelivery.FieldWebhookID, webhookdelivery.FieldAttempts, webhookdelivery.FieldResponseCode:
+			values[i] = new(sql.NullInt64)
+		case webhookdelivery.FieldEvent, webhookdelivery.FieldPayload, webhookdelivery.FieldStatus, webhookdelivery.FieldError:
+			values[i] = new(sql.NullString)
+		case webhookdelivery.FieldCreatedAt, webhookdelivery.FieldUpdatedAt, webhookdelivery.FieldDeletedAt, webhookdelivery.FieldNextAttemptAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookDelivery fields.
+func (wd *WebhookDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookdelivery.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wd.ID = int(value.Int64)
+		case webhookdelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wd.CreatedAt = value.Time
+			}
+		case webhookdelivery.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				wd.UpdatedAt = value.Time
+			}
+		case webhookdelivery.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				wd.DeletedAt = new(time.Time)
+				*wd.DeletedAt = value.Time
+			}
+		case webhookdelivery.FieldWebhookID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field webhook_id", values[i])
+			} else if value.Valid {
+				wd.WebhookID = int(value.Int64)
+			}
+		case webhookdelivery.FieldEvent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event", values[i])
+			} else if value.Valid {
+				wd.Event = value.String
+			}
+		case webhookdelivery.FieldPayload:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value.Valid {
+				wd.Payload = value.String
+			}
+		case webhookdelivery.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				wd.Status = webhookdelivery.Status(value.String)
+			}
+		case webhookdelivery.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				wd.Attempts = int(value.Int64)
+			}
+		case webhookdelivery.FieldResponseCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field response_code", values[i])
+			} else if value.Valid {
+				wd.ResponseCode = int(value.Int64)
+			}
+		case webhookdelivery.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				wd.Error = value.String
+			}
+		case webhookdelivery.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				wd.NextAttemptAt = new(time.Time)
+				*wd.NextAttemptAt = value.Time
+			}
+		default:
+			wd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebhookDelivery.
+// This includes values selected through modifiers, order, etc.
+func (wd *WebhookDelivery) Value(name string) (ent.Value, error) {
+	return wd.selectValues.Get(name)
+}
+
+// QueryWebhook queries the "webhook" edge of the WebhookDelivery entity.
+func (wd *WebhookDelivery) QueryWebhook() *WebhookQuery {
+	return NewWebhookDeliveryClient(wd.config).QueryWebhook(wd)
+}
+
+// Update returns a builder for updating this WebhookDelivery.
+// Note that you need to call WebhookDelivery.Unwrap() before calling this method if this WebhookDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wd *WebhookDelivery) Update() *WebhookDeliveryUpdateOne {
+	return NewWebhookDeliveryClient(wd.config).UpdateOne(wd)
+}
+
+// Unwrap unwraps the WebhookDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wd *WebhookDelivery) Unwrap() *WebhookDelivery {
+	_tx, ok := wd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebhookDelivery is not a transactional entity")
+	}
+	wd.config.driver = _tx.drv
+	return wd
+}
+
+// String implements the fmt.Stringer.
+func (wd *WebhookDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wd.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(wd.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(wd.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := wd.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("webhook_id=")
+	builder.WriteString(fmt.Sprintf("%v", wd.WebhookID))
+	builder.WriteString(", ")
+	builder.WriteString("event=")
+	builder.WriteString(wd.Event)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(wd.Payload)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", wd.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", wd.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("response_code=")
+	builder.WriteString(fmt.Sprintf("%v", wd.ResponseCode))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(wd.Error)
+	builder.WriteString(", ")
+	if v := wd.NextAttemptAt; v != nil {
+		builder.WriteString("next_attempt_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SetWebhook manually set the edge as loaded state.
+func (e *WebhookDelivery) SetWebhook(v *Webhook) {
+	e.Edges.Webhook = v
+	e.Edges.loadedTypes[0] = true
+}
+
+// WebhookDeliveries is a parsable slice of WebhookDelivery.
+type WebhookDeliveries []*WebhookDelivery
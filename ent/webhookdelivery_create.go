@@ -0,0 +1,1199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhook"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhookdelivery"
+)
+
+// WebhookDeliveryCreate is the builder for creating a WebhookDelivery entity.
+type WebhookDeliveryCreate struct {
+	config
+	mutation *WebhookDeliveryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wdc *WebhookDeliveryCreate) SetCreatedAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetCreatedAt(t)
+	return wdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableCreatedAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetCreatedAt(*t)
+	}
+	return wdc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdc *WebhookDeliveryCreate) SetUpdatedAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetUpdatedAt(t)
+	return wdc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableUpdatedAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetUpdatedAt(*t)
+	}
+	return wdc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (wdc *WebhookDeliveryCreate) SetDeletedAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetDeletedAt(t)
+	return wdc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableDeletedAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetDeletedAt(*t)
+	}
+	return wdc
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wdc *WebhookDeliveryCreate) SetWebhookID(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetWebhookID(i)
+	return wdc
+}
+
+// SetEvent sets the "event" field.
+func (wdc *WebhookDeliveryCreate) SetEvent(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetEvent(s)
+	return wdc
+}
+
+// SetPayload sets the "payload" field.
+func (wdc *WebhookDeliveryCreate) SetPayload(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetPayload(s)
+	return wdc
+}
+
+// SetStatus sets the "status" field.
+func (wdc *WebhookDeliveryCreate) SetStatus(w webhookdelivery.Status) *WebhookDeliveryCreate {
+	wdc.mutation.SetStatus(w)
+	return wdc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableStatus(w *webhookdelivery.Status) *WebhookDeliveryCreate {
+	if w != nil {
+		wdc.SetStatus(*w)
+	}
+	return wdc
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdc *WebhookDeliveryCreate) SetAttempts(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetAttempts(i)
+	return wdc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableAttempts(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetAttempts(*i)
+	}
+	return wdc
+}
+
+// SetResponseCode sets the "response_code" field.
+func (wdc *WebhookDeliveryCreate) SetResponseCode(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetResponseCode(i)
+	return wdc
+}
+
+// SetNillableResponseCode sets the "response_code" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableResponseCode(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetResponseCode(*i)
+	}
+	return wdc
+}
+
+// SetError sets the "error" field.
+func (wdc *WebhookDeliveryCreate) SetError(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetError(s)
+	return wdc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableError(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetError(*s)
+	}
+	return wdc
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wdc *WebhookDeliveryCreate) SetNextAttemptAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetNextAttemptAt(t)
+	return wdc
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetNextAttemptAt(*t)
+	}
+	return wdc
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wdc *WebhookDeliveryCreate) SetWebhook(w *Webhook) *WebhookDeliveryCreate {
+	return wdc.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdc *WebhookDeliveryCreate) Mutation() *WebhookDeliveryMutation {
+	return wdc.mutation
+}
+
+// Save creates the WebhookDelivery in the database.
+func (wdc *WebhookDeliveryCreate) Save(ctx context.Context) (*WebhookDelivery, error) {
+	if err := wdc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, wdc.sqlSave, wdc.mutation, wdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wdc *WebhookDeliveryCreate) SaveX(ctx context.Context) *WebhookDelivery {
+	v, err := wdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdc *WebhookDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := wdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdc *WebhookDeliveryCreate) ExecX(ctx context.Context) {
+	if err := wdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdc *WebhookDeliveryCreate) defaults() error {
+	if _, ok := wdc.mutation.CreatedAt(); !ok {
+		if webhookdelivery.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized webhookdelivery.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := webhookdelivery.DefaultCreatedAt()
+		wdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wdc.mutation.UpdatedAt(); !ok {
+		if webhookdelivery.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized webhookdelivery.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := webhookdelivery.DefaultUpdatedAt()
+		wdc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wdc.mutation.Status(); !ok {
+		v := webhookdelivery.DefaultStatus
+		wdc.mutation.SetStatus(v)
+	}
+	if _, ok := wdc.mutation.Attempts(); !ok {
+		v := webhookdelivery.DefaultAttempts
+		wdc.mutation.SetAttempts(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdc *WebhookDeliveryCreate) check() error {
+	if _, ok := wdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebhookDelivery.created_at"`)}
+	}
+	if _, ok := wdc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WebhookDelivery.updated_at"`)}
+	}
+	if _, ok := wdc.mutation.WebhookID(); !ok {
+		return &ValidationError{Name: "webhook_id", err: errors.New(`ent: missing required field "WebhookDelivery.webhook_id"`)}
+	}
+	if _, ok := wdc.mutation.Event(); !ok {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required field "WebhookDelivery.event"`)}
+	}
+	if _, ok := wdc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "WebhookDelivery.payload"`)}
+	}
+	if _, ok := wdc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WebhookDelivery.status"`)}
+	}
+	if v, ok := wdc.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.status": %w`, err)}
+		}
+	}
+	if _, ok := wdc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "WebhookDelivery.attempts"`)}
+	}
+	if _, ok := wdc.mutation.WebhookID(); !ok {
+		return &ValidationError{Name: "webhook", err: errors.New(`ent: missing required edge "WebhookDelivery.webhook"`)}
+	}
+	return nil
+}
+
+func (wdc *WebhookDeliveryCreate) sqlSave(ctx context.Context) (*WebhookDelivery, error) {
+	if err := wdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wdc.mutation.id = &_node.ID
+	wdc.mutation.done = true
+	return _node, nil
+}
+
+func (wdc *WebhookDeliveryCreate) createSpec() (*WebhookDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookDelivery{config: wdc.config}
+		_spec = sqlgraph.NewCreateSpec(webhookdelivery.Table, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt))
+	)
+
+	if id, ok := wdc.mutation.ID(); ok {
+		_node.ID = id
+		id64 := int64(id)
+		_spec.ID.Value = id64
+	}
+
+	_spec.OnConflict = wdc.conflict
+	if value, ok := wdc.mutation.CreatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wdc.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := wdc.mutation.DeletedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := wdc.mutation.Event(); ok {
+		_spec.SetField(webhookdelivery.FieldEvent, field.TypeString, value)
+		_node.Event = value
+	}
+	if value, ok := wdc.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeString, value)
+		_node.Payload = value
+	}
+	if value, ok := wdc.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := wdc.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := wdc.mutation.ResponseCode(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseCode, field.TypeInt, value)
+		_node.ResponseCode = value
+	}
+	if value, ok := wdc.mutation.Error(); ok {
+		_spec.SetField(webhookdelivery.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := wdc.mutation.NextAttemptAt(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = &value
+	}
+	if nodes := wdc.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WebhookID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookDelivery.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookDeliveryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (wdc *WebhookDeliveryCreate) OnConflict(opts ...sql.ConflictOption) *WebhookDeliveryUpsertOne {
+	wdc.conflict = opts
+	return &WebhookDeliveryUpsertOne{
+		create: wdc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wdc *WebhookDeliveryCreate) OnConflictColumns(columns ...string) *WebhookDeliveryUpsertOne {
+	wdc.conflict = append(wdc.conflict, sql.ConflictColumns(columns...))
+	return &WebhookDeliveryUpsertOne{
+		create: wdc,
+	}
+}
+
+type (
+	// WebhookDeliveryUpsertOne is the builder for "upsert"-ing
+	//  one WebhookDelivery node.
+	WebhookDeliveryUpsertOne struct {
+		create *WebhookDeliveryCreate
+	}
+
+	// WebhookDeliveryUpsert is the "OnConflict" setter.
+	WebhookDeliveryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookDeliveryUpsert) SetUpdatedAt(v time.Time) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateUpdatedAt() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *WebhookDeliveryUpsert) SetDeletedAt(v time.Time) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateDeletedAt() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *WebhookDeliveryUpsert) ClearDeletedAt() *WebhookDeliveryUpsert {
+	u.SetNull(webhookdelivery.FieldDeletedAt)
+	return u
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (u *WebhookDeliveryUpsert) SetWebhookID(v int) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldWebhookID, v)
+	return u
+}
+
+// UpdateWebhookID sets the "webhook_id" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateWebhookID() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldWebhookID)
+	return u
+}
+
+// SetEvent sets the "event" field.
+func (u *WebhookDeliveryUpsert) SetEvent(v string) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldEvent, v)
+	return u
+}
+
+// UpdateEvent sets the "event" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateEvent() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldEvent)
+	return u
+}
+
+// SetPayload sets the "payload" field.
+func (u *WebhookDeliveryUpsert) SetPayload(v string) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldPayload, v)
+	return u
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdatePayload() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldPayload)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *WebhookDeliveryUpsert) SetStatus(v webhookdelivery.Status) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateStatus() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldStatus)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *WebhookDeliveryUpsert) SetAttempts(v int) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateAttempts() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *WebhookDeliveryUpsert) AddAttempts(v int) *WebhookDeliveryUpsert {
+	u.Add(webhookdelivery.FieldAttempts, v)
+	return u
+}
+
+// SetResponseCode sets the "response_code" field.
+func (u *WebhookDeliveryUpsert) SetResponseCode(v int) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldResponseCode, v)
+	return u
+}
+
+// UpdateResponseCode sets the "response_code" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateResponseCode() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldResponseCode)
+	return u
+}
+
+// AddResponseCode adds v to the "response_code" field.
+func (u *WebhookDeliveryUpsert) AddResponseCode(v int) *WebhookDeliveryUpsert {
+	u.Add(webhookdelivery.FieldResponseCode, v)
+	return u
+}
+
+// ClearResponseCode clears the value of the "response_code" field.
+func (u *WebhookDeliveryUpsert) ClearResponseCode() *WebhookDeliveryUpsert {
+	u.SetNull(webhookdelivery.FieldResponseCode)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *WebhookDeliveryUpsert) SetError(v string) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateError() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *WebhookDeliveryUpsert) ClearError() *WebhookDeliveryUpsert {
+	u.SetNull(webhookdelivery.FieldError)
+	return u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *WebhookDeliveryUpsert) SetNextAttemptAt(v time.Time) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldNextAttemptAt, v)
+	return u
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateNextAttemptAt() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldNextAttemptAt)
+	return u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *WebhookDeliveryUpsert) ClearNextAttemptAt() *WebhookDeliveryUpsert {
+	u.SetNull(webhookdelivery.FieldNextAttemptAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WebhookDeliveryUpsertOne) UpdateNewValues() *WebhookDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(webhookdelivery.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WebhookDeliveryUpsertOne) Ignore() *WebhookDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookDeliveryUpsertOne) DoNothing() *WebhookDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookDeliveryCreate.OnConflict
+// documentation for more info.
+func (u *WebhookDeliveryUpsertOne) Update(set func(*WebhookDeliveryUpsert)) *WebhookDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookDeliveryUpsertOne) SetUpdatedAt(v time.Time) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateUpdatedAt() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *WebhookDeliveryUpsertOne) SetDeletedAt(v time.Time) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateDeletedAt() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *WebhookDeliveryUpsertOne) ClearDeletedAt() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (u *WebhookDeliveryUpsertOne) SetWebhookID(v int) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetWebhookID(v)
+	})
+}
+
+// UpdateWebhookID sets the "webhook_id" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateWebhookID() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateWebhookID()
+	})
+}
+
+// SetEvent sets the "event" field.
+func (u *WebhookDeliveryUpsertOne) SetEvent(v string) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetEvent(v)
+	})
+}
+
+// UpdateEvent sets the "event" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateEvent() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateEvent()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *WebhookDeliveryUpsertOne) SetPayload(v string) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdatePayload() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WebhookDeliveryUpsertOne) SetStatus(v webhookdelivery.Status) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateStatus() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *WebhookDeliveryUpsertOne) SetAttempts(v int) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *WebhookDeliveryUpsertOne) AddAttempts(v int) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateAttempts() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetResponseCode sets the "response_code" field.
+func (u *WebhookDeliveryUpsertOne) SetResponseCode(v int) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetResponseCode(v)
+	})
+}
+
+// AddResponseCode adds v to the "response_code" field.
+func (u *WebhookDeliveryUpsertOne) AddResponseCode(v int) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.AddResponseCode(v)
+	})
+}
+
+// UpdateResponseCode sets the "response_code" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateResponseCode() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateResponseCode()
+	})
+}
+
+// ClearResponseCode clears the value of the "response_code" field.
+func (u *WebhookDeliveryUpsertOne) ClearResponseCode() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearResponseCode()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *WebhookDeliveryUpsertOne) SetError(v string) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateError() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *WebhookDeliveryUpsertOne) ClearError() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *WebhookDeliveryUpsertOne) SetNextAttemptAt(v time.Time) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateNextAttemptAt() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *WebhookDeliveryUpsertOne) ClearNextAttemptAt() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearNextAttemptAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookDeliveryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WebhookDeliveryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookDeliveryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WebhookDeliveryUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WebhookDeliveryUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (m *WebhookDeliveryCreate) SetRawID(t int) *WebhookDeliveryCreate {
+	m.mutation.SetRawID(t)
+	return m
+}
+
+// WebhookDeliveryCreateBulk is the builder for creating many WebhookDelivery entities in bulk.
+type WebhookDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookDeliveryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WebhookDelivery entities in the database.
+func (wdcb *WebhookDeliveryCreateBulk) Save(ctx context.Context) ([]*WebhookDelivery, error) {
+	if wdcb.err != nil {
+		return nil, wdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wdcb.builders))
+	nodes := make([]*WebhookDelivery, len(wdcb.builders))
+	mutators := make([]Mutator, len(wdcb.builders))
+	for i := range wdcb.builders {
+		func(i int, root context.Context) {
+			builder := wdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wdcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) SaveX(ctx context.Context) []*WebhookDelivery {
+	v, err := wdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdcb *WebhookDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := wdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := wdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookDelivery.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookDeliveryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (wdcb *WebhookDeliveryCreateBulk) OnConflict(opts ...sql.ConflictOption) *WebhookDeliveryUpsertBulk {
+	wdcb.conflict = opts
+	return &WebhookDeliveryUpsertBulk{
+		create: wdcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wdcb *WebhookDeliveryCreateBulk) OnConflictColumns(columns ...string) *WebhookDeliveryUpsertBulk {
+	wdcb.conflict = append(wdcb.conflict, sql.ConflictColumns(columns...))
+	return &WebhookDeliveryUpsertBulk{
+		create: wdcb,
+	}
+}
+
+// WebhookDeliveryUpsertBulk is the builder for "upsert"-ing
+// a bulk of WebhookDelivery nodes.
+type WebhookDeliveryUpsertBulk struct {
+	create *WebhookDeliveryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WebhookDeliveryUpsertBulk) UpdateNewValues() *WebhookDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(webhookdelivery.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WebhookDeliveryUpsertBulk) Ignore() *WebhookDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookDeliveryUpsertBulk) DoNothing() *WebhookDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookDeliveryCreateBulk.OnConflict
+// documentation for more info.
+func (u *WebhookDeliveryUpsertBulk) Update(set func(*WebhookDeliveryUpsert)) *WebhookDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *WebhookDeliveryUpsertBulk) SetUpdatedAt(v time.Time) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateUpdatedAt() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *WebhookDeliveryUpsertBulk) SetDeletedAt(v time.Time) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateDeletedAt() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *WebhookDeliveryUpsertBulk) ClearDeletedAt() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (u *WebhookDeliveryUpsertBulk) SetWebhookID(v int) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetWebhookID(v)
+	})
+}
+
+// UpdateWebhookID sets the "webhook_id" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateWebhookID() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateWebhookID()
+	})
+}
+
+// SetEvent sets the "event" field.
+func (u *WebhookDeliveryUpsertBulk) SetEvent(v string) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetEvent(v)
+	})
+}
+
+// UpdateEvent sets the "event" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateEvent() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateEvent()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *WebhookDeliveryUpsertBulk) SetPayload(v string) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdatePayload() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WebhookDeliveryUpsertBulk) SetStatus(v webhookdelivery.Status) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateStatus() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *WebhookDeliveryUpsertBulk) SetAttempts(v int) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *WebhookDeliveryUpsertBulk) AddAttempts(v int) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateAttempts() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetResponseCode sets the "response_code" field.
+func (u *WebhookDeliveryUpsertBulk) SetResponseCode(v int) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetResponseCode(v)
+	})
+}
+
+// AddResponseCode adds v to the "response_code" field.
+func (u *WebhookDeliveryUpsertBulk) AddResponseCode(v int) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.AddResponseCode(v)
+	})
+}
+
+// UpdateResponseCode sets the "response_code" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateResponseCode() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateResponseCode()
+	})
+}
+
+// ClearResponseCode clears the value of the "response_code" field.
+func (u *WebhookDeliveryUpsertBulk) ClearResponseCode() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearResponseCode()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *WebhookDeliveryUpsertBulk) SetError(v string) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateError() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *WebhookDeliveryUpsertBulk) ClearError() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *WebhookDeliveryUpsertBulk) SetNextAttemptAt(v time.Time) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateNextAttemptAt() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *WebhookDeliveryUpsertBulk) ClearNextAttemptAt() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearNextAttemptAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookDeliveryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WebhookDeliveryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WebhookDeliveryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookDeliveryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,749 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhook"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhookdelivery"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wdu *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	wdu.mutation.Where(ps...)
+	return wdu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdu *WebhookDeliveryUpdate) SetUpdatedAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetUpdatedAt(t)
+	return wdu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (wdu *WebhookDeliveryUpdate) SetDeletedAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetDeletedAt(t)
+	return wdu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableDeletedAt(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetDeletedAt(*t)
+	}
+	return wdu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (wdu *WebhookDeliveryUpdate) ClearDeletedAt() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearDeletedAt()
+	return wdu
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wdu *WebhookDeliveryUpdate) SetWebhookID(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.SetWebhookID(i)
+	return wdu
+}
+
+// SetNillableWebhookID sets the "webhook_id" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableWebhookID(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetWebhookID(*i)
+	}
+	return wdu
+}
+
+// SetEvent sets the "event" field.
+func (wdu *WebhookDeliveryUpdate) SetEvent(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetEvent(s)
+	return wdu
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableEvent(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetEvent(*s)
+	}
+	return wdu
+}
+
+// SetPayload sets the "payload" field.
+func (wdu *WebhookDeliveryUpdate) SetPayload(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetPayload(s)
+	return wdu
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillablePayload(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetPayload(*s)
+	}
+	return wdu
+}
+
+// SetStatus sets the "status" field.
+func (wdu *WebhookDeliveryUpdate) SetStatus(w webhookdelivery.Status) *WebhookDeliveryUpdate {
+	wdu.mutation.SetStatus(w)
+	return wdu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableStatus(w *webhookdelivery.Status) *WebhookDeliveryUpdate {
+	if w != nil {
+		wdu.SetStatus(*w)
+	}
+	return wdu
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) SetAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetAttempts()
+	wdu.mutation.SetAttempts(i)
+	return wdu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableAttempts(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetAttempts(*i)
+	}
+	return wdu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) AddAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddAttempts(i)
+	return wdu
+}
+
+// SetResponseCode sets the "response_code" field.
+func (wdu *WebhookDeliveryUpdate) SetResponseCode(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetResponseCode()
+	wdu.mutation.SetResponseCode(i)
+	return wdu
+}
+
+// SetNillableResponseCode sets the "response_code" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableResponseCode(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetResponseCode(*i)
+	}
+	return wdu
+}
+
+// AddResponseCode adds i to the "response_code" field.
+func (wdu *WebhookDeliveryUpdate) AddResponseCode(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddResponseCode(i)
+	return wdu
+}
+
+// ClearResponseCode clears the value of the "response_code" field.
+func (wdu *WebhookDeliveryUpdate) ClearResponseCode() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearResponseCode()
+	return wdu
+}
+
+// SetError sets the "error" field.
+func (wdu *WebhookDeliveryUpdate) SetError(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetError(s)
+	return wdu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableError(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetError(*s)
+	}
+	return wdu
+}
+
+// ClearError clears the value of the "error" field.
+func (wdu *WebhookDeliveryUpdate) ClearError() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearError()
+	return wdu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wdu *WebhookDeliveryUpdate) SetNextAttemptAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetNextAttemptAt(t)
+	return wdu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetNextAttemptAt(*t)
+	}
+	return wdu
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (wdu *WebhookDeliveryUpdate) ClearNextAttemptAt() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearNextAttemptAt()
+	return wdu
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wdu *WebhookDeliveryUpdate) SetWebhook(w *Webhook) *WebhookDeliveryUpdate {
+	return wdu.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdu *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return wdu.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (wdu *WebhookDeliveryUpdate) ClearWebhook() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearWebhook()
+	return wdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdu *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	if err := wdu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, wdu.sqlSave, wdu.mutation, wdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdu *WebhookDeliveryUpdate) defaults() error {
+	if _, ok := wdu.mutation.UpdatedAt(); !ok {
+		if webhookdelivery.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized webhookdelivery.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		wdu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdu *WebhookDeliveryUpdate) check() error {
+	if v, ok := wdu.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.status": %w`, err)}
+		}
+	}
+	if _, ok := wdu.mutation.WebhookID(); wdu.mutation.WebhookCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "WebhookDelivery.webhook"`)
+	}
+	return nil
+}
+
+func (wdu *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt))
+	if ps := wdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wdu.mutation.DeletedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldDeletedAt, field.TypeTime, value)
+	}
+	if wdu.mutation.DeletedAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := wdu.mutation.Event(); ok {
+		_spec.SetField(webhookdelivery.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := wdu.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.ResponseCode(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseCode, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedResponseCode(); ok {
+		_spec.AddField(webhookdelivery.FieldResponseCode, field.TypeInt, value)
+	}
+	if wdu.mutation.ResponseCodeCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseCode, field.TypeInt)
+	}
+	if value, ok := wdu.mutation.Error(); ok {
+		_spec.SetField(webhookdelivery.FieldError, field.TypeString, value)
+	}
+	if wdu.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldError, field.TypeString)
+	}
+	if value, ok := wdu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if wdu.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldNextAttemptAt, field.TypeTime)
+	}
+	if wdu.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wdu.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wdu.mutation.done = true
+	return n, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetUpdatedAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetUpdatedAt(t)
+	return wduo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetDeletedAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetDeletedAt(t)
+	return wduo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableDeletedAt(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetDeletedAt(*t)
+	}
+	return wduo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearDeletedAt() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearDeletedAt()
+	return wduo
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetWebhookID(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetWebhookID(i)
+	return wduo
+}
+
+// SetNillableWebhookID sets the "webhook_id" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableWebhookID(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetWebhookID(*i)
+	}
+	return wduo
+}
+
+// SetEvent sets the "event" field.
+func (wduo *WebhookDeliveryUpdateOne) SetEvent(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetEvent(s)
+	return wduo
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableEvent(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetEvent(*s)
+	}
+	return wduo
+}
+
+// SetPayload sets the "payload" field.
+func (wduo *WebhookDeliveryUpdateOne) SetPayload(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetPayload(s)
+	return wduo
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillablePayload(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetPayload(*s)
+	}
+	return wduo
+}
+
+// SetStatus sets the "status" field.
+func (wduo *WebhookDeliveryUpdateOne) SetStatus(w webhookdelivery.Status) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetStatus(w)
+	return wduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableStatus(w *webhookdelivery.Status) *WebhookDeliveryUpdateOne {
+	if w != nil {
+		wduo.SetStatus(*w)
+	}
+	return wduo
+}
+
+// SetAttempts sets the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) SetAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetAttempts()
+	wduo.mutation.SetAttempts(i)
+	return wduo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableAttempts(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetAttempts(*i)
+	}
+	return wduo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) AddAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddAttempts(i)
+	return wduo
+}
+
+// SetResponseCode sets the "response_code" field.
+func (wduo *WebhookDeliveryUpdateOne) SetResponseCode(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetResponseCode()
+	wduo.mutation.SetResponseCode(i)
+	return wduo
+}
+
+// SetNillableResponseCode sets the "response_code" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableResponseCode(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetResponseCode(*i)
+	}
+	return wduo
+}
+
+// AddResponseCode adds i to the "response_code" field.
+func (wduo *WebhookDeliveryUpdateOne) AddResponseCode(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddResponseCode(i)
+	return wduo
+}
+
+// ClearResponseCode clears the value of the "response_code" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearResponseCode() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearResponseCode()
+	return wduo
+}
+
+// SetError sets the "error" field.
+func (wduo *WebhookDeliveryUpdateOne) SetError(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetError(s)
+	return wduo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableError(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetError(*s)
+	}
+	return wduo
+}
+
+// ClearError clears the value of the "error" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearError() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearError()
+	return wduo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetNextAttemptAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetNextAttemptAt(t)
+	return wduo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetNextAttemptAt(*t)
+	}
+	return wduo
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearNextAttemptAt() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearNextAttemptAt()
+	return wduo
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wduo *WebhookDeliveryUpdateOne) SetWebhook(w *Webhook) *WebhookDeliveryUpdateOne {
+	return wduo.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wduo *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return wduo.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (wduo *WebhookDeliveryUpdateOne) ClearWebhook() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearWebhook()
+	return wduo
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wduo *WebhookDeliveryUpdateOne) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdateOne {
+	wduo.mutation.Where(ps...)
+	return wduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wduo *WebhookDeliveryUpdateOne) Select(field string, fields ...string) *WebhookDeliveryUpdateOne {
+	wduo.fields = append([]string{field}, fields...)
+	return wduo
+}
+
+// Save executes the query and returns the updated WebhookDelivery entity.
+func (wduo *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	if err := wduo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, wduo.sqlSave, wduo.mutation, wduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wduo *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wduo *WebhookDeliveryUpdateOne) defaults() error {
+	if _, ok := wduo.mutation.UpdatedAt(); !ok {
+		if webhookdelivery.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized webhookdelivery.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		wduo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wduo *WebhookDeliveryUpdateOne) check() error {
+	if v, ok := wduo.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WebhookDelivery.status": %w`, err)}
+		}
+	}
+	if _, ok := wduo.mutation.WebhookID(); wduo.mutation.WebhookCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "WebhookDelivery.webhook"`)
+	}
+	return nil
+}
+
+func (wduo *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	if err := wduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt))
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for _, f := range fields {
+			if !webhookdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := wduo.mutation.DeletedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldDeletedAt, field.TypeTime, value)
+	}
+	if wduo.mutation.DeletedAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := wduo.mutation.Event(); ok {
+		_spec.SetField(webhookdelivery.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := wduo.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.ResponseCode(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseCode, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedResponseCode(); ok {
+		_spec.AddField(webhookdelivery.FieldResponseCode, field.TypeInt, value)
+	}
+	if wduo.mutation.ResponseCodeCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseCode, field.TypeInt)
+	}
+	if value, ok := wduo.mutation.Error(); ok {
+		_spec.SetField(webhookdelivery.FieldError, field.TypeString, value)
+	}
+	if wduo.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldError, field.TypeString)
+	}
+	if value, ok := wduo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if wduo.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldNextAttemptAt, field.TypeTime)
+	}
+	if wduo.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wduo.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WebhookDelivery{config: wduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,123 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/announcement"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/samber/lo"
+)
+
+type (
+	AnnouncementClient interface {
+		TxOperator
+		// ListAll returns all announcements, newest first.
+		ListAll(ctx context.Context) ([]*ent.Announcement, error)
+		// ListActive returns announcements shown at given time, newest first. IDs of ones dismissed by
+		// given user are loaded into edges.
+		ListActive(ctx context.Context, now time.Time, uid int) ([]*ent.Announcement, error)
+		// GetByID returns announcement by its ID.
+		GetByID(ctx context.Context, id int) (*ent.Announcement, error)
+		// Upsert creates a new announcement if ID is 0, otherwise updates the existing one.
+		Upsert(ctx context.Context, a *ent.Announcement) (*ent.Announcement, error)
+		// Delete deletes announcement by its ID.
+		Delete(ctx context.Context, id int) error
+		// Dismiss hides the announcement for given user.
+		Dismiss(ctx context.Context, id, uid int) error
+	}
+)
+
+func NewAnnouncementClient(client *ent.Client) AnnouncementClient {
+	return &announcementClient{client: client}
+}
+
+type announcementClient struct {
+	client *ent.Client
+}
+
+func (c *announcementClient) SetClient(newClient *ent.Client) TxOperator {
+	return &announcementClient{client: newClient}
+}
+
+func (c *announcementClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *announcementClient) ListAll(ctx context.Context) ([]*ent.Announcement, error) {
+	return c.client.Announcement.Query().Order(announcement.ByID(sql.OrderDesc())).All(ctx)
+}
+
+func (c *announcementClient) ListActive(ctx context.Context, now time.Time, uid int) ([]*ent.Announcement, error) {
+	return c.client.Announcement.Query().
+		Where(
+			announcement.Or(announcement.StartsAtIsNil(), announcement.StartsAtLTE(now)),
+			announcement.Or(announcement.EndsAtIsNil(), announcement.EndsAtGT(now)),
+		).
+		WithDismissedBy(func(q *ent.UserQuery) {
+			q.Where(user.ID(uid)).Select(user.FieldID)
+		}).
+		Order(announcement.ByID(sql.OrderDesc())).
+		All(ctx)
+}
+
+func (c *announcementClient) GetByID(ctx context.Context, id int) (*ent.Announcement, error) {
+	return c.client.Announcement.Get(ctx, id)
+}
+
+func (c *announcementClient) Upsert(ctx context.Context, a *ent.Announcement) (*ent.Announcement, error) {
+	if a.ID == 0 {
+		return c.client.Announcement.Create().
+			SetTitle(a.Title).
+			SetBody(a.Body).
+			SetGroups(a.Groups).
+			SetNillableStartsAt(a.StartsAt).
+			SetNillableEndsAt(a.EndsAt).
+			SetDismissible(a.Dismissible).
+			Save(ctx)
+	}
+
+	update := c.client.Announcement.UpdateOneID(a.ID).
+		SetTitle(a.Title).
+		SetBody(a.Body).
+		SetGroups(a.Groups).
+		SetDismissible(a.Dismissible)
+	if a.StartsAt != nil {
+		update.SetStartsAt(*a.StartsAt)
+	} else {
+		update.ClearStartsAt()
+	}
+	if a.EndsAt != nil {
+		update.SetEndsAt(*a.EndsAt)
+	} else {
+		update.ClearEndsAt()
+	}
+
+	return update.Save(ctx)
+}
+
+func (c *announcementClient) Delete(ctx context.Context, id int) error {
+	if err := c.client.Announcement.UpdateOneID(id).ClearDismissedBy().Exec(ctx); err != nil {
+		return fmt.Errorf("failed to clear dismissals: %w", err)
+	}
+
+	return c.client.Announcement.DeleteOneID(id).Exec(schema.SkipSoftDelete(ctx))
+}
+
+func (c *announcementClient) Dismiss(ctx context.Context, id, uid int) error {
+	return c.client.Announcement.UpdateOneID(id).AddDismissedByIDs(uid).Exec(ctx)
+}
+
+// AnnouncementVisibleTo returns whether the announcement targets given group.
+func AnnouncementVisibleTo(a *ent.Announcement, groupID int) bool {
+	return len(a.Groups) == 0 || lo.Contains(a.Groups, groupID)
+}
+
+// AnnouncementDismissed returns whether the announcement loaded by ListActive is dismissed by user.
+func AnnouncementDismissed(a *ent.Announcement) bool {
+	return a.Dismissible && len(a.Edges.DismissedBy) > 0
+}
@@ -0,0 +1,146 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/auditlog"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+)
+
+type (
+	AuditLogClient interface {
+		TxOperator
+		// Create appends a new audit log.
+		Create(ctx context.Context, args *AuditLogArgs) (*ent.AuditLog, error)
+		// List returns audit logs matching given args, newest first.
+		List(ctx context.Context, args *ListAuditLogArgs) ([]*ent.AuditLog, error)
+		// Count returns the number of audit logs matching given args, cursor and pagination are ignored.
+		Count(ctx context.Context, args *ListAuditLogArgs) (int, error)
+		// DeleteBefore hard-deletes audit logs created before given time, returns the number of deleted logs.
+		DeleteBefore(ctx context.Context, before time.Time) (int, error)
+	}
+
+	AuditLogArgs struct {
+		Type          string
+		CreatedAt     time.Time
+		UserID        int
+		ActorID       int
+		IP            string
+		UserAgent     string
+		CorrelationID string
+		EntityType    string
+		EntityID      int
+		Content       map[string]string
+	}
+
+	// ListAuditLogArgs filters audit logs. Zero value fields are ignored.
+	ListAuditLogArgs struct {
+		// BeforeID only returns logs with ID less than it, used as cursor for the next page.
+		BeforeID int
+		// Offset skips given number of matching logs.
+		Offset     int
+		PageSize   int
+		ActorID    int
+		UserID     int
+		EntityType string
+		Types      []string
+		IP         string
+		From       time.Time
+		To         time.Time
+	}
+)
+
+func NewAuditLogClient(client *ent.Client, dbType conf.DBType) AuditLogClient {
+	return &auditLogClient{client: client, maxSQlParam: sqlParamLimit(dbType)}
+}
+
+type auditLogClient struct {
+	maxSQlParam int
+	client      *ent.Client
+}
+
+func (c *auditLogClient) SetClient(newClient *ent.Client) TxOperator {
+	return &auditLogClient{client: newClient, maxSQlParam: c.maxSQlParam}
+}
+
+func (c *auditLogClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *auditLogClient) Create(ctx context.Context, args *AuditLogArgs) (*ent.AuditLog, error) {
+	stm := c.client.AuditLog.Create().
+		SetType(args.Type).
+		SetUserID(args.UserID).
+		SetActorID(args.ActorID).
+		SetIP(args.IP).
+		SetUserAgent(args.UserAgent).
+		SetCorrelationID(args.CorrelationID).
+		SetEntityType(args.EntityType).
+		SetEntityID(args.EntityID).
+		SetContent(args.Content)
+	if !args.CreatedAt.IsZero() {
+		stm.SetCreatedAt(args.CreatedAt)
+	}
+
+	return stm.Save(ctx)
+}
+
+func (c *auditLogClient) List(ctx context.Context, args *ListAuditLogArgs) ([]*ent.AuditLog, error) {
+	query := auditLogQuery(c.client.AuditLog.Query(), args)
+	if args.BeforeID > 0 {
+		query.Where(auditlog.IDLT(args.BeforeID))
+	}
+	if args.Offset > 0 {
+		query.Offset(args.Offset)
+	}
+
+	pageSize := capPageSize(c.maxSQlParam, args.PageSize, 10)
+	return query.Order(auditlog.ByID(sql.OrderDesc())).Limit(pageSize).All(ctx)
+}
+
+func (c *auditLogClient) Count(ctx context.Context, args *ListAuditLogArgs) (int, error) {
+	return auditLogQuery(c.client.AuditLog.Query(), args).Count(ctx)
+}
+
+// auditLogQuery applies filters in args to the query.
+func auditLogQuery(query *ent.AuditLogQuery, args *ListAuditLogArgs) *ent.AuditLogQuery {
+	if args.ActorID > 0 {
+		query.Where(auditlog.ActorID(args.ActorID))
+	}
+	if args.UserID > 0 {
+		query.Where(auditlog.UserID(args.UserID))
+	}
+	if args.EntityType != "" {
+		query.Where(auditlog.EntityType(args.EntityType))
+	}
+	if len(args.Types) > 0 {
+		query.Where(auditlog.TypeIn(args.Types...))
+	}
+	if args.IP != "" {
+		query.Where(auditlog.IP(args.IP))
+	}
+	if !args.From.IsZero() {
+		query.Where(auditlog.CreatedAtGTE(args.From))
+	}
+	if !args.To.IsZero() {
+		query.Where(auditlog.CreatedAtLTE(args.To))
+	}
+
+	return query
+}
+
+func (c *auditLogClient) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	deleted, err := c.client.AuditLog.Delete().
+		Where(auditlog.CreatedAtLT(before)).
+		Exec(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete audit logs: %w", err)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,123 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/automationrule"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+type (
+	AutomationRuleClient interface {
+		TxOperator
+		// Create creates an automation rule for given user.
+		Create(ctx context.Context, uid int, args *AutomationRuleArgs) (*ent.AutomationRule, error)
+		// Update updates an automation rule.
+		Update(ctx context.Context, id int, args *AutomationRuleArgs) (*ent.AutomationRule, error)
+		// GetByIDAndUserID returns the rule with given id and user id.
+		GetByIDAndUserID(ctx context.Context, id, uid int) (*ent.AutomationRule, error)
+		// List returns rules of given user, oldest first.
+		List(ctx context.Context, uid int) ([]*ent.AutomationRule, error)
+		// ListEnabled returns enabled rules of given user and trigger, in the order they are evaluated.
+		ListEnabled(ctx context.Context, uid int, trigger string) ([]*ent.AutomationRule, error)
+		// Count returns the number of rules of given user.
+		Count(ctx context.Context, uid int) (int, error)
+		// Delete deletes the rule.
+		Delete(ctx context.Context, id int) error
+		// MarkTriggered saves result of applying the rule, lastErr is empty if succeeded.
+		MarkTriggered(ctx context.Context, id int, triggeredAt time.Time, lastErr string) error
+	}
+
+	AutomationRuleArgs struct {
+		Name       string
+		Trigger    string
+		Conditions *types.AutomationConditions
+		Actions    []types.AutomationAction
+		Enabled    bool
+	}
+)
+
+func NewAutomationRuleClient(client *ent.Client) AutomationRuleClient {
+	return &automationRuleClient{client: client}
+}
+
+type automationRuleClient struct {
+	client *ent.Client
+}
+
+func (c *automationRuleClient) SetClient(newClient *ent.Client) TxOperator {
+	return &automationRuleClient{client: newClient}
+}
+
+func (c *automationRuleClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *automationRuleClient) Create(ctx context.Context, uid int, args *AutomationRuleArgs) (*ent.AutomationRule, error) {
+	created, err := c.client.AutomationRule.Create().
+		SetUserID(uid).
+		SetName(args.Name).
+		SetTrigger(args.Trigger).
+		SetConditions(args.Conditions).
+		SetActions(args.Actions).
+		SetEnabled(args.Enabled).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create automation rule: %w", err)
+	}
+
+	return created, nil
+}
+
+func (c *automationRuleClient) Update(ctx context.Context, id int, args *AutomationRuleArgs) (*ent.AutomationRule, error) {
+	return c.client.AutomationRule.UpdateOneID(id).
+		SetName(args.Name).
+		SetTrigger(args.Trigger).
+		SetConditions(args.Conditions).
+		SetActions(args.Actions).
+		SetEnabled(args.Enabled).
+		Save(ctx)
+}
+
+func (c *automationRuleClient) GetByIDAndUserID(ctx context.Context, id, uid int) (*ent.AutomationRule, error) {
+	return c.client.AutomationRule.Query().
+		Where(automationrule.ID(id), automationrule.UserID(uid)).
+		First(ctx)
+}
+
+func (c *automationRuleClient) List(ctx context.Context, uid int) ([]*ent.AutomationRule, error) {
+	return c.client.AutomationRule.Query().
+		Where(automationrule.UserID(uid)).
+		Order(automationrule.ByID(sql.OrderAsc())).
+		All(ctx)
+}
+
+func (c *automationRuleClient) ListEnabled(ctx context.Context, uid int, trigger string) ([]*ent.AutomationRule, error) {
+	return c.client.AutomationRule.Query().
+		Where(
+			automationrule.UserID(uid),
+			automationrule.Trigger(trigger),
+			automationrule.Enabled(true),
+		).
+		Order(automationrule.ByID(sql.OrderAsc())).
+		All(ctx)
+}
+
+func (c *automationRuleClient) Count(ctx context.Context, uid int) (int, error) {
+	return c.client.AutomationRule.Query().Where(automationrule.UserID(uid)).Count(ctx)
+}
+
+func (c *automationRuleClient) Delete(ctx context.Context, id int) error {
+	return c.client.AutomationRule.DeleteOneID(id).Exec(ctx)
+}
+
+func (c *automationRuleClient) MarkTriggered(ctx context.Context, id int, triggeredAt time.Time, lastErr string) error {
+	return c.client.AutomationRule.UpdateOneID(id).
+		SetLastTriggeredAt(triggeredAt).
+		SetLastError(lastErr).
+		Exec(ctx)
+}
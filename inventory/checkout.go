@@ -0,0 +1,140 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/checkout"
+	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+type (
+	CheckoutClient interface {
+		TxOperator
+		// Create checks out a file, expired check-outs in the same namespace are removed.
+		Create(ctx context.Context, args *CheckoutArgs) (*ent.Checkout, error)
+		// List returns active check-outs in given lock namespace with owners loaded.
+		List(ctx context.Context, ns string) ([]*ent.Checkout, error)
+		// ListByTokens returns active check-outs with given tokens with owners loaded.
+		ListByTokens(ctx context.Context, tokens ...string) ([]*ent.Checkout, error)
+		// Conflicts returns active check-outs in ns locking given path. For a lock of infinite depth, check-outs
+		// on its descendants are also returned.
+		Conflicts(ctx context.Context, ns, path string, zeroDepth bool) ([]*ent.Checkout, error)
+		// Delete deletes check-outs with given tokens.
+		Delete(ctx context.Context, tokens ...string) error
+	}
+
+	CheckoutArgs struct {
+		Token     string
+		Ns        string
+		Path      string
+		FileType  types.FileType
+		ZeroDepth bool
+		ExpireAt  time.Time
+		OwnerID   int
+	}
+)
+
+func NewCheckoutClient(client *ent.Client) CheckoutClient {
+	return &checkoutClient{client: client}
+}
+
+type checkoutClient struct {
+	client *ent.Client
+}
+
+func (c *checkoutClient) SetClient(newClient *ent.Client) TxOperator {
+	return &checkoutClient{client: newClient}
+}
+
+func (c *checkoutClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *checkoutClient) Create(ctx context.Context, args *CheckoutArgs) (*ent.Checkout, error) {
+	if _, err := c.client.Checkout.Delete().
+		Where(checkout.Ns(args.Ns), checkout.ExpireAtLTE(time.Now())).
+		Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return nil, fmt.Errorf("failed to delete expired check-outs: %w", err)
+	}
+
+	created, err := c.client.Checkout.Create().
+		SetToken(args.Token).
+		SetNs(args.Ns).
+		SetPath(args.Path).
+		SetFileType(int(args.FileType)).
+		SetZeroDepth(args.ZeroDepth).
+		SetExpireAt(args.ExpireAt).
+		SetOwnerID(args.OwnerID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create check-out: %w", err)
+	}
+
+	return created, nil
+}
+
+func (c *checkoutClient) List(ctx context.Context, ns string) ([]*ent.Checkout, error) {
+	return c.active().
+		Where(checkout.Ns(ns)).
+		WithOwner().
+		Order(checkout.ByPath()).
+		All(ctx)
+}
+
+func (c *checkoutClient) ListByTokens(ctx context.Context, tokens ...string) ([]*ent.Checkout, error) {
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	return c.active().
+		Where(checkout.TokenIn(tokens...)).
+		WithOwner().
+		All(ctx)
+}
+
+func (c *checkoutClient) Conflicts(ctx context.Context, ns, path string, zeroDepth bool) ([]*ent.Checkout, error) {
+	ancestors := make([]string, 0)
+	for name := path; name != "/" && name != ""; {
+		name = name[:strings.LastIndex(name, "/")]
+		if name == "" {
+			name = "/"
+		}
+		ancestors = append(ancestors, name)
+	}
+
+	conditions := []predicate.Checkout{checkout.Path(path)}
+	if len(ancestors) > 0 {
+		conditions = append(conditions, checkout.And(checkout.PathIn(ancestors...), checkout.ZeroDepth(false)))
+	}
+	if !zeroDepth {
+		if path == "/" {
+			conditions = append(conditions, checkout.PathHasPrefix("/"))
+		} else {
+			conditions = append(conditions, checkout.PathHasPrefix(path+"/"))
+		}
+	}
+
+	return c.active().
+		Where(checkout.Ns(ns), checkout.Or(conditions...)).
+		WithOwner().
+		All(ctx)
+}
+
+func (c *checkoutClient) Delete(ctx context.Context, tokens ...string) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	_, err := c.client.Checkout.Delete().Where(checkout.TokenIn(tokens...)).Exec(schema.SkipSoftDelete(ctx))
+	return err
+}
+
+func (c *checkoutClient) active() *ent.CheckoutQuery {
+	return c.client.Checkout.Query().Where(checkout.ExpireAtGT(time.Now()))
+}
@@ -0,0 +1,82 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	_ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCheckoutClient(t *testing.T) (CheckoutClient, *ent.User) {
+	drv, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	group := client.Group.Create().SetName("test").SetPermissions(&boolset.BooleanSet{}).SaveX(ctx)
+	owner := client.User.Create().SetEmail("owner@cloudreve.org").SetNick("owner").SetGroupUsers(group.ID).SaveX(ctx)
+	return NewCheckoutClient(client), owner
+}
+
+func TestCheckoutClient_Conflicts(t *testing.T) {
+	a := assert.New(t)
+	c, owner := newTestCheckoutClient(t)
+	ctx := context.Background()
+	expireAt := time.Now().Add(time.Hour)
+
+	for _, args := range []*CheckoutArgs{
+		{Token: "file", Ns: "1/my", Path: "/a/b/c.txt", FileType: types.FileTypeFile, ZeroDepth: true, ExpireAt: expireAt},
+		{Token: "folder", Ns: "1/my", Path: "/a/d", FileType: types.FileTypeFolder, ExpireAt: expireAt},
+		{Token: "expired", Ns: "1/my", Path: "/e", FileType: types.FileTypeFile, ZeroDepth: true, ExpireAt: time.Now().Add(-time.Hour)},
+		{Token: "other", Ns: "2/my", Path: "/a/b/c.txt", FileType: types.FileTypeFile, ZeroDepth: true, ExpireAt: expireAt},
+	} {
+		args.OwnerID = owner.ID
+		_, err := c.Create(ctx, args)
+		a.NoError(err)
+	}
+
+	conflicts := func(path string, zeroDepth bool) []string {
+		res, err := c.Conflicts(ctx, "1/my", path, zeroDepth)
+		a.NoError(err)
+		return lo.Map(res, func(item *ent.Checkout, index int) string {
+			return item.Token
+		})
+	}
+
+	a.ElementsMatch([]string{"file"}, conflicts("/a/b/c.txt", true))
+	a.Empty(conflicts("/a/b/d.txt", true))
+	// Infinite lock on ancestor conflicts with check-outs of descendants
+	a.ElementsMatch([]string{"file"}, conflicts("/a/b", false))
+	a.ElementsMatch([]string{"file", "folder"}, conflicts("/", false))
+	a.Empty(conflicts("/a/b", true))
+	// Check-out of folder covers its descendants
+	a.ElementsMatch([]string{"folder"}, conflicts("/a/d/e/f.txt", true))
+	a.Empty(conflicts("/a/dd", true))
+	// Expired check-outs are ignored
+	a.Empty(conflicts("/e", true))
+
+	checkouts, err := c.List(ctx, "1/my")
+	a.NoError(err)
+	a.Len(checkouts, 2)
+	a.Equal("owner", checkouts[0].Edges.Owner.Nick)
+
+	a.NoError(c.Delete(ctx, "file"))
+	a.Empty(conflicts("/a/b/c.txt", true))
+	checkouts, err = c.ListByTokens(ctx, "file", "folder", "other")
+	a.NoError(err)
+	a.Len(checkouts, 2)
+}
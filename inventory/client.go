@@ -8,6 +8,7 @@ import (
 	"os"
 	"time"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/ent"
@@ -24,6 +25,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	_ "github.com/go-sql-driver/mysql"
+	"github.com/gofrs/uuid"
 	_ "github.com/lib/pq"
 	"modernc.org/sqlite"
 )
@@ -32,6 +34,12 @@ const (
 	DBVersionPrefix           = "db_version_"
 	EnvDefaultOverwritePrefix = "CR_SETTING_DEFAULT_"
 	EnvEnableAria2            = "CR_ENABLE_ARIA2"
+
+	migrationLockKey = "db_migration_lock"
+	// migrationLockTTL is how long the migration lock is kept if its holder exits unexpectedly, in seconds.
+	// It is renewed while migration is running.
+	migrationLockTTL      = 60
+	migrationLockInterval = 3 * time.Second
 )
 
 // InitializeDBClient runs migration and returns a new ent.Client with additional configurations
@@ -41,19 +49,47 @@ func InitializeDBClient(l logging.Logger,
 	ctx := context.WithValue(context.Background(), logging.LoggerCtx{}, l)
 	if needMigration(client, ctx, requiredDbVersion) {
 		// Run the auto migration tool.
-		if err := migrate(l, client, ctx, kv, requiredDbVersion); err != nil {
+		if err := migrateWithLock(l, client, ctx, kv, requiredDbVersion); err != nil {
 			return nil, fmt.Errorf("failed to migrate database: %w", err)
 		}
 	} else {
 		l.Info("Database schema is up to date.")
 	}
 
+	registerOrganizationInterceptors(client)
+
 	//createMockData(client, ctx)
 	return client, nil
 }
 
 // NewRawEntClient returns a new ent.Client without additional configurations.
 func NewRawEntClient(l logging.Logger, config conf.ConfigProvider) (*ent.Client, error) {
+	client, err := NewSQLDriver(l, config)
+	if err != nil {
+		return nil, err
+	}
+
+	var drv dialect.Driver = client
+
+	// Enable verbose logging for debug mode.
+	if config.System().Debug {
+		l.Debug("Debug mode is enabled for DB client.")
+		drv = debug.DebugWithContext(drv, func(ctx context.Context, i ...any) {
+			logging.FromContext(ctx).Debug(i[0].(string), i[1:]...)
+		})
+	}
+
+	if config.Tracing().Enabled {
+		drv = withTracing(drv)
+	}
+
+	driverOpt := ent.Driver(drv)
+
+	return ent.NewClient(driverOpt), nil
+}
+
+// NewSQLDriver opens the configured database with connection pool settings applied.
+func NewSQLDriver(l logging.Logger, config conf.ConfigProvider) (*sql.Driver, error) {
 	l.Info("Initializing database connection...")
 	dbConfig := config.Database()
 	confDBType := dbConfig.Type
@@ -117,17 +153,7 @@ func NewRawEntClient(l logging.Logger, config conf.ConfigProvider) (*ent.Client,
 	// Set timeout
 	db.SetConnMaxLifetime(time.Second * 30)
 
-	driverOpt := ent.Driver(client)
-
-	// Enable verbose logging for debug mode.
-	if config.System().Debug {
-		l.Debug("Debug mode is enabled for DB client.")
-		driverOpt = ent.Driver(debug.DebugWithContext(client, func(ctx context.Context, i ...any) {
-			logging.FromContext(ctx).Debug(i[0].(string), i[1:]...)
-		}))
-	}
-
-	return ent.NewClient(driverOpt), nil
+	return client, nil
 }
 
 type sqlite3Driver struct {
@@ -168,6 +194,67 @@ func needMigration(client *ent.Client, ctx context.Context, requiredDbVersion st
 	return c == 0
 }
 
+// migrateWithLock runs migration while holding a lock in KV store, so that only one of the master
+// instances sharing the same database migrates it, others wait until the migration is done.
+func migrateWithLock(l logging.Logger, client *ent.Client, ctx context.Context, kv cache.Driver, requiredDbVersion string) error {
+	id := uuid.Must(uuid.NewV4()).String()
+	for {
+		acquired, err := kv.SetNX(migrationLockKey, id, migrationLockTTL)
+		if err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+
+		if acquired {
+			break
+		}
+
+		l.Info("Database is being migrated by another instance, waiting...")
+		time.Sleep(migrationLockInterval)
+		if !needMigration(client, ctx, requiredDbVersion) {
+			l.Info("Database is migrated by another instance.")
+			return nil
+		}
+	}
+
+	done := make(chan struct{})
+	go renewMigrationLock(l, kv, id, done)
+	defer func() {
+		close(done)
+		if _, err := kv.CompareAndDelete(migrationLockKey, id); err != nil {
+			l.Warning("Failed to release migration lock: %s", err)
+		}
+	}()
+
+	// Another instance might have finished the migration before we acquire the lock.
+	if !needMigration(client, ctx, requiredDbVersion) {
+		l.Info("Database is migrated by another instance.")
+		return nil
+	}
+
+	return migrate(l, client, ctx, kv, requiredDbVersion)
+}
+
+// renewMigrationLock keeps the migration lock held by id alive until done is closed, as migrating a large
+// database may take longer than the lock TTL.
+func renewMigrationLock(l logging.Logger, kv cache.Driver, id string, done <-chan struct{}) {
+	ticker := time.NewTicker(migrationLockTTL * time.Second / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			renewed, err := kv.CompareAndSwap(migrationLockKey, id, id, migrationLockTTL)
+			if err != nil {
+				l.Warning("Failed to renew migration lock: %s", err)
+			} else if !renewed {
+				l.Warning("Migration lock is lost, another instance may start migrating.")
+			}
+		}
+	}
+}
+
 func migrate(l logging.Logger, client *ent.Client, ctx context.Context, kv cache.Driver, requiredDbVersion string) error {
 	l.Info("Start initializing database schema...")
 	l.Info("Creating basic table schema...")
@@ -285,6 +372,8 @@ func migrateAdminGroup(l logging.Logger, client *ent.Client, ctx context.Context
 		types.GroupPermissionRedirectedSource:    true,
 		types.GroupPermissionAdvanceDelete:       true,
 		types.GroupPermissionIgnoreFileOwnership: true,
+		types.GroupPermissionTranscode:           true,
+		types.GroupPermissionOfficeEdit:          true,
 		// TODO: review default permission
 	}, permissions)
 	if _, err := client.Group.Create().
@@ -3,6 +3,7 @@ package inventory
 import (
 	"encoding/base64"
 	"encoding/json"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"fmt"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
@@ -83,6 +84,26 @@ func (p *PageToken) Encode(hasher hashid.Encoder, encodeFunc hashid.EncodeFunc)
 }
 
 // sqlParamLimit returns the max number of sql parameters.
+// lockForUpdate locks selected rows until the transaction ends. SQLite does not support row locks,
+// its write transactions are serialized instead.
+func lockForUpdate(s *sql.Selector) {
+	if d := s.Dialect(); d == dialect.MySQL || d == dialect.Postgres {
+		s.ForUpdate()
+	}
+}
+
+// sqlDialect returns the dialect of SQL builder for given database type.
+func sqlDialect(dbType conf.DBType) string {
+	switch dbType {
+	case conf.PostgresDB:
+		return dialect.Postgres
+	case conf.MySqlDB, conf.MsSqlDB:
+		return dialect.MySQL
+	default:
+		return dialect.SQLite
+	}
+}
+
 func sqlParamLimit(dbType conf.DBType) int {
 	switch dbType {
 	case conf.PostgresDB:
@@ -0,0 +1,128 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/dailyactiveuser"
+	"github.com/cloudreve/Cloudreve/v4/ent/dailystat"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/samber/lo"
+)
+
+// dailyActiveUserBatchSize is the number of active users inserted in a single statement.
+const dailyActiveUserBatchSize = 200
+
+type (
+	DailyStatClient interface {
+		TxOperator
+		// Update locks stats of given date, creates it if not exist, records given active users of the day
+		// and saves changes made by fn.
+		Update(ctx context.Context, date string, activeUsers []int, fn func(s *ent.DailyStat)) error
+		// Range returns stats of days between from and to (inclusive), oldest first.
+		Range(ctx context.Context, from, to string) ([]*ent.DailyStat, error)
+		// Prune deletes stats of days before given date, returns the number of deleted days.
+		Prune(ctx context.Context, before string) (int, error)
+	}
+)
+
+func NewDailyStatClient(client *ent.Client) DailyStatClient {
+	return &dailyStatClient{client: client}
+}
+
+type dailyStatClient struct {
+	client *ent.Client
+}
+
+func (c *dailyStatClient) SetClient(newClient *ent.Client) TxOperator {
+	return &dailyStatClient{client: newClient}
+}
+
+func (c *dailyStatClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *dailyStatClient) Update(ctx context.Context, date string, activeUsers []int, fn func(s *ent.DailyStat)) error {
+	sc, tx, ctx, err := WithTx(ctx, DailyStatClient(c))
+	if err != nil {
+		return err
+	}
+
+	client := sc.GetClient()
+	if err := client.DailyStat.Create().
+		SetDate(date).
+		OnConflictColumns(dailystat.FieldDate).
+		Ignore().
+		Exec(ctx); err != nil {
+		_ = Rollback(tx)
+		return fmt.Errorf("failed to create daily stats of %s: %w", date, err)
+	}
+
+	stats, err := client.DailyStat.Query().
+		Where(dailystat.Date(date), lockForUpdate).
+		Only(ctx)
+	if err != nil {
+		_ = Rollback(tx)
+		return fmt.Errorf("failed to lock daily stats of %s: %w", date, err)
+	}
+
+	if len(activeUsers) > 0 {
+		for _, chunk := range lo.Chunk(activeUsers, dailyActiveUserBatchSize) {
+			if err := client.DailyActiveUser.MapCreateBulk(chunk, func(create *ent.DailyActiveUserCreate, i int) {
+				create.SetDate(date).SetUserID(chunk[i])
+			}).
+				OnConflictColumns(dailyactiveuser.FieldDate, dailyactiveuser.FieldUserID).
+				Ignore().
+				Exec(ctx); err != nil {
+				_ = Rollback(tx)
+				return fmt.Errorf("failed to record active users of %s: %w", date, err)
+			}
+		}
+
+		if stats.ActiveUserCount, err = client.DailyActiveUser.Query().
+			Where(dailyactiveuser.Date(date)).
+			Count(ctx); err != nil {
+			_ = Rollback(tx)
+			return fmt.Errorf("failed to count active users of %s: %w", date, err)
+		}
+	}
+
+	fn(stats)
+	if err := client.DailyStat.UpdateOne(stats).
+		SetActiveUserCount(stats.ActiveUserCount).
+		SetUploads(stats.Uploads).
+		SetUploadSize(stats.UploadSize).
+		SetDownloads(stats.Downloads).
+		SetDownloadSize(stats.DownloadSize).
+		SetFileTypes(stats.FileTypes).
+		SetPolicyStorage(stats.PolicyStorage).
+		SetGroupStorage(stats.GroupStorage).
+		SetTopUsers(stats.TopUsers).
+		Exec(ctx); err != nil {
+		_ = Rollback(tx)
+		return fmt.Errorf("failed to save daily stats of %s: %w", date, err)
+	}
+
+	return Commit(tx)
+}
+
+func (c *dailyStatClient) Range(ctx context.Context, from, to string) ([]*ent.DailyStat, error) {
+	return c.client.DailyStat.Query().
+		Where(dailystat.DateGTE(from), dailystat.DateLTE(to)).
+		Order(ent.Asc(dailystat.FieldDate)).
+		All(ctx)
+}
+
+func (c *dailyStatClient) Prune(ctx context.Context, before string) (int, error) {
+	ctx = schema.SkipSoftDelete(ctx)
+	if _, err := c.client.DailyActiveUser.Delete().
+		Where(dailyactiveuser.DateLT(before)).
+		Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to delete daily active users: %w", err)
+	}
+
+	return c.client.DailyStat.Delete().
+		Where(dailystat.DateLT(before)).
+		Exec(ctx)
+}
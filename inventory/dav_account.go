@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/ent/davaccount"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/samber/lo"
+	"time"
 )
 
 type (
@@ -25,6 +27,14 @@ type (
 		GetByIDAndUserID(ctx context.Context, id, userID int) (*ent.DavAccount, error)
 		// Delete deletes the dav account.
 		Delete(ctx context.Context, id int) error
+		// Rotate replaces the password of the dav account and re-enables it if disabled.
+		Rotate(ctx context.Context, id int, password string, expireAt *time.Time, props *types.DavAccountProps) (*ent.DavAccount, error)
+		// MarkUsed updates last used time of the dav account.
+		MarkUsed(ctx context.Context, id int) error
+		// Disable disables the dav account until its password is rotated.
+		Disable(ctx context.Context, id int) error
+		// UpdateProps updates props of the dav account.
+		UpdateProps(ctx context.Context, id int, props *types.DavAccountProps) error
 	}
 
 	ListDavAccountArgs struct {
@@ -41,6 +51,9 @@ type (
 		URI      string
 		Password string
 		Options  *boolset.BooleanSet
+		Props    *types.DavAccountProps
+		// ExpireAt is when the password expires, nil means never.
+		ExpireAt *time.Time
 	}
 )
 
@@ -72,7 +85,9 @@ func (c *davAccountClient) Create(ctx context.Context, params *CreateDavAccountP
 		SetName(params.Name).
 		SetURI(params.URI).
 		SetPassword(params.Password).
-		SetOptions(params.Options)
+		SetOptions(params.Options).
+		SetProps(params.Props).
+		SetNillableExpireAt(params.ExpireAt)
 
 	return account.Save(ctx)
 }
@@ -87,11 +102,46 @@ func (c *davAccountClient) Update(ctx context.Context, id int, params *CreateDav
 	account := c.client.DavAccount.UpdateOneID(id).
 		SetName(params.Name).
 		SetURI(params.URI).
-		SetOptions(params.Options)
+		SetOptions(params.Options).
+		SetProps(params.Props)
+	if params.ExpireAt != nil {
+		account.SetExpireAt(*params.ExpireAt)
+	} else {
+		account.ClearExpireAt()
+	}
+
+	return account.Save(ctx)
+}
+
+func (c *davAccountClient) Rotate(ctx context.Context, id int, password string, expireAt *time.Time, props *types.DavAccountProps) (*ent.DavAccount, error) {
+	account := c.client.DavAccount.UpdateOneID(id).
+		SetPassword(password).
+		SetProps(props).
+		ClearDisabledAt()
+	if expireAt != nil {
+		account.SetExpireAt(*expireAt)
+	} else {
+		account.ClearExpireAt()
+	}
 
 	return account.Save(ctx)
 }
 
+func (c *davAccountClient) MarkUsed(ctx context.Context, id int) error {
+	return c.client.DavAccount.UpdateOneID(id).SetLastUsedAt(time.Now()).Exec(ctx)
+}
+
+func (c *davAccountClient) Disable(ctx context.Context, id int) error {
+	return c.client.DavAccount.Update().
+		Where(davaccount.ID(id), davaccount.DisabledAtIsNil()).
+		SetDisabledAt(time.Now()).
+		Exec(ctx)
+}
+
+func (c *davAccountClient) UpdateProps(ctx context.Context, id int, props *types.DavAccountProps) error {
+	return c.client.DavAccount.UpdateOneID(id).SetProps(props).Exec(ctx)
+}
+
 func (c *davAccountClient) Delete(ctx context.Context, id int) error {
 	return c.client.DavAccount.DeleteOneID(id).Exec(ctx)
 }
@@ -3,6 +3,7 @@ package inventory
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -14,6 +15,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
 	"github.com/cloudreve/Cloudreve/v4/ent/schema"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
@@ -34,6 +36,7 @@ type (
 	LoadFileMetadata        struct{}
 	LoadFilePublicMetadata  struct{}
 	LoadFileShare           struct{}
+	LoadFileShareID         struct{}
 	LoadFileUser            struct{}
 	LoadFileDirectLink      struct{}
 	LoadEntityUser          struct{}
@@ -74,6 +77,57 @@ type (
 		CreatedAtLte   *time.Time
 		UpdatedAtGte   *time.Time
 		UpdatedAtLte   *time.Time
+		// TakenAtGte and TakenAtLte filter photos by capture time in EXIF.
+		TakenAtGte *time.Time
+		TakenAtLte *time.Time
+		// WithGps filters photos with GPS location in EXIF.
+		WithGps bool
+		// Tags filters files with all of the given tags.
+		Tags []string
+		// StoragePolicyID filters files stored in the storage policy.
+		StoragePolicyID int
+		// CategoryFilter is the conditions of a predefined category, matched along with other conditions.
+		CategoryFilter *SearchFileParameters
+	}
+
+	ListGeoTaggedFilesParameters struct {
+		OwnerID int
+		// South, West, North and East bound the locations, all zero means no bounds.
+		South, West, North, East float64
+	}
+
+	ListAiTagCandidatesParameters struct {
+		// PolicyIDs limits candidates to files stored in these storage policies.
+		PolicyIDs []int
+		Exts      []string
+		// TaggedKey is the metadata key marking a file as already processed.
+		TaggedKey string
+		Limit     int
+	}
+
+	ListThumbBackfillCandidatesParameters struct {
+		// AfterID limits candidates to files with greater ID, they are listed in ascending order of ID.
+		AfterID int
+		Exts    []string
+		// DisabledKey is the metadata key marking thumbnail of a file as not available.
+		DisabledKey string
+		Limit       int
+	}
+
+	// ListUpdatedFilesParameters lists files updated after a position, in ascending order of update time and ID.
+	ListUpdatedFilesParameters struct {
+		After   time.Time
+		AfterID int
+		Limit   int
+		// OwnerID lists files of given owner only if set.
+		OwnerID int
+	}
+
+	// GeoTaggedFile is a file with GPS location in its EXIF metadata.
+	GeoTaggedFile struct {
+		FileID int
+		Lat    float64
+		Lng    float64
 	}
 
 	ListEntityParameters struct {
@@ -81,6 +135,8 @@ type (
 		EntityType      *types.EntityType
 		UserID          int
 		StoragePolicyID int
+		// FileOwnerID lists entities referenced by files of given owner.
+		FileOwnerID int
 	}
 
 	ListEntityResult struct {
@@ -110,6 +166,7 @@ type (
 		IsSymbolic          bool
 		Metadata            map[string]string
 		MetadataPrivateMask map[string]bool
+		ModifiedAt          *time.Time
 	}
 
 	EntityParameters struct {
@@ -183,6 +240,8 @@ type FileClient interface {
 	// StaleEntities returns stale entities of a given file. If ID is not provided, all entities
 	// will be examined.
 	StaleEntities(ctx context.Context, ids ...int) ([]*ent.Entity, error)
+	// ListIDsByMetadata returns IDs of files of given owner with metadata of given name.
+	ListIDsByMetadata(ctx context.Context, ownerID int, name string) ([]int, error)
 	// QueryMetadata load metadata of a given file
 	QueryMetadata(ctx context.Context, root *ent.File) error
 	// SoftDelete soft-deletes a file, also renaming it to a random name
@@ -199,30 +258,62 @@ type FileClient interface {
 	CountEntityByTimeRange(ctx context.Context, start, end *time.Time) (int, error)
 	// CountEntityByStoragePolicyID counts entities by storage policy ID
 	CountEntityByStoragePolicyID(ctx context.Context, storagePolicyID int) (int, int, error)
+	// SumEntitySizeByStoragePolicy returns total size of entities grouped by storage policy ID.
+	SumEntitySizeByStoragePolicy(ctx context.Context) (map[int]int64, error)
+	// ListOldVersions returns version entities of given storage policy created before given time, with
+	// linked files loaded. Entities are ordered by ID, starting after afterID.
+	ListOldVersions(ctx context.Context, policyID int, before time.Time, afterID, limit int) ([]*ent.Entity, error)
 	// IsStoragePolicyUsedByEntities checks if a storage policy is used by entities
 	IsStoragePolicyUsedByEntities(ctx context.Context, policyID int) (bool, error)
 	// DeleteByUser deletes all files by a given user
 	DeleteByUser(ctx context.Context, uid int) error
+	// TransferOwnership moves all files and shares of a user to another user. Files under root folder of
+	// the source user are placed into a new folder under root of the target user, named after folderName
+	// with a numeric suffix if it's already taken. Returns the created folder and storage diff.
+	TransferOwnership(ctx context.Context, from, to *ent.User, folderName string) (*ent.File, StorageDiff, error)
+	// ListAiTagCandidates lists image files not processed by AI tagging yet, newest first.
+	ListAiTagCandidates(ctx context.Context, args *ListAiTagCandidatesParameters) ([]*ent.File, error)
+	// ListThumbBackfillCandidates lists files without thumbnail generated yet.
+	ListThumbBackfillCandidates(ctx context.Context, args *ListThumbBackfillCandidatesParameters) ([]*ent.File, error)
+	// CountThumbBackfillCandidates counts files without thumbnail generated yet, Limit is ignored.
+	CountThumbBackfillCandidates(ctx context.Context, args *ListThumbBackfillCandidatesParameters) (int, error)
+	// ListUpdatedFiles lists files updated after given position, eager loading follows context values.
+	ListUpdatedFiles(ctx context.Context, args *ListUpdatedFilesParameters) ([]*ent.File, error)
+	// CountUpdatedFiles counts files updated after given position, Limit is ignored.
+	CountUpdatedFiles(ctx context.Context, args *ListUpdatedFilesParameters) (int, error)
+	// ListGeoTaggedFiles lists locations of files with GPS location in EXIF metadata.
+	ListGeoTaggedFiles(ctx context.Context, args *ListGeoTaggedFilesParameters) ([]GeoTaggedFile, error)
 	// FlattenListFiles list files ignoring hierarchy
 	FlattenListFiles(ctx context.Context, args *FlattenListFileParameters) (*ListFileResult, error)
 	// Update updates a file
 	Update(ctx context.Context, file *ent.File) (*ent.File, error)
 	// ListEntities lists entities
 	ListEntities(ctx context.Context, args *ListEntityParameters) (*ListEntityResult, error)
+	// ExistingEntitySources returns sources in given list referenced by entities of given storage policies.
+	ExistingEntitySources(ctx context.Context, policyIDs []int, sources []string) (map[string]bool, error)
+	// RelocateEntity points an entity to a new blob under given storage policy.
+	RelocateEntity(ctx context.Context, e *ent.Entity, policyID int, source string) (*ent.Entity, error)
+	// ResizeEntity updates size of an entity whose content is modified in place, size of the file is also
+	// updated if the entity is its primary entity.
+	ResizeEntity(ctx context.Context, e *ent.Entity, file *ent.File, size int64) (StorageDiff, error)
+	// ReconcileFolderSizes recalculates cached sizes of all folders of given owner, returns the number of
+	// checked folders and fixed ones.
+	ReconcileFolderSizes(ctx context.Context, ownerID int) (int, int, error)
 }
 
 func NewFileClient(client *ent.Client, dbType conf.DBType, hasher hashid.Encoder) FileClient {
-	return &fileClient{client: client, maxSQlParam: sqlParamLimit(dbType), hasher: hasher}
+	return &fileClient{client: client, maxSQlParam: sqlParamLimit(dbType), dialect: sqlDialect(dbType), hasher: hasher}
 }
 
 type fileClient struct {
 	maxSQlParam int
+	dialect     string
 	client      *ent.Client
 	hasher      hashid.Encoder
 }
 
 func (c *fileClient) SetClient(newClient *ent.Client) TxOperator {
-	return &fileClient{client: newClient, maxSQlParam: c.maxSQlParam, hasher: c.hasher}
+	return &fileClient{client: newClient, maxSQlParam: c.maxSQlParam, dialect: c.dialect, hasher: c.hasher}
 }
 
 func (c *fileClient) GetClient() *ent.Client {
@@ -309,6 +400,28 @@ func (f *fileClient) CountEntityByStoragePolicyID(ctx context.Context, storagePo
 	return v[0].Count, v[0].Sum, nil
 }
 
+func (f *fileClient) SumEntitySizeByStoragePolicy(ctx context.Context) (map[int]int64, error) {
+	var v []struct {
+		PolicyID int   `json:"storage_policy_entities"`
+		Sum      int64 `json:"sum"`
+	}
+
+	err := f.client.Entity.Query().
+		GroupBy(entity.FieldStoragePolicyEntities).
+		Aggregate(ent.Sum(entity.FieldSize)).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[int]int64, len(v))
+	for _, item := range v {
+		res[item.PolicyID] = item.Sum
+	}
+
+	return res, nil
+}
+
 func (f *fileClient) CreateDirectLink(ctx context.Context, file int, name string, speed int) (*ent.DirectLink, error) {
 	// Find existed
 	existed, err := f.client.DirectLink.
@@ -347,7 +460,7 @@ func (f *fileClient) SoftDelete(ctx context.Context, file *ent.File) error {
 		return fmt.Errorf("failed to soft delete file %d: %w", file.ID, err)
 	}
 
-	return err
+	return f.applyFolderSizeDiff(ctx, folderSizeDiff{file.FileChildren: -file.Size})
 }
 
 func (f *fileClient) RemoveEntitiesByID(ctx context.Context, ids ...int) (map[int]int64, error) {
@@ -443,6 +556,77 @@ func (f *fileClient) DeleteByUser(ctx context.Context, uid int) error {
 	return nil
 }
 
+func (f *fileClient) TransferOwnership(ctx context.Context, from, to *ent.User, folderName string) (*ent.File, StorageDiff, error) {
+	srcRoot, err := f.Root(ctx, from)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// File system of source user is not initialized, nothing to transfer.
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("failed to get root folder of source user: %w", err)
+	}
+
+	dstRoot, err := f.Root(ctx, to)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get root folder of target user: %w", err)
+	}
+
+	// Find an available folder name
+	name := folderName
+	for i := 1; ; i++ {
+		exist, err := f.client.File.Query().
+			Where(file.FileChildren(dstRoot.ID), file.Name(name)).
+			Exist(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to check folder name: %w", err)
+		}
+
+		if !exist {
+			break
+		}
+
+		name = fmt.Sprintf("%s (%d)", folderName, i)
+	}
+
+	folder, err := f.client.File.Create().
+		SetOwnerID(to.ID).
+		SetType(int(types.FileTypeFolder)).
+		SetName(name).
+		SetParent(dstRoot).
+		SetSize(srcRoot.Size).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create transfer folder: %w", err)
+	}
+
+	if err := f.client.File.Update().
+		Where(file.FileChildren(srcRoot.ID)).
+		SetFileChildren(folder.ID).
+		Exec(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to move files: %w", err)
+	}
+
+	if err := f.applyFolderSizeDiff(ctx, folderSizeDiff{srcRoot.ID: -srcRoot.Size, dstRoot.ID: srcRoot.Size}); err != nil {
+		return nil, nil, err
+	}
+
+	if err := f.client.File.Update().
+		Where(file.OwnerID(from.ID), file.IDNEQ(srcRoot.ID)).
+		SetOwnerID(to.ID).
+		Exec(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to rewrite file owner: %w", err)
+	}
+
+	if err := f.client.Share.Update().
+		Where(share.HasUserWith(user.ID(from.ID))).
+		SetUserID(to.ID).
+		Exec(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to rewrite share owner: %w", err)
+	}
+
+	return folder, StorageDiff{from.ID: -from.Storage, to.ID: from.Storage}, nil
+}
+
 func (f *fileClient) Delete(ctx context.Context, files []*ent.File, options *types.EntityRecycleOption) ([]*ent.Entity, StorageDiff, error) {
 	// 1. Decrease reference count for all entities;
 	// entities stores the relation between its reference count in `files` and entity ID.
@@ -505,6 +689,18 @@ func (f *fileClient) Delete(ctx context.Context, files []*ent.File, options *typ
 		}
 	}
 
+	// Only top level files are subtracted from their parents, sizes of deleted folders already include
+	// their children.
+	deleted := lo.SliceToMap(files, func(item *ent.File) (int, bool) {
+		return item.ID, true
+	})
+	sizeDiff := make(folderSizeDiff)
+	for _, fi := range files {
+		if !deleted[fi.FileChildren] {
+			sizeDiff.add(fi.FileChildren, -fi.Size)
+		}
+	}
+
 	hardDeleteCtx := schema.SkipSoftDelete(ctx)
 	fileGroups, chunks := f.batchInCondition(intsets.MaxInt, 10, 1,
 		lo.Map(files, func(file *ent.File, index int) int {
@@ -532,18 +728,29 @@ func (f *fileClient) Delete(ctx context.Context, files []*ent.File, options *typ
 		}
 	}
 
+	if err := f.applyFolderSizeDiff(ctx, sizeDiff); err != nil {
+		return nil, nil, err
+	}
+
 	return toBeRecycled, storageReduced, nil
 }
 
 func (f *fileClient) Copy(ctx context.Context, files []*ent.File, dstMap map[int][]*ent.File) (map[int][]*ent.File, StorageDiff, error) {
 	pageSize := capPageSize(f.maxSQlParam, intsets.MaxInt, 10)
 	// 1. Copy files and metadata
+	// Copied folders start empty, their sizes grow as children are copied into them.
+	parentSizeDiff := make(folderSizeDiff)
 	copyFileStm := lo.Map(files, func(file *ent.File, index int) *ent.FileCreate {
+		size := file.Size
+		if file.Type == int(types.FileTypeFolder) {
+			size = 0
+		}
+		parentSizeDiff.add(dstMap[file.FileChildren][0].ID, size)
 
 		stm := f.client.File.Create().
 			SetName(file.Name).
 			SetOwnerID(dstMap[file.FileChildren][0].OwnerID).
-			SetSize(file.Size).
+			SetSize(size).
 			SetType(file.Type).
 			SetParent(dstMap[file.FileChildren][0]).
 			SetIsSymbolic(file.IsSymbolic)
@@ -618,6 +825,10 @@ func (f *fileClient) Copy(ctx context.Context, files []*ent.File, dstMap map[int
 		}
 	}
 
+	if err := f.applyFolderSizeDiff(ctx, parentSizeDiff); err != nil {
+		return nil, nil, err
+	}
+
 	return newDstMap, map[int]int64{dstMap[files[0].FileChildren][0].OwnerID: sizeDiff}, nil
 }
 
@@ -699,6 +910,10 @@ func (f *fileClient) UpgradePlaceholder(ctx context.Context, file *ent.File, mod
 			Exec(ctx); err != nil {
 			return fmt.Errorf("failed to upgrade file primary entity: %v", err)
 		}
+
+		if err := f.applyFolderSizeDiff(ctx, folderSizeDiff{file.FileChildren: placeholder.Size - file.Size}); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -720,6 +935,10 @@ func (f *fileClient) CreateFile(ctx context.Context, root *ent.File, args *Creat
 
 	if args.EntityParameters != nil && args.EntityParameters.Importing {
 		stm.SetSize(args.EntityParameters.Size)
+		// Imported files keep modification time of their blobs.
+		if args.EntityParameters.ModifiedAt != nil {
+			stm.SetUpdatedAt(*args.EntityParameters.ModifiedAt)
+		}
 	}
 
 	newFile, err := stm.Save(ctx)
@@ -738,9 +957,18 @@ func (f *fileClient) CreateFile(ctx context.Context, root *ent.File, args *Creat
 		}
 
 		if args.EntityParameters.Importing {
-			if err := f.client.File.UpdateOne(newFile).SetPrimaryEntity(defaultEntity.ID).Exec(ctx); err != nil {
+			stm := f.client.File.UpdateOne(newFile).SetPrimaryEntity(defaultEntity.ID)
+			if args.EntityParameters.ModifiedAt != nil {
+				stm.SetUpdatedAt(*args.EntityParameters.ModifiedAt)
+			}
+
+			if err := stm.Exec(ctx); err != nil {
 				return nil, nil, storageDiff, fmt.Errorf("failed to set primary entity: %v", err)
 			}
+
+			if err := f.applyFolderSizeDiff(ctx, folderSizeDiff{root.ID: newFile.Size}); err != nil {
+				return nil, nil, storageDiff, err
+			}
 		}
 	}
 
@@ -802,6 +1030,21 @@ func (f *fileClient) UnlinkEntity(ctx context.Context, entity *ent.Entity, file
 	return map[int]int64{owner.ID: entity.Size * int64(-1)}, nil
 }
 
+func (f *fileClient) ListOldVersions(ctx context.Context, policyID int, before time.Time, afterID, limit int) ([]*ent.Entity, error) {
+	return f.client.Entity.Query().
+		Where(
+			entity.StoragePolicyEntities(policyID),
+			entity.Type(int(types.EntityTypeVersion)),
+			entity.UploadSessionIDIsNil(),
+			entity.CreatedAtLT(before),
+			entity.IDGT(afterID),
+		).
+		WithFile().
+		Order(entity.ByID()).
+		Limit(limit).
+		All(ctx)
+}
+
 func (f *fileClient) IsStoragePolicyUsedByEntities(ctx context.Context, policyID int) (bool, error) {
 	res, err := f.client.Entity.Query().Where(entity.StoragePolicyEntities(policyID)).Limit(1).All(ctx)
 	if err != nil {
@@ -889,7 +1132,13 @@ func (f *fileClient) SetParent(ctx context.Context, files []*ent.File, parent *e
 		}
 	}
 
-	return nil
+	sizeDiff := make(folderSizeDiff)
+	for _, fi := range files {
+		sizeDiff.add(fi.FileChildren, -fi.Size)
+		sizeDiff.add(parent.ID, fi.Size)
+	}
+
+	return f.applyFolderSizeDiff(ctx, sizeDiff)
 }
 
 func (f *fileClient) GetParentFile(ctx context.Context, root *ent.File, eagerLoading bool) (*ent.File, error) {
@@ -901,6 +1150,12 @@ func (f *fileClient) GetParentFile(ctx context.Context, root *ent.File, eagerLoa
 	return query.First(ctx)
 }
 
+func (f *fileClient) ListIDsByMetadata(ctx context.Context, ownerID int, name string) ([]int, error) {
+	return f.client.File.Query().
+		Where(file.OwnerID(ownerID), file.HasMetadataWith(metadata.Name(name))).
+		IDs(ctx)
+}
+
 func (f *fileClient) QueryMetadata(ctx context.Context, root *ent.File) error {
 	metadata, err := f.client.File.QueryMetadata(root).All(ctx)
 	if err != nil {
@@ -969,6 +1224,10 @@ func (f *fileClient) CreateFolder(ctx context.Context, root *ent.File, args *Cre
 		stm.SetParent(root).SetType(int(types.FileTypeFolder))
 	}
 
+	if args.ModifiedAt != nil {
+		stm.SetUpdatedAt(*args.ModifiedAt)
+	}
+
 	fid, err := stm.OnConflict(sql.ConflictColumns(file.FieldFileChildren, file.FieldName)).Ignore().ID(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create folder: %w", err)
@@ -1087,6 +1346,207 @@ func (f *fileClient) FlattenListFiles(ctx context.Context, args *FlattenListFile
 	}, nil
 }
 
+func (f *fileClient) ListAiTagCandidates(ctx context.Context, args *ListAiTagCandidatesParameters) ([]*ent.File, error) {
+	if len(args.PolicyIDs) == 0 || len(args.Exts) == 0 {
+		return nil, nil
+	}
+
+	files, err := f.client.File.Query().
+		Where(
+			file.Type(int(types.FileTypeFile)),
+			file.IsSymbolic(false),
+			file.HasParent(),
+			file.PrimaryEntityNEQ(0),
+			file.StoragePolicyFilesIn(args.PolicyIDs...),
+			file.Or(nameExtPredicates(args.Exts)...),
+			file.Not(file.HasMetadataWith(metadata.NameEQ(args.TaggedKey))),
+		).
+		WithMetadata().
+		Order(ent.Desc(file.FieldID)).
+		Limit(args.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list AI tag candidates: %w", err)
+	}
+
+	return files, nil
+}
+
+func (f *fileClient) ListThumbBackfillCandidates(ctx context.Context, args *ListThumbBackfillCandidatesParameters) ([]*ent.File, error) {
+	if len(args.Exts) == 0 {
+		return nil, nil
+	}
+
+	files, err := f.client.File.Query().
+		Where(thumbBackfillPredicates(args)...).
+		Order(ent.Asc(file.FieldID)).
+		Limit(args.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list thumb backfill candidates: %w", err)
+	}
+
+	return files, nil
+}
+
+func (f *fileClient) CountThumbBackfillCandidates(ctx context.Context, args *ListThumbBackfillCandidatesParameters) (int, error) {
+	if len(args.Exts) == 0 {
+		return 0, nil
+	}
+
+	count, err := f.client.File.Query().
+		Where(thumbBackfillPredicates(args)...).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count thumb backfill candidates: %w", err)
+	}
+
+	return count, nil
+}
+
+func updatedFilesPredicates(args *ListUpdatedFilesParameters) []predicate.File {
+	predicates := []predicate.File{
+		file.Or(
+			file.UpdatedAtGT(args.After),
+			file.And(file.UpdatedAt(args.After), file.IDGT(args.AfterID)),
+		),
+	}
+	if args.OwnerID > 0 {
+		predicates = append(predicates, file.OwnerID(args.OwnerID))
+	}
+
+	return predicates
+}
+
+func (f *fileClient) CountUpdatedFiles(ctx context.Context, args *ListUpdatedFilesParameters) (int, error) {
+	count, err := f.client.File.Query().
+		Where(updatedFilesPredicates(args)...).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count updated files: %w", err)
+	}
+
+	return count, nil
+}
+
+func (f *fileClient) ListUpdatedFiles(ctx context.Context, args *ListUpdatedFilesParameters) ([]*ent.File, error) {
+	query := f.client.File.Query().
+		Where(updatedFilesPredicates(args)...).
+		Order(ent.Asc(file.FieldUpdatedAt), ent.Asc(file.FieldID)).
+		Limit(args.Limit)
+
+	files, err := withFileEagerLoading(ctx, query).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list updated files: %w", err)
+	}
+
+	return files, nil
+}
+
+func (f *fileClient) ListGeoTaggedFiles(ctx context.Context, args *ListGeoTaggedFilesParameters) ([]GeoTaggedFile, error) {
+	metas, err := f.client.Metadata.Query().
+		Where(
+			metadata.NameIn(exifLatitudeKey, exifLongitudeKey),
+			metadata.HasFileWith(file.OwnerID(args.OwnerID), file.Type(int(types.FileTypeFile)), file.HasParent()),
+		).
+		Select(metadata.FieldFileID, metadata.FieldName, metadata.FieldValue).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query geo tagged files: %w", err)
+	}
+
+	locations := make(map[int]*GeoTaggedFile)
+	found := make(map[int]int)
+	for _, m := range metas {
+		v, err := strconv.ParseFloat(m.Value, 64)
+		if err != nil {
+			continue
+		}
+
+		loc, ok := locations[m.FileID]
+		if !ok {
+			loc = &GeoTaggedFile{FileID: m.FileID}
+			locations[m.FileID] = loc
+		}
+
+		if m.Name == exifLatitudeKey {
+			loc.Lat = v
+		} else {
+			loc.Lng = v
+		}
+		found[m.FileID]++
+	}
+
+	bounded := args.South != 0 || args.West != 0 || args.North != 0 || args.East != 0
+	res := make([]GeoTaggedFile, 0, len(locations))
+	for id, loc := range locations {
+		if found[id] != 2 {
+			continue
+		}
+
+		if bounded {
+			if loc.Lat < args.South || loc.Lat > args.North {
+				continue
+			}
+
+			// Bounds crossing the antimeridian have west greater than east.
+			if args.West <= args.East && (loc.Lng < args.West || loc.Lng > args.East) ||
+				args.West > args.East && loc.Lng < args.West && loc.Lng > args.East {
+				continue
+			}
+		}
+
+		res = append(res, *loc)
+	}
+
+	return res, nil
+}
+
+func (f *fileClient) RelocateEntity(ctx context.Context, e *ent.Entity, policyID int, source string) (*ent.Entity, error) {
+	return f.client.Entity.UpdateOne(e).
+		SetStoragePolicyEntities(policyID).
+		SetSource(source).
+		Save(ctx)
+}
+
+func (f *fileClient) ResizeEntity(ctx context.Context, e *ent.Entity, file *ent.File, size int64) (StorageDiff, error) {
+	now := time.Now()
+	if err := f.client.Entity.UpdateOne(e).SetSize(size).SetUpdatedAt(now).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to update entity size: %w", err)
+	}
+
+	if file.PrimaryEntity == e.ID {
+		if err := f.client.File.UpdateOne(file).SetSize(size).SetUpdatedAt(now).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to update file size: %w", err)
+		}
+
+		if err := f.applyFolderSizeDiff(ctx, folderSizeDiff{file.FileChildren: size - file.Size}); err != nil {
+			return nil, err
+		}
+	}
+
+	return map[int]int64{file.OwnerID: size - e.Size}, nil
+}
+
+func (f *fileClient) ExistingEntitySources(ctx context.Context, policyIDs []int, sources []string) (map[string]bool, error) {
+	res := make(map[string]bool)
+	for _, chunk := range lo.Chunk(sources, max(f.maxSQlParam-len(policyIDs), 1)) {
+		found, err := f.client.Entity.Query().
+			Where(entity.StoragePolicyEntitiesIn(policyIDs...), entity.SourceIn(chunk...)).
+			Select(entity.FieldSource).
+			Strings(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query entity sources: %w", err)
+		}
+
+		for _, source := range found {
+			res[source] = true
+		}
+	}
+
+	return res, nil
+}
+
 func (f *fileClient) ListEntities(ctx context.Context, args *ListEntityParameters) (*ListEntityResult, error) {
 	query := f.client.Entity.Query()
 	if args.EntityType != nil {
@@ -1101,6 +1561,10 @@ func (f *fileClient) ListEntities(ctx context.Context, args *ListEntityParameter
 		query = query.Where(entity.StoragePolicyEntities(args.StoragePolicyID))
 	}
 
+	if args.FileOwnerID > 0 {
+		query = query.Where(entity.HasFileWith(file.OwnerID(args.FileOwnerID)))
+	}
+
 	query.Order(getEntityOrderOption(args)...)
 
 	// Count total items
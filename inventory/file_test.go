@@ -0,0 +1,131 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	_ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/stretchr/testify/assert"
+)
+
+type queryCounterCtx struct{}
+
+// newTestFileClient returns a file client on an empty database, queries sent with a context created
+// by countQueries are counted.
+func newTestFileClient(t *testing.T) (FileClient, *ent.Client, *sql.Driver) {
+	drv, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counting := dialect.DebugWithContext(drv, func(ctx context.Context, _ ...any) {
+		if counter, ok := ctx.Value(queryCounterCtx{}).(*int); ok {
+			*counter++
+		}
+	})
+	client := ent.NewClient(ent.Driver(counting))
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	hasher, err := hashid.New("test-salt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewFileClient(client, conf.SQLiteDB, hasher), client, drv
+}
+
+func countQueries(ctx context.Context) (context.Context, *int) {
+	counter := new(int)
+	return context.WithValue(ctx, queryCounterCtx{}, counter), counter
+}
+
+func TestGetChildFiles_QueryCount(t *testing.T) {
+	a := assert.New(t)
+	fc, client, _ := newTestFileClient(t)
+	ctx := context.Background()
+
+	group := client.Group.Create().SetName("test").SetPermissions(&boolset.BooleanSet{}).SaveX(ctx)
+	owner := client.User.Create().SetEmail("owner@cloudreve.org").SetNick("owner").SetGroupUsers(group.ID).SaveX(ctx)
+	policy := client.StoragePolicy.Create().SetName("local").SetType(types.PolicyTypeLocal).SaveX(ctx)
+
+	// Files in a folder with all edges loaded by listings
+	newFolder := func(name string, size int) *ent.File {
+		folder := client.File.Create().SetType(int(types.FileTypeFolder)).SetName(name).SetOwnerID(owner.ID).SaveX(ctx)
+		for i := 0; i < size; i++ {
+			f := client.File.Create().SetType(int(types.FileTypeFile)).SetName(fmt.Sprintf("%d.txt", i)).
+				SetOwnerID(owner.ID).SetParent(folder).SetStoragePoliciesID(policy.ID).SaveX(ctx)
+			client.Metadata.Create().SetName("tag").SetValue("a").SetIsPublic(true).SetFile(f).SaveX(ctx)
+			client.Share.Create().SetFile(f).SetUser(owner).SaveX(ctx)
+			client.Entity.Create().SetType(int(types.EntityTypeVersion)).SetSource("/").SetSize(1).
+				SetStoragePolicyEntities(policy.ID).AddFile(f).SaveX(ctx)
+		}
+		return folder
+	}
+
+	list := func(folder *ent.File, cursor bool) ([]*ent.File, int) {
+		listCtx := context.WithValue(ctx, LoadFilePublicMetadata{}, true)
+		listCtx = context.WithValue(listCtx, LoadFileShareID{}, true)
+		listCtx = context.WithValue(listCtx, LoadFileEntity{}, true)
+		listCtx = context.WithValue(listCtx, LoadEntityStoragePolicy{}, true)
+		listCtx = context.WithValue(listCtx, LoadFileUser{}, true)
+		listCtx, counter := countQueries(listCtx)
+		res, err := fc.GetChildFiles(listCtx, &ListFileParameters{PaginationArgs: &PaginationArgs{
+			PageSize:            100,
+			OrderBy:             "name",
+			UseCursorPagination: cursor,
+		}}, owner.ID, folder)
+		a.NoError(err)
+		return res.Files, *counter
+	}
+
+	small, large := newFolder("small", 2), newFolder("large", 40)
+	for _, cursor := range []bool{false, true} {
+		files, smallQueries := list(small, cursor)
+		a.Len(files, 2)
+		files, largeQueries := list(large, cursor)
+		if a.Len(files, 40) {
+			a.Len(files[0].Edges.Metadata, 1)
+			a.Len(files[0].Edges.Shares, 1)
+			a.NotNil(files[0].Edges.Owner)
+			if a.Len(files[0].Edges.Entities, 1) {
+				a.Equal(policy.ID, files[0].Edges.Entities[0].Edges.StoragePolicy.ID)
+			}
+		}
+
+		// Edges are loaded in one query each regardless of the number of files.
+		a.Equal(smallQueries, largeQueries, "cursor pagination: %v", cursor)
+	}
+}
+
+func TestGetChildFiles_Index(t *testing.T) {
+	a := assert.New(t)
+	_, _, drv := newTestFileClient(t)
+
+	// Folders and files are listed in separate queries ordered by name, only the index with type
+	// before name finds the folders without scanning all children in the folder.
+	rows, err := drv.DB().Query("EXPLAIN QUERY PLAN SELECT * FROM files WHERE file_children = 1 AND type = 1 ORDER BY name, id LIMIT 51")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var plan []string
+	for rows.Next() {
+		var id, parent, notUsed int
+		var detail string
+		a.NoError(rows.Scan(&id, &parent, &notUsed, &detail))
+		plan = append(plan, detail)
+	}
+	a.Equal([]string{"SEARCH files USING INDEX file_file_children_type_name (file_children=? AND type=?)"}, plan)
+}
@@ -3,7 +3,9 @@ package inventory
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/cloudreve/Cloudreve/v4/ent"
@@ -11,11 +13,21 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
 	"github.com/cloudreve/Cloudreve/v4/ent/metadata"
 	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
+	"github.com/cloudreve/Cloudreve/v4/ent/share"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/samber/lo"
 )
 
+// Keys of metadata used by search filters, consistent with the media meta extractor and OCR task.
+const (
+	exifTakenAtKey   = "exif:taken_at"
+	exifLatitudeKey  = "exif:latitude"
+	exifLongitudeKey = "exif:longitude"
+	ocrTextKey       = "sys:ocr_text"
+	tagKeyPrefix     = "tag:"
+)
+
 func (f *fileClient) searchQuery(q *ent.FileQuery, args *SearchFileParameters, parents []*ent.File, ownerId int) *ent.FileQuery {
 	if len(parents) == 1 && parents[0] == nil {
 		q = q.Where(file.OwnerID(ownerId))
@@ -30,6 +42,12 @@ func (f *fileClient) searchQuery(q *ent.FileQuery, args *SearchFileParameters, p
 		)
 	}
 
+	return q.Where(searchPredicates(args)...)
+}
+
+// searchPredicates converts search conditions other than parent folders into predicates.
+func searchPredicates(args *SearchFileParameters) []predicate.File {
+	predicates := make([]predicate.File, 0)
 	if len(args.Name) > 0 {
 		namePredicates := lo.Map(args.Name, func(item string, index int) predicate.File {
 			// If start and ends with quotes, treat as exact match
@@ -50,6 +68,12 @@ func (f *fileClient) searchQuery(q *ent.FileQuery, args *SearchFileParameters, p
 				}
 			}
 
+			// Full text search also matches text recognized in images and scans.
+			if args.UseFullText {
+				return file.Or(file.NameContainsFold(item),
+					file.HasMetadataWith(metadata.NameEQ(ocrTextKey), metadata.ValueContainsFold(item)))
+			}
+
 			if args.CaseFolding {
 				return file.NameContainsFold(item)
 			}
@@ -58,14 +82,14 @@ func (f *fileClient) searchQuery(q *ent.FileQuery, args *SearchFileParameters, p
 		})
 
 		if args.NameOperatorOr {
-			q = q.Where(file.Or(namePredicates...))
+			predicates = append(predicates, file.Or(namePredicates...))
 		} else {
-			q = q.Where(file.And(namePredicates...))
+			predicates = append(predicates, file.And(namePredicates...))
 		}
 	}
 
 	if args.Type != nil {
-		q = q.Where(file.TypeEQ(int(*args.Type)))
+		predicates = append(predicates, file.TypeEQ(int(*args.Type)))
 	}
 
 	if len(args.Metadata) > 0 {
@@ -79,30 +103,69 @@ func (f *fileClient) searchQuery(q *ent.FileQuery, args *SearchFileParameters, p
 			}
 		})
 		metaPredicates = append(metaPredicates, metadata.IsPublic(true))
-		q.Where(file.HasMetadataWith(metadata.And(metaPredicates...)))
+		predicates = append(predicates, file.HasMetadataWith(metadata.And(metaPredicates...)))
+	}
+
+	// Zero size bound is not applied, so that a range can be open ended.
+	if args.SizeGte > 0 {
+		predicates = append(predicates, file.SizeGTE(args.SizeGte))
+	}
+
+	if args.SizeLte > 0 {
+		predicates = append(predicates, file.SizeLTE(args.SizeLte))
 	}
 
-	if args.SizeLte > 0 || args.SizeGte > 0 {
-		q = q.Where(file.SizeGTE(args.SizeGte), file.SizeLTE(args.SizeLte))
+	// Size ranges match only files, unless folders are explicitly searched for.
+	if (args.SizeGte > 0 || args.SizeLte > 0) && args.Type == nil {
+		predicates = append(predicates, file.TypeEQ(int(types.FileTypeFile)))
 	}
 
 	if args.CreatedAtLte != nil {
-		q = q.Where(file.CreatedAtLTE(*args.CreatedAtLte))
+		predicates = append(predicates, file.CreatedAtLTE(*args.CreatedAtLte))
 	}
 
 	if args.CreatedAtGte != nil {
-		q = q.Where(file.CreatedAtGTE(*args.CreatedAtGte))
+		predicates = append(predicates, file.CreatedAtGTE(*args.CreatedAtGte))
 	}
 
 	if args.UpdatedAtLte != nil {
-		q = q.Where(file.UpdatedAtLTE(*args.UpdatedAtLte))
+		predicates = append(predicates, file.UpdatedAtLTE(*args.UpdatedAtLte))
 	}
 
 	if args.UpdatedAtGte != nil {
-		q = q.Where(file.UpdatedAtGTE(*args.UpdatedAtGte))
+		predicates = append(predicates, file.UpdatedAtGTE(*args.UpdatedAtGte))
 	}
 
-	return q
+	// Capture time is saved in RFC3339 format in UTC, which can be compared as string.
+	if args.TakenAtGte != nil {
+		predicates = append(predicates, file.HasMetadataWith(metadata.NameEQ(exifTakenAtKey),
+			metadata.ValueGTE(args.TakenAtGte.UTC().Format(time.RFC3339))))
+	}
+
+	if args.TakenAtLte != nil {
+		predicates = append(predicates, file.HasMetadataWith(metadata.NameEQ(exifTakenAtKey),
+			metadata.ValueLTE(args.TakenAtLte.UTC().Format(time.RFC3339))))
+	}
+
+	for _, tag := range args.Tags {
+		predicates = append(predicates, file.HasMetadataWith(metadata.NameEQ(tagKeyPrefix+tag)))
+	}
+
+	if args.StoragePolicyID > 0 {
+		predicates = append(predicates, file.StoragePolicyFiles(args.StoragePolicyID))
+	}
+
+	if args.CategoryFilter != nil {
+		if category := searchPredicates(args.CategoryFilter); len(category) > 0 {
+			predicates = append(predicates, file.And(category...))
+		}
+	}
+
+	if args.WithGps {
+		predicates = append(predicates, file.HasMetadataWith(metadata.NameEQ(exifLatitudeKey)))
+	}
+
+	return predicates
 }
 
 // ChildFileQuery generates query for child file(s) of a given set of root
@@ -110,7 +173,7 @@ func (f *fileClient) childFileQuery(ownerID int, isSymbolic bool, root ...*ent.F
 	rawQuery := f.client.File.Query()
 	if len(root) == 1 && root[0] != nil {
 		// Query children of one single root
-		rawQuery = f.client.File.QueryChildren(root[0])
+		rawQuery = f.client.File.Query().Where(file.FileChildren(root[0].ID))
 	} else if root[0] == nil {
 		// Query orphan files with owner ID
 		predicates := []predicate.File{
@@ -134,11 +197,9 @@ func (f *fileClient) childFileQuery(ownerID int, isSymbolic bool, root ...*ent.F
 		// Query children of multiple roots
 		rawQuery.
 			Where(
-				file.HasParentWith(
-					file.IDIn(lo.Map(root, func(item *ent.File, index int) int {
-						return item.ID
-					})...),
-				),
+				file.FileChildrenIn(lo.Map(root, func(item *ent.File, index int) int {
+					return item.ID
+				})...),
 			)
 	}
 
@@ -336,6 +397,11 @@ func withFileEagerLoading(ctx context.Context, q *ent.FileQuery) *ent.FileQuery
 	}
 	if v, ok := ctx.Value(LoadFileShare{}).(bool); ok && v {
 		q.WithShares()
+	} else if v, ok := ctx.Value(LoadFileShareID{}).(bool); ok && v {
+		// Only IDs are needed to tell whether files are shared.
+		q.WithShares(func(s *ent.ShareQuery) {
+			s.Select(share.FieldID)
+		})
 	}
 	if v, ok := ctx.Value(LoadFileUser{}).(bool); ok && v {
 		q.WithOwner(func(query *ent.UserQuery) {
@@ -373,7 +439,11 @@ func getFileOrderOption(args *ListFileParameters) []file.OrderOption {
 	case file.FieldName:
 		return []file.OrderOption{file.ByName(orderTerm), file.ByID(orderTerm)}
 	case file.FieldSize:
-		return []file.OrderOption{file.BySize(orderTerm), file.ByID(orderTerm)}
+		if sortByFolderSize(args) {
+			return []file.OrderOption{file.BySize(orderTerm), file.ByID(orderTerm)}
+		}
+
+		return []file.OrderOption{bySortSize(orderTerm), file.ByID(orderTerm)}
 	case file.FieldUpdatedAt:
 		return []file.OrderOption{file.ByUpdatedAt(orderTerm), file.ByID(orderTerm)}
 	default:
@@ -381,6 +451,57 @@ func getFileOrderOption(args *ListFileParameters) []file.OrderOption {
 	}
 }
 
+// sortByFolderSize returns whether folders are sorted by their cached sizes, which is only the case when
+// folders are explicitly searched for. Otherwise, folders are sorted as empty ones.
+func sortByFolderSize(args *ListFileParameters) bool {
+	return args.Search != nil && args.Search.Type != nil && *args.Search.Type == types.FileTypeFolder
+}
+
+// sortSize writes the size used to sort files, which is zero for folders.
+func sortSize(s *sql.Selector, b *sql.Builder) {
+	b.WriteString("(CASE WHEN ").Ident(s.C(file.FieldType)).WriteOp(sql.OpEQ).
+		WriteString(strconv.Itoa(int(types.FileTypeFolder))).WriteString(" THEN 0 ELSE ").Ident(s.C(file.FieldSize)).WriteString(" END)")
+}
+
+func bySortSize(orderTerm sql.OrderTermOption) file.OrderOption {
+	return func(s *sql.Selector) {
+		o := &sql.OrderTermOptions{}
+		orderTerm(o)
+		s.OrderExprFunc(func(b *sql.Builder) {
+			sortSize(s, b)
+			if o.Desc {
+				b.WriteString(" DESC")
+			}
+		})
+	}
+}
+
+// sortSizeCompare matches files whose sort size compares to v with given operator.
+func sortSizeCompare(op sql.Op, v int64) predicate.File {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			sortSize(s, b)
+			b.WriteOp(op).Arg(v)
+		}))
+	}
+}
+
+// fileSortSizeCursorQuery is like cursor query of size in fileCursorQuery, but folders are treated as empty.
+var fileSortSizeCursorQuery = map[bool]func(token *PageToken) predicate.File{
+	true: func(token *PageToken) predicate.File {
+		return file.Or(
+			sortSizeCompare(sql.OpLT, int64(token.Int)),
+			file.And(sortSizeCompare(sql.OpEQ, int64(token.Int)), file.IDLT(token.ID)),
+		)
+	},
+	false: func(token *PageToken) predicate.File {
+		return file.Or(
+			sortSizeCompare(sql.OpGT, int64(token.Int)),
+			file.And(sortSizeCompare(sql.OpEQ, int64(token.Int)), file.IDGT(token.ID)),
+		)
+	},
+}
+
 func getEntityOrderOption(args *ListEntityParameters) []entity.OrderOption {
 	orderTerm := getOrderTerm(args.Order)
 	switch args.OrderBy {
@@ -463,6 +584,8 @@ func getFileCursorQuery(args *ListFileParameters, token *PageToken, query []*ent
 	predicates, ok := fileCursorQuery[args.OrderBy]
 	if !ok {
 		predicates = fileCursorQuery[file.FieldID]
+	} else if args.OrderBy == file.FieldSize && !sortByFolderSize(args) {
+		predicates = fileSortSizeCursorQuery
 	}
 
 	// If all folder is already listed in previous page, only query for files.
@@ -494,10 +617,35 @@ func getFileNextPageToken(hasher hashid.Encoder, last *ent.File, args *ListFileP
 	case file.FieldName:
 		token.String = last.Name
 	case file.FieldSize:
-		token.Int = int(last.Size)
+		if last.Type != int(types.FileTypeFolder) || sortByFolderSize(args) {
+			token.Int = int(last.Size)
+		}
 	case file.FieldUpdatedAt:
 		token.Time = &last.UpdatedAt
 	}
 
 	return token.Encode(hasher, hashid.EncodeFileID)
 }
+
+// nameExtPredicates matches files with any of given extensions, in either lower or upper case.
+func nameExtPredicates(exts []string) []predicate.File {
+	return lo.FlatMap(exts, func(ext string, index int) []predicate.File {
+		return []predicate.File{
+			file.NameHasSuffix("." + strings.ToLower(ext)),
+			file.NameHasSuffix("." + strings.ToUpper(ext)),
+		}
+	})
+}
+
+func thumbBackfillPredicates(args *ListThumbBackfillCandidatesParameters) []predicate.File {
+	return []predicate.File{
+		file.IDGT(args.AfterID),
+		file.Type(int(types.FileTypeFile)),
+		file.IsSymbolic(false),
+		file.HasParent(),
+		file.PrimaryEntityNEQ(0),
+		file.Or(nameExtPredicates(args.Exts)...),
+		file.Not(file.HasEntitiesWith(entity.Type(int(types.EntityTypeThumbnail)))),
+		file.Not(file.HasMetadataWith(metadata.NameEQ(args.DisabledKey))),
+	}
+}
@@ -0,0 +1,155 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/samber/lo"
+)
+
+// Size of a folder is the cached total size of files under it, maintained incrementally when files are
+// created, resized, moved or deleted, and reconciled periodically.
+
+// folderSizeDiff maps folder ID to the size change of its direct children.
+type folderSizeDiff map[int]int64
+
+func (d folderSizeDiff) add(folder int, size int64) {
+	if folder > 0 && size != 0 {
+		d[folder] += size
+	}
+}
+
+// applyFolderSizeDiff adds size changes to folders and all their ancestors. Update time of folders is
+// not changed.
+func (f *fileClient) applyFolderSizeDiff(ctx context.Context, diff folderSizeDiff) error {
+	nonZero := func(id int, size int64) bool {
+		return size != 0
+	}
+
+	total := make(map[int]int64)
+	current := lo.PickBy(map[int]int64(diff), nonZero)
+	for len(current) > 0 {
+		next := make(map[int]int64)
+		for _, chunk := range lo.Chunk(lo.Keys(current), max(f.maxSQlParam, 1)) {
+			folders, err := f.client.File.Query().
+				Where(file.IDIn(chunk...)).
+				Select(file.FieldID, file.FieldFileChildren).
+				All(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to query parent folders: %w", err)
+			}
+
+			for _, folder := range folders {
+				total[folder.ID] += current[folder.ID]
+				if folder.FileChildren > 0 {
+					next[folder.FileChildren] += current[folder.ID]
+				}
+			}
+		}
+
+		current = lo.PickBy(next, nonZero)
+	}
+
+	// Folders with the same size change are updated together.
+	grouped := lo.GroupBy(lo.Keys(lo.PickBy(total, nonZero)), func(id int) int64 {
+		return total[id]
+	})
+	for size, ids := range grouped {
+		for _, chunk := range lo.Chunk(ids, max(f.maxSQlParam-1, 1)) {
+			query, args := sql.Dialect(f.dialect).
+				Update(file.Table).
+				Add(file.FieldSize, size).
+				Where(sql.InInts(file.FieldID, chunk...)).
+				Query()
+			if _, err := f.client.ExecContext(ctx, query, args...); err != nil {
+				return fmt.Errorf("failed to update folder size: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (f *fileClient) ReconcileFolderSizes(ctx context.Context, ownerID int) (int, int, error) {
+	var (
+		files  []*ent.File
+		lastID int
+	)
+	batchSize := 30000
+	for {
+		batch, err := f.client.File.Query().
+			Where(file.OwnerID(ownerID), file.IDGT(lastID)).
+			Order(file.ByID()).
+			Limit(batchSize).
+			Select(file.FieldID, file.FieldFileChildren, file.FieldType, file.FieldSize).
+			All(ctx)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to list user files: %w", err)
+		}
+
+		files = append(files, batch...)
+		if len(batch) < batchSize {
+			break
+		}
+		lastID = batch[len(batch)-1].ID
+	}
+
+	children := lo.GroupBy(files, func(item *ent.File) int {
+		return item.FileChildren
+	})
+	actual := make(map[int]int64)
+	var sizeOf func(folder *ent.File) int64
+	sizeOf = func(folder *ent.File) int64 {
+		if size, ok := actual[folder.ID]; ok {
+			return size
+		}
+
+		// Guard against cycles in corrupted trees.
+		actual[folder.ID] = 0
+		size := int64(0)
+		for _, child := range children[folder.ID] {
+			if child.Type == int(types.FileTypeFolder) {
+				size += sizeOf(child)
+			} else {
+				size += child.Size
+			}
+		}
+
+		actual[folder.ID] = size
+		return size
+	}
+
+	checked, fixed := 0, 0
+	for _, folder := range files {
+		if folder.Type != int(types.FileTypeFolder) {
+			continue
+		}
+
+		checked++
+		size := sizeOf(folder)
+		if size == folder.Size {
+			continue
+		}
+
+		// Folders changed during reconciliation are left to the next run.
+		query, args := sql.Dialect(f.dialect).
+			Update(file.Table).
+			Set(file.FieldSize, size).
+			Where(sql.And(sql.EQ(file.FieldID, folder.ID), sql.EQ(file.FieldSize, folder.Size))).
+			Query()
+		res, err := f.client.ExecContext(ctx, query, args...)
+		if err != nil {
+			return checked, fixed, fmt.Errorf("failed to update size of folder %d: %w", folder.ID, err)
+		}
+
+		if affected, _ := res.RowsAffected(); affected > 0 {
+			fixed++
+		}
+	}
+
+	return checked, fixed, nil
+}
@@ -0,0 +1,121 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/moderationcase"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+)
+
+type (
+	ModerationCaseClient interface {
+		TxOperator
+		// Create creates a quarantined case for given share.
+		Create(ctx context.Context, share *ent.Share, matches []types.ModerationMatch) (*ent.ModerationCase, error)
+		// GetByID returns the case with given id.
+		GetByID(ctx context.Context, id int) (*ent.ModerationCase, error)
+		// List returns cases with pagination, newest first. Owner of cases are loaded.
+		List(ctx context.Context, args *ListModerationCaseParameters) (*ListModerationCaseResult, error)
+		// Review sets the review result of a case. Share ID is cleared if the share is rejected.
+		Review(ctx context.Context, id int, status moderationcase.Status, reviewer int) (*ent.ModerationCase, error)
+	}
+
+	ListModerationCaseParameters struct {
+		*PaginationArgs
+		// Status filters cases of given status, empty for all.
+		Status moderationcase.Status
+		// UserID filters cases of given share owner, 0 for all users.
+		UserID int
+	}
+
+	ListModerationCaseResult struct {
+		*PaginationResults
+		Cases []*ent.ModerationCase
+	}
+)
+
+func NewModerationCaseClient(client *ent.Client, dbType conf.DBType) ModerationCaseClient {
+	return &moderationCaseClient{client: client, maxSQlParam: sqlParamLimit(dbType)}
+}
+
+type moderationCaseClient struct {
+	maxSQlParam int
+	client      *ent.Client
+}
+
+func (c *moderationCaseClient) SetClient(newClient *ent.Client) TxOperator {
+	return &moderationCaseClient{client: newClient, maxSQlParam: c.maxSQlParam}
+}
+
+func (c *moderationCaseClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *moderationCaseClient) Create(ctx context.Context, share *ent.Share, matches []types.ModerationMatch) (*ent.ModerationCase, error) {
+	created, err := c.client.ModerationCase.Create().
+		SetUserID(share.Edges.User.ID).
+		SetShareID(share.ID).
+		SetFileID(share.Edges.File.ID).
+		SetMatches(matches).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create moderation case: %w", err)
+	}
+
+	return created, nil
+}
+
+func (c *moderationCaseClient) GetByID(ctx context.Context, id int) (*ent.ModerationCase, error) {
+	return c.client.ModerationCase.Get(ctx, id)
+}
+
+func (c *moderationCaseClient) List(ctx context.Context, args *ListModerationCaseParameters) (*ListModerationCaseResult, error) {
+	query := c.client.ModerationCase.Query()
+	if args.Status != "" {
+		query = query.Where(moderationcase.StatusEQ(args.Status))
+	}
+	if args.UserID > 0 {
+		query = query.Where(moderationcase.UserID(args.UserID))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cases, err := query.
+		WithUser().
+		Order(moderationcase.ByID(sql.OrderDesc())).
+		Limit(args.PageSize).
+		Offset(args.Page * args.PageSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListModerationCaseResult{
+		PaginationResults: &PaginationResults{
+			TotalItems: total,
+			Page:       args.Page,
+			PageSize:   args.PageSize,
+		},
+		Cases: cases,
+	}, nil
+}
+
+func (c *moderationCaseClient) Review(ctx context.Context, id int, status moderationcase.Status, reviewer int) (*ent.ModerationCase, error) {
+	stm := c.client.ModerationCase.UpdateOneID(id).
+		SetStatus(status).
+		SetReviewedBy(reviewer).
+		SetReviewedAt(time.Now())
+	if status == moderationcase.StatusRejected {
+		stm.ClearShareID()
+	}
+
+	return stm.Save(ctx)
+}
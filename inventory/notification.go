@@ -0,0 +1,109 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/notification"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"time"
+)
+
+// MaxNotifications is the maximum number of notifications kept for each user, older ones are dropped.
+const MaxNotifications = 100
+
+type (
+	NotificationClient interface {
+		TxOperator
+		// Create sends a notification to given user, oldest ones exceeding MaxNotifications are deleted.
+		Create(ctx context.Context, uid int, n *NotificationArgs) (*ent.Notification, error)
+		// List returns notifications of given user, newest first.
+		List(ctx context.Context, uid int, unreadOnly bool) ([]*ent.Notification, error)
+		// CountUnread returns the number of unread notifications of given user.
+		CountUnread(ctx context.Context, uid int) (int, error)
+		// MarkRead marks given notifications of user as read, all unread ones are marked if ids is empty.
+		MarkRead(ctx context.Context, uid int, ids []int) error
+	}
+
+	NotificationArgs struct {
+		Type  types.NotificationType
+		Props map[string]string
+		Link  string
+	}
+)
+
+func NewNotificationClient(client *ent.Client, dbType conf.DBType) NotificationClient {
+	return &notificationClient{client: client, maxSQlParam: sqlParamLimit(dbType)}
+}
+
+type notificationClient struct {
+	maxSQlParam int
+	client      *ent.Client
+}
+
+func (c *notificationClient) SetClient(newClient *ent.Client) TxOperator {
+	return &notificationClient{client: newClient, maxSQlParam: c.maxSQlParam}
+}
+
+func (c *notificationClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *notificationClient) Create(ctx context.Context, uid int, n *NotificationArgs) (*ent.Notification, error) {
+	created, err := c.client.Notification.Create().
+		SetUserID(uid).
+		SetType(string(n.Type)).
+		SetProps(n.Props).
+		SetLink(n.Link).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	// Drop notifications older than the newest MaxNotifications ones.
+	oldest, err := c.client.Notification.Query().
+		Where(notification.UserID(uid)).
+		Order(notification.ByID(sql.OrderDesc())).
+		Offset(MaxNotifications - 1).
+		FirstID(ctx)
+	if err == nil {
+		if _, err := c.client.Notification.Delete().
+			Where(notification.UserID(uid), notification.IDLT(oldest)).
+			Exec(schema.SkipSoftDelete(ctx)); err != nil {
+			return nil, fmt.Errorf("failed to delete old notifications: %w", err)
+		}
+	} else if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to query old notifications: %w", err)
+	}
+
+	return created, nil
+}
+
+func (c *notificationClient) List(ctx context.Context, uid int, unreadOnly bool) ([]*ent.Notification, error) {
+	query := c.client.Notification.Query().Where(notification.UserID(uid))
+	if unreadOnly {
+		query.Where(notification.ReadAtIsNil())
+	}
+
+	return query.Order(notification.ByID(sql.OrderDesc())).Limit(MaxNotifications).All(ctx)
+}
+
+func (c *notificationClient) CountUnread(ctx context.Context, uid int) (int, error) {
+	return c.client.Notification.Query().Where(notification.UserID(uid), notification.ReadAtIsNil()).Count(ctx)
+}
+
+func (c *notificationClient) MarkRead(ctx context.Context, uid int, ids []int) error {
+	update := c.client.Notification.Update().Where(notification.UserID(uid), notification.ReadAtIsNil())
+	if len(ids) > 0 {
+		if len(ids) > c.maxSQlParam {
+			ids = ids[:c.maxSQlParam]
+		}
+		update.Where(notification.IDIn(ids...))
+	}
+
+	return update.SetReadAt(time.Now()).Exec(ctx)
+}
@@ -0,0 +1,192 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/group"
+	"github.com/cloudreve/Cloudreve/v4/ent/intercept"
+	"github.com/cloudreve/Cloudreve/v4/ent/organization"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/ent/storagepolicy"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/samber/lo"
+)
+
+type (
+	// OrganizationScopeCtx context key of *OrganizationScope. User, group and storage policy queries
+	// made with a scope in context only return records belonging to the organization.
+	OrganizationScopeCtx struct{}
+	// OrganizationCtx context key of *ent.Organization, the organization managed in org-scoped
+	// admin APIs, loaded before the scope is applied.
+	OrganizationCtx struct{}
+
+	// OrganizationScope records visible to an organization.
+	OrganizationScope struct {
+		// ID of the organization. Users in its groups are members, storage policies in its
+		// storage pool are visible.
+		ID int
+	}
+
+	OrganizationClient interface {
+		TxOperator
+		// List returns all organizations with groups, admins and storage policies loaded.
+		List(ctx context.Context) ([]*ent.Organization, error)
+		// GetByID returns organization by its ID, with groups, admins and storage policies loaded.
+		GetByID(ctx context.Context, id int) (*ent.Organization, error)
+		// GetByGroup returns the organization given group belongs to.
+		GetByGroup(ctx context.Context, groupID int) (*ent.Organization, error)
+		// IsAdmin returns whether given user is a delegated admin of the organization.
+		IsAdmin(ctx context.Context, id, uid int) (bool, error)
+		// Upsert creates a new organization if ID is 0, otherwise updates the existing one.
+		Upsert(ctx context.Context, args *OrganizationArgs) (*ent.Organization, error)
+		// UpdateBranding updates branding of the organization, nil resets to site settings.
+		UpdateBranding(ctx context.Context, id int, branding *types.OrganizationBranding) (*ent.Organization, error)
+		// Delete deletes organization by its ID, its groups, admins and storage policies are kept.
+		Delete(ctx context.Context, id int) error
+	}
+
+	OrganizationArgs struct {
+		ID              int
+		Name            string
+		Groups          []int
+		Admins          []int
+		StoragePolicies []int
+		Branding        *types.OrganizationBranding
+	}
+)
+
+func NewOrganizationClient(client *ent.Client) OrganizationClient {
+	return &organizationClient{client: client}
+}
+
+type organizationClient struct {
+	client *ent.Client
+}
+
+func (c *organizationClient) SetClient(newClient *ent.Client) TxOperator {
+	return &organizationClient{client: newClient}
+}
+
+func (c *organizationClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *organizationClient) List(ctx context.Context) ([]*ent.Organization, error) {
+	return withOrganizationEdges(c.client.Organization.Query()).Order(organization.ByID()).All(ctx)
+}
+
+func (c *organizationClient) GetByID(ctx context.Context, id int) (*ent.Organization, error) {
+	return withOrganizationEdges(c.client.Organization.Query().Where(organization.ID(id))).First(ctx)
+}
+
+func (c *organizationClient) GetByGroup(ctx context.Context, groupID int) (*ent.Organization, error) {
+	return c.client.Organization.Query().Where(organization.HasGroupsWith(group.ID(groupID))).First(ctx)
+}
+
+func (c *organizationClient) IsAdmin(ctx context.Context, id, uid int) (bool, error) {
+	return c.client.Organization.Query().
+		Where(organization.ID(id), organization.HasAdminsWith(user.ID(uid))).
+		Exist(ctx)
+}
+
+func (c *organizationClient) Upsert(ctx context.Context, args *OrganizationArgs) (*ent.Organization, error) {
+	if args.ID == 0 {
+		return c.client.Organization.Create().
+			SetName(args.Name).
+			SetBranding(args.Branding).
+			AddGroupIDs(args.Groups...).
+			AddAdminIDs(args.Admins...).
+			AddStoragePolicyIDs(args.StoragePolicies...).
+			Save(ctx)
+	}
+
+	update := c.client.Organization.UpdateOneID(args.ID).
+		SetName(args.Name).
+		ClearGroups().
+		AddGroupIDs(args.Groups...).
+		ClearAdmins().
+		AddAdminIDs(args.Admins...).
+		ClearStoragePolicies().
+		AddStoragePolicyIDs(args.StoragePolicies...)
+	if args.Branding != nil {
+		update.SetBranding(args.Branding)
+	} else {
+		update.ClearBranding()
+	}
+
+	return update.Save(ctx)
+}
+
+func (c *organizationClient) UpdateBranding(ctx context.Context, id int, branding *types.OrganizationBranding) (*ent.Organization, error) {
+	update := c.client.Organization.UpdateOneID(id)
+	if branding != nil {
+		update.SetBranding(branding)
+	} else {
+		update.ClearBranding()
+	}
+
+	return update.Save(ctx)
+}
+
+func (c *organizationClient) Delete(ctx context.Context, id int) error {
+	if err := c.client.Organization.UpdateOneID(id).ClearGroups().ClearAdmins().ClearStoragePolicies().Exec(ctx); err != nil {
+		return fmt.Errorf("failed to clear organization edges: %w", err)
+	}
+
+	return c.client.Organization.DeleteOneID(id).Exec(schema.SkipSoftDelete(ctx))
+}
+
+func withOrganizationEdges(q *ent.OrganizationQuery) *ent.OrganizationQuery {
+	return q.WithGroups(func(q *ent.GroupQuery) {
+		q.Select(group.FieldID, group.FieldName, group.FieldOrganizationID, group.FieldStoragePolicyID)
+	}).WithAdmins(func(q *ent.UserQuery) {
+		q.Select(user.FieldID, user.FieldEmail, user.FieldNick)
+	}).WithStoragePolicies(func(q *ent.StoragePolicyQuery) {
+		q.Select(storagepolicy.FieldID, storagepolicy.FieldName)
+	})
+}
+
+// OrganizationHasAdmin returns whether given user is a delegated admin of the organization loaded
+// with its admins.
+func OrganizationHasAdmin(org *ent.Organization, uid int) bool {
+	return lo.ContainsBy(org.Edges.Admins, func(u *ent.User) bool {
+		return u.ID == uid
+	})
+}
+
+// OrganizationScopeFromContext returns the organization scope in ctx, nil if not scoped.
+func OrganizationScopeFromContext(ctx context.Context) *OrganizationScope {
+	scope, _ := ctx.Value(OrganizationScopeCtx{}).(*OrganizationScope)
+	return scope
+}
+
+// OrganizationFromContext returns the organization in ctx, nil if not set.
+func OrganizationFromContext(ctx context.Context) *ent.Organization {
+	org, _ := ctx.Value(OrganizationCtx{}).(*ent.Organization)
+	return org
+}
+
+// registerOrganizationInterceptors enforces data isolation of organization scopes.
+func registerOrganizationInterceptors(client *ent.Client) {
+	client.User.Intercept(intercept.TraverseUser(func(ctx context.Context, q *ent.UserQuery) error {
+		if scope := OrganizationScopeFromContext(ctx); scope != nil {
+			q.Where(user.HasGroupWith(group.OrganizationID(scope.ID)))
+		}
+		return nil
+	}))
+	client.Group.Intercept(intercept.TraverseGroup(func(ctx context.Context, q *ent.GroupQuery) error {
+		if scope := OrganizationScopeFromContext(ctx); scope != nil {
+			q.Where(group.OrganizationID(scope.ID))
+		}
+		return nil
+	}))
+	client.StoragePolicy.Intercept(intercept.TraverseStoragePolicy(func(ctx context.Context, q *ent.StoragePolicyQuery) error {
+		if scope := OrganizationScopeFromContext(ctx); scope != nil {
+			q.Where(storagepolicy.HasOrganizationsWith(organization.ID(scope.ID)))
+		}
+		return nil
+	}))
+}
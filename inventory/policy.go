@@ -27,8 +27,12 @@ type (
 	SkipStoragePolicyCache struct{}
 
 	StoragePolicyClient interface {
+		TxOperator
 		// GetByGroup returns the storage policies of the group.
 		GetByGroup(ctx context.Context, group *ent.Group) (*ent.StoragePolicy, error)
+		// GetByUser returns the effective storage policy of the user, considering per-user override.
+		// User's group must be loaded.
+		GetByUser(ctx context.Context, u *ent.User) (*ent.StoragePolicy, error)
 		// GetPolicyByID returns the storage policy by id.
 		GetPolicyByID(ctx context.Context, id int) (*ent.StoragePolicy, error)
 		// UpdateAccessKey updates the access key of the storage policy. It also clear related cache in KV.
@@ -64,6 +68,14 @@ type storagePolicyClient struct {
 	cache  cache.Driver
 }
 
+func (c *storagePolicyClient) SetClient(newClient *ent.Client) TxOperator {
+	return &storagePolicyClient{client: newClient, cache: c.cache}
+}
+
+func (c *storagePolicyClient) GetClient() *ent.Client {
+	return c.client
+}
+
 func (c *storagePolicyClient) Delete(ctx context.Context, policy *ent.StoragePolicy) error {
 	if err := c.client.StoragePolicy.DeleteOne(policy).Exec(ctx); err != nil {
 		return fmt.Errorf("failed to delete storage policy: %w", err)
@@ -145,10 +157,32 @@ func (c *storagePolicyClient) GetByGroup(ctx context.Context, group *ent.Group)
 	return res, nil
 }
 
+func (c *storagePolicyClient) GetByUser(ctx context.Context, u *ent.User) (*ent.StoragePolicy, error) {
+	if id := EffectiveStoragePolicyOverride(u); id > 0 {
+		res, err := c.GetPolicyByID(ctx, id)
+		if err == nil {
+			return res, nil
+		}
+
+		// Overridden policy might be deleted, fallback to group policy.
+		if !ent.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	group, err := u.Edges.GroupOrErr()
+	if err != nil {
+		return nil, fmt.Errorf("user group not loaded: %w", err)
+	}
+
+	return c.GetByGroup(ctx, group)
+}
+
 // GetPolicyByID returns the storage policy by id.
 func (c *storagePolicyClient) GetPolicyByID(ctx context.Context, id int) (*ent.StoragePolicy, error) {
 	val, skipCache := ctx.Value(SkipStoragePolicyCache{}).(bool)
-	skipCache = skipCache && val
+	// Cache is not aware of organization scope.
+	skipCache = (skipCache && val) || OrganizationScopeFromContext(ctx) != nil
 
 	// Try to read from cache
 	if c.cache != nil && !skipCache {
@@ -0,0 +1,176 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/retentionrule"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+)
+
+type (
+	RetentionRuleClient interface {
+		TxOperator
+		// Create creates a retention rule for given user.
+		Create(ctx context.Context, uid int, args *RetentionRuleArgs) (*ent.RetentionRule, error)
+		// Update updates a retention rule.
+		Update(ctx context.Context, id int, args *RetentionRuleArgs) (*ent.RetentionRule, error)
+		// GetByID returns the rule with given id.
+		GetByID(ctx context.Context, id int) (*ent.RetentionRule, error)
+		// GetByIDAndUserID returns the rule with given id and user id.
+		GetByIDAndUserID(ctx context.Context, id, uid int) (*ent.RetentionRule, error)
+		// List returns rules of given user, oldest first.
+		List(ctx context.Context, uid int) ([]*ent.RetentionRule, error)
+		// ListAll returns rules of all users with pagination, newest first. Owner of rules are loaded.
+		ListAll(ctx context.Context, args *ListRetentionRuleParameters) (*ListRetentionRuleResult, error)
+		// ListEnabled returns enabled rules of all users ordered by ID.
+		ListEnabled(ctx context.Context, afterID, limit int) ([]*ent.RetentionRule, error)
+		// Count returns the number of rules of given user.
+		Count(ctx context.Context, uid int) (int, error)
+		// Delete deletes the rule.
+		Delete(ctx context.Context, id int) error
+		// MarkExecuted saves result of a run.
+		MarkExecuted(ctx context.Context, id int, executedAt time.Time, result *types.RetentionResult) error
+	}
+
+	RetentionRuleArgs struct {
+		// Folder is the URI of the folder the rule is attached to.
+		Folder       string
+		Recursive    bool
+		Action       retentionrule.Action
+		MaxAgeDays   int
+		KeepVersions int
+		Enabled      bool
+	}
+
+	ListRetentionRuleParameters struct {
+		*PaginationArgs
+		// UserID filters rules of given user, 0 for all users.
+		UserID int
+	}
+
+	ListRetentionRuleResult struct {
+		*PaginationResults
+		Rules []*ent.RetentionRule
+	}
+)
+
+func NewRetentionRuleClient(client *ent.Client, dbType conf.DBType) RetentionRuleClient {
+	return &retentionRuleClient{client: client, maxSQlParam: sqlParamLimit(dbType)}
+}
+
+type retentionRuleClient struct {
+	maxSQlParam int
+	client      *ent.Client
+}
+
+func (c *retentionRuleClient) SetClient(newClient *ent.Client) TxOperator {
+	return &retentionRuleClient{client: newClient, maxSQlParam: c.maxSQlParam}
+}
+
+func (c *retentionRuleClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *retentionRuleClient) Create(ctx context.Context, uid int, args *RetentionRuleArgs) (*ent.RetentionRule, error) {
+	created, err := c.client.RetentionRule.Create().
+		SetUserID(uid).
+		SetFolder(args.Folder).
+		SetRecursive(args.Recursive).
+		SetAction(args.Action).
+		SetMaxAgeDays(args.MaxAgeDays).
+		SetKeepVersions(args.KeepVersions).
+		SetEnabled(args.Enabled).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create retention rule: %w", err)
+	}
+
+	return created, nil
+}
+
+func (c *retentionRuleClient) Update(ctx context.Context, id int, args *RetentionRuleArgs) (*ent.RetentionRule, error) {
+	return c.client.RetentionRule.UpdateOneID(id).
+		SetFolder(args.Folder).
+		SetRecursive(args.Recursive).
+		SetAction(args.Action).
+		SetMaxAgeDays(args.MaxAgeDays).
+		SetKeepVersions(args.KeepVersions).
+		SetEnabled(args.Enabled).
+		Save(ctx)
+}
+
+func (c *retentionRuleClient) GetByID(ctx context.Context, id int) (*ent.RetentionRule, error) {
+	return c.client.RetentionRule.Get(ctx, id)
+}
+
+func (c *retentionRuleClient) GetByIDAndUserID(ctx context.Context, id, uid int) (*ent.RetentionRule, error) {
+	return c.client.RetentionRule.Query().
+		Where(retentionrule.ID(id), retentionrule.UserID(uid)).
+		First(ctx)
+}
+
+func (c *retentionRuleClient) List(ctx context.Context, uid int) ([]*ent.RetentionRule, error) {
+	return c.client.RetentionRule.Query().
+		Where(retentionrule.UserID(uid)).
+		Order(retentionrule.ByID()).
+		All(ctx)
+}
+
+func (c *retentionRuleClient) ListAll(ctx context.Context, args *ListRetentionRuleParameters) (*ListRetentionRuleResult, error) {
+	query := c.client.RetentionRule.Query()
+	if args.UserID > 0 {
+		query = query.Where(retentionrule.UserID(args.UserID))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := query.
+		WithUser().
+		Order(retentionrule.ByID(sql.OrderDesc())).
+		Limit(args.PageSize).
+		Offset(args.Page * args.PageSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListRetentionRuleResult{
+		PaginationResults: &PaginationResults{
+			TotalItems: total,
+			Page:       args.Page,
+			PageSize:   args.PageSize,
+		},
+		Rules: rules,
+	}, nil
+}
+
+func (c *retentionRuleClient) ListEnabled(ctx context.Context, afterID, limit int) ([]*ent.RetentionRule, error) {
+	return c.client.RetentionRule.Query().
+		Where(retentionrule.Enabled(true), retentionrule.IDGT(afterID)).
+		Order(retentionrule.ByID()).
+		Limit(capPageSize(c.maxSQlParam, limit, 0)).
+		All(ctx)
+}
+
+func (c *retentionRuleClient) Count(ctx context.Context, uid int) (int, error) {
+	return c.client.RetentionRule.Query().Where(retentionrule.UserID(uid)).Count(ctx)
+}
+
+func (c *retentionRuleClient) Delete(ctx context.Context, id int) error {
+	return c.client.RetentionRule.DeleteOneID(id).Exec(ctx)
+}
+
+func (c *retentionRuleClient) MarkExecuted(ctx context.Context, id int, executedAt time.Time, result *types.RetentionResult) error {
+	return c.client.RetentionRule.UpdateOneID(id).
+		SetLastRunAt(executedAt).
+		SetLastResult(result).
+		Exec(ctx)
+}
@@ -0,0 +1,127 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/rsssubscription"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+)
+
+type (
+	RssSubscriptionClient interface {
+		TxOperator
+		// Create subscribes a feed for given user.
+		Create(ctx context.Context, uid int, args *RssSubscriptionArgs) (*ent.RssSubscription, error)
+		// Update updates a subscription.
+		Update(ctx context.Context, id int, args *RssSubscriptionArgs) (*ent.RssSubscription, error)
+		// GetByIDAndUserID returns the subscription with given id and user id.
+		GetByIDAndUserID(ctx context.Context, id, uid int) (*ent.RssSubscription, error)
+		// List returns subscriptions of given user, newest first.
+		List(ctx context.Context, uid int) ([]*ent.RssSubscription, error)
+		// Count returns the number of subscriptions of given user.
+		Count(ctx context.Context, uid int) (int, error)
+		// Delete deletes the subscription.
+		Delete(ctx context.Context, id int) error
+		// ListEnabled returns enabled subscriptions whose ID is greater than afterID, ordered by ID.
+		ListEnabled(ctx context.Context, afterID, limit int) ([]*ent.RssSubscription, error)
+		// MarkChecked saves result of polling the feed, lastErr is empty if succeeded.
+		MarkChecked(ctx context.Context, id int, seen []string, checkedAt time.Time, lastErr string) error
+	}
+
+	RssSubscriptionArgs struct {
+		Name string
+		Url  string
+		// Include and Exclude are regular expressions matched against titles of feed items.
+		Include string
+		Exclude string
+		// Dst is the URI of folder to save downloaded files.
+		Dst     string
+		Enabled bool
+	}
+)
+
+func NewRssSubscriptionClient(client *ent.Client, dbType conf.DBType) RssSubscriptionClient {
+	return &rssSubscriptionClient{client: client, maxSQlParam: sqlParamLimit(dbType)}
+}
+
+type rssSubscriptionClient struct {
+	maxSQlParam int
+	client      *ent.Client
+}
+
+func (c *rssSubscriptionClient) SetClient(newClient *ent.Client) TxOperator {
+	return &rssSubscriptionClient{client: newClient, maxSQlParam: c.maxSQlParam}
+}
+
+func (c *rssSubscriptionClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *rssSubscriptionClient) Create(ctx context.Context, uid int, args *RssSubscriptionArgs) (*ent.RssSubscription, error) {
+	created, err := c.client.RssSubscription.Create().
+		SetUserID(uid).
+		SetName(args.Name).
+		SetURL(args.Url).
+		SetInclude(args.Include).
+		SetExclude(args.Exclude).
+		SetDst(args.Dst).
+		SetEnabled(args.Enabled).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rss subscription: %w", err)
+	}
+
+	return created, nil
+}
+
+func (c *rssSubscriptionClient) Update(ctx context.Context, id int, args *RssSubscriptionArgs) (*ent.RssSubscription, error) {
+	return c.client.RssSubscription.UpdateOneID(id).
+		SetName(args.Name).
+		SetURL(args.Url).
+		SetInclude(args.Include).
+		SetExclude(args.Exclude).
+		SetDst(args.Dst).
+		SetEnabled(args.Enabled).
+		Save(ctx)
+}
+
+func (c *rssSubscriptionClient) GetByIDAndUserID(ctx context.Context, id, uid int) (*ent.RssSubscription, error) {
+	return c.client.RssSubscription.Query().
+		Where(rsssubscription.ID(id), rsssubscription.UserID(uid)).
+		First(ctx)
+}
+
+func (c *rssSubscriptionClient) List(ctx context.Context, uid int) ([]*ent.RssSubscription, error) {
+	return c.client.RssSubscription.Query().
+		Where(rsssubscription.UserID(uid)).
+		Order(rsssubscription.ByID(sql.OrderDesc())).
+		All(ctx)
+}
+
+func (c *rssSubscriptionClient) Count(ctx context.Context, uid int) (int, error) {
+	return c.client.RssSubscription.Query().Where(rsssubscription.UserID(uid)).Count(ctx)
+}
+
+func (c *rssSubscriptionClient) Delete(ctx context.Context, id int) error {
+	return c.client.RssSubscription.DeleteOneID(id).Exec(ctx)
+}
+
+func (c *rssSubscriptionClient) ListEnabled(ctx context.Context, afterID, limit int) ([]*ent.RssSubscription, error) {
+	return c.client.RssSubscription.Query().
+		Where(rsssubscription.Enabled(true), rsssubscription.IDGT(afterID)).
+		Order(rsssubscription.ByID()).
+		Limit(capPageSize(c.maxSQlParam, limit, 0)).
+		All(ctx)
+}
+
+func (c *rssSubscriptionClient) MarkChecked(ctx context.Context, id int, seen []string, checkedAt time.Time, lastErr string) error {
+	return c.client.RssSubscription.UpdateOneID(id).
+		SetSeen(seen).
+		SetLastCheckedAt(checkedAt).
+		SetLastError(lastErr).
+		Exec(ctx)
+}
@@ -0,0 +1,115 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/s3accesskey"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+)
+
+const (
+	s3AccessKeyIDPrefix = "CR"
+	s3AccessKeyIDLength = 20
+	s3SecretLength      = 40
+)
+
+var s3AccessKeyIDVariant = []rune("ABCDEFGHIJKLMNOPQRSTUVWXYZ234567")
+
+type (
+	S3AccessKeyClient interface {
+		TxOperator
+		// Create generates a new access key pair for given user.
+		Create(ctx context.Context, uid int, name string, readOnly bool) (*ent.S3AccessKey, error)
+		// GetByAccessKeyID returns the key with given public access key ID.
+		GetByAccessKeyID(ctx context.Context, accessKeyID string) (*ent.S3AccessKey, error)
+		// List returns access keys of given user, newest first.
+		List(ctx context.Context, uid int) ([]*ent.S3AccessKey, error)
+		// Count returns the number of access keys of given user.
+		Count(ctx context.Context, uid int) (int, error)
+		// Delete deletes the access key with given access key ID owned by given user.
+		Delete(ctx context.Context, uid int, accessKeyID string) error
+		// MarkUsed updates last used time of the access key.
+		MarkUsed(ctx context.Context, id int) error
+	}
+)
+
+func NewS3AccessKeyClient(client *ent.Client) S3AccessKeyClient {
+	return &s3AccessKeyClient{client: client}
+}
+
+type s3AccessKeyClient struct {
+	client *ent.Client
+}
+
+func (c *s3AccessKeyClient) SetClient(newClient *ent.Client) TxOperator {
+	return &s3AccessKeyClient{client: newClient}
+}
+
+func (c *s3AccessKeyClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *s3AccessKeyClient) Create(ctx context.Context, uid int, name string, readOnly bool) (*ent.S3AccessKey, error) {
+	accessKeyID, err := util.SecureRandString(s3AccessKeyIDLength-len(s3AccessKeyIDPrefix), s3AccessKeyIDVariant)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := util.SecureRandString(s3SecretLength, util.RandomVariantAll)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := c.client.S3AccessKey.Create().
+		SetUserID(uid).
+		SetName(name).
+		SetAccessKeyID(s3AccessKeyIDPrefix + accessKeyID).
+		SetSecret(secret).
+		SetReadOnly(readOnly).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 access key: %w", err)
+	}
+
+	return created, nil
+}
+
+func (c *s3AccessKeyClient) GetByAccessKeyID(ctx context.Context, accessKeyID string) (*ent.S3AccessKey, error) {
+	return c.client.S3AccessKey.Query().
+		Where(s3accesskey.AccessKeyID(accessKeyID)).
+		First(ctx)
+}
+
+func (c *s3AccessKeyClient) List(ctx context.Context, uid int) ([]*ent.S3AccessKey, error) {
+	return c.client.S3AccessKey.Query().
+		Where(s3accesskey.UserID(uid)).
+		Order(s3accesskey.ByID(sql.OrderDesc())).
+		All(ctx)
+}
+
+func (c *s3AccessKeyClient) Count(ctx context.Context, uid int) (int, error) {
+	return c.client.S3AccessKey.Query().Where(s3accesskey.UserID(uid)).Count(ctx)
+}
+
+func (c *s3AccessKeyClient) Delete(ctx context.Context, uid int, accessKeyID string) error {
+	deleted, err := c.client.S3AccessKey.Delete().
+		Where(s3accesskey.UserID(uid), s3accesskey.AccessKeyID(accessKeyID)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+}
+
+func (c *s3AccessKeyClient) MarkUsed(ctx context.Context, id int) error {
+	return c.client.S3AccessKey.UpdateOneID(id).SetLastUsedAt(time.Now()).Exec(ctx)
+}
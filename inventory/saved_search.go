@@ -0,0 +1,120 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/savedsearch"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+)
+
+type (
+	SavedSearchClient interface {
+		TxOperator
+		// Create saves a search query for given user.
+		Create(ctx context.Context, uid int, args *SavedSearchArgs) (*ent.SavedSearch, error)
+		// Update updates a saved search.
+		Update(ctx context.Context, id int, args *SavedSearchArgs) (*ent.SavedSearch, error)
+		// GetByIDAndUserID returns the saved search with given id and user id.
+		GetByIDAndUserID(ctx context.Context, id, uid int) (*ent.SavedSearch, error)
+		// List returns saved searches of given user, newest first.
+		List(ctx context.Context, uid int) ([]*ent.SavedSearch, error)
+		// Count returns the number of saved searches of given user.
+		Count(ctx context.Context, uid int) (int, error)
+		// Delete deletes the saved search.
+		Delete(ctx context.Context, id int) error
+		// ListNotify returns saved searches with notification enabled whose ID is greater than afterID,
+		// ordered by ID.
+		ListNotify(ctx context.Context, afterID, limit int) ([]*ent.SavedSearch, error)
+		// MarkChecked updates the last time new matches of the saved search were checked.
+		MarkChecked(ctx context.Context, id int, checkedAt time.Time) error
+	}
+
+	SavedSearchArgs struct {
+		Name string
+		// Uri is a file URI with search parameters in its query.
+		Uri    string
+		Notify bool
+	}
+)
+
+func NewSavedSearchClient(client *ent.Client, dbType conf.DBType) SavedSearchClient {
+	return &savedSearchClient{client: client, maxSQlParam: sqlParamLimit(dbType)}
+}
+
+type savedSearchClient struct {
+	maxSQlParam int
+	client      *ent.Client
+}
+
+func (c *savedSearchClient) SetClient(newClient *ent.Client) TxOperator {
+	return &savedSearchClient{client: newClient, maxSQlParam: c.maxSQlParam}
+}
+
+func (c *savedSearchClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *savedSearchClient) Create(ctx context.Context, uid int, args *SavedSearchArgs) (*ent.SavedSearch, error) {
+	created, err := c.client.SavedSearch.Create().
+		SetUserID(uid).
+		SetName(args.Name).
+		SetURI(args.Uri).
+		SetNotify(args.Notify).
+		SetLastCheckedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create saved search: %w", err)
+	}
+
+	return created, nil
+}
+
+func (c *savedSearchClient) Update(ctx context.Context, id int, args *SavedSearchArgs) (*ent.SavedSearch, error) {
+	update := c.client.SavedSearch.UpdateOneID(id).
+		SetName(args.Name).
+		SetURI(args.Uri).
+		SetNotify(args.Notify)
+	if args.Notify {
+		// Only files created after notification is turned on are reported.
+		update.SetLastCheckedAt(time.Now())
+	}
+
+	return update.Save(ctx)
+}
+
+func (c *savedSearchClient) GetByIDAndUserID(ctx context.Context, id, uid int) (*ent.SavedSearch, error) {
+	return c.client.SavedSearch.Query().
+		Where(savedsearch.ID(id), savedsearch.UserID(uid)).
+		First(ctx)
+}
+
+func (c *savedSearchClient) List(ctx context.Context, uid int) ([]*ent.SavedSearch, error) {
+	return c.client.SavedSearch.Query().
+		Where(savedsearch.UserID(uid)).
+		Order(savedsearch.ByID(sql.OrderDesc())).
+		All(ctx)
+}
+
+func (c *savedSearchClient) Count(ctx context.Context, uid int) (int, error) {
+	return c.client.SavedSearch.Query().Where(savedsearch.UserID(uid)).Count(ctx)
+}
+
+func (c *savedSearchClient) Delete(ctx context.Context, id int) error {
+	return c.client.SavedSearch.DeleteOneID(id).Exec(ctx)
+}
+
+func (c *savedSearchClient) ListNotify(ctx context.Context, afterID, limit int) ([]*ent.SavedSearch, error) {
+	return c.client.SavedSearch.Query().
+		Where(savedsearch.Notify(true), savedsearch.IDGT(afterID)).
+		Order(savedsearch.ByID()).
+		Limit(capPageSize(c.maxSQlParam, limit, 0)).
+		All(ctx)
+}
+
+func (c *savedSearchClient) MarkChecked(ctx context.Context, id int, checkedAt time.Time) error {
+	return c.client.SavedSearch.UpdateOneID(id).SetLastCheckedAt(checkedAt).Exec(ctx)
+}
@@ -3,6 +3,7 @@ package inventory
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -11,6 +12,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent/predicate"
 	"github.com/cloudreve/Cloudreve/v4/ent/share"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/useremail"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/samber/lo"
@@ -26,6 +28,7 @@ var (
 	ErrShareLinkExpired  = fmt.Errorf("share link expired")
 	ErrOwnerInactive     = fmt.Errorf("owner is inactive")
 	ErrSourceFileInvalid = fmt.Errorf("source file is deleted")
+	ErrShareQuarantined  = fmt.Errorf("share is quarantined by content moderation")
 )
 
 type (
@@ -53,6 +56,11 @@ type (
 		CountByTimeRange(ctx context.Context, start, end *time.Time) (int, error)
 		// DeleteBatch deletes the shares with the given ids.
 		DeleteBatch(ctx context.Context, shareIds []int) error
+		// SetQuarantined disables or re-enables the share for content moderation.
+		SetQuarantined(ctx context.Context, shareId int, quarantined bool) error
+		// IsRecipient returns whether one of the primary or verified secondary emails of the user is
+		// a recipient of the share.
+		IsRecipient(ctx context.Context, share *ent.Share, u *ent.User) (bool, error)
 	}
 
 	CreateShareParams struct {
@@ -62,6 +70,8 @@ type (
 		Expires         *time.Time
 		OwnerID         int
 		FileID          int
+		// Recipients are lower case emails allowed to access the share, empty for everyone.
+		Recipients []string
 	}
 
 	ListShareArgs struct {
@@ -121,6 +131,11 @@ func (c *shareClient) Upsert(ctx context.Context, params *CreateShareParams) (*e
 		} else {
 			createQuery.ClearExpires()
 		}
+		if len(params.Recipients) > 0 {
+			createQuery.SetRecipients(params.Recipients)
+		} else {
+			createQuery.ClearRecipients()
+		}
 
 		return createQuery.Save(ctx)
 	}
@@ -138,6 +153,9 @@ func (c *shareClient) Upsert(ctx context.Context, params *CreateShareParams) (*e
 	if params.Expires != nil {
 		query.SetNillableExpires(params.Expires)
 	}
+	if len(params.Recipients) > 0 {
+		query.SetRecipients(params.Recipients)
+	}
 
 	return query.Save(ctx)
 }
@@ -189,6 +207,20 @@ func (c *shareClient) Delete(ctx context.Context, shareId int) error {
 	return c.client.Share.DeleteOneID(shareId).Exec(ctx)
 }
 
+func (c *shareClient) IsRecipient(ctx context.Context, s *ent.Share, u *ent.User) (bool, error) {
+	if len(s.Recipients) == 0 {
+		return false, nil
+	}
+
+	if lo.Contains(s.Recipients, strings.ToLower(u.Email)) {
+		return true, nil
+	}
+
+	return c.client.UserEmail.Query().
+		Where(useremail.UserID(u.ID), useremail.EmailIn(s.Recipients...), useremail.VerifiedAtNotNil()).
+		Exist(ctx)
+}
+
 // Viewed increments the view count of the share.
 func (c *shareClient) Viewed(ctx context.Context, share *ent.Share) error {
 	_, err := c.client.Share.UpdateOneID(share.ID).AddViews(1).Save(ctx)
@@ -207,12 +239,20 @@ func (c *shareClient) Downloaded(ctx context.Context, share *ent.Share) error {
 	return err
 }
 
+func (c *shareClient) SetQuarantined(ctx context.Context, shareId int, quarantined bool) error {
+	return c.client.Share.UpdateOneID(shareId).SetQuarantined(quarantined).Exec(ctx)
+}
+
 func IsValidShare(share *ent.Share) error {
 	// Check if share is expired
 	if err := IsShareExpired(share); err != nil {
 		return err
 	}
 
+	if share.Quarantined {
+		return ErrShareQuarantined
+	}
+
 	// Check owner status
 	owner, err := share.Edges.UserOrErr()
 	if err != nil || owner.Status != user.StatusActive {
@@ -337,7 +377,7 @@ func (c *shareClient) listQuery(args *ListShareArgs) *ent.ShareQuery {
 	}
 
 	if args.PublicOnly {
-		query.Where(share.PasswordIsNil())
+		query.Where(share.PasswordIsNil(), share.RecipientsIsNil())
 	}
 
 	if args.FileID > 0 {
@@ -0,0 +1,157 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/syncjob"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+)
+
+type (
+	SyncJobClient interface {
+		TxOperator
+		// Create creates a folder sync job for given user, it runs once the cron job is triggered.
+		Create(ctx context.Context, uid int, args *SyncJobArgs) (*ent.SyncJob, error)
+		// Update updates a sync job.
+		Update(ctx context.Context, id int, args *SyncJobArgs) (*ent.SyncJob, error)
+		// GetByID returns the sync job with given id.
+		GetByID(ctx context.Context, id int) (*ent.SyncJob, error)
+		// GetByIDAndUserID returns the sync job with given id and user id.
+		GetByIDAndUserID(ctx context.Context, id, uid int) (*ent.SyncJob, error)
+		// List returns sync jobs of given user, newest first.
+		List(ctx context.Context, uid int) ([]*ent.SyncJob, error)
+		// Count returns the number of sync jobs of given user.
+		Count(ctx context.Context, uid int) (int, error)
+		// Delete deletes the sync job.
+		Delete(ctx context.Context, id int) error
+		// ListDue returns enabled jobs whose next run is before given time, ordered by ID.
+		ListDue(ctx context.Context, before time.Time, afterID, limit int) ([]*ent.SyncJob, error)
+		// MarkQueued saves the task of a new run and schedules the next one.
+		MarkQueued(ctx context.Context, id, taskID int, nextRunAt time.Time) error
+		// SaveReport saves result of a finished run.
+		SaveReport(ctx context.Context, id int, report *types.SyncReport) error
+		// RunNow schedules the job to run once the cron job is triggered.
+		RunNow(ctx context.Context, id int) error
+	}
+
+	SyncJobArgs struct {
+		Name string
+		// Src and Dst are URIs of the source and destination folder.
+		Src             string
+		Dst             string
+		Interval        int
+		Compare         syncjob.Compare
+		PropagateDelete bool
+		Enabled         bool
+	}
+)
+
+func NewSyncJobClient(client *ent.Client, dbType conf.DBType) SyncJobClient {
+	return &syncJobClient{client: client, maxSQlParam: sqlParamLimit(dbType)}
+}
+
+type syncJobClient struct {
+	maxSQlParam int
+	client      *ent.Client
+}
+
+func (c *syncJobClient) SetClient(newClient *ent.Client) TxOperator {
+	return &syncJobClient{client: newClient, maxSQlParam: c.maxSQlParam}
+}
+
+func (c *syncJobClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *syncJobClient) Create(ctx context.Context, uid int, args *SyncJobArgs) (*ent.SyncJob, error) {
+	created, err := c.client.SyncJob.Create().
+		SetUserID(uid).
+		SetName(args.Name).
+		SetSrc(args.Src).
+		SetDst(args.Dst).
+		SetInterval(args.Interval).
+		SetCompare(args.Compare).
+		SetPropagateDelete(args.PropagateDelete).
+		SetEnabled(args.Enabled).
+		SetNextRunAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sync job: %w", err)
+	}
+
+	return created, nil
+}
+
+func (c *syncJobClient) Update(ctx context.Context, id int, args *SyncJobArgs) (*ent.SyncJob, error) {
+	return c.client.SyncJob.UpdateOneID(id).
+		SetName(args.Name).
+		SetSrc(args.Src).
+		SetDst(args.Dst).
+		SetInterval(args.Interval).
+		SetCompare(args.Compare).
+		SetPropagateDelete(args.PropagateDelete).
+		SetEnabled(args.Enabled).
+		Save(ctx)
+}
+
+func (c *syncJobClient) GetByID(ctx context.Context, id int) (*ent.SyncJob, error) {
+	return c.client.SyncJob.Get(ctx, id)
+}
+
+func (c *syncJobClient) GetByIDAndUserID(ctx context.Context, id, uid int) (*ent.SyncJob, error) {
+	return c.client.SyncJob.Query().
+		Where(syncjob.ID(id), syncjob.UserID(uid)).
+		First(ctx)
+}
+
+func (c *syncJobClient) List(ctx context.Context, uid int) ([]*ent.SyncJob, error) {
+	return c.client.SyncJob.Query().
+		Where(syncjob.UserID(uid)).
+		Order(syncjob.ByID(sql.OrderDesc())).
+		All(ctx)
+}
+
+func (c *syncJobClient) Count(ctx context.Context, uid int) (int, error) {
+	return c.client.SyncJob.Query().Where(syncjob.UserID(uid)).Count(ctx)
+}
+
+func (c *syncJobClient) Delete(ctx context.Context, id int) error {
+	return c.client.SyncJob.DeleteOneID(id).Exec(ctx)
+}
+
+func (c *syncJobClient) ListDue(ctx context.Context, before time.Time, afterID, limit int) ([]*ent.SyncJob, error) {
+	return c.client.SyncJob.Query().
+		Where(
+			syncjob.Enabled(true),
+			syncjob.IDGT(afterID),
+			syncjob.Or(syncjob.NextRunAtIsNil(), syncjob.NextRunAtLTE(before)),
+		).
+		Order(syncjob.ByID()).
+		Limit(capPageSize(c.maxSQlParam, limit, 0)).
+		All(ctx)
+}
+
+func (c *syncJobClient) MarkQueued(ctx context.Context, id, taskID int, nextRunAt time.Time) error {
+	return c.client.SyncJob.UpdateOneID(id).
+		SetTaskID(taskID).
+		SetNextRunAt(nextRunAt).
+		Exec(ctx)
+}
+
+func (c *syncJobClient) SaveReport(ctx context.Context, id int, report *types.SyncReport) error {
+	return c.client.SyncJob.UpdateOneID(id).
+		SetLastRunAt(report.StartedAt).
+		SetLastReport(report).
+		Exec(ctx)
+}
+
+func (c *syncJobClient) RunNow(ctx context.Context, id int) error {
+	return c.client.SyncJob.UpdateOneID(id).
+		SetNextRunAt(time.Now()).
+		Exec(ctx)
+}
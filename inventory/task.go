@@ -3,6 +3,7 @@ package inventory
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/cloudreve/Cloudreve/v4/ent"
@@ -25,9 +26,15 @@ type (
 		PrivateState  string
 		OwnerID       int
 		CorrelationID uuid.UUID
+		// IdempotencyKey is only used on creation, tasks with the same key are rejected until the key is
+		// released.
+		IdempotencyKey string
 	}
 )
 
+// statusError is referred in methods where task package is shadowed.
+const statusError = task.StatusError
+
 type TaskClient interface {
 	TxOperator
 	// New creates a new task with the given args.
@@ -38,12 +45,22 @@ type TaskClient interface {
 	GetPendingTasks(ctx context.Context, taskType ...string) ([]*ent.Task, error)
 	// GetTaskByID returns the task with the given ID.
 	GetTaskByID(ctx context.Context, taskID int) (*ent.Task, error)
+	// GetTaskByIdempotencyKey returns the task created with the given idempotency key.
+	GetTaskByIdempotencyKey(ctx context.Context, key string) (*ent.Task, error)
+	// ReleaseIdempotencyKey clears the given idempotency key from tasks created before the given time, so
+	// that it can be used by new tasks.
+	ReleaseIdempotencyKey(ctx context.Context, key string, before time.Time) error
 	// SetCompleteByID sets the task with the given ID to complete.
 	SetCompleteByID(ctx context.Context, taskID int) error
 	// List returns a list of tasks with the given args.
 	List(ctx context.Context, args *ListTaskArgs) (*ListTaskResult, error)
 	// DeleteByIDs deletes the tasks with the given IDs.
 	DeleteByIDs(ctx context.Context, ids ...int) error
+	// MarkDeadLettered moves the failed task with the given ID into dead-letter queue.
+	MarkDeadLettered(ctx context.Context, taskID int) error
+	// DeleteDeadLettered deletes tasks in dead-letter queue, only the ones with given IDs if any is
+	// given. Returns the number of deleted tasks.
+	DeleteDeadLettered(ctx context.Context, ids ...int) (int, error)
 }
 
 type (
@@ -53,6 +70,8 @@ type (
 		Status        []task.Status
 		UserID        int
 		CorrelationID *uuid.UUID
+		// DeadLettered only lists tasks in dead-letter queue.
+		DeadLettered bool
 	}
 
 	ListTaskResult struct {
@@ -100,6 +119,10 @@ func (c *taskClient) New(ctx context.Context, task *TaskArgs) (*ent.Task, error)
 		stm.SetCorrelationID(task.CorrelationID)
 	}
 
+	if task.IdempotencyKey != "" {
+		stm.SetIdempotencyKey(task.IdempotencyKey)
+	}
+
 	newTask, err := stm.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create task: %w", err)
@@ -113,6 +136,15 @@ func (c *taskClient) DeleteByIDs(ctx context.Context, ids ...int) error {
 	return err
 }
 
+func (c *taskClient) DeleteDeadLettered(ctx context.Context, ids ...int) (int, error) {
+	q := c.client.Task.Delete().Where(task.DeadLetteredAtNotNil())
+	if len(ids) > 0 {
+		q.Where(task.IDIn(ids...))
+	}
+
+	return q.Exec(ctx)
+}
+
 func (c *taskClient) Update(ctx context.Context, task *ent.Task, args *TaskArgs) (*ent.Task, error) {
 	stm := c.client.Task.UpdateOne(task).
 		SetPublicState(args.PublicState)
@@ -129,6 +161,12 @@ func (c *taskClient) Update(ctx context.Context, task *ent.Task, args *TaskArgs)
 		task.Status = args.Status
 	}
 
+	// Task leaves dead-letter queue once it is requeued.
+	if task.DeadLetteredAt != nil && args.Status != statusError {
+		stm.ClearDeadLetteredAt()
+		task.DeadLetteredAt = nil
+	}
+
 	if err := stm.Exec(ctx); err != nil {
 		return nil, fmt.Errorf("failed to create task: %w", err)
 	}
@@ -136,6 +174,12 @@ func (c *taskClient) Update(ctx context.Context, task *ent.Task, args *TaskArgs)
 	return task, nil
 }
 
+func (c *taskClient) MarkDeadLettered(ctx context.Context, taskID int) error {
+	return c.client.Task.UpdateOneID(taskID).
+		SetDeadLetteredAt(time.Now()).
+		Exec(ctx)
+}
+
 func (c *taskClient) GetPendingTasks(ctx context.Context, taskType ...string) ([]*ent.Task, error) {
 	tasks, err := withTaskEagerLoading(ctx, c.client.Task.Query()).
 		Where(task.StatusIn(task.StatusProcessing, task.StatusQueued, task.StatusSuspending)).
@@ -166,6 +210,20 @@ func (c *taskClient) GetTaskByID(ctx context.Context, taskID int) (*ent.Task, er
 		First(ctx)
 }
 
+func (c *taskClient) GetTaskByIdempotencyKey(ctx context.Context, key string) (*ent.Task, error) {
+	return withTaskEagerLoading(ctx, c.client.Task.Query()).
+		Where(task.IdempotencyKey(key)).
+		First(ctx)
+}
+
+func (c *taskClient) ReleaseIdempotencyKey(ctx context.Context, key string, before time.Time) error {
+	_, err := c.client.Task.Update().
+		Where(task.IdempotencyKey(key), task.CreatedAtLT(before)).
+		ClearIdempotencyKey().
+		Save(ctx)
+	return err
+}
+
 func (c *taskClient) SetCompleteByID(ctx context.Context, taskID int) error {
 	_, err := c.client.Task.UpdateOneID(taskID).
 		SetStatus(task.StatusCompleted).
@@ -191,6 +249,10 @@ func (c *taskClient) List(ctx context.Context, args *ListTaskArgs) (*ListTaskRes
 		q.Where(task.CorrelationID(*args.CorrelationID))
 	}
 
+	if args.DeadLettered {
+		q.Where(task.DeadLetteredAtNotNil())
+	}
+
 	q = withTaskEagerLoading(ctx, q)
 	var (
 		tasks         []*ent.Task
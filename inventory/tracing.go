@@ -0,0 +1,141 @@
+package inventory
+
+import (
+	"context"
+	rawsql "database/sql"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"github.com/cloudreve/Cloudreve/v4/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedDriver wraps an ent driver to create a span for each statement.
+type tracedDriver struct {
+	dialect.Driver
+}
+
+// withTracing returns an ent driver that records a span for each statement.
+func withTracing(d dialect.Driver) dialect.Driver {
+	return &tracedDriver{Driver: d}
+}
+
+func (d *tracedDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return traceStatement(ctx, d.Dialect(), "exec", query, func(ctx context.Context) error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+func (d *tracedDriver) Query(ctx context.Context, query string, args, v any) error {
+	return traceStatement(ctx, d.Dialect(), "query", query, func(ctx context.Context) error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+// ExecContext calls the underlying driver ExecContext method if it is supported.
+func (d *tracedDriver) ExecContext(ctx context.Context, query string, args ...any) (rawsql.Result, error) {
+	return execContext(ctx, d.Driver, d.Dialect(), query, args...)
+}
+
+// QueryContext calls the underlying driver QueryContext method if it is supported.
+func (d *tracedDriver) QueryContext(ctx context.Context, query string, args ...any) (*rawsql.Rows, error) {
+	return queryContext(ctx, d.Driver, d.Dialect(), query, args...)
+}
+
+func (d *tracedDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tracedTx{Tx: tx, dialect: d.Dialect()}, nil
+}
+
+// BeginTx starts a transaction with options, only supported if the underlying driver does.
+func (d *tracedDriver) BeginTx(ctx context.Context, opts *rawsql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *rawsql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("driver.BeginTx is not supported")
+	}
+
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tracedTx{Tx: tx, dialect: d.Dialect()}, nil
+}
+
+// tracedTx wraps an ent transaction to create a span for each statement.
+type tracedTx struct {
+	dialect.Tx
+	dialect string
+}
+
+func (t *tracedTx) Exec(ctx context.Context, query string, args, v any) error {
+	return traceStatement(ctx, t.dialect, "exec", query, func(ctx context.Context) error {
+		return t.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+func (t *tracedTx) Query(ctx context.Context, query string, args, v any) error {
+	return traceStatement(ctx, t.dialect, "query", query, func(ctx context.Context) error {
+		return t.Tx.Query(ctx, query, args, v)
+	})
+}
+
+func (t *tracedTx) ExecContext(ctx context.Context, query string, args ...any) (rawsql.Result, error) {
+	return execContext(ctx, t.Tx, t.dialect, query, args...)
+}
+
+func (t *tracedTx) QueryContext(ctx context.Context, query string, args ...any) (*rawsql.Rows, error) {
+	return queryContext(ctx, t.Tx, t.dialect, query, args...)
+}
+
+func execContext(ctx context.Context, d any, dbSystem, query string, args ...any) (rawsql.Result, error) {
+	drv, ok := d.(interface {
+		ExecContext(context.Context, string, ...any) (rawsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("driver.ExecContext is not supported")
+	}
+
+	var res rawsql.Result
+	err := traceStatement(ctx, dbSystem, "exec", query, func(ctx context.Context) (err error) {
+		res, err = drv.ExecContext(ctx, query, args...)
+		return err
+	})
+	return res, err
+}
+
+func queryContext(ctx context.Context, d any, dbSystem, query string, args ...any) (*rawsql.Rows, error) {
+	drv, ok := d.(interface {
+		QueryContext(context.Context, string, ...any) (*rawsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("driver.QueryContext is not supported")
+	}
+
+	var rows *rawsql.Rows
+	err := traceStatement(ctx, dbSystem, "query", query, func(ctx context.Context) (err error) {
+		rows, err = drv.QueryContext(ctx, query, args...)
+		return err
+	})
+	return rows, err
+}
+
+func traceStatement(ctx context.Context, dbSystem, op, query string, fn func(ctx context.Context) error) error {
+	ctx, span := tracing.Start(ctx, "db."+op,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", dbSystem),
+			attribute.String("db.statement", query),
+		),
+	)
+	err := fn(ctx)
+	tracing.End(span, err)
+	return err
+}
@@ -15,6 +15,82 @@ type (
 		Pined               []PinedFile `json:"pined,omitempty"`
 		Language            string      `json:"email_language,omitempty"`
 		SyncViewPreferences bool        `json:"sync_view_preferences,omitempty"`
+		// TwoFAPasskey indicates whether a registered passkey can be used as second factor.
+		TwoFAPasskey bool `json:"two_fa_passkey,omitempty"`
+		// InvitedBy ID of the user whose invitation code is used to register this user.
+		InvitedBy int `json:"invited_by,omitempty"`
+		// QuotaAlert the last storage quota alert sent to user.
+		QuotaAlert *QuotaAlert `json:"quota_alert,omitempty"`
+		// Offboarding is set when the user is archived by admin.
+		Offboarding *Offboarding `json:"offboarding,omitempty"`
+		// PasswordChangedAt is the last time the password is changed.
+		PasswordChangedAt *time.Time `json:"password_changed_at,omitempty"`
+		// PasswordHistory digests of previous passwords, newest first.
+		PasswordHistory []string `json:"password_history,omitempty"`
+		// PendingEmail is set when an email change is waiting for confirmation.
+		PendingEmail *PendingEmailChange `json:"pending_email,omitempty"`
+		// Deletion is set when the user requested to delete the account.
+		Deletion *AccountDeletion `json:"deletion,omitempty"`
+		// GroupExpiration is set when current group membership is time-limited.
+		GroupExpiration *GroupExpiration `json:"group_expiration,omitempty"`
+		// LegalHold is set when all files of the user are under legal hold.
+		LegalHold *LegalHold `json:"legal_hold,omitempty"`
+	}
+
+	// LegalHold records a legal hold placed by admin, files under hold cannot be deleted or overwritten.
+	LegalHold struct {
+		At time.Time `json:"at"`
+		// By ID of the admin who placed the hold.
+		By     int    `json:"by"`
+		Reason string `json:"reason,omitempty"`
+	}
+
+	// PendingEmailChange records an email change waiting for confirmation from the new address.
+	PendingEmailChange struct {
+		Email string `json:"email"`
+		// ConfirmDigest and VetoDigest are digests of secrets sent to the new and old address.
+		ConfirmDigest string    `json:"confirm_digest"`
+		VetoDigest    string    `json:"veto_digest,omitempty"`
+		RequestedAt   time.Time `json:"requested_at"`
+		ExpiresAt     time.Time `json:"expires_at"`
+		// EffectiveAt is set once the new address is confirmed, the old address stays in use and can veto
+		// the change until then.
+		EffectiveAt *time.Time `json:"effective_at,omitempty"`
+	}
+
+	// Offboarding records how a user is archived.
+	Offboarding struct {
+		At time.Time `json:"at"`
+		// By ID of the admin who archived the user.
+		By int `json:"by"`
+		// TransferTo ID of the user who receives files and shares, 0 if not transferred.
+		TransferTo int `json:"transfer_to,omitempty"`
+		// ArchivePolicy ID of the storage policy that files are exported to, 0 if not exported.
+		ArchivePolicy int `json:"archive_policy,omitempty"`
+		// DeleteAt the user will be deleted after this time, nil means never.
+		DeleteAt *time.Time `json:"delete_at,omitempty"`
+	}
+
+	// GroupExpiration records a time-limited group membership.
+	GroupExpiration struct {
+		ExpiresAt time.Time `json:"expires_at"`
+		// FallbackGroup the user is moved to after expiration.
+		FallbackGroup int `json:"fallback_group"`
+		// NotifiedAt is set once the user is notified about upcoming expiration.
+		NotifiedAt *time.Time `json:"notified_at,omitempty"`
+	}
+	// AccountDeletion records a self-service account deletion request.
+	AccountDeletion struct {
+		RequestedAt time.Time `json:"requested_at"`
+		// PurgeAt the account and all its data will be purged after this time, login before it reactivates
+		// the account.
+		PurgeAt time.Time `json:"purge_at"`
+	}
+
+	// QuotaAlert records the highest storage usage threshold crossed by user.
+	QuotaAlert struct {
+		Threshold  int       `json:"threshold"`
+		NotifiedAt time.Time `json:"notified_at"`
 	}
 
 	PinedFile struct {
@@ -32,8 +108,39 @@ type (
 		MaxWalkedFiles        int                    `json:"max_walked_files,omitempty"`
 		TrashRetention        int                    `json:"trash_retention,omitempty"`
 		RedirectedSource      bool                   `json:"redirected_source,omitempty"`
+		// RemoteDownloadSchedule restricts when remote downloads of the group run and their speed.
+		RemoteDownloadSchedule *RemoteDownloadSchedule `json:"remote_download_schedule,omitempty"`
+		// AutomationRuleMax maximum number of automation rules of each user, 0 to disable automation.
+		AutomationRuleMax int `json:"automation_rule_max,omitempty"`
+		// Policy structured restrictions evaluated on top of permission flags.
+		Policy *GroupPolicy `json:"policy,omitempty"`
+		// MaxConcurrentTasks maximum number of running tasks of each user in a queue, 0 for unlimited.
+		MaxConcurrentTasks int `json:"max_concurrent_tasks,omitempty"`
+	}
+
+	// GroupPolicy structured restrictions applied to members of a group.
+	GroupPolicy struct {
+		// MaxFileSize maximum size of a single uploaded file, 0 for unlimited.
+		MaxFileSize int64 `json:"max_file_size,omitempty"`
+		// AllowedMimeTypes MIME types allowed for upload, supports wildcard like "image/*". Empty for any.
+		AllowedMimeTypes []string `json:"allowed_mime_types,omitempty"`
+		// Limits usage limits within time windows.
+		Limits []GroupPolicyLimit `json:"limits,omitempty"`
+	}
+
+	// GroupPolicyLimit limits count or total size of an action within a time window.
+	GroupPolicyLimit struct {
+		Action GroupPolicyAction `json:"action"`
+		// Window length of the window in seconds.
+		Window int `json:"window"`
+		// MaxCount maximum number of actions within the window, 0 for unlimited.
+		MaxCount int64 `json:"max_count,omitempty"`
+		// MaxSize maximum total bytes within the window, 0 for unlimited.
+		MaxSize int64 `json:"max_size,omitempty"`
 	}
 
+	GroupPolicyAction string
+
 	// PolicySetting 非公有的存储策略属性
 	PolicySetting struct {
 		// Upyun访问Token
@@ -79,6 +186,16 @@ type (
 		MediaMetaGeneratorProxy bool `json:"media_meta_generator_proxy,omitempty"`
 		// ThumbGeneratorProxy whether to use local proxy to generate thumbnail.
 		ThumbGeneratorProxy bool `json:"thumb_generator_proxy,omitempty"`
+		// ThumbGeneratorNode whether to offload local proxy thumbnail generation to slave nodes.
+		ThumbGeneratorNode bool `json:"thumb_generator_node,omitempty"`
+		// VideoThumbDisabled whether to skip ffmpeg thumbnail generator for files of this policy.
+		VideoThumbDisabled bool `json:"video_thumb_disabled,omitempty"`
+		// VideoThumbMaxSize overrides max source size of ffmpeg thumbnail generator, 0 uses site setting.
+		VideoThumbMaxSize int64 `json:"video_thumb_max_size,omitempty"`
+		// AiTagging whether to send images of this policy to the AI tagging endpoint.
+		AiTagging bool `json:"ai_tagging,omitempty"`
+		// EdgeCacheNode whether to serve downloads through slave nodes caching hot entities.
+		EdgeCacheNode bool `json:"edge_cache_node,omitempty"`
 		// NativeMediaProcessing whether to use native media processing API from storage provider.
 		NativeMediaProcessing bool `json:"native_media_processing"`
 		// S3DeleteBatchSize the number of objects to delete in each batch.
@@ -89,6 +206,12 @@ type (
 		UseCname bool `json:"use_cname,omitempty"`
 		// CDN domain does not need to be signed.
 		SourceAuth bool `json:"source_auth,omitempty"`
+		// VersionMaxCount caps versions kept for each file of this policy on top of user's setting, 0 means
+		// no limit.
+		VersionMaxCount int `json:"version_max_count,omitempty"`
+		// VersionMaxAge is the max age in seconds of previous versions, older ones are pruned in background.
+		// 0 means no limit.
+		VersionMaxAge int `json:"version_max_age,omitempty"`
 	}
 
 	FileType         int
@@ -99,12 +222,40 @@ type (
 	NodeCapability   int
 
 	NodeSetting struct {
-		Provider            DownloaderProvider `json:"provider,omitempty"`
-		*QBittorrentSetting `json:"qbittorrent,omitempty"`
-		*Aria2Setting       `json:"aria2,omitempty"`
+		Provider             DownloaderProvider `json:"provider,omitempty"`
+		*QBittorrentSetting  `json:"qbittorrent,omitempty"`
+		*Aria2Setting        `json:"aria2,omitempty"`
+		*TransmissionSetting `json:"transmission,omitempty"`
+		*YtDlpSetting        `json:"ytdlp,omitempty"`
 		// 下载监控间隔
 		Interval       int  `json:"interval,omitempty"`
 		WaitForSeeding bool `json:"wait_for_seeding,omitempty"`
+		// Schedule restricts when remote downloads run on the node and their speed.
+		Schedule *RemoteDownloadSchedule `json:"schedule,omitempty"`
+		// gRPC address of slave node in host:port form, HTTP API is used if empty.
+		GRPCServer string `json:"grpc_server,omitempty"`
+		// TLS settings used by master to call slave node.
+		TLS *NodeTLSSetting `json:"tls,omitempty"`
+		// Transcode overrides site transcode settings for tasks running on this node.
+		Transcode *NodeTranscodeSetting `json:"transcode,omitempty"`
+	}
+
+	// NodeTranscodeSetting ffmpeg settings of a node, usually to enable hardware acceleration.
+	NodeTranscodeSetting struct {
+		FFMpegPath   string `json:"ffmpeg_path,omitempty"`
+		HWAccel      string `json:"hwaccel,omitempty"`
+		VideoEncoder string `json:"video_encoder,omitempty"`
+	}
+
+	// NodeTLSSetting PEM encoded certificates for mutual TLS with slave node.
+	NodeTLSSetting struct {
+		// CA to verify certificate of slave node, system roots are used if empty.
+		CA string `json:"ca,omitempty"`
+		// Cert and Key of client certificate presented by master.
+		Cert string `json:"cert,omitempty"`
+		Key  string `json:"key,omitempty"`
+		// ServerName overrides the host name to verify certificate of slave node against.
+		ServerName string `json:"server_name,omitempty"`
 	}
 
 	DownloaderProvider string
@@ -115,6 +266,8 @@ type (
 		Password string         `json:"password,omitempty"`
 		Options  map[string]any `json:"options,omitempty"`
 		TempPath string         `json:"temp_path,omitempty"`
+		// Category is assigned to torrents created by Cloudreve, isolating them from others in the same client.
+		Category string `json:"category,omitempty"`
 	}
 
 	Aria2Setting struct {
@@ -124,6 +277,35 @@ type (
 		TempPath string         `json:"temp_path,omitempty"`
 	}
 
+	TransmissionSetting struct {
+		// Server is the RPC URL, "/transmission/rpc" is used if path is omitted.
+		Server   string         `json:"server,omitempty"`
+		User     string         `json:"user,omitempty"`
+		Password string         `json:"password,omitempty"`
+		Options  map[string]any `json:"options,omitempty"`
+		TempPath string         `json:"temp_path,omitempty"`
+	}
+
+	// RemoteDownloadSchedule time windows and speed limit of remote downloads.
+	RemoteDownloadSchedule struct {
+		// Windows in local time as "HH:MM-HH:MM", e.g. "01:00-07:00" or "22:00-06:00". Downloads start
+		// anytime if empty.
+		Windows []string `json:"windows,omitempty"`
+		// SpeedLimit maximum download speed in bytes per second, 0 for unlimited.
+		SpeedLimit int64 `json:"speed_limit,omitempty"`
+	}
+
+	YtDlpSetting struct {
+		// BinaryPath is the path of yt-dlp executable, looked up in PATH if empty.
+		BinaryPath string         `json:"binary_path,omitempty"`
+		Options    map[string]any `json:"options,omitempty"`
+		TempPath   string         `json:"temp_path,omitempty"`
+		// Sandbox is a command prefix yt-dlp is run with, e.g. "firejail --quiet --private-tmp".
+		Sandbox string `json:"sandbox,omitempty"`
+		// Timeout is the maximum seconds a download can run, 0 for no limit.
+		Timeout int `json:"timeout,omitempty"`
+	}
+
 	TaskPublicState struct {
 		Error            string          `json:"error,omitempty"`
 		ErrorHistory     []string        `json:"error_history,omitempty"`
@@ -145,12 +327,127 @@ type (
 	}
 
 	DavAccountProps struct {
+		// Roots are the mount roots exposed by the account. If set, the account URI is ignored and
+		// only the roots are visible, each as a top-level folder named after it.
+		Roots []DavAccountRoot `json:"roots,omitempty"`
+		// RequestLimit is the max number of requests per minute, 0 means unlimited.
+		RequestLimit int `json:"request_limit,omitempty"`
+		// SpeedLimit is the max bandwidth in bytes per second shared by all transfers of the
+		// account, 0 means unlimited.
+		SpeedLimit int64 `json:"speed_limit,omitempty"`
+		// ValidDays is how long the password is valid after it is created or rotated, 0 means never expires.
+		ValidDays int `json:"valid_days,omitempty"`
+		// ExpiryNotifiedAt is when the owner is notified about upcoming expiration of the password.
+		ExpiryNotifiedAt *time.Time `json:"expiry_notified_at,omitempty"`
+	}
+
+	DavAccountRoot struct {
+		Name     string `json:"name" binding:"required,min=1,max=255"`
+		Uri      string `json:"uri" binding:"required"`
+		ReadOnly bool   `json:"read_only,omitempty"`
 	}
 
 	PolicyType string
 
+	NotificationType string
+
 	FileProps struct {
 	}
+
+	// OrganizationBranding overrides site branding for members of an organization, empty fields
+	// fall back to site settings.
+	OrganizationBranding struct {
+		SiteName     string `json:"site_name,omitempty"`
+		Logo         string `json:"logo,omitempty"`
+		LogoLight    string `json:"logo_light,omitempty"`
+		DefaultTheme string `json:"default_theme,omitempty"`
+	}
+
+	// StatsUserStorage is the storage used by a user in daily stats.
+	StatsUserStorage struct {
+		UserID  int   `json:"user_id"`
+		Storage int64 `json:"storage"`
+	}
+
+	// StatsFileType is the uploads of a file extension in daily stats.
+	StatsFileType struct {
+		Count int   `json:"count"`
+		Size  int64 `json:"size"`
+	}
+
+	// AutomationConditions files must match all non-empty conditions to trigger an automation rule.
+	AutomationConditions struct {
+		// Folder URI, only files directly in it match, or also files in its sub folders if Recursive.
+		Folder    string `json:"folder,omitempty"`
+		Recursive bool   `json:"recursive,omitempty"`
+		// NamePatterns glob patterns like "*.jpg" matched against file name case-insensitively, any of
+		// them matches.
+		NamePatterns []string `json:"name_patterns,omitempty"`
+		// MinSize and MaxSize in bytes, 0 for no limit.
+		MinSize int64 `json:"min_size,omitempty"`
+		MaxSize int64 `json:"max_size,omitempty"`
+	}
+
+	// AutomationAction is an action applied to matched files.
+	AutomationAction struct {
+		Type AutomationActionType `json:"type"`
+		// Dst URI of the destination folder of move and copy actions, placeholders like {year} and
+		// {month} are replaced. Missing folders are created.
+		Dst string `json:"dst,omitempty"`
+		// Tag name and optional color of tag actions.
+		Tag      string `json:"tag,omitempty"`
+		TagColor string `json:"tag_color,omitempty"`
+	}
+
+	AutomationActionType string
+
+	// SyncReport is the result of a folder sync run.
+	SyncReport struct {
+		StartedAt  time.Time  `json:"started_at"`
+		FinishedAt *time.Time `json:"finished_at,omitempty"`
+		// Created and Updated are the number of files copied to destination, new or changed.
+		Created   int `json:"created"`
+		Updated   int `json:"updated"`
+		Deleted   int `json:"deleted"`
+		Unchanged int `json:"unchanged"`
+		Failed    int `json:"failed"`
+		// Errors of failed files, only first few are kept.
+		Errors []string `json:"errors,omitempty"`
+		// Error aborting the run.
+		Error string `json:"error,omitempty"`
+	}
+
+	// RetentionResult is the result of the latest run of a retention rule.
+	RetentionResult struct {
+		// Removed is the number of expired files moved to trash or deleted.
+		Removed         int `json:"removed"`
+		VersionsDeleted int `json:"versions_deleted"`
+		Failed          int `json:"failed"`
+		// Error aborting the run.
+		Error string `json:"error,omitempty"`
+	}
+
+	// ModerationMatch is a file of a public share flagged by content moderation.
+	ModerationMatch struct {
+		FileID int    `json:"file_id"`
+		Name   string `json:"name"`
+		SHA256 string `json:"sha256,omitempty"`
+		// Source is either "hash_list" or "api".
+		Source string `json:"source"`
+		Reason string `json:"reason,omitempty"`
+	}
+)
+
+const (
+	GroupPolicyActionUpload   = GroupPolicyAction("upload")
+	GroupPolicyActionShare    = GroupPolicyAction("share")
+	GroupPolicyActionDownload = GroupPolicyAction("download")
+)
+
+const (
+	AutomationActionMove = AutomationActionType("move")
+	AutomationActionCopy = AutomationActionType("copy")
+	AutomationActionTag  = AutomationActionType("tag")
 )
 
 const (
@@ -171,6 +468,9 @@ const (
 	GroupPermission_CommunityPlaceholder4
 	GroupPermissionSetExplicitUser_placeholder
 	GroupPermissionIgnoreFileOwnership // not used
+	GroupPermissionInvite
+	GroupPermissionTranscode
+	GroupPermissionOfficeEdit
 )
 
 const (
@@ -179,6 +479,9 @@ const (
 	NodeCapabilityExtractArchive
 	NodeCapabilityRemoteDownload
 	NodeCapability_CommunityPlaceholder
+	NodeCapabilityGenerateThumb
+	NodeCapabilityTranscode
+	NodeCapabilityEdgeCache
 )
 
 const (
@@ -205,6 +508,8 @@ func FileTypeFromString(s string) FileType {
 const (
 	DavAccountReadOnly DavAccountOption = iota
 	DavAccountProxy
+	// DavAccountShares exposes received shares under /dav/shares.
+	DavAccountShares
 )
 
 const (
@@ -219,7 +524,24 @@ const (
 	PolicyTypeObs    = "obs"
 )
 
+// Notification types are also the translation keys of notification messages on the client side,
+// variables of messages are stored in notification props.
+const (
+	NotificationTypeQuotaAlert    = NotificationType("quota_alert")
+	NotificationTypeTaskCompleted = NotificationType("task_completed")
+	NotificationTypeTaskFailed    = NotificationType("task_failed")
+	NotificationTypeShareActivity = NotificationType("share_activity")
+	// NotificationTypeDavAccountExpiring is sent before the password of a WebDAV account expires.
+	NotificationTypeDavAccountExpiring = NotificationType("dav_account_expiring")
+	// NotificationTypeDavAccountDisabled is sent when a WebDAV account is disabled for being unused.
+	NotificationTypeDavAccountDisabled = NotificationType("dav_account_disabled")
+	// NotificationTypeSavedSearchMatch is sent when new files match a saved search.
+	NotificationTypeSavedSearchMatch = NotificationType("saved_search_match")
+)
+
 const (
-	DownloaderProviderAria2       = DownloaderProvider("aria2")
-	DownloaderProviderQBittorrent = DownloaderProvider("qbittorrent")
+	DownloaderProviderAria2        = DownloaderProvider("aria2")
+	DownloaderProviderQBittorrent  = DownloaderProvider("qbittorrent")
+	DownloaderProviderTransmission = DownloaderProvider("transmission")
+	DownloaderProviderYtDlp        = DownloaderProvider("ytdlp")
 )
@@ -13,13 +13,18 @@ import (
 	"strings"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/accesstoken"
+	"github.com/cloudreve/Cloudreve/v4/ent/checkout"
 	"github.com/cloudreve/Cloudreve/v4/ent/davaccount"
 	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/invitation"
 	"github.com/cloudreve/Cloudreve/v4/ent/passkey"
 	"github.com/cloudreve/Cloudreve/v4/ent/schema"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/ent/useremail"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
@@ -43,17 +48,26 @@ var (
 	ErrInsufficientPoints    = errors.New("insufficient points")
 )
 
+// MaxPasswordHistory is the maximum number of previous password digests kept for each user.
+const MaxPasswordHistory = 24
+
 type (
 	UserClient interface {
 		TxOperator
 		// New creates a new user. If user email registered, existed User will be returned.
 		Create(ctx context.Context, args *NewUserArgs) (*ent.User, error)
-		// GetByEmail get the user with given email, user status is ignored.
+		// GetByEmail get the user with given primary or verified secondary email, user status is ignored.
 		GetByEmail(ctx context.Context, email string) (*ent.User, error)
 		// GetByID get user by its ID, user status is ignored.
 		GetByID(ctx context.Context, id int) (*ent.User, error)
+		// GetByIDs get users with given IDs in one query, user status is ignored.
+		GetByIDs(ctx context.Context, ids []int) ([]*ent.User, error)
 		// GetActiveByID get user by its ID, only active user will be returned.
 		GetActiveByID(ctx context.Context, id int) (*ent.User, error)
+		// SetGroup moves user to given group and saves user settings.
+		SetGroup(ctx context.Context, u *ent.User, groupID int) (*ent.User, error)
+		// SetOverrides sets per-user storage policy and max storage overrides, 0 or nil clears them.
+		SetOverrides(ctx context.Context, uid, storagePolicy int, maxStorage *int64) error
 		// SetStatus Set user to given status
 		SetStatus(ctx context.Context, u *ent.User, status user.Status) (*ent.User, error)
 		// AnonymousUser returns the anonymous user.
@@ -64,6 +78,14 @@ type (
 		GetActiveByDavAccount(ctx context.Context, email, pwd string) (*ent.User, error)
 		// SaveSettings saves user settings.
 		SaveSettings(ctx context.Context, u *ent.User) error
+		// UpdateSettings reloads the user with its row locked, applies fn and saves settings in a
+		// transaction, so that fields changed concurrently are not overwritten by a stale copy. Settings
+		// are not saved if fn returns an error.
+		UpdateSettings(ctx context.Context, uid int, fn func(u *ent.User) error) (*ent.User, error)
+		// ListGroupExpiring returns users whose time-limited group membership expires before given time.
+		ListGroupExpiring(ctx context.Context, before time.Time) ([]*ent.User, error)
+		// ListEmailChangeDue returns IDs of users whose confirmed email change takes effect before given time.
+		ListEmailChangeDue(ctx context.Context, before time.Time) ([]int, error)
 		// SearchActive search active users by Email or nickname.
 		SearchActive(ctx context.Context, limit int, keyword string) ([]*ent.User, error)
 		// ApplyStorageDiff apply storage diff to user.
@@ -74,16 +96,56 @@ type (
 		UpdateNickname(ctx context.Context, u *ent.User, name string) (*ent.User, error)
 		// UpdatePassword updates user password.
 		UpdatePassword(ctx context.Context, u *ent.User, newPassword string) (*ent.User, error)
+		// UpdateEmail updates user email along with user settings.
+		UpdateEmail(ctx context.Context, u *ent.User, email string) (*ent.User, error)
 		// UpdateTwoFASecret updates user two factor secret.
 		UpdateTwoFASecret(ctx context.Context, u *ent.User, secret string) (*ent.User, error)
 		// ListPasskeys list user's passkeys.
 		ListPasskeys(ctx context.Context, uid int) ([]*ent.Passkey, error)
 		// AddPasskey add passkey to user.
 		AddPasskey(ctx context.Context, uid int, name string, credential *webauthn.Credential) (*ent.Passkey, error)
+		// RenamePasskey updates the display name of user's passkey.
+		RenamePasskey(ctx context.Context, uid int, keyId, name string) (*ent.Passkey, error)
 		// RemovePasskey remove passkey from user.
 		RemovePasskey(ctx context.Context, uid int, keyId string) error
 		// MarkPasskeyUsed updates passkey used at.
 		MarkPasskeyUsed(ctx context.Context, uid int, keyId string) error
+		// ListEmails list user's secondary emails, including unverified ones.
+		ListEmails(ctx context.Context, uid int) ([]*ent.UserEmail, error)
+		// AddEmail adds an unverified secondary email to user. Unverified ones of other users with the
+		// same address are replaced, since only the owner of the address can confirm it.
+		AddEmail(ctx context.Context, uid int, email, confirmDigest string, expiresAt time.Time) (*ent.UserEmail, error)
+		// VerifyEmail marks the secondary email as verified.
+		VerifyEmail(ctx context.Context, id int) error
+		// RemoveEmail removes the secondary email from user.
+		RemoveEmail(ctx context.Context, uid int, email string) error
+		// SwapPrimaryEmail swaps the verified secondary email with the primary one of user.
+		SwapPrimaryEmail(ctx context.Context, u *ent.User, alias *ent.UserEmail) (*ent.User, error)
+		// ListAccessTokens list user's personal access tokens.
+		ListAccessTokens(ctx context.Context, uid int) ([]*ent.AccessToken, error)
+		// CountAccessTokens count user's personal access tokens.
+		CountAccessTokens(ctx context.Context, uid int) (int, error)
+		// AddAccessToken add personal access token to user.
+		AddAccessToken(ctx context.Context, token *ent.AccessToken) (*ent.AccessToken, error)
+		// GetAccessToken get user's personal access token by its public token ID.
+		GetAccessToken(ctx context.Context, uid int, tokenID string) (*ent.AccessToken, error)
+		// RemoveAccessToken remove personal access token from user.
+		RemoveAccessToken(ctx context.Context, uid int, tokenID string) error
+		// MarkAccessTokenUsed updates access token last used at.
+		MarkAccessTokenUsed(ctx context.Context, id int) error
+		// ListInvitations list invitation codes generated by user, along with IDs of invitees.
+		ListInvitations(ctx context.Context, uid int) ([]*ent.Invitation, error)
+		// CountInvitations count invitation codes generated by user.
+		CountInvitations(ctx context.Context, uid int) (int, error)
+		// AddInvitation creates a new invitation code.
+		AddInvitation(ctx context.Context, invitation *ent.Invitation) (*ent.Invitation, error)
+		// GetInvitationByCode get invitation by its code, inviter and its group are loaded.
+		GetInvitationByCode(ctx context.Context, code string) (*ent.Invitation, error)
+		// RemoveInvitation remove invitation code generated by user.
+		RemoveInvitation(ctx context.Context, uid, id int) error
+		// ConsumeInvitation increases use count of the invitation if it is not used up or expired yet,
+		// returns false otherwise.
+		ConsumeInvitation(ctx context.Context, id int) (bool, error)
 		// CountByTimeRange count users by time range. Will return all records if start or end is nil.
 		CountByTimeRange(ctx context.Context, start, end *time.Time) (int, error)
 		// ListUsers list users with pagination.
@@ -94,6 +156,15 @@ type (
 		Delete(ctx context.Context, uid int) error
 		// CalculateStorage calculate user's storage from scratch and update user's storage.
 		CalculateStorage(ctx context.Context, uid int) (int64, error)
+		// ComputeStorage calculate user's storage from scratch without updating user's storage.
+		ComputeStorage(ctx context.Context, uid int) (int64, error)
+		// ReconcileStorage sets user's storage to actual if it is still expected, returns false if
+		// the storage is changed concurrently.
+		ReconcileStorage(ctx context.Context, uid int, expected, actual int64) (bool, error)
+		// SumStorageByGroup returns total storage used by users grouped by group ID.
+		SumStorageByGroup(ctx context.Context) (map[int]int64, error)
+		// ListTopByStorage lists users using most storage.
+		ListTopByStorage(ctx context.Context, limit int) ([]*ent.User, error)
 	}
 	ListUserParameters struct {
 		*PaginationArgs
@@ -126,6 +197,8 @@ type (
 		GroupID       int
 		Avatar        string // Optional
 		Language      string // Optional
+		// Invitation used to register, its group and storage presets are applied. Optional.
+		Invitation *ent.Invitation
 	}
 	CreateStoragePackArgs struct {
 		UserID   int
@@ -163,7 +236,45 @@ func (c *userClient) UpdatePassword(ctx context.Context, u *ent.User, newPasswor
 		return nil, err
 	}
 
-	return c.client.User.UpdateOne(u).SetPassword(digest).Save(ctx)
+	uc, tx, ctx, err := WithTx(ctx, UserClient(c))
+	if err != nil {
+		return nil, err
+	}
+
+	// Password history is read from the locked row, so that concurrent settings changes are not overwritten.
+	if _, err := uc.UpdateSettings(ctx, u.ID, func(locked *ent.User) error {
+		if locked.Password != "" {
+			locked.Settings.PasswordHistory = append([]string{locked.Password}, locked.Settings.PasswordHistory...)
+			if len(locked.Settings.PasswordHistory) > MaxPasswordHistory {
+				locked.Settings.PasswordHistory = locked.Settings.PasswordHistory[:MaxPasswordHistory]
+			}
+		}
+
+		now := time.Now()
+		locked.Settings.PasswordChangedAt = &now
+		return nil
+	}); err != nil {
+		_ = Rollback(tx)
+		return nil, err
+	}
+
+	updated, err := uc.GetClient().User.UpdateOneID(u.ID).SetPassword(digest).Save(ctx)
+	if err != nil {
+		_ = Rollback(tx)
+		return nil, err
+	}
+
+	if err := Commit(tx); err != nil {
+		return nil, err
+	}
+
+	u.Password = updated.Password
+	u.Settings = updated.Settings
+	return updated, nil
+}
+
+func (c *userClient) UpdateEmail(ctx context.Context, u *ent.User, email string) (*ent.User, error) {
+	return setUserSettings(c.client.User.UpdateOne(u).SetEmail(email), u.Settings).Save(ctx)
 }
 
 func (c *userClient) SetClient(newClient *ent.Client) TxOperator {
@@ -187,6 +298,15 @@ func (c *userClient) AddPasskey(ctx context.Context, uid int, name string, crede
 		Save(ctx)
 }
 
+func (c *userClient) RenamePasskey(ctx context.Context, uid int, keyId, name string) (*ent.Passkey, error) {
+	existing, err := c.client.Passkey.Query().Where(passkey.UserID(uid), passkey.CredentialID(keyId)).First(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.client.Passkey.UpdateOne(existing).SetName(name).Save(ctx)
+}
+
 func (c *userClient) RemovePasskey(ctx context.Context, uid int, keyId string) error {
 	ctx = schema.SkipSoftDelete(ctx)
 	_, err := c.client.Passkey.Delete().Where(passkey.UserID(uid), passkey.CredentialID(keyId)).Exec(ctx)
@@ -198,12 +318,189 @@ func (c *userClient) MarkPasskeyUsed(ctx context.Context, uid int, keyId string)
 	return err
 }
 
+func (c *userClient) ListEmails(ctx context.Context, uid int) ([]*ent.UserEmail, error) {
+	return c.client.UserEmail.Query().Where(useremail.UserID(uid)).All(ctx)
+}
+
+func (c *userClient) AddEmail(ctx context.Context, uid int, email, confirmDigest string, expiresAt time.Time) (*ent.UserEmail, error) {
+	email = strings.ToLower(email)
+	if _, err := c.client.UserEmail.Delete().
+		Where(useremail.Email(email), useremail.VerifiedAtIsNil()).
+		Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return nil, fmt.Errorf("failed to delete unverified emails: %w", err)
+	}
+
+	return c.client.UserEmail.Create().
+		SetUserID(uid).
+		SetEmail(email).
+		SetConfirmDigest(confirmDigest).
+		SetExpiresAt(expiresAt).
+		Save(ctx)
+}
+
+func (c *userClient) VerifyEmail(ctx context.Context, id int) error {
+	return c.client.UserEmail.UpdateOneID(id).
+		SetVerifiedAt(time.Now()).
+		ClearConfirmDigest().
+		ClearExpiresAt().
+		Exec(ctx)
+}
+
+func (c *userClient) RemoveEmail(ctx context.Context, uid int, email string) error {
+	deleted, err := c.client.UserEmail.Delete().
+		Where(useremail.UserID(uid), useremail.Email(strings.ToLower(email))).
+		Exec(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return &ent.NotFoundError{}
+	}
+
+	return nil
+}
+
+func (c *userClient) SwapPrimaryEmail(ctx context.Context, u *ent.User, alias *ent.UserEmail) (*ent.User, error) {
+	uc, tx, ctx, err := WithTx(ctx, UserClient(c))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.GetClient().UserEmail.UpdateOne(alias).
+		SetEmail(strings.ToLower(u.Email)).
+		SetVerifiedAt(time.Now()).
+		Exec(ctx); err != nil {
+		_ = Rollback(tx)
+		return nil, fmt.Errorf("failed to update secondary email: %w", err)
+	}
+
+	updated, err := uc.GetClient().User.UpdateOne(u).SetEmail(alias.Email).Save(ctx)
+	if err != nil {
+		_ = Rollback(tx)
+		return nil, fmt.Errorf("failed to update primary email: %w", err)
+	}
+
+	return updated, Commit(tx)
+}
+
+func (c *userClient) ListAccessTokens(ctx context.Context, uid int) ([]*ent.AccessToken, error) {
+	return c.client.AccessToken.Query().Where(accesstoken.UserID(uid)).All(ctx)
+}
+
+func (c *userClient) CountAccessTokens(ctx context.Context, uid int) (int, error) {
+	return c.client.AccessToken.Query().Where(accesstoken.UserID(uid)).Count(ctx)
+}
+
+func (c *userClient) AddAccessToken(ctx context.Context, token *ent.AccessToken) (*ent.AccessToken, error) {
+	return c.client.AccessToken.Create().
+		SetUserID(token.UserID).
+		SetTokenID(token.TokenID).
+		SetName(token.Name).
+		SetDigest(token.Digest).
+		SetScopes(token.Scopes).
+		SetNillableExpiresAt(token.ExpiresAt).
+		Save(ctx)
+}
+
+func (c *userClient) GetAccessToken(ctx context.Context, uid int, tokenID string) (*ent.AccessToken, error) {
+	return c.client.AccessToken.Query().Where(accesstoken.UserID(uid), accesstoken.TokenID(tokenID)).First(ctx)
+}
+
+func (c *userClient) RemoveAccessToken(ctx context.Context, uid int, tokenID string) error {
+	ctx = schema.SkipSoftDelete(ctx)
+	_, err := c.client.AccessToken.Delete().Where(accesstoken.UserID(uid), accesstoken.TokenID(tokenID)).Exec(ctx)
+	return err
+}
+
+func (c *userClient) MarkAccessTokenUsed(ctx context.Context, id int) error {
+	return c.client.AccessToken.UpdateOneID(id).SetLastUsedAt(time.Now()).Exec(ctx)
+}
+
+func (c *userClient) ListInvitations(ctx context.Context, uid int) ([]*ent.Invitation, error) {
+	return c.client.Invitation.Query().
+		Where(invitation.UserID(uid)).
+		WithInvitees(func(q *ent.UserQuery) {
+			q.Select(user.FieldID)
+		}).
+		All(ctx)
+}
+
+func (c *userClient) CountInvitations(ctx context.Context, uid int) (int, error) {
+	return c.client.Invitation.Query().Where(invitation.UserID(uid)).Count(ctx)
+}
+
+func (c *userClient) AddInvitation(ctx context.Context, i *ent.Invitation) (*ent.Invitation, error) {
+	return c.client.Invitation.Create().
+		SetUserID(i.UserID).
+		SetCode(i.Code).
+		SetNote(i.Note).
+		SetGroupID(i.GroupID).
+		SetNillableMaxStorage(i.MaxStorage).
+		SetMaxUses(i.MaxUses).
+		SetNillableExpiresAt(i.ExpiresAt).
+		Save(ctx)
+}
+
+func (c *userClient) GetInvitationByCode(ctx context.Context, code string) (*ent.Invitation, error) {
+	return c.client.Invitation.Query().
+		Where(invitation.Code(code)).
+		WithUser(func(q *ent.UserQuery) {
+			q.WithGroup()
+		}).
+		First(ctx)
+}
+
+func (c *userClient) RemoveInvitation(ctx context.Context, uid, id int) error {
+	ctx = schema.SkipSoftDelete(ctx)
+	_, err := c.client.Invitation.Delete().Where(invitation.UserID(uid), invitation.ID(id)).Exec(ctx)
+	return err
+}
+
+func (c *userClient) ConsumeInvitation(ctx context.Context, id int) (bool, error) {
+	affected, err := c.client.Invitation.Update().
+		Where(
+			invitation.ID(id),
+			invitation.Or(invitation.ExpiresAtIsNil(), invitation.ExpiresAtGT(time.Now())),
+			invitation.Or(invitation.MaxUses(0), func(s *sql.Selector) {
+				s.Where(sql.ColumnsLT(s.C(invitation.FieldUses), s.C(invitation.FieldMaxUses)))
+			}),
+		).
+		AddUses(1).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
 func (c *userClient) Delete(ctx context.Context, uid int) error {
 	// Dav accounts
 	if _, err := c.client.DavAccount.Delete().Where(davaccount.OwnerID(uid)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
 		return fmt.Errorf("failed to delete dav accounts: %w", err)
 	}
 
+	// Invitations
+	if _, err := c.client.Invitation.Delete().Where(invitation.UserID(uid)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("failed to delete invitations: %w", err)
+	}
+
+	// Access tokens
+	if _, err := c.client.AccessToken.Delete().Where(accesstoken.UserID(uid)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("failed to delete access tokens: %w", err)
+	}
+
+	// Secondary emails
+	if _, err := c.client.UserEmail.Delete().Where(useremail.UserID(uid)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("failed to delete secondary emails: %w", err)
+	}
+
+	// Check-outs
+	if _, err := c.client.Checkout.Delete().Where(checkout.OwnerID(uid)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("failed to delete check-outs: %w", err)
+	}
+
 	// Passkeys
 	if _, err := c.client.Passkey.Delete().Where(passkey.UserID(uid)).Exec(schema.SkipSoftDelete(ctx)); err != nil {
 		return fmt.Errorf("failed to delete passkeys: %w", err)
@@ -228,7 +525,62 @@ func (c *userClient) ApplyStorageDiff(ctx context.Context, diffs StorageDiff) er
 	return ae.Aggregate()
 }
 
+func (c *userClient) SumStorageByGroup(ctx context.Context) (map[int]int64, error) {
+	var v []struct {
+		GroupID int   `json:"group_users"`
+		Sum     int64 `json:"sum"`
+	}
+
+	err := c.client.User.Query().
+		GroupBy(user.FieldGroupUsers).
+		Aggregate(ent.Sum(user.FieldStorage)).
+		Scan(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[int]int64, len(v))
+	for _, item := range v {
+		res[item.GroupID] = item.Sum
+	}
+
+	return res, nil
+}
+
+func (c *userClient) ListTopByStorage(ctx context.Context, limit int) ([]*ent.User, error) {
+	return c.client.User.Query().
+		Where(user.StorageGT(0)).
+		Order(user.ByStorage(sql.OrderDesc())).
+		Limit(limit).
+		All(ctx)
+}
+
 func (c *userClient) CalculateStorage(ctx context.Context, uid int) (int64, error) {
+	sum, err := c.ComputeStorage(ctx, uid)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := c.client.User.UpdateOneID(uid).SetStorage(sum).Save(ctx); err != nil {
+		return 0, err
+	}
+
+	return sum, nil
+}
+
+func (c *userClient) ReconcileStorage(ctx context.Context, uid int, expected, actual int64) (bool, error) {
+	affected, err := c.client.User.Update().
+		Where(user.ID(uid), user.Storage(expected)).
+		SetStorage(actual).
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to update user storage: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+func (c *userClient) ComputeStorage(ctx context.Context, uid int) (int64, error) {
 	var sum int64
 	batchSize := 30000
 	offset := 0
@@ -258,11 +610,22 @@ func (c *userClient) CalculateStorage(ctx context.Context, uid int) (int64, erro
 		offset += batchSize
 	}
 
-	if _, err := c.client.User.UpdateOneID(uid).SetStorage(sum).Save(ctx); err != nil {
-		return 0, err
+	return sum, nil
+}
+
+func (c *userClient) SetGroup(ctx context.Context, u *ent.User, groupID int) (*ent.User, error) {
+	return setUserSettings(c.client.User.UpdateOne(u).SetGroupID(groupID), u.Settings).Save(ctx)
+}
+
+func (c *userClient) SetOverrides(ctx context.Context, uid, storagePolicy int, maxStorage *int64) error {
+	q := c.client.User.UpdateOneID(uid).SetStoragePolicyOverride(storagePolicy)
+	if maxStorage != nil {
+		q.SetMaxStorageOverride(*maxStorage)
+	} else {
+		q.ClearMaxStorageOverride()
 	}
 
-	return sum, nil
+	return q.Exec(ctx)
 }
 
 func (c *userClient) SetStatus(ctx context.Context, u *ent.User, status user.Status) (*ent.User, error) {
@@ -297,11 +660,21 @@ func (c *userClient) Create(ctx context.Context, args *NewUserArgs) (*ent.User,
 			return nil, fmt.Errorf("failed to sha256 password: %w", err)
 		}
 		query.SetPassword(pwdDigest)
+		now := time.Now()
+		userSetting.PasswordChangedAt = &now
 	}
 
 	if args.Language != "" {
 		userSetting.Language = args.Language
 	}
+	if args.Invitation != nil {
+		query.SetInvitationID(args.Invitation.ID)
+		userSetting.InvitedBy = args.Invitation.UserID
+		if args.Invitation.GroupID > 0 {
+			query.SetGroupID(args.Invitation.GroupID)
+		}
+		query.SetNillableMaxStorageOverride(args.Invitation.MaxStorage)
+	}
 	query.SetSettings(userSetting)
 
 	// Create user
@@ -321,13 +694,25 @@ func (c *userClient) Create(ctx context.Context, args *NewUserArgs) (*ent.User,
 }
 
 func (c *userClient) GetByEmail(ctx context.Context, email string) (*ent.User, error) {
-	return withUserEagerLoading(ctx, c.client.User.Query().Where(user.EmailEqualFold(email))).First(ctx)
+	u, err := withUserEagerLoading(ctx, c.client.User.Query().Where(user.EmailEqualFold(email))).First(ctx)
+	if err == nil || !ent.IsNotFound(err) {
+		return u, err
+	}
+
+	// Fallback to verified secondary emails.
+	return withUserEagerLoading(ctx, c.client.User.Query().Where(
+		user.HasEmailsWith(useremail.Email(strings.ToLower(email)), useremail.VerifiedAtNotNil()),
+	)).First(ctx)
 }
 
 func (c *userClient) GetByID(ctx context.Context, id int) (*ent.User, error) {
 	return withUserEagerLoading(ctx, c.client.User.Query().Where(user.ID(id))).First(ctx)
 }
 
+func (c *userClient) GetByIDs(ctx context.Context, ids []int) ([]*ent.User, error) {
+	return withUserEagerLoading(ctx, c.client.User.Query().Where(user.IDIn(ids...))).All(ctx)
+}
+
 func (c *userClient) GetActiveByID(ctx context.Context, id int) (*ent.User, error) {
 	return withUserEagerLoading(
 		ctx,
@@ -345,7 +730,11 @@ func (c *userClient) GetActiveByDavAccount(ctx context.Context, email, pwd strin
 			Where(user.EmailEqualFold(email)).
 			Where(user.StatusEQ(user.StatusActive)).
 			WithDavAccounts(func(q *ent.DavAccountQuery) {
-				q.Where(davaccount.Password(pwd))
+				q.Where(
+					davaccount.Password(pwd),
+					davaccount.DisabledAtIsNil(),
+					davaccount.Or(davaccount.ExpireAtIsNil(), davaccount.ExpireAtGT(time.Now())),
+				)
 			}),
 	).First(ctx)
 }
@@ -379,8 +768,65 @@ func (c *userClient) SearchActive(ctx context.Context, limit int, keyword string
 	).All(ctx)
 }
 
+func (c *userClient) ListGroupExpiring(ctx context.Context, before time.Time) ([]*ent.User, error) {
+	return withUserEagerLoading(ctx, c.client.User.Query().Where(user.GroupExpiresAtLT(before))).All(ctx)
+}
+
+func (c *userClient) ListEmailChangeDue(ctx context.Context, before time.Time) ([]int, error) {
+	return c.client.User.Query().Where(user.EmailChangeAtLTE(before)).IDs(ctx)
+}
+
 func (c *userClient) SaveSettings(ctx context.Context, u *ent.User) error {
-	return c.client.User.UpdateOne(u).SetSettings(u.Settings).Exec(ctx)
+	return setUserSettings(c.client.User.UpdateOne(u), u.Settings).Exec(ctx)
+}
+
+// setUserSettings sets settings of the update, along with the columns copied from settings for lookup.
+func setUserSettings(q *ent.UserUpdateOne, settings *types.UserSetting) *ent.UserUpdateOne {
+	q.SetSettings(settings)
+	if settings != nil && settings.GroupExpiration != nil {
+		q.SetGroupExpiresAt(settings.GroupExpiration.ExpiresAt)
+	} else {
+		q.ClearGroupExpiresAt()
+	}
+
+	if settings != nil && settings.PendingEmail != nil && settings.PendingEmail.EffectiveAt != nil {
+		q.SetEmailChangeAt(*settings.PendingEmail.EffectiveAt)
+	} else {
+		q.ClearEmailChangeAt()
+	}
+
+	return q
+}
+
+func (c *userClient) UpdateSettings(ctx context.Context, uid int, fn func(u *ent.User) error) (*ent.User, error) {
+	uc, tx, ctx, err := WithTx(ctx, UserClient(c))
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := withUserEagerLoading(ctx, uc.GetClient().User.Query()).
+		Where(user.ID(uid), lockForUpdate).
+		Only(ctx)
+	if err != nil {
+		_ = Rollback(tx)
+		return nil, err
+	}
+
+	if u.Settings == nil {
+		u.Settings = &types.UserSetting{}
+	}
+
+	if err := fn(u); err != nil {
+		_ = Rollback(tx)
+		return nil, err
+	}
+
+	if err := uc.SaveSettings(ctx, u); err != nil {
+		_ = Rollback(tx)
+		return nil, err
+	}
+
+	return u, Commit(tx)
 }
 
 // UserFromContext get user from context
@@ -521,8 +967,46 @@ func IsAnonymousUser(u *ent.User) bool {
 
 // CheckPassword 根据明文校验密码
 func CheckPassword(u *ent.User, password string) error {
+	return checkPasswordDigest(u.Password, password)
+}
+
+// PasswordReused returns true if the password matches current password of the user or any of
+// the last n previous passwords.
+func PasswordReused(u *ent.User, password string, n int) bool {
+	if u.Password != "" && checkPasswordDigest(u.Password, password) == nil {
+		return true
+	}
+
+	if u.Settings == nil {
+		return false
+	}
+
+	for i, digest := range u.Settings.PasswordHistory {
+		if i >= n {
+			break
+		}
+
+		if checkPasswordDigest(digest, password) == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PasswordChangedAt returns the last time password of the user is changed, falls back to the
+// creation time if the password was never changed.
+func PasswordChangedAt(u *ent.User) time.Time {
+	if u.Settings != nil && u.Settings.PasswordChangedAt != nil {
+		return *u.Settings.PasswordChangedAt
+	}
+
+	return u.CreatedAt
+}
+
+func checkPasswordDigest(stored, password string) error {
 	// 根据存储密码拆分为 Salt 和 Digest
-	passwordStore := strings.Split(u.Password, ":")
+	passwordStore := strings.Split(stored, ":")
 	if len(passwordStore) != 2 && len(passwordStore) != 3 {
 		return ErrorUnknownPasswordType
 	}
@@ -593,3 +1077,22 @@ func digestPassword(password string) (string, error) {
 	//存储 Salt 值和摘要， ":"分割
 	return salt + ":" + string(bs), nil
 }
+
+// EffectiveMaxStorage returns the max storage of the user, per-user override takes precedence over
+// the group setting. User's group must be loaded.
+func EffectiveMaxStorage(u *ent.User) int64 {
+	if u.MaxStorageOverride != nil {
+		return *u.MaxStorageOverride
+	}
+
+	if u.Edges.Group == nil {
+		return 0
+	}
+
+	return u.Edges.Group.MaxStorage
+}
+
+// EffectiveStoragePolicyOverride returns the overridden storage policy ID of the user, 0 if not overridden.
+func EffectiveStoragePolicyOverride(u *ent.User) int {
+	return u.StoragePolicyOverride
+}
@@ -0,0 +1,214 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/schema"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhook"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhookdelivery"
+)
+
+type (
+	WebhookClient interface {
+		TxOperator
+		// ListAll returns all webhooks.
+		ListAll(ctx context.Context) ([]*ent.Webhook, error)
+		// ListEnabled returns enabled webhooks.
+		ListEnabled(ctx context.Context) ([]*ent.Webhook, error)
+		// GetByID returns webhook by its ID.
+		GetByID(ctx context.Context, id int) (*ent.Webhook, error)
+		// Upsert creates a new webhook if ID is 0, otherwise updates the existing one.
+		Upsert(ctx context.Context, id int, args *WebhookArgs) (*ent.Webhook, error)
+		// Delete deletes webhook and its delivery logs.
+		Delete(ctx context.Context, id int) error
+		// CreateDelivery creates a pending delivery of given webhook, it is retried at nextAttemptAt unless
+		// updated before.
+		CreateDelivery(ctx context.Context, webhookID int, event, payload string, nextAttemptAt time.Time) (*ent.WebhookDelivery, error)
+		// UpdateDelivery saves result of a delivery attempt.
+		UpdateDelivery(ctx context.Context, id int, args *WebhookDeliveryResult) error
+		// GetDelivery returns delivery by its ID, with webhook loaded.
+		GetDelivery(ctx context.Context, id int) (*ent.WebhookDelivery, error)
+		// ListDeliveries returns deliveries of given webhook, newest first.
+		ListDeliveries(ctx context.Context, args *ListWebhookDeliveryParameters) (*ListWebhookDeliveryResult, error)
+		// ListDueDeliveries returns pending deliveries of enabled webhooks whose next attempt is before given time,
+		// with webhook loaded.
+		ListDueDeliveries(ctx context.Context, before time.Time, limit int) ([]*ent.WebhookDelivery, error)
+		// DeleteDeliveriesBefore deletes finished deliveries created before given time.
+		DeleteDeliveriesBefore(ctx context.Context, before time.Time) (int, error)
+	}
+
+	WebhookArgs struct {
+		Name    string
+		Url     string
+		Secret  string
+		Events  []string
+		Enabled bool
+	}
+
+	WebhookDeliveryResult struct {
+		Status       webhookdelivery.Status
+		Attempts     int
+		ResponseCode int
+		Error        string
+		// NextAttemptAt is the time of next retry, nil if no more retries.
+		NextAttemptAt *time.Time
+	}
+
+	ListWebhookDeliveryParameters struct {
+		*PaginationArgs
+		WebhookID int
+		Status    webhookdelivery.Status
+	}
+
+	ListWebhookDeliveryResult struct {
+		*PaginationResults
+		Deliveries []*ent.WebhookDelivery
+	}
+)
+
+func NewWebhookClient(client *ent.Client) WebhookClient {
+	return &webhookClient{client: client}
+}
+
+type webhookClient struct {
+	client *ent.Client
+}
+
+func (c *webhookClient) SetClient(newClient *ent.Client) TxOperator {
+	return &webhookClient{client: newClient}
+}
+
+func (c *webhookClient) GetClient() *ent.Client {
+	return c.client
+}
+
+func (c *webhookClient) ListAll(ctx context.Context) ([]*ent.Webhook, error) {
+	return c.client.Webhook.Query().Order(webhook.ByID(sql.OrderDesc())).All(ctx)
+}
+
+func (c *webhookClient) ListEnabled(ctx context.Context) ([]*ent.Webhook, error) {
+	return c.client.Webhook.Query().Where(webhook.Enabled(true)).All(ctx)
+}
+
+func (c *webhookClient) GetByID(ctx context.Context, id int) (*ent.Webhook, error) {
+	return c.client.Webhook.Get(ctx, id)
+}
+
+func (c *webhookClient) Upsert(ctx context.Context, id int, args *WebhookArgs) (*ent.Webhook, error) {
+	if id == 0 {
+		return c.client.Webhook.Create().
+			SetName(args.Name).
+			SetURL(args.Url).
+			SetSecret(args.Secret).
+			SetEvents(args.Events).
+			SetEnabled(args.Enabled).
+			Save(ctx)
+	}
+
+	return c.client.Webhook.UpdateOneID(id).
+		SetName(args.Name).
+		SetURL(args.Url).
+		SetSecret(args.Secret).
+		SetEvents(args.Events).
+		SetEnabled(args.Enabled).
+		Save(ctx)
+}
+
+func (c *webhookClient) Delete(ctx context.Context, id int) error {
+	if _, err := c.client.WebhookDelivery.Delete().
+		Where(webhookdelivery.WebhookID(id)).
+		Exec(schema.SkipSoftDelete(ctx)); err != nil {
+		return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+	}
+
+	return c.client.Webhook.DeleteOneID(id).Exec(schema.SkipSoftDelete(ctx))
+}
+
+func (c *webhookClient) CreateDelivery(ctx context.Context, webhookID int, event, payload string, nextAttemptAt time.Time) (*ent.WebhookDelivery, error) {
+	return c.client.WebhookDelivery.Create().
+		SetWebhookID(webhookID).
+		SetEvent(event).
+		SetPayload(payload).
+		SetStatus(webhookdelivery.StatusPending).
+		SetNextAttemptAt(nextAttemptAt).
+		Save(ctx)
+}
+
+func (c *webhookClient) UpdateDelivery(ctx context.Context, id int, args *WebhookDeliveryResult) error {
+	stm := c.client.WebhookDelivery.UpdateOneID(id).
+		SetStatus(args.Status).
+		SetAttempts(args.Attempts).
+		SetResponseCode(args.ResponseCode).
+		SetError(args.Error)
+	if args.NextAttemptAt != nil {
+		stm.SetNextAttemptAt(*args.NextAttemptAt)
+	} else {
+		stm.ClearNextAttemptAt()
+	}
+
+	return stm.Exec(ctx)
+}
+
+func (c *webhookClient) GetDelivery(ctx context.Context, id int) (*ent.WebhookDelivery, error) {
+	return c.client.WebhookDelivery.Query().
+		Where(webhookdelivery.ID(id)).
+		WithWebhook().
+		First(ctx)
+}
+
+func (c *webhookClient) ListDeliveries(ctx context.Context, args *ListWebhookDeliveryParameters) (*ListWebhookDeliveryResult, error) {
+	query := c.client.WebhookDelivery.Query().
+		Where(webhookdelivery.WebhookID(args.WebhookID))
+	if args.Status != "" {
+		query = query.Where(webhookdelivery.StatusEQ(args.Status))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := query.
+		Order(webhookdelivery.ByID(sql.OrderDesc())).
+		Limit(args.PageSize).
+		Offset(args.Page * args.PageSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListWebhookDeliveryResult{
+		PaginationResults: &PaginationResults{
+			TotalItems: total,
+			Page:       args.Page,
+			PageSize:   args.PageSize,
+		},
+		Deliveries: deliveries,
+	}, nil
+}
+
+func (c *webhookClient) ListDueDeliveries(ctx context.Context, before time.Time, limit int) ([]*ent.WebhookDelivery, error) {
+	return c.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(before),
+			webhookdelivery.HasWebhookWith(webhook.Enabled(true)),
+		).
+		WithWebhook().
+		Order(webhookdelivery.ByNextAttemptAt()).
+		Limit(limit).
+		All(ctx)
+}
+
+func (c *webhookClient) DeleteDeliveriesBefore(ctx context.Context, before time.Time) (int, error) {
+	return c.client.WebhookDelivery.Delete().
+		Where(
+			webhookdelivery.StatusNEQ(webhookdelivery.StatusPending),
+			webhookdelivery.CreatedAtLT(before),
+		).
+		Exec(schema.SkipSoftDelete(ctx))
+}
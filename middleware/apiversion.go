@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/pkg/apiversion"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// APIVersionHeader is the response header of the API version serving the request.
+const APIVersionHeader = "X-Cr-Api-Version"
+
+// APIVersion marks the request with given API version, sends deprecation headers of the version, and
+// converts responses into the shape of the version by registered shims.
+func APIVersion(dep dependency.Dep, version int) gin.HandlerFunc {
+	prefix := apiversion.Prefix(version)
+	return func(c *gin.Context) {
+		util.WithValue(c, apiversion.VersionCtx{}, version)
+		c.Header(APIVersionHeader, strconv.Itoa(version))
+
+		deprecation := dep.SettingProvider().APIDeprecation(c, version)
+		if deprecation.DeprecatedAt > 0 {
+			c.Header("Deprecation", fmt.Sprintf("@%d", deprecation.DeprecatedAt))
+			c.Header("Link", fmt.Sprintf(`<%s>; rel="successor-version"`, apiversion.Prefix(apiversion.Latest)))
+		}
+		if deprecation.SunsetAt > 0 {
+			c.Header("Sunset", time.Unix(deprecation.SunsetAt, 0).UTC().Format(http.TimeFormat))
+		}
+
+		shims := apiversion.ShimsFor(c.Request.Method, strings.TrimPrefix(c.FullPath(), prefix), version)
+		if len(shims) == 0 {
+			c.Next()
+			return
+		}
+
+		w := &shimWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+		c.Next()
+		c.Writer = w.ResponseWriter
+		w.flush(shims)
+	}
+}
+
+// shimWriter buffers the response so that it can be converted by shims.
+type shimWriter struct {
+	gin.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *shimWriter) WriteHeader(code int) {
+	w.status = code
+}
+
+func (w *shimWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+func (w *shimWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *shimWriter) flush(shims []apiversion.Shim) {
+	body := w.body.Bytes()
+	if strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") && len(body) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+
+		var data any
+		if err := decoder.Decode(&data); err == nil {
+			if converted, err := json.Marshal(apiversion.Downgrade(data, shims)); err == nil {
+				body = converted
+			}
+		}
+	}
+
+	if w.status != 0 {
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+	_, _ = w.ResponseWriter.Write(body)
+}
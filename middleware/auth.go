@@ -1,8 +1,14 @@
 package middleware
 
 import (
+	"errors"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
@@ -10,19 +16,45 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/oss"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/ipaccess"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/s3gateway"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
 	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 )
 
 const (
 	CallbackFailedStatusCode = http.StatusUnauthorized
+	// ImpersonatedByHeader flags responses served within an admin impersonation session.
+	ImpersonatedByHeader = constants.CrHeaderPrefix + "Impersonated-By"
+	// davLastUsedInterval is the min interval between updates of last used time of WebDAV accounts and S3 access keys.
+	davLastUsedInterval = 10 * time.Minute
 )
 
+// WebSocketAuth moves the token offered as a WebSocket subprotocol to Authorization header, so that
+// CurrentUser can authenticate WebSocket handshakes from browsers.
+func WebSocketAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetHeader(auth.AuthorizationHeader) == "" && websocket.IsWebSocketUpgrade(c.Request) {
+			for _, protocol := range websocket.Subprotocols(c.Request) {
+				if token, ok := strings.CutPrefix(protocol, auth.WebSocketTokenProtocolPrefix); ok {
+					c.Request.Header.Set(auth.AuthorizationHeader, auth.TokenHeaderPrefix+token)
+					break
+				}
+			}
+		}
+
+		c.Next()
+	}
+}
+
 // SignRequired 验证请求签名
 func SignRequired(authInstance auth.Auth) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -66,10 +98,68 @@ func CurrentUser() gin.HandlerFunc {
 			return
 		}
 
+		if _, ok := auth.AccessTokenScopesFromContext(c); ok && !accessTokenAllowed(c) {
+			c.JSON(200, serializer.ErrWithDetails(c, serializer.CodeNoPermissionErr, "Access token scope does not allow this operation", nil))
+			c.Abort()
+			return
+		}
+
+		if impersonator, ok := auth.ImpersonatorFromContext(c); ok {
+			if err := checkImpersonator(c, impersonator); err != nil {
+				c.JSON(200, serializer.Err(c, err))
+				c.Abort()
+				return
+			}
+
+			c.Header(ImpersonatedByHeader, hashid.EncodeUserID(dep.HashIDEncoder(), impersonator))
+			c.Next()
+			recordImpersonatedRequest(c, uid, impersonator)
+			return
+		}
+
+		dep.StatsRecorder().Active(uid)
 		c.Next()
 	}
 }
 
+// checkImpersonator makes sure the admin who started the impersonation session still has admin permission.
+func checkImpersonator(c *gin.Context, impersonator int) error {
+	admin, err := dependency.FromContext(c).UserClient().GetLoginUserByID(c, impersonator)
+	if err != nil || inventory.IsAnonymousUser(admin) ||
+		!admin.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+		return serializer.NewError(serializer.CodeCredentialInvalid, "Impersonation session is no longer valid", err)
+	}
+
+	return nil
+}
+
+// NoImpersonation rejects requests made within an admin impersonation session. It guards endpoints
+// managing credentials or account lifecycle, which must only be used by the user in person.
+func NoImpersonation() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := auth.ImpersonatorFromContext(c); ok {
+			c.JSON(200, serializer.ErrWithDetails(c, serializer.CodeNoPermissionErr, "This operation is not allowed in impersonation session", nil))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// recordImpersonatedRequest records requests made within an impersonation session into audit log.
+func recordImpersonatedRequest(c *gin.Context, uid, impersonator int) {
+	dep := dependency.FromContext(c)
+	event := audit.NewEvent(c, audit.EventImpersonatedRequest, uid, impersonator, map[string]string{
+		"method": c.Request.Method,
+		"path":   c.Request.URL.Path,
+		"status": strconv.Itoa(c.Writer.Status()),
+	}).WithEntity(audit.EntityUser, uid)
+	if err := dep.AuditRecorder().Record(c, event); err != nil {
+		dep.Logger().Warning("Failed to record impersonated request: %s", err)
+	}
+}
+
 // SetUserCtx set the current login user via uid
 func SetUserCtx(c *gin.Context, uid int) error {
 	dep := dependency.FromContext(c)
@@ -159,10 +249,16 @@ func WebDAVAuth() gin.HandlerFunc {
 			return
 		}
 
+		if account := accounts[0]; account.LastUsedAt == nil || time.Since(*account.LastUsedAt) > davLastUsedInterval {
+			if err := dep.DavAccountClient().MarkUsed(c, account.ID); err != nil {
+				l.Warning("WebDAVAuth: failed to update last used time of dav account %d: %s", account.ID, err)
+			}
+		}
+
 		// 检查是否只读
 		if expectedUser.Edges.DavAccounts[0].Options.Enabled(int(types.DavAccountReadOnly)) {
 			switch c.Request.Method {
-			case http.MethodDelete, http.MethodPut, "MKCOL", "COPY", "MOVE", "LOCK", "UNLOCK":
+			case http.MethodDelete, http.MethodPut, "MKCOL", "COPY", "MOVE", "LOCK", "UNLOCK", "RESTORE":
 				c.Status(http.StatusForbidden)
 				c.Abort()
 				return
@@ -174,6 +270,79 @@ func WebDAVAuth() gin.HandlerFunc {
 	}
 }
 
+// S3Auth authenticates S3 gateway requests signed with user's S3 access key. Signature failures
+// are counted per client IP in the same way as failed logins, so that secrets cannot be brute forced.
+func S3Auth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		dep := dependency.FromContext(c)
+		l := dep.Logger()
+		if !dep.SettingProvider().S3GatewayEnabled(c) {
+			s3gateway.WriteError(c, s3gateway.ErrGatewayDisabled)
+			c.Abort()
+			return
+		}
+
+		kv := dep.KV()
+		protection := dep.SettingProvider().LoginProtection(c)
+		ip := ipaccess.RequestClientIP(c, dep).String()
+		if lockedUntil := auth.LoginLocks(kv, protection, "", ip); !lockedUntil.IsZero() {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(time.Until(lockedUntil).Seconds()))))
+			s3gateway.WriteError(c, s3gateway.ErrTooManyAuthFailures)
+			c.Abort()
+			return
+		}
+
+		var key *ent.S3AccessKey
+		_, err := s3gateway.Verify(c.Request, func(accessKeyID string) (string, error) {
+			found, err := dep.S3AccessKeyClient().GetByAccessKeyID(c, accessKeyID)
+			if err != nil {
+				return "", s3gateway.ErrInvalidAccessKeyID
+			}
+
+			key = found
+			return found.Secret, nil
+		})
+		if err != nil {
+			l.Debug("S3Auth: failed to verify request signature: %s", err)
+			if errors.Is(err, s3gateway.ErrSignatureDoesNotMatch) || errors.Is(err, s3gateway.ErrInvalidAccessKeyID) {
+				if _, err := auth.RecordLoginFailure(kv, protection, "", ip); err != nil {
+					l.Warning("S3Auth: failed to record signature failure: %s", err)
+				}
+			}
+
+			s3gateway.WriteError(c, err)
+			c.Abort()
+			return
+		}
+
+		user, err := dep.UserClient().GetLoginUserByID(c, key.UserID)
+		if err != nil {
+			l.Debug("S3Auth: failed to get user %d of access key %q: %s", key.UserID, key.AccessKeyID, err)
+			s3gateway.WriteError(c, s3gateway.ErrInvalidAccessKeyID)
+			c.Abort()
+			return
+		}
+
+		if key.LastUsedAt == nil || time.Since(*key.LastUsedAt) > davLastUsedInterval {
+			if err := dep.S3AccessKeyClient().MarkUsed(c, key.ID); err != nil {
+				l.Warning("S3Auth: failed to update last used time of access key %d: %s", key.ID, err)
+			}
+		}
+
+		if key.ReadOnly {
+			switch c.Request.Method {
+			case http.MethodPut, http.MethodPost, http.MethodDelete:
+				s3gateway.WriteError(c, s3gateway.ErrAccessDenied)
+				c.Abort()
+				return
+			}
+		}
+
+		SetUserCtxByUser(c, user)
+		c.Next()
+	}
+}
+
 // 对上传会话进行验证
 func UseUploadSession(policyType types.PolicyType) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -1,14 +1,23 @@
 package middleware
 
 import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"sync"
 
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent/node"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webdav"
 	"github.com/cloudreve/Cloudreve/v4/routers/controllers"
 	"github.com/gin-gonic/gin"
 )
@@ -56,20 +65,83 @@ func SlaveRPCSignRequired() gin.HandlerFunc {
 			return
 		}
 
-		np, err := dependency.FromContext(c).NodePool(c)
+		dep := dependency.FromContext(c)
+		np, err := dep.NodePool(c)
 		if err != nil {
 			c.JSON(200, serializer.NewError(serializer.CodeInternalSetting, "Failed to get node pool", err))
 			c.Abort()
 			return
 		}
 
-		slaveNode, err := np.Get(c, types.NodeCapabilityNone, nodeId)
+		slaveNode, err := np.GetByID(c, nodeId)
 		if slaveNode == nil || slaveNode.IsMaster() {
 			c.JSON(200, serializer.ParamErr(c, "Unknown node ID", err))
 			c.Abort()
 			return
 		}
 
+		// Node pool of this instance is not updated when the node is disabled on other instances.
+		if n, err := dep.NodeClient().GetNodeById(c, nodeId); err != nil || n.Status != node.StatusActive {
+			c.JSON(200, serializer.NewError(serializer.CodeNodeOffline, "Node is disabled", err))
+			c.Abort()
+			return
+		}
+
+		// Offline nodes can only report heartbeats to get back online.
+		if c.FullPath() != constants.APIPrefixSlave+"/heartbeat" {
+			if status := cluster.GetNodeStatus(dep.KV(), nodeId); status != nil && !status.Online {
+				c.JSON(200, serializer.NewError(serializer.CodeNodeOffline, "Node is offline", nil))
+				c.Abort()
+				return
+			}
+		}
+
 		SignRequired(slaveNode.AuthInstance())(c)
 	}
 }
+
+// DavAssertion turns an assertion request from slave node into the WebDAV request it stands for, so that it is
+// authenticated and handled like requests received by master. The rewritten request carries no body.
+func DavAssertion(ctxKey any) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := controllers.ParametersFromContext[*cluster.DavAssertionRequest](c, ctxKey)
+		r := c.Request.Clone(context.WithValue(c.Request.Context(), webdav.AssertionNodeCtx{}, cluster.NodeIdFromContext(c)))
+		r.Method = req.Method
+		r.URL = &url.URL{Path: "/dav" + req.Path}
+		r.RequestURI = r.URL.RequestURI()
+		r.Header = req.Header
+		if r.Header == nil {
+			r.Header = http.Header{}
+		}
+		r.Body = http.NoBody
+		r.ContentLength, _ = strconv.ParseInt(r.Header.Get("Content-Length"), 10, 64)
+
+		c.Request = r
+		c.Next()
+	}
+}
+
+// MasterCertRequired rejects requests without a verified client certificate when mutual TLS is
+// enabled on slave. Routes accessed by clients directly with signed URLs are exempted.
+func MasterCertRequired(config conf.ConfigProvider) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if config.Slave().ClientCAPath == "" || isClientFacingSlaveRoute(c) {
+			c.Next()
+			return
+		}
+
+		if c.Request.TLS == nil || len(c.Request.TLS.VerifiedChains) == 0 {
+			c.JSON(200, serializer.ErrWithDetails(c, serializer.CodeCredentialInvalid, "Client certificate required", nil))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func isClientFacingSlaveRoute(c *gin.Context) bool {
+	route := c.FullPath()
+	return strings.HasPrefix(route, constants.APIPrefixSlave+"/file/content/") ||
+		(c.Request.Method == http.MethodPost && route == constants.APIPrefixSlave+"/upload/:sessionId")
+}
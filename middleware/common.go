@@ -102,7 +102,8 @@ func InitializeHandling(dep dependency.Dep) gin.HandlerFunc {
 			cid = uuid.Must(uuid.NewV4())
 		}
 
-		l := dep.Logger().CopyWithPrefix(fmt.Sprintf("[Cid: %s]", cid))
+		c.Header(request.CorrelationHeader, cid.String())
+		l := dep.Logger().CopyWithField(logging.FieldCorrelationID, cid.String())
 		ctx := dep.ForkWithLogger(c.Request.Context(), l)
 		ctx = context.WithValue(ctx, logging.CorrelationIDCtx{}, cid)
 		ctx = context.WithValue(ctx, requestinfo.RequestInfoCtx{}, reqInfo)
@@ -144,7 +145,7 @@ func Logging() gin.HandlerFunc {
 			path = path + "?" + raw
 		}
 
-		l := logging.FromContext(c)
+		l := logging.FromContext(c).CopyWithComponent("http")
 		logging.Request(l, true, c.Writer.Status(), c.Request.Method, c.ClientIP(), path,
 			c.Errors.ByType(gin.ErrorTypePrivate).String(), start)
 	}
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"reflect"
+	"sync/atomic"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+)
+
+type corsHandler struct {
+	config  conf.Cors
+	handler gin.HandlerFunc
+}
+
+// CORS applies CORS config of the config file. The underlying handler is rebuilt
+// once the config is changed by a hot reload.
+func CORS(l logging.Logger, config conf.ConfigProvider) gin.HandlerFunc {
+	var current atomic.Pointer[corsHandler]
+	return func(c *gin.Context) {
+		latest := *config.Cors()
+		h := current.Load()
+		if h == nil || !reflect.DeepEqual(h.config, latest) {
+			h = newCorsHandler(l, latest, h)
+			current.Store(h)
+		}
+
+		if h.handler != nil {
+			h.handler(c)
+		}
+	}
+}
+
+func newCorsHandler(l logging.Logger, c conf.Cors, previous *corsHandler) *corsHandler {
+	if len(c.AllowOrigins) == 0 || c.AllowOrigins[0] == "UNSET" {
+		return &corsHandler{config: c}
+	}
+
+	corsConf := cors.Config{
+		AllowOrigins:     c.AllowOrigins,
+		AllowMethods:     c.AllowMethods,
+		AllowHeaders:     c.AllowHeaders,
+		AllowCredentials: c.AllowCredentials,
+		ExposeHeaders:    c.ExposeHeaders,
+	}
+	if err := corsConf.Validate(); err != nil {
+		// Keep previous handler, cors.New panics on invalid config.
+		l.Warning("Invalid CORS config, previous one is kept: %s", err)
+		res := &corsHandler{config: c}
+		if previous != nil {
+			res.handler = previous.handler
+		}
+		return res
+	}
+
+	return &corsHandler{config: c, handler: cors.New(corsConf)}
+}
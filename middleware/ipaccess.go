@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/pkg/apiversion"
+	"github.com/cloudreve/Cloudreve/v4/pkg/ipaccess"
+	"github.com/cloudreve/Cloudreve/v4/pkg/s3gateway"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+// IPAccess rejects requests from client IPs not allowed by the access rules of given surface.
+// Forwarded client IP header is only trusted for requests from trusted proxies. Slave RPC and
+// storage provider callbacks are not restricted by API rules, as they are not sent by users.
+func IPAccess(dep dependency.Dep, surface string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		settings := dep.SettingProvider()
+		rule := settings.IPAccess(c, surface)
+		if (rule.Allow == "" && rule.Deny == "") || (surface == ipaccess.API && isServerToServerRequest(c)) {
+			c.Next()
+			return
+		}
+
+		allow, err := ipaccess.ParseCIDRs(rule.Allow)
+		var deny []*net.IPNet
+		if err == nil {
+			deny, err = ipaccess.ParseCIDRs(rule.Deny)
+		}
+
+		if err != nil {
+			// Reject all requests rather than ignoring the rules if they are broken.
+			dep.Logger().Warning("Invalid IP access rules of %q: %s", surface, err)
+			abortIPAccess(c, surface)
+			return
+		}
+
+		ip := ipaccess.RequestClientIP(c, dep)
+		if !ipaccess.Allowed(ip, allow, deny) {
+			dep.Logger().Info("Request to %q from %s is rejected by IP access rules.", surface, ip)
+			abortIPAccess(c, surface)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func abortIPAccess(c *gin.Context, surface string) {
+	if surface == ipaccess.S3 {
+		// S3 clients only understand errors in S3 format.
+		s3gateway.WriteError(c, s3gateway.ErrIPNotAllowed)
+		c.Abort()
+		return
+	}
+
+	c.JSON(http.StatusForbidden, serializer.ErrWithDetails(c, serializer.CodeNoPermissionErr, "Access from your IP address is not allowed", nil))
+	c.Abort()
+}
+
+// isServerToServerRequest returns whether the request is a slave RPC or a storage provider callback.
+func isServerToServerRequest(c *gin.Context) bool {
+	p := c.Request.URL.Path
+	if p == constants.APIPrefixSlave || strings.HasPrefix(p, constants.APIPrefixSlave+"/") {
+		return true
+	}
+
+	route, _, ok := apiversion.TrimPrefix(p)
+	return ok && strings.HasPrefix(route, "/callback/")
+}
@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/apiversion"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
+	"github.com/cloudreve/Cloudreve/v4/pkg/s3gateway"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/gin-gonic/gin"
+)
+
+// maintenanceExemptPrefixes are API routes, relative to the version prefix, still served during
+// maintenance, so that clients can read site config, admins can sign in, slave nodes can keep
+// reporting heartbeats and uploads to storage providers can be completed.
+var maintenanceExemptPrefixes = []string{
+	"/site/",
+	"/session/",
+	"/slave/",
+	"/callback/",
+}
+
+// Maintenance rejects requests from non-admin users with 503 and maintenance error when maintenance
+// mode is enabled. Must be used after CurrentUser.
+func Maintenance(dep dependency.Dep) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		m := dep.SettingProvider().Maintenance(c)
+		if !m.Enabled {
+			c.Next()
+			return
+		}
+
+		if route, _, ok := apiversion.TrimPrefix(c.Request.URL.Path); ok {
+			for _, prefix := range maintenanceExemptPrefixes {
+				if strings.HasPrefix(route, prefix) {
+					c.Next()
+					return
+				}
+			}
+		}
+
+		if isAdminUser(c) {
+			c.Next()
+			return
+		}
+
+		abortMaintenance(c, m)
+	}
+}
+
+// WebDAVMaintenance rejects WebDAV requests from non-admin users with 503 when maintenance mode
+// is enabled, as WebDAV clients only understand HTTP status. Must be used after WebDAVAuth.
+func WebDAVMaintenance(dep dependency.Dep) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		m := dep.SettingProvider().Maintenance(c)
+		if !m.Enabled || isAdminUser(c) {
+			c.Next()
+			return
+		}
+
+		setRetryAfter(c, m)
+		c.Status(http.StatusServiceUnavailable)
+		c.Abort()
+	}
+}
+
+// S3Maintenance rejects S3 gateway requests from non-admin users with S3 error when maintenance
+// mode is enabled. Must be used after S3Auth.
+func S3Maintenance(dep dependency.Dep) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		m := dep.SettingProvider().Maintenance(c)
+		if !m.Enabled || isAdminUser(c) {
+			c.Next()
+			return
+		}
+
+		setRetryAfter(c, m)
+		s3gateway.WriteError(c, s3gateway.ErrMaintenance)
+		c.Abort()
+	}
+}
+
+// RelayedMaintenance rejects requests on slave node when master is in maintenance.
+func RelayedMaintenance(dep dependency.Dep) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if m := cluster.RelayedMaintenance(dep.KV()); m != nil && m.Enabled {
+			abortMaintenance(c, m)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func isAdminUser(c *gin.Context) bool {
+	u := inventory.UserFromContext(c)
+	return u != nil && !inventory.IsAnonymousUser(u) &&
+		u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin))
+}
+
+// defaultMaintenanceRetryAfter is the Retry-After in seconds used when maintenance has no ETA or is overdue.
+const defaultMaintenanceRetryAfter = 300
+
+func setRetryAfter(c *gin.Context, m *setting.Maintenance) {
+	retryAfter := m.ETA - time.Now().Unix()
+	if m.ETA <= 0 || retryAfter <= 0 {
+		retryAfter = defaultMaintenanceRetryAfter
+	}
+
+	c.Header("Retry-After", strconv.FormatInt(retryAfter, 10))
+}
+
+func abortMaintenance(c *gin.Context, m *setting.Maintenance) {
+	setRetryAfter(c, m)
+
+	msg := m.Message
+	if msg == "" {
+		msg = "Site is under maintenance, please try again later"
+	}
+
+	res := serializer.ErrWithDetails(c, serializer.CodeMaintenance, msg, nil)
+	res.Data = m
+	c.JSON(http.StatusServiceUnavailable, res)
+	c.Abort()
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"strconv"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// OrganizationAdmin requires current user to be a site admin or a delegated admin of the
+// organization in `org` URL parameter. Subsequent queries are scoped to the organization.
+func OrganizationAdmin(dep dependency.Dep) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("org"))
+		if err != nil {
+			c.JSON(200, serializer.ParamErr(c, "Invalid organization ID", err))
+			c.Abort()
+			return
+		}
+
+		org, err := dep.OrganizationClient().GetByID(c, id)
+		if err != nil {
+			c.JSON(200, serializer.ErrWithDetails(c, serializer.CodeNotFound, "Organization not found", err))
+			c.Abort()
+			return
+		}
+
+		u := inventory.UserFromContext(c)
+		if inventory.IsAnonymousUser(u) ||
+			(!u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) && !inventory.OrganizationHasAdmin(org, u.ID)) {
+			c.JSON(200, serializer.ErrWithDetails(c, serializer.CodeNoPermissionErr, "", nil))
+			c.Abort()
+			return
+		}
+
+		util.WithValue(c, inventory.OrganizationCtx{}, org)
+		util.WithValue(c, inventory.OrganizationScopeCtx{}, &inventory.OrganizationScope{ID: org.ID})
+		c.Next()
+	}
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/ipaccess"
+	"github.com/cloudreve/Cloudreve/v4/pkg/ratelimit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimit limits requests by the rule configured for given route group name, and sets
+// RateLimit-* headers in the response.
+func RateLimit(dep dependency.Dep, name string) gin.HandlerFunc {
+	return RateLimitWhen(dep, name, nil)
+}
+
+// RateLimitWhen is like RateLimit, but only counts requests for which shouldLimit returns true.
+func RateLimitWhen(dep dependency.Dep, name string, shouldLimit func(c *gin.Context) bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rule := dep.SettingProvider().RateLimit(c, name)
+		if rule == nil || (shouldLimit != nil && !shouldLimit(c)) {
+			c.Next()
+			return
+		}
+
+		res, err := ratelimit.Allow(dep.KV(), name, rule, rateLimitSubject(c, dep, rule.Key), time.Now())
+		if err != nil {
+			// Do not block requests if the KV store is unavailable.
+			dep.Logger().Warning("Failed to check rate limit %q: %s", name, err)
+			c.Next()
+			return
+		}
+
+		c.Header("RateLimit-Limit", strconv.Itoa(res.Limit))
+		c.Header("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		c.Header("RateLimit-Reset", strconv.Itoa(res.Reset))
+		if !res.Allowed {
+			c.Header("Retry-After", strconv.Itoa(res.Reset))
+			c.JSON(200, serializer.ErrWithDetails(c, serializer.CodeTooManyRequests, "Too many requests, please try again later", nil))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// rateLimitSubject returns the identity requests are counted by, falls back to client IP if
+// the request is anonymous or not authorized by an access token.
+func rateLimitSubject(c *gin.Context, dep dependency.Dep, key setting.RateLimitKey) string {
+	u := inventory.UserFromContext(c)
+	if u != nil && !inventory.IsAnonymousUser(u) {
+		switch key {
+		case setting.RateLimitKeyUser:
+			return "u" + strconv.Itoa(u.ID)
+		case setting.RateLimitKeyToken:
+			// Tokens are counted by their owner, so that refreshing tokens does not reset the limit.
+			if c.GetHeader(auth.AuthorizationHeader) != "" {
+				return "t" + strconv.Itoa(u.ID)
+			}
+		}
+	}
+
+	return "ip" + ipaccess.RequestClientIP(c, dep).String()
+}
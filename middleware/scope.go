@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+// scopedRoutes records routes declared with Scoped, keyed by method and full path of the route.
+var scopedRoutes sync.Map
+
+// ScopedRoutes registers routes accepting personal access tokens granted with any of its scopes.
+type ScopedRoutes struct {
+	group  *gin.RouterGroup
+	scopes []auth.Scope
+}
+
+// Scoped declares the scopes of personal access tokens accepted by routes registered through the returned
+// ScopedRoutes. Routes registered in other ways are denied for personal access tokens.
+func Scoped(group *gin.RouterGroup, scopes ...auth.Scope) *ScopedRoutes {
+	return &ScopedRoutes{group: group, scopes: scopes}
+}
+
+func (r *ScopedRoutes) Handle(method, relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	scopedRoutes.Store(routeKey(method, joinPaths(r.group.BasePath(), relativePath)), r.scopes)
+	return r.group.Handle(method, relativePath, append([]gin.HandlerFunc{AccessTokenScopes(r.scopes...)}, handlers...)...)
+}
+
+func (r *ScopedRoutes) GET(relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodGet, relativePath, handlers...)
+}
+
+func (r *ScopedRoutes) HEAD(relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodHead, relativePath, handlers...)
+}
+
+func (r *ScopedRoutes) POST(relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPost, relativePath, handlers...)
+}
+
+func (r *ScopedRoutes) PUT(relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPut, relativePath, handlers...)
+}
+
+func (r *ScopedRoutes) PATCH(relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPatch, relativePath, handlers...)
+}
+
+func (r *ScopedRoutes) DELETE(relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodDelete, relativePath, handlers...)
+}
+
+// AccessTokenScopes checks that the personal access token used in current request is granted with any
+// of given scopes. Routes should be declared with Scoped instead of using it directly.
+func AccessTokenScopes(scopes ...auth.Scope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !auth.AccessTokenAllows(c, scopes...) {
+			c.JSON(200, serializer.ErrWithDetails(c, serializer.CodeNoPermissionErr, "Access token scope does not allow this operation", nil))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// accessTokenAllowed returns true if current route is declared with Scoped. Scopes themselves are
+// checked by the handler prepended by Scoped.
+func accessTokenAllowed(c *gin.Context) bool {
+	_, ok := scopedRoutes.Load(routeKey(c.Request.Method, c.FullPath()))
+	return ok
+}
+
+func routeKey(method, fullPath string) string {
+	return method + " " + fullPath
+}
+
+// joinPaths joins paths in the same way as gin does when registering routes in a group.
+func joinPaths(absolutePath, relativePath string) string {
+	if relativePath == "" {
+		return absolutePath
+	}
+
+	finalPath := path.Join(absolutePath, relativePath)
+	if strings.HasSuffix(relativePath, "/") && !strings.HasSuffix(finalPath, "/") {
+		return finalPath + "/"
+	}
+
+	return finalPath
+}
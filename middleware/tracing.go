@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/tracing"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing starts a server span for each request, continuing the trace from upstream nodes if
+// trace context headers are present.
+func Tracing() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		ctx := tracing.Extract(c.Request.Context(), c.Request.Header)
+		ctx, span := tracing.Start(ctx, fmt.Sprintf("%s %s", c.Request.Method, route),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", c.Request.Method),
+				attribute.String("http.route", route),
+				attribute.String("url.path", c.Request.URL.Path),
+				attribute.String("client.address", c.ClientIP()),
+				attribute.String("cloudreve.correlation_id", logging.CorrelationID(ctx).String()),
+			),
+		)
+		defer span.End()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(attribute.Int("http.response.status_code", status))
+		if status >= 500 {
+			span.SetStatus(codes.Error, fmt.Sprintf("HTTP %d", status))
+		}
+		if len(c.Errors) > 0 {
+			span.RecordError(c.Errors.Last())
+		}
+	}
+}
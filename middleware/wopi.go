@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"crypto/subtle"
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
@@ -49,7 +50,15 @@ func ViewerSessionValidation() gin.HandlerFunc {
 			return
 		}
 
+		// Access token is bound to the session, the random part must match as well.
 		session := sessionRaw.(manager.ViewerSessionCache)
+		if subtle.ConstantTimeCompare([]byte(session.Token), []byte(c.Query(wopi.AccessTokenQuery))) != 1 {
+			c.Status(http.StatusForbidden)
+			c.Header(wopi.ServerErrorHeader, "invalid access token")
+			c.Abort()
+			return
+		}
+
 		if err := SetUserCtx(c, session.UserID); err != nil {
 			c.Status(http.StatusInternalServerError)
 			c.Header(wopi.ServerErrorHeader, "user not found")
@@ -0,0 +1,124 @@
+// Package aitag sends images to an inference endpoint, which labels them with tags. The endpoint can be a
+// local ONNX model server or an external API, as long as it speaks the JSON protocol below:
+//
+//	POST {"images": [{"id": "...", "name": "...", "data": "<base64>"}]}
+//	200  {"results": [{"id": "...", "labels": [{"name": "cat", "score": 0.93}]}]}
+package aitag
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+)
+
+// maxLabelLength limits the length of a label stored as tag.
+const maxLabelLength = 64
+
+type (
+	// Tagger labels a batch of images.
+	Tagger interface {
+		// Tag sends images to the endpoint, results are keyed by image ID.
+		Tag(ctx context.Context, images []Image) (map[string][]Label, error)
+	}
+
+	Image struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		Data []byte `json:"data"`
+	}
+
+	Label struct {
+		Name  string  `json:"name"`
+		Score float64 `json:"score"`
+	}
+
+	tagRequest struct {
+		Images []Image `json:"images"`
+	}
+
+	tagResponse struct {
+		Results []struct {
+			ID     string  `json:"id"`
+			Labels []Label `json:"labels"`
+		} `json:"results"`
+	}
+
+	tagger struct {
+		client   request.Client
+		endpoint string
+		token    string
+	}
+)
+
+// NewTagger returns a Tagger sending requests to endpoint, authorized by the token as bearer if not empty.
+func NewTagger(client request.Client, endpoint, token string) Tagger {
+	return &tagger{client: client, endpoint: endpoint, token: token}
+}
+
+func (t *tagger) Tag(ctx context.Context, images []Image) (map[string][]Label, error) {
+	body, err := json.Marshal(&tagRequest{Images: images})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	opts := []request.Option{
+		request.WithContext(ctx),
+		request.WithHeader(http.Header{"Content-Type": []string{"application/json"}}),
+	}
+	if t.token != "" {
+		opts = append(opts, request.WithHeader(http.Header{"Authorization": []string{"Bearer " + t.token}}))
+	}
+
+	content, err := t.client.Request(http.MethodPost, t.endpoint, bytes.NewReader(body), opts...).
+		CheckHTTPResponse(http.StatusOK).
+		GetResponse()
+	if err != nil {
+		return nil, fmt.Errorf("failed to request inference endpoint: %w", err)
+	}
+
+	var res tagResponse
+	if err := json.Unmarshal([]byte(content), &res); err != nil {
+		return nil, fmt.Errorf("failed to decode inference response: %w", err)
+	}
+
+	labels := make(map[string][]Label, len(res.Results))
+	for _, r := range res.Results {
+		labels[r.ID] = r.Labels
+	}
+
+	return labels, nil
+}
+
+// Select returns names of at most max labels with score not lower than minScore, highest score first.
+// Names are normalized to lower case, duplicated or empty ones are dropped.
+func Select(labels []Label, minScore float64, max int) []string {
+	sorted := make([]Label, len(labels))
+	copy(sorted, labels)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Score > sorted[j].Score
+	})
+
+	res := make([]string, 0, max)
+	seen := make(map[string]bool)
+	for _, l := range sorted {
+		if len(res) >= max || l.Score < minScore {
+			break
+		}
+
+		name := strings.ToLower(strings.TrimSpace(l.Name))
+		if name == "" || len(name) > maxLabelLength || seen[name] {
+			continue
+		}
+
+		seen[name] = true
+		res = append(res, name)
+	}
+
+	return res
+}
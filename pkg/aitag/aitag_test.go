@@ -0,0 +1,55 @@
+package aitag
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/stretchr/testify/assert"
+)
+
+type testConfig struct {
+	conf.ConfigProvider
+}
+
+func (testConfig) System() *conf.System {
+	return &conf.System{Mode: conf.MasterMode}
+}
+
+func TestSelect(t *testing.T) {
+	a := assert.New(t)
+	labels := []Label{
+		{Name: "Dog", Score: 0.6},
+		{Name: "cat", Score: 0.9},
+		{Name: " CAT ", Score: 0.8},
+		{Name: "", Score: 0.7},
+		{Name: "tree", Score: 0.3},
+	}
+
+	a.Equal([]string{"cat", "dog"}, Select(labels, 0.5, 10))
+	a.Equal([]string{"cat"}, Select(labels, 0.5, 1))
+	a.Empty(Select(labels, 0.95, 10))
+}
+
+func TestTaggerTag(t *testing.T) {
+	a := assert.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.Equal("Bearer secret", r.Header.Get("Authorization"))
+		var req tagRequest
+		a.NoError(json.NewDecoder(r.Body).Decode(&req))
+		a.Len(req.Images, 1)
+		a.Equal([]byte("image"), req.Images[0].Data)
+
+		_, _ = w.Write([]byte(`{"results":[{"id":"1","labels":[{"name":"cat","score":0.9}]}]}`))
+	}))
+	defer server.Close()
+
+	tagger := NewTagger(request.NewClient(testConfig{}), server.URL, "secret")
+	res, err := tagger.Tag(context.Background(), []Image{{ID: "1", Name: "a.jpg", Data: []byte("image")}})
+	a.NoError(err)
+	a.Equal([]Label{{Name: "cat", Score: 0.9}}, res["1"])
+}
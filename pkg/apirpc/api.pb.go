@@ -0,0 +1,1972 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: api.proto
+
+package apirpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FileType int32
+
+const (
+	FileType_FILE_TYPE_FILE   FileType = 0
+	FileType_FILE_TYPE_FOLDER FileType = 1
+)
+
+// Enum value maps for FileType.
+var (
+	FileType_name = map[int32]string{
+		0: "FILE_TYPE_FILE",
+		1: "FILE_TYPE_FOLDER",
+	}
+	FileType_value = map[string]int32{
+		"FILE_TYPE_FILE":   0,
+		"FILE_TYPE_FOLDER": 1,
+	}
+)
+
+func (x FileType) Enum() *FileType {
+	p := new(FileType)
+	*p = x
+	return p
+}
+
+func (x FileType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FileType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[0].Descriptor()
+}
+
+func (FileType) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[0]
+}
+
+func (x FileType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FileType.Descriptor instead.
+func (FileType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{0}
+}
+
+// File is a file or folder.
+type File struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          FileType               `protobuf:"varint,2,opt,name=type,proto3,enum=cloudreve.api.v1.FileType" json:"type,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Uri           string                 `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PrimaryEntity string                 `protobuf:"bytes,9,opt,name=primary_entity,json=primaryEntity,proto3" json:"primary_entity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *File) Reset() {
+	*x = File{}
+	mi := &file_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*File) ProtoMessage() {}
+
+func (x *File) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use File.ProtoReflect.Descriptor instead.
+func (*File) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *File) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *File) GetType() FileType {
+	if x != nil {
+		return x.Type
+	}
+	return FileType_FILE_TYPE_FILE
+}
+
+func (x *File) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *File) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *File) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *File) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *File) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *File) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *File) GetPrimaryEntity() string {
+	if x != nil {
+		return x.PrimaryEntity
+	}
+	return ""
+}
+
+type GetFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileRequest) Reset() {
+	*x = GetFileRequest{}
+	mi := &file_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileRequest) ProtoMessage() {}
+
+func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
+func (*GetFileRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+// ListFilesRequest lists files under uri. Depending on the file system, results are paginated by
+// next_page_token, or by page if no token is returned.
+type ListFilesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Uri            string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Page           int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize       int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	OrderBy        string                 `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	OrderDirection string                 `protobuf:"bytes,5,opt,name=order_direction,json=orderDirection,proto3" json:"order_direction,omitempty"`
+	NextPageToken  string                 `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListFilesRequest) Reset() {
+	*x = ListFilesRequest{}
+	mi := &file_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilesRequest) ProtoMessage() {}
+
+func (x *ListFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListFilesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListFilesRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ListFilesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFilesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFilesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListFilesRequest) GetOrderDirection() string {
+	if x != nil {
+		return x.OrderDirection
+	}
+	return ""
+}
+
+func (x *ListFilesRequest) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListFilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parent        *File                  `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Files         []*File                `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	TotalItems    int32                  `protobuf:"varint,4,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFilesResponse) Reset() {
+	*x = ListFilesResponse{}
+	mi := &file_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilesResponse) ProtoMessage() {}
+
+func (x *ListFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListFilesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListFilesResponse) GetParent() *File {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *ListFilesResponse) GetFiles() []*File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ListFilesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFilesResponse) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+func (x *ListFilesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// SearchRequest searches files under uri. Type is either "file" or "folder". Times are Unix timestamps
+// in seconds, zero values are not used as conditions.
+type SearchRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Uri            string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Name           []string               `protobuf:"bytes,2,rep,name=name,proto3" json:"name,omitempty"`
+	NameOperatorOr bool                   `protobuf:"varint,3,opt,name=name_operator_or,json=nameOperatorOr,proto3" json:"name_operator_or,omitempty"`
+	CaseFolding    bool                   `protobuf:"varint,4,opt,name=case_folding,json=caseFolding,proto3" json:"case_folding,omitempty"`
+	FullText       bool                   `protobuf:"varint,5,opt,name=full_text,json=fullText,proto3" json:"full_text,omitempty"`
+	Type           string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	Category       string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	Metadata       map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SizeGte        int64                  `protobuf:"varint,9,opt,name=size_gte,json=sizeGte,proto3" json:"size_gte,omitempty"`
+	SizeLte        int64                  `protobuf:"varint,10,opt,name=size_lte,json=sizeLte,proto3" json:"size_lte,omitempty"`
+	CreatedGte     int64                  `protobuf:"varint,11,opt,name=created_gte,json=createdGte,proto3" json:"created_gte,omitempty"`
+	CreatedLte     int64                  `protobuf:"varint,12,opt,name=created_lte,json=createdLte,proto3" json:"created_lte,omitempty"`
+	UpdatedGte     int64                  `protobuf:"varint,13,opt,name=updated_gte,json=updatedGte,proto3" json:"updated_gte,omitempty"`
+	UpdatedLte     int64                  `protobuf:"varint,14,opt,name=updated_lte,json=updatedLte,proto3" json:"updated_lte,omitempty"`
+	Page           int32                  `protobuf:"varint,15,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize       int32                  `protobuf:"varint,16,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken  string                 `protobuf:"bytes,17,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetName() []string {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetNameOperatorOr() bool {
+	if x != nil {
+		return x.NameOperatorOr
+	}
+	return false
+}
+
+func (x *SearchRequest) GetCaseFolding() bool {
+	if x != nil {
+		return x.CaseFolding
+	}
+	return false
+}
+
+func (x *SearchRequest) GetFullText() bool {
+	if x != nil {
+		return x.FullText
+	}
+	return false
+}
+
+func (x *SearchRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetSizeGte() int64 {
+	if x != nil {
+		return x.SizeGte
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetSizeLte() int64 {
+	if x != nil {
+		return x.SizeLte
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetCreatedGte() int64 {
+	if x != nil {
+		return x.CreatedGte
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetCreatedLte() int64 {
+	if x != nil {
+		return x.CreatedLte
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetUpdatedGte() int64 {
+	if x != nil {
+		return x.UpdatedGte
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetUpdatedLte() int64 {
+	if x != nil {
+		return x.UpdatedLte
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateFolderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uri   string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// Fails the call if the folder exists, otherwise the existing one is returned.
+	ErrorOnConflict bool `protobuf:"varint,2,opt,name=error_on_conflict,json=errorOnConflict,proto3" json:"error_on_conflict,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateFolderRequest) Reset() {
+	*x = CreateFolderRequest{}
+	mi := &file_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFolderRequest) ProtoMessage() {}
+
+func (x *CreateFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFolderRequest.ProtoReflect.Descriptor instead.
+func (*CreateFolderRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateFolderRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *CreateFolderRequest) GetErrorOnConflict() bool {
+	if x != nil {
+		return x.ErrorOnConflict
+	}
+	return false
+}
+
+type RenameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	NewName       string                 `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameRequest) Reset() {
+	*x = RenameRequest{}
+	mi := &file_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameRequest) ProtoMessage() {}
+
+func (x *RenameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameRequest.ProtoReflect.Descriptor instead.
+func (*RenameRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RenameRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RenameRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+type MoveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uris          []string               `protobuf:"bytes,1,rep,name=uris,proto3" json:"uris,omitempty"`
+	Dst           string                 `protobuf:"bytes,2,opt,name=dst,proto3" json:"dst,omitempty"`
+	Copy          bool                   `protobuf:"varint,3,opt,name=copy,proto3" json:"copy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveRequest) Reset() {
+	*x = MoveRequest{}
+	mi := &file_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveRequest) ProtoMessage() {}
+
+func (x *MoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveRequest.ProtoReflect.Descriptor instead.
+func (*MoveRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MoveRequest) GetUris() []string {
+	if x != nil {
+		return x.Uris
+	}
+	return nil
+}
+
+func (x *MoveRequest) GetDst() string {
+	if x != nil {
+		return x.Dst
+	}
+	return ""
+}
+
+func (x *MoveRequest) GetCopy() bool {
+	if x != nil {
+		return x.Copy
+	}
+	return false
+}
+
+type DeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uris          []string               `protobuf:"bytes,1,rep,name=uris,proto3" json:"uris,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
+	mi := &file_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteRequest) GetUris() []string {
+	if x != nil {
+		return x.Uris
+	}
+	return nil
+}
+
+// CreateUploadSessionRequest negotiates an upload session, file content is then uploaded to the storage
+// by the returned credential, the same way as the REST API.
+type CreateUploadSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	LastModified  int64                  `protobuf:"varint,3,opt,name=last_modified,json=lastModified,proto3" json:"last_modified,omitempty"`
+	MimeType      string                 `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	PolicyId      string                 `protobuf:"bytes,5,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUploadSessionRequest) Reset() {
+	*x = CreateUploadSessionRequest{}
+	mi := &file_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUploadSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUploadSessionRequest) ProtoMessage() {}
+
+func (x *CreateUploadSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUploadSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateUploadSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateUploadSessionRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *CreateUploadSessionRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CreateUploadSessionRequest) GetLastModified() int64 {
+	if x != nil {
+		return x.LastModified
+	}
+	return 0
+}
+
+func (x *CreateUploadSessionRequest) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *CreateUploadSessionRequest) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *CreateUploadSessionRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// UploadSession is the credential to upload file content to the storage policy.
+type UploadSession struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SessionId      string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	UploadId       string                 `protobuf:"bytes,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	ChunkSize      int64                  `protobuf:"varint,3,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	Expires        int64                  `protobuf:"varint,4,opt,name=expires,proto3" json:"expires,omitempty"`
+	UploadUrls     []string               `protobuf:"bytes,5,rep,name=upload_urls,json=uploadUrls,proto3" json:"upload_urls,omitempty"`
+	Credential     string                 `protobuf:"bytes,6,opt,name=credential,proto3" json:"credential,omitempty"`
+	AccessKey      string                 `protobuf:"bytes,7,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	KeyTime        string                 `protobuf:"bytes,8,opt,name=key_time,json=keyTime,proto3" json:"key_time,omitempty"`
+	CompleteUrl    string                 `protobuf:"bytes,9,opt,name=complete_url,json=completeUrl,proto3" json:"complete_url,omitempty"`
+	StoragePolicy  *StoragePolicy         `protobuf:"bytes,10,opt,name=storage_policy,json=storagePolicy,proto3" json:"storage_policy,omitempty"`
+	Uri            string                 `protobuf:"bytes,11,opt,name=uri,proto3" json:"uri,omitempty"`
+	CallbackSecret string                 `protobuf:"bytes,12,opt,name=callback_secret,json=callbackSecret,proto3" json:"callback_secret,omitempty"`
+	MimeType       string                 `protobuf:"bytes,13,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	UploadPolicy   string                 `protobuf:"bytes,14,opt,name=upload_policy,json=uploadPolicy,proto3" json:"upload_policy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UploadSession) Reset() {
+	*x = UploadSession{}
+	mi := &file_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadSession) ProtoMessage() {}
+
+func (x *UploadSession) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadSession.ProtoReflect.Descriptor instead.
+func (*UploadSession) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UploadSession) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *UploadSession) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadSession) GetChunkSize() int64 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *UploadSession) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+func (x *UploadSession) GetUploadUrls() []string {
+	if x != nil {
+		return x.UploadUrls
+	}
+	return nil
+}
+
+func (x *UploadSession) GetCredential() string {
+	if x != nil {
+		return x.Credential
+	}
+	return ""
+}
+
+func (x *UploadSession) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *UploadSession) GetKeyTime() string {
+	if x != nil {
+		return x.KeyTime
+	}
+	return ""
+}
+
+func (x *UploadSession) GetCompleteUrl() string {
+	if x != nil {
+		return x.CompleteUrl
+	}
+	return ""
+}
+
+func (x *UploadSession) GetStoragePolicy() *StoragePolicy {
+	if x != nil {
+		return x.StoragePolicy
+	}
+	return nil
+}
+
+func (x *UploadSession) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *UploadSession) GetCallbackSecret() string {
+	if x != nil {
+		return x.CallbackSecret
+	}
+	return ""
+}
+
+func (x *UploadSession) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *UploadSession) GetUploadPolicy() string {
+	if x != nil {
+		return x.UploadPolicy
+	}
+	return ""
+}
+
+type StoragePolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	MaxSize       int64                  `protobuf:"varint,4,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	Relay         bool                   `protobuf:"varint,5,opt,name=relay,proto3" json:"relay,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoragePolicy) Reset() {
+	*x = StoragePolicy{}
+	mi := &file_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoragePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoragePolicy) ProtoMessage() {}
+
+func (x *StoragePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoragePolicy.ProtoReflect.Descriptor instead.
+func (*StoragePolicy) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StoragePolicy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StoragePolicy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StoragePolicy) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StoragePolicy) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *StoragePolicy) GetRelay() bool {
+	if x != nil {
+		return x.Relay
+	}
+	return false
+}
+
+type DeleteUploadSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUploadSessionRequest) Reset() {
+	*x = DeleteUploadSessionRequest{}
+	mi := &file_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUploadSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUploadSessionRequest) ProtoMessage() {}
+
+func (x *DeleteUploadSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUploadSessionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUploadSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteUploadSessionRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *DeleteUploadSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// CreateShareRequest creates a share link, expire is in seconds, 0 for never.
+type CreateShareRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uri             string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	IsPrivate       bool                   `protobuf:"varint,2,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	RemainDownloads int32                  `protobuf:"varint,3,opt,name=remain_downloads,json=remainDownloads,proto3" json:"remain_downloads,omitempty"`
+	Expire          int32                  `protobuf:"varint,4,opt,name=expire,proto3" json:"expire,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateShareRequest) Reset() {
+	*x = CreateShareRequest{}
+	mi := &file_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareRequest) ProtoMessage() {}
+
+func (x *CreateShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShareRequest.ProtoReflect.Descriptor instead.
+func (*CreateShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateShareRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *CreateShareRequest) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+func (x *CreateShareRequest) GetRemainDownloads() int32 {
+	if x != nil {
+		return x.RemainDownloads
+	}
+	return 0
+}
+
+func (x *CreateShareRequest) GetExpire() int32 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type Share struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url             string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Uri             string                 `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	IsPrivate       bool                   `protobuf:"varint,4,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	Visited         int32                  `protobuf:"varint,5,opt,name=visited,proto3" json:"visited,omitempty"`
+	Downloaded      int32                  `protobuf:"varint,6,opt,name=downloaded,proto3" json:"downloaded,omitempty"`
+	RemainDownloads *int32                 `protobuf:"varint,7,opt,name=remain_downloads,json=remainDownloads,proto3,oneof" json:"remain_downloads,omitempty"`
+	Expires         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires,proto3" json:"expires,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Share) Reset() {
+	*x = Share{}
+	mi := &file_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Share) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Share) ProtoMessage() {}
+
+func (x *Share) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Share.ProtoReflect.Descriptor instead.
+func (*Share) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Share) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Share) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Share) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *Share) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+func (x *Share) GetVisited() int32 {
+	if x != nil {
+		return x.Visited
+	}
+	return 0
+}
+
+func (x *Share) GetDownloaded() int32 {
+	if x != nil {
+		return x.Downloaded
+	}
+	return 0
+}
+
+func (x *Share) GetRemainDownloads() int32 {
+	if x != nil && x.RemainDownloads != nil {
+		return *x.RemainDownloads
+	}
+	return 0
+}
+
+func (x *Share) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+func (x *Share) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListSharesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PageSize       int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	OrderBy        string                 `protobuf:"bytes,2,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	OrderDirection string                 `protobuf:"bytes,3,opt,name=order_direction,json=orderDirection,proto3" json:"order_direction,omitempty"`
+	NextPageToken  string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListSharesRequest) Reset() {
+	*x = ListSharesRequest{}
+	mi := &file_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharesRequest) ProtoMessage() {}
+
+func (x *ListSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListSharesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListSharesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSharesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListSharesRequest) GetOrderDirection() string {
+	if x != nil {
+		return x.OrderDirection
+	}
+	return ""
+}
+
+func (x *ListSharesRequest) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListSharesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shares        []*Share               `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharesResponse) Reset() {
+	*x = ListSharesResponse{}
+	mi := &file_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharesResponse) ProtoMessage() {}
+
+func (x *ListSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListSharesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListSharesResponse) GetShares() []*Share {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *ListSharesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DeleteShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShareRequest) Reset() {
+	*x = DeleteShareRequest{}
+	mi := &file_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShareRequest) ProtoMessage() {}
+
+func (x *DeleteShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShareRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteShareRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UploadFrame is a part of file content streamed to the server. Other fields are only read from the
+// first frame. The file is created or overwritten once all frames are received.
+type UploadFrame struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Uri          string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Size         int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	LastModified int64                  `protobuf:"varint,3,opt,name=last_modified,json=lastModified,proto3" json:"last_modified,omitempty"`
+	// Fails the upload if current version of the file is not the given one.
+	PreviousVersion string `protobuf:"bytes,4,opt,name=previous_version,json=previousVersion,proto3" json:"previous_version,omitempty"`
+	Data            []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UploadFrame) Reset() {
+	*x = UploadFrame{}
+	mi := &file_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFrame) ProtoMessage() {}
+
+func (x *UploadFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFrame.ProtoReflect.Descriptor instead.
+func (*UploadFrame) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UploadFrame) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *UploadFrame) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadFrame) GetLastModified() int64 {
+	if x != nil {
+		return x.LastModified
+	}
+	return 0
+}
+
+func (x *UploadFrame) GetPreviousVersion() string {
+	if x != nil {
+		return x.PreviousVersion
+	}
+	return ""
+}
+
+func (x *UploadFrame) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// DownloadRequest downloads the primary entity, or the given entity, of a file from offset.
+type DownloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Entity        string                 `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	Offset        int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadRequest) Reset() {
+	*x = DownloadRequest{}
+	mi := &file_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadRequest) ProtoMessage() {}
+
+func (x *DownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadRequest.ProtoReflect.Descriptor instead.
+func (*DownloadRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DownloadRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *DownloadRequest) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *DownloadRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// DownloadFrame is a part of file content streamed to the client. Name and size are only set in the
+// first frame.
+type DownloadFrame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadFrame) Reset() {
+	*x = DownloadFrame{}
+	mi := &file_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFrame) ProtoMessage() {}
+
+func (x *DownloadFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFrame.ProtoReflect.Descriptor instead.
+func (*DownloadFrame) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DownloadFrame) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DownloadFrame) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DownloadFrame) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_api_proto protoreflect.FileDescriptor
+
+const file_api_proto_rawDesc = "" +
+	"\n" +
+	"\tapi.proto\x12\x10cloudreve.api.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9c\x03\n" +
+	"\x04File\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1a.cloudreve.api.v1.FileTypeR\x04type\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x10\n" +
+	"\x03uri\x18\x04 \x01(\tR\x03uri\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12@\n" +
+	"\bmetadata\x18\b \x03(\v2$.cloudreve.api.v1.File.MetadataEntryR\bmetadata\x12%\n" +
+	"\x0eprimary_entity\x18\t \x01(\tR\rprimaryEntity\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\"\n" +
+	"\x0eGetFileRequest\x12\x10\n" +
+	"\x03uri\x18\x01 \x01(\tR\x03uri\"\xc1\x01\n" +
+	"\x10ListFilesRequest\x12\x10\n" +
+	"\x03uri\x18\x01 \x01(\tR\x03uri\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x19\n" +
+	"\border_by\x18\x04 \x01(\tR\aorderBy\x12'\n" +
+	"\x0forder_direction\x18\x05 \x01(\tR\x0eorderDirection\x12&\n" +
+	"\x0fnext_page_token\x18\x06 \x01(\tR\rnextPageToken\"\xce\x01\n" +
+	"\x11ListFilesResponse\x12.\n" +
+	"\x06parent\x18\x01 \x01(\v2\x16.cloudreve.api.v1.FileR\x06parent\x12,\n" +
+	"\x05files\x18\x02 \x03(\v2\x16.cloudreve.api.v1.FileR\x05files\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
+	"\vtotal_items\x18\x04 \x01(\x05R\n" +
+	"totalItems\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"\xea\x04\n" +
+	"\rSearchRequest\x12\x10\n" +
+	"\x03uri\x18\x01 \x01(\tR\x03uri\x12\x12\n" +
+	"\x04name\x18\x02 \x03(\tR\x04name\x12(\n" +
+	"\x10name_operator_or\x18\x03 \x01(\bR\x0enameOperatorOr\x12!\n" +
+	"\fcase_folding\x18\x04 \x01(\bR\vcaseFolding\x12\x1b\n" +
+	"\tfull_text\x18\x05 \x01(\bR\bfullText\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\x12I\n" +
+	"\bmetadata\x18\b \x03(\v2-.cloudreve.api.v1.SearchRequest.MetadataEntryR\bmetadata\x12\x19\n" +
+	"\bsize_gte\x18\t \x01(\x03R\asizeGte\x12\x19\n" +
+	"\bsize_lte\x18\n" +
+	" \x01(\x03R\asizeLte\x12\x1f\n" +
+	"\vcreated_gte\x18\v \x01(\x03R\n" +
+	"createdGte\x12\x1f\n" +
+	"\vcreated_lte\x18\f \x01(\x03R\n" +
+	"createdLte\x12\x1f\n" +
+	"\vupdated_gte\x18\r \x01(\x03R\n" +
+	"updatedGte\x12\x1f\n" +
+	"\vupdated_lte\x18\x0e \x01(\x03R\n" +
+	"updatedLte\x12\x12\n" +
+	"\x04page\x18\x0f \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x10 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x11 \x01(\tR\rnextPageToken\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"S\n" +
+	"\x13CreateFolderRequest\x12\x10\n" +
+	"\x03uri\x18\x01 \x01(\tR\x03uri\x12*\n" +
+	"\x11error_on_conflict\x18\x02 \x01(\bR\x0ferrorOnConflict\"<\n" +
+	"\rRenameRequest\x12\x10\n" +
+	"\x03uri\x18\x01 \x01(\tR\x03uri\x12\x19\n" +
+	"\bnew_name\x18\x02 \x01(\tR\anewName\"G\n" +
+	"\vMoveRequest\x12\x12\n" +
+	"\x04uris\x18\x01 \x03(\tR\x04uris\x12\x10\n" +
+	"\x03dst\x18\x02 \x01(\tR\x03dst\x12\x12\n" +
+	"\x04copy\x18\x03 \x01(\bR\x04copy\"#\n" +
+	"\rDeleteRequest\x12\x12\n" +
+	"\x04uris\x18\x01 \x03(\tR\x04uris\"\xb6\x02\n" +
+	"\x1aCreateUploadSessionRequest\x12\x10\n" +
+	"\x03uri\x18\x01 \x01(\tR\x03uri\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12#\n" +
+	"\rlast_modified\x18\x03 \x01(\x03R\flastModified\x12\x1b\n" +
+	"\tmime_type\x18\x04 \x01(\tR\bmimeType\x12\x1b\n" +
+	"\tpolicy_id\x18\x05 \x01(\tR\bpolicyId\x12V\n" +
+	"\bmetadata\x18\x06 \x03(\v2:.cloudreve.api.v1.CreateUploadSessionRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe7\x03\n" +
+	"\rUploadSession\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tupload_id\x18\x02 \x01(\tR\buploadId\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x03 \x01(\x03R\tchunkSize\x12\x18\n" +
+	"\aexpires\x18\x04 \x01(\x03R\aexpires\x12\x1f\n" +
+	"\vupload_urls\x18\x05 \x03(\tR\n" +
+	"uploadUrls\x12\x1e\n" +
+	"\n" +
+	"credential\x18\x06 \x01(\tR\n" +
+	"credential\x12\x1d\n" +
+	"\n" +
+	"access_key\x18\a \x01(\tR\taccessKey\x12\x19\n" +
+	"\bkey_time\x18\b \x01(\tR\akeyTime\x12!\n" +
+	"\fcomplete_url\x18\t \x01(\tR\vcompleteUrl\x12F\n" +
+	"\x0estorage_policy\x18\n" +
+	" \x01(\v2\x1f.cloudreve.api.v1.StoragePolicyR\rstoragePolicy\x12\x10\n" +
+	"\x03uri\x18\v \x01(\tR\x03uri\x12'\n" +
+	"\x0fcallback_secret\x18\f \x01(\tR\x0ecallbackSecret\x12\x1b\n" +
+	"\tmime_type\x18\r \x01(\tR\bmimeType\x12#\n" +
+	"\rupload_policy\x18\x0e \x01(\tR\fuploadPolicy\"x\n" +
+	"\rStoragePolicy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x19\n" +
+	"\bmax_size\x18\x04 \x01(\x03R\amaxSize\x12\x14\n" +
+	"\x05relay\x18\x05 \x01(\bR\x05relay\"M\n" +
+	"\x1aDeleteUploadSessionRequest\x12\x10\n" +
+	"\x03uri\x18\x01 \x01(\tR\x03uri\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"\x88\x01\n" +
+	"\x12CreateShareRequest\x12\x10\n" +
+	"\x03uri\x18\x01 \x01(\tR\x03uri\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\x02 \x01(\bR\tisPrivate\x12)\n" +
+	"\x10remain_downloads\x18\x03 \x01(\x05R\x0fremainDownloads\x12\x16\n" +
+	"\x06expire\x18\x04 \x01(\x05R\x06expire\"\xca\x02\n" +
+	"\x05Share\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x10\n" +
+	"\x03uri\x18\x03 \x01(\tR\x03uri\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\x04 \x01(\bR\tisPrivate\x12\x18\n" +
+	"\avisited\x18\x05 \x01(\x05R\avisited\x12\x1e\n" +
+	"\n" +
+	"downloaded\x18\x06 \x01(\x05R\n" +
+	"downloaded\x12.\n" +
+	"\x10remain_downloads\x18\a \x01(\x05H\x00R\x0fremainDownloads\x88\x01\x01\x124\n" +
+	"\aexpires\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\aexpires\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x13\n" +
+	"\x11_remain_downloads\"\x9c\x01\n" +
+	"\x11ListSharesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x19\n" +
+	"\border_by\x18\x02 \x01(\tR\aorderBy\x12'\n" +
+	"\x0forder_direction\x18\x03 \x01(\tR\x0eorderDirection\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"m\n" +
+	"\x12ListSharesResponse\x12/\n" +
+	"\x06shares\x18\x01 \x03(\v2\x17.cloudreve.api.v1.ShareR\x06shares\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"$\n" +
+	"\x12DeleteShareRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x97\x01\n" +
+	"\vUploadFrame\x12\x10\n" +
+	"\x03uri\x18\x01 \x01(\tR\x03uri\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12#\n" +
+	"\rlast_modified\x18\x03 \x01(\x03R\flastModified\x12)\n" +
+	"\x10previous_version\x18\x04 \x01(\tR\x0fpreviousVersion\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data\"S\n" +
+	"\x0fDownloadRequest\x12\x10\n" +
+	"\x03uri\x18\x01 \x01(\tR\x03uri\x12\x16\n" +
+	"\x06entity\x18\x02 \x01(\tR\x06entity\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x03R\x06offset\"K\n" +
+	"\rDownloadFrame\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data*4\n" +
+	"\bFileType\x12\x12\n" +
+	"\x0eFILE_TYPE_FILE\x10\x00\x12\x14\n" +
+	"\x10FILE_TYPE_FOLDER\x10\x012\xd0\b\n" +
+	"\x03API\x12C\n" +
+	"\aGetFile\x12 .cloudreve.api.v1.GetFileRequest\x1a\x16.cloudreve.api.v1.File\x12T\n" +
+	"\tListFiles\x12\".cloudreve.api.v1.ListFilesRequest\x1a#.cloudreve.api.v1.ListFilesResponse\x12N\n" +
+	"\x06Search\x12\x1f.cloudreve.api.v1.SearchRequest\x1a#.cloudreve.api.v1.ListFilesResponse\x12M\n" +
+	"\fCreateFolder\x12%.cloudreve.api.v1.CreateFolderRequest\x1a\x16.cloudreve.api.v1.File\x12A\n" +
+	"\x06Rename\x12\x1f.cloudreve.api.v1.RenameRequest\x1a\x16.cloudreve.api.v1.File\x12=\n" +
+	"\x04Move\x12\x1d.cloudreve.api.v1.MoveRequest\x1a\x16.google.protobuf.Empty\x12A\n" +
+	"\x06Delete\x12\x1f.cloudreve.api.v1.DeleteRequest\x1a\x16.google.protobuf.Empty\x12d\n" +
+	"\x13CreateUploadSession\x12,.cloudreve.api.v1.CreateUploadSessionRequest\x1a\x1f.cloudreve.api.v1.UploadSession\x12[\n" +
+	"\x13DeleteUploadSession\x12,.cloudreve.api.v1.DeleteUploadSessionRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
+	"\vCreateShare\x12$.cloudreve.api.v1.CreateShareRequest\x1a\x17.cloudreve.api.v1.Share\x12W\n" +
+	"\n" +
+	"ListShares\x12#.cloudreve.api.v1.ListSharesRequest\x1a$.cloudreve.api.v1.ListSharesResponse\x12K\n" +
+	"\vDeleteShare\x12$.cloudreve.api.v1.DeleteShareRequest\x1a\x16.google.protobuf.Empty\x12A\n" +
+	"\x06Upload\x12\x1d.cloudreve.api.v1.UploadFrame\x1a\x16.cloudreve.api.v1.File(\x01\x12P\n" +
+	"\bDownload\x12!.cloudreve.api.v1.DownloadRequest\x1a\x1f.cloudreve.api.v1.DownloadFrame0\x01B.Z,github.com/cloudreve/Cloudreve/v4/pkg/apirpcb\x06proto3"
+
+var (
+	file_api_proto_rawDescOnce sync.Once
+	file_api_proto_rawDescData []byte
+)
+
+func file_api_proto_rawDescGZIP() []byte {
+	file_api_proto_rawDescOnce.Do(func() {
+		file_api_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_rawDesc), len(file_api_proto_rawDesc)))
+	})
+	return file_api_proto_rawDescData
+}
+
+var file_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_api_proto_goTypes = []any{
+	(FileType)(0),                      // 0: cloudreve.api.v1.FileType
+	(*File)(nil),                       // 1: cloudreve.api.v1.File
+	(*GetFileRequest)(nil),             // 2: cloudreve.api.v1.GetFileRequest
+	(*ListFilesRequest)(nil),           // 3: cloudreve.api.v1.ListFilesRequest
+	(*ListFilesResponse)(nil),          // 4: cloudreve.api.v1.ListFilesResponse
+	(*SearchRequest)(nil),              // 5: cloudreve.api.v1.SearchRequest
+	(*CreateFolderRequest)(nil),        // 6: cloudreve.api.v1.CreateFolderRequest
+	(*RenameRequest)(nil),              // 7: cloudreve.api.v1.RenameRequest
+	(*MoveRequest)(nil),                // 8: cloudreve.api.v1.MoveRequest
+	(*DeleteRequest)(nil),              // 9: cloudreve.api.v1.DeleteRequest
+	(*CreateUploadSessionRequest)(nil), // 10: cloudreve.api.v1.CreateUploadSessionRequest
+	(*UploadSession)(nil),              // 11: cloudreve.api.v1.UploadSession
+	(*StoragePolicy)(nil),              // 12: cloudreve.api.v1.StoragePolicy
+	(*DeleteUploadSessionRequest)(nil), // 13: cloudreve.api.v1.DeleteUploadSessionRequest
+	(*CreateShareRequest)(nil),         // 14: cloudreve.api.v1.CreateShareRequest
+	(*Share)(nil),                      // 15: cloudreve.api.v1.Share
+	(*ListSharesRequest)(nil),          // 16: cloudreve.api.v1.ListSharesRequest
+	(*ListSharesResponse)(nil),         // 17: cloudreve.api.v1.ListSharesResponse
+	(*DeleteShareRequest)(nil),         // 18: cloudreve.api.v1.DeleteShareRequest
+	(*UploadFrame)(nil),                // 19: cloudreve.api.v1.UploadFrame
+	(*DownloadRequest)(nil),            // 20: cloudreve.api.v1.DownloadRequest
+	(*DownloadFrame)(nil),              // 21: cloudreve.api.v1.DownloadFrame
+	nil,                                // 22: cloudreve.api.v1.File.MetadataEntry
+	nil,                                // 23: cloudreve.api.v1.SearchRequest.MetadataEntry
+	nil,                                // 24: cloudreve.api.v1.CreateUploadSessionRequest.MetadataEntry
+	(*timestamppb.Timestamp)(nil),      // 25: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),              // 26: google.protobuf.Empty
+}
+var file_api_proto_depIdxs = []int32{
+	0,  // 0: cloudreve.api.v1.File.type:type_name -> cloudreve.api.v1.FileType
+	25, // 1: cloudreve.api.v1.File.created_at:type_name -> google.protobuf.Timestamp
+	25, // 2: cloudreve.api.v1.File.updated_at:type_name -> google.protobuf.Timestamp
+	22, // 3: cloudreve.api.v1.File.metadata:type_name -> cloudreve.api.v1.File.MetadataEntry
+	1,  // 4: cloudreve.api.v1.ListFilesResponse.parent:type_name -> cloudreve.api.v1.File
+	1,  // 5: cloudreve.api.v1.ListFilesResponse.files:type_name -> cloudreve.api.v1.File
+	23, // 6: cloudreve.api.v1.SearchRequest.metadata:type_name -> cloudreve.api.v1.SearchRequest.MetadataEntry
+	24, // 7: cloudreve.api.v1.CreateUploadSessionRequest.metadata:type_name -> cloudreve.api.v1.CreateUploadSessionRequest.MetadataEntry
+	12, // 8: cloudreve.api.v1.UploadSession.storage_policy:type_name -> cloudreve.api.v1.StoragePolicy
+	25, // 9: cloudreve.api.v1.Share.expires:type_name -> google.protobuf.Timestamp
+	25, // 10: cloudreve.api.v1.Share.created_at:type_name -> google.protobuf.Timestamp
+	15, // 11: cloudreve.api.v1.ListSharesResponse.shares:type_name -> cloudreve.api.v1.Share
+	2,  // 12: cloudreve.api.v1.API.GetFile:input_type -> cloudreve.api.v1.GetFileRequest
+	3,  // 13: cloudreve.api.v1.API.ListFiles:input_type -> cloudreve.api.v1.ListFilesRequest
+	5,  // 14: cloudreve.api.v1.API.Search:input_type -> cloudreve.api.v1.SearchRequest
+	6,  // 15: cloudreve.api.v1.API.CreateFolder:input_type -> cloudreve.api.v1.CreateFolderRequest
+	7,  // 16: cloudreve.api.v1.API.Rename:input_type -> cloudreve.api.v1.RenameRequest
+	8,  // 17: cloudreve.api.v1.API.Move:input_type -> cloudreve.api.v1.MoveRequest
+	9,  // 18: cloudreve.api.v1.API.Delete:input_type -> cloudreve.api.v1.DeleteRequest
+	10, // 19: cloudreve.api.v1.API.CreateUploadSession:input_type -> cloudreve.api.v1.CreateUploadSessionRequest
+	13, // 20: cloudreve.api.v1.API.DeleteUploadSession:input_type -> cloudreve.api.v1.DeleteUploadSessionRequest
+	14, // 21: cloudreve.api.v1.API.CreateShare:input_type -> cloudreve.api.v1.CreateShareRequest
+	16, // 22: cloudreve.api.v1.API.ListShares:input_type -> cloudreve.api.v1.ListSharesRequest
+	18, // 23: cloudreve.api.v1.API.DeleteShare:input_type -> cloudreve.api.v1.DeleteShareRequest
+	19, // 24: cloudreve.api.v1.API.Upload:input_type -> cloudreve.api.v1.UploadFrame
+	20, // 25: cloudreve.api.v1.API.Download:input_type -> cloudreve.api.v1.DownloadRequest
+	1,  // 26: cloudreve.api.v1.API.GetFile:output_type -> cloudreve.api.v1.File
+	4,  // 27: cloudreve.api.v1.API.ListFiles:output_type -> cloudreve.api.v1.ListFilesResponse
+	4,  // 28: cloudreve.api.v1.API.Search:output_type -> cloudreve.api.v1.ListFilesResponse
+	1,  // 29: cloudreve.api.v1.API.CreateFolder:output_type -> cloudreve.api.v1.File
+	1,  // 30: cloudreve.api.v1.API.Rename:output_type -> cloudreve.api.v1.File
+	26, // 31: cloudreve.api.v1.API.Move:output_type -> google.protobuf.Empty
+	26, // 32: cloudreve.api.v1.API.Delete:output_type -> google.protobuf.Empty
+	11, // 33: cloudreve.api.v1.API.CreateUploadSession:output_type -> cloudreve.api.v1.UploadSession
+	26, // 34: cloudreve.api.v1.API.DeleteUploadSession:output_type -> google.protobuf.Empty
+	15, // 35: cloudreve.api.v1.API.CreateShare:output_type -> cloudreve.api.v1.Share
+	17, // 36: cloudreve.api.v1.API.ListShares:output_type -> cloudreve.api.v1.ListSharesResponse
+	26, // 37: cloudreve.api.v1.API.DeleteShare:output_type -> google.protobuf.Empty
+	1,  // 38: cloudreve.api.v1.API.Upload:output_type -> cloudreve.api.v1.File
+	21, // 39: cloudreve.api.v1.API.Download:output_type -> cloudreve.api.v1.DownloadFrame
+	26, // [26:40] is the sub-list for method output_type
+	12, // [12:26] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_init() }
+func file_api_proto_init() {
+	if File_api_proto != nil {
+		return
+	}
+	file_api_proto_msgTypes[14].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_rawDesc), len(file_api_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   24,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_goTypes,
+		DependencyIndexes: file_api_proto_depIdxs,
+		EnumInfos:         file_api_proto_enumTypes,
+		MessageInfos:      file_api_proto_msgTypes,
+	}.Build()
+	File_api_proto = out.File
+	file_api_proto_goTypes = nil
+	file_api_proto_depIdxs = nil
+}
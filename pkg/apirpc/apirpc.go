@@ -0,0 +1,13 @@
+// Package apirpc defines the gRPC API for programmatic access. It covers file operations, upload
+// sessions, search and share management, with file content streamed in frames, for automation
+// clients where the overhead of REST and JSON responses matters. Messages and service stubs are
+// generated from api.proto, the server also serves reflection so that generic clients can discover
+// the schema. Calls are authenticated by personal access tokens.
+package apirpc
+
+//go:generate protoc -I . --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative api.proto
+
+const (
+	// FrameSize max size of file data in one upload or download frame.
+	FrameSize = 1 << 20
+)
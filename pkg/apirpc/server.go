@@ -0,0 +1,130 @@
+package apirpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/rpcerr"
+	"github.com/samber/lo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Metadata keys, gRPC requires them to be lower case.
+	authorizationKey = "authorization"
+	// ErrorCodeKey trailer key of the Cloudreve error code of a failed call.
+	ErrorCodeKey = rpcerr.CodeKey
+	// reflectionPrefix is the method prefix of server reflection services.
+	reflectionPrefix = "/grpc.reflection."
+)
+
+// methodScopes lists scopes of personal access tokens allowed to call each method, they are the same
+// as scopes of the equivalent REST routes.
+var methodScopes = map[string][]auth.Scope{
+	API_GetFile_FullMethodName:             {auth.ScopeFileRead},
+	API_ListFiles_FullMethodName:           {auth.ScopeFileRead},
+	API_Search_FullMethodName:              {auth.ScopeFileRead},
+	API_Download_FullMethodName:            {auth.ScopeFileRead},
+	API_CreateFolder_FullMethodName:        {auth.ScopeFileUpload},
+	API_CreateUploadSession_FullMethodName: {auth.ScopeFileUpload},
+	API_DeleteUploadSession_FullMethodName: {auth.ScopeFileUpload},
+	API_Upload_FullMethodName:              {auth.ScopeFileUpload},
+	API_Rename_FullMethodName:              {auth.ScopeFileManage},
+	API_Move_FullMethodName:                {auth.ScopeFileManage},
+	API_Delete_FullMethodName:              {auth.ScopeFileManage},
+	API_CreateShare_FullMethodName:         {auth.ScopeShareManage},
+	API_ListShares_FullMethodName:          {auth.ScopeShareManage},
+	API_DeleteShare_FullMethodName:         {auth.ScopeShareManage},
+}
+
+type (
+	// TokenVerifier verifies a personal access token, returns ID of its owner and granted scopes.
+	TokenVerifier func(ctx context.Context, token string) (int, []string, error)
+	// ContextFunc builds the context to handle an authenticated call of given user.
+	ContextFunc func(ctx context.Context, md metadata.MD, uid int, scopes []string) (context.Context, error)
+)
+
+// NewServer creates a gRPC server that rejects calls without a personal access token granted with
+// the scopes of called method. Handler errors of serializer.AppError are sent with their error code.
+// Interceptors in opts run after authentication, with the context built by ctxFn. Server reflection
+// is registered and allowed for any valid token.
+func NewServer(verify TokenVerifier, ctxFn ContextFunc, opts ...grpc.ServerOption) *grpc.Server {
+	opts = append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			ctx, err := authenticate(ctx, info.FullMethod, verify, ctxFn)
+			if err != nil {
+				return nil, err
+			}
+
+			res, err := handler(ctx, req)
+			if err != nil {
+				return nil, rpcerr.ToStatus(err, func(md metadata.MD) { _ = grpc.SetTrailer(ctx, md) })
+			}
+
+			return res, nil
+		}),
+		grpc.ChainStreamInterceptor(func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			ctx, err := authenticate(ss.Context(), info.FullMethod, verify, ctxFn)
+			if err != nil {
+				return err
+			}
+
+			if err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx}); err != nil {
+				return rpcerr.ToStatus(err, ss.SetTrailer)
+			}
+
+			return nil
+		}),
+	}, opts...)
+
+	s := grpc.NewServer(opts...)
+	reflection.Register(s)
+	return s
+}
+
+func authenticate(ctx context.Context, fullMethod string, verify TokenVerifier, ctxFn ContextFunc) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	token := ""
+	if values := md.Get(authorizationKey); len(values) > 0 {
+		token = strings.TrimPrefix(values[0], auth.TokenHeaderPrefix)
+	}
+
+	if !strings.HasPrefix(token, auth.AccessTokenPrefix) {
+		return nil, status.Error(codes.Unauthenticated, "personal access token is required")
+	}
+
+	uid, scopes, err := verify(ctx, token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	// Reflection only exposes the schema, it is allowed regardless of scopes.
+	if !strings.HasPrefix(fullMethod, reflectionPrefix) {
+		allowed, ok := methodScopes[fullMethod]
+		if !ok || !lo.SomeBy(allowed, func(s auth.Scope) bool { return lo.Contains(scopes, string(s)) }) {
+			return nil, status.Error(codes.PermissionDenied, "access token scope does not allow this operation")
+		}
+	}
+
+	handlingCtx, err := ctxFn(ctx, md, uid, scopes)
+	if err != nil {
+		return nil, rpcerr.ToStatus(err, func(md metadata.MD) { _ = grpc.SetTrailer(ctx, md) })
+	}
+
+	return handlingCtx, nil
+}
+
+// serverStream overrides the context of an authenticated stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,169 @@
+package apirpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+)
+
+type testAPIServer struct {
+	UnimplementedAPIServer
+}
+
+func (s *testAPIServer) GetFile(ctx context.Context, req *GetFileRequest) (*File, error) {
+	if req.Uri == "missing" {
+		return nil, serializer.NewError(serializer.CodeNotFound, "not found", nil)
+	}
+
+	return &File{Uri: req.Uri, Name: strconv.Itoa(ctx.Value(uidCtx{}).(int))}, nil
+}
+
+type uidCtx struct{}
+
+func newTestConn(t *testing.T, opts ...grpc.ServerOption) *grpc.ClientConn {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verify := func(ctx context.Context, token string) (int, []string, error) {
+		switch token {
+		case auth.AccessTokenPrefix + "read":
+			return 1, []string{string(auth.ScopeFileRead)}, nil
+		case auth.AccessTokenPrefix + "share":
+			return 2, []string{string(auth.ScopeShareManage)}, nil
+		case auth.AccessTokenPrefix + "write":
+			return 3, []string{string(auth.ScopeFileWrite)}, nil
+		case auth.AccessTokenPrefix + "manage":
+			return 4, []string{string(auth.ScopeFileManage)}, nil
+		}
+		return 0, nil, errors.New("invalid token")
+	}
+	ctxFn := func(ctx context.Context, md metadata.MD, uid int, scopes []string) (context.Context, error) {
+		return context.WithValue(ctx, uidCtx{}, uid), nil
+	}
+
+	s := NewServer(verify, ctxFn, opts...)
+	RegisterAPIServer(s, &testAPIServer{})
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestServer_Authenticate(t *testing.T) {
+	a := assert.New(t)
+	conn := newTestConn(t)
+	withToken := func(token string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), authorizationKey, auth.TokenHeaderPrefix+token)
+	}
+
+	c := NewAPIClient(conn)
+	res, err := c.GetFile(withToken(auth.AccessTokenPrefix+"read"), &GetFileRequest{Uri: "cloudreve://my/a"})
+	a.NoError(err)
+	a.Equal("cloudreve://my/a", res.Uri)
+	a.Equal("1", res.Name)
+
+	// Missing or invalid token is rejected.
+	_, err = c.GetFile(context.Background(), &GetFileRequest{})
+	a.Equal(codes.Unauthenticated, status.Code(err))
+	_, err = c.GetFile(withToken(auth.AccessTokenPrefix+"other"), &GetFileRequest{})
+	a.Equal(codes.Unauthenticated, status.Code(err))
+
+	// Token without required scope is rejected.
+	_, err = c.GetFile(withToken(auth.AccessTokenPrefix+"share"), &GetFileRequest{})
+	a.Equal(codes.PermissionDenied, status.Code(err))
+
+	// Renaming requires files.manage, files.write only allows overwriting content.
+	_, err = c.Rename(withToken(auth.AccessTokenPrefix+"write"), &RenameRequest{})
+	a.Equal(codes.PermissionDenied, status.Code(err))
+	_, err = c.Rename(withToken(auth.AccessTokenPrefix+"manage"), &RenameRequest{})
+	a.Equal(codes.Unimplemented, status.Code(err))
+
+	// Error code of handler errors is sent in trailer.
+	var trailer metadata.MD
+	_, err = c.GetFile(withToken(auth.AccessTokenPrefix+"read"), &GetFileRequest{Uri: "missing"}, grpc.Trailer(&trailer))
+	a.Equal(codes.NotFound, status.Code(err))
+	a.Equal([]string{strconv.Itoa(serializer.CodeNotFound)}, trailer.Get(ErrorCodeKey))
+}
+
+func TestServer_Interceptors(t *testing.T) {
+	a := assert.New(t)
+	conn := newTestConn(t, grpc.ChainUnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		// Interceptors in options run with the authenticated context.
+		if ctx.Value(uidCtx{}) != 1 {
+			return nil, serializer.NewError(serializer.CodeMaintenance, "maintenance", nil)
+		}
+		return handler(ctx, req)
+	}))
+	withToken := func(token string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), authorizationKey, auth.TokenHeaderPrefix+token)
+	}
+
+	c := NewAPIClient(conn)
+	_, err := c.GetFile(withToken(auth.AccessTokenPrefix+"read"), &GetFileRequest{Uri: "cloudreve://my/a"})
+	a.NoError(err)
+
+	// Unauthenticated calls are rejected before interceptors.
+	_, err = c.GetFile(context.Background(), &GetFileRequest{})
+	a.Equal(codes.Unauthenticated, status.Code(err))
+
+	// Interceptor errors are sent with their error code.
+	var trailer metadata.MD
+	_, err = c.ListShares(withToken(auth.AccessTokenPrefix+"share"), &ListSharesRequest{}, grpc.Trailer(&trailer))
+	a.Equal(codes.Unavailable, status.Code(err))
+	a.Equal([]string{strconv.Itoa(serializer.CodeMaintenance)}, trailer.Get(ErrorCodeKey))
+}
+
+func TestServer_Reflection(t *testing.T) {
+	a := assert.New(t)
+	conn := newTestConn(t)
+	listServices := func(ctx context.Context) ([]string, error) {
+		stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		req := &rpb.ServerReflectionRequest{MessageRequest: &rpb.ServerReflectionRequest_ListServices{}}
+		if err := stream.Send(req); err != nil {
+			return nil, err
+		}
+
+		res, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+
+		names := make([]string, 0)
+		for _, s := range res.GetListServicesResponse().GetService() {
+			names = append(names, s.GetName())
+		}
+		return names, nil
+	}
+
+	// Any valid token is allowed.
+	ctx := metadata.AppendToOutgoingContext(context.Background(), authorizationKey, auth.TokenHeaderPrefix+auth.AccessTokenPrefix+"share")
+	names, err := listServices(ctx)
+	a.NoError(err)
+	a.Contains(names, API_ServiceDesc.ServiceName)
+
+	_, err = listServices(context.Background())
+	a.Equal(codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,131 @@
+// Package apiversion negotiates versions of the HTTP API. Each version is served under its own URL
+// prefix with the same routes, handlers always respond in the shape of Latest, responses of older
+// versions are converted back by registered shims.
+package apiversion
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	V4 = 4
+	V5 = 5
+
+	// Latest is the version handlers respond in.
+	Latest = V5
+)
+
+// Supported are versions served by master, from oldest to latest.
+var Supported = []int{V4, V5}
+
+type (
+	// VersionCtx is the context key of the API version requested by client.
+	VersionCtx struct{}
+
+	// Shim converts a JSON response of Version into the shape of the previous version. It is
+	// applied to responses of route Method and Route, if the requested version is older than Version.
+	Shim struct {
+		Method string
+		// Route is the route path relative to the version prefix, e.g. "/file/info".
+		Route   string
+		Version int
+		// Apply converts the decoded JSON body in place, or returns a new one.
+		Apply func(body any) any
+	}
+)
+
+var (
+	mu    sync.RWMutex
+	shims = make(map[string][]Shim)
+)
+
+// Prefix returns the URL prefix of given version.
+func Prefix(version int) string {
+	return fmt.Sprintf("/api/v%d", version)
+}
+
+// TrimPrefix returns path relative to the version prefix, and the version of the prefix. ok is false
+// if path is not under prefix of any supported version.
+func TrimPrefix(path string) (rel string, version int, ok bool) {
+	rest, found := strings.CutPrefix(path, "/api/v")
+	if !found {
+		return path, 0, false
+	}
+
+	end := strings.IndexByte(rest, '/')
+	if end < 0 {
+		end = len(rest)
+	}
+
+	version, err := strconv.Atoi(rest[:end])
+	if err != nil || !IsSupported(version) {
+		return path, 0, false
+	}
+
+	return rest[end:], version, true
+}
+
+// IsSupported returns whether version is served.
+func IsSupported(version int) bool {
+	for _, v := range Supported {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+// FromContext returns the API version requested by client, Latest if not specified.
+func FromContext(ctx context.Context) int {
+	if v, ok := ctx.Value(VersionCtx{}).(int); ok {
+		return v
+	}
+
+	return Latest
+}
+
+// Register adds response shims, usually called in init of the package introducing breaking changes.
+func Register(s ...Shim) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for _, shim := range s {
+		key := shimKey(shim.Method, shim.Route)
+		shims[key] = append(shims[key], shim)
+		sort.SliceStable(shims[key], func(i, j int) bool {
+			return shims[key][i].Version > shims[key][j].Version
+		})
+	}
+}
+
+// ShimsFor returns shims to be applied to responses of given route for version, from the newest to the oldest.
+func ShimsFor(method, route string, version int) []Shim {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	var res []Shim
+	for _, shim := range shims[shimKey(method, route)] {
+		if shim.Version > version {
+			res = append(res, shim)
+		}
+	}
+
+	return res
+}
+
+// Downgrade applies shims to the decoded JSON body in order.
+func Downgrade(body any, s []Shim) any {
+	for _, shim := range s {
+		body = shim.Apply(body)
+	}
+	return body
+}
+
+func shimKey(method, route string) string {
+	return method + " " + route
+}
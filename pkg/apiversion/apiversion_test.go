@@ -0,0 +1,59 @@
+package apiversion
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrimPrefix(t *testing.T) {
+	a := assert.New(t)
+
+	rel, version, ok := TrimPrefix("/api/v5/file/info")
+	a.True(ok)
+	a.Equal("/file/info", rel)
+	a.Equal(V5, version)
+
+	rel, version, ok = TrimPrefix("/api/v4")
+	a.True(ok)
+	a.Equal("", rel)
+	a.Equal(V4, version)
+
+	_, _, ok = TrimPrefix("/api/v3/file")
+	a.False(ok)
+	_, _, ok = TrimPrefix("/dav/file")
+	a.False(ok)
+}
+
+func TestFromContext(t *testing.T) {
+	a := assert.New(t)
+	a.Equal(Latest, FromContext(context.Background()))
+	a.Equal(V4, FromContext(context.WithValue(context.Background(), VersionCtx{}, V4)))
+}
+
+func TestShims(t *testing.T) {
+	a := assert.New(t)
+	rename := func(from, to string) func(any) any {
+		return func(body any) any {
+			m := body.(map[string]any)
+			m[to] = m[from]
+			delete(m, from)
+			return m
+		}
+	}
+
+	Register(
+		Shim{Method: http.MethodGet, Route: "/test/shim", Version: 5, Apply: rename("c", "b")},
+		Shim{Method: http.MethodGet, Route: "/test/shim", Version: 6, Apply: rename("d", "c")},
+	)
+
+	a.Empty(ShimsFor(http.MethodGet, "/test/shim", 6))
+	a.Empty(ShimsFor(http.MethodPost, "/test/shim", 4))
+	a.Len(ShimsFor(http.MethodGet, "/test/shim", 5), 1)
+
+	s := ShimsFor(http.MethodGet, "/test/shim", 4)
+	a.Len(s, 2)
+	a.Equal(map[string]any{"b": 1.0}, Downgrade(map[string]any{"d": 1.0}, s))
+}
@@ -0,0 +1,235 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/requestinfo"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
+)
+
+// EventType is the type of an audit event.
+type EventType string
+
+const (
+	// EventImpersonateStart an admin started an impersonation session.
+	EventImpersonateStart = EventType("impersonate_start")
+	// EventImpersonatedRequest a request is made within an impersonation session.
+	EventImpersonatedRequest = EventType("impersonated_request")
+	// EventDavRequest a request is made with a WebDAV account.
+	EventDavRequest = EventType("dav_request")
+	// EventLegalHoldBlocked an operation is blocked by legal hold.
+	EventLegalHoldBlocked = EventType("legal_hold_blocked")
+	// EventLegalHoldChanged an admin placed or released legal hold on a file or user.
+	EventLegalHoldChanged = EventType("legal_hold_changed")
+	// EventShareQuarantined a public share is disabled by content moderation.
+	EventShareQuarantined = EventType("share_quarantined")
+	// EventModerationReviewed an admin approved or rejected a quarantined share.
+	EventModerationReviewed = EventType("moderation_reviewed")
+	// EventTakeoutRequested an admin requested an export of personal data of a user.
+	EventTakeoutRequested = EventType("takeout_requested")
+	// EventLockReleased an admin forcibly released locks on files of a user.
+	EventLockReleased = EventType("lock_released")
+)
+
+const (
+	// EntityUser the event targets a user.
+	EntityUser = "user"
+	// EntityDavAccount the event targets a WebDAV account.
+	EntityDavAccount = "dav_account"
+	// EntityFile the event targets a file or folder.
+	EntityFile = "file"
+	// EntityShare the event targets a share link.
+	EntityShare = "share"
+)
+
+// queryPageSize is the number of events fetched from database at a time.
+const queryPageSize = 500
+
+// Event is a single audit log entry.
+type Event struct {
+	// ID is assigned once the event is recorded.
+	ID            string            `json:"id"`
+	Type          EventType         `json:"type"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UserID        int               `json:"user_id,omitempty"`
+	ActorID       int               `json:"actor_id,omitempty"`
+	IP            string            `json:"ip,omitempty"`
+	UserAgent     string            `json:"user_agent,omitempty"`
+	CorrelationID string            `json:"correlation_id,omitempty"`
+	EntityType    string            `json:"entity_type,omitempty"`
+	EntityID      int               `json:"entity_id,omitempty"`
+	Content       map[string]string `json:"content,omitempty"`
+}
+
+// Recorder persists audit events.
+type Recorder interface {
+	// Record appends an event to the audit log.
+	Record(ctx context.Context, e *Event) error
+	// Query calls fn for each event matching args, newest first. Iteration stops once fn returns false.
+	Query(ctx context.Context, args *QueryArgs, fn func(e *Event) bool) error
+	// List returns at most limit events matching args after skipping offset of them, newest first,
+	// along with the total number of matching events.
+	List(ctx context.Context, args *QueryArgs, offset, limit int) ([]*Event, int, error)
+	// Prune deletes audit logs recorded before given time, returns the number of deleted events.
+	Prune(ctx context.Context, before time.Time) (int, error)
+	// Close releases underlying resources.
+	Close() error
+}
+
+// QueryArgs filters audit events. Zero value fields are ignored.
+type QueryArgs struct {
+	ActorID    int
+	UserID     int
+	EntityType string
+	Types      []EventType
+	IP         string
+	From       time.Time
+	To         time.Time
+}
+
+// NewEvent creates a new event of given type, request info and correlation ID are filled from context.
+func NewEvent(ctx context.Context, t EventType, uid, actor int, content map[string]string) *Event {
+	e := &Event{
+		Type:      t,
+		CreatedAt: time.Now(),
+		UserID:    uid,
+		ActorID:   actor,
+		Content:   content,
+	}
+
+	if info := requestinfo.RequestInfoFromContext(ctx); info != nil {
+		e.IP = info.IP
+		e.UserAgent = info.UserAgent
+	}
+
+	if cid := logging.CorrelationID(ctx); cid != uuid.Nil {
+		e.CorrelationID = cid.String()
+	}
+
+	return e
+}
+
+// WithEntity sets the entity this event targets.
+func (e *Event) WithEntity(t string, id int) *Event {
+	e.EntityType = t
+	e.EntityID = id
+	return e
+}
+
+// NewDBRecorder creates a Recorder that stores events in database, shared by all nodes.
+func NewDBRecorder(client inventory.AuditLogClient) Recorder {
+	return &dbRecorder{client: client}
+}
+
+type dbRecorder struct {
+	client inventory.AuditLogClient
+}
+
+func (r *dbRecorder) Record(ctx context.Context, e *Event) error {
+	log, err := r.client.Create(ctx, &inventory.AuditLogArgs{
+		Type:          string(e.Type),
+		CreatedAt:     e.CreatedAt,
+		UserID:        e.UserID,
+		ActorID:       e.ActorID,
+		IP:            e.IP,
+		UserAgent:     e.UserAgent,
+		CorrelationID: e.CorrelationID,
+		EntityType:    e.EntityType,
+		EntityID:      e.EntityID,
+		Content:       e.Content,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write audit event: %w", err)
+	}
+
+	e.ID = strconv.Itoa(log.ID)
+	return nil
+}
+
+func (r *dbRecorder) Query(ctx context.Context, args *QueryArgs, fn func(e *Event) bool) error {
+	listArgs := listArgsFromQuery(args)
+	listArgs.PageSize = queryPageSize
+
+	for {
+		logs, err := r.client.List(ctx, listArgs)
+		if err != nil {
+			return fmt.Errorf("failed to query audit events: %w", err)
+		}
+
+		for _, log := range logs {
+			if !fn(eventFromModel(log)) {
+				return nil
+			}
+		}
+
+		if len(logs) == 0 {
+			return nil
+		}
+
+		listArgs.BeforeID = logs[len(logs)-1].ID
+	}
+}
+
+func (r *dbRecorder) List(ctx context.Context, args *QueryArgs, offset, limit int) ([]*Event, int, error) {
+	listArgs := listArgsFromQuery(args)
+	total, err := r.client.Count(ctx, listArgs)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit events: %w", err)
+	}
+
+	listArgs.Offset = offset
+	listArgs.PageSize = limit
+	logs, err := r.client.List(ctx, listArgs)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query audit events: %w", err)
+	}
+
+	return lo.Map(logs, func(log *ent.AuditLog, _ int) *Event {
+		return eventFromModel(log)
+	}), total, nil
+}
+
+func listArgsFromQuery(args *QueryArgs) *inventory.ListAuditLogArgs {
+	return &inventory.ListAuditLogArgs{
+		ActorID:    args.ActorID,
+		UserID:     args.UserID,
+		EntityType: args.EntityType,
+		Types: lo.Map(args.Types, func(t EventType, _ int) string {
+			return string(t)
+		}),
+		IP:   args.IP,
+		From: args.From,
+		To:   args.To,
+	}
+}
+
+func (r *dbRecorder) Prune(ctx context.Context, before time.Time) (int, error) {
+	return r.client.DeleteBefore(ctx, before)
+}
+
+func (r *dbRecorder) Close() error {
+	return nil
+}
+
+func eventFromModel(log *ent.AuditLog) *Event {
+	return &Event{
+		ID:            strconv.Itoa(log.ID),
+		Type:          EventType(log.Type),
+		CreatedAt:     log.CreatedAt,
+		UserID:        log.UserID,
+		ActorID:       log.ActorID,
+		IP:            log.IP,
+		UserAgent:     log.UserAgent,
+		CorrelationID: log.CorrelationID,
+		EntityType:    log.EntityType,
+		EntityID:      log.EntityID,
+		Content:       log.Content,
+	}
+}
@@ -0,0 +1,126 @@
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	_ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRecorder(t *testing.T) *dbRecorder {
+	drv, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	return &dbRecorder{client: inventory.NewAuditLogClient(client, conf.SQLiteDB)}
+}
+
+func TestDBRecorder_Query(t *testing.T) {
+	a := assert.New(t)
+	r := newTestRecorder(t)
+
+	ctx := context.Background()
+	yesterday := time.Now().AddDate(0, 0, -1)
+	events := []*Event{
+		{Type: EventImpersonateStart, CreatedAt: yesterday, ActorID: 1, UserID: 2, IP: "1.1.1.1"},
+		{Type: EventImpersonatedRequest, CreatedAt: time.Now(), ActorID: 1, UserID: 2, IP: "1.1.1.1"},
+		{Type: EventImpersonateStart, CreatedAt: time.Now(), ActorID: 3, UserID: 4, IP: "2.2.2.2",
+			EntityType: EntityUser, EntityID: 4, Content: map[string]string{"expires": "1"}},
+	}
+	for _, e := range events {
+		a.NoError(r.Record(ctx, e))
+		a.NotEmpty(e.ID)
+	}
+
+	collect := func(args *QueryArgs) []string {
+		var ids []string
+		a.NoError(r.Query(ctx, args, func(e *Event) bool {
+			ids = append(ids, e.ID)
+			return true
+		}))
+		return ids
+	}
+
+	first, second, third := events[0].ID, events[1].ID, events[2].ID
+	a.Equal([]string{third, second, first}, collect(&QueryArgs{}))
+	a.Equal([]string{second, first}, collect(&QueryArgs{ActorID: 1}))
+	a.Equal([]string{third, first}, collect(&QueryArgs{Types: []EventType{EventImpersonateStart}}))
+	a.Equal([]string{third}, collect(&QueryArgs{EntityType: EntityUser}))
+	a.Equal([]string{third}, collect(&QueryArgs{IP: "2.2.2.2"}))
+	a.Equal([]string{third, second}, collect(&QueryArgs{From: time.Now().Add(-time.Hour)}))
+	a.Equal([]string{first}, collect(&QueryArgs{To: time.Now().Add(-time.Hour)}))
+
+	var got []*Event
+	a.NoError(r.Query(ctx, &QueryArgs{}, func(e *Event) bool {
+		got = append(got, e)
+		return false
+	}))
+	a.Len(got, 1)
+	a.Equal(map[string]string{"expires": "1"}, got[0].Content)
+}
+
+func TestDBRecorder_List(t *testing.T) {
+	a := assert.New(t)
+	r := newTestRecorder(t)
+
+	ctx := context.Background()
+	var ids []string
+	for i := 0; i < 5; i++ {
+		e := &Event{Type: EventImpersonateStart, CreatedAt: time.Now(), ActorID: 1 + i%2}
+		a.NoError(r.Record(ctx, e))
+		ids = append([]string{e.ID}, ids...)
+	}
+
+	idsOf := func(events []*Event) []string {
+		res := make([]string, 0, len(events))
+		for _, e := range events {
+			res = append(res, e.ID)
+		}
+		return res
+	}
+
+	events, total, err := r.List(ctx, &QueryArgs{}, 0, 2)
+	a.NoError(err)
+	a.Equal(5, total)
+	a.Equal(ids[:2], idsOf(events))
+
+	events, total, err = r.List(ctx, &QueryArgs{}, 4, 2)
+	a.NoError(err)
+	a.Equal(5, total)
+	a.Equal(ids[4:], idsOf(events))
+
+	events, total, err = r.List(ctx, &QueryArgs{ActorID: 1}, 1, 10)
+	a.NoError(err)
+	a.Equal(3, total)
+	a.Equal([]string{ids[2], ids[4]}, idsOf(events))
+}
+
+func TestDBRecorder_Prune(t *testing.T) {
+	a := assert.New(t)
+	r := newTestRecorder(t)
+
+	ctx := context.Background()
+	a.NoError(r.Record(ctx, &Event{Type: EventImpersonateStart, CreatedAt: time.Now().AddDate(0, 0, -10)}))
+	a.NoError(r.Record(ctx, &Event{Type: EventImpersonateStart, CreatedAt: time.Now()}))
+
+	deleted, err := r.Prune(ctx, time.Now().AddDate(0, 0, -5))
+	a.NoError(err)
+	a.Equal(1, deleted)
+
+	deleted, err = r.Prune(ctx, time.Now().AddDate(0, 0, -5))
+	a.NoError(err)
+	a.Equal(0, deleted)
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
@@ -54,3 +55,29 @@ func (auth HMACAuth) Check(body string, sign string) error {
 	}
 	return nil
 }
+
+// SwappableAuth delegates to an Auth instance that can be replaced at runtime, e.g. when the
+// secret key is reloaded.
+type SwappableAuth struct {
+	inner atomic.Pointer[Auth]
+}
+
+// NewSwappableAuth creates a SwappableAuth delegating to a.
+func NewSwappableAuth(a Auth) *SwappableAuth {
+	s := &SwappableAuth{}
+	s.Swap(a)
+	return s
+}
+
+// Swap replaces the underlying Auth instance.
+func (s *SwappableAuth) Swap(a Auth) {
+	s.inner.Store(&a)
+}
+
+func (s *SwappableAuth) Sign(body string, expires int64) string {
+	return (*s.inner.Load()).Sign(body, expires)
+}
+
+func (s *SwappableAuth) Check(body string, sign string) error {
+	return (*s.inner.Load()).Check(body, sign)
+}
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type (
+	// ImpersonatorCtx context key for ID of admin impersonating current user.
+	ImpersonatorCtx struct{}
+)
+
+// ImpersonatorFromContext returns ID of admin impersonating current user. The second return value
+// is false if current request is not within an impersonation session.
+func ImpersonatorFromContext(ctx context.Context) (int, bool) {
+	uid, ok := ctx.Value(ImpersonatorCtx{}).(int)
+	return uid, ok && uid > 0
+}
+
+func (t *tokenAuth) IssueImpersonation(ctx context.Context, u *ent.User, impersonator int) (*Token, error) {
+	issueDate := time.Now()
+	expires := issueDate.Add(t.s.TokenAuth(ctx).ImpersonationTTL)
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{
+		TokenType:    TokenTypeAccess,
+		Impersonator: hashid.EncodeUserID(t.idEncoder, impersonator),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   hashid.EncodeUserID(t.idEncoder, u.ID),
+			NotBefore: jwt.NewNumericDate(issueDate),
+			ExpiresAt: jwt.NewNumericDate(expires),
+		},
+	}).SignedString(t.secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign impersonation token: %w", err)
+	}
+
+	return &Token{
+		AccessToken:   accessToken,
+		AccessExpires: expires,
+		UID:           u.ID,
+	}, nil
+}
@@ -32,6 +32,11 @@ type TokenAuth interface {
 	Refresh(ctx context.Context, refreshToken string) (*Token, error)
 	// Claims parses the given token string and returns the claims.
 	Claims(ctx context.Context, tokenStr string) (*Claims, error)
+	// IssueImpersonation issues a short-lived access token for admin to act as the given user.
+	// No refresh token is issued, the session ends when the access token expires.
+	IssueImpersonation(ctx context.Context, u *ent.User, impersonator int) (*Token, error)
+	// VerifyAccessToken verifies the given personal access token, returns ID of its owner and granted scopes.
+	VerifyAccessToken(ctx context.Context, token string) (int, []string, error)
 }
 
 // Token stores token pair for authentication
@@ -61,6 +66,9 @@ const (
 	AuthorizationHeader = "Authorization"
 	TokenHeaderPrefix   = "Bearer "
 	RevokeTokenPrefix   = "jwt_revoke_"
+	// WebSocketTokenProtocolPrefix prefixes the token offered as a WebSocket subprotocol, since browsers
+	// cannot set Authorization header in WebSocket handshakes.
+	WebSocketTokenProtocolPrefix = "cloudreve.bearer."
 )
 
 type Claims struct {
@@ -68,6 +76,8 @@ type Claims struct {
 	jwt.RegisteredClaims
 	StateHash   []byte     `json:"state_hash,omitempty"`
 	RootTokenID *uuid.UUID `json:"root_token_id,omitempty"`
+	// Impersonator is the encoded ID of admin who issued this token on behalf of the subject.
+	Impersonator string `json:"impersonator,omitempty"`
 }
 
 // NewTokenAuth creates a new token based auth provider.
@@ -164,6 +174,17 @@ func (t *tokenAuth) VerifyAndRetrieveUser(c *gin.Context) (bool, error) {
 		return true, nil
 	}
 
+	if strings.HasPrefix(tokenString, AccessTokenPrefix) {
+		uid, scopes, err := t.verifyAccessToken(c, tokenString)
+		if err != nil {
+			return false, serializer.NewError(serializer.CodeCredentialInvalid, "Invalid access token", err)
+		}
+
+		util.WithValue(c, inventory.UserIDCtx{}, uid)
+		util.WithValue(c, AccessTokenScopesCtx{}, scopes)
+		return false, nil
+	}
+
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
 		return t.secret, nil
 	})
@@ -183,6 +204,15 @@ func (t *tokenAuth) VerifyAndRetrieveUser(c *gin.Context) (bool, error) {
 		return false, serializer.NewError(serializer.CodeNotFound, "User not found", err)
 	}
 
+	if claims.Impersonator != "" {
+		impersonator, err := t.idEncoder.Decode(claims.Impersonator, hashid.UserID)
+		if err != nil {
+			return false, serializer.NewError(serializer.CodeCredentialInvalid, "Invalid impersonator", err)
+		}
+
+		util.WithValue(c, ImpersonatorCtx{}, impersonator)
+	}
+
 	util.WithValue(c, inventory.UserIDCtx{}, uid)
 	return false, nil
 }
@@ -0,0 +1,155 @@
+package auth
+
+import (
+	"encoding/gob"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const (
+	loginFailureUserPrefix = "login_failure_user_"
+	loginFailureIPPrefix   = "login_failure_ip_"
+	loginLockoutsSuffix    = "lockouts_"
+	loginLockedSuffix      = "locked_"
+)
+
+func init() {
+	gob.Register(time.Time{})
+}
+
+// LoginSubject returns the subject failed logins of an account are counted by. Attempts on unknown
+// login names are counted by the name, so that they are locked just like existing accounts.
+func LoginSubject(u *ent.User, name string) string {
+	if u != nil {
+		return "u" + strconv.Itoa(u.ID)
+	}
+
+	return "n" + strings.ToLower(strings.TrimSpace(name))
+}
+
+// LoginLocks returns lockout state of given account subject and client IP. Pass empty subject to only
+// check the IP. The returned time is zero if neither is locked.
+func LoginLocks(kv cache.Driver, p *setting.LoginProtection, subject string, ip string) time.Time {
+	if !p.Enabled {
+		return time.Time{}
+	}
+
+	now := time.Now()
+	var lockedUntil time.Time
+	for _, prefix := range loginFailurePrefixes(subject, ip) {
+		if v, ok := kv.Get(prefix + loginLockedSuffix); ok {
+			if until, ok := v.(time.Time); ok && until.After(now) && until.After(lockedUntil) {
+				lockedUntil = until
+			}
+		}
+	}
+
+	return lockedUntil
+}
+
+// RecordLoginFailure records a failed login attempt of given account subject and client IP. If the
+// account becomes locked by this attempt, the lockout expiry is returned.
+func RecordLoginFailure(kv cache.Driver, p *setting.LoginProtection, subject string, ip string) (*time.Time, error) {
+	if !p.Enabled {
+		return nil, nil
+	}
+
+	var lockedUntil *time.Time
+	if subject != "" {
+		locked, err := recordLoginFailure(kv, p, loginFailureUserPrefix+subject+"_", p.MaxAttempts)
+		if err != nil {
+			return nil, err
+		}
+		lockedUntil = locked
+	}
+
+	if ip != "" {
+		if _, err := recordLoginFailure(kv, p, loginFailureIPPrefix+ip+"_", p.IPMaxAttempts); err != nil {
+			return nil, err
+		}
+	}
+
+	return lockedUntil, nil
+}
+
+// ResetLoginFailure clears failed login records of given account subject, used after successful login
+// or by admin unlock.
+func ResetLoginFailure(kv cache.Driver, subject string) error {
+	return kv.Delete(loginFailureUserPrefix+subject+"_", "", loginLockoutsSuffix, loginLockedSuffix)
+}
+
+// ResetLoginFailureIP clears failed login records of given client IP.
+func ResetLoginFailureIP(kv cache.Driver, ip string) error {
+	return kv.Delete(loginFailureIPPrefix+ip+"_", "", loginLockoutsSuffix, loginLockedSuffix)
+}
+
+// recordLoginFailure counts a failure under prefix. Failures are counted in a window starting from
+// the first one, the subject is locked when the count reaches maxAttempts.
+func recordLoginFailure(kv cache.Driver, p *setting.LoginProtection, prefix string, maxAttempts int) (*time.Time, error) {
+	if maxAttempts <= 0 {
+		return nil, nil
+	}
+
+	count, err := kv.Incr(prefix, 1, p.FailureWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count login failure: %w", err)
+	}
+
+	// Only the attempt that reaches the limit locks the subject, so that concurrent failures do not
+	// stack up lockouts.
+	if count != maxAttempts {
+		return nil, nil
+	}
+
+	// Keep the lockout count long enough so that it survives between lockouts.
+	lockouts, err := kv.Incr(prefix+loginLockoutsSuffix, 1, p.FailureWindow+p.MaxLockoutDuration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count lockouts: %w", err)
+	}
+
+	d := lockoutDuration(p, lockouts)
+	lockedUntil := time.Now().Add(d)
+	if err := kv.Set(prefix+loginLockedSuffix, lockedUntil, int(math.Ceil(d.Seconds()))); err != nil {
+		return nil, fmt.Errorf("failed to save lockout: %w", err)
+	}
+
+	if err := kv.Delete(prefix, ""); err != nil {
+		return nil, fmt.Errorf("failed to reset login failure count: %w", err)
+	}
+
+	return &lockedUntil, nil
+}
+
+// lockoutDuration returns the duration of the n-th lockout, doubled on every lockout and capped by max duration.
+func lockoutDuration(p *setting.LoginProtection, n int) time.Duration {
+	max := time.Duration(p.MaxLockoutDuration) * time.Second
+	d := time.Duration(p.LockoutDuration) * time.Second
+	for i := 1; i < n && d < max; i++ {
+		d *= 2
+	}
+
+	if max > 0 && d > max {
+		d = max
+	}
+
+	return d
+}
+
+func loginFailurePrefixes(subject string, ip string) []string {
+	prefixes := make([]string, 0, 2)
+	if subject != "" {
+		prefixes = append(prefixes, loginFailureUserPrefix+subject+"_")
+	}
+	if ip != "" {
+		prefixes = append(prefixes, loginFailureIPPrefix+ip+"_")
+	}
+
+	return prefixes
+}
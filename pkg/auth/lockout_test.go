@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockoutDuration(t *testing.T) {
+	a := assert.New(t)
+	p := &setting.LoginProtection{LockoutDuration: 60, MaxLockoutDuration: 300}
+
+	a.Equal(60*time.Second, lockoutDuration(p, 1))
+	a.Equal(120*time.Second, lockoutDuration(p, 2))
+	a.Equal(240*time.Second, lockoutDuration(p, 3))
+	a.Equal(300*time.Second, lockoutDuration(p, 4))
+	a.Equal(300*time.Second, lockoutDuration(p, 100))
+}
+
+func TestRecordLoginFailure(t *testing.T) {
+	a := assert.New(t)
+	kv := cache.NewMemoStore("", nil)
+	p := &setting.LoginProtection{
+		Enabled:            true,
+		MaxAttempts:        3,
+		FailureWindow:      60,
+		LockoutDuration:    60,
+		MaxLockoutDuration: 300,
+	}
+	subject := LoginSubject(nil, " Foo@Example.com ")
+	a.Equal("nfoo@example.com", subject)
+
+	for i := 0; i < 2; i++ {
+		locked, err := RecordLoginFailure(kv, p, subject, "")
+		a.NoError(err)
+		a.Nil(locked)
+	}
+	a.True(LoginLocks(kv, p, subject, "").IsZero())
+
+	locked, err := RecordLoginFailure(kv, p, subject, "")
+	a.NoError(err)
+	a.NotNil(locked)
+	a.Equal(*locked, LoginLocks(kv, p, subject, ""))
+
+	a.NoError(ResetLoginFailure(kv, subject))
+	a.True(LoginLocks(kv, p, subject, "").IsZero())
+}
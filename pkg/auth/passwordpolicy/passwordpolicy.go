@@ -0,0 +1,126 @@
+package passwordpolicy
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const (
+	hibpRangeEndpoint = "https://api.pwnedpasswords.com/range/"
+	hibpTimeout       = 5 * time.Second
+)
+
+// Validate checks a new password against password policy. u is the user whose password is being
+// changed, or nil for new users. Breach check is skipped with a warning if the lookup service is
+// unavailable, so that an outage does not block password changes.
+func Validate(ctx context.Context, p *setting.PasswordPolicy, client request.Client, l logging.Logger,
+	u *ent.User, password string) error {
+	if err := CheckComplexity(p, password); err != nil {
+		return serializer.NewError(serializer.CodeWeakPassword, err.Error(), nil)
+	}
+
+	if u != nil && inventory.PasswordReused(u, password, p.History) {
+		return serializer.NewError(serializer.CodeWeakPassword, "Password has been used recently", nil)
+	}
+
+	if p.BreachCheck {
+		breached, err := Breached(ctx, client, password)
+		if err != nil {
+			l.Warning("Failed to check password breach: %s", err)
+		} else if breached {
+			return serializer.NewError(serializer.CodeWeakPassword, "Password has appeared in a data breach", nil)
+		}
+	}
+
+	return nil
+}
+
+// CheckComplexity checks length and character class requirements.
+func CheckComplexity(p *setting.PasswordPolicy, password string) error {
+	if len([]rune(password)) < p.MinLength {
+		return fmt.Errorf("password must be at least %d characters", p.MinLength)
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+
+	switch {
+	case p.RequireUpper && !upper:
+		return fmt.Errorf("password must contain an uppercase letter")
+	case p.RequireLower && !lower:
+		return fmt.Errorf("password must contain a lowercase letter")
+	case p.RequireDigit && !digit:
+		return fmt.Errorf("password must contain a digit")
+	case p.RequireSymbol && !symbol:
+		return fmt.Errorf("password must contain a symbol")
+	}
+
+	return nil
+}
+
+// Expired returns true if password of the user must be rotated.
+func Expired(p *setting.PasswordPolicy, u *ent.User) bool {
+	if p.MaxAge <= 0 || u.Password == "" {
+		return false
+	}
+
+	return time.Since(inventory.PasswordChangedAt(u)) > p.MaxAge
+}
+
+// Breached looks up the password in Have I Been Pwned using k-anonymity: only the first 5
+// characters of its SHA-1 digest are sent.
+func Breached(ctx context.Context, client request.Client, password string) (bool, error) {
+	digest := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(digest[:]))
+	prefix, suffix := hash[:5], hash[5:]
+
+	res, err := client.Request(http.MethodGet, hibpRangeEndpoint+prefix, nil,
+		request.WithContext(ctx),
+		request.WithTimeout(hibpTimeout),
+		request.WithHeader(http.Header{"Add-Padding": []string{"true"}}),
+	).CheckHTTPResponse(http.StatusOK).GetResponse()
+	if err != nil {
+		return false, fmt.Errorf("failed to query breached passwords: %w", err)
+	}
+
+	return matchRange(res, suffix), nil
+}
+
+// matchRange returns true if the suffix is listed in range response with a non-zero count.
+// Padding entries have zero count.
+func matchRange(body, suffix string) bool {
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		hashSuffix, count, found := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if found && strings.EqualFold(hashSuffix, suffix) && count != "0" {
+			return true
+		}
+	}
+
+	return false
+}
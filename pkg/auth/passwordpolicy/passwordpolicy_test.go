@@ -0,0 +1,30 @@
+package passwordpolicy
+
+import (
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckComplexity(t *testing.T) {
+	a := assert.New(t)
+	p := &setting.PasswordPolicy{MinLength: 8, RequireUpper: true, RequireLower: true, RequireDigit: true, RequireSymbol: true}
+
+	a.Error(CheckComplexity(p, "Ab1!"))
+	a.Error(CheckComplexity(p, "abcdefg1!"))
+	a.Error(CheckComplexity(p, "ABCDEFG1!"))
+	a.Error(CheckComplexity(p, "Abcdefgh!"))
+	a.Error(CheckComplexity(p, "Abcdefgh1"))
+	a.NoError(CheckComplexity(p, "Abcdefg1!"))
+	a.NoError(CheckComplexity(&setting.PasswordPolicy{MinLength: 6}, "123456"))
+}
+
+func TestMatchRange(t *testing.T) {
+	a := assert.New(t)
+	body := "0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n00D4F6E8FA6EECAD2A3AA415EEC418D38EC:0\r\n"
+
+	a.True(matchRange(body, "0018a45c4d1def81644b54ab7f969b88d65"))
+	a.False(matchRange(body, "00D4F6E8FA6EECAD2A3AA415EEC418D38EC"))
+	a.False(matchRange(body, "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF"))
+}
@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/samber/lo"
+)
+
+// Scope is a permission that can be granted to a personal access token.
+type Scope string
+
+const (
+	// ScopeFileRead allows listing files and getting file content.
+	ScopeFileRead = Scope("files.read")
+	// ScopeFileUpload allows creating files and uploading content.
+	ScopeFileUpload = Scope("files.upload")
+	// ScopeFileWrite allows overwriting content of existing files and checking them out. It does not
+	// allow renaming, moving or deleting files, see ScopeFileManage.
+	ScopeFileWrite = Scope("files.write")
+	// ScopeFileManage allows renaming, moving, copying and deleting files.
+	ScopeFileManage = Scope("files.manage")
+	// ScopeShareManage allows creating, editing and deleting share links.
+	ScopeShareManage = Scope("shares.manage")
+	// ScopeWebDAV allows managing WebDAV accounts. Read-write accounts also require ScopeFileUpload,
+	// ScopeFileWrite and ScopeFileManage.
+	ScopeWebDAV = Scope("webdav")
+)
+
+const (
+	AccessTokenPrefix = "cr_pat_"
+	// accessTokenUsedAtPrecision limits how often last-used time is persisted.
+	accessTokenUsedAtPrecision = 5 * time.Minute
+)
+
+type (
+	// AccessTokenScopesCtx context key for scopes of the personal access token used in current request.
+	AccessTokenScopesCtx struct{}
+)
+
+var (
+	ErrInvalidAccessToken = errors.New("invalid access token")
+	ErrAccessTokenExpired = errors.New("access token expired")
+
+	AllScopes = []Scope{ScopeFileRead, ScopeFileUpload, ScopeFileWrite, ScopeFileManage, ScopeShareManage, ScopeWebDAV}
+)
+
+// IsValidScope returns true if given scope is known.
+func IsValidScope(s string) bool {
+	return lo.Contains(AllScopes, Scope(s))
+}
+
+// NewAccessToken generates a new personal access token for given user. The plain token is returned
+// along with the record that should be persisted, plain token will not be recoverable afterward.
+func NewAccessToken(idEncoder hashid.Encoder, uid int, name string, scopes []string, expires *time.Time) (string, *ent.AccessToken, error) {
+	id, err := randomHex(6)
+	if err != nil {
+		return "", nil, err
+	}
+
+	secret, err := randomHex(20)
+	if err != nil {
+		return "", nil, err
+	}
+
+	token := fmt.Sprintf("%s%s_%s_%s", AccessTokenPrefix, hashid.EncodeUserID(idEncoder, uid), id, secret)
+	return token, &ent.AccessToken{
+		UserID:    uid,
+		TokenID:   id,
+		Name:      name,
+		Scopes:    lo.Uniq(scopes),
+		Digest:    digestAccessTokenSecret(secret),
+		ExpiresAt: expires,
+	}, nil
+}
+
+// ParseAccessToken splits a plain token into owner ID, token ID and secret.
+func ParseAccessToken(idEncoder hashid.Encoder, token string) (int, string, string, error) {
+	if !strings.HasPrefix(token, AccessTokenPrefix) {
+		return 0, "", "", ErrInvalidAccessToken
+	}
+
+	parts := strings.Split(strings.TrimPrefix(token, AccessTokenPrefix), "_")
+	if len(parts) != 3 {
+		return 0, "", "", ErrInvalidAccessToken
+	}
+
+	uid, err := idEncoder.Decode(parts[0], hashid.UserID)
+	if err != nil {
+		return 0, "", "", ErrInvalidAccessToken
+	}
+
+	return uid, parts[1], parts[2], nil
+}
+
+// MatchAccessToken checks the secret and expiry of given token record.
+func MatchAccessToken(t *ent.AccessToken, secret string) error {
+	if subtle.ConstantTimeCompare([]byte(t.Digest), []byte(digestAccessTokenSecret(secret))) != 1 {
+		return ErrInvalidAccessToken
+	}
+
+	if t.ExpiresAt != nil && t.ExpiresAt.Before(time.Now()) {
+		return ErrAccessTokenExpired
+	}
+
+	return nil
+}
+
+// AccessTokenScopesFromContext returns scopes of the personal access token used in current request.
+// The second return value is false if current request is not authenticated by a personal access token.
+func AccessTokenScopesFromContext(ctx context.Context) ([]string, bool) {
+	scopes, ok := ctx.Value(AccessTokenScopesCtx{}).([]string)
+	return scopes, ok
+}
+
+// AccessTokenAllows returns true if current request is not authenticated by a personal access token,
+// or the token is granted with any of given scopes.
+func AccessTokenAllows(ctx context.Context, scopes ...Scope) bool {
+	granted, ok := AccessTokenScopesFromContext(ctx)
+	if !ok {
+		return true
+	}
+
+	return lo.SomeBy(scopes, func(s Scope) bool {
+		return lo.Contains(granted, string(s))
+	})
+}
+
+func (t *tokenAuth) VerifyAccessToken(ctx context.Context, token string) (int, []string, error) {
+	return t.verifyAccessToken(ctx, token)
+}
+
+func (t *tokenAuth) verifyAccessToken(ctx context.Context, tokenString string) (int, []string, error) {
+	uid, id, secret, err := ParseAccessToken(t.idEncoder, tokenString)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if _, err := t.userClient.GetActiveByID(ctx, uid); err != nil {
+		return 0, nil, ErrUserNotFound
+	}
+
+	token, err := t.userClient.GetAccessToken(ctx, uid, id)
+	if err != nil {
+		return 0, nil, ErrInvalidAccessToken
+	}
+
+	if err := MatchAccessToken(token, secret); err != nil {
+		return 0, nil, err
+	}
+
+	if token.LastUsedAt == nil || time.Since(*token.LastUsedAt) > accessTokenUsedAtPrecision {
+		if err := t.userClient.MarkAccessTokenUsed(ctx, token.ID); err != nil {
+			t.l.Warning("Failed to update last used time of access token %q: %s", id, err)
+		}
+	}
+
+	return uid, token.Scopes, nil
+}
+
+func digestAccessTokenSecret(secret string) string {
+	digest := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(digest[:])
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestEncoder(t *testing.T) hashid.Encoder {
+	encoder, err := hashid.New("test-salt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return encoder
+}
+
+func TestAccessTokenRoundTrip(t *testing.T) {
+	a := assert.New(t)
+	encoder := newTestEncoder(t)
+
+	token, record, err := NewAccessToken(encoder, 42, "ci", []string{"files.read", "files.read", "webdav"}, nil)
+	a.NoError(err)
+	a.True(strings.HasPrefix(token, AccessTokenPrefix))
+	a.Equal(42, record.UserID)
+	a.Equal([]string{"files.read", "webdav"}, record.Scopes)
+	a.NotContains(token, record.Digest)
+
+	uid, id, secret, err := ParseAccessToken(encoder, token)
+	a.NoError(err)
+	a.Equal(42, uid)
+	a.Equal(record.TokenID, id)
+	a.NoError(MatchAccessToken(record, secret))
+
+	// Secret is stored as digest only
+	a.Equal(digestAccessTokenSecret(secret), record.Digest)
+	a.NotEqual(secret, record.Digest)
+
+	another, _, err := NewAccessToken(encoder, 42, "ci", nil, nil)
+	a.NoError(err)
+	a.NotEqual(token, another)
+}
+
+func TestParseAccessTokenInvalid(t *testing.T) {
+	a := assert.New(t)
+	encoder := newTestEncoder(t)
+	uid := hashid.EncodeUserID(encoder, 1)
+
+	for _, token := range []string{
+		"",
+		"Bearer abc",
+		"cr_pat_",
+		"cr_pat_" + uid + "_id",
+		"cr_pat_" + uid + "_id_secret_extra",
+		"cr_pat_notahashid_id_secret",
+		"pat_" + uid + "_id_secret",
+	} {
+		_, _, _, err := ParseAccessToken(encoder, token)
+		a.ErrorIs(err, ErrInvalidAccessToken, token)
+	}
+}
+
+func TestMatchAccessToken(t *testing.T) {
+	a := assert.New(t)
+	encoder := newTestEncoder(t)
+
+	expires := time.Now().Add(time.Hour)
+	token, record, err := NewAccessToken(encoder, 1, "ci", nil, &expires)
+	a.NoError(err)
+	_, _, secret, err := ParseAccessToken(encoder, token)
+	a.NoError(err)
+
+	a.NoError(MatchAccessToken(record, secret))
+	a.ErrorIs(MatchAccessToken(record, secret+"0"), ErrInvalidAccessToken)
+	a.ErrorIs(MatchAccessToken(record, ""), ErrInvalidAccessToken)
+
+	expired := time.Now().Add(-time.Second)
+	record.ExpiresAt = &expired
+	a.ErrorIs(MatchAccessToken(record, secret), ErrAccessTokenExpired)
+
+	// Wrong secret is reported before expiry
+	a.ErrorIs(MatchAccessToken(record, secret+"0"), ErrInvalidAccessToken)
+}
+
+func TestAccessTokenAllows(t *testing.T) {
+	a := assert.New(t)
+
+	// Requests not authenticated by access tokens are not restricted
+	a.True(AccessTokenAllows(context.Background(), ScopeFileWrite))
+
+	ctx := context.WithValue(context.Background(), AccessTokenScopesCtx{}, []string{string(ScopeFileRead)})
+	a.True(AccessTokenAllows(ctx, ScopeFileRead))
+	a.True(AccessTokenAllows(ctx, ScopeFileWrite, ScopeFileRead))
+	a.False(AccessTokenAllows(ctx, ScopeFileWrite))
+	a.False(AccessTokenAllows(ctx))
+
+	scopes, ok := AccessTokenScopesFromContext(ctx)
+	a.True(ok)
+	a.Equal([]string{string(ScopeFileRead)}, scopes)
+}
+
+func TestIsValidScope(t *testing.T) {
+	a := assert.New(t)
+	for _, s := range AllScopes {
+		a.True(IsValidScope(string(s)))
+	}
+	a.False(IsValidScope("admin"))
+	a.False(IsValidScope(""))
+}
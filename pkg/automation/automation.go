@@ -0,0 +1,220 @@
+// Package automation evaluates user defined automation rules against files, rules are applied by file
+// manager once a file event is triggered.
+package automation
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webhook"
+	"github.com/go-playground/validator/v10"
+	"github.com/samber/lo"
+)
+
+const (
+	// MaxActions is the maximum number of actions in a rule.
+	MaxActions = 5
+	// MaxNamePatterns is the maximum number of name patterns in a rule.
+	MaxNamePatterns = 20
+
+	maxTagLength = 255
+)
+
+// Triggers lists file events that can trigger automation rules.
+var Triggers = []string{
+	webhook.EventFileUploaded,
+	webhook.EventFileRenamed,
+}
+
+var validate = validator.New()
+
+type (
+	// Target is the file a rule is evaluated on.
+	Target struct {
+		Uri    *fs.URI
+		Size   int64
+		Folder bool
+		// OwnerID is the hashed ID of file owner, used to compare URIs without explicit user.
+		OwnerID string
+	}
+
+	// PlannedAction is an action with placeholders resolved, to be applied on a target.
+	PlannedAction struct {
+		Type     types.AutomationActionType `json:"type"`
+		Dst      string                     `json:"dst,omitempty"`
+		Tag      string                     `json:"tag,omitempty"`
+		TagColor string                     `json:"tag_color,omitempty"`
+	}
+)
+
+// Validate checks trigger, conditions and actions of a rule.
+func Validate(trigger string, cond *types.AutomationConditions, actions []types.AutomationAction) error {
+	if !lo.Contains(Triggers, trigger) {
+		return fmt.Errorf("unknown trigger %q", trigger)
+	}
+
+	if cond != nil {
+		if cond.Folder != "" {
+			if _, err := parseFolder(cond.Folder); err != nil {
+				return fmt.Errorf("invalid folder: %w", err)
+			}
+		}
+
+		if len(cond.NamePatterns) > MaxNamePatterns {
+			return fmt.Errorf("too many name patterns, maximum is %d", MaxNamePatterns)
+		}
+
+		for _, pattern := range cond.NamePatterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("invalid name pattern %q: %w", pattern, err)
+			}
+		}
+
+		if cond.MinSize < 0 || cond.MaxSize < 0 || (cond.MaxSize > 0 && cond.MinSize > cond.MaxSize) {
+			return errors.New("invalid size range")
+		}
+	}
+
+	if len(actions) == 0 {
+		return errors.New("at least one action is required")
+	}
+
+	if len(actions) > MaxActions {
+		return fmt.Errorf("too many actions, maximum is %d", MaxActions)
+	}
+
+	for i, action := range actions {
+		if err := validateAction(&action); err != nil {
+			return fmt.Errorf("invalid action #%d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+func validateAction(action *types.AutomationAction) error {
+	switch action.Type {
+	case types.AutomationActionMove, types.AutomationActionCopy:
+		if action.Dst == "" {
+			return errors.New("destination is required")
+		}
+
+		_, err := ExpandDst(action.Dst, &Target{Uri: &fs.URI{U: &url.URL{Path: "/a.txt"}}}, time.Now())
+		return err
+	case types.AutomationActionTag:
+		if action.Tag == "" || len(action.Tag) > maxTagLength {
+			return errors.New("invalid tag")
+		}
+
+		return validate.Var(action.TagColor, "omitempty,iscolor")
+	default:
+		return fmt.Errorf("unknown action type %q", action.Type)
+	}
+}
+
+// Match returns whether the target matches all conditions. A nil condition matches any file.
+func Match(cond *types.AutomationConditions, t *Target) bool {
+	if cond == nil {
+		return true
+	}
+
+	if cond.Folder != "" {
+		folder, err := parseFolder(cond.Folder)
+		if err != nil {
+			return false
+		}
+
+		parent := t.Uri.DirUri()
+		if cond.Recursive {
+			if !parent.EqualOrIsDescendantOf(folder, t.OwnerID) {
+				return false
+			}
+		} else if !parent.IsSame(folder, t.OwnerID) {
+			return false
+		}
+	}
+
+	if len(cond.NamePatterns) > 0 {
+		name := strings.ToLower(t.Uri.Name())
+		if !lo.ContainsBy(cond.NamePatterns, func(pattern string) bool {
+			matched, _ := path.Match(strings.ToLower(pattern), name)
+			return matched
+		}) {
+			return false
+		}
+	}
+
+	if cond.MinSize > 0 && t.Size < cond.MinSize {
+		return false
+	}
+
+	if cond.MaxSize > 0 && t.Size > cond.MaxSize {
+		return false
+	}
+
+	return true
+}
+
+// Plan resolves placeholders of actions for given target.
+func Plan(actions []types.AutomationAction, t *Target, now time.Time) ([]PlannedAction, error) {
+	res := make([]PlannedAction, 0, len(actions))
+	for _, action := range actions {
+		planned := PlannedAction{
+			Type:     action.Type,
+			Tag:      action.Tag,
+			TagColor: action.TagColor,
+		}
+
+		if action.Type == types.AutomationActionMove || action.Type == types.AutomationActionCopy {
+			dst, err := ExpandDst(action.Dst, t, now)
+			if err != nil {
+				return nil, err
+			}
+
+			planned.Dst = dst.String()
+		}
+
+		res = append(res, planned)
+	}
+
+	return res, nil
+}
+
+// ExpandDst replaces placeholders in destination folder URI. Supported placeholders are {year}, {month}
+// and {day} of given time, and {ext} of target file name in lower case.
+func ExpandDst(dst string, t *Target, now time.Time) (*fs.URI, error) {
+	ext := strings.TrimPrefix(strings.ToLower(path.Ext(t.Uri.Name())), ".")
+	if ext == "" {
+		ext = "other"
+	}
+
+	expanded := strings.NewReplacer(
+		"{year}", now.Format("2006"),
+		"{month}", now.Format("01"),
+		"{day}", now.Format("02"),
+		"{ext}", url.PathEscape(ext),
+	).Replace(dst)
+
+	return parseFolder(expanded)
+}
+
+// parseFolder parses a folder URI in user's own file system.
+func parseFolder(raw string) (*fs.URI, error) {
+	uri, err := fs.NewUriFromString(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if uri.FileSystem() != constants.FileSystemMy {
+		return nil, errors.New("folder must be in my files")
+	}
+
+	return uri, nil
+}
@@ -0,0 +1,66 @@
+package automation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTarget(t *testing.T, uri string, size int64) *Target {
+	u, err := fs.NewUriFromString(uri)
+	assert.NoError(t, err)
+	return &Target{Uri: u, Size: size, OwnerID: "lx"}
+}
+
+func TestMatch(t *testing.T) {
+	a := assert.New(t)
+	target := newTarget(t, "cloudreve://my/Inbox/Trip/IMG_001.JPG", 2048)
+
+	a.True(Match(nil, target))
+	a.True(Match(&types.AutomationConditions{NamePatterns: []string{"*.png", "*.jpg"}}, target))
+	a.False(Match(&types.AutomationConditions{NamePatterns: []string{"*.png"}}, target))
+
+	a.False(Match(&types.AutomationConditions{Folder: "cloudreve://my/Inbox"}, target))
+	a.True(Match(&types.AutomationConditions{Folder: "cloudreve://my/Inbox", Recursive: true}, target))
+	a.True(Match(&types.AutomationConditions{Folder: "cloudreve://my/Inbox/Trip"}, target))
+	a.True(Match(&types.AutomationConditions{Folder: "cloudreve://lx@my/Inbox/Trip"}, target))
+	a.False(Match(&types.AutomationConditions{Folder: "cloudreve://other@my/Inbox/Trip"}, target))
+
+	a.True(Match(&types.AutomationConditions{MinSize: 1024, MaxSize: 4096}, target))
+	a.False(Match(&types.AutomationConditions{MinSize: 4096}, target))
+	a.False(Match(&types.AutomationConditions{MaxSize: 1024}, target))
+}
+
+func TestPlan(t *testing.T) {
+	a := assert.New(t)
+	target := newTarget(t, "cloudreve://my/Inbox/IMG_001.JPG", 2048)
+	now := time.Date(2024, 3, 9, 12, 0, 0, 0, time.UTC)
+
+	res, err := Plan([]types.AutomationAction{
+		{Type: types.AutomationActionMove, Dst: "cloudreve://my/Photos/{year}/{month}/{ext}"},
+		{Type: types.AutomationActionTag, Tag: "large", TagColor: "#ff0000"},
+	}, target, now)
+	a.NoError(err)
+	a.Equal([]PlannedAction{
+		{Type: types.AutomationActionMove, Dst: "cloudreve://my/Photos/2024/03/jpg"},
+		{Type: types.AutomationActionTag, Tag: "large", TagColor: "#ff0000"},
+	}, res)
+}
+
+func TestValidate(t *testing.T) {
+	a := assert.New(t)
+	move := []types.AutomationAction{{Type: types.AutomationActionMove, Dst: "cloudreve://my/Photos/{year}"}}
+
+	a.NoError(Validate("file.uploaded", &types.AutomationConditions{NamePatterns: []string{"*.jpg"}}, move))
+	a.Error(Validate("file.deleted", nil, move))
+	a.Error(Validate("file.uploaded", &types.AutomationConditions{NamePatterns: []string{"[a"}}, move))
+	a.Error(Validate("file.uploaded", &types.AutomationConditions{MinSize: 10, MaxSize: 5}, move))
+	a.Error(Validate("file.uploaded", &types.AutomationConditions{Folder: "cloudreve://trash"}, move))
+	a.Error(Validate("file.uploaded", nil, nil))
+	a.Error(Validate("file.uploaded", nil, []types.AutomationAction{{Type: types.AutomationActionCopy}}))
+	a.Error(Validate("file.uploaded", nil, []types.AutomationAction{{Type: types.AutomationActionTag, Tag: "a", TagColor: "red!"}}))
+	a.Error(Validate("file.uploaded", nil, []types.AutomationAction{{Type: "delete"}}))
+}
@@ -13,6 +13,22 @@ type Driver interface {
 	// 设置值，ttl为过期时间，单位为秒
 	Set(key string, value any, ttl int) error
 
+	// SetNX sets value only if the key does not exist, returns whether the value is set.
+	// It can be used as a distributed lock.
+	SetNX(key string, value any, ttl int) (bool, error)
+
+	// Incr atomically adds delta to the integer counter of key and returns the new value. A missing
+	// key is created with ttl, which is not extended by later increments. Counters can only be read
+	// by Incr with zero delta.
+	Incr(key string, delta int, ttl int) (int, error)
+
+	// CompareAndSwap sets value only if the current value of key equals old, returns whether the value
+	// is set. Values are compared by their serialized form, so old should be the value read by Get.
+	CompareAndSwap(key string, old, value any, ttl int) (bool, error)
+
+	// CompareAndDelete deletes key only if its current value equals old, returns whether the key is deleted.
+	CompareAndDelete(key string, old any) (bool, error)
+
 	// 取值，并返回是否成功
 	Get(key string) (any, bool)
 
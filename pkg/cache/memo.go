@@ -6,6 +6,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"os"
+	"reflect"
 	"strings"
 	"sync"
 	"time"
@@ -14,6 +15,8 @@ import (
 // MemoStore 内存存储驱动
 type MemoStore struct {
 	Store *sync.Map
+	// nxMu serializes SetNX, Incr, CompareAndSwap and CompareAndDelete calls.
+	nxMu sync.Mutex
 }
 
 // item 存储的对象
@@ -88,6 +91,69 @@ func (store *MemoStore) Set(key string, value any, ttl int) error {
 	return nil
 }
 
+// SetNX 仅在键不存在时存储值
+func (store *MemoStore) SetNX(key string, value any, ttl int) (bool, error) {
+	store.nxMu.Lock()
+	defer store.nxMu.Unlock()
+
+	if _, ok := getValue(store.Store.Load(key)); ok {
+		return false, nil
+	}
+
+	store.Store.Store(key, newItem(value, ttl))
+	return true, nil
+}
+
+// Incr 原子地增加计数器
+func (store *MemoStore) Incr(key string, delta int, ttl int) (int, error) {
+	store.nxMu.Lock()
+	defer store.nxMu.Unlock()
+
+	current := newItem(0, ttl)
+	if v, ok := store.Store.Load(key); ok {
+		if item, ok := v.(itemWithTTL); ok && (item.Expires <= 0 || item.Expires >= time.Now().Unix()) {
+			current = item
+		}
+	}
+
+	value, ok := current.Value.(int)
+	if !ok {
+		return 0, fmt.Errorf("value of key %q is not a counter", key)
+	}
+
+	current.Value = value + delta
+	store.Store.Store(key, current)
+	return value + delta, nil
+}
+
+// CompareAndSwap 仅在当前值等于 old 时存储值
+func (store *MemoStore) CompareAndSwap(key string, old, value any, ttl int) (bool, error) {
+	store.nxMu.Lock()
+	defer store.nxMu.Unlock()
+
+	current, ok := getValue(store.Store.Load(key))
+	if !ok || !reflect.DeepEqual(current, old) {
+		return false, nil
+	}
+
+	store.Store.Store(key, newItem(value, ttl))
+	return true, nil
+}
+
+// CompareAndDelete 仅在当前值等于 old 时删除值
+func (store *MemoStore) CompareAndDelete(key string, old any) (bool, error) {
+	store.nxMu.Lock()
+	defer store.nxMu.Unlock()
+
+	current, ok := getValue(store.Store.Load(key))
+	if !ok || !reflect.DeepEqual(current, old) {
+		return false, nil
+	}
+
+	store.Store.Delete(key)
+	return true, nil
+}
+
 // Get 取值
 func (store *MemoStore) Get(key string) (any, bool) {
 	return getValue(store.Store.Load(key))
@@ -102,6 +102,116 @@ func (store *RedisStore) Set(key string, value any, ttl int) error {
 
 }
 
+// SetNX 仅在键不存在时存储值
+func (store *RedisStore) SetNX(key string, value any, ttl int) (bool, error) {
+	rc := store.pool.Get()
+	defer rc.Close()
+
+	serialized, err := serializer(value)
+	if err != nil {
+		return false, err
+	}
+
+	if rc.Err() != nil {
+		return false, rc.Err()
+	}
+
+	args := redis.Args{}.Add(key, serialized, "NX")
+	if ttl > 0 {
+		args = args.Add("EX", ttl)
+	}
+
+	_, err = redis.String(rc.Do("SET", args...))
+	if err == redis.ErrNil {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// incrScript increments a counter and sets its TTL if the key is created by this call.
+var incrScript = redis.NewScript(1, `
+local v = redis.call("INCRBY", KEYS[1], ARGV[1])
+if tonumber(ARGV[2]) > 0 and redis.call("TTL", KEYS[1]) == -1 then
+	redis.call("EXPIRE", KEYS[1], ARGV[2])
+end
+return v
+`)
+
+// Incr 原子地增加计数器
+func (store *RedisStore) Incr(key string, delta int, ttl int) (int, error) {
+	rc := store.pool.Get()
+	defer rc.Close()
+	if rc.Err() != nil {
+		return 0, rc.Err()
+	}
+
+	return redis.Int(incrScript.Do(rc, key, delta, ttl))
+}
+
+// casScript sets the value if the current one equals the expected one.
+var casScript = redis.NewScript(1, `
+if redis.call("GET", KEYS[1]) ~= ARGV[1] then
+	return 0
+end
+if tonumber(ARGV[3]) > 0 then
+	redis.call("SET", KEYS[1], ARGV[2], "EX", ARGV[3])
+else
+	redis.call("SET", KEYS[1], ARGV[2])
+end
+return 1
+`)
+
+// CompareAndSwap 仅在当前值等于 old 时存储值
+func (store *RedisStore) CompareAndSwap(key string, old, value any, ttl int) (bool, error) {
+	rc := store.pool.Get()
+	defer rc.Close()
+	if rc.Err() != nil {
+		return false, rc.Err()
+	}
+
+	expected, err := serializer(old)
+	if err != nil {
+		return false, err
+	}
+
+	serialized, err := serializer(value)
+	if err != nil {
+		return false, err
+	}
+
+	return redis.Bool(casScript.Do(rc, key, expected, serialized, ttl))
+}
+
+// cadScript deletes the key if its value equals the expected one.
+var cadScript = redis.NewScript(1, `
+if redis.call("GET", KEYS[1]) ~= ARGV[1] then
+	return 0
+end
+redis.call("DEL", KEYS[1])
+return 1
+`)
+
+// CompareAndDelete 仅在当前值等于 old 时删除值
+func (store *RedisStore) CompareAndDelete(key string, old any) (bool, error) {
+	rc := store.pool.Get()
+	defer rc.Close()
+	if rc.Err() != nil {
+		return false, rc.Err()
+	}
+
+	expected, err := serializer(old)
+	if err != nil {
+		return false, err
+	}
+
+	return redis.Bool(cadScript.Do(rc, key, expected))
+}
+
 // Get 取值
 func (store *RedisStore) Get(key string) (any, bool) {
 	rc := store.pool.Get()
@@ -0,0 +1,258 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"maps"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+)
+
+const (
+	// BalanceWeighted selects nodes in proportion to their weights. It is the default strategy.
+	BalanceWeighted = "weighted"
+	// BalanceRoundRobin selects nodes in turn, ignoring weights.
+	BalanceRoundRobin = "round_robin"
+	// BalanceLeastActive selects the node with the least unfinished tasks.
+	BalanceLeastActive = "least_active"
+	// BalanceLatency selects the node with the lowest recent call latency.
+	BalanceLatency = "latency"
+
+	// BalanceDefaultKey is the key of strategy setting that applies to capabilities without one.
+	BalanceDefaultKey = "default"
+
+	activeTaskCacheTTL = 10 * time.Second
+	// latencyDecay is the weight of previous average when a new latency sample is observed.
+	latencyDecay = 0.8
+)
+
+type (
+	// Candidate is a node available for selection.
+	Candidate struct {
+		Node   Node
+		Weight int
+	}
+
+	// BalanceStrategy selects a node from candidates of a capability. Candidates are never empty.
+	BalanceStrategy interface {
+		Select(ctx context.Context, candidates []Candidate) Node
+	}
+)
+
+var (
+	// CapabilityNames are the names of capabilities used as keys of strategy settings.
+	CapabilityNames = map[types.NodeCapability]string{
+		types.NodeCapabilityNone:           "general",
+		types.NodeCapabilityCreateArchive:  "create_archive",
+		types.NodeCapabilityExtractArchive: "extract_archive",
+		types.NodeCapabilityRemoteDownload: "remote_download",
+		types.NodeCapabilityGenerateThumb:  "generate_thumb",
+		types.NodeCapabilityTranscode:      "transcode",
+		types.NodeCapabilityEdgeCache:      "edge_cache",
+	}
+
+	// nodeTaskTypes are task types that run on nodes allocated from pool.
+	nodeTaskTypes = []string{
+		queue.RemoteDownloadTaskType,
+		queue.CreateArchiveTaskType,
+		queue.ExtractArchiveTaskType,
+		queue.TranscodeTaskType,
+	}
+)
+
+// strategyName returns the strategy configured for capability.
+func strategyName(strategies map[string]string, capability types.NodeCapability) string {
+	if name, ok := strategies[CapabilityNames[capability]]; ok && name != "" {
+		return name
+	}
+
+	if name, ok := strategies[BalanceDefaultKey]; ok && name != "" {
+		return name
+	}
+
+	return BalanceWeighted
+}
+
+func newBalanceStrategy(name string, tasks *activeTaskCache) BalanceStrategy {
+	switch name {
+	case BalanceRoundRobin:
+		return &roundRobinStrategy{}
+	case BalanceLeastActive:
+		return &leastActiveStrategy{tasks: tasks}
+	case BalanceLatency:
+		return &latencyStrategy{}
+	default:
+		return &weightedStrategy{current: make(map[int]int)}
+	}
+}
+
+// weightedStrategy is smooth weighted round-robin.
+type weightedStrategy struct {
+	mu      sync.Mutex
+	current map[int]int
+}
+
+func (s *weightedStrategy) Select(ctx context.Context, candidates []Candidate) Node {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Add weight of each candidate to its current weight, then select the one with max current weight
+	// and reduce it by the total weight.
+	var (
+		total    int
+		selected Node
+	)
+	for _, c := range candidates {
+		weight := max(1, c.Weight)
+		s.current[c.Node.ID()] += weight
+		total += weight
+		if selected == nil || s.current[c.Node.ID()] > s.current[selected.ID()] {
+			selected = c.Node
+		}
+	}
+
+	logging.FromContext(ctx).Debug("Selected node %q with current=%d, total=%d", selected.Name(), s.current[selected.ID()], total)
+	s.current[selected.ID()] -= total
+	return selected
+}
+
+type roundRobinStrategy struct {
+	next atomic.Uint64
+}
+
+func (s *roundRobinStrategy) Select(ctx context.Context, candidates []Candidate) Node {
+	index := (s.next.Add(1) - 1) % uint64(len(candidates))
+	return candidates[index].Node
+}
+
+type leastActiveStrategy struct {
+	tasks *activeTaskCache
+}
+
+func (s *leastActiveStrategy) Select(ctx context.Context, candidates []Candidate) Node {
+	counts := s.tasks.Counts(ctx)
+	selected := candidates[0]
+	for _, c := range candidates[1:] {
+		active, selectedActive := counts[c.Node.ID()], counts[selected.Node.ID()]
+		if active < selectedActive || (active == selectedActive && c.Weight > selected.Weight) {
+			selected = c
+		}
+	}
+
+	// Count the new task before cache is refreshed, so that bursts are spread across nodes.
+	s.tasks.Add(selected.Node.ID())
+	return selected.Node
+}
+
+type latencyStrategy struct{}
+
+func (s *latencyStrategy) Select(ctx context.Context, candidates []Candidate) Node {
+	// Nodes without latency samples are preferred, so that they get measured.
+	selected := candidates[0]
+	for _, c := range candidates[1:] {
+		if c.Node.Latency() < selected.Node.Latency() {
+			selected = c
+		}
+	}
+
+	return selected.Node
+}
+
+// activeTaskCache caches number of unfinished tasks of each node.
+type activeTaskCache struct {
+	client inventory.TaskClient
+
+	mu      sync.Mutex
+	counts  map[int]int
+	expires time.Time
+}
+
+// Counts returns number of unfinished tasks keyed by node ID.
+func (c *activeTaskCache) Counts(ctx context.Context) map[int]int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.counts != nil && time.Now().Before(c.expires) {
+		return maps.Clone(c.counts)
+	}
+
+	counts := make(map[int]int)
+	tasks, err := c.client.GetPendingTasks(ctx, nodeTaskTypes...)
+	if err != nil {
+		logging.FromContext(ctx).Warning("Failed to count active tasks of nodes: %s", err)
+	}
+
+	for _, t := range tasks {
+		var state struct {
+			NodeID int `json:"node_id"`
+		}
+		if err := json.Unmarshal([]byte(t.PrivateState), &state); err == nil && state.NodeID > 0 {
+			counts[state.NodeID]++
+		}
+	}
+
+	c.counts = counts
+	c.expires = time.Now().Add(activeTaskCacheTTL)
+	return maps.Clone(counts)
+}
+
+// Add counts a new task of node until cache is refreshed.
+func (c *activeTaskCache) Add(id int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.counts != nil {
+		c.counts[id]++
+	}
+}
+
+// latencyTracker keeps exponentially weighted moving average of call latency.
+type latencyTracker struct {
+	mu      sync.Mutex
+	average time.Duration
+}
+
+// Observe records a latency sample.
+func (t *latencyTracker) Observe(d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.average == 0 {
+		t.average = d
+		return
+	}
+
+	t.average = time.Duration(float64(t.average)*latencyDecay + float64(d)*(1-latencyDecay))
+}
+
+// Latency returns the average latency, 0 if no sample is observed.
+func (t *latencyTracker) Latency() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.average
+}
+
+// latencyClient records latency of successful requests sent by the underlying client.
+type latencyClient struct {
+	request.Client
+	tracker *latencyTracker
+}
+
+func (c *latencyClient) Request(method, target string, body io.Reader, opts ...request.Option) *request.Response {
+	start := time.Now()
+	resp := c.Client.Request(method, target, body, opts...)
+	if resp.Err == nil {
+		c.tracker.Observe(time.Since(start))
+	}
+
+	return resp
+}
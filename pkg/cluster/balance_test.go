@@ -0,0 +1,71 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCandidates(weights ...int) []Candidate {
+	candidates := make([]Candidate, 0, len(weights))
+	for i, weight := range weights {
+		candidates = append(candidates, Candidate{
+			Node:   &nodeBase{model: &ent.Node{ID: i + 1}},
+			Weight: weight,
+		})
+	}
+
+	return candidates
+}
+
+func TestStrategyName(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal(BalanceWeighted, strategyName(map[string]string{}, types.NodeCapabilityRemoteDownload))
+	a.Equal(BalanceLatency, strategyName(map[string]string{
+		BalanceDefaultKey: BalanceLatency,
+	}, types.NodeCapabilityRemoteDownload))
+	a.Equal(BalanceLeastActive, strategyName(map[string]string{
+		BalanceDefaultKey: BalanceLatency,
+		"remote_download": BalanceLeastActive,
+	}, types.NodeCapabilityRemoteDownload))
+}
+
+func TestWeightedStrategy(t *testing.T) {
+	a := assert.New(t)
+	s := newBalanceStrategy(BalanceWeighted, nil)
+	candidates := testCandidates(5, 1, 1)
+
+	selected := make(map[int]int)
+	for i := 0; i < 7; i++ {
+		selected[s.Select(context.Background(), candidates).ID()]++
+	}
+
+	a.Equal(map[int]int{1: 5, 2: 1, 3: 1}, selected)
+}
+
+func TestRoundRobinStrategy(t *testing.T) {
+	a := assert.New(t)
+	s := newBalanceStrategy(BalanceRoundRobin, nil)
+	candidates := testCandidates(5, 1, 1)
+
+	for _, expected := range []int{1, 2, 3, 1} {
+		a.Equal(expected, s.Select(context.Background(), candidates).ID())
+	}
+}
+
+func TestLatencyTracker(t *testing.T) {
+	a := assert.New(t)
+	tracker := &latencyTracker{}
+	a.Zero(tracker.Latency())
+
+	tracker.Observe(100 * time.Millisecond)
+	a.Equal(100*time.Millisecond, tracker.Latency())
+
+	tracker.Observe(200 * time.Millisecond)
+	a.InDelta(float64(120*time.Millisecond), float64(tracker.Latency()), float64(time.Microsecond))
+}
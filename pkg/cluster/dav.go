@@ -0,0 +1,124 @@
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+)
+
+const (
+	// DavAssertionServe tells slave node to serve entity content from its local storage.
+	DavAssertionServe = "serve"
+	// DavAssertionUpload tells slave node to write request body into an upload session created on it.
+	DavAssertionUpload = "upload"
+	// DavAssertionRedirect tells slave node to redirect client to the entity URL.
+	DavAssertionRedirect = "redirect"
+	// DavAssertionProxy tells slave node to proxy the request to master.
+	DavAssertionProxy = "proxy"
+)
+
+type (
+	// DavAssertionRequest is sent from slave node to master to authorize a WebDAV request received by
+	// the slave. Only the request line and headers are sent, request body stays on slave node.
+	DavAssertionRequest struct {
+		Method string `json:"method" binding:"required,oneof=GET HEAD PUT"`
+		// Path of the request relative to the WebDAV endpoint.
+		Path   string      `json:"path" binding:"required,startswith=/"`
+		Header http.Header `json:"header"`
+	}
+
+	// DavAssertion is issued by master for an authorized WebDAV request, telling slave node how to handle it.
+	DavAssertion struct {
+		Kind string
+		// Source path, display name, ETag and speed limit of the entity to serve.
+		Source     string
+		Name       string
+		ETag       string
+		SpeedLimit int64
+		// Url to redirect client to.
+		Url string
+		// SessionID, Size and ChunkSize of the upload session created on slave node.
+		SessionID     string
+		Size          int64
+		ChunkSize     int64
+		MtimeAccepted bool
+		Expires       int64
+		Signature     string
+	}
+)
+
+// Sign signs the assertion with the auth instance of the node it is issued for.
+func (a *DavAssertion) Sign(instance auth.Auth) {
+	a.Signature = ""
+	a.Signature = instance.Sign(a.signContent(), a.Expires)
+}
+
+// Check verifies the signature of the assertion.
+func (a *DavAssertion) Check(instance auth.Auth) error {
+	return instance.Check(a.signContent(), a.Signature)
+}
+
+func (a *DavAssertion) signContent() string {
+	unsigned := *a
+	unsigned.Signature = ""
+	content, _ := json.Marshal(unsigned)
+	return string(content)
+}
+
+// RequestDavAssertion asks master to authorize a WebDAV request. If master rejects the request, the returned
+// assertion is nil and the response of master should be relayed to client, its body must be closed by caller.
+func RequestDavAssertion(ctx context.Context, client request.Client, masterURL string, req *DavAssertionRequest,
+	instance auth.Auth) (*DavAssertion, *http.Response, error) {
+	dst := routes.MasterDavAssertionUrl(masterURL)
+	if dst == nil {
+		return nil, nil, fmt.Errorf("invalid master URL %q", masterURL)
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal assertion request: %w", err)
+	}
+
+	resp := client.Request(
+		"POST",
+		dst.String(),
+		bytes.NewReader(body),
+		request.WithContext(ctx),
+		request.WithTimeout(30*time.Second),
+	)
+	if resp.Err != nil {
+		return nil, nil, resp.Err
+	}
+
+	if resp.Response.StatusCode != http.StatusOK {
+		return nil, resp.Response, nil
+	}
+
+	res, err := resp.DecodeResponse()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if res.Code != 0 {
+		return nil, nil, serializer.NewErrorFromResponse(res)
+	}
+
+	assertion := &DavAssertion{}
+	if _, ok := res.Data.(string); ok {
+		res.GobDecode(assertion)
+	}
+
+	if err := assertion.Check(instance); err != nil {
+		return nil, nil, fmt.Errorf("invalid assertion signature: %w", err)
+	}
+
+	return assertion, nil, nil
+}
@@ -0,0 +1,84 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDavAssertionSign(t *testing.T) {
+	a := assert.New(t)
+	instance := auth.HMACAuth{SecretKey: []byte("secret")}
+	assertion := &DavAssertion{
+		Kind:    DavAssertionServe,
+		Source:  "uploads/1/a.txt",
+		Expires: time.Now().Add(time.Minute).Unix(),
+	}
+
+	assertion.Sign(instance)
+	a.NoError(assertion.Check(instance))
+	a.Error(assertion.Check(auth.HMACAuth{SecretKey: []byte("other")}))
+
+	assertion.Source = "uploads/2/b.txt"
+	a.Error(assertion.Check(instance))
+}
+
+func TestRequestDavAssertion(t *testing.T) {
+	a := assert.New(t)
+	instance := auth.HMACAuth{SecretKey: []byte("secret")}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.Equal(constants.APIPrefixSlave+"/dav/assertion", r.URL.Path)
+
+		var received DavAssertionRequest
+		a.NoError(json.NewDecoder(r.Body).Decode(&received))
+		if received.Header.Get("Authorization") == "" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="cloudreve"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		assertion := &DavAssertion{Kind: DavAssertionServe, Source: received.Path, Expires: time.Now().Add(time.Minute).Unix()}
+		assertion.Sign(instance)
+		_ = json.NewEncoder(w).Encode(serializer.NewResponseWithGobData(context.Background(), assertion))
+	}))
+	defer srv.Close()
+	client := request.NewClient(testConfig{})
+
+	res, rejected, err := RequestDavAssertion(context.Background(), client, srv.URL, &DavAssertionRequest{
+		Method: http.MethodGet,
+		Path:   "/a.txt",
+		Header: http.Header{"Authorization": {"Basic dXNlcjpwYXNz"}},
+	}, instance)
+	a.NoError(err)
+	a.Nil(rejected)
+	a.Equal(DavAssertionServe, res.Kind)
+	a.Equal("/a.txt", res.Source)
+
+	// Rejected requests are relayed to client.
+	res, rejected, err = RequestDavAssertion(context.Background(), client, srv.URL, &DavAssertionRequest{
+		Method: http.MethodGet,
+		Path:   "/a.txt",
+	}, instance)
+	a.NoError(err)
+	a.Nil(res)
+	a.Equal(http.StatusUnauthorized, rejected.StatusCode)
+	a.Equal(`Basic realm="cloudreve"`, rejected.Header.Get("WWW-Authenticate"))
+	rejected.Body.Close()
+
+	// Assertions not signed by master are refused.
+	_, _, err = RequestDavAssertion(context.Background(), client, srv.URL, &DavAssertionRequest{
+		Method: http.MethodGet,
+		Path:   "/a.txt",
+		Header: http.Header{"Authorization": {"Basic dXNlcjpwYXNz"}},
+	}, auth.HMACAuth{SecretKey: []byte("other")})
+	a.Error(err)
+}
@@ -0,0 +1,270 @@
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+)
+
+const (
+	// NodeStatusKVPrefix is the KV key prefix of heartbeat status of slave nodes.
+	NodeStatusKVPrefix = "node_status_"
+	// NodeStatusTTL is how long the heartbeat status is kept after the last heartbeat.
+	NodeStatusTTL = 7 * 24 * 3600
+	// relayedMaintenanceKey is the KV key of maintenance state received from master.
+	relayedMaintenanceKey = "relayed_maintenance"
+)
+
+type (
+	// Heartbeat is periodically pushed from slave nodes to master.
+	Heartbeat struct {
+		Version string `json:"version"`
+		// Interval in seconds until the next heartbeat.
+		Interval int `json:"interval"`
+		// Load average of 1, 5 and 15 minutes, empty if not supported on the platform.
+		Load       []float64 `json:"load,omitempty"`
+		CPUs       int       `json:"cpus"`
+		Goroutines int       `json:"goroutines"`
+		DiskTotal  uint64    `json:"disk_total,omitempty"`
+		DiskFree   uint64    `json:"disk_free,omitempty"`
+		// CPUUsage in percent since the last heartbeat.
+		CPUUsage     float64 `json:"cpu_usage,omitempty"`
+		MemTotal     uint64  `json:"mem_total,omitempty"`
+		MemAvailable uint64  `json:"mem_available,omitempty"`
+		// NetRx and NetTx are network throughput in bytes per second since the last heartbeat.
+		NetRx uint64 `json:"net_rx,omitempty"`
+		NetTx uint64 `json:"net_tx,omitempty"`
+	}
+
+	// resourceSampler keeps counters of the last heartbeat to compute usage rates.
+	resourceSampler struct {
+		at                time.Time
+		cpuBusy, cpuTotal uint64
+		netRx, netTx      uint64
+	}
+
+	// HeartbeatResponse is returned by master for a heartbeat.
+	HeartbeatResponse struct {
+		// Maintenance state of master, relayed by slave nodes to clients.
+		Maintenance *setting.Maintenance `json:"maintenance,omitempty"`
+	}
+
+	// NodeStatus is the heartbeat status of a slave node tracked by master.
+	NodeStatus struct {
+		Online    bool       `json:"online"`
+		LastSeen  time.Time  `json:"last_seen"`
+		Heartbeat *Heartbeat `json:"heartbeat,omitempty"`
+	}
+)
+
+func init() {
+	gob.Register(NodeStatus{})
+	gob.Register(setting.Maintenance{})
+}
+
+// RelayedMaintenance returns the maintenance state received from master, nil if master is not
+// in maintenance or the state has expired.
+func RelayedMaintenance(kv cache.Driver) *setting.Maintenance {
+	if m, ok := kv.Get(relayedMaintenanceKey); ok {
+		state := m.(setting.Maintenance)
+		return &state
+	}
+
+	return nil
+}
+
+// GetNodeStatus returns the heartbeat status of given node, nil if the node never reports heartbeats.
+func GetNodeStatus(kv cache.Driver, id int) *NodeStatus {
+	if status, ok := kv.Get(NodeStatusKVPrefix + strconv.Itoa(id)); ok {
+		s := status.(NodeStatus)
+		return &s
+	}
+
+	return nil
+}
+
+// CompareAndSetNodeStatus saves the heartbeat status of given node only if the current one equals old,
+// nil old means the node has no status yet. Returns whether the status is saved.
+func CompareAndSetNodeStatus(kv cache.Driver, id int, old, status *NodeStatus) (bool, error) {
+	key := NodeStatusKVPrefix + strconv.Itoa(id)
+	if old == nil {
+		return kv.SetNX(key, *status, NodeStatusTTL)
+	}
+
+	return kv.CompareAndSwap(key, *old, *status, NodeStatusTTL)
+}
+
+// Expired returns whether more than `missed` heartbeats are missed at given time.
+func (s *NodeStatus) Expired(now time.Time, missed int) bool {
+	interval := 30
+	if s.Heartbeat != nil && s.Heartbeat.Interval > 0 {
+		interval = s.Heartbeat.Interval
+	}
+
+	return now.Sub(s.LastSeen) > time.Duration(interval*max(1, missed))*time.Second
+}
+
+// Overloaded returns the name of the first resource whose utilization exceeds given thresholds, empty
+// if none does. Resources not reported by the node are not checked.
+func (hb *Heartbeat) Overloaded(t *setting.NodeAdmission) string {
+	if t.CPU > 0 && hb.CPUUsage > t.CPU {
+		return "cpu"
+	}
+
+	if t.Memory > 0 && hb.MemTotal > 0 && usagePercent(hb.MemTotal, hb.MemAvailable) > t.Memory {
+		return "memory"
+	}
+
+	if t.Disk > 0 && hb.DiskTotal > 0 && usagePercent(hb.DiskTotal, hb.DiskFree) > t.Disk {
+		return "disk"
+	}
+
+	if t.Bandwidth > 0 && (hb.NetRx+hb.NetTx)*8 > uint64(t.Bandwidth)*1000*1000 {
+		return "bandwidth"
+	}
+
+	return ""
+}
+
+func usagePercent(total, free uint64) float64 {
+	return float64(total-min(free, total)) * 100 / float64(total)
+}
+
+// CollectHeartbeat collects runtime metrics of current node.
+func CollectHeartbeat(interval time.Duration) *Heartbeat {
+	total, free := diskUsage(util.DataPath(""))
+	memTotal, memAvailable := memoryUsage()
+	return &Heartbeat{
+		Version:      constants.BackendVersion,
+		Interval:     int(interval.Seconds()),
+		Load:         loadAverage(),
+		CPUs:         runtime.NumCPU(),
+		Goroutines:   runtime.NumGoroutine(),
+		DiskTotal:    total,
+		DiskFree:     free,
+		MemTotal:     memTotal,
+		MemAvailable: memAvailable,
+	}
+}
+
+// sample fills CPU usage and network throughput since the last sample into hb. Rates are left empty
+// for the first sample.
+func (s *resourceSampler) sample(now time.Time, hb *Heartbeat) {
+	cpuBusy, cpuTotal := cpuTimes()
+	netRx, netTx := networkBytes()
+	if !s.at.IsZero() {
+		if cpuTotal > s.cpuTotal && cpuBusy >= s.cpuBusy {
+			hb.CPUUsage = float64(cpuBusy-s.cpuBusy) * 100 / float64(cpuTotal-s.cpuTotal)
+		}
+
+		if elapsed := now.Sub(s.at).Seconds(); elapsed > 0 && netRx >= s.netRx && netTx >= s.netTx {
+			hb.NetRx = uint64(float64(netRx-s.netRx) / elapsed)
+			hb.NetTx = uint64(float64(netTx-s.netTx) / elapsed)
+		}
+	}
+
+	*s = resourceSampler{at: now, cpuBusy: cpuBusy, cpuTotal: cpuTotal, netRx: netRx, netTx: netTx}
+}
+
+// RunHeartbeat pushes heartbeats to master until ctx is canceled, and saves the maintenance state
+// of master in kv. It returns immediately if master URL or node ID is not configured.
+func RunHeartbeat(ctx context.Context, l logging.Logger, config conf.ConfigProvider, kv cache.Driver) {
+	slaveConf := config.Slave()
+	if slaveConf.MasterURL == "" || slaveConf.NodeID == 0 {
+		l.Info("Master URL or node ID is not configured, heartbeat is disabled.")
+		return
+	}
+
+	dst := routes.MasterHeartbeatUrl(slaveConf.MasterURL)
+	if dst == nil {
+		l.Warning("Invalid master URL %q, heartbeat is disabled.", slaveConf.MasterURL)
+		return
+	}
+
+	client := request.NewClient(config,
+		request.WithCorrelationID(),
+		request.WithSlaveMeta(slaveConf.NodeID),
+		request.WithCredential(auth.HMACAuth{
+			SecretKey: []byte(slaveConf.Secret),
+		}, int64(slaveConf.SignatureTTL)),
+	)
+	interval := time.Duration(slaveConf.HeartbeatInterval) * time.Second
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	sampler := &resourceSampler{}
+	for {
+		hb := CollectHeartbeat(interval)
+		sampler.sample(time.Now(), hb)
+		res, err := sendHeartbeat(ctx, client, dst.String(), hb)
+		if err != nil {
+			l.Warning("Failed to send heartbeat to master: %s", err)
+		} else {
+			relayMaintenance(l, kv, res.Maintenance, interval)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayMaintenance saves maintenance state of master. The state expires after a few missed
+// heartbeats, so that clients are not blocked forever if master is unreachable.
+func relayMaintenance(l logging.Logger, kv cache.Driver, m *setting.Maintenance, interval time.Duration) {
+	if m == nil || !m.Enabled {
+		_ = kv.Delete("", relayedMaintenanceKey)
+		return
+	}
+
+	if err := kv.Set(relayedMaintenanceKey, *m, int(3*interval.Seconds())); err != nil {
+		l.Warning("Failed to save maintenance state of master: %s", err)
+	}
+}
+
+func sendHeartbeat(ctx context.Context, client request.Client, dst string, hb *Heartbeat) (*HeartbeatResponse, error) {
+	body, err := json.Marshal(hb)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal heartbeat: %w", err)
+	}
+
+	resp, err := client.Request(
+		"POST",
+		dst,
+		bytes.NewReader(body),
+		request.WithContext(ctx),
+		request.WithTimeout(10*time.Second),
+	).CheckHTTPResponse(200).DecodeResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Code != 0 {
+		return nil, serializer.NewErrorFromResponse(resp)
+	}
+
+	res := &HeartbeatResponse{}
+	if _, ok := resp.Data.(string); ok {
+		resp.GobDecode(res)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,108 @@
+// Package mtls builds TLS configurations for mutual TLS between master and slave nodes.
+package mtls
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+// ClientConfig returns the TLS config master uses to call a slave node, nil if s is nil.
+func ClientConfig(s *types.NodeTLSSetting) (*tls.Config, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: s.ServerName,
+	}
+
+	if s.CA != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(s.CA)) {
+			return nil, errors.New("no valid certificate found in CA")
+		}
+		config.RootCAs = pool
+	}
+
+	if s.Cert != "" || s.Key != "" {
+		cert, err := tls.X509KeyPair([]byte(s.Cert), []byte(s.Key))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// ServerConfig returns the TLS config of slave listeners. If clientCAPath is not empty, clients
+// must present a certificate signed by one of the CAs in it.
+func ServerConfig(certPath, keyPath, clientCAPath string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+
+	config := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+	}
+
+	if clientCAPath != "" {
+		content, err := os.ReadFile(clientCAPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(content) {
+			return nil, fmt.Errorf("no valid certificate found in %q", clientCAPath)
+		}
+
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return config, nil
+}
+
+// CertificateExpiry returns the expiry time of the first certificate in PEM encoded content.
+func CertificateExpiry(content string) (time.Time, error) {
+	block, _ := pem.Decode([]byte(content))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return time.Time{}, errors.New("no certificate found")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+
+	return cert.NotAfter, nil
+}
+
+// Fingerprint identifies the content of s, used to tell whether cached connections built from a
+// previous version of s can be reused.
+func Fingerprint(s *types.NodeTLSSetting) string {
+	if s == nil {
+		return ""
+	}
+
+	h := sha256.New()
+	for _, v := range []string{s.CA, s.Cert, s.Key, s.ServerName} {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
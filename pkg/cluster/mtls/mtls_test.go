@@ -0,0 +1,84 @@
+package mtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCert(t *testing.T, notAfter time.Time) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "master"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+func TestClientConfig(t *testing.T) {
+	a := assert.New(t)
+	cert, key := newTestCert(t, time.Now().Add(time.Hour))
+
+	config, err := ClientConfig(nil)
+	a.NoError(err)
+	a.Nil(config)
+
+	config, err = ClientConfig(&types.NodeTLSSetting{CA: cert, Cert: cert, Key: key, ServerName: "slave"})
+	a.NoError(err)
+	a.Len(config.Certificates, 1)
+	a.NotNil(config.RootCAs)
+	a.Equal("slave", config.ServerName)
+
+	_, err = ClientConfig(&types.NodeTLSSetting{CA: "invalid"})
+	a.Error(err)
+
+	_, err = ClientConfig(&types.NodeTLSSetting{Cert: cert})
+	a.Error(err)
+}
+
+func TestCertificateExpiry(t *testing.T) {
+	a := assert.New(t)
+	notAfter := time.Now().Add(72 * time.Hour).Truncate(time.Second)
+	cert, _ := newTestCert(t, notAfter)
+
+	expiry, err := CertificateExpiry(cert)
+	a.NoError(err)
+	a.True(expiry.Equal(notAfter))
+
+	_, err = CertificateExpiry("invalid")
+	a.Error(err)
+}
+
+func TestFingerprint(t *testing.T) {
+	a := assert.New(t)
+	a.Empty(Fingerprint(nil))
+	a.Equal(Fingerprint(&types.NodeTLSSetting{CA: "a"}), Fingerprint(&types.NodeTLSSetting{CA: "a"}))
+	a.NotEqual(Fingerprint(&types.NodeTLSSetting{CA: "a"}), Fingerprint(&types.NodeTLSSetting{Cert: "a"}))
+}
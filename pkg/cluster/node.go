@@ -13,18 +13,23 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/rpc"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/aria2"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/qbittorrent"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/slave"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/transmission"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/ytdlp"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"net/url"
 	"strconv"
+	"time"
 )
 
 type (
@@ -37,14 +42,24 @@ type (
 		CreateTask(ctx context.Context, taskType string, state string) (int, error)
 		// GetTask returns the task summary of the task with the given id.
 		GetTask(ctx context.Context, id int, clearOnComplete bool) (*SlaveTaskSummary, error)
+		// WatchTask calls onUpdate with task summary every interval until the task is terminal. Terminal
+		// tasks are kept in the node until fetched by GetTask.
+		WatchTask(ctx context.Context, id int, interval time.Duration, onUpdate func(*SlaveTaskSummary) error) error
 		// CleanupFolders cleans up the given folders on the node.
 		CleanupFolders(ctx context.Context, folders ...string) error
+		// GenerateThumb generates thumbnail of the file at args.Src on the node and uploads it to args.SavePath
+		// of args.Policy.
+		GenerateThumb(ctx context.Context, args *GenerateSlaveThumb) (*GenerateSlaveThumbResponse, error)
+		// EdgeCacheUrl returns a signed URL to download the entity through the edge cache of the node.
+		EdgeCacheUrl(ctx context.Context, args *EdgeCacheUrlArgs) (string, error)
 		// AuthInstance returns the auth instance for the node.
 		AuthInstance() auth.Auth
 		// CreateDownloader creates a downloader instance from the node for remote download tasks.
 		CreateDownloader(ctx context.Context, c request.Client, settings setting.Provider) (downloader.Downloader, error)
 		// Settings returns the settings of the node.
 		Settings(ctx context.Context) *types.NodeSetting
+		// Latency returns the average latency of recent calls to the node, 0 if unknown.
+		Latency() time.Duration
 	}
 
 	// Request body for creating tasks on slave node
@@ -58,6 +73,35 @@ type (
 		Path []string `json:"path" binding:"required"`
 	}
 
+	// Request body for generating thumbnails on slave node
+	GenerateSlaveThumb struct {
+		// Src is a signed URL of the source file.
+		Src string `json:"src" binding:"required"`
+		Ext string `json:"ext" binding:"required"`
+		// Policy is the storage policy the thumbnail will be uploaded to.
+		Policy *ent.StoragePolicy `json:"policy" binding:"required"`
+		// SavePath is the sidecar path of the thumbnail in Policy.
+		SavePath string `json:"save_path" binding:"required"`
+	}
+
+	// Arguments of downloading an entity through edge cache of slave node
+	EdgeCacheUrlArgs struct {
+		// Key identifies the entity content in edge cache.
+		Key  string
+		Size int64
+		// Origin is a signed URL to pull the entity from its storage policy.
+		Origin   string
+		Name     string
+		Download bool
+		Speed    int64
+		Expire   *time.Time
+	}
+
+	// Response of generating thumbnails on slave node
+	GenerateSlaveThumbResponse struct {
+		Size int64 `json:"size"`
+	}
+
 	SlaveTaskSummary struct {
 		Status       task.Status      `json:"status"`
 		Error        string           `json:"error"`
@@ -223,6 +267,10 @@ func NewDownloader(ctx context.Context, c request.Client, settings setting.Provi
 		return qbittorrent.NewClient(logging.FromContext(ctx), c, settings, options.QBittorrentSetting)
 	} else if options.Provider == types.DownloaderProviderAria2 {
 		return aria2.New(logging.FromContext(ctx), settings, options.Aria2Setting), nil
+	} else if options.Provider == types.DownloaderProviderTransmission {
+		return transmission.NewClient(logging.FromContext(ctx), c, settings, options.TransmissionSetting)
+	} else if options.Provider == types.DownloaderProviderYtDlp {
+		return ytdlp.New(logging.FromContext(ctx), settings, options.YtDlpSetting), nil
 	} else if options.Provider == "" {
 		return nil, errors.New("downloader not configured for this node")
 	} else {
@@ -233,24 +281,81 @@ func NewDownloader(ctx context.Context, c request.Client, settings setting.Provi
 type slaveNode struct {
 	nodeBase
 	client request.Client
+	// rpc is the gRPC client of the node, nil if gRPC address is not configured.
+	rpc     *rpc.Client
+	latency *latencyTracker
 }
 
 func newSlaveNode(ctx context.Context, model *ent.Node, config conf.ConfigProvider, settings setting.Provider) *slaveNode {
 	siteBasic := settings.SiteBasic(ctx)
-	return &slaveNode{
+	siteURL := settings.SiteURL(setting.UseFirstSiteUrl(ctx)).String()
+	// TLS settings are validated by node pool before the node is added.
+	transport, _ := NodeTransport(model)
+	latency := &latencyTracker{}
+	n := &slaveNode{
 		nodeBase: nodeBase{
 			model: model,
 		},
-		client: request.NewClient(config,
-			request.WithCorrelationID(),
-			request.WithSlaveMeta(model.ID),
-			request.WithMasterMeta(siteBasic.ID, settings.SiteURL(setting.UseFirstSiteUrl(ctx)).String()),
-			request.WithCredential(auth.HMACAuth{[]byte(model.SlaveKey)}, int64(settings.SlaveRequestSignTTL(ctx))),
-			request.WithEndpoint(model.Server)),
+		client: &latencyClient{
+			Client: request.NewClient(config,
+				request.WithCorrelationID(),
+				request.WithSlaveMeta(model.ID),
+				request.WithMasterMeta(siteBasic.ID, siteURL),
+				request.WithCredential(auth.HMACAuth{SecretKey: []byte(model.SlaveKey)}, int64(settings.SlaveRequestSignTTL(ctx))),
+				request.WithEndpoint(model.Server),
+				request.WithTransport(transport)),
+			tracker: latency,
+		},
+		latency: latency,
+	}
+
+	if model.Settings != nil && model.Settings.GRPCServer != "" {
+		rpcClient, err := rpc.GetClient(&rpc.Options{
+			Addr:        model.Settings.GRPCServer,
+			Auth:        auth.HMACAuth{SecretKey: []byte(model.SlaveKey)},
+			SignTTL:     int64(settings.SlaveRequestSignTTL(ctx)),
+			NodeID:      model.ID,
+			SiteID:      siteBasic.ID,
+			SiteURL:     siteURL,
+			SiteVersion: constants.BackendVersion,
+			TLS:         model.Settings.TLS,
+		})
+		if err != nil {
+			logging.FromContext(ctx).Warning("Failed to create gRPC client for node %q, HTTP API will be used: %s", model.Name, err)
+		} else {
+			n.rpc = rpcClient
+		}
 	}
+
+	return n
+}
+
+// fallbackToHTTP returns whether a gRPC call failed with err should be retried with HTTP API.
+func (n *slaveNode) fallbackToHTTP(ctx context.Context, err error) bool {
+	if rpc.IsUnavailable(err) {
+		logging.FromContext(ctx).Debug("gRPC service of node %q is unavailable, fallback to HTTP API: %s", n.model.Name, err)
+		return true
+	}
+
+	return false
+}
+
+func (n *slaveNode) Latency() time.Duration {
+	return n.latency.Latency()
 }
 
 func (n *slaveNode) CreateTask(ctx context.Context, taskType string, state string) (int, error) {
+	if n.rpc != nil {
+		start := time.Now()
+		id, err := n.rpc.CreateTask(ctx, taskType, state)
+		if err == nil {
+			n.latency.Observe(time.Since(start))
+		}
+		if !n.fallbackToHTTP(ctx, err) {
+			return id, err
+		}
+	}
+
 	reqBody, err := json.Marshal(&CreateSlaveTask{
 		Type:  taskType,
 		State: state,
@@ -284,6 +389,20 @@ func (n *slaveNode) CreateTask(ctx context.Context, taskType string, state strin
 }
 
 func (n *slaveNode) GetTask(ctx context.Context, id int, clearOnComplete bool) (*SlaveTaskSummary, error) {
+	if n.rpc != nil {
+		start := time.Now()
+		summary, err := n.rpc.GetTask(ctx, id, clearOnComplete)
+		if err == nil {
+			n.latency.Observe(time.Since(start))
+		}
+		if !n.fallbackToHTTP(ctx, err) {
+			if err != nil {
+				return nil, err
+			}
+			return fromRPCSummary(summary), nil
+		}
+	}
+
 	resp, err := n.client.Request(
 		"GET",
 		routes.SlaveGetTaskRoute(id, clearOnComplete),
@@ -306,6 +425,48 @@ func (n *slaveNode) GetTask(ctx context.Context, id int, clearOnComplete bool) (
 	return summary, nil
 }
 
+func (n *slaveNode) WatchTask(ctx context.Context, id int, interval time.Duration, onUpdate func(*SlaveTaskSummary) error) error {
+	if n.rpc != nil {
+		err := n.rpc.WatchTask(ctx, id, interval, func(summary *rpc.TaskSummary) error {
+			return onUpdate(fromRPCSummary(summary))
+		})
+		if !n.fallbackToHTTP(ctx, err) {
+			return err
+		}
+	}
+
+	// Poll task status with HTTP API
+	for {
+		summary, err := n.GetTask(ctx, id, false)
+		if err != nil {
+			return err
+		}
+
+		if err := onUpdate(summary); err != nil {
+			return err
+		}
+
+		if summary.Status == task.StatusCompleted || summary.Status == task.StatusError || summary.Status == task.StatusCanceled {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+func fromRPCSummary(summary *rpc.TaskSummary) *SlaveTaskSummary {
+	return &SlaveTaskSummary{
+		Status:       task.Status(summary.Status),
+		Error:        summary.Error,
+		PrivateState: summary.PrivateState,
+		Progress:     rpc.FromProgresses(summary.Progress),
+	}
+}
+
 func (b *slaveNode) CleanupFolders(ctx context.Context, folders ...string) error {
 	args := &FolderCleanup{
 		Path: folders,
@@ -334,6 +495,51 @@ func (b *slaveNode) CleanupFolders(ctx context.Context, folders ...string) error
 	return nil
 }
 
+func (b *slaveNode) GenerateThumb(ctx context.Context, args *GenerateSlaveThumb) (*GenerateSlaveThumbResponse, error) {
+	reqBody, err := json.Marshal(args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	resp, err := b.client.Request(
+		"POST",
+		constants.APIPrefixSlave+"/thumb",
+		bytes.NewReader(reqBody),
+		request.WithContext(ctx),
+		request.WithLogger(logging.FromContext(ctx)),
+	).CheckHTTPResponse(200).DecodeResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	// 处理列取结果
+	if resp.Code != 0 {
+		return nil, serializer.NewErrorFromResponse(resp)
+	}
+
+	var res *GenerateSlaveThumbResponse
+	if resp.GobDecode(&res); res != nil {
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("unexpected response data: %v", resp.Data)
+}
+
+func (b *slaveNode) EdgeCacheUrl(ctx context.Context, args *EdgeCacheUrlArgs) (string, error) {
+	server, err := url.Parse(b.model.Server)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse node server url: %w", err)
+	}
+
+	base := routes.SlaveEdgeCacheUrl(server, args.Key, args.Size, args.Origin, args.Name, args.Download, args.Speed)
+	signed, err := auth.SignURI(ctx, b.AuthInstance(), base.String(), args.Expire)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign edge cache url: %w", err)
+	}
+
+	return signed.String(), nil
+}
+
 func (b *slaveNode) CreateDownloader(ctx context.Context, c request.Client, settings setting.Provider) (downloader.Downloader, error) {
 	return slave.NewSlaveDownloader(b.client, b.Settings(ctx)), nil
 }
@@ -366,10 +572,22 @@ func (b *nodeBase) GetTask(ctx context.Context, id int, clearOnComplete bool) (*
 	return nil, errors.New("not implemented")
 }
 
+func (b *nodeBase) WatchTask(ctx context.Context, id int, interval time.Duration, onUpdate func(*SlaveTaskSummary) error) error {
+	return errors.New("not implemented")
+}
+
 func (b *nodeBase) CleanupFolders(ctx context.Context, folders ...string) error {
 	return errors.New("not implemented")
 }
 
+func (b *nodeBase) GenerateThumb(ctx context.Context, args *GenerateSlaveThumb) (*GenerateSlaveThumbResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (b *nodeBase) EdgeCacheUrl(ctx context.Context, args *EdgeCacheUrlArgs) (string, error) {
+	return "", errors.New("not implemented")
+}
+
 func (b *nodeBase) PrepareUpload(ctx context.Context, args *fs.StatelessPrepareUploadService) (*fs.StatelessPrepareUploadResponse, error) {
 	return nil, errors.New("not implemented")
 }
@@ -390,6 +608,10 @@ func (b *nodeBase) CreateDownloader(ctx context.Context, c request.Client, setti
 	return nil, errors.New("not implemented")
 }
 
+func (b *nodeBase) Latency() time.Duration {
+	return 0
+}
+
 func (b *nodeBase) Settings(ctx context.Context) *types.NodeSetting {
 	return b.model.Settings
 }
@@ -0,0 +1,105 @@
+package cluster
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/stretchr/testify/assert"
+)
+
+type testConfig struct {
+	conf.ConfigProvider
+}
+
+func (testConfig) System() *conf.System {
+	return &conf.System{Mode: conf.MasterMode}
+}
+
+func testSlaveNode(t *testing.T, handler http.HandlerFunc) *slaveNode {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	return &slaveNode{
+		nodeBase: nodeBase{model: &ent.Node{ID: 1, Server: srv.URL}},
+		client:   request.NewClient(testConfig{}, request.WithEndpoint(srv.URL)),
+	}
+}
+
+func TestSlaveNodeGenerateThumb(t *testing.T) {
+	a := assert.New(t)
+	args := &GenerateSlaveThumb{
+		Src:      "https://master/source",
+		Ext:      "jpg",
+		Policy:   &ent.StoragePolicy{ID: 2, Name: "s3"},
+		SavePath: "uploads/a.jpg.rand_thumb",
+	}
+
+	n := testSlaveNode(t, func(w http.ResponseWriter, r *http.Request) {
+		a.Equal(constants.APIPrefixSlave+"/thumb", r.URL.Path)
+
+		var received GenerateSlaveThumb
+		a.NoError(json.NewDecoder(r.Body).Decode(&received))
+		a.Equal(args.Src, received.Src)
+		a.Equal(args.SavePath, received.SavePath)
+		a.Equal(args.Policy.ID, received.Policy.ID)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(serializer.NewResponseWithGobData(context.Background(),
+			&GenerateSlaveThumbResponse{Size: 42}))
+	})
+
+	res, err := n.GenerateThumb(context.Background(), args)
+	a.NoError(err)
+	a.EqualValues(42, res.Size)
+}
+
+func TestSlaveNodeGenerateThumbError(t *testing.T) {
+	a := assert.New(t)
+	n := testSlaveNode(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(serializer.Response{Code: serializer.CodeIOFailed, Msg: "Failed to upload file"})
+	})
+
+	res, err := n.GenerateThumb(context.Background(), &GenerateSlaveThumb{})
+	a.Nil(res)
+	var appErr serializer.AppError
+	a.ErrorAs(err, &appErr)
+	a.Equal(serializer.CodeIOFailed, appErr.Code)
+}
+
+func TestSlaveNodeEdgeCacheUrl(t *testing.T) {
+	a := assert.New(t)
+	n := &slaveNode{nodeBase: nodeBase{model: &ent.Node{ID: 1, Server: "https://edge.example.com", SlaveKey: "key"}}}
+	expire := time.Now().Add(time.Hour)
+	origin := "https://bucket.example.com/uploads/a.mp4?sign=abc"
+
+	res, err := n.EdgeCacheUrl(context.Background(), &EdgeCacheUrlArgs{
+		Key:      "site_1",
+		Size:     1024,
+		Origin:   origin,
+		Name:     "a b.mp4",
+		Download: true,
+		Speed:    0,
+		Expire:   &expire,
+	})
+	a.NoError(err)
+
+	u, err := url.Parse(res)
+	a.NoError(err)
+	a.Equal("edge.example.com", u.Host)
+	a.Equal(constants.APIPrefixSlave+"/edge/site_1/1024/0/"+base64.URLEncoding.EncodeToString([]byte(origin))+"/a b.mp4", u.Path)
+	a.Equal("true", u.Query().Get("download"))
+	a.NoError(auth.CheckURI(context.Background(), auth.HMACAuth{SecretKey: []byte("key")}, u))
+}
@@ -18,9 +18,16 @@ import (
 type NodePool interface {
 	// Upsert updates or inserts a node into the pool.
 	Upsert(ctx context.Context, node *ent.Node)
-	// Get returns a node with the given capability and preferred node id. `allowed` is a list of allowed node ids.
-	// If `allowed` is empty, all nodes with the capability are considered.
-	Get(ctx context.Context, capability types.NodeCapability, preferred int) (Node, error)
+	// Get returns a node with the given capability and preferred node id. Nodes in `exclude` are
+	// not selected.
+	Get(ctx context.Context, capability types.NodeCapability, preferred int, exclude ...int) (Node, error)
+	// GetByID returns the node with given ID, including offline ones.
+	GetByID(ctx context.Context, id int) (Node, error)
+	// SetOnline marks a node as online or offline. Offline nodes are not selected by Get.
+	SetOnline(id int, online bool)
+	// SetResources updates the resource usage of a node reported in heartbeat. Nodes above admission
+	// thresholds are not selected by Get for heavy tasks.
+	SetResources(id int, hb *Heartbeat)
 }
 
 type (
@@ -31,12 +38,23 @@ type (
 		settings setting.Provider
 
 		nodes map[types.NodeCapability][]*nodeItem
+		// offline IDs of nodes that missed heartbeats.
+		offline map[int]bool
+		// resources reported in the latest heartbeat of nodes.
+		resources map[int]*Heartbeat
+
+		strategies map[types.NodeCapability]*strategySlot
+		tasks      *activeTaskCache
 	}
 
 	nodeItem struct {
-		node    Node
-		weight  int
-		current int
+		node   Node
+		weight int
+	}
+
+	strategySlot struct {
+		name     string
+		strategy BalanceStrategy
 	}
 )
 
@@ -48,22 +66,42 @@ var (
 		types.NodeCapabilityCreateArchive,
 		types.NodeCapabilityExtractArchive,
 		types.NodeCapabilityRemoteDownload,
+		types.NodeCapabilityGenerateThumb,
+		types.NodeCapabilityTranscode,
+		types.NodeCapabilityEdgeCache,
+	}
+
+	// heavyCapabilities are not scheduled onto nodes above admission thresholds.
+	heavyCapabilities = []types.NodeCapability{
+		types.NodeCapabilityCreateArchive,
+		types.NodeCapabilityExtractArchive,
+		types.NodeCapabilityTranscode,
 	}
 )
 
 func NewNodePool(ctx context.Context, l logging.Logger, config conf.ConfigProvider, settings setting.Provider,
-	client inventory.NodeClient) (NodePool, error) {
+	client inventory.NodeClient, taskClient inventory.TaskClient) (NodePool, error) {
 	nodes, err := client.ListActiveNodes(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list active nodes: %w", err)
 	}
 
 	pool := &weightedNodePool{
-		nodes:    make(map[types.NodeCapability][]*nodeItem),
-		conf:     config,
-		settings: settings,
+		nodes:      make(map[types.NodeCapability][]*nodeItem),
+		offline:    make(map[int]bool),
+		resources:  make(map[int]*Heartbeat),
+		strategies: make(map[types.NodeCapability]*strategySlot),
+		tasks:      &activeTaskCache{client: taskClient},
+		conf:       config,
+		settings:   settings,
 	}
+	secureMode := settings.ClusterSecurity(ctx).SecureMode
 	for _, node := range nodes {
+		if err := CheckNodeSecurity(node, secureMode); err != nil {
+			l.Warning("Node %q is not added to pool: %s", node.Name, err)
+			continue
+		}
+
 		for _, capability := range supportedCapabilities {
 			// If current capability is enabled, add it to pool slot.
 			if capability == types.NodeCapabilityNone ||
@@ -74,9 +112,8 @@ func NewNodePool(ctx context.Context, l logging.Logger, config conf.ConfigProvid
 
 				l.Debug("Add node %q to capability slot %d with weight %d", node.Name, capability, node.Weight)
 				pool.nodes[capability] = append(pool.nodes[capability], &nodeItem{
-					node:    newNode(ctx, node, config, settings),
-					weight:  node.Weight,
-					current: 0,
+					node:   newNode(ctx, node, config, settings),
+					weight: node.Weight,
 				})
 			}
 		}
@@ -85,79 +122,126 @@ func NewNodePool(ctx context.Context, l logging.Logger, config conf.ConfigProvid
 	return pool, nil
 }
 
-func (p *weightedNodePool) Get(ctx context.Context, capability types.NodeCapability, preferred int) (Node, error) {
+func (p *weightedNodePool) Get(ctx context.Context, capability types.NodeCapability, preferred int, exclude ...int) (Node, error) {
 	l := logging.FromContext(ctx)
-	p.lock.RLock()
-	defer p.lock.RUnlock()
+	// Strategies keep selection state, so a write lock is required.
+	p.lock.Lock()
+	defer p.lock.Unlock()
 
-	nodes, ok := p.nodes[capability]
-	if !ok || len(nodes) == 0 {
+	nodes := lo.Filter(p.nodes[capability], func(item *nodeItem, index int) bool {
+		return !p.offline[item.node.ID()] && !lo.Contains(exclude, item.node.ID())
+	})
+	if len(nodes) == 0 {
 		return nil, fmt.Errorf("no node found with capability %d: %w", capability, ErrNoAvailableNode)
 	}
 
-	var selected *nodeItem
+	if lo.Contains(heavyCapabilities, capability) {
+		thresholds := p.settings.NodeAdmission(ctx)
+		nodes = lo.Filter(nodes, func(item *nodeItem, index int) bool {
+			hb, ok := p.resources[item.node.ID()]
+			if !ok {
+				return true
+			}
+
+			if resource := hb.Overloaded(thresholds); resource != "" {
+				l.Debug("Node %q is skipped for capability %d due to high %s usage", item.node.Name(), capability, resource)
+				return false
+			}
+
+			return true
+		})
+		if len(nodes) == 0 {
+			return nil, fmt.Errorf("all nodes with capability %d are overloaded: %w", capability, ErrNoAvailableNode)
+		}
+	}
 
 	if preferred > 0 {
 		// First try to find the preferred node.
 		for _, n := range nodes {
 			if n.node.ID() == preferred {
-				selected = n
-				break
+				return n.node, nil
 			}
 		}
 
-		if selected == nil {
-			l.Debug("Preferred node %d not found, fallback to select a node with the least current weight", preferred)
-		}
+		l.Debug("Preferred node %d not found, fallback to select a node with load balancing strategy", preferred)
 	}
 
-	if selected == nil {
-		// If no preferred one, or the preferred one is not available, select a node with the least current weight.
+	candidates := lo.Map(nodes, func(item *nodeItem, index int) Candidate {
+		return Candidate{Node: item.node, Weight: item.weight}
+	})
+	selected := p.strategy(ctx, capability).Select(ctx, candidates)
+	l.Debug("Selected node %q for capability %d", selected.Name(), capability)
+	return selected, nil
+}
 
-		// Total weight of all items.
-		var total int
+// strategy returns the load balancing strategy of capability, recreated if setting is changed.
+func (p *weightedNodePool) strategy(ctx context.Context, capability types.NodeCapability) BalanceStrategy {
+	name := strategyName(p.settings.NodeBalanceStrategies(ctx), capability)
+	slot, ok := p.strategies[capability]
+	if !ok || slot.name != name {
+		slot = &strategySlot{name: name, strategy: newBalanceStrategy(name, p.tasks)}
+		p.strategies[capability] = slot
+	}
 
-		// Loop through the list of items and add the item's weight to the current weight.
-		// Also increment the total weight counter.
-		var maxNode *nodeItem
-		for _, item := range nodes {
-			item.current += max(1, item.weight)
-			total += max(1, item.weight)
+	return slot.strategy
+}
 
-			// Select the item with max weight.
-			if maxNode == nil || item.current > maxNode.current {
-				maxNode = item
-			}
-		}
+func (p *weightedNodePool) GetByID(ctx context.Context, id int) (Node, error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
 
-		// Select the item with the max weight.
-		selected = maxNode
-		if selected == nil {
-			return nil, fmt.Errorf("no node found with capability %d: %w", capability, ErrNoAvailableNode)
-		}
+	item, found := lo.Find(p.nodes[types.NodeCapabilityNone], func(i *nodeItem) bool {
+		return i.node.ID() == id
+	})
+	if !found {
+		return nil, fmt.Errorf("node %d not found: %w", id, ErrNoAvailableNode)
+	}
 
-		l.Debug("Selected node %q with weight=%d, current=%d, total=%d", selected.node.Name(), selected.weight, maxNode.current, total)
+	return item.node, nil
+}
 
-		// Reduce the current weight of the selected item by the total weight.
-		maxNode.current -= total
+func (p *weightedNodePool) SetOnline(id int, online bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if online {
+		delete(p.offline, id)
+	} else {
+		p.offline[id] = true
 	}
+}
 
-	return selected.node, nil
+func (p *weightedNodePool) SetResources(id int, hb *Heartbeat) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if hb == nil {
+		delete(p.resources, id)
+	} else {
+		p.resources[id] = hb
+	}
 }
 
 func (p *weightedNodePool) Upsert(ctx context.Context, n *ent.Node) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
 
+	secErr := CheckNodeSecurity(n, p.settings.ClusterSecurity(ctx).SecureMode)
+	if secErr != nil {
+		logging.FromContext(ctx).Warning("Node %q is removed from pool: %s", n.Name, secErr)
+	}
+
 	for _, capability := range supportedCapabilities {
 		_, index, found := lo.FindIndexOf(p.nodes[capability], func(i *nodeItem) bool {
 			return i.node.ID() == n.ID
 		})
 		if capability == types.NodeCapabilityNone ||
 			(n.Capabilities != nil && n.Capabilities.Enabled(int(capability))) {
-			if n.Status != node.StatusActive && found {
-				// Remove inactive node
-				p.nodes[capability] = append(p.nodes[capability][:index], p.nodes[capability][index+1:]...)
+			if n.Status != node.StatusActive || secErr != nil {
+				// Remove inactive or insecure node
+				if found {
+					p.nodes[capability] = append(p.nodes[capability][:index], p.nodes[capability][index+1:]...)
+				}
 				continue
 			}
 
@@ -165,9 +249,8 @@ func (p *weightedNodePool) Upsert(ctx context.Context, n *ent.Node) {
 				p.nodes[capability][index].node = newNode(ctx, n, p.conf, p.settings)
 			} else {
 				p.nodes[capability] = append(p.nodes[capability], &nodeItem{
-					node:    newNode(ctx, n, p.conf, p.settings),
-					weight:  n.Weight,
-					current: 0,
+					node:   newNode(ctx, n, p.conf, p.settings),
+					weight: n.Weight,
 				})
 			}
 		} else if found {
@@ -198,6 +281,16 @@ func NewSlaveDummyNodePool(ctx context.Context, config conf.ConfigProvider, sett
 func (s *slaveDummyNodePool) Upsert(ctx context.Context, node *ent.Node) {
 }
 
-func (s *slaveDummyNodePool) Get(ctx context.Context, capability types.NodeCapability, preferred int) (Node, error) {
+func (s *slaveDummyNodePool) Get(ctx context.Context, capability types.NodeCapability, preferred int, exclude ...int) (Node, error) {
+	return s.masterNode, nil
+}
+
+func (s *slaveDummyNodePool) GetByID(ctx context.Context, id int) (Node, error) {
 	return s.masterNode, nil
 }
+
+func (s *slaveDummyNodePool) SetOnline(id int, online bool) {
+}
+
+func (s *slaveDummyNodePool) SetResources(id int, hb *Heartbeat) {
+}
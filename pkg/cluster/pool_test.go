@@ -0,0 +1,80 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/stretchr/testify/assert"
+)
+
+type testSettings struct {
+	setting.Provider
+	admission *setting.NodeAdmission
+}
+
+func (s *testSettings) NodeAdmission(ctx context.Context) *setting.NodeAdmission {
+	return s.admission
+}
+
+func (s *testSettings) NodeBalanceStrategies(ctx context.Context) map[string]string {
+	return map[string]string{}
+}
+
+func TestHeartbeatOverloaded(t *testing.T) {
+	a := assert.New(t)
+	thresholds := &setting.NodeAdmission{CPU: 90, Memory: 80, Disk: 95, Bandwidth: 100}
+
+	a.Equal("", (&Heartbeat{}).Overloaded(thresholds))
+	a.Equal("cpu", (&Heartbeat{CPUUsage: 95}).Overloaded(thresholds))
+	a.Equal("memory", (&Heartbeat{MemTotal: 100, MemAvailable: 10}).Overloaded(thresholds))
+	a.Equal("", (&Heartbeat{MemTotal: 100, MemAvailable: 30}).Overloaded(thresholds))
+	a.Equal("disk", (&Heartbeat{DiskTotal: 100, DiskFree: 1}).Overloaded(thresholds))
+	// 15 MB/s = 120 Mbps
+	a.Equal("bandwidth", (&Heartbeat{NetRx: 10 * 1000 * 1000, NetTx: 5 * 1000 * 1000}).Overloaded(thresholds))
+	a.Equal("", (&Heartbeat{CPUUsage: 95}).Overloaded(&setting.NodeAdmission{}))
+}
+
+func TestPoolAdmission(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+	busy := &slaveNode{nodeBase: nodeBase{model: &ent.Node{ID: 1, Name: "busy"}}}
+	idle := &slaveNode{nodeBase: nodeBase{model: &ent.Node{ID: 2, Name: "idle"}}}
+	items := []*nodeItem{{node: busy, weight: 1}, {node: idle, weight: 1}}
+	p := &weightedNodePool{
+		settings: &testSettings{admission: &setting.NodeAdmission{CPU: 90}},
+		nodes: map[types.NodeCapability][]*nodeItem{
+			types.NodeCapabilityTranscode:      items,
+			types.NodeCapabilityRemoteDownload: items,
+		},
+		offline:    make(map[int]bool),
+		resources:  make(map[int]*Heartbeat),
+		strategies: make(map[types.NodeCapability]*strategySlot),
+	}
+	p.SetResources(busy.ID(), &Heartbeat{CPUUsage: 99})
+	p.SetResources(idle.ID(), &Heartbeat{CPUUsage: 10})
+
+	for i := 0; i < 10; i++ {
+		n, err := p.Get(ctx, types.NodeCapabilityTranscode, 0)
+		a.NoError(err)
+		a.Equal(idle.ID(), n.ID())
+	}
+
+	// Light tasks are not subject to admission control
+	n, err := p.Get(ctx, types.NodeCapabilityRemoteDownload, busy.ID())
+	a.NoError(err)
+	a.Equal(busy.ID(), n.ID())
+
+	p.SetResources(idle.ID(), &Heartbeat{CPUUsage: 95})
+	_, err = p.Get(ctx, types.NodeCapabilityTranscode, 0)
+	a.True(errors.Is(err, ErrNoAvailableNode))
+
+	// Nodes without reports are admitted
+	p.SetResources(idle.ID(), nil)
+	n, err = p.Get(ctx, types.NodeCapabilityTranscode, 0)
+	a.NoError(err)
+	a.Equal(idle.ID(), n.ID())
+}
@@ -22,12 +22,18 @@ var (
 	masterUserActivate *url.URL
 	masterUserReset    *url.URL
 	masterHome         *url.URL
+	masterEmailConfirm *url.URL
+	masterEmailVeto    *url.URL
+	masterEmailAlias   *url.URL
 )
 
 func init() {
 	masterPing, _ = url.Parse(constants.APIPrefix + "/site/ping")
 	masterUserActivate, _ = url.Parse("/session/activate")
 	masterUserReset, _ = url.Parse("/session/reset")
+	masterEmailConfirm, _ = url.Parse("/session/email/confirm")
+	masterEmailVeto, _ = url.Parse("/session/email/veto")
+	masterEmailAlias, _ = url.Parse("/session/email/alias")
 }
 
 func FrontendHomeUrl(base *url.URL, path string) *url.URL {
@@ -61,6 +67,18 @@ func MasterUserResetUrl(base *url.URL) *url.URL {
 	return base.ResolveReference(masterUserReset)
 }
 
+func MasterEmailConfirmUrl(base *url.URL) *url.URL {
+	return base.ResolveReference(masterEmailConfirm)
+}
+
+func MasterEmailVetoUrl(base *url.URL) *url.URL {
+	return base.ResolveReference(masterEmailVeto)
+}
+
+func MasterEmailAliasUrl(base *url.URL) *url.URL {
+	return base.ResolveReference(masterEmailAlias)
+}
+
 func MasterShareUrl(base *url.URL, id, password string) *url.URL {
 	p := "/s/" + id
 	if password != "" {
@@ -95,6 +113,25 @@ func MasterArchiveDownloadUrl(base *url.URL, sessionID string) *url.URL {
 	return base.ResolveReference(routes)
 }
 
+// MasterLinkUrl returns URL of the page confirming navigation to the target of a web link file.
+func MasterLinkUrl(base *url.URL, fileID string) *url.URL {
+	routes, err := url.Parse(path.Join(constants.APIPrefix, "file", "link", fileID))
+	if err != nil {
+		return nil
+	}
+
+	return base.ResolveReference(routes)
+}
+
+func MasterTakeoutDownloadUrl(base *url.URL, taskID string) *url.URL {
+	routes, err := url.Parse(path.Join(constants.APIPrefix, "workflow", "takeout", taskID, "takeout.zip"))
+	if err != nil {
+		return nil
+	}
+
+	return base.ResolveReference(routes)
+}
+
 func MasterPolicyOAuthCallback(base *url.URL) *url.URL {
 	if base.Scheme != "https" {
 		base.Scheme = "https"
@@ -134,6 +171,34 @@ func MasterStatelessUrl(base, method string) *url.URL {
 	return masterBase.ResolveReference(routes)
 }
 
+func MasterHeartbeatUrl(base string) *url.URL {
+	masterBase, err := url.Parse(base)
+	if err != nil {
+		return nil
+	}
+
+	routes, err := url.Parse(path.Join(constants.APIPrefixSlave, "heartbeat"))
+	if err != nil {
+		return nil
+	}
+
+	return masterBase.ResolveReference(routes)
+}
+
+func MasterDavAssertionUrl(base string) *url.URL {
+	masterBase, err := url.Parse(base)
+	if err != nil {
+		return nil
+	}
+
+	routes, err := url.Parse(path.Join(constants.APIPrefixSlave, "dav", "assertion"))
+	if err != nil {
+		return nil
+	}
+
+	return masterBase.ResolveReference(routes)
+}
+
 func SlaveUploadUrl(base *url.URL, sessionID string) *url.URL {
 	base.Path = path.Join(base.Path, constants.APIPrefixSlave, "/upload", sessionID)
 	return base
@@ -165,6 +230,16 @@ func MasterWopiSrc(base *url.URL, sessionId string) *url.URL {
 	return base.ResolveReference(route)
 }
 
+func MasterOnlyOfficeContentUrl(base *url.URL, fileId, accessToken string) *url.URL {
+	route, _ := url.Parse(constants.APIPrefix + "/file/onlyoffice/" + fileId + "/content?access_token=" + url.QueryEscape(accessToken))
+	return base.ResolveReference(route)
+}
+
+func MasterOnlyOfficeCallbackUrl(base *url.URL, fileId, accessToken string) *url.URL {
+	route, _ := url.Parse(constants.APIPrefix + "/file/onlyoffice/" + fileId + "/callback?access_token=" + url.QueryEscape(accessToken))
+	return base.ResolveReference(route)
+}
+
 func SlaveFileContentUrl(base *url.URL, srcPath, name string, download bool, speed int64, nodeId int) *url.URL {
 	srcPath = url.PathEscape(base64.URLEncoding.EncodeToString([]byte(srcPath)))
 	name = url.PathEscape(name)
@@ -180,6 +255,21 @@ func SlaveFileContentUrl(base *url.URL, srcPath, name string, download bool, spe
 	return base
 }
 
+func SlaveEdgeCacheUrl(base *url.URL, key string, size int64, origin, name string, download bool, speed int64) *url.URL {
+	origin = url.PathEscape(base64.URLEncoding.EncodeToString([]byte(origin)))
+	route, _ := url.Parse(constants.APIPrefixSlave + fmt.Sprintf("/edge/%s/%d/%d/%s/%s",
+		url.PathEscape(key), size, speed, origin, url.PathEscape(name)))
+	base = base.ResolveReference(route)
+
+	values := url.Values{}
+	if download {
+		values.Set(IsDownloadQuery, "true")
+	}
+
+	base.RawQuery = values.Encode()
+	return base
+}
+
 func SlaveMediaMetaRoute(src, ext string) string {
 	src = url.PathEscape(base64.URLEncoding.EncodeToString([]byte(src)))
 	return fmt.Sprintf("file/meta/%s/%s", src, url.PathEscape(ext))
@@ -0,0 +1,245 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/mtls"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/rpcerr"
+	"github.com/cloudreve/Cloudreve/v4/pkg/tracing"
+	"github.com/gofrs/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// Client calls the gRPC service of a slave node.
+type Client struct {
+	client SlaveClient
+	auth   auth.Auth
+	// signTTL validity of call signatures in seconds.
+	signTTL int64
+	meta    metadata.MD
+}
+
+var (
+	clientsMu sync.Mutex
+	clients   = make(map[string]*Client)
+)
+
+// Options of Client.
+type Options struct {
+	// Addr is the gRPC address of the slave node, in host:port form.
+	Addr    string
+	Auth    auth.Auth
+	SignTTL int64
+	NodeID  int
+	// SiteID and SiteURL of master site.
+	SiteID      string
+	SiteURL     string
+	SiteVersion string
+	// TLS enables mutual TLS with the slave node if not nil.
+	TLS *types.NodeTLSSetting
+}
+
+// GetClient returns a client sharing the connection with other clients of the same address and
+// credential. Connections are established lazily on the first call.
+func GetClient(opts *Options) (*Client, error) {
+	key := fmt.Sprintf("%s|%d|%s|%s", opts.Addr, opts.NodeID, opts.Auth.Sign(opts.Addr, 0), mtls.Fingerprint(opts.TLS))
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+
+	if c, ok := clients[key]; ok {
+		return c, nil
+	}
+
+	creds := insecure.NewCredentials()
+	if opts.TLS != nil {
+		tlsConfig, err := mtls.ClientConfig(opts.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build TLS config: %w", err)
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	conn, err := grpc.NewClient(opts.Addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC client: %w", err)
+	}
+
+	c := &Client{
+		client:  NewSlaveClient(conn),
+		auth:    opts.Auth,
+		signTTL: opts.SignTTL,
+		meta: metadata.Pairs(
+			NodeIDKey, strconv.Itoa(opts.NodeID),
+			SiteIDKey, opts.SiteID,
+			SiteURLKey, opts.SiteURL,
+			SiteVersionKey, opts.SiteVersion,
+		),
+	}
+	clients[key] = c
+	return c, nil
+}
+
+// outgoingContext attaches metadata and signature of given method and request to ctx. For streams,
+// req is the first message sent.
+func (c *Client) outgoingContext(ctx context.Context, fullMethod string, req proto.Message) (context.Context, error) {
+	md := c.meta.Copy()
+	digest, err := requestDigest(fullMethod, md, req)
+	if err != nil {
+		return nil, err
+	}
+
+	md.Set(authorizationKey, c.auth.Sign(digest, time.Now().Unix()+c.signTTL))
+	if cid := logging.CorrelationID(ctx); cid != uuid.Nil {
+		md.Set(CorrelationIDKey, cid.String())
+	}
+
+	// Propagate trace context, slave nodes continue the trace from master.
+	tracing.InjectMetadata(ctx, md)
+	return metadata.NewOutgoingContext(ctx, md), nil
+}
+
+// startSpan starts a client span for a call of given method.
+func startSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "gRPC "+fullMethod,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("rpc.method", fullMethod),
+		),
+	)
+}
+
+// invoke calls a unary method with signed req.
+func invoke[Req proto.Message, Res any](ctx context.Context, c *Client, fullMethod string, req Req,
+	call func(context.Context, Req, ...grpc.CallOption) (*Res, error)) (res *Res, err error) {
+	ctx, span := startSpan(ctx, fullMethod)
+	defer func() { tracing.End(span, err) }()
+
+	ctx, err = c.outgoingContext(ctx, fullMethod, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var trailer metadata.MD
+	res, err = call(ctx, req, grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, rpcerr.FromStatus(err, trailer)
+	}
+
+	return res, nil
+}
+
+// Ping checks the connection to slave node.
+func (c *Client) Ping(ctx context.Context, masterURL string) (*PingResponse, error) {
+	return invoke(ctx, c, Slave_Ping_FullMethodName, &PingRequest{MasterUrl: masterURL}, c.client.Ping)
+}
+
+// CreateTask creates a task on slave node and returns its ID.
+func (c *Client) CreateTask(ctx context.Context, taskType, state string) (int, error) {
+	res, err := invoke(ctx, c, Slave_CreateTask_FullMethodName, &CreateTaskRequest{Type: taskType, State: state}, c.client.CreateTask)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(res.Id), nil
+}
+
+// GetTask returns summary of a task on slave node.
+func (c *Client) GetTask(ctx context.Context, id int, clearOnComplete bool) (*TaskSummary, error) {
+	return invoke(ctx, c, Slave_GetTask_FullMethodName, &GetTaskRequest{Id: int64(id), ClearOnComplete: clearOnComplete}, c.client.GetTask)
+}
+
+// WatchTask calls onUpdate with task summary every interval until the task is terminal,
+// onUpdate returns an error or ctx is done.
+func (c *Client) WatchTask(ctx context.Context, id int, interval time.Duration, onUpdate func(*TaskSummary) error) (err error) {
+	ctx, span := startSpan(ctx, Slave_WatchTask_FullMethodName)
+	defer func() { tracing.End(span, err) }()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	req := &WatchTaskRequest{Id: int64(id), Interval: int32(interval.Seconds())}
+	ctx, err = c.outgoingContext(ctx, Slave_WatchTask_FullMethodName, req)
+	if err != nil {
+		return err
+	}
+
+	stream, err := c.client.WatchTask(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		summary, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return rpcerr.FromStatus(err, stream.Trailer())
+		}
+
+		if err := onUpdate(summary); err != nil {
+			return err
+		}
+	}
+}
+
+// UploadChunk streams a chunk of given upload session to slave node.
+func (c *Client) UploadChunk(ctx context.Context, sessionID string, index int, overwrite bool, chunk io.Reader, size int64) (err error) {
+	ctx, span := startSpan(ctx, Slave_UploadChunk_FullMethodName)
+	defer func() { tracing.End(span, err) }()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// The signed first frame only carries session related fields, data follows in later frames.
+	header := &UploadChunkFrame{SessionId: sessionID, Index: int64(index), Size: size, Overwrite: overwrite}
+	ctx, err = c.outgoingContext(ctx, Slave_UploadChunk_FullMethodName, header)
+	if err != nil {
+		return err
+	}
+
+	stream, err := c.client.UploadChunk(ctx)
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, chunkFrameSize)
+	sendErr := stream.Send(header)
+	for sendErr == nil {
+		n, readErr := io.ReadFull(chunk, buf)
+		if n > 0 {
+			// Actual error is returned by RecvMsg.
+			sendErr = stream.Send(&UploadChunkFrame{Data: buf[:n]})
+		}
+
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+
+		if readErr != nil {
+			return fmt.Errorf("failed to read chunk: %w", readErr)
+		}
+	}
+
+	if _, err := stream.CloseAndRecv(); err != nil {
+		return rpcerr.FromStatus(err, stream.Trailer())
+	}
+
+	return nil
+}
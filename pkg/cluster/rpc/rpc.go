@@ -0,0 +1,59 @@
+// Package rpc implements the gRPC transport between master and slave nodes. It covers the
+// frequent and long-running calls: task creation, task status and progress streaming and chunk
+// upload. Other calls, and nodes without a gRPC address, use the HTTP API.
+//
+// Messages and service stubs are generated from slave.proto.
+package rpc
+
+//go:generate protoc -I . --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative slave.proto
+
+import (
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+)
+
+const (
+	// chunkFrameSize max size of file data in one upload frame.
+	chunkFrameSize = 1 << 20
+)
+
+// Terminal returns whether the task will not change anymore.
+func (s *TaskSummary) Terminal() bool {
+	switch task.Status(s.Status) {
+	case task.StatusCompleted, task.StatusError, task.StatusCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
+// ToProgresses converts task progresses into messages.
+func ToProgresses(progresses queue.Progresses) map[string]*Progress {
+	if progresses == nil {
+		return nil
+	}
+
+	res := make(map[string]*Progress, len(progresses))
+	for k, p := range progresses {
+		if p == nil {
+			continue
+		}
+		res[k] = &Progress{Total: p.Total, Current: p.Current, Identifier: p.Identifier}
+	}
+
+	return res
+}
+
+// FromProgresses converts progress messages into task progresses.
+func FromProgresses(progresses map[string]*Progress) queue.Progresses {
+	if progresses == nil {
+		return nil
+	}
+
+	res := make(queue.Progresses, len(progresses))
+	for k, p := range progresses {
+		res[k] = &queue.Progress{Total: p.GetTotal(), Current: p.GetCurrent(), Identifier: p.GetIdentifier()}
+	}
+
+	return res
+}
@@ -0,0 +1,192 @@
+package rpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/rpcerr"
+	"github.com/cloudreve/Cloudreve/v4/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// Metadata keys, gRPC requires them to be lower case.
+	authorizationKey = "authorization"
+	// NodeIDKey slave node ID of the call.
+	NodeIDKey = strings.ToLower(request.SlaveNodeIDHeader)
+	// SiteIDKey, SiteURLKey and SiteVersionKey describe the master site making the call.
+	SiteIDKey      = strings.ToLower(request.SiteIDHeader)
+	SiteURLKey     = strings.ToLower(request.SiteURLHeader)
+	SiteVersionKey = strings.ToLower(request.SiteVersionHeader)
+	// CorrelationIDKey correlation ID of the call.
+	CorrelationIDKey = strings.ToLower(request.CorrelationHeader)
+)
+
+// ContextFunc builds the context to handle an authenticated call with from its metadata.
+type ContextFunc func(ctx context.Context, md metadata.MD) context.Context
+
+// NewServer creates a gRPC server that rejects calls not signed by a. Handler errors of
+// serializer.AppError are sent with their error code.
+func NewServer(a auth.Auth, ctxFn ContextFunc, opts ...grpc.ServerOption) *grpc.Server {
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (res any, err error) {
+			ctx, span := startServerSpan(ctx, info.FullMethod)
+			defer func() { tracing.End(span, err) }()
+
+			ctx, err = authenticate(ctx, a, info.FullMethod, req, ctxFn)
+			if err != nil {
+				return nil, err
+			}
+
+			res, err = handler(ctx, req)
+			if err != nil {
+				return nil, rpcerr.ToStatus(err, func(md metadata.MD) { _ = grpc.SetTrailer(ctx, md) })
+			}
+
+			return res, nil
+		}),
+		grpc.ChainStreamInterceptor(func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+			ctx, span := startServerSpan(ss.Context(), info.FullMethod)
+			defer func() { tracing.End(span, err) }()
+
+			// Signature covers the first request message, it is checked once the message is received.
+			stream := &authenticatedServerStream{ServerStream: ss, base: ctx, auth: a, fullMethod: info.FullMethod, ctxFn: ctxFn}
+			if err := handler(srv, stream); err != nil {
+				if stream.ctx == nil {
+					return err
+				}
+				return rpcerr.ToStatus(err, ss.SetTrailer)
+			}
+
+			return nil
+		}),
+	)
+
+	return grpc.NewServer(opts...)
+}
+
+// startServerSpan starts a server span for a call of given method, continuing the trace from master
+// if trace context is present in metadata.
+func startServerSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return tracing.Start(tracing.ExtractMetadata(ctx, md), "gRPC "+fullMethod,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("rpc.method", fullMethod),
+		),
+	)
+}
+
+func authenticate(ctx context.Context, a auth.Auth, fullMethod string, req any, ctxFn ContextFunc) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	digest, err := requestDigest(fullMethod, md, req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.Check(digest, firstValue(md, authorizationKey)); err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return ctxFn(ctx, md), nil
+}
+
+// requestDigest returns the content signed for a call. It covers the method, the node and site
+// making the call and the deterministically marshalled request, so that a signature cannot be reused
+// for other calls.
+func requestDigest(fullMethod string, md metadata.MD, req any) (string, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return "", fmt.Errorf("unexpected request type %T", req)
+	}
+
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	h := sha256.New()
+	for _, part := range []string{fullMethod, firstValue(md, NodeIDKey), firstValue(md, SiteURLKey)} {
+		h.Write([]byte(part))
+		h.Write([]byte{'\n'})
+	}
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// IsUnavailable returns whether err is caused by the gRPC endpoint being unreachable or not
+// implementing the service, in which case HTTP API should be used instead.
+func IsUnavailable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.Unimplemented:
+		return true
+	default:
+		return false
+	}
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// authenticatedServerStream authenticates the call with its first request message, handlers must
+// receive it before sending anything.
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	// base is the context of the stream before authentication.
+	base       context.Context
+	auth       auth.Auth
+	fullMethod string
+	ctxFn      ContextFunc
+	ctx        context.Context
+}
+
+func (s *authenticatedServerStream) Context() context.Context {
+	if s.ctx == nil {
+		return s.base
+	}
+
+	return s.ctx
+}
+
+func (s *authenticatedServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if s.ctx != nil {
+		return nil
+	}
+
+	ctx, err := authenticate(s.base, s.auth, s.fullMethod, m, s.ctxFn)
+	if err != nil {
+		return err
+	}
+
+	s.ctx = ctx
+	return nil
+}
+
+func (s *authenticatedServerStream) SendMsg(m any) error {
+	if s.ctx == nil {
+		return status.Error(codes.Unauthenticated, "request is not authenticated")
+	}
+
+	return s.ServerStream.SendMsg(m)
+}
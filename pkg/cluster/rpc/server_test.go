@@ -0,0 +1,112 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type testSlaveServer struct {
+	UnimplementedSlaveServer
+	received []byte
+	traceID  trace.TraceID
+}
+
+func (s *testSlaveServer) Ping(ctx context.Context, req *PingRequest) (*PingResponse, error) {
+	s.traceID = trace.SpanContextFromContext(ctx).TraceID()
+	return &PingResponse{Version: req.MasterUrl}, nil
+}
+
+func (s *testSlaveServer) UploadChunk(stream Slave_UploadChunkServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	s.received = append(s.received, first.Data...)
+	for {
+		frame, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&UploadChunkResponse{})
+		}
+		if err != nil {
+			return err
+		}
+		s.received = append(s.received, frame.Data...)
+	}
+}
+
+func newTestServer(t *testing.T, a auth.Auth) (*testSlaveServer, string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	impl := &testSlaveServer{}
+	s := NewServer(a, func(ctx context.Context, md metadata.MD) context.Context { return ctx })
+	RegisterSlaveServer(s, impl)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	return impl, lis.Addr().String()
+}
+
+func TestAuthenticate(t *testing.T) {
+	a := assert.New(t)
+	secret := &auth.HMACAuth{SecretKey: []byte("secret")}
+	impl, addr := newTestServer(t, secret)
+	opts := &Options{Addr: addr, Auth: secret, SignTTL: 60, NodeID: 1, SiteURL: "http://master"}
+	c, err := GetClient(opts)
+	a.NoError(err)
+
+	res, err := c.Ping(context.Background(), "http://master")
+	a.NoError(err)
+	a.Equal("http://master", res.Version)
+
+	data := bytes.Repeat([]byte("a"), chunkFrameSize+1)
+	a.NoError(c.UploadChunk(context.Background(), "session", 0, false, bytes.NewReader(data), int64(len(data))))
+	a.Equal(data, impl.received)
+
+	// Signature of another request is rejected.
+	ctx, err := c.outgoingContext(context.Background(), Slave_Ping_FullMethodName, &PingRequest{MasterUrl: "http://master"})
+	a.NoError(err)
+	_, err = c.client.Ping(ctx, &PingRequest{MasterUrl: "http://evil"})
+	a.Equal(codes.Unauthenticated, status.Code(err))
+
+	// Signature with another secret is rejected.
+	other, err := GetClient(&Options{Addr: addr, Auth: &auth.HMACAuth{SecretKey: []byte("other")}, SignTTL: 60, NodeID: 1})
+	a.NoError(err)
+	_, err = other.Ping(context.Background(), "http://master")
+	a.Equal(codes.Unauthenticated, status.Code(err))
+	a.Error(other.UploadChunk(context.Background(), "session", 0, false, bytes.NewReader(data), int64(len(data))))
+}
+
+func TestTracePropagation(t *testing.T) {
+	a := assert.New(t)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator()) })
+
+	secret := &auth.HMACAuth{SecretKey: []byte("secret")}
+	impl, addr := newTestServer(t, secret)
+	c, err := GetClient(&Options{Addr: addr, Auth: secret, SignTTL: 60, NodeID: 1})
+	a.NoError(err)
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		SpanID:     trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+		TraceFlags: trace.FlagsSampled,
+	})
+	_, err = c.Ping(trace.ContextWithSpanContext(context.Background(), sc), "http://master")
+	a.NoError(err)
+	a.Equal(sc.TraceID(), impl.traceID)
+}
@@ -0,0 +1,674 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: slave.proto
+
+package rpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MasterUrl     string                 `protobuf:"bytes,1,opt,name=master_url,json=masterUrl,proto3" json:"master_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	mi := &file_slave_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingRequest) ProtoMessage() {}
+
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_slave_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_slave_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PingRequest) GetMasterUrl() string {
+	if x != nil {
+		return x.MasterUrl
+	}
+	return ""
+}
+
+type PingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	mi := &file_slave_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResponse) ProtoMessage() {}
+
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_slave_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_slave_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PingResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type CreateTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskRequest) Reset() {
+	*x = CreateTaskRequest{}
+	mi := &file_slave_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskRequest) ProtoMessage() {}
+
+func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_slave_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_slave_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateTaskRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type CreateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskResponse) Reset() {
+	*x = CreateTaskResponse{}
+	mi := &file_slave_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskResponse) ProtoMessage() {}
+
+func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_slave_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_slave_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTaskResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetTaskRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClearOnComplete bool                   `protobuf:"varint,2,opt,name=clear_on_complete,json=clearOnComplete,proto3" json:"clear_on_complete,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTaskRequest) Reset() {
+	*x = GetTaskRequest{}
+	mi := &file_slave_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskRequest) ProtoMessage() {}
+
+func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_slave_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRequest) Descriptor() ([]byte, []int) {
+	return file_slave_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetTaskRequest) GetClearOnComplete() bool {
+	if x != nil {
+		return x.ClearOnComplete
+	}
+	return false
+}
+
+type WatchTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Interval in seconds between progress updates.
+	Interval      int32 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTaskRequest) Reset() {
+	*x = WatchTaskRequest{}
+	mi := &file_slave_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTaskRequest) ProtoMessage() {}
+
+func (x *WatchTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_slave_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTaskRequest.ProtoReflect.Descriptor instead.
+func (*WatchTaskRequest) Descriptor() ([]byte, []int) {
+	return file_slave_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WatchTaskRequest) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+type Progress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Current       int64                  `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"`
+	Identifier    string                 `protobuf:"bytes,3,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Progress) Reset() {
+	*x = Progress{}
+	mi := &file_slave_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Progress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Progress) ProtoMessage() {}
+
+func (x *Progress) ProtoReflect() protoreflect.Message {
+	mi := &file_slave_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Progress.ProtoReflect.Descriptor instead.
+func (*Progress) Descriptor() ([]byte, []int) {
+	return file_slave_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Progress) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Progress) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *Progress) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+type TaskSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	PrivateState  string                 `protobuf:"bytes,3,opt,name=private_state,json=privateState,proto3" json:"private_state,omitempty"`
+	Progress      map[string]*Progress   `protobuf:"bytes,4,rep,name=progress,proto3" json:"progress,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskSummary) Reset() {
+	*x = TaskSummary{}
+	mi := &file_slave_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskSummary) ProtoMessage() {}
+
+func (x *TaskSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_slave_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskSummary.ProtoReflect.Descriptor instead.
+func (*TaskSummary) Descriptor() ([]byte, []int) {
+	return file_slave_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TaskSummary) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TaskSummary) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TaskSummary) GetPrivateState() string {
+	if x != nil {
+		return x.PrivateState
+	}
+	return ""
+}
+
+func (x *TaskSummary) GetProgress() map[string]*Progress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+// UploadChunkFrame is a part of a chunk streamed to slave. Session related fields are only read from
+// the first frame.
+type UploadChunkFrame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Index         int64                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Overwrite     bool                   `protobuf:"varint,4,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	Data          []byte                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadChunkFrame) Reset() {
+	*x = UploadChunkFrame{}
+	mi := &file_slave_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadChunkFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadChunkFrame) ProtoMessage() {}
+
+func (x *UploadChunkFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_slave_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadChunkFrame.ProtoReflect.Descriptor instead.
+func (*UploadChunkFrame) Descriptor() ([]byte, []int) {
+	return file_slave_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UploadChunkFrame) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *UploadChunkFrame) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *UploadChunkFrame) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadChunkFrame) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+func (x *UploadChunkFrame) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UploadChunkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadChunkResponse) Reset() {
+	*x = UploadChunkResponse{}
+	mi := &file_slave_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadChunkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadChunkResponse) ProtoMessage() {}
+
+func (x *UploadChunkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_slave_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadChunkResponse.ProtoReflect.Descriptor instead.
+func (*UploadChunkResponse) Descriptor() ([]byte, []int) {
+	return file_slave_proto_rawDescGZIP(), []int{9}
+}
+
+var File_slave_proto protoreflect.FileDescriptor
+
+const file_slave_proto_rawDesc = "" +
+	"\n" +
+	"\vslave.proto\x12\x14cloudreve.cluster.v1\",\n" +
+	"\vPingRequest\x12\x1d\n" +
+	"\n" +
+	"master_url\x18\x01 \x01(\tR\tmasterUrl\"(\n" +
+	"\fPingResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\"=\n" +
+	"\x11CreateTaskRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"$\n" +
+	"\x12CreateTaskResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"L\n" +
+	"\x0eGetTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12*\n" +
+	"\x11clear_on_complete\x18\x02 \x01(\bR\x0fclearOnComplete\">\n" +
+	"\x10WatchTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\x05R\binterval\"Z\n" +
+	"\bProgress\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x18\n" +
+	"\acurrent\x18\x02 \x01(\x03R\acurrent\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x03 \x01(\tR\n" +
+	"identifier\"\x8a\x02\n" +
+	"\vTaskSummary\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12#\n" +
+	"\rprivate_state\x18\x03 \x01(\tR\fprivateState\x12K\n" +
+	"\bprogress\x18\x04 \x03(\v2/.cloudreve.cluster.v1.TaskSummary.ProgressEntryR\bprogress\x1a[\n" +
+	"\rProgressEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x124\n" +
+	"\x05value\x18\x02 \x01(\v2\x1e.cloudreve.cluster.v1.ProgressR\x05value:\x028\x01\"\x8d\x01\n" +
+	"\x10UploadChunkFrame\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\x03R\x05index\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x1c\n" +
+	"\toverwrite\x18\x04 \x01(\bR\toverwrite\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data\"\x15\n" +
+	"\x13UploadChunkResponse2\xc9\x03\n" +
+	"\x05Slave\x12M\n" +
+	"\x04Ping\x12!.cloudreve.cluster.v1.PingRequest\x1a\".cloudreve.cluster.v1.PingResponse\x12_\n" +
+	"\n" +
+	"CreateTask\x12'.cloudreve.cluster.v1.CreateTaskRequest\x1a(.cloudreve.cluster.v1.CreateTaskResponse\x12R\n" +
+	"\aGetTask\x12$.cloudreve.cluster.v1.GetTaskRequest\x1a!.cloudreve.cluster.v1.TaskSummary\x12X\n" +
+	"\tWatchTask\x12&.cloudreve.cluster.v1.WatchTaskRequest\x1a!.cloudreve.cluster.v1.TaskSummary0\x01\x12b\n" +
+	"\vUploadChunk\x12&.cloudreve.cluster.v1.UploadChunkFrame\x1a).cloudreve.cluster.v1.UploadChunkResponse(\x01B3Z1github.com/cloudreve/Cloudreve/v4/pkg/cluster/rpcb\x06proto3"
+
+var (
+	file_slave_proto_rawDescOnce sync.Once
+	file_slave_proto_rawDescData []byte
+)
+
+func file_slave_proto_rawDescGZIP() []byte {
+	file_slave_proto_rawDescOnce.Do(func() {
+		file_slave_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_slave_proto_rawDesc), len(file_slave_proto_rawDesc)))
+	})
+	return file_slave_proto_rawDescData
+}
+
+var file_slave_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_slave_proto_goTypes = []any{
+	(*PingRequest)(nil),         // 0: cloudreve.cluster.v1.PingRequest
+	(*PingResponse)(nil),        // 1: cloudreve.cluster.v1.PingResponse
+	(*CreateTaskRequest)(nil),   // 2: cloudreve.cluster.v1.CreateTaskRequest
+	(*CreateTaskResponse)(nil),  // 3: cloudreve.cluster.v1.CreateTaskResponse
+	(*GetTaskRequest)(nil),      // 4: cloudreve.cluster.v1.GetTaskRequest
+	(*WatchTaskRequest)(nil),    // 5: cloudreve.cluster.v1.WatchTaskRequest
+	(*Progress)(nil),            // 6: cloudreve.cluster.v1.Progress
+	(*TaskSummary)(nil),         // 7: cloudreve.cluster.v1.TaskSummary
+	(*UploadChunkFrame)(nil),    // 8: cloudreve.cluster.v1.UploadChunkFrame
+	(*UploadChunkResponse)(nil), // 9: cloudreve.cluster.v1.UploadChunkResponse
+	nil,                         // 10: cloudreve.cluster.v1.TaskSummary.ProgressEntry
+}
+var file_slave_proto_depIdxs = []int32{
+	10, // 0: cloudreve.cluster.v1.TaskSummary.progress:type_name -> cloudreve.cluster.v1.TaskSummary.ProgressEntry
+	6,  // 1: cloudreve.cluster.v1.TaskSummary.ProgressEntry.value:type_name -> cloudreve.cluster.v1.Progress
+	0,  // 2: cloudreve.cluster.v1.Slave.Ping:input_type -> cloudreve.cluster.v1.PingRequest
+	2,  // 3: cloudreve.cluster.v1.Slave.CreateTask:input_type -> cloudreve.cluster.v1.CreateTaskRequest
+	4,  // 4: cloudreve.cluster.v1.Slave.GetTask:input_type -> cloudreve.cluster.v1.GetTaskRequest
+	5,  // 5: cloudreve.cluster.v1.Slave.WatchTask:input_type -> cloudreve.cluster.v1.WatchTaskRequest
+	8,  // 6: cloudreve.cluster.v1.Slave.UploadChunk:input_type -> cloudreve.cluster.v1.UploadChunkFrame
+	1,  // 7: cloudreve.cluster.v1.Slave.Ping:output_type -> cloudreve.cluster.v1.PingResponse
+	3,  // 8: cloudreve.cluster.v1.Slave.CreateTask:output_type -> cloudreve.cluster.v1.CreateTaskResponse
+	7,  // 9: cloudreve.cluster.v1.Slave.GetTask:output_type -> cloudreve.cluster.v1.TaskSummary
+	7,  // 10: cloudreve.cluster.v1.Slave.WatchTask:output_type -> cloudreve.cluster.v1.TaskSummary
+	9,  // 11: cloudreve.cluster.v1.Slave.UploadChunk:output_type -> cloudreve.cluster.v1.UploadChunkResponse
+	7,  // [7:12] is the sub-list for method output_type
+	2,  // [2:7] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_slave_proto_init() }
+func file_slave_proto_init() {
+	if File_slave_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_slave_proto_rawDesc), len(file_slave_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_slave_proto_goTypes,
+		DependencyIndexes: file_slave_proto_depIdxs,
+		MessageInfos:      file_slave_proto_msgTypes,
+	}.Build()
+	File_slave_proto = out.File
+	file_slave_proto_goTypes = nil
+	file_slave_proto_depIdxs = nil
+}
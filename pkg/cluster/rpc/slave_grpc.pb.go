@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: slave.proto
+
+package rpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Slave_Ping_FullMethodName        = "/cloudreve.cluster.v1.Slave/Ping"
+	Slave_CreateTask_FullMethodName  = "/cloudreve.cluster.v1.Slave/CreateTask"
+	Slave_GetTask_FullMethodName     = "/cloudreve.cluster.v1.Slave/GetTask"
+	Slave_WatchTask_FullMethodName   = "/cloudreve.cluster.v1.Slave/WatchTask"
+	Slave_UploadChunk_FullMethodName = "/cloudreve.cluster.v1.Slave/UploadChunk"
+)
+
+// SlaveClient is the client API for Slave service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Slave is served by slave nodes and called by master.
+type SlaveClient interface {
+	// Ping checks the connection to slave node.
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*CreateTaskResponse, error)
+	GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*TaskSummary, error)
+	// WatchTask streams task summary until the task is terminal or the stream is closed.
+	WatchTask(ctx context.Context, in *WatchTaskRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TaskSummary], error)
+	// UploadChunk receives a chunk of an upload session as a stream of frames.
+	UploadChunk(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadChunkFrame, UploadChunkResponse], error)
+}
+
+type slaveClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSlaveClient(cc grpc.ClientConnInterface) SlaveClient {
+	return &slaveClient{cc}
+}
+
+func (c *slaveClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, Slave_Ping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slaveClient) CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*CreateTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTaskResponse)
+	err := c.cc.Invoke(ctx, Slave_CreateTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slaveClient) GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*TaskSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskSummary)
+	err := c.cc.Invoke(ctx, Slave_GetTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *slaveClient) WatchTask(ctx context.Context, in *WatchTaskRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TaskSummary], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Slave_ServiceDesc.Streams[0], Slave_WatchTask_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchTaskRequest, TaskSummary]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Slave_WatchTaskClient = grpc.ServerStreamingClient[TaskSummary]
+
+func (c *slaveClient) UploadChunk(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadChunkFrame, UploadChunkResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Slave_ServiceDesc.Streams[1], Slave_UploadChunk_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadChunkFrame, UploadChunkResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Slave_UploadChunkClient = grpc.ClientStreamingClient[UploadChunkFrame, UploadChunkResponse]
+
+// SlaveServer is the server API for Slave service.
+// All implementations must embed UnimplementedSlaveServer
+// for forward compatibility.
+//
+// Slave is served by slave nodes and called by master.
+type SlaveServer interface {
+	// Ping checks the connection to slave node.
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	CreateTask(context.Context, *CreateTaskRequest) (*CreateTaskResponse, error)
+	GetTask(context.Context, *GetTaskRequest) (*TaskSummary, error)
+	// WatchTask streams task summary until the task is terminal or the stream is closed.
+	WatchTask(*WatchTaskRequest, grpc.ServerStreamingServer[TaskSummary]) error
+	// UploadChunk receives a chunk of an upload session as a stream of frames.
+	UploadChunk(grpc.ClientStreamingServer[UploadChunkFrame, UploadChunkResponse]) error
+	mustEmbedUnimplementedSlaveServer()
+}
+
+// UnimplementedSlaveServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSlaveServer struct{}
+
+func (UnimplementedSlaveServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedSlaveServer) CreateTask(context.Context, *CreateTaskRequest) (*CreateTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTask not implemented")
+}
+func (UnimplementedSlaveServer) GetTask(context.Context, *GetTaskRequest) (*TaskSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTask not implemented")
+}
+func (UnimplementedSlaveServer) WatchTask(*WatchTaskRequest, grpc.ServerStreamingServer[TaskSummary]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTask not implemented")
+}
+func (UnimplementedSlaveServer) UploadChunk(grpc.ClientStreamingServer[UploadChunkFrame, UploadChunkResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadChunk not implemented")
+}
+func (UnimplementedSlaveServer) mustEmbedUnimplementedSlaveServer() {}
+func (UnimplementedSlaveServer) testEmbeddedByValue()               {}
+
+// UnsafeSlaveServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SlaveServer will
+// result in compilation errors.
+type UnsafeSlaveServer interface {
+	mustEmbedUnimplementedSlaveServer()
+}
+
+func RegisterSlaveServer(s grpc.ServiceRegistrar, srv SlaveServer) {
+	// If the following call pancis, it indicates UnimplementedSlaveServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Slave_ServiceDesc, srv)
+}
+
+func _Slave_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlaveServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Slave_Ping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlaveServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slave_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlaveServer).CreateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Slave_CreateTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlaveServer).CreateTask(ctx, req.(*CreateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slave_GetTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SlaveServer).GetTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Slave_GetTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SlaveServer).GetTask(ctx, req.(*GetTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Slave_WatchTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTaskRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SlaveServer).WatchTask(m, &grpc.GenericServerStream[WatchTaskRequest, TaskSummary]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Slave_WatchTaskServer = grpc.ServerStreamingServer[TaskSummary]
+
+func _Slave_UploadChunk_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SlaveServer).UploadChunk(&grpc.GenericServerStream[UploadChunkFrame, UploadChunkResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Slave_UploadChunkServer = grpc.ClientStreamingServer[UploadChunkFrame, UploadChunkResponse]
+
+// Slave_ServiceDesc is the grpc.ServiceDesc for Slave service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Slave_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cloudreve.cluster.v1.Slave",
+	HandlerType: (*SlaveServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Ping",
+			Handler:    _Slave_Ping_Handler,
+		},
+		{
+			MethodName: "CreateTask",
+			Handler:    _Slave_CreateTask_Handler,
+		},
+		{
+			MethodName: "GetTask",
+			Handler:    _Slave_GetTask_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTask",
+			Handler:       _Slave_WatchTask_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadChunk",
+			Handler:       _Slave_UploadChunk_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "slave.proto",
+}
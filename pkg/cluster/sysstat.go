@@ -0,0 +1,29 @@
+//go:build !linux
+// +build !linux
+
+package cluster
+
+// No-op on non-Linux platforms.
+func loadAverage() []float64 {
+	return nil
+}
+
+// No-op on non-Linux platforms.
+func diskUsage(path string) (uint64, uint64) {
+	return 0, 0
+}
+
+// No-op on non-Linux platforms.
+func cpuTimes() (uint64, uint64) {
+	return 0, 0
+}
+
+// No-op on non-Linux platforms.
+func memoryUsage() (uint64, uint64) {
+	return 0, 0
+}
+
+// No-op on non-Linux platforms.
+func networkBytes() (uint64, uint64) {
+	return 0, 0
+}
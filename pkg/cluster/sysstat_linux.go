@@ -0,0 +1,137 @@
+package cluster
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// loadAverage reads load average of 1, 5 and 15 minutes from procfs.
+func loadAverage() []float64 {
+	content, err := os.ReadFile("/proc/loadavg")
+	if err != nil {
+		return nil
+	}
+
+	fields := strings.Fields(string(content))
+	if len(fields) < 3 {
+		return nil
+	}
+
+	res := make([]float64, 0, 3)
+	for _, field := range fields[:3] {
+		v, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return nil
+		}
+		res = append(res, v)
+	}
+
+	return res
+}
+
+// diskUsage returns total and available bytes of the filesystem containing path.
+func diskUsage(path string) (uint64, uint64) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, 0
+	}
+
+	return stat.Blocks * uint64(stat.Bsize), stat.Bavail * uint64(stat.Bsize)
+}
+
+// cpuTimes returns busy and total CPU time in clock ticks from procfs.
+func cpuTimes() (uint64, uint64) {
+	content, err := os.ReadFile("/proc/stat")
+	if err != nil {
+		return 0, 0
+	}
+
+	line, _, _ := strings.Cut(string(content), "\n")
+	fields := strings.Fields(line)
+	if len(fields) < 5 || fields[0] != "cpu" {
+		return 0, 0
+	}
+
+	var busy, total uint64
+	for i, field := range fields[1:] {
+		v, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return 0, 0
+		}
+
+		total += v
+		// idle and iowait
+		if i != 3 && i != 4 {
+			busy += v
+		}
+	}
+
+	return busy, total
+}
+
+// memoryUsage returns total and available memory in bytes from procfs.
+func memoryUsage() (uint64, uint64) {
+	content, err := os.ReadFile("/proc/meminfo")
+	if err != nil {
+		return 0, 0
+	}
+
+	var total, available uint64
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		switch fields[0] {
+		case "MemTotal:":
+			total = v * 1024
+		case "MemAvailable:":
+			available = v * 1024
+		}
+	}
+
+	return total, available
+}
+
+// networkBytes returns bytes received and transmitted by all non-loopback interfaces from procfs.
+func networkBytes() (uint64, uint64) {
+	content, err := os.ReadFile("/proc/net/dev")
+	if err != nil {
+		return 0, 0
+	}
+
+	var rx, tx uint64
+	for _, line := range strings.Split(string(content), "\n") {
+		name, stats, found := strings.Cut(line, ":")
+		if !found || strings.TrimSpace(name) == "lo" {
+			continue
+		}
+
+		fields := strings.Fields(stats)
+		if len(fields) < 9 {
+			continue
+		}
+
+		r, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		t, err := strconv.ParseUint(fields[8], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		rx += r
+		tx += t
+	}
+
+	return rx, tx
+}
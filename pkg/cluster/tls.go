@@ -0,0 +1,61 @@
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/node"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/mtls"
+)
+
+var ErrInsecureNode = errors.New("node is not configured with mutual TLS, which is required in secure mode")
+
+// CheckNodeSecurity validates TLS settings of a slave node. In secure mode, the node must be
+// reached over HTTPS, and gRPC if enabled, with a client certificate.
+func CheckNodeSecurity(model *ent.Node, secureMode bool) error {
+	if model.Type == node.TypeMaster {
+		return nil
+	}
+
+	nodeSettings := model.Settings
+	if nodeSettings != nil && nodeSettings.TLS != nil {
+		if _, err := mtls.ClientConfig(nodeSettings.TLS); err != nil {
+			return fmt.Errorf("invalid TLS settings: %w", err)
+		}
+	}
+
+	if !secureMode {
+		return nil
+	}
+
+	server, err := url.Parse(model.Server)
+	if err != nil || server.Scheme != "https" {
+		return fmt.Errorf("server URL must use https: %w", ErrInsecureNode)
+	}
+
+	if nodeSettings == nil || nodeSettings.TLS == nil || nodeSettings.TLS.Cert == "" {
+		return fmt.Errorf("client certificate not configured: %w", ErrInsecureNode)
+	}
+
+	return nil
+}
+
+// NodeTransport returns the HTTP transport to call a slave node with its TLS settings, nil if
+// not configured.
+func NodeTransport(model *ent.Node) (*http.Transport, error) {
+	if model.Settings == nil || model.Settings.TLS == nil {
+		return nil, nil
+	}
+
+	config, err := mtls.ClientConfig(model.Settings.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = config
+	return transport, nil
+}
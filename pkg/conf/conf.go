@@ -4,12 +4,17 @@ import (
 	"encoding/base64"
 	"fmt"
 	"os"
+	"reflect"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/go-ini/ini"
 	"github.com/go-playground/validator/v10"
+	"github.com/samber/lo"
 )
 
 const (
@@ -24,7 +29,33 @@ type ConfigProvider interface {
 	Slave() *Slave
 	Redis() *Redis
 	Cors() *Cors
+	Tracing() *Tracing
+	Log() *Log
+	ErrorReporting() *ErrorReporting
+	EventBus() *EventBus
 	OptionOverwrite() map[string]any
+	// Reload re-reads the config file and applies changes of fields listed in HotReloadFields.
+	Reload() (*ReloadResult, error)
+}
+
+// HotReloadFields are config fields applied by Reload without restart, in "Section.Field" form.
+// "Section.*" means all fields in the section. Changes of other fields require a restart.
+var HotReloadFields = []string{
+	"System.LogLevel",
+	"System.Debug",
+	"Log.Levels",
+	"Slave.Secret",
+	"Slave.SignatureTTL",
+	"Slave.CallbackTimeout",
+	"CORS.*",
+}
+
+// ReloadResult lists config fields changed since last load.
+type ReloadResult struct {
+	// Applied changed fields that take effect immediately.
+	Applied []string `json:"applied"`
+	// RestartRequired changed fields that are ignored until restart.
+	RestartRequired []string `json:"restart_required"`
 }
 
 // NewIniConfigProvider initializes a new Ini config file provider. A default config file
@@ -50,12 +81,42 @@ func NewIniConfigProvider(configPath string, l logging.Logger) (ConfigProvider,
 		f.Close()
 	}
 
+	config, err := loadIniConfig(configPath, l)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode FileEncryptionKey if provided
+	if config.system.FileEncryptionKey != "" {
+		decodedKey, err := base64.StdEncoding.DecodeString(config.system.FileEncryptionKey)
+		if err != nil {
+			l.Warning("Failed to decode FileEncryptionKey, encryption will be disabled: %s", err)
+			DecodedFileEncryptionKey = nil
+		} else {
+			DecodedFileEncryptionKey = decodedKey
+			l.Info("FileEncryptionKey loaded and decoded. Key length: %d", len(decodedKey))
+		}
+	} else {
+		DecodedFileEncryptionKey = nil
+		l.Info("FileEncryptionKey not provided, file encryption will be disabled.")
+	}
+
+	provider := &iniConfigProvider{
+		path: configPath,
+		l:    l,
+	}
+	provider.current.Store(config)
+	return provider, nil
+}
+
+// loadIniConfig parses config file and overrides from environment variables.
+func loadIniConfig(configPath string, l logging.Logger) (*iniConfig, error) {
 	cfg, err := ini.Load(configPath, []byte(getOverrideConfFromEnv(l)))
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse config file %q: %w", configPath, err)
 	}
 
-	provider := &iniConfigProvider{
+	config := &iniConfig{
 		database:        *DatabaseConfig,
 		system:          *SystemConfig,
 		ssl:             *SSLConfig,
@@ -63,19 +124,14 @@ func NewIniConfigProvider(configPath string, l logging.Logger) (ConfigProvider,
 		slave:           *SlaveConfig,
 		redis:           *RedisConfig,
 		cors:            *CORSConfig,
+		tracing:         *TracingConfig,
+		log:             *LogConfig,
+		errorReporting:  *ErrorReportingConfig,
+		eventBus:        *EventBusConfig,
 		optionOverwrite: make(map[string]interface{}),
 	}
 
-	sections := map[string]interface{}{
-		"Database":   &provider.database,
-		"System":     &provider.system,
-		"SSL":        &provider.ssl,
-		"UnixSocket": &provider.unix,
-		"Redis":      &provider.redis,
-		"CORS":       &provider.cors,
-		"Slave":      &provider.slave,
-	}
-	for sectionName, sectionStruct := range sections {
+	for sectionName, sectionStruct := range config.sections() {
 		err = mapSection(cfg, sectionName, sectionStruct)
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse config section %q: %w", sectionName, err)
@@ -84,28 +140,15 @@ func NewIniConfigProvider(configPath string, l logging.Logger) (ConfigProvider,
 
 	// 映射数据库配置覆盖
 	for _, key := range cfg.Section("OptionOverwrite").Keys() {
-		provider.optionOverwrite[key.Name()] = key.Value()
-	}
-
-	// Decode FileEncryptionKey if provided
-	if provider.system.FileEncryptionKey != "" {
-		decodedKey, err := base64.StdEncoding.DecodeString(provider.system.FileEncryptionKey)
-		if err != nil {
-			l.Warning("Failed to decode FileEncryptionKey, encryption will be disabled: %s", err)
-			DecodedFileEncryptionKey = nil
-		} else {
-			DecodedFileEncryptionKey = decodedKey
-			l.Info("FileEncryptionKey loaded and decoded. Key length: %d", len(decodedKey))
-		}
-	} else {
-		DecodedFileEncryptionKey = nil
-		l.Info("FileEncryptionKey not provided, file encryption will be disabled.")
+		config.optionOverwrite[key.Name()] = key.Value()
 	}
 
-	return provider, nil
+	return config, nil
 }
 
-type iniConfigProvider struct {
+// iniConfig is a snapshot of loaded config, it is never modified once published, so that readers
+// see either the old or the new config as a whole during reload.
+type iniConfig struct {
 	database        Database
 	system          System
 	ssl             SSL
@@ -113,39 +156,127 @@ type iniConfigProvider struct {
 	slave           Slave
 	redis           Redis
 	cors            Cors
+	tracing         Tracing
+	log             Log
+	errorReporting  ErrorReporting
+	eventBus        EventBus
 	optionOverwrite map[string]any
 }
 
+func (c *iniConfig) sections() map[string]interface{} {
+	return map[string]interface{}{
+		"Database":       &c.database,
+		"System":         &c.system,
+		"SSL":            &c.ssl,
+		"UnixSocket":     &c.unix,
+		"Redis":          &c.redis,
+		"CORS":           &c.cors,
+		"Slave":          &c.slave,
+		"Tracing":        &c.tracing,
+		"Log":            &c.log,
+		"ErrorReporting": &c.errorReporting,
+		"EventBus":       &c.eventBus,
+	}
+}
+
+type iniConfigProvider struct {
+	current atomic.Pointer[iniConfig]
+
+	path string
+	l    logging.Logger
+	// reloadMu serializes reloads.
+	reloadMu sync.Mutex
+}
+
+func (i *iniConfigProvider) Reload() (*ReloadResult, error) {
+	i.reloadMu.Lock()
+	defer i.reloadMu.Unlock()
+
+	loaded, err := loadIniConfig(i.path, i.l)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the next config from a copy of current one, so that it can be swapped in at once.
+	current := i.current.Load()
+	next := *current
+	res := &ReloadResult{Applied: []string{}, RestartRequired: []string{}}
+	newSections := loaded.sections()
+	for sectionName, section := range next.sections() {
+		nextValue := reflect.ValueOf(section).Elem()
+		newValue := reflect.ValueOf(newSections[sectionName]).Elem()
+		for f := 0; f < nextValue.NumField(); f++ {
+			if reflect.DeepEqual(nextValue.Field(f).Interface(), newValue.Field(f).Interface()) {
+				continue
+			}
+
+			name := sectionName + "." + nextValue.Type().Field(f).Name
+			if !lo.Contains(HotReloadFields, name) && !lo.Contains(HotReloadFields, sectionName+".*") {
+				res.RestartRequired = append(res.RestartRequired, name)
+				continue
+			}
+
+			nextValue.Field(f).Set(newValue.Field(f))
+			res.Applied = append(res.Applied, name)
+		}
+	}
+
+	if !reflect.DeepEqual(current.optionOverwrite, loaded.optionOverwrite) {
+		res.RestartRequired = append(res.RestartRequired, "OptionOverwrite")
+	}
+
+	i.current.Store(&next)
+	sort.Strings(res.Applied)
+	sort.Strings(res.RestartRequired)
+	return res, nil
+}
+
 func (i *iniConfigProvider) Database() *Database {
-	return &i.database
+	return &i.current.Load().database
 }
 
 func (i *iniConfigProvider) System() *System {
-	return &i.system
+	return &i.current.Load().system
 }
 
 func (i *iniConfigProvider) SSL() *SSL {
-	return &i.ssl
+	return &i.current.Load().ssl
 }
 
 func (i *iniConfigProvider) Unix() *Unix {
-	return &i.unix
+	return &i.current.Load().unix
 }
 
 func (i *iniConfigProvider) Slave() *Slave {
-	return &i.slave
+	return &i.current.Load().slave
 }
 
 func (i *iniConfigProvider) Redis() *Redis {
-	return &i.redis
+	return &i.current.Load().redis
 }
 
 func (i *iniConfigProvider) Cors() *Cors {
-	return &i.cors
+	return &i.current.Load().cors
+}
+
+func (i *iniConfigProvider) Tracing() *Tracing {
+	return &i.current.Load().tracing
+}
+
+func (i *iniConfigProvider) Log() *Log {
+	return &i.current.Load().log
+}
+
+func (i *iniConfigProvider) ErrorReporting() *ErrorReporting {
+	return &i.current.Load().errorReporting
+}
+
+func (i *iniConfigProvider) EventBus() *EventBus {
+	return &i.current.Load().eventBus
 }
 
 func (i *iniConfigProvider) OptionOverwrite() map[string]any {
-	return i.optionOverwrite
+	return i.current.Load().optionOverwrite
 }
 
 const defaultConf = `[System]
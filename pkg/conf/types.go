@@ -40,10 +40,13 @@ type System struct {
 	Debug             bool
 	SessionSecret     string
 	HashIDSalt        string // deprecated
-	GracePeriod       int    `validate:"gte=0"`
+	GracePeriod       int    `validate:"gte=0"` // drain window in seconds on shutdown, 0 for no limit
 	ProxyHeader       string `validate:"required_with=Listen"`
 	LogLevel          string `validate:"oneof=debug info warning error"`
 	FileEncryptionKey string `ini:"file_encryption_key" json:"file_encryption_key"`
+	// GRPCListen address of the gRPC API for programmatic access in master mode, e.g. ":5214".
+	// Empty disables it.
+	GRPCListen string
 }
 
 type SSL struct {
@@ -62,6 +65,25 @@ type Slave struct {
 	Secret          string `validate:"omitempty,gte=64"`
 	CallbackTimeout int    `validate:"omitempty,gte=1"`
 	SignatureTTL    int    `validate:"omitempty,gte=1"`
+	// MasterURL and NodeID are required to push heartbeats to master.
+	MasterURL string `validate:"omitempty,url"`
+	NodeID    int    `validate:"omitempty,gte=1"`
+	// HeartbeatInterval in seconds.
+	HeartbeatInterval int `validate:"omitempty,gte=1"`
+	// GRPCListen address of the gRPC service for master calls, e.g. ":5213". Empty disables it.
+	GRPCListen string
+	// ClientCAPath CA bundle to verify client certificates of master. If set, master calls must be
+	// made over TLS with a certificate signed by it. Requires SSL to be enabled.
+	ClientCAPath string
+	// SecureMode refuses to start without mutual TLS, or with a plain HTTP master URL.
+	SecureMode bool
+	// EdgeCachePath folder of the on-disk cache of entities served as edge node, relative to data path.
+	EdgeCachePath string
+	// EdgeCacheSize max size of the edge cache in MB. 0 disables edge caching.
+	EdgeCacheSize int64 `validate:"omitempty,gte=0"`
+	// WebDAV serves the WebDAV endpoint under /dav on slave node, content of policies on this node is
+	// transferred directly once master authorizes the request. Requires MasterURL and NodeID.
+	WebDAV bool
 }
 
 // Redis 配置
@@ -84,6 +106,64 @@ type Cors struct {
 	Secure           bool
 }
 
+// Tracing OpenTelemetry tracing config
+type Tracing struct {
+	Enabled bool
+	// Endpoint OTLP/HTTP collector endpoint in host:port form.
+	Endpoint string `validate:"required_if=Enabled true"`
+	// Insecure disables TLS to the collector.
+	Insecure    bool
+	ServiceName string
+	// SampleRate ratio of root spans to sample, between 0 and 1.
+	SampleRate float64 `validate:"gte=0,lte=1"`
+}
+
+// Log logging output config
+type Log struct {
+	// Format of log lines, either text or json.
+	Format string `validate:"oneof=text json"`
+	// File path to write logs to. Logs are written to stdout if empty.
+	File string
+	// MaxSize rotates log file once it exceeds given size in MB, 0 to disable.
+	MaxSize int `validate:"gte=0"`
+	// Daily rotates log file at midnight.
+	Daily bool
+	// MaxBackups maximum number of rotated files to keep, 0 to keep all.
+	MaxBackups int `validate:"gte=0"`
+	// MaxAge maximum days to keep rotated files, 0 to keep all.
+	MaxAge int `validate:"gte=0"`
+	// Levels overrides log level per component, e.g. "cron:debug,queue:warning".
+	Levels string
+}
+
+// ErrorReporting Sentry-compatible error reporting config
+type ErrorReporting struct {
+	// DSN of the Sentry-compatible project, error reporting is disabled if empty.
+	DSN         string `validate:"omitempty,url"`
+	Environment string
+	// SampleRate ratio of errors to report, between 0 and 1.
+	SampleRate float64 `validate:"gte=0,lte=1"`
+}
+
+// EventBus publishes file, user and share events to external message brokers
+type EventBus struct {
+	// Type of the broker, either redis or nats. Event bus is disabled if empty.
+	Type string `validate:"omitempty,oneof=redis nats"`
+	// Server address of the broker, host:port for Redis, or comma separated URLs for NATS.
+	Server   string `validate:"required_with=Type"`
+	Network  string
+	User     string
+	Password string
+	// DB Redis database number.
+	DB string
+	// Prefix of Redis stream keys or NATS subjects, events are published to "<prefix>.<event>".
+	Prefix string `validate:"required_with=Type"`
+	// MaxLen approximate max length of each Redis stream, 0 for no limit.
+	MaxLen int `validate:"gte=0"`
+	// BufferSize number of events buffered before new events are dropped.
+	BufferSize int `validate:"gte=1"`
+}
+
 // RedisConfig Redis服务器配置
 var RedisConfig = &Redis{
 	Network:  "tcp",
@@ -113,7 +193,7 @@ var SystemConfig = &System{
 var CORSConfig = &Cors{
 	AllowOrigins:     []string{"UNSET"},
 	AllowMethods:     []string{"PUT", "POST", "GET", "OPTIONS"},
-	AllowHeaders:     []string{"Cookie", "X-Cr-Policy", "Authorization", "Content-Length", "Content-Type", "X-Cr-Path", "X-Cr-FileName"},
+	AllowHeaders:     []string{"Cookie", "X-Cr-Policy", "Authorization", "Content-Length", "Content-Type", "X-Cr-Path", "X-Cr-FileName", "Idempotency-Key"},
 	AllowCredentials: false,
 	ExposeHeaders:    nil,
 	SameSite:         "Default",
@@ -122,8 +202,11 @@ var CORSConfig = &Cors{
 
 // SlaveConfig 从机配置
 var SlaveConfig = &Slave{
-	CallbackTimeout: 20,
-	SignatureTTL:    600,
+	CallbackTimeout:   20,
+	SignatureTTL:      600,
+	HeartbeatInterval: 30,
+	EdgeCachePath:     "edge_cache",
+	EdgeCacheSize:     1024,
 }
 
 var SSLConfig = &SSL{
@@ -136,6 +219,34 @@ var UnixConfig = &Unix{
 	Listen: "",
 }
 
+// TracingConfig OpenTelemetry tracing config
+var TracingConfig = &Tracing{
+	Endpoint:    "localhost:4318",
+	ServiceName: "cloudreve",
+	SampleRate:  1,
+}
+
+// LogConfig logging output config
+var LogConfig = &Log{
+	Format:  "text",
+	MaxSize: 100,
+}
+
+// ErrorReportingConfig Sentry-compatible error reporting config
+var ErrorReportingConfig = &ErrorReporting{
+	Environment: "production",
+	SampleRate:  1,
+}
+
+// EventBusConfig event bus config
+var EventBusConfig = &EventBus{
+	Network:    "tcp",
+	DB:         "0",
+	Prefix:     "cloudreve",
+	MaxLen:     100000,
+	BufferSize: 1024,
+}
+
 var OptionOverwrite = map[string]interface{}{}
 
 // DecodedFileEncryptionKey stores the decoded file encryption key
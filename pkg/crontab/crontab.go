@@ -33,7 +33,8 @@ func Register(t setting.CronType, fn CronTaskFunc) {
 	})
 }
 
-// NewCron constructs a new cron instance with given dependency.
+// NewCron constructs a new cron instance with given dependency. When multiple instances share the
+// same KV store, jobs only run on the elected leader. Leader election stops once ctx is canceled.
 func NewCron(ctx context.Context, dep dependency.Dep) (*cron.Cron, error) {
 	settings := dep.SettingProvider()
 	userClient := dep.UserClient()
@@ -46,9 +47,13 @@ func NewCron(ctx context.Context, dep dependency.Dep) (*cron.Cron, error) {
 	l.Info("Initialize crontab jobs...")
 	c := cron.New()
 
+	elector := newLeaderElector(dep.KV(), l)
+	elector.campaign()
+	go elector.run(ctx)
+
 	for _, r := range registrations {
 		cronConfig := settings.Cron(ctx, r.t)
-		if _, err := c.AddFunc(cronConfig, taskWrapper(string(r.t), cronConfig, anonymous, dep, r.fn)); err != nil {
+		if _, err := c.AddFunc(cronConfig, taskWrapper(string(r.t), cronConfig, anonymous, dep, elector, r.fn)); err != nil {
 			l.Warning("Failed to start crontab job %q: %s", cronConfig, err)
 		}
 	}
@@ -56,14 +61,21 @@ func NewCron(ctx context.Context, dep dependency.Dep) (*cron.Cron, error) {
 	return c, nil
 }
 
-func taskWrapper(name, config string, user *ent.User, dep dependency.Dep, task CronTaskFunc) func() {
+func taskWrapper(name, config string, user *ent.User, dep dependency.Dep, elector *leaderElector, task CronTaskFunc) func() {
 	l := dep.Logger()
 	l.Info("Cron task %s started with config %q", name, config)
 	return func() {
+		if !elector.IsLeader() {
+			l.Debug("Skip Cron task %q as current instance is not the leader.", name)
+			return
+		}
+
 		cid := uuid.Must(uuid.NewV4())
 		l.Info("Executing Cron task %q with Cid %q", name, cid)
 		ctx := context.Background()
-		l := dep.Logger().CopyWithPrefix(fmt.Sprintf("[Cid: %s Cron: %s]", cid, name))
+		l := dep.Logger().CopyWithComponent("cron").
+			CopyWithField(logging.FieldCorrelationID, cid.String()).
+			CopyWithField("cron", name)
 		ctx = dep.ForkWithLogger(ctx, l)
 		ctx = context.WithValue(ctx, logging.CorrelationIDCtx{}, cid)
 		ctx = context.WithValue(ctx, logging.LoggerCtx{}, l)
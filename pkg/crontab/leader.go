@@ -0,0 +1,100 @@
+package crontab
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	cronLeaderKey = "cron_leader"
+	// cronLeaderTTL is how long the leadership is kept without renewal, in seconds.
+	cronLeaderTTL = 60
+)
+
+// leaderElector elects a single instance among all instances sharing the same KV store to run
+// cron jobs. Instances using in-memory KV store are always the leader of themselves.
+type leaderElector struct {
+	kv     cache.Driver
+	l      logging.Logger
+	id     string
+	leader atomic.Bool
+}
+
+func newLeaderElector(kv cache.Driver, l logging.Logger) *leaderElector {
+	return &leaderElector{
+		kv: kv,
+		l:  l,
+		id: uuid.Must(uuid.NewV4()).String(),
+	}
+}
+
+// IsLeader returns whether current instance should run cron jobs.
+func (e *leaderElector) IsLeader() bool {
+	return e.leader.Load()
+}
+
+// campaign acquires the leadership if no one holds it, or renews it if current instance is the leader.
+// Renewal is a compare-and-swap, so that leadership taken over by another instance after expiration
+// is never overwritten.
+func (e *leaderElector) campaign() {
+	if e.IsLeader() {
+		renewed, err := e.kv.CompareAndSwap(cronLeaderKey, e.id, e.id, cronLeaderTTL)
+		if err != nil {
+			e.l.Warning("Failed to renew cron leadership: %s", err)
+			e.setLeader(false)
+			return
+		}
+
+		if renewed {
+			return
+		}
+	}
+
+	acquired, err := e.kv.SetNX(cronLeaderKey, e.id, cronLeaderTTL)
+	if err != nil {
+		e.l.Warning("Failed to acquire cron leadership: %s", err)
+	}
+	e.setLeader(acquired)
+}
+
+// run keeps campaigning until ctx is canceled, then resigns the leadership.
+func (e *leaderElector) run(ctx context.Context) {
+	ticker := time.NewTicker(cronLeaderTTL / 3 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			e.resign()
+			return
+		case <-ticker.C:
+			e.campaign()
+		}
+	}
+}
+
+func (e *leaderElector) resign() {
+	if !e.IsLeader() {
+		return
+	}
+
+	if _, err := e.kv.CompareAndDelete(cronLeaderKey, e.id); err != nil {
+		e.l.Warning("Failed to resign cron leadership: %s", err)
+	}
+	e.setLeader(false)
+}
+
+func (e *leaderElector) setLeader(leader bool) {
+	if e.leader.Swap(leader) != leader {
+		if leader {
+			e.l.Info("Current instance is elected to run cron jobs.")
+		} else {
+			e.l.Info("Current instance is no longer running cron jobs.")
+		}
+	}
+}
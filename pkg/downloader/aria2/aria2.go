@@ -74,6 +74,10 @@ func (a *aria2Client) CreateTask(ctx context.Context, url string, options map[st
 	for k, v := range options {
 		downloadOptions[k] = v
 	}
+	delete(downloadOptions, downloader.OptionSpeedLimit)
+	if limit := downloader.SpeedLimitOption(options); limit > 0 {
+		downloadOptions["max-download-limit"] = strconv.FormatInt(limit, 10)
+	}
 	downloadOptions["dir"] = path
 	downloadOptions["follow-torrent"] = "mem"
 
@@ -48,17 +48,23 @@ type (
 	}
 
 	TaskFile struct {
-		Index    int     `json:"index"`
-		Name     string  `json:"name"`
-		Size     int64   `json:"size"`
-		Progress float64 `json:"progress"`
-		Selected bool    `json:"selected"`
+		Index    int          `json:"index"`
+		Name     string       `json:"name"`
+		Size     int64        `json:"size"`
+		Progress float64      `json:"progress"`
+		Selected bool         `json:"selected"`
+		Priority FilePriority `json:"priority,omitempty"`
 	}
 
 	SetFileToDownloadArgs struct {
 		Index    int  `json:"index"`
 		Download bool `json:"download"`
+		// Priority of a file to download, normal if empty. Ignored by downloaders not supporting it.
+		Priority FilePriority `json:"priority,omitempty"`
 	}
+
+	// FilePriority download priority of a file in the task.
+	FilePriority string
 )
 
 const (
@@ -71,6 +77,12 @@ const (
 	DownloaderCtxKey = "downloader"
 )
 
+const (
+	FilePriorityLow    FilePriority = "low"
+	FilePriorityNormal FilePriority = "normal"
+	FilePriorityHigh   FilePriority = "high"
+)
+
 func init() {
 	gob.Register(TaskHandle{})
 	gob.Register(TaskStatus{})
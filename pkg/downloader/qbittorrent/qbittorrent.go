@@ -10,7 +10,10 @@ import (
 	"net/http/cookiejar"
 	"net/url"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
@@ -29,6 +32,7 @@ const (
 
 	downloadPrioritySkip     = 0
 	downloadPriorityDownload = 1
+	downloadPriorityHigh     = 6
 )
 
 var (
@@ -47,54 +51,74 @@ var (
 	}
 )
 
-type qbittorrentClient struct {
-	c        request.Client
-	settings setting.Provider
-	l        logging.Logger
-	options  *types.QBittorrentSetting
-}
+// sessions holds login sessions by server and user, shared by clients so that a new client does not
+// login again.
+var sessions sync.Map
 
-func NewClient(l logging.Logger, c request.Client, setting setting.Provider, options *types.QBittorrentSetting) (downloader.Downloader, error) {
-	jar, err := cookiejar.New(nil)
-	if err != nil {
-		return nil, err
+type (
+	qbittorrentClient struct {
+		c        request.Client
+		settings setting.Provider
+		l        logging.Logger
+		options  *types.QBittorrentSetting
+		session  *session
+	}
+
+	session struct {
+		jar http.CookieJar
+		mu  sync.Mutex
+		// loggedInAt is the last time login succeeded, requests sent before it are retried without
+		// logging in again.
+		loggedInAt time.Time
+		// categories are categories known to exist in the client.
+		categories map[string]bool
 	}
+)
 
+func NewClient(l logging.Logger, c request.Client, setting setting.Provider, options *types.QBittorrentSetting) (downloader.Downloader, error) {
 	server, err := url.Parse(options.Server)
 	if err != nil {
 		return nil, fmt.Errorf("invalid qbittorrent server URL: %w", err)
 	}
 
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	s, _ := sessions.LoadOrStore(options.Server+"|"+options.User, &session{jar: jar, categories: make(map[string]bool)})
 	base, _ := url.Parse(apiPrefix)
 	c.Apply(
-		request.WithCookieJar(jar),
+		request.WithCookieJar(s.(*session).jar),
 		request.WithLogger(l),
 		request.WithEndpoint(options.Server),
 		request.WithEndpoint(server.ResolveReference(base).String()),
 	)
-	return &qbittorrentClient{c: c, options: options, l: l, settings: setting}, nil
+	return &qbittorrentClient{c: c, options: options, l: l, settings: setting, session: s.(*session)}, nil
 }
 
 func (c *qbittorrentClient) SetFilesToDownload(ctx context.Context, handle *downloader.TaskHandle, args ...*downloader.SetFileToDownloadArgs) error {
-	downloadId := make([]int, 0, len(args))
-	skipId := make([]int, 0, len(args))
+	// File IDs grouped by priority
+	ids := make(map[int][]int)
+	priorities := make([]int, 0, 3)
 	for _, arg := range args {
+		priority := downloadPrioritySkip
 		if arg.Download {
-			downloadId = append(downloadId, arg.Index)
-		} else {
-			skipId = append(skipId, arg.Index)
+			priority = downloadPriorityDownload
+			if arg.Priority == downloader.FilePriorityHigh {
+				priority = downloadPriorityHigh
+			}
 		}
-	}
 
-	if len(downloadId) > 0 {
-		if err := c.setFilePriority(ctx, handle.Hash, downloadPriorityDownload, downloadId...); err != nil {
-			return fmt.Errorf("failed to set file priority to download: %w", err)
+		if _, ok := ids[priority]; !ok {
+			priorities = append(priorities, priority)
 		}
+		ids[priority] = append(ids[priority], arg.Index)
 	}
 
-	if len(skipId) > 0 {
-		if err := c.setFilePriority(ctx, handle.Hash, downloadPrioritySkip, skipId...); err != nil {
-			return fmt.Errorf("failed to set file priority to skip: %w", err)
+	for _, priority := range priorities {
+		if err := c.setFilePriority(ctx, handle.Hash, priority, ids[priority]...); err != nil {
+			return fmt.Errorf("failed to set file priority to %d: %w", priority, err)
 		}
 	}
 
@@ -189,7 +213,7 @@ func (c *qbittorrentClient) Info(ctx context.Context, handle *downloader.TaskHan
 	// Combining and converting all info
 	state := downloader.StatusDownloading
 	switch torrents[0].State {
-	case "downloading", "pausedDL", "allocating", "metaDL", "queuedDL", "stalledDL", "checkingDL", "forcedDL", "checkingResumeData", "moving", "forcedMetaDL":
+	case "downloading", "pausedDL", "stoppedDL", "allocating", "metaDL", "queuedDL", "stalledDL", "checkingDL", "forcedDL", "checkingResumeData", "moving", "forcedMetaDL":
 		state = downloader.StatusDownloading
 	case "uploading", "queuedUP", "stalledUP", "checkingUP", "forcedUP":
 		state = downloader.StatusSeeding
@@ -200,6 +224,15 @@ func (c *qbittorrentClient) Info(ctx context.Context, handle *downloader.TaskHan
 	default:
 		state = downloader.StatusUnknown
 	}
+
+	errorMessage := ""
+	switch torrents[0].State {
+	case "error":
+		errorMessage = "qBittorrent reported an error for this torrent, check its log for details"
+	case "missingFiles":
+		errorMessage = "downloaded files are missing"
+	}
+
 	status := &downloader.TaskStatus{
 		Name:          torrents[0].Name,
 		Total:         torrents[0].Size,
@@ -209,6 +242,7 @@ func (c *qbittorrentClient) Info(ctx context.Context, handle *downloader.TaskHan
 		UploadSpeed:   torrents[0].Upspeed,
 		SavePath:      filepath.ToSlash(torrents[0].SavePath),
 		State:         state,
+		ErrorMessage:  errorMessage,
 		Hash:          torrents[0].Hash,
 		Files: lo.Map(files, func(item File, index int) downloader.TaskFile {
 			return downloader.TaskFile{
@@ -217,6 +251,7 @@ func (c *qbittorrentClient) Info(ctx context.Context, handle *downloader.TaskHan
 				Size:     item.Size,
 				Progress: item.Progress,
 				Selected: item.Priority > 0,
+				Priority: filePriority(item.Priority),
 			}
 		}),
 	}
@@ -248,6 +283,19 @@ func (c *qbittorrentClient) Info(ctx context.Context, handle *downloader.TaskHan
 	return status, nil
 }
 
+// filePriority converts qBittorrent file priority, 0 for skipped files, 1 for normal, 6 for high and 7 for
+// maximal.
+func filePriority(priority int) downloader.FilePriority {
+	switch {
+	case priority <= downloadPrioritySkip:
+		return ""
+	case priority >= downloadPriorityHigh:
+		return downloader.FilePriorityHigh
+	default:
+		return downloader.FilePriorityNormal
+	}
+}
+
 func (c *qbittorrentClient) CreateTask(ctx context.Context, url string, options map[string]interface{}) (*downloader.TaskHandle, error) {
 	guid, _ := uuid.NewV4()
 
@@ -268,21 +316,35 @@ func (c *qbittorrentClient) CreateTask(ctx context.Context, url string, options
 	_ = formWriter.WriteField("urls", url)
 	_ = formWriter.WriteField("savepath", path)
 	_ = formWriter.WriteField("tags", crTagPrefix+guid.String())
+	if c.options.Category != "" {
+		if err := c.ensureCategory(ctx, c.options.Category); err != nil {
+			return nil, err
+		}
+		_ = formWriter.WriteField("category", c.options.Category)
+	}
+
+	limit := downloader.SpeedLimitOption(options)
 
 	// Apply global options
 	for k, v := range c.options.Options {
-		if _, ok := supportDownloadOptions[k]; ok {
+		if _, ok := supportDownloadOptions[k]; ok && (k != "dlLimit" || limit == 0) {
 			_ = formWriter.WriteField(k, fmt.Sprintf("%s", v))
 		}
 	}
 
 	// Apply group options
 	for k, v := range options {
-		if _, ok := supportDownloadOptions[k]; ok {
+		if _, ok := supportDownloadOptions[k]; ok && (k != "dlLimit" || limit == 0) {
 			_ = formWriter.WriteField(k, fmt.Sprintf("%s", v))
 		}
 	}
 
+	if limit > 0 {
+		_ = formWriter.WriteField("dlLimit", strconv.FormatInt(limit, 10))
+	}
+
+	_ = formWriter.Close()
+
 	// Send request
 	headers := http.Header{
 		"Content-Type": []string{formWriter.FormDataContentType()},
@@ -302,6 +364,43 @@ func (c *qbittorrentClient) CreateTask(ctx context.Context, url string, options
 	}, nil
 }
 
+// ensureCategory creates the category if it does not exist yet.
+func (c *qbittorrentClient) ensureCategory(ctx context.Context, category string) error {
+	c.session.mu.Lock()
+	known := c.session.categories[category]
+	c.session.mu.Unlock()
+	if known {
+		return nil
+	}
+
+	resp, err := c.request(ctx, http.MethodPost, "torrents/categories", "", nil)
+	if err != nil {
+		return fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	var categories map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(resp), &categories); err != nil {
+		return fmt.Errorf("failed to unmarshal categories response: %w", err)
+	}
+
+	if _, ok := categories[category]; !ok {
+		form := url.Values{}
+		form.Add("category", category)
+		headers := http.Header{
+			"Content-Type": []string{"application/x-www-form-urlencoded"},
+		}
+
+		if _, err := c.request(ctx, http.MethodPost, "torrents/createCategory", form.Encode(), &headers); err != nil {
+			return fmt.Errorf("failed to create category %q: %w", category, err)
+		}
+	}
+
+	c.session.mu.Lock()
+	c.session.categories[category] = true
+	c.session.mu.Unlock()
+	return nil
+}
+
 func (c *qbittorrentClient) setFilePriority(ctx context.Context, hash string, priority int, id ...int) error {
 	buffer := bytes.Buffer{}
 	formWriter := multipart.NewWriter(&buffer)
@@ -333,7 +432,16 @@ func (c *qbittorrentClient) Test(ctx context.Context) (string, error) {
 	return res, nil
 }
 
-func (c *qbittorrentClient) login(ctx context.Context) error {
+// login logs in unless another request has done so since sentAt.
+func (c *qbittorrentClient) login(ctx context.Context, sentAt time.Time) error {
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+
+	if c.session.loggedInAt.After(sentAt) {
+		return nil
+	}
+
+	c.l.Info("QBittorrent cookie expired, sending login request...")
 	form := url.Values{}
 	form.Add("username", c.options.User)
 	form.Add("password", c.options.Password)
@@ -352,10 +460,15 @@ func (c *qbittorrentClient) login(ctx context.Context) error {
 		return fmt.Errorf("login failed with response: %s, possibly inccorrect credential is provided", res)
 	}
 
+	c.session.loggedInAt = time.Now()
 	return nil
 }
 
 func (c *qbittorrentClient) request(ctx context.Context, method, path string, body string, headers *http.Header) (string, error) {
+	return c.doRequest(ctx, method, path, body, headers, false)
+}
+
+func (c *qbittorrentClient) doRequest(ctx context.Context, method, path string, body string, headers *http.Header, retried bool) (string, error) {
 	opts := []request.Option{
 		request.WithContext(ctx),
 	}
@@ -364,6 +477,7 @@ func (c *qbittorrentClient) request(ctx context.Context, method, path string, bo
 		opts = append(opts, request.WithHeader(*headers))
 	}
 
+	sentAt := time.Now()
 	res := c.c.Request(method, path, strings.NewReader(body), opts...)
 
 	if res.Err != nil {
@@ -372,12 +486,15 @@ func (c *qbittorrentClient) request(ctx context.Context, method, path string, bo
 
 	switch res.Response.StatusCode {
 	case http.StatusForbidden:
-		c.l.Info("QBittorrent cookie expired, sending login request...")
-		if err := c.login(ctx); err != nil {
+		if retried {
+			return "", fmt.Errorf("request is still forbidden after login")
+		}
+
+		if err := c.login(ctx, sentAt); err != nil {
 			return "", fmt.Errorf("login failed: %w", err)
 		}
 
-		return c.request(ctx, method, path, body, headers)
+		return c.doRequest(ctx, method, path, body, headers, true)
 
 	case http.StatusOK:
 		respContent, err := res.GetResponse()
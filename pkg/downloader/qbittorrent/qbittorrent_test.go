@@ -0,0 +1,140 @@
+package qbittorrent
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/stretchr/testify/assert"
+)
+
+type testConfig struct {
+	conf.ConfigProvider
+}
+
+func (testConfig) System() *conf.System {
+	return &conf.System{Mode: conf.MasterMode}
+}
+
+// fakeQBittorrent accepts requests with the session cookie set by login only.
+type fakeQBittorrent struct {
+	mu         sync.Mutex
+	logins     int
+	categories map[string]bool
+	addedForm  []map[string][]string
+	state      string
+}
+
+func (f *fakeQBittorrent) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == "/api/v2/auth/login" {
+		f.logins++
+		http.SetCookie(w, &http.Cookie{Name: "SID", Value: "session", Path: "/"})
+		_, _ = w.Write([]byte(successResponse))
+		return
+	}
+
+	if cookie, err := r.Cookie("SID"); err != nil || cookie.Value != "session" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/api/v2/torrents/categories":
+		_, _ = w.Write([]byte(`{}`))
+	case "/api/v2/torrents/createCategory":
+		_ = r.ParseForm()
+		f.categories[r.PostForm.Get("category")] = true
+		w.WriteHeader(http.StatusOK)
+	case "/api/v2/torrents/add":
+		_ = r.ParseMultipartForm(1 << 20)
+		f.addedForm = append(f.addedForm, r.MultipartForm.Value)
+		_, _ = w.Write([]byte(successResponse))
+	case "/api/v2/torrents/info":
+		_, _ = w.Write([]byte(`[{"hash":"abc","name":"ubuntu","state":"` + f.state + `","size":100,"completed":50}]`))
+	case "/api/v2/torrents/files":
+		_, _ = w.Write([]byte(`[{"index":0,"name":"ubuntu.iso","size":100,"progress":0.5,"priority":1}]`))
+	case "/api/v2/torrents/pieceStates":
+		_, _ = w.Write([]byte(`[2,1,0]`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newTestClient(t *testing.T, server *httptest.Server, category string) downloader.Downloader {
+	client, err := NewClient(logging.NewConsoleLogger(logging.LevelError), request.NewClient(testConfig{}), nil,
+		&types.QBittorrentSetting{Server: server.URL, User: "admin", Password: "pass", TempPath: t.TempDir(), Category: category})
+	assert.NoError(t, err)
+	return client
+}
+
+func TestQBittorrentClient_CreateTask(t *testing.T) {
+	a := assert.New(t)
+	fake := &fakeQBittorrent{categories: make(map[string]bool)}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := newTestClient(t, server, "cloudreve")
+	handle, err := client.CreateTask(context.Background(), "magnet:?xt=urn:btih:abc", nil)
+	a.NoError(err)
+	a.NotEmpty(handle.ID)
+	a.True(fake.categories["cloudreve"])
+	a.Len(fake.addedForm, 1)
+	a.Equal([]string{"cloudreve"}, fake.addedForm[0]["category"])
+	a.Equal([]string{crTagPrefix + handle.ID}, fake.addedForm[0]["tags"])
+
+	// A new client of the same server reuses the session and known categories.
+	delete(fake.categories, "cloudreve")
+	_, err = newTestClient(t, server, "cloudreve").CreateTask(context.Background(), "magnet:?xt=urn:btih:def", nil)
+	a.NoError(err)
+	a.Equal(1, fake.logins)
+	a.False(fake.categories["cloudreve"])
+}
+
+func TestQBittorrentClient_Info(t *testing.T) {
+	a := assert.New(t)
+	fake := &fakeQBittorrent{categories: make(map[string]bool)}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := newTestClient(t, server, "")
+	for state, expected := range map[string]downloader.Status{
+		"stoppedDL":    downloader.StatusDownloading,
+		"stalledUP":    downloader.StatusSeeding,
+		"stoppedUP":    downloader.StatusCompleted,
+		"missingFiles": downloader.StatusError,
+		"unknownState": downloader.StatusUnknown,
+	} {
+		fake.state = state
+		status, err := client.Info(context.Background(), &downloader.TaskHandle{ID: "id", Hash: "abc"})
+		a.NoError(err)
+		a.Equal(expected, status.State, state)
+		a.Equal(expected == downloader.StatusError, status.ErrorMessage != "", state)
+		a.Equal(3, status.NumPieces)
+		a.Equal([]byte{0x80}, status.Pieces)
+		a.True(status.Files[0].Selected)
+	}
+}
+
+func TestQBittorrentClient_LoginFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/auth/login" {
+			_, _ = w.Write([]byte("Fails."))
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	_, err := newTestClient(t, server, "").Test(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,155 @@
+package downloader
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+)
+
+const (
+	// OptionSpeedLimit is the download option of maximum download speed in bytes per second, each
+	// provider converts it into its own option.
+	OptionSpeedLimit = "cr_speed_limit"
+
+	// maxScheduleIterations bounds the search of time within all schedules.
+	maxScheduleIterations = 16
+)
+
+type window struct {
+	start, end time.Duration
+}
+
+// ValidateSchedule checks windows of schedule are in "HH:MM-HH:MM" form.
+func ValidateSchedule(schedule *types.RemoteDownloadSchedule) error {
+	if schedule == nil {
+		return nil
+	}
+
+	_, err := parseWindows(schedule.Windows)
+	return err
+}
+
+// UntilScheduled returns how long to wait from now until time is within windows of all schedules, 0 if
+// downloads can start now. Windows are in local time, a window ending before it starts crosses midnight.
+func UntilScheduled(now time.Time, schedules ...*types.RemoteDownloadSchedule) (time.Duration, error) {
+	parsed := make([][]window, 0, len(schedules))
+	for _, schedule := range schedules {
+		if schedule == nil || len(schedule.Windows) == 0 {
+			continue
+		}
+
+		windows, err := parseWindows(schedule.Windows)
+		if err != nil {
+			return 0, err
+		}
+		parsed = append(parsed, windows)
+	}
+
+	t := now
+	for i := 0; i < maxScheduleIterations; i++ {
+		wait := time.Duration(0)
+		for _, windows := range parsed {
+			wait = max(wait, untilOpen(t, windows))
+		}
+
+		if wait == 0 {
+			return t.Sub(now), nil
+		}
+		t = t.Add(wait)
+	}
+
+	return 0, fmt.Errorf("remote download schedules never overlap")
+}
+
+// SpeedLimit returns the lowest non-zero download speed limit of schedules, 0 for unlimited.
+func SpeedLimit(schedules ...*types.RemoteDownloadSchedule) int64 {
+	limit := int64(0)
+	for _, schedule := range schedules {
+		if schedule != nil && schedule.SpeedLimit > 0 && (limit == 0 || schedule.SpeedLimit < limit) {
+			limit = schedule.SpeedLimit
+		}
+	}
+
+	return limit
+}
+
+// SpeedLimitOption reads OptionSpeedLimit from download options, 0 if not set.
+func SpeedLimitOption(options map[string]interface{}) int64 {
+	switch v := options[OptionSpeedLimit].(type) {
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	case float64:
+		// Options decoded from JSON in slave nodes
+		return int64(v)
+	default:
+		return 0
+	}
+}
+
+// untilOpen returns how long to wait from t until one of windows opens, 0 if already open.
+func untilOpen(t time.Time, windows []window) time.Duration {
+	if len(windows) == 0 {
+		return 0
+	}
+
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := t.Sub(midnight)
+	wait := time.Duration(-1)
+	for _, w := range windows {
+		if w.start <= w.end && offset >= w.start && offset < w.end ||
+			w.start > w.end && (offset >= w.start || offset < w.end) {
+			return 0
+		}
+
+		next := w.start - offset
+		if next < 0 {
+			next += 24 * time.Hour
+		}
+		if wait < 0 || next < wait {
+			wait = next
+		}
+	}
+
+	return wait
+}
+
+func parseWindows(raw []string) ([]window, error) {
+	windows := make([]window, 0, len(raw))
+	for _, w := range raw {
+		start, end, ok := strings.Cut(w, "-")
+		if !ok {
+			return nil, fmt.Errorf("invalid time window %q, expect HH:MM-HH:MM", w)
+		}
+
+		startOffset, err := parseClock(start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time window %q: %w", w, err)
+		}
+
+		endOffset, err := parseClock(end)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time window %q: %w", w, err)
+		}
+
+		if startOffset == endOffset {
+			return nil, fmt.Errorf("invalid time window %q: empty window", w)
+		}
+
+		windows = append(windows, window{start: startOffset, end: endOffset})
+	}
+
+	return windows, nil
+}
+
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
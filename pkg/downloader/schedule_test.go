@@ -0,0 +1,54 @@
+package downloader
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUntilScheduled(t *testing.T) {
+	a := assert.New(t)
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 1, hour, minute, 0, 0, time.Local)
+	}
+
+	night := &types.RemoteDownloadSchedule{Windows: []string{"01:00-07:00"}}
+	wait, err := UntilScheduled(at(3, 0), night)
+	a.NoError(err)
+	a.Zero(wait)
+
+	wait, err = UntilScheduled(at(8, 0), night)
+	a.NoError(err)
+	a.Equal(17*time.Hour, wait)
+
+	// Window crossing midnight
+	wait, err = UntilScheduled(at(23, 30), &types.RemoteDownloadSchedule{Windows: []string{"22:00-06:00"}})
+	a.NoError(err)
+	a.Zero(wait)
+
+	// Both schedules must be satisfied
+	early := &types.RemoteDownloadSchedule{Windows: []string{"00:00-02:00", "05:00-06:00"}}
+	wait, err = UntilScheduled(at(0, 30), night, early)
+	a.NoError(err)
+	a.Equal(30*time.Minute, wait)
+
+	wait, err = UntilScheduled(at(12, 0), nil, &types.RemoteDownloadSchedule{})
+	a.NoError(err)
+	a.Zero(wait)
+
+	_, err = UntilScheduled(at(12, 0), &types.RemoteDownloadSchedule{Windows: []string{"01:00"}})
+	a.Error(err)
+
+	_, err = UntilScheduled(at(12, 0), night, &types.RemoteDownloadSchedule{Windows: []string{"08:00-09:00"}})
+	a.Error(err)
+}
+
+func TestSpeedLimit(t *testing.T) {
+	a := assert.New(t)
+	a.EqualValues(0, SpeedLimit(nil, &types.RemoteDownloadSchedule{}))
+	a.EqualValues(100, SpeedLimit(&types.RemoteDownloadSchedule{SpeedLimit: 200}, nil, &types.RemoteDownloadSchedule{SpeedLimit: 100}))
+	a.EqualValues(100, SpeedLimitOption(map[string]interface{}{OptionSpeedLimit: float64(100)}))
+	a.EqualValues(0, SpeedLimitOption(nil))
+}
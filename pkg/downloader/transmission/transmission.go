@@ -0,0 +1,324 @@
+package transmission
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultRPCPath  = "/transmission/rpc"
+	sessionIDHeader = "X-Transmission-Session-Id"
+	successResult   = "success"
+)
+
+var (
+	supportDownloadOptions = map[string]bool{
+		"cookies":           true,
+		"bandwidthPriority": true,
+		"peer-limit":        true,
+		"paused":            true,
+	}
+
+	torrentFields = []string{
+		"id", "name", "hashString", "status", "error", "errorString", "downloadDir", "sizeWhenDone",
+		"leftUntilDone", "downloadedEver", "uploadedEver", "rateDownload", "rateUpload", "isFinished",
+		"pieceCount", "pieces", "files", "fileStats",
+	}
+)
+
+// sessionIDs holds the latest session ID of each RPC endpoint, shared by clients to skip the handshake.
+var sessionIDs sync.Map
+
+type transmissionClient struct {
+	c        request.Client
+	settings setting.Provider
+	l        logging.Logger
+	options  *types.TransmissionSetting
+	rpcUrl   string
+}
+
+func NewClient(l logging.Logger, c request.Client, setting setting.Provider, options *types.TransmissionSetting) (downloader.Downloader, error) {
+	server, err := url.Parse(options.Server)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transmission server URL: %w", err)
+	}
+
+	if server.Path == "" || server.Path == "/" {
+		server.Path = defaultRPCPath
+	}
+
+	c.Apply(request.WithLogger(l))
+	return &transmissionClient{c: c, options: options, l: l, settings: setting, rpcUrl: server.String()}, nil
+}
+
+func (c *transmissionClient) CreateTask(ctx context.Context, url string, options map[string]interface{}) (*downloader.TaskHandle, error) {
+	guid, _ := uuid.NewV4()
+
+	// Generate a unique path for the task
+	base := util.RelativePath(c.options.TempPath)
+	if c.options.TempPath == "" {
+		base = util.DataPath(c.settings.TempPath(ctx))
+	}
+	path := filepath.Join(
+		base,
+		"transmission",
+		guid.String(),
+	)
+	c.l.Info("Creating Transmission task with url %q saving to %q...", url, path)
+
+	args := map[string]any{}
+	// Apply global options
+	for k, v := range c.options.Options {
+		if _, ok := supportDownloadOptions[k]; ok {
+			args[k] = v
+		}
+	}
+
+	// Apply group options
+	for k, v := range options {
+		if _, ok := supportDownloadOptions[k]; ok {
+			args[k] = v
+		}
+	}
+
+	args["filename"] = url
+	args["download-dir"] = path
+
+	res := &rpcResponse[TorrentAdded]{}
+	if err := c.call(ctx, "torrent-add", args, res); err != nil {
+		return nil, fmt.Errorf("create task transmission failed: %w", err)
+	}
+
+	if res.Arguments.TorrentAdded == nil {
+		if res.Arguments.TorrentDuplicate != nil {
+			return nil, fmt.Errorf("torrent %q already exists in transmission", res.Arguments.TorrentDuplicate.HashString)
+		}
+
+		return nil, fmt.Errorf("create task transmission failed: no torrent added")
+	}
+
+	handle := &downloader.TaskHandle{
+		ID:   guid.String(),
+		Hash: res.Arguments.TorrentAdded.HashString,
+	}
+
+	if limit := downloader.SpeedLimitOption(options); limit > 0 {
+		setArgs := &TorrentSetArgs{IDs: []string{handle.Hash}, DownloadLimited: true, DownloadLimit: max(limit/1024, 1)}
+		if err := c.call(ctx, "torrent-set", setArgs, &rpcResponse[struct{}]{}); err != nil {
+			return nil, fmt.Errorf("failed to set speed limit: %w", err)
+		}
+	}
+
+	return handle, nil
+}
+
+func (c *transmissionClient) Info(ctx context.Context, handle *downloader.TaskHandle) (*downloader.TaskStatus, error) {
+	res := &rpcResponse[TorrentList]{}
+	if err := c.call(ctx, "torrent-get", &TorrentGetArgs{IDs: []string{handle.Hash}, Fields: torrentFields}, res); err != nil {
+		return nil, fmt.Errorf("failed to get task info with hash %q: %w", handle.Hash, err)
+	}
+
+	if len(res.Arguments.Torrents) == 0 {
+		return nil, fmt.Errorf("no torrent with hash %q: %w", handle.Hash, downloader.ErrTaskNotFount)
+	}
+
+	t := res.Arguments.Torrents[0]
+	state := downloader.StatusDownloading
+	errorMessage := ""
+	switch {
+	case t.Error == errorLocal:
+		state = downloader.StatusError
+		errorMessage = t.ErrorString
+	case t.Status == statusSeed || t.Status == statusSeedWait:
+		state = downloader.StatusSeeding
+	case t.Status == statusStopped && t.SizeWhenDone > 0 && t.LeftUntilDone == 0:
+		state = downloader.StatusCompleted
+	case t.Status >= statusStopped && t.Status <= statusDownload:
+		state = downloader.StatusDownloading
+	default:
+		state = downloader.StatusUnknown
+	}
+
+	pieces, err := base64.StdEncoding.DecodeString(t.Pieces)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode pieces of torrent %q: %w", handle.Hash, err)
+	}
+
+	return &downloader.TaskStatus{
+		Name:          t.Name,
+		Total:         t.SizeWhenDone,
+		Downloaded:    t.SizeWhenDone - t.LeftUntilDone,
+		DownloadSpeed: t.RateDownload,
+		Uploaded:      t.UploadedEver,
+		UploadSpeed:   t.RateUpload,
+		SavePath:      filepath.ToSlash(t.DownloadDir),
+		State:         state,
+		ErrorMessage:  errorMessage,
+		Hash:          t.HashString,
+		// Transmission encodes pieces the same way as TaskStatus, the highest bit corresponds to the piece at index 0.
+		Pieces:    pieces,
+		NumPieces: t.PieceCount,
+		Files: lo.Map(t.Files, func(item File, index int) downloader.TaskFile {
+			progress := float64(0)
+			if item.Length > 0 {
+				progress = float64(item.BytesCompleted) / float64(item.Length)
+			}
+
+			file := downloader.TaskFile{
+				Index:    index,
+				Name:     filepath.ToSlash(item.Name),
+				Size:     item.Length,
+				Progress: progress,
+				Selected: true,
+			}
+
+			if index < len(t.FileStats) {
+				file.Selected = t.FileStats[index].Wanted
+				file.Priority = filePriority(t.FileStats[index])
+			}
+
+			return file
+		}),
+	}, nil
+}
+
+// filePriority converts Transmission file priority, -1 for low, 0 for normal and 1 for high.
+func filePriority(stats FileStats) downloader.FilePriority {
+	switch {
+	case !stats.Wanted:
+		return ""
+	case stats.Priority < 0:
+		return downloader.FilePriorityLow
+	case stats.Priority > 0:
+		return downloader.FilePriorityHigh
+	default:
+		return downloader.FilePriorityNormal
+	}
+}
+
+func (c *transmissionClient) Cancel(ctx context.Context, handle *downloader.TaskHandle) error {
+	res := &rpcResponse[struct{}]{}
+	if err := c.call(ctx, "torrent-remove", &TorrentRemoveArgs{IDs: []string{handle.Hash}, DeleteLocalData: true}, res); err != nil {
+		return fmt.Errorf("failed to cancel task with hash %q: %w", handle.Hash, err)
+	}
+
+	return nil
+}
+
+func (c *transmissionClient) SetFilesToDownload(ctx context.Context, handle *downloader.TaskHandle, args ...*downloader.SetFileToDownloadArgs) error {
+	setArgs := &TorrentSetArgs{IDs: []string{handle.Hash}}
+	for _, arg := range args {
+		if !arg.Download {
+			setArgs.FilesUnwanted = append(setArgs.FilesUnwanted, arg.Index)
+			continue
+		}
+
+		setArgs.FilesWanted = append(setArgs.FilesWanted, arg.Index)
+		switch arg.Priority {
+		case downloader.FilePriorityHigh:
+			setArgs.PriorityHigh = append(setArgs.PriorityHigh, arg.Index)
+		case downloader.FilePriorityLow:
+			setArgs.PriorityLow = append(setArgs.PriorityLow, arg.Index)
+		default:
+			setArgs.PriorityNormal = append(setArgs.PriorityNormal, arg.Index)
+		}
+	}
+
+	res := &rpcResponse[struct{}]{}
+	if err := c.call(ctx, "torrent-set", setArgs, res); err != nil {
+		return fmt.Errorf("failed to set files to download: %w", err)
+	}
+
+	return nil
+}
+
+func (c *transmissionClient) Test(ctx context.Context) (string, error) {
+	res := &rpcResponse[Session]{}
+	if err := c.call(ctx, "session-get", map[string]any{"fields": []string{"version", "rpc-version"}}, res); err != nil {
+		return "", fmt.Errorf("test transmission failed: %w", err)
+	}
+
+	return res.Arguments.Version, nil
+}
+
+// call sends an RPC request and decodes response into res, the request is sent again with the new session
+// ID if current one is rejected.
+func (c *transmissionClient) call(ctx context.Context, method string, args any, res interface{ result() string }) error {
+	body, err := json.Marshal(&rpcRequest{Method: method, Arguments: args})
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	for retried := false; ; retried = true {
+		headers := http.Header{
+			"Content-Type": []string{"application/json"},
+		}
+		if sessionID, ok := sessionIDs.Load(c.rpcUrl); ok {
+			headers.Set(sessionIDHeader, sessionID.(string))
+		}
+		if c.options.User != "" {
+			headers.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(c.options.User+":"+c.options.Password)))
+		}
+
+		resp := c.c.Request(http.MethodPost, c.rpcUrl, strings.NewReader(string(body)),
+			request.WithContext(ctx),
+			request.WithHeader(headers),
+		)
+		if resp.Err != nil {
+			return fmt.Errorf("send request failed: %w", resp.Err)
+		}
+
+		switch resp.Response.StatusCode {
+		case http.StatusConflict:
+			if retried {
+				return fmt.Errorf("session ID is still rejected after handshake")
+			}
+
+			// Session ID expired or not acquired yet, use the new one and try again.
+			c.l.Debug("Transmission session ID expired, retrying with new one...")
+			sessionIDs.Store(c.rpcUrl, resp.Response.Header.Get(sessionIDHeader))
+			_, _ = resp.GetResponseIgnoreErr()
+			continue
+		case http.StatusUnauthorized:
+			return fmt.Errorf("unauthorized, possibly inccorrect credential is provided")
+		case http.StatusOK:
+			content, err := resp.GetResponse()
+			if err != nil {
+				return fmt.Errorf("failed reading response: %w", err)
+			}
+
+			if err := json.Unmarshal([]byte(content), res); err != nil {
+				return fmt.Errorf("failed to decode response: %w", err)
+			}
+
+			if res.result() != successResult {
+				return fmt.Errorf("rpc failed with result: %s", res.result())
+			}
+
+			return nil
+		default:
+			content, _ := resp.GetResponse()
+			return fmt.Errorf("unexpected status code: %d, content: %s", resp.Response.StatusCode, content)
+		}
+	}
+}
+
+func (r *rpcResponse[T]) result() string {
+	return r.Result
+}
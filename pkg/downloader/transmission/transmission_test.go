@@ -0,0 +1,174 @@
+package transmission
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/stretchr/testify/assert"
+)
+
+type testConfig struct {
+	conf.ConfigProvider
+}
+
+func (testConfig) System() *conf.System {
+	return &conf.System{Mode: conf.MasterMode}
+}
+
+// fakeTransmission rejects requests without current session ID like Transmission does.
+type fakeTransmission struct {
+	mu         sync.Mutex
+	sessionID  string
+	handshakes int
+	requests   []map[string]any
+	status     int
+	left       int64
+}
+
+func (f *fakeTransmission) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if user, password, ok := r.BasicAuth(); !ok || user != "admin" || password != "pass" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if r.URL.Path != defaultRPCPath {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if r.Header.Get(sessionIDHeader) != f.sessionID {
+		f.handshakes++
+		w.Header().Set(sessionIDHeader, f.sessionID)
+		w.WriteHeader(http.StatusConflict)
+		return
+	}
+
+	var req map[string]any
+	_ = json.NewDecoder(r.Body).Decode(&req)
+	f.requests = append(f.requests, req)
+
+	switch req["method"] {
+	case "torrent-add":
+		_, _ = w.Write([]byte(`{"result":"success","arguments":{"torrent-added":{"id":1,"name":"ubuntu","hashString":"abc"}}}`))
+	case "torrent-get":
+		res := map[string]any{
+			"result": "success",
+			"arguments": map[string]any{"torrents": []map[string]any{{
+				"id": 1, "name": "ubuntu", "hashString": "abc", "status": f.status, "sizeWhenDone": 100,
+				"leftUntilDone": f.left, "downloadDir": "/tmp/ubuntu", "pieceCount": 3, "pieces": "gA==",
+				"files":     []map[string]any{{"name": "ubuntu/ubuntu.iso", "length": 100, "bytesCompleted": 100 - f.left}},
+				"fileStats": []map[string]any{{"wanted": true}},
+			}}},
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	default:
+		_, _ = w.Write([]byte(`{"result":"success","arguments":{}}`))
+	}
+}
+
+func newTestClient(t *testing.T, server *httptest.Server) downloader.Downloader {
+	client, err := NewClient(logging.NewConsoleLogger(logging.LevelError), request.NewClient(testConfig{}), nil,
+		&types.TransmissionSetting{Server: server.URL, User: "admin", Password: "pass", TempPath: t.TempDir(),
+			Options: map[string]any{"peer-limit": 10, "unsupported": true}})
+	assert.NoError(t, err)
+	return client
+}
+
+func TestTransmissionClient_CreateTask(t *testing.T) {
+	a := assert.New(t)
+	fake := &fakeTransmission{sessionID: "session1"}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	handle, err := newTestClient(t, server).CreateTask(context.Background(), "magnet:?xt=urn:btih:abc", nil)
+	a.NoError(err)
+	a.Equal("abc", handle.Hash)
+	a.Equal(1, fake.handshakes)
+
+	args := fake.requests[0]["arguments"].(map[string]any)
+	a.Equal("magnet:?xt=urn:btih:abc", args["filename"])
+	a.Contains(args["download-dir"], handle.ID)
+	a.EqualValues(10, args["peer-limit"])
+	a.NotContains(args, "unsupported")
+
+	// Session ID is renewed once expired.
+	fake.sessionID = "session2"
+	a.NoError(newTestClient(t, server).Cancel(context.Background(), handle))
+	a.Equal(2, fake.handshakes)
+	args = fake.requests[1]["arguments"].(map[string]any)
+	a.Equal([]any{"abc"}, args["ids"])
+	a.Equal(true, args["delete-local-data"])
+}
+
+func TestTransmissionClient_SetFilesToDownload(t *testing.T) {
+	a := assert.New(t)
+	fake := &fakeTransmission{sessionID: "session"}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	err := newTestClient(t, server).SetFilesToDownload(context.Background(), &downloader.TaskHandle{Hash: "abc"},
+		&downloader.SetFileToDownloadArgs{Index: 0, Download: true, Priority: downloader.FilePriorityHigh},
+		&downloader.SetFileToDownloadArgs{Index: 1, Download: true},
+		&downloader.SetFileToDownloadArgs{Index: 2},
+	)
+	a.NoError(err)
+
+	args := fake.requests[0]["arguments"].(map[string]any)
+	a.Equal([]any{float64(0), float64(1)}, args["files-wanted"])
+	a.Equal([]any{float64(2)}, args["files-unwanted"])
+	a.Equal([]any{float64(0)}, args["priority-high"])
+	a.Equal([]any{float64(1)}, args["priority-normal"])
+	a.NotContains(args, "priority-low")
+}
+
+func TestTransmissionClient_Info(t *testing.T) {
+	a := assert.New(t)
+	fake := &fakeTransmission{sessionID: "session"}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	for _, c := range []struct {
+		status   int
+		left     int64
+		expected downloader.Status
+	}{
+		{statusDownload, 50, downloader.StatusDownloading},
+		{statusStopped, 50, downloader.StatusDownloading},
+		{statusSeed, 0, downloader.StatusSeeding},
+		{statusStopped, 0, downloader.StatusCompleted},
+	} {
+		fake.status, fake.left = c.status, c.left
+		status, err := client.Info(context.Background(), &downloader.TaskHandle{Hash: "abc"})
+		a.NoError(err)
+		a.Equal(c.expected, status.State)
+		a.Equal(100-c.left, status.Downloaded)
+		a.Equal([]byte{0x80}, status.Pieces)
+		a.Equal(3, status.NumPieces)
+		a.Equal(float64(100-c.left)/100, status.Files[0].Progress)
+		a.True(status.Files[0].Selected)
+		a.Equal(downloader.FilePriorityNormal, status.Files[0].Priority)
+	}
+}
+
+func TestTransmissionClient_Unauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	_, err := newTestClient(t, server).Test(context.Background())
+	assert.Error(t, err)
+}
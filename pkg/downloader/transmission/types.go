@@ -0,0 +1,103 @@
+package transmission
+
+type (
+	rpcRequest struct {
+		Method    string `json:"method"`
+		Arguments any    `json:"arguments,omitempty"`
+	}
+
+	rpcResponse[T any] struct {
+		Result    string `json:"result"`
+		Arguments T      `json:"arguments"`
+	}
+
+	TorrentAdded struct {
+		TorrentAdded     *TorrentRef `json:"torrent-added"`
+		TorrentDuplicate *TorrentRef `json:"torrent-duplicate"`
+	}
+
+	TorrentRef struct {
+		ID         int    `json:"id"`
+		Name       string `json:"name"`
+		HashString string `json:"hashString"`
+	}
+
+	TorrentGetArgs struct {
+		IDs    []string `json:"ids"`
+		Fields []string `json:"fields"`
+	}
+
+	TorrentList struct {
+		Torrents []Torrent `json:"torrents"`
+	}
+
+	Torrent struct {
+		ID             int         `json:"id"`
+		Name           string      `json:"name"`
+		HashString     string      `json:"hashString"`
+		Status         int         `json:"status"`
+		Error          int         `json:"error"`
+		ErrorString    string      `json:"errorString"`
+		DownloadDir    string      `json:"downloadDir"`
+		SizeWhenDone   int64       `json:"sizeWhenDone"`
+		LeftUntilDone  int64       `json:"leftUntilDone"`
+		DownloadedEver int64       `json:"downloadedEver"`
+		UploadedEver   int64       `json:"uploadedEver"`
+		RateDownload   int64       `json:"rateDownload"`
+		RateUpload     int64       `json:"rateUpload"`
+		IsFinished     bool        `json:"isFinished"`
+		PieceCount     int         `json:"pieceCount"`
+		Pieces         string      `json:"pieces"`
+		Files          []File      `json:"files"`
+		FileStats      []FileStats `json:"fileStats"`
+	}
+
+	File struct {
+		Name           string `json:"name"`
+		Length         int64  `json:"length"`
+		BytesCompleted int64  `json:"bytesCompleted"`
+	}
+
+	FileStats struct {
+		BytesCompleted int64 `json:"bytesCompleted"`
+		Wanted         bool  `json:"wanted"`
+		Priority       int   `json:"priority"`
+	}
+
+	TorrentSetArgs struct {
+		IDs            []string `json:"ids"`
+		FilesWanted    []int    `json:"files-wanted,omitempty"`
+		FilesUnwanted  []int    `json:"files-unwanted,omitempty"`
+		PriorityHigh   []int    `json:"priority-high,omitempty"`
+		PriorityNormal []int    `json:"priority-normal,omitempty"`
+		PriorityLow    []int    `json:"priority-low,omitempty"`
+		// DownloadLimit is in KB/s.
+		DownloadLimit   int64 `json:"downloadLimit,omitempty"`
+		DownloadLimited bool  `json:"downloadLimited,omitempty"`
+	}
+
+	TorrentRemoveArgs struct {
+		IDs             []string `json:"ids"`
+		DeleteLocalData bool     `json:"delete-local-data"`
+	}
+
+	Session struct {
+		Version    string `json:"version"`
+		RPCVersion int    `json:"rpc-version"`
+	}
+)
+
+// Torrent status defined by Transmission RPC.
+const (
+	statusStopped = iota
+	statusCheckWait
+	statusCheck
+	statusDownloadWait
+	statusDownload
+	statusSeedWait
+	statusSeed
+)
+
+// errorLocal is the torrent error code of local errors, errors of 1 and 2 are tracker warnings and errors
+// which are usually transient.
+const errorLocal = 3
@@ -0,0 +1,468 @@
+package ytdlp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	YtDlpTempFolder   = "ytdlp"
+	defaultBinaryPath = "yt-dlp"
+	progressPrefix    = "[cr-progress]"
+	// outputTemplate names downloaded files after title and ID, title is truncated to keep names within
+	// file system limits.
+	outputTemplate = "%(title).180B [%(id)s].%(ext)s"
+	// statusFileSuffix is appended to the download folder path to store the final status of a task.
+	statusFileSuffix = ".json"
+	// maxErrorLines is the number of last output lines kept as the error message of a failed task.
+	maxErrorLines = 5
+
+	// Options accepted from node and group settings.
+	OptionFormat            = "format"
+	OptionFormatPreset      = "format_preset"
+	OptionPlaylist          = "playlist"
+	OptionProxy             = "proxy"
+	OptionLimitRate         = "limit_rate"
+	OptionMergeOutputFormat = "merge_output_format"
+)
+
+var (
+	// FormatPresets maps preset names usable in group settings to yt-dlp format selectors.
+	FormatPresets = map[string]string{
+		"best":  "bv*+ba/b",
+		"2160p": "bv*[height<=2160]+ba/b[height<=2160]",
+		"1080p": "bv*[height<=1080]+ba/b[height<=1080]",
+		"720p":  "bv*[height<=720]+ba/b[height<=720]",
+		"480p":  "bv*[height<=480]+ba/b[height<=480]",
+		"audio": "ba/b",
+	}
+
+	// jobs holds running yt-dlp processes by task ID.
+	jobs sync.Map
+)
+
+type (
+	ytdlpClient struct {
+		l        logging.Logger
+		settings setting.Provider
+		options  *types.YtDlpSetting
+	}
+
+	job struct {
+		id     string
+		mu     sync.Mutex
+		status *downloader.TaskStatus
+		cancel context.CancelFunc
+		done   chan struct{}
+	}
+
+	// progress is a progress line printed by yt-dlp.
+	progress struct {
+		downloaded int64
+		total      int64
+		speed      int64
+		filename   string
+	}
+)
+
+func New(l logging.Logger, settings setting.Provider, options *types.YtDlpSetting) downloader.Downloader {
+	return &ytdlpClient{
+		l:        l,
+		settings: settings,
+		options:  options,
+	}
+}
+
+func (c *ytdlpClient) CreateTask(ctx context.Context, rawUrl string, options map[string]interface{}) (*downloader.TaskHandle, error) {
+	target, err := url.Parse(rawUrl)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") {
+		return nil, fmt.Errorf("unsupported url %q, only http and https are allowed", rawUrl)
+	}
+
+	merged := make(map[string]interface{})
+	for k, v := range c.options.Options {
+		merged[k] = v
+	}
+	for k, v := range options {
+		merged[k] = v
+	}
+
+	args, err := buildArgs(merged)
+	if err != nil {
+		return nil, err
+	}
+
+	guid, _ := uuid.NewV4()
+	path := c.tempPath(ctx, guid.String())
+	if err := os.MkdirAll(path, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create download folder: %w", err)
+	}
+
+	c.l.Info("Creating yt-dlp task with url %q saving to %q...", rawUrl, path)
+	args = append(args, "--paths", path, "--", rawUrl)
+
+	// Process outlives the request creating it.
+	var (
+		runCtx context.Context
+		cancel context.CancelFunc
+	)
+	if c.options.Timeout > 0 {
+		runCtx, cancel = context.WithTimeout(context.Background(), time.Duration(c.options.Timeout)*time.Second)
+	} else {
+		runCtx, cancel = context.WithCancel(context.Background())
+	}
+
+	j := &job{
+		id:     guid.String(),
+		cancel: cancel,
+		done:   make(chan struct{}),
+		status: &downloader.TaskStatus{
+			Name:     target.String(),
+			State:    downloader.StatusDownloading,
+			SavePath: filepath.ToSlash(path),
+		},
+	}
+
+	cmd := c.command(runCtx, args)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to open yt-dlp output: %w", err)
+	}
+	cmd.Stderr = cmd.Stdout
+
+	if err := cmd.Start(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to start yt-dlp: %w", err)
+	}
+
+	jobs.Store(guid.String(), j)
+	go c.run(j, cmd, stdout, path)
+
+	return &downloader.TaskHandle{
+		ID: guid.String(),
+	}, nil
+}
+
+func (c *ytdlpClient) Info(ctx context.Context, handle *downloader.TaskHandle) (*downloader.TaskStatus, error) {
+	if v, ok := jobs.Load(handle.ID); ok {
+		j := v.(*job)
+		j.mu.Lock()
+		defer j.mu.Unlock()
+
+		status := *j.status
+		status.Files = append([]downloader.TaskFile(nil), j.status.Files...)
+		return &status, nil
+	}
+
+	// Process is not running in this instance, load the final status if saved.
+	content, err := os.ReadFile(c.tempPath(ctx, handle.ID) + statusFileSuffix)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no yt-dlp task with id %q: %w", handle.ID, downloader.ErrTaskNotFount)
+		}
+		return nil, fmt.Errorf("failed to read task status: %w", err)
+	}
+
+	status := &downloader.TaskStatus{}
+	if err := json.Unmarshal(content, status); err != nil {
+		return nil, fmt.Errorf("failed to decode task status: %w", err)
+	}
+
+	return status, nil
+}
+
+func (c *ytdlpClient) Cancel(ctx context.Context, handle *downloader.TaskHandle) error {
+	if v, ok := jobs.Load(handle.ID); ok {
+		j := v.(*job)
+		j.cancel()
+		<-j.done
+	}
+
+	path := c.tempPath(ctx, handle.ID)
+	if err := os.RemoveAll(path); err != nil {
+		return fmt.Errorf("failed to remove download folder: %w", err)
+	}
+
+	if err := os.Remove(path + statusFileSuffix); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove task status: %w", err)
+	}
+
+	return nil
+}
+
+func (c *ytdlpClient) SetFilesToDownload(ctx context.Context, handle *downloader.TaskHandle, args ...*downloader.SetFileToDownloadArgs) error {
+	return fmt.Errorf("selecting files is not supported by yt-dlp")
+}
+
+func (c *ytdlpClient) Test(ctx context.Context) (string, error) {
+	out, err := c.command(ctx, []string{"--version"}).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("test yt-dlp failed: %w, output: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+// command builds yt-dlp command with given arguments, wrapped in sandbox command if configured.
+func (c *ytdlpClient) command(ctx context.Context, args []string) *exec.Cmd {
+	binary := c.options.BinaryPath
+	if binary == "" {
+		binary = defaultBinaryPath
+	}
+
+	sandbox := strings.Fields(c.options.Sandbox)
+	if len(sandbox) > 0 {
+		return exec.CommandContext(ctx, sandbox[0], append(append(sandbox[1:], binary), args...)...)
+	}
+
+	return exec.CommandContext(ctx, binary, args...)
+}
+
+// run waits for the yt-dlp process while updating status from its output, the final status is saved next
+// to the download folder once exited.
+func (c *ytdlpClient) run(j *job, cmd *exec.Cmd, stdout io.Reader, path string) {
+	defer close(j.done)
+
+	// Size of each file is fixed once known, so that estimated size changes do not re-validate files.
+	sizes := make(map[string]int64)
+	var files []string
+	var lastLines []string
+
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		line := scanner.Text()
+		p, ok := parseProgress(line)
+		if !ok {
+			c.l.Debug("yt-dlp: %s", line)
+			lastLines = append(lastLines, line)
+			if len(lastLines) > maxErrorLines {
+				lastLines = lastLines[1:]
+			}
+			continue
+		}
+
+		name, err := filepath.Rel(path, p.filename)
+		if err != nil || strings.HasPrefix(name, "..") {
+			name = filepath.Base(p.filename)
+		}
+		name = filepath.ToSlash(name)
+
+		if _, ok := sizes[name]; !ok {
+			files = append(files, name)
+			sizes[name] = 0
+		}
+		if sizes[name] == 0 && p.total > 0 {
+			sizes[name] = p.total
+		}
+
+		j.mu.Lock()
+		j.status.DownloadSpeed = p.speed
+		j.status.Total, j.status.Downloaded = 0, 0
+		j.status.Files = make([]downloader.TaskFile, 0, len(files))
+		for i, f := range files {
+			downloaded := sizes[f]
+			if f == name {
+				downloaded = p.downloaded
+			}
+
+			progress := float64(0)
+			if sizes[f] > 0 {
+				progress = min(float64(downloaded)/float64(sizes[f]), 1)
+			}
+
+			j.status.Total += sizes[f]
+			j.status.Downloaded += downloaded
+			j.status.Files = append(j.status.Files, downloader.TaskFile{
+				Index:    i,
+				Name:     f,
+				Size:     sizes[f],
+				Progress: progress,
+				Selected: true,
+			})
+		}
+		j.mu.Unlock()
+	}
+
+	err := cmd.Wait()
+
+	j.mu.Lock()
+	j.status.DownloadSpeed = 0
+	if err != nil {
+		j.status.State = downloader.StatusError
+		j.status.ErrorMessage = fmt.Sprintf("yt-dlp exited with error: %s, output: %s", err, strings.Join(lastLines, "\n"))
+	} else if downloaded, scanErr := scanFiles(path); scanErr != nil {
+		j.status.State = downloader.StatusError
+		j.status.ErrorMessage = scanErr.Error()
+	} else {
+		j.status.State = downloader.StatusCompleted
+		j.status.Files = downloaded
+		j.status.Total = lo.SumBy(downloaded, func(f downloader.TaskFile) int64 { return f.Size })
+		j.status.Downloaded = j.status.Total
+		if len(downloaded) > 0 {
+			j.status.Name = downloaded[0].Name
+		}
+	}
+
+	content, _ := json.Marshal(j.status)
+	j.mu.Unlock()
+
+	if err := os.WriteFile(path+statusFileSuffix, content, 0600); err != nil {
+		c.l.Warning("Failed to save yt-dlp task status: %s", err)
+	}
+
+	// Status is loaded from file from now on, so that it survives restarts.
+	jobs.Delete(j.id)
+}
+
+func (c *ytdlpClient) tempPath(ctx context.Context, id string) string {
+	base := util.RelativePath(c.options.TempPath)
+	if c.options.TempPath == "" {
+		base = util.DataPath(c.settings.TempPath(ctx))
+	}
+
+	return filepath.Join(base, YtDlpTempFolder, id)
+}
+
+// buildArgs converts node and group options into yt-dlp arguments. Configuration files and --exec are
+// always disabled, so that settings cannot run arbitrary commands.
+func buildArgs(options map[string]interface{}) ([]string, error) {
+	args := []string{
+		"--ignore-config",
+		"--no-exec",
+		"--newline",
+		"--no-colors",
+		"--no-mtime",
+		"--output", outputTemplate,
+		"--progress-template",
+		"download:" + progressPrefix + " %(progress.downloaded_bytes)s %(progress.total_bytes)s %(progress.total_bytes_estimate)s %(progress.speed)s %(progress.filename)s",
+	}
+
+	format := ""
+	if preset, ok := options[OptionFormatPreset].(string); ok && preset != "" {
+		if format, ok = FormatPresets[preset]; !ok {
+			return nil, fmt.Errorf("unknown format preset %q", preset)
+		}
+	}
+	if raw, ok := options[OptionFormat].(string); ok && raw != "" {
+		format = raw
+	}
+	if format != "" {
+		args = append(args, "--format", format)
+	}
+
+	if playlist, _ := options[OptionPlaylist].(bool); playlist {
+		args = append(args, "--yes-playlist")
+	} else {
+		args = append(args, "--no-playlist")
+	}
+
+	for option, flag := range map[string]string{
+		OptionProxy:             "--proxy",
+		OptionLimitRate:         "--limit-rate",
+		OptionMergeOutputFormat: "--merge-output-format",
+	} {
+		if v, ok := options[option]; ok && fmt.Sprint(v) != "" {
+			args = append(args, flag, fmt.Sprint(v))
+		}
+	}
+
+	// Scheduled speed limit comes last to take precedence over limit_rate.
+	if limit := downloader.SpeedLimitOption(options); limit > 0 {
+		args = append(args, "--limit-rate", strconv.FormatInt(limit, 10))
+	}
+
+	return args, nil
+}
+
+// parseProgress parses a progress line printed with the progress template, unknown numbers are "NA".
+func parseProgress(line string) (*progress, bool) {
+	rest, ok := strings.CutPrefix(line, progressPrefix+" ")
+	if !ok {
+		return nil, false
+	}
+
+	fields := strings.SplitN(rest, " ", 5)
+	if len(fields) < 5 {
+		return nil, false
+	}
+
+	number := func(s string) int64 {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0
+		}
+		return int64(f)
+	}
+
+	p := &progress{
+		downloaded: number(fields[0]),
+		total:      number(fields[1]),
+		speed:      number(fields[3]),
+		filename:   fields[4],
+	}
+	if p.total == 0 {
+		p.total = number(fields[2])
+	}
+
+	return p, true
+}
+
+// scanFiles lists downloaded files in path, leftover intermediate files are skipped.
+func scanFiles(path string) ([]downloader.TaskFile, error) {
+	var files []downloader.TaskFile
+	err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || strings.HasSuffix(p, ".part") || strings.HasSuffix(p, ".ytdl") {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		name, _ := filepath.Rel(path, p)
+		files = append(files, downloader.TaskFile{
+			Index:    len(files),
+			Name:     filepath.ToSlash(name),
+			Size:     info.Size(),
+			Progress: 1,
+			Selected: true,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list downloaded files: %w", err)
+	}
+
+	if len(files) == 0 {
+		return nil, errors.New("yt-dlp exited without downloading any file")
+	}
+
+	return files, nil
+}
@@ -0,0 +1,102 @@
+package ytdlp
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeYtDlp prints a progress line and writes a file into the folder given by --paths.
+const fakeYtDlp = `#!/bin/sh
+while [ "$1" != "--paths" ]; do shift; done
+dir="$2"
+echo "[youtube] Extracting URL"
+echo "[cr-progress] 5 NA 10.0 2.5 $dir/video [abc].mp4"
+printf '0123456789' > "$dir/video [abc].mp4"
+`
+
+func TestParseProgress(t *testing.T) {
+	a := assert.New(t)
+
+	p, ok := parseProgress("[cr-progress] 1024 NA 4096.5 512.25 /tmp/ytdlp/a b.mp4")
+	a.True(ok)
+	a.EqualValues(1024, p.downloaded)
+	a.EqualValues(4096, p.total)
+	a.EqualValues(512, p.speed)
+	a.Equal("/tmp/ytdlp/a b.mp4", p.filename)
+
+	_, ok = parseProgress("[download] Destination: a.mp4")
+	a.False(ok)
+}
+
+func TestBuildArgs(t *testing.T) {
+	a := assert.New(t)
+
+	args, err := buildArgs(map[string]interface{}{OptionFormatPreset: "720p", OptionProxy: "socks5://127.0.0.1"})
+	a.NoError(err)
+	a.Contains(args, FormatPresets["720p"])
+	a.Contains(args, "--no-playlist")
+	a.Contains(args, "--no-exec")
+	a.Contains(args, "socks5://127.0.0.1")
+
+	args, err = buildArgs(map[string]interface{}{OptionFormatPreset: "720p", OptionFormat: "b", OptionPlaylist: true})
+	a.NoError(err)
+	a.NotContains(args, FormatPresets["720p"])
+	a.Contains(args, "--yes-playlist")
+
+	_, err = buildArgs(map[string]interface{}{OptionFormatPreset: "8k"})
+	a.Error(err)
+}
+
+func TestYtdlpClient_CreateTask(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell script is not supported")
+	}
+
+	a := assert.New(t)
+	dir := t.TempDir()
+	binary := filepath.Join(dir, "yt-dlp")
+	a.NoError(os.WriteFile(binary, []byte(fakeYtDlp), 0700))
+
+	client := New(logging.NewConsoleLogger(logging.LevelError), nil, &types.YtDlpSetting{
+		BinaryPath: binary,
+		TempPath:   dir,
+	})
+
+	_, err := client.CreateTask(context.Background(), "file:///etc/passwd", nil)
+	a.Error(err)
+
+	handle, err := client.CreateTask(context.Background(), "https://example.com/watch?v=abc", nil)
+	a.NoError(err)
+
+	var status *downloader.TaskStatus
+	a.Eventually(func() bool {
+		status, err = client.Info(context.Background(), handle)
+		return err == nil && status.State == downloader.StatusCompleted
+	}, 5*time.Second, 10*time.Millisecond)
+	a.EqualValues(10, status.Total)
+	a.Len(status.Files, 1)
+	a.Equal("video [abc].mp4", status.Files[0].Name)
+	a.True(status.Files[0].Selected)
+
+	// Status is kept once process exited.
+	a.Eventually(func() bool {
+		_, running := jobs.Load(handle.ID)
+		return !running
+	}, 5*time.Second, 10*time.Millisecond)
+	status, err = client.Info(context.Background(), handle)
+	a.NoError(err)
+	a.Equal(downloader.StatusCompleted, status.State)
+
+	a.NoError(client.Cancel(context.Background(), handle))
+	_, err = client.Info(context.Background(), handle)
+	a.ErrorIs(err, downloader.ErrTaskNotFount)
+}
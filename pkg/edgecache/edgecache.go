@@ -0,0 +1,348 @@
+// Package edgecache keeps a size-capped LRU on-disk cache of entities served by edge nodes.
+package edgecache
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+)
+
+const (
+	metaSuffix = ".meta"
+	tempSuffix = ".tmp"
+)
+
+var (
+	// ErrTooLarge is returned if the entity cannot fit into the cache.
+	ErrTooLarge = errors.New("entity is larger than edge cache capacity")
+	// ErrSizeMismatch is returned if the fetched content does not match the expected size.
+	ErrSizeMismatch = errors.New("fetched content size mismatch")
+)
+
+type (
+	// Cache is an on-disk cache of entity contents.
+	Cache interface {
+		// Open returns the path of the cached content of key. On cache miss, fetch is called to pull
+		// the content, which must be exactly size bytes.
+		Open(ctx context.Context, key string, size int64, fetch FetchFunc) (string, error)
+		// Usage returns the total size of cached contents in bytes.
+		Usage() int64
+	}
+
+	// FetchFunc pulls the content of a cache entry from its origin.
+	FetchFunc func(ctx context.Context) (io.ReadCloser, error)
+
+	diskCache struct {
+		dir      string
+		capacity int64
+		l        logging.Logger
+
+		mu      sync.Mutex
+		lru     *list.List
+		items   map[string]*list.Element
+		used    int64
+		pending map[string]*fetchCall
+	}
+
+	entry struct {
+		Key    string    `json:"key"`
+		Size   int64     `json:"size"`
+		Sha256 string    `json:"sha256"`
+		Cached time.Time `json:"cached"`
+
+		name     string
+		accessed time.Time
+	}
+
+	fetchCall struct {
+		done chan struct{}
+		path string
+		err  error
+	}
+)
+
+// New creates a disk cache in dir with capacity in bytes. Existing contents in dir are verified
+// against their recorded checksum, corrupted ones are removed.
+func New(dir string, capacity int64, l logging.Logger) (Cache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create edge cache folder: %w", err)
+	}
+
+	c := &diskCache{
+		dir:      dir,
+		capacity: capacity,
+		l:        l,
+		lru:      list.New(),
+		items:    make(map[string]*list.Element),
+		pending:  make(map[string]*fetchCall),
+	}
+
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *diskCache) Usage() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.used
+}
+
+func (c *diskCache) Open(ctx context.Context, key string, size int64, fetch FetchFunc) (string, error) {
+	if size > c.capacity {
+		return "", ErrTooLarge
+	}
+
+	name := entryName(key)
+	for {
+		c.mu.Lock()
+		if elem, ok := c.items[name]; ok {
+			e := elem.Value.(*entry)
+			if e.Size == size && c.intact(e) {
+				now := time.Now()
+				c.lru.MoveToFront(elem)
+				e.accessed = now
+				c.mu.Unlock()
+
+				// Access time is kept in mtime to restore LRU order on restart.
+				_ = os.Chtimes(c.dataPath(name), now, now)
+				return c.dataPath(name), nil
+			}
+
+			// Stale or corrupted content, fetch again.
+			c.l.Debug("Edge cache entry %q is invalid, fetching again.", key)
+			c.removeLocked(elem)
+		}
+
+		if call, ok := c.pending[name]; ok {
+			c.mu.Unlock()
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-call.done:
+			}
+
+			if call.err != nil {
+				return "", call.err
+			}
+			continue
+		}
+
+		call := &fetchCall{done: make(chan struct{})}
+		c.pending[name] = call
+		c.mu.Unlock()
+
+		call.path, call.err = c.fill(ctx, key, name, size, fetch)
+
+		c.mu.Lock()
+		delete(c.pending, name)
+		c.mu.Unlock()
+		close(call.done)
+		return call.path, call.err
+	}
+}
+
+// fill fetches the content into cache and records its checksum.
+func (c *diskCache) fill(ctx context.Context, key, name string, size int64, fetch FetchFunc) (string, error) {
+	content, err := fetch(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch content: %w", err)
+	}
+	defer content.Close()
+
+	tempPath := c.dataPath(name) + tempSuffix
+	out, err := os.Create(tempPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+
+	hash := sha256.New()
+	written, err := io.Copy(io.MultiWriter(out, hash), io.LimitReader(content, size+1))
+	out.Close()
+	if err == nil && written != size {
+		err = fmt.Errorf("%w: expected %d, got %d", ErrSizeMismatch, size, written)
+	}
+	if err != nil {
+		_ = os.Remove(tempPath)
+		return "", fmt.Errorf("failed to cache content: %w", err)
+	}
+
+	now := time.Now()
+	e := &entry{
+		Key:      key,
+		Size:     size,
+		Sha256:   hex.EncodeToString(hash.Sum(nil)),
+		Cached:   now,
+		name:     name,
+		accessed: now,
+	}
+	meta, err := json.Marshal(e)
+	if err == nil {
+		err = os.WriteFile(c.metaPath(name), meta, 0600)
+	}
+	if err == nil {
+		err = os.Rename(tempPath, c.dataPath(name))
+	}
+	if err != nil {
+		_ = os.Remove(tempPath)
+		_ = os.Remove(c.metaPath(name))
+		return "", fmt.Errorf("failed to save cache entry: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[name] = c.lru.PushFront(e)
+	c.used += size
+	c.evictLocked()
+	return c.dataPath(name), nil
+}
+
+// load rebuilds the index from cache folder, verifying the checksum of each entry.
+func (c *diskCache) load() error {
+	files, err := os.ReadDir(c.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read edge cache folder: %w", err)
+	}
+
+	entries := make([]*entry, 0, len(files))
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+
+		if strings.HasSuffix(f.Name(), tempSuffix) {
+			_ = os.Remove(filepath.Join(c.dir, f.Name()))
+			continue
+		}
+
+		if !strings.HasSuffix(f.Name(), metaSuffix) {
+			continue
+		}
+
+		name := strings.TrimSuffix(f.Name(), metaSuffix)
+		e, err := c.verify(name)
+		if err != nil {
+			c.l.Warning("Removing corrupted edge cache entry %q: %s", name, err)
+			_ = os.Remove(c.dataPath(name))
+			_ = os.Remove(c.metaPath(name))
+			continue
+		}
+
+		entries = append(entries, e)
+	}
+
+	// Most recently accessed entries go to front.
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].accessed.After(entries[j].accessed)
+	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range entries {
+		c.items[e.name] = c.lru.PushBack(e)
+		c.used += e.Size
+	}
+	c.evictLocked()
+
+	c.l.Info("Edge cache loaded with %d entries, %d bytes in use.", len(entries), c.used)
+	return nil
+}
+
+func (c *diskCache) verify(name string) (*entry, error) {
+	meta, err := os.ReadFile(c.metaPath(name))
+	if err != nil {
+		return nil, err
+	}
+
+	e := &entry{name: name}
+	if err := json.Unmarshal(meta, e); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(c.dataPath(name))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Size() != e.Size {
+		return nil, ErrSizeMismatch
+	}
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return nil, err
+	}
+
+	if hex.EncodeToString(hash.Sum(nil)) != e.Sha256 {
+		return nil, errors.New("checksum mismatch")
+	}
+
+	e.accessed = info.ModTime()
+	return e, nil
+}
+
+// intact checks whether the content of the entry is still in place with its recorded size.
+func (c *diskCache) intact(e *entry) bool {
+	info, err := os.Stat(c.dataPath(e.name))
+	return err == nil && info.Size() == e.Size
+}
+
+func (c *diskCache) evictLocked() {
+	for c.used > c.capacity {
+		oldest := c.lru.Back()
+		if oldest == nil {
+			return
+		}
+
+		c.l.Debug("Evicting edge cache entry %q.", oldest.Value.(*entry).Key)
+		c.removeLocked(oldest)
+	}
+}
+
+func (c *diskCache) removeLocked(elem *list.Element) {
+	e := elem.Value.(*entry)
+	c.lru.Remove(elem)
+	delete(c.items, e.name)
+	c.used -= e.Size
+
+	if err := os.Remove(c.dataPath(e.name)); err != nil && !os.IsNotExist(err) {
+		c.l.Warning("Failed to remove edge cache entry %q: %s", e.Key, err)
+	}
+	_ = os.Remove(c.metaPath(e.name))
+}
+
+func (c *diskCache) dataPath(name string) string {
+	return filepath.Join(c.dir, name)
+}
+
+func (c *diskCache) metaPath(name string) string {
+	return filepath.Join(c.dir, name+metaSuffix)
+}
+
+// entryName returns the file name of a cache key.
+func entryName(key string) string {
+	sum := sha1.Sum([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,125 @@
+package edgecache
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func fetchString(content string, calls *int32) FetchFunc {
+	return func(ctx context.Context) (io.ReadCloser, error) {
+		atomic.AddInt32(calls, 1)
+		return io.NopCloser(strings.NewReader(content)), nil
+	}
+}
+
+func TestOpen(t *testing.T) {
+	a := assert.New(t)
+	c, err := New(t.TempDir(), 10, logging.NewConsoleLogger(logging.LevelError))
+	a.NoError(err)
+
+	var calls int32
+	path, err := c.Open(context.Background(), "1", 5, fetchString("hello", &calls))
+	a.NoError(err)
+	content, _ := os.ReadFile(path)
+	a.Equal("hello", string(content))
+
+	// Served from cache
+	_, err = c.Open(context.Background(), "1", 5, fetchString("hello", &calls))
+	a.NoError(err)
+	a.EqualValues(1, calls)
+	a.EqualValues(5, c.Usage())
+
+	_, err = c.Open(context.Background(), "2", 11, fetchString("hello world", &calls))
+	a.ErrorIs(err, ErrTooLarge)
+
+	_, err = c.Open(context.Background(), "3", 4, fetchString("hello", &calls))
+	a.ErrorIs(err, ErrSizeMismatch)
+	a.EqualValues(5, c.Usage())
+}
+
+func TestEviction(t *testing.T) {
+	a := assert.New(t)
+	c, err := New(t.TempDir(), 10, logging.NewConsoleLogger(logging.LevelError))
+	a.NoError(err)
+
+	var calls int32
+	ctx := context.Background()
+	_, err = c.Open(ctx, "1", 4, fetchString("aaaa", &calls))
+	a.NoError(err)
+	_, err = c.Open(ctx, "2", 4, fetchString("bbbb", &calls))
+	a.NoError(err)
+	// Touch 1 so that 2 is the least recently used.
+	_, err = c.Open(ctx, "1", 4, fetchString("aaaa", &calls))
+	a.NoError(err)
+	_, err = c.Open(ctx, "3", 4, fetchString("cccc", &calls))
+	a.NoError(err)
+	a.EqualValues(8, c.Usage())
+	a.EqualValues(3, calls)
+
+	_, err = c.Open(ctx, "1", 4, fetchString("aaaa", &calls))
+	a.NoError(err)
+	a.EqualValues(3, calls)
+	_, err = c.Open(ctx, "2", 4, fetchString("bbbb", &calls))
+	a.NoError(err)
+	a.EqualValues(4, calls)
+}
+
+func TestLoadVerifiesChecksum(t *testing.T) {
+	a := assert.New(t)
+	dir := t.TempDir()
+	l := logging.NewConsoleLogger(logging.LevelError)
+	c, err := New(dir, 10, l)
+	a.NoError(err)
+
+	var calls int32
+	ctx := context.Background()
+	_, err = c.Open(ctx, "1", 4, fetchString("aaaa", &calls))
+	a.NoError(err)
+	path, err := c.Open(ctx, "2", 4, fetchString("bbbb", &calls))
+	a.NoError(err)
+
+	// Corrupt entry 2 without changing its size
+	a.NoError(os.WriteFile(path, []byte("xxxx"), 0600))
+
+	c, err = New(dir, 10, l)
+	a.NoError(err)
+	a.EqualValues(4, c.Usage())
+
+	_, err = c.Open(ctx, "1", 4, fetchString("aaaa", &calls))
+	a.NoError(err)
+	a.EqualValues(2, calls)
+	path, err = c.Open(ctx, "2", 4, fetchString("bbbb", &calls))
+	a.NoError(err)
+	a.EqualValues(3, calls)
+	content, _ := os.ReadFile(path)
+	a.Equal("bbbb", string(content))
+}
+
+func TestConcurrentFetch(t *testing.T) {
+	a := assert.New(t)
+	c, err := New(t.TempDir(), 10, logging.NewConsoleLogger(logging.LevelError))
+	a.NoError(err)
+
+	var (
+		calls int32
+		wg    sync.WaitGroup
+	)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.Open(context.Background(), "1", 5, fetchString("hello", &calls))
+			a.NoError(err)
+		}()
+	}
+	wg.Wait()
+	a.EqualValues(1, calls)
+}
@@ -145,7 +145,7 @@ func (client *SMTPPool) Init() {
 					open = true
 				}
 
-				l := client.l.CopyWithPrefix(fmt.Sprintf("[Cid: %s]", m.cid))
+				l := client.l.CopyWithComponent("email").CopyWithField(logging.FieldCorrelationID, m.cid)
 				if err := mail.Send(s, m.msg); err != nil {
 					l.Warning("Failed to send email: %s, Cid=%s", err, m.cid)
 				} else {
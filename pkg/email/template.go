@@ -6,6 +6,7 @@ import (
 	"html/template"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
@@ -87,6 +88,338 @@ func NewActivationEmail(ctx context.Context, settings setting.Provider, user *en
 	return fmt.Sprintf("[%s] %s", activationCtx.SiteBasic.Name, selected.Title), res.String(), nil
 }
 
+// LoginLockedContext used for variables in login locked email
+type LoginLockedContext struct {
+	*CommonContext
+	User        *ent.User
+	Url         string
+	IP          string
+	LockedUntil string
+}
+
+// NewLoginLockedEmail generates account lockout notification email from template
+func NewLoginLockedEmail(ctx context.Context, settings setting.Provider, user *ent.User, ip string, lockedUntil time.Time) (string, string, error) {
+	templates := settings.LoginLockedEmailTemplate(ctx)
+	if len(templates) == 0 {
+		return "", "", fmt.Errorf("login locked email template not configured")
+	}
+
+	selected := selectTemplate(templates, user)
+	lockedCtx := LoginLockedContext{
+		CommonContext: commonContext(ctx, settings),
+		User:          user,
+		IP:            ip,
+		LockedUntil:   lockedUntil.UTC().Format(time.RFC1123),
+	}
+	lockedCtx.Url = lockedCtx.SiteUrl
+
+	tmpl, err := template.New("login_locked").Parse(selected.Body)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse email template: %w", err)
+	}
+
+	var res strings.Builder
+	err = tmpl.Execute(&res, lockedCtx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to execute email template: %w", err)
+	}
+
+	return fmt.Sprintf("[%s] %s", lockedCtx.SiteBasic.Name, selected.Title), res.String(), nil
+}
+
+// ImpersonationContext used for variables in impersonation notification email
+type ImpersonationContext struct {
+	*CommonContext
+	User      *ent.User
+	Admin     *ent.User
+	Url       string
+	IP        string
+	ExpiresAt string
+}
+
+// NewImpersonationEmail generates admin impersonation notification email from template
+func NewImpersonationEmail(ctx context.Context, settings setting.Provider, user, admin *ent.User, ip string, expires time.Time) (string, string, error) {
+	templates := settings.ImpersonationEmailTemplate(ctx)
+	if len(templates) == 0 {
+		return "", "", fmt.Errorf("impersonation email template not configured")
+	}
+
+	selected := selectTemplate(templates, user)
+	impersonationCtx := ImpersonationContext{
+		CommonContext: commonContext(ctx, settings),
+		User:          user,
+		Admin:         admin,
+		IP:            ip,
+		ExpiresAt:     expires.UTC().Format(time.RFC1123),
+	}
+	impersonationCtx.Url = impersonationCtx.SiteUrl
+
+	tmpl, err := template.New("impersonation").Parse(selected.Body)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse email template: %w", err)
+	}
+
+	var res strings.Builder
+	err = tmpl.Execute(&res, impersonationCtx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to execute email template: %w", err)
+	}
+
+	return fmt.Sprintf("[%s] %s", impersonationCtx.SiteBasic.Name, selected.Title), res.String(), nil
+}
+
+// EmailChangeContext used for variables in email change emails
+type EmailChangeContext struct {
+	*CommonContext
+	User     *ent.User
+	Url      string
+	NewEmail string
+	VetoUrl  string
+}
+
+// NewEmailChangeEmail generates email for confirming the new email address from template
+func NewEmailChangeEmail(ctx context.Context, settings setting.Provider, user *ent.User, newEmail, confirmUrl string) (string, string, error) {
+	templates := settings.EmailChangeEmailTemplate(ctx)
+	if len(templates) == 0 {
+		return "", "", fmt.Errorf("email change template not configured")
+	}
+
+	return renderEmailChange(ctx, settings, user, templates, &EmailChangeContext{
+		NewEmail: newEmail,
+		Url:      confirmUrl,
+	})
+}
+
+// NewEmailChangeNotifyEmail generates email notifying the old address about a pending email change from
+// template. vetoUrl is empty if veto is disabled.
+func NewEmailChangeNotifyEmail(ctx context.Context, settings setting.Provider, user *ent.User, newEmail, vetoUrl string) (string, string, error) {
+	templates := settings.EmailChangeNotifyEmailTemplate(ctx)
+	if len(templates) == 0 {
+		return "", "", fmt.Errorf("email change notify template not configured")
+	}
+
+	return renderEmailChange(ctx, settings, user, templates, &EmailChangeContext{
+		NewEmail: newEmail,
+		Url:      vetoUrl,
+		VetoUrl:  vetoUrl,
+	})
+}
+
+// NewEmailAliasEmail generates email for confirming a secondary email address from template
+func NewEmailAliasEmail(ctx context.Context, settings setting.Provider, user *ent.User, alias, confirmUrl string) (string, string, error) {
+	templates := settings.EmailAliasEmailTemplate(ctx)
+	if len(templates) == 0 {
+		return "", "", fmt.Errorf("email alias template not configured")
+	}
+
+	return renderEmailChange(ctx, settings, user, templates, &EmailChangeContext{
+		NewEmail: alias,
+		Url:      confirmUrl,
+	})
+}
+
+func renderEmailChange(ctx context.Context, settings setting.Provider, user *ent.User, templates []setting.EmailTemplate,
+	changeCtx *EmailChangeContext) (string, string, error) {
+	selected := selectTemplate(templates, user)
+	changeCtx.CommonContext = commonContext(ctx, settings)
+	changeCtx.User = user
+	if changeCtx.Url == "" {
+		changeCtx.Url = changeCtx.SiteUrl
+	}
+
+	tmpl, err := template.New("email_change").Parse(selected.Body)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse email template: %w", err)
+	}
+
+	var res strings.Builder
+	err = tmpl.Execute(&res, changeCtx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to execute email template: %w", err)
+	}
+
+	return fmt.Sprintf("[%s] %s", changeCtx.SiteBasic.Name, selected.Title), res.String(), nil
+}
+
+// QuotaAlertContext used for variables in quota alert email
+type QuotaAlertContext struct {
+	*CommonContext
+	User    *ent.User
+	Url     string
+	Percent int
+	Used    string
+	Total   string
+}
+
+// NewQuotaAlertEmail generates storage quota alert email from template
+func NewQuotaAlertEmail(ctx context.Context, settings setting.Provider, user *ent.User, percent int, used, total int64) (string, string, error) {
+	templates := settings.QuotaAlertEmailTemplate(ctx)
+	if len(templates) == 0 {
+		return "", "", fmt.Errorf("quota alert email template not configured")
+	}
+
+	selected := selectTemplate(templates, user)
+	alertCtx := QuotaAlertContext{
+		CommonContext: commonContext(ctx, settings),
+		User:          user,
+		Percent:       percent,
+		Used:          formatSize(used),
+		Total:         formatSize(total),
+	}
+	alertCtx.Url = alertCtx.SiteUrl
+
+	tmpl, err := template.New("quota_alert").Parse(selected.Body)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse email template: %w", err)
+	}
+
+	var res strings.Builder
+	err = tmpl.Execute(&res, alertCtx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to execute email template: %w", err)
+	}
+
+	return fmt.Sprintf("[%s] %s", alertCtx.SiteBasic.Name, selected.Title), res.String(), nil
+}
+
+// GroupExpirationContext used for variables in group expiration email
+type GroupExpirationContext struct {
+	*CommonContext
+	User          *ent.User
+	Url           string
+	Group         string
+	FallbackGroup string
+	ExpiresAt     string
+}
+
+// NewGroupExpirationEmail generates email notifying upcoming expiration of group membership from template
+func NewGroupExpirationEmail(ctx context.Context, settings setting.Provider, user *ent.User, group, fallback string,
+	expiresAt time.Time) (string, string, error) {
+	templates := settings.GroupExpirationEmailTemplate(ctx)
+	if len(templates) == 0 {
+		return "", "", fmt.Errorf("group expiration email template not configured")
+	}
+
+	selected := selectTemplate(templates, user)
+	expirationCtx := GroupExpirationContext{
+		CommonContext: commonContext(ctx, settings),
+		User:          user,
+		Group:         group,
+		FallbackGroup: fallback,
+		ExpiresAt:     expiresAt.UTC().Format(time.RFC1123),
+	}
+	expirationCtx.Url = expirationCtx.SiteUrl
+
+	tmpl, err := template.New("group_expiration").Parse(selected.Body)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse email template: %w", err)
+	}
+
+	var res strings.Builder
+	err = tmpl.Execute(&res, expirationCtx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to execute email template: %w", err)
+	}
+
+	return fmt.Sprintf("[%s] %s", expirationCtx.SiteBasic.Name, selected.Title), res.String(), nil
+}
+
+// QuotaDigestUser is a user listed in quota digest email
+type QuotaDigestUser struct {
+	Email   string
+	Percent int
+}
+
+// QuotaDigestContext used for variables in quota digest email
+type QuotaDigestContext struct {
+	*CommonContext
+	Url   string
+	Users []QuotaDigestUser
+}
+
+// NewQuotaDigestEmail generates admin digest email of users over quota from template
+func NewQuotaDigestEmail(ctx context.Context, settings setting.Provider, admin *ent.User, users []QuotaDigestUser) (string, string, error) {
+	templates := settings.QuotaDigestEmailTemplate(ctx)
+	if len(templates) == 0 {
+		return "", "", fmt.Errorf("quota digest email template not configured")
+	}
+
+	selected := selectTemplate(templates, admin)
+	digestCtx := QuotaDigestContext{
+		CommonContext: commonContext(ctx, settings),
+		Users:         users,
+	}
+	digestCtx.Url = digestCtx.SiteUrl
+
+	tmpl, err := template.New("quota_digest").Parse(selected.Body)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse email template: %w", err)
+	}
+
+	var res strings.Builder
+	err = tmpl.Execute(&res, digestCtx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to execute email template: %w", err)
+	}
+
+	return fmt.Sprintf("[%s] %s", digestCtx.SiteBasic.Name, selected.Title), res.String(), nil
+}
+
+// NodeStatusContext used for variables in node status change email
+type NodeStatusContext struct {
+	*CommonContext
+	Url      string
+	Node     string
+	Online   bool
+	LastSeen string
+}
+
+// NewNodeStatusEmail generates node status change alert email from template
+func NewNodeStatusEmail(ctx context.Context, settings setting.Provider, admin *ent.User, node string, online bool, lastSeen time.Time) (string, string, error) {
+	templates := settings.NodeStatusEmailTemplate(ctx)
+	if len(templates) == 0 {
+		return "", "", fmt.Errorf("node status email template not configured")
+	}
+
+	selected := selectTemplate(templates, admin)
+	statusCtx := NodeStatusContext{
+		CommonContext: commonContext(ctx, settings),
+		Node:          node,
+		Online:        online,
+		LastSeen:      lastSeen.UTC().Format(time.RFC1123),
+	}
+	statusCtx.Url = statusCtx.SiteUrl
+
+	tmpl, err := template.New("node_status").Parse(selected.Body)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse email template: %w", err)
+	}
+
+	var res strings.Builder
+	err = tmpl.Execute(&res, statusCtx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to execute email template: %w", err)
+	}
+
+	return fmt.Sprintf("[%s] %s", statusCtx.SiteBasic.Name, selected.Title), res.String(), nil
+}
+
+func formatSize(size int64) string {
+	units := []string{"B", "KB", "MB", "GB", "TB", "PB"}
+	value := float64(size)
+	i := 0
+	for value >= 1024 && i < len(units)-1 {
+		value /= 1024
+		i++
+	}
+
+	if i == 0 {
+		return fmt.Sprintf("%d %s", size, units[i])
+	}
+
+	return fmt.Sprintf("%.2f %s", value, units[i])
+}
+
 func commonContext(ctx context.Context, settings setting.Provider) *CommonContext {
 	logo := settings.Logo(ctx)
 	siteUrl := settings.SiteURL(ctx)
@@ -0,0 +1,185 @@
+// Package eventbus publishes normalized file, user and share events to external message brokers, so that
+// other systems can consume Cloudreve activity without polling the API. Events of a user are also delivered to
+// in-process subscribers through Hub.
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	// Source is the source field of published events.
+	Source = "cloudreve"
+
+	publishTimeout = 10 * time.Second
+)
+
+type (
+	// Event is the normalized envelope published to brokers.
+	Event struct {
+		ID            string    `json:"id"`
+		Type          string    `json:"type"`
+		Source        string    `json:"source"`
+		CreatedAt     time.Time `json:"created_at"`
+		CorrelationID string    `json:"correlation_id,omitempty"`
+		Data          any       `json:"data"`
+	}
+
+	// Bus publishes events to a message broker.
+	Bus interface {
+		// Publish queues the event to be published in background, events are dropped if the buffer is full.
+		Publish(ctx context.Context, event string, data any)
+		// Close publishes buffered events and releases the broker connection.
+		Close() error
+	}
+
+	// driver sends a single encoded event to the broker.
+	driver interface {
+		send(ctx context.Context, subject string, e *Event, body []byte) error
+		close() error
+	}
+)
+
+// NewBus creates a Bus from config, a no-op bus is returned if event bus is disabled.
+func NewBus(config *conf.EventBus, l logging.Logger) (Bus, error) {
+	var (
+		d   driver
+		err error
+	)
+	switch config.Type {
+	case "":
+		return NewNoopBus(), nil
+	case "redis":
+		d, err = newRedisDriver(config)
+	case "nats":
+		d, err = newNatsDriver(config, l)
+	default:
+		return nil, fmt.Errorf("unknown event bus type %q", config.Type)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize %s event bus: %w", config.Type, err)
+	}
+
+	return newBus(d, config.Prefix, config.BufferSize, l), nil
+}
+
+// Subject returns the Redis stream key or NATS subject of given event type.
+func Subject(prefix, event string) string {
+	return prefix + "." + event
+}
+
+func newEvent(ctx context.Context, event string, data any) *Event {
+	e := &Event{
+		ID:        uuid.Must(uuid.NewV4()).String(),
+		Type:      event,
+		Source:    Source,
+		CreatedAt: time.Now(),
+		Data:      data,
+	}
+	if cid := logging.CorrelationID(ctx); cid != uuid.Nil {
+		e.CorrelationID = cid.String()
+	}
+
+	return e
+}
+
+type envelope struct {
+	subject string
+	event   *Event
+	body    []byte
+}
+
+type bus struct {
+	d      driver
+	prefix string
+	l      logging.Logger
+	events chan *envelope
+
+	mu     sync.RWMutex
+	closed bool
+	done   chan struct{}
+}
+
+func newBus(d driver, prefix string, bufferSize int, l logging.Logger) *bus {
+	b := &bus{
+		d:      d,
+		prefix: prefix,
+		l:      l,
+		events: make(chan *envelope, bufferSize),
+		done:   make(chan struct{}),
+	}
+
+	go b.run()
+	return b
+}
+
+func (b *bus) Publish(ctx context.Context, event string, data any) {
+	e := newEvent(ctx, event, data)
+
+	// Encoded now, data may be changed by caller once it returns.
+	body, err := json.Marshal(e)
+	if err != nil {
+		b.l.Warning("Failed to marshal event %q: %s", event, err)
+		return
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return
+	}
+
+	select {
+	case b.events <- &envelope{subject: Subject(b.prefix, event), event: e, body: body}:
+	default:
+		b.l.Warning("Event bus buffer is full, event %q is dropped.", event)
+	}
+}
+
+func (b *bus) run() {
+	defer close(b.done)
+	for env := range b.events {
+		ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+		if err := b.d.send(ctx, env.subject, env.event, env.body); err != nil {
+			b.l.Warning("Failed to publish event %q to %q: %s", env.event.Type, env.subject, err)
+		}
+		cancel()
+	}
+}
+
+func (b *bus) Close() error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+
+	b.closed = true
+	close(b.events)
+	b.mu.Unlock()
+
+	<-b.done
+	return b.d.close()
+}
+
+// NewNoopBus creates a Bus that drops all events.
+func NewNoopBus() Bus {
+	return &noopBus{}
+}
+
+type noopBus struct{}
+
+func (n *noopBus) Publish(ctx context.Context, event string, data any) {}
+
+func (n *noopBus) Close() error {
+	return nil
+}
@@ -0,0 +1,166 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+type sent struct {
+	subject string
+	body    []byte
+}
+
+type fakeDriver struct {
+	mu     sync.Mutex
+	sent   []sent
+	closed bool
+	// block holds send until closed.
+	block chan struct{}
+}
+
+func (d *fakeDriver) send(ctx context.Context, subject string, e *Event, body []byte) error {
+	if d.block != nil {
+		<-d.block
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.sent = append(d.sent, sent{subject: subject, body: body})
+	return nil
+}
+
+func (d *fakeDriver) close() error {
+	d.closed = true
+	return nil
+}
+
+func TestBus_Publish(t *testing.T) {
+	a := assert.New(t)
+	d := &fakeDriver{}
+	b := newBus(d, "cloudreve", 10, logging.NewConsoleLogger(logging.LevelError))
+
+	b.Publish(context.Background(), "file.uploaded", map[string]string{"name": "a.txt"})
+	b.Publish(context.Background(), "user.registered", nil)
+	a.NoError(b.Close())
+	a.True(d.closed)
+
+	// Events published after close are dropped.
+	b.Publish(context.Background(), "file.deleted", nil)
+	a.NoError(b.Close())
+
+	a.Len(d.sent, 2)
+	a.Equal("cloudreve.file.uploaded", d.sent[0].subject)
+	a.Equal("cloudreve.user.registered", d.sent[1].subject)
+
+	var e Event
+	a.NoError(json.Unmarshal(d.sent[0].body, &e))
+	a.Equal("file.uploaded", e.Type)
+	a.Equal(Source, e.Source)
+	a.NotEmpty(e.ID)
+	a.Equal(map[string]any{"name": "a.txt"}, e.Data)
+}
+
+func TestBus_BufferFull(t *testing.T) {
+	a := assert.New(t)
+	d := &fakeDriver{block: make(chan struct{})}
+	b := newBus(d, "cloudreve", 1, logging.NewConsoleLogger(logging.LevelError))
+
+	for i := 0; i < 5; i++ {
+		b.Publish(context.Background(), "file.uploaded", i)
+	}
+
+	close(d.block)
+	a.NoError(b.Close())
+	// One event held by the worker, one in buffer, others are dropped.
+	a.LessOrEqual(len(d.sent), 2)
+	a.GreaterOrEqual(len(d.sent), 1)
+}
+
+func TestNewBus(t *testing.T) {
+	a := assert.New(t)
+	l := logging.NewConsoleLogger(logging.LevelError)
+
+	b, err := NewBus(&conf.EventBus{}, l)
+	a.NoError(err)
+	a.IsType(&noopBus{}, b)
+
+	_, err = NewBus(&conf.EventBus{Type: "kafka"}, l)
+	a.Error(err)
+}
+
+func TestHub(t *testing.T) {
+	a := assert.New(t)
+	h := NewHub()
+
+	s1, err := h.Subscribe(1)
+	a.NoError(err)
+	s2, err := h.Subscribe(2)
+	a.NoError(err)
+
+	h.Publish(context.Background(), 1, EventFileCreated, "a", "cloudreve://my/a")
+	e := <-s1.C
+	a.Equal(EventFileCreated, e.Type)
+	a.Equal([]string{"cloudreve://my/a"}, e.Uris)
+	a.Len(s2.C, 0, "events of other users are not delivered")
+
+	for i := 0; i < subscriptionBufferSize+1; i++ {
+		h.Publish(context.Background(), 1, EventFileCreated, i)
+	}
+	a.True(s1.Dropped())
+	a.False(s1.Dropped())
+
+	s1.Close()
+	s1.Close()
+	for range s1.C {
+	}
+	h.Publish(context.Background(), 1, EventFileCreated, "b")
+
+	for i := 0; i < MaxSubscriptionsPerUser-1; i++ {
+		_, err := h.Subscribe(2)
+		a.NoError(err)
+	}
+	_, err = h.Subscribe(2)
+	a.ErrorIs(err, ErrTooManySubscriptions)
+}
+
+func TestHub_Resume(t *testing.T) {
+	a := assert.New(t)
+	h := NewHub()
+
+	h.Publish(context.Background(), 1, EventFileCreated, "before subscribed")
+	s, err := h.Subscribe(1)
+	a.NoError(err)
+	h.Publish(context.Background(), 1, EventFileCreated, "a")
+	last := <-s.C
+	s.Close()
+
+	h.Publish(context.Background(), 1, EventFileCreated, "b")
+	h.Publish(context.Background(), 1, EventFileCreated, "c")
+
+	s, missed, found, err := h.Resume(1, last.ID)
+	a.NoError(err)
+	a.True(found)
+	a.Len(missed, 2)
+	a.Equal("b", missed[0].Data)
+	a.Equal("c", missed[1].Data)
+	s.Close()
+
+	s, missed, found, err = h.Resume(1, "unknown")
+	a.NoError(err)
+	a.False(found)
+	a.Empty(missed)
+	s.Close()
+
+	for i := 0; i < historySize+1; i++ {
+		h.Publish(context.Background(), 1, EventFileCreated, i)
+	}
+	_, _, found, err = h.Resume(1, last.ID)
+	a.NoError(err)
+	a.False(found, "event out of history size")
+}
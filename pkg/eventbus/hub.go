@@ -0,0 +1,233 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Event types only delivered to in-process subscribers through Hub.
+const (
+	EventFileCreated         = "file.created"
+	EventFileMoved           = "file.moved"
+	EventTaskProgress        = "task.progress"
+	EventTaskFinished        = "task.finished"
+	EventNotificationCreated = "notification.created"
+)
+
+const (
+	// MaxSubscriptionsPerUser limits concurrent subscriptions, e.g. open WebSocket connections, of one user.
+	MaxSubscriptionsPerUser = 16
+	subscriptionBufferSize  = 64
+	// historySize and historyTTL bound the events kept for each user to resume subscriptions.
+	historySize = 256
+	historyTTL  = 5 * time.Minute
+)
+
+var ErrTooManySubscriptions = errors.New("too many subscriptions")
+
+type (
+	// Hub delivers events of a user to in-process subscribers such as WebSocket connections. Unlike Bus,
+	// events are kept in memory, only subscribers connected to current instance receive them.
+	Hub interface {
+		// Publish delivers the event to all subscriptions of given user. uris are Cloudreve URIs affected by
+		// the event, used by subscribers for filtering.
+		Publish(ctx context.Context, uid int, event string, data any, uris ...string)
+		// Subscribe starts receiving events of given user, the subscription must be closed once not needed.
+		Subscribe(uid int) (*Subscription, error)
+		// Resume subscribes like Subscribe, and returns events published after the one of lastEventID. found
+		// is false if the event is no longer kept in history, events may have been missed since then.
+		Resume(uid int, lastEventID string) (sub *Subscription, missed []*UserEvent, found bool, err error)
+	}
+
+	// UserEvent is an event delivered to subscriptions of a user.
+	UserEvent struct {
+		*Event
+		Uris []string `json:"-"`
+	}
+
+	// Subscription receives events of a user from C. Events are dropped if C is not drained in time,
+	// Dropped reports whether it happened since last call.
+	Subscription struct {
+		C <-chan *UserEvent
+
+		c       chan *UserEvent
+		uid     int
+		hub     *hub
+		mu      sync.Mutex
+		dropped bool
+	}
+
+	// TaskData is the data of task.progress and task.finished events.
+	TaskData struct {
+		ID     string `json:"id"`
+		Type   string `json:"type"`
+		Status string `json:"status"`
+		// Progress is the per-phase progress of a running task.
+		Progress any `json:"progress,omitempty"`
+	}
+
+	// MoveData is the data of file.moved event.
+	MoveData struct {
+		Uris    []string `json:"uris"`
+		Dst     string   `json:"dst"`
+		Copy    bool     `json:"copy"`
+		ActorID string   `json:"actor_id,omitempty"`
+	}
+
+	// NotificationData is the data of notification.created event.
+	NotificationData struct {
+		ID        int               `json:"id"`
+		Type      string            `json:"type"`
+		Link      string            `json:"link,omitempty"`
+		Props     map[string]string `json:"props,omitempty"`
+		CreatedAt time.Time         `json:"created_at"`
+	}
+
+	hub struct {
+		mu      sync.Mutex
+		subs    map[int]map[*Subscription]struct{}
+		history map[int]*userHistory
+	}
+
+	// userHistory holds recent events of a user to resume subscriptions.
+	userHistory struct {
+		events []*UserEvent
+		// seen is the last time the user had a subscription.
+		seen time.Time
+	}
+)
+
+// NewHub creates an in-process Hub.
+func NewHub() Hub {
+	return &hub{subs: make(map[int]map[*Subscription]struct{}), history: make(map[int]*userHistory)}
+}
+
+func (h *hub) Publish(ctx context.Context, uid int, event string, data any, uris ...string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// History is only kept for users subscribed recently, who may resume later.
+	subs := h.subs[uid]
+	history, recent := h.history[uid]
+	if len(subs) == 0 && !recent {
+		return
+	}
+
+	e := NewUserEvent(ctx, event, data, uris...)
+	if recent {
+		if len(history.events) >= historySize {
+			history.events = append(history.events[:0:0], history.events[len(history.events)-historySize+1:]...)
+		}
+		history.events = append(history.events, e)
+	}
+
+	for s := range subs {
+		select {
+		case s.c <- e:
+		default:
+			s.mu.Lock()
+			s.dropped = true
+			s.mu.Unlock()
+		}
+	}
+}
+
+// NewUserEvent creates an event to be delivered to a subscription.
+func NewUserEvent(ctx context.Context, event string, data any, uris ...string) *UserEvent {
+	return &UserEvent{Event: newEvent(ctx, event, data), Uris: uris}
+}
+
+func (h *hub) Subscribe(uid int) (*Subscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.subscribe(uid)
+}
+
+func (h *hub) Resume(uid int, lastEventID string) (*Subscription, []*UserEvent, bool, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s, err := h.subscribe(uid)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	events := h.history[uid].events
+	for i, e := range events {
+		if e.ID == lastEventID {
+			return s, append([]*UserEvent(nil), events[i+1:]...), true, nil
+		}
+	}
+
+	return s, nil, false, nil
+}
+
+// subscribe creates a subscription, h.mu must be held.
+func (h *hub) subscribe(uid int) (*Subscription, error) {
+	h.pruneHistory()
+	if len(h.subs[uid]) >= MaxSubscriptionsPerUser {
+		return nil, ErrTooManySubscriptions
+	}
+
+	c := make(chan *UserEvent, subscriptionBufferSize)
+	s := &Subscription{C: c, c: c, uid: uid, hub: h}
+	if h.subs[uid] == nil {
+		h.subs[uid] = make(map[*Subscription]struct{})
+	}
+	h.subs[uid][s] = struct{}{}
+	if _, ok := h.history[uid]; !ok {
+		h.history[uid] = &userHistory{}
+	}
+	h.history[uid].seen = time.Now()
+	return s, nil
+}
+
+// pruneHistory drops expired events, and history of users who have left, h.mu must be held.
+func (h *hub) pruneHistory() {
+	expire := time.Now().Add(-historyTTL)
+	for uid, history := range h.history {
+		if len(h.subs[uid]) == 0 && history.seen.Before(expire) {
+			delete(h.history, uid)
+			continue
+		}
+
+		i := 0
+		for i < len(history.events) && history.events[i].CreatedAt.Before(expire) {
+			i++
+		}
+		history.events = history.events[i:]
+	}
+}
+
+// Dropped returns true if any event is dropped since last call.
+func (s *Subscription) Dropped() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dropped := s.dropped
+	s.dropped = false
+	return dropped
+}
+
+// Close stops receiving events, C is closed afterward.
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	subs, ok := s.hub.subs[s.uid]
+	if _, subscribed := subs[s]; !ok || !subscribed {
+		return
+	}
+
+	delete(subs, s)
+	if len(subs) == 0 {
+		delete(s.hub.subs, s.uid)
+		if history, ok := s.hub.history[s.uid]; ok {
+			history.seen = time.Now()
+		}
+	}
+	close(s.c)
+}
@@ -0,0 +1,49 @@
+package eventbus
+
+import (
+	"context"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/nats-io/nats.go"
+)
+
+// natsDriver publishes events to NATS subjects.
+type natsDriver struct {
+	conn *nats.Conn
+}
+
+func newNatsDriver(config *conf.EventBus, l logging.Logger) (driver, error) {
+	opts := []nats.Option{
+		nats.Name(Source),
+		// Events are buffered by client while the server is unavailable.
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(-1),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				l.Warning("Disconnected from NATS server: %s", err)
+			}
+		}),
+	}
+	if config.User != "" {
+		opts = append(opts, nats.UserInfo(config.User, config.Password))
+	}
+
+	conn, err := nats.Connect(config.Server, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &natsDriver{conn: conn}, nil
+}
+
+func (d *natsDriver) send(ctx context.Context, subject string, e *Event, body []byte) error {
+	msg := nats.NewMsg(subject)
+	msg.Header.Set(nats.MsgIdHdr, e.ID)
+	msg.Data = body
+	return d.conn.PublishMsg(msg)
+}
+
+func (d *natsDriver) close() error {
+	return d.conn.Drain()
+}
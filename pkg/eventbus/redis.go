@@ -0,0 +1,65 @@
+package eventbus
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/gomodule/redigo/redis"
+)
+
+// redisDriver appends events to Redis Streams, one stream per event type.
+type redisDriver struct {
+	pool   *redis.Pool
+	maxLen int
+}
+
+func newRedisDriver(config *conf.EventBus) (driver, error) {
+	db, err := strconv.Atoi(config.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	return &redisDriver{
+		maxLen: config.MaxLen,
+		pool: &redis.Pool{
+			MaxIdle:     1,
+			IdleTimeout: 240 * time.Second,
+			TestOnBorrow: func(c redis.Conn, t time.Time) error {
+				_, err := c.Do("PING")
+				return err
+			},
+			Dial: func() (redis.Conn, error) {
+				return redis.Dial(
+					config.Network,
+					config.Server,
+					redis.DialDatabase(db),
+					redis.DialPassword(config.Password),
+					redis.DialUsername(config.User),
+				)
+			},
+		},
+	}, nil
+}
+
+func (d *redisDriver) send(ctx context.Context, subject string, e *Event, body []byte) error {
+	rc, err := d.pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	args := redis.Args{subject}
+	if d.maxLen > 0 {
+		args = args.Add("MAXLEN", "~", d.maxLen)
+	}
+
+	args = args.Add("*", "id", e.ID, "type", e.Type, "payload", body)
+	_, err = redis.DoContext(rc, ctx, "XADD", args...)
+	return err
+}
+
+func (d *redisDriver) close() error {
+	return d.pool.Close()
+}
@@ -3,6 +3,7 @@ package driver
 import (
 	"context"
 	"encoding/gob"
+	"io"
 	"os"
 	"time"
 
@@ -86,6 +87,16 @@ type (
 		MediaMeta(ctx context.Context, path, ext string) ([]MediaMeta, error)
 	}
 
+	// RangeWriter is implemented by handlers that can overwrite a byte range of a stored file in place.
+	RangeWriter interface {
+		// RangeWritable reports whether stored files can be overwritten in place under current configuration.
+		RangeWritable() bool
+		// WriteRange writes content of r into the file at path starting from offset, the file is extended
+		// if r exceeds its end. offset must not be greater than the file size. Number of bytes written is
+		// returned, also on errors.
+		WriteRange(ctx context.Context, path string, offset int64, r io.Reader) (int64, error)
+	}
+
 	Capabilities struct {
 		StaticFeatures *boolset.BooleanSet
 		// MaxSourceExpire indicates the maximum allowed expiration duration of a source URL
@@ -117,6 +128,7 @@ const (
 	MetaTypeExif        MetaType = "exif"
 	MediaTypeMusic      MetaType = "music"
 	MetaTypeStreamMedia MetaType = "stream"
+	MetaTypeModel       MetaType = "model"
 )
 
 type ForceUsePublicEndpointCtx struct{}
@@ -188,6 +188,43 @@ func (handler *Driver) Put(ctx context.Context, file *fs.UploadRequest) error {
 	return err
 }
 
+// RangeWritable reports whether files can be overwritten in place. Encrypted files are not, rewriting
+// a range with the same keystream would reveal the difference between old and new content.
+func (handler *Driver) RangeWritable() bool {
+	return len(conf.DecodedFileEncryptionKey) == 0
+}
+
+// WriteRange overwrites content of the file at path starting from offset.
+func (handler *Driver) WriteRange(ctx context.Context, path string, offset int64, r io.Reader) (int64, error) {
+	if !handler.RangeWritable() {
+		return 0, errors.New("in place write is not supported for encrypted files")
+	}
+
+	dst := util.RelativePath(filepath.FromSlash(path))
+	out, err := os.OpenFile(dst, os.O_WRONLY, Perm)
+	if err != nil {
+		handler.l.Warning("Failed to open file: %s", err)
+		return 0, err
+	}
+	defer out.Close()
+
+	stat, err := out.Stat()
+	if err != nil {
+		handler.l.Warning("Failed to read file info: %s", err)
+		return 0, err
+	}
+
+	if stat.Size() < offset {
+		return 0, fmt.Errorf("offset %d exceeds file size %d", offset, stat.Size())
+	}
+
+	if _, err := out.Seek(offset, io.SeekStart); err != nil {
+		return 0, fmt.Errorf("failed to seek to desired offset %d: %s", offset, err)
+	}
+
+	return io.Copy(out, r)
+}
+
 // Delete 删除一个或多个文件，
 // 返回未删除的文件，及遇到的最后一个错误
 func (handler *Driver) Delete(ctx context.Context, files ...string) ([]string, error) {
@@ -9,12 +9,15 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/rpc"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/chunk/backoff"
@@ -60,6 +63,15 @@ func NewClient(ctx context.Context, policy *ent.StoragePolicy, settings setting.
 		return nil, fmt.Errorf("remote storage policy %d has no node", policy.ID)
 	}
 
+	if err := cluster.CheckNodeSecurity(policy.Edges.Node, settings.ClusterSecurity(ctx).SecureMode); err != nil {
+		return nil, fmt.Errorf("node of remote storage policy %d is rejected: %w", policy.ID, err)
+	}
+
+	transport, err := cluster.NodeTransport(policy.Edges.Node)
+	if err != nil {
+		return nil, err
+	}
+
 	authInstance := auth.HMACAuth{[]byte(policy.Edges.Node.SlaveKey)}
 	serverURL, err := url.Parse(policy.Edges.Node.Server)
 	if err != nil {
@@ -68,8 +80,26 @@ func NewClient(ctx context.Context, policy *ent.StoragePolicy, settings setting.
 
 	base, _ := url.Parse(constants.APIPrefixSlave)
 
+	var rpcClient *rpc.Client
+	if nodeSettings := policy.Edges.Node.Settings; nodeSettings != nil && nodeSettings.GRPCServer != "" {
+		rpcClient, err = rpc.GetClient(&rpc.Options{
+			Addr:        nodeSettings.GRPCServer,
+			Auth:        authInstance,
+			SignTTL:     int64(settings.SlaveRequestSignTTL(ctx)),
+			NodeID:      policy.Edges.Node.ID,
+			SiteID:      settings.SiteBasic(ctx).ID,
+			SiteURL:     settings.SiteURL(setting.UseFirstSiteUrl(ctx)).String(),
+			SiteVersion: constants.BackendVersion,
+			TLS:         nodeSettings.TLS,
+		})
+		if err != nil {
+			l.Warning("Failed to create gRPC client for node %q, HTTP API will be used: %s", policy.Edges.Node.Name, err)
+		}
+	}
+
 	return &remoteClient{
 		policy:       policy,
+		rpc:          rpcClient,
 		authInstance: authInstance,
 		httpClient: request.NewClient(
 			config,
@@ -78,6 +108,7 @@ func NewClient(ctx context.Context, policy *ent.StoragePolicy, settings setting.
 			request.WithSlaveMeta(policy.Edges.Node.ID),
 			request.WithMasterMeta(settings.SiteBasic(ctx).ID, settings.SiteURL(setting.UseFirstSiteUrl(ctx)).String()),
 			request.WithCorrelationID(),
+			request.WithTransport(transport),
 		),
 		settings: settings,
 		l:        l,
@@ -90,6 +121,11 @@ type remoteClient struct {
 	httpClient   request.Client
 	settings     setting.Provider
 	l            logging.Logger
+	// rpc streams chunks to slave if gRPC address of the node is configured.
+	rpc *rpc.Client
+	// rpcUnavailable is set once the gRPC service is found unreachable, remaining chunks are
+	// uploaded with HTTP API.
+	rpcUnavailable atomic.Bool
 }
 
 func (c *remoteClient) Upload(ctx context.Context, file *fs.UploadRequest) error {
@@ -270,6 +306,17 @@ func (c *remoteClient) GetUploadURL(ctx context.Context, expires time.Time, sess
 }
 
 func (c *remoteClient) uploadChunk(ctx context.Context, sessionID string, index int, chunk io.Reader, overwrite bool, size int64) error {
+	if c.rpc != nil && !c.rpcUnavailable.Load() {
+		err := c.rpc.UploadChunk(ctx, sessionID, index, overwrite, chunk, size)
+		if rpc.IsUnavailable(err) {
+			// Part of the chunk might be consumed, let chunk retry to upload it again with HTTP API.
+			c.rpcUnavailable.Store(true)
+			c.l.Warning("gRPC service of node is unavailable, fallback to HTTP API: %s", err)
+		}
+
+		return err
+	}
+
 	resp, err := c.httpClient.Request(
 		"POST",
 		fmt.Sprintf("upload/%s?chunk=%d", sessionID, index),
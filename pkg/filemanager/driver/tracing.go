@@ -0,0 +1,114 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedHandler wraps a storage driver to create a span for each driver call.
+type tracedHandler struct {
+	Handler
+	policyType string
+}
+
+// WithTracing returns a handler that records a span for each call of given storage driver.
+func WithTracing(h Handler, policyType string) Handler {
+	return &tracedHandler{Handler: h, policyType: policyType}
+}
+
+func (h *tracedHandler) start(ctx context.Context, op string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "driver."+op, trace.WithAttributes(
+		append(attrs, attribute.String("cloudreve.policy_type", h.policyType))...,
+	))
+}
+
+func (h *tracedHandler) Put(ctx context.Context, file *fs.UploadRequest) (err error) {
+	ctx, span := h.start(ctx, "Put",
+		attribute.String("cloudreve.save_path", file.Props.SavePath),
+		attribute.Int64("cloudreve.size", file.Props.Size),
+	)
+	defer func() { tracing.End(span, err) }()
+	return h.Handler.Put(ctx, file)
+}
+
+func (h *tracedHandler) Delete(ctx context.Context, files ...string) (failed []string, err error) {
+	ctx, span := h.start(ctx, "Delete", attribute.Int("cloudreve.files", len(files)))
+	defer func() { tracing.End(span, err) }()
+	return h.Handler.Delete(ctx, files...)
+}
+
+func (h *tracedHandler) Open(ctx context.Context, path string) (f *os.File, err error) {
+	ctx, span := h.start(ctx, "Open", attribute.String("cloudreve.save_path", path))
+	defer func() { tracing.End(span, err) }()
+	return h.Handler.Open(ctx, path)
+}
+
+func (h *tracedHandler) Thumb(ctx context.Context, expire *time.Time, ext string, e fs.Entity) (url string, err error) {
+	ctx, span := h.start(ctx, "Thumb", attribute.String("cloudreve.save_path", e.Source()))
+	defer func() { tracing.End(span, err) }()
+	return h.Handler.Thumb(ctx, expire, ext, e)
+}
+
+func (h *tracedHandler) Source(ctx context.Context, e fs.Entity, args *GetSourceArgs) (url string, err error) {
+	ctx, span := h.start(ctx, "Source", attribute.String("cloudreve.save_path", e.Source()))
+	defer func() { tracing.End(span, err) }()
+	return h.Handler.Source(ctx, e, args)
+}
+
+func (h *tracedHandler) Token(ctx context.Context, uploadSession *fs.UploadSession, file *fs.UploadRequest) (credential *fs.UploadCredential, err error) {
+	ctx, span := h.start(ctx, "Token", attribute.String("cloudreve.save_path", file.Props.SavePath))
+	defer func() { tracing.End(span, err) }()
+	return h.Handler.Token(ctx, uploadSession, file)
+}
+
+func (h *tracedHandler) CancelToken(ctx context.Context, uploadSession *fs.UploadSession) (err error) {
+	ctx, span := h.start(ctx, "CancelToken")
+	defer func() { tracing.End(span, err) }()
+	return h.Handler.CancelToken(ctx, uploadSession)
+}
+
+func (h *tracedHandler) CompleteUpload(ctx context.Context, session *fs.UploadSession) (err error) {
+	ctx, span := h.start(ctx, "CompleteUpload")
+	defer func() { tracing.End(span, err) }()
+	return h.Handler.CompleteUpload(ctx, session)
+}
+
+func (h *tracedHandler) List(ctx context.Context, base string, onProgress ListProgressFunc, recursive bool) (objects []fs.PhysicalObject, err error) {
+	ctx, span := h.start(ctx, "List", attribute.String("cloudreve.base", base))
+	defer func() { tracing.End(span, err) }()
+	return h.Handler.List(ctx, base, onProgress, recursive)
+}
+
+func (h *tracedHandler) MediaMeta(ctx context.Context, path, ext string) (meta []MediaMeta, err error) {
+	ctx, span := h.start(ctx, "MediaMeta", attribute.String("cloudreve.save_path", path))
+	defer func() { tracing.End(span, err) }()
+	return h.Handler.MediaMeta(ctx, path, ext)
+}
+
+// RangeWritable reports whether the wrapped driver can overwrite files in place.
+func (h *tracedHandler) RangeWritable() bool {
+	w, ok := h.Handler.(RangeWriter)
+	return ok && w.RangeWritable()
+}
+
+func (h *tracedHandler) WriteRange(ctx context.Context, path string, offset int64, r io.Reader) (written int64, err error) {
+	w, ok := h.Handler.(RangeWriter)
+	if !ok {
+		return 0, errors.New("in place write is not supported by storage driver")
+	}
+
+	ctx, span := h.start(ctx, "WriteRange",
+		attribute.String("cloudreve.save_path", path),
+		attribute.Int64("cloudreve.offset", offset),
+	)
+	defer func() { tracing.End(span, err) }()
+	return w.WriteRange(ctx, path, offset, r)
+}
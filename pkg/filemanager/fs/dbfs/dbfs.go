@@ -16,6 +16,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/lock"
@@ -45,7 +46,8 @@ type (
 func NewDatabaseFS(u *ent.User, fileClient inventory.FileClient, shareClient inventory.ShareClient,
 	l logging.Logger, ls lock.LockSystem, settingClient setting.Provider,
 	storagePolicyClient inventory.StoragePolicyClient, hasher hashid.Encoder, userClient inventory.UserClient,
-	cache, stateKv cache.Driver, directLinkClient inventory.DirectLinkClient) fs.FileSystem {
+	cache, stateKv cache.Driver, directLinkClient inventory.DirectLinkClient, checkoutClient inventory.CheckoutClient,
+	recorder audit.Recorder) fs.FileSystem {
 	return &DBFS{
 		user:                u,
 		navigators:          make(map[string]Navigator),
@@ -60,6 +62,8 @@ func NewDatabaseFS(u *ent.User, fileClient inventory.FileClient, shareClient inv
 		cache:               cache,
 		stateKv:             stateKv,
 		directLinkClient:    directLinkClient,
+		checkoutClient:      checkoutClient,
+		recorder:            recorder,
 	}
 }
 
@@ -71,12 +75,14 @@ type DBFS struct {
 	storagePolicyClient inventory.StoragePolicyClient
 	shareClient         inventory.ShareClient
 	directLinkClient    inventory.DirectLinkClient
+	checkoutClient      inventory.CheckoutClient
 	l                   logging.Logger
 	ls                  lock.LockSystem
 	settingClient       setting.Provider
 	hasher              hashid.Encoder
 	cache               cache.Driver
 	stateKv             cache.Driver
+	recorder            audit.Recorder
 	mu                  sync.Mutex
 }
 
@@ -86,6 +92,58 @@ func (f *DBFS) Recycle() {
 	}
 }
 
+func (f *DBFS) FollowTx(ctx context.Context) (func(), error) {
+	if _, ok := ctx.Value(inventory.TxCtx{}).(*inventory.Tx); !ok {
+		return nil, fmt.Errorf("dbfs: no inherited transaction found in context")
+	}
+
+	fileClient, _, _, err := inventory.WithTx(ctx, f.fileClient)
+	if err != nil {
+		return nil, err
+	}
+	userClient, _, _, err := inventory.WithTx(ctx, f.userClient)
+	if err != nil {
+		return nil, err
+	}
+	shareClient, _, _, err := inventory.WithTx(ctx, f.shareClient)
+	if err != nil {
+		return nil, err
+	}
+	storagePolicyClient, _, _, err := inventory.WithTx(ctx, f.storagePolicyClient)
+	if err != nil {
+		return nil, err
+	}
+	directLinkClient, _, _, err := inventory.WithTx(ctx, f.directLinkClient)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	oldFileClient, oldUserClient, oldShareClient := f.fileClient, f.userClient, f.shareClient
+	oldStoragePolicyClient, oldDirectLinkClient := f.storagePolicyClient, f.directLinkClient
+	oldNavigators := f.navigators
+
+	// Navigators hold DB clients, new ones are created on demand with clients in transaction.
+	f.fileClient, f.userClient, f.shareClient = fileClient, userClient, shareClient
+	f.storagePolicyClient, f.directLinkClient = storagePolicyClient, directLinkClient
+	f.navigators = make(map[string]Navigator)
+
+	return func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		for _, navigator := range f.navigators {
+			navigator.Recycle()
+		}
+
+		f.fileClient, f.userClient, f.shareClient = oldFileClient, oldUserClient, oldShareClient
+		f.storagePolicyClient, f.directLinkClient = oldStoragePolicyClient, oldDirectLinkClient
+		f.navigators = oldNavigators
+	}, nil
+}
+
 func (f *DBFS) GetEntity(ctx context.Context, entityID int) (fs.Entity, error) {
 	if entityID == 0 {
 		return fs.NewEmptyEntity(f.user), nil
@@ -117,6 +175,9 @@ func (f *DBFS) List(ctx context.Context, path *fs.URI, opts ...fs.Option) (fs.Fi
 	}
 
 	searchParams := path.SearchParameters()
+	if searchParams != nil && o.searchParams != nil {
+		searchParams = o.searchParams
+	}
 	isSearching := searchParams != nil
 
 	// Validate pagination args
@@ -140,7 +201,7 @@ func (f *DBFS) List(ctx context.Context, path *fs.URI, opts ...fs.Option) (fs.Fi
 		ctx = context.WithValue(ctx, inventory.LoadFilePublicMetadata{}, true)
 	}
 	if o.loadFileShareIfOwned && parent != nil && parent.OwnerID() == f.user.ID {
-		ctx = context.WithValue(ctx, inventory.LoadFileShare{}, true)
+		ctx = context.WithValue(ctx, inventory.LoadFileShareID{}, true)
 	}
 
 	var streamCallback func([]*File)
@@ -197,13 +258,12 @@ func (f *DBFS) Capacity(ctx context.Context, u *ent.User) (*fs.Capacity, error)
 		res = &fs.Capacity{}
 	)
 
-	requesterGroup, err := u.Edges.GroupOrErr()
-	if err != nil {
+	if _, err := u.Edges.GroupOrErr(); err != nil {
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get user's group", err)
 	}
 
 	res.Used = f.user.Storage
-	res.Total = requesterGroup.MaxStorage
+	res.Total = inventory.EffectiveMaxStorage(u)
 	return res, nil
 }
 
@@ -686,17 +746,17 @@ func (f *DBFS) createFile(ctx context.Context, parent *File, name string, fileTy
 
 // getPreferredPolicy tries to get the preferred storage policy for the given file.
 func (f *DBFS) getPreferredPolicy(ctx context.Context, file *File) (*ent.StoragePolicy, error) {
-	ownerGroup := file.Owner().Edges.Group
-	if ownerGroup == nil {
+	owner := file.Owner()
+	if owner.Edges.Group == nil {
 		return nil, fmt.Errorf("owner group not loaded")
 	}
 
-	groupPolicy, err := f.storagePolicyClient.GetByGroup(ctx, ownerGroup)
+	policy, err := f.storagePolicyClient.GetByUser(ctx, owner)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get available storage policies", err)
 	}
 
-	return groupPolicy, nil
+	return policy, nil
 }
 
 func (f *DBFS) getFileByPath(ctx context.Context, navigator Navigator, path *fs.URI) (*File, error) {
@@ -54,10 +54,21 @@ const (
 	MetadataRestoreUri          = MetadataSysPrefix + "restore_uri"
 	MetadataExpectedCollectTime = MetadataSysPrefix + "expected_collect_time"
 	MetadataSharedOwner         = MetadataSysPrefix + "shared_owner"
+	MetadataAiTagged            = MetadataSysPrefix + "ai_tagged"
+	MetadataOcrText             = MetadataSysPrefix + "ocr_text"
+	MetadataLegalHold           = MetadataSysPrefix + "legal_hold"
+	MetadataLinkUrl             = MetadataSysPrefix + "link_url"
+	MetadataLinkFavicon         = MetadataSysPrefix + "link_favicon"
+	MetadataSha256              = MetadataSysPrefix + "sha256"
 
 	ThumbMetadataPrefix = "thumb:"
 	ThumbDisabledKey    = ThumbMetadataPrefix + "disabled"
 
+	TrashMetadataPrefix = "trash:"
+	// TrashRetentionKey overrides trash retention of owner's group in seconds, for files deleted from
+	// the folder or its descendants.
+	TrashRetentionKey = TrashMetadataPrefix + "retention"
+
 	pathIndexRoot = 0
 	pathIndexUser = 1
 )
@@ -0,0 +1,119 @@
+package dbfs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/samber/lo"
+)
+
+const (
+	LegalHoldOpDelete        = "delete"
+	LegalHoldOpTrash         = "trash"
+	LegalHoldOpOverwrite     = "overwrite"
+	LegalHoldOpDeleteVersion = "delete_version"
+)
+
+// checkLegalHold returns fs.ErrLegalHold if the operation on target is blocked by legal hold, regardless
+// of permissions of current user. Blocked attempts are recorded in audit log.
+func (f *DBFS) checkLegalHold(ctx context.Context, op string, target *File) error {
+	held, err := f.isLegalHeld(ctx, target)
+	if err != nil {
+		return fmt.Errorf("failed to check legal hold: %w", err)
+	}
+
+	if !held {
+		return nil
+	}
+
+	f.l.Info("Operation %q on %q is blocked by legal hold.", op, target.Uri(true))
+	if f.recorder != nil {
+		event := audit.NewEvent(ctx, audit.EventLegalHoldBlocked, target.OwnerID(), f.user.ID, map[string]string{
+			"operation": op,
+			"uri":       target.Uri(true).String(),
+		}).WithEntity(audit.EntityFile, target.ID())
+		record := func(ctx context.Context) {
+			if err := f.recorder.Record(ctx, event); err != nil {
+				f.l.Warning("Failed to record legal hold audit event: %s", err)
+			}
+		}
+
+		if afterTx, ok := ctx.Value(fs.AfterTxCtxKey{}).(func(func())); ok {
+			// Blocked operation rolls back the atomic transaction, record once it ends so that the record
+			// is not written inside the transaction and rolled back along with it.
+			afterTx(func() { record(context.WithoutCancel(ctx)) })
+		} else {
+			record(ctx)
+		}
+	}
+
+	return fs.ErrLegalHold
+}
+
+// isLegalHeld returns whether target is under legal hold, that is, its owner is on hold, target or one of
+// its ancestors is held, or target is a folder containing held files.
+func (f *DBFS) isLegalHeld(ctx context.Context, target *File) (bool, error) {
+	if owner := target.Owner(); owner != nil && owner.Settings != nil && owner.Settings.LegalHold != nil {
+		return true, nil
+	}
+
+	// Holds are rare, most users have no held files at all.
+	heldIDs, err := f.fileClient.ListIDsByMetadata(ctx, target.OwnerID(), MetadataLegalHold)
+	if err != nil || len(heldIDs) == 0 {
+		return false, err
+	}
+
+	held := lo.SliceToMap(heldIDs, func(id int) (int, bool) { return id, true })
+	chain, err := f.ancestorIDs(ctx, target.ID())
+	if err != nil {
+		return false, err
+	}
+
+	if lo.ContainsBy(chain, func(id int) bool { return held[id] }) {
+		return true, nil
+	}
+
+	if target.Type() != types.FileTypeFolder {
+		return false, nil
+	}
+
+	for _, id := range heldIDs {
+		chain, err := f.ancestorIDs(ctx, id)
+		if err != nil {
+			return false, err
+		}
+
+		if lo.Contains(chain, target.ID()) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ancestorIDs returns ID of given file followed by IDs of all its ancestors.
+func (f *DBFS) ancestorIDs(ctx context.Context, id int) ([]int, error) {
+	current, err := f.fileClient.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int{id}
+	for {
+		parent, err := f.fileClient.GetParentFile(ctx, current, false)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ids, nil
+			}
+
+			return nil, err
+		}
+
+		ids = append(ids, parent.ID)
+		current = parent
+	}
+}
@@ -9,13 +9,18 @@ import (
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/lock"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/samber/lo"
 )
 
+// checkoutLockDuration is the duration of the lock held while checking out a file.
+const checkoutLockDuration = time.Minute
+
 type (
 	LockSession struct {
 		Tokens     map[string]string
@@ -30,6 +35,8 @@ type (
 	}
 
 	AlwaysIncludeTokenCtx struct{}
+	// SharedLockCtx requests shared locks instead of exclusive ones.
+	SharedLockCtx struct{}
 )
 
 func (f *DBFS) ConfirmLock(ctx context.Context, ancestor fs.File, uri *fs.URI, token ...string) (func(), fs.LockSession, error) {
@@ -48,6 +55,11 @@ func (f *DBFS) ConfirmLock(ctx context.Context, ancestor fs.File, uri *fs.URI, t
 	}
 
 	release, tokenHit, err := f.ls.Confirm(time.Now(), lc)
+	if errors.Is(err, lock.ErrConfirmationFailed) {
+		// Token might be of a check-out, which is not in the lock system.
+		release = func() {}
+		tokenHit, err = f.confirmCheckout(ctx, lc)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -60,24 +72,64 @@ func (f *DBFS) ConfirmLock(ctx context.Context, ancestor fs.File, uri *fs.URI, t
 
 func (f *DBFS) Lock(ctx context.Context, d time.Duration, requester *ent.User, zeroDepth bool, application lock.Application,
 	uri *fs.URI, token string) (fs.LockSession, error) {
+	_, ls, err := f.lock(ctx, d, requester, zeroDepth, application, uri, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return ls, nil
+}
+
+// Checkout checks out the file until expireAt. Unlike other locks, check-outs are persisted in DB, so that they
+// are enforced by all masters and survive restarts.
+func (f *DBFS) Checkout(ctx context.Context, expireAt time.Time, requester *ent.User, zeroDepth bool, uri *fs.URI,
+	token string) (*lock.ActiveLock, error) {
+	// Hold a lock during check-out, so that it does not conflict with other locks and concurrent check-outs.
+	lr, ls, err := f.lock(ctx, checkoutLockDuration, requester, zeroDepth, fs.LockApp(fs.ApplicationCheckout), uri, "")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Release(ctx, ls) }()
+
+	ns, root, _ := lockTupleFromUri(lr.Uri, f.user, f.hasher)
+	checkout, err := f.checkoutClient.Create(ctx, &inventory.CheckoutArgs{
+		Token:     token,
+		Ns:        ns,
+		Path:      util.SlashClean(root),
+		FileType:  lr.Type,
+		ZeroDepth: zeroDepth,
+		ExpireAt:  expireAt,
+		OwnerID:   requester.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save check-out: %w", err)
+	}
+
+	checkout.Edges.Owner = requester
+	l := checkoutToActiveLock(checkout, f.hasher)
+	return &l, nil
+}
+
+func (f *DBFS) lock(ctx context.Context, d time.Duration, requester *ent.User, zeroDepth bool, application lock.Application,
+	uri *fs.URI, token string) (*LockByPath, *LockSession, error) {
 	// Get navigator
 	navigator, err := f.getNavigator(ctx, uri, NavigatorCapabilityLockFile)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	ancestor, err := f.getFileByPath(ctx, navigator, uri)
 	if err != nil && !ent.IsNotFound(err) {
-		return nil, fmt.Errorf("failed to get ancestor: %w", err)
+		return nil, nil, fmt.Errorf("failed to get ancestor: %w", err)
 	}
 
 	if ancestor.IsRootFolder() && ancestor.Uri(false).IsSame(uri, hashid.EncodeUserID(f.hasher, f.user.ID)) {
-		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("cannot lock root folder"))
+		return nil, nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("cannot lock root folder"))
 	}
 
 	// Lock require create or update permission
 	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && ancestor.Owner().ID != requester.ID {
-		return nil, fs.ErrOwnerOnly
+		return nil, nil, fs.ErrOwnerOnly
 	}
 
 	t := types.FileTypeFile
@@ -92,13 +144,32 @@ func (f *DBFS) Lock(ctx context.Context, d time.Duration, requester *ent.User, z
 	}
 	ls, err := f.acquireByPath(ctx, d, requester, zeroDepth, application, lr)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return ls, nil
+	return lr, ls, nil
 }
 
 func (f *DBFS) Unlock(ctx context.Context, tokens ...string) error {
+	checkouts, err := f.checkoutClient.ListByTokens(ctx, tokens...)
+	if err != nil {
+		return fmt.Errorf("failed to get check-outs: %w", err)
+	}
+
+	if len(checkouts) > 0 {
+		checkoutTokens := lo.Map(checkouts, func(item *ent.Checkout, index int) string {
+			return item.Token
+		})
+		if err := f.checkoutClient.Delete(ctx, checkoutTokens...); err != nil {
+			return fmt.Errorf("failed to delete check-outs: %w", err)
+		}
+
+		tokens = lo.Without(tokens, checkoutTokens...)
+		if len(tokens) == 0 {
+			return nil
+		}
+	}
+
 	return f.ls.Unlock(time.Now(), tokens...)
 }
 
@@ -106,9 +177,34 @@ func (f *DBFS) Refresh(ctx context.Context, d time.Duration, token string) (lock
 	return f.ls.Refresh(time.Now(), d, token)
 }
 
+func (f *DBFS) ListLocks(ctx context.Context) ([]lock.ActiveLock, error) {
+	if inventory.IsAnonymousUser(f.user) {
+		return nil, ErrLoginRequired
+	}
+
+	ns, _, _ := lockTupleFromUri(newMyUri(), f.user, f.hasher)
+	checkouts, err := f.checkoutClient.List(ctx, ns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list check-outs: %w", err)
+	}
+
+	return append(f.ls.List(time.Now(), ns), lo.Map(checkouts, func(item *ent.Checkout, index int) lock.ActiveLock {
+		return checkoutToActiveLock(item, f.hasher)
+	})...), nil
+}
+
+func (f *DBFS) FileLocks(ctx context.Context, file fs.File) []lock.ActiveLock {
+	ns, root, _ := lockTupleFromUri(file.RootUri().JoinRaw(file.Uri(false).PathTrimmed()), f.user, f.hasher)
+	root = util.SlashClean(root)
+	return lo.Filter(f.ls.List(time.Now(), ns), func(item lock.ActiveLock, index int) bool {
+		return util.SlashClean(item.Root) == root
+	})
+}
+
 func (f *DBFS) acquireByPath(ctx context.Context, duration time.Duration,
 	requester *ent.User, zeroDepth bool, application lock.Application, locks ...*LockByPath) (*LockSession, error) {
 	session := LockSessionFromCtx(ctx)
+	shared, _ := ctx.Value(SharedLockCtx{}).(bool)
 
 	// Prepare lock details for each file
 	lockDetails := make([]lock.LockDetails, 0, len(locks))
@@ -125,6 +221,7 @@ func (f *DBFS) acquireByPath(ctx context.Context, duration time.Duration,
 			Duration:  duration,
 			Type:      l.Type,
 			Token:     l.Token,
+			Shared:    shared,
 		}
 
 		// Skip if already locked in current session
@@ -137,7 +234,11 @@ func (f *DBFS) acquireByPath(ctx context.Context, duration time.Duration,
 	}
 
 	// Acquire lock
-	tokens, err := f.ls.Create(time.Now(), lockDetails...)
+	var tokens []string
+	err := f.checkoutConflicts(ctx, session, lockDetails)
+	if err == nil {
+		tokens, err = f.ls.Create(time.Now(), lockDetails...)
+	}
 	if len(tokens) > 0 {
 		for i, token := range tokens {
 			key := lockDetails[i].Key()
@@ -262,6 +363,86 @@ func (f *DBFS) ensureConsistency(ctx context.Context, files ...*File) error {
 	return nil
 }
 
+// checkoutConflicts returns conflicts of requested locks with check-outs, except those confirmed in session.
+func (f *DBFS) checkoutConflicts(ctx context.Context, session *LockSession, details []lock.LockDetails) error {
+	confirmed := lo.Values(session.Tokens)
+	for i, detail := range details {
+		checkouts, err := f.checkoutClient.Conflicts(ctx, detail.Ns, util.SlashClean(detail.Root), detail.ZeroDepth)
+		if err != nil {
+			return fmt.Errorf("failed to get check-outs: %w", err)
+		}
+
+		conflicts := lo.FilterMap(checkouts, func(item *ent.Checkout, index int) (*lock.ConflictDetail, bool) {
+			if lo.Contains(confirmed, item.Token) {
+				return nil, false
+			}
+
+			// Token is never returned, otherwise it could be used to bypass the check-out.
+			l := checkoutToActiveLock(item, f.hasher)
+			return &lock.ConflictDetail{
+				Path:     l.Root,
+				Owner:    l.Owner,
+				Index:    i,
+				Type:     l.Type,
+				ExpireAt: l.ExpireAt,
+			}, true
+		})
+		if len(conflicts) > 0 {
+			return lock.ConflictError(conflicts)
+		}
+	}
+
+	return nil
+}
+
+// confirmCheckout returns the first given token of an active check-out covering the requested resource. Only
+// the user who checks out the file can confirm the check-out.
+func (f *DBFS) confirmCheckout(ctx context.Context, request lock.LockInfo) (string, error) {
+	checkouts, err := f.checkoutClient.ListByTokens(ctx, request.Token...)
+	if err != nil {
+		return "", fmt.Errorf("failed to get check-outs: %w", err)
+	}
+
+	for _, token := range request.Token {
+		checkout, found := lo.Find(checkouts, func(item *ent.Checkout) bool {
+			return item.Token == token
+		})
+		if !found || checkout.Ns != request.Ns || checkout.OwnerID != f.user.ID {
+			continue
+		}
+
+		if checkout.Path == request.Root ||
+			(!checkout.ZeroDepth && (checkout.Path == "/" || strings.HasPrefix(request.Root, checkout.Path+"/"))) {
+			return token, nil
+		}
+	}
+
+	return "", lock.ErrConfirmationFailed
+}
+
+// checkoutToActiveLock converts a check-out to a lock owned by the user who checks out the file.
+func checkoutToActiveLock(checkout *ent.Checkout, hasher hashid.Encoder) lock.ActiveLock {
+	expireAt := checkout.ExpireAt
+	application := fs.LockApp(fs.ApplicationCheckout)
+	application.UserID = hashid.EncodeUserID(hasher, checkout.OwnerID)
+	if checkout.Edges.Owner != nil {
+		application.UserName = checkout.Edges.Owner.Nick
+	}
+
+	return lock.ActiveLock{
+		LockDetails: lock.LockDetails{
+			Root:      checkout.Path,
+			Ns:        checkout.Ns,
+			Duration:  time.Until(expireAt),
+			Owner:     lock.Owner{Application: application},
+			ZeroDepth: checkout.ZeroDepth,
+			Type:      types.FileType(checkout.FileType),
+			Token:     checkout.Token,
+		},
+		ExpireAt: &expireAt,
+	}
+}
+
 // LockSessionFromCtx retrieves lock session from context. If no lock session
 // found, a new empty lock session will be returned.
 func LockSessionFromCtx(ctx context.Context) *LockSession {
@@ -0,0 +1,76 @@
+package dbfs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	_ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/lock"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfirmCheckout_Owner(t *testing.T) {
+	a := assert.New(t)
+	drv, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	encoder, err := hashid.New("test-salt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	group := client.Group.Create().SetName("users").SetPermissions(&boolset.BooleanSet{}).SaveX(ctx)
+	userA := client.User.Create().SetEmail("a@cloudreve.org").SetNick("a").SetGroupUsers(group.ID).SaveX(ctx)
+	userB := client.User.Create().SetEmail("b@cloudreve.org").SetNick("b").SetGroupUsers(group.ID).SaveX(ctx)
+
+	checkoutClient := inventory.NewCheckoutClient(client)
+	_, err = checkoutClient.Create(ctx, &inventory.CheckoutArgs{
+		Token:     "token-a",
+		Ns:        "1/my",
+		Path:      "/doc.txt",
+		FileType:  types.FileTypeFile,
+		ZeroDepth: true,
+		ExpireAt:  time.Now().Add(time.Hour),
+		OwnerID:   userA.ID,
+	})
+	a.NoError(err)
+
+	fsOf := func(u *ent.User) *DBFS {
+		return &DBFS{user: u, checkoutClient: checkoutClient, hasher: encoder}
+	}
+	request := lock.LockInfo{Ns: "1/my", Root: "/doc.txt", Token: []string{"token-a"}}
+
+	// Owner of the check-out confirms it with the token
+	token, err := fsOf(userA).confirmCheckout(ctx, request)
+	a.NoError(err)
+	a.Equal("token-a", token)
+
+	// Other users presenting the token are rejected
+	_, err = fsOf(userB).confirmCheckout(ctx, request)
+	a.ErrorIs(err, lock.ErrConfirmationFailed)
+
+	// Token is not revealed in conflicts
+	err = fsOf(userB).checkoutConflicts(ctx, LockSessionFromCtx(ctx), []lock.LockDetails{{Ns: "1/my", Root: "/doc.txt", ZeroDepth: true}})
+	var conflicts lock.ConflictError
+	if a.True(errors.As(err, &conflicts)) && a.Len(conflicts, 1) {
+		a.Equal("/doc.txt", conflicts[0].Path)
+		a.Empty(conflicts[0].Token)
+	}
+}
@@ -100,6 +100,7 @@ func (f *DBFS) Create(ctx context.Context, path *fs.URI, fileType types.FileType
 					args.Metadata = o.Metadata
 				}
 				args.IsSymbolic = o.isSymbolicLink
+				args.ModifiedAt = o.ModifiedAt
 			}
 
 			// Create folder if it is not the last element or the target is a folder
@@ -239,6 +240,11 @@ func (f *DBFS) SoftDelete(ctx context.Context, path ...*fs.URI) error {
 			continue
 		}
 
+		if err := f.checkLegalHold(ctx, LegalHoldOpTrash, target); err != nil {
+			ae.Add(p.String(), err)
+			continue
+		}
+
 		targets = append(targets, target)
 	}
 
@@ -262,6 +268,12 @@ func (f *DBFS) SoftDelete(ctx context.Context, path ...*fs.URI) error {
 	}
 
 	for _, target := range targets {
+		retention, err := f.trashRetention(ctx, fc, target)
+		if err != nil {
+			_ = inventory.Rollback(tx)
+			return serializer.NewError(serializer.CodeDBError, "failed to get trash retention", err)
+		}
+
 		// Perform soft-delete
 		if err := fc.SoftDelete(ctx, target.Model); err != nil {
 			_ = inventory.Rollback(tx)
@@ -272,7 +284,7 @@ func (f *DBFS) SoftDelete(ctx context.Context, path ...*fs.URI) error {
 		if err := fc.UpsertMetadata(ctx, target.Model, map[string]string{
 			MetadataRestoreUri: target.Uri(true).String(),
 			MetadataExpectedCollectTime: strconv.FormatInt(
-				time.Now().Add(time.Duration(target.Owner().Edges.Group.Settings.TrashRetention)*time.Second).Unix(),
+				time.Now().Add(time.Duration(retention)*time.Second).Unix(),
 				10),
 		}, nil); err != nil {
 			_ = inventory.Rollback(tx)
@@ -331,6 +343,11 @@ func (f *DBFS) Delete(ctx context.Context, path []*fs.URI, opts ...fs.Option) ([
 			continue
 		}
 
+		if err := f.checkLegalHold(ctx, LegalHoldOpDelete, target); err != nil {
+			ae.Add(p.String(), err)
+			continue
+		}
+
 		if _, ok := fileNavGroup[navigator]; !ok {
 			fileNavGroup[navigator] = make([]*File, 0)
 		}
@@ -394,6 +411,12 @@ func (f *DBFS) VersionControl(ctx context.Context, path *fs.URI, versionId int,
 		return fs.ErrNotSupportedAction.WithError(fmt.Errorf("target must be a valid file"))
 	}
 
+	if delete {
+		if err := f.checkLegalHold(ctx, LegalHoldOpDeleteVersion, target); err != nil {
+			return err
+		}
+	}
+
 	// Lock file
 	ls, err := f.acquireByPath(ctx, -1, f.user, true, fs.LockApp(fs.ApplicationVersionControl),
 		&LockByPath{target.Uri(true), target, target.Type(), ""})
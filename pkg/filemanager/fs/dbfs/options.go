@@ -2,6 +2,7 @@ package dbfs
 
 import (
 	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 )
 
@@ -26,6 +27,7 @@ type dbfsOption struct {
 	streamListResponseCallback func(parent fs.File, file []fs.File)
 	ancestor                   *File
 	notRoot                    bool
+	searchParams               *inventory.SearchFileParameters
 }
 
 func newDbfsOption() *dbfsOption {
@@ -64,6 +66,14 @@ func WithNotRoot() fs.Option {
 	})
 }
 
+// WithSearchParameters overrides search parameters parsed from the listed URI, used when they are resolved with
+// settings or hash IDs.
+func WithSearchParameters(params *inventory.SearchFileParameters) fs.Option {
+	return optionFunc(func(o *dbfsOption) {
+		o.searchParams = params
+	})
+}
+
 // WithContextHint enables generating context hint for the list operation.
 func WithContextHint() fs.Option {
 	return optionFunc(func(o *dbfsOption) {
@@ -20,6 +20,7 @@ import (
 var (
 	ErrShareNotFound = serializer.NewError(serializer.CodeNotFound, "Shared file does not exist", nil)
 	ErrNotPurchased  = serializer.NewError(serializer.CodePurchaseRequired, "You need to purchased this share", nil)
+	ErrNotRecipient  = serializer.NewError(serializer.CodeNoPermissionErr, "You are not a recipient of this share", nil)
 )
 
 const (
@@ -111,6 +112,29 @@ func (n *shareNavigator) Recycle() {
 	}
 }
 
+// CheckShareRecipient returns an error if the share is limited to recipients and u is neither the owner
+// nor one of them. Owner of the share must be loaded.
+func CheckShareRecipient(ctx context.Context, shareClient inventory.ShareClient, share *ent.Share, u *ent.User) error {
+	if len(share.Recipients) == 0 || share.Edges.User.ID == u.ID {
+		return nil
+	}
+
+	if inventory.IsAnonymousUser(u) {
+		return ErrLoginRequired
+	}
+
+	ok, err := shareClient.IsRecipient(ctx, share, u)
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to check share recipients", err)
+	}
+
+	if !ok {
+		return ErrNotRecipient
+	}
+
+	return nil
+}
+
 func (n *shareNavigator) Root(ctx context.Context, path *fs.URI) (*File, error) {
 	ctx = context.WithValue(ctx, inventory.LoadShareUser{}, true)
 	ctx = context.WithValue(ctx, inventory.LoadUserGroup{}, true)
@@ -131,6 +155,10 @@ func (n *shareNavigator) Root(ctx context.Context, path *fs.URI) (*File, error)
 		return nil, ErrShareIncorrectPassword
 	}
 
+	if err := CheckShareRecipient(ctx, n.shareClient, share, n.user); err != nil {
+		return nil, err
+	}
+
 	// Share permission setting should overwrite root folder's permission
 	n.shareRoot = newFile(nil, share.Edges.File)
 
@@ -231,6 +259,19 @@ func (n *shareNavigator) Children(ctx context.Context, parent *File, args *ListA
 		}, nil
 	}
 
+	if args.Search != nil && (n.owner == nil || n.owner.ID != n.user.ID) {
+		// Search is scoped under the shared folder by walking from the parent. Text recognized from file
+		// content is private metadata, it is not matched for visitors other than the owner.
+		search := *args.Search
+		search.UseFullText = false
+		if search.CategoryFilter != nil {
+			category := *search.CategoryFilter
+			category.UseFullText = false
+			search.CategoryFilter = &category
+		}
+		args.Search = &search
+	}
+
 	return n.baseNavigator.children(ctx, parent, args)
 }
 
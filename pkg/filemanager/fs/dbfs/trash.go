@@ -0,0 +1,126 @@
+package dbfs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/samber/lo"
+)
+
+// trashRetention returns seconds that target is kept in trash bin. Retention of owner's group is overridden
+// by TrashRetentionKey metadata of the nearest one among target and its ancestors.
+func (f *DBFS) trashRetention(ctx context.Context, fc inventory.FileClient, target *File) (int, error) {
+	retention := target.Owner().Edges.Group.Settings.TrashRetention
+
+	// Overrides are rare, most users have no folder with overridden retention.
+	overridden, err := fc.ListIDsByMetadata(ctx, target.OwnerID(), TrashRetentionKey)
+	if err != nil || len(overridden) == 0 {
+		return retention, err
+	}
+
+	ids := lo.SliceToMap(overridden, func(id int) (int, bool) { return id, true })
+	ctx = context.WithValue(ctx, inventory.LoadFilePublicMetadata{}, true)
+	for current := target; current != nil; current = current.Parent {
+		if !ids[current.ID()] {
+			continue
+		}
+
+		model, err := fc.GetByID(ctx, current.ID())
+		if err != nil {
+			return retention, fmt.Errorf("failed to get folder with overridden trash retention: %w", err)
+		}
+
+		if value, err := strconv.Atoi(newFile(nil, model).Metadata()[TrashRetentionKey]); err == nil && value > 0 {
+			return value, nil
+		}
+	}
+
+	return retention, nil
+}
+
+func (f *DBFS) RestoreTree(ctx context.Context, root *fs.URI, dst *fs.URI) error {
+	if inventory.IsAnonymousUser(f.user) {
+		return ErrLoginRequired
+	}
+
+	uid := hashid.EncodeUserID(f.hasher, f.user.ID)
+	if root.FileSystem() != constants.FileSystemMy || root.ID(uid) != uid {
+		return fs.ErrNotSupportedAction.WithError(fmt.Errorf("only files deleted from my files can be restored"))
+	}
+
+	ids, err := f.fileClient.ListIDsByMetadata(ctx, f.user.ID, MetadataRestoreUri)
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to list files in trash bin", err)
+	}
+
+	type trashed struct {
+		name       string
+		restoreUri *fs.URI
+	}
+
+	var (
+		targets []trashed
+		files   []*ent.File
+	)
+	listCtx := context.WithValue(ctx, inventory.LoadFilePublicMetadata{}, true)
+	for page := 0; page >= 0 && len(ids) > 0; {
+		files, page, err = f.fileClient.GetByIDs(listCtx, ids, page)
+		if err != nil {
+			return serializer.NewError(serializer.CodeDBError, "Failed to get files in trash bin", err)
+		}
+
+		for _, model := range files {
+			// Only top level files in trash bin are marked with restore uri.
+			if model.FileChildren > 0 {
+				continue
+			}
+
+			restoreUri, err := fs.NewUriFromString(newFile(nil, model).Metadata()[MetadataRestoreUri])
+			if err != nil || !restoreUri.EqualOrIsDescendantOf(root, uid) {
+				continue
+			}
+
+			targets = append(targets, trashed{name: model.Name, restoreUri: restoreUri})
+		}
+	}
+
+	if len(targets) == 0 {
+		return fs.ErrPathNotExist.WithError(fmt.Errorf("no file in trash bin is deleted from %q", root))
+	}
+
+	// Folders are restored before their descendants deleted separately.
+	sort.SliceStable(targets, func(i, j int) bool {
+		return len(targets[i].restoreUri.Elements()) < len(targets[j].restoreUri.Elements())
+	})
+
+	ae := serializer.NewAggregateError()
+	for _, target := range targets {
+		parent := target.restoreUri.DirUri()
+		if dst != nil {
+			parent = dst.Rebase(parent, root.DirUri())
+		}
+
+		// Parent folder might be deleted permanently, or moved away after target is deleted.
+		if _, err := f.Create(ctx, parent, types.FileTypeFolder); err != nil {
+			ae.Add(target.restoreUri.String(), fmt.Errorf("failed to create parent folder: %w", err))
+			continue
+		}
+
+		if err := f.MoveOrCopy(ctx, []*fs.URI{newTrashUri(target.name)}, parent, false); err != nil {
+			if !ae.Merge(err) {
+				ae.Add(target.restoreUri.String(), err)
+			}
+		}
+	}
+
+	return ae.Aggregate()
+}
@@ -106,6 +106,13 @@ func (f *DBFS) PrepareUpload(ctx context.Context, req *fs.UploadRequest, opts ..
 		return nil, fs.ErrOwnerOnly
 	}
 
+	// Overwriting with a new version is blocked by legal hold, other entity types like thumbnails are allowed.
+	if fileExisted && *req.Props.EntityType == types.EntityTypeVersion {
+		if err := f.checkLegalHold(ctx, LegalHoldOpOverwrite, ancestor); err != nil {
+			return nil, err
+		}
+	}
+
 	// Lock target
 	lockedPath := ancestor.RootUri().JoinRaw(req.Props.Uri.PathTrimmed())
 	lr := &LockByPath{lockedPath, ancestor, types.FileTypeFile, ""}
@@ -281,6 +288,11 @@ func (f *DBFS) CompleteUpload(ctx context.Context, session *fs.UploadSession) (f
 			// Unlimited versions
 			maxVersions = math.MaxInt32
 		}
+
+		// Storage policy might limit versions further
+		if session.Policy != nil && session.Policy.Settings != nil && session.Policy.Settings.VersionMaxCount > 0 {
+			maxVersions = min(maxVersions, session.Policy.Settings.VersionMaxCount)
+		}
 	}
 
 	// Start transaction to update file
@@ -430,3 +442,110 @@ func (f *DBFS) CancelUploadSession(ctx context.Context, path *fs.URI, sessionID
 
 	return nil, nil
 }
+
+func (f *DBFS) WriteInPlace(ctx context.Context, path *fs.URI, size int64, write fs.InPlaceWriteFunc, opts ...fs.Option) (fs.File, error) {
+	o := newDbfsOption()
+	for _, opt := range opts {
+		o.apply(opt)
+	}
+
+	// Get navigator
+	navigator, err := f.getNavigator(ctx, path, NavigatorCapabilityUploadFile, NavigatorCapabilityLockFile)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get target file
+	ctx = context.WithValue(ctx, inventory.LoadFileEntity{}, true)
+	target, err := f.getFileByPath(ctx, navigator, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get target file: %w", err)
+	}
+
+	if target.Type() != types.FileTypeFile {
+		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("target must be a valid file"))
+	}
+
+	if _, ok := ctx.Value(ByPassOwnerCheckCtxKey{}).(bool); !ok && target.OwnerID() != f.user.ID {
+		return nil, fs.ErrOwnerOnly
+	}
+
+	if err := f.checkLegalHold(ctx, LegalHoldOpOverwrite, target); err != nil {
+		return nil, err
+	}
+
+	entity := target.PrimaryEntity()
+	if entity == nil {
+		return nil, fs.ErrEntityNotExist
+	}
+
+	// Content of shared entities cannot be modified, as other files would be changed too.
+	if entity.ReferenceCount() > 1 {
+		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("entity is referenced by other files"))
+	}
+
+	if o.previousVersion != "" {
+		entityId, err := f.hasher.Decode(o.previousVersion, hashid.EntityID)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Unknown version ID", err)
+		}
+
+		if entity.ID() != entityId {
+			return nil, fs.ErrStaleVersion
+		}
+	}
+
+	// Lock file
+	ls, err := f.acquireByPath(ctx, -1, f.user, true, fs.LockApp(fs.ApplicationUpload),
+		&LockByPath{target.Uri(true), target, target.Type(), ""})
+	defer func() { _ = f.Release(ctx, ls) }()
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := f.storagePolicyClient.GetPolicyByID(ctx, entity.PolicyID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage policy: %w", err)
+	}
+
+	if err := validateNewFile(target.Name(), size, policy); err != nil {
+		return nil, err
+	}
+
+	if size > entity.Size() {
+		if err := f.validateUserCapacity(ctx, size-entity.Size(), target.Owner()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := write(ctx, target, entity, policy); err != nil {
+		return nil, err
+	}
+
+	fc, tx, ctx, err := inventory.WithTx(ctx, f.fileClient)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	storageDiff, err := fc.ResizeEntity(ctx, entity.Model(), target.Model, size)
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update entity size", err)
+	}
+
+	tx.AppendStorageDiff(storageDiff)
+
+	// Content is changed, existing thumbnails must be re-generated.
+	diff, err := fc.CapEntities(ctx, target.Model, target.Owner(), 0, types.EntityTypeThumbnail)
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to cap thumbnail entities", err)
+	}
+	tx.AppendStorageDiff(diff)
+
+	if err := inventory.CommitWithStorageDiff(ctx, tx, f.l, f.userClient); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit in place write", err)
+	}
+
+	return f.Get(ctx, path, WithFileEntities(), WithNotRoot())
+}
@@ -41,6 +41,7 @@ var (
 	ErrInsufficientCapacity = serializer.NewError(serializer.CodeInsufficientCapacity, "Insufficient capacity", nil)
 	ErrStaleVersion         = serializer.NewError(serializer.CodeStaleVersion, "File is updated during your edit", nil)
 	ErrOwnerOnly            = serializer.NewError(serializer.CodeOwnerOnly, "Only owner or administrator can perform this action", nil)
+	ErrLegalHold            = serializer.NewError(serializer.CodeLegalHold, "File is under legal hold", nil)
 	ErrArchiveSrcSizeTooBig = ErrFileSizeTooBig.WithError(fmt.Errorf("total size of to-be compressed file exceed group limit (%w)", queue.CriticalErr))
 )
 
@@ -66,6 +67,9 @@ type (
 		SharedAddressTranslation(ctx context.Context, path *URI, opts ...Option) (File, *URI, error)
 		// ExecuteNavigatorHooks executes hooks of given type on a file for navigator based custom hooks.
 		ExecuteNavigatorHooks(ctx context.Context, hookType HookType, file File) error
+		// FollowTx lets the filesystem run all DB queries in the inherited transaction of ctx. Return a function
+		// to reset back to previous DB clients.
+		FollowTx(ctx context.Context) (func(), error)
 	}
 
 	FileManager interface {
@@ -89,6 +93,10 @@ type (
 		SoftDelete(ctx context.Context, path ...*URI) error
 		// Restore restores given files from trash bin to its original location.
 		Restore(ctx context.Context, path ...*URI) error
+		// RestoreTree restores all files in trash bin deleted from given root folder or its descendants, to
+		// their original locations, or the same relative locations under dst if it's not nil. Missing parent
+		// folders are created.
+		RestoreTree(ctx context.Context, root *URI, dst *URI) error
 		// VersionControl performs version control on given file.
 		//  - `delete` is false: set version as current version;
 		//  - `delete` is true: delete version.
@@ -109,6 +117,10 @@ type (
 		CancelUploadSession(ctx context.Context, path *URI, sessionID string, session *UploadSession) ([]Entity, error)
 		// PreValidateUpload pre-validates an upload request.
 		PreValidateUpload(ctx context.Context, dst *URI, files ...PreValidateFile) error
+		// WriteInPlace locks the file and overwrites content of its primary entity by write, size of the entity
+		// is updated to size afterward if write succeeds. The entity must not be shared by other files, and no
+		// version is kept for the overwritten content.
+		WriteInPlace(ctx context.Context, path *URI, size int64, write InPlaceWriteFunc, opts ...Option) (File, error)
 	}
 
 	LockSystem interface {
@@ -123,6 +135,14 @@ type (
 		Unlock(ctx context.Context, tokens ...string) error
 		// Refresh refreshes a lock.
 		Refresh(ctx context.Context, d time.Duration, token string) (lock.LockDetails, error)
+		// ListLocks lists active locks on files of current user, including check-outs.
+		ListLocks(ctx context.Context) ([]lock.ActiveLock, error)
+		// FileLocks returns active locks held on the file itself, check-outs are not included. No lock is
+		// acquired to find them.
+		FileLocks(ctx context.Context, file File) []lock.ActiveLock
+		// Checkout checks out a file until expireAt. Check-outs are persisted and released by Unlock with its token.
+		Checkout(ctx context.Context, expireAt time.Time, requester *ent.User, zeroDepth bool, uri *URI,
+			token string) (*lock.ActiveLock, error)
 	}
 
 	StatelessUploadManager interface {
@@ -312,6 +332,7 @@ type (
 		Node            StatelessUploadManager
 		StatelessUserID int
 		NoCache         bool
+		ModifiedAt      *time.Time
 	}
 
 	// Option 发送请求的额外设置
@@ -341,6 +362,9 @@ type (
 
 	CreateArchiveDryRunFunc func(name string, e Entity)
 
+	// InPlaceWriteFunc overwrites content of the entity stored under policy.
+	InPlaceWriteFunc func(ctx context.Context, file File, entity Entity, policy *ent.StoragePolicy) error
+
 	StatelessPrepareUploadService struct {
 		UploadRequest *UploadRequest `json:"upload_request" binding:"required"`
 		UserID        int            `json:"user_id"`
@@ -390,6 +414,8 @@ type (
 		Size         int64     `json:"size"`
 		IsDir        bool      `json:"is_dir"`
 		LastModify   time.Time `json:"last_modify"`
+		// Metadata is attached to the imported file.
+		Metadata map[string]string `json:"-"`
 	}
 )
 
@@ -557,6 +583,13 @@ func WithMetadata(m map[string]string) Option {
 	})
 }
 
+// WithModifiedAt sets modification time of created folder.
+func WithModifiedAt(t time.Time) Option {
+	return OptionFunc(func(o *FsOption) {
+		o.ModifiedAt = &t
+	})
+}
+
 // WithArchiveCompression sets whether to compress files in archive.
 func WithArchiveCompression(b bool) Option {
 	return OptionFunc(func(o *FsOption) {
@@ -679,13 +712,19 @@ const (
 	ApplicationViewer         ApplicationType = "viewer"
 	ApplicationMount          ApplicationType = "mount"
 	ApplicationRelocate       ApplicationType = "relocate"
+	ApplicationCheckout       ApplicationType = "checkout"
 )
 
 func LockApp(a ApplicationType) lock.Application {
 	return lock.Application{Type: string(a)}
 }
 
-type LockSessionCtxKey struct{}
+type (
+	LockSessionCtxKey struct{}
+	// AfterTxCtxKey holds a func(func()) registering side effects that run once the atomic transaction
+	// in context ends, whether it is committed or rolled back.
+	AfterTxCtxKey struct{}
+)
 
 // LockSessionToContext stores lock session to context.
 func LockSessionToContext(ctx context.Context, session LockSession) context.Context {
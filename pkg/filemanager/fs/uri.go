@@ -26,6 +26,7 @@ const (
 	QuerySearchUseOr          = "use_or"
 	QuerySearchMetadataPrefix = "meta_"
 	QuerySearchCaseFolding    = "case_folding"
+	QuerySearchFullText       = "full_text"
 	QuerySearchType           = "type"
 	QuerySearchTypeCategory   = "category"
 	QuerySearchSizeGte        = "size_gte"
@@ -34,6 +35,12 @@ const (
 	QuerySearchCreatedLte     = "created_lte"
 	QuerySearchUpdatedGte     = "updated_gte"
 	QuerySearchUpdatedLte     = "updated_lte"
+	QuerySearchTakenGte       = "taken_gte"
+	QuerySearchTakenLte       = "taken_lte"
+	QuerySearchWithGps        = "with_gps"
+	QuerySearchTag            = "tag"
+	// QuerySearchPolicy is the hash ID of storage policy, decoded by file manager.
+	QuerySearchPolicy = "policy"
 )
 
 type URI struct {
@@ -239,6 +246,10 @@ func (u *URI) SearchParameters() *inventory.SearchFileParameters {
 		res.CaseFolding = true
 	}
 
+	if _, ok := q[QuerySearchFullText]; ok {
+		res.UseFullText = true
+	}
+
 	if v, ok := q[QuerySearchTypeCategory]; ok {
 		res.Category = v[0]
 		withSearch = withSearch || len(res.Category) > 0
@@ -309,6 +320,40 @@ func (u *URI) SearchParameters() *inventory.SearchFileParameters {
 		}
 	}
 
+	if v, ok := q[QuerySearchTakenGte]; ok {
+		limit, err := strconv.ParseInt(v[0], 10, 64)
+		if err == nil {
+			limit := time.Unix(limit, 0)
+			res.TakenAtGte = &limit
+			withSearch = true
+		}
+	}
+
+	if v, ok := q[QuerySearchTakenLte]; ok {
+		limit, err := strconv.ParseInt(v[0], 10, 64)
+		if err == nil {
+			limit := time.Unix(limit, 0)
+			res.TakenAtLte = &limit
+			withSearch = true
+		}
+	}
+
+	if _, ok := q[QuerySearchWithGps]; ok {
+		res.WithGps = true
+		withSearch = true
+	}
+
+	if tags, ok := q[QuerySearchTag]; ok {
+		res.Tags = lo.Filter(tags, func(tag string, index int) bool {
+			return tag != ""
+		})
+		withSearch = withSearch || len(res.Tags) > 0
+	}
+
+	if v, ok := q[QuerySearchPolicy]; ok && v[0] != "" {
+		withSearch = true
+	}
+
 	if withSearch {
 		return res
 	}
@@ -0,0 +1,375 @@
+package lock
+
+import (
+	"container/heap"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	// kvLockPrefix is the key prefix of lock records, keyed by lock token.
+	kvLockPrefix = "file_lock_token_"
+	// kvLockNsPrefix is the key prefix of namespace indexes, holding tokens of locks in a namespace.
+	kvLockNsPrefix = "file_lock_ns_"
+	// kvLockMutexPrefix is the key prefix of mutexes guarding lock creation in a namespace.
+	kvLockMutexPrefix = "file_lock_mutex_"
+
+	kvLockMutexTTL      = 30
+	kvLockWaitTimeout   = 10 * time.Second
+	kvLockRetryInterval = 10 * time.Millisecond
+	kvLockMaxRetry      = 16
+	// kvLockHoldTTL is the minimum TTL of a lock held by a Confirm call. Held locks do not expire, but
+	// one left held by a crashed instance will be removed after this TTL.
+	kvLockHoldTTL = 3600
+)
+
+var ErrLockNamespaceBusy = errors.New("kvlock: timeout waiting for lock namespace")
+
+func init() {
+	gob.Register(kvLockRecord{})
+	gob.Register([]string{})
+}
+
+// kvLockRecord is a lock stored in KV.
+type kvLockRecord struct {
+	Details LockDetails
+	// ExpireAt is zero if the lock never expires.
+	ExpireAt time.Time
+	// Held is whether the lock is actively held by a Confirm call.
+	Held bool
+}
+
+func (r *kvLockRecord) alive(now time.Time) bool {
+	return r.Held || r.ExpireAt.IsZero() || now.Before(r.ExpireAt)
+}
+
+// ttl returns TTL of the record in KV, 0 if it never expires.
+func (r *kvLockRecord) ttl(now time.Time) int {
+	if r.ExpireAt.IsZero() {
+		return 0
+	}
+
+	ttl := max(int(math.Ceil(r.ExpireAt.Sub(now).Seconds())), 1)
+	if r.Held {
+		ttl = max(ttl, kvLockHoldTTL)
+	}
+
+	return ttl
+}
+
+func (r *kvLockRecord) toConflictDetail(index int) *ConflictDetail {
+	return &ConflictDetail{
+		Path:     r.Details.Root,
+		Owner:    r.Details.Owner,
+		Token:    r.Details.Token,
+		Index:    index,
+		Type:     r.Details.Type,
+		ExpireAt: lo.Ternary(r.ExpireAt.IsZero(), nil, &r.ExpireAt),
+	}
+}
+
+type kvLS struct {
+	kv     cache.Driver
+	hasher hashid.Encoder
+	l      logging.Logger
+}
+
+// NewKVLS returns a LockSystem storing locks in KV, so that they are shared by all master instances
+// using the same KV and survive restarts with a persisted one. Each lock is stored under its token with
+// a TTL of its remaining duration.
+func NewKVLS(kv cache.Driver, hasher hashid.Encoder, l logging.Logger) LockSystem {
+	return &kvLS{
+		kv:     kv,
+		hasher: hasher,
+		l:      l,
+	}
+}
+
+func (s *kvLS) Create(now time.Time, details ...LockDetails) ([]string, error) {
+	namespaces := lo.Uniq(lo.Map(details, func(item LockDetails, index int) string {
+		return item.Ns
+	}))
+	release, err := s.lockNamespaces(namespaces)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// Conflicts are resolved against a snapshot of affected namespaces, which stays valid
+	// as long as the namespaces are locked.
+	m := newMemLS(s.hasher, s.l)
+	for _, ns := range namespaces {
+		s.load(now, m, ns)
+	}
+
+	tokens, err := m.Create(now, details...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, token := range tokens {
+		n := m.byToken[token]
+		record := kvLockRecord{Details: n.details}
+		record.Details.Token = token
+		if n.details.Duration >= 0 {
+			record.ExpireAt = n.expiry
+		}
+
+		if err := s.kv.Set(kvLockPrefix+token, record, record.ttl(now)); err != nil {
+			_ = s.kv.Delete(kvLockPrefix, tokens...)
+			return nil, fmt.Errorf("failed to save lock: %w", err)
+		}
+	}
+
+	for _, ns := range namespaces {
+		index := make([]string, 0)
+		for token, n := range m.byToken {
+			if n.details.Ns == ns {
+				index = append(index, token)
+			}
+		}
+
+		slices.Sort(index)
+		if err := s.kv.Set(kvLockNsPrefix+ns, index, 0); err != nil {
+			_ = s.kv.Delete(kvLockPrefix, tokens...)
+			return nil, fmt.Errorf("failed to save lock index: %w", err)
+		}
+	}
+
+	return tokens, nil
+}
+
+func (s *kvLS) Unlock(now time.Time, tokens ...string) error {
+	records := make([]kvLockRecord, 0, len(tokens))
+	conflicts := make([]*ConflictDetail, 0)
+	for i, token := range tokens {
+		r, ok := s.get(now, token)
+		if !ok {
+			return ErrNoSuchLock
+		}
+		if r.Held {
+			conflicts = append(conflicts, r.toConflictDetail(i))
+		}
+		records = append(records, r)
+	}
+
+	if len(conflicts) > 0 {
+		return ConflictError(conflicts)
+	}
+
+	for i, token := range tokens {
+		deleted, err := s.kv.CompareAndDelete(kvLockPrefix+token, records[i])
+		if err != nil {
+			return fmt.Errorf("failed to delete lock: %w", err)
+		}
+
+		// Lock is held or refreshed after we read it.
+		if r, ok := s.get(now, token); !deleted && ok {
+			conflicts = append(conflicts, r.toConflictDetail(i))
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return ConflictError(conflicts)
+	}
+
+	return nil
+}
+
+func (s *kvLS) Confirm(now time.Time, request LockInfo) (func(), string, error) {
+	s.l.Debug("KV lock confirm: NS:%s, Root: %s, Token: %v", request.Ns, request.Root, request.Token)
+	for _, token := range request.Token {
+		r, ok := s.get(now, token)
+		if !ok || r.Held || r.Details.Ns != request.Ns {
+			continue
+		}
+
+		root := r.Details.Root
+		if request.Root != root && (r.Details.ZeroDepth || (root != "/" && !strings.HasPrefix(request.Root, root+"/"))) {
+			continue
+		}
+
+		held := r
+		held.Held = true
+		swapped, err := s.kv.CompareAndSwap(kvLockPrefix+token, r, held, held.ttl(now))
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to hold lock: %w", err)
+		}
+
+		if swapped {
+			return func() {
+				s.unhold(token)
+			}, token, nil
+		}
+	}
+
+	return nil, "", ErrConfirmationFailed
+}
+
+func (s *kvLS) Refresh(now time.Time, duration time.Duration, token string) (LockDetails, error) {
+	s.l.Debug("KV lock refresh: Token: %s, Duration: %v", token, duration)
+	for i := 0; i < kvLockMaxRetry; i++ {
+		r, ok := s.get(now, token)
+		if !ok {
+			return LockDetails{}, ErrNoSuchLock
+		}
+		if r.Held {
+			return LockDetails{}, ErrLocked
+		}
+
+		refreshed := r
+		refreshed.Details.Duration = duration
+		refreshed.ExpireAt = time.Time{}
+		if duration >= 0 {
+			refreshed.ExpireAt = now.Add(duration)
+		}
+
+		swapped, err := s.kv.CompareAndSwap(kvLockPrefix+token, r, refreshed, refreshed.ttl(now))
+		if err != nil {
+			return LockDetails{}, fmt.Errorf("failed to refresh lock: %w", err)
+		}
+
+		if swapped {
+			return refreshed.Details, nil
+		}
+	}
+
+	return LockDetails{}, ErrLocked
+}
+
+func (s *kvLS) List(now time.Time, ns string) []ActiveLock {
+	m := newMemLS(s.hasher, s.l)
+	s.load(now, m, ns)
+	return m.List(now, ns)
+}
+
+// get returns the lock record of given token if it is not expired.
+func (s *kvLS) get(now time.Time, token string) (kvLockRecord, bool) {
+	v, ok := s.kv.Get(kvLockPrefix + token)
+	if !ok {
+		return kvLockRecord{}, false
+	}
+
+	r, ok := v.(kvLockRecord)
+	if !ok || !r.alive(now) {
+		return kvLockRecord{}, false
+	}
+
+	return r, true
+}
+
+// load restores active locks of given namespace into m. Tokens of released or expired locks
+// are dropped from the namespace index on next Create.
+func (s *kvLS) load(now time.Time, m *memLS, ns string) {
+	v, ok := s.kv.Get(kvLockNsPrefix + ns)
+	if !ok {
+		return
+	}
+
+	tokens, _ := v.([]string)
+	records, _ := s.kv.Gets(tokens, kvLockPrefix)
+	for token, v := range records {
+		r, ok := v.(kvLockRecord)
+		if !ok || !r.alive(now) || r.Details.Ns != ns {
+			continue
+		}
+
+		n := m.create(ns, r.Details.Root, token, r.Details.Shared)
+		m.byToken[token] = n
+		n.details = r.Details
+		n.held = r.Held
+		if !r.ExpireAt.IsZero() {
+			n.expiry = r.ExpireAt
+			if !r.Held {
+				heap.Push(&m.byExpiry, n)
+			}
+		}
+	}
+}
+
+func (s *kvLS) unhold(token string) {
+	for i := 0; i < kvLockMaxRetry; i++ {
+		v, ok := s.kv.Get(kvLockPrefix + token)
+		if !ok {
+			return
+		}
+
+		r, ok := v.(kvLockRecord)
+		if !ok || !r.Held {
+			return
+		}
+
+		now := time.Now()
+		released := r
+		released.Held = false
+
+		var (
+			done bool
+			err  error
+		)
+		if released.alive(now) {
+			done, err = s.kv.CompareAndSwap(kvLockPrefix+token, r, released, released.ttl(now))
+		} else {
+			done, err = s.kv.CompareAndDelete(kvLockPrefix+token, r)
+		}
+
+		if err != nil {
+			s.l.Warning("Failed to release held lock %q: %s", token, err)
+			return
+		}
+
+		if done {
+			return
+		}
+	}
+}
+
+// lockNamespaces acquires mutexes of given namespaces in a fixed order, so that concurrent
+// Create calls from all instances are serialized.
+func (s *kvLS) lockNamespaces(namespaces []string) (func(), error) {
+	id := uuid.Must(uuid.NewV4()).String()
+	locked := make([]string, 0, len(namespaces))
+	release := func() {
+		for _, ns := range locked {
+			if _, err := s.kv.CompareAndDelete(kvLockMutexPrefix+ns, id); err != nil {
+				s.l.Warning("Failed to release lock namespace %q: %s", ns, err)
+			}
+		}
+	}
+
+	deadline := time.Now().Add(kvLockWaitTimeout)
+	for _, ns := range slices.Sorted(slices.Values(namespaces)) {
+		for {
+			ok, err := s.kv.SetNX(kvLockMutexPrefix+ns, id, kvLockMutexTTL)
+			if err != nil {
+				release()
+				return nil, fmt.Errorf("failed to lock namespace %q: %w", ns, err)
+			}
+
+			if ok {
+				locked = append(locked, ns)
+				break
+			}
+
+			if time.Now().After(deadline) {
+				release()
+				return nil, ErrLockNamespaceBusy
+			}
+
+			time.Sleep(kvLockRetryInterval)
+		}
+	}
+
+	return release, nil
+}
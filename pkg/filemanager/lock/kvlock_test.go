@@ -0,0 +1,100 @@
+package lock
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestKVLS(kv cache.Driver) LockSystem {
+	return NewKVLS(kv, nil, logging.NewConsoleLogger(logging.LevelError))
+}
+
+func TestKVLS_SharedAcrossInstances(t *testing.T) {
+	a := assert.New(t)
+	kv := cache.NewMemoStore("", logging.NewConsoleLogger(logging.LevelError))
+	first, second := newTestKVLS(kv), newTestKVLS(kv)
+	now := time.Now()
+
+	tokens, err := first.Create(now, LockDetails{Ns: "my", Root: "/a", Duration: time.Minute, Owner: Owner{Application: Application{Type: "dav"}}})
+	a.NoError(err)
+	a.Len(tokens, 1)
+
+	// Lock created on one instance conflicts on another
+	_, err = second.Create(now, LockDetails{Ns: "my", Root: "/a/b", Duration: -1, ZeroDepth: true})
+	var conflicts ConflictError
+	a.True(errors.As(err, &conflicts))
+	a.Equal(tokens[0], conflicts[0].Token)
+	a.Equal("dav", conflicts[0].Owner.Application.Type)
+
+	locks := second.List(now, "my")
+	a.Len(locks, 1)
+	a.Equal("/a", locks[0].Root)
+	a.True(locks[0].ExpireAt.Equal(now.Add(time.Minute)))
+
+	// Held lock cannot be released or refreshed until the holder finishes
+	release, token, err := second.Confirm(now, LockInfo{Ns: "my", Root: "/a/b", Token: tokens})
+	a.NoError(err)
+	a.Equal(tokens[0], token)
+	_, _, err = first.Confirm(now, LockInfo{Ns: "my", Root: "/a", Token: tokens})
+	a.ErrorIs(err, ErrConfirmationFailed)
+	a.ErrorIs(first.Unlock(now, tokens...), ErrLocked)
+	_, err = first.Refresh(now, time.Minute, tokens[0])
+	a.ErrorIs(err, ErrLocked)
+	release()
+
+	details, err := first.Refresh(now.Add(30*time.Second), time.Minute, tokens[0])
+	a.NoError(err)
+	a.Equal("/a", details.Root)
+	a.True(second.List(now.Add(time.Minute), "my")[0].ExpireAt.Equal(now.Add(90 * time.Second)))
+
+	a.NoError(second.Unlock(now, tokens...))
+	a.ErrorIs(first.Unlock(now, tokens...), ErrNoSuchLock)
+	a.Empty(first.List(now, "my"))
+	_, err = second.Create(now, LockDetails{Ns: "my", Root: "/a/b", Duration: -1, ZeroDepth: true})
+	a.NoError(err)
+}
+
+func TestKVLS_SharedLock(t *testing.T) {
+	a := assert.New(t)
+	ls := newTestKVLS(cache.NewMemoStore("", logging.NewConsoleLogger(logging.LevelError)))
+	now := time.Now()
+
+	first, err := ls.Create(now, LockDetails{Ns: "my", Root: "/a/b", Duration: -1, ZeroDepth: true, Shared: true})
+	a.NoError(err)
+	second, err := ls.Create(now, LockDetails{Ns: "my", Root: "/a/b", Duration: -1, ZeroDepth: true, Shared: true})
+	a.NoError(err)
+
+	_, err = ls.Create(now, LockDetails{Ns: "my", Root: "/a", Duration: -1})
+	a.ErrorIs(err, ErrLocked)
+
+	// Namespaces are isolated
+	_, err = ls.Create(now, LockDetails{Ns: "other", Root: "/a", Duration: -1})
+	a.NoError(err)
+
+	a.NoError(ls.Unlock(now, first[0], second[0]))
+	_, err = ls.Create(now, LockDetails{Ns: "my", Root: "/a", Duration: -1})
+	a.NoError(err)
+}
+
+func TestKVLS_Expiry(t *testing.T) {
+	a := assert.New(t)
+	ls := newTestKVLS(cache.NewMemoStore("", logging.NewConsoleLogger(logging.LevelError)))
+	now := time.Now()
+
+	tokens, err := ls.Create(now, LockDetails{Ns: "my", Root: "/a", Duration: time.Minute, ZeroDepth: true})
+	a.NoError(err)
+
+	_, err = ls.Create(now.Add(30*time.Second), LockDetails{Ns: "my", Root: "/a", Duration: -1, ZeroDepth: true})
+	a.ErrorIs(err, ErrLocked)
+
+	a.Empty(ls.List(now.Add(time.Minute), "my"))
+	_, err = ls.Refresh(now.Add(time.Minute), time.Minute, tokens[0])
+	a.ErrorIs(err, ErrNoSuchLock)
+	_, err = ls.Create(now.Add(time.Minute), LockDetails{Ns: "my", Root: "/a", Duration: -1, ZeroDepth: true})
+	a.NoError(err)
+}
@@ -30,6 +30,8 @@ type LockSystem interface {
 	Unlock(now time.Time, tokens ...string) error
 	Confirm(now time.Time, requests LockInfo) (func(), string, error)
 	Refresh(now time.Time, duration time.Duration, token string) (LockDetails, error)
+	// List returns all active locks in given namespace.
+	List(now time.Time, ns string) []ActiveLock
 }
 
 // LockDetails are a lock's metadata.
@@ -50,12 +52,21 @@ type LockDetails struct {
 	Type types.FileType
 	// Optional, customize the token of the lock.
 	Token string
+	// Shared is whether the lock is shared. Shared locks only conflict with exclusive locks.
+	Shared bool
 }
 
 func (d *LockDetails) Key() string {
 	return d.Ns + "/" + d.Root
 }
 
+// ActiveLock is a lock that is not released or expired yet. Token of LockDetails is always set.
+type ActiveLock struct {
+	LockDetails
+	// ExpireAt is nil if the lock never expires.
+	ExpireAt *time.Time
+}
+
 type Owner struct {
 	// Name of the application who are currently lock this.
 	Application Application `json:"application"`
@@ -65,6 +76,9 @@ type Application struct {
 	Type     string `json:"type"`
 	InnerXML string `json:"inner_xml,omitempty"`
 	ViewerID string `json:"viewer_id,omitempty"`
+	// UserID and UserName identify the user who checked out the file.
+	UserID   string `json:"user_id,omitempty"`
+	UserName string `json:"user_name,omitempty"`
 }
 
 // LockInfo is a lock confirmation request.
@@ -88,6 +102,10 @@ type memLS struct {
 
 // NewMemLS returns a new in-memory LockSystem.
 func NewMemLS(hasher hashid.Encoder, l logging.Logger) LockSystem {
+	return newMemLS(hasher, l)
+}
+
+func newMemLS(hasher hashid.Encoder, l logging.Logger) *memLS {
 	return &memLS{
 		byName:  make(map[string]map[string]*memLSNode),
 		byToken: make(map[string]*memLSNode),
@@ -139,6 +157,25 @@ func (m *memLS) Refresh(now time.Time, duration time.Duration, token string) (Lo
 	return n.details, nil
 }
 
+func (m *memLS) List(now time.Time, ns string) []ActiveLock {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.collectExpiredNodes(now)
+
+	res := make([]ActiveLock, 0)
+	for token, n := range m.byToken {
+		if n.details.Ns != ns {
+			continue
+		}
+
+		l := ActiveLock{LockDetails: n.details, ExpireAt: n.expireAt()}
+		l.Token = token
+		res = append(res, l)
+	}
+
+	return res
+}
+
 func (m *memLS) Create(now time.Time, details ...LockDetails) ([]string, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -153,15 +190,15 @@ func (m *memLS) Create(now time.Time, details ...LockDetails) ([]string, error)
 		// }
 		// Check lock conflicts
 		detail.Root = util.SlashClean(detail.Root)
-		m.l.Debug("Memlock create: NS:%s, Root: %s, Duration: %v, ZeroDepth: %v", detail.Ns, detail.Root, detail.Duration, detail.ZeroDepth)
-		conflict := m.canCreate(i, detail.Ns, detail.Root, detail.ZeroDepth)
+		m.l.Debug("Memlock create: NS:%s, Root: %s, Duration: %v, ZeroDepth: %v, Shared: %v", detail.Ns, detail.Root, detail.Duration, detail.ZeroDepth, detail.Shared)
+		conflict := m.canCreate(i, detail.Ns, detail.Root, detail.ZeroDepth, detail.Shared)
 		if len(conflict) > 0 {
 			conflicts = append(conflicts, conflict...)
 			// Stop processing more locks since there's already conflicts
 			break
 		} else {
 			// Create locks
-			n := m.create(detail.Ns, detail.Root, detail.Token)
+			n := m.create(detail.Ns, detail.Root, detail.Token, detail.Shared)
 			m.byToken[n.token] = n
 			n.details = detail
 			if n.details.Duration >= 0 {
@@ -185,12 +222,17 @@ func (m *memLS) Create(now time.Time, details ...LockDetails) ([]string, error)
 	}), nil
 }
 
-func (m *memLS) canCreate(index int, ns, name string, zeroDepth bool) []*ConflictDetail {
+func (m *memLS) canCreate(index int, ns, name string, zeroDepth, shared bool) []*ConflictDetail {
 	n := m.byName[ns]
 	if n == nil {
 		return nil
 	}
 
+	// conflicted returns whether an existing lock conflicts with the requested one.
+	conflicted := func(l *memLSNode) bool {
+		return !shared || !l.details.Shared
+	}
+
 	conflicts := make([]*ConflictDetail, 0)
 	canCreate := walkToRoot(name, func(name0 string, first bool) bool {
 		n := m.byName[ns][name0]
@@ -200,24 +242,41 @@ func (m *memLS) canCreate(index int, ns, name string, zeroDepth bool) []*Conflic
 
 		if first {
 			if n.token != "" {
-				// The target node is already locked.
+				// The target node is already exclusively locked.
 				conflicts = append(conflicts, n.toConflictDetail(index, m.hasher))
 				return false
 			}
-			if !zeroDepth {
-				// The requested lock depth is infinite, and the fact that n exists
-				// (n != nil) means that a descendent of the target node is locked.
+			if !shared && len(n.sharedLocks) > 0 {
+				// The target node is locked by shared locks.
 				conflicts = append(conflicts,
-					lo.MapToSlice(n.childLocks, func(key string, value *memLSNode) *ConflictDetail {
+					lo.MapToSlice(n.sharedLocks, func(key string, value *memLSNode) *ConflictDetail {
 						return value.toConflictDetail(index, m.hasher)
 					},
 					)...)
 				return false
 			}
-		} else if n.token != "" && !n.details.ZeroDepth {
-			// An ancestor of the target node is locked with infinite depth.
-			conflicts = append(conflicts, n.toConflictDetail(index, m.hasher))
-			return false
+			if !zeroDepth {
+				// The requested lock depth is infinite, check locks on descendents of the target node.
+				for _, l := range n.childLocks {
+					if conflicted(l) {
+						conflicts = append(conflicts, l.toConflictDetail(index, m.hasher))
+					}
+				}
+				return len(conflicts) == 0
+			}
+		} else {
+			if n.token != "" && !n.details.ZeroDepth {
+				// An ancestor of the target node is exclusively locked with infinite depth.
+				conflicts = append(conflicts, n.toConflictDetail(index, m.hasher))
+				return false
+			}
+			for _, l := range n.sharedLocks {
+				if !l.details.ZeroDepth && conflicted(l) {
+					// An ancestor of the target node is locked by a shared lock with infinite depth.
+					conflicts = append(conflicts, l.toConflictDetail(index, m.hasher))
+				}
+			}
+			return len(conflicts) == 0
 		}
 		return true
 	})
@@ -268,7 +327,7 @@ func (m *memLS) collectExpiredNodes(now time.Time) {
 	}
 }
 
-func (m *memLS) create(ns, name, token string) (ret *memLSNode) {
+func (m *memLS) create(ns, name, token string, shared bool) (ret *memLSNode) {
 	if _, ok := m.byName[ns]; !ok {
 		m.byName[ns] = make(map[string]*memLSNode)
 	}
@@ -285,12 +344,22 @@ func (m *memLS) create(ns, name, token string) (ret *memLSNode) {
 					Root: name0,
 				},
 				childLocks:    make(map[string]*memLSNode),
+				sharedLocks:   make(map[string]*memLSNode),
 				byExpiryIndex: -1,
 			}
 			m.byName[ns][name0] = n
 		}
 		n.refCount++
-		if first {
+		if first && shared {
+			// Shared locks are kept apart from the name node, since multiple of them can
+			// coexist on the same name.
+			ret = &memLSNode{
+				details:       LockDetails{Root: name0},
+				token:         token,
+				byExpiryIndex: -1,
+			}
+			n.sharedLocks[token] = ret
+		} else if first {
 			n.token = token
 			ret = n
 		} else {
@@ -331,6 +400,7 @@ func (m *memLS) remove(n *memLSNode) {
 		x := m.byName[n.details.Ns][name0]
 		x.refCount--
 		delete(x.childLocks, token)
+		delete(x.sharedLocks, token)
 		if x.refCount == 0 {
 			delete(m.byName[n.details.Ns], name0)
 			if len(m.byName[n.details.Ns]) == 0 {
@@ -399,6 +469,8 @@ type memLSNode struct {
 	// childLocks hold the relation between lock token and child locks.
 	// This is used to find out who is locking this file.
 	childLocks map[string]*memLSNode
+	// sharedLocks hold shared locks on this node's name, keyed by their tokens.
+	sharedLocks map[string]*memLSNode
 }
 
 func (n *memLSNode) toConflictDetail(index int, hasher hashid.Encoder) *ConflictDetail {
@@ -407,10 +479,21 @@ func (n *memLSNode) toConflictDetail(index int, hasher hashid.Encoder) *Conflict
 		Owner: Owner{
 			Application: n.details.Owner.Application,
 		},
-		Token: n.token,
-		Index: index,
-		Type:  n.details.Type,
+		Token:    n.token,
+		Index:    index,
+		Type:     n.details.Type,
+		ExpireAt: n.expireAt(),
+	}
+}
+
+// expireAt returns when the lock expires, nil if it never expires.
+func (n *memLSNode) expireAt() *time.Time {
+	if n.details.Duration < 0 || n.expiry.IsZero() {
+		return nil
 	}
+
+	expiry := n.expiry
+	return &expiry
 }
 
 type byExpiry []*memLSNode
@@ -451,6 +534,8 @@ type ConflictDetail struct {
 	Owner Owner          `json:"owner,omitempty"`
 	Index int            `json:"-"`
 	Type  types.FileType `json:"type"`
+	// ExpireAt is nil if the lock never expires.
+	ExpireAt *time.Time `json:"expire_at,omitempty"`
 }
 
 type ConflictError []*ConflictDetail
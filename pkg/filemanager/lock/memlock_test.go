@@ -1 +1,108 @@
 package lock
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLS() LockSystem {
+	return NewMemLS(nil, logging.NewConsoleLogger(logging.LevelError))
+}
+
+func TestSharedLock(t *testing.T) {
+	a := assert.New(t)
+	ls := newTestLS()
+	now := time.Now()
+
+	first, err := ls.Create(now, LockDetails{Ns: "my", Root: "/a/b", Duration: -1, ZeroDepth: true, Shared: true})
+	a.NoError(err)
+	second, err := ls.Create(now, LockDetails{Ns: "my", Root: "/a/b", Duration: -1, ZeroDepth: true, Shared: true})
+	a.NoError(err)
+	a.NotEqual(first[0], second[0])
+
+	// Exclusive lock conflicts with shared locks on the same name
+	_, err = ls.Create(now, LockDetails{Ns: "my", Root: "/a/b", Duration: -1, ZeroDepth: true})
+	a.True(errors.Is(err, ErrLocked))
+
+	// Exclusive infinite lock on ancestor conflicts with shared locks on descendents
+	_, err = ls.Create(now, LockDetails{Ns: "my", Root: "/a", Duration: -1})
+	a.True(errors.Is(err, ErrLocked))
+
+	// Shared infinite lock on ancestor is compatible with shared locks on descendents
+	parent, err := ls.Create(now, LockDetails{Ns: "my", Root: "/a", Duration: -1, Shared: true})
+	a.NoError(err)
+
+	// Exclusive lock on descendent conflicts with shared infinite lock on ancestor
+	_, err = ls.Create(now, LockDetails{Ns: "my", Root: "/a/c", Duration: -1, ZeroDepth: true})
+	a.True(errors.Is(err, ErrLocked))
+
+	release, token, err := ls.Confirm(now, LockInfo{Ns: "my", Root: "/a/b", Token: second})
+	a.NoError(err)
+	a.Equal(second[0], token)
+	release()
+
+	a.NoError(ls.Unlock(now, first[0], second[0], parent[0]))
+	_, err = ls.Create(now, LockDetails{Ns: "my", Root: "/a", Duration: -1})
+	a.NoError(err)
+}
+
+func TestSharedLockExpiry(t *testing.T) {
+	a := assert.New(t)
+	ls := newTestLS()
+	now := time.Now()
+
+	tokens, err := ls.Create(now, LockDetails{Ns: "my", Root: "/a", Duration: time.Minute, ZeroDepth: true, Shared: true})
+	a.NoError(err)
+
+	_, err = ls.Refresh(now.Add(30*time.Second), time.Minute, tokens[0])
+	a.NoError(err)
+
+	_, err = ls.Create(now.Add(time.Minute), LockDetails{Ns: "my", Root: "/a", Duration: -1, ZeroDepth: true})
+	a.True(errors.Is(err, ErrLocked))
+
+	_, err = ls.Create(now.Add(2*time.Minute), LockDetails{Ns: "my", Root: "/a", Duration: -1, ZeroDepth: true})
+	a.NoError(err)
+}
+
+func TestList(t *testing.T) {
+	a := assert.New(t)
+	ls := newTestLS()
+	now := time.Now()
+
+	tokens, err := ls.Create(now,
+		LockDetails{Ns: "my", Root: "/a", Duration: time.Minute, ZeroDepth: true, Owner: Owner{Application: Application{Type: "checkout", UserName: "Alice"}}},
+		LockDetails{Ns: "my", Root: "/b", Duration: -1, ZeroDepth: true},
+	)
+	a.NoError(err)
+	_, err = ls.Create(now, LockDetails{Ns: "other", Root: "/a", Duration: -1, ZeroDepth: true})
+	a.NoError(err)
+
+	locks := ls.List(now, "my")
+	a.Len(locks, 2)
+	for _, l := range locks {
+		if l.Root == "/a" {
+			a.Equal(tokens[0], l.Token)
+			a.Equal("Alice", l.Owner.Application.UserName)
+			a.NotNil(l.ExpireAt)
+			a.True(l.ExpireAt.Equal(now.Add(time.Minute)))
+		} else {
+			a.Equal(tokens[1], l.Token)
+			a.Nil(l.ExpireAt)
+		}
+	}
+
+	// Conflicts report when the lock expires
+	_, err = ls.Create(now, LockDetails{Ns: "my", Root: "/a", Duration: -1, ZeroDepth: true})
+	var conflicts ConflictError
+	a.True(errors.As(err, &conflicts))
+	a.NotNil(conflicts[0].ExpireAt)
+
+	// Expired locks are not listed
+	locks = ls.List(now.Add(time.Minute), "my")
+	a.Len(locks, 1)
+	a.Equal("/b", locks[0].Root)
+}
@@ -0,0 +1,61 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const accountDeletionPageSize = 500
+
+func init() {
+	crontab.Register(setting.CronTypeAccountDeletion, CronPurgeDeletedAccounts)
+}
+
+// CronPurgeDeletedAccounts purges users whose self-service deletion grace period has passed.
+func CronPurgeDeletedAccounts(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	uc := dep.UserClient()
+
+	expired := make([]int, 0)
+	for page := 0; ; page++ {
+		res, err := uc.ListUsers(ctx, &inventory.ListUserParameters{
+			PaginationArgs: &inventory.PaginationArgs{
+				Page:     page,
+				PageSize: accountDeletionPageSize,
+			},
+			Status: user.StatusSysBanned,
+		})
+		if err != nil {
+			l.Error("Failed to list users pending deletion: %s", err)
+			return
+		}
+
+		for _, u := range res.Users {
+			if u.Settings != nil && u.Settings.Deletion != nil && u.Settings.Deletion.PurgeAt.Before(time.Now()) {
+				expired = append(expired, u.ID)
+			}
+		}
+
+		if len(res.Users) < accountDeletionPageSize {
+			break
+		}
+	}
+
+	for _, uid := range expired {
+		l.Info("Purging user %d after account deletion grace period.", uid)
+		if err := purgeUser(ctx, dep, uid); errors.Is(err, fs.ErrLegalHold) {
+			l.Info("Skip purging user %d under legal hold.", uid)
+		} else if err != nil {
+			l.Error("Failed to purge user %d: %s", uid, err)
+		}
+	}
+}
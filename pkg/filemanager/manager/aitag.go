@@ -0,0 +1,198 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/aitag"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/samber/lo"
+)
+
+const aiTaggingPolicyPageSize = 100
+
+func init() {
+	crontab.Register(setting.CronTypeAiTagging, CronAiTagging)
+}
+
+// CronAiTagging sends images not tagged yet to the inference endpoint in batches, saving returned labels as
+// tags. Only files of storage policies opted in are processed, newest first. The number of images processed
+// in each run is limited, so that the endpoint is not flooded when a large library is uploaded.
+func CronAiTagging(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	settings := dep.SettingProvider().AiTagging(ctx)
+	if !settings.Enabled || settings.Endpoint == "" {
+		return
+	}
+
+	policyIDs, err := aiTaggingPolicies(ctx, dep)
+	if err != nil {
+		l.Error("Failed to list storage policies for AI tagging: %s", err)
+		return
+	}
+
+	files, err := dep.FileClient().ListAiTagCandidates(ctx, &inventory.ListAiTagCandidatesParameters{
+		PolicyIDs: policyIDs,
+		Exts:      settings.Exts,
+		TaggedKey: dbfs.MetadataAiTagged,
+		Limit:     settings.MaxPerRun,
+	})
+	if err != nil {
+		l.Error("Failed to list images for AI tagging: %s", err)
+		return
+	}
+
+	tagger := aitag.NewTagger(dep.RequestClient(request.WithLogger(l)), settings.Endpoint, settings.Token)
+	tagged := 0
+	for _, batch := range lo.Chunk(files, max(settings.BatchSize, 1)) {
+		if err := aiTagBatch(ctx, dep, tagger, settings, batch); err != nil {
+			// Endpoint is likely unavailable, rest of the images are retried in next run.
+			l.Warning("Failed to tag images with inference endpoint: %s", err)
+			break
+		}
+
+		tagged += len(batch)
+	}
+
+	if tagged > 0 {
+		l.Info("%d images are processed by AI tagging.", tagged)
+	}
+}
+
+// aiTaggingPolicies returns IDs of storage policies opted in AI tagging.
+func aiTaggingPolicies(ctx context.Context, dep dependency.Dep) ([]int, error) {
+	var ids []int
+	for page := 0; ; page++ {
+		res, err := dep.StoragePolicyClient().ListPolicies(ctx, &inventory.ListPolicyParameters{
+			PaginationArgs: &inventory.PaginationArgs{
+				Page:     page,
+				PageSize: aiTaggingPolicyPageSize,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range res.Policies {
+			if p.Settings != nil && p.Settings.AiTagging {
+				ids = append(ids, p.ID)
+			}
+		}
+
+		if len(res.Policies) < aiTaggingPolicyPageSize {
+			return ids, nil
+		}
+	}
+}
+
+// aiTagBatch tags a batch of images in one request. Images that cannot be read are marked as processed
+// without tags, so that they are not retried endlessly.
+func aiTagBatch(ctx context.Context, dep dependency.Dep, tagger aitag.Tagger, settings *setting.AiTagging, batch []*ent.File) error {
+	l := dep.Logger()
+	owners := make(map[int]*ent.User)
+	images := make([]aitag.Image, 0, len(batch))
+	for _, file := range batch {
+		data, err := aiTagImageData(ctx, dep, owners, settings, file)
+		if err != nil {
+			l.Debug("Skip AI tagging of file %d: %s", file.ID, err)
+			continue
+		}
+
+		images = append(images, aitag.Image{ID: strconv.Itoa(file.ID), Name: file.Name, Data: data})
+	}
+
+	labels := make(map[string][]aitag.Label)
+	if len(images) > 0 {
+		var err error
+		if labels, err = tagger.Tag(ctx, images); err != nil {
+			return err
+		}
+	}
+
+	fc := dep.FileClient()
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	for _, file := range batch {
+		existing := lo.SliceToMap(file.Edges.Metadata, func(m *ent.Metadata) (string, bool) {
+			return m.Name, true
+		})
+
+		metas := map[string]string{dbfs.MetadataAiTagged: now}
+		for _, name := range aitag.Select(labels[strconv.Itoa(file.ID)], settings.MinScore, settings.MaxTags) {
+			// Keep color of tags added by user.
+			if key := tagMetadataSuffix + ":" + name; !existing[key] {
+				metas[key] = ""
+			}
+		}
+
+		if err := fc.UpsertMetadata(ctx, file, metas, map[string]bool{dbfs.MetadataAiTagged: true}); err != nil {
+			l.Warning("Failed to save AI tags of file %d: %s", file.ID, err)
+		}
+	}
+
+	searchIndexFiles(ctx, dep, lo.Map(batch, func(file *ent.File, index int) int {
+		return file.ID
+	})...)
+
+	return nil
+}
+
+// aiTagImageData reads the image sent to inference endpoint, thumbnail is preferred to save bandwidth.
+func aiTagImageData(ctx context.Context, dep dependency.Dep, owners map[int]*ent.User, settings *setting.AiTagging, model *ent.File) ([]byte, error) {
+	owner, ok := owners[model.OwnerID]
+	if !ok {
+		var err error
+		if owner, err = dep.UserClient().GetLoginUserByID(ctx, model.OwnerID); err != nil {
+			return nil, fmt.Errorf("failed to get owner: %w", err)
+		}
+		owners[model.OwnerID] = owner
+	}
+
+	fm := NewFileManager(dep, owner)
+	defer fm.Recycle()
+
+	traversed, err := fm.TraverseFile(ctx, model.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to traverse file: %w", err)
+	}
+
+	uri := traversed.Uri(false)
+	es, err := fm.Thumbnail(ctx, uri)
+	if err != nil {
+		file, err := fm.Get(ctx, uri, dbfs.WithFileEntities())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get file: %w", err)
+		}
+
+		latest := file.PrimaryEntity()
+		if latest == nil || latest.Size() > settings.MaxSize {
+			return nil, fmt.Errorf("no thumbnail and image is too big: %w", fs.ErrFileSizeTooBig)
+		}
+
+		if es, err = fm.GetEntitySource(ctx, 0, fs.WithEntity(latest)); err != nil {
+			return nil, fmt.Errorf("failed to get entity source: %w", err)
+		}
+	}
+
+	defer es.Close()
+	data, err := io.ReadAll(io.LimitReader(es, settings.MaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+
+	if int64(len(data)) > settings.MaxSize {
+		return nil, fs.ErrFileSizeTooBig
+	}
+
+	return data, nil
+}
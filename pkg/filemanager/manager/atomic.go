@@ -0,0 +1,86 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+)
+
+type (
+	afterCommitCtx struct{}
+
+	// afterCommitQueue holds side effects, e.g. events, of operations in an atomic transaction.
+	afterCommitQueue struct {
+		funcs     []func()
+		committed bool
+		// afterTx are run once the transaction ends, also when it's rolled back.
+		afterTx []func()
+	}
+)
+
+// afterCommit runs f once the atomic transaction in ctx is committed, or right away outside of one.
+// f is dropped if the transaction is rolled back.
+func afterCommit(ctx context.Context, f func()) {
+	if q, ok := ctx.Value(afterCommitCtx{}).(*afterCommitQueue); ok && !q.committed {
+		q.funcs = append(q.funcs, f)
+		return
+	}
+
+	f()
+}
+
+func (m *manager) Atomic(ctx context.Context, f func(ctx context.Context, op FileOperation) error) error {
+	if m.stateless {
+		return fs.ErrNotSupportedAction.WithError(fmt.Errorf("atomic operations are not supported in stateless mode"))
+	}
+
+	if q, ok := ctx.Value(afterCommitCtx{}).(*afterCommitQueue); ok && !q.committed {
+		return fmt.Errorf("nested atomic operation is not supported")
+	}
+
+	_, tx, ctx, err := inventory.WithTx(ctx, m.dep.FileClient())
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	// A dedicated manager so that no file loaded outside the transaction is reused.
+	txm := NewFileManager(m.dep, m.user).(*manager)
+	defer txm.Recycle()
+
+	reset, err := txm.fs.FollowTx(ctx)
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return serializer.NewError(serializer.CodeDBError, "Failed to inherit transaction", err)
+	}
+	defer reset()
+
+	q := &afterCommitQueue{}
+	ctx = context.WithValue(ctx, afterCommitCtx{}, q)
+	ctx = context.WithValue(ctx, fs.AfterTxCtxKey{}, func(f func()) {
+		q.afterTx = append(q.afterTx, f)
+	})
+	defer func() {
+		for _, f := range q.afterTx {
+			f()
+		}
+	}()
+
+	if err := f(ctx, txm); err != nil {
+		_ = inventory.Rollback(tx)
+		return err
+	}
+
+	if err := inventory.CommitWithStorageDiff(ctx, tx, m.l, m.dep.UserClient()); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to commit transaction", err)
+	}
+
+	q.committed = true
+	for _, f := range q.funcs {
+		f()
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/automation"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+)
+
+// AutomationRuleMax returns the maximum number of automation rules of given user, 0 if automation is
+// not allowed for the group of user.
+func AutomationRuleMax(u *ent.User) int {
+	if u == nil || u.Edges.Group == nil || u.Edges.Group.Settings == nil {
+		return 0
+	}
+
+	return u.Edges.Group.Settings.AutomationRuleMax
+}
+
+// AutomationTarget returns the target of automation rules for given file.
+func AutomationTarget(file fs.File, hasher hashid.Encoder) *automation.Target {
+	return &automation.Target{
+		Uri:     file.Uri(false),
+		Size:    file.Size(),
+		Folder:  file.Type() == types.FileTypeFolder,
+		OwnerID: hashid.EncodeUserID(hasher, file.OwnerID()),
+	}
+}
+
+// runAutomation applies enabled rules of current user for the trigger on given file. Only files owned by
+// current user are processed. Failures are saved in rules and never returned, so that the operation
+// triggering them is not affected.
+func (m *manager) runAutomation(ctx context.Context, trigger string, file fs.File) {
+	if m.stateless || m.user == nil || inventory.IsAnonymousUser(m.user) || file.OwnerID() != m.user.ID {
+		return
+	}
+
+	limit := AutomationRuleMax(m.user)
+	if limit <= 0 {
+		return
+	}
+
+	ruleClient := m.dep.AutomationRuleClient()
+	rules, err := ruleClient.ListEnabled(ctx, m.user.ID, trigger)
+	if err != nil {
+		m.l.Warning("Failed to list automation rules of user %d: %s", m.user.ID, err)
+		return
+	}
+
+	// Rules exceeding the limit, e.g. after user is moved to another group, are ignored.
+	if len(rules) > limit {
+		rules = rules[:limit]
+	}
+
+	target := AutomationTarget(file, m.dep.HashIDEncoder())
+	for _, rule := range rules {
+		if !automation.Match(rule.Conditions, target) {
+			continue
+		}
+
+		now := time.Now()
+		lastErr := ""
+		if err := m.applyAutomationRule(ctx, rule, target, now); err != nil {
+			m.l.Warning("Failed to apply automation rule %d on %q: %s", rule.ID, target.Uri, err)
+			lastErr = err.Error()
+		}
+
+		if err := ruleClient.MarkTriggered(ctx, rule.ID, now, lastErr); err != nil {
+			m.l.Warning("Failed to save result of automation rule %d: %s", rule.ID, err)
+		}
+	}
+}
+
+// applyAutomationRule applies actions of the rule in order, target is updated once the file is moved.
+func (m *manager) applyAutomationRule(ctx context.Context, rule *ent.AutomationRule, target *automation.Target, now time.Time) error {
+	actions, err := automation.Plan(rule.Actions, target, now)
+	if err != nil {
+		return err
+	}
+
+	for _, action := range actions {
+		switch action.Type {
+		case types.AutomationActionMove, types.AutomationActionCopy:
+			dst, err := fs.NewUriFromString(action.Dst)
+			if err != nil {
+				return fmt.Errorf("invalid destination %q: %w", action.Dst, err)
+			}
+
+			if _, err := m.Create(ctx, dst, types.FileTypeFolder); err != nil {
+				return fmt.Errorf("failed to create destination %q: %w", action.Dst, err)
+			}
+
+			isCopy := action.Type == types.AutomationActionCopy
+			if err := m.MoveOrCopy(ctx, []*fs.URI{target.Uri}, dst, isCopy); err != nil {
+				return fmt.Errorf("failed to %s file to %q: %w", action.Type, action.Dst, err)
+			}
+
+			if !isCopy {
+				target.Uri = dst.Join(target.Uri.Name())
+			}
+		case types.AutomationActionTag:
+			if err := m.PatchMedata(ctx, []*fs.URI{target.Uri}, fs.MetadataPatch{
+				Key:   tagMetadataSuffix + ":" + action.Tag,
+				Value: action.TagColor,
+			}); err != nil {
+				return fmt.Errorf("failed to tag file: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const davAccountExpiryPageSize = 500
+
+func init() {
+	crontab.Register(setting.CronTypeDavAccountExpiry, CronDavAccountExpiry)
+}
+
+// CronDavAccountExpiry notifies owners of WebDAV accounts whose password is about to expire, and disables
+// accounts unused for longer than the idle timeout.
+func CronDavAccountExpiry(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	settings := dep.SettingProvider()
+	notifyBefore := settings.DavAccountExpiryNotifyBefore(ctx)
+	idleTimeout := settings.DavAccountIdleTimeout(ctx)
+
+	now := time.Now()
+	args := &inventory.ListDavAccountArgs{
+		PaginationArgs: &inventory.PaginationArgs{
+			UseCursorPagination: true,
+			PageSize:            davAccountExpiryPageSize,
+		},
+	}
+	for {
+		res, err := dep.DavAccountClient().List(ctx, args)
+		if err != nil {
+			l.Error("Failed to list dav accounts for expiry check: %s", err)
+			return
+		}
+
+		for _, account := range res.Accounts {
+			if account.DisabledAt != nil {
+				continue
+			}
+
+			if davAccountIdle(account, now, idleTimeout) {
+				disableDavAccount(ctx, dep, account)
+			} else if davAccountExpiring(account, now, notifyBefore) {
+				notifyDavAccountExpiring(ctx, dep, account)
+			}
+		}
+
+		if res.NextPageToken == "" {
+			break
+		}
+		args.PageToken = res.NextPageToken
+	}
+}
+
+// davAccountIdle returns whether the account is not used since longer than idle timeout, accounts never
+// used are counted from their creation.
+func davAccountIdle(account *ent.DavAccount, now time.Time, idleTimeout time.Duration) bool {
+	if idleTimeout <= 0 {
+		return false
+	}
+
+	lastUsed := account.CreatedAt
+	if account.LastUsedAt != nil {
+		lastUsed = *account.LastUsedAt
+	}
+
+	return now.Sub(lastUsed) > idleTimeout
+}
+
+// davAccountExpiring returns whether the password of account expires soon and the owner is not notified yet.
+func davAccountExpiring(account *ent.DavAccount, now time.Time, notifyBefore time.Duration) bool {
+	return account.ExpireAt != nil && account.ExpireAt.After(now) && account.ExpireAt.Sub(now) <= notifyBefore &&
+		(account.Props == nil || account.Props.ExpiryNotifiedAt == nil)
+}
+
+func disableDavAccount(ctx context.Context, dep dependency.Dep, account *ent.DavAccount) {
+	l := dep.Logger()
+	if err := dep.DavAccountClient().Disable(ctx, account.ID); err != nil {
+		l.Warning("Failed to disable unused dav account %d: %s", account.ID, err)
+		return
+	}
+
+	l.Info("Dav account %d of user %d is disabled for being unused.", account.ID, account.OwnerID)
+	if _, err := dep.NotificationClient().Create(ctx, account.OwnerID, &inventory.NotificationArgs{
+		Type: types.NotificationTypeDavAccountDisabled,
+		Props: map[string]string{
+			"name": account.Name,
+		},
+	}); err != nil {
+		l.Warning("Failed to create dav account disabled notification for user %d: %s", account.OwnerID, err)
+	}
+}
+
+func notifyDavAccountExpiring(ctx context.Context, dep dependency.Dep, account *ent.DavAccount) {
+	l := dep.Logger()
+	if _, err := dep.NotificationClient().Create(ctx, account.OwnerID, &inventory.NotificationArgs{
+		Type: types.NotificationTypeDavAccountExpiring,
+		Props: map[string]string{
+			"name":       account.Name,
+			"expires_at": account.ExpireAt.UTC().Format(time.RFC3339),
+		},
+	}); err != nil {
+		l.Warning("Failed to create dav account expiring notification for user %d: %s", account.OwnerID, err)
+		return
+	}
+
+	props := &types.DavAccountProps{}
+	if account.Props != nil {
+		*props = *account.Props
+	}
+	now := time.Now()
+	props.ExpiryNotifiedAt = &now
+	if err := dep.DavAccountClient().UpdateProps(ctx, account.ID, props); err != nil {
+		l.Warning("Failed to save expiry notification state of dav account %d: %s", account.ID, err)
+	}
+}
@@ -0,0 +1,101 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+var (
+	// ErrEmailChangeConflict is returned when the new address is taken by another user before the change
+	// takes effect, the pending change is dropped.
+	ErrEmailChangeConflict = errors.New("email already in use")
+	errEmailChangeNotDue   = errors.New("email change is not due")
+)
+
+func init() {
+	crontab.Register(setting.CronTypeEmailChange, CronApplyEmailChanges)
+}
+
+// CronApplyEmailChanges applies confirmed email changes whose veto window has passed.
+func CronApplyEmailChanges(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	uc := dep.UserClient()
+
+	due, err := uc.ListEmailChangeDue(ctx, time.Now())
+	if err != nil {
+		l.Error("Failed to list users for email change: %s", err)
+		return
+	}
+
+	for _, uid := range due {
+		u, err := ApplyEmailChange(ctx, uid)
+		if err != nil {
+			l.Warning("Failed to apply email change of user %d: %s", uid, err)
+			continue
+		}
+
+		if u != nil {
+			l.Info("Email of user %d changed after veto window.", uid)
+		}
+	}
+}
+
+// ApplyEmailChange switches email of given user to the confirmed pending address if its veto window has
+// passed. Returns nil user if there's no due email change.
+func ApplyEmailChange(ctx context.Context, uid int) (*ent.User, error) {
+	dep := dependency.FromContext(ctx)
+	uc, tx, ctx, err := inventory.WithTx(ctx, dep.UserClient())
+	if err != nil {
+		return nil, err
+	}
+
+	newEmail := ""
+	u, err := uc.UpdateSettings(ctx, uid, func(locked *ent.User) error {
+		if !emailChangeDue(locked, time.Now()) {
+			return errEmailChangeNotDue
+		}
+
+		newEmail = locked.Settings.PendingEmail.Email
+		locked.Settings.PendingEmail = nil
+		return nil
+	})
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		if errors.Is(err, errEmailChangeNotDue) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if existed, err := uc.GetByEmail(ctx, newEmail); err == nil && existed.ID != uid {
+		// Keep the dropped pending change so that it won't be retried.
+		if err := inventory.Commit(tx); err != nil {
+			return nil, err
+		}
+
+		return nil, ErrEmailChangeConflict
+	}
+
+	u, err = uc.UpdateEmail(ctx, u, newEmail)
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, err
+	}
+
+	return u, inventory.Commit(tx)
+}
+
+// emailChangeDue returns true if user has a confirmed email change whose veto window has passed.
+func emailChangeDue(u *ent.User, now time.Time) bool {
+	p := u.Settings.PendingEmail
+	return p != nil && p.EffectiveAt != nil && !p.EffectiveAt.After(now)
+}
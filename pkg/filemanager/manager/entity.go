@@ -9,13 +9,18 @@ import (
 
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/grouppolicy"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/textpreview"
+	"github.com/cloudreve/Cloudreve/v4/pkg/thumb"
 	"github.com/gofrs/uuid"
 	"github.com/samber/lo"
 )
@@ -32,6 +37,23 @@ type (
 		GetEntitySource(ctx context.Context, entityID int, opts ...fs.Option) (entitysource.EntitySource, error)
 		// Thumbnail gets thumbnail entity of given file
 		Thumbnail(ctx context.Context, uri *fs.URI) (entitysource.EntitySource, error)
+		// ThumbnailOrQueue gets thumbnail entity of given file without waiting for generation, ErrThumbnailPending
+		// is returned if it is being generated. ctx is used after the request is finished, it must not be a gin.Context.
+		ThumbnailOrQueue(ctx context.Context, uri *fs.URI) (entitysource.EntitySource, error)
+		// Preview gets preview rendition of given page of the file
+		Preview(ctx context.Context, uri *fs.URI, page int) (*Rendition, error)
+		// TextPreview gets the beginning of given text file decoded into UTF-8
+		TextPreview(ctx context.Context, uri *fs.URI) (*textpreview.Result, error)
+		// Waveform gets waveform peak data of given audio file
+		Waveform(ctx context.Context, uri *fs.URI) (*thumb.Waveform, error)
+		// VideoSprite gets sprite sheet layout of given video file
+		VideoSprite(ctx context.Context, uri *fs.URI) (*thumb.SpriteSheet, error)
+		// VideoSpriteImage gets sprite sheet image of given video file
+		VideoSpriteImage(ctx context.Context, uri *fs.URI) (*Rendition, error)
+		// Subtitles lists subtitle sidecars of given video file
+		Subtitles(ctx context.Context, uri *fs.URI) ([]SubtitleTrack, error)
+		// Subtitle gets content of given subtitle file, converted into WebVTT if it is SRT
+		Subtitle(ctx context.Context, uri *fs.URI) (*Rendition, error)
 		// SubmitAndAwaitThumbnailTask submits a thumbnail task and waits for result
 		SubmitAndAwaitThumbnailTask(ctx context.Context, uri *fs.URI, ext string, entity fs.Entity) (fs.Entity, error)
 		// SetCurrentVersion sets current version of given file
@@ -46,6 +68,8 @@ type (
 		ListPhysical(ctx context.Context, path string, policyID int, recursive bool, progress driver.ListProgressFunc) ([]fs.PhysicalObject, error)
 		// ImportPhysical imports a physical file to a Cloudreve file
 		ImportPhysical(ctx context.Context, dst *fs.URI, policyId int, src fs.PhysicalObject, completeHook bool) error
+		// ScanOrphans reconciles blobs of given storage policy with entities, optionally deleting orphan blobs
+		ScanOrphans(ctx context.Context, policyID int, cleanup bool) (*OrphanReport, error)
 	}
 	DirectLink struct {
 		File fs.File
@@ -221,6 +245,7 @@ func (m *manager) GetEntityUrls(ctx context.Context, args []GetEntityUrlArgs, op
 		file, err := m.fs.Get(
 			ctx, arg.URI,
 			dbfs.WithFileEntities(),
+			dbfs.WithFilePublicMetadata(),
 			dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityDownloadFile),
 		)
 		if err != nil {
@@ -233,6 +258,34 @@ func (m *manager) GetEntityUrls(ctx context.Context, args []GetEntityUrlArgs, op
 			continue
 		}
 
+		// Web links are opened through a page on this site showing their target, so that shared links
+		// cannot silently redirect visitors to arbitrary sites.
+		if link := LinkUrl(file); link != "" && arg.PreferredEntityID == "" {
+			if err := ValidateLinkUrl(link); err != nil {
+				ae.Add(arg.URI.String(), fs.ErrEntityNotExist.WithError(err))
+				continue
+			}
+
+			expire := o.Expire
+			if expire == nil {
+				t := time.Now().Add(m.settings.EntityUrlValidDuration(ctx))
+				expire = &t
+			}
+
+			linkUrl := routes.MasterLinkUrl(m.settings.SiteURL(ctx), hashid.EncodeFileID(m.hasher, file.ID()))
+			signed, err := auth.SignURI(ctx, m.auth, linkUrl.String(), expire)
+			if err != nil {
+				ae.Add(arg.URI.String(), err)
+				continue
+			}
+
+			if earliestExpireAt == nil || expire.Before(*earliestExpireAt) {
+				earliestExpireAt = expire
+			}
+			res[i] = EntityUrl{Url: signed.String()}
+			continue
+		}
+
 		var (
 			target fs.Entity
 			found  bool
@@ -279,7 +332,14 @@ func (m *manager) GetEntityUrls(ctx context.Context, args []GetEntityUrlArgs, op
 			continue
 		}
 
-		// Cache miss, Generate new url
+		// Cache miss, the download is accounted once per issued URL, cached URLs are not counted again.
+		policyReq := &grouppolicy.Request{Action: types.GroupPolicyActionDownload, Size: target.Size()}
+		if err := m.dep.GroupPolicyChecker().Consume(ctx, m.user, policyReq); err != nil {
+			ae.Add(arg.URI.String(), err)
+			continue
+		}
+
+		// Generate new url
 		source := entitysource.NewEntitySource(target, d, policy, m.auth, m.settings, m.hasher, m.dep.RequestClient(),
 			m.l, m.config, m.dep.MimeDetector(ctx))
 		downloadUrl, err := source.Url(ctx,
@@ -289,10 +349,16 @@ func (m *manager) GetEntityUrls(ctx context.Context, args []GetEntityUrlArgs, op
 			entitysource.WithDisplayName(getEntityDisplayName(file, target)),
 		)
 		if err != nil {
+			m.dep.GroupPolicyChecker().Release(ctx, m.user, policyReq)
 			ae.Add(arg.URI.String(), err)
 			continue
 		}
 
+		edgeUrl := m.edgeCacheUrl(ctx, policy, source, target, getEntityDisplayName(file, target), o)
+		if edgeUrl != nil {
+			downloadUrl = edgeUrl
+		}
+
 		// Find the earliest expiry time
 		if downloadUrl.ExpireAt != nil && (earliestExpireAt == nil || downloadUrl.ExpireAt.Before(*earliestExpireAt)) {
 			earliestExpireAt = downloadUrl.ExpireAt
@@ -310,7 +376,7 @@ func (m *manager) GetEntityUrls(ctx context.Context, args []GetEntityUrlArgs, op
 		res[i] = EntityUrl{
 			Url: downloadUrl.Url,
 		}
-		if d.Capabilities().BrowserRelayedDownload {
+		if d.Capabilities().BrowserRelayedDownload && edgeUrl == nil {
 			res[i].BrowserDownloadDisplayName = getEntityDisplayName(file, target)
 		}
 	}
@@ -401,9 +467,66 @@ func (l *manager) ImportPhysical(ctx context.Context, dst *fs.URI, policyId int,
 		l.onNewEntityUploaded(ctx, uploadSession, d)
 	}
 
+	if len(src.Metadata) > 0 {
+		patches := lo.MapToSlice(src.Metadata, func(key, value string) fs.MetadataPatch {
+			return fs.MetadataPatch{Key: key, Value: value}
+		})
+		if err := l.fs.PatchMetadata(ctx, []*fs.URI{targetUri}, patches...); err != nil {
+			return fmt.Errorf("failed to patch metadata: %w", err)
+		}
+	}
+
 	return nil
 }
 
+// edgeCacheUrl returns URL to download the entity through a slave node caching hot entities, if enabled
+// by storage policy. It returns nil if the entity should be downloaded from origin.
+func (m *manager) edgeCacheUrl(ctx context.Context, policy *ent.StoragePolicy, source entitysource.EntitySource,
+	target fs.Entity, displayName string, o *fs.FsOption) *entitysource.EntityUrl {
+	if policy.Settings == nil || !policy.Settings.EdgeCacheNode || target.Size() == 0 {
+		return nil
+	}
+
+	np, err := m.dep.NodePool(ctx)
+	if err != nil {
+		return nil
+	}
+
+	node, err := np.Get(ctx, types.NodeCapabilityEdgeCache, 0)
+	if err != nil || node.IsMaster() {
+		return nil
+	}
+
+	// Speed limit and content disposition are applied by the edge node.
+	origin, err := source.Url(ctx,
+		entitysource.WithDownload(false),
+		entitysource.WithSpeedLimit(0),
+	)
+	if err != nil {
+		m.l.Warning("Failed to get origin URL for edge node %q: %s", node.Name(), err)
+		return nil
+	}
+
+	edgeUrl, err := node.EdgeCacheUrl(ctx, &cluster.EdgeCacheUrlArgs{
+		Key:      fmt.Sprintf("%s_%d", m.settings.SiteBasic(ctx).ID, target.ID()),
+		Size:     target.Size(),
+		Origin:   origin.Url,
+		Name:     displayName,
+		Download: o.IsDownload,
+		Speed:    o.DownloadSpeed,
+		Expire:   origin.ExpireAt,
+	})
+	if err != nil {
+		m.l.Warning("Failed to get edge cache URL from node %q: %s", node.Name(), err)
+		return nil
+	}
+
+	return &entitysource.EntityUrl{
+		Url:      edgeUrl,
+		ExpireAt: origin.ExpireAt,
+	}
+}
+
 func entityUrlCacheKey(id int, speed int64, displayName string, download bool, siteUrl string) string {
 	hash := sha1.New()
 	hash.Write([]byte(fmt.Sprintf("%d_%d_%s_%t_%s", id,
@@ -0,0 +1,128 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const (
+	folderSizeReconcilePageSize = 500
+	folderSizeReconcileLockKey  = "folder_size_reconcile_lock"
+	// folderSizeReconcileLockTTL is the maximum time a reconciliation can hold the lock, in seconds.
+	folderSizeReconcileLockTTL = 6 * 3600
+	// folderSizeReconciledSetting marks whether folder sizes have ever been reconciled.
+	folderSizeReconciledSetting = "folder_size_reconciled"
+)
+
+func init() {
+	crontab.Register(setting.CronTypeFolderSize, CronReconcileFolderSizes)
+}
+
+// CronReconcileFolderSizes recalculates cached folder sizes of all users, fixing drifts of incremental
+// maintenance, e.g. from interrupted operations or files migrated from v3.
+func CronReconcileFolderSizes(ctx context.Context) {
+	if _, err := reconcileFolderSizesWithLock(ctx); err != nil {
+		dependency.FromContext(ctx).Logger().Error("Failed to reconcile folder sizes: %s", err)
+	}
+}
+
+// ReconcileFolderSizesOnce reconciles cached folder sizes of all users if it has never been done, so that
+// folders created before sizes are cached do not report zero sizes until the first scheduled run.
+func ReconcileFolderSizesOnce(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	settingClient := dep.SettingClient()
+
+	reconciled, err := settingClient.Get(ctx, folderSizeReconciledSetting)
+	if err != nil {
+		l.Warning("Failed to get %q setting: %s", folderSizeReconciledSetting, err)
+		return
+	}
+
+	if reconciled == "1" {
+		return
+	}
+
+	l.Info("Reconciling folder sizes for the first time...")
+	done, err := reconcileFolderSizesWithLock(ctx)
+	if err != nil {
+		l.Error("Failed to reconcile folder sizes: %s", err)
+		return
+	}
+
+	if !done {
+		return
+	}
+
+	if err := settingClient.Set(ctx, map[string]string{folderSizeReconciledSetting: "1"}); err != nil {
+		l.Warning("Failed to save %q setting: %s", folderSizeReconciledSetting, err)
+	}
+}
+
+// reconcileFolderSizesWithLock reconciles folder sizes of all users unless another reconciliation is running,
+// returns whether all users are reconciled.
+func reconcileFolderSizesWithLock(ctx context.Context) (bool, error) {
+	dep := dependency.FromContext(ctx)
+	kv := dep.KV()
+	locked, err := kv.SetNX(folderSizeReconcileLockKey, true, folderSizeReconcileLockTTL)
+	if err != nil {
+		return false, fmt.Errorf("failed to lock folder size reconciliation: %w", err)
+	}
+	if !locked {
+		dep.Logger().Info("Folder size reconciliation is already running, skipped.")
+		return false, nil
+	}
+	defer kv.Delete("", folderSizeReconcileLockKey)
+
+	return reconcileFolderSizes(ctx)
+}
+
+func reconcileFolderSizes(ctx context.Context) (bool, error) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	userClient := dep.UserClient()
+	fileClient := dep.FileClient()
+
+	checked, fixed, failed := 0, 0, 0
+	for page := 0; ; page++ {
+		res, err := userClient.ListUsers(ctx, &inventory.ListUserParameters{
+			PaginationArgs: &inventory.PaginationArgs{
+				Page:     page,
+				PageSize: folderSizeReconcilePageSize,
+			},
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to list users: %w", err)
+		}
+
+		for _, u := range res.Users {
+			select {
+			case <-ctx.Done():
+				return false, ctx.Err()
+			default:
+			}
+
+			c, f, err := fileClient.ReconcileFolderSizes(ctx, u.ID)
+			if err != nil {
+				l.Warning("Failed to reconcile folder sizes of user %d: %s", u.ID, err)
+				failed++
+				continue
+			}
+
+			checked += c
+			fixed += f
+		}
+
+		if len(res.Users) < folderSizeReconcilePageSize {
+			break
+		}
+	}
+
+	l.Info("Reconciled sizes of %d folders, %d of them are fixed.", checked, fixed)
+	return failed == 0, nil
+}
@@ -62,6 +62,15 @@ func (m *manager) CastStoragePolicyOnSlave(ctx context.Context, policy *ent.Stor
 }
 
 func (m *manager) GetStorageDriver(ctx context.Context, policy *ent.StoragePolicy) (driver.Handler, error) {
+	d, err := m.newStorageDriver(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return driver.WithTracing(d, policy.Type), nil
+}
+
+func (m *manager) newStorageDriver(ctx context.Context, policy *ent.StoragePolicy) (driver.Handler, error) {
 	switch policy.Type {
 	case types.PolicyTypeLocal:
 		return local.New(policy, m.l, m.config), nil
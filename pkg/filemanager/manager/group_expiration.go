@@ -0,0 +1,162 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/email"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+var errGroupExpirationChanged = errors.New("group membership changed")
+
+func init() {
+	crontab.Register(setting.CronTypeGroupExpiration, CronGroupExpiration)
+}
+
+// CronGroupExpiration notifies users whose time-limited group membership is about to expire, and moves
+// expired users back to their fallback group.
+func CronGroupExpiration(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	uc := dep.UserClient()
+	notifyBefore := dep.SettingProvider().GroupExpirationNotifyBefore(ctx)
+
+	now := time.Now()
+	ctx = context.WithValue(ctx, inventory.LoadUserGroup{}, true)
+	pending, err := uc.ListGroupExpiring(ctx, now.Add(notifyBefore))
+	if err != nil {
+		l.Error("Failed to list users for group expiration: %s", err)
+		return
+	}
+
+	for _, u := range pending {
+		if u.Settings == nil || u.Settings.GroupExpiration == nil {
+			continue
+		}
+
+		expiration := u.Settings.GroupExpiration
+		if expiration.ExpiresAt.Before(now) {
+			expireGroupMembership(ctx, dep, u)
+		} else if expiration.NotifiedAt == nil && expiration.ExpiresAt.Sub(now) <= notifyBefore {
+			notifyGroupExpiration(ctx, dep, u)
+		}
+	}
+}
+
+// expireGroupMembership moves user to the fallback group, or the default group if the fallback one no
+// longer exists, and re-evaluates storage quota under the new group.
+func expireGroupMembership(ctx context.Context, dep dependency.Dep, u *ent.User) {
+	l := dep.Logger()
+	uc := dep.UserClient()
+
+	fallback := u.Settings.GroupExpiration.FallbackGroup
+	if _, err := dep.GroupClient().GetByID(ctx, fallback); err != nil {
+		l.Warning("Fallback group %d of user %d not found, using default group: %s", fallback, u.ID, err)
+		fallback = dep.SettingProvider().DefaultGroup(ctx)
+	}
+
+	txUc, tx, txCtx, err := inventory.WithTx(ctx, uc)
+	if err != nil {
+		l.Error("Failed to start transaction: %s", err)
+		return
+	}
+
+	// Membership might be changed by admin since the user is listed, re-check it on the latest settings.
+	locked, err := txUc.UpdateSettings(txCtx, u.ID, func(locked *ent.User) error {
+		if !sameGroupExpiration(locked, u) {
+			return errGroupExpirationChanged
+		}
+
+		locked.Settings.GroupExpiration = nil
+		return nil
+	})
+	if err == nil {
+		_, err = txUc.SetGroup(txCtx, locked, fallback)
+	}
+
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		if errors.Is(err, errGroupExpirationChanged) {
+			return
+		}
+
+		l.Error("Failed to revert group of user %d: %s", u.ID, err)
+		return
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		l.Error("Failed to commit group change of user %d: %s", u.ID, err)
+		return
+	}
+
+	l.Info("Group membership of user %d expired, moved to group %d.", u.ID, fallback)
+	if _, err := uc.CalculateStorage(ctx, u.ID); err != nil {
+		l.Warning("Failed to recalculate storage of user %d: %s", u.ID, err)
+	}
+
+	conf := dep.SettingProvider().QuotaAlert(ctx)
+	if !conf.Enabled || len(conf.Thresholds) == 0 {
+		return
+	}
+
+	reloaded, err := uc.GetByID(ctx, u.ID)
+	if err != nil {
+		l.Warning("Failed to reload user %d: %s", u.ID, err)
+		return
+	}
+
+	checkQuotaAlert(ctx, dep, conf, reloaded, storageUsagePercent(reloaded))
+}
+
+// notifyGroupExpiration sends notice of upcoming expiration to the user.
+func notifyGroupExpiration(ctx context.Context, dep dependency.Dep, u *ent.User) {
+	l := dep.Logger()
+	expiration := u.Settings.GroupExpiration
+
+	fallbackName := ""
+	if fallback, err := dep.GroupClient().GetByID(ctx, expiration.FallbackGroup); err == nil {
+		fallbackName = fallback.Name
+	}
+
+	groupName := ""
+	if u.Edges.Group != nil {
+		groupName = u.Edges.Group.Name
+	}
+
+	title, body, err := email.NewGroupExpirationEmail(ctx, dep.SettingProvider(), u, groupName, fallbackName, expiration.ExpiresAt)
+	if err != nil {
+		l.Warning("Failed to generate group expiration email: %s", err)
+		return
+	}
+
+	if err := dep.EmailClient(ctx).Send(ctx, u.Email, title, body); err != nil {
+		l.Warning("Failed to send group expiration email to %q: %s", u.Email, err)
+		return
+	}
+
+	now := time.Now()
+	_, err = dep.UserClient().UpdateSettings(ctx, u.ID, func(locked *ent.User) error {
+		if !sameGroupExpiration(locked, u) {
+			return errGroupExpirationChanged
+		}
+
+		locked.Settings.GroupExpiration.NotifiedAt = &now
+		return nil
+	})
+	if err != nil && !errors.Is(err, errGroupExpirationChanged) {
+		l.Warning("Failed to save group expiration state of user %d: %s", u.ID, err)
+	}
+}
+
+// sameGroupExpiration returns true if the time-limited membership of latest user record is still the
+// one being processed.
+func sameGroupExpiration(latest, processing *ent.User) bool {
+	a, b := latest.Settings.GroupExpiration, processing.Settings.GroupExpiration
+	return a != nil && b != nil && latest.GroupUsers == processing.GroupUsers && a.ExpiresAt.Equal(b.ExpiresAt)
+}
@@ -0,0 +1,55 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+)
+
+// LegalHoldOpPurgeUser is the operation recorded when deletion of a held user is blocked.
+const LegalHoldOpPurgeUser = "purge_user"
+
+// IsUserLegalHeld returns whether the user or any of its files is under legal hold.
+func IsUserLegalHeld(ctx context.Context, dep dependency.Dep, uid int) (bool, error) {
+	u, err := dep.UserClient().GetByID(ctx, uid)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if u.Settings != nil && u.Settings.LegalHold != nil {
+		return true, nil
+	}
+
+	held, err := dep.FileClient().ListIDsByMetadata(ctx, uid, dbfs.MetadataLegalHold)
+	if err != nil {
+		return false, fmt.Errorf("failed to list held files: %w", err)
+	}
+
+	return len(held) > 0, nil
+}
+
+// checkPurgeLegalHold returns fs.ErrLegalHold if user uid is under legal hold, blocked purges are
+// recorded in audit log.
+func checkPurgeLegalHold(ctx context.Context, dep dependency.Dep, uid int) error {
+	held, err := IsUserLegalHeld(ctx, dep, uid)
+	if err != nil {
+		return fmt.Errorf("failed to check legal hold: %w", err)
+	}
+
+	if !held {
+		return nil
+	}
+
+	event := audit.NewEvent(ctx, audit.EventLegalHoldBlocked, uid, 0, map[string]string{
+		"operation": LegalHoldOpPurgeUser,
+	}).WithEntity(audit.EntityUser, uid)
+	if err := dep.AuditRecorder().Record(ctx, event); err != nil {
+		dep.Logger().Warning("Failed to record legal hold audit event: %s", err)
+	}
+
+	return fs.ErrLegalHold
+}
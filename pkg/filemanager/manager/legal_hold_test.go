@@ -0,0 +1,169 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	_ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPurgeUser_LegalHold(t *testing.T) {
+	a := assert.New(t)
+	drv, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	encoder, err := hashid.New("test-salt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expired := time.Now().Add(-time.Hour)
+	hold := &types.LegalHold{At: expired, By: 1, Reason: "litigation"}
+	group := client.Group.Create().SetName("users").SetPermissions(&boolset.BooleanSet{}).SaveX(ctx)
+	newUser := func(email string, status user.Status, settings *types.UserSetting) *ent.User {
+		return client.User.Create().SetEmail(email).SetNick(email).SetGroupUsers(group.ID).
+			SetStatus(status).SetSettings(settings).SaveX(ctx)
+	}
+
+	// Archived users, one held on user level
+	archived := newUser("archived@cloudreve.org", user.StatusArchived,
+		&types.UserSetting{Offboarding: &types.Offboarding{DeleteAt: &expired}})
+	heldArchived := newUser("held-archived@cloudreve.org", user.StatusArchived,
+		&types.UserSetting{Offboarding: &types.Offboarding{DeleteAt: &expired}, LegalHold: hold})
+
+	// Users pending self-service deletion, one with a held file
+	deleted := newUser("deleted@cloudreve.org", user.StatusSysBanned,
+		&types.UserSetting{Deletion: &types.AccountDeletion{PurgeAt: expired}})
+	heldDeleted := newUser("held-deleted@cloudreve.org", user.StatusSysBanned,
+		&types.UserSetting{Deletion: &types.AccountDeletion{PurgeAt: expired}})
+	heldFile := client.File.Create().SetType(int(types.FileTypeFile)).SetName("evidence.txt").SetOwnerID(heldDeleted.ID).SaveX(ctx)
+	client.Metadata.Create().SetFileID(heldFile.ID).SetName(dbfs.MetadataLegalHold).SetValue("{}").SaveX(ctx)
+
+	ctx = context.WithValue(ctx, dependency.DepCtx{}, dependency.NewDependency(
+		dependency.WithDbClient(client),
+		dependency.WithHashIDEncoder(encoder),
+		dependency.WithConfigPath(filepath.Join(t.TempDir(), "conf.ini")),
+		dependency.WithLogger(logging.NewConsoleLogger(logging.LevelError)),
+	))
+
+	CronCollectOffboardedUsers(ctx)
+	CronPurgeDeletedAccounts(ctx)
+
+	exists := func(u *ent.User) bool {
+		return client.User.Query().Where(user.ID(u.ID)).ExistX(context.Background())
+	}
+	a.False(exists(archived))
+	a.False(exists(deleted))
+	a.True(exists(heldArchived))
+	a.True(exists(heldDeleted))
+	a.True(client.File.Query().ExistX(context.Background()))
+
+	// Blocked purges are audited
+	logs := client.AuditLog.Query().AllX(context.Background())
+	if a.Len(logs, 2) {
+		a.ElementsMatch([]int{heldArchived.ID, heldDeleted.ID}, []int{logs[0].UserID, logs[1].UserID})
+	}
+}
+
+func TestDelete_LegalHold(t *testing.T) {
+	a := assert.New(t)
+	dep, u, root := newTestUser(t)
+	ctx := context.WithValue(context.Background(), dependency.DepCtx{}, dep)
+	ctx = context.WithValue(ctx, inventory.UserCtx{}, u)
+
+	db := dep.DBClient()
+	heldFolder := db.File.Create().SetType(int(types.FileTypeFolder)).SetName("held").SetOwnerID(u.ID).SetParent(root).SaveX(ctx)
+	db.Metadata.Create().SetFileID(heldFolder.ID).SetName(dbfs.MetadataLegalHold).SetValue("{}").SaveX(ctx)
+	db.File.Create().SetType(int(types.FileTypeFile)).SetName("a.txt").SetOwnerID(u.ID).SetParent(heldFolder).SaveX(ctx)
+	db.File.Create().SetType(int(types.FileTypeFile)).SetName("b.txt").SetOwnerID(u.ID).SetParent(root).SaveX(ctx)
+
+	m := NewFileManager(dep, u)
+	defer m.Recycle()
+	del := func(path string) int {
+		uri, err := fs.NewUriFromString("cloudreve://my/" + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return errCode(ctx, m.Delete(ctx, []*fs.URI{uri}, fs.WithSkipSoftDelete(true)))
+	}
+
+	// Files in held folders are held too
+	a.Equal(serializer.CodeLegalHold, del("held/a.txt"))
+	a.Equal(serializer.CodeLegalHold, del("held"))
+	a.Equal(0, del("b.txt"))
+}
+
+func TestAtomic_LegalHoldAudit(t *testing.T) {
+	a := assert.New(t)
+	dep, u, root := newTestUser(t)
+	ctx := context.WithValue(context.Background(), dependency.DepCtx{}, dep)
+	ctx = context.WithValue(ctx, inventory.UserCtx{}, u)
+
+	db := dep.DBClient()
+	db.File.Create().SetType(int(types.FileTypeFile)).SetName("a.txt").SetOwnerID(u.ID).SetParent(root).SaveX(ctx)
+	held := db.File.Create().SetType(int(types.FileTypeFile)).SetName("held.txt").SetOwnerID(u.ID).SetParent(root).SaveX(ctx)
+	db.Metadata.Create().SetFileID(held.ID).SetName(dbfs.MetadataLegalHold).SetValue("{}").SaveX(ctx)
+
+	uri := func(name string) *fs.URI {
+		res, err := fs.NewUriFromString("cloudreve://my/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	m := NewFileManager(dep, u)
+	defer m.Recycle()
+	err := m.Atomic(ctx, func(ctx context.Context, op FileOperation) error {
+		if _, err := op.Rename(ctx, uri("a.txt"), "b.txt"); err != nil {
+			return err
+		}
+		return op.Delete(ctx, []*fs.URI{uri("held.txt")})
+	})
+	a.Equal(serializer.CodeLegalHold, errCode(ctx, err))
+
+	// Batch is rolled back, the blocked attempt is still recorded once the transaction ends
+	a.Equal(1, db.AuditLog.Query().CountX(ctx))
+	names := db.File.Query().Select("name").StringsX(ctx)
+	a.Contains(names, "a.txt")
+	a.NotContains(names, "b.txt")
+}
+
+// errCode returns code of given error, or of the first failed operation if it's aggregated.
+func errCode(ctx context.Context, err error) int {
+	var aggregated *serializer.AggregateError
+	if errors.As(err, &aggregated) {
+		for _, err := range aggregated.Raw() {
+			return serializer.Err(ctx, err).Code
+		}
+	}
+	if err != nil {
+		return serializer.Err(ctx, err).Code
+	}
+	return 0
+}
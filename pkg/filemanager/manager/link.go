@@ -0,0 +1,84 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+)
+
+const (
+	// LinkFileExt is the extension of web link files, appended to the name if missing.
+	LinkFileExt = "url"
+	// maxLinkUrlLen is the maximum length of link target and favicon URL.
+	maxLinkUrlLen = 4096
+)
+
+// CreateLink creates a web link file. Link files have no content, the target URL is stored in system metadata
+// so that it cannot be changed by metadata patches, and is returned as the file URL to open it.
+func (m *manager) CreateLink(ctx context.Context, path *fs.URI, target, favicon string, opts ...fs.Option) (fs.File, error) {
+	if m.stateless {
+		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("link is not supported in stateless mode"))
+	}
+
+	if err := ValidateLinkUrl(target); err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid link URL", err)
+	}
+
+	metadata := map[string]string{dbfs.MetadataLinkUrl: target}
+	if favicon != "" {
+		if err := ValidateLinkUrl(favicon); err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid favicon URL", err)
+		}
+		metadata[dbfs.MetadataLinkFavicon] = favicon
+	}
+
+	if util.Ext(path.Name()) != LinkFileExt {
+		path = path.DirUri().Join(path.Name() + "." + LinkFileExt)
+	}
+
+	// Existing files are never turned into links.
+	opts = append(opts, fs.WithMetadata(metadata), dbfs.WithErrorOnConflict())
+	file, err := m.fs.Create(ctx, path, types.FileTypeFile, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	m.publishCreateEvent(ctx, file)
+	searchIndexFiles(ctx, m.dep, file.ID())
+	return file, nil
+}
+
+// LinkUrl returns target URL of a web link file, empty if given file is not a link. Public metadata of the
+// file must be loaded.
+func LinkUrl(file fs.File) string {
+	// Links overwritten by uploaded content are served as regular files.
+	if file.Type() != types.FileTypeFile || file.PrimaryEntityID() != 0 {
+		return ""
+	}
+
+	return file.Metadata()[dbfs.MetadataLinkUrl]
+}
+
+// ValidateLinkUrl checks that raw is an absolute http or https URL.
+func ValidateLinkUrl(raw string) error {
+	if len(raw) > maxLinkUrlLen {
+		return fmt.Errorf("URL exceeds %d bytes", maxLinkUrlLen)
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("only absolute http or https URLs are allowed")
+	}
+
+	return nil
+}
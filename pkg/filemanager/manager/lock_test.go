@@ -0,0 +1,55 @@
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/lock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileLocks(t *testing.T) {
+	a := assert.New(t)
+	dep, u, root := newTestUser(t)
+	ctx := context.WithValue(context.Background(), dependency.DepCtx{}, dep)
+	ctx = context.WithValue(ctx, inventory.UserCtx{}, u)
+	dep.DBClient().File.Create().SetType(int(types.FileTypeFile)).SetName("a.docx").SetOwnerID(u.ID).SetParent(root).SaveX(ctx)
+	dep.DBClient().File.Create().SetType(int(types.FileTypeFile)).SetName("b.docx").SetOwnerID(u.ID).SetParent(root).SaveX(ctx)
+
+	m := NewFileManager(dep, u)
+	defer m.Recycle()
+	get := func(name string) fs.File {
+		uri, err := fs.NewUriFromString("cloudreve://my/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := m.Get(ctx, uri)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return f
+	}
+
+	a.Empty(m.FileLocks(ctx, get("a.docx")))
+
+	app := lock.Application{Type: string(fs.ApplicationViewer), ViewerID: "office"}
+	_, err := m.Lock(ctx, time.Minute, u, true, app, get("a.docx").Uri(false), "wopi-lock")
+	a.NoError(err)
+
+	// Reading locks does not acquire one, the same locks are returned repeatedly
+	for i := 0; i < 2; i++ {
+		locks := m.FileLocks(ctx, get("a.docx"))
+		if a.Len(locks, 1) {
+			a.Equal("wopi-lock", locks[0].Token)
+		}
+	}
+	a.Empty(m.FileLocks(ctx, get("b.docx")))
+	all, err := m.ListLocks(ctx)
+	a.NoError(err)
+	a.Len(all, 1)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/search"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
 )
@@ -39,12 +40,16 @@ type (
 		List(ctx context.Context, path *fs.URI, args *ListArgs) (fs.File, *fs.ListFileResult, error)
 		// Create creates a file or directory
 		Create(ctx context.Context, path *fs.URI, fileType types.FileType, opt ...fs.Option) (fs.File, error)
+		// CreateLink creates a web link file pointing to target URL, with optional favicon URL.
+		CreateLink(ctx context.Context, path *fs.URI, target, favicon string, opt ...fs.Option) (fs.File, error)
 		// Rename renames a file or directory
 		Rename(ctx context.Context, path *fs.URI, newName string) (fs.File, error)
 		// Delete deletes a group of file or directory. UnlinkOnly indicates whether to delete file record in DB only.
 		Delete(ctx context.Context, path []*fs.URI, opts ...fs.Option) error
 		// Restore restores a group of files
 		Restore(ctx context.Context, path ...*fs.URI) error
+		// RestoreTree restores all files deleted from given folder or its descendants
+		RestoreTree(ctx context.Context, root *fs.URI, dst *fs.URI) error
 		// MoveOrCopy moves or copies a group of files
 		MoveOrCopy(ctx context.Context, src []*fs.URI, dst *fs.URI, isCopy bool) error
 		// Update puts file content. If given file does not exist, it will create a new one.
@@ -57,6 +62,11 @@ type (
 		CreateViewerSession(ctx context.Context, uri *fs.URI, version string, viewer *setting.Viewer) (*ViewerSession, error)
 		// TraverseFile traverses a file to its root file, return the file with linked root.
 		TraverseFile(ctx context.Context, fileID int) (fs.File, error)
+		// FullTextSearch searches files by content with optional filters, ordered by relevance.
+		FullTextSearch(ctx context.Context, q *search.Query, category string) (*FullTextSearchResult, error)
+		// Atomic runs f in one DB transaction, changes made through op are committed only if f returns nil.
+		// Events of the operations are sent after commit.
+		Atomic(ctx context.Context, f func(ctx context.Context, op FileOperation) error) error
 	}
 
 	FsManagement interface {
@@ -111,6 +121,7 @@ type (
 		IsPrivate       bool
 		RemainDownloads int
 		Expire          *time.Time
+		Recipients      []string
 	}
 )
 
@@ -139,7 +150,8 @@ func NewFileManager(dep dependency.Dep, u *ent.User) FileManager {
 		user:     u,
 		settings: dep.SettingProvider(),
 		fs: dbfs.NewDatabaseFS(u, dep.FileClient(), dep.ShareClient(), dep.Logger(), dep.LockSystem(),
-			dep.SettingProvider(), dep.StoragePolicyClient(), dep.HashIDEncoder(), dep.UserClient(), dep.KV(), dep.NavigatorStateKV(), dep.DirectLinkClient()),
+			dep.SettingProvider(), dep.StoragePolicyClient(), dep.HashIDEncoder(), dep.UserClient(), dep.KV(), dep.NavigatorStateKV(), dep.DirectLinkClient(), dep.CheckoutClient(),
+			dep.AuditRecorder()),
 		kv:           dep.KV(),
 		config:       config,
 		auth:         dep.GeneralAuth(),
@@ -106,6 +106,20 @@ func (m *manager) ExtractAndSaveMediaMeta(ctx context.Context, uri *fs.URI, enti
 		return nil
 	}
 
+	// Waveform sidecar is generated along with media meta, so that it is ready when the file is played.
+	if m.settings.WaveformEnabled(ctx) && util.IsInExtensionList(m.settings.WaveformExts(ctx), file.Name()) {
+		if _, err := m.waveform(ctx, targetVersion); err != nil {
+			m.l.Warning("Failed to generate waveform: %s", err)
+		}
+	}
+
+	// So is sprite sheet of videos, ready for scrub previews.
+	if sprite := m.settings.VideoSprite(ctx); sprite.Enabled && util.IsInExtensionList(sprite.Exts, file.Name()) {
+		if _, _, err := m.videoSprite(ctx, file, targetVersion); err != nil {
+			m.l.Warning("Failed to generate sprite sheet: %s", err)
+		}
+	}
+
 	var (
 		metas []driver.MediaMeta
 	)
@@ -12,6 +12,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/go-playground/validator/v10"
+	"strconv"
 	"strings"
 )
 
@@ -25,8 +26,11 @@ const (
 	tagMetadataSuffix        = "tag"
 	iconColorMetadataKey     = customizeMetadataSuffix + ":icon_color"
 	emojiIconMetadataKey     = customizeMetadataSuffix + ":emoji"
+	descriptionMetadataKey   = customizeMetadataSuffix + ":description"
 	shareOwnerMetadataKey    = dbfs.MetadataSysPrefix + "shared_owner"
 	shareRedirectMetadataKey = dbfs.MetadataSysPrefix + "shared_redirect"
+	// davPropMaxSize is the maximum size of a WebDAV dead property value.
+	davPropMaxSize = 4096
 )
 
 var (
@@ -87,7 +91,15 @@ var (
 				return fmt.Errorf("unsupported system metadata key: %s", patch.Key)
 			},
 		},
-		"dav": {},
+		"dav": {
+			wildcardMetadataKey: func(ctx context.Context, m *manager, patch *fs.MetadataPatch) error {
+				if !patch.Remove && len(patch.Value) > davPropMaxSize {
+					return fmt.Errorf("dead property exceeds %d bytes", davPropMaxSize)
+				}
+
+				return nil
+			},
+		},
 		"thumb": {
 			wildcardMetadataKey: func(ctx context.Context, m *manager, patch *fs.MetadataPatch) error {
 				// Only allow removing thumb:disabled key
@@ -103,6 +115,23 @@ var (
 				return fmt.Errorf("unsupported thumb metadata key: %s", patch.Key)
 			},
 		},
+		"trash": {
+			wildcardMetadataKey: func(ctx context.Context, m *manager, patch *fs.MetadataPatch) error {
+				if patch.Key != dbfs.TrashRetentionKey {
+					return fmt.Errorf("unsupported trash metadata key: %s", patch.Key)
+				}
+
+				if patch.Remove {
+					return nil
+				}
+
+				if retention, err := strconv.Atoi(patch.Value); err != nil || retention <= 0 {
+					return fmt.Errorf("trash retention must be a positive number of seconds")
+				}
+
+				return nil
+			},
+		},
 		customizeMetadataSuffix: {
 			iconColorMetadataKey: validateColor(false),
 			emojiIconMetadataKey: func(ctx context.Context, m *manager, patch *fs.MetadataPatch) error {
@@ -154,7 +183,12 @@ func (m *manager) PatchMedata(ctx context.Context, path []*fs.URI, data ...fs.Me
 		return err
 	}
 
-	return m.fs.PatchMetadata(ctx, path, data...)
+	if err := m.fs.PatchMetadata(ctx, path, data...); err != nil {
+		return err
+	}
+
+	m.searchIndexURIs(ctx, path...)
+	return nil
 }
 
 func (m *manager) validateMetadata(ctx context.Context, data ...fs.MetadataPatch) error {
@@ -0,0 +1,269 @@
+package manager
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/moderation"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/samber/lo"
+	"golang.org/x/tools/container/intsets"
+)
+
+// moderationThumbMaxSize limits the size of thumbnails sent to the moderation API.
+const moderationThumbMaxSize = 10 << 20
+
+var errModerationLimitReached = errors.New("maximum number of moderated files reached")
+
+type (
+	// ShareModerationTask checks content of a new public share, running in media meta queue.
+	ShareModerationTask struct {
+		*queue.DBTask
+	}
+
+	ShareModerationTaskState struct {
+		ShareID int `json:"share_id"`
+	}
+)
+
+func init() {
+	queue.RegisterResumableTaskFactory(queue.ShareModerationTaskType, NewShareModerationTaskFromModel)
+}
+
+// NewShareModerationTask creates a new ShareModerationTask for given share.
+func NewShareModerationTask(ctx context.Context, shareID int, creator *ent.User) (*ShareModerationTask, error) {
+	stateBytes, err := json.Marshal(&ShareModerationTaskState{
+		ShareID: shareID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	return &ShareModerationTask{
+		DBTask: &queue.DBTask{
+			DirectOwner: creator,
+			Task: &ent.Task{
+				Type:          queue.ShareModerationTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  string(stateBytes),
+				PublicState:   &types.TaskPublicState{},
+			},
+		},
+	}, nil
+}
+
+func NewShareModerationTaskFromModel(task *ent.Task) queue.Task {
+	return &ShareModerationTask{
+		DBTask: &queue.DBTask{
+			Task: task,
+		},
+	}
+}
+
+func (m *ShareModerationTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	fm := NewFileManager(dep, inventory.UserFromContext(ctx)).(*manager)
+
+	var state ShareModerationTaskState
+	if err := json.Unmarshal([]byte(m.State()), &state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %s (%w)", err, queue.CriticalErr)
+	}
+
+	if err := fm.ModerateShare(ctx, state.ShareID); err != nil {
+		return task.StatusError, err
+	}
+
+	return task.StatusCompleted, nil
+}
+
+// ModerateShare checks files of a public share against the hash list and moderation API. If any file is
+// flagged, the share is quarantined and a case is created for admin review.
+func (m *manager) ModerateShare(ctx context.Context, shareID int) error {
+	settings := m.settings.Moderation(ctx)
+	if !settings.Enabled {
+		return nil
+	}
+
+	loadCtx := context.WithValue(ctx, inventory.LoadShareFile{}, true)
+	loadCtx = context.WithValue(loadCtx, inventory.LoadShareUser{}, true)
+	share, err := m.dep.ShareClient().GetByID(loadCtx, shareID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			m.l.Debug("Skip moderation of deleted share %d.", shareID)
+			return nil
+		}
+
+		return fmt.Errorf("failed to get share: %w", err)
+	}
+
+	if share.Password != "" || share.Quarantined {
+		return nil
+	}
+
+	shared, err := m.fs.TraverseFile(ctx, share.Edges.File.ID)
+	if err != nil {
+		return fmt.Errorf("failed to traverse shared file: %w", err)
+	}
+
+	var files []fs.File
+	if err := m.fs.Walk(ctx, shared.Uri(false), intsets.MaxInt, func(f fs.File, level int) error {
+		if f.Type() != types.FileTypeFile || f.IsSymbolic() {
+			return nil
+		}
+
+		if len(files) >= settings.MaxFiles {
+			return errModerationLimitReached
+		}
+
+		files = append(files, f)
+		return nil
+	}, dbfs.WithFileEntities()); err != nil && !errors.Is(err, errModerationLimitReached) {
+		return fmt.Errorf("failed to walk shared file: %w", err)
+	}
+
+	items := make([]moderation.Item, 0, len(files))
+	for _, f := range files {
+		item, err := m.moderationItem(ctx, settings, f)
+		if err != nil {
+			m.l.Debug("Skip moderation of file %q: %s", f.Uri(false), err)
+			continue
+		}
+
+		items = append(items, *item)
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	verdicts, err := m.moderationChecker(settings).Check(ctx, items)
+	if err != nil {
+		return fmt.Errorf("failed to check share content: %w", err)
+	}
+
+	if len(verdicts) == 0 {
+		return nil
+	}
+
+	matches := lo.FilterMap(items, func(item moderation.Item, index int) (types.ModerationMatch, bool) {
+		v, ok := verdicts[item.ID]
+		id, _ := strconv.Atoi(item.ID)
+		return types.ModerationMatch{
+			FileID: id,
+			Name:   item.Name,
+			SHA256: item.SHA256,
+			Source: v.Source,
+			Reason: v.Reason,
+		}, ok
+	})
+
+	return m.quarantineShare(ctx, share, matches)
+}
+
+// quarantineShare disables the share and creates a case for admin review.
+func (m *manager) quarantineShare(ctx context.Context, share *ent.Share, matches []types.ModerationMatch) error {
+	if err := m.dep.ShareClient().SetQuarantined(ctx, share.ID, true); err != nil {
+		return fmt.Errorf("failed to quarantine share: %w", err)
+	}
+
+	c, err := m.dep.ModerationCaseClient().Create(ctx, share, matches)
+	if err != nil {
+		return fmt.Errorf("failed to create moderation case: %s (%w)", err, queue.CriticalErr)
+	}
+
+	m.l.Info("Share %d is quarantined by content moderation with %d flagged files, case %d.", share.ID, len(matches), c.ID)
+	event := audit.NewEvent(ctx, audit.EventShareQuarantined, share.Edges.User.ID, 0, map[string]string{
+		"case":    strconv.Itoa(c.ID),
+		"flagged": strconv.Itoa(len(matches)),
+	}).WithEntity(audit.EntityShare, share.ID)
+	if err := m.dep.AuditRecorder().Record(ctx, event); err != nil {
+		m.l.Warning("Failed to record share quarantine audit event: %s", err)
+	}
+
+	return nil
+}
+
+func (m *manager) moderationChecker(settings *setting.Moderation) moderation.Checker {
+	checkers := []moderation.Checker{moderation.NewHashListChecker(settings.HashList)}
+	if settings.Endpoint != "" {
+		checkers = append(checkers, moderation.NewAPIChecker(m.dep.RequestClient(request.WithLogger(m.l)),
+			settings.Endpoint, settings.Token))
+	}
+
+	return moderation.Chain(checkers...)
+}
+
+// moderationItem hashes latest version of the file, thumbnail is attached if enabled and available.
+func (m *manager) moderationItem(ctx context.Context, settings *setting.Moderation, f fs.File) (*moderation.Item, error) {
+	latest := f.PrimaryEntity()
+	if latest == nil {
+		return nil, fmt.Errorf("no primary entity")
+	}
+
+	if latest.Size() > settings.MaxSize {
+		return nil, fs.ErrFileSizeTooBig
+	}
+
+	es, err := m.GetEntitySource(ctx, 0, fs.WithEntity(latest))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entity source: %w", err)
+	}
+	defer es.Close()
+
+	es.Apply(entitysource.WithContext(ctx))
+	h := sha256.New()
+	if _, err := io.Copy(h, es); err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	item := &moderation.Item{
+		ID:     strconv.Itoa(f.ID()),
+		Name:   f.DisplayName(),
+		SHA256: fmt.Sprintf("%x", h.Sum(nil)),
+	}
+
+	if settings.SendThumbnails && settings.Endpoint != "" {
+		if thumb, err := m.Thumbnail(ctx, f.Uri(false)); err == nil {
+			data, err := io.ReadAll(io.LimitReader(thumb, moderationThumbMaxSize))
+			thumb.Close()
+			if err == nil {
+				item.Thumbnail = data
+			}
+		}
+	}
+
+	return item, nil
+}
+
+func (m *manager) moderateNewShare(ctx context.Context, share *ent.Share) {
+	if !m.settings.Moderation(ctx).Enabled {
+		return
+	}
+
+	t, err := NewShareModerationTask(ctx, share.ID, m.user)
+	if err != nil {
+		m.l.Warning("Failed to create share moderation task: %s", err)
+		return
+	}
+
+	if err := m.dep.MediaMetaQueue(ctx).QueueTask(ctx, t); err != nil {
+		m.l.Warning("Failed to queue share moderation task: %s", err)
+	}
+}
@@ -0,0 +1,165 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/ocr"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+)
+
+type (
+	// OcrTask recognizes text in an uploaded image or scanned PDF, running in media meta queue.
+	OcrTask struct {
+		*queue.DBTask
+	}
+
+	OcrTaskState struct {
+		Uri      *fs.URI `json:"uri"`
+		EntityID int     `json:"entity_id"`
+	}
+)
+
+func init() {
+	queue.RegisterResumableTaskFactory(queue.OcrTaskType, NewOcrTaskFromModel)
+}
+
+// NewOcrTask creates a new OcrTask for given version of the file.
+func NewOcrTask(ctx context.Context, uri *fs.URI, entityID int, creator *ent.User) (*OcrTask, error) {
+	stateBytes, err := json.Marshal(&OcrTaskState{
+		Uri:      uri,
+		EntityID: entityID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	return &OcrTask{
+		DBTask: &queue.DBTask{
+			DirectOwner: creator,
+			Task: &ent.Task{
+				Type:          queue.OcrTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  string(stateBytes),
+				PublicState:   &types.TaskPublicState{},
+			},
+		},
+	}, nil
+}
+
+func NewOcrTaskFromModel(task *ent.Task) queue.Task {
+	return &OcrTask{
+		DBTask: &queue.DBTask{
+			Task: task,
+		},
+	}
+}
+
+func (m *OcrTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	fm := NewFileManager(dep, inventory.UserFromContext(ctx)).(*manager)
+
+	var state OcrTaskState
+	if err := json.Unmarshal([]byte(m.State()), &state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %s (%w)", err, queue.CriticalErr)
+	}
+
+	if err := fm.RecognizeAndSaveText(ctx, state.Uri, state.EntityID); err != nil {
+		return task.StatusError, err
+	}
+
+	return task.StatusCompleted, nil
+}
+
+// RecognizeAndSaveText recognizes text in given version of the file, saving it as private metadata that is
+// matched by full text search. Only the latest version is recognized.
+func (m *manager) RecognizeAndSaveText(ctx context.Context, uri *fs.URI, entityID int) error {
+	settings := m.settings.OCR(ctx)
+	file, err := m.fs.Get(ctx, uri, dbfs.WithFileEntities())
+	if err != nil {
+		return fmt.Errorf("failed to get file: %w", err)
+	}
+
+	latest := file.PrimaryEntity()
+	if latest == nil || latest.ID() != entityID {
+		m.l.Debug("Skip OCR task for non-latest version.")
+		return nil
+	}
+
+	if latest.Size() > settings.MaxSize {
+		m.l.Debug("Skip OCR task for file larger than %d bytes.", settings.MaxSize)
+		return nil
+	}
+
+	recognizer, err := ocr.NewRecognizer(settings, m.dep.RequestClient(request.WithLogger(m.l)))
+	if err != nil {
+		return fmt.Errorf("failed to create recognizer: %s (%w)", err, queue.CriticalErr)
+	}
+
+	source, err := m.GetEntitySource(ctx, 0, fs.WithEntity(latest))
+	if err != nil {
+		return fmt.Errorf("failed to get entity source: %w", err)
+	}
+	defer source.Close()
+
+	var text string
+	if strings.EqualFold(file.Ext(), "pdf") {
+		text, err = ocr.RecognizePdf(ctx, recognizer, m.settings, source, file.DisplayName(), settings.PdfMaxPages)
+	} else {
+		text, err = recognizer.Recognize(ctx, source, file.DisplayName())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to recognize text: %w", err)
+	}
+
+	text = ocr.Normalize(text, inventory.MaxMetadataLen)
+	if text == "" {
+		return nil
+	}
+
+	if err := m.fs.PatchMetadata(ctx, []*fs.URI{uri}, fs.MetadataPatch{
+		Key:     dbfs.MetadataOcrText,
+		Value:   text,
+		Private: true,
+	}); err != nil {
+		return fmt.Errorf("failed to save recognized text: %s (%w)", err, queue.CriticalErr)
+	}
+
+	searchIndexFiles(ctx, m.dep, file.ID())
+
+	return nil
+}
+
+func (m *manager) ocrForNewEntity(ctx context.Context, session *fs.UploadSession) {
+	if session.Props.EntityType != nil && *session.Props.EntityType != types.EntityTypeVersion {
+		return
+	}
+
+	settings := m.settings.OCR(ctx)
+	if !settings.Enabled || !util.IsInExtensionList(settings.Exts, session.Props.Uri.Name()) ||
+		session.Props.Size > settings.MaxSize {
+		return
+	}
+
+	ocrTask, err := NewOcrTask(ctx, session.Props.Uri, session.EntityID, m.user)
+	if err != nil {
+		m.l.Warning("Failed to create OCR task: %s", err)
+		return
+	}
+
+	if err := m.dep.MediaMetaQueue(ctx).QueueTask(ctx, ocrTask); err != nil {
+		m.l.Warning("Failed to queue OCR task: %s", err)
+	}
+}
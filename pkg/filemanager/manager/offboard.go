@@ -0,0 +1,270 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	offboardCollectPageSize = 500
+	offboardExportPageSize  = 100
+)
+
+type (
+	// OffboardExportTask moves all blobs of an archived user's files to the archive storage policy.
+	OffboardExportTask struct {
+		*queue.DBTask
+	}
+
+	OffboardExportTaskState struct {
+		UserID   int `json:"user_id"`
+		PolicyID int `json:"policy_id"`
+		// Page next page of entities to be exported.
+		Page   int            `json:"page"`
+		Moved  int            `json:"moved"`
+		Errors []RecycleError `json:"errors,omitempty"`
+	}
+)
+
+func init() {
+	crontab.Register(setting.CronTypeOffboardCollect, CronCollectOffboardedUsers)
+	queue.RegisterResumableTaskFactory(queue.OffboardExportTaskType, NewOffboardExportTaskFromModel)
+}
+
+func NewOffboardExportTaskFromModel(task *ent.Task) queue.Task {
+	return &OffboardExportTask{
+		DBTask: &queue.DBTask{
+			Task: task,
+		},
+	}
+}
+
+// NewOffboardExportTask creates a task exporting files of user uid to storage policy policyID.
+func NewOffboardExportTask(ctx context.Context, uid, policyID int) (queue.Task, error) {
+	state := &OffboardExportTaskState{
+		UserID:   uid,
+		PolicyID: policyID,
+	}
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	return &OffboardExportTask{
+		DBTask: &queue.DBTask{
+			Task: &ent.Task{
+				Type:          queue.OffboardExportTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  string(stateBytes),
+				PublicState:   &types.TaskPublicState{},
+			},
+			DirectOwner: inventory.UserFromContext(ctx),
+		},
+	}, nil
+}
+
+func (m *OffboardExportTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	fm := NewFileManager(dep, inventory.UserFromContext(ctx)).(*manager)
+
+	state := &OffboardExportTaskState{}
+	if err := json.Unmarshal([]byte(m.State()), state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+
+	dst, err := dep.StoragePolicyClient().GetPolicyByID(ctx, state.PolicyID)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to get archive policy %d: %s (%w)", state.PolicyID, err, queue.CriticalErr)
+	}
+
+	dstDriver, err := fm.GetStorageDriver(ctx, dst)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to get storage driver of archive policy: %s (%w)", err, queue.CriticalErr)
+	}
+
+	// Entities are listed by file owner, the listed set is not changed by relocation, so that
+	// it's safe to resume from the saved page.
+	res, err := dep.FileClient().ListEntities(ctx, &inventory.ListEntityParameters{
+		PaginationArgs: &inventory.PaginationArgs{
+			Page:     state.Page,
+			PageSize: offboardExportPageSize,
+		},
+		FileOwnerID: state.UserID,
+	})
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to list entities: %w", err)
+	}
+
+	for _, e := range res.Entities {
+		if e.StoragePolicyEntities == dst.ID || e.ReferenceCount == 0 || e.UploadSessionID != nil {
+			continue
+		}
+
+		if err := fm.exportEntity(ctx, e, dst, dstDriver, state.UserID); err != nil {
+			l.Warning("Failed to export entity %d: %s", e.ID, err)
+			state.Errors = append(state.Errors, RecycleError{ID: strconv.Itoa(e.ID), Error: err.Error()})
+			continue
+		}
+
+		state.Moved++
+	}
+
+	state.Page++
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	m.Lock()
+	m.Task.PrivateState = string(stateBytes)
+	m.Unlock()
+
+	if len(res.Entities) < offboardExportPageSize {
+		if len(state.Errors) > 0 {
+			return task.StatusError, fmt.Errorf("failed to export %d entities", len(state.Errors))
+		}
+		return task.StatusCompleted, nil
+	}
+
+	m.ResumeAfter(0)
+	return task.StatusSuspending, nil
+}
+
+// exportEntity copies the blob of entity e to policy dst, points the entity to the new blob and
+// deletes the original one.
+func (m *manager) exportEntity(ctx context.Context, e *ent.Entity, dst *ent.StoragePolicy, dstDriver driver.Handler, uid int) error {
+	src, srcDriver, err := m.getEntityPolicyDriver(ctx, fs.NewEntity(e), nil)
+	if err != nil {
+		return err
+	}
+
+	es, err := m.GetEntitySource(ctx, 0, fs.WithEntity(fs.NewEntity(e)), fs.WithPolicy(src))
+	if err != nil {
+		return fmt.Errorf("failed to open entity source: %w", err)
+	}
+
+	savePath := path.Join("offboard", strconv.Itoa(uid), uuid.Must(uuid.NewV4()).String())
+	if dst.Type == types.PolicyTypeLocal {
+		savePath = filepath.ToSlash(util.DataPath(savePath))
+	}
+
+	if err := dstDriver.Put(ctx, &fs.UploadRequest{
+		Props: &fs.UploadProps{
+			Size:     e.Size,
+			SavePath: savePath,
+		},
+		File:   es,
+		Seeker: es,
+	}); err != nil {
+		return fmt.Errorf("failed to upload blob: %w", err)
+	}
+
+	if _, err := m.dep.FileClient().RelocateEntity(ctx, e, dst.ID, savePath); err != nil {
+		return fmt.Errorf("failed to update entity: %w", err)
+	}
+
+	if _, err := srcDriver.Delete(ctx, e.Source); err != nil {
+		m.l.Warning("Failed to delete original blob %q of exported entity %d: %s", e.Source, e.ID, err)
+	}
+
+	return nil
+}
+
+// CronCollectOffboardedUsers deletes archived users whose scheduled deletion time has passed.
+func CronCollectOffboardedUsers(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	uc := dep.UserClient()
+
+	expired := make([]int, 0)
+	for page := 0; ; page++ {
+		res, err := uc.ListUsers(ctx, &inventory.ListUserParameters{
+			PaginationArgs: &inventory.PaginationArgs{
+				Page:     page,
+				PageSize: offboardCollectPageSize,
+			},
+			Status: user.StatusArchived,
+		})
+		if err != nil {
+			l.Error("Failed to list archived users: %s", err)
+			return
+		}
+
+		for _, u := range res.Users {
+			if u.Settings != nil && u.Settings.Offboarding != nil && u.Settings.Offboarding.DeleteAt != nil &&
+				u.Settings.Offboarding.DeleteAt.Before(time.Now()) {
+				expired = append(expired, u.ID)
+			}
+		}
+
+		if len(res.Users) < offboardCollectPageSize {
+			break
+		}
+	}
+
+	for _, uid := range expired {
+		l.Info("Deleting archived user %d.", uid)
+		if err := purgeUser(ctx, dep, uid); errors.Is(err, fs.ErrLegalHold) {
+			l.Info("Skip deleting archived user %d under legal hold.", uid)
+		} else if err != nil {
+			l.Error("Failed to delete archived user %d: %s", uid, err)
+		}
+	}
+}
+
+// purgeUser deletes the user along with files, shares, WebDAV accounts, passkeys and tasks in one transaction.
+// Users under legal hold are kept, fs.ErrLegalHold is returned.
+func purgeUser(ctx context.Context, dep dependency.Dep, uid int) error {
+	if err := checkPurgeLegalHold(ctx, dep, uid); err != nil {
+		return err
+	}
+
+	fc, tx, ctx, err := inventory.WithTx(ctx, dep.FileClient())
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	uc, _, ctx, err := inventory.WithTx(ctx, dep.UserClient())
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if err := fc.DeleteByUser(ctx, uid); err != nil {
+		_ = inventory.Rollback(tx)
+		return fmt.Errorf("failed to delete files: %w", err)
+	}
+
+	if err := uc.Delete(ctx, uid); err != nil {
+		_ = inventory.Rollback(tx)
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return fmt.Errorf("failed to commit deletion: %w", err)
+	}
+
+	return nil
+}
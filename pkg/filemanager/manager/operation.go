@@ -12,9 +12,10 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/lock"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
-	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webhook"
 	"github.com/samber/lo"
 )
 
@@ -68,15 +69,25 @@ func (m *manager) List(ctx context.Context, path *fs.URI, args *ListArgs) (fs.Fi
 		}
 
 		if searchParams.Category != "" {
-			// Overwrite search query with predefined category
-			category := fs.SearchCategoryFromString(searchParams.Category)
-			if category == setting.CategoryUnknown {
-				return nil, nil, fmt.Errorf("unknown category: %s", searchParams.Category)
+			// Predefined category is matched along with other conditions.
+			category, err := m.searchCategoryParameters(ctx, searchParams.Category)
+			if err != nil {
+				return nil, nil, err
 			}
 
-			path = path.SetQuery(m.settings.SearchCategoryQuery(ctx, category))
-			searchParams = path.SearchParameters()
+			searchParams.CategoryFilter = category
 		}
+
+		if policy := path.U.Query().Get(fs.QuerySearchPolicy); policy != "" {
+			policyID, err := m.hasher.Decode(policy, hashid.PolicyID)
+			if err != nil {
+				return nil, nil, serializer.NewError(serializer.CodeParamErr, "Invalid storage policy ID", err)
+			}
+
+			searchParams.StoragePolicyID = policyID
+		}
+
+		opts = append(opts, dbfs.WithSearchParameters(searchParams))
 	}
 
 	if dbfsSetting.UseCursorPagination || searchParams != nil {
@@ -85,7 +96,12 @@ func (m *manager) List(ctx context.Context, path *fs.URI, args *ListArgs) (fs.Fi
 		opts = append(opts, fs.WithPage(args.Page))
 	}
 
-	return m.fs.List(ctx, path, opts...)
+	parent, res, err := m.fs.List(ctx, path, opts...)
+	if err == nil && searchParams == nil && !m.stateless {
+		m.thumbPregenForListed(ctx, res)
+	}
+
+	return parent, res, err
 }
 
 func (m *manager) SharedAddressTranslation(ctx context.Context, path *fs.URI, opts ...fs.Option) (fs.File, *fs.URI, error) {
@@ -131,19 +147,45 @@ func (m *manager) Create(ctx context.Context, path *fs.URI, fileType types.FileT
 		opts = append(opts, dbfs.WithSymbolicLink())
 	}
 
-	return m.fs.Create(ctx, path, fileType, opts...)
+	file, err := m.fs.Create(ctx, path, fileType, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	m.publishCreateEvent(ctx, file)
+	return file, nil
 }
 
 func (m *manager) Rename(ctx context.Context, path *fs.URI, newName string) (fs.File, error) {
-	return m.fs.Rename(ctx, path, newName)
+	file, err := m.fs.Rename(ctx, path, newName)
+	if err != nil {
+		return nil, err
+	}
+
+	m.dispatchFileEvent(ctx, webhook.EventFileRenamed, file, path.Name())
+	afterCommit(ctx, func() {
+		searchIndexFiles(ctx, m.dep, file.ID())
+		m.runAutomation(ctx, webhook.EventFileRenamed, file)
+	})
+	return file, nil
 }
 
 func (m *manager) MoveOrCopy(ctx context.Context, src []*fs.URI, dst *fs.URI, isCopy bool) error {
-	return m.fs.MoveOrCopy(ctx, src, dst, isCopy)
+	if err := m.fs.MoveOrCopy(ctx, src, dst, isCopy); err != nil {
+		return err
+	}
+
+	m.publishMoveEvent(ctx, src, dst, isCopy)
+	return nil
 }
 
 func (m *manager) SoftDelete(ctx context.Context, path ...*fs.URI) error {
-	return m.fs.SoftDelete(ctx, path...)
+	if err := m.fs.SoftDelete(ctx, path...); err != nil {
+		return err
+	}
+
+	m.dispatchDeleteEvent(ctx, path, false)
+	return nil
 }
 
 func (m *manager) Delete(ctx context.Context, path []*fs.URI, opts ...fs.Option) error {
@@ -161,6 +203,8 @@ func (m *manager) Delete(ctx context.Context, path []*fs.URI, opts ...fs.Option)
 		return err
 	}
 
+	m.dispatchDeleteEvent(ctx, path, true)
+
 	m.l.Debug("New stale entities: %v", staleEntities)
 
 	// Delete stale entities
@@ -223,10 +267,27 @@ func (l *manager) Refresh(ctx context.Context, d time.Duration, token string) (l
 	return l.fs.Refresh(ctx, d, token)
 }
 
+func (l *manager) ListLocks(ctx context.Context) ([]lock.ActiveLock, error) {
+	return l.fs.ListLocks(ctx)
+}
+
+func (l *manager) FileLocks(ctx context.Context, file fs.File) []lock.ActiveLock {
+	return l.fs.FileLocks(ctx, file)
+}
+
+func (l *manager) Checkout(ctx context.Context, expireAt time.Time, requester *ent.User, zeroDepth bool, uri *fs.URI,
+	token string) (*lock.ActiveLock, error) {
+	return l.fs.Checkout(ctx, expireAt, requester, zeroDepth, uri, token)
+}
+
 func (l *manager) Restore(ctx context.Context, path ...*fs.URI) error {
 	return l.fs.Restore(ctx, path...)
 }
 
+func (l *manager) RestoreTree(ctx context.Context, root *fs.URI, dst *fs.URI) error {
+	return l.fs.RestoreTree(ctx, root, dst)
+}
+
 func (l *manager) CreateOrUpdateShare(ctx context.Context, path *fs.URI, args *CreateShareArgs) (*ent.Share, error) {
 	file, err := l.fs.Get(ctx, path, dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityShare), dbfs.WithNotRoot())
 	if err != nil {
@@ -267,6 +328,7 @@ func (l *manager) CreateOrUpdateShare(ctx context.Context, path *fs.URI, args *C
 		Password:        password,
 		Expires:         args.Expire,
 		RemainDownloads: args.RemainDownloads,
+		Recipients:      args.Recipients,
 		Existed:         existed,
 	})
 
@@ -274,6 +336,21 @@ func (l *manager) CreateOrUpdateShare(ctx context.Context, path *fs.URI, args *C
 		return nil, serializer.NewError(serializer.CodeDBError, "failed to create share", err)
 	}
 
+	// New public content is checked by moderation, including private shares made public.
+	if !args.IsPrivate && (existed == nil || existed.Password != "") {
+		l.moderateNewShare(ctx, share)
+	}
+
+	if existed == nil {
+		hasher := l.dep.HashIDEncoder()
+		emitEvent(ctx, l.dep, webhook.EventShareCreated, &webhook.ShareEvent{
+			ShareID: hashid.EncodeShareID(hasher, share.ID),
+			File:    buildWebhookFile(file, hasher),
+			Private: args.IsPrivate,
+			Expires: share.Expires,
+		})
+	}
+
 	return share, nil
 }
 
@@ -0,0 +1,447 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/samber/lo"
+)
+
+const (
+	orphanReportFolder = "orphan"
+	orphanLockKVPrefix = "orphan_scan_lock_"
+	// orphanLockTTL is the maximum time a scan can hold the lock, in seconds.
+	orphanLockTTL        = 6 * 3600
+	orphanEntityPageSize = 1000
+)
+
+type (
+	OrphanScanStatus string
+
+	// OrphanReport is the result of reconciling blobs in storage with entities in DB.
+	OrphanReport struct {
+		PolicyID int              `json:"policy_id"`
+		Root     string           `json:"root"`
+		Status   OrphanScanStatus `json:"status"`
+		Error    string           `json:"error,omitempty"`
+		Cleanup  bool             `json:"cleanup"`
+		// Quarantine orphan blobs first seen within this period are never deleted.
+		Quarantine      time.Duration `json:"quarantine"`
+		StartedAt       time.Time     `json:"started_at"`
+		FinishedAt      *time.Time    `json:"finished_at,omitempty"`
+		ScannedBlobs    int           `json:"scanned_blobs"`
+		ScannedEntities int           `json:"scanned_entities"`
+		// OrphanBlobs blobs in storage without entity records.
+		OrphanBlobs []OrphanBlob `json:"orphan_blobs"`
+		// MissingBlobs entity records whose blob does not exist in storage.
+		MissingBlobs []MissingBlob `json:"missing_blobs"`
+	}
+
+	OrphanBlob struct {
+		Source    string    `json:"source"`
+		Size      int64     `json:"size"`
+		FirstSeen time.Time `json:"first_seen"`
+		Deleted   bool      `json:"deleted,omitempty"`
+	}
+
+	MissingBlob struct {
+		EntityID       int    `json:"entity_id"`
+		Source         string `json:"source"`
+		Size           int64  `json:"size"`
+		ReferenceCount int    `json:"reference_count"`
+	}
+)
+
+const (
+	OrphanScanStatusRunning   = OrphanScanStatus("running")
+	OrphanScanStatusCompleted = OrphanScanStatus("completed")
+	OrphanScanStatusFailed    = OrphanScanStatus("failed")
+)
+
+var ErrOrphanScanRunning = serializer.NewError(serializer.CodeConflict, "Orphan scan of this storage policy is already running", nil)
+
+func init() {
+	crontab.Register(setting.CronTypeOrphanScan, CronOrphanScan)
+}
+
+// OrphanScanRoot returns the folder containing all blobs of given policy, derived from the static
+// prefix of its DirNameRule. Empty string is returned if the rule has no static prefix.
+func OrphanScanRoot(policy *ent.StoragePolicy) string {
+	rule := filepath.ToSlash(policy.DirNameRule)
+	if i := strings.Index(rule, "{"); i >= 0 {
+		rule = rule[:i]
+	}
+
+	if i := strings.LastIndex(rule, "/"); i >= 0 {
+		rule = rule[:i]
+	} else {
+		rule = ""
+	}
+
+	if strings.Trim(rule, "/") == "" {
+		return ""
+	}
+
+	return rule
+}
+
+// IsOrphanScanRunning returns whether an orphan scan of given policy is running.
+func IsOrphanScanRunning(dep dependency.Dep, policyID int) bool {
+	_, ok := dep.KV().Get(orphanLockKVPrefix + strconv.Itoa(policyID))
+	return ok
+}
+
+// LoadOrphanReport returns the latest orphan scan report of given policy, nil if never scanned.
+func LoadOrphanReport(policyID int) (*OrphanReport, error) {
+	content, err := os.ReadFile(orphanReportPath(policyID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read orphan report: %w", err)
+	}
+
+	report := &OrphanReport{}
+	if err := json.Unmarshal(content, report); err != nil {
+		return nil, fmt.Errorf("failed to decode orphan report: %w", err)
+	}
+
+	return report, nil
+}
+
+func orphanReportPath(policyID int) string {
+	return filepath.Join(util.DataPath(orphanReportFolder), "orphan-"+strconv.Itoa(policyID)+".json")
+}
+
+func saveOrphanReport(report *OrphanReport) error {
+	if err := os.MkdirAll(util.DataPath(orphanReportFolder), 0700); err != nil {
+		return fmt.Errorf("failed to create orphan report folder: %w", err)
+	}
+
+	content, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("failed to encode orphan report: %w", err)
+	}
+
+	dst := orphanReportPath(report.PolicyID)
+	if err := os.WriteFile(dst+".tmp", content, 0600); err != nil {
+		return fmt.Errorf("failed to write orphan report: %w", err)
+	}
+
+	return os.Rename(dst+".tmp", dst)
+}
+
+// ScanOrphans walks blobs under the scan root of given policy and entities of the policy in both
+// directions. If cleanup is true, orphan blobs out of quarantine period are deleted from storage.
+func (m *manager) ScanOrphans(ctx context.Context, policyID int, cleanup bool) (*OrphanReport, error) {
+	policy, err := m.dep.StoragePolicyClient().GetPolicyByID(ctx, policyID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Storage policy not found", err)
+	}
+
+	root := OrphanScanRoot(policy)
+	if root == "" {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Blob folder of this storage policy cannot be determined from its naming rule", nil)
+	}
+
+	kv := m.dep.KV()
+	lockKey := orphanLockKVPrefix + strconv.Itoa(policyID)
+	locked, err := kv.SetNX(lockKey, true, orphanLockTTL)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to lock orphan scan", err)
+	}
+	if !locked {
+		return nil, ErrOrphanScanRunning
+	}
+	defer kv.Delete(orphanLockKVPrefix, strconv.Itoa(policyID))
+
+	previous, err := LoadOrphanReport(policyID)
+	if err != nil {
+		m.l.Warning("Failed to load previous orphan report, quarantine period restarts: %s", err)
+	}
+
+	report := &OrphanReport{
+		PolicyID:   policyID,
+		Root:       root,
+		Status:     OrphanScanStatusRunning,
+		Cleanup:    cleanup,
+		Quarantine: m.settings.OrphanCleanup(ctx).Quarantine,
+		StartedAt:  time.Now(),
+	}
+	if previous != nil {
+		// Keep previous orphans so that quarantine period is not reset while running.
+		report.OrphanBlobs = previous.OrphanBlobs
+	}
+	if err := saveOrphanReport(report); err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to save orphan report", err)
+	}
+
+	if err := m.scanOrphans(ctx, policy, report, previous); err != nil {
+		report.Status = OrphanScanStatusFailed
+		report.Error = err.Error()
+	} else {
+		report.Status = OrphanScanStatusCompleted
+	}
+
+	finishedAt := time.Now()
+	report.FinishedAt = &finishedAt
+	if err := saveOrphanReport(report); err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to save orphan report", err)
+	}
+
+	return report, nil
+}
+
+func (m *manager) scanOrphans(ctx context.Context, policy *ent.StoragePolicy, report, previous *OrphanReport) error {
+	handler, err := m.GetStorageDriver(ctx, policy)
+	if err != nil {
+		return fmt.Errorf("failed to get storage driver: %w", err)
+	}
+
+	policyIDs, err := m.policiesSharingStorage(ctx, policy)
+	if err != nil {
+		return fmt.Errorf("failed to list storage policies: %w", err)
+	}
+
+	firstSeen := make(map[string]time.Time)
+	if previous != nil {
+		for _, blob := range previous.OrphanBlobs {
+			if !blob.Deleted {
+				firstSeen[blob.Source] = blob.FirstSeen
+			}
+		}
+	}
+
+	// Folders are listed one by one, so that blobs are reconciled without loading all of them.
+	now := time.Now()
+	blobs := make(map[string]bool)
+	report.OrphanBlobs = make([]OrphanBlob, 0)
+	folders := []string{report.Root}
+	for len(folders) > 0 {
+		folder := folders[len(folders)-1]
+		folders = folders[:len(folders)-1]
+
+		objects, err := handler.List(ctx, folder, func(int) {}, false)
+		if err != nil {
+			return fmt.Errorf("failed to list blobs under %q: %w", folder, err)
+		}
+
+		files := make([]fs.PhysicalObject, 0, len(objects))
+		for _, obj := range objects {
+			if obj.IsDir {
+				folders = append(folders, path.Join(folder, obj.RelativePath))
+				continue
+			}
+
+			files = append(files, obj)
+		}
+
+		for _, chunk := range lo.Chunk(files, orphanEntityPageSize) {
+			if err := m.collectOrphanBlobs(ctx, report, policyIDs, folder, chunk, firstSeen, blobs, now); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := m.collectMissingBlobs(ctx, report, policy.ID, blobs); err != nil {
+		return err
+	}
+
+	sort.Slice(report.OrphanBlobs, func(i, j int) bool {
+		return report.OrphanBlobs[i].Source < report.OrphanBlobs[j].Source
+	})
+	m.l.Info("Orphan scan of storage policy %d found %d orphan blob(s) and %d missing blob(s).",
+		policy.ID, len(report.OrphanBlobs), len(report.MissingBlobs))
+
+	if !report.Cleanup {
+		return nil
+	}
+
+	var expired []string
+	for _, blob := range report.OrphanBlobs {
+		if now.Sub(blob.FirstSeen) >= report.Quarantine {
+			expired = append(expired, blob.Source)
+		}
+	}
+
+	if len(expired) == 0 {
+		return nil
+	}
+
+	failed, err := handler.Delete(ctx, expired...)
+	if err != nil {
+		m.l.Warning("Failed to delete %d orphan blob(s): %s", len(failed), err)
+	}
+
+	failedSet := make(map[string]bool, len(failed))
+	for _, f := range failed {
+		failedSet[f] = true
+	}
+
+	for i, blob := range report.OrphanBlobs {
+		if now.Sub(blob.FirstSeen) >= report.Quarantine && !failedSet[blob.Source] {
+			report.OrphanBlobs[i].Deleted = true
+		}
+	}
+
+	m.l.Info("Deleted %d orphan blob(s) of storage policy %d.", len(expired)-len(failed), policy.ID)
+	return nil
+}
+
+// policiesSharingStorage returns IDs of policies storing blobs in the same bucket or folder as given
+// policy, including itself. Blobs of any of them are not orphans.
+func (m *manager) policiesSharingStorage(ctx context.Context, policy *ent.StoragePolicy) ([]int, error) {
+	policies, err := m.dep.StoragePolicyClient().ListPolicyByType(ctx, types.PolicyType(policy.Type))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int{policy.ID}
+	for _, p := range policies {
+		if p.ID != policy.ID && p.Server == policy.Server && p.BucketName == policy.BucketName && p.NodeID == policy.NodeID {
+			ids = append(ids, p.ID)
+		}
+	}
+
+	return ids, nil
+}
+
+// collectOrphanBlobs adds blobs under folder without entity records of given policies to report.
+// First seen time of orphan blobs is inherited from previous report.
+func (m *manager) collectOrphanBlobs(ctx context.Context, report *OrphanReport, policyIDs []int, folder string,
+	files []fs.PhysicalObject, firstSeen map[string]time.Time, blobs map[string]bool, now time.Time) error {
+	keys := make([]string, 0, len(files)*2)
+	for _, obj := range files {
+		key := normalizeBlobKey(path.Join(folder, obj.RelativePath))
+		keys = append(keys, key, "/"+key)
+	}
+
+	known, err := m.dep.FileClient().ExistingEntitySources(ctx, policyIDs, keys)
+	if err != nil {
+		return fmt.Errorf("failed to query entities: %w", err)
+	}
+
+	for _, obj := range files {
+		report.ScannedBlobs++
+		key := normalizeBlobKey(path.Join(folder, obj.RelativePath))
+		blobs[key] = true
+		if known[key] || known["/"+key] {
+			continue
+		}
+
+		source := obj.Source
+		if source == "" {
+			source = key
+		}
+
+		seen, ok := firstSeen[source]
+		if !ok {
+			seen = now
+		}
+
+		report.OrphanBlobs = append(report.OrphanBlobs, OrphanBlob{
+			Source:    source,
+			Size:      obj.Size,
+			FirstSeen: seen,
+		})
+	}
+
+	return nil
+}
+
+// collectMissingBlobs adds entities of given policy whose blob is not found in scanned blobs to report.
+func (m *manager) collectMissingBlobs(ctx context.Context, report *OrphanReport, policyID int, blobs map[string]bool) error {
+	root := normalizeBlobKey(report.Root)
+	report.MissingBlobs = make([]MissingBlob, 0)
+	for page := 0; ; page++ {
+		res, err := m.dep.FileClient().ListEntities(ctx, &inventory.ListEntityParameters{
+			PaginationArgs: &inventory.PaginationArgs{
+				Page:     page,
+				PageSize: orphanEntityPageSize,
+			},
+			StoragePolicyID: policyID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list entities: %w", err)
+		}
+
+		for _, e := range res.Entities {
+			key := normalizeBlobKey(e.Source)
+			// Entities outside of scan root and pending uploads are not checked.
+			if !strings.HasPrefix(key, root+"/") || e.UploadSessionID != nil {
+				continue
+			}
+
+			report.ScannedEntities++
+			if !blobs[key] {
+				report.MissingBlobs = append(report.MissingBlobs, MissingBlob{
+					EntityID:       e.ID,
+					Source:         e.Source,
+					Size:           e.Size,
+					ReferenceCount: e.ReferenceCount,
+				})
+			}
+		}
+
+		if len(res.Entities) < orphanEntityPageSize {
+			return nil
+		}
+	}
+}
+
+func normalizeBlobKey(source string) string {
+	return strings.TrimPrefix(path.Clean(filepath.ToSlash(source)), "/")
+}
+
+// CronOrphanScan scans orphan blobs of all storage policies, cleanup is performed if enabled in settings.
+func CronOrphanScan(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	fm := NewFileManager(dep, inventory.UserFromContext(ctx)).(*manager)
+	cleanup := dep.SettingProvider().OrphanCleanup(ctx).AutoCleanup
+
+	for page := 0; ; page++ {
+		res, err := dep.StoragePolicyClient().ListPolicies(ctx, &inventory.ListPolicyParameters{
+			PaginationArgs: &inventory.PaginationArgs{
+				Page:     page,
+				PageSize: policyHealthPageSize,
+			},
+		})
+		if err != nil {
+			l.Error("Failed to list storage policies: %s", err)
+			return
+		}
+
+		for _, p := range res.Policies {
+			if OrphanScanRoot(p) == "" {
+				l.Debug("Skip orphan scan of storage policy %d with no static blob folder.", p.ID)
+				continue
+			}
+
+			if _, err := fm.ScanOrphans(ctx, p.ID, cleanup); err != nil {
+				l.Warning("Failed to scan orphan blobs of storage policy %d: %s", p.ID, err)
+			}
+		}
+
+		if len(res.Policies) < policyHealthPageSize {
+			break
+		}
+	}
+}
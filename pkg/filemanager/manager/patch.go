@@ -0,0 +1,121 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/grouppolicy"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webhook"
+)
+
+func (m *manager) PatchContent(ctx context.Context, uri *fs.URI, offset, size int64, r io.Reader, previous string) (fs.File, error) {
+	if m.stateless {
+		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("partial update is not supported in stateless mode"))
+	}
+
+	file, err := m.fs.Get(ctx, uri, dbfs.WithFileEntities(), dbfs.WithNotRoot())
+	if err != nil {
+		return nil, err
+	}
+
+	if file.Type() != types.FileTypeFile {
+		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("target must be a valid file"))
+	}
+
+	entity := file.PrimaryEntity()
+	if entity == nil {
+		return nil, fs.ErrEntityNotExist
+	}
+
+	if offset < 0 || size < 0 || offset > entity.Size() {
+		return nil, serializer.NewError(serializer.CodeParamErr, fmt.Sprintf("Range starting at %d is out of file size %d", offset, entity.Size()), nil)
+	}
+
+	if previous == "" {
+		// Make sure the content patched is the one we checked above.
+		previous = hashid.EncodeEntityID(m.hasher, entity.ID())
+	}
+
+	newSize := max(entity.Size(), offset+size)
+	_, d, err := m.getEntityPolicyDriver(ctx, entity, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if w, ok := d.(driver.RangeWriter); ok && w.RangeWritable() && entity.ReferenceCount() <= 1 {
+		return m.patchInPlace(ctx, uri, w, offset, size, newSize, r, previous)
+	}
+
+	// Storage does not support in place write, assemble the new content as a new version.
+	head, err := m.GetEntitySource(ctx, 0, fs.WithEntity(entity))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entity source: %w", err)
+	}
+	defer head.Close()
+
+	tail, err := m.GetEntitySource(ctx, 0, fs.WithEntity(entity))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entity source: %w", err)
+	}
+	defer tail.Close()
+
+	readers := []io.Reader{io.LimitReader(head, offset), io.LimitReader(r, size)}
+	if offset+size < entity.Size() {
+		if _, err := tail.Seek(offset+size, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("failed to seek entity source: %w", err)
+		}
+		readers = append(readers, io.LimitReader(tail, entity.Size()-offset-size))
+	}
+
+	return m.Update(ctx, &fs.UploadRequest{
+		Props: &fs.UploadProps{
+			Uri:             uri,
+			PreviousVersion: previous,
+			Size:            newSize,
+		},
+		File: io.NopCloser(io.MultiReader(readers...)),
+		Mode: fs.ModeOverwrite,
+	})
+}
+
+func (m *manager) patchInPlace(ctx context.Context, uri *fs.URI, w driver.RangeWriter, offset, size, newSize int64, r io.Reader, previous string) (fs.File, error) {
+	policyReq := &grouppolicy.Request{
+		Action:   types.GroupPolicyActionUpload,
+		Size:     size,
+		MimeType: m.dep.MimeDetector(ctx).TypeByName(uri.Name()),
+	}
+	if err := m.dep.GroupPolicyChecker().Consume(ctx, m.user, policyReq); err != nil {
+		return nil, err
+	}
+
+	file, err := m.fs.WriteInPlace(ctx, uri, newSize, func(ctx context.Context, file fs.File, entity fs.Entity, policy *ent.StoragePolicy) error {
+		written, err := w.WriteRange(ctx, entity.Source(), offset, io.LimitReader(r, size))
+		if err != nil {
+			return serializer.NewError(serializer.CodeIOFailed, "Failed to write file content", err)
+		}
+
+		// Entity must not be resized if the request body is shorter than declared.
+		if written != size {
+			return serializer.NewError(serializer.CodeParamErr,
+				fmt.Sprintf("Request body has %d bytes, expected %d", written, size), nil)
+		}
+		return nil
+	}, dbfs.WithPreviousVersion(previous))
+	if err != nil {
+		m.dep.GroupPolicyChecker().Release(ctx, m.user, policyReq)
+		return nil, fmt.Errorf("failed to write file in place: %w", err)
+	}
+
+	searchIndexFiles(ctx, m.dep, file.ID())
+	m.dispatchFileEvent(ctx, webhook.EventFileUploaded, file, "")
+	m.runAutomation(ctx, webhook.EventFileUploaded, file)
+	return file, nil
+}
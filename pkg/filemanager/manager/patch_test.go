@@ -0,0 +1,94 @@
+package manager
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	_ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestUser returns dependencies on a migrated database with an active user in the default group, whose
+// root folder is created.
+func newTestUser(t *testing.T) (dependency.Dep, *ent.User, *ent.File) {
+	drv, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l := logging.NewConsoleLogger(logging.LevelError)
+	dep := dependency.NewDependency(
+		dependency.WithLogger(l),
+		dependency.WithConfigPath(filepath.Join(t.TempDir(), "conf.ini")),
+		dependency.WithKV(cache.NewMemoStore("", l)),
+		dependency.WithRawEntClient(ent.NewClient(ent.Driver(drv))),
+	)
+	t.Cleanup(func() { dep.DBClient().Close() })
+
+	ctx := context.Background()
+	u, err := dep.UserClient().Create(ctx, &inventory.NewUserArgs{Email: "test@cloudreve.org", Status: user.StatusActive, GroupID: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err = dep.UserClient().GetByID(context.WithValue(ctx, inventory.LoadUserGroup{}, true), u.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root := dep.DBClient().File.Create().SetType(int(types.FileTypeFolder)).SetName(inventory.RootFolderName).SetOwnerID(u.ID).SaveX(ctx)
+	return dep, u, root
+}
+
+func TestPatchContent_InPlace(t *testing.T) {
+	a := assert.New(t)
+	dep, u, root := newTestUser(t)
+	ctx := context.Background()
+
+	// File stored by the default local storage policy
+	src := filepath.Join(t.TempDir(), "a.txt")
+	if err := os.WriteFile(src, []byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	db := dep.DBClient()
+	entity := db.Entity.Create().SetType(int(types.EntityTypeVersion)).SetSource(src).SetSize(5).
+		SetStoragePolicyEntities(1).SetReferenceCount(1).SaveX(ctx)
+	db.File.Create().SetType(int(types.FileTypeFile)).SetName("a.txt").SetOwnerID(u.ID).SetParent(root).
+		SetSize(5).SetStoragePoliciesID(1).SetPrimaryEntity(entity.ID).AddEntities(entity).SaveX(ctx)
+
+	uri, err := fs.NewUriFromString("cloudreve://my/a.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx = context.WithValue(ctx, dependency.DepCtx{}, dep)
+	ctx = context.WithValue(ctx, inventory.UserCtx{}, u)
+	patch := func(offset, size int64, body string) error {
+		m := NewFileManager(dep, u)
+		defer m.Recycle()
+		_, err := m.PatchContent(ctx, uri, offset, size, strings.NewReader(body), "")
+		return err
+	}
+
+	a.NoError(patch(5, 6, " world"))
+	a.Equal(1, db.Entity.Query().CountX(ctx), "content is written in place")
+	a.EqualValues(11, db.Entity.GetX(ctx, entity.ID).Size)
+	content, _ := os.ReadFile(src)
+	a.Equal("hello world", string(content))
+
+	// Body shorter than declared size does not change the recorded size
+	a.Error(patch(11, 10, "!"))
+	a.EqualValues(11, db.Entity.GetX(ctx, entity.ID).Size)
+}
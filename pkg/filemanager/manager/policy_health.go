@@ -0,0 +1,106 @@
+package manager
+
+import (
+	"context"
+	"encoding/gob"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const (
+	// PolicyHealthKVPrefix is the KV key prefix of the latest health check result of storage policies.
+	PolicyHealthKVPrefix = "policy_health_"
+	policyHealthTTL      = 7 * 24 * 3600
+	// policyHealthProbePath is listed to verify the storage is reachable, it does not need to exist.
+	policyHealthProbePath = ".cloudreve_health_probe"
+	policyHealthTimeout   = 30 * time.Second
+	policyHealthPageSize  = 100
+)
+
+// PolicyHealth is the result of the latest health check of a storage policy.
+type PolicyHealth struct {
+	Healthy   bool      `json:"healthy"`
+	CheckedAt time.Time `json:"checked_at"`
+	Error     string    `json:"error,omitempty"`
+}
+
+func init() {
+	gob.Register(PolicyHealth{})
+	crontab.Register(setting.CronTypePolicyHealthCheck, CronPolicyHealthCheck)
+}
+
+// GetPolicyHealth returns the latest health check result of given policy, nil if never checked.
+func GetPolicyHealth(dep dependency.Dep, policyID int) *PolicyHealth {
+	if res, ok := dep.KV().Get(PolicyHealthKVPrefix + strconv.Itoa(policyID)); ok {
+		health := res.(PolicyHealth)
+		return &health
+	}
+
+	return nil
+}
+
+// CronPolicyHealthCheck verifies every storage policy is reachable and records the result.
+func CronPolicyHealthCheck(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	fm := NewFileManager(dep, inventory.UserFromContext(ctx)).(*manager)
+	policyClient := dep.StoragePolicyClient()
+
+	checked, unhealthy := 0, 0
+	for page := 0; ; page++ {
+		res, err := policyClient.ListPolicies(ctx, &inventory.ListPolicyParameters{
+			PaginationArgs: &inventory.PaginationArgs{
+				Page:     page,
+				PageSize: policyHealthPageSize,
+			},
+		})
+		if err != nil {
+			l.Error("Failed to list storage policies: %s", err)
+			return
+		}
+
+		for _, p := range res.Policies {
+			health := PolicyHealth{Healthy: true, CheckedAt: time.Now()}
+			if err := fm.checkPolicyHealth(ctx, p.ID); err != nil {
+				l.Warning("Storage policy %q (#%d) is unhealthy: %s", p.Name, p.ID, err)
+				health.Healthy = false
+				health.Error = err.Error()
+				unhealthy++
+			}
+
+			if err := dep.KV().Set(PolicyHealthKVPrefix+strconv.Itoa(p.ID), health, policyHealthTTL); err != nil {
+				l.Warning("Failed to save health check result of storage policy %d: %s", p.ID, err)
+			}
+			checked++
+		}
+
+		if len(res.Policies) < policyHealthPageSize {
+			break
+		}
+	}
+
+	l.Info("Checked %d storage policies, %d unhealthy.", checked, unhealthy)
+}
+
+func (m *manager) checkPolicyHealth(ctx context.Context, policyID int) error {
+	policy, err := m.dep.StoragePolicyClient().GetPolicyByID(ctx, policyID)
+	if err != nil {
+		return err
+	}
+
+	handler, err := m.GetStorageDriver(ctx, policy)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, policyHealthTimeout)
+	defer cancel()
+
+	_, err = handler.List(ctx, policyHealthProbePath, func(int) {}, false)
+	return err
+}
@@ -0,0 +1,235 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/textpreview"
+	"github.com/cloudreve/Cloudreve/v4/pkg/thumb"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+)
+
+// previewCacheFolder is the folder under temp path where rendered preview pages are cached.
+const previewCacheFolder = "preview"
+
+func init() {
+	crontab.Register(setting.CronTypePreviewCache, CronCollectPreviewCache)
+}
+
+type (
+	// Rendition is a preview image of a file that cannot be displayed by browsers directly, larger than thumbnail.
+	Rendition struct {
+		io.ReadSeeker
+		io.Closer
+		Name    string
+		ModTime time.Time
+	}
+)
+
+// Preview returns the preview rendition of given page (starting from 1) of the file.
+func (m *manager) Preview(ctx context.Context, uri *fs.URI, page int) (*Rendition, error) {
+	file, err := m.fs.Get(ctx, uri, dbfs.WithFileEntities(),
+		dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityDownloadFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	latest := file.PrimaryEntity()
+	if file.Type() != types.FileTypeFile || latest == nil || latest.ID() == 0 {
+		return nil, fs.ErrEntityNotExist
+	}
+
+	if m.settings.RawThumbGeneratorEnabled(ctx) && util.IsInExtensionList(m.settings.RawThumbExts(ctx), file.DisplayName()) {
+		return m.rawPreview(ctx, file, latest)
+	}
+
+	if m.settings.PdfThumbGeneratorEnabled(ctx) && util.IsInExtensionList(m.settings.PdfThumbExts(ctx), file.DisplayName()) {
+		return m.pdfPreview(ctx, file, latest, page)
+	}
+
+	return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("no preview rendition available for %q", file.DisplayName()))
+}
+
+// rawPreview serves the embedded full size JPEG preview of RAW photos, only the preview part is read from storage.
+func (m *manager) rawPreview(ctx context.Context, file fs.File, latest fs.Entity) (*Rendition, error) {
+	if latest.Size() > m.settings.RawThumbMaxSize(ctx) {
+		return nil, fs.ErrFileSizeTooBig
+	}
+
+	es, err := m.GetEntitySource(ctx, 0, fs.WithEntity(latest))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entity source: %w", err)
+	}
+
+	preview, err := thumb.FindRawPreview(es, latest.Size())
+	if err != nil {
+		es.Close()
+		return nil, fs.ErrEntityNotExist.WithError(err)
+	}
+
+	return &Rendition{
+		ReadSeeker: io.NewSectionReader(es, preview.Offset, preview.Length),
+		Closer:     es,
+		Name:       file.DisplayName() + ".jpg",
+		ModTime:    file.UpdatedAt(),
+	}, nil
+}
+
+// pdfPreview renders given page of PDF files on demand. Rendered pages are cached as sidecars in the preview
+// cache folder, keyed by entity ID, and collected once unused for longer than the cache TTL.
+func (m *manager) pdfPreview(ctx context.Context, file fs.File, latest fs.Entity, page int) (*Rendition, error) {
+	if page < 1 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "invalid page number", nil)
+	}
+
+	name := fmt.Sprintf("%s_%d.jpg", file.DisplayName(), page)
+	cachePath := filepath.Join(previewCacheRoot(ctx, m.settings), strconv.Itoa(latest.ID()), fmt.Sprintf("%d.jpg", page))
+	if cached, err := os.Open(cachePath); err == nil {
+		now := time.Now()
+		_ = os.Chtimes(cachePath, now, now)
+		return &Rendition{ReadSeeker: cached, Closer: cached, Name: name, ModTime: file.UpdatedAt()}, nil
+	}
+
+	if latest.Size() > m.settings.PdfThumbMaxSize(ctx) {
+		return nil, fs.ErrFileSizeTooBig
+	}
+
+	es, err := m.GetEntitySource(ctx, 0, fs.WithEntity(latest))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entity source: %w", err)
+	}
+
+	defer es.Close()
+
+	// Render into a temp file first, so that concurrent requests never see a partial sidecar.
+	if err := util.CreatNestedFolder(filepath.Dir(cachePath)); err != nil {
+		return nil, fmt.Errorf("failed to create preview cache folder: %w", err)
+	}
+
+	tempPath := fmt.Sprintf("%s.%s.jpg", strings.TrimSuffix(cachePath, ".jpg"), uuid.Must(uuid.NewV4()).String())
+	defer os.Remove(tempPath)
+	if err := thumb.RenderPdfPage(ctx, m.settings.PdfToPpmPath(ctx), es, page, m.settings.PdfPreviewSize(ctx),
+		m.settings.ThumbEncode(ctx).Quality, tempPath); err != nil {
+		if errors.Is(err, thumb.ErrPdfPageNotExist) {
+			return nil, fs.ErrEntityNotExist.WithError(err)
+		}
+
+		return nil, fmt.Errorf("failed to render page %d: %w", page, err)
+	}
+
+	if err := os.Rename(tempPath, cachePath); err != nil {
+		return nil, fmt.Errorf("failed to save preview sidecar: %w", err)
+	}
+
+	rendered, err := os.Open(cachePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open preview sidecar: %w", err)
+	}
+
+	return &Rendition{ReadSeeker: rendered, Closer: rendered, Name: name, ModTime: file.UpdatedAt()}, nil
+}
+
+// TextPreview reads at most the configured bytes of the text file, decoding it into UTF-8 from the detected
+// encoding, so that large or legacy encoded files do not stall or garble in browsers.
+func (m *manager) TextPreview(ctx context.Context, uri *fs.URI) (*textpreview.Result, error) {
+	file, err := m.fs.Get(ctx, uri, dbfs.WithFileEntities(),
+		dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityDownloadFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	latest := file.PrimaryEntity()
+	if file.Type() != types.FileTypeFile {
+		return nil, fs.ErrEntityNotExist
+	}
+
+	if latest == nil || latest.ID() == 0 {
+		// Empty file
+		return &textpreview.Result{Encoding: textpreview.EncodingUTF8, Language: textpreview.Language(file.DisplayName(), "")}, nil
+	}
+
+	es, err := m.GetEntitySource(ctx, 0, fs.WithEntity(latest))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entity source: %w", err)
+	}
+
+	defer es.Close()
+	limit := m.settings.TextPreviewMaxSize(ctx)
+	data, err := io.ReadAll(io.LimitReader(es, limit))
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeIOFailed, "Failed to read file", err)
+	}
+
+	res := textpreview.Decode(data, latest.Size() > int64(len(data)))
+	res.Language = textpreview.Language(file.DisplayName(), res.Content)
+	return res, nil
+}
+
+func previewCacheRoot(ctx context.Context, settings setting.Provider) string {
+	return filepath.Join(util.DataPath(settings.TempPath(ctx)), previewCacheFolder)
+}
+
+// CronCollectPreviewCache removes preview sidecars unused for longer than the cache TTL.
+func CronCollectPreviewCache(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	settings := dep.SettingProvider()
+	root := previewCacheRoot(ctx, settings)
+	expireBefore := time.Now().Add(-settings.PreviewCacheTTL(ctx))
+
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			l.Warning("Failed to read preview cache folder: %s", err)
+		}
+		return
+	}
+
+	removed := 0
+	for _, entry := range entries {
+		dir := filepath.Join(root, entry.Name())
+		pages, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		kept := 0
+		for _, p := range pages {
+			info, err := p.Info()
+			if err != nil || info.ModTime().After(expireBefore) {
+				kept++
+				continue
+			}
+
+			if err := os.Remove(filepath.Join(dir, p.Name())); err != nil {
+				l.Warning("Failed to remove preview sidecar %q: %s", p.Name(), err)
+				kept++
+				continue
+			}
+			removed++
+		}
+
+		if kept == 0 {
+			_ = os.Remove(dir)
+		}
+	}
+
+	if removed > 0 {
+		l.Info("%d expired preview sidecars are removed.", removed)
+	}
+}
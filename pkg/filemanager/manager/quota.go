@@ -0,0 +1,192 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/email"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webhook"
+)
+
+var errQuotaAlertUnchanged = errors.New("quota alert state unchanged")
+
+const (
+	quotaAlertPageSize = 500
+	// quotaDigestKey marks that admin digest has been sent in the last 24 hours.
+	quotaDigestKey = "quota_alert_digest"
+	quotaDigestTTL = 86400
+)
+
+func init() {
+	crontab.Register(setting.CronTypeQuotaAlert, CronQuotaAlert)
+}
+
+// CronQuotaAlert checks storage usage of all active users, sends alerts to users crossing
+// configured thresholds, and a digest of users over quota to admins.
+func CronQuotaAlert(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	conf := dep.SettingProvider().QuotaAlert(ctx)
+	if !conf.Enabled || len(conf.Thresholds) == 0 {
+		return
+	}
+
+	var (
+		admins    []*ent.User
+		overQuota []email.QuotaDigestUser
+	)
+
+	uc := dep.UserClient()
+	ctx = context.WithValue(ctx, inventory.LoadUserGroup{}, true)
+	for page := 0; ; page++ {
+		res, err := uc.ListUsers(ctx, &inventory.ListUserParameters{
+			PaginationArgs: &inventory.PaginationArgs{
+				Page:     page,
+				PageSize: quotaAlertPageSize,
+			},
+			Status: user.StatusActive,
+		})
+		if err != nil {
+			l.Error("Failed to list users for quota alert: %s", err)
+			return
+		}
+
+		for _, u := range res.Users {
+			if u.Edges.Group != nil && u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+				admins = append(admins, u)
+			}
+
+			percent := storageUsagePercent(u)
+			if percent >= 100 {
+				overQuota = append(overQuota, email.QuotaDigestUser{Email: u.Email, Percent: percent})
+			}
+
+			checkQuotaAlert(ctx, dep, conf, u, percent)
+		}
+
+		if len(res.Users) < quotaAlertPageSize {
+			break
+		}
+	}
+
+	if !conf.AdminDigest || len(overQuota) == 0 {
+		return
+	}
+
+	kv := dep.KV()
+	if _, ok := kv.Get(quotaDigestKey); ok {
+		return
+	}
+
+	for _, admin := range admins {
+		title, body, err := email.NewQuotaDigestEmail(ctx, dep.SettingProvider(), admin, overQuota)
+		if err != nil {
+			l.Warning("Failed to generate quota digest email: %s", err)
+			return
+		}
+
+		if err := dep.EmailClient(ctx).Send(ctx, admin.Email, title, body); err != nil {
+			l.Warning("Failed to send quota digest email to %q: %s", admin.Email, err)
+		}
+	}
+
+	_ = kv.Set(quotaDigestKey, true, quotaDigestTTL)
+}
+
+// checkQuotaAlert notifies user if a new threshold is crossed, or the alert of the same threshold is
+// sent more than one period ago.
+func checkQuotaAlert(ctx context.Context, dep dependency.Dep, conf *setting.QuotaAlert, u *ent.User, percent int) {
+	l := dep.Logger()
+	crossed := 0
+	for _, threshold := range conf.Thresholds {
+		if percent >= threshold {
+			crossed = threshold
+		}
+	}
+
+	// Alert state is updated on the locked row so that concurrent settings changes are not overwritten.
+	notify := false
+	_, err := dep.UserClient().UpdateSettings(ctx, u.ID, func(locked *ent.User) error {
+		state := locked.Settings.QuotaAlert
+		now := time.Now()
+		switch {
+		case crossed == 0 && state == nil:
+			return errQuotaAlertUnchanged
+		case crossed == 0:
+			// Usage dropped below all thresholds, reset alert state.
+			locked.Settings.QuotaAlert = nil
+		case state == nil || crossed > state.Threshold ||
+			now.Sub(state.NotifiedAt) > time.Duration(conf.Period)*time.Second:
+			locked.Settings.QuotaAlert = &types.QuotaAlert{Threshold: crossed, NotifiedAt: now}
+			notify = true
+		case crossed < state.Threshold:
+			// Usage dropped to a lower threshold, so that crossing the higher one again is notified.
+			locked.Settings.QuotaAlert.Threshold = crossed
+		default:
+			return errQuotaAlertUnchanged
+		}
+
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, errQuotaAlertUnchanged) {
+			l.Warning("Failed to save quota alert state of user %d: %s", u.ID, err)
+		}
+		return
+	}
+
+	if !notify {
+		return
+	}
+
+	total := inventory.EffectiveMaxStorage(u)
+	emitEvent(ctx, dep, webhook.EventQuotaAlert, &webhook.QuotaEvent{
+		User:    webhook.BuildUserData(u, dep.HashIDEncoder()),
+		Percent: percent,
+		Used:    u.Storage,
+		Total:   total,
+	})
+
+	if _, err := dep.NotificationClient().Create(ctx, u.ID, &inventory.NotificationArgs{
+		Type: types.NotificationTypeQuotaAlert,
+		Props: map[string]string{
+			"percent": strconv.Itoa(percent),
+			"used":    strconv.FormatInt(u.Storage, 10),
+			"total":   strconv.FormatInt(total, 10),
+		},
+	}); err != nil {
+		l.Warning("Failed to create quota alert notification for user %d: %s", u.ID, err)
+	}
+
+	title, body, err := email.NewQuotaAlertEmail(ctx, dep.SettingProvider(), u, percent, u.Storage, total)
+	if err != nil {
+		l.Warning("Failed to generate quota alert email: %s", err)
+		return
+	}
+
+	if err := dep.EmailClient(ctx).Send(ctx, u.Email, title, body); err != nil {
+		l.Warning("Failed to send quota alert email to %q: %s", u.Email, err)
+	}
+}
+
+// storageUsagePercent returns storage usage of the user in percentage.
+func storageUsagePercent(u *ent.User) int {
+	total := inventory.EffectiveMaxStorage(u)
+	if total <= 0 {
+		if u.Storage > 0 {
+			return 100
+		}
+		return 0
+	}
+
+	return int(u.Storage * 100 / total)
+}
@@ -0,0 +1,128 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const (
+	savedSearchPageSize = 100
+	// savedSearchMaxMatches is the maximum number of new matches counted in each check.
+	savedSearchMaxMatches = 100
+)
+
+func init() {
+	crontab.Register(setting.CronTypeSavedSearch, CronSavedSearch)
+}
+
+// CronSavedSearch re-runs saved searches with notification enabled, owners are notified when files created
+// since the last check match the query.
+func CronSavedSearch(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+
+	afterID := 0
+	for {
+		searches, err := dep.SavedSearchClient().ListNotify(ctx, afterID, savedSearchPageSize)
+		if err != nil {
+			l.Error("Failed to list saved searches: %s", err)
+			return
+		}
+
+		for _, saved := range searches {
+			checkSavedSearch(ctx, dep, saved)
+		}
+
+		if len(searches) < savedSearchPageSize {
+			break
+		}
+		afterID = searches[len(searches)-1].ID
+	}
+}
+
+func checkSavedSearch(ctx context.Context, dep dependency.Dep, saved *ent.SavedSearch) {
+	l := dep.Logger()
+	owner, err := dep.UserClient().GetLoginUserByID(ctx, saved.UserID)
+	if err != nil {
+		// Owner is not active, check later.
+		l.Debug("Skip saved search %d of inactive user %d: %s", saved.ID, saved.UserID, err)
+		return
+	}
+
+	since := saved.CreatedAt
+	if saved.LastCheckedAt != nil {
+		since = *saved.LastCheckedAt
+	}
+
+	uri, err := SavedSearchNewMatchesUri(saved.URI, since)
+	if err != nil {
+		l.Warning("Invalid URI of saved search %d: %s", saved.ID, err)
+		return
+	}
+
+	checkedAt := time.Now()
+	ctx = context.WithValue(ctx, inventory.UserCtx{}, owner)
+	fm := NewFileManager(dep, owner)
+	defer fm.Recycle()
+
+	_, res, err := fm.List(ctx, uri, &ListArgs{PageSize: savedSearchMaxMatches})
+	if err != nil {
+		l.Warning("Failed to run saved search %d: %s", saved.ID, err)
+	} else if len(res.Files) > 0 {
+		notifySavedSearchMatch(ctx, dep, saved, uri, len(res.Files), res.Pagination != nil && res.Pagination.NextPageToken != "")
+	}
+
+	if err := dep.SavedSearchClient().MarkChecked(ctx, saved.ID, checkedAt); err != nil {
+		l.Warning("Failed to update check time of saved search %d: %s", saved.ID, err)
+	}
+}
+
+func notifySavedSearchMatch(ctx context.Context, dep dependency.Dep, saved *ent.SavedSearch, uri *fs.URI, matches int, more bool) {
+	count := strconv.Itoa(matches)
+	if more {
+		count += "+"
+	}
+
+	if _, err := dep.NotificationClient().Create(ctx, saved.UserID, &inventory.NotificationArgs{
+		Type: types.NotificationTypeSavedSearchMatch,
+		Link: routes.FrontendHomeUrl(dep.SettingProvider().SiteURL(ctx), uri.String()).String(),
+		Props: map[string]string{
+			"name":  saved.Name,
+			"count": count,
+		},
+	}); err != nil {
+		dep.Logger().Warning("Failed to create saved search notification for user %d: %s", saved.UserID, err)
+	}
+}
+
+// SavedSearchNewMatchesUri returns the search URI of saved search limited to files created since given time.
+func SavedSearchNewMatchesUri(raw string, since time.Time) (*fs.URI, error) {
+	uri, err := fs.NewUriFromString(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if uri.SearchParameters() == nil {
+		return nil, fmt.Errorf("no search parameters in %q", raw)
+	}
+
+	q := uri.U.Query()
+	createdGte := since.Unix()
+	if v, err := strconv.ParseInt(q.Get(fs.QuerySearchCreatedGte), 10, 64); err == nil && v > createdGte {
+		createdGte = v
+	}
+	q.Set(fs.QuerySearchCreatedGte, strconv.FormatInt(createdGte, 10))
+
+	return uri.SetQuery(q.Encode()), nil
+}
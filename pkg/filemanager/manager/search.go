@@ -0,0 +1,399 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/search"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+)
+
+const (
+	// SearchIndexCursorKey stores the update time and ID of the last file indexed by CronSearchIndex.
+	SearchIndexCursorKey = "search_index_cursor"
+	// searchIndexMaxBatches limits batches indexed in each scheduled run, rest of the files are left to next run.
+	searchIndexMaxBatches = 20
+)
+
+func init() {
+	crontab.Register(setting.CronTypeSearchIndex, CronSearchIndex)
+}
+
+type (
+	// FullTextSearchHit is a file matched by full-text search.
+	FullTextSearchHit struct {
+		File  fs.File
+		Score float64
+		// Highlights are HTML escaped fragments of matched fields, matched terms are wrapped in <mark>.
+		Highlights map[string][]string
+	}
+
+	FullTextSearchResult struct {
+		Hits []FullTextSearchHit
+		// Total is the number of matched files, including those no longer accessible.
+		Total uint64
+		// TotalEstimated is true if Total is a lower bound.
+		TotalEstimated bool
+		// Next is the cursor of next page, nil if there are no more hits. It is the position of the last hit
+		// returned by the index, which may be skipped in Hits.
+		Next *search.Cursor
+	}
+)
+
+// FullTextSearch searches files in the full-text index, ordered by relevance. Files of current user are searched
+// if owner is not specified in the query, only admins can search files of other users. Extensions of the
+// predefined category are added into filters if given, fuzzy and pinyin matching follow site settings. Files
+// no longer exist are removed from the index, those in trash bin are skipped.
+func (m *manager) FullTextSearch(ctx context.Context, q *search.Query, category string) (*FullTextSearchResult, error) {
+	if q.OwnerID == 0 {
+		q.OwnerID = m.user.ID
+	}
+
+	if q.OwnerID != m.user.ID && !m.user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+		return nil, fs.ErrOwnerOnly.WithError(fmt.Errorf("only admin can search files of other users"))
+	}
+
+	if category != "" {
+		params, err := m.searchCategoryParameters(ctx, category)
+		if err != nil {
+			return nil, err
+		}
+
+		q.Exts = append(q.Exts, categoryExts(params)...)
+	}
+
+	settings := m.settings.FullTextSearch(ctx)
+	q.Fuzzy = settings.Fuzzy
+	q.Pinyin = settings.Pinyin
+
+	res, err := m.dep.SearchIndexer(ctx).Search(ctx, q)
+	if err != nil {
+		if errors.Is(err, search.ErrDisabled) {
+			return nil, fs.ErrNotSupportedAction.WithError(err)
+		}
+
+		return nil, err
+	}
+
+	hits := make([]FullTextSearchHit, 0, len(res.Hits))
+	stale := make([]int, 0)
+	for _, hit := range res.Hits {
+		traversed, err := m.fs.TraverseFile(ctx, hit.ID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				stale = append(stale, hit.ID)
+			}
+			continue
+		}
+
+		uri := traversed.Uri(false)
+		if uri == nil || uri.FileSystem() != constants.FileSystemMy {
+			continue
+		}
+
+		file, err := m.fs.Get(ctx, uri, dbfs.WithFilePublicMetadata(), dbfs.WithNotRoot())
+		if err != nil {
+			continue
+		}
+
+		hits = append(hits, FullTextSearchHit{File: file, Score: hit.Score, Highlights: hit.Highlights})
+	}
+
+	if len(stale) > 0 {
+		if err := m.dep.SearchIndexer(ctx).Delete(ctx, stale...); err != nil {
+			m.l.Warning("Failed to remove deleted files from search index: %s", err)
+		}
+	}
+
+	result := &FullTextSearchResult{Hits: hits, Total: res.Total, TotalEstimated: res.TotalEstimated}
+	if q.Limit > 0 && len(res.Hits) >= q.Limit {
+		result.Next = res.Hits[len(res.Hits)-1].Cursor()
+	}
+
+	return result, nil
+}
+
+// categoryExts extracts extensions from name patterns like "*.jpg" of a search category.
+func categoryExts(params *inventory.SearchFileParameters) []string {
+	exts := make([]string, 0, len(params.Name))
+	for _, name := range params.Name {
+		if ext, ok := strings.CutPrefix(name, "*."); ok && ext != "" && !strings.ContainsAny(ext, "*.") {
+			exts = append(exts, strings.ToLower(ext))
+		}
+	}
+
+	return exts
+}
+
+// searchCategoryParameters parses conditions of a predefined search category, configured as query of search URI.
+func (m *manager) searchCategoryParameters(ctx context.Context, name string) (*inventory.SearchFileParameters, error) {
+	category := fs.SearchCategoryFromString(name)
+	if category == setting.CategoryUnknown {
+		return nil, serializer.NewError(serializer.CodeParamErr, fmt.Sprintf("Unknown category: %s", name), nil)
+	}
+
+	uri, err := fs.NewUriFromString(fs.NewMyUri(""))
+	if err != nil {
+		return nil, err
+	}
+
+	params := uri.SetQuery(m.settings.SearchCategoryQuery(ctx, category)).SearchParameters()
+	if params == nil {
+		return &inventory.SearchFileParameters{}, nil
+	}
+
+	return params, nil
+}
+
+// searchIndexURIs updates full-text index of files at given URIs.
+func (m *manager) searchIndexURIs(ctx context.Context, uris ...*fs.URI) {
+	if !m.settings.FullTextSearch(ctx).Enabled {
+		return
+	}
+
+	ids := make([]int, 0, len(uris))
+	for _, uri := range uris {
+		if file, err := m.fs.Get(ctx, uri); err == nil {
+			ids = append(ids, file.ID())
+		}
+	}
+
+	searchIndexFiles(ctx, m.dep, ids...)
+}
+
+// searchIndexFiles updates full-text index of given files. External search engines are updated asynchronously
+// in media meta queue, without blocking file operations on network round trips.
+func searchIndexFiles(ctx context.Context, dep dependency.Dep, ids ...int) {
+	settings := dep.SettingProvider().FullTextSearch(ctx)
+	if !settings.Enabled || len(ids) == 0 {
+		return
+	}
+
+	if settings.Provider == setting.SearchProviderBleve {
+		indexFiles(ctx, dep, settings, ids...)
+		return
+	}
+
+	if err := dep.MediaMetaQueue(ctx).QueueTask(ctx, newSearchIndexTask(ctx, ids)); err != nil {
+		dep.Logger().Warning("Failed to queue search index task: %s", err)
+	}
+}
+
+// SearchIndexTask updates full-text index of files, running in media meta queue.
+type SearchIndexTask struct {
+	*queue.InMemoryTask
+	fileIDs []int
+}
+
+func newSearchIndexTask(ctx context.Context, ids []int) *SearchIndexTask {
+	return &SearchIndexTask{
+		InMemoryTask: &queue.InMemoryTask{
+			DBTask: &queue.DBTask{
+				Task: &ent.Task{
+					Type:          queue.SearchIndexTaskType,
+					CorrelationID: logging.CorrelationID(ctx),
+					PublicState:   &types.TaskPublicState{},
+				},
+			},
+		},
+		fileIDs: ids,
+	}
+}
+
+func (t *SearchIndexTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	settings := dep.SettingProvider().FullTextSearch(ctx)
+	if settings.Enabled {
+		indexFiles(ctx, dep, settings, t.fileIDs...)
+	}
+
+	return task.StatusCompleted, nil
+}
+
+// indexFiles updates full-text index of given files, files not found are removed from the index. Errors are
+// only logged, as changes of file rows are eventually indexed by CronSearchIndex.
+func indexFiles(ctx context.Context, dep dependency.Dep, settings *setting.FullTextSearch, ids ...int) {
+
+	ctx = context.WithValue(ctx, inventory.LoadFileMetadata{}, true)
+	found := make(map[int]bool, len(ids))
+	docs := make([]*search.Document, 0, len(ids))
+	for page := 0; ; page++ {
+		files, next, err := dep.FileClient().GetByIDs(ctx, ids, page)
+		if err != nil {
+			dep.Logger().Warning("Failed to load files for search index: %s", err)
+			return
+		}
+
+		for _, file := range files {
+			found[file.ID] = true
+			if doc := searchDocument(file, settings.MaxTextSize); doc != nil {
+				docs = append(docs, doc)
+			}
+		}
+
+		if next <= page+1 {
+			break
+		}
+	}
+
+	indexer := dep.SearchIndexer(ctx)
+	if err := indexer.Index(ctx, docs...); err != nil {
+		dep.Logger().Warning("Failed to update search index: %s", err)
+	}
+
+	missing := make([]int, 0)
+	for _, id := range ids {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+
+	if len(missing) > 0 {
+		if err := indexer.Delete(ctx, missing...); err != nil {
+			dep.Logger().Warning("Failed to remove deleted files from search index: %s", err)
+		}
+	}
+}
+
+// searchDocument builds the indexed content of a file, nil if the file should not be searchable, e.g. root
+// folders and files being uploaded. Metadata must be loaded.
+func searchDocument(file *ent.File, maxTextSize int) *search.Document {
+	// Root folders have no parent.
+	if file.FileChildren == 0 {
+		return nil
+	}
+
+	doc := &search.Document{
+		ID:        file.ID,
+		OwnerID:   file.OwnerID,
+		Type:      file.Type,
+		Size:      file.Size,
+		UpdatedAt: file.UpdatedAt,
+		CreatedAt: file.CreatedAt,
+		Ext:       util.Ext(file.Name),
+		PolicyID:  file.StoragePolicyFiles,
+		Name:      file.Name,
+	}
+
+	for _, meta := range file.Edges.Metadata {
+		switch {
+		case meta.Name == dbfs.MetadataUploadSessionID:
+			return nil
+		case meta.Name == descriptionMetadataKey:
+			doc.Description = meta.Value
+		case meta.Name == dbfs.MetadataOcrText, meta.Name == dbfs.MetadataLinkUrl:
+			// Metadata are not ordered, append so that neither overwrites the other.
+			if doc.Text != "" {
+				doc.Text += "\n"
+			}
+			doc.Text = truncateUTF8(doc.Text+meta.Value, maxTextSize)
+		case strings.HasPrefix(meta.Name, tagMetadataSuffix+":"):
+			doc.Tags = append(doc.Tags, strings.TrimPrefix(meta.Name, tagMetadataSuffix+":"))
+		}
+	}
+
+	return doc
+}
+
+func truncateUTF8(s string, size int) string {
+	if size <= 0 || len(s) <= size {
+		return s
+	}
+
+	for size > 0 && !utf8.RuneStart(s[size]) {
+		size--
+	}
+	return s[:size]
+}
+
+// CronSearchIndex indexes files created or updated since last run, in ascending order of update time. The
+// position is kept in KV, so that a new index is built from existing files gradually.
+func CronSearchIndex(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	settings := dep.SettingProvider().FullTextSearch(ctx)
+	if !settings.Enabled {
+		return
+	}
+
+	kv := dep.KV()
+	after, afterID := SearchIndexCursor(kv)
+	indexer := dep.SearchIndexer(ctx)
+	loadCtx := context.WithValue(ctx, inventory.LoadFileMetadata{}, true)
+	indexed := 0
+	for batch := 0; batch < searchIndexMaxBatches; batch++ {
+		files, err := dep.FileClient().ListUpdatedFiles(loadCtx, &inventory.ListUpdatedFilesParameters{
+			After:   after,
+			AfterID: afterID,
+			Limit:   settings.BatchSize,
+		})
+		if err != nil {
+			l.Error("Failed to list updated files for search index: %s", err)
+			break
+		}
+
+		if len(files) == 0 {
+			break
+		}
+
+		docs := make([]*search.Document, 0, len(files))
+		for _, file := range files {
+			if doc := searchDocument(file, settings.MaxTextSize); doc != nil {
+				docs = append(docs, doc)
+			}
+		}
+
+		if err := indexer.Index(ctx, docs...); err != nil {
+			l.Error("Failed to update search index: %s", err)
+			break
+		}
+
+		last := files[len(files)-1]
+		after, afterID = last.UpdatedAt, last.ID
+		_ = kv.Set(SearchIndexCursorKey, fmt.Sprintf("%d:%d", after.UnixNano(), afterID), 0)
+		indexed += len(docs)
+
+		if len(files) < settings.BatchSize {
+			break
+		}
+	}
+
+	if indexed > 0 {
+		l.Info("%d updated files are added into search index.", indexed)
+	}
+}
+
+// SearchIndexCursor returns the update time and ID of the last file indexed by CronSearchIndex.
+func SearchIndexCursor(kv cache.Driver) (time.Time, int) {
+	if cursor, ok := kv.Get(SearchIndexCursorKey); ok {
+		if s, ok := cursor.(string); ok {
+			ts, id, _ := strings.Cut(s, ":")
+			nano, err1 := strconv.ParseInt(ts, 10, 64)
+			fileID, err2 := strconv.Atoi(id)
+			if err1 == nil && err2 == nil {
+				return time.Unix(0, nano), fileID
+			}
+		}
+	}
+
+	return time.Time{}, 0
+}
@@ -0,0 +1,199 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/search"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+)
+
+const (
+	searchRebuildReportFile = "search_rebuild.json"
+	searchRebuildLockKey    = "search_rebuild_lock"
+	// searchRebuildLockTTL is the maximum time a rebuild can hold the lock, in seconds.
+	searchRebuildLockTTL = 12 * 3600
+)
+
+type (
+	SearchRebuildStatus string
+
+	// SearchRebuildReport is the progress and result of rebuilding the full-text search index.
+	SearchRebuildReport struct {
+		Status SearchRebuildStatus `json:"status"`
+		Error  string              `json:"error,omitempty"`
+		// UserID is the owner whose files are reindexed, 0 if the whole index is rebuilt.
+		UserID     int        `json:"user_id,omitempty"`
+		StartedAt  time.Time  `json:"started_at"`
+		FinishedAt *time.Time `json:"finished_at,omitempty"`
+		// Total is the number of files to index when started, Processed may exceed it if files are
+		// updated during rebuilding.
+		Total     int `json:"total"`
+		Processed int `json:"processed"`
+	}
+)
+
+const (
+	SearchRebuildStatusRunning   = SearchRebuildStatus("running")
+	SearchRebuildStatusCompleted = SearchRebuildStatus("completed")
+	SearchRebuildStatusFailed    = SearchRebuildStatus("failed")
+)
+
+var ErrSearchRebuildRunning = serializer.NewError(serializer.CodeConflict, "Search index is being rebuilt", nil)
+
+// IsSearchRebuildRunning returns whether a search index rebuild is running.
+func IsSearchRebuildRunning(dep dependency.Dep) bool {
+	_, ok := dep.KV().Get(searchRebuildLockKey)
+	return ok
+}
+
+// LoadSearchRebuildReport returns the latest search index rebuild report, nil if never run.
+func LoadSearchRebuildReport() (*SearchRebuildReport, error) {
+	content, err := os.ReadFile(util.DataPath(searchRebuildReportFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read search rebuild report: %w", err)
+	}
+
+	report := &SearchRebuildReport{}
+	if err := json.Unmarshal(content, report); err != nil {
+		return nil, fmt.Errorf("failed to decode search rebuild report: %w", err)
+	}
+
+	return report, nil
+}
+
+func saveSearchRebuildReport(report *SearchRebuildReport) error {
+	content, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("failed to encode search rebuild report: %w", err)
+	}
+
+	dst := util.DataPath(searchRebuildReportFile)
+	if err := os.WriteFile(dst+".tmp", content, 0600); err != nil {
+		return fmt.Errorf("failed to write search rebuild report: %w", err)
+	}
+
+	return os.Rename(dst+".tmp", dst)
+}
+
+// RebuildSearchIndex indexes all files of given user again, or rebuilds the whole index if uid is 0. A whole
+// index is filled aside and swapped in once completed, so that files no longer exist are dropped, while
+// searches are served by the current index meanwhile. Documents of deleted files are kept in a per-user
+// reindex, they are removed once found in search results.
+func RebuildSearchIndex(ctx context.Context, dep dependency.Dep, uid int) (*SearchRebuildReport, error) {
+	settings := dep.SettingProvider().FullTextSearch(ctx)
+	if !settings.Enabled {
+		return nil, serializer.NewError(serializer.CodeFeatureNotEnabled, "Full text search is not enabled", nil)
+	}
+
+	kv := dep.KV()
+	locked, err := kv.SetNX(searchRebuildLockKey, true, searchRebuildLockTTL)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to lock search index rebuild", err)
+	}
+	if !locked {
+		return nil, ErrSearchRebuildRunning
+	}
+	defer kv.Delete("", searchRebuildLockKey)
+
+	report := &SearchRebuildReport{
+		Status:    SearchRebuildStatusRunning,
+		UserID:    uid,
+		StartedAt: time.Now(),
+	}
+
+	report.Total, err = dep.FileClient().CountUpdatedFiles(ctx, &inventory.ListUpdatedFilesParameters{OwnerID: uid})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to count files to index", err)
+	}
+
+	if err := saveSearchRebuildReport(report); err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to save search rebuild report", err)
+	}
+
+	fill := func(ctx context.Context, target search.Indexer) error {
+		return fillSearchIndex(ctx, dep, settings.BatchSize, settings.MaxTextSize, target, report)
+	}
+
+	indexer := dep.SearchIndexer(ctx)
+	if rebuilder, ok := indexer.(search.Rebuilder); ok && uid == 0 {
+		err = rebuilder.Rebuild(ctx, fill)
+	} else {
+		err = fill(ctx, indexer)
+	}
+
+	if err != nil {
+		report.Status = SearchRebuildStatusFailed
+		report.Error = err.Error()
+	} else {
+		report.Status = SearchRebuildStatusCompleted
+	}
+
+	finishedAt := time.Now()
+	report.FinishedAt = &finishedAt
+	if err := saveSearchRebuildReport(report); err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to save search rebuild report", err)
+	}
+
+	return report, nil
+}
+
+// fillSearchIndex indexes files into target in ascending order of update time, progress is saved after
+// each batch.
+func fillSearchIndex(ctx context.Context, dep dependency.Dep, batchSize, maxTextSize int, target search.Indexer,
+	report *SearchRebuildReport) error {
+	l := dep.Logger()
+	loadCtx := context.WithValue(ctx, inventory.LoadFileMetadata{}, true)
+	args := &inventory.ListUpdatedFilesParameters{
+		OwnerID: report.UserID,
+		Limit:   batchSize,
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		files, err := dep.FileClient().ListUpdatedFiles(loadCtx, args)
+		if err != nil {
+			return err
+		}
+
+		docs := make([]*search.Document, 0, len(files))
+		for _, file := range files {
+			if doc := searchDocument(file, maxTextSize); doc != nil {
+				docs = append(docs, doc)
+			}
+		}
+
+		if err := target.Index(ctx, docs...); err != nil {
+			return err
+		}
+
+		report.Processed += len(files)
+		if err := saveSearchRebuildReport(report); err != nil {
+			l.Warning("Failed to save search rebuild report: %s", err)
+		}
+
+		if len(files) < batchSize {
+			break
+		}
+
+		last := files[len(files)-1]
+		args.After, args.AfterID = last.UpdatedAt, last.ID
+	}
+
+	l.Info("Indexed %d files into search index.", report.Processed)
+	return nil
+}
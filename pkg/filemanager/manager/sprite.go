@@ -0,0 +1,149 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/thumb"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	// spriteSidecarName is the name of sprite sheet image in the preview cache folder of an entity, its layout
+	// is saved next to it in spriteLayoutSidecarName.
+	spriteSidecarName       = "sprite.jpg"
+	spriteLayoutSidecarName = "sprite.json"
+)
+
+// VideoSprite returns the sprite sheet layout of the latest version of given video file.
+func (m *manager) VideoSprite(ctx context.Context, uri *fs.URI) (*thumb.SpriteSheet, error) {
+	file, latest, err := m.videoSpriteTarget(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+
+	sheet, _, err := m.videoSprite(ctx, file, latest)
+	return sheet, err
+}
+
+// VideoSpriteImage returns the sprite sheet image of the latest version of given video file.
+func (m *manager) VideoSpriteImage(ctx context.Context, uri *fs.URI) (*Rendition, error) {
+	file, latest, err := m.videoSpriteTarget(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+
+	_, image, err := m.videoSprite(ctx, file, latest)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(image)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sprite sidecar: %w", err)
+	}
+
+	return &Rendition{ReadSeeker: f, Closer: f, Name: file.DisplayName() + "_sprite.jpg", ModTime: file.UpdatedAt()}, nil
+}
+
+func (m *manager) videoSpriteTarget(ctx context.Context, uri *fs.URI) (fs.File, fs.Entity, error) {
+	file, err := m.fs.Get(ctx, uri, dbfs.WithFileEntities(),
+		dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityDownloadFile))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	latest := file.PrimaryEntity()
+	if file.Type() != types.FileTypeFile || latest == nil || latest.ID() == 0 {
+		return nil, nil, fs.ErrEntityNotExist
+	}
+
+	settings := m.settings.VideoSprite(ctx)
+	if !settings.Enabled || !util.IsInExtensionList(settings.Exts, file.DisplayName()) {
+		return nil, nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("sprite sheet is not available for %q", file.DisplayName()))
+	}
+
+	return file, latest, nil
+}
+
+// videoSprite reads sprite sheet of the entity from sidecars, generating it if not exist. It returns the layout
+// and path of the image.
+func (m *manager) videoSprite(ctx context.Context, file fs.File, entity fs.Entity) (*thumb.SpriteSheet, string, error) {
+	folder := filepath.Join(previewCacheRoot(ctx, m.settings), strconv.Itoa(entity.ID()))
+	image := filepath.Join(folder, spriteSidecarName)
+	layout := filepath.Join(folder, spriteLayoutSidecarName)
+
+	// Layout is written after the image, so that the image is complete once layout exists.
+	if content, err := os.ReadFile(layout); err == nil {
+		res := &thumb.SpriteSheet{}
+		if err := json.Unmarshal(content, res); err == nil {
+			if _, err := os.Stat(image); err == nil {
+				now := time.Now()
+				_ = os.Chtimes(layout, now, now)
+				_ = os.Chtimes(image, now, now)
+				return res, image, nil
+			}
+		}
+	}
+
+	settings := m.settings.VideoSprite(ctx)
+	if entity.Size() > settings.MaxSize {
+		return nil, "", fs.ErrFileSizeTooBig
+	}
+
+	es, err := m.GetEntitySource(ctx, 0, fs.WithEntity(entity))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get entity source: %w", err)
+	}
+
+	defer es.Close()
+	input, err := ffmpegInput(ctx, es)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Write into temp files first, so that concurrent requests never see partial sidecars.
+	if err := util.CreatNestedFolder(folder); err != nil {
+		return nil, "", fmt.Errorf("failed to create preview cache folder: %w", err)
+	}
+
+	suffix := uuid.Must(uuid.NewV4()).String()
+	tempImage := fmt.Sprintf("%s.%s.jpg", image, suffix)
+	tempLayout := fmt.Sprintf("%s.%s", layout, suffix)
+	defer os.Remove(tempImage)
+	defer os.Remove(tempLayout)
+
+	res, err := thumb.GenerateSpriteSheet(ctx, m.settings.FFMpegPath(ctx), input, tempImage, settings.Frames,
+		settings.Columns, settings.FrameWidth, settings.FrameHeight)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate sprite sheet of %q: %w", file.DisplayName(), err)
+	}
+
+	content, err := json.Marshal(res)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encode sprite sheet layout: %w", err)
+	}
+
+	if err := os.WriteFile(tempLayout, content, 0644); err != nil {
+		return nil, "", fmt.Errorf("failed to write sprite sheet layout: %w", err)
+	}
+
+	if err := os.Rename(tempImage, image); err != nil {
+		return nil, "", fmt.Errorf("failed to save sprite sidecar: %w", err)
+	}
+
+	if err := os.Rename(tempLayout, layout); err != nil {
+		return nil, "", fmt.Errorf("failed to save sprite layout sidecar: %w", err)
+	}
+
+	return res, image, nil
+}
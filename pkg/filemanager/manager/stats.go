@@ -0,0 +1,79 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/stats"
+)
+
+// statsTopUsers is the number of users using most storage kept in daily stats.
+const statsTopUsers = 20
+
+func init() {
+	crontab.Register(setting.CronTypeDailyStats, CronDailyStats)
+}
+
+// CronDailyStats takes a snapshot of storage usage, persists pending usage counters into daily stats,
+// and prunes expired stats.
+func CronDailyStats(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	recorder := dep.StatsRecorder()
+
+	snapshot, err := storageSnapshot(ctx, dep)
+	if err != nil {
+		l.Warning("Failed to take storage usage snapshot: %s", err)
+	}
+
+	if err := recorder.Flush(ctx, snapshot); err != nil {
+		l.Error("Failed to save daily stats: %s", err)
+		return
+	}
+
+	retention := dep.SettingProvider().StatsRetention(ctx)
+	if retention <= 0 {
+		return
+	}
+
+	deleted, err := recorder.Prune(ctx, time.Now().Add(-retention))
+	if err != nil {
+		l.Warning("Failed to prune daily stats: %s", err)
+		return
+	}
+
+	if deleted > 0 {
+		l.Info("Pruned %d day(s) of expired daily stats.", deleted)
+	}
+}
+
+func storageSnapshot(ctx context.Context, dep dependency.Dep) (*stats.StorageSnapshot, error) {
+	policies, err := dep.FileClient().SumEntitySizeByStoragePolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := dep.UserClient().SumStorageByGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	top, err := dep.UserClient().ListTopByStorage(ctx, statsTopUsers)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &stats.StorageSnapshot{
+		Policies: policies,
+		Groups:   groups,
+		TopUsers: make([]stats.UserStorage, 0, len(top)),
+	}
+	for _, u := range top {
+		snapshot.TopUsers = append(snapshot.TopUsers, stats.UserStorage{UserID: u.ID, Storage: u.Storage})
+	}
+
+	return snapshot, nil
+}
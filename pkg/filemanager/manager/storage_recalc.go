@@ -0,0 +1,211 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+)
+
+const (
+	storageRecalcReportFile = "storage_recalc.json"
+	storageRecalcLockKey    = "storage_recalc_lock"
+	// storageRecalcLockTTL is the maximum time a recalculation can hold the lock, in seconds.
+	storageRecalcLockTTL  = 6 * 3600
+	storageRecalcPageSize = 500
+)
+
+type (
+	StorageRecalcStatus string
+
+	// StorageRecalcReport is the result of recalculating used storage of all users.
+	StorageRecalcReport struct {
+		Status StorageRecalcStatus `json:"status"`
+		Error  string              `json:"error,omitempty"`
+		// DryRun only reports discrepancies without updating storage counters.
+		DryRun     bool       `json:"dry_run"`
+		StartedAt  time.Time  `json:"started_at"`
+		FinishedAt *time.Time `json:"finished_at,omitempty"`
+		Checked    int        `json:"checked"`
+		// Discrepancies users whose recorded storage differs from the calculated one.
+		Discrepancies []StorageDiscrepancy `json:"discrepancies"`
+	}
+
+	StorageDiscrepancy struct {
+		UserID   int    `json:"user_id"`
+		Email    string `json:"email"`
+		Recorded int64  `json:"recorded"`
+		Actual   int64  `json:"actual"`
+		// Fixed whether the counter is reconciled, false if in dry run or changed concurrently.
+		Fixed bool `json:"fixed"`
+	}
+)
+
+const (
+	StorageRecalcStatusRunning   = StorageRecalcStatus("running")
+	StorageRecalcStatusCompleted = StorageRecalcStatus("completed")
+	StorageRecalcStatusFailed    = StorageRecalcStatus("failed")
+)
+
+var ErrStorageRecalcRunning = serializer.NewError(serializer.CodeConflict, "Storage recalculation is already running", nil)
+
+func init() {
+	crontab.Register(setting.CronTypeStorageRecalc, CronRecalculateStorage)
+}
+
+// IsStorageRecalcRunning returns whether a storage recalculation is running.
+func IsStorageRecalcRunning(dep dependency.Dep) bool {
+	_, ok := dep.KV().Get(storageRecalcLockKey)
+	return ok
+}
+
+// LoadStorageRecalcReport returns the latest storage recalculation report, nil if never run.
+func LoadStorageRecalcReport() (*StorageRecalcReport, error) {
+	content, err := os.ReadFile(util.DataPath(storageRecalcReportFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read storage recalculation report: %w", err)
+	}
+
+	report := &StorageRecalcReport{}
+	if err := json.Unmarshal(content, report); err != nil {
+		return nil, fmt.Errorf("failed to decode storage recalculation report: %w", err)
+	}
+
+	return report, nil
+}
+
+func saveStorageRecalcReport(report *StorageRecalcReport) error {
+	content, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("failed to encode storage recalculation report: %w", err)
+	}
+
+	dst := util.DataPath(storageRecalcReportFile)
+	if err := os.WriteFile(dst+".tmp", content, 0600); err != nil {
+		return fmt.Errorf("failed to write storage recalculation report: %w", err)
+	}
+
+	return os.Rename(dst+".tmp", dst)
+}
+
+// RecalculateStorage recomputes used storage of all users from entities in batches, and reconciles
+// the counters unless dryRun is set.
+func RecalculateStorage(ctx context.Context, dep dependency.Dep, dryRun bool) (*StorageRecalcReport, error) {
+	kv := dep.KV()
+	locked, err := kv.SetNX(storageRecalcLockKey, true, storageRecalcLockTTL)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to lock storage recalculation", err)
+	}
+	if !locked {
+		return nil, ErrStorageRecalcRunning
+	}
+	defer kv.Delete("", storageRecalcLockKey)
+
+	report := &StorageRecalcReport{
+		Status:        StorageRecalcStatusRunning,
+		DryRun:        dryRun,
+		StartedAt:     time.Now(),
+		Discrepancies: make([]StorageDiscrepancy, 0),
+	}
+	if err := saveStorageRecalcReport(report); err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to save storage recalculation report", err)
+	}
+
+	if err := recalculateStorage(ctx, dep, report); err != nil {
+		report.Status = StorageRecalcStatusFailed
+		report.Error = err.Error()
+	} else {
+		report.Status = StorageRecalcStatusCompleted
+	}
+
+	finishedAt := time.Now()
+	report.FinishedAt = &finishedAt
+	if err := saveStorageRecalcReport(report); err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to save storage recalculation report", err)
+	}
+
+	return report, nil
+}
+
+func recalculateStorage(ctx context.Context, dep dependency.Dep, report *StorageRecalcReport) error {
+	l := dep.Logger()
+	userClient := dep.UserClient()
+
+	for page := 0; ; page++ {
+		res, err := userClient.ListUsers(ctx, &inventory.ListUserParameters{
+			PaginationArgs: &inventory.PaginationArgs{
+				Page:     page,
+				PageSize: storageRecalcPageSize,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list users: %w", err)
+		}
+
+		for _, u := range res.Users {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+
+			actual, err := userClient.ComputeStorage(ctx, u.ID)
+			if err != nil {
+				return fmt.Errorf("failed to calculate storage of user %d: %w", u.ID, err)
+			}
+
+			report.Checked++
+			if actual == u.Storage {
+				continue
+			}
+
+			discrepancy := StorageDiscrepancy{
+				UserID:   u.ID,
+				Email:    u.Email,
+				Recorded: u.Storage,
+				Actual:   actual,
+			}
+
+			if !report.DryRun {
+				fixed, err := userClient.ReconcileStorage(ctx, u.ID, u.Storage, actual)
+				if err != nil {
+					return err
+				}
+
+				if !fixed {
+					l.Info("Storage of user %d changed during recalculation, skipped.", u.ID)
+				}
+				discrepancy.Fixed = fixed
+			}
+
+			l.Info("Storage of user %d is %d, but %d is calculated.", u.ID, u.Storage, actual)
+			report.Discrepancies = append(report.Discrepancies, discrepancy)
+		}
+
+		if len(res.Users) < storageRecalcPageSize {
+			break
+		}
+	}
+
+	l.Info("Recalculated storage of %d users, %d discrepancies found.", report.Checked, len(report.Discrepancies))
+	return nil
+}
+
+// CronRecalculateStorage reconciles storage counters of all users.
+func CronRecalculateStorage(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	if _, err := RecalculateStorage(ctx, dep, false); err != nil {
+		dep.Logger().Warning("Failed to recalculate user storage: %s", err)
+	}
+}
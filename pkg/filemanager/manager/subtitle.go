@@ -0,0 +1,135 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/subtitle"
+	"github.com/cloudreve/Cloudreve/v4/pkg/textpreview"
+)
+
+// subtitleMaxListPages limits pages of siblings scanned for subtitles, in case of huge folders.
+const subtitleMaxListPages = 10
+
+// SubtitleTrack is a subtitle sidecar of a video, sharing its base name in the same folder.
+type SubtitleTrack struct {
+	Name string `json:"name"`
+	Uri  string `json:"uri"`
+	// Format is the original format of the sidecar, served as WebVTT if it is SRT.
+	Format string `json:"format"`
+	// Label is the part between the base name of video and the extension, usually a language code.
+	Label string `json:"label,omitempty"`
+}
+
+// Subtitles lists subtitle sidecars of given video file, those without label come first.
+func (m *manager) Subtitles(ctx context.Context, uri *fs.URI) ([]SubtitleTrack, error) {
+	video, err := m.fs.Get(ctx, uri, dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityDownloadFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	if video.Type() != types.FileTypeFile {
+		return nil, fs.ErrEntityNotExist
+	}
+
+	dir := uri.DirUri()
+	pageSize := m.settings.DBFS(ctx).MaxPageSize
+	tracks := make([]SubtitleTrack, 0)
+	token := ""
+	for page := 0; page < subtitleMaxListPages; page++ {
+		_, res, err := m.fs.List(ctx, dir, fs.WithPageSize(pageSize), dbfs.WithCursorPagination(token))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list siblings: %w", err)
+		}
+
+		for _, sibling := range res.Files {
+			if sibling.Type() != types.FileTypeFile || sibling.ID() == video.ID() {
+				continue
+			}
+
+			label, ok := subtitle.Match(video.DisplayName(), sibling.DisplayName())
+			if !ok {
+				continue
+			}
+
+			tracks = append(tracks, SubtitleTrack{
+				Name:   sibling.DisplayName(),
+				Uri:    dir.Join(sibling.DisplayName()).String(),
+				Format: subtitle.Format(sibling.DisplayName()),
+				Label:  label,
+			})
+		}
+
+		if res.Pagination == nil || res.Pagination.NextPageToken == "" {
+			break
+		}
+		token = res.Pagination.NextPageToken
+	}
+
+	sort.SliceStable(tracks, func(i, j int) bool {
+		if (tracks[i].Label == "") != (tracks[j].Label == "") {
+			return tracks[i].Label == ""
+		}
+		return strings.ToLower(tracks[i].Name) < strings.ToLower(tracks[j].Name)
+	})
+
+	return tracks, nil
+}
+
+// Subtitle returns content of given subtitle file decoded into UTF-8, SRT is converted into WebVTT so that
+// it can be used in <track> elements directly. ASS/SSA is kept as is for players rendering styles.
+func (m *manager) Subtitle(ctx context.Context, uri *fs.URI) (*Rendition, error) {
+	file, err := m.fs.Get(ctx, uri, dbfs.WithFileEntities(),
+		dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityDownloadFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	format := subtitle.Format(file.DisplayName())
+	if file.Type() != types.FileTypeFile || format == "" {
+		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("%q is not a subtitle", file.DisplayName()))
+	}
+
+	content := ""
+	latest := file.PrimaryEntity()
+	if latest != nil && latest.ID() != 0 {
+		if latest.Size() > m.settings.SubtitleMaxSize(ctx) {
+			return nil, fs.ErrFileSizeTooBig
+		}
+
+		es, err := m.GetEntitySource(ctx, 0, fs.WithEntity(latest))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get entity source: %w", err)
+		}
+
+		defer es.Close()
+		data, err := io.ReadAll(es)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeIOFailed, "Failed to read file", err)
+		}
+
+		// Subtitles are often saved in legacy encodings of their languages.
+		content = textpreview.Decode(data, false).Content
+	}
+
+	name := file.DisplayName()
+	if format == subtitle.FormatSrt {
+		content = subtitle.SrtToVtt(content)
+		name = strings.TrimSuffix(name, path.Ext(name)) + ".vtt"
+	}
+
+	return &Rendition{
+		ReadSeeker: strings.NewReader(content),
+		Closer:     io.NopCloser(nil),
+		Name:       name,
+		ModTime:    file.UpdatedAt(),
+	}, nil
+}
@@ -0,0 +1,389 @@
+package manager
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/samber/lo"
+	"golang.org/x/tools/container/intsets"
+)
+
+const (
+	takeoutFolder   = "takeout"
+	takeoutPageSize = 500
+
+	SummaryKeyTakeoutUser         = "user"
+	SummaryKeyTakeoutIncludeFiles = "include_files"
+	SummaryKeyTakeoutSize         = "size"
+	SummaryKeyTakeoutFailed       = "failed"
+)
+
+type (
+	// TakeoutTask packages personal data of a user into a zip archive in temp folder, downloaded by the
+	// requester with a signed URL.
+	TakeoutTask struct {
+		*queue.DBTask
+	}
+
+	TakeoutTaskState struct {
+		UserID       int  `json:"user_id"`
+		IncludeFiles bool `json:"include_files"`
+		// Path of the archive, set once completed.
+		Path string `json:"path,omitempty"`
+		Size int64  `json:"size,omitempty"`
+		// Failed number of files that cannot be exported.
+		Failed int `json:"failed,omitempty"`
+	}
+
+	// takeoutProfile is the exported profile, credentials are never included.
+	takeoutProfile struct {
+		ID        string            `json:"id"`
+		Email     string            `json:"email"`
+		Emails    []*ent.UserEmail  `json:"emails"`
+		Nick      string            `json:"nick"`
+		Status    string            `json:"status"`
+		Avatar    string            `json:"avatar,omitempty"`
+		Group     string            `json:"group"`
+		Storage   int64             `json:"storage"`
+		CreatedAt time.Time         `json:"created_at"`
+		Settings  types.UserSetting `json:"settings"`
+	}
+)
+
+func init() {
+	crontab.Register(setting.CronTypeTakeoutCollect, CronCollectTakeouts)
+	queue.RegisterResumableTaskFactory(queue.TakeoutTaskType, NewTakeoutTaskFromModel)
+}
+
+func NewTakeoutTaskFromModel(task *ent.Task) queue.Task {
+	return &TakeoutTask{
+		DBTask: &queue.DBTask{
+			Task: task,
+		},
+	}
+}
+
+// NewTakeoutTask creates a task exporting personal data of user uid, owned by current user of ctx, who is
+// either the user itself or an admin.
+func NewTakeoutTask(ctx context.Context, uid int, includeFiles bool) (queue.Task, error) {
+	state := &TakeoutTaskState{
+		UserID:       uid,
+		IncludeFiles: includeFiles,
+	}
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	return &TakeoutTask{
+		DBTask: &queue.DBTask{
+			Task: &ent.Task{
+				Type:          queue.TakeoutTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  string(stateBytes),
+				PublicState:   &types.TaskPublicState{},
+			},
+			DirectOwner: inventory.UserFromContext(ctx),
+		},
+	}, nil
+}
+
+// TakeoutArchive returns the archive path of a completed takeout task.
+func TakeoutArchive(t *ent.Task) (string, error) {
+	if t.Type != queue.TakeoutTaskType || t.Status != task.StatusCompleted {
+		return "", fmt.Errorf("task %d is not a completed takeout", t.ID)
+	}
+
+	state := &TakeoutTaskState{}
+	if err := json.Unmarshal([]byte(t.PrivateState), state); err != nil {
+		return "", fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+
+	if _, err := os.Stat(state.Path); err != nil {
+		return "", fmt.Errorf("takeout archive is expired: %w", err)
+	}
+
+	return state.Path, nil
+}
+
+func (m *TakeoutTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	state := &TakeoutTaskState{}
+	if err := json.Unmarshal([]byte(m.State()), state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+
+	target, err := dep.UserClient().GetByID(context.WithValue(ctx, inventory.LoadUserGroup{}, true), state.UserID)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to get user %d: %s (%w)", state.UserID, err, queue.CriticalErr)
+	}
+
+	root := takeoutRoot(ctx, dep.SettingProvider())
+	if err := util.CreatNestedFolder(root); err != nil {
+		return task.StatusError, fmt.Errorf("failed to create takeout folder: %w", err)
+	}
+
+	// The archive is rebuilt from scratch if the task is resumed after restart.
+	archivePath := filepath.Join(root, strconv.Itoa(m.ID())+".zip")
+	archive, err := os.Create(archivePath)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to create archive file: %w", err)
+	}
+
+	failed, err := writeTakeout(ctx, dep, target, state.IncludeFiles, archive)
+	if closeErr := archive.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(archivePath)
+		return task.StatusError, err
+	}
+
+	info, err := os.Stat(archivePath)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to stat archive file: %w", err)
+	}
+
+	state.Path = archivePath
+	state.Size = info.Size()
+	state.Failed = failed
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	m.Lock()
+	m.Task.PrivateState = string(stateBytes)
+	m.Unlock()
+	return task.StatusCompleted, nil
+}
+
+func (m *TakeoutTask) Summarize(hasher hashid.Encoder) *queue.Summary {
+	m.Lock()
+	defer m.Unlock()
+
+	state := &TakeoutTaskState{}
+	if err := json.Unmarshal([]byte(m.Task.PrivateState), state); err != nil {
+		return nil
+	}
+
+	return &queue.Summary{
+		Props: map[string]any{
+			SummaryKeyTakeoutUser:         hashid.EncodeUserID(hasher, state.UserID),
+			SummaryKeyTakeoutIncludeFiles: state.IncludeFiles,
+			SummaryKeyTakeoutSize:         state.Size,
+			SummaryKeyTakeoutFailed:       state.Failed,
+		},
+	}
+}
+
+// writeTakeout writes personal data of user u as JSON documents into a zip archive, and file contents under
+// "files" if includeFiles is set. Returns the number of files that cannot be exported.
+func writeTakeout(ctx context.Context, dep dependency.Dep, u *ent.User, includeFiles bool, w io.Writer) (int, error) {
+	zipWriter := zip.NewWriter(w)
+	failed, err := writeTakeoutEntries(ctx, dep, u, includeFiles, zipWriter)
+	if err != nil {
+		_ = zipWriter.Close()
+		return 0, err
+	}
+
+	// Central directory is written on close, the archive cannot be opened without it.
+	if err := zipWriter.Close(); err != nil {
+		return 0, fmt.Errorf("failed to finish archive: %w", err)
+	}
+
+	return failed, nil
+}
+
+func writeTakeoutEntries(ctx context.Context, dep dependency.Dep, u *ent.User, includeFiles bool, zipWriter *zip.Writer) (int, error) {
+	writeJSON := func(name string, v any) error {
+		writer, err := zipWriter.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+		if err != nil {
+			return fmt.Errorf("failed to create %s in archive: %w", name, err)
+		}
+
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(v); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+		return nil
+	}
+
+	hasher := dep.HashIDEncoder()
+	emails, err := dep.UserClient().ListEmails(ctx, u.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list emails: %w", err)
+	}
+
+	profile := &takeoutProfile{
+		ID:        hashid.EncodeUserID(hasher, u.ID),
+		Email:     u.Email,
+		Emails:    emails,
+		Nick:      u.Nick,
+		Status:    string(u.Status),
+		Avatar:    u.Avatar,
+		Storage:   u.Storage,
+		CreatedAt: u.CreatedAt,
+	}
+	if u.Edges.Group != nil {
+		profile.Group = u.Edges.Group.Name
+	}
+	if u.Settings != nil {
+		profile.Settings = *u.Settings
+		profile.Settings.PasswordHistory = nil
+	}
+	if err := writeJSON("profile.json", profile); err != nil {
+		return 0, err
+	}
+
+	shares := make([]*ent.Share, 0)
+	shareCtx := context.WithValue(ctx, inventory.LoadShareFile{}, true)
+	for token := ""; ; {
+		res, err := dep.ShareClient().List(shareCtx, &inventory.ListShareArgs{
+			PaginationArgs: &inventory.PaginationArgs{UseCursorPagination: true, PageToken: token, PageSize: takeoutPageSize},
+			UserID:         u.ID,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to list shares: %w", err)
+		}
+
+		shares = append(shares, res.Shares...)
+		if token = res.NextPageToken; token == "" {
+			break
+		}
+	}
+	if err := writeJSON("shares.json", shares); err != nil {
+		return 0, err
+	}
+
+	accounts := make([]*ent.DavAccount, 0)
+	for token := ""; ; {
+		res, err := dep.DavAccountClient().List(ctx, &inventory.ListDavAccountArgs{
+			PaginationArgs: &inventory.PaginationArgs{UseCursorPagination: true, PageToken: token, PageSize: takeoutPageSize},
+			UserID:         u.ID,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to list WebDAV accounts: %w", err)
+		}
+
+		accounts = append(accounts, res.Accounts...)
+		if token = res.NextPageToken; token == "" {
+			break
+		}
+	}
+	if err := writeJSON("webdav_accounts.json", accounts); err != nil {
+		return 0, err
+	}
+
+	// Activities include both actions on the user and actions made by the user.
+	activities := make([]*ent.AuditLog, 0)
+	for _, args := range []*inventory.ListAuditLogArgs{{UserID: u.ID}, {ActorID: u.ID}} {
+		for {
+			args.PageSize = takeoutPageSize
+			logs, err := dep.AuditLogClient().List(ctx, args)
+			if err != nil {
+				return 0, fmt.Errorf("failed to list activities: %w", err)
+			}
+
+			activities = append(activities, logs...)
+			if len(logs) == 0 {
+				break
+			}
+			args.BeforeID = logs[len(logs)-1].ID
+		}
+	}
+	activities = lo.UniqBy(activities, func(item *ent.AuditLog) int { return item.ID })
+	if err := writeJSON("activities.json", activities); err != nil {
+		return 0, err
+	}
+
+	if !includeFiles {
+		return 0, nil
+	}
+
+	fm := NewFileManager(dep, u).(*manager)
+	defer fm.Recycle()
+
+	rootUri, err := fs.NewUriFromString(fs.NewMyUri(hashid.EncodeUserID(hasher, u.ID)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build root uri: %w", err)
+	}
+
+	failed := 0
+	if err := fm.Walk(ctx, rootUri, intsets.MaxInt, func(f fs.File, level int) error {
+		if f.Type() != types.FileTypeFile || f.IsSymbolic() || f.PrimaryEntityID() == 0 {
+			return nil
+		}
+
+		if err := fm.compressFileToArchive(ctx, path.Join("files", f.Uri(false).Dir()), f, zipWriter, true, nil); err != nil {
+			failed++
+			fm.l.Warning("Failed to export file %s: %s, skipping it...", f.Uri(false), err)
+		}
+
+		return nil
+	}); err != nil {
+		return 0, fmt.Errorf("failed to walk files: %w", err)
+	}
+
+	return failed, nil
+}
+
+func takeoutRoot(ctx context.Context, settings setting.Provider) string {
+	return filepath.Join(util.DataPath(settings.TempPath(ctx)), takeoutFolder)
+}
+
+// CronCollectTakeouts removes takeout archives older than the takeout TTL.
+func CronCollectTakeouts(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	settings := dep.SettingProvider()
+	root := takeoutRoot(ctx, settings)
+	expireBefore := time.Now().Add(-settings.TakeoutTTL(ctx))
+
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			l.Warning("Failed to read takeout folder: %s", err)
+		}
+		return
+	}
+
+	removed := 0
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || info.ModTime().After(expireBefore) {
+			continue
+		}
+
+		if err := os.Remove(filepath.Join(root, entry.Name())); err != nil {
+			l.Warning("Failed to remove takeout archive %q: %s", entry.Name(), err)
+			continue
+		}
+		removed++
+	}
+
+	if removed > 0 {
+		l.Info("%d expired takeout archives are removed.", removed)
+	}
+}
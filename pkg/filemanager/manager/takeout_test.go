@@ -0,0 +1,57 @@
+package manager
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/stretchr/testify/assert"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestWriteTakeout(t *testing.T) {
+	a := assert.New(t)
+	dep, u, _ := newTestUser(t)
+	ctx := context.WithValue(context.Background(), dependency.DepCtx{}, dep)
+	ctx = context.WithValue(ctx, inventory.UserCtx{}, u)
+
+	buf := &bytes.Buffer{}
+	failed, err := writeTakeout(ctx, dep, u, true, buf)
+	a.NoError(err)
+	a.Zero(failed)
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if !a.NoError(err) {
+		return
+	}
+
+	names := make([]string, 0, len(archive.File))
+	for _, f := range archive.File {
+		names = append(names, f.Name)
+	}
+	a.ElementsMatch([]string{"profile.json", "shares.json", "webdav_accounts.json", "activities.json"}, names)
+
+	profileFile, err := archive.Open("profile.json")
+	if a.NoError(err) {
+		defer profileFile.Close()
+		content, _ := io.ReadAll(profileFile)
+		profile := &takeoutProfile{}
+		a.NoError(json.Unmarshal(content, profile))
+		a.Equal(u.Email, profile.Email)
+	}
+
+	// Failure to write the archive, including its central directory, is returned
+	_, err = writeTakeout(ctx, dep, u, false, failingWriter{})
+	a.Error(err)
+}
@@ -7,11 +7,13 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/thumb"
 	"os"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/local"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
@@ -19,11 +21,31 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
 	"github.com/samber/lo"
 )
 
+// thumbSourceUrlTTL is how long the source URL sent to slave nodes for thumb generation is valid.
+const thumbSourceUrlTTL = time.Hour
+
+// ErrThumbnailPending is returned by ThumbnailOrQueue if the thumbnail is being generated.
+var ErrThumbnailPending = errors.New("thumbnail is being generated")
+
+// pendingThumbs holds IDs of entities whose thumbnail is being generated in background.
+var pendingThumbs sync.Map
+
 // Thumbnail returns the thumbnail entity of the file.
 func (m *manager) Thumbnail(ctx context.Context, uri *fs.URI) (entitysource.EntitySource, error) {
+	return m.thumbnail(ctx, uri, true)
+}
+
+func (m *manager) ThumbnailOrQueue(ctx context.Context, uri *fs.URI) (entitysource.EntitySource, error) {
+	return m.thumbnail(ctx, uri, false)
+}
+
+// thumbnail returns the thumbnail entity of the file. If it needs to be generated and wait is false,
+// generation is started in background and ErrThumbnailPending is returned.
+func (m *manager) thumbnail(ctx context.Context, uri *fs.URI, wait bool) (entitysource.EntitySource, error) {
 	// retrieve file info
 	file, err := m.fs.Get(ctx, uri, dbfs.WithFileEntities(), dbfs.WithFilePublicMetadata())
 	if err != nil {
@@ -79,6 +101,11 @@ func (m *manager) Thumbnail(ctx context.Context, uri *fs.URI) (entitysource.Enti
 			return nil, fs.ErrEntityNotExist
 		}
 
+		if !wait {
+			m.generateThumbInBackground(ctx, uri, file.Ext(), latest)
+			return nil, ErrThumbnailPending
+		}
+
 		thumbEntity, err := m.SubmitAndAwaitThumbnailTask(ctx, uri, file.Ext(), latest)
 		if err != nil {
 			return nil, fmt.Errorf("failed to execute thumb task: %w", err)
@@ -124,8 +151,41 @@ func (m *manager) SubmitAndAwaitThumbnailTask(ctx context.Context, uri *fs.URI,
 
 }
 
+// generateThumbInBackground submits a thumbnail task for the entity unless one is already running.
+func (m *manager) generateThumbInBackground(ctx context.Context, uri *fs.URI, ext string, entity fs.Entity) {
+	if _, loaded := pendingThumbs.LoadOrStore(entity.ID(), struct{}{}); loaded {
+		return
+	}
+
+	// Generation outlives the request, a new file manager is used as current one is recycled with the request.
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer pendingThumbs.Delete(entity.ID())
+		fm := NewFileManager(m.dep, m.user)
+		defer fm.Recycle()
+
+		if _, err := fm.SubmitAndAwaitThumbnailTask(ctx, uri, ext, entity); err != nil {
+			m.l.Debug("Failed to generate thumbnail of %q in background: %s", uri, err)
+		}
+	}()
+}
+
 func (m *manager) generateThumb(ctx context.Context, uri *fs.URI, ext string, es entitysource.EntitySource) (fs.Entity, error) {
-	// Generate thumb
+	// Generate thumb on a slave node if offloaded by policy, the node uploads the thumb to storage directly.
+	if thumbEntity := m.generateThumbOnNode(ctx, uri, ext, es); thumbEntity != nil {
+		return thumbEntity, nil
+	}
+
+	// Generators honor settings of the source policy, on slave nodes it is sent by master along with the request.
+	if thumb.PolicyFromContext(ctx) == nil && !m.stateless && es.Entity().PolicyID() != 0 {
+		policy, err := m.policyClient.GetPolicyByID(ctx, es.Entity().PolicyID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get source policy: %w", err)
+		}
+
+		ctx = thumb.WithPolicy(ctx, policy)
+	}
+
 	pipeline := m.dep.ThumbPipeline()
 	res, err := pipeline.Generate(ctx, es, ext, nil)
 	if err != nil {
@@ -182,14 +242,9 @@ func (m *manager) generateThumb(ctx context.Context, uri *fs.URI, ext string, es
 		entityType := types.EntityTypeThumbnail
 		req := &fs.UploadRequest{
 			Props: &fs.UploadProps{
-				Uri:  uri,
-				Size: fileInfo.Size(),
-				SavePath: fmt.Sprintf(
-					"%s.%s%s",
-					es.Entity().Source(),
-					util.RandStringRunes(16),
-					m.settings.ThumbEntitySuffix(ctx),
-				),
+				Uri:        uri,
+				Size:       fileInfo.Size(),
+				SavePath:   m.thumbSavePath(ctx, es.Entity()),
 				MimeType:   m.dep.MimeDetector(ctx).TypeByName("thumb.jpg"),
 				EntityType: &entityType,
 			},
@@ -224,14 +279,118 @@ func (m *manager) generateThumb(ctx context.Context, uri *fs.URI, ext string, es
 	return thumbEntity, nil
 }
 
+// generateThumbOnNode generates thumb on a slave node with thumbnail capability if enabled in storage policy.
+// The node uploads the thumb next to the source entity, which is then recorded as the thumb entity. It
+// returns nil if thumb should be generated locally.
+func (m *manager) generateThumbOnNode(ctx context.Context, uri *fs.URI, ext string, es entitysource.EntitySource) fs.Entity {
+	if m.stateless {
+		return nil
+	}
+
+	policy, d, err := m.getEntityPolicyDriver(ctx, es.Entity(), nil)
+	if err != nil || policy.Settings == nil || !policy.Settings.ThumbGeneratorNode {
+		return nil
+	}
+
+	np, err := m.dep.NodePool(ctx)
+	if err != nil {
+		return nil
+	}
+
+	node, err := np.Get(ctx, types.NodeCapabilityGenerateThumb, 0)
+	if err != nil || node.IsMaster() {
+		return nil
+	}
+
+	expire := time.Now().Add(thumbSourceUrlTTL)
+	src, err := es.Url(ctx, entitysource.WithExpire(&expire))
+	if err != nil {
+		m.l.Warning("Failed to get source URL for thumb generation on node %q: %s", node.Name(), err)
+		return nil
+	}
+
+	savePath := m.thumbSavePath(ctx, es.Entity())
+	res, err := node.GenerateThumb(ctx, &cluster.GenerateSlaveThumb{
+		Src:      src.Url,
+		Ext:      ext,
+		Policy:   policy,
+		SavePath: savePath,
+	})
+	if err != nil {
+		m.l.Warning("Failed to generate thumb on node %q, fallback to local generator: %s", node.Name(), err)
+		return nil
+	}
+
+	thumbEntity, err := m.importThumb(ctx, uri, policy, savePath, res.Size)
+	if err != nil {
+		m.l.Warning("Failed to record thumb generated on node %q, fallback to local generator: %s", node.Name(), err)
+		if failed, err := d.Delete(context.WithoutCancel(ctx), savePath); err != nil {
+			m.l.Warning("Failed to remove thumb %q uploaded by node: %s", failed, err)
+		}
+		return nil
+	}
+
+	m.l.Debug("Thumb of %q is generated on node %q", es.Entity().Source(), node.Name())
+	return thumbEntity
+}
+
+// importThumb records the thumb already uploaded to savePath of given policy as thumb entity of the file.
+func (m *manager) importThumb(ctx context.Context, uri *fs.URI, policy *ent.StoragePolicy, savePath string, size int64) (fs.Entity, error) {
+	entityType := types.EntityTypeThumbnail
+	req := &fs.UploadRequest{
+		Props: &fs.UploadProps{
+			Uri:                    uri,
+			Size:                   size,
+			SavePath:               savePath,
+			MimeType:               m.dep.MimeDetector(ctx).TypeByName("thumb.jpg"),
+			EntityType:             &entityType,
+			UploadSessionID:        uuid.Must(uuid.NewV4()).String(),
+			PreferredStoragePolicy: policy.ID,
+		},
+		ImportFrom: &fs.PhysicalObject{Source: savePath, Size: size},
+	}
+
+	// Generating thumb can be triggered by users with read-only permission. We can bypass update permission check.
+	ctx = dbfs.WithBypassOwnerCheck(ctx)
+	session, err := m.fs.PrepareUpload(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare thumb entity: %w", err)
+	}
+
+	file, err := m.fs.CompleteUpload(ctx, session)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete thumb entity: %w", err)
+	}
+
+	thumbEntity, found := lo.Find(file.Entities(), func(e fs.Entity) bool {
+		return e.Type() == types.EntityTypeThumbnail
+	})
+	if !found {
+		return nil, fmt.Errorf("failed to find thumb entity")
+	}
+
+	return thumbEntity, nil
+}
+
+// thumbSavePath returns the sidecar save path of the thumb entity for given source entity.
+func (m *manager) thumbSavePath(ctx context.Context, source fs.Entity) string {
+	return fmt.Sprintf(
+		"%s.%s%s",
+		source.Source(),
+		util.RandStringRunes(16),
+		m.settings.ThumbEntitySuffix(ctx),
+	)
+}
+
 type (
 	GenerateThumbTask struct {
 		*queue.InMemoryTask
-		es  entitysource.EntitySource
-		ext string
-		m   *manager
-		uri *fs.URI
-		sig chan *generateRes
+		es     entitysource.EntitySource
+		ext    string
+		m      *manager
+		uri    *fs.URI
+		policy *ent.StoragePolicy
+		sig    chan *generateRes
 	}
 	generateRes struct {
 		thumbEntity fs.Entity
@@ -249,11 +408,12 @@ func newGenerateThumbTask(ctx context.Context, m *manager, uri *fs.URI, ext stri
 				},
 			},
 		},
-		es:  es,
-		ext: ext,
-		m:   m,
-		uri: uri,
-		sig: make(chan *generateRes, 2),
+		es:     es,
+		ext:    ext,
+		m:      m,
+		uri:    uri,
+		policy: thumb.PolicyFromContext(ctx),
+		sig:    make(chan *generateRes, 2),
 	}
 
 	t.InMemoryTask.DBTask.Task.SetUser(m.user)
@@ -269,6 +429,10 @@ func (m *GenerateThumbTask) Do(ctx context.Context) (task.Status, error) {
 	default:
 	}
 
+	if m.policy != nil {
+		ctx = thumb.WithPolicy(ctx, m.policy)
+	}
+
 	res, err := m.m.generateThumb(ctx, m.uri, m.ext, m.es)
 	if err != nil {
 		if errors.Is(err, thumb.ErrNotAvailable) {
@@ -0,0 +1,209 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+)
+
+const (
+	// thumbPregenKeyPrefix marks files queued or processed recently, the value is the priority queued with.
+	thumbPregenKeyPrefix = "thumb_pregen_"
+	// thumbPregenTTL is how long a file is not queued again with the same or lower priority.
+	thumbPregenTTL = 24 * 3600
+	// thumbPregenDone is stored once a file is processed, lower than any priority.
+	thumbPregenDone = -1
+	// ThumbBackfillCursorKey stores ID of the last file queued for backfill.
+	ThumbBackfillCursorKey = "thumb_pregen_backfill_cursor"
+)
+
+func init() {
+	crontab.Register(setting.CronTypeThumbBackfill, CronThumbBackfill)
+}
+
+// ThumbPregenTask generates thumbnail of a file ahead of being requested, running in thumb pregen queue.
+type ThumbPregenTask struct {
+	*queue.InMemoryTask
+	fileID   int
+	priority int
+}
+
+func newThumbPregenTask(ctx context.Context, fileID, priority int) *ThumbPregenTask {
+	return &ThumbPregenTask{
+		InMemoryTask: &queue.InMemoryTask{
+			DBTask: &queue.DBTask{
+				Task: &ent.Task{
+					Type:          queue.ThumbPregenTaskType,
+					CorrelationID: logging.CorrelationID(ctx),
+					PublicState:   &types.TaskPublicState{},
+				},
+			},
+		},
+		fileID:   fileID,
+		priority: priority,
+	}
+}
+
+func (t *ThumbPregenTask) Priority() int {
+	return t.priority
+}
+
+func (t *ThumbPregenTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	file, err := dep.FileClient().GetByID(ctx, t.fileID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return task.StatusCompleted, nil
+		}
+
+		return task.StatusError, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	// Thumbnail is generated on behalf of the owner, as the file might be listed by other users in shares.
+	owner, err := dep.UserClient().GetLoginUserByID(ctx, file.OwnerID)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to get owner: %w", err)
+	}
+
+	fm := NewFileManager(dep, owner)
+	defer fm.Recycle()
+
+	traversed, err := fm.TraverseFile(ctx, t.fileID)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to traverse file: %w", err)
+	}
+
+	es, err := fm.Thumbnail(ctx, traversed.Uri(false))
+	if err != nil && !errors.Is(err, fs.ErrEntityNotExist) {
+		return task.StatusError, err
+	}
+
+	if es != nil {
+		es.Close()
+	}
+
+	_ = dep.KV().Set(thumbPregenKeyPrefix+strconv.Itoa(t.fileID), thumbPregenDone, thumbPregenTTL)
+	return task.StatusCompleted, nil
+}
+
+// queueThumbPregen queues thumbnail generation of the file, unless it is queued or processed recently with
+// the same or higher priority.
+func queueThumbPregen(ctx context.Context, dep dependency.Dep, fileID, priority int) {
+	kv := dep.KV()
+	key := thumbPregenKeyPrefix + strconv.Itoa(fileID)
+	if queued, ok := kv.Get(key); ok {
+		if p, ok := queued.(int); ok && p <= priority {
+			return
+		}
+	}
+
+	if err := dep.ThumbPregenQueue(ctx).QueueTask(ctx, newThumbPregenTask(ctx, fileID, priority)); err != nil {
+		dep.Logger().Warning("Failed to queue thumb pregen task for file %d: %s", fileID, err)
+		return
+	}
+
+	_ = kv.Set(key, priority, thumbPregenTTL)
+}
+
+func (m *manager) thumbPregenForNewEntity(ctx context.Context, session *fs.UploadSession) {
+	if session.Props.EntityType != nil && *session.Props.EntityType != types.EntityTypeVersion {
+		return
+	}
+
+	settings := m.settings.ThumbPregen(ctx)
+	if !settings.Enabled || !settings.OnUpload || !util.IsInExtensionList(settings.Exts, session.Props.Uri.Name()) {
+		return
+	}
+
+	// A new version replaces the thumbnail of previous one.
+	_ = m.dep.KV().Delete(thumbPregenKeyPrefix, strconv.Itoa(session.FileID))
+	queueThumbPregen(ctx, m.dep, session.FileID, queue.ThumbPregenPriorityRecent)
+}
+
+// thumbPregenForListed queues thumbnail generation of files in the folder being browsed, so that they are
+// likely ready before requested by the client.
+func (m *manager) thumbPregenForListed(ctx context.Context, res *fs.ListFileResult) {
+	settings := m.settings.ThumbPregen(ctx)
+	if !settings.Enabled || !settings.OnList || res == nil {
+		return
+	}
+
+	for _, file := range res.Files {
+		if file.Type() != types.FileTypeFile || file.IsSymbolic() ||
+			!util.IsInExtensionList(settings.Exts, file.DisplayName()) {
+			continue
+		}
+
+		if _, disabled := file.Metadata()[dbfs.ThumbDisabledKey]; disabled {
+			continue
+		}
+
+		queueThumbPregen(ctx, m.dep, file.ID(), queue.ThumbPregenPriorityVisible)
+	}
+}
+
+// CronThumbBackfill queues a batch of existing files without thumbnail, once the previous batch is consumed.
+// Files are scanned in ascending order of ID, the position is kept in KV so that backfill continues after
+// restart.
+func CronThumbBackfill(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	settings := dep.SettingProvider().ThumbPregen(ctx)
+	if !settings.Enabled || !settings.Backfill {
+		return
+	}
+
+	if pending := dep.ThumbPregenQueue(ctx).PendingTasks(); len(pending) > queue.ThumbPregenPriorityBackfill &&
+		pending[queue.ThumbPregenPriorityBackfill] > 0 {
+		return
+	}
+
+	cursor := ThumbBackfillCursor(dep.KV())
+	files, err := dep.FileClient().ListThumbBackfillCandidates(ctx, &inventory.ListThumbBackfillCandidatesParameters{
+		AfterID:     cursor,
+		Exts:        settings.Exts,
+		DisabledKey: dbfs.ThumbDisabledKey,
+		Limit:       settings.BackfillBatch,
+	})
+	if err != nil {
+		l.Error("Failed to list files for thumb backfill: %s", err)
+		return
+	}
+
+	if len(files) == 0 {
+		return
+	}
+
+	for _, file := range files {
+		queueThumbPregen(ctx, dep, file.ID, queue.ThumbPregenPriorityBackfill)
+	}
+
+	_ = dep.KV().Set(ThumbBackfillCursorKey, files[len(files)-1].ID, 0)
+	l.Info("%d files are queued for thumb backfill.", len(files))
+}
+
+// ThumbBackfillCursor returns ID of the last file queued for backfill.
+func ThumbBackfillCursor(kv cache.Driver) int {
+	if cursor, ok := kv.Get(ThumbBackfillCursorKey); ok {
+		if id, ok := cursor.(int); ok {
+			return id
+		}
+	}
+
+	return 0
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strconv"
 	"time"
 
@@ -15,9 +16,12 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/grouppolicy"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webhook"
 	"github.com/gofrs/uuid"
 	"github.com/samber/lo"
 )
@@ -40,6 +44,10 @@ type (
 		PrepareUpload(ctx context.Context, req *fs.UploadRequest, opts ...fs.Option) (*fs.UploadSession, error)
 		// PreValidateUpload pre-validates an upload request.
 		PreValidateUpload(ctx context.Context, dst *fs.URI, files ...fs.PreValidateFile) error
+		// PatchContent overwrites size bytes of given file starting from offset with r. Content is written
+		// in place if the storage supports it, otherwise a new version is created. In place writes are not
+		// versioned, and bytes written before a failure are not reverted.
+		PatchContent(ctx context.Context, uri *fs.URI, offset, size int64, r io.Reader, previous string) (fs.File, error)
 	}
 )
 
@@ -70,7 +78,20 @@ func (m *manager) CreateUploadSession(ctx context.Context, req *fs.UploadRequest
 		err error
 	)
 
+	sessionCreated := false
 	if uploadSession == nil {
+		// Upload is accounted once here, usage is given back if the session cannot be created.
+		policyReq := m.uploadPolicyRequest(ctx, req)
+		if err := m.dep.GroupPolicyChecker().Consume(ctx, m.user, policyReq); err != nil {
+			return nil, err
+		}
+
+		defer func() {
+			if !sessionCreated {
+				m.dep.GroupPolicyChecker().Release(ctx, m.user, policyReq)
+			}
+		}()
+
 		// If upload session not specified, invoke DBFS to create one
 		sessionID := uuid.Must(uuid.NewV4()).String()
 		req.Props.UploadSessionID = sessionID
@@ -136,6 +157,7 @@ func (m *manager) CreateUploadSession(ctx context.Context, req *fs.UploadRequest
 		return nil, err
 	}
 
+	sessionCreated = true
 	return credential, nil
 }
 
@@ -280,6 +302,11 @@ func (m *manager) CompleteUpload(ctx context.Context, session *fs.UploadSession)
 	}
 
 	m.onNewEntityUploaded(ctx, session, d)
+	if file != nil {
+		m.dispatchFileEvent(ctx, webhook.EventFileUploaded, file, "")
+		m.runAutomation(ctx, webhook.EventFileUploaded, file)
+	}
+
 	// Remove upload session
 	_ = m.kv.Delete(UploadSessionCachePrefix, session.Props.UploadSessionID)
 	return file, nil
@@ -306,19 +333,27 @@ func (m *manager) Update(ctx context.Context, req *fs.UploadRequest, opts ...fs.
 		return m.updateStateless(ctx, req, o)
 	}
 
+	policyReq := m.uploadPolicyRequest(ctx, req)
+	if err := m.dep.GroupPolicyChecker().Consume(ctx, m.user, policyReq); err != nil {
+		return nil, err
+	}
+
 	// Prepare for upload
 	uploadSession, err := m.fs.PrepareUpload(ctx, req)
 	if err != nil {
+		m.dep.GroupPolicyChecker().Release(ctx, m.user, policyReq)
 		return nil, fmt.Errorf("faield to prepare uplaod: %w", err)
 	}
 
 	if err := m.Upload(ctx, req, uploadSession.Policy); err != nil {
+		m.dep.GroupPolicyChecker().Release(ctx, m.user, policyReq)
 		m.OnUploadFailed(ctx, uploadSession)
 		return nil, fmt.Errorf("failed to upload new entity: %w", err)
 	}
 
 	file, err := m.CompleteUpload(ctx, uploadSession)
 	if err != nil {
+		m.dep.GroupPolicyChecker().Release(ctx, m.user, policyReq)
 		m.OnUploadFailed(ctx, uploadSession)
 		return nil, fmt.Errorf("failed to complete update: %w", err)
 	}
@@ -326,6 +361,20 @@ func (m *manager) Update(ctx context.Context, req *fs.UploadRequest, opts ...fs.
 	return file, nil
 }
 
+// uploadPolicyRequest builds the group policy request for given upload.
+func (m *manager) uploadPolicyRequest(ctx context.Context, req *fs.UploadRequest) *grouppolicy.Request {
+	mimeType := req.Props.MimeType
+	if mimeType == "" && req.Props.Uri != nil {
+		mimeType = m.dep.MimeDetector(ctx).TypeByName(req.Props.Uri.Name())
+	}
+
+	return &grouppolicy.Request{
+		Action:   types.GroupPolicyActionUpload,
+		Size:     req.Props.Size,
+		MimeType: mimeType,
+	}
+}
+
 func (m *manager) OnUploadFailed(ctx context.Context, session *fs.UploadSession) {
 	ctx = context.WithoutCancel(ctx)
 	if !m.stateless {
@@ -399,6 +448,12 @@ func (m *manager) onNewEntityUploaded(ctx context.Context, session *fs.UploadSes
 	if !m.stateless {
 		// Submit media meta task for new entity
 		m.mediaMetaForNewEntity(ctx, session, d)
+		m.ocrForNewEntity(ctx, session)
+		m.thumbPregenForNewEntity(ctx, session)
+		searchIndexFiles(ctx, m.dep, session.FileID)
+		if !session.Importing {
+			m.dep.StatsRecorder().Uploaded(util.Ext(session.Props.Uri.Name()), session.Props.Size)
+		}
 	}
 }
 
@@ -0,0 +1,115 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+const versionPrunePageSize = 500
+
+func init() {
+	crontab.Register(setting.CronTypeVersionPrune, CronPruneVersions)
+}
+
+// CronPruneVersions deletes previous versions older than max version age of their storage policies. Versions
+// are deleted as their owners, so that locks and legal holds are respected and storage usage is updated.
+func CronPruneVersions(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+
+	var policies []*ent.StoragePolicy
+	for page := 0; ; page++ {
+		res, err := dep.StoragePolicyClient().ListPolicies(ctx, &inventory.ListPolicyParameters{
+			PaginationArgs: &inventory.PaginationArgs{
+				Page:     page,
+				PageSize: versionPrunePageSize,
+			},
+		})
+		if err != nil {
+			l.Error("Failed to list storage policies for version pruning: %s", err)
+			return
+		}
+
+		for _, policy := range res.Policies {
+			if policy.Settings != nil && policy.Settings.VersionMaxAge > 0 {
+				policies = append(policies, policy)
+			}
+		}
+
+		if len(res.Policies) < versionPrunePageSize {
+			break
+		}
+	}
+
+	pruned, failed := 0, 0
+	owners := make(map[int]*ent.User)
+	for _, policy := range policies {
+		before := time.Now().Add(-time.Duration(policy.Settings.VersionMaxAge) * time.Second)
+		afterID := 0
+		for {
+			versions, err := dep.FileClient().ListOldVersions(ctx, policy.ID, before, afterID, versionPrunePageSize)
+			if err != nil {
+				l.Error("Failed to list old versions of storage policy %d: %s", policy.ID, err)
+				break
+			}
+
+			for _, version := range versions {
+				for _, file := range version.Edges.File {
+					// Current version is never pruned.
+					if file.PrimaryEntity == version.ID {
+						continue
+					}
+
+					select {
+					case <-ctx.Done():
+						return
+					default:
+					}
+
+					if err := pruneVersion(ctx, dep, owners, file, version.ID); err != nil {
+						l.Debug("Failed to prune version %d of file %d: %s", version.ID, file.ID, err)
+						failed++
+						continue
+					}
+
+					pruned++
+				}
+			}
+
+			if len(versions) < versionPrunePageSize {
+				break
+			}
+			afterID = versions[len(versions)-1].ID
+		}
+	}
+
+	l.Info("Pruned %d expired versions, failed to prune %d.", pruned, failed)
+}
+
+func pruneVersion(ctx context.Context, dep dependency.Dep, owners map[int]*ent.User, file *ent.File, version int) error {
+	owner, ok := owners[file.OwnerID]
+	if !ok {
+		var err error
+		if owner, err = dep.UserClient().GetLoginUserByID(ctx, file.OwnerID); err != nil {
+			return err
+		}
+		owners[file.OwnerID] = owner
+	}
+
+	ctx = context.WithValue(ctx, inventory.UserCtx{}, owner)
+	fm := NewFileManager(dep, owner)
+	defer fm.Recycle()
+
+	traversed, err := fm.TraverseFile(ctx, file.ID)
+	if err != nil {
+		return err
+	}
+
+	return fm.DeleteVersion(ctx, traversed.Uri(false), version)
+}
@@ -0,0 +1,118 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/thumb"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	// waveformSidecarName is the name of waveform sidecar in the preview cache folder of an entity.
+	waveformSidecarName = "waveform.json"
+	// ffmpegUrlTimeout is how long the source URL read by ffmpeg is valid.
+	ffmpegUrlTimeout = time.Duration(1) * time.Hour
+)
+
+// Waveform returns the waveform peak data of the latest version of given audio file.
+func (m *manager) Waveform(ctx context.Context, uri *fs.URI) (*thumb.Waveform, error) {
+	file, err := m.fs.Get(ctx, uri, dbfs.WithFileEntities(),
+		dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityDownloadFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	latest := file.PrimaryEntity()
+	if file.Type() != types.FileTypeFile || latest == nil || latest.ID() == 0 {
+		return nil, fs.ErrEntityNotExist
+	}
+
+	if !m.settings.WaveformEnabled(ctx) || !util.IsInExtensionList(m.settings.WaveformExts(ctx), file.DisplayName()) {
+		return nil, fs.ErrNotSupportedAction.WithError(fmt.Errorf("waveform is not available for %q", file.DisplayName()))
+	}
+
+	return m.waveform(ctx, latest)
+}
+
+// waveform reads waveform of the entity from sidecar, generating it if not exist.
+func (m *manager) waveform(ctx context.Context, entity fs.Entity) (*thumb.Waveform, error) {
+	sidecar := filepath.Join(previewCacheRoot(ctx, m.settings), strconv.Itoa(entity.ID()), waveformSidecarName)
+	if content, err := os.ReadFile(sidecar); err == nil {
+		res := &thumb.Waveform{}
+		if err := json.Unmarshal(content, res); err == nil {
+			now := time.Now()
+			_ = os.Chtimes(sidecar, now, now)
+			return res, nil
+		}
+	}
+
+	if entity.Size() > m.settings.WaveformMaxSize(ctx) {
+		return nil, fs.ErrFileSizeTooBig
+	}
+
+	es, err := m.GetEntitySource(ctx, 0, fs.WithEntity(entity))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entity source: %w", err)
+	}
+
+	defer es.Close()
+	input, err := ffmpegInput(ctx, es)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := thumb.ComputeWaveform(ctx, m.settings.FFMpegPath(ctx), input, m.settings.WaveformPeaks(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute waveform: %w", err)
+	}
+
+	content, err := json.Marshal(res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode waveform: %w", err)
+	}
+
+	// Write into a temp file first, so that concurrent requests never see a partial sidecar.
+	if err := util.CreatNestedFolder(filepath.Dir(sidecar)); err != nil {
+		return nil, fmt.Errorf("failed to create preview cache folder: %w", err)
+	}
+
+	tempPath := fmt.Sprintf("%s.%s", sidecar, uuid.Must(uuid.NewV4()).String())
+	defer os.Remove(tempPath)
+	if err := os.WriteFile(tempPath, content, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write waveform sidecar: %w", err)
+	}
+
+	if err := os.Rename(tempPath, sidecar); err != nil {
+		return nil, fmt.Errorf("failed to save waveform sidecar: %w", err)
+	}
+
+	return res, nil
+}
+
+// ffmpegInput returns the input of ffmpeg for the entity, either a local path or a signed URL.
+func ffmpegInput(ctx context.Context, es entitysource.EntitySource) (string, error) {
+	if es.IsLocal() {
+		return es.LocalPath(ctx), nil
+	}
+
+	expire := time.Now().Add(ffmpegUrlTimeout)
+	src, err := es.Url(driver.WithForcePublicEndpoint(ctx, false), entitysource.WithNoInternalProxy(),
+		entitysource.WithContext(ctx), entitysource.WithExpire(&expire))
+	if err != nil {
+		return "", fmt.Errorf("failed to get entity url: %w", err)
+	}
+
+	return src.Url, nil
+}
@@ -0,0 +1,113 @@
+package manager
+
+import (
+	"context"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/eventbus"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webhook"
+	"github.com/samber/lo"
+)
+
+// buildWebhookFile returns the payload of given file.
+func buildWebhookFile(file fs.File, hasher hashid.Encoder) *webhook.FileData {
+	fileType := "file"
+	if file.Type() == types.FileTypeFolder {
+		fileType = "folder"
+	}
+
+	return &webhook.FileData{
+		ID:      hashid.EncodeFileID(hasher, file.ID()),
+		Name:    file.DisplayName(),
+		Uri:     file.Uri(false).String(),
+		Type:    fileType,
+		Size:    file.Size(),
+		OwnerID: hashid.EncodeUserID(hasher, file.OwnerID()),
+	}
+}
+
+// emitEvent sends the event to webhooks and publishes it to the event bus.
+func emitEvent(ctx context.Context, dep dependency.Dep, event string, data any) {
+	afterCommit(ctx, func() {
+		dep.WebhookDispatcher().Dispatch(ctx, event, data)
+		dep.EventBus().Publish(ctx, event, data)
+	})
+}
+
+// dispatchFileEvent sends a file.uploaded or file.renamed event.
+func (m *manager) dispatchFileEvent(ctx context.Context, event string, file fs.File, oldName string) {
+	hasher := m.dep.HashIDEncoder()
+	data := &webhook.FileEvent{
+		File:    buildWebhookFile(file, hasher),
+		ActorID: m.webhookActor(hasher),
+		OldName: oldName,
+	}
+	emitEvent(ctx, m.dep, event, data)
+	m.publish(ctx, file.OwnerID(), event, data, data.File.Uri)
+}
+
+// dispatchDeleteEvent sends a file.deleted event.
+func (m *manager) dispatchDeleteEvent(ctx context.Context, path []*fs.URI, permanent bool) {
+	data := &webhook.DeleteEvent{
+		Uris:      uriStrings(path),
+		ActorID:   m.webhookActor(m.dep.HashIDEncoder()),
+		Permanent: permanent,
+	}
+	emitEvent(ctx, m.dep, webhook.EventFileDeleted, data)
+	m.publishToActor(ctx, webhook.EventFileDeleted, data, data.Uris...)
+}
+
+// publishCreateEvent sends a file.created event to real-time subscribers of the owner.
+func (m *manager) publishCreateEvent(ctx context.Context, file fs.File) {
+	hasher := m.dep.HashIDEncoder()
+	data := &webhook.FileEvent{
+		File:    buildWebhookFile(file, hasher),
+		ActorID: m.webhookActor(hasher),
+	}
+	m.publish(ctx, file.OwnerID(), eventbus.EventFileCreated, data, data.File.Uri)
+}
+
+// publishMoveEvent sends a file.moved event to real-time subscribers of current user.
+func (m *manager) publishMoveEvent(ctx context.Context, src []*fs.URI, dst *fs.URI, isCopy bool) {
+	data := &eventbus.MoveData{
+		Uris:    uriStrings(src),
+		Dst:     dst.String(),
+		Copy:    isCopy,
+		ActorID: m.webhookActor(m.dep.HashIDEncoder()),
+	}
+	m.publishToActor(ctx, eventbus.EventFileMoved, data, append(data.Uris, data.Dst)...)
+}
+
+// publishToActor sends the event to real-time subscribers of current user, URIs in such events are
+// relative to current user.
+func (m *manager) publishToActor(ctx context.Context, event string, data any, uris ...string) {
+	if m.user == nil || m.user.ID == 0 {
+		return
+	}
+
+	m.publish(ctx, m.user.ID, event, data, uris...)
+}
+
+// publish sends the event to real-time subscribers of given user.
+func (m *manager) publish(ctx context.Context, uid int, event string, data any, uris ...string) {
+	afterCommit(ctx, func() {
+		m.dep.EventHub().Publish(ctx, uid, event, data, uris...)
+	})
+}
+
+func uriStrings(uris []*fs.URI) []string {
+	return lo.Map(uris, func(uri *fs.URI, _ int) string {
+		return uri.String()
+	})
+}
+
+func (m *manager) webhookActor(hasher hashid.Encoder) string {
+	if m.user == nil || m.user.ID == 0 {
+		return ""
+	}
+
+	return hashid.EncodeUserID(hasher, m.user.ID)
+}
@@ -125,11 +125,14 @@ func (m *CreateArchiveTask) Do(ctx context.Context) (task.Status, error) {
 	m.state = state
 
 	// select node
-	node, err := allocateNode(ctx, dep, &m.state.NodeState, types.NodeCapabilityCreateArchive)
+	node, reassigned, err := allocateNode(ctx, dep, &m.state.NodeState, types.NodeCapabilityCreateArchive)
 	if err != nil {
 		return task.StatusError, fmt.Errorf("failed to allocate node: %w", err)
 	}
 	m.node = node
+	if reassigned {
+		m.resetNodeWork()
+	}
 
 	next := task.StatusCompleted
 
@@ -191,6 +194,19 @@ func (m *CreateArchiveTask) Cleanup(ctx context.Context) error {
 	return nil
 }
 
+// resetNodeWork discards work done on the previous node, so that the archive is created from the
+// beginning on the newly allocated node.
+func (m *CreateArchiveTask) resetNodeWork() {
+	if m.state.Phase == CreateArchiveTaskPhaseCompleteUpload {
+		return
+	}
+
+	m.state.Phase = CreateArchiveTaskPhaseNotStarted
+	m.state.SlaveArchiveTaskID = 0
+	m.state.SlaveUploadTaskID = 0
+	m.state.SlaveCompressState = nil
+}
+
 func (m *CreateArchiveTask) initializeTempFolder(ctx context.Context, dep dependency.Dep) (task.Status, error) {
 	tempPath, err := prepareTempFolder(ctx, dep, m)
 	if err != nil {
@@ -250,6 +266,7 @@ func (m *CreateArchiveTask) listEntitiesAndSendToSlave(ctx context.Context, dep
 		return task.StatusError, fmt.Errorf("failed to create slave task: %w", err)
 	}
 
+	m.state.NodeState.renewLease(ctx, dep, m.node)
 	m.state.Phase = CreateArchiveTaskPhaseAwaitSlaveCompressing
 	m.state.SlaveArchiveTaskID = taskId
 	m.ResumeAfter((10 * time.Second))
@@ -259,9 +276,10 @@ func (m *CreateArchiveTask) listEntitiesAndSendToSlave(ctx context.Context, dep
 func (m *CreateArchiveTask) awaitSlaveCompressing(ctx context.Context, dep dependency.Dep) (task.Status, error) {
 	t, err := m.node.GetTask(ctx, m.state.SlaveArchiveTaskID, false)
 	if err != nil {
-		return task.StatusError, fmt.Errorf("failed to get slave task: %w", err)
+		return m.state.NodeState.handleNodeErr(ctx, dep, m, fmt.Errorf("failed to get slave task: %w", err), m.resetNodeWork)
 	}
 
+	m.state.NodeState.renewLease(ctx, dep, m.node)
 	m.Lock()
 	m.state.NodeState.progress = t.Progress
 	m.Unlock()
@@ -322,6 +340,7 @@ func (m *CreateArchiveTask) createAndAwaitSlaveUploading(ctx context.Context, de
 			return task.StatusError, fmt.Errorf("failed to create slave task: %w", err)
 		}
 
+		m.state.NodeState.renewLease(ctx, dep, m.node)
 		m.state.NodeState.progress = nil
 		m.state.SlaveUploadTaskID = taskId
 		m.ResumeAfter(0)
@@ -331,9 +350,10 @@ func (m *CreateArchiveTask) createAndAwaitSlaveUploading(ctx context.Context, de
 	m.l.Info("Checking slave upload task %d...", m.state.SlaveUploadTaskID)
 	t, err := m.node.GetTask(ctx, m.state.SlaveUploadTaskID, true)
 	if err != nil {
-		return task.StatusError, fmt.Errorf("failed to get slave task: %w", err)
+		return m.state.NodeState.handleNodeErr(ctx, dep, m, fmt.Errorf("failed to get slave task: %w", err), m.resetNodeWork)
 	}
 
+	m.state.NodeState.renewLease(ctx, dep, m.node)
 	m.Lock()
 	m.state.NodeState.progress = t.Progress
 	m.Unlock()
@@ -49,6 +49,8 @@ type (
 		SlaveTaskID     int    `json:"slave_task_id,omitempty"`
 		NodeState       `json:",inline"`
 		Phase           ExtractArchiveTaskPhase `json:"phase,omitempty"`
+		// DeleteSrc deletes the archive once extracted.
+		DeleteSrc bool `json:"delete_src,omitempty"`
 	}
 )
 
@@ -71,12 +73,13 @@ func init() {
 }
 
 // NewExtractArchiveTask creates a new ExtractArchiveTask
-func NewExtractArchiveTask(ctx context.Context, src, dst, encoding string) (queue.Task, error) {
+func NewExtractArchiveTask(ctx context.Context, src, dst, encoding string, deleteSrc bool) (queue.Task, error) {
 	state := &ExtractArchiveTaskState{
 		Uri:       src,
 		Dst:       dst,
 		Encoding:  encoding,
 		NodeState: NodeState{},
+		DeleteSrc: deleteSrc,
 	}
 	stateBytes, err := json.Marshal(state)
 	if err != nil {
@@ -123,11 +126,14 @@ func (m *ExtractArchiveTask) Do(ctx context.Context) (task.Status, error) {
 	m.state = state
 
 	// select node
-	node, err := allocateNode(ctx, dep, &m.state.NodeState, types.NodeCapabilityExtractArchive)
+	node, reassigned, err := allocateNode(ctx, dep, &m.state.NodeState, types.NodeCapabilityExtractArchive)
 	if err != nil {
 		return task.StatusError, fmt.Errorf("failed to allocate node: %w", err)
 	}
 	m.node = node
+	if reassigned {
+		m.resetNodeWork()
+	}
 
 	next := task.StatusCompleted
 
@@ -151,6 +157,10 @@ func (m *ExtractArchiveTask) Do(ctx context.Context) (task.Status, error) {
 		}
 	}
 
+	if next == task.StatusCompleted && err == nil && m.state.DeleteSrc {
+		m.deleteSrc(ctx, dep)
+	}
+
 	newStateStr, marshalErr := json.Marshal(m.state)
 	if marshalErr != nil {
 		return task.StatusError, fmt.Errorf("failed to marshal state: %w", marshalErr)
@@ -162,6 +172,30 @@ func (m *ExtractArchiveTask) Do(ctx context.Context) (task.Status, error) {
 	return next, err
 }
 
+// deleteSrc deletes the extracted archive, failures are logged only as the archive is extracted already.
+func (m *ExtractArchiveTask) deleteSrc(ctx context.Context, dep dependency.Dep) {
+	uri, err := fs.NewUriFromString(m.state.Uri)
+	if err != nil {
+		m.l.Warning("Failed to parse src uri to delete: %s", err)
+		return
+	}
+
+	fm := manager.NewFileManager(dep, inventory.UserFromContext(ctx))
+	if err := fm.Delete(ctx, []*fs.URI{uri}); err != nil {
+		m.l.Warning("Failed to delete extracted archive %q: %s", uri, err)
+		return
+	}
+
+	m.l.Info("Extracted archive %q deleted.", uri)
+}
+
+// resetNodeWork discards work done on the previous node, so that the archive is extracted from the
+// beginning on the newly allocated node.
+func (m *ExtractArchiveTask) resetNodeWork() {
+	m.state.Phase = ExtractArchivePhaseNotStarted
+	m.state.SlaveTaskID = 0
+}
+
 func (m *ExtractArchiveTask) createSlaveExtractTask(ctx context.Context, dep dependency.Dep) (task.Status, error) {
 	uri, err := fs.NewUriFromString(m.state.Uri)
 	if err != nil {
@@ -209,6 +243,7 @@ func (m *ExtractArchiveTask) createSlaveExtractTask(ctx context.Context, dep dep
 		return task.StatusError, fmt.Errorf("failed to create slave task: %w", err)
 	}
 
+	m.state.NodeState.renewLease(ctx, dep, m.node)
 	m.state.Phase = ExtractArchivePhaseAwaitSlaveComplete
 	m.state.SlaveTaskID = taskId
 	m.ResumeAfter((10 * time.Second))
@@ -218,9 +253,10 @@ func (m *ExtractArchiveTask) createSlaveExtractTask(ctx context.Context, dep dep
 func (m *ExtractArchiveTask) awaitSlaveExtractComplete(ctx context.Context, dep dependency.Dep) (task.Status, error) {
 	t, err := m.node.GetTask(ctx, m.state.SlaveTaskID, true)
 	if err != nil {
-		return task.StatusError, fmt.Errorf("failed to get slave task: %w", err)
+		return m.state.NodeState.handleNodeErr(ctx, dep, m, fmt.Errorf("failed to get slave task: %w", err), m.resetNodeWork)
 	}
 
+	m.state.NodeState.renewLease(ctx, dep, m.node)
 	m.Lock()
 	m.state.NodeState.progress = t.Progress
 	m.Unlock()
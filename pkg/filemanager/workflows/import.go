@@ -2,9 +2,14 @@ package workflows
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
 	"sync/atomic"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
@@ -13,11 +18,13 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/samber/lo"
 )
 
 type (
@@ -29,15 +36,41 @@ type (
 		progress queue.Progresses
 	}
 	ImportTaskState struct {
-		PolicyID         int             `json:"policy_id"`
-		Src              string          `json:"src"`
-		Recursive        bool            `json:"is_recursive"`
-		Dst              string          `json:"dst"`
-		Phase            ImportTaskPhase `json:"phase"`
-		Failed           int             `json:"failed,omitempty"`
-		ExtractMediaMeta bool            `json:"extract_media_meta"`
+		PolicyID         int               `json:"policy_id"`
+		Src              string            `json:"src"`
+		Recursive        bool              `json:"is_recursive"`
+		Dst              string            `json:"dst"`
+		Phase            ImportTaskPhase   `json:"phase"`
+		Failed           int               `json:"failed,omitempty"`
+		ExtractMediaMeta bool              `json:"extract_media_meta"`
+		ComputeHash      bool              `json:"compute_hash,omitempty"`
+		OwnerRules       []ImportOwnerRule `json:"owner_rules,omitempty"`
 	}
 	ImportTaskPhase string
+
+	// ImportTaskOptions optional behaviors of an import task.
+	ImportTaskOptions struct {
+		// ExtractMediaMeta runs hooks of new entities to extract media metadata and thumbnails.
+		ExtractMediaMeta bool
+		// ComputeHash stores SHA256 of imported files as metadata, only supported by local policy.
+		ComputeHash bool
+		// OwnerRules assigns files under given paths to other users.
+		OwnerRules []ImportOwnerRule
+	}
+
+	// ImportOwnerRule assigns physical files under Prefix to user UserID. They are imported into the
+	// same destination path in "my" file system of that user, relative to Prefix.
+	ImportOwnerRule struct {
+		Prefix string `json:"prefix"`
+		UserID int    `json:"user_id"`
+	}
+
+	// importOwner is the context to import files of one owner.
+	importOwner struct {
+		ctx context.Context
+		fm  manager.FileManager
+		dst *fs.URI
+	}
 )
 
 const (
@@ -49,12 +82,30 @@ func init() {
 	queue.RegisterResumableTaskFactory(queue.ImportTaskType, NewImportTaskFromModel)
 }
 
-func NewImportTask(ctx context.Context, u *ent.User, src string, recursive bool, dst string, policyID int) (queue.Task, error) {
+// NewImportTask creates a task registering physical files under src of given storage policy into dst
+// without copying them. Files are owned by u unless matched by an owner rule in opts.
+func NewImportTask(ctx context.Context, u *ent.User, src string, recursive bool, dst string, policyID int, opts *ImportTaskOptions) (queue.Task, error) {
+	if opts == nil {
+		opts = &ImportTaskOptions{}
+	}
+
+	// Longest prefix is matched first.
+	rules := lo.Map(opts.OwnerRules, func(r ImportOwnerRule, index int) ImportOwnerRule {
+		r.Prefix = strings.Trim(r.Prefix, fs.Separator)
+		return r
+	})
+	sort.SliceStable(rules, func(i, j int) bool {
+		return len(rules[i].Prefix) > len(rules[j].Prefix)
+	})
+
 	state := &ImportTaskState{
-		Src:       src,
-		Recursive: recursive,
-		Dst:       dst,
-		PolicyID:  policyID,
+		Src:              src,
+		Recursive:        recursive,
+		Dst:              dst,
+		PolicyID:         policyID,
+		ExtractMediaMeta: opts.ExtractMediaMeta,
+		ComputeHash:      opts.ComputeHash,
+		OwnerRules:       rules,
 	}
 	stateBytes, err := json.Marshal(state)
 	if err != nil {
@@ -126,6 +177,48 @@ func (m *ImportTask) processImport(ctx context.Context, dep dependency.Dep) (tas
 		return task.StatusError, fmt.Errorf("failed to parse dst: %s (%w)", err, queue.CriticalErr)
 	}
 
+	if m.state.ComputeHash {
+		policy, err := dep.StoragePolicyClient().GetPolicyByID(ctx, m.state.PolicyID)
+		if err != nil {
+			return task.StatusError, fmt.Errorf("failed to get storage policy: %w", err)
+		}
+
+		if policy.Type != types.PolicyTypeLocal {
+			return task.StatusError, fmt.Errorf("hash can only be computed for local policy (%w)", queue.CriticalErr)
+		}
+	}
+
+	owners := map[int]*importOwner{user.ID: {ctx: ctx, fm: fm, dst: dst}}
+	defer func() {
+		for uid, owner := range owners {
+			if uid != user.ID {
+				owner.fm.Recycle()
+			}
+		}
+	}()
+
+	for _, rule := range m.state.OwnerRules {
+		if _, ok := owners[rule.UserID]; ok {
+			continue
+		}
+
+		owner, err := dep.UserClient().GetLoginUserByID(ctx, rule.UserID)
+		if err != nil {
+			return task.StatusError, fmt.Errorf("failed to get owner %d of %q: %s (%w)", rule.UserID, rule.Prefix, err, queue.CriticalErr)
+		}
+
+		ownerRoot, err := fs.NewUriFromString(fs.NewMyUri(hashid.EncodeUserID(dep.HashIDEncoder(), owner.ID)))
+		if err != nil {
+			return task.StatusError, fmt.Errorf("failed to build dst of owner %d: %w", owner.ID, err)
+		}
+
+		owners[owner.ID] = &importOwner{
+			ctx: context.WithValue(ctx, inventory.UserCtx{}, owner),
+			fm:  manager.NewFileManager(dep, owner),
+			dst: ownerRoot.JoinRaw(dst.PathTrimmed()),
+		}
+	}
+
 	physicalFiles, err := fm.ListPhysical(ctx, m.state.Src, m.state.PolicyID, m.state.Recursive,
 		func(i int) {
 			atomic.AddInt64(&m.progress[ProgressTypeIndexed].Current, int64(i))
@@ -144,33 +237,88 @@ func (m *ImportTask) processImport(ctx context.Context, dep dependency.Dep) (tas
 	m.Unlock()
 
 	for _, physicalFile := range physicalFiles {
+		uid, relativePath, ok := m.resolveOwner(user.ID, physicalFile.RelativePath)
+		if !ok {
+			atomic.AddInt64(&m.progress[ProgressTypeImported].Current, 1)
+			continue
+		}
+		physicalFile.RelativePath = relativePath
+		owner := owners[uid]
+
 		if physicalFile.IsDir {
 			m.l.Info("Creating folder %s", physicalFile.RelativePath)
-			_, err := fm.Create(ctx, dst.Join(physicalFile.RelativePath), types.FileTypeFolder)
+			_, err := owner.fm.Create(owner.ctx, owner.dst.Join(physicalFile.RelativePath), types.FileTypeFolder,
+				fs.WithModifiedAt(physicalFile.LastModify))
 			atomic.AddInt64(&m.progress[ProgressTypeImported].Current, 1)
 			if err != nil {
 				m.l.Warning("Failed to create folder %s: %s", physicalFile.RelativePath, err)
 				failed++
 			}
-		} else {
-			m.l.Info("Importing file %s", physicalFile.RelativePath)
-			err := fm.ImportPhysical(ctx, dst, m.state.PolicyID, physicalFile, m.state.ExtractMediaMeta)
-			atomic.AddInt64(&m.progress[ProgressTypeImported].Current, 1)
+			continue
+		}
+
+		m.l.Info("Importing file %s", physicalFile.RelativePath)
+		if m.state.ComputeHash {
+			hash, err := hashPhysicalFile(physicalFile.Source)
 			if err != nil {
-				var appErr serializer.AppError
-				if errors.As(err, &appErr) && appErr.Code == serializer.CodeObjectExist {
-					m.l.Info("File %s already exists, skipping", physicalFile.RelativePath)
-					continue
-				}
-				m.l.Error("Failed to import file %s: %s, skipping", physicalFile.RelativePath, err)
-				failed++
+				m.l.Warning("Failed to compute hash of file %s: %s", physicalFile.RelativePath, err)
+			} else {
+				physicalFile.Metadata = map[string]string{dbfs.MetadataSha256: hash}
 			}
 		}
+
+		err := owner.fm.ImportPhysical(owner.ctx, owner.dst, m.state.PolicyID, physicalFile, m.state.ExtractMediaMeta)
+		atomic.AddInt64(&m.progress[ProgressTypeImported].Current, 1)
+		if err != nil {
+			var appErr serializer.AppError
+			if errors.As(err, &appErr) && appErr.Code == serializer.CodeObjectExist {
+				m.l.Info("File %s already exists, skipping", physicalFile.RelativePath)
+				continue
+			}
+			m.l.Error("Failed to import file %s: %s, skipping", physicalFile.RelativePath, err)
+			failed++
+		}
 	}
 
+	m.state.Failed = failed
 	return task.StatusCompleted, nil
 }
 
+// resolveOwner returns owner of physical file at relativePath and its path relative to owner's
+// destination. Root folders of owner rules and their ancestors are not imported.
+func (m *ImportTask) resolveOwner(defaultOwner int, relativePath string) (int, string, bool) {
+	for _, rule := range m.state.OwnerRules {
+		if rule.Prefix == "" {
+			return rule.UserID, relativePath, true
+		}
+
+		if relativePath == rule.Prefix || strings.HasPrefix(rule.Prefix, relativePath+fs.Separator) {
+			return 0, "", false
+		}
+
+		if strings.HasPrefix(relativePath, rule.Prefix+fs.Separator) {
+			return rule.UserID, strings.TrimPrefix(relativePath, rule.Prefix+fs.Separator), true
+		}
+	}
+
+	return defaultOwner, relativePath, true
+}
+
+func hashPhysicalFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
 func (m *ImportTask) Progress(ctx context.Context) queue.Progresses {
 	m.Lock()
 	defer m.Unlock()
@@ -8,6 +8,9 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -52,6 +55,23 @@ type (
 		GetTaskStatusTried int                     `json:"get_task_status_tried,omitempty"`
 		Transferred        map[int]interface{}     `json:"transferred,omitempty"`
 		Failed             int                     `json:"failed,omitempty"`
+		// AwaitSelection holds the download after metadata is loaded until user selects files.
+		AwaitSelection bool `json:"await_selection,omitempty"`
+		SelectionHeld  bool `json:"selection_held,omitempty"`
+		// ExtractArchives extracts downloaded archives into the destination folder once transferred.
+		ExtractArchives bool `json:"extract_archives,omitempty"`
+		DeleteArchives  bool `json:"delete_archives,omitempty"`
+		ExtractQueued   bool `json:"extract_queued,omitempty"`
+	}
+
+	// RemoteDownloadTaskOptions optional behaviors of a remote download task.
+	RemoteDownloadTaskOptions struct {
+		// SelectFiles holds the download once metadata is loaded, until files to download are selected.
+		SelectFiles bool
+		// ExtractArchives extracts downloaded archives into the destination folder.
+		ExtractArchives bool
+		// DeleteArchives deletes archives once extracted.
+		DeleteArchives bool
 	}
 )
 
@@ -63,6 +83,11 @@ const (
 
 	GetTaskStatusMaxTries = 5
 
+	// slaveUploadCheckInterval is the interval to check status of slave upload task, progress is
+	// watched every slaveUploadWatchInterval in between.
+	slaveUploadCheckInterval = 30 * time.Second
+	slaveUploadWatchInterval = 5 * time.Second
+
 	SummaryKeyDownloadStatus = "download"
 	SummaryKeySrcStr         = "src_str"
 
@@ -72,6 +97,13 @@ const (
 	SummaryKeySrcMultiple    = "src_multiple"
 	SummaryKeySrcDstPolicyID = "dst_policy_id"
 	SummaryKeyFailed         = "failed"
+	SummaryKeyAwaitSelection = "await_selection"
+)
+
+var (
+	extractableArchiveSuffixes = []string{".zip", ".7z", ".tar", ".tar.gz", ".tgz", ".tar.bz2", ".tbz2", ".tar.xz", ".txz", ".tar.zst"}
+	// rarVolumeRegexp matches volume number of multi-volume RAR archives, e.g. "movie.part2.rar".
+	rarVolumeRegexp = regexp.MustCompile(`\.part(\d+)\.rar$`)
 )
 
 func init() {
@@ -79,12 +111,19 @@ func init() {
 }
 
 // NewRemoteDownloadTask creates a new RemoteDownloadTask
-func NewRemoteDownloadTask(ctx context.Context, src string, srcFile, dst string) (queue.Task, error) {
+func NewRemoteDownloadTask(ctx context.Context, src string, srcFile, dst string, opts *RemoteDownloadTaskOptions) (queue.Task, error) {
+	if opts == nil {
+		opts = &RemoteDownloadTaskOptions{}
+	}
+
 	state := &RemoteDownloadTaskState{
-		SrcUri:     src,
-		SrcFileUri: srcFile,
-		Dst:        dst,
-		NodeState:  NodeState{},
+		SrcUri:          src,
+		SrcFileUri:      srcFile,
+		Dst:             dst,
+		NodeState:       NodeState{},
+		AwaitSelection:  opts.SelectFiles,
+		ExtractArchives: opts.ExtractArchives,
+		DeleteArchives:  opts.DeleteArchives,
 	}
 	stateBytes, err := json.Marshal(state)
 	if err != nil {
@@ -125,11 +164,14 @@ func (m *RemoteDownloadTask) Do(ctx context.Context) (task.Status, error) {
 	m.state = state
 
 	// select node
-	node, err := allocateNode(ctx, dep, &m.state.NodeState, types.NodeCapabilityRemoteDownload)
+	node, reassigned, err := allocateNode(ctx, dep, &m.state.NodeState, types.NodeCapabilityRemoteDownload)
 	if err != nil {
 		return task.StatusError, fmt.Errorf("failed to allocate node: %w", err)
 	}
 	m.node = node
+	if reassigned {
+		m.resetNodeWork()
+	}
 
 	// create downloader instance
 	if m.d == nil {
@@ -146,6 +188,12 @@ func (m *RemoteDownloadTask) Do(ctx context.Context) (task.Status, error) {
 	case RemoteDownloadTaskPhaseNotStarted:
 		next, err = m.createDownloadTask(ctx, dep)
 	case RemoteDownloadTaskPhaseMonitor, RemoteDownloadTaskPhaseAwaitSeeding:
+		if reassigned && m.state.Phase == RemoteDownloadTaskPhaseAwaitSeeding {
+			// All files are transferred, seeding on the lost node is abandoned.
+			m.l.Info("Node of seeding task is lost, skip seeding.")
+			break
+		}
+
 		next, err = m.monitor(ctx, dep)
 	case RemoteDownloadTaskPhaseTransfer:
 		if m.node.IsMaster() {
@@ -166,6 +214,22 @@ func (m *RemoteDownloadTask) Do(ctx context.Context) (task.Status, error) {
 	return next, err
 }
 
+// resetNodeWork discards work done on the previous node, so that the download restarts on the newly
+// allocated node. Files already transferred are not transferred again.
+func (m *RemoteDownloadTask) resetNodeWork() {
+	if m.state.Phase == RemoteDownloadTaskPhaseAwaitSeeding {
+		return
+	}
+
+	m.d = nil
+	m.state.Phase = RemoteDownloadTaskPhaseNotStarted
+	m.state.Handle = nil
+	m.state.Status = nil
+	m.state.SlaveUploadTaskID = 0
+	m.state.SlaveUploadState = nil
+	m.state.GetTaskStatusTried = 0
+}
+
 func (m *RemoteDownloadTask) createDownloadTask(ctx context.Context, dep dependency.Dep) (task.Status, error) {
 	if m.state.Handle != nil {
 		m.state.Phase = RemoteDownloadTaskPhaseMonitor
@@ -173,6 +237,20 @@ func (m *RemoteDownloadTask) createDownloadTask(ctx context.Context, dep depende
 	}
 
 	user := inventory.UserFromContext(ctx)
+	nodeSchedule := m.node.Settings(ctx).Schedule
+	groupSchedule := user.Edges.Group.Settings.RemoteDownloadSchedule
+	wait, err := downloader.UntilScheduled(time.Now(), nodeSchedule, groupSchedule)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("invalid remote download schedule: %s (%w)", err, queue.CriticalErr)
+	}
+
+	if wait > 0 {
+		// Queued until the download window opens
+		m.l.Info("Outside of remote download window, starting after %s.", wait)
+		m.ResumeAfter(wait)
+		return task.StatusSuspending, nil
+	}
+
 	torrentUrl := m.state.SrcUri
 	if m.state.SrcFileUri != "" {
 		// Target is a torrent file
@@ -198,11 +276,17 @@ func (m *RemoteDownloadTask) createDownloadTask(ctx context.Context, dep depende
 	}
 
 	// Create download task
-	handle, err := m.d.CreateTask(ctx, torrentUrl, user.Edges.Group.Settings.RemoteDownloadOptions)
+	options := user.Edges.Group.Settings.RemoteDownloadOptions
+	if limit := downloader.SpeedLimit(nodeSchedule, groupSchedule); limit > 0 {
+		options = lo.Assign(options, map[string]interface{}{downloader.OptionSpeedLimit: limit})
+	}
+
+	handle, err := m.d.CreateTask(ctx, torrentUrl, options)
 	if err != nil {
 		return task.StatusError, fmt.Errorf("failed to create download task: %w", err)
 	}
 
+	m.state.NodeState.renewLease(ctx, dep, m.node)
 	m.state.Handle = handle
 	m.state.Phase = RemoteDownloadTaskPhaseMonitor
 	return task.StatusSuspending, nil
@@ -238,6 +322,16 @@ func (m *RemoteDownloadTask) monitor(ctx context.Context, dep dependency.Dep) (t
 		return task.StatusSuspending, nil
 	}
 
+	if m.state.AwaitSelection {
+		if waiting := m.awaitSelection(ctx, status); waiting {
+			m.state.NodeState.renewLease(ctx, dep, m.node)
+			m.state.Status = status
+			m.state.GetTaskStatusTried = 0
+			m.ResumeAfter(resumeAfter)
+			return task.StatusSuspending, nil
+		}
+	}
+
 	if m.state.Status == nil || m.state.Status.Total != status.Total {
 		m.l.Info("download size changed, re-validate files.")
 		// First time to get status / total size changed, check user capacity
@@ -247,6 +341,7 @@ func (m *RemoteDownloadTask) monitor(ctx context.Context, dep dependency.Dep) (t
 		}
 	}
 
+	m.state.NodeState.renewLease(ctx, dep, m.node)
 	m.state.Status = status
 	m.state.GetTaskStatusTried = 0
 
@@ -338,18 +433,33 @@ func (m *RemoteDownloadTask) slaveTransfer(ctx context.Context, dep dependency.D
 			return task.StatusError, fmt.Errorf("failed to create slave task: %w", err)
 		}
 
+		m.state.NodeState.renewLease(ctx, dep, m.node)
 		m.state.NodeState.progress = nil
 		m.state.SlaveUploadTaskID = taskId
 		m.ResumeAfter(0)
 		return task.StatusSuspending, nil
 	}
 
+	// Follow progress of slave task until it finishes or the check window ends.
+	watchCtx, cancel := context.WithTimeout(ctx, slaveUploadCheckInterval)
+	err := m.node.WatchTask(watchCtx, m.state.SlaveUploadTaskID, slaveUploadWatchInterval, func(summary *cluster.SlaveTaskSummary) error {
+		m.Lock()
+		m.state.NodeState.progress = summary.Progress
+		m.Unlock()
+		return nil
+	})
+	cancel()
+	if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+		m.l.Warning("Failed to watch slave upload task %d: %s", m.state.SlaveUploadTaskID, err)
+	}
+
 	m.l.Info("Checking slave upload task %d...", m.state.SlaveUploadTaskID)
 	t, err := m.node.GetTask(ctx, m.state.SlaveUploadTaskID, true)
 	if err != nil {
-		return task.StatusError, fmt.Errorf("failed to get slave task: %w", err)
+		return m.state.NodeState.handleNodeErr(ctx, dep, m, fmt.Errorf("failed to get slave task: %w", err), m.resetNodeWork)
 	}
 
+	m.state.NodeState.renewLease(ctx, dep, m.node)
 	m.Lock()
 	m.state.NodeState.progress = t.Progress
 	m.Unlock()
@@ -375,6 +485,7 @@ func (m *RemoteDownloadTask) slaveTransfer(ctx context.Context, dep dependency.D
 				m.state.SlaveUploadState.First5TransferErrors,
 			)
 		} else {
+			m.queueExtractTasks(ctx, dep)
 			m.state.Phase = RemoteDownloadTaskPhaseAwaitSeeding
 			m.ResumeAfter(0)
 			return task.StatusSuspending, nil
@@ -386,7 +497,7 @@ func (m *RemoteDownloadTask) slaveTransfer(ctx context.Context, dep dependency.D
 	}
 
 	m.l.Info("Slave task %d is still uploading, resume after 30s.", m.state.SlaveUploadTaskID)
-	m.ResumeAfter(time.Second * 30)
+	m.ResumeAfter(slaveUploadCheckInterval)
 	return task.StatusSuspending, nil
 }
 
@@ -511,14 +622,103 @@ func (m *RemoteDownloadTask) masterTransfer(ctx context.Context, dep dependency.
 	}
 
 	m.l.Info("All files transferred.")
+	m.queueExtractTasks(ctx, dep)
 	m.state.Phase = RemoteDownloadTaskPhaseAwaitSeeding
 	return task.StatusSuspending, nil
 }
 
+// queueExtractTasks queues tasks extracting transferred archives into the destination folder if enabled.
+// Failures are logged only, as files are downloaded already.
+func (m *RemoteDownloadTask) queueExtractTasks(ctx context.Context, dep dependency.Dep) {
+	if !m.state.ExtractArchives || m.state.ExtractQueued || m.state.Status == nil {
+		return
+	}
+
+	m.state.ExtractQueued = true
+	dstUri, err := fs.NewUriFromString(m.state.Dst)
+	if err != nil {
+		m.l.Warning("Failed to parse dst uri to extract archives: %s", err)
+		return
+	}
+
+	for _, f := range m.state.Status.Files {
+		if !f.Selected || !isExtractableArchive(f.Name) {
+			continue
+		}
+
+		src := dstUri.JoinRaw(f.Name)
+		t, err := NewExtractArchiveTask(ctx, src.String(), m.state.Dst, "", m.state.DeleteArchives)
+		if err != nil {
+			m.l.Warning("Failed to create task to extract %q: %s", src, err)
+			continue
+		}
+
+		if err := dep.IoIntenseQueue(ctx).QueueTask(ctx, t); err != nil {
+			m.l.Warning("Failed to queue task to extract %q: %s", src, err)
+			continue
+		}
+
+		m.l.Info("Queued task %d to extract %q.", t.ID(), src)
+	}
+}
+
+// isExtractableArchive returns whether a downloaded file is an archive to extract. Only the first volume
+// of multi-volume RAR archives is extracted.
+func isExtractableArchive(name string) bool {
+	name = strings.ToLower(name)
+	if strings.HasSuffix(name, ".rar") {
+		if matches := rarVolumeRegexp.FindStringSubmatch(name); matches != nil {
+			volume, _ := strconv.Atoi(matches[1])
+			return volume == 1
+		}
+
+		return true
+	}
+
+	return lo.ContainsBy(extractableArchiveSuffixes, func(suffix string) bool {
+		return strings.HasSuffix(name, suffix)
+	})
+}
+
 func (m *RemoteDownloadTask) awaitSeeding(ctx context.Context, dep dependency.Dep) (task.Status, error) {
 	return task.StatusSuspending, nil
 }
 
+// awaitSelection holds all files once metadata is loaded, until user selects files to download. Returns
+// whether the task is still waiting for selection.
+func (m *RemoteDownloadTask) awaitSelection(ctx context.Context, status *downloader.TaskStatus) bool {
+	if len(status.Files) == 0 {
+		// Metadata not loaded yet
+		return true
+	}
+
+	if !m.state.SelectionHeld {
+		args := lo.Map(status.Files, func(f downloader.TaskFile, _ int) *downloader.SetFileToDownloadArgs {
+			return &downloader.SetFileToDownloadArgs{Index: f.Index}
+		})
+		if err := m.d.SetFilesToDownload(ctx, m.state.Handle, args...); err != nil {
+			m.l.Warning("Failed to hold files for selection, download without selection: %s", err)
+			m.state.AwaitSelection = false
+			return false
+		}
+
+		m.l.Info("Metadata loaded, waiting for user to select files.")
+		m.state.SelectionHeld = true
+		for i := range status.Files {
+			status.Files[i].Selected = false
+		}
+		return true
+	}
+
+	if lo.ContainsBy(status.Files, func(f downloader.TaskFile) bool { return f.Selected }) {
+		m.l.Info("Files selected, resuming download.")
+		m.state.AwaitSelection = false
+		return false
+	}
+
+	return true
+}
+
 func (m *RemoteDownloadTask) validateFiles(ctx context.Context, dep dependency.Dep, status *downloader.TaskStatus) error {
 	// Validate files
 	user := inventory.UserFromContext(ctx)
@@ -615,6 +815,7 @@ func (m *RemoteDownloadTask) Summarize(hasher hashid.Encoder) *queue.Summary {
 			SummaryKeyDst:            m.state.Dst,
 			SummaryKeyFailed:         failed,
 			SummaryKeyDownloadStatus: status,
+			SummaryKeyAwaitSelection: m.state.AwaitSelection,
 		},
 	}
 }
@@ -0,0 +1,242 @@
+package workflows
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/retentionrule"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/retention"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/samber/lo"
+	"golang.org/x/tools/container/intsets"
+)
+
+const (
+	retentionPageSize = 100
+	// retentionDeleteBatch is the number of expired files deleted in one call.
+	retentionDeleteBatch = 100
+)
+
+type (
+	// RetentionCandidate is a file affected by a retention rule.
+	RetentionCandidate struct {
+		Uri       string    `json:"uri"`
+		Size      int64     `json:"size"`
+		UpdatedAt time.Time `json:"updated_at"`
+		// Expired indicates the file itself will be removed.
+		Expired bool `json:"expired"`
+		// StaleVersions is the number of versions to be deleted.
+		StaleVersions int `json:"stale_versions,omitempty"`
+	}
+
+	// RetentionPreview lists files that would be affected if a rule is executed now.
+	RetentionPreview struct {
+		Files         []*RetentionCandidate `json:"files"`
+		Expired       int                   `json:"expired"`
+		StaleVersions int                   `json:"stale_versions"`
+		// Truncated is set if more files are affected than listed.
+		Truncated bool `json:"truncated"`
+	}
+
+	retentionTarget struct {
+		file          fs.File
+		expired       bool
+		staleVersions []int
+	}
+)
+
+func init() {
+	crontab.Register(setting.CronTypeRetention, CronRetention)
+}
+
+// CronRetention executes enabled retention rules of all active users.
+func CronRetention(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+
+	afterID := 0
+	for {
+		rules, err := dep.RetentionRuleClient().ListEnabled(ctx, afterID, retentionPageSize)
+		if err != nil {
+			l.Error("Failed to list retention rules: %s", err)
+			return
+		}
+
+		for _, rule := range rules {
+			executeRetentionRule(ctx, dep, rule)
+		}
+
+		if len(rules) < retentionPageSize {
+			break
+		}
+		afterID = rules[len(rules)-1].ID
+	}
+}
+
+func executeRetentionRule(ctx context.Context, dep dependency.Dep, rule *ent.RetentionRule) {
+	l := dep.Logger()
+	owner, err := dep.UserClient().GetLoginUserByID(ctx, rule.UserID)
+	if err != nil {
+		// Owner is not active, check later.
+		l.Debug("Skip retention rule %d of inactive user %d: %s", rule.ID, rule.UserID, err)
+		return
+	}
+
+	ctx = context.WithValue(ctx, inventory.UserCtx{}, owner)
+	fm := manager.NewFileManager(dep, owner)
+	defer fm.Recycle()
+
+	executedAt := time.Now()
+	result := ApplyRetention(ctx, fm, RetentionRuleArgsFromModel(rule), executedAt)
+	if result.Error != "" || result.Failed > 0 {
+		l.Warning("Retention rule %d finished with %d failures: %s", rule.ID, result.Failed, result.Error)
+	}
+
+	if err := dep.RetentionRuleClient().MarkExecuted(ctx, rule.ID, executedAt, result); err != nil {
+		l.Warning("Failed to update retention rule %d: %s", rule.ID, err)
+	}
+}
+
+// RetentionRuleArgsFromModel returns arguments of a saved rule.
+func RetentionRuleArgsFromModel(rule *ent.RetentionRule) *inventory.RetentionRuleArgs {
+	return &inventory.RetentionRuleArgs{
+		Folder:       rule.Folder,
+		Recursive:    rule.Recursive,
+		Action:       rule.Action,
+		MaxAgeDays:   rule.MaxAgeDays,
+		KeepVersions: rule.KeepVersions,
+		Enabled:      rule.Enabled,
+	}
+}
+
+// PreviewRetention returns files that would be affected if the rule is executed at given time, at most
+// limit files are listed.
+func PreviewRetention(ctx context.Context, fm manager.FileManager, args *inventory.RetentionRuleArgs, now time.Time, limit int) (*RetentionPreview, error) {
+	targets, err := evaluateRetention(ctx, fm, args, now)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &RetentionPreview{Files: []*RetentionCandidate{}}
+	for _, t := range targets {
+		if t.expired {
+			res.Expired++
+		} else {
+			res.StaleVersions += len(t.staleVersions)
+		}
+
+		if len(res.Files) >= limit {
+			res.Truncated = true
+			continue
+		}
+
+		candidate := &RetentionCandidate{
+			Uri:       t.file.Uri(false).String(),
+			Size:      t.file.Size(),
+			UpdatedAt: t.file.UpdatedAt(),
+			Expired:   t.expired,
+		}
+		if !t.expired {
+			candidate.StaleVersions = len(t.staleVersions)
+		}
+		res.Files = append(res.Files, candidate)
+	}
+
+	return res, nil
+}
+
+// ApplyRetention executes the rule at given time. Expired files are removed in batches, stale versions of
+// remaining files are deleted one by one. Failures are counted in the result.
+func ApplyRetention(ctx context.Context, fm manager.FileManager, args *inventory.RetentionRuleArgs, now time.Time) *types.RetentionResult {
+	result := &types.RetentionResult{}
+	targets, err := evaluateRetention(ctx, fm, args, now)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	var opts []fs.Option
+	if args.Action == retentionrule.ActionDelete {
+		opts = append(opts, fs.WithSkipSoftDelete(true))
+	}
+
+	expired := lo.Filter(targets, func(t *retentionTarget, index int) bool {
+		return t.expired
+	})
+	for _, batch := range lo.Chunk(expired, retentionDeleteBatch) {
+		uris := lo.Map(batch, func(t *retentionTarget, index int) *fs.URI {
+			return t.file.Uri(false)
+		})
+		if err := fm.Delete(ctx, uris, opts...); err != nil {
+			result.Failed += len(batch)
+			result.Error = fmt.Sprintf("failed to remove expired files: %s", err)
+			continue
+		}
+
+		result.Removed += len(batch)
+	}
+
+	for _, t := range targets {
+		if t.expired {
+			continue
+		}
+
+		for _, version := range t.staleVersions {
+			if err := fm.DeleteVersion(ctx, t.file.Uri(false), version); err != nil {
+				result.Failed++
+				result.Error = fmt.Sprintf("failed to delete version of %q: %s", t.file.Uri(false), err)
+				continue
+			}
+
+			result.VersionsDeleted++
+		}
+	}
+
+	return result
+}
+
+// evaluateRetention walks the folder of the rule and returns files that are expired or have stale versions.
+func evaluateRetention(ctx context.Context, fm manager.FileManager, args *inventory.RetentionRuleArgs, now time.Time) ([]*retentionTarget, error) {
+	folder, err := fs.NewUriFromString(args.Folder)
+	if err != nil {
+		return nil, fmt.Errorf("invalid folder: %w", err)
+	}
+
+	depth := 1
+	if args.Recursive {
+		depth = intsets.MaxInt
+	}
+
+	var targets []*retentionTarget
+	if err := fm.Walk(ctx, folder, depth, func(f fs.File, level int) error {
+		if level == 0 || f.Type() != types.FileTypeFile || f.IsSymbolic() {
+			return nil
+		}
+
+		t := &retentionTarget{file: f, expired: retention.Expired(f.UpdatedAt(), now, args.MaxAgeDays)}
+		if !t.expired && args.KeepVersions > 0 {
+			versions := lo.FilterMap(f.Entities(), func(e fs.Entity, index int) (retention.Version, bool) {
+				return retention.Version{ID: e.ID(), CreatedAt: e.CreatedAt()}, e.Type() == types.EntityTypeVersion
+			})
+			t.staleVersions = retention.StaleVersions(f.PrimaryEntityID(), versions, args.KeepVersions)
+		}
+
+		if t.expired || len(t.staleVersions) > 0 {
+			targets = append(targets, t)
+		}
+		return nil
+	}, dbfs.WithFileEntities()); err != nil {
+		return nil, fmt.Errorf("failed to walk folder: %w", err)
+	}
+
+	return targets, nil
+}
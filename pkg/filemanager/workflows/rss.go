@@ -0,0 +1,173 @@
+package workflows
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/rss"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/samber/lo"
+)
+
+const (
+	rssPageSize = 100
+	// rssMaxSeen is the number of latest seen item IDs kept for each subscription, more than items in
+	// a typical feed.
+	rssMaxSeen = 1000
+	// rssMaxTasksPerPoll limits download tasks created from a feed in each poll, remaining items are
+	// downloaded in later polls.
+	rssMaxTasksPerPoll = 20
+	rssFetchTimeout    = 30 * time.Second
+)
+
+func init() {
+	crontab.Register(setting.CronTypeRss, CronRss)
+}
+
+// CronRss polls enabled RSS subscriptions, items not seen before matching filters of a subscription are
+// downloaded by remote download tasks.
+func CronRss(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+
+	afterID := 0
+	for {
+		subscriptions, err := dep.RssSubscriptionClient().ListEnabled(ctx, afterID, rssPageSize)
+		if err != nil {
+			l.Error("Failed to list RSS subscriptions: %s", err)
+			return
+		}
+
+		for _, sub := range subscriptions {
+			checkRssSubscription(ctx, dep, sub)
+		}
+
+		if len(subscriptions) < rssPageSize {
+			break
+		}
+		afterID = subscriptions[len(subscriptions)-1].ID
+	}
+}
+
+func checkRssSubscription(ctx context.Context, dep dependency.Dep, sub *ent.RssSubscription) {
+	l := dep.Logger()
+	owner, err := dep.UserClient().GetLoginUserByID(ctx, sub.UserID)
+	if err != nil {
+		// Owner is not active, check later.
+		l.Debug("Skip RSS subscription %d of inactive user %d: %s", sub.ID, sub.UserID, err)
+		return
+	}
+
+	checkedAt := time.Now()
+	ctx = context.WithValue(ctx, inventory.UserCtx{}, owner)
+	seen, err := pollRssSubscription(ctx, dep, sub, owner)
+	lastErr := ""
+	if err != nil {
+		l.Warning("Failed to poll RSS subscription %d: %s", sub.ID, err)
+		lastErr = err.Error()
+	}
+
+	if err := dep.RssSubscriptionClient().MarkChecked(ctx, sub.ID, seen, checkedAt, lastErr); err != nil {
+		l.Warning("Failed to update RSS subscription %d: %s", sub.ID, err)
+	}
+}
+
+// pollRssSubscription fetches the feed and queues download tasks of new matching items, returns IDs of
+// seen items including those processed in this poll.
+func pollRssSubscription(ctx context.Context, dep dependency.Dep, sub *ent.RssSubscription, owner *ent.User) ([]string, error) {
+	seen := sub.Seen
+	if !owner.Edges.Group.Permissions.Enabled(int(types.GroupPermissionRemoteDownload)) {
+		return seen, fmt.Errorf("group not allowed to download files")
+	}
+
+	include, exclude, err := CompileRssFilters(sub.Include, sub.Exclude)
+	if err != nil {
+		return seen, err
+	}
+
+	res := dep.RequestClient().Request(http.MethodGet, sub.URL, nil,
+		request.WithContext(ctx),
+		request.WithTimeout(rssFetchTimeout),
+		request.WithLogger(dep.Logger()),
+		request.WithTransport(publicTransport),
+	).CheckHTTPResponse(http.StatusOK)
+	if res.Err != nil {
+		return seen, fmt.Errorf("failed to fetch feed: %w", res.Err)
+	}
+	defer res.Response.Body.Close()
+
+	items, err := rss.Parse(res.Response.Body)
+	if err != nil {
+		return seen, err
+	}
+
+	seenSet := lo.SliceToMap(seen, func(id string) (string, bool) { return id, true })
+	created := 0
+	for _, item := range items {
+		if seenSet[item.ID] {
+			continue
+		}
+
+		if (include != nil && !include.MatchString(item.Title)) || (exclude != nil && exclude.MatchString(item.Title)) {
+			seenSet[item.ID] = true
+			seen = append(seen, item.ID)
+			continue
+		}
+
+		if created >= rssMaxTasksPerPoll {
+			break
+		}
+
+		t, err := NewRemoteDownloadTask(ctx, item.DownloadUrl, "", sub.Dst, nil)
+		if err != nil {
+			return lastRssSeen(seen), fmt.Errorf("failed to create task for %q: %w", item.Title, err)
+		}
+
+		if err := dep.RemoteDownloadQueue(ctx).QueueTask(ctx, t); err != nil {
+			return lastRssSeen(seen), fmt.Errorf("failed to queue task for %q: %w", item.Title, err)
+		}
+
+		dep.Logger().Info("Queued remote download task %d for RSS item %q of subscription %d.", t.ID(), item.Title, sub.ID)
+		created++
+		seenSet[item.ID] = true
+		seen = append(seen, item.ID)
+	}
+
+	return lastRssSeen(seen), nil
+}
+
+// CompileRssFilters compiles include and exclude filters of a subscription, nil for empty ones.
+func CompileRssFilters(include, exclude string) (*regexp.Regexp, *regexp.Regexp, error) {
+	var includeRegexp, excludeRegexp *regexp.Regexp
+	var err error
+	if include != "" {
+		if includeRegexp, err = regexp.Compile(include); err != nil {
+			return nil, nil, fmt.Errorf("invalid include filter: %w", err)
+		}
+	}
+
+	if exclude != "" {
+		if excludeRegexp, err = regexp.Compile(exclude); err != nil {
+			return nil, nil, fmt.Errorf("invalid exclude filter: %w", err)
+		}
+	}
+
+	return includeRegexp, excludeRegexp, nil
+}
+
+func lastRssSeen(seen []string) []string {
+	if len(seen) > rssMaxSeen {
+		return seen[len(seen)-rssMaxSeen:]
+	}
+
+	return seen
+}
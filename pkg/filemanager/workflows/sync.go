@@ -0,0 +1,473 @@
+package workflows
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/syncjob"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"golang.org/x/tools/container/intsets"
+)
+
+type (
+	// FolderSyncTask mirrors a source folder to a destination folder one way. The destination can be
+	// in any storage policy, files are copied through the file manager so that they are stored in the
+	// policy of destination folder.
+	FolderSyncTask struct {
+		*queue.DBTask
+
+		l        logging.Logger
+		state    *FolderSyncTaskState
+		progress queue.Progresses
+	}
+	FolderSyncTaskState struct {
+		JobID           int               `json:"job_id"`
+		Src             string            `json:"src"`
+		Dst             string            `json:"dst"`
+		Compare         syncjob.Compare   `json:"compare"`
+		PropagateDelete bool              `json:"propagate_delete"`
+		Report          *types.SyncReport `json:"report,omitempty"`
+	}
+)
+
+const (
+	ProgressTypeSynced = "synced"
+
+	SummaryKeySyncReport = "sync_report"
+
+	syncJobPageSize = 100
+	// syncMaxErrors is the number of error messages kept in a sync report.
+	syncMaxErrors = 20
+)
+
+func init() {
+	queue.RegisterResumableTaskFactory(queue.FolderSyncTaskType, NewFolderSyncTaskFromModel)
+	crontab.Register(setting.CronTypeFolderSync, CronFolderSync)
+}
+
+func NewFolderSyncTask(ctx context.Context, u *ent.User, job *ent.SyncJob) (queue.Task, error) {
+	state := &FolderSyncTaskState{
+		JobID:           job.ID,
+		Src:             job.Src,
+		Dst:             job.Dst,
+		Compare:         job.Compare,
+		PropagateDelete: job.PropagateDelete,
+	}
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	return &FolderSyncTask{
+		DBTask: &queue.DBTask{
+			Task: &ent.Task{
+				Type:          queue.FolderSyncTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  string(stateBytes),
+				PublicState:   &types.TaskPublicState{},
+			},
+			DirectOwner: u,
+		},
+	}, nil
+}
+
+func NewFolderSyncTaskFromModel(task *ent.Task) queue.Task {
+	return &FolderSyncTask{
+		DBTask: &queue.DBTask{
+			Task: task,
+		},
+	}
+}
+
+func (m *FolderSyncTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	m.l = dep.Logger()
+
+	m.Lock()
+	if m.progress == nil {
+		m.progress = make(queue.Progresses)
+	}
+	m.progress[ProgressTypeSynced] = &queue.Progress{}
+	m.Unlock()
+
+	state := &FolderSyncTaskState{}
+	if err := json.Unmarshal([]byte(m.State()), state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+	m.state = state
+
+	m.state.Report = &types.SyncReport{StartedAt: time.Now()}
+	err := m.sync(ctx, dep)
+	if err != nil {
+		m.state.Report.Error = err.Error()
+	}
+
+	finishedAt := time.Now()
+	m.state.Report.FinishedAt = &finishedAt
+	if saveErr := dep.SyncJobClient().SaveReport(ctx, m.state.JobID, m.state.Report); saveErr != nil && !ent.IsNotFound(saveErr) {
+		m.l.Warning("Failed to save report of sync job %d: %s", m.state.JobID, saveErr)
+	}
+
+	newStateStr, marshalErr := json.Marshal(m.state)
+	if marshalErr != nil {
+		return task.StatusError, fmt.Errorf("failed to marshal state: %w", marshalErr)
+	}
+
+	m.Lock()
+	m.Task.PrivateState = string(newStateStr)
+	m.Unlock()
+
+	if err != nil {
+		return task.StatusError, fmt.Errorf("%s (%w)", err, queue.CriticalErr)
+	}
+
+	return task.StatusCompleted, nil
+}
+
+func (m *FolderSyncTask) sync(ctx context.Context, dep dependency.Dep) error {
+	user := inventory.UserFromContext(ctx)
+	fm := manager.NewFileManager(dep, user)
+	defer fm.Recycle()
+
+	src, err := fs.NewUriFromString(m.state.Src)
+	if err != nil {
+		return fmt.Errorf("failed to parse src: %w", err)
+	}
+
+	dst, err := fs.NewUriFromString(m.state.Dst)
+	if err != nil {
+		return fmt.Errorf("failed to parse dst: %w", err)
+	}
+
+	srcRoot, err := fm.Get(ctx, src)
+	if err != nil {
+		return fmt.Errorf("failed to get source folder: %w", err)
+	}
+
+	if srcRoot.Type() != types.FileTypeFolder {
+		return errors.New("source is not a folder")
+	}
+
+	dstRoot, err := fm.Create(ctx, dst, types.FileTypeFolder)
+	if err != nil {
+		return fmt.Errorf("failed to create destination folder: %w", err)
+	}
+
+	// Index existing files in destination by relative path.
+	existing := make(map[string]fs.File)
+	dstRootPath := dstRoot.Uri(false).Path()
+	if err := fm.Walk(ctx, dstRoot.Uri(false), intsets.MaxInt, func(f fs.File, level int) error {
+		if rel, ok := syncRelativePath(dstRootPath, f.Uri(false).Path()); ok {
+			existing[rel] = f
+		}
+		return nil
+	}, dbfs.WithFileEntities()); err != nil {
+		return fmt.Errorf("failed to walk destination folder: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	srcRootPath := srcRoot.Uri(false).Path()
+	if err := fm.Walk(ctx, srcRoot.Uri(false), intsets.MaxInt, func(f fs.File, level int) error {
+		rel, ok := syncRelativePath(srcRootPath, f.Uri(false).Path())
+		if !ok || f.IsSymbolic() {
+			return nil
+		}
+
+		seen[rel] = true
+		if err := m.syncOne(ctx, fm, f, dstRoot.Uri(false).JoinRaw(rel), existing[rel]); err != nil {
+			m.l.Warning("Failed to sync %q: %s", rel, err)
+			m.state.Report.Failed++
+			m.addError(fmt.Sprintf("%s: %s", rel, err))
+		}
+
+		atomic.AddInt64(&m.progress[ProgressTypeSynced].Current, 1)
+		return nil
+	}, dbfs.WithFileEntities()); err != nil {
+		return fmt.Errorf("failed to walk source folder: %w", err)
+	}
+
+	if m.state.PropagateDelete {
+		m.deleteOrphans(ctx, fm, existing, seen)
+	}
+
+	return nil
+}
+
+// syncOne copies a source file or folder to dst, existing is the file at dst, nil if not exist.
+func (m *FolderSyncTask) syncOne(ctx context.Context, fm manager.FileManager, src fs.File, dst *fs.URI, existing fs.File) error {
+	if src.Type() == types.FileTypeFolder {
+		if existing != nil && existing.Type() == types.FileTypeFolder {
+			m.state.Report.Unchanged++
+			return nil
+		}
+
+		if existing != nil {
+			return errors.New("a file with the same name exists in destination")
+		}
+
+		if _, err := fm.Create(ctx, dst, types.FileTypeFolder); err != nil {
+			return err
+		}
+
+		m.state.Report.Created++
+		return nil
+	}
+
+	if existing != nil {
+		if existing.Type() == types.FileTypeFolder {
+			return errors.New("a folder with the same name exists in destination")
+		}
+
+		changed, err := m.changed(ctx, fm, src, existing)
+		if err != nil {
+			return fmt.Errorf("failed to compare files: %w", err)
+		}
+
+		if !changed {
+			m.state.Report.Unchanged++
+			return nil
+		}
+	}
+
+	if err := copySyncFile(ctx, fm, src, dst); err != nil {
+		return err
+	}
+
+	if existing != nil {
+		m.state.Report.Updated++
+	} else {
+		m.state.Report.Created++
+	}
+
+	return nil
+}
+
+// changed reports whether content of dst differs from src using the compare mode of the job.
+func (m *FolderSyncTask) changed(ctx context.Context, fm manager.FileManager, src, dst fs.File) (bool, error) {
+	if m.state.Compare != syncjob.CompareHash {
+		return SyncMtimeChanged(src.Size(), dst.Size(), src.UpdatedAt(), dst.UpdatedAt()), nil
+	}
+
+	if src.PrimaryEntityID() == dst.PrimaryEntityID() {
+		return false, nil
+	}
+
+	if src.Size() != dst.Size() {
+		return true, nil
+	}
+
+	srcHash, err := hashSyncFile(ctx, fm, src)
+	if err != nil {
+		return false, err
+	}
+
+	dstHash, err := hashSyncFile(ctx, fm, dst)
+	if err != nil {
+		return false, err
+	}
+
+	return srcHash != dstHash, nil
+}
+
+// deleteOrphans moves files in destination that no longer exist in source to trash. Only the top-most
+// orphan is deleted so that its children are deleted along with it.
+func (m *FolderSyncTask) deleteOrphans(ctx context.Context, fm manager.FileManager, existing map[string]fs.File, seen map[string]bool) {
+	for rel, f := range existing {
+		if seen[rel] {
+			continue
+		}
+
+		parent := rel
+		topMost := true
+		for {
+			idx := strings.LastIndex(parent, fs.Separator)
+			if idx <= 0 {
+				break
+			}
+
+			parent = parent[:idx]
+			if _, ok := existing[parent]; ok && !seen[parent] {
+				topMost = false
+				break
+			}
+		}
+
+		if !topMost {
+			continue
+		}
+
+		if err := fm.Delete(ctx, []*fs.URI{f.Uri(false)}); err != nil {
+			m.l.Warning("Failed to delete %q: %s", rel, err)
+			m.state.Report.Failed++
+			m.addError(fmt.Sprintf("%s: %s", rel, err))
+			continue
+		}
+
+		m.state.Report.Deleted++
+	}
+}
+
+func (m *FolderSyncTask) addError(msg string) {
+	if len(m.state.Report.Errors) < syncMaxErrors {
+		m.state.Report.Errors = append(m.state.Report.Errors, msg)
+	}
+}
+
+func (m *FolderSyncTask) Progress(ctx context.Context) queue.Progresses {
+	m.Lock()
+	defer m.Unlock()
+	return m.progress
+}
+
+func (m *FolderSyncTask) Summarize(hasher hashid.Encoder) *queue.Summary {
+	if m.state == nil {
+		if err := json.Unmarshal([]byte(m.State()), &m.state); err != nil {
+			return nil
+		}
+	}
+
+	return &queue.Summary{
+		Props: map[string]any{
+			SummaryKeySrcStr:     m.state.Src,
+			SummaryKeyDst:        m.state.Dst,
+			SummaryKeySyncReport: m.state.Report,
+		},
+	}
+}
+
+// copySyncFile uploads content of src to dst, replacing existing file as a new version. Modification
+// time of src is preserved so that unchanged files can be detected in later runs.
+func copySyncFile(ctx context.Context, fm manager.FileManager, src fs.File, dst *fs.URI) error {
+	es, err := fm.GetEntitySource(ctx, src.PrimaryEntityID())
+	if err != nil {
+		return fmt.Errorf("failed to get entity source: %w", err)
+	}
+	defer es.Close()
+
+	es.Apply(entitysource.WithContext(ctx))
+	lastModified := src.UpdatedAt()
+	_, err = fm.Update(ctx, &fs.UploadRequest{
+		Props: &fs.UploadProps{
+			Uri:          dst,
+			Size:         src.Size(),
+			LastModified: &lastModified,
+		},
+		File:   es,
+		Seeker: es,
+	})
+	return err
+}
+
+func hashSyncFile(ctx context.Context, fm manager.FileManager, f fs.File) (string, error) {
+	es, err := fm.GetEntitySource(ctx, f.PrimaryEntityID())
+	if err != nil {
+		return "", fmt.Errorf("failed to get entity source: %w", err)
+	}
+	defer es.Close()
+
+	es.Apply(entitysource.WithContext(ctx))
+	h := sha256.New()
+	if _, err := io.Copy(h, es); err != nil {
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// syncRelativePath returns path of p relative to root, false if p is root itself or not under root.
+func syncRelativePath(root, p string) (string, bool) {
+	root = strings.TrimSuffix(root, fs.Separator)
+	if !strings.HasPrefix(p, root+fs.Separator) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(p, root), true
+}
+
+// SyncMtimeChanged reports whether a file is changed by size and modification time. Sub-second
+// precision is ignored since it might be lost by storage providers.
+func SyncMtimeChanged(srcSize, dstSize int64, srcMtime, dstMtime time.Time) bool {
+	return srcSize != dstSize || !srcMtime.Truncate(time.Second).Equal(dstMtime.Truncate(time.Second))
+}
+
+// CronFolderSync queues sync tasks of jobs that are due. A job is skipped if its previous run is not
+// finished yet.
+func CronFolderSync(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+
+	afterID := 0
+	now := time.Now()
+	for {
+		jobs, err := dep.SyncJobClient().ListDue(ctx, now, afterID, syncJobPageSize)
+		if err != nil {
+			l.Error("Failed to list due sync jobs: %s", err)
+			return
+		}
+
+		for _, job := range jobs {
+			queueSyncJob(ctx, dep, job)
+		}
+
+		if len(jobs) < syncJobPageSize {
+			break
+		}
+		afterID = jobs[len(jobs)-1].ID
+	}
+}
+
+func queueSyncJob(ctx context.Context, dep dependency.Dep, job *ent.SyncJob) {
+	l := dep.Logger()
+	if job.TaskID != nil {
+		previous, err := dep.TaskClient().GetTaskByID(ctx, *job.TaskID)
+		if err == nil && (previous.Status == task.StatusQueued || previous.Status == task.StatusProcessing ||
+			previous.Status == task.StatusSuspending) {
+			l.Debug("Previous run of sync job %d is not finished, skipping.", job.ID)
+			return
+		}
+	}
+
+	owner, err := dep.UserClient().GetLoginUserByID(ctx, job.UserID)
+	if err != nil {
+		// Owner is not active, check later.
+		l.Debug("Skip sync job %d of inactive user %d: %s", job.ID, job.UserID, err)
+		return
+	}
+
+	ctx = context.WithValue(ctx, inventory.UserCtx{}, owner)
+	t, err := NewFolderSyncTask(ctx, owner, job)
+	if err != nil {
+		l.Warning("Failed to create task for sync job %d: %s", job.ID, err)
+		return
+	}
+
+	if err := dep.IoIntenseQueue(ctx).QueueTask(ctx, t); err != nil {
+		l.Warning("Failed to queue task for sync job %d: %s", job.ID, err)
+		return
+	}
+
+	nextRunAt := time.Now().Add(time.Duration(job.Interval) * time.Minute)
+	if err := dep.SyncJobClient().MarkQueued(ctx, job.ID, t.ID(), nextRunAt); err != nil {
+		l.Warning("Failed to update sync job %d: %s", job.ID, err)
+	}
+}
@@ -0,0 +1,661 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/transcode"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+)
+
+type (
+	TranscodeTask struct {
+		*queue.DBTask
+
+		l        logging.Logger
+		state    *TranscodeTaskState
+		progress queue.Progresses
+		node     cluster.Node
+	}
+
+	TranscodeTaskPhase string
+
+	TranscodeTaskState struct {
+		Uri    string `json:"uri,omitempty"`
+		Dst    string `json:"dst,omitempty"`
+		Preset string `json:"preset,omitempty"`
+		// TempPath and Outputs are used when transcoding on master.
+		TempPath             string                   `json:"temp_path,omitempty"`
+		Outputs              []string                 `json:"outputs,omitempty"`
+		Phase                TranscodeTaskPhase       `json:"phase,omitempty"`
+		SlaveTranscodeTaskID int                      `json:"slave_transcode_task_id,omitempty"`
+		SlaveUploadTaskID    int                      `json:"slave_upload_task_id,omitempty"`
+		SlaveTranscodeState  *SlaveTranscodeTaskState `json:"slave_transcode_state,omitempty"`
+		NodeState            `json:",inline"`
+	}
+)
+
+const (
+	TranscodeTaskPhaseNotStarted            TranscodeTaskPhase = ""
+	TranscodeTaskPhaseUploadOutputs         TranscodeTaskPhase = "upload_outputs"
+	TranscodeTaskPhaseAwaitSlaveTranscoding TranscodeTaskPhase = "await_slave_transcoding"
+	TranscodeTaskPhaseAwaitSlaveUploading   TranscodeTaskPhase = "await_slave_uploading"
+
+	ProgressTypeTranscode = "transcode"
+
+	// transcodeSourceUrlTTL is how long the source URL used by ffmpeg is valid. Inputs are read
+	// progressively, so the URL must outlive the whole transcoding.
+	transcodeSourceUrlTTL = 24 * time.Hour
+)
+
+func init() {
+	queue.RegisterResumableTaskFactory(queue.TranscodeTaskType, NewTranscodeTaskFromModel)
+}
+
+// NewTranscodeTask creates a new TranscodeTask. Renditions are saved in a folder under dst named
+// after the source file and preset.
+func NewTranscodeTask(ctx context.Context, src *fs.URI, dst *fs.URI, preset string) (queue.Task, error) {
+	state := &TranscodeTaskState{
+		Uri:       src.String(),
+		Dst:       dst.Join(RenditionFolderName(src.Name(), preset)).String(),
+		Preset:    preset,
+		NodeState: NodeState{},
+	}
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	t := &TranscodeTask{
+		DBTask: &queue.DBTask{
+			Task: &ent.Task{
+				Type:          queue.TranscodeTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  string(stateBytes),
+				PublicState:   &types.TaskPublicState{},
+			},
+			DirectOwner: inventory.UserFromContext(ctx),
+		},
+	}
+	return t, nil
+}
+
+func NewTranscodeTaskFromModel(task *ent.Task) queue.Task {
+	return &TranscodeTask{
+		DBTask: &queue.DBTask{
+			Task: task,
+		},
+	}
+}
+
+// RenditionFolderName returns the name of folder that renditions of a file are saved in.
+func RenditionFolderName(name, preset string) string {
+	return fmt.Sprintf("%s_%s", strings.TrimSuffix(name, filepath.Ext(name)), preset)
+}
+
+func (m *TranscodeTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	m.l = dep.Logger()
+
+	m.Lock()
+	if m.progress == nil {
+		m.progress = make(queue.Progresses)
+	}
+	m.Unlock()
+
+	// unmarshal state
+	state := &TranscodeTaskState{}
+	if err := json.Unmarshal([]byte(m.State()), state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+	m.state = state
+
+	// select node
+	node, reassigned, err := allocateNode(ctx, dep, &m.state.NodeState, types.NodeCapabilityTranscode)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to allocate node: %w", err)
+	}
+	m.node = node
+	if reassigned {
+		m.resetNodeWork()
+	}
+
+	next := task.StatusCompleted
+	if node.IsMaster() {
+		switch m.state.Phase {
+		case TranscodeTaskPhaseNotStarted:
+			next, err = m.masterTranscode(ctx, dep)
+		case TranscodeTaskPhaseUploadOutputs:
+			next, err = m.masterUploadOutputs(ctx, dep)
+		default:
+			next, err = task.StatusError, fmt.Errorf("unknown phase %q: %w", m.state.Phase, queue.CriticalErr)
+		}
+	} else {
+		switch m.state.Phase {
+		case TranscodeTaskPhaseNotStarted:
+			next, err = m.createSlaveTranscodeTask(ctx, dep)
+		case TranscodeTaskPhaseAwaitSlaveTranscoding:
+			next, err = m.awaitSlaveTranscoding(ctx, dep)
+		case TranscodeTaskPhaseAwaitSlaveUploading:
+			next, err = m.createAndAwaitSlaveUploading(ctx, dep)
+		default:
+			next, err = task.StatusError, fmt.Errorf("unknown phase %q: %w", m.state.Phase, queue.CriticalErr)
+		}
+	}
+
+	newStateStr, marshalErr := json.Marshal(m.state)
+	if marshalErr != nil {
+		return task.StatusError, fmt.Errorf("failed to marshal state: %w", marshalErr)
+	}
+
+	m.Lock()
+	m.Task.PrivateState = string(newStateStr)
+	m.Unlock()
+	return next, err
+}
+
+func (m *TranscodeTask) Cleanup(ctx context.Context) error {
+	if m.state.SlaveTranscodeState != nil && m.state.SlaveTranscodeState.TempPath != "" && m.node != nil {
+		if err := m.node.CleanupFolders(context.Background(), m.state.SlaveTranscodeState.TempPath); err != nil {
+			m.l.Warning("Failed to cleanup slave temp folder %s: %s", m.state.SlaveTranscodeState.TempPath, err)
+		}
+	}
+
+	if m.state.TempPath != "" {
+		time.Sleep(time.Duration(1) * time.Second)
+		return os.RemoveAll(m.state.TempPath)
+	}
+
+	return nil
+}
+
+// resetNodeWork discards work done on the previous node, so that the video is transcoded from the
+// beginning on the newly allocated node.
+func (m *TranscodeTask) resetNodeWork() {
+	m.state.Phase = TranscodeTaskPhaseNotStarted
+	m.state.SlaveTranscodeTaskID = 0
+	m.state.SlaveUploadTaskID = 0
+	m.state.SlaveTranscodeState = nil
+}
+
+// source returns entity source of the video to transcode after validating its size and extension.
+func (m *TranscodeTask) source(ctx context.Context, dep dependency.Dep) (entitysource.EntitySource, error) {
+	uri, err := fs.NewUriFromString(m.state.Uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse src uri: %s (%w)", err, queue.CriticalErr)
+	}
+
+	fm := manager.NewFileManager(dep, inventory.UserFromContext(ctx))
+	file, err := fm.Get(ctx, uri, dbfs.WithFileEntities(), dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityDownloadFile), dbfs.WithNotRoot())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source file: %s (%w)", err, queue.CriticalErr)
+	}
+
+	settings := dep.SettingProvider().Transcode(ctx)
+	if settings.MaxSize > 0 && file.Size() > settings.MaxSize {
+		return nil, fmt.Errorf("file size %d exceeds the limit %d (%w)", file.Size(), settings.MaxSize, queue.CriticalErr)
+	}
+
+	if !util.IsInExtensionList(settings.Exts, file.DisplayName()) {
+		return nil, fmt.Errorf("file extension not supported (%w)", queue.CriticalErr)
+	}
+
+	es, err := fm.GetEntitySource(ctx, 0, fs.WithEntity(file.PrimaryEntity()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entity source: %w", err)
+	}
+
+	return es, nil
+}
+
+func (m *TranscodeTask) masterTranscode(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	es, err := m.source(ctx, dep)
+	if err != nil {
+		return task.StatusError, err
+	}
+
+	defer es.Close()
+
+	input := ""
+	if es.IsLocal() {
+		input = es.LocalPath(ctx)
+	} else {
+		expire := time.Now().Add(transcodeSourceUrlTTL)
+		src, err := es.Url(driver.WithForcePublicEndpoint(ctx, false), entitysource.WithNoInternalProxy(),
+			entitysource.WithContext(ctx), entitysource.WithExpire(&expire))
+		if err != nil {
+			return task.StatusError, fmt.Errorf("failed to get entity url: %w", err)
+		}
+
+		input = src.Url
+	}
+
+	tempPath, err := prepareTempFolder(ctx, dep, m)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to prepare temp folder: %w", err)
+	}
+	m.state.TempPath = tempPath
+
+	m.Lock()
+	m.progress[ProgressTypeTranscode] = &queue.Progress{}
+	m.Unlock()
+
+	m.l.Info("Transcoding %q with preset %q...", m.state.Uri, m.state.Preset)
+	opts := transcodeOptions(ctx, dep.SettingProvider(), m.node, m.state.Preset)
+	outputs, err := transcode.Run(ctx, opts, input, tempPath, transcodeProgressFunc(m.progress[ProgressTypeTranscode]))
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to transcode: %w", err)
+	}
+
+	m.state.Outputs = outputs
+	m.state.Phase = TranscodeTaskPhaseUploadOutputs
+	m.ResumeAfter(0)
+	return task.StatusSuspending, nil
+}
+
+func (m *TranscodeTask) masterUploadOutputs(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	dst, err := fs.NewUriFromString(m.state.Dst)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to parse dst uri %q: %s (%w)", m.state.Dst, err, queue.CriticalErr)
+	}
+
+	fm := manager.NewFileManager(dep, inventory.UserFromContext(ctx))
+	m.Lock()
+	delete(m.progress, ProgressTypeTranscode)
+	m.progress[ProgressTypeUploadCount] = &queue.Progress{Total: int64(len(m.state.Outputs))}
+	m.Unlock()
+
+	for _, name := range m.state.Outputs {
+		if err := m.uploadOutput(ctx, fm, dst.Join(name), filepath.Join(m.state.TempPath, name)); err != nil {
+			return task.StatusError, fmt.Errorf("failed to upload %q: %w", name, err)
+		}
+
+		atomic.AddInt64(&m.progress[ProgressTypeUploadCount].Current, 1)
+	}
+
+	return task.StatusCompleted, nil
+}
+
+func (m *TranscodeTask) uploadOutput(ctx context.Context, fm manager.FileManager, dst *fs.URI, src string) error {
+	file, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open output: %w", err)
+	}
+	defer file.Close()
+
+	fi, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to get file info: %w", err)
+	}
+
+	_, err = fm.Update(ctx, &fs.UploadRequest{
+		Props: &fs.UploadProps{
+			Uri:  dst,
+			Size: fi.Size(),
+		},
+		File:   file,
+		Seeker: file,
+	})
+	return err
+}
+
+func (m *TranscodeTask) createSlaveTranscodeTask(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	es, err := m.source(ctx, dep)
+	if err != nil {
+		return task.StatusError, err
+	}
+
+	defer es.Close()
+
+	// Slave node reads the video from a signed URL, internal proxy of local files is allowed.
+	expire := time.Now().Add(transcodeSourceUrlTTL)
+	src, err := es.Url(ctx, entitysource.WithContext(ctx), entitysource.WithExpire(&expire))
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to get entity url: %w", err)
+	}
+
+	payload := &SlaveTranscodeTaskState{
+		Src:     src.Url,
+		Options: transcodeOptions(ctx, dep.SettingProvider(), m.node, m.state.Preset),
+	}
+	payloadStr, err := json.Marshal(payload)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	taskId, err := m.node.CreateTask(ctx, queue.SlaveTranscodeTaskType, string(payloadStr))
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to create slave task: %w", err)
+	}
+
+	m.state.NodeState.renewLease(ctx, dep, m.node)
+	m.state.Phase = TranscodeTaskPhaseAwaitSlaveTranscoding
+	m.state.SlaveTranscodeTaskID = taskId
+	m.ResumeAfter(0)
+	return task.StatusSuspending, nil
+}
+
+// watchSlaveTask follows progress of slave task until it finishes or the check window ends, then
+// returns its latest summary.
+func (m *TranscodeTask) watchSlaveTask(ctx context.Context, dep dependency.Dep, id int, clearOnComplete bool) (*cluster.SlaveTaskSummary, error) {
+	watchCtx, cancel := context.WithTimeout(ctx, slaveUploadCheckInterval)
+	err := m.node.WatchTask(watchCtx, id, slaveUploadWatchInterval, func(summary *cluster.SlaveTaskSummary) error {
+		m.Lock()
+		m.state.NodeState.progress = summary.Progress
+		m.Unlock()
+		return nil
+	})
+	cancel()
+	if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+		m.l.Warning("Failed to watch slave task %d: %s", id, err)
+	}
+
+	t, err := m.node.GetTask(ctx, id, clearOnComplete)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get slave task: %w", err)
+	}
+
+	m.state.NodeState.renewLease(ctx, dep, m.node)
+	m.Lock()
+	m.state.NodeState.progress = t.Progress
+	m.Unlock()
+	return t, nil
+}
+
+// slaveTaskErr returns error if the slave task is failed or canceled.
+func slaveTaskErr(t *cluster.SlaveTaskSummary) error {
+	if t.Status == task.StatusError {
+		return fmt.Errorf("slave task failed: %s (%w)", t.Error, queue.CriticalErr)
+	}
+
+	if t.Status == task.StatusCanceled {
+		return fmt.Errorf("slave task canceled (%w)", queue.CriticalErr)
+	}
+
+	return nil
+}
+
+func (m *TranscodeTask) awaitSlaveTranscoding(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	t, err := m.watchSlaveTask(ctx, dep, m.state.SlaveTranscodeTaskID, false)
+	if err != nil {
+		return m.state.NodeState.handleNodeErr(ctx, dep, m, err, m.resetNodeWork)
+	}
+
+	if err := slaveTaskErr(t); err != nil {
+		return task.StatusError, err
+	}
+
+	m.state.SlaveTranscodeState = &SlaveTranscodeTaskState{}
+	if err := json.Unmarshal([]byte(t.PrivateState), m.state.SlaveTranscodeState); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal slave transcode state: %s (%w)", err, queue.CriticalErr)
+	}
+
+	if t.Status == task.StatusCompleted {
+		m.state.Phase = TranscodeTaskPhaseAwaitSlaveUploading
+		m.ResumeAfter(0)
+		return task.StatusSuspending, nil
+	}
+
+	m.l.Info("Slave task %d is still transcoding, resume after 30s.", m.state.SlaveTranscodeTaskID)
+	m.ResumeAfter(slaveUploadCheckInterval)
+	return task.StatusSuspending, nil
+}
+
+func (m *TranscodeTask) createAndAwaitSlaveUploading(ctx context.Context, dep dependency.Dep) (task.Status, error) {
+	if m.state.SlaveUploadTaskID == 0 {
+		dst, err := fs.NewUriFromString(m.state.Dst)
+		if err != nil {
+			return task.StatusError, fmt.Errorf("failed to parse dst uri %q: %s (%w)", m.state.Dst, err, queue.CriticalErr)
+		}
+
+		payload := &SlaveUploadTaskState{
+			Files:       make([]SlaveUploadEntity, 0, len(m.state.SlaveTranscodeState.Outputs)),
+			MaxParallel: dep.SettingProvider().MaxParallelTransfer(ctx),
+			UserID:      inventory.UserFromContext(ctx).ID,
+		}
+		for i, output := range m.state.SlaveTranscodeState.Outputs {
+			payload.Files = append(payload.Files, SlaveUploadEntity{
+				Uri:   dst.Join(output.Name),
+				Src:   output.Path,
+				Size:  output.Size,
+				Index: i,
+			})
+		}
+
+		payloadStr, err := json.Marshal(payload)
+		if err != nil {
+			return task.StatusError, fmt.Errorf("failed to marshal payload: %w", err)
+		}
+
+		taskId, err := m.node.CreateTask(ctx, queue.SlaveUploadTaskType, string(payloadStr))
+		if err != nil {
+			return task.StatusError, fmt.Errorf("failed to create slave task: %w", err)
+		}
+
+		m.state.NodeState.renewLease(ctx, dep, m.node)
+		m.state.NodeState.progress = nil
+		m.state.SlaveUploadTaskID = taskId
+		m.ResumeAfter(0)
+		return task.StatusSuspending, nil
+	}
+
+	t, err := m.watchSlaveTask(ctx, dep, m.state.SlaveUploadTaskID, true)
+	if err != nil {
+		return m.state.NodeState.handleNodeErr(ctx, dep, m, err, m.resetNodeWork)
+	}
+
+	if err := slaveTaskErr(t); err != nil {
+		return task.StatusError, err
+	}
+
+	if t.Status == task.StatusCompleted {
+		return task.StatusCompleted, nil
+	}
+
+	m.l.Info("Slave task %d is still uploading, resume after 30s.", m.state.SlaveUploadTaskID)
+	m.ResumeAfter(slaveUploadCheckInterval)
+	return task.StatusSuspending, nil
+}
+
+func (m *TranscodeTask) Progress(ctx context.Context) queue.Progresses {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.state.NodeState.progress != nil {
+		merged := make(queue.Progresses)
+		for k, v := range m.progress {
+			merged[k] = v
+		}
+
+		for k, v := range m.state.NodeState.progress {
+			merged[k] = v
+		}
+
+		return merged
+	}
+	return m.progress
+}
+
+func (m *TranscodeTask) Summarize(hasher hashid.Encoder) *queue.Summary {
+	if m.state == nil {
+		if err := json.Unmarshal([]byte(m.State()), &m.state); err != nil {
+			return nil
+		}
+	}
+
+	return &queue.Summary{
+		NodeID: m.state.NodeID,
+		Phase:  string(m.state.Phase),
+		Props: map[string]any{
+			SummaryKeySrc: m.state.Uri,
+			SummaryKeyDst: m.state.Dst,
+		},
+	}
+}
+
+// transcodeOptions returns transcode options of preset using site settings, overridden by settings of node.
+func transcodeOptions(ctx context.Context, settings setting.Provider, node cluster.Node, preset string) *transcode.Options {
+	s := settings.Transcode(ctx)
+	opts := &transcode.Options{
+		FFMpegPath:     s.FFMpegPath,
+		HWAccel:        s.HWAccel,
+		VideoEncoder:   s.VideoEncoder,
+		Preset:         preset,
+		Height:         s.Height,
+		SegmentSeconds: s.SegmentSeconds,
+	}
+
+	nodeSettings := node.Settings(ctx)
+	if nodeSettings == nil || nodeSettings.Transcode == nil {
+		return opts
+	}
+
+	if nodeSettings.Transcode.FFMpegPath != "" {
+		opts.FFMpegPath = nodeSettings.Transcode.FFMpegPath
+	}
+	if nodeSettings.Transcode.HWAccel != "" {
+		opts.HWAccel = nodeSettings.Transcode.HWAccel
+	}
+	if nodeSettings.Transcode.VideoEncoder != "" {
+		opts.VideoEncoder = nodeSettings.Transcode.VideoEncoder
+	}
+
+	return opts
+}
+
+// transcodeProgressFunc reports transcoded duration of video in milliseconds to p.
+func transcodeProgressFunc(p *queue.Progress) transcode.ProgressFunc {
+	return func(current, total time.Duration) {
+		atomic.StoreInt64(&p.Current, current.Milliseconds())
+		atomic.StoreInt64(&p.Total, total.Milliseconds())
+	}
+}
+
+type (
+	SlaveTranscodeOutput struct {
+		Name string `json:"name"`
+		Path string `json:"path"`
+		Size int64  `json:"size"`
+	}
+	SlaveTranscodeTaskState struct {
+		Src      string                 `json:"src,omitempty"`
+		Options  *transcode.Options     `json:"options,omitempty"`
+		TempPath string                 `json:"temp_path"`
+		Outputs  []SlaveTranscodeOutput `json:"outputs"`
+	}
+	SlaveTranscodeTask struct {
+		*queue.InMemoryTask
+
+		progress queue.Progresses
+		l        logging.Logger
+		state    *SlaveTranscodeTaskState
+	}
+)
+
+// NewSlaveTranscodeTask creates a new SlaveTranscodeTask from raw private state
+func NewSlaveTranscodeTask(ctx context.Context, props *types.SlaveTaskProps, id int, state string) queue.Task {
+	return &SlaveTranscodeTask{
+		InMemoryTask: &queue.InMemoryTask{
+			DBTask: &queue.DBTask{
+				Task: &ent.Task{
+					ID:            id,
+					CorrelationID: logging.CorrelationID(ctx),
+					PublicState: &types.TaskPublicState{
+						SlaveTaskProps: props,
+					},
+					PrivateState: state,
+				},
+			},
+		},
+
+		progress: make(queue.Progresses),
+	}
+}
+
+func (t *SlaveTranscodeTask) Do(ctx context.Context) (task.Status, error) {
+	ctx = prepareSlaveTaskCtx(ctx, t.Model().PublicState.SlaveTaskProps)
+	dep := dependency.FromContext(ctx)
+	t.l = dep.Logger()
+
+	// unmarshal state
+	state := &SlaveTranscodeTaskState{}
+	if err := json.Unmarshal([]byte(t.State()), state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+
+	t.state = state
+	if t.state.Options == nil {
+		return task.StatusError, fmt.Errorf("transcode options not specified (%w)", queue.CriticalErr)
+	}
+
+	tempPath, err := prepareTempFolder(ctx, dep, t)
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to prepare temp folder: %w", err)
+	}
+	t.state.TempPath = tempPath
+
+	t.Lock()
+	t.progress[ProgressTypeTranscode] = &queue.Progress{}
+	t.Unlock()
+
+	outputs, err := transcode.Run(ctx, t.state.Options, t.state.Src, tempPath, transcodeProgressFunc(t.progress[ProgressTypeTranscode]))
+	if err != nil {
+		return task.StatusError, fmt.Errorf("failed to transcode: %w", err)
+	}
+
+	t.state.Outputs = make([]SlaveTranscodeOutput, 0, len(outputs))
+	for _, name := range outputs {
+		outputPath := filepath.Join(tempPath, name)
+		stat, err := os.Stat(outputPath)
+		if err != nil {
+			return task.StatusError, fmt.Errorf("failed to get output info: %w", err)
+		}
+
+		t.state.Outputs = append(t.state.Outputs, SlaveTranscodeOutput{
+			Name: name,
+			Path: outputPath,
+			Size: stat.Size(),
+		})
+	}
+
+	// Clear source URL, it is no longer needed.
+	t.state.Src = ""
+	newStateStr, marshalErr := json.Marshal(t.state)
+	if marshalErr != nil {
+		return task.StatusError, fmt.Errorf("failed to marshal state: %w", marshalErr)
+	}
+
+	t.Lock()
+	t.Task.PrivateState = string(newStateStr)
+	t.Unlock()
+	return task.StatusCompleted, nil
+}
+
+func (t *SlaveTranscodeTask) Progress(ctx context.Context) queue.Progresses {
+	t.Lock()
+	defer t.Unlock()
+
+	return t.progress
+}
@@ -0,0 +1,392 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/samber/lo"
+)
+
+type (
+	// UrlImportTask downloads a list of URLs into a folder over HTTP, status of each URL is kept so that
+	// a report can be downloaded once finished.
+	UrlImportTask struct {
+		*queue.DBTask
+
+		l        logging.Logger
+		state    *UrlImportTaskState
+		progress queue.Progresses
+	}
+	UrlImportTaskState struct {
+		Dst         string           `json:"dst"`
+		Concurrency int              `json:"concurrency"`
+		Items       []*UrlImportItem `json:"items"`
+	}
+	UrlImportItem struct {
+		Url    string              `json:"url"`
+		Status UrlImportItemStatus `json:"status"`
+		// Uri of the imported file.
+		Uri   string `json:"uri,omitempty"`
+		Size  int64  `json:"size,omitempty"`
+		Error string `json:"error,omitempty"`
+	}
+	UrlImportItemStatus string
+)
+
+const (
+	UrlImportItemPending  = UrlImportItemStatus("pending")
+	UrlImportItemImported = UrlImportItemStatus("imported")
+	UrlImportItemSkipped  = UrlImportItemStatus("skipped")
+	UrlImportItemFailed   = UrlImportItemStatus("failed")
+
+	SummaryKeyUrlImportTotal    = "total"
+	SummaryKeyUrlImportImported = "imported"
+	SummaryKeyUrlImportSkipped  = "skipped"
+
+	urlImportDefaultName = "download"
+)
+
+// publicTransport is used to fetch user supplied URLs of URL imports and RSS feeds, so that they cannot
+// reach services on internal networks.
+var publicTransport = request.NewPublicTransport()
+
+func init() {
+	queue.RegisterResumableTaskFactory(queue.UrlImportTaskType, NewUrlImportTaskFromModel)
+}
+
+// NewUrlImportTask creates a task importing urls into dst, at most concurrency URLs are downloaded in
+// parallel.
+func NewUrlImportTask(ctx context.Context, u *ent.User, urls []string, dst string, concurrency int) (queue.Task, error) {
+	state := &UrlImportTaskState{
+		Dst:         dst,
+		Concurrency: concurrency,
+		Items: lo.Map(urls, func(u string, index int) *UrlImportItem {
+			return &UrlImportItem{Url: u, Status: UrlImportItemPending}
+		}),
+	}
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal state: %w", err)
+	}
+
+	return &UrlImportTask{
+		DBTask: &queue.DBTask{
+			Task: &ent.Task{
+				Type:          queue.UrlImportTaskType,
+				CorrelationID: logging.CorrelationID(ctx),
+				PrivateState:  string(stateBytes),
+				PublicState:   &types.TaskPublicState{},
+			},
+			DirectOwner: u,
+		},
+	}, nil
+}
+
+func NewUrlImportTaskFromModel(task *ent.Task) queue.Task {
+	return &UrlImportTask{
+		DBTask: &queue.DBTask{
+			Task: task,
+		},
+	}
+}
+
+// ParseUrlList returns URLs listed in a manifest, one per line. Blank lines and lines starting with # are
+// ignored.
+func ParseUrlList(manifest string) []string {
+	return lo.FilterMap(strings.Split(manifest, "\n"), func(line string, index int) (string, bool) {
+		line = strings.TrimSpace(line)
+		return line, line != "" && !strings.HasPrefix(line, "#")
+	})
+}
+
+// ValidateImportUrl returns error if u is not an absolute HTTP(S) URL.
+func ValidateImportUrl(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("unsupported url %q", u)
+	}
+
+	return nil
+}
+
+func (m *UrlImportTask) Do(ctx context.Context) (task.Status, error) {
+	dep := dependency.FromContext(ctx)
+	m.l = dep.Logger()
+
+	state := &UrlImportTaskState{}
+	if err := json.Unmarshal([]byte(m.State()), state); err != nil {
+		return task.StatusError, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+
+	m.Lock()
+	m.state = state
+	m.progress = queue.Progresses{
+		ProgressTypeImported: &queue.Progress{Total: int64(len(state.Items))},
+	}
+	m.Unlock()
+
+	err := m.importAll(ctx, dep)
+
+	m.Lock()
+	newStateStr, marshalErr := json.Marshal(m.state)
+	if marshalErr == nil {
+		m.Task.PrivateState = string(newStateStr)
+	}
+	m.Unlock()
+
+	if marshalErr != nil {
+		return task.StatusError, fmt.Errorf("failed to marshal state: %w", marshalErr)
+	}
+
+	if err != nil {
+		return task.StatusError, err
+	}
+
+	return task.StatusCompleted, nil
+}
+
+func (m *UrlImportTask) importAll(ctx context.Context, dep dependency.Dep) error {
+	user := inventory.UserFromContext(ctx)
+	fm := manager.NewFileManager(dep, user)
+	defer fm.Recycle()
+
+	dst, err := fs.NewUriFromString(m.state.Dst)
+	if err != nil {
+		return fmt.Errorf("failed to parse dst: %s (%w)", err, queue.CriticalErr)
+	}
+
+	if _, err := fm.Create(ctx, dst, types.FileTypeFolder); err != nil {
+		return fmt.Errorf("failed to create destination folder: %w", err)
+	}
+
+	tempPath, err := prepareTempFolder(ctx, dep, m)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.RemoveAll(tempPath); err != nil {
+			m.l.Warning("Failed to remove temp folder %q: %s", tempPath, err)
+		}
+	}()
+
+	client := dep.RequestClient(request.WithLogger(m.l), request.WithTransport(publicTransport))
+	worker := make(chan struct{}, max(m.state.Concurrency, 1))
+	wg := sync.WaitGroup{}
+	for i, item := range m.state.Items {
+		if item.Status != UrlImportItemPending {
+			// Finished in a previous run.
+			atomic.AddInt64(&m.progress[ProgressTypeImported].Current, 1)
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return ctx.Err()
+		case worker <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int, item *UrlImportItem) {
+			defer func() {
+				atomic.AddInt64(&m.progress[ProgressTypeImported].Current, 1)
+				<-worker
+				wg.Done()
+			}()
+
+			dstFile, size, err := m.importOne(ctx, fm, client, item.Url, dst, filepath.Join(tempPath, strconv.Itoa(i)))
+			m.Lock()
+			defer m.Unlock()
+
+			item.Size = size
+			if dstFile != nil {
+				item.Uri = dstFile.String()
+			}
+
+			switch {
+			case err == nil:
+				item.Status = UrlImportItemImported
+			case errors.Is(err, fs.ErrFileExisted):
+				item.Status = UrlImportItemSkipped
+				item.Error = "file already exists"
+			default:
+				m.l.Warning("Failed to import %q: %s", item.Url, err)
+				item.Status = UrlImportItemFailed
+				item.Error = err.Error()
+			}
+		}(i, item)
+	}
+
+	wg.Wait()
+	return nil
+}
+
+// importOne downloads the URL into a temp file, then uploads it into dst folder.
+func (m *UrlImportTask) importOne(ctx context.Context, fm manager.FileManager, client request.Client, u string,
+	dst *fs.URI, tempFile string) (*fs.URI, int64, error) {
+	res := client.Request(http.MethodGet, u, nil, request.WithContext(ctx)).CheckHTTPResponse(http.StatusOK)
+	if res.Err != nil {
+		return nil, 0, fmt.Errorf("failed to request url: %w", res.Err)
+	}
+	defer res.Response.Body.Close()
+
+	target := dst.JoinRaw(UrlImportFileName(res.Response.Header.Get("Content-Disposition"), u))
+	if res.Response.ContentLength >= 0 {
+		// Reject early before downloading files not allowed by storage policy.
+		if err := fm.PreValidateUpload(ctx, dst, fs.PreValidateFile{
+			Name: target.Name(),
+			Size: res.Response.ContentLength,
+		}); err != nil {
+			return target, res.Response.ContentLength, err
+		}
+	}
+
+	temp, err := os.Create(tempFile)
+	if err != nil {
+		return target, 0, fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer func() {
+		temp.Close()
+		os.Remove(tempFile)
+	}()
+
+	size, err := io.Copy(temp, res.Response.Body)
+	if err != nil {
+		return target, size, fmt.Errorf("failed to download: %w", err)
+	}
+
+	if _, err := temp.Seek(0, io.SeekStart); err != nil {
+		return target, size, fmt.Errorf("failed to seek temp file: %w", err)
+	}
+
+	_, err = fm.Update(ctx, &fs.UploadRequest{
+		Props: &fs.UploadProps{
+			Uri:  target,
+			Size: size,
+		},
+		File:   temp,
+		Seeker: temp,
+	}, fs.WithNoEntityType())
+	if err != nil {
+		var appErr serializer.AppError
+		if errors.As(err, &appErr) && appErr.Code == serializer.CodeObjectExist {
+			return target, size, fs.ErrFileExisted
+		}
+
+		return target, size, fmt.Errorf("failed to upload: %w", err)
+	}
+
+	return target, size, nil
+}
+
+// UrlImportFileName returns name of the file imported from u, the name in Content-Disposition header is
+// preferred over the last segment of URL path.
+func UrlImportFileName(contentDisposition, u string) string {
+	name := ""
+	if _, params, err := mime.ParseMediaType(contentDisposition); err == nil {
+		name = params["filename"]
+	}
+
+	if name == "" {
+		if parsed, err := url.Parse(u); err == nil {
+			name = path.Base(parsed.Path)
+		}
+	}
+
+	name = strings.TrimSpace(strings.NewReplacer("/", "_", "\\", "_").Replace(name))
+	if name == "" || name == "." || name == ".." {
+		return urlImportDefaultName
+	}
+
+	return name
+}
+
+// Items returns a copy of status of all URLs.
+func (m *UrlImportTask) Items() ([]UrlImportItem, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	state, err := m.currentState()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Map(state.Items, func(item *UrlImportItem, index int) UrlImportItem {
+		return *item
+	}), nil
+}
+
+// currentState returns state of running task, or the persisted one if not running. Caller must hold the lock.
+func (m *UrlImportTask) currentState() (*UrlImportTaskState, error) {
+	if m.state != nil {
+		return m.state, nil
+	}
+
+	state := &UrlImportTaskState{}
+	if err := json.Unmarshal([]byte(m.Task.PrivateState), state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal state: %w", err)
+	}
+
+	return state, nil
+}
+
+func (m *UrlImportTask) Progress(ctx context.Context) queue.Progresses {
+	m.Lock()
+	defer m.Unlock()
+	return m.progress
+}
+
+func (m *UrlImportTask) Summarize(hasher hashid.Encoder) *queue.Summary {
+	m.Lock()
+	defer m.Unlock()
+
+	state, err := m.currentState()
+	if err != nil {
+		return nil
+	}
+
+	count := func(status UrlImportItemStatus) int {
+		return lo.CountBy(state.Items, func(item *UrlImportItem) bool {
+			return item.Status == status
+		})
+	}
+
+	return &queue.Summary{
+		Props: map[string]any{
+			SummaryKeyDst:               state.Dst,
+			SummaryKeyUrlImportTotal:    len(state.Items),
+			SummaryKeyUrlImportImported: count(UrlImportItemImported),
+			SummaryKeyUrlImportSkipped:  count(UrlImportItemSkipped),
+			SummaryKeyFailed:            count(UrlImportItemFailed),
+		},
+	}
+}
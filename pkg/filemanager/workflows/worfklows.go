@@ -2,43 +2,139 @@ package workflows
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path"
 	"strconv"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 )
 
 const (
 	TaskTempPath                 = "fm_workflows"
 	slaveProgressRefreshInterval = 5 * time.Second
+	// nodeRetryInterval is the interval to retry contacting the node that owns the task.
+	nodeRetryInterval = 30 * time.Second
 )
 
 type NodeState struct {
 	NodeID int `json:"node_id"`
+	// LeaseExpires is when the node loses ownership of the task if it is not heard from. Zero for
+	// master node.
+	LeaseExpires time.Time `json:"lease_expires"`
+	// Failovers is the number of times the task is reassigned to another node.
+	Failovers int `json:"failovers,omitempty"`
 
 	progress queue.Progresses
 }
 
-// allocateNode allocates a node for the task.
-func allocateNode(ctx context.Context, dep dependency.Dep, state *NodeState, capability types.NodeCapability) (cluster.Node, error) {
+// allocateNode allocates a node for the task. If the node that owns the task is offline or its lease
+// has expired, the task is reassigned to another capable node and `reassigned` is true, callers should
+// then restart work done on the previous node.
+func allocateNode(ctx context.Context, dep dependency.Dep, state *NodeState, capability types.NodeCapability) (cluster.Node, bool, error) {
 	np, err := dep.NodePool(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get node pool: %w", err)
+		return nil, false, fmt.Errorf("failed to get node pool: %w", err)
 	}
 
-	node, err := np.Get(ctx, capability, state.NodeID)
+	owner := state.NodeID
+	node, err := np.Get(ctx, capability, owner)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get node: %w", err)
+		return nil, false, fmt.Errorf("failed to get node: %w", err)
+	}
+
+	reassigned := false
+	if owner > 0 {
+		if node.ID() != owner {
+			// Owner is marked as offline by heartbeat check.
+			reassigned = true
+		} else if state.leaseExpired(ctx, dep, time.Now()) {
+			reassigned = true
+			// Prefer another node, fallback to the owner if it's the only one capable.
+			if another, err := np.Get(ctx, capability, 0, owner); err == nil {
+				node = another
+			}
+		}
+	}
+
+	if reassigned {
+		if err := state.failover(ctx, dep); err != nil {
+			return nil, false, err
+		}
+
+		dep.Logger().Warning("Node %d lost ownership of the task, reassigned to node %q.", owner, node.Name())
 	}
 
 	state.NodeID = node.ID()
-	return node, nil
+	if reassigned || owner == 0 {
+		state.renewLease(ctx, dep, node)
+	}
+
+	return node, reassigned, nil
+}
+
+// renewLease extends the ownership of the task by node, called whenever the node is heard from.
+func (s *NodeState) renewLease(ctx context.Context, dep dependency.Dep, node cluster.Node) {
+	if node.IsMaster() {
+		s.LeaseExpires = time.Time{}
+		return
+	}
+
+	s.LeaseExpires = time.Now().Add(dep.SettingProvider().NodeTaskFailover(ctx).Lease)
+}
+
+// leaseExpired returns whether the owner node is neither heard from by the task nor by heartbeats
+// within the lease.
+func (s *NodeState) leaseExpired(ctx context.Context, dep dependency.Dep, now time.Time) bool {
+	if s.LeaseExpires.IsZero() || now.Before(s.LeaseExpires) {
+		return false
+	}
+
+	// Task might be suspended longer than the lease, e.g. master restarted, a recent heartbeat
+	// indicates the node is still alive.
+	lease := dep.SettingProvider().NodeTaskFailover(ctx).Lease
+	status := cluster.GetNodeStatus(dep.KV(), s.NodeID)
+	return status == nil || !status.Online || now.Sub(status.LastSeen) > lease
+}
+
+// failover records a reassignment of the task, returns error if max failovers is reached.
+func (s *NodeState) failover(ctx context.Context, dep dependency.Dep) error {
+	maxFailovers := dep.SettingProvider().NodeTaskFailover(ctx).MaxFailovers
+	if s.Failovers >= maxFailovers {
+		return fmt.Errorf("node %d lost ownership of the task after %d failovers (%w)", s.NodeID, s.Failovers, queue.CriticalErr)
+	}
+
+	s.Failovers++
+	s.progress = nil
+	return nil
+}
+
+// handleNodeErr handles error of querying the task on its owner node. If the node has lost the task,
+// e.g. restarted, reset is called to restart the work on the node. Otherwise, the task is retried
+// later, until the lease expires and the task is reassigned to another node.
+func (s *NodeState) handleNodeErr(ctx context.Context, dep dependency.Dep, t queue.Task, err error, reset func()) (task.Status, error) {
+	var appErr serializer.AppError
+	if errors.As(err, &appErr) && appErr.Code == serializer.CodeNotFound {
+		if err := s.failover(ctx, dep); err != nil {
+			return task.StatusError, err
+		}
+
+		dep.Logger().Warning("Node %d lost the task, restart it: %s", s.NodeID, err)
+		reset()
+		t.ResumeAfter(0)
+		return task.StatusSuspending, nil
+	}
+
+	dep.Logger().Warning("Failed to query task on node %d: %s, retry after %s.", s.NodeID, err, nodeRetryInterval)
+	t.ResumeAfter(nodeRetryInterval)
+	return task.StatusSuspending, nil
 }
 
 // prepareSlaveTaskCtx prepares the context for the slave task.
@@ -0,0 +1,171 @@
+package grouppolicy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+)
+
+const usageKeyPrefix = "group_policy_usage_"
+
+// Request describes an action to be evaluated against group policy.
+type Request struct {
+	Action types.GroupPolicyAction
+	// Size bytes involved in the action, e.g. upload or download size.
+	Size int64
+	// MimeType of the uploaded file, only used for upload.
+	MimeType string
+}
+
+// Checker evaluates structured group policy of users.
+type Checker interface {
+	// Consume checks the action against policy of user's group and accounts it into usage windows
+	// atomically. An error is returned and nothing is accounted if the action is not allowed.
+	Consume(ctx context.Context, u *ent.User, req *Request) error
+	// Release gives back usage accounted by Consume, used if the action fails afterwards.
+	Release(ctx context.Context, u *ent.User, req *Request)
+}
+
+// NewChecker creates a Checker that keeps usage counters in given KV store.
+func NewChecker(kv cache.Driver) Checker {
+	return &checker{kv: kv}
+}
+
+type checker struct {
+	kv cache.Driver
+}
+
+// counter is a usage counter of a limit in current window.
+type counter struct {
+	key   string
+	delta int
+	max   int64
+	ttl   int
+	kind  string
+	reset time.Time
+	limit *types.GroupPolicyLimit
+}
+
+func (c *checker) Consume(ctx context.Context, u *ent.User, req *Request) error {
+	p := policyOf(u)
+	if p == nil {
+		return nil
+	}
+
+	if req.Action == types.GroupPolicyActionUpload {
+		if p.MaxFileSize > 0 && req.Size > p.MaxFileSize {
+			return serializer.NewError(serializer.CodeGroupPolicyViolation,
+				fmt.Sprintf("File size exceeds the limit of your group (%d bytes)", p.MaxFileSize), nil)
+		}
+
+		if len(p.AllowedMimeTypes) > 0 && !MatchMimeType(p.AllowedMimeTypes, req.MimeType) {
+			return serializer.NewError(serializer.CodeGroupPolicyViolation,
+				fmt.Sprintf("File type %q is not allowed for your group", req.MimeType), nil)
+		}
+	}
+
+	counters := counters(u.ID, p, req, time.Now())
+	for i, cnt := range counters {
+		value, err := c.kv.Incr(cnt.key, cnt.delta, cnt.ttl)
+		if err != nil {
+			c.release(counters[:i])
+			return fmt.Errorf("failed to account group policy usage: %w", err)
+		}
+
+		if int64(value) > cnt.max {
+			c.release(counters[:i+1])
+			return serializer.NewError(serializer.CodeGroupPolicyViolation,
+				fmt.Sprintf("Your group has reached the %s limit of %s, try again after %s", cnt.kind, cnt.limit.Action,
+					cnt.reset.UTC().Format(time.RFC3339)), nil)
+		}
+	}
+
+	return nil
+}
+
+func (c *checker) Release(ctx context.Context, u *ent.User, req *Request) {
+	p := policyOf(u)
+	if p == nil {
+		return
+	}
+
+	c.release(counters(u.ID, p, req, time.Now()))
+}
+
+func (c *checker) release(counters []counter) {
+	for _, cnt := range counters {
+		_, _ = c.kv.Incr(cnt.key, -cnt.delta, cnt.ttl)
+	}
+}
+
+// counters returns usage counters affected by the request. Windows are aligned to multiples of
+// their length, so that all nodes share the same counter key.
+func counters(uid int, p *types.GroupPolicy, req *Request, now time.Time) []counter {
+	var res []counter
+	for i := range p.Limits {
+		limit := &p.Limits[i]
+		if limit.Action != req.Action || limit.Window <= 0 {
+			continue
+		}
+
+		window := int64(limit.Window)
+		start := now.Unix() / window * window
+		reset := time.Unix(start+window, 0)
+		ttl := max(1, int(reset.Sub(now).Seconds())+1)
+		if limit.MaxCount > 0 {
+			res = append(res, counter{
+				key: usageKey(uid, limit, start, "count"), delta: 1, max: limit.MaxCount,
+				ttl: ttl, kind: "count", reset: reset, limit: limit,
+			})
+		}
+
+		if limit.MaxSize > 0 && req.Size > 0 {
+			res = append(res, counter{
+				key: usageKey(uid, limit, start, "size"), delta: int(req.Size), max: limit.MaxSize,
+				ttl: ttl, kind: "size", reset: reset, limit: limit,
+			})
+		}
+	}
+
+	return res
+}
+
+// MatchMimeType returns true if the MIME type matches any of the patterns. A pattern ending
+// with "/*" matches all subtypes.
+func MatchMimeType(patterns []string, mimeType string) bool {
+	mimeType = strings.ToLower(strings.TrimSpace(strings.Split(mimeType, ";")[0]))
+	if mimeType == "" {
+		return false
+	}
+
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		if pattern == "*" || pattern == "*/*" || pattern == mimeType {
+			return true
+		}
+
+		if prefix, ok := strings.CutSuffix(pattern, "/*"); ok && strings.HasPrefix(mimeType, prefix+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyOf(u *ent.User) *types.GroupPolicy {
+	if u == nil || u.Edges.Group == nil || u.Edges.Group.Settings == nil {
+		return nil
+	}
+
+	return u.Edges.Group.Settings.Policy
+}
+
+func usageKey(uid int, limit *types.GroupPolicyLimit, start int64, kind string) string {
+	return fmt.Sprintf("%s%d_%s_%d_%d_%s", usageKeyPrefix, uid, limit.Action, limit.Window, start, kind)
+}
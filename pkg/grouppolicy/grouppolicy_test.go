@@ -0,0 +1,61 @@
+package grouppolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchMimeType(t *testing.T) {
+	a := assert.New(t)
+	patterns := []string{"image/*", "application/pdf"}
+
+	a.True(MatchMimeType(patterns, "image/png"))
+	a.True(MatchMimeType(patterns, "IMAGE/JPEG"))
+	a.True(MatchMimeType(patterns, "application/pdf; charset=binary"))
+	a.False(MatchMimeType(patterns, "video/mp4"))
+	a.False(MatchMimeType(patterns, "imagex/png"))
+	a.False(MatchMimeType(patterns, ""))
+	a.True(MatchMimeType([]string{"*/*"}, "video/mp4"))
+}
+
+func TestChecker(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+	c := NewChecker(cache.NewMemoStore("", nil))
+	u := &ent.User{ID: 1, Edges: ent.UserEdges{Group: &ent.Group{Settings: &types.GroupSetting{
+		Policy: &types.GroupPolicy{
+			MaxFileSize: 100,
+			Limits: []types.GroupPolicyLimit{
+				{Action: types.GroupPolicyActionShare, Window: 86400, MaxCount: 2},
+				{Action: types.GroupPolicyActionUpload, Window: 86400, MaxSize: 150},
+			},
+		},
+	}}}}
+
+	// Without policy
+	a.NoError(c.Consume(ctx, &ent.User{ID: 2}, &Request{Action: types.GroupPolicyActionUpload, Size: 1000}))
+
+	// Max file size
+	a.Error(c.Consume(ctx, u, &Request{Action: types.GroupPolicyActionUpload, Size: 101}))
+
+	// Count limit
+	for i := 0; i < 2; i++ {
+		a.NoError(c.Consume(ctx, u, &Request{Action: types.GroupPolicyActionShare}))
+	}
+	a.Error(c.Consume(ctx, u, &Request{Action: types.GroupPolicyActionShare}))
+
+	// Size limit, rejected requests are not accounted
+	a.NoError(c.Consume(ctx, u, &Request{Action: types.GroupPolicyActionUpload, Size: 100}))
+	a.Error(c.Consume(ctx, u, &Request{Action: types.GroupPolicyActionUpload, Size: 51}))
+	a.NoError(c.Consume(ctx, u, &Request{Action: types.GroupPolicyActionUpload, Size: 50}))
+	a.Error(c.Consume(ctx, u, &Request{Action: types.GroupPolicyActionUpload, Size: 1}))
+
+	// Released usage can be consumed again
+	c.Release(ctx, u, &Request{Action: types.GroupPolicyActionUpload, Size: 50})
+	a.NoError(c.Consume(ctx, u, &Request{Action: types.GroupPolicyActionUpload, Size: 50}))
+}
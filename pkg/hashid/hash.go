@@ -22,6 +22,12 @@ const (
 	TaskID
 	DavAccountID
 	PaymentID
+	InvitationID
+	SavedSearchID
+	RssSubscriptionID
+	AutomationRuleID
+	SyncJobID
+	RetentionRuleID
 )
 
 var (
@@ -141,6 +147,42 @@ func EncodeDavAccountID(encoder Encoder, id int) string {
 	return res
 }
 
+// EncodeInvitationID encode invitation id to hash id
+func EncodeInvitationID(encoder Encoder, id int) string {
+	res, _ := encoder.Encode([]int{id, InvitationID})
+	return res
+}
+
+// EncodeRssSubscriptionID encode RSS subscription id to hash id
+func EncodeRssSubscriptionID(encoder Encoder, id int) string {
+	res, _ := encoder.Encode([]int{id, RssSubscriptionID})
+	return res
+}
+
+// EncodeAutomationRuleID encode automation rule id to hash id
+func EncodeAutomationRuleID(encoder Encoder, id int) string {
+	res, _ := encoder.Encode([]int{id, AutomationRuleID})
+	return res
+}
+
+// EncodeSyncJobID encode folder sync job id to hash id
+func EncodeSyncJobID(encoder Encoder, id int) string {
+	res, _ := encoder.Encode([]int{id, SyncJobID})
+	return res
+}
+
+// EncodeRetentionRuleID encode retention rule id to hash id
+func EncodeRetentionRuleID(encoder Encoder, id int) string {
+	res, _ := encoder.Encode([]int{id, RetentionRuleID})
+	return res
+}
+
+// EncodeSavedSearchID encode saved search id to hash id
+func EncodeSavedSearchID(encoder Encoder, id int) string {
+	res, _ := encoder.Encode([]int{id, SavedSearchID})
+	return res
+}
+
 // EncodeSourceLinkID encode source link id to hash id
 func EncodeSourceLinkID(encoder Encoder, id int) string {
 	res, _ := encoder.Encode([]int{id, SourceLinkID})
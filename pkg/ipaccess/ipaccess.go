@@ -0,0 +1,107 @@
+package ipaccess
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// Surfaces that IP access rules can be applied to, each can be configured by
+// `ip_<surface>_allow` and `ip_<surface>_deny` settings.
+const (
+	API    = "api"
+	Admin  = "admin"
+	WebDAV = "webdav"
+	S3     = "s3"
+)
+
+// ParseCIDRs parses a list of CIDRs or single IP addresses separated by commas, spaces or new lines.
+// An error is returned if any of the entries is invalid.
+func ParseCIDRs(raw string) ([]*net.IPNet, error) {
+	entries := strings.FieldsFunc(raw, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\n' || r == '\r' || r == '\t'
+	})
+
+	res := make([]*net.IPNet, 0, len(entries))
+	for _, entry := range entries {
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP address %q", entry)
+			}
+
+			bits := 128
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 32
+			}
+			res = append(res, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q: %w", entry, err)
+		}
+
+		res = append(res, ipNet)
+	}
+
+	return res, nil
+}
+
+// Contains returns true if ip is in any of the networks.
+func Contains(networks []*net.IPNet, ip net.IP) bool {
+	for _, n := range networks {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Allowed checks ip against the rules. Deny rules take precedence, and if allow rules are
+// present, only matched IPs are allowed.
+func Allowed(ip net.IP, allow, deny []*net.IPNet) bool {
+	if ip == nil {
+		return len(allow) == 0 && len(deny) == 0
+	}
+
+	if Contains(deny, ip) {
+		return false
+	}
+
+	return len(allow) == 0 || Contains(allow, ip)
+}
+
+// ClientIP returns the client IP of the request. The forwarded header is only trusted if the
+// request comes from a trusted proxy, and its entries are walked from right to left skipping
+// trusted proxies, so that a client cannot spoof its address by prepending entries.
+func ClientIP(r *http.Request, header string, trustedProxies []*net.IPNet) net.IP {
+	remote := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		remote = host
+	}
+
+	ip := net.ParseIP(remote)
+	if ip == nil || header == "" || !Contains(trustedProxies, ip) {
+		return ip
+	}
+
+	forwarded := strings.Split(strings.Join(r.Header.Values(header), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		hop := net.ParseIP(strings.TrimSpace(forwarded[i]))
+		if hop == nil {
+			break
+		}
+
+		ip = hop
+		if !Contains(trustedProxies, hop) {
+			break
+		}
+	}
+
+	return ip
+}
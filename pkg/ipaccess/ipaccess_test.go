@@ -0,0 +1,60 @@
+package ipaccess
+
+import (
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowed(t *testing.T) {
+	a := assert.New(t)
+	allow, err := ParseCIDRs("10.0.0.0/8, 192.168.1.1\n::1")
+	a.NoError(err)
+	deny, err := ParseCIDRs("10.0.0.5")
+	a.NoError(err)
+
+	a.Len(allow, 3)
+	a.Len(deny, 1)
+	a.True(Allowed(net.ParseIP("10.1.2.3"), allow, deny))
+	a.True(Allowed(net.ParseIP("192.168.1.1"), allow, deny))
+	a.True(Allowed(net.ParseIP("::1"), allow, deny))
+	a.False(Allowed(net.ParseIP("10.0.0.5"), allow, deny))
+	a.False(Allowed(net.ParseIP("192.168.1.2"), allow, deny))
+	a.True(Allowed(net.ParseIP("192.168.1.2"), nil, deny))
+	a.False(Allowed(nil, allow, nil))
+	a.True(Allowed(nil, nil, nil))
+}
+
+func TestClientIP(t *testing.T) {
+	a := assert.New(t)
+	trusted, err := ParseCIDRs("127.0.0.1,172.16.0.0/12")
+	a.NoError(err)
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r.RemoteAddr = "1.1.1.1:1234"
+	r.Header.Set("X-Forwarded-For", "2.2.2.2")
+	a.Equal("1.1.1.1", ClientIP(r, "X-Forwarded-For", trusted).String())
+
+	r.RemoteAddr = "127.0.0.1:1234"
+	r.Header.Set("X-Forwarded-For", "9.9.9.9, 2.2.2.2, 172.16.0.2")
+	a.Equal("2.2.2.2", ClientIP(r, "X-Forwarded-For", trusted).String())
+	a.Equal("127.0.0.1", ClientIP(r, "", trusted).String())
+
+	r.Header.Set("X-Forwarded-For", "172.16.0.3")
+	a.Equal("172.16.0.3", ClientIP(r, "X-Forwarded-For", trusted).String())
+}
+
+func TestParseCIDRs(t *testing.T) {
+	a := assert.New(t)
+
+	_, err := ParseCIDRs("10.0.0.5,invalid")
+	a.Error(err)
+	_, err = ParseCIDRs("10.0.0.0/33")
+	a.Error(err)
+
+	res, err := ParseCIDRs("")
+	a.NoError(err)
+	a.Empty(res)
+}
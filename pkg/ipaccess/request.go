@@ -0,0 +1,20 @@
+package ipaccess
+
+import (
+	"net"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/gin-gonic/gin"
+)
+
+// RequestClientIP returns the client IP of the request, forwarded header is only trusted for requests
+// from trusted proxies configured in settings. It should be used instead of gin's ClientIP wherever the
+// address is used for access control or throttling, as gin trusts the forwarded header from any client.
+func RequestClientIP(c *gin.Context, dep dependency.Dep) net.IP {
+	trusted, err := ParseCIDRs(dep.SettingProvider().TrustedProxies(c))
+	if err != nil {
+		dep.Logger().Warning("Invalid trusted proxies, forwarded client IP is ignored: %s", err)
+	}
+
+	return ClientIP(c.Request, dep.ConfigProvider().System().ProxyHeader, trusted)
+}
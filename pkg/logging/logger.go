@@ -2,12 +2,17 @@ package logging
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"time"
+
 	"github.com/fatih/color"
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
-	"runtime"
-	"time"
 )
 
 // Logger interface for logging messages.
@@ -19,6 +24,11 @@ type Logger interface {
 	Debug(format string, v ...any)
 	// Copy a new logger with a prefix.
 	CopyWithPrefix(prefix string) Logger
+	// CopyWithField copies a new logger with an additional structured field.
+	CopyWithField(key string, value any) Logger
+	// CopyWithComponent copies a new logger for given component, using the level configured for
+	// this component if any.
+	CopyWithComponent(name string) Logger
 
 	// SupportColor returns if current logger support outputting colors.
 	SupportColor() bool
@@ -42,42 +52,83 @@ const (
 	LevelDebug LogLevel = "debug"
 )
 
+type Format string
+
+const (
+	FormatText Format = "text"
+	FormatJSON Format = "json"
+)
+
+// FieldCorrelationID is the field key of correlation ID.
+const FieldCorrelationID = "cid"
+
+var levelRanks = map[LogLevel]int{
+	LevelError:         0,
+	LevelWarning:       1,
+	LevelInformational: 2,
+	LevelDebug:         3,
+}
+
+// Options for creating a new logger.
+type Options struct {
+	// Level is the default log level.
+	Level LogLevel
+	// Format of log lines, defaults to FormatText.
+	Format Format
+	// Output to write logs to, defaults to Stdout with color support.
+	Output io.Writer
+	// ComponentLevels overrides log level of given components.
+	ComponentLevels map[string]LogLevel
+}
+
 // NewConsoleLogger initializes a new logging that prints logs to Stdout.
 func NewConsoleLogger(level LogLevel) Logger {
-	logFunc := func(level string) loggingFunc {
-		return func(logger *consoleLogger, s string, a ...any) {
-			msg := fmt.Sprintf(s, a...)
-			logger.println(level, msg)
-		}
+	return NewLogger(&Options{Level: level})
+}
+
+// NewLogger initializes a new logger with given options.
+func NewLogger(opts *Options) Logger {
+	if opts.Format == "" {
+		opts.Format = FormatText
 	}
 
-	logger := &consoleLogger{
-		warning: logFunc("Warn"),
-		panic: func(logger *consoleLogger, s string, a ...any) {
-			msg := fmt.Sprintf(s, a...)
-			logger.println("Panic", msg)
-			panic(msg)
-		},
-		error: logFunc("Error"),
-		info:  logFunc("Info"),
-		debug: logFunc("Debug"),
+	l := &consoleLogger{
+		opts:   opts,
+		levels: &atomic.Pointer[levelConfig]{},
 	}
+	l.SetLevels(opts.Level, opts.ComponentLevels)
+	return l
+}
 
-	switch level {
-	case LevelError:
-		logger.warning = noopLoggingFunc
-		logger.info = noopLoggingFunc
-		logger.debug = noopLoggingFunc
-	case LevelWarning:
-		logger.info = noopLoggingFunc
-		logger.debug = noopLoggingFunc
-	case LevelInformational:
-		logger.debug = noopLoggingFunc
-	case LevelDebug:
+// LevelSetter is implemented by loggers whose levels can be changed at runtime.
+type LevelSetter interface {
+	// SetLevels changes the default level and component levels of the logger and all its copies.
+	SetLevels(level LogLevel, componentLevels map[string]LogLevel)
+}
+
+// ParseComponentLevels parses component levels in "component:level,component:level" format.
+func ParseComponentLevels(s string) (map[string]LogLevel, error) {
+	res := make(map[string]LogLevel)
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		component, level, found := strings.Cut(item, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid component level %q", item)
+		}
 
+		l := LogLevel(strings.TrimSpace(level))
+		if _, ok := levelRanks[l]; !ok {
+			return nil, fmt.Errorf("unknown log level %q for component %q", l, component)
+		}
+
+		res[strings.TrimSpace(component)] = l
 	}
 
-	return logger
+	return res, nil
 }
 
 // FromContext retrieves a logger from context.
@@ -98,69 +149,184 @@ func CorrelationID(ctx context.Context) uuid.UUID {
 	return v
 }
 
-type consoleLogger struct {
-	warning loggingFunc
-	panic   loggingFunc
-	error   loggingFunc
-	info    loggingFunc
-	debug   loggingFunc
-	prefix  string
+type (
+	consoleLogger struct {
+		opts *Options
+		// levels is shared between the logger and its copies.
+		levels    *atomic.Pointer[levelConfig]
+		prefix    string
+		component string
+		fields    []field
+	}
+
+	field struct {
+		key   string
+		value any
+	}
+
+	levelConfig struct {
+		level           LogLevel
+		componentLevels map[string]LogLevel
+	}
+)
+
+func (ll *consoleLogger) SetLevels(level LogLevel, componentLevels map[string]LogLevel) {
+	ll.levels.Store(&levelConfig{level: level, componentLevels: componentLevels})
+}
+
+// level returns the effective log level of the logger.
+func (ll *consoleLogger) level() LogLevel {
+	conf := ll.levels.Load()
+	if level, ok := conf.componentLevels[ll.component]; ok && ll.component != "" {
+		return level
+	}
+
+	return conf.level
 }
 
 func (ll *consoleLogger) Panic(format string, v ...any) {
-	ll.panic(ll, format, v...)
+	msg := fmt.Sprintf(format, v...)
+	ll.log(LevelError, "Panic", "%s", msg)
+	panic(msg)
 }
 
 func (ll *consoleLogger) Error(format string, v ...any) {
-	ll.error(ll, format, v...)
+	ll.log(LevelError, "Error", format, v...)
 }
 
 func (ll *consoleLogger) Warning(format string, v ...any) {
-	ll.warning(ll, format, v...)
+	ll.log(LevelWarning, "Warn", format, v...)
 }
 
 func (ll *consoleLogger) Info(format string, v ...any) {
-	ll.info(ll, format, v...)
+	ll.log(LevelInformational, "Info", format, v...)
 }
 
 func (ll *consoleLogger) Debug(format string, v ...any) {
-	ll.debug(ll, format, v...)
+	ll.log(LevelDebug, "Debug", format, v...)
+}
+
+func (ll *consoleLogger) log(level LogLevel, label string, format string, v ...any) {
+	if levelRanks[level] > levelRanks[ll.level()] {
+		return
+	}
+
+	ll.println(label, fmt.Sprintf(format, v...))
+}
+
+const jsonFieldPrefix = "field_"
+
+// jsonReservedKeys are built-in keys of JSON log entries.
+var jsonReservedKeys = map[string]bool{
+	"time":      true,
+	"level":     true,
+	"caller":    true,
+	"msg":       true,
+	"component": true,
+	"prefix":    true,
 }
 
 // println 打印
 func (ll *consoleLogger) println(level string, msg string) {
-	c := color.New()
 	_, filename, line, _ := runtime.Caller(3)
+	now := time.Now()
+
+	if ll.opts.Format == FormatJSON {
+		entry := map[string]any{
+			"time":   now.Format(time.RFC3339Nano),
+			"level":  strings.ToLower(level),
+			"caller": fmt.Sprintf("%s:%d", filename, line),
+			"msg":    msg,
+		}
+		if ll.component != "" {
+			entry["component"] = ll.component
+		}
+		if ll.prefix != "" {
+			entry["prefix"] = strings.TrimSpace(ll.prefix)
+		}
+		for _, f := range ll.fields {
+			key := f.key
+			// Fields must not overwrite built-in keys, namespace the clashing ones instead.
+			if _, reserved := entry[key]; reserved || jsonReservedKeys[key] {
+				key = jsonFieldPrefix + key
+			}
+			entry[key] = f.value
+		}
+
+		b, err := json.Marshal(entry)
+		if err != nil {
+			b, _ = json.Marshal(map[string]any{"level": "error", "msg": fmt.Sprintf("failed to encode log: %s", err)})
+		}
+
+		_, _ = ll.output().Write(append(b, '\n'))
+		return
+	}
 
-	_, _ = c.Printf(
-		"%s\t %s [%s:%d]%s %s\n",
-		colors[level]("["+level+"]"),
-		time.Now().Format("2006-01-02 15:04:05"),
+	levelText := "[" + level + "]"
+	if ll.SupportColor() {
+		levelText = colors[level](levelText)
+	}
+
+	var tags strings.Builder
+	if ll.component != "" {
+		tags.WriteString(" [" + ll.component + "]")
+	}
+	for _, f := range ll.fields {
+		tags.WriteString(fmt.Sprintf(" [%s: %v]", f.key, f.value))
+	}
+
+	_, _ = fmt.Fprintf(
+		ll.output(),
+		"%s\t %s [%s:%d]%s%s %s\n",
+		levelText,
+		now.Format("2006-01-02 15:04:05"),
 		filename,
 		line,
+		tags.String(),
 		ll.prefix,
 		msg,
 	)
 }
 
-func (ll *consoleLogger) CopyWithPrefix(prefix string) Logger {
+func (ll *consoleLogger) output() io.Writer {
+	if ll.opts.Output != nil {
+		return ll.opts.Output
+	}
+
+	return color.Output
+}
+
+func (ll *consoleLogger) copy() *consoleLogger {
 	return &consoleLogger{
-		warning: ll.warning,
-		panic:   ll.panic,
-		error:   ll.error,
-		info:    ll.info,
-		debug:   ll.debug,
-		prefix:  ll.prefix + " " + prefix,
+		opts:      ll.opts,
+		levels:    ll.levels,
+		prefix:    ll.prefix,
+		component: ll.component,
+		fields:    ll.fields[:len(ll.fields):len(ll.fields)],
 	}
 }
 
-func (ll *consoleLogger) SupportColor() bool {
-	return !color.NoColor
+func (ll *consoleLogger) CopyWithPrefix(prefix string) Logger {
+	l := ll.copy()
+	l.prefix = ll.prefix + " " + prefix
+	return l
+}
+
+func (ll *consoleLogger) CopyWithField(key string, value any) Logger {
+	l := ll.copy()
+	l.fields = append(l.fields, field{key: key, value: value})
+	return l
 }
 
-type loggingFunc func(*consoleLogger, string, ...any)
+func (ll *consoleLogger) CopyWithComponent(name string) Logger {
+	l := ll.copy()
+	l.component = name
+	return l
+}
 
-func noopLoggingFunc(*consoleLogger, string, ...any) {}
+func (ll *consoleLogger) SupportColor() bool {
+	return ll.opts.Output == nil && ll.opts.Format == FormatText && !color.NoColor
+}
 
 var colors = map[string]func(a ...interface{}) string{
 	"Warn":  color.New(color.FgYellow).Add(color.Bold).SprintFunc(),
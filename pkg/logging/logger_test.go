@@ -0,0 +1,148 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLogger_JSON(t *testing.T) {
+	a := assert.New(t)
+	buf := &bytes.Buffer{}
+	l := NewLogger(&Options{Level: LevelInformational, Format: FormatJSON, Output: buf})
+
+	l.CopyWithComponent("cron").CopyWithField(FieldCorrelationID, "abc").Info("hello %d", 1)
+	l.Debug("should be skipped")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	a.Len(lines, 1)
+
+	entry := map[string]any{}
+	a.NoError(json.Unmarshal([]byte(lines[0]), &entry))
+	a.Equal("hello 1", entry["msg"])
+	a.Equal("info", entry["level"])
+	a.Equal("cron", entry["component"])
+	a.Equal("abc", entry[FieldCorrelationID])
+	a.Contains(entry["caller"], "logger_test.go")
+}
+
+func TestNewLogger_JSONReservedField(t *testing.T) {
+	a := assert.New(t)
+	buf := &bytes.Buffer{}
+	l := NewLogger(&Options{Level: LevelInformational, Format: FormatJSON, Output: buf})
+
+	l.CopyWithField("msg", "injected").CopyWithField("level", "debug").Info("hello")
+
+	entry := map[string]any{}
+	a.NoError(json.Unmarshal(buf.Bytes(), &entry))
+	a.Equal("hello", entry["msg"])
+	a.Equal("info", entry["level"])
+	a.Equal("injected", entry["field_msg"])
+	a.Equal("debug", entry["field_level"])
+}
+
+func TestNewLogger_Text(t *testing.T) {
+	a := assert.New(t)
+	buf := &bytes.Buffer{}
+	l := NewLogger(&Options{Level: LevelDebug, Output: buf})
+
+	l.CopyWithField("cid", "abc").CopyWithPrefix("[Test]").Warning("hello")
+	a.Contains(buf.String(), "[Warn]")
+	a.Contains(buf.String(), "[cid: abc] [Test] hello")
+	a.False(l.SupportColor())
+}
+
+func TestConsoleLogger_CopyWithComponent(t *testing.T) {
+	a := assert.New(t)
+	buf := &bytes.Buffer{}
+	l := NewLogger(&Options{
+		Level:           LevelError,
+		Output:          buf,
+		ComponentLevels: map[string]LogLevel{"queue": LevelDebug},
+	})
+
+	l.Info("skipped")
+	a.Empty(buf.String())
+
+	l.CopyWithComponent("queue").Debug("printed")
+	a.Contains(buf.String(), "[queue]")
+	a.Contains(buf.String(), "printed")
+
+	buf.Reset()
+	l.CopyWithComponent("cron").Info("skipped")
+	a.Empty(buf.String())
+}
+
+func TestConsoleLogger_CopyWithField(t *testing.T) {
+	a := assert.New(t)
+	buf := &bytes.Buffer{}
+	l := NewLogger(&Options{Level: LevelDebug, Output: buf})
+
+	parent := l.CopyWithField("a", 1)
+	child1 := parent.CopyWithField("b", 2)
+	child2 := parent.CopyWithField("c", 3)
+
+	child1.Info("child1")
+	child2.Info("child2")
+	a.Contains(buf.String(), "[a: 1] [b: 2] child1")
+	a.Contains(buf.String(), "[a: 1] [c: 3] child2")
+}
+
+func TestParseComponentLevels(t *testing.T) {
+	a := assert.New(t)
+
+	levels, err := ParseComponentLevels(" cron:debug, queue:warning ,")
+	a.NoError(err)
+	a.Equal(map[string]LogLevel{"cron": LevelDebug, "queue": LevelWarning}, levels)
+
+	_, err = ParseComponentLevels("cron")
+	a.Error(err)
+
+	_, err = ParseComponentLevels("cron:verbose")
+	a.Error(err)
+}
+
+func TestSetLevels(t *testing.T) {
+	a := assert.New(t)
+	buf := &bytes.Buffer{}
+	l := NewLogger(&Options{Level: LevelWarning, Output: buf})
+	cron := l.CopyWithComponent("cron")
+
+	cron.Info("skipped")
+	a.Empty(buf.String())
+
+	l.(LevelSetter).SetLevels(LevelWarning, map[string]LogLevel{"cron": LevelDebug})
+	cron.Debug("cron debug")
+	l.Info("skipped")
+	a.Contains(buf.String(), "cron debug")
+	a.NotContains(buf.String(), "skipped")
+}
+
+func TestRotatingFile(t *testing.T) {
+	a := assert.New(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cloudreve.log")
+
+	r, err := NewRotatingFile(path, 1, false, 2, 0)
+	a.NoError(err)
+	defer r.Close()
+
+	chunk := bytes.Repeat([]byte("a"), 600*1024)
+	for i := 0; i < 4; i++ {
+		_, err := r.Write(chunk)
+		a.NoError(err)
+	}
+
+	backups, err := filepath.Glob(filepath.Join(dir, "cloudreve-*.log"))
+	a.NoError(err)
+	a.Len(backups, 2)
+
+	stat, err := os.Stat(path)
+	a.NoError(err)
+	a.EqualValues(len(chunk), stat.Size())
+}
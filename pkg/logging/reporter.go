@@ -0,0 +1,366 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// ErrorReporter sends errors to an external error tracking service.
+type ErrorReporter interface {
+	// CaptureError reports an error occurred in ctx. r is the request being handled, can be nil.
+	CaptureError(ctx context.Context, err error, r *http.Request)
+	// CapturePanic reports a recovered panic. r is the request being handled, can be nil.
+	CapturePanic(ctx context.Context, recovered any, r *http.Request)
+	// Flush waits until queued events are sent or ctx is done.
+	Flush(ctx context.Context) error
+}
+
+var (
+	reporterMu sync.RWMutex
+	reporter   ErrorReporter = nopReporter{}
+)
+
+// SetErrorReporter sets the global error reporter.
+func SetErrorReporter(r ErrorReporter) {
+	reporterMu.Lock()
+	defer reporterMu.Unlock()
+	reporter = r
+}
+
+// Reporter returns the global error reporter, which does nothing if not configured.
+func Reporter() ErrorReporter {
+	reporterMu.RLock()
+	defer reporterMu.RUnlock()
+	return reporter
+}
+
+// CaptureError reports an error with the global error reporter.
+func CaptureError(ctx context.Context, err error, r *http.Request) {
+	Reporter().CaptureError(ctx, err, r)
+}
+
+// CapturePanic reports a recovered panic with the global error reporter.
+func CapturePanic(ctx context.Context, recovered any, r *http.Request) {
+	Reporter().CapturePanic(ctx, recovered, r)
+}
+
+type nopReporter struct{}
+
+func (nopReporter) CaptureError(context.Context, error, *http.Request) {}
+func (nopReporter) CapturePanic(context.Context, any, *http.Request)   {}
+func (nopReporter) Flush(context.Context) error                        { return nil }
+
+const (
+	sentryQueueSize = 100
+	sentryTimeout   = 10 * time.Second
+	// sentryMaxFrames limits frames sent in a stack trace.
+	sentryMaxFrames = 50
+	filtered        = "[Filtered]"
+)
+
+// sensitiveHeaders are never sent to error reporter.
+var sensitiveHeaders = map[string]bool{
+	"authorization":       true,
+	"cookie":              true,
+	"set-cookie":          true,
+	"proxy-authorization": true,
+	"x-cr-credential":     true,
+}
+
+// sensitiveQueryKeywords query parameters containing these keywords are filtered.
+var sensitiveQueryKeywords = []string{"sign", "token", "password", "secret", "key", "code", "credential"}
+
+// SentryOptions options of Sentry-compatible error reporter.
+type SentryOptions struct {
+	DSN         string
+	Environment string
+	// Release tags events with the build version.
+	Release string
+	// SampleRate ratio of events to send, between 0 and 1.
+	SampleRate float64
+}
+
+type sentryReporter struct {
+	opts     *SentryOptions
+	l        Logger
+	endpoint string
+	auth     string
+	client   *http.Client
+	events   chan *sentryEvent
+	// pending number of events queued or being sent.
+	pending  atomic.Int64
+	hostname string
+}
+
+// NewSentryReporter creates an error reporter sending events to the store API of a
+// Sentry-compatible service.
+func NewSentryReporter(opts *SentryOptions, l Logger) (ErrorReporter, error) {
+	dsn, err := url.Parse(opts.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DSN: %w", err)
+	}
+
+	if dsn.User == nil || dsn.User.Username() == "" {
+		return nil, errors.New("invalid DSN: missing public key")
+	}
+
+	path := strings.Trim(dsn.Path, "/")
+	projectIndex := strings.LastIndex(path, "/")
+	project, prefix := path[projectIndex+1:], ""
+	if projectIndex >= 0 {
+		prefix = "/" + path[:projectIndex]
+	}
+	if project == "" {
+		return nil, errors.New("invalid DSN: missing project ID")
+	}
+
+	auth := fmt.Sprintf("Sentry sentry_version=7, sentry_client=cloudreve/%s, sentry_key=%s", opts.Release, dsn.User.Username())
+	if secret, ok := dsn.User.Password(); ok {
+		auth += ", sentry_secret=" + secret
+	}
+
+	hostname, _ := os.Hostname()
+	r := &sentryReporter{
+		opts:     opts,
+		l:        l,
+		endpoint: fmt.Sprintf("%s://%s%s/api/%s/store/", dsn.Scheme, dsn.Host, prefix, project),
+		auth:     auth,
+		client:   &http.Client{Timeout: sentryTimeout},
+		events:   make(chan *sentryEvent, sentryQueueSize),
+		hostname: hostname,
+	}
+
+	go r.worker()
+	return r, nil
+}
+
+type (
+	sentryEvent struct {
+		EventID     string            `json:"event_id"`
+		Timestamp   string            `json:"timestamp"`
+		Level       string            `json:"level"`
+		Platform    string            `json:"platform"`
+		Logger      string            `json:"logger"`
+		Release     string            `json:"release,omitempty"`
+		Environment string            `json:"environment,omitempty"`
+		ServerName  string            `json:"server_name,omitempty"`
+		Tags        map[string]string `json:"tags,omitempty"`
+		Exception   *sentryExceptions `json:"exception"`
+		Request     *sentryRequest    `json:"request,omitempty"`
+	}
+
+	sentryExceptions struct {
+		Values []sentryException `json:"values"`
+	}
+
+	sentryException struct {
+		Type       string            `json:"type"`
+		Value      string            `json:"value"`
+		Stacktrace *sentryStacktrace `json:"stacktrace,omitempty"`
+	}
+
+	sentryStacktrace struct {
+		Frames []sentryFrame `json:"frames"`
+	}
+
+	sentryFrame struct {
+		Function string `json:"function"`
+		Filename string `json:"filename"`
+		Lineno   int    `json:"lineno"`
+		InApp    bool   `json:"in_app"`
+	}
+
+	sentryRequest struct {
+		URL         string            `json:"url"`
+		Method      string            `json:"method"`
+		QueryString string            `json:"query_string,omitempty"`
+		Headers     map[string]string `json:"headers,omitempty"`
+	}
+)
+
+func (s *sentryReporter) CaptureError(ctx context.Context, err error, r *http.Request) {
+	if err == nil {
+		return
+	}
+
+	// Report the innermost error type to group events better.
+	inner := err
+	for unwrapped := errors.Unwrap(inner); unwrapped != nil; unwrapped = errors.Unwrap(inner) {
+		inner = unwrapped
+	}
+
+	s.capture(ctx, "error", reflect.TypeOf(inner).String(), err.Error(), r)
+}
+
+func (s *sentryReporter) CapturePanic(ctx context.Context, recovered any, r *http.Request) {
+	s.capture(ctx, "fatal", "panic", fmt.Sprint(recovered), r)
+}
+
+func (s *sentryReporter) capture(ctx context.Context, level, errType, msg string, r *http.Request) {
+	if s.opts.SampleRate < 1 && rand.Float64() >= s.opts.SampleRate {
+		return
+	}
+
+	id := uuid.Must(uuid.NewV4())
+	event := &sentryEvent{
+		EventID:     hex.EncodeToString(id[:]),
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		Level:       level,
+		Platform:    "go",
+		Logger:      "cloudreve",
+		Release:     s.opts.Release,
+		Environment: s.opts.Environment,
+		ServerName:  s.hostname,
+		Tags:        map[string]string{},
+		Exception: &sentryExceptions{Values: []sentryException{{
+			Type:       errType,
+			Value:      msg,
+			Stacktrace: stacktrace(4),
+		}}},
+		Request: sanitizeRequest(r),
+	}
+
+	if cid := CorrelationID(ctx); cid != uuid.Nil {
+		event.Tags["correlation_id"] = cid.String()
+	}
+
+	s.pending.Add(1)
+	select {
+	case s.events <- event:
+	default:
+		s.pending.Add(-1)
+		s.l.Warning("Error reporter queue is full, event %s is dropped.", event.EventID)
+	}
+}
+
+func (s *sentryReporter) Flush(ctx context.Context) error {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for s.pending.Load() > 0 {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+func (s *sentryReporter) worker() {
+	for event := range s.events {
+		if err := s.send(event); err != nil {
+			s.l.Warning("Failed to send error report: %s", err)
+		}
+		s.pending.Add(-1)
+	}
+}
+
+func (s *sentryReporter) send(event *sentryEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Sentry-Auth", s.auth)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// stacktrace returns current stack trace, oldest frame first as Sentry expects.
+func stacktrace(skip int) *sentryStacktrace {
+	pcs := make([]uintptr, sentryMaxFrames)
+	n := runtime.Callers(skip, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	res := make([]sentryFrame, 0, n)
+	for {
+		frame, more := frames.Next()
+		res = append(res, sentryFrame{
+			Function: frame.Function,
+			Filename: frame.File,
+			Lineno:   frame.Line,
+			InApp:    strings.Contains(frame.Function, "cloudreve/Cloudreve"),
+		})
+		if !more {
+			break
+		}
+	}
+
+	for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+		res[i], res[j] = res[j], res[i]
+	}
+
+	return &sentryStacktrace{Frames: res}
+}
+
+// sanitizeRequest extracts request context without credentials.
+func sanitizeRequest(r *http.Request) *sentryRequest {
+	if r == nil {
+		return nil
+	}
+
+	headers := make(map[string]string, len(r.Header))
+	for k, v := range r.Header {
+		if sensitiveHeaders[strings.ToLower(k)] {
+			headers[k] = filtered
+			continue
+		}
+		headers[k] = strings.Join(v, ", ")
+	}
+
+	query := r.URL.Query()
+	for k := range query {
+		lower := strings.ToLower(k)
+		for _, keyword := range sensitiveQueryKeywords {
+			if strings.Contains(lower, keyword) {
+				query[k] = []string{filtered}
+				break
+			}
+		}
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	return &sentryRequest{
+		URL:         fmt.Sprintf("%s://%s%s", scheme, r.Host, r.URL.Path),
+		Method:      r.Method,
+		QueryString: query.Encode(),
+		Headers:     headers,
+	}
+}
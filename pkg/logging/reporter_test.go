@@ -0,0 +1,40 @@
+package logging
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSentryReporter(t *testing.T) {
+	a := assert.New(t)
+	l := NewConsoleLogger(LevelError)
+
+	r, err := NewSentryReporter(&SentryOptions{DSN: "https://public@sentry.example.com/prefix/42", Release: "4.0.0"}, l)
+	a.NoError(err)
+	a.Equal("https://sentry.example.com/prefix/api/42/store/", r.(*sentryReporter).endpoint)
+	a.Contains(r.(*sentryReporter).auth, "sentry_key=public")
+
+	_, err = NewSentryReporter(&SentryOptions{DSN: "https://sentry.example.com/42"}, l)
+	a.Error(err)
+
+	_, err = NewSentryReporter(&SentryOptions{DSN: "https://public@sentry.example.com/"}, l)
+	a.Error(err)
+}
+
+func TestSanitizeRequest(t *testing.T) {
+	a := assert.New(t)
+	r := httptest.NewRequest("GET", "/api/v4/file?uri=cloudreve%3A%2F%2Fmy&sign=abc&password=123", nil)
+	r.Header.Set("Authorization", "Bearer secret")
+	r.Header.Set("User-Agent", "test")
+
+	res := sanitizeRequest(r)
+	a.Equal("http://example.com/api/v4/file", res.URL)
+	a.Equal(filtered, res.Headers["Authorization"])
+	a.Equal("test", res.Headers["User-Agent"])
+	a.NotContains(res.QueryString, "abc")
+	a.NotContains(res.QueryString, "123")
+	a.Contains(res.QueryString, "uri=")
+	a.Nil(sanitizeRequest(nil))
+}
@@ -0,0 +1,200 @@
+package logging
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const backupTimeFormat = "20060102-150405"
+
+// RotatingFile is a log file writer that rotates the file once it exceeds the size limit, or at
+// midnight if daily rotation is enabled. Rotated files are renamed with a timestamp suffix.
+type RotatingFile struct {
+	mu sync.Mutex
+
+	path       string
+	maxSize    int64
+	daily      bool
+	maxBackups int
+	maxAge     time.Duration
+
+	file     *os.File
+	size     int64
+	openedAt time.Time
+}
+
+// NewRotatingFile opens or creates the log file at given path. maxSizeMB, maxBackups and maxAgeDays
+// can be 0 to disable the corresponding limit.
+func NewRotatingFile(path string, maxSizeMB int, daily bool, maxBackups, maxAgeDays int) (*RotatingFile, error) {
+	r := &RotatingFile{
+		path:       path,
+		maxSize:    int64(maxSizeMB) * 1024 * 1024,
+		daily:      daily,
+		maxBackups: maxBackups,
+		maxAge:     time.Duration(maxAgeDays) * 24 * time.Hour,
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create log folder: %w", err)
+	}
+
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *RotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.shouldRotate(int64(len(p))) {
+		if err := r.rotate(); err != nil {
+			if r.file == nil {
+				return 0, err
+			}
+
+			// Keep writing to the current file rather than losing logs, rotation is retried on next write.
+			fmt.Fprintf(os.Stderr, "Failed to rotate log file: %s\n", err)
+		}
+	}
+
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// Close closes the underlying file.
+func (r *RotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+
+	return r.file.Close()
+}
+
+func (r *RotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+
+	stat, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("failed to stat log file: %w", err)
+	}
+
+	r.file = f
+	r.size = stat.Size()
+	r.openedAt = time.Now()
+	if r.size > 0 {
+		// Existing file is considered opened at the time of its last write, so that a log written
+		// yesterday is rotated on first write of today.
+		r.openedAt = stat.ModTime()
+	}
+
+	return nil
+}
+
+func (r *RotatingFile) shouldRotate(incoming int64) bool {
+	if r.maxSize > 0 && r.size > 0 && r.size+incoming > r.maxSize {
+		return true
+	}
+
+	if r.daily {
+		y1, m1, d1 := r.openedAt.Date()
+		y2, m2, d2 := time.Now().Date()
+		return y1 != y2 || m1 != m2 || d1 != d2
+	}
+
+	return false
+}
+
+// rotate renames current file to a backup and opens a new one. If it fails, the original file is
+// reopened so that logs can still be written, r.file is nil only if the original file cannot be
+// reopened either.
+func (r *RotatingFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return fmt.Errorf("failed to close log file: %w", err)
+	}
+
+	ext := filepath.Ext(r.path)
+	base := strings.TrimSuffix(r.path, ext)
+	backup := fmt.Sprintf("%s-%s%s", base, r.openedAt.Format(backupTimeFormat), ext)
+	for i := 1; ; i++ {
+		if _, err := os.Stat(backup); os.IsNotExist(err) {
+			break
+		}
+		backup = fmt.Sprintf("%s-%s.%d%s", base, r.openedAt.Format(backupTimeFormat), i, ext)
+	}
+
+	if err := os.Rename(r.path, backup); err != nil {
+		return r.reopen(fmt.Errorf("failed to rename log file: %w", err))
+	}
+
+	openedAt := r.openedAt
+	if err := r.open(); err != nil {
+		r.file = nil
+		// Move the backup back, so that later logs are appended to the original file.
+		if renameErr := os.Rename(backup, r.path); renameErr == nil {
+			err = r.reopen(err)
+			r.openedAt = openedAt
+		}
+		return err
+	}
+
+	// Newly opened file is always empty, start counting from now.
+	r.openedAt = time.Now()
+	r.removeBackups()
+	return nil
+}
+
+// reopen opens the original file after a failed rotation and returns the rotation error.
+func (r *RotatingFile) reopen(rotateErr error) error {
+	openedAt := r.openedAt
+	r.file = nil
+	if err := r.open(); err != nil {
+		return fmt.Errorf("%w, failed to reopen log file: %s", rotateErr, err)
+	}
+
+	r.openedAt = openedAt
+	return rotateErr
+}
+
+// removeBackups deletes rotated files exceeding the count or age limit.
+func (r *RotatingFile) removeBackups() {
+	if r.maxBackups <= 0 && r.maxAge <= 0 {
+		return
+	}
+
+	ext := filepath.Ext(r.path)
+	matches, err := filepath.Glob(strings.TrimSuffix(r.path, ext) + "-*" + ext)
+	if err != nil {
+		return
+	}
+
+	// Timestamp suffix sorts in chronological order, newest first after reversing.
+	sort.Sort(sort.Reverse(sort.StringSlice(matches)))
+	for i, backup := range matches {
+		expired := r.maxBackups > 0 && i >= r.maxBackups
+		if !expired && r.maxAge > 0 {
+			if stat, err := os.Stat(backup); err == nil && time.Since(stat.ModTime()) > r.maxAge {
+				expired = true
+			}
+		}
+
+		if expired {
+			_ = os.Remove(backup)
+		}
+	}
+}
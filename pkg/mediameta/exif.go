@@ -475,10 +475,11 @@ func ExtractExifMap(exifMap map[string]string, gpsTime time.Time) []driver.Media
 		takeTime = gpsTime.UTC()
 	}
 
+	// Saved in UTC so that capture time can be filtered by comparing strings.
 	if !takeTime.IsZero() {
 		metas = append(metas, driver.MediaMeta{
 			Key:   TakenAt,
-			Value: takeTime.Format(time.RFC3339),
+			Value: takeTime.UTC().Format(time.RFC3339),
 		})
 	}
 
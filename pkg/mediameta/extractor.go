@@ -47,6 +47,11 @@ func NewExtractorManager(ctx context.Context, settings setting.Provider, l loggi
 		extractors = append(extractors, musicE)
 	}
 
+	if e.settings.MediaMetaModelEnabled(ctx) {
+		modelE := newModelExtractor(settings, l)
+		extractors = append(extractors, modelE)
+	}
+
 	if e.settings.MediaMetaFFProbeEnabled(ctx) {
 		ffprobeE := newFFProbeExtractor(settings, l)
 		extractors = append(extractors, ffprobeE)
@@ -0,0 +1,348 @@
+package mediameta
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+var (
+	modelExts = []string{
+		"stl", "obj", "gltf", "glb",
+	}
+)
+
+const (
+	ModelFormat    = "format"
+	ModelTriangles = "triangles"
+	ModelVertices  = "vertices"
+	// ModelSizeX, ModelSizeY and ModelSizeZ are dimensions of the bounding box, in model units.
+	ModelSizeX = "size_x"
+	ModelSizeY = "size_y"
+	ModelSizeZ = "size_z"
+	// ModelBoundsMin and ModelBoundsMax are corners of the bounding box, formatted as "x,y,z".
+	ModelBoundsMin = "bounds_min"
+	ModelBoundsMax = "bounds_max"
+
+	stlHeaderSize     = 80
+	stlTriangleSize   = 50
+	glbMagic          = 0x46546C67 // "glTF"
+	glbChunkJSON      = 0x4E4F534A // "JSON"
+	gltfModeTriangles = 4
+	// modelMaxLineLength is the maximum length of a line in text based model files.
+	modelMaxLineLength = 1024 * 1024
+)
+
+var ErrInvalidModel = errors.New("invalid 3D model")
+
+type (
+	// ModelInfo is the geometry statistics of a 3D model.
+	ModelInfo struct {
+		Format    string
+		Triangles int64
+		// Vertices is the number of distinct vertices, 0 if the format does not share vertices among faces.
+		Vertices int64
+		Min      [3]float64
+		Max      [3]float64
+	}
+
+	modelExtractor struct {
+		l        logging.Logger
+		settings setting.Provider
+	}
+)
+
+func newModelExtractor(settings setting.Provider, l logging.Logger) *modelExtractor {
+	return &modelExtractor{
+		l:        l,
+		settings: settings,
+	}
+}
+
+func (m *modelExtractor) Exts() []string {
+	return modelExts
+}
+
+func (m *modelExtractor) Extract(ctx context.Context, ext string, source entitysource.EntitySource) ([]driver.MediaMeta, error) {
+	localLimit, remoteLimit := m.settings.MediaMetaModelSizeLimit(ctx)
+	if err := checkFileSize(localLimit, remoteLimit, source); err != nil {
+		return nil, err
+	}
+
+	info, err := ParseModel(source, ext, source.Entity().Size())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse 3D model: %w", err)
+	}
+
+	return info.Metas(), nil
+}
+
+// Metas converts model info into media metas.
+func (i *ModelInfo) Metas() []driver.MediaMeta {
+	metas := []driver.MediaMeta{
+		{Key: ModelFormat, Value: i.Format},
+		{Key: ModelTriangles, Value: strconv.FormatInt(i.Triangles, 10)},
+	}
+
+	if i.Vertices > 0 {
+		metas = append(metas, driver.MediaMeta{Key: ModelVertices, Value: strconv.FormatInt(i.Vertices, 10)})
+	}
+
+	if i.Min[0] <= i.Max[0] {
+		metas = append(metas,
+			driver.MediaMeta{Key: ModelSizeX, Value: formatModelFloat(i.Max[0] - i.Min[0])},
+			driver.MediaMeta{Key: ModelSizeY, Value: formatModelFloat(i.Max[1] - i.Min[1])},
+			driver.MediaMeta{Key: ModelSizeZ, Value: formatModelFloat(i.Max[2] - i.Min[2])},
+			driver.MediaMeta{Key: ModelBoundsMin, Value: formatModelPoint(i.Min)},
+			driver.MediaMeta{Key: ModelBoundsMax, Value: formatModelPoint(i.Max)},
+		)
+	}
+
+	for j := range metas {
+		metas[j].Type = driver.MetaTypeModel
+	}
+
+	return metas
+}
+
+// ParseModel reads geometry statistics of the model in given format, size is the total size of the file.
+func ParseModel(r io.Reader, ext string, size int64) (*ModelInfo, error) {
+	info := &ModelInfo{Format: ext}
+	for j := 0; j < 3; j++ {
+		info.Min[j] = math.Inf(1)
+		info.Max[j] = math.Inf(-1)
+	}
+
+	var err error
+	switch ext {
+	case "stl":
+		err = parseStl(r, size, info)
+	case "obj":
+		err = parseObj(r, info)
+	case "gltf":
+		err = parseGltf(r, info)
+	case "glb":
+		err = parseGlb(r, info)
+	default:
+		err = fmt.Errorf("unsupported model format %q", ext)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func (i *ModelInfo) extend(p [3]float64) {
+	for j := 0; j < 3; j++ {
+		i.Min[j] = math.Min(i.Min[j], p[j])
+		i.Max[j] = math.Max(i.Max[j], p[j])
+	}
+}
+
+// parseStl parses both binary and ASCII STL. A file is binary if its size matches the triangle count in
+// header, as many binary files also start with "solid".
+func parseStl(r io.Reader, size int64, info *ModelInfo) error {
+	br := bufio.NewReader(r)
+	header, err := br.Peek(stlHeaderSize + 4)
+	if err == nil {
+		count := int64(binary.LittleEndian.Uint32(header[stlHeaderSize:]))
+		if stlHeaderSize+4+count*stlTriangleSize == size {
+			info.Format = "stl_binary"
+			return parseBinaryStl(br, count, info)
+		}
+	}
+
+	info.Format = "stl_ascii"
+	return scanModelLines(br, func(fields []string) error {
+		switch fields[0] {
+		case "facet":
+			info.Triangles++
+		case "vertex":
+			p, err := parseModelPoint(fields[1:])
+			if err != nil {
+				return err
+			}
+			info.extend(p)
+		}
+		return nil
+	})
+}
+
+func parseBinaryStl(r io.Reader, count int64, info *ModelInfo) error {
+	if _, err := io.CopyN(io.Discard, r, stlHeaderSize+4); err != nil {
+		return err
+	}
+
+	buf := make([]byte, stlTriangleSize)
+	for t := int64(0); t < count; t++ {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return fmt.Errorf("%w: truncated triangle %d: %s", ErrInvalidModel, t, err)
+		}
+
+		// Skip the normal vector, followed by 3 vertices.
+		for v := 0; v < 3; v++ {
+			var p [3]float64
+			for j := 0; j < 3; j++ {
+				p[j] = float64(math.Float32frombits(binary.LittleEndian.Uint32(buf[12+v*12+j*4:])))
+			}
+			info.extend(p)
+		}
+	}
+
+	info.Triangles = count
+	return nil
+}
+
+func parseObj(r io.Reader, info *ModelInfo) error {
+	return scanModelLines(r, func(fields []string) error {
+		switch fields[0] {
+		case "v":
+			p, err := parseModelPoint(fields[1:])
+			if err != nil {
+				return err
+			}
+			info.Vertices++
+			info.extend(p)
+		case "f":
+			// Polygons are counted as triangle fans.
+			if len(fields) >= 4 {
+				info.Triangles += int64(len(fields) - 3)
+			}
+		}
+		return nil
+	})
+}
+
+func scanModelLines(r io.Reader, handle func(fields []string) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), modelMaxLineLength)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		if err := handle(fields); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+func parseModelPoint(fields []string) ([3]float64, error) {
+	var p [3]float64
+	if len(fields) < 3 {
+		return p, fmt.Errorf("%w: expect 3 coordinates, got %d", ErrInvalidModel, len(fields))
+	}
+
+	for j := 0; j < 3; j++ {
+		v, err := strconv.ParseFloat(fields[j], 64)
+		if err != nil {
+			return p, fmt.Errorf("%w: %s", ErrInvalidModel, err)
+		}
+		p[j] = v
+	}
+
+	return p, nil
+}
+
+type gltfDocument struct {
+	Accessors []struct {
+		Count int64     `json:"count"`
+		Min   []float64 `json:"min"`
+		Max   []float64 `json:"max"`
+	} `json:"accessors"`
+	Meshes []struct {
+		Primitives []struct {
+			Attributes map[string]int `json:"attributes"`
+			Indices    *int           `json:"indices"`
+			Mode       *int           `json:"mode"`
+		} `json:"primitives"`
+	} `json:"meshes"`
+}
+
+func parseGlb(r io.Reader, info *ModelInfo) error {
+	var header [5]uint32
+	if err := binary.Read(r, binary.LittleEndian, &header); err != nil {
+		return fmt.Errorf("%w: failed to read GLB header: %s", ErrInvalidModel, err)
+	}
+
+	// magic, version, length, then the first chunk which must be JSON.
+	if header[0] != glbMagic || header[4] != glbChunkJSON {
+		return fmt.Errorf("%w: not a GLB file", ErrInvalidModel)
+	}
+
+	return parseGltf(io.LimitReader(r, int64(header[3])), info)
+}
+
+// parseGltf reads statistics from accessors of mesh primitives. Bounds are in local space of meshes, as
+// POSITION accessors are required to have min and max, node transforms are not applied.
+func parseGltf(r io.Reader, info *ModelInfo) error {
+	var doc gltfDocument
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return fmt.Errorf("%w: failed to decode glTF: %s", ErrInvalidModel, err)
+	}
+
+	accessor := func(i int) bool {
+		return i >= 0 && i < len(doc.Accessors)
+	}
+
+	for _, mesh := range doc.Meshes {
+		for _, primitive := range mesh.Primitives {
+			position, ok := primitive.Attributes["POSITION"]
+			if !ok || !accessor(position) {
+				continue
+			}
+
+			positions := doc.Accessors[position]
+			info.Vertices += positions.Count
+			if len(positions.Min) >= 3 && len(positions.Max) >= 3 {
+				info.extend([3]float64(positions.Min[:3]))
+				info.extend([3]float64(positions.Max[:3]))
+			}
+
+			if primitive.Mode != nil && *primitive.Mode != gltfModeTriangles {
+				continue
+			}
+
+			if primitive.Indices != nil && accessor(*primitive.Indices) {
+				info.Triangles += doc.Accessors[*primitive.Indices].Count / 3
+			} else {
+				info.Triangles += positions.Count / 3
+			}
+		}
+	}
+
+	return nil
+}
+
+func formatModelFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func formatModelPoint(p [3]float64) string {
+	var b bytes.Buffer
+	for j, v := range p {
+		if j > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(formatModelFloat(v))
+	}
+	return b.String()
+}
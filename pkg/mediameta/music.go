@@ -30,6 +30,8 @@ const (
 	MusicYear         = "year"
 	MusicTrack        = "track"
 	MusicDisc         = "disc"
+	// MusicHasCover indicates the album art is embedded, which is served as thumbnail.
+	MusicHasCover = "has_cover"
 )
 
 func newMusicExtractor(settings setting.Provider, l logging.Logger) *musicExtractor {
@@ -137,6 +139,13 @@ func (a *musicExtractor) Extract(ctx context.Context, ext string, source entitys
 		})
 	}
 
+	if p := m.Picture(); p != nil && len(p.Data) > 0 {
+		metas = append(metas, driver.MediaMeta{
+			Key:   MusicHasCover,
+			Value: "true",
+		})
+	}
+
 	for i := 0; i < len(metas); i++ {
 		metas[i].Type = driver.MediaTypeMusic
 	}
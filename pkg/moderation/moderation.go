@@ -0,0 +1,156 @@
+// Package moderation checks content of public shares against a list of known SHA-256 hashes and an
+// external moderation API. Perceptual hashing (PhotoDNA-style) is left to the API, which speaks the JSON
+// protocol below, thumbnails are only sent if enabled:
+//
+//	POST {"items": [{"id": "...", "name": "...", "sha256": "...", "thumbnail": "<base64>"}]}
+//	200  {"results": [{"id": "...", "flagged": true, "reason": "..."}]}
+package moderation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+)
+
+const (
+	SourceHashList = "hash_list"
+	SourceAPI      = "api"
+)
+
+type (
+	// Checker checks a batch of items.
+	Checker interface {
+		// Check returns verdicts of flagged items keyed by item ID, clean items are omitted.
+		Check(ctx context.Context, items []Item) (map[string]Verdict, error)
+	}
+
+	Item struct {
+		ID        string `json:"id"`
+		Name      string `json:"name"`
+		SHA256    string `json:"sha256"`
+		Thumbnail []byte `json:"thumbnail,omitempty"`
+	}
+
+	Verdict struct {
+		// Source is the checker flagging the item.
+		Source string
+		Reason string
+	}
+
+	checkRequest struct {
+		Items []Item `json:"items"`
+	}
+
+	checkResponse struct {
+		Results []struct {
+			ID      string `json:"id"`
+			Flagged bool   `json:"flagged"`
+			Reason  string `json:"reason"`
+		} `json:"results"`
+	}
+
+	apiChecker struct {
+		client   request.Client
+		endpoint string
+		token    string
+	}
+
+	hashListChecker map[string]bool
+
+	chain []Checker
+)
+
+// NewAPIChecker returns a Checker sending requests to endpoint, authorized by the token as bearer if not
+// empty.
+func NewAPIChecker(client request.Client, endpoint, token string) Checker {
+	return &apiChecker{client: client, endpoint: endpoint, token: token}
+}
+
+func (c *apiChecker) Check(ctx context.Context, items []Item) (map[string]Verdict, error) {
+	body, err := json.Marshal(&checkRequest{Items: items})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	opts := []request.Option{
+		request.WithContext(ctx),
+		request.WithHeader(http.Header{"Content-Type": []string{"application/json"}}),
+	}
+	if c.token != "" {
+		opts = append(opts, request.WithHeader(http.Header{"Authorization": []string{"Bearer " + c.token}}))
+	}
+
+	content, err := c.client.Request(http.MethodPost, c.endpoint, bytes.NewReader(body), opts...).
+		CheckHTTPResponse(http.StatusOK).
+		GetResponse()
+	if err != nil {
+		return nil, fmt.Errorf("failed to request moderation endpoint: %w", err)
+	}
+
+	var res checkResponse
+	if err := json.Unmarshal([]byte(content), &res); err != nil {
+		return nil, fmt.Errorf("failed to decode moderation response: %w", err)
+	}
+
+	verdicts := make(map[string]Verdict)
+	for _, r := range res.Results {
+		if r.Flagged {
+			verdicts[r.ID] = Verdict{Source: SourceAPI, Reason: r.Reason}
+		}
+	}
+
+	return verdicts, nil
+}
+
+// NewHashListChecker returns a Checker flagging items whose SHA-256 hash is in the list. Hashes are
+// case-insensitive, empty ones are ignored.
+func NewHashListChecker(hashes []string) Checker {
+	c := make(hashListChecker, len(hashes))
+	for _, h := range hashes {
+		if h = strings.ToLower(strings.TrimSpace(h)); h != "" {
+			c[h] = true
+		}
+	}
+
+	return c
+}
+
+func (c hashListChecker) Check(ctx context.Context, items []Item) (map[string]Verdict, error) {
+	verdicts := make(map[string]Verdict)
+	for _, item := range items {
+		if item.SHA256 != "" && c[strings.ToLower(item.SHA256)] {
+			verdicts[item.ID] = Verdict{Source: SourceHashList}
+		}
+	}
+
+	return verdicts, nil
+}
+
+// Chain returns a Checker running given checkers in order, verdict of the first checker flagging an
+// item is kept. Any error aborts the check.
+func Chain(checkers ...Checker) Checker {
+	return chain(checkers)
+}
+
+func (c chain) Check(ctx context.Context, items []Item) (map[string]Verdict, error) {
+	verdicts := make(map[string]Verdict)
+	for _, checker := range c {
+		res, err := checker.Check(ctx, items)
+		if err != nil {
+			return nil, err
+		}
+
+		for id, v := range res {
+			if _, ok := verdicts[id]; !ok {
+				verdicts[id] = v
+			}
+		}
+	}
+
+	return verdicts, nil
+}
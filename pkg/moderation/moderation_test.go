@@ -0,0 +1,63 @@
+package moderation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/stretchr/testify/assert"
+)
+
+type testConfig struct {
+	conf.ConfigProvider
+}
+
+func (testConfig) System() *conf.System {
+	return &conf.System{Mode: conf.MasterMode}
+}
+
+func TestHashListChecker(t *testing.T) {
+	a := assert.New(t)
+	checker := NewHashListChecker([]string{" ABC ", "", "def"})
+
+	res, err := checker.Check(context.Background(), []Item{
+		{ID: "1", SHA256: "abc"},
+		{ID: "2", SHA256: "DEF"},
+		{ID: "3", SHA256: "123"},
+		{ID: "4"},
+	})
+	a.NoError(err)
+	a.Equal(map[string]Verdict{
+		"1": {Source: SourceHashList},
+		"2": {Source: SourceHashList},
+	}, res)
+}
+
+func TestAPIChecker(t *testing.T) {
+	a := assert.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.Equal("Bearer secret", r.Header.Get("Authorization"))
+		var req checkRequest
+		a.NoError(json.NewDecoder(r.Body).Decode(&req))
+		a.Len(req.Items, 2)
+		a.Equal([]byte("thumb"), req.Items[0].Thumbnail)
+
+		_, _ = w.Write([]byte(`{"results":[{"id":"1","flagged":true,"reason":"nsfw"},{"id":"2","flagged":false}]}`))
+	}))
+	defer server.Close()
+
+	checker := Chain(NewHashListChecker([]string{"def"}), NewAPIChecker(request.NewClient(testConfig{}), server.URL, "secret"))
+	res, err := checker.Check(context.Background(), []Item{
+		{ID: "1", SHA256: "abc", Thumbnail: []byte("thumb")},
+		{ID: "2", SHA256: "def"},
+	})
+	a.NoError(err)
+	a.Equal(map[string]Verdict{
+		"1": {Source: SourceAPI, Reason: "nsfw"},
+		"2": {Source: SourceHashList},
+	}, res)
+}
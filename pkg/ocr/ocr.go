@@ -0,0 +1,170 @@
+// Package ocr recognizes text in images and scanned PDFs, either with local tesseract or an external API.
+package ocr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/thumb"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	EngineTesseract = "tesseract"
+	EngineAPI       = "api"
+
+	// pdfRenderSize is the size of longer side of PDF pages rendered for recognition, about 200 DPI for A4.
+	pdfRenderSize = 2400
+	pdfQuality    = 90
+	ocrTempFolder = "ocr"
+)
+
+var ErrUnknownEngine = errors.New("unknown OCR engine")
+
+type (
+	// Recognizer recognizes text in an image.
+	Recognizer interface {
+		Recognize(ctx context.Context, image io.Reader, name string) (string, error)
+	}
+
+	tesseract struct {
+		executable string
+		languages  string
+	}
+
+	api struct {
+		client   request.Client
+		endpoint string
+		token    string
+	}
+)
+
+// NewRecognizer returns the recognizer of configured engine.
+func NewRecognizer(settings *setting.OCR, client request.Client) (Recognizer, error) {
+	switch settings.Engine {
+	case EngineTesseract:
+		return &tesseract{executable: settings.TesseractPath, languages: settings.Languages}, nil
+	case EngineAPI:
+		return &api{client: client, endpoint: settings.Endpoint, token: settings.Token}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownEngine, settings.Engine)
+	}
+}
+
+func (t *tesseract) Recognize(ctx context.Context, image io.Reader, name string) (string, error) {
+	args := []string{"stdin", "stdout"}
+	if t.languages != "" {
+		args = append(args, "-l", t.languages)
+	}
+
+	cmd := exec.CommandContext(ctx, t.executable, args...)
+	var stdOut, stdErr bytes.Buffer
+	cmd.Stdin = image
+	cmd.Stdout = &stdOut
+	cmd.Stderr = &stdErr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("failed to invoke tesseract: %w, raw output: %s", err, stdErr.String())
+	}
+
+	return stdOut.String(), nil
+}
+
+// Recognize posts the image to the endpoint, which responds with {"text": "..."}.
+func (a *api) Recognize(ctx context.Context, image io.Reader, name string) (string, error) {
+	opts := []request.Option{
+		request.WithContext(ctx),
+		request.WithHeader(http.Header{
+			"Content-Type":        []string{"application/octet-stream"},
+			"Content-Disposition": []string{fmt.Sprintf("attachment; filename=%q", name)},
+		}),
+	}
+	if a.token != "" {
+		opts = append(opts, request.WithHeader(http.Header{"Authorization": []string{"Bearer " + a.token}}))
+	}
+
+	content, err := a.client.Request(http.MethodPost, a.endpoint, image, opts...).
+		CheckHTTPResponse(http.StatusOK).
+		GetResponse()
+	if err != nil {
+		return "", fmt.Errorf("failed to request OCR endpoint: %w", err)
+	}
+
+	var res struct {
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal([]byte(content), &res); err != nil {
+		return "", fmt.Errorf("failed to decode OCR response: %w", err)
+	}
+
+	return res.Text, nil
+}
+
+// RecognizePdf renders first maxPages pages of scanned PDF with pdftoppm, recognizing them one by one.
+func RecognizePdf(ctx context.Context, r Recognizer, settings setting.Provider, pdf io.ReadSeeker, name string, maxPages int) (string, error) {
+	tempPath := filepath.Join(util.DataPath(settings.TempPath(ctx)), ocrTempFolder,
+		fmt.Sprintf("ocr_%s.jpg", uuid.Must(uuid.NewV4()).String()))
+	if err := util.CreatNestedFolder(filepath.Dir(tempPath)); err != nil {
+		return "", fmt.Errorf("failed to create temp folder: %w", err)
+	}
+	defer os.Remove(tempPath)
+
+	pages := make([]string, 0, maxPages)
+	for page := 1; page <= maxPages; page++ {
+		if _, err := pdf.Seek(0, io.SeekStart); err != nil {
+			return "", fmt.Errorf("failed to seek PDF: %w", err)
+		}
+
+		err := thumb.RenderPdfPage(ctx, settings.PdfToPpmPath(ctx), pdf, page, pdfRenderSize, pdfQuality, tempPath)
+		if errors.Is(err, thumb.ErrPdfPageNotExist) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		image, err := os.Open(tempPath)
+		if err != nil {
+			return "", fmt.Errorf("failed to open rendered page: %w", err)
+		}
+
+		text, err := r.Recognize(ctx, image, fmt.Sprintf("%s_%d.jpg", name, page))
+		image.Close()
+		if err != nil {
+			return "", fmt.Errorf("failed to recognize page %d: %w", page, err)
+		}
+
+		pages = append(pages, strings.TrimSpace(text))
+	}
+
+	return strings.Join(pages, "\n\n"), nil
+}
+
+// Normalize collapses whitespaces in recognized text, and truncates it to at most maxLen bytes on a rune
+// boundary.
+func Normalize(text string, maxLen int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if len(text) <= maxLen {
+		return text
+	}
+
+	// At most 3 bytes of a partial rune are dropped.
+	text = text[:maxLen]
+	for !utf8.ValidString(text) {
+		text = text[:len(text)-1]
+	}
+
+	return text
+}
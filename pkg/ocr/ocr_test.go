@@ -0,0 +1,15 @@
+package ocr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	a := assert.New(t)
+	a.Equal("hello world", Normalize("  hello\n\n world \t", 100))
+	a.Equal("hello", Normalize("hello world", 5))
+	// Partial rune is dropped
+	a.Equal("报告", Normalize("报告本", 8))
+}
@@ -0,0 +1,255 @@
+// Package onlyoffice integrates OnlyOffice DocumentServer as an online document editor.
+package onlyoffice
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// PropServer is the viewer prop of DocumentServer URL.
+	PropServer = "server"
+	// PropSecret is the viewer prop of JWT secret shared with DocumentServer, never exposed to clients.
+	PropSecret = "secret"
+
+	ModeEdit = "edit"
+	ModeView = "view"
+
+	authHeaderPrefix = "Bearer "
+)
+
+// Callback status sent by DocumentServer.
+const (
+	StatusEditing         = 1
+	StatusReadyForSave    = 2
+	StatusSaveError       = 3
+	StatusClosedNoChange  = 4
+	StatusForceSave       = 6
+	StatusForceSaveFailed = 7
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid OnlyOffice token")
+	// ErrSecretRequired is returned if JWT secret is not configured. Without it anyone can forge callbacks
+	// and make the server download arbitrary URLs.
+	ErrSecretRequired = errors.New("OnlyOffice JWT secret is required")
+
+	documentTypes = map[string]string{
+		"word":  "doc,docm,docx,docxf,dot,dotm,dotx,epub,fb2,fodt,htm,html,mht,mhtml,odt,oform,ott,rtf,stw,sxw,txt,wps,wpt,xml",
+		"cell":  "csv,et,ett,fods,ods,ots,sxc,xls,xlsb,xlsm,xlsx,xlt,xltm,xltx",
+		"slide": "dps,dpt,fodp,odp,otp,pot,potm,potx,pps,ppsm,ppsx,ppt,pptm,pptx,sxi",
+		"pdf":   "djvu,oxps,pdf,xps",
+	}
+)
+
+type (
+	// Config is the editor config passed to DocumentServer API by the frontend.
+	Config struct {
+		Document     Document     `json:"document"`
+		DocumentType string       `json:"documentType"`
+		EditorConfig EditorConfig `json:"editorConfig"`
+		Token        string       `json:"token,omitempty"`
+	}
+	Document struct {
+		FileType    string      `json:"fileType"`
+		Key         string      `json:"key"`
+		Title       string      `json:"title"`
+		Url         string      `json:"url"`
+		Permissions Permissions `json:"permissions"`
+	}
+	Permissions struct {
+		Edit     bool `json:"edit"`
+		Download bool `json:"download"`
+		Print    bool `json:"print"`
+		Review   bool `json:"review"`
+		Comment  bool `json:"comment"`
+	}
+	EditorConfig struct {
+		CallbackUrl string `json:"callbackUrl"`
+		Mode        string `json:"mode"`
+		Lang        string `json:"lang,omitempty"`
+		User        User   `json:"user"`
+	}
+	User struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+
+	// Callback is the request body sent by DocumentServer to the callback URL.
+	Callback struct {
+		Key      string   `json:"key"`
+		Status   int      `json:"status"`
+		Url      string   `json:"url,omitempty"`
+		Users    []string `json:"users,omitempty"`
+		FileType string   `json:"filetype,omitempty"`
+		Token    string   `json:"token,omitempty"`
+	}
+
+	// CallbackResponse must be returned for every callback, DocumentServer treats non-zero error as failure.
+	CallbackResponse struct {
+		Error int `json:"error"`
+	}
+)
+
+// DocumentType returns the document type of given file extension, empty if not supported by DocumentServer.
+func DocumentType(ext string) string {
+	ext = strings.ToLower(ext)
+	for t, exts := range documentTypes {
+		for _, e := range strings.Split(exts, ",") {
+			if e == ext {
+				return t
+			}
+		}
+	}
+
+	return ""
+}
+
+// DocumentKey returns the key identifying a version of file. Users editing the same version share the key and
+// thus the co-editing session, a new key is used once a new version is saved.
+func DocumentKey(fileID, entityID string) string {
+	return fmt.Sprintf("%s_%s", fileID, entityID)
+}
+
+// Sign signs the config with the JWT secret, DocumentServer rejects unsigned configs if JWT is enabled.
+func (c *Config) Sign(secret string) error {
+	c.Token = ""
+	if secret == "" {
+		return nil
+	}
+
+	token, err := sign(c, secret)
+	if err != nil {
+		return err
+	}
+
+	c.Token = token
+	return nil
+}
+
+// ValidateViewer checks viewer props of DocumentServer, a valid server URL and JWT secret are required.
+func ValidateViewer(props map[string]string) error {
+	if props[PropSecret] == "" {
+		return ErrSecretRequired
+	}
+
+	if _, err := serverOrigin(props[PropServer]); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CheckDownloadURL makes sure the URL of edited document in callback points to the configured DocumentServer,
+// so that the server is not used to fetch other resources.
+func CheckDownloadURL(src, server string) error {
+	expected, err := serverOrigin(server)
+	if err != nil {
+		return err
+	}
+
+	actual, err := serverOrigin(src)
+	if err != nil {
+		return fmt.Errorf("invalid document URL: %w", err)
+	}
+
+	if actual != expected {
+		return fmt.Errorf("document URL %q is not served by DocumentServer", src)
+	}
+
+	return nil
+}
+
+// serverOrigin returns the scheme and host of an absolute http(s) URL, with default port omitted.
+func serverOrigin(raw string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid DocumentServer URL: %w", err)
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	if (scheme != "http" && scheme != "https") || u.Hostname() == "" {
+		return "", fmt.Errorf("invalid DocumentServer URL %q", raw)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if port := u.Port(); port != "" && !(scheme == "http" && port == "80") && !(scheme == "https" && port == "443") {
+		host += ":" + port
+	}
+
+	return scheme + "://" + host, nil
+}
+
+// ParseCallback parses callback sent by DocumentServer. The callback must be signed with secret either
+// in the token field, or in the Authorization header where the payload is wrapped in "payload" field.
+func ParseCallback(body []byte, authHeader, secret string) (*Callback, error) {
+	callback := &Callback{}
+	if err := json.Unmarshal(body, callback); err != nil {
+		return nil, fmt.Errorf("failed to parse callback: %w", err)
+	}
+
+	if secret == "" {
+		return nil, ErrSecretRequired
+	}
+
+	if callback.Token != "" {
+		signed := &Callback{}
+		if err := verify(callback.Token, secret, signed); err != nil {
+			return nil, err
+		}
+
+		return signed, nil
+	}
+
+	if strings.HasPrefix(authHeader, authHeaderPrefix) {
+		signed := &struct {
+			Payload *Callback `json:"payload"`
+		}{}
+		if err := verify(strings.TrimPrefix(authHeader, authHeaderPrefix), secret, signed); err != nil {
+			return nil, err
+		}
+
+		if signed.Payload == nil {
+			return nil, ErrInvalidToken
+		}
+
+		return signed.Payload, nil
+	}
+
+	return nil, ErrInvalidToken
+}
+
+func sign(payload any, secret string) (string, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	claims := jwt.MapClaims{}
+	if err := json.Unmarshal(raw, &claims); err != nil {
+		return "", err
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+}
+
+func verify(token, secret string, dst any) error {
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		return []byte(secret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()})); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+
+	raw, err := json.Marshal(claims)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(raw, dst)
+}
@@ -0,0 +1,90 @@
+package onlyoffice
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocumentType(t *testing.T) {
+	a := assert.New(t)
+	a.Equal("word", DocumentType("DOCX"))
+	a.Equal("cell", DocumentType("xlsx"))
+	a.Equal("slide", DocumentType("pptx"))
+	a.Equal("pdf", DocumentType("pdf"))
+	a.Equal("", DocumentType("mp4"))
+}
+
+func TestConfigSign(t *testing.T) {
+	a := assert.New(t)
+	config := &Config{
+		Document:     Document{FileType: "docx", Key: DocumentKey("file", "entity")},
+		DocumentType: "word",
+	}
+
+	a.NoError(config.Sign(""))
+	a.Empty(config.Token)
+
+	a.NoError(config.Sign("secret"))
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(config.Token, claims, func(t *jwt.Token) (any, error) {
+		return []byte("secret"), nil
+	})
+	a.NoError(err)
+	a.Equal("file_entity", claims["document"].(map[string]any)["key"])
+	a.NotContains(claims, "token")
+}
+
+func TestParseCallback(t *testing.T) {
+	a := assert.New(t)
+	callback := &Callback{Key: "file_entity", Status: StatusReadyForSave, Url: "https://ds/cache/file.docx"}
+	token, err := sign(callback, "secret")
+	a.NoError(err)
+
+	// Callback is rejected without secret
+	raw, _ := json.Marshal(callback)
+	_, err = ParseCallback(raw, "", "")
+	a.ErrorIs(err, ErrSecretRequired)
+
+	// Unsigned callback rejected with secret
+	_, err = ParseCallback(raw, "", "secret")
+	a.ErrorIs(err, ErrInvalidToken)
+
+	// Signed in body, signed content wins
+	raw, _ = json.Marshal(&Callback{Key: "file_entity", Status: StatusReadyForSave, Url: "https://evil", Token: token})
+	res, err := ParseCallback(raw, "", "secret")
+	a.NoError(err)
+	a.Equal("https://ds/cache/file.docx", res.Url)
+
+	_, err = ParseCallback(raw, "", "other")
+	a.ErrorIs(err, ErrInvalidToken)
+
+	// Signed in header
+	headerToken, err := sign(map[string]any{"payload": callback}, "secret")
+	a.NoError(err)
+	raw, _ = json.Marshal(&Callback{Key: "file_entity", Status: StatusEditing})
+	res, err = ParseCallback(raw, "Bearer "+headerToken, "secret")
+	a.NoError(err)
+	a.Equal(StatusReadyForSave, res.Status)
+}
+
+func TestValidateViewer(t *testing.T) {
+	a := assert.New(t)
+	a.NoError(ValidateViewer(map[string]string{PropServer: "https://ds", PropSecret: "secret"}))
+	a.ErrorIs(ValidateViewer(map[string]string{PropServer: "https://ds"}), ErrSecretRequired)
+	a.Error(ValidateViewer(map[string]string{PropServer: "ds", PropSecret: "secret"}))
+}
+
+func TestCheckDownloadURL(t *testing.T) {
+	a := assert.New(t)
+	a.NoError(CheckDownloadURL("https://ds/cache/file.docx", "https://ds/"))
+	a.NoError(CheckDownloadURL("https://DS:443/cache/file.docx", "https://ds"))
+	a.NoError(CheckDownloadURL("http://ds:8080/cache/file.docx", "http://ds:8080/office/"))
+	a.Error(CheckDownloadURL("http://ds/cache/file.docx", "https://ds"))
+	a.Error(CheckDownloadURL("https://ds:8443/cache/file.docx", "https://ds"))
+	a.Error(CheckDownloadURL("http://169.254.169.254/latest/meta-data", "https://ds"))
+	a.Error(CheckDownloadURL("file:///etc/passwd", "https://ds"))
+	a.Error(CheckDownloadURL("https://ds/cache/file.docx", ""))
+}
@@ -0,0 +1,489 @@
+// Package openapi builds OpenAPI 3 documents of the HTTP API. Schemas of request and response are
+// derived from Go types and their json, form and binding tags, so that the document follows the code.
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+const (
+	Version = "3.0.3"
+
+	// EnvelopeSchema is the name of the response envelope schema, every JSON response of the API is
+	// wrapped in it with the actual payload in "data" field.
+	EnvelopeSchema = "Response"
+	// BearerAuth is the name of the security scheme of access tokens.
+	BearerAuth = "bearerAuth"
+)
+
+type (
+	Document struct {
+		OpenAPI    string                `json:"openapi"`
+		Info       Info                  `json:"info"`
+		Servers    []Server              `json:"servers,omitempty"`
+		Security   []SecurityRequirement `json:"security,omitempty"`
+		Paths      map[string]PathItem   `json:"paths"`
+		Components Components            `json:"components"`
+	}
+
+	Info struct {
+		Title       string `json:"title"`
+		Description string `json:"description,omitempty"`
+		Version     string `json:"version"`
+	}
+
+	Server struct {
+		URL string `json:"url"`
+	}
+
+	// PathItem operations of a path keyed by lower-cased HTTP method.
+	PathItem map[string]*Operation
+
+	Operation struct {
+		OperationID string                `json:"operationId"`
+		Summary     string                `json:"summary,omitempty"`
+		Tags        []string              `json:"tags,omitempty"`
+		Parameters  []*Parameter          `json:"parameters,omitempty"`
+		RequestBody *RequestBody          `json:"requestBody,omitempty"`
+		Responses   map[string]*Response  `json:"responses"`
+		Security    []SecurityRequirement `json:"security,omitempty"`
+	}
+
+	Parameter struct {
+		Name     string  `json:"name"`
+		In       string  `json:"in"`
+		Required bool    `json:"required,omitempty"`
+		Schema   *Schema `json:"schema"`
+	}
+
+	RequestBody struct {
+		Required bool                  `json:"required,omitempty"`
+		Content  map[string]*MediaType `json:"content"`
+	}
+
+	Response struct {
+		Description string                `json:"description"`
+		Content     map[string]*MediaType `json:"content,omitempty"`
+	}
+
+	MediaType struct {
+		Schema *Schema `json:"schema"`
+	}
+
+	Schema struct {
+		Ref                  string             `json:"$ref,omitempty"`
+		Type                 string             `json:"type,omitempty"`
+		Format               string             `json:"format,omitempty"`
+		Nullable             bool               `json:"nullable,omitempty"`
+		Enum                 []string           `json:"enum,omitempty"`
+		Minimum              *float64           `json:"minimum,omitempty"`
+		Maximum              *float64           `json:"maximum,omitempty"`
+		Items                *Schema            `json:"items,omitempty"`
+		Properties           map[string]*Schema `json:"properties,omitempty"`
+		AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+		Required             []string           `json:"required,omitempty"`
+		AllOf                []*Schema          `json:"allOf,omitempty"`
+	}
+
+	Components struct {
+		Schemas         map[string]*Schema         `json:"schemas"`
+		SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+	}
+
+	SecurityScheme struct {
+		Type         string `json:"type"`
+		Scheme       string `json:"scheme,omitempty"`
+		BearerFormat string `json:"bearerFormat,omitempty"`
+	}
+
+	SecurityRequirement map[string][]string
+
+	// Endpoint describes an API endpoint to be added into the document.
+	Endpoint struct {
+		// ID is the unique operation ID, used as method name by SDK generators.
+		ID     string
+		Method string
+		// Path in gin syntax, parameters like ":id" are documented as path parameters.
+		Path    string
+		Summary string
+		Tag     string
+		// Query is a value of the type bound from query string by form tags.
+		Query any
+		// Body is a value of the type bound from JSON body.
+		Body any
+		// Response is a value of the type in "data" field of the response envelope, nil if there is none.
+		Response any
+		// ContentTypes are media types of the response if it is not wrapped in the envelope, e.g. file content.
+		ContentTypes []string
+		// Anonymous endpoints can be called without access token.
+		Anonymous bool
+	}
+)
+
+var (
+	pathParamPattern   = regexp.MustCompile(`[:*]([A-Za-z0-9_]+)`)
+	schemaNameReplacer = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+	timeType          = reflect.TypeOf(time.Time{})
+	uuidType          = reflect.TypeOf(uuid.UUID{})
+	rawMessageType    = reflect.TypeOf(json.RawMessage{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// NewDocument creates an empty document served under given base URL.
+func NewDocument(info Info, baseURL string) *Document {
+	return &Document{
+		OpenAPI:  Version,
+		Info:     info,
+		Servers:  []Server{{URL: baseURL}},
+		Security: []SecurityRequirement{{BearerAuth: {}}},
+		Paths:    make(map[string]PathItem),
+		Components: Components{
+			Schemas: map[string]*Schema{
+				EnvelopeSchema: {
+					Type: "object",
+					Properties: map[string]*Schema{
+						"code":           {Type: "integer", Format: "int32"},
+						"data":           {},
+						"msg":            {Type: "string"},
+						"error":          {Type: "string"},
+						"correlation_id": {Type: "string"},
+					},
+					Required: []string{"code", "msg"},
+				},
+			},
+			SecuritySchemes: map[string]*SecurityScheme{
+				BearerAuth: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+	}
+}
+
+// Add adds an endpoint into the document.
+func (d *Document) Add(e Endpoint) error {
+	path := pathParamPattern.ReplaceAllString(e.Path, "{$1}")
+	method := strings.ToLower(e.Method)
+	if item, ok := d.Paths[path]; ok && item[method] != nil {
+		return fmt.Errorf("duplicated endpoint %s %s", e.Method, e.Path)
+	}
+
+	op := &Operation{
+		OperationID: e.ID,
+		Summary:     e.Summary,
+		Responses: map[string]*Response{
+			strconv.Itoa(http.StatusOK): {
+				Description: "Succeed if code is 0, otherwise the error is described in msg and error.",
+				Content: map[string]*MediaType{
+					"application/json": {Schema: d.envelopeOf(e.Response)},
+				},
+			},
+		},
+	}
+	if len(e.ContentTypes) > 0 {
+		// Errors are still responded in the envelope.
+		ok := op.Responses[strconv.Itoa(http.StatusOK)]
+		ok.Description = "Content of given media types if succeed, otherwise the error is described in JSON envelope."
+		for _, t := range e.ContentTypes {
+			ok.Content[t] = &MediaType{Schema: &Schema{Type: "string", Format: "binary"}}
+		}
+	}
+
+	if e.Tag != "" {
+		op.Tags = []string{e.Tag}
+	}
+
+	if e.Anonymous {
+		// Empty requirement means authentication is optional.
+		op.Security = []SecurityRequirement{{}}
+	}
+
+	for _, match := range pathParamPattern.FindAllStringSubmatch(e.Path, -1) {
+		op.Parameters = append(op.Parameters, &Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+
+	if e.Query != nil {
+		params, err := d.queryParameters(reflect.TypeOf(e.Query))
+		if err != nil {
+			return fmt.Errorf("invalid query of %s: %w", e.ID, err)
+		}
+		op.Parameters = append(op.Parameters, params...)
+	}
+
+	if e.Body != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]*MediaType{
+				"application/json": {Schema: d.SchemaOf(reflect.TypeOf(e.Body))},
+			},
+		}
+	}
+
+	if d.Paths[path] == nil {
+		d.Paths[path] = make(PathItem)
+	}
+	d.Paths[path][method] = op
+	return nil
+}
+
+// envelopeOf returns schema of the response envelope with data of given value's type.
+func (d *Document) envelopeOf(data any) *Schema {
+	envelope := &Schema{Ref: componentRef(EnvelopeSchema)}
+	if data == nil {
+		return envelope
+	}
+
+	return &Schema{
+		AllOf: []*Schema{
+			envelope,
+			{
+				Type:       "object",
+				Properties: map[string]*Schema{"data": d.SchemaOf(reflect.TypeOf(data))},
+			},
+		},
+	}
+}
+
+// queryParameters returns query parameters of a struct bound by form tags.
+func (d *Document) queryParameters(t reflect.Type) ([]*Parameter, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("query must be a struct, got %s", t)
+	}
+
+	var params []*Parameter
+	for _, f := range fieldsOf(t, "form") {
+		name := tagName(f.Tag.Get("form"))
+		if name == "" || name == "-" {
+			continue
+		}
+
+		schema, required := d.fieldSchema(f)
+		params = append(params, &Parameter{
+			Name:     name,
+			In:       "query",
+			Required: required,
+			Schema:   schema,
+		})
+	}
+
+	return params, nil
+}
+
+// SchemaOf returns schema of given type, named struct types are added into components and referred.
+func (d *Document) SchemaOf(t reflect.Type) *Schema {
+	nullable := false
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+		nullable = true
+	}
+
+	schema := d.schemaOf(t)
+	if nullable && schema.Ref == "" {
+		schema.Nullable = true
+	}
+
+	return schema
+}
+
+func (d *Document) schemaOf(t reflect.Type) *Schema {
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t == uuidType:
+		return &Schema{Type: "string", Format: "uuid"}
+	case t == rawMessageType:
+		return &Schema{}
+	case t.Kind() != reflect.String && implements(t, jsonMarshalerType):
+		// Custom JSON encoding cannot be told from the type.
+		return &Schema{}
+	case implements(t, textMarshalerType):
+		return &Schema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: d.SchemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: d.SchemaOf(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return d.structSchema(t)
+		}
+
+		name := schemaName(t)
+		if _, ok := d.Components.Schemas[name]; !ok {
+			// Registered before building properties so that recursive types refer to themselves.
+			d.Components.Schemas[name] = &Schema{}
+			*d.Components.Schemas[name] = *d.structSchema(t)
+		}
+
+		return &Schema{Ref: componentRef(name)}
+	default:
+		// Interfaces and other kinds can be any value.
+		return &Schema{}
+	}
+}
+
+// structSchema returns schema of struct fields encoded by encoding/json.
+func (d *Document) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	for _, f := range fieldsOf(t, "json") {
+		name, omitEmpty := jsonName(f)
+		if name == "" {
+			continue
+		}
+
+		if _, ok := schema.Properties[name]; ok {
+			// Shadowed by a shallower field.
+			continue
+		}
+
+		fieldSchema, required := d.fieldSchema(f)
+		schema.Properties[name] = fieldSchema
+		if required && !omitEmpty {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+
+	return schema
+}
+
+// fieldsOf returns exported fields of a struct, fields of embedded structs without name in given tag are
+// flattened after the shallower ones.
+func fieldsOf(t reflect.Type, tag string) []reflect.StructField {
+	var (
+		fields   []reflect.StructField
+		embedded []reflect.Type
+	)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		ft := f.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+
+		if f.Anonymous && ft.Kind() == reflect.Struct && tagName(f.Tag.Get(tag)) == "" {
+			embedded = append(embedded, ft)
+			continue
+		}
+
+		if f.IsExported() && f.Tag.Get(tag) != "-" {
+			fields = append(fields, f)
+		}
+	}
+
+	for _, et := range embedded {
+		fields = append(fields, fieldsOf(et, tag)...)
+	}
+
+	return fields
+}
+
+// fieldSchema returns schema of a field with validations in binding tag applied, and whether it is
+// required.
+func (d *Document) fieldSchema(f reflect.StructField) (*Schema, bool) {
+	schema := d.SchemaOf(f.Type)
+	required := false
+	for _, rule := range strings.Split(f.Tag.Get("binding"), ",") {
+		key, value, _ := strings.Cut(rule, "=")
+		switch key {
+		case "required":
+			required = true
+		case "oneof":
+			if schema.Type == "string" {
+				schema.Enum = strings.Fields(value)
+			}
+		case "min", "max", "gte", "lte":
+			bound, err := strconv.ParseFloat(value, 64)
+			if err != nil || schema.Ref != "" {
+				continue
+			}
+
+			if schema.Type == "array" || schema.Type == "string" {
+				// Length constraints are not documented.
+				continue
+			}
+
+			if key == "min" || key == "gte" {
+				schema.Minimum = &bound
+			} else {
+				schema.Maximum = &bound
+			}
+		}
+	}
+
+	return schema, required
+}
+
+// jsonName returns the name of field in JSON and whether it is omitted if empty.
+func jsonName(f reflect.StructField) (string, bool) {
+	tag := f.Tag.Get("json")
+	name := tagName(tag)
+	if name == "" {
+		name = f.Name
+	}
+
+	return name, strings.Contains(tag, ",omitempty")
+}
+
+func tagName(tag string) string {
+	name, _, _ := strings.Cut(tag, ",")
+	return name
+}
+
+func implements(t, iface reflect.Type) bool {
+	return t.Implements(iface) || reflect.PointerTo(t).Implements(iface)
+}
+
+// schemaName returns component name of a named type, prefixed with its package name.
+func schemaName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if i := strings.LastIndex(pkg, "/"); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+
+	name := t.Name()
+	if pkg != "" {
+		name = pkg + "." + name
+	}
+
+	return schemaNameReplacer.ReplaceAllString(name, "_")
+}
+
+func componentRef(name string) string {
+	return "#/components/schemas/" + name
+}
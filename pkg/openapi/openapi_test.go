@@ -0,0 +1,90 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	testBase struct {
+		ID string `json:"id"`
+	}
+	testNode struct {
+		*testBase
+		Name      string            `json:"name" binding:"required"`
+		Kind      string            `json:"kind" binding:"required,oneof=file folder"`
+		Size      int64             `json:"size,omitempty" binding:"min=0"`
+		CreatedAt time.Time         `json:"created_at"`
+		Children  []*testNode       `json:"children,omitempty"`
+		Meta      map[string]string `json:"meta,omitempty"`
+		Ignored   string            `json:"-"`
+		internal  string
+	}
+	testQuery struct {
+		Uri      string `form:"uri" json:"uri" binding:"required"`
+		PageSize int    `form:"page_size" binding:"min=10"`
+		Body     string `json:"body"`
+	}
+)
+
+func TestDocument_Add(t *testing.T) {
+	a := assert.New(t)
+	d := NewDocument(Info{Title: "Test", Version: "1.0.0"}, "/api")
+
+	a.NoError(d.Add(Endpoint{
+		ID:       "listNodes",
+		Method:   "GET",
+		Path:     "node/:id/*path",
+		Query:    testQuery{},
+		Response: []testNode{},
+	}))
+	a.NoError(d.Add(Endpoint{
+		ID:        "createNode",
+		Method:    "POST",
+		Path:      "node/:id/*path",
+		Body:      &testNode{},
+		Anonymous: true,
+	}))
+	a.Error(d.Add(Endpoint{ID: "duplicated", Method: "post", Path: "node/:id/*path"}))
+	a.NoError(d.Add(Endpoint{
+		ID:           "downloadNode",
+		Method:       "GET",
+		Path:         "node/:id/content",
+		ContentTypes: []string{"application/octet-stream"},
+	}))
+
+	list := d.Paths["node/{id}/{path}"]["get"]
+	a.Len(list.Parameters, 4)
+	a.Equal("path", list.Parameters[0].In)
+	a.Equal("uri", list.Parameters[2].Name)
+	a.True(list.Parameters[2].Required)
+	a.EqualValues(10, *list.Parameters[3].Schema.Minimum)
+	a.Empty(list.Security)
+
+	data := list.Responses["200"].Content["application/json"].Schema.AllOf[1].Properties["data"]
+	a.Equal("array", data.Type)
+	a.Equal("#/components/schemas/openapi.testNode", data.Items.Ref)
+
+	create := d.Paths["node/{id}/{path}"]["post"]
+	a.Equal("#/components/schemas/openapi.testNode", create.RequestBody.Content["application/json"].Schema.Ref)
+	a.Equal([]SecurityRequirement{{}}, create.Security)
+
+	download := d.Paths["node/{id}/content"]["get"].Responses["200"].Content
+	a.Equal("binary", download["application/octet-stream"].Schema.Format)
+	a.Equal("#/components/schemas/Response", download["application/json"].Schema.Ref)
+
+	node := d.Components.Schemas["openapi.testNode"]
+	a.ElementsMatch([]string{"id", "name", "kind", "size", "created_at", "children", "meta"}, lo.Keys(node.Properties))
+	a.Equal([]string{"name", "kind"}, node.Required)
+	a.Equal([]string{"file", "folder"}, node.Properties["kind"].Enum)
+	a.Equal("date-time", node.Properties["created_at"].Format)
+	a.Equal("#/components/schemas/openapi.testNode", node.Properties["children"].Items.Ref)
+	a.Equal("string", node.Properties["meta"].AdditionalProperties.Type)
+
+	_, err := json.Marshal(d)
+	a.NoError(err)
+}
@@ -0,0 +1,132 @@
+package queue
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	taskLeaseKVPrefix = "task_lease_"
+	// taskLeaseTTL is the default visibility timeout, i.e. how long a Task lease is kept without renewal,
+	// in seconds. Tasks of an instance exited unexpectedly are adopted by others after the lease expires.
+	taskLeaseTTL = 60
+)
+
+// taskLease tracks Tasks owned by current instance among all instances sharing the same KV store,
+// so that a persisted Task is only resumed by one of them.
+type taskLease struct {
+	kv  cache.Driver
+	l   logging.Logger
+	id  string
+	ttl int
+
+	mu   sync.Mutex
+	held map[int]struct{}
+}
+
+func newTaskLease(kv cache.Driver, l logging.Logger, ttl time.Duration) *taskLease {
+	ttlSeconds := int(ttl / time.Second)
+	if ttlSeconds < 3 {
+		ttlSeconds = taskLeaseTTL
+	}
+
+	return &taskLease{
+		kv:   kv,
+		l:    l,
+		id:   uuid.Must(uuid.NewV4()).String(),
+		ttl:  ttlSeconds,
+		held: make(map[int]struct{}),
+	}
+}
+
+// interval returns how often leases should be renewed.
+func (tl *taskLease) interval() time.Duration {
+	return time.Duration(tl.ttl) * time.Second / 3
+}
+
+// acquire takes the lease of a Task, returns false if it is owned by another instance.
+func (tl *taskLease) acquire(id int) bool {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	if _, ok := tl.held[id]; ok {
+		return true
+	}
+
+	acquired, err := tl.kv.SetNX(taskLeaseKey(id), tl.id, tl.ttl)
+	if err != nil {
+		tl.l.Warning("Failed to acquire lease of task %d: %s", id, err)
+		return false
+	}
+
+	if acquired {
+		tl.held[id] = struct{}{}
+	}
+	return acquired
+}
+
+// holds returns whether current instance owns the Task.
+func (tl *taskLease) holds(id int) bool {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	_, ok := tl.held[id]
+	return ok
+}
+
+// release gives up the lease of a Task, so that other instances can adopt it.
+func (tl *taskLease) release(id int) {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	if _, ok := tl.held[id]; !ok {
+		return
+	}
+
+	delete(tl.held, id)
+	if _, err := tl.kv.CompareAndDelete(taskLeaseKey(id), tl.id); err != nil {
+		tl.l.Warning("Failed to release lease of task %d: %s", id, err)
+	}
+}
+
+// releaseAll gives up all leases held by current instance.
+func (tl *taskLease) releaseAll() {
+	tl.mu.Lock()
+	ids := make([]int, 0, len(tl.held))
+	for id := range tl.held {
+		ids = append(ids, id)
+	}
+	tl.mu.Unlock()
+
+	for _, id := range ids {
+		tl.release(id)
+	}
+}
+
+// renew extends all leases held by current instance. Leases taken over by other instances
+// after expiration are dropped.
+func (tl *taskLease) renew() {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	for id := range tl.held {
+		renewed, err := tl.kv.CompareAndSwap(taskLeaseKey(id), tl.id, tl.id, tl.ttl)
+		if err != nil {
+			tl.l.Warning("Failed to renew lease of task %d: %s", id, err)
+			continue
+		}
+
+		if !renewed {
+			tl.l.Warning("Lease of task %d is taken over by another instance.", id)
+			delete(tl.held, id)
+		}
+	}
+}
+
+func taskLeaseKey(id int) string {
+	return taskLeaseKVPrefix + strconv.Itoa(id)
+}
@@ -0,0 +1,82 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskLease(t *testing.T) {
+	a := assert.New(t)
+	l := logging.NewConsoleLogger(logging.LevelError)
+	kv := cache.NewMemoStore("", l)
+	first := newTaskLease(kv, l, 0)
+	second := newTaskLease(kv, l, 0)
+
+	a.True(first.acquire(1))
+	a.True(first.acquire(1))
+	a.False(second.acquire(1))
+	a.True(second.acquire(2))
+
+	first.renew()
+	a.True(first.holds(1))
+
+	first.release(1)
+	a.False(first.holds(1))
+	a.True(second.acquire(1))
+
+	// Lease taken over by another instance is dropped on renewal
+	a.NoError(kv.Set(taskLeaseKey(2), first.id, taskLeaseTTL))
+	second.renew()
+	a.False(second.holds(2))
+	a.True(second.holds(1))
+
+	second.releaseAll()
+	a.Equal(taskLeaseTTL*time.Second/3, first.interval())
+	a.Equal(10*time.Second, newTaskLease(kv, l, 30*time.Second).interval())
+
+	a.True(first.acquire(1))
+	_, ok := kv.Get(taskLeaseKey(2))
+	a.True(ok)
+}
+
+type leaseTestTaskClient struct {
+	inventory.TaskClient
+	nextID int
+}
+
+func (c *leaseTestTaskClient) New(ctx context.Context, args *inventory.TaskArgs) (*ent.Task, error) {
+	c.nextID++
+	return &ent.Task{ID: c.nextID, Type: args.Type, Status: args.Status, PublicState: args.PublicState}, nil
+}
+
+type leaseTestTask struct {
+	*DBTask
+}
+
+func (t *leaseTestTask) Do(ctx context.Context) (task.Status, error) {
+	return task.StatusCompleted, nil
+}
+
+func TestQueue_LeaseNewTask(t *testing.T) {
+	a := assert.New(t)
+	l := logging.NewConsoleLogger(logging.LevelError)
+	kv := cache.NewMemoStore("", l)
+	q := New(l, &leaseTestTaskClient{}, nil, nil, WithTaskLease(kv)).(*queue)
+
+	newTask := &leaseTestTask{DBTask: &DBTask{DirectOwner: &ent.User{ID: 1}, Task: &ent.Task{PublicState: &types.TaskPublicState{}}}}
+	a.NoError(q.QueueTask(context.Background(), newTask))
+	a.NotZero(newTask.ID())
+
+	// New task is owned by current instance once saved, so that others do not resume it.
+	a.True(q.lease.holds(newTask.ID()))
+	a.False(newTaskLease(kv, l, 0).acquire(newTask.ID()))
+}
@@ -1,8 +1,11 @@
 package queue
 
 import (
+	"context"
 	"runtime"
 	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
 )
 
 // An Option configures a mutex.
@@ -28,6 +31,12 @@ type options struct {
 	resumeTaskType     []string
 	workerCount        int
 	name               string
+	onTaskFinished     func(ctx context.Context, t Task)
+	cancelSignal       cache.Driver
+	taskLease          cache.Driver
+	visibilityTimeout  time.Duration
+	priorityLanes      int
+	idempotencyWindow  time.Duration
 }
 
 func newDefaultOptions() *options {
@@ -39,6 +48,8 @@ func newDefaultOptions() *options {
 		resumeTaskType:     []string{},
 		taskPullInterval:   1 * time.Second,
 		name:               "default",
+		priorityLanes:      PriorityLanes,
+		idempotencyWindow:  IdempotencyWindow,
 	}
 }
 
@@ -107,3 +118,51 @@ func WithTaskPullInterval(d time.Duration) Option {
 		q.taskPullInterval = d
 	})
 }
+
+// WithOnTaskFinished set a hook called after a Task is completed or failed
+func WithOnTaskFinished(f func(ctx context.Context, t Task)) Option {
+	return OptionFunc(func(q *options) {
+		q.onTaskFinished = f
+	})
+}
+
+// WithCancelSignal set the KV store polled by running Tasks for cancellation requested on other instances,
+// see SignalCancel.
+func WithCancelSignal(kv cache.Driver) Option {
+	return OptionFunc(func(q *options) {
+		q.cancelSignal = kv
+	})
+}
+
+// WithTaskLease set the KV store used to coordinate persisted Tasks among instances sharing the same
+// database. A Task is only resumed by the instance holding its lease, Tasks left by exited instances
+// are adopted by others once their leases expire.
+func WithTaskLease(kv cache.Driver) Option {
+	return OptionFunc(func(q *options) {
+		q.taskLease = kv
+	})
+}
+
+// WithVisibilityTimeout set how long a Task owned by an unresponsive instance stays invisible to others
+// before being adopted, only effective with WithTaskLease.
+func WithVisibilityTimeout(d time.Duration) Option {
+	return OptionFunc(func(q *options) {
+		q.visibilityTimeout = d
+	})
+}
+
+// WithPriorityLanes schedules Tasks by their priority in given number of lanes instead of PriorityLanes, see
+// NewPriorityScheduler.
+func WithPriorityLanes(lanes int) Option {
+	return OptionFunc(func(q *options) {
+		q.priorityLanes = lanes
+	})
+}
+
+// WithIdempotencyWindow set how long an idempotency key is used by the Task submitted with it instead of
+// IdempotencyWindow. Tasks submitted with the key after the window are created again.
+func WithIdempotencyWindow(d time.Duration) Option {
+	return OptionFunc(func(q *options) {
+		q.idempotencyWindow = d
+	})
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/jpillora/backoff"
 )
@@ -20,6 +23,9 @@ type (
 		Start()
 		// Shutdown stops all workers.
 		Shutdown()
+		// Drain stops picking up new tasks and waits for running tasks to finish until ctx is done.
+		// Tasks not started are kept queued and resumed on next start.
+		Drain(ctx context.Context)
 		// SubmitTask submits a Task to the queue.
 		QueueTask(ctx context.Context, t Task) error
 		// BusyWorkers returns the numbers of workers in the running process.
@@ -32,6 +38,8 @@ type (
 		SubmittedTasks() int
 		// SuspendingTasks returns the numbers of suspending tasks.
 		SuspendingTasks() int
+		// PendingTasks returns the numbers of tasks waiting to be picked up in each priority lane.
+		PendingTasks() []int
 	}
 	queue struct {
 		sync.Mutex
@@ -42,6 +50,7 @@ type (
 		scheduler    Scheduler
 		stopOnce     sync.Once
 		stopFlag     int32
+		drainFlag    int32
 		rootCtx      context.Context
 		cancel       context.CancelFunc
 
@@ -50,6 +59,11 @@ type (
 		taskClient inventory.TaskClient
 		dep        Dep
 		registry   TaskRegistry
+		lease      *taskLease
+
+		// running is the number of running Tasks of each owner, used to enforce group quotas.
+		running   map[int]int
+		runningMu sync.Mutex
 
 		// Options
 		*options
@@ -60,10 +74,31 @@ type (
 	}
 )
 
+const (
+	cancelSignalKVPrefix = "task_cancel_"
+	cancelSignalTTL      = 7 * 24 * 3600
+	// cancelSignalInterval is how often running Tasks poll the cancel signal.
+	cancelSignalInterval = 5 * time.Second
+	// IdempotencyWindow is how long an idempotency key stays used by a Task by default.
+	IdempotencyWindow = 24 * time.Hour
+)
+
 var (
 	CriticalErr = errors.New("non-retryable error")
+	// ErrTaskCanceled is returned when the Task is canceled during an iteration.
+	ErrTaskCanceled = errors.New("task canceled")
 )
 
+// DuplicateTaskError is returned when submitting a Task with an idempotency key already used by
+// another Task within the idempotency window.
+type DuplicateTaskError struct {
+	Existing *ent.Task
+}
+
+func (e *DuplicateTaskError) Error() string {
+	return fmt.Sprintf("task with the same idempotency key already exists: %d", e.Existing.ID)
+}
+
 func New(l logging.Logger, taskClient inventory.TaskClient, registry TaskRegistry, dep Dep, opts ...Option) Queue {
 	o := newDefaultOptions()
 	for _, opt := range opts {
@@ -72,9 +107,19 @@ func New(l logging.Logger, taskClient inventory.TaskClient, registry TaskRegistr
 
 	ctx, cancel := context.WithCancel(context.Background())
 
+	var lease *taskLease
+	if o.taskLease != nil {
+		lease = newTaskLease(o.taskLease, l, o.visibilityTimeout)
+	}
+
+	scheduler := NewFifoScheduler(0, l)
+	if o.priorityLanes > 0 {
+		scheduler = NewPriorityScheduler(o.priorityLanes, 0, l)
+	}
+
 	return &queue{
 		routineGroup: newRoutineGroup(),
-		scheduler:    NewFifoScheduler(0, l),
+		scheduler:    scheduler,
 		quit:         make(chan struct{}),
 		ready:        make(chan struct{}, 1),
 		metric:       &metric{},
@@ -85,6 +130,8 @@ func New(l logging.Logger, taskClient inventory.TaskClient, registry TaskRegistr
 		dep:          dep,
 		rootCtx:      ctx,
 		cancel:       cancel,
+		lease:        lease,
+		running:      make(map[int]int),
 	}
 }
 
@@ -93,40 +140,73 @@ func (q *queue) Start() {
 	q.routineGroup.Run(func() {
 		// Resume tasks in DB
 		if len(q.options.resumeTaskType) > 0 && q.taskClient != nil {
+			resumed := q.resume()
+			q.logger.Info("Resumed %d tasks from DB.", resumed)
 
-			ctx := context.TODO()
-			ctx = context.WithValue(ctx, inventory.LoadTaskUser{}, true)
-			ctx = context.WithValue(ctx, inventory.LoadUserGroup{}, true)
-			tasks, err := q.taskClient.GetPendingTasks(ctx, q.resumeTaskType...)
-			if err != nil {
-				q.logger.Warning("Failed to get pending tasks from DB for given type %v: %s", q.resumeTaskType, err)
+			if q.lease != nil {
+				q.routineGroup.Run(q.keepLeases)
 			}
+		}
 
-			resumed := 0
-			for _, t := range tasks {
-				resumedTask, err := NewTaskFromModel(t)
-				if err != nil {
-					q.logger.Warning("Failed to resume task %d: %s", t.ID, err)
-					continue
-				}
+		q.start()
+	})
+	q.logger.Info("Queue %q started with %d workers.", q.name, q.workerCount)
+}
 
-				if resumedTask.Status() == task.StatusSuspending {
-					q.metric.IncSuspendingTask()
-					q.metric.IncSubmittedTask()
-				}
+// resume queues pending tasks in DB, skipping the ones owned by other instances.
+func (q *queue) resume() int {
+	ctx := context.TODO()
+	ctx = context.WithValue(ctx, inventory.LoadTaskUser{}, true)
+	ctx = context.WithValue(ctx, inventory.LoadUserGroup{}, true)
+	tasks, err := q.taskClient.GetPendingTasks(ctx, q.resumeTaskType...)
+	if err != nil {
+		q.logger.Warning("Failed to get pending tasks from DB for given type %v: %s", q.resumeTaskType, err)
+	}
 
-				if err := q.QueueTask(ctx, resumedTask); err != nil {
-					q.logger.Warning("Failed to resume task %d: %s", t.ID, err)
-				}
-				resumed++
-			}
+	resumed := 0
+	for _, t := range tasks {
+		if q.lease != nil && (q.lease.holds(t.ID) || !q.lease.acquire(t.ID)) {
+			continue
+		}
 
-			q.logger.Info("Resumed %d tasks from DB.", resumed)
+		resumedTask, err := NewTaskFromModel(t)
+		if err != nil {
+			q.logger.Warning("Failed to resume task %d: %s", t.ID, err)
+			continue
 		}
 
-		q.start()
-	})
-	q.logger.Info("Queue %q started with %d workers.", q.name, q.workerCount)
+		if resumedTask.Status() == task.StatusSuspending {
+			q.metric.IncSuspendingTask()
+			q.metric.IncSubmittedTask()
+		}
+
+		if err := q.QueueTask(ctx, resumedTask); err != nil {
+			q.logger.Warning("Failed to resume task %d: %s", t.ID, err)
+		}
+		resumed++
+	}
+
+	return resumed
+}
+
+// keepLeases renews leases of owned tasks, and periodically adopts tasks left by exited instances.
+func (q *queue) keepLeases() {
+	ticker := time.NewTicker(q.lease.interval())
+	defer ticker.Stop()
+
+	for i := 1; ; i++ {
+		select {
+		case <-q.quit:
+			return
+		case <-ticker.C:
+			q.lease.renew()
+			if i%3 == 0 && atomic.LoadInt32(&q.drainFlag) == 0 {
+				if adopted := q.resume(); adopted > 0 {
+					q.logger.Info("Adopted %d tasks left by other instances in queue %q.", adopted, q.name)
+				}
+			}
+		}
+	}
 }
 
 // Shutdown stops all queues.
@@ -134,6 +214,10 @@ func (q *queue) Shutdown() {
 	q.logger.Info("Shutting down queue %q...", q.name)
 	defer func() {
 		q.routineGroup.Wait()
+		if q.lease != nil {
+			// Tasks not finished are left in DB for other instances to adopt.
+			q.lease.releaseAll()
+		}
 	}()
 
 	if !atomic.CompareAndSwapInt32(&q.stopFlag, 0, 1) {
@@ -154,6 +238,25 @@ func (q *queue) Shutdown() {
 
 }
 
+func (q *queue) Drain(ctx context.Context) {
+	if !atomic.CompareAndSwapInt32(&q.drainFlag, 0, 1) {
+		return
+	}
+
+	q.logger.Info("Draining queue %q with %d running tasks...", q.name, q.metric.BusyWorkers())
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for q.metric.BusyWorkers() > 0 {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			q.logger.Warning("Drain window of queue %q exceeded, %d running tasks will be interrupted.", q.name, q.metric.BusyWorkers())
+			return
+		}
+	}
+}
+
 // BusyWorkers returns the numbers of workers in the running process.
 func (q *queue) BusyWorkers() int {
 	return int(q.metric.BusyWorkers())
@@ -179,20 +282,49 @@ func (q *queue) SuspendingTasks() int {
 	return int(q.metric.SuspendingTasks())
 }
 
+// PendingTasks returns the numbers of tasks waiting to be picked up in each priority lane.
+func (q *queue) PendingTasks() []int {
+	return q.scheduler.Pending()
+}
+
 // QueueTask to queue single Task
 func (q *queue) QueueTask(ctx context.Context, t Task) error {
 	if atomic.LoadInt32(&q.stopFlag) == 1 {
 		return ErrQueueShutdown
 	}
 
+	if q.lease != nil && t.ID() != 0 && !q.lease.acquire(t.ID()) {
+		return fmt.Errorf("task %d is owned by another instance", t.ID())
+	}
+
+	if key := t.IdempotencyKey(); key != "" && q.taskClient != nil && t.ShouldPersist() && !t.Persisted() {
+		// Keys are only unique within the idempotency window, older Tasks give up their keys.
+		if err := q.taskClient.ReleaseIdempotencyKey(ctx, key, time.Now().Add(-q.idempotencyWindow)); err != nil {
+			return fmt.Errorf("failed to release expired idempotency key: %w", err)
+		}
+
+		if existing, err := q.taskClient.GetTaskByIdempotencyKey(ctx, key); err == nil {
+			return &DuplicateTaskError{Existing: existing}
+		} else if !ent.IsNotFound(err) {
+			return fmt.Errorf("failed to check idempotency key: %w", err)
+		}
+	}
+
 	if t.Status() != task.StatusSuspending {
 		q.metric.IncSubmittedTask()
 		if err := q.transitStatus(ctx, t, task.StatusQueued); err != nil {
+			q.releaseLease(t)
 			return err
 		}
+
+		// New Task adopted by another instance between being saved and leased runs there.
+		if q.lease != nil && t.ShouldPersist() && !q.lease.holds(t.ID()) {
+			return nil
+		}
 	}
 
 	if err := q.scheduler.Queue(t); err != nil {
+		q.releaseLease(t)
 		return err
 	}
 	owner := ""
@@ -209,7 +341,10 @@ func (q *queue) QueueTask(ctx context.Context, t Task) error {
 
 // newContext creates a new context for a new Task iteration.
 func (q *queue) newContext(t Task) context.Context {
-	l := q.logger.CopyWithPrefix(fmt.Sprintf("[Cid: %s TaskID: %d Queue: %s]", t.CorrelationID(), t.ID(), q.name))
+	l := q.logger.CopyWithComponent("queue").
+		CopyWithField(logging.FieldCorrelationID, t.CorrelationID().String()).
+		CopyWithField("task_id", t.ID()).
+		CopyWithField("queue", q.name)
 	ctx := q.dep.ForkWithLogger(q.rootCtx, l)
 	ctx = context.WithValue(ctx, logging.CorrelationIDCtx{}, t.CorrelationID())
 	ctx = context.WithValue(ctx, logging.LoggerCtx{}, l)
@@ -227,6 +362,7 @@ func (q *queue) work(t Task) {
 	// in such case, we start a new goroutine
 	defer func() {
 		q.metric.DecBusyWorker()
+		q.trackRunning(t, -1)
 		e := recover()
 		if e != nil {
 			l.Error("Panic error in queue %q: %v", q.name, e)
@@ -243,15 +379,41 @@ func (q *queue) work(t Task) {
 		panic(err)
 	}
 
+	stopWatch := q.watchCancelSignal(t)
+	defer stopWatch()
+
 	for {
+		if isCanceled(t) {
+			_ = q.transitStatus(ctx, t, task.StatusCanceled)
+			break
+		}
+
 		timeIterationStart = time.Now()
 		var next task.Status
 		next, err = q.run(ctx, t)
+		if errors.Is(err, context.Canceled) && q.rootCtx.Err() != nil {
+			// Interrupted by shutdown, persist it as queued so that it is resumed on next start. Other
+			// errors are handled as usual even if they occur during shutdown.
+			t.OnIterationComplete(time.Since(timeIterationStart))
+			l.Info("Task interrupted by shutdown in queue %q, will be resumed on next start: %s", q.name, err)
+			_ = q.transitStatus(context.WithoutCancel(ctx), t, task.StatusQueued)
+			break
+		}
+
+		if errors.Is(err, ErrTaskCanceled) {
+			t.OnIterationComplete(time.Since(timeIterationStart))
+			l.Info("Task canceled in queue %q.", q.name)
+			_ = q.transitStatus(ctx, t, task.StatusCanceled)
+			break
+		}
+
 		if err != nil {
 			t.OnError(err, time.Since(timeIterationStart))
 			l.Error("runtime error in queue %q: %s", q.name, err.Error())
 
-			_ = q.transitStatus(ctx, t, task.StatusError)
+			if q.transitStatus(ctx, t, task.StatusError) == nil && q.retriesExhausted(t, err) {
+				q.deadLetter(ctx, t)
+			}
 			break
 		}
 
@@ -291,7 +453,7 @@ func (q *queue) run(ctx context.Context, t Task) (task.Status, error) {
 		l.Debug("Iteration started.")
 		next, err := t.Do(ctx)
 		l.Debug("Iteration ended with err=%s", err)
-		if err != nil && q.maxRetry-t.Retried() > 0 && !errors.Is(err, CriticalErr) && atomic.LoadInt32(&q.stopFlag) != 1 {
+		if err != nil && q.maxRetry-t.Retried() > 0 && !errors.Is(err, CriticalErr) && !isCanceled(t) && atomic.LoadInt32(&q.stopFlag) != 1 {
 			// Retry needed
 			t.OnRetry(err)
 			b := &backoff.Backoff{
@@ -321,6 +483,18 @@ func (q *queue) run(ctx context.Context, t Task) (task.Status, error) {
 		panic(p)
 	case <-ctx.Done(): // timeout reached
 		return task.StatusError, ctx.Err()
+	case <-t.Canceled(): // canceled by admin
+		cancel()
+
+		leftTime := q.maxTaskExecution - t.Executed() - time.Since(startTime)
+		// wait job to exit
+		select {
+		case <-time.After(leftTime):
+		case <-done:
+		case p := <-panicChan:
+			panic(p)
+		}
+		return task.StatusCanceled, ErrTaskCanceled
 	case <-q.quit: // shutdown service
 		// cancel job
 		cancel()
@@ -340,14 +514,34 @@ func (q *queue) run(ctx context.Context, t Task) (task.Status, error) {
 	}
 }
 
+// retriesExhausted returns true if the Task failed with a retryable error after all retries are used up.
+func (q *queue) retriesExhausted(t Task, err error) bool {
+	return q.maxRetry > 0 && t.Retried() >= q.maxRetry && !errors.Is(err, CriticalErr)
+}
+
+// deadLetter moves the failed Task into dead-letter queue, its payload and error history are kept in DB
+// for admins to inspect, edit and replay.
+func (q *queue) deadLetter(ctx context.Context, t Task) {
+	if !t.ShouldPersist() || q.taskClient == nil {
+		return
+	}
+
+	if err := q.taskClient.MarkDeadLettered(context.WithoutCancel(ctx), t.ID()); err != nil {
+		logging.FromContext(ctx).Warning("Failed to move task %d into dead-letter queue: %s", t.ID(), err)
+		return
+	}
+
+	logging.FromContext(ctx).Info("Task %d exhausted all retries, moved into dead-letter queue.", t.ID())
+}
+
 // beforeTaskStart updates Task status from queued to processing
-func (q *queue) transitStatus(ctx context.Context, task Task, to task.Status) (err error) {
-	old := task.Status()
-	transition, ok := stateTransitions[task.Status()][to]
+func (q *queue) transitStatus(ctx context.Context, t Task, to task.Status) (err error) {
+	old := t.Status()
+	transition, ok := stateTransitions[t.Status()][to]
 	if !ok {
 		err = fmt.Errorf("invalid state transition from %s to %s", old, to)
 	} else {
-		if innerErr := transition(ctx, task, to, q); innerErr != nil {
+		if innerErr := transition(ctx, t, to, q); innerErr != nil {
 			err = fmt.Errorf("failed to transit Task status from %s to %s: %w", old, to, innerErr)
 		}
 	}
@@ -357,10 +551,123 @@ func (q *queue) transitStatus(ctx context.Context, task Task, to task.Status) (e
 		l.Error(err.Error())
 	}
 
-	l.Info("Task %d status changed from %q to %q.", task.ID(), old, to)
+	l.Info("Task %d status changed from %q to %q.", t.ID(), old, to)
+	if err == nil && (to == task.StatusCompleted || to == task.StatusError || to == task.StatusCanceled) {
+		q.releaseLease(t)
+	}
+	if err == nil && q.onTaskFinished != nil && (to == task.StatusCompleted || to == task.StatusError) {
+		q.onTaskFinished(ctx, t)
+	}
 	return
 }
 
+// releaseLease gives up the lease of a Task once it is no longer handled by current instance.
+func (q *queue) releaseLease(t Task) {
+	if q.lease != nil {
+		q.lease.release(t.ID())
+	}
+}
+
+// SignalCancel requests the Task to be canceled by the instance running it. Queues created with
+// WithCancelSignal poll the signal while the Task is queued or running.
+func SignalCancel(kv cache.Driver, id int) error {
+	return kv.Set(cancelSignalKey(id), true, cancelSignalTTL)
+}
+
+// ClearCancelSignal removes the cancel signal of a Task, so that it can be requeued.
+func ClearCancelSignal(kv cache.Driver, id int) error {
+	return kv.Delete(cancelSignalKVPrefix, strconv.Itoa(id))
+}
+
+func cancelSignalKey(id int) string {
+	return cancelSignalKVPrefix + strconv.Itoa(id)
+}
+
+// watchCancelSignal cancels the Task once a cancel signal is found, until the returned func is called.
+func (q *queue) watchCancelSignal(t Task) func() {
+	if q.cancelSignal == nil {
+		return func() {}
+	}
+
+	signaled := func() bool {
+		_, ok := q.cancelSignal.Get(cancelSignalKey(t.ID()))
+		return ok
+	}
+
+	if signaled() {
+		t.Cancel()
+		return func() {}
+	}
+
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(cancelSignalInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if signaled() {
+					t.Cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	return func() { close(stop) }
+}
+
+// isCanceled returns true if the Task is canceled.
+func isCanceled(t Task) bool {
+	select {
+	case <-t.Canceled():
+		return true
+	default:
+		return false
+	}
+}
+
+// admit returns false if the Task owner already runs as many Tasks in this queue as allowed by the
+// group, so that Tasks of other users are picked up first.
+func (q *queue) admit(t Task) bool {
+	owner := t.Owner()
+	limit := maxConcurrentTasks(owner)
+	if limit <= 0 {
+		return true
+	}
+
+	q.runningMu.Lock()
+	defer q.runningMu.Unlock()
+	return q.running[owner.ID] < limit
+}
+
+// trackRunning updates the number of running Tasks of the Task owner by delta.
+func (q *queue) trackRunning(t Task, delta int) {
+	owner := t.Owner()
+	if owner == nil {
+		return
+	}
+
+	q.runningMu.Lock()
+	defer q.runningMu.Unlock()
+	q.running[owner.ID] += delta
+	if q.running[owner.ID] <= 0 {
+		delete(q.running, owner.ID)
+	}
+}
+
+// maxConcurrentTasks returns the maximum number of running Tasks of given user in a queue, 0 for unlimited.
+func maxConcurrentTasks(u *ent.User) int {
+	if u == nil || u.Edges.Group == nil || u.Edges.Group.Settings == nil {
+		return 0
+	}
+
+	return u.Edges.Group.Settings.MaxConcurrentTasks
+}
+
 // schedule to check worker number
 func (q *queue) schedule() {
 	q.Lock()
@@ -390,10 +697,15 @@ func (q *queue) start() {
 			return
 		}
 
+		if atomic.LoadInt32(&q.drainFlag) == 1 {
+			<-q.quit
+			return
+		}
+
 		// request Task from queue in background
 		q.routineGroup.Run(func() {
 			for {
-				t, err := q.scheduler.Request()
+				t, err := q.scheduler.RequestFor(q.admit)
 				if t == nil || err != nil {
 					if err != nil {
 						select {
@@ -428,7 +740,15 @@ func (q *queue) start() {
 			return
 		}
 
+		if atomic.LoadInt32(&q.drainFlag) == 1 {
+			// Put it back, it is still queued in DB and will be resumed on next start.
+			_ = q.scheduler.Queue(t)
+			<-q.quit
+			return
+		}
+
 		// start new Task
+		q.trackRunning(t, 1)
 		q.metric.IncBusyWorker()
 		q.routineGroup.Run(func() {
 			q.work(t)
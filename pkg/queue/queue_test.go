@@ -0,0 +1,142 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	_ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueue_Admit(t *testing.T) {
+	a := assert.New(t)
+	q := New(logging.NewConsoleLogger(logging.LevelError), nil, nil, nil).(*queue)
+	newOwnedTask := func(owner *ent.User) Task {
+		t := newPrioritizedTestTask(0, PriorityNormal)
+		t.DirectOwner = owner
+		return t
+	}
+
+	limited := &ent.User{ID: 1, Edges: ent.UserEdges{Group: &ent.Group{
+		Settings: &types.GroupSetting{MaxConcurrentTasks: 1},
+	}}}
+	unlimited := &ent.User{ID: 2, Edges: ent.UserEdges{Group: &ent.Group{
+		Settings: &types.GroupSetting{},
+	}}}
+
+	running := newOwnedTask(limited)
+	a.True(q.admit(running))
+	q.trackRunning(running, 1)
+	a.False(q.admit(newOwnedTask(limited)))
+
+	// Other users and users without quota are not affected.
+	q.trackRunning(newOwnedTask(unlimited), 1)
+	a.True(q.admit(newOwnedTask(unlimited)))
+	a.True(q.admit(newOwnedTask(nil)))
+
+	q.trackRunning(running, -1)
+	a.True(q.admit(newOwnedTask(limited)))
+}
+
+func TestQueue_RetriesExhausted(t *testing.T) {
+	a := assert.New(t)
+	q := New(logging.NewConsoleLogger(logging.LevelError), nil, nil, nil, WithMaxRetry(2)).(*queue)
+	failed := newPrioritizedTestTask(1, PriorityNormal)
+	err := errors.New("failed")
+
+	a.False(q.retriesExhausted(failed, err))
+	failed.OnRetry(err)
+	failed.OnRetry(err)
+	a.True(q.retriesExhausted(failed, err))
+	a.False(q.retriesExhausted(failed, fmt.Errorf("invalid state: %w", CriticalErr)))
+
+	noRetry := New(logging.NewConsoleLogger(logging.LevelError), nil, nil, nil).(*queue)
+	a.False(noRetry.retriesExhausted(failed, err))
+}
+
+// idempotencyTestTaskClient hides existing tasks from the first lookup by idempotency key, as if another
+// submission with the same key was saved right after the lookup.
+type idempotencyTestTaskClient struct {
+	inventory.TaskClient
+	hideOnce bool
+}
+
+func (c *idempotencyTestTaskClient) GetTaskByIdempotencyKey(ctx context.Context, key string) (*ent.Task, error) {
+	if c.hideOnce {
+		c.hideOnce = false
+		return nil, &ent.NotFoundError{}
+	}
+
+	return c.TaskClient.GetTaskByIdempotencyKey(ctx, key)
+}
+
+func TestQueue_IdempotencyKey(t *testing.T) {
+	a := assert.New(t)
+	drv, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	group := client.Group.Create().SetName("users").SetPermissions(&boolset.BooleanSet{}).SaveX(ctx)
+	owner := client.User.Create().SetEmail("a@cloudreve.org").SetNick("a").SetGroupUsers(group.ID).SaveX(ctx)
+	ctx = context.WithValue(ctx, logging.CorrelationIDCtx{}, uuid.Must(uuid.NewV4()))
+	taskClient := &idempotencyTestTaskClient{TaskClient: inventory.NewTaskClient(client, conf.SQLiteDB, nil)}
+	q := New(logging.NewConsoleLogger(logging.LevelError), taskClient, nil, nil, WithIdempotencyWindow(time.Hour)).(*queue)
+
+	submit := func(key string) (*leaseTestTask, error) {
+		newTask := &leaseTestTask{DBTask: &DBTask{DirectOwner: owner, Task: &ent.Task{Type: "test", PublicState: &types.TaskPublicState{}}}}
+		SetIdempotencyKey(newTask, key)
+		return newTask, q.QueueTask(ctx, newTask)
+	}
+
+	first, err := submit("key")
+	a.NoError(err)
+	a.NotZero(first.ID())
+
+	// Duplicated submission is rejected with the existing task
+	var duplicated *DuplicateTaskError
+	_, err = submit("key")
+	if a.ErrorAs(err, &duplicated) {
+		a.Equal(first.ID(), duplicated.Existing.ID)
+	}
+
+	// Submission losing the race is rejected by the unique constraint
+	taskClient.hideOnce = true
+	_, err = submit("key")
+	if a.ErrorAs(err, &duplicated) {
+		a.Equal(first.ID(), duplicated.Existing.ID)
+	}
+
+	other, err := submit("other")
+	a.NoError(err)
+	a.NotEqual(first.ID(), other.ID())
+	a.Equal(2, client.Task.Query().CountX(ctx))
+
+	// Key is used by new task once the window passes
+	_, err = drv.DB().ExecContext(ctx, "UPDATE tasks SET created_at = ? WHERE id = ?", time.Now().Add(-2*time.Hour), first.ID())
+	a.NoError(err)
+	second, err := submit("key")
+	a.NoError(err)
+	a.NotEqual(first.ID(), second.ID())
+	a.True(client.Task.Query().Where(task.ID(first.ID()), task.IdempotencyKeyIsNil()).ExistX(ctx))
+	a.Equal("key", second.IdempotencyKey())
+}
@@ -13,6 +13,8 @@ type (
 		Set(id int, t Task)
 		// Delete deletes the Task by ID.
 		Delete(id int)
+		// List returns all tracked Tasks.
+		List() []Task
 	}
 
 	taskRegistry struct {
@@ -58,3 +60,14 @@ func (r *taskRegistry) Delete(id int) {
 
 	delete(r.tasks, id)
 }
+
+func (r *taskRegistry) List() []Task {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tasks := make([]Task, 0, len(r.tasks))
+	for _, t := range r.tasks {
+		tasks = append(tasks, t)
+	}
+	return tasks
+}
@@ -3,6 +3,7 @@ package queue
 import (
 	"errors"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/samber/lo"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -23,8 +24,17 @@ type (
 		Queue(task Task) error
 		// Request get a new Task from the queue
 		Request() (Task, error)
+		// RequestFor get a new Task accepted by admit from the queue, rejected Tasks are kept queued.
+		RequestFor(admit func(Task) bool) (Task, error)
 		// Shutdown stop all worker
 		Shutdown() error
+		// Pending returns the numbers of Tasks waiting in each priority lane.
+		Pending() []int
+	}
+	// PrioritizedTask is a Task with priority, only honored by the priority scheduler.
+	PrioritizedTask interface {
+		// Priority returns the lane of the Task, smaller value means higher priority.
+		Priority() int
 	}
 	fifoScheduler struct {
 		sync.Mutex
@@ -36,6 +46,14 @@ type (
 		stopOnce  sync.Once
 		stopFlag  int32
 	}
+	priorityScheduler struct {
+		sync.Mutex
+		lanes    [][]Task
+		capacity int
+		count    int
+		logger   logging.Logger
+		stopFlag int32
+	}
 	taskHeap []Task
 )
 
@@ -58,6 +76,11 @@ func (s *fifoScheduler) Queue(task Task) error {
 
 // Request a new Task from channel
 func (s *fifoScheduler) Request() (Task, error) {
+	return s.RequestFor(nil)
+}
+
+// RequestFor a new Task from channel, only the next Task is considered.
+func (s *fifoScheduler) RequestFor(admit func(Task) bool) (Task, error) {
 	if atomic.LoadInt32(&s.stopFlag) == 1 {
 		return nil, ErrQueueShutdown
 	}
@@ -66,7 +89,8 @@ func (s *fifoScheduler) Request() (Task, error) {
 		return nil, ErrNoTaskInQueue
 	}
 	s.Lock()
-	if s.taskQueue[s.taskQueue.Len()-1].ResumeTime() > time.Now().Unix() {
+	next := s.taskQueue[s.taskQueue.Len()-1]
+	if next.ResumeTime() > time.Now().Unix() || (admit != nil && !admit(next)) {
 		s.Unlock()
 		return nil, ErrNoTaskInQueue
 	}
@@ -87,6 +111,13 @@ func (s *fifoScheduler) Shutdown() error {
 	return nil
 }
 
+// Pending returns the number of queued Tasks in the only lane.
+func (s *fifoScheduler) Pending() []int {
+	s.Lock()
+	defer s.Unlock()
+	return []int{s.count}
+}
+
 // NewFifoScheduler for create new Scheduler instance
 func NewFifoScheduler(queueSize int, logger logging.Logger) Scheduler {
 	w := &fifoScheduler{
@@ -98,6 +129,87 @@ func NewFifoScheduler(queueSize int, logger logging.Logger) Scheduler {
 	return w
 }
 
+// NewPriorityScheduler creates a Scheduler with given number of lanes. Tasks in a lane are only requested
+// when all lanes with higher priority are empty, Tasks in the same lane are served first in first out.
+// Tasks not implementing PrioritizedTask are put into the lowest priority lane.
+func NewPriorityScheduler(lanes, queueSize int, logger logging.Logger) Scheduler {
+	return &priorityScheduler{
+		lanes:    make([][]Task, max(lanes, 1)),
+		capacity: queueSize,
+		logger:   logger,
+	}
+}
+
+func (s *priorityScheduler) Queue(task Task) error {
+	if atomic.LoadInt32(&s.stopFlag) == 1 {
+		return ErrQueueShutdown
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	if s.capacity > 0 && s.count >= s.capacity {
+		return ErrMaxCapacity
+	}
+
+	lane := len(s.lanes) - 1
+	if p, ok := task.(PrioritizedTask); ok {
+		lane = min(max(p.Priority(), 0), lane)
+	}
+
+	s.lanes[lane] = append(s.lanes[lane], task)
+	s.count++
+	return nil
+}
+
+func (s *priorityScheduler) Request() (Task, error) {
+	return s.RequestFor(nil)
+}
+
+func (s *priorityScheduler) RequestFor(admit func(Task) bool) (Task, error) {
+	if atomic.LoadInt32(&s.stopFlag) == 1 {
+		return nil, ErrQueueShutdown
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	now := time.Now().Unix()
+	for i, lane := range s.lanes {
+		for j, t := range lane {
+			// Tasks suspended for retry are skipped until they are due.
+			if t.ResumeTime() > now {
+				continue
+			}
+
+			// Tasks not admitted, e.g. owner running out of quota, give way to the ones behind.
+			if admit != nil && !admit(t) {
+				continue
+			}
+
+			s.lanes[i] = append(lane[:j:j], lane[j+1:]...)
+			s.count--
+			return t, nil
+		}
+	}
+
+	return nil, ErrNoTaskInQueue
+}
+
+func (s *priorityScheduler) Shutdown() error {
+	if !atomic.CompareAndSwapInt32(&s.stopFlag, 0, 1) {
+		return ErrQueueShutdown
+	}
+
+	return nil
+}
+
+func (s *priorityScheduler) Pending() []int {
+	s.Lock()
+	defer s.Unlock()
+	return lo.Map(s.lanes, func(lane []Task, _ int) int {
+		return len(lane)
+	})
+}
+
 // Implement heap.Interface
 func (h taskHeap) Len() int {
 	return len(h)
@@ -0,0 +1,94 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+type prioritizedTestTask struct {
+	*InMemoryTask
+	priority int
+}
+
+func (t *prioritizedTestTask) Do(ctx context.Context) (task.Status, error) {
+	return task.StatusCompleted, nil
+}
+
+func (t *prioritizedTestTask) Priority() int {
+	return t.priority
+}
+
+func newPrioritizedTestTask(id, priority int) *prioritizedTestTask {
+	return &prioritizedTestTask{
+		InMemoryTask: &InMemoryTask{DBTask: &DBTask{Task: &ent.Task{ID: id, PublicState: &types.TaskPublicState{}}}},
+		priority:     priority,
+	}
+}
+
+func TestPriorityScheduler(t *testing.T) {
+	a := assert.New(t)
+	s := NewPriorityScheduler(3, 0, logging.NewConsoleLogger(logging.LevelError))
+
+	suspended := newPrioritizedTestTask(1, 0)
+	suspended.OnSuspend(time.Now().Add(time.Hour).Unix())
+	a.NoError(s.Queue(suspended))
+	a.NoError(s.Queue(newPrioritizedTestTask(2, 2)))
+	a.NoError(s.Queue(newPrioritizedTestTask(3, 1)))
+	a.NoError(s.Queue(newPrioritizedTestTask(4, 1)))
+	// Out of range priority falls into the nearest lane
+	a.NoError(s.Queue(newPrioritizedTestTask(5, 9)))
+	a.Equal([]int{1, 2, 2}, s.Pending())
+
+	for _, expected := range []int{3, 4, 2, 5} {
+		next, err := s.Request()
+		a.NoError(err)
+		a.Equal(expected, next.ID())
+	}
+
+	_, err := s.Request()
+	a.ErrorIs(err, ErrNoTaskInQueue)
+	a.Equal([]int{1, 0, 0}, s.Pending())
+
+	a.NoError(s.Shutdown())
+	a.ErrorIs(s.Queue(newPrioritizedTestTask(6, 0)), ErrQueueShutdown)
+}
+
+func TestPriorityScheduler_RequestFor(t *testing.T) {
+	a := assert.New(t)
+	s := NewPriorityScheduler(3, 0, logging.NewConsoleLogger(logging.LevelError))
+
+	a.NoError(s.Queue(newPrioritizedTestTask(1, 0)))
+	a.NoError(s.Queue(newPrioritizedTestTask(2, 0)))
+	a.NoError(s.Queue(newPrioritizedTestTask(3, 2)))
+
+	// Rejected Tasks are kept queued and give way to the ones behind.
+	next, err := s.RequestFor(func(t Task) bool { return t.ID() != 1 })
+	a.NoError(err)
+	a.Equal(2, next.ID())
+
+	_, err = s.RequestFor(func(t Task) bool { return false })
+	a.ErrorIs(err, ErrNoTaskInQueue)
+	a.Equal([]int{1, 0, 1}, s.Pending())
+
+	next, err = s.Request()
+	a.NoError(err)
+	a.Equal(1, next.ID())
+}
+
+func TestDBTask_Priority(t *testing.T) {
+	a := assert.New(t)
+	newTask := func(taskType string) *DBTask {
+		return &DBTask{Task: &ent.Task{Type: taskType, PublicState: &types.TaskPublicState{}}}
+	}
+
+	a.Equal(PriorityInteractive, newTask(MediaMetaTaskType).Priority())
+	a.Equal(PriorityNormal, newTask(RemoteDownloadTaskType).Priority())
+	a.Equal(PriorityBackground, newTask(CreateArchiveTaskType).Priority())
+}
@@ -48,6 +48,8 @@ type (
 		Model() *ent.Task
 		// CorrelationID returns the correlation ID of the Task
 		CorrelationID() uuid.UUID
+		// IdempotencyKey returns the key used to deduplicate submissions of the Task, empty if not set
+		IdempotencyKey() string
 		// ResumeTime returns the time when the Task is resumed
 		ResumeTime() int64
 		// ResumeAfter sets the time when the Task should be resumed
@@ -68,6 +70,13 @@ type (
 		// OnStatusTransition is called when the Task status is changed
 		OnStatusTransition(newStatus task.Status)
 
+		// OnRequeue is called before a failed or canceled Task is queued again
+		OnRequeue()
+		// Cancel requests the Task to stop, the running iteration is interrupted
+		Cancel()
+		// Canceled returns a channel that is closed once the Task is canceled
+		Canceled() <-chan struct{}
+
 		// Cleanup is called when the Task is done or error.
 		Cleanup(ctx context.Context) error
 
@@ -96,6 +105,8 @@ var (
 
 const (
 	MediaMetaTaskType             = "media_meta"
+	OcrTaskType                   = "ocr"
+	ShareModerationTaskType       = "share_moderation"
 	EntityRecycleRoutineTaskType  = "entity_recycle_routine"
 	ExplicitEntityRecycleTaskType = "explicit_entity_recycle"
 	UploadSentinelCheckTaskType   = "upload_sentinel_check"
@@ -104,12 +115,55 @@ const (
 	RelocateTaskType              = "relocate"
 	RemoteDownloadTaskType        = "remote_download"
 	ImportTaskType                = "import"
+	TranscodeTaskType             = "transcode"
+	OffboardExportTaskType        = "offboard_export"
+	ThumbPregenTaskType           = "thumb_pregen"
+	SearchIndexTaskType           = "search_index"
+	FolderSyncTaskType            = "folder_sync"
+	UrlImportTaskType             = "url_import"
+	TakeoutTaskType               = "takeout"
 
 	SlaveCreateArchiveTaskType = "slave_create_archive"
 	SlaveUploadTaskType        = "slave_upload"
 	SlaveExtractArchiveType    = "slave_extract_archive"
+	SlaveTranscodeTaskType     = "slave_transcode"
+)
+
+// Priority lanes of thumbnail pregeneration, thumbnails of files being browsed are generated first, then
+// the newly uploaded ones, existing files are backfilled when idle.
+const (
+	ThumbPregenPriorityVisible = iota
+	ThumbPregenPriorityRecent
+	ThumbPregenPriorityBackfill
+	ThumbPregenPriorityLanes
 )
 
+// Priority classes of Tasks. Tasks users are waiting for are served first, bulk jobs only run when no
+// other Tasks are waiting in the same queue.
+const (
+	PriorityInteractive = iota
+	PriorityNormal
+	PriorityBackground
+	PriorityLanes
+)
+
+// taskPriorities are priority classes of Task types, types not listed are PriorityNormal.
+var taskPriorities = map[string]int{
+	MediaMetaTaskType:             PriorityInteractive,
+	ShareModerationTaskType:       PriorityInteractive,
+	UploadSentinelCheckTaskType:   PriorityInteractive,
+	ExplicitEntityRecycleTaskType: PriorityInteractive,
+	CreateArchiveTaskType:         PriorityBackground,
+	ExtractArchiveTaskType:        PriorityBackground,
+	SlaveCreateArchiveTaskType:    PriorityBackground,
+	SlaveExtractArchiveType:       PriorityBackground,
+	OffboardExportTaskType:        PriorityBackground,
+	TakeoutTaskType:               PriorityBackground,
+	FolderSyncTaskType:            PriorityBackground,
+	EntityRecycleRoutineTaskType:  PriorityBackground,
+	SearchIndexTaskType:           PriorityBackground,
+}
+
 func init() {
 	gob.Register(Progresses{})
 }
@@ -119,6 +173,13 @@ func RegisterResumableTaskFactory(taskType string, factory ResumableTaskFactory)
 	taskFactories.Store(taskType, factory)
 }
 
+// SetIdempotencyKey sets the idempotency key of a Task not yet submitted, see DBTask.SetIdempotencyKey.
+func SetIdempotencyKey(t Task, key string) {
+	if keyed, ok := t.(interface{ SetIdempotencyKey(key string) }); ok {
+		keyed.SetIdempotencyKey(key)
+	}
+}
+
 // NewTaskFromModel creates a Task from ent.Task model
 func NewTaskFromModel(model *ent.Task) (Task, error) {
 	if factory, ok := taskFactories.Load(model.Type); ok {
@@ -151,7 +212,8 @@ type DBTask struct {
 	DirectOwner *ent.User
 	Task        *ent.Task
 
-	mu sync.Mutex
+	mu       sync.Mutex
+	canceled chan struct{}
 }
 
 func (t *DBTask) ID() int {
@@ -274,6 +336,27 @@ func (t *DBTask) CorrelationID() uuid.UUID {
 	return uuid.Nil
 }
 
+func (t *DBTask) IdempotencyKey() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.Task != nil && t.Task.IdempotencyKey != nil {
+		return *t.Task.IdempotencyKey
+	}
+	return ""
+}
+
+// SetIdempotencyKey sets the idempotency key of a Task not yet submitted. Submitting another Task with
+// the same key within the idempotency window fails with DuplicateTaskError.
+func (t *DBTask) SetIdempotencyKey(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.Task != nil && key != "" {
+		t.Task.IdempotencyKey = &key
+	}
+}
+
 func (t *DBTask) ShouldPersist() bool {
 	return true
 }
@@ -328,6 +411,15 @@ func (t *DBTask) ResumeTime() int64 {
 	return 0
 }
 
+// Priority returns the priority class of the Task type.
+func (t *DBTask) Priority() int {
+	if p, ok := taskPriorities[t.Type()]; ok {
+		return p
+	}
+
+	return PriorityNormal
+}
+
 func (t *DBTask) OnSuspend(time int64) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
@@ -345,6 +437,43 @@ func (t *DBTask) OnStatusTransition(newStatus task.Status) {
 	// Nop
 }
 
+func (t *DBTask) OnRequeue() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.canceled = nil
+	if t.Task != nil {
+		t.Task.PublicState.Error = ""
+		t.Task.PublicState.RetryCount = 0
+		t.Task.PublicState.ResumeTime = 0
+	}
+}
+
+func (t *DBTask) Cancel() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.canceled == nil {
+		t.canceled = make(chan struct{})
+	}
+
+	select {
+	case <-t.canceled:
+	default:
+		close(t.canceled)
+	}
+}
+
+func (t *DBTask) Canceled() <-chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.canceled == nil {
+		t.canceled = make(chan struct{})
+	}
+	return t.canceled
+}
+
 func (t *DBTask) Lock() {
 	t.mu.Lock()
 }
@@ -453,6 +582,13 @@ func init() {
 				return q.QueueTask(ctx, task)
 			},
 		},
+		// Failed or canceled tasks can be requeued manually
+		task.StatusError: {
+			task.StatusQueued: persistTask,
+		},
+		task.StatusCanceled: {
+			task.StatusQueued: persistTask,
+		},
 		task.StatusSuspending: {
 			task.StatusProcessing: func(ctx context.Context, task Task, newStatus task.Status, q *queue) error {
 				q.metric.DecSuspendingTask()
@@ -503,9 +639,10 @@ func saveTaskToInventory(ctx context.Context, task Task, newStatus task.Status,
 			Error:            errStr,
 			ResumeTime:       task.ResumeTime(),
 		},
-		PrivateState:  task.State(),
-		OwnerID:       task.Owner().ID,
-		CorrelationID: logging.CorrelationID(ctx),
+		PrivateState:   task.State(),
+		OwnerID:        task.Owner().ID,
+		CorrelationID:  logging.CorrelationID(ctx),
+		IdempotencyKey: task.IdempotencyKey(),
 	}
 
 	var (
@@ -515,6 +652,12 @@ func saveTaskToInventory(ctx context.Context, task Task, newStatus task.Status,
 
 	if !task.Persisted() {
 		res, err = q.taskClient.New(ctx, args)
+		if err != nil && args.IdempotencyKey != "" && ent.IsConstraintError(err) {
+			// Lost the race to another submission with the same key
+			if existing, lookupErr := q.taskClient.GetTaskByIdempotencyKey(ctx, args.IdempotencyKey); lookupErr == nil {
+				return &DuplicateTaskError{Existing: existing}
+			}
+		}
 	} else {
 		res, err = q.taskClient.Update(ctx, task.Model(), args)
 	}
@@ -522,6 +665,12 @@ func saveTaskToInventory(ctx context.Context, task Task, newStatus task.Status,
 		return fmt.Errorf("failed to persist Task into DB: %w", err)
 	}
 
+	// A new Task is visible to other instances once saved, take its lease right away so that
+	// it is not adopted by them.
+	if q.lease != nil && !task.Persisted() && !q.lease.acquire(res.ID) {
+		q.logger.Warning("Lease of new task %d is taken by another instance.", res.ID)
+	}
+
 	task.OnPersisted(res)
 	return nil
 }
@@ -0,0 +1,44 @@
+package ratelimit
+
+import (
+	"io"
+	"sync"
+
+	"github.com/juju/ratelimit"
+)
+
+// BandwidthPool shares bandwidth among concurrent transfers of the same subject within current
+// instance.
+type BandwidthPool struct {
+	mu      sync.Mutex
+	buckets map[string]*ratelimit.Bucket
+}
+
+// NewBandwidthPool creates an empty BandwidthPool.
+func NewBandwidthPool() *BandwidthPool {
+	return &BandwidthPool{buckets: make(map[string]*ratelimit.Bucket)}
+}
+
+// bucket returns the token bucket of subject, a new one is created if rate is changed.
+func (p *BandwidthPool) bucket(subject string, rate int64) *ratelimit.Bucket {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	b, ok := p.buckets[subject]
+	if !ok || b.Capacity() != rate {
+		b = ratelimit.NewBucketWithRate(float64(rate), rate)
+		p.buckets[subject] = b
+	}
+
+	return b
+}
+
+// Reader limits r to rate bytes per second shared with other transfers of subject.
+func (p *BandwidthPool) Reader(subject string, rate int64, r io.Reader) io.Reader {
+	return ratelimit.Reader(r, p.bucket(subject, rate))
+}
+
+// Writer limits w to rate bytes per second shared with other transfers of subject.
+func (p *BandwidthPool) Writer(subject string, rate int64, w io.Writer) io.Writer {
+	return ratelimit.Writer(w, p.bucket(subject, rate))
+}
@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+// Names of rate limited route groups, each can be configured by `rate_limit_<name>` setting.
+const (
+	Login         = "login"
+	SharePassword = "share_password"
+	Search        = "search"
+	// ShareSearch limits searching inside shares, which is open to anonymous visitors.
+	ShareSearch   = "share_search"
+	Thumbnail     = "thumbnail"
+	DownloadToken = "download_token"
+	// DavAccount limits requests of a WebDAV account, the rule is configured per account.
+	DavAccount = "dav_account"
+)
+
+const kvPrefix = "rate_limit_"
+
+// Result is the state of a rate limit after a request is counted.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset seconds until current window ends.
+	Reset int
+}
+
+// Allow counts a request of subject against the rule using a sliding window counter, which
+// weights the count of previous fixed window by its overlap with the sliding window. Counters
+// are increased atomically, and rejected requests are not counted.
+func Allow(kv cache.Driver, name string, rule *setting.RateLimitRule, subject string, now time.Time) (*Result, error) {
+	window := time.Duration(rule.Window) * time.Second
+	current := now.UnixNano() / int64(window)
+	elapsed := time.Duration(now.UnixNano() % int64(window))
+
+	prefix := fmt.Sprintf("%s%s_%s_", kvPrefix, name, subject)
+	currentKey, previousKey := prefix+strconv.FormatInt(current, 10), prefix+strconv.FormatInt(current-1, 10)
+
+	// Keep the counter until it is no longer the previous window.
+	currentCount, err := kv.Incr(currentKey, 1, rule.Window*2)
+	if err != nil {
+		return nil, fmt.Errorf("failed to increase rate limit counter: %w", err)
+	}
+
+	previousCount, err := kv.Incr(previousKey, 0, rule.Window)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rate limit counter: %w", err)
+	}
+
+	weight := 1 - float64(elapsed)/float64(window)
+	estimated := int(math.Floor(float64(previousCount)*weight)) + currentCount
+	res := &Result{
+		Limit: rule.Limit,
+		Reset: int(math.Ceil((window - elapsed).Seconds())),
+	}
+
+	if estimated > rule.Limit {
+		if _, err := kv.Incr(currentKey, -1, rule.Window*2); err != nil {
+			return nil, fmt.Errorf("failed to decrease rate limit counter: %w", err)
+		}
+
+		return res, nil
+	}
+
+	res.Allowed = true
+	res.Remaining = rule.Limit - estimated
+	return res, nil
+}
@@ -0,0 +1,60 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllow(t *testing.T) {
+	a := assert.New(t)
+	kv := cache.NewMemoStore("", nil)
+	rule := &setting.RateLimitRule{Limit: 2, Window: 60}
+	start := time.Unix(6000, 0)
+
+	res, err := Allow(kv, Login, rule, "1.1.1.1", start)
+	a.NoError(err)
+	a.True(res.Allowed)
+	a.Equal(1, res.Remaining)
+	a.Equal(60, res.Reset)
+
+	res, err = Allow(kv, Login, rule, "1.1.1.1", start.Add(time.Second))
+	a.NoError(err)
+	a.True(res.Allowed)
+	a.Equal(0, res.Remaining)
+
+	res, err = Allow(kv, Login, rule, "1.1.1.1", start.Add(2*time.Second))
+	a.NoError(err)
+	a.False(res.Allowed)
+	a.Equal(58, res.Reset)
+
+	// Other subjects are counted separately.
+	res, err = Allow(kv, Login, rule, "2.2.2.2", start.Add(2*time.Second))
+	a.NoError(err)
+	a.True(res.Allowed)
+
+	// Previous window still weights at the beginning of next window.
+	res, err = Allow(kv, Login, rule, "1.1.1.1", start.Add(60*time.Second))
+	a.NoError(err)
+	a.False(res.Allowed)
+
+	res, err = Allow(kv, Login, rule, "1.1.1.1", start.Add(90*time.Second))
+	a.NoError(err)
+	a.True(res.Allowed)
+	a.Equal(0, res.Remaining)
+}
+
+func TestBandwidthPool(t *testing.T) {
+	a := assert.New(t)
+	p := NewBandwidthPool()
+
+	b := p.bucket("1", 1024)
+	a.Same(b, p.bucket("1", 1024))
+	a.NotSame(b, p.bucket("2", 1024))
+
+	// Bucket is replaced once the rate is changed.
+	a.EqualValues(2048, p.bucket("1", 2048).Capacity())
+}
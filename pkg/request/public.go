@@ -0,0 +1,52 @@
+package request
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// ErrNonPublicAddress is returned when dialing an address not reachable from the public internet.
+var ErrNonPublicAddress = errors.New("connecting to non-public address is not allowed")
+
+// NewPublicTransport returns a transport that only connects to public addresses, used to fetch URLs
+// supplied by users. Addresses are checked after DNS resolution on every dial, so redirects and DNS
+// rebinding cannot reach loopback, private or link-local services either. Proxies from environment
+// are not used, as the proxy itself would be dialed instead of the target.
+func NewPublicTransport() *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   publicAddressControl,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return transport
+}
+
+// publicAddressControl rejects connections to non-public addresses before they are made.
+func publicAddressControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	if ip := net.ParseIP(host); ip == nil || !IsPublicIP(ip) {
+		return fmt.Errorf("%w: %s", ErrNonPublicAddress, host)
+	}
+
+	return nil
+}
+
+// IsPublicIP returns whether ip is a global unicast address outside of private ranges.
+func IsPublicIP(ip net.IP) bool {
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !sharedAddressSpace.Contains(ip)
+}
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), not covered by net.IP.IsPrivate.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
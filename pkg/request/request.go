@@ -18,7 +18,10 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/tracing"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // GeneralClient 通用 HTTP Client
@@ -155,8 +158,22 @@ func (c *HTTPClient) Request(method, target string, body io.Reader, opts ...Opti
 		req.Header.Add(CorrelationHeader, logging.CorrelationID(options.ctx).String())
 	}
 
-	mode := c.config.System().Mode
-	if options.masterMeta && mode == conf.MasterMode {
+	// Propagate trace context, slave nodes continue the trace from master.
+	var span trace.Span
+	if options.ctx != nil {
+		var ctx context.Context
+		ctx, span = tracing.Start(options.ctx, "HTTP "+method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				attribute.String("http.request.method", method),
+				attribute.String("server.address", req.URL.Host),
+			),
+		)
+		req = req.WithContext(ctx)
+		tracing.Inject(ctx, req.Header)
+	}
+
+	if options.masterMeta && c.config != nil && c.config.System().Mode == conf.MasterMode {
 		req.Header.Add(SiteURLHeader, options.siteURL)
 		req.Header.Add(SiteIDHeader, options.siteID)
 		req.Header.Add(SiteVersionHeader, constants.BackendVersion)
@@ -193,6 +210,12 @@ func (c *HTTPClient) Request(method, target string, body io.Reader, opts ...Opti
 
 	// 发送请求
 	resp, err := client.Do(req)
+	if span != nil {
+		if resp != nil {
+			span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		}
+		tracing.End(span, err)
+	}
 
 	// Logging request
 	if options.logger != nil {
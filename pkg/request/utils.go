@@ -44,6 +44,11 @@ type limitReaderCloser struct {
 	read int64
 }
 
+// NewLimitReaderCloser wraps r to read at most limit bytes and count bytes read.
+func NewLimitReaderCloser(r io.ReadCloser, limit int64) LimitReaderCloser {
+	return newLimitReaderCloser(r, limit)
+}
+
 func newLimitReaderCloser(r io.ReadCloser, limit int64) LimitReaderCloser {
 	return &limitReaderCloser{
 		Reader: io.LimitReader(r, limit),
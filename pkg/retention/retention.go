@@ -0,0 +1,97 @@
+// Package retention evaluates folder retention rules against files, rules are executed by the scheduler
+// and previewed before enabling.
+package retention
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+)
+
+const (
+	// MaxAgeDays is the upper bound of max age of files, about 100 years.
+	MaxAgeDays = 36500
+	// MaxKeepVersions is the upper bound of versions kept by a rule.
+	MaxKeepVersions = 1000
+)
+
+// Version is a version of a file.
+type Version struct {
+	ID        int
+	CreatedAt time.Time
+}
+
+// Validate checks the folder and cleanup settings of a rule.
+func Validate(folder string, maxAgeDays, keepVersions int) error {
+	uri, err := fs.NewUriFromString(folder)
+	if err != nil {
+		return err
+	}
+
+	if uri.FileSystem() != constants.FileSystemMy {
+		return errors.New("folder must be in my files")
+	}
+
+	if maxAgeDays < 0 || maxAgeDays > MaxAgeDays {
+		return errors.New("invalid max age")
+	}
+
+	if keepVersions < 0 || keepVersions > MaxKeepVersions {
+		return errors.New("invalid number of versions to keep")
+	}
+
+	if maxAgeDays == 0 && keepVersions == 0 {
+		return errors.New("either max age or number of versions to keep is required")
+	}
+
+	return nil
+}
+
+// Expired returns whether a file last modified at updatedAt exceeds max age. Zero max age never expires.
+func Expired(updatedAt, now time.Time, maxAgeDays int) bool {
+	if maxAgeDays <= 0 {
+		return false
+	}
+
+	return updatedAt.Before(now.AddDate(0, 0, -maxAgeDays))
+}
+
+// StaleVersions returns IDs of versions to delete so that only the latest keep versions remain. The
+// current version is always kept and counted. Zero keep retains all versions.
+func StaleVersions(current int, versions []Version, keep int) []int {
+	if keep <= 0 || len(versions) <= keep {
+		return nil
+	}
+
+	sorted := make([]Version, len(versions))
+	copy(sorted, versions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.After(sorted[j].CreatedAt)
+	})
+
+	kept := 0
+	for _, v := range sorted {
+		if v.ID == current {
+			kept++
+		}
+	}
+
+	var stale []int
+	for _, v := range sorted {
+		if v.ID == current {
+			continue
+		}
+
+		if kept < keep {
+			kept++
+			continue
+		}
+
+		stale = append(stale, v.ID)
+	}
+
+	return stale
+}
@@ -0,0 +1,47 @@
+package retention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	a := assert.New(t)
+
+	a.NoError(Validate("cloudreve://my/Logs", 30, 0))
+	a.NoError(Validate("cloudreve://my/Logs", 0, 3))
+	a.Error(Validate("cloudreve://my/Logs", 0, 0))
+	a.Error(Validate("cloudreve://my/Logs", -1, 3))
+	a.Error(Validate("cloudreve://my/Logs", 30, -1))
+	a.Error(Validate("cloudreve://trash", 30, 0))
+	a.Error(Validate("%zz", 30, 0))
+}
+
+func TestExpired(t *testing.T) {
+	a := assert.New(t)
+	now := time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC)
+
+	a.False(Expired(now.AddDate(0, 0, -40), now, 0))
+	a.True(Expired(now.AddDate(0, 0, -31), now, 30))
+	a.False(Expired(now.AddDate(0, 0, -29), now, 30))
+}
+
+func TestStaleVersions(t *testing.T) {
+	a := assert.New(t)
+	base := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	versions := []Version{
+		{ID: 1, CreatedAt: base},
+		{ID: 2, CreatedAt: base.Add(time.Hour)},
+		{ID: 3, CreatedAt: base.Add(2 * time.Hour)},
+		{ID: 4, CreatedAt: base.Add(3 * time.Hour)},
+	}
+
+	a.Nil(StaleVersions(4, versions, 0))
+	a.Nil(StaleVersions(4, versions, 4))
+	a.Equal([]int{2, 1}, StaleVersions(4, versions, 2))
+	// Current version is kept even if it is not the latest one.
+	a.Equal([]int{3, 2}, StaleVersions(1, versions, 2))
+	a.Equal([]int{4, 3, 2}, StaleVersions(1, versions, 1))
+}
@@ -0,0 +1,80 @@
+// Package rpcerr converts errors between serializer.AppError and gRPC status, shared by the gRPC API
+// and the master-slave gRPC transport.
+package rpcerr
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// CodeKey trailer key of the Cloudreve error code of a failed call.
+const CodeKey = "cr-error-code"
+
+// ToStatus converts handler error to gRPC status, setting error code of AppError in trailer.
+func ToStatus(err error, setTrailer func(metadata.MD)) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var appErr serializer.AppError
+	if !errors.As(err, &appErr) {
+		return status.Error(codes.Unknown, err.Error())
+	}
+
+	code := appErr.ErrCode()
+	setTrailer(metadata.Pairs(CodeKey, strconv.Itoa(code)))
+	return status.Error(statusCode(code), err.Error())
+}
+
+// FromStatus converts gRPC error back to AppError if error code is set in trailer.
+func FromStatus(err error, trailer metadata.MD) error {
+	if err == nil {
+		return nil
+	}
+
+	values := trailer.Get(CodeKey)
+	if len(values) == 0 {
+		return err
+	}
+
+	code, convErr := strconv.Atoi(values[0])
+	if convErr != nil {
+		return err
+	}
+
+	return serializer.NewError(code, status.Convert(err).Message(), nil)
+}
+
+// statusCode maps common Cloudreve error codes to gRPC codes.
+func statusCode(code int) codes.Code {
+	switch code {
+	case serializer.CodeParamErr, serializer.CodeIllegalObjectName:
+		return codes.InvalidArgument
+	case serializer.CodeNotFound, serializer.CodeParentNotExist, serializer.CodeFileNotFound,
+		serializer.CodeEntityNotExist, serializer.CodeShareLinkNotFound:
+		return codes.NotFound
+	case serializer.CodeObjectExist:
+		return codes.AlreadyExists
+	case serializer.CodeCheckLogin, serializer.CodeCredentialInvalid:
+		return codes.Unauthenticated
+	case serializer.CodeNoPermissionErr, serializer.CodeGroupNotAllowed, serializer.CodeOwnerOnly,
+		serializer.CodePolicyNotAllowed, serializer.CodeGroupPolicyViolation, serializer.CodeLegalHold:
+		return codes.PermissionDenied
+	case serializer.CodeInsufficientCapacity, serializer.CodeFileTooLarge, serializer.CodeTooManyRequests:
+		return codes.ResourceExhausted
+	case serializer.CodeConflict, serializer.CodeLockConflict, serializer.CodeStaleVersion,
+		serializer.CodeConflictUploadOngoing:
+		return codes.Aborted
+	case serializer.CodeFeatureNotEnabled:
+		return codes.Unimplemented
+	case serializer.CodeMaintenance:
+		return codes.Unavailable
+	default:
+		return codes.Unknown
+	}
+}
@@ -0,0 +1,185 @@
+// Package rss parses RSS 2.0 and Atom feeds for items to download.
+package rss
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	// MaxFeedSize is the maximum size of feed to read.
+	MaxFeedSize = 5 << 20
+
+	torrentMimeType = "application/x-bittorrent"
+)
+
+type (
+	// Item a feed item with a URL to download.
+	Item struct {
+		// ID identifies the item in the feed, falls back to download URL if not provided.
+		ID    string
+		Title string
+		// DownloadUrl is a magnet link, torrent file URL or other URL of the item to download.
+		DownloadUrl string
+	}
+
+	rssFeed struct {
+		XMLName xml.Name  `xml:"rss"`
+		Items   []rssItem `xml:"channel>item"`
+	}
+
+	rssItem struct {
+		Title      string          `xml:"title"`
+		Link       string          `xml:"link"`
+		Guid       string          `xml:"guid"`
+		Enclosures []rssEnclosure  `xml:"enclosure"`
+		Torrent    *rssTorrentInfo `xml:"torrent"`
+	}
+
+	rssEnclosure struct {
+		Url  string `xml:"url,attr"`
+		Type string `xml:"type,attr"`
+	}
+
+	// rssTorrentInfo torrent extension used by some trackers.
+	rssTorrentInfo struct {
+		MagnetURI string `xml:"magnetURI"`
+	}
+
+	atomFeed struct {
+		XMLName xml.Name    `xml:"feed"`
+		Entries []atomEntry `xml:"entry"`
+	}
+
+	atomEntry struct {
+		ID    string     `xml:"id"`
+		Title string     `xml:"title"`
+		Links []atomLink `xml:"link"`
+	}
+
+	atomLink struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+		Type string `xml:"type,attr"`
+	}
+)
+
+// Parse reads items with a download URL from a RSS 2.0 or Atom feed, other items are skipped.
+func Parse(r io.Reader) ([]Item, error) {
+	content, err := io.ReadAll(io.LimitReader(r, MaxFeedSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read feed: %w", err)
+	}
+
+	if len(content) > MaxFeedSize {
+		return nil, fmt.Errorf("feed exceeds size limit of %d bytes", MaxFeedSize)
+	}
+
+	var items []Item
+	rss := &rssFeed{}
+	if err := unmarshal(content, rss); err == nil {
+		for _, i := range rss.Items {
+			items = append(items, newItem(i.Guid, i.Title, rssDownloadUrl(&i)))
+		}
+	} else {
+		atom := &atomFeed{}
+		if atomErr := unmarshal(content, atom); atomErr != nil {
+			return nil, fmt.Errorf("failed to parse feed as RSS (%s) or Atom: %w", err, atomErr)
+		}
+
+		for _, e := range atom.Entries {
+			items = append(items, newItem(e.ID, e.Title, atomDownloadUrl(&e)))
+		}
+	}
+
+	res := make([]Item, 0, len(items))
+	for _, i := range items {
+		if i.DownloadUrl != "" {
+			res = append(res, i)
+		}
+	}
+
+	return res, nil
+}
+
+func unmarshal(content []byte, v any) error {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	// Feeds are not always encoded in UTF-8, read them as is.
+	decoder.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	decoder.Strict = false
+	return decoder.Decode(v)
+}
+
+func newItem(id, title, downloadUrl string) Item {
+	id = strings.TrimSpace(id)
+	downloadUrl = strings.TrimSpace(downloadUrl)
+	if id == "" {
+		id = downloadUrl
+	}
+
+	return Item{ID: id, Title: strings.TrimSpace(title), DownloadUrl: downloadUrl}
+}
+
+// rssDownloadUrl prefers torrent enclosures, then magnet links, then other enclosures, then torrent links.
+func rssDownloadUrl(i *rssItem) string {
+	for _, e := range i.Enclosures {
+		if e.Type == torrentMimeType && e.Url != "" {
+			return e.Url
+		}
+	}
+
+	if i.Torrent != nil && i.Torrent.MagnetURI != "" {
+		return i.Torrent.MagnetURI
+	}
+
+	if isMagnet(i.Link) {
+		return i.Link
+	}
+
+	for _, e := range i.Enclosures {
+		if e.Url != "" {
+			return e.Url
+		}
+	}
+
+	if isTorrentUrl(i.Link) {
+		return i.Link
+	}
+
+	return ""
+}
+
+// atomDownloadUrl prefers enclosure links, then magnet or torrent links.
+func atomDownloadUrl(e *atomEntry) string {
+	for _, l := range e.Links {
+		if l.Rel == "enclosure" && l.Href != "" {
+			return l.Href
+		}
+	}
+
+	for _, l := range e.Links {
+		if isMagnet(l.Href) || isTorrentUrl(l.Href) || l.Type == torrentMimeType {
+			return l.Href
+		}
+	}
+
+	return ""
+}
+
+func isMagnet(link string) bool {
+	return strings.HasPrefix(strings.TrimSpace(link), "magnet:?")
+}
+
+func isTorrentUrl(link string) bool {
+	link = strings.ToLower(strings.TrimSpace(link))
+	if i := strings.IndexAny(link, "?#"); i >= 0 {
+		link = link[:i]
+	}
+
+	return strings.HasSuffix(link, ".torrent")
+}
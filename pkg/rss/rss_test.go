@@ -0,0 +1,69 @@
+package rss
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_RSS(t *testing.T) {
+	a := assert.New(t)
+	items, err := Parse(strings.NewReader(`<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:torrent="http://xmlns.ezrss.it/0.1/">
+<channel>
+	<title>Releases</title>
+	<item>
+		<title>Show S01E01 1080p</title>
+		<guid>ep1</guid>
+		<link>https://example.com/view/1</link>
+		<enclosure url="https://example.com/1.torrent" type="application/x-bittorrent" />
+	</item>
+	<item>
+		<title>Show S01E02 1080p</title>
+		<link>magnet:?xt=urn:btih:abc</link>
+	</item>
+	<item>
+		<title>Show S01E03</title>
+		<link>https://example.com/view/3</link>
+		<torrent:torrent><torrent:magnetURI>magnet:?xt=urn:btih:def</torrent:magnetURI></torrent:torrent>
+	</item>
+	<item>
+		<title>Announcement</title>
+		<link>https://example.com/news</link>
+	</item>
+</channel>
+</rss>`))
+	a.NoError(err)
+	a.Equal([]Item{
+		{ID: "ep1", Title: "Show S01E01 1080p", DownloadUrl: "https://example.com/1.torrent"},
+		{ID: "magnet:?xt=urn:btih:abc", Title: "Show S01E02 1080p", DownloadUrl: "magnet:?xt=urn:btih:abc"},
+		{ID: "magnet:?xt=urn:btih:def", Title: "Show S01E03", DownloadUrl: "magnet:?xt=urn:btih:def"},
+	}, items)
+}
+
+func TestParse_Atom(t *testing.T) {
+	a := assert.New(t)
+	items, err := Parse(strings.NewReader(`<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+	<entry>
+		<id>urn:1</id>
+		<title>Video 1</title>
+		<link rel="alternate" href="https://example.com/1" />
+		<link rel="enclosure" href="https://example.com/1.mp4" />
+	</entry>
+	<entry>
+		<id>urn:2</id>
+		<title>Video 2</title>
+		<link href="https://example.com/2.torrent?key=1" />
+	</entry>
+</feed>`))
+	a.NoError(err)
+	a.Equal([]Item{
+		{ID: "urn:1", Title: "Video 1", DownloadUrl: "https://example.com/1.mp4"},
+		{ID: "urn:2", Title: "Video 2", DownloadUrl: "https://example.com/2.torrent?key=1"},
+	}, items)
+
+	_, err = Parse(strings.NewReader("not a feed"))
+	a.Error(err)
+}
@@ -0,0 +1,134 @@
+package s3gateway
+
+import (
+	"encoding/xml"
+	"errors"
+	"net/http"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/lock"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+// Error is an S3 API error.
+type Error struct {
+	Code    string
+	Message string
+	Status  int
+}
+
+func (e *Error) Error() string {
+	return e.Code + ": " + e.Message
+}
+
+var (
+	ErrAccessDenied                 = &Error{"AccessDenied", "Access Denied.", http.StatusForbidden}
+	ErrInvalidAccessKeyID           = &Error{"InvalidAccessKeyId", "The access key ID you provided does not exist in our records.", http.StatusForbidden}
+	ErrSignatureDoesNotMatch        = &Error{"SignatureDoesNotMatch", "The request signature we calculated does not match the signature you provided.", http.StatusForbidden}
+	ErrSignatureVersionNotSupported = &Error{"InvalidRequest", "The authorization mechanism you have provided is not supported. Please use AWS4-HMAC-SHA256.", http.StatusBadRequest}
+	ErrAuthorizationMalformed       = &Error{"AuthorizationHeaderMalformed", "The authorization header is malformed.", http.StatusBadRequest}
+	ErrPresignMalformed             = &Error{"AuthorizationQueryParametersError", "Query-string authentication parameters are malformed.", http.StatusBadRequest}
+	ErrMissingDate                  = &Error{"AccessDenied", "AWS authentication requires a valid Date or x-amz-date header.", http.StatusForbidden}
+	ErrRequestTimeTooSkewed         = &Error{"RequestTimeTooSkewed", "The difference between the request time and the server's time is too large.", http.StatusForbidden}
+	ErrExpiredPresignRequest        = &Error{"AccessDenied", "Request has expired.", http.StatusForbidden}
+	ErrMissingContentSHA256         = &Error{"InvalidRequest", "Missing required header for this request: x-amz-content-sha256.", http.StatusBadRequest}
+	ErrContentSHA256Mismatch        = &Error{"XAmzContentSHA256Mismatch", "The provided 'x-amz-content-sha256' header does not match what was computed.", http.StatusBadRequest}
+	ErrMissingContentLength         = &Error{"MissingContentLength", "You must provide the Content-Length HTTP header.", http.StatusLengthRequired}
+	ErrInvalidChunk                 = &Error{"IncompleteBody", "The chunked request body is malformed or its signature does not match.", http.StatusBadRequest}
+	ErrNoSuchBucket                 = &Error{"NoSuchBucket", "The specified bucket does not exist.", http.StatusNotFound}
+	ErrNoSuchKey                    = &Error{"NoSuchKey", "The specified key does not exist.", http.StatusNotFound}
+	ErrNoSuchUpload                 = &Error{"NoSuchUpload", "The specified multipart upload does not exist.", http.StatusNotFound}
+	ErrBucketNotEmpty               = &Error{"BucketNotEmpty", "The bucket you tried to delete is not empty.", http.StatusConflict}
+	ErrBucketAlreadyOwnedByYou      = &Error{"BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.", http.StatusConflict}
+	ErrInvalidBucketName            = &Error{"InvalidBucketName", "The specified bucket is not valid.", http.StatusBadRequest}
+	ErrInvalidKey                   = &Error{"InvalidArgument", "The specified key is not valid.", http.StatusBadRequest}
+	ErrInvalidArgument              = &Error{"InvalidArgument", "Invalid argument.", http.StatusBadRequest}
+	ErrInvalidPart                  = &Error{"InvalidPart", "One or more of the specified parts could not be found or its ETag does not match.", http.StatusBadRequest}
+	ErrInvalidPartOrder             = &Error{"InvalidPartOrder", "The list of parts was not in ascending order.", http.StatusBadRequest}
+	ErrEntityTooLarge               = &Error{"EntityTooLarge", "Your proposed upload exceeds the maximum allowed object size.", http.StatusBadRequest}
+	ErrMalformedXML                 = &Error{"MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema.", http.StatusBadRequest}
+	ErrQuotaExceeded                = &Error{"QuotaExceeded", "Your storage capacity is exceeded.", http.StatusForbidden}
+	ErrKeyConflict                  = &Error{"InvalidRequest", "The key conflicts with an existing folder or file.", http.StatusConflict}
+	ErrTooManyObjects               = &Error{"InvalidRequest", "Too many objects under the prefix, please list with a delimiter or a deeper prefix.", http.StatusBadRequest}
+	ErrBadDigest                    = &Error{"BadDigest", "The Content-MD5 you specified did not match what we received.", http.StatusBadRequest}
+	ErrGatewayDisabled              = &Error{"AccessDenied", "S3 gateway is not enabled.", http.StatusForbidden}
+	ErrSlowDown                     = &Error{"SlowDown", "The object is locked by another client, please retry later.", http.StatusServiceUnavailable}
+	ErrMethodNotAllowed             = &Error{"MethodNotAllowed", "The specified method is not allowed against this resource.", http.StatusMethodNotAllowed}
+	ErrNotImplemented               = &Error{"NotImplemented", "A header or query you provided implies functionality that is not implemented.", http.StatusNotImplemented}
+	ErrIPNotAllowed                 = &Error{"AccessDenied", "Access from your IP address is not allowed.", http.StatusForbidden}
+	ErrMaintenance                  = &Error{"ServiceUnavailable", "Site is under maintenance, please retry later.", http.StatusServiceUnavailable}
+	ErrTooManyAuthFailures          = &Error{"SlowDown", "Too many failed authentication attempts, please retry later.", http.StatusServiceUnavailable}
+	ErrInternal                     = &Error{"InternalError", "We encountered an internal error. Please try again.", http.StatusInternalServerError}
+)
+
+type errorResponse struct {
+	XMLName  xml.Name `xml:"Error"`
+	Code     string
+	Message  string
+	Resource string
+}
+
+// WriteError writes err in S3 error response format.
+func WriteError(c *gin.Context, err error) {
+	s3Err := toS3Error(err)
+	c.Header("Content-Type", "application/xml")
+	c.Status(s3Err.Status)
+	if c.Request.Method == http.MethodHead {
+		return
+	}
+
+	c.Writer.Write([]byte(xml.Header))
+	_ = xml.NewEncoder(c.Writer).Encode(&errorResponse{
+		Code:     s3Err.Code,
+		Message:  s3Err.Message,
+		Resource: c.Request.URL.Path,
+	})
+}
+
+// toS3Error converts errors of file manager into S3 errors.
+func toS3Error(err error) *Error {
+	var s3Err *Error
+	if errors.As(err, &s3Err) {
+		return s3Err
+	}
+
+	if ent.IsNotFound(err) {
+		return ErrNoSuchKey
+	}
+
+	if errors.Is(err, lock.ErrNoSuchLock) {
+		return ErrSlowDown
+	}
+
+	// Batch operations report the first failed item.
+	var ae *serializer.AggregateError
+	if errors.As(err, &ae) {
+		for _, e := range ae.Raw() {
+			err = e
+			break
+		}
+	}
+
+	var appErr serializer.AppError
+	if errors.As(err, &appErr) {
+		switch appErr.ErrCode() {
+		case serializer.CodeNotFound, serializer.CodeParentNotExist, serializer.CodeEntityNotExist:
+			return ErrNoSuchKey
+		case serializer.CodeNoPermissionErr, serializer.CodeOwnerOnly:
+			return ErrAccessDenied
+		case serializer.CodeFileCountLimitedReached:
+			return ErrTooManyObjects
+		case serializer.CodeLockConflict:
+			return ErrSlowDown
+		case serializer.CodeObjectExist:
+			return ErrKeyConflict
+		case serializer.CodeInsufficientCapacity:
+			return ErrQuotaExceeded
+		case serializer.CodeFileTooLarge:
+			return ErrEntityTooLarge
+		}
+	}
+
+	return ErrInternal
+}
@@ -0,0 +1,841 @@
+// Package s3gateway serves a subset of the Amazon S3 API on top of user's files, so that
+// backup and sync tools can use Cloudreve as an S3 endpoint. Buckets are mapped to
+// top-level folders of user's "My files", and object keys to paths under them.
+package s3gateway
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/stats"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	maxKeyLength      = 1024
+	maxXMLBodySize    = 2 << 20
+	maxDeleteObjects  = 1000
+	emptyMD5          = "d41d8cd98f00b204e9800998ecf8427e"
+	headerCopySource  = "X-Amz-Copy-Source"
+	headerContentMD5  = "Content-MD5"
+	headerMetaMtime   = "X-Amz-Meta-Mtime"
+	encodingTypeURL   = "url"
+	listTypeV2        = "2"
+	defaultDelimiter  = "/"
+	continuationBytes = 1024
+)
+
+type handler struct {
+	c    *gin.Context
+	dep  dependency.Dep
+	user *ent.User
+	fm   manager.FileManager
+}
+
+// ServeHTTP serves S3 API requests of the user authenticated by S3Auth middleware.
+// Only path-style requests are supported, bucket and key are parsed from path param.
+func ServeHTTP(c *gin.Context) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	fm := manager.NewFileManager(dep, user)
+	defer fm.Recycle()
+
+	h := &handler{c: c, dep: dep, user: user, fm: fm}
+	if err := h.serve(); err != nil {
+		if toS3Error(err) == ErrInternal {
+			dep.Logger().Warning("S3 gateway: failed to serve %s %q: %s", c.Request.Method, c.Request.URL.Path, err)
+		}
+		WriteError(c, err)
+	}
+}
+
+func (h *handler) serve() error {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(h.c.Param("path"), "/"), "/")
+	q := h.c.Request.URL.Query()
+	method := h.c.Request.Method
+
+	if bucket == "" {
+		if method != http.MethodGet {
+			return ErrMethodNotAllowed
+		}
+		return h.listBuckets()
+	}
+
+	if bucket == "." || bucket == ".." || len(bucket) > 255 {
+		return ErrInvalidBucketName
+	}
+
+	if key == "" {
+		switch {
+		case method == http.MethodGet && q.Has("location"):
+			return h.getBucketLocation(bucket)
+		case method == http.MethodGet && q.Has("versioning"):
+			return h.getBucketVersioning(bucket)
+		case method == http.MethodGet && q.Has("uploads"):
+			return h.listMultipartUploads(bucket)
+		case method == http.MethodGet:
+			return h.listObjects(bucket)
+		case method == http.MethodHead:
+			_, err := h.getBucket(bucket)
+			return err
+		case method == http.MethodPut:
+			return h.createBucket(bucket)
+		case method == http.MethodDelete:
+			return h.deleteBucket(bucket)
+		case method == http.MethodPost && q.Has("delete"):
+			return h.deleteObjects(bucket)
+		}
+		return ErrNotImplemented
+	}
+
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	switch {
+	case method == http.MethodGet && q.Has("uploadId"):
+		return h.listParts(bucket, key)
+	case method == http.MethodGet, method == http.MethodHead:
+		return h.getObject(bucket, key)
+	case method == http.MethodPut && h.c.GetHeader(headerCopySource) != "":
+		return ErrNotImplemented
+	case method == http.MethodPut && q.Has("uploadId"):
+		return h.uploadPart(bucket, key)
+	case method == http.MethodPut:
+		return h.putObject(bucket, key)
+	case method == http.MethodPost && q.Has("uploads"):
+		return h.createMultipartUpload(bucket, key)
+	case method == http.MethodPost && q.Has("uploadId"):
+		return h.completeMultipartUpload(bucket, key)
+	case method == http.MethodDelete && q.Has("uploadId"):
+		return h.abortMultipartUpload(bucket, key)
+	case method == http.MethodDelete:
+		return h.deleteObject(bucket, key)
+	}
+
+	return ErrNotImplemented
+}
+
+// validateKey rejects keys that cannot be mapped to a path unambiguously.
+func validateKey(key string) error {
+	if len(key) > maxKeyLength {
+		return ErrInvalidKey
+	}
+
+	for i, segment := range strings.Split(key, "/") {
+		if segment == "." || segment == ".." {
+			return ErrInvalidKey
+		}
+
+		// Only a trailing slash is allowed, which marks a folder.
+		if segment == "" && i != strings.Count(key, "/") {
+			return ErrInvalidKey
+		}
+	}
+
+	return nil
+}
+
+func (h *handler) rootUri() *fs.URI {
+	uri, _ := fs.NewUriFromString(fs.NewMyUri(""))
+	return uri
+}
+
+func (h *handler) bucketUri(bucket string) *fs.URI {
+	return h.rootUri().Join(bucket)
+}
+
+func (h *handler) objectUri(bucket, key string) *fs.URI {
+	return h.bucketUri(bucket).JoinRaw(strings.TrimSuffix(key, "/"))
+}
+
+// getBucket returns the top-level folder of given bucket.
+func (h *handler) getBucket(bucket string) (fs.File, error) {
+	folder, err := h.fm.Get(h.c, h.bucketUri(bucket))
+	if err != nil {
+		if toS3Error(err) == ErrNoSuchKey {
+			return nil, ErrNoSuchBucket
+		}
+		return nil, err
+	}
+
+	if folder.Type() != types.FileTypeFolder {
+		return nil, ErrNoSuchBucket
+	}
+
+	return folder, nil
+}
+
+func (h *handler) etag(file fs.File) string {
+	return `"` + hashid.EncodeEntityID(h.dep.HashIDEncoder(), file.PrimaryEntityID()) + `"`
+}
+
+func (h *handler) owner() owner {
+	return owner{
+		ID:          hashid.EncodeUserID(h.dep.HashIDEncoder(), h.user.ID),
+		DisplayName: h.user.Nick,
+	}
+}
+
+func (h *handler) writeXML(status int, v any) error {
+	h.c.Header("Content-Type", "application/xml")
+	h.c.Status(status)
+	h.c.Writer.Write([]byte(xml.Header))
+	_ = xml.NewEncoder(h.c.Writer).Encode(v)
+	return nil
+}
+
+func (h *handler) readXML(v any) error {
+	body, err := io.ReadAll(io.LimitReader(h.c.Request.Body, maxXMLBodySize+1))
+	if err != nil {
+		return err
+	}
+
+	if len(body) > maxXMLBodySize || xml.Unmarshal(body, v) != nil {
+		return ErrMalformedXML
+	}
+
+	return nil
+}
+
+func (h *handler) listBuckets() error {
+	res := &listAllMyBucketsResult{Xmlns: xmlns, Owner: h.owner(), Buckets: []bucket{}}
+	err := h.fm.Walk(h.c, h.rootUri(), 1, func(f fs.File, level int) error {
+		if level == 1 && f.Type() == types.FileTypeFolder {
+			res.Buckets = append(res.Buckets, bucket{
+				Name:         f.Name(),
+				CreationDate: f.CreatedAt().UTC().Format(timeFormatISO),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(res.Buckets, func(i, j int) bool {
+		return res.Buckets[i].Name < res.Buckets[j].Name
+	})
+	return h.writeXML(http.StatusOK, res)
+}
+
+func (h *handler) getBucketLocation(bucket string) error {
+	if _, err := h.getBucket(bucket); err != nil {
+		return err
+	}
+
+	// us-east-1 is represented by an empty location constraint.
+	return h.writeXML(http.StatusOK, &locationConstraint{Xmlns: xmlns})
+}
+
+func (h *handler) getBucketVersioning(bucket string) error {
+	if _, err := h.getBucket(bucket); err != nil {
+		return err
+	}
+
+	return h.writeXML(http.StatusOK, &versioningConfiguration{Xmlns: xmlns})
+}
+
+func (h *handler) listMultipartUploads(bucket string) error {
+	if _, err := h.getBucket(bucket); err != nil {
+		return err
+	}
+
+	// Uploads in progress are not enumerable, clients cannot resume them without knowing the ID anyway.
+	return h.writeXML(http.StatusOK, &listMultipartUploadsResult{
+		Xmlns:      xmlns,
+		Bucket:     bucket,
+		KeyMarker:  h.c.Query("key-marker"),
+		MaxUploads: defaultMaxKeys,
+	})
+}
+
+func (h *handler) createBucket(bucket string) error {
+	_, _ = io.Copy(io.Discard, h.c.Request.Body)
+	if _, err := h.getBucket(bucket); err == nil {
+		return ErrBucketAlreadyOwnedByYou
+	} else if err != ErrNoSuchBucket {
+		return err
+	}
+
+	if _, err := h.fm.Create(h.c, h.bucketUri(bucket), types.FileTypeFolder); err != nil {
+		return err
+	}
+
+	h.c.Header("Location", "/"+bucket)
+	h.c.Status(http.StatusOK)
+	return nil
+}
+
+func (h *handler) deleteBucket(bucket string) error {
+	if _, err := h.getBucket(bucket); err != nil {
+		return err
+	}
+
+	empty, err := h.isEmptyFolder(h.bucketUri(bucket))
+	if err != nil {
+		return err
+	}
+
+	if !empty {
+		return ErrBucketNotEmpty
+	}
+
+	if err := h.fm.Delete(h.c, []*fs.URI{h.bucketUri(bucket)}); err != nil {
+		return err
+	}
+
+	h.c.Status(http.StatusNoContent)
+	return nil
+}
+
+func (h *handler) isEmptyFolder(uri *fs.URI) (bool, error) {
+	errNotEmpty := errors.New("not empty")
+	err := h.fm.Walk(h.c, uri, 1, func(f fs.File, level int) error {
+		if level > 0 {
+			return errNotEmpty
+		}
+		return nil
+	})
+	if errors.Is(err, errNotEmpty) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+type listEntry struct {
+	key    string
+	prefix bool
+	file   fs.File
+}
+
+// list walks the folder containing given prefix, and returns sorted entries after given key.
+// With "/" delimiter only the direct children are walked, sub folders are reported as common prefixes.
+func (h *handler) list(bucket, prefix, delimiter, after string, maxKeys int) ([]listEntry, bool, error) {
+	if delimiter != "" && delimiter != defaultDelimiter {
+		return nil, false, ErrNotImplemented
+	}
+
+	if _, err := h.getBucket(bucket); err != nil {
+		return nil, false, err
+	}
+
+	bucketPath := h.bucketUri(bucket).Path() + "/"
+	walkRoot := h.bucketUri(bucket)
+	if dir := prefix[:strings.LastIndex(prefix, "/")+1]; dir != "" {
+		if validateKey(dir) != nil {
+			return []listEntry{}, false, nil
+		}
+		walkRoot = h.objectUri(bucket, dir)
+	}
+
+	depth := math.MaxInt
+	if delimiter != "" {
+		depth = 1
+	}
+
+	entries := make([]listEntry, 0)
+	err := h.fm.Walk(h.c, walkRoot, depth, func(f fs.File, level int) error {
+		if level == 0 {
+			return nil
+		}
+
+		key := strings.TrimPrefix(f.Uri(false).Path(), bucketPath)
+		if f.Type() == types.FileTypeFolder {
+			if delimiter != "" && strings.HasPrefix(key+"/", prefix) && key+"/" > after {
+				entries = append(entries, listEntry{key: key + "/", prefix: true})
+			}
+			return nil
+		}
+
+		if strings.HasPrefix(key, prefix) && key > after {
+			entries = append(entries, listEntry{key: key, file: f})
+		}
+		return nil
+	})
+	if err != nil {
+		if toS3Error(err) == ErrNoSuchKey {
+			return []listEntry{}, false, nil
+		}
+		return nil, false, err
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].key < entries[j].key
+	})
+
+	if len(entries) > maxKeys {
+		return entries[:maxKeys], true, nil
+	}
+
+	return entries, false, nil
+}
+
+func (h *handler) listObjects(bucket string) error {
+	q := h.c.Request.URL.Query()
+	maxKeys := defaultMaxKeys
+	if raw := q.Get("max-keys"); raw != "" {
+		v, err := strconv.Atoi(raw)
+		if err != nil || v < 0 {
+			return ErrInvalidArgument
+		}
+		maxKeys = min(v, defaultMaxKeys)
+	}
+
+	encodingType := q.Get("encoding-type")
+	if encodingType != "" && encodingType != encodingTypeURL {
+		return ErrInvalidArgument
+	}
+
+	encode := func(s string) string {
+		if encodingType == encodingTypeURL {
+			return uriEncode(s, false)
+		}
+		return s
+	}
+
+	res := &listBucketResult{
+		Xmlns:        xmlns,
+		Name:         bucket,
+		Prefix:       encode(q.Get("prefix")),
+		Delimiter:    encode(q.Get("delimiter")),
+		MaxKeys:      maxKeys,
+		EncodingType: encodingType,
+	}
+
+	after := q.Get("marker")
+	isV2 := q.Get("list-type") == listTypeV2
+	if isV2 {
+		after = q.Get("start-after")
+		res.StartAfter = encode(after)
+		if token := q.Get("continuation-token"); token != "" {
+			decoded, err := base64.RawURLEncoding.DecodeString(token)
+			if err != nil || len(decoded) > continuationBytes {
+				return ErrInvalidArgument
+			}
+			after = string(decoded)
+			res.ContinuationToken = token
+		}
+	} else {
+		marker := encode(after)
+		res.Marker = &marker
+	}
+
+	entries, truncated, err := h.list(bucket, q.Get("prefix"), q.Get("delimiter"), after, maxKeys)
+	if err != nil {
+		return err
+	}
+
+	res.IsTruncated = truncated
+	var objOwner *owner
+	if !isV2 || q.Get("fetch-owner") == "true" {
+		o := h.owner()
+		objOwner = &o
+	}
+
+	for _, e := range entries {
+		if e.prefix {
+			res.CommonPrefixes = append(res.CommonPrefixes, commonPrefix{Prefix: encode(e.key)})
+			continue
+		}
+
+		res.Contents = append(res.Contents, object{
+			Key:          encode(e.key),
+			LastModified: e.file.UpdatedAt().UTC().Format(timeFormatISO),
+			ETag:         h.etag(e.file),
+			Size:         e.file.Size(),
+			StorageClass: storageClass,
+			Owner:        objOwner,
+		})
+	}
+
+	if truncated {
+		last := entries[len(entries)-1].key
+		if isV2 {
+			res.NextContinuationToken = base64.RawURLEncoding.EncodeToString([]byte(last))
+		} else {
+			res.NextMarker = encode(last)
+		}
+	}
+
+	if isV2 {
+		count := len(entries)
+		res.KeyCount = &count
+	}
+
+	return h.writeXML(http.StatusOK, res)
+}
+
+// getFile returns the file of given object key.
+func (h *handler) getFile(bucket, key string) (fs.File, error) {
+	if _, err := h.getBucket(bucket); err != nil {
+		return nil, err
+	}
+
+	file, err := h.fm.Get(h.c, h.objectUri(bucket, key))
+	if err != nil {
+		return nil, err
+	}
+
+	if file.Type() != types.FileTypeFile || strings.HasSuffix(key, "/") {
+		return nil, ErrNoSuchKey
+	}
+
+	return file, nil
+}
+
+func (h *handler) getObject(bucket, key string) error {
+	file, err := h.getFile(bucket, key)
+	if err != nil {
+		return err
+	}
+
+	header := h.c.Writer.Header()
+	header.Set("Last-Modified", file.UpdatedAt().UTC().Format(http.TimeFormat))
+	header.Set("Accept-Ranges", "bytes")
+	if h.c.Request.Method == http.MethodHead {
+		header.Set("Content-Length", strconv.FormatInt(file.Size(), 10))
+		header.Set("Content-Type", h.dep.MimeDetector(h.c).TypeByName(file.Name()))
+		header.Set("ETag", h.etag(file))
+		h.c.Status(http.StatusOK)
+		return nil
+	}
+
+	es, err := h.fm.GetEntitySource(h.c, file.PrimaryEntityID())
+	if err != nil {
+		return err
+	}
+	defer es.Close()
+
+	if stats.CountsAsDownload(h.c.Request) {
+		h.dep.StatsRecorder().Downloaded(es.Entity().Size())
+	}
+
+	es.Serve(h.c.Writer, h.c.Request)
+	return nil
+}
+
+// uploadBody returns request body, with Content-MD5 verified if provided.
+func (h *handler) uploadBody() (io.Reader, int64, error) {
+	if h.c.Request.ContentLength < 0 {
+		return nil, 0, ErrMissingContentLength
+	}
+
+	body := h.c.Request.Body
+	if raw := h.c.GetHeader(headerContentMD5); raw != "" {
+		expected, err := base64.StdEncoding.DecodeString(raw)
+		if err != nil || len(expected) != md5.Size {
+			return nil, 0, ErrInvalidArgument
+		}
+		body = &hashingReader{ReadCloser: body, hash: md5.New(), expected: expected, mismatch: ErrBadDigest}
+	}
+
+	return body, h.c.Request.ContentLength, nil
+}
+
+func (h *handler) upload(bucket, key string, body io.Reader, size int64) (fs.File, error) {
+	req := &fs.UploadRequest{
+		Props: &fs.UploadProps{
+			Uri:  h.objectUri(bucket, key),
+			Size: size,
+		},
+		File: io.NopCloser(body),
+		Mode: fs.ModeOverwrite,
+	}
+
+	// rclone preserves modification time in "mtime" user metadata, in seconds with fraction.
+	if mtime, err := strconv.ParseFloat(h.c.GetHeader(headerMetaMtime), 64); err == nil && mtime > 0 {
+		sec, frac := math.Modf(mtime)
+		lastModified := time.Unix(int64(sec), int64(frac*1e9))
+		req.Props.LastModified = &lastModified
+	}
+
+	return h.fm.Update(h.c, req)
+}
+
+func (h *handler) putObject(bucket, key string) error {
+	if _, err := h.getBucket(bucket); err != nil {
+		return err
+	}
+
+	body, size, err := h.uploadBody()
+	if err != nil {
+		return err
+	}
+
+	// Keys with trailing slash are folder markers.
+	if strings.HasSuffix(key, "/") {
+		if size != 0 {
+			return ErrInvalidKey
+		}
+
+		if _, err := h.fm.Create(h.c, h.objectUri(bucket, key), types.FileTypeFolder); err != nil {
+			if existing, getErr := h.fm.Get(h.c, h.objectUri(bucket, key)); getErr != nil || existing.Type() != types.FileTypeFolder {
+				return err
+			}
+		}
+
+		h.c.Header("ETag", `"`+emptyMD5+`"`)
+		h.c.Status(http.StatusOK)
+		return nil
+	}
+
+	file, err := h.upload(bucket, key, body, size)
+	if err != nil {
+		return err
+	}
+
+	h.c.Header("ETag", h.etag(file))
+	h.c.Status(http.StatusOK)
+	return nil
+}
+
+// deleteKey deletes the object, missing objects are treated as deleted.
+func (h *handler) deleteKey(bucket, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+
+	uri := h.objectUri(bucket, key)
+	file, err := h.fm.Get(h.c, uri)
+	if err != nil {
+		if toS3Error(err) == ErrNoSuchKey {
+			return nil
+		}
+		return err
+	}
+
+	// A folder is deleted by its marker only if it has no other keys under it.
+	if file.Type() == types.FileTypeFolder {
+		if !strings.HasSuffix(key, "/") {
+			return nil
+		}
+
+		empty, err := h.isEmptyFolder(uri)
+		if err != nil || !empty {
+			return err
+		}
+	} else if strings.HasSuffix(key, "/") {
+		return nil
+	}
+
+	return h.fm.Delete(h.c, []*fs.URI{uri})
+}
+
+func (h *handler) deleteObject(bucket, key string) error {
+	if _, err := h.getBucket(bucket); err != nil {
+		return err
+	}
+
+	if err := h.deleteKey(bucket, key); err != nil {
+		return err
+	}
+
+	h.c.Status(http.StatusNoContent)
+	return nil
+}
+
+func (h *handler) deleteObjects(bucket string) error {
+	if _, err := h.getBucket(bucket); err != nil {
+		return err
+	}
+
+	req := &deleteRequest{}
+	if err := h.readXML(req); err != nil {
+		return err
+	}
+
+	if len(req.Objects) > maxDeleteObjects {
+		return ErrMalformedXML
+	}
+
+	res := &deleteResult{Xmlns: xmlns}
+	for _, obj := range req.Objects {
+		if err := h.deleteKey(bucket, obj.Key); err != nil {
+			s3Err := toS3Error(err)
+			res.Errors = append(res.Errors, deleteError{Key: obj.Key, Code: s3Err.Code, Message: s3Err.Message})
+			continue
+		}
+
+		if !req.Quiet {
+			res.Deleted = append(res.Deleted, deletedObject{Key: obj.Key})
+		}
+	}
+
+	return h.writeXML(http.StatusOK, res)
+}
+
+func (h *handler) multipartStore() *multipartStore {
+	return newMultipartStore(h.dep.SettingProvider().TempPath(h.c))
+}
+
+func (h *handler) createMultipartUpload(bucket, key string) error {
+	if strings.HasSuffix(key, "/") {
+		return ErrInvalidKey
+	}
+
+	if _, err := h.getBucket(bucket); err != nil {
+		return err
+	}
+
+	upload, err := h.multipartStore().create(h.user.ID, bucket, key)
+	if err != nil {
+		return err
+	}
+
+	return h.writeXML(http.StatusOK, &initiateMultipartUploadResult{
+		Xmlns:    xmlns,
+		Bucket:   bucket,
+		Key:      key,
+		UploadId: upload.UploadID,
+	})
+}
+
+func (h *handler) uploadPart(bucket, key string) error {
+	number, err := strconv.Atoi(h.c.Query("partNumber"))
+	if err != nil {
+		return ErrInvalidArgument
+	}
+
+	store := h.multipartStore()
+	upload, err := store.get(h.user.ID, h.c.Query("uploadId"), bucket, key)
+	if err != nil {
+		return err
+	}
+
+	body, _, err := h.uploadBody()
+	if err != nil {
+		return err
+	}
+
+	etag, err := store.putPart(upload, number, io.LimitReader(body, h.c.Request.ContentLength))
+	if err != nil {
+		return err
+	}
+
+	h.c.Header("ETag", `"`+etag+`"`)
+	h.c.Status(http.StatusOK)
+	return nil
+}
+
+func (h *handler) listParts(bucket, key string) error {
+	store := h.multipartStore()
+	upload, err := store.get(h.user.ID, h.c.Query("uploadId"), bucket, key)
+	if err != nil {
+		return err
+	}
+
+	marker, _ := strconv.Atoi(h.c.Query("part-number-marker"))
+	maxParts := defaultMaxKeys
+	if v, err := strconv.Atoi(h.c.Query("max-parts")); err == nil && v >= 0 {
+		maxParts = min(v, defaultMaxKeys)
+	}
+
+	stored, err := store.parts(upload)
+	if err != nil {
+		return err
+	}
+
+	res := &listPartsResult{
+		Xmlns:            xmlns,
+		Bucket:           bucket,
+		Key:              key,
+		UploadId:         upload.UploadID,
+		StorageClass:     storageClass,
+		PartNumberMarker: marker,
+		MaxParts:         maxParts,
+	}
+	for _, p := range stored {
+		if p.Number <= marker {
+			continue
+		}
+
+		if len(res.Parts) == maxParts {
+			res.IsTruncated = true
+			break
+		}
+
+		res.Parts = append(res.Parts, part{
+			PartNumber:   p.Number,
+			LastModified: p.LastModified.UTC().Format(timeFormatISO),
+			ETag:         `"` + p.ETag + `"`,
+			Size:         p.Size,
+		})
+		res.NextPartNumberMarker = p.Number
+	}
+
+	return h.writeXML(http.StatusOK, res)
+}
+
+func (h *handler) completeMultipartUpload(bucket, key string) error {
+	store := h.multipartStore()
+	upload, err := store.get(h.user.ID, h.c.Query("uploadId"), bucket, key)
+	if err != nil {
+		return err
+	}
+
+	req := &completeMultipartUpload{}
+	if err := h.readXML(req); err != nil {
+		return err
+	}
+
+	content, size, err := store.assemble(upload, req.Parts)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	if _, err := h.getBucket(bucket); err != nil {
+		return err
+	}
+
+	file, err := h.upload(bucket, key, content, size)
+	if err != nil {
+		return err
+	}
+
+	if err := store.remove(upload); err != nil {
+		h.dep.Logger().Warning("S3 gateway: failed to clean up multipart upload %q: %s", upload.UploadID, err)
+	}
+
+	return h.writeXML(http.StatusOK, &completeMultipartUploadResult{
+		Xmlns:    xmlns,
+		Location: path.Join("/", bucket, key),
+		Bucket:   bucket,
+		Key:      key,
+		ETag:     h.etag(file),
+	})
+}
+
+func (h *handler) abortMultipartUpload(bucket, key string) error {
+	store := h.multipartStore()
+	upload, err := store.get(h.user.ID, h.c.Query("uploadId"), bucket, key)
+	if err != nil {
+		return err
+	}
+
+	if err := store.remove(upload); err != nil {
+		return err
+	}
+
+	h.c.Status(http.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,288 @@
+package s3gateway
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+)
+
+const (
+	multipartDir      = "s3_multipart"
+	multipartMetaFile = "meta.json"
+	multipartEtagExt  = ".etag"
+	// multipartTTL is the max lifetime of an incomplete multipart upload.
+	multipartTTL  = 24 * time.Hour
+	maxPartNumber = 10000
+	uploadIDLen   = 32
+)
+
+var uploadIDVariant = []rune("0123456789abcdef")
+
+type (
+	// multipartStore keeps parts of multipart uploads in local temp directory until completed.
+	multipartStore struct {
+		root string
+	}
+
+	multipartUpload struct {
+		UploadID  string    `json:"upload_id"`
+		OwnerID   int       `json:"owner_id"`
+		Bucket    string    `json:"bucket"`
+		Key       string    `json:"key"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+
+	storedPart struct {
+		Number       int
+		ETag         string
+		Size         int64
+		LastModified time.Time
+		path         string
+	}
+)
+
+func newMultipartStore(tempPath string) *multipartStore {
+	return &multipartStore{root: filepath.Join(util.DataPath(tempPath), multipartDir)}
+}
+
+// create starts a new multipart upload, stale uploads are purged along the way.
+func (s *multipartStore) create(uid int, bucket, key string) (*multipartUpload, error) {
+	s.purgeStale()
+
+	id, err := util.SecureRandString(uploadIDLen, uploadIDVariant)
+	if err != nil {
+		return nil, err
+	}
+
+	upload := &multipartUpload{
+		UploadID:  id,
+		OwnerID:   uid,
+		Bucket:    bucket,
+		Key:       key,
+		CreatedAt: time.Now(),
+	}
+
+	if err := os.MkdirAll(s.dir(id), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create multipart upload dir: %w", err)
+	}
+
+	meta, err := json.Marshal(upload)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(filepath.Join(s.dir(id), multipartMetaFile), meta, 0600); err != nil {
+		return nil, fmt.Errorf("failed to write multipart upload meta: %w", err)
+	}
+
+	return upload, nil
+}
+
+// get returns the multipart upload with given ID started by given user for given object.
+func (s *multipartStore) get(uid int, uploadID, bucket, key string) (*multipartUpload, error) {
+	if len(uploadID) != uploadIDLen || strings.Trim(uploadID, string(uploadIDVariant)) != "" {
+		return nil, ErrNoSuchUpload
+	}
+
+	meta, err := os.ReadFile(filepath.Join(s.dir(uploadID), multipartMetaFile))
+	if err != nil {
+		return nil, ErrNoSuchUpload
+	}
+
+	upload := &multipartUpload{}
+	if err := json.Unmarshal(meta, upload); err != nil {
+		return nil, ErrNoSuchUpload
+	}
+
+	if upload.OwnerID != uid || upload.Bucket != bucket || upload.Key != key ||
+		time.Since(upload.CreatedAt) > multipartTTL {
+		return nil, ErrNoSuchUpload
+	}
+
+	return upload, nil
+}
+
+// putPart stores the content of a part, replacing the existing one with the same number.
+func (s *multipartStore) putPart(upload *multipartUpload, number int, r io.Reader) (string, error) {
+	if number < 1 || number > maxPartNumber {
+		return "", ErrInvalidArgument
+	}
+
+	partPath := s.partPath(upload.UploadID, number)
+	tmp, err := os.CreateTemp(s.dir(upload.UploadID), "upload-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create part file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := md5.New()
+	_, err = io.Copy(io.MultiWriter(tmp, hash), r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", err
+	}
+
+	etag := hex.EncodeToString(hash.Sum(nil))
+	if err := os.WriteFile(partPath+multipartEtagExt, []byte(etag), 0600); err != nil {
+		return "", fmt.Errorf("failed to write part etag: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), partPath); err != nil {
+		return "", fmt.Errorf("failed to save part file: %w", err)
+	}
+
+	return etag, nil
+}
+
+// parts lists uploaded parts ordered by part number.
+func (s *multipartStore) parts(upload *multipartUpload) ([]storedPart, error) {
+	entries, err := os.ReadDir(s.dir(upload.UploadID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read multipart upload dir: %w", err)
+	}
+
+	res := make([]storedPart, 0, len(entries))
+	for _, entry := range entries {
+		number, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+
+		partPath := s.partPath(upload.UploadID, number)
+		etag, err := os.ReadFile(partPath + multipartEtagExt)
+		if err != nil {
+			continue
+		}
+
+		res = append(res, storedPart{
+			Number:       number,
+			ETag:         string(etag),
+			Size:         info.Size(),
+			LastModified: info.ModTime(),
+			path:         partPath,
+		})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Number < res[j].Number
+	})
+	return res, nil
+}
+
+// assemble validates the part list of a CompleteMultipartUpload request, and returns a reader
+// of the concatenated content with its total size.
+func (s *multipartStore) assemble(upload *multipartUpload, requested []completePart) (io.ReadCloser, int64, error) {
+	if len(requested) == 0 {
+		return nil, 0, ErrMalformedXML
+	}
+
+	stored, err := s.parts(upload)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	byNumber := make(map[int]storedPart, len(stored))
+	for _, p := range stored {
+		byNumber[p.Number] = p
+	}
+
+	var (
+		size    int64
+		files   = make(multiCloser, 0, len(requested))
+		readers = make([]io.Reader, 0, len(requested))
+	)
+	for i, req := range requested {
+		if i > 0 && req.PartNumber <= requested[i-1].PartNumber {
+			files.Close()
+			return nil, 0, ErrInvalidPartOrder
+		}
+
+		p, ok := byNumber[req.PartNumber]
+		if !ok || strings.Trim(req.ETag, `"`) != p.ETag {
+			files.Close()
+			return nil, 0, ErrInvalidPart
+		}
+
+		f, err := os.Open(p.path)
+		if err != nil {
+			files.Close()
+			return nil, 0, ErrInvalidPart
+		}
+
+		files = append(files, f)
+		readers = append(readers, f)
+		size += p.Size
+	}
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(readers...), files}, size, nil
+}
+
+// remove deletes the multipart upload and all its parts.
+func (s *multipartStore) remove(upload *multipartUpload) error {
+	return os.RemoveAll(s.dir(upload.UploadID))
+}
+
+// purgeStale deletes multipart uploads that are never completed or aborted.
+func (s *multipartStore) purgeStale() {
+	entries, err := os.ReadDir(s.root)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || !entry.IsDir() || time.Since(info.ModTime()) < multipartTTL {
+			continue
+		}
+
+		// Directory mtime changes whenever a part is added, check meta for the actual age.
+		meta, err := os.ReadFile(filepath.Join(s.root, entry.Name(), multipartMetaFile))
+		upload := &multipartUpload{}
+		if err == nil && json.Unmarshal(meta, upload) == nil && time.Since(upload.CreatedAt) < multipartTTL {
+			continue
+		}
+
+		_ = os.RemoveAll(filepath.Join(s.root, entry.Name()))
+	}
+}
+
+func (s *multipartStore) dir(uploadID string) string {
+	return filepath.Join(s.root, uploadID)
+}
+
+func (s *multipartStore) partPath(uploadID string, number int) string {
+	return filepath.Join(s.dir(uploadID), fmt.Sprintf("%05d", number))
+}
+
+type multiCloser []io.Closer
+
+func (m multiCloser) Close() error {
+	var errs []error
+	for _, c := range m {
+		if err := c.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,69 @@
+package s3gateway
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultipartStore(t *testing.T) {
+	a := assert.New(t)
+	store := &multipartStore{root: t.TempDir()}
+
+	upload, err := store.create(1, "bucket", "dir/file.bin")
+	a.NoError(err)
+
+	_, err = store.get(2, upload.UploadID, "bucket", "dir/file.bin")
+	a.ErrorIs(err, ErrNoSuchUpload, "uploads of other users are invisible")
+	_, err = store.get(1, "../../etc", "bucket", "dir/file.bin")
+	a.ErrorIs(err, ErrNoSuchUpload)
+
+	upload, err = store.get(1, upload.UploadID, "bucket", "dir/file.bin")
+	a.NoError(err)
+
+	etag1, err := store.putPart(upload, 1, strings.NewReader("hello "))
+	a.NoError(err)
+	etag2, err := store.putPart(upload, 2, strings.NewReader("world"))
+	a.NoError(err)
+	a.Equal("5d41402abc4b2a76b9719d911017c592", mustPut(t, store, upload, 3, "hello"))
+
+	parts, err := store.parts(upload)
+	a.NoError(err)
+	a.Len(parts, 3)
+
+	_, _, err = store.assemble(upload, []completePart{{2, etag2}, {1, etag1}})
+	a.ErrorIs(err, ErrInvalidPartOrder)
+	_, _, err = store.assemble(upload, []completePart{{1, etag2}})
+	a.ErrorIs(err, ErrInvalidPart)
+
+	content, size, err := store.assemble(upload, []completePart{{1, `"` + etag1 + `"`}, {2, etag2}})
+	a.NoError(err)
+	a.EqualValues(11, size)
+	data, _ := io.ReadAll(content)
+	a.Equal("hello world", string(data))
+	a.NoError(content.Close())
+
+	a.NoError(store.remove(upload))
+	_, err = store.get(1, upload.UploadID, "bucket", "dir/file.bin")
+	a.ErrorIs(err, ErrNoSuchUpload)
+}
+
+func mustPut(t *testing.T, store *multipartStore, upload *multipartUpload, number int, content string) string {
+	etag, err := store.putPart(upload, number, strings.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return etag
+}
+
+func TestValidateKey(t *testing.T) {
+	a := assert.New(t)
+	for _, key := range []string{"a", "a/b.txt", "dir/", "a b/c+d"} {
+		a.NoError(validateKey(key), key)
+	}
+	for _, key := range []string{"a//b", "/a", "a/../b", "./a", strings.Repeat("a", maxKeyLength+1)} {
+		a.ErrorIs(validateKey(key), ErrInvalidKey, key)
+	}
+}
@@ -0,0 +1,511 @@
+package s3gateway
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	signAlgorithm  = "AWS4-HMAC-SHA256"
+	iso8601Format  = "20060102T150405Z"
+	yyyymmdd       = "20060102"
+	scopeTerminal  = "aws4_request"
+	serviceName    = "s3"
+	maxClockSkew   = 15 * time.Minute
+	maxPresignLife = 7 * 24 * time.Hour
+
+	unsignedPayload        = "UNSIGNED-PAYLOAD"
+	streamingPayload       = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	streamingUnsignedTrail = "STREAMING-UNSIGNED-PAYLOAD-TRAILER"
+	emptySHA256            = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	headerAuthorization   = "Authorization"
+	headerAmzDate         = "X-Amz-Date"
+	headerContentSHA256   = "X-Amz-Content-Sha256"
+	headerDecodedLength   = "X-Amz-Decoded-Content-Length"
+	maxChunkSize          = 16 << 20
+	maxChunkHeaderLength  = 4096
+	chunkSignatureSegment = ";chunk-signature="
+)
+
+// SecretFunc returns the secret of given access key ID.
+type SecretFunc func(accessKeyID string) (string, error)
+
+// signature is the parsed result of a signed request.
+type signature struct {
+	accessKeyID   string
+	date          time.Time
+	scope         string
+	signedHeaders []string
+	signature     string
+	payloadHash   string
+	presigned     bool
+	expires       time.Duration
+}
+
+// now is replaced in tests.
+var now = time.Now
+
+// Verify checks the AWS Signature Version 4 of r, either from the Authorization header
+// or from presigned query parameters, and returns the access key ID used to sign it.
+// Request body is replaced with a reader that verifies the payload while being read.
+func Verify(r *http.Request, secretOf SecretFunc) (string, error) {
+	var (
+		sig *signature
+		err error
+	)
+	if r.URL.Query().Has("X-Amz-Credential") {
+		sig, err = parsePresigned(r)
+	} else {
+		sig, err = parseHeader(r)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	current := now()
+	if sig.presigned {
+		if sig.date.Sub(current) > maxClockSkew {
+			return "", ErrRequestTimeTooSkewed
+		}
+		if current.After(sig.date.Add(sig.expires)) {
+			return "", ErrExpiredPresignRequest
+		}
+	} else if d := current.Sub(sig.date); d > maxClockSkew || d < -maxClockSkew {
+		return "", ErrRequestTimeTooSkewed
+	}
+
+	secret, err := secretOf(sig.accessKeyID)
+	if err != nil {
+		return "", err
+	}
+
+	key := signingKey(secret, sig.scope)
+	expected := hex.EncodeToString(hmacSHA256(key, stringToSign(sig.date, sig.scope, canonicalRequest(r, sig))))
+	if !hmac.Equal([]byte(expected), []byte(sig.signature)) {
+		return "", ErrSignatureDoesNotMatch
+	}
+
+	if err := wrapPayload(r, sig, key); err != nil {
+		return "", err
+	}
+
+	return sig.accessKeyID, nil
+}
+
+func parseHeader(r *http.Request) (*signature, error) {
+	auth := r.Header.Get(headerAuthorization)
+	if auth == "" {
+		return nil, ErrAccessDenied
+	}
+
+	algorithm, rest, _ := strings.Cut(auth, " ")
+	if algorithm != signAlgorithm {
+		return nil, ErrSignatureVersionNotSupported
+	}
+
+	fields := make(map[string]string, 3)
+	for _, part := range strings.Split(rest, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, ErrAuthorizationMalformed
+		}
+		fields[k] = v
+	}
+
+	if fields["Credential"] == "" || fields["SignedHeaders"] == "" || fields["Signature"] == "" {
+		return nil, ErrAuthorizationMalformed
+	}
+
+	sig := &signature{
+		signedHeaders: strings.Split(fields["SignedHeaders"], ";"),
+		signature:     fields["Signature"],
+		payloadHash:   r.Header.Get(headerContentSHA256),
+	}
+	if sig.payloadHash == "" {
+		return nil, ErrMissingContentSHA256
+	}
+
+	rawDate := r.Header.Get(headerAmzDate)
+	if rawDate == "" {
+		rawDate = r.Header.Get("Date")
+	}
+	if err := sig.parseDate(rawDate); err != nil {
+		return nil, err
+	}
+
+	if err := sig.parseCredential(fields["Credential"], ErrAuthorizationMalformed); err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+func parsePresigned(r *http.Request) (*signature, error) {
+	q := r.URL.Query()
+	if q.Get("X-Amz-Algorithm") != signAlgorithm {
+		return nil, ErrSignatureVersionNotSupported
+	}
+
+	expires, err := strconv.Atoi(q.Get("X-Amz-Expires"))
+	if err != nil || expires < 0 || time.Duration(expires)*time.Second > maxPresignLife {
+		return nil, ErrPresignMalformed
+	}
+
+	sig := &signature{
+		signedHeaders: strings.Split(q.Get("X-Amz-SignedHeaders"), ";"),
+		signature:     q.Get("X-Amz-Signature"),
+		payloadHash:   unsignedPayload,
+		presigned:     true,
+		expires:       time.Duration(expires) * time.Second,
+	}
+	if sig.signature == "" || q.Get("X-Amz-SignedHeaders") == "" {
+		return nil, ErrPresignMalformed
+	}
+	if h := q.Get(headerContentSHA256); h != "" {
+		sig.payloadHash = h
+	}
+
+	if err := sig.parseDate(q.Get(headerAmzDate)); err != nil {
+		return nil, err
+	}
+
+	if err := sig.parseCredential(q.Get("X-Amz-Credential"), ErrPresignMalformed); err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+func (s *signature) parseDate(raw string) error {
+	if raw == "" {
+		return ErrMissingDate
+	}
+
+	date, err := time.Parse(iso8601Format, raw)
+	if err != nil {
+		date, err = http.ParseTime(raw)
+		if err != nil {
+			return ErrMissingDate
+		}
+	}
+
+	s.date = date.UTC()
+	return nil
+}
+
+// parseCredential parses "<access key>/<date>/<region>/<service>/aws4_request".
+func (s *signature) parseCredential(credential string, malformed error) error {
+	parts := strings.Split(credential, "/")
+	if len(parts) != 5 || parts[0] == "" || parts[4] != scopeTerminal || parts[3] != serviceName {
+		return malformed
+	}
+
+	if parts[1] != s.date.Format(yyyymmdd) {
+		return malformed
+	}
+
+	s.accessKeyID = parts[0]
+	s.scope = strings.Join(parts[1:], "/")
+	return nil
+}
+
+func canonicalRequest(r *http.Request, sig *signature) string {
+	var b strings.Builder
+	b.WriteString(r.Method)
+	b.WriteByte('\n')
+	b.WriteString(encodePath(r.URL.Path))
+	b.WriteByte('\n')
+	b.WriteString(canonicalQuery(r.URL.Query(), sig.presigned))
+	b.WriteByte('\n')
+
+	for _, h := range sig.signedHeaders {
+		b.WriteString(h)
+		b.WriteByte(':')
+		b.WriteString(canonicalHeaderValue(r, h))
+		b.WriteByte('\n')
+	}
+
+	b.WriteByte('\n')
+	b.WriteString(strings.Join(sig.signedHeaders, ";"))
+	b.WriteByte('\n')
+	b.WriteString(sig.payloadHash)
+	return b.String()
+}
+
+func canonicalQuery(q url.Values, presigned bool) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		if presigned && k == "X-Amz-Signature" {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values := append([]string(nil), q[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			pairs = append(pairs, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+func canonicalHeaderValue(r *http.Request, name string) string {
+	switch name {
+	case "host":
+		return r.Host
+	case "content-length":
+		if r.ContentLength >= 0 {
+			return strconv.FormatInt(r.ContentLength, 10)
+		}
+	}
+
+	values := make([]string, 0, 1)
+	for _, v := range r.Header.Values(name) {
+		values = append(values, strings.Join(strings.Fields(v), " "))
+	}
+	return strings.Join(values, ",")
+}
+
+func stringToSign(date time.Time, scope, canonical string) string {
+	hashed := sha256.Sum256([]byte(canonical))
+	return signAlgorithm + "\n" + date.Format(iso8601Format) + "\n" + scope + "\n" + hex.EncodeToString(hashed[:])
+}
+
+func signingKey(secret, scope string) []byte {
+	key := []byte("AWS4" + secret)
+	for _, part := range strings.Split(scope, "/") {
+		key = hmacSHA256(key, part)
+	}
+	return key
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// encodePath encodes a decoded URL path with S3 rules, slashes are kept as-is.
+func encodePath(p string) string {
+	if p == "" {
+		return "/"
+	}
+	return uriEncode(p, false)
+}
+
+// uriEncode percent-encodes everything except unreserved characters defined in RFC 3986.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !encodeSlash) {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+// wrapPayload replaces request body with a reader verifying the payload hash or chunk signatures.
+func wrapPayload(r *http.Request, sig *signature, key []byte) error {
+	switch sig.payloadHash {
+	case unsignedPayload:
+		return nil
+	case streamingPayload, streamingUnsignedTrail:
+		size, err := strconv.ParseInt(r.Header.Get(headerDecodedLength), 10, 64)
+		if err != nil || size < 0 {
+			return ErrMissingContentLength
+		}
+
+		reader := &chunkedReader{
+			src:       bufio.NewReader(r.Body),
+			closer:    r.Body,
+			remaining: size,
+		}
+		if sig.payloadHash == streamingPayload {
+			reader.key = key
+			reader.date = sig.date
+			reader.scope = sig.scope
+			reader.prevSignature = sig.signature
+		} else {
+			reader.unsigned = true
+		}
+
+		r.Body = reader
+		r.ContentLength = size
+		return nil
+	default:
+		expected, err := hex.DecodeString(sig.payloadHash)
+		if err != nil || len(expected) != sha256.Size {
+			return ErrContentSHA256Mismatch
+		}
+
+		if r.Body == nil {
+			r.Body = http.NoBody
+		}
+		r.Body = &hashingReader{ReadCloser: r.Body, hash: sha256.New(), expected: expected, mismatch: ErrContentSHA256Mismatch}
+		return nil
+	}
+}
+
+// hashingReader reports mismatch error at EOF if the body does not match the expected digest.
+type hashingReader struct {
+	io.ReadCloser
+	hash     hash.Hash
+	expected []byte
+	mismatch error
+}
+
+func (h *hashingReader) Read(p []byte) (int, error) {
+	n, err := h.ReadCloser.Read(p)
+	h.hash.Write(p[:n])
+	if errors.Is(err, io.EOF) && !bytes.Equal(h.hash.Sum(nil), h.expected) {
+		return n, h.mismatch
+	}
+	return n, err
+}
+
+// chunkedReader decodes aws-chunked payload, verifying signature of each chunk
+// before any of its data is returned.
+type chunkedReader struct {
+	src    *bufio.Reader
+	closer io.Closer
+
+	key           []byte
+	date          time.Time
+	scope         string
+	prevSignature string
+	unsigned      bool
+
+	buf       []byte
+	remaining int64
+	done      bool
+	err       error
+}
+
+func (c *chunkedReader) Read(p []byte) (int, error) {
+	for len(c.buf) == 0 {
+		if c.err != nil {
+			return 0, c.err
+		}
+		if c.done {
+			return 0, io.EOF
+		}
+		if err := c.nextChunk(); err != nil {
+			c.err = err
+		}
+	}
+
+	n := copy(p, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+func (c *chunkedReader) Close() error {
+	return c.closer.Close()
+}
+
+func (c *chunkedReader) nextChunk() error {
+	header, err := c.readLine()
+	if err != nil {
+		return err
+	}
+
+	sizeHex, chunkSig := header, ""
+	if !c.unsigned {
+		var ok bool
+		sizeHex, chunkSig, ok = strings.Cut(header, chunkSignatureSegment)
+		if !ok {
+			return ErrInvalidChunk
+		}
+	} else {
+		// Unsigned chunks may still carry extensions, which are ignored.
+		sizeHex, _, _ = strings.Cut(header, ";")
+	}
+
+	size, err := strconv.ParseInt(sizeHex, 16, 64)
+	if err != nil || size < 0 || size > maxChunkSize || size > c.remaining {
+		return ErrInvalidChunk
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(c.src, data); err != nil {
+		return ErrInvalidChunk
+	}
+
+	if !c.unsigned {
+		hashed := sha256.Sum256(data)
+		toSign := "AWS4-HMAC-SHA256-PAYLOAD\n" + c.date.Format(iso8601Format) + "\n" + c.scope + "\n" +
+			c.prevSignature + "\n" + emptySHA256 + "\n" + hex.EncodeToString(hashed[:])
+		expected := hex.EncodeToString(hmacSHA256(c.key, toSign))
+		if !hmac.Equal([]byte(expected), []byte(chunkSig)) {
+			return ErrSignatureDoesNotMatch
+		}
+		c.prevSignature = chunkSig
+	}
+
+	if size == 0 {
+		// Final chunk, followed by optional trailers and an empty line.
+		for {
+			line, err := c.readLine()
+			if err != nil {
+				return err
+			}
+			if line == "" {
+				break
+			}
+		}
+
+		if c.remaining != 0 {
+			return ErrInvalidChunk
+		}
+		c.done = true
+		return nil
+	}
+
+	if line, err := c.readLine(); err != nil || line != "" {
+		return ErrInvalidChunk
+	}
+
+	c.remaining -= size
+	c.buf = data
+	return nil
+}
+
+func (c *chunkedReader) readLine() (string, error) {
+	var line []byte
+	for {
+		part, isPrefix, err := c.src.ReadLine()
+		if err != nil {
+			return "", ErrInvalidChunk
+		}
+		line = append(line, part...)
+		if len(line) > maxChunkHeaderLength {
+			return "", ErrInvalidChunk
+		}
+		if !isPrefix {
+			return string(line), nil
+		}
+	}
+}
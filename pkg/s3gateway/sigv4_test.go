@@ -0,0 +1,240 @@
+package s3gateway
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testAccessKeyID = "CRTESTACCESSKEY00001"
+	testSecret      = "testsecret"
+	testRegion      = "us-east-1"
+)
+
+var testTime = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func testSecretOf(accessKeyID string) (string, error) {
+	if accessKeyID != testAccessKeyID {
+		return "", ErrInvalidAccessKeyID
+	}
+	return testSecret, nil
+}
+
+func newSigner() *v4.Signer {
+	return v4.NewSigner(credentials.NewStaticCredentials(testAccessKeyID, testSecret, ""), func(s *v4.Signer) {
+		s.DisableURIPathEscaping = true
+	})
+}
+
+func signedRequest(t *testing.T, method, target string, body []byte) *http.Request {
+	r := httptest.NewRequest(method, target, bytes.NewReader(body))
+	if _, err := newSigner().Sign(r, bytes.NewReader(body), serviceName, testRegion, testTime); err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func withNow(t *testing.T, current time.Time) {
+	old := now
+	now = func() time.Time { return current }
+	t.Cleanup(func() { now = old })
+}
+
+func TestVerify_Header(t *testing.T) {
+	a := assert.New(t)
+	withNow(t, testTime.Add(time.Minute))
+
+	t.Run("valid", func(t *testing.T) {
+		r := signedRequest(t, http.MethodGet, "http://example.com/s3/bucket/a%20b%2Bc.txt?list-type=2&prefix=x%2Fy", nil)
+		id, err := Verify(r, testSecretOf)
+		a.NoError(err)
+		a.Equal(testAccessKeyID, id)
+	})
+
+	t.Run("tampered query", func(t *testing.T) {
+		r := signedRequest(t, http.MethodGet, "http://example.com/s3/bucket?prefix=a", nil)
+		r.URL.RawQuery = "prefix=b"
+		_, err := Verify(r, testSecretOf)
+		a.ErrorIs(err, ErrSignatureDoesNotMatch)
+	})
+
+	t.Run("unknown access key", func(t *testing.T) {
+		r := signedRequest(t, http.MethodGet, "http://example.com/s3/", nil)
+		r.Header.Set(headerAuthorization, strings.Replace(r.Header.Get(headerAuthorization), testAccessKeyID, "CROTHER", 1))
+		_, err := Verify(r, testSecretOf)
+		a.ErrorIs(err, ErrInvalidAccessKeyID)
+	})
+
+	t.Run("clock skew", func(t *testing.T) {
+		withNow(t, testTime.Add(maxClockSkew+time.Minute))
+		r := signedRequest(t, http.MethodGet, "http://example.com/s3/", nil)
+		_, err := Verify(r, testSecretOf)
+		a.ErrorIs(err, ErrRequestTimeTooSkewed)
+	})
+
+	t.Run("unsupported algorithm", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "http://example.com/s3/", nil)
+		r.Header.Set(headerAuthorization, "AWS CRTESTACCESSKEY00001:signature")
+		_, err := Verify(r, testSecretOf)
+		a.ErrorIs(err, ErrSignatureVersionNotSupported)
+	})
+}
+
+func TestVerify_Payload(t *testing.T) {
+	a := assert.New(t)
+	withNow(t, testTime)
+
+	r := signedRequest(t, http.MethodPut, "http://example.com/s3/bucket/file.txt", []byte("hello"))
+	_, err := Verify(r, testSecretOf)
+	a.NoError(err)
+	content, err := io.ReadAll(r.Body)
+	a.NoError(err)
+	a.Equal("hello", string(content))
+
+	r = signedRequest(t, http.MethodPut, "http://example.com/s3/bucket/file.txt", []byte("hello"))
+	r.Body = io.NopCloser(strings.NewReader("hellO"))
+	_, err = Verify(r, testSecretOf)
+	a.NoError(err, "payload is verified when being read")
+	_, err = io.ReadAll(r.Body)
+	a.ErrorIs(err, ErrContentSHA256Mismatch)
+}
+
+func TestVerify_Presigned(t *testing.T) {
+	a := assert.New(t)
+	r := httptest.NewRequest(http.MethodGet, "http://example.com/s3/bucket/file.txt", nil)
+	if _, err := newSigner().Presign(r, nil, serviceName, testRegion, time.Hour, testTime); err != nil {
+		t.Fatal(err)
+	}
+	presigned := r.URL.String()
+
+	withNow(t, testTime.Add(30*time.Minute))
+	id, err := Verify(httptest.NewRequest(http.MethodGet, presigned, nil), testSecretOf)
+	a.NoError(err)
+	a.Equal(testAccessKeyID, id)
+
+	_, err = Verify(httptest.NewRequest(http.MethodGet, presigned+"&extra=1", nil), testSecretOf)
+	a.ErrorIs(err, ErrSignatureDoesNotMatch)
+
+	withNow(t, testTime.Add(2*time.Hour))
+	_, err = Verify(httptest.NewRequest(http.MethodGet, presigned, nil), testSecretOf)
+	a.ErrorIs(err, ErrExpiredPresignRequest)
+}
+
+// chunkedBody encodes chunks in aws-chunked format, signing each chunk if seed is not empty.
+func chunkedBody(seed string, chunks ...string) string {
+	key := []byte("AWS4" + testSecret)
+	for _, part := range []string{testTime.Format(yyyymmdd), testRegion, serviceName, scopeTerminal} {
+		key = hmacSum(key, part)
+	}
+	scope := fmt.Sprintf("%s/%s/%s/%s", testTime.Format(yyyymmdd), testRegion, serviceName, scopeTerminal)
+
+	var b strings.Builder
+	prev := seed
+	for _, chunk := range append(chunks, "") {
+		if seed == "" {
+			fmt.Fprintf(&b, "%x\r\n%s\r\n", len(chunk), chunk)
+			continue
+		}
+
+		hashed := sha256.Sum256([]byte(chunk))
+		prev = hex.EncodeToString(hmacSum(key, "AWS4-HMAC-SHA256-PAYLOAD\n"+testTime.Format(iso8601Format)+"\n"+
+			scope+"\n"+prev+"\n"+emptySHA256+"\n"+hex.EncodeToString(hashed[:])))
+		fmt.Fprintf(&b, "%x;chunk-signature=%s\r\n%s\r\n", len(chunk), prev, chunk)
+	}
+	return b.String()
+}
+
+func hmacSum(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func streamingRequest(t *testing.T, decodedLength int) (*http.Request, string) {
+	r := httptest.NewRequest(http.MethodPut, "http://example.com/s3/bucket/file.txt", nil)
+	r.Header.Set(headerContentSHA256, streamingPayload)
+	r.Header.Set(headerDecodedLength, fmt.Sprint(decodedLength))
+	if _, err := newSigner().Sign(r, nil, serviceName, testRegion, testTime); err != nil {
+		t.Fatal(err)
+	}
+
+	auth := r.Header.Get(headerAuthorization)
+	return r, auth[strings.LastIndex(auth, "=")+1:]
+}
+
+func TestVerify_Chunked(t *testing.T) {
+	a := assert.New(t)
+	withNow(t, testTime)
+
+	t.Run("signed chunks", func(t *testing.T) {
+		r, seed := streamingRequest(t, 11)
+		r.Body = io.NopCloser(strings.NewReader(chunkedBody(seed, "hello ", "world")))
+		_, err := Verify(r, testSecretOf)
+		a.NoError(err)
+		a.EqualValues(11, r.ContentLength)
+
+		content, err := io.ReadAll(r.Body)
+		a.NoError(err)
+		a.Equal("hello world", string(content))
+	})
+
+	t.Run("tampered chunk", func(t *testing.T) {
+		r, seed := streamingRequest(t, 11)
+		body := strings.Replace(chunkedBody(seed, "hello ", "world"), "world", "w0rld", 1)
+		r.Body = io.NopCloser(strings.NewReader(body))
+		_, err := Verify(r, testSecretOf)
+		a.NoError(err)
+
+		content, err := io.ReadAll(r.Body)
+		a.ErrorIs(err, ErrSignatureDoesNotMatch)
+		a.Equal("hello ", string(content), "data of the bad chunk is never returned")
+	})
+
+	t.Run("length mismatch", func(t *testing.T) {
+		r, seed := streamingRequest(t, 12)
+		r.Body = io.NopCloser(strings.NewReader(chunkedBody(seed, "hello ", "world")))
+		_, err := Verify(r, testSecretOf)
+		a.NoError(err)
+
+		_, err = io.ReadAll(r.Body)
+		a.ErrorIs(err, ErrInvalidChunk)
+	})
+
+	t.Run("unsigned chunks with trailer", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPut, "http://example.com/s3/bucket/file.txt", nil)
+		r.Header.Set(headerContentSHA256, streamingUnsignedTrail)
+		r.Header.Set(headerDecodedLength, "5")
+		if _, err := newSigner().Sign(r, nil, serviceName, testRegion, testTime); err != nil {
+			t.Fatal(err)
+		}
+
+		body := strings.TrimSuffix(chunkedBody("", "hello"), "\r\n") + "x-amz-checksum-crc32:NhCmhg==\r\n\r\n"
+		r.Body = io.NopCloser(strings.NewReader(body))
+		_, err := Verify(r, testSecretOf)
+		a.NoError(err)
+
+		content, err := io.ReadAll(r.Body)
+		a.NoError(err)
+		a.Equal("hello", string(content))
+	})
+}
+
+func TestToS3Error(t *testing.T) {
+	a := assert.New(t)
+	a.Equal(ErrNoSuchBucket, toS3Error(fmt.Errorf("wrapped: %w", ErrNoSuchBucket)))
+	a.Equal(ErrInternal, toS3Error(errors.New("unknown")))
+}
@@ -0,0 +1,158 @@
+package s3gateway
+
+import (
+	"encoding/xml"
+)
+
+const (
+	xmlns          = "http://s3.amazonaws.com/doc/2006-03-01/"
+	timeFormatISO  = "2006-01-02T15:04:05.000Z"
+	storageClass   = "STANDARD"
+	defaultMaxKeys = 1000
+)
+
+type owner struct {
+	ID          string
+	DisplayName string
+}
+
+type bucket struct {
+	Name         string
+	CreationDate string
+}
+
+type listAllMyBucketsResult struct {
+	XMLName xml.Name `xml:"ListAllMyBucketsResult"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	Owner   owner
+	Buckets []bucket `xml:"Buckets>Bucket"`
+}
+
+type object struct {
+	Key          string
+	LastModified string
+	ETag         string
+	Size         int64
+	StorageClass string
+	Owner        *owner `xml:",omitempty"`
+}
+
+type commonPrefix struct {
+	Prefix string
+}
+
+type listBucketResult struct {
+	XMLName        xml.Name `xml:"ListBucketResult"`
+	Xmlns          string   `xml:"xmlns,attr"`
+	Name           string
+	Prefix         string
+	Delimiter      string `xml:",omitempty"`
+	MaxKeys        int
+	EncodingType   string `xml:",omitempty"`
+	IsTruncated    bool
+	Contents       []object
+	CommonPrefixes []commonPrefix
+
+	// ListObjects (v1)
+	Marker     *string `xml:",omitempty"`
+	NextMarker string  `xml:",omitempty"`
+
+	// ListObjectsV2
+	KeyCount              *int   `xml:",omitempty"`
+	StartAfter            string `xml:",omitempty"`
+	ContinuationToken     string `xml:",omitempty"`
+	NextContinuationToken string `xml:",omitempty"`
+}
+
+type locationConstraint struct {
+	XMLName  xml.Name `xml:"LocationConstraint"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Location string   `xml:",chardata"`
+}
+
+type versioningConfiguration struct {
+	XMLName xml.Name `xml:"VersioningConfiguration"`
+	Xmlns   string   `xml:"xmlns,attr"`
+}
+
+type deleteRequest struct {
+	XMLName xml.Name `xml:"Delete"`
+	Quiet   bool
+	Objects []struct {
+		Key string
+	} `xml:"Object"`
+}
+
+type deletedObject struct {
+	Key string
+}
+
+type deleteError struct {
+	Key     string
+	Code    string
+	Message string
+}
+
+type deleteResult struct {
+	XMLName xml.Name `xml:"DeleteResult"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	Deleted []deletedObject
+	Errors  []deleteError `xml:"Error"`
+}
+
+type initiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Bucket   string
+	Key      string
+	UploadId string
+}
+
+type completePart struct {
+	PartNumber int
+	ETag       string
+}
+
+type completeMultipartUpload struct {
+	XMLName xml.Name       `xml:"CompleteMultipartUpload"`
+	Parts   []completePart `xml:"Part"`
+}
+
+type completeMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"CompleteMultipartUploadResult"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Location string
+	Bucket   string
+	Key      string
+	ETag     string
+}
+
+type part struct {
+	PartNumber   int
+	LastModified string
+	ETag         string
+	Size         int64
+}
+
+type listPartsResult struct {
+	XMLName              xml.Name `xml:"ListPartsResult"`
+	Xmlns                string   `xml:"xmlns,attr"`
+	Bucket               string
+	Key                  string
+	UploadId             string
+	StorageClass         string
+	PartNumberMarker     int
+	NextPartNumberMarker int
+	MaxParts             int
+	IsTruncated          bool
+	Parts                []part `xml:"Part"`
+}
+
+type listMultipartUploadsResult struct {
+	XMLName     xml.Name `xml:"ListMultipartUploadsResult"`
+	Xmlns       string   `xml:"xmlns,attr"`
+	Bucket      string
+	KeyMarker   string
+	MaxUploads  int
+	IsTruncated bool
+}
@@ -0,0 +1,422 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/query"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+)
+
+// fieldBoosts weights matches in each text field, a match in name is more relevant than in extracted text.
+var fieldBoosts = map[string]float64{
+	FieldName:        3,
+	FieldTags:        2,
+	FieldDescription: 1.5,
+	FieldText:        1,
+}
+
+// bleveRebuildSuffix is appended to index path for the new index being filled by Rebuild.
+const bleveRebuildSuffix = ".rebuild"
+
+type bleveIndexer struct {
+	path string
+	l    logging.Logger
+
+	mu    sync.RWMutex
+	index bleve.Index
+	// rebuilding is the new index being filled by Rebuild, changes are also written into it.
+	rebuilding bleve.Index
+}
+
+// NewBleveIndexer opens the embedded Bleve index at given path, creating it if not exist.
+func NewBleveIndexer(path string, l logging.Logger) (Indexer, error) {
+	index, err := bleve.Open(path)
+	if errors.Is(err, bleve.ErrorIndexPathDoesNotExist) {
+		l.Info("Creating full text search index at %q...", path)
+		index, err = bleve.New(path, newIndexMapping())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to open search index at %q: %w", path, err)
+	}
+
+	return &bleveIndexer{path: path, index: index, l: l}, nil
+}
+
+// newIndexMapping maps text fields with the CJK analyzer, which splits CJK text into bigrams and works like the
+// standard analyzer for other languages.
+func newIndexMapping() mapping.IndexMapping {
+	text := bleve.NewTextFieldMapping()
+	text.Analyzer = cjk.AnalyzerName
+	text.Store = true
+	text.IncludeTermVectors = true
+
+	numeric := bleve.NewNumericFieldMapping()
+	numeric.Store = false
+
+	date := bleve.NewDateTimeFieldMapping()
+	date.Store = false
+
+	keyword := bleve.NewKeywordFieldMapping()
+	keyword.Store = false
+
+	doc := bleve.NewDocumentStaticMapping()
+	for _, field := range TextFields {
+		doc.AddFieldMappingsAt(field, text)
+	}
+	doc.AddFieldMappingsAt(FieldOwner, numeric)
+	doc.AddFieldMappingsAt(FieldType, numeric)
+	doc.AddFieldMappingsAt(FieldSize, numeric)
+	doc.AddFieldMappingsAt(FieldPolicy, numeric)
+	doc.AddFieldMappingsAt(FieldUpdatedAt, date)
+	doc.AddFieldMappingsAt(FieldCreatedAt, date)
+	doc.AddFieldMappingsAt(FieldExt, keyword)
+	doc.AddFieldMappingsAt(FieldTagKeys, keyword)
+	doc.AddFieldMappingsAt(FieldNamePinyin, keyword)
+	doc.AddFieldMappingsAt(FieldNameInitials, keyword)
+
+	m := bleve.NewIndexMapping()
+	m.DefaultMapping = doc
+	m.DefaultAnalyzer = cjk.AnalyzerName
+	return m
+}
+
+// targets returns indices written by changes, read lock must be held.
+func (b *bleveIndexer) targets() []bleve.Index {
+	if b.rebuilding != nil {
+		return []bleve.Index{b.index, b.rebuilding}
+	}
+
+	return []bleve.Index{b.index}
+}
+
+func (b *bleveIndexer) Index(ctx context.Context, docs ...*Document) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, index := range b.targets() {
+		if err := bleveIndexInto(index, docs...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func bleveIndexInto(index bleve.Index, docs ...*Document) error {
+	batch := index.NewBatch()
+	for _, doc := range docs {
+		py, initials := namePinyin(doc.Name)
+		if err := batch.Index(strconv.Itoa(doc.ID), map[string]interface{}{
+			FieldName:         doc.Name,
+			FieldDescription:  doc.Description,
+			FieldTags:         doc.Tags,
+			FieldText:         doc.Text,
+			FieldOwner:        float64(doc.OwnerID),
+			FieldType:         float64(doc.Type),
+			FieldSize:         float64(doc.Size),
+			FieldPolicy:       float64(doc.PolicyID),
+			FieldUpdatedAt:    doc.UpdatedAt,
+			FieldCreatedAt:    doc.CreatedAt,
+			FieldExt:          doc.Ext,
+			FieldTagKeys:      doc.Tags,
+			FieldNamePinyin:   py,
+			FieldNameInitials: initials,
+		}); err != nil {
+			return fmt.Errorf("failed to index file %d: %w", doc.ID, err)
+		}
+	}
+
+	return index.Batch(batch)
+}
+
+func (b *bleveIndexer) Delete(ctx context.Context, ids ...int) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, index := range b.targets() {
+		batch := index.NewBatch()
+		for _, id := range ids {
+			batch.Delete(strconv.Itoa(id))
+		}
+
+		if err := index.Batch(batch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *bleveIndexer) Search(ctx context.Context, q *Query) (*Result, error) {
+	fields := make([]query.Query, 0, len(TextFields))
+	for _, field := range TextFields {
+		match := bleve.NewMatchQuery(q.Text)
+		match.SetField(field)
+		match.SetBoost(fieldBoosts[field])
+		fields = append(fields, match)
+	}
+
+	if fuzzyEnabled(q) {
+		match := bleve.NewMatchQuery(q.Text)
+		match.SetField(FieldName)
+		match.SetFuzziness(1)
+		match.SetBoost(fuzzyBoost)
+		fields = append(fields, match)
+	}
+
+	if text, ok := pinyinQueryText(q.Text); ok && q.Pinyin {
+		for _, field := range []string{FieldNamePinyin, FieldNameInitials} {
+			wildcard := bleve.NewWildcardQuery("*" + text + "*")
+			wildcard.SetField(field)
+			wildcard.SetBoost(pinyinBoost)
+			fields = append(fields, wildcard)
+		}
+	}
+
+	conditions := append(bleveFilters(q), bleve.NewDisjunctionQuery(fields...))
+	req := bleve.NewSearchRequestOptions(bleve.NewConjunctionQuery(conditions...), q.Limit, 0, false)
+	// Document IDs are compared as strings, which is a consistent tie breaker as well.
+	req.SortBy([]string{"-_score", "_id"})
+	if q.After != nil {
+		req.SetSearchAfter([]string{strconv.FormatFloat(q.After.Score, 'g', -1, 64), strconv.Itoa(q.After.ID)})
+	}
+	req.Fields = TextFields
+	req.IncludeLocations = true
+
+	b.mu.RLock()
+	res, err := b.index.SearchInContext(ctx, req)
+	b.mu.RUnlock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to search index: %w", err)
+	}
+
+	hits := make([]Hit, 0, len(res.Hits))
+	for _, match := range res.Hits {
+		id, err := strconv.Atoi(match.ID)
+		if err != nil {
+			b.l.Warning("Invalid document ID %q in search index.", match.ID)
+			continue
+		}
+
+		hits = append(hits, Hit{
+			ID:         id,
+			Score:      match.Score,
+			Highlights: highlight(match.Fields, match.Locations),
+		})
+	}
+
+	return &Result{Total: res.Total, Hits: hits}, nil
+}
+
+func (b *bleveIndexer) Stats(ctx context.Context) (*Stats, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	count, err := b.index.DocCount()
+	if err != nil {
+		return &Stats{Health: HealthRed}, nil
+	}
+
+	return &Stats{Documents: count, SizeBytes: dirSize(b.path), Health: HealthGreen}, nil
+}
+
+// Rebuild fills a new index next to the current one, and replaces the current one with it once completed.
+// Searches are served by the current index meanwhile.
+func (b *bleveIndexer) Rebuild(ctx context.Context, fill func(ctx context.Context, target Indexer) error) error {
+	b.mu.Lock()
+	if b.rebuilding != nil {
+		b.mu.Unlock()
+		return ErrRebuilding
+	}
+
+	newPath := b.path + bleveRebuildSuffix
+	_ = os.RemoveAll(newPath)
+	index, err := bleve.New(newPath, newIndexMapping())
+	if err != nil {
+		b.mu.Unlock()
+		return fmt.Errorf("failed to create new index at %q: %w", newPath, err)
+	}
+
+	b.rebuilding = index
+	b.mu.Unlock()
+
+	fillErr := fill(ctx, &bleveIndexWriter{index: index})
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.rebuilding = nil
+	if err := index.Close(); err != nil && fillErr == nil {
+		fillErr = err
+	}
+
+	if fillErr != nil {
+		_ = os.RemoveAll(newPath)
+		return fmt.Errorf("failed to fill new index: %w", fillErr)
+	}
+
+	if err := b.index.Close(); err != nil {
+		b.l.Warning("Failed to close search index: %s", err)
+	}
+
+	if err := os.RemoveAll(b.path); err != nil {
+		return fmt.Errorf("failed to remove old index at %q: %w", b.path, err)
+	}
+
+	if err := os.Rename(newPath, b.path); err != nil {
+		return fmt.Errorf("failed to move new index to %q: %w", b.path, err)
+	}
+
+	b.index, err = bleve.Open(b.path)
+	if err != nil {
+		return fmt.Errorf("failed to open rebuilt index at %q: %w", b.path, err)
+	}
+
+	b.l.Info("Search index at %q is rebuilt.", b.path)
+	return nil
+}
+
+func (b *bleveIndexer) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.index.Close()
+}
+
+// dirSize returns total size of files under given directory, unreadable files are ignored.
+func dirSize(path string) int64 {
+	var size int64
+	_ = filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			if info, err := d.Info(); err == nil {
+				size += info.Size()
+			}
+		}
+		return nil
+	})
+
+	return size
+}
+
+// bleveIndexWriter writes documents into a new index being rebuilt.
+type bleveIndexWriter struct {
+	index bleve.Index
+}
+
+func (w *bleveIndexWriter) Index(ctx context.Context, docs ...*Document) error {
+	return bleveIndexInto(w.index, docs...)
+}
+
+func (w *bleveIndexWriter) Delete(ctx context.Context, ids ...int) error {
+	return nil
+}
+
+func (w *bleveIndexWriter) Search(ctx context.Context, q *Query) (*Result, error) {
+	return nil, fmt.Errorf("index is being rebuilt")
+}
+
+func (w *bleveIndexWriter) Stats(ctx context.Context) (*Stats, error) {
+	return nil, fmt.Errorf("index is being rebuilt")
+}
+
+func (w *bleveIndexWriter) Close() error {
+	return nil
+}
+
+// bleveFilters converts owner and filters of the query into conditions, which must all be matched.
+func bleveFilters(q *Query) []query.Query {
+	conditions := []query.Query{bleveNumericRange(FieldOwner, float64(q.OwnerID), float64(q.OwnerID))}
+	if q.Type != nil {
+		conditions = append(conditions, bleveNumericRange(FieldType, float64(*q.Type), float64(*q.Type)))
+	}
+
+	if q.SizeGte > 0 || q.SizeLte > 0 {
+		from, to := float64(q.SizeGte), math.Inf(1)
+		if q.SizeLte > 0 {
+			to = float64(q.SizeLte)
+		}
+		conditions = append(conditions, bleveNumericRange(FieldSize, from, to))
+
+		// Size ranges match only files, unless folders are explicitly searched for.
+		if q.Type == nil {
+			fileType := float64(types.FileTypeFile)
+			conditions = append(conditions, bleveNumericRange(FieldType, fileType, fileType))
+		}
+	}
+
+	if q.PolicyID > 0 {
+		conditions = append(conditions, bleveNumericRange(FieldPolicy, float64(q.PolicyID), float64(q.PolicyID)))
+	}
+
+	if q.CreatedAtGte != nil || q.CreatedAtLte != nil {
+		conditions = append(conditions, bleveDateRange(FieldCreatedAt, q.CreatedAtGte, q.CreatedAtLte))
+	}
+
+	if q.UpdatedAtGte != nil || q.UpdatedAtLte != nil {
+		conditions = append(conditions, bleveDateRange(FieldUpdatedAt, q.UpdatedAtGte, q.UpdatedAtLte))
+	}
+
+	if len(q.Exts) > 0 {
+		exts := make([]query.Query, 0, len(q.Exts))
+		for _, ext := range q.Exts {
+			term := bleve.NewTermQuery(strings.ToLower(ext))
+			term.SetField(FieldExt)
+			exts = append(exts, term)
+		}
+		conditions = append(conditions, bleve.NewDisjunctionQuery(exts...))
+	}
+
+	for _, tag := range q.Tags {
+		term := bleve.NewTermQuery(tag)
+		term.SetField(FieldTagKeys)
+		conditions = append(conditions, term)
+	}
+
+	return conditions
+}
+
+// bleveNumericRange matches values in [from, to], infinite bounds are open ended.
+func bleveNumericRange(field string, from, to float64) query.Query {
+	inclusive := true
+	var fromP, toP *float64
+	if !math.IsInf(from, -1) {
+		fromP = &from
+	}
+	if !math.IsInf(to, 1) {
+		toP = &to
+	}
+
+	q := bleve.NewNumericRangeInclusiveQuery(fromP, toP, &inclusive, &inclusive)
+	q.SetField(field)
+	return q
+}
+
+func bleveDateRange(field string, start, end *time.Time) query.Query {
+	var s, e time.Time
+	if start != nil {
+		s = *start
+	}
+	if end != nil {
+		e = *end
+	}
+
+	// Zero time is treated as open ended by Bleve.
+	inclusive := true
+	q := bleve.NewDateRangeInclusiveQuery(s, e, &inclusive, &inclusive)
+	q.SetField(field)
+	return q
+}
@@ -0,0 +1,203 @@
+package search
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBleveIndexer(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+	indexer, err := NewBleveIndexer(filepath.Join(t.TempDir(), "index"), logging.NewConsoleLogger(logging.LevelError))
+	a.NoError(err)
+	defer indexer.Close()
+
+	a.NoError(indexer.Index(ctx,
+		&Document{ID: 1, OwnerID: 1, Name: "annual report 2024.pdf", Text: "revenue grew by <10%>", UpdatedAt: time.Now()},
+		&Document{ID: 2, OwnerID: 1, Name: "holiday.jpg", Tags: []string{"beach", "report"}},
+		&Document{ID: 3, OwnerID: 2, Name: "report.docx"},
+		&Document{ID: 4, OwnerID: 1, Name: "年度报告.docx"},
+	))
+
+	res, err := indexer.Search(ctx, &Query{OwnerID: 1, Text: "report", Limit: 10})
+	a.NoError(err)
+	a.EqualValues(2, res.Total)
+	a.Equal(1, res.Hits[0].ID, "match in name ranks first")
+	a.Equal([]string{"annual <mark>report</mark> 2024.pdf"}, res.Hits[0].Highlights[FieldName])
+	a.Equal([]string{"<mark>report</mark>"}, res.Hits[1].Highlights[FieldTags])
+
+	res, err = indexer.Search(ctx, &Query{OwnerID: 1, Text: "revenue", Limit: 10})
+	a.NoError(err)
+	a.Equal([]string{"<mark>revenue</mark> grew by &lt;10%&gt;"}, res.Hits[0].Highlights[FieldText])
+
+	res, err = indexer.Search(ctx, &Query{OwnerID: 1, Text: "报告", Limit: 10})
+	a.NoError(err)
+	a.Len(res.Hits, 1)
+	a.Equal(4, res.Hits[0].ID)
+
+	a.NoError(indexer.Delete(ctx, 1))
+	res, err = indexer.Search(ctx, &Query{OwnerID: 1, Text: "annual", Limit: 10})
+	a.NoError(err)
+	a.Len(res.Hits, 0)
+}
+
+func TestBleveIndexerFilters(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+	indexer, err := NewBleveIndexer(filepath.Join(t.TempDir(), "index"), logging.NewConsoleLogger(logging.LevelError))
+	a.NoError(err)
+	defer indexer.Close()
+
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	a.NoError(indexer.Index(ctx,
+		&Document{ID: 1, OwnerID: 1, Name: "trip photo.jpg", Ext: "jpg", Size: 2048, PolicyID: 1,
+			Tags: []string{"travel", "family"}, CreatedAt: day, UpdatedAt: day},
+		&Document{ID: 2, OwnerID: 1, Name: "trip notes.txt", Ext: "txt", Size: 10, PolicyID: 2,
+			Tags: []string{"travel"}, CreatedAt: day.AddDate(0, 1, 0), UpdatedAt: day.AddDate(0, 1, 0)},
+		&Document{ID: 3, OwnerID: 1, Name: "trip", Type: 1, PolicyID: 1, CreatedAt: day, UpdatedAt: day},
+	))
+
+	search := func(filters Filters) []int {
+		res, err := indexer.Search(ctx, &Query{OwnerID: 1, Text: "trip", Filters: filters, Limit: 10})
+		a.NoError(err)
+		ids := make([]int, 0, len(res.Hits))
+		for _, hit := range res.Hits {
+			ids = append(ids, hit.ID)
+		}
+		return ids
+	}
+
+	folder := 1
+	after := day.AddDate(0, 0, 1)
+	a.ElementsMatch([]int{1, 2, 3}, search(Filters{}))
+	a.ElementsMatch([]int{1}, search(Filters{SizeGte: 1024}))
+	a.ElementsMatch([]int{2}, search(Filters{SizeLte: 100}))
+	a.ElementsMatch([]int{3}, search(Filters{SizeLte: 100, Type: &folder}))
+	a.ElementsMatch([]int{1, 2}, search(Filters{Exts: []string{"JPG", "txt"}}))
+	a.ElementsMatch([]int{1}, search(Filters{Tags: []string{"travel", "family"}}))
+	a.ElementsMatch([]int{1, 3}, search(Filters{PolicyID: 1}))
+	a.ElementsMatch([]int{3}, search(Filters{Type: &folder}))
+	a.ElementsMatch([]int{2}, search(Filters{CreatedAtGte: &after}))
+	a.ElementsMatch([]int{1, 3}, search(Filters{UpdatedAtLte: &after}))
+}
+
+func TestBleveIndexer_Rebuild(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+	indexer, err := NewBleveIndexer(filepath.Join(t.TempDir(), "index"), logging.NewConsoleLogger(logging.LevelError))
+	a.NoError(err)
+	defer indexer.Close()
+
+	a.NoError(indexer.Index(ctx, &Document{ID: 1, OwnerID: 1, Name: "stale.txt"}))
+	err = indexer.(Rebuilder).Rebuild(ctx, func(ctx context.Context, target Indexer) error {
+		// Concurrent changes are written into both indices.
+		a.NoError(indexer.Index(ctx, &Document{ID: 3, OwnerID: 1, Name: "new.txt"}))
+		a.ErrorIs(indexer.(Rebuilder).Rebuild(ctx, nil), ErrRebuilding)
+		return target.Index(ctx, &Document{ID: 2, OwnerID: 1, Name: "fresh.txt"})
+	})
+	a.NoError(err)
+
+	stats, err := indexer.Stats(ctx)
+	a.NoError(err)
+	a.EqualValues(2, stats.Documents)
+	a.Equal(HealthGreen, stats.Health)
+	a.Positive(stats.SizeBytes)
+
+	res, err := indexer.Search(ctx, &Query{OwnerID: 1, Text: "stale", Limit: 10})
+	a.NoError(err)
+	a.Empty(res.Hits)
+}
+
+func TestBleveIndexer_FuzzyAndPinyin(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+	indexer, err := NewBleveIndexer(filepath.Join(t.TempDir(), "index"), logging.NewConsoleLogger(logging.LevelError))
+	a.NoError(err)
+	defer indexer.Close()
+
+	a.NoError(indexer.Index(ctx,
+		&Document{ID: 1, OwnerID: 1, Name: "报告本.docx"},
+		&Document{ID: 2, OwnerID: 1, Name: "invoice 2024.pdf"},
+	))
+
+	search := func(q *Query) []int {
+		q.OwnerID = 1
+		q.Limit = 10
+		res, err := indexer.Search(ctx, q)
+		a.NoError(err)
+		ids := make([]int, 0, len(res.Hits))
+		for _, hit := range res.Hits {
+			ids = append(ids, hit.ID)
+		}
+		return ids
+	}
+
+	a.Empty(search(&Query{Text: "bgb"}))
+	a.Equal([]int{1}, search(&Query{Text: "bgb", Pinyin: true}))
+	a.Equal([]int{1}, search(&Query{Text: "BaoGao", Pinyin: true}))
+	a.Empty(search(&Query{Text: "invoise"}))
+	a.Equal([]int{2}, search(&Query{Text: "invoise", Fuzzy: true}))
+}
+
+func TestBleveIndexer_Cursor(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+	indexer, err := NewBleveIndexer(filepath.Join(t.TempDir(), "index"), logging.NewConsoleLogger(logging.LevelError))
+	a.NoError(err)
+	defer indexer.Close()
+
+	for i := 1; i <= 5; i++ {
+		a.NoError(indexer.Index(ctx, &Document{ID: i, OwnerID: 1, Name: "report 2024.pdf"}))
+	}
+
+	var (
+		ids   []int
+		after *Cursor
+	)
+	for page := 0; page < 5; page++ {
+		res, err := indexer.Search(ctx, &Query{OwnerID: 1, Text: "report", Limit: 2, After: after})
+		a.NoError(err)
+		a.EqualValues(5, res.Total)
+		if len(res.Hits) == 0 {
+			break
+		}
+
+		for _, hit := range res.Hits {
+			ids = append(ids, hit.ID)
+		}
+		after = res.Hits[len(res.Hits)-1].Cursor()
+	}
+
+	a.Equal([]int{1, 2, 3, 4, 5}, ids, "hits of equal score are ordered by ID without duplicates")
+}
+
+func TestNamePinyin(t *testing.T) {
+	a := assert.New(t)
+	full, initials := namePinyin("2024年度报告.PDF")
+	a.Equal("2024niandubaogao.pdf", full)
+	a.Equal("2024ndbg.pdf", initials)
+
+	full, initials = namePinyin("report.pdf")
+	a.Empty(full)
+	a.Empty(initials)
+}
+
+func TestFragment(t *testing.T) {
+	a := assert.New(t)
+	content := "前言" + strings.Repeat("x ", 60) + "needle" + strings.Repeat(" y", 200)
+	start := strings.Index(content, "needle")
+	res := fragment(content, []span{{start, start + len("needle")}})
+	a.Contains(res, "x <mark>needle</mark> y")
+	a.True(strings.HasPrefix(res, fragmentEllipsis))
+	a.True(strings.HasSuffix(res, fragmentEllipsis))
+	a.True(utf8.ValidString(res))
+
+	a.Equal("a &amp; <mark>b</mark>", fragment("a & b", []span{{4, 5}}))
+}
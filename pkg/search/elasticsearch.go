@@ -0,0 +1,618 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+)
+
+// esHighlightPreTag and esHighlightPostTag wrap matched terms in highlights, same as the Bleve indexer.
+const (
+	esHighlightPreTag  = "<mark>"
+	esHighlightPostTag = "</mark>"
+	// esTrackTotalHits is the maximum number of hits counted exactly, total of larger results is estimated.
+	esTrackTotalHits = 10000
+)
+
+type (
+	// ElasticsearchConfig is the cluster and alias used by Elasticsearch indexer.
+	ElasticsearchConfig struct {
+		Endpoint string
+		Username string
+		Password string
+		// Index is the alias searched and written by the indexer, pointing to a concrete index named with
+		// creation time as suffix.
+		Index string
+	}
+
+	elasticsearchIndexer struct {
+		client request.Client
+		config ElasticsearchConfig
+		l      logging.Logger
+
+		mu sync.Mutex
+		// rebuilding is the new index being filled by Rebuild, changes are also written into it, so that it
+		// does not miss changes made during rebuilding.
+		rebuilding string
+	}
+
+	esBulkResponse struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			ID     string          `json:"_id"`
+			Status int             `json:"status"`
+			Error  json.RawMessage `json:"error"`
+		} `json:"items"`
+	}
+
+	esStatsResponse struct {
+		All struct {
+			Primaries struct {
+				Docs struct {
+					Count uint64 `json:"count"`
+				} `json:"docs"`
+				Store struct {
+					SizeInBytes int64 `json:"size_in_bytes"`
+				} `json:"store"`
+			} `json:"primaries"`
+		} `json:"_all"`
+	}
+
+	esSearchResponse struct {
+		Hits struct {
+			Total struct {
+				Value uint64 `json:"value"`
+				// Relation is "gte" if the value is a lower bound.
+				Relation string `json:"relation"`
+			} `json:"total"`
+			Hits []struct {
+				ID        string              `json:"_id"`
+				Score     float64             `json:"_score"`
+				Highlight map[string][]string `json:"highlight"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+)
+
+// NewElasticsearchIndexer returns an indexer storing documents in Elasticsearch or OpenSearch. The alias and
+// its first index are created if not exist.
+func NewElasticsearchIndexer(ctx context.Context, client request.Client, config ElasticsearchConfig, l logging.Logger) (Indexer, error) {
+	if config.Endpoint == "" || config.Index == "" {
+		return nil, fmt.Errorf("endpoint and index of Elasticsearch must be set")
+	}
+
+	e := &elasticsearchIndexer{client: client, config: config, l: l}
+	indices, err := e.aliasIndices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(indices) == 0 {
+		index := e.newIndexName()
+		l.Info("Creating full text search index %q with alias %q...", index, config.Index)
+		if err := e.createIndex(ctx, index, true); err != nil {
+			return nil, err
+		}
+	} else if _, err := e.request(ctx, http.MethodPut, "/"+url.PathEscape(config.Index)+"/_mapping",
+		esIndexMapping()); err != nil {
+		// Fields added in newer versions are mapped into existing indices, as unknown fields are rejected.
+		return nil, fmt.Errorf("failed to update index mapping: %w", err)
+	}
+
+	return e, nil
+}
+
+// esIndexMapping maps text fields with the CJK analyzer, consistent with the Bleve index.
+func esIndexMapping() map[string]interface{} {
+	text := map[string]interface{}{"type": "text", "analyzer": "cjk"}
+	return map[string]interface{}{
+		"dynamic": "strict",
+		"properties": map[string]interface{}{
+			FieldName:         text,
+			FieldDescription:  text,
+			FieldTags:         text,
+			FieldText:         map[string]interface{}{"type": "text", "analyzer": "cjk", "term_vector": "with_positions_offsets"},
+			FieldOwner:        map[string]interface{}{"type": "integer"},
+			FieldType:         map[string]interface{}{"type": "integer"},
+			FieldSize:         map[string]interface{}{"type": "long"},
+			FieldPolicy:       map[string]interface{}{"type": "integer"},
+			FieldID:           map[string]interface{}{"type": "integer"},
+			FieldUpdatedAt:    map[string]interface{}{"type": "date"},
+			FieldCreatedAt:    map[string]interface{}{"type": "date"},
+			FieldExt:          map[string]interface{}{"type": "keyword"},
+			FieldTagKeys:      map[string]interface{}{"type": "keyword"},
+			FieldNamePinyin:   map[string]interface{}{"type": "keyword"},
+			FieldNameInitials: map[string]interface{}{"type": "keyword"},
+		},
+	}
+}
+
+func (e *elasticsearchIndexer) newIndexName() string {
+	return fmt.Sprintf("%s_%d", e.config.Index, time.Now().UnixNano())
+}
+
+func (e *elasticsearchIndexer) request(ctx context.Context, method, path string, body interface{}, status ...int) ([]byte, error) {
+	var reader *bytes.Reader
+	contentType := "application/json"
+	switch b := body.(type) {
+	case nil:
+		reader = bytes.NewReader(nil)
+	case []byte:
+		// Bulk requests are sent as newline delimited JSON.
+		reader = bytes.NewReader(b)
+		contentType = "application/x-ndjson"
+	default:
+		encoded, err := json.Marshal(b)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	header := http.Header{"Content-Type": []string{contentType}}
+	if e.config.Username != "" {
+		header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString(
+			[]byte(e.config.Username+":"+e.config.Password)))
+	}
+
+	if len(status) == 0 {
+		status = []int{http.StatusOK}
+	}
+
+	content, err := e.client.Request(method, strings.TrimSuffix(e.config.Endpoint, "/")+path, reader,
+		request.WithContext(ctx),
+		request.WithHeader(header),
+	).CheckHTTPResponse(status...).GetResponseIgnoreErr()
+	if err != nil {
+		return nil, fmt.Errorf("failed to request Elasticsearch %s %s: %w: %s", method, path, err, content)
+	}
+
+	return []byte(content), nil
+}
+
+// aliasIndices returns names of indices the alias points to.
+func (e *elasticsearchIndexer) aliasIndices(ctx context.Context) ([]string, error) {
+	content, err := e.request(ctx, http.MethodGet, "/_alias/"+url.PathEscape(e.config.Index), nil,
+		http.StatusOK, http.StatusNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	var aliases map[string]json.RawMessage
+	if err := json.Unmarshal(content, &aliases); err != nil {
+		return nil, fmt.Errorf("failed to decode aliases: %w", err)
+	}
+
+	indices := make([]string, 0, len(aliases))
+	for index, value := range aliases {
+		// Not found response is an object with error and status.
+		if index == "error" || index == "status" || len(value) == 0 || value[0] != '{' {
+			continue
+		}
+		indices = append(indices, index)
+	}
+
+	return indices, nil
+}
+
+func (e *elasticsearchIndexer) createIndex(ctx context.Context, index string, withAlias bool) error {
+	body := map[string]interface{}{
+		"mappings": esIndexMapping(),
+	}
+	if withAlias {
+		body["aliases"] = map[string]interface{}{e.config.Index: map[string]interface{}{}}
+	}
+
+	if _, err := e.request(ctx, http.MethodPut, "/"+url.PathEscape(index), body); err != nil {
+		return fmt.Errorf("failed to create index %q: %w", index, err)
+	}
+
+	return nil
+}
+
+// targets returns indices written by changes.
+func (e *elasticsearchIndexer) targets() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	targets := []string{e.config.Index}
+	if e.rebuilding != "" {
+		targets = append(targets, e.rebuilding)
+	}
+
+	return targets
+}
+
+func (e *elasticsearchIndexer) Index(ctx context.Context, docs ...*Document) error {
+	return e.indexInto(ctx, e.targets(), docs...)
+}
+
+func (e *elasticsearchIndexer) indexInto(ctx context.Context, indices []string, docs ...*Document) error {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, index := range indices {
+		for _, doc := range docs {
+			tags := doc.Tags
+			if tags == nil {
+				tags = []string{}
+			}
+			py, initials := namePinyin(doc.Name)
+
+			if err := encoder.Encode(map[string]interface{}{
+				"index": map[string]string{"_index": index, "_id": strconv.Itoa(doc.ID)},
+			}); err != nil {
+				return err
+			}
+			if err := encoder.Encode(map[string]interface{}{
+				FieldName:         doc.Name,
+				FieldDescription:  doc.Description,
+				FieldTags:         tags,
+				FieldText:         doc.Text,
+				FieldOwner:        doc.OwnerID,
+				FieldType:         doc.Type,
+				FieldSize:         doc.Size,
+				FieldPolicy:       doc.PolicyID,
+				FieldID:           doc.ID,
+				FieldUpdatedAt:    doc.UpdatedAt,
+				FieldCreatedAt:    doc.CreatedAt,
+				FieldExt:          doc.Ext,
+				FieldTagKeys:      tags,
+				FieldNamePinyin:   py,
+				FieldNameInitials: initials,
+			}); err != nil {
+				return fmt.Errorf("failed to encode file %d: %w", doc.ID, err)
+			}
+		}
+	}
+
+	return e.bulk(ctx, body.Bytes())
+}
+
+func (e *elasticsearchIndexer) Delete(ctx context.Context, ids ...int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, index := range e.targets() {
+		for _, id := range ids {
+			if err := encoder.Encode(map[string]interface{}{
+				"delete": map[string]string{"_index": index, "_id": strconv.Itoa(id)},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return e.bulk(ctx, body.Bytes())
+}
+
+// bulk sends bulk actions, failure of any action is returned as error. Deleting documents not exist is not
+// considered as failure.
+func (e *elasticsearchIndexer) bulk(ctx context.Context, body []byte) error {
+	content, err := e.request(ctx, http.MethodPost, "/_bulk", body)
+	if err != nil {
+		return err
+	}
+
+	var res esBulkResponse
+	if err := json.Unmarshal(content, &res); err != nil {
+		return fmt.Errorf("failed to decode bulk response: %w", err)
+	}
+
+	if !res.Errors {
+		return nil
+	}
+
+	for _, item := range res.Items {
+		for action, result := range item {
+			if len(result.Error) > 0 && !(action == "delete" && result.Status == http.StatusNotFound) {
+				return fmt.Errorf("failed to %s document %q: %s", action, result.ID, result.Error)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (e *elasticsearchIndexer) Search(ctx context.Context, q *Query) (*Result, error) {
+	fields := make([]string, 0, len(TextFields))
+	highlights := make(map[string]interface{}, len(TextFields))
+	for _, field := range TextFields {
+		fields = append(fields, fmt.Sprintf("%s^%g", field, fieldBoosts[field]))
+		if field == FieldText || field == FieldDescription {
+			highlights[field] = map[string]interface{}{"fragment_size": fragmentSize, "number_of_fragments": 3}
+		} else {
+			// Short fields are returned as a whole.
+			highlights[field] = map[string]interface{}{"number_of_fragments": 0}
+		}
+	}
+
+	body := map[string]interface{}{
+		"size":             q.Limit,
+		"track_total_hits": esTrackTotalHits,
+		"sort": []interface{}{
+			map[string]interface{}{"_score": "desc"},
+			map[string]interface{}{FieldID: map[string]interface{}{"order": "asc", "missing": "_last"}},
+		},
+		"_source": false,
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				// Only files of the owner are matched, regardless of the search text.
+				"filter": esFilters(q),
+				"must": map[string]interface{}{
+					"bool": map[string]interface{}{
+						"should":               esTextQueries(q, fields),
+						"minimum_should_match": 1,
+					},
+				},
+			},
+		},
+		"highlight": map[string]interface{}{
+			"pre_tags":  []string{esHighlightPreTag},
+			"post_tags": []string{esHighlightPostTag},
+			"encoder":   "html",
+			"fields":    highlights,
+		},
+	}
+
+	if q.After != nil {
+		body["search_after"] = []interface{}{q.After.Score, q.After.ID}
+	}
+
+	content, err := e.request(ctx, http.MethodPost, "/"+url.PathEscape(e.config.Index)+"/_search", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var res esSearchResponse
+	if err := json.Unmarshal(content, &res); err != nil {
+		return nil, fmt.Errorf("failed to decode search response: %w", err)
+	}
+
+	hits := make([]Hit, 0, len(res.Hits.Hits))
+	for _, match := range res.Hits.Hits {
+		id, err := strconv.Atoi(match.ID)
+		if err != nil {
+			e.l.Warning("Invalid document ID %q in search index.", match.ID)
+			continue
+		}
+
+		hits = append(hits, Hit{ID: id, Score: match.Score, Highlights: match.Highlight})
+	}
+
+	return &Result{Total: res.Hits.Total.Value, TotalEstimated: res.Hits.Total.Relation == "gte", Hits: hits}, nil
+}
+
+// esFilters converts owner and filters of the query into filter clauses, which do not affect scores.
+func esFilters(q *Query) []interface{} {
+	term := func(field string, value interface{}) interface{} {
+		return map[string]interface{}{"term": map[string]interface{}{field: value}}
+	}
+
+	filters := []interface{}{term(FieldOwner, q.OwnerID)}
+	if q.Type != nil {
+		filters = append(filters, term(FieldType, *q.Type))
+	}
+
+	if q.PolicyID > 0 {
+		filters = append(filters, term(FieldPolicy, q.PolicyID))
+	}
+
+	if q.SizeGte > 0 || q.SizeLte > 0 {
+		bounds := map[string]interface{}{"gte": q.SizeGte}
+		if q.SizeLte > 0 {
+			bounds["lte"] = q.SizeLte
+		}
+		filters = append(filters, map[string]interface{}{"range": map[string]interface{}{FieldSize: bounds}})
+
+		// Size ranges match only files, unless folders are explicitly searched for.
+		if q.Type == nil {
+			filters = append(filters, term(FieldType, int(types.FileTypeFile)))
+		}
+	}
+
+	dateRange := func(field string, gte, lte *time.Time) {
+		if gte == nil && lte == nil {
+			return
+		}
+
+		bounds := map[string]interface{}{}
+		if gte != nil {
+			bounds["gte"] = gte.Format(time.RFC3339)
+		}
+		if lte != nil {
+			bounds["lte"] = lte.Format(time.RFC3339)
+		}
+		filters = append(filters, map[string]interface{}{"range": map[string]interface{}{field: bounds}})
+	}
+	dateRange(FieldCreatedAt, q.CreatedAtGte, q.CreatedAtLte)
+	dateRange(FieldUpdatedAt, q.UpdatedAtGte, q.UpdatedAtLte)
+
+	if len(q.Exts) > 0 {
+		exts := make([]string, 0, len(q.Exts))
+		for _, ext := range q.Exts {
+			exts = append(exts, strings.ToLower(ext))
+		}
+		filters = append(filters, map[string]interface{}{"terms": map[string]interface{}{FieldExt: exts}})
+	}
+
+	for _, tag := range q.Tags {
+		filters = append(filters, term(FieldTagKeys, tag))
+	}
+
+	return filters
+}
+
+func (e *elasticsearchIndexer) Stats(ctx context.Context) (*Stats, error) {
+	alias := url.PathEscape(e.config.Index)
+	content, err := e.request(ctx, http.MethodGet, "/"+alias+"/_stats/docs,store", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats esStatsResponse
+	if err := json.Unmarshal(content, &stats); err != nil {
+		return nil, fmt.Errorf("failed to decode index stats: %w", err)
+	}
+
+	res := &Stats{
+		Documents: stats.All.Primaries.Docs.Count,
+		SizeBytes: stats.All.Primaries.Store.SizeInBytes,
+		Health:    HealthRed,
+	}
+
+	content, err = e.request(ctx, http.MethodGet, "/_cluster/health/"+alias, nil)
+	if err != nil {
+		e.l.Warning("Failed to get health of search index: %s", err)
+		return res, nil
+	}
+
+	var health struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(content, &health); err == nil && health.Status != "" {
+		res.Health = health.Status
+	}
+
+	return res, nil
+}
+
+// esTextQueries returns queries matching search text, any of which is matched.
+func esTextQueries(q *Query, fields []string) []interface{} {
+	queries := []interface{}{
+		map[string]interface{}{
+			"multi_match": map[string]interface{}{
+				"query":  q.Text,
+				"fields": fields,
+			},
+		},
+	}
+
+	if fuzzyEnabled(q) {
+		queries = append(queries, map[string]interface{}{
+			"match": map[string]interface{}{
+				FieldName: map[string]interface{}{"query": q.Text, "fuzziness": 1, "boost": fuzzyBoost},
+			},
+		})
+	}
+
+	if text, ok := pinyinQueryText(q.Text); ok && q.Pinyin {
+		for _, field := range []string{FieldNamePinyin, FieldNameInitials} {
+			queries = append(queries, map[string]interface{}{
+				"wildcard": map[string]interface{}{
+					field: map[string]interface{}{"value": "*" + text + "*", "boost": pinyinBoost},
+				},
+			})
+		}
+	}
+
+	return queries
+}
+
+// Rebuild fills a new index and swaps the alias to it once completed, searches are served by the current
+// index meanwhile. Old indices are deleted after swapping.
+func (e *elasticsearchIndexer) Rebuild(ctx context.Context, fill func(ctx context.Context, target Indexer) error) error {
+	e.mu.Lock()
+	if e.rebuilding != "" {
+		e.mu.Unlock()
+		return ErrRebuilding
+	}
+
+	index := e.newIndexName()
+	e.rebuilding = index
+	e.mu.Unlock()
+
+	defer func() {
+		e.mu.Lock()
+		e.rebuilding = ""
+		e.mu.Unlock()
+	}()
+
+	if err := e.createIndex(ctx, index, false); err != nil {
+		return err
+	}
+
+	if err := fill(ctx, &esIndexWriter{e: e, index: index}); err != nil {
+		e.deleteIndices(ctx, index)
+		return fmt.Errorf("failed to fill new index: %w", err)
+	}
+
+	old, err := e.aliasIndices(ctx)
+	if err != nil {
+		e.deleteIndices(ctx, index)
+		return err
+	}
+
+	actions := []interface{}{
+		map[string]interface{}{"add": map[string]string{"index": index, "alias": e.config.Index}},
+	}
+	for _, o := range old {
+		actions = append(actions, map[string]interface{}{"remove": map[string]string{"index": o, "alias": e.config.Index}})
+	}
+
+	if _, err := e.request(ctx, http.MethodPost, "/_aliases", map[string]interface{}{"actions": actions}); err != nil {
+		e.deleteIndices(ctx, index)
+		return fmt.Errorf("failed to swap alias: %w", err)
+	}
+
+	e.l.Info("Search index alias %q is swapped to %q.", e.config.Index, index)
+	e.deleteIndices(ctx, old...)
+	return nil
+}
+
+func (e *elasticsearchIndexer) deleteIndices(ctx context.Context, indices ...string) {
+	for _, index := range indices {
+		if _, err := e.request(ctx, http.MethodDelete, "/"+url.PathEscape(index), nil,
+			http.StatusOK, http.StatusNotFound); err != nil {
+			e.l.Warning("Failed to delete search index %q: %s", index, err)
+		}
+	}
+}
+
+func (e *elasticsearchIndexer) Close() error {
+	return nil
+}
+
+// esIndexWriter writes documents into a specific index being rebuilt.
+type esIndexWriter struct {
+	e     *elasticsearchIndexer
+	index string
+}
+
+func (w *esIndexWriter) Index(ctx context.Context, docs ...*Document) error {
+	return w.e.indexInto(ctx, []string{w.index}, docs...)
+}
+
+func (w *esIndexWriter) Delete(ctx context.Context, ids ...int) error {
+	return nil
+}
+
+func (w *esIndexWriter) Search(ctx context.Context, q *Query) (*Result, error) {
+	return nil, fmt.Errorf("index %q is being rebuilt", w.index)
+}
+
+func (w *esIndexWriter) Stats(ctx context.Context) (*Stats, error) {
+	return nil, fmt.Errorf("index %q is being rebuilt", w.index)
+}
+
+func (w *esIndexWriter) Close() error {
+	return nil
+}
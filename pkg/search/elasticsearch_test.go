@@ -0,0 +1,156 @@
+package search
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/stretchr/testify/assert"
+)
+
+type testConfig struct {
+	conf.ConfigProvider
+}
+
+func (testConfig) System() *conf.System {
+	return &conf.System{Mode: conf.MasterMode}
+}
+
+// fakeElasticsearch records indices, the alias and documents written by bulk requests.
+type fakeElasticsearch struct {
+	mu      sync.Mutex
+	alias   map[string]bool
+	docs    map[string]map[string]bool
+	queries []map[string]interface{}
+}
+
+func (f *fakeElasticsearch) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/_alias/files":
+		if len(f.alias) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"alias [files] missing","status":404}`))
+			return
+		}
+
+		res := make(map[string]interface{})
+		for index := range f.alias {
+			res[index] = map[string]interface{}{"aliases": map[string]interface{}{"files": map[string]interface{}{}}}
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	case r.Method == http.MethodPut:
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		index := strings.TrimPrefix(r.URL.Path, "/")
+		f.docs[index] = make(map[string]bool)
+		if _, ok := body["aliases"]; ok {
+			f.alias[index] = true
+		}
+		_, _ = w.Write([]byte(`{"acknowledged":true}`))
+	case r.Method == http.MethodPost && r.URL.Path == "/_bulk":
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			var action map[string]map[string]string
+			_ = json.Unmarshal(scanner.Bytes(), &action)
+			for name, meta := range action {
+				index := meta["_index"]
+				if index == "files" {
+					for i := range f.alias {
+						index = i
+					}
+				}
+
+				if name == "index" {
+					scanner.Scan()
+					f.docs[index][meta["_id"]] = true
+				} else {
+					delete(f.docs[index], meta["_id"])
+				}
+			}
+		}
+		_, _ = w.Write([]byte(`{"errors":false,"items":[]}`))
+	case r.Method == http.MethodPost && r.URL.Path == "/files/_search":
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		f.queries = append(f.queries, body)
+		_, _ = w.Write([]byte(`{"hits":{"total":{"value":1},"hits":[{"_id":"1","_score":1.5,` +
+			`"highlight":{"name":["<mark>report</mark>.pdf"]}}]}}`))
+	case r.Method == http.MethodPost && r.URL.Path == "/_aliases":
+		var body struct {
+			Actions []map[string]map[string]string `json:"actions"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		for _, action := range body.Actions {
+			if add, ok := action["add"]; ok {
+				f.alias[add["index"]] = true
+			}
+			if remove, ok := action["remove"]; ok {
+				delete(f.alias, remove["index"])
+			}
+		}
+		_, _ = w.Write([]byte(`{"acknowledged":true}`))
+	case r.Method == http.MethodDelete:
+		delete(f.docs, strings.TrimPrefix(r.URL.Path, "/"))
+		_, _ = w.Write([]byte(`{"acknowledged":true}`))
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func TestElasticsearchIndexer(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+	fake := &fakeElasticsearch{alias: make(map[string]bool), docs: make(map[string]map[string]bool)}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	indexer, err := NewElasticsearchIndexer(ctx, request.NewClient(testConfig{}),
+		ElasticsearchConfig{Endpoint: server.URL, Index: "files"}, logging.NewConsoleLogger(logging.LevelError))
+	a.NoError(err)
+	a.Len(fake.alias, 1, "alias is created with the first index")
+
+	a.NoError(indexer.Index(ctx, &Document{ID: 1, OwnerID: 1, Name: "report.pdf"}, &Document{ID: 2, OwnerID: 1}))
+	a.NoError(indexer.Delete(ctx, 2))
+
+	res, err := indexer.Search(ctx, &Query{OwnerID: 1, Text: "report", Limit: 10})
+	a.NoError(err)
+	a.EqualValues(1, res.Total)
+	a.Equal(1, res.Hits[0].ID)
+	a.Equal([]string{"<mark>report</mark>.pdf"}, res.Hits[0].Highlights[FieldName])
+
+	query, _ := json.Marshal(fake.queries[0]["query"])
+	a.Contains(string(query), `"filter":[{"term":{"owner_id":1}}]`, "results are filtered by owner")
+
+	_, err = indexer.Search(ctx, &Query{OwnerID: 1, Text: "report", Limit: 10, After: res.Hits[0].Cursor()})
+	a.NoError(err)
+	a.Equal([]interface{}{1.5, float64(1)}, fake.queries[1]["search_after"])
+
+	var old string
+	for index := range fake.alias {
+		old = index
+	}
+
+	a.NoError(indexer.(Rebuilder).Rebuild(ctx, func(ctx context.Context, target Indexer) error {
+		// Changes made during rebuilding are written into both indices.
+		a.NoError(indexer.Index(ctx, &Document{ID: 3, OwnerID: 1}))
+		return target.Index(ctx, &Document{ID: 1, OwnerID: 1})
+	}))
+
+	a.Len(fake.alias, 1)
+	a.NotContains(fake.alias, old, "alias is swapped to the new index")
+	a.NotContains(fake.docs, old, "old index is deleted")
+	for index := range fake.alias {
+		a.Equal(map[string]bool{"1": true, "3": true}, fake.docs[index])
+	}
+}
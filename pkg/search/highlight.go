@@ -0,0 +1,114 @@
+package search
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/blevesearch/bleve/v2/search"
+)
+
+const (
+	// fragmentSize is the approximate maximum bytes of a fragment of long fields.
+	fragmentSize = 200
+	// fragmentLeading is the bytes kept before the first match in a fragment.
+	fragmentLeading  = 40
+	fragmentEllipsis = "…"
+)
+
+type span struct {
+	start, end int
+}
+
+// highlight builds fragments of stored field values around matched terms. Fields not matched are omitted.
+func highlight(values map[string]interface{}, locations search.FieldTermLocationMap) map[string][]string {
+	res := make(map[string][]string)
+	for field, terms := range locations {
+		// Spans are grouped by array position, values of non-array fields are at position 0.
+		spans := make(map[int][]span)
+		for _, locs := range terms {
+			for _, loc := range locs {
+				pos := 0
+				if len(loc.ArrayPositions) > 0 {
+					pos = int(loc.ArrayPositions[0])
+				}
+				spans[pos] = append(spans[pos], span{int(loc.Start), int(loc.End)})
+			}
+		}
+
+		var elements []string
+		switch v := values[field].(type) {
+		case string:
+			elements = []string{v}
+		case []interface{}:
+			for _, e := range v {
+				s, _ := e.(string)
+				elements = append(elements, s)
+			}
+		default:
+			continue
+		}
+
+		positions := make([]int, 0, len(spans))
+		for pos := range spans {
+			positions = append(positions, pos)
+		}
+		sort.Ints(positions)
+
+		for _, pos := range positions {
+			if pos < len(elements) {
+				res[field] = append(res[field], fragment(elements[pos], spans[pos]))
+			}
+		}
+	}
+
+	return res
+}
+
+// fragment escapes content as HTML, wrapping matched spans in <mark>. Long content is cut around the first match.
+func fragment(content string, spans []span) string {
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].start < spans[j].start
+	})
+
+	from, to := 0, len(content)
+	if len(content) > fragmentSize && len(spans) > 0 {
+		from = runeStart(content, max(spans[0].start-fragmentLeading, 0))
+		to = runeStart(content, min(from+fragmentSize, len(content)))
+	}
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString(fragmentEllipsis)
+	}
+
+	cursor := from
+	for _, s := range spans {
+		// Skip overlapping spans and those out of the fragment.
+		if s.start < cursor || s.end > to || s.start >= s.end {
+			continue
+		}
+
+		b.WriteString(html.EscapeString(content[cursor:s.start]))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(content[s.start:s.end]))
+		b.WriteString("</mark>")
+		cursor = s.end
+	}
+
+	b.WriteString(html.EscapeString(content[cursor:to]))
+	if to < len(content) {
+		b.WriteString(fragmentEllipsis)
+	}
+
+	return b.String()
+}
+
+// runeStart moves i backwards to the start of the rune containing it.
+func runeStart(s string, i int) int {
+	for i > 0 && i < len(s) && !utf8.RuneStart(s[i]) {
+		i--
+	}
+	return i
+}
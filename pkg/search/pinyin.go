@@ -0,0 +1,70 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mozillazg/go-pinyin"
+)
+
+const (
+	// pinyinMinQueryLength is the minimum length of search text matched against pinyin of names.
+	pinyinMinQueryLength = 2
+	// fuzzyMinQueryLength is the minimum length of search text matched with typos allowed, shorter text
+	// matches too many unrelated names with an edit.
+	fuzzyMinQueryLength = 4
+	// fuzzyBoost weights fuzzy matches below exact ones.
+	fuzzyBoost = 0.5
+	// pinyinBoost weights pinyin matches of names.
+	pinyinBoost = 2
+)
+
+// namePinyin returns full pinyin and pinyin initials of Chinese characters in name, e.g. "baogaoben.pdf"
+// and "bgb.pdf" for "报告本.pdf", other characters are kept as is. Both are empty if name has no Chinese
+// characters.
+func namePinyin(name string) (string, string) {
+	args := pinyin.NewArgs()
+	var full, initials strings.Builder
+	hasHan := false
+	for _, r := range strings.ToLower(name) {
+		if unicode.Is(unicode.Han, r) {
+			if py := pinyin.SinglePinyin(r, args); len(py) > 0 && py[0] != "" {
+				hasHan = true
+				full.WriteString(py[0])
+				initials.WriteByte(py[0][0])
+				continue
+			}
+		}
+
+		full.WriteRune(r)
+		initials.WriteRune(r)
+	}
+
+	if !hasHan {
+		return "", ""
+	}
+
+	return full.String(), initials.String()
+}
+
+// pinyinQueryText returns the lower cased search text if it may be pinyin or initials of a name, i.e. consists
+// of ASCII letters and digits only.
+func pinyinQueryText(text string) (string, bool) {
+	if len(text) < pinyinMinQueryLength {
+		return "", false
+	}
+
+	for _, r := range text {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return "", false
+		}
+	}
+
+	return strings.ToLower(text), true
+}
+
+// fuzzyEnabled returns whether typos are allowed when matching given search text.
+func fuzzyEnabled(q *Query) bool {
+	return q.Fuzzy && utf8.RuneCountInString(q.Text) >= fuzzyMinQueryLength
+}
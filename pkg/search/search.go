@@ -0,0 +1,177 @@
+// Package search maintains full-text indexes of files, covering names, descriptions, tags and text extracted
+// from file content, so that files are searched by relevance instead of SQL pattern matching.
+package search
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	FieldName        = "name"
+	FieldDescription = "description"
+	FieldTags        = "tags"
+	FieldText        = "text"
+	FieldOwner       = "owner_id"
+	FieldType        = "type"
+	FieldSize        = "size"
+	FieldUpdatedAt   = "updated_at"
+	FieldCreatedAt   = "created_at"
+	FieldExt         = "ext"
+	FieldPolicy      = "policy_id"
+	// FieldID is the file ID, used as tie breaker when paginating hits of equal score.
+	FieldID = "file_id"
+	// FieldTagKeys is tags indexed as exact keywords for filtering, while FieldTags is analyzed for matching.
+	FieldTagKeys = "tag_keys"
+	// FieldNamePinyin and FieldNameInitials are full pinyin and pinyin initials of Chinese names, matched as
+	// substrings of lower cased keywords.
+	FieldNamePinyin   = "name_pinyin"
+	FieldNameInitials = "name_initials"
+)
+
+const (
+	HealthGreen  = "green"
+	HealthYellow = "yellow"
+	HealthRed    = "red"
+)
+
+var (
+	ErrDisabled   = errors.New("full text search is not enabled")
+	ErrRebuilding = errors.New("search index is being rebuilt")
+
+	// TextFields are fields matched by search text, highlights are returned for them.
+	TextFields = []string{FieldName, FieldDescription, FieldTags, FieldText}
+)
+
+type (
+	// Indexer maintains a full-text index of files.
+	Indexer interface {
+		// Index adds or replaces documents in the index.
+		Index(ctx context.Context, docs ...*Document) error
+		// Delete removes documents of given file IDs from the index.
+		Delete(ctx context.Context, ids ...int) error
+		// Search returns files matching the query, ordered by relevance.
+		Search(ctx context.Context, q *Query) (*Result, error)
+		// Stats returns size and health of the index.
+		Stats(ctx context.Context) (*Stats, error)
+		// Close releases underlying resources.
+		Close() error
+	}
+
+	// Rebuilder is implemented by indexers able to rebuild the index without downtime. Searches are served by
+	// the current index until the new one filled by fill is completed.
+	Rebuilder interface {
+		Rebuild(ctx context.Context, fill func(ctx context.Context, target Indexer) error) error
+	}
+
+	// Document is the indexed content of a file.
+	Document struct {
+		ID        int
+		OwnerID   int
+		Type      int
+		Size      int64
+		UpdatedAt time.Time
+		CreatedAt time.Time
+		// Ext is the lower case extension of file name, without dot.
+		Ext         string
+		PolicyID    int
+		Name        string
+		Description string
+		Tags        []string
+		// Text is extracted from file content, e.g. by OCR.
+		Text string
+	}
+
+	// Query searches files of an owner, optionally narrowed by filters.
+	Query struct {
+		OwnerID int
+		Text    string
+		// Fuzzy also matches names with a typo in the search text.
+		Fuzzy bool
+		// Pinyin also matches Chinese names by pinyin or pinyin initials, e.g. "bgb" for "报告本".
+		Pinyin bool
+		Filters
+		// After is the position of the last hit of previous page, hits are returned from the first if nil.
+		After *Cursor
+		Limit int
+	}
+
+	// Cursor is a position in hits ordered by descending score and ascending file ID.
+	Cursor struct {
+		Score float64
+		ID    int
+	}
+
+	// Filters are structured conditions matched along with search text, zero values are not applied.
+	Filters struct {
+		Type         *int
+		SizeGte      int64
+		SizeLte      int64
+		CreatedAtGte *time.Time
+		CreatedAtLte *time.Time
+		UpdatedAtGte *time.Time
+		UpdatedAtLte *time.Time
+		// Exts matches files with any of the extensions.
+		Exts []string
+		// Tags matches files with all of the tags.
+		Tags     []string
+		PolicyID int
+	}
+
+	Result struct {
+		// Total is the number of all matched files, regardless of cursor and limit.
+		Total uint64
+		// TotalEstimated is true if Total is a lower bound, as counting is stopped for large results.
+		TotalEstimated bool
+		Hits           []Hit
+	}
+
+	// Stats describes the index searched currently.
+	Stats struct {
+		Documents uint64
+		// SizeBytes is the storage used by the index, excluding replicas.
+		SizeBytes int64
+		// Health is "green" if the index is fully available, "yellow" if degraded and "red" if unavailable.
+		Health string
+	}
+
+	Hit struct {
+		ID    int
+		Score float64
+		// Highlights are HTML escaped fragments of matched fields, matched terms are wrapped in <mark>.
+		Highlights map[string][]string
+	}
+)
+
+// Cursor returns the position of the hit.
+func (h *Hit) Cursor() *Cursor {
+	return &Cursor{Score: h.Score, ID: h.ID}
+}
+
+// NewNoopIndexer returns an indexer ignoring updates, used when full text search is disabled.
+func NewNoopIndexer() Indexer {
+	return noopIndexer{}
+}
+
+type noopIndexer struct{}
+
+func (noopIndexer) Index(ctx context.Context, docs ...*Document) error {
+	return nil
+}
+
+func (noopIndexer) Delete(ctx context.Context, ids ...int) error {
+	return nil
+}
+
+func (noopIndexer) Search(ctx context.Context, q *Query) (*Result, error) {
+	return nil, ErrDisabled
+}
+
+func (noopIndexer) Stats(ctx context.Context) (*Stats, error) {
+	return nil, ErrDisabled
+}
+
+func (noopIndexer) Close() error {
+	return nil
+}
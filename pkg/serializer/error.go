@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/lock"
@@ -253,6 +254,24 @@ const (
 	CodeNodeUsedByStoragePolicy = 40086
 	// CodeDomainNotLicensed domain not licensed
 	CodeDomainNotLicensed = 40087
+	// CodeLoginLocked too many failed login attempts
+	CodeLoginLocked = 40088
+	// CodeInvalidInvitation invitation code is invalid, expired or used up
+	CodeInvalidInvitation = 40089
+	// CodeEmailDomainNotAllowed email domain is not allowed for registration
+	CodeEmailDomainNotAllowed = 40090
+	// CodeTargetUserInvalid target user for ownership transfer is invalid
+	CodeTargetUserInvalid = 40091
+	// CodeGroupPolicyViolation action is not allowed by group policy
+	CodeGroupPolicyViolation = 40092
+	// CodeWeakPassword password does not meet password policy
+	CodeWeakPassword = 40093
+	// CodePasswordExpired password must be changed before login
+	CodePasswordExpired = 40094
+	// CodeTooManyRequests request is rate limited
+	CodeTooManyRequests = 40095
+	// CodeLegalHold file or user is under legal hold
+	CodeLegalHold = 40096
 	// CodeDBError 数据库操作失败
 	CodeDBError = 50001
 	// CodeEncryptError 加密失败
@@ -273,6 +292,8 @@ const (
 	CodeNodeOffline = 50010
 	// 文件元信息查询失败
 	CodeQueryMetaFailed = 50011
+	// CodeMaintenance site is under maintenance
+	CodeMaintenance = 50012
 	//CodeParamErr 各种奇奇怪怪的参数错误
 	CodeParamErr = 40001
 	// CodeNotSet 未定错误，后续尝试从error中获取
@@ -363,6 +384,15 @@ func ErrWithDetails(c context.Context, errCode int, msg string, err error) Respo
 		}
 	}
 
+	// Report server side errors
+	if err != nil && res.Code >= CodeDBError {
+		var r *http.Request
+		if ginCtx, ok := c.(*gin.Context); ok {
+			r = ginCtx.Request
+		}
+		logging.CaptureError(c, err, r)
+	}
+
 	// 生产环境隐藏底层报错
 	if err != nil && gin.Mode() != gin.ReleaseMode {
 		res.Error = err.Error()
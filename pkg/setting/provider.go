@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -22,6 +23,14 @@ type (
 		PWA(ctx context.Context) *PWASetting
 		// RegisterEnabled returns true if public sign-up is enabled.
 		RegisterEnabled(ctx context.Context) bool
+		// InvitationEnabled returns true if sign-up with invitation code is enabled.
+		InvitationEnabled(ctx context.Context) bool
+		// RegisterAllowedDomains returns email domains allowed for public sign-up, empty means no restriction.
+		RegisterAllowedDomains(ctx context.Context) []string
+		// EmailChangeVetoEnabled returns true if the old address can cancel a pending email change.
+		EmailChangeVetoEnabled(ctx context.Context) bool
+		// EmailChangeVetoWindow returns how long a confirmed email change waits for veto before taking effect.
+		EmailChangeVetoWindow(ctx context.Context) time.Duration
 		// AuthnEnabled returns true if Webauthn is enabled.
 		AuthnEnabled(ctx context.Context) bool
 		// RegCaptchaEnabled returns true if registration captcha is enabled.
@@ -52,6 +61,97 @@ type (
 		ActivationEmailTemplate(ctx context.Context) []EmailTemplate
 		// ResetEmailTemplate returns the email template for reset password.
 		ResetEmailTemplate(ctx context.Context) []EmailTemplate
+		// LoginLockedEmailTemplate returns the email template for account lockout notification.
+		LoginLockedEmailTemplate(ctx context.Context) []EmailTemplate
+		// LoginProtection returns the login brute-force protection settings.
+		LoginProtection(ctx context.Context) *LoginProtection
+		// PasswordPolicy returns the password policy settings.
+		PasswordPolicy(ctx context.Context) *PasswordPolicy
+		// QuotaAlertEmailTemplate returns the email template for storage quota alert.
+		QuotaAlertEmailTemplate(ctx context.Context) []EmailTemplate
+		// QuotaDigestEmailTemplate returns the email template for admin digest of users over quota.
+		QuotaDigestEmailTemplate(ctx context.Context) []EmailTemplate
+		// ImpersonationEmailTemplate returns the email template for admin impersonation notification.
+		ImpersonationEmailTemplate(ctx context.Context) []EmailTemplate
+		// EmailChangeEmailTemplate returns the email template for confirming new email address.
+		EmailChangeEmailTemplate(ctx context.Context) []EmailTemplate
+		// EmailChangeNotifyEmailTemplate returns the email template for notifying old email address.
+		EmailChangeNotifyEmailTemplate(ctx context.Context) []EmailTemplate
+		// EmailAliasEmailTemplate returns the email template for confirming secondary email address.
+		EmailAliasEmailTemplate(ctx context.Context) []EmailTemplate
+		// QuotaAlert returns the storage quota alert settings.
+		QuotaAlert(ctx context.Context) *QuotaAlert
+		// GroupExpirationNotifyBefore returns how long before expiration users are notified about
+		// time-limited group membership.
+		GroupExpirationNotifyBefore(ctx context.Context) time.Duration
+		// GroupExpirationEmailTemplate returns the email template for group membership expiration notice.
+		GroupExpirationEmailTemplate(ctx context.Context) []EmailTemplate
+		// AuditLogRetention returns how long audit logs are kept, 0 means forever.
+		AuditLogRetention(ctx context.Context) time.Duration
+		// DavAuditLog returns whether WebDAV requests are recorded into audit log.
+		DavAuditLog(ctx context.Context) bool
+		// DavAccountExpiryNotifyBefore returns how long before password expiration owners of WebDAV
+		// accounts are notified.
+		DavAccountExpiryNotifyBefore(ctx context.Context) time.Duration
+		// DavAccountIdleTimeout returns how long a WebDAV account can stay unused before it is disabled,
+		// 0 means never.
+		DavAccountIdleTimeout(ctx context.Context) time.Duration
+		// NodeHeartbeat returns the slave node heartbeat monitoring settings.
+		NodeHeartbeat(ctx context.Context) *NodeHeartbeat
+		// NodeTaskFailover returns the lease and failover settings of tasks offloaded to slave nodes.
+		NodeTaskFailover(ctx context.Context) *NodeTaskFailover
+		// NodeAdmission returns the utilization thresholds above which heavy tasks are not scheduled onto slave nodes.
+		NodeAdmission(ctx context.Context) *NodeAdmission
+		// NodeStatusEmailTemplate returns the email template for node status change alert.
+		NodeStatusEmailTemplate(ctx context.Context) []EmailTemplate
+		// ClusterSecurity returns the security settings of master-slave communication.
+		ClusterSecurity(ctx context.Context) *ClusterSecurity
+		// Transcode returns the video transcoding settings.
+		Transcode(ctx context.Context) *Transcode
+		// NodeBalanceStrategies returns load balancing strategy names of node selection keyed by capability.
+		NodeBalanceStrategies(ctx context.Context) map[string]string
+		// StatsRetention returns how long daily usage statistics are kept, 0 means forever.
+		StatsRetention(ctx context.Context) time.Duration
+		// OrphanCleanup returns orphan blob cleanup settings.
+		OrphanCleanup(ctx context.Context) *OrphanCleanup
+		// RateLimit returns the rate limit rule of given route group, nil if not limited.
+		RateLimit(ctx context.Context, name string) *RateLimitRule
+		// IPAccess returns the IP allow/deny lists of given surface.
+		IPAccess(ctx context.Context, surface string) *IPAccessRule
+		// TrustedProxies returns IPs or CIDRs of reverse proxies trusted to set the client IP header.
+		TrustedProxies(ctx context.Context) string
+		// Maintenance returns the maintenance mode settings.
+		Maintenance(ctx context.Context) *Maintenance
+		// AccountDeletion returns self-service account deletion settings.
+		AccountDeletion(ctx context.Context) *AccountDeletion
+		// OCR returns text recognition settings.
+		OCR(ctx context.Context) *OCR
+		// Moderation returns content moderation settings of public shares.
+		Moderation(ctx context.Context) *Moderation
+		// AiTagging returns image tagging settings.
+		AiTagging(ctx context.Context) *AiTagging
+		// FullTextSearch returns full-text search index settings.
+		FullTextSearch(ctx context.Context) *FullTextSearch
+		// SavedSearchMax returns the maximum number of saved searches of each user.
+		SavedSearchMax(ctx context.Context) int
+		// RssSubscriptionMax returns the maximum number of RSS subscriptions of each user.
+		RssSubscriptionMax(ctx context.Context) int
+		// FolderSyncMax returns the maximum number of folder sync jobs of each user.
+		FolderSyncMax(ctx context.Context) int
+		// RetentionRuleMax returns the maximum number of folder retention rules of each user.
+		RetentionRuleMax(ctx context.Context) int
+		// UrlImport returns settings of bulk URL import tasks.
+		UrlImport(ctx context.Context) *UrlImport
+		// APIDocUIEnabled returns true if Swagger UI of the API document is served.
+		APIDocUIEnabled(ctx context.Context) bool
+		// APIDeprecation returns deprecation schedule of given API version.
+		APIDeprecation(ctx context.Context, version int) *APIDeprecation
+		// S3GatewayEnabled returns true if the S3 compatible gateway is enabled.
+		S3GatewayEnabled(ctx context.Context) bool
+		// S3AccessKeyMax returns the maximum number of S3 access keys of each user.
+		S3AccessKeyMax(ctx context.Context) int
+		// ThumbPregen returns eager thumbnail generation settings.
+		ThumbPregen(ctx context.Context) *ThumbPregen
 		// TokenAuth returns token based auth related settings.
 		TokenAuth(ctx context.Context) *TokenAuth
 		// HashIDSalt returns the salt used for hash ID generation.
@@ -64,6 +164,10 @@ type (
 		UploadSessionTTL(ctx context.Context) time.Duration
 		// MaxOnlineEditSize returns the maximum size of online editing.
 		MaxOnlineEditSize(ctx context.Context) int64
+		// TextPreviewMaxSize returns the maximum bytes of text files decoded for preview.
+		TextPreviewMaxSize(ctx context.Context) int64
+		// SubtitleMaxSize returns the maximum bytes of subtitle sidecars served to video players.
+		SubtitleMaxSize(ctx context.Context) int64
 		// SlaveRequestSignTTL returns the TTL of slave request signature.
 		SlaveRequestSignTTL(ctx context.Context) int
 		// ChunkRetryLimit returns the maximum number of chunk retries.
@@ -92,6 +196,11 @@ type (
 		MediaMetaMusicEnabled(ctx context.Context) bool
 		// MediaMetaMusicSizeLimit returns the size limit of media meta audio. first return value is for local sources;
 		MediaMetaMusicSizeLimit(ctx context.Context) (int64, int64)
+		// MediaMetaModelEnabled returns true if geometry statistics of 3D models are extracted.
+		MediaMetaModelEnabled(ctx context.Context) bool
+		// MediaMetaModelSizeLimit returns the size limit of 3D model meta. first return value is for local sources;
+		// second return value is for remote sources.
+		MediaMetaModelSizeLimit(ctx context.Context) (int64, int64)
 		// MediaMetaFFProbeEnabled returns true if media meta ffprobe is enabled.
 		MediaMetaFFProbeEnabled(ctx context.Context) bool
 		// MediaMetaFFProbeSizeLimit returns the size limit of media meta ffprobe. first return value is for local sources;
@@ -120,7 +229,8 @@ type (
 		FFMpegThumbGeneratorEnabled(ctx context.Context) bool
 		// FFMpegThumbExts returns the supported extensions of ffmpeg thumb generator.
 		FFMpegThumbExts(ctx context.Context) []string
-		// FFMpegThumbSeek returns the seek time of ffmpeg thumb generator.
+		// FFMpegThumbSeek returns the seek time of ffmpeg thumb generator, either a timestamp or a
+		// percentage of video duration like "10%".
 		FFMpegThumbSeek(ctx context.Context) string
 		// FFMpegThumbMaxSize returns the maximum size of ffmpeg thumb generator.
 		FFMpegThumbMaxSize(ctx context.Context) int64
@@ -146,6 +256,44 @@ type (
 		MusicCoverThumbMaxSize(ctx context.Context) int64
 		// MusicCoverThumbExts returns the supported extensions of music cover thumb generator.
 		MusicCoverThumbExts(ctx context.Context) []string
+		// RawThumbGeneratorEnabled returns true if RAW photo preview extraction is enabled.
+		RawThumbGeneratorEnabled(ctx context.Context) bool
+		// RawThumbMaxSize returns the maximum size of RAW photos to extract preview from.
+		RawThumbMaxSize(ctx context.Context) int64
+		// RawThumbExts returns the supported extensions of RAW photo preview extraction.
+		RawThumbExts(ctx context.Context) []string
+		// ModelThumbGeneratorEnabled returns true if 3D models are rendered as thumbnails.
+		ModelThumbGeneratorEnabled(ctx context.Context) bool
+		// ModelThumbMaxSize returns the maximum size of 3D models to render.
+		ModelThumbMaxSize(ctx context.Context) int64
+		// ModelThumbExts returns the supported extensions of 3D model thumb generator.
+		ModelThumbExts(ctx context.Context) []string
+		// ModelThumbAngle returns the turntable angle in degrees that 3D models are rendered from.
+		ModelThumbAngle(ctx context.Context) int
+		// PdfThumbGeneratorEnabled returns true if PDF thumb generator is enabled.
+		PdfThumbGeneratorEnabled(ctx context.Context) bool
+		// PdfToPpmPath returns the path of pdftoppm executable.
+		PdfToPpmPath(ctx context.Context) string
+		// PdfThumbExts returns the supported extensions of PDF thumb generator.
+		PdfThumbExts(ctx context.Context) []string
+		// PdfThumbMaxSize returns the maximum size of PDF files to render.
+		PdfThumbMaxSize(ctx context.Context) int64
+		// PdfPreviewSize returns the size of longer side of PDF page preview renditions.
+		PdfPreviewSize(ctx context.Context) int
+		// PreviewCacheTTL returns how long an unused preview rendition is kept in cache.
+		PreviewCacheTTL(ctx context.Context) time.Duration
+		// TakeoutTTL returns how long a personal data export archive is kept for download.
+		TakeoutTTL(ctx context.Context) time.Duration
+		// WaveformEnabled returns true if waveform peak data of audio files is generated with ffmpeg.
+		WaveformEnabled(ctx context.Context) bool
+		// WaveformExts returns the supported extensions of waveform generation.
+		WaveformExts(ctx context.Context) []string
+		// WaveformMaxSize returns the maximum size of audio files to generate waveform for.
+		WaveformMaxSize(ctx context.Context) int64
+		// WaveformPeaks returns the number of peaks in generated waveform.
+		WaveformPeaks(ctx context.Context) int
+		// VideoSprite returns sprite sheet settings of video scrub previews.
+		VideoSprite(ctx context.Context) *VideoSprite
 		// Cron returns the crontab settings.
 		Cron(ctx context.Context, t CronType) string
 		// Theme returns the theme settings.
@@ -348,6 +496,90 @@ func (s *settingProvider) MusicCoverThumbExts(ctx context.Context) []string {
 	return s.getStringList(ctx, "thumb_music_cover_exts", []string{})
 }
 
+func (s *settingProvider) RawThumbGeneratorEnabled(ctx context.Context) bool {
+	return s.getBoolean(ctx, "thumb_raw_enabled", true)
+}
+
+func (s *settingProvider) RawThumbMaxSize(ctx context.Context) int64 {
+	return s.getInt64(ctx, "thumb_raw_max_size", 536870912)
+}
+
+func (s *settingProvider) RawThumbExts(ctx context.Context) []string {
+	return s.getStringList(ctx, "thumb_raw_exts", []string{})
+}
+
+func (s *settingProvider) ModelThumbGeneratorEnabled(ctx context.Context) bool {
+	return s.getBoolean(ctx, "thumb_model_enabled", true)
+}
+
+func (s *settingProvider) ModelThumbMaxSize(ctx context.Context) int64 {
+	return s.getInt64(ctx, "thumb_model_max_size", 67108864)
+}
+
+func (s *settingProvider) ModelThumbExts(ctx context.Context) []string {
+	return s.getStringList(ctx, "thumb_model_exts", []string{})
+}
+
+func (s *settingProvider) ModelThumbAngle(ctx context.Context) int {
+	return s.getInt(ctx, "thumb_model_angle", 35)
+}
+
+func (s *settingProvider) PdfThumbGeneratorEnabled(ctx context.Context) bool {
+	return s.getBoolean(ctx, "thumb_pdf_enabled", false)
+}
+
+func (s *settingProvider) PdfToPpmPath(ctx context.Context) string {
+	return s.getString(ctx, "thumb_pdftoppm_path", "pdftoppm")
+}
+
+func (s *settingProvider) PdfThumbExts(ctx context.Context) []string {
+	return s.getStringList(ctx, "thumb_pdf_exts", []string{})
+}
+
+func (s *settingProvider) PdfThumbMaxSize(ctx context.Context) int64 {
+	return s.getInt64(ctx, "thumb_pdf_max_size", 134217728)
+}
+
+func (s *settingProvider) PdfPreviewSize(ctx context.Context) int {
+	return s.getInt(ctx, "thumb_pdf_preview_size", 1600)
+}
+
+func (s *settingProvider) PreviewCacheTTL(ctx context.Context) time.Duration {
+	return time.Duration(s.getInt(ctx, "preview_cache_ttl", 604800)) * time.Second
+}
+
+func (s *settingProvider) TakeoutTTL(ctx context.Context) time.Duration {
+	return time.Duration(s.getInt(ctx, "takeout_ttl", 604800)) * time.Second
+}
+
+func (s *settingProvider) WaveformEnabled(ctx context.Context) bool {
+	return s.getBoolean(ctx, "media_meta_waveform", false)
+}
+
+func (s *settingProvider) WaveformExts(ctx context.Context) []string {
+	return s.getStringList(ctx, "media_meta_waveform_exts", []string{})
+}
+
+func (s *settingProvider) WaveformMaxSize(ctx context.Context) int64 {
+	return s.getInt64(ctx, "media_meta_waveform_max_size", 1073741824)
+}
+
+func (s *settingProvider) WaveformPeaks(ctx context.Context) int {
+	return s.getInt(ctx, "media_meta_waveform_peaks", 800)
+}
+
+func (s *settingProvider) VideoSprite(ctx context.Context) *VideoSprite {
+	return &VideoSprite{
+		Enabled:     s.getBoolean(ctx, "media_meta_video_sprite", false),
+		Exts:        s.getStringList(ctx, "media_meta_video_sprite_exts", []string{}),
+		MaxSize:     s.getInt64(ctx, "media_meta_video_sprite_max_size", 10737418240),
+		Frames:      s.getInt(ctx, "media_meta_video_sprite_frames", 60),
+		Columns:     s.getInt(ctx, "media_meta_video_sprite_columns", 10),
+		FrameWidth:  s.getInt(ctx, "media_meta_video_sprite_frame_width", 160),
+		FrameHeight: s.getInt(ctx, "media_meta_video_sprite_frame_height", 90),
+	}
+}
+
 func (s *settingProvider) FFMpegPath(ctx context.Context) string {
 	return s.getString(ctx, "thumb_ffmpeg_path", "ffmpeg")
 }
@@ -439,6 +671,14 @@ func (s *settingProvider) MediaMetaFFProbeEnabled(ctx context.Context) bool {
 	return s.getBoolean(ctx, "media_meta_ffprobe", true)
 }
 
+func (s *settingProvider) MediaMetaModelSizeLimit(ctx context.Context) (int64, int64) {
+	return s.getInt64(ctx, "media_meta_model_size_local", 0), s.getInt64(ctx, "media_meta_model_size_remote", 0)
+}
+
+func (s *settingProvider) MediaMetaModelEnabled(ctx context.Context) bool {
+	return s.getBoolean(ctx, "media_meta_model", true)
+}
+
 func (s *settingProvider) MediaMetaMusicSizeLimit(ctx context.Context) (int64, int64) {
 	return s.getInt64(ctx, "media_meta_music_size_local", 0), s.getInt64(ctx, "media_meta_music_size_remote", 0)
 }
@@ -478,12 +718,13 @@ func (s *settingProvider) EntityUrlValidDuration(ctx context.Context) time.Durat
 func (s *settingProvider) Queue(ctx context.Context, queueType QueueType) *QueueSetting {
 	queueTypeStr := string(queueType)
 	return &QueueSetting{
-		WorkerNum:          s.getInt(ctx, "queue_"+queueTypeStr+"_worker_num,", 15),
+		WorkerNum:          s.getInt(ctx, "queue_"+queueTypeStr+"_worker_num", 15),
 		MaxExecution:       time.Duration(s.getInt(ctx, "queue_"+queueTypeStr+"_max_execution", 86400)) * time.Second,
 		BackoffFactor:      s.getFloat64(ctx, "queue_"+queueTypeStr+"_backoff_factor", 4),
 		BackoffMaxDuration: time.Duration(s.getInt(ctx, "queue_"+queueTypeStr+"_backoff_max_duration", 3600)) * time.Second,
 		MaxRetry:           s.getInt(ctx, "queue_"+queueTypeStr+"_max_retry", 5),
 		RetryDelay:         time.Duration(s.getInt(ctx, "queue_"+queueTypeStr+"_retry_delay", 5)) * time.Second,
+		VisibilityTimeout:  time.Duration(s.getInt(ctx, "queue_"+queueTypeStr+"_visibility_timeout", 60)) * time.Second,
 	}
 }
 
@@ -503,6 +744,14 @@ func (s *settingProvider) MaxOnlineEditSize(ctx context.Context) int64 {
 	return int64(s.getInt(ctx, "maxEditSize", 52428800))
 }
 
+func (s *settingProvider) TextPreviewMaxSize(ctx context.Context) int64 {
+	return s.getInt64(ctx, "text_preview_max_size", 1048576)
+}
+
+func (s *settingProvider) SubtitleMaxSize(ctx context.Context) int64 {
+	return s.getInt64(ctx, "subtitle_max_size", 10485760)
+}
+
 func (s *settingProvider) UploadSessionTTL(ctx context.Context) time.Duration {
 	return time.Duration(s.getInt(ctx, "upload_session_timeout", 86400)) * time.Second
 }
@@ -526,8 +775,9 @@ func (s *settingProvider) HashIDSalt(ctx context.Context) string {
 
 func (s *settingProvider) TokenAuth(ctx context.Context) *TokenAuth {
 	return &TokenAuth{
-		AccessTokenTTL:  time.Duration(s.getInt(ctx, "access_token_ttl", 3600)) * time.Second,
-		RefreshTokenTTL: time.Duration(s.getInt(ctx, "refresh_token_ttl", 15552000)) * time.Second,
+		AccessTokenTTL:   time.Duration(s.getInt(ctx, "access_token_ttl", 3600)) * time.Second,
+		RefreshTokenTTL:  time.Duration(s.getInt(ctx, "refresh_token_ttl", 15552000)) * time.Second,
+		ImpersonationTTL: time.Duration(s.getInt(ctx, "impersonation_ttl", 3600)) * time.Second,
 	}
 }
 
@@ -541,6 +791,379 @@ func (s *settingProvider) ResetEmailTemplate(ctx context.Context) []EmailTemplat
 	return templates
 }
 
+func (s *settingProvider) LoginLockedEmailTemplate(ctx context.Context) []EmailTemplate {
+	src := s.getString(ctx, "mail_login_locked_template", "[]")
+	var templates []EmailTemplate
+	if err := json.Unmarshal([]byte(src), &templates); err != nil {
+		return []EmailTemplate{}
+	}
+
+	return templates
+}
+
+func (s *settingProvider) QuotaAlertEmailTemplate(ctx context.Context) []EmailTemplate {
+	src := s.getString(ctx, "mail_quota_alert_template", "[]")
+	var templates []EmailTemplate
+	if err := json.Unmarshal([]byte(src), &templates); err != nil {
+		return []EmailTemplate{}
+	}
+
+	return templates
+}
+
+func (s *settingProvider) QuotaDigestEmailTemplate(ctx context.Context) []EmailTemplate {
+	src := s.getString(ctx, "mail_quota_digest_template", "[]")
+	var templates []EmailTemplate
+	if err := json.Unmarshal([]byte(src), &templates); err != nil {
+		return []EmailTemplate{}
+	}
+
+	return templates
+}
+
+func (s *settingProvider) ImpersonationEmailTemplate(ctx context.Context) []EmailTemplate {
+	src := s.getString(ctx, "mail_impersonation_template", "[]")
+	var templates []EmailTemplate
+	if err := json.Unmarshal([]byte(src), &templates); err != nil {
+		return []EmailTemplate{}
+	}
+
+	return templates
+}
+
+func (s *settingProvider) EmailChangeEmailTemplate(ctx context.Context) []EmailTemplate {
+	src := s.getString(ctx, "mail_email_change_template", "[]")
+	var templates []EmailTemplate
+	if err := json.Unmarshal([]byte(src), &templates); err != nil {
+		return []EmailTemplate{}
+	}
+
+	return templates
+}
+
+func (s *settingProvider) EmailChangeNotifyEmailTemplate(ctx context.Context) []EmailTemplate {
+	src := s.getString(ctx, "mail_email_change_notify_template", "[]")
+	var templates []EmailTemplate
+	if err := json.Unmarshal([]byte(src), &templates); err != nil {
+		return []EmailTemplate{}
+	}
+
+	return templates
+}
+
+func (s *settingProvider) EmailAliasEmailTemplate(ctx context.Context) []EmailTemplate {
+	src := s.getString(ctx, "mail_email_alias_template", "[]")
+	var templates []EmailTemplate
+	if err := json.Unmarshal([]byte(src), &templates); err != nil {
+		return []EmailTemplate{}
+	}
+
+	return templates
+}
+
+func (s *settingProvider) QuotaAlert(ctx context.Context) *QuotaAlert {
+	thresholds := make([]int, 0)
+	for _, raw := range s.getStringList(ctx, "quota_alert_thresholds", []string{"80", "95", "100"}) {
+		if v, err := strconv.Atoi(strings.TrimSpace(raw)); err == nil && v > 0 {
+			thresholds = append(thresholds, v)
+		}
+	}
+	sort.Ints(thresholds)
+
+	return &QuotaAlert{
+		Enabled:     s.getBoolean(ctx, "quota_alert", true),
+		Thresholds:  thresholds,
+		Period:      s.getInt(ctx, "quota_alert_period", 2592000),
+		AdminDigest: s.getBoolean(ctx, "quota_alert_admin_digest", true),
+	}
+}
+
+func (s *settingProvider) GroupExpirationNotifyBefore(ctx context.Context) time.Duration {
+	return time.Duration(s.getInt(ctx, "group_expiration_notify_before", 259200)) * time.Second
+}
+
+func (s *settingProvider) GroupExpirationEmailTemplate(ctx context.Context) []EmailTemplate {
+	src := s.getString(ctx, "mail_group_expiration_template", "[]")
+	var templates []EmailTemplate
+	if err := json.Unmarshal([]byte(src), &templates); err != nil {
+		return []EmailTemplate{}
+	}
+
+	return templates
+}
+
+func (s *settingProvider) NodeHeartbeat(ctx context.Context) *NodeHeartbeat {
+	return &NodeHeartbeat{
+		MissedBeats:  s.getInt(ctx, "node_heartbeat_missed", 3),
+		AlertEmail:   s.getBoolean(ctx, "node_alert_email", false),
+		AlertWebhook: s.getString(ctx, "node_alert_webhook", ""),
+	}
+}
+
+func (s *settingProvider) NodeTaskFailover(ctx context.Context) *NodeTaskFailover {
+	return &NodeTaskFailover{
+		Lease:        time.Duration(s.getInt(ctx, "node_task_lease", 300)) * time.Second,
+		MaxFailovers: s.getInt(ctx, "node_task_max_failovers", 3),
+	}
+}
+
+func (s *settingProvider) NodeAdmission(ctx context.Context) *NodeAdmission {
+	return &NodeAdmission{
+		CPU:       s.getFloat64(ctx, "node_admission_cpu", 90),
+		Memory:    s.getFloat64(ctx, "node_admission_memory", 90),
+		Disk:      s.getFloat64(ctx, "node_admission_disk", 95),
+		Bandwidth: s.getInt64(ctx, "node_admission_bandwidth", 0),
+	}
+}
+
+func (s *settingProvider) ClusterSecurity(ctx context.Context) *ClusterSecurity {
+	return &ClusterSecurity{
+		SecureMode:      s.getBoolean(ctx, "cluster_secure_mode", false),
+		CertRenewBefore: time.Duration(s.getInt(ctx, "node_cert_renew_days", 30)) * 24 * time.Hour,
+	}
+}
+
+func (s *settingProvider) Transcode(ctx context.Context) *Transcode {
+	return &Transcode{
+		Enabled:        s.getBoolean(ctx, "transcode_enabled", false),
+		FFMpegPath:     s.getString(ctx, "transcode_ffmpeg_path", "ffmpeg"),
+		HWAccel:        s.getString(ctx, "transcode_hwaccel", ""),
+		VideoEncoder:   s.getString(ctx, "transcode_video_encoder", "libx264"),
+		Height:         s.getInt(ctx, "transcode_height", 720),
+		SegmentSeconds: s.getInt(ctx, "transcode_hls_segment", 6),
+		MaxSize:        s.getInt64(ctx, "transcode_max_size", 10737418240),
+		Exts:           s.getStringList(ctx, "transcode_exts", []string{}),
+	}
+}
+
+func (s *settingProvider) NodeBalanceStrategies(ctx context.Context) map[string]string {
+	src := s.getString(ctx, "node_balance_strategies", "{}")
+	strategies := make(map[string]string)
+	if err := json.Unmarshal([]byte(src), &strategies); err != nil {
+		return map[string]string{}
+	}
+
+	return strategies
+}
+
+func (s *settingProvider) NodeStatusEmailTemplate(ctx context.Context) []EmailTemplate {
+	src := s.getString(ctx, "mail_node_status_template", "[]")
+	var templates []EmailTemplate
+	if err := json.Unmarshal([]byte(src), &templates); err != nil {
+		return []EmailTemplate{}
+	}
+
+	return templates
+}
+
+func (s *settingProvider) StatsRetention(ctx context.Context) time.Duration {
+	return time.Duration(s.getInt(ctx, "stats_retention_days", 365)) * 24 * time.Hour
+}
+
+func (s *settingProvider) OrphanCleanup(ctx context.Context) *OrphanCleanup {
+	return &OrphanCleanup{
+		AutoCleanup: s.getBoolean(ctx, "orphan_auto_cleanup", false),
+		Quarantine:  time.Duration(s.getInt(ctx, "orphan_quarantine_days", 7)) * 24 * time.Hour,
+	}
+}
+
+func (s *settingProvider) OCR(ctx context.Context) *OCR {
+	return &OCR{
+		Enabled:       s.getBoolean(ctx, "ocr_enabled", false),
+		Engine:        s.getString(ctx, "ocr_engine", "tesseract"),
+		TesseractPath: s.getString(ctx, "ocr_tesseract_path", "tesseract"),
+		Languages:     s.getString(ctx, "ocr_languages", "eng"),
+		Endpoint:      s.getString(ctx, "ocr_endpoint", ""),
+		Token:         s.getString(ctx, "ocr_token", ""),
+		Exts:          s.getStringList(ctx, "ocr_exts", []string{}),
+		MaxSize:       s.getInt64(ctx, "ocr_max_size", 52428800),
+		PdfMaxPages:   s.getInt(ctx, "ocr_pdf_max_pages", 10),
+	}
+}
+
+func (s *settingProvider) FullTextSearch(ctx context.Context) *FullTextSearch {
+	return &FullTextSearch{
+		Enabled:   s.getBoolean(ctx, "fts_enabled", false),
+		Provider:  SearchProvider(s.getString(ctx, "fts_provider", string(SearchProviderBleve))),
+		IndexPath: s.getString(ctx, "fts_index_path", "search_index"),
+		Elasticsearch: ElasticsearchSearch{
+			Endpoint: s.getString(ctx, "fts_es_endpoint", ""),
+			Username: s.getString(ctx, "fts_es_username", ""),
+			Password: s.getString(ctx, "fts_es_password", ""),
+			Index:    s.getString(ctx, "fts_es_index", "cloudreve_files"),
+		},
+		BatchSize:   s.getInt(ctx, "fts_index_batch", 500),
+		MaxTextSize: s.getInt(ctx, "fts_max_text_size", 65536),
+		Fuzzy:       s.getBoolean(ctx, "fts_fuzzy", true),
+		Pinyin:      s.getBoolean(ctx, "fts_pinyin", true),
+	}
+}
+
+func (s *settingProvider) SavedSearchMax(ctx context.Context) int {
+	return s.getInt(ctx, "saved_search_max", 20)
+}
+
+func (s *settingProvider) RssSubscriptionMax(ctx context.Context) int {
+	return s.getInt(ctx, "rss_subscription_max", 10)
+}
+
+func (s *settingProvider) FolderSyncMax(ctx context.Context) int {
+	return s.getInt(ctx, "folder_sync_max", 10)
+}
+
+func (s *settingProvider) RetentionRuleMax(ctx context.Context) int {
+	return s.getInt(ctx, "retention_rule_max", 10)
+}
+
+func (s *settingProvider) UrlImport(ctx context.Context) *UrlImport {
+	return &UrlImport{
+		MaxItems:        s.getInt(ctx, "url_import_max_items", 1000),
+		MaxConcurrency:  s.getInt(ctx, "url_import_max_concurrency", 4),
+		ManifestMaxSize: s.getInt64(ctx, "url_import_manifest_max_size", 1048576),
+	}
+}
+
+func (s *settingProvider) APIDocUIEnabled(ctx context.Context) bool {
+	return s.getBoolean(ctx, "api_doc_ui", false)
+}
+
+func (s *settingProvider) APIDeprecation(ctx context.Context, version int) *APIDeprecation {
+	return &APIDeprecation{
+		DeprecatedAt: s.getInt64(ctx, fmt.Sprintf("api_v%d_deprecated_at", version), 0),
+		SunsetAt:     s.getInt64(ctx, fmt.Sprintf("api_v%d_sunset_at", version), 0),
+	}
+}
+
+func (s *settingProvider) S3GatewayEnabled(ctx context.Context) bool {
+	return s.getBoolean(ctx, "s3_gateway", false)
+}
+
+func (s *settingProvider) S3AccessKeyMax(ctx context.Context) int {
+	return s.getInt(ctx, "s3_access_key_max", 10)
+}
+
+func (s *settingProvider) AiTagging(ctx context.Context) *AiTagging {
+	return &AiTagging{
+		Enabled:   s.getBoolean(ctx, "ai_tagging_enabled", false),
+		Endpoint:  s.getString(ctx, "ai_tagging_endpoint", ""),
+		Token:     s.getString(ctx, "ai_tagging_token", ""),
+		Exts:      s.getStringList(ctx, "ai_tagging_exts", []string{}),
+		MaxSize:   s.getInt64(ctx, "ai_tagging_max_size", 10485760),
+		BatchSize: s.getInt(ctx, "ai_tagging_batch_size", 8),
+		MaxPerRun: s.getInt(ctx, "ai_tagging_max_per_run", 64),
+		MinScore:  s.getFloat64(ctx, "ai_tagging_min_score", 0.5),
+		MaxTags:   s.getInt(ctx, "ai_tagging_max_tags", 10),
+	}
+}
+
+func (s *settingProvider) Moderation(ctx context.Context) *Moderation {
+	return &Moderation{
+		Enabled:        s.getBoolean(ctx, "moderation_enabled", false),
+		Endpoint:       s.getString(ctx, "moderation_endpoint", ""),
+		Token:          s.getString(ctx, "moderation_token", ""),
+		HashList:       s.getStringList(ctx, "moderation_hash_list", []string{}),
+		SendThumbnails: s.getBoolean(ctx, "moderation_send_thumbnails", false),
+		MaxFiles:       s.getInt(ctx, "moderation_max_files", 100),
+		MaxSize:        s.getInt64(ctx, "moderation_max_size", 104857600),
+	}
+}
+
+func (s *settingProvider) ThumbPregen(ctx context.Context) *ThumbPregen {
+	return &ThumbPregen{
+		Enabled:       s.getBoolean(ctx, "thumb_pregen_enabled", false),
+		OnUpload:      s.getBoolean(ctx, "thumb_pregen_on_upload", true),
+		OnList:        s.getBoolean(ctx, "thumb_pregen_on_list", true),
+		Backfill:      s.getBoolean(ctx, "thumb_pregen_backfill", false),
+		BackfillBatch: s.getInt(ctx, "thumb_pregen_backfill_batch", 200),
+		Exts:          s.getStringList(ctx, "thumb_pregen_exts", []string{}),
+	}
+}
+
+func (s *settingProvider) RateLimit(ctx context.Context, name string) *RateLimitRule {
+	if !s.getBoolean(ctx, "rate_limit_enabled", true) {
+		return nil
+	}
+
+	raw := s.getString(ctx, "rate_limit_"+name, "")
+	rule := &RateLimitRule{}
+	if err := json.Unmarshal([]byte(raw), rule); err != nil || rule.Limit <= 0 || rule.Window <= 0 {
+		return nil
+	}
+
+	if rule.Key == "" {
+		rule.Key = RateLimitKeyIP
+	}
+
+	return rule
+}
+
+func (s *settingProvider) IPAccess(ctx context.Context, surface string) *IPAccessRule {
+	return &IPAccessRule{
+		Allow: s.getString(ctx, "ip_"+surface+"_allow", ""),
+		Deny:  s.getString(ctx, "ip_"+surface+"_deny", ""),
+	}
+}
+
+func (s *settingProvider) TrustedProxies(ctx context.Context) string {
+	return s.getString(ctx, "ip_trusted_proxies", "")
+}
+
+func (s *settingProvider) Maintenance(ctx context.Context) *Maintenance {
+	return &Maintenance{
+		Enabled: s.getBoolean(ctx, "maintenance_enabled", false),
+		Message: s.getString(ctx, "maintenance_message", ""),
+		ETA:     s.getInt64(ctx, "maintenance_eta", 0),
+	}
+}
+
+func (s *settingProvider) AuditLogRetention(ctx context.Context) time.Duration {
+	return time.Duration(s.getInt(ctx, "audit_log_retention_days", 180)) * 24 * time.Hour
+}
+
+func (s *settingProvider) DavAuditLog(ctx context.Context) bool {
+	return s.getBoolean(ctx, "dav_audit_log", false)
+}
+
+func (s *settingProvider) DavAccountExpiryNotifyBefore(ctx context.Context) time.Duration {
+	return time.Duration(s.getInt(ctx, "dav_account_expiry_notify_before", 259200)) * time.Second
+}
+
+func (s *settingProvider) DavAccountIdleTimeout(ctx context.Context) time.Duration {
+	return time.Duration(s.getInt(ctx, "dav_account_idle_days", 0)) * 24 * time.Hour
+}
+
+func (s *settingProvider) AccountDeletion(ctx context.Context) *AccountDeletion {
+	return &AccountDeletion{
+		Enabled:     s.getBoolean(ctx, "account_deletion", true),
+		GracePeriod: time.Duration(s.getInt(ctx, "account_deletion_grace_period", 604800)) * time.Second,
+	}
+}
+
+func (s *settingProvider) LoginProtection(ctx context.Context) *LoginProtection {
+	return &LoginProtection{
+		Enabled:            s.getBoolean(ctx, "login_protection", true),
+		MaxAttempts:        s.getInt(ctx, "login_max_attempts", 5),
+		IPMaxAttempts:      s.getInt(ctx, "login_ip_max_attempts", 20),
+		FailureWindow:      s.getInt(ctx, "login_failure_window", 900),
+		LockoutDuration:    s.getInt(ctx, "login_lockout_duration", 300),
+		MaxLockoutDuration: s.getInt(ctx, "login_lockout_max_duration", 86400),
+		NotifyUser:         s.getBoolean(ctx, "login_failure_notify", true),
+	}
+}
+
+func (s *settingProvider) PasswordPolicy(ctx context.Context) *PasswordPolicy {
+	return &PasswordPolicy{
+		MinLength:     s.getInt(ctx, "password_min_length", 6),
+		RequireUpper:  s.getBoolean(ctx, "password_require_upper", false),
+		RequireLower:  s.getBoolean(ctx, "password_require_lower", false),
+		RequireDigit:  s.getBoolean(ctx, "password_require_digit", false),
+		RequireSymbol: s.getBoolean(ctx, "password_require_symbol", false),
+		BreachCheck:   s.getBoolean(ctx, "password_breach_check", false),
+		History:       s.getInt(ctx, "password_history", 0),
+		MaxAge:        time.Duration(s.getInt(ctx, "password_max_age", 0)) * 24 * time.Hour,
+	}
+}
+
 func (s *settingProvider) ActivationEmailTemplate(ctx context.Context) []EmailTemplate {
 	src := s.getString(ctx, "mail_activation_template", "[]")
 	var templates []EmailTemplate
@@ -669,6 +1292,29 @@ func (s *settingProvider) RegisterEnabled(ctx context.Context) bool {
 	return s.getBoolean(ctx, "register_enabled", false)
 }
 
+func (s *settingProvider) InvitationEnabled(ctx context.Context) bool {
+	return s.getBoolean(ctx, "register_invitation", false)
+}
+
+func (s *settingProvider) RegisterAllowedDomains(ctx context.Context) []string {
+	domains := make([]string, 0)
+	for _, domain := range s.getStringList(ctx, "register_allowed_domains", []string{}) {
+		if domain = strings.ToLower(strings.TrimSpace(domain)); domain != "" {
+			domains = append(domains, domain)
+		}
+	}
+
+	return domains
+}
+
+func (s *settingProvider) EmailChangeVetoEnabled(ctx context.Context) bool {
+	return s.getBoolean(ctx, "email_change_veto", true)
+}
+
+func (s *settingProvider) EmailChangeVetoWindow(ctx context.Context) time.Duration {
+	return time.Duration(s.getInt(ctx, "email_change_veto_window", 86400)) * time.Second
+}
+
 func (s *settingProvider) SiteBasic(ctx context.Context) *SiteBasic {
 	return &SiteBasic{
 		Name:        s.getString(ctx, "siteName", ""),
@@ -62,6 +62,8 @@ type SMTP struct {
 type TokenAuth struct {
 	AccessTokenTTL  time.Duration
 	RefreshTokenTTL time.Duration
+	// ImpersonationTTL is the lifetime of admin impersonation sessions.
+	ImpersonationTTL time.Duration
 }
 
 type DBFS struct {
@@ -80,6 +82,8 @@ type (
 		BackoffMaxDuration time.Duration
 		MaxRetry           int
 		RetryDelay         time.Duration
+		// VisibilityTimeout is how long tasks of an unresponsive instance are kept before adopted by others.
+		VisibilityTimeout time.Duration
 	}
 )
 
@@ -92,6 +96,7 @@ var (
 	QueueTypeMediaMeta      = QueueType("media_meta")
 	QueueTypeIOIntense      = QueueType("io_intense")
 	QueueTypeThumb          = QueueType("thumb")
+	QueueTypeThumbPregen    = QueueType("thumb_pregen")
 	QueueTypeEntityRecycle  = QueueType("recycle")
 	QueueTypeSlave          = QueueType("slave")
 	QueueTypeRemoteDownload = QueueType("remote_download")
@@ -100,9 +105,34 @@ var (
 type CronType string
 
 var (
-	CronTypeEntityCollect    = CronType("entity_collect")
-	CronTypeTrashBinCollect  = CronType("trash_bin_collect")
-	CronTypeOauthCredRefresh = CronType("oauth_cred_refresh")
+	CronTypeEntityCollect     = CronType("entity_collect")
+	CronTypeTrashBinCollect   = CronType("trash_bin_collect")
+	CronTypeOauthCredRefresh  = CronType("oauth_cred_refresh")
+	CronTypeQuotaAlert        = CronType("quota_alert")
+	CronTypeOffboardCollect   = CronType("offboard_collect")
+	CronTypeAccountDeletion   = CronType("account_deletion")
+	CronTypeGroupExpiration   = CronType("group_expiration")
+	CronTypeEmailChange       = CronType("email_change")
+	CronTypeAuditLogPrune     = CronType("audit_log_prune")
+	CronTypeDailyStats        = CronType("daily_stats")
+	CronTypeNodeHeartbeat     = CronType("node_heartbeat_check")
+	CronTypePolicyHealthCheck = CronType("policy_health_check")
+	CronTypeOrphanScan        = CronType("orphan_scan")
+	CronTypeStorageRecalc     = CronType("storage_recalc")
+	CronTypeNodeCertCheck     = CronType("node_cert_check")
+	CronTypeDavAccountExpiry  = CronType("dav_account_expiry")
+	CronTypePreviewCache      = CronType("preview_cache_collect")
+	CronTypeAiTagging         = CronType("ai_tagging")
+	CronTypeThumbBackfill     = CronType("thumb_backfill")
+	CronTypeSearchIndex       = CronType("search_index")
+	CronTypeSavedSearch       = CronType("saved_search")
+	CronTypeRss               = CronType("rss")
+	CronTypeWebhookRetry      = CronType("webhook_retry")
+	CronTypeFolderSync        = CronType("folder_sync")
+	CronTypeRetention         = CronType("retention")
+	CronTypeTakeoutCollect    = CronType("takeout_collect")
+	CronTypeFolderSize        = CronType("folder_size")
+	CronTypeVersionPrune      = CronType("version_prune")
 )
 
 type Theme struct {
@@ -129,6 +159,283 @@ const (
 	CaptchaModeNumberAlphabet
 )
 
+// LoginProtection login brute-force protection settings. Durations are in seconds.
+type LoginProtection struct {
+	Enabled bool
+	// MaxAttempts failed attempts per account before it is locked. 0 means unlimited.
+	MaxAttempts int
+	// IPMaxAttempts failed attempts per client IP before it is locked. 0 means unlimited.
+	IPMaxAttempts int
+	// FailureWindow failed attempts older than this are forgotten.
+	FailureWindow int
+	// LockoutDuration duration of the first lockout, doubled on every subsequent lockout.
+	LockoutDuration int
+	// MaxLockoutDuration upper bound of the lockout duration.
+	MaxLockoutDuration int
+	// NotifyUser sends an email to the user when the account is locked.
+	NotifyUser bool
+}
+
+// PasswordPolicy requirements for new passwords.
+type PasswordPolicy struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// BreachCheck rejects passwords found in known breaches via k-anonymity lookup.
+	BreachCheck bool
+	// History number of previous passwords that cannot be reused, 0 to only forbid current one.
+	History int
+	// MaxAge forces password rotation after this duration, 0 for never.
+	MaxAge time.Duration
+}
+
+// AccountDeletion self-service account deletion settings.
+type AccountDeletion struct {
+	Enabled bool
+	// GracePeriod before the account is purged, login during it reactivates the account.
+	GracePeriod time.Duration
+}
+
+// QuotaAlert storage quota alert settings.
+type QuotaAlert struct {
+	Enabled bool
+	// Thresholds usage percentages that trigger alerts, in ascending order.
+	Thresholds []int
+	// Period in seconds, an alert of the same threshold is sent at most once per period.
+	Period int
+	// AdminDigest sends a daily digest of users over quota to admins.
+	AdminDigest bool
+}
+
+// NodeHeartbeat slave node heartbeat monitoring settings.
+type NodeHeartbeat struct {
+	// MissedBeats a node is marked offline after missing this number of heartbeats.
+	MissedBeats int
+	// AlertEmail sends emails to admins when node status changes.
+	AlertEmail bool
+	// AlertWebhook URL that receives a POST request when node status changes, empty to disable.
+	AlertWebhook string
+}
+
+// NodeTaskFailover settings of tasks offloaded to slave nodes.
+type NodeTaskFailover struct {
+	// Lease a node owns a task without being heard from, the task is reassigned to another node
+	// after the lease expires.
+	Lease time.Duration
+	// MaxFailovers is the maximum number of times a task can be reassigned.
+	MaxFailovers int
+}
+
+// NodeAdmission utilization thresholds of slave nodes for heavy tasks. 0 disables the check of a resource.
+type NodeAdmission struct {
+	// CPU usage in percent.
+	CPU float64
+	// Memory usage in percent.
+	Memory float64
+	// Disk usage of data folder in percent.
+	Disk float64
+	// Bandwidth network throughput in Mbps, received and transmitted combined.
+	Bandwidth int64
+}
+
+// ClusterSecurity settings of communication between master and slave nodes.
+type ClusterSecurity struct {
+	// SecureMode rejects slave nodes that are not configured with mutual TLS.
+	SecureMode bool
+	// CertRenewBefore admins are reminded to rotate node certificates expiring within this duration.
+	CertRenewBefore time.Duration
+}
+
+// Transcode video transcoding settings.
+type Transcode struct {
+	Enabled bool
+	// FFMpegPath, HWAccel and VideoEncoder are used by master, and can be overridden by node settings.
+	FFMpegPath     string
+	HWAccel        string
+	VideoEncoder   string
+	Height         int
+	SegmentSeconds int
+	// MaxSize is the maximum size of source video.
+	MaxSize int64
+	Exts    []string
+}
+
+// OrphanCleanup orphan blob detection and cleanup settings.
+type OrphanCleanup struct {
+	// AutoCleanup deletes orphan blobs in scheduled scans.
+	AutoCleanup bool
+	// Quarantine orphan blobs are only deleted after being orphan for this long.
+	Quarantine time.Duration
+}
+
+// AiTagging image tagging with an inference endpoint.
+type AiTagging struct {
+	Enabled  bool
+	Endpoint string
+	Token    string
+	Exts     []string
+	// MaxSize is the maximum size of image sent when thumbnail is not available.
+	MaxSize int64
+	// BatchSize is the number of images sent in one request.
+	BatchSize int
+	// MaxPerRun limits the number of images tagged in each scheduled run.
+	MaxPerRun int
+	// MinScore is the minimum confidence score of labels saved as tags.
+	MinScore float64
+	// MaxTags is the maximum number of tags saved for an image.
+	MaxTags int
+}
+
+// UrlImport bulk import of files from a list of URLs.
+type UrlImport struct {
+	// MaxItems is the maximum number of URLs in one import task.
+	MaxItems int
+	// MaxConcurrency is the maximum number of URLs downloaded in parallel by one task.
+	MaxConcurrency int
+	// ManifestMaxSize is the maximum size of a manifest file listing URLs.
+	ManifestMaxSize int64
+}
+
+// Moderation content moderation of public shares.
+type Moderation struct {
+	Enabled bool
+	// Endpoint of the external moderation API, empty to check hash list only.
+	Endpoint string
+	Token    string
+	// HashList is a list of SHA-256 hashes of known offending files.
+	HashList []string
+	// SendThumbnails sends thumbnails of files to the API along with their hashes.
+	SendThumbnails bool
+	// MaxFiles is the maximum number of files checked in a shared folder.
+	MaxFiles int
+	// MaxSize is the maximum size of files hashed, larger files are skipped.
+	MaxSize int64
+}
+
+// OCR text recognition of images and scanned PDFs.
+type OCR struct {
+	Enabled bool
+	// Engine is either "tesseract" or "api".
+	Engine        string
+	TesseractPath string
+	// Languages are tesseract language codes joined by "+", e.g. "eng+chi_sim".
+	Languages string
+	Endpoint  string
+	Token     string
+	Exts      []string
+	MaxSize   int64
+	// PdfMaxPages is the maximum number of PDF pages recognized.
+	PdfMaxPages int
+}
+
+// VideoSprite sprite sheet of sampled video frames, used for scrub previews.
+type VideoSprite struct {
+	Enabled bool
+	Exts    []string
+	MaxSize int64
+	// Frames is the maximum number of frames sampled from a video.
+	Frames int
+	// Columns is the number of frames in each row of the sprite sheet.
+	Columns     int
+	FrameWidth  int
+	FrameHeight int
+}
+
+// ThumbPregen eager thumbnail generation.
+type ThumbPregen struct {
+	Enabled bool
+	// OnUpload pregenerates thumbnails of newly uploaded files.
+	OnUpload bool
+	// OnList pregenerates thumbnails of files in folders being browsed.
+	OnList bool
+	// Backfill pregenerates thumbnails of existing files in background.
+	Backfill bool
+	// BackfillBatch is the number of files queued in each scheduled backfill run.
+	BackfillBatch int
+	Exts          []string
+}
+
+// SearchProvider is the backend of full-text search index.
+type SearchProvider string
+
+const (
+	SearchProviderBleve         = SearchProvider("bleve")
+	SearchProviderElasticsearch = SearchProvider("elasticsearch")
+)
+
+// FullTextSearch full-text search index of files.
+type FullTextSearch struct {
+	Enabled  bool
+	Provider SearchProvider
+	// IndexPath is the folder of the embedded Bleve index, relative to data path.
+	IndexPath string
+	// Elasticsearch is the cluster used by SearchProviderElasticsearch, also compatible with OpenSearch.
+	Elasticsearch ElasticsearchSearch
+	// BatchSize is the number of changed files indexed in each scheduled run.
+	BatchSize int
+	// MaxTextSize is the maximum bytes of extracted text indexed for a file.
+	MaxTextSize int
+	// Fuzzy allows a typo in search text when matching file names.
+	Fuzzy bool
+	// Pinyin matches Chinese file names by pinyin or pinyin initials.
+	Pinyin bool
+}
+
+type ElasticsearchSearch struct {
+	Endpoint string
+	Username string
+	Password string
+	// Index is the alias pointing to current index, a new index is created and swapped in when rebuilding.
+	Index string
+}
+
+// RateLimitKey is what requests are counted by in a rate limit rule.
+type RateLimitKey string
+
+const (
+	RateLimitKeyIP    = RateLimitKey("ip")
+	RateLimitKeyUser  = RateLimitKey("user")
+	RateLimitKeyToken = RateLimitKey("token")
+)
+
+// RateLimitRule limits requests to a route group in a sliding window.
+type RateLimitRule struct {
+	// Limit max requests in a window.
+	Limit int `json:"limit"`
+	// Window length of the sliding window in seconds.
+	Window int `json:"window"`
+	// Key requests are counted per client IP, login user or owner of the access token. Anonymous
+	// requests and requests without access token are counted by IP.
+	Key RateLimitKey `json:"key"`
+}
+
+// IPAccessRule IP allow/deny lists of a surface, each is a list of IPs or CIDRs separated by
+// commas or new lines. Deny list takes precedence, and if allow list is not empty, only listed
+// IPs are allowed.
+type IPAccessRule struct {
+	Allow string
+	Deny  string
+}
+
+// Maintenance maintenance mode settings, relayed to slave nodes in heartbeat responses.
+type Maintenance struct {
+	Enabled bool `json:"enabled"`
+	// Message shown to users during maintenance.
+	Message string `json:"message,omitempty"`
+	// ETA unix timestamp when maintenance is expected to end, 0 if unknown.
+	ETA int64 `json:"eta,omitempty"`
+}
+
+// APIDeprecation is the deprecation schedule of an API version.
+type APIDeprecation struct {
+	// DeprecatedAt unix timestamp when the version is deprecated, 0 if not deprecated.
+	DeprecatedAt int64
+	// SunsetAt unix timestamp when the version is expected to be removed, 0 if unknown.
+	SunsetAt int64
+}
+
 type Captcha struct {
 	Height             int
 	Width              int
@@ -178,8 +485,9 @@ const (
 	ViewerActionView = "view"
 	ViewerActionEdit = "edit"
 
-	ViewerTypeBuiltin = "builtin"
-	ViewerTypeWopi    = "wopi"
+	ViewerTypeBuiltin    = "builtin"
+	ViewerTypeWopi       = "wopi"
+	ViewerTypeOnlyOffice = "onlyoffice"
 )
 
 type Viewer struct {
@@ -0,0 +1,691 @@
+// Package siteconfig exports site settings, and optionally groups, storage policies and nodes, into a
+// declarative YAML manifest that can be re-applied idempotently on another instance. Entities are
+// matched by name, references between them are expressed by name instead of ID.
+package siteconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/node"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestVersion is the current version of manifest format.
+const ManifestVersion = 1
+
+const listPageSize = 1000
+
+// instanceSettings are bound to current instance and never exported or applied.
+var instanceSettings = []string{"siteID", "secret_key", "hash_id_salt"}
+
+// secretSettings are only exported if secrets are requested. They are kept as is on apply if omitted.
+var secretSettings = []string{"smtpPass", "captcha_ReCaptchaSecret", "captcha_turnstile_site_secret"}
+
+type (
+	// Manifest is the declarative representation of site configuration.
+	Manifest struct {
+		Version  int               `yaml:"version"`
+		Settings map[string]string `yaml:"settings,omitempty"`
+		Nodes    []*Node           `yaml:"nodes,omitempty"`
+		Policies []*Policy         `yaml:"policies,omitempty"`
+		Groups   []*Group          `yaml:"groups,omitempty"`
+	}
+
+	Node struct {
+		Name         string         `yaml:"name"`
+		Type         string         `yaml:"type"`
+		Status       string         `yaml:"status"`
+		Server       string         `yaml:"server,omitempty"`
+		SlaveKey     string         `yaml:"slave_key,omitempty"`
+		Capabilities string         `yaml:"capabilities,omitempty"`
+		Weight       int            `yaml:"weight"`
+		Settings     map[string]any `yaml:"settings,omitempty"`
+	}
+
+	Policy struct {
+		Name         string         `yaml:"name"`
+		Type         string         `yaml:"type"`
+		Server       string         `yaml:"server,omitempty"`
+		BucketName   string         `yaml:"bucket_name,omitempty"`
+		IsPrivate    bool           `yaml:"is_private"`
+		AccessKey    string         `yaml:"access_key,omitempty"`
+		SecretKey    string         `yaml:"secret_key,omitempty"`
+		MaxSize      int64          `yaml:"max_size"`
+		DirNameRule  string         `yaml:"dir_name_rule,omitempty"`
+		FileNameRule string         `yaml:"file_name_rule,omitempty"`
+		Node         string         `yaml:"node,omitempty"`
+		Settings     map[string]any `yaml:"settings,omitempty"`
+	}
+
+	Group struct {
+		Name          string         `yaml:"name"`
+		MaxStorage    int64          `yaml:"max_storage"`
+		SpeedLimit    int            `yaml:"speed_limit"`
+		Permissions   string         `yaml:"permissions,omitempty"`
+		StoragePolicy string         `yaml:"storage_policy,omitempty"`
+		Settings      map[string]any `yaml:"settings,omitempty"`
+	}
+
+	// ExportOptions controls what is included in exported manifest.
+	ExportOptions struct {
+		Nodes    bool
+		Policies bool
+		Groups   bool
+		// Secrets includes storage policy credentials, node keys and secret settings.
+		Secrets bool
+	}
+
+	// ApplyOptions controls how a manifest is applied.
+	ApplyOptions struct {
+		// SkipSettings skips applying site settings, used when caller applies them on its own.
+		SkipSettings bool
+		// DryRun computes changes without saving them.
+		DryRun bool
+		// ValidateNode is called with each node before it is saved.
+		ValidateNode func(ctx context.Context, n *ent.Node) error
+		// ValidatePolicy is called with each storage policy before it is saved, nc reads nodes
+		// within the transaction.
+		ValidatePolicy func(ctx context.Context, nc inventory.NodeClient, p *ent.StoragePolicy) error
+	}
+
+	// ApplyResult summarizes changes made by applying a manifest.
+	ApplyResult struct {
+		Settings []string `json:"settings"`
+		Unknown  []string `json:"unknown_settings,omitempty"`
+		Created  []string `json:"created"`
+		Updated  []string `json:"updated"`
+		// ChangedSettings are settings different from current values, only set if SkipSettings is true.
+		ChangedSettings map[string]string `json:"-"`
+		// SavedNodes are nodes created or updated.
+		SavedNodes []*ent.Node `json:"-"`
+		// SavedPolicies are storage policies created or updated.
+		SavedPolicies []*ent.StoragePolicy `json:"-"`
+	}
+)
+
+// Marshal encodes manifest into YAML.
+func Marshal(m *Manifest) ([]byte, error) {
+	return yaml.Marshal(m)
+}
+
+// Unmarshal decodes manifest from YAML.
+func Unmarshal(data []byte) (*Manifest, error) {
+	m := &Manifest{}
+	if err := yaml.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	if m.Version != ManifestVersion {
+		return nil, fmt.Errorf("unsupported manifest version %d", m.Version)
+	}
+
+	return m, nil
+}
+
+// Export builds a manifest from current site configuration.
+func Export(ctx context.Context, dep dependency.Dep, opts *ExportOptions) (*Manifest, error) {
+	keys := lo.Filter(lo.Keys(inventory.DefaultSettings), func(item string, _ int) bool {
+		return !slices.Contains(instanceSettings, item) && (opts.Secrets || !slices.Contains(secretSettings, item))
+	})
+	settings, err := dep.SettingClient().Gets(ctx, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get settings: %w", err)
+	}
+
+	m := &Manifest{Version: ManifestVersion, Settings: settings}
+	if !opts.Nodes && !opts.Policies && !opts.Groups {
+		return m, nil
+	}
+
+	nodes, err := listNodes(ctx, dep.NodeClient())
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := listPolicies(ctx, dep.StoragePolicyClient())
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Nodes {
+		for _, n := range nodes {
+			m.Nodes = append(m.Nodes, exportNode(n, opts.Secrets))
+		}
+	}
+
+	if opts.Policies {
+		nodeNames := lo.SliceToMap(nodes, func(n *ent.Node) (int, string) { return n.ID, n.Name })
+		for _, p := range policies {
+			exported, err := exportPolicy(p, nodeNames, opts.Secrets)
+			if err != nil {
+				return nil, err
+			}
+			m.Policies = append(m.Policies, exported)
+		}
+	}
+
+	if opts.Groups {
+		groups, err := dep.GroupClient().ListAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list groups: %w", err)
+		}
+
+		policyNames := lo.SliceToMap(policies, func(p *ent.StoragePolicy) (int, string) { return p.ID, p.Name })
+		for _, g := range groups {
+			exported, err := exportGroup(g, policyNames)
+			if err != nil {
+				return nil, err
+			}
+			m.Groups = append(m.Groups, exported)
+		}
+	}
+
+	return m, nil
+}
+
+// Apply applies given manifest in a single transaction. Nodes are applied before storage policies,
+// which are applied before groups, so that references by name can be resolved.
+func Apply(ctx context.Context, dep dependency.Dep, m *Manifest, opts *ApplyOptions) (*ApplyResult, error) {
+	res := &ApplyResult{}
+
+	sc, tx, ctx, err := inventory.WithTx(ctx, dep.SettingClient())
+	if err != nil {
+		return nil, err
+	}
+	// Following clients inherit the transaction above.
+	nc, _, ctx, err := inventory.WithTx(ctx, dep.NodeClient())
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, err
+	}
+	pc, _, ctx, err := inventory.WithTx(ctx, dep.StoragePolicyClient())
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, err
+	}
+	gc, _, ctx, err := inventory.WithTx(ctx, dep.GroupClient())
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, err
+	}
+
+	changed, err := diffSettings(ctx, sc, m.Settings, res)
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, err
+	}
+
+	if err := applyNodes(ctx, nc, m.Nodes, opts, res); err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, err
+	}
+
+	if err := applyPolicies(ctx, nc, pc, m.Policies, opts, res); err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, err
+	}
+
+	if err := applyGroups(ctx, pc, gc, m.Groups, res); err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, err
+	}
+
+	if opts.SkipSettings {
+		res.ChangedSettings = changed
+	} else if len(changed) > 0 {
+		if err := sc.Set(ctx, changed); err != nil {
+			_ = inventory.Rollback(tx)
+			return nil, fmt.Errorf("failed to save settings: %w", err)
+		}
+	}
+
+	if opts.DryRun {
+		_ = inventory.Rollback(tx)
+		return res, nil
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if !opts.SkipSettings && len(changed) > 0 {
+		if err := dep.KV().Delete(setting.KvSettingPrefix, lo.Keys(changed)...); err != nil {
+			return res, fmt.Errorf("failed to clear setting cache: %w", err)
+		}
+	}
+
+	return res, nil
+}
+
+// diffSettings returns settings that differ from current values.
+func diffSettings(ctx context.Context, sc inventory.SettingClient, settings map[string]string, res *ApplyResult) (map[string]string, error) {
+	keys := lo.Filter(lo.Keys(settings), func(item string, _ int) bool {
+		return !slices.Contains(instanceSettings, item)
+	})
+	current, err := sc.Gets(ctx, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get settings: %w", err)
+	}
+
+	changed := make(map[string]string)
+	for _, k := range keys {
+		v, ok := current[k]
+		if !ok {
+			res.Unknown = append(res.Unknown, k)
+			continue
+		}
+
+		if v != settings[k] {
+			changed[k] = settings[k]
+			res.Settings = append(res.Settings, k)
+		}
+	}
+
+	sort.Strings(res.Settings)
+	sort.Strings(res.Unknown)
+	return changed, nil
+}
+
+func applyNodes(ctx context.Context, nc inventory.NodeClient, nodes []*Node, opts *ApplyOptions, res *ApplyResult) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	existing, err := listNodes(ctx, nc)
+	if err != nil {
+		return err
+	}
+
+	for _, n := range nodes {
+		target, found := lo.Find(existing, func(item *ent.Node) bool {
+			if n.Type == string(node.TypeMaster) {
+				return item.Type == node.TypeMaster
+			}
+			return item.Name == n.Name
+		})
+
+		model := &ent.Node{
+			Name:   n.Name,
+			Type:   node.Type(n.Type),
+			Status: node.Status(n.Status),
+			Server: n.Server,
+			Weight: n.Weight,
+		}
+		if found {
+			model.ID = target.ID
+			model.SlaveKey = target.SlaveKey
+			model.Capabilities = target.Capabilities
+			model.Settings = target.Settings
+		}
+
+		if err := node.TypeValidator(model.Type); err != nil {
+			return fmt.Errorf("node %q: %w", n.Name, err)
+		}
+		if err := node.StatusValidator(model.Status); err != nil {
+			return fmt.Errorf("node %q: %w", n.Name, err)
+		}
+		if n.SlaveKey != "" {
+			model.SlaveKey = n.SlaveKey
+		}
+		if n.Capabilities != "" {
+			if model.Capabilities, err = boolset.FromString(n.Capabilities); err != nil {
+				return fmt.Errorf("node %q: invalid capabilities: %w", n.Name, err)
+			}
+		}
+		if model.Capabilities == nil {
+			model.Capabilities = &boolset.BooleanSet{}
+		}
+		if n.Settings != nil {
+			model.Settings = &types.NodeSetting{}
+			if err := fromMap(n.Settings, model.Settings); err != nil {
+				return fmt.Errorf("node %q: invalid settings: %w", n.Name, err)
+			}
+		}
+		if model.Settings == nil {
+			model.Settings = &types.NodeSetting{}
+		}
+
+		if found && sameEntity(exportNodeModel(target), exportNodeModel(model)) {
+			continue
+		}
+
+		if opts.ValidateNode != nil {
+			if err := opts.ValidateNode(ctx, model); err != nil {
+				return fmt.Errorf("node %q: %w", n.Name, err)
+			}
+		}
+
+		saved, err := nc.Upsert(ctx, model)
+		if err != nil {
+			return fmt.Errorf("failed to save node %q: %w", n.Name, err)
+		}
+		res.SavedNodes = append(res.SavedNodes, saved)
+		recordChange(res, "node", n.Name, found)
+	}
+
+	return nil
+}
+
+func applyPolicies(ctx context.Context, nc inventory.NodeClient, pc inventory.StoragePolicyClient, policies []*Policy, opts *ApplyOptions, res *ApplyResult) error {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	nodes, err := listNodes(ctx, nc)
+	if err != nil {
+		return err
+	}
+
+	existing, err := listPolicies(ctx, pc)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range policies {
+		target, found := lo.Find(existing, func(item *ent.StoragePolicy) bool {
+			return item.Name == p.Name
+		})
+
+		model := &ent.StoragePolicy{
+			Name:         p.Name,
+			Type:         p.Type,
+			Server:       p.Server,
+			BucketName:   p.BucketName,
+			IsPrivate:    p.IsPrivate,
+			AccessKey:    p.AccessKey,
+			SecretKey:    p.SecretKey,
+			MaxSize:      p.MaxSize,
+			DirNameRule:  p.DirNameRule,
+			FileNameRule: p.FileNameRule,
+			Settings:     &types.PolicySetting{},
+		}
+		if found {
+			model.ID = target.ID
+			// Credentials are not exported by default, keep existing ones if not specified.
+			if model.AccessKey == "" {
+				model.AccessKey = target.AccessKey
+			}
+			if model.SecretKey == "" {
+				model.SecretKey = target.SecretKey
+			}
+			if p.Settings == nil && target.Settings != nil {
+				model.Settings = target.Settings
+			}
+		}
+
+		if p.Node != "" {
+			n, ok := lo.Find(nodes, func(item *ent.Node) bool { return item.Name == p.Node })
+			if !ok {
+				return fmt.Errorf("storage policy %q: node %q not found", p.Name, p.Node)
+			}
+			model.NodeID = n.ID
+		}
+
+		if p.Settings != nil {
+			if err := fromMap(p.Settings, model.Settings); err != nil {
+				return fmt.Errorf("storage policy %q: invalid settings: %w", p.Name, err)
+			}
+		}
+
+		if found && sameEntity(exportPolicyModel(target), exportPolicyModel(model)) {
+			continue
+		}
+
+		if opts.ValidatePolicy != nil {
+			if err := opts.ValidatePolicy(ctx, nc, model); err != nil {
+				return fmt.Errorf("storage policy %q: %w", p.Name, err)
+			}
+		}
+
+		saved, err := pc.Upsert(ctx, model)
+		if err != nil {
+			return fmt.Errorf("failed to save storage policy %q: %w", p.Name, err)
+		}
+		res.SavedPolicies = append(res.SavedPolicies, saved)
+		recordChange(res, "policy", p.Name, found)
+	}
+
+	return nil
+}
+
+func applyGroups(ctx context.Context, pc inventory.StoragePolicyClient, gc inventory.GroupClient, groups []*Group, res *ApplyResult) error {
+	if len(groups) == 0 {
+		return nil
+	}
+
+	policies, err := listPolicies(ctx, pc)
+	if err != nil {
+		return err
+	}
+
+	existing, err := gc.ListAll(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	for _, g := range groups {
+		target, found := lo.Find(existing, func(item *ent.Group) bool {
+			return item.Name == g.Name
+		})
+
+		model := &ent.Group{
+			Name:        g.Name,
+			MaxStorage:  g.MaxStorage,
+			SpeedLimit:  g.SpeedLimit,
+			Permissions: &boolset.BooleanSet{},
+			Settings:    &types.GroupSetting{},
+		}
+		if found {
+			model.ID = target.ID
+			// Keep existing permissions and settings if omitted.
+			if target.Permissions != nil {
+				model.Permissions = target.Permissions
+			}
+			if target.Settings != nil {
+				model.Settings = target.Settings
+			}
+		}
+
+		if g.Permissions != "" {
+			if model.Permissions, err = boolset.FromString(g.Permissions); err != nil {
+				return fmt.Errorf("group %q: invalid permissions: %w", g.Name, err)
+			}
+		}
+
+		if g.Settings != nil {
+			model.Settings = &types.GroupSetting{}
+			if err := fromMap(g.Settings, model.Settings); err != nil {
+				return fmt.Errorf("group %q: invalid settings: %w", g.Name, err)
+			}
+		}
+
+		if g.StoragePolicy != "" {
+			p, ok := lo.Find(policies, func(item *ent.StoragePolicy) bool { return item.Name == g.StoragePolicy })
+			if !ok {
+				return fmt.Errorf("group %q: storage policy %q not found", g.Name, g.StoragePolicy)
+			}
+			model.StoragePolicyID = p.ID
+			model.Edges.StoragePolicies = p
+		} else if found {
+			model.StoragePolicyID = target.StoragePolicyID
+			model.Edges.StoragePolicies = &ent.StoragePolicy{ID: target.StoragePolicyID}
+		} else {
+			return fmt.Errorf("group %q: storage policy is required", g.Name)
+		}
+
+		if found && sameEntity(exportGroupModel(target), exportGroupModel(model)) {
+			continue
+		}
+
+		if _, err := gc.Upsert(ctx, model); err != nil {
+			return fmt.Errorf("failed to save group %q: %w", g.Name, err)
+		}
+		recordChange(res, "group", g.Name, found)
+	}
+
+	return nil
+}
+
+func recordChange(res *ApplyResult, kind, name string, updated bool) {
+	item := fmt.Sprintf("%s:%s", kind, name)
+	if updated {
+		res.Updated = append(res.Updated, item)
+	} else {
+		res.Created = append(res.Created, item)
+	}
+}
+
+func listNodes(ctx context.Context, nc inventory.NodeClient) ([]*ent.Node, error) {
+	res, err := nc.ListNodes(ctx, &inventory.ListNodeParameters{
+		PaginationArgs: &inventory.PaginationArgs{PageSize: listPageSize},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	return res.Nodes, nil
+}
+
+func listPolicies(ctx context.Context, pc inventory.StoragePolicyClient) ([]*ent.StoragePolicy, error) {
+	res, err := pc.ListPolicies(ctx, &inventory.ListPolicyParameters{
+		PaginationArgs: &inventory.PaginationArgs{PageSize: listPageSize},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list storage policies: %w", err)
+	}
+
+	return res.Policies, nil
+}
+
+func exportNode(n *ent.Node, secrets bool) *Node {
+	res := exportNodeModel(n)
+	if !secrets {
+		res.SlaveKey = ""
+	}
+
+	return res
+}
+
+func exportNodeModel(n *ent.Node) *Node {
+	res := &Node{
+		Name:     n.Name,
+		Type:     string(n.Type),
+		Status:   string(n.Status),
+		Server:   n.Server,
+		SlaveKey: n.SlaveKey,
+		Weight:   n.Weight,
+	}
+	if n.Capabilities != nil {
+		res.Capabilities, _ = n.Capabilities.String()
+	}
+	res.Settings, _ = toMap(n.Settings)
+	return res
+}
+
+func exportPolicy(p *ent.StoragePolicy, nodeNames map[int]string, secrets bool) (*Policy, error) {
+	res := exportPolicyModel(p)
+	if p.NodeID != 0 {
+		name, ok := nodeNames[p.NodeID]
+		if !ok {
+			return nil, fmt.Errorf("storage policy %q: node %d not found", p.Name, p.NodeID)
+		}
+		res.Node = name
+	}
+
+	if !secrets {
+		res.AccessKey = ""
+		res.SecretKey = ""
+	}
+
+	return res, nil
+}
+
+func exportPolicyModel(p *ent.StoragePolicy) *Policy {
+	res := &Policy{
+		Name:         p.Name,
+		Type:         p.Type,
+		Server:       p.Server,
+		BucketName:   p.BucketName,
+		IsPrivate:    p.IsPrivate,
+		AccessKey:    p.AccessKey,
+		SecretKey:    p.SecretKey,
+		MaxSize:      p.MaxSize,
+		DirNameRule:  p.DirNameRule,
+		FileNameRule: p.FileNameRule,
+		// Node is compared by ID in the name field so that models can be compared without lookups.
+		Node: fmt.Sprint(p.NodeID),
+	}
+	res.Settings, _ = toMap(p.Settings)
+	return res
+}
+
+func exportGroup(g *ent.Group, policyNames map[int]string) (*Group, error) {
+	res := exportGroupModel(g)
+	res.StoragePolicy = ""
+	if g.StoragePolicyID != 0 {
+		name, ok := policyNames[g.StoragePolicyID]
+		if !ok {
+			return nil, fmt.Errorf("group %q: storage policy %d not found", g.Name, g.StoragePolicyID)
+		}
+		res.StoragePolicy = name
+	}
+
+	return res, nil
+}
+
+func exportGroupModel(g *ent.Group) *Group {
+	res := &Group{
+		Name:          g.Name,
+		MaxStorage:    g.MaxStorage,
+		SpeedLimit:    g.SpeedLimit,
+		StoragePolicy: fmt.Sprint(g.StoragePolicyID),
+	}
+	if g.Permissions != nil {
+		res.Permissions, _ = g.Permissions.String()
+	}
+	res.Settings, _ = toMap(g.Settings)
+	return res
+}
+
+// sameEntity compares two exported entities.
+func sameEntity(a, b any) bool {
+	aj, errA := json.Marshal(a)
+	bj, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(aj) == string(bj)
+}
+
+// toMap converts a settings struct into a map using its JSON field names.
+func toMap(v any) (map[string]any, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var res map[string]any
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// fromMap converts a map produced by toMap back into settings struct.
+func fromMap(m map[string]any, v any) error {
+	raw, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(raw, v)
+}
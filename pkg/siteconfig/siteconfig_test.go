@@ -0,0 +1,65 @@
+package siteconfig
+
+import (
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalUnmarshal(t *testing.T) {
+	a := assert.New(t)
+	m := &Manifest{
+		Version:  ManifestVersion,
+		Settings: map[string]string{"siteName": "Cloudreve"},
+		Groups: []*Group{
+			{Name: "Admin", MaxStorage: 1024, StoragePolicy: "Default", Settings: map[string]any{"source_batch": 10}},
+		},
+	}
+
+	content, err := Marshal(m)
+	a.NoError(err)
+
+	decoded, err := Unmarshal(content)
+	a.NoError(err)
+	a.Equal("Cloudreve", decoded.Settings["siteName"])
+	a.Len(decoded.Groups, 1)
+	a.Equal("Default", decoded.Groups[0].StoragePolicy)
+
+	settings := &types.GroupSetting{}
+	a.NoError(fromMap(decoded.Groups[0].Settings, settings))
+	a.Equal(10, settings.SourceBatchSize)
+}
+
+func TestUnmarshal_Version(t *testing.T) {
+	_, err := Unmarshal([]byte("version: 99\n"))
+	assert.Error(t, err)
+}
+
+func TestExportPolicy(t *testing.T) {
+	a := assert.New(t)
+	p := &ent.StoragePolicy{
+		Name:      "Remote",
+		Type:      "remote",
+		AccessKey: "ak",
+		SecretKey: "sk",
+		NodeID:    2,
+		Settings:  &types.PolicySetting{},
+	}
+
+	exported, err := exportPolicy(p, map[int]string{2: "Slave"}, false)
+	a.NoError(err)
+	a.Equal("Slave", exported.Node)
+	a.Empty(exported.AccessKey)
+	a.Empty(exported.SecretKey)
+
+	exported, err = exportPolicy(p, map[int]string{2: "Slave"}, true)
+	a.NoError(err)
+	a.Equal("sk", exported.SecretKey)
+
+	_, err = exportPolicy(p, map[int]string{}, false)
+	a.Error(err)
+
+	a.True(sameEntity(exportPolicyModel(p), exportPolicyModel(p)))
+}
@@ -0,0 +1,296 @@
+package stats
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/samber/lo"
+)
+
+// DateLayout is the layout of DailyStats.Date.
+const DateLayout = "2006-01-02"
+
+type (
+	// DailyStats is the pre-aggregated usage statistics of a single day.
+	DailyStats struct {
+		Date      string    `json:"date"`
+		UpdatedAt time.Time `json:"updated_at"`
+		// PolicyStorage total size of entities grouped by storage policy ID.
+		PolicyStorage map[int]int64 `json:"policy_storage,omitempty"`
+		// GroupStorage total storage used by users grouped by group ID.
+		GroupStorage map[int]int64 `json:"group_storage,omitempty"`
+		// TopUsers users using most storage, in descending order.
+		TopUsers []UserStorage `json:"top_users,omitempty"`
+		// ActiveUsers number of distinct users who made authenticated requests.
+		ActiveUsers  int                       `json:"active_users"`
+		Uploads      int                       `json:"uploads"`
+		UploadSize   int64                     `json:"upload_size"`
+		Downloads    int                       `json:"downloads"`
+		DownloadSize int64                     `json:"download_size"`
+		FileTypes    map[string]*FileTypeStats `json:"file_types,omitempty"`
+	}
+
+	UserStorage = types.StatsUserStorage
+
+	// FileTypeStats uploads of a file extension.
+	FileTypeStats = types.StatsFileType
+
+	// StorageSnapshot is the storage usage at the moment of Flush.
+	StorageSnapshot struct {
+		Policies map[int]int64
+		Groups   map[int]int64
+		TopUsers []UserStorage
+	}
+)
+
+// Recorder collects usage counters in memory and persists them into daily stats.
+type Recorder interface {
+	// Active marks the user as active today.
+	Active(uid int)
+	// Uploaded records an upload of given extension and size.
+	Uploaded(ext string, size int64)
+	// Downloaded records a download of given size. Only downloads served or redirected by this instance
+	// are recorded, see CountsAsDownload.
+	Downloaded(size int64)
+	// Flush merges pending counters into persisted stats. If snapshot is not nil, storage usage
+	// of today is replaced by it. Counters failed to be saved are kept for the next Flush.
+	Flush(ctx context.Context, snapshot *StorageSnapshot) error
+	// Range returns persisted stats of days between from and to, oldest first.
+	Range(ctx context.Context, from, to time.Time) ([]*DailyStats, error)
+	// Prune deletes stats of days before given time, returns the number of deleted days.
+	Prune(ctx context.Context, before time.Time) (int, error)
+	// Close flushes pending counters.
+	Close() error
+}
+
+// CountsAsDownload returns whether the request starts a new download. Following range requests of the
+// same download, e.g. seeking in a media player, are not counted.
+func CountsAsDownload(r *http.Request) bool {
+	rangeHeader := r.Header.Get("Range")
+	return rangeHeader == "" || strings.HasPrefix(rangeHeader, "bytes=0-")
+}
+
+// NewDBRecorder creates a Recorder that stores daily stats in DB, counters of all nodes are merged
+// into the same row of the day.
+func NewDBRecorder(client inventory.DailyStatClient) Recorder {
+	return &dbRecorder{
+		client:  client,
+		pending: make(map[string]*pendingStats),
+	}
+}
+
+type (
+	dbRecorder struct {
+		client inventory.DailyStatClient
+		// mu guards pending counters, flushMu serializes Flush.
+		mu      sync.Mutex
+		flushMu sync.Mutex
+		pending map[string]*pendingStats
+	}
+
+	pendingStats struct {
+		active       map[int]struct{}
+		uploads      int
+		uploadSize   int64
+		downloads    int
+		downloadSize int64
+		fileTypes    map[string]*FileTypeStats
+	}
+)
+
+func newPendingStats() *pendingStats {
+	return &pendingStats{
+		active:    make(map[int]struct{}),
+		fileTypes: make(map[string]*FileTypeStats),
+	}
+}
+
+func (r *dbRecorder) today() *pendingStats {
+	date := time.Now().Format(DateLayout)
+	p, ok := r.pending[date]
+	if !ok {
+		p = newPendingStats()
+		r.pending[date] = p
+	}
+
+	return p
+}
+
+func (r *dbRecorder) Active(uid int) {
+	if uid <= 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.today().active[uid] = struct{}{}
+}
+
+func (r *dbRecorder) Uploaded(ext string, size int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p := r.today()
+	p.uploads++
+	p.uploadSize += size
+
+	ft, ok := p.fileTypes[ext]
+	if !ok {
+		ft = &FileTypeStats{}
+		p.fileTypes[ext] = ft
+	}
+	ft.Count++
+	ft.Size += size
+}
+
+func (r *dbRecorder) Downloaded(size int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p := r.today()
+	p.downloads++
+	p.downloadSize += size
+}
+
+func (r *dbRecorder) Flush(ctx context.Context, snapshot *StorageSnapshot) error {
+	r.flushMu.Lock()
+	defer r.flushMu.Unlock()
+
+	r.mu.Lock()
+	pending := r.pending
+	r.pending = make(map[string]*pendingStats)
+	r.mu.Unlock()
+
+	today := time.Now().Format(DateLayout)
+	if snapshot != nil {
+		if _, ok := pending[today]; !ok {
+			pending[today] = nil
+		}
+	}
+
+	for date, p := range pending {
+		var active []int
+		if p != nil {
+			active = lo.Keys(p.active)
+		}
+
+		err := r.client.Update(ctx, date, active, func(stats *ent.DailyStat) {
+			if p != nil {
+				p.mergeInto(stats)
+			}
+
+			if snapshot != nil && date == today {
+				stats.PolicyStorage = snapshot.Policies
+				stats.GroupStorage = snapshot.Groups
+				stats.TopUsers = snapshot.TopUsers
+			}
+		})
+		if err != nil {
+			r.restore(pending)
+			return err
+		}
+
+		delete(pending, date)
+	}
+
+	return nil
+}
+
+// restore puts counters failed to be saved back to pending ones.
+func (r *dbRecorder) restore(failed map[string]*pendingStats) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for date, p := range failed {
+		if p == nil {
+			continue
+		}
+
+		if existing, ok := r.pending[date]; ok {
+			existing.add(p)
+		} else {
+			r.pending[date] = p
+		}
+	}
+}
+
+// add merges counters of o into p.
+func (p *pendingStats) add(o *pendingStats) {
+	for uid := range o.active {
+		p.active[uid] = struct{}{}
+	}
+	p.uploads += o.uploads
+	p.uploadSize += o.uploadSize
+	p.downloads += o.downloads
+	p.downloadSize += o.downloadSize
+	for ext, ft := range o.fileTypes {
+		existing, ok := p.fileTypes[ext]
+		if !ok {
+			existing = &FileTypeStats{}
+			p.fileTypes[ext] = existing
+		}
+		existing.Count += ft.Count
+		existing.Size += ft.Size
+	}
+}
+
+// mergeInto adds counters of p into stats. Active users are recorded separately by the client.
+func (p *pendingStats) mergeInto(stats *ent.DailyStat) {
+	stats.Uploads += p.uploads
+	stats.UploadSize += p.uploadSize
+	stats.Downloads += p.downloads
+	stats.DownloadSize += p.downloadSize
+
+	if len(p.fileTypes) > 0 && stats.FileTypes == nil {
+		stats.FileTypes = make(map[string]*FileTypeStats, len(p.fileTypes))
+	}
+	for ext, ft := range p.fileTypes {
+		existing, ok := stats.FileTypes[ext]
+		if !ok {
+			existing = &FileTypeStats{}
+			stats.FileTypes[ext] = existing
+		}
+		existing.Count += ft.Count
+		existing.Size += ft.Size
+	}
+}
+
+func (r *dbRecorder) Range(ctx context.Context, from, to time.Time) ([]*DailyStats, error) {
+	days, err := r.client.Range(ctx, from.Format(DateLayout), to.Format(DateLayout))
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*DailyStats, 0, len(days))
+	for _, day := range days {
+		res = append(res, &DailyStats{
+			Date:          day.Date,
+			UpdatedAt:     day.UpdatedAt,
+			PolicyStorage: day.PolicyStorage,
+			GroupStorage:  day.GroupStorage,
+			TopUsers:      day.TopUsers,
+			ActiveUsers:   day.ActiveUserCount,
+			Uploads:       day.Uploads,
+			UploadSize:    day.UploadSize,
+			Downloads:     day.Downloads,
+			DownloadSize:  day.DownloadSize,
+			FileTypes:     day.FileTypes,
+		})
+	}
+
+	return res, nil
+}
+
+func (r *dbRecorder) Prune(ctx context.Context, before time.Time) (int, error) {
+	return r.client.Prune(ctx, before.Format(DateLayout))
+}
+
+func (r *dbRecorder) Close() error {
+	return r.Flush(context.Background(), nil)
+}
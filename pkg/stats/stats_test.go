@@ -0,0 +1,104 @@
+package stats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	_ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRecorder(t *testing.T) (*dbRecorder, *ent.Client) {
+	drv, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewDBRecorder(inventory.NewDailyStatClient(client)).(*dbRecorder), client
+}
+
+func TestDBRecorder_Flush(t *testing.T) {
+	a := assert.New(t)
+	r, _ := newTestRecorder(t)
+	ctx := context.Background()
+
+	r.Active(1)
+	r.Active(2)
+	r.Active(0)
+	r.Uploaded("jpg", 100)
+	r.Uploaded("jpg", 50)
+	r.Downloaded(10)
+	a.NoError(r.Flush(ctx, &StorageSnapshot{Policies: map[int]int64{1: 1024}}))
+
+	r.Active(2)
+	r.Active(3)
+	r.Uploaded("mp4", 1000)
+	a.NoError(r.Flush(ctx, nil))
+
+	res, err := r.Range(ctx, time.Now(), time.Now())
+	a.NoError(err)
+	a.Len(res, 1)
+	a.Equal(3, res[0].ActiveUsers)
+	a.Equal(3, res[0].Uploads)
+	a.EqualValues(1150, res[0].UploadSize)
+	a.Equal(1, res[0].Downloads)
+	a.Equal(2, res[0].FileTypes["jpg"].Count)
+	a.EqualValues(1000, res[0].FileTypes["mp4"].Size)
+	a.EqualValues(1024, res[0].PolicyStorage[1])
+}
+
+func TestDBRecorder_FlushKeepsCountersOnError(t *testing.T) {
+	a := assert.New(t)
+	r, client := newTestRecorder(t)
+	ctx := context.Background()
+
+	r.Uploaded("jpg", 100)
+	a.NoError(client.Close())
+	a.Error(r.Flush(ctx, nil))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p := r.pending[time.Now().Format(DateLayout)]
+	if a.NotNil(p) {
+		a.Equal(1, p.uploads)
+		a.EqualValues(100, p.fileTypes["jpg"].Size)
+	}
+}
+
+func TestDBRecorder_RangeAndPrune(t *testing.T) {
+	a := assert.New(t)
+	r, _ := newTestRecorder(t)
+	ctx := context.Background()
+
+	old := time.Now().AddDate(0, 0, -10)
+	a.NoError(r.client.Update(ctx, old.Format(DateLayout), []int{1}, func(s *ent.DailyStat) { s.Uploads = 1 }))
+	a.NoError(r.client.Update(ctx, time.Now().Format(DateLayout), nil, func(s *ent.DailyStat) { s.Uploads = 2 }))
+
+	res, err := r.Range(ctx, old, time.Now())
+	a.NoError(err)
+	a.Len(res, 2)
+	a.Equal(1, res[0].Uploads)
+	a.Equal(1, res[0].ActiveUsers)
+
+	res, err = r.Range(ctx, time.Now().AddDate(0, 0, -5), time.Now())
+	a.NoError(err)
+	a.Len(res, 1)
+
+	deleted, err := r.Prune(ctx, time.Now().AddDate(0, 0, -5))
+	a.NoError(err)
+	a.Equal(1, deleted)
+
+	res, err = r.Range(ctx, old, time.Now())
+	a.NoError(err)
+	a.Len(res, 1)
+}
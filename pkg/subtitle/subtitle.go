@@ -0,0 +1,86 @@
+// Package subtitle matches subtitle sidecar files to videos, converting them into WebVTT that HTML5 players
+// understand.
+package subtitle
+
+import (
+	"path"
+	"regexp"
+	"strings"
+)
+
+const (
+	FormatSrt = "srt"
+	FormatVtt = "vtt"
+	FormatAss = "ass"
+	FormatSsa = "ssa"
+
+	vttHeader = "WEBVTT"
+)
+
+var (
+	formats = map[string]string{
+		".srt": FormatSrt,
+		".vtt": FormatVtt,
+		".ass": FormatAss,
+		".ssa": FormatSsa,
+	}
+
+	// srtTiming matches timing lines of SRT cues, e.g. "00:00:01,000 --> 00:00:02,500 X1:..." where the
+	// trailing coordinates are optional.
+	srtTiming = regexp.MustCompile(`^\s*(\d+:\d{2}:\d{2})[,.](\d{1,3})\s*-->\s*(\d+:\d{2}:\d{2})[,.](\d{1,3})`)
+)
+
+// Format returns the subtitle format of given file name, or empty string if it is not a subtitle.
+func Format(name string) string {
+	return formats[strings.ToLower(path.Ext(name))]
+}
+
+// Match reports whether the subtitle file belongs to the video, sharing its base name case-insensitively.
+// Label is the part between the base name and the extension, e.g. "en" for "movie.en.srt" of "movie.mkv".
+func Match(video, name string) (label string, ok bool) {
+	format := Format(name)
+	if format == "" {
+		return "", false
+	}
+
+	base := strings.TrimSuffix(video, path.Ext(video))
+	stem := strings.TrimSuffix(name, path.Ext(name))
+	if strings.EqualFold(stem, base) {
+		return "", true
+	}
+
+	if len(stem) <= len(base)+1 || !strings.EqualFold(stem[:len(base)+1], base+".") {
+		return "", false
+	}
+
+	return stem[len(base)+1:], true
+}
+
+// SrtToVtt converts SRT subtitle into WebVTT. Cue numbers are kept as identifiers, comma decimal separators
+// in timings are replaced and SRT-only coordinates are dropped.
+func SrtToVtt(content string) string {
+	content = strings.TrimPrefix(content, "\ufeff")
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.ReplaceAll(content, "\r", "\n")
+
+	var b strings.Builder
+	b.Grow(len(content) + len(vttHeader) + 2)
+	b.WriteString(vttHeader)
+	b.WriteString("\n\n")
+
+	for _, line := range strings.Split(strings.TrimSpace(content), "\n") {
+		if m := srtTiming.FindStringSubmatch(line); m != nil {
+			line = m[1] + "." + padMillis(m[2]) + " --> " + m[3] + "." + padMillis(m[4])
+		}
+
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
+
+// padMillis pads milliseconds to 3 digits, as "1,5" in SRT means 1.500 second.
+func padMillis(ms string) string {
+	return ms + strings.Repeat("0", 3-len(ms))
+}
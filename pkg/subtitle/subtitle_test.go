@@ -0,0 +1,35 @@
+package subtitle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	a := assert.New(t)
+
+	label, ok := Match("Movie.2020.mkv", "movie.2020.srt")
+	a.True(ok)
+	a.Empty(label)
+
+	label, ok = Match("Movie.2020.mkv", "Movie.2020.zh-CN.ass")
+	a.True(ok)
+	a.Equal("zh-CN", label)
+
+	_, ok = Match("Movie.2020.mkv", "Movie.2021.srt")
+	a.False(ok)
+
+	_, ok = Match("Movie.mkv", "Movies.srt")
+	a.False(ok)
+
+	_, ok = Match("Movie.mkv", "Movie.en.txt")
+	a.False(ok)
+}
+
+func TestSrtToVtt(t *testing.T) {
+	a := assert.New(t)
+
+	srt := "\ufeff1\r\n00:00:01,000 --> 00:00:02,5 X1:100 X2:200\r\nHello\r\n\r\n2\r\n00:01:00,250 --> 00:01:02,000\r\nWorld\r\n"
+	a.Equal("WEBVTT\n\n1\n00:00:01.000 --> 00:00:02.500\nHello\n\n2\n00:01:00.250 --> 00:01:02.000\nWorld\n", SrtToVtt(srt))
+}
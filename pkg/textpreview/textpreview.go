@@ -0,0 +1,250 @@
+// Package textpreview decodes text files of legacy encodings into UTF-8 for preview, with hints of the
+// programming language used for syntax highlighting.
+package textpreview
+
+import (
+	"bytes"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+	"golang.org/x/text/encoding/unicode"
+)
+
+const (
+	EncodingUTF8    = "utf-8"
+	EncodingUTF16LE = "utf-16le"
+	EncodingUTF16BE = "utf-16be"
+	EncodingGBK     = "gbk"
+	EncodingBig5    = "big5"
+	// EncodingLatin1 is the fallback of binary-like content, every byte is mapped to a character.
+	EncodingLatin1 = "windows-1252"
+)
+
+// Result is a text file decoded into UTF-8.
+type Result struct {
+	Content  string `json:"content"`
+	Encoding string `json:"encoding"`
+	// Truncated is true if only the beginning of the file is decoded.
+	Truncated bool   `json:"truncated"`
+	Language  string `json:"language,omitempty"`
+}
+
+var (
+	bomUTF8    = []byte{0xEF, 0xBB, 0xBF}
+	bomUTF16LE = []byte{0xFF, 0xFE}
+	bomUTF16BE = []byte{0xFE, 0xFF}
+)
+
+// Decode detects encoding of data and converts it into UTF-8. If truncated, data is only the beginning of the
+// file, the partial character at the end is dropped.
+func Decode(data []byte, truncated bool) *Result {
+	name, enc, body := detect(data)
+	if truncated && (name == EncodingUTF16LE || name == EncodingUTF16BE) && len(body)%2 == 1 {
+		body = body[:len(body)-1]
+	}
+
+	content := string(body)
+	if enc != nil {
+		if decoded, err := enc.NewDecoder().Bytes(body); err == nil {
+			content = string(decoded)
+		}
+	}
+
+	content = strings.ToValidUTF8(content, string(utf8.RuneError))
+	if truncated {
+		content = strings.TrimRight(content, string(utf8.RuneError))
+	}
+
+	return &Result{Content: content, Encoding: name, Truncated: truncated}
+}
+
+// detect returns the name and decoder of detected encoding, and data with BOM removed. Decoder is nil for UTF-8.
+func detect(data []byte) (string, encoding.Encoding, []byte) {
+	switch {
+	case bytes.HasPrefix(data, bomUTF8):
+		return EncodingUTF8, nil, data[len(bomUTF8):]
+	case bytes.HasPrefix(data, bomUTF16LE):
+		return EncodingUTF16LE, unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), data[len(bomUTF16LE):]
+	case bytes.HasPrefix(data, bomUTF16BE):
+		return EncodingUTF16BE, unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM), data[len(bomUTF16BE):]
+	}
+
+	if name, enc := detectUTF16(data); enc != nil {
+		return name, enc, data
+	}
+
+	if validUTF8(data) {
+		return EncodingUTF8, nil, data
+	}
+
+	gbk, big5 := validDoubleByte(data, isGBKLead, isGBKTrail), validDoubleByte(data, isBig5Lead, isBig5Trail)
+	switch {
+	case gbk && big5:
+		if big5Score(data) > gbkScore(data) {
+			return EncodingBig5, traditionalchinese.Big5, data
+		}
+		return EncodingGBK, simplifiedchinese.GBK, data
+	case gbk:
+		return EncodingGBK, simplifiedchinese.GBK, data
+	case big5:
+		return EncodingBig5, traditionalchinese.Big5, data
+	}
+
+	return EncodingLatin1, charmap.Windows1252, data
+}
+
+// detectUTF16 detects UTF-16 without BOM, in which ASCII characters have a zero byte on one side.
+func detectUTF16(data []byte) (string, encoding.Encoding) {
+	if len(data) < 4 {
+		return "", nil
+	}
+
+	var even, odd int
+	for i := 0; i+1 < len(data); i += 2 {
+		if data[i] == 0 {
+			even++
+		}
+		if data[i+1] == 0 {
+			odd++
+		}
+	}
+
+	pairs := len(data) / 2
+	switch {
+	case odd*10 > pairs*4 && even*10 < pairs:
+		return EncodingUTF16LE, unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)
+	case even*10 > pairs*4 && odd*10 < pairs:
+		return EncodingUTF16BE, unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)
+	}
+
+	return "", nil
+}
+
+// validUTF8 is utf8.Valid allowing a partial character at the end of truncated data.
+func validUTF8(data []byte) bool {
+	for i := 0; i < utf8.UTFMax && i < len(data); i++ {
+		if utf8.Valid(data[:len(data)-i]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validDoubleByte returns whether data is valid in a double byte encoding with given lead and trail byte ranges,
+// allowing a partial character at the end.
+func validDoubleByte(data []byte, isLead, isTrail func(byte) bool) bool {
+	for i := 0; i < len(data); i++ {
+		b := data[i]
+		if b < 0x80 {
+			continue
+		}
+
+		if !isLead(b) {
+			return false
+		}
+
+		if i+1 == len(data) {
+			return true
+		}
+
+		if !isTrail(data[i+1]) {
+			return false
+		}
+		i++
+	}
+
+	return true
+}
+
+func isGBKLead(b byte) bool  { return b >= 0x81 && b <= 0xFE }
+func isGBKTrail(b byte) bool { return b >= 0x40 && b <= 0xFE && b != 0x7F }
+func isBig5Lead(b byte) bool { return b >= 0x81 && b <= 0xFE }
+func isBig5Trail(b byte) bool {
+	return (b >= 0x40 && b <= 0x7E) || (b >= 0xA1 && b <= 0xFE)
+}
+
+// gbkScore counts characters in the most frequently used area of GB2312 (level 1 Hanzi).
+func gbkScore(data []byte) int {
+	return countPairs(data, func(lead, trail byte) bool {
+		return lead >= 0xB0 && lead <= 0xD7 && trail >= 0xA1
+	})
+}
+
+// big5Score counts characters in the frequently used area of Big5, trail bytes below 0x80 never appear in
+// GB2312 so they are strong signals.
+func big5Score(data []byte) int {
+	return countPairs(data, func(lead, trail byte) bool {
+		return lead >= 0xA4 && lead <= 0xC6 && (trail <= 0x7E || lead <= 0xAF)
+	})
+}
+
+func countPairs(data []byte, match func(lead, trail byte) bool) int {
+	count := 0
+	for i := 0; i+1 < len(data); i++ {
+		if data[i] < 0x80 {
+			continue
+		}
+
+		if match(data[i], data[i+1]) {
+			count++
+		}
+		i++
+	}
+
+	return count
+}
+
+var extLanguages = map[string]string{
+	"c": "c", "h": "c", "cc": "cpp", "cpp": "cpp", "cxx": "cpp", "hpp": "cpp", "cs": "csharp",
+	"css": "css", "scss": "scss", "less": "less", "go": "go", "java": "java", "kt": "kotlin",
+	"js": "javascript", "mjs": "javascript", "cjs": "javascript", "jsx": "javascript", "ts": "typescript",
+	"tsx": "typescript", "json": "json", "py": "python", "rb": "ruby", "rs": "rust", "php": "php",
+	"swift": "swift", "sh": "shell", "bash": "shell", "zsh": "shell", "ps1": "powershell", "bat": "bat",
+	"sql": "sql", "html": "html", "htm": "html", "xml": "xml", "svg": "xml", "vue": "html", "yaml": "yaml",
+	"yml": "yaml", "toml": "ini", "ini": "ini", "conf": "ini", "md": "markdown", "markdown": "markdown",
+	"lua": "lua", "r": "r", "pl": "perl", "scala": "scala", "dart": "dart", "tex": "latex", "proto": "protobuf",
+	"dockerfile": "dockerfile", "makefile": "makefile", "graphql": "graphql",
+}
+
+var shebangLanguages = map[string]string{
+	"sh": "shell", "bash": "shell", "zsh": "shell", "python": "python", "python3": "python", "node": "javascript",
+	"ruby": "ruby", "perl": "perl", "php": "php", "lua": "lua",
+}
+
+// Language returns hint of the programming language by file name, or the shebang line of scripts. It returns
+// empty string if unknown.
+func Language(name, content string) string {
+	base := strings.ToLower(path.Base(name))
+	if lang, ok := extLanguages[base]; ok {
+		return lang
+	}
+
+	if ext := strings.TrimPrefix(path.Ext(base), "."); ext != "" {
+		if lang, ok := extLanguages[ext]; ok {
+			return lang
+		}
+	}
+
+	if !strings.HasPrefix(content, "#!") {
+		return ""
+	}
+
+	line, _, _ := strings.Cut(content, "\n")
+	fields := strings.Fields(strings.TrimPrefix(line, "#!"))
+	if len(fields) == 0 {
+		return ""
+	}
+
+	interpreter := path.Base(fields[0])
+	if interpreter == "env" && len(fields) > 1 {
+		interpreter = fields[1]
+	}
+
+	return shebangLanguages[interpreter]
+}
@@ -0,0 +1,66 @@
+package textpreview
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+	"golang.org/x/text/encoding/unicode"
+)
+
+func TestDecode(t *testing.T) {
+	a := assert.New(t)
+
+	res := Decode([]byte("hello, 世界"), false)
+	a.Equal(EncodingUTF8, res.Encoding)
+	a.Equal("hello, 世界", res.Content)
+
+	gbk, _ := simplifiedchinese.GBK.NewEncoder().Bytes([]byte("这是一个简体中文的文本文件，用于测试编码检测。"))
+	res = Decode(gbk, false)
+	a.Equal(EncodingGBK, res.Encoding)
+	a.Equal("这是一个简体中文的文本文件，用于测试编码检测。", res.Content)
+
+	big5, _ := traditionalchinese.Big5.NewEncoder().Bytes([]byte("這是一個繁體中文的文字檔案，用來測試編碼偵測。"))
+	res = Decode(big5, false)
+	a.Equal(EncodingBig5, res.Encoding)
+	a.Equal("這是一個繁體中文的文字檔案，用來測試編碼偵測。", res.Content)
+
+	utf16, _ := unicode.UTF16(unicode.LittleEndian, unicode.UseBOM).NewEncoder().Bytes([]byte("hello"))
+	res = Decode(utf16, false)
+	a.Equal(EncodingUTF16LE, res.Encoding)
+	a.Equal("hello", res.Content)
+
+	utf16, _ = unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM).NewEncoder().Bytes([]byte("plain text"))
+	res = Decode(utf16, false)
+	a.Equal(EncodingUTF16BE, res.Encoding)
+	a.Equal("plain text", res.Content)
+
+	res = Decode([]byte{0xFF, 0xFF, 0xFF, 0x41}, false)
+	a.Equal(EncodingLatin1, res.Encoding)
+}
+
+func TestDecodeTruncated(t *testing.T) {
+	a := assert.New(t)
+
+	// Partial character at the end is dropped
+	data := []byte("世界")
+	res := Decode(data[:len(data)-1], true)
+	a.Equal(EncodingUTF8, res.Encoding)
+	a.Equal("世", res.Content)
+	a.True(res.Truncated)
+
+	gbk, _ := simplifiedchinese.GBK.NewEncoder().Bytes([]byte("简体中文文本"))
+	res = Decode(gbk[:len(gbk)-1], true)
+	a.Equal(EncodingGBK, res.Encoding)
+	a.Equal("简体中文文", res.Content)
+}
+
+func TestLanguage(t *testing.T) {
+	a := assert.New(t)
+	a.Equal("go", Language("main.go", ""))
+	a.Equal("dockerfile", Language("Dockerfile", ""))
+	a.Equal("python", Language("run", "#!/usr/bin/env python3\nprint(1)"))
+	a.Equal("shell", Language("run", "#!/bin/bash\n"))
+	a.Equal("", Language("notes.txt", "hello"))
+}
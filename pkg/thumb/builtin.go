@@ -15,6 +15,8 @@ import (
 	"path/filepath"
 	//"github.com/nfnt/resize"
 	"golang.org/x/image/draw"
+	"golang.org/x/image/tiff"
+	"golang.org/x/image/webp"
 )
 
 const thumbTempFolder = "thumb"
@@ -42,6 +44,10 @@ func NewThumbFromFile(file io.Reader, ext string) (*Thumb, error) {
 		img, err = gif.Decode(file)
 	case "png":
 		img, err = png.Decode(file)
+	case "webp":
+		img, err = webp.Decode(file)
+	case "tif", "tiff":
+		img, err = tiff.Decode(file)
 	default:
 		return nil, fmt.Errorf("unknown image format %q: %w", ext, ErrPassThrough)
 	}
@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
@@ -34,7 +37,18 @@ func (f *FfmpegGenerator) Generate(ctx context.Context, es entitysource.EntitySo
 		return nil, fmt.Errorf("unsupported video format: %w", ErrPassThrough)
 	}
 
-	if es.Entity().Size() > f.settings.FFMpegThumbMaxSize(ctx) {
+	maxSize := f.settings.FFMpegThumbMaxSize(ctx)
+	if policy := PolicyFromContext(ctx); policy != nil && policy.Settings != nil {
+		if policy.Settings.VideoThumbDisabled {
+			return nil, fmt.Errorf("disabled by storage policy: %w", ErrPassThrough)
+		}
+
+		if policy.Settings.VideoThumbMaxSize > 0 {
+			maxSize = policy.Settings.VideoThumbMaxSize
+		}
+	}
+
+	if es.Entity().Size() > maxSize {
 		return nil, fmt.Errorf("file is too big: %w", ErrPassThrough)
 	}
 
@@ -65,7 +79,7 @@ func (f *FfmpegGenerator) Generate(ctx context.Context, es entitysource.EntitySo
 	w, h := f.settings.ThumbSize(ctx)
 	scaleOpt := fmt.Sprintf("scale=%d:%d:force_original_aspect_ratio=decrease", w, h)
 	cmd := exec.CommandContext(ctx,
-		f.settings.FFMpegPath(ctx), "-ss", f.settings.FFMpegThumbSeek(ctx), "-i", input,
+		f.settings.FFMpegPath(ctx), "-ss", f.seekPosition(ctx, input), "-i", input,
 		"-vf", scaleOpt, "-vframes", "1", tempOutputPath)
 
 	// Redirect IO
@@ -80,6 +94,55 @@ func (f *FfmpegGenerator) Generate(ctx context.Context, es entitysource.EntitySo
 	return &Result{Path: tempOutputPath}, nil
 }
 
+// seekPosition returns the position of poster frame. Percentage of duration is resolved by probing the
+// video, the first frame is used if the duration is unknown.
+func (f *FfmpegGenerator) seekPosition(ctx context.Context, input string) string {
+	seek := strings.TrimSpace(f.settings.FFMpegThumbSeek(ctx))
+	if !strings.HasSuffix(seek, "%") {
+		return seek
+	}
+
+	percent, err := strconv.ParseFloat(strings.TrimSuffix(seek, "%"), 64)
+	if err != nil || percent < 0 || percent > 100 {
+		f.l.Warning("Invalid ffmpeg thumb seek %q, using the first frame.", seek)
+		return "0"
+	}
+
+	duration, ok := probeDuration(ctx, f.settings.FFMpegPath(ctx), input)
+	if !ok {
+		f.l.Debug("Failed to get video duration from ffmpeg output, using the first frame.")
+		return "0"
+	}
+
+	return strconv.FormatFloat(duration*percent/100, 'f', 3, 64)
+}
+
+// probeDuration returns duration of the media in seconds.
+func probeDuration(ctx context.Context, executable, input string) (float64, bool) {
+	// Without output file, ffmpeg prints stream info including duration and exits with error.
+	var stdErr bytes.Buffer
+	cmd := exec.CommandContext(ctx, executable, "-hide_banner", "-i", input)
+	cmd.Stderr = &stdErr
+	_ = cmd.Run()
+
+	return parseFfmpegDuration(stdErr.String())
+}
+
+var ffmpegDurationRegexp = regexp.MustCompile(`Duration: (\d+):(\d{2}):(\d{2}(?:\.\d+)?)`)
+
+// parseFfmpegDuration parses video duration in seconds from ffmpeg output.
+func parseFfmpegDuration(output string) (float64, bool) {
+	match := ffmpegDurationRegexp.FindStringSubmatch(output)
+	if match == nil {
+		return 0, false
+	}
+
+	hours, _ := strconv.ParseFloat(match[1], 64)
+	minutes, _ := strconv.ParseFloat(match[2], 64)
+	seconds, _ := strconv.ParseFloat(match[3], 64)
+	return hours*3600 + minutes*60 + seconds, true
+}
+
 func (f *FfmpegGenerator) Priority() int {
 	return 200
 }
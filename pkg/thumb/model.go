@@ -0,0 +1,408 @@
+package thumb
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	// modelPitch is the elevation in degrees of the camera looking at the model.
+	modelPitch = 25.0
+	// modelSupersampling renders the model larger than the thumb, smoothing edges once downscaled by next generators.
+	modelSupersampling = 2
+	// modelFill is the portion of the image covered by the bounding sphere of the model.
+	modelFill = 0.92
+	// modelAmbient is the minimum brightness of faces not lit by the light source.
+	modelAmbient = 0.3
+	// modelMaxLineLength is the maximum length of a line in text based model files.
+	modelMaxLineLength = 1024 * 1024
+)
+
+var (
+	ErrModelEmpty = errors.New("no triangle found in 3D model")
+
+	modelBackground = color.NRGBA{R: 0xf5, G: 0xf5, B: 0xf5, A: 0xff}
+	modelColor      = [3]float64{0x5c, 0x8d, 0xd6}
+	// modelLight is the direction towards the light source in camera space, from upper left front.
+	modelLight = normalizeVec([3]float64{-0.6, 1, 0.5})
+)
+
+// ModelTriangle is the three vertices of a triangle.
+type ModelTriangle [3][3]float32
+
+func NewModelGenerator(l logging.Logger, settings setting.Provider) *ModelGenerator {
+	return &ModelGenerator{l: l, settings: settings}
+}
+
+// ModelGenerator renders STL and OBJ models from a turntable angle with a software rasterizer.
+type ModelGenerator struct {
+	l        logging.Logger
+	settings setting.Provider
+}
+
+func (m *ModelGenerator) Generate(ctx context.Context, es entitysource.EntitySource, ext string, previous *Result) (*Result, error) {
+	if !util.IsInExtensionListExt(m.settings.ModelThumbExts(ctx), ext) {
+		return nil, fmt.Errorf("unsupported model format: %w", ErrPassThrough)
+	}
+
+	if es.Entity().Size() > m.settings.ModelThumbMaxSize(ctx) {
+		return nil, fmt.Errorf("file is too big: %w", ErrPassThrough)
+	}
+
+	triangles, yUp, err := LoadModelTriangles(es, ext, es.Entity().Size())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load model: %w", err)
+	}
+
+	w, h := m.settings.ThumbSize(ctx)
+	img := RenderModel(triangles, yUp, float64(m.settings.ModelThumbAngle(ctx)),
+		w*modelSupersampling, h*modelSupersampling)
+
+	tempPath := filepath.Join(
+		util.DataPath(m.settings.TempPath(ctx)),
+		thumbTempFolder,
+		fmt.Sprintf("thumb_%s.png", uuid.Must(uuid.NewV4()).String()),
+	)
+
+	output, err := util.CreatNestedFile(tempPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp file: %w", err)
+	}
+
+	defer output.Close()
+	if err := png.Encode(output, img); err != nil {
+		return &Result{Path: tempPath}, fmt.Errorf("failed to encode model render: %w", err)
+	}
+
+	return &Result{
+		Path:     tempPath,
+		Continue: true,
+		Cleanup:  []func(){func() { _ = os.Remove(tempPath) }},
+	}, nil
+}
+
+func (m *ModelGenerator) Priority() int {
+	return 35
+}
+
+func (m *ModelGenerator) Enabled(ctx context.Context) bool {
+	return m.settings.ModelThumbGeneratorEnabled(ctx)
+}
+
+// LoadModelTriangles reads triangles of STL or OBJ models, size is the total size of the file. yUp reports
+// whether the format conventionally uses Y as the up axis rather than Z.
+func LoadModelTriangles(r io.Reader, ext string, size int64) ([]ModelTriangle, bool, error) {
+	var (
+		triangles []ModelTriangle
+		yUp       bool
+		err       error
+	)
+
+	switch ext {
+	case "stl":
+		triangles, err = loadStlTriangles(r, size)
+	case "obj":
+		yUp = true
+		triangles, err = loadObjTriangles(r)
+	default:
+		err = fmt.Errorf("unsupported model format %q", ext)
+	}
+
+	if err == nil && len(triangles) == 0 {
+		err = ErrModelEmpty
+	}
+
+	return triangles, yUp, err
+}
+
+func loadStlTriangles(r io.Reader, size int64) ([]ModelTriangle, error) {
+	br := bufio.NewReader(r)
+	header, err := br.Peek(84)
+	if err == nil {
+		count := int64(binary.LittleEndian.Uint32(header[80:]))
+		if 84+count*50 == size {
+			if _, err := br.Discard(84); err != nil {
+				return nil, err
+			}
+
+			triangles := make([]ModelTriangle, count)
+			buf := make([]byte, 50)
+			for i := range triangles {
+				if _, err := io.ReadFull(br, buf); err != nil {
+					return nil, fmt.Errorf("truncated triangle %d: %w", i, err)
+				}
+
+				// Normal vector is skipped, it is recalculated from vertices.
+				for v := 0; v < 3; v++ {
+					for j := 0; j < 3; j++ {
+						triangles[i][v][j] = math.Float32frombits(binary.LittleEndian.Uint32(buf[12+v*12+j*4:]))
+					}
+				}
+			}
+
+			return triangles, nil
+		}
+	}
+
+	var (
+		triangles []ModelTriangle
+		current   ModelTriangle
+		vertex    int
+	)
+	err = scanModelFields(br, func(fields []string) error {
+		if fields[0] != "vertex" {
+			return nil
+		}
+
+		p, err := parseModelVertex(fields[1:])
+		if err != nil {
+			return err
+		}
+
+		current[vertex] = p
+		vertex++
+		if vertex == 3 {
+			triangles = append(triangles, current)
+			vertex = 0
+		}
+
+		return nil
+	})
+
+	return triangles, err
+}
+
+func loadObjTriangles(r io.Reader) ([]ModelTriangle, error) {
+	var (
+		vertices  [][3]float32
+		triangles []ModelTriangle
+	)
+
+	err := scanModelFields(r, func(fields []string) error {
+		switch fields[0] {
+		case "v":
+			p, err := parseModelVertex(fields[1:])
+			if err != nil {
+				return err
+			}
+			vertices = append(vertices, p)
+		case "f":
+			// Faces reference vertices as "v", "v/vt", "v//vn" or "v/vt/vn", negative indices are relative to
+			// the end of vertices defined so far.
+			indices := make([]int, 0, len(fields)-1)
+			for _, field := range fields[1:] {
+				ref, _, _ := strings.Cut(field, "/")
+				i, err := strconv.Atoi(ref)
+				if err != nil {
+					return fmt.Errorf("invalid face vertex %q: %w", field, err)
+				}
+
+				if i < 0 {
+					i += len(vertices)
+				} else {
+					i--
+				}
+
+				if i < 0 || i >= len(vertices) {
+					return fmt.Errorf("face vertex %q out of range", field)
+				}
+
+				indices = append(indices, i)
+			}
+
+			// Polygons are triangulated as fans.
+			for i := 2; i < len(indices); i++ {
+				triangles = append(triangles, ModelTriangle{
+					vertices[indices[0]], vertices[indices[i-1]], vertices[indices[i]],
+				})
+			}
+		}
+
+		return nil
+	})
+
+	return triangles, err
+}
+
+func scanModelFields(r io.Reader, handle func(fields []string) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), modelMaxLineLength)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		if err := handle(fields); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+func parseModelVertex(fields []string) ([3]float32, error) {
+	var p [3]float32
+	if len(fields) < 3 {
+		return p, fmt.Errorf("expect 3 coordinates, got %d", len(fields))
+	}
+
+	for j := 0; j < 3; j++ {
+		v, err := strconv.ParseFloat(fields[j], 32)
+		if err != nil {
+			return p, err
+		}
+		p[j] = float32(v)
+	}
+
+	return p, nil
+}
+
+// RenderModel rasterizes triangles with flat shading into an image of given size. The model is centered and
+// rotated around its up axis by angle degrees, then viewed from slightly above with orthographic projection.
+func RenderModel(triangles []ModelTriangle, yUp bool, angle float64, width, height int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = modelBackground.R, modelBackground.G,
+			modelBackground.B, modelBackground.A
+	}
+
+	minP := [3]float64{math.Inf(1), math.Inf(1), math.Inf(1)}
+	maxP := [3]float64{math.Inf(-1), math.Inf(-1), math.Inf(-1)}
+	for _, t := range triangles {
+		for _, v := range t {
+			for j := 0; j < 3; j++ {
+				minP[j] = math.Min(minP[j], float64(v[j]))
+				maxP[j] = math.Max(maxP[j], float64(v[j]))
+			}
+		}
+	}
+
+	var center [3]float64
+	radius := 0.0
+	for j := 0; j < 3; j++ {
+		center[j] = (minP[j] + maxP[j]) / 2
+		radius += (maxP[j] - minP[j]) * (maxP[j] - minP[j])
+	}
+
+	radius = math.Sqrt(radius) / 2
+	if radius == 0 || math.IsNaN(radius) || math.IsInf(radius, 0) {
+		return img
+	}
+
+	scale := modelFill * float64(min(width, height)) / (2 * radius)
+	yaw, pitch := angle*math.Pi/180, modelPitch*math.Pi/180
+	sinYaw, cosYaw := math.Sincos(yaw)
+	sinPitch, cosPitch := math.Sincos(pitch)
+
+	// project converts a vertex into screen coordinates, with depth increasing towards the camera.
+	project := func(v [3]float32) [3]float64 {
+		x, y, z := float64(v[0])-center[0], float64(v[1])-center[1], float64(v[2])-center[2]
+		if !yUp {
+			y, z = z, -y
+		}
+
+		x, z = x*cosYaw+z*sinYaw, -x*sinYaw+z*cosYaw
+		y, z = y*cosPitch-z*sinPitch, y*sinPitch+z*cosPitch
+		return [3]float64{float64(width)/2 + x*scale, float64(height)/2 - y*scale, z * scale}
+	}
+
+	depth := make([]float64, width*height)
+	for i := range depth {
+		depth[i] = math.Inf(-1)
+	}
+
+	for _, t := range triangles {
+		a, b, c := project(t[0]), project(t[1]), project(t[2])
+
+		// Normal in camera space, with screen Y flipped back to point upwards.
+		normal := normalizeVec(crossVec(
+			[3]float64{b[0] - a[0], a[1] - b[1], b[2] - a[2]},
+			[3]float64{c[0] - a[0], a[1] - c[1], c[2] - a[2]},
+		))
+		brightness := modelAmbient + (1-modelAmbient)*math.Abs(dotVec(normal, modelLight))
+		shade := color.NRGBA{
+			R: uint8(modelColor[0] * brightness),
+			G: uint8(modelColor[1] * brightness),
+			B: uint8(modelColor[2] * brightness),
+			A: 0xff,
+		}
+
+		rasterizeTriangle(img, depth, a, b, c, shade)
+	}
+
+	return img
+}
+
+func rasterizeTriangle(img *image.NRGBA, depth []float64, a, b, c [3]float64, shade color.NRGBA) {
+	area := edge(a, b, c[0], c[1])
+	if area == 0 || math.IsNaN(area) {
+		return
+	}
+
+	bounds := img.Bounds()
+	minX := max(int(math.Floor(math.Min(a[0], math.Min(b[0], c[0])))), bounds.Min.X)
+	maxX := min(int(math.Ceil(math.Max(a[0], math.Max(b[0], c[0])))), bounds.Max.X-1)
+	minY := max(int(math.Floor(math.Min(a[1], math.Min(b[1], c[1])))), bounds.Min.Y)
+	maxY := min(int(math.Ceil(math.Max(a[1], math.Max(b[1], c[1])))), bounds.Max.Y-1)
+
+	for y := minY; y <= maxY; y++ {
+		py := float64(y) + 0.5
+		for x := minX; x <= maxX; x++ {
+			px := float64(x) + 0.5
+			w0, w1, w2 := edge(b, c, px, py)/area, edge(c, a, px, py)/area, edge(a, b, px, py)/area
+			if w0 < 0 || w1 < 0 || w2 < 0 {
+				continue
+			}
+
+			z := w0*a[2] + w1*b[2] + w2*c[2]
+			i := y*bounds.Dx() + x
+			if z <= depth[i] {
+				continue
+			}
+
+			depth[i] = z
+			img.SetNRGBA(x, y, shade)
+		}
+	}
+}
+
+// edge returns twice the signed area of triangle (a, b, p).
+func edge(a, b [3]float64, px, py float64) float64 {
+	return (b[0]-a[0])*(py-a[1]) - (b[1]-a[1])*(px-a[0])
+}
+
+func crossVec(a, b [3]float64) [3]float64 {
+	return [3]float64{a[1]*b[2] - a[2]*b[1], a[2]*b[0] - a[0]*b[2], a[0]*b[1] - a[1]*b[0]}
+}
+
+func dotVec(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+func normalizeVec(v [3]float64) [3]float64 {
+	l := math.Sqrt(dotVec(v, v))
+	if l == 0 {
+		return v
+	}
+
+	return [3]float64{v[0] / l, v[1] / l, v[2] / l}
+}
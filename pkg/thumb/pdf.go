@@ -0,0 +1,108 @@
+package thumb
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+)
+
+var ErrPdfPageNotExist = errors.New("page does not exist in PDF")
+
+// RenderPdfPage renders a page (starting from 1) of the PDF read from r with pdftoppm, its longer side is scaled
+// to size. The image is written to dst, whose extension decides the format, either ".jpg" or ".png".
+func RenderPdfPage(ctx context.Context, executable string, r io.Reader, page, size, quality int, dst string) error {
+	format := []string{"-jpeg", "-jpegopt", fmt.Sprintf("quality=%d", quality)}
+	if filepath.Ext(dst) == ".png" {
+		format = []string{"-png"}
+	}
+
+	// pdftoppm appends extension to the output root in single file mode.
+	args := append([]string{
+		"-f", strconv.Itoa(page), "-l", strconv.Itoa(page), "-singlefile", "-scale-to", strconv.Itoa(size),
+	}, format...)
+	args = append(args, "-", strings.TrimSuffix(dst, filepath.Ext(dst)))
+	cmd := exec.CommandContext(ctx, executable, args...)
+
+	var stdErr bytes.Buffer
+	cmd.Stdin = r
+	cmd.Stderr = &stdErr
+	if err := cmd.Run(); err != nil {
+		if strings.Contains(stdErr.String(), "Wrong page range") {
+			return ErrPdfPageNotExist
+		}
+
+		return fmt.Errorf("failed to invoke pdftoppm: %w, raw output: %s", err, stdErr.String())
+	}
+
+	return nil
+}
+
+func NewPdfGenerator(l logging.Logger, settings setting.Provider) *PdfGenerator {
+	return &PdfGenerator{l: l, settings: settings}
+}
+
+// PdfGenerator renders the first page of PDF files with pdftoppm.
+type PdfGenerator struct {
+	l        logging.Logger
+	settings setting.Provider
+}
+
+func (p *PdfGenerator) Generate(ctx context.Context, es entitysource.EntitySource, ext string, previous *Result) (*Result, error) {
+	if !util.IsInExtensionListExt(p.settings.PdfThumbExts(ctx), ext) {
+		return nil, fmt.Errorf("unsupported document format: %w", ErrPassThrough)
+	}
+
+	if es.Entity().Size() > p.settings.PdfThumbMaxSize(ctx) {
+		return nil, fmt.Errorf("file is too big: %w", ErrPassThrough)
+	}
+
+	encode := p.settings.ThumbEncode(ctx)
+	outputExt := ".jpg"
+	if encode.Format == "png" {
+		outputExt = ".png"
+	}
+
+	tempPath := filepath.Join(
+		util.DataPath(p.settings.TempPath(ctx)),
+		thumbTempFolder,
+		fmt.Sprintf("thumb_%s%s", uuid.Must(uuid.NewV4()).String(), outputExt),
+	)
+
+	if err := util.CreatNestedFolder(filepath.Dir(tempPath)); err != nil {
+		return nil, fmt.Errorf("failed to create temp folder: %w", err)
+	}
+
+	// Downscaling to fit the thumb size is left to next generators.
+	w, h := p.settings.ThumbSize(ctx)
+	if err := RenderPdfPage(ctx, p.settings.PdfToPpmPath(ctx), es, 1, max(w, h), encode.Quality, tempPath); err != nil {
+		p.l.Warning("Failed to render first page of PDF: %s", err)
+		return &Result{Path: tempPath}, fmt.Errorf("failed to render PDF: %w", err)
+	}
+
+	return &Result{
+		Path:     tempPath,
+		Continue: true,
+		Cleanup:  []func(){func() { _ = os.Remove(tempPath) }},
+	}, nil
+}
+
+func (p *PdfGenerator) Priority() int {
+	return 45
+}
+
+func (p *PdfGenerator) Enabled(ctx context.Context) bool {
+	return p.settings.PdfThumbGeneratorEnabled(ctx)
+}
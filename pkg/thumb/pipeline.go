@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
@@ -49,6 +50,19 @@ var (
 	ErrNotAvailable = fmt.Errorf("thumbnail not available: %w", ErrPassThrough)
 )
 
+type policyCtx struct{}
+
+// WithPolicy attaches the storage policy of source file to context, generators honor its settings.
+func WithPolicy(ctx context.Context, policy *ent.StoragePolicy) context.Context {
+	return context.WithValue(ctx, policyCtx{}, policy)
+}
+
+// PolicyFromContext returns the storage policy of source file, nil if not attached.
+func PolicyFromContext(ctx context.Context) *ent.StoragePolicy {
+	policy, _ := ctx.Value(policyCtx{}).(*ent.StoragePolicy)
+	return policy
+}
+
 func (g generatorList) Len() int {
 	return len(g)
 }
@@ -71,6 +85,9 @@ func NewPipeline(settings setting.Provider, l logging.Logger) Generator {
 		NewVipsGenerator(l, settings),
 		NewLibreOfficeGenerator(l, settings),
 		NewMusicCoverGenerator(l, settings),
+		NewRawGenerator(l, settings),
+		NewPdfGenerator(l, settings),
+		NewModelGenerator(l, settings),
 	)
 	sort.Sort(generators)
 
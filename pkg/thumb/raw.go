@@ -0,0 +1,339 @@
+package thumb
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image/jpeg"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gofrs/uuid"
+)
+
+const (
+	// rawMaxIFDs limits the number of IFDs visited in a TIFF based RAW file, preventing loops in malformed files.
+	rawMaxIFDs = 64
+	// rawMaxIFDEntries limits the number of entries read from a single IFD.
+	rawMaxIFDEntries = 1024
+
+	tiffTagCompression      = 0x0103
+	tiffTagStripOffsets     = 0x0111
+	tiffTagStripByteCounts  = 0x0117
+	tiffTagSubIFDs          = 0x014a
+	tiffTagJpegOffset       = 0x0201
+	tiffTagJpegLength       = 0x0202
+	tiffTagExifIFD          = 0x8769
+	tiffCompressionJpeg     = 6
+	tiffCompressionJpegDNG  = 7
+	cr3PreviewHeaderSize    = 16
+	isoBoxHeaderSize        = 8
+	isoBoxLargeHeaderSize   = 16
+	cr3PreviewSearchMaxSize = 1 << 10
+)
+
+var (
+	ErrRawPreviewNotFound = errors.New("no embedded preview found in RAW file")
+
+	// cr3PreviewUUID is the UUID of the box holding the full size JPEG preview in CR3 files.
+	cr3PreviewUUID = []byte{0xea, 0xf4, 0x2b, 0x5e, 0x1c, 0x98, 0x4b, 0x88, 0xb9, 0xfb, 0xb7, 0xdc, 0x40, 0x6e, 0x4d, 0x16}
+)
+
+// RawPreview is an embedded JPEG preview in RAW file.
+type RawPreview struct {
+	Offset int64
+	Length int64
+	Width  int
+	Height int
+}
+
+// FindRawPreview locates the largest embedded JPEG preview in a RAW file. TIFF based formats (CR2, NEF, ARW,
+// DNG, etc.) and CR3 are supported.
+func FindRawPreview(r io.ReaderAt, size int64) (*RawPreview, error) {
+	header := make([]byte, 12)
+	if _, err := r.ReadAt(header, 0); err != nil {
+		return nil, fmt.Errorf("failed to read RAW header: %w", err)
+	}
+
+	var candidates []RawPreview
+	switch {
+	case bytes.Equal(header[:2], []byte("II")):
+		candidates = tiffJpegCandidates(r, size, binary.LittleEndian)
+	case bytes.Equal(header[:2], []byte("MM")):
+		candidates = tiffJpegCandidates(r, size, binary.BigEndian)
+	case bytes.Equal(header[4:12], []byte("ftypcrx ")):
+		candidates = cr3JpegCandidates(r, size)
+	default:
+		return nil, fmt.Errorf("unknown RAW format: %w", ErrRawPreviewNotFound)
+	}
+
+	var best *RawPreview
+	for i := range candidates {
+		c := &candidates[i]
+		if !validateJpeg(r, c) {
+			continue
+		}
+
+		if best == nil || c.Width*c.Height > best.Width*best.Height ||
+			c.Width*c.Height == best.Width*best.Height && c.Length > best.Length {
+			best = c
+		}
+	}
+
+	if best == nil {
+		return nil, ErrRawPreviewNotFound
+	}
+
+	return best, nil
+}
+
+// validateJpeg checks if the candidate is a JPEG image that can be decoded, lossless JPEG used by some RAW
+// formats for sensor data is rejected.
+func validateJpeg(r io.ReaderAt, c *RawPreview) bool {
+	cfg, err := jpeg.DecodeConfig(io.NewSectionReader(r, c.Offset, c.Length))
+	if err != nil {
+		return false
+	}
+
+	c.Width, c.Height = cfg.Width, cfg.Height
+	return true
+}
+
+// tiffJpegCandidates walks IFDs of a TIFF based RAW file, collecting JPEG streams referenced by them.
+func tiffJpegCandidates(r io.ReaderAt, size int64, order binary.ByteOrder) []RawPreview {
+	var (
+		candidates []RawPreview
+		buf        = make([]byte, 12)
+		visited    = make(map[int64]bool)
+		queue      []int64
+	)
+
+	if _, err := r.ReadAt(buf[:8], 0); err != nil {
+		return nil
+	}
+	queue = append(queue, int64(order.Uint32(buf[4:8])))
+
+	for len(queue) > 0 && len(visited) < rawMaxIFDs {
+		offset := queue[0]
+		queue = queue[1:]
+		if offset <= 0 || offset >= size || visited[offset] {
+			continue
+		}
+		visited[offset] = true
+
+		if _, err := r.ReadAt(buf[:2], offset); err != nil {
+			continue
+		}
+
+		entries := int(order.Uint16(buf[:2]))
+		if entries > rawMaxIFDEntries {
+			continue
+		}
+
+		var (
+			compression, jpegOffset, jpegLength int64
+			stripOffsets, stripByteCounts       []int64
+		)
+		for i := 0; i < entries; i++ {
+			if _, err := r.ReadAt(buf, offset+2+int64(i)*12); err != nil {
+				break
+			}
+
+			tag := order.Uint16(buf[0:2])
+			switch tag {
+			case tiffTagCompression:
+				compression = firstOf(tiffValues(r, order, buf))
+			case tiffTagStripOffsets:
+				stripOffsets = tiffValues(r, order, buf)
+			case tiffTagStripByteCounts:
+				stripByteCounts = tiffValues(r, order, buf)
+			case tiffTagJpegOffset:
+				jpegOffset = firstOf(tiffValues(r, order, buf))
+			case tiffTagJpegLength:
+				jpegLength = firstOf(tiffValues(r, order, buf))
+			case tiffTagSubIFDs, tiffTagExifIFD:
+				queue = append(queue, tiffValues(r, order, buf)...)
+			}
+		}
+
+		if jpegOffset > 0 && jpegLength > 0 && jpegOffset+jpegLength <= size {
+			candidates = append(candidates, RawPreview{Offset: jpegOffset, Length: jpegLength})
+		}
+
+		if (compression == tiffCompressionJpeg || compression == tiffCompressionJpegDNG) &&
+			len(stripOffsets) == 1 && len(stripByteCounts) == 1 && stripOffsets[0]+stripByteCounts[0] <= size {
+			candidates = append(candidates, RawPreview{Offset: stripOffsets[0], Length: stripByteCounts[0]})
+		}
+
+		// Offset of next IFD follows the entries.
+		if _, err := r.ReadAt(buf[:4], offset+2+int64(entries)*12); err == nil {
+			queue = append(queue, int64(order.Uint32(buf[:4])))
+		}
+	}
+
+	return candidates
+}
+
+// tiffValues reads values of a SHORT, LONG or IFD typed IFD entry.
+func tiffValues(r io.ReaderAt, order binary.ByteOrder, entry []byte) []int64 {
+	var width int
+	switch order.Uint16(entry[2:4]) {
+	case 3: // SHORT
+		width = 2
+	case 4, 13: // LONG, IFD
+		width = 4
+	default:
+		return nil
+	}
+
+	count := int(order.Uint32(entry[4:8]))
+	if count <= 0 || count > rawMaxIFDEntries {
+		return nil
+	}
+
+	data := entry[8:12]
+	if count*width > 4 {
+		data = make([]byte, count*width)
+		if _, err := r.ReadAt(data, int64(order.Uint32(entry[8:12]))); err != nil {
+			return nil
+		}
+	}
+
+	values := make([]int64, count)
+	for i := range values {
+		if width == 2 {
+			values[i] = int64(order.Uint16(data[i*2:]))
+		} else {
+			values[i] = int64(order.Uint32(data[i*4:]))
+		}
+	}
+
+	return values
+}
+
+func firstOf(values []int64) int64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	return values[0]
+}
+
+// cr3JpegCandidates finds the PRVW box in a CR3 file, which holds the full size JPEG preview.
+func cr3JpegCandidates(r io.ReaderAt, size int64) []RawPreview {
+	header := make([]byte, isoBoxLargeHeaderSize+len(cr3PreviewUUID))
+	for offset := int64(0); offset+isoBoxHeaderSize <= size; {
+		if _, err := r.ReadAt(header[:isoBoxHeaderSize], offset); err != nil {
+			return nil
+		}
+
+		boxSize := int64(binary.BigEndian.Uint32(header[0:4]))
+		headerSize := int64(isoBoxHeaderSize)
+		switch boxSize {
+		case 0:
+			boxSize = size - offset
+		case 1:
+			if _, err := r.ReadAt(header[:isoBoxLargeHeaderSize], offset); err != nil {
+				return nil
+			}
+			boxSize = int64(binary.BigEndian.Uint64(header[8:16]))
+			headerSize = isoBoxLargeHeaderSize
+		}
+
+		if boxSize < headerSize {
+			return nil
+		}
+
+		if string(header[4:8]) == "uuid" {
+			id := make([]byte, len(cr3PreviewUUID))
+			if _, err := r.ReadAt(id, offset+headerSize); err == nil && bytes.Equal(id, cr3PreviewUUID) {
+				return cr3PreviewInBox(r, size, offset+headerSize+int64(len(id)))
+			}
+		}
+
+		offset += boxSize
+	}
+
+	return nil
+}
+
+// cr3PreviewInBox reads the JPEG preview following the PRVW tag, which is located near the start of the box.
+func cr3PreviewInBox(r io.ReaderAt, size, start int64) []RawPreview {
+	buf := make([]byte, cr3PreviewSearchMaxSize)
+	n, _ := r.ReadAt(buf, start)
+	pos := bytes.Index(buf[:n], []byte("PRVW"))
+	if pos < 0 || pos+cr3PreviewHeaderSize+4 > n {
+		return nil
+	}
+
+	length := int64(binary.BigEndian.Uint32(buf[pos+cr3PreviewHeaderSize:]))
+	offset := start + int64(pos) + cr3PreviewHeaderSize + 4
+	if length <= 0 || offset+length > size {
+		return nil
+	}
+
+	return []RawPreview{{Offset: offset, Length: length}}
+}
+
+func NewRawGenerator(l logging.Logger, settings setting.Provider) *RawGenerator {
+	return &RawGenerator{l: l, settings: settings}
+}
+
+// RawGenerator extracts the embedded JPEG preview of RAW photos, leaving the downscaling to next generators.
+type RawGenerator struct {
+	l        logging.Logger
+	settings setting.Provider
+}
+
+func (v *RawGenerator) Generate(ctx context.Context, es entitysource.EntitySource, ext string, previous *Result) (*Result, error) {
+	if !util.IsInExtensionListExt(v.settings.RawThumbExts(ctx), ext) {
+		return nil, fmt.Errorf("unsupported RAW format: %w", ErrPassThrough)
+	}
+
+	if es.Entity().Size() > v.settings.RawThumbMaxSize(ctx) {
+		return nil, fmt.Errorf("file is too big: %w", ErrPassThrough)
+	}
+
+	preview, err := FindRawPreview(es, es.Entity().Size())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find RAW preview: %w (%w)", err, ErrPassThrough)
+	}
+
+	tempPath := filepath.Join(
+		util.DataPath(v.settings.TempPath(ctx)),
+		thumbTempFolder,
+		fmt.Sprintf("thumb_%s.jpg", uuid.Must(uuid.NewV4()).String()),
+	)
+
+	thumbFile, err := util.CreatNestedFile(tempPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp file: %w", err)
+	}
+
+	defer thumbFile.Close()
+
+	if _, err := io.Copy(thumbFile, io.NewSectionReader(es, preview.Offset, preview.Length)); err != nil {
+		return &Result{Path: tempPath}, fmt.Errorf("failed to write RAW preview to file: %w", err)
+	}
+
+	return &Result{
+		Path:     tempPath,
+		Continue: true,
+		Cleanup:  []func(){func() { _ = os.Remove(tempPath) }},
+	}, nil
+}
+
+func (v *RawGenerator) Priority() int {
+	return 40
+}
+
+func (v *RawGenerator) Enabled(ctx context.Context) bool {
+	return v.settings.RawThumbGeneratorEnabled(ctx)
+}
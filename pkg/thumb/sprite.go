@@ -0,0 +1,80 @@
+package thumb
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os/exec"
+	"strconv"
+)
+
+const (
+	// spriteMinInterval is the minimum interval in seconds between sampled frames, short videos get fewer frames.
+	spriteMinInterval = 1.0
+	// spriteJpegQScale is the JPEG quality scale of ffmpeg, from 2 (best) to 31 (worst).
+	spriteJpegQScale = 5
+)
+
+var ErrUnknownDuration = errors.New("failed to get video duration")
+
+// SpriteSheet is the layout of video frames tiled into a single image, used by clients to show scrub
+// previews on hover. Frame i is at column i % Columns, row i / Columns, sampled at i * Interval seconds.
+type SpriteSheet struct {
+	// Duration of the video in seconds.
+	Duration float64 `json:"duration"`
+	// Interval between sampled frames in seconds.
+	Interval    float64 `json:"interval"`
+	Frames      int     `json:"frames"`
+	Columns     int     `json:"columns"`
+	Rows        int     `json:"rows"`
+	FrameWidth  int     `json:"frame_width"`
+	FrameHeight int     `json:"frame_height"`
+}
+
+// NewSpriteSheet plans the layout of at most `frames` frames evenly sampled from a video of given duration.
+func NewSpriteSheet(duration float64, frames, columns, width, height int) *SpriteSheet {
+	frames = max(frames, 1)
+	interval := duration / float64(frames)
+	if interval < spriteMinInterval {
+		interval = spriteMinInterval
+		frames = max(int(math.Ceil(duration/spriteMinInterval)), 1)
+	}
+
+	columns = min(max(columns, 1), frames)
+	return &SpriteSheet{
+		Duration:    duration,
+		Interval:    interval,
+		Frames:      frames,
+		Columns:     columns,
+		Rows:        (frames + columns - 1) / columns,
+		FrameWidth:  width,
+		FrameHeight: height,
+	}
+}
+
+// GenerateSpriteSheet samples frames of the video from input (a local path or URL) with ffmpeg, tiling them
+// into a JPEG image at output. Only key frames are decoded, so that long videos are sampled quickly.
+func GenerateSpriteSheet(ctx context.Context, executable, input, output string, frames, columns, width, height int) (*SpriteSheet, error) {
+	duration, ok := probeDuration(ctx, executable, input)
+	if !ok || duration <= 0 {
+		return nil, ErrUnknownDuration
+	}
+
+	sheet := NewSpriteSheet(duration, frames, columns, width, height)
+	filter := fmt.Sprintf(
+		"fps=1/%s,scale=%d:%d:force_original_aspect_ratio=decrease,pad=%d:%d:(ow-iw)/2:(oh-ih)/2,setsar=1,tile=%dx%d",
+		strconv.FormatFloat(sheet.Interval, 'f', 6, 64), width, height, width, height, sheet.Columns, sheet.Rows,
+	)
+
+	cmd := exec.CommandContext(ctx, executable, "-hide_banner", "-nostdin", "-skip_frame", "nokey", "-i", input,
+		"-an", "-sn", "-vf", filter, "-frames:v", "1", "-q:v", strconv.Itoa(spriteJpegQScale), "-y", output)
+	var stdErr bytes.Buffer
+	cmd.Stderr = &stdErr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("failed to invoke ffmpeg: %w, raw output: %s", err, stdErr.String())
+	}
+
+	return sheet, nil
+}
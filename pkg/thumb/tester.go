@@ -25,6 +25,8 @@ func TestGenerator(ctx context.Context, name, executable string) (string, error)
 		return testLibreOfficeGenerator(ctx, executable)
 	case "ffprobe":
 		return testFFProbeGenerator(ctx, executable)
+	case "pdftoppm":
+		return testPdfToPpmGenerator(ctx, executable)
 	default:
 		return "", ErrUnknownGenerator
 	}
@@ -89,3 +91,19 @@ func testLibreOfficeGenerator(ctx context.Context, executable string) (string, e
 
 	return output.String(), nil
 }
+
+func testPdfToPpmGenerator(ctx context.Context, executable string) (string, error) {
+	cmd := exec.CommandContext(ctx, executable, "-v")
+	var output bytes.Buffer
+	// pdftoppm prints version info to stderr.
+	cmd.Stderr = &output
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("failed to invoke pdftoppm executable: %w", err)
+	}
+
+	if !strings.Contains(output.String(), "pdftoppm") {
+		return "", ErrUnknownOutput
+	}
+
+	return output.String(), nil
+}
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strconv"
+	"sync"
 
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
@@ -25,11 +26,17 @@ func NewVipsGenerator(l logging.Logger, settings setting.Provider) *VipsGenerato
 type VipsGenerator struct {
 	l        logging.Logger
 	settings setting.Provider
+	// available caches whether vips executable is found, keyed by configured path.
+	available sync.Map
 }
 
 func (v *VipsGenerator) Generate(ctx context.Context, es entitysource.EntitySource, ext string, previous *Result) (*Result, error) {
 	if !util.IsInExtensionListExt(v.settings.VipsThumbExts(ctx), ext) {
-		return nil, fmt.Errorf("unsupported video format: %w", ErrPassThrough)
+		return nil, fmt.Errorf("unsupported image format: %w", ErrPassThrough)
+	}
+
+	if !v.executableFound(ctx) {
+		return nil, fmt.Errorf("vips executable not found: %w", ErrPassThrough)
 	}
 
 	if es.Entity().Size() > v.settings.VipsThumbMaxSize(ctx) {
@@ -105,12 +112,36 @@ func (v *VipsGenerator) Generate(ctx context.Context, es entitysource.EntitySour
 
 	if err := cmd.Run(); err != nil {
 		v.l.Warning("Failed to invoke vips: %s", vipsErr.String())
-		return &Result{Path: tempPath}, fmt.Errorf("failed to invoke vips: %w, raw output: %s", err, vipsErr.String())
+		if ctx.Err() != nil {
+			return &Result{Path: tempPath}, fmt.Errorf("failed to invoke vips: %w, raw output: %s", err, vipsErr.String())
+		}
+
+		// Vips might be built without loader of this format, leave it to the pure-Go generator.
+		thumbFile.Close()
+		_ = os.Remove(tempPath)
+		return nil, fmt.Errorf("failed to invoke vips: %s, raw output: %s (%w)", err, vipsErr.String(), ErrPassThrough)
 	}
 
 	return &Result{Path: tempPath}, nil
 }
 
+// executableFound returns whether vips executable exists, so that thumbnails fall back to the pure-Go
+// generator when libvips is not installed. The result is cached until the configured path is changed.
+func (v *VipsGenerator) executableFound(ctx context.Context) bool {
+	path := v.settings.VipsPath(ctx)
+	if found, ok := v.available.Load(path); ok {
+		return found.(bool)
+	}
+
+	_, err := exec.LookPath(path)
+	if err != nil {
+		v.l.Warning("Vips executable %q not found, falling back to other thumb generators: %s", path, err)
+	}
+
+	v.available.Store(path, err == nil)
+	return err == nil
+}
+
 func (v *VipsGenerator) Priority() int {
 	return 100
 }
@@ -0,0 +1,117 @@
+package thumb
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os/exec"
+)
+
+const (
+	// waveformSampleRate is the sample rate audio is decoded at, high enough for a scrubber waveform.
+	waveformSampleRate = 8000
+	// waveformBlockSize is the number of samples folded into one block while decoding, 10ms per block.
+	waveformBlockSize = waveformSampleRate / 100
+)
+
+// Waveform is the peak data of an audio file, used by the web player to draw a scrubber.
+type Waveform struct {
+	// Duration of the audio in seconds.
+	Duration float64 `json:"duration"`
+	// Peaks are normalized absolute peak amplitudes in [0, 1], evenly distributed over the duration.
+	Peaks []float64 `json:"peaks"`
+}
+
+// ComputeWaveform decodes the audio from input (a local path or URL) into mono PCM with ffmpeg, reducing it
+// into at most `peaks` peak values.
+func ComputeWaveform(ctx context.Context, executable, input string, peaks int) (*Waveform, error) {
+	cmd := exec.CommandContext(ctx, executable, "-hide_banner", "-nostdin", "-i", input,
+		"-vn", "-ac", "1", "-ar", fmt.Sprint(waveformSampleRate), "-f", "s16le", "-acodec", "pcm_s16le", "pipe:1")
+
+	var stdErr bytes.Buffer
+	cmd.Stderr = &stdErr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stdout pipe: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start ffmpeg: %w", err)
+	}
+
+	// Fold samples into blocks while decoding, so that memory usage does not grow with sample rate.
+	var (
+		blocks  []uint16
+		samples int
+		peak    uint16
+		buf     = make([]byte, 2)
+		reader  = bufio.NewReader(stdout)
+	)
+	for {
+		if _, err := io.ReadFull(reader, buf); err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+				_ = cmd.Wait()
+				return nil, fmt.Errorf("failed to read decoded audio: %w", err)
+			}
+			break
+		}
+
+		sample := int16(binary.LittleEndian.Uint16(buf))
+		peak = max(peak, absSample(sample))
+		samples++
+		if samples%waveformBlockSize == 0 {
+			blocks = append(blocks, peak)
+			peak = 0
+		}
+	}
+
+	if samples%waveformBlockSize != 0 {
+		blocks = append(blocks, peak)
+	}
+
+	if err := cmd.Wait(); err != nil {
+		return nil, fmt.Errorf("failed to invoke ffmpeg: %w, raw output: %s", err, stdErr.String())
+	}
+
+	if samples == 0 {
+		return nil, errors.New("no audio stream decoded")
+	}
+
+	return &Waveform{
+		Duration: float64(samples) / waveformSampleRate,
+		Peaks:    reducePeaks(blocks, peaks),
+	}, nil
+}
+
+// reducePeaks reduces blocks into n buckets, each bucket holds the max peak of blocks within it.
+func reducePeaks(blocks []uint16, n int) []float64 {
+	if n <= 0 || len(blocks) < n {
+		n = len(blocks)
+	}
+
+	res := make([]float64, n)
+	for i := range res {
+		start, end := i*len(blocks)/n, (i+1)*len(blocks)/n
+		var peak uint16
+		for _, b := range blocks[start:end] {
+			peak = max(peak, b)
+		}
+
+		res[i] = math.Min(1, math.Round(float64(peak)/math.MaxInt16*1000)/1000)
+	}
+
+	return res
+}
+
+func absSample(s int16) uint16 {
+	if s < 0 {
+		return uint16(-int32(s))
+	}
+
+	return uint16(s)
+}
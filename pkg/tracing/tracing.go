@@ -0,0 +1,120 @@
+// Package tracing sets up OpenTelemetry tracing and provides helpers to create spans and propagate
+// trace context between master and slave nodes. When tracing is disabled, the global no-op tracer
+// provider is used so that instrumentation costs almost nothing.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+const instrumentationName = "github.com/cloudreve/Cloudreve/v4"
+
+// Init configures global tracer provider and propagator. The returned function flushes pending
+// spans and must be called on shutdown.
+func Init(ctx context.Context, c *conf.Tracing, mode conf.SysMode) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if !c.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(c.Endpoint)}
+	if c.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(c.ServiceName),
+		semconv.ServiceVersion(constants.BackendVersion),
+		attribute.String("cloudreve.mode", string(mode)),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracing resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(c.SampleRate))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Start starts a span with given name as a child of span in ctx.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// End records err on span if not nil and ends the span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// Inject writes trace context in ctx to outgoing request headers.
+func Inject(ctx context.Context, header http.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// Extract returns a context carrying trace context from incoming request headers.
+func Extract(ctx context.Context, header http.Header) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(header))
+}
+
+// InjectMetadata writes trace context in ctx to outgoing gRPC metadata.
+func InjectMetadata(ctx context.Context, md metadata.MD) {
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+}
+
+// ExtractMetadata returns a context carrying trace context from incoming gRPC metadata.
+func ExtractMetadata(ctx context.Context, md metadata.MD) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+}
+
+// metadataCarrier adapts gRPC metadata to propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
@@ -0,0 +1,238 @@
+// Package transcode converts videos into renditions for online preview with ffmpeg.
+package transcode
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// PresetHLS splits the video into HLS segments with a VOD playlist.
+	PresetHLS = "hls"
+	// PresetPreview encodes the video into a single progressive MP4 file.
+	PresetPreview = "preview"
+
+	HLSPlaylist    = "index.m3u8"
+	PreviewFile    = "preview.mp4"
+	defaultEncoder = "libx264"
+	defaultHeight  = 720
+	defaultSegment = 6
+)
+
+var (
+	ErrUnknownPreset = errors.New("unknown transcode preset")
+
+	durationPattern = regexp.MustCompile(`Duration: (\d+):(\d+):(\d+(?:\.\d+)?)`)
+)
+
+type (
+	// Options of a transcode job.
+	Options struct {
+		// FFMpegPath is the path of ffmpeg executable.
+		FFMpegPath string `json:"ffmpeg_path,omitempty"`
+		// HWAccel is the hardware decoding method passed to ffmpeg, e.g. cuda, vaapi, qsv. Empty for software decoding.
+		HWAccel string `json:"hwaccel,omitempty"`
+		// VideoEncoder is the ffmpeg video encoder, e.g. h264_nvenc. Defaults to libx264.
+		VideoEncoder string `json:"video_encoder,omitempty"`
+		// Preset is one of PresetHLS and PresetPreview.
+		Preset string `json:"preset"`
+		// Height of output video, width is scaled in proportion.
+		Height int `json:"height,omitempty"`
+		// SegmentSeconds is the target length of HLS segments.
+		SegmentSeconds int `json:"segment_seconds,omitempty"`
+	}
+
+	// ProgressFunc is called with transcoded and total duration of the video.
+	ProgressFunc func(current, total time.Duration)
+)
+
+// Args returns ffmpeg arguments to transcode input into dir.
+func (o *Options) Args(input, dir string) ([]string, error) {
+	encoder := o.VideoEncoder
+	if encoder == "" {
+		encoder = defaultEncoder
+	}
+
+	height := o.Height
+	if height <= 0 {
+		height = defaultHeight
+	}
+
+	args := []string{"-y", "-nostats", "-progress", "pipe:1"}
+	if o.HWAccel != "" {
+		args = append(args, "-hwaccel", o.HWAccel)
+	}
+
+	args = append(args,
+		"-i", input,
+		"-vf", fmt.Sprintf("scale=-2:'min(%d,ih)'", height),
+		"-c:v", encoder,
+		"-c:a", "aac",
+	)
+
+	switch o.Preset {
+	case PresetHLS:
+		segment := o.SegmentSeconds
+		if segment <= 0 {
+			segment = defaultSegment
+		}
+
+		args = append(args,
+			"-f", "hls",
+			"-hls_time", strconv.Itoa(segment),
+			"-hls_playlist_type", "vod",
+			"-hls_segment_filename", filepath.Join(dir, "segment_%05d.ts"),
+			filepath.Join(dir, HLSPlaylist),
+		)
+	case PresetPreview:
+		args = append(args,
+			"-movflags", "+faststart",
+			filepath.Join(dir, PreviewFile),
+		)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownPreset, o.Preset)
+	}
+
+	return args, nil
+}
+
+// Run transcodes input, a local path or URL, into dir and returns names of output files in dir.
+func Run(ctx context.Context, o *Options, input, dir string, progress ProgressFunc) ([]string, error) {
+	args, err := o.Args(input, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create output folder: %w", err)
+	}
+
+	ffmpeg := o.FFMpegPath
+	if ffmpeg == "" {
+		ffmpeg = "ffmpeg"
+	}
+
+	cmd := exec.CommandContext(ctx, ffmpeg, args...)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ffmpeg stdout: %w", err)
+	}
+
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ffmpeg stderr: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start ffmpeg: %w", err)
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		total  time.Duration
+		errLog bytes.Buffer
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		scanner := bufio.NewScanner(stderr)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if d, ok := ParseDuration(line); ok {
+				mu.Lock()
+				if total == 0 {
+					total = d
+				}
+				mu.Unlock()
+			}
+
+			if errLog.Len() < 64*1024 {
+				errLog.WriteString(line)
+				errLog.WriteByte('\n')
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			if current, ok := ParseProgress(scanner.Text()); ok && progress != nil {
+				mu.Lock()
+				t := total
+				mu.Unlock()
+				progress(current, t)
+			}
+		}
+		_, _ = io.Copy(io.Discard, stdout)
+	}()
+
+	wg.Wait()
+	if err := cmd.Wait(); err != nil {
+		return nil, fmt.Errorf("failed to invoke ffmpeg: %w, raw output: %s", err, lastLines(errLog.String(), 10))
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list outputs: %w", err)
+	}
+
+	outputs := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() {
+			outputs = append(outputs, e.Name())
+		}
+	}
+
+	return outputs, nil
+}
+
+// ParseDuration parses the input duration from a line of ffmpeg log.
+func ParseDuration(line string) (time.Duration, bool) {
+	matches := durationPattern.FindStringSubmatch(line)
+	if matches == nil {
+		return 0, false
+	}
+
+	hours, _ := strconv.Atoi(matches[1])
+	minutes, _ := strconv.Atoi(matches[2])
+	seconds, _ := strconv.ParseFloat(matches[3], 64)
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute +
+		time.Duration(seconds*float64(time.Second)), true
+}
+
+// ParseProgress parses the transcoded duration from a line of ffmpeg progress output.
+func ParseProgress(line string) (time.Duration, bool) {
+	value, found := strings.CutPrefix(line, "out_time_us=")
+	if !found {
+		return 0, false
+	}
+
+	us, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || us < 0 {
+		return 0, false
+	}
+
+	return time.Duration(us) * time.Microsecond, true
+}
+
+func lastLines(s string, n int) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,53 @@
+package transcode
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionsArgs(t *testing.T) {
+	a := assert.New(t)
+
+	args, err := (&Options{Preset: PresetHLS, HWAccel: "cuda", VideoEncoder: "h264_nvenc"}).Args("in.mp4", "out")
+	a.NoError(err)
+	a.Contains(args, "cuda")
+	a.Contains(args, "h264_nvenc")
+	a.Contains(args, "hls")
+	a.Equal("out/index.m3u8", args[len(args)-1])
+
+	args, err = (&Options{Preset: PresetPreview}).Args("in.mp4", "out")
+	a.NoError(err)
+	a.NotContains(args, "-hwaccel")
+	a.Contains(args, defaultEncoder)
+	a.Equal("out/preview.mp4", args[len(args)-1])
+
+	_, err = (&Options{Preset: "unknown"}).Args("in.mp4", "out")
+	a.ErrorIs(err, ErrUnknownPreset)
+}
+
+func TestParseDuration(t *testing.T) {
+	a := assert.New(t)
+
+	d, ok := ParseDuration("  Duration: 01:02:03.50, start: 0.000000, bitrate: 1205 kb/s")
+	a.True(ok)
+	a.Equal(time.Hour+2*time.Minute+3500*time.Millisecond, d)
+
+	_, ok = ParseDuration("Stream #0:0: Video: h264")
+	a.False(ok)
+}
+
+func TestParseProgress(t *testing.T) {
+	a := assert.New(t)
+
+	d, ok := ParseProgress("out_time_us=1500000")
+	a.True(ok)
+	a.Equal(1500*time.Millisecond, d)
+
+	_, ok = ParseProgress("out_time_us=N/A")
+	a.False(ok)
+
+	_, ok = ParseProgress("frame=10")
+	a.False(ok)
+}
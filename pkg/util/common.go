@@ -2,8 +2,10 @@ package util
 
 import (
 	"context"
+	crand "crypto/rand"
 	"fmt"
 	"github.com/gin-gonic/gin"
+	"math/big"
 	"math/rand"
 	"regexp"
 	"strings"
@@ -38,6 +40,21 @@ func RandString(n int, variant []rune) string {
 	return string(b)
 }
 
+// SecureRandString returns random string in given length and variant using a cryptographically
+// secure source. Use it for codes and secrets that must not be guessed.
+func SecureRandString(n int, variant []rune) (string, error) {
+	b := make([]rune, n)
+	max := big.NewInt(int64(len(variant)))
+	for i := range b {
+		idx, err := crand.Int(crand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate random number: %w", err)
+		}
+		b[i] = variant[idx.Int64()]
+	}
+	return string(b), nil
+}
+
 // ContainsUint 返回list中是否包含
 func ContainsUint(s []uint, e uint) bool {
 	for _, a := range s {
@@ -5,6 +5,25 @@ import (
 	"testing"
 )
 
+func TestSecureRandString(t *testing.T) {
+	asserts := assert.New(t)
+
+	randStr, err := SecureRandString(0, RandomLowerCases)
+	asserts.NoError(err)
+	asserts.Len(randStr, 0)
+
+	randStr, err = SecureRandString(32, RandomLowerCases)
+	asserts.NoError(err)
+	asserts.Len(randStr, 32)
+	for _, r := range randStr {
+		asserts.Contains(string(RandomLowerCases), string(r))
+	}
+
+	another, err := SecureRandString(32, RandomLowerCases)
+	asserts.NoError(err)
+	asserts.NotEqual(randStr, another)
+}
+
 func TestRandStringRunes(t *testing.T) {
 	asserts := assert.New(t)
 
@@ -0,0 +1,39 @@
+package util
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// WeakETag returns a weak entity tag derived from given parts.
+func WeakETag(parts ...any) string {
+	h := sha1.New()
+	for _, part := range parts {
+		fmt.Fprintf(h, "%v\x00", part)
+	}
+
+	return `W/"` + hex.EncodeToString(h.Sum(nil)[:12]) + `"`
+}
+
+// ETagMatch returns true if etag matches the If-None-Match header using weak comparison.
+func ETagMatch(ifNoneMatch, etag string) bool {
+	ifNoneMatch = strings.TrimSpace(ifNoneMatch)
+	if ifNoneMatch == "" || etag == "" {
+		return false
+	}
+
+	if ifNoneMatch == "*" {
+		return true
+	}
+
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
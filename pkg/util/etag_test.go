@@ -0,0 +1,28 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeakETag(t *testing.T) {
+	a := assert.New(t)
+	etag := WeakETag(1, "a", int64(2))
+	a.Regexp(`^W/"[0-9a-f]{24}"$`, etag)
+	a.Equal(etag, WeakETag(1, "a", int64(2)))
+	a.NotEqual(etag, WeakETag(1, "a", int64(3)))
+	a.NotEqual(WeakETag("ab", "c"), WeakETag("a", "bc"))
+}
+
+func TestETagMatch(t *testing.T) {
+	a := assert.New(t)
+	etag := `W/"abc"`
+	a.True(ETagMatch(`W/"abc"`, etag))
+	a.True(ETagMatch(`"abc"`, etag))
+	a.True(ETagMatch(`"x", W/"abc"`, etag))
+	a.True(ETagMatch(`*`, etag))
+	a.False(ETagMatch(``, etag))
+	a.False(ETagMatch(`W/"abd"`, etag))
+	a.False(ETagMatch(`*`, ""))
+}
@@ -0,0 +1,112 @@
+package webdav
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/ratelimit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/gin-gonic/gin"
+)
+
+// davBandwidth shares the bandwidth limit among concurrent requests of the same DAV account.
+var davBandwidth = ratelimit.NewBandwidthPool()
+
+type (
+	// countingBody counts bytes read from request body.
+	countingBody struct {
+		io.ReadCloser
+		n int64
+	}
+
+	limitedBody struct {
+		io.Reader
+		io.Closer
+	}
+
+	// limitedResponseWriter writes response body through a bandwidth limited writer.
+	limitedResponseWriter struct {
+		gin.ResponseWriter
+		w io.Writer
+	}
+)
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n += int64(n)
+	return n, err
+}
+
+func (w *limitedResponseWriter) Write(p []byte) (int, error) {
+	return w.w.Write(p)
+}
+
+func (w *limitedResponseWriter) WriteString(s string) (int, error) {
+	return w.w.Write([]byte(s))
+}
+
+// limitAccount applies the request and bandwidth limits of current DAV account.
+func limitAccount(c *gin.Context, dep dependency.Dep, account *ent.DavAccount) (int, error) {
+	if account.Props == nil {
+		return 0, nil
+	}
+
+	subject := strconv.Itoa(account.ID)
+	if limit := account.Props.RequestLimit; limit > 0 {
+		rule := &setting.RateLimitRule{Limit: limit, Window: 60}
+		res, err := ratelimit.Allow(dep.KV(), ratelimit.DavAccount, rule, subject, time.Now())
+		if err != nil {
+			// Do not block requests if the KV store is unavailable.
+			dep.Logger().Warning("Failed to check request limit of DAV account %d: %s", account.ID, err)
+		} else if !res.Allowed {
+			c.Header("Retry-After", strconv.Itoa(res.Reset))
+			return http.StatusTooManyRequests, errRequestLimited
+		}
+	}
+
+	if rate := account.Props.SpeedLimit; rate > 0 {
+		c.Request.Body = limitedBody{davBandwidth.Reader(subject, rate, c.Request.Body), c.Request.Body}
+		c.Writer = &limitedResponseWriter{ResponseWriter: c.Writer, w: davBandwidth.Writer(subject, rate, c.Writer)}
+	}
+
+	return 0, nil
+}
+
+// speedLimit returns the download speed limit of current user and DAV account, 0 means unlimited.
+func speedLimit(user *ent.User) int64 {
+	limit := int64(user.Edges.Group.SpeedLimit)
+	if props := user.Edges.DavAccounts[0].Props; props != nil && props.SpeedLimit > 0 {
+		if limit <= 0 || props.SpeedLimit < limit {
+			limit = props.SpeedLimit
+		}
+	}
+
+	return limit
+}
+
+// recordAccountAudit records a finished DAV request into audit log.
+func recordAccountAudit(c *gin.Context, dep dependency.Dep, user *ent.User, bytesIn int64) {
+	account := user.Edges.DavAccounts[0]
+	content := map[string]string{
+		"account":   account.Name,
+		"method":    c.Request.Method,
+		"path":      c.Request.URL.Path,
+		"status":    strconv.Itoa(c.Writer.Status()),
+		"bytes_in":  strconv.FormatInt(bytesIn, 10),
+		"bytes_out": strconv.Itoa(max(c.Writer.Size(), 0)),
+	}
+	if dst := c.Request.Header.Get("Destination"); dst != "" {
+		content["destination"] = dst
+	}
+
+	event := audit.NewEvent(c, audit.EventDavRequest, user.ID, user.ID, content).
+		WithEntity(audit.EntityDavAccount, account.ID)
+	if err := dep.AuditRecorder().Record(c, event); err != nil {
+		dep.Logger().Warning("Failed to record DAV request: %s", err)
+	}
+}
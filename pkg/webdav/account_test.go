@@ -0,0 +1,22 @@
+package webdav
+
+import (
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpeedLimit(t *testing.T) {
+	a := assert.New(t)
+	u := testDavUser("cloudreve://my")
+	u.Edges.Group = &ent.Group{SpeedLimit: 2048}
+	a.EqualValues(2048, speedLimit(u))
+
+	u.Edges.DavAccounts[0].Props = &types.DavAccountProps{SpeedLimit: 1024}
+	a.EqualValues(1024, speedLimit(u))
+
+	u.Edges.Group.SpeedLimit = 0
+	a.EqualValues(1024, speedLimit(u))
+}
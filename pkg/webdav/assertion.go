@@ -0,0 +1,114 @@
+package webdav
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+// assertionTTL is how long an assertion can be used by slave node after it is issued.
+const assertionTTL = 60 * time.Second
+
+// AssertionNodeCtx is the context key of the slave node ID that a WebDAV request is authorized for. Such
+// requests carry no body, content is transferred between client and slave node directly.
+type AssertionNodeCtx struct{}
+
+func assertionNode(ctx context.Context) int {
+	id, _ := ctx.Value(AssertionNodeCtx{}).(int)
+	return id
+}
+
+// policyOnNode returns whether content of given policy is stored on the slave node.
+func policyOnNode(policy *ent.StoragePolicy, node int) bool {
+	return policy != nil && policy.Type == types.PolicyTypeRemote && policy.NodeID == node
+}
+
+// assertRead authorizes slave node to serve content of target. Content is served from the slave only if it
+// is stored there, otherwise client is redirected, or the request is proxied to master.
+func assertRead(c *gin.Context, user *ent.User, fm manager.FileManager, target fs.File, es entitysource.EntitySource, node int) (int, error) {
+	dep := dependency.FromContext(c)
+	a := &cluster.DavAssertion{Kind: cluster.DavAssertionProxy}
+	policy, err := dep.StoragePolicyClient().GetPolicyByID(c, es.Entity().PolicyID())
+	if err != nil {
+		return purposeStatusCodeFromError(err), err
+	}
+
+	switch {
+	// Encrypted or internally proxied content is always served by master.
+	case es.ShouldInternalProxy():
+	case policyOnNode(policy, node):
+		a.Kind = cluster.DavAssertionServe
+		a.Source = es.Entity().Source()
+		a.Name = target.DisplayName()
+		a.SpeedLimit = speedLimit(user)
+		if a.ETag, err = findETag(c, fm, target); err != nil {
+			return http.StatusInternalServerError, err
+		}
+	case user.Edges.DavAccounts[0].Options.Enabled(int(types.DavAccountProxy)) &&
+		user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionWebDAVProxy)):
+	default:
+		expire := time.Now().Add(dep.SettingProvider().EntityUrlValidDuration(c))
+		src, err := es.Url(c, entitysource.WithExpire(&expire))
+		if err != nil {
+			return purposeStatusCodeFromError(err), err
+		}
+
+		a.Kind = cluster.DavAssertionRedirect
+		a.Url = src.Url
+	}
+
+	return writeAssertion(c, node, a)
+}
+
+// assertUpload authorizes slave node to receive content of a PUT request. An upload session is created for the
+// request, if the selected policy is not stored on the slave, it is cancelled and the request is proxied to master.
+func assertUpload(ctx context.Context, c *gin.Context, fm manager.FileManager, req *fs.UploadRequest, mtimeAccepted bool, node int) (int, error) {
+	a := &cluster.DavAssertion{Kind: cluster.DavAssertionProxy}
+	if req.Props.Size > 0 {
+		entityType := types.EntityTypeVersion
+		req.Props.EntityType = &entityType
+		credential, err := fm.CreateUploadSession(ctx, req)
+		if err != nil {
+			return purposeStatusCodeFromError(err), err
+		}
+
+		if policyOnNode(credential.StoragePolicy, node) && !credential.StoragePolicy.Settings.Relay {
+			a.Kind = cluster.DavAssertionUpload
+			a.SessionID = credential.SessionID
+			a.Size = req.Props.Size
+			a.ChunkSize = credential.ChunkSize
+			a.MtimeAccepted = mtimeAccepted
+		} else if err := fm.CancelUploadSession(ctx, req.Props.Uri, credential.SessionID); err != nil {
+			return purposeStatusCodeFromError(err), err
+		}
+	}
+
+	return writeAssertion(c, node, a)
+}
+
+func writeAssertion(c *gin.Context, node int, a *cluster.DavAssertion) (int, error) {
+	np, err := dependency.FromContext(c).NodePool(c)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	n, err := np.GetByID(c, node)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	a.Expires = time.Now().Add(assertionTTL).Unix()
+	a.Sign(n.AuthInstance())
+	c.JSON(http.StatusOK, serializer.NewResponseWithGobData(c, a))
+	return 0, nil
+}
@@ -0,0 +1,481 @@
+package webdav
+
+import (
+	"context"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/grouppolicy"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+)
+
+// Chunked upload follows the Nextcloud chunking v2 protocol: client creates a transfer with MKCOL
+// /dav/.uploads/<id>, PUTs numbered chunks into it in order, and finally MOVEs <id>/.file to the
+// destination. Each transfer is backed by a Cloudreve upload session created on MKCOL.
+const (
+	// uploadsCollection is the name of the collection holding chunked upload transfers.
+	uploadsCollection = ".uploads"
+	// assembledFile is the name of the resource to be moved to finish a transfer.
+	assembledFile        = ".file"
+	headerOCTotalLength  = "OC-Total-Length"
+	chunkedUploadPrefix  = "dav_upload_"
+	chunkedUploadLockTTL = 3600
+	maxChunkNumber       = 10000
+	maxTransferIDLength  = 128
+	chunkedUploadTempDir = "dav_uploads"
+)
+
+type (
+	// ChunkedUpload is the state of a chunked upload transfer.
+	ChunkedUpload struct {
+		Session  *fs.UploadSession
+		Received int64
+		Chunks   []UploadChunk
+		// Staging is the path of local file that chunks are appended to, if the storage policy
+		// cannot receive chunks at arbitrary offsets. Empty if chunks are written to storage directly.
+		Staging string
+	}
+
+	// UploadChunk is a chunk received in a chunked upload transfer.
+	UploadChunk struct {
+		Number int
+		Size   int64
+	}
+)
+
+func init() {
+	gob.Register(ChunkedUpload{})
+}
+
+// uploadsPath returns the transfer ID and chunk name request path p points to, ok is false if p
+// is not inside the uploads collection. An empty id means the collection itself.
+func uploadsPath(p string) (id, name string, ok bool) {
+	r := strings.TrimPrefix(p, davPrefix)
+	if len(r) == len(p) {
+		return "", "", false
+	}
+
+	first, rest, _ := strings.Cut(strings.Trim(r, fs.Separator), fs.Separator)
+	if first != uploadsCollection {
+		return "", "", false
+	}
+
+	id, name, _ = strings.Cut(rest, fs.Separator)
+	return id, name, true
+}
+
+// chunkedUploadKey returns the KV key of a transfer without chunkedUploadPrefix. Transfer IDs
+// are chosen by clients, so they are scoped to the DAV account.
+func chunkedUploadKey(user *ent.User, id string) string {
+	return fmt.Sprintf("%d_%s", user.Edges.DavAccounts[0].ID, id)
+}
+
+// handleChunkedUpload handles requests inside the uploads collection.
+func handleChunkedUpload(c *gin.Context, user *ent.User, fm manager.FileManager) (int, error) {
+	id, name, _ := uploadsPath(c.Request.URL.Path)
+	if id == "" {
+		if c.Request.Method == "PROPFIND" {
+			return handleTransferPropfind(c, nil, "")
+		}
+		return http.StatusMethodNotAllowed, errUploadsRoot
+	}
+
+	if len(id) > maxTransferIDLength || strings.Contains(name, fs.Separator) {
+		return http.StatusBadRequest, errInvalidTransfer
+	}
+
+	dep := dependency.FromContext(c)
+	key := chunkedUploadKey(user, id)
+	if c.Request.Method == "MKCOL" && name == "" {
+		return handleTransferMkcol(c, user, fm, key)
+	}
+
+	raw, ok := dep.KV().Get(chunkedUploadPrefix + key)
+	if !ok {
+		return http.StatusNotFound, errNoSuchTransfer
+	}
+	transfer := raw.(ChunkedUpload)
+
+	switch {
+	case c.Request.Method == "PROPFIND" && name == "":
+		return handleTransferPropfind(c, &transfer, id)
+	case c.Request.Method == http.MethodDelete && name == "":
+		cancelChunkedUpload(c, fm, user, key, &transfer)
+		return http.StatusNoContent, nil
+	case c.Request.Method == http.MethodPut && name != "":
+		return handleChunkPut(c, fm, key, &transfer, name)
+	case c.Request.Method == "MOVE" && name == assembledFile:
+		return handleTransferAssemble(c, user, fm, key, &transfer)
+	}
+
+	return http.StatusMethodNotAllowed, errUnsupportedMethod
+}
+
+// handleTransferMkcol creates a transfer and its underlying upload session.
+func handleTransferMkcol(c *gin.Context, user *ent.User, fm manager.FileManager, key string) (int, error) {
+	dep := dependency.FromContext(c)
+	if _, ok := dep.KV().Get(chunkedUploadPrefix + key); ok {
+		return http.StatusMethodNotAllowed, errTransferExisted
+	}
+
+	total, err := strconv.ParseInt(c.Request.Header.Get(headerOCTotalLength), 10, 64)
+	if err != nil || total < 0 {
+		return http.StatusBadRequest, errInvalidTotalLength
+	}
+
+	dst, status, err := transferDestination(c, user)
+	if err != nil {
+		return status, err
+	}
+
+	ancestor, uri, err := fm.SharedAddressTranslation(c, dst)
+	if err != nil && !ent.IsNotFound(err) {
+		return purposeStatusCodeFromError(err), err
+	}
+
+	release, ls, status, err := confirmLock(c, fm, user, ancestor, nil, uri, nil)
+	if err != nil {
+		return status, err
+	}
+	defer release()
+	ctx := fs.LockSessionToContext(c, ls)
+
+	entityType := types.EntityTypeVersion
+	req := &fs.UploadRequest{
+		Props: &fs.UploadProps{
+			Uri:             uri,
+			Size:            total,
+			UploadSessionID: uuid.Must(uuid.NewV4()).String(),
+			EntityType:      &entityType,
+			ExpireAt:        time.Now().Add(dep.SettingProvider().UploadSessionTTL(ctx)),
+		},
+		Mode: fs.ModeOverwrite,
+	}
+
+	policyReq := transferPolicyRequest(ctx, req.Props)
+	if err := dep.GroupPolicyChecker().Consume(ctx, user, policyReq); err != nil {
+		return purposeStatusCodeFromError(err), err
+	}
+
+	session, err := fm.PrepareUpload(ctx, req)
+	if err != nil {
+		dep.GroupPolicyChecker().Release(ctx, user, policyReq)
+		return purposeStatusCodeFromError(err), err
+	}
+
+	// Chunks are written to local storage at arbitrary offsets, other storage providers
+	// receive the assembled file at once.
+	transfer := &ChunkedUpload{Session: session}
+	if session.Policy.Type != types.PolicyTypeLocal {
+		transfer.Staging = filepath.Join(util.DataPath(dep.SettingProvider().TempPath(ctx)), chunkedUploadTempDir, req.Props.UploadSessionID)
+		err = os.MkdirAll(filepath.Dir(transfer.Staging), 0700)
+		if err == nil {
+			var f *os.File
+			if f, err = os.Create(transfer.Staging); err == nil {
+				f.Close()
+			}
+		}
+	}
+
+	if err == nil {
+		err = saveChunkedUpload(dep, key, transfer)
+	}
+	if err != nil {
+		cancelChunkedUpload(ctx, fm, user, key, transfer)
+		return http.StatusInternalServerError, err
+	}
+
+	return http.StatusCreated, nil
+}
+
+// handleChunkPut writes a chunk into the transfer. Chunks must be uploaded in ascending order,
+// re-uploading a received chunk with the same size is a no-op.
+func handleChunkPut(c *gin.Context, fm manager.FileManager, key string, transfer *ChunkedUpload, name string) (int, error) {
+	number, err := strconv.Atoi(name)
+	if err != nil || number < 1 || number > maxChunkNumber {
+		return http.StatusBadRequest, errInvalidChunk
+	}
+
+	rc, size, err := request.SniffContentLength(c.Request)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	unlock, status, err := lockTransfer(c, key, transfer)
+	if err != nil {
+		return status, err
+	}
+	defer unlock()
+
+	if n := len(transfer.Chunks); n > 0 && transfer.Chunks[n-1].Number >= number {
+		for _, chunk := range transfer.Chunks {
+			if chunk.Number == number && chunk.Size == size {
+				return http.StatusCreated, nil
+			}
+		}
+		return http.StatusConflict, errChunkOutOfOrder
+	}
+
+	if transfer.Received+size > transfer.Session.Props.Size {
+		return http.StatusRequestEntityTooLarge, errChunkTooLarge
+	}
+
+	if transfer.Staging == "" {
+		req := &fs.UploadRequest{
+			File:   rc,
+			Offset: transfer.Received,
+			Props:  transfer.Session.Props.Copy(),
+			Mode:   fs.ModeOverwrite,
+		}
+		if err := fm.Upload(transferContext(c, transfer), req, transfer.Session.Policy); err != nil {
+			return purposeStatusCodeFromError(err), err
+		}
+	} else {
+		f, err := os.OpenFile(transfer.Staging, os.O_WRONLY, 0600)
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+		defer f.Close()
+
+		// Discard data of previously failed attempts.
+		if err := f.Truncate(transfer.Received); err != nil {
+			return http.StatusInternalServerError, err
+		}
+		if _, err := f.Seek(transfer.Received, io.SeekStart); err != nil {
+			return http.StatusInternalServerError, err
+		}
+		if _, err := io.Copy(f, rc); err != nil {
+			return http.StatusInternalServerError, err
+		}
+	}
+
+	if rc.Count() != size {
+		return http.StatusBadRequest, fmt.Errorf("received data(%d) does not match purposed size(%d)", rc.Count(), size)
+	}
+
+	transfer.Received += size
+	transfer.Chunks = append(transfer.Chunks, UploadChunk{Number: number, Size: size})
+	if err := saveChunkedUpload(dependency.FromContext(c), key, transfer); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return http.StatusCreated, nil
+}
+
+// handleTransferAssemble finishes the transfer and its upload session.
+func handleTransferAssemble(c *gin.Context, user *ent.User, fm manager.FileManager, key string, transfer *ChunkedUpload) (int, error) {
+	dst, status, err := transferDestination(c, user)
+	if err != nil {
+		return status, err
+	}
+
+	_, uri, err := fm.SharedAddressTranslation(c, dst)
+	if err != nil && !ent.IsNotFound(err) {
+		return purposeStatusCodeFromError(err), err
+	}
+
+	if uri.String() != transfer.Session.Props.Uri.String() {
+		return http.StatusConflict, errTransferDestination
+	}
+
+	unlock, status, err := lockTransfer(c, key, transfer)
+	if err != nil {
+		return status, err
+	}
+	defer unlock()
+
+	if transfer.Received != transfer.Session.Props.Size {
+		return http.StatusBadRequest, errTransferIncomplete
+	}
+
+	ctx := transferContext(c, transfer)
+	if transfer.Staging != "" {
+		f, err := os.Open(transfer.Staging)
+		if err != nil {
+			return http.StatusInternalServerError, err
+		}
+
+		req := &fs.UploadRequest{
+			File:   f,
+			Seeker: f,
+			Props:  transfer.Session.Props.Copy(),
+			Mode:   fs.ModeOverwrite,
+		}
+		if err := fm.Upload(ctx, req, transfer.Session.Policy); err != nil {
+			f.Close()
+			return purposeStatusCodeFromError(err), err
+		}
+		f.Close()
+	}
+
+	mtimeAccepted := false
+	if mtime, err := strconv.ParseInt(c.Request.Header.Get(headerOCMtime), 10, 64); err == nil && mtime > 0 {
+		lastModified := time.Unix(mtime, 0)
+		transfer.Session.Props.LastModified = &lastModified
+		mtimeAccepted = true
+	}
+
+	res, err := fm.CompleteUpload(ctx, transfer.Session)
+	if err != nil {
+		cancelChunkedUpload(c, fm, user, key, transfer)
+		return purposeStatusCodeFromError(err), err
+	}
+
+	dependency.FromContext(c).KV().Delete(chunkedUploadPrefix, key)
+	removeStaging(transfer)
+
+	etag, err := findETag(ctx, fm, res)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	c.Writer.Header().Set("ETag", etag)
+	if mtimeAccepted {
+		c.Writer.Header().Set(headerOCMtime, "accepted")
+	}
+	if transfer.Session.NewFileCreated {
+		return http.StatusCreated, nil
+	}
+	return http.StatusNoContent, nil
+}
+
+// handleTransferPropfind lists received chunks of a transfer, so that clients can resume it.
+// A nil transfer means the uploads collection itself, which does not list its transfers.
+func handleTransferPropfind(c *gin.Context, transfer *ChunkedUpload, id string) (int, error) {
+	depth := infiniteDepth
+	if hdr := c.Request.Header.Get("Depth"); hdr != "" {
+		depth = parseDepth(hdr)
+		if depth == invalidDepth {
+			return http.StatusBadRequest, errInvalidDepth
+		}
+	}
+	pf, status, err := readPropfind(c.Request.Body)
+	if err != nil {
+		return status, err
+	}
+
+	href := path.Join(davPrefix, uploadsCollection, id)
+	mw := multistatusWriter{w: c.Writer}
+	if err := mw.write(makePropstatResponse(util.FillSlash(href), virtualRootProps(pf))); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if depth != 0 && transfer != nil {
+		for _, chunk := range transfer.Chunks {
+			props := virtualProps(pf, map[string]string{
+				"resourcetype":     "",
+				"displayname":      strconv.Itoa(chunk.Number),
+				"getcontentlength": strconv.FormatInt(chunk.Size, 10),
+			})
+			if err := mw.write(makePropstatResponse(path.Join(href, strconv.Itoa(chunk.Number)), props)); err != nil {
+				return http.StatusInternalServerError, err
+			}
+		}
+	}
+
+	if err := mw.close(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return 0, nil
+}
+
+// transferDestination resolves the Destination header of chunked upload requests.
+func transferDestination(c *gin.Context, user *ent.User) (*fs.URI, int, error) {
+	hdr := c.Request.Header.Get("Destination")
+	if hdr == "" {
+		return nil, http.StatusBadRequest, errInvalidDestination
+	}
+	u, err := url.Parse(hdr)
+	if err != nil {
+		return nil, http.StatusBadRequest, errInvalidDestination
+	}
+	if u.Host != "" && u.Host != c.Request.Host {
+		return nil, http.StatusBadGateway, errInvalidDestination
+	}
+	if _, _, ok := uploadsPath(u.Path); ok {
+		return nil, http.StatusBadRequest, errInvalidDestination
+	}
+
+	_, dst, status, err := stripPrefix(c, u.Path, user)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return dst, 0, nil
+}
+
+// lockTransfer prevents concurrent writes to the same transfer, and reloads its latest state.
+func lockTransfer(c *gin.Context, key string, transfer *ChunkedUpload) (func(), int, error) {
+	kv := dependency.FromContext(c).KV()
+	token := uuid.Must(uuid.NewV4()).String()
+	lockKey := chunkedUploadPrefix + key + "_lock"
+	ok, err := kv.SetNX(lockKey, token, chunkedUploadLockTTL)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if !ok {
+		return nil, StatusLocked, errTransferLocked
+	}
+
+	unlock := func() {
+		_, _ = kv.CompareAndDelete(lockKey, token)
+	}
+
+	raw, ok := kv.Get(chunkedUploadPrefix + key)
+	if !ok {
+		unlock()
+		return nil, http.StatusNotFound, errNoSuchTransfer
+	}
+	*transfer = raw.(ChunkedUpload)
+	return unlock, 0, nil
+}
+
+func saveChunkedUpload(dep dependency.Dep, key string, transfer *ChunkedUpload) error {
+	ttl := max(1, int(time.Until(transfer.Session.Props.ExpireAt).Seconds()))
+	return dep.KV().Set(chunkedUploadPrefix+key, *transfer, ttl)
+}
+
+// cancelChunkedUpload removes the transfer, its upload session and received chunks.
+func cancelChunkedUpload(ctx context.Context, fm manager.FileManager, user *ent.User, key string, transfer *ChunkedUpload) {
+	dep := dependency.FromContext(ctx)
+	fm.OnUploadFailed(ctx, transfer.Session)
+	dep.GroupPolicyChecker().Release(ctx, user, transferPolicyRequest(ctx, transfer.Session.Props))
+	dep.KV().Delete(chunkedUploadPrefix, key)
+	removeStaging(transfer)
+}
+
+func removeStaging(transfer *ChunkedUpload) {
+	if transfer.Staging != "" {
+		_ = os.Remove(transfer.Staging)
+	}
+}
+
+func transferContext(ctx context.Context, transfer *ChunkedUpload) context.Context {
+	return context.WithValue(ctx, cluster.SlaveNodeIDCtx{}, strconv.Itoa(transfer.Session.Policy.NodeID))
+}
+
+func transferPolicyRequest(ctx context.Context, props *fs.UploadProps) *grouppolicy.Request {
+	return &grouppolicy.Request{
+		Action:   types.GroupPolicyActionUpload,
+		Size:     props.Size,
+		MimeType: dependency.FromContext(ctx).MimeDetector(ctx).TypeByName(props.Uri.Name()),
+	}
+}
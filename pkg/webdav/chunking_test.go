@@ -0,0 +1,50 @@
+package webdav
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadsPath(t *testing.T) {
+	a := assert.New(t)
+
+	id, name, ok := uploadsPath("/dav/.uploads")
+	a.True(ok)
+	a.Equal("", id)
+	a.Equal("", name)
+
+	id, name, ok = uploadsPath("/dav/.uploads/transfer-1/")
+	a.True(ok)
+	a.Equal("transfer-1", id)
+	a.Equal("", name)
+
+	id, name, ok = uploadsPath("/dav/.uploads/transfer-1/00002")
+	a.True(ok)
+	a.Equal("transfer-1", id)
+	a.Equal("00002", name)
+
+	_, _, ok = uploadsPath("/dav/docs/.uploads/transfer-1")
+	a.False(ok)
+	_, _, ok = uploadsPath("/other/.uploads/transfer-1")
+	a.False(ok)
+}
+
+func TestHandleTransferPropfind(t *testing.T) {
+	a := assert.New(t)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest("PROPFIND", "/dav/.uploads/transfer-1", nil)
+	c.Request.Header.Set("Depth", "1")
+
+	transfer := &ChunkedUpload{Chunks: []UploadChunk{{Number: 1, Size: 10}, {Number: 2, Size: 5}}}
+	status, err := handleTransferPropfind(c, transfer, "transfer-1")
+	a.NoError(err)
+	a.Equal(0, status)
+	a.Equal(StatusMulti, w.Code)
+	a.Contains(w.Body.String(), "<D:href>/dav/.uploads/transfer-1/</D:href>")
+	a.Contains(w.Body.String(), "<D:href>/dav/.uploads/transfer-1/2</D:href>")
+	a.Contains(w.Body.String(), "<D:getcontentlength>5</D:getcontentlength>")
+}
@@ -0,0 +1,70 @@
+package webdav
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/gin-gonic/gin"
+)
+
+// checkFileConditions evaluates conditional headers against file, which is nil if the
+// requested resource does not exist. Folders exist but have no ETag.
+func checkFileConditions(c *gin.Context, fm manager.FileManager, file fs.File) int {
+	if file == nil {
+		return checkConditions(c, false, "")
+	}
+
+	etag := ""
+	if file.Type() == types.FileTypeFile {
+		etag, _ = findETag(c, fm, file)
+	}
+	return checkConditions(c, true, etag)
+}
+
+// checkConditions evaluates If-Match and If-None-Match headers against the ETag of the current
+// resource, as described in RFC 7232 section 6. A non-zero status is returned if the request
+// should not proceed.
+func checkConditions(c *gin.Context, exists bool, etag string) int {
+	if im := c.Request.Header.Get("If-Match"); im != "" {
+		if !exists || !etagListMatch(im, etag, false) {
+			return http.StatusPreconditionFailed
+		}
+	}
+
+	if inm := c.Request.Header.Get("If-None-Match"); inm != "" && exists && etagListMatch(inm, etag, true) {
+		if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+			c.Writer.Header().Set("ETag", etag)
+			return http.StatusNotModified
+		}
+		return http.StatusPreconditionFailed
+	}
+
+	return 0
+}
+
+// etagListMatch returns whether etag is listed in header value list. Weak ETags only match
+// if weak comparison is allowed.
+func etagListMatch(list, etag string, weak bool) bool {
+	for _, candidate := range strings.Split(list, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+
+		if strings.HasPrefix(candidate, "W/") {
+			if !weak {
+				continue
+			}
+			candidate = strings.TrimPrefix(candidate, "W/")
+		}
+
+		if etag != "" && candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
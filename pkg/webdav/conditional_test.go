@@ -0,0 +1,33 @@
+package webdav
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckConditions(t *testing.T) {
+	a := assert.New(t)
+	etag := `"abc"`
+	check := func(method string, headers map[string]string, exists bool) int {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(method, "/dav/a", nil)
+		for k, v := range headers {
+			c.Request.Header.Set(k, v)
+		}
+		return checkConditions(c, exists, etag)
+	}
+
+	a.Equal(0, check(http.MethodPut, nil, true))
+	a.Equal(0, check(http.MethodPut, map[string]string{"If-Match": `"xyz", "abc"`}, true))
+	a.Equal(http.StatusPreconditionFailed, check(http.MethodPut, map[string]string{"If-Match": `"xyz"`}, true))
+	a.Equal(http.StatusPreconditionFailed, check(http.MethodPut, map[string]string{"If-Match": `W/"abc"`}, true))
+	a.Equal(http.StatusPreconditionFailed, check(http.MethodPut, map[string]string{"If-Match": "*"}, false))
+	a.Equal(0, check(http.MethodPut, map[string]string{"If-None-Match": "*"}, false))
+	a.Equal(http.StatusPreconditionFailed, check(http.MethodPut, map[string]string{"If-None-Match": "*"}, true))
+	a.Equal(http.StatusNotModified, check(http.MethodGet, map[string]string{"If-None-Match": `W/"abc"`}, true))
+	a.Equal(0, check(http.MethodGet, map[string]string{"If-None-Match": `"xyz"`}, true))
+}
@@ -113,6 +113,10 @@ func (m *metadataDeadProps) DeadProps() (map[xml.Name]Property, error) {
 		}
 
 		spaceLocal := strings.SplitN(strings.TrimPrefix(k, DeadPropsMetadataPrefix), SpaceNameSeparator, 2)
+		if len(spaceLocal) != 2 {
+			continue
+		}
+
 		name := xml.Name{spaceLocal[0], spaceLocal[1]}
 		propsStore := &DeadPropsStore{}
 		if err := json.Unmarshal([]byte(v), propsStore); err != nil {
@@ -329,6 +333,13 @@ var liveProps = map[xml.Name]struct {
 	},
 }
 
+// allpropExcluded contains live properties only returned if explicitly requested. RFC 4331 says
+// quota properties SHOULD NOT be returned by allprop, as computing them can be expensive.
+var allpropExcluded = map[xml.Name]bool{
+	{Space: "DAV:", Local: "quota-used-bytes"}:      true,
+	{Space: "DAV:", Local: "quota-available-bytes"}: true,
+}
+
 // TODO(nigeltao) merge props and allprop?
 
 // Props returns the status of the properties named pnames for resource name.
@@ -424,9 +435,15 @@ func allprop(c *gin.Context, file fs.File, fm manager.FileManager, include []xml
 	}
 	// Add names from include if they are not already covered in pnames.
 	nameset := make(map[xml.Name]bool)
+	filtered := pnames[:0]
 	for _, pn := range pnames {
+		if allpropExcluded[pn] {
+			continue
+		}
 		nameset[pn] = true
+		filtered = append(filtered, pn)
 	}
+	pnames = filtered
 	for _, pn := range include {
 		if !nameset[pn] {
 			pnames = append(pnames, pn)
@@ -567,7 +584,8 @@ func findQuotaAvailableBytes(ctx context.Context, fm manager.FileManager, file f
 	if err != nil {
 		return "", err
 	}
-	return strconv.FormatInt(capacity.Total-capacity.Used, 10), nil
+	// Used space might exceed the quota after the quota is lowered.
+	return strconv.FormatInt(max(capacity.Total-capacity.Used, 0), 10), nil
 }
 
 func findSupportedLock(ctx context.Context, fm manager.FileManager, file fs.File) (string, error) {
@@ -575,5 +593,9 @@ func findSupportedLock(ctx context.Context, fm manager.FileManager, file fs.File
 		`<D:lockentry xmlns:D="DAV:">` +
 		`<D:lockscope><D:exclusive/></D:lockscope>` +
 		`<D:locktype><D:write/></D:locktype>` +
+		`</D:lockentry>` +
+		`<D:lockentry xmlns:D="DAV:">` +
+		`<D:lockscope><D:shared/></D:lockscope>` +
+		`<D:locktype><D:write/></D:locktype>` +
 		`</D:lockentry>`, nil
 }
@@ -0,0 +1,193 @@
+package webdav
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// accountRoots returns the mount roots of current DAV account. Accounts without roots expose
+// their URI as the only root.
+func accountRoots(u *ent.User) []types.DavAccountRoot {
+	// Unauthenticated OPTIONS requests have no user.
+	if u == nil {
+		return nil
+	}
+
+	if props := u.Edges.DavAccounts[0].Props; props != nil {
+		return props.Roots
+	}
+	return nil
+}
+
+// matchRoot finds the mount root of relative request path r, returns the path relative to the root.
+// isVirtualRoot is true if r points to the virtual collection listing all roots.
+func matchRoot(roots []types.DavAccountRoot, r string) (root *types.DavAccountRoot, rest string, isVirtualRoot bool) {
+	r = strings.Trim(r, fs.Separator)
+	if r == "" {
+		return nil, "", true
+	}
+
+	name, rest, _ := strings.Cut(r, fs.Separator)
+	for i := range roots {
+		if roots[i].Name == name {
+			return &roots[i], rest, false
+		}
+	}
+
+	return nil, "", false
+}
+
+// isVirtualRoot returns whether the request path points to the collection listing all mount roots.
+func isVirtualRoot(p string, u *ent.User) bool {
+	roots := accountRoots(u)
+	if len(roots) == 0 {
+		return false
+	}
+
+	r := strings.TrimPrefix(p, davPrefix)
+	if len(r) == len(p) {
+		return false
+	}
+
+	_, _, virtual := matchRoot(roots, r)
+	return virtual
+}
+
+// checkRootWritable rejects write requests to read-only mount roots. For COPY and MOVE, the
+// destination is checked as well.
+func checkRootWritable(c *gin.Context, u *ent.User) (int, error) {
+	roots := accountRoots(u)
+	if len(roots) == 0 {
+		return 0, nil
+	}
+
+	readOnly := func(p string) bool {
+		root, _, _ := matchRoot(roots, strings.TrimPrefix(p, davPrefix))
+		return root != nil && root.ReadOnly
+	}
+
+	switch c.Request.Method {
+	case http.MethodDelete, http.MethodPut, "MKCOL", "MOVE", "LOCK", "UNLOCK", "PROPPATCH":
+		if readOnly(c.Request.URL.Path) {
+			return http.StatusForbidden, errReadOnlyRoot
+		}
+	}
+
+	// Chunked uploads declare their destination on MKCOL.
+	switch c.Request.Method {
+	case "COPY", "MOVE", "MKCOL":
+		if u, err := url.Parse(c.Request.Header.Get("Destination")); err == nil && readOnly(u.Path) {
+			return http.StatusForbidden, errReadOnlyRoot
+		}
+	}
+
+	return 0, nil
+}
+
+// handleVirtualRootPropfind lists mount roots as top-level collections.
+func handleVirtualRootPropfind(c *gin.Context, user *ent.User, fm manager.FileManager) (int, error) {
+	depth := infiniteDepth
+	if hdr := c.Request.Header.Get("Depth"); hdr != "" {
+		depth = parseDepth(hdr)
+		if depth == invalidDepth {
+			return http.StatusBadRequest, errInvalidDepth
+		}
+	}
+	pf, status, err := readPropfind(c.Request.Body)
+	if err != nil {
+		return status, err
+	}
+
+	mw := multistatusWriter{w: c.Writer}
+	if err := mw.write(makePropstatResponse(util.FillSlash(davPrefix), virtualRootProps(pf))); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if depth != 0 {
+		childDepth := depth
+		if depth == 1 {
+			childDepth = 0
+		}
+
+		for _, root := range accountRoots(user) {
+			base, err := fs.NewUriFromString(root.Uri)
+			if err != nil {
+				return http.StatusInternalServerError, err
+			}
+
+			_, target, err := fm.SharedAddressTranslation(c, base)
+			if err != nil {
+				// Roots pointing to unavailable files are hidden.
+				logging.FromContext(c).Debug("Failed to get WebDAV mount root %q: %s", root.Name, err)
+				continue
+			}
+
+			if err := fm.Walk(c, target, childDepth, propfindWalkFunc(c, fm, &mw, pf, root.Name)); err != nil {
+				return purposeStatusCodeFromError(err), err
+			}
+		}
+	}
+
+	if err := mw.close(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return 0, nil
+}
+
+// virtualRootProps returns properties of the virtual collection listing all mount roots.
+func virtualRootProps(pf propfind) []Propstat {
+	return virtualProps(pf, map[string]string{
+		"resourcetype": `<D:collection xmlns:D="DAV:"/>`,
+		"displayname":  "",
+	})
+}
+
+// virtualProps returns properties of a resource not backed by a file, known maps names of
+// supported DAV properties to their values.
+func virtualProps(pf propfind, known map[string]string) []Propstat {
+	pstatOK := Propstat{Status: http.StatusOK}
+	pstatNotFound := Propstat{Status: http.StatusNotFound}
+	if pf.Propname != nil || pf.Allprop != nil {
+		for name, value := range known {
+			prop := Property{XMLName: xml.Name{Space: "DAV:", Local: name}}
+			if pf.Allprop != nil {
+				prop.InnerXML = []byte(value)
+			}
+			pstatOK.Props = append(pstatOK.Props, prop)
+		}
+		return makePropstats(pstatOK, pstatNotFound)
+	}
+
+	for _, pn := range pf.Prop {
+		if value, ok := known[pn.Local]; ok && pn.Space == "DAV:" {
+			pstatOK.Props = append(pstatOK.Props, Property{XMLName: pn, InnerXML: []byte(value)})
+		} else {
+			pstatNotFound.Props = append(pstatNotFound.Props, Property{XMLName: pn})
+		}
+	}
+	return makePropstats(pstatOK, pstatNotFound)
+}
+
+// rootHref returns the href of a file under mount root href, given its depth from the root.
+func rootHref(href string, f fs.File, level int) string {
+	p := path.Join(davPrefix, href)
+	elements := f.Uri(false).Elements()
+	for i := 0; i < level; i++ {
+		p = path.Join(p, elements[len(elements)-level+i])
+	}
+	if f.Type() == types.FileTypeFolder {
+		p = util.FillSlash(p)
+	}
+	return p
+}
@@ -0,0 +1,566 @@
+package webdav
+
+// SEARCH method with the DAV:basicsearch grammar.
+// http://www.webdav.org/specs/rfc5323.html
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/gin-gonic/gin"
+
+	ixml "github.com/cloudreve/Cloudreve/v4/pkg/webdav/internal/xml"
+)
+
+const (
+	methodSearch   = "SEARCH"
+	searchPageSize = 1000
+)
+
+var (
+	propDisplayName   = xml.Name{Space: "DAV:", Local: "displayname"}
+	propContentLength = xml.Name{Space: "DAV:", Local: "getcontentlength"}
+	propLastModified  = xml.Name{Space: "DAV:", Local: "getlastmodified"}
+	propCreationDate  = xml.Name{Space: "DAV:", Local: "creationdate"}
+
+	errSearchTruncated = errors.New("webdav: search result truncated")
+)
+
+type (
+	// http://www.webdav.org/specs/rfc5323.html#ELEMENT_searchrequest
+	searchRequest struct {
+		XMLName     ixml.Name    `xml:"DAV: searchrequest"`
+		BasicSearch *basicSearch `xml:"DAV: basicsearch"`
+	}
+
+	// http://www.webdav.org/specs/rfc5323.html#basic.search.xml.elements
+	basicSearch struct {
+		Select  searchSelect   `xml:"DAV: select"`
+		From    searchFrom     `xml:"DAV: from"`
+		Where   *searchWhere   `xml:"DAV: where"`
+		OrderBy *searchOrderBy `xml:"DAV: orderby"`
+		Limit   *searchLimit   `xml:"DAV: limit"`
+	}
+
+	searchSelect struct {
+		Allprop *struct{}     `xml:"DAV: allprop"`
+		Prop    propfindProps `xml:"DAV: prop"`
+	}
+
+	searchFrom struct {
+		Scope []searchScope `xml:"DAV: scope"`
+	}
+
+	searchScope struct {
+		Href  string `xml:"DAV: href"`
+		Depth string `xml:"DAV: depth"`
+	}
+
+	searchOrderBy struct {
+		Order []searchOrder `xml:"DAV: order"`
+	}
+
+	searchOrder struct {
+		Prop       propfindProps `xml:"DAV: prop"`
+		Descending *struct{}     `xml:"DAV: descending"`
+	}
+
+	searchLimit struct {
+		NResults int `xml:"DAV: nresults"`
+	}
+
+	// searchWhere holds the only expression within DAV:where.
+	searchWhere struct {
+		expr *searchExpr
+	}
+
+	// searchExpr is an operator of the basicsearch grammar, e.g. DAV:and, DAV:like.
+	searchExpr struct {
+		op       string
+		prop     xml.Name
+		literal  string
+		operands []*searchExpr
+	}
+
+	// searchMatcher reports whether a file satisfies the search condition.
+	searchMatcher func(f fs.File) bool
+
+	searchHit struct {
+		file  fs.File
+		level int
+	}
+)
+
+func (w *searchWhere) UnmarshalXML(d *ixml.Decoder, start ixml.StartElement) error {
+	for {
+		t, err := next(d)
+		if err != nil {
+			return err
+		}
+
+		switch t := t.(type) {
+		case ixml.StartElement:
+			if w.expr != nil {
+				return fmt.Errorf("%s must contain exactly one expression", start.Name.Local)
+			}
+			if w.expr, err = readSearchExpr(d, t); err != nil {
+				return err
+			}
+		case ixml.EndElement:
+			if w.expr == nil {
+				return fmt.Errorf("%s must contain exactly one expression", start.Name.Local)
+			}
+			return nil
+		}
+	}
+}
+
+func readSearchExpr(d *ixml.Decoder, start ixml.StartElement) (*searchExpr, error) {
+	if start.Name.Space != "DAV:" {
+		return nil, fmt.Errorf("unsupported search operator %s", start.Name.Local)
+	}
+
+	e := &searchExpr{op: start.Name.Local}
+	for {
+		t, err := next(d)
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := t.(type) {
+		case ixml.StartElement:
+			switch {
+			case t.Name.Space == "DAV:" && t.Name.Local == "prop":
+				var pn propfindProps
+				if err := d.DecodeElement(&pn, &t); err != nil {
+					return nil, err
+				}
+				if len(pn) != 1 {
+					return nil, fmt.Errorf("operator %s must reference exactly one property", e.op)
+				}
+				e.prop = pn[0]
+			case t.Name.Space == "DAV:" && t.Name.Local == "literal":
+				if err := d.DecodeElement(&e.literal, &t); err != nil {
+					return nil, err
+				}
+			default:
+				operand, err := readSearchExpr(d, t)
+				if err != nil {
+					return nil, err
+				}
+				e.operands = append(e.operands, operand)
+			}
+		case ixml.EndElement:
+			return e, nil
+		}
+	}
+}
+
+func readSearchRequest(r io.Reader) (*basicSearch, int, error) {
+	var req searchRequest
+	if err := ixml.NewDecoder(r).Decode(&req); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	if req.BasicSearch == nil {
+		return nil, http.StatusUnprocessableEntity, errUnsupportedSearch
+	}
+
+	bs := req.BasicSearch
+	if (bs.Select.Allprop == nil) == (bs.Select.Prop == nil) {
+		return nil, http.StatusBadRequest, errInvalidSearch
+	}
+	if len(bs.From.Scope) != 1 {
+		return nil, http.StatusUnprocessableEntity, errUnsupportedSearch
+	}
+
+	return bs, 0, nil
+}
+
+// compile builds a matcher evaluating the expression against files.
+func (e *searchExpr) compile() (searchMatcher, error) {
+	switch e.op {
+	case "and", "or":
+		if len(e.operands) == 0 {
+			return nil, errInvalidSearch
+		}
+		matchers := make([]searchMatcher, 0, len(e.operands))
+		for _, operand := range e.operands {
+			m, err := operand.compile()
+			if err != nil {
+				return nil, err
+			}
+			matchers = append(matchers, m)
+		}
+
+		isAnd := e.op == "and"
+		return func(f fs.File) bool {
+			for _, m := range matchers {
+				if m(f) != isAnd {
+					return !isAnd
+				}
+			}
+			return isAnd
+		}, nil
+	case "not":
+		if len(e.operands) != 1 {
+			return nil, errInvalidSearch
+		}
+		m, err := e.operands[0].compile()
+		if err != nil {
+			return nil, err
+		}
+		return func(f fs.File) bool { return !m(f) }, nil
+	case "is-collection":
+		return func(f fs.File) bool { return f.Type() == types.FileTypeFolder }, nil
+	case "like":
+		if e.prop != propDisplayName {
+			return nil, errUnsupportedSearch
+		}
+		pattern := likePattern(e.literal)
+		return func(f fs.File) bool { return pattern.MatchString(f.DisplayName()) }, nil
+	case "eq", "lt", "lte", "gt", "gte":
+		return e.compileComparison()
+	}
+
+	return nil, errUnsupportedSearch
+}
+
+func (e *searchExpr) compileComparison() (searchMatcher, error) {
+	satisfies := func(c int) bool {
+		switch e.op {
+		case "lt":
+			return c < 0
+		case "lte":
+			return c <= 0
+		case "gt":
+			return c > 0
+		case "gte":
+			return c >= 0
+		}
+		return c == 0
+	}
+
+	switch e.prop {
+	case propDisplayName:
+		if e.op != "eq" {
+			return nil, errUnsupportedSearch
+		}
+		return func(f fs.File) bool { return strings.EqualFold(f.DisplayName(), e.literal) }, nil
+	case propContentLength:
+		size, err := strconv.ParseInt(strings.TrimSpace(e.literal), 10, 64)
+		if err != nil {
+			return nil, errInvalidSearch
+		}
+		return func(f fs.File) bool {
+			switch {
+			case f.Size() < size:
+				return satisfies(-1)
+			case f.Size() > size:
+				return satisfies(1)
+			}
+			return satisfies(0)
+		}, nil
+	case propLastModified, propCreationDate:
+		t, err := parseSearchTime(e.literal)
+		if err != nil {
+			return nil, errInvalidSearch
+		}
+		lastModified := e.prop == propLastModified
+		return func(f fs.File) bool {
+			ft := f.CreatedAt()
+			if lastModified {
+				ft = f.UpdatedAt()
+			}
+			// Dates are exposed in second precision.
+			return satisfies(ft.Truncate(time.Second).Compare(t))
+		}, nil
+	}
+
+	return nil, errUnsupportedSearch
+}
+
+// prefilter translates conditions supported by the internal search into query parameters.
+// Only conjunctions are translated, the result is a superset of files matching e.
+func (e *searchExpr) prefilter(q url.Values) {
+	switch e.op {
+	case "and":
+		for _, operand := range e.operands {
+			operand.prefilter(q)
+		}
+	case "is-collection":
+		q.Set(fs.QuerySearchType, "folder")
+	case "not":
+		if len(e.operands) == 1 && e.operands[0].op == "is-collection" {
+			q.Set(fs.QuerySearchType, "file")
+		}
+	case "like":
+		if e.prop == propDisplayName && !strings.Contains(e.literal, `\`) {
+			for _, part := range strings.FieldsFunc(e.literal, func(r rune) bool { return r == '%' || r == '_' }) {
+				q.Add(fs.QuerySearchName, part)
+				q.Set(fs.QuerySearchCaseFolding, "true")
+			}
+		}
+	case "eq", "lt", "lte", "gt", "gte":
+		lower, upper := e.op == "eq" || strings.HasPrefix(e.op, "g"), e.op == "eq" || strings.HasPrefix(e.op, "l")
+		switch e.prop {
+		case propDisplayName:
+			if e.op == "eq" && e.literal != "" {
+				q.Add(fs.QuerySearchName, e.literal)
+				q.Set(fs.QuerySearchCaseFolding, "true")
+			}
+		case propContentLength:
+			if size, err := strconv.ParseInt(strings.TrimSpace(e.literal), 10, 64); err == nil {
+				if lower {
+					q.Set(fs.QuerySearchSizeGte, strconv.FormatInt(size, 10))
+				}
+				if upper && size > 0 {
+					q.Set(fs.QuerySearchSizeLte, strconv.FormatInt(size, 10))
+				}
+			}
+		case propLastModified, propCreationDate:
+			if t, err := parseSearchTime(e.literal); err == nil {
+				gteKey, lteKey := fs.QuerySearchCreatedGte, fs.QuerySearchCreatedLte
+				if e.prop == propLastModified {
+					gteKey, lteKey = fs.QuerySearchUpdatedGte, fs.QuerySearchUpdatedLte
+				}
+				if lower {
+					q.Set(gteKey, strconv.FormatInt(t.Unix(), 10))
+				}
+				if upper {
+					q.Set(lteKey, strconv.FormatInt(t.Unix()+1, 10))
+				}
+			}
+		}
+	}
+}
+
+// likePattern converts a DAV:like pattern into a case-insensitive regular expression.
+func likePattern(literal string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("(?is)^")
+	escaped := false
+	for _, r := range literal {
+		switch {
+		case escaped:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			sb.WriteString(".*")
+		case r == '_':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.MustCompile(sb.String())
+}
+
+func parseSearchTime(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := http.ParseTime(s); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+// searchLess returns the comparison function of search results for given DAV:orderby.
+func searchLess(orders []searchOrder) (func(a, b fs.File) bool, error) {
+	type compareFunc func(a, b fs.File) int
+	compares := make([]compareFunc, 0, len(orders))
+	for _, order := range orders {
+		if len(order.Prop) != 1 {
+			return nil, errInvalidSearch
+		}
+
+		var compare compareFunc
+		switch order.Prop[0] {
+		case propDisplayName:
+			compare = func(a, b fs.File) int {
+				return strings.Compare(strings.ToLower(a.DisplayName()), strings.ToLower(b.DisplayName()))
+			}
+		case propContentLength:
+			compare = func(a, b fs.File) int {
+				return int(max(min(a.Size()-b.Size(), 1), -1))
+			}
+		case propLastModified:
+			compare = func(a, b fs.File) int { return a.UpdatedAt().Compare(b.UpdatedAt()) }
+		case propCreationDate:
+			compare = func(a, b fs.File) int { return a.CreatedAt().Compare(b.CreatedAt()) }
+		default:
+			return nil, errUnsupportedSearch
+		}
+
+		if order.Descending != nil {
+			asc := compare
+			compare = func(a, b fs.File) int { return asc(b, a) }
+		}
+		compares = append(compares, compare)
+	}
+
+	return func(a, b fs.File) bool {
+		for _, compare := range compares {
+			if c := compare(a, b); c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	}, nil
+}
+
+func handleSearch(c *gin.Context, user *ent.User, fm manager.FileManager) (status int, err error) {
+	bs, status, err := readSearchRequest(c.Request.Body)
+	if err != nil {
+		return status, err
+	}
+
+	match := func(f fs.File) bool { return true }
+	if bs.Where != nil {
+		if match, err = bs.Where.expr.compile(); err != nil {
+			return http.StatusUnprocessableEntity, err
+		}
+	}
+
+	var less func(a, b fs.File) bool
+	if bs.OrderBy != nil && len(bs.OrderBy.Order) > 0 {
+		if less, err = searchLess(bs.OrderBy.Order); err != nil {
+			return http.StatusUnprocessableEntity, err
+		}
+	}
+
+	scope := bs.From.Scope[0]
+	depth := infiniteDepth
+	if scope.Depth != "" {
+		if depth = parseDepth(scope.Depth); depth == invalidDepth {
+			return http.StatusBadRequest, errInvalidDepth
+		}
+	}
+
+	scopeUrl, err := url.Parse(strings.TrimSpace(scope.Href))
+	if err != nil {
+		return http.StatusBadRequest, errInvalidSearch
+	}
+	if scopeUrl.Host != "" && scopeUrl.Host != c.Request.Host {
+		return http.StatusBadGateway, errInvalidSearch
+	}
+
+	href, reqPath, status, err := stripPrefix(c, scopeUrl.Path, user)
+	if err != nil {
+		return status, err
+	}
+
+	_, scopeUri, err := fm.SharedAddressTranslation(c, reqPath)
+	if err != nil {
+		return purposeStatusCodeFromError(err), err
+	}
+
+	// Results are buffered for ordering, the amount is capped in the same way as PROPFIND.
+	limit := max(user.Edges.Group.Settings.MaxWalkedFiles, 1)
+	if bs.Limit != nil && bs.Limit.NResults > 0 && less == nil {
+		limit = min(limit, bs.Limit.NResults)
+	}
+
+	hits := make([]searchHit, 0)
+	collect := func(f fs.File, level int) error {
+		if level == 0 || !match(f) {
+			return nil
+		}
+		if len(hits) >= limit {
+			return errSearchTruncated
+		}
+		hits = append(hits, searchHit{file: f, level: level})
+		return nil
+	}
+
+	q := url.Values{}
+	if bs.Where != nil {
+		bs.Where.expr.prefilter(q)
+	}
+
+	if depth == infiniteDepth && len(q) > 0 {
+		// Narrow down candidates with the internal search, so that the whole tree is not walked.
+		searchUri := scopeUri.SetQuery(q.Encode())
+		baseLevel := len(scopeUri.Elements())
+		args := &manager.ListArgs{PageSize: searchPageSize}
+		for err == nil {
+			_, res, listErr := fm.List(c, searchUri, args)
+			if listErr != nil {
+				return purposeStatusCodeFromError(listErr), listErr
+			}
+
+			for _, f := range res.Files {
+				if err = collect(f, len(f.Uri(false).Elements())-baseLevel); err != nil {
+					break
+				}
+			}
+
+			if res.Pagination == nil || res.Pagination.NextPageToken == "" {
+				break
+			}
+			args.PageToken = res.Pagination.NextPageToken
+		}
+	} else {
+		err = fm.Walk(c, scopeUri, depth, collect, dbfs.WithFilePublicMetadata())
+	}
+
+	truncated := errors.Is(err, errSearchTruncated)
+	if err != nil && !truncated {
+		return purposeStatusCodeFromError(err), err
+	}
+
+	if less != nil {
+		sort.SliceStable(hits, func(i, j int) bool {
+			return less(hits[i].file, hits[j].file)
+		})
+		if bs.Limit != nil && bs.Limit.NResults > 0 && len(hits) > bs.Limit.NResults {
+			hits = hits[:bs.Limit.NResults]
+			truncated = true
+		}
+	}
+
+	pf := propfind{Allprop: bs.Select.Allprop, Prop: bs.Select.Prop}
+	mw := multistatusWriter{w: c.Writer}
+	if err := mw.writeHeader(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	write := propfindWalkFunc(c, fm, &mw, pf, href)
+	for _, hit := range hits {
+		if err := write(hit.file, hit.level); err != nil {
+			return http.StatusInternalServerError, err
+		}
+	}
+
+	if truncated {
+		// Section 2.4 says that truncated results must be reported with a 507 response
+		// for the request-URI.
+		if err := mw.write(&response{
+			Href:   []string{(&url.URL{Path: c.Request.URL.Path}).EscapedPath()},
+			Status: fmt.Sprintf("HTTP/1.1 %d %s", StatusInsufficientStorage, StatusText(StatusInsufficientStorage)),
+		}); err != nil {
+			return http.StatusInternalServerError, err
+		}
+	}
+
+	if err := mw.close(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return 0, nil
+}
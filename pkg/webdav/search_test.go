@@ -0,0 +1,114 @@
+package webdav
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/stretchr/testify/assert"
+)
+
+type testSearchFile struct {
+	fs.File
+	name    string
+	size    int64
+	folder  bool
+	updated time.Time
+}
+
+func (f *testSearchFile) DisplayName() string  { return f.name }
+func (f *testSearchFile) Size() int64          { return f.size }
+func (f *testSearchFile) UpdatedAt() time.Time { return f.updated }
+func (f *testSearchFile) CreatedAt() time.Time { return f.updated }
+func (f *testSearchFile) Type() types.FileType {
+	if f.folder {
+		return types.FileTypeFolder
+	}
+	return types.FileTypeFile
+}
+
+func TestReadSearchRequest(t *testing.T) {
+	a := assert.New(t)
+	body := `<?xml version="1.0"?>
+<d:searchrequest xmlns:d="DAV:">
+  <d:basicsearch>
+    <d:select><d:prop><d:displayname/></d:prop></d:select>
+    <d:from><d:scope><d:href>/dav/docs</d:href><d:depth>infinity</d:depth></d:scope></d:from>
+    <d:where>
+      <d:and>
+        <d:like><d:prop><d:displayname/></d:prop><d:literal>%report%.pdf</d:literal></d:like>
+        <d:not><d:is-collection/></d:not>
+        <d:gt><d:prop><d:getcontentlength/></d:prop><d:literal>1024</d:literal></d:gt>
+        <d:gte><d:prop><d:getlastmodified/></d:prop><d:literal>Mon, 02 Jan 2023 15:04:05 GMT</d:literal></d:gte>
+      </d:and>
+    </d:where>
+    <d:orderby><d:order><d:prop><d:getcontentlength/></d:prop><d:descending/></d:order></d:orderby>
+    <d:limit><d:nresults>10</d:nresults></d:limit>
+  </d:basicsearch>
+</d:searchrequest>`
+
+	bs, _, err := readSearchRequest(strings.NewReader(body))
+	a.NoError(err)
+	a.Equal("/dav/docs", bs.From.Scope[0].Href)
+	a.Equal(10, bs.Limit.NResults)
+	a.Len(bs.OrderBy.Order, 1)
+	a.NotNil(bs.OrderBy.Order[0].Descending)
+
+	match, err := bs.Where.expr.compile()
+	a.NoError(err)
+	updated := time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC)
+	a.True(match(&testSearchFile{name: "Annual Report 2022.pdf", size: 2048, updated: updated}))
+	a.False(match(&testSearchFile{name: "Annual Report 2022.pdf", size: 512, updated: updated}))
+	a.False(match(&testSearchFile{name: "Annual Report 2022.pdf", size: 2048, updated: updated, folder: true}))
+	a.False(match(&testSearchFile{name: "Annual Report 2022.docx", size: 2048, updated: updated}))
+	a.False(match(&testSearchFile{name: "Annual Report 2022.pdf", size: 2048, updated: updated.AddDate(-1, 0, 0)}))
+
+	q := url.Values{}
+	bs.Where.expr.prefilter(q)
+	a.Equal([]string{"report", ".pdf"}, q[fs.QuerySearchName])
+	a.Equal("file", q.Get(fs.QuerySearchType))
+	a.Equal("1024", q.Get(fs.QuerySearchSizeGte))
+	a.Equal("1672671845", q.Get(fs.QuerySearchUpdatedGte))
+}
+
+func TestReadSearchRequestInvalid(t *testing.T) {
+	a := assert.New(t)
+	_, _, err := readSearchRequest(strings.NewReader(`<d:searchrequest xmlns:d="DAV:"><d:basicsearch>
+<d:select><d:prop><d:displayname/></d:prop></d:select>
+<d:from><d:scope><d:href>/dav</d:href></d:scope></d:from>
+<d:where><d:is-collection/><d:is-collection/></d:where>
+</d:basicsearch></d:searchrequest>`))
+	a.Error(err)
+
+	_, _, err = readSearchRequest(strings.NewReader(`<d:searchrequest xmlns:d="DAV:"><d:basicsearch>
+<d:select><d:prop><d:displayname/></d:prop></d:select>
+<d:from></d:from>
+</d:basicsearch></d:searchrequest>`))
+	a.ErrorIs(err, errUnsupportedSearch)
+
+	_, err = (&searchExpr{op: "like", prop: propContentLength, literal: "1%"}).compile()
+	a.ErrorIs(err, errUnsupportedSearch)
+}
+
+func TestLikePattern(t *testing.T) {
+	a := assert.New(t)
+	a.True(likePattern("%.JPG").MatchString("photo.jpg"))
+	a.True(likePattern("a_c").MatchString("abc"))
+	a.False(likePattern("a_c").MatchString("abbc"))
+	a.True(likePattern(`100\%`).MatchString("100%"))
+	a.False(likePattern(`100\%`).MatchString("1000"))
+}
+
+func TestSearchLess(t *testing.T) {
+	a := assert.New(t)
+	less, err := searchLess([]searchOrder{{Prop: propfindProps{propContentLength}, Descending: &struct{}{}}})
+	a.NoError(err)
+	a.True(less(&testSearchFile{size: 2}, &testSearchFile{size: 1}))
+	a.False(less(&testSearchFile{size: 1}, &testSearchFile{size: 2}))
+
+	_, err = searchLess([]searchOrder{{Prop: propfindProps{{Space: "DAV:", Local: "getetag"}}}})
+	a.ErrorIs(err, errUnsupportedSearch)
+}
@@ -0,0 +1,164 @@
+package webdav
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// sharesCollection is the name of the read-only collection exposing shares received by user,
+	// i.e. share links saved into "shared with me".
+	sharesCollection = "shares"
+	shareRootsCtxKey = "dav_share_roots"
+)
+
+// sharesEnabled returns whether received shares are exposed to current DAV account.
+func sharesEnabled(u *ent.User) bool {
+	options := u.Edges.DavAccounts[0].Options
+	return options != nil && options.Enabled(int(types.DavAccountShares))
+}
+
+// sharesName returns the name of received share request path p points to, and the path inside
+// the share. ok is false if p is not inside the shares collection. An empty name means the
+// collection itself.
+func sharesName(p string, u *ent.User) (name, rest string, ok bool) {
+	r := strings.TrimPrefix(p, davPrefix)
+	if u == nil || len(r) == len(p) {
+		return "", "", false
+	}
+
+	first, remaining, _ := strings.Cut(strings.Trim(r, fs.Separator), fs.Separator)
+	if first != sharesCollection || !sharesEnabled(u) {
+		return "", "", false
+	}
+
+	name, rest, _ = strings.Cut(remaining, fs.Separator)
+	return name, rest, true
+}
+
+func sharedWithMeRootUri() *fs.URI {
+	res, _ := fs.NewUriFromString(fmt.Sprintf("%s://%s", constants.CloudreveScheme, constants.FileSystemSharedWithMe))
+	return res
+}
+
+// shareRoots returns share URIs of received shares keyed by their names. Shares are loaded once
+// per request, if names are duplicated, only the first one is exposed.
+func shareRoots(c *gin.Context, u *ent.User) (map[string]*fs.URI, error) {
+	if roots, ok := c.Get(shareRootsCtxKey); ok {
+		return roots.(map[string]*fs.URI), nil
+	}
+
+	fm := manager.NewFileManager(dependency.FromContext(c), u)
+	defer fm.Recycle()
+
+	roots := make(map[string]*fs.URI)
+	err := listAll(c, fm, sharedWithMeRootUri(), func(f fs.File) error {
+		redirect, ok := f.Metadata()[dbfs.MetadataSharedRedirect]
+		if _, existed := roots[f.DisplayName()]; !ok || existed {
+			return nil
+		}
+
+		uri, err := fs.NewUriFromString(redirect)
+		if err != nil {
+			logging.FromContext(c).Debug("Invalid redirect uri of received share %q: %s", f.DisplayName(), err)
+			return nil
+		}
+
+		roots[f.DisplayName()] = uri
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.Set(shareRootsCtxKey, roots)
+	return roots, nil
+}
+
+// checkSharesWritable rejects requests modifying received shares, they are always read-only.
+func checkSharesWritable(c *gin.Context, u *ent.User) (int, error) {
+	if _, _, ok := sharesName(c.Request.URL.Path, u); ok {
+		switch c.Request.Method {
+		case http.MethodOptions, http.MethodGet, http.MethodHead, "PROPFIND", methodSearch:
+		default:
+			return http.StatusForbidden, errReadOnlyShares
+		}
+	}
+
+	switch c.Request.Method {
+	case "COPY", "MOVE", "MKCOL":
+		if dst, err := url.Parse(c.Request.Header.Get("Destination")); err == nil {
+			if _, _, ok := sharesName(dst.Path, u); ok {
+				return http.StatusForbidden, errReadOnlyShares
+			}
+		}
+	}
+
+	return 0, nil
+}
+
+// handleSharesPropfind lists received shares as top-level collections.
+func handleSharesPropfind(c *gin.Context, user *ent.User, fm manager.FileManager) (int, error) {
+	depth := infiniteDepth
+	if hdr := c.Request.Header.Get("Depth"); hdr != "" {
+		depth = parseDepth(hdr)
+		if depth == invalidDepth {
+			return http.StatusBadRequest, errInvalidDepth
+		}
+	}
+	pf, status, err := readPropfind(c.Request.Body)
+	if err != nil {
+		return status, err
+	}
+
+	mw := multistatusWriter{w: c.Writer}
+	if err := mw.write(makePropstatResponse(util.FillSlash(path.Join(davPrefix, sharesCollection)), virtualRootProps(pf))); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if depth != 0 {
+		childDepth := depth
+		if depth == 1 {
+			childDepth = 0
+		}
+
+		roots, err := shareRoots(c, user)
+		if err != nil {
+			return purposeStatusCodeFromError(err), err
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(roots)) {
+			_, target, err := fm.SharedAddressTranslation(c, roots[name])
+			if err != nil {
+				// Expired or deleted shares are hidden.
+				logging.FromContext(c).Debug("Failed to get received share %q: %s", name, err)
+				continue
+			}
+
+			if err := fm.Walk(c, target, childDepth, propfindWalkFunc(c, fm, &mw, pf, path.Join(sharesCollection, name))); err != nil {
+				return purposeStatusCodeFromError(err), err
+			}
+		}
+	}
+
+	if err := mw.close(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return 0, nil
+}
@@ -0,0 +1,63 @@
+package webdav
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSharesName(t *testing.T) {
+	a := assert.New(t)
+	u := testDavUser("cloudreve://my")
+
+	// Received shares are opt-in.
+	_, _, ok := sharesName("/dav/shares/photos", u)
+	a.False(ok)
+
+	options := boolset.BooleanSet{}
+	boolset.Set(types.DavAccountShares, true, &options)
+	u.Edges.DavAccounts[0].Options = &options
+
+	name, rest, ok := sharesName("/dav/shares", u)
+	a.True(ok)
+	a.Equal("", name)
+	a.Equal("", rest)
+
+	name, rest, ok = sharesName("/dav/shares/photos/2024/a.jpg", u)
+	a.True(ok)
+	a.Equal("photos", name)
+	a.Equal("2024/a.jpg", rest)
+
+	_, _, ok = sharesName("/dav/docs/shares/photos", u)
+	a.False(ok)
+}
+
+func TestCheckSharesWritable(t *testing.T) {
+	a := assert.New(t)
+	u := testDavUser("cloudreve://my")
+	options := boolset.BooleanSet{}
+	boolset.Set(types.DavAccountShares, true, &options)
+	u.Edges.DavAccounts[0].Options = &options
+
+	check := func(method, p, dst string) int {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(method, p, nil)
+		if dst != "" {
+			c.Request.Header.Set("Destination", dst)
+		}
+		status, _ := checkSharesWritable(c, u)
+		return status
+	}
+
+	a.Equal(0, check("PROPFIND", "/dav/shares", ""))
+	a.Equal(0, check(http.MethodGet, "/dav/shares/photos/a.jpg", ""))
+	a.Equal(http.StatusForbidden, check(http.MethodPut, "/dav/shares/photos/a.jpg", ""))
+	a.Equal(http.StatusForbidden, check("COPY", "/dav/shares/photos/a.jpg", "/dav/a.jpg"))
+	a.Equal(http.StatusForbidden, check("MOVE", "/dav/a.jpg", "/dav/shares/photos/a.jpg"))
+	a.Equal(0, check("MOVE", "/dav/a.jpg", "/dav/b.jpg"))
+}
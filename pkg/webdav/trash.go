@@ -0,0 +1,184 @@
+package webdav
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// trashCollection is the name of the read-only collection exposing user's trash bin.
+	trashCollection = ".trash"
+	methodRestore   = "RESTORE"
+	listPageSize    = 1000
+)
+
+// trashEnabled returns whether the trash collection is exposed to current DAV account. Trash
+// items are not filtered by their original location, so only accounts with access to the
+// whole "my" file system can see them.
+func trashEnabled(u *ent.User) bool {
+	uris := []string{u.Edges.DavAccounts[0].URI}
+	if roots := accountRoots(u); len(roots) > 0 {
+		uris = uris[:0]
+		for _, root := range roots {
+			uris = append(uris, root.Uri)
+		}
+	}
+
+	for _, raw := range uris {
+		uri, err := fs.NewUriFromString(raw)
+		if err == nil && uri.FileSystem() == constants.FileSystemMy && uri.Path() == fs.Separator {
+			return true
+		}
+	}
+
+	return false
+}
+
+// trashName returns the name of trash item request path p points to, ok is false if p is not
+// inside the trash collection. An empty name means the collection itself.
+func trashName(p string, u *ent.User) (name string, ok bool) {
+	r := strings.TrimPrefix(p, davPrefix)
+	if u == nil || len(r) == len(p) {
+		return "", false
+	}
+
+	first, rest, _ := strings.Cut(strings.Trim(r, fs.Separator), fs.Separator)
+	if first != trashCollection || !trashEnabled(u) {
+		return "", false
+	}
+
+	return rest, true
+}
+
+func trashRootUri() *fs.URI {
+	res, _ := fs.NewUriFromString(fmt.Sprintf("%s://%s", constants.CloudreveScheme, constants.FileSystemTrash))
+	return res
+}
+
+// checkTrashWritable rejects requests modifying the trash collection. Items can only leave
+// the trash by RESTORE or MOVE.
+func checkTrashWritable(c *gin.Context, u *ent.User) (int, error) {
+	if _, ok := trashName(c.Request.URL.Path, u); ok {
+		switch c.Request.Method {
+		case http.MethodOptions, http.MethodGet, http.MethodHead, "PROPFIND", "MOVE", methodRestore:
+		default:
+			return http.StatusForbidden, errReadOnlyTrash
+		}
+	}
+
+	switch c.Request.Method {
+	case "COPY", "MOVE", "MKCOL":
+		if dst, err := url.Parse(c.Request.Header.Get("Destination")); err == nil {
+			if _, ok := trashName(dst.Path, u); ok {
+				return http.StatusForbidden, errReadOnlyTrash
+			}
+		}
+	}
+
+	return 0, nil
+}
+
+// handleTrashPropfind lists items in the trash collection. The trash is a flat collection,
+// content of trashed folders is not listed.
+func handleTrashPropfind(c *gin.Context, user *ent.User, fm manager.FileManager) (int, error) {
+	depth := infiniteDepth
+	if hdr := c.Request.Header.Get("Depth"); hdr != "" {
+		depth = parseDepth(hdr)
+		if depth == invalidDepth {
+			return http.StatusBadRequest, errInvalidDepth
+		}
+	}
+	pf, status, err := readPropfind(c.Request.Body)
+	if err != nil {
+		return status, err
+	}
+
+	mw := multistatusWriter{w: c.Writer}
+	if err := mw.write(makePropstatResponse(util.FillSlash(path.Join(davPrefix, trashCollection)), virtualRootProps(pf))); err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	if depth != 0 {
+		walk := propfindWalkFunc(c, fm, &mw, pf, trashCollection)
+		if err := listAll(c, fm, trashRootUri(), func(f fs.File) error {
+			return walk(f, 1)
+		}); err != nil {
+			return purposeStatusCodeFromError(err), err
+		}
+	}
+
+	if err := mw.close(); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return 0, nil
+}
+
+// listAll calls fn for all children of uri, following pagination of the listing.
+func listAll(c *gin.Context, fm manager.FileManager, uri *fs.URI, fn func(f fs.File) error) error {
+	args := &manager.ListArgs{PageSize: listPageSize}
+	for {
+		_, res, err := fm.List(c, uri, args)
+		if err != nil {
+			return err
+		}
+
+		for _, f := range res.Files {
+			if err := fn(f); err != nil {
+				return err
+			}
+		}
+
+		if res.Pagination == nil || len(res.Files) == 0 {
+			return nil
+		}
+
+		if res.Pagination.IsCursor {
+			if res.Pagination.NextPageToken == "" {
+				return nil
+			}
+			args.PageToken = res.Pagination.NextPageToken
+		} else {
+			if (args.Page+1)*res.Pagination.PageSize >= res.Pagination.TotalItems {
+				return nil
+			}
+			args.Page++
+		}
+	}
+}
+
+// handleRestore moves a trash item back to its original location.
+func handleRestore(c *gin.Context, user *ent.User, fm manager.FileManager) (int, error) {
+	if name, ok := trashName(c.Request.URL.Path, user); !ok || name == "" {
+		return http.StatusMethodNotAllowed, errUnsupportedMethod
+	}
+
+	_, reqPath, status, err := stripPrefix(c, c.Request.URL.Path, user)
+	if err != nil {
+		return status, err
+	}
+
+	target, _, err := fm.SharedAddressTranslation(c, reqPath)
+	if err != nil {
+		return purposeStatusCodeFromError(err), err
+	}
+
+	if status := checkFileConditions(c, fm, target); status != 0 {
+		return status, nil
+	}
+
+	if err := fm.Restore(c, reqPath); err != nil {
+		return purposeStatusCodeFromError(err), err
+	}
+
+	return http.StatusNoContent, nil
+}
@@ -0,0 +1,57 @@
+package webdav
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func testDavUser(uri string) *ent.User {
+	return &ent.User{Edges: ent.UserEdges{DavAccounts: []*ent.DavAccount{{URI: uri}}}}
+}
+
+func TestTrashName(t *testing.T) {
+	a := assert.New(t)
+	u := testDavUser("cloudreve://my")
+
+	name, ok := trashName("/dav/.trash", u)
+	a.True(ok)
+	a.Equal("", name)
+
+	name, ok = trashName("/dav/.trash/a.txt_abc", u)
+	a.True(ok)
+	a.Equal("a.txt_abc", name)
+
+	_, ok = trashName("/dav/docs/.trash", u)
+	a.False(ok)
+
+	// Accounts scoped to a sub folder do not see the trash.
+	_, ok = trashName("/dav/.trash", testDavUser("cloudreve://my/docs"))
+	a.False(ok)
+}
+
+func TestCheckTrashWritable(t *testing.T) {
+	a := assert.New(t)
+	u := testDavUser("cloudreve://my")
+	check := func(method, p, dst string) int {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(method, p, nil)
+		if dst != "" {
+			c.Request.Header.Set("Destination", dst)
+		}
+		status, _ := checkTrashWritable(c, u)
+		return status
+	}
+
+	a.Equal(0, check("PROPFIND", "/dav/.trash", ""))
+	a.Equal(0, check(methodRestore, "/dav/.trash/a.txt", ""))
+	a.Equal(0, check("MOVE", "/dav/.trash/a.txt", "/dav/a.txt"))
+	a.Equal(http.StatusForbidden, check(http.MethodPut, "/dav/.trash/a.txt", ""))
+	a.Equal(http.StatusForbidden, check(http.MethodDelete, "/dav/.trash/a.txt", ""))
+	a.Equal(http.StatusForbidden, check("MOVE", "/dav/a.txt", "/dav/.trash/a.txt"))
+	a.Equal(http.StatusForbidden, check("COPY", "/dav/.trash/a.txt", "/dav/a.txt"))
+}
@@ -11,7 +11,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"path"
+	"strconv"
 	"strings"
 	"time"
 
@@ -29,6 +29,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/stats"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/gin-gonic/gin"
 	"github.com/samber/lo"
@@ -36,21 +37,64 @@ import (
 )
 
 const (
-	davPrefix = "/dav"
+	davPrefix     = "/dav"
+	headerOCMtime = "X-OC-Mtime"
 )
 
-func stripPrefix(p string, u *ent.User) (string, *fs.URI, int, error) {
-	base, err := fs.NewUriFromString(u.Edges.DavAccounts[0].URI)
+func stripPrefix(c *gin.Context, p string, u *ent.User) (string, *fs.URI, int, error) {
+	baseUri, rest := u.Edges.DavAccounts[0].URI, ""
+	prefix := davPrefix
+	r := strings.TrimPrefix(p, prefix)
+	if len(r) == len(p) {
+		return "", nil, http.StatusNotFound, errPrefixMismatch
+	}
+	r = strings.TrimPrefix(r, fs.Separator)
+	rest = r
+
+	// Trash items are resolved in user's trash bin.
+	if name, ok := trashName(p, u); ok {
+		if name == "" {
+			return "", nil, http.StatusMethodNotAllowed, errTrashRoot
+		}
+		return r, trashRootUri().JoinRaw(name), http.StatusOK, nil
+	}
+
+	// Received shares are resolved to their share URIs.
+	if name, rest, ok := sharesName(p, u); ok {
+		if name == "" {
+			return "", nil, http.StatusMethodNotAllowed, errSharesRoot
+		}
+
+		roots, err := shareRoots(c, u)
+		if err != nil {
+			return "", nil, http.StatusInternalServerError, err
+		}
+
+		base, found := roots[name]
+		if !found {
+			return "", nil, http.StatusNotFound, errNoSuchShare
+		}
+		return r, base.JoinRaw(util.RemoveSlash(rest)), http.StatusOK, nil
+	}
+
+	// Resolve mount root if the account exposes multiple roots.
+	if roots := accountRoots(u); len(roots) > 0 {
+		root, remaining, virtual := matchRoot(roots, r)
+		if virtual {
+			return "", nil, http.StatusMethodNotAllowed, errVirtualRoot
+		}
+		if root == nil {
+			return "", nil, http.StatusNotFound, errNoSuchRoot
+		}
+		baseUri, rest = root.Uri, remaining
+	}
+
+	base, err := fs.NewUriFromString(baseUri)
 	if err != nil {
 		return "", nil, http.StatusInternalServerError, err
 	}
 
-	prefix := davPrefix
-	if r := strings.TrimPrefix(p, prefix); len(r) < len(p) {
-		r = strings.TrimPrefix(r, fs.Separator)
-		return r, base.JoinRaw(util.RemoveSlash(r)), http.StatusOK, nil
-	}
-	return "", nil, http.StatusNotFound, errPrefixMismatch
+	return r, base.JoinRaw(util.RemoveSlash(rest)), http.StatusOK, nil
 }
 
 func ServeHTTP(c *gin.Context) {
@@ -59,29 +103,67 @@ func ServeHTTP(c *gin.Context) {
 	fm := manager.NewFileManager(dep, u)
 	defer fm.Recycle()
 
-	status, err := http.StatusBadRequest, errUnsupportedMethod
-
-	switch c.Request.Method {
-	case "OPTIONS":
-		status, err = handleOptions(c, u, fm)
-	case "GET", "HEAD", "POST":
-		status, err = handleGetHeadPost(c, u, fm)
-	case "DELETE":
-		status, err = handleDelete(c, u, fm)
-	case "PUT":
-		status, err = handlePut(c, u, fm)
-	case "MKCOL":
-		status, err = handleMkcol(c, u, fm)
-	case "COPY", "MOVE":
-		status, err = handleCopyMove(c, u, fm)
-	case "LOCK":
-		status, err = handleLock(c, u, fm)
-	case "UNLOCK":
-		status, err = handleUnlock(c, u, fm)
-	case "PROPFIND":
-		status, err = handlePropfind(c, u, fm)
-	case "PROPPATCH":
-		status, err = handleProppatch(c, u, fm)
+	var (
+		status int
+		err    error
+	)
+	if u != nil {
+		if dep.SettingProvider().DavAuditLog(c) {
+			body := &countingBody{ReadCloser: c.Request.Body}
+			c.Request.Body = body
+			defer func() {
+				recordAccountAudit(c, dep, u, body.n)
+			}()
+		}
+
+		status, err = limitAccount(c, dep, u.Edges.DavAccounts[0])
+	}
+	if err == nil {
+		status, err = checkRootWritable(c, u)
+	}
+	if err == nil {
+		status, err = checkTrashWritable(c, u)
+	}
+	if err == nil {
+		status, err = checkSharesWritable(c, u)
+	}
+	if err != nil {
+		c.Writer.WriteHeader(status)
+		c.Writer.Write([]byte(StatusText(status)))
+		dep.Logger().Debug("WebDAV request failed with error: %s", err)
+		return
+	}
+
+	status, err = http.StatusBadRequest, errUnsupportedMethod
+	if _, _, ok := uploadsPath(c.Request.URL.Path); ok && u != nil && c.Request.Method != "OPTIONS" {
+		status, err = handleChunkedUpload(c, u, fm)
+	} else {
+		switch c.Request.Method {
+		case "OPTIONS":
+			status, err = handleOptions(c, u, fm)
+		case "GET", "HEAD", "POST":
+			status, err = handleGetHeadPost(c, u, fm)
+		case "DELETE":
+			status, err = handleDelete(c, u, fm)
+		case "PUT":
+			status, err = handlePut(c, u, fm)
+		case "MKCOL":
+			status, err = handleMkcol(c, u, fm)
+		case "COPY", "MOVE":
+			status, err = handleCopyMove(c, u, fm)
+		case "LOCK":
+			status, err = handleLock(c, u, fm)
+		case "UNLOCK":
+			status, err = handleUnlock(c, u, fm)
+		case "PROPFIND":
+			status, err = handlePropfind(c, u, fm)
+		case "PROPPATCH":
+			status, err = handleProppatch(c, u, fm)
+		case methodRestore:
+			status, err = handleRestore(c, u, fm)
+		case methodSearch:
+			status, err = handleSearch(c, u, fm)
+		}
 	}
 	if status != 0 {
 		c.Writer.WriteHeader(status)
@@ -190,7 +272,7 @@ func confirmLock(c *gin.Context, fm manager.FileManager, user *ent.User, srcAnc,
 }
 
 func handleMkcol(c *gin.Context, user *ent.User, fm manager.FileManager) (status int, err error) {
-	_, reqPath, status, err := stripPrefix(c.Request.URL.Path, user)
+	_, reqPath, status, err := stripPrefix(c, c.Request.URL.Path, user)
 	if err != nil {
 		return status, err
 	}
@@ -220,7 +302,7 @@ func handleMkcol(c *gin.Context, user *ent.User, fm manager.FileManager) (status
 }
 
 func handlePut(c *gin.Context, user *ent.User, fm manager.FileManager) (status int, err error) {
-	_, reqPath, status, err := stripPrefix(c.Request.URL.Path, user)
+	_, reqPath, status, err := stripPrefix(c, c.Request.URL.Path, user)
 	if err != nil {
 		return status, err
 	}
@@ -230,6 +312,15 @@ func handlePut(c *gin.Context, user *ent.User, fm manager.FileManager) (status i
 		return purposeStatusCodeFromError(err), err
 	}
 
+	// Ancestor is the closest existing parent if target does not exist.
+	existing := ancestor
+	if err != nil {
+		existing = nil
+	}
+	if status := checkFileConditions(c, fm, existing); status != 0 {
+		return status, nil
+	}
+
 	release, ls, status, err := confirmLock(c, fm, user, ancestor, nil, uri, nil)
 	if err != nil {
 		return status, err
@@ -237,8 +328,6 @@ func handlePut(c *gin.Context, user *ent.User, fm manager.FileManager) (status i
 	defer release()
 
 	ctx := fs.LockSessionToContext(c, ls)
-	// TODO(rost): Support the If-Match, If-None-Match headers? See bradfitz'
-	// comments in http.checkEtag.
 
 	rc, fileSize, err := request.SniffContentLength(c.Request)
 	if err != nil {
@@ -255,9 +344,21 @@ func handlePut(c *gin.Context, user *ent.User, fm manager.FileManager) (status i
 		Mode: fs.ModeOverwrite,
 	}
 
+	// Sync clients like rclone preserve modification time with ownCloud's X-OC-Mtime header.
+	mtimeAccepted := false
+	if mtime, err := strconv.ParseInt(c.Request.Header.Get(headerOCMtime), 10, 64); err == nil && mtime > 0 {
+		lastModified := time.Unix(mtime, 0)
+		fileData.Props.LastModified = &lastModified
+		mtimeAccepted = true
+	}
+
 	m := manager.NewFileManager(dependency.FromContext(ctx), user)
 	defer m.Recycle()
 
+	if node := assertionNode(c); node != 0 {
+		return assertUpload(ctx, c, m, fileData, mtimeAccepted, node)
+	}
+
 	// Update file
 	res, err := m.Update(ctx, fileData)
 	if err != nil {
@@ -270,14 +371,29 @@ func handlePut(c *gin.Context, user *ent.User, fm manager.FileManager) (status i
 	}
 
 	c.Writer.Header().Set("ETag", etag)
+	if mtimeAccepted {
+		c.Writer.Header().Set(headerOCMtime, "accepted")
+	}
 	return http.StatusCreated, nil
 }
 
 func handleOptions(c *gin.Context, user *ent.User, fm manager.FileManager) (status int, err error) {
 	allow := []string{"OPTIONS", "LOCK", "PUT", "MKCOL"}
 
-	if user != nil {
-		_, reqPath, status, err := stripPrefix(c.Request.URL.Path, user)
+	if user != nil && isVirtualRoot(c.Request.URL.Path, user) {
+		allow = []string{"OPTIONS", "PROPFIND"}
+	} else if name, ok := trashName(c.Request.URL.Path, user); ok {
+		allow = []string{"OPTIONS", "PROPFIND"}
+		if name != "" {
+			allow = append(allow, "GET", "HEAD", "MOVE", methodRestore)
+		}
+	} else if name, _, ok := sharesName(c.Request.URL.Path, user); ok {
+		allow = []string{"OPTIONS", "PROPFIND"}
+		if name != "" {
+			allow = append(allow, "GET", "HEAD", methodSearch)
+		}
+	} else if user != nil {
+		_, reqPath, status, err := stripPrefix(c, c.Request.URL.Path, user)
 		if err != nil {
 			return status, err
 		}
@@ -296,6 +412,10 @@ func handleOptions(c *gin.Context, user *ent.User, fm manager.FileManager) (stat
 				allow = append(allow, "COPY", "PROPFIND")
 				if target.Type() == types.FileTypeFile {
 					allow = append(allow, "GET", "HEAD", "POST")
+				} else {
+					allow = append(allow, methodSearch)
+					// http://www.webdav.org/specs/rfc5323.html#rfc.section.3.2
+					c.Writer.Header().Set("DASL", "<DAV:basicsearch>")
 				}
 			}
 			if update || create {
@@ -321,7 +441,7 @@ func handleOptions(c *gin.Context, user *ent.User, fm manager.FileManager) (stat
 }
 
 func handleGetHeadPost(c *gin.Context, user *ent.User, fm manager.FileManager) (status int, err error) {
-	_, reqPath, status, err := stripPrefix(c.Request.URL.Path, user)
+	_, reqPath, status, err := stripPrefix(c, c.Request.URL.Path, user)
 	if err != nil {
 		return status, err
 	}
@@ -335,6 +455,10 @@ func handleGetHeadPost(c *gin.Context, user *ent.User, fm manager.FileManager) (
 		return http.StatusMethodNotAllowed, nil
 	}
 
+	if status := checkFileConditions(c, fm, target); status != 0 {
+		return status, nil
+	}
+
 	es, err := fm.GetEntitySource(c, target.PrimaryEntityID())
 	if err != nil {
 		return purposeStatusCodeFromError(err), err
@@ -342,7 +466,15 @@ func handleGetHeadPost(c *gin.Context, user *ent.User, fm manager.FileManager) (
 
 	defer es.Close()
 
-	es.Apply(entitysource.WithSpeedLimit(int64(user.Edges.Group.SpeedLimit)))
+	es.Apply(entitysource.WithSpeedLimit(speedLimit(user)))
+	if stats.CountsAsDownload(c.Request) {
+		dependency.FromContext(c).StatsRecorder().Downloaded(es.Entity().Size())
+	}
+
+	if node := assertionNode(c); node != 0 {
+		return assertRead(c, user, fm, target, es, node)
+	}
+
 	if es.ShouldInternalProxy() ||
 		(user.Edges.DavAccounts[0].Options.Enabled(int(types.DavAccountProxy)) &&
 			user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionWebDAVProxy))) {
@@ -392,7 +524,7 @@ func handleLock(c *gin.Context, user *ent.User, fm manager.FileManager) (retStat
 		return status, err
 	}
 
-	href, reqPath, status, err := stripPrefix(c.Request.URL.Path, user)
+	href, reqPath, status, err := stripPrefix(c, c.Request.URL.Path, user)
 	if err != nil {
 		return status, err
 	}
@@ -441,12 +573,17 @@ func handleLock(c *gin.Context, user *ent.User, fm manager.FileManager) (retStat
 			Duration:  duration,
 			Owner:     lock.Owner{Application: lock.Application{InnerXML: li.Owner.InnerXML}},
 			ZeroDepth: depth == 0,
+			Shared:    li.Shared != nil,
 		}
 		app := lock.Application{
 			Type:     string(fs.ApplicationDAV),
 			InnerXML: li.Owner.InnerXML,
 		}
-		ls, err := fm.Lock(c, duration, user, depth == 0, app, uri, "")
+		lockCtx := context.Context(c)
+		if ld.Shared {
+			lockCtx = context.WithValue(lockCtx, dbfs.SharedLockCtx{}, true)
+		}
+		ls, err := fm.Lock(lockCtx, duration, user, depth == 0, app, uri, "")
 		if err != nil {
 			if errors.Is(err, lock.ErrLocked) {
 				return StatusLocked, err
@@ -488,7 +625,23 @@ func handleLock(c *gin.Context, user *ent.User, fm manager.FileManager) (retStat
 }
 
 func handlePropfind(c *gin.Context, user *ent.User, fm manager.FileManager) (status int, err error) {
-	href, reqPath, status, err := stripPrefix(c.Request.URL.Path, user)
+	if isVirtualRoot(c.Request.URL.Path, user) {
+		return handleVirtualRootPropfind(c, user, fm)
+	}
+
+	if name, _, ok := sharesName(c.Request.URL.Path, user); ok && name == "" {
+		return handleSharesPropfind(c, user, fm)
+	}
+
+	trashItem := false
+	if name, ok := trashName(c.Request.URL.Path, user); ok {
+		if name == "" {
+			return handleTrashPropfind(c, user, fm)
+		}
+		trashItem = true
+	}
+
+	href, reqPath, status, err := stripPrefix(c, c.Request.URL.Path, user)
 	if err != nil {
 		return status, err
 	}
@@ -505,14 +658,34 @@ func handlePropfind(c *gin.Context, user *ent.User, fm manager.FileManager) (sta
 			return http.StatusBadRequest, errInvalidDepth
 		}
 	}
+	if trashItem {
+		// Trash is a flat collection, content of trashed folders is not exposed.
+		depth = 0
+	}
 	pf, status, err := readPropfind(c.Request.Body)
 	if err != nil {
 		return status, err
 	}
 
 	mw := multistatusWriter{w: c.Writer}
-	walkFn := func(f fs.File, level int) error {
-		var pstats []Propstat
+	if err := fm.Walk(c, targetPath, depth, propfindWalkFunc(c, fm, &mw, pf, href), dbfs.WithFilePublicMetadata()); err != nil {
+		return purposeStatusCodeFromError(err), err
+	}
+
+	closeErr := mw.close()
+	if closeErr != nil {
+		return http.StatusInternalServerError, closeErr
+	}
+	return 0, nil
+}
+
+// propfindWalkFunc writes properties of each walked file under href.
+func propfindWalkFunc(c *gin.Context, fm manager.FileManager, mw *multistatusWriter, pf propfind, href string) fs.WalkFunc {
+	return func(f fs.File, level int) error {
+		var (
+			pstats []Propstat
+			err    error
+		)
 		if pf.Propname != nil {
 			pnames, err := propnames(c, f, fm)
 			if err != nil {
@@ -532,31 +705,12 @@ func handlePropfind(c *gin.Context, user *ent.User, fm manager.FileManager) (sta
 			return err
 		}
 
-		p := path.Join(davPrefix, href)
-		elements := f.Uri(false).Elements()
-		for i := 0; i < level; i++ {
-			p = path.Join(p, elements[len(elements)-level+i])
-		}
-		if f.Type() == types.FileTypeFolder {
-			p = util.FillSlash(p)
-		}
-
-		return mw.write(makePropstatResponse(p, pstats))
-	}
-
-	if err := fm.Walk(c, targetPath, depth, walkFn, dbfs.WithFilePublicMetadata()); err != nil {
-		return purposeStatusCodeFromError(err), err
+		return mw.write(makePropstatResponse(rootHref(href, f, level), pstats))
 	}
-
-	closeErr := mw.close()
-	if closeErr != nil {
-		return http.StatusInternalServerError, closeErr
-	}
-	return 0, nil
 }
 
 func handleDelete(c *gin.Context, user *ent.User, fm manager.FileManager) (status int, err error) {
-	_, reqPath, status, err := stripPrefix(c.Request.URL.Path, user)
+	_, reqPath, status, err := stripPrefix(c, c.Request.URL.Path, user)
 	if err != nil {
 		return status, err
 	}
@@ -566,6 +720,10 @@ func handleDelete(c *gin.Context, user *ent.User, fm manager.FileManager) (statu
 		return purposeStatusCodeFromError(err), err
 	}
 
+	if status := checkFileConditions(c, fm, ancestor); status != 0 {
+		return status, nil
+	}
+
 	release, ls, status, err := confirmLock(c, fm, user, ancestor, nil, uri, nil)
 	if err != nil {
 		return status, err
@@ -595,7 +753,7 @@ func handleCopyMove(c *gin.Context, user *ent.User, fm manager.FileManager) (sta
 		return http.StatusBadGateway, errInvalidDestination
 	}
 
-	_, src, status, err := stripPrefix(c.Request.URL.Path, user)
+	_, src, status, err := stripPrefix(c, c.Request.URL.Path, user)
 	if err != nil {
 		return status, err
 	}
@@ -605,7 +763,11 @@ func handleCopyMove(c *gin.Context, user *ent.User, fm manager.FileManager) (sta
 		return purposeStatusCodeFromError(err), err
 	}
 
-	_, dst, status, err := stripPrefix(u.Path, user)
+	if status := checkFileConditions(c, fm, srcTarget); status != 0 {
+		return status, nil
+	}
+
+	_, dst, status, err := stripPrefix(c, u.Path, user)
 	if err != nil {
 		return status, err
 	}
@@ -674,8 +836,9 @@ func handleCopyMove(c *gin.Context, user *ent.User, fm manager.FileManager) (sta
 		return purposeStatusCodeFromError(err), err
 	}
 
-	if dstUri.Name() != srcUri.Name() {
-		if _, err := fm.Rename(ctx, dstFolderUri.Join(srcUri.Name()), dstUri.Name()); err != nil {
+	// Files moved out from trash get back their original name.
+	if srcName := srcTarget.DisplayName(); dstUri.Name() != srcName {
+		if _, err := fm.Rename(ctx, dstFolderUri.Join(srcName), dstUri.Name()); err != nil {
 			return purposeStatusCodeFromError(err), err
 		}
 	}
@@ -684,7 +847,7 @@ func handleCopyMove(c *gin.Context, user *ent.User, fm manager.FileManager) (sta
 }
 
 func handleProppatch(c *gin.Context, user *ent.User, fm manager.FileManager) (status int, err error) {
-	_, reqPath, status, err := stripPrefix(c.Request.URL.Path, user)
+	_, reqPath, status, err := stripPrefix(c, c.Request.URL.Path, user)
 	if err != nil {
 		return status, err
 	}
@@ -845,4 +1008,26 @@ var (
 	errRecursionTooDeep        = errors.New("webdav: recursion too deep")
 	errUnsupportedLockInfo     = errors.New("webdav: unsupported lock info")
 	errUnsupportedMethod       = errors.New("webdav: unsupported method")
+	errVirtualRoot             = errors.New("webdav: method not allowed on mount roots listing")
+	errNoSuchRoot              = errors.New("webdav: no such mount root")
+	errReadOnlyRoot            = errors.New("webdav: mount root is read-only")
+	errInvalidSearch           = errors.New("webdav: invalid search")
+	errUnsupportedSearch       = errors.New("webdav: unsupported search")
+	errRequestLimited          = errors.New("webdav: too many requests")
+	errTrashRoot               = errors.New("webdav: method not allowed on trash collection")
+	errReadOnlyTrash           = errors.New("webdav: trash collection is read-only")
+	errUploadsRoot             = errors.New("webdav: method not allowed on uploads collection")
+	errInvalidTransfer         = errors.New("webdav: invalid chunked upload transfer")
+	errNoSuchTransfer          = errors.New("webdav: no such chunked upload transfer")
+	errTransferExisted         = errors.New("webdav: chunked upload transfer already exists")
+	errTransferLocked          = errors.New("webdav: chunked upload transfer is being written")
+	errTransferDestination     = errors.New("webdav: destination does not match chunked upload transfer")
+	errTransferIncomplete      = errors.New("webdav: chunked upload transfer is incomplete")
+	errInvalidTotalLength      = errors.New("webdav: invalid OC-Total-Length")
+	errInvalidChunk            = errors.New("webdav: invalid chunk name")
+	errChunkOutOfOrder         = errors.New("webdav: chunk uploaded out of order")
+	errChunkTooLarge           = errors.New("webdav: chunks exceed total length")
+	errSharesRoot              = errors.New("webdav: method not allowed on shares collection")
+	errNoSuchShare             = errors.New("webdav: no such received share")
+	errReadOnlyShares          = errors.New("webdav: received shares are read-only")
 )
@@ -63,9 +63,8 @@ func readLockInfo(r io.Reader) (li lockInfo, status int, err error) {
 		}
 		return lockInfo{}, http.StatusBadRequest, err
 	}
-	// We only support exclusive (non-shared) write locks. In practice, these are
-	// the only types of locks that seem to matter.
-	if li.Exclusive == nil || li.Shared != nil || li.Write == nil {
+	// Exactly one of exclusive or shared scope is required, and write is the only lock type.
+	if (li.Exclusive == nil) == (li.Shared == nil) || li.Write == nil {
 		return lockInfo{}, http.StatusNotImplemented, errUnsupportedLockInfo
 	}
 	return li, 0, nil
@@ -87,18 +86,22 @@ func writeLockInfo(w io.Writer, token string, ld lock.LockDetails) (int, error)
 	if ld.ZeroDepth {
 		depth = "0"
 	}
+	scope := "exclusive"
+	if ld.Shared {
+		scope = "shared"
+	}
 	timeout := ld.Duration / time.Second
 	return fmt.Fprintf(w, "<?xml version=\"1.0\" encoding=\"utf-8\"?>\n"+
 		"<D:prop xmlns:D=\"DAV:\"><D:lockdiscovery><D:activelock>\n"+
 		"	<D:locktype><D:write/></D:locktype>\n"+
-		"	<D:lockscope><D:exclusive/></D:lockscope>\n"+
+		"	<D:lockscope><D:%s/></D:lockscope>\n"+
 		"	<D:depth>%s</D:depth>\n"+
 		"	<D:owner>%s</D:owner>\n"+
 		"	<D:timeout>Second-%d</D:timeout>\n"+
 		"	<D:locktoken><D:href>%s</D:href></D:locktoken>\n"+
 		"	<D:lockroot><D:href>/%s</D:href></D:lockroot>\n"+
 		"</D:activelock></D:lockdiscovery></D:prop>",
-		depth, ld.Owner.Application.InnerXML, timeout, escape(token), escape(ld.Root),
+		scope, depth, ld.Owner.Application.InnerXML, timeout, escape(token), escape(ld.Root),
 	)
 }
 
@@ -0,0 +1,330 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhookdelivery"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
+)
+
+// Event types sent to webhooks.
+const (
+	EventFileUploaded   = "file.uploaded"
+	EventFileDeleted    = "file.deleted"
+	EventFileRenamed    = "file.renamed"
+	EventShareCreated   = "share.created"
+	EventUserRegistered = "user.registered"
+	EventQuotaAlert     = "quota.alert"
+)
+
+// Events lists all event types can be subscribed.
+var Events = []string{
+	EventFileUploaded,
+	EventFileDeleted,
+	EventFileRenamed,
+	EventShareCreated,
+	EventUserRegistered,
+	EventQuotaAlert,
+}
+
+const (
+	// SignatureHeader carries "t=<unix timestamp>,v1=<hex HMAC-SHA256 of "<timestamp>.<body>">", signed
+	// with webhook secret.
+	SignatureHeader = constants.CrHeaderPrefix + "Webhook-Signature"
+	EventHeader     = constants.CrHeaderPrefix + "Webhook-Event"
+	DeliveryHeader  = constants.CrHeaderPrefix + "Webhook-Delivery"
+
+	// MaxAttempts is the number of attempts before a delivery is marked as failed.
+	MaxAttempts = 6
+	// DeliveryLogRetention is how long finished deliveries are kept.
+	DeliveryLogRetention = 30 * 24 * time.Hour
+
+	requestTimeout   = 10 * time.Second
+	retryBaseDelay   = 30 * time.Second
+	retryMaxDelay    = 6 * time.Hour
+	webhookCacheTTL  = 30 * time.Second
+	maxResponseError = 1024
+	retryBatchSize   = 100
+)
+
+type (
+	// Payload is the JSON body sent to webhooks.
+	Payload struct {
+		ID        string    `json:"id"`
+		Event     string    `json:"event"`
+		CreatedAt time.Time `json:"created_at"`
+		Data      any       `json:"data"`
+	}
+
+	// FileData describes a file in event payloads.
+	FileData struct {
+		ID      string `json:"id"`
+		Name    string `json:"name"`
+		Uri     string `json:"uri"`
+		Type    string `json:"type"`
+		Size    int64  `json:"size"`
+		OwnerID string `json:"owner_id"`
+	}
+
+	// UserData describes a user in event payloads.
+	UserData struct {
+		ID       string `json:"id"`
+		Email    string `json:"email"`
+		Nickname string `json:"nickname,omitempty"`
+	}
+
+	// FileEvent is the data of file.uploaded and file.renamed events.
+	FileEvent struct {
+		File    *FileData `json:"file"`
+		ActorID string    `json:"actor_id,omitempty"`
+		OldName string    `json:"old_name,omitempty"`
+	}
+
+	// DeleteEvent is the data of file.deleted event.
+	DeleteEvent struct {
+		Uris    []string `json:"uris"`
+		ActorID string   `json:"actor_id,omitempty"`
+		// Permanent is false if files are moved to trash bin.
+		Permanent bool `json:"permanent"`
+	}
+
+	// ShareEvent is the data of share.created event.
+	ShareEvent struct {
+		ShareID string     `json:"share_id"`
+		File    *FileData  `json:"file"`
+		Private bool       `json:"private"`
+		Expires *time.Time `json:"expires,omitempty"`
+	}
+
+	// UserEvent is the data of user.registered event.
+	UserEvent struct {
+		User *UserData `json:"user"`
+	}
+
+	// QuotaEvent is the data of quota.alert event.
+	QuotaEvent struct {
+		User    *UserData `json:"user"`
+		Percent int       `json:"percent"`
+		Used    int64     `json:"used"`
+		Total   int64     `json:"total"`
+	}
+
+	// Dispatcher sends events to subscribed webhooks.
+	Dispatcher interface {
+		// Dispatch records a delivery for each webhook subscribing the event and sends them in background.
+		Dispatch(ctx context.Context, event string, data any)
+		// Deliver sends the delivery and saves the result, failed ones are scheduled for retry.
+		Deliver(ctx context.Context, d *ent.WebhookDelivery) error
+		// RetryDue sends pending deliveries whose retry time has come, returns the number of attempts.
+		RetryDue(ctx context.Context) int
+		// Invalidate drops the cached webhook list, called after webhooks are changed.
+		Invalidate()
+	}
+)
+
+// BuildUserData returns the payload of given user.
+func BuildUserData(u *ent.User, hasher hashid.Encoder) *UserData {
+	return &UserData{
+		ID:       hashid.EncodeUserID(hasher, u.ID),
+		Email:    u.Email,
+		Nickname: u.Nick,
+	}
+}
+
+// NewDispatcher creates a new Dispatcher.
+func NewDispatcher(client inventory.WebhookClient, rc request.Client, l logging.Logger) Dispatcher {
+	return &dispatcher{client: client, rc: rc, l: l}
+}
+
+type dispatcher struct {
+	client inventory.WebhookClient
+	rc     request.Client
+	l      logging.Logger
+
+	mu       sync.Mutex
+	webhooks []*ent.Webhook
+	cachedAt time.Time
+}
+
+func (d *dispatcher) Dispatch(_ context.Context, event string, data any) {
+	// Sent in background, detached from the request context which is recycled once the request ends.
+	ctx := context.Background()
+	go func() {
+		webhooks, err := d.subscribers(ctx, event)
+		if err != nil {
+			d.l.Warning("Failed to list webhooks for event %q: %s", event, err)
+			return
+		}
+
+		if len(webhooks) == 0 {
+			return
+		}
+
+		body, err := json.Marshal(&Payload{
+			ID:        uuid.Must(uuid.NewV4()).String(),
+			Event:     event,
+			CreatedAt: time.Now(),
+			Data:      data,
+		})
+		if err != nil {
+			d.l.Warning("Failed to marshal webhook payload for event %q: %s", event, err)
+			return
+		}
+
+		for _, w := range webhooks {
+			// Retried by cron if the attempt below is interrupted.
+			delivery, err := d.client.CreateDelivery(ctx, w.ID, event, string(body), time.Now().Add(retryDelay(1)))
+			if err != nil {
+				d.l.Warning("Failed to create delivery for webhook %d: %s", w.ID, err)
+				continue
+			}
+
+			delivery.Edges.Webhook = w
+			if err := d.Deliver(ctx, delivery); err != nil {
+				d.l.Debug("Webhook %d delivery %d failed: %s", w.ID, delivery.ID, err)
+			}
+		}
+	}()
+}
+
+func (d *dispatcher) Deliver(ctx context.Context, delivery *ent.WebhookDelivery) error {
+	w := delivery.Edges.Webhook
+	if w == nil {
+		return fmt.Errorf("webhook of delivery %d not loaded", delivery.ID)
+	}
+
+	code, sendErr := d.send(ctx, w, delivery)
+	res := &inventory.WebhookDeliveryResult{
+		Status:       webhookdelivery.StatusSucceeded,
+		Attempts:     delivery.Attempts + 1,
+		ResponseCode: code,
+	}
+
+	if sendErr != nil {
+		res.Error = sendErr.Error()
+		res.Status = webhookdelivery.StatusFailed
+		if res.Attempts < MaxAttempts {
+			res.Status = webhookdelivery.StatusPending
+			next := time.Now().Add(retryDelay(res.Attempts))
+			res.NextAttemptAt = &next
+		}
+	}
+
+	if err := d.client.UpdateDelivery(ctx, delivery.ID, res); err != nil {
+		return fmt.Errorf("failed to save delivery result: %w", err)
+	}
+
+	return sendErr
+}
+
+func (d *dispatcher) RetryDue(ctx context.Context) int {
+	deliveries, err := d.client.ListDueDeliveries(ctx, time.Now(), retryBatchSize)
+	if err != nil {
+		d.l.Warning("Failed to list webhook deliveries to retry: %s", err)
+		return 0
+	}
+
+	for _, delivery := range deliveries {
+		if err := d.Deliver(ctx, delivery); err != nil {
+			d.l.Debug("Retry of webhook delivery %d failed: %s", delivery.ID, err)
+		}
+	}
+
+	return len(deliveries)
+}
+
+func (d *dispatcher) Invalidate() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.webhooks = nil
+}
+
+// subscribers returns enabled webhooks subscribing the event.
+func (d *dispatcher) subscribers(ctx context.Context, event string) ([]*ent.Webhook, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.webhooks == nil || time.Since(d.cachedAt) > webhookCacheTTL {
+		webhooks, err := d.client.ListEnabled(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		d.webhooks = webhooks
+		d.cachedAt = time.Now()
+	}
+
+	return lo.Filter(d.webhooks, func(w *ent.Webhook, _ int) bool {
+		return len(w.Events) == 0 || lo.Contains(w.Events, event)
+	}), nil
+}
+
+// send posts the payload to webhook, returns the response status code.
+func (d *dispatcher) send(ctx context.Context, w *ent.Webhook, delivery *ent.WebhookDelivery) (int, error) {
+	header := http.Header{
+		"Content-Type": []string{"application/json"},
+		EventHeader:    []string{delivery.Event},
+		DeliveryHeader: []string{strconv.Itoa(delivery.ID)},
+	}
+	if w.Secret != "" {
+		header.Set(SignatureHeader, Sign(w.Secret, time.Now(), []byte(delivery.Payload)))
+	}
+
+	resp := d.rc.Request(
+		"POST",
+		w.URL,
+		bytes.NewReader([]byte(delivery.Payload)),
+		request.WithContext(ctx),
+		request.WithHeader(header),
+		request.WithTimeout(requestTimeout),
+	)
+	if resp.Err != nil {
+		return 0, resp.Err
+	}
+
+	defer resp.Response.Body.Close()
+	code := resp.Response.StatusCode
+	if code < 200 || code >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Response.Body, maxResponseError))
+		return code, fmt.Errorf("webhook returns unexpected status code %d: %s", code, body)
+	}
+
+	return code, nil
+}
+
+// Sign returns the signature header value of the payload body sent at given time.
+func Sign(secret string, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts + "."))
+	mac.Write(body)
+	return fmt.Sprintf("t=%s,v1=%s", ts, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// retryDelay returns the delay before next attempt after given number of failed attempts.
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 4
+	}
+
+	return min(delay, retryMaxDelay)
+}
@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign(t *testing.T) {
+	a := assert.New(t)
+	body := []byte(`{"event":"file.uploaded"}`)
+	ts := time.Unix(1700000000, 0)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("1700000000." + string(body)))
+	a.Equal("t=1700000000,v1="+hex.EncodeToString(mac.Sum(nil)), Sign("secret", ts, body))
+	a.NotEqual(Sign("secret", ts, body), Sign("other", ts, body))
+	a.NotEqual(Sign("secret", ts, body), Sign("secret", ts.Add(time.Second), body))
+}
+
+func TestRetryDelay(t *testing.T) {
+	a := assert.New(t)
+	a.Equal(30*time.Second, retryDelay(1))
+	a.Equal(2*time.Minute, retryDelay(2))
+	a.Equal(8*time.Minute, retryDelay(3))
+	a.Equal(retryMaxDelay, retryDelay(MaxAttempts))
+	a.Equal(retryMaxDelay, retryDelay(100))
+}
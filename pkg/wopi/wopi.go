@@ -41,13 +41,17 @@ const (
 	ServerErrorHeader     = WopiHeaderPrefix + "ServerError"
 	RenameRequestHeader   = WopiHeaderPrefix + "RequestedName"
 	LockTokenHeader       = WopiHeaderPrefix + "Lock"
+	OldLockTokenHeader    = WopiHeaderPrefix + "OldLock"
+	InvalidNameHeader     = WopiHeaderPrefix + "InvalidFileNameError"
 	ItemVersionHeader     = WopiHeaderPrefix + "ItemVersion"
 	SuggestedTargetHeader = WopiHeaderPrefix + "SuggestedTarget"
 
 	MethodLock           = "LOCK"
+	MethodGetLock        = "GET_LOCK"
 	MethodUnlock         = "UNLOCK"
 	MethodRefreshLock    = "REFRESH_LOCK"
 	MethodPutRelative    = "PUT_RELATIVE"
+	MethodRenameFile     = "RENAME_FILE"
 	wopiSrcPlaceholder   = "WOPI_SOURCE"
 	wopiSrcParamDefault  = "WOPISrc"
 	languageParamDefault = "lang"
@@ -0,0 +1,681 @@
+// Package apidoc describes the HTTP API in OpenAPI 3. The document is generated from routes registered in
+// the router and their descriptions in Endpoints by `go generate` at build time, and embedded into the binary.
+package apidoc
+
+import (
+	_ "embed"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/workflows"
+	"github.com/cloudreve/Cloudreve/v4/pkg/openapi"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/siteconfig"
+	"github.com/cloudreve/Cloudreve/v4/pkg/textpreview"
+	"github.com/cloudreve/Cloudreve/v4/pkg/thumb"
+	"github.com/cloudreve/Cloudreve/v4/service/admin"
+	"github.com/cloudreve/Cloudreve/v4/service/basic"
+	"github.com/cloudreve/Cloudreve/v4/service/explorer"
+	"github.com/cloudreve/Cloudreve/v4/service/graph"
+	settingsvc "github.com/cloudreve/Cloudreve/v4/service/setting"
+	"github.com/cloudreve/Cloudreve/v4/service/share"
+	"github.com/cloudreve/Cloudreve/v4/service/user"
+	"github.com/gin-gonic/gin"
+	"github.com/go-webauthn/webauthn/protocol"
+)
+
+//go:generate go run gen.go
+
+// Spec is the generated OpenAPI document in JSON.
+//
+//go:embed openapi.json
+var Spec []byte
+
+const (
+	tagSite     = "site"
+	tagSession  = "session"
+	tagUser     = "user"
+	tagFile     = "file"
+	tagShare    = "share"
+	tagWorkflow = "workflow"
+	tagDevices  = "devices"
+	tagGraphQL  = "graphql"
+	tagOrg      = "org"
+	tagAdmin    = "admin"
+)
+
+// internalPrefixes are paths of routes not meant for API clients, i.e. requests from slave nodes and callbacks
+// of storage providers. They are left out of the document.
+var internalPrefixes = []string{"/slave/", "/callback/"}
+
+// Endpoints describe API routes, paths are relative to constants.APIPrefix. Every route registered in the
+// router must be described here unless it is internal, see Build.
+var Endpoints = []openapi.Endpoint{
+	// Site
+	{ID: "ping", Method: http.MethodGet, Path: "/site/ping", Tag: tagSite, Anonymous: true,
+		Summary: "Get backend version", Response: ""},
+	{ID: "getCaptcha", Method: http.MethodGet, Path: "/site/captcha", Tag: tagSite, Anonymous: true,
+		Summary: "Get a captcha image", Response: basic.CaptchaResponse{}},
+	{ID: "getSiteConfig", Method: http.MethodGet, Path: "/site/config/:section", Tag: tagSite, Anonymous: true,
+		Summary: "Get site config of a section", Response: basic.SiteConfig{}},
+	{ID: "listAnnouncements", Method: http.MethodGet, Path: "/site/announcements", Tag: tagSite, Anonymous: true,
+		Summary: "List active site announcements", Response: []basic.Announcement{}},
+	{ID: "getOpenAPISpec", Method: http.MethodGet, Path: "/openapi.json", Tag: tagSite, Anonymous: true,
+		Summary: "Get OpenAPI document of the API", ContentTypes: []string{"application/json"}},
+	{ID: "getAPIDocs", Method: http.MethodGet, Path: "/docs", Tag: tagSite, Anonymous: true,
+		Summary: "Swagger UI of the OpenAPI document", ContentTypes: []string{"text/html"}},
+
+	// Session
+	{ID: "login", Method: http.MethodPost, Path: "/session/token", Tag: tagSession, Anonymous: true,
+		Summary: "Login with email and password", Body: user.UserLoginService{}, Response: user.BuiltinLoginResponse{}},
+	{ID: "login2FA", Method: http.MethodPost, Path: "/session/token/2fa", Tag: tagSession, Anonymous: true,
+		Summary: "Finish login with 2FA code", Body: user.OtpValidationService{}, Response: user.BuiltinLoginResponse{}},
+	{ID: "preparePasskey2FA", Method: http.MethodPut, Path: "/session/token/2fa/authn", Tag: tagSession, Anonymous: true,
+		Summary: "Prepare passkey as second factor of login", Body: user.PreparePasskey2FAService{}, Response: protocol.CredentialAssertion{}},
+	{ID: "loginPasskey2FA", Method: http.MethodPost, Path: "/session/token/2fa/authn", Tag: tagSession, Anonymous: true,
+		Summary: "Finish login with passkey as second factor", Body: user.FinishPasskey2FAService{}, Response: user.BuiltinLoginResponse{}},
+	{ID: "refreshToken", Method: http.MethodPost, Path: "/session/token/refresh", Tag: tagSession, Anonymous: true,
+		Summary: "Issue a new access token with refresh token", Body: user.RefreshTokenService{}, Response: auth.Token{}},
+	{ID: "signOut", Method: http.MethodDelete, Path: "/session/token", Tag: tagSession,
+		Summary: "Revoke refresh token", Body: user.RefreshTokenService{}, Response: ""},
+	{ID: "prepareLogin", Method: http.MethodGet, Path: "/session/prepare", Tag: tagSession, Anonymous: true,
+		Summary: "Get available login methods of a user", Query: user.PrepareLoginService{}, Response: user.PrepareLoginResponse{}},
+	{ID: "rotatePassword", Method: http.MethodPatch, Path: "/session/password", Tag: tagSession, Anonymous: true,
+		Summary: "Change an expired password", Body: user.RotatePasswordService{}},
+	{ID: "preparePasskeyLogin", Method: http.MethodPut, Path: "/session/authn", Tag: tagSession, Anonymous: true,
+		Summary: "Prepare passkey login", Response: user.PreparePasskeyLoginResponse{}},
+	{ID: "loginPasskey", Method: http.MethodPost, Path: "/session/authn", Tag: tagSession, Anonymous: true,
+		Summary: "Login with passkey", Body: user.FinishPasskeyLoginService{}, Response: user.BuiltinLoginResponse{}},
+
+	// User
+	{ID: "register", Method: http.MethodPost, Path: "/user", Tag: tagUser, Anonymous: true,
+		Summary: "Register a new user", Body: user.UserRegisterService{}, Response: user.User{}},
+	{ID: "resetPassword", Method: http.MethodPatch, Path: "/user/reset/:id", Tag: tagUser, Anonymous: true,
+		Summary: "Reset password with the secret from reset email", Body: user.UserResetService{}, Response: user.User{}},
+	{ID: "confirmEmailChange", Method: http.MethodPatch, Path: "/user/email/:id", Tag: tagUser, Anonymous: true,
+		Summary: "Confirm new email with the secret from confirmation email", Body: user.EmailChangeSecretService{}, Response: user.User{}},
+	{ID: "vetoEmailChange", Method: http.MethodDelete, Path: "/user/email/:id", Tag: tagUser, Anonymous: true,
+		Summary: "Cancel email change with the secret sent to old email", Body: user.EmailChangeSecretService{}},
+	{ID: "confirmEmailAlias", Method: http.MethodPatch, Path: "/user/email/:id/alias", Tag: tagUser, Anonymous: true,
+		Summary: "Confirm secondary email with the secret from confirmation email", Body: user.ConfirmEmailAliasService{}},
+	{ID: "sendPasswordReset", Method: http.MethodPost, Path: "/user/reset", Tag: tagUser, Anonymous: true,
+		Summary: "Send password reset email", Body: user.UserResetEmailService{}},
+	{ID: "activateUser", Method: http.MethodGet, Path: "/user/activate/:id", Tag: tagUser, Anonymous: true,
+		Summary: "Activate account from activation email", Response: user.User{}},
+	{ID: "getUserAvatar", Method: http.MethodGet, Path: "/user/avatar/:id", Tag: tagUser, Anonymous: true,
+		Summary: "Get avatar of a user", Query: user.GetAvatarService{}, ContentTypes: []string{"image/*"}},
+	{ID: "getUser", Method: http.MethodGet, Path: "/user/info/:id", Tag: tagUser, Anonymous: true,
+		Summary: "Get public info of a user", Response: user.User{}},
+	{ID: "listUserShares", Method: http.MethodGet, Path: "/user/shares/:id", Tag: tagUser, Anonymous: true,
+		Summary: "List public shares of a user", Query: share.ListShareService{}, Response: share.ListShareResponse{}},
+	{ID: "copySession", Method: http.MethodGet, Path: "/user/session/copy/:id", Tag: tagUser, Anonymous: true,
+		Summary: "Copy login session to mobile app"},
+	{ID: "getCurrentUser", Method: http.MethodGet, Path: "/user/me", Tag: tagUser,
+		Summary: "Get current user", Response: user.User{}},
+	{ID: "getUserCapacity", Method: http.MethodGet, Path: "/user/capacity", Tag: tagUser,
+		Summary: "Get storage usage of current user", Response: user.UserCapacity{}},
+	{ID: "searchUsers", Method: http.MethodGet, Path: "/user/search", Tag: tagUser,
+		Summary: "Search users by keywords", Query: user.SearchUserService{}, Response: []user.User{}},
+	{ID: "preparePasskeyRegistration", Method: http.MethodPut, Path: "/user/authn", Tag: tagUser,
+		Summary: "Prepare registering a passkey", Response: protocol.CredentialCreation{}},
+	{ID: "registerPasskey", Method: http.MethodPost, Path: "/user/authn", Tag: tagUser,
+		Summary: "Register a passkey", Body: user.FinishPasskeyRegisterService{}, Response: user.Passkey{}},
+	{ID: "deletePasskey", Method: http.MethodDelete, Path: "/user/authn", Tag: tagUser,
+		Summary: "Delete a passkey", Query: user.DeletePasskeyService{}},
+	{ID: "renamePasskey", Method: http.MethodPatch, Path: "/user/authn", Tag: tagUser,
+		Summary: "Rename a passkey", Body: user.RenamePasskeyService{}, Response: user.Passkey{}},
+	{ID: "preparePasskeyVerification", Method: http.MethodPut, Path: "/user/authn/verify", Tag: tagUser,
+		Summary: "Prepare verifying a passkey before changing 2FA settings", Response: protocol.CredentialAssertion{}},
+	{ID: "listInvitations", Method: http.MethodGet, Path: "/user/invitation", Tag: tagUser,
+		Summary: "List invitation codes", Response: []user.Invitation{}},
+	{ID: "createInvitation", Method: http.MethodPut, Path: "/user/invitation", Tag: tagUser,
+		Summary: "Create an invitation code", Body: user.CreateInvitationService{}, Response: user.Invitation{}},
+	{ID: "deleteInvitation", Method: http.MethodDelete, Path: "/user/invitation/:id", Tag: tagUser,
+		Summary: "Delete an invitation code"},
+	{ID: "listAccessTokens", Method: http.MethodGet, Path: "/user/token", Tag: tagUser,
+		Summary: "List personal access tokens", Response: []user.AccessToken{}},
+	{ID: "createAccessToken", Method: http.MethodPut, Path: "/user/token", Tag: tagUser,
+		Summary: "Create a personal access token", Body: user.CreateAccessTokenService{}, Response: user.NewAccessTokenResponse{}},
+	{ID: "revokeAccessToken", Method: http.MethodDelete, Path: "/user/token/:id", Tag: tagUser,
+		Summary: "Revoke a personal access token"},
+	{ID: "listNotifications", Method: http.MethodGet, Path: "/user/notification", Tag: tagUser,
+		Summary: "List notifications", Query: user.ListNotificationService{}, Response: user.ListNotificationResponse{}},
+	{ID: "markNotificationsRead", Method: http.MethodPost, Path: "/user/notification/read", Tag: tagUser,
+		Summary: "Mark notifications as read", Body: user.MarkNotificationReadService{}},
+	{ID: "dismissAnnouncement", Method: http.MethodPut, Path: "/user/announcement/:id/dismiss", Tag: tagUser,
+		Summary: "Dismiss a site announcement"},
+	{ID: "getUserSettings", Method: http.MethodGet, Path: "/user/setting", Tag: tagUser,
+		Summary: "Get settings of current user", Response: user.UserSettings{}},
+	{ID: "uploadAvatar", Method: http.MethodPut, Path: "/user/setting/avatar", Tag: tagUser,
+		Summary: "Upload avatar image"},
+	{ID: "updateUserSetting", Method: http.MethodPatch, Path: "/user/setting", Tag: tagUser,
+		Summary: "Update a setting of current user", Body: user.PatchUserSetting{}},
+	{ID: "prepare2FA", Method: http.MethodGet, Path: "/user/setting/2fa", Tag: tagUser,
+		Summary: "Get secret to enable 2FA", Response: ""},
+	{ID: "changeEmail", Method: http.MethodPut, Path: "/user/setting/email", Tag: tagUser,
+		Summary: "Request to change email", Body: user.ChangeEmailService{}},
+	{ID: "cancelEmailChange", Method: http.MethodDelete, Path: "/user/setting/email", Tag: tagUser,
+		Summary: "Cancel pending email change"},
+	{ID: "addEmailAlias", Method: http.MethodPost, Path: "/user/setting/emails", Tag: tagUser,
+		Summary: "Add a secondary email", Body: user.AddEmailAliasService{}},
+	{ID: "removeEmailAlias", Method: http.MethodDelete, Path: "/user/setting/emails", Tag: tagUser,
+		Summary: "Remove a secondary email", Body: user.EmailAliasService{}},
+	{ID: "setPrimaryEmail", Method: http.MethodPut, Path: "/user/setting/emails/primary", Tag: tagUser,
+		Summary: "Make a secondary email primary", Body: user.EmailAliasService{}, Response: user.User{}},
+	{ID: "prepareDeleteAccount", Method: http.MethodPut, Path: "/user/setting/delete", Tag: tagUser,
+		Summary: "Prepare passkey verification before deleting account", Response: protocol.CredentialAssertion{}},
+	{ID: "deleteAccount", Method: http.MethodPost, Path: "/user/setting/delete", Tag: tagUser,
+		Summary: "Delete account of current user", Body: user.DeleteAccountService{}, Response: types.AccountDeletion{}},
+	{ID: "getViewPreference", Method: http.MethodPost, Path: "/user/setting/view-preference", Tag: tagUser,
+		Summary: "Get view preferences of folders", Body: user.GetViewPreferenceService{}, Response: user.ViewPreferenceResponse{}},
+	{ID: "setViewPreference", Method: http.MethodPut, Path: "/user/setting/view-preference", Tag: tagUser,
+		Summary: "Set view preference of a folder", Body: user.SetViewPreferenceService{}},
+	{ID: "listGroups", Method: http.MethodGet, Path: "/group/list", Tag: tagUser,
+		Summary: "List groups for options", Response: []user.Group{}},
+
+	// File
+	{ID: "downloadArchive", Method: http.MethodGet, Path: "/file/archive/:sessionID/archive.zip", Tag: tagFile, Anonymous: true,
+		Summary: "Download files as an archive created on the fly", ContentTypes: []string{"application/zip"}},
+	{ID: "openLink", Method: http.MethodGet, Path: "/file/link/:id", Tag: tagFile, Anonymous: true,
+		Summary: "Page leading to the target of a web link file", ContentTypes: []string{"text/html"}},
+	{ID: "listFiles", Method: http.MethodGet, Path: "/file", Tag: tagFile, Anonymous: true,
+		Summary: "List files under a folder URI", Query: explorer.ListFileService{}, Response: explorer.ListResponse{}},
+	{ID: "createFile", Method: http.MethodPost, Path: "/file/create", Tag: tagFile,
+		Summary: "Create a file or folder", Body: explorer.CreateFileService{}, Response: explorer.FileResponse{}},
+	{ID: "createLink", Method: http.MethodPost, Path: "/file/link", Tag: tagFile,
+		Summary: "Create a web link file", Body: explorer.CreateLinkService{}, Response: explorer.FileResponse{}},
+	{ID: "renameFile", Method: http.MethodPost, Path: "/file/rename", Tag: tagFile,
+		Summary: "Rename a file", Body: explorer.RenameFileService{}, Response: explorer.FileResponse{}},
+	{ID: "moveFiles", Method: http.MethodPost, Path: "/file/move", Tag: tagFile,
+		Summary: "Move or copy files", Body: explorer.MoveFileService{}},
+	{ID: "batchFileOperations", Method: http.MethodPost, Path: "/file/batch", Tag: tagFile,
+		Summary: "Execute file operations in one transaction", Body: explorer.BatchFileService{}, Response: explorer.BatchFileResponse{}},
+	{ID: "getFileUrl", Method: http.MethodPost, Path: "/file/url", Tag: tagFile, Anonymous: true,
+		Summary: "Get download URLs of files", Body: explorer.FileURLService{}, Response: explorer.FileURLResponse{}},
+	{ID: "putFileContent", Method: http.MethodPut, Path: "/file/content", Tag: tagFile,
+		Summary: "Update file content", Query: explorer.FileUpdateService{}, Response: explorer.FileResponse{}},
+	{ID: "patchFileContent", Method: http.MethodPatch, Path: "/file/content", Tag: tagFile,
+		Summary: "Overwrite a byte range of file content", Query: explorer.FilePatchService{}, Response: explorer.FileResponse{}},
+	{ID: "serveEntity", Method: http.MethodGet, Path: "/file/content/:id/:speed/:name", Tag: tagFile, Anonymous: true,
+		Summary: "Download file content with a signed URL", ContentTypes: []string{"application/octet-stream"}},
+	{ID: "headEntity", Method: http.MethodHead, Path: "/file/content/:id/:speed/:name", Tag: tagFile, Anonymous: true,
+		Summary: "Get headers of file content with a signed URL", ContentTypes: []string{"application/octet-stream"}},
+	{ID: "getThumb", Method: http.MethodGet, Path: "/file/thumb", Tag: tagFile, Anonymous: true,
+		Summary: "Get thumbnail URL of a file", Query: explorer.FileThumbService{}, Response: explorer.FileThumbResponse{}},
+	{ID: "getThumbs", Method: http.MethodPost, Path: "/file/thumbs", Tag: tagFile, Anonymous: true,
+		Summary: "Get thumbnails of multiple files", Body: explorer.BatchFileThumbService{}, Response: explorer.BatchFileThumbResponse{}},
+	{ID: "getPreview", Method: http.MethodGet, Path: "/file/preview", Tag: tagFile, Anonymous: true,
+		Summary: "Get preview rendition of a file", Query: explorer.FilePreviewService{}, ContentTypes: []string{"application/octet-stream"}},
+	{ID: "getWaveform", Method: http.MethodGet, Path: "/file/waveform", Tag: tagFile, Anonymous: true,
+		Summary: "Get waveform of an audio file", Query: explorer.FileWaveformService{}, Response: thumb.Waveform{}},
+	{ID: "getTextPreview", Method: http.MethodGet, Path: "/file/text", Tag: tagFile, Anonymous: true,
+		Summary: "Get text file content decoded into UTF-8", Query: explorer.FileTextPreviewService{}, Response: textpreview.Result{}},
+	{ID: "getSubtitle", Method: http.MethodGet, Path: "/file/subtitle", Tag: tagFile, Anonymous: true,
+		Summary: "Get subtitle sidecar of a video file, SRT is converted into WebVTT", Query: explorer.FileSubtitleService{}, ContentTypes: []string{"text/vtt"}},
+	{ID: "getVideoSprite", Method: http.MethodGet, Path: "/file/sprite", Tag: tagFile, Anonymous: true,
+		Summary: "Get sprite sheet layout of a video file for scrub previews", Query: explorer.FileSpriteService{}, Response: thumb.SpriteSheet{}},
+	{ID: "getVideoSpriteImage", Method: http.MethodGet, Path: "/file/sprite/image", Tag: tagFile, Anonymous: true,
+		Summary: "Get sprite sheet image of a video file", Query: explorer.FileSpriteService{}, ContentTypes: []string{"image/*"}},
+	{ID: "searchFiles", Method: http.MethodGet, Path: "/file/search", Tag: tagFile,
+		Summary: "Full-text search of files", Query: explorer.FullTextSearchService{}, Response: explorer.FullTextSearchResponse{}},
+	{ID: "listSavedSearches", Method: http.MethodGet, Path: "/file/search/saved", Tag: tagFile,
+		Summary: "List saved searches", Response: []explorer.SavedSearch{}},
+	{ID: "createSavedSearch", Method: http.MethodPut, Path: "/file/search/saved", Tag: tagFile,
+		Summary: "Save a search query", Body: explorer.SavedSearchService{}, Response: explorer.SavedSearch{}},
+	{ID: "updateSavedSearch", Method: http.MethodPatch, Path: "/file/search/saved/:id", Tag: tagFile,
+		Summary: "Update a saved search", Body: explorer.SavedSearchService{}, Response: explorer.SavedSearch{}},
+	{ID: "deleteSavedSearch", Method: http.MethodDelete, Path: "/file/search/saved/:id", Tag: tagFile,
+		Summary: "Delete a saved search"},
+	{ID: "getPhotoMap", Method: http.MethodGet, Path: "/file/geo", Tag: tagFile,
+		Summary: "Get clustered photo locations for map view", Query: explorer.PhotoMapService{}, Response: []explorer.PhotoCluster{}},
+	{ID: "deleteFiles", Method: http.MethodDelete, Path: "/file", Tag: tagFile,
+		Summary: "Delete files", Body: explorer.DeleteFileService{}},
+	{ID: "unlockFiles", Method: http.MethodDelete, Path: "/file/lock", Tag: tagFile,
+		Summary: "Force unlock files", Body: explorer.UnlockFileService{}},
+	{ID: "listLocks", Method: http.MethodGet, Path: "/file/lock", Tag: tagFile,
+		Summary: "List active locks of current user", Response: []explorer.Lock{}},
+	{ID: "checkoutFile", Method: http.MethodPut, Path: "/file/checkout", Tag: tagFile,
+		Summary: "Check out a file for exclusive editing", Body: explorer.CheckoutFileService{}, Response: explorer.Lock{}},
+	{ID: "checkinFile", Method: http.MethodDelete, Path: "/file/checkout", Tag: tagFile,
+		Summary: "Check in a file", Body: explorer.CheckinFileService{}},
+	{ID: "restoreFiles", Method: http.MethodPost, Path: "/file/restore", Tag: tagFile,
+		Summary: "Restore files from trash bin", Body: explorer.DeleteFileService{}},
+	{ID: "restoreTree", Method: http.MethodPost, Path: "/file/restore/tree", Tag: tagFile,
+		Summary: "Restore all files deleted from a folder", Body: explorer.RestoreTreeService{}},
+	{ID: "patchMetadata", Method: http.MethodPatch, Path: "/file/metadata", Tag: tagFile,
+		Summary: "Update metadata of files", Body: explorer.PatchMetadataService{}},
+	{ID: "createUploadSession", Method: http.MethodPut, Path: "/file/upload", Tag: tagFile, Anonymous: true,
+		Summary: "Create an upload session", Body: explorer.CreateUploadSessionService{}, Response: explorer.UploadSessionResponse{}},
+	{ID: "uploadChunk", Method: http.MethodPost, Path: "/file/upload/:sessionId/:index", Tag: tagFile, Anonymous: true,
+		Summary: "Upload a chunk of file data"},
+	{ID: "deleteUploadSession", Method: http.MethodDelete, Path: "/file/upload", Tag: tagFile, Anonymous: true,
+		Summary: "Delete an upload session", Body: explorer.DeleteUploadSessionService{}},
+	{ID: "pinFile", Method: http.MethodPut, Path: "/file/pin", Tag: tagFile,
+		Summary: "Pin a folder into sidebar", Body: explorer.PinFileService{}},
+	{ID: "unpinFile", Method: http.MethodDelete, Path: "/file/pin", Tag: tagFile,
+		Summary: "Unpin a folder from sidebar", Body: explorer.PinFileService{}},
+	{ID: "getFileEvents", Method: http.MethodGet, Path: "/file/events", Tag: tagFile,
+		Summary: "Real-time events of current user over WebSocket", Query: explorer.EventStreamService{}},
+	{ID: "getFileEventSource", Method: http.MethodGet, Path: "/file/events/sse", Tag: tagFile,
+		Summary: "Real-time events of current user as Server-Sent Events", Query: explorer.EventSourceService{}, ContentTypes: []string{"text/event-stream"}},
+	{ID: "getFileInfo", Method: http.MethodGet, Path: "/file/info", Tag: tagFile, Anonymous: true,
+		Summary: "Get file info", Query: explorer.GetFileInfoService{}, Response: explorer.FileResponse{}},
+	{ID: "listVersions", Method: http.MethodGet, Path: "/file/version", Tag: tagFile,
+		Summary: "List versions of a file", Query: explorer.ListVersionsService{}, Response: []explorer.Version{}},
+	{ID: "setCurrentVersion", Method: http.MethodPost, Path: "/file/version/current", Tag: tagFile,
+		Summary: "Set current version of a file", Body: explorer.SetCurrentVersionService{}},
+	{ID: "deleteVersion", Method: http.MethodDelete, Path: "/file/version", Tag: tagFile,
+		Summary: "Delete a version from a file", Body: explorer.DeleteVersionService{}},
+	{ID: "createViewerSession", Method: http.MethodPut, Path: "/file/viewerSession", Tag: tagFile,
+		Summary: "Create a session for third-party document viewers", Body: explorer.CreateViewerSessionService{}, Response: explorer.ViewerSessionResponse{}},
+	{ID: "getDirectLinks", Method: http.MethodPut, Path: "/file/source", Tag: tagFile,
+		Summary: "Get or create direct links of files", Body: explorer.GetDirectLinkService{}, Response: []explorer.DirectLinkResponse{}},
+	{ID: "enableThumbnail", Method: http.MethodPost, Path: "/file/action/enable-thumbnail", Tag: tagFile,
+		Summary: "Enable thumbnail of a file", Body: explorer.EnableFileThumbnailService{}},
+
+	// Share
+	{ID: "createShare", Method: http.MethodPut, Path: "/share", Tag: tagShare,
+		Summary: "Create a share link, returns the link", Body: share.ShareCreateService{}, Response: ""},
+	{ID: "updateShare", Method: http.MethodPost, Path: "/share/:id", Tag: tagShare,
+		Summary: "Update a share link, returns the link", Body: share.ShareCreateService{}, Response: ""},
+	{ID: "getShare", Method: http.MethodGet, Path: "/share/info/:id", Tag: tagShare, Anonymous: true,
+		Summary: "Get share link info", Query: share.ShareInfoService{}, Response: explorer.Share{}},
+	{ID: "listShares", Method: http.MethodGet, Path: "/share", Tag: tagShare,
+		Summary: "List shares of current user", Query: share.ListShareService{}, Response: share.ListShareResponse{}},
+	{ID: "deleteShare", Method: http.MethodDelete, Path: "/share/:id", Tag: tagShare,
+		Summary: "Delete a share link"},
+
+	// Workflow
+	{ID: "downloadTakeout", Method: http.MethodGet, Path: "/workflow/takeout/:id/takeout.zip", Tag: tagWorkflow, Anonymous: true,
+		Summary: "Download personal data export", ContentTypes: []string{"application/zip"}},
+	{ID: "listTasks", Method: http.MethodGet, Path: "/workflow", Tag: tagWorkflow,
+		Summary: "List tasks of current user", Query: explorer.ListTaskService{}, Response: explorer.TaskListResponse{}},
+	{ID: "getTaskProgress", Method: http.MethodGet, Path: "/workflow/progress/:id", Tag: tagWorkflow,
+		Summary: "Get progress of a task", Response: queue.Progresses{}},
+	{ID: "createArchive", Method: http.MethodPost, Path: "/workflow/archive", Tag: tagWorkflow,
+		Summary: "Create task to compress files", Body: explorer.ArchiveWorkflowService{}, Response: explorer.TaskResponse{}},
+	{ID: "extractArchive", Method: http.MethodPost, Path: "/workflow/extract", Tag: tagWorkflow,
+		Summary: "Create task to extract an archive", Body: explorer.ArchiveWorkflowService{}, Response: explorer.TaskResponse{}},
+	{ID: "createTranscode", Method: http.MethodPost, Path: "/workflow/transcode", Tag: tagWorkflow,
+		Summary: "Create task to transcode a video", Body: explorer.TranscodeWorkflowService{}, Response: explorer.TaskResponse{}},
+	{ID: "createTakeout", Method: http.MethodPost, Path: "/workflow/takeout", Tag: tagWorkflow,
+		Summary: "Create task to export personal data", Body: explorer.TakeoutWorkflowService{}, Response: explorer.TaskResponse{}},
+	{ID: "getTakeoutUrl", Method: http.MethodGet, Path: "/workflow/takeout/:id", Tag: tagWorkflow,
+		Summary: "Get download URL of personal data export", Response: explorer.FileURLResponse{}},
+	{ID: "createRemoteDownload", Method: http.MethodPost, Path: "/workflow/download", Tag: tagWorkflow,
+		Summary: "Create tasks to download files from URLs", Body: explorer.DownloadWorkflowService{}, Response: []explorer.TaskResponse{}},
+	{ID: "setDownloadTarget", Method: http.MethodPatch, Path: "/workflow/download/:id", Tag: tagWorkflow,
+		Summary: "Select files to download of a remote download task", Body: explorer.SetDownloadFilesService{}},
+	{ID: "cancelDownload", Method: http.MethodDelete, Path: "/workflow/download/:id", Tag: tagWorkflow,
+		Summary: "Cancel a remote download task"},
+	{ID: "listRssSubscriptions", Method: http.MethodGet, Path: "/workflow/rss", Tag: tagWorkflow,
+		Summary: "List RSS subscriptions", Response: []explorer.RssSubscription{}},
+	{ID: "createRssSubscription", Method: http.MethodPut, Path: "/workflow/rss", Tag: tagWorkflow,
+		Summary: "Create an RSS subscription", Body: explorer.RssSubscriptionService{}, Response: explorer.RssSubscription{}},
+	{ID: "updateRssSubscription", Method: http.MethodPatch, Path: "/workflow/rss/:id", Tag: tagWorkflow,
+		Summary: "Update an RSS subscription", Body: explorer.RssSubscriptionService{}, Response: explorer.RssSubscription{}},
+	{ID: "deleteRssSubscription", Method: http.MethodDelete, Path: "/workflow/rss/:id", Tag: tagWorkflow,
+		Summary: "Delete an RSS subscription"},
+	{ID: "listAutomationRules", Method: http.MethodGet, Path: "/workflow/automation", Tag: tagWorkflow,
+		Summary: "List automation rules", Response: explorer.ListAutomationRuleResponse{}},
+	{ID: "createAutomationRule", Method: http.MethodPut, Path: "/workflow/automation", Tag: tagWorkflow,
+		Summary: "Create an automation rule", Body: explorer.AutomationRuleService{}, Response: explorer.AutomationRule{}},
+	{ID: "dryRunAutomationRule", Method: http.MethodPost, Path: "/workflow/automation/dry-run", Tag: tagWorkflow,
+		Summary: "Test a rule against a file without applying it", Body: explorer.DryRunAutomationRuleService{}, Response: explorer.DryRunAutomationRuleResponse{}},
+	{ID: "updateAutomationRule", Method: http.MethodPatch, Path: "/workflow/automation/:id", Tag: tagWorkflow,
+		Summary: "Update an automation rule", Body: explorer.AutomationRuleService{}, Response: explorer.AutomationRule{}},
+	{ID: "deleteAutomationRule", Method: http.MethodDelete, Path: "/workflow/automation/:id", Tag: tagWorkflow,
+		Summary: "Delete an automation rule"},
+	{ID: "listSyncJobs", Method: http.MethodGet, Path: "/workflow/sync", Tag: tagWorkflow,
+		Summary: "List folder sync jobs", Response: explorer.ListSyncJobResponse{}},
+	{ID: "createSyncJob", Method: http.MethodPut, Path: "/workflow/sync", Tag: tagWorkflow,
+		Summary: "Create a folder sync job", Body: explorer.SyncJobService{}, Response: explorer.SyncJob{}},
+	{ID: "updateSyncJob", Method: http.MethodPatch, Path: "/workflow/sync/:id", Tag: tagWorkflow,
+		Summary: "Update a folder sync job", Body: explorer.SyncJobService{}, Response: explorer.SyncJob{}},
+	{ID: "deleteSyncJob", Method: http.MethodDelete, Path: "/workflow/sync/:id", Tag: tagWorkflow,
+		Summary: "Delete a folder sync job"},
+	{ID: "runSyncJob", Method: http.MethodPost, Path: "/workflow/sync/:id/run", Tag: tagWorkflow,
+		Summary: "Run a folder sync job on next cron tick"},
+	{ID: "listRetentionRules", Method: http.MethodGet, Path: "/workflow/retention", Tag: tagWorkflow,
+		Summary: "List retention rules", Response: explorer.ListRetentionRuleResponse{}},
+	{ID: "createRetentionRule", Method: http.MethodPut, Path: "/workflow/retention", Tag: tagWorkflow,
+		Summary: "Create a retention rule", Body: explorer.RetentionRuleService{}, Response: explorer.RetentionRule{}},
+	{ID: "previewRetentionRule", Method: http.MethodPost, Path: "/workflow/retention/preview", Tag: tagWorkflow,
+		Summary: "List files affected by a rule without applying it", Body: explorer.RetentionRuleService{}, Response: workflows.RetentionPreview{}},
+	{ID: "updateRetentionRule", Method: http.MethodPatch, Path: "/workflow/retention/:id", Tag: tagWorkflow,
+		Summary: "Update a retention rule", Body: explorer.RetentionRuleService{}, Response: explorer.RetentionRule{}},
+	{ID: "deleteRetentionRule", Method: http.MethodDelete, Path: "/workflow/retention/:id", Tag: tagWorkflow,
+		Summary: "Delete a retention rule"},
+	{ID: "createUrlImport", Method: http.MethodPost, Path: "/workflow/url-import", Tag: tagWorkflow,
+		Summary: "Create task to import files from a list of URLs", Body: explorer.UrlImportWorkflowService{}, Response: explorer.TaskResponse{}},
+	{ID: "getUrlImportReport", Method: http.MethodGet, Path: "/workflow/url-import/:id/report", Tag: tagWorkflow,
+		Summary: "Download status of each URL as CSV", ContentTypes: []string{"text/csv"}},
+	{ID: "importFiles", Method: http.MethodPost, Path: "/workflow/import", Tag: tagWorkflow,
+		Summary: "Create task to import files from storage policy", Body: explorer.ImportWorkflowService{}, Response: explorer.TaskResponse{}},
+
+	// Devices
+	{ID: "listDavAccounts", Method: http.MethodGet, Path: "/devices/dav", Tag: tagDevices,
+		Summary: "List WebDAV accounts", Query: settingsvc.ListDavAccountsService{}, Response: settingsvc.ListDavAccountResponse{}},
+	{ID: "createDavAccount", Method: http.MethodPut, Path: "/devices/dav", Tag: tagDevices,
+		Summary: "Create a WebDAV account", Body: settingsvc.CreateDavAccountService{}, Response: settingsvc.DavAccount{}},
+	{ID: "updateDavAccount", Method: http.MethodPatch, Path: "/devices/dav/:id", Tag: tagDevices,
+		Summary: "Update a WebDAV account", Body: settingsvc.CreateDavAccountService{}, Response: settingsvc.DavAccount{}},
+	{ID: "rotateDavAccount", Method: http.MethodPost, Path: "/devices/dav/:id/rotate", Tag: tagDevices,
+		Summary: "Rotate password of a WebDAV account", Response: settingsvc.DavAccount{}},
+	{ID: "deleteDavAccount", Method: http.MethodDelete, Path: "/devices/dav/:id", Tag: tagDevices,
+		Summary: "Delete a WebDAV account"},
+	{ID: "listS3AccessKeys", Method: http.MethodGet, Path: "/devices/s3", Tag: tagDevices,
+		Summary: "List S3 access keys", Response: settingsvc.ListS3AccessKeyResponse{}},
+	{ID: "createS3AccessKey", Method: http.MethodPut, Path: "/devices/s3", Tag: tagDevices,
+		Summary: "Create an S3 access key", Body: settingsvc.CreateS3AccessKeyService{}, Response: settingsvc.S3AccessKey{}},
+	{ID: "deleteS3AccessKey", Method: http.MethodDelete, Path: "/devices/s3/:id", Tag: tagDevices,
+		Summary: "Revoke an S3 access key"},
+
+	// GraphQL
+	{ID: "graphqlQuery", Method: http.MethodPost, Path: "/graphql", Tag: tagGraphQL,
+		Summary: "Execute a GraphQL query", Body: graph.Request{}, ContentTypes: []string{"application/json"}},
+	{ID: "getGraphqlSchema", Method: http.MethodGet, Path: "/graphql/schema", Tag: tagGraphQL,
+		Summary: "Get GraphQL schema in SDL", ContentTypes: []string{"text/plain"}},
+
+	// Organization
+	{ID: "orgGetOrganization", Method: http.MethodGet, Path: "/org/:org", Tag: tagOrg,
+		Summary: "Get an organization", Response: ent.Organization{}},
+	{ID: "orgUpdateBranding", Method: http.MethodPut, Path: "/org/:org/branding", Tag: tagOrg,
+		Summary: "Update branding of an organization", Body: admin.UpdateOrganizationBrandingService{}, Response: ent.Organization{}},
+	{ID: "orgListGroups", Method: http.MethodPost, Path: "/org/:org/group", Tag: tagOrg,
+		Summary: "List groups of an organization", Body: admin.AdminListService{}, Response: admin.ListGroupResponse{}},
+	{ID: "orgListPolicies", Method: http.MethodPost, Path: "/org/:org/policy", Tag: tagOrg,
+		Summary: "List storage policies of an organization", Body: admin.AdminListService{}, Response: admin.ListPolicyResponse{}},
+	{ID: "orgListUsers", Method: http.MethodPost, Path: "/org/:org/user", Tag: tagOrg,
+		Summary: "List members of an organization", Body: admin.AdminListService{}, Response: admin.ListUserResponse{}},
+	{ID: "orgGetUser", Method: http.MethodGet, Path: "/org/:org/user/:id", Tag: tagOrg,
+		Summary: "Get a member of an organization", Response: admin.GetUserResponse{}},
+	{ID: "orgUpdateUserGroup", Method: http.MethodPut, Path: "/org/:org/user/:id/group", Tag: tagOrg,
+		Summary: "Change group of a member of an organization", Body: admin.UserGroupService{}, Response: admin.GetUserResponse{}},
+
+	// Admin
+	{ID: "adminGetSummary", Method: http.MethodGet, Path: "/admin/summary", Tag: tagAdmin,
+		Summary: "Get summary of the site", Query: admin.SummaryService{}, Response: admin.HomepageSummary{}},
+	{ID: "adminGetStorageAnalytics", Method: http.MethodGet, Path: "/admin/analytics/storage", Tag: tagAdmin,
+		Summary: "Get trend of storage usage", Query: admin.AnalyticsService{}, Response: admin.StorageAnalyticsResponse{}},
+	{ID: "adminGetUsageAnalytics", Method: http.MethodGet, Path: "/admin/analytics/usage", Tag: tagAdmin,
+		Summary: "Get trend of active users and traffic", Query: admin.AnalyticsService{}, Response: []admin.UsageTrend{}},
+	{ID: "adminGetFileTypeAnalytics", Method: http.MethodGet, Path: "/admin/analytics/file_types", Tag: tagAdmin,
+		Summary: "Get ranking of uploaded file types", Query: admin.AnalyticsService{}, Response: []admin.FileTypeUsage{}},
+	{ID: "adminGetSettings", Method: http.MethodPost, Path: "/admin/settings", Tag: tagAdmin,
+		Summary: "Get settings", Body: admin.GetSettingService{}, Response: map[string]string{}},
+	{ID: "adminSetSettings", Method: http.MethodPatch, Path: "/admin/settings", Tag: tagAdmin,
+		Summary: "Update settings", Body: admin.SetSettingService{}, Response: map[string]string{}},
+	{ID: "adminExportSiteConfig", Method: http.MethodPost, Path: "/admin/settings/export", Tag: tagAdmin,
+		Summary: "Export settings as YAML manifest", Body: admin.ExportSiteConfigService{}, ContentTypes: []string{"application/yaml"}},
+	{ID: "adminImportSiteConfig", Method: http.MethodPost, Path: "/admin/settings/import", Tag: tagAdmin,
+		Summary: "Apply YAML manifest of settings", Body: admin.ImportSiteConfigService{}, Response: siteconfig.ApplyResult{}},
+	{ID: "adminReloadConfig", Method: http.MethodPost, Path: "/admin/config/reload", Tag: tagAdmin,
+		Summary: "Reload config file", Response: conf.ReloadResult{}},
+	{ID: "adminListGroups", Method: http.MethodPost, Path: "/admin/group", Tag: tagAdmin,
+		Summary: "List groups", Body: admin.AdminListService{}, Response: admin.ListGroupResponse{}},
+	{ID: "adminGetGroup", Method: http.MethodGet, Path: "/admin/group/:id", Tag: tagAdmin,
+		Summary: "Get a group", Response: admin.GetGroupResponse{}},
+	{ID: "adminCreateGroup", Method: http.MethodPut, Path: "/admin/group", Tag: tagAdmin,
+		Summary: "Create a group", Body: admin.UpsertGroupService{}, Response: admin.GetGroupResponse{}},
+	{ID: "adminUpdateGroup", Method: http.MethodPut, Path: "/admin/group/:id", Tag: tagAdmin,
+		Summary: "Update a group", Body: admin.UpsertGroupService{}, Response: admin.GetGroupResponse{}},
+	{ID: "adminDeleteGroup", Method: http.MethodDelete, Path: "/admin/group/:id", Tag: tagAdmin,
+		Summary: "Delete a group"},
+	{ID: "adminFetchWopi", Method: http.MethodGet, Path: "/admin/tool/wopi", Tag: tagAdmin,
+		Summary: "Fetch WOPI discovery of a document server", Query: admin.FetchWOPIDiscoveryService{}, Response: setting.ViewerGroup{}},
+	{ID: "adminTestThumbGenerator", Method: http.MethodPost, Path: "/admin/tool/thumbExecutable", Tag: tagAdmin,
+		Summary: "Test executable of a thumbnail generator", Body: admin.ThumbGeneratorTestService{}, Response: ""},
+	{ID: "adminSendTestMail", Method: http.MethodPost, Path: "/admin/tool/mail", Tag: tagAdmin,
+		Summary: "Send a test email", Body: admin.TestSMTPService{}},
+	{ID: "adminClearEntityUrlCache", Method: http.MethodDelete, Path: "/admin/tool/entityUrlCache", Tag: tagAdmin,
+		Summary: "Clear cached URLs of blobs"},
+	{ID: "adminGetQueueMetrics", Method: http.MethodGet, Path: "/admin/queue/metrics", Tag: tagAdmin,
+		Summary: "Get metrics of task queues", Response: []admin.QueueMetric{}},
+	{ID: "adminGetThumbPregenProgress", Method: http.MethodGet, Path: "/admin/queue/thumb_pregen", Tag: tagAdmin,
+		Summary: "Get progress of thumbnail pregeneration", Response: admin.ThumbPregenProgress{}},
+	{ID: "adminListTasks", Method: http.MethodPost, Path: "/admin/queue", Tag: tagAdmin,
+		Summary: "List tasks", Body: admin.AdminListService{}, Response: admin.ListTaskResponse{}},
+	{ID: "adminGetTask", Method: http.MethodGet, Path: "/admin/queue/:id", Tag: tagAdmin,
+		Summary: "Get a task", Response: admin.GetTaskResponse{}},
+	{ID: "adminBatchDeleteTasks", Method: http.MethodPost, Path: "/admin/queue/batch/delete", Tag: tagAdmin,
+		Summary: "Delete tasks", Body: admin.BatchTaskService{}},
+	{ID: "adminCancelTask", Method: http.MethodPost, Path: "/admin/queue/:id/cancel", Tag: tagAdmin,
+		Summary: "Cancel a task"},
+	{ID: "adminRetryTask", Method: http.MethodPost, Path: "/admin/queue/:id/retry", Tag: tagAdmin,
+		Summary: "Retry a failed task"},
+	{ID: "adminBatchRetryTasks", Method: http.MethodPost, Path: "/admin/queue/batch/retry", Tag: tagAdmin,
+		Summary: "Retry failed tasks", Body: admin.BatchTaskService{}, Response: admin.BatchRetryTaskResponse{}},
+	{ID: "adminRetryFailedTasks", Method: http.MethodPost, Path: "/admin/queue/retry_failed", Tag: tagAdmin,
+		Summary: "Requeue all failed tasks", Body: admin.RetryFailedTaskService{}, Response: admin.BatchRetryTaskResponse{}},
+	{ID: "adminListDeadLetters", Method: http.MethodPost, Path: "/admin/queue/dead_letter", Tag: tagAdmin,
+		Summary: "List tasks failed after exhausting all retries", Body: admin.AdminListService{}, Response: admin.ListTaskResponse{}},
+	{ID: "adminPurgeDeadLetters", Method: http.MethodPost, Path: "/admin/queue/dead_letter/purge", Tag: tagAdmin,
+		Summary: "Purge dead-lettered tasks", Body: admin.PurgeDeadLetterService{}, Response: admin.PurgeDeadLetterResponse{}},
+	{ID: "adminGetDeadLetter", Method: http.MethodGet, Path: "/admin/queue/dead_letter/:id", Tag: tagAdmin,
+		Summary: "Get a dead-lettered task with payload and error history", Response: admin.GetTaskResponse{}},
+	{ID: "adminUpdateDeadLetter", Method: http.MethodPut, Path: "/admin/queue/dead_letter/:id", Tag: tagAdmin,
+		Summary: "Edit payload of a dead-lettered task", Body: admin.UpdateDeadLetterService{}},
+	{ID: "adminReplayDeadLetter", Method: http.MethodPost, Path: "/admin/queue/dead_letter/:id/replay", Tag: tagAdmin,
+		Summary: "Replay a dead-lettered task"},
+	{ID: "adminGetSearchIndexStatus", Method: http.MethodGet, Path: "/admin/search/index", Tag: tagAdmin,
+		Summary: "Get size, health and indexing progress of full-text search index", Response: admin.SearchIndexStatus{}},
+	{ID: "adminRebuildSearchIndex", Method: http.MethodPost, Path: "/admin/search/index/rebuild", Tag: tagAdmin,
+		Summary: "Rebuild full-text search index of all or one user's files", Body: admin.RebuildSearchIndexService{}},
+	{ID: "adminListPolicies", Method: http.MethodPost, Path: "/admin/policy", Tag: tagAdmin,
+		Summary: "List storage policies", Body: admin.AdminListService{}, Response: admin.ListPolicyResponse{}},
+	{ID: "adminGetPolicy", Method: http.MethodGet, Path: "/admin/policy/:id", Tag: tagAdmin,
+		Summary: "Get a storage policy", Response: admin.GetStoragePolicyResponse{}},
+	{ID: "adminGetPolicyOrphanReport", Method: http.MethodGet, Path: "/admin/policy/:id/orphan", Tag: tagAdmin,
+		Summary: "Get report of orphan files scan", Response: manager.OrphanReport{}},
+	{ID: "adminScanPolicyOrphans", Method: http.MethodPost, Path: "/admin/policy/:id/orphan", Tag: tagAdmin,
+		Summary: "Scan orphan files of a storage policy"},
+	{ID: "adminCreatePolicy", Method: http.MethodPut, Path: "/admin/policy", Tag: tagAdmin,
+		Summary: "Create a storage policy", Body: admin.CreateStoragePolicyService{}, Response: admin.GetStoragePolicyResponse{}},
+	{ID: "adminUpdatePolicy", Method: http.MethodPut, Path: "/admin/policy/:id", Tag: tagAdmin,
+		Summary: "Update a storage policy", Body: admin.UpdateStoragePolicyService{}, Response: admin.GetStoragePolicyResponse{}},
+	{ID: "adminCreatePolicyCors", Method: http.MethodPost, Path: "/admin/policy/cors", Tag: tagAdmin,
+		Summary: "Create CORS rules on storage provider", Body: admin.CreateStoragePolicyCorsService{}},
+	{ID: "adminGetPolicyOAuthURL", Method: http.MethodPost, Path: "/admin/policy/oauth/signin", Tag: tagAdmin,
+		Summary: "Get OAuth sign in URL of a storage policy", Body: admin.GetOauthRedirectService{}, Response: ""},
+	{ID: "adminGetPolicyOAuthCallbackURL", Method: http.MethodGet, Path: "/admin/policy/oauth/redirect", Tag: tagAdmin,
+		Summary: "Get OAuth callback URL", Response: ""},
+	{ID: "adminGetPolicyOAuthStatus", Method: http.MethodGet, Path: "/admin/policy/oauth/status/:id", Tag: tagAdmin,
+		Summary: "Get OAuth credential status of a storage policy", Response: admin.OauthCredentialStatus{}},
+	{ID: "adminFinishPolicyOAuth", Method: http.MethodPost, Path: "/admin/policy/oauth/callback", Tag: tagAdmin,
+		Summary: "Finish OAuth sign in of a storage policy", Body: admin.FinishOauthCallbackService{}},
+	{ID: "adminGetSharePointDriveRoot", Method: http.MethodGet, Path: "/admin/policy/oauth/root/:id", Tag: tagAdmin,
+		Summary: "Get root of SharePoint drive", Response: ""},
+	{ID: "adminDeletePolicy", Method: http.MethodDelete, Path: "/admin/policy/:id", Tag: tagAdmin,
+		Summary: "Delete a storage policy"},
+	{ID: "adminListNodes", Method: http.MethodPost, Path: "/admin/node", Tag: tagAdmin,
+		Summary: "List nodes", Body: admin.AdminListService{}, Response: admin.ListNodeResponse{}},
+	{ID: "adminGetNode", Method: http.MethodGet, Path: "/admin/node/:id", Tag: tagAdmin,
+		Summary: "Get a node", Response: admin.GetNodeResponse{}},
+	{ID: "adminTestNode", Method: http.MethodPost, Path: "/admin/node/test", Tag: tagAdmin,
+		Summary: "Test connection to a slave node", Body: admin.TestNodeService{}},
+	{ID: "adminTestDownloader", Method: http.MethodPost, Path: "/admin/node/test/downloader", Tag: tagAdmin,
+		Summary: "Test downloader of a node", Body: admin.TestNodeDownloaderService{}, Response: ""},
+	{ID: "adminCreateNode", Method: http.MethodPut, Path: "/admin/node", Tag: tagAdmin,
+		Summary: "Create a node", Body: admin.UpsertNodeService{}, Response: admin.GetNodeResponse{}},
+	{ID: "adminUpdateNode", Method: http.MethodPut, Path: "/admin/node/:id", Tag: tagAdmin,
+		Summary: "Update a node", Body: admin.UpsertNodeService{}, Response: admin.GetNodeResponse{}},
+	{ID: "adminDeleteNode", Method: http.MethodDelete, Path: "/admin/node/:id", Tag: tagAdmin,
+		Summary: "Delete a node"},
+	{ID: "adminListUsers", Method: http.MethodPost, Path: "/admin/user", Tag: tagAdmin,
+		Summary: "List users", Body: admin.AdminListService{}, Response: admin.ListUserResponse{}},
+	{ID: "adminGetUser", Method: http.MethodGet, Path: "/admin/user/:id", Tag: tagAdmin,
+		Summary: "Get a user", Response: admin.GetUserResponse{}},
+	{ID: "adminUpdateUser", Method: http.MethodPut, Path: "/admin/user/:id", Tag: tagAdmin,
+		Summary: "Update a user", Body: admin.UpsertUserService{}, Response: admin.GetUserResponse{}},
+	{ID: "adminCreateUser", Method: http.MethodPut, Path: "/admin/user", Tag: tagAdmin,
+		Summary: "Create a user", Body: admin.UpsertUserService{}, Response: admin.GetUserResponse{}},
+	{ID: "adminDeleteUsers", Method: http.MethodPost, Path: "/admin/user/batch/delete", Tag: tagAdmin,
+		Summary: "Delete users", Body: admin.BatchUserService{}},
+	{ID: "adminGetStorageRecalcReport", Method: http.MethodGet, Path: "/admin/user/storage/recalculate", Tag: tagAdmin,
+		Summary: "Get report of storage recalculation", Response: manager.StorageRecalcReport{}},
+	{ID: "adminRecalculateStorage", Method: http.MethodPost, Path: "/admin/user/storage/recalculate", Tag: tagAdmin,
+		Summary: "Recalculate used storage of all users"},
+	{ID: "adminCalibrateStorage", Method: http.MethodPost, Path: "/admin/user/:id/calibrate", Tag: tagAdmin,
+		Summary: "Recalculate used storage of a user", Response: admin.GetUserResponse{}},
+	{ID: "adminUpdateUserOverride", Method: http.MethodPut, Path: "/admin/user/:id/override", Tag: tagAdmin,
+		Summary: "Override storage policy and capacity of a user", Body: admin.UserOverrideService{}, Response: admin.GetUserResponse{}},
+	{ID: "adminUpdateUserGroup", Method: http.MethodPut, Path: "/admin/user/:id/group", Tag: tagAdmin,
+		Summary: "Change group of a user, optionally until a time", Body: admin.UserGroupService{}, Response: admin.GetUserResponse{}},
+	{ID: "adminListUserLocks", Method: http.MethodGet, Path: "/admin/user/:id/lock", Tag: tagAdmin,
+		Summary: "List locks on files of a user", Response: []explorer.Lock{}},
+	{ID: "adminForceUnlockUserFiles", Method: http.MethodDelete, Path: "/admin/user/:id/lock", Tag: tagAdmin,
+		Summary: "Release locks on files of a user", Body: admin.UserForceUnlockService{}},
+	{ID: "adminUpdateUserLegalHold", Method: http.MethodPut, Path: "/admin/user/:id/legal-hold", Tag: tagAdmin,
+		Summary: "Place or release legal hold of a user", Body: admin.UserLegalHoldService{}, Response: admin.GetUserResponse{}},
+	{ID: "adminOffboardUser", Method: http.MethodPost, Path: "/admin/user/:id/offboard", Tag: tagAdmin,
+		Summary: "Archive a user and transfer their files", Body: admin.OffboardUserService{}, Response: admin.GetUserResponse{}},
+	{ID: "adminCreateUserTakeout", Method: http.MethodPost, Path: "/admin/user/:id/takeout", Tag: tagAdmin,
+		Summary: "Export personal data of a user", Body: admin.UserTakeoutService{}, Response: explorer.TaskResponse{}},
+	{ID: "adminUnlockUserLogin", Method: http.MethodPost, Path: "/admin/user/:id/unlock", Tag: tagAdmin,
+		Summary: "Release login lockout of a user", Response: admin.GetUserResponse{}},
+	{ID: "adminImpersonateUser", Method: http.MethodPost, Path: "/admin/user/:id/impersonate", Tag: tagAdmin,
+		Summary: "Login as a user", Response: admin.ImpersonateUserResponse{}},
+	{ID: "adminListFiles", Method: http.MethodPost, Path: "/admin/file", Tag: tagAdmin,
+		Summary: "List files", Body: admin.AdminListService{}, Response: admin.ListFileResponse{}},
+	{ID: "adminGetFile", Method: http.MethodGet, Path: "/admin/file/:id", Tag: tagAdmin,
+		Summary: "Get a file", Response: admin.GetFileResponse{}},
+	{ID: "adminUpdateFile", Method: http.MethodPut, Path: "/admin/file/:id", Tag: tagAdmin,
+		Summary: "Update a file", Body: admin.UpsertFileService{}, Response: admin.GetFileResponse{}},
+	{ID: "adminGetFileUrl", Method: http.MethodGet, Path: "/admin/file/url/:id", Tag: tagAdmin,
+		Summary: "Get download URL of a file", Response: ""},
+	{ID: "adminDeleteFiles", Method: http.MethodPost, Path: "/admin/file/batch/delete", Tag: tagAdmin,
+		Summary: "Delete files", Body: admin.BatchFileService{}},
+	{ID: "adminUpdateFileLegalHold", Method: http.MethodPut, Path: "/admin/file/batch/legal-hold", Tag: tagAdmin,
+		Summary: "Place or release legal hold of files", Body: admin.FileLegalHoldService{}},
+	{ID: "adminListEntities", Method: http.MethodPost, Path: "/admin/entity", Tag: tagAdmin,
+		Summary: "List blobs", Body: admin.AdminListService{}, Response: admin.ListEntityResponse{}},
+	{ID: "adminGetEntity", Method: http.MethodGet, Path: "/admin/entity/:id", Tag: tagAdmin,
+		Summary: "Get a blob", Response: admin.GetEntityResponse{}},
+	{ID: "adminDeleteEntities", Method: http.MethodPost, Path: "/admin/entity/batch/delete", Tag: tagAdmin,
+		Summary: "Delete blobs", Body: admin.BatchEntityService{}},
+	{ID: "adminGetEntityUrl", Method: http.MethodGet, Path: "/admin/entity/url/:id", Tag: tagAdmin,
+		Summary: "Get download URL of a blob", Response: ""},
+	{ID: "adminListShares", Method: http.MethodPost, Path: "/admin/share", Tag: tagAdmin,
+		Summary: "List shares", Body: admin.AdminListService{}, Response: admin.ListShareResponse{}},
+	{ID: "adminGetShare", Method: http.MethodGet, Path: "/admin/share/:id", Tag: tagAdmin,
+		Summary: "Get a share", Response: admin.GetShareResponse{}},
+	{ID: "adminDeleteShares", Method: http.MethodPost, Path: "/admin/share/batch/delete", Tag: tagAdmin,
+		Summary: "Delete shares", Body: admin.BatchShareService{}},
+	{ID: "adminListAuditEvents", Method: http.MethodPost, Path: "/admin/audit", Tag: tagAdmin,
+		Summary: "List audit logs", Body: admin.AdminListService{}, Response: admin.ListAuditEventResponse{}},
+	{ID: "adminExportAuditEvents", Method: http.MethodPost, Path: "/admin/audit/export", Tag: tagAdmin,
+		Summary: "Export audit logs", Body: admin.ExportAuditService{}, ContentTypes: []string{"text/csv", "application/json"}},
+	{ID: "adminListAnnouncements", Method: http.MethodGet, Path: "/admin/announcement", Tag: tagAdmin,
+		Summary: "List site announcements", Response: []ent.Announcement{}},
+	{ID: "adminUpsertAnnouncement", Method: http.MethodPut, Path: "/admin/announcement", Tag: tagAdmin,
+		Summary: "Create or update a site announcement", Body: admin.UpsertAnnouncementService{}, Response: ent.Announcement{}},
+	{ID: "adminDeleteAnnouncement", Method: http.MethodDelete, Path: "/admin/announcement/:id", Tag: tagAdmin,
+		Summary: "Delete a site announcement"},
+	{ID: "adminListOrganizations", Method: http.MethodGet, Path: "/admin/organization", Tag: tagAdmin,
+		Summary: "List organizations", Response: []ent.Organization{}},
+	{ID: "adminUpsertOrganization", Method: http.MethodPut, Path: "/admin/organization", Tag: tagAdmin,
+		Summary: "Create or update an organization", Body: admin.UpsertOrganizationService{}, Response: ent.Organization{}},
+	{ID: "adminDeleteOrganization", Method: http.MethodDelete, Path: "/admin/organization/:id", Tag: tagAdmin,
+		Summary: "Delete an organization"},
+	{ID: "adminListWebhooks", Method: http.MethodGet, Path: "/admin/webhook", Tag: tagAdmin,
+		Summary: "List webhooks", Response: admin.ListWebhookResponse{}},
+	{ID: "adminUpsertWebhook", Method: http.MethodPut, Path: "/admin/webhook", Tag: tagAdmin,
+		Summary: "Create or update a webhook", Body: admin.UpsertWebhookService{}, Response: admin.Webhook{}},
+	{ID: "adminDeleteWebhook", Method: http.MethodDelete, Path: "/admin/webhook/:id", Tag: tagAdmin,
+		Summary: "Delete a webhook"},
+	{ID: "adminListWebhookDeliveries", Method: http.MethodGet, Path: "/admin/webhook/:id/deliveries", Tag: tagAdmin,
+		Summary: "List deliveries of a webhook", Query: admin.ListWebhookDeliveryService{}, Response: admin.ListWebhookDeliveryResponse{}},
+	{ID: "adminRedeliverWebhook", Method: http.MethodPost, Path: "/admin/webhook/delivery/:id/redeliver", Tag: tagAdmin,
+		Summary: "Redeliver a webhook delivery", Response: ent.WebhookDelivery{}},
+	{ID: "adminListRetentionRules", Method: http.MethodGet, Path: "/admin/retention", Tag: tagAdmin,
+		Summary: "List retention rules", Query: admin.ListRetentionRuleService{}, Response: admin.ListRetentionRuleResponse{}},
+	{ID: "adminUpsertRetentionRule", Method: http.MethodPut, Path: "/admin/retention", Tag: tagAdmin,
+		Summary: "Create or update a retention rule", Body: admin.UpsertRetentionRuleService{}, Response: ent.RetentionRule{}},
+	{ID: "adminPreviewRetentionRule", Method: http.MethodGet, Path: "/admin/retention/:id/preview", Tag: tagAdmin,
+		Summary: "List files affected by a retention rule", Response: workflows.RetentionPreview{}},
+	{ID: "adminDeleteRetentionRule", Method: http.MethodDelete, Path: "/admin/retention/:id", Tag: tagAdmin,
+		Summary: "Delete a retention rule"},
+	{ID: "adminListModerationCases", Method: http.MethodGet, Path: "/admin/moderation", Tag: tagAdmin,
+		Summary: "List moderation cases", Query: admin.ListModerationCaseService{}, Response: admin.ListModerationCaseResponse{}},
+	{ID: "adminApproveModerationCase", Method: http.MethodPost, Path: "/admin/moderation/:id/approve", Tag: tagAdmin,
+		Summary: "Approve a moderation case and restore the share", Response: ent.ModerationCase{}},
+	{ID: "adminRejectModerationCase", Method: http.MethodPost, Path: "/admin/moderation/:id/reject", Tag: tagAdmin,
+		Summary: "Reject a moderation case and delete the share", Response: ent.ModerationCase{}},
+}
+
+// Build returns the OpenAPI document of given routes. Routes under constants.APIPrefix must match Endpoints
+// one to one, except internal routes and CORS preflight ones.
+func Build(routes gin.RoutesInfo) (*openapi.Document, error) {
+	doc := openapi.NewDocument(openapi.Info{
+		Title:       "Cloudreve API",
+		Description: "Generated from API endpoints of Cloudreve, do not edit.",
+		Version:     constants.BackendVersion,
+	}, constants.APIPrefix)
+
+	endpoints := make(map[string]openapi.Endpoint, len(Endpoints))
+	ids := make(map[string]bool, len(Endpoints))
+	for _, e := range Endpoints {
+		if ids[e.ID] {
+			return nil, fmt.Errorf("duplicated endpoint ID %q", e.ID)
+		}
+
+		ids[e.ID] = true
+		endpoints[e.Method+" "+e.Path] = e
+	}
+
+	var undocumented []string
+	for _, r := range routes {
+		path, ok := strings.CutPrefix(r.Path, constants.APIPrefix)
+		if !ok || r.Method == http.MethodOptions || isInternal(path) {
+			continue
+		}
+
+		key := r.Method + " " + path
+		e, ok := endpoints[key]
+		if !ok {
+			undocumented = append(undocumented, key)
+			continue
+		}
+
+		delete(endpoints, key)
+		if err := doc.Add(e); err != nil {
+			return nil, fmt.Errorf("failed to add endpoint %q: %w", e.ID, err)
+		}
+	}
+
+	if len(undocumented) > 0 {
+		sort.Strings(undocumented)
+		return nil, fmt.Errorf("routes are not described in Endpoints: %s", strings.Join(undocumented, ", "))
+	}
+
+	if len(endpoints) > 0 {
+		unregistered := make([]string, 0, len(endpoints))
+		for key := range endpoints {
+			unregistered = append(unregistered, key)
+		}
+		sort.Strings(unregistered)
+		return nil, fmt.Errorf("endpoints are not registered in router: %s", strings.Join(unregistered, ", "))
+	}
+
+	return doc, nil
+}
+
+func isInternal(path string) bool {
+	for _, prefix := range internalPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,59 @@
+package apidoc_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/routers"
+	"github.com/cloudreve/Cloudreve/v4/routers/apidoc"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func apiRoutes(t *testing.T) gin.RoutesInfo {
+	gin.SetMode(gin.TestMode)
+	l := logging.NewConsoleLogger(logging.LevelError)
+	return routers.APIRoutes(dependency.NewDependency(
+		dependency.WithLogger(l),
+		dependency.WithConfigPath(filepath.Join(t.TempDir(), "conf.ini")),
+		dependency.WithKV(cache.NewMemoStore("", l)),
+	))
+}
+
+func TestSpecUpToDate(t *testing.T) {
+	a := assert.New(t)
+	doc, err := apidoc.Build(apiRoutes(t))
+	if !a.NoError(err) {
+		return
+	}
+
+	expected, err := json.Marshal(doc)
+	a.NoError(err)
+	a.JSONEq(string(expected), string(apidoc.Spec), "openapi.json is outdated, run go generate ./routers/apidoc")
+}
+
+func TestBuild_RoutesMatchEndpoints(t *testing.T) {
+	a := assert.New(t)
+	routes := apiRoutes(t)
+
+	_, err := apidoc.Build(append(slices.Clone(routes),
+		gin.RouteInfo{Method: http.MethodGet, Path: constants.APIPrefix + "/file/undocumented"}))
+	a.ErrorContains(err, "GET /file/undocumented")
+
+	_, err = apidoc.Build(append(slices.Clone(routes),
+		gin.RouteInfo{Method: http.MethodGet, Path: constants.APIPrefix + "/slave/internal"},
+		gin.RouteInfo{Method: http.MethodOptions, Path: constants.APIPrefix + "/file/preflight"}))
+	a.NoError(err, "internal and preflight routes are not documented")
+
+	_, err = apidoc.Build(slices.DeleteFunc(slices.Clone(routes), func(r gin.RouteInfo) bool {
+		return r.Method == http.MethodGet && r.Path == constants.APIPrefix+"/site/ping"
+	}))
+	a.ErrorContains(err, "GET /site/ping")
+}
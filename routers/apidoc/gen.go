@@ -0,0 +1,49 @@
+//go:build ignore
+
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/routers"
+	"github.com/cloudreve/Cloudreve/v4/routers/apidoc"
+	"github.com/gin-gonic/gin"
+)
+
+func main() {
+	gin.SetMode(gin.ReleaseMode)
+	l := logging.NewConsoleLogger(logging.LevelWarning)
+
+	// Routes are only registered, a throwaway config is enough for the middlewares.
+	confDir, err := os.MkdirTemp("", "apidoc")
+	if err != nil {
+		log.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(confDir)
+
+	dep := dependency.NewDependency(
+		dependency.WithLogger(l),
+		dependency.WithConfigPath(filepath.Join(confDir, "conf.ini")),
+		dependency.WithKV(cache.NewMemoStore("", l)),
+	)
+
+	doc, err := apidoc.Build(routers.APIRoutes(dep))
+	if err != nil {
+		log.Fatalf("failed to build OpenAPI document: %v", err)
+	}
+
+	content, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		log.Fatalf("failed to encode OpenAPI document: %v", err)
+	}
+
+	if err := os.WriteFile("openapi.json", append(content, '\n'), 0644); err != nil {
+		log.Fatalf("failed to write OpenAPI document: %v", err)
+	}
+}
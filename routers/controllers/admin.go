@@ -18,6 +18,39 @@ func AdminSummary(c *gin.Context) {
 	c.JSON(200, serializer.Response{Data: res})
 }
 
+func AdminStorageAnalytics(c *gin.Context) {
+	service := ParametersFromContext[*admin.AnalyticsService](c, admin.AnalyticsParamCtx{})
+	res, err := service.Storage(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminUsageAnalytics(c *gin.Context) {
+	service := ParametersFromContext[*admin.AnalyticsService](c, admin.AnalyticsParamCtx{})
+	res, err := service.Usage(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminFileTypeAnalytics(c *gin.Context) {
+	service := ParametersFromContext[*admin.AnalyticsService](c, admin.AnalyticsParamCtx{})
+	res, err := service.FileTypes(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
 // AdminGetSettings 获取站点设定项
 func AdminGetSettings(c *gin.Context) {
 	service := ParametersFromContext[*admin.GetSettingService](c, admin.GetSettingParamCtx{})
@@ -41,6 +74,39 @@ func AdminSetSettings(c *gin.Context) {
 	c.JSON(200, serializer.Response{Data: res})
 }
 
+func AdminExportSiteConfig(c *gin.Context) {
+	service := ParametersFromContext[*admin.ExportSiteConfigService](c, admin.ExportSiteConfigParamCtx{})
+	res, err := service.Export(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="cloudreve.yaml"`)
+	c.Data(200, "application/yaml; charset=utf-8", res)
+}
+
+func AdminImportSiteConfig(c *gin.Context) {
+	service := ParametersFromContext[*admin.ImportSiteConfigService](c, admin.ImportSiteConfigParamCtx{})
+	res, err := service.Import(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminReloadConfig(c *gin.Context) {
+	res, err := admin.ReloadConfig(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
 // AdminListGroups 获取用户组列表
 func AdminListGroups(c *gin.Context) {
 	service := ParametersFromContext[*admin.AdminListService](c, admin.AdminListServiceParamsCtx{})
@@ -85,6 +151,33 @@ func AdminGetQueueMetrics(c *gin.Context) {
 	c.JSON(200, serializer.Response{Data: res})
 }
 
+func AdminGetThumbPregenProgress(c *gin.Context) {
+	res, err := admin.GetThumbPregenProgress(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminGetSearchIndexStatus(c *gin.Context) {
+	res, err := admin.GetSearchIndexStatus(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminRebuildSearchIndex(c *gin.Context) {
+	service := ParametersFromContext[*admin.RebuildSearchIndexService](c, admin.RebuildSearchIndexParamCtx{})
+	if err := service.Rebuild(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
 func AdminListPolicies(c *gin.Context) {
 	service := ParametersFromContext[*admin.AdminListService](c, admin.AdminListServiceParamsCtx{})
 	res, err := service.Policies(c)
@@ -105,6 +198,25 @@ func AdminGetPolicy(c *gin.Context) {
 	c.JSON(200, serializer.Response{Data: res})
 }
 
+func AdminScanPolicyOrphans(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleStoragePolicyService](c, admin.GetStoragePolicyParamCtx{})
+	if err := service.ScanOrphans(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminGetPolicyOrphanReport(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleStoragePolicyService](c, admin.GetStoragePolicyParamCtx{})
+	res, err := service.OrphanReport(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
 // AdminSendTestMail 发送测试邮件
 func AdminSendTestMail(c *gin.Context) {
 	service := ParametersFromContext[*admin.TestSMTPService](c, admin.TestSMTPParamCtx{})
@@ -548,6 +660,96 @@ func AdminBatchDeleteTask(c *gin.Context) {
 	c.JSON(200, serializer.Response{})
 }
 
+func AdminCancelTask(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleTaskService](c, admin.SingleTaskParamCtx{})
+	err := service.Cancel(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminRetryTask(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleTaskService](c, admin.SingleTaskParamCtx{})
+	err := service.Retry(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminBatchRetryTask(c *gin.Context) {
+	service := ParametersFromContext[*admin.BatchTaskService](c, admin.BatchTaskParamCtx{})
+	res, err := service.Retry(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminRetryFailedTasks(c *gin.Context) {
+	service := ParametersFromContext[*admin.RetryFailedTaskService](c, admin.RetryFailedTaskParamCtx{})
+	res, err := service.Retry(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminListDeadLetters(c *gin.Context) {
+	service := ParametersFromContext[*admin.AdminListService](c, admin.AdminListServiceParamsCtx{})
+	res, err := service.DeadLetters(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminGetDeadLetter(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleTaskService](c, admin.SingleTaskParamCtx{})
+	res, err := service.GetDeadLetter(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminUpdateDeadLetter(c *gin.Context) {
+	service := ParametersFromContext[*admin.UpdateDeadLetterService](c, admin.UpdateDeadLetterParamCtx{})
+	err := service.Update(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminReplayDeadLetter(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleTaskService](c, admin.SingleTaskParamCtx{})
+	err := service.ReplayDeadLetter(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminPurgeDeadLetters(c *gin.Context) {
+	service := ParametersFromContext[*admin.PurgeDeadLetterService](c, admin.PurgeDeadLetterParamCtx{})
+	res, err := service.Purge(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
 func AdminListShares(c *gin.Context) {
 	service := ParametersFromContext[*admin.AdminListService](c, admin.AdminListServiceParamsCtx{})
 	res, err := service.Shares(c)
@@ -577,6 +779,103 @@ func AdminBatchDeleteShare(c *gin.Context) {
 	}
 }
 
+func AdminUpdateUserOverride(c *gin.Context) {
+	service := ParametersFromContext[*admin.UserOverrideService](c, admin.UserOverrideParamCtx{})
+	res, err := service.Update(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminUpdateUserGroup(c *gin.Context) {
+	service := ParametersFromContext[*admin.UserGroupService](c, admin.UserGroupParamCtx{})
+	res, err := service.Update(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminUpdateUserLegalHold(c *gin.Context) {
+	service := ParametersFromContext[*admin.UserLegalHoldService](c, admin.UserLegalHoldParamCtx{})
+	res, err := service.Update(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminUpdateFileLegalHold(c *gin.Context) {
+	service := ParametersFromContext[*admin.FileLegalHoldService](c, admin.FileLegalHoldParamCtx{})
+	if err := service.Update(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminOffboardUser(c *gin.Context) {
+	service := ParametersFromContext[*admin.OffboardUserService](c, admin.OffboardUserParamCtx{})
+	res, err := service.Offboard(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminCreateUserTakeout(c *gin.Context) {
+	service := ParametersFromContext[*admin.UserTakeoutService](c, admin.UserTakeoutParamCtx{})
+	res, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminUnlockUserLogin(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleUserService](c, admin.SingleUserParamCtx{})
+	res, err := service.UnlockLogin(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminImpersonateUser(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleUserService](c, admin.SingleUserParamCtx{})
+	res, err := service.Impersonate(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminListAuditEvents(c *gin.Context) {
+	service := ParametersFromContext[*admin.AdminListService](c, admin.AdminListServiceParamsCtx{})
+	res, err := service.AuditEvents(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminExportAuditEvents(c *gin.Context) {
+	service := ParametersFromContext[*admin.ExportAuditService](c, admin.ExportAuditParamCtx{})
+	if err := service.Export(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+}
+
 func AdminCalibrateStorage(c *gin.Context) {
 	service := ParametersFromContext[*admin.SingleUserService](c, admin.SingleUserParamCtx{})
 	res, err := service.CalibrateStorage(c)
@@ -586,3 +885,233 @@ func AdminCalibrateStorage(c *gin.Context) {
 	}
 	c.JSON(200, serializer.Response{Data: res})
 }
+
+func AdminListUserLocks(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleUserService](c, admin.SingleUserParamCtx{})
+	res, err := service.ListLocks(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminForceUnlockUserFiles(c *gin.Context) {
+	service := ParametersFromContext[*admin.UserForceUnlockService](c, admin.UserForceUnlockParamCtx{})
+	if err := service.Unlock(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminRecalculateStorage(c *gin.Context) {
+	if err := admin.RecalculateStorage(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminGetStorageRecalcReport(c *gin.Context) {
+	res, err := admin.StorageRecalcReport(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminListAnnouncements(c *gin.Context) {
+	res, err := admin.ListAnnouncements(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminUpsertAnnouncement(c *gin.Context) {
+	service := ParametersFromContext[*admin.UpsertAnnouncementService](c, admin.UpsertAnnouncementParamCtx{})
+	res, err := service.Upsert(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminDeleteAnnouncement(c *gin.Context) {
+	service := ParametersFromContext[*admin.DeleteAnnouncementService](c, admin.DeleteAnnouncementParamCtx{})
+	if err := service.Delete(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminListOrganizations(c *gin.Context) {
+	res, err := admin.ListOrganizations(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminUpsertOrganization(c *gin.Context) {
+	service := ParametersFromContext[*admin.UpsertOrganizationService](c, admin.UpsertOrganizationParamCtx{})
+	res, err := service.Upsert(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminDeleteOrganization(c *gin.Context) {
+	service := ParametersFromContext[*admin.DeleteOrganizationService](c, admin.DeleteOrganizationParamCtx{})
+	if err := service.Delete(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminListWebhooks(c *gin.Context) {
+	res, err := admin.ListWebhooks(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminUpsertWebhook(c *gin.Context) {
+	service := ParametersFromContext[*admin.UpsertWebhookService](c, admin.UpsertWebhookParamCtx{})
+	res, err := service.Upsert(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminDeleteWebhook(c *gin.Context) {
+	service := ParametersFromContext[*admin.DeleteWebhookService](c, admin.DeleteWebhookParamCtx{})
+	if err := service.Delete(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminListWebhookDeliveries(c *gin.Context) {
+	service := ParametersFromContext[*admin.ListWebhookDeliveryService](c, admin.ListWebhookDeliveryParamCtx{})
+	res, err := service.List(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminRedeliverWebhook(c *gin.Context) {
+	service := ParametersFromContext[*admin.RedeliverWebhookService](c, admin.RedeliverWebhookParamCtx{})
+	res, err := service.Redeliver(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminListRetentionRules(c *gin.Context) {
+	service := ParametersFromContext[*admin.ListRetentionRuleService](c, admin.ListRetentionRuleParamCtx{})
+	res, err := service.List(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminUpsertRetentionRule(c *gin.Context) {
+	service := ParametersFromContext[*admin.UpsertRetentionRuleService](c, admin.UpsertRetentionRuleParamCtx{})
+	res, err := service.Upsert(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminPreviewRetentionRule(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleRetentionRuleService](c, admin.SingleRetentionRuleParamCtx{})
+	res, err := service.Preview(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminDeleteRetentionRule(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleRetentionRuleService](c, admin.SingleRetentionRuleParamCtx{})
+	if err := service.Delete(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{})
+}
+
+func AdminListModerationCases(c *gin.Context) {
+	service := ParametersFromContext[*admin.ListModerationCaseService](c, admin.ListModerationCaseParamCtx{})
+	res, err := service.List(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminApproveModerationCase(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleModerationCaseService](c, admin.SingleModerationCaseParamCtx{})
+	res, err := service.Approve(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+func AdminRejectModerationCase(c *gin.Context) {
+	service := ParametersFromContext[*admin.SingleModerationCaseService](c, admin.SingleModerationCaseParamCtx{})
+	res, err := service.Reject(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// OrgGetOrganization 获取组织信息
+func OrgGetOrganization(c *gin.Context) {
+	res, err := admin.GetOrganization(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// OrgUpdateBranding 更新组织品牌设置
+func OrgUpdateBranding(c *gin.Context) {
+	service := ParametersFromContext[*admin.UpdateOrganizationBrandingService](c, admin.UpdateOrganizationBrandingParamCtx{})
+	res, err := service.Update(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+	c.JSON(200, serializer.Response{Data: res})
+}
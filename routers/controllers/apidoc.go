@@ -0,0 +1,39 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/routers/apidoc"
+	"github.com/gin-gonic/gin"
+)
+
+// swaggerUIPage renders the OpenAPI document with Swagger UI loaded from CDN.
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8" />
+  <meta name="viewport" content="width=device-width, initial-scale=1" />
+  <title>Cloudreve API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css" />
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = () => {
+      window.ui = SwaggerUIBundle({ url: "` + constants.APIPrefix + `/openapi.json", dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>`
+
+// OpenAPISpec serves the OpenAPI document of the API.
+func OpenAPISpec(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json; charset=utf-8", apidoc.Spec)
+}
+
+// SwaggerUI serves Swagger UI of the OpenAPI document.
+func SwaggerUI(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(swaggerUIPage))
+}
@@ -21,7 +21,5 @@ func ListDirectory(c *gin.Context) {
 		return
 	}
 
-	c.JSON(200, serializer.Response{
-		Data: resp,
-	})
+	JSONWithETag(c, resp.ETag(c.Request.URL.RawQuery), resp)
 }
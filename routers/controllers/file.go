@@ -1,6 +1,10 @@
 package controllers
 
 import (
+	"html/template"
+	"net/http"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/service/explorer"
@@ -34,6 +38,23 @@ func CreateArchive(c *gin.Context) {
 	}
 }
 
+// CreateTranscode 创建视频转码任务
+func CreateTranscode(c *gin.Context) {
+	service := ParametersFromContext[*explorer.TranscodeWorkflowService](c, explorer.CreateTranscodeParamCtx{})
+	resp, err := service.CreateTranscodeTask(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	if resp != nil {
+		c.JSON(200, serializer.Response{
+			Data: resp,
+		})
+	}
+}
+
 // ImportFiles imports files
 func ImportFiles(c *gin.Context) {
 	service := ParametersFromContext[*explorer.ImportWorkflowService](c, explorer.CreateImportParamCtx{})
@@ -129,6 +150,163 @@ func Thumb(c *gin.Context) {
 	c.JSON(200, serializer.Response{Data: res})
 }
 
+// BatchThumb gets thumbnails of multiple files
+func BatchThumb(c *gin.Context) {
+	service := ParametersFromContext[*explorer.BatchFileThumbService](c, explorer.BatchFileThumbParameterCtx{})
+	res, err := service.Get(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// Preview serves preview rendition of the file
+func Preview(c *gin.Context) {
+	service := ParametersFromContext[*explorer.FilePreviewService](c, explorer.FilePreviewParameterCtx{})
+	if err := service.Serve(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+}
+
+// Waveform gets waveform peak data of audio file
+func Waveform(c *gin.Context) {
+	service := ParametersFromContext[*explorer.FileWaveformService](c, explorer.FileWaveformParameterCtx{})
+	res, err := service.Get(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// TextPreview gets text file content decoded into UTF-8
+func TextPreview(c *gin.Context) {
+	service := ParametersFromContext[*explorer.FileTextPreviewService](c, explorer.FileTextPreviewParameterCtx{})
+	res, err := service.Get(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// Subtitle serves subtitle file for video players
+func Subtitle(c *gin.Context) {
+	service := ParametersFromContext[*explorer.FileSubtitleService](c, explorer.FileSubtitleParameterCtx{})
+	if err := service.Serve(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+}
+
+// VideoSprite gets sprite sheet layout of video file
+func VideoSprite(c *gin.Context) {
+	service := ParametersFromContext[*explorer.FileSpriteService](c, explorer.FileSpriteParameterCtx{})
+	res, err := service.Get(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// VideoSpriteImage serves sprite sheet image of video file
+func VideoSpriteImage(c *gin.Context) {
+	service := ParametersFromContext[*explorer.FileSpriteService](c, explorer.FileSpriteParameterCtx{})
+	if err := service.Serve(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+}
+
+// PhotoMap gets clustered locations of user's photos
+func PhotoMap(c *gin.Context) {
+	service := ParametersFromContext[*explorer.PhotoMapService](c, explorer.PhotoMapParameterCtx{})
+	res, err := service.Clusters(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// FullTextSearch searches files by content
+func FullTextSearch(c *gin.Context) {
+	service := ParametersFromContext[*explorer.FullTextSearchService](c, explorer.FullTextSearchParameterCtx{})
+	res, err := service.Search(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// ListSavedSearches lists saved searches of current user
+func ListSavedSearches(c *gin.Context) {
+	res, err := explorer.ListSavedSearches(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// CreateSavedSearch saves a search query
+func CreateSavedSearch(c *gin.Context) {
+	service := ParametersFromContext[*explorer.SavedSearchService](c, explorer.SavedSearchParameterCtx{})
+	res, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UpdateSavedSearch updates a saved search
+func UpdateSavedSearch(c *gin.Context) {
+	service := ParametersFromContext[*explorer.SavedSearchService](c, explorer.SavedSearchParameterCtx{})
+	res, err := service.Update(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// DeleteSavedSearch deletes a saved search
+func DeleteSavedSearch(c *gin.Context) {
+	if err := explorer.DeleteSavedSearch(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
 // FileURL get temporary file url for preview or download
 func FileURL(c *gin.Context) {
 	service := ParametersFromContext[*explorer.FileURLService](c, explorer.FileURLParameterCtx{})
@@ -188,6 +366,20 @@ func PutContent(c *gin.Context) {
 	c.JSON(200, serializer.Response{Data: res})
 }
 
+// PatchContent overwrites a byte range of file content
+func PatchContent(c *gin.Context) {
+	service := ParametersFromContext[*explorer.FilePatchService](c, explorer.FilePatchParameterCtx{})
+	res, err := service.PatchContent(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		request.BlackHole(c.Request.Body)
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
 // FileUpload 本地策略文件上传
 func FileUpload(c *gin.Context) {
 	service := ParametersFromContext[*explorer.UploadService](c, explorer.UploadParameterCtx{})
@@ -245,6 +437,56 @@ func CreateFile(c *gin.Context) {
 	})
 }
 
+// linkPage asks visitors to confirm before leaving the site for the target of a web link file.
+var linkPage = template.Must(template.New("link").Parse(`<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8" />
+  <meta name="viewport" content="width=device-width, initial-scale=1" />
+  <meta name="referrer" content="no-referrer" />
+  <title>Leaving {{.Site}}</title>
+</head>
+<body style="font-family: sans-serif; max-width: 640px; margin: 10vh auto; padding: 0 16px;">
+  <h2>You are leaving {{.Site}}</h2>
+  <p>This link points to an external site not checked by {{.Site}}, only continue if you trust it:</p>
+  <p style="word-break: break-all;"><code>{{.Target}}</code></p>
+  <p><a href="{{.Target}}" rel="noopener noreferrer nofollow">Continue</a></p>
+</body>
+</html>`))
+
+// OpenLink renders the page leading to the target of a web link file.
+func OpenLink(c *gin.Context) {
+	target, err := explorer.LinkTarget(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	c.Header("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'")
+	c.Status(http.StatusOK)
+	_ = linkPage.Execute(c.Writer, map[string]string{
+		"Site":   dependency.FromContext(c).SettingProvider().SiteBasic(c).Name,
+		"Target": target,
+	})
+}
+
+// CreateLink creates a web link file
+func CreateLink(c *gin.Context) {
+	service := ParametersFromContext[*explorer.CreateLinkService](c, explorer.CreateLinkParameterCtx{})
+	resp, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{
+		Data: resp,
+	})
+}
+
 // RenameFile Renames a file.
 func RenameFile(c *gin.Context) {
 	service := ParametersFromContext[*explorer.RenameFileService](c, explorer.RenameFileParameterCtx{})
@@ -272,6 +514,25 @@ func MoveFile(c *gin.Context) {
 	c.JSON(200, serializer.Response{})
 }
 
+// BatchFileOperations executes file operations in one transaction.
+func BatchFileOperations(c *gin.Context) {
+	service := ParametersFromContext[*explorer.BatchFileService](c, explorer.BatchFileParameterCtx{})
+	resp, err := service.Execute(c)
+	if err != nil {
+		res := serializer.Err(c, err)
+		if resp != nil {
+			res.Data = resp
+		}
+		c.JSON(200, res)
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{
+		Data: resp,
+	})
+}
+
 // Delete 删除文件或目录
 func Delete(c *gin.Context) {
 	service := ParametersFromContext[*explorer.DeleteFileService](c, explorer.DeleteFileParameterCtx{})
@@ -298,6 +559,19 @@ func Restore(c *gin.Context) {
 	c.JSON(200, serializer.Response{})
 }
 
+// RestoreTree restores all files deleted from a folder or its descendants
+func RestoreTree(c *gin.Context) {
+	service := ParametersFromContext[*explorer.RestoreTreeService](c, explorer.RestoreTreeParameterCtx{})
+	err := service.RestoreTree(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
 // Unlock unlocks files by given tokens
 func Unlock(c *gin.Context) {
 	service := ParametersFromContext[*explorer.UnlockFileService](c, explorer.UnlockFileParameterCtx{})
@@ -311,6 +585,44 @@ func Unlock(c *gin.Context) {
 	c.JSON(200, serializer.Response{})
 }
 
+// ListLocks lists active locks on files of current user
+func ListLocks(c *gin.Context) {
+	res, err := (&explorer.ListLocksService{}).List(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// Checkout checks out a file until given expire time
+func Checkout(c *gin.Context) {
+	service := ParametersFromContext[*explorer.CheckoutFileService](c, explorer.CheckoutFileParameterCtx{})
+	res, err := service.Checkout(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// Checkin releases a check-out by its token
+func Checkin(c *gin.Context) {
+	service := ParametersFromContext[*explorer.CheckinFileService](c, explorer.CheckinFileParameterCtx{})
+	err := service.Checkin(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
 // Pin pins files by given uri
 func Pin(c *gin.Context) {
 	service := ParametersFromContext[*explorer.PinFileService](c, explorer.PinFileParameterCtx{})
@@ -358,9 +670,7 @@ func GetFileInfo(c *gin.Context) {
 		return
 	}
 
-	c.JSON(200, serializer.Response{
-		Data: resp,
-	})
+	JSONWithETag(c, resp.ETag(), resp)
 }
 
 // SetCurrentVersion sets current version
@@ -376,6 +686,19 @@ func SetCurrentVersion(c *gin.Context) {
 	c.JSON(200, serializer.Response{})
 }
 
+// ListVersions lists versions of a file
+func ListVersions(c *gin.Context) {
+	service := ParametersFromContext[*explorer.ListVersionsService](c, explorer.ListVersionsParamCtx{})
+	res, err := service.List(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
 // DeleteVersion deletes a version
 func DeleteVersion(c *gin.Context) {
 	service := ParametersFromContext[*explorer.DeleteVersionService](c, explorer.DeleteVersionParamCtx{})
@@ -401,3 +724,21 @@ func EnableFileThumbnail(c *gin.Context) {
 
 	c.JSON(200, serializer.Response{})
 }
+
+// FileEvents streams real-time events of current user over WebSocket.
+func FileEvents(c *gin.Context) {
+	service := ParametersFromContext[*explorer.EventStreamService](c, explorer.EventStreamParameterCtx{})
+	if err := service.Stream(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+	}
+}
+
+// FileEventSource streams real-time events of current user as Server-Sent Events.
+func FileEventSource(c *gin.Context) {
+	service := ParametersFromContext[*explorer.EventSourceService](c, explorer.EventSourceParameterCtx{})
+	if err := service.Stream(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+	}
+}
@@ -0,0 +1,17 @@
+package controllers
+
+import (
+	"github.com/cloudreve/Cloudreve/v4/service/graph"
+	"github.com/gin-gonic/gin"
+)
+
+// GraphQLQuery executes a GraphQL query, the result is returned in GraphQL response format
+// instead of serializer.Response.
+func GraphQLQuery(c *gin.Context) {
+	graph.Execute(c)
+}
+
+// GraphQLSchema returns the GraphQL schema in SDL.
+func GraphQLSchema(c *gin.Context) {
+	c.String(200, graph.SDL())
+}
@@ -3,7 +3,10 @@ package controllers
 import (
 	"context"
 	"encoding/json"
+	"net/http"
+
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 )
@@ -103,3 +106,19 @@ func FromUri[T any](ctxKey any) gin.HandlerFunc {
 func ParametersFromContext[T any](c *gin.Context, ctxKey any) T {
 	return c.Request.Context().Value(ctxKey).(T)
 }
+
+// JSONWithETag responds data with given entity tag, or 304 if the client has an up-to-date copy.
+func JSONWithETag(c *gin.Context, etag string, data any) {
+	if etag != "" {
+		c.Header("ETag", etag)
+		c.Header("Cache-Control", "private, no-cache")
+		if util.ETagMatch(c.GetHeader("If-None-Match"), etag) {
+			c.Status(http.StatusNotModified)
+			return
+		}
+	}
+
+	c.JSON(200, serializer.Response{
+		Data: data,
+	})
+}
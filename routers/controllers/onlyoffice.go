@@ -0,0 +1,31 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/pkg/onlyoffice"
+	"github.com/cloudreve/Cloudreve/v4/service/explorer"
+	"github.com/gin-gonic/gin"
+)
+
+// OnlyOfficeGetFile serves document content to DocumentServer
+func OnlyOfficeGetFile(c *gin.Context) {
+	var service explorer.OnlyOfficeService
+	if err := service.GetFile(c); err != nil {
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+}
+
+// OnlyOfficeCallback handles callback of DocumentServer
+func OnlyOfficeCallback(c *gin.Context) {
+	var service explorer.OnlyOfficeService
+	if err := service.Callback(c); err != nil {
+		dependency.FromContext(c).Logger().Warning("Failed to handle OnlyOffice callback: %s", err)
+		c.JSON(http.StatusOK, onlyoffice.CallbackResponse{Error: 1})
+		return
+	}
+
+	c.JSON(http.StatusOK, onlyoffice.CallbackResponse{Error: 0})
+}
@@ -0,0 +1,48 @@
+package controllers
+
+import (
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/service/setting"
+	"github.com/gin-gonic/gin"
+)
+
+// ListS3AccessKeys lists S3 gateway access keys of current user.
+func ListS3AccessKeys(c *gin.Context) {
+	resp, err := setting.ListS3AccessKeys(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{
+		Data: resp,
+	})
+}
+
+// CreateS3AccessKey creates a new S3 gateway access key.
+func CreateS3AccessKey(c *gin.Context) {
+	service := ParametersFromContext[*setting.CreateS3AccessKeyService](c, setting.CreateS3AccessKeyParamCtx{})
+	resp, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{
+		Data: resp,
+	})
+}
+
+// DeleteS3AccessKey revokes an S3 gateway access key.
+func DeleteS3AccessKey(c *gin.Context) {
+	err := setting.DeleteS3AccessKey(c, c.Param("id"))
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
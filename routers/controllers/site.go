@@ -1,6 +1,8 @@
 package controllers
 
 import (
+	"net/http"
+
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
@@ -37,6 +39,22 @@ func Ping(c *gin.Context) {
 	})
 }
 
+// Healthz 存活探针
+func Healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, basic.Liveness(c))
+}
+
+// Readyz 就绪探针，检查各项依赖服务
+func Readyz(c *gin.Context) {
+	res, ready := basic.Readiness(c)
+	status := http.StatusOK
+	if !ready {
+		status = http.StatusServiceUnavailable
+	}
+
+	c.JSON(status, res)
+}
+
 // Captcha 获取验证码
 func Captcha(c *gin.Context) {
 	c.JSON(200, serializer.Response{
@@ -77,3 +95,15 @@ func Manifest(c *gin.Context) {
 		"background_color": pwaOpts.BackgroundColor,
 	})
 }
+
+// SiteAnnouncements lists active announcements for current user
+func SiteAnnouncements(c *gin.Context) {
+	res, err := basic.ListAnnouncements(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
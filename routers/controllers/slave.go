@@ -3,11 +3,13 @@ package controllers
 import (
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/slave"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/service/admin"
@@ -65,6 +67,17 @@ func SlaveServeEntity(c *gin.Context) {
 	}
 }
 
+// SlaveServeEdge serves entity content through edge cache
+func SlaveServeEdge(c *gin.Context) {
+	service := ParametersFromContext[*explorer.SlaveEdgeService](c, explorer.SlaveEdgeParamCtx{})
+	err := service.Serve(c)
+	if err != nil {
+		c.JSON(400, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+}
+
 // SlaveMeta retrieve media metadata
 func SlaveMeta(c *gin.Context) {
 	service := ParametersFromContext[*explorer.SlaveMetaService](c, explorer.SlaveMetaParamCtx{})
@@ -89,6 +102,19 @@ func SlaveThumb(c *gin.Context) {
 	}
 }
 
+// SlaveGenerateThumb generates thumbnail for a file stored outside of slave node
+func SlaveGenerateThumb(c *gin.Context) {
+	service := ParametersFromContext[*cluster.GenerateSlaveThumb](c, explorer.SlaveGenerateThumbParamCtx{})
+	res, err := explorer.SlaveGenerateThumb(c, service)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.NewResponseWithGobData(c, res))
+}
+
 // SlaveDelete 从机删除
 func SlaveDelete(c *gin.Context) {
 	service := ParametersFromContext[*explorer.SlaveDeleteFileService](c, explorer.SlaveDeleteFileParamCtx{})
@@ -104,6 +130,19 @@ func SlaveDelete(c *gin.Context) {
 	}
 }
 
+// SlaveHeartbeat 接收从机心跳
+func SlaveHeartbeat(c *gin.Context) {
+	hb := ParametersFromContext[*cluster.Heartbeat](c, node.HeartbeatParamCtx{})
+	res, err := node.ReceiveHeartbeat(hb, c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.NewResponseWithGobData(c, res))
+}
+
 // SlavePing 从机测试
 func SlavePing(c *gin.Context) {
 	service := ParametersFromContext[*admin.SlavePingService](c, admin.SlavePingParameterCtx{})
@@ -299,3 +338,13 @@ func StatelessCreateFile(c *gin.Context) {
 
 	c.JSON(200, serializer.Response{})
 }
+
+// SlaveWebDAV serves WebDAV requests on slave node
+func SlaveWebDAV(c *gin.Context) {
+	if err := node.ServeSlaveWebDAV(c); err != nil {
+		logging.FromContext(c).Warning("Failed to serve WebDAV request: %s", err)
+		if !c.Writer.Written() {
+			c.Status(http.StatusInternalServerError)
+		}
+	}
+}
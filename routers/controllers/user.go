@@ -48,6 +48,17 @@ func StartRegAuthn(c *gin.Context) {
 	c.JSON(200, serializer.Response{Data: res})
 }
 
+// StartVerifyAuthn starts passkey assertion of current user to confirm changes of 2FA settings
+func StartVerifyAuthn(c *gin.Context) {
+	res, err := user.PreparePasskeyVerification(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
 // FinishRegAuthn 完成注册WebAuthn信息
 func FinishRegAuthn(c *gin.Context) {
 	service := ParametersFromContext[*user.FinishPasskeyRegisterService](c, user.FinishPasskeyRegisterParameterCtx{})
@@ -72,6 +83,44 @@ func UserDeletePasskey(c *gin.Context) {
 	c.JSON(200, serializer.Response{})
 }
 
+// UserRenamePasskey renames user passkey
+func UserRenamePasskey(c *gin.Context) {
+	service := ParametersFromContext[*user.RenamePasskeyService](c, user.RenamePasskeyParameterCtx{})
+	res, err := service.RenamePasskey(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserPreparePasskey2FA starts passkey assertion as second factor
+func UserPreparePasskey2FA(c *gin.Context) {
+	service := ParametersFromContext[*user.PreparePasskey2FAService](c, user.PreparePasskey2FAParameterCtx{})
+	res, err := service.Prepare(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserLoginPasskey2FAValidation validates passkey assertion as second factor
+func UserLoginPasskey2FAValidation(c *gin.Context) {
+	service := ParametersFromContext[*user.FinishPasskey2FAService](c, user.FinishPasskey2FAParameterCtx{})
+	expectedUser, err := service.Verify2FA(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	util.WithValue(c, inventory.UserCtx{}, expectedUser)
+	c.Next()
+}
+
 // UserLoginValidation validates user login request
 func UserLoginValidation(c *gin.Context) {
 	service := ParametersFromContext[*user.UserLoginService](c, user.LoginParameterCtx{})
@@ -93,6 +142,129 @@ func UserLoginValidation(c *gin.Context) {
 	c.Abort()
 }
 
+// UserChangeEmail starts a verified email change
+func UserChangeEmail(c *gin.Context) {
+	service := ParametersFromContext[*user.ChangeEmailService](c, user.ChangeEmailParameterCtx{})
+	if err := service.Request(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// UserCancelEmailChange cancels pending email change
+func UserCancelEmailChange(c *gin.Context) {
+	if err := user.CancelEmailChange(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// UserAddEmailAlias adds a secondary email to current user
+func UserAddEmailAlias(c *gin.Context) {
+	service := ParametersFromContext[*user.AddEmailAliasService](c, user.AddEmailAliasParameterCtx{})
+	if err := service.Add(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// UserRemoveEmailAlias removes a secondary email of current user
+func UserRemoveEmailAlias(c *gin.Context) {
+	service := ParametersFromContext[*user.EmailAliasService](c, user.EmailAliasParameterCtx{})
+	if err := service.Remove(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// UserSetPrimaryEmail makes a verified secondary email the primary one
+func UserSetPrimaryEmail(c *gin.Context) {
+	service := ParametersFromContext[*user.EmailAliasService](c, user.EmailAliasParameterCtx{})
+	res, err := service.SetPrimary(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserConfirmEmailAlias verifies a secondary email via link sent to it
+func UserConfirmEmailAlias(c *gin.Context) {
+	service := ParametersFromContext[*user.ConfirmEmailAliasService](c, user.ConfirmEmailAliasParameterCtx{})
+	if err := service.Confirm(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// UserPrepareDeleteAccount begins passkey assertion for account deletion of passwordless users
+func UserPrepareDeleteAccount(c *gin.Context) {
+	res, err := user.PrepareDeleteAccount(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserDeleteAccount schedules deletion of current user
+func UserDeleteAccount(c *gin.Context) {
+	service := ParametersFromContext[*user.DeleteAccountService](c, user.DeleteAccountParameterCtx{})
+	res, err := service.Delete(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserConfirmEmailChange confirms pending email change via link sent to the new address
+func UserConfirmEmailChange(c *gin.Context) {
+	service := ParametersFromContext[*user.EmailChangeSecretService](c, user.EmailChangeSecretParameterCtx{})
+	res, err := service.Confirm(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserVetoEmailChange cancels pending email change via link sent to the old address
+func UserVetoEmailChange(c *gin.Context) {
+	service := ParametersFromContext[*user.EmailChangeSecretService](c, user.EmailChangeSecretParameterCtx{})
+	if err := service.Veto(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// UserRotatePassword changes an expired password
+func UserRotatePassword(c *gin.Context) {
+	service := ParametersFromContext[*user.RotatePasswordService](c, user.RotatePasswordParameterCtx{})
+	if err := service.Rotate(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
 // UserLogin2FAValidation validates user OTP code
 func UserLogin2FAValidation(c *gin.Context) {
 	service := ParametersFromContext[*user.OtpValidationService](c, user.OtpValidationParameterCtx{})
@@ -435,3 +607,114 @@ func SetViewPreference(c *gin.Context) {
 
 	c.JSON(200, serializer.Response{})
 }
+
+// UserListAccessTokens lists personal access tokens of current user
+func UserListAccessTokens(c *gin.Context) {
+	res, err := user.ListAccessTokens(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserCreateAccessToken issues a new personal access token
+func UserCreateAccessToken(c *gin.Context) {
+	service := ParametersFromContext[*user.CreateAccessTokenService](c, user.CreateAccessTokenParameterCtx{})
+	res, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserRevokeAccessToken revokes a personal access token
+func UserRevokeAccessToken(c *gin.Context) {
+	service := ParametersFromContext[*user.RevokeAccessTokenService](c, user.RevokeAccessTokenParameterCtx{})
+	if err := service.Revoke(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// UserListInvitations lists invitation codes of current user
+func UserListInvitations(c *gin.Context) {
+	res, err := user.ListInvitations(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserCreateInvitation generates a new invitation code
+func UserCreateInvitation(c *gin.Context) {
+	service := ParametersFromContext[*user.CreateInvitationService](c, user.CreateInvitationParameterCtx{})
+	res, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserDeleteInvitation revokes an invitation code
+func UserDeleteInvitation(c *gin.Context) {
+	service := ParametersFromContext[*user.DeleteInvitationService](c, user.DeleteInvitationParameterCtx{})
+	if err := service.Delete(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// UserListNotifications lists notifications of current user
+func UserListNotifications(c *gin.Context) {
+	service := ParametersFromContext[*user.ListNotificationService](c, user.ListNotificationParameterCtx{})
+	res, err := service.List(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UserMarkNotificationsRead marks notifications of current user as read
+func UserMarkNotificationsRead(c *gin.Context) {
+	service := ParametersFromContext[*user.MarkNotificationReadService](c, user.MarkNotificationReadParameterCtx{})
+	if err := service.MarkRead(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// UserDismissAnnouncement dismisses an announcement for current user
+func UserDismissAnnouncement(c *gin.Context) {
+	service := ParametersFromContext[*user.DismissAnnouncementService](c, user.DismissAnnouncementParameterCtx{})
+	if err := service.Dismiss(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
@@ -53,6 +53,20 @@ func UpdateDAVAccounts(c *gin.Context) {
 	})
 }
 
+// RotateDAVAccounts replaces password of WebDAV accounts.
+func RotateDAVAccounts(c *gin.Context) {
+	resp, err := setting.RotateDavAccount(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{
+		Data: resp,
+	})
+}
+
 // DeleteDAVAccounts deletes WebDAV accounts.
 func DeleteDAVAccounts(c *gin.Context) {
 	err := setting.DeleteDavAccount(c)
@@ -56,6 +56,16 @@ func ModifyFile(c *gin.Context) {
 		if err == nil {
 			return
 		}
+	case wopi.MethodGetLock:
+		err = service.GetLock(c)
+		if err == nil {
+			return
+		}
+	case wopi.MethodRenameFile:
+		err = service.Rename(c)
+		if err == nil {
+			return
+		}
 	case wopi.MethodRefreshLock:
 		err = service.RefreshLock(c)
 		if err == nil {
@@ -42,6 +42,40 @@ func GetTaskPhaseProgress(c *gin.Context) {
 	}
 }
 
+// CreateTakeout creates a task to export personal data of current user
+func CreateTakeout(c *gin.Context) {
+	service := ParametersFromContext[*explorer.TakeoutWorkflowService](c, explorer.CreateTakeoutParamCtx{})
+	resp, err := service.CreateTakeoutTask(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: resp})
+}
+
+// GetTakeoutUrl gets download URL of a completed personal data export
+func GetTakeoutUrl(c *gin.Context) {
+	resp, err := explorer.TakeoutUrl(c, hashid.FromContext(c))
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: resp})
+}
+
+// DownloadTakeout downloads personal data export through signed URL
+func DownloadTakeout(c *gin.Context) {
+	service := ParametersFromContext[*explorer.TakeoutDownloadService](c, explorer.TakeoutDownloadParamCtx{})
+	if err := service.Download(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+	}
+}
+
 func SetDownloadTaskTarget(c *gin.Context) {
 	taskId := hashid.FromContext(c)
 	service := ParametersFromContext[*explorer.SetDownloadFilesService](c, explorer.SetDownloadFilesParamCtx{})
@@ -66,3 +100,256 @@ func CancelDownloadTask(c *gin.Context) {
 
 	c.JSON(200, serializer.Response{})
 }
+
+// ListRssSubscriptions lists RSS subscriptions of current user
+func ListRssSubscriptions(c *gin.Context) {
+	res, err := explorer.ListRssSubscriptions(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// CreateRssSubscription subscribes a feed
+func CreateRssSubscription(c *gin.Context) {
+	service := ParametersFromContext[*explorer.RssSubscriptionService](c, explorer.RssSubscriptionParameterCtx{})
+	res, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UpdateRssSubscription updates an RSS subscription
+func UpdateRssSubscription(c *gin.Context) {
+	service := ParametersFromContext[*explorer.RssSubscriptionService](c, explorer.RssSubscriptionParameterCtx{})
+	res, err := service.Update(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// DeleteRssSubscription deletes an RSS subscription
+func DeleteRssSubscription(c *gin.Context) {
+	if err := explorer.DeleteRssSubscription(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// ListAutomationRules lists automation rules of current user
+func ListAutomationRules(c *gin.Context) {
+	res, err := explorer.ListAutomationRules(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// CreateAutomationRule creates an automation rule
+func CreateAutomationRule(c *gin.Context) {
+	service := ParametersFromContext[*explorer.AutomationRuleService](c, explorer.AutomationRuleParameterCtx{})
+	res, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UpdateAutomationRule updates an automation rule
+func UpdateAutomationRule(c *gin.Context) {
+	service := ParametersFromContext[*explorer.AutomationRuleService](c, explorer.AutomationRuleParameterCtx{})
+	res, err := service.Update(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// DeleteAutomationRule deletes an automation rule
+func DeleteAutomationRule(c *gin.Context) {
+	if err := explorer.DeleteAutomationRule(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// DryRunAutomationRule tests an automation rule against a file without applying it
+func DryRunAutomationRule(c *gin.Context) {
+	service := ParametersFromContext[*explorer.DryRunAutomationRuleService](c, explorer.DryRunAutomationRuleParameterCtx{})
+	res, err := service.DryRun(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// ListSyncJobs lists folder sync jobs of current user
+func ListSyncJobs(c *gin.Context) {
+	res, err := explorer.ListSyncJobs(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// CreateSyncJob creates a folder sync job
+func CreateSyncJob(c *gin.Context) {
+	service := ParametersFromContext[*explorer.SyncJobService](c, explorer.SyncJobParameterCtx{})
+	res, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UpdateSyncJob updates a folder sync job
+func UpdateSyncJob(c *gin.Context) {
+	service := ParametersFromContext[*explorer.SyncJobService](c, explorer.SyncJobParameterCtx{})
+	res, err := service.Update(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// DeleteSyncJob deletes a folder sync job
+func DeleteSyncJob(c *gin.Context) {
+	if err := explorer.DeleteSyncJob(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// RunSyncJob schedules a folder sync job to run immediately
+func RunSyncJob(c *gin.Context) {
+	if err := explorer.RunSyncJob(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+// ListRetentionRules lists retention rules of current user
+func ListRetentionRules(c *gin.Context) {
+	res, err := explorer.ListRetentionRules(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// CreateRetentionRule creates a retention rule
+func CreateRetentionRule(c *gin.Context) {
+	service := ParametersFromContext[*explorer.RetentionRuleService](c, explorer.RetentionRuleParameterCtx{})
+	res, err := service.Create(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// UpdateRetentionRule updates a retention rule
+func UpdateRetentionRule(c *gin.Context) {
+	service := ParametersFromContext[*explorer.RetentionRuleService](c, explorer.RetentionRuleParameterCtx{})
+	res, err := service.Update(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// PreviewRetentionRule lists files affected by a retention rule without applying it
+func PreviewRetentionRule(c *gin.Context) {
+	service := ParametersFromContext[*explorer.RetentionRuleService](c, explorer.PreviewRetentionRuleParameterCtx{})
+	res, err := service.Preview(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: res})
+}
+
+// DeleteRetentionRule deletes a retention rule
+func DeleteRetentionRule(c *gin.Context) {
+	if err := explorer.DeleteRetentionRule(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{})
+}
+
+func CreateUrlImport(c *gin.Context) {
+	service := ParametersFromContext[*explorer.UrlImportWorkflowService](c, explorer.CreateUrlImportParamCtx{})
+	resp, err := service.CreateUrlImportTask(c)
+	if err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+
+	c.JSON(200, serializer.Response{Data: resp})
+}
+
+func GetUrlImportReport(c *gin.Context) {
+	if err := explorer.UrlImportReport(c); err != nil {
+		c.JSON(200, serializer.Err(c, err))
+		c.Abort()
+		return
+	}
+}
@@ -7,12 +7,17 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/middleware"
+	"github.com/cloudreve/Cloudreve/v4/pkg/apiversion"
+	authpkg "github.com/cloudreve/Cloudreve/v4/pkg/auth"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/slave"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/ipaccess"
 	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/ratelimit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/s3gateway"
 	"github.com/cloudreve/Cloudreve/v4/pkg/webdav"
 	"github.com/cloudreve/Cloudreve/v4/routers/controllers"
 	adminsvc "github.com/cloudreve/Cloudreve/v4/service/admin"
@@ -43,12 +48,20 @@ func InitRouter(dep dependency.Dep) *gin.Engine {
 func newGinEngine(dep dependency.Dep) *gin.Engine {
 	r := gin.New()
 	r.ContextWithFallback = true
-	r.Use(gin.Recovery())
+	r.Use(gin.CustomRecovery(func(c *gin.Context, recovered any) {
+		logging.CapturePanic(c, recovered, c.Request)
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}))
 	r.Use(middleware.InitializeHandling(dep))
 	if dep.ConfigProvider().System().Mode == conf.SlaveMode {
 		r.Use(middleware.InitializeHandlingSlave())
 	}
+	r.Use(middleware.Tracing())
 	r.Use(middleware.Logging())
+
+	// 健康检查
+	r.GET("healthz", controllers.Healthz)
+	r.GET("readyz", controllers.Readyz)
 	return r
 }
 
@@ -111,6 +124,8 @@ func initSlaveRouter(dep dependency.Dep) *gin.Engine {
 	// 跨域相关
 	initCORS(dep.Logger(), dep.ConfigProvider(), r)
 	v4 := r.Group(constants.APIPrefix + "/slave")
+	// Require client certificate of master if mutual TLS is enabled
+	v4.Use(middleware.MasterCertRequired(dep.ConfigProvider()))
 	// 鉴权中间件
 	v4.Use(middleware.SignRequired(dep.GeneralAuth()))
 	// 禁止缓存
@@ -127,7 +142,8 @@ func initSlaveRouter(dep dependency.Dep) *gin.Engine {
 		)
 		// // 测试 Aria2 RPC 连接
 		// v4.POST("ping/aria2", controllers.AdminTestAria2)
-		initSlaveFileRouter(v4)
+		// Client facing file routes are blocked while master is in maintenance
+		initSlaveFileRouter(v4.Group("", middleware.RelayedMaintenance(dep)))
 
 		// 离线下载
 		download := v4.Group("download")
@@ -160,6 +176,26 @@ func initSlaveRouter(dep dependency.Dep) *gin.Engine {
 			)
 		}
 
+		// Serve entities of other policies through edge cache
+		edge := v4.Group("edge")
+		{
+			edge.GET(":key/:size/:speed/:origin/:name",
+				middleware.Sandbox(),
+				controllers.FromUri[explorer.SlaveEdgeService](explorer.SlaveEdgeParamCtx{}),
+				controllers.SlaveServeEdge,
+			)
+			edge.HEAD(":key/:size/:speed/:origin/:name",
+				controllers.FromUri[explorer.SlaveEdgeService](explorer.SlaveEdgeParamCtx{}),
+				controllers.SlaveServeEdge,
+			)
+		}
+
+		// Generate thumbnail for files stored on master or other policies
+		v4.POST("thumb",
+			controllers.FromJSON[cluster.GenerateSlaveThumb](explorer.SlaveGenerateThumbParamCtx{}),
+			controllers.SlaveGenerateThumb,
+		)
+
 		// 异步任务
 		task := v4.Group("task")
 		{
@@ -174,20 +210,23 @@ func initSlaveRouter(dep dependency.Dep) *gin.Engine {
 				controllers.SlaveCleanupFolder)
 		}
 	}
+
+	// WebDAV served on slave node, requests are authorized by master
+	if slaveConf := dep.ConfigProvider().Slave(); slaveConf.WebDAV {
+		if slaveConf.MasterURL == "" || slaveConf.NodeID == 0 {
+			dep.Logger().Warning("WebDAV on slave node requires MasterURL and NodeID, it is disabled.")
+		} else {
+			initWebDAVMethods(r.Group("dav", middleware.CacheControl()), controllers.SlaveWebDAV)
+		}
+	}
 	return r
 }
 
 // initCORS 初始化跨域配置
 func initCORS(l logging.Logger, config conf.ConfigProvider, router *gin.Engine) {
-	c := config.Cors()
-	if c.AllowOrigins[0] != "UNSET" {
-		router.Use(cors.New(cors.Config{
-			AllowOrigins:     c.AllowOrigins,
-			AllowMethods:     c.AllowMethods,
-			AllowHeaders:     c.AllowHeaders,
-			AllowCredentials: c.AllowCredentials,
-			ExposeHeaders:    c.ExposeHeaders,
-		}))
+	// CORS config can be changed by hot reload, so the middleware is always registered.
+	router.Use(middleware.CORS(l, config))
+	if config.Cors().AllowOrigins[0] != "UNSET" {
 		return
 	}
 
@@ -215,7 +254,7 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 	r.GET("manifest.json", controllers.Manifest) // Done
 
 	noAuth := r.Group(constants.APIPrefix)
-	wopi := noAuth.Group("file/wopi", middleware.HashID(hashid.FileID), middleware.ViewerSessionValidation())
+	wopi := noAuth.Group("file/wopi", middleware.IPAccess(dep, ipaccess.API), middleware.HashID(hashid.FileID), middleware.ViewerSessionValidation())
 	{
 		// 获取文件信息
 		wopi.GET(":id", controllers.CheckFileInfo)
@@ -227,45 +266,79 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 		wopi.POST(":id", controllers.ModifyFile)
 	}
 
-	v4 := r.Group(constants.APIPrefix)
+	onlyOffice := noAuth.Group("file/onlyoffice", middleware.IPAccess(dep, ipaccess.API), middleware.HashID(hashid.FileID), middleware.ViewerSessionValidation())
+	{
+		// Get document content
+		onlyOffice.GET(":id/content", controllers.OnlyOfficeGetFile)
+		// Document status callback
+		onlyOffice.POST(":id/callback", controllers.OnlyOfficeCallback)
+	}
 
+	// Redirect file source link
+	source := r.Group("f")
+	{
+		source.GET(":id/:name",
+			middleware.HashID(hashid.SourceLinkID),
+			controllers.AnonymousPermLink)
+	}
+
+	shareShort := r.Group("s")
+	{
+		shareShort.GET(":id",
+			controllers.FromUri[sharesvc.ShortLinkRedirectService](sharesvc.ShortLinkRedirectParamCtx{}),
+			controllers.ShareRedirect,
+		)
+		shareShort.GET(":id/:password",
+			controllers.FromUri[sharesvc.ShortLinkRedirectService](sharesvc.ShortLinkRedirectParamCtx{}),
+			controllers.ShareRedirect,
+		)
+	}
+
+	// Each API version serves the same routes, responses of older versions are converted by shims.
+	for _, version := range apiversion.Supported {
+		initMasterAPIRouter(dep, r.Group(apiversion.Prefix(version), middleware.APIVersion(dep, version)))
+	}
+
+	// 初始化WebDAV相关路由
+	initWebDAV(dep, r.Group("dav", middleware.IPAccess(dep, ipaccess.WebDAV)))
+
+	// S3 compatible gateway
+	initS3Gateway(dep, r.Group("s3", middleware.IPAccess(dep, ipaccess.S3)))
+	return r
+}
+
+// APIRoutes returns routes of master API registered under constants.APIPrefix, without serving them. It is
+// used to generate the API document.
+func APIRoutes(dep dependency.Dep) gin.RoutesInfo {
+	r := gin.New()
+	initMasterAPIRouter(dep, r.Group(constants.APIPrefix))
+	return r.Routes()
+}
+
+// initMasterAPIRouter registers API routes of master under the group of an API version.
+func initMasterAPIRouter(dep dependency.Dep, api *gin.RouterGroup) {
 	/*
 		中间件
 	*/
-	v4.Use(middleware.Session(dep)) // Done
+	api.Use(middleware.IPAccess(dep, ipaccess.API))
+	api.Use(middleware.Session(dep)) // Done
 
 	// 用户会话
-	v4.Use(middleware.CurrentUser())
+	api.Use(middleware.WebSocketAuth())
+	api.Use(middleware.CurrentUser())
+
+	// 维护模式
+	api.Use(middleware.Maintenance(dep))
 
 	// 禁止缓存
-	v4.Use(middleware.CacheControl()) // Done
+	api.Use(middleware.CacheControl()) // Done
 
 	/*
 		路由
 	*/
 	{
-		// Redirect file source link
-		source := r.Group("f")
-		{
-			source.GET(":id/:name",
-				middleware.HashID(hashid.SourceLinkID),
-				controllers.AnonymousPermLink)
-		}
-
-		shareShort := r.Group("s")
-		{
-			shareShort.GET(":id",
-				controllers.FromUri[sharesvc.ShortLinkRedirectService](sharesvc.ShortLinkRedirectParamCtx{}),
-				controllers.ShareRedirect,
-			)
-			shareShort.GET(":id/:password",
-				controllers.FromUri[sharesvc.ShortLinkRedirectService](sharesvc.ShortLinkRedirectParamCtx{}),
-				controllers.ShareRedirect,
-			)
-		}
-
 		// 全局设置相关
-		site := v4.Group("site")
+		site := api.Group("site")
 		{
 			// 测试用路由
 			site.GET("ping", controllers.Ping)
@@ -276,16 +349,39 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				controllers.FromUri[basic.GetSettingService](basic.GetSettingParamCtx{}),
 				controllers.SiteConfig,
 			)
+			// 站点公告
+			site.GET("announcements", controllers.SiteAnnouncements)
+		}
+
+		// OpenAPI document of the API
+		api.GET("openapi.json", controllers.OpenAPISpec)
+		// Swagger UI of the OpenAPI document
+		api.GET("docs",
+			middleware.IsFunctionEnabled(func(c *gin.Context) bool {
+				return dep.SettingProvider().APIDocUIEnabled(c)
+			}),
+			controllers.SwaggerUI,
+		)
+
+		// GraphQL API for dashboard and reporting clients
+		gql := api.Group("graphql")
+		gql.Use(middleware.LoginRequired())
+		{
+			// Execute query
+			gql.POST("", controllers.GraphQLQuery)
+			// Schema in SDL
+			gql.GET("schema", controllers.GraphQLSchema)
 		}
 
 		// User authentication
-		session := v4.Group("session")
+		session := api.Group("session")
 		{
 			token := session.Group("token")
 			// Token based authentication
 			{
 				// 用户登录
 				token.POST("",
+					middleware.RateLimit(dep, ratelimit.Login),
 					middleware.CaptchaRequired(func(c *gin.Context) bool {
 						return dep.SettingProvider().LoginCaptchaEnabled(c)
 					}),
@@ -295,10 +391,30 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				)
 				// 2-factor authentication
 				token.POST("2fa",
+					middleware.RateLimit(dep, ratelimit.Login),
 					controllers.FromJSON[usersvc.OtpValidationService](usersvc.OtpValidationParameterCtx{}),
 					controllers.UserLogin2FAValidation,
 					controllers.UserIssueToken,
 				)
+				// Use passkey as second factor
+				passkey2FA := token.Group("2fa/authn",
+					middleware.IsFunctionEnabled(func(c *gin.Context) bool {
+						return dep.SettingProvider().AuthnEnabled(c)
+					}),
+				)
+				{
+					passkey2FA.PUT("",
+						middleware.RateLimit(dep, ratelimit.Login),
+						controllers.FromJSON[usersvc.PreparePasskey2FAService](usersvc.PreparePasskey2FAParameterCtx{}),
+						controllers.UserPreparePasskey2FA,
+					)
+					passkey2FA.POST("",
+						middleware.RateLimit(dep, ratelimit.Login),
+						controllers.FromJSON[usersvc.FinishPasskey2FAService](usersvc.FinishPasskey2FAParameterCtx{}),
+						controllers.UserLoginPasskey2FAValidation,
+						controllers.UserIssueToken,
+					)
+				}
 				token.POST("refresh",
 					controllers.FromJSON[usersvc.RefreshTokenService](usersvc.RefreshTokenParameterCtx{}),
 					controllers.UserRefreshToken,
@@ -315,6 +431,15 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				controllers.UserPrepareLogin,
 			)
 
+			// 更换已过期的密码
+			session.PATCH("password",
+				middleware.CaptchaRequired(func(c *gin.Context) bool {
+					return dep.SettingProvider().LoginCaptchaEnabled(c)
+				}),
+				controllers.FromJSON[usersvc.RotatePasswordService](usersvc.RotatePasswordParameterCtx{}),
+				controllers.UserRotatePassword,
+			)
+
 			authn := session.Group("authn")
 			{
 				// WebAuthn login prepare
@@ -337,12 +462,12 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 		}
 
 		// 用户相关路由
-		user := v4.Group("user")
+		user := api.Group("user")
 		{
 			// 用户注册 Done
 			user.POST("",
 				middleware.IsFunctionEnabled(func(c *gin.Context) bool {
-					return dep.SettingProvider().RegisterEnabled(c)
+					return dep.SettingProvider().RegisterEnabled(c) || dep.SettingProvider().InvitationEnabled(c)
 				}),
 				middleware.CaptchaRequired(func(c *gin.Context) bool {
 					return dep.SettingProvider().RegCaptchaEnabled(c)
@@ -356,6 +481,24 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				controllers.FromJSON[usersvc.UserResetService](usersvc.UserResetParameterCtx{}),
 				controllers.UserReset,
 			)
+			// 通过邮件里的链接确认新邮箱
+			user.PATCH("email/:id",
+				middleware.HashID(hashid.UserID),
+				controllers.FromJSON[usersvc.EmailChangeSecretService](usersvc.EmailChangeSecretParameterCtx{}),
+				controllers.UserConfirmEmailChange,
+			)
+			// 通过原邮箱里的链接取消邮箱更改
+			user.DELETE("email/:id",
+				middleware.HashID(hashid.UserID),
+				controllers.FromJSON[usersvc.EmailChangeSecretService](usersvc.EmailChangeSecretParameterCtx{}),
+				controllers.UserVetoEmailChange,
+			)
+			// 通过备用邮箱里的链接确认备用邮箱
+			user.PATCH("email/:id/alias",
+				middleware.HashID(hashid.UserID),
+				controllers.FromJSON[usersvc.ConfirmEmailAliasService](usersvc.ConfirmEmailAliasParameterCtx{}),
+				controllers.UserConfirmEmailAlias,
+			)
 			// 发送密码重设邮件
 			user.POST("reset",
 				middleware.CaptchaRequired(func(c *gin.Context) bool {
@@ -387,17 +530,25 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 		}
 
 		// 需要携带签名验证的
-		sign := v4.Group("")
+		sign := api.Group("")
 		sign.Use(middleware.SignRequired(dep.GeneralAuth()))
 		{
 			file := sign.Group("file")
 			{
-				file.GET("archive/:sessionID/archive.zip",
+				middleware.Scoped(file, authpkg.ScopeFileRead).GET("archive/:sessionID/archive.zip",
 					controllers.FromUri[explorer.ArchiveService](explorer.ArchiveParamCtx{}),
 					controllers.DownloadArchive,
 				)
+				// Page leading to the target of a web link file
+				file.GET("link/:id", middleware.HashID(hashid.FileID), controllers.OpenLink)
 			}
 
+			// Download personal data export
+			sign.GET("workflow/takeout/:id/takeout.zip",
+				controllers.FromUri[explorer.TakeoutDownloadService](explorer.TakeoutDownloadParamCtx{}),
+				controllers.DownloadTakeout,
+			)
+
 			// Copy user session
 			sign.GET(
 				"user/session/copy/:id",
@@ -407,7 +558,7 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 		}
 
 		// Receive calls from slave node
-		slave := v4.Group("slave")
+		slave := api.Group("slave")
 		slave.Use(
 			middleware.SlaveRPCSignRequired(),
 		)
@@ -417,6 +568,17 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 			slave.GET("credential/:id",
 				controllers.FromUri[node.OauthCredentialService](node.OauthCredentialParamCtx{}),
 				controllers.SlaveGetCredential)
+			// Receive heartbeat
+			slave.POST("heartbeat",
+				controllers.FromJSON[cluster.Heartbeat](node.HeartbeatParamCtx{}),
+				controllers.SlaveHeartbeat)
+			// Authorize WebDAV request served by slave node
+			slave.POST("dav/assertion",
+				controllers.FromJSON[cluster.DavAssertionRequest](node.DavAssertionParamCtx{}),
+				middleware.DavAssertion(node.DavAssertionParamCtx{}),
+				middleware.WebDAVAuth(),
+				middleware.WebDAVMaintenance(dep),
+				webdav.ServeHTTP)
 			statelessUpload := slave.Group("statelessUpload")
 			{
 				// Prepare upload
@@ -439,7 +601,7 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 		}
 
 		// 回调接口
-		callback := v4.Group("callback")
+		callback := api.Group("callback")
 		{
 			// 远程策略上传回调
 			callback.POST(
@@ -509,7 +671,7 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 		}
 
 		// Workflows
-		wf := v4.Group("workflow")
+		wf := api.Group("workflow")
 		wf.Use(middleware.LoginRequired())
 		{
 			// List
@@ -532,6 +694,22 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				controllers.FromJSON[explorer.ArchiveWorkflowService](explorer.CreateArchiveParamCtx{}),
 				controllers.ExtractArchive,
 			)
+			// Create task to transcode a video
+			wf.POST("transcode",
+				controllers.FromJSON[explorer.TranscodeWorkflowService](explorer.CreateTranscodeParamCtx{}),
+				controllers.CreateTranscode,
+			)
+			// Create task to export personal data
+			wf.POST("takeout",
+				middleware.NoImpersonation(),
+				controllers.FromJSON[explorer.TakeoutWorkflowService](explorer.CreateTakeoutParamCtx{}),
+				controllers.CreateTakeout,
+			)
+			// Get download URL of personal data export
+			wf.GET("takeout/:id",
+				middleware.HashID(hashid.TaskID),
+				controllers.GetTakeoutUrl,
+			)
 
 			remoteDownload := wf.Group("download")
 			{
@@ -551,42 +729,178 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 					controllers.CancelDownloadTask,
 				)
 			}
+
+			// RSS subscriptions that download new feed items
+			rss := wf.Group("rss")
+			{
+				rss.GET("",
+					controllers.ListRssSubscriptions,
+				)
+				rss.PUT("",
+					controllers.FromJSON[explorer.RssSubscriptionService](explorer.RssSubscriptionParameterCtx{}),
+					controllers.CreateRssSubscription,
+				)
+				rss.PATCH(":id",
+					middleware.HashID(hashid.RssSubscriptionID),
+					controllers.FromJSON[explorer.RssSubscriptionService](explorer.RssSubscriptionParameterCtx{}),
+					controllers.UpdateRssSubscription,
+				)
+				rss.DELETE(":id",
+					middleware.HashID(hashid.RssSubscriptionID),
+					controllers.DeleteRssSubscription,
+				)
+			}
+
+			// Automation rules applied on file events
+			automation := wf.Group("automation")
+			{
+				automation.GET("",
+					controllers.ListAutomationRules,
+				)
+				automation.PUT("",
+					controllers.FromJSON[explorer.AutomationRuleService](explorer.AutomationRuleParameterCtx{}),
+					controllers.CreateAutomationRule,
+				)
+				// Test a rule against a file without applying it
+				automation.POST("dry-run",
+					controllers.FromJSON[explorer.DryRunAutomationRuleService](explorer.DryRunAutomationRuleParameterCtx{}),
+					controllers.DryRunAutomationRule,
+				)
+				automation.PATCH(":id",
+					middleware.HashID(hashid.AutomationRuleID),
+					controllers.FromJSON[explorer.AutomationRuleService](explorer.AutomationRuleParameterCtx{}),
+					controllers.UpdateAutomationRule,
+				)
+				automation.DELETE(":id",
+					middleware.HashID(hashid.AutomationRuleID),
+					controllers.DeleteAutomationRule,
+				)
+			}
+
+			// Scheduled one-way folder sync
+			folderSync := wf.Group("sync")
+			{
+				folderSync.GET("",
+					controllers.ListSyncJobs,
+				)
+				folderSync.PUT("",
+					controllers.FromJSON[explorer.SyncJobService](explorer.SyncJobParameterCtx{}),
+					controllers.CreateSyncJob,
+				)
+				folderSync.PATCH(":id",
+					middleware.HashID(hashid.SyncJobID),
+					controllers.FromJSON[explorer.SyncJobService](explorer.SyncJobParameterCtx{}),
+					controllers.UpdateSyncJob,
+				)
+				folderSync.DELETE(":id",
+					middleware.HashID(hashid.SyncJobID),
+					controllers.DeleteSyncJob,
+				)
+				// Run the job on next cron tick
+				folderSync.POST(":id/run",
+					middleware.HashID(hashid.SyncJobID),
+					controllers.RunSyncJob,
+				)
+			}
+
+			// Folder retention rules executed by the scheduler
+			retention := wf.Group("retention")
+			{
+				retention.GET("",
+					controllers.ListRetentionRules,
+				)
+				retention.PUT("",
+					controllers.FromJSON[explorer.RetentionRuleService](explorer.RetentionRuleParameterCtx{}),
+					controllers.CreateRetentionRule,
+				)
+				// List files affected by a rule without applying it
+				retention.POST("preview",
+					controllers.FromJSON[explorer.RetentionRuleService](explorer.PreviewRetentionRuleParameterCtx{}),
+					controllers.PreviewRetentionRule,
+				)
+				retention.PATCH(":id",
+					middleware.HashID(hashid.RetentionRuleID),
+					controllers.FromJSON[explorer.RetentionRuleService](explorer.RetentionRuleParameterCtx{}),
+					controllers.UpdateRetentionRule,
+				)
+				retention.DELETE(":id",
+					middleware.HashID(hashid.RetentionRuleID),
+					controllers.DeleteRetentionRule,
+				)
+			}
+
+			// Import files from a list of URLs
+			urlImport := wf.Group("url-import")
+			{
+				urlImport.POST("",
+					controllers.FromJSON[explorer.UrlImportWorkflowService](explorer.CreateUrlImportParamCtx{}),
+					controllers.CreateUrlImport,
+				)
+				// Download status of each URL as CSV
+				urlImport.GET(":id/report",
+					middleware.HashID(hashid.TaskID),
+					controllers.GetUrlImportReport,
+				)
+			}
 		}
 
 		// 文件
-		file := v4.Group("file")
+		file := api.Group("file")
 		{
 			// List files
-			file.GET("",
+			middleware.Scoped(file, authpkg.ScopeFileRead).GET("",
+				middleware.RateLimitWhen(dep, ratelimit.Search, func(c *gin.Context) bool {
+					uri, err := fs.NewUriFromString(c.Query("uri"))
+					return err == nil && uri.SearchParameters() != nil && uri.FileSystem() != constants.FileSystemShare
+				}),
+				middleware.RateLimitWhen(dep, ratelimit.ShareSearch, func(c *gin.Context) bool {
+					uri, err := fs.NewUriFromString(c.Query("uri"))
+					return err == nil && uri.SearchParameters() != nil && uri.FileSystem() == constants.FileSystemShare
+				}),
 				controllers.FromQuery[explorer.ListFileService](explorer.ListFileParameterCtx{}),
 				controllers.ListDirectory,
 			)
 			// Create file
-			file.POST("create",
+			middleware.Scoped(file, authpkg.ScopeFileUpload).POST("create",
 				controllers.FromJSON[explorer.CreateFileService](explorer.CreateFileParameterCtx{}),
 				controllers.CreateFile,
 			)
+			// Create web link file
+			middleware.Scoped(file, authpkg.ScopeFileUpload).POST("link",
+				controllers.FromJSON[explorer.CreateLinkService](explorer.CreateLinkParameterCtx{}),
+				controllers.CreateLink,
+			)
 			// Rename file
-			file.POST("rename",
+			middleware.Scoped(file, authpkg.ScopeFileManage).POST("rename",
 				controllers.FromJSON[explorer.RenameFileService](explorer.RenameFileParameterCtx{}),
 				controllers.RenameFile,
 			)
 			// Move or copy files
-			file.POST("move",
+			middleware.Scoped(file, authpkg.ScopeFileManage).POST("move",
 				controllers.FromJSON[explorer.MoveFileService](explorer.MoveFileParameterCtx{}),
 				middleware.ValidateBatchFileCount(dep, explorer.MoveFileParameterCtx{}),
 				controllers.MoveFile)
+			// Execute file operations in one transaction
+			file.POST("batch",
+				controllers.FromJSON[explorer.BatchFileService](explorer.BatchFileParameterCtx{}),
+				middleware.ValidateBatchFileCount(dep, explorer.BatchFileParameterCtx{}),
+				controllers.BatchFileOperations)
 			// Get URL of the file for preview/download
-			file.POST("url",
+			middleware.Scoped(file, authpkg.ScopeFileRead).POST("url",
+				middleware.RateLimit(dep, ratelimit.DownloadToken),
 				middleware.ContextHint(),
 				controllers.FromJSON[explorer.FileURLService](explorer.FileURLParameterCtx{}),
 				middleware.ValidateBatchFileCount(dep, explorer.FileURLParameterCtx{}),
 				controllers.FileURL,
 			)
 			// Update file content
-			file.PUT("content",
+			middleware.Scoped(file, authpkg.ScopeFileWrite).PUT("content",
 				controllers.FromQuery[explorer.FileUpdateService](explorer.FileUpdateParameterCtx{}),
 				controllers.PutContent)
+			// Overwrite a byte range of file content
+			middleware.Scoped(file, authpkg.ScopeFileWrite).PATCH("content",
+				controllers.FromQuery[explorer.FilePatchService](explorer.FilePatchParameterCtx{}),
+				controllers.PatchContent)
 			// Get entity content for preview/download
 			content := file.Group("content")
 			contentCors := cors.New(cors.Config{
@@ -595,14 +909,14 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 			content.Use(contentCors)
 			{
 				content.OPTIONS("*option", contentCors)
-				content.GET(":id/:speed/:name",
+				middleware.Scoped(content, authpkg.ScopeFileRead).GET(":id/:speed/:name",
 					middleware.SignRequired(dep.GeneralAuth()),
 					middleware.HashID(hashid.EntityID),
 					middleware.Sandbox(),
 					controllers.FromUri[explorer.EntityDownloadService](explorer.EntityDownloadParameterCtx{}),
 					controllers.ServeEntity,
 				)
-				content.HEAD(":id/:speed/:name",
+				middleware.Scoped(content, authpkg.ScopeFileRead).HEAD(":id/:speed/:name",
 					middleware.SignRequired(dep.GeneralAuth()),
 					middleware.HashID(hashid.EntityID),
 					controllers.FromUri[explorer.EntityDownloadService](explorer.EntityDownloadParameterCtx{}),
@@ -610,13 +924,95 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				)
 			}
 			// 获取缩略图
-			file.GET("thumb",
+			middleware.Scoped(file, authpkg.ScopeFileRead).GET("thumb",
+				middleware.RateLimit(dep, ratelimit.Thumbnail),
 				middleware.ContextHint(),
 				controllers.FromQuery[explorer.FileThumbService](explorer.FileThumbParameterCtx{}),
 				controllers.Thumb,
 			)
+			// Get thumbnails of multiple files
+			middleware.Scoped(file, authpkg.ScopeFileRead).POST("thumbs",
+				middleware.RateLimit(dep, ratelimit.Thumbnail),
+				controllers.FromJSON[explorer.BatchFileThumbService](explorer.BatchFileThumbParameterCtx{}),
+				controllers.BatchThumb,
+			)
+			// Get preview rendition
+			middleware.Scoped(file, authpkg.ScopeFileRead).GET("preview",
+				middleware.RateLimit(dep, ratelimit.Thumbnail),
+				middleware.ContextHint(),
+				controllers.FromQuery[explorer.FilePreviewService](explorer.FilePreviewParameterCtx{}),
+				controllers.Preview,
+			)
+			// Get waveform of audio file
+			middleware.Scoped(file, authpkg.ScopeFileRead).GET("waveform",
+				middleware.RateLimit(dep, ratelimit.Thumbnail),
+				middleware.ContextHint(),
+				controllers.FromQuery[explorer.FileWaveformService](explorer.FileWaveformParameterCtx{}),
+				controllers.Waveform,
+			)
+			// Get text file content decoded into UTF-8
+			middleware.Scoped(file, authpkg.ScopeFileRead).GET("text",
+				middleware.ContextHint(),
+				controllers.FromQuery[explorer.FileTextPreviewService](explorer.FileTextPreviewParameterCtx{}),
+				controllers.TextPreview,
+			)
+			// Get subtitle sidecar of video file, SRT is converted into WebVTT
+			middleware.Scoped(file, authpkg.ScopeFileRead).GET("subtitle",
+				middleware.ContextHint(),
+				controllers.FromQuery[explorer.FileSubtitleService](explorer.FileSubtitleParameterCtx{}),
+				controllers.Subtitle,
+			)
+			// Get sprite sheet layout of video file for scrub previews
+			middleware.Scoped(file, authpkg.ScopeFileRead).GET("sprite",
+				middleware.RateLimit(dep, ratelimit.Thumbnail),
+				middleware.ContextHint(),
+				controllers.FromQuery[explorer.FileSpriteService](explorer.FileSpriteParameterCtx{}),
+				controllers.VideoSprite,
+			)
+			// Get sprite sheet image of video file
+			middleware.Scoped(file, authpkg.ScopeFileRead).GET("sprite/image",
+				middleware.RateLimit(dep, ratelimit.Thumbnail),
+				middleware.ContextHint(),
+				controllers.FromQuery[explorer.FileSpriteService](explorer.FileSpriteParameterCtx{}),
+				controllers.VideoSpriteImage,
+			)
+			// Search files by name, description, tags and recognized text in full-text index
+			middleware.Scoped(file, authpkg.ScopeFileRead).GET("search",
+				middleware.RateLimit(dep, ratelimit.Search),
+				controllers.FromQuery[explorer.FullTextSearchService](explorer.FullTextSearchParameterCtx{}),
+				controllers.FullTextSearch,
+			)
+			// Saved searches
+			savedSearch := file.Group("search/saved")
+			{
+				// List saved searches
+				middleware.Scoped(savedSearch, authpkg.ScopeFileRead).GET("",
+					controllers.ListSavedSearches,
+				)
+				// Save a search query
+				savedSearch.PUT("",
+					controllers.FromJSON[explorer.SavedSearchService](explorer.SavedSearchParameterCtx{}),
+					controllers.CreateSavedSearch,
+				)
+				// Update saved search
+				savedSearch.PATCH(":id",
+					middleware.HashID(hashid.SavedSearchID),
+					controllers.FromJSON[explorer.SavedSearchService](explorer.SavedSearchParameterCtx{}),
+					controllers.UpdateSavedSearch,
+				)
+				// Delete saved search
+				savedSearch.DELETE(":id",
+					middleware.HashID(hashid.SavedSearchID),
+					controllers.DeleteSavedSearch,
+				)
+			}
+			// Get clustered photo locations for map view
+			middleware.Scoped(file, authpkg.ScopeFileRead).GET("geo",
+				controllers.FromQuery[explorer.PhotoMapService](explorer.PhotoMapParameterCtx{}),
+				controllers.PhotoMap,
+			)
 			// Delete files
-			file.DELETE("",
+			middleware.Scoped(file, authpkg.ScopeFileManage).DELETE("",
 				controllers.FromJSON[explorer.DeleteFileService](explorer.DeleteFileParameterCtx{}),
 				middleware.ValidateBatchFileCount(dep, explorer.DeleteFileParameterCtx{}),
 				controllers.Delete,
@@ -626,12 +1022,31 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				controllers.FromJSON[explorer.UnlockFileService](explorer.UnlockFileParameterCtx{}),
 				controllers.Unlock,
 			)
+			// List active locks
+			middleware.Scoped(file, authpkg.ScopeFileRead).GET("lock",
+				controllers.ListLocks,
+			)
+			// Check out file
+			middleware.Scoped(file, authpkg.ScopeFileWrite).PUT("checkout",
+				controllers.FromJSON[explorer.CheckoutFileService](explorer.CheckoutFileParameterCtx{}),
+				controllers.Checkout,
+			)
+			// Check in file
+			middleware.Scoped(file, authpkg.ScopeFileWrite).DELETE("checkout",
+				controllers.FromJSON[explorer.CheckinFileService](explorer.CheckinFileParameterCtx{}),
+				controllers.Checkin,
+			)
 			// Restore files
 			file.POST("restore",
 				controllers.FromJSON[explorer.DeleteFileService](explorer.DeleteFileParameterCtx{}),
 				middleware.ValidateBatchFileCount(dep, explorer.DeleteFileParameterCtx{}),
 				controllers.Restore,
 			)
+			// Restore all files deleted from a folder
+			file.POST("restore/tree",
+				controllers.FromJSON[explorer.RestoreTreeService](explorer.RestoreTreeParameterCtx{}),
+				controllers.RestoreTree,
+			)
 			// Patch metadata
 			file.PATCH("metadata",
 				controllers.FromJSON[explorer.PatchMetadataService](explorer.PatchMetadataParameterCtx{}),
@@ -642,16 +1057,16 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 			upload := file.Group("upload")
 			{
 				// Create upload session
-				upload.PUT("",
+				middleware.Scoped(upload, authpkg.ScopeFileUpload).PUT("",
 					controllers.FromJSON[explorer.CreateUploadSessionService](explorer.CreateUploadSessionParameterCtx{}),
 					controllers.CreateUploadSession,
 				)
 				// Upload file data
-				upload.POST(":sessionId/:index",
+				middleware.Scoped(upload, authpkg.ScopeFileUpload).POST(":sessionId/:index",
 					controllers.FromUri[explorer.UploadService](explorer.UploadParameterCtx{}),
 					controllers.FileUpload,
 				)
-				upload.DELETE("",
+				middleware.Scoped(upload, authpkg.ScopeFileUpload).DELETE("",
 					controllers.FromJSON[explorer.DeleteUploadSessionService](explorer.DeleteUploadSessionParameterCtx{}),
 					controllers.DeleteUploadSession,
 				)
@@ -670,14 +1085,31 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 					controllers.Unpin,
 				)
 			}
+			// Real-time events of current user over WebSocket
+			middleware.Scoped(file, authpkg.ScopeFileRead).GET("events",
+				middleware.LoginRequired(),
+				controllers.FromQuery[explorer.EventStreamService](explorer.EventStreamParameterCtx{}),
+				controllers.FileEvents,
+			)
+			// Real-time events of current user as Server-Sent Events, for clients behind proxies blocking WebSocket
+			middleware.Scoped(file, authpkg.ScopeFileRead).GET("events/sse",
+				middleware.LoginRequired(),
+				controllers.FromQuery[explorer.EventSourceService](explorer.EventSourceParameterCtx{}),
+				controllers.FileEventSource,
+			)
 			// Get file info
-			file.GET("info",
+			middleware.Scoped(file, authpkg.ScopeFileRead).GET("info",
 				controllers.FromQuery[explorer.GetFileInfoService](explorer.GetFileInfoParameterCtx{}),
 				controllers.GetFileInfo,
 			)
 			// Version management
 			version := file.Group("version")
 			{
+				// List versions of a file
+				version.GET("",
+					controllers.FromQuery[explorer.ListVersionsService](explorer.ListVersionsParamCtx{}),
+					controllers.ListVersions,
+				)
 				// Set current version
 				version.POST("current",
 					controllers.FromJSON[explorer.SetCurrentVersionService](explorer.SetCurrentVersionParamCtx{}),
@@ -718,35 +1150,38 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 		}
 
 		// 分享相关
-		share := v4.Group("share")
+		share := api.Group("share")
 		{
 			// Create share link
-			share.PUT("",
+			middleware.Scoped(share, authpkg.ScopeShareManage).PUT("",
 				middleware.LoginRequired(),
 				controllers.FromJSON[sharesvc.ShareCreateService](sharesvc.ShareCreateParamCtx{}),
 				controllers.CreateShare,
 			)
 			// Edit existing share link
-			share.POST(":id",
+			middleware.Scoped(share, authpkg.ScopeShareManage).POST(":id",
 				middleware.LoginRequired(),
 				middleware.HashID(hashid.ShareID),
 				controllers.FromJSON[sharesvc.ShareCreateService](sharesvc.ShareCreateParamCtx{}),
 				controllers.EditShare,
 			)
 			// Get share link info
-			share.GET("info/:id",
+			middleware.Scoped(share, authpkg.ScopeShareManage).GET("info/:id",
+				middleware.RateLimitWhen(dep, ratelimit.SharePassword, func(c *gin.Context) bool {
+					return c.Query("password") != ""
+				}),
 				middleware.HashID(hashid.ShareID),
 				controllers.FromQuery[sharesvc.ShareInfoService](sharesvc.ShareInfoParamCtx{}),
 				controllers.GetShare,
 			)
 			// List my shares
-			share.GET("",
+			middleware.Scoped(share, authpkg.ScopeShareManage).GET("",
 				middleware.LoginRequired(),
 				controllers.FromQuery[sharesvc.ListShareService](sharesvc.ListShareParamCtx{}),
 				controllers.ListShare,
 			)
 			// 删除分享
-			share.DELETE(":id",
+			middleware.Scoped(share, authpkg.ScopeShareManage).DELETE(":id",
 				middleware.LoginRequired(),
 				middleware.HashID(hashid.ShareID),
 				controllers.DeleteShare,
@@ -764,17 +1199,36 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 		}
 
 		// 需要登录保护的
-		auth := v4.Group("")
+		auth := api.Group("")
 		auth.Use(middleware.LoginRequired())
 		{
 			// 管理
-			admin := auth.Group("admin", middleware.IsAdmin())
+			admin := auth.Group("admin", middleware.IPAccess(dep, ipaccess.Admin), middleware.IsAdmin())
 			{
 				admin.GET("summary",
 					controllers.FromQuery[adminsvc.SummaryService](adminsvc.SummaryParamCtx{}),
 					controllers.AdminSummary,
 				)
 
+				analytics := admin.Group("analytics")
+				{
+					// 存储用量趋势
+					analytics.GET("storage",
+						controllers.FromQuery[adminsvc.AnalyticsService](adminsvc.AnalyticsParamCtx{}),
+						controllers.AdminStorageAnalytics,
+					)
+					// 活跃用户与传输量趋势
+					analytics.GET("usage",
+						controllers.FromQuery[adminsvc.AnalyticsService](adminsvc.AnalyticsParamCtx{}),
+						controllers.AdminUsageAnalytics,
+					)
+					// 上传文件类型排行
+					analytics.GET("file_types",
+						controllers.FromQuery[adminsvc.AnalyticsService](adminsvc.AnalyticsParamCtx{}),
+						controllers.AdminFileTypeAnalytics,
+					)
+				}
+
 				settings := admin.Group("settings")
 				{
 					// Get settings
@@ -787,8 +1241,21 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						controllers.FromJSON[adminsvc.SetSettingService](adminsvc.SetSettingParamCtx{}),
 						controllers.AdminSetSettings,
 					)
+					// Export settings as YAML manifest
+					settings.POST("export",
+						controllers.FromJSON[adminsvc.ExportSiteConfigService](adminsvc.ExportSiteConfigParamCtx{}),
+						controllers.AdminExportSiteConfig,
+					)
+					// Apply YAML manifest
+					settings.POST("import",
+						controllers.FromJSON[adminsvc.ImportSiteConfigService](adminsvc.ImportSiteConfigParamCtx{}),
+						controllers.AdminImportSiteConfig,
+					)
 				}
 
+				// Reload config file
+				admin.POST("config/reload", controllers.AdminReloadConfig)
+
 				// 用户组管理
 				group := admin.Group("group")
 				{
@@ -840,6 +1307,8 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				queue := admin.Group("queue")
 				{
 					queue.GET("metrics", controllers.AdminGetQueueMetrics)
+					// Get thumbnail pregeneration progress
+					queue.GET("thumb_pregen", controllers.AdminGetThumbPregenProgress)
 					// List tasks
 					queue.POST("",
 						controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
@@ -855,12 +1324,68 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						controllers.FromJSON[adminsvc.BatchTaskService](adminsvc.BatchTaskParamCtx{}),
 						controllers.AdminBatchDeleteTask,
 					)
+					// Cancel task
+					queue.POST(":id/cancel",
+						controllers.FromUri[adminsvc.SingleTaskService](adminsvc.SingleTaskParamCtx{}),
+						controllers.AdminCancelTask,
+					)
+					// Retry failed task
+					queue.POST(":id/retry",
+						controllers.FromUri[adminsvc.SingleTaskService](adminsvc.SingleTaskParamCtx{}),
+						controllers.AdminRetryTask,
+					)
+					// Batch retry tasks
+					queue.POST("batch/retry",
+						controllers.FromJSON[adminsvc.BatchTaskService](adminsvc.BatchTaskParamCtx{}),
+						controllers.AdminBatchRetryTask,
+					)
+					// Requeue all failed tasks
+					queue.POST("retry_failed",
+						controllers.FromJSON[adminsvc.RetryFailedTaskService](adminsvc.RetryFailedTaskParamCtx{}),
+						controllers.AdminRetryFailedTasks,
+					)
+					// List tasks failed after exhausting all retries
+					queue.POST("dead_letter",
+						controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
+						controllers.AdminListDeadLetters,
+					)
+					// Purge dead-lettered tasks
+					queue.POST("dead_letter/purge",
+						controllers.FromJSON[adminsvc.PurgeDeadLetterService](adminsvc.PurgeDeadLetterParamCtx{}),
+						controllers.AdminPurgeDeadLetters,
+					)
+					// Get dead-lettered task with payload and error history
+					queue.GET("dead_letter/:id",
+						controllers.FromUri[adminsvc.SingleTaskService](adminsvc.SingleTaskParamCtx{}),
+						controllers.AdminGetDeadLetter,
+					)
+					// Edit payload of dead-lettered task
+					queue.PUT("dead_letter/:id",
+						controllers.FromJSON[adminsvc.UpdateDeadLetterService](adminsvc.UpdateDeadLetterParamCtx{}),
+						controllers.AdminUpdateDeadLetter,
+					)
+					// Replay dead-lettered task
+					queue.POST("dead_letter/:id/replay",
+						controllers.FromUri[adminsvc.SingleTaskService](adminsvc.SingleTaskParamCtx{}),
+						controllers.AdminReplayDeadLetter,
+					)
 					// // 列出任务
 					// queue.POST("list", controllers.AdminListTask)
 					// // 新建文件导入任务
 					// queue.POST("import", controllers.AdminCreateImportTask)
 				}
 
+				search := admin.Group("search")
+				{
+					// Get size, health and indexing progress of full-text search index
+					search.GET("index", controllers.AdminGetSearchIndexStatus)
+					// Rebuild full-text search index of all or one user's files
+					search.POST("index/rebuild",
+						controllers.FromJSON[adminsvc.RebuildSearchIndexService](adminsvc.RebuildSearchIndexParamCtx{}),
+						controllers.AdminRebuildSearchIndex,
+					)
+				}
+
 				// 存储策略管理
 				policy := admin.Group("policy")
 				{
@@ -874,6 +1399,16 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						controllers.FromUri[adminsvc.SingleStoragePolicyService](adminsvc.GetStoragePolicyParamCtx{}),
 						controllers.AdminGetPolicy,
 					)
+					// 获取孤立文件扫描报告
+					policy.GET(":id/orphan",
+						controllers.FromUri[adminsvc.SingleStoragePolicyService](adminsvc.GetStoragePolicyParamCtx{}),
+						controllers.AdminGetPolicyOrphanReport,
+					)
+					// 扫描孤立文件
+					policy.POST(":id/orphan",
+						controllers.FromUri[adminsvc.SingleStoragePolicyService](adminsvc.GetStoragePolicyParamCtx{}),
+						controllers.AdminScanPolicyOrphans,
+					)
 					// 创建存储策略
 					policy.PUT("",
 						controllers.FromJSON[adminsvc.CreateStoragePolicyService](adminsvc.CreateStoragePolicyParamCtx{}),
@@ -984,10 +1519,59 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 							controllers.AdminDeleteUser,
 						)
 					}
+					// 获取用户容量重新计算报告
+					user.GET("storage/recalculate", controllers.AdminGetStorageRecalcReport)
+					// 重新计算所有用户已用容量
+					user.POST("storage/recalculate", controllers.AdminRecalculateStorage)
 					user.POST(":id/calibrate",
 						controllers.FromUri[adminsvc.SingleUserService](adminsvc.SingleUserParamCtx{}),
 						controllers.AdminCalibrateStorage,
 					)
+					// 更新用户存储策略、容量覆盖
+					user.PUT(":id/override",
+						controllers.FromJSON[adminsvc.UserOverrideService](adminsvc.UserOverrideParamCtx{}),
+						controllers.AdminUpdateUserOverride,
+					)
+					// 更改用户组，可设置到期时间
+					user.PUT(":id/group",
+						controllers.FromJSON[adminsvc.UserGroupService](adminsvc.UserGroupParamCtx{}),
+						controllers.AdminUpdateUserGroup,
+					)
+					// 列出用户文件上的锁
+					user.GET(":id/lock",
+						controllers.FromUri[adminsvc.SingleUserService](adminsvc.SingleUserParamCtx{}),
+						controllers.AdminListUserLocks,
+					)
+					// 强制释放用户文件上的锁
+					user.DELETE(":id/lock",
+						controllers.FromJSON[adminsvc.UserForceUnlockService](adminsvc.UserForceUnlockParamCtx{}),
+						controllers.AdminForceUnlockUserFiles,
+					)
+					// 设置或解除用户的法律保留
+					user.PUT(":id/legal-hold",
+						controllers.FromJSON[adminsvc.UserLegalHoldService](adminsvc.UserLegalHoldParamCtx{}),
+						controllers.AdminUpdateUserLegalHold,
+					)
+					// 归档用户并转移文件
+					user.POST(":id/offboard",
+						controllers.FromJSON[adminsvc.OffboardUserService](adminsvc.OffboardUserParamCtx{}),
+						controllers.AdminOffboardUser,
+					)
+					// 导出用户个人数据
+					user.POST(":id/takeout",
+						controllers.FromJSON[adminsvc.UserTakeoutService](adminsvc.UserTakeoutParamCtx{}),
+						controllers.AdminCreateUserTakeout,
+					)
+					// 解除登录锁定
+					user.POST(":id/unlock",
+						controllers.FromUri[adminsvc.SingleUserService](adminsvc.SingleUserParamCtx{}),
+						controllers.AdminUnlockUserLogin,
+					)
+					// 以用户身份登录
+					user.POST(":id/impersonate",
+						controllers.FromUri[adminsvc.SingleUserService](adminsvc.SingleUserParamCtx{}),
+						controllers.AdminImpersonateUser,
+					)
 				}
 
 				file := admin.Group("file")
@@ -1017,6 +1601,11 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						controllers.FromJSON[adminsvc.BatchFileService](adminsvc.BatchFileParamCtx{}),
 						controllers.AdminBatchDeleteFile,
 					)
+					// 批量设置或解除法律保留
+					file.PUT("batch/legal-hold",
+						controllers.FromJSON[adminsvc.FileLegalHoldService](adminsvc.FileLegalHoldParamCtx{}),
+						controllers.AdminUpdateFileLegalHold,
+					)
 				}
 
 				entity := admin.Group("entity")
@@ -1061,17 +1650,176 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						controllers.AdminBatchDeleteShare,
 					)
 				}
+
+				auditLog := admin.Group("audit")
+				{
+					// 列出审计日志
+					auditLog.POST("",
+						controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
+						controllers.AdminListAuditEvents,
+					)
+					// 导出审计日志
+					auditLog.POST("export",
+						controllers.FromJSON[adminsvc.ExportAuditService](adminsvc.ExportAuditParamCtx{}),
+						controllers.AdminExportAuditEvents,
+					)
+				}
+
+				announcement := admin.Group("announcement")
+				{
+					// 列出站点公告
+					announcement.GET("", controllers.AdminListAnnouncements)
+					// 创建或更新站点公告
+					announcement.PUT("",
+						controllers.FromJSON[adminsvc.UpsertAnnouncementService](adminsvc.UpsertAnnouncementParamCtx{}),
+						controllers.AdminUpsertAnnouncement,
+					)
+					// 删除站点公告
+					announcement.DELETE(":id",
+						controllers.FromUri[adminsvc.DeleteAnnouncementService](adminsvc.DeleteAnnouncementParamCtx{}),
+						controllers.AdminDeleteAnnouncement,
+					)
+				}
+
+				organization := admin.Group("organization")
+				{
+					// 列出组织
+					organization.GET("", controllers.AdminListOrganizations)
+					// 创建或更新组织
+					organization.PUT("",
+						controllers.FromJSON[adminsvc.UpsertOrganizationService](adminsvc.UpsertOrganizationParamCtx{}),
+						controllers.AdminUpsertOrganization,
+					)
+					// 删除组织
+					organization.DELETE(":id",
+						controllers.FromUri[adminsvc.DeleteOrganizationService](adminsvc.DeleteOrganizationParamCtx{}),
+						controllers.AdminDeleteOrganization,
+					)
+				}
+
+				webhook := admin.Group("webhook")
+				{
+					// 列出 Webhook
+					webhook.GET("", controllers.AdminListWebhooks)
+					// 创建或更新 Webhook
+					webhook.PUT("",
+						controllers.FromJSON[adminsvc.UpsertWebhookService](adminsvc.UpsertWebhookParamCtx{}),
+						controllers.AdminUpsertWebhook,
+					)
+					// 删除 Webhook
+					webhook.DELETE(":id",
+						controllers.FromUri[adminsvc.DeleteWebhookService](adminsvc.DeleteWebhookParamCtx{}),
+						controllers.AdminDeleteWebhook,
+					)
+					// 列出 Webhook 投递记录
+					webhook.GET(":id/deliveries",
+						controllers.FromQuery[adminsvc.ListWebhookDeliveryService](adminsvc.ListWebhookDeliveryParamCtx{}),
+						controllers.AdminListWebhookDeliveries,
+					)
+					// 重新投递
+					webhook.POST("delivery/:id/redeliver",
+						controllers.FromUri[adminsvc.RedeliverWebhookService](adminsvc.RedeliverWebhookParamCtx{}),
+						controllers.AdminRedeliverWebhook,
+					)
+				}
+
+				// 文件夹保留规则
+				retention := admin.Group("retention")
+				{
+					// 列出保留规则
+					retention.GET("",
+						controllers.FromQuery[adminsvc.ListRetentionRuleService](adminsvc.ListRetentionRuleParamCtx{}),
+						controllers.AdminListRetentionRules,
+					)
+					// 创建或更新保留规则
+					retention.PUT("",
+						controllers.FromJSON[adminsvc.UpsertRetentionRuleService](adminsvc.UpsertRetentionRuleParamCtx{}),
+						controllers.AdminUpsertRetentionRule,
+					)
+					// 预览受影响的文件
+					retention.GET(":id/preview",
+						controllers.FromUri[adminsvc.SingleRetentionRuleService](adminsvc.SingleRetentionRuleParamCtx{}),
+						controllers.AdminPreviewRetentionRule,
+					)
+					// 删除保留规则
+					retention.DELETE(":id",
+						controllers.FromUri[adminsvc.SingleRetentionRuleService](adminsvc.SingleRetentionRuleParamCtx{}),
+						controllers.AdminDeleteRetentionRule,
+					)
+				}
+
+				// 内容审核隔离队列
+				moderation := admin.Group("moderation")
+				{
+					// 列出审核案例
+					moderation.GET("",
+						controllers.FromQuery[adminsvc.ListModerationCaseService](adminsvc.ListModerationCaseParamCtx{}),
+						controllers.AdminListModerationCases,
+					)
+					// 通过审核，恢复分享
+					moderation.POST(":id/approve",
+						controllers.FromUri[adminsvc.SingleModerationCaseService](adminsvc.SingleModerationCaseParamCtx{}),
+						controllers.AdminApproveModerationCase,
+					)
+					// 拒绝，删除分享
+					moderation.POST(":id/reject",
+						controllers.FromUri[adminsvc.SingleModerationCaseService](adminsvc.SingleModerationCaseParamCtx{}),
+						controllers.AdminRejectModerationCase,
+					)
+				}
+			}
+
+			// Organization scoped admin APIs, for site admins and delegated organization admins.
+			// Users, groups and storage policies outside the organization are invisible.
+			org := auth.Group("org/:org", middleware.IPAccess(dep, ipaccess.Admin), middleware.OrganizationAdmin(dep))
+			{
+				// 获取组织信息
+				org.GET("", controllers.OrgGetOrganization)
+				// 更新组织品牌设置
+				org.PUT("branding",
+					controllers.FromJSON[adminsvc.UpdateOrganizationBrandingService](adminsvc.UpdateOrganizationBrandingParamCtx{}),
+					controllers.OrgUpdateBranding,
+				)
+				// 列出组织用户组
+				org.POST("group",
+					controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
+					controllers.AdminListGroups,
+				)
+				// 列出组织存储池
+				org.POST("policy",
+					controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
+					controllers.AdminListPolicies,
+				)
+				orgUser := org.Group("user")
+				{
+					// 列出组织成员
+					orgUser.POST("",
+						controllers.FromJSON[adminsvc.AdminListService](adminsvc.AdminListServiceParamsCtx{}),
+						controllers.AdminListUsers,
+					)
+					// 获取组织成员
+					orgUser.GET(":id",
+						controllers.FromUri[adminsvc.SingleUserService](adminsvc.SingleUserParamCtx{}),
+						controllers.AdminGetUser,
+					)
+					// 更改组织成员用户组
+					orgUser.PUT(":id/group",
+						controllers.FromJSON[adminsvc.UserGroupService](adminsvc.UserGroupParamCtx{}),
+						controllers.AdminUpdateUserGroup,
+					)
+				}
 			}
 
 			// 用户
 			user := auth.Group("user")
 			{
 				// 当前登录用户信息
-				user.GET("me", controllers.UserMe)
+				middleware.Scoped(user, authpkg.AllScopes...).GET("me", controllers.UserMe)
 				// 存储信息
-				user.GET("capacity", controllers.UserStorage)
+				middleware.Scoped(user, authpkg.AllScopes...).GET("capacity", controllers.UserStorage)
 				// Search user by keywords
 				user.GET("search",
+					middleware.RateLimit(dep, ratelimit.Search),
 					controllers.FromQuery[usersvc.SearchUserService](usersvc.SearchUserParamCtx{}),
 					controllers.UserSearch,
 				)
@@ -1082,17 +1830,76 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						return dep.SettingProvider().AuthnEnabled(c)
 					}))
 				{
-					authn.PUT("", controllers.StartRegAuthn)
+					authn.PUT("", middleware.NoImpersonation(), controllers.StartRegAuthn)
 					authn.POST("",
+						middleware.NoImpersonation(),
 						controllers.FromJSON[usersvc.FinishPasskeyRegisterService](usersvc.FinishPasskeyRegisterParameterCtx{}),
 						controllers.FinishRegAuthn,
 					)
 					authn.DELETE("",
+						middleware.NoImpersonation(),
 						controllers.FromQuery[usersvc.DeletePasskeyService](usersvc.DeletePasskeyParameterCtx{}),
 						controllers.UserDeletePasskey,
 					)
+					authn.PATCH("",
+						controllers.FromJSON[usersvc.RenamePasskeyService](usersvc.RenamePasskeyParameterCtx{}),
+						controllers.UserRenamePasskey,
+					)
+					// Challenge to verify a passkey before changing 2FA settings
+					authn.PUT("verify", middleware.NoImpersonation(), controllers.StartVerifyAuthn)
+				}
+
+				// Invitation codes
+				invitation := user.Group("invitation")
+				{
+					invitation.GET("", controllers.UserListInvitations)
+					invitation.PUT("",
+						controllers.FromJSON[usersvc.CreateInvitationService](usersvc.CreateInvitationParameterCtx{}),
+						controllers.UserCreateInvitation,
+					)
+					invitation.DELETE(":id",
+						controllers.FromUri[usersvc.DeleteInvitationService](usersvc.DeleteInvitationParameterCtx{}),
+						controllers.UserDeleteInvitation,
+					)
 				}
 
+				// Personal access tokens
+				token := user.Group("token")
+				{
+					token.GET("", controllers.UserListAccessTokens)
+					token.PUT("",
+						middleware.NoImpersonation(),
+						controllers.FromJSON[usersvc.CreateAccessTokenService](usersvc.CreateAccessTokenParameterCtx{}),
+						controllers.UserCreateAccessToken,
+					)
+					token.DELETE(":id",
+						middleware.NoImpersonation(),
+						controllers.FromUri[usersvc.RevokeAccessTokenService](usersvc.RevokeAccessTokenParameterCtx{}),
+						controllers.UserRevokeAccessToken,
+					)
+				}
+
+				// 站内通知
+				notification := user.Group("notification")
+				{
+					// 列出通知
+					notification.GET("",
+						controllers.FromQuery[usersvc.ListNotificationService](usersvc.ListNotificationParameterCtx{}),
+						controllers.UserListNotifications,
+					)
+					// 标记通知为已读
+					notification.POST("read",
+						controllers.FromJSON[usersvc.MarkNotificationReadService](usersvc.MarkNotificationReadParameterCtx{}),
+						controllers.UserMarkNotificationsRead,
+					)
+				}
+
+				// 关闭站点公告
+				user.PUT("announcement/:id/dismiss",
+					controllers.FromUri[usersvc.DismissAnnouncementService](usersvc.DismissAnnouncementParameterCtx{}),
+					controllers.UserDismissAnnouncement,
+				)
+
 				// 用户设置
 				setting := user.Group("setting")
 				{
@@ -1106,7 +1913,44 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 						controllers.UpdateOption,
 					)
 					// 获得二步验证初始化信息
-					setting.GET("2fa", controllers.UserInit2FA)
+					setting.GET("2fa", middleware.NoImpersonation(), controllers.UserInit2FA)
+					// 请求更改邮箱
+					setting.PUT("email",
+						middleware.NoImpersonation(),
+						controllers.FromJSON[usersvc.ChangeEmailService](usersvc.ChangeEmailParameterCtx{}),
+						controllers.UserChangeEmail,
+					)
+					// 取消待确认的邮箱更改
+					setting.DELETE("email", middleware.NoImpersonation(), controllers.UserCancelEmailChange)
+					// 添加备用邮箱
+					setting.POST("emails",
+						middleware.NoImpersonation(),
+						controllers.FromJSON[usersvc.AddEmailAliasService](usersvc.AddEmailAliasParameterCtx{}),
+						controllers.UserAddEmailAlias,
+					)
+					// 删除备用邮箱
+					setting.DELETE("emails",
+						middleware.NoImpersonation(),
+						controllers.FromJSON[usersvc.EmailAliasService](usersvc.EmailAliasParameterCtx{}),
+						controllers.UserRemoveEmailAlias,
+					)
+					// 将备用邮箱设为主邮箱
+					setting.PUT("emails/primary",
+						middleware.NoImpersonation(),
+						controllers.FromJSON[usersvc.EmailAliasService](usersvc.EmailAliasParameterCtx{}),
+						controllers.UserSetPrimaryEmail,
+					)
+					// 注销账号前的通行密钥验证
+					setting.PUT("delete",
+						middleware.NoImpersonation(),
+						controllers.UserPrepareDeleteAccount,
+					)
+					// 注销账号
+					setting.POST("delete",
+						middleware.NoImpersonation(),
+						controllers.FromJSON[usersvc.DeleteAccountService](usersvc.DeleteAccountParameterCtx{}),
+						controllers.UserDeleteAccount,
+					)
 
 					// View preferences
 					setting.POST("view-preference",
@@ -1132,27 +1976,50 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 				dav := devices.Group("dav")
 				{
 					// List WebDAV accounts
-					dav.GET("",
+					middleware.Scoped(dav, authpkg.ScopeWebDAV).GET("",
 						controllers.FromQuery[setting.ListDavAccountsService](setting.ListDavAccountParamCtx{}),
 						controllers.ListDavAccounts,
 					)
 					// Create WebDAV account
-					dav.PUT("",
+					middleware.Scoped(dav, authpkg.ScopeWebDAV).PUT("",
+						middleware.NoImpersonation(),
 						controllers.FromJSON[setting.CreateDavAccountService](setting.CreateDavAccountParamCtx{}),
 						controllers.CreateDAVAccounts,
 					)
 					// Create WebDAV account
-					dav.PATCH(":id",
+					middleware.Scoped(dav, authpkg.ScopeWebDAV).PATCH(":id",
+						middleware.NoImpersonation(),
 						middleware.HashID(hashid.DavAccountID),
 						controllers.FromJSON[setting.CreateDavAccountService](setting.CreateDavAccountParamCtx{}),
 						controllers.UpdateDAVAccounts,
 					)
+					// Rotate password of WebDAV account
+					middleware.Scoped(dav, authpkg.ScopeWebDAV).POST(":id/rotate",
+						middleware.NoImpersonation(),
+						middleware.HashID(hashid.DavAccountID),
+						controllers.RotateDAVAccounts,
+					)
 					// Delete WebDAV account
-					dav.DELETE(":id",
+					middleware.Scoped(dav, authpkg.ScopeWebDAV).DELETE(":id",
 						middleware.HashID(hashid.DavAccountID),
 						controllers.DeleteDAVAccounts,
 					)
 				}
+				s3 := devices.Group("s3", middleware.IsFunctionEnabled(func(c *gin.Context) bool {
+					return dep.SettingProvider().S3GatewayEnabled(c)
+				}))
+				{
+					// List S3 access keys
+					s3.GET("", controllers.ListS3AccessKeys)
+					// Create S3 access key
+					s3.PUT("",
+						middleware.NoImpersonation(),
+						controllers.FromJSON[setting.CreateS3AccessKeyService](setting.CreateS3AccessKeyParamCtx{}),
+						controllers.CreateS3AccessKey,
+					)
+					// Revoke S3 access key
+					s3.DELETE(":id", controllers.DeleteS3AccessKey)
+				}
 				//// 获取账号信息
 				//devices.GET("dav", controllers.GetWebDAVAccounts)
 				//// 删除目录挂载
@@ -1169,26 +2036,36 @@ func initMasterRouter(dep dependency.Dep) *gin.Engine {
 		}
 
 	}
-
-	// 初始化WebDAV相关路由
-	initWebDAV(r.Group("dav"))
-	return r
 }
 
 // initWebDAV 初始化WebDAV相关路由
-func initWebDAV(group *gin.RouterGroup) {
+func initWebDAV(dep dependency.Dep, group *gin.RouterGroup) {
 	{
-		group.Use(middleware.CacheControl(), middleware.WebDAVAuth())
-		group.Any("/*path", webdav.ServeHTTP)
-		group.Any("", webdav.ServeHTTP)
-		group.Handle("PROPFIND", "/*path", webdav.ServeHTTP)
-		group.Handle("PROPFIND", "", webdav.ServeHTTP)
-		group.Handle("MKCOL", "/*path", webdav.ServeHTTP)
-		group.Handle("LOCK", "/*path", webdav.ServeHTTP)
-		group.Handle("UNLOCK", "/*path", webdav.ServeHTTP)
-		group.Handle("PROPPATCH", "/*path", webdav.ServeHTTP)
-		group.Handle("COPY", "/*path", webdav.ServeHTTP)
-		group.Handle("MOVE", "/*path", webdav.ServeHTTP)
-
+		group.Use(middleware.CacheControl(), middleware.WebDAVAuth(), middleware.WebDAVMaintenance(dep))
+		initWebDAVMethods(group, webdav.ServeHTTP)
 	}
 }
+
+// initS3Gateway routes S3 API requests to the gateway, only path-style addressing is supported.
+func initS3Gateway(dep dependency.Dep, group *gin.RouterGroup) {
+	group.Use(middleware.CacheControl(), middleware.S3Auth(), middleware.S3Maintenance(dep))
+	group.Any("/*path", s3gateway.ServeHTTP)
+	group.Any("", s3gateway.ServeHTTP)
+}
+
+// initWebDAVMethods routes all WebDAV methods of the group to given handler
+func initWebDAVMethods(group *gin.RouterGroup, handler gin.HandlerFunc) {
+	group.Any("/*path", handler)
+	group.Any("", handler)
+	group.Handle("PROPFIND", "/*path", handler)
+	group.Handle("PROPFIND", "", handler)
+	group.Handle("SEARCH", "/*path", handler)
+	group.Handle("SEARCH", "", handler)
+	group.Handle("MKCOL", "/*path", handler)
+	group.Handle("LOCK", "/*path", handler)
+	group.Handle("UNLOCK", "/*path", handler)
+	group.Handle("PROPPATCH", "/*path", handler)
+	group.Handle("COPY", "/*path", handler)
+	group.Handle("MOVE", "/*path", handler)
+	group.Handle("RESTORE", "/*path", handler)
+}
@@ -0,0 +1,183 @@
+package admin
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/stats"
+	"github.com/gin-gonic/gin"
+)
+
+// maxAnalyticsFileTypes is the maximum number of file types returned in analytics.
+const maxAnalyticsFileTypes = 20
+
+type (
+	AnalyticsService struct {
+		// Days number of days to query, including today.
+		Days int `form:"days" binding:"required,min=1,max=366"`
+	}
+	AnalyticsParamCtx struct{}
+
+	StorageTrend struct {
+		Date     string        `json:"date"`
+		Policies map[int]int64 `json:"policies"`
+		Groups   map[int]int64 `json:"groups"`
+		// Users storage of users in the latest top list, 0 if the user is not in top list of the day.
+		Users map[int]int64 `json:"users"`
+	}
+	TopStorageUser struct {
+		ID      int    `json:"id"`
+		Email   string `json:"email,omitempty"`
+		Nick    string `json:"nick,omitempty"`
+		Storage int64  `json:"storage"`
+	}
+	StorageAnalyticsResponse struct {
+		Trends   []StorageTrend   `json:"trends"`
+		TopUsers []TopStorageUser `json:"top_users"`
+	}
+
+	UsageTrend struct {
+		Date         string `json:"date"`
+		ActiveUsers  int    `json:"active_users"`
+		Uploads      int    `json:"uploads"`
+		UploadSize   int64  `json:"upload_size"`
+		Downloads    int    `json:"downloads"`
+		DownloadSize int64  `json:"download_size"`
+	}
+
+	FileTypeUsage struct {
+		Ext   string `json:"ext"`
+		Count int    `json:"count"`
+		Size  int64  `json:"size"`
+	}
+)
+
+// Storage returns storage consumption per policy, group and top users over time.
+func (s *AnalyticsService) Storage(c *gin.Context) (*StorageAnalyticsResponse, error) {
+	dep := dependency.FromContext(c)
+	days, err := s.dailyStats(c)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &StorageAnalyticsResponse{
+		Trends:   make([]StorageTrend, 0, len(days)),
+		TopUsers: make([]TopStorageUser, 0),
+	}
+
+	// Users in the latest top list are tracked across the whole range.
+	var latestTop []stats.UserStorage
+	for i := len(days) - 1; i >= 0; i-- {
+		if len(days[i].TopUsers) > 0 {
+			latestTop = days[i].TopUsers
+			break
+		}
+	}
+
+	for _, day := range days {
+		trend := StorageTrend{
+			Date:     day.Date,
+			Policies: day.PolicyStorage,
+			Groups:   day.GroupStorage,
+			Users:    make(map[int]int64, len(latestTop)),
+		}
+
+		for _, top := range latestTop {
+			trend.Users[top.UserID] = 0
+		}
+		for _, u := range day.TopUsers {
+			if _, ok := trend.Users[u.UserID]; ok {
+				trend.Users[u.UserID] = u.Storage
+			}
+		}
+
+		res.Trends = append(res.Trends, trend)
+	}
+
+	userClient := dep.UserClient()
+	for _, top := range latestTop {
+		item := TopStorageUser{ID: top.UserID, Storage: top.Storage}
+		if u, err := userClient.GetByID(c, top.UserID); err == nil {
+			item.Email = u.Email
+			item.Nick = u.Nick
+		}
+
+		res.TopUsers = append(res.TopUsers, item)
+	}
+
+	return res, nil
+}
+
+// Usage returns daily active users and upload/download volumes over time.
+func (s *AnalyticsService) Usage(c *gin.Context) ([]UsageTrend, error) {
+	days, err := s.dailyStats(c)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]UsageTrend, 0, len(days))
+	for _, day := range days {
+		res = append(res, UsageTrend{
+			Date:         day.Date,
+			ActiveUsers:  day.ActiveUsers,
+			Uploads:      day.Uploads,
+			UploadSize:   day.UploadSize,
+			Downloads:    day.Downloads,
+			DownloadSize: day.DownloadSize,
+		})
+	}
+
+	return res, nil
+}
+
+// FileTypes returns the most uploaded file types by size in the queried range.
+func (s *AnalyticsService) FileTypes(c *gin.Context) ([]FileTypeUsage, error) {
+	days, err := s.dailyStats(c)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := make(map[string]*FileTypeUsage)
+	for _, day := range days {
+		for ext, ft := range day.FileTypes {
+			usage, ok := merged[ext]
+			if !ok {
+				usage = &FileTypeUsage{Ext: ext}
+				merged[ext] = usage
+			}
+			usage.Count += ft.Count
+			usage.Size += ft.Size
+		}
+	}
+
+	res := make([]FileTypeUsage, 0, len(merged))
+	for _, usage := range merged {
+		res = append(res, *usage)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Size == res[j].Size {
+			return res[i].Count > res[j].Count
+		}
+		return res[i].Size > res[j].Size
+	})
+
+	if len(res) > maxAnalyticsFileTypes {
+		res = res[:maxAnalyticsFileTypes]
+	}
+
+	return res, nil
+}
+
+func (s *AnalyticsService) dailyStats(c *gin.Context) ([]*stats.DailyStats, error) {
+	dep := dependency.FromContext(c)
+	now := time.Now()
+	days, err := dep.StatsRecorder().Range(c, now.AddDate(0, 0, 1-s.Days), now)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to read daily stats", err)
+	}
+
+	return days, nil
+}
@@ -0,0 +1,71 @@
+package admin
+
+import (
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+// ListAnnouncements lists all site announcements.
+func ListAnnouncements(c *gin.Context) ([]*ent.Announcement, error) {
+	dep := dependency.FromContext(c)
+	res, err := dep.AnnouncementClient().ListAll(c)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list announcements", err)
+	}
+
+	return res, nil
+}
+
+type (
+	UpsertAnnouncementService struct {
+		Announcement *ent.Announcement `json:"announcement" binding:"required"`
+	}
+	UpsertAnnouncementParamCtx struct{}
+)
+
+// Upsert creates a new announcement if ID is 0, otherwise updates the existing one.
+func (s *UpsertAnnouncementService) Upsert(c *gin.Context) (*ent.Announcement, error) {
+	dep := dependency.FromContext(c)
+	a := s.Announcement
+	if a.Title == "" {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Title cannot be empty", nil)
+	}
+
+	if a.StartsAt != nil && a.EndsAt != nil && !a.EndsAt.After(*a.StartsAt) {
+		return nil, serializer.NewError(serializer.CodeParamErr, "End time must be after start time", nil)
+	}
+
+	res, err := dep.AnnouncementClient().Upsert(c, a)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, serializer.NewError(serializer.CodeNotFound, "Announcement not found", err)
+		}
+
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to save announcement", err)
+	}
+
+	return res, nil
+}
+
+type (
+	DeleteAnnouncementService struct {
+		ID int `uri:"id" binding:"required"`
+	}
+	DeleteAnnouncementParamCtx struct{}
+)
+
+// Delete deletes an announcement.
+func (s *DeleteAnnouncementService) Delete(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	if err := dep.AnnouncementClient().Delete(c, s.ID); err != nil {
+		if ent.IsNotFound(err) {
+			return serializer.NewError(serializer.CodeNotFound, "Announcement not found", err)
+		}
+
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete announcement", err)
+	}
+
+	return nil
+}
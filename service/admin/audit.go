@@ -0,0 +1,212 @@
+package admin
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+const (
+	auditActorCondition      = "actor"
+	auditUserCondition       = "user"
+	auditEntityTypeCondition = "entity_type"
+	auditTypeCondition       = "type"
+	auditIPCondition         = "ip"
+	auditFromCondition       = "from"
+	auditToCondition         = "to"
+
+	auditExportCSV  = "csv"
+	auditExportJSON = "json"
+)
+
+type ListAuditEventResponse struct {
+	Events     []*audit.Event               `json:"events"`
+	Pagination *inventory.PaginationResults `json:"pagination"`
+}
+
+// AuditEvents lists audit events matching the conditions, newest first.
+func (service *AdminListService) AuditEvents(c *gin.Context) (*ListAuditEventResponse, error) {
+	dep := dependency.FromContext(c)
+	args, err := auditQueryArgs(service.Conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	events, total, err := dep.AuditRecorder().List(c, args, (service.Page-1)*service.PageSize, service.PageSize)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to query audit logs", err)
+	}
+
+	return &ListAuditEventResponse{
+		Events: events,
+		Pagination: &inventory.PaginationResults{
+			Page:       service.Page - 1,
+			PageSize:   service.PageSize,
+			TotalItems: total,
+		},
+	}, nil
+}
+
+type (
+	ExportAuditService struct {
+		Format     string            `json:"format" binding:"required,oneof=csv json"`
+		Conditions map[string]string `json:"conditions"`
+	}
+	ExportAuditParamCtx struct{}
+)
+
+// Export writes all audit events matching the conditions into response in given format.
+func (service *ExportAuditService) Export(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	args, err := auditQueryArgs(service.Conditions)
+	if err != nil {
+		return err
+	}
+
+	fileName := fmt.Sprintf("audit-%s.%s", time.Now().Format("20060102-150405"), service.Format)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+
+	var (
+		write  func(e *audit.Event) error
+		finish func() error
+	)
+	switch service.Format {
+	case auditExportCSV:
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		w := csv.NewWriter(c.Writer)
+		if err := w.Write([]string{"id", "type", "created_at", "actor_id", "user_id", "entity_type", "entity_id",
+			"ip", "user_agent", "correlation_id", "content"}); err != nil {
+			return err
+		}
+
+		write = func(e *audit.Event) error {
+			content, _ := json.Marshal(e.Content)
+			return w.Write([]string{
+				e.ID,
+				string(e.Type),
+				e.CreatedAt.Format(time.RFC3339),
+				strconv.Itoa(e.ActorID),
+				strconv.Itoa(e.UserID),
+				csvCell(e.EntityType),
+				strconv.Itoa(e.EntityID),
+				csvCell(e.IP),
+				csvCell(e.UserAgent),
+				csvCell(e.CorrelationID),
+				csvCell(string(content)),
+			})
+		}
+		finish = func() error {
+			w.Flush()
+			return w.Error()
+		}
+	case auditExportJSON:
+		c.Header("Content-Type", "application/json; charset=utf-8")
+		first := true
+		if _, err := c.Writer.WriteString("["); err != nil {
+			return err
+		}
+
+		write = func(e *audit.Event) error {
+			line, err := json.Marshal(e)
+			if err != nil {
+				return err
+			}
+
+			if !first {
+				line = append([]byte(","), line...)
+			}
+			first = false
+			_, err = c.Writer.Write(line)
+			return err
+		}
+		finish = func() error {
+			_, err := c.Writer.WriteString("]")
+			return err
+		}
+	}
+
+	var writeErr error
+	if err := dep.AuditRecorder().Query(c, args, func(e *audit.Event) bool {
+		writeErr = write(e)
+		return writeErr == nil
+	}); err != nil {
+		dep.Logger().Warning("Failed to query audit logs for export: %s", err)
+		return nil
+	}
+
+	if writeErr == nil {
+		writeErr = finish()
+	}
+
+	if writeErr != nil {
+		dep.Logger().Warning("Failed to write audit log export: %s", writeErr)
+	}
+
+	return nil
+}
+
+// auditQueryArgs builds audit query filter from list conditions.
+func auditQueryArgs(conditions map[string]string) (*audit.QueryArgs, error) {
+	args := &audit.QueryArgs{
+		EntityType: conditions[auditEntityTypeCondition],
+		IP:         conditions[auditIPCondition],
+	}
+
+	for condition, dst := range map[string]*int{
+		auditActorCondition: &args.ActorID,
+		auditUserCondition:  &args.UserID,
+	} {
+		if conditions[condition] == "" {
+			continue
+		}
+
+		id, err := strconv.Atoi(conditions[condition])
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid "+condition+" ID", err)
+		}
+		*dst = id
+	}
+
+	for condition, dst := range map[string]*time.Time{
+		auditFromCondition: &args.From,
+		auditToCondition:   &args.To,
+	} {
+		if conditions[condition] == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, conditions[condition])
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid "+condition+" time", err)
+		}
+		*dst = t
+	}
+
+	if conditions[auditTypeCondition] != "" {
+		args.Types = lo.Map(strings.Split(conditions[auditTypeCondition], ","), func(item string, _ int) audit.EventType {
+			return audit.EventType(strings.TrimSpace(item))
+		})
+	}
+
+	return args, nil
+}
+
+// csvCell escapes values that spreadsheet applications would interpret as formulas, user agents and
+// other client supplied values are attacker controlled.
+func csvCell(v string) string {
+	if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+		return "'" + v
+	}
+
+	return v
+}
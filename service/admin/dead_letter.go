@@ -0,0 +1,109 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	UpdateDeadLetterService struct {
+		ID int `json:"id" binding:"required"`
+		// Payload is the new private state of the task, must be valid JSON.
+		Payload string `json:"payload" binding:"required"`
+	}
+	UpdateDeadLetterParamCtx struct{}
+
+	PurgeDeadLetterService struct {
+		// IDs only purge given dead-lettered tasks if not empty, otherwise all of them are purged.
+		IDs []int `json:"ids"`
+	}
+	PurgeDeadLetterParamCtx struct{}
+)
+
+// DeadLetters lists tasks failed after exhausting all retries.
+func (s *AdminListService) DeadLetters(c *gin.Context) (*ListTaskResponse, error) {
+	if s.Conditions == nil {
+		s.Conditions = make(map[string]string)
+	}
+
+	s.Conditions[taskDeadLetterCondition] = "true"
+	return s.Tasks(c)
+}
+
+// GetDeadLetter returns a dead-lettered task with its payload and error history.
+func (s *SingleTaskService) GetDeadLetter(c *gin.Context) (*GetTaskResponse, error) {
+	if _, err := getDeadLetter(c, dependency.FromContext(c), s.ID); err != nil {
+		return nil, err
+	}
+
+	return s.Get(c)
+}
+
+// Update replaces the payload of a dead-lettered task, e.g. to fix a parameter that caused the failure
+// before replaying it.
+func (s *UpdateDeadLetterService) Update(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	model, err := getDeadLetter(c, dep, s.ID)
+	if err != nil {
+		return err
+	}
+
+	if !json.Valid([]byte(s.Payload)) {
+		return serializer.NewError(serializer.CodeParamErr, "Payload must be valid JSON", nil)
+	}
+
+	if model.PrivateState == "" {
+		return serializer.NewError(serializer.CodeParamErr, "Task has no parameters to edit", nil)
+	}
+
+	if _, err := dep.TaskClient().Update(c, model, &inventory.TaskArgs{
+		Status:       model.Status,
+		PublicState:  model.PublicState,
+		PrivateState: s.Payload,
+	}); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to update task", err)
+	}
+
+	return nil
+}
+
+// ReplayDeadLetter requeues a dead-lettered task, it leaves the dead-letter queue once queued.
+func (s *SingleTaskService) ReplayDeadLetter(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	if _, err := getDeadLetter(c, dep, s.ID); err != nil {
+		return err
+	}
+
+	return retryTask(c, dep, s.ID)
+}
+
+// Purge deletes dead-lettered tasks, returns the number of deleted tasks.
+func (s *PurgeDeadLetterService) Purge(c *gin.Context) (*PurgeDeadLetterResponse, error) {
+	dep := dependency.FromContext(c)
+	purged, err := dep.TaskClient().DeleteDeadLettered(c, s.IDs...)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to delete tasks", err)
+	}
+
+	return &PurgeDeadLetterResponse{Purged: purged}, nil
+}
+
+// getDeadLetter returns the task with given ID if it is in dead-letter queue.
+func getDeadLetter(c *gin.Context, dep dependency.Dep, id int) (*ent.Task, error) {
+	model, err := dep.TaskClient().GetTaskByID(context.WithValue(c, inventory.LoadTaskUser{}, true), id)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Task not found", err)
+	}
+
+	if model.DeadLetteredAt == nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Task is not in dead-letter queue", nil)
+	}
+
+	return model, nil
+}
@@ -353,6 +353,10 @@ func (s *BatchFileService) Delete(c *gin.Context) error {
 		return serializer.NewError(serializer.CodeDBError, "Failed to get files", err)
 	}
 
+	if err := checkFileLegalHold(c, dep, files); err != nil {
+		return err
+	}
+
 	fc, tx, ctx, err := inventory.WithTx(c, fileClient)
 	if err != nil {
 		return serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
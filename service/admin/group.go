@@ -8,6 +8,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/gin-gonic/gin"
 )
@@ -165,6 +166,12 @@ func (s *UpsertGroupService) Update(c *gin.Context) (*GetGroupResponse, error) {
 		return nil, serializer.NewError(serializer.CodeParamErr, "Initial admin group have to be admin", nil)
 	}
 
+	if s.Group.Settings != nil {
+		if err := downloader.ValidateSchedule(s.Group.Settings.RemoteDownloadSchedule); err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid remote download schedule", err)
+		}
+	}
+
 	group, err := groupClient.Upsert(c, s.Group)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update group", err)
@@ -182,6 +189,12 @@ func (s *UpsertGroupService) Create(c *gin.Context) (*GetGroupResponse, error) {
 		return nil, serializer.NewError(serializer.CodeParamErr, "ID must be 0", nil)
 	}
 
+	if s.Group.Settings != nil {
+		if err := downloader.ValidateSchedule(s.Group.Settings.RemoteDownloadSchedule); err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid remote download schedule", err)
+		}
+	}
+
 	group, err := groupClient.Upsert(c, s.Group)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create group", err)
@@ -0,0 +1,76 @@
+package admin
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/requestinfo"
+	"github.com/cloudreve/Cloudreve/v4/pkg/email"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+// Impersonate issues a time-limited session for current admin to act as the user. The user is
+// notified by email and the session start is recorded in audit log.
+func (service *SingleUserService) Impersonate(c *gin.Context) (*ImpersonateUserResponse, error) {
+	dep := dependency.FromContext(c)
+	current := inventory.UserFromContext(c)
+	if _, ok := auth.ImpersonatorFromContext(c); ok {
+		return nil, serializer.NewError(serializer.CodeNoPermissionErr, "Cannot impersonate within an impersonation session", nil)
+	}
+
+	if service.ID == current.ID {
+		return nil, serializer.NewError(serializer.CodeTargetUserInvalid, "Cannot impersonate yourself", nil)
+	}
+
+	ctx := context.WithValue(c, inventory.LoadUserGroup{}, true)
+	target, err := dep.UserClient().GetByID(ctx, service.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeUserNotFound, "User not found", err)
+	}
+
+	if target.Status != user.StatusActive {
+		return nil, serializer.NewError(serializer.CodeTargetUserInvalid, "Cannot impersonate inactive user", nil)
+	}
+
+	if target.Edges.Group != nil && target.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+		return nil, serializer.NewError(serializer.CodeTargetUserInvalid, "Cannot impersonate another admin", nil)
+	}
+
+	token, err := dep.TokenAuth().IssueImpersonation(c, target, current.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeEncryptError, "Failed to issue impersonation token", err)
+	}
+
+	event := audit.NewEvent(c, audit.EventImpersonateStart, target.ID, current.ID, map[string]string{
+		"expires": strconv.FormatInt(token.AccessExpires.Unix(), 10),
+	}).WithEntity(audit.EntityUser, target.ID)
+	if err := dep.AuditRecorder().Record(c, event); err != nil {
+		// Impersonation must not happen without a trace.
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to record audit log", err)
+	}
+
+	ip := ""
+	if info := requestinfo.RequestInfoFromContext(c); info != nil {
+		ip = info.IP
+	}
+
+	title, body, err := email.NewImpersonationEmail(c, dep.SettingProvider(), target, current, ip, token.AccessExpires)
+	if err != nil {
+		dep.Logger().Warning("Failed to generate impersonation email: %s", err)
+	} else if err := dep.EmailClient(c).Send(c, target.Email, title, body); err != nil {
+		dep.Logger().Warning("Failed to send impersonation email: %s", err)
+	}
+
+	return &ImpersonateUserResponse{
+		UserHashID: hashid.EncodeUserID(dep.HashIDEncoder(), target.ID),
+		Token:      token,
+	}, nil
+}
@@ -0,0 +1,144 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type (
+	FileLegalHoldService struct {
+		IDs []int `json:"ids" binding:"min=1"`
+		// Hold places legal hold on files if true, otherwise releases it.
+		Hold   bool   `json:"hold"`
+		Reason string `json:"reason" binding:"max=255"`
+	}
+	FileLegalHoldParamCtx struct{}
+)
+
+// Update places or releases legal hold on files and folders. Files in held folders are held as well.
+func (s *FileLegalHoldService) Update(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	fileClient := dep.FileClient()
+	current := inventory.UserFromContext(c)
+
+	files, _, err := fileClient.GetByIDs(c, s.IDs, 0)
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to get files", err)
+	}
+
+	hold, err := json.Marshal(&types.LegalHold{At: time.Now(), By: current.ID, Reason: s.Reason})
+	if err != nil {
+		return serializer.NewError(serializer.CodeInternalSetting, "Failed to encode legal hold", err)
+	}
+
+	ae := serializer.NewAggregateError()
+	for _, file := range files {
+		if s.Hold {
+			err = fileClient.UpsertMetadata(c, file, map[string]string{dbfs.MetadataLegalHold: string(hold)},
+				map[string]bool{dbfs.MetadataLegalHold: true})
+		} else {
+			err = fileClient.RemoveMetadata(c, file, dbfs.MetadataLegalHold)
+		}
+
+		if err != nil {
+			ae.Add(strconv.Itoa(file.ID), serializer.NewError(serializer.CodeDBError, "Failed to update legal hold", err))
+			continue
+		}
+
+		recordLegalHoldChange(c, dep, file.OwnerID, current.ID, s.Hold, s.Reason, audit.EntityFile, file.ID)
+	}
+
+	return ae.Aggregate()
+}
+
+type (
+	UserLegalHoldService struct {
+		ID int `json:"id" binding:"required"`
+		// Hold places legal hold on all files of the user if true, otherwise releases it.
+		Hold   bool   `json:"hold"`
+		Reason string `json:"reason" binding:"max=255"`
+	}
+	UserLegalHoldParamCtx struct{}
+)
+
+// Update places or releases legal hold on all files of a user.
+func (s *UserLegalHoldService) Update(c *gin.Context) (*GetUserResponse, error) {
+	dep := dependency.FromContext(c)
+	current := inventory.UserFromContext(c)
+
+	var hold *types.LegalHold
+	if s.Hold {
+		hold = &types.LegalHold{At: time.Now(), By: current.ID, Reason: s.Reason}
+	}
+
+	if _, err := dep.UserClient().UpdateSettings(c, s.ID, func(u *ent.User) error {
+		u.Settings.LegalHold = hold
+		return nil
+	}); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, serializer.NewError(serializer.CodeUserNotFound, "User not found", err)
+		}
+
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to save user settings", err)
+	}
+
+	recordLegalHoldChange(c, dep, s.ID, current.ID, s.Hold, s.Reason, audit.EntityUser, s.ID)
+	return (&SingleUserService{ID: s.ID}).Get(c)
+}
+
+func recordLegalHoldChange(ctx context.Context, dep dependency.Dep, uid, actor int, hold bool, reason, entityType string, entityID int) {
+	event := audit.NewEvent(ctx, audit.EventLegalHoldChanged, uid, actor, map[string]string{
+		"hold":   strconv.FormatBool(hold),
+		"reason": reason,
+	}).WithEntity(entityType, entityID)
+	if err := dep.AuditRecorder().Record(ctx, event); err != nil {
+		dep.Logger().Warning("Failed to record legal hold audit event: %s", err)
+	}
+}
+
+// checkUserLegalHold returns fs.ErrLegalHold if the user or any of its files is under legal hold.
+func checkUserLegalHold(ctx context.Context, dep dependency.Dep, uid int) error {
+	held, err := manager.IsUserLegalHeld(ctx, dep, uid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return serializer.NewError(serializer.CodeUserNotFound, "User not found", err)
+		}
+		return serializer.NewError(serializer.CodeDBError, "Failed to check legal hold", err)
+	}
+
+	if held {
+		return fs.ErrLegalHold
+	}
+
+	return nil
+}
+
+// checkFileLegalHold returns fs.ErrLegalHold if any of given files is held directly.
+func checkFileLegalHold(ctx context.Context, dep dependency.Dep, files []*ent.File) error {
+	for owner, ownedFiles := range lo.GroupBy(files, func(f *ent.File) int { return f.OwnerID }) {
+		held, err := dep.FileClient().ListIDsByMetadata(ctx, owner, dbfs.MetadataLegalHold)
+		if err != nil {
+			return serializer.NewError(serializer.CodeDBError, "Failed to check legal hold", err)
+		}
+
+		if lo.ContainsBy(ownedFiles, func(f *ent.File) bool { return lo.Contains(held, f.ID) }) {
+			return fs.ErrLegalHold
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package admin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/lock"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/service/explorer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// ListLocks lists active locks on files of the user, including check-outs.
+func (s *SingleUserService) ListLocks(c *gin.Context) ([]explorer.Lock, error) {
+	m, err := userFileManager(c, dependency.FromContext(c), s.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer m.Recycle()
+
+	locks, err := m.ListLocks(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list locks: %w", err)
+	}
+
+	return explorer.BuildLocks(locks), nil
+}
+
+type (
+	UserForceUnlockService struct {
+		ID     int      `json:"id" binding:"required"`
+		Tokens []string `json:"tokens" binding:"required,min=1,max=16384"`
+	}
+	UserForceUnlockParamCtx struct{}
+)
+
+// Unlock releases locks on files of the user regardless of who holds them, e.g. a check-out of a user
+// who is on leave.
+func (s *UserForceUnlockService) Unlock(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	current := inventory.UserFromContext(c)
+	m, err := userFileManager(c, dep, s.ID)
+	if err != nil {
+		return err
+	}
+	defer m.Recycle()
+
+	locks, err := m.ListLocks(c)
+	if err != nil {
+		return fmt.Errorf("failed to list locks: %w", err)
+	}
+
+	locks = lo.Filter(locks, func(l lock.ActiveLock, index int) bool {
+		return lo.Contains(s.Tokens, l.Token)
+	})
+	if len(locks) != len(lo.Uniq(s.Tokens)) {
+		return serializer.NewError(serializer.CodeNotFound, "Some locks are not found or expired", nil)
+	}
+
+	if err := m.Unlock(c, s.Tokens...); err != nil {
+		return serializer.NewError(serializer.CodeLockConflict, "Failed to release locks", err)
+	}
+
+	event := audit.NewEvent(c, audit.EventLockReleased, s.ID, current.ID, map[string]string{
+		"paths": strings.Join(lo.Map(locks, func(l lock.ActiveLock, index int) string {
+			return l.Root
+		}), ","),
+	}).WithEntity(audit.EntityUser, s.ID)
+	if err := dep.AuditRecorder().Record(c, event); err != nil {
+		dep.Logger().Warning("Failed to record lock release audit event: %s", err)
+	}
+
+	return nil
+}
+
+// userFileManager returns the file manager of given user, locks and check-outs on the user's files are
+// managed by it.
+func userFileManager(c *gin.Context, dep dependency.Dep, uid int) (manager.FileManager, error) {
+	u, err := dep.UserClient().GetLoginUserByID(c, uid)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, serializer.NewError(serializer.CodeUserNotFound, "User not found", err)
+		}
+
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get user", err)
+	}
+
+	return manager.NewFileManager(dep, u), nil
+}
@@ -0,0 +1,126 @@
+package admin
+
+import (
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/moderationcase"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	ListModerationCaseService struct {
+		Page     int `form:"page" binding:"min=1"`
+		PageSize int `form:"page_size" binding:"min=1,max=200,required"`
+		// Status filters cases of given status, empty for all.
+		Status string `form:"status" binding:"omitempty,oneof=quarantined approved rejected"`
+		// UserID filters cases of given share owner, 0 for all users.
+		UserID int `form:"user_id"`
+	}
+	ListModerationCaseParamCtx struct{}
+
+	ListModerationCaseResponse struct {
+		Pagination *inventory.PaginationResults `json:"pagination"`
+		Cases      []*ent.ModerationCase        `json:"cases"`
+	}
+)
+
+// List lists content moderation cases, newest first.
+func (s *ListModerationCaseService) List(c *gin.Context) (*ListModerationCaseResponse, error) {
+	dep := dependency.FromContext(c)
+	res, err := dep.ModerationCaseClient().List(c, &inventory.ListModerationCaseParameters{
+		PaginationArgs: &inventory.PaginationArgs{
+			Page:     s.Page - 1,
+			PageSize: s.PageSize,
+		},
+		Status: moderationcase.Status(s.Status),
+		UserID: s.UserID,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list moderation cases", err)
+	}
+
+	return &ListModerationCaseResponse{
+		Pagination: res.PaginationResults,
+		Cases:      res.Cases,
+	}, nil
+}
+
+type (
+	SingleModerationCaseService struct {
+		ID int `uri:"id" binding:"required"`
+	}
+	SingleModerationCaseParamCtx struct{}
+)
+
+// Approve lifts the quarantine, the share is accessible again.
+func (s *SingleModerationCaseService) Approve(c *gin.Context) (*ent.ModerationCase, error) {
+	dep := dependency.FromContext(c)
+	mc, err := s.pending(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if mc.ShareID != nil {
+		if err := dep.ShareClient().SetQuarantined(c, *mc.ShareID, false); err != nil && !ent.IsNotFound(err) {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to lift quarantine of share", err)
+		}
+	}
+
+	return s.review(c, mc, moderationcase.StatusApproved)
+}
+
+// Reject confirms the quarantine, the share is deleted. Shared files are kept.
+func (s *SingleModerationCaseService) Reject(c *gin.Context) (*ent.ModerationCase, error) {
+	dep := dependency.FromContext(c)
+	mc, err := s.pending(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if mc.ShareID != nil {
+		if err := dep.ShareClient().Delete(c, *mc.ShareID); err != nil && !ent.IsNotFound(err) {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to delete share", err)
+		}
+	}
+
+	return s.review(c, mc, moderationcase.StatusRejected)
+}
+
+// pending returns the case if it is not reviewed yet.
+func (s *SingleModerationCaseService) pending(c *gin.Context) (*ent.ModerationCase, error) {
+	dep := dependency.FromContext(c)
+	mc, err := dep.ModerationCaseClient().GetByID(c, s.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Moderation case not found", err)
+	}
+
+	if mc.Status != moderationcase.StatusQuarantined {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Moderation case is already reviewed", nil)
+	}
+
+	return mc, nil
+}
+
+func (s *SingleModerationCaseService) review(c *gin.Context, mc *ent.ModerationCase, status moderationcase.Status) (*ent.ModerationCase, error) {
+	dep := dependency.FromContext(c)
+	actor := inventory.UserFromContext(c)
+	res, err := dep.ModerationCaseClient().Review(c, mc.ID, status, actor.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update moderation case", err)
+	}
+
+	event := audit.NewEvent(c, audit.EventModerationReviewed, mc.UserID, actor.ID, map[string]string{
+		"status": string(status),
+	})
+	if mc.ShareID != nil {
+		event = event.WithEntity(audit.EntityShare, *mc.ShareID)
+	}
+	if err := dep.AuditRecorder().Record(c, event); err != nil {
+		dep.Logger().Warning("Failed to record moderation audit event: %s", err)
+	}
+
+	return res, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/mtls"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader"
 	"github.com/cloudreve/Cloudreve/v4/pkg/downloader/slave"
@@ -48,7 +49,14 @@ func (service *AdminListService) Nodes(c *gin.Context) (*ListNodeResponse, error
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list nodes", err)
 	}
 
-	return &ListNodeResponse{Nodes: res.Nodes, Pagination: res.PaginationResults}, nil
+	statuses := make(map[int]*cluster.NodeStatus)
+	for _, n := range res.Nodes {
+		if status := cluster.GetNodeStatus(dep.KV(), n.ID); status != nil {
+			statuses[n.ID] = status
+		}
+	}
+
+	return &ListNodeResponse{Nodes: res.Nodes, Pagination: res.PaginationResults, Statuses: statuses}, nil
 }
 
 type (
@@ -68,7 +76,14 @@ func (service *SingleNodeService) Get(c *gin.Context) (*GetNodeResponse, error)
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get node", err)
 	}
 
-	return &GetNodeResponse{Node: node}, nil
+	res := &GetNodeResponse{Node: node, Status: cluster.GetNodeStatus(dep.KV(), node.ID)}
+	if node.Settings != nil && node.Settings.TLS != nil && node.Settings.TLS.Cert != "" {
+		if expiry, err := mtls.CertificateExpiry(node.Settings.TLS.Cert); err == nil {
+			res.CertExpiry = &expiry
+		}
+	}
+
+	return res, nil
 }
 
 type (
@@ -87,6 +102,11 @@ func (service *TestNodeService) Test(c *gin.Context) error {
 		return serializer.NewError(serializer.CodeParamErr, "Failed to parse node URL", err)
 	}
 
+	transport, err := nodeTransport(c, service.Node)
+	if err != nil {
+		return err
+	}
+
 	primaryURL := settings.SiteURL(setting.UseFirstSiteUrl(c)).String()
 	body := map[string]string{
 		"callback": primaryURL,
@@ -106,6 +126,7 @@ func (service *TestNodeService) Test(c *gin.Context) error {
 		request.WithSlaveMeta(int(service.Node.ID)),
 		request.WithMasterMeta(settings.SiteBasic(c).ID, primaryURL),
 		request.WithCorrelationID(),
+		request.WithTransport(transport),
 	).CheckHTTPResponse(http.StatusOK).DecodeResponse()
 
 	if err != nil {
@@ -136,6 +157,11 @@ func (service *TestNodeDownloaderService) Test(c *gin.Context) (string, error) {
 	if service.Node.Type == node.TypeMaster {
 		dl, err = cluster.NewDownloader(c, dep.RequestClient(request.WithContext(c)), dep.SettingProvider(), service.Node.Settings)
 	} else {
+		transport, err := nodeTransport(c, service.Node)
+		if err != nil {
+			return "", err
+		}
+
 		dl = slave.NewSlaveDownloader(dep.RequestClient(
 			request.WithContext(c),
 			request.WithCorrelationID(),
@@ -143,6 +169,7 @@ func (service *TestNodeDownloaderService) Test(c *gin.Context) (string, error) {
 			request.WithMasterMeta(settings.SiteBasic(c).ID, settings.SiteURL(setting.UseFirstSiteUrl(c)).String()),
 			request.WithCredential(auth.HMACAuth{[]byte(service.Node.SlaveKey)}, int64(settings.SlaveRequestSignTTL(c))),
 			request.WithEndpoint(service.Node.Server),
+			request.WithTransport(transport),
 		), service.Node.Settings)
 	}
 
@@ -165,6 +192,37 @@ type (
 	UpsertNodeParamCtx struct{}
 )
 
+// nodeTransport validates TLS settings of node against secure mode and returns the transport to
+// call it with.
+func nodeTransport(c *gin.Context, n *ent.Node) (*http.Transport, error) {
+	dep := dependency.FromContext(c)
+	if err := cluster.CheckNodeSecurity(n, dep.SettingProvider().ClusterSecurity(c).SecureMode); err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, err.Error(), nil)
+	}
+
+	transport, err := cluster.NodeTransport(n)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid TLS settings", err)
+	}
+
+	return transport, nil
+}
+
+// validateNode applies checks on node settings before it is saved.
+func validateNode(c *gin.Context, n *ent.Node) error {
+	if _, err := nodeTransport(c, n); err != nil {
+		return err
+	}
+
+	if n.Settings != nil {
+		if err := downloader.ValidateSchedule(n.Settings.Schedule); err != nil {
+			return serializer.NewError(serializer.CodeParamErr, "Invalid remote download schedule", err)
+		}
+	}
+
+	return nil
+}
+
 func (s *UpsertNodeService) Update(c *gin.Context) (*GetNodeResponse, error) {
 	dep := dependency.FromContext(c)
 	nodeClient := dep.NodeClient()
@@ -173,6 +231,10 @@ func (s *UpsertNodeService) Update(c *gin.Context) (*GetNodeResponse, error) {
 		return nil, serializer.NewError(serializer.CodeParamErr, "ID is required", nil)
 	}
 
+	if err := validateNode(c, s.Node); err != nil {
+		return nil, err
+	}
+
 	node, err := nodeClient.Upsert(c, s.Node)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update node", err)
@@ -201,6 +263,10 @@ func (s *UpsertNodeService) Create(c *gin.Context) (*GetNodeResponse, error) {
 		return nil, serializer.NewError(serializer.CodeParamErr, "ID must be 0", nil)
 	}
 
+	if err := validateNode(c, s.Node); err != nil {
+		return nil, err
+	}
+
 	node, err := nodeClient.Upsert(c, s.Node)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create node", err)
@@ -0,0 +1,132 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type (
+	OffboardUserService struct {
+		ID int `json:"id" binding:"required"`
+		// TransferTo ID of the user who receives all files and shares, 0 to keep them.
+		TransferTo int `json:"transfer_to" binding:"min=0"`
+		// ArchivePolicy ID of the storage policy that all files are exported to, 0 to keep them in place.
+		// Cannot be used together with TransferTo.
+		ArchivePolicy int `json:"archive_policy" binding:"min=0"`
+		// DeleteAfter deletes the user after given seconds, 0 to keep the archived user.
+		DeleteAfter int `json:"delete_after" binding:"min=0"`
+	}
+	OffboardUserParamCtx struct{}
+)
+
+// Offboard archives a user: login is disabled, files and shares are optionally transferred to another
+// user or exported to an archive storage policy, and the user is scheduled for deletion.
+func (s *OffboardUserService) Offboard(c *gin.Context) (*GetUserResponse, error) {
+	dep := dependency.FromContext(c)
+	userClient := dep.UserClient()
+	current := inventory.UserFromContext(c)
+
+	if s.ID == 1 || s.ID == current.ID {
+		return nil, serializer.NewError(serializer.CodeInvalidActionOnDefaultUser, "Cannot offboard default or current user", nil)
+	}
+
+	ctx := context.WithValue(c, inventory.LoadUserGroup{}, true)
+	target, err := userClient.GetByID(ctx, s.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeUserNotFound, "User not found", err)
+	}
+
+	if s.TransferTo > 0 && s.ArchivePolicy > 0 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Files cannot be both transferred and exported", nil)
+	}
+
+	if s.ArchivePolicy > 0 {
+		if _, err := dep.StoragePolicyClient().GetPolicyByID(ctx, s.ArchivePolicy); err != nil {
+			return nil, serializer.NewError(serializer.CodePolicyNotExist, "Archive policy not found", err)
+		}
+	}
+
+	offboarding := &types.Offboarding{
+		At:            time.Now(),
+		By:            current.ID,
+		TransferTo:    s.TransferTo,
+		ArchivePolicy: s.ArchivePolicy,
+	}
+	if s.DeleteAfter > 0 {
+		offboarding.DeleteAt = lo.ToPtr(time.Now().Add(time.Duration(s.DeleteAfter) * time.Second))
+	}
+
+	fc, tx, ctx, err := inventory.WithTx(ctx, dep.FileClient())
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	uc, _, ctx, err := inventory.WithTx(ctx, userClient)
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	if s.TransferTo > 0 {
+		if s.TransferTo == s.ID {
+			_ = inventory.Rollback(tx)
+			return nil, serializer.NewError(serializer.CodeTargetUserInvalid, "Cannot transfer to the same user", nil)
+		}
+
+		receiver, err := uc.GetActiveByID(ctx, s.TransferTo)
+		if err != nil {
+			_ = inventory.Rollback(tx)
+			return nil, serializer.NewError(serializer.CodeTargetUserInvalid, "Target user not found or inactive", err)
+		}
+
+		_, diff, err := fc.TransferOwnership(ctx, target, receiver, fmt.Sprintf("%s (%s)", target.Nick, target.Email))
+		if err != nil {
+			_ = inventory.Rollback(tx)
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to transfer ownership", err)
+		}
+
+		tx.AppendStorageDiff(diff)
+	}
+
+	if _, err := uc.UpdateSettings(ctx, target.ID, func(u *ent.User) error {
+		u.Settings.Offboarding = offboarding
+		return nil
+	}); err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to save user settings", err)
+	}
+
+	if _, err := uc.SetStatus(ctx, target, user.StatusArchived); err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to disable user", err)
+	}
+
+	if err := inventory.CommitWithStorageDiff(ctx, tx, dep.Logger(), userClient); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit offboarding", err)
+	}
+
+	if s.ArchivePolicy > 0 {
+		t, err := manager.NewOffboardExportTask(c, s.ID, s.ArchivePolicy)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create export task", err)
+		}
+
+		if err := dep.IoIntenseQueue(c).QueueTask(c, t); err != nil {
+			return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue export task", err)
+		}
+	}
+
+	subService := &SingleUserService{ID: s.ID}
+	return subService.Get(c)
+}
@@ -0,0 +1,187 @@
+package admin
+
+import (
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// ListOrganizations lists all organizations.
+func ListOrganizations(c *gin.Context) ([]*ent.Organization, error) {
+	dep := dependency.FromContext(c)
+	orgs, err := dep.OrganizationClient().List(c)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list organizations", err)
+	}
+
+	return orgs, nil
+}
+
+type (
+	UpsertOrganizationService struct {
+		ID   int    `json:"id"`
+		Name string `json:"name" binding:"required"`
+		// Groups IDs of user groups in the organization.
+		Groups []int `json:"groups"`
+		// Admins IDs of users delegated to manage members of the organization.
+		Admins []int `json:"admins"`
+		// StoragePolicies IDs of storage policies in the storage pool of the organization.
+		StoragePolicies []int                       `json:"storage_policies"`
+		Branding        *types.OrganizationBranding `json:"branding"`
+	}
+	UpsertOrganizationParamCtx struct{}
+)
+
+// Upsert creates a new organization if ID is 0, otherwise updates the existing one.
+func (s *UpsertOrganizationService) Upsert(c *gin.Context) (*ent.Organization, error) {
+	dep := dependency.FromContext(c)
+	orgClient := dep.OrganizationClient()
+	if s.ID != 0 {
+		if _, err := orgClient.GetByID(c, s.ID); err != nil {
+			return nil, serializer.NewError(serializer.CodeNotFound, "Organization not found", err)
+		}
+	}
+
+	if err := s.validate(c, dep); err != nil {
+		return nil, err
+	}
+
+	oc, tx, ctx, err := inventory.WithTx(c, orgClient)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	org, err := oc.Upsert(ctx, &inventory.OrganizationArgs{
+		ID:              s.ID,
+		Name:            s.Name,
+		Groups:          lo.Uniq(s.Groups),
+		Admins:          lo.Uniq(s.Admins),
+		StoragePolicies: lo.Uniq(s.StoragePolicies),
+		Branding:        s.Branding,
+	})
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to save organization", err)
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit organization", err)
+	}
+
+	org, err = orgClient.GetByID(c, org.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get organization", err)
+	}
+
+	return org, nil
+}
+
+func (s *UpsertOrganizationService) validate(c *gin.Context, dep dependency.Dep) error {
+	for _, policyID := range s.StoragePolicies {
+		if _, err := dep.StoragePolicyClient().GetPolicyByID(c, policyID); err != nil {
+			return serializer.NewError(serializer.CodeParamErr, "Storage policy not found", err)
+		}
+	}
+
+	for _, groupID := range s.Groups {
+		if groupID == inventory.AnonymousGroupID {
+			return serializer.NewError(serializer.CodeParamErr, "Anonymous group cannot be added to organization", nil)
+		}
+
+		g, err := dep.GroupClient().GetByID(c, groupID)
+		if err != nil {
+			return serializer.NewError(serializer.CodeParamErr, "Group not found", err)
+		}
+
+		// Organization admins must not be able to grant site admin permission.
+		if g.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+			return serializer.NewError(serializer.CodeParamErr, "Admin group \""+g.Name+"\" cannot be added to organization", nil)
+		}
+
+		if len(s.StoragePolicies) > 0 && !lo.Contains(s.StoragePolicies, g.StoragePolicyID) {
+			return serializer.NewError(serializer.CodeParamErr, "Storage policy of group \""+g.Name+"\" is not in the storage pool", nil)
+		}
+
+		if g.OrganizationID != 0 && g.OrganizationID != s.ID {
+			return serializer.NewError(serializer.CodeParamErr, "Group \""+g.Name+"\" already belongs to another organization", nil)
+		}
+	}
+
+	for _, uid := range s.Admins {
+		if _, err := dep.UserClient().GetByID(c, uid); err != nil {
+			return serializer.NewError(serializer.CodeParamErr, "Admin user not found", err)
+		}
+	}
+
+	return nil
+}
+
+type (
+	DeleteOrganizationService struct {
+		ID int `uri:"id" binding:"required"`
+	}
+	DeleteOrganizationParamCtx struct{}
+)
+
+// Delete deletes an organization, its groups and users are kept.
+func (s *DeleteOrganizationService) Delete(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	orgClient := dep.OrganizationClient()
+	if _, err := orgClient.GetByID(c, s.ID); err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Organization not found", err)
+	}
+
+	oc, tx, ctx, err := inventory.WithTx(c, orgClient)
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	if err := oc.Delete(ctx, s.ID); err != nil {
+		_ = inventory.Rollback(tx)
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete organization", err)
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to commit organization deletion", err)
+	}
+
+	return nil
+}
+
+// GetOrganization returns the organization in `org` URL parameter, used by org-scoped admin APIs.
+func GetOrganization(c *gin.Context) (*ent.Organization, error) {
+	org := inventory.OrganizationFromContext(c)
+	if org == nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Organization not found", nil)
+	}
+
+	return org, nil
+}
+
+type (
+	UpdateOrganizationBrandingService struct {
+		Branding *types.OrganizationBranding `json:"branding"`
+	}
+	UpdateOrganizationBrandingParamCtx struct{}
+)
+
+// Update updates branding of the organization in `org` URL parameter, nil branding resets to
+// site settings.
+func (s *UpdateOrganizationBrandingService) Update(c *gin.Context) (*ent.Organization, error) {
+	dep := dependency.FromContext(c)
+	org := inventory.OrganizationFromContext(c)
+	if org == nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Organization not found", nil)
+	}
+
+	updated, err := dep.OrganizationClient().UpdateBranding(c, org.ID, s.Branding)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update organization branding", err)
+	}
+
+	return updated, nil
+}
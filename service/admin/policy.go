@@ -12,6 +12,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/node"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
@@ -236,7 +237,7 @@ func (service *SingleStoragePolicyService) Get(c *gin.Context) (*GetStoragePolic
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get policy", err)
 	}
 
-	res := &GetStoragePolicyResponse{StoragePolicy: policy}
+	res := &GetStoragePolicyResponse{StoragePolicy: policy, Health: manager.GetPolicyHealth(dep, policy.ID)}
 	if c.Query(countEntityQuery) != "" {
 		count, size, err := dep.FileClient().CountEntityByStoragePolicyID(ctx, service.ID)
 		if err != nil {
@@ -264,6 +265,10 @@ func (service *CreateStoragePolicyService) Create(c *gin.Context) (*GetStoragePo
 		service.Policy.DirNameRule = util.DataPath("uploads/{uid}/{path}")
 	}
 
+	if err := validatePolicyNode(c, dep.NodeClient(), service.Policy); err != nil {
+		return nil, err
+	}
+
 	service.Policy.ID = 0
 	policy, err := storagePolicyClient.Upsert(c, service.Policy)
 	if err != nil {
@@ -273,6 +278,29 @@ func (service *CreateStoragePolicyService) Create(c *gin.Context) (*GetStoragePo
 	return &GetStoragePolicyResponse{StoragePolicy: policy}, nil
 }
 
+// validatePolicyNode makes sure policies storing files on a slave node's local disk are bound to
+// an existing slave node.
+func validatePolicyNode(ctx context.Context, nc inventory.NodeClient, policy *ent.StoragePolicy) error {
+	if policy.Type != types.PolicyTypeRemote {
+		return nil
+	}
+
+	if policy.NodeID == 0 {
+		return serializer.NewError(serializer.CodeParamErr, "Slave node is required for remote storage policy", nil)
+	}
+
+	n, err := nc.GetNodeById(ctx, policy.NodeID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "Failed to get slave node", err)
+	}
+
+	if n.Type != node.TypeSlave {
+		return serializer.NewError(serializer.CodeParamErr, "Remote storage policy must be bound to a slave node", nil)
+	}
+
+	return nil
+}
+
 type (
 	UpdateStoragePolicyService struct {
 		Policy *ent.StoragePolicy `json:"policy" binding:"required"`
@@ -293,6 +321,10 @@ func (service *UpdateStoragePolicyService) Update(c *gin.Context) (*GetStoragePo
 		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid ID", err)
 	}
 
+	if err := validatePolicyNode(c, dep.NodeClient(), service.Policy); err != nil {
+		return nil, err
+	}
+
 	service.Policy.ID = idInt
 	_, err = storagePolicyClient.Upsert(c, service.Policy)
 	if err != nil {
@@ -502,3 +534,54 @@ func (service *SingleStoragePolicyService) GetSharePointDriverRoot(c *gin.Contex
 
 	return fmt.Sprintf("sites/%s/drive", root), nil
 }
+
+const cleanupOrphanQuery = "cleanup"
+
+// ScanOrphans starts an orphan blob scan of the policy in background, orphan blobs out of quarantine
+// period are deleted if cleanup is set in query.
+func (service *SingleStoragePolicyService) ScanOrphans(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	policy, err := dep.StoragePolicyClient().GetPolicyByID(c, service.ID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Storage policy not found", err)
+	}
+
+	if manager.OrphanScanRoot(policy) == "" {
+		return serializer.NewError(serializer.CodeParamErr, "Blob folder of this storage policy cannot be determined from its naming rule", nil)
+	}
+
+	if manager.IsOrphanScanRunning(dep, service.ID) {
+		return manager.ErrOrphanScanRunning
+	}
+
+	user := inventory.UserFromContext(c)
+	cleanup := c.Query(cleanupOrphanQuery) != ""
+	l := logging.FromContext(c)
+	ctx := dep.ForkWithLogger(context.Background(), l)
+	ctx = context.WithValue(ctx, logging.LoggerCtx{}, l)
+	ctx = context.WithValue(ctx, inventory.UserCtx{}, user)
+	go func() {
+		fm := manager.NewFileManager(dep, user)
+		defer fm.Recycle()
+
+		if _, err := fm.ScanOrphans(ctx, service.ID, cleanup); err != nil {
+			l.Warning("Failed to scan orphan blobs of storage policy %d: %s", service.ID, err)
+		}
+	}()
+
+	return nil
+}
+
+// OrphanReport returns the latest orphan blob scan report of the policy.
+func (service *SingleStoragePolicyService) OrphanReport(c *gin.Context) (*manager.OrphanReport, error) {
+	report, err := manager.LoadOrphanReport(service.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to load orphan report", err)
+	}
+
+	if report == nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Storage policy has not been scanned yet", nil)
+	}
+
+	return report, nil
+}
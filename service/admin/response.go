@@ -6,7 +6,10 @@ import (
 
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
 )
@@ -33,6 +36,18 @@ type GetTaskResponse struct {
 	TaskHashID string         `json:"task_hash_id,omitempty"`
 	Summary    *queue.Summary `json:"summary,omitempty"`
 	Node       *ent.Node      `json:"node,omitempty"`
+	// Queue the queue that runs this task, empty for tasks that cannot be requeued.
+	Queue setting.QueueType `json:"queue,omitempty"`
+}
+
+type BatchRetryTaskResponse struct {
+	Requeued int `json:"requeued"`
+	// Failed error messages of tasks failed to requeue, keyed by task ID.
+	Failed map[int]string `json:"failed,omitempty"`
+}
+
+type PurgeDeadLetterResponse struct {
+	Purged int `json:"purged"`
 }
 
 type ListEntityResponse struct {
@@ -68,10 +83,23 @@ type GetUserResponse struct {
 	HashID       string       `json:"hash_id,omitempty"`
 	TwoFAEnabled bool         `json:"two_fa_enabled,omitempty"`
 	Capacity     *fs.Capacity `json:"capacity,omitempty"`
+	LockedUntil  *time.Time   `json:"locked_until,omitempty"`
+	// Effective storage policy and max storage after applying per-user overrides.
+	EffectivePolicyID   int    `json:"effective_policy_id,omitempty"`
+	EffectivePolicyName string `json:"effective_policy_name,omitempty"`
+	EffectiveMaxStorage int64  `json:"effective_max_storage"`
+}
+
+type ImpersonateUserResponse struct {
+	UserHashID string      `json:"user_hash_id"`
+	Token      *auth.Token `json:"token"`
 }
 
 type GetNodeResponse struct {
 	*ent.Node
+	Status *cluster.NodeStatus `json:"status,omitempty"`
+	// CertExpiry expiry time of the client certificate used to call the node.
+	CertExpiry *time.Time `json:"cert_expiry,omitempty"`
 }
 
 type GetGroupResponse struct {
@@ -88,11 +116,15 @@ type GetStoragePolicyResponse struct {
 	*ent.StoragePolicy
 	EntitiesCount int `json:"entities_count,omitempty"`
 	EntitiesSize  int `json:"entities_size,omitempty"`
+	// Health result of the latest storage policy health check.
+	Health *manager.PolicyHealth `json:"health,omitempty"`
 }
 
 type ListNodeResponse struct {
 	Pagination *inventory.PaginationResults `json:"pagination"`
 	Nodes      []*ent.Node                  `json:"nodes"`
+	// Statuses heartbeat status of nodes, keyed by node ID.
+	Statuses map[int]*cluster.NodeStatus `json:"statuses,omitempty"`
 }
 
 type ListPolicyResponse struct {
@@ -109,6 +141,38 @@ type QueueMetric struct {
 	SuspendingTasks int               `json:"suspending_tasks"`
 }
 
+// ThumbPregenProgress is the progress of thumbnail pregeneration on current node.
+type ThumbPregenProgress struct {
+	BusyWorkers     int `json:"busy_workers"`
+	SuccessTasks    int `json:"success_tasks"`
+	FailureTasks    int `json:"failure_tasks"`
+	PendingVisible  int `json:"pending_visible"`
+	PendingRecent   int `json:"pending_recent"`
+	PendingBackfill int `json:"pending_backfill"`
+	// BackfillCursor is ID of the last file queued for backfill.
+	BackfillCursor int `json:"backfill_cursor"`
+	// BackfillRemaining is the number of files without thumbnail not queued for backfill yet.
+	BackfillRemaining int `json:"backfill_remaining"`
+}
+
+// SearchIndexStatus is the size, health and indexing progress of the full-text search index.
+type SearchIndexStatus struct {
+	Enabled   bool                   `json:"enabled"`
+	Provider  setting.SearchProvider `json:"provider,omitempty"`
+	Documents uint64                 `json:"documents"`
+	SizeBytes int64                  `json:"size_bytes"`
+	Health    string                 `json:"health,omitempty"`
+	// Error is set if stats of the index cannot be retrieved.
+	Error string `json:"error,omitempty"`
+	// Cursor is the update time of the last file indexed by scheduled indexing.
+	Cursor *time.Time `json:"cursor,omitempty"`
+	// Pending is the number of changed files not indexed yet.
+	Pending int `json:"pending"`
+	// LagSeconds is how long the oldest pending change has been waiting for indexing.
+	LagSeconds int64                        `json:"lag_seconds"`
+	Rebuild    *manager.SearchRebuildReport `json:"rebuild,omitempty"`
+}
+
 type ListGroupResponse struct {
 	Groups     []*ent.Group                 `json:"groups"`
 	Pagination *inventory.PaginationResults `json:"pagination"`
@@ -0,0 +1,185 @@
+package admin
+
+import (
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/retentionrule"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/workflows"
+	"github.com/cloudreve/Cloudreve/v4/pkg/retention"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+// retentionPreviewLimit is the maximum number of files listed in a retention preview.
+const retentionPreviewLimit = 500
+
+type (
+	ListRetentionRuleService struct {
+		Page     int `form:"page" binding:"min=1"`
+		PageSize int `form:"page_size" binding:"min=1,max=200,required"`
+		// UserID filters rules of given user, 0 for all users.
+		UserID int `form:"user_id"`
+	}
+	ListRetentionRuleParamCtx struct{}
+
+	ListRetentionRuleResponse struct {
+		Pagination *inventory.PaginationResults `json:"pagination"`
+		Rules      []*ent.RetentionRule         `json:"rules"`
+	}
+)
+
+// List lists retention rules of all users, newest first.
+func (s *ListRetentionRuleService) List(c *gin.Context) (*ListRetentionRuleResponse, error) {
+	dep := dependency.FromContext(c)
+	res, err := dep.RetentionRuleClient().ListAll(c, &inventory.ListRetentionRuleParameters{
+		PaginationArgs: &inventory.PaginationArgs{
+			Page:     s.Page - 1,
+			PageSize: s.PageSize,
+		},
+		UserID: s.UserID,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list retention rules", err)
+	}
+
+	return &ListRetentionRuleResponse{
+		Pagination: res.PaginationResults,
+		Rules:      res.Rules,
+	}, nil
+}
+
+type (
+	UpsertRetentionRuleService struct {
+		ID int `json:"id"`
+		// UserID is the owner of the folder, ignored when updating.
+		UserID int `json:"user_id"`
+		// Folder is the URI of the folder under view of the owner.
+		Folder       string `json:"folder" binding:"required"`
+		Recursive    bool   `json:"recursive"`
+		Action       string `json:"action" binding:"omitempty,oneof=trash delete"`
+		MaxAgeDays   int    `json:"max_age_days"`
+		KeepVersions int    `json:"keep_versions"`
+		Enabled      bool   `json:"enabled"`
+	}
+	UpsertRetentionRuleParamCtx struct{}
+)
+
+// Upsert creates a retention rule on a folder of given user if ID is 0, otherwise updates the existing one.
+// Per-user limit of rules does not apply to admins.
+func (s *UpsertRetentionRuleService) Upsert(c *gin.Context) (*ent.RetentionRule, error) {
+	dep := dependency.FromContext(c)
+	ruleClient := dep.RetentionRuleClient()
+
+	uid := s.UserID
+	if s.ID > 0 {
+		existing, err := ruleClient.GetByID(c, s.ID)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeNotFound, "Retention rule not found", err)
+		}
+
+		uid = existing.UserID
+	}
+
+	if err := retention.Validate(s.Folder, s.MaxAgeDays, s.KeepVersions); err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid retention rule", err)
+	}
+
+	owner, err := dep.UserClient().GetLoginUserByID(c, uid)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeUserNotFound, "", err)
+	}
+
+	uri, err := fs.NewUriFromString(s.Folder)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid folder", err)
+	}
+
+	m := manager.NewFileManager(dep, owner)
+	defer m.Recycle()
+
+	folder, err := m.Get(c, uri)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid folder", err)
+	}
+
+	if folder.Type() != types.FileTypeFolder || folder.OwnerID() != owner.ID {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Rule must be attached to a folder of the user", nil)
+	}
+
+	action := retentionrule.ActionTrash
+	if s.Action != "" {
+		action = retentionrule.Action(s.Action)
+	}
+
+	args := &inventory.RetentionRuleArgs{
+		Folder:       uri.String(),
+		Recursive:    s.Recursive,
+		Action:       action,
+		MaxAgeDays:   s.MaxAgeDays,
+		KeepVersions: s.KeepVersions,
+		Enabled:      s.Enabled,
+	}
+
+	var res *ent.RetentionRule
+	if s.ID > 0 {
+		res, err = ruleClient.Update(c, s.ID, args)
+	} else {
+		res, err = ruleClient.Create(c, owner.ID, args)
+	}
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to save retention rule", err)
+	}
+
+	return res, nil
+}
+
+type (
+	SingleRetentionRuleService struct {
+		ID int `uri:"id" binding:"required"`
+	}
+	SingleRetentionRuleParamCtx struct{}
+)
+
+// Preview lists files that would be affected if the rule is executed now, nothing is changed.
+func (s *SingleRetentionRuleService) Preview(c *gin.Context) (*workflows.RetentionPreview, error) {
+	dep := dependency.FromContext(c)
+	rule, err := dep.RetentionRuleClient().GetByID(c, s.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Retention rule not found", err)
+	}
+
+	owner, err := dep.UserClient().GetLoginUserByID(c, rule.UserID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeUserNotFound, "", err)
+	}
+
+	m := manager.NewFileManager(dep, owner)
+	defer m.Recycle()
+
+	res, err := workflows.PreviewRetention(c, m, workflows.RetentionRuleArgsFromModel(rule), time.Now(), retentionPreviewLimit)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeListFilesError, "Failed to preview retention rule", err)
+	}
+
+	return res, nil
+}
+
+// Delete deletes a retention rule.
+func (s *SingleRetentionRuleService) Delete(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	if err := dep.RetentionRuleClient().Delete(c, s.ID); err != nil {
+		if ent.IsNotFound(err) {
+			return serializer.NewError(serializer.CodeNotFound, "Retention rule not found", err)
+		}
+
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete retention rule", err)
+	}
+
+	return nil
+}
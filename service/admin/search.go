@@ -0,0 +1,101 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	RebuildSearchIndexService struct {
+		// UserID is the user whose files are reindexed, the whole index is rebuilt if not set.
+		UserID int `json:"user_id" binding:"min=0"`
+	}
+	RebuildSearchIndexParamCtx struct{}
+)
+
+// Rebuild starts rebuilding the full-text search index in background.
+func (s *RebuildSearchIndexService) Rebuild(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	if !dep.SettingProvider().FullTextSearch(c).Enabled {
+		return serializer.NewError(serializer.CodeFeatureNotEnabled, "Full text search is not enabled", nil)
+	}
+
+	if manager.IsSearchRebuildRunning(dep) {
+		return manager.ErrSearchRebuildRunning
+	}
+
+	if s.UserID > 0 {
+		if _, err := dep.UserClient().GetByID(c, s.UserID); err != nil {
+			return serializer.NewError(serializer.CodeUserNotFound, "", err)
+		}
+	}
+
+	l := logging.FromContext(c)
+	ctx := dep.ForkWithLogger(context.Background(), l)
+	ctx = context.WithValue(ctx, logging.LoggerCtx{}, l)
+	go func() {
+		if _, err := manager.RebuildSearchIndex(ctx, dep, s.UserID); err != nil {
+			l.Warning("Failed to rebuild search index: %s", err)
+		}
+	}()
+
+	return nil
+}
+
+// GetSearchIndexStatus returns size, health and indexing progress of the full-text search index.
+func GetSearchIndexStatus(c *gin.Context) (*SearchIndexStatus, error) {
+	dep := dependency.FromContext(c)
+	settings := dep.SettingProvider().FullTextSearch(c)
+	res := &SearchIndexStatus{Enabled: settings.Enabled}
+
+	report, err := manager.LoadSearchRebuildReport()
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to load search rebuild report", err)
+	}
+	res.Rebuild = report
+
+	if !settings.Enabled {
+		return res, nil
+	}
+
+	res.Provider = settings.Provider
+	if stats, err := dep.SearchIndexer(c).Stats(c); err != nil {
+		res.Error = err.Error()
+	} else {
+		res.Documents = stats.Documents
+		res.SizeBytes = stats.SizeBytes
+		res.Health = stats.Health
+	}
+
+	after, afterID := manager.SearchIndexCursor(dep.KV())
+	if !after.IsZero() {
+		res.Cursor = &after
+	}
+
+	fileClient := dep.FileClient()
+	pendingArgs := &inventory.ListUpdatedFilesParameters{After: after, AfterID: afterID, Limit: 1}
+	res.Pending, err = fileClient.CountUpdatedFiles(c, pendingArgs)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to count files to index", err)
+	}
+
+	if res.Pending > 0 {
+		oldest, err := fileClient.ListUpdatedFiles(c, pendingArgs)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to list files to index", err)
+		}
+
+		if len(oldest) > 0 {
+			res.LagSeconds = int64(time.Since(oldest[0].UpdatedAt).Seconds())
+		}
+	}
+
+	return res, nil
+}
@@ -12,10 +12,15 @@ import (
 
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/ipaccess"
+	"github.com/cloudreve/Cloudreve/v4/pkg/onlyoffice"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/siteconfig"
 	"github.com/cloudreve/Cloudreve/v4/pkg/thumb"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/gin-gonic/gin"
@@ -213,14 +218,25 @@ type (
 
 var (
 	preprocessors = map[string]SettingPreProcessor{
-		"siteURL":      siteUrlPreProcessor,
-		"mime_mapping": mimeMappingPreProcessor,
-		"secret_key":   secretKeyPreProcessor,
+		"siteURL":            siteUrlPreProcessor,
+		"mime_mapping":       mimeMappingPreProcessor,
+		"secret_key":         secretKeyPreProcessor,
+		"ip_trusted_proxies": ipRulesPreProcessor,
+		"ip_api_allow":       ipRulesPreProcessor,
+		"ip_api_deny":        ipRulesPreProcessor,
+		"ip_admin_allow":     ipRulesPreProcessor,
+		"ip_admin_deny":      ipRulesPreProcessor,
+		"ip_webdav_allow":    ipRulesPreProcessor,
+		"ip_webdav_deny":     ipRulesPreProcessor,
+		"ip_s3_allow":        ipRulesPreProcessor,
+		"ip_s3_deny":         ipRulesPreProcessor,
+		"file_viewers":       fileViewersPreProcessor,
 	}
 	postprocessors = map[string]SettingPostProcessor{
 		"mime_mapping":                               mimeMappingPostProcessor,
 		"media_meta_exif":                            mediaMetaPostProcessor,
 		"media_meta_music":                           mediaMetaPostProcessor,
+		"media_meta_model":                           mediaMetaPostProcessor,
 		"media_meta_ffprobe":                         mediaMetaPostProcessor,
 		"smtpUser":                                   emailPostProcessor,
 		"smtpPass":                                   emailPostProcessor,
@@ -243,6 +259,12 @@ var (
 		"queue_thumb_backoff_max_duration":           thumbQueuePostProcessor,
 		"queue_thumb_max_retry":                      thumbQueuePostProcessor,
 		"queue_thumb_retry_delay":                    thumbQueuePostProcessor,
+		"queue_thumb_pregen_worker_num":              thumbPregenQueuePostProcessor,
+		"queue_thumb_pregen_max_execution":           thumbPregenQueuePostProcessor,
+		"queue_thumb_pregen_backoff_factor":          thumbPregenQueuePostProcessor,
+		"queue_thumb_pregen_backoff_max_duration":    thumbPregenQueuePostProcessor,
+		"queue_thumb_pregen_max_retry":               thumbPregenQueuePostProcessor,
+		"queue_thumb_pregen_retry_delay":             thumbPregenQueuePostProcessor,
 		"queue_recycle_worker_num":                   entityRecycleQueuePostProcessor,
 		"queue_recycle_max_execution":                entityRecycleQueuePostProcessor,
 		"queue_recycle_backoff_factor":               entityRecycleQueuePostProcessor,
@@ -267,22 +289,48 @@ var (
 
 func (s *SetSettingService) SetSetting(c *gin.Context) (map[string]string, error) {
 	dep := dependency.FromContext(c)
-	kv := dep.KV()
-	settingClient := dep.SettingClient()
+	postprocessors, err := preprocessSettings(c, s.Settings)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save to db
+	sc, tx, ctx, err := inventory.WithTx(c, dep.SettingClient())
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create transaction", err)
+	}
+
+	if err := sc.Set(ctx, s.Settings); err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to save settings", err)
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit transaction", err)
+	}
+
+	if err := postprocessSettings(c, s.Settings, postprocessors); err != nil {
+		return nil, err
+	}
+
+	return s.Settings, nil
+}
 
-	// Preprocess settings
-	allPreprocessors := make(map[string]SettingPreProcessor)
-	allPostprocessors := make(map[string]SettingPostProcessor)
-	for k, _ := range s.Settings {
+// preprocessSettings validates settings to be saved, returns post processors to be executed once
+// they are saved.
+func preprocessSettings(c *gin.Context, settings map[string]string) (map[uintptr]SettingPostProcessor, error) {
+	allPreprocessors := make(map[uintptr]SettingPreProcessor)
+	allPostprocessors := make(map[uintptr]SettingPostProcessor)
+	for k, _ := range settings {
 		if preprocessor, ok := preprocessors[k]; ok {
-			fnName := reflect.TypeOf(preprocessor).Name()
+			fnName := reflect.ValueOf(preprocessor).Pointer()
 			if _, ok := allPreprocessors[fnName]; !ok {
 				allPreprocessors[fnName] = preprocessor
 			}
 		}
 
 		if postprocessor, ok := postprocessors[k]; ok {
-			fnName := reflect.TypeOf(postprocessor).Name()
+			fnName := reflect.ValueOf(postprocessor).Pointer()
 			if _, ok := allPostprocessors[fnName]; !ok {
 				allPostprocessors[fnName] = postprocessor
 			}
@@ -291,39 +339,40 @@ func (s *SetSettingService) SetSetting(c *gin.Context) (map[string]string, error
 
 	// Execute all preprocessors
 	for _, preprocessor := range allPreprocessors {
-		if err := preprocessor(c, s.Settings); err != nil {
+		if err := preprocessor(c, settings); err != nil {
 			return nil, serializer.NewError(serializer.CodeParamErr, "Failed to validate settings", err)
 		}
 	}
 
-	// Save to db
-	sc, tx, ctx, err := inventory.WithTx(c, settingClient)
-	if err != nil {
-		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create transaction", err)
-	}
-
-	if err := sc.Set(ctx, s.Settings); err != nil {
-		_ = inventory.Rollback(tx)
-		return nil, serializer.NewError(serializer.CodeDBError, "Failed to save settings", err)
-	}
-
-	if err := inventory.Commit(tx); err != nil {
-		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit transaction", err)
-	}
+	return allPostprocessors, nil
+}
 
+// postprocessSettings cleans cache of saved settings and executes post processors.
+func postprocessSettings(c *gin.Context, settings map[string]string, postprocessors map[uintptr]SettingPostProcessor) error {
 	// Clean cache
-	if err := kv.Delete(setting.KvSettingPrefix, lo.Keys(s.Settings)...); err != nil {
-		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to clear cache", err)
+	if err := dependency.FromContext(c).KV().Delete(setting.KvSettingPrefix, lo.Keys(settings)...); err != nil {
+		return serializer.NewError(serializer.CodeInternalSetting, "Failed to clear cache", err)
 	}
 
 	// Execute post preprocessors
-	for _, postprocessor := range allPostprocessors {
-		if err := postprocessor(ctx, s.Settings); err != nil {
-			return nil, serializer.NewError(serializer.CodeParamErr, "Failed to post process settings", err)
+	for _, postprocessor := range postprocessors {
+		if err := postprocessor(c, settings); err != nil {
+			return serializer.NewError(serializer.CodeParamErr, "Failed to post process settings", err)
 		}
 	}
 
-	return s.Settings, nil
+	return nil
+}
+
+// ReloadConfig re-reads the config file and applies hot reloadable changes.
+func ReloadConfig(c *gin.Context) (*conf.ReloadResult, error) {
+	dep := dependency.FromContext(c)
+	res, err := dep.ReloadConfig(c)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to reload config file", err)
+	}
+
+	return res, nil
 }
 
 func siteUrlPreProcessor(ctx context.Context, settings map[string]string) error {
@@ -355,6 +404,42 @@ func mimeMappingPreProcessor(ctx context.Context, settings map[string]string) er
 	return nil
 }
 
+func ipRulesPreProcessor(ctx context.Context, settings map[string]string) error {
+	for key, value := range settings {
+		if !strings.HasPrefix(key, "ip_") {
+			continue
+		}
+
+		if _, err := ipaccess.ParseCIDRs(value); err != nil {
+			return fmt.Errorf("invalid IP list of %q: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// fileViewersPreProcessor refuses enabled OnlyOffice viewers without a valid DocumentServer URL and JWT secret.
+func fileViewersPreProcessor(ctx context.Context, settings map[string]string) error {
+	var groups []setting.ViewerGroup
+	if err := json.Unmarshal([]byte(settings["file_viewers"]), &groups); err != nil {
+		return fmt.Errorf("invalid file viewers: %w", err)
+	}
+
+	for _, group := range groups {
+		for _, viewer := range group.Viewers {
+			if viewer.Type != setting.ViewerTypeOnlyOffice || viewer.Disabled {
+				continue
+			}
+
+			if err := onlyoffice.ValidateViewer(viewer.Props); err != nil {
+				return fmt.Errorf("viewer %q: %w", viewer.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func mimeMappingPostProcessor(ctx context.Context, settings map[string]string) error {
 	dep := dependency.FromContext(ctx)
 	dep.MimeDetector(context.WithValue(ctx, dependency.ReloadCtx{}, true))
@@ -404,9 +489,129 @@ func thumbQueuePostProcessor(ctx context.Context, settings map[string]string) er
 	return nil
 }
 
+func thumbPregenQueuePostProcessor(ctx context.Context, settings map[string]string) error {
+	dep := dependency.FromContext(ctx)
+	dep.ThumbPregenQueue(context.WithValue(ctx, dependency.ReloadCtx{}, true)).Start()
+	return nil
+}
+
 func secretKeyPostProcessor(ctx context.Context, settings map[string]string) error {
 	dep := dependency.FromContext(ctx)
 	dep.KV().Delete(manager.EntityUrlCacheKeyPrefix)
 	settings["secret_key"] = ""
 	return nil
 }
+
+type (
+	ExportSiteConfigService struct {
+		Nodes    bool `json:"nodes"`
+		Policies bool `json:"policies"`
+		Groups   bool `json:"groups"`
+		Secrets  bool `json:"secrets"`
+	}
+	ExportSiteConfigParamCtx struct{}
+)
+
+// Export exports site settings and selected entities as YAML manifest.
+func (s *ExportSiteConfigService) Export(c *gin.Context) ([]byte, error) {
+	dep := dependency.FromContext(c)
+	m, err := siteconfig.Export(c, dep, &siteconfig.ExportOptions{
+		Nodes:    s.Nodes,
+		Policies: s.Policies,
+		Groups:   s.Groups,
+		Secrets:  s.Secrets,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to export site config", err)
+	}
+
+	res, err := siteconfig.Marshal(m)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to encode site config", err)
+	}
+
+	return res, nil
+}
+
+type (
+	ImportSiteConfigService struct {
+		Manifest string `json:"manifest" binding:"required"`
+		DryRun   bool   `json:"dry_run"`
+	}
+	ImportSiteConfigParamCtx struct{}
+)
+
+// Import applies given YAML manifest in a single transaction. Settings are validated and post processed
+// the same way as manual edits.
+func (s *ImportSiteConfigService) Import(c *gin.Context) (*siteconfig.ApplyResult, error) {
+	dep := dependency.FromContext(c)
+	m, err := siteconfig.Unmarshal([]byte(s.Manifest))
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, err.Error(), err)
+	}
+
+	sc, tx, ctx, err := inventory.WithTx(c, dep.SettingClient())
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create transaction", err)
+	}
+
+	res, err := siteconfig.Apply(ctx, dep, m, &siteconfig.ApplyOptions{
+		SkipSettings: true,
+		ValidateNode: func(ctx context.Context, n *ent.Node) error {
+			return validateNode(c, n)
+		},
+		ValidatePolicy: validatePolicyNode,
+	})
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeParamErr, "Failed to apply site config: "+err.Error(), err)
+	}
+
+	var postprocessors map[uintptr]SettingPostProcessor
+	if len(res.ChangedSettings) > 0 {
+		if postprocessors, err = preprocessSettings(c, res.ChangedSettings); err != nil {
+			_ = inventory.Rollback(tx)
+			return nil, err
+		}
+
+		if err := sc.Set(ctx, res.ChangedSettings); err != nil {
+			_ = inventory.Rollback(tx)
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to save settings", err)
+		}
+	}
+
+	if s.DryRun {
+		_ = inventory.Rollback(tx)
+		return res, nil
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit transaction", err)
+	}
+
+	if len(res.SavedNodes) > 0 {
+		np, err := dep.NodePool(c)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to get node pool", err)
+		}
+
+		for _, n := range res.SavedNodes {
+			np.Upsert(c, n)
+		}
+	}
+
+	if len(res.SavedNodes) > 0 || len(res.SavedPolicies) > 0 {
+		// Policies cached before the transaction is committed may refer to outdated nodes or settings.
+		kv := dep.KV()
+		kv.Delete(inventory.StoragePolicyCacheKey)
+		kv.Delete(manager.EntityUrlCacheKeyPrefix)
+	}
+
+	if len(res.ChangedSettings) > 0 {
+		if err := postprocessSettings(c, res.ChangedSettings, postprocessors); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/audit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/service/explorer"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	UserTakeoutService struct {
+		ID           int  `json:"id" binding:"required"`
+		IncludeFiles bool `json:"include_files"`
+	}
+	UserTakeoutParamCtx struct{}
+)
+
+// Create exports personal data of a user for legal requests. The task is owned by current admin, so that
+// the archive can only be downloaded by the admin.
+func (s *UserTakeoutService) Create(c *gin.Context) (*explorer.TaskResponse, error) {
+	dep := dependency.FromContext(c)
+	current := inventory.UserFromContext(c)
+	if _, err := dep.UserClient().GetByID(c, s.ID); err != nil {
+		return nil, serializer.NewError(serializer.CodeUserNotFound, "User not found", err)
+	}
+
+	event := audit.NewEvent(c, audit.EventTakeoutRequested, s.ID, current.ID, map[string]string{
+		"include_files": strconv.FormatBool(s.IncludeFiles),
+	}).WithEntity(audit.EntityUser, s.ID)
+	if err := dep.AuditRecorder().Record(c, event); err != nil {
+		// Exports of personal data must not happen without a trace.
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to record audit log", err)
+	}
+
+	return explorer.CreateTakeoutTask(c, s.ID, s.IncludeFiles)
+}
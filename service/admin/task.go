@@ -2,12 +2,16 @@ package admin
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/ent/task"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
@@ -26,6 +30,7 @@ func GetQueueMetrics(c *gin.Context) ([]QueueMetric, error) {
 	ioIntense := dep.IoIntenseQueue(c)
 	remoteDownload := dep.RemoteDownloadQueue(c)
 	thumb := dep.ThumbQueue(c)
+	thumbPregen := dep.ThumbPregenQueue(c)
 
 	res = append(res, QueueMetric{
 		Name:            setting.QueueTypeMediaMeta,
@@ -67,7 +72,48 @@ func GetQueueMetrics(c *gin.Context) ([]QueueMetric, error) {
 		SubmittedTasks:  thumb.SubmittedTasks(),
 		SuspendingTasks: thumb.SuspendingTasks(),
 	})
+	res = append(res, QueueMetric{
+		Name:            setting.QueueTypeThumbPregen,
+		BusyWorkers:     thumbPregen.BusyWorkers(),
+		SuccessTasks:    thumbPregen.SuccessTasks(),
+		FailureTasks:    thumbPregen.FailureTasks(),
+		SubmittedTasks:  thumbPregen.SubmittedTasks(),
+		SuspendingTasks: thumbPregen.SuspendingTasks(),
+	})
+
+	return res, nil
+}
+
+// GetThumbPregenProgress returns progress of thumbnail pregeneration on current node.
+func GetThumbPregenProgress(c *gin.Context) (*ThumbPregenProgress, error) {
+	dep := dependency.FromContext(c)
+	settings := dep.SettingProvider().ThumbPregen(c)
+	q := dep.ThumbPregenQueue(c)
+	pending := q.PendingTasks()
+	cursor := manager.ThumbBackfillCursor(dep.KV())
+
+	res := &ThumbPregenProgress{
+		BusyWorkers:    q.BusyWorkers(),
+		SuccessTasks:   q.SuccessTasks(),
+		FailureTasks:   q.FailureTasks(),
+		BackfillCursor: cursor,
+	}
+	if len(pending) == queue.ThumbPregenPriorityLanes {
+		res.PendingVisible = pending[queue.ThumbPregenPriorityVisible]
+		res.PendingRecent = pending[queue.ThumbPregenPriorityRecent]
+		res.PendingBackfill = pending[queue.ThumbPregenPriorityBackfill]
+	}
+
+	remaining, err := dep.FileClient().CountThumbBackfillCandidates(c, &inventory.ListThumbBackfillCandidatesParameters{
+		AfterID:     cursor,
+		Exts:        settings.Exts,
+		DisabledKey: dbfs.ThumbDisabledKey,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to count files to backfill", err)
+	}
 
+	res.BackfillRemaining = remaining
 	return res, nil
 }
 
@@ -76,8 +122,48 @@ const (
 	taskStatusCondition        = "task_status"
 	taskCorrelationIDCondition = "task_correlation_id"
 	taskUserIDCondition        = "task_user_id"
+	taskQueueCondition         = "task_queue"
+	taskDeadLetterCondition    = "task_dead_letter"
+
+	// retryFailedTaskPageSize is the page size used to scan failed tasks for bulk requeue.
+	retryFailedTaskPageSize = 100
 )
 
+// queueTaskTypes maps queues to the types of persisted tasks they run.
+var queueTaskTypes = map[setting.QueueType][]string{
+	setting.QueueTypeMediaMeta:      {queue.MediaMetaTaskType, queue.OcrTaskType, queue.ShareModerationTaskType},
+	setting.QueueTypeIOIntense:      {queue.CreateArchiveTaskType, queue.ExtractArchiveTaskType, queue.RelocateTaskType, queue.ImportTaskType, queue.OffboardExportTaskType, queue.FolderSyncTaskType, queue.UrlImportTaskType, queue.TakeoutTaskType},
+	setting.QueueTypeRemoteDownload: {queue.RemoteDownloadTaskType},
+	setting.QueueTypeEntityRecycle:  {queue.EntityRecycleRoutineTaskType, queue.ExplicitEntityRecycleTaskType, queue.UploadSentinelCheckTaskType},
+}
+
+// queueOfTaskType returns the queue type that runs given task type.
+func queueOfTaskType(taskType string) setting.QueueType {
+	for q, types := range queueTaskTypes {
+		if lo.Contains(types, taskType) {
+			return q
+		}
+	}
+
+	return ""
+}
+
+// taskQueue returns the queue instance that runs given task type.
+func taskQueue(c *gin.Context, dep dependency.Dep, taskType string) queue.Queue {
+	switch queueOfTaskType(taskType) {
+	case setting.QueueTypeMediaMeta:
+		return dep.MediaMetaQueue(c)
+	case setting.QueueTypeIOIntense:
+		return dep.IoIntenseQueue(c)
+	case setting.QueueTypeRemoteDownload:
+		return dep.RemoteDownloadQueue(c)
+	case setting.QueueTypeEntityRecycle:
+		return dep.EntityRecycleQueue(c)
+	default:
+		return nil
+	}
+}
+
 func (s *AdminListService) Tasks(c *gin.Context) (*ListTaskResponse, error) {
 	dep := dependency.FromContext(c)
 	taskClient := dep.TaskClient()
@@ -94,8 +180,28 @@ func (s *AdminListService) Tasks(c *gin.Context) (*ListTaskResponse, error) {
 		taskType = []string{s.Conditions[taskTypeCondition]}
 	}
 
+	if s.Conditions[taskQueueCondition] != "" {
+		queueTypes, ok := queueTaskTypes[setting.QueueType(s.Conditions[taskQueueCondition])]
+		if !ok {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Unknown task queue", nil)
+		}
+
+		if len(taskType) > 0 {
+			taskType = lo.Intersect(taskType, queueTypes)
+			if len(taskType) == 0 {
+				// Task type not in given queue, nothing will match.
+				taskType = []string{""}
+			}
+		} else {
+			taskType = queueTypes
+		}
+	}
+
 	if s.Conditions[taskStatusCondition] != "" {
-		status = []task.Status{task.Status(s.Conditions[taskStatusCondition])}
+		// Multiple status can be separated by comma
+		for _, st := range strings.Split(s.Conditions[taskStatusCondition], ",") {
+			status = append(status, task.Status(strings.TrimSpace(st)))
+		}
 	}
 
 	if s.Conditions[taskCorrelationIDCondition] != "" {
@@ -125,6 +231,7 @@ func (s *AdminListService) Tasks(c *gin.Context) (*ListTaskResponse, error) {
 		CorrelationID: correlationID,
 		Types:         taskType,
 		Status:        status,
+		DeadLettered:  s.Conditions[taskDeadLetterCondition] == "true",
 	})
 
 	if err != nil {
@@ -182,6 +289,7 @@ func (s *AdminListService) Tasks(c *gin.Context) (*ListTaskResponse, error) {
 				UserHashID: uid,
 				Node:       node,
 				Summary:    summary,
+				Queue:      queueOfTaskType(task.Type),
 			}
 		}),
 	}, nil
@@ -230,6 +338,7 @@ func (s *SingleTaskService) Get(c *gin.Context) (*GetTaskResponse, error) {
 		Node:       node,
 		UserHashID: userHashID,
 		TaskHashID: hashid.EncodeTaskID(hasher, task.ID),
+		Queue:      queueOfTaskType(task.Type),
 	}, nil
 }
 
@@ -251,3 +360,154 @@ func (s *BatchTaskService) Delete(c *gin.Context) error {
 
 	return nil
 }
+
+// Cancel cancels a queued, suspended or running task.
+func (s *SingleTaskService) Cancel(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+
+	// Tasks running in this instance are interrupted through the queue.
+	if t, found := dep.TaskRegistry().Get(s.ID); found {
+		t.Cancel()
+		return nil
+	}
+
+	model, err := dep.TaskClient().GetTaskByID(c, s.ID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Task not found", err)
+	}
+
+	if model.Status == task.StatusCompleted || model.Status == task.StatusError || model.Status == task.StatusCanceled {
+		return serializer.NewError(serializer.CodeParamErr, fmt.Sprintf("Task is already %s", model.Status), nil)
+	}
+
+	// Task may be queued or running on other instances, which pick up the signal and cancel it.
+	if err := queue.SignalCancel(dep.KV(), s.ID); err != nil {
+		return serializer.NewError(serializer.CodeCacheOperation, "Failed to signal task cancellation", err)
+	}
+
+	if model.Status == task.StatusProcessing {
+		return nil
+	}
+
+	if _, err := dep.TaskClient().Update(c, model, &inventory.TaskArgs{
+		Status:       task.StatusCanceled,
+		PublicState:  model.PublicState,
+		PrivateState: model.PrivateState,
+	}); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to cancel task", err)
+	}
+
+	return nil
+}
+
+// Retry requeues a failed or canceled task.
+func (s *SingleTaskService) Retry(c *gin.Context) error {
+	return retryTask(c, dependency.FromContext(c), s.ID)
+}
+
+type (
+	RetryFailedTaskService struct {
+		// Queue only requeue failed tasks in given queue if not empty.
+		Queue setting.QueueType `json:"queue"`
+		// Types only requeue failed tasks of given types if not empty.
+		Types []string `json:"types"`
+	}
+	RetryFailedTaskParamCtx struct{}
+)
+
+// Retry requeues failed tasks in batch, returns the number of requeued tasks.
+func (s *BatchTaskService) Retry(c *gin.Context) (*BatchRetryTaskResponse, error) {
+	dep := dependency.FromContext(c)
+	res := &BatchRetryTaskResponse{Failed: make(map[int]string)}
+	for _, id := range s.IDs {
+		if err := retryTask(c, dep, id); err != nil {
+			res.Failed[id] = err.Error()
+			continue
+		}
+		res.Requeued++
+	}
+
+	return res, nil
+}
+
+// Retry requeues all failed tasks matching the filters, e.g. after the cause of failures is fixed.
+func (s *RetryFailedTaskService) Retry(c *gin.Context) (*BatchRetryTaskResponse, error) {
+	dep := dependency.FromContext(c)
+	taskTypes := s.Types
+	if s.Queue != "" {
+		queueTypes, ok := queueTaskTypes[s.Queue]
+		if !ok {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Unknown task queue", nil)
+		}
+
+		if len(taskTypes) > 0 {
+			taskTypes = lo.Intersect(taskTypes, queueTypes)
+			if len(taskTypes) == 0 {
+				return &BatchRetryTaskResponse{}, nil
+			}
+		} else {
+			taskTypes = queueTypes
+		}
+	}
+
+	// Collect IDs first, requeued tasks leave the error status and would shift the pages.
+	var ids []int
+	for page := 0; ; page++ {
+		tasks, err := dep.TaskClient().List(c, &inventory.ListTaskArgs{
+			PaginationArgs: &inventory.PaginationArgs{
+				Page:     page,
+				PageSize: retryFailedTaskPageSize,
+			},
+			Types:  taskTypes,
+			Status: []task.Status{task.StatusError},
+		})
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to list failed tasks", err)
+		}
+
+		for _, t := range tasks.Tasks {
+			ids = append(ids, t.ID)
+		}
+
+		if len(tasks.Tasks) < retryFailedTaskPageSize {
+			break
+		}
+	}
+
+	batch := &BatchTaskService{IDs: ids}
+	return batch.Retry(c)
+}
+
+func retryTask(c *gin.Context, dep dependency.Dep, id int) error {
+	ctx := context.WithValue(c, inventory.LoadTaskUser{}, true)
+	ctx = context.WithValue(ctx, inventory.LoadUserGroup{}, true)
+	model, err := dep.TaskClient().GetTaskByID(ctx, id)
+	if err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Task not found", err)
+	}
+
+	if model.Status != task.StatusError && model.Status != task.StatusCanceled {
+		return serializer.NewError(serializer.CodeParamErr, fmt.Sprintf("Task in %s status cannot be retried", model.Status), nil)
+	}
+
+	q := taskQueue(c, dep, model.Type)
+	if q == nil {
+		return serializer.NewError(serializer.CodeParamErr, fmt.Sprintf("Task type %q cannot be retried", model.Type), nil)
+	}
+
+	t, err := queue.NewTaskFromModel(model)
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to parse task", err)
+	}
+
+	if err := queue.ClearCancelSignal(dep.KV(), id); err != nil {
+		return serializer.NewError(serializer.CodeCacheOperation, "Failed to clear task cancellation", err)
+	}
+
+	t.OnRequeue()
+	if err := q.QueueTask(c, t); err != nil {
+		return serializer.NewError(serializer.CodeInternalSetting, "Failed to requeue task", err)
+	}
+
+	return nil
+}
@@ -3,14 +3,18 @@ package admin
 import (
 	"context"
 	"strconv"
+	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/passwordpolicy"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/gin-gonic/gin"
 	"github.com/samber/lo"
@@ -113,12 +117,34 @@ func (service *SingleUserService) Get(c *gin.Context) (*GetUserResponse, error)
 		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to get user capacity", err)
 	}
 
-	return &GetUserResponse{
-		User:         user,
-		HashID:       hashid.EncodeUserID(hasher, user.ID),
-		TwoFAEnabled: user.TwoFactorSecret != "",
-		Capacity:     capacity,
-	}, nil
+	res := &GetUserResponse{
+		User:                user,
+		HashID:              hashid.EncodeUserID(hasher, user.ID),
+		TwoFAEnabled:        user.TwoFactorSecret != "",
+		Capacity:            capacity,
+		EffectiveMaxStorage: inventory.EffectiveMaxStorage(user),
+	}
+
+	if policy, err := dep.StoragePolicyClient().GetByUser(ctx, user); err == nil {
+		res.EffectivePolicyID = policy.ID
+		res.EffectivePolicyName = policy.Name
+	}
+
+	if lockedUntil := auth.LoginLocks(dep.KV(), dep.SettingProvider().LoginProtection(c), auth.LoginSubject(user, ""), ""); !lockedUntil.IsZero() {
+		res.LockedUntil = &lockedUntil
+	}
+
+	return res, nil
+}
+
+// UnlockLogin clears failed login records of the user, lifting the lockout if any.
+func (service *SingleUserService) UnlockLogin(c *gin.Context) (*GetUserResponse, error) {
+	dep := dependency.FromContext(c)
+	if err := auth.ResetLoginFailure(dep.KV(), auth.LoginSubject(&ent.User{ID: service.ID}, "")); err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to unlock user", err)
+	}
+
+	return service.Get(c)
 }
 
 func (service *SingleUserService) CalibrateStorage(c *gin.Context) (*GetUserResponse, error) {
@@ -135,6 +161,184 @@ func (service *SingleUserService) CalibrateStorage(c *gin.Context) (*GetUserResp
 	return subService.Get(c)
 }
 
+const dryRunRecalcQuery = "dry_run"
+
+// RecalculateStorage starts recalculating used storage of all users in background, storage counters
+// are only reported but not updated if dry_run is set in query.
+func RecalculateStorage(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	if manager.IsStorageRecalcRunning(dep) {
+		return manager.ErrStorageRecalcRunning
+	}
+
+	dryRun := c.Query(dryRunRecalcQuery) != ""
+	l := logging.FromContext(c)
+	ctx := dep.ForkWithLogger(context.Background(), l)
+	ctx = context.WithValue(ctx, logging.LoggerCtx{}, l)
+	go func() {
+		if _, err := manager.RecalculateStorage(ctx, dep, dryRun); err != nil {
+			l.Warning("Failed to recalculate user storage: %s", err)
+		}
+	}()
+
+	return nil
+}
+
+// StorageRecalcReport returns the latest storage recalculation report.
+func StorageRecalcReport(c *gin.Context) (*manager.StorageRecalcReport, error) {
+	report, err := manager.LoadStorageRecalcReport()
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to load storage recalculation report", err)
+	}
+
+	if report == nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Storage has not been recalculated yet", nil)
+	}
+
+	return report, nil
+}
+
+type (
+	UserOverrideService struct {
+		ID int `json:"id" binding:"required"`
+		// StoragePolicyID overrides group's storage policy, 0 to clear the override.
+		StoragePolicyID int `json:"storage_policy_id" binding:"min=0"`
+		// MaxStorage overrides group's max storage, null to clear the override.
+		MaxStorage *int64 `json:"max_storage" binding:"omitempty,min=0"`
+	}
+	UserOverrideParamCtx struct{}
+)
+
+// Update sets per-user storage policy and max storage overrides.
+func (s *UserOverrideService) Update(c *gin.Context) (*GetUserResponse, error) {
+	dep := dependency.FromContext(c)
+	userClient := dep.UserClient()
+
+	u, err := userClient.GetByID(c, s.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get user", err)
+	}
+
+	if s.StoragePolicyID > 0 {
+		if _, err := dep.StoragePolicyClient().GetPolicyByID(c, s.StoragePolicyID); err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Storage policy not found", err)
+		}
+	}
+
+	if err := userClient.SetOverrides(c, u.ID, s.StoragePolicyID, s.MaxStorage); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to save user overrides", err)
+	}
+
+	subService := &SingleUserService{ID: s.ID}
+	return subService.Get(c)
+}
+
+type (
+	UserGroupService struct {
+		ID      int `json:"id" binding:"required"`
+		GroupID int `json:"group_id" binding:"required"`
+		// ExpiresAt reverts the user to fallback group after this time, null for permanent membership.
+		ExpiresAt *time.Time `json:"expires_at"`
+		// FallbackGroupID group after expiration, 0 to use the group before this assignment.
+		FallbackGroupID int `json:"fallback_group_id" binding:"min=0"`
+	}
+	UserGroupParamCtx struct{}
+)
+
+// Update moves user to given group, optionally for a limited time.
+func (s *UserGroupService) Update(c *gin.Context) (*GetUserResponse, error) {
+	dep := dependency.FromContext(c)
+	userClient := dep.UserClient()
+	groupClient := dep.GroupClient()
+
+	ctx := context.WithValue(c, inventory.LoadUserGroup{}, true)
+	u, err := userClient.GetByID(ctx, s.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeUserNotFound, "User not found", err)
+	}
+
+	if s.ID == 1 && u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+		return nil, serializer.NewError(serializer.CodeInvalidActionOnDefaultUser, "Cannot change default user's group", nil)
+	}
+
+	if s.GroupID == inventory.AnonymousGroupID || s.FallbackGroupID == inventory.AnonymousGroupID {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Cannot assign user to anonymous group", nil)
+	}
+
+	target, err := groupClient.GetByID(c, s.GroupID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Group not found", err)
+	}
+
+	// Organization admins can only move other members between non-admin groups of the organization.
+	scoped := inventory.OrganizationScopeFromContext(c) != nil
+	if scoped {
+		if current := inventory.UserFromContext(c); current != nil && current.ID == s.ID {
+			return nil, serializer.NewError(serializer.CodeNoPermissionErr, "Cannot change your own group", nil)
+		}
+
+		if target.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+			return nil, serializer.NewError(serializer.CodeNoPermissionErr, "Cannot assign user to admin group", nil)
+		}
+	}
+
+	var expiration *types.GroupExpiration
+	if s.ExpiresAt != nil {
+		if s.ExpiresAt.Before(time.Now()) {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Expiration time must be in the future", nil)
+		}
+
+		if s.FallbackGroupID != 0 {
+			fallback, err := groupClient.GetByID(c, s.FallbackGroupID)
+			if err != nil {
+				return nil, serializer.NewError(serializer.CodeParamErr, "Fallback group not found", err)
+			}
+
+			if scoped && fallback.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+				return nil, serializer.NewError(serializer.CodeNoPermissionErr, "Cannot assign user to admin group", nil)
+			}
+		}
+
+		expiration = &types.GroupExpiration{
+			ExpiresAt:     *s.ExpiresAt,
+			FallbackGroup: s.FallbackGroupID,
+		}
+	}
+
+	uc, tx, txCtx, err := inventory.WithTx(c, userClient)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	locked, err := uc.UpdateSettings(txCtx, u.ID, func(locked *ent.User) error {
+		if expiration != nil && expiration.FallbackGroup == 0 {
+			// Keep original fallback when extending an existing time-limited membership.
+			expiration.FallbackGroup = locked.GroupUsers
+			if locked.Settings.GroupExpiration != nil {
+				expiration.FallbackGroup = locked.Settings.GroupExpiration.FallbackGroup
+			}
+		}
+
+		locked.Settings.GroupExpiration = expiration
+		return nil
+	})
+	if err == nil {
+		_, err = uc.SetGroup(txCtx, locked, s.GroupID)
+	}
+
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update user group", err)
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit user group change", err)
+	}
+
+	subService := &SingleUserService{ID: s.ID}
+	return subService.Get(c)
+}
+
 type (
 	UpsertUserService struct {
 		User     *ent.User `json:"user" binding:"required"`
@@ -169,11 +373,38 @@ func (s *UpsertUserService) Update(c *gin.Context) (*GetUserResponse, error) {
 		return nil, serializer.NewError(serializer.CodeParamErr, "Password too long", nil)
 	}
 
-	newUser, err := userClient.Upsert(ctx, s.User, s.Password, s.TwoFA)
+	if s.Password != "" {
+		if err := passwordpolicy.Validate(c, dep.SettingProvider().PasswordPolicy(c), dep.RequestClient(), dep.Logger(),
+			existing, s.Password); err != nil {
+			return nil, err
+		}
+	}
+
+	newUser, err := userClient.Upsert(ctx, s.User, "", s.TwoFA)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update user", err)
 	}
 
+	// Group changed manually, time-limited membership no longer applies.
+	if s.User.GroupUsers != existing.GroupUsers {
+		updated, err := userClient.UpdateSettings(ctx, existing.ID, func(locked *ent.User) error {
+			locked.Settings.GroupExpiration = nil
+			return nil
+		})
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to clear group expiration", err)
+		}
+
+		existing.Settings = updated.Settings
+	}
+
+	// Reset password separately so that password history is kept.
+	if s.Password != "" {
+		if _, err := userClient.UpdatePassword(ctx, existing, s.Password); err != nil {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to update user password", err)
+		}
+	}
+
 	service := &SingleUserService{ID: newUser.ID}
 	return service.Get(c)
 }
@@ -190,6 +421,11 @@ func (s *UpsertUserService) Create(c *gin.Context) (*GetUserResponse, error) {
 		return nil, serializer.NewError(serializer.CodeParamErr, "ID must be 0", nil)
 	}
 
+	if err := passwordpolicy.Validate(c, dep.SettingProvider().PasswordPolicy(c), dep.RequestClient(), dep.Logger(),
+		nil, s.Password); err != nil {
+		return nil, err
+	}
+
 	user, err := userClient.Upsert(c, s.User, s.Password, s.TwoFA)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create user", err)
@@ -220,6 +456,11 @@ func (s *BatchUserService) Delete(c *gin.Context) error {
 			continue
 		}
 
+		if err := checkUserLegalHold(c, dep, id); err != nil {
+			ae.Add(strconv.Itoa(id), err)
+			continue
+		}
+
 		fc, tx, ctx, err := inventory.WithTx(c, fileClient)
 		if err != nil {
 			ae.Add(strconv.Itoa(id), serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err))
@@ -0,0 +1,224 @@
+package admin
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/webhookdelivery"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webhook"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+func init() {
+	crontab.Register(setting.CronTypeWebhookRetry, CronRetryWebhookDeliveries)
+}
+
+// CronRetryWebhookDeliveries retries failed webhook deliveries and prunes old delivery logs.
+func CronRetryWebhookDeliveries(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	if retried := dep.WebhookDispatcher().RetryDue(ctx); retried > 0 {
+		l.Info("Retried %d webhook deliveries.", retried)
+	}
+
+	deleted, err := dep.WebhookClient().DeleteDeliveriesBefore(ctx, time.Now().Add(-webhook.DeliveryLogRetention))
+	if err != nil {
+		l.Warning("Failed to prune webhook delivery logs: %s", err)
+		return
+	}
+
+	if deleted > 0 {
+		l.Info("Pruned %d webhook delivery logs.", deleted)
+	}
+}
+
+type (
+	// Webhook is a webhook in admin API, the secret is never returned.
+	Webhook struct {
+		*ent.Webhook
+		HasSecret bool `json:"has_secret"`
+	}
+
+	ListWebhookResponse struct {
+		Webhooks []*Webhook `json:"webhooks"`
+		// Events lists event types can be subscribed.
+		Events []string `json:"events"`
+	}
+)
+
+func buildWebhook(w *ent.Webhook) *Webhook {
+	return &Webhook{Webhook: w, HasSecret: w.Secret != ""}
+}
+
+// ListWebhooks lists all webhooks.
+func ListWebhooks(c *gin.Context) (*ListWebhookResponse, error) {
+	dep := dependency.FromContext(c)
+	res, err := dep.WebhookClient().ListAll(c)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list webhooks", err)
+	}
+
+	return &ListWebhookResponse{
+		Webhooks: lo.Map(res, func(w *ent.Webhook, _ int) *Webhook {
+			return buildWebhook(w)
+		}),
+		Events: webhook.Events,
+	}, nil
+}
+
+type (
+	UpsertWebhookService struct {
+		ID   int    `json:"id"`
+		Name string `json:"name" binding:"required,min=1,max=255"`
+		Url  string `json:"url" binding:"required"`
+		// Secret is kept unchanged if nil.
+		Secret  *string  `json:"secret" binding:"omitempty,max=255"`
+		Events  []string `json:"events"`
+		Enabled bool     `json:"enabled"`
+	}
+	UpsertWebhookParamCtx struct{}
+)
+
+// Upsert creates a new webhook if ID is 0, otherwise updates the existing one.
+func (s *UpsertWebhookService) Upsert(c *gin.Context) (*Webhook, error) {
+	dep := dependency.FromContext(c)
+	webhookClient := dep.WebhookClient()
+
+	u, err := url.Parse(s.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid webhook URL", err)
+	}
+
+	if unknown, _ := lo.Difference(s.Events, webhook.Events); len(unknown) > 0 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Unknown event: "+unknown[0], nil)
+	}
+
+	args := &inventory.WebhookArgs{
+		Name:    s.Name,
+		Url:     u.String(),
+		Events:  lo.Uniq(s.Events),
+		Enabled: s.Enabled,
+	}
+
+	if s.Secret != nil {
+		args.Secret = *s.Secret
+	} else if s.ID > 0 {
+		existing, err := webhookClient.GetByID(c, s.ID)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeNotFound, "Webhook not found", err)
+		}
+
+		args.Secret = existing.Secret
+	}
+
+	res, err := webhookClient.Upsert(c, s.ID, args)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, serializer.NewError(serializer.CodeNotFound, "Webhook not found", err)
+		}
+
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to save webhook", err)
+	}
+
+	dep.WebhookDispatcher().Invalidate()
+	return buildWebhook(res), nil
+}
+
+type (
+	DeleteWebhookService struct {
+		ID int `uri:"id" binding:"required"`
+	}
+	DeleteWebhookParamCtx struct{}
+)
+
+// Delete deletes a webhook and its delivery logs.
+func (s *DeleteWebhookService) Delete(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	if err := dep.WebhookClient().Delete(c, s.ID); err != nil {
+		if ent.IsNotFound(err) {
+			return serializer.NewError(serializer.CodeNotFound, "Webhook not found", err)
+		}
+
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete webhook", err)
+	}
+
+	dep.WebhookDispatcher().Invalidate()
+	return nil
+}
+
+type (
+	ListWebhookDeliveryService struct {
+		Page     int    `form:"page" binding:"min=1"`
+		PageSize int    `form:"page_size" binding:"min=1,max=200,required"`
+		Status   string `form:"status" binding:"omitempty,eq=pending|eq=succeeded|eq=failed"`
+	}
+	ListWebhookDeliveryParamCtx struct{}
+
+	ListWebhookDeliveryResponse struct {
+		Pagination *inventory.PaginationResults `json:"pagination"`
+		Deliveries []*ent.WebhookDelivery       `json:"deliveries"`
+	}
+)
+
+// List lists delivery logs of the webhook in path, newest first.
+func (s *ListWebhookDeliveryService) List(c *gin.Context) (*ListWebhookDeliveryResponse, error) {
+	dep := dependency.FromContext(c)
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid ID", err)
+	}
+
+	res, err := dep.WebhookClient().ListDeliveries(c, &inventory.ListWebhookDeliveryParameters{
+		PaginationArgs: &inventory.PaginationArgs{
+			Page:     s.Page - 1,
+			PageSize: s.PageSize,
+		},
+		WebhookID: id,
+		Status:    webhookdelivery.Status(s.Status),
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list webhook deliveries", err)
+	}
+
+	return &ListWebhookDeliveryResponse{
+		Pagination: res.PaginationResults,
+		Deliveries: res.Deliveries,
+	}, nil
+}
+
+type (
+	RedeliverWebhookService struct {
+		ID int `uri:"id" binding:"required"`
+	}
+	RedeliverWebhookParamCtx struct{}
+)
+
+// Redeliver sends the delivery again immediately, regardless of its status.
+func (s *RedeliverWebhookService) Redeliver(c *gin.Context) (*ent.WebhookDelivery, error) {
+	dep := dependency.FromContext(c)
+	webhookClient := dep.WebhookClient()
+
+	delivery, err := webhookClient.GetDelivery(c, s.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Webhook delivery not found", err)
+	}
+
+	// Error of the attempt is saved in delivery log.
+	_ = dep.WebhookDispatcher().Deliver(c, delivery)
+
+	delivery, err = webhookClient.GetDelivery(c, s.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get webhook delivery", err)
+	}
+
+	return delivery, nil
+}
@@ -0,0 +1,60 @@
+package basic
+
+import (
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+// Announcement is a site announcement visible to current user.
+type Announcement struct {
+	ID          int        `json:"id"`
+	Title       string     `json:"title"`
+	Body        string     `json:"body"`
+	Dismissible bool       `json:"dismissible,omitempty"`
+	StartsAt    *time.Time `json:"starts_at,omitempty"`
+	EndsAt      *time.Time `json:"ends_at,omitempty"`
+}
+
+// ListAnnouncements lists active announcements targeting current user's group, dismissed
+// ones are excluded.
+func ListAnnouncements(c *gin.Context) ([]Announcement, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+
+	groupID := u.GroupUsers
+	if u.Edges.Group != nil {
+		groupID = u.Edges.Group.ID
+	}
+
+	announcements, err := dep.AnnouncementClient().ListActive(c, time.Now(), u.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list announcements", err)
+	}
+
+	res := make([]Announcement, 0, len(announcements))
+	for _, a := range announcements {
+		if !inventory.AnnouncementVisibleTo(a, groupID) || inventory.AnnouncementDismissed(a) {
+			continue
+		}
+
+		res = append(res, buildAnnouncement(a))
+	}
+
+	return res, nil
+}
+
+func buildAnnouncement(a *ent.Announcement) Announcement {
+	return Announcement{
+		ID:          a.ID,
+		Title:       a.Title,
+		Body:        a.Body,
+		Dismissible: a.Dismissible,
+		StartsAt:    a.StartsAt,
+		EndsAt:      a.EndsAt,
+	}
+}
@@ -0,0 +1,251 @@
+package basic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent/node"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
+	"github.com/cloudreve/Cloudreve/v4/pkg/conf"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	healthCheckTimeout     = 5 * time.Second
+	healthCheckKvKey       = "health_check_canary"
+	healthCheckMaxPolicies = 1000
+
+	HealthStatusUp       = "up"
+	HealthStatusDown     = "down"
+	HealthStatusDegraded = "degraded"
+)
+
+type (
+	// HealthCheck is the result of a single dependency check.
+	HealthCheck struct {
+		Name     string `json:"name"`
+		Status   string `json:"status"`
+		Error    string `json:"error,omitempty"`
+		Duration int64  `json:"duration_ms"`
+	}
+
+	// HealthResponse is the response of liveness and readiness probes.
+	HealthResponse struct {
+		Status  string         `json:"status"`
+		Version string         `json:"version"`
+		Mode    conf.SysMode   `json:"mode"`
+		Checks  []*HealthCheck `json:"checks,omitempty"`
+	}
+
+	healthCheckFunc func(ctx context.Context) error
+
+	// healthCheck is a dependency check. Failure of a non-critical check only degrades the
+	// service, as it can still serve traffic that does not rely on the dependency.
+	healthCheck struct {
+		check    healthCheckFunc
+		critical bool
+	}
+)
+
+// Liveness reports whether the process is up and serving requests. It does not check any dependency.
+func Liveness(c *gin.Context) *HealthResponse {
+	dep := dependency.FromContext(c)
+	return &HealthResponse{
+		Status:  HealthStatusUp,
+		Version: constants.BackendVersion,
+		Mode:    dep.ConfigProvider().System().Mode,
+	}
+}
+
+// Readiness checks dependencies required to serve traffic. Returns false if any of the core dependencies
+// (DB, KV) failed, unhealthy storage policies or slave nodes only mark the service as degraded. It is served without authentication, so storage policies and slave nodes are judged by results
+// cached by their background checks, and only aggregated results are exposed.
+func Readiness(c *gin.Context) (*HealthResponse, bool) {
+	dep := dependency.FromContext(c)
+	mode := dep.ConfigProvider().System().Mode
+
+	checks := map[string]healthCheck{
+		"kv": {critical: true, check: func(ctx context.Context) error {
+			return checkKV(dep)
+		}},
+	}
+
+	if mode == conf.MasterMode {
+		checks["db"] = healthCheck{critical: true, check: func(ctx context.Context) error {
+			_, err := dep.DBClient().Setting.Query().Exist(ctx)
+			return err
+		}}
+		checks["storage_policies"] = healthCheck{check: func(ctx context.Context) error {
+			return checkStoragePolicies(ctx, dep)
+		}}
+		checks["nodes"] = healthCheck{check: func(ctx context.Context) error {
+			return checkSlaveNodes(ctx, dep)
+		}}
+	}
+
+	res := &HealthResponse{
+		Status:  HealthStatusUp,
+		Version: constants.BackendVersion,
+		Mode:    mode,
+		Checks:  runHealthChecks(c, dep, checks),
+	}
+
+	for _, check := range res.Checks {
+		switch check.Status {
+		case HealthStatusDown:
+			res.Status = HealthStatusDown
+			return res, false
+		case HealthStatusDegraded:
+			res.Status = HealthStatusDegraded
+		}
+	}
+
+	return res, true
+}
+
+// runHealthChecks runs all checks concurrently, each with its own timeout.
+// Raw errors are logged instead of being returned.
+func runHealthChecks(ctx context.Context, dep dependency.Dep, checks map[string]healthCheck) []*HealthCheck {
+	var (
+		wg  sync.WaitGroup
+		mu  sync.Mutex
+		res = make([]*HealthCheck, 0, len(checks))
+	)
+
+	for name, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+			defer cancel()
+
+			start := time.Now()
+			errChan := make(chan error, 1)
+			go func() {
+				errChan <- check.check(checkCtx)
+			}()
+
+			var err error
+			select {
+			case err = <-errChan:
+			case <-checkCtx.Done():
+				err = checkCtx.Err()
+			}
+
+			result := &HealthCheck{
+				Name:     name,
+				Status:   HealthStatusUp,
+				Duration: time.Since(start).Milliseconds(),
+			}
+			if err != nil {
+				dep.Logger().Warning("Health check %q failed: %s", name, err)
+				result.Status = HealthStatusDown
+				if !check.critical {
+					result.Status = HealthStatusDegraded
+				}
+				result.Error = healthCheckError(err)
+			}
+
+			mu.Lock()
+			res = append(res, result)
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+	return res
+}
+
+// checkKV writes a canary value into KV store and reads it back.
+func checkKV(dep dependency.Dep) error {
+	kv := dep.KV()
+	canary := time.Now().UnixNano()
+	if err := kv.Set(healthCheckKvKey, canary, 60); err != nil {
+		return fmt.Errorf("failed to write: %w", err)
+	}
+
+	if _, ok := kv.Get(healthCheckKvKey); !ok {
+		return fmt.Errorf("failed to read back canary value")
+	}
+
+	return nil
+}
+
+// checkStoragePolicies fails if any storage policy is unhealthy in its latest background check.
+func checkStoragePolicies(ctx context.Context, dep dependency.Dep) error {
+	policies, err := dep.StoragePolicyClient().ListPolicies(ctx, &inventory.ListPolicyParameters{
+		PaginationArgs: &inventory.PaginationArgs{PageSize: healthCheckMaxPolicies},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list storage policies: %w", err)
+	}
+
+	unhealthy := 0
+	for _, policy := range policies.Policies {
+		if health := manager.GetPolicyHealth(dep, policy.ID); health != nil && !health.Healthy {
+			unhealthy++
+		}
+	}
+
+	if unhealthy > 0 {
+		return &aggregatedHealthError{fmt.Sprintf("%d storage policies are unhealthy", unhealthy)}
+	}
+
+	return nil
+}
+
+// checkSlaveNodes fails if any monitored slave node is marked offline by heartbeat check.
+func checkSlaveNodes(ctx context.Context, dep dependency.Dep) error {
+	nodes, err := dep.NodeClient().ListActiveNodes(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	offline := 0
+	for _, n := range nodes {
+		if n.Type != node.TypeSlave {
+			continue
+		}
+
+		if status := cluster.GetNodeStatus(dep.KV(), n.ID); status != nil && !status.Online {
+			offline++
+		}
+	}
+
+	if offline > 0 {
+		return &aggregatedHealthError{fmt.Sprintf("%d slave nodes are offline", offline)}
+	}
+
+	return nil
+}
+
+// aggregatedHealthError is a failed check result that is safe to be exposed.
+type aggregatedHealthError struct {
+	msg string
+}
+
+func (e *aggregatedHealthError) Error() string {
+	return e.msg
+}
+
+// healthCheckError returns the error message exposed in probe response.
+func healthCheckError(err error) string {
+	var aggregated *aggregatedHealthError
+	if errors.As(err, &aggregated) {
+		return aggregated.msg
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "timeout"
+	}
+
+	return "unavailable"
+}
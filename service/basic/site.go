@@ -3,10 +3,13 @@ package basic
 import (
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/onlyoffice"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
 	"github.com/cloudreve/Cloudreve/v4/service/user"
 	"github.com/gin-gonic/gin"
 	"github.com/mojocn/base64Captcha"
+	"github.com/samber/lo"
 )
 
 // SiteConfig 站点全局设置序列
@@ -29,6 +32,7 @@ type SiteConfig struct {
 	CaptchaType      setting.CaptchaType `json:"captcha_type,omitempty"`
 	TurnstileSiteID  string              `json:"turnstile_site_id,omitempty"`
 	RegisterEnabled  bool                `json:"register_enabled,omitempty"`
+	InviteEnabled    bool                `json:"invite_enabled,omitempty"`
 	TosUrl           string              `json:"tos_url,omitempty"`
 	PrivacyPolicyUrl string              `json:"privacy_policy_url,omitempty"`
 
@@ -76,6 +80,7 @@ func (s *GetSettingService) GetSiteConfig(c *gin.Context) (*SiteConfig, error) {
 			ForgetCaptcha:    settings.ForgotPasswordCaptchaEnabled(c),
 			Authn:            settings.AuthnEnabled(c),
 			RegisterEnabled:  settings.RegisterEnabled(c),
+			InviteEnabled:    settings.InvitationEnabled(c),
 			PrivacyPolicyUrl: legalDocs.PrivacyPolicy,
 			TosUrl:           legalDocs.TermsOfService,
 		}, nil
@@ -88,6 +93,9 @@ func (s *GetSettingService) GetSiteConfig(c *gin.Context) (*SiteConfig, error) {
 		for i := range fileViewers {
 			for j := range fileViewers[i].Viewers {
 				fileViewers[i].Viewers[j].WopiActions = nil
+				if _, ok := fileViewers[i].Viewers[j].Props[onlyoffice.PropSecret]; ok {
+					fileViewers[i].Viewers[j].Props = lo.OmitByKeys(fileViewers[i].Viewers[j].Props, []string{onlyoffice.PropSecret})
+				}
 			}
 		}
 		return &SiteConfig{
@@ -121,7 +129,7 @@ func (s *GetSettingService) GetSiteConfig(c *gin.Context) (*SiteConfig, error) {
 	reCaptcha := settings.ReCaptcha(c)
 	appSetting := settings.AppSetting(c)
 
-	return &SiteConfig{
+	res := &SiteConfig{
 		InstanceID:      siteBasic.ID,
 		SiteName:        siteBasic.Name,
 		Themes:          themes.Themes,
@@ -133,7 +141,31 @@ func (s *GetSettingService) GetSiteConfig(c *gin.Context) (*SiteConfig, error) {
 		TurnstileSiteID: settings.TurnstileCaptcha(c).Key,
 		ReCaptchaKey:    reCaptcha.Key,
 		AppPromotion:    appSetting.Promotion,
-	}, nil
+	}
+
+	// Apply branding of the organization current user belongs to
+	if u != nil && !inventory.IsAnonymousUser(u) {
+		if org, err := dep.OrganizationClient().GetByGroup(c, u.GroupUsers); err == nil && org.Branding != nil {
+			applyOrganizationBranding(res, org.Branding)
+		}
+	}
+
+	return res, nil
+}
+
+func applyOrganizationBranding(res *SiteConfig, b *types.OrganizationBranding) {
+	if b.SiteName != "" {
+		res.SiteName = b.SiteName
+	}
+	if b.Logo != "" {
+		res.Logo = b.Logo
+	}
+	if b.LogoLight != "" {
+		res.LogoLight = b.LogoLight
+	}
+	if b.DefaultTheme != "" {
+		res.DefaultTheme = b.DefaultTheme
+	}
 }
 
 const (
@@ -0,0 +1,221 @@
+package explorer
+
+import (
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/automation"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type (
+	AutomationRuleService struct {
+		Name string `json:"name" binding:"required,min=1,max=255"`
+		// Trigger is the file event evaluating the rule, e.g. file.uploaded.
+		Trigger    string                      `json:"trigger" binding:"required"`
+		Conditions *types.AutomationConditions `json:"conditions"`
+		Actions    []types.AutomationAction    `json:"actions" binding:"required"`
+		Enabled    bool                        `json:"enabled"`
+	}
+	AutomationRuleParameterCtx struct{}
+
+	AutomationRule struct {
+		ID              string                      `json:"id"`
+		Name            string                      `json:"name"`
+		Trigger         string                      `json:"trigger"`
+		Conditions      *types.AutomationConditions `json:"conditions,omitempty"`
+		Actions         []types.AutomationAction    `json:"actions"`
+		Enabled         bool                        `json:"enabled"`
+		CreatedAt       time.Time                   `json:"created_at"`
+		LastTriggeredAt *time.Time                  `json:"last_triggered_at,omitempty"`
+		LastError       string                      `json:"last_error,omitempty"`
+	}
+
+	ListAutomationRuleResponse struct {
+		Rules []*AutomationRule `json:"rules"`
+		// Max is the maximum number of rules of current user.
+		Max int `json:"max"`
+	}
+)
+
+func BuildAutomationRule(r *ent.AutomationRule, hasher hashid.Encoder) *AutomationRule {
+	return &AutomationRule{
+		ID:              hashid.EncodeAutomationRuleID(hasher, r.ID),
+		Name:            r.Name,
+		Trigger:         r.Trigger,
+		Conditions:      r.Conditions,
+		Actions:         r.Actions,
+		Enabled:         r.Enabled,
+		CreatedAt:       r.CreatedAt,
+		LastTriggeredAt: r.LastTriggeredAt,
+		LastError:       r.LastError,
+	}
+}
+
+// ListAutomationRules lists automation rules of current user.
+func ListAutomationRules(c *gin.Context) (*ListAutomationRuleResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	rules, err := dep.AutomationRuleClient().List(c, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list automation rules", err)
+	}
+
+	return &ListAutomationRuleResponse{
+		Rules: lo.Map(rules, func(item *ent.AutomationRule, index int) *AutomationRule {
+			return BuildAutomationRule(item, hasher)
+		}),
+		Max: manager.AutomationRuleMax(user),
+	}, nil
+}
+
+// Create creates a new automation rule for current user.
+func (service *AutomationRuleService) Create(c *gin.Context) (*AutomationRule, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	ruleClient := dep.AutomationRuleClient()
+
+	args, err := service.validate(c)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := ruleClient.Count(c, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to count automation rules", err)
+	}
+
+	if count >= manager.AutomationRuleMax(user) {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Too many automation rules", nil)
+	}
+
+	rule, err := ruleClient.Create(c, user.ID, args)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create automation rule", err)
+	}
+
+	return BuildAutomationRule(rule, dep.HashIDEncoder()), nil
+}
+
+// Update updates an existing automation rule.
+func (service *AutomationRuleService) Update(c *gin.Context) (*AutomationRule, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	ruleClient := dep.AutomationRuleClient()
+	id := hashid.FromContext(c)
+
+	existing, err := ruleClient.GetByIDAndUserID(c, id, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Automation rule not exist", err)
+	}
+
+	args, err := service.validate(c)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := ruleClient.Update(c, existing.ID, args)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update automation rule", err)
+	}
+
+	return BuildAutomationRule(rule, dep.HashIDEncoder()), nil
+}
+
+// DeleteAutomationRule deletes an automation rule of current user.
+func DeleteAutomationRule(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	ruleClient := dep.AutomationRuleClient()
+	id := hashid.FromContext(c)
+
+	if _, err := ruleClient.GetByIDAndUserID(c, id, user.ID); err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Automation rule not exist", err)
+	}
+
+	if err := ruleClient.Delete(c, id); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete automation rule", err)
+	}
+
+	return nil
+}
+
+// validate checks the rule definition, returns the normalized arguments.
+func (service *AutomationRuleService) validate(c *gin.Context) (*inventory.AutomationRuleArgs, error) {
+	user := inventory.UserFromContext(c)
+	if manager.AutomationRuleMax(user) <= 0 {
+		return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Group not allowed to use automation", nil)
+	}
+
+	if err := automation.Validate(service.Trigger, service.Conditions, service.Actions); err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid automation rule", err)
+	}
+
+	return &inventory.AutomationRuleArgs{
+		Name:       service.Name,
+		Trigger:    service.Trigger,
+		Conditions: service.Conditions,
+		Actions:    service.Actions,
+		Enabled:    service.Enabled,
+	}, nil
+}
+
+type (
+	DryRunAutomationRuleService struct {
+		AutomationRuleService
+		// Uri of an existing file to test the rule against.
+		Uri string `json:"uri" binding:"required"`
+	}
+	DryRunAutomationRuleParameterCtx struct{}
+
+	DryRunAutomationRuleResponse struct {
+		Matched bool `json:"matched"`
+		// Actions would be applied on the file, empty if not matched.
+		Actions []automation.PlannedAction `json:"actions"`
+	}
+)
+
+// DryRun evaluates the rule against an existing file without applying any action.
+func (service *DryRunAutomationRuleService) DryRun(c *gin.Context) (*DryRunAutomationRuleResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	if _, err := service.validate(c); err != nil {
+		return nil, err
+	}
+
+	uri, err := fs.NewUriFromString(service.Uri)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Unknown uri", err)
+	}
+
+	file, err := m.Get(c, uri)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "File not found", err)
+	}
+
+	res := &DryRunAutomationRuleResponse{Actions: []automation.PlannedAction{}}
+	target := manager.AutomationTarget(file, dep.HashIDEncoder())
+	if file.OwnerID() != user.ID || !automation.Match(service.Conditions, target) {
+		return res, nil
+	}
+
+	res.Matched = true
+	if res.Actions, err = automation.Plan(service.Actions, target, time.Now()); err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid automation rule", err)
+	}
+
+	return res, nil
+}
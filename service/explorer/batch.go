@@ -0,0 +1,184 @@
+package explorer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	BatchOpMove         = "move"
+	BatchOpCopy         = "copy"
+	BatchOpRename       = "rename"
+	BatchOpDelete       = "delete"
+	BatchOpCreateFolder = "create_folder"
+
+	BatchResultSucceeded = "succeeded"
+	BatchResultFailed    = "failed"
+	// BatchResultSkipped is the status of operations not executed, or rolled back, because another one failed.
+	BatchResultSkipped = "skipped"
+)
+
+type (
+	BatchFileParameterCtx struct{}
+	// BatchFileService executes a list of operations in one transaction, either all of them are applied,
+	// or none of them.
+	BatchFileService struct {
+		Operations []BatchFileOperation `json:"operations" binding:"required,min=1,max=1000,dive"`
+	}
+
+	// BatchFileOperation is one operation in a batch. Uris are used by move, copy and delete; Uri is used
+	// by rename and create_folder.
+	BatchFileOperation struct {
+		Type    string   `json:"type" binding:"required,eq=move|eq=copy|eq=rename|eq=delete|eq=create_folder"`
+		Uris    []string `json:"uris"`
+		Uri     string   `json:"uri"`
+		Dst     string   `json:"dst"`
+		NewName string   `json:"new_name" binding:"max=255"`
+	}
+
+	BatchFileResponse struct {
+		// Committed is true if all operations are applied.
+		Committed bool                       `json:"committed"`
+		Results   []BatchFileOperationResult `json:"results"`
+	}
+
+	BatchFileOperationResult struct {
+		Index  int    `json:"index"`
+		Status string `json:"status"`
+		Code   int    `json:"code,omitempty"`
+		Msg    string `json:"msg,omitempty"`
+		// File is the renamed file or created folder.
+		File *FileResponse `json:"file,omitempty"`
+	}
+
+	batchFileOperation struct {
+		typ     string
+		uris    []*fs.URI
+		uri     *fs.URI
+		dst     *fs.URI
+		newName string
+	}
+)
+
+func (s *BatchFileService) GetUris() []string {
+	var uris []string
+	for _, op := range s.Operations {
+		uris = append(uris, op.Uris...)
+		if op.Uri != "" {
+			uris = append(uris, op.Uri)
+		}
+	}
+	return uris
+}
+
+// Execute runs the batch. If any operation fails, the transaction is rolled back and the error of
+// the failed operation is returned along with per-operation results.
+func (s *BatchFileService) Execute(c *gin.Context) (*BatchFileResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	ops := make([]*batchFileOperation, len(s.Operations))
+	for i := range s.Operations {
+		op, err := s.Operations[i].parse()
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, fmt.Sprintf("Invalid operation #%d", i), err)
+		}
+		ops[i] = op
+	}
+
+	res := &BatchFileResponse{Results: make([]BatchFileOperationResult, len(ops))}
+	for i := range res.Results {
+		res.Results[i] = BatchFileOperationResult{Index: i, Status: BatchResultSkipped}
+	}
+
+	files := make([]fs.File, len(ops))
+	failed := -1
+	err := m.Atomic(c, func(ctx context.Context, op manager.FileOperation) error {
+		for i, o := range ops {
+			file, err := o.execute(ctx, op)
+			if err != nil {
+				failed = i
+				return err
+			}
+			files[i] = file
+		}
+		return nil
+	})
+
+	if err != nil {
+		if failed >= 0 {
+			errRes := serializer.Err(c, err)
+			res.Results[failed].Status = BatchResultFailed
+			res.Results[failed].Code = errRes.Code
+			res.Results[failed].Msg = errRes.Msg
+		}
+		return res, err
+	}
+
+	res.Committed = true
+	for i := range res.Results {
+		res.Results[i].Status = BatchResultSucceeded
+		if files[i] != nil {
+			res.Results[i].File = BuildFileResponse(c, user, files[i], dep.HashIDEncoder(), nil)
+		}
+	}
+
+	return res, nil
+}
+
+func (o *BatchFileOperation) parse() (*batchFileOperation, error) {
+	op := &batchFileOperation{typ: o.Type, newName: o.NewName}
+	var err error
+	switch o.Type {
+	case BatchOpMove, BatchOpCopy, BatchOpDelete:
+		if len(o.Uris) == 0 {
+			return nil, fmt.Errorf("uris is required")
+		}
+		if op.uris, err = fs.NewUriFromStrings(o.Uris...); err != nil {
+			return nil, fmt.Errorf("unknown uri: %w", err)
+		}
+		if o.Type == BatchOpDelete {
+			break
+		}
+		if op.dst, err = fs.NewUriFromString(o.Dst); err != nil {
+			return nil, fmt.Errorf("unknown destination uri: %w", err)
+		}
+	case BatchOpRename, BatchOpCreateFolder:
+		if op.uri, err = fs.NewUriFromString(o.Uri); err != nil {
+			return nil, fmt.Errorf("unknown uri: %w", err)
+		}
+		if o.Type == BatchOpRename && o.NewName == "" {
+			return nil, fmt.Errorf("new_name is required")
+		}
+	}
+
+	return op, nil
+}
+
+// execute applies the operation, the renamed file or created folder is returned.
+func (o *batchFileOperation) execute(ctx context.Context, m manager.FileOperation) (fs.File, error) {
+	switch o.typ {
+	case BatchOpMove, BatchOpCopy:
+		return nil, m.MoveOrCopy(ctx, o.uris, o.dst, o.typ == BatchOpCopy)
+	case BatchOpDelete:
+		// Files are always moved to trash bin, deleting physical files cannot be rolled back.
+		return nil, m.Delete(ctx, o.uris)
+	case BatchOpRename:
+		return m.Rename(ctx, o.uri, o.newName)
+	case BatchOpCreateFolder:
+		return m.Create(ctx, o.uri, types.FileTypeFolder, dbfs.WithErrorOnConflict())
+	}
+
+	return nil, fmt.Errorf("unknown operation %q", o.typ)
+}
@@ -5,12 +5,15 @@ import (
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/stats"
 	"github.com/gin-gonic/gin"
 )
 
@@ -44,6 +47,10 @@ func (s *EntityDownloadService) Serve(c *gin.Context) error {
 
 	isDownload := c.Query(routes.IsDownloadQuery) != ""
 	isThumb := c.Query(routes.IsThumbQuery) != ""
+	if !isThumb && stats.CountsAsDownload(c.Request) {
+		dep.StatsRecorder().Downloaded(entitySource.Entity().Size())
+	}
+
 	entitySource.Serve(c.Writer, c.Request,
 		entitysource.WithSpeedLimit(s.SpeedLimit),
 		entitysource.WithDownload(isDownload),
@@ -54,6 +61,38 @@ func (s *EntityDownloadService) Serve(c *gin.Context) error {
 	return nil
 }
 
+type (
+	ListVersionsParamCtx struct{}
+	ListVersionsService  struct {
+		Uri string `form:"uri" binding:"required"`
+	}
+)
+
+// List lists versions of the file. Versions are restored by setting as current version, and downloaded
+// by requesting URL with the version as entity.
+func (s *ListVersionsService) List(c *gin.Context) ([]Version, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	uri, err := fs.NewUriFromString(s.Uri)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	file, err := m.Get(c, uri, dbfs.WithExtendedInfo(), dbfs.WithEntityUser(), dbfs.WithNotRoot())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	if file.Type() != types.FileTypeFile {
+		return nil, serializer.NewError(serializer.CodeParamErr, "only files have versions", nil)
+	}
+
+	return BuildVersions(file, dep.HashIDEncoder()), nil
+}
+
 type (
 	SetCurrentVersionParamCtx struct{}
 	SetCurrentVersionService  struct {
@@ -0,0 +1,356 @@
+package explorer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/eventbus"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// EventStreamProtocol is the WebSocket subprotocol of event streams.
+	EventStreamProtocol = "cloudreve.events"
+	// EventStreamOverflow is sent when events are dropped as the client is not keeping up, clients should
+	// refresh their state afterward.
+	EventStreamOverflow = "stream.overflow"
+
+	maxEventFilters       = 64
+	eventWriteTimeout     = 10 * time.Second
+	eventPingInterval     = 30 * time.Second
+	eventPongTimeout      = 2 * eventPingInterval
+	taskProgressInterval  = 3 * time.Second
+	maxEventClientMessage = 64 << 10
+	// eventSourceRetry is the reconnection delay advised to SSE clients, in milliseconds.
+	eventSourceRetry = 3000
+)
+
+var eventUpgrader = websocket.Upgrader{
+	Subprotocols:    []string{EventStreamProtocol},
+	ReadBufferSize:  1024,
+	WriteBufferSize: 4096,
+}
+
+type (
+	EventStreamParameterCtx struct{}
+	// EventStreamService streams events of current user over WebSocket. Clients can replace the filters
+	// by sending an EventFilterMessage.
+	EventStreamService struct {
+		Uris   []string `form:"uri"`
+		Events []string `form:"event"`
+	}
+
+	EventSourceParameterCtx struct{}
+	// EventSourceService streams events of current user as Server-Sent Events, for clients that cannot use
+	// WebSocket. Filters are fixed for the connection. Events published after the one of LastEventID, or
+	// Last-Event-ID header, are sent first if they are still kept.
+	EventSourceService struct {
+		Uris        []string `form:"uri"`
+		Events      []string `form:"event"`
+		LastEventID string   `form:"last_event_id"`
+	}
+
+	// EventFilterMessage is sent by clients to replace the filters of the stream. File events are only
+	// delivered if any affected file is under one of Uris, only Events are delivered if not empty.
+	EventFilterMessage struct {
+		Uris   []string `json:"uris"`
+		Events []string `json:"events"`
+	}
+
+	eventFilter struct {
+		uid    string
+		uris   []*fs.URI
+		events map[string]bool
+	}
+)
+
+func newEventFilter(uid string, uris, events []string) (*eventFilter, error) {
+	if len(uris) > maxEventFilters || len(events) > maxEventFilters {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Too many filters", nil)
+	}
+
+	f := &eventFilter{uid: uid, events: make(map[string]bool, len(events))}
+	for _, raw := range uris {
+		uri, err := fs.NewUriFromString(raw)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Unknown uri", err)
+		}
+		f.uris = append(f.uris, uri)
+	}
+
+	for _, event := range events {
+		f.events[event] = true
+	}
+
+	return f, nil
+}
+
+func (f *eventFilter) match(e *eventbus.UserEvent) bool {
+	if len(f.events) > 0 && !f.events[e.Type] {
+		return false
+	}
+
+	if len(f.uris) == 0 || len(e.Uris) == 0 {
+		return true
+	}
+
+	for _, raw := range e.Uris {
+		uri, err := fs.NewUriFromString(raw)
+		if err != nil {
+			continue
+		}
+
+		for _, prefix := range f.uris {
+			if uri.EqualOrIsDescendantOf(prefix, f.uid) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Stream upgrades the request to WebSocket and writes events until the connection is closed.
+func (s *EventStreamService) Stream(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	hasher := dep.HashIDEncoder()
+	uid := hashid.EncodeUserID(hasher, user.ID)
+
+	filter, err := newEventFilter(uid, s.Uris, s.Events)
+	if err != nil {
+		return err
+	}
+
+	sub, err := dep.EventHub().Subscribe(user.ID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeTooManyRequests, "Too many event streams", err)
+	}
+	defer sub.Close()
+
+	conn, err := eventUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// Upgrader has responded with the error.
+		dep.Logger().Debug("Failed to upgrade event stream: %s", err)
+		return nil
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	filters := make(chan *eventFilter)
+	go readEventFilters(ctx, cancel, conn, uid, filters)
+
+	ping := time.NewTicker(eventPingInterval)
+	defer ping.Stop()
+	progress := time.NewTicker(taskProgressInterval)
+	defer progress.Stop()
+
+	for {
+		var events []*eventbus.UserEvent
+		select {
+		case <-ctx.Done():
+			return nil
+		case filter = <-filters:
+			continue
+		case e, ok := <-sub.C:
+			if !ok {
+				return nil
+			}
+			events = append(events, e)
+		case <-progress.C:
+			events = taskProgressEvents(ctx, dep, user.ID, hasher)
+		case <-ping.C:
+			_ = conn.SetWriteDeadline(time.Now().Add(eventWriteTimeout))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return nil
+			}
+			continue
+		}
+
+		if sub.Dropped() {
+			events = append([]*eventbus.UserEvent{eventbus.NewUserEvent(ctx, EventStreamOverflow, nil)}, events...)
+		}
+
+		for _, e := range events {
+			if e.Type != EventStreamOverflow && !filter.match(e) {
+				continue
+			}
+
+			_ = conn.SetWriteDeadline(time.Now().Add(eventWriteTimeout))
+			if err := conn.WriteJSON(e); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// Stream writes events as Server-Sent Events until the client disconnects. Task progress and overflow
+// events carry no ID, as they cannot be resumed.
+func (s *EventSourceService) Stream(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	hasher := dep.HashIDEncoder()
+	uid := hashid.EncodeUserID(hasher, user.ID)
+
+	filter, err := newEventFilter(uid, s.Uris, s.Events)
+	if err != nil {
+		return err
+	}
+
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = s.LastEventID
+	}
+
+	var (
+		sub    *eventbus.Subscription
+		missed []*eventbus.UserEvent
+		found  = true
+	)
+	if lastEventID != "" {
+		sub, missed, found, err = dep.EventHub().Resume(user.ID, lastEventID)
+	} else {
+		sub, err = dep.EventHub().Subscribe(user.ID)
+	}
+	if err != nil {
+		return serializer.NewError(serializer.CodeTooManyRequests, "Too many event streams", err)
+	}
+	defer sub.Close()
+
+	WriteEventSourceHeader(c)
+	c.Status(http.StatusOK)
+	if _, err := fmt.Fprintf(c.Writer, "retry: %d\n\n", eventSourceRetry); err != nil {
+		return nil
+	}
+
+	ctx := c.Request.Context()
+	if !found {
+		missed = append([]*eventbus.UserEvent{eventbus.NewUserEvent(ctx, EventStreamOverflow, nil)}, missed...)
+	}
+
+	ping := time.NewTicker(eventPingInterval)
+	defer ping.Stop()
+	progress := time.NewTicker(taskProgressInterval)
+	defer progress.Stop()
+
+	events, resumable := missed, true
+	for {
+		if sub.Dropped() {
+			events = append([]*eventbus.UserEvent{eventbus.NewUserEvent(ctx, EventStreamOverflow, nil)}, events...)
+		}
+
+		for _, e := range events {
+			if e.Type != EventStreamOverflow && !filter.match(e) {
+				continue
+			}
+
+			if err := writeEventSource(c.Writer, e, resumable && e.Type != EventStreamOverflow); err != nil {
+				return nil
+			}
+		}
+		c.Writer.Flush()
+
+		events, resumable = nil, true
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-sub.C:
+			if !ok {
+				return nil
+			}
+			events = append(events, e)
+		case <-progress.C:
+			events, resumable = taskProgressEvents(ctx, dep, user.ID, hasher), false
+		case <-ping.C:
+			if _, err := fmt.Fprint(c.Writer, ": ping\n\n"); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// writeEventSource writes e as a Server-Sent Event, the event ID is included if withID is true.
+func writeEventSource(w gin.ResponseWriter, e *eventbus.UserEvent, withID bool) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	if withID {
+		if _, err := fmt.Fprintf(w, "id: %s\n", e.ID); err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(w, "data: %s\n\n", data)
+	return err
+}
+
+// readEventFilters reads filter updates from the client, the stream is closed on any read error.
+func readEventFilters(ctx context.Context, cancel context.CancelFunc, conn *websocket.Conn, uid string, filters chan<- *eventFilter) {
+	defer cancel()
+
+	conn.SetReadLimit(maxEventClientMessage)
+	_ = conn.SetReadDeadline(time.Now().Add(eventPongTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(eventPongTimeout))
+	})
+
+	for {
+		msg := &EventFilterMessage{}
+		if err := conn.ReadJSON(msg); err != nil {
+			return
+		}
+
+		filter, err := newEventFilter(uid, msg.Uris, msg.Events)
+		if err != nil {
+			_ = conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.ClosePolicyViolation, err.Error()),
+				time.Now().Add(eventWriteTimeout))
+			return
+		}
+
+		select {
+		case filters <- filter:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// taskProgressEvents reports progress of running tasks owned by given user and executed by current instance.
+func taskProgressEvents(ctx context.Context, dep dependency.Dep, uid int, hasher hashid.Encoder) []*eventbus.UserEvent {
+	var events []*eventbus.UserEvent
+	for _, t := range dep.TaskRegistry().List() {
+		owner := t.Owner()
+		if owner == nil || owner.ID != uid || t.Status() != task.StatusProcessing {
+			continue
+		}
+
+		progress := t.Progress(ctx)
+		if len(progress) == 0 {
+			continue
+		}
+
+		events = append(events, eventbus.NewUserEvent(ctx, eventbus.EventTaskProgress, &eventbus.TaskData{
+			ID:       hashid.EncodeTaskID(hasher, t.ID()),
+			Type:     t.Type(),
+			Status:   string(t.Status()),
+			Progress: progress,
+		}))
+	}
+
+	return events
+}
@@ -3,8 +3,11 @@ package explorer
 import (
 	"context"
 	"encoding/gob"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
@@ -14,12 +17,17 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/lock"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/cloudreve/Cloudreve/v4/pkg/stats"
+	"github.com/cloudreve/Cloudreve/v4/pkg/subtitle"
+	"github.com/cloudreve/Cloudreve/v4/pkg/textpreview"
+	"github.com/cloudreve/Cloudreve/v4/pkg/thumb"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
@@ -222,6 +230,57 @@ func (service *CreateFileService) Create(c *gin.Context) (*FileResponse, error)
 	return BuildFileResponse(c, user, file, dep.HashIDEncoder(), nil), nil
 }
 
+type (
+	CreateLinkParameterCtx struct{}
+	CreateLinkService      struct {
+		Uri     string `json:"uri" binding:"required"`
+		Url     string `json:"url" binding:"required"`
+		Favicon string `json:"favicon"`
+	}
+)
+
+// Create creates a web link file, ".url" is appended to the name if missing.
+func (service *CreateLinkService) Create(c *gin.Context) (*FileResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	uri, err := fs.NewUriFromString(service.Uri)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	file, err := m.CreateLink(c, uri, service.Url, service.Favicon)
+	if err != nil {
+		return nil, err
+	}
+
+	return BuildFileResponse(c, user, file, dep.HashIDEncoder(), nil), nil
+}
+
+// LinkTarget returns the target URL of the web link file in current signed link page request.
+func LinkTarget(c *gin.Context) (string, error) {
+	dep := dependency.FromContext(c)
+	ctx := context.WithValue(c, inventory.LoadFilePublicMetadata{}, true)
+	file, err := dep.FileClient().GetByID(ctx, hashid.FromContext(c))
+	if err != nil || file.Type != int(types.FileTypeFile) || file.PrimaryEntity != 0 {
+		return "", serializer.NewError(serializer.CodeNotFound, "Link not found", err)
+	}
+
+	for _, meta := range file.Edges.Metadata {
+		if meta.Name == dbfs.MetadataLinkUrl {
+			if err := manager.ValidateLinkUrl(meta.Value); err != nil {
+				return "", serializer.NewError(serializer.CodeNotFound, "Link not found", err)
+			}
+
+			return meta.Value, nil
+		}
+	}
+
+	return "", serializer.NewError(serializer.CodeNotFound, "Link not found", nil)
+}
+
 type (
 	RenameFileParameterCtx struct{}
 	RenameFileService      struct {
@@ -334,6 +393,89 @@ func (service *FileUpdateService) PutContent(c *gin.Context, ls fs.LockSession)
 	return BuildFileResponse(c, user, res, dep.HashIDEncoder(), nil), nil
 }
 
+type (
+	FilePatchParameterCtx struct{}
+	// FilePatchService overwrites a byte range of a file. The range is given by Content-Range header in the
+	// form of "bytes start-end/*", or by Offset if the header is absent.
+	FilePatchService struct {
+		Uri      string `form:"uri" binding:"required"`
+		Previous string `form:"previous"`
+		Offset   *int64 `form:"offset" binding:"omitempty,min=0"`
+	}
+)
+
+func (service *FilePatchService) PatchContent(c *gin.Context) (*FileResponse, error) {
+	dep := dependency.FromContext(c)
+	settings := dep.SettingProvider()
+	rc, size, err := request.SniffContentLength(c.Request)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "invalid content length", err)
+	}
+
+	if size > settings.MaxOnlineEditSize(c) {
+		return nil, fs.ErrFileSizeTooBig
+	}
+
+	var offset int64
+	if header := c.GetHeader("Content-Range"); header != "" {
+		start, end, err := parseContentRange(header)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "invalid Content-Range header", err)
+		}
+
+		if end-start+1 != size {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Content-Range does not match content length", nil)
+		}
+		offset = start
+	} else if service.Offset != nil {
+		offset = *service.Offset
+	} else {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Content-Range header or offset is required", nil)
+	}
+
+	uri, err := fs.NewUriFromString(service.Uri)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	res, err := m.PatchContent(c, uri, offset, size, rc, service.Previous)
+	if err != nil {
+		return nil, fmt.Errorf("failed to patch file: %w", err)
+	}
+
+	return BuildFileResponse(c, user, res, dep.HashIDEncoder(), nil), nil
+}
+
+// parseContentRange parses Content-Range header in the form of "bytes start-end/total", total is ignored.
+func parseContentRange(header string) (int64, int64, error) {
+	rangeSpec, ok := strings.CutPrefix(header, "bytes ")
+	if !ok {
+		return 0, 0, fmt.Errorf("unsupported range unit")
+	}
+
+	rangeSpec, _, _ = strings.Cut(rangeSpec, "/")
+	startStr, endStr, ok := strings.Cut(rangeSpec, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("malformed range %q", rangeSpec)
+	}
+
+	start, err := strconv.ParseInt(startStr, 10, 64)
+	if err != nil || start < 0 {
+		return 0, 0, fmt.Errorf("invalid range start %q", startStr)
+	}
+
+	end, err := strconv.ParseInt(endStr, 10, 64)
+	if err != nil || end < start {
+		return 0, 0, fmt.Errorf("invalid range end %q", endStr)
+	}
+
+	return start, end, nil
+}
+
 type (
 	FileURLParameterCtx struct{}
 	FileURLService      struct {
@@ -345,10 +487,12 @@ type (
 		SkipError         bool     `json:"skip_error"`
 		Archive           bool     `json:"archive"`
 		NoCache           bool     `json:"no_cache"`
+		Subtitles         bool     `json:"subtitles"` // Only works if Uris count is 1.
 	}
 	FileURLResponse struct {
-		Urls    []manager.EntityUrl `json:"urls"`
-		Expires *time.Time          `json:"expires"`
+		Urls      []manager.EntityUrl     `json:"urls"`
+		Expires   *time.Time              `json:"expires"`
+		Subtitles []manager.SubtitleTrack `json:"subtitles,omitempty"`
 	}
 	ArchiveDownloadSession struct {
 		Uris        []*fs.URI `json:"uris"`
@@ -457,9 +601,18 @@ func (s *FileURLService) Get(c *gin.Context) (*FileURLResponse, error) {
 		return nil, nil
 	}
 
+	var subtitles []manager.SubtitleTrack
+	if s.Subtitles && len(uris) == 1 {
+		subtitles, err = m.Subtitles(c, uris[0])
+		if err != nil {
+			dep.Logger().Warning("Failed to list subtitles of %q: %s", uris[0], err)
+		}
+	}
+
 	return &FileURLResponse{
-		Urls:    res,
-		Expires: earliestExpire,
+		Urls:      res,
+		Expires:   earliestExpire,
+		Subtitles: subtitles,
 	}, nil
 }
 
@@ -504,6 +657,265 @@ func (s *FileThumbService) Get(c *gin.Context) (*FileThumbResponse, error) {
 	}, nil
 }
 
+const (
+	ThumbStatusReady       = "ready"
+	ThumbStatusPending     = "pending"
+	ThumbStatusUnavailable = "unavailable"
+)
+
+type (
+	BatchFileThumbParameterCtx struct{}
+	// BatchFileThumbService gets thumbnails of multiple files. Missing thumbnails are generated in background,
+	// clients should request the pending ones again later.
+	BatchFileThumbService struct {
+		IDs []string `json:"ids" binding:"required,min=1,max=100"`
+	}
+	BatchFileThumbResponse struct {
+		Thumbs []BatchFileThumbItem `json:"thumbs"`
+	}
+	BatchFileThumbItem struct {
+		ID      string     `json:"id"`
+		Status  string     `json:"status"`
+		Url     string     `json:"url,omitempty"`
+		Expires *time.Time `json:"expires,omitempty"`
+		Code    int        `json:"code,omitempty"`
+		Msg     string     `json:"msg,omitempty"`
+	}
+)
+
+// Get returns thumbnail URLs or status of given files, in the same order as IDs.
+func (s *BatchFileThumbService) Get(c *gin.Context) (*BatchFileThumbResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	// Thumbnails are generated after the request is finished.
+	ctx := c.Request.Context()
+	expire := time.Now().Add(dep.SettingProvider().EntityUrlValidDuration(c))
+	res := &BatchFileThumbResponse{Thumbs: make([]BatchFileThumbItem, len(s.IDs))}
+	for i, id := range s.IDs {
+		item := &res.Thumbs[i]
+		item.ID = id
+		item.Status = ThumbStatusUnavailable
+
+		fileId, err := dep.HashIDEncoder().Decode(id, hashid.FileID)
+		if err != nil {
+			item.Code, item.Msg = serializer.CodeParamErr, "unknown file id"
+			continue
+		}
+
+		url, err := batchThumbUrl(ctx, m, fileId, &expire)
+		switch {
+		case err == nil:
+			item.Status, item.Url, item.Expires = ThumbStatusReady, url.Url, url.ExpireAt
+		case errors.Is(err, manager.ErrThumbnailPending):
+			item.Status = ThumbStatusPending
+		default:
+			errRes := serializer.Err(c, err)
+			item.Code, item.Msg = errRes.Code, errRes.Msg
+		}
+	}
+
+	return res, nil
+}
+
+func batchThumbUrl(ctx context.Context, m manager.FileManager, fileId int, expire *time.Time) (*entitysource.EntityUrl, error) {
+	file, err := m.TraverseFile(ctx, fileId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to traverse file: %w", err)
+	}
+
+	thumb, err := m.ThumbnailOrQueue(ctx, file.Uri(true))
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := thumb.Url(ctx, entitysource.WithExpire(expire))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get thumbnail url: %w", err)
+	}
+
+	return url, nil
+}
+
+type (
+	FilePreviewParameterCtx struct{}
+	FilePreviewService      struct {
+		Uri string `form:"uri" binding:"required"`
+		// Page of multi-page documents, starting from 1.
+		Page int `form:"page" binding:"min=0"`
+	}
+)
+
+// Serve serves preview rendition of the file, e.g. embedded JPEG preview of RAW photos, or a page of PDF.
+func (s *FilePreviewService) Serve(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	uri, err := fs.NewUriFromString(s.Uri)
+	if err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	page := s.Page
+	if page == 0 {
+		page = 1
+	}
+
+	rendition, err := m.Preview(c, uri, page)
+	if err != nil {
+		return fmt.Errorf("failed to get preview: %w", err)
+	}
+
+	defer rendition.Close()
+	c.Header("Cache-Control", "private, max-age=3600")
+	http.ServeContent(c.Writer, c.Request, rendition.Name, rendition.ModTime, rendition)
+	return nil
+}
+
+type (
+	FileWaveformParameterCtx struct{}
+	FileWaveformService      struct {
+		Uri string `form:"uri" binding:"required"`
+	}
+)
+
+// Get returns waveform peak data of the audio file.
+func (s *FileWaveformService) Get(c *gin.Context) (*thumb.Waveform, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	uri, err := fs.NewUriFromString(s.Uri)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	res, err := m.Waveform(c, uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get waveform: %w", err)
+	}
+
+	return res, nil
+}
+
+type (
+	FileTextPreviewParameterCtx struct{}
+	FileTextPreviewService      struct {
+		Uri string `form:"uri" binding:"required"`
+	}
+)
+
+// Get returns the beginning of the text file decoded into UTF-8.
+func (s *FileTextPreviewService) Get(c *gin.Context) (*textpreview.Result, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	uri, err := fs.NewUriFromString(s.Uri)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	res, err := m.TextPreview(c, uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get text preview: %w", err)
+	}
+
+	return res, nil
+}
+
+type (
+	FileSpriteParameterCtx struct{}
+	FileSpriteService      struct {
+		Uri string `form:"uri" binding:"required"`
+	}
+)
+
+// Get returns sprite sheet layout of the video file.
+func (s *FileSpriteService) Get(c *gin.Context) (*thumb.SpriteSheet, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	uri, err := fs.NewUriFromString(s.Uri)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	res, err := m.VideoSprite(c, uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sprite sheet: %w", err)
+	}
+
+	return res, nil
+}
+
+// Serve serves sprite sheet image of the video file.
+func (s *FileSpriteService) Serve(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	uri, err := fs.NewUriFromString(s.Uri)
+	if err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	rendition, err := m.VideoSpriteImage(c, uri)
+	if err != nil {
+		return fmt.Errorf("failed to get sprite sheet: %w", err)
+	}
+
+	defer rendition.Close()
+	c.Header("Cache-Control", "private, max-age=3600")
+	http.ServeContent(c.Writer, c.Request, rendition.Name, rendition.ModTime, rendition)
+	return nil
+}
+
+type (
+	FileSubtitleParameterCtx struct{}
+	FileSubtitleService      struct {
+		Uri string `form:"uri" binding:"required"`
+	}
+)
+
+// Serve serves the subtitle file in UTF-8, SRT is converted into WebVTT.
+func (s *FileSubtitleService) Serve(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	uri, err := fs.NewUriFromString(s.Uri)
+	if err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	rendition, err := m.Subtitle(c, uri)
+	if err != nil {
+		return fmt.Errorf("failed to get subtitle: %w", err)
+	}
+
+	defer rendition.Close()
+	contentType := "text/vtt; charset=utf-8"
+	if subtitle.Format(rendition.Name) != subtitle.FormatVtt {
+		contentType = "text/x-ssa; charset=utf-8"
+	}
+
+	c.Header("Content-Type", contentType)
+	c.Header("Cache-Control", "private, no-cache")
+	http.ServeContent(c.Writer, c.Request, rendition.Name, rendition.ModTime, rendition)
+	return nil
+}
+
 type (
 	DeleteFileParameterCtx struct{}
 	DeleteFileService      struct {
@@ -560,6 +972,41 @@ func (s *DeleteFileService) Restore(c *gin.Context) error {
 	return nil
 }
 
+type (
+	RestoreTreeParameterCtx struct{}
+	RestoreTreeService      struct {
+		Uri string `json:"uri" binding:"required"`
+		Dst string `json:"dst"`
+	}
+)
+
+// RestoreTree restores all files deleted from given folder or its descendants in one operation.
+func (s *RestoreTreeService) RestoreTree(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	root, err := fs.NewUriFromString(s.Uri)
+	if err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	var dst *fs.URI
+	if s.Dst != "" {
+		dst, err = fs.NewUriFromString(s.Dst)
+		if err != nil {
+			return serializer.NewError(serializer.CodeParamErr, "unknown destination uri", err)
+		}
+	}
+
+	if err = m.RestoreTree(c, root, dst); err != nil {
+		return fmt.Errorf("failed to restore files: %w", err)
+	}
+
+	return nil
+}
+
 type (
 	UnlockFileParameterCtx struct{}
 	UnlockFileService      struct {
@@ -581,6 +1028,97 @@ func (s *UnlockFileService) Unlock(c *gin.Context) error {
 	return nil
 }
 
+type ListLocksService struct{}
+
+// List lists active locks on files of current user, including check-outs and locks held by WebDAV clients
+// and online office editors.
+func (s *ListLocksService) List(c *gin.Context) ([]Lock, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	locks, err := m.ListLocks(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list locks: %w", err)
+	}
+
+	return BuildLocks(locks), nil
+}
+
+type (
+	CheckoutFileParameterCtx struct{}
+	CheckoutFileService      struct {
+		Uri string `json:"uri" binding:"required"`
+		// ExpireAt is when the file is checked in automatically.
+		ExpireAt time.Time `json:"expire_at" binding:"required"`
+	}
+)
+
+// Checkout locks the file until it is checked in or expires. Before that, the file cannot be overwritten, moved
+// or deleted from web, WebDAV clients or online office editors, unless the lock token is presented.
+func (s *CheckoutFileService) Checkout(c *gin.Context) (*Lock, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	if !s.ExpireAt.After(time.Now()) {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Expire time must be in the future", nil)
+	}
+
+	uri, err := fs.NewUriFromString(s.Uri)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	file, err := m.Get(c, uri, dbfs.WithNotRoot())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	token := uuid.Must(uuid.NewV4()).String()
+	zeroDepth := file.Type() == types.FileTypeFile
+	checkout, err := m.Checkout(c, s.ExpireAt, user, zeroDepth, file.Uri(false), token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check out file: %w", err)
+	}
+
+	return &BuildLocks([]lock.ActiveLock{*checkout})[0], nil
+}
+
+type (
+	CheckinFileParameterCtx struct{}
+	CheckinFileService      struct {
+		Token string `json:"token" binding:"required"`
+	}
+)
+
+// Checkin releases a check-out of current user.
+func (s *CheckinFileService) Checkin(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	locks, err := m.ListLocks(c)
+	if err != nil {
+		return fmt.Errorf("failed to list locks: %w", err)
+	}
+
+	if !lo.ContainsBy(locks, func(l lock.ActiveLock) bool {
+		return l.Token == s.Token && l.Owner.Application.Type == string(fs.ApplicationCheckout)
+	}) {
+		return serializer.NewError(serializer.CodeNotFound, "Check-out not found or expired", nil)
+	}
+
+	if err := m.Unlock(c, s.Token); err != nil {
+		return serializer.NewError(serializer.CodeLockConflict, "failed to check in file", err)
+	}
+
+	return nil
+}
+
 type (
 	GetFileInfoParameterCtx struct{}
 	GetFileInfoService      struct {
@@ -666,6 +1204,10 @@ func RedirectDirectLink(c *gin.Context, name string) error {
 		return err
 	}
 
+	if stats.CountsAsDownload(c.Request) {
+		dep.StatsRecorder().Downloaded(dl.Edges.File.Size)
+	}
+
 	c.Redirect(http.StatusFound, res)
 	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", int(earliestExpire.Sub(time.Now()).Seconds())))
 	return nil
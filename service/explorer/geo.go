@@ -0,0 +1,89 @@
+package explorer
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/gin-gonic/gin"
+)
+
+// photoMapCellsPerTile is the number of cluster cells along one side of a 256px map tile.
+const photoMapCellsPerTile = 4
+
+type (
+	PhotoMapParameterCtx struct{}
+	PhotoMapService      struct {
+		Zoom  int     `form:"zoom" binding:"min=0,max=22"`
+		South float64 `form:"south" binding:"min=-90,max=90"`
+		West  float64 `form:"west" binding:"min=-180,max=180"`
+		North float64 `form:"north" binding:"min=-90,max=90"`
+		East  float64 `form:"east" binding:"min=-180,max=180"`
+	}
+
+	// PhotoCluster is a group of photos taken near each other at given zoom level.
+	PhotoCluster struct {
+		Lat   float64 `json:"lat"`
+		Lng   float64 `json:"lng"`
+		Count int     `json:"count"`
+		// Cover is the ID of the latest uploaded photo in the cluster.
+		Cover string `json:"cover"`
+	}
+)
+
+// Clusters returns locations of user's photos within given bounds, clustered by a grid sized by zoom level.
+func (s *PhotoMapService) Clusters(c *gin.Context) ([]PhotoCluster, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+
+	files, err := dep.FileClient().ListGeoTaggedFiles(c, &inventory.ListGeoTaggedFilesParameters{
+		OwnerID: user.ID,
+		South:   s.South,
+		West:    s.West,
+		North:   s.North,
+		East:    s.East,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list geo tagged files: %w", err)
+	}
+
+	type cellKey struct{ lat, lng int }
+	type cell struct {
+		latSum, lngSum float64
+		count, cover   int
+	}
+
+	cellSize := 360 / math.Pow(2, float64(s.Zoom)) / photoMapCellsPerTile
+	cells := make(map[cellKey]*cell)
+	order := make([]cellKey, 0)
+	for _, f := range files {
+		key := cellKey{int(math.Floor(f.Lat / cellSize)), int(math.Floor(f.Lng / cellSize))}
+		cl, ok := cells[key]
+		if !ok {
+			cl = &cell{}
+			cells[key] = cl
+			order = append(order, key)
+		}
+
+		cl.latSum += f.Lat
+		cl.lngSum += f.Lng
+		cl.count++
+		cl.cover = max(cl.cover, f.FileID)
+	}
+
+	hasher := dep.HashIDEncoder()
+	res := make([]PhotoCluster, 0, len(cells))
+	for _, key := range order {
+		cl := cells[key]
+		res = append(res, PhotoCluster{
+			Lat:   cl.latSum / float64(cl.count),
+			Lng:   cl.lngSum / float64(cl.count),
+			Count: cl.count,
+			Cover: hashid.EncodeFileID(hasher, cl.cover),
+		})
+	}
+
+	return res, nil
+}
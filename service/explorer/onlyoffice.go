@@ -0,0 +1,208 @@
+package explorer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/onlyoffice"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/gin-gonic/gin"
+)
+
+// onlyOfficeCallbackMaxSize limits the size of callback body sent by DocumentServer.
+const onlyOfficeCallbackMaxSize = 1 << 20
+
+type OnlyOfficeService struct {
+}
+
+// onlyOfficeConfig generates the signed editor config of the file opened in viewer session. The document is
+// editable only if user can edit it as in WOPI, and the group has office editing permission.
+func onlyOfficeConfig(c *gin.Context, user *ent.User, uri *fs.URI, action setting.ViewerAction, viewer *setting.Viewer,
+	viewerSession *manager.ViewerSession) (*onlyoffice.Config, error) {
+	dep := dependency.FromContext(c)
+	hasher := dep.HashIDEncoder()
+	file := viewerSession.File
+
+	if err := onlyoffice.ValidateViewer(viewer.Props); err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "OnlyOffice viewer is not configured properly", err)
+	}
+
+	docType := onlyoffice.DocumentType(file.Ext())
+	if docType == "" {
+		return nil, serializer.NewError(serializer.CodeParamErr, "file type is not supported by OnlyOffice", nil)
+	}
+
+	sessionCache, err := viewerSessionCache(dep, viewerSession.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	versionType := types.EntityTypeVersion
+	found, entity := fs.FindDesiredEntity(file, sessionCache.Version, hasher, &versionType)
+	if !found {
+		return nil, fs.ErrEntityNotExist
+	}
+
+	canEdit := action == setting.ViewerActionEdit &&
+		user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionOfficeEdit)) &&
+		file.PrimaryEntityID() == entity.ID() && file.OwnerID() == user.ID && uri.FileSystem() == constants.FileSystemMy
+	mode := onlyoffice.ModeView
+	if canEdit {
+		mode = onlyoffice.ModeEdit
+	}
+
+	base := dep.SettingProvider().SiteURL(setting.UseFirstSiteUrl(c))
+	fileID := hashid.EncodeFileID(hasher, file.ID())
+	config := &onlyoffice.Config{
+		Document: onlyoffice.Document{
+			FileType: strings.ToLower(file.Ext()),
+			Key:      onlyoffice.DocumentKey(fileID, hashid.EncodeEntityID(hasher, entity.ID())),
+			Title:    file.DisplayName(),
+			Url:      routes.MasterOnlyOfficeContentUrl(base, fileID, viewerSession.AccessToken).String(),
+			Permissions: onlyoffice.Permissions{
+				Edit:     canEdit,
+				Download: true,
+				Print:    true,
+				Review:   canEdit,
+				Comment:  canEdit,
+			},
+		},
+		DocumentType: docType,
+		EditorConfig: onlyoffice.EditorConfig{
+			CallbackUrl: routes.MasterOnlyOfficeCallbackUrl(base, fileID, viewerSession.AccessToken).String(),
+			Mode:        mode,
+			User: onlyoffice.User{
+				ID:   hashid.EncodeUserID(hasher, user.ID),
+				Name: user.Nick,
+			},
+		},
+	}
+
+	if err := config.Sign(viewer.Props[onlyoffice.PropSecret]); err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "failed to sign OnlyOffice config", err)
+	}
+
+	return config, nil
+}
+
+func viewerSessionCache(dep dependency.Dep, id string) (*manager.ViewerSessionCache, error) {
+	raw, ok := dep.KV().Get(manager.ViewerSessionCachePrefix + id)
+	if !ok {
+		return nil, serializer.NewError(serializer.CodeNotFound, "viewer session not found", nil)
+	}
+
+	session := raw.(manager.ViewerSessionCache)
+	return &session, nil
+}
+
+// GetFile serves the document content to DocumentServer.
+func (service *OnlyOfficeService) GetFile(c *gin.Context) error {
+	return serveViewerSessionFile(c)
+}
+
+// Callback handles callback of DocumentServer. Edited document is saved as a new version once all users close
+// the editor, or force saved by user. The viewer session is kept alive while the document is being edited.
+func (service *OnlyOfficeService) Callback(c *gin.Context) error {
+	uri, m, user, viewerSession, dep, err := prepareFs(c)
+	if err != nil {
+		return err
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, onlyOfficeCallbackMaxSize))
+	if err != nil {
+		return fmt.Errorf("failed to read callback: %w", err)
+	}
+
+	viewer := manager.ViewerFromContext(c)
+	callback, err := onlyoffice.ParseCallback(body, c.GetHeader("Authorization"), viewer.Props[onlyoffice.PropSecret])
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(callback.Key, hashid.EncodeFileID(dep.HashIDEncoder(), viewerSession.FileID)+"_") {
+		return serializer.NewError(serializer.CodeParamErr, "document key does not match viewer session", nil)
+	}
+
+	switch callback.Status {
+	case onlyoffice.StatusEditing:
+		return dep.KV().Set(manager.ViewerSessionCachePrefix+viewerSession.ID, *viewerSession,
+			dep.SettingProvider().ViewerSessionTTL(c))
+	case onlyoffice.StatusReadyForSave, onlyoffice.StatusForceSave:
+		if !user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionOfficeEdit)) {
+			return serializer.NewError(serializer.CodeNoPermissionErr, "office editing is not allowed", nil)
+		}
+
+		if err := onlyoffice.CheckDownloadURL(callback.Url, viewer.Props[onlyoffice.PropServer]); err != nil {
+			return serializer.NewError(serializer.CodeParamErr, "invalid document URL", err)
+		}
+
+		return saveOnlyOfficeDocument(c, dep, m, uri, callback.Url)
+	}
+
+	return nil
+}
+
+// saveOnlyOfficeDocument downloads the edited document from DocumentServer and saves it as a new version.
+func saveOnlyOfficeDocument(c *gin.Context, dep dependency.Dep, m manager.FileManager, uri *fs.URI, src string) error {
+	if _, err := m.Get(c, uri, dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityUploadFile), dbfs.WithNotRoot()); err != nil {
+		return fmt.Errorf("failed to get file: %w", err)
+	}
+
+	resp := dep.RequestClient().Request(
+		http.MethodGet,
+		src,
+		nil,
+		request.WithContext(c),
+		request.WithLogger(logging.FromContext(c)),
+	).CheckHTTPResponse(http.StatusOK)
+	if resp.Err != nil {
+		return fmt.Errorf("failed to download edited document: %w", resp.Err)
+	}
+
+	defer resp.Response.Body.Close()
+	maxSize := dep.SettingProvider().MaxOnlineEditSize(c)
+	var (
+		content io.Reader = resp.Response.Body
+		size              = resp.Response.ContentLength
+	)
+	if size < 0 {
+		buf, err := io.ReadAll(io.LimitReader(resp.Response.Body, maxSize+1))
+		if err != nil {
+			return fmt.Errorf("failed to download edited document: %w", err)
+		}
+
+		content, size = bytes.NewReader(buf), int64(len(buf))
+	}
+
+	if size > maxSize {
+		return fs.ErrFileSizeTooBig
+	}
+
+	if _, err := m.Update(c, &fs.UploadRequest{
+		Props: &fs.UploadProps{
+			Uri:  uri,
+			Size: size,
+		},
+		File: io.NopCloser(content),
+		Mode: fs.ModeOverwrite,
+	}); err != nil {
+		return fmt.Errorf("failed to save edited document: %w", err)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"sort"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
@@ -16,8 +17,10 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/lock"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/onlyoffice"
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/cloudreve/Cloudreve/v4/service/user"
@@ -31,6 +34,10 @@ type PutRelativeResponse struct {
 	Url  string
 }
 
+type RenameFileResponse struct {
+	Name string
+}
+
 type DirectLinkResponse struct {
 	Link    string `json:"link"`
 	FileUrl string `json:"file_url"`
@@ -186,6 +193,7 @@ type WopiFileInfo struct {
 	UserCanNotWriteRelative bool
 
 	SupportsRename    bool
+	SupportsGetLock   bool
 	SupportsReviewing bool
 	SupportsUpdate    bool
 	SupportsLocks     bool
@@ -196,6 +204,8 @@ type WopiFileInfo struct {
 type ViewerSessionResponse struct {
 	Session *manager.ViewerSession `json:"session"`
 	WopiSrc string                 `json:"wopi_src,omitempty"`
+	// OnlyOfficeConfig is the signed editor config for OnlyOffice viewer.
+	OnlyOfficeConfig *onlyoffice.Config `json:"onlyoffice_config,omitempty"`
 }
 
 type ListResponse struct {
@@ -214,6 +224,24 @@ type ListResponse struct {
 	StoragePolicy         *StoragePolicy `json:"storage_policy,omitempty"`
 }
 
+// ETag returns a weak entity tag of the listing, derived from the parent folder, the number of children
+// and their latest update time. variant distinguishes listings of the same folder, e.g. the query string.
+func (r *ListResponse) ETag(variant string) string {
+	var latest time.Time
+	for i := range r.Files {
+		if r.Files[i].UpdatedAt.After(latest) {
+			latest = r.Files[i].UpdatedAt
+		}
+	}
+
+	parts := []any{variant, r.Parent.ID, r.Parent.UpdatedAt.UnixNano(), len(r.Files), latest.UnixNano()}
+	if r.Pagination != nil {
+		parts = append(parts, r.Pagination.Page, r.Pagination.TotalItems, r.Pagination.NextPageToken)
+	}
+
+	return util.WeakETag(parts...)
+}
+
 type FileResponse struct {
 	Type          int                 `json:"type"`
 	ID            string              `json:"id"`
@@ -232,6 +260,16 @@ type FileResponse struct {
 	ExtendedInfo  *ExtendedInfo     `json:"extended_info,omitempty"`
 }
 
+// ETag returns a weak entity tag of the file info.
+func (r *FileResponse) ETag() string {
+	content, err := json.Marshal(r)
+	if err != nil {
+		return ""
+	}
+
+	return util.WeakETag(string(content))
+}
+
 type ExtendedInfo struct {
 	StoragePolicy *StoragePolicy `json:"storage_policy,omitempty"`
 	StorageUsed   int64          `json:"storage_used"`
@@ -257,6 +295,25 @@ type Entity struct {
 	CreatedBy     *user.User       `json:"created_by,omitempty"`
 }
 
+type Version struct {
+	Entity
+	// SizeDiff is the size change compared with the previous version.
+	SizeDiff int64 `json:"size_diff"`
+	Current  bool  `json:"current"`
+	// ExpireAt is when the version is pruned by max version age of its storage policy.
+	ExpireAt *time.Time `json:"expire_at,omitempty"`
+}
+
+type Lock struct {
+	Uri      string         `json:"uri"`
+	Token    string         `json:"token"`
+	Type     types.FileType `json:"type"`
+	Owner    lock.Owner     `json:"owner"`
+	Shared   bool           `json:"shared,omitempty"`
+	Depth    string         `json:"depth"`
+	ExpireAt *time.Time     `json:"expire_at,omitempty"`
+}
+
 type Share struct {
 	ID              string          `json:"id"`
 	Name            string          `json:"name,omitempty"`
@@ -274,6 +331,9 @@ type Share struct {
 	// Only viewable by owner
 	IsPrivate bool   `json:"is_private,omitempty"`
 	Password  string `json:"password,omitempty"`
+	// Quarantined is set if the share is disabled by content moderation.
+	Quarantined bool     `json:"quarantined,omitempty"`
+	Recipients  []string `json:"recipients,omitempty"`
 
 	// Only viewable if explicitly unlocked by owner
 	SourceUri string `json:"source_uri,omitempty"`
@@ -306,6 +366,8 @@ func BuildShare(s *ent.Share, base *url.URL, hasher hashid.Encoder, requester *e
 
 	if requester.ID == owner.ID {
 		res.IsPrivate = s.Password != ""
+		res.Quarantined = s.Quarantined
+		res.Recipients = s.Recipients
 	}
 
 	return &res
@@ -405,6 +467,61 @@ func BuildEntity(extendedInfo *fs.FileExtendedInfo, e fs.Entity, hasher hashid.E
 	}
 }
 
+// BuildLocks builds active locks on files of current user, sorted by path.
+func BuildLocks(locks []lock.ActiveLock) []Lock {
+	root, _ := fs.NewUriFromString(fs.NewMyUri(""))
+	sort.Slice(locks, func(i, j int) bool {
+		return locks[i].Root < locks[j].Root
+	})
+
+	return lo.Map(locks, func(l lock.ActiveLock, index int) Lock {
+		depth := "infinity"
+		if l.ZeroDepth {
+			depth = "0"
+		}
+
+		return Lock{
+			Uri:      root.JoinRaw(l.Root).String(),
+			Token:    l.Token,
+			Type:     l.Type,
+			Owner:    l.Owner,
+			Shared:   l.Shared,
+			Depth:    depth,
+			ExpireAt: l.ExpireAt,
+		}
+	})
+}
+
+// BuildVersions builds versions of file from the latest one, excluding the one being uploaded.
+func BuildVersions(f fs.File, hasher hashid.Encoder) []Version {
+	extendedInfo := f.ExtendedInfo()
+	versions := lo.Filter(f.Entities(), func(e fs.Entity, index int) bool {
+		return e.Type() == types.EntityTypeVersion && e.UploadSessionID() == nil
+	})
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].CreatedAt().After(versions[j].CreatedAt())
+	})
+
+	return lo.Map(versions, func(e fs.Entity, index int) Version {
+		v := Version{
+			Entity:   BuildEntity(extendedInfo, e, hasher),
+			SizeDiff: e.Size(),
+			Current:  e.ID() == f.PrimaryEntityID(),
+		}
+		if index+1 < len(versions) {
+			v.SizeDiff -= versions[index+1].Size()
+		}
+
+		policy := extendedInfo.EntityStoragePolicies[e.PolicyID()]
+		if !v.Current && policy != nil && policy.Settings != nil && policy.Settings.VersionMaxAge > 0 {
+			expireAt := e.CreatedAt().Add(time.Duration(policy.Settings.VersionMaxAge) * time.Second)
+			v.ExpireAt = &expireAt
+		}
+
+		return v
+	})
+}
+
 func BuildShareLink(s *ent.Share, hasher hashid.Encoder, base *url.URL) string {
 	shareId := hashid.EncodeShareID(hasher, s.ID)
 	return routes.MasterShareUrl(base, shareId, s.Password).String()
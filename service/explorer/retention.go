@@ -0,0 +1,221 @@
+package explorer
+
+import (
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/retentionrule"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/workflows"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/retention"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// retentionPreviewLimit is the maximum number of files listed in a retention preview.
+const retentionPreviewLimit = 500
+
+type (
+	RetentionRuleService struct {
+		// Folder is the URI of the folder the rule is attached to.
+		Folder    string `json:"folder" binding:"required"`
+		Recursive bool   `json:"recursive"`
+		// Action applied on expired files, trash or delete.
+		Action       string `json:"action" binding:"omitempty,oneof=trash delete"`
+		MaxAgeDays   int    `json:"max_age_days"`
+		KeepVersions int    `json:"keep_versions"`
+		Enabled      bool   `json:"enabled"`
+	}
+	RetentionRuleParameterCtx        struct{}
+	PreviewRetentionRuleParameterCtx struct{}
+
+	RetentionRule struct {
+		ID           string                 `json:"id"`
+		Folder       string                 `json:"folder"`
+		Recursive    bool                   `json:"recursive"`
+		Action       string                 `json:"action"`
+		MaxAgeDays   int                    `json:"max_age_days"`
+		KeepVersions int                    `json:"keep_versions"`
+		Enabled      bool                   `json:"enabled"`
+		CreatedAt    time.Time              `json:"created_at"`
+		LastRunAt    *time.Time             `json:"last_run_at,omitempty"`
+		LastResult   *types.RetentionResult `json:"last_result,omitempty"`
+	}
+
+	ListRetentionRuleResponse struct {
+		Rules []*RetentionRule `json:"rules"`
+		// Max is the maximum number of rules of each user.
+		Max int `json:"max"`
+	}
+)
+
+func BuildRetentionRule(r *ent.RetentionRule, hasher hashid.Encoder) *RetentionRule {
+	return &RetentionRule{
+		ID:           hashid.EncodeRetentionRuleID(hasher, r.ID),
+		Folder:       r.Folder,
+		Recursive:    r.Recursive,
+		Action:       string(r.Action),
+		MaxAgeDays:   r.MaxAgeDays,
+		KeepVersions: r.KeepVersions,
+		Enabled:      r.Enabled,
+		CreatedAt:    r.CreatedAt,
+		LastRunAt:    r.LastRunAt,
+		LastResult:   r.LastResult,
+	}
+}
+
+// ListRetentionRules lists retention rules of current user.
+func ListRetentionRules(c *gin.Context) (*ListRetentionRuleResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	rules, err := dep.RetentionRuleClient().List(c, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list retention rules", err)
+	}
+
+	return &ListRetentionRuleResponse{
+		Rules: lo.Map(rules, func(item *ent.RetentionRule, index int) *RetentionRule {
+			return BuildRetentionRule(item, hasher)
+		}),
+		Max: dep.SettingProvider().RetentionRuleMax(c),
+	}, nil
+}
+
+// Create creates a new retention rule for current user.
+func (service *RetentionRuleService) Create(c *gin.Context) (*RetentionRule, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	ruleClient := dep.RetentionRuleClient()
+
+	args, err := service.validate(c)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := ruleClient.Count(c, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to count retention rules", err)
+	}
+
+	if count >= dep.SettingProvider().RetentionRuleMax(c) {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Too many retention rules", nil)
+	}
+
+	rule, err := ruleClient.Create(c, user.ID, args)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create retention rule", err)
+	}
+
+	return BuildRetentionRule(rule, dep.HashIDEncoder()), nil
+}
+
+// Update updates an existing retention rule.
+func (service *RetentionRuleService) Update(c *gin.Context) (*RetentionRule, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	ruleClient := dep.RetentionRuleClient()
+	id := hashid.FromContext(c)
+
+	existing, err := ruleClient.GetByIDAndUserID(c, id, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Retention rule not exist", err)
+	}
+
+	args, err := service.validate(c)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := ruleClient.Update(c, existing.ID, args)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update retention rule", err)
+	}
+
+	return BuildRetentionRule(rule, dep.HashIDEncoder()), nil
+}
+
+// Preview lists files that would be affected if the rule is executed now, nothing is changed.
+func (service *RetentionRuleService) Preview(c *gin.Context) (*workflows.RetentionPreview, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	args, err := service.validate(c)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := workflows.PreviewRetention(c, m, args, time.Now(), retentionPreviewLimit)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeListFilesError, "Failed to preview retention rule", err)
+	}
+
+	return res, nil
+}
+
+// DeleteRetentionRule deletes a retention rule of current user.
+func DeleteRetentionRule(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	ruleClient := dep.RetentionRuleClient()
+	id := hashid.FromContext(c)
+
+	if _, err := ruleClient.GetByIDAndUserID(c, id, user.ID); err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Retention rule not exist", err)
+	}
+
+	if err := ruleClient.Delete(c, id); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete retention rule", err)
+	}
+
+	return nil
+}
+
+// validate checks the folder and cleanup settings, returns the normalized arguments.
+func (service *RetentionRuleService) validate(c *gin.Context) (*inventory.RetentionRuleArgs, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	if err := retention.Validate(service.Folder, service.MaxAgeDays, service.KeepVersions); err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid retention rule", err)
+	}
+
+	uri, err := fs.NewUriFromString(service.Folder)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid folder", err)
+	}
+
+	folder, err := m.Get(c, uri)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid folder", err)
+	}
+
+	if folder.Type() != types.FileTypeFolder || folder.OwnerID() != user.ID {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Rule must be attached to a folder of your own", nil)
+	}
+
+	action := retentionrule.ActionTrash
+	if service.Action != "" {
+		action = retentionrule.Action(service.Action)
+	}
+
+	return &inventory.RetentionRuleArgs{
+		Folder:       uri.String(),
+		Recursive:    service.Recursive,
+		Action:       action,
+		MaxAgeDays:   service.MaxAgeDays,
+		KeepVersions: service.KeepVersions,
+		Enabled:      service.Enabled,
+	}, nil
+}
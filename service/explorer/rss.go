@@ -0,0 +1,187 @@
+package explorer
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/workflows"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type (
+	RssSubscriptionService struct {
+		Name string `json:"name" binding:"required,min=1,max=255"`
+		Url  string `json:"url" binding:"required,max=65535"`
+		// Include and Exclude are regular expressions matched against titles of feed items.
+		Include string `json:"include" binding:"max=1024"`
+		Exclude string `json:"exclude" binding:"max=1024"`
+		// Dst is the URI of folder to save downloaded files.
+		Dst     string `json:"dst" binding:"required,max=65535"`
+		Enabled bool   `json:"enabled"`
+	}
+	RssSubscriptionParameterCtx struct{}
+
+	RssSubscription struct {
+		ID            string     `json:"id"`
+		Name          string     `json:"name"`
+		Url           string     `json:"url"`
+		Include       string     `json:"include,omitempty"`
+		Exclude       string     `json:"exclude,omitempty"`
+		Dst           string     `json:"dst"`
+		Enabled       bool       `json:"enabled"`
+		CreatedAt     time.Time  `json:"created_at"`
+		LastCheckedAt *time.Time `json:"last_checked_at,omitempty"`
+		LastError     string     `json:"last_error,omitempty"`
+	}
+)
+
+func BuildRssSubscription(s *ent.RssSubscription, hasher hashid.Encoder) *RssSubscription {
+	return &RssSubscription{
+		ID:            hashid.EncodeRssSubscriptionID(hasher, s.ID),
+		Name:          s.Name,
+		Url:           s.URL,
+		Include:       s.Include,
+		Exclude:       s.Exclude,
+		Dst:           s.Dst,
+		Enabled:       s.Enabled,
+		CreatedAt:     s.CreatedAt,
+		LastCheckedAt: s.LastCheckedAt,
+		LastError:     s.LastError,
+	}
+}
+
+// ListRssSubscriptions lists RSS subscriptions of current user.
+func ListRssSubscriptions(c *gin.Context) ([]*RssSubscription, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	subs, err := dep.RssSubscriptionClient().List(c, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list RSS subscriptions", err)
+	}
+
+	return lo.Map(subs, func(item *ent.RssSubscription, index int) *RssSubscription {
+		return BuildRssSubscription(item, hasher)
+	}), nil
+}
+
+// Create subscribes a new feed for current user.
+func (service *RssSubscriptionService) Create(c *gin.Context) (*RssSubscription, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	rssClient := dep.RssSubscriptionClient()
+
+	args, err := service.validate(c)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := rssClient.Count(c, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to count RSS subscriptions", err)
+	}
+
+	if count >= dep.SettingProvider().RssSubscriptionMax(c) {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Too many RSS subscriptions", nil)
+	}
+
+	sub, err := rssClient.Create(c, user.ID, args)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create RSS subscription", err)
+	}
+
+	return BuildRssSubscription(sub, dep.HashIDEncoder()), nil
+}
+
+// Update updates an existing RSS subscription.
+func (service *RssSubscriptionService) Update(c *gin.Context) (*RssSubscription, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	rssClient := dep.RssSubscriptionClient()
+	id := hashid.FromContext(c)
+
+	existing, err := rssClient.GetByIDAndUserID(c, id, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "RSS subscription not exist", err)
+	}
+
+	args, err := service.validate(c)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := rssClient.Update(c, existing.ID, args)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update RSS subscription", err)
+	}
+
+	return BuildRssSubscription(sub, dep.HashIDEncoder()), nil
+}
+
+// DeleteRssSubscription deletes an RSS subscription of current user.
+func DeleteRssSubscription(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	rssClient := dep.RssSubscriptionClient()
+	id := hashid.FromContext(c)
+
+	if _, err := rssClient.GetByIDAndUserID(c, id, user.ID); err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "RSS subscription not exist", err)
+	}
+
+	if err := rssClient.Delete(c, id); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete RSS subscription", err)
+	}
+
+	return nil
+}
+
+// validate checks the feed URL, filters and destination folder, returns the normalized arguments.
+func (service *RssSubscriptionService) validate(c *gin.Context) (*inventory.RssSubscriptionArgs, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	if !user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionRemoteDownload)) {
+		return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Group not allowed to download files", nil)
+	}
+
+	feed, err := url.Parse(service.Url)
+	if err != nil || (feed.Scheme != "http" && feed.Scheme != "https") || feed.Host == "" {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid feed URL", err)
+	}
+
+	if _, _, err := workflows.CompileRssFilters(service.Include, service.Exclude); err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid filter", err)
+	}
+
+	dst, err := fs.NewUriFromString(service.Dst)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid destination", err)
+	}
+
+	if _, err = m.Get(c, dst, dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityCreateFile)); err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid destination", err)
+	}
+
+	return &inventory.RssSubscriptionArgs{
+		Name:    service.Name,
+		Url:     feed.String(),
+		Include: service.Include,
+		Exclude: service.Exclude,
+		Dst:     dst.String(),
+		Enabled: service.Enabled,
+	}, nil
+}
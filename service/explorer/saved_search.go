@@ -0,0 +1,157 @@
+package explorer
+
+import (
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type (
+	SavedSearchService struct {
+		Name string `json:"name" binding:"required,min=1,max=255"`
+		// Uri is a file URI with search parameters, e.g. cloudreve://my/docs?name=*.pdf
+		Uri string `json:"uri" binding:"required,max=65535"`
+		// Notify enables notification when new files match the search.
+		Notify bool `json:"notify"`
+	}
+	SavedSearchParameterCtx struct{}
+
+	SavedSearch struct {
+		ID            string     `json:"id"`
+		Name          string     `json:"name"`
+		Uri           string     `json:"uri"`
+		Notify        bool       `json:"notify"`
+		CreatedAt     time.Time  `json:"created_at"`
+		LastCheckedAt *time.Time `json:"last_checked_at,omitempty"`
+	}
+)
+
+func BuildSavedSearch(s *ent.SavedSearch, hasher hashid.Encoder) *SavedSearch {
+	return &SavedSearch{
+		ID:            hashid.EncodeSavedSearchID(hasher, s.ID),
+		Name:          s.Name,
+		Uri:           s.URI,
+		Notify:        s.Notify,
+		CreatedAt:     s.CreatedAt,
+		LastCheckedAt: s.LastCheckedAt,
+	}
+}
+
+// ListSavedSearches lists saved searches of current user.
+func ListSavedSearches(c *gin.Context) ([]*SavedSearch, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	searches, err := dep.SavedSearchClient().List(c, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list saved searches", err)
+	}
+
+	return lo.Map(searches, func(item *ent.SavedSearch, index int) *SavedSearch {
+		return BuildSavedSearch(item, hasher)
+	}), nil
+}
+
+// Create saves a new search query for current user.
+func (service *SavedSearchService) Create(c *gin.Context) (*SavedSearch, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	savedSearchClient := dep.SavedSearchClient()
+
+	uri, err := validateSavedSearchUri(service.Uri)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := savedSearchClient.Count(c, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to count saved searches", err)
+	}
+
+	if count >= dep.SettingProvider().SavedSearchMax(c) {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Too many saved searches", nil)
+	}
+
+	saved, err := savedSearchClient.Create(c, user.ID, &inventory.SavedSearchArgs{
+		Name:   service.Name,
+		Uri:    uri,
+		Notify: service.Notify,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create saved search", err)
+	}
+
+	return BuildSavedSearch(saved, dep.HashIDEncoder()), nil
+}
+
+// Update updates an existing saved search.
+func (service *SavedSearchService) Update(c *gin.Context) (*SavedSearch, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	savedSearchClient := dep.SavedSearchClient()
+	id := hashid.FromContext(c)
+
+	existing, err := savedSearchClient.GetByIDAndUserID(c, id, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Saved search not exist", err)
+	}
+
+	uri, err := validateSavedSearchUri(service.Uri)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := savedSearchClient.Update(c, existing.ID, &inventory.SavedSearchArgs{
+		Name:   service.Name,
+		Uri:    uri,
+		Notify: service.Notify,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update saved search", err)
+	}
+
+	return BuildSavedSearch(saved, dep.HashIDEncoder()), nil
+}
+
+// DeleteSavedSearch deletes a saved search of current user.
+func DeleteSavedSearch(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	savedSearchClient := dep.SavedSearchClient()
+	id := hashid.FromContext(c)
+
+	if _, err := savedSearchClient.GetByIDAndUserID(c, id, user.ID); err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Saved search not exist", err)
+	}
+
+	if err := savedSearchClient.Delete(c, id); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete saved search", err)
+	}
+
+	return nil
+}
+
+// validateSavedSearchUri checks the URI is a search in "my" or "share" file system, and returns it in
+// normalized form.
+func validateSavedSearchUri(raw string) (string, error) {
+	uri, err := fs.NewUriFromString(raw)
+	if err != nil {
+		return "", serializer.NewError(serializer.CodeParamErr, "Invalid URI", err)
+	}
+
+	if uriFs := uri.FileSystem(); uri.SearchParameters() == nil ||
+		(uriFs != constants.FileSystemMy && uriFs != constants.FileSystemShare) {
+		return "", serializer.NewError(serializer.CodeParamErr, "URI is not a search", nil)
+	}
+
+	return uri.String(), nil
+}
@@ -0,0 +1,192 @@
+package explorer
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/search"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// fullTextSearchDefaultPageSize is the number of hits returned if page size is not specified.
+const fullTextSearchDefaultPageSize = 50
+
+type (
+	FullTextSearchParameterCtx struct{}
+	FullTextSearchService      struct {
+		Query    string `form:"q" binding:"required,max=256"`
+		PageSize int    `form:"page_size" binding:"min=0,max=200"`
+		// NextPageToken is the cursor returned in previous page.
+		NextPageToken string `form:"next_page_token"`
+
+		// Filters, dates are in Unix seconds. Zero values are not applied.
+		Type       string   `form:"type" binding:"omitempty,eq=file|eq=folder"`
+		Category   string   `form:"category"`
+		SizeGte    int64    `form:"size_gte" binding:"min=0"`
+		SizeLte    int64    `form:"size_lte" binding:"min=0"`
+		CreatedGte int64    `form:"created_gte"`
+		CreatedLte int64    `form:"created_lte"`
+		UpdatedGte int64    `form:"updated_gte"`
+		UpdatedLte int64    `form:"updated_lte"`
+		Tags       []string `form:"tag" binding:"max=20"`
+		Policy     string   `form:"policy"`
+		// Owner is the hash ID of user whose files are searched, only allowed for admins.
+		Owner string `form:"owner"`
+	}
+
+	// FullTextSearchHit is a file matched by full-text search.
+	FullTextSearchHit struct {
+		*FileResponse
+		Score float64 `json:"score"`
+		// Highlights are HTML escaped fragments of matched fields, keyed by field name, with matched terms
+		// wrapped in <mark>.
+		Highlights map[string][]string `json:"highlights,omitempty"`
+	}
+
+	FullTextSearchResponse struct {
+		Files []FullTextSearchHit `json:"files"`
+		// Total is the estimated number of matched files, including those no longer accessible.
+		Total uint64 `json:"total"`
+		// TotalEstimated is true if Total is a lower bound of large results.
+		TotalEstimated bool                         `json:"total_estimated"`
+		Pagination     *inventory.PaginationResults `json:"pagination"`
+	}
+
+	// fullTextSearchPageToken is the position of the last hit of a page.
+	fullTextSearchPageToken struct {
+		Score float64 `json:"score"`
+		ID    string  `json:"id"`
+	}
+)
+
+// Search searches files of current user in full-text index.
+func (s *FullTextSearchService) Search(c *gin.Context) (*FullTextSearchResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	pageSize := s.PageSize
+	if pageSize == 0 {
+		pageSize = fullTextSearchDefaultPageSize
+	}
+
+	hasher := dep.HashIDEncoder()
+	after, err := decodeFullTextSearchPageToken(s.NextPageToken, hasher)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "invalid page token", err)
+	}
+
+	q := &search.Query{
+		Text:  s.Query,
+		After: after,
+		Limit: pageSize,
+		Filters: search.Filters{
+			SizeGte:      s.SizeGte,
+			SizeLte:      s.SizeLte,
+			CreatedAtGte: unixTimeOrNil(s.CreatedGte),
+			CreatedAtLte: unixTimeOrNil(s.CreatedLte),
+			UpdatedAtGte: unixTimeOrNil(s.UpdatedGte),
+			UpdatedAtLte: unixTimeOrNil(s.UpdatedLte),
+			Tags:         lo.Compact(s.Tags),
+		},
+	}
+
+	if s.Type != "" {
+		fileType := int(types.FileTypeFromString(s.Type))
+		q.Type = &fileType
+	}
+
+	if s.Policy != "" {
+		policyID, err := hasher.Decode(s.Policy, hashid.PolicyID)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "unknown policy id", err)
+		}
+		q.PolicyID = policyID
+	}
+
+	if s.Owner != "" {
+		ownerID, err := hasher.Decode(s.Owner, hashid.UserID)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "unknown user id", err)
+		}
+		q.OwnerID = ownerID
+	}
+
+	res, err := m.FullTextSearch(c, q, s.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	nextPageToken := ""
+	if res.Next != nil {
+		nextPageToken = encodeFullTextSearchPageToken(res.Next, hasher)
+	}
+
+	return &FullTextSearchResponse{
+		Files: lo.Map(res.Hits, func(hit manager.FullTextSearchHit, index int) FullTextSearchHit {
+			return FullTextSearchHit{
+				FileResponse: BuildFileResponse(c, user, hit.File, hasher, nil),
+				Score:        hit.Score,
+				Highlights:   hit.Highlights,
+			}
+		}),
+		Total:          res.Total,
+		TotalEstimated: res.TotalEstimated,
+		Pagination: &inventory.PaginationResults{
+			PageSize:      pageSize,
+			NextPageToken: nextPageToken,
+			IsCursor:      true,
+		},
+	}, nil
+}
+
+func encodeFullTextSearchPageToken(cursor *search.Cursor, hasher hashid.Encoder) string {
+	res, _ := json.Marshal(fullTextSearchPageToken{
+		Score: cursor.Score,
+		ID:    hashid.EncodeFileID(hasher, cursor.ID),
+	})
+	return base64.StdEncoding.EncodeToString(res)
+}
+
+// decodeFullTextSearchPageToken returns the cursor in page token, nil if token is empty.
+func decodeFullTextSearchPageToken(token string, hasher hashid.Encoder) (*search.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64 for page token: %w", err)
+	}
+
+	var pageToken fullTextSearchPageToken
+	if err := json.Unmarshal(decoded, &pageToken); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal page token: %w", err)
+	}
+
+	id, err := hasher.Decode(pageToken.ID, hashid.FileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode id: %w", err)
+	}
+
+	return &search.Cursor{Score: pageToken.Score, ID: id}, nil
+}
+
+func unixTimeOrNil(sec int64) *time.Time {
+	if sec == 0 {
+		return nil
+	}
+
+	t := time.Unix(sec, 0)
+	return &t
+}
@@ -1,20 +1,33 @@
 package explorer
 
 import (
+	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/edgecache"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/local"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/thumb"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
 	"github.com/samber/lo"
 )
 
@@ -74,6 +87,85 @@ func (s *EntityDownloadService) SlaveServe(c *gin.Context) error {
 	return nil
 }
 
+type (
+	SlaveEdgeParamCtx struct{}
+	// SlaveEdgeService serves entity content through edge cache of slave node
+	SlaveEdgeService struct {
+		Key    string `uri:"key" binding:"required"`
+		Size   int64  `uri:"size" binding:"min=0"`
+		Speed  int64  `uri:"speed" binding:"min=0"`
+		Origin string `uri:"origin" binding:"required"`
+		Name   string `uri:"name" binding:"required"`
+	}
+)
+
+// Serve serves entity content from edge cache, the content is pulled from origin on cache miss. Requests
+// are redirected to origin if edge caching is disabled or the entity cannot fit into the cache.
+func (s *SlaveEdgeService) Serve(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	origin, err := base64.URLEncoding.DecodeString(s.Origin)
+	if err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "Failed to decode origin", err)
+	}
+
+	edgeCache := dep.EdgeCache()
+	if edgeCache == nil {
+		c.Redirect(http.StatusFound, string(origin))
+		return nil
+	}
+
+	cached, err := edgeCache.Open(c, s.Key, s.Size, func(ctx context.Context) (io.ReadCloser, error) {
+		// Keep filling the cache even if the client that triggered it is gone.
+		ctx = context.WithoutCancel(ctx)
+		resp := dep.RequestClient().Request(
+			http.MethodGet,
+			string(origin),
+			nil,
+			request.WithContext(ctx),
+			request.WithLogger(logging.FromContext(ctx)),
+		).CheckHTTPResponse(http.StatusOK)
+		if resp.Err != nil {
+			return nil, resp.Err
+		}
+
+		return resp.Response.Body, nil
+	})
+	if errors.Is(err, edgecache.ErrTooLarge) {
+		c.Redirect(http.StatusFound, string(origin))
+		return nil
+	}
+	if err != nil {
+		return serializer.NewError(serializer.CodeIOFailed, "Failed to pull entity from origin", err)
+	}
+
+	m := manager.NewFileManager(dep, nil)
+	defer m.Recycle()
+
+	entity, err := local.NewLocalFileEntity(types.EntityTypeVersion, cached)
+	if err != nil {
+		return fs.ErrPathNotExist.WithError(err)
+	}
+
+	entitySource, err := m.GetEntitySource(c, 0, fs.WithEntity(entity))
+	if err != nil {
+		return fmt.Errorf("failed to get entity source: %w", err)
+	}
+
+	defer entitySource.Close()
+
+	// Set cache header for public resource
+	maxAge := dep.SettingProvider().PublicResourceMaxAge(c)
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", maxAge))
+
+	entitySource.Serve(c.Writer, c.Request,
+		entitysource.WithSpeedLimit(s.Speed),
+		entitysource.WithDownload(c.Query(routes.IsDownloadQuery) != ""),
+		entitysource.WithDisplayName(s.Name),
+		entitysource.WithContext(c),
+	)
+	return nil
+}
+
 type (
 	SlaveCreateUploadSessionParamCtx struct{}
 	// SlaveCreateUploadSessionService 从机上传会话服务
@@ -194,6 +286,92 @@ func (s *SlaveThumbService) Thumb(c *gin.Context) error {
 	return nil
 }
 
+const slaveThumbTempFolder = "thumb_offload"
+
+type SlaveGenerateThumbParamCtx struct{}
+
+// SlaveGenerateThumb downloads the source file from signed URL, generates thumbnail and uploads it to the
+// sidecar location in the given storage policy.
+func SlaveGenerateThumb(c *gin.Context, args *cluster.GenerateSlaveThumb) (*cluster.GenerateSlaveThumbResponse, error) {
+	dep := dependency.FromContext(c)
+	m := manager.NewFileManager(dep, nil)
+	defer m.Recycle()
+
+	settings := dep.SettingProvider()
+	src := filepath.Join(
+		util.DataPath(settings.TempPath(c)),
+		slaveThumbTempFolder,
+		fmt.Sprintf("src_%s", uuid.Must(uuid.NewV4()).String()),
+	)
+	defer os.Remove(src)
+	defer os.Remove(src + settings.ThumbSlaveSidecarSuffix(c))
+
+	if err := downloadThumbSource(c, dep, args.Src, src); err != nil {
+		return nil, serializer.NewError(serializer.CodeIOFailed, "Failed to download source file", err)
+	}
+
+	srcEntity, err := local.NewLocalFileEntity(types.EntityTypeVersion, src)
+	if err != nil {
+		return nil, fs.ErrPathNotExist.WithError(err)
+	}
+
+	entity, err := m.SubmitAndAwaitThumbnailTask(thumb.WithPolicy(c, args.Policy), nil, args.Ext, srcEntity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit and await thumbnail task: %w", err)
+	}
+
+	thumbFile, err := os.Open(entity.Source())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open thumb %q: %w", entity.Source(), err)
+	}
+
+	defer thumbFile.Close()
+	fileInfo, err := thumbFile.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat thumb %q: %w", entity.Source(), err)
+	}
+
+	if err := m.Upload(c, &fs.UploadRequest{
+		File:   thumbFile,
+		Seeker: thumbFile,
+		Props: &fs.UploadProps{
+			SavePath: args.SavePath,
+			Size:     fileInfo.Size(),
+			MimeType: dep.MimeDetector(c).TypeByName("thumb.jpg"),
+		},
+	}, args.Policy); err != nil {
+		return nil, err
+	}
+
+	return &cluster.GenerateSlaveThumbResponse{Size: fileInfo.Size()}, nil
+}
+
+func downloadThumbSource(c *gin.Context, dep dependency.Dep, srcUrl, dst string) error {
+	resp := dep.RequestClient().Request(
+		http.MethodGet,
+		srcUrl,
+		nil,
+		request.WithContext(c),
+		request.WithLogger(logging.FromContext(c)),
+	).CheckHTTPResponse(http.StatusOK)
+	if resp.Err != nil {
+		return resp.Err
+	}
+
+	defer resp.Response.Body.Close()
+	out, err := util.CreatNestedFile(dst)
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+
+	defer out.Close()
+	if _, err := io.Copy(out, resp.Response.Body); err != nil {
+		return fmt.Errorf("failed to write temp file: %w", err)
+	}
+
+	return nil
+}
+
 type (
 	SlaveDeleteUploadSessionParamCtx struct{}
 	SlaveDeleteUploadSessionService  struct {
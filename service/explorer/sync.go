@@ -0,0 +1,238 @@
+package explorer
+
+import (
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/syncjob"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type (
+	SyncJobService struct {
+		Name string `json:"name" binding:"required,min=1,max=255"`
+		// Src and Dst are URIs of source and destination folder, destination is created if not exist.
+		Src string `json:"src" binding:"required"`
+		Dst string `json:"dst" binding:"required"`
+		// Interval in minutes between two runs.
+		Interval        int    `json:"interval" binding:"required,min=5,max=525600"`
+		Compare         string `json:"compare" binding:"omitempty,oneof=mtime hash"`
+		PropagateDelete bool   `json:"propagate_delete"`
+		Enabled         bool   `json:"enabled"`
+	}
+	SyncJobParameterCtx struct{}
+
+	SyncJob struct {
+		ID              string            `json:"id"`
+		Name            string            `json:"name"`
+		Src             string            `json:"src"`
+		Dst             string            `json:"dst"`
+		Interval        int               `json:"interval"`
+		Compare         string            `json:"compare"`
+		PropagateDelete bool              `json:"propagate_delete"`
+		Enabled         bool              `json:"enabled"`
+		CreatedAt       time.Time         `json:"created_at"`
+		NextRunAt       *time.Time        `json:"next_run_at,omitempty"`
+		LastRunAt       *time.Time        `json:"last_run_at,omitempty"`
+		LastReport      *types.SyncReport `json:"last_report,omitempty"`
+		TaskID          string            `json:"task_id,omitempty"`
+	}
+
+	ListSyncJobResponse struct {
+		Jobs []*SyncJob `json:"jobs"`
+		// Max is the maximum number of sync jobs of each user.
+		Max int `json:"max"`
+	}
+)
+
+func BuildSyncJob(j *ent.SyncJob, hasher hashid.Encoder) *SyncJob {
+	res := &SyncJob{
+		ID:              hashid.EncodeSyncJobID(hasher, j.ID),
+		Name:            j.Name,
+		Src:             j.Src,
+		Dst:             j.Dst,
+		Interval:        j.Interval,
+		Compare:         string(j.Compare),
+		PropagateDelete: j.PropagateDelete,
+		Enabled:         j.Enabled,
+		CreatedAt:       j.CreatedAt,
+		NextRunAt:       j.NextRunAt,
+		LastRunAt:       j.LastRunAt,
+		LastReport:      j.LastReport,
+	}
+
+	if j.TaskID != nil {
+		res.TaskID = hashid.EncodeTaskID(hasher, *j.TaskID)
+	}
+
+	return res
+}
+
+// ListSyncJobs lists folder sync jobs of current user.
+func ListSyncJobs(c *gin.Context) (*ListSyncJobResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	hasher := dep.HashIDEncoder()
+
+	jobs, err := dep.SyncJobClient().List(c, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list sync jobs", err)
+	}
+
+	return &ListSyncJobResponse{
+		Jobs: lo.Map(jobs, func(item *ent.SyncJob, index int) *SyncJob {
+			return BuildSyncJob(item, hasher)
+		}),
+		Max: dep.SettingProvider().FolderSyncMax(c),
+	}, nil
+}
+
+// Create creates a new folder sync job for current user, the first run is scheduled immediately.
+func (service *SyncJobService) Create(c *gin.Context) (*SyncJob, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	jobClient := dep.SyncJobClient()
+
+	args, err := service.validate(c)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := jobClient.Count(c, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to count sync jobs", err)
+	}
+
+	if count >= dep.SettingProvider().FolderSyncMax(c) {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Too many sync jobs", nil)
+	}
+
+	job, err := jobClient.Create(c, user.ID, args)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create sync job", err)
+	}
+
+	return BuildSyncJob(job, dep.HashIDEncoder()), nil
+}
+
+// Update updates an existing folder sync job.
+func (service *SyncJobService) Update(c *gin.Context) (*SyncJob, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	jobClient := dep.SyncJobClient()
+	id := hashid.FromContext(c)
+
+	existing, err := jobClient.GetByIDAndUserID(c, id, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Sync job not exist", err)
+	}
+
+	args, err := service.validate(c)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := jobClient.Update(c, existing.ID, args)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update sync job", err)
+	}
+
+	return BuildSyncJob(job, dep.HashIDEncoder()), nil
+}
+
+// DeleteSyncJob deletes a folder sync job of current user. Running task of the job is not affected.
+func DeleteSyncJob(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	jobClient := dep.SyncJobClient()
+	id := hashid.FromContext(c)
+
+	if _, err := jobClient.GetByIDAndUserID(c, id, user.ID); err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Sync job not exist", err)
+	}
+
+	if err := jobClient.Delete(c, id); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete sync job", err)
+	}
+
+	return nil
+}
+
+// RunSyncJob schedules a folder sync job of current user to run on next cron tick.
+func RunSyncJob(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	jobClient := dep.SyncJobClient()
+	id := hashid.FromContext(c)
+
+	job, err := jobClient.GetByIDAndUserID(c, id, user.ID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Sync job not exist", err)
+	}
+
+	if !job.Enabled {
+		return serializer.NewError(serializer.CodeParamErr, "Sync job is disabled", nil)
+	}
+
+	if err := jobClient.RunNow(c, id); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to schedule sync job", err)
+	}
+
+	return nil
+}
+
+// validate checks source and destination folder, returns the normalized arguments.
+func (service *SyncJobService) validate(c *gin.Context) (*inventory.SyncJobArgs, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	src, err := fs.NewUriFromString(service.Src)
+	if err != nil || src.FileSystem() != constants.FileSystemMy {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid source", err)
+	}
+
+	dst, err := fs.NewUriFromString(service.Dst)
+	if err != nil || dst.FileSystem() != constants.FileSystemMy {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid destination", err)
+	}
+
+	uid := hashid.EncodeUserID(dep.HashIDEncoder(), user.ID)
+	if src.EqualOrIsDescendantOf(dst, uid) || dst.EqualOrIsDescendantOf(src, uid) {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Source and destination must not contain each other", nil)
+	}
+
+	srcFolder, err := m.Get(c, src)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid source", err)
+	}
+
+	if srcFolder.Type() != types.FileTypeFolder || srcFolder.OwnerID() != user.ID {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Source must be a folder of your own", nil)
+	}
+
+	compare := syncjob.CompareMtime
+	if service.Compare != "" {
+		compare = syncjob.Compare(service.Compare)
+	}
+
+	return &inventory.SyncJobArgs{
+		Name:            service.Name,
+		Src:             src.String(),
+		Dst:             dst.String(),
+		Interval:        service.Interval,
+		Compare:         compare,
+		PropagateDelete: service.PropagateDelete,
+		Enabled:         service.Enabled,
+	}, nil
+}
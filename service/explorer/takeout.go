@@ -0,0 +1,97 @@
+package explorer
+
+import (
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	TakeoutWorkflowService struct {
+		IncludeFiles bool `json:"include_files"`
+	}
+	CreateTakeoutParamCtx struct{}
+)
+
+// CreateTakeoutTask creates a task exporting personal data of current user.
+func (service *TakeoutWorkflowService) CreateTakeoutTask(c *gin.Context) (*TaskResponse, error) {
+	return CreateTakeoutTask(c, inventory.UserFromContext(c).ID, service.IncludeFiles)
+}
+
+// CreateTakeoutTask creates a task exporting personal data of user uid, owned by current user.
+func CreateTakeoutTask(c *gin.Context, uid int, includeFiles bool) (*TaskResponse, error) {
+	dep := dependency.FromContext(c)
+	t, err := manager.NewTakeoutTask(c, uid, includeFiles)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
+	}
+
+	if t, err = queueTask(c, dep.IoIntenseQueue(c), t, ""); err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue task", err)
+	}
+
+	return BuildTaskResponse(t, nil, dep.HashIDEncoder()), nil
+}
+
+// TakeoutUrl signs the download URL of the archive of a completed takeout task owned by current user.
+func TakeoutUrl(c *gin.Context, taskID int) (*FileURLResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+
+	t, err := dep.TaskClient().GetTaskByID(c, taskID)
+	if err != nil || t.UserTasks != user.ID {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Task not found", err)
+	}
+
+	if _, err := manager.TakeoutArchive(t); err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Takeout archive not found", err)
+	}
+
+	expire := time.Now().Add(dep.SettingProvider().EntityUrlValidDuration(c))
+	downloadUrl := routes.MasterTakeoutDownloadUrl(dep.SettingProvider().SiteURL(c), hashid.EncodeTaskID(dep.HashIDEncoder(), t.ID))
+	signed, err := auth.SignURI(c, dep.GeneralAuth(), downloadUrl.String(), &expire)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to sign takeout download url", err)
+	}
+
+	return &FileURLResponse{
+		Urls:    []manager.EntityUrl{{Url: signed.String()}},
+		Expires: &expire,
+	}, nil
+}
+
+type (
+	TakeoutDownloadService struct {
+		ID string `uri:"id" binding:"required"`
+	}
+	TakeoutDownloadParamCtx struct{}
+)
+
+// Download serves the takeout archive through a signed URL.
+func (service *TakeoutDownloadService) Download(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	taskID, err := dep.HashIDEncoder().Decode(service.ID, hashid.TaskID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Task not found", err)
+	}
+
+	t, err := dep.TaskClient().GetTaskByID(c, taskID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Task not found", err)
+	}
+
+	archive, err := manager.TakeoutArchive(t)
+	if err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Takeout archive not found", err)
+	}
+
+	c.FileAttachment(archive, "takeout.zip")
+	return nil
+}
@@ -3,9 +3,12 @@ package explorer
 import (
 	"context"
 	"fmt"
+	"io"
+
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
@@ -49,6 +52,10 @@ func (service *CreateUploadSessionService) Create(c context.Context) (*UploadSes
 		livePhoto := types.EntityTypeLivePhoto
 		entityType = &livePhoto
 	case "version":
+		if !auth.AccessTokenAllows(c, auth.ScopeFileWrite) {
+			return nil, serializer.NewError(serializer.CodeNoPermissionErr, "Access token scope does not allow this operation", nil)
+		}
+
 		version := types.EntityTypeVersion
 		entityType = &version
 	}
@@ -143,15 +150,43 @@ func (service *UploadService) SlaveUpload(c *gin.Context) error {
 	return processChunkUpload(c, m, &uploadSession, service.Index, nil, fs.ModeOverwrite)
 }
 
-func processChunkUpload(c *gin.Context, m manager.FileManager, session *fs.UploadSession, index int, file fs.File, mode fs.WriteMode) error {
-	// 取得并校验文件大小是否符合分片要求
+// SlaveUploadChunk handles a chunk of slave upload session streamed over gRPC.
+func SlaveUploadChunk(ctx context.Context, sessionID string, index int, size int64, content io.ReadCloser) error {
+	dep := dependency.FromContext(ctx)
+	uploadSessionRaw, ok := dep.KV().Get(manager.UploadSessionCachePrefix + sessionID)
+	if !ok {
+		return serializer.NewError(serializer.CodeUploadSessionExpired, "", nil)
+	}
+
+	uploadSession := uploadSessionRaw.(fs.UploadSession)
+	if expectedLength, _ := expectedChunkLength(&uploadSession, index); expectedLength != size {
+		return serializer.NewError(
+			serializer.CodeInvalidContentLength,
+			fmt.Sprintf("Invalid Content-Length (expected: %d)", expectedLength),
+			nil,
+		)
+	}
+
+	m := manager.NewFileManager(dep, nil)
+	defer m.Recycle()
+
+	return uploadChunkContent(ctx, m, &uploadSession, index, nil, fs.ModeOverwrite, request.NewLimitReaderCloser(content, size))
+}
+
+// expectedChunkLength returns the expected size of given chunk and whether it is the last one.
+func expectedChunkLength(session *fs.UploadSession, index int) (int64, bool) {
 	chunkSize := session.ChunkSize
 	isLastChunk := session.ChunkSize == 0 || int64(index+1)*chunkSize >= session.Props.Size
-	expectedLength := chunkSize
 	if isLastChunk {
-		expectedLength = session.Props.Size - int64(index)*chunkSize
+		return session.Props.Size - int64(index)*chunkSize, true
 	}
 
+	return chunkSize, false
+}
+
+func processChunkUpload(c *gin.Context, m manager.FileManager, session *fs.UploadSession, index int, file fs.File, mode fs.WriteMode) error {
+	// 取得并校验文件大小是否符合分片要求
+	expectedLength, _ := expectedChunkLength(session, index)
 	rc, fileSize, err := request.SniffContentLength(c.Request)
 	if err != nil || (expectedLength != fileSize) {
 		return serializer.NewError(
@@ -161,6 +196,13 @@ func processChunkUpload(c *gin.Context, m manager.FileManager, session *fs.Uploa
 		)
 	}
 
+	return uploadChunkContent(c, m, session, index, file, mode, rc)
+}
+
+func uploadChunkContent(c context.Context, m manager.FileManager, session *fs.UploadSession, index int, file fs.File, mode fs.WriteMode, rc request.LimitReaderCloser) error {
+	chunkSize := session.ChunkSize
+	expectedLength, isLastChunk := expectedChunkLength(session, index)
+
 	// 非首个分片时需要允许覆盖
 	if index > 0 {
 		mode |= fs.ModeOverwrite
@@ -175,8 +217,7 @@ func processChunkUpload(c *gin.Context, m manager.FileManager, session *fs.Uploa
 
 	// 执行上传
 	ctx := context.WithValue(c, cluster.SlaveNodeIDCtx{}, strconv.Itoa(session.Policy.NodeID))
-	err = m.Upload(ctx, req, session.Policy)
-	if err != nil {
+	if err := m.Upload(ctx, req, session.Policy); err != nil {
 		return err
 	}
 
@@ -0,0 +1,197 @@
+package explorer
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/workflows"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type (
+	UrlImportWorkflowService struct {
+		Urls []string `json:"urls"`
+		// Manifest is the URI of a text file listing URLs, one per line.
+		Manifest string `json:"manifest"`
+		Dst      string `json:"dst" binding:"required"`
+		// Concurrency is the number of URLs downloaded in parallel, capped by site setting.
+		Concurrency int `json:"concurrency" binding:"min=0"`
+	}
+	CreateUrlImportParamCtx struct{}
+)
+
+// CreateUrlImportTask creates a task importing all listed URLs into the destination folder.
+func (service *UrlImportWorkflowService) CreateUrlImportTask(c *gin.Context) (*TaskResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	settings := dep.SettingProvider().UrlImport(c)
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	if !user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionRemoteDownload)) {
+		return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Group not allowed to download files", nil)
+	}
+
+	urls := lo.FilterMap(service.Urls, func(u string, index int) (string, bool) {
+		u = strings.TrimSpace(u)
+		return u, u != ""
+	})
+	if service.Manifest != "" {
+		listed, err := readUrlManifest(c, m, service.Manifest, settings.ManifestMaxSize)
+		if err != nil {
+			return nil, err
+		}
+
+		urls = append(urls, listed...)
+	}
+
+	if len(urls) == 0 {
+		return nil, serializer.NewError(serializer.CodeParamErr, "No URLs to import", nil)
+	}
+
+	if len(urls) > settings.MaxItems {
+		return nil, serializer.NewError(serializer.CodeParamErr, fmt.Sprintf("At most %d URLs can be imported at once", settings.MaxItems), nil)
+	}
+
+	for _, u := range urls {
+		if err := workflows.ValidateImportUrl(u); err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid URL", err)
+		}
+	}
+
+	dst, err := fs.NewUriFromString(service.Dst)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid destination", err)
+	}
+
+	if _, err := m.Get(c, dst, dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityCreateFile)); err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid destination", err)
+	}
+
+	concurrency := settings.MaxConcurrency
+	if service.Concurrency > 0 {
+		concurrency = min(service.Concurrency, settings.MaxConcurrency)
+	}
+
+	t, err := workflows.NewUrlImportTask(c, user, urls, dst.String(), concurrency)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
+	}
+
+	if t, err = queueTask(c, dep.IoIntenseQueue(c), t, ""); err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue task", err)
+	}
+
+	return BuildTaskResponse(t, nil, dep.HashIDEncoder()), nil
+}
+
+// readUrlManifest reads URLs listed in a text file.
+func readUrlManifest(c *gin.Context, m manager.FileManager, manifest string, maxSize int64) ([]string, error) {
+	uri, err := fs.NewUriFromString(manifest)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid manifest", err)
+	}
+
+	file, err := m.Get(c, uri, dbfs.WithFileEntities(), dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityDownloadFile))
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid manifest", err)
+	}
+
+	entity := file.PrimaryEntity()
+	if file.Type() != types.FileTypeFile || entity == nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Manifest must be a file", nil)
+	}
+
+	if entity.Size() > maxSize {
+		return nil, serializer.NewError(serializer.CodeFileTooLarge, "Manifest is too large", nil)
+	}
+
+	es, err := m.GetEntitySource(c, 0, fs.WithEntity(entity))
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Failed to open manifest", err)
+	}
+	defer es.Close()
+
+	content, err := io.ReadAll(io.LimitReader(es, maxSize))
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeIOFailed, "Failed to read manifest", err)
+	}
+
+	return workflows.ParseUrlList(string(content)), nil
+}
+
+// UrlImportReport writes status of each URL of an import task as CSV into response.
+func UrlImportReport(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	taskID := hashid.FromContext(c)
+
+	t, found := dep.TaskRegistry().Get(taskID)
+	if !found {
+		model, err := dep.TaskClient().GetTaskByID(context.WithValue(c, inventory.LoadTaskUser{}, true), taskID)
+		if err != nil {
+			return serializer.NewError(serializer.CodeNotFound, "Task not found", err)
+		}
+
+		if t, err = queue.NewTaskFromModel(model); err != nil {
+			return serializer.NewError(serializer.CodeNotFound, "Task not found", err)
+		}
+	}
+
+	importTask, ok := t.(*workflows.UrlImportTask)
+	if !ok || t.Owner() == nil || t.Owner().ID != user.ID {
+		return serializer.NewError(serializer.CodeNotFound, "Task not found", nil)
+	}
+
+	items, err := importTask.Items()
+	if err != nil {
+		return serializer.NewError(serializer.CodeInternalSetting, "Failed to read task state", err)
+	}
+
+	fileName := fmt.Sprintf("url-import-%s.csv", hashid.EncodeTaskID(dep.HashIDEncoder(), taskID))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+
+	w := csv.NewWriter(c.Writer)
+	if err := w.Write([]string{"url", "status", "uri", "size", "error"}); err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if err := w.Write([]string{
+			reportCell(item.Url),
+			string(item.Status),
+			reportCell(item.Uri),
+			strconv.FormatInt(item.Size, 10),
+			reportCell(item.Error),
+		}); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// reportCell escapes values that spreadsheet software would evaluate as formula.
+func reportCell(v string) string {
+	if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+		return "'" + v
+	}
+
+	return v
+}
@@ -1,6 +1,7 @@
 package explorer
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
@@ -115,6 +116,22 @@ func (service *WopiService) Lock(c *gin.Context) error {
 	}
 
 	lockToken := c.GetHeader(wopi.LockTokenHeader)
+	if oldLockToken := c.GetHeader(wopi.OldLockTokenHeader); oldLockToken != "" {
+		// UnlockAndRelock: replace the old lock with the new one
+		release, _, err := m.ConfirmLock(c, file, file.Uri(false), oldLockToken)
+		if err != nil {
+			l.Debug("WOPI unlock and relock, not locked or not match: %s", err)
+			c.Status(http.StatusConflict)
+			c.Header(wopi.LockTokenHeader, currentLockToken(c, m, file))
+			return nil
+		}
+
+		release()
+		if err := m.Unlock(c, oldLockToken); err != nil {
+			return fmt.Errorf("failed to unlock file: %w", err)
+		}
+	}
+
 	release, _, err := m.ConfirmLock(c, file, file.Uri(false), lockToken)
 	if err != nil {
 		// File not locked for token not match
@@ -130,7 +147,7 @@ func (service *WopiService) Lock(c *gin.Context) error {
 			var lockConflict lock.ConflictError
 			if errors.As(err, &lockConflict) {
 				c.Status(http.StatusConflict)
-				c.Header(wopi.LockTokenHeader, lockConflict[0].Token)
+				c.Header(wopi.LockTokenHeader, wopiLockID(lockConflict))
 
 				l.Debug("WOPI lock, lock conflict: %w", err)
 				return nil
@@ -156,6 +173,104 @@ func (service *WopiService) Lock(c *gin.Context) error {
 	return nil
 }
 
+// GetLock returns the current lock token of the file in X-WOPI-Lock header, empty if not locked.
+func (service *WopiService) GetLock(c *gin.Context) error {
+	uri, m, _, _, _, err := prepareFs(c)
+	if err != nil {
+		return err
+	}
+
+	file, err := m.Get(c, uri, dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityLockFile), dbfs.WithNotRoot())
+	if err != nil {
+		return fmt.Errorf("failed to get file: %w", err)
+	}
+
+	c.Header(wopi.LockTokenHeader, currentLockToken(c, m, file))
+	return nil
+}
+
+// wopiLockID returns the token of conflicted lock as WOPI lock ID. Tokens of locks not held by office editors,
+// e.g. check-outs and WebDAV locks, are hidden, otherwise editors could overwrite the file with them.
+func wopiLockID(conflict lock.ConflictError) string {
+	if len(conflict) == 0 || conflict[0].Owner.Application.Type != string(fs.ApplicationViewer) {
+		return ""
+	}
+
+	return conflict[0].Token
+}
+
+// currentLockToken returns the token of the office editor lock currently on the file, empty string is returned
+// if the file is not locked by editors.
+func currentLockToken(c *gin.Context, m manager.FileManager, file fs.File) string {
+	for _, l := range m.FileLocks(c, file) {
+		if l.Owner.Application.Type == string(fs.ApplicationViewer) {
+			return l.Token
+		}
+	}
+
+	return ""
+}
+
+// Rename renames the file, the new name given in X-WOPI-RequestedName header does not include the extension.
+func (service *WopiService) Rename(c *gin.Context) error {
+	uri, m, user, _, _, err := prepareFs(c)
+	if err != nil {
+		return err
+	}
+
+	file, err := m.Get(c, uri, dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityRenameFile), dbfs.WithNotRoot())
+	if err != nil {
+		return fmt.Errorf("failed to get file: %w", err)
+	}
+
+	if file.OwnerID() != user.ID || uri.FileSystem() != constants.FileSystemMy {
+		c.Status(http.StatusUnauthorized)
+		return nil
+	}
+
+	requestedName, err := wopi.UTF7Decode(c.GetHeader(wopi.RenameRequestHeader))
+	if err != nil {
+		return fmt.Errorf("failed to decode X-WOPI-RequestedName header (UTF-7): %w", err)
+	}
+
+	ctx := context.Context(c)
+	if lockToken := c.GetHeader(wopi.LockTokenHeader); lockToken != "" {
+		release, ls, err := m.ConfirmLock(c, file, file.Uri(false), lockToken)
+		if err != nil {
+			c.Status(http.StatusConflict)
+			c.Header(wopi.LockTokenHeader, currentLockToken(c, m, file))
+			return nil
+		}
+
+		defer release()
+		ctx = fs.LockSessionToContext(c, ls)
+	}
+
+	newName := requestedName + filepath.Ext(file.DisplayName())
+	if _, err := m.Rename(ctx, uri, newName); err != nil {
+		var lockConflict lock.ConflictError
+		if errors.As(err, &lockConflict) && len(lockConflict) > 0 {
+			c.Status(http.StatusConflict)
+			c.Header(wopi.LockTokenHeader, wopiLockID(lockConflict))
+			return nil
+		}
+
+		var appErr serializer.AppError
+		if errors.As(err, &appErr) && (appErr.Code == serializer.CodeIllegalObjectName || appErr.Code == serializer.CodeObjectExist) {
+			c.Status(http.StatusBadRequest)
+			c.Header(wopi.InvalidNameHeader, appErr.Msg)
+			return nil
+		}
+
+		return err
+	}
+
+	c.JSON(http.StatusOK, RenameFileResponse{
+		Name: requestedName,
+	})
+	return nil
+}
+
 func (service *WopiService) PutContent(c *gin.Context, isPutRelative bool) error {
 	uri, m, user, viewerSession, _, err := prepareFs(c)
 	if err != nil {
@@ -192,7 +307,7 @@ func (service *WopiService) PutContent(c *gin.Context, isPutRelative bool) error
 				var lockConflict lock.ConflictError
 				if errors.As(err, &lockConflict) {
 					c.Status(http.StatusConflict)
-					c.Header(wopi.LockTokenHeader, lockConflict[0].Token)
+					c.Header(wopi.LockTokenHeader, wopiLockID(lockConflict))
 
 					return nil
 				}
@@ -236,6 +351,13 @@ func (service *WopiService) PutContent(c *gin.Context, isPutRelative bool) error
 
 	res, err := subService.PutContent(c, lockSession)
 	if err != nil {
+		var lockConflict lock.ConflictError
+		if errors.As(err, &lockConflict) && len(lockConflict) > 0 {
+			c.Status(http.StatusConflict)
+			c.Header(wopi.LockTokenHeader, wopiLockID(lockConflict))
+			return nil
+		}
+
 		var appErr serializer.AppError
 		if errors.As(err, &appErr) {
 			switch appErr.Code {
@@ -267,6 +389,11 @@ func (service *WopiService) PutContent(c *gin.Context, isPutRelative bool) error
 }
 
 func (service *WopiService) GetFile(c *gin.Context) error {
+	return serveViewerSessionFile(c)
+}
+
+// serveViewerSessionFile serves content of the file version opened in current viewer session.
+func serveViewerSessionFile(c *gin.Context) error {
 	uri, m, _, viewerSession, dep, err := prepareFs(c)
 	if err != nil {
 		return err
@@ -354,8 +481,11 @@ func (service *WopiService) FileInfo(c *gin.Context) (*WopiFileInfo, error) {
 		Size:                    targetEntity.Size(),
 		OwnerId:                 hashid.EncodeUserID(hasher, file.OwnerID()),
 		SupportsRename:          true,
+		SupportsGetLock:         true,
 		SupportsReviewing:       true,
+		SupportsUpdate:          true,
 		SupportsLocks:           true,
+		UserCanRename:           canEdit,
 		UserCanReview:           canEdit,
 		UserCanWrite:            canEdit,
 		UserCanNotWriteRelative: cantPutRelative,
@@ -413,13 +543,19 @@ func (s *CreateViewerSessionService) Create(c *gin.Context) (*ViewerSessionRespo
 	}
 
 	res := &ViewerSessionResponse{Session: viewerSession}
-	if targetViewer.Type == setting.ViewerTypeWopi {
+	switch targetViewer.Type {
+	case setting.ViewerTypeWopi:
 		// For WOPI viewer, generate WOPI src
 		wopiSrc, err := wopi.GenerateWopiSrc(c, s.PreferredAction, targetViewer, viewerSession)
 		if err != nil {
 			return nil, serializer.NewError(serializer.CodeInternalSetting, "failed to generate wopi src", err)
 		}
 		res.WopiSrc = wopiSrc.String()
+	case setting.ViewerTypeOnlyOffice:
+		res.OnlyOfficeConfig, err = onlyOfficeConfig(c, user, uri, s.PreferredAction, targetViewer, viewerSession)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return res, nil
@@ -1,8 +1,10 @@
 package explorer
 
 import (
+	"crypto/sha256"
 	"encoding/gob"
-	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
@@ -15,8 +17,10 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/workflows"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/queue"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/samber/lo"
@@ -69,11 +73,41 @@ func init() {
 	gob.Register(ItemIDService{})
 }
 
+// IdempotencyKeyHeader is the request header carrying a client generated key, retried requests with the
+// same key within queue.IdempotencyWindow return the task created by the first one instead of creating another.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// queueTask submits the task with idempotency key from request, scope distinguishes multiple tasks created
+// in one request. If the key is already used, the existing task is returned.
+func queueTask(c *gin.Context, q queue.Queue, t queue.Task, scope string) (queue.Task, error) {
+	if key := c.GetHeader(IdempotencyKeyHeader); key != "" {
+		user := inventory.UserFromContext(c)
+		digest := sha256.Sum256([]byte(c.FullPath() + "\n" + key + "\n" + scope))
+		queue.SetIdempotencyKey(t, fmt.Sprintf("%d:%x", user.ID, digest))
+	}
+
+	err := q.QueueTask(c, t)
+	var duplicated *queue.DuplicateTaskError
+	if errors.As(err, &duplicated) {
+		if existing, err := queue.NewTaskFromModel(duplicated.Existing); err == nil {
+			return existing, nil
+		}
+	}
+
+	return t, err
+}
+
 type (
 	DownloadWorkflowService struct {
 		Src     []string `json:"src"`
 		SrcFile string   `json:"src_file"`
 		Dst     string   `json:"dst" binding:"required"`
+		// SelectFiles holds the download once metadata is loaded, until files to download are selected.
+		SelectFiles bool `json:"select_files"`
+		// Extract extracts downloaded archives into the destination folder.
+		Extract bool `json:"extract"`
+		// DeleteArchive deletes archives once extracted.
+		DeleteArchive bool `json:"delete_archive"`
 	}
 	CreateDownloadParamCtx struct{}
 )
@@ -129,6 +163,16 @@ func (service *DownloadWorkflowService) CreateDownloadTask(c *gin.Context) ([]*T
 		}
 	}
 
+	if service.Extract && !user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionArchiveTask)) {
+		return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Group not allowed to extract archives", nil)
+	}
+
+	opts := &workflows.RemoteDownloadTaskOptions{
+		SelectFiles:     service.SelectFiles,
+		ExtractArchives: service.Extract,
+		DeleteArchives:  service.Extract && service.DeleteArchive,
+	}
+
 	// batch creating tasks
 	ae := serializer.NewAggregateError()
 	tasks := make([]queue.Task, 0, len(service.Src))
@@ -137,13 +181,13 @@ func (service *DownloadWorkflowService) CreateDownloadTask(c *gin.Context) ([]*T
 			continue
 		}
 
-		t, err := workflows.NewRemoteDownloadTask(c, src, service.SrcFile, service.Dst)
+		t, err := workflows.NewRemoteDownloadTask(c, src, service.SrcFile, service.Dst, opts)
 		if err != nil {
 			ae.Add(src, err)
 			continue
 		}
 
-		if err := dep.RemoteDownloadQueue(c).QueueTask(c, t); err != nil {
+		if t, err = queueTask(c, dep.RemoteDownloadQueue(c), t, src); err != nil {
 			ae.Add(src, err)
 		}
 
@@ -151,12 +195,12 @@ func (service *DownloadWorkflowService) CreateDownloadTask(c *gin.Context) ([]*T
 	}
 
 	if service.SrcFile != "" {
-		t, err := workflows.NewRemoteDownloadTask(c, "", service.SrcFile, service.Dst)
+		t, err := workflows.NewRemoteDownloadTask(c, "", service.SrcFile, service.Dst, opts)
 		if err != nil {
 			ae.Add(service.SrcFile, err)
 		}
 
-		if err := dep.RemoteDownloadQueue(c).QueueTask(c, t); err != nil {
+		if t, err = queueTask(c, dep.RemoteDownloadQueue(c), t, service.SrcFile); err != nil {
 			ae.Add(service.SrcFile, err)
 		}
 
@@ -203,12 +247,12 @@ func (service *ArchiveWorkflowService) CreateExtractTask(c *gin.Context) (*TaskR
 	}
 
 	// Create task
-	t, err := workflows.NewExtractArchiveTask(c, service.Src[0], service.Dst, service.Encoding)
+	t, err := workflows.NewExtractArchiveTask(c, service.Src[0], service.Dst, service.Encoding, false)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
 	}
 
-	if err := dep.IoIntenseQueue(c).QueueTask(c, t); err != nil {
+	if t, err = queueTask(c, dep.IoIntenseQueue(c), t, ""); err != nil {
 		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue task", err)
 	}
 
@@ -252,7 +296,78 @@ func (service *ArchiveWorkflowService) CreateCompressTask(c *gin.Context) (*Task
 		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
 	}
 
-	if err := dep.IoIntenseQueue(c).QueueTask(c, t); err != nil {
+	if t, err = queueTask(c, dep.IoIntenseQueue(c), t, ""); err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue task", err)
+	}
+
+	return BuildTaskResponse(t, nil, hasher), nil
+}
+
+type (
+	TranscodeWorkflowService struct {
+		Src    string `json:"src" binding:"required"`
+		Dst    string `json:"dst"`
+		Preset string `json:"preset" binding:"required,eq=hls|eq=preview"`
+	}
+	CreateTranscodeParamCtx struct{}
+)
+
+// CreateTranscodeTask Create task to transcode a video into renditions for preview
+func (service *TranscodeWorkflowService) CreateTranscodeTask(c *gin.Context) (*TaskResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	hasher := dep.HashIDEncoder()
+	m := manager.NewFileManager(dep, user)
+	defer m.Recycle()
+
+	if !user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionTranscode)) {
+		return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Group not allowed to transcode files", nil)
+	}
+
+	settings := dep.SettingProvider().Transcode(c)
+	if !settings.Enabled {
+		return nil, serializer.NewError(serializer.CodeFeatureNotEnabled, "Transcoding is not enabled", nil)
+	}
+
+	src, err := fs.NewUriFromString(service.Src)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid source", err)
+	}
+
+	file, err := m.Get(c, src, dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityDownloadFile), dbfs.WithNotRoot())
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid source", err)
+	}
+
+	if file.Type() != types.FileTypeFile || !util.IsInExtensionList(settings.Exts, file.DisplayName()) {
+		return nil, serializer.NewError(serializer.CodeParamErr, "File type not supported", nil)
+	}
+
+	if settings.MaxSize > 0 && file.Size() > settings.MaxSize {
+		return nil, serializer.NewError(serializer.CodeFileTooLarge, "File is too large", nil)
+	}
+
+	// Renditions are saved next to source file by default.
+	dst := src.DirUri()
+	if service.Dst != "" {
+		dst, err = fs.NewUriFromString(service.Dst)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid destination", err)
+		}
+	}
+
+	// Validate destination
+	if _, err := m.Get(c, dst, dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityCreateFile)); err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid destination", err)
+	}
+
+	// Create task
+	t, err := workflows.NewTranscodeTask(c, src, dst, service.Preset)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
+	}
+
+	if t, err = queueTask(c, dep.IoIntenseQueue(c), t, ""); err != nil {
 		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue task", err)
 	}
 
@@ -267,6 +382,14 @@ type (
 		UserID           string `json:"user_id" binding:"required"`
 		Recursive        bool   `json:"recursive"`
 		PolicyID         int    `json:"policy_id" binding:"required"`
+		// ComputeHash stores SHA256 of imported files, only supported by local policy.
+		ComputeHash bool              `json:"compute_hash"`
+		OwnerRules  []ImportOwnerRule `json:"owner_rules" binding:"dive"`
+	}
+	// ImportOwnerRule assigns files under Prefix of Src to another user.
+	ImportOwnerRule struct {
+		Prefix string `json:"prefix" binding:"required"`
+		UserID string `json:"user_id" binding:"required"`
 	}
 	CreateImportParamCtx struct{}
 )
@@ -297,13 +420,43 @@ func (service *ImportWorkflowService) CreateImportTask(c *gin.Context) (*TaskRes
 		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid destination", err)
 	}
 
+	if service.ComputeHash {
+		policy, err := dep.StoragePolicyClient().GetPolicyByID(c, service.PolicyID)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodePolicyNotExist, "", err)
+		}
+
+		if policy.Type != types.PolicyTypeLocal {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Hash can only be computed for local policy", nil)
+		}
+	}
+
+	rules := make([]workflows.ImportOwnerRule, 0, len(service.OwnerRules))
+	for _, rule := range service.OwnerRules {
+		uid, err := hasher.Decode(rule.UserID, hashid.UserID)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Invalid user id in owner rules", err)
+		}
+
+		if _, err := dep.UserClient().GetByID(c, uid); err != nil {
+			return nil, serializer.NewError(serializer.CodeUserNotFound, "", err)
+		}
+
+		rules = append(rules, workflows.ImportOwnerRule{Prefix: rule.Prefix, UserID: uid})
+	}
+
 	// Create task
-	t, err := workflows.NewImportTask(c, owner, service.Src, service.Recursive, dst.Join(service.Dst).String(), service.PolicyID)
+	t, err := workflows.NewImportTask(c, owner, service.Src, service.Recursive, dst.Join(service.Dst).String(), service.PolicyID,
+		&workflows.ImportTaskOptions{
+			ExtractMediaMeta: service.ExtractMediaMeta,
+			ComputeHash:      service.ComputeHash,
+			OwnerRules:       rules,
+		})
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to create task", err)
 	}
 
-	if err := dep.IoIntenseQueue(c).QueueTask(c, t); err != nil {
+	if t, err = queueTask(c, dep.IoIntenseQueue(c), t, ""); err != nil {
 		return nil, serializer.NewError(serializer.CodeCreateTaskError, "Failed to queue task", err)
 	}
 
@@ -331,7 +484,7 @@ func (service *ListTaskService) ListTasks(c *gin.Context) (*TaskListResponse, er
 			PageToken:           service.NextPageToken,
 			PageSize:            service.PageSize,
 		},
-		Types:  []string{queue.CreateArchiveTaskType, queue.ExtractArchiveTaskType, queue.RelocateTaskType, queue.ImportTaskType},
+		Types:  []string{queue.CreateArchiveTaskType, queue.ExtractArchiveTaskType, queue.RelocateTaskType, queue.ImportTaskType, queue.TranscodeTaskType, queue.FolderSyncTaskType, queue.UrlImportTaskType, queue.TakeoutTaskType},
 		UserID: user.ID,
 	}
 
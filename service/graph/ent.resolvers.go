@@ -0,0 +1,59 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+)
+
+// ID is the resolver for the id field.
+func (r *fileResolver) ID(ctx context.Context, obj *ent.File) (string, error) {
+	return hashid.EncodeFileID(dependency.FromContext(ctx).HashIDEncoder(), obj.ID), nil
+}
+
+// ID is the resolver for the id field.
+func (r *groupResolver) ID(ctx context.Context, obj *ent.Group) (string, error) {
+	return hashid.EncodeGroupID(dependency.FromContext(ctx).HashIDEncoder(), obj.ID), nil
+}
+
+// ID is the resolver for the id field.
+func (r *shareResolver) ID(ctx context.Context, obj *ent.Share) (string, error) {
+	return hashid.EncodeShareID(dependency.FromContext(ctx).HashIDEncoder(), obj.ID), nil
+}
+
+// ID is the resolver for the id field.
+func (r *taskResolver) ID(ctx context.Context, obj *ent.Task) (string, error) {
+	return hashid.EncodeTaskID(dependency.FromContext(ctx).HashIDEncoder(), obj.ID), nil
+}
+
+// ID is the resolver for the id field.
+func (r *userResolver) ID(ctx context.Context, obj *ent.User) (string, error) {
+	return hashid.EncodeUserID(dependency.FromContext(ctx).HashIDEncoder(), obj.ID), nil
+}
+
+// File returns FileResolver implementation.
+func (r *Resolver) File() FileResolver { return &fileResolver{r} }
+
+// Group returns GroupResolver implementation.
+func (r *Resolver) Group() GroupResolver { return &groupResolver{r} }
+
+// Share returns ShareResolver implementation.
+func (r *Resolver) Share() ShareResolver { return &shareResolver{r} }
+
+// Task returns TaskResolver implementation.
+func (r *Resolver) Task() TaskResolver { return &taskResolver{r} }
+
+// User returns UserResolver implementation.
+func (r *Resolver) User() UserResolver { return &userResolver{r} }
+
+type fileResolver struct{ *Resolver }
+type groupResolver struct{ *Resolver }
+type shareResolver struct{ *Resolver }
+type taskResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
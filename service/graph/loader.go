@@ -0,0 +1,55 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/graph-gophers/dataloader/v7"
+	"github.com/samber/lo"
+)
+
+type loadersCtx struct{}
+
+// loaders batch loads relations that are not eager loaded with the query of their sources. They live
+// within one request, so that loaded objects are never shared between users.
+type loaders struct {
+	users *dataloader.Loader[int, *ent.User]
+}
+
+func newLoaders() *loaders {
+	return &loaders{
+		users: dataloader.NewBatchedLoader(batchUsers),
+	}
+}
+
+func withLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersCtx{}, newLoaders())
+}
+
+func loadersFromContext(ctx context.Context) *loaders {
+	return ctx.Value(loadersCtx{}).(*loaders)
+}
+
+// loadUser loads the user with given ID, owners of files and tasks in the same response are loaded
+// in one query.
+func loadUser(ctx context.Context, uid int) (*ent.User, error) {
+	return loadersFromContext(ctx).users.Load(ctx, uid)()
+}
+
+func batchUsers(ctx context.Context, ids []int) []*dataloader.Result[*ent.User] {
+	users, err := dependency.FromContext(ctx).UserClient().GetByIDs(context.WithValue(ctx, inventory.LoadUserGroup{}, true), ids)
+	if err != nil {
+		err = loadError(ctx, "users", err)
+		return lo.Map(ids, func(_ int, _ int) *dataloader.Result[*ent.User] {
+			return &dataloader.Result[*ent.User]{Error: err}
+		})
+	}
+
+	// Deleted users are resolved as null.
+	byID := lo.KeyBy(users, func(u *ent.User) int { return u.ID })
+	return lo.Map(ids, func(id int, _ int) *dataloader.Result[*ent.User] {
+		return &dataloader.Result[*ent.User]{Data: byID[id]}
+	})
+}
@@ -0,0 +1,50 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graph
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type FileType string
+
+const (
+	FileTypeFile   FileType = "file"
+	FileTypeFolder FileType = "folder"
+)
+
+var AllFileType = []FileType{
+	FileTypeFile,
+	FileTypeFolder,
+}
+
+func (e FileType) IsValid() bool {
+	switch e {
+	case FileTypeFile, FileTypeFolder:
+		return true
+	}
+	return false
+}
+
+func (e FileType) String() string {
+	return string(e)
+}
+
+func (e *FileType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FileType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FileType", str)
+	}
+	return nil
+}
+
+func (e FileType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
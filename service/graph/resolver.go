@@ -0,0 +1,85 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+)
+
+//go:generate go run -mod=mod github.com/99designs/gqlgen generate
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var errPermissionDenied = errors.New("permission denied")
+
+// Resolver is the root resolver of the GraphQL API. Dependency and current user are read from the
+// request context, the same way as HTTP handlers do.
+type Resolver struct{}
+
+// private resolves fields with @private directive, they are only visible to the user and admins.
+func private(ctx context.Context, obj any, next graphql.Resolver) (any, error) {
+	owner, ok := obj.(*ent.User)
+	if !ok {
+		return nil, fmt.Errorf("@private is not supported on %T", obj)
+	}
+
+	u := inventory.UserFromContext(ctx)
+	if u.ID != owner.ID && !isAdmin(u) {
+		return nil, nil
+	}
+
+	return next(ctx)
+}
+
+// targetUser returns the user ID in `userId` argument, defaults to current user. Only admins can
+// query other users.
+func targetUser(ctx context.Context, userID *string) (int, error) {
+	u := inventory.UserFromContext(ctx)
+	if userID == nil {
+		return u.ID, nil
+	}
+
+	uid, err := dependency.FromContext(ctx).HashIDEncoder().Decode(*userID, hashid.UserID)
+	if err != nil {
+		return 0, errors.New("invalid user ID")
+	}
+
+	if uid != u.ID && !isAdmin(u) {
+		return 0, errPermissionDenied
+	}
+
+	return uid, nil
+}
+
+func isAdmin(u *ent.User) bool {
+	return u != nil && u.Edges.Group != nil && u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin))
+}
+
+// pageSize validates `first` argument of connections.
+func pageSize(first *int) (*int, error) {
+	if first == nil {
+		size := defaultPageSize
+		return &size, nil
+	}
+
+	if *first < 1 || *first > maxPageSize {
+		return nil, fmt.Errorf("first must be between 1 and %d", maxPageSize)
+	}
+
+	return first, nil
+}
+
+func loadError(ctx context.Context, name string, err error) error {
+	dependency.FromContext(ctx).Logger().Warning("Failed to load %s for GraphQL query: %s", name, err)
+	return fmt.Errorf("failed to load %s", name)
+}
@@ -0,0 +1,214 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/contrib/entgql"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/file"
+	"github.com/cloudreve/Cloudreve/v4/ent/share"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/service/explorer"
+)
+
+// Type is the resolver for the type field.
+func (r *fileResolver) Type(ctx context.Context, obj *ent.File) (FileType, error) {
+	if types.FileType(obj.Type) == types.FileTypeFolder {
+		return FileTypeFolder, nil
+	}
+	return FileTypeFile, nil
+}
+
+// Owner is the resolver for the owner field.
+func (r *fileResolver) Owner(ctx context.Context, obj *ent.File) (*ent.User, error) {
+	return loadUser(ctx, obj.OwnerID)
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*ent.User, error) {
+	return inventory.UserFromContext(ctx), nil
+}
+
+// User is the resolver for the user field.
+func (r *queryResolver) User(ctx context.Context, id string) (*ent.User, error) {
+	dep := dependency.FromContext(ctx)
+	uid, err := dep.HashIDEncoder().Decode(id, hashid.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	q, err := dep.DBClient().User.Query().Where(user.ID(uid)).CollectFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := q.Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, loadError(ctx, "user", err)
+	}
+
+	return u, nil
+}
+
+// Users is the resolver for the users field.
+func (r *queryResolver) Users(ctx context.Context, first *int, after *entgql.Cursor[int], groupID *string, status *user.Status) (*ent.UserConnection, error) {
+	if !isAdmin(inventory.UserFromContext(ctx)) {
+		return nil, errPermissionDenied
+	}
+
+	first, err := pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+
+	dep := dependency.FromContext(ctx)
+	q := dep.DBClient().User.Query()
+	if groupID != nil {
+		gid, err := dep.HashIDEncoder().Decode(*groupID, hashid.GroupID)
+		if err != nil {
+			return nil, errors.New("invalid group ID")
+		}
+		q.Where(user.GroupUsers(gid))
+	}
+	if status != nil {
+		q.Where(user.StatusEQ(*status))
+	}
+
+	res, err := q.Paginate(ctx, after, first, nil, nil)
+	if err != nil {
+		return nil, loadError(ctx, "users", err)
+	}
+
+	return res, nil
+}
+
+// Files is the resolver for the files field.
+func (r *queryResolver) Files(ctx context.Context, first *int, after *entgql.Cursor[int], userID *string, name *string) (*ent.FileConnection, error) {
+	uid, err := targetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	first, err = pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+
+	q := dependency.FromContext(ctx).DBClient().File.Query().
+		Where(file.OwnerID(uid), file.Type(int(types.FileTypeFile)), file.IsSymbolic(false))
+	if name != nil && *name != "" {
+		q.Where(file.NameContainsFold(*name))
+	}
+
+	res, err := q.Paginate(ctx, after, first, nil, nil)
+	if err != nil {
+		return nil, loadError(ctx, "files", err)
+	}
+
+	return res, nil
+}
+
+// Shares is the resolver for the shares field.
+func (r *queryResolver) Shares(ctx context.Context, first *int, after *entgql.Cursor[int], userID *string) (*ent.ShareConnection, error) {
+	uid, err := targetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	first, err = pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := dependency.FromContext(ctx).DBClient().Share.Query().
+		Where(share.HasUserWith(user.ID(uid))).
+		Paginate(ctx, after, first, nil, nil)
+	if err != nil {
+		return nil, loadError(ctx, "shares", err)
+	}
+
+	return res, nil
+}
+
+// Tasks is the resolver for the tasks field.
+func (r *queryResolver) Tasks(ctx context.Context, first *int, after *entgql.Cursor[int], userID *string, status []task.Status, deadLettered *bool) (*ent.TaskConnection, error) {
+	uid, err := targetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	first, err = pageSize(first)
+	if err != nil {
+		return nil, err
+	}
+
+	q := dependency.FromContext(ctx).DBClient().Task.Query().Where(task.UserTasks(uid))
+	if len(status) > 0 {
+		q.Where(task.StatusIn(status...))
+	}
+	if deadLettered != nil && *deadLettered {
+		q.Where(task.DeadLetteredAtNotNil())
+	}
+
+	res, err := q.Paginate(ctx, after, first, nil, nil)
+	if err != nil {
+		return nil, loadError(ctx, "tasks", err)
+	}
+
+	return res, nil
+}
+
+// URL is the resolver for the url field.
+func (r *shareResolver) URL(ctx context.Context, obj *ent.Share) (string, error) {
+	dep := dependency.FromContext(ctx)
+	return explorer.BuildShareLink(obj, dep.HashIDEncoder(), dep.SettingProvider().SiteURL(ctx)), nil
+}
+
+// PasswordProtected is the resolver for the passwordProtected field.
+func (r *shareResolver) PasswordProtected(ctx context.Context, obj *ent.Share) (bool, error) {
+	return obj.Password != "", nil
+}
+
+// RetryCount is the resolver for the retryCount field.
+func (r *taskResolver) RetryCount(ctx context.Context, obj *ent.Task) (int, error) {
+	if obj.PublicState == nil {
+		return 0, nil
+	}
+	return obj.PublicState.RetryCount, nil
+}
+
+// Error is the resolver for the error field.
+func (r *taskResolver) Error(ctx context.Context, obj *ent.Task) (*string, error) {
+	if obj.PublicState == nil || obj.PublicState.Error == "" {
+		return nil, nil
+	}
+	return &obj.PublicState.Error, nil
+}
+
+// DeadLettered is the resolver for the deadLettered field.
+func (r *taskResolver) DeadLettered(ctx context.Context, obj *ent.Task) (bool, error) {
+	return obj.DeadLetteredAt != nil, nil
+}
+
+// Owner is the resolver for the owner field.
+func (r *taskResolver) Owner(ctx context.Context, obj *ent.Task) (*ent.User, error) {
+	return loadUser(ctx, obj.UserTasks)
+}
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+type queryResolver struct{ *Resolver }
@@ -0,0 +1,137 @@
+// Package graph serves a read-only GraphQL API of files, shares, users and tasks for dashboard and
+// reporting clients. Types and pagination are generated from ent schemas by entgql, executor is
+// generated by gqlgen.
+package graph
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/task"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/gin-gonic/gin"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/lexer"
+)
+
+const (
+	// maxComplexity limits the number of fields resolved by a query, fields of connections are counted
+	// once per requested node.
+	maxComplexity = 5000
+	// maxTokens limits the size of a document, cost of validating a document grows quadratically
+	// with the number of fields.
+	maxTokens = 1000
+	// queryCacheSize is the number of parsed documents cached.
+	queryCacheSize = 1000
+)
+
+type (
+	// Request is the body of GraphQL queries.
+	Request struct {
+		Query         string         `json:"query" binding:"required"`
+		OperationName string         `json:"operationName"`
+		Variables     map[string]any `json:"variables"`
+	}
+)
+
+var (
+	execSchema = NewExecutableSchema(Config{
+		Resolvers:  &Resolver{},
+		Directives: DirectiveRoot{Private: private},
+		Complexity: complexity(),
+	})
+	server = newServer()
+)
+
+func newServer() *handler.Server {
+	srv := handler.New(execSchema)
+	srv.AddTransport(transport.POST{})
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](queryCacheSize))
+	srv.Use(documentLimit{})
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.FixedComplexityLimit(maxComplexity))
+	srv.SetRecoverFunc(func(ctx context.Context, err any) error {
+		dependency.FromContext(ctx).Logger().Error("Panic while resolving GraphQL query: %v", err)
+		return errors.New("internal server error")
+	})
+	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		return next(withLoaders(ctx))
+	})
+	return srv
+}
+
+// Execute executes the GraphQL query in request body as current user, the result is written in
+// GraphQL response format.
+func Execute(c *gin.Context) {
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+// SDL returns the GraphQL schema in SDL.
+func SDL() string {
+	var sb strings.Builder
+	formatter.NewFormatter(&sb).FormatSchema(execSchema.Schema())
+	return sb.String()
+}
+
+// documentLimit rejects documents with more than maxTokens tokens before they are parsed.
+type documentLimit struct{}
+
+func (documentLimit) ExtensionName() string {
+	return "DocumentLimit"
+}
+
+func (documentLimit) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (documentLimit) MutateOperationParameters(ctx context.Context, params *graphql.RawParams) *gqlerror.Error {
+	l := lexer.New(&ast.Source{Input: params.Query})
+	for i := 0; ; i++ {
+		tok, err := l.ReadToken()
+		// Syntax errors are reported by parser.
+		if err != nil || tok.Kind == lexer.EOF {
+			return nil
+		}
+
+		if i >= maxTokens {
+			return gqlerror.Errorf("document exceeds maximum of %d tokens", maxTokens)
+		}
+	}
+}
+
+// complexity counts fields of connections once per requested node, so that aliasing large pages
+// cannot bypass maxComplexity.
+func complexity() ComplexityRoot {
+	var c ComplexityRoot
+	c.Query.Users = func(childComplexity int, first *int, _ *ent.Cursor, _ *string, _ *user.Status) int {
+		return pageComplexity(childComplexity, first)
+	}
+	c.Query.Files = func(childComplexity int, first *int, _ *ent.Cursor, _ *string, _ *string) int {
+		return pageComplexity(childComplexity, first)
+	}
+	c.Query.Shares = func(childComplexity int, first *int, _ *ent.Cursor, _ *string) int {
+		return pageComplexity(childComplexity, first)
+	}
+	c.Query.Tasks = func(childComplexity int, first *int, _ *ent.Cursor, _ *string, _ []task.Status, _ *bool) int {
+		return pageComplexity(childComplexity, first)
+	}
+	return c
+}
+
+func pageComplexity(childComplexity int, first *int) int {
+	size := defaultPageSize
+	if first != nil && *first > 0 {
+		size = *first
+	}
+	return 1 + childComplexity*size
+}
@@ -0,0 +1,179 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	_ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type testResponse struct {
+	Data   map[string]any `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func execute(t *testing.T, ctx context.Context, query string) *testResponse {
+	body, _ := json.Marshal(Request{Query: query})
+	req := httptest.NewRequestWithContext(ctx, http.MethodPost, "/graphql", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+
+	res := &testResponse{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), res))
+	return res
+}
+
+func TestExecute(t *testing.T) {
+	a := assert.New(t)
+	drv, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	encoder, err := hashid.New("test-salt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	group := client.Group.Create().SetName("users").SetPermissions(&boolset.BooleanSet{}).SaveX(ctx)
+	owner := client.User.Create().SetEmail("owner@cloudreve.org").SetNick("owner").SetGroupUsers(group.ID).SaveX(ctx)
+	other := client.User.Create().SetEmail("other@cloudreve.org").SetNick("other").SetGroupUsers(group.ID).SaveX(ctx)
+	client.File.Create().SetType(int(types.FileTypeFolder)).SetName("folder").SetOwnerID(owner.ID).SaveX(ctx)
+	for _, name := range []string{"a.txt", "b.txt", "c.txt"} {
+		client.File.Create().SetType(int(types.FileTypeFile)).SetName(name).SetOwnerID(owner.ID).SetSize(1).SaveX(ctx)
+	}
+
+	client.Task.Create().SetType("test").SetCorrelationID(uuid.Must(uuid.NewV4())).SetPublicState(&types.TaskPublicState{RetryCount: 2}).SetUserTasks(owner.ID).SaveX(ctx)
+
+	ctx = context.WithValue(ctx, dependency.DepCtx{}, dependency.NewDependency(
+		dependency.WithDbClient(client),
+		dependency.WithHashIDEncoder(encoder),
+		dependency.WithLogger(logging.NewConsoleLogger(logging.LevelError)),
+	))
+	owner.Edges.Group = group
+	ownerCtx := context.WithValue(ctx, inventory.UserCtx{}, owner)
+
+	// Files of current user are paginated, owners are batch loaded
+	res := execute(t, ownerCtx, `{ files(first: 2) { totalCount pageInfo { hasNextPage endCursor } edges { node { id name type owner { nick } } } } }`)
+	a.Empty(res.Errors)
+	files := res.Data["files"].(map[string]any)
+	a.EqualValues(3, files["totalCount"])
+	a.Equal(true, files["pageInfo"].(map[string]any)["hasNextPage"])
+	edges := files["edges"].([]any)
+	if a.Len(edges, 2) {
+		node := edges[0].(map[string]any)["node"].(map[string]any)
+		a.Equal("a.txt", node["name"])
+		a.Equal("file", node["type"])
+		a.Equal(map[string]any{"nick": "owner"}, node["owner"])
+	}
+
+	// Next page
+	cursor := files["pageInfo"].(map[string]any)["endCursor"].(string)
+	res = execute(t, ownerCtx, `{ files(after: "`+cursor+`") { edges { node { name } } } }`)
+	a.Empty(res.Errors)
+	a.Len(res.Data["files"].(map[string]any)["edges"], 1)
+
+	// Tasks of current user
+	res = execute(t, ownerCtx, `{ tasks { edges { node { type status retryCount deadLettered owner { nick } } } } }`)
+	a.Empty(res.Errors)
+	a.Equal([]any{map[string]any{"node": map[string]any{
+		"type": "test", "status": "queued", "retryCount": float64(2), "deadLettered": false, "owner": map[string]any{"nick": "owner"},
+	}}}, res.Data["tasks"].(map[string]any)["edges"])
+
+	// Private fields of other users are null
+	res = execute(t, ownerCtx, `{ user(id: "`+hashid.EncodeUserID(encoder, other.ID)+`") { nick email } }`)
+	a.Empty(res.Errors)
+	a.Equal(map[string]any{"nick": "other", "email": nil}, res.Data["user"])
+
+	// Only admins can query other users
+	res = execute(t, ownerCtx, `{ files(userId: "`+hashid.EncodeUserID(encoder, other.ID)+`") { totalCount } }`)
+	a.NotEmpty(res.Errors)
+	res = execute(t, ownerCtx, `{ users { totalCount } }`)
+	a.NotEmpty(res.Errors)
+}
+
+func TestExecute_Limits(t *testing.T) {
+	a := assert.New(t)
+
+	// Introspection is allowed
+	res := execute(t, context.Background(), `{ __schema { queryType { name } } }`)
+	a.Empty(res.Errors)
+
+	// Fields of connections are counted once per node
+	var sb strings.Builder
+	sb.WriteString("{")
+	for _, alias := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		sb.WriteString(alias + `: files(first: 100) { edges { node { id name size createdAt } } } `)
+	}
+	sb.WriteString("}")
+	res = execute(t, context.Background(), sb.String())
+	if a.Len(res.Errors, 1) {
+		a.Contains(res.Errors[0].Message, "complexity")
+	}
+
+	// Documents are limited in size
+	res = execute(t, context.Background(), "{"+strings.Repeat(" __typename", maxTokens)+" }")
+	if a.Len(res.Errors, 1) {
+		a.Contains(res.Errors[0].Message, "maximum of 1000 tokens")
+	}
+}
+
+func TestPrivate(t *testing.T) {
+	a := assert.New(t)
+	admin := &boolset.BooleanSet{}
+	boolset.Set(types.GroupPermissionIsAdmin, true, admin)
+	owner := &ent.User{ID: 1, Edges: ent.UserEdges{Group: &ent.Group{Permissions: &boolset.BooleanSet{}}}}
+	other := &ent.User{ID: 2, Edges: ent.UserEdges{Group: &ent.Group{Permissions: &boolset.BooleanSet{}}}}
+	adminUser := &ent.User{ID: 3, Edges: ent.UserEdges{Group: &ent.Group{Permissions: admin}}}
+	next := func(ctx context.Context) (any, error) { return "secret", nil }
+
+	for _, c := range []struct {
+		requester *ent.User
+		expected  any
+	}{
+		{owner, "secret"},
+		{other, nil},
+		{adminUser, "secret"},
+	} {
+		res, err := private(context.WithValue(context.Background(), inventory.UserCtx{}, c.requester), owner, next)
+		a.NoError(err)
+		a.Equal(c.expected, res)
+	}
+}
+
+func TestPageSize(t *testing.T) {
+	a := assert.New(t)
+
+	size, err := pageSize(nil)
+	a.NoError(err)
+	a.Equal(defaultPageSize, *size)
+
+	for _, first := range []int{0, maxPageSize + 1} {
+		_, err = pageSize(&first)
+		a.Error(err)
+	}
+}
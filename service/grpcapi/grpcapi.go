@@ -0,0 +1,615 @@
+// Package grpcapi implements the gRPC API defined by pkg/apirpc on master nodes.
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/apirpc"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/requestinfo"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/grouppolicy"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/service/explorer"
+	"github.com/cloudreve/Cloudreve/v4/service/share"
+	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+var correlationIDKey = strings.ToLower(request.CorrelationHeader)
+
+// NewServer creates the gRPC API implementation.
+func NewServer(dep dependency.Dep) *Server {
+	return &Server{dep: dep}
+}
+
+// Server implements apirpc.APIServer.
+type Server struct {
+	apirpc.UnimplementedAPIServer
+	dep dependency.Dep
+}
+
+// VerifyToken verifies the personal access token of a call.
+func (s *Server) VerifyToken(ctx context.Context, token string) (int, []string, error) {
+	return s.dep.TokenAuth().VerifyAccessToken(ctx, token)
+}
+
+// Context builds the handling context of a call, the same way as InitializeHandling and CurrentUser
+// middlewares do for HTTP requests.
+func (s *Server) Context(ctx context.Context, md metadata.MD, uid int, scopes []string) (context.Context, error) {
+	first := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+
+	cid := uuid.FromStringOrNil(first(correlationIDKey))
+	if cid == uuid.Nil {
+		cid = uuid.Must(uuid.NewV4())
+	}
+
+	reqInfo := &requestinfo.RequestInfo{UserAgent: first("user-agent")}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		reqInfo.IP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(reqInfo.IP); err == nil {
+			reqInfo.IP = host
+		}
+	}
+
+	l := s.dep.Logger().CopyWithField(logging.FieldCorrelationID, cid.String())
+	ctx = s.dep.ForkWithLogger(ctx, l)
+	ctx = context.WithValue(ctx, logging.CorrelationIDCtx{}, cid)
+	ctx = context.WithValue(ctx, requestinfo.RequestInfoCtx{}, reqInfo)
+	ctx = context.WithValue(ctx, logging.LoggerCtx{}, l)
+
+	user, err := s.dep.UserClient().GetLoginUserByID(ctx, uid)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "failed to get login user", err)
+	}
+
+	ctx = context.WithValue(ctx, inventory.UserCtx{}, user)
+	ctx = context.WithValue(ctx, auth.AccessTokenScopesCtx{}, scopes)
+	s.dep.StatsRecorder().Active(uid)
+	return ctx, nil
+}
+
+func (s *Server) GetFile(ctx context.Context, req *apirpc.GetFileRequest) (*apirpc.File, error) {
+	m := manager.NewFileManager(s.dep, inventory.UserFromContext(ctx))
+	defer m.Recycle()
+
+	uri, err := parseUri(req.Uri)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := m.Get(ctx, uri, dbfs.WithNotRoot())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toFile(file), nil
+}
+
+func (s *Server) ListFiles(ctx context.Context, req *apirpc.ListFilesRequest) (*apirpc.ListFilesResponse, error) {
+	uri, err := parseUri(req.Uri)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.list(ctx, uri, &manager.ListArgs{
+		Page:           int(req.Page),
+		PageSize:       int(req.PageSize),
+		PageToken:      req.NextPageToken,
+		Order:          req.OrderBy,
+		OrderDirection: req.OrderDirection,
+	})
+}
+
+func (s *Server) Search(ctx context.Context, req *apirpc.SearchRequest) (*apirpc.ListFilesResponse, error) {
+	uri, err := parseUri(req.Uri)
+	if err != nil {
+		return nil, err
+	}
+
+	q := url.Values{}
+	q[fs.QuerySearchName] = req.Name
+	setFlag := func(key string, enabled bool) {
+		if enabled {
+			q.Set(key, "")
+		}
+	}
+	setFlag(fs.QuerySearchNameOpOr, req.NameOperatorOr)
+	setFlag(fs.QuerySearchCaseFolding, req.CaseFolding)
+	setFlag(fs.QuerySearchFullText, req.FullText)
+	setString := func(key, value string) {
+		if value != "" {
+			q.Set(key, value)
+		}
+	}
+	setString(fs.QuerySearchType, req.Type)
+	setString(fs.QuerySearchTypeCategory, req.Category)
+	for k, v := range req.Metadata {
+		q.Set(fs.QuerySearchMetadataPrefix+k, v)
+	}
+	setInt := func(key string, value int64) {
+		if value != 0 {
+			q.Set(key, strconv.FormatInt(value, 10))
+		}
+	}
+	setInt(fs.QuerySearchSizeGte, req.SizeGte)
+	setInt(fs.QuerySearchSizeLte, req.SizeLte)
+	setInt(fs.QuerySearchCreatedGte, req.CreatedGte)
+	setInt(fs.QuerySearchCreatedLte, req.CreatedLte)
+	setInt(fs.QuerySearchUpdatedGte, req.UpdatedGte)
+	setInt(fs.QuerySearchUpdatedLte, req.UpdatedLte)
+
+	uri = uri.SetQuery(q.Encode())
+	if uri.SearchParameters() == nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "At least one search condition is required", nil)
+	}
+
+	return s.list(ctx, uri, &manager.ListArgs{
+		Page:      int(req.Page),
+		PageSize:  int(req.PageSize),
+		PageToken: req.NextPageToken,
+	})
+}
+
+func (s *Server) list(ctx context.Context, uri *fs.URI, args *manager.ListArgs) (*apirpc.ListFilesResponse, error) {
+	m := manager.NewFileManager(s.dep, inventory.UserFromContext(ctx))
+	defer m.Recycle()
+
+	if args.PageSize <= 0 {
+		args.PageSize = defaultPageSize
+	}
+	args.PageSize = min(args.PageSize, maxPageSize)
+
+	parent, res, err := m.List(ctx, uri, args)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &apirpc.ListFilesResponse{Files: lo.Map(res.Files, func(f fs.File, _ int) *apirpc.File {
+		return s.toFile(f)
+	})}
+	if parent != nil {
+		resp.Parent = s.toFile(parent)
+	}
+	if res.Pagination != nil {
+		resp.Page = int32(res.Pagination.Page)
+		resp.TotalItems = int32(res.Pagination.TotalItems)
+		resp.NextPageToken = res.Pagination.NextPageToken
+	}
+
+	return resp, nil
+}
+
+func (s *Server) CreateFolder(ctx context.Context, req *apirpc.CreateFolderRequest) (*apirpc.File, error) {
+	m := manager.NewFileManager(s.dep, inventory.UserFromContext(ctx))
+	defer m.Recycle()
+
+	uri, err := parseUri(req.Uri)
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []fs.Option
+	if req.ErrorOnConflict {
+		opts = append(opts, dbfs.WithErrorOnConflict())
+	}
+
+	file, err := m.Create(ctx, uri, types.FileTypeFolder, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toFile(file), nil
+}
+
+func (s *Server) Rename(ctx context.Context, req *apirpc.RenameRequest) (*apirpc.File, error) {
+	m := manager.NewFileManager(s.dep, inventory.UserFromContext(ctx))
+	defer m.Recycle()
+
+	uri, err := parseUri(req.Uri)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := m.Rename(ctx, uri, req.NewName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toFile(file), nil
+}
+
+func (s *Server) Move(ctx context.Context, req *apirpc.MoveRequest) (*emptypb.Empty, error) {
+	m := manager.NewFileManager(s.dep, inventory.UserFromContext(ctx))
+	defer m.Recycle()
+
+	uris, err := s.parseUris(ctx, req.Uris)
+	if err != nil {
+		return nil, err
+	}
+
+	dst, err := parseUri(req.Dst)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, m.MoveOrCopy(ctx, uris, dst, req.Copy)
+}
+
+func (s *Server) Delete(ctx context.Context, req *apirpc.DeleteRequest) (*emptypb.Empty, error) {
+	m := manager.NewFileManager(s.dep, inventory.UserFromContext(ctx))
+	defer m.Recycle()
+
+	uris, err := s.parseUris(ctx, req.Uris)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, m.Delete(ctx, uris)
+}
+
+func (s *Server) CreateUploadSession(ctx context.Context, req *apirpc.CreateUploadSessionRequest) (*apirpc.UploadSession, error) {
+	service := &explorer.CreateUploadSessionService{
+		Uri:          req.Uri,
+		Size:         req.Size,
+		LastModified: req.LastModified,
+		MimeType:     req.MimeType,
+		PolicyID:     req.PolicyId,
+		Metadata:     req.Metadata,
+	}
+	res, err := service.Create(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &apirpc.UploadSession{
+		SessionId:      res.SessionID,
+		UploadId:       res.UploadID,
+		ChunkSize:      res.ChunkSize,
+		Expires:        res.Expires,
+		UploadUrls:     res.UploadURLs,
+		Credential:     res.Credential,
+		AccessKey:      res.AccessKey,
+		KeyTime:        res.KeyTime,
+		CompleteUrl:    res.CompleteURL,
+		Uri:            res.Uri,
+		CallbackSecret: res.CallbackSecret,
+		MimeType:       res.MimeType,
+		UploadPolicy:   res.UploadPolicy,
+	}
+	if res.StoragePolicy != nil {
+		session.StoragePolicy = &apirpc.StoragePolicy{
+			Id:      res.StoragePolicy.ID,
+			Name:    res.StoragePolicy.Name,
+			Type:    string(res.StoragePolicy.Type),
+			MaxSize: res.StoragePolicy.MaxSize,
+			Relay:   res.StoragePolicy.Relay,
+		}
+	}
+
+	return session, nil
+}
+
+func (s *Server) DeleteUploadSession(ctx context.Context, req *apirpc.DeleteUploadSessionRequest) (*emptypb.Empty, error) {
+	m := manager.NewFileManager(s.dep, inventory.UserFromContext(ctx))
+	defer m.Recycle()
+
+	uri, err := parseUri(req.Uri)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, m.CancelUploadSession(ctx, uri, req.SessionId)
+}
+
+func (s *Server) CreateShare(ctx context.Context, req *apirpc.CreateShareRequest) (*apirpc.Share, error) {
+	user := inventory.UserFromContext(ctx)
+	m := manager.NewFileManager(s.dep, user)
+	defer m.Recycle()
+
+	if !user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionShare)) {
+		return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Group permission denied", nil)
+	}
+
+	uri, err := parseUri(req.Uri)
+	if err != nil {
+		return nil, err
+	}
+
+	var expires *time.Time
+	if req.Expire > 0 {
+		expires = lo.ToPtr(time.Now().Add(time.Duration(req.Expire) * time.Second))
+	}
+
+	policyReq := &grouppolicy.Request{Action: types.GroupPolicyActionShare}
+	if err := s.dep.GroupPolicyChecker().Consume(ctx, user, policyReq); err != nil {
+		return nil, err
+	}
+
+	created, err := m.CreateOrUpdateShare(ctx, uri, &manager.CreateShareArgs{
+		IsPrivate:       req.IsPrivate,
+		RemainDownloads: int(req.RemainDownloads),
+		Expire:          expires,
+	})
+	if err != nil {
+		s.dep.GroupPolicyChecker().Release(ctx, user, policyReq)
+		return nil, err
+	}
+
+	return s.toShare(ctx, created), nil
+}
+
+func (s *Server) ListShares(ctx context.Context, req *apirpc.ListSharesRequest) (*apirpc.ListSharesResponse, error) {
+	user := inventory.UserFromContext(ctx)
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	ctx = context.WithValue(ctx, inventory.LoadShareFile{}, true)
+	res, err := s.dep.ShareClient().List(ctx, &inventory.ListShareArgs{
+		PaginationArgs: &inventory.PaginationArgs{
+			UseCursorPagination: true,
+			PageToken:           req.NextPageToken,
+			PageSize:            min(pageSize, maxPageSize),
+			Order:               inventory.OrderDirection(req.OrderDirection),
+			OrderBy:             req.OrderBy,
+		},
+		UserID: user.ID,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list shares", err)
+	}
+
+	resp := &apirpc.ListSharesResponse{Shares: lo.Map(res.Shares, func(item *ent.Share, _ int) *apirpc.Share {
+		return s.toShare(ctx, item)
+	})}
+	if res.PaginationResults != nil {
+		resp.NextPageToken = res.PaginationResults.NextPageToken
+	}
+
+	return resp, nil
+}
+
+func (s *Server) DeleteShare(ctx context.Context, req *apirpc.DeleteShareRequest) (*emptypb.Empty, error) {
+	id, err := s.dep.HashIDEncoder().Decode(req.Id, hashid.ShareID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Unknown share ID", err)
+	}
+
+	return &emptypb.Empty{}, share.DeleteShare(ctx, id)
+}
+
+func (s *Server) Upload(stream apirpc.API_UploadServer) error {
+	ctx := stream.Context()
+	m := manager.NewFileManager(s.dep, inventory.UserFromContext(ctx))
+	defer m.Recycle()
+
+	frame, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	uri, err := parseUri(frame.Uri)
+	if err != nil {
+		return err
+	}
+
+	if frame.Size < 0 {
+		return serializer.NewError(serializer.CodeParamErr, "Invalid file size", nil)
+	}
+
+	req := &fs.UploadRequest{
+		Props: &fs.UploadProps{
+			Uri:             uri,
+			Size:            frame.Size,
+			PreviousVersion: frame.PreviousVersion,
+		},
+		File: io.NopCloser(&frameReader{stream: stream, buf: frame.Data, remain: frame.Size}),
+		Mode: fs.ModeOverwrite,
+	}
+	if frame.LastModified > 0 {
+		req.Props.LastModified = lo.ToPtr(time.UnixMilli(frame.LastModified))
+	}
+
+	file, err := m.Update(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(s.toFile(file))
+}
+
+func (s *Server) Download(req *apirpc.DownloadRequest, stream apirpc.API_DownloadServer) error {
+	ctx := stream.Context()
+	m := manager.NewFileManager(s.dep, inventory.UserFromContext(ctx))
+	defer m.Recycle()
+
+	uri, err := parseUri(req.Uri)
+	if err != nil {
+		return err
+	}
+
+	file, err := m.Get(ctx, uri, dbfs.WithFileEntities(), dbfs.WithNotRoot(),
+		dbfs.WithRequiredCapabilities(dbfs.NavigatorCapabilityDownloadFile))
+	if err != nil {
+		return err
+	}
+
+	if file.Type() != types.FileTypeFile {
+		return fs.ErrEntityNotExist
+	}
+
+	entity := file.PrimaryEntity()
+	if req.Entity != "" {
+		entityID, err := s.dep.HashIDEncoder().Decode(req.Entity, hashid.EntityID)
+		if err != nil {
+			return serializer.NewError(serializer.CodeParamErr, "Unknown entity ID", err)
+		}
+
+		entity, _ = lo.Find(file.Entities(), func(e fs.Entity) bool { return e.ID() == entityID })
+	}
+
+	if entity == nil {
+		return fs.ErrEntityNotExist
+	}
+
+	if req.Offset < 0 || req.Offset > entity.Size() {
+		return serializer.NewError(serializer.CodeParamErr, "Offset is out of file size", nil)
+	}
+
+	es, err := m.GetEntitySource(ctx, 0, fs.WithEntity(entity))
+	if err != nil {
+		return err
+	}
+	defer es.Close()
+
+	if _, err := es.Seek(req.Offset, io.SeekStart); err != nil {
+		return serializer.NewError(serializer.CodeIOFailed, "Failed to seek file", err)
+	}
+
+	frame := &apirpc.DownloadFrame{Name: file.DisplayName(), Size: entity.Size()}
+	buf := make([]byte, apirpc.FrameSize)
+	for first := true; ; first = false {
+		n, err := io.ReadFull(es, buf)
+		if n > 0 || first {
+			frame.Data = buf[:n]
+			if err := stream.Send(frame); err != nil {
+				return err
+			}
+			frame = &apirpc.DownloadFrame{}
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+
+		if err != nil {
+			return serializer.NewError(serializer.CodeIOFailed, "Failed to read file", err)
+		}
+	}
+}
+
+func (s *Server) toFile(f fs.File) *apirpc.File {
+	hasher := s.dep.HashIDEncoder()
+	return &apirpc.File{
+		Id:            hashid.EncodeFileID(hasher, f.ID()),
+		Type:          apirpc.FileType(f.Type()),
+		Name:          f.DisplayName(),
+		Uri:           f.Uri(false).String(),
+		Size:          f.Size(),
+		CreatedAt:     timestamppb.New(f.CreatedAt()),
+		UpdatedAt:     timestamppb.New(f.UpdatedAt()),
+		Metadata:      f.Metadata(),
+		PrimaryEntity: hashid.EncodeEntityID(hasher, f.PrimaryEntityID()),
+	}
+}
+
+func (s *Server) toShare(ctx context.Context, item *ent.Share) *apirpc.Share {
+	hasher := s.dep.HashIDEncoder()
+	res := &apirpc.Share{
+		Id:         hashid.EncodeShareID(hasher, item.ID),
+		Url:        explorer.BuildShareLink(item, hasher, s.dep.SettingProvider().SiteURL(ctx)),
+		IsPrivate:  item.Password != "",
+		Visited:    int32(item.Views),
+		Downloaded: int32(item.Downloads),
+		CreatedAt:  timestamppb.New(item.CreatedAt),
+	}
+	if item.RemainDownloads != nil {
+		res.RemainDownloads = lo.ToPtr(int32(*item.RemainDownloads))
+	}
+	if item.Expires != nil {
+		res.Expires = timestamppb.New(*item.Expires)
+	}
+	if item.Edges.File != nil {
+		res.Uri = hashid.EncodeFileID(hasher, item.Edges.File.ID)
+	}
+
+	return res
+}
+
+func (s *Server) parseUris(ctx context.Context, raw []string) ([]*fs.URI, error) {
+	if limit := s.dep.SettingProvider().MaxBatchedFile(ctx); len(raw) > limit {
+		return nil, serializer.NewError(serializer.CodeTooManyUris, fmt.Sprintf("Maximum allowed batch size: %d", limit), nil)
+	}
+
+	uris, err := fs.NewUriFromStrings(raw...)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	return uris, nil
+}
+
+func parseUri(raw string) (*fs.URI, error) {
+	uri, err := fs.NewUriFromString(raw)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeParamErr, "unknown uri", err)
+	}
+
+	return uri, nil
+}
+
+// frameReader reads file content from upload frames, the content must be exactly remain bytes.
+type frameReader struct {
+	stream apirpc.API_UploadServer
+	buf    []byte
+	remain int64
+}
+
+func (r *frameReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		frame, err := r.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			if r.remain > 0 {
+				return 0, io.ErrUnexpectedEOF
+			}
+			return 0, io.EOF
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		r.buf = frame.Data
+	}
+
+	if int64(len(r.buf)) > r.remain {
+		return 0, serializer.NewError(serializer.CodeInvalidContentLength, "Uploaded content exceeds declared size", nil)
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	r.remain -= int64(n)
+	return n, nil
+}
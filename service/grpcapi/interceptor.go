@@ -0,0 +1,115 @@
+package grpcapi
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/apirpc"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/requestinfo"
+	"github.com/cloudreve/Cloudreve/v4/pkg/ipaccess"
+	"github.com/cloudreve/Cloudreve/v4/pkg/ratelimit"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"google.golang.org/grpc"
+)
+
+// methodRateLimits lists rate limits of methods, the same as their HTTP routes.
+var methodRateLimits = map[string]string{
+	apirpc.API_Search_FullMethodName:   ratelimit.Search,
+	apirpc.API_Download_FullMethodName: ratelimit.DownloadToken,
+}
+
+// UnaryInterceptor applies API IP access rules, maintenance mode and rate limits to unary calls, the
+// same way as middlewares do for HTTP API. Must run after authentication, see apirpc.NewServer.
+func (s *Server) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := s.guard(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor is like UnaryInterceptor, but for streaming calls.
+func (s *Server) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := s.guard(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func (s *Server) guard(ctx context.Context, fullMethod string) error {
+	settings := s.dep.SettingProvider()
+	var ip net.IP
+	if reqInfo := requestinfo.RequestInfoFromContext(ctx); reqInfo != nil {
+		ip = net.ParseIP(reqInfo.IP)
+	}
+
+	if rule := settings.IPAccess(ctx, ipaccess.API); rule.Allow != "" || rule.Deny != "" {
+		allow, err := ipaccess.ParseCIDRs(rule.Allow)
+		var deny []*net.IPNet
+		if err == nil {
+			deny, err = ipaccess.ParseCIDRs(rule.Deny)
+		}
+
+		if err != nil {
+			// Reject all calls rather than ignoring the rules if they are broken.
+			s.dep.Logger().Warning("Invalid IP access rules of %q: %s", ipaccess.API, err)
+			return serializer.NewError(serializer.CodeNoPermissionErr, "Access from your IP address is not allowed", nil)
+		}
+
+		if !ipaccess.Allowed(ip, allow, deny) {
+			s.dep.Logger().Info("gRPC call from %s is rejected by IP access rules.", ip)
+			return serializer.NewError(serializer.CodeNoPermissionErr, "Access from your IP address is not allowed", nil)
+		}
+	}
+
+	u := inventory.UserFromContext(ctx)
+	if m := settings.Maintenance(ctx); m.Enabled && !u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+		msg := m.Message
+		if msg == "" {
+			msg = "Site is under maintenance, please try again later"
+		}
+		return serializer.NewError(serializer.CodeMaintenance, msg, nil)
+	}
+
+	name, ok := methodRateLimits[fullMethod]
+	if !ok {
+		return nil
+	}
+
+	rule := settings.RateLimit(ctx, name)
+	if rule == nil {
+		return nil
+	}
+
+	// Calls are always authorized by access tokens, which are counted by their owner.
+	subject := "ip" + ip.String()
+	switch rule.Key {
+	case setting.RateLimitKeyUser:
+		subject = "u" + strconv.Itoa(u.ID)
+	case setting.RateLimitKeyToken:
+		subject = "t" + strconv.Itoa(u.ID)
+	}
+
+	res, err := ratelimit.Allow(s.dep.KV(), name, rule, subject, time.Now())
+	if err != nil {
+		// Do not block calls if the KV store is unavailable.
+		s.dep.Logger().Warning("Failed to check rate limit %q: %s", name, err)
+		return nil
+	}
+
+	if !res.Allowed {
+		return serializer.NewError(serializer.CodeTooManyRequests, "Too many requests, please try again later", nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package node
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/mtls"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+)
+
+func init() {
+	crontab.Register(setting.CronTypeNodeCertCheck, CronCheckCertificates)
+}
+
+// nodeCertWebhook is the payload sent to alert webhook for expiring node certificates.
+type nodeCertWebhook struct {
+	Event   string    `json:"event"`
+	NodeID  int       `json:"node_id"`
+	Node    string    `json:"node"`
+	Expires time.Time `json:"expires"`
+}
+
+// CronCheckCertificates reminds admins to rotate client certificates of slave nodes that expire
+// within the configured duration.
+func CronCheckCertificates(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	renewBefore := dep.SettingProvider().ClusterSecurity(ctx).CertRenewBefore
+
+	nodes, err := dep.NodeClient().ListActiveNodes(ctx, nil)
+	if err != nil {
+		l.Error("Failed to list nodes for certificate check: %s", err)
+		return
+	}
+
+	now := time.Now()
+	for _, n := range nodes {
+		if n.Settings == nil || n.Settings.TLS == nil || n.Settings.TLS.Cert == "" {
+			continue
+		}
+
+		expires, err := mtls.CertificateExpiry(n.Settings.TLS.Cert)
+		if err != nil {
+			l.Warning("Failed to parse client certificate of node %q: %s", n.Name, err)
+			continue
+		}
+
+		if expires.Sub(now) > renewBefore {
+			continue
+		}
+
+		if expires.Before(now) {
+			l.Error("Client certificate of node %q expired at %s, please rotate it.", n.Name, expires)
+		} else {
+			l.Warning("Client certificate of node %q expires at %s, please rotate it.", n.Name, expires)
+		}
+
+		sendAlertWebhook(ctx, dep, &nodeCertWebhook{
+			Event:   "node_cert_expiring",
+			NodeID:  n.ID,
+			Node:    n.Name,
+			Expires: expires,
+		})
+	}
+}
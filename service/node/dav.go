@@ -0,0 +1,183 @@
+package node
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/driver/local"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager/entitysource"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/service/explorer"
+	"github.com/gin-gonic/gin"
+)
+
+const davPrefix = "/dav"
+
+type DavAssertionParamCtx struct{}
+
+// davRelayedHeaders are headers of master response relayed to client when a request is rejected by master.
+var davRelayedHeaders = []string{"WWW-Authenticate", "Retry-After", "Content-Type"}
+
+// ServeSlaveWebDAV serves a WebDAV request received by slave node. GET, HEAD and PUT requests are authorized
+// by master with a signed assertion, content stored on this node is then transferred without passing through
+// master. Other requests, and those whose content is stored elsewhere, are proxied to master.
+func ServeSlaveWebDAV(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	slaveConf := dep.ConfigProvider().Slave()
+	master, err := url.Parse(slaveConf.MasterURL)
+	if err != nil {
+		return fmt.Errorf("invalid master URL: %w", err)
+	}
+
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead:
+	case http.MethodPut:
+		// Upload session cannot be created without knowing the size.
+		if c.Request.ContentLength <= 0 {
+			proxyWebDAV(c, master)
+			return nil
+		}
+	default:
+		proxyWebDAV(c, master)
+		return nil
+	}
+
+	header := c.Request.Header.Clone()
+	if c.Request.ContentLength > 0 {
+		header.Set("Content-Length", strconv.FormatInt(c.Request.ContentLength, 10))
+	}
+
+	client := request.NewClient(dep.ConfigProvider(),
+		request.WithCorrelationID(),
+		request.WithSlaveMeta(slaveConf.NodeID),
+		request.WithCredential(auth.HMACAuth{
+			SecretKey: []byte(slaveConf.Secret),
+		}, int64(slaveConf.SignatureTTL)),
+	)
+	assertion, rejected, err := cluster.RequestDavAssertion(c, client, slaveConf.MasterURL, &cluster.DavAssertionRequest{
+		Method: c.Request.Method,
+		Path:   "/" + strings.TrimPrefix(strings.TrimPrefix(c.Request.URL.Path, davPrefix), "/"),
+		Header: header,
+	}, dep.GeneralAuth())
+	if err != nil {
+		return fmt.Errorf("failed to request assertion from master: %w", err)
+	}
+
+	if rejected != nil {
+		defer rejected.Body.Close()
+		for _, h := range davRelayedHeaders {
+			if v := rejected.Header.Get(h); v != "" {
+				c.Header(h, v)
+			}
+		}
+
+		c.Writer.WriteHeader(rejected.StatusCode)
+		_, err := io.Copy(c.Writer, rejected.Body)
+		return err
+	}
+
+	switch assertion.Kind {
+	case cluster.DavAssertionServe:
+		return serveDavContent(c, assertion)
+	case cluster.DavAssertionUpload:
+		return receiveDavContent(c, assertion)
+	case cluster.DavAssertionRedirect:
+		c.Redirect(http.StatusFound, assertion.Url)
+	default:
+		proxyWebDAV(c, master)
+	}
+
+	return nil
+}
+
+// serveDavContent serves entity content stored on this node.
+func serveDavContent(c *gin.Context, a *cluster.DavAssertion) error {
+	m := manager.NewFileManager(dependency.FromContext(c), nil)
+	defer m.Recycle()
+
+	entity, err := local.NewLocalFileEntity(types.EntityTypeVersion, a.Source)
+	if err != nil {
+		return fs.ErrPathNotExist.WithError(err)
+	}
+
+	es, err := m.GetEntitySource(c, 0, fs.WithEntity(entity))
+	if err != nil {
+		return fmt.Errorf("failed to get entity source: %w", err)
+	}
+
+	defer es.Close()
+
+	c.Header("ETag", a.ETag)
+	es.Serve(c.Writer, c.Request,
+		entitysource.WithSpeedLimit(a.SpeedLimit),
+		entitysource.WithDisplayName(a.Name),
+		entitysource.WithContext(c),
+	)
+	return nil
+}
+
+// receiveDavContent writes request body into the upload session created on this node, chunk by chunk. The
+// upload is completed on master by the callback of the last chunk.
+func receiveDavContent(c *gin.Context, a *cluster.DavAssertion) error {
+	for index, offset := 0, int64(0); offset < a.Size; index++ {
+		size := a.Size - offset
+		if a.ChunkSize > 0 && size > a.ChunkSize {
+			size = a.ChunkSize
+		}
+
+		if err := explorer.SlaveUploadChunk(c, a.SessionID, index, size, io.NopCloser(io.LimitReader(c.Request.Body, size))); err != nil {
+			return fmt.Errorf("failed to upload chunk %d: %w", index, err)
+		}
+
+		offset += size
+	}
+
+	if a.MtimeAccepted {
+		c.Header("X-OC-Mtime", "accepted")
+	}
+
+	c.Status(http.StatusCreated)
+	return nil
+}
+
+// proxyWebDAV proxies the request to WebDAV endpoint of master. Destination header pointing to this node is
+// rewritten so that master sees the request as if it was sent to itself.
+func proxyWebDAV(c *gin.Context, master *url.URL) {
+	l := dependency.FromContext(c).Logger()
+	proxy := &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(master)
+			r.SetXForwarded()
+			if dst, err := url.Parse(r.In.Header.Get("Destination")); err == nil && dst.Host == r.In.Host {
+				dst.Scheme = master.Scheme
+				dst.Host = master.Host
+				dst.Path = strings.TrimSuffix(master.Path, "/") + dst.Path
+				dst.RawPath = ""
+				r.Out.Header.Set("Destination", dst.String())
+			}
+		},
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			l.Warning("Failed to proxy WebDAV request %q to master: %s", r.URL.String(), err)
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+
+	defer func() {
+		if err := recover(); err != nil && err != http.ErrAbortHandler {
+			panic(err)
+		}
+	}()
+
+	proxy.ServeHTTP(c.Writer, c.Request)
+}
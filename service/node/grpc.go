@@ -0,0 +1,164 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/constants"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/rpc"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/service/explorer"
+	"github.com/gofrs/uuid"
+	"google.golang.org/grpc/metadata"
+)
+
+const defaultWatchInterval = 5 * time.Second
+
+// NewGRPCServer creates the slave gRPC service implementation.
+func NewGRPCServer(dep dependency.Dep) *GRPCServer {
+	return &GRPCServer{dep: dep}
+}
+
+// GRPCServer implements rpc.SlaveServer.
+type GRPCServer struct {
+	rpc.UnimplementedSlaveServer
+	dep dependency.Dep
+}
+
+// Context builds the handling context of a call, the same way as InitializeHandling and
+// InitializeHandlingSlave middlewares do for HTTP requests.
+func (s *GRPCServer) Context(ctx context.Context, md metadata.MD) context.Context {
+	first := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+
+	cid := uuid.FromStringOrNil(first(rpc.CorrelationIDKey))
+	if cid == uuid.Nil {
+		cid = uuid.Must(uuid.NewV4())
+	}
+
+	l := s.dep.Logger().CopyWithField(logging.FieldCorrelationID, cid.String())
+	ctx = s.dep.ForkWithLogger(ctx, l)
+	ctx = context.WithValue(ctx, logging.CorrelationIDCtx{}, cid)
+	ctx = context.WithValue(ctx, logging.LoggerCtx{}, l)
+	ctx = context.WithValue(ctx, cluster.SlaveNodeIDCtx{}, first(rpc.NodeIDKey))
+	ctx = context.WithValue(ctx, cluster.MasterSiteIDCtx{}, first(rpc.SiteIDKey))
+	ctx = context.WithValue(ctx, cluster.MasterSiteUrlCtx{}, first(rpc.SiteURLKey))
+	ctx = context.WithValue(ctx, cluster.MasterSiteVersionCtx{}, first(rpc.SiteVersionKey))
+	return ctx
+}
+
+func (s *GRPCServer) Ping(ctx context.Context, req *rpc.PingRequest) (*rpc.PingResponse, error) {
+	return &rpc.PingResponse{Version: constants.BackendVersion}, nil
+}
+
+func (s *GRPCServer) CreateTask(ctx context.Context, req *rpc.CreateTaskRequest) (*rpc.CreateTaskResponse, error) {
+	id, err := createTaskInSlave(ctx, &cluster.CreateSlaveTask{Type: req.Type, State: req.State})
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpc.CreateTaskResponse{Id: int64(id)}, nil
+}
+
+func (s *GRPCServer) GetTask(ctx context.Context, req *rpc.GetTaskRequest) (*rpc.TaskSummary, error) {
+	summary, err := getSlaveTask(ctx, int(req.Id), req.ClearOnComplete, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRPCSummary(summary), nil
+}
+
+func (s *GRPCServer) WatchTask(req *rpc.WatchTaskRequest, stream rpc.Slave_WatchTaskServer) error {
+	interval := time.Duration(req.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+
+	ctx := stream.Context()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		// Terminal tasks are kept in registry until master fetches the final state with GetTask.
+		summary, err := getSlaveTask(ctx, int(req.Id), false, false)
+		if err != nil {
+			return err
+		}
+
+		res := toRPCSummary(summary)
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+
+		if res.Terminal() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *GRPCServer) UploadChunk(stream rpc.Slave_UploadChunkServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	if first.SessionId == "" {
+		return serializer.NewError(serializer.CodeParamErr, "session ID is required in first frame", nil)
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		frame := first
+		for {
+			if _, err := pw.Write(frame.Data); err != nil {
+				return
+			}
+
+			var recvErr error
+			frame, recvErr = stream.Recv()
+			if errors.Is(recvErr, io.EOF) {
+				pw.Close()
+				return
+			}
+
+			if recvErr != nil {
+				pw.CloseWithError(recvErr)
+				return
+			}
+		}
+	}()
+
+	err = explorer.SlaveUploadChunk(stream.Context(), first.SessionId, int(first.Index), first.Size, pr)
+	// Unblock the frame reader in case the chunk is rejected before fully read.
+	pr.CloseWithError(io.ErrClosedPipe)
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(&rpc.UploadChunkResponse{})
+}
+
+func toRPCSummary(summary *cluster.SlaveTaskSummary) *rpc.TaskSummary {
+	return &rpc.TaskSummary{
+		Status:       string(summary.Status),
+		Error:        summary.Error,
+		PrivateState: summary.PrivateState,
+		Progress:     rpc.ToProgresses(summary.Progress),
+	}
+}
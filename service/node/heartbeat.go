@@ -0,0 +1,244 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/node"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster"
+	"github.com/cloudreve/Cloudreve/v4/pkg/crontab"
+	"github.com/cloudreve/Cloudreve/v4/pkg/email"
+	"github.com/cloudreve/Cloudreve/v4/pkg/request"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	adminListPageSize = 500
+	// nodeStatusRetries is the number of attempts to save heartbeat status on concurrent changes.
+	nodeStatusRetries = 3
+)
+
+type HeartbeatParamCtx struct{}
+
+func init() {
+	crontab.Register(setting.CronTypeNodeHeartbeat, CronCheckHeartbeat)
+}
+
+// ReceiveHeartbeat saves the heartbeat pushed from slave node and marks it online. Maintenance
+// state of master is returned for the slave node to relay.
+func ReceiveHeartbeat(hb *cluster.Heartbeat, c *gin.Context) (*cluster.HeartbeatResponse, error) {
+	dep := dependency.FromContext(c)
+	kv := dep.KV()
+	nodeID := cluster.NodeIdFromContext(c)
+
+	status := &cluster.NodeStatus{
+		Online:    true,
+		LastSeen:  time.Now(),
+		Heartbeat: hb,
+	}
+
+	// Status is swapped atomically, so that the heartbeat is not overwritten by heartbeat check
+	// running on other instances.
+	var existing *cluster.NodeStatus
+	saved := false
+	for i := 0; i < nodeStatusRetries && !saved; i++ {
+		existing = cluster.GetNodeStatus(kv, nodeID)
+		var err error
+		saved, err = cluster.CompareAndSetNodeStatus(kv, nodeID, existing, status)
+		if err != nil {
+			return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to save heartbeat", err)
+		}
+	}
+
+	if !saved {
+		return nil, serializer.NewError(serializer.CodeConflict, "Node status is changed concurrently", nil)
+	}
+
+	wasOffline := existing != nil && !existing.Online
+
+	np, err := dep.NodePool(c)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to get node pool", err)
+	}
+	np.SetOnline(nodeID, true)
+	np.SetResources(nodeID, hb)
+
+	if wasOffline {
+		n, err := dep.NodeClient().GetNodeById(c, nodeID)
+		if err == nil {
+			notifyNodeStatus(c, dep, n, status)
+		}
+	}
+
+	return &cluster.HeartbeatResponse{Maintenance: dep.SettingProvider().Maintenance(c)}, nil
+}
+
+// CronCheckHeartbeat marks slave nodes that missed heartbeats as offline. Nodes that never report
+// heartbeats are not monitored.
+func CronCheckHeartbeat(ctx context.Context) {
+	dep := dependency.FromContext(ctx)
+	l := dep.Logger()
+	kv := dep.KV()
+	conf := dep.SettingProvider().NodeHeartbeat(ctx)
+
+	nodes, err := dep.NodeClient().ListActiveNodes(ctx, nil)
+	if err != nil {
+		l.Error("Failed to list nodes for heartbeat check: %s", err)
+		return
+	}
+
+	np, err := dep.NodePool(ctx)
+	if err != nil {
+		l.Error("Failed to get node pool for heartbeat check: %s", err)
+		return
+	}
+
+	now := time.Now()
+	for _, n := range nodes {
+		if n.Type == node.TypeMaster {
+			continue
+		}
+
+		status := cluster.GetNodeStatus(kv, n.ID)
+		if status == nil {
+			continue
+		}
+
+		if !status.Online || !status.Expired(now, conf.MissedBeats) {
+			// Status may be changed by another instance, keep the local pool in sync.
+			np.SetOnline(n.ID, status.Online)
+			np.SetResources(n.ID, status.Heartbeat)
+			continue
+		}
+
+		// Heartbeat received after the status is read must not be overwritten.
+		offline := *status
+		offline.Online = false
+		saved, err := cluster.CompareAndSetNodeStatus(kv, n.ID, status, &offline)
+		if err != nil {
+			l.Warning("Failed to save status of node %q: %s", n.Name, err)
+			continue
+		}
+
+		if !saved {
+			l.Debug("Status of node %q is changed concurrently, skip heartbeat check.", n.Name)
+			continue
+		}
+
+		l.Warning("Node %q missed heartbeats since %s, marked as offline.", n.Name, status.LastSeen)
+		np.SetOnline(n.ID, false)
+		notifyNodeStatus(ctx, dep, n, &offline)
+	}
+}
+
+// nodeStatusWebhook is the payload sent to alert webhook.
+type nodeStatusWebhook struct {
+	Event    string    `json:"event"`
+	NodeID   int       `json:"node_id"`
+	Node     string    `json:"node"`
+	Online   bool      `json:"online"`
+	LastSeen time.Time `json:"last_seen"`
+}
+
+// notifyNodeStatus sends alert emails and webhook on node status change according to settings.
+func notifyNodeStatus(ctx context.Context, dep dependency.Dep, n *ent.Node, status *cluster.NodeStatus) {
+	l := dep.Logger()
+	conf := dep.SettingProvider().NodeHeartbeat(ctx)
+
+	sendAlertWebhook(ctx, dep, &nodeStatusWebhook{
+		Event:    "node_status",
+		NodeID:   n.ID,
+		Node:     n.Name,
+		Online:   status.Online,
+		LastSeen: status.LastSeen,
+	})
+
+	if !conf.AlertEmail {
+		return
+	}
+
+	admins, err := listAdmins(ctx, dep)
+	if err != nil {
+		l.Warning("Failed to list admins for node status alert: %s", err)
+		return
+	}
+
+	for _, admin := range admins {
+		title, body, err := email.NewNodeStatusEmail(ctx, dep.SettingProvider(), admin, n.Name, status.Online, status.LastSeen)
+		if err != nil {
+			l.Warning("Failed to generate node status email: %s", err)
+			return
+		}
+
+		if err := dep.EmailClient(ctx).Send(ctx, admin.Email, title, body); err != nil {
+			l.Warning("Failed to send node status email to %q: %s", admin.Email, err)
+		}
+	}
+}
+
+// sendAlertWebhook posts payload to the node alert webhook if configured.
+func sendAlertWebhook(ctx context.Context, dep dependency.Dep, payload any) {
+	webhook := dep.SettingProvider().NodeHeartbeat(ctx).AlertWebhook
+	if webhook == "" {
+		return
+	}
+
+	body, _ := json.Marshal(payload)
+	_, err := dep.RequestClient().Request(
+		"POST",
+		webhook,
+		bytes.NewReader(body),
+		request.WithContext(ctx),
+		request.WithHeader(http.Header{"Content-Type": []string{"application/json"}}),
+		request.WithTimeout(10*time.Second),
+	).CheckHTTPResponse(200).GetResponse()
+	if err != nil {
+		dep.Logger().Warning("Failed to send node alert webhook: %s", err)
+	}
+}
+
+// listAdmins lists active users in admin groups.
+func listAdmins(ctx context.Context, dep dependency.Dep) ([]*ent.User, error) {
+	groups, err := dep.GroupClient().ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var admins []*ent.User
+	for _, g := range groups {
+		if !g.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+			continue
+		}
+
+		for page := 0; ; page++ {
+			res, err := dep.UserClient().ListUsers(ctx, &inventory.ListUserParameters{
+				PaginationArgs: &inventory.PaginationArgs{
+					Page:     page,
+					PageSize: adminListPageSize,
+				},
+				GroupID: g.ID,
+				Status:  user.StatusActive,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			admins = append(admins, res.Users...)
+			if len(res.Users) < adminListPageSize {
+				break
+			}
+		}
+	}
+
+	return admins, nil
+}
@@ -23,6 +23,10 @@ type (
 )
 
 func CreateTaskInSlave(s *cluster.CreateSlaveTask, c *gin.Context) (int, error) {
+	return createTaskInSlave(c, s)
+}
+
+func createTaskInSlave(c context.Context, s *cluster.CreateSlaveTask) (int, error) {
 	dep := dependency.FromContext(c)
 	registry := dep.TaskRegistry()
 
@@ -39,6 +43,8 @@ func CreateTaskInSlave(s *cluster.CreateSlaveTask, c *gin.Context) (int, error)
 		t = workflows.NewSlaveCreateArchiveTask(c, props, registry.NextID(), s.State)
 	case queue.SlaveExtractArchiveType:
 		t = workflows.NewSlaveExtractArchiveTask(c, props, registry.NextID(), s.State)
+	case queue.SlaveTranscodeTaskType:
+		t = workflows.NewSlaveTranscodeTask(c, props, registry.NextID(), s.State)
 	default:
 		return 0, serializer.NewError(serializer.CodeParamErr, "type not supported", nil)
 	}
@@ -59,19 +65,25 @@ type (
 )
 
 func (s *GetSlaveTaskService) Get(c *gin.Context) (*cluster.SlaveTaskSummary, error) {
+	_, clearOnComplete := c.GetQuery(routes.SlaveClearTaskRegistryQuery)
+	return getSlaveTask(c, s.ID, clearOnComplete, true)
+}
+
+// getSlaveTask returns summary of a task in registry. If clear is true, tasks that are failed or
+// canceled, or completed if clearOnComplete is set, are removed from registry.
+func getSlaveTask(c context.Context, id int, clearOnComplete, clear bool) (*cluster.SlaveTaskSummary, error) {
 	dep := dependency.FromContext(c)
 	registry := dep.TaskRegistry()
 
-	t, ok := registry.Get(s.ID)
+	t, ok := registry.Get(id)
 	if !ok {
 		return nil, serializer.NewError(serializer.CodeNotFound, "task not found", nil)
 	}
 	status := t.Status()
-	_, clearOnComplete := c.GetQuery(routes.SlaveClearTaskRegistryQuery)
-	if clearOnComplete && status == task.StatusCompleted ||
+	if clear && (clearOnComplete && status == task.StatusCompleted ||
 		status == task.StatusError ||
-		status == task.StatusCanceled {
-		registry.Delete(s.ID)
+		status == task.StatusCanceled) {
+		registry.Delete(id)
 	}
 
 	res := &cluster.SlaveTaskSummary{
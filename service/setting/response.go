@@ -3,6 +3,7 @@ package setting
 import (
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/samber/lo"
@@ -24,21 +25,43 @@ func BuildListDavAccountResponse(res *inventory.ListDavAccountResult, hasher has
 }
 
 type DavAccount struct {
-	ID        string              `json:"id"`
-	CreatedAt time.Time           `json:"created_at"`
-	Name      string              `json:"name"`
-	Uri       string              `json:"uri"`
-	Password  string              `json:"password"`
-	Options   *boolset.BooleanSet `json:"options"`
+	ID        string                 `json:"id"`
+	CreatedAt time.Time              `json:"created_at"`
+	Name      string                 `json:"name"`
+	Uri       string                 `json:"uri"`
+	Password  string                 `json:"password"`
+	Options   *boolset.BooleanSet    `json:"options"`
+	Roots     []types.DavAccountRoot `json:"roots,omitempty"`
+	// RequestLimit requests per minute, 0 means unlimited.
+	RequestLimit int `json:"request_limit,omitempty"`
+	// SpeedLimit bytes per second, 0 means unlimited.
+	SpeedLimit int64 `json:"speed_limit,omitempty"`
+	// ValidDays days the password is valid after rotation, 0 means never expires.
+	ValidDays  int        `json:"valid_days,omitempty"`
+	ExpireAt   *time.Time `json:"expire_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// DisabledAt is set if the account is disabled for being unused.
+	DisabledAt *time.Time `json:"disabled_at,omitempty"`
 }
 
 func BuildDavAccount(account *ent.DavAccount, hasher hashid.Encoder) DavAccount {
-	return DavAccount{
-		ID:        hashid.EncodeDavAccountID(hasher, account.ID),
-		CreatedAt: account.CreatedAt,
-		Name:      account.Name,
-		Uri:       account.URI,
-		Password:  account.Password,
-		Options:   account.Options,
+	res := DavAccount{
+		ID:         hashid.EncodeDavAccountID(hasher, account.ID),
+		CreatedAt:  account.CreatedAt,
+		Name:       account.Name,
+		Uri:        account.URI,
+		Password:   account.Password,
+		Options:    account.Options,
+		ExpireAt:   account.ExpireAt,
+		LastUsedAt: account.LastUsedAt,
+		DisabledAt: account.DisabledAt,
 	}
+	if account.Props != nil {
+		res.Roots = account.Props.Roots
+		res.RequestLimit = account.Props.RequestLimit
+		res.SpeedLimit = account.Props.SpeedLimit
+		res.ValidDays = account.Props.ValidDays
+	}
+
+	return res
 }
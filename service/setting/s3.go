@@ -0,0 +1,105 @@
+package setting
+
+import (
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type (
+	// S3AccessKey is an access key of the S3 gateway, secret is only returned once on creation.
+	S3AccessKey struct {
+		AccessKeyID string     `json:"access_key_id"`
+		Secret      string     `json:"secret,omitempty"`
+		Name        string     `json:"name"`
+		ReadOnly    bool       `json:"read_only"`
+		CreatedAt   time.Time  `json:"created_at"`
+		LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	}
+
+	ListS3AccessKeyResponse struct {
+		Keys []S3AccessKey `json:"keys"`
+		Max  int           `json:"max"`
+	}
+)
+
+func buildS3AccessKey(key *ent.S3AccessKey) S3AccessKey {
+	return S3AccessKey{
+		AccessKeyID: key.AccessKeyID,
+		Name:        key.Name,
+		ReadOnly:    key.ReadOnly,
+		CreatedAt:   key.CreatedAt,
+		LastUsedAt:  key.LastUsedAt,
+	}
+}
+
+// ListS3AccessKeys lists S3 access keys of current user.
+func ListS3AccessKeys(c *gin.Context) (*ListS3AccessKeyResponse, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+
+	keys, err := dep.S3AccessKeyClient().List(c, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list S3 access keys", err)
+	}
+
+	return &ListS3AccessKeyResponse{
+		Keys: lo.Map(keys, func(item *ent.S3AccessKey, index int) S3AccessKey {
+			return buildS3AccessKey(item)
+		}),
+		Max: dep.SettingProvider().S3AccessKeyMax(c),
+	}, nil
+}
+
+type (
+	CreateS3AccessKeyService struct {
+		Name     string `json:"name" binding:"required,min=1,max=255"`
+		ReadOnly bool   `json:"read_only"`
+	}
+	CreateS3AccessKeyParamCtx struct{}
+)
+
+// Create creates a new S3 access key for current user.
+func (service *CreateS3AccessKeyService) Create(c *gin.Context) (*S3AccessKey, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	keyClient := dep.S3AccessKeyClient()
+
+	count, err := keyClient.Count(c, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to count S3 access keys", err)
+	}
+
+	if count >= dep.SettingProvider().S3AccessKeyMax(c) {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Too many S3 access keys", nil)
+	}
+
+	key, err := keyClient.Create(c, user.ID, service.Name, service.ReadOnly)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create S3 access key", err)
+	}
+
+	res := buildS3AccessKey(key)
+	res.Secret = key.Secret
+	return &res, nil
+}
+
+// DeleteS3AccessKey revokes the S3 access key of current user with given access key ID.
+func DeleteS3AccessKey(c *gin.Context, accessKeyID string) error {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+
+	if err := dep.S3AccessKeyClient().Delete(c, user.ID, accessKeyID); err != nil {
+		if ent.IsNotFound(err) {
+			return serializer.NewError(serializer.CodeNotFound, "Access key not exist", err)
+		}
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete S3 access key", err)
+	}
+
+	return nil
+}
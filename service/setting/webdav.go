@@ -1,11 +1,16 @@
 package setting
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/cloudreve/Cloudreve/v4/application/constants"
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
 	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
@@ -90,6 +95,15 @@ type (
 		Name     string `json:"name" binding:"required,min=1,max=255"`
 		Readonly bool   `json:"readonly"`
 		Proxy    bool   `json:"proxy"`
+		// Shares exposes received shares under /dav/shares.
+		Shares bool `json:"shares"`
+		// Roots are optional mount roots, see types.DavAccountProps.
+		Roots []types.DavAccountRoot `json:"roots" binding:"omitempty,max=32,dive"`
+		// RequestLimit and SpeedLimit are optional limits, see types.DavAccountProps.
+		RequestLimit int   `json:"request_limit" binding:"min=0"`
+		SpeedLimit   int64 `json:"speed_limit" binding:"min=0"`
+		// ValidDays is how long the password is valid, 0 means never expires.
+		ValidDays int `json:"valid_days" binding:"min=0,max=3650"`
 	}
 	CreateDavAccountParamCtx struct{}
 )
@@ -99,7 +113,12 @@ func (service *CreateDavAccountService) Create(c *gin.Context) (*DavAccount, err
 	dep := dependency.FromContext(c)
 	user := inventory.UserFromContext(c)
 
-	bs, err := service.validateAndGetBs(user)
+	bs, err := service.validateAndGetBs(c, user)
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := service.validateAndGetProps()
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +130,8 @@ func (service *CreateDavAccountService) Create(c *gin.Context) (*DavAccount, err
 		URI:      service.Uri,
 		Password: util.RandString(32, util.RandomLowerCases),
 		Options:  bs,
+		Props:    props,
+		ExpireAt: davPasswordExpireAt(props.ValidDays),
 	})
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to create dav account", err)
@@ -133,16 +154,31 @@ func (service *CreateDavAccountService) Update(c *gin.Context) (*DavAccount, err
 		return nil, serializer.NewError(serializer.CodeNotFound, "Account not exist", err)
 	}
 
-	bs, err := service.validateAndGetBs(user)
+	bs, err := service.validateAndGetBs(c, user)
+	if err != nil {
+		return nil, err
+	}
+
+	props, err := service.validateAndGetProps()
 	if err != nil {
 		return nil, err
 	}
 
+	// Expiration is only reset if validity period is changed.
+	expireAt := account.ExpireAt
+	if account.Props == nil || account.Props.ValidDays != props.ValidDays {
+		expireAt = davPasswordExpireAt(props.ValidDays)
+	} else {
+		props.ExpiryNotifiedAt = account.Props.ExpiryNotifiedAt
+	}
+
 	// Update account
 	account, err = davAccountClient.Update(c, accountId, &inventory.CreateDavAccountParams{
-		Name:    service.Name,
-		URI:     service.Uri,
-		Options: bs,
+		Name:     service.Name,
+		URI:      service.Uri,
+		Options:  bs,
+		Props:    props,
+		ExpireAt: expireAt,
 	})
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update dav account", err)
@@ -152,20 +188,17 @@ func (service *CreateDavAccountService) Update(c *gin.Context) (*DavAccount, err
 	return &accountRes, nil
 }
 
-func (service *CreateDavAccountService) validateAndGetBs(user *ent.User) (*boolset.BooleanSet, error) {
+func (service *CreateDavAccountService) validateAndGetBs(c *gin.Context, user *ent.User) (*boolset.BooleanSet, error) {
 	if !user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionWebDAV)) {
 		return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "WebDAV is not enabled for this user group", nil)
 	}
 
-	uri, err := fs.NewUriFromString(service.Uri)
-	if err != nil {
-		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid URI", err)
+	if err := checkDavWriteScopes(c, service.Readonly); err != nil {
+		return nil, err
 	}
 
-	// Only "my" and "share" fs is allowed in WebDAV
-	if uriFs := uri.FileSystem(); uri.SearchParameters() != nil ||
-		(uriFs != constants.FileSystemMy && uriFs != constants.FileSystemShare) {
-		return nil, serializer.NewError(serializer.CodeParamErr, "Invalid URI", nil)
+	if err := validateDavUri(service.Uri); err != nil {
+		return nil, err
 	}
 
 	bs := boolset.BooleanSet{}
@@ -176,9 +209,116 @@ func (service *CreateDavAccountService) validateAndGetBs(user *ent.User) (*bools
 	if service.Proxy && user.Edges.Group.Permissions.Enabled(int(types.GroupPermissionWebDAVProxy)) {
 		boolset.Set(types.DavAccountProxy, true, &bs)
 	}
+
+	if service.Shares {
+		boolset.Set(types.DavAccountShares, true, &bs)
+	}
 	return &bs, nil
 }
 
+func (service *CreateDavAccountService) validateAndGetProps() (*types.DavAccountProps, error) {
+	names := make(map[string]bool, len(service.Roots))
+	for _, root := range service.Roots {
+		// Root names are used as top-level folder names
+		if strings.Contains(root.Name, fs.Separator) || root.Name == "." || root.Name == ".." ||
+			root.Name == ".trash" || root.Name == ".uploads" || (service.Shares && root.Name == "shares") {
+			return nil, serializer.NewError(serializer.CodeParamErr, fmt.Sprintf("Invalid root name %q", root.Name), nil)
+		}
+
+		if names[root.Name] {
+			return nil, serializer.NewError(serializer.CodeParamErr, fmt.Sprintf("Duplicated root name %q", root.Name), nil)
+		}
+		names[root.Name] = true
+
+		if err := validateDavUri(root.Uri); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.DavAccountProps{
+		Roots:        service.Roots,
+		RequestLimit: service.RequestLimit,
+		SpeedLimit:   service.SpeedLimit,
+		ValidDays:    service.ValidDays,
+	}, nil
+}
+
+// checkDavWriteScopes rejects personal access tokens managing read-write accounts unless they are
+// granted with all scopes modifying files, otherwise a webdav token could gain write access through
+// the password of the account.
+func checkDavWriteScopes(c *gin.Context, readonly bool) error {
+	if readonly {
+		return nil
+	}
+
+	for _, scope := range []auth.Scope{auth.ScopeFileUpload, auth.ScopeFileWrite, auth.ScopeFileManage} {
+		if !auth.AccessTokenAllows(c, scope) {
+			return serializer.NewError(serializer.CodeNoPermissionErr,
+				fmt.Sprintf("Access token needs %q scope to manage read-write WebDAV accounts", scope), nil)
+		}
+	}
+
+	return nil
+}
+
+// davPasswordExpireAt returns expiration time of a password created now, nil if it never expires.
+func davPasswordExpireAt(validDays int) *time.Time {
+	if validDays <= 0 {
+		return nil
+	}
+
+	expireAt := time.Now().Add(time.Duration(validDays) * 24 * time.Hour)
+	return &expireAt
+}
+
+func validateDavUri(raw string) error {
+	uri, err := fs.NewUriFromString(raw)
+	if err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "Invalid URI", err)
+	}
+
+	// Only "my" and "share" fs is allowed in WebDAV
+	if uriFs := uri.FileSystem(); uri.SearchParameters() != nil ||
+		(uriFs != constants.FileSystemMy && uriFs != constants.FileSystemShare) {
+		return serializer.NewError(serializer.CodeParamErr, "Invalid URI", nil)
+	}
+
+	return nil
+}
+
+// RotateDavAccount replaces the password of an account with a new one, expiration is reset and the account
+// is re-enabled if it was disabled for being unused.
+func RotateDavAccount(c *gin.Context) (*DavAccount, error) {
+	dep := dependency.FromContext(c)
+	user := inventory.UserFromContext(c)
+	accountId := hashid.FromContext(c)
+
+	davAccountClient := dep.DavAccountClient()
+	account, err := davAccountClient.GetByIDAndUserID(c, accountId, user.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Account not exist", err)
+	}
+
+	if err := checkDavWriteScopes(c, account.Options.Enabled(int(types.DavAccountReadOnly))); err != nil {
+		return nil, err
+	}
+
+	props := &types.DavAccountProps{}
+	if account.Props != nil {
+		*props = *account.Props
+	}
+	props.ExpiryNotifiedAt = nil
+
+	account, err = davAccountClient.Rotate(c, accountId, util.RandString(32, util.RandomLowerCases),
+		davPasswordExpireAt(props.ValidDays), props)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to rotate dav account password", err)
+	}
+
+	accountRes := BuildDavAccount(account, dep.HashIDEncoder())
+	return &accountRes, nil
+}
+
 func DeleteDavAccount(c *gin.Context) error {
 	dep := dependency.FromContext(c)
 	user := inventory.UserFromContext(c)
@@ -0,0 +1,107 @@
+package setting
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	_ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/boolset"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDavAccount_AccessTokenScopes(t *testing.T) {
+	a := assert.New(t)
+	drv, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := ent.NewClient(ent.Driver(drv))
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	encoder, err := hashid.New("test-salt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	permissions := &boolset.BooleanSet{}
+	boolset.Set(types.GroupPermissionWebDAV, true, permissions)
+	group := client.Group.Create().SetName("users").SetPermissions(permissions).SaveX(ctx)
+	u := client.User.Create().SetEmail("dav@cloudreve.org").SetNick("dav").SetGroupUsers(group.ID).SaveX(ctx)
+	u.Edges.Group = group
+
+	ctx = context.WithValue(ctx, dependency.DepCtx{}, dependency.NewDependency(
+		dependency.WithDbClient(client),
+		dependency.WithHashIDEncoder(encoder),
+		dependency.WithLogger(logging.NewConsoleLogger(logging.LevelError)),
+		dependency.WithConfigPath(filepath.Join(t.TempDir(), "conf.ini")),
+	))
+	ctx = context.WithValue(ctx, inventory.UserCtx{}, u)
+	newContext := func(scopes ...auth.Scope) *gin.Context {
+		c, r := gin.CreateTestContext(httptest.NewRecorder())
+		r.ContextWithFallback = true
+		reqCtx := ctx
+		if scopes != nil {
+			granted := make([]string, len(scopes))
+			for i, scope := range scopes {
+				granted[i] = string(scope)
+			}
+			reqCtx = context.WithValue(reqCtx, auth.AccessTokenScopesCtx{}, granted)
+		}
+		c.Request = httptest.NewRequestWithContext(reqCtx, http.MethodPut, "/", nil)
+		return c
+	}
+
+	readWrite := &CreateDavAccountService{Uri: "cloudreve://my", Name: "rw"}
+	readOnly := &CreateDavAccountService{Uri: "cloudreve://my", Name: "ro", Readonly: true}
+
+	// webdav tokens can only create read-only accounts
+	_, err = readWrite.Create(newContext(auth.ScopeWebDAV))
+	a.Error(err)
+	_, err = readWrite.Create(newContext(auth.ScopeWebDAV, auth.ScopeFileUpload, auth.ScopeFileWrite))
+	a.Error(err)
+	_, err = readOnly.Create(newContext(auth.ScopeWebDAV))
+	a.NoError(err)
+
+	// Requests not authenticated by tokens and tokens with file scopes are not restricted
+	account, err := readWrite.Create(newContext())
+	a.NoError(err)
+	_, err = readWrite.Create(newContext(auth.ScopeWebDAV, auth.ScopeFileUpload, auth.ScopeFileWrite, auth.ScopeFileManage))
+	a.NoError(err)
+
+	accountID, err := encoder.Decode(account.ID, hashid.DavAccountID)
+	a.NoError(err)
+
+	// Existing read-write accounts cannot be updated or rotated with webdav tokens
+	c := newContext(auth.ScopeWebDAV)
+	util.WithValue(c, hashid.ObjectIDCtx{}, accountID)
+	_, err = RotateDavAccount(c)
+	a.Error(err)
+	_, err = readWrite.Update(c)
+	a.Error(err)
+
+	// Downgrading to read-only is allowed
+	c = newContext(auth.ScopeWebDAV)
+	util.WithValue(c, hashid.ObjectIDCtx{}, accountID)
+	_, err = readOnly.Update(c)
+	a.NoError(err)
+	_, err = RotateDavAccount(c)
+	a.NoError(err)
+}
@@ -2,6 +2,7 @@ package share
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
@@ -10,9 +11,11 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/grouppolicy"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/service/explorer"
 	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
 )
 
 type (
@@ -22,6 +25,8 @@ type (
 		IsPrivate       bool   `json:"is_private"`
 		RemainDownloads int    `json:"downloads"`
 		Expire          int    `json:"expire"`
+		// Recipients limits the share to users with one of the emails, including verified secondary ones.
+		Recipients []string `json:"recipients" binding:"omitempty,max=50,dive,email"`
 	}
 	ShareCreateParamCtx struct{}
 )
@@ -49,13 +54,25 @@ func (service *ShareCreateService) Upsert(c *gin.Context, existed int) (string,
 		*expires = time.Now().Add(time.Duration(service.Expire) * time.Second)
 	}
 
+	// Only new share links are counted by group policy
+	policyReq := &grouppolicy.Request{Action: types.GroupPolicyActionShare}
+	if existed == 0 {
+		if err := dep.GroupPolicyChecker().Consume(c, user, policyReq); err != nil {
+			return "", err
+		}
+	}
+
 	share, err := m.CreateOrUpdateShare(c, uri, &manager.CreateShareArgs{
 		IsPrivate:       service.IsPrivate,
 		RemainDownloads: service.RemainDownloads,
 		Expire:          expires,
+		Recipients:      normalizeRecipients(service.Recipients),
 		ExistedShareID:  existed,
 	})
 	if err != nil {
+		if existed == 0 {
+			dep.GroupPolicyChecker().Release(c, user, policyReq)
+		}
 		return "", err
 	}
 
@@ -63,7 +80,14 @@ func (service *ShareCreateService) Upsert(c *gin.Context, existed int) (string,
 	return explorer.BuildShareLink(share, dep.HashIDEncoder(), base), nil
 }
 
-func DeleteShare(c *gin.Context, shareId int) error {
+// normalizeRecipients lower cases recipient emails and removes duplicates, emails are stored in lower case.
+func normalizeRecipients(recipients []string) []string {
+	return lo.Uniq(lo.Map(recipients, func(email string, _ int) string {
+		return strings.ToLower(strings.TrimSpace(email))
+	}))
+}
+
+func DeleteShare(c context.Context, shareId int) error {
 	dep := dependency.FromContext(c)
 	user := inventory.UserFromContext(c)
 	shareClient := dep.ShareClient()
@@ -2,6 +2,7 @@ package share
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/ent"
@@ -9,6 +10,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs/dbfs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
@@ -56,8 +58,13 @@ func (s *ShareInfoService) Get(c *gin.Context) (*explorer.Share, error) {
 		return nil, serializer.NewError(serializer.CodeNotFound, "Share link expired", err)
 	}
 
+	if err := dbfs.CheckShareRecipient(c, shareClient, share, u); err != nil {
+		return nil, err
+	}
+
+	viewed := false
 	if s.CountViews {
-		_ = shareClient.Viewed(c, share)
+		viewed = shareClient.Viewed(c, share) == nil
 	}
 
 	unlocked := true
@@ -70,6 +77,10 @@ func (s *ShareInfoService) Get(c *gin.Context) (*explorer.Share, error) {
 	res := explorer.BuildShare(share, base, dep.HashIDEncoder(), u, share.Edges.User, share.Edges.File.Name,
 		types.FileType(share.Edges.File.Type), unlocked, false)
 
+	if viewed && share.Edges.User.ID != u.ID && isViewMilestone(share.Views+1) {
+		notifyShareViewed(c, dep, share, res.Url)
+	}
+
 	if s.OwnerExtended && share.Edges.User.ID == u.ID {
 		// Add more information about the shared file
 		m := manager.NewFileManager(dep, u)
@@ -160,3 +171,29 @@ func (s *ListShareService) ListInUserProfile(c *gin.Context, uid int) (*ListShar
 	base := dep.SettingProvider().SiteURL(ctx)
 	return BuildListShareResponse(res, hasher, base, user, false), nil
 }
+
+// isViewMilestone returns whether the view count is a power of 10, share owners are notified
+// when milestones are reached instead of every single view.
+func isViewMilestone(views int) bool {
+	for views >= 10 && views%10 == 0 {
+		views /= 10
+	}
+
+	return views == 1
+}
+
+func notifyShareViewed(ctx context.Context, dep dependency.Dep, share *ent.Share, url string) {
+	n := &inventory.NotificationArgs{
+		Type: types.NotificationTypeShareActivity,
+		Link: url,
+		Props: map[string]string{
+			"share_id":  hashid.EncodeShareID(dep.HashIDEncoder(), share.ID),
+			"file_name": share.Edges.File.Name,
+			"views":     strconv.Itoa(share.Views + 1),
+		},
+	}
+
+	if _, err := dep.NotificationClient().Create(ctx, share.Edges.User.ID, n); err != nil {
+		dep.Logger().Warning("Failed to notify share activity to user %d: %s", share.Edges.User.ID, err)
+	}
+}
@@ -0,0 +1,108 @@
+package share
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	_ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShareInfoService_Recipients(t *testing.T) {
+	a := assert.New(t)
+	gin.SetMode(gin.TestMode)
+	drv, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l := logging.NewConsoleLogger(logging.LevelError)
+	dep := dependency.NewDependency(
+		dependency.WithLogger(l),
+		dependency.WithConfigPath(filepath.Join(t.TempDir(), "conf.ini")),
+		dependency.WithKV(cache.NewMemoStore("", l)),
+		dependency.WithRawEntClient(ent.NewClient(ent.Driver(drv))),
+	)
+	t.Cleanup(func() { dep.DBClient().Close() })
+
+	ctx := context.Background()
+	newUser := func(email string) *ent.User {
+		u, err := dep.UserClient().Create(ctx, &inventory.NewUserArgs{Email: email, Status: user.StatusActive, GroupID: 2})
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, err = dep.UserClient().GetByID(context.WithValue(ctx, inventory.LoadUserGroup{}, true), u.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return u
+	}
+
+	owner := newUser("owner@cloudreve.org")
+	recipient := newUser("recipient@cloudreve.org")
+	other := newUser("other@cloudreve.org")
+
+	// Verified secondary email matches, unverified one does not
+	alias, err := dep.UserClient().AddEmail(ctx, recipient.ID, "alias@cloudreve.org", "", time.Now().Add(time.Hour))
+	a.NoError(err)
+	a.NoError(dep.UserClient().VerifyEmail(ctx, alias.ID))
+	_, err = dep.UserClient().AddEmail(ctx, other.ID, "pending@cloudreve.org", "", time.Now().Add(time.Hour))
+	a.NoError(err)
+
+	db := dep.DBClient()
+	root := db.File.Create().SetType(int(types.FileTypeFolder)).SetName(inventory.RootFolderName).SetOwnerID(owner.ID).SaveX(ctx)
+	f := db.File.Create().SetType(int(types.FileTypeFile)).SetName("a.txt").SetOwnerID(owner.ID).SetParent(root).SaveX(ctx)
+	share, err := dep.ShareClient().Upsert(ctx, &inventory.CreateShareParams{
+		OwnerID:    owner.ID,
+		FileID:     f.ID,
+		Recipients: normalizeRecipients([]string{" Alias@Cloudreve.org", "pending@cloudreve.org", "alias@cloudreve.org"}),
+	})
+	a.NoError(err)
+	a.Equal([]string{"alias@cloudreve.org", "pending@cloudreve.org"}, share.Recipients)
+
+	anonymous, err := dep.UserClient().AnonymousUser(ctx)
+	a.NoError(err)
+
+	get := func(u *ent.User) int {
+		reqCtx := context.WithValue(ctx, dependency.DepCtx{}, dep)
+		reqCtx = context.WithValue(reqCtx, inventory.UserCtx{}, u)
+		c, r := gin.CreateTestContext(httptest.NewRecorder())
+		r.ContextWithFallback = true
+		c.Request = httptest.NewRequestWithContext(reqCtx, http.MethodGet, "/", nil)
+		util.WithValue(c, hashid.ObjectIDCtx{}, share.ID)
+		if _, err := (&ShareInfoService{}).Get(c); err != nil {
+			return err.(serializer.AppError).Code
+		}
+		return 0
+	}
+
+	a.Equal(0, get(owner))
+	a.Equal(0, get(recipient))
+	a.Equal(serializer.CodeNoPermissionErr, get(other))
+	a.Equal(serializer.CodeCheckLogin, get(anonymous))
+
+	// Shares limited to recipients are not listed in user profile
+	res, err := dep.ShareClient().List(ctx, &inventory.ListShareArgs{
+		PaginationArgs: &inventory.PaginationArgs{PageSize: 10},
+		UserID:         owner.ID,
+		PublicOnly:     true,
+	})
+	a.NoError(err)
+	a.Empty(res.Shares)
+}
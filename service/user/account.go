@@ -0,0 +1,218 @@
+package user
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/pquerna/otp/totp"
+)
+
+type (
+	// DeleteAccountService schedules deletion of current user after confirming credentials.
+	DeleteAccountService struct {
+		// Password current password, not required for passwordless users.
+		Password string `json:"password" binding:"max=128"`
+		// TwoFACode required if 2FA is enabled.
+		TwoFACode string `json:"two_fa_code"`
+		// PasskeyResponse passkey assertion started by PrepareDeleteAccount, required for passwordless users.
+		PasskeyResponse string `json:"passkey_response"`
+	}
+	DeleteAccountParameterCtx struct{}
+)
+
+const accountDeletionAuthnKey = "authn_account_deletion_"
+
+// PrepareDeleteAccount begins a passkey assertion used to re-authenticate passwordless users before
+// account deletion.
+func PrepareDeleteAccount(c *gin.Context) (*protocol.CredentialAssertion, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+
+	passkeys, err := dep.UserClient().ListPasskeys(c, u.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list passkeys", err)
+	}
+
+	if len(passkeys) == 0 {
+		return nil, serializer.NewError(serializer.CodeNotFound, "No passkey registered", nil)
+	}
+
+	webAuthn, err := dep.WebAuthn(c)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to initialize WebAuthn", err)
+	}
+
+	options, sessionData, err := webAuthn.BeginLogin(&authnUser{
+		u:           u,
+		hasher:      dep.HashIDEncoder(),
+		credentials: passkeys,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInitializeAuthn, "Failed to begin assertion", err)
+	}
+
+	if err := dep.KV().Set(fmt.Sprintf("%s%d", accountDeletionAuthnKey, u.ID), *sessionData, 300); err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to store session data", err)
+	}
+
+	return options, nil
+}
+
+// Delete blocks the account and schedules its purge after the grace period. Existing sessions
+// are invalidated since only active users can be authenticated.
+func (s *DeleteAccountService) Delete(c *gin.Context) (*types.AccountDeletion, error) {
+	dep := dependency.FromContext(c)
+	settings := dep.SettingProvider().AccountDeletion(c)
+	if !settings.Enabled {
+		return nil, serializer.NewError(serializer.CodeFeatureNotEnabled, "Account deletion is not enabled", nil)
+	}
+
+	u := inventory.UserFromContext(c)
+	if u.ID == 1 {
+		return nil, serializer.NewError(serializer.CodeInvalidActionOnDefaultUser, "Default user cannot be deleted", nil)
+	}
+
+	if u.Edges.Group != nil && u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+		return nil, serializer.NewError(serializer.CodeNoPermissionErr, "Admin account cannot be deleted by itself", nil)
+	}
+
+	if u.Password != "" {
+		if err := inventory.CheckPassword(u, s.Password); err != nil {
+			return nil, serializer.NewError(serializer.CodeIncorrectPassword, "Incorrect password", err)
+		}
+	} else if err := s.verifyPasskey(c, dep, u); err != nil {
+		return nil, err
+	}
+
+	if u.TwoFactorSecret != "" && !totp.Validate(s.TwoFACode, u.TwoFactorSecret) {
+		return nil, serializer.NewError(serializer.Code2FACodeErr, "Incorrect 2FA code", nil)
+	}
+
+	now := time.Now()
+	deletion := &types.AccountDeletion{
+		RequestedAt: now,
+		PurgeAt:     now.Add(settings.GracePeriod),
+	}
+
+	userClient, tx, ctx, err := inventory.WithTx(c, dep.UserClient())
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	u, err = userClient.UpdateSettings(ctx, u.ID, func(locked *ent.User) error {
+		locked.Settings.Deletion = deletion
+		return nil
+	})
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to save account deletion", err)
+	}
+
+	if _, err := userClient.SetStatus(ctx, u, user.StatusSysBanned); err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to block account", err)
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit account deletion", err)
+	}
+
+	dep.Logger().Info("User %d requested account deletion, scheduled at %s.", u.ID, deletion.PurgeAt)
+	return deletion, nil
+}
+
+// verifyPasskey validates the passkey assertion of passwordless users, since there's no password to
+// re-authenticate with.
+func (s *DeleteAccountService) verifyPasskey(c *gin.Context, dep dependency.Dep, u *ent.User) error {
+	kv := dep.KV()
+	sessionKey := fmt.Sprintf("%s%d", accountDeletionAuthnKey, u.ID)
+	sessionDataRaw, ok := kv.Get(sessionKey)
+	if !ok || s.PasskeyResponse == "" {
+		return serializer.NewError(serializer.CodeWebAuthnCredentialError, "Passkey verification is required", nil)
+	}
+
+	_ = kv.Delete(accountDeletionAuthnKey, strconv.Itoa(u.ID))
+
+	passkeys, err := dep.UserClient().ListPasskeys(c, u.ID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to list passkeys", err)
+	}
+
+	webAuthn, err := dep.WebAuthn(c)
+	if err != nil {
+		return serializer.NewError(serializer.CodeInternalSetting, "Failed to initialize WebAuthn", err)
+	}
+
+	pcc, err := protocol.ParseCredentialRequestResponseBody(strings.NewReader(s.PasskeyResponse))
+	if err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "Failed to parse request", err)
+	}
+
+	credential, err := webAuthn.ValidateLogin(&authnUser{
+		u:           u,
+		hasher:      dep.HashIDEncoder(),
+		credentials: passkeys,
+	}, sessionDataRaw.(webauthn.SessionData), pcc)
+	if err != nil {
+		return serializer.NewError(serializer.CodeWebAuthnCredentialError, "Failed to validate assertion", err)
+	}
+
+	if err := dep.UserClient().MarkPasskeyUsed(c, u.ID, base64.StdEncoding.EncodeToString(credential.ID)); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to update passkey", err)
+	}
+
+	return nil
+}
+
+// deletionPending returns true if the user is blocked by a self-service deletion that can still be
+// reactivated by login.
+func deletionPending(u *ent.User) bool {
+	return u.Status == user.StatusSysBanned && u.Settings != nil && u.Settings.Deletion != nil &&
+		u.Settings.Deletion.PurgeAt.After(time.Now())
+}
+
+// loginAllowed returns true if the user is active or can login to reactivate the account.
+func loginAllowed(u *ent.User) bool {
+	return u.Status == user.StatusActive || deletionPending(u)
+}
+
+// reactivateAccount cancels pending deletion of the user on successful login.
+func reactivateAccount(c *gin.Context, dep dependency.Dep, u *ent.User) (*ent.User, error) {
+	userClient, tx, ctx, err := inventory.WithTx(c, dep.UserClient())
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to start transaction", err)
+	}
+
+	u.Settings.Deletion = nil
+	if err := userClient.SaveSettings(ctx, u); err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to cancel account deletion", err)
+	}
+
+	reactivated, err := userClient.SetStatus(ctx, u, user.StatusActive)
+	if err != nil {
+		_ = inventory.Rollback(tx)
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to reactivate account", err)
+	}
+
+	if err := inventory.Commit(tx); err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to commit account reactivation", err)
+	}
+
+	// Keep loaded edges like group for response.
+	reactivated.Edges = u.Edges
+	dep.Logger().Info("User %d is reactivated by login, account deletion is canceled.", u.ID)
+	return reactivated, nil
+}
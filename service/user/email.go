@@ -0,0 +1,262 @@
+package user
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/email"
+	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+const emailChangeTTL = 24 * time.Hour
+
+var errEmailChangeLinkExpired = errors.New("email change link is expired")
+
+type (
+	// ChangeEmailService starts an email change, the new address must be confirmed before it takes effect.
+	ChangeEmailService struct {
+		Email string `json:"email" binding:"required,email"`
+		// Password current password, not required for passwordless users.
+		Password string `json:"password" binding:"max=128"`
+	}
+	ChangeEmailParameterCtx struct{}
+)
+
+// Request records a pending email change, sends a confirmation link to the new address and notifies the
+// old address.
+func (s *ChangeEmailService) Request(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	userClient := dep.UserClient()
+	u := inventory.UserFromContext(c)
+	newEmail := strings.ToLower(s.Email)
+
+	if u.Password != "" {
+		if err := inventory.CheckPassword(u, s.Password); err != nil {
+			return serializer.NewError(serializer.CodeIncorrectPassword, "Incorrect password", err)
+		}
+	}
+
+	if newEmail == strings.ToLower(u.Email) {
+		return serializer.NewError(serializer.CodeParamErr, "New email is the same as current one", nil)
+	}
+
+	if _, err := userClient.GetByEmail(c, newEmail); err == nil {
+		return serializer.NewError(serializer.CodeEmailExisted, "Email already in use", nil)
+	}
+
+	confirmSecret, err := util.SecureRandString(32, util.RandomVariantAll)
+	if err != nil {
+		return serializer.NewError(serializer.CodeEncryptError, "Failed to generate secret", err)
+	}
+
+	pending := &types.PendingEmailChange{
+		Email:         newEmail,
+		ConfirmDigest: digestEmailChangeSecret(confirmSecret),
+		RequestedAt:   time.Now(),
+		ExpiresAt:     time.Now().Add(emailChangeTTL),
+	}
+
+	vetoSecret := ""
+	if dep.SettingProvider().EmailChangeVetoEnabled(c) {
+		vetoSecret, err = util.SecureRandString(32, util.RandomVariantAll)
+		if err != nil {
+			return serializer.NewError(serializer.CodeEncryptError, "Failed to generate secret", err)
+		}
+
+		pending.VetoDigest = digestEmailChangeSecret(vetoSecret)
+	}
+
+	if _, err := userClient.UpdateSettings(c, u.ID, func(locked *ent.User) error {
+		locked.Settings.PendingEmail = pending
+		return nil
+	}); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to save pending email change", err)
+	}
+
+	base := dep.SettingProvider().SiteURL(c)
+	uid := hashid.EncodeUserID(dep.HashIDEncoder(), u.ID)
+	title, body, err := email.NewEmailChangeEmail(c, dep.SettingProvider(), u, newEmail,
+		emailChangeLink(routes.MasterEmailConfirmUrl(base), uid, confirmSecret))
+	if err != nil {
+		return serializer.NewError(serializer.CodeFailedSendEmail, "Failed to send confirmation email", err)
+	}
+
+	if err := dep.EmailClient(c).Send(c, newEmail, title, body); err != nil {
+		return serializer.NewError(serializer.CodeFailedSendEmail, "Failed to send confirmation email", err)
+	}
+
+	vetoUrl := ""
+	if vetoSecret != "" {
+		vetoUrl = emailChangeLink(routes.MasterEmailVetoUrl(base), uid, vetoSecret)
+	}
+
+	title, body, err = email.NewEmailChangeNotifyEmail(c, dep.SettingProvider(), u, newEmail, vetoUrl)
+	if err != nil {
+		dep.Logger().Warning("Failed to generate email change notification: %s", err)
+	} else if err := dep.EmailClient(c).Send(c, u.Email, title, body); err != nil {
+		dep.Logger().Warning("Failed to send email change notification: %s", err)
+	}
+
+	return nil
+}
+
+// CancelEmailChange cancels pending email change of current user.
+func CancelEmailChange(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+	if u.Settings.PendingEmail == nil {
+		return nil
+	}
+
+	if _, err := dep.UserClient().UpdateSettings(c, u.ID, func(locked *ent.User) error {
+		locked.Settings.PendingEmail = nil
+		return nil
+	}); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to cancel pending email change", err)
+	}
+
+	return nil
+}
+
+type (
+	// EmailChangeSecretService confirms or vetoes a pending email change with the secret sent by email.
+	EmailChangeSecretService struct {
+		Secret string `json:"secret" binding:"required"`
+	}
+	EmailChangeSecretParameterCtx struct{}
+)
+
+// Confirm confirms the pending email change with secret sent to the new address. If veto is enabled, the
+// old address stays in use until the veto window passes, otherwise the change takes effect immediately.
+func (s *EmailChangeSecretService) Confirm(c *gin.Context) (*User, error) {
+	dep := dependency.FromContext(c)
+	userClient := dep.UserClient()
+	u, err := s.pendingUser(c, func(p *types.PendingEmailChange) string { return p.ConfirmDigest })
+	if err != nil {
+		return nil, err
+	}
+
+	pending := u.Settings.PendingEmail
+	if pending.EffectiveAt != nil {
+		return nil, serializer.NewError(serializer.CodeTempLinkExpired, "Link is expired", nil)
+	}
+
+	if existed, err := userClient.GetByEmail(c, pending.Email); err == nil && existed.ID != u.ID {
+		return nil, serializer.NewError(serializer.CodeEmailExisted, "Email already in use", nil)
+	}
+
+	effectiveAt := time.Now()
+	if pending.VetoDigest != "" {
+		effectiveAt = effectiveAt.Add(dep.SettingProvider().EmailChangeVetoWindow(c))
+	}
+
+	u, err = userClient.UpdateSettings(c, u.ID, func(locked *ent.User) error {
+		if locked.Settings.PendingEmail == nil || locked.Settings.PendingEmail.ConfirmDigest != pending.ConfirmDigest {
+			return errEmailChangeLinkExpired
+		}
+
+		locked.Settings.PendingEmail.EffectiveAt = &effectiveAt
+		return nil
+	})
+	if errors.Is(err, errEmailChangeLinkExpired) {
+		return nil, serializer.NewError(serializer.CodeTempLinkExpired, "Link is expired", nil)
+	} else if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to confirm email change", err)
+	}
+
+	if pending.VetoDigest == "" {
+		applied, err := manager.ApplyEmailChange(c, u.ID)
+		if errors.Is(err, manager.ErrEmailChangeConflict) {
+			return nil, serializer.NewError(serializer.CodeEmailExisted, "Email already in use", nil)
+		} else if err != nil {
+			return nil, serializer.NewError(serializer.CodeDBError, "Failed to update email", err)
+		}
+
+		if applied != nil {
+			u = applied
+		}
+	}
+
+	res := BuildUser(u, dep.HashIDEncoder())
+	return &res, nil
+}
+
+// Veto cancels the pending email change with secret sent to the old address, it works until the change
+// takes effect.
+func (s *EmailChangeSecretService) Veto(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	u, err := s.pendingUser(c, func(p *types.PendingEmailChange) string { return p.VetoDigest })
+	if err != nil {
+		return err
+	}
+
+	vetoDigest := u.Settings.PendingEmail.VetoDigest
+	if _, err := dep.UserClient().UpdateSettings(c, u.ID, func(locked *ent.User) error {
+		if locked.Settings.PendingEmail == nil || locked.Settings.PendingEmail.VetoDigest != vetoDigest {
+			return errEmailChangeLinkExpired
+		}
+
+		locked.Settings.PendingEmail = nil
+		return nil
+	}); errors.Is(err, errEmailChangeLinkExpired) {
+		return serializer.NewError(serializer.CodeTempLinkExpired, "Link is expired", nil)
+	} else if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to cancel pending email change", err)
+	}
+
+	return nil
+}
+
+// pendingUser returns the user in route with a valid pending email change matching the secret.
+func (s *EmailChangeSecretService) pendingUser(c *gin.Context, digest func(p *types.PendingEmailChange) string) (*ent.User, error) {
+	dep := dependency.FromContext(c)
+	u, err := dep.UserClient().GetActiveByID(c, hashid.FromContext(c))
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeUserNotFound, "User not found", err)
+	}
+
+	pending := u.Settings.PendingEmail
+	if pending == nil || emailChangeLinkExpired(pending, time.Now()) || digest(pending) == "" ||
+		subtle.ConstantTimeCompare([]byte(digest(pending)), []byte(digestEmailChangeSecret(s.Secret))) != 1 {
+		return nil, serializer.NewError(serializer.CodeTempLinkExpired, "Link is expired", nil)
+	}
+
+	return u, nil
+}
+
+// emailChangeLinkExpired returns true if links of the pending change can no longer be used. Confirmed changes
+// stay vetoable until they take effect.
+func emailChangeLinkExpired(p *types.PendingEmailChange, now time.Time) bool {
+	if p.EffectiveAt != nil {
+		return !p.EffectiveAt.After(now)
+	}
+
+	return p.ExpiresAt.Before(now)
+}
+
+func emailChangeLink(link *url.URL, uid, secret string) string {
+	queries := link.Query()
+	queries.Add("id", uid)
+	queries.Add("secret", secret)
+	link.RawQuery = queries.Encode()
+	return link.String()
+}
+
+func digestEmailChangeSecret(secret string) string {
+	digest := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(digest[:])
+}
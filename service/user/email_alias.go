@@ -0,0 +1,180 @@
+package user
+
+import (
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
+	"github.com/cloudreve/Cloudreve/v4/pkg/email"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+// maxEmailAliases maximum number of secondary emails per user, including unverified ones.
+const maxEmailAliases = 5
+
+type (
+	// AddEmailAliasService adds a secondary email, it can be used for login once confirmed.
+	AddEmailAliasService struct {
+		Email string `json:"email" binding:"required,email"`
+		// Password current password, not required for passwordless users.
+		Password string `json:"password" binding:"max=128"`
+	}
+	AddEmailAliasParameterCtx struct{}
+)
+
+// Add records an unverified secondary email and sends a confirmation link to it. Adding an existing
+// unverified address again resends the link.
+func (s *AddEmailAliasService) Add(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	userClient := dep.UserClient()
+	u := inventory.UserFromContext(c)
+	alias := strings.ToLower(s.Email)
+
+	if u.Password != "" {
+		if err := inventory.CheckPassword(u, s.Password); err != nil {
+			return serializer.NewError(serializer.CodeIncorrectPassword, "Incorrect password", err)
+		}
+	}
+
+	if _, err := userClient.GetByEmail(c, alias); err == nil {
+		return serializer.NewError(serializer.CodeEmailExisted, "Email already in use", nil)
+	}
+
+	emails, err := userClient.ListEmails(c, u.ID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to list secondary emails", err)
+	}
+
+	others := lo.Filter(emails, func(item *ent.UserEmail, index int) bool {
+		return item.Email != alias
+	})
+	if len(others) >= maxEmailAliases {
+		return serializer.NewError(serializer.CodeParamErr, "Too many secondary emails", nil)
+	}
+
+	secret, err := util.SecureRandString(32, util.RandomVariantAll)
+	if err != nil {
+		return serializer.NewError(serializer.CodeEncryptError, "Failed to generate secret", err)
+	}
+
+	if _, err := userClient.AddEmail(c, u.ID, alias, digestEmailChangeSecret(secret), time.Now().Add(emailChangeTTL)); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to save secondary email", err)
+	}
+
+	link := routes.MasterEmailAliasUrl(dep.SettingProvider().SiteURL(c))
+	queries := link.Query()
+	queries.Add("email", alias)
+	link.RawQuery = queries.Encode()
+	title, body, err := email.NewEmailAliasEmail(c, dep.SettingProvider(), u, alias,
+		emailChangeLink(link, hashid.EncodeUserID(dep.HashIDEncoder(), u.ID), secret))
+	if err != nil {
+		return serializer.NewError(serializer.CodeFailedSendEmail, "Failed to send confirmation email", err)
+	}
+
+	if err := dep.EmailClient(c).Send(c, alias, title, body); err != nil {
+		return serializer.NewError(serializer.CodeFailedSendEmail, "Failed to send confirmation email", err)
+	}
+
+	return nil
+}
+
+type (
+	// EmailAliasService operates on a secondary email of current user.
+	EmailAliasService struct {
+		Email string `json:"email" binding:"required,email"`
+	}
+	EmailAliasParameterCtx struct{}
+)
+
+// Remove deletes the secondary email from current user.
+func (s *EmailAliasService) Remove(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+	if err := dep.UserClient().RemoveEmail(c, u.ID, s.Email); err != nil {
+		if ent.IsNotFound(err) {
+			return serializer.NewError(serializer.CodeNotFound, "Secondary email not found", nil)
+		}
+
+		return serializer.NewError(serializer.CodeDBError, "Failed to remove secondary email", err)
+	}
+
+	return nil
+}
+
+// SetPrimary swaps the verified secondary email with the primary one, notifications are sent to
+// the primary address.
+func (s *EmailAliasService) SetPrimary(c *gin.Context) (*User, error) {
+	dep := dependency.FromContext(c)
+	userClient := dep.UserClient()
+	u := inventory.UserFromContext(c)
+	emails, err := userClient.ListEmails(c, u.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list secondary emails", err)
+	}
+
+	alias, found := lo.Find(emails, func(item *ent.UserEmail) bool {
+		return item.VerifiedAt != nil && strings.EqualFold(item.Email, s.Email)
+	})
+	if !found {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Verified secondary email not found", nil)
+	}
+
+	u, err = userClient.SwapPrimaryEmail(c, u, alias)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update email", err)
+	}
+
+	res := BuildUser(u, dep.HashIDEncoder())
+	return &res, nil
+}
+
+type (
+	// ConfirmEmailAliasService verifies a secondary email with the secret sent to it.
+	ConfirmEmailAliasService struct {
+		Email  string `json:"email" binding:"required,email"`
+		Secret string `json:"secret" binding:"required"`
+	}
+	ConfirmEmailAliasParameterCtx struct{}
+)
+
+// Confirm marks the secondary email of user in route as verified.
+func (s *ConfirmEmailAliasService) Confirm(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	userClient := dep.UserClient()
+	u, err := userClient.GetActiveByID(c, hashid.FromContext(c))
+	if err != nil {
+		return serializer.NewError(serializer.CodeUserNotFound, "User not found", err)
+	}
+
+	emails, err := userClient.ListEmails(c, u.ID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to list secondary emails", err)
+	}
+
+	alias, found := lo.Find(emails, func(item *ent.UserEmail) bool {
+		return item.VerifiedAt == nil && strings.EqualFold(item.Email, s.Email)
+	})
+	if !found || alias.ExpiresAt == nil || alias.ExpiresAt.Before(time.Now()) ||
+		subtle.ConstantTimeCompare([]byte(alias.ConfirmDigest), []byte(digestEmailChangeSecret(s.Secret))) != 1 {
+		return serializer.NewError(serializer.CodeTempLinkExpired, "Link is expired", nil)
+	}
+
+	// Address might be taken by others after the link is sent.
+	if existed, err := userClient.GetByEmail(c, alias.Email); err == nil && existed.ID != u.ID {
+		return serializer.NewError(serializer.CodeEmailExisted, "Email already in use", nil)
+	}
+
+	if err := userClient.VerifyEmail(c, alias.ID); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to verify secondary email", err)
+	}
+
+	return nil
+}
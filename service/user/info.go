@@ -5,6 +5,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/fs"
 	"github.com/cloudreve/Cloudreve/v4/pkg/filemanager/manager"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
@@ -21,13 +22,27 @@ func GetUser(c *gin.Context) (*ent.User, error) {
 	return userClient.GetByID(ctx, uid)
 }
 
-func GetUserCapacity(c *gin.Context) (*fs.Capacity, error) {
+// UserCapacity is the storage capacity of user, along with the in-app quota alert if any.
+type UserCapacity struct {
+	*fs.Capacity
+	QuotaAlert *types.QuotaAlert `json:"quota_alert,omitempty"`
+}
+
+func GetUserCapacity(c *gin.Context) (*UserCapacity, error) {
 	user := inventory.UserFromContext(c)
 	dep := dependency.FromContext(c)
 	m := manager.NewFileManager(dep, user)
 	defer m.Recycle()
 
-	return m.Capacity(c)
+	capacity, err := m.Capacity(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserCapacity{
+		Capacity:   capacity,
+		QuotaAlert: user.Settings.QuotaAlert,
+	}, nil
 }
 
 type (
@@ -0,0 +1,160 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/inventory/types"
+	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+const (
+	maxInvitationsPerUser = 100
+	invitationCodeLength  = 24
+)
+
+// ListInvitations lists invitation codes generated by current user.
+func ListInvitations(c *gin.Context) ([]Invitation, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+	if !canInvite(u) {
+		return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Group permission denied", nil)
+	}
+
+	invitations, err := dep.UserClient().ListInvitations(c, u.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list invitation codes", err)
+	}
+
+	return lo.Map(invitations, func(item *ent.Invitation, index int) Invitation {
+		return BuildInvitation(item, dep.HashIDEncoder())
+	}), nil
+}
+
+type (
+	CreateInvitationService struct {
+		Note string `json:"note" binding:"max=255"`
+		// GroupID target group of invited users, only admin can set it.
+		GroupID int `json:"group_id" binding:"min=0"`
+		// MaxStorage max storage override of invited users in bytes, only admin can set it.
+		MaxStorage *int64 `json:"max_storage" binding:"omitempty,min=0"`
+		MaxUses    int    `json:"max_uses" binding:"min=0"`
+		// ExpiresIn is the TTL of the code in seconds, 0 means never expire.
+		ExpiresIn int `json:"expires_in" binding:"min=0"`
+	}
+	CreateInvitationParameterCtx struct{}
+)
+
+// Create generates a new invitation code for current user.
+func (s *CreateInvitationService) Create(c *gin.Context) (*Invitation, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+	if !canInvite(u) {
+		return nil, serializer.NewError(serializer.CodeGroupNotAllowed, "Group permission denied", nil)
+	}
+
+	if s.GroupID > 0 || s.MaxStorage != nil {
+		if !u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) {
+			return nil, serializer.NewError(serializer.CodeNoPermissionErr, "Only admin can specify target group or storage", nil)
+		}
+	}
+
+	if s.GroupID > 0 {
+		if _, err := dep.GroupClient().GetByID(c, s.GroupID); err != nil {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Group not found", err)
+		}
+	}
+
+	count, err := dep.UserClient().CountInvitations(c, u.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to count invitation codes", err)
+	}
+
+	if count >= maxInvitationsPerUser {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Too many invitation codes", nil)
+	}
+
+	code, err := util.SecureRandString(invitationCodeLength, util.RandomVariantAll)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeEncryptError, "Failed to generate invitation code", err)
+	}
+
+	invitation := &ent.Invitation{
+		UserID:     u.ID,
+		Code:       code,
+		Note:       s.Note,
+		GroupID:    s.GroupID,
+		MaxStorage: s.MaxStorage,
+		MaxUses:    s.MaxUses,
+	}
+	if s.ExpiresIn > 0 {
+		invitation.ExpiresAt = lo.ToPtr(time.Now().Add(time.Duration(s.ExpiresIn) * time.Second))
+	}
+
+	invitation, err = dep.UserClient().AddInvitation(c, invitation)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to save invitation code", err)
+	}
+
+	res := BuildInvitation(invitation, dep.HashIDEncoder())
+	return &res, nil
+}
+
+type (
+	DeleteInvitationService struct {
+		ID string `uri:"id" binding:"required"`
+	}
+	DeleteInvitationParameterCtx struct{}
+)
+
+// Delete revokes an invitation code of current user.
+func (s *DeleteInvitationService) Delete(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+
+	id, err := dep.HashIDEncoder().Decode(s.ID, hashid.InvitationID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Invitation code not found", err)
+	}
+
+	if err := dep.UserClient().RemoveInvitation(c, u.ID, id); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to delete invitation code", err)
+	}
+
+	return nil
+}
+
+// findInvitation resolves an invitation code into the invitation record, with inviter loaded.
+func findInvitation(ctx context.Context, userClient inventory.UserClient, code string) (*ent.Invitation, error) {
+	invalid := serializer.NewError(serializer.CodeInvalidInvitation, "Invitation code is invalid or expired", nil)
+	invitation, err := userClient.GetInvitationByCode(ctx, code)
+	if err != nil {
+		return nil, invalid
+	}
+
+	inviter := invitation.Edges.User
+	if inviter == nil || inviter.Status != user.StatusActive || !canInvite(inviter) ||
+		(invitation.ExpiresAt != nil && invitation.ExpiresAt.Before(time.Now())) ||
+		(invitation.MaxUses > 0 && invitation.Uses >= invitation.MaxUses) {
+		return nil, invalid
+	}
+
+	return invitation, nil
+}
+
+func canInvite(u *ent.User) bool {
+	if u.Edges.Group == nil {
+		return false
+	}
+
+	return u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionIsAdmin)) ||
+		u.Edges.Group.Permissions.Enabled(int(types.GroupPermissionInvite))
+}
@@ -3,16 +3,20 @@ package user
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/ent/user"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
 	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/passwordpolicy"
 	"github.com/cloudreve/Cloudreve/v4/pkg/cluster/routes"
 	"github.com/cloudreve/Cloudreve/v4/pkg/email"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/ipaccess"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/cloudreve/Cloudreve/v4/pkg/setting"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
@@ -58,6 +62,10 @@ func (service *UserResetService) Reset(c *gin.Context) (*User, error) {
 		return nil, serializer.NewError(serializer.CodeUserNotFound, "User not found", err)
 	}
 
+	if err := validateNewPassword(c, dep, u, service.Password); err != nil {
+		return nil, err
+	}
+
 	u, err = userClient.UpdatePassword(c, u, service.Password)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to update password", err)
@@ -76,7 +84,10 @@ type (
 	UserResetEmailParameterCtx struct{}
 )
 
-const userResetPrefix = "user_reset_"
+const (
+	userResetPrefix      = "user_reset_"
+	user2FASessionPrefix = "user_2fa_"
+)
 
 // Reset 发送密码重设邮件
 func (service *UserResetEmailService) Reset(c *gin.Context) error {
@@ -88,7 +99,7 @@ func (service *UserResetEmailService) Reset(c *gin.Context) error {
 		return serializer.NewError(serializer.CodeUserNotFound, "User not found", err)
 	}
 
-	if u.Status == user.StatusManualBanned || u.Status == user.StatusSysBanned {
+	if u.Status == user.StatusManualBanned || u.Status == user.StatusSysBanned || u.Status == user.StatusArchived {
 		return serializer.NewError(serializer.CodeUserBaned, "This user is banned", nil)
 	}
 
@@ -125,15 +136,34 @@ func (service *UserLoginService) Login(c *gin.Context) (*ent.User, string, error
 	dep := dependency.FromContext(c)
 	userClient := dep.UserClient()
 
+	kv := dep.KV()
+	protection := dep.SettingProvider().LoginProtection(c)
+	ip := ipaccess.RequestClientIP(c, dep).String()
+
 	ctx := context.WithValue(c, inventory.LoadUserGroup{}, true)
+	ctx = context.WithValue(ctx, inventory.LoadUserPasskey{}, true)
 	expectedUser, err := userClient.GetByEmail(ctx, service.UserName)
+	if err != nil {
+		expectedUser = nil
+	}
+
+	// Unknown accounts are locked the same way, so that lockouts do not reveal whether an account exists.
+	subject := auth.LoginSubject(expectedUser, service.UserName)
+	if lockedUntil := auth.LoginLocks(kv, protection, subject, ip); !lockedUntil.IsZero() {
+		return nil, "", loginLockedError(lockedUntil)
+	}
 
 	// 一系列校验
 	if err != nil {
+		recordLoginFailure(c, dep, protection, nil, subject, ip)
 		err = serializer.NewError(serializer.CodeInvalidPassword, "Incorrect password or email address", err)
 	} else if checkErr := inventory.CheckPassword(expectedUser, service.Password); checkErr != nil {
+		recordLoginFailure(c, dep, protection, expectedUser, subject, ip)
 		err = serializer.NewError(serializer.CodeInvalidPassword, "Incorrect password or email address", err)
-	} else if expectedUser.Status == user.StatusManualBanned || expectedUser.Status == user.StatusSysBanned {
+	} else if deletionPending(expectedUser) {
+		// Login during grace period of account deletion reactivates the account, see IssueToken.
+	} else if expectedUser.Status == user.StatusManualBanned || expectedUser.Status == user.StatusSysBanned ||
+		expectedUser.Status == user.StatusArchived {
 		err = serializer.NewError(serializer.CodeUserBaned, "This account has been blocked", nil)
 	} else if expectedUser.Status == user.StatusInactive {
 		err = serializer.NewError(serializer.CodeUserNotActivated, "This account is not activated", nil)
@@ -143,15 +173,67 @@ func (service *UserLoginService) Login(c *gin.Context) (*ent.User, string, error
 		return nil, "", err
 	}
 
-	if expectedUser.TwoFactorSecret != "" {
+	if passwordpolicy.Expired(dep.SettingProvider().PasswordPolicy(c), expectedUser) {
+		return nil, "", serializer.NewError(serializer.CodePasswordExpired, "Password expired, please change your password", nil)
+	}
+
+	if expectedUser.TwoFactorSecret != "" || passkey2FAEnabled(expectedUser) {
 		twoFaSessionID := uuid.Must(uuid.NewV4())
-		dep.KV().Set(fmt.Sprintf("user_2fa_%s", twoFaSessionID), expectedUser.ID, 600)
+		dep.KV().Set(fmt.Sprintf("%s%s", user2FASessionPrefix, twoFaSessionID), expectedUser.ID, 600)
 		return expectedUser, twoFaSessionID.String(), nil
 	}
 
+	// Failures are kept until second factor is verified, otherwise a known password could be used to
+	// reset the counter between 2FA attempts.
+	resetLoginFailure(dep, expectedUser)
 	return expectedUser, "", nil
 }
 
+func loginLockedError(lockedUntil time.Time) error {
+	return serializer.NewError(serializer.CodeLoginLocked,
+		fmt.Sprintf("Too many failed login attempts, please try again after %s", lockedUntil.Format(time.RFC3339)), nil)
+}
+
+// recordLoginFailure records a failed login attempt of subject, and notifies the user by email if the
+// account gets locked. u is nil if the account does not exist.
+func recordLoginFailure(c *gin.Context, dep dependency.Dep, protection *setting.LoginProtection, u *ent.User, subject, ip string) {
+	lockedUntil, err := auth.RecordLoginFailure(dep.KV(), protection, subject, ip)
+	if err != nil {
+		dep.Logger().Warning("Failed to record login failure: %s", err)
+		return
+	}
+
+	if lockedUntil == nil || u == nil {
+		return
+	}
+
+	dep.Logger().Info("User %d is locked until %s due to too many failed login attempts from %s.", u.ID, lockedUntil, ip)
+	if !protection.NotifyUser {
+		return
+	}
+
+	// Send in background so that response time does not tell whether the account exists.
+	ctx := c.Copy()
+	go func() {
+		title, body, err := email.NewLoginLockedEmail(ctx, dep.SettingProvider(), u, ip, *lockedUntil)
+		if err != nil {
+			dep.Logger().Warning("Failed to generate login locked email: %s", err)
+			return
+		}
+
+		if err := dep.EmailClient(ctx).Send(ctx, u.Email, title, body); err != nil {
+			dep.Logger().Warning("Failed to send login locked email: %s", err)
+		}
+	}()
+}
+
+// resetLoginFailure clears failed login records of u after a successful login.
+func resetLoginFailure(dep dependency.Dep, u *ent.User) {
+	if err := auth.ResetLoginFailure(dep.KV(), auth.LoginSubject(u, "")); err != nil {
+		dep.Logger().Warning("Failed to reset login failure record of user %d: %s", u.ID, err)
+	}
+}
+
 type (
 	LoginLogCtx struct{}
 )
@@ -159,6 +241,15 @@ type (
 func IssueToken(c *gin.Context) (*BuiltinLoginResponse, error) {
 	dep := dependency.FromContext(c)
 	u := inventory.UserFromContext(c)
+	if deletionPending(u) {
+		reactivated, err := reactivateAccount(c, dep, u)
+		if err != nil {
+			return nil, err
+		}
+
+		u = reactivated
+	}
+
 	token, err := dep.TokenAuth().Issue(c, u, nil)
 	if err != nil {
 		return nil, serializer.NewError(serializer.CodeEncryptError, "Failed to issue token pair", err)
@@ -217,7 +308,7 @@ func (service *OtpValidationService) Verify2FA(c *gin.Context) (*ent.User, error
 	dep := dependency.FromContext(c)
 	kv := dep.KV()
 
-	sessionRaw, ok := kv.Get(fmt.Sprintf("user_2fa_%s", service.SessionID))
+	sessionRaw, ok := kv.Get(fmt.Sprintf("%s%s", user2FASessionPrefix, service.SessionID))
 	if !ok {
 		return nil, serializer.NewError(serializer.CodeNotFound, "Session not found", nil)
 	}
@@ -229,17 +320,30 @@ func (service *OtpValidationService) Verify2FA(c *gin.Context) (*ent.User, error
 		return nil, serializer.NewError(serializer.CodeNotFound, "User not found", err)
 	}
 
-	if expectedUser.TwoFactorSecret != "" {
-		if !totp.Validate(service.OTP, expectedUser.TwoFactorSecret) {
-			err := serializer.NewError(serializer.Code2FACodeErr, "Incorrect 2FA code", nil)
-			return nil, err
-		}
+	protection := dep.SettingProvider().LoginProtection(c)
+	subject := auth.LoginSubject(expectedUser, "")
+	ip := ipaccess.RequestClientIP(c, dep).String()
+	if lockedUntil := auth.LoginLocks(kv, protection, subject, ip); !lockedUntil.IsZero() {
+		return nil, loginLockedError(lockedUntil)
 	}
 
-	kv.Delete("user_2fa_", service.SessionID)
+	// OTP cannot be used if user only enabled passkey as second factor.
+	if expectedUser.TwoFactorSecret == "" || !totp.Validate(service.OTP, expectedUser.TwoFactorSecret) {
+		recordLoginFailure(c, dep, protection, expectedUser, subject, ip)
+		err := serializer.NewError(serializer.Code2FACodeErr, "Incorrect 2FA code", nil)
+		return nil, err
+	}
+
+	kv.Delete(user2FASessionPrefix, service.SessionID)
+	resetLoginFailure(dep, expectedUser)
 	return expectedUser, nil
 }
 
+// passkey2FAEnabled returns true if given user can use passkey as second factor. User passkeys must be loaded.
+func passkey2FAEnabled(u *ent.User) bool {
+	return u.Settings != nil && u.Settings.TwoFAPasskey && len(u.Edges.Passkey) > 0
+}
+
 type (
 	PrepareLoginParameterCtx struct{}
 	PrepareLoginService      struct {
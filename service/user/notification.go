@@ -0,0 +1,103 @@
+package user
+
+import (
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+type (
+	ListNotificationService struct {
+		UnreadOnly bool `form:"unread_only"`
+	}
+	ListNotificationParameterCtx struct{}
+)
+
+// List lists notifications of current user, newest first.
+func (s *ListNotificationService) List(c *gin.Context) (*ListNotificationResponse, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+	nc := dep.NotificationClient()
+
+	notifications, err := nc.List(c, u.ID, s.UnreadOnly)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list notifications", err)
+	}
+
+	unread, err := nc.CountUnread(c, u.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to count unread notifications", err)
+	}
+
+	return &ListNotificationResponse{
+		Notifications: lo.Map(notifications, func(item *ent.Notification, index int) Notification {
+			return BuildNotification(item)
+		}),
+		Unread: unread,
+	}, nil
+}
+
+type (
+	MarkNotificationReadService struct {
+		IDs []int `json:"ids"`
+		// All marks all notifications as read, IDs are ignored.
+		All bool `json:"all"`
+	}
+	MarkNotificationReadParameterCtx struct{}
+)
+
+// MarkRead marks given notifications of current user as read.
+func (s *MarkNotificationReadService) MarkRead(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+	if !s.All && len(s.IDs) == 0 {
+		return serializer.NewError(serializer.CodeParamErr, "No notification specified", nil)
+	}
+
+	ids := s.IDs
+	if s.All {
+		ids = nil
+	}
+
+	if err := dep.NotificationClient().MarkRead(c, u.ID, ids); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to update notifications", err)
+	}
+
+	return nil
+}
+
+type (
+	DismissAnnouncementService struct {
+		ID int `uri:"id" binding:"required"`
+	}
+	DismissAnnouncementParameterCtx struct{}
+)
+
+// Dismiss hides a dismissible announcement for current user.
+func (s *DismissAnnouncementService) Dismiss(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+	ac := dep.AnnouncementClient()
+
+	announcement, err := ac.GetByID(c, s.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return serializer.NewError(serializer.CodeNotFound, "Announcement not found", err)
+		}
+
+		return serializer.NewError(serializer.CodeDBError, "Failed to get announcement", err)
+	}
+
+	if !announcement.Dismissible {
+		return serializer.NewError(serializer.CodeNoPermissionErr, "Announcement cannot be dismissed", nil)
+	}
+
+	if err := ac.Dismiss(c, s.ID, u.ID); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to dismiss announcement", err)
+	}
+
+	return nil
+}
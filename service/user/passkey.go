@@ -9,7 +9,10 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/passwordpolicy"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
+	"github.com/cloudreve/Cloudreve/v4/pkg/ipaccess"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
 	"github.com/gin-gonic/gin"
@@ -120,13 +123,13 @@ func (s *FinishPasskeyLoginService) FinishPasskeyLogin(c *gin.Context) (*ent.Use
 
 		ctx := context.WithValue(c, inventory.LoadUserPasskey{}, true)
 		ctx = context.WithValue(ctx, inventory.LoadUserGroup{}, true)
-		u, err := userClient.GetLoginUserByID(ctx, uid)
+		u, err := userClient.GetByID(ctx, uid)
 		if err != nil {
 			return nil, serializer.NewError(serializer.CodeDBError, "Failed to get user", err)
 		}
 
-		if inventory.IsAnonymousUser(u) {
-			return nil, errors.New("anonymous user")
+		if !loginAllowed(u) {
+			return nil, errors.New("user is not active")
 		}
 
 		loginedUser = u
@@ -148,6 +151,11 @@ func (s *FinishPasskeyLoginService) FinishPasskeyLogin(c *gin.Context) (*ent.Use
 		return nil, serializer.NewError(serializer.CodeInternalSetting, "Passkey login passed but credential used is unknown", nil)
 	}
 
+	// Passkey does not bypass password expiration, otherwise an expired password would stay valid forever.
+	if passwordpolicy.Expired(dep.SettingProvider().PasswordPolicy(c), loginedUser) {
+		return nil, serializer.NewError(serializer.CodePasswordExpired, "Password expired, please change your password", nil)
+	}
+
 	// Update used at
 	if err := userClient.MarkPasskeyUsed(c, loginedUser.ID, usedCredential.CredentialID); err != nil {
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to update passkey", err)
@@ -280,9 +288,243 @@ func (s *DeletePasskeyService) DeletePasskey(c *gin.Context) error {
 		return serializer.NewError(serializer.CodeNotFound, "Passkey not found", nil)
 	}
 
+	// Passkey 2FA would be silently disabled without any passkey left.
+	if len(existingKeys) == 1 && u.Settings != nil && u.Settings.TwoFAPasskey {
+		return serializer.NewError(serializer.CodeParamErr, "Disable passkey as second factor before deleting the last passkey", nil)
+	}
+
 	if err := userClient.RemovePasskey(c, u.ID, s.ID); err != nil {
 		return serializer.NewError(serializer.CodeDBError, "Failed to delete passkey", err)
 	}
 
 	return nil
 }
+
+type (
+	RenamePasskeyService struct {
+		ID   string `json:"id" binding:"required"`
+		Name string `json:"name" binding:"required,min=1,max=255"`
+	}
+	RenamePasskeyParameterCtx struct{}
+)
+
+func (s *RenamePasskeyService) RenamePasskey(c *gin.Context) (*Passkey, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+
+	passkey, err := dep.UserClient().RenamePasskey(c, u.ID, s.ID, s.Name)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, serializer.NewError(serializer.CodeNotFound, "Passkey not found", nil)
+		}
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to rename passkey", err)
+	}
+
+	res := BuildPasskey(passkey)
+	return &res, nil
+}
+
+const (
+	authn2FASessionKey = "authn_2fa_"
+)
+
+type (
+	PreparePasskey2FAParameterCtx struct{}
+	PreparePasskey2FAService      struct {
+		SessionID string `json:"session_id" binding:"required"`
+	}
+)
+
+// Prepare begins a WebAuthn assertion using credentials of the user in given 2FA session.
+func (s *PreparePasskey2FAService) Prepare(c *gin.Context) (*protocol.CredentialAssertion, error) {
+	dep := dependency.FromContext(c)
+	kv := dep.KV()
+
+	sessionRaw, ok := kv.Get(fmt.Sprintf("%s%s", user2FASessionPrefix, s.SessionID))
+	if !ok {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Session not found", nil)
+	}
+
+	ctx := context.WithValue(c, inventory.LoadUserPasskey{}, true)
+	expectedUser, err := dep.UserClient().GetByID(ctx, sessionRaw.(int))
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "User not found", err)
+	}
+
+	if !passkey2FAEnabled(expectedUser) {
+		return nil, serializer.NewError(serializer.CodeFeatureNotEnabled, "Passkey is not enabled as second factor", nil)
+	}
+
+	protection := dep.SettingProvider().LoginProtection(c)
+	subject := auth.LoginSubject(expectedUser, "")
+	ip := ipaccess.RequestClientIP(c, dep).String()
+	if lockedUntil := auth.LoginLocks(kv, protection, subject, ip); !lockedUntil.IsZero() {
+		return nil, loginLockedError(lockedUntil)
+	}
+
+	webAuthn, err := dep.WebAuthn(c)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to initialize WebAuthn", err)
+	}
+
+	options, sessionData, err := webAuthn.BeginLogin(&authnUser{
+		u:           expectedUser,
+		hasher:      dep.HashIDEncoder(),
+		credentials: expectedUser.Edges.Passkey,
+	})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInitializeAuthn, "Failed to begin assertion", err)
+	}
+
+	if err := kv.Set(fmt.Sprintf("%s%s", authn2FASessionKey, s.SessionID), *sessionData, 300); err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to store session data", err)
+	}
+
+	return options, nil
+}
+
+type (
+	FinishPasskey2FAParameterCtx struct{}
+	FinishPasskey2FAService      struct {
+		Response  string `json:"response" binding:"required"`
+		SessionID string `json:"session_id" binding:"required"`
+	}
+)
+
+// Verify2FA validates the WebAuthn assertion of given 2FA session and returns the login user.
+func (s *FinishPasskey2FAService) Verify2FA(c *gin.Context) (*ent.User, error) {
+	dep := dependency.FromContext(c)
+	kv := dep.KV()
+
+	sessionRaw, ok := kv.Get(fmt.Sprintf("%s%s", user2FASessionPrefix, s.SessionID))
+	if !ok {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Session not found", nil)
+	}
+
+	sessionDataRaw, ok := kv.Get(fmt.Sprintf("%s%s", authn2FASessionKey, s.SessionID))
+	if !ok {
+		return nil, serializer.NewError(serializer.CodeNotFound, "Assertion session not found", nil)
+	}
+
+	_ = kv.Delete(authn2FASessionKey, s.SessionID)
+
+	ctx := context.WithValue(c, inventory.LoadUserGroup{}, true)
+	ctx = context.WithValue(ctx, inventory.LoadUserPasskey{}, true)
+	expectedUser, err := dep.UserClient().GetByID(ctx, sessionRaw.(int))
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeNotFound, "User not found", err)
+	}
+
+	if !passkey2FAEnabled(expectedUser) {
+		return nil, serializer.NewError(serializer.CodeFeatureNotEnabled, "Passkey is not enabled as second factor", nil)
+	}
+
+	protection := dep.SettingProvider().LoginProtection(c)
+	subject := auth.LoginSubject(expectedUser, "")
+	ip := ipaccess.RequestClientIP(c, dep).String()
+	if lockedUntil := auth.LoginLocks(kv, protection, subject, ip); !lockedUntil.IsZero() {
+		return nil, loginLockedError(lockedUntil)
+	}
+
+	webAuthn, err := dep.WebAuthn(c)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to initialize WebAuthn", err)
+	}
+
+	// Malformed responses are counted as failures like incorrect OTP codes.
+	if err := validatePasskeyAssertion(c, dep, webAuthn, expectedUser, sessionDataRaw.(webauthn.SessionData), s.Response); err != nil {
+		recordLoginFailure(c, dep, protection, expectedUser, subject, ip)
+		return nil, err
+	}
+
+	_ = kv.Delete(user2FASessionPrefix, s.SessionID)
+	resetLoginFailure(dep, expectedUser)
+	return expectedUser, nil
+}
+
+// validatePasskeyAssertion validates a WebAuthn assertion response of u against given session, and
+// marks the passkey used. User passkeys must be loaded.
+func validatePasskeyAssertion(c *gin.Context, dep dependency.Dep, webAuthn *webauthn.WebAuthn, u *ent.User,
+	sessionData webauthn.SessionData, response string) error {
+	pcc, err := protocol.ParseCredentialRequestResponseBody(strings.NewReader(response))
+	if err != nil {
+		return serializer.NewError(serializer.CodeParamErr, "Failed to parse request", err)
+	}
+
+	credential, err := webAuthn.ValidateLogin(&authnUser{
+		u:           u,
+		hasher:      dep.HashIDEncoder(),
+		credentials: u.Edges.Passkey,
+	}, sessionData, pcc)
+	if err != nil {
+		return serializer.NewError(serializer.CodeWebAuthnCredentialError, "Failed to validate assertion", err)
+	}
+
+	if err := dep.UserClient().MarkPasskeyUsed(c, u.ID, base64.StdEncoding.EncodeToString(credential.ID)); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to update passkey", err)
+	}
+
+	return nil
+}
+
+const (
+	authnVerifySessionKey = "authn_verify_"
+)
+
+// PreparePasskeyVerification begins a WebAuthn assertion for current user to confirm sensitive changes
+// of settings, e.g. disabling passkey as second factor.
+func PreparePasskeyVerification(c *gin.Context) (*protocol.CredentialAssertion, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+
+	passkeys, err := dep.UserClient().ListPasskeys(c, u.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list passkeys", err)
+	}
+
+	if len(passkeys) == 0 {
+		return nil, serializer.NewError(serializer.CodeNotFound, "No passkey registered", nil)
+	}
+
+	webAuthn, err := dep.WebAuthn(c)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to initialize WebAuthn", err)
+	}
+
+	options, sessionData, err := webAuthn.BeginLogin(&authnUser{u: u, hasher: dep.HashIDEncoder(), credentials: passkeys})
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeInitializeAuthn, "Failed to begin assertion", err)
+	}
+
+	if err := dep.KV().Set(fmt.Sprintf("%s%d", authnVerifySessionKey, u.ID), *sessionData, 300); err != nil {
+		return nil, serializer.NewError(serializer.CodeInternalSetting, "Failed to store session data", err)
+	}
+
+	return options, nil
+}
+
+// verifyPasskey validates the assertion response of current user to the challenge issued by
+// PreparePasskeyVerification, each challenge can only be used once.
+func verifyPasskey(c *gin.Context, dep dependency.Dep, u *ent.User, response string) error {
+	kv := dep.KV()
+	sessionDataRaw, ok := kv.Get(fmt.Sprintf("%s%d", authnVerifySessionKey, u.ID))
+	if !ok {
+		return serializer.NewError(serializer.CodeNotFound, "Assertion session not found", nil)
+	}
+
+	_ = kv.Delete(authnVerifySessionKey, strconv.Itoa(u.ID))
+
+	passkeys, err := dep.UserClient().ListPasskeys(c, u.ID)
+	if err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to list passkeys", err)
+	}
+
+	webAuthn, err := dep.WebAuthn(c)
+	if err != nil {
+		return serializer.NewError(serializer.CodeInternalSetting, "Failed to initialize WebAuthn", err)
+	}
+
+	withPasskeys := *u
+	withPasskeys.Edges.Passkey = passkeys
+	return validatePasskeyAssertion(c, dep, webAuthn, &withPasskeys, sessionDataRaw.(webauthn.SessionData), response)
+}
@@ -0,0 +1,280 @@
+package user
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	_ "github.com/cloudreve/Cloudreve/v4/ent/runtime"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/cache"
+	"github.com/cloudreve/Cloudreve/v4/pkg/logging"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+const testPassword = "password"
+
+// testAuthenticator is a software authenticator holding one ES256 passkey.
+type testAuthenticator struct {
+	id  []byte
+	key *ecdsa.PrivateKey
+}
+
+func newTestAuthenticator(t *testing.T) *testAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+	return &testAuthenticator{id: id, key: key}
+}
+
+func (a *testAuthenticator) credential(t *testing.T) *webauthn.Credential {
+	pub, err := a.key.PublicKey.ECDH()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Uncompressed point is 0x04 || X || Y
+	point := pub.Bytes()
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: point[1:33],
+		YCoord: point[33:],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &webauthn.Credential{ID: a.id, PublicKey: publicKey, AttestationType: "none"}
+}
+
+// assert signs the challenge in options and returns the assertion response sent by browsers.
+func (a *testAuthenticator) assert(t *testing.T, options *protocol.CredentialAssertion, origin string) string {
+	clientData, _ := json.Marshal(map[string]string{
+		"type":      string(protocol.AssertCeremony),
+		"challenge": options.Response.Challenge.String(),
+		"origin":    origin,
+	})
+
+	// User present and verified, sign count 1.
+	rpIDHash := sha256.Sum256([]byte(options.Response.RelyingPartyID))
+	authData := append(rpIDHash[:], 0x05, 0, 0, 0, 1)
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(authData, clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encode := base64.RawURLEncoding.EncodeToString
+	res, _ := json.Marshal(map[string]any{
+		"id":    encode(a.id),
+		"rawId": encode(a.id),
+		"type":  "public-key",
+		"response": map[string]string{
+			"clientDataJSON":    encode(clientData),
+			"authenticatorData": encode(authData),
+			"signature":         encode(signature),
+		},
+	})
+	return string(res)
+}
+
+type passkeyTestEnv struct {
+	dep    dependency.Dep
+	user   *ent.User
+	origin string
+}
+
+func newPasskeyTestEnv(t *testing.T) *passkeyTestEnv {
+	gin.SetMode(gin.TestMode)
+	drv, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l := logging.NewConsoleLogger(logging.LevelError)
+	dep := dependency.NewDependency(
+		dependency.WithLogger(l),
+		dependency.WithConfigPath(filepath.Join(t.TempDir(), "conf.ini")),
+		dependency.WithKV(cache.NewMemoStore("", l)),
+		dependency.WithRawEntClient(ent.NewClient(ent.Driver(drv))),
+	)
+	t.Cleanup(func() { dep.DBClient().Close() })
+
+	ctx := context.Background()
+	if err := dep.SettingClient().Set(ctx, map[string]string{"login_failure_notify": "0"}); err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := dep.UserClient().Create(ctx, &inventory.NewUserArgs{
+		Email:         "passkey@cloudreve.org",
+		PlainPassword: testPassword,
+		Status:        user.StatusActive,
+		GroupID:       2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	siteURL := dep.SettingProvider().SiteURL(ctx)
+	return &passkeyTestEnv{dep: dep, user: u, origin: siteURL.Scheme + "://" + siteURL.Host}
+}
+
+// context returns the request context of the test user, or an anonymous one if anonymous is set.
+func (e *passkeyTestEnv) context(t *testing.T, anonymous bool) *gin.Context {
+	ctx := context.WithValue(context.Background(), dependency.DepCtx{}, e.dep)
+	if !anonymous {
+		u, err := e.dep.UserClient().GetByID(context.WithValue(ctx, inventory.LoadUserGroup{}, true), e.user.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx = context.WithValue(ctx, inventory.UserCtx{}, u)
+	}
+
+	c, r := gin.CreateTestContext(httptest.NewRecorder())
+	r.ContextWithFallback = true
+	c.Request = httptest.NewRequestWithContext(ctx, http.MethodPost, "/", nil)
+	return c
+}
+
+func (e *passkeyTestEnv) addPasskey(t *testing.T, a *testAuthenticator) {
+	if _, err := e.dep.UserClient().AddPasskey(context.Background(), e.user.ID, "key", a.credential(t)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func errCode(err error) int {
+	appErr, ok := err.(serializer.AppError)
+	if !ok {
+		return 0
+	}
+	return appErr.Code
+}
+
+func TestPatchUserSetting_TwoFAPasskey(t *testing.T) {
+	a := assert.New(t)
+	env := newPasskeyTestEnv(t)
+	authenticator := newTestAuthenticator(t)
+
+	// Enabling requires a passkey
+	a.Error((&PatchUserSetting{TwoFAPasskeyEnabled: lo.ToPtr(true)}).Patch(env.context(t, false)))
+	env.addPasskey(t, authenticator)
+	a.NoError((&PatchUserSetting{TwoFAPasskeyEnabled: lo.ToPtr(true)}).Patch(env.context(t, false)))
+	a.True(env.context(t, false).Value(inventory.UserCtx{}).(*ent.User).Settings.TwoFAPasskey)
+
+	// The last passkey cannot be deleted while it is used as second factor
+	credentialID := base64.StdEncoding.EncodeToString(authenticator.id)
+	a.Error((&DeletePasskeyService{ID: credentialID}).DeletePasskey(env.context(t, false)))
+
+	// Disabling requires verification
+	a.Equal(serializer.Code2FACodeErr, errCode((&PatchUserSetting{TwoFAPasskeyEnabled: lo.ToPtr(false)}).Patch(env.context(t, false))))
+	a.Error((&PatchUserSetting{TwoFAPasskeyEnabled: lo.ToPtr(false), TwoFACode: lo.ToPtr("000000")}).Patch(env.context(t, false)))
+
+	// Assertion signed by another key is rejected, and the challenge cannot be reused
+	options, err := PreparePasskeyVerification(env.context(t, false))
+	a.NoError(err)
+	a.Error((&PatchUserSetting{
+		TwoFAPasskeyEnabled: lo.ToPtr(false),
+		PasskeyResponse:     lo.ToPtr(newTestAuthenticator(t).assert(t, options, env.origin)),
+	}).Patch(env.context(t, false)))
+	a.Error((&PatchUserSetting{
+		TwoFAPasskeyEnabled: lo.ToPtr(false),
+		PasskeyResponse:     lo.ToPtr(authenticator.assert(t, options, env.origin)),
+	}).Patch(env.context(t, false)))
+	a.True(env.context(t, false).Value(inventory.UserCtx{}).(*ent.User).Settings.TwoFAPasskey)
+
+	options, err = PreparePasskeyVerification(env.context(t, false))
+	a.NoError(err)
+	a.NoError((&PatchUserSetting{
+		TwoFAPasskeyEnabled: lo.ToPtr(false),
+		PasskeyResponse:     lo.ToPtr(authenticator.assert(t, options, env.origin)),
+	}).Patch(env.context(t, false)))
+	a.False(env.context(t, false).Value(inventory.UserCtx{}).(*ent.User).Settings.TwoFAPasskey)
+
+	// Passkey can be deleted after passkey 2FA is disabled
+	a.NoError((&DeletePasskeyService{ID: credentialID}).DeletePasskey(env.context(t, false)))
+}
+
+func TestPasskey2FA_Login(t *testing.T) {
+	a := assert.New(t)
+	env := newPasskeyTestEnv(t)
+	authenticator := newTestAuthenticator(t)
+	env.addPasskey(t, authenticator)
+	a.NoError((&PatchUserSetting{TwoFAPasskeyEnabled: lo.ToPtr(true)}).Patch(env.context(t, false)))
+
+	login := func() string {
+		_, sessionID, err := (&UserLoginService{UserName: env.user.Email, Password: testPassword}).Login(env.context(t, true))
+		a.NoError(err)
+		a.NotEmpty(sessionID, "passkey 2FA challenge is required")
+		return sessionID
+	}
+	prepare := func(sessionID string) (*protocol.CredentialAssertion, error) {
+		return (&PreparePasskey2FAService{SessionID: sessionID}).Prepare(env.context(t, true))
+	}
+	verify := func(sessionID, response string) (*ent.User, error) {
+		return (&FinishPasskey2FAService{SessionID: sessionID, Response: response}).Verify2FA(env.context(t, true))
+	}
+
+	// Successful verification resets failures recorded before it
+	sessionID := login()
+	options, err := prepare(sessionID)
+	a.NoError(err)
+	_, err = verify(sessionID, newTestAuthenticator(t).assert(t, options, env.origin))
+	a.Error(err)
+	options, err = prepare(sessionID)
+	a.NoError(err)
+	u, err := verify(sessionID, authenticator.assert(t, options, env.origin))
+	a.NoError(err)
+	a.Equal(env.user.ID, u.ID)
+
+	// 2FA session is consumed
+	_, err = prepare(sessionID)
+	a.Error(err)
+
+	// Wrong and malformed assertions are counted until the account is locked
+	maxAttempts := env.dep.SettingProvider().LoginProtection(context.Background()).MaxAttempts
+	sessionID = login()
+	for i := 0; i < maxAttempts; i++ {
+		options, err = prepare(sessionID)
+		if !a.NoError(err) {
+			return
+		}
+
+		response := "{}"
+		if i%2 == 0 {
+			response = newTestAuthenticator(t).assert(t, options, env.origin)
+		}
+		_, err = verify(sessionID, response)
+		a.Error(err)
+	}
+
+	_, err = prepare(sessionID)
+	a.Equal(serializer.CodeLoginLocked, errCode(err))
+}
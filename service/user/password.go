@@ -0,0 +1,77 @@
+package user
+
+import (
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/ent/user"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth/passwordpolicy"
+	"github.com/cloudreve/Cloudreve/v4/pkg/ipaccess"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	// RotatePasswordService changes an expired password with current credentials, used when
+	// login is rejected because of password max age.
+	RotatePasswordService struct {
+		UserName    string `json:"email" binding:"required,email"`
+		Password    string `json:"password" binding:"required,min=4,max=128"`
+		NewPassword string `json:"new_password" binding:"required,min=6,max=128"`
+	}
+	RotatePasswordParameterCtx struct{}
+)
+
+// Rotate changes the expired password. No session is issued, user should login with new password
+// afterward so that 2FA is still enforced.
+func (s *RotatePasswordService) Rotate(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	userClient := dep.UserClient()
+	kv := dep.KV()
+	protection := dep.SettingProvider().LoginProtection(c)
+	ip := ipaccess.RequestClientIP(c, dep).String()
+
+	u, err := userClient.GetByEmail(c, s.UserName)
+	if err != nil {
+		u = nil
+	}
+
+	subject := auth.LoginSubject(u, s.UserName)
+	if lockedUntil := auth.LoginLocks(kv, protection, subject, ip); !lockedUntil.IsZero() {
+		return loginLockedError(lockedUntil)
+	}
+
+	if err != nil {
+		recordLoginFailure(c, dep, protection, nil, subject, ip)
+		return serializer.NewError(serializer.CodeInvalidPassword, "Incorrect password or email address", err)
+	}
+
+	if err := inventory.CheckPassword(u, s.Password); err != nil {
+		recordLoginFailure(c, dep, protection, u, subject, ip)
+		return serializer.NewError(serializer.CodeInvalidPassword, "Incorrect password or email address", err)
+	}
+
+	if u.Status != user.StatusActive {
+		return serializer.NewError(serializer.CodeUserBaned, "This account is not active", nil)
+	}
+
+	if !passwordpolicy.Expired(dep.SettingProvider().PasswordPolicy(c), u) {
+		return serializer.NewError(serializer.CodeParamErr, "Password is not expired", nil)
+	}
+
+	if err := validateNewPassword(c, dep, u, s.NewPassword); err != nil {
+		return err
+	}
+
+	if _, err := userClient.UpdatePassword(c, u, s.NewPassword); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to update user password", err)
+	}
+
+	return nil
+}
+
+// validateNewPassword checks new password of given user against password policy, u is nil for new users.
+func validateNewPassword(c *gin.Context, dep dependency.Dep, u *ent.User, password string) error {
+	return passwordpolicy.Validate(c, dep.SettingProvider().PasswordPolicy(c), dep.RequestClient(), dep.Logger(), u, password)
+}
@@ -16,7 +16,9 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
 	"github.com/cloudreve/Cloudreve/v4/pkg/util"
+	"github.com/cloudreve/Cloudreve/v4/pkg/webhook"
 	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
 )
 
 // RegisterParameterCtx define key fore UserRegisterService
@@ -27,6 +29,9 @@ type UserRegisterService struct {
 	UserName string `form:"email" json:"email" binding:"required,email"`
 	Password string `form:"password" json:"password" binding:"required,min=6,max=128"`
 	Language string `form:"language" json:"language"`
+	// InvitationCode optional, allows registration when public sign-up is closed and
+	// bypasses email domain restrictions.
+	InvitationCode string `form:"invitation_code" json:"invitation_code"`
 }
 
 // Register 新用户注册
@@ -47,11 +52,54 @@ func (service *UserRegisterService) Register(c *gin.Context) serializer.Response
 	}
 
 	userClient := dep.UserClient()
+	var invitation *ent.Invitation
+	if service.InvitationCode != "" {
+		if !settings.InvitationEnabled(c) {
+			return serializer.ErrWithDetails(c, serializer.CodeFeatureNotEnabled, "Invitation is not enabled", nil)
+		}
+
+		var err error
+		invitation, err = findInvitation(c, userClient, service.InvitationCode)
+		if err != nil {
+			return serializer.Err(c, err)
+		}
+
+		args.Invitation = invitation
+	} else {
+		if !settings.RegisterEnabled(c) {
+			return serializer.ErrWithDetails(c, serializer.CodeFeatureNotEnabled, "This feature is not enabled", nil)
+		}
+
+		if domains := settings.RegisterAllowedDomains(c); len(domains) > 0 {
+			domain := args.Email[strings.LastIndex(args.Email, "@")+1:]
+			if !lo.Contains(domains, domain) {
+				return serializer.ErrWithDetails(c, serializer.CodeEmailDomainNotAllowed, "Email domain is not allowed", nil)
+			}
+		}
+	}
+
+	if err := validateNewPassword(c, dep, nil, service.Password); err != nil {
+		return serializer.Err(c, err)
+	}
+
 	uc, tx, _, err := inventory.WithTx(c, userClient)
 	if err != nil {
 		return serializer.DBErr(c, "Failed to start transaction", err)
 	}
 
+	if invitation != nil {
+		consumed, err := uc.ConsumeInvitation(c, invitation.ID)
+		if err != nil {
+			_ = inventory.Rollback(tx)
+			return serializer.DBErr(c, "Failed to update invitation code", err)
+		}
+
+		if !consumed {
+			_ = inventory.Rollback(tx)
+			return serializer.ErrWithDetails(c, serializer.CodeInvalidInvitation, "Invitation code is invalid or expired", nil)
+		}
+	}
+
 	expectedUser, err := uc.Create(c, args)
 	if expectedUser != nil {
 		util.WithValue(c, inventory.UserCtx{}, expectedUser)
@@ -78,6 +126,12 @@ func (service *UserRegisterService) Register(c *gin.Context) serializer.Response
 		return serializer.DBErr(c, "Failed to commit user row", err)
 	}
 
+	registered := &webhook.UserEvent{
+		User: webhook.BuildUserData(expectedUser, dep.HashIDEncoder()),
+	}
+	dep.WebhookDispatcher().Dispatch(c, webhook.EventUserRegistered, registered)
+	dep.EventBus().Publish(c, webhook.EventUserRegistered, registered)
+
 	if isEmailRequired {
 		if err := sendActivationEmail(c, dep, expectedUser); err != nil {
 			return serializer.ErrWithDetails(c, serializer.CodeNotSet, "", err)
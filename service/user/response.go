@@ -27,22 +27,44 @@ type UserSettings struct {
 	VersionRetentionMax     int       `json:"version_retention_max,omitempty"`
 	Paswordless             bool      `json:"passwordless"`
 	TwoFAEnabled            bool      `json:"two_fa_enabled"`
+	TwoFAPasskeyEnabled     bool      `json:"two_fa_passkey_enabled"`
 	Passkeys                []Passkey `json:"passkeys,omitempty"`
 	SyncViewPreferences     bool      `json:"sync_view_preferences"`
+	PendingEmail            string    `json:"pending_email,omitempty"`
+	Emails                  []Email   `json:"emails,omitempty"`
 }
 
-func BuildUserSettings(u *ent.User, passkeys []*ent.Passkey, parser *uaparser.Parser) *UserSettings {
+func BuildUserSettings(u *ent.User, passkeys []*ent.Passkey, emails []*ent.UserEmail, parser *uaparser.Parser) *UserSettings {
 	return &UserSettings{
 		VersionRetentionEnabled: u.Settings.VersionRetention,
 		VersionRetentionExt:     u.Settings.VersionRetentionExt,
 		VersionRetentionMax:     u.Settings.VersionRetentionMax,
 		TwoFAEnabled:            u.TwoFactorSecret != "",
+		TwoFAPasskeyEnabled:     u.Settings.TwoFAPasskey && len(passkeys) > 0,
 		Paswordless:             u.Password == "",
 		Passkeys: lo.Map(passkeys, func(item *ent.Passkey, index int) Passkey {
 			return BuildPasskey(item)
 		}),
 		SyncViewPreferences: u.Settings.SyncViewPreferences,
+		PendingEmail:        pendingEmail(u),
+		Emails: lo.Map(emails, func(item *ent.UserEmail, index int) Email {
+			return Email{Email: item.Email, Verified: item.VerifiedAt != nil}
+		}),
+	}
+}
+
+// Email secondary email address of user.
+type Email struct {
+	Email    string `json:"email"`
+	Verified bool   `json:"verified"`
+}
+
+func pendingEmail(u *ent.User) string {
+	if p := u.Settings.PendingEmail; p != nil && !emailChangeLinkExpired(p, time.Now()) {
+		return p.Email
 	}
+
+	return ""
 }
 
 type Passkey struct {
@@ -61,6 +83,93 @@ func BuildPasskey(passkey *ent.Passkey) Passkey {
 	}
 }
 
+// AccessToken is the public view of a personal access token.
+type AccessToken struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+// NewAccessTokenResponse contains the plain token, which is only visible once.
+type ListNotificationResponse struct {
+	Notifications []Notification `json:"notifications"`
+	// Unread total number of unread notifications.
+	Unread int `json:"unread"`
+}
+
+// Notification is an in-app notification. Type is the translation key of the message, Props are
+// variables of the message.
+type Notification struct {
+	ID        int               `json:"id"`
+	Type      string            `json:"type"`
+	Link      string            `json:"link,omitempty"`
+	Props     map[string]string `json:"props,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+	// ReadAt is nil if the notification is unread.
+	ReadAt *time.Time `json:"read_at,omitempty"`
+}
+
+func BuildNotification(n *ent.Notification) Notification {
+	return Notification{
+		ID:        n.ID,
+		Type:      n.Type,
+		Link:      n.Link,
+		Props:     n.Props,
+		CreatedAt: n.CreatedAt,
+		ReadAt:    n.ReadAt,
+	}
+}
+
+type NewAccessTokenResponse struct {
+	AccessToken
+	Token string `json:"token"`
+}
+
+func BuildAccessToken(token *ent.AccessToken) AccessToken {
+	return AccessToken{
+		ID:         token.TokenID,
+		Name:       token.Name,
+		Scopes:     token.Scopes,
+		CreatedAt:  token.CreatedAt,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+	}
+}
+
+// Invitation is the view of an invitation code for its creator.
+type Invitation struct {
+	ID         string     `json:"id"`
+	Code       string     `json:"code"`
+	Note       string     `json:"note,omitempty"`
+	GroupID    int        `json:"group_id,omitempty"`
+	MaxStorage *int64     `json:"max_storage,omitempty"`
+	MaxUses    int        `json:"max_uses,omitempty"`
+	Uses       int        `json:"uses"`
+	Invitees   []string   `json:"invitees,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+}
+
+func BuildInvitation(invitation *ent.Invitation, idEncoder hashid.Encoder) Invitation {
+	return Invitation{
+		ID:         hashid.EncodeInvitationID(idEncoder, invitation.ID),
+		Code:       invitation.Code,
+		Note:       invitation.Note,
+		GroupID:    invitation.GroupID,
+		MaxStorage: invitation.MaxStorage,
+		MaxUses:    invitation.MaxUses,
+		Uses:       invitation.Uses,
+		Invitees: lo.Map(invitation.Edges.Invitees, func(item *ent.User, index int) string {
+			return hashid.EncodeUserID(idEncoder, item.ID)
+		}),
+		CreatedAt: invitation.CreatedAt,
+		ExpiresAt: invitation.ExpiresAt,
+	}
+}
+
 // Node option for handling workflows.
 type Node struct {
 	ID           string              `json:"id"`
@@ -108,6 +217,7 @@ type User struct {
 	Group          *Group            `json:"group,omitempty"`
 	Pined          []types.PinedFile `json:"pined,omitempty"`
 	Language       string            `json:"language,omitempty"`
+	GroupExpires   *time.Time        `json:"group_expires,omitempty"`
 }
 
 type Group struct {
@@ -163,7 +273,16 @@ func BuildUser(user *ent.User, idEncoder hashid.Encoder) User {
 		Group:          BuildGroup(user.Edges.Group, idEncoder),
 		Pined:          user.Settings.Pined,
 		Language:       user.Settings.Language,
+		GroupExpires:   groupExpires(user),
+	}
+}
+
+func groupExpires(u *ent.User) *time.Time {
+	if u.Settings.GroupExpiration == nil {
+		return nil
 	}
+
+	return &u.Settings.GroupExpiration.ExpiresAt
 }
 
 func BuildGroup(group *ent.Group, idEncoder hashid.Encoder) *Group {
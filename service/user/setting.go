@@ -14,6 +14,7 @@ import (
 	"github.com/cloudreve/Cloudreve/v4/application/dependency"
 	"github.com/cloudreve/Cloudreve/v4/ent"
 	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
 	"github.com/cloudreve/Cloudreve/v4/pkg/hashid"
 	"github.com/cloudreve/Cloudreve/v4/pkg/request"
 	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
@@ -129,7 +130,12 @@ func GetUserSettings(c *gin.Context) (*UserSettings, error) {
 		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get user passkey", err)
 	}
 
-	return BuildUserSettings(u, passkeys, dep.UAParser()), nil
+	emails, err := userClient.ListEmails(c, u.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to get user emails", err)
+	}
+
+	return BuildUserSettings(u, passkeys, emails, dep.UAParser()), nil
 
 	// 用户组有效期
 
@@ -220,17 +226,42 @@ type (
 		NewPassword             *string   `json:"new_password" binding:"omitempty,min=6,max=128"`
 		TwoFAEnabled            *bool     `json:"two_fa_enabled" binding:"omitempty"`
 		TwoFACode               *string   `json:"two_fa_code" binding:"omitempty"`
-		SyncViewPreferences     *bool     `json:"sync_view_preferences" binding:"omitempty"`
+		TwoFAPasskeyEnabled     *bool     `json:"two_fa_passkey_enabled" binding:"omitempty"`
+		// PasskeyResponse is the assertion response to the challenge of PreparePasskeyVerification.
+		PasskeyResponse     *string `json:"passkey_response" binding:"omitempty"`
+		SyncViewPreferences *bool   `json:"sync_view_preferences" binding:"omitempty"`
 	}
 	PatchUserSettingParamsCtx struct{}
 )
 
+// verifySecondFactor checks the OTP code or passkey assertion in request, used before weakening the
+// second factor of u.
+func (s *PatchUserSetting) verifySecondFactor(c *gin.Context, dep dependency.Dep, u *ent.User) error {
+	if s.TwoFACode != nil && u.TwoFactorSecret != "" {
+		if !totp.Validate(*s.TwoFACode, u.TwoFactorSecret) {
+			return serializer.NewError(serializer.Code2FACodeErr, "Incorrect 2FA code", nil)
+		}
+
+		return nil
+	}
+
+	if s.PasskeyResponse != nil {
+		return verifyPasskey(c, dep, u, *s.PasskeyResponse)
+	}
+
+	return serializer.NewError(serializer.Code2FACodeErr, "2FA code or passkey verification is required", nil)
+}
+
 func (s *PatchUserSetting) Patch(c *gin.Context) error {
 	dep := dependency.FromContext(c)
 	u := inventory.UserFromContext(c)
 	userClient := dep.UserClient()
 	saveSetting := false
 
+	if _, ok := auth.ImpersonatorFromContext(c); ok && (s.NewPassword != nil || s.TwoFAEnabled != nil || s.TwoFAPasskeyEnabled != nil) {
+		return serializer.NewError(serializer.CodeNoPermissionErr, "Credentials cannot be changed in impersonation session", nil)
+	}
+
 	if s.Nick != nil {
 		if _, err := userClient.UpdateNickname(c, u, *s.Nick); err != nil {
 			return serializer.NewError(serializer.CodeDBError, "Failed to update user nick", err)
@@ -267,11 +298,35 @@ func (s *PatchUserSetting) Patch(c *gin.Context) error {
 		saveSetting = true
 	}
 
+	if s.TwoFAPasskeyEnabled != nil {
+		if *s.TwoFAPasskeyEnabled {
+			passkeys, err := userClient.ListPasskeys(c, u.ID)
+			if err != nil {
+				return serializer.NewError(serializer.CodeDBError, "Failed to list passkeys", err)
+			}
+
+			if len(passkeys) == 0 {
+				return serializer.NewError(serializer.CodeParamErr, "At least one passkey is required", nil)
+			}
+		} else if u.Settings.TwoFAPasskey {
+			if err := s.verifySecondFactor(c, dep, u); err != nil {
+				return err
+			}
+		}
+
+		u.Settings.TwoFAPasskey = *s.TwoFAPasskeyEnabled
+		saveSetting = true
+	}
+
 	if s.CurrentPassword != nil && s.NewPassword != nil {
 		if err := inventory.CheckPassword(u, *s.CurrentPassword); err != nil {
 			return serializer.NewError(serializer.CodeIncorrectPassword, "Incorrect password", err)
 		}
 
+		if err := validateNewPassword(c, dep, u, *s.NewPassword); err != nil {
+			return err
+		}
+
 		if _, err := userClient.UpdatePassword(c, u, *s.NewPassword); err != nil {
 			return serializer.NewError(serializer.CodeDBError, "Failed to update user password", err)
 		}
@@ -0,0 +1,103 @@
+package user
+
+import (
+	"time"
+
+	"github.com/cloudreve/Cloudreve/v4/application/dependency"
+	"github.com/cloudreve/Cloudreve/v4/ent"
+	"github.com/cloudreve/Cloudreve/v4/inventory"
+	"github.com/cloudreve/Cloudreve/v4/pkg/auth"
+	"github.com/cloudreve/Cloudreve/v4/pkg/serializer"
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+)
+
+const maxAccessTokensPerUser = 50
+
+// ListAccessTokens lists personal access tokens of current user.
+func ListAccessTokens(c *gin.Context) ([]AccessToken, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+	tokens, err := dep.UserClient().ListAccessTokens(c, u.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to list access tokens", err)
+	}
+
+	return lo.Map(tokens, func(item *ent.AccessToken, index int) AccessToken {
+		return BuildAccessToken(item)
+	}), nil
+}
+
+type (
+	CreateAccessTokenService struct {
+		Name   string   `json:"name" binding:"required,min=1,max=255"`
+		Scopes []string `json:"scopes" binding:"required,min=1"`
+		// ExpiresIn is the TTL of the token in seconds, 0 means never expire.
+		ExpiresIn int `json:"expires_in" binding:"min=0"`
+	}
+	CreateAccessTokenParameterCtx struct{}
+)
+
+// Create issues a new personal access token for current user.
+func (s *CreateAccessTokenService) Create(c *gin.Context) (*NewAccessTokenResponse, error) {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+
+	for _, scope := range s.Scopes {
+		if !auth.IsValidScope(scope) {
+			return nil, serializer.NewError(serializer.CodeParamErr, "Unknown scope: "+scope, nil)
+		}
+	}
+
+	count, err := dep.UserClient().CountAccessTokens(c, u.ID)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to count access tokens", err)
+	}
+
+	if count >= maxAccessTokensPerUser {
+		return nil, serializer.NewError(serializer.CodeParamErr, "Too many access tokens", nil)
+	}
+
+	var expires *time.Time
+	if s.ExpiresIn > 0 {
+		expires = lo.ToPtr(time.Now().Add(time.Duration(s.ExpiresIn) * time.Second))
+	}
+
+	plain, token, err := auth.NewAccessToken(dep.HashIDEncoder(), u.ID, s.Name, s.Scopes, expires)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeEncryptError, "Failed to generate access token", err)
+	}
+
+	token, err = dep.UserClient().AddAccessToken(c, token)
+	if err != nil {
+		return nil, serializer.NewError(serializer.CodeDBError, "Failed to save access token", err)
+	}
+
+	return &NewAccessTokenResponse{
+		AccessToken: BuildAccessToken(token),
+		Token:       plain,
+	}, nil
+}
+
+type (
+	RevokeAccessTokenService struct {
+		ID string `uri:"id" binding:"required"`
+	}
+	RevokeAccessTokenParameterCtx struct{}
+)
+
+// Revoke deletes a personal access token of current user.
+func (s *RevokeAccessTokenService) Revoke(c *gin.Context) error {
+	dep := dependency.FromContext(c)
+	u := inventory.UserFromContext(c)
+
+	if _, err := dep.UserClient().GetAccessToken(c, u.ID, s.ID); err != nil {
+		return serializer.NewError(serializer.CodeNotFound, "Access token not found", err)
+	}
+
+	if err := dep.UserClient().RemoveAccessToken(c, u.ID, s.ID); err != nil {
+		return serializer.NewError(serializer.CodeDBError, "Failed to revoke access token", err)
+	}
+
+	return nil
+}